@@ -14,16 +14,31 @@ import (
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
+func (g frontendClient) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.BackfillSchedule(ctx, proto.FromBackfillScheduleRequest(bp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	response, err := g.c.CountWorkflowExecutions(ctx, proto.FromCountWorkflowExecutionsRequest(cp1), p1...)
 	return proto.ToCountWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.CreateSchedule(ctx, proto.FromCreateScheduleRequest(cp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.DeleteDomain(ctx, proto.FromDeleteDomainRequest(dp1), p1...)
 	return proto.ToError(err)
 }
 
+func (g frontendClient) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.DeleteSchedule(ctx, proto.FromDeleteScheduleRequest(dp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.DeprecateDomain(ctx, proto.FromDeprecateDomainRequest(dp1), p1...)
 	return proto.ToError(err)
@@ -34,6 +49,11 @@ func (g frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeD
 	return proto.ToDescribeDomainResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeScheduleResponse, err error) {
+	response, err := g.c.DescribeSchedule(ctx, proto.FromDescribeScheduleRequest(dp1), p1...)
+	return proto.ToDescribeScheduleResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, proto.FromDescribeTaskListRequest(dp1), p1...)
 	return proto.ToDescribeTaskListResponse(response), proto.ToError(err)
@@ -99,6 +119,11 @@ func (g frontendClient) ListOpenWorkflowExecutions(ctx context.Context, lp1 *typ
 	return proto.ToListOpenWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSchedulesResponse, err error) {
+	response, err := g.c.ListSchedules(ctx, proto.FromListSchedulesRequest(lp1), p1...)
+	return proto.ToListSchedulesResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	response, err := g.c.ListTaskListPartitions(ctx, proto.FromListTaskListPartitionsRequest(lp1), p1...)
 	return proto.ToListTaskListPartitionsResponse(response), proto.ToError(err)
@@ -109,6 +134,16 @@ func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.L
 	return proto.ToListWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseSchedule(ctx, proto.FromPauseScheduleRequest(pp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseWorkflowExecution(ctx, proto.FromPauseWorkflowExecutionRequest(pp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, proto.FromPollForActivityTaskRequest(pp1), p1...)
 	return proto.ToPollForActivityTaskResponse(response), proto.ToError(err)
@@ -149,6 +184,11 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return proto.ToError(err)
 }
 
+func (g frontendClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ResetActivity(ctx, proto.FromResetActivityRequest(rp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, proto.FromResetStickyTaskListRequest(rp1), p1...)
 	return proto.ToResetStickyTaskListResponse(response), proto.ToError(err)
@@ -209,6 +249,11 @@ func (g frontendClient) RestartWorkflowExecution(ctx context.Context, rp1 *types
 	return proto.ToRestartWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ResumeSchedule(ctx context.Context, rp1 *types.ResumeScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ResumeSchedule(ctx, proto.FromResumeScheduleRequest(rp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.c.ScanWorkflowExecutions(ctx, proto.FromScanWorkflowExecutionsRequest(lp1), p1...)
 	return proto.ToScanWorkflowExecutionsResponse(response), proto.ToError(err)
@@ -244,7 +289,20 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return proto.ToError(err)
 }
 
+func (g frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseWorkflowExecution(ctx, proto.FromUnpauseWorkflowExecutionRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, proto.FromUpdateDomainRequest(up1), p1...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
 }
+func (g frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UpdateSchedule(ctx, proto.FromUpdateScheduleRequest(up1), p1...)
+	return proto.ToError(err)
+}
+func (g frontendClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, proto.FromUpdateWorkflowExecutionRequest(up1), p1...)
+	return proto.ToUpdateWorkflowExecutionResponse(response), proto.ToError(err)
+}
@@ -13,16 +13,31 @@ import (
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
+func (g frontendClient) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.BackfillSchedule(ctx, thrift.FromBackfillScheduleRequest(bp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	response, err := g.c.CountWorkflowExecutions(ctx, thrift.FromCountWorkflowExecutionsRequest(cp1), p1...)
 	return thrift.ToCountWorkflowExecutionsResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.CreateSchedule(ctx, thrift.FromCreateScheduleRequest(cp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.DeleteDomain(ctx, thrift.FromDeleteDomainRequest(dp1), p1...)
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.DeleteSchedule(ctx, thrift.FromDeleteScheduleRequest(dp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.DeprecateDomain(ctx, thrift.FromDeprecateDomainRequest(dp1), p1...)
 	return thrift.ToError(err)
@@ -33,6 +48,11 @@ func (g frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeD
 	return thrift.ToDescribeDomainResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeScheduleResponse, err error) {
+	response, err := g.c.DescribeSchedule(ctx, thrift.FromDescribeScheduleRequest(dp1), p1...)
+	return thrift.ToDescribeScheduleResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, thrift.FromDescribeTaskListRequest(dp1), p1...)
 	return thrift.ToDescribeTaskListResponse(response), thrift.ToError(err)
@@ -98,6 +118,11 @@ func (g frontendClient) ListOpenWorkflowExecutions(ctx context.Context, lp1 *typ
 	return thrift.ToListOpenWorkflowExecutionsResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSchedulesResponse, err error) {
+	response, err := g.c.ListSchedules(ctx, thrift.FromListSchedulesRequest(lp1), p1...)
+	return thrift.ToListSchedulesResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	response, err := g.c.ListTaskListPartitions(ctx, thrift.FromListTaskListPartitionsRequest(lp1), p1...)
 	return thrift.ToListTaskListPartitionsResponse(response), thrift.ToError(err)
@@ -108,6 +133,16 @@ func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.L
 	return thrift.ToListWorkflowExecutionsResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseSchedule(ctx, thrift.FromPauseScheduleRequest(pp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseWorkflowExecution(ctx, thrift.FromPauseWorkflowExecutionRequest(pp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, thrift.FromPollForActivityTaskRequest(pp1), p1...)
 	return thrift.ToPollForActivityTaskResponse(response), thrift.ToError(err)
@@ -148,6 +183,11 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ResetActivity(ctx, thrift.FromResetActivityRequest(rp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, thrift.FromResetStickyTaskListRequest(rp1), p1...)
 	return thrift.ToResetStickyTaskListResponse(response), thrift.ToError(err)
@@ -208,6 +248,11 @@ func (g frontendClient) RestartWorkflowExecution(ctx context.Context, rp1 *types
 	return thrift.ToRestartWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) ResumeSchedule(ctx context.Context, rp1 *types.ResumeScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ResumeSchedule(ctx, thrift.FromResumeScheduleRequest(rp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.c.ScanWorkflowExecutions(ctx, thrift.FromScanWorkflowExecutionsRequest(lp1), p1...)
 	return thrift.ToScanWorkflowExecutionsResponse(response), thrift.ToError(err)
@@ -243,7 +288,20 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseWorkflowExecution(ctx, thrift.FromUnpauseWorkflowExecutionRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, thrift.FromUpdateDomainRequest(up1), p1...)
 	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
 }
+func (g frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpdateSchedule(ctx, thrift.FromUpdateScheduleRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+func (g frontendClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, thrift.FromUpdateWorkflowExecutionRequest(up1), p1...)
+	return thrift.ToUpdateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
 	"github.com/uber/cadence/common/rpc/rpcfx"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/tracing/tracingfx"
 	shardDistributorCfg "github.com/uber/cadence/service/sharddistributor/config"
 	"github.com/uber/cadence/service/sharddistributor/sharddistributorfx"
 	"github.com/uber/cadence/service/sharddistributor/store/etcd"
@@ -55,6 +56,7 @@ var _commonModule = fx.Options(
 	dynamicconfigfx.Module,
 	logfx.Module,
 	metricsfx.Module,
+	tracingfx.Module,
 	clockfx.Module)
 
 // Module provides a cadence server initialization with root components.
@@ -53,6 +53,7 @@ import (
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging/kafka"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/peerprovider"
 	"github.com/uber/cadence/common/peerprovider/ringpopprovider"
 	pnt "github.com/uber/cadence/common/pinot"
 	"github.com/uber/cadence/common/resource"
@@ -64,8 +65,12 @@ import (
 	"github.com/uber/cadence/service/matching"
 	"github.com/uber/cadence/service/worker"
 	diagnosticsInvariant "github.com/uber/cadence/service/worker/diagnostics/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/childworkflow"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/historysize"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/nondeterminism"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/retry"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/stuckdecision"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
 
@@ -154,19 +159,33 @@ func (s *server) startService() common.Daemon {
 	rpcFactory := rpc.NewFactory(params.Logger, rpcParams)
 	params.RPCFactory = rpcFactory
 
-	peerProvider, err := ringpopprovider.New(
-		params.Name,
-		&s.cfg.Ringpop,
-		rpcFactory.GetTChannel(),
-		membership.PortMap{
-			membership.PortGRPC:     svcCfg.RPC.GRPCPort,
-			membership.PortTchannel: svcCfg.RPC.Port,
-		},
-		params.Logger,
-	)
-
-	if err != nil {
-		s.logger.Fatal("ringpop provider failed", tag.Error(err))
+	portMap := membership.PortMap{
+		membership.PortGRPC:     svcCfg.RPC.GRPCPort,
+		membership.PortTchannel: svcCfg.RPC.Port,
+	}
+	var peerProvider membership.PeerProvider
+	if len(s.cfg.Membership.Provider) > 0 {
+		// a peer provider plugin is configured, ringpop is used otherwise
+		peerProvider, err = peerprovider.New(s.cfg.Membership.Provider, peerprovider.Container{
+			Service: params.Name,
+			Channel: rpcFactory.GetTChannel(),
+			Logger:  params.Logger,
+			Portmap: portMap,
+		}).Provider()
+		if err != nil {
+			s.logger.Fatal("peer provider failed", tag.Error(err))
+		}
+	} else {
+		peerProvider, err = ringpopprovider.New(
+			params.Name,
+			&s.cfg.Ringpop,
+			rpcFactory.GetTChannel(),
+			portMap,
+			params.Logger,
+		)
+		if err != nil {
+			s.logger.Fatal("ringpop provider failed", tag.Error(err))
+		}
 	}
 
 	params.HashRings = make(map[string]membership.SingleProvider)
@@ -248,7 +267,15 @@ func (s *server) startService() common.Daemon {
 	}
 
 	params.KafkaConfig = s.cfg.Kafka
-	params.DiagnosticsInvariants = []diagnosticsInvariant.Invariant{timeout.NewInvariant(timeout.Params{Client: params.PublicClient}), failure.NewInvariant(), retry.NewInvariant()}
+	params.DiagnosticsInvariants = []diagnosticsInvariant.Invariant{
+		timeout.NewInvariant(timeout.Params{Client: params.PublicClient}),
+		failure.NewInvariant(),
+		retry.NewInvariant(),
+		nondeterminism.NewInvariant(),
+		stuckdecision.NewInvariant(),
+		historysize.NewInvariant(),
+		childworkflow.NewInvariant(),
+	}
 
 	params.Logger.Info("Starting service " + s.name)
 
@@ -55,6 +55,8 @@ var (
 	ErrInvalidGetHistoryRequest = errors.New("get archived history request is invalid")
 	// ErrInvalidQueryVisibilityRequest is the error for invalid Query Visibility request
 	ErrInvalidQueryVisibilityRequest = errors.New("query visiblity request is invalid")
+	// ErrInvalidDeleteRequest is the error for invalid Delete or DeleteExpired request
+	ErrInvalidDeleteRequest = errors.New("delete archived records request is invalid")
 	// ErrNextPageTokenCorrupted is the error for corrupted GetHistory token
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
@@ -0,0 +1,104 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+const (
+	// dirCursorIdleTimeout is the time after which a cursor no page of its sweep used is closed
+	dirCursorIdleTimeout = 10 * time.Minute
+)
+
+type (
+	// dirCursors keeps the directories swept by DeleteExpired() open between the pages of a sweep,
+	// so that each page continues reading the directory where the previous page stopped, and a sweep
+	// reads the directory only once. The file system doesn't list a directory in any order which
+	// could be resumed from a file name, so the cursor can't be carried by the page token itself.
+	dirCursors struct {
+		sync.Mutex
+		timeSource clock.TimeSource
+		cursors    map[string]*dirCursor
+	}
+
+	dirCursor struct {
+		dirPath  string
+		dir      *os.File
+		lastUsed time.Time
+	}
+)
+
+func newDirCursors(timeSource clock.TimeSource) *dirCursors {
+	return &dirCursors{
+		timeSource: timeSource,
+		cursors:    make(map[string]*dirCursor),
+	}
+}
+
+// acquire returns the cursor with the given ID, or a new cursor at the beginning of the directory
+// if there is no such cursor, e.g. because the process restarted or another host served the previous page.
+// Starting over only examines again the files kept by the previous pages. The cursor is held by the
+// caller until it is released, so that two pages of the same sweep never read it concurrently.
+func (c *dirCursors) acquire(cursorID string, dirPath string) (string, *os.File, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.closeIdleLocked()
+	if cursor, ok := c.cursors[cursorID]; ok && cursor.dirPath == dirPath {
+		delete(c.cursors, cursorID)
+		return cursorID, cursor.dir, nil
+	}
+
+	dir, err := os.Open(dirPath)
+	if err != nil {
+		return "", nil, err
+	}
+	return uuid.New().String(), dir, nil
+}
+
+// release keeps the cursor open for the next page of the sweep
+func (c *dirCursors) release(cursorID string, dirPath string, dir *os.File) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.cursors[cursorID] = &dirCursor{
+		dirPath:  dirPath,
+		dir:      dir,
+		lastUsed: c.timeSource.Now(),
+	}
+}
+
+func (c *dirCursors) closeIdleLocked() {
+	now := c.timeSource.Now()
+	for cursorID, cursor := range c.cursors {
+		if now.Sub(cursor.lastUsed) >= dirCursorIdleTimeout {
+			cursor.dir.Close()
+			delete(c.cursors, cursorID)
+		}
+	}
+}
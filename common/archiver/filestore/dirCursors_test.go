@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/util"
+)
+
+func TestDirCursors(t *testing.T) {
+	dir := t.TempDir()
+	otherDir := t.TempDir()
+	for _, filename := range []string{"a", "b", "c"} {
+		require.NoError(t, util.WriteFile(filepath.Join(dir, filename), []byte("content"), testFileMode))
+	}
+
+	timeSource := clock.NewMockedTimeSource()
+	cursors := newDirCursors(timeSource)
+
+	// a sweep reads the directory once, across pages
+	cursorID, f, err := cursors.acquire("", dir)
+	require.NoError(t, err)
+	read, err := f.Readdirnames(2)
+	require.NoError(t, err)
+	cursors.release(cursorID, dir, f)
+
+	resumedID, resumed, err := cursors.acquire(cursorID, dir)
+	require.NoError(t, err)
+	require.Equal(t, cursorID, resumedID)
+	require.Same(t, f, resumed)
+	rest, err := resumed.Readdirnames(2)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"a", "b", "c"}, append(read, rest...))
+	cursors.release(cursorID, dir, resumed)
+
+	// the cursor of a sweep is not used for another directory
+	otherID, other, err := cursors.acquire(cursorID, otherDir)
+	require.NoError(t, err)
+	require.NotEqual(t, cursorID, otherID)
+	require.NoError(t, other.Close())
+
+	// an idle cursor is closed, and the sweep starts over
+	timeSource.Advance(dirCursorIdleTimeout)
+	restartedID, restarted, err := cursors.acquire(cursorID, dir)
+	require.NoError(t, err)
+	require.NotEqual(t, cursorID, restartedID)
+	require.Empty(t, cursors.cursors)
+	read, err = restarted.Readdirnames(-1)
+	require.NoError(t, err)
+	require.Len(t, read, 3)
+	require.NoError(t, restarted.Close())
+}
@@ -32,6 +32,11 @@
 // of NextPageToken or close failover version is specified, the highest close failover version
 // will be picked.
 
+// The Delete() method removes all archived versions of a workflow history, while the
+// DeleteExpired() method removes the histories of a domain whose files were last modified
+// before the given time. DeleteExpired() reads the directory once per sweep, keeping it open
+// between pages, and uses the ID of the open directory as its NextPageToken.
+
 package filestore
 
 import (
@@ -44,6 +49,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
@@ -69,9 +75,10 @@ var (
 
 type (
 	historyArchiver struct {
-		container *archiver.HistoryBootstrapContainer
-		fileMode  os.FileMode
-		dirMode   os.FileMode
+		container  *archiver.HistoryBootstrapContainer
+		fileMode   os.FileMode
+		dirMode    os.FileMode
+		dirCursors *dirCursors
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -81,6 +88,10 @@ type (
 		CloseFailoverVersion int64
 		NextBatchIdx         int
 	}
+
+	deleteExpiredToken struct {
+		CursorID string
+	}
 )
 
 // NewHistoryArchiver creates a new archiver.HistoryArchiver based on filestore
@@ -109,6 +120,7 @@ func newHistoryArchiver(
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
 		historyIterator: historyIterator,
+		dirCursors:      newDirCursors(clock.NewRealTimeSource()),
 	}, nil
 }
 
@@ -282,6 +294,61 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	dirPath := URI.Path()
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil
+	}
+
+	filenames, err := util.ListFilesByPrefix(dirPath, constructHistoryFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID))
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	for _, filename := range filenames {
+		if _, err := extractCloseFailoverVersion(filename); err != nil {
+			continue
+		}
+		if err := deleteFile(path.Join(dirPath, filename)); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
+func (h *historyArchiver) DeleteExpired(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteExpiredRequest,
+) (*archiver.DeleteExpiredResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteExpiredRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	// histories of all domains share the same directory, the hashed domainID
+	// at the beginning of each file name tells them apart
+	return deleteExpiredFiles(ctx, h.dirCursors, URI.Path(), hash(request.DomainID), historyFileSuffix, request)
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
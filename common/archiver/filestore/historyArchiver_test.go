@@ -555,6 +555,184 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestDelete_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = historyArchiver.Delete(context.Background(), URI, request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	err := historyArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID: testDomainID,
+		RunID:    testRunID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDelete_Success_DirectoryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	err := historyArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.NoError(err)
+}
+
+func (s *historyArchiverSuite) TestDelete_Success() {
+	dir, err := ioutil.TempDir("", "TestDelete")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	filenames := []string{
+		constructHistoryFilename(testDomainID, testWorkflowID, testRunID, 1),
+		constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion),
+		constructHistoryFilename(testDomainID, testWorkflowID, "other-run-id", testCloseFailoverVersion),
+	}
+	for _, filename := range filenames {
+		s.NoError(util.WriteFile(path.Join(dir, filename), []byte("history"), testFileMode))
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Delete(context.Background(), URI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.NoError(err)
+
+	remaining, err := util.ListFiles(dir)
+	s.NoError(err)
+	s.Equal([]string{filenames[2]}, remaining)
+
+	// deleting again is a no-op
+	err = historyArchiver.Delete(context.Background(), URI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.NoError(err)
+}
+
+func (s *historyArchiverSuite) TestDeleteExpired_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.DeleteExpired(context.Background(), s.testArchivalURI, &archiver.DeleteExpiredRequest{
+		DomainID: testDomainID,
+		PageSize: testPageSize,
+	})
+	s.Nil(response)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDeleteExpired_Fail_InvalidToken() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.DeleteExpired(context.Background(), s.testArchivalURI, &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: time.Now(),
+		PageSize:       testPageSize,
+		NextPageToken:  []byte{'r', 'a', 'n', 'd', 'o', 'm'},
+	})
+	s.Nil(response)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDeleteExpired_Success() {
+	dir, err := ioutil.TempDir("", "TestDeleteExpired")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	expired := []string{
+		constructHistoryFilename(testDomainID, testWorkflowID, "run-1", testCloseFailoverVersion),
+		constructHistoryFilename(testDomainID, testWorkflowID, "run-2", testCloseFailoverVersion),
+		constructHistoryFilename(testDomainID, "other-workflow-id", "run-3", testCloseFailoverVersion),
+	}
+	retained := []string{
+		constructHistoryFilename(testDomainID, testWorkflowID, "run-4", testCloseFailoverVersion),
+		constructHistoryFilename("other-domain-id", testWorkflowID, "run-5", testCloseFailoverVersion),
+	}
+	for _, filename := range append(expired, retained...) {
+		s.NoError(util.WriteFile(path.Join(dir, filename), []byte("history"), testFileMode))
+	}
+	for _, filename := range append(expired, retained[1]) {
+		oldTime := now.Add(-48 * time.Hour)
+		s.NoError(os.Chtimes(path.Join(dir, filename), oldTime, oldTime))
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	request := &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: now.Add(-24 * time.Hour),
+		PageSize:       1,
+	}
+	deleted := 0
+	pages := 0
+	for {
+		response, err := historyArchiver.DeleteExpired(context.Background(), URI, request)
+		s.NoError(err)
+		deleted += response.DeletedCount
+		pages++
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal(len(expired), deleted)
+	// each page reads one entry of the directory, the last one finds the end of the directory
+	s.Equal(len(expired)+len(retained)+1, pages)
+
+	remaining, err := util.ListFiles(dir)
+	s.NoError(err)
+	s.ElementsMatch(retained, remaining)
+}
+
+func (s *historyArchiverSuite) TestDeleteExpired_Success_CursorGone() {
+	dir := s.T().TempDir()
+	oldTime := time.Now().Add(-48 * time.Hour)
+	for _, runID := range []string{"run-1", "run-2", "run-3"} {
+		filename := path.Join(dir, constructHistoryFilename(testDomainID, testWorkflowID, runID, testCloseFailoverVersion))
+		s.NoError(util.WriteFile(filename, []byte("history"), testFileMode))
+		s.NoError(os.Chtimes(filename, oldTime, oldTime))
+	}
+
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	request := &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: time.Now().Add(-24 * time.Hour),
+		PageSize:       1,
+	}
+	response, err := s.newTestHistoryArchiver(nil).DeleteExpired(context.Background(), URI, request)
+	s.NoError(err)
+	s.Equal(1, response.DeletedCount)
+	s.NotNil(response.NextPageToken)
+
+	// the next page is served by another archiver, which doesn't have the cursor and starts over
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request.NextPageToken = response.NextPageToken
+	request.PageSize = testPageSize
+	response, err = historyArchiver.DeleteExpired(context.Background(), URI, request)
+	s.NoError(err)
+	s.Equal(2, response.DeletedCount)
+	s.Nil(response.NextPageToken)
+
+	remaining, err := util.ListFiles(dir)
+	s.NoError(err)
+	s.Empty(remaining)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -25,16 +25,24 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 
 	"github.com/dgryski/go-farm"
 
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/util"
 )
 
+const (
+	historyFileSuffix    = ".history"
+	visibilityFileSuffix = ".visibility"
+)
+
 var (
 	errEmptyDirectoryPath = errors.New("directory path is empty")
 )
@@ -82,11 +90,17 @@ func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error
 	return token, err
 }
 
+func deserializeDeleteExpiredToken(bytes []byte) (*deleteExpiredToken, error) {
+	token := &deleteExpiredToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
 // File name construction
 
 func constructHistoryFilename(domainID, workflowID, runID string, version int64) string {
 	combinedHash := constructHistoryFilenamePrefix(domainID, workflowID, runID)
-	return fmt.Sprintf("%s_%v.history", combinedHash, version)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, historyFileSuffix)
 }
 
 func constructHistoryFilenamePrefix(domainID, workflowID, runID string) string {
@@ -94,7 +108,7 @@ func constructHistoryFilenamePrefix(domainID, workflowID, runID string) string {
 }
 
 func constructVisibilityFilename(closeTimestamp int64, runID string) string {
-	return fmt.Sprintf("%v_%s.visibility", closeTimestamp, hash(runID))
+	return fmt.Sprintf("%v_%s%s", closeTimestamp, hash(runID), visibilityFileSuffix)
 }
 
 func hash(s string) string {
@@ -120,6 +134,101 @@ func validateDirPath(dirPath string) error {
 	return nil
 }
 
+// Deletion
+
+// deleteExpiredFiles reads the next request.PageSize entries of dirPath and removes the files which have the given
+// prefix and suffix and were last modified before request.ArchivedBefore. The directory is kept open by the
+// cursors between the pages of a sweep, the NextPageToken identifies the cursor of the sweep.
+func deleteExpiredFiles(
+	ctx context.Context,
+	cursors *dirCursors,
+	dirPath string,
+	prefix string,
+	suffix string,
+	request *archiver.DeleteExpiredRequest,
+) (*archiver.DeleteExpiredResponse, error) {
+	var token *deleteExpiredToken
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeDeleteExpiredToken(request.NextPageToken)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+	}
+
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return &archiver.DeleteExpiredResponse{}, nil
+	}
+
+	var cursorID string
+	if token != nil {
+		cursorID = token.CursorID
+	}
+	cursorID, dir, err := cursors.acquire(cursorID, dirPath)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	filenames, err := dir.Readdirnames(request.PageSize)
+	if err != nil && err != io.EOF {
+		dir.Close()
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	response := &archiver.DeleteExpiredResponse{}
+	for _, filename := range filenames {
+		if contextExpired(ctx) {
+			dir.Close()
+			return nil, archiver.ErrContextTimeout
+		}
+		if !strings.HasPrefix(filename, prefix) || !strings.HasSuffix(filename, suffix) {
+			continue
+		}
+
+		filepath := path.Join(dirPath, filename)
+		info, err := os.Stat(filepath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			dir.Close()
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		if info.ModTime().Before(request.ArchivedBefore) {
+			if err := deleteFile(filepath); err != nil {
+				dir.Close()
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			response.DeletedCount++
+		}
+	}
+
+	if len(filenames) < request.PageSize {
+		// the end of the directory is reached
+		dir.Close()
+		return response, nil
+	}
+	nextToken, err := serializeToken(&deleteExpiredToken{CursorID: cursorID})
+	if err != nil {
+		dir.Close()
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+	cursors.release(cursorID, dirPath, dir)
+	response.NextPageToken = nextToken
+	return response, nil
+}
+
+func deleteFile(filepath string) error {
+	if err := os.Remove(filepath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 // Misc.
 
 func extractCloseFailoverVersion(filename string) (int64, error) {
@@ -31,6 +31,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/types"
@@ -47,6 +48,7 @@ type (
 		fileMode    os.FileMode
 		dirMode     os.FileMode
 		queryParser QueryParser
+		dirCursors  *dirCursors
 	}
 
 	queryVisibilityToken struct {
@@ -82,6 +84,7 @@ func NewVisibilityArchiver(
 		fileMode:    os.FileMode(fileMode),
 		dirMode:     os.FileMode(dirMode),
 		queryParser: NewQueryParser(),
+		dirCursors:  newDirCursors(clock.NewRealTimeSource()),
 	}, nil
 }
 
@@ -237,6 +240,60 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	dirPath := path.Join(URI.Path(), request.DomainID)
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil
+	}
+
+	files, err := util.ListFiles(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	suffix := fmt.Sprintf("_%s%s", hash(request.RunID), visibilityFileSuffix)
+	for _, file := range files {
+		if !strings.HasSuffix(file, suffix) {
+			continue
+		}
+		if err := deleteFile(path.Join(dirPath, file)); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) DeleteExpired(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteExpiredRequest,
+) (*archiver.DeleteExpiredResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteExpiredRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	return deleteExpiredFiles(ctx, v.dirCursors, path.Join(URI.Path(), request.DomainID), "", visibilityFileSuffix, request)
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
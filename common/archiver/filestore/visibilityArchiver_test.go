@@ -479,6 +479,103 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	err := visibilityArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestDelete_Success() {
+	dir := s.T().TempDir()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, record := range s.visibilityRecords {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record)))
+	}
+
+	err = visibilityArchiver.Delete(context.Background(), URI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: "another workflow ID",
+		RunID:      "another run ID",
+	})
+	s.NoError(err)
+
+	remaining, err := util.ListFiles(path.Join(dir, testDomainID))
+	s.NoError(err)
+	s.Len(remaining, 3)
+	s.NotContains(remaining, constructVisibilityFilename(10, "another run ID"))
+
+	// records of other domains are untouched
+	remaining, err = util.ListFiles(path.Join(dir, "some random domain ID"))
+	s.NoError(err)
+	s.Len(remaining, 1)
+}
+
+func (s *visibilityArchiverSuite) TestDeleteExpired_Success_DirectoryNotExist() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	response, err := visibilityArchiver.DeleteExpired(context.Background(), s.testArchivalURI, &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: time.Now(),
+		PageSize:       1,
+	})
+	s.NoError(err)
+	s.Zero(response.DeletedCount)
+	s.Nil(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestDeleteExpired_Success() {
+	dir := s.T().TempDir()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, record := range s.visibilityRecords {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record)))
+	}
+
+	now := time.Now()
+	oldTime := now.Add(-48 * time.Hour)
+	expired := []string{
+		constructVisibilityFilename(10000, testRunID),
+		constructVisibilityFilename(5, "and another run ID"),
+	}
+	for _, filename := range expired {
+		s.NoError(os.Chtimes(path.Join(dir, testDomainID, filename), oldTime, oldTime))
+	}
+	otherDomainFile := path.Join(dir, "some random domain ID", constructVisibilityFilename(10000, "another run ID"))
+	s.NoError(os.Chtimes(otherDomainFile, oldTime, oldTime))
+
+	request := &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: now.Add(-24 * time.Hour),
+		PageSize:       3,
+	}
+	response, err := visibilityArchiver.DeleteExpired(context.Background(), URI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	deleted := response.DeletedCount
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.DeleteExpired(context.Background(), URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	deleted += response.DeletedCount
+	s.Equal(len(expired), deleted)
+
+	remaining, err := util.ListFiles(path.Join(dir, testDomainID))
+	s.NoError(err)
+	s.ElementsMatch([]string{
+		constructVisibilityFilename(1000, "some random run ID"),
+		constructVisibilityFilename(10, "another run ID"),
+	}, remaining)
+	s.assertFileExists(otherDomainFile)
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
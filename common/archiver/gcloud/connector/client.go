@@ -27,6 +27,8 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/iterator"
@@ -51,7 +53,9 @@ type (
 		Get(ctx context.Context, URI archiver.URI, file string) ([]byte, error)
 		Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) ([]string, error)
 		QueryWithFilters(ctx context.Context, URI archiver.URI, fileNamePrefix string, pageSize, offset int, filters []Precondition) ([]string, bool, int, error)
+		QueryModifiedBefore(ctx context.Context, URI archiver.URI, fileNamePrefix string, modifiedBefore time.Time, startAfter string, pageSize int) ([]string, string, bool, error)
 		Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error)
+		Delete(ctx context.Context, URI archiver.URI, fileName string) error
 	}
 
 	// Config structure used to parse from the storage-provider yaml nodes in [github.com/uber/cadence/common/config.HistoryArchiverProvider]
@@ -197,6 +201,58 @@ func (s *storageWrapper) QueryWithFilters(ctx context.Context, URI archiver.URI,
 
 }
 
+// QueryModifiedBefore examines up to pageSize files whose names sort after startAfter and returns the names of the
+// ones last modified before the given time, the name of the last examined file and whether all files were examined.
+// File names are relative to the URI path, the same way they are passed to Upload and Get.
+func (s *storageWrapper) QueryModifiedBefore(ctx context.Context, URI archiver.URI, fileNamePrefix string, modifiedBefore time.Time, startAfter string, pageSize int) ([]string, string, bool, error) {
+	sinkPath := formatSinkPath(URI.Path()) + "/"
+	query := &storage.Query{
+		Prefix: sinkPath + fileNamePrefix,
+	}
+	if startAfter != "" {
+		query.StartOffset = sinkPath + startAfter
+	}
+
+	fileNames := make([]string, 0)
+	lastFileName := ""
+	examined := 0
+	it := s.client.Bucket(URI.Hostname()).Objects(ctx, query)
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return fileNames, lastFileName, true, nil
+		}
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		fileName := strings.TrimPrefix(attrs.Name, sinkPath)
+		if fileName == startAfter {
+			// StartOffset is inclusive
+			continue
+		}
+		if completed := isPageCompleted(pageSize, examined); completed {
+			return fileNames, lastFileName, false, nil
+		}
+
+		examined++
+		lastFileName = fileName
+		if attrs.Updated.Before(modifiedBefore) {
+			fileNames = append(fileNames, fileName)
+		}
+	}
+}
+
+// Delete removes a file, deleting a file which does not exist is not an error
+func (s *storageWrapper) Delete(ctx context.Context, URI archiver.URI, fileName string) error {
+	bucket := s.client.Bucket(URI.Hostname())
+	err := bucket.Object(formatSinkPath(URI.Path()) + "/" + fileName).Delete(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil
+	}
+	return err
+}
+
 func isPageCompleted(pageSize, currentPosition int) bool {
 	return pageSize != 0 && currentPosition > 0 && pageSize <= currentPosition
 }
@@ -29,6 +29,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/stretchr/testify/mock"
@@ -309,6 +310,69 @@ func (s *clientSuite) TestQueryWithFilter() {
 	s.Equal(strings.Join(fileNames, ", "), "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility")
 }
 
+func (s *clientSuite) TestQueryModifiedBefore() {
+	ctx := context.Background()
+	mockBucketHandleClient := &mocks.BucketHandleWrapper{}
+	mockStorageClient := &mocks.GcloudStorageClient{}
+	mockObjectIterator := &mocks.ObjectIteratorWrapper{}
+	storageWrapper, _ := connector.NewClientWithParams(mockStorageClient)
+
+	now := time.Now()
+	objects := []*storage.ObjectAttrs{
+		{Name: "cadence_archival/development/a.history", Updated: now.Add(-time.Hour)},
+		{Name: "cadence_archival/development/b.history", Updated: now.Add(-time.Hour)},
+		{Name: "cadence_archival/development/c.history", Updated: now.Add(time.Hour)},
+		{Name: "cadence_archival/development/d.history", Updated: now.Add(-time.Hour)},
+	}
+
+	mockStorageClient.On("Bucket", "my-bucket-cad").Return(mockBucketHandleClient).Times(1)
+	mockBucketHandleClient.On("Objects", ctx, &storage.Query{
+		Prefix:      "cadence_archival/development/",
+		StartOffset: "cadence_archival/development/a.history",
+	}).Return(mockObjectIterator).Times(1)
+	mockIterator := 0
+	mockObjectIterator.On("Next").Return(func() *storage.ObjectAttrs {
+		mockIterator++
+		if mockIterator <= len(objects) {
+			return objects[mockIterator-1]
+		}
+		return nil
+	}, func() error {
+		if mockIterator <= len(objects) {
+			return nil
+		}
+		return iterator.Done
+	})
+
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	s.Require().NoError(err)
+	fileNames, lastFileName, completed, err := storageWrapper.QueryModifiedBefore(ctx, URI, "", now, "a.history", 2)
+	s.Require().NoError(err)
+	s.Equal([]string{"b.history"}, fileNames)
+	s.Equal("c.history", lastFileName)
+	s.False(completed)
+}
+
+func (s *clientSuite) TestDelete() {
+	ctx := context.Background()
+	mockBucketHandleClient := &mocks.BucketHandleWrapper{}
+	mockStorageClient := &mocks.GcloudStorageClient{}
+	mockObjectHandler := &mocks.ObjectHandleWrapper{}
+	storageWrapper, _ := connector.NewClientWithParams(mockStorageClient)
+
+	mockStorageClient.On("Bucket", "my-bucket-cad").Return(mockBucketHandleClient).Times(2)
+	mockBucketHandleClient.On("Object", "cadence_archival/development/myfile.history").Return(mockObjectHandler).Times(2)
+	mockObjectHandler.On("Delete", ctx).Return(nil).Once()
+	mockObjectHandler.On("Delete", ctx).Return(storage.ErrObjectNotExist).Once()
+
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	s.Require().NoError(err)
+	s.NoError(storageWrapper.Delete(ctx, URI, "myfile.history"))
+	// deleting a file which no longer exists is not an error
+	s.NoError(storageWrapper.Delete(ctx, URI, "myfile.history"))
+	mockObjectHandler.AssertExpectations(s.T())
+}
+
 func newWorkflowIDPrecondition(workflowID string) connector.Precondition {
 	return func(subject interface{}) bool {
 
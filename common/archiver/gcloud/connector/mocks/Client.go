@@ -24,6 +24,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -36,6 +37,20 @@ type Client struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: ctx, URI, fileName
+func (_m *Client) Delete(ctx context.Context, URI archiver.URI, fileName string) error {
+	ret := _m.Called(ctx, URI, fileName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, archiver.URI, string) error); ok {
+		r0 = rf(ctx, URI, fileName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Exist provides a mock function with given fields: ctx, URI, fileName
 func (_m *Client) Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error) {
 	ret := _m.Called(ctx, URI, fileName)
@@ -103,6 +118,43 @@ func (_m *Client) Query(ctx context.Context, URI archiver.URI, fileNamePrefix st
 	return r0, r1
 }
 
+// QueryModifiedBefore provides a mock function with given fields: ctx, URI, fileNamePrefix, modifiedBefore, startAfter, pageSize
+func (_m *Client) QueryModifiedBefore(ctx context.Context, URI archiver.URI, fileNamePrefix string, modifiedBefore time.Time, startAfter string, pageSize int) ([]string, string, bool, error) {
+	ret := _m.Called(ctx, URI, fileNamePrefix, modifiedBefore, startAfter, pageSize)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, archiver.URI, string, time.Time, string, int) []string); ok {
+		r0 = rf(ctx, URI, fileNamePrefix, modifiedBefore, startAfter, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, archiver.URI, string, time.Time, string, int) string); ok {
+		r1 = rf(ctx, URI, fileNamePrefix, modifiedBefore, startAfter, pageSize)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 bool
+	if rf, ok := ret.Get(2).(func(context.Context, archiver.URI, string, time.Time, string, int) bool); ok {
+		r2 = rf(ctx, URI, fileNamePrefix, modifiedBefore, startAfter, pageSize)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, archiver.URI, string, time.Time, string, int) error); ok {
+		r3 = rf(ctx, URI, fileNamePrefix, modifiedBefore, startAfter, pageSize)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // QueryWithFilters provides a mock function with given fields: ctx, URI, fileNamePrefix, pageSize, offset, filters
 func (_m *Client) QueryWithFilters(ctx context.Context, URI archiver.URI, fileNamePrefix string, pageSize int, offset int, filters []connector.Precondition) ([]string, bool, int, error) {
 	ret := _m.Called(ctx, URI, fileNamePrefix, pageSize, offset, filters)
@@ -0,0 +1,220 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcloud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+
+	"github.com/uber/cadence/common/archiver/gcloud/connector"
+)
+
+type (
+	// testGcloudStorage is an in-memory stand-in for a gcloud storage bucket. It replaces the
+	// native client below the connector, so that the archivers are tested through the real
+	// connector.Client: object names, query prefixes and the StartOffset paging semantics are
+	// those of gcloud storage rather than the expectations of a mocked connector.Client
+	testGcloudStorage struct {
+		sync.Mutex
+		bucket      string
+		objects     map[string]*testGcloudObject
+		failDeletes map[string]bool
+	}
+
+	testGcloudObject struct {
+		data    []byte
+		updated time.Time
+	}
+
+	testGcloudBucket struct {
+		storage *testGcloudStorage
+		name    string
+	}
+
+	testGcloudObjectHandle struct {
+		storage *testGcloudStorage
+		name    string
+	}
+
+	testGcloudWriter struct {
+		bytes.Buffer
+		object *testGcloudObjectHandle
+	}
+
+	testGcloudReader struct {
+		*bytes.Reader
+	}
+
+	testGcloudObjectIterator struct {
+		objects []*storage.ObjectAttrs
+	}
+)
+
+var errTestGcloudDeleteFailed = errors.New("delete failed")
+
+// newTestGcloudStorage returns a connector.Client backed by an empty in-memory bucket
+func newTestGcloudStorage(bucket string) (connector.Client, *testGcloudStorage) {
+	s := &testGcloudStorage{
+		bucket:      bucket,
+		objects:     make(map[string]*testGcloudObject),
+		failDeletes: make(map[string]bool),
+	}
+	client, _ := connector.NewClientWithParams(s)
+	return client, s
+}
+
+// put stores an object as if it was uploaded at the given time
+func (s *testGcloudStorage) put(name string, data []byte, updated time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	s.objects[name] = &testGcloudObject{data: data, updated: updated}
+}
+
+// setUpdated changes the upload time of the objects which satisfy the predicate
+func (s *testGcloudStorage) setUpdated(updated time.Time, predicate func(name string) bool) {
+	s.Lock()
+	defer s.Unlock()
+	for name, object := range s.objects {
+		if predicate(name) {
+			object.updated = updated
+		}
+	}
+}
+
+// names returns the sorted names of the stored objects starting with the given prefix
+func (s *testGcloudStorage) names(prefix string) []string {
+	s.Lock()
+	defer s.Unlock()
+	var names []string
+	for name := range s.objects {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *testGcloudStorage) Bucket(name string) connector.BucketHandleWrapper {
+	return &testGcloudBucket{storage: s, name: name}
+}
+
+func (b *testGcloudBucket) Object(name string) connector.ObjectHandleWrapper {
+	return &testGcloudObjectHandle{storage: b.storage, name: name}
+}
+
+// Objects lists the objects in lexicographical order, starting at q.StartOffset inclusive like gcloud storage does
+func (b *testGcloudBucket) Objects(_ context.Context, q *storage.Query) connector.ObjectIteratorWrapper {
+	it := &testGcloudObjectIterator{}
+	if b.name != b.storage.bucket {
+		return it
+	}
+	for _, name := range b.storage.names(q.Prefix) {
+		if name < q.StartOffset {
+			continue
+		}
+		b.storage.Lock()
+		object := b.storage.objects[name]
+		it.objects = append(it.objects, &storage.ObjectAttrs{
+			Bucket:  b.name,
+			Name:    name,
+			Size:    int64(len(object.data)),
+			Updated: object.updated,
+		})
+		b.storage.Unlock()
+	}
+	return it
+}
+
+func (b *testGcloudBucket) Attrs(_ context.Context) (*storage.BucketAttrs, error) {
+	if b.name != b.storage.bucket {
+		return nil, storage.ErrBucketNotExist
+	}
+	return &storage.BucketAttrs{Name: b.name}, nil
+}
+
+func (o *testGcloudObjectHandle) NewWriter(_ context.Context) connector.WriterWrapper {
+	return &testGcloudWriter{object: o}
+}
+
+func (o *testGcloudObjectHandle) NewReader(_ context.Context) (connector.ReaderWrapper, error) {
+	o.storage.Lock()
+	defer o.storage.Unlock()
+	object, ok := o.storage.objects[o.name]
+	if !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return &testGcloudReader{Reader: bytes.NewReader(object.data)}, nil
+}
+
+func (o *testGcloudObjectHandle) Attrs(_ context.Context) (*storage.ObjectAttrs, error) {
+	o.storage.Lock()
+	defer o.storage.Unlock()
+	object, ok := o.storage.objects[o.name]
+	if !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return &storage.ObjectAttrs{Bucket: o.storage.bucket, Name: o.name, Size: int64(len(object.data)), Updated: object.updated}, nil
+}
+
+func (o *testGcloudObjectHandle) Delete(_ context.Context) error {
+	o.storage.Lock()
+	defer o.storage.Unlock()
+	if o.storage.failDeletes[o.name] {
+		return errTestGcloudDeleteFailed
+	}
+	if _, ok := o.storage.objects[o.name]; !ok {
+		return storage.ErrObjectNotExist
+	}
+	delete(o.storage.objects, o.name)
+	return nil
+}
+
+// Close makes the written object visible, like a gcloud storage upload
+func (w *testGcloudWriter) Close() error {
+	w.object.storage.put(w.object.name, w.Bytes(), time.Now())
+	return nil
+}
+
+func (w *testGcloudWriter) CloseWithError(err error) error {
+	return nil
+}
+
+func (r *testGcloudReader) Close() error {
+	return nil
+}
+
+func (it *testGcloudObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	if len(it.objects) == 0 {
+		return nil, iterator.Done
+	}
+	attrs := it.objects[0]
+	it.objects = it.objects[1:]
+	return attrs, nil
+}
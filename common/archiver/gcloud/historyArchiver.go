@@ -49,6 +49,7 @@ const (
 	errEncodeHistory      = "failed to encode history batches"
 	errBucketHistory      = "failed to get google storage bucket handle"
 	errWriteFile          = "failed to write history to google storage"
+	historyFileSuffix     = ".history"
 )
 
 type historyArchiver struct {
@@ -64,6 +65,10 @@ type progress struct {
 	IteratorState     []byte
 }
 
+type deleteExpiredToken struct {
+	StartAfter string
+}
+
 type getHistoryToken struct {
 	CloseFailoverVersion int64
 	HighestPart          int
@@ -288,6 +293,45 @@ outer:
 	return response, nil
 }
 
+// Delete is used to remove all archived versions of a workflow history.
+func (h *historyArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteHistoryRequest) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	filenames, err := h.gcloudStorage.Query(ctx, URI, constructHistoryFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID))
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+
+	for _, filename := range filenames {
+		if _, _, err := extractCloseFailoverVersion(filepath.Base(filename)); err != nil {
+			continue
+		}
+		if err := h.gcloudStorage.Delete(ctx, URI, filepath.Base(filename)); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
+// DeleteExpired is used to remove the archived histories of a domain which were archived before the given time.
+func (h *historyArchiver) DeleteExpired(ctx context.Context, URI archiver.URI, request *archiver.DeleteExpiredRequest) (*archiver.DeleteExpiredResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteExpiredRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	return deleteExpiredFiles(ctx, h.gcloudStorage, URI, "", hash(request.DomainID), historyFileSuffix, request)
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (h *historyArchiver) ValidateURI(URI archiver.URI) (err error) {
 
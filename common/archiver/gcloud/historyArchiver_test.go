@@ -23,6 +23,7 @@ package gcloud
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -593,3 +594,161 @@ func (h *historyArchiverSuite) TestGet_Success_FromToken() {
 
 	h.EqualValues(4, numOfEvents)
 }
+
+func (h *historyArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	h.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
+	historyArchiver := newHistoryArchiver(h.container, nil, storageWrapper)
+	err = historyArchiver.Delete(ctx, URI, &archiver.DeleteHistoryRequest{
+		DomainID: testDomainID,
+		RunID:    testRunID,
+	})
+	h.IsType(&types.BadRequestError{}, err)
+}
+
+func (h *historyArchiverSuite) TestDelete_Success() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	h.NoError(err)
+	prefix := constructHistoryFilenamePrefix(testDomainID, testWorkflowID, testRunID)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
+	storageWrapper.On("Query", ctx, URI, prefix).Return([]string{
+		"cadence_archival/development/" + prefix + "_-24_0.history",
+		"cadence_archival/development/" + prefix + "_-24_1.history",
+		"cadence_archival/development/" + prefix + "_-25_0.history",
+	}, nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, prefix+"_-24_0.history").Return(nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, prefix+"_-24_1.history").Return(nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, prefix+"_-25_0.history").Return(nil).Times(1)
+
+	historyArchiver := newHistoryArchiver(h.container, nil, storageWrapper)
+	err = historyArchiver.Delete(ctx, URI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	h.NoError(err)
+	storageWrapper.AssertExpectations(h.T())
+}
+
+func (h *historyArchiverSuite) TestDeleteExpired_Success() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	h.NoError(err)
+	archivedBefore := time.Now().Add(-24 * time.Hour)
+	prefix := hash(testDomainID)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(2)
+	storageWrapper.On("QueryModifiedBefore", ctx, URI, prefix, archivedBefore, "", 2).Return([]string{
+		prefix + "1_-24_0.history",
+		prefix + "1/closeTimeout_2020-02-05T09:56:14Z_1_1_1.visibility",
+	}, prefix+"1_-24_0.history", false, nil).Times(1)
+	storageWrapper.On("QueryModifiedBefore", ctx, URI, prefix, archivedBefore, prefix+"1_-24_0.history", 2).Return([]string{
+		prefix + "2_-24_0.history",
+	}, prefix+"2_-24_0.history", true, nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, prefix+"1_-24_0.history").Return(nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, prefix+"2_-24_0.history").Return(nil).Times(1)
+
+	historyArchiver := newHistoryArchiver(h.container, nil, storageWrapper)
+	request := &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: archivedBefore,
+		PageSize:       2,
+	}
+	response, err := historyArchiver.DeleteExpired(ctx, URI, request)
+	h.NoError(err)
+	h.Equal(1, response.DeletedCount)
+	h.NotNil(response.NextPageToken)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = historyArchiver.DeleteExpired(ctx, URI, request)
+	h.NoError(err)
+	h.Equal(1, response.DeletedCount)
+	h.Nil(response.NextPageToken)
+	storageWrapper.AssertExpectations(h.T())
+}
+
+func (h *historyArchiverSuite) TestDelete_StorageStandIn() {
+	ctx := context.Background()
+	sinkPath := "cadence_archival/development/"
+	storageClient, gcs := newTestGcloudStorage("my-bucket-cad")
+	now := time.Now()
+	gcs.put(sinkPath+constructHistoryFilenameMultipart(testDomainID, testWorkflowID, testRunID, -24, 0), []byte(exampleHistoryRecord), now)
+	gcs.put(sinkPath+constructHistoryFilenameMultipart(testDomainID, testWorkflowID, testRunID, -24, 1), []byte(exampleHistoryRecord), now)
+	gcs.put(sinkPath+constructHistoryFilenameMultipart(testDomainID, testWorkflowID, testRunID, -25, 0), []byte(exampleHistoryRecord), now)
+	retained := sinkPath + constructHistoryFilenameMultipart(testDomainID, testWorkflowID, "other-run-id", -24, 0)
+	gcs.put(retained, []byte(exampleHistoryRecord), now)
+
+	historyArchiver := newHistoryArchiver(h.container, nil, storageClient)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	h.NoError(historyArchiver.Delete(ctx, h.testArchivalURI, request))
+	h.Equal([]string{retained}, gcs.names(""))
+
+	// deleting a history which does not exist is a no-op
+	h.NoError(historyArchiver.Delete(ctx, h.testArchivalURI, request))
+
+	gcs.failDeletes[retained] = true
+	err := historyArchiver.Delete(ctx, h.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      "other-run-id",
+	})
+	h.IsType(&types.InternalServiceError{}, err)
+	h.Equal([]string{retained}, gcs.names(""))
+
+	URI, err := archiver.NewURI("gs://missing-bucket/cadence_archival/development")
+	h.NoError(err)
+	err = historyArchiver.Delete(ctx, URI, request)
+	h.IsType(&types.BadRequestError{}, err)
+}
+
+func (h *historyArchiverSuite) TestDeleteExpired_StorageStandIn() {
+	ctx := context.Background()
+	sinkPath := "cadence_archival/development/"
+	storageClient, gcs := newTestGcloudStorage("my-bucket-cad")
+	now := time.Now()
+	var retained []string
+	for i := 0; i < 5; i++ {
+		gcs.put(sinkPath+constructHistoryFilenameMultipart(testDomainID, testWorkflowID, fmt.Sprintf("expired-run-%d", i), -24, 0), []byte(exampleHistoryRecord), now.Add(-48*time.Hour))
+	}
+	for i := 0; i < 3; i++ {
+		name := sinkPath + constructHistoryFilenameMultipart(testDomainID, testWorkflowID, fmt.Sprintf("retained-run-%d", i), -24, 0)
+		gcs.put(name, []byte(exampleHistoryRecord), now)
+		retained = append(retained, name)
+	}
+	// files in sub directories and histories of other domains are not touched
+	nested := sinkPath + hash(testDomainID) + "/nested.history"
+	gcs.put(nested, []byte(exampleHistoryRecord), now.Add(-48*time.Hour))
+	otherDomain := sinkPath + constructHistoryFilenameMultipart("other-domain-id", testWorkflowID, testRunID, -24, 0)
+	gcs.put(otherDomain, []byte(exampleHistoryRecord), now.Add(-48*time.Hour))
+
+	historyArchiver := newHistoryArchiver(h.container, nil, storageClient)
+	request := &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: now.Add(-24 * time.Hour),
+		PageSize:       2,
+	}
+	deleted, pages := 0, 0
+	for {
+		response, err := historyArchiver.DeleteExpired(ctx, h.testArchivalURI, request)
+		h.NoError(err)
+		deleted += response.DeletedCount
+		pages++
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	h.Equal(5, deleted)
+	// 9 files of the domain are examined, 2 per page
+	h.Equal(5, pages)
+	h.ElementsMatch(append(retained, nested, otherDomain), gcs.names(""))
+}
@@ -150,6 +150,59 @@ func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error
 	return token, err
 }
 
+func deserializeDeleteExpiredToken(bytes []byte) (*deleteExpiredToken, error) {
+	token := &deleteExpiredToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// deleteExpiredFiles examines up to request.PageSize files in dir whose names start with prefix and deletes the
+// ones which have the given suffix and were last modified before request.ArchivedBefore
+func deleteExpiredFiles(
+	ctx context.Context,
+	storage connector.Client,
+	URI archiver.URI,
+	dir string,
+	prefix string,
+	suffix string,
+	request *archiver.DeleteExpiredRequest,
+) (*archiver.DeleteExpiredResponse, error) {
+	token := &deleteExpiredToken{}
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeDeleteExpiredToken(request.NextPageToken)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+	}
+
+	filenames, lastFilename, completed, err := storage.QueryModifiedBefore(ctx, URI, dir+prefix, request.ArchivedBefore, token.StartAfter, request.PageSize)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	response := &archiver.DeleteExpiredResponse{}
+	for _, filename := range filenames {
+		// skip files in sub directories, they are not written by the archivers of this domain
+		if strings.Contains(strings.TrimPrefix(filename, dir), "/") || !strings.HasSuffix(filename, suffix) {
+			continue
+		}
+		if err := storage.Delete(ctx, URI, filename); err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		response.DeletedCount++
+	}
+
+	if !completed {
+		nextToken, err := serializeToken(&deleteExpiredToken{StartAfter: lastFilename})
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		response.NextPageToken = nextToken
+	}
+	return response, nil
+}
+
 func convertToExecutionInfo(record *visibilityRecord) *types.WorkflowExecutionInfo {
 	return &types.WorkflowExecutionInfo{
 		Execution: &types.WorkflowExecution{
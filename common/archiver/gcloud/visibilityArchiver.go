@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/uber/cadence/common/archiver"
@@ -39,6 +40,7 @@ const (
 	indexKeyStartTimeout      = "startTimeout"
 	indexKeyCloseTimeout      = "closeTimeout"
 	timeoutInSeconds          = 5
+	visibilityFileSuffix      = ".visibility"
 )
 
 var (
@@ -239,6 +241,48 @@ func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, reques
 	return response, nil
 }
 
+// Delete is used to remove the archived visibility records of a workflow run.
+func (v *visibilityArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteVisibilityRequest) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	// file names end with hash(workflowID)_hash(runID).visibility and are written once per index key
+	suffix := fmt.Sprintf("_%s_%s%s", hash(request.WorkflowID), hash(request.RunID), visibilityFileSuffix)
+	for _, indexKey := range []string{indexKeyCloseTimeout, indexKeyStartTimeout} {
+		filenames, err := v.gcloudStorage.Query(ctx, URI, constructVisibilityFilenamePrefix(request.DomainID, indexKey))
+		if err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		for _, filename := range filenames {
+			if !strings.HasSuffix(filename, suffix) {
+				continue
+			}
+			if err := v.gcloudStorage.Delete(ctx, URI, fmt.Sprintf("%s/%s", request.DomainID, filepath.Base(filename))); err != nil {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteExpired is used to remove the archived visibility records of a domain which were archived before the given time.
+func (v *visibilityArchiver) DeleteExpired(ctx context.Context, URI archiver.URI, request *archiver.DeleteExpiredRequest) (*archiver.DeleteExpiredResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteExpiredRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	return deleteExpiredFiles(ctx, v.gcloudStorage, URI, request.DomainID+"/", "", visibilityFileSuffix, request)
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeoutInSeconds*time.Second)
@@ -23,6 +23,7 @@ package gcloud
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -320,3 +321,104 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.Len(response.Executions, 1)
 	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
 }
+
+func (s *visibilityArchiverSuite) TestDelete_Success() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	suffix := "_" + hash(testWorkflowID) + "_" + hash(testRunID) + ".visibility"
+	closeFile := "closeTimeout_2020-02-05T09:56:15Z_" + hash(testWorkflowTypeName) + suffix
+	startFile := "startTimeout_2020-02-05T09:56:14Z_" + hash(testWorkflowTypeName) + suffix
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, "").Return(true, nil).Times(1)
+	storageWrapper.On("Query", ctx, URI, testDomainID+"/closeTimeout").Return([]string{
+		"cadence_archival/visibility/" + testDomainID + "/" + closeFile,
+		"cadence_archival/visibility/" + testDomainID + "/closeTimeout_2020-02-05T09:56:15Z_1_2_3.visibility",
+	}, nil).Times(1)
+	storageWrapper.On("Query", ctx, URI, testDomainID+"/startTimeout").Return([]string{
+		"cadence_archival/visibility/" + testDomainID + "/" + startFile,
+	}, nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, testDomainID+"/"+closeFile).Return(nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, testDomainID+"/"+startFile).Return(nil).Times(1)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	err = visibilityArchiver.Delete(ctx, URI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.NoError(err)
+	storageWrapper.AssertExpectations(s.T())
+}
+
+func (s *visibilityArchiverSuite) TestDeleteExpired_Success() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	archivedBefore := time.Now().Add(-24 * time.Hour)
+	expiredFile := testDomainID + "/closeTimeout_2020-02-05T09:56:15Z_1_2_3.visibility"
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, "").Return(true, nil).Times(1)
+	storageWrapper.On("QueryModifiedBefore", ctx, URI, testDomainID+"/", archivedBefore, "", 10).
+		Return([]string{expiredFile}, expiredFile, true, nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, expiredFile).Return(nil).Times(1)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	response, err := visibilityArchiver.DeleteExpired(ctx, URI, &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: archivedBefore,
+		PageSize:       10,
+	})
+	s.NoError(err)
+	s.Equal(1, response.DeletedCount)
+	s.Nil(response.NextPageToken)
+	storageWrapper.AssertExpectations(s.T())
+}
+
+func (s *visibilityArchiverSuite) TestDeleteAndDeleteExpired_StorageStandIn() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	domainPath := "cadence_archival/visibility/" + testDomainID + "/"
+	storageClient, gcs := newTestGcloudStorage("my-bucket-cad")
+	visibilityArchiver := newVisibilityArchiver(s.container, storageClient)
+
+	record := *s.expectedVisibilityRecords[0]
+	otherRecord := record
+	otherRecord.RunID = "other-run-id"
+	for _, r := range []visibilityRecord{record, otherRecord} {
+		r := r
+		s.NoError(visibilityArchiver.Archive(ctx, URI, (*archiver.ArchiveVisibilityRequest)(&r)))
+	}
+	s.Len(gcs.names(domainPath), 4)
+
+	deleteRequest := &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(visibilityArchiver.Delete(ctx, URI, deleteRequest))
+	remaining := gcs.names(domainPath)
+	s.Len(remaining, 2)
+	for _, name := range remaining {
+		s.True(strings.HasSuffix(name, "_"+hash(otherRecord.RunID)+".visibility"))
+	}
+	// deleting a record which does not exist is a no-op
+	s.NoError(visibilityArchiver.Delete(ctx, URI, deleteRequest))
+
+	now := time.Now()
+	gcs.setUpdated(now.Add(-48*time.Hour), func(name string) bool {
+		return strings.HasPrefix(name, domainPath+indexKeyCloseTimeout)
+	})
+	response, err := visibilityArchiver.DeleteExpired(ctx, URI, &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: now.Add(-24 * time.Hour),
+		PageSize:       10,
+	})
+	s.NoError(err)
+	s.Equal(1, response.DeletedCount)
+	s.Nil(response.NextPageToken)
+	remaining = gcs.names(domainPath)
+	s.Len(remaining, 1)
+	s.True(strings.HasPrefix(remaining[0], domainPath+indexKeyStartTimeout))
+}
@@ -22,6 +22,7 @@ package archiver
 
 import (
 	"context"
+	"time"
 
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
@@ -60,6 +61,28 @@ type (
 		NextPageToken  []byte
 	}
 
+	// DeleteHistoryRequest is the request to delete the archived history of a workflow run
+	DeleteHistoryRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	// DeleteExpiredRequest is the request to delete archived records of a domain
+	// which were archived before the given time
+	DeleteExpiredRequest struct {
+		DomainID       string
+		ArchivedBefore time.Time
+		PageSize       int
+		NextPageToken  []byte
+	}
+
+	// DeleteExpiredResponse is the response of deleting expired archived records
+	DeleteExpiredResponse struct {
+		DeletedCount  int
+		NextPageToken []byte
+	}
+
 	// HistoryBootstrapContainer contains components needed by all history Archiver implementations
 	HistoryBootstrapContainer struct {
 		HistoryV2Manager persistence.HistoryManager
@@ -69,10 +92,12 @@ type (
 		DomainCache      cache.DomainCache
 	}
 
-	// HistoryArchiver is used to archive history and read archived history
+	// HistoryArchiver is used to archive history, read archived history and delete archived history
 	HistoryArchiver interface {
 		Archive(context.Context, URI, *ArchiveHistoryRequest, ...ArchiveOption) error
 		Get(context.Context, URI, *GetHistoryRequest) (*GetHistoryResponse, error)
+		Delete(context.Context, URI, *DeleteHistoryRequest) error
+		DeleteExpired(context.Context, URI, *DeleteExpiredRequest) (*DeleteExpiredResponse, error)
 		ValidateURI(URI) error
 	}
 
@@ -115,10 +140,19 @@ type (
 		NextPageToken []byte
 	}
 
-	// VisibilityArchiver is used to archive visibility and read archived visibility
+	// DeleteVisibilityRequest is the request to delete the archived visibility records of a workflow run
+	DeleteVisibilityRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	// VisibilityArchiver is used to archive visibility, read archived visibility and delete archived visibility
 	VisibilityArchiver interface {
 		Archive(context.Context, URI, *ArchiveVisibilityRequest, ...ArchiveOption) error
 		Query(context.Context, URI, *QueryVisibilityRequest) (*QueryVisibilityResponse, error)
+		Delete(context.Context, URI, *DeleteVisibilityRequest) error
+		DeleteExpired(context.Context, URI, *DeleteExpiredRequest) (*DeleteExpiredResponse, error)
 		ValidateURI(URI) error
 	}
 )
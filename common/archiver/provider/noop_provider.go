@@ -58,6 +58,14 @@ func (*noOpHistoryArchiver) Get(context.Context, archiver.URI, *archiver.GetHist
 	return &archiver.GetHistoryResponse{}, nil
 }
 
+func (*noOpHistoryArchiver) Delete(context.Context, archiver.URI, *archiver.DeleteHistoryRequest) error {
+	return nil
+}
+
+func (*noOpHistoryArchiver) DeleteExpired(context.Context, archiver.URI, *archiver.DeleteExpiredRequest) (*archiver.DeleteExpiredResponse, error) {
+	return &archiver.DeleteExpiredResponse{}, nil
+}
+
 func (*noOpHistoryArchiver) ValidateURI(archiver.URI) error {
 	return nil
 }
@@ -72,6 +80,14 @@ func (*noOpVisibilityArchiver) Query(context.Context, archiver.URI, *archiver.Qu
 	return &archiver.QueryVisibilityResponse{}, nil
 }
 
+func (*noOpVisibilityArchiver) Delete(context.Context, archiver.URI, *archiver.DeleteVisibilityRequest) error {
+	return nil
+}
+
+func (*noOpVisibilityArchiver) DeleteExpired(context.Context, archiver.URI, *archiver.DeleteExpiredRequest) (*archiver.DeleteExpiredResponse, error) {
+	return &archiver.DeleteExpiredResponse{}, nil
+}
+
 func (*noOpVisibilityArchiver) ValidateURI(archiver.URI) error {
 	return nil
 }
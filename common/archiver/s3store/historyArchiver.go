@@ -54,6 +54,7 @@ const (
 	errWriteKey             = "failed to write history to s3"
 	defaultBlobstoreTimeout = 60 * time.Second
 	targetHistoryBlobSize   = 2 * 1024 * 1024 // 2MB
+	maxDeleteObjectsKeys    = 1000            // limit of a single DeleteObjects request
 )
 
 var (
@@ -346,6 +347,41 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	// removes all versions and batches of the history
+	prefix := constructHistoryKeyPrefix(URI.Path(), request.DomainID, request.WorkflowID, request.RunID) + "/"
+	return deleteObjectsByPrefix(ctx, h.s3cli, URI, prefix)
+}
+
+func (h *historyArchiver) DeleteExpired(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteExpiredRequest,
+) (*archiver.DeleteExpiredResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteExpiredRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	prefix := constructHistoryDomainPrefix(URI.Path(), request.DomainID) + "/"
+	return deleteExpiredObjects(ctx, h.s3cli, URI, prefix, request)
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
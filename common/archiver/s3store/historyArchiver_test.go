@@ -71,6 +71,7 @@ type historyArchiverSuite struct {
 	*require.Assertions
 	suite.Suite
 	s3cli              *mocks.S3API
+	lastModified       map[string]time.Time
 	container          *archiver.HistoryBootstrapContainer
 	testArchivalURI    archiver.URI
 	historyBatchesV1   []*archiver.HistoryBlob
@@ -84,7 +85,7 @@ func TestHistoryArchiverSuite(t *testing.T) {
 func (s *historyArchiverSuite) SetupSuite() {
 	var err error
 	s.s3cli = &mocks.S3API{}
-	setupFsEmulation(s.s3cli)
+	s.lastModified = setupFsEmulation(s.s3cli)
 	s.setupHistoryDirectory()
 	s.testArchivalURI, err = archiver.NewURI(testBucketURI)
 
@@ -103,15 +104,26 @@ func (s *historyArchiverSuite) SetupTest() {
 	}
 }
 
-func setupFsEmulation(s3cli *mocks.S3API) {
+// setupFsEmulation backs the mocked S3API with an in-memory object store and returns the
+// last modified time of every stored object, keyed by bucket and object key
+func setupFsEmulation(s3cli *mocks.S3API) map[string]time.Time {
 	fs := make(map[string][]byte)
+	lastModified := make(map[string]time.Time)
 
 	putObjectFn := func(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) *s3.PutObjectOutput {
 		buf := new(bytes.Buffer)
 		buf.ReadFrom(input.Body)
 		fs[*input.Bucket+*input.Key] = buf.Bytes()
+		lastModified[*input.Bucket+*input.Key] = time.Now()
 		return &s3.PutObjectOutput{}
 	}
+	deleteObjectsFn := func(_ aws.Context, input *s3.DeleteObjectsInput, _ ...request.Option) *s3.DeleteObjectsOutput {
+		for _, object := range input.Delete.Objects {
+			delete(fs, *input.Bucket+*object.Key)
+			delete(lastModified, *input.Bucket+*object.Key)
+		}
+		return &s3.DeleteObjectsOutput{}
+	}
 	getObjectFn := func(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) *s3.GetObjectOutput {
 		return &s3.GetObjectOutput{
 			Body: ioutil.NopCloser(bytes.NewReader(fs[*input.Bucket+*input.Key])),
@@ -128,7 +140,8 @@ func setupFsEmulation(s3cli *mocks.S3API) {
 					index := strings.Index(keyWithoutPrefix, "/")
 					if index == -1 || input.Delimiter == nil {
 						objects = append(objects, &s3.Object{
-							Key: aws.String(key),
+							Key:          aws.String(key),
+							LastModified: aws.Time(lastModified[k]),
 						})
 					} else {
 						commonPrefixMap[key[:len(*input.Prefix)+index]] = true
@@ -155,11 +168,9 @@ func setupFsEmulation(s3cli *mocks.S3API) {
 			}
 
 			if input.StartAfter != nil {
-				for k, v := range objects {
-					if *input.StartAfter == *v.Key {
-						start = k + 1
-					}
-				}
+				start = sort.Search(len(objects), func(i int) bool {
+					return *objects[i].Key > *input.StartAfter
+				})
 			}
 
 			isTruncated := false
@@ -208,6 +219,9 @@ func setupFsEmulation(s3cli *mocks.S3API) {
 		return !ok
 	})).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "", nil))
 	s3cli.On("GetObjectWithContext", mock.Anything, mock.Anything).Return(getObjectFn, nil)
+	s3cli.On("DeleteObjectsWithContext", mock.Anything, mock.Anything).Return(deleteObjectsFn, nil)
+
+	return lastModified
 }
 
 func (s *historyArchiverSuite) TestValidateURI() {
@@ -657,6 +671,196 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestDelete_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = historyArchiver.Delete(context.Background(), URI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	err := historyArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDelete_Success() {
+	domainID := "delete-test-domain-id"
+	s.writeHistoryBlobs(domainID, testWorkflowID, testRunID, 1, s.historyBatchesV1)
+	s.writeHistoryBlobs(domainID, testWorkflowID, testRunID, testCloseFailoverVersion, s.historyBatchesV100)
+	retained := s.writeHistoryBlobs(domainID, testWorkflowID, "other-run-id", testCloseFailoverVersion, s.historyBatchesV100)
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	err := historyArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID:   domainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.NoError(err)
+	s.ElementsMatch(retained, listKeys(s.s3cli, constructHistoryDomainPrefix("", domainID)))
+
+	_, err = historyArchiver.Get(context.Background(), s.testArchivalURI, &archiver.GetHistoryRequest{
+		DomainID:   domainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	})
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestDeleteExpired_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.DeleteExpired(context.Background(), s.testArchivalURI, &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: time.Now(),
+	})
+	s.Nil(response)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDeleteExpired_Success() {
+	domainID := "delete-expired-test-domain-id"
+	now := time.Now()
+	var expired, retained []string
+	expired = append(expired, s.writeHistoryBlobs(domainID, testWorkflowID, "run-1", testCloseFailoverVersion, s.historyBatchesV100)...)
+	expired = append(expired, s.writeHistoryBlobs(domainID, "other-workflow-id", "run-2", 1, s.historyBatchesV1)...)
+	retained = append(retained, s.writeHistoryBlobs(domainID, testWorkflowID, "run-3", testCloseFailoverVersion, s.historyBatchesV100)...)
+	for _, key := range expired {
+		s.lastModified[testBucket+key] = now.Add(-48 * time.Hour)
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.DeleteExpiredRequest{
+		DomainID:       domainID,
+		ArchivedBefore: now.Add(-24 * time.Hour),
+		PageSize:       2,
+	}
+	deleted := 0
+	for {
+		response, err := historyArchiver.DeleteExpired(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		deleted += response.DeletedCount
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal(len(expired), deleted)
+	s.ElementsMatch(retained, listKeys(s.s3cli, constructHistoryDomainPrefix("", domainID)))
+
+	// histories of other domains are untouched
+	s.NotEmpty(listKeys(s.s3cli, constructHistoryDomainPrefix("", testDomainID)))
+}
+
+func (s *historyArchiverSuite) TestDelete_S3Server() {
+	server := newTestS3Server(s.T(), testBucket)
+	historyArchiver := &historyArchiver{
+		container: s.container,
+		s3cli:     server.client(s.T()),
+	}
+	now := time.Now()
+	s.putHistoryBlobs(server, testWorkflowID, testRunID, 1, s.historyBatchesV1, now)
+	s.putHistoryBlobs(server, testWorkflowID, testRunID, testCloseFailoverVersion, s.historyBatchesV100, now)
+	retained := s.putHistoryBlobs(server, testWorkflowID, "other-run-id", testCloseFailoverVersion, s.historyBatchesV100, now)
+	// more keys than fit in one listing or one DeleteObjects request
+	for i := 0; i <= maxDeleteObjectsKeys; i++ {
+		server.putObject(testBucket, constructHistoryKey("", testDomainID, testWorkflowID, testRunID, 2, i), []byte{}, now)
+	}
+
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	_, err := historyArchiver.Get(context.Background(), s.testArchivalURI, getRequest)
+	s.NoError(err)
+
+	deleteRequest := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(historyArchiver.Delete(context.Background(), s.testArchivalURI, deleteRequest))
+	s.ElementsMatch(retained, server.keys(testBucket, ""))
+	_, err = historyArchiver.Get(context.Background(), s.testArchivalURI, getRequest)
+	s.Error(err)
+
+	// deleting a history which does not exist is a no-op
+	s.NoError(historyArchiver.Delete(context.Background(), s.testArchivalURI, deleteRequest))
+
+	URI, err := archiver.NewURI("s3://missing-bucket")
+	s.NoError(err)
+	err = historyArchiver.Delete(context.Background(), URI, deleteRequest)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDelete_S3Server_Fail_ObjectNotDeleted() {
+	server := newTestS3Server(s.T(), testBucket)
+	historyArchiver := &historyArchiver{
+		container: s.container,
+		s3cli:     server.client(s.T()),
+	}
+	keys := s.putHistoryBlobs(server, testWorkflowID, testRunID, testCloseFailoverVersion, s.historyBatchesV100, time.Now())
+	server.failDeleteKeys[keys[0]] = true
+
+	err := historyArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.IsType(&types.InternalServiceError{}, err)
+	s.Equal(keys[:1], server.keys(testBucket, ""))
+}
+
+func (s *historyArchiverSuite) TestDeleteExpired_S3Server() {
+	server := newTestS3Server(s.T(), testBucket)
+	historyArchiver := &historyArchiver{
+		container: s.container,
+		s3cli:     server.client(s.T()),
+	}
+	now := time.Now()
+	var expired, retained []string
+	for i := 0; i < 5; i++ {
+		runID := fmt.Sprintf("expired-run-%d", i)
+		expired = append(expired, s.putHistoryBlobs(server, testWorkflowID, runID, testCloseFailoverVersion, s.historyBatchesV100, now.Add(-48*time.Hour))...)
+	}
+	for i := 0; i < 3; i++ {
+		runID := fmt.Sprintf("retained-run-%d", i)
+		retained = append(retained, s.putHistoryBlobs(server, testWorkflowID, runID, testCloseFailoverVersion, s.historyBatchesV100, now)...)
+	}
+	otherDomainKey := constructHistoryKey("", "other-domain-id", testWorkflowID, testRunID, 1, 0)
+	server.putObject(testBucket, otherDomainKey, []byte{}, now.Add(-48*time.Hour))
+
+	request := &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: now.Add(-24 * time.Hour),
+		PageSize:       3,
+	}
+	deleted, pages := 0, 0
+	for {
+		response, err := historyArchiver.DeleteExpired(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		deleted += response.DeletedCount
+		pages++
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal(len(expired), deleted)
+	s.Equal((len(expired)+len(retained))/request.PageSize+1, pages)
+	s.ElementsMatch(append(retained, otherDomainKey), server.keys(testBucket, ""))
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	// config := &config.S3Archiver{}
 	// archiver, err := newHistoryArchiver(s.container, config, historyIterator)
@@ -759,3 +963,46 @@ func getCanceledContext() context.Context {
 	cancel()
 	return ctx
 }
+
+func (s *historyArchiverSuite) writeHistoryBlobs(domainID, workflowID, runID string, version int64, historyBlobs []*archiver.HistoryBlob) []string {
+	var keys []string
+	for i, blob := range historyBlobs {
+		data, err := encode(blob)
+		s.Require().NoError(err)
+		key := constructHistoryKey("", domainID, workflowID, runID, version, i)
+		_, err = s.s3cli.PutObjectWithContext(context.Background(), &s3.PutObjectInput{
+			Bucket: aws.String(testBucket),
+			Key:    aws.String(key),
+			Body:   bytes.NewReader(data),
+		})
+		s.Require().NoError(err)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// putHistoryBlobs stores the history blobs of a workflow run of the test domain in the S3 stand-in
+func (s *historyArchiverSuite) putHistoryBlobs(server *testS3Server, workflowID, runID string, version int64, historyBlobs []*archiver.HistoryBlob, lastModified time.Time) []string {
+	var keys []string
+	for i, blob := range historyBlobs {
+		data, err := encode(blob)
+		s.Require().NoError(err)
+		key := constructHistoryKey("", testDomainID, workflowID, runID, version, i)
+		server.putObject(testBucket, key, data, lastModified)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// listKeys returns the keys stored in the emulated test bucket under the given prefix
+func listKeys(s3cli *mocks.S3API, prefix string) []string {
+	results, _ := s3cli.ListObjectsV2WithContext(context.Background(), &s3.ListObjectsV2Input{
+		Bucket: aws.String(testBucket),
+		Prefix: aws.String(prefix + "/"),
+	})
+	var keys []string
+	for _, item := range results.Contents {
+		keys = append(keys, *item.Key)
+	}
+	return keys
+}
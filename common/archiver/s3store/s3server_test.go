@@ -0,0 +1,308 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// testS3Server is a local stand-in for S3, serving the subset of the S3 REST API used by the archivers
+	// over HTTP, so that requests go through the real SDK client: request serialization, error codes and
+	// the pagination of ListObjectsV2 are those of S3 rather than of a mocked S3API
+	testS3Server struct {
+		*httptest.Server
+
+		sync.Mutex
+		buckets        map[string]map[string]*testS3Object
+		failDeleteKeys map[string]bool
+	}
+
+	testS3Object struct {
+		data         []byte
+		lastModified time.Time
+	}
+
+	testS3Error struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}
+
+	testS3ListBucketResult struct {
+		XMLName        xml.Name               `xml:"ListBucketResult"`
+		Name           string                 `xml:"Name"`
+		Prefix         string                 `xml:"Prefix"`
+		StartAfter     string                 `xml:"StartAfter,omitempty"`
+		KeyCount       int                    `xml:"KeyCount"`
+		MaxKeys        int                    `xml:"MaxKeys"`
+		Delimiter      string                 `xml:"Delimiter,omitempty"`
+		IsTruncated    bool                   `xml:"IsTruncated"`
+		Contents       []testS3ObjectResult   `xml:"Contents"`
+		CommonPrefixes []testS3CommonPrefixes `xml:"CommonPrefixes"`
+	}
+
+	testS3CommonPrefixes struct {
+		Prefix string `xml:"Prefix"`
+	}
+
+	testS3ObjectResult struct {
+		Key          string `xml:"Key"`
+		LastModified string `xml:"LastModified"`
+		Size         int    `xml:"Size"`
+	}
+
+	testS3Delete struct {
+		Quiet   bool `xml:"Quiet"`
+		Objects []struct {
+			Key string `xml:"Key"`
+		} `xml:"Object"`
+	}
+
+	testS3DeleteResult struct {
+		XMLName xml.Name              `xml:"DeleteResult"`
+		Deleted []testS3DeletedResult `xml:"Deleted"`
+		Errors  []testS3DeleteError   `xml:"Error"`
+	}
+
+	testS3DeletedResult struct {
+		Key string `xml:"Key"`
+	}
+
+	testS3DeleteError struct {
+		Key     string `xml:"Key"`
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+)
+
+const testS3MaxKeys = 1000
+
+// newTestS3Server starts a local S3 stand-in with the given empty buckets
+func newTestS3Server(t *testing.T, buckets ...string) *testS3Server {
+	server := &testS3Server{
+		buckets:        make(map[string]map[string]*testS3Object),
+		failDeleteKeys: make(map[string]bool),
+	}
+	for _, bucket := range buckets {
+		server.buckets[bucket] = make(map[string]*testS3Object)
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// client returns a real S3 client sending its requests to the server
+func (s *testS3Server) client(t *testing.T) s3iface.S3API {
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         aws.String(s.URL),
+		Region:           aws.String("us-east-1"),
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+		S3ForcePathStyle: aws.Bool(true),
+		DisableSSL:       aws.Bool(true),
+		MaxRetries:       aws.Int(0),
+	})
+	require.NoError(t, err)
+	return s3.New(sess)
+}
+
+// putObject stores an object as if it was uploaded at the given time
+func (s *testS3Server) putObject(bucket, key string, data []byte, lastModified time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	s.buckets[bucket][key] = &testS3Object{data: data, lastModified: lastModified}
+}
+
+// setLastModified changes the upload time of the objects under the given prefix which satisfy the predicate
+func (s *testS3Server) setLastModified(bucket, prefix string, lastModified time.Time, predicate func(key string) bool) {
+	s.Lock()
+	defer s.Unlock()
+	for key, object := range s.buckets[bucket] {
+		if strings.HasPrefix(key, prefix) && predicate(key) {
+			object.lastModified = lastModified
+		}
+	}
+}
+
+// keys returns the sorted keys stored under the given prefix
+func (s *testS3Server) keys(bucket, prefix string) []string {
+	s.Lock()
+	defer s.Unlock()
+	var keys []string
+	for key := range s.buckets[bucket] {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *testS3Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	bucketName, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		writeTestS3Error(w, http.StatusNotFound, s3.ErrCodeNoSuchBucket)
+		return
+	}
+
+	switch {
+	case key == "" && r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case key == "" && r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+		s.listObjects(w, r, bucketName, bucket)
+	case key == "" && r.Method == http.MethodPost && r.URL.Query().Has("delete"):
+		s.deleteObjects(w, r, bucket)
+	case key != "" && r.Method == http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeTestS3Error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		bucket[key] = &testS3Object{data: data, lastModified: time.Now()}
+		w.WriteHeader(http.StatusOK)
+	case key != "" && (r.Method == http.MethodGet || r.Method == http.MethodHead):
+		object, ok := bucket[key]
+		if !ok {
+			writeTestS3Error(w, http.StatusNotFound, s3.ErrCodeNoSuchKey)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.data)))
+		w.Header().Set("Last-Modified", object.lastModified.UTC().Format(http.TimeFormat))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(object.data)
+		}
+	default:
+		writeTestS3Error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (s *testS3Server) listObjects(w http.ResponseWriter, r *http.Request, bucketName string, bucket map[string]*testS3Object) {
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	startAfter := query.Get("start-after")
+	delimiter := query.Get("delimiter")
+	maxKeys := testS3MaxKeys
+	if value := query.Get("max-keys"); value != "" {
+		maxKeys, _ = strconv.Atoi(value)
+	}
+
+	var keys []string
+	for key := range bucket {
+		if strings.HasPrefix(key, prefix) && key > startAfter {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := testS3ListBucketResult{
+		Name:       bucketName,
+		Prefix:     prefix,
+		StartAfter: startAfter,
+		MaxKeys:    maxKeys,
+		Delimiter:  delimiter,
+	}
+	// keys sharing the part of their name up to the delimiter are rolled up into a single common prefix
+	for _, key := range keys {
+		commonPrefix := ""
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				commonPrefix = key[:len(prefix)+i+len(delimiter)]
+			}
+		}
+		prefixes := len(result.CommonPrefixes)
+		if commonPrefix != "" && prefixes > 0 && result.CommonPrefixes[prefixes-1].Prefix == commonPrefix {
+			continue
+		}
+		if len(result.Contents)+prefixes == maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		if commonPrefix != "" {
+			result.CommonPrefixes = append(result.CommonPrefixes, testS3CommonPrefixes{Prefix: commonPrefix})
+			continue
+		}
+		result.Contents = append(result.Contents, testS3ObjectResult{
+			Key:          key,
+			LastModified: bucket[key].lastModified.UTC().Format("2006-01-02T15:04:05.000Z"),
+			Size:         len(bucket[key].data),
+		})
+	}
+	result.KeyCount = len(result.Contents) + len(result.CommonPrefixes)
+	writeTestS3XML(w, result)
+}
+
+func (s *testS3Server) deleteObjects(w http.ResponseWriter, r *http.Request, bucket map[string]*testS3Object) {
+	var request testS3Delete
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeTestS3Error(w, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+	if len(request.Objects) > testS3MaxKeys {
+		writeTestS3Error(w, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+
+	var result testS3DeleteResult
+	for _, object := range request.Objects {
+		if s.failDeleteKeys[object.Key] {
+			result.Errors = append(result.Errors, testS3DeleteError{Key: object.Key, Code: "AccessDenied", Message: "Access Denied"})
+			continue
+		}
+		delete(bucket, object.Key)
+		if !request.Quiet {
+			result.Deleted = append(result.Deleted, testS3DeletedResult{Key: object.Key})
+		}
+	}
+	writeTestS3XML(w, result)
+}
+
+func writeTestS3XML(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	xml.NewEncoder(w).Encode(v)
+}
+
+func writeTestS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	xml.NewEncoder(w).Encode(testS3Error{Code: code, Message: code})
+}
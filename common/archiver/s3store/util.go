@@ -146,6 +146,14 @@ func constructHistoryKeyPrefix(path, domainID, workflowID, runID string) string
 	return strings.TrimLeft(strings.Join([]string{path, domainID, "history", workflowID, runID}, "/"), "/")
 }
 
+func constructHistoryDomainPrefix(path, domainID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "history"}, "/"), "/")
+}
+
+func constructVisibilityDomainPrefix(path, domainID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "visibility"}, "/"), "/")
+}
+
 func constructTimeBasedSearchKey(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, precision string) string {
 	t := time.Unix(0, timestamp).In(time.UTC)
 	var timeFormat = ""
@@ -235,6 +243,126 @@ func download(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key st
 	return body, nil
 }
 
+// listObjects lists up to maxKeys objects under the given prefix whose keys sort after startAfter
+func listObjects(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, prefix string, startAfter *string, maxKeys int) (*s3.ListObjectsV2Output, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	results, err := s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:     aws.String(URI.Hostname()),
+		Prefix:     aws.String(prefix),
+		StartAfter: startAfter,
+		MaxKeys:    aws.Int64(int64(maxKeys)),
+	})
+	if err != nil {
+		return nil, convertDeleteError(err)
+	}
+	return results, nil
+}
+
+// deleteObjects deletes the given keys, at most maxDeleteObjectsKeys keys per request
+func deleteObjects(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, keys []string) error {
+	for start := 0; start < len(keys); start += maxDeleteObjectsKeys {
+		end := start + maxDeleteObjectsKeys
+		if end > len(keys) {
+			end = len(keys)
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		deleteCtx, cancel := ensureContextTimeout(ctx)
+		output, err := s3cli.DeleteObjectsWithContext(deleteCtx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(URI.Hostname()),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		cancel()
+		if err != nil {
+			return convertDeleteError(err)
+		}
+		if output != nil && len(output.Errors) > 0 {
+			failure := output.Errors[0]
+			return &types.InternalServiceError{
+				Message: fmt.Sprintf("failed to delete %d object(s), first failure on key %s: %s", len(output.Errors), aws.StringValue(failure.Key), aws.StringValue(failure.Message)),
+			}
+		}
+	}
+	return nil
+}
+
+// deleteObjectsByPrefix deletes all objects under the given prefix
+func deleteObjectsByPrefix(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, prefix string) error {
+	var startAfter *string
+	for {
+		if contextExpired(ctx) {
+			return archiver.ErrContextTimeout
+		}
+		results, err := listObjects(ctx, s3cli, URI, prefix, startAfter, maxDeleteObjectsKeys)
+		if err != nil {
+			return err
+		}
+		keys := make([]string, 0, len(results.Contents))
+		for _, item := range results.Contents {
+			keys = append(keys, *item.Key)
+		}
+		if err := deleteObjects(ctx, s3cli, URI, keys); err != nil {
+			return err
+		}
+		if !aws.BoolValue(results.IsTruncated) || len(keys) == 0 {
+			return nil
+		}
+		startAfter = aws.String(keys[len(keys)-1])
+	}
+}
+
+// deleteExpiredObjects examines up to request.PageSize objects under the given prefix and deletes
+// the ones last modified before request.ArchivedBefore. The last examined key is used as next page token.
+func deleteExpiredObjects(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, prefix string, request *archiver.DeleteExpiredRequest) (*archiver.DeleteExpiredResponse, error) {
+	var startAfter *string
+	if request.NextPageToken != nil {
+		startAfter = aws.String(string(request.NextPageToken))
+	}
+	pageSize := request.PageSize
+	if pageSize > maxDeleteObjectsKeys {
+		pageSize = maxDeleteObjectsKeys
+	}
+	results, err := listObjects(ctx, s3cli, URI, prefix, startAfter, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiredKeys []string
+	for _, item := range results.Contents {
+		if item.LastModified != nil && item.LastModified.Before(request.ArchivedBefore) {
+			expiredKeys = append(expiredKeys, *item.Key)
+		}
+	}
+	if err := deleteObjects(ctx, s3cli, URI, expiredKeys); err != nil {
+		return nil, err
+	}
+
+	response := &archiver.DeleteExpiredResponse{
+		DeletedCount: len(expiredKeys),
+	}
+	if aws.BoolValue(results.IsTruncated) && len(results.Contents) > 0 {
+		response.NextPageToken = []byte(*results.Contents[len(results.Contents)-1].Key)
+	}
+	return response, nil
+}
+
+func convertDeleteError(err error) error {
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+		return &types.BadRequestError{Message: errBucketNotExists.Error()}
+	}
+	if isRetryableError(err) {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return err
+}
+
 func contextExpired(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -22,6 +22,7 @@ package s3store
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -243,6 +244,80 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	// the record is stored under both the workflowID and the workflowTypeName indexes,
+	// find it through the workflowID index first to learn the workflow type
+	prefix := strings.Join([]string{constructVisibilityDomainPrefix(URI.Path(), request.DomainID), primaryIndexKeyWorkflowID, request.WorkflowID, ""}, "/")
+	var keys []string
+	var startAfter *string
+	for {
+		results, err := listObjects(ctx, v.s3cli, URI, prefix, startAfter, maxDeleteObjectsKeys)
+		if err != nil {
+			return err
+		}
+		for _, item := range results.Contents {
+			if strings.HasSuffix(*item.Key, "/"+request.RunID) {
+				keys = append(keys, *item.Key)
+			}
+		}
+		if !aws.BoolValue(results.IsTruncated) || len(results.Contents) == 0 {
+			break
+		}
+		startAfter = results.Contents[len(results.Contents)-1].Key
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	encodedRecord, err := download(ctx, v.s3cli, URI, keys[0])
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); !ok {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	} else {
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		for _, element := range createIndexesToArchive((*archiver.ArchiveVisibilityRequest)(record)) {
+			if element.primaryIndex == primaryIndexKeyWorkflowTypeName {
+				keys = append(keys, constructTimestampIndex(URI.Path(), request.DomainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.RunID))
+			}
+		}
+	}
+	return deleteObjects(ctx, v.s3cli, URI, keys)
+}
+
+func (v *visibilityArchiver) DeleteExpired(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteExpiredRequest,
+) (*archiver.DeleteExpiredResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteExpiredRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	// every index key of a record is written at the same time, so they expire together
+	prefix := constructVisibilityDomainPrefix(URI.Path(), request.DomainID) + "/"
+	return deleteExpiredObjects(ctx, v.s3cli, URI, prefix, request)
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
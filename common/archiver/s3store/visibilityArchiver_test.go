@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -47,7 +48,8 @@ import (
 type visibilityArchiverSuite struct {
 	*require.Assertions
 	suite.Suite
-	s3cli *mocks.S3API
+	s3cli        *mocks.S3API
+	lastModified map[string]time.Time
 
 	container         *archiver.VisibilityBootstrapContainer
 	visibilityRecords []*visibilityRecord
@@ -117,7 +119,7 @@ func (s *visibilityArchiverSuite) SetupSuite() {
 	var err error
 	scope := tally.NewTestScope("test", nil)
 	s.s3cli = &mocks.S3API{}
-	setupFsEmulation(s.s3cli)
+	s.lastModified = setupFsEmulation(s.s3cli)
 
 	s.testArchivalURI, err = archiver.NewURI(testBucketURI)
 	s.Require().NoError(err)
@@ -551,6 +553,147 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[2])
 }
 
+func (s *visibilityArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	err := visibilityArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteVisibilityRequest{
+		DomainID: testDomainID,
+		RunID:    testRunID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestDelete_Success() {
+	domainID := "delete-test-domain-id"
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, record := range s.visibilityRecords[:2] {
+		record := *record
+		record.DomainID = domainID
+		s.writeVisibilityRecordForQueryTest(visibilityArchiver, &record)
+	}
+	s.Len(listKeys(s.s3cli, constructVisibilityDomainPrefix("", domainID)), 8)
+
+	err := visibilityArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteVisibilityRequest{
+		DomainID:   domainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.NoError(err)
+
+	remaining := listKeys(s.s3cli, constructVisibilityDomainPrefix("", domainID))
+	s.Len(remaining, 4)
+	for _, key := range remaining {
+		s.True(strings.HasSuffix(key, "/"+testRunID+"1"))
+	}
+
+	// deleting a record which does not exist is a no-op
+	err = visibilityArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteVisibilityRequest{
+		DomainID:   domainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.NoError(err)
+}
+
+func (s *visibilityArchiverSuite) TestDeleteExpired_Success() {
+	domainID := "delete-expired-test-domain-id"
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, record := range s.visibilityRecords[:2] {
+		record := *record
+		record.DomainID = domainID
+		s.writeVisibilityRecordForQueryTest(visibilityArchiver, &record)
+	}
+	now := time.Now()
+	for _, key := range listKeys(s.s3cli, constructVisibilityDomainPrefix("", domainID)) {
+		if strings.HasSuffix(key, "/"+testRunID) {
+			s.lastModified[testBucket+key] = now.Add(-48 * time.Hour)
+		}
+	}
+
+	request := &archiver.DeleteExpiredRequest{
+		DomainID:       domainID,
+		ArchivedBefore: now.Add(-24 * time.Hour),
+		PageSize:       3,
+	}
+	deleted := 0
+	for {
+		response, err := visibilityArchiver.DeleteExpired(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		deleted += response.DeletedCount
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal(4, deleted)
+
+	remaining := listKeys(s.s3cli, constructVisibilityDomainPrefix("", domainID))
+	s.Len(remaining, 4)
+	for _, key := range remaining {
+		s.True(strings.HasSuffix(key, "/"+testRunID+"1"))
+	}
+	s.NotEmpty(listKeys(s.s3cli, constructVisibilityDomainPrefix("", testDomainID)))
+}
+
+func (s *visibilityArchiverSuite) TestDeleteAndDeleteExpired_S3Server() {
+	server := newTestS3Server(s.T(), testBucket)
+	visibilityArchiver := &visibilityArchiver{
+		container:   s.container,
+		s3cli:       server.client(s.T()),
+		queryParser: NewQueryParser(),
+	}
+	for _, record := range s.visibilityRecords {
+		s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, (*archiver.ArchiveVisibilityRequest)(record)))
+	}
+	domainPrefix := constructVisibilityDomainPrefix("", testDomainID) + "/"
+	// the two records of the same run share their start time index keys
+	s.Len(server.keys(testBucket, domainPrefix), 10)
+
+	deleteRequest := &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(visibilityArchiver.Delete(context.Background(), s.testArchivalURI, deleteRequest))
+	remaining := server.keys(testBucket, domainPrefix)
+	s.Len(remaining, 6)
+	for _, key := range remaining {
+		s.True(strings.HasSuffix(key, "/"+testRunID+"1"))
+	}
+	// deleting a record which does not exist is a no-op
+	s.NoError(visibilityArchiver.Delete(context.Background(), s.testArchivalURI, deleteRequest))
+
+	now := time.Now()
+	server.setLastModified(testBucket, domainPrefix, now.Add(-48*time.Hour), func(key string) bool {
+		return strings.Contains(key, "/"+primaryIndexKeyWorkflowID+"/")
+	})
+	request := &archiver.DeleteExpiredRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: now.Add(-24 * time.Hour),
+		PageSize:       3,
+	}
+	deleted := 0
+	for {
+		response, err := visibilityArchiver.DeleteExpired(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		deleted += response.DeletedCount
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal(3, deleted)
+	remaining = server.keys(testBucket, domainPrefix)
+	s.Len(remaining, 3)
+	for _, key := range remaining {
+		s.Contains(key, "/"+primaryIndexKeyWorkflowTypeName+"/")
+	}
+
+	URI, err := archiver.NewURI("s3://missing-bucket")
+	s.NoError(err)
+	_, err = visibilityArchiver.DeleteExpired(context.Background(), URI, request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
 	s.visibilityRecords = []*visibilityRecord{
 		{
@@ -0,0 +1,302 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package consumer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	defaultSQLBatchSize       = 100
+	defaultSQLPollInterval    = time.Second
+	defaultSQLLeaseDuration   = 5 * time.Minute
+	defaultSQLMaxAttempts     = 5
+	defaultSQLRetryBackoff    = 10 * time.Second
+	defaultSQLMaxRetryBackoff = 10 * time.Minute
+	sqlOperationTimeout       = 10 * time.Second
+)
+
+type (
+	// SQLConsumerOptions contains the leasing and retry options of a SQLConsumer, zero values are replaced by the defaults
+	SQLConsumerOptions struct {
+		// BatchSize is the max number of requests leased by one poll
+		BatchSize int
+		// PollInterval is how often the queue is polled when there are no visible requests
+		PollInterval time.Duration
+		// LeaseDuration is how long a leased request is hidden from the other consumers,
+		// the request is leased again by any consumer if it's neither acked nor nacked before the lease expires
+		LeaseDuration time.Duration
+		// MaxAttempts is the number of times a request is processed before it's moved to the DLQ
+		MaxAttempts int
+		// RetryBackoff is the delay before a nacked request is retried, doubled on each attempt up to MaxRetryBackoff
+		RetryBackoff    time.Duration
+		MaxRetryBackoff time.Duration
+	}
+
+	// SQLConsumer is a messaging.Consumer of the requests of an async workflow queue stored in the
+	// async_workflow_requests table of the persistence database. Multiple consumers of the same queue
+	// lease the requests with optimistic concurrency on the attempt of the rows, so every request is
+	// delivered to one consumer at a time. Acked requests are deleted, nacked requests are retried with
+	// exponential backoff and moved to the DLQ of the queue after MaxAttempts.
+	SQLConsumer struct {
+		db         sqlplugin.DB
+		queueName  string
+		options    SQLConsumerOptions
+		timeSource clock.TimeSource
+		logger     log.Logger
+		scope      metrics.Scope
+		msgCh      chan messaging.Message
+		ctx        context.Context
+		cancelFn   context.CancelFunc
+		wg         sync.WaitGroup
+	}
+
+	sqlMessage struct {
+		consumer *SQLConsumer
+		row      sqlplugin.AsyncWorkflowRequestRow
+	}
+)
+
+var _ messaging.Consumer = (*SQLConsumer)(nil)
+
+// NewSQLConsumer creates a consumer of the async workflow queue stored in the given database.
+// The consumer owns the database and closes it when it's stopped.
+func NewSQLConsumer(
+	db sqlplugin.DB,
+	queueName string,
+	options SQLConsumerOptions,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *SQLConsumer {
+	if options.BatchSize <= 0 {
+		options.BatchSize = defaultSQLBatchSize
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaultSQLPollInterval
+	}
+	if options.LeaseDuration <= 0 {
+		options.LeaseDuration = defaultSQLLeaseDuration
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = defaultSQLMaxAttempts
+	}
+	if options.RetryBackoff <= 0 {
+		options.RetryBackoff = defaultSQLRetryBackoff
+	}
+	if options.MaxRetryBackoff <= 0 {
+		options.MaxRetryBackoff = defaultSQLMaxRetryBackoff
+	}
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	return &SQLConsumer{
+		db:         db,
+		queueName:  queueName,
+		options:    options,
+		timeSource: timeSource,
+		logger:     logger.WithTags(tag.Dynamic("queue-name", queueName)),
+		scope:      metricsClient.Scope(metrics.AsyncWorkflowConsumerScope),
+		msgCh:      make(chan messaging.Message),
+		ctx:        ctx,
+		cancelFn:   cancelFn,
+	}
+}
+
+// Start starts polling the queue
+func (c *SQLConsumer) Start() error {
+	c.wg.Add(1)
+	go c.pollLoop()
+	c.logger.Info("Started sql consumer")
+	return nil
+}
+
+// Stop stops polling the queue and closes the database.
+// The requests leased but not delivered yet are leased again by any consumer after their lease expires.
+func (c *SQLConsumer) Stop() {
+	c.cancelFn()
+	c.wg.Wait()
+	if err := c.db.Close(); err != nil {
+		c.logger.Warn("Failed to close sql consumer database", tag.Error(err))
+	}
+	c.logger.Info("Stopped sql consumer")
+}
+
+// Messages returns the channel of the leased requests
+func (c *SQLConsumer) Messages() <-chan messaging.Message {
+	return c.msgCh
+}
+
+func (c *SQLConsumer) pollLoop() {
+	defer c.wg.Done()
+
+	for {
+		leased := c.leaseRequests()
+		if leased == c.options.BatchSize {
+			// there may be more visible requests, poll again without waiting
+			select {
+			case <-c.ctx.Done():
+				return
+			default:
+				continue
+			}
+		}
+
+		timer := c.timeSource.NewTimer(c.options.PollInterval)
+		select {
+		case <-c.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.Chan():
+		}
+	}
+}
+
+// leaseRequests leases the visible requests of the queue and delivers them, it returns the number of leased requests
+func (c *SQLConsumer) leaseRequests() int {
+	ctx, cancel := context.WithTimeout(c.ctx, sqlOperationTimeout)
+	rows, err := c.db.SelectFromAsyncWorkflowRequests(ctx, &sqlplugin.AsyncWorkflowRequestsFilter{
+		QueueName:    c.queueName,
+		InDLQ:        false,
+		MaxVisibleAt: c.timeSource.Now().UnixNano(),
+		PageSize:     c.options.BatchSize,
+	})
+	cancel()
+	if err != nil {
+		if c.ctx.Err() == nil {
+			c.logger.Error("Failed to read requests", tag.Error(err))
+		}
+		return 0
+	}
+
+	leased := 0
+	for _, row := range rows {
+		leasedRow := row
+		leasedRow.Attempt++
+		leasedRow.VisibleAt = c.timeSource.Now().Add(c.options.LeaseDuration).UnixNano()
+		ok, err := c.updateRequest(&leasedRow, row.Attempt)
+		if err != nil {
+			if c.ctx.Err() == nil {
+				c.logger.Error("Failed to lease request", tag.Dynamic("request-id", row.RequestID), tag.Error(err))
+			}
+			continue
+		}
+		if !ok {
+			// leased by another consumer
+			continue
+		}
+		leased++
+
+		select {
+		case c.msgCh <- &sqlMessage{consumer: c, row: leasedRow}:
+		case <-c.ctx.Done():
+			return leased
+		}
+	}
+	return leased
+}
+
+// updateRequest updates the row if its attempt is still previousAttempt, it returns false if the row was changed by another consumer
+func (c *SQLConsumer) updateRequest(row *sqlplugin.AsyncWorkflowRequestRow, previousAttempt int) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), sqlOperationTimeout)
+	defer cancel()
+
+	result, err := c.db.UpdateAsyncWorkflowRequest(ctx, row, previousAttempt)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected == 1, nil
+}
+
+// retryBackoff returns the delay before the given attempt of a request is retried
+func (c *SQLConsumer) retryBackoff(attempt int) time.Duration {
+	backoff := c.options.RetryBackoff
+	for i := 1; i < attempt && backoff < c.options.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.options.MaxRetryBackoff {
+		backoff = c.options.MaxRetryBackoff
+	}
+	return backoff
+}
+
+func (m *sqlMessage) Value() []byte {
+	return m.row.Payload
+}
+
+func (m *sqlMessage) Partition() int32 {
+	return 0
+}
+
+// Offset returns the creation time of the request, which orders the requests of the queue
+func (m *sqlMessage) Offset() int64 {
+	return m.row.CreatedAt
+}
+
+// Ack deletes the request from the queue
+func (m *sqlMessage) Ack() error {
+	ctx, cancel := context.WithTimeout(context.Background(), sqlOperationTimeout)
+	defer cancel()
+
+	_, err := m.consumer.db.DeleteFromAsyncWorkflowRequests(ctx, m.row.QueueName, m.row.RequestID)
+	return err
+}
+
+// Nack makes the request visible again after the retry backoff, or moves it to the DLQ if it ran out of attempts.
+// Nothing is changed if the lease has expired and the request is leased by another consumer.
+func (m *sqlMessage) Nack() error {
+	c := m.consumer
+	row := m.row
+	if row.Attempt >= c.options.MaxAttempts {
+		row.InDLQ = true
+		row.VisibleAt = c.timeSource.Now().UnixNano()
+	} else {
+		row.VisibleAt = c.timeSource.Now().Add(c.retryBackoff(row.Attempt)).UnixNano()
+	}
+
+	ok, err := c.updateRequest(&row, m.row.Attempt)
+	if err != nil || !ok {
+		return err
+	}
+
+	scope := c.scope.Tagged(metrics.DomainTag(row.DomainName))
+	if row.InDLQ {
+		c.logger.Warn("Moved request to DLQ", tag.WorkflowDomainName(row.DomainName), tag.Dynamic("request-id", row.RequestID), tag.Attempt(int32(row.Attempt)))
+		scope.IncCounter(metrics.AsyncWorkflowDLQCount)
+	} else {
+		scope.IncCounter(metrics.AsyncWorkflowRetryCount)
+	}
+	return nil
+}
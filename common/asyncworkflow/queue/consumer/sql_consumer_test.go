@@ -0,0 +1,235 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package consumer
+
+import (
+	"context"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	persistencesql "github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
+	sqliteschema "github.com/uber/cadence/schema/sqlite"
+)
+
+const testQueueName = "test-queue"
+
+func TestSQLConsumer(t *testing.T) {
+	db, cfg := newTestSQLDB(t)
+	timeSource := clock.NewMockedTimeSource()
+	now := timeSource.Now()
+	insertTestRequest(t, db, "request-1", "domain-1", now)
+	insertTestRequest(t, db, "request-2", "domain-2", now.Add(time.Minute))
+	_, err := db.InsertIntoAsyncWorkflowRequests(context.Background(), &sqlplugin.AsyncWorkflowRequestRow{
+		QueueName:  "other-queue",
+		RequestID:  "other-queue-request",
+		DomainName: "domain-1",
+		VisibleAt:  now.UnixNano(),
+		CreatedAt:  now.UnixNano(),
+		Payload:    []byte("other-queue-request"),
+	})
+	require.NoError(t, err)
+
+	c := NewSQLConsumer(openTestSQLDB(t, cfg), testQueueName, SQLConsumerOptions{
+		LeaseDuration: time.Hour,
+		MaxAttempts:   2,
+		RetryBackoff:  time.Minute,
+	}, timeSource, testlogger.New(t), metrics.NewNoopMetricsClient())
+	require.NoError(t, c.Start())
+	defer c.Stop()
+
+	// the visible request is leased and deleted once it's acked
+	msg := receiveMessage(t, c)
+	assert.Equal(t, []byte("request-1"), msg.Value())
+	assert.Equal(t, now.UnixNano(), msg.Offset())
+	require.NoError(t, msg.Ack())
+	assert.Equal(t, []string{"request-2"}, getRequestIDs(t, db, false))
+
+	// the other request is leased once it's visible and retried after the backoff when it's nacked
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Minute)
+	msg = receiveMessage(t, c)
+	assert.Equal(t, []byte("request-2"), msg.Value())
+	row := getRequest(t, db, "request-2")
+	assert.Equal(t, 1, row.Attempt)
+	assert.Equal(t, timeSource.Now().Add(time.Hour).UnixNano(), row.VisibleAt)
+
+	require.NoError(t, msg.Nack())
+	row = getRequest(t, db, "request-2")
+	assert.Equal(t, 1, row.Attempt)
+	assert.False(t, row.InDLQ)
+	assert.Equal(t, timeSource.Now().Add(time.Minute).UnixNano(), row.VisibleAt)
+
+	// the request is moved to the DLQ when it runs out of attempts
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Minute)
+	msg = receiveMessage(t, c)
+	require.NoError(t, msg.Nack())
+	row = getRequest(t, db, "request-2")
+	assert.Equal(t, 2, row.Attempt)
+	assert.True(t, row.InDLQ)
+	assert.Empty(t, getRequestIDs(t, db, false))
+	assert.Equal(t, []string{"request-2"}, getRequestIDs(t, db, true))
+
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Hour)
+	select {
+	case msg := <-c.Messages():
+		t.Fatalf("unexpected message %s", msg.Value())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSQLConsumerExpiredLease(t *testing.T) {
+	db, cfg := newTestSQLDB(t)
+	timeSource := clock.NewMockedTimeSource()
+	insertTestRequest(t, db, "request-1", "domain-1", timeSource.Now())
+
+	options := SQLConsumerOptions{LeaseDuration: time.Minute}
+	c1 := NewSQLConsumer(openTestSQLDB(t, cfg), testQueueName, options, timeSource, testlogger.New(t), metrics.NewNoopMetricsClient())
+	require.NoError(t, c1.Start())
+	msg1 := receiveMessage(t, c1)
+
+	// the request is leased by another consumer once the lease expires
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Minute)
+	c2 := NewSQLConsumer(openTestSQLDB(t, cfg), testQueueName, options, timeSource, testlogger.New(t), metrics.NewNoopMetricsClient())
+	require.NoError(t, c2.Start())
+	defer c2.Stop()
+	msg2 := receiveMessage(t, c2)
+	assert.Equal(t, 2, getRequest(t, db, "request-1").Attempt)
+
+	// the nack of the expired lease doesn't change the request
+	before := getRequest(t, db, "request-1")
+	require.NoError(t, msg1.Nack())
+	assert.Equal(t, before, getRequest(t, db, "request-1"))
+	c1.Stop()
+
+	require.NoError(t, msg2.Ack())
+	assert.Empty(t, getRequestIDs(t, db, false))
+}
+
+func TestSQLConsumerRetryBackoff(t *testing.T) {
+	c := NewSQLConsumer(nil, testQueueName, SQLConsumerOptions{
+		RetryBackoff:    time.Second,
+		MaxRetryBackoff: 5 * time.Second,
+	}, clock.NewMockedTimeSource(), testlogger.New(t), metrics.NewNoopMetricsClient())
+
+	assert.Equal(t, time.Second, c.retryBackoff(1))
+	assert.Equal(t, 2*time.Second, c.retryBackoff(2))
+	assert.Equal(t, 4*time.Second, c.retryBackoff(3))
+	assert.Equal(t, 5*time.Second, c.retryBackoff(4))
+	assert.Equal(t, 5*time.Second, c.retryBackoff(100))
+}
+
+func newTestSQLDB(t *testing.T) (sqlplugin.DB, *config.SQL) {
+	cfg := &config.SQL{
+		PluginName:   sqlite.PluginName,
+		DatabaseName: filepath.Join(t.TempDir(), "cadence.db"),
+	}
+	adminDB, err := persistencesql.NewSQLAdminDB(cfg)
+	require.NoError(t, err)
+	defer adminDB.Close()
+	schema, err := sqliteschema.SchemaFS.ReadFile("cadence/schema.sql")
+	require.NoError(t, err)
+	require.NoError(t, adminDB.ExecSchemaOperationQuery(context.Background(), string(schema)))
+
+	db := openTestSQLDB(t, cfg)
+	t.Cleanup(func() { db.Close() })
+	return db, cfg
+}
+
+// openTestSQLDB opens a database handle which is owned and closed by its consumer
+func openTestSQLDB(t *testing.T, cfg *config.SQL) sqlplugin.DB {
+	db, err := persistencesql.NewSQLDB(cfg)
+	require.NoError(t, err)
+	return db
+}
+
+func insertTestRequest(t *testing.T, db sqlplugin.DB, requestID, domainName string, visibleAt time.Time) {
+	row := &sqlplugin.AsyncWorkflowRequestRow{
+		QueueName:  testQueueName,
+		RequestID:  requestID,
+		DomainName: domainName,
+		VisibleAt:  visibleAt.UnixNano(),
+		CreatedAt:  visibleAt.UnixNano(),
+		Payload:    []byte(requestID),
+	}
+	_, err := db.InsertIntoAsyncWorkflowRequests(context.Background(), row)
+	require.NoError(t, err)
+}
+
+func getRequestIDs(t *testing.T, db sqlplugin.DB, inDLQ bool) []string {
+	rows, err := db.SelectFromAsyncWorkflowRequests(context.Background(), &sqlplugin.AsyncWorkflowRequestsFilter{
+		QueueName:    testQueueName,
+		InDLQ:        inDLQ,
+		MaxVisibleAt: math.MaxInt64,
+		PageSize:     100,
+	})
+	require.NoError(t, err)
+	var requestIDs []string
+	for _, row := range rows {
+		requestIDs = append(requestIDs, row.RequestID)
+	}
+	return requestIDs
+}
+
+func getRequest(t *testing.T, db sqlplugin.DB, requestID string) sqlplugin.AsyncWorkflowRequestRow {
+	for _, inDLQ := range []bool{false, true} {
+		rows, err := db.SelectFromAsyncWorkflowRequests(context.Background(), &sqlplugin.AsyncWorkflowRequestsFilter{
+			QueueName:    testQueueName,
+			InDLQ:        inDLQ,
+			MaxVisibleAt: math.MaxInt64,
+			PageSize:     100,
+		})
+		require.NoError(t, err)
+		for _, row := range rows {
+			if row.RequestID == requestID {
+				return row
+			}
+		}
+	}
+	t.Fatalf("request %s not found", requestID)
+	return sqlplugin.AsyncWorkflowRequestRow{}
+}
+
+func receiveMessage(t *testing.T, c *SQLConsumer) messaging.Message {
+	select {
+	case msg := <-c.Messages():
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message is received")
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sqlqueue
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/asyncworkflow/queue/consumer"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	queueConfig struct {
+		// Connection is the SQL database of the queue, usually the default store of the persistence config
+		Connection config.SQL `yaml:"connection"`
+		// QueueName identifies the requests of the queue in the async_workflow_requests table,
+		// so that multiple queues can be stored in the same database
+		QueueName string `yaml:"queueName"`
+		// Consumer contains the leasing and retry options of the consumers of the queue
+		Consumer consumerConfig `yaml:"consumer"`
+	}
+
+	consumerConfig struct {
+		BatchSize       int           `yaml:"batchSize"`
+		PollInterval    time.Duration `yaml:"pollInterval"`
+		LeaseDuration   time.Duration `yaml:"leaseDuration"`
+		MaxAttempts     int           `yaml:"maxAttempts"`
+		RetryBackoff    time.Duration `yaml:"retryBackoff"`
+		MaxRetryBackoff time.Duration `yaml:"maxRetryBackoff"`
+	}
+)
+
+func (c *queueConfig) validate() error {
+	if c.QueueName == "" {
+		return fmt.Errorf("queueName is required")
+	}
+	if c.Connection.PluginName == "" {
+		return fmt.Errorf("connection.pluginName is required")
+	}
+	return nil
+}
+
+func (c *queueConfig) ID() string {
+	return fmt.Sprintf("sql::%s/%s/%s/%s", c.QueueName, c.Connection.PluginName, c.Connection.ConnectAddr, c.Connection.DatabaseName)
+}
+
+func (c *consumerConfig) options() consumer.SQLConsumerOptions {
+	return consumer.SQLConsumerOptions{
+		BatchSize:       c.BatchSize,
+		PollInterval:    c.PollInterval,
+		LeaseDuration:   c.LeaseDuration,
+		MaxAttempts:     c.MaxAttempts,
+		RetryBackoff:    c.RetryBackoff,
+		MaxRetryBackoff: c.MaxRetryBackoff,
+	}
+}
@@ -0,0 +1,112 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sqlqueue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/gen/go/shared"
+	"github.com/uber/cadence/gen/go/sqlblobs"
+)
+
+type (
+	producerImpl struct {
+		db         sqlplugin.DB
+		queueName  string
+		timeSource clock.TimeSource
+		msgEncoder codec.BinaryEncoder
+	}
+)
+
+var _ messaging.Producer = (*producerImpl)(nil)
+
+func newProducer(db sqlplugin.DB, queueName string, timeSource clock.TimeSource) messaging.Producer {
+	return &producerImpl{
+		db:         db,
+		queueName:  queueName,
+		timeSource: timeSource,
+		msgEncoder: codec.NewThriftRWEncoder(),
+	}
+}
+
+// Publish inserts an async request message into the queue
+func (p *producerImpl) Publish(ctx context.Context, message interface{}) error {
+	request, ok := message.(*sqlblobs.AsyncRequestMessage)
+	if !ok {
+		return fmt.Errorf("unsupported message type %T", message)
+	}
+	domainName, err := p.getDomainName(request)
+	if err != nil {
+		return err
+	}
+	payload, err := p.msgEncoder.Encode(request)
+	if err != nil {
+		return fmt.Errorf("failed to serialize async request message: %w", err)
+	}
+
+	now := p.timeSource.Now().UnixNano()
+	_, err = p.db.InsertIntoAsyncWorkflowRequests(ctx, &sqlplugin.AsyncWorkflowRequestRow{
+		QueueName:  p.queueName,
+		RequestID:  uuid.New(),
+		DomainName: domainName,
+		VisibleAt:  now,
+		CreatedAt:  now,
+		Payload:    payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert async request: %w", err)
+	}
+	return nil
+}
+
+// getDomainName returns the domain of the request, which is stored with the request so that the requests of each domain
+// in the queue and in its DLQ can be told apart
+func (p *producerImpl) getDomainName(request *sqlblobs.AsyncRequestMessage) (string, error) {
+	if request.GetEncoding() != string(constants.EncodingTypeThriftRW) {
+		return "", fmt.Errorf("unsupported encoding %v", request.GetEncoding())
+	}
+	switch request.GetType() {
+	case sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest:
+		var startRequest shared.StartWorkflowExecutionAsyncRequest
+		if err := p.msgEncoder.Decode(request.GetPayload(), &startRequest); err != nil {
+			return "", fmt.Errorf("failed to decode start workflow request: %w", err)
+		}
+		return startRequest.GetRequest().GetDomain(), nil
+	case sqlblobs.AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest:
+		var signalWithStartRequest shared.SignalWithStartWorkflowExecutionAsyncRequest
+		if err := p.msgEncoder.Decode(request.GetPayload(), &signalWithStartRequest); err != nil {
+			return "", fmt.Errorf("failed to decode signal with start workflow request: %w", err)
+		}
+		return signalWithStartRequest.GetRequest().GetDomain(), nil
+	default:
+		return "", fmt.Errorf("unsupported request type %v", request.GetType())
+	}
+}
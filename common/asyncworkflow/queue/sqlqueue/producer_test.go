@@ -0,0 +1,133 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sqlqueue
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/gen/go/sqlblobs"
+)
+
+func TestPublish(t *testing.T) {
+	encoder := codec.NewThriftRWEncoder()
+	startPayload, err := encoder.Encode(thrift.FromStartWorkflowExecutionAsyncRequest(&types.StartWorkflowExecutionAsyncRequest{
+		StartWorkflowExecutionRequest: &types.StartWorkflowExecutionRequest{Domain: "start-domain"},
+	}))
+	require.NoError(t, err)
+	signalWithStartPayload, err := encoder.Encode(thrift.FromSignalWithStartWorkflowExecutionAsyncRequest(&types.SignalWithStartWorkflowExecutionAsyncRequest{
+		SignalWithStartWorkflowExecutionRequest: &types.SignalWithStartWorkflowExecutionRequest{Domain: "signal-domain"},
+	}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		message    interface{}
+		wantDomain string
+		wantErr    string
+	}{
+		{
+			name: "start workflow request",
+			message: &sqlblobs.AsyncRequestMessage{
+				Type:     sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest.Ptr(),
+				Encoding: common.StringPtr(string(constants.EncodingTypeThriftRW)),
+				Payload:  startPayload,
+			},
+			wantDomain: "start-domain",
+		},
+		{
+			name: "signal with start workflow request",
+			message: &sqlblobs.AsyncRequestMessage{
+				Type:     sqlblobs.AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest.Ptr(),
+				Encoding: common.StringPtr(string(constants.EncodingTypeThriftRW)),
+				Payload:  signalWithStartPayload,
+			},
+			wantDomain: "signal-domain",
+		},
+		{
+			name:    "unsupported message type",
+			message: "message",
+			wantErr: "unsupported message type string",
+		},
+		{
+			name: "unsupported encoding",
+			message: &sqlblobs.AsyncRequestMessage{
+				Type:     sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest.Ptr(),
+				Encoding: common.StringPtr(string(constants.EncodingTypeJSON)),
+				Payload:  startPayload,
+			},
+			wantErr: "unsupported encoding json",
+		},
+		{
+			name: "invalid payload",
+			message: &sqlblobs.AsyncRequestMessage{
+				Type:     sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest.Ptr(),
+				Encoding: common.StringPtr(string(constants.EncodingTypeThriftRW)),
+				Payload:  []byte("invalid payload"),
+			},
+			wantErr: "failed to decode start workflow request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := sqlplugin.NewMockDB(ctrl)
+			timeSource := clock.NewMockedTimeSource()
+			if tt.wantErr == "" {
+				mockDB.EXPECT().InsertIntoAsyncWorkflowRequests(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, row *sqlplugin.AsyncWorkflowRequestRow) (sql.Result, error) {
+						assert.Equal(t, "queue1", row.QueueName)
+						assert.NotEmpty(t, row.RequestID)
+						assert.Equal(t, tt.wantDomain, row.DomainName)
+						assert.Equal(t, timeSource.Now().UnixNano(), row.VisibleAt)
+						assert.Equal(t, timeSource.Now().UnixNano(), row.CreatedAt)
+
+						var message sqlblobs.AsyncRequestMessage
+						require.NoError(t, encoder.Decode(row.Payload, &message))
+						assert.Equal(t, tt.message, &message)
+						return nil, nil
+					})
+			}
+
+			err := newProducer(mockDB, "queue1", timeSource).Publish(context.Background(), tt.message)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sqlqueue
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/config"
+)
+
+type mockDecoder struct {
+	decodeFunc func(v any) error
+}
+
+func (m *mockDecoder) Decode(v any) error {
+	return m.decodeFunc(v)
+}
+
+func TestNewQueue(t *testing.T) {
+	tests := []struct {
+		name      string
+		decoder   *mockDecoder
+		want      *queueImpl
+		errString string
+	}{
+		{
+			name: "successful decoding",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error {
+					out := v.(*queueConfig)
+					out.QueueName = "queue1"
+					out.Connection.PluginName = "mysql"
+					return nil
+				},
+			},
+			want: &queueImpl{
+				config: &queueConfig{
+					QueueName:  "queue1",
+					Connection: config.SQL{PluginName: "mysql"},
+				},
+			},
+		},
+		{
+			name: "decoding failure",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error {
+					return errors.New("decoding error")
+				},
+			},
+			errString: "bad config: decoding error",
+		},
+		{
+			name: "missing queue name",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error {
+					v.(*queueConfig).Connection.PluginName = "mysql"
+					return nil
+				},
+			},
+			errString: "bad config: queueName is required",
+		},
+		{
+			name: "missing plugin name",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error {
+					v.(*queueConfig).QueueName = "queue1"
+					return nil
+				},
+			},
+			errString: "bad config: connection.pluginName is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newQueue(tt.decoder)
+			if tt.errString != "" {
+				assert.EqualError(t, err, tt.errString)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestQueueID(t *testing.T) {
+	q := &queueImpl{
+		config: &queueConfig{
+			QueueName: "queue1",
+			Connection: config.SQL{
+				PluginName:   "mysql",
+				ConnectAddr:  "127.0.0.1:3306",
+				DatabaseName: "cadence",
+			},
+		},
+	}
+	assert.Equal(t, "sql::queue1/mysql/127.0.0.1:3306/cadence", q.ID())
+}
@@ -24,7 +24,9 @@ package queueconfigapi
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/types"
@@ -62,6 +64,9 @@ func (h *handlerImpl) UpdateConfiguration(ctx context.Context, req *types.Update
 	if req == nil {
 		return nil, &types.BadRequestError{Message: "Request is nil."}
 	}
+	if err := validateConfiguration(req.Configuration); err != nil {
+		return nil, err
+	}
 
 	err := h.domainHandler.UpdateAsyncWorkflowConfiguraton(ctx, *req)
 	if err != nil {
@@ -70,3 +75,28 @@ func (h *handlerImpl) UpdateConfiguration(ctx context.Context, req *types.Update
 
 	return &types.UpdateDomainAsyncWorkflowConfiguratonResponse{}, nil
 }
+
+// validateConfiguration checks that the queue configured inline in the domain config can be created,
+// so that a domain isn't configured with a queue which neither the frontend nor the worker can use.
+// Predefined queues are validated when the static config is loaded.
+func validateConfiguration(cfg *types.AsyncWorkflowConfiguration) error {
+	if cfg == nil || cfg.PredefinedQueueName != "" || cfg.QueueType == "" {
+		return nil
+	}
+
+	newDecoder, ok := provider.GetDecoder(cfg.QueueType)
+	if !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("Queue type %v is not registered.", cfg.QueueType)}
+	}
+	newQueue, ok := provider.GetQueueProvider(cfg.QueueType)
+	if !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("Queue type %v is not registered.", cfg.QueueType)}
+	}
+	if cfg.QueueConfig == nil {
+		return &types.BadRequestError{Message: "Queue config is required when queue type is set."}
+	}
+	if _, err := newQueue(newDecoder(cfg.QueueConfig)); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid %v queue config: %v", cfg.QueueType, err)}
+	}
+	return nil
+}
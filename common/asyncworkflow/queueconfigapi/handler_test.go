@@ -30,11 +30,38 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
+	"github.com/uber/cadence/common/asyncworkflow/queue/sqlqueue"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/types"
 )
 
+const testQueueType = "queueconfigapi-test"
+
+type testDecoder struct {
+	blob *types.DataBlob
+}
+
+func (d *testDecoder) Decode(any) error {
+	if string(d.blob.Data) == "invalid" {
+		return errors.New("invalid config")
+	}
+	return nil
+}
+
+func init() {
+	provider.RegisterDecoder(testQueueType, func(blob *types.DataBlob) provider.Decoder {
+		return &testDecoder{blob: blob}
+	})
+	provider.RegisterQueueProvider(testQueueType, func(decoder provider.Decoder) (provider.Queue, error) {
+		if err := decoder.Decode(nil); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+}
+
 func TestGetConfiguraton(t *testing.T) {
 	tests := map[string]struct {
 		req                 *types.GetDomainAsyncWorkflowConfiguratonRequest
@@ -151,6 +178,80 @@ func TestUpdateConfiguration(t *testing.T) {
 			req:     nil,
 			wantErr: true,
 		},
+		"unregistered queue type": {
+			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
+				Domain: "test-domain",
+				Configuration: &types.AsyncWorkflowConfiguration{
+					Enabled:     true,
+					QueueType:   "unknown",
+					QueueConfig: &types.DataBlob{Data: []byte("config")},
+				},
+			},
+			wantErr: true,
+		},
+		"missing queue config": {
+			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
+				Domain: "test-domain",
+				Configuration: &types.AsyncWorkflowConfiguration{
+					Enabled:   true,
+					QueueType: testQueueType,
+				},
+			},
+			wantErr: true,
+		},
+		"invalid queue config": {
+			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
+				Domain: "test-domain",
+				Configuration: &types.AsyncWorkflowConfiguration{
+					Enabled:     true,
+					QueueType:   testQueueType,
+					QueueConfig: &types.DataBlob{Data: []byte("invalid")},
+				},
+			},
+			wantErr: true,
+		},
+		"sql queue config in domain config": {
+			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
+				Domain: "test-domain",
+				Configuration: &types.AsyncWorkflowConfiguration{
+					Enabled:   true,
+					QueueType: sqlqueue.QueueType,
+					QueueConfig: &types.DataBlob{
+						EncodingType: types.EncodingTypeJSON.Ptr(),
+						Data:         []byte(`{"connection":{"pluginName":"mysql","user":"cadence","password":"cadence"},"queueName":"queue1"}`),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"Success with valid queue config": {
+			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
+				Domain: "test-domain",
+				Configuration: &types.AsyncWorkflowConfiguration{
+					Enabled:     true,
+					QueueType:   testQueueType,
+					QueueConfig: &types.DataBlob{Data: []byte("config")},
+				},
+			},
+			domainHandlerMockFn: func(m *domain.MockHandler) {
+				m.EXPECT().UpdateAsyncWorkflowConfiguraton(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantResp: &types.UpdateDomainAsyncWorkflowConfiguratonResponse{},
+		},
+		"Success with predefined queue": {
+			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
+				Domain: "test-domain",
+				Configuration: &types.AsyncWorkflowConfiguration{
+					Enabled:             true,
+					PredefinedQueueName: "queue1",
+					QueueType:           "unknown",
+				},
+			},
+			domainHandlerMockFn: func(m *domain.MockHandler) {
+				m.EXPECT().UpdateAsyncWorkflowConfiguraton(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantResp: &types.UpdateDomainAsyncWorkflowConfiguratonResponse{},
+		},
 		"Success": {
 			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
 				Domain: "test-domain",
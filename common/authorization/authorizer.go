@@ -150,30 +150,35 @@ func validatePermission(claims *JWTClaims, attributes *Attributes, data domainDa
 		return fmt.Errorf("permission %v is not supported", attributes.Permission)
 	}
 
+	allowedGroups := domainAllowedGroups(attributes.Permission, data)
+	for _, jwtGroup := range claims.GetGroups() {
+		if _, ok := allowedGroups[jwtGroup]; ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("token doesn't have the right permission, jwt groups: %v, allowed groups: %v", claims.GetGroups(), allowedGroups)
+}
+
+// domainAllowedGroups returns the groups that are allowed the permission by domain configuration(in domainData)
+func domainAllowedGroups(permission Permission, data domainData) map[string]bool {
 	allowedGroups := map[string]bool{}
-	// groups that allowed by domain configuration(in domainData)
 	// write groups are always checked
 	for _, g := range data.Groups(constants.DomainDataKeyForWriteGroups) {
 		allowedGroups[g] = true
 	}
 
-	if attributes.Permission == PermissionRead {
+	if permission == PermissionRead {
 		for _, g := range data.Groups(constants.DomainDataKeyForReadGroups) {
 			allowedGroups[g] = true
 		}
 	}
 
-	if attributes.Permission == PermissionProcess {
+	if permission == PermissionProcess {
 		for _, g := range data.Groups(constants.DomainDataKeyForProcessGroups) {
 			allowedGroups[g] = true
 		}
 	}
 
-	for _, jwtGroup := range claims.GetGroups() {
-		if _, ok := allowedGroups[jwtGroup]; ok {
-			return nil
-		}
-	}
-
-	return fmt.Errorf("token doesn't have the right permission, jwt groups: %v, allowed groups: %v", claims.GetGroups(), allowedGroups)
+	return allowedGroups
 }
@@ -22,6 +22,7 @@ package authorization
 
 import (
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 )
@@ -30,6 +31,8 @@ func NewAuthorizer(authorization config.Authorization, logger log.Logger, domain
 	switch true {
 	case authorization.OAuthAuthorizer.Enable:
 		return NewOAuthAuthorizer(authorization.OAuthAuthorizer, logger, domainCache)
+	case authorization.RBACAuthorizer.Enable:
+		return NewRBACAuthorizer(authorization.RBACAuthorizer, logger, domainCache, clock.NewRealTimeSource(), nil)
 	default:
 		return NewNopAuthorizer()
 	}
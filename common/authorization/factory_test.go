@@ -21,6 +21,8 @@
 package authorization
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -96,3 +98,23 @@ func (s *factorySuite) TestFactoryNoopAuthorizer() {
 		s.Equal(err, test.err)
 	}
 }
+
+func (s *factorySuite) TestFactoryRBACAuthorizer() {
+	policyFile := filepath.Join(s.T().TempDir(), "policy.yaml")
+	s.Require().NoError(os.WriteFile(policyFile, []byte(`rules: [{actors: [alice], permissions: [read]}]`), 0644))
+	cfg := config.Authorization{
+		RBACAuthorizer: config.RBACAuthorizer{
+			Enable:          true,
+			PolicyFile:      policyFile,
+			TLSPeerIdentity: true,
+		},
+	}
+
+	authorizer, err := NewAuthorizer(cfg, s.logger, nil)
+	s.NoError(err)
+	s.IsType(&rbacAuthority{}, authorizer)
+
+	cfg.RBACAuthorizer.PolicyFile = filepath.Join(s.T().TempDir(), "missing.yaml")
+	_, err = NewAuthorizer(cfg, s.logger, nil)
+	s.Error(err)
+}
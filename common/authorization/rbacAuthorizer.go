@@ -0,0 +1,320 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/yarpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	defaultRBACReloadInterval = 10 * time.Second
+	// rbacDomainDataRule is the rule name in the audit records of the requests allowed by the groups in the domain data
+	rbacDomainDataRule = "domain-data"
+)
+
+type rbacAuthority struct {
+	config          config.RBACAuthorizer
+	domainCache     cache.DomainCache
+	log             log.Logger
+	auditLog        log.Logger
+	timeSource      clock.TimeSource
+	dynamicPolicyFn dynamicproperties.StringPropertyFn
+	reloadInterval  time.Duration
+	jwt             *oauthAuthority
+
+	mu            sync.Mutex
+	nextReload    time.Time
+	fileModTime   time.Time
+	fileSize      int64
+	filePolicy    *RBACPolicy
+	dynamicRaw    string
+	dynamicPolicy *RBACPolicy
+	policy        *RBACPolicy
+}
+
+// NewRBACAuthorizer creates an Authorizer which evaluates the rules of a policy read from the policy file and,
+// if dynamicPolicy is not nil, from dynamic config. Both sources are checked for changes every reload interval.
+func NewRBACAuthorizer(
+	rbacConfig config.RBACAuthorizer,
+	logger log.Logger,
+	domainCache cache.DomainCache,
+	timeSource clock.TimeSource,
+	dynamicPolicy dynamicproperties.StringPropertyFn,
+) (Authorizer, error) {
+	reloadInterval := rbacConfig.ReloadInterval
+	if reloadInterval == 0 {
+		reloadInterval = defaultRBACReloadInterval
+	}
+
+	a := &rbacAuthority{
+		config:          rbacConfig,
+		domainCache:     domainCache,
+		log:             logger,
+		auditLog:        logger.WithTags(tag.ComponentAuthorizationAudit),
+		timeSource:      timeSource,
+		dynamicPolicyFn: dynamicPolicy,
+		reloadInterval:  reloadInterval,
+	}
+
+	if rbacConfig.JWT != nil {
+		jwtAuthority, err := newOAuthAuthority(rbacConfig.JWT.OAuthAuthorizer(), logger, domainCache)
+		if err != nil {
+			return nil, fmt.Errorf("creating RBAC JWT verifier: %w", err)
+		}
+		a.jwt = jwtAuthority
+	}
+
+	// A broken policy file is only tolerated once the authorizer has a policy to fall back to
+	if rbacConfig.PolicyFile != "" {
+		if _, err := a.reloadFile(); err != nil {
+			return nil, fmt.Errorf("loading RBAC policy file: %w", err)
+		}
+	}
+	a.currentPolicy()
+
+	return a, nil
+}
+
+// Authorize allows the request if a rule of the policy, or the groups in the domain data, allow it,
+// and records the decision in the audit log
+func (a *rbacAuthority) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	policy := a.currentPolicy()
+	actors, groups := a.identity(ctx, attributes)
+	groups = policy.groupsOf(actors, groups)
+
+	decision, rule := policy.evaluate(actors, groups, attributes)
+	// Deny rules of the policy also apply to the groups in the domain data
+	if decision != DecisionAllow && rule == "" && a.config.UseDomainData && attributes.DomainName != "" {
+		domain, err := a.domainCache.GetDomain(attributes.DomainName)
+		if err != nil {
+			a.audit(actors, groups, attributes, DecisionDeny, rule, err)
+			return Result{Decision: DecisionDeny}, err
+		}
+		allowedGroups := domainAllowedGroups(attributes.Permission, domain.GetInfo().Data)
+		for _, group := range groups {
+			if allowedGroups[group] {
+				decision, rule = DecisionAllow, rbacDomainDataRule
+				break
+			}
+		}
+	}
+
+	a.audit(actors, groups, attributes, decision, rule, nil)
+	return Result{Decision: decision}, nil
+}
+
+// identity returns the actors and groups of the caller, from the attributes, the verified client certificate,
+// the verified JWT and, only if they are trusted, the headers set by a proxy
+func (a *rbacAuthority) identity(ctx context.Context, attributes *Attributes) ([]string, []string) {
+	var actors, groups []string
+	if attributes.Actor != "" {
+		actors = append(actors, attributes.Actor)
+	}
+
+	if a.config.TLSPeerIdentity {
+		if p, ok := peer.FromContext(ctx); ok {
+			if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+				cert := tlsInfo.State.VerifiedChains[0][0]
+				if cert.Subject.CommonName != "" {
+					actors = append(actors, cert.Subject.CommonName)
+				}
+				for _, uri := range cert.URIs {
+					actors = append(actors, uri.String())
+				}
+			}
+		}
+	}
+
+	if a.jwt != nil {
+		claims, err := a.jwt.verifiedClaims(ctx)
+		if err != nil {
+			a.log.Debug("JWT of the request is not verified", tag.Error(err))
+		} else {
+			if claims.Subject != "" {
+				actors = append(actors, claims.Subject)
+			}
+			if claims.Name != "" {
+				actors = append(actors, claims.Name)
+			}
+			groups = append(groups, strings.Fields(claims.Groups)...)
+		}
+	}
+
+	if a.config.TrustProxyHeaders {
+		call := yarpc.CallFromContext(ctx)
+		if a.config.ActorHeader != "" {
+			if actor := call.Header(a.config.ActorHeader); actor != "" {
+				actors = append(actors, actor)
+			}
+		}
+		if a.config.GroupsHeader != "" {
+			groups = append(groups, strings.Fields(call.Header(a.config.GroupsHeader))...)
+		}
+	}
+
+	return actors, groups
+}
+
+// currentPolicy returns the policy, after reloading the sources which changed if the reload interval has passed
+func (a *rbacAuthority) currentPolicy() *RBACPolicy {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.timeSource.Now()
+	if a.policy != nil && now.Before(a.nextReload) {
+		return a.policy
+	}
+	a.nextReload = now.Add(a.reloadInterval)
+
+	changed := a.policy == nil
+	if a.config.PolicyFile != "" {
+		fileChanged, err := a.reloadFile()
+		if err != nil {
+			a.log.Error("failed to reload RBAC policy file, keeping the previous policy", tag.Error(err))
+		}
+		changed = changed || fileChanged
+	}
+	if a.dynamicPolicyFn != nil {
+		changed = a.reloadDynamic() || changed
+	}
+
+	if changed {
+		a.policy = mergeRBACPolicies(a.filePolicy, a.dynamicPolicy)
+	}
+	return a.policy
+}
+
+// reloadFile parses the policy file if it changed since it was last loaded
+func (a *rbacAuthority) reloadFile() (bool, error) {
+	info, err := os.Stat(a.config.PolicyFile)
+	if err != nil {
+		return false, err
+	}
+	if a.filePolicy != nil && info.ModTime().Equal(a.fileModTime) && info.Size() == a.fileSize {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(a.config.PolicyFile)
+	if err != nil {
+		return false, err
+	}
+	policy, err := parseRBACPolicy(data)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", a.config.PolicyFile, err)
+	}
+
+	a.filePolicy = policy
+	a.fileModTime = info.ModTime()
+	a.fileSize = info.Size()
+	a.log.Info("loaded RBAC policy file", tag.Value(a.config.PolicyFile))
+	return true, nil
+}
+
+// reloadDynamic parses the policy from dynamic config if it changed since it was last loaded
+func (a *rbacAuthority) reloadDynamic() bool {
+	raw := a.dynamicPolicyFn()
+	if raw == a.dynamicRaw {
+		return false
+	}
+
+	var policy *RBACPolicy
+	if raw != "" {
+		var err error
+		if policy, err = parseRBACPolicy([]byte(raw)); err != nil {
+			a.log.Error("failed to reload RBAC policy from dynamic config, keeping the previous policy", tag.Error(err))
+			return false
+		}
+	}
+
+	a.dynamicRaw = raw
+	a.dynamicPolicy = policy
+	a.log.Info("loaded RBAC policy from dynamic config")
+	return true
+}
+
+func (a *rbacAuthority) audit(actors, groups []string, attributes *Attributes, decision Decision, rule string, err error) {
+	tags := []tag.Tag{
+		tag.ActorID(strings.Join(actors, ",")),
+		tag.ActorGroups(groups),
+		tag.HandlerCall(attributes.APIName),
+		tag.WorkflowDomainName(attributes.DomainName),
+		tag.AuthorizationPermission(permissionName(attributes.Permission)),
+		tag.AuthorizationDecision(decisionName(decision)),
+		tag.AuthorizationRule(rule),
+	}
+	if attributes.WorkflowType != nil {
+		tags = append(tags, tag.WorkflowType(attributes.WorkflowType.GetName()))
+	}
+	if attributes.TaskList != nil {
+		tags = append(tags, tag.WorkflowTaskListName(attributes.TaskList.GetName()))
+	}
+	if attributes.RequestBody != nil {
+		body, serializeErr := attributes.RequestBody.SerializeForLogging()
+		if serializeErr != nil {
+			a.log.Warn("failed to serialize request body for the audit log", tag.Error(serializeErr))
+		} else {
+			tags = append(tags, tag.RequestBody(body))
+		}
+	}
+	if err != nil {
+		tags = append(tags, tag.Error(err))
+	}
+
+	a.auditLog.Info("authorization decision", tags...)
+}
+
+func permissionName(permission Permission) string {
+	switch permission {
+	case PermissionRead:
+		return "read"
+	case PermissionWrite:
+		return "write"
+	case PermissionAdmin:
+		return "admin"
+	case PermissionProcess:
+		return "process"
+	default:
+		return fmt.Sprintf("unknown(%d)", permission)
+	}
+}
+
+func decisionName(decision Decision) string {
+	if decision == DecisionAllow {
+		return "allow"
+	}
+	return "deny"
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	rbacEffectAllow = "allow"
+	rbacEffectDeny  = "deny"
+)
+
+type (
+	// RBACPolicy is the policy of the RBAC authorizer.
+	// Requests are denied unless a rule allows them, and a matching deny rule always wins over allow rules.
+	RBACPolicy struct {
+		// Groups adds actors to groups, in addition to the groups that come with their identity
+		Groups map[string][]string `yaml:"groups"`
+		Rules  []RBACRule          `yaml:"rules"`
+	}
+
+	// RBACRule allows or denies permissions to actors and groups.
+	// Every condition which is set must match the request. Values are matched exactly,
+	// except "*" which matches anything, and values ending with "*" which match by prefix.
+	RBACRule struct {
+		Name string `yaml:"name"`
+		// Effect is either allow, which is the default, or deny
+		Effect string   `yaml:"effect"`
+		Actors []string `yaml:"actors"`
+		Groups []string `yaml:"groups"`
+		// Domains, APIs, WorkflowTypes and TaskLists match any request when they are empty.
+		// Rules with WorkflowTypes or TaskLists only match the requests which have a workflow type or task list.
+		Domains       []string `yaml:"domains"`
+		APIs          []string `yaml:"apis"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		TaskLists     []string `yaml:"taskLists"`
+		// Permissions are read, write, admin or process. Admin implies all of them, and write implies read and process.
+		Permissions []string `yaml:"permissions"`
+
+		permissions []Permission
+	}
+)
+
+// parseRBACPolicy parses and validates a policy in YAML or JSON
+func parseRBACPolicy(data []byte) (*RBACPolicy, error) {
+	var policy RBACPolicy
+	if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+		return nil, fmt.Errorf("unmarshal policy: %w", err)
+	}
+
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rules[%d]", i)
+		}
+		switch rule.Effect {
+		case "":
+			rule.Effect = rbacEffectAllow
+		case rbacEffectAllow, rbacEffectDeny:
+		default:
+			return nil, fmt.Errorf("rule %s: effect %q is not supported", rule.Name, rule.Effect)
+		}
+		if len(rule.Actors) == 0 && len(rule.Groups) == 0 {
+			return nil, fmt.Errorf("rule %s: actors or groups must be set", rule.Name)
+		}
+		if len(rule.Permissions) == 0 {
+			return nil, fmt.Errorf("rule %s: permissions must be set", rule.Name)
+		}
+		for _, p := range rule.Permissions {
+			permission := NewPermission(p)
+			if permission < 0 {
+				return nil, fmt.Errorf("rule %s: permission %q is not supported", rule.Name, p)
+			}
+			rule.permissions = append(rule.permissions, permission)
+		}
+	}
+
+	return &policy, nil
+}
+
+// mergeRBACPolicies combines the groups and rules of the policies, skipping the nil ones
+func mergeRBACPolicies(policies ...*RBACPolicy) *RBACPolicy {
+	merged := &RBACPolicy{Groups: map[string][]string{}}
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		for group, actors := range policy.Groups {
+			merged.Groups[group] = append(merged.Groups[group], actors...)
+		}
+		merged.Rules = append(merged.Rules, policy.Rules...)
+	}
+	return merged
+}
+
+// groupsOf returns the groups of the identity, and the groups the policy adds its actors to
+func (p *RBACPolicy) groupsOf(actors []string, groups []string) []string {
+	result := append([]string(nil), groups...)
+	for group, members := range p.Groups {
+		if containsAny(members, actors) && !containsAny([]string{group}, result) {
+			result = append(result, group)
+		}
+	}
+	return result
+}
+
+// evaluate returns the decision for the request and the name of the rule which made it,
+// which is empty when no rule matched and the request is denied by default
+func (p *RBACPolicy) evaluate(actors []string, groups []string, attributes *Attributes) (Decision, string) {
+	allowedBy := ""
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if !rule.matches(actors, groups, attributes) {
+			continue
+		}
+		if rule.Effect == rbacEffectDeny {
+			return DecisionDeny, rule.Name
+		}
+		if allowedBy == "" {
+			allowedBy = rule.Name
+		}
+	}
+	if allowedBy != "" {
+		return DecisionAllow, allowedBy
+	}
+	return DecisionDeny, ""
+}
+
+func (r *RBACRule) matches(actors []string, groups []string, attributes *Attributes) bool {
+	if !matchesAny(r.Actors, actors) && !matchesAny(r.Groups, groups) {
+		return false
+	}
+	if !r.grants(attributes.Permission) {
+		return false
+	}
+	if len(r.Domains) > 0 && !matchesAny(r.Domains, []string{attributes.DomainName}) {
+		return false
+	}
+	if len(r.APIs) > 0 && !matchesAny(r.APIs, []string{attributes.APIName}) {
+		return false
+	}
+	if len(r.WorkflowTypes) > 0 && (attributes.WorkflowType == nil || !matchesAny(r.WorkflowTypes, []string{attributes.WorkflowType.GetName()})) {
+		return false
+	}
+	if len(r.TaskLists) > 0 && (attributes.TaskList == nil || !matchesAny(r.TaskLists, []string{attributes.TaskList.GetName()})) {
+		return false
+	}
+	return true
+}
+
+func (r *RBACRule) grants(permission Permission) bool {
+	for _, p := range r.permissions {
+		switch {
+		case p == permission, p == PermissionAdmin:
+			return true
+		case p == PermissionWrite && (permission == PermissionRead || permission == PermissionProcess):
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAny returns true if any of the values matches any of the patterns
+func matchesAny(patterns []string, values []string) bool {
+	for _, pattern := range patterns {
+		for _, value := range values {
+			if pattern == "*" || pattern == value {
+				return true
+			}
+			if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(value, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsAny(list []string, values []string) bool {
+	for _, v := range list {
+		for _, value := range values {
+			if v == value {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestParseRBACPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy string
+		err    string
+	}{
+		"yaml": {
+			policy: `
+groups:
+  operators: [alice]
+rules:
+  - name: operators
+    groups: [operators]
+    permissions: [admin]
+  - actors: [bob]
+    effect: deny
+    domains: [payments-*]
+    permissions: [write]
+`,
+		},
+		"json": {
+			policy: `{"rules": [{"actors": ["bob"], "permissions": ["read"]}]}`,
+		},
+		"unknown field": {
+			policy: `{"rules": [{"actor": ["bob"], "permissions": ["read"]}]}`,
+			err:    "unmarshal policy",
+		},
+		"no subject": {
+			policy: `{"rules": [{"name": "nobody", "permissions": ["read"]}]}`,
+			err:    "rule nobody: actors or groups must be set",
+		},
+		"no permissions": {
+			policy: `{"rules": [{"actors": ["bob"]}]}`,
+			err:    "rule rules[0]: permissions must be set",
+		},
+		"unknown permission": {
+			policy: `{"rules": [{"actors": ["bob"], "permissions": ["delete"]}]}`,
+			err:    `rule rules[0]: permission "delete" is not supported`,
+		},
+		"unknown effect": {
+			policy: `{"rules": [{"actors": ["bob"], "effect": "maybe", "permissions": ["read"]}]}`,
+			err:    `rule rules[0]: effect "maybe" is not supported`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy, err := parseRBACPolicy([]byte(test.policy))
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			for _, rule := range policy.Rules {
+				assert.NotEmpty(t, rule.Name)
+				assert.Contains(t, []string{rbacEffectAllow, rbacEffectDeny}, rule.Effect)
+				assert.Len(t, rule.permissions, len(rule.Permissions))
+			}
+		})
+	}
+}
+
+func TestRBACPolicyEvaluate(t *testing.T) {
+	policy, err := parseRBACPolicy([]byte(`
+groups:
+  operators: [alice]
+rules:
+  - name: operators-admin
+    groups: [operators]
+    permissions: [admin]
+  - name: payments-writers
+    actors: [bob, "spiffe://example.org/payments/*"]
+    domains: [payments-*]
+    permissions: [write]
+  - name: no-refunds
+    actors: [bob]
+    domains: [payments-*]
+    workflowTypes: [RefundWorkflow]
+    effect: deny
+    permissions: [write]
+  - name: billing-workers
+    groups: [billing]
+    domains: [billing]
+    taskLists: [billing-tl]
+    permissions: [process]
+  - name: everyone-reads-describe
+    actors: ["*"]
+    apis: [DescribeDomain]
+    permissions: [read]
+`))
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		actors     []string
+		groups     []string
+		attributes Attributes
+		decision   Decision
+		rule       string
+	}{
+		"group from policy": {
+			actors:     []string{"alice"},
+			attributes: Attributes{APIName: "UpdateDomain", DomainName: "any", Permission: PermissionAdmin},
+			decision:   DecisionAllow,
+			rule:       "operators-admin",
+		},
+		"write implies read": {
+			actors:     []string{"bob"},
+			attributes: Attributes{APIName: "DescribeWorkflowExecution", DomainName: "payments-eu", Permission: PermissionRead},
+			decision:   DecisionAllow,
+			rule:       "payments-writers",
+		},
+		"write does not imply admin": {
+			actors:     []string{"bob"},
+			attributes: Attributes{APIName: "UpdateDomain", DomainName: "payments-eu", Permission: PermissionAdmin},
+			decision:   DecisionDeny,
+		},
+		"other domain": {
+			actors:     []string{"bob"},
+			attributes: Attributes{APIName: "StartWorkflowExecution", DomainName: "orders", Permission: PermissionWrite},
+			decision:   DecisionDeny,
+		},
+		"prefix actor": {
+			actors:     []string{"spiffe://example.org/payments/api"},
+			attributes: Attributes{APIName: "StartWorkflowExecution", DomainName: "payments-us", Permission: PermissionWrite},
+			decision:   DecisionAllow,
+			rule:       "payments-writers",
+		},
+		"deny wins": {
+			actors: []string{"bob"},
+			attributes: Attributes{
+				APIName:      "StartWorkflowExecution",
+				DomainName:   "payments-eu",
+				WorkflowType: &types.WorkflowType{Name: "RefundWorkflow"},
+				Permission:   PermissionWrite,
+			},
+			decision: DecisionDeny,
+			rule:     "no-refunds",
+		},
+		"deny needs workflow type": {
+			actors:     []string{"bob"},
+			attributes: Attributes{APIName: "SignalWorkflowExecution", DomainName: "payments-eu", Permission: PermissionWrite},
+			decision:   DecisionAllow,
+			rule:       "payments-writers",
+		},
+		"task list": {
+			groups: []string{"billing"},
+			attributes: Attributes{
+				APIName:    "PollForDecisionTask",
+				DomainName: "billing",
+				TaskList:   &types.TaskList{Name: "billing-tl"},
+				Permission: PermissionProcess,
+			},
+			decision: DecisionAllow,
+			rule:     "billing-workers",
+		},
+		"other task list": {
+			groups: []string{"billing"},
+			attributes: Attributes{
+				APIName:    "PollForDecisionTask",
+				DomainName: "billing",
+				TaskList:   &types.TaskList{Name: "other-tl"},
+				Permission: PermissionProcess,
+			},
+			decision: DecisionDeny,
+		},
+		"api": {
+			actors:     []string{"carol"},
+			attributes: Attributes{APIName: "DescribeDomain", DomainName: "orders", Permission: PermissionRead},
+			decision:   DecisionAllow,
+			rule:       "everyone-reads-describe",
+		},
+		"no identity": {
+			attributes: Attributes{APIName: "DescribeDomain", DomainName: "orders", Permission: PermissionRead},
+			decision:   DecisionDeny,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			groups := policy.groupsOf(test.actors, test.groups)
+			decision, rule := policy.evaluate(test.actors, groups, &test.attributes)
+			assert.Equal(t, test.decision, decision)
+			assert.Equal(t, test.rule, rule)
+		})
+	}
+}
+
+func TestMergeRBACPolicies(t *testing.T) {
+	file, err := parseRBACPolicy([]byte(`{"groups": {"ops": ["alice"]}, "rules": [{"groups": ["ops"], "permissions": ["read"]}]}`))
+	require.NoError(t, err)
+	dynamic, err := parseRBACPolicy([]byte(`{"groups": {"ops": ["bob"]}, "rules": [{"actors": ["carol"], "permissions": ["write"]}]}`))
+	require.NoError(t, err)
+
+	merged := mergeRBACPolicies(file, nil, dynamic)
+	assert.ElementsMatch(t, []string{"alice", "bob"}, merged.Groups["ops"])
+	assert.Len(t, merged.Rules, 2)
+}
@@ -23,6 +23,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
@@ -31,6 +32,7 @@ type (
 	Authorization struct {
 		OAuthAuthorizer OAuthAuthorizer `yaml:"oauthAuthorizer"`
 		NoopAuthorizer  NoopAuthorizer  `yaml:"noopAuthorizer"`
+		RBACAuthorizer  RBACAuthorizer  `yaml:"rbacAuthorizer"`
 	}
 
 	NoopAuthorizer struct {
@@ -47,6 +49,40 @@ type (
 		Provider *OAuthProvider `yaml:"provider"`
 	}
 
+	// RBACAuthorizer grants permissions to actors and groups with the rules of a policy,
+	// which is read from a file and from the frontend.rbacPolicy dynamic config
+	RBACAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// Path of the policy file, which is reloaded when it changes
+		PolicyFile string `yaml:"policyFile"`
+		// How often the policy is checked for changes, 10s if not set
+		ReloadInterval time.Duration `yaml:"reloadInterval"`
+		// Use the common name and URI SANs of the verified client certificate as the identity of the caller
+		TLSPeerIdentity bool `yaml:"tlsPeerIdentity"`
+		// Verify the JWT of the request the same way the OAuth authorizer does, and use its subject,
+		// name and groups as the identity of the caller
+		JWT *RBACJWT `yaml:"jwt"`
+		// Trust the actor and groups headers of the requests. Any caller can set these headers, so this
+		// must only be enabled when the frontend is reachable only through a proxy which sets them.
+		TrustProxyHeaders bool `yaml:"trustProxyHeaders"`
+		// Header set by the trusted proxy with the identity of the caller
+		ActorHeader string `yaml:"actorHeader"`
+		// Header set by the trusted proxy with the groups of the caller, separated by space
+		GroupsHeader string `yaml:"groupsHeader"`
+		// Also allow the groups listed in the domain data, the same way the OAuth authorizer does
+		UseDomainData bool `yaml:"useDomainData"`
+	}
+
+	// RBACJWT is how the RBAC authorizer verifies the JWT of a request
+	RBACJWT struct {
+		// Max of TTL in the claim
+		MaxJwtTTL int64 `yaml:"maxJwtTTL"`
+		// Credentials to verify the JWT using public keys
+		JwtCredentials *JwtCredentials `yaml:"jwtCredentials"`
+		// Provider
+		Provider *OAuthProvider `yaml:"provider"`
+	}
+
 	JwtCredentials struct {
 		// support: RS256 (RSA using SHA256)
 		Algorithm string `yaml:"algorithm"`
@@ -64,7 +100,13 @@ type (
 
 // Validate validates the persistence config
 func (a *Authorization) Validate() error {
-	if a.OAuthAuthorizer.Enable && a.NoopAuthorizer.Enable {
+	enabled := 0
+	for _, enable := range []bool{a.OAuthAuthorizer.Enable, a.NoopAuthorizer.Enable, a.RBACAuthorizer.Enable} {
+		if enable {
+			enabled++
+		}
+	}
+	if enabled > 1 {
 		return fmt.Errorf("[AuthorizationConfig] More than one authorizer is enabled")
 	}
 
@@ -74,14 +116,59 @@ func (a *Authorization) Validate() error {
 		}
 	}
 
+	if a.RBACAuthorizer.Enable {
+		if err := a.validateRBAC(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (a *Authorization) validateRBAC() error {
+	rbacConfig := a.RBACAuthorizer
+
+	if rbacConfig.ReloadInterval < 0 {
+		return fmt.Errorf("[RBACConfig] ReloadInterval can't be negative")
+	}
+
+	hasHeader := rbacConfig.ActorHeader != "" || rbacConfig.GroupsHeader != ""
+	if hasHeader && !rbacConfig.TrustProxyHeaders {
+		return fmt.Errorf("[RBACConfig] actorHeader and groupsHeader are only used with trustProxyHeaders")
+	}
+	if rbacConfig.TrustProxyHeaders && !hasHeader {
+		return fmt.Errorf("[RBACConfig] trustProxyHeaders requires actorHeader or groupsHeader")
+	}
+
+	if !rbacConfig.TLSPeerIdentity && rbacConfig.JWT == nil && !rbacConfig.TrustProxyHeaders {
+		return fmt.Errorf("[RBACConfig] At least one of tlsPeerIdentity, jwt or trustProxyHeaders must be set")
+	}
+
+	if rbacConfig.JWT != nil {
+		if err := validateJWT("[RBACConfig]", rbacConfig.JWT.OAuthAuthorizer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OAuthAuthorizer returns the OAuth authorizer config which verifies the JWT the same way
+func (j *RBACJWT) OAuthAuthorizer() OAuthAuthorizer {
+	return OAuthAuthorizer{
+		MaxJwtTTL:      j.MaxJwtTTL,
+		JwtCredentials: j.JwtCredentials,
+		Provider:       j.Provider,
+	}
+}
+
 func (a *Authorization) validateOAuth() error {
-	oauthConfig := a.OAuthAuthorizer
+	return validateJWT("[OAuthConfig]", a.OAuthAuthorizer)
+}
 
+func validateJWT(prefix string, oauthConfig OAuthAuthorizer) error {
 	if oauthConfig.MaxJwtTTL <= 0 {
-		return fmt.Errorf("[OAuthConfig] MaxTTL must be greater than 0")
+		return fmt.Errorf("%s MaxTTL must be greater than 0", prefix)
 	}
 
 	if oauthConfig.JwtCredentials == nil && oauthConfig.Provider == nil {
@@ -90,17 +177,17 @@ func (a *Authorization) validateOAuth() error {
 
 	if oauthConfig.JwtCredentials != nil {
 		if oauthConfig.JwtCredentials.PublicKey == "" {
-			return fmt.Errorf("[OAuthConfig] PublicKey can't be empty")
+			return fmt.Errorf("%s PublicKey can't be empty", prefix)
 		}
 
 		if oauthConfig.JwtCredentials.Algorithm != jwt.SigningMethodRS256.Name {
-			return fmt.Errorf("[OAuthConfig] The only supported Algorithm is RS256")
+			return fmt.Errorf("%s The only supported Algorithm is RS256", prefix)
 		}
 	}
 
 	if oauthConfig.Provider != nil {
 		if oauthConfig.Provider.JWKSURL == "" {
-			return fmt.Errorf("[OAuthConfig] JWKSURL is not set")
+			return fmt.Errorf("%s JWKSURL is not set", prefix)
 		}
 	}
 
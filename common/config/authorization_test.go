@@ -22,7 +22,9 @@ package config
 
 import (
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -112,3 +114,69 @@ func TestCorrectValidation(t *testing.T) {
 	err := cfg.Validate()
 	assert.NoError(t, err)
 }
+
+func TestRBACAndOAuthEnabled(t *testing.T) {
+	cfg := Authorization{
+		OAuthAuthorizer: OAuthAuthorizer{
+			Enable: true,
+		},
+		RBACAuthorizer: RBACAuthorizer{
+			Enable: true,
+		},
+	}
+
+	err := cfg.Validate()
+	assert.EqualError(t, err, "[AuthorizationConfig] More than one authorizer is enabled")
+}
+
+func TestRBACValidation(t *testing.T) {
+	tests := map[string]struct {
+		cfg RBACAuthorizer
+		err string
+	}{
+		"no identity": {
+			cfg: RBACAuthorizer{Enable: true, PolicyFile: "policy.yaml"},
+			err: "[RBACConfig] At least one of tlsPeerIdentity, jwt or trustProxyHeaders must be set",
+		},
+		"negative reload interval": {
+			cfg: RBACAuthorizer{Enable: true, TLSPeerIdentity: true, ReloadInterval: -time.Second},
+			err: "[RBACConfig] ReloadInterval can't be negative",
+		},
+		"headers without trustProxyHeaders": {
+			cfg: RBACAuthorizer{Enable: true, TLSPeerIdentity: true, ActorHeader: "cadence-actor"},
+			err: "[RBACConfig] actorHeader and groupsHeader are only used with trustProxyHeaders",
+		},
+		"trustProxyHeaders without headers": {
+			cfg: RBACAuthorizer{Enable: true, TrustProxyHeaders: true},
+			err: "[RBACConfig] trustProxyHeaders requires actorHeader or groupsHeader",
+		},
+		"invalid jwt": {
+			cfg: RBACAuthorizer{Enable: true, JWT: &RBACJWT{MaxJwtTTL: 0}},
+			err: "[RBACConfig] MaxTTL must be greater than 0",
+		},
+		"valid tls": {
+			cfg: RBACAuthorizer{Enable: true, PolicyFile: "policy.yaml", TLSPeerIdentity: true},
+		},
+		"valid jwt": {
+			cfg: RBACAuthorizer{Enable: true, PolicyFile: "policy.yaml", JWT: &RBACJWT{
+				MaxJwtTTL:      3600,
+				JwtCredentials: &JwtCredentials{Algorithm: jwt.SigningMethodRS256.Name, PublicKey: "key.pub"},
+			}},
+		},
+		"valid trusted proxy": {
+			cfg: RBACAuthorizer{Enable: true, PolicyFile: "policy.yaml", TrustProxyHeaders: true, ActorHeader: "cadence-actor"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := Authorization{RBACAuthorizer: test.cfg}
+			err := cfg.Validate()
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
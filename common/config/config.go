@@ -98,6 +98,9 @@ type (
 		//
 		// Timers will eventually be dropped, and this config will be validation-only (e.g. to error if any explicitly request timers).
 		Histograms metrics.HistogramMigration `yaml:"histograms"`
+
+		// Tracing is the config for OpenTelemetry tracing, which is disabled if not set
+		Tracing Tracing `yaml:"tracing"`
 	}
 
 	// Membership holds peer provider configuration.
@@ -649,6 +652,18 @@ type (
 		Mode string `yaml:"mode"`
 		// ShardNum is defined for fixed namespace.
 		ShardNum int64 `yaml:"shardNum"`
+		// AssignmentStrategy is how the leader places shards on executors. Supported values: round_robin|load_balanced.
+		// Default: round_robin
+		AssignmentStrategy string `yaml:"assignmentStrategy"`
+		// MaxMovesPerCycle caps the number of shards moved between healthy executors by a single rebalance of the load_balanced strategy.
+		// Default: 10
+		MaxMovesPerCycle int `yaml:"maxMovesPerCycle"`
+		// MoveCooldown is the minimum duration between two moves of the same shard by the load_balanced strategy.
+		// Default: 1 minute
+		MoveCooldown time.Duration `yaml:"moveCooldown"`
+		// LoadImbalanceRatio is how much the load of an executor may exceed the average load before the load_balanced strategy moves its shards.
+		// Default: 0.1
+		LoadImbalanceRatio float64 `yaml:"loadImbalanceRatio"`
 	}
 
 	Election struct {
@@ -740,6 +755,9 @@ func (c *Config) validate() error {
 	if err := c.Archival.Validate(&c.DomainDefaults.Archival); err != nil {
 		return err
 	}
+	if err := c.Tracing.Validate(); err != nil {
+		return err
+	}
 
 	return c.Authorization.Validate()
 }
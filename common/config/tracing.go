@@ -0,0 +1,101 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// TracingExporterOTLP exports spans to an OpenTelemetry collector over OTLP/gRPC
+	TracingExporterOTLP = "otlp"
+	// TracingExporterFile writes spans as JSON lines to a file
+	TracingExporterFile = "file"
+)
+
+type (
+	// Tracing is the config for OpenTelemetry tracing.
+	// Tracing is disabled when no exporter is set, in which case no span is recorded
+	// but the trace context of the requests is still propagated.
+	Tracing struct {
+		// Exporter is either "otlp" or "file"
+		Exporter string `yaml:"exporter"`
+		// SamplingRate is the ratio of the traces started by this service that are sampled, 1 if not set.
+		// The spans of a trace started by a caller follow the sampling decision of the caller.
+		SamplingRate float64 `yaml:"samplingRate"`
+		// OTLP is the config of the otlp exporter
+		OTLP OTLPTracing `yaml:"otlp"`
+		// File is the config of the file exporter
+		File FileTracing `yaml:"file"`
+	}
+
+	// OTLPTracing is the config for exporting spans to an OpenTelemetry collector
+	OTLPTracing struct {
+		// Endpoint is the host:port of the collector
+		Endpoint string `yaml:"endpoint"`
+		// Insecure disables TLS to the collector
+		Insecure bool `yaml:"insecure"`
+		// Headers are sent with every export request, e.g. for authentication
+		Headers map[string]string `yaml:"headers"`
+		// Timeout of an export request, 10s if not set
+		Timeout time.Duration `yaml:"timeout"`
+	}
+
+	// FileTracing is the config for writing spans to a file
+	FileTracing struct {
+		// Path of the file, which is appended to
+		Path string `yaml:"path"`
+	}
+)
+
+// Enabled returns true if an exporter is set
+func (t *Tracing) Enabled() bool {
+	return t.Exporter != ""
+}
+
+// Validate validates the tracing config
+func (t *Tracing) Validate() error {
+	if t.SamplingRate < 0 || t.SamplingRate > 1 {
+		return fmt.Errorf("[TracingConfig] SamplingRate must be between 0 and 1")
+	}
+
+	switch t.Exporter {
+	case "":
+		return nil
+	case TracingExporterOTLP:
+		if t.OTLP.Endpoint == "" {
+			return fmt.Errorf("[TracingConfig] otlp endpoint can't be empty")
+		}
+		if t.OTLP.Timeout < 0 {
+			return fmt.Errorf("[TracingConfig] otlp timeout can't be negative")
+		}
+	case TracingExporterFile:
+		if t.File.Path == "" {
+			return fmt.Errorf("[TracingConfig] file path can't be empty")
+		}
+	default:
+		return fmt.Errorf("[TracingConfig] unknown exporter %q, must be %q or %q", t.Exporter, TracingExporterOTLP, TracingExporterFile)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracingValidate(t *testing.T) {
+	tests := map[string]struct {
+		cfg     Tracing
+		enabled bool
+		err     string
+	}{
+		"disabled": {
+			cfg: Tracing{},
+		},
+		"otlp": {
+			cfg:     Tracing{Exporter: TracingExporterOTLP, SamplingRate: 0.1, OTLP: OTLPTracing{Endpoint: "localhost:4317", Timeout: time.Second}},
+			enabled: true,
+		},
+		"file": {
+			cfg:     Tracing{Exporter: TracingExporterFile, File: FileTracing{Path: "/tmp/traces.json"}},
+			enabled: true,
+		},
+		"otlp without endpoint": {
+			cfg:     Tracing{Exporter: TracingExporterOTLP},
+			enabled: true,
+			err:     "[TracingConfig] otlp endpoint can't be empty",
+		},
+		"otlp with negative timeout": {
+			cfg:     Tracing{Exporter: TracingExporterOTLP, OTLP: OTLPTracing{Endpoint: "localhost:4317", Timeout: -time.Second}},
+			enabled: true,
+			err:     "[TracingConfig] otlp timeout can't be negative",
+		},
+		"file without path": {
+			cfg:     Tracing{Exporter: TracingExporterFile},
+			enabled: true,
+			err:     "[TracingConfig] file path can't be empty",
+		},
+		"unknown exporter": {
+			cfg:     Tracing{Exporter: "jaeger"},
+			enabled: true,
+			err:     `[TracingConfig] unknown exporter "jaeger", must be "otlp" or "file"`,
+		},
+		"invalid sampling rate": {
+			cfg: Tracing{SamplingRate: 2},
+			err: "[TracingConfig] SamplingRate must be between 0 and 1",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.enabled, tc.cfg.Enabled())
+			err := tc.cfg.Validate()
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
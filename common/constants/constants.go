@@ -243,6 +243,10 @@ const MemoKeyForOperator = "operator"
 // ReservedTaskListPrefix is the required naming prefix for any task list partition other than partition 0
 const ReservedTaskListPrefix = "/__cadence_sys/"
 
+// ReservedPauseMemoKey is the memo key the pause state used to be recorded under. User memos may not set it,
+// so that a memo cannot be mistaken for a pause by readers that still look for it.
+const ReservedPauseMemoKey = "__cadence_pause"
+
 type (
 	// VisibilityOperation is an enum that represents visibility message types
 	VisibilityOperation string
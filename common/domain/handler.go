@@ -455,7 +455,7 @@ func (d *handlerImpl) UpdateDomain(
 	}
 
 	if updateRequest.IsAFailoverRequest() {
-		return d.handleFailoverRequest(ctx, updateRequest, currentDomainState, notificationVersion)
+		return d.handleFailoverRequest(ctx, updateRequest, currentDomainState, notificationVersion, "")
 	}
 
 	return d.updateGlobalDomainConfiguration(ctx, updateRequest, currentDomainState, notificationVersion)
@@ -480,6 +480,7 @@ func (d *handlerImpl) handleFailoverRequest(ctx context.Context,
 	updateRequest *types.UpdateDomainRequest,
 	currentState *persistence.GetDomainResponse,
 	notificationVersion int64,
+	reason string,
 ) (*types.UpdateDomainResponse, error) {
 
 	// intendedDomainState will be modified
@@ -656,7 +657,11 @@ func (d *handlerImpl) handleFailoverRequest(ctx context.Context,
 	}
 	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = d.createResponse(intendedDomainState.Info, intendedDomainState.Config, intendedDomainState.ReplicationConfig)
 
-	err = d.updateDomainAuditLog(ctx, currentState, intendedDomainState, persistence.DomainAuditOperationTypeFailover, "domain failover")
+	comment := "domain failover"
+	if reason != "" {
+		comment = reason
+	}
+	err = d.updateDomainAuditLog(ctx, currentState, intendedDomainState, persistence.DomainAuditOperationTypeFailover, comment)
 	if err != nil {
 		return nil, err
 	}
@@ -1014,6 +1019,7 @@ func (d *handlerImpl) FailoverDomain(
 		failoverRequest.ToUpdateDomainRequest(),
 		currentDomainState,
 		notificationVersion,
+		failoverRequest.GetReason(),
 	)
 	if err != nil {
 		return nil, err
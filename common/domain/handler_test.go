@@ -3588,9 +3588,10 @@ func TestHandler_FailoverDomain(t *testing.T) {
 			timeSource clock.MockedTimeSource,
 			domainReplicator *MockReplicator,
 		)
-		request  *types.FailoverDomainRequest
-		response func(timeSource clock.MockedTimeSource) *types.FailoverDomainResponse
-		err      error
+		request      *types.FailoverDomainRequest
+		response     func(timeSource clock.MockedTimeSource) *types.FailoverDomainResponse
+		auditComment string
+		err          error
 	}{
 		{
 			name: "Success case - active/passive domain - global domain force failover - failing over from cluster A to cluster B",
@@ -3685,7 +3686,9 @@ func TestHandler_FailoverDomain(t *testing.T) {
 			request: &types.FailoverDomainRequest{
 				DomainName:              constants.TestDomainName,
 				DomainActiveClusterName: common.Ptr(clusterB),
+				Reason:                  "automatic failover: cluster-a is unhealthy",
 			},
+			auditComment: "automatic failover: cluster-a is unhealthy",
 			response: func(timeSource clock.MockedTimeSource) *types.FailoverDomainResponse {
 				data, _ := json.Marshal([]FailoverEvent{
 					{EventTime: timeSource.Now(),
@@ -3780,14 +3783,24 @@ func TestHandler_FailoverDomain(t *testing.T) {
 			mockDomainManager := persistence.NewMockDomainManager(ctrl)
 			mockReplicator := NewMockReplicator(ctrl)
 			mockArchivalMetadata := &archiver.MockArchivalMetadata{}
+			mockDomainAuditManager := persistence.NewMockDomainAuditManager(ctrl)
+
+			var auditComments []string
+			mockDomainAuditManager.EXPECT().CreateDomainAuditLog(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, request *persistence.CreateDomainAuditLogRequest) (*persistence.CreateDomainAuditLogResponse, error) {
+					assert.Equal(t, persistence.DomainAuditOperationTypeFailover, request.OperationType)
+					auditComments = append(auditComments, request.Comment)
+					return &persistence.CreateDomainAuditLogResponse{EventID: request.EventID}, nil
+				}).AnyTimes()
 
 			testConfig := Config{
-				MinRetentionDays:       dynamicproperties.GetIntPropertyFn(1),
-				MaxRetentionDays:       dynamicproperties.GetIntPropertyFn(5),
-				RequiredDomainDataKeys: nil,
-				MaxBadBinaryCount:      dynamicproperties.GetIntPropertyFilteredByDomain(maxLength),
-				FailoverCoolDown:       func(string) time.Duration { return time.Second },
-				FailoverHistoryMaxSize: dynamicproperties.GetIntPropertyFilteredByDomain(5),
+				MinRetentionDays:         dynamicproperties.GetIntPropertyFn(1),
+				MaxRetentionDays:         dynamicproperties.GetIntPropertyFn(5),
+				RequiredDomainDataKeys:   nil,
+				MaxBadBinaryCount:        dynamicproperties.GetIntPropertyFilteredByDomain(maxLength),
+				FailoverCoolDown:         func(string) time.Duration { return time.Second },
+				FailoverHistoryMaxSize:   dynamicproperties.GetIntPropertyFilteredByDomain(5),
+				EnableDomainAuditLogging: dynamicproperties.GetBoolPropertyFn(true),
 			}
 
 			clusterMetadata := cluster.NewMetadata(
@@ -3815,6 +3828,7 @@ func TestHandler_FailoverDomain(t *testing.T) {
 
 			handler := handlerImpl{
 				domainManager:       mockDomainManager,
+				domainAuditManager:  mockDomainAuditManager,
 				clusterMetadata:     clusterMetadata,
 				domainReplicator:    mockReplicator,
 				domainAttrValidator: newAttrValidator(clusterMetadata, int32(testConfig.MinRetentionDays())),
@@ -3836,6 +3850,9 @@ func TestHandler_FailoverDomain(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, response)
 				assert.Equal(t, tc.response(mockTimeSource), response)
+				if tc.auditComment != "" {
+					assert.Equal(t, []string{tc.auditComment}, auditComments)
+				}
 			}
 		})
 	}
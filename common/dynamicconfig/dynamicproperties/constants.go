@@ -349,6 +349,15 @@ const (
 	TransactionSizeLimit
 	MaxRetentionDays
 	MinRetentionDays
+	// ArchivalRetentionInDays is the archival retention of a domain: the number of days its archived histories and
+	// visibility records are kept. The archival scanner reads it for each domain it sweeps, and deletes the records
+	// archived before the retention period. It is set per domain with the DomainName filter, the value without filter
+	// applies to the domains which have none. 0 means archived records are kept forever
+	// KeyName: system.archivalRetentionInDays
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	ArchivalRetentionInDays
 	MaxDecisionStartToCloseSeconds
 	BlobSizeLimitError
 	// BlobSizeLimitWarn is the per event blob size limit for warning
@@ -826,6 +835,12 @@ const (
 	// Default value: 2
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingIsolationGroupsPerPartition
+	// MatchingTaskPriorityWeightRatio is the ratio between the dispatch weights of two adjacent task priority levels
+	// KeyName: matching.taskPriorityWeightRatio
+	// Value type: Int
+	// Default value: 2
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskPriorityWeightRatio
 
 	// key for history
 
@@ -1464,6 +1479,24 @@ const (
 	// Value type: Int
 	// Default value: 100
 	ESAnalyzerMinNumWorkflowsForAvg
+	// ActiveClusterFailoverMaxFailoversPerRound is the max number of cluster attributes the active cluster failover controller fails over in one round
+	// KeyName: worker.activeClusterFailoverMaxFailoversPerRound
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	ActiveClusterFailoverMaxFailoversPerRound
+	// ActiveClusterFailoverUnhealthyThreshold is the number of consecutive failed health checks after which a cluster is considered unhealthy
+	// KeyName: worker.activeClusterFailoverUnhealthyThreshold
+	// Value type: Int
+	// Default value: 3
+	// Allowed filters: N/A
+	ActiveClusterFailoverUnhealthyThreshold
+	// ActiveClusterFailoverReplicationLagShardSampleSize is the number of history shards sampled to measure the replication lag to each cluster
+	// KeyName: worker.activeClusterFailoverReplicationLagShardSampleSize
+	// Value type: Int
+	// Default value: 32
+	// Allowed filters: N/A
+	ActiveClusterFailoverReplicationLagShardSampleSize
 
 	// key for shard manager
 
@@ -1539,6 +1572,18 @@ const (
 	DeleteHistoryEventContextTimeout
 
 	QueueMaxVirtualQueueCount
+	// QueueCriticalSliceCount is the critical number of virtual slices in a history queue, 0 disables the alert
+	// KeyName: history.queueCriticalSliceCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalSliceCount
+	// QueueCriticalDomainTaskRate is the critical number of tasks loaded per second for a single domain in a history queue, 0 disables the alert
+	// KeyName: history.queueCriticalDomainTaskRate
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalDomainTaskRate
 
 	// LastIntKey must be the last one in this const group
 	LastIntKey
@@ -1872,6 +1917,12 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	HistoryScannerEnabled
+	// ArchivalScannerEnabled indicates if archival scanner should be started as part of worker.Scanner
+	// KeyName: worker.archivalScannerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ArchivalScannerEnabled
 	// ConcreteExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner
 	// KeyName: worker.executionsScannerEnabled
 	// Value type: Bool
@@ -1969,6 +2020,30 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableFailoverManager
+	// EnableScheduler indicates if the worker running schedule workflows is enabled
+	// KeyName: worker.enableScheduler
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableScheduler
+	// EnableActiveClusterFailoverController indicates if the controller failing over cluster attributes of unhealthy clusters is enabled
+	// KeyName: worker.enableActiveClusterFailoverController
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableActiveClusterFailoverController
+	// ActiveClusterFailoverEnabledForDomain indicates if the active cluster failover controller can fail over the cluster attributes of a domain
+	// KeyName: worker.activeClusterFailoverEnabledForDomain
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	ActiveClusterFailoverEnabledForDomain
+	// ActiveClusterFailoverDryRun indicates if the active cluster failover controller only logs and audits its decisions without failing over
+	// KeyName: worker.activeClusterFailoverDryRun
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ActiveClusterFailoverDryRun
 	// ConcreteExecutionFixerDomainAllow is which domains are allowed to be fixed by concrete fixer workflow
 	// KeyName: worker.concreteExecutionFixerDomainAllow
 	// Value type: Bool
@@ -2114,6 +2189,15 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingEnableClientAutoConfig
+	// MatchingEnableTaskPriority enables dispatching the backlog of a tasklist by the priority of the tasks.
+	// The tasks of each priority level are persisted in a backlog of their own, which a tasklist sets up when it is loaded
+	// with the property enabled. Once it is disabled, the backlogs of the levels are read again when it is re-enabled,
+	// or removed by the tasklist scavenger once their tasks expire.
+	// KeyName: matching.enableTaskPriority
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableTaskPriority
 
 	EnableNoSQLHistoryTaskDualWriteMode
 	ReadNoSQLHistoryTaskFromDataBlob
@@ -2474,6 +2558,23 @@ const (
 	// Allowed filters: namespace
 	MigrationMode
 
+	// FrontendRBACPolicy is the policy of the RBAC authorizer, in the same YAML or JSON format as its policy file
+	// KeyName: frontend.rbacPolicy
+	// Value type: String
+	// Default value: "" => means the policy only comes from the policy file
+	// Allowed filters: N/A
+	FrontendRBACPolicy
+
+	// ActiveClusterFailoverWitnessCluster is the cluster which breaks the tie when exactly half of the clusters voting
+	// in the leader election of the active cluster failover controller are healthy. The witness is a cluster of the
+	// cluster group which hosts no active-active domain, so that a deployment of two clusters keeps failing over
+	// when either of them is lost
+	// KeyName: worker.activeClusterFailoverWitnessCluster
+	// Value type: String
+	// Default value: "" => the half with the first voting cluster by name has the quorum
+	// Allowed filters: N/A
+	ActiveClusterFailoverWitnessCluster
+
 	// LastStringKey must be the last one in this const group
 	LastStringKey
 )
@@ -2673,6 +2774,18 @@ const (
 	// Allowed filters: N/A
 	QueueProcessorPollBackoffInterval
 	VirtualSliceForceAppendInterval
+	// QueueCriticalStuckSliceDuration is the duration after which a virtual slice with pending tasks that doesn't make progress is considered stuck, 0 disables the alert
+	// KeyName: history.queueCriticalStuckSliceDuration
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalStuckSliceDuration
+	// QueueCriticalWatermarkLag is the duration after which a history queue whose ack level doesn't move is considered lagging, 0 disables the alert
+	// KeyName: history.queueCriticalWatermarkLag
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalWatermarkLag
 	// TimerProcessorUpdateAckInterval is update interval for timer processor
 	// KeyName: history.timerProcessorUpdateAckInterval
 	// Value type: Duration
@@ -2905,6 +3018,24 @@ const (
 	// Value type: Duration
 	// Default value: 30 minutes
 	ESAnalyzerBufferWaitTime
+	// ActiveClusterFailoverInterval is the interval between two rounds of the active cluster failover controller
+	// KeyName: worker.activeClusterFailoverInterval
+	// Value type: Duration
+	// Default value: 30s (30*time.Second)
+	// Allowed filters: N/A
+	ActiveClusterFailoverInterval
+	// ActiveClusterFailoverCooldown is the min time between a failover of a cluster attribute, automatic or not, and its next automatic failover
+	// KeyName: worker.activeClusterFailoverCooldown
+	// Value type: Duration
+	// Default value: 10m (10*time.Minute)
+	// Allowed filters: N/A
+	ActiveClusterFailoverCooldown
+	// ActiveClusterFailoverMaxReplicationLag is the replication lag to a cluster above which its health check fails
+	// KeyName: worker.activeClusterFailoverMaxReplicationLag
+	// Value type: Duration
+	// Default value: 5m (5*time.Minute)
+	// Allowed filters: N/A
+	ActiveClusterFailoverMaxReplicationLag
 	// IsolationGroupStateRefreshInterval
 	// KeyName: system.isolationGroupStateRefreshInterval
 	// Value type: Duration
@@ -3062,6 +3193,12 @@ const (
 	// Value type: []rpc.HeaderRule or an []interface{} containing `map[string]interface{}{"Add":bool,"Match":string}` values.
 	// Default value: forward all headers.  (this is a problematic value, and it will be changing as we reduce to a list of known values)
 	HeaderForwardingRules
+	// ActiveClusterFailoverDrainedClusters is the list of cluster names drained by operators.
+	// The active cluster failover controller fails over the cluster attributes active in these clusters
+	// KeyName: worker.activeClusterFailoverDrainedClusters
+	// Value type: []string
+	// Default value: empty
+	ActiveClusterFailoverDrainedClusters
 
 	LastListKey
 )
@@ -3114,6 +3251,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MinRetentionDays is the minimal allowed retention days for domain",
 		DefaultValue: 1,
 	},
+	ArchivalRetentionInDays: {
+		KeyName:      "system.archivalRetentionInDays",
+		Filters:      []Filter{DomainName},
+		Description:  "ArchivalRetentionInDays is the number of days the archived histories and visibility records of a domain are kept before the archival scanner deletes them, 0 means archived records are kept forever",
+		DefaultValue: 0,
+	},
 	MaxDecisionStartToCloseSeconds: {
 		KeyName:      "system.maxDecisionStartToCloseSeconds",
 		Description:  "MaxDecisionStartToCloseSeconds is the maximum allowed value for decision start to close timeout in seconds",
@@ -3511,6 +3654,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingIsolationGroupsPerPartition is the target number of isolation groups to assign to each partition",
 		DefaultValue: 2,
 	},
+	MatchingTaskPriorityWeightRatio: {
+		KeyName:      "matching.taskPriorityWeightRatio",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingTaskPriorityWeightRatio is the ratio between the dispatch weights of two adjacent task priority levels, the lowest level always has a weight of 1",
+		DefaultValue: 2,
+	},
 	HistoryRPS: {
 		KeyName:      "history.rps",
 		Description:  "HistoryRPS is request rate per second for each history host",
@@ -4073,6 +4222,21 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "ESAnalyzerMinNumWorkflowsForAvg controls how many workflows to have at least to rely on workflow run time avg per type",
 		DefaultValue: 100,
 	},
+	ActiveClusterFailoverMaxFailoversPerRound: {
+		KeyName:      "worker.activeClusterFailoverMaxFailoversPerRound",
+		Description:  "ActiveClusterFailoverMaxFailoversPerRound is the max number of cluster attributes the active cluster failover controller fails over in one round",
+		DefaultValue: 10,
+	},
+	ActiveClusterFailoverUnhealthyThreshold: {
+		KeyName:      "worker.activeClusterFailoverUnhealthyThreshold",
+		Description:  "ActiveClusterFailoverUnhealthyThreshold is the number of consecutive failed health checks after which a cluster is considered unhealthy",
+		DefaultValue: 3,
+	},
+	ActiveClusterFailoverReplicationLagShardSampleSize: {
+		KeyName:      "worker.activeClusterFailoverReplicationLagShardSampleSize",
+		Description:  "ActiveClusterFailoverReplicationLagShardSampleSize is the number of history shards sampled to measure the replication lag to each cluster",
+		DefaultValue: 32,
+	},
 	ShardManagerPersistenceMaxQPS: {
 		KeyName:      "shardManager.persistenceMaxQPS",
 		Description:  "ShardManagerPersistenceMaxQPS is the max qps shard manager host can query DB",
@@ -4138,6 +4302,16 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "QueueMaxVirtualQueueCount is the max number of virtual queues",
 		DefaultValue: 2,
 	},
+	QueueCriticalSliceCount: {
+		KeyName:      "history.queueCriticalSliceCount",
+		Description:  "QueueCriticalSliceCount is the critical number of virtual slices in a history queue, 0 disables the alert",
+		DefaultValue: 0,
+	},
+	QueueCriticalDomainTaskRate: {
+		KeyName:      "history.queueCriticalDomainTaskRate",
+		Description:  "QueueCriticalDomainTaskRate is the critical number of tasks loaded per second for a single domain in a history queue, 0 disables the alert",
+		DefaultValue: 0,
+	},
 }
 
 var BoolKeys = map[BoolKey]DynamicBool{
@@ -4479,6 +4653,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "HistoryScannerEnabled indicates if history scanner should be started as part of worker.Scanner",
 		DefaultValue: false,
 	},
+	ArchivalScannerEnabled: {
+		KeyName:      "worker.archivalScannerEnabled",
+		Description:  "ArchivalScannerEnabled indicates if archival scanner should be started as part of worker.Scanner",
+		DefaultValue: false,
+	},
 	ConcreteExecutionsScannerEnabled: {
 		KeyName:      "worker.executionsScannerEnabled",
 		Description:  "ConcreteExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner",
@@ -4560,6 +4739,27 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableFailoverManager indicates if failover manager is enabled",
 		DefaultValue: true,
 	},
+	EnableScheduler: {
+		KeyName:      "worker.enableScheduler",
+		Description:  "EnableScheduler indicates if the worker running schedule workflows is enabled",
+		DefaultValue: true,
+	},
+	EnableActiveClusterFailoverController: {
+		KeyName:      "worker.enableActiveClusterFailoverController",
+		Description:  "EnableActiveClusterFailoverController indicates if the controller failing over cluster attributes of unhealthy clusters is enabled",
+		DefaultValue: false,
+	},
+	ActiveClusterFailoverEnabledForDomain: {
+		KeyName:      "worker.activeClusterFailoverEnabledForDomain",
+		Filters:      []Filter{DomainName},
+		Description:  "ActiveClusterFailoverEnabledForDomain indicates if the active cluster failover controller can fail over the cluster attributes of a domain",
+		DefaultValue: false,
+	},
+	ActiveClusterFailoverDryRun: {
+		KeyName:      "worker.activeClusterFailoverDryRun",
+		Description:  "ActiveClusterFailoverDryRun indicates if the active cluster failover controller only logs and audits its decisions without failing over",
+		DefaultValue: false,
+	},
 	ConcreteExecutionFixerDomainAllow: {
 		KeyName:      "worker.concreteExecutionFixerDomainAllow",
 		Filters:      []Filter{DomainName},
@@ -4688,6 +4888,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnableClientAutoConfig is to enable auto config on worker side",
 		DefaultValue: false,
 	},
+	MatchingEnableTaskPriority: {
+		KeyName:      "matching.enableTaskPriority",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableTaskPriority is to enable dispatching the backlog of a tasklist by the priority of the tasks, which persists the tasks of each priority level in a backlog of its own. It takes effect when a tasklist is loaded",
+		DefaultValue: false,
+	},
 	EnablePartitionIsolationGroupAssignment: {
 		KeyName:      "matching.enablePartitionIsolationGroupAssignment",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -5029,6 +5235,16 @@ var StringKeys = map[StringKey]DynamicString{
 		DefaultValue: "onboarded",
 		Filters:      []Filter{Namespace},
 	},
+	FrontendRBACPolicy: {
+		KeyName:      "frontend.rbacPolicy",
+		Description:  "FrontendRBACPolicy is the policy of the RBAC authorizer, in the same YAML or JSON format as its policy file",
+		DefaultValue: "",
+	},
+	ActiveClusterFailoverWitnessCluster: {
+		KeyName:      "worker.activeClusterFailoverWitnessCluster",
+		Description:  "ActiveClusterFailoverWitnessCluster is the cluster which breaks the tie when exactly half of the clusters voting in the leader election of the active cluster failover controller are healthy",
+		DefaultValue: "",
+	},
 }
 
 var DurationKeys = map[DurationKey]DynamicDuration{
@@ -5253,6 +5469,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "VirtualSliceForceAppendInterval is the duration forcing a new virtual slice to be appended to the root virtual queue instead of being merged. It has 2 benefits: First, virtual slices won't grow infinitely, task loading for that slice can complete and its scope can be shrinked. Second, when we need to unload a virtual slice to free memory, we won't unload too many tasks.",
 		DefaultValue: time.Minute * 5,
 	},
+	QueueCriticalStuckSliceDuration: {
+		KeyName:      "history.queueCriticalStuckSliceDuration",
+		Description:  "QueueCriticalStuckSliceDuration is the duration after which a virtual slice with pending tasks that doesn't make progress is considered stuck, 0 disables the alert",
+		DefaultValue: time.Duration(0),
+	},
+	QueueCriticalWatermarkLag: {
+		KeyName:      "history.queueCriticalWatermarkLag",
+		Description:  "QueueCriticalWatermarkLag is the duration after which a history queue whose ack level doesn't move is considered lagging, 0 disables the alert",
+		DefaultValue: time.Duration(0),
+	},
 	TimerProcessorUpdateAckInterval: {
 		KeyName:      "history.timerProcessorUpdateAckInterval",
 		Description:  "TimerProcessorUpdateAckInterval is update interval for timer processor",
@@ -5469,6 +5695,21 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ESAnalyzerBufferWaitTime controls min time required to consider a worklow stuck",
 		DefaultValue: time.Minute * 30,
 	},
+	ActiveClusterFailoverInterval: {
+		KeyName:      "worker.activeClusterFailoverInterval",
+		Description:  "ActiveClusterFailoverInterval is the interval between two rounds of the active cluster failover controller",
+		DefaultValue: time.Second * 30,
+	},
+	ActiveClusterFailoverCooldown: {
+		KeyName:      "worker.activeClusterFailoverCooldown",
+		Description:  "ActiveClusterFailoverCooldown is the min time between a failover of a cluster attribute, automatic or not, and its next automatic failover",
+		DefaultValue: time.Minute * 10,
+	},
+	ActiveClusterFailoverMaxReplicationLag: {
+		KeyName:      "worker.activeClusterFailoverMaxReplicationLag",
+		Description:  "ActiveClusterFailoverMaxReplicationLag is the replication lag to a cluster above which its health check fails",
+		DefaultValue: time.Minute * 5,
+	},
 	AsyncTaskDispatchTimeout: {
 		KeyName:      "matching.asyncTaskDispatchTimeout",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -5590,6 +5831,10 @@ var ListKeys = map[ListKey]DynamicList{
 			},
 		},
 	},
+	ActiveClusterFailoverDrainedClusters: {
+		KeyName:     "worker.activeClusterFailoverDrainedClusters",
+		Description: "ActiveClusterFailoverDrainedClusters is the list of cluster names drained by operators. The active cluster failover controller fails over the cluster attributes active in these clusters",
+	},
 }
 
 var _keyNames map[string]Key
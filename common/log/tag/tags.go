@@ -597,6 +597,26 @@ func RequestType(requestType string) Tag {
 	return newStringTag("request-type", requestType)
 }
 
+// ActorGroups returns tag for the groups of the actor making this request
+func ActorGroups(groups []string) Tag {
+	return newObjectTag("actor-groups", groups)
+}
+
+// AuthorizationPermission returns tag for the permission a request needs
+func AuthorizationPermission(permission string) Tag {
+	return newStringTag("authorization-permission", permission)
+}
+
+// AuthorizationDecision returns tag for the decision of the authorizer (allow, deny)
+func AuthorizationDecision(decision string) Tag {
+	return newStringTag("authorization-decision", decision)
+}
+
+// AuthorizationRule returns tag for the policy rule which made an authorization decision
+func AuthorizationRule(rule string) Tag {
+	return newStringTag("authorization-rule", rule)
+}
+
 // history engine shard
 
 // ShardID returns tag for ShardID
@@ -32,10 +32,16 @@ var (
 	WorkflowActionWorkflowContinueAsNew = workflowAction("add-workflow-continue-as-new-event")
 
 	// workflow cancellation / sign
-	WorkflowActionWorkflowCancelRequested        = workflowAction("add-workflow-cancel-requested-event")
-	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
-	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
-	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionWorkflowCancelRequested         = workflowAction("add-workflow-cancel-requested-event")
+	WorkflowActionWorkflowSignaled                = workflowAction("add-workflow-signaled-event")
+	WorkflowActionWorkflowRecordMarker            = workflowAction("add-workflow-marker-record-event")
+	WorkflowActionUpsertWorkflowSearchAttributes  = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionWorkflowSearchAttributesUpdated = workflowAction("add-workflow-search-attributes-updated-event")
+	WorkflowActionWorkflowPaused                  = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowUnpaused                = workflowAction("add-workflow-unpaused-event")
+	WorkflowActionWorkflowUpdateAccepted          = workflowAction("add-workflow-update-accepted-event")
+	WorkflowActionWorkflowUpdateRejected          = workflowAction("add-workflow-update-rejected-event")
+	WorkflowActionWorkflowUpdateCompleted         = workflowAction("add-workflow-update-completed-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -58,6 +64,8 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskPaused          = workflowAction("add-activitytask-paused-event")
+	WorkflowActionActivityTaskUnpaused        = workflowAction("add-activitytask-unpaused-event")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -127,6 +135,7 @@ var (
 	ComponentESVisibilityManager              = component("es-visibility-manager")
 	ComponentArchiver                         = component("archiver")
 	ComponentBatcher                          = component("batcher")
+	ComponentScheduler                        = component("scheduler")
 	ComponentWorker                           = component("worker")
 	ComponentServiceResolver                  = component("service-resolver")
 	ComponentFailoverCoordinator              = component("failover-coordinator")
@@ -142,11 +151,16 @@ var (
 	ComponentMapQ                             = component("mapq")
 	ComponentMapQTree                         = component("mapq-tree")
 	ComponentMapQTreeNode                     = component("mapq-tree-node")
+	ComponentMapQDispatcher                   = component("mapq-dispatcher")
+	ComponentAuthorizationAudit               = component("authorization-audit")
 	ComponentRPCFactory                       = component("rpc-factory")
 	ComponentTaskListAdaptiveScaler           = component("task-list-adaptive-scaler")
 	ComponentActiveClusterManager             = component("active-cluster-manager")
+	ComponentActiveClusterFailover            = component("active-cluster-failover-controller")
+	ComponentArchiveRestorer                  = component("archive-restorer")
 	ComponentMembershipResolver               = component("membership-resolver")
 	ComponentHashring                         = component("hashring")
+	ComponentPeerProvider                     = component("peer-provider")
 	ComponentNamespaceManager                 = component("shard-namespace-manager")
 	ComponentLeaderElection                   = component("shard-leader-election")
 	ComponentLeaderProcessor                  = component("shard-leader-processor")
@@ -384,6 +398,18 @@ var (
 	FrontendClientOperationStartWorkflowExecution                = clientOperation("frontend-start-wf-execution")
 	FrontendClientOperationStartWorkflowExecutionAsync           = clientOperation("frontend-start-wf-execution-async")
 	FrontendClientOperationTerminateWorkflowExecution            = clientOperation("frontend-terminate-wf-execution")
+	FrontendClientOperationPauseWorkflowExecution                = clientOperation("frontend-pause-wf-execution")
+	FrontendClientOperationUnpauseWorkflowExecution              = clientOperation("frontend-unpause-wf-execution")
+	FrontendClientOperationResetActivity                         = clientOperation("frontend-reset-activity")
+	FrontendClientOperationUpdateWorkflowExecution               = clientOperation("frontend-update-wf-execution")
+	FrontendClientOperationCreateSchedule                        = clientOperation("frontend-create-schedule")
+	FrontendClientOperationDescribeSchedule                      = clientOperation("frontend-describe-schedule")
+	FrontendClientOperationUpdateSchedule                        = clientOperation("frontend-update-schedule")
+	FrontendClientOperationPauseSchedule                         = clientOperation("frontend-pause-schedule")
+	FrontendClientOperationResumeSchedule                        = clientOperation("frontend-resume-schedule")
+	FrontendClientOperationBackfillSchedule                      = clientOperation("frontend-backfill-schedule")
+	FrontendClientOperationDeleteSchedule                        = clientOperation("frontend-delete-schedule")
+	FrontendClientOperationListSchedules                         = clientOperation("frontend-list-schedules")
 	FrontendClientOperationUpdateDomain                          = clientOperation("frontend-update-domain")
 	FrontendClientOperationFailoverDomain                        = clientOperation("frontend-failover-domain")
 	FrontendClientOperationListFailoverHistory                   = clientOperation("frontend-list-failover-history")
@@ -430,6 +456,11 @@ var (
 	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
 	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
 	HistoryClientOperationRefreshWorkflowTasks              = clientOperation("history-refresh-wf-tasks")
+	HistoryClientOperationUpdateWorkflowSearchAttributes    = clientOperation("history-update-wf-search-attributes")
+	HistoryClientOperationPauseWorkflowExecution            = clientOperation("history-pause-wf-execution")
+	HistoryClientOperationUnpauseWorkflowExecution          = clientOperation("history-unpause-wf-execution")
+	HistoryClientOperationResetActivity                     = clientOperation("history-reset-activity")
+	HistoryClientOperationUpdateWorkflowExecution           = clientOperation("history-update-wf-execution")
 	HistoryClientOperationNotifyFailoverMarkers             = clientOperation("history-notify-failover-markers")
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
@@ -24,10 +24,13 @@ package mapq
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/mapq/tree"
 	"github.com/uber/cadence/common/mapq/types"
 	"github.com/uber/cadence/common/metrics"
@@ -41,6 +44,13 @@ type clientImpl struct {
 	tree            *tree.QueueTree
 	partitions      []string
 	policies        []types.NodePolicy
+	commitInterval  time.Duration
+	// dispatchCapacity is the number of dispatched and not yet ack'ed items of all queues, 0 means no limit
+	dispatchCapacity int
+
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+	wg        sync.WaitGroup
 }
 
 func (c *clientImpl) Start(ctx context.Context) error {
@@ -50,6 +60,9 @@ func (c *clientImpl) Start(ctx context.Context) error {
 		return err
 	}
 
+	c.wg.Add(1)
+	go c.commitLoop()
+
 	c.logger.Info("Started MAPQ client")
 	return nil
 }
@@ -57,6 +70,12 @@ func (c *clientImpl) Start(ctx context.Context) error {
 func (c *clientImpl) Stop(ctx context.Context) error {
 	c.logger.Info("Stopping MAPQ client")
 
+	// Stop the periodic offset commits
+	c.cancelCtx()
+	if !common.AwaitWaitGroup(&c.wg, time.Minute) {
+		return fmt.Errorf("failed to stop offset committer")
+	}
+
 	// Stop the tree which will stop the dispatchers
 	if err := c.tree.Stop(ctx); err != nil {
 		return fmt.Errorf("failed to stop tree: %w", err)
@@ -68,6 +87,11 @@ func (c *clientImpl) Stop(ctx context.Context) error {
 		return fmt.Errorf("failed to stop consumer factory: %w", err)
 	}
 
+	// persist the offsets including the acks of the stopped consumers
+	if err := c.tree.CommitOffsets(ctx); err != nil {
+		return err
+	}
+
 	c.logger.Info("Stopped MAPQ client")
 	return nil
 }
@@ -76,10 +100,27 @@ func (c *clientImpl) Enqueue(ctx context.Context, items []types.Item) ([]types.I
 	return c.tree.Enqueue(ctx, items)
 }
 
-func (c *clientImpl) Ack(context.Context, types.Item) error {
-	return errors.New("not implemented")
+func (c *clientImpl) Ack(ctx context.Context, item types.Item) error {
+	return c.tree.Ack(ctx, item)
+}
+
+func (c *clientImpl) Nack(ctx context.Context, item types.Item) error {
+	return c.tree.Nack(ctx, item)
 }
 
-func (c *clientImpl) Nack(context.Context, types.Item) error {
-	return errors.New("not implemented")
+func (c *clientImpl) commitLoop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.commitInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.tree.CommitOffsets(c.ctx); err != nil {
+				c.logger.Error("Failed to commit offsets", tag.Error(err))
+			}
+		}
+	}
 }
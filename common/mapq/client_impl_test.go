@@ -24,14 +24,20 @@ package mapq
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/mapq/types"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 )
 
 func TestNew(t *testing.T) {
@@ -56,6 +62,32 @@ func TestNew(t *testing.T) {
 				WithConsumerFactory(types.NewMockConsumerFactory(ctrl)),
 			},
 		},
+		{
+			name:    "invalid commit interval",
+			wantErr: true,
+			opts: []Options{
+				WithPersister(types.NewMockPersister(ctrl)),
+				WithConsumerFactory(types.NewMockConsumerFactory(ctrl)),
+				WithCommitInterval(0),
+			},
+		},
+		{
+			name: "with dispatch capacity",
+			opts: []Options{
+				WithPersister(types.NewMockPersister(ctrl)),
+				WithConsumerFactory(types.NewMockConsumerFactory(ctrl)),
+				WithDispatchCapacity(100),
+			},
+		},
+		{
+			name:    "negative dispatch capacity",
+			wantErr: true,
+			opts: []Options{
+				WithPersister(types.NewMockPersister(ctrl)),
+				WithConsumerFactory(types.NewMockConsumerFactory(ctrl)),
+				WithDispatchCapacity(-1),
+			},
+		},
 		{
 			name:    "no consumer factoru",
 			wantErr: true,
@@ -93,8 +125,12 @@ func TestStartStop(t *testing.T) {
 	consumer := types.NewMockConsumer(ctrl)
 	consumerFactory.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
 	consumerFactory.EXPECT().New(gomock.Any()).Return(consumer, nil).Times(1)
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(nil, nil).Times(1)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	persister.EXPECT().CommitOffsets(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	opts := []Options{
-		WithPersister(types.NewMockPersister(ctrl)),
+		WithPersister(persister),
 		WithConsumerFactory(consumerFactory),
 	}
 	logger := testlogger.New(t)
@@ -114,8 +150,12 @@ func TestAck(t *testing.T) {
 	consumer := types.NewMockConsumer(ctrl)
 	consumerFactory.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
 	consumerFactory.EXPECT().New(gomock.Any()).Return(consumer, nil).Times(1)
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(nil, nil).Times(1)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	persister.EXPECT().CommitOffsets(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	opts := []Options{
-		WithPersister(types.NewMockPersister(ctrl)),
+		WithPersister(persister),
 		WithConsumerFactory(consumerFactory),
 	}
 	logger := testlogger.New(t)
@@ -129,8 +169,14 @@ func TestAck(t *testing.T) {
 	defer cl.Stop(context.Background())
 
 	err = cl.Ack(context.Background(), nil)
-	if err == nil || err.Error() != "not implemented" {
-		t.Errorf("Ack() error: %q, want %q", err, "not implemented")
+	if err == nil || err.Error() != "item is nil" {
+		t.Errorf("Ack() error: %q, want %q", err, "item is nil")
+	}
+
+	// acking an item which was not dispatched has no effect
+	err = cl.Ack(context.Background(), newTransferItem("d1", 1, persistence.TransferTaskTypeDecisionTask))
+	if err != nil {
+		t.Errorf("Ack() error: %v", err)
 	}
 }
 
@@ -140,8 +186,12 @@ func TestNack(t *testing.T) {
 	consumer := types.NewMockConsumer(ctrl)
 	consumerFactory.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
 	consumerFactory.EXPECT().New(gomock.Any()).Return(consumer, nil).Times(1)
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(nil, nil).Times(1)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	persister.EXPECT().CommitOffsets(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	opts := []Options{
-		WithPersister(types.NewMockPersister(ctrl)),
+		WithPersister(persister),
 		WithConsumerFactory(consumerFactory),
 	}
 	logger := testlogger.New(t)
@@ -155,7 +205,158 @@ func TestNack(t *testing.T) {
 	defer cl.Stop(context.Background())
 
 	err = cl.Nack(context.Background(), nil)
-	if err == nil || err.Error() != "not implemented" {
-		t.Errorf("Ack() error: %q, want %q", err, "not implemented")
+	if err == nil || err.Error() != "item is nil" {
+		t.Errorf("Nack() error: %q, want %q", err, "item is nil")
+	}
+
+	// nacking an item which was not dispatched has no effect
+	err = cl.Nack(context.Background(), newTransferItem("d1", 1, persistence.TransferTaskTypeDecisionTask))
+	if err != nil {
+		t.Errorf("Nack() error: %v", err)
+	}
+}
+
+func TestConsumeAndRestart(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	persister := &InMemoryPersister{}
+	consumerFactory := &ackingConsumerFactory{}
+	newClient := func() types.Client {
+		cl, err := New(
+			testlogger.New(t),
+			metrics.NoopScope,
+			WithPersister(persister),
+			WithConsumerFactory(consumerFactory),
+			WithPartitions([]string{"domain"}),
+			WithPolicies([]types.NodePolicy{
+				{
+					Path:        "*",
+					SplitPolicy: &types.SplitPolicy{PredefinedSplits: []any{"d1"}},
+				},
+				{Path: "*/.", SplitPolicy: &types.SplitPolicy{Disabled: true}},
+				{Path: "*/*", SplitPolicy: &types.SplitPolicy{Disabled: true}},
+			}),
+			WithCommitInterval(time.Millisecond),
+		)
+		if err != nil {
+			t.Fatalf("New() error: %v", err)
+		}
+		consumerFactory.client = cl
+		return cl
+	}
+
+	// items with offset 3 are never ack'ed
+	consumerFactory.shouldAck = func(item types.Item) bool { return item.Offset() != 3 }
+	cl := newClient()
+	if err := cl.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error: %v", err)
+	}
+
+	var items []types.Item
+	for i := int64(1); i <= 5; i++ {
+		items = append(items, newTransferItem("d1", i, persistence.TransferTaskTypeDecisionTask), newTransferItem("d2", i, persistence.TransferTaskTypeDecisionTask))
+	}
+	if _, err := cl.Enqueue(context.Background(), items); err != nil {
+		t.Fatalf("Enqueue() error: %v", err)
+	}
+
+	consumerFactory.waitForProcessed(t, 10)
+	if err := cl.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() error: %v", err)
+	}
+
+	offsets, _ := persister.GetOffsets(context.Background())
+	wantOffsets := map[string]int64{"*/d1": 2, "*/*": 2}
+	if diff := cmp.Diff(wantOffsets, offsets.CommittedOffsets); diff != "" {
+		t.Errorf("Committed offsets mismatch (-want +got):\n%s", diff)
+	}
+
+	// after restart only the items after the committed offsets are dispatched again
+	consumerFactory.reset()
+	consumerFactory.shouldAck = func(types.Item) bool { return true }
+	cl = newClient()
+	if err := cl.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error: %v", err)
+	}
+
+	consumerFactory.waitForProcessed(t, 6)
+	if err := cl.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() error: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"d1-3", "d1-4", "d1-5", "d2-3", "d2-4", "d2-5"}, consumerFactory.processedItems()); diff != "" {
+		t.Errorf("Processed items mismatch (-want +got):\n%s", diff)
+	}
+
+	offsets, _ = persister.GetOffsets(context.Background())
+	wantOffsets = map[string]int64{"*/d1": 5, "*/*": 5}
+	if diff := cmp.Diff(wantOffsets, offsets.CommittedOffsets); diff != "" {
+		t.Errorf("Committed offsets mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// ackingConsumerFactory creates consumers which ack the processed items through the client
+type ackingConsumerFactory struct {
+	sync.Mutex
+	client    types.Client
+	shouldAck func(types.Item) bool
+	processed []string
+}
+
+func (f *ackingConsumerFactory) New(types.ItemPartitions) (types.Consumer, error) {
+	return &ackingConsumer{factory: f}, nil
+}
+
+func (f *ackingConsumerFactory) Stop(context.Context) error {
+	return nil
+}
+
+func (f *ackingConsumerFactory) reset() {
+	f.Lock()
+	defer f.Unlock()
+	f.processed = nil
+}
+
+func (f *ackingConsumerFactory) processedItems() []string {
+	f.Lock()
+	defer f.Unlock()
+	result := append([]string(nil), f.processed...)
+	sort.Strings(result)
+	return result
+}
+
+func (f *ackingConsumerFactory) waitForProcessed(t *testing.T, count int) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if len(f.processedItems()) >= count {
+			return
+		}
+	}
+	t.Fatalf("Expected %d processed items, got %v", count, f.processedItems())
+}
+
+type ackingConsumer struct {
+	factory *ackingConsumerFactory
+}
+
+func (c *ackingConsumer) Start(context.Context) error {
+	return nil
+}
+
+func (c *ackingConsumer) Stop(context.Context) error {
+	return nil
+}
+
+func (c *ackingConsumer) Process(ctx context.Context, item types.Item) error {
+	c.factory.Lock()
+	ti := item.(types.ItemToPersist)
+	c.factory.processed = append(c.factory.processed, fmt.Sprintf("%v-%d", ti.GetAttribute("domain"), item.Offset()))
+	shouldAck := c.factory.shouldAck(item)
+	cl := c.factory.client
+	c.factory.Unlock()
+
+	if shouldAck {
+		return cl.Ack(ctx, item)
 	}
+	return nil
 }
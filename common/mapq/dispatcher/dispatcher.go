@@ -19,7 +19,6 @@
 // LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
 // SOFTWARE.
-
 package dispatcher
 
 import (
@@ -28,24 +27,142 @@ import (
 	"sync"
 	"time"
 
+	"golang.org/x/time/rate"
+
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	defaultConcurrency  = 100
+	defaultPageSize     = 100
+	defaultPollInterval = time.Second
 )
 
+type Options func(*Dispatcher)
+
+// WithPageSize sets the maximum number of items fetched from the persister at once.
+func WithPageSize(pageSize int) Options {
+	return func(d *Dispatcher) {
+		d.pageSize = pageSize
+	}
+}
+
+// WithPollInterval sets how often the persister is checked for new items when the dispatcher is not notified about them.
+func WithPollInterval(interval time.Duration) Options {
+	return func(d *Dispatcher) {
+		d.pollInterval = interval
+	}
+}
+
+// WithFairScheduler makes the dispatcher share the dispatch slots of the scheduler with the other queues
+// using it, by the weight of its dispatch policy.
+func WithFairScheduler(scheduler *FairScheduler) Options {
+	return func(d *Dispatcher) {
+		d.scheduler = scheduler
+	}
+}
+
+// WithMetricsScope sets the scope of the dispatch metrics of the queue.
+func WithMetricsScope(scope metrics.Scope) Options {
+	return func(d *Dispatcher) {
+		d.scope = scope
+	}
+}
+
+// Dispatcher fetches the items of a leaf queue from the persister and pushes them to the consumer.
+// It keeps track of the dispatched items until they are ack'ed so that the committed offset
+// of the queue never goes past an item which is not processed yet.
+// Items are pushed at most at the DispatchRPS of the policy, and if the dispatcher has a fair scheduler
+// each item holds one of its slots until it is ack'ed.
 type Dispatcher struct {
-	consumer  types.Consumer
-	ctx       context.Context
-	cancelCtx context.CancelFunc
-	wg        sync.WaitGroup
+	logger       log.Logger
+	scope        metrics.Scope
+	consumer     types.Consumer
+	persister    types.Persister
+	partitions   types.ItemPartitions
+	queue        string
+	concurrency  int
+	weight       int
+	limiter      clock.Ratelimiter
+	scheduler    *FairScheduler
+	pageSize     int
+	pollInterval time.Duration
+	notifyCh     chan struct{}
+	ctx          context.Context
+	cancelCtx    context.CancelFunc
+	wg           sync.WaitGroup
+
+	mu sync.Mutex
+	// readOffset is the offset of the last item fetched from the persister
+	readOffset int64
+	// committedOffset is the offset up to which all items are ack'ed
+	committedOffset int64
+	// inflight contains the fetched items which are not ack'ed yet ordered by offset
+	inflight         []*inflightItem
+	inflightByOffset map[int64]*inflightItem
+	// nacked contains the items to dispatch again
+	nacked []*inflightItem
 }
 
-func New(c types.Consumer) *Dispatcher {
+type inflightItem struct {
+	item   types.Item
+	acked  bool
+	nacked bool
+	// release releases the slot of the fair scheduler held by the item
+	release func()
+}
+
+// New creates a dispatcher for the leaf queue with the given partitions.
+// Dispatching starts from the item after committedOffset.
+// At most policy.Concurrency items are dispatched without being ack'ed, at most policy.DispatchRPS per second.
+func New(
+	logger log.Logger,
+	consumer types.Consumer,
+	persister types.Persister,
+	partitions types.ItemPartitions,
+	policy types.DispatchPolicy,
+	committedOffset int64,
+	opts ...Options,
+) *Dispatcher {
 	ctx, cancelCtx := context.WithCancel(context.Background())
-	return &Dispatcher{
-		consumer:  c,
-		ctx:       ctx,
-		cancelCtx: cancelCtx,
+	d := &Dispatcher{
+		logger:           logger.WithTags(tag.ComponentMapQDispatcher),
+		scope:            metrics.NoopScope,
+		consumer:         consumer,
+		persister:        persister,
+		partitions:       partitions,
+		queue:            types.QueuePath(partitions),
+		concurrency:      policy.Concurrency,
+		weight:           policy.Weight,
+		pageSize:         defaultPageSize,
+		pollInterval:     defaultPollInterval,
+		notifyCh:         make(chan struct{}, 1),
+		ctx:              ctx,
+		cancelCtx:        cancelCtx,
+		readOffset:       committedOffset,
+		committedOffset:  committedOffset,
+		inflightByOffset: map[int64]*inflightItem{},
+	}
+	if d.concurrency <= 0 {
+		d.concurrency = defaultConcurrency
+	}
+	if d.weight <= 0 {
+		d.weight = 1
+	}
+	if policy.DispatchRPS > 0 {
+		d.limiter = clock.NewRatelimiter(rate.Limit(policy.DispatchRPS), 1)
 	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
 }
 
 func (d *Dispatcher) Start(ctx context.Context) error {
@@ -63,10 +180,259 @@ func (d *Dispatcher) Stop(ctx context.Context) error {
 	if !common.AwaitWaitGroup(&d.wg, timeout) {
 		return fmt.Errorf("failed to stop dispatcher in %v", timeout)
 	}
+
+	// the items which are not ack'ed are dispatched again after a restart, so their slots are given back
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, entry := range d.inflight {
+		if entry.release != nil {
+			entry.release()
+		}
+	}
 	return nil
 }
 
+// Notify wakes up the dispatcher to fetch the items which were just persisted.
+func (d *Dispatcher) Notify() {
+	select {
+	case d.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Ack marks the item as processed. Acking an item which was not dispatched or is already ack'ed has no effect.
+// It returns false if the item is not one of the dispatched items of the queue.
+func (d *Dispatcher) Ack(item types.Item) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.inflightByOffset[item.Offset()]
+	if !ok {
+		return false
+	}
+	if entry.acked {
+		return true
+	}
+	entry.acked = true
+	if entry.release != nil {
+		entry.release()
+	}
+
+	advanced := false
+	for len(d.inflight) > 0 && d.inflight[0].acked {
+		d.committedOffset = d.inflight[0].item.Offset()
+		delete(d.inflightByOffset, d.committedOffset)
+		d.inflight[0] = nil
+		d.inflight = d.inflight[1:]
+		advanced = true
+	}
+	if advanced {
+		d.scope.UpdateGauge(metrics.MapQInflightItems, float64(len(d.inflight)))
+		// more items may be fetched now
+		d.Notify()
+	}
+	return true
+}
+
+// Nack schedules the item to be dispatched again. Nacking an item which was not dispatched or is already ack'ed has no effect.
+// It returns false if the item is not one of the dispatched items of the queue.
+func (d *Dispatcher) Nack(item types.Item) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.inflightByOffset[item.Offset()]
+	if !ok {
+		return false
+	}
+	if entry.acked || entry.nacked {
+		return true
+	}
+	entry.nacked = true
+	d.nacked = append(d.nacked, entry)
+	return true
+}
+
+// CommittedOffset returns the offset up to which all items of the queue are ack'ed.
+func (d *Dispatcher) CommittedOffset() int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.committedOffset
+}
+
+// Drained returns true if all the items of the queue are ack'ed. Items persisted while it is called may be missed.
+func (d *Dispatcher) Drained(ctx context.Context) (bool, error) {
+	d.mu.Lock()
+	inflight := len(d.inflight)
+	committedOffset := d.committedOffset
+	d.mu.Unlock()
+	if inflight > 0 {
+		return false, nil
+	}
+
+	items, err := d.persister.Fetch(ctx, d.partitions, types.PageInfo{
+		ExclusiveStartOffset: committedOffset,
+		PageSize:             1,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(items) == 0, nil
+}
+
 func (d *Dispatcher) run() {
 	defer d.wg.Done()
-	// TODO: implement
+	d.logger.Info("Dispatcher started", tag.Dynamic("partitions", d.partitions.String()))
+	defer d.logger.Info("Dispatcher stopped", tag.Dynamic("partitions", d.partitions.String()))
+
+	for {
+		d.redispatch()
+
+		fullPage, err := d.fetch()
+		if err != nil {
+			d.logger.Error("Failed to fetch items", tag.Error(err))
+		}
+
+		if err == nil && fullPage {
+			// there may be more items to fetch right away
+			select {
+			case <-d.ctx.Done():
+				return
+			default:
+				continue
+			}
+		}
+
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-d.notifyCh:
+		case <-time.After(d.pollInterval):
+		}
+	}
+}
+
+// fetch fetches the next page of items if the number of inflight items allows it, and dispatches them.
+// It returns true if a full page was fetched.
+func (d *Dispatcher) fetch() (bool, error) {
+	d.mu.Lock()
+	pageSize := d.concurrency - len(d.inflight)
+	if pageSize > d.pageSize {
+		pageSize = d.pageSize
+	}
+	startOffset := d.readOffset
+	d.mu.Unlock()
+
+	if pageSize <= 0 {
+		return false, nil
+	}
+
+	items, err := d.persister.Fetch(d.ctx, d.partitions, types.PageInfo{
+		ExclusiveStartOffset: startOffset,
+		PageSize:             pageSize,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	toDispatch := make([]*inflightItem, 0, len(items))
+	d.mu.Lock()
+	for _, item := range items {
+		if item.Offset() <= d.readOffset {
+			// the persister must return items ordered by offset after the start offset
+			d.logger.Warn("Skipping item fetched out of order", tag.Dynamic("item", item.String()), tag.Dynamic("read-offset", d.readOffset))
+			continue
+		}
+		entry := &inflightItem{item: item}
+		d.inflight = append(d.inflight, entry)
+		d.inflightByOffset[item.Offset()] = entry
+		d.readOffset = item.Offset()
+		toDispatch = append(toDispatch, entry)
+	}
+	d.scope.UpdateGauge(metrics.MapQInflightItems, float64(len(d.inflight)))
+	d.mu.Unlock()
+
+	for _, entry := range toDispatch {
+		d.dispatch(entry, false)
+	}
+
+	return len(items) == pageSize, nil
+}
+
+// redispatch dispatches the nacked items which are not ack'ed in the meantime.
+func (d *Dispatcher) redispatch() {
+	d.mu.Lock()
+	var toDispatch []*inflightItem
+	for _, entry := range d.nacked {
+		entry.nacked = false
+		if !entry.acked {
+			toDispatch = append(toDispatch, entry)
+		}
+	}
+	d.nacked = nil
+	d.mu.Unlock()
+
+	for _, entry := range toDispatch {
+		d.dispatch(entry, true)
+	}
+}
+
+func (d *Dispatcher) dispatch(entry *inflightItem, redispatch bool) {
+	if d.ctx.Err() != nil {
+		return
+	}
+
+	if !d.acquire(entry) {
+		return
+	}
+
+	item := entry.item
+	if redispatch {
+		d.scope.IncCounter(metrics.MapQRedispatchedItems)
+	} else {
+		d.scope.IncCounter(metrics.MapQDispatchedItems)
+	}
+	if err := d.consumer.Process(d.ctx, item); err != nil {
+		if d.ctx.Err() != nil {
+			return
+		}
+		d.logger.Warn("Failed to process item, it will be dispatched again", tag.Dynamic("item", item.String()), tag.Error(err))
+		d.Nack(item)
+	}
+}
+
+// acquire waits for the rate limiter and, on the first dispatch of the item, for a slot of the fair scheduler.
+// It returns false if the dispatcher is stopped in the meantime.
+func (d *Dispatcher) acquire(entry *inflightItem) bool {
+	d.mu.Lock()
+	needsSlot := d.scheduler != nil && entry.release == nil
+	d.mu.Unlock()
+	if d.limiter == nil && !needsSlot {
+		return true
+	}
+
+	sw := d.scope.StartTimer(metrics.MapQDispatchWaitLatency)
+	defer sw.Stop()
+
+	if d.limiter != nil {
+		if err := d.limiter.Wait(d.ctx); err != nil {
+			return false
+		}
+	}
+	if !needsSlot {
+		return true
+	}
+
+	release, err := d.scheduler.Acquire(d.ctx, d.queue, d.weight)
+	if err != nil {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if entry.acked || d.ctx.Err() != nil {
+		// ack'ed while waiting or the dispatcher is stopping
+		release()
+		return false
+	}
+	entry.release = release
+	return true
 }
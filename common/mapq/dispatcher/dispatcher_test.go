@@ -19,21 +19,32 @@
 // LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
 // SOFTWARE.
-
 package dispatcher
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"go.uber.org/goleak"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/mapq/types"
 )
 
 func TestStartStop(t *testing.T) {
 	defer goleak.VerifyNone(t)
 
-	d := New(nil)
+	ctrl := gomock.NewController(t)
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	d := New(testlogger.New(t), types.NewMockConsumer(ctrl), persister, testPartitions(), types.DispatchPolicy{}, types.InitialOffset)
 	err := d.Start(context.Background())
 	if err != nil {
 		t.Fatalf("Start() failed: %v", err)
@@ -46,3 +57,354 @@ func TestStartStop(t *testing.T) {
 		t.Fatalf("Stop() failed: %v", err)
 	}
 }
+
+func TestDispatchAndAck(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	persister := newFakePersister(1, 2, 3, 4, 5)
+	consumer := newFakeConsumer()
+	d := New(testlogger.New(t), consumer, persister, testPartitions(), types.DispatchPolicy{}, types.InitialOffset, WithPageSize(2), WithPollInterval(time.Millisecond))
+	stop := startDispatcher(t, d)
+	defer stop()
+
+	consumer.waitForOffsets(t, 1, 2, 3, 4, 5)
+	if got := d.CommittedOffset(); got != types.InitialOffset {
+		t.Errorf("CommittedOffset() = %v, want %v before any ack", got, types.InitialOffset)
+	}
+
+	// out of order acks only move the committed offset up to the first un-acked item
+	d.Ack(testItem(1))
+	d.Ack(testItem(3))
+	d.Ack(testItem(5))
+	if got := d.CommittedOffset(); got != 1 {
+		t.Errorf("CommittedOffset() = %v, want %v", got, 1)
+	}
+
+	d.Ack(testItem(2))
+	if got := d.CommittedOffset(); got != 3 {
+		t.Errorf("CommittedOffset() = %v, want %v", got, 3)
+	}
+
+	// acking unknown or already acked items has no effect
+	d.Ack(testItem(42))
+	d.Ack(testItem(3))
+	if got := d.CommittedOffset(); got != 3 {
+		t.Errorf("CommittedOffset() = %v, want %v", got, 3)
+	}
+
+	d.Ack(testItem(4))
+	if got := d.CommittedOffset(); got != 5 {
+		t.Errorf("CommittedOffset() = %v, want %v", got, 5)
+	}
+}
+
+func TestStartFromCommittedOffset(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	persister := newFakePersister(1, 2, 3, 4)
+	consumer := newFakeConsumer()
+	d := New(testlogger.New(t), consumer, persister, testPartitions(), types.DispatchPolicy{}, 2, WithPollInterval(time.Millisecond))
+	stop := startDispatcher(t, d)
+	defer stop()
+
+	consumer.waitForOffsets(t, 3, 4)
+	if got := d.CommittedOffset(); got != 2 {
+		t.Errorf("CommittedOffset() = %v, want %v", got, 2)
+	}
+}
+
+func TestNack(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	persister := newFakePersister(1, 2)
+	consumer := newFakeConsumer()
+	d := New(testlogger.New(t), consumer, persister, testPartitions(), types.DispatchPolicy{}, types.InitialOffset, WithPollInterval(time.Millisecond))
+	stop := startDispatcher(t, d)
+	defer stop()
+
+	consumer.waitForOffsets(t, 1, 2)
+
+	// nacked item is dispatched again
+	d.Nack(testItem(1))
+	consumer.waitForOffsets(t, 1, 2, 1)
+
+	// nacking an acked item has no effect
+	d.Ack(testItem(2))
+	d.Nack(testItem(2))
+	d.Ack(testItem(1))
+	time.Sleep(20 * time.Millisecond)
+	consumer.waitForOffsets(t, 1, 2, 1)
+	if got := d.CommittedOffset(); got != 2 {
+		t.Errorf("CommittedOffset() = %v, want %v", got, 2)
+	}
+}
+
+func TestProcessFailureIsDispatchedAgain(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	persister := newFakePersister(1)
+	consumer := newFakeConsumer()
+	consumer.failures = 2
+	d := New(testlogger.New(t), consumer, persister, testPartitions(), types.DispatchPolicy{}, types.InitialOffset, WithPollInterval(time.Millisecond))
+	stop := startDispatcher(t, d)
+	defer stop()
+
+	consumer.waitForOffsets(t, 1, 1, 1)
+}
+
+func TestConcurrencyLimitsInflightItems(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	persister := newFakePersister(1, 2, 3, 4, 5)
+	consumer := newFakeConsumer()
+	d := New(testlogger.New(t), consumer, persister, testPartitions(), types.DispatchPolicy{Concurrency: 2}, types.InitialOffset, WithPollInterval(time.Millisecond))
+	stop := startDispatcher(t, d)
+	defer stop()
+
+	consumer.waitForOffsets(t, 1, 2)
+	time.Sleep(20 * time.Millisecond)
+	consumer.waitForOffsets(t, 1, 2)
+
+	// acking frees up room for more items
+	d.Ack(testItem(1))
+	consumer.waitForOffsets(t, 1, 2, 3)
+	d.Ack(testItem(2))
+	d.Ack(testItem(3))
+	consumer.waitForOffsets(t, 1, 2, 3, 4, 5)
+}
+
+func TestNotify(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	persister := newFakePersister()
+	consumer := newFakeConsumer()
+	d := New(testlogger.New(t), consumer, persister, testPartitions(), types.DispatchPolicy{}, types.InitialOffset, WithPollInterval(time.Hour))
+	stop := startDispatcher(t, d)
+	defer stop()
+
+	persister.add(1)
+	d.Notify()
+	consumer.waitForOffsets(t, 1)
+}
+
+func TestFetchFailure(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	ctrl := gomock.NewController(t)
+	persister := types.NewMockPersister(ctrl)
+	consumer := newFakeConsumer()
+	gomock.InOrder(
+		persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), types.PageInfo{ExclusiveStartOffset: types.InitialOffset, PageSize: defaultPageSize}).Return(nil, errors.New("failed")),
+		persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), types.PageInfo{ExclusiveStartOffset: types.InitialOffset, PageSize: defaultPageSize}).Return([]types.Item{testItem(1)}, nil),
+		persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), types.PageInfo{ExclusiveStartOffset: 1, PageSize: defaultPageSize - 1}).Return(nil, nil).AnyTimes(),
+	)
+	d := New(testlogger.New(t), consumer, persister, testPartitions(), types.DispatchPolicy{}, types.InitialOffset, WithPollInterval(time.Millisecond))
+	stop := startDispatcher(t, d)
+	defer stop()
+
+	consumer.waitForOffsets(t, 1)
+}
+
+func TestDispatchRPS(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	persister := newFakePersister(1, 2, 3, 4, 5, 6)
+	consumer := newFakeConsumer()
+	d := New(testlogger.New(t), consumer, persister, testPartitions(), types.DispatchPolicy{DispatchRPS: 50}, types.InitialOffset, WithPollInterval(time.Millisecond))
+	start := time.Now()
+	stop := startDispatcher(t, d)
+	defer stop()
+
+	consumer.waitForOffsets(t, 1, 2, 3, 4, 5, 6)
+	// the first item is dispatched right away, the others every 20ms
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("6 items were dispatched in %v, want at least 100ms at 50 rps", elapsed)
+	}
+}
+
+func TestFairSchedulerNoisyNeighbourDispatch(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	scheduler := NewFairScheduler(2)
+	var noisyOffsets []int64
+	for i := int64(1); i <= 500; i++ {
+		noisyOffsets = append(noisyOffsets, i)
+	}
+
+	acks := make(chan func(), 10)
+	ackerDone := make(chan struct{})
+	go func() {
+		defer close(ackerDone)
+		for ack := range acks {
+			time.Sleep(100 * time.Microsecond)
+			ack()
+		}
+	}()
+
+	noisyConsumer := newAckingConsumer(acks)
+	noisy := New(testlogger.New(t), noisyConsumer, newFakePersister(noisyOffsets...), testPartitionsFor("noisy"), types.DispatchPolicy{}, types.InitialOffset,
+		WithPollInterval(time.Millisecond), WithFairScheduler(scheduler))
+	noisyConsumer.dispatcher = noisy
+
+	quietPersister := newFakePersister()
+	quietConsumer := newAckingConsumer(acks)
+	quiet := New(testlogger.New(t), quietConsumer, quietPersister, testPartitionsFor("quiet"), types.DispatchPolicy{Weight: 2}, types.InitialOffset,
+		WithPollInterval(time.Millisecond), WithFairScheduler(scheduler))
+	quietConsumer.dispatcher = quiet
+
+	stopNoisy := startDispatcher(t, noisy)
+	stopQuiet := startDispatcher(t, quiet)
+	defer func() {
+		stopNoisy()
+		stopQuiet()
+		close(acks)
+		<-ackerDone
+	}()
+
+	// the noisy queue uses all the slots with its backlog when the quiet queue gets items
+	waitFor(t, func() bool { return noisyConsumer.count() >= 20 })
+	noisyBefore := noisyConsumer.count()
+	quietPersister.add(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	quiet.Notify()
+
+	quietConsumer.waitForOffsets(t, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	noisyDuring := noisyConsumer.count() - noisyBefore
+	if noisyDuring >= len(noisyOffsets)-noisyBefore {
+		t.Fatalf("quiet queue was served after the whole backlog of the noisy queue")
+	}
+	// with twice the weight the quiet queue gets about two slots for each slot of the noisy one
+	if noisyDuring > 10 {
+		t.Errorf("noisy queue dispatched %d items while the quiet queue dispatched 10, want at most 10", noisyDuring)
+	}
+	if got := scheduler.InUse(); got > 2 {
+		t.Errorf("InUse() = %d, want at most the capacity", got)
+	}
+}
+
+func startDispatcher(t *testing.T, d *Dispatcher) (stop func()) {
+	t.Helper()
+	if err := d.Start(context.Background()); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+	return func() {
+		if err := d.Stop(context.Background()); err != nil {
+			t.Errorf("Stop() failed: %v", err)
+		}
+	}
+}
+
+func testPartitions() types.ItemPartitions {
+	return types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "*"})
+}
+
+func testPartitionsFor(domain string) types.ItemPartitions {
+	return types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": domain})
+}
+
+type fakeItem struct {
+	offset int64
+}
+
+func testItem(offset int64) types.Item {
+	return &fakeItem{offset: offset}
+}
+
+func (i *fakeItem) GetAttribute(string) any { return nil }
+func (i *fakeItem) Offset() int64           { return i.offset }
+func (i *fakeItem) String() string          { return fmt.Sprintf("fakeItem{offset:%d}", i.offset) }
+
+type fakePersister struct {
+	types.Persister
+
+	mu      sync.Mutex
+	offsets []int64
+}
+
+func newFakePersister(offsets ...int64) *fakePersister {
+	return &fakePersister{offsets: offsets}
+}
+
+func (p *fakePersister) add(offsets ...int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.offsets = append(p.offsets, offsets...)
+}
+
+func (p *fakePersister) Fetch(_ context.Context, _ types.ItemPartitions, pageInfo types.PageInfo) ([]types.Item, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var items []types.Item
+	for _, offset := range p.offsets {
+		if offset > pageInfo.ExclusiveStartOffset && len(items) < pageInfo.PageSize {
+			items = append(items, testItem(offset))
+		}
+	}
+	return items, nil
+}
+
+type fakeConsumer struct {
+	types.Consumer
+
+	mu        sync.Mutex
+	processed []int64
+	failures  int
+}
+
+func newFakeConsumer() *fakeConsumer {
+	return &fakeConsumer{}
+}
+
+func (c *fakeConsumer) Process(_ context.Context, item types.Item) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.processed = append(c.processed, item.Offset())
+	if c.failures > 0 {
+		c.failures--
+		return errors.New("failed")
+	}
+	return nil
+}
+
+// waitForOffsets waits until the consumer processed exactly the items with the given offsets, in order
+func (c *fakeConsumer) waitForOffsets(t *testing.T, want ...int64) {
+	t.Helper()
+	var got []int64
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		c.mu.Lock()
+		got = append([]int64(nil), c.processed...)
+		c.mu.Unlock()
+		if len(got) >= len(want) {
+			break
+		}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("Processed items mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// ackingConsumer records the processed items and acks them asynchronously, like a worker pool
+type ackingConsumer struct {
+	fakeConsumer
+
+	dispatcher *Dispatcher
+	acks       chan<- func()
+}
+
+func newAckingConsumer(acks chan<- func()) *ackingConsumer {
+	return &ackingConsumer{acks: acks}
+}
+
+func (c *ackingConsumer) Process(ctx context.Context, item types.Item) error {
+	c.fakeConsumer.Process(ctx, item)
+	select {
+	case c.acks <- func() { c.dispatcher.Ack(item) }:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func (c *ackingConsumer) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.processed)
+}
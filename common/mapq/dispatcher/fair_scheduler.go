@@ -0,0 +1,163 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dispatcher
+
+import (
+	"context"
+	"sync"
+)
+
+// minPrunedQueues is the number of queues the scheduler keeps the state of before pruning the idle ones
+const minPrunedQueues = 64
+
+// FairScheduler shares a number of dispatch slots between the leaf queues of a tree so that a queue with
+// a large backlog cannot starve the others. A slot is held from the first dispatch of an item until it is ack'ed.
+//
+// Slots are granted by weighted fair queuing: each request gets a virtual start time which is the later
+// of the current virtual time and the virtual finish time of the previous request of the queue, and its queue
+// advances by 1/weight. The waiting request with the earliest start time is granted first. Unlike round-robin
+// schemes this keeps the weights when every queue has at most one waiting request, which is the case with
+// dispatchers, and idle queues do not accumulate credit.
+type FairScheduler struct {
+	capacity int
+
+	mu    sync.Mutex
+	inUse int
+	// virtualTime is the start time of the last granted request
+	virtualTime float64
+	// finishTimes contains the virtual finish time of the last request of each queue
+	finishTimes map[string]float64
+	pruneAt     int
+	waiters     []*fairWaiter
+}
+
+type fairWaiter struct {
+	queue   string
+	start   float64
+	granted bool
+	ch      chan struct{}
+}
+
+// NewFairScheduler creates a scheduler with the given number of slots.
+func NewFairScheduler(capacity int) *FairScheduler {
+	if capacity <= 0 {
+		capacity = defaultConcurrency
+	}
+	return &FairScheduler{
+		capacity:    capacity,
+		finishTimes: map[string]float64{},
+		pruneAt:     minPrunedQueues,
+	}
+}
+
+// Acquire blocks until a slot is granted to the queue or the context is done.
+// The returned function releases the slot, calling it more than once has no effect.
+func (s *FairScheduler) Acquire(ctx context.Context, queue string, weight int) (release func(), err error) {
+	if weight <= 0 {
+		weight = 1
+	}
+
+	w := &fairWaiter{queue: queue, ch: make(chan struct{})}
+	s.mu.Lock()
+	w.start = s.virtualTime
+	if finish, ok := s.finishTimes[queue]; ok && finish > w.start {
+		w.start = finish
+	}
+	s.finishTimes[queue] = w.start + 1/float64(weight)
+	s.waiters = append(s.waiters, w)
+	s.schedule()
+	s.mu.Unlock()
+
+	select {
+	case <-w.ch:
+		return s.releaseFunc(), nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if w.granted {
+		// granted concurrently with the cancellation, give the slot to the next waiter
+		s.inUse--
+		s.schedule()
+		return nil, ctx.Err()
+	}
+	for i, other := range s.waiters {
+		if other == w {
+			s.waiters = append(s.waiters[:i], s.waiters[i+1:]...)
+			break
+		}
+	}
+	return nil, ctx.Err()
+}
+
+// InUse returns the number of granted slots which are not released yet.
+func (s *FairScheduler) InUse() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.inUse
+}
+
+func (s *FairScheduler) releaseFunc() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.inUse--
+			s.schedule()
+		})
+	}
+}
+
+// schedule grants the free slots to the waiters with the earliest start times. It must be called with the lock held.
+func (s *FairScheduler) schedule() {
+	for s.inUse < s.capacity && len(s.waiters) > 0 {
+		next := 0
+		for i, w := range s.waiters {
+			if w.start < s.waiters[next].start {
+				next = i
+			}
+		}
+		w := s.waiters[next]
+		s.waiters = append(s.waiters[:next], s.waiters[next+1:]...)
+
+		s.virtualTime = w.start
+		s.inUse++
+		w.granted = true
+		close(w.ch)
+	}
+
+	if len(s.finishTimes) > s.pruneAt {
+		// queues which finished before the virtual time start from the virtual time anyway
+		for queue, finish := range s.finishTimes {
+			if finish <= s.virtualTime {
+				delete(s.finishTimes, queue)
+			}
+		}
+		s.pruneAt = 2 * len(s.finishTimes)
+		if s.pruneAt < minPrunedQueues {
+			s.pruneAt = minPrunedQueues
+		}
+	}
+}
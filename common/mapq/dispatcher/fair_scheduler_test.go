@@ -0,0 +1,212 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dispatcher
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/goleak"
+)
+
+func TestFairSchedulerWeights(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	s := NewFairScheduler(1)
+	hold := mustAcquire(t, s, "busy", 1)
+
+	type grant struct {
+		queue   string
+		release func()
+	}
+	grants := make(chan grant)
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for queue, weight := range map[string]int{"heavy": 3, "light": 1} {
+		wg.Add(1)
+		go func(queue string, weight int) {
+			defer wg.Done()
+			for {
+				release, err := s.Acquire(ctx, queue, weight)
+				if err != nil {
+					return
+				}
+				select {
+				case grants <- grant{queue: queue, release: release}:
+				case <-ctx.Done():
+					release()
+					return
+				}
+			}
+		}(queue, weight)
+	}
+
+	// like dispatchers, both queues always have a request waiting when a slot is released
+	waitFor(t, func() bool { return numWaiters(s) == 2 })
+	hold()
+	counts := map[string]int{}
+	for i := 0; i < 40; i++ {
+		g := <-grants
+		counts[g.queue]++
+		waitFor(t, func() bool { return numWaiters(s) == 2 })
+		g.release()
+	}
+	cancel()
+	wg.Wait()
+
+	if diff := cmp.Diff(map[string]int{"heavy": 30, "light": 10}, counts); diff != "" {
+		t.Errorf("Grants mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFairSchedulerNoisyNeighbour(t *testing.T) {
+	s := NewFairScheduler(1)
+	hold := mustAcquire(t, s, "noisy", 1)
+
+	// the noisy queue requested many slots before the quiet queue
+	var order []string
+	results := make(chan string, 20)
+	for i := 0; i < 10; i++ {
+		go acquireAndReport(t, s, "noisy", results)
+		waitFor(t, func() bool { return numWaiters(s) == i+1 })
+	}
+	go acquireAndReport(t, s, "quiet", results)
+	waitFor(t, func() bool { return numWaiters(s) == 11 })
+
+	hold()
+	for i := 0; i < 11; i++ {
+		order = append(order, <-results)
+	}
+
+	// the quiet queue is served right after the noisy one got its fair share, not after its whole backlog
+	for i, name := range order {
+		if name == "quiet" {
+			if i > 1 {
+				t.Errorf("quiet queue was served at position %d: %v", i, order)
+			}
+			return
+		}
+	}
+	t.Errorf("quiet queue was not served: %v", order)
+}
+
+func TestFairSchedulerIdleQueueDoesNotAccumulateCredit(t *testing.T) {
+	s := NewFairScheduler(1)
+
+	// the busy queue advances the virtual time while the idle queue does not request anything
+	for i := 0; i < 5; i++ {
+		mustAcquire(t, s, "busy", 1)()
+	}
+	hold := mustAcquire(t, s, "busy", 1)
+
+	results := make(chan string, 10)
+	go acquireAndReport(t, s, "idle", results)
+	waitFor(t, func() bool { return numWaiters(s) == 1 })
+	go acquireAndReport(t, s, "busy", results)
+	waitFor(t, func() bool { return numWaiters(s) == 2 })
+	go acquireAndReport(t, s, "idle", results)
+	waitFor(t, func() bool { return numWaiters(s) == 3 })
+
+	hold()
+	var order []string
+	for i := 0; i < 3; i++ {
+		order = append(order, <-results)
+	}
+	if diff := cmp.Diff([]string{"idle", "busy", "idle"}, order); diff != "" {
+		t.Errorf("Grant order mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFairSchedulerCancel(t *testing.T) {
+	s := NewFairScheduler(1)
+	hold := mustAcquire(t, s, "a", 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := s.Acquire(ctx, "b", 1)
+		errCh <- err
+	}()
+	waitFor(t, func() bool { return numWaiters(s) == 1 })
+	cancel()
+	if err := <-errCh; err != context.Canceled {
+		t.Errorf("Acquire() error = %v, want %v", err, context.Canceled)
+	}
+	if n := numWaiters(s); n != 0 {
+		t.Errorf("waiters = %d, want 0 after cancel", n)
+	}
+
+	// releasing more than once has no effect
+	hold()
+	hold()
+	if got := s.InUse(); got != 0 {
+		t.Errorf("InUse() = %d, want 0", got)
+	}
+	mustAcquire(t, s, "a", 1)
+	if got := s.InUse(); got != 1 {
+		t.Errorf("InUse() = %d, want 1", got)
+	}
+}
+
+func mustAcquire(t *testing.T, s *FairScheduler, queue string, weight int) func() {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	release, err := s.Acquire(ctx, queue, weight)
+	if err != nil {
+		t.Fatalf("Acquire() failed: %v", err)
+	}
+	return release
+}
+
+func acquireAndReport(t *testing.T, s *FairScheduler, queue string, results chan<- string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	release, err := s.Acquire(ctx, queue, 1)
+	if err != nil {
+		t.Errorf("Acquire() failed: %v", err)
+		results <- ""
+		return
+	}
+	results <- queue
+	release()
+}
+
+func numWaiters(s *FairScheduler) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.waiters)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if cond() {
+			return
+		}
+	}
+	t.Fatal("condition not met in time")
+}
@@ -25,6 +25,8 @@ package mapq
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -189,11 +191,25 @@ func (c *NoOpConsumer) Process(ctx context.Context, item types.Item) error {
 }
 
 type InMemoryPersister struct {
-	items   []types.ItemToPersist
-	offsets *types.Offsets
+	sync.Mutex
+	items       []*inMemoryItem
+	lastOffsets map[string]int64
+	offsets     *types.Offsets
+}
+
+// inMemoryItem is a persisted item with the offset assigned to it by the persister
+type inMemoryItem struct {
+	types.ItemToPersist
+	offset int64
+}
+
+func (i *inMemoryItem) Offset() int64 {
+	return i.offset
 }
 
 func (p *InMemoryPersister) Persist(ctx context.Context, items []types.ItemToPersist) error {
+	p.Lock()
+	defer p.Unlock()
 	fmt.Printf("persisting %v items\n", len(items))
 	for _, item := range items {
 		partitionsKV := map[string]any{}
@@ -203,24 +219,48 @@ func (p *InMemoryPersister) Persist(ctx context.Context, items []types.ItemToPer
 			actualKV[k] = item.GetAttribute(k)
 		}
 		fmt.Printf("item attributes: %v, partitions: %v\n", actualKV, partitionsKV)
+
+		if p.lastOffsets == nil {
+			p.lastOffsets = map[string]int64{}
+		}
+		path := types.QueuePath(item)
+		p.lastOffsets[path]++
+		p.items = append(p.items, &inMemoryItem{ItemToPersist: item, offset: p.lastOffsets[path]})
 	}
-	p.items = append(p.items, items...)
 	return nil
 }
 
 func (p *InMemoryPersister) GetOffsets(context.Context) (*types.Offsets, error) {
+	p.Lock()
+	defer p.Unlock()
 	return p.offsets, nil
 }
 
 func (p *InMemoryPersister) CommitOffsets(ctx context.Context, offsets *types.Offsets) error {
+	p.Lock()
+	defer p.Unlock()
 	fmt.Printf("committing offsets: %v\n", offsets)
 	p.offsets = offsets
 	return nil
 }
 
-// Fetch(ctx context.Context, partitions ItemPartitions, pageInfo PageInfo) ([]Item, error)
 func (p *InMemoryPersister) Fetch(ctx context.Context, partitions types.ItemPartitions, pageInfo types.PageInfo) ([]types.Item, error) {
-	return nil, nil
+	p.Lock()
+	defer p.Unlock()
+	path := types.QueuePath(partitions)
+	var items []types.Item
+	for _, item := range p.items {
+		if types.QueuePath(item) == path && item.Offset() > pageInfo.ExclusiveStartOffset {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Offset() < items[j].Offset()
+	})
+	if len(items) > pageInfo.PageSize {
+		items = items[:pageInfo.PageSize]
+	}
+	return items, nil
 }
 
 func newTimerItem(domain string, t time.Time, timerType int) types.Item {
@@ -254,7 +294,8 @@ func (t *timerItem) String() string {
 }
 
 func (t *timerItem) Offset() int64 {
-	return t.t.UnixNano()
+	// offsets are assigned by the persister
+	return 0
 }
 
 func (t *timerItem) GetAttribute(key string) any {
@@ -306,7 +347,8 @@ func (t *transferItem) String() string {
 }
 
 func (t *transferItem) Offset() int64 {
-	return t.taskID
+	// offsets are assigned by the persister
+	return 0
 }
 
 func (t *transferItem) GetAttribute(key string) any {
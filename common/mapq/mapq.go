@@ -23,7 +23,9 @@
 package mapq
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -32,6 +34,8 @@ import (
 	"github.com/uber/cadence/common/metrics"
 )
 
+const defaultCommitInterval = 10 * time.Second
+
 type Options func(*clientImpl)
 
 func WithPersister(p types.Persister) Options {
@@ -72,10 +76,31 @@ func WithPolicies(policies []types.NodePolicy) Options {
 	}
 }
 
+// WithCommitInterval sets how often the committed offsets of the queues are persisted.
+// Offsets are also persisted when the client is stopped.
+func WithCommitInterval(interval time.Duration) Options {
+	return func(c *clientImpl) {
+		c.commitInterval = interval
+	}
+}
+
+// WithDispatchCapacity limits the number of dispatched and not yet ack'ed items of all queues.
+// The capacity is shared by the queues by the weights of their dispatch policies when they all have
+// items to dispatch, so that a bursty tenant cannot starve the others. There is no shared limit by default.
+func WithDispatchCapacity(capacity int) Options {
+	return func(c *clientImpl) {
+		c.dispatchCapacity = capacity
+	}
+}
+
 func New(logger log.Logger, scope metrics.Scope, opts ...Options) (types.Client, error) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
 	c := &clientImpl{
-		logger: logger.WithTags(tag.ComponentMapQ),
-		scope:  scope,
+		logger:         logger.WithTags(tag.ComponentMapQ),
+		scope:          scope,
+		commitInterval: defaultCommitInterval,
+		ctx:            ctx,
+		cancelCtx:      cancelCtx,
 	}
 
 	for _, opt := range opts {
@@ -90,7 +115,19 @@ func New(logger log.Logger, scope metrics.Scope, opts ...Options) (types.Client,
 		return nil, fmt.Errorf("consumer factory is required. Use WithConsumerFactory option to set it")
 	}
 
-	tree, err := tree.New(logger, scope, c.partitions, c.policies, c.persister, c.consumerFactory)
+	if c.commitInterval <= 0 {
+		return nil, fmt.Errorf("commit interval must be positive, got %v", c.commitInterval)
+	}
+
+	if c.dispatchCapacity < 0 {
+		return nil, fmt.Errorf("dispatch capacity can't be negative, got %d", c.dispatchCapacity)
+	}
+
+	var treeOpts []tree.Options
+	if c.dispatchCapacity > 0 {
+		treeOpts = append(treeOpts, tree.WithDispatchCapacity(c.dispatchCapacity))
+	}
+	tree, err := tree.New(logger, scope, c.partitions, c.policies, c.persister, c.consumerFactory, treeOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,200 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+// Package sqlpersister provides a MAPQ persister which stores the items and offsets of the queues in a SQL database.
+// The tables are defined in the mapq schema of each supported database under the schema directory.
+package sqlpersister
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+// ItemCodec serializes the items stored by the persister.
+// Decoded items must return the same attributes as the encoded ones, since they are routed by their attributes when they are ack'ed.
+// They must also return the given offset, which is assigned to them by the persister when they are persisted.
+type ItemCodec interface {
+	Encode(types.Item) ([]byte, error)
+	Decode(payload []byte, offset int64) (types.Item, error)
+}
+
+type persister struct {
+	db      sqlplugin.DB
+	queueID string
+	codec   ItemCodec
+}
+
+var _ types.Persister = (*persister)(nil)
+
+// New creates a persister for the MAPQ instance identified by queueID.
+// Multiple MAPQ instances can share the same database as long as they have different queue IDs.
+func New(db sqlplugin.DB, queueID string, codec ItemCodec) (types.Persister, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+	if queueID == "" {
+		return nil, fmt.Errorf("queue ID is required")
+	}
+	if codec == nil {
+		return nil, fmt.Errorf("item codec is required")
+	}
+
+	return &persister{
+		db:      db,
+		queueID: queueID,
+		codec:   codec,
+	}, nil
+}
+
+// Persist assigns the offsets of the items from the sequence of their leaf queue and inserts them.
+// The sequence of a leaf queue stays locked until the transaction is committed, so the items of a leaf queue
+// become visible in offset order and a fetch never skips an item which is committed later with a lower offset.
+func (p *persister) Persist(ctx context.Context, items []types.ItemToPersist) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	itemsByPath := make(map[string][]types.ItemToPersist)
+	for _, item := range items {
+		path := types.QueuePath(item)
+		itemsByPath[path] = append(itemsByPath[path], item)
+	}
+	// lock the sequences in the same order in all transactions to avoid deadlocks
+	paths := make([]string, 0, len(itemsByPath))
+	for path := range itemsByPath {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return p.inTx(ctx, func(tx sqlplugin.Tx) error {
+		rows := make([]sqlplugin.MAPQItemRow, 0, len(items))
+		for _, path := range paths {
+			pathItems := itemsByPath[path]
+			lastOffset, err := tx.IncrementMAPQSequence(ctx, p.queueID, path, int64(len(pathItems)))
+			if err != nil {
+				return fmt.Errorf("failed to assign offsets to the items of queue %s: %w", path, err)
+			}
+
+			firstOffset := lastOffset - int64(len(pathItems)) + 1
+			for i, item := range pathItems {
+				payload, err := p.codec.Encode(item)
+				if err != nil {
+					return fmt.Errorf("failed to encode item %v: %w", item, err)
+				}
+				rows = append(rows, sqlplugin.MAPQItemRow{
+					QueueID:    p.queueID,
+					QueuePath:  path,
+					ItemOffset: firstOffset + int64(i),
+					Payload:    payload,
+				})
+			}
+		}
+
+		if _, err := tx.InsertIntoMAPQItems(ctx, rows); err != nil {
+			return fmt.Errorf("failed to insert items: %w", err)
+		}
+		return nil
+	})
+}
+
+func (p *persister) GetOffsets(ctx context.Context) (*types.Offsets, error) {
+	row, err := p.db.SelectFromMAPQOffsets(ctx, p.queueID)
+	if p.db.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offsets: %w", err)
+	}
+
+	var offsets types.Offsets
+	if err := json.Unmarshal(row.Offsets, &offsets); err != nil {
+		return nil, fmt.Errorf("failed to decode offsets: %w", err)
+	}
+	return &offsets, nil
+}
+
+// CommitOffsets persists the offsets and deletes the items which are ack'ed.
+func (p *persister) CommitOffsets(ctx context.Context, offsets *types.Offsets) error {
+	if offsets == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(offsets)
+	if err != nil {
+		return fmt.Errorf("failed to encode offsets: %w", err)
+	}
+
+	return p.inTx(ctx, func(tx sqlplugin.Tx) error {
+		if _, err := tx.UpsertIntoMAPQOffsets(ctx, &sqlplugin.MAPQOffsetsRow{QueueID: p.queueID, Offsets: data}); err != nil {
+			return fmt.Errorf("failed to commit offsets: %w", err)
+		}
+		for path, offset := range offsets.CommittedOffsets {
+			if offset == types.InitialOffset {
+				continue
+			}
+			if _, err := tx.RangeDeleteFromMAPQItems(ctx, p.queueID, path, offset); err != nil {
+				return fmt.Errorf("failed to delete ack'ed items of queue %s: %w", path, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (p *persister) Fetch(ctx context.Context, partitions types.ItemPartitions, pageInfo types.PageInfo) ([]types.Item, error) {
+	rows, err := p.db.SelectFromMAPQItems(ctx, &sqlplugin.MAPQItemsFilter{
+		QueueID:            p.queueID,
+		QueuePath:          types.QueuePath(partitions),
+		ExclusiveMinOffset: pageInfo.ExclusiveStartOffset,
+		PageSize:           pageInfo.PageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch items: %w", err)
+	}
+
+	items := make([]types.Item, 0, len(rows))
+	for _, row := range rows {
+		item, err := p.codec.Decode(row.Payload, row.ItemOffset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode item with offset %d: %w", row.ItemOffset, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func (p *persister) inTx(ctx context.Context, fn func(tx sqlplugin.Tx) error) error {
+	tx, err := p.db.BeginTx(ctx, sqlplugin.DbDefaultShard)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w, rollback failed: %v", err, rollbackErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
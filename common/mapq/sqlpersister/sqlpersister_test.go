@@ -0,0 +1,434 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package sqlpersister
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/goleak"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/mapq"
+	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/metrics"
+	persistencesql "github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	sqliteplugin "github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
+	"github.com/uber/cadence/schema/sqlite"
+)
+
+func TestNew(t *testing.T) {
+	db := newTestDB(t)
+
+	if _, err := New(nil, "queue", jsonCodec{}); err == nil {
+		t.Error("New() succeeded without db")
+	}
+	if _, err := New(db, "", jsonCodec{}); err == nil {
+		t.Error("New() succeeded without queue ID")
+	}
+	if _, err := New(db, "queue", nil); err == nil {
+		t.Error("New() succeeded without codec")
+	}
+}
+
+func TestPersistAndFetch(t *testing.T) {
+	p := newTestPersister(t, newTestDB(t), "queue")
+	ctx := context.Background()
+
+	d1 := types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "d1"})
+	catchAll := types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "*"})
+	err := p.Persist(ctx, []types.ItemToPersist{
+		types.NewItemToPersist(newTestItem("d1", 1), d1),
+		types.NewItemToPersist(newTestItem("d2", 1), catchAll),
+		types.NewItemToPersist(newTestItem("d1", 2), d1),
+		types.NewItemToPersist(newTestItem("d1", 3), d1),
+	})
+	if err != nil {
+		t.Fatalf("Persist() failed: %v", err)
+	}
+
+	// offsets are assigned per leaf queue in the order of the items
+	items, err := p.Fetch(ctx, d1, types.PageInfo{ExclusiveStartOffset: types.InitialOffset, PageSize: 2})
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+	if diff := cmp.Diff([]types.Item{newFetchedItem("d1", 1, 1), newFetchedItem("d1", 2, 2)}, items); diff != "" {
+		t.Errorf("Fetched items mismatch (-want +got):\n%s", diff)
+	}
+
+	items, err = p.Fetch(ctx, d1, types.PageInfo{ExclusiveStartOffset: 2, PageSize: 2})
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+	if diff := cmp.Diff([]types.Item{newFetchedItem("d1", 3, 3)}, items); diff != "" {
+		t.Errorf("Fetched items mismatch (-want +got):\n%s", diff)
+	}
+
+	items, err = p.Fetch(ctx, catchAll, types.PageInfo{ExclusiveStartOffset: types.InitialOffset, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+	if diff := cmp.Diff([]types.Item{newFetchedItem("d2", 1, 1)}, items); diff != "" {
+		t.Errorf("Fetched items mismatch (-want +got):\n%s", diff)
+	}
+
+	// offsets continue from the last assigned offset of the leaf queue, even after its items are deleted
+	if err := p.CommitOffsets(ctx, &types.Offsets{CommittedOffsets: map[string]int64{"*/*": 1}}); err != nil {
+		t.Fatalf("CommitOffsets() failed: %v", err)
+	}
+	if err := p.Persist(ctx, []types.ItemToPersist{types.NewItemToPersist(newTestItem("d2", 2), catchAll)}); err != nil {
+		t.Fatalf("Persist() failed: %v", err)
+	}
+	items, err = p.Fetch(ctx, catchAll, types.PageInfo{ExclusiveStartOffset: types.InitialOffset, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+	if diff := cmp.Diff([]types.Item{newFetchedItem("d2", 2, 2)}, items); diff != "" {
+		t.Errorf("Fetched items mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPersistConcurrently(t *testing.T) {
+	p := newTestPersister(t, newTestDB(t), "queue")
+	ctx := context.Background()
+	partitions := types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "*"})
+
+	const writers, itemsPerWriter = 5, 10
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			var items []types.ItemToPersist
+			for i := 0; i < itemsPerWriter; i++ {
+				items = append(items, types.NewItemToPersist(newTestItem(fmt.Sprintf("d%d", w), int64(i)), partitions))
+			}
+			errs <- p.Persist(ctx, items)
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Persist() failed: %v", err)
+		}
+	}
+
+	items, err := p.Fetch(ctx, partitions, types.PageInfo{ExclusiveStartOffset: types.InitialOffset, PageSize: writers * itemsPerWriter})
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+	if got := len(items); got != writers*itemsPerWriter {
+		t.Fatalf("Fetch() returned %d items, want %d", got, writers*itemsPerWriter)
+	}
+	// the items of each batch get consecutive offsets
+	for i, item := range items {
+		if want := int64(i + 1); item.Offset() != want {
+			t.Errorf("Offset of item %d = %d, want %d", i, item.Offset(), want)
+		}
+		if first := items[i-i%itemsPerWriter]; item.GetAttribute("domain") != first.GetAttribute("domain") {
+			t.Errorf("Item %v is not in the same batch as %v", item, first)
+		}
+	}
+}
+
+func TestCommitOffsets(t *testing.T) {
+	db := newTestDB(t)
+	p := newTestPersister(t, db, "queue")
+	otherQueue := newTestPersister(t, db, "other-queue")
+	ctx := context.Background()
+
+	offsets, err := p.GetOffsets(ctx)
+	if err != nil {
+		t.Fatalf("GetOffsets() failed: %v", err)
+	}
+	if offsets != nil {
+		t.Errorf("GetOffsets() = %v, want nil before any commit", offsets)
+	}
+
+	d1 := types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "d1"})
+	catchAll := types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "*"})
+	items := []types.ItemToPersist{
+		types.NewItemToPersist(newTestItem("d1", 1), d1),
+		types.NewItemToPersist(newTestItem("d1", 2), d1),
+		types.NewItemToPersist(newTestItem("d2", 1), catchAll),
+	}
+	for _, persister := range []types.Persister{p, otherQueue} {
+		if err := persister.Persist(ctx, items); err != nil {
+			t.Fatalf("Persist() failed: %v", err)
+		}
+	}
+
+	for _, want := range []*types.Offsets{
+		{CommittedOffsets: map[string]int64{"*/d1": 1, "*/*": types.InitialOffset}},
+		{CommittedOffsets: map[string]int64{"*/d1": 2, "*/*": 1}},
+	} {
+		if err := p.CommitOffsets(ctx, want); err != nil {
+			t.Fatalf("CommitOffsets() failed: %v", err)
+		}
+		got, err := p.GetOffsets(ctx)
+		if err != nil {
+			t.Fatalf("GetOffsets() failed: %v", err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Offsets mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	// ack'ed items are deleted
+	for _, partitions := range []types.ItemPartitions{d1, catchAll} {
+		remaining, err := p.Fetch(ctx, partitions, types.PageInfo{ExclusiveStartOffset: types.InitialOffset, PageSize: 10})
+		if err != nil {
+			t.Fatalf("Fetch() failed: %v", err)
+		}
+		if len(remaining) != 0 {
+			t.Errorf("Items %v remain after committing all offsets, want none", remaining)
+		}
+	}
+
+	// other queues are not affected
+	offsets, err = otherQueue.GetOffsets(ctx)
+	if err != nil {
+		t.Fatalf("GetOffsets() failed: %v", err)
+	}
+	if offsets != nil {
+		t.Errorf("GetOffsets() = %v, want nil for the other queue", offsets)
+	}
+	fetched, err := otherQueue.Fetch(ctx, d1, types.PageInfo{ExclusiveStartOffset: types.InitialOffset, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+	if got := len(fetched); got != 2 {
+		t.Errorf("Fetch() returned %d items for the other queue, want 2", got)
+	}
+}
+
+func TestMAPQClient(t *testing.T) {
+	// the database is closed by the test cleanup
+	defer goleak.VerifyNone(t, goleak.IgnoreTopFunction("database/sql.(*DB).connectionOpener"))
+
+	p := newTestPersister(t, newTestDB(t), "queue")
+	consumerFactory := &testConsumerFactory{}
+	newClient := func() types.Client {
+		cl, err := mapq.New(
+			testlogger.New(t),
+			metrics.NoopScope,
+			mapq.WithPersister(p),
+			mapq.WithConsumerFactory(consumerFactory),
+			mapq.WithPartitions([]string{"domain"}),
+			mapq.WithCommitInterval(time.Millisecond),
+		)
+		if err != nil {
+			t.Fatalf("New() failed: %v", err)
+		}
+		consumerFactory.setClient(cl)
+		return cl
+	}
+
+	ctx := context.Background()
+	cl := newClient()
+	if err := cl.Start(ctx); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+	if _, err := cl.Enqueue(ctx, []types.Item{newTestItem("d1", 1), newTestItem("d1", 2), newTestItem("d2", 3)}); err != nil {
+		t.Fatalf("Enqueue() failed: %v", err)
+	}
+	consumerFactory.waitForProcessed(t, 3)
+	if err := cl.Stop(ctx); err != nil {
+		t.Fatalf("Stop() failed: %v", err)
+	}
+
+	offsets, err := p.GetOffsets(ctx)
+	if err != nil {
+		t.Fatalf("GetOffsets() failed: %v", err)
+	}
+	if got := offsets.GetCommittedOffset("*/*"); got != 3 {
+		t.Errorf("Committed offset = %v, want 3", got)
+	}
+
+	// all items are ack'ed so nothing is dispatched after restart
+	cl = newClient()
+	if err := cl.Start(ctx); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+	if _, err := cl.Enqueue(ctx, []types.Item{newTestItem("d1", 4)}); err != nil {
+		t.Fatalf("Enqueue() failed: %v", err)
+	}
+	consumerFactory.waitForProcessed(t, 4)
+	if err := cl.Stop(ctx); err != nil {
+		t.Fatalf("Stop() failed: %v", err)
+	}
+	if diff := cmp.Diff([]int64{1, 2, 3, 4}, consumerFactory.processedOffsets()); diff != "" {
+		t.Errorf("Processed items mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func newTestDB(t *testing.T) sqlplugin.DB {
+	t.Helper()
+
+	cfg := &config.SQL{
+		PluginName:   sqliteplugin.PluginName,
+		DatabaseName: filepath.Join(t.TempDir(), "mapq.db"),
+	}
+	adminDB, err := persistencesql.NewSQLAdminDB(cfg)
+	if err != nil {
+		t.Fatalf("failed to create admin db: %v", err)
+	}
+	schema, err := sqlite.SchemaFS.ReadFile("mapq/schema.sql")
+	if err != nil {
+		t.Fatalf("failed to read schema: %v", err)
+	}
+	if err := adminDB.ExecSchemaOperationQuery(context.Background(), string(schema)); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	adminDB.Close()
+
+	db, err := persistencesql.NewSQLDB(cfg)
+	if err != nil {
+		t.Fatalf("failed to create db: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newTestPersister(t *testing.T, db sqlplugin.DB, queueID string) types.Persister {
+	t.Helper()
+	p, err := New(db, queueID, jsonCodec{})
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	return p
+}
+
+type testItem struct {
+	Domain   string `json:"domain"`
+	Sequence int64  `json:"sequence"`
+	// ItemOffset is assigned by the persister
+	ItemOffset int64 `json:"-"`
+}
+
+func newTestItem(domain string, sequence int64) types.Item {
+	return &testItem{Domain: domain, Sequence: sequence}
+}
+
+func newFetchedItem(domain string, sequence int64, offset int64) types.Item {
+	return &testItem{Domain: domain, Sequence: sequence, ItemOffset: offset}
+}
+
+func (i *testItem) GetAttribute(key string) any {
+	switch key {
+	case "domain":
+		return i.Domain
+	case "sequence":
+		return i.Sequence
+	}
+	return nil
+}
+
+func (i *testItem) Offset() int64 {
+	return i.ItemOffset
+}
+
+func (i *testItem) String() string {
+	return fmt.Sprintf("testItem{domain:%s, sequence:%d, offset:%d}", i.Domain, i.Sequence, i.ItemOffset)
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Encode(item types.Item) ([]byte, error) {
+	return json.Marshal(testItem{Domain: item.GetAttribute("domain").(string), Sequence: item.GetAttribute("sequence").(int64)})
+}
+
+func (jsonCodec) Decode(payload []byte, offset int64) (types.Item, error) {
+	var item testItem
+	if err := json.Unmarshal(payload, &item); err != nil {
+		return nil, err
+	}
+	item.ItemOffset = offset
+	return &item, nil
+}
+
+// testConsumerFactory creates consumers which ack every processed item through the client
+type testConsumerFactory struct {
+	sync.Mutex
+	client    types.Client
+	processed []int64
+}
+
+func (f *testConsumerFactory) New(types.ItemPartitions) (types.Consumer, error) {
+	return &testConsumer{factory: f}, nil
+}
+
+func (f *testConsumerFactory) Stop(context.Context) error {
+	return nil
+}
+
+func (f *testConsumerFactory) setClient(cl types.Client) {
+	f.Lock()
+	defer f.Unlock()
+	f.client = cl
+}
+
+func (f *testConsumerFactory) processedOffsets() []int64 {
+	f.Lock()
+	defer f.Unlock()
+	return append([]int64(nil), f.processed...)
+}
+
+func (f *testConsumerFactory) waitForProcessed(t *testing.T, count int) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if len(f.processedOffsets()) >= count {
+			return
+		}
+	}
+	t.Fatalf("Expected %d processed items, got %v", count, f.processedOffsets())
+}
+
+type testConsumer struct {
+	factory *testConsumerFactory
+}
+
+func (c *testConsumer) Start(context.Context) error {
+	return nil
+}
+
+func (c *testConsumer) Stop(context.Context) error {
+	return nil
+}
+
+func (c *testConsumer) Process(ctx context.Context, item types.Item) error {
+	c.factory.Lock()
+	c.factory.processed = append(c.factory.processed, item.Offset())
+	cl := c.factory.client
+	c.factory.Unlock()
+	return cl.Ack(ctx, item)
+}
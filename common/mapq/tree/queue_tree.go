@@ -25,10 +25,16 @@ package tree
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/mapq/dispatcher"
 	"github.com/uber/cadence/common/mapq/types"
 	"github.com/uber/cadence/common/metrics"
 )
@@ -42,7 +48,45 @@ type QueueTree struct {
 	policyCol       types.NodePolicyCollection
 	persister       types.Persister
 	consumerFactory types.ConsumerFactory
-	root            *QueueTreeNode
+	scheduler       *dispatcher.FairScheduler
+	timeSource      clock.TimeSource
+	splitInterval   time.Duration
+
+	// mu protects the shape of the tree. Enqueues hold the read lock until their items are persisted
+	// so that a node is not merged while items are being added to its queues.
+	mu      sync.RWMutex
+	root    *QueueTreeNode
+	started bool
+	// lastSplitEvaluation is when the enqueue counts of the nodes were last reset
+	lastSplitEvaluation time.Time
+
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+type Options func(*QueueTree)
+
+// WithTimeSource sets the time source used to measure the enqueue rates of the nodes.
+func WithTimeSource(timeSource clock.TimeSource) Options {
+	return func(t *QueueTree) {
+		t.timeSource = timeSource
+	}
+}
+
+// WithSplitInterval sets how often the enqueue rates of the nodes are evaluated to split or merge them.
+func WithSplitInterval(interval time.Duration) Options {
+	return func(t *QueueTree) {
+		t.splitInterval = interval
+	}
+}
+
+// WithDispatchCapacity limits the number of dispatched and not yet ack'ed items of all leaf queues.
+// The capacity is shared by the leaf queues by the weights of their dispatch policies.
+func WithDispatchCapacity(capacity int) Options {
+	return func(t *QueueTree) {
+		t.scheduler = dispatcher.NewFairScheduler(capacity)
+	}
 }
 
 func New(
@@ -52,7 +96,9 @@ func New(
 	policies []types.NodePolicy,
 	persister types.Persister,
 	consumerFactory types.ConsumerFactory,
+	opts ...Options,
 ) (*QueueTree, error) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
 	t := &QueueTree{
 		originalLogger:  logger,
 		logger:          logger.WithTags(tag.ComponentMapQTree),
@@ -61,6 +107,18 @@ func New(
 		policyCol:       types.NewNodePolicyCollection(policies),
 		persister:       persister,
 		consumerFactory: consumerFactory,
+		timeSource:      clock.NewRealTimeSource(),
+		splitInterval:   defaultSplitInterval,
+		ctx:             ctx,
+		cancelCtx:       cancelCtx,
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	if t.splitInterval <= 0 {
+		return nil, fmt.Errorf("split interval must be positive, got %v", t.splitInterval)
 	}
 
 	return t, t.init()
@@ -69,10 +127,30 @@ func New(
 // Start the dispatchers for all leaf nodes
 func (t *QueueTree) Start(ctx context.Context) error {
 	t.logger.Info("Starting MAPQ tree", tag.Dynamic("tree", t.String()))
-	err := t.root.Start(ctx, t.consumerFactory, nil, map[string]any{})
+	offsets, err := t.persister.GetOffsets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get offsets: %w", err)
+	}
+
+	t.logger.Info("Fetched MAPQ offsets", tag.Dynamic("offsets", offsets.String()))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if offsets != nil {
+		if err := t.restoreSplits(offsets.Splits); err != nil {
+			return fmt.Errorf("failed to restore splits: %w", err)
+		}
+	}
+
+	err = t.root.Start(ctx, t.consumerFactory, t.persister, t.scheduler, offsets, nil, map[string]any{})
 	if err != nil {
 		return fmt.Errorf("failed to start root node: %w", err)
 	}
+	t.started = true
+	t.lastSplitEvaluation = t.timeSource.Now()
+
+	t.wg.Add(1)
+	go t.splitLoop()
 
 	t.logger.Info("Started MAPQ tree")
 	return nil
@@ -82,16 +160,31 @@ func (t *QueueTree) Start(ctx context.Context) error {
 func (t *QueueTree) Stop(ctx context.Context) error {
 	t.logger.Info("Stopping MAPQ tree", tag.Dynamic("tree", t.String()))
 
+	t.cancelCtx()
+	timeout := 10 * time.Second
+	if dl, ok := ctx.Deadline(); ok {
+		timeout = time.Until(dl)
+	}
+	if !common.AwaitWaitGroup(&t.wg, timeout) {
+		return fmt.Errorf("failed to stop split loop in %v", timeout)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	err := t.root.Stop(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to stop nodes: %w", err)
 	}
+	t.started = false
 
 	t.logger.Info("Stopped MAPQ tree")
 	return nil
 }
 
 func (t *QueueTree) String() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	var sb strings.Builder
 	var nodes []*QueueTreeNode
 	nodes = append(nodes, t.root)
@@ -113,6 +206,9 @@ func (t *QueueTree) Enqueue(ctx context.Context, items []types.Item) ([]types.It
 		return nil, fmt.Errorf("root node is nil")
 	}
 
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	var itemsToPersist []types.ItemToPersist
 	for _, item := range items {
 		itemToPersist, err := t.root.Enqueue(ctx, item, nil, map[string]any{})
@@ -122,7 +218,90 @@ func (t *QueueTree) Enqueue(ctx context.Context, items []types.Item) ([]types.It
 		itemsToPersist = append(itemsToPersist, itemToPersist)
 	}
 
-	return itemsToPersist, t.persister.Persist(ctx, itemsToPersist)
+	if err := t.persister.Persist(ctx, itemsToPersist); err != nil {
+		return itemsToPersist, err
+	}
+
+	// wake up the dispatchers of the leaf nodes which received items
+	for _, item := range items {
+		leaf, err := t.root.Route(item)
+		if err == nil && leaf.Dispatcher != nil {
+			leaf.Dispatcher.Notify()
+		}
+	}
+
+	return itemsToPersist, nil
+}
+
+// Ack marks the item as processed in the leaf node it belongs to
+func (t *QueueTree) Ack(ctx context.Context, item types.Item) error {
+	return t.withDispatcherOf(item, (*dispatcher.Dispatcher).Ack)
+}
+
+// Nack schedules the item to be dispatched again by the leaf node it belongs to
+func (t *QueueTree) Nack(ctx context.Context, item types.Item) error {
+	return t.withDispatcherOf(item, (*dispatcher.Dispatcher).Nack)
+}
+
+// CommitOffsets persists the committed offsets of all leaf nodes and the splits of the tree
+func (t *QueueTree) CommitOffsets(ctx context.Context) error {
+	t.mu.RLock()
+	offsets := t.collectOffsets()
+	t.mu.RUnlock()
+
+	if err := t.persister.CommitOffsets(ctx, offsets); err != nil {
+		return fmt.Errorf("failed to commit offsets: %w", err)
+	}
+
+	return nil
+}
+
+// collectOffsets returns the committed offsets and the splits of the tree. It must be called with the lock held.
+func (t *QueueTree) collectOffsets() *types.Offsets {
+	offsets := &types.Offsets{CommittedOffsets: map[string]int64{}}
+	t.root.CollectOffsets(offsets.CommittedOffsets)
+	offsets.Splits = t.root.CollectSplits(nil)
+	sort.Slice(offsets.Splits, func(i, j int) bool {
+		// parents first
+		if li, lj := nodeLevel(offsets.Splits[i]), nodeLevel(offsets.Splits[j]); li != lj {
+			return li < lj
+		}
+		return offsets.Splits[i] < offsets.Splits[j]
+	})
+	return offsets
+}
+
+// withDispatcherOf calls fn with the dispatcher of the leaf node the item is routed to. Items dispatched before
+// their partition value was split are in the queue of the catch-all node, so the other dispatchers are tried
+// if the item is not one of the dispatched items of that leaf.
+func (t *QueueTree) withDispatcherOf(item types.Item, fn func(*dispatcher.Dispatcher, types.Item) bool) error {
+	if item == nil {
+		return fmt.Errorf("item is nil")
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	leaf, err := t.root.Route(item)
+	if err != nil {
+		return err
+	}
+
+	if leaf.Dispatcher == nil {
+		return fmt.Errorf("leaf node %s is not started", leaf.Path)
+	}
+
+	if fn(leaf.Dispatcher, item) {
+		return nil
+	}
+
+	found := false
+	t.root.ForEachLeaf(func(other *QueueTreeNode) {
+		if !found && other != leaf && other.Dispatcher != nil {
+			found = fn(other.Dispatcher, item)
+		}
+	})
+	return nil
 }
 
 func (t *QueueTree) init() error {
@@ -25,6 +25,8 @@ package tree
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -65,24 +67,52 @@ type QueueTreeNode struct {
 
 	// The dispatcher for this node. Only leaf nodes have dispatcher
 	Dispatcher *dispatcher.Dispatcher
+
+	// Dynamic is true for the nodes created by splits at runtime. Only they are merged.
+	Dynamic bool
+
+	// partitions and partitionMap are the partitions of the path of the node, set when the node is started
+	partitions   []string
+	partitionMap map[string]any
+
+	// enqueueCounts contains the number of items enqueued by value of the partition key since the last split evaluation
+	statsMu       sync.Mutex
+	enqueueCounts map[any]int64
+
+	// belowMergeRPSSince is when the enqueue rate of a dynamic node went below the MergeRPS of its parent
+	belowMergeRPSSince time.Time
 }
 
 func (n *QueueTreeNode) Start(
 	ctx context.Context,
 	consumerFactory types.ConsumerFactory,
+	persister types.Persister,
+	scheduler *dispatcher.FairScheduler,
+	offsets *types.Offsets,
 	partitions []string,
 	partitionMap map[string]any,
 ) error {
 	n.logger.Info("Starting node", tag.Dynamic("node", n.String()))
+	n.partitions = partitions
+	n.partitionMap = partitionMap
 
 	// If there are no children then this is a leaf node
 	if len(n.Children) == 0 {
 		n.logger.Info("Creating consumer and starting a new dispatcher for leaf node")
-		c, err := consumerFactory.New(types.NewItemPartitions(partitions, partitionMap))
+		itemPartitions := types.NewItemPartitions(partitions, partitionMap)
+		c, err := consumerFactory.New(itemPartitions)
 		if err != nil {
 			return err
 		}
-		d := dispatcher.New(c)
+		var dispatchPolicy types.DispatchPolicy
+		if n.NodePolicy.DispatchPolicy != nil {
+			dispatchPolicy = *n.NodePolicy.DispatchPolicy
+		}
+		opts := []dispatcher.Options{dispatcher.WithMetricsScope(n.scope.Tagged(metrics.MapQQueueTag(n.Path)))}
+		if scheduler != nil {
+			opts = append(opts, dispatcher.WithFairScheduler(scheduler))
+		}
+		d := dispatcher.New(n.originalLogger, c, persister, itemPartitions, dispatchPolicy, offsets.GetCommittedOffset(n.Path), opts...)
 		if err := d.Start(ctx); err != nil {
 			return err
 		}
@@ -91,10 +121,8 @@ func (n *QueueTreeNode) Start(
 	}
 
 	for _, child := range n.Children {
-		partitionMap[n.PartitionKey] = child.AttributeVal
-		err := child.Start(ctx, consumerFactory, partitions, partitionMap)
-		if err != nil {
-			return fmt.Errorf("failed to start child %s: %w", child.Path, err)
+		if err := n.startChild(ctx, child, consumerFactory, persister, scheduler, offsets); err != nil {
+			return err
 		}
 	}
 
@@ -102,6 +130,27 @@ func (n *QueueTreeNode) Start(
 	return nil
 }
 
+func (n *QueueTreeNode) startChild(
+	ctx context.Context,
+	child *QueueTreeNode,
+	consumerFactory types.ConsumerFactory,
+	persister types.Persister,
+	scheduler *dispatcher.FairScheduler,
+	offsets *types.Offsets,
+) error {
+	// each leaf gets its own copy of the partitions of its path
+	childPartitions := append(n.partitions[:len(n.partitions):len(n.partitions)], n.PartitionKey)
+	childPartitionMap := make(map[string]any, len(n.partitionMap)+1)
+	for k, v := range n.partitionMap {
+		childPartitionMap[k] = v
+	}
+	childPartitionMap[n.PartitionKey] = child.AttributeVal
+	if err := child.Start(ctx, consumerFactory, persister, scheduler, offsets, childPartitions, childPartitionMap); err != nil {
+		return fmt.Errorf("failed to start child %s: %w", child.Path, err)
+	}
+	return nil
+}
+
 func (n *QueueTreeNode) Stop(ctx context.Context) error {
 	n.logger.Info("Stopping node")
 
@@ -132,6 +181,7 @@ func (n *QueueTreeNode) Enqueue(
 
 	// Add the attribute value to queueNodePathParts
 	partitionVal := item.GetAttribute(n.PartitionKey)
+	n.recordEnqueue(partitionVal)
 	partitions = append(partitions, n.PartitionKey)
 	partitionMap[n.PartitionKey] = partitionVal
 
@@ -149,6 +199,78 @@ func (n *QueueTreeNode) Enqueue(
 	return child.Enqueue(ctx, item, partitions, partitionMap)
 }
 
+// Route returns the leaf node which the item is enqueued to.
+func (n *QueueTreeNode) Route(item types.Item) (*QueueTreeNode, error) {
+	if len(n.Children) == 0 {
+		return n, nil
+	}
+
+	child, ok := n.Children[item.GetAttribute(n.PartitionKey)]
+	if !ok {
+		child, ok = n.Children["*"]
+		if !ok {
+			return nil, fmt.Errorf("no child found for attribute %v in node %v", item.GetAttribute(n.PartitionKey), n.Path)
+		}
+	}
+
+	return child.Route(item)
+}
+
+// ForEachLeaf calls fn for each leaf node under this node.
+func (n *QueueTreeNode) ForEachLeaf(fn func(leaf *QueueTreeNode)) {
+	if len(n.Children) == 0 {
+		fn(n)
+		return
+	}
+
+	for _, child := range n.Children {
+		child.ForEachLeaf(fn)
+	}
+}
+
+// CollectSplits appends the paths of the nodes created by splits under this node, parents first.
+func (n *QueueTreeNode) CollectSplits(splits []string) []string {
+	if n.Dynamic {
+		splits = append(splits, n.Path)
+	}
+	for _, child := range n.Children {
+		splits = child.CollectSplits(splits)
+	}
+	return splits
+}
+
+func (n *QueueTreeNode) recordEnqueue(partitionVal any) {
+	n.statsMu.Lock()
+	defer n.statsMu.Unlock()
+	if n.enqueueCounts == nil {
+		n.enqueueCounts = map[any]int64{}
+	}
+	n.enqueueCounts[partitionVal]++
+}
+
+// takeEnqueueCounts returns the number of items enqueued by value of the partition key since the last call.
+func (n *QueueTreeNode) takeEnqueueCounts() map[any]int64 {
+	n.statsMu.Lock()
+	defer n.statsMu.Unlock()
+	counts := n.enqueueCounts
+	n.enqueueCounts = nil
+	return counts
+}
+
+// CollectOffsets adds the committed offsets of the leaf nodes under this node to the given map.
+func (n *QueueTreeNode) CollectOffsets(committedOffsets map[string]int64) {
+	if len(n.Children) == 0 {
+		if n.Dispatcher != nil {
+			committedOffsets[n.Path] = n.Dispatcher.CommittedOffset()
+		}
+		return
+	}
+
+	for _, child := range n.Children {
+		child.CollectOffsets(committedOffsets)
+	}
+}
+
 func (n *QueueTreeNode) String() string {
 	return fmt.Sprintf("QueueTreeNode{Path: %q, AttributeKey: %v, AttributeVal: %v, NodePolicy: %s, Num Children: %d}", n.Path, n.AttributeKey, n.AttributeVal, n.NodePolicy, len(n.Children))
 }
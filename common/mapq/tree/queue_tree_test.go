@@ -24,7 +24,10 @@ package tree
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/goleak"
@@ -48,14 +51,21 @@ func TestStartStop(t *testing.T) {
 	// - */transfer/*/*
 	// - */*/*/domain1
 	// - */*/*/*
-	consumerFactory.EXPECT().New(gomock.Any()).Return(consumer, nil).Times(7)
+	var gotLeafPaths []string
+	consumerFactory.EXPECT().New(gomock.Any()).DoAndReturn(func(partitions types.ItemPartitions) (types.Consumer, error) {
+		gotLeafPaths = append(gotLeafPaths, types.QueuePath(partitions))
+		return consumer, nil
+	}).Times(7)
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(nil, nil).Times(1)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 	tree, err := New(
 		testlogger.New(t),
 		metrics.NoopScope,
 		[]string{"type", "sub-type", "domain"},
 		getTestPolicies(),
-		types.NewMockPersister(ctrl),
+		persister,
 		consumerFactory,
 	)
 	if err != nil {
@@ -69,6 +79,133 @@ func TestStartStop(t *testing.T) {
 	if err := tree.Stop(context.Background()); err != nil {
 		t.Fatalf("failed to stop queue tree: %v", err)
 	}
+
+	// each consumer is created with the partitions of its own leaf node
+	wantLeafPaths := []string{
+		"*/*/*/*",
+		"*/*/*/domain1",
+		"*/timer/*/*",
+		"*/timer/*/domain1",
+		"*/timer/deletehistory/*",
+		"*/transfer/*/*",
+		"*/transfer/*/domain1",
+	}
+	sort.Strings(gotLeafPaths)
+	if diff := cmp.Diff(wantLeafPaths, gotLeafPaths); diff != "" {
+		t.Errorf("Leaf paths mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAckNackAndCommitOffsets(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctrl := gomock.NewController(t)
+
+	consumer := types.NewMockConsumer(ctrl)
+	consumer.EXPECT().Process(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	consumerFactory := types.NewMockConsumerFactory(ctrl)
+	consumerFactory.EXPECT().New(gomock.Any()).Return(consumer, nil).Times(7)
+
+	timerItem := mockItemWithOffset(t, map[string]any{"type": "timer", "sub-type": "usertimer", "domain": "domain1"}, 10)
+	transferItem := mockItemWithOffset(t, map[string]any{"type": "transfer", "sub-type": "activity", "domain": "domain2"}, 20)
+	fetched := map[string][]types.Item{
+		"*/timer/*/domain1": {timerItem},
+		"*/transfer/*/*":    {transferItem},
+	}
+
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(&types.Offsets{
+		CommittedOffsets: map[string]int64{"*/timer/*/domain1": 5},
+	}, nil).Times(1)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, partitions types.ItemPartitions, pageInfo types.PageInfo) ([]types.Item, error) {
+			var items []types.Item
+			for _, item := range fetched[types.QueuePath(partitions)] {
+				if item.Offset() > pageInfo.ExclusiveStartOffset {
+					items = append(items, item)
+				}
+			}
+			return items, nil
+		}).AnyTimes()
+
+	var gotOffsets *types.Offsets
+	persister.EXPECT().CommitOffsets(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, offsets *types.Offsets) error {
+		gotOffsets = offsets
+		return nil
+	}).Times(1)
+
+	tree, err := New(
+		testlogger.New(t),
+		metrics.NoopScope,
+		[]string{"type", "sub-type", "domain"},
+		getTestPolicies(),
+		persister,
+		consumerFactory,
+	)
+	if err != nil {
+		t.Fatalf("failed to create queue tree: %v", err)
+	}
+
+	if err := tree.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start queue tree: %v", err)
+	}
+	defer tree.Stop(context.Background())
+
+	// wait for the items to be dispatched
+	for _, item := range []types.Item{timerItem, transferItem} {
+		leaf, err := tree.root.Route(item)
+		if err != nil {
+			t.Fatalf("Route() failed: %v", err)
+		}
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline) && leaf.Dispatcher.CommittedOffset() != item.Offset(); time.Sleep(time.Millisecond) {
+			if err := tree.Ack(context.Background(), item); err != nil {
+				t.Fatalf("Ack() failed: %v", err)
+			}
+		}
+	}
+
+	if err := tree.Nack(context.Background(), timerItem); err != nil {
+		t.Fatalf("Nack() failed: %v", err)
+	}
+
+	if err := tree.CommitOffsets(context.Background()); err != nil {
+		t.Fatalf("CommitOffsets() failed: %v", err)
+	}
+
+	if got, want := gotOffsets.GetCommittedOffset("*/timer/*/domain1"), int64(10); got != want {
+		t.Errorf("Committed offset of timer queue = %v, want %v", got, want)
+	}
+	if got, want := gotOffsets.GetCommittedOffset("*/transfer/*/*"), int64(20); got != want {
+		t.Errorf("Committed offset of transfer queue = %v, want %v", got, want)
+	}
+	if got, want := gotOffsets.GetCommittedOffset("*/*/*/*"), types.InitialOffset; got != want {
+		t.Errorf("Committed offset of catch-all queue = %v, want %v", got, want)
+	}
+	if got, want := len(gotOffsets.CommittedOffsets), 7; got != want {
+		t.Errorf("Number of committed offsets = %v, want %v", got, want)
+	}
+}
+
+func TestAckBeforeStart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tree, err := New(
+		testlogger.New(t),
+		metrics.NoopScope,
+		[]string{"type", "sub-type", "domain"},
+		getTestPolicies(),
+		types.NewMockPersister(ctrl),
+		types.NewMockConsumerFactory(ctrl),
+	)
+	if err != nil {
+		t.Fatalf("failed to create queue tree: %v", err)
+	}
+
+	item := mockItemWithOffset(t, map[string]any{"type": "timer", "sub-type": "usertimer", "domain": "domain1"}, 10)
+	if err := tree.Ack(context.Background(), item); err == nil {
+		t.Error("Ack() succeeded for a tree which is not started")
+	}
+	if err := tree.Nack(context.Background(), nil); err == nil {
+		t.Error("Nack() succeeded for a nil item")
+	}
 }
 
 func TestEnqueue(t *testing.T) {
@@ -163,6 +300,8 @@ func TestEnqueue(t *testing.T) {
 				gotItemsToPersistByPersister = itemsToPersist
 				return tc.persistErr
 			})
+			persister.EXPECT().GetOffsets(gomock.Any()).Return(nil, nil).Times(1)
+			persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 			tree, err := New(
 				testlogger.New(t),
@@ -220,6 +359,16 @@ func mockItem(t *testing.T, attributes map[string]any) types.Item {
 	return item
 }
 
+func mockItemWithOffset(t *testing.T, attributes map[string]any, offset int64) types.Item {
+	item := types.NewMockItem(gomock.NewController(t))
+	item.EXPECT().GetAttribute(gomock.Any()).DoAndReturn(func(key string) any {
+		return attributes[key]
+	}).AnyTimes()
+	item.EXPECT().Offset().Return(offset).AnyTimes()
+	item.EXPECT().String().Return(fmt.Sprintf("mockitem{offset:%d}", offset)).AnyTimes()
+	return item
+}
+
 func getTestPolicies() []types.NodePolicy {
 	return []types.NodePolicy{
 		{
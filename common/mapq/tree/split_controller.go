@@ -0,0 +1,327 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tree
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/mapq/types"
+)
+
+const defaultSplitInterval = 10 * time.Second
+
+// splitLoop periodically splits and merges the nodes by their enqueue rates until the tree is stopped
+func (t *QueueTree) splitLoop() {
+	defer t.wg.Done()
+
+	ticker := t.timeSource.NewTicker(t.splitInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.Chan():
+			if err := t.evaluateSplits(t.ctx); err != nil {
+				t.logger.Error("Failed to split or merge nodes", tag.Error(err))
+			}
+		}
+	}
+}
+
+// evaluateSplits computes the enqueue rates of the partition values of each node since the last evaluation.
+// Values going to the catch-all child of a node whose rate is above the BurstRPS of its split policy, or whose share
+// of the rate of the node is above SkewRatio, get their own child node. Child nodes created by splits whose rate
+// stays below MergeRPS for MergeCoolDown are merged back into the catch-all node once all their items are ack'ed.
+// Each split is persisted with the offsets before the lock is released, i.e. before any item is routed to the new
+// node, so that the tree has the same shape after a restart.
+func (t *QueueTree) evaluateSplits(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.timeSource.Now()
+	lastEvaluation := t.lastSplitEvaluation
+	t.lastSplitEvaluation = now
+	elapsed := now.Sub(lastEvaluation).Seconds()
+	if !t.started || elapsed <= 0 {
+		return nil
+	}
+
+	var internalNodes []*QueueTreeNode
+	nodes := []*QueueTreeNode{t.root}
+	for len(nodes) > 0 {
+		n := nodes[0]
+		nodes = nodes[1:]
+		if len(n.Children) == 0 {
+			continue
+		}
+		internalNodes = append(internalNodes, n)
+		for _, child := range n.Children {
+			nodes = append(nodes, child)
+		}
+	}
+
+	for _, n := range internalNodes {
+		// counts are reset for all nodes so that they do not grow if the policy of a node changes
+		counts := n.takeEnqueueCounts()
+		policy := n.NodePolicy.SplitPolicy
+		if !policy.AutoSplitEnabled() {
+			continue
+		}
+
+		if err := t.splitHotValues(ctx, n, policy, counts, elapsed); err != nil {
+			return err
+		}
+
+		if err := t.mergeColdChildren(ctx, n, policy, counts, elapsed, lastEvaluation, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *QueueTree) splitHotValues(ctx context.Context, n *QueueTreeNode, policy *types.SplitPolicy, counts map[any]int64, elapsed float64) error {
+	var total int64
+	var candidates []any
+	for val, count := range counts {
+		total += count
+		if _, ok := n.Children[val]; !ok && isSplittable(val) {
+			candidates = append(candidates, val)
+		}
+	}
+	// the hottest values are split first in case MaxSplits is reached
+	sort.Slice(candidates, func(i, j int) bool {
+		if counts[candidates[i]] != counts[candidates[j]] {
+			return counts[candidates[i]] > counts[candidates[j]]
+		}
+		return candidates[i].(string) < candidates[j].(string)
+	})
+
+	numSplits := 0
+	for _, child := range n.Children {
+		if child.Dynamic {
+			numSplits++
+		}
+	}
+
+	for _, val := range candidates {
+		rate := float64(counts[val]) / elapsed
+		burst := policy.BurstRPS > 0 && rate >= policy.BurstRPS
+		skewed := policy.SkewRatio > 0 && rate >= policy.SkewMinRPS && float64(counts[val])/float64(total) >= policy.SkewRatio
+		if !burst && !skewed {
+			continue
+		}
+		if policy.MaxSplits > 0 && numSplits >= policy.MaxSplits {
+			t.logger.Warn("Not splitting hot partition value, the node reached its maximum number of splits",
+				tag.Dynamic("path", n.Path), tag.Dynamic("value", val), tag.Dynamic("rps", rate))
+			break
+		}
+
+		child, err := t.splitNode(ctx, n, val, nil)
+		if err != nil {
+			return fmt.Errorf("failed to split %v from node %s: %w", val, n.Path, err)
+		}
+		if err := t.persistSplit(ctx, n, child); err != nil {
+			return fmt.Errorf("failed to persist split %s: %w", child.Path, err)
+		}
+		t.logger.Info("Split hot partition value into its own node",
+			tag.Dynamic("path", n.Path), tag.Dynamic("value", val), tag.Dynamic("rps", rate), tag.Dynamic("burst", burst), tag.Dynamic("skewed", skewed))
+		numSplits++
+	}
+	return nil
+}
+
+// persistSplit commits the offsets with the split of child from n. If the commit fails the split is rolled back,
+// since after a restart the items enqueued to the child would be in a queue which is not part of the tree.
+// It must be called with the lock held, before any item is routed to the child.
+func (t *QueueTree) persistSplit(ctx context.Context, n *QueueTreeNode, child *QueueTreeNode) error {
+	err := t.persister.CommitOffsets(ctx, t.collectOffsets())
+	if err == nil {
+		return nil
+	}
+
+	delete(n.Children, child.AttributeVal)
+	if stopErr := child.Stop(ctx); stopErr != nil {
+		t.logger.Warn("Failed to stop the dispatchers of a split which failed to persist", tag.Dynamic("path", child.Path), tag.Error(stopErr))
+	}
+	return err
+}
+
+func (t *QueueTree) mergeColdChildren(
+	ctx context.Context,
+	n *QueueTreeNode,
+	policy *types.SplitPolicy,
+	counts map[any]int64,
+	elapsed float64,
+	lastEvaluation time.Time,
+	now time.Time,
+) error {
+	if policy.MergeRPS <= 0 {
+		return nil
+	}
+
+	for _, child := range n.Children {
+		if !child.Dynamic {
+			continue
+		}
+		rate := float64(counts[child.AttributeVal]) / elapsed
+		if rate >= policy.MergeRPS {
+			child.belowMergeRPSSince = time.Time{}
+			continue
+		}
+		if child.belowMergeRPSSince.IsZero() {
+			child.belowMergeRPSSince = lastEvaluation
+		}
+		if now.Sub(child.belowMergeRPSSince) < policy.MergeCoolDown {
+			continue
+		}
+
+		merged, err := t.mergeNode(ctx, n, child)
+		if err != nil {
+			return fmt.Errorf("failed to merge node %s: %w", child.Path, err)
+		}
+		if merged {
+			t.logger.Info("Merged cold node into the catch-all node", tag.Dynamic("path", child.Path), tag.Dynamic("rps", rate))
+		}
+	}
+	return nil
+}
+
+// splitNode creates the child node of n for the partition value with its catch-all descendants, and starts
+// its dispatchers if the tree is started. It must be called with the lock held.
+func (t *QueueTree) splitNode(ctx context.Context, n *QueueTreeNode, val any, offsets *types.Offsets) (*QueueTreeNode, error) {
+	child, err := n.addChild(val, t.policyCol, t.partitions)
+	if err != nil {
+		return nil, err
+	}
+	child.Dynamic = true
+
+	if err := t.constructInitialNodes(child); err != nil {
+		delete(n.Children, val)
+		return nil, err
+	}
+
+	if t.started {
+		if err := n.startChild(ctx, child, t.consumerFactory, t.persister, t.scheduler, offsets); err != nil {
+			delete(n.Children, val)
+			if stopErr := child.Stop(ctx); stopErr != nil {
+				t.logger.Warn("Failed to stop the dispatchers of a node which failed to start", tag.Error(stopErr))
+			}
+			return nil, err
+		}
+	}
+	return child, nil
+}
+
+// mergeNode removes the child node created by a split if all the items of its queues are ack'ed, so that its
+// partition value goes to the catch-all node again. It must be called with the lock held, which blocks enqueues.
+func (t *QueueTree) mergeNode(ctx context.Context, n *QueueTreeNode, child *QueueTreeNode) (bool, error) {
+	drained := true
+	var drainErr error
+	child.ForEachLeaf(func(leaf *QueueTreeNode) {
+		if !drained || drainErr != nil || leaf.Dispatcher == nil {
+			return
+		}
+		drained, drainErr = leaf.Dispatcher.Drained(ctx)
+	})
+	if drainErr != nil {
+		return false, drainErr
+	}
+	if !drained {
+		return false, nil
+	}
+
+	// the final offsets of the merged queues are committed so that their items are deleted,
+	// and the node is left out of the splits
+	offsets := t.collectOffsets()
+	splits := offsets.Splits[:0]
+	for _, path := range offsets.Splits {
+		if path != child.Path && !strings.HasPrefix(path, child.Path+"/") {
+			splits = append(splits, path)
+		}
+	}
+	offsets.Splits = splits
+	if err := t.persister.CommitOffsets(ctx, offsets); err != nil {
+		return false, err
+	}
+
+	delete(n.Children, child.AttributeVal)
+	if err := child.Stop(ctx); err != nil {
+		t.logger.Warn("Failed to stop the dispatchers of a merged node", tag.Dynamic("path", child.Path), tag.Error(err))
+	}
+	return true, nil
+}
+
+// restoreSplits creates the nodes of the persisted splits. Splits which do not fit the partitions of the tree
+// anymore are skipped. It must be called with the lock held, before the tree is started.
+func (t *QueueTree) restoreSplits(splits []string) error {
+	for _, path := range splits {
+		segments := strings.Split(path, "/")
+		if segments[0] != "*" || len(segments) < 2 || len(segments) > len(t.partitions)+1 {
+			t.logger.Warn("Skipping persisted split which does not fit the tree", tag.Dynamic("path", path))
+			continue
+		}
+
+		parent := t.root
+		for _, segment := range segments[1 : len(segments)-1] {
+			parent = findChild(parent, segment)
+			if parent == nil {
+				break
+			}
+		}
+		val := segments[len(segments)-1]
+		if parent == nil || !isSplittable(val) {
+			t.logger.Warn("Skipping persisted split which does not fit the tree", tag.Dynamic("path", path))
+			continue
+		}
+		if findChild(parent, val) != nil {
+			// the split became a predefined split
+			continue
+		}
+
+		if _, err := t.splitNode(context.Background(), parent, val, nil); err != nil {
+			return fmt.Errorf("failed to restore split %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+func findChild(n *QueueTreeNode, segment string) *QueueTreeNode {
+	for val, child := range n.Children {
+		if fmt.Sprint(val) == segment {
+			return child
+		}
+	}
+	return nil
+}
+
+// isSplittable returns true if the partition value can have its own node created at runtime.
+// Only strings are split since the values are restored from the paths of the nodes.
+func isSplittable(val any) bool {
+	s, ok := val.(string)
+	return ok && s != "" && s != "*" && !strings.Contains(s, "/")
+}
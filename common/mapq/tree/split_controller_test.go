@@ -0,0 +1,462 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/goleak"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const testEvaluationInterval = 10 * time.Second
+
+func TestSplitOnBurst(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	env := newSplitTestEnv(t, &types.SplitPolicy{BurstRPS: 10})
+	defer env.stop()
+
+	// 20 rps for the bursty domain, 0.5 rps for the other one
+	env.enqueue("bursty", 200)
+	env.enqueue("calm", 5)
+	env.evaluate(testEvaluationInterval)
+
+	env.assertSplits("*/bursty")
+	env.assertLeaves("*/*/*", "*/bursty/*")
+	if !env.tree.root.Children["bursty"].Dynamic {
+		t.Errorf("split node is not dynamic")
+	}
+
+	// new items of the bursty domain go to its own queue, the ones enqueued before the split stay in the catch-all queue
+	env.enqueue("bursty", 1)
+	if got, want := env.persister.numItems("*/bursty/*"), 1; got != want {
+		t.Errorf("items in split queue = %d, want %d", got, want)
+	}
+	if got, want := env.persister.numItems("*/*/*"), 205; got != want {
+		t.Errorf("items in catch-all queue = %d, want %d", got, want)
+	}
+
+	// the split is persisted with the offsets
+	if diff := cmp.Diff([]string{"*/bursty"}, env.persister.getOffsets().Splits); diff != "" {
+		t.Errorf("Persisted splits mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSplitRolledBackIfNotPersisted(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	env := newSplitTestEnv(t, &types.SplitPolicy{BurstRPS: 10})
+	defer env.stop()
+
+	env.enqueue("bursty", 200)
+	env.persister.setCommitErr(errors.New("persistence unavailable"))
+	env.timeSource.Advance(testEvaluationInterval)
+	if err := env.tree.evaluateSplits(context.Background()); err == nil {
+		t.Fatalf("evaluateSplits() succeeded, want error")
+	}
+
+	// the items of the domain keep going to the catch-all queue, which is restored after a restart
+	env.assertSplits()
+	env.assertLeaves("*/*/*")
+	env.enqueue("bursty", 1)
+	if got, want := env.persister.numItems("*/*/*"), 201; got != want {
+		t.Errorf("items in catch-all queue = %d, want %d", got, want)
+	}
+
+	// the split is made again once the offsets can be committed
+	env.persister.setCommitErr(nil)
+	env.enqueue("bursty", 200)
+	env.evaluate(testEvaluationInterval)
+	env.assertSplits("*/bursty")
+	if diff := cmp.Diff([]string{"*/bursty"}, env.persister.getOffsets().Splits); diff != "" {
+		t.Errorf("Persisted splits mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSplitOnSkew(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	env := newSplitTestEnv(t, &types.SplitPolicy{BurstRPS: 1000, SkewRatio: 0.5, SkewMinRPS: 2})
+	defer env.stop()
+
+	// 80% of the items belong to the skewed domain, none of the domains is above the burst rate
+	env.enqueue("skewed", 80)
+	env.enqueue("a", 10)
+	env.enqueue("b", 10)
+	env.evaluate(testEvaluationInterval)
+	env.assertSplits("*/skewed")
+
+	// a skewed domain below the minimum rate is not split
+	env.enqueue("slow", 8)
+	env.enqueue("a", 1)
+	env.evaluate(testEvaluationInterval)
+	env.assertSplits("*/skewed")
+}
+
+func TestMaxSplits(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	env := newSplitTestEnv(t, &types.SplitPolicy{BurstRPS: 1, MaxSplits: 1})
+	defer env.stop()
+
+	env.enqueue("hottest", 30)
+	env.enqueue("hot", 20)
+	env.evaluate(testEvaluationInterval)
+
+	// the hottest value is split first
+	env.assertSplits("*/hottest")
+}
+
+func TestMergeAfterCoolDown(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	env := newSplitTestEnv(t, &types.SplitPolicy{BurstRPS: 10, MergeRPS: 1, MergeCoolDown: time.Minute})
+	defer env.stop()
+
+	env.enqueue("bursty", 200)
+	env.evaluate(testEvaluationInterval)
+	env.assertSplits("*/bursty")
+
+	// the burst is over but the items of the split queue are not ack'ed yet
+	env.enqueue("bursty", 3)
+	env.evaluate(testEvaluationInterval)
+	env.evaluate(time.Minute)
+	env.assertSplits("*/bursty")
+
+	// once the queue is drained and the rate stayed low for the cool-down, the node is merged
+	env.ackAll("*/bursty/*", 3)
+	env.evaluate(testEvaluationInterval)
+	env.assertSplits()
+	env.assertLeaves("*/*/*")
+	if got := env.persister.getOffsets().Splits; len(got) != 0 {
+		t.Errorf("Persisted splits = %v, want none", got)
+	}
+	if got := env.persister.numItems("*/bursty/*"); got != 0 {
+		t.Errorf("items in merged queue = %d, want 0", got)
+	}
+
+	// the items of the merged domain go to the catch-all queue again
+	env.enqueue("bursty", 1)
+	if got, want := env.persister.numItems("*/*/*"), 201; got != want {
+		t.Errorf("items in catch-all queue = %d, want %d", got, want)
+	}
+}
+
+func TestNoMergeWhileRateIsHigh(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	env := newSplitTestEnv(t, &types.SplitPolicy{BurstRPS: 10, MergeRPS: 1, MergeCoolDown: time.Minute})
+	defer env.stop()
+
+	env.enqueue("bursty", 200)
+	env.evaluate(testEvaluationInterval)
+
+	// the rate goes below the merge rate and back above it before the cool-down is over
+	env.evaluate(50 * time.Second)
+	env.enqueue("bursty", 50)
+	env.evaluate(testEvaluationInterval)
+	env.ackAll("*/bursty/*", 50)
+	env.evaluate(50 * time.Second)
+	env.assertSplits("*/bursty")
+
+	env.evaluate(testEvaluationInterval)
+	env.assertSplits()
+}
+
+func TestSplitsAreRestoredOnStart(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	persister := newMemPersister()
+	persister.offsets = &types.Offsets{
+		CommittedOffsets: map[string]int64{"*/restored/*": 42},
+		Splits:           []string{"*/restored", "*/not/fitting/the/tree", "*/restored/x"},
+	}
+	env := newSplitTestEnvWithPersister(t, &types.SplitPolicy{BurstRPS: 10}, persister)
+	defer env.stop()
+
+	env.assertSplits("*/restored", "*/restored/x")
+	env.assertLeaves("*/*/*", "*/restored/*", "*/restored/x")
+	if got := env.tree.root.Children["restored"].Children["*"].Dispatcher.CommittedOffset(); got != 42 {
+		t.Errorf("CommittedOffset() of restored queue = %d, want 42", got)
+	}
+}
+
+func TestAckOfItemDispatchedBeforeSplit(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	env := newSplitTestEnv(t, &types.SplitPolicy{BurstRPS: 10})
+	defer env.stop()
+
+	env.enqueue("bursty", 200)
+	// the catch-all queue dispatches up to its default concurrency without acks
+	env.waitForDispatched("*/*/*", 100)
+	env.evaluate(testEvaluationInterval)
+	env.assertSplits("*/bursty")
+
+	// the items are routed to the split node now, but they are ack'ed in the catch-all queue which dispatched them
+	env.ackAll("*/*/*", 100)
+	if got := env.tree.root.Children["*"].Children["*"].Dispatcher.CommittedOffset(); got != 100 {
+		t.Errorf("CommittedOffset() of catch-all queue = %d, want 100", got)
+	}
+}
+
+type splitTestEnv struct {
+	t          *testing.T
+	tree       *QueueTree
+	timeSource clock.MockedTimeSource
+	persister  *memPersister
+	consumers  *recordingConsumerFactory
+	offset     int64
+}
+
+func newSplitTestEnv(t *testing.T, policy *types.SplitPolicy) *splitTestEnv {
+	return newSplitTestEnvWithPersister(t, policy, newMemPersister())
+}
+
+func newSplitTestEnvWithPersister(t *testing.T, policy *types.SplitPolicy, persister *memPersister) *splitTestEnv {
+	timeSource := clock.NewMockedTimeSource()
+	consumers := &recordingConsumerFactory{processed: map[string][]types.Item{}}
+	tree, err := New(
+		testlogger.New(t),
+		metrics.NoopScope,
+		[]string{"domain", "type"},
+		[]types.NodePolicy{
+			{Path: "*", SplitPolicy: policy},
+			// only the domains are split
+			{Path: "*/.", SplitPolicy: &types.SplitPolicy{Disabled: true}},
+			{Path: "*/*", SplitPolicy: &types.SplitPolicy{Disabled: true}},
+		},
+		persister,
+		consumers,
+		WithTimeSource(timeSource),
+		// splits are evaluated by the tests
+		WithSplitInterval(24*time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("failed to create queue tree: %v", err)
+	}
+	if err := tree.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start queue tree: %v", err)
+	}
+	return &splitTestEnv{t: t, tree: tree, timeSource: timeSource, persister: persister, consumers: consumers}
+}
+
+func (e *splitTestEnv) stop() {
+	if err := e.tree.Stop(context.Background()); err != nil {
+		e.t.Errorf("failed to stop queue tree: %v", err)
+	}
+}
+
+func (e *splitTestEnv) enqueue(domain string, n int) {
+	e.t.Helper()
+	var items []types.Item
+	for i := 0; i < n; i++ {
+		e.offset++
+		items = append(items, &testItem{attributes: map[string]any{"domain": domain, "type": "start"}, offset: e.offset})
+	}
+	if _, err := e.tree.Enqueue(context.Background(), items); err != nil {
+		e.t.Fatalf("Enqueue() failed: %v", err)
+	}
+}
+
+func (e *splitTestEnv) evaluate(elapsed time.Duration) {
+	e.t.Helper()
+	e.timeSource.Advance(elapsed)
+	if err := e.tree.evaluateSplits(context.Background()); err != nil {
+		e.t.Fatalf("evaluateSplits() failed: %v", err)
+	}
+}
+
+func (e *splitTestEnv) waitForDispatched(path string, n int) []types.Item {
+	e.t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if items := e.consumers.getProcessed(path); len(items) >= n {
+			return items
+		}
+	}
+	e.t.Fatalf("%d items of %s were not dispatched in time", n, path)
+	return nil
+}
+
+func (e *splitTestEnv) ackAll(path string, n int) {
+	e.t.Helper()
+	for _, item := range e.waitForDispatched(path, n) {
+		if err := e.tree.Ack(context.Background(), item); err != nil {
+			e.t.Fatalf("Ack() failed: %v", err)
+		}
+	}
+}
+
+func (e *splitTestEnv) assertSplits(want ...string) {
+	e.t.Helper()
+	e.tree.mu.RLock()
+	got := e.tree.collectOffsets().Splits
+	e.tree.mu.RUnlock()
+	if diff := cmp.Diff(want, got, cmpEmptyAsNil()); diff != "" {
+		e.t.Errorf("Splits mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func (e *splitTestEnv) assertLeaves(want ...string) {
+	e.t.Helper()
+	var got []string
+	e.tree.mu.RLock()
+	e.tree.root.ForEachLeaf(func(leaf *QueueTreeNode) {
+		got = append(got, leaf.Path)
+	})
+	e.tree.mu.RUnlock()
+	sort.Strings(got)
+	if diff := cmp.Diff(want, got); diff != "" {
+		e.t.Errorf("Leaves mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func cmpEmptyAsNil() cmp.Option {
+	return cmp.Transformer("emptyAsNil", func(s []string) []string {
+		if len(s) == 0 {
+			return nil
+		}
+		return s
+	})
+}
+
+type testItem struct {
+	attributes map[string]any
+	offset     int64
+}
+
+func (i *testItem) GetAttribute(key string) any { return i.attributes[key] }
+func (i *testItem) Offset() int64               { return i.offset }
+func (i *testItem) String() string              { return fmt.Sprintf("testItem{offset:%d}", i.offset) }
+
+// memPersister stores the items and offsets in memory
+type memPersister struct {
+	mu        sync.Mutex
+	items     map[string][]types.Item
+	offsets   *types.Offsets
+	commitErr error
+}
+
+func newMemPersister() *memPersister {
+	return &memPersister{items: map[string][]types.Item{}}
+}
+
+func (p *memPersister) Persist(_ context.Context, items []types.ItemToPersist) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, item := range items {
+		path := types.QueuePath(item)
+		p.items[path] = append(p.items[path], item)
+	}
+	return nil
+}
+
+func (p *memPersister) GetOffsets(context.Context) (*types.Offsets, error) {
+	return p.getOffsets(), nil
+}
+
+func (p *memPersister) getOffsets() *types.Offsets {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.offsets
+}
+
+func (p *memPersister) CommitOffsets(_ context.Context, offsets *types.Offsets) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.commitErr != nil {
+		return p.commitErr
+	}
+	p.offsets = offsets
+	for path, committed := range offsets.CommittedOffsets {
+		var remaining []types.Item
+		for _, item := range p.items[path] {
+			if item.Offset() > committed {
+				remaining = append(remaining, item)
+			}
+		}
+		p.items[path] = remaining
+	}
+	return nil
+}
+
+func (p *memPersister) Fetch(_ context.Context, partitions types.ItemPartitions, pageInfo types.PageInfo) ([]types.Item, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var items []types.Item
+	for _, item := range p.items[types.QueuePath(partitions)] {
+		if item.Offset() > pageInfo.ExclusiveStartOffset && len(items) < pageInfo.PageSize {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (p *memPersister) setCommitErr(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.commitErr = err
+}
+
+func (p *memPersister) numItems(path string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.items[path])
+}
+
+// recordingConsumerFactory creates consumers which record the processed items by the path of their queue
+type recordingConsumerFactory struct {
+	mu        sync.Mutex
+	processed map[string][]types.Item
+}
+
+func (f *recordingConsumerFactory) New(partitions types.ItemPartitions) (types.Consumer, error) {
+	return &recordingConsumer{factory: f, path: types.QueuePath(partitions)}, nil
+}
+
+func (f *recordingConsumerFactory) Stop(context.Context) error { return nil }
+
+func (f *recordingConsumerFactory) getProcessed(path string) []types.Item {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]types.Item(nil), f.processed[path]...)
+}
+
+type recordingConsumer struct {
+	factory *recordingConsumerFactory
+	path    string
+}
+
+func (c *recordingConsumer) Start(context.Context) error { return nil }
+func (c *recordingConsumer) Stop(context.Context) error  { return nil }
+
+func (c *recordingConsumer) Process(_ context.Context, item types.Item) error {
+	c.factory.mu.Lock()
+	defer c.factory.mu.Unlock()
+	c.factory.processed[c.path] = append(c.factory.processed[c.path], item)
+	return nil
+}
@@ -39,5 +39,9 @@ type ConsumerFactory interface {
 type Consumer interface {
 	Start(context.Context) error
 	Stop(context.Context) error
+
+	// Process is called for each item dispatched from the leaf queue of the consumer.
+	// The item must be ack'ed via Client.Ack once it is processed, or nack'ed via Client.Nack to be dispatched again.
+	// Returning an error is equivalent to nack'ing the item.
 	Process(context.Context, Item) error
 }
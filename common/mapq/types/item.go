@@ -24,7 +24,10 @@ package types
 
 //go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination item_mock.go -package types github.com/uber/cadence/common/mapq/types Item
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type Item interface {
 	// GetAttribute returns the value of the attribute key.
@@ -33,7 +36,8 @@ type Item interface {
 	// identifiers in the queue tree.
 	GetAttribute(key string) any
 
-	// Offset returns the offset of the item in the queue. e.g. monotonically increasing sequence number or a timestamp
+	// Offset returns the offset of the item in its leaf queue.
+	// Offsets are assigned by the persister when the items are persisted, so only the items fetched from the persister have one.
 	Offset() int64
 
 	// String returns a human friendly representation of the item for logging purposes
@@ -68,6 +72,17 @@ type ItemPartitions interface {
 	String() string
 }
 
+// QueuePath returns the path of the leaf node of the queue tree which stores the items with the given partitions.
+// e.g. "*/timer/*/domain1" for the partition keys ["type", "sub-type", "domain"]
+func QueuePath(partitions ItemPartitions) string {
+	var sb strings.Builder
+	sb.WriteString("*")
+	for _, key := range partitions.GetPartitionKeys() {
+		fmt.Fprintf(&sb, "/%v", partitions.GetPartitionValue(key))
+	}
+	return sb.String()
+}
+
 func NewItemPartitions(partitionKeys []string, partitionMap map[string]any) ItemPartitions {
 	return &defaultItemPartitions{
 		partitionKeys: partitionKeys,
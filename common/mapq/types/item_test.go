@@ -79,3 +79,33 @@ func TestNewItemToPersist(t *testing.T) {
 		t.Errorf("itemToPersist.String() = %v, want to contain %v", itemToPersistStr, itemStr)
 	}
 }
+
+func TestQueuePath(t *testing.T) {
+	tests := []struct {
+		name       string
+		partitions ItemPartitions
+		want       string
+	}{
+		{
+			name:       "root",
+			partitions: NewItemPartitions(nil, nil),
+			want:       "*",
+		},
+		{
+			name: "multiple levels",
+			partitions: NewItemPartitions(
+				[]string{"type", "sub-type", "domain"},
+				map[string]any{"type": "timer", "sub-type": 4, "domain": "*"},
+			),
+			want: "*/timer/4/*",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := QueuePath(tc.partitions); got != tc.want {
+				t.Errorf("QueuePath() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -22,7 +22,41 @@
 
 package types
 
+import (
+	"fmt"
+	"math"
+)
+
+// InitialOffset is the committed offset of a leaf queue which has no ack'ed items yet.
+const InitialOffset int64 = math.MinInt64
+
 // Offsets encapsulates the whole queue tree state including the offsets of each leaf node
 type Offsets struct {
-	// TODO: complete
+	// CommittedOffsets contains the committed offset of each leaf queue by the path of its node. See QueuePath.
+	// All items of a leaf queue up to its committed offset are ack'ed.
+	CommittedOffsets map[string]int64 `json:"committedOffsets,omitempty"`
+
+	// Splits contains the paths of the nodes created by splits at runtime, parents first.
+	// They are created again when the tree is started.
+	Splits []string `json:"splits,omitempty"`
+}
+
+// GetCommittedOffset returns the committed offset of the leaf queue with the given path,
+// or InitialOffset if no offset was committed for it.
+func (o *Offsets) GetCommittedOffset(path string) int64 {
+	if o == nil {
+		return InitialOffset
+	}
+	offset, ok := o.CommittedOffsets[path]
+	if !ok {
+		return InitialOffset
+	}
+	return offset
+}
+
+func (o *Offsets) String() string {
+	if o == nil {
+		return "Offsets{}"
+	}
+	return fmt.Sprintf("Offsets{CommittedOffsets:%v, Splits:%v}", o.CommittedOffsets, o.Splits)
 }
@@ -0,0 +1,39 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package types
+
+import "testing"
+
+func TestGetCommittedOffset(t *testing.T) {
+	var nilOffsets *Offsets
+	if got := nilOffsets.GetCommittedOffset("*/a"); got != InitialOffset {
+		t.Errorf("GetCommittedOffset() = %v, want %v for nil offsets", got, InitialOffset)
+	}
+
+	offsets := &Offsets{CommittedOffsets: map[string]int64{"*/a": 42}}
+	if got := offsets.GetCommittedOffset("*/a"); got != 42 {
+		t.Errorf("GetCommittedOffset() = %v, want %v", got, 42)
+	}
+	if got := offsets.GetCommittedOffset("*/b"); got != InitialOffset {
+		t.Errorf("GetCommittedOffset() = %v, want %v for unknown queue", got, InitialOffset)
+	}
+}
@@ -27,12 +27,28 @@ import "context"
 //go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination persister_mock.go -package types github.com/uber/cadence/common/mapq/types Persister
 
 type Persister interface {
+	// Persist writes the items to the leaf queues identified by their partitions.
+	// The persister assigns the offsets of the items, which increase in the order the items are persisted to a leaf queue.
+	// Items must become visible to Fetch in offset order, so that a fetch never skips an item persisted later with a lower offset.
 	Persist(ctx context.Context, items []ItemToPersist) error
+
+	// GetOffsets returns the last committed offsets. It returns nil if no offsets were committed yet.
 	GetOffsets(ctx context.Context) (*Offsets, error)
+
+	// CommitOffsets persists the offsets. Items whose offset is lower than or equal to the committed offset
+	// of their leaf queue are ack'ed and the persister may delete them.
 	CommitOffsets(ctx context.Context, offsets *Offsets) error
+
+	// Fetch returns the items of the leaf queue identified by the partitions whose offset is greater than
+	// pageInfo.ExclusiveStartOffset, ordered by offset. At most pageInfo.PageSize items are returned.
 	Fetch(ctx context.Context, partitions ItemPartitions, pageInfo PageInfo) ([]Item, error)
 }
 
 type PageInfo struct {
-	// TODO: define ack levels, page size, etc.
+	// ExclusiveStartOffset is the offset after which items are fetched.
+	// It is the offset of the last item fetched from the leaf queue, or its committed offset when the queue is (re)started.
+	ExclusiveStartOffset int64
+
+	// PageSize is the maximum number of items to fetch.
+	PageSize int
 }
@@ -25,6 +25,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 type DispatchPolicy struct {
@@ -36,11 +37,15 @@ type DispatchPolicy struct {
 	// Concurrency is the maximum number of items to be processed concurrently.
 	Concurrency int `json:"concurrency,omitempty"`
 
+	// Weight is the share of the dispatch capacity of the tree the node gets relative to the other leaf nodes
+	// when they all have items to dispatch. Defaults to 1. Only used when the client has a dispatch capacity.
+	Weight int `json:"weight,omitempty"`
+
 	// TODO: define retry policy
 }
 
 func (dp DispatchPolicy) String() string {
-	return fmt.Sprintf("DispatchPolicy{DispatchRPS:%d, Concurrency:%d}", dp.DispatchRPS, dp.Concurrency)
+	return fmt.Sprintf("DispatchPolicy{DispatchRPS:%d, Concurrency:%d, Weight:%d}", dp.DispatchRPS, dp.Concurrency, dp.Weight)
 }
 
 type SplitPolicy struct {
@@ -50,10 +55,40 @@ type SplitPolicy struct {
 	// PredefinedSplits is a list of predefined splits for the attribute key
 	// Child nodes for these attributes will be created during initialization
 	PredefinedSplits []any `json:"predefinedSplits,omitempty"`
+
+	// BurstRPS is the enqueue rate above which a value of the partition key of the node, whose items go to
+	// the catch-all child, gets its own child node. 0 disables splits by burst.
+	// Only string values are split, the items enqueued before the split stay in the catch-all queue.
+	BurstRPS float64 `json:"burstRPS,omitempty"`
+
+	// SkewRatio is the share of the enqueue rate of the node above which a value of the partition key gets its
+	// own child node, if its rate is at least SkewMinRPS. 0 disables splits by skew.
+	SkewRatio float64 `json:"skewRatio,omitempty"`
+
+	// SkewMinRPS is the minimum enqueue rate of a value to be split by skew.
+	SkewMinRPS float64 `json:"skewMinRPS,omitempty"`
+
+	// MergeRPS is the enqueue rate below which a child node created by a split is merged back into the catch-all
+	// node, after it stays below it for MergeCoolDown and all its items are ack'ed. 0 disables merges.
+	MergeRPS float64 `json:"mergeRPS,omitempty"`
+
+	// MergeCoolDown is how long the enqueue rate of a child node created by a split must stay below MergeRPS before it is merged.
+	MergeCoolDown time.Duration `json:"mergeCoolDown,omitempty"`
+
+	// MaxSplits is the maximum number of child nodes created by splits. 0 means no limit.
+	MaxSplits int `json:"maxSplits,omitempty"`
 }
 
 func (sp SplitPolicy) String() string {
-	return fmt.Sprintf("SplitPolicy{Disabled:%v, PredefinedSplits:%v}", sp.Disabled, sp.PredefinedSplits)
+	return fmt.Sprintf(
+		"SplitPolicy{Disabled:%v, PredefinedSplits:%v, BurstRPS:%v, SkewRatio:%v, SkewMinRPS:%v, MergeRPS:%v, MergeCoolDown:%v, MaxSplits:%d}",
+		sp.Disabled, sp.PredefinedSplits, sp.BurstRPS, sp.SkewRatio, sp.SkewMinRPS, sp.MergeRPS, sp.MergeCoolDown, sp.MaxSplits,
+	)
+}
+
+// AutoSplitEnabled returns true if the policy splits or merges the children of the node at runtime.
+func (sp *SplitPolicy) AutoSplitEnabled() bool {
+	return sp != nil && !sp.Disabled && (sp.BurstRPS > 0 || sp.SkewRatio > 0 || sp.MergeRPS > 0)
 }
 
 type NodePolicy struct {
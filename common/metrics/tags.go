@@ -72,6 +72,10 @@ const (
 	isRetry                   = "is_retry"
 	queryConsistencyLevel     = "query_consistency_level"
 	budgetManagerName         = "budget_manager_name"
+	mapqQueue                 = "mapq_queue"
+	queueAlertType            = "queue_alert_type"
+	queueMitigationAction     = "queue_mitigation_action"
+	taskPriority              = "task_priority"
 
 	// limiter-side tags
 	globalRatelimitKey            = "global_ratelimit_key"
@@ -389,3 +393,23 @@ func QueryConsistencyLevelTag(level string) Tag {
 func BudgetManagerNameTag(name string) Tag {
 	return metricWithUnknown(budgetManagerName, name)
 }
+
+// MapQQueueTag returns a new MAPQ queue tag with the path of the leaf node of the queue.
+func MapQQueueTag(path string) Tag {
+	return metricWithUnknown(mapqQueue, path)
+}
+
+// QueueAlertTypeTag returns a new history queue alert type tag.
+func QueueAlertTypeTag(alertType string) Tag {
+	return metricWithUnknown(queueAlertType, alertType)
+}
+
+// QueueMitigationActionTag returns a new history queue mitigation action tag.
+func QueueMitigationActionTag(action string) Tag {
+	return metricWithUnknown(queueMitigationAction, action)
+}
+
+// TaskPriorityTag returns a new matching task priority level tag.
+func TaskPriorityTag(priority string) Tag {
+	return metricWithUnknown(taskPriority, priority)
+}
@@ -0,0 +1,202 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dnsprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/membership"
+)
+
+// Mode is an enum type for the way the peers of a service are discovered
+type Mode int
+
+const (
+	// ModeNone represents a mode set to nothing or invalid
+	ModeNone Mode = iota
+	// ModeStatic represents a static list of ip:port hosts passed in the configuration
+	ModeStatic
+	// ModeDNS represents a list of host:port passed in the configuration to be resolved,
+	// every address the host resolves to is a peer listening on the port.
+	// This is the mode to use with a Kubernetes headless service
+	ModeDNS
+	// ModeDNSSRV represents a list of DNS SRV names passed in the configuration,
+	// every address the targets of the SRV records resolve to is a peer listening on the port of the record
+	ModeDNSSRV
+)
+
+const (
+	defaultRefreshInterval = 10 * time.Second
+	defaultResolveTimeout  = 5 * time.Second
+)
+
+// Config contains the config items of the DNS peer provider
+type Config struct {
+	// BroadcastAddress is the IP address of this host which the other hosts discover in DNS.
+	// In Kubernetes this is the pod IP.
+	BroadcastAddress string `yaml:"broadcastAddress" validate:"nonzero"`
+	// RefreshInterval is how often the peers are discovered again, default to 10s
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+	// ResolveTimeout is the timeout of discovering the peers of a service, default to 5s
+	ResolveTimeout time.Duration `yaml:"resolveTimeout"`
+	// Services contains the way the peers of each service are discovered, keyed by service name, e.g. cadence-history
+	Services map[string]ServiceConfig `yaml:"services"`
+}
+
+// ServiceConfig contains the way the peers of a service are discovered
+type ServiceConfig struct {
+	// Mode is a enum that defines how Hosts are discovered, currently supports: static, dns, and dns-srv
+	Mode Mode `yaml:"mode"`
+	// Hosts is a list of ip:port for static mode, host:port for dns mode and SRV names for dns-srv mode.
+	// The port is the tchannel port of the peers.
+	Hosts []string `yaml:"hosts"`
+	// GRPCPort is the gRPC port of the peers, optional
+	GRPCPort uint16 `yaml:"grpcPort"`
+}
+
+func (cfg *Config) validate() error {
+	if net.ParseIP(cfg.BroadcastAddress) == nil {
+		return fmt.Errorf("dns peer provider config has invalid `broadcastAddress` %q", cfg.BroadcastAddress)
+	}
+	if cfg.RefreshInterval == 0 {
+		cfg.RefreshInterval = defaultRefreshInterval
+	}
+	if cfg.ResolveTimeout == 0 {
+		cfg.ResolveTimeout = defaultResolveTimeout
+	}
+	if len(cfg.Services) == 0 {
+		return fmt.Errorf("dns peer provider config missing `services` param")
+	}
+	for service, serviceConfig := range cfg.Services {
+		if len(serviceConfig.Hosts) == 0 {
+			return fmt.Errorf("dns peer provider config missing hosts of service %q", service)
+		}
+		switch serviceConfig.Mode {
+		case ModeStatic:
+			for _, host := range serviceConfig.Hosts {
+				ip, _, err := net.SplitHostPort(host)
+				if err != nil || net.ParseIP(ip) == nil {
+					return fmt.Errorf("dns peer provider config has invalid static host %q of service %q", host, service)
+				}
+			}
+		case ModeDNS:
+			for _, host := range serviceConfig.Hosts {
+				if _, _, err := net.SplitHostPort(host); err != nil {
+					return fmt.Errorf("dns peer provider config has invalid host %q of service %q: %w", host, service, err)
+				}
+			}
+		case ModeDNSSRV:
+		default:
+			return fmt.Errorf("dns peer provider config has unknown mode %v of service %q", serviceConfig.Mode, service)
+		}
+	}
+	return nil
+}
+
+// UnmarshalYAML is called by the yaml package to convert
+// the config YAML into a Mode.
+func (m *Mode) UnmarshalYAML(
+	unmarshal func(interface{}) error,
+) error {
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	var err error
+	*m, err = parseMode(s)
+	return err
+}
+
+// parseMode reads a string value and returns a mode.
+func parseMode(
+	mode string,
+) (Mode, error) {
+
+	switch strings.ToLower(mode) {
+	case "static":
+		return ModeStatic, nil
+	case "dns":
+		return ModeDNS, nil
+	case "dns-srv":
+		return ModeDNSSRV, nil
+	}
+	return ModeNone, fmt.Errorf("invalid dns peer provider mode %q", mode)
+}
+
+// resolver is the subset of net.Resolver used to discover the peers
+type resolver interface {
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+	LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+}
+
+// resolve returns the ip:port addresses of the peers of a service
+func (cfg ServiceConfig) resolve(ctx context.Context, r resolver) ([]string, error) {
+	var results []string
+	for _, host := range cfg.Hosts {
+		switch cfg.Mode {
+		case ModeStatic:
+			results = append(results, host)
+		case ModeDNS:
+			name, port, err := net.SplitHostPort(host)
+			if err != nil {
+				return nil, err
+			}
+			addrs, err := r.LookupHost(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("resolving host %q: %w", name, err)
+			}
+			for _, addr := range addrs {
+				results = append(results, net.JoinHostPort(addr, port))
+			}
+		case ModeDNSSRV:
+			_, srvs, err := r.LookupSRV(ctx, "", "", host)
+			if err != nil {
+				return nil, fmt.Errorf("resolving srv %q: %w", host, err)
+			}
+			for _, srv := range srvs {
+				addrs, err := r.LookupHost(ctx, srv.Target)
+				if err != nil {
+					return nil, fmt.Errorf("resolving srv target %q: %w", srv.Target, err)
+				}
+				for _, addr := range addrs {
+					results = append(results, net.JoinHostPort(addr, fmt.Sprint(srv.Port)))
+				}
+			}
+		}
+	}
+	return results, nil
+}
+
+// portMap returns the ports of a peer listening on the given tchannel port
+func (cfg ServiceConfig) portMap(tchannelPort uint16) membership.PortMap {
+	portMap := membership.PortMap{membership.PortTchannel: tchannelPort}
+	if cfg.GRPCPort != 0 {
+		portMap[membership.PortGRPC] = cfg.GRPCPort
+	}
+	return portMap
+}
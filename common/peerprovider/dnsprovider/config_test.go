@@ -0,0 +1,155 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dnsprovider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestConfig(t *testing.T) {
+	var cfg Config
+	err := yaml.Unmarshal([]byte(`
+broadcastAddress: 10.0.0.1
+refreshInterval: 30s
+services:
+  cadence-frontend:
+    mode: static
+    hosts:
+      - 10.0.0.1:7933
+      - 10.0.0.2:7933
+  cadence-history:
+    mode: dns
+    hosts:
+      - cadence-history-headless:7934
+    grpcPort: 7834
+  cadence-matching:
+    mode: dns-srv
+    hosts:
+      - _tchannel._tcp.cadence-matching-headless
+`), &cfg)
+	require.NoError(t, err)
+	require.NoError(t, cfg.validate())
+
+	assert.Equal(t, "10.0.0.1", cfg.BroadcastAddress)
+	assert.Equal(t, 30*time.Second, cfg.RefreshInterval)
+	assert.Equal(t, defaultResolveTimeout, cfg.ResolveTimeout)
+	assert.Equal(t, ServiceConfig{Mode: ModeStatic, Hosts: []string{"10.0.0.1:7933", "10.0.0.2:7933"}}, cfg.Services["cadence-frontend"])
+	assert.Equal(t, ServiceConfig{Mode: ModeDNS, Hosts: []string{"cadence-history-headless:7934"}, GRPCPort: 7834}, cfg.Services["cadence-history"])
+	assert.Equal(t, ServiceConfig{Mode: ModeDNSSRV, Hosts: []string{"_tchannel._tcp.cadence-matching-headless"}}, cfg.Services["cadence-matching"])
+}
+
+func TestConfigInvalidMode(t *testing.T) {
+	var cfg Config
+	err := yaml.Unmarshal([]byte(`
+services:
+  cadence-history:
+    mode: gossip
+`), &cfg)
+	assert.EqualError(t, err, `invalid dns peer provider mode "gossip"`)
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		cfg Config
+		err string
+	}{
+		"invalid broadcast address": {
+			cfg: Config{
+				BroadcastAddress: "localhost",
+				Services:         map[string]ServiceConfig{"svc": {Mode: ModeDNS, Hosts: []string{"svc:1"}}},
+			},
+			err: `dns peer provider config has invalid ` + "`broadcastAddress`" + ` "localhost"`,
+		},
+		"no services": {
+			cfg: Config{BroadcastAddress: "10.0.0.1"},
+			err: "dns peer provider config missing `services` param",
+		},
+		"no hosts": {
+			cfg: Config{
+				BroadcastAddress: "10.0.0.1",
+				Services:         map[string]ServiceConfig{"svc": {Mode: ModeDNS}},
+			},
+			err: `dns peer provider config missing hosts of service "svc"`,
+		},
+		"static host is not an ip": {
+			cfg: Config{
+				BroadcastAddress: "10.0.0.1",
+				Services:         map[string]ServiceConfig{"svc": {Mode: ModeStatic, Hosts: []string{"svc:1"}}},
+			},
+			err: `dns peer provider config has invalid static host "svc:1" of service "svc"`,
+		},
+		"dns host without port": {
+			cfg: Config{
+				BroadcastAddress: "10.0.0.1",
+				Services:         map[string]ServiceConfig{"svc": {Mode: ModeDNS, Hosts: []string{"svc"}}},
+			},
+			err: `dns peer provider config has invalid host "svc" of service "svc": address svc: missing port in address`,
+		},
+		"no mode": {
+			cfg: Config{
+				BroadcastAddress: "10.0.0.1",
+				Services:         map[string]ServiceConfig{"svc": {Hosts: []string{"svc:1"}}},
+			},
+			err: `dns peer provider config has unknown mode 0 of service "svc"`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, test.cfg.validate(), test.err)
+		})
+	}
+}
+
+func TestServiceConfigResolve(t *testing.T) {
+	r := newFakeResolver()
+	r.setHost("cadence-history-headless", "10.0.0.1", "10.0.0.2")
+	r.setHost("pod-3.cadence-matching-headless", "10.0.0.3")
+	r.setHost("pod-4.cadence-matching-headless", "10.0.0.4")
+	r.setSRV("_tchannel._tcp.cadence-matching-headless",
+		&net.SRV{Target: "pod-3.cadence-matching-headless", Port: 7935},
+		&net.SRV{Target: "pod-4.cadence-matching-headless", Port: 7935},
+	)
+
+	addresses, err := ServiceConfig{Mode: ModeStatic, Hosts: []string{"10.0.0.5:7933"}}.resolve(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.5:7933"}, addresses)
+
+	addresses, err = ServiceConfig{Mode: ModeDNS, Hosts: []string{"cadence-history-headless:7934"}}.resolve(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1:7934", "10.0.0.2:7934"}, addresses)
+
+	addresses, err = ServiceConfig{Mode: ModeDNSSRV, Hosts: []string{"_tchannel._tcp.cadence-matching-headless"}}.resolve(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.3:7935", "10.0.0.4:7935"}, addresses)
+
+	_, err = ServiceConfig{Mode: ModeDNS, Hosts: []string{"unknown:7934"}}.resolve(context.Background(), r)
+	assert.True(t, errors.Is(err, errNotFound))
+}
@@ -0,0 +1,289 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dnsprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+)
+
+// ConfigKey is the key of the DNS peer provider config under membership.provider
+const ConfigKey = "dns"
+
+type (
+	// Provider discovers the peers of each service from DNS or a static host list, and announces
+	// membership changes when the discovered peers change. Unlike ringpop there is no gossip:
+	// every host refreshes the peers on its own, so the ring of each host converges within RefreshInterval.
+	Provider struct {
+		status     int32
+		service    string
+		config     *Config
+		resolver   resolver
+		self       membership.HostInfo
+		timeSource clock.TimeSource
+		logger     log.Logger
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+
+		mu sync.RWMutex
+		// members are the peers of each service keyed by address
+		members     map[string]map[string]membership.HostInfo
+		evicted     bool
+		subscribers map[string]func(membership.ChangedEvent)
+	}
+)
+
+var _ membership.PeerProvider = (*Provider)(nil)
+
+func init() {
+	if err := peerprovider.Register(ConfigKey, newFromPlugin); err != nil {
+		panic(err)
+	}
+}
+
+func newFromPlugin(cfg *config.YamlNode, container peerprovider.Container) (membership.PeerProvider, error) {
+	var providerConfig Config
+	if err := cfg.Decode(&providerConfig); err != nil {
+		return nil, fmt.Errorf("decoding dns peer provider config: %w", err)
+	}
+	return New(container.Service, &providerConfig, container.Portmap, container.Logger)
+}
+
+// New creates a DNS peer provider which announces this host with the given ports
+func New(
+	service string,
+	config *Config,
+	portMap membership.PortMap,
+	logger log.Logger,
+) (*Provider, error) {
+	return newProvider(service, config, portMap, net.DefaultResolver, clock.NewRealTimeSource(), logger)
+}
+
+func newProvider(
+	service string,
+	config *Config,
+	portMap membership.PortMap,
+	resolver resolver,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) (*Provider, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	tchannelPort, ok := portMap[membership.PortTchannel]
+	if !ok {
+		return nil, fmt.Errorf("dns peer provider requires the tchannel port of the host")
+	}
+	address := net.JoinHostPort(config.BroadcastAddress, strconv.Itoa(int(tchannelPort)))
+
+	return &Provider{
+		status:      common.DaemonStatusInitialized,
+		service:     service,
+		config:      config,
+		resolver:    resolver,
+		self:        membership.NewDetailedHostInfo(address, address, portMap),
+		timeSource:  timeSource,
+		logger:      logger.WithTags(tag.ComponentPeerProvider),
+		shutdownCh:  make(chan struct{}),
+		members:     map[string]map[string]membership.HostInfo{},
+		subscribers: map[string]func(membership.ChangedEvent){},
+	}, nil
+}
+
+// Start discovers the peers and starts refreshing them periodically
+func (p *Provider) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&p.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	p.refresh()
+
+	p.shutdownWG.Add(1)
+	go p.refreshLoop()
+}
+
+// Stop stops refreshing the peers
+func (p *Provider) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&p.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(p.shutdownCh)
+	p.shutdownWG.Wait()
+}
+
+func (p *Provider) refreshLoop() {
+	defer p.shutdownWG.Done()
+
+	ticker := p.timeSource.NewTicker(p.config.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.shutdownCh:
+			return
+		case <-ticker.Chan():
+			p.refresh()
+		}
+	}
+}
+
+// refresh discovers the peers of all services and notifies the subscribers if they changed.
+// The peers of a service are kept when they can't be discovered, so a DNS outage doesn't empty the ring.
+func (p *Provider) refresh() {
+	discovered := make(map[string]map[string]membership.HostInfo, len(p.config.Services))
+	for service, serviceConfig := range p.config.Services {
+		ctx, cancel := context.WithTimeout(context.Background(), p.config.ResolveTimeout)
+		addresses, err := serviceConfig.resolve(ctx, p.resolver)
+		cancel()
+		if err != nil {
+			p.logger.Warn("failed to discover peers, keeping the previous ones", tag.Service(service), tag.Error(err))
+			continue
+		}
+
+		members := make(map[string]membership.HostInfo, len(addresses))
+		for _, address := range addresses {
+			_, port, err := net.SplitHostPort(address)
+			if err != nil {
+				p.logger.Warn("discovered invalid peer address", tag.Service(service), tag.Address(address), tag.Error(err))
+				continue
+			}
+			tchannelPort, err := strconv.ParseUint(port, 10, 16)
+			if err != nil {
+				p.logger.Warn("discovered invalid peer port", tag.Service(service), tag.Address(address), tag.Error(err))
+				continue
+			}
+			members[address] = membership.NewDetailedHostInfo(address, address, serviceConfig.portMap(uint16(tchannelPort)))
+		}
+		discovered[service] = members
+	}
+
+	var change membership.ChangedEvent
+	p.mu.Lock()
+	for service, members := range discovered {
+		previous := p.members[service]
+		for address := range members {
+			if _, ok := previous[address]; !ok {
+				change.HostsAdded = append(change.HostsAdded, address)
+			}
+		}
+		for address := range previous {
+			if _, ok := members[address]; !ok {
+				change.HostsRemoved = append(change.HostsRemoved, address)
+			}
+		}
+		p.members[service] = members
+	}
+	p.mu.Unlock()
+
+	if len(change.HostsAdded) == 0 && len(change.HostsRemoved) == 0 {
+		return
+	}
+	sort.Strings(change.HostsAdded)
+	sort.Strings(change.HostsRemoved)
+	p.logger.Info("Discovered peers changed", tag.MembershipChangeEvent(change))
+	p.notifySubscribers(change)
+}
+
+func (p *Provider) notifySubscribers(event membership.ChangedEvent) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for _, handler := range p.subscribers {
+		handler(event)
+	}
+}
+
+// GetMembers returns the discovered peers of a service
+func (p *Provider) GetMembers(service string) ([]membership.HostInfo, error) {
+	if _, ok := p.config.Services[service]; !ok {
+		return nil, fmt.Errorf("dns peer provider has no config for service %q", service)
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	res := make([]membership.HostInfo, 0, len(p.members[service]))
+	for address, member := range p.members[service] {
+		if p.evicted && address == p.self.GetAddress() {
+			continue
+		}
+		res = append(res, member)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].GetAddress() < res[j].GetAddress()
+	})
+	return res, nil
+}
+
+// WhoAmI returns the address of this host
+func (p *Provider) WhoAmI() (membership.HostInfo, error) {
+	return p.self, nil
+}
+
+// SelfEvict removes this host from the peers discovered by this host.
+// The other hosts keep discovering this host until it is removed from DNS.
+func (p *Provider) SelfEvict() error {
+	p.mu.Lock()
+	p.evicted = true
+	p.mu.Unlock()
+
+	p.notifySubscribers(membership.ChangedEvent{
+		HostsRemoved: []string{p.self.GetAddress()},
+	})
+	return nil
+}
+
+// Subscribe allows to be subscribed for peer changes
+func (p *Provider) Subscribe(name string, handler func(membership.ChangedEvent)) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.subscribers[name]
+	if ok {
+		return fmt.Errorf("%q already subscribed to dns peer provider", name)
+	}
+
+	p.subscribers[name] = handler
+	return nil
+}
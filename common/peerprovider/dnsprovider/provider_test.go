@@ -0,0 +1,284 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dnsprovider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+)
+
+var errNotFound = errors.New("not found")
+
+type fakeResolver struct {
+	sync.Mutex
+	hosts map[string][]string
+	srvs  map[string][]*net.SRV
+	err   error
+}
+
+func newFakeResolver() *fakeResolver {
+	return &fakeResolver{
+		hosts: map[string][]string{},
+		srvs:  map[string][]*net.SRV{},
+	}
+}
+
+func (r *fakeResolver) setHost(host string, addrs ...string) {
+	r.Lock()
+	defer r.Unlock()
+	r.hosts[host] = addrs
+}
+
+func (r *fakeResolver) setSRV(name string, srvs ...*net.SRV) {
+	r.Lock()
+	defer r.Unlock()
+	r.srvs[name] = srvs
+}
+
+func (r *fakeResolver) setError(err error) {
+	r.Lock()
+	defer r.Unlock()
+	r.err = err
+}
+
+func (r *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	r.Lock()
+	defer r.Unlock()
+	if r.err != nil {
+		return nil, r.err
+	}
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, errNotFound
+	}
+	return addrs, nil
+}
+
+func (r *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	r.Lock()
+	defer r.Unlock()
+	if r.err != nil {
+		return "", nil, r.err
+	}
+	srvs, ok := r.srvs[name]
+	if !ok {
+		return "", nil, errNotFound
+	}
+	return name, srvs, nil
+}
+
+func newTestConfig() *Config {
+	return &Config{
+		BroadcastAddress: "10.0.0.1",
+		Services: map[string]ServiceConfig{
+			"cadence-frontend": {
+				Mode:  ModeStatic,
+				Hosts: []string{"10.0.1.1:7933"},
+			},
+			"cadence-history": {
+				Mode:     ModeDNS,
+				Hosts:    []string{"cadence-history-headless:7934"},
+				GRPCPort: 7834,
+			},
+		},
+	}
+}
+
+func newTestProvider(t *testing.T, r resolver) (*Provider, clock.MockedTimeSource) {
+	timeSource := clock.NewMockedTimeSource()
+	provider, err := newProvider(
+		"cadence-history",
+		newTestConfig(),
+		membership.PortMap{membership.PortTchannel: 7934, membership.PortGRPC: 7834},
+		r,
+		timeSource,
+		testlogger.New(t),
+	)
+	require.NoError(t, err)
+	return provider, timeSource
+}
+
+func getAddresses(t *testing.T, provider *Provider, service string) []string {
+	members, err := provider.GetMembers(service)
+	require.NoError(t, err)
+	var addresses []string
+	for _, member := range members {
+		addresses = append(addresses, member.GetAddress())
+	}
+	return addresses
+}
+
+func TestProvider(t *testing.T) {
+	r := newFakeResolver()
+	r.setHost("cadence-history-headless", "10.0.0.2", "10.0.0.1")
+	provider, timeSource := newTestProvider(t, r)
+
+	changes := make(chan membership.ChangedEvent, 1)
+	require.NoError(t, provider.Subscribe("test", func(event membership.ChangedEvent) {
+		changes <- event
+	}))
+	assert.Error(t, provider.Subscribe("test", func(membership.ChangedEvent) {}))
+
+	provider.Start()
+	defer provider.Stop()
+
+	assert.Equal(t, membership.ChangedEvent{
+		HostsAdded: []string{"10.0.0.1:7934", "10.0.0.2:7934", "10.0.1.1:7933"},
+	}, <-changes)
+
+	self, err := provider.WhoAmI()
+	require.NoError(t, err)
+	assert.Equal(t, membership.NewDetailedHostInfo(
+		"10.0.0.1:7934",
+		"10.0.0.1:7934",
+		membership.PortMap{membership.PortTchannel: 7934, membership.PortGRPC: 7834},
+	), self)
+
+	members, err := provider.GetMembers("cadence-history")
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{
+		membership.NewDetailedHostInfo("10.0.0.1:7934", "10.0.0.1:7934", membership.PortMap{membership.PortTchannel: 7934, membership.PortGRPC: 7834}),
+		membership.NewDetailedHostInfo("10.0.0.2:7934", "10.0.0.2:7934", membership.PortMap{membership.PortTchannel: 7934, membership.PortGRPC: 7834}),
+	}, members)
+	assert.Equal(t, []string{"10.0.1.1:7933"}, getAddresses(t, provider, "cadence-frontend"))
+
+	_, err = provider.GetMembers("cadence-matching")
+	assert.Error(t, err)
+
+	// a scaled history service is discovered on the next refresh
+	r.setHost("cadence-history-headless", "10.0.0.1", "10.0.0.3")
+	timeSource.BlockUntil(1)
+	timeSource.Advance(defaultRefreshInterval)
+	select {
+	case change := <-changes:
+		assert.Equal(t, membership.ChangedEvent{
+			HostsAdded:   []string{"10.0.0.3:7934"},
+			HostsRemoved: []string{"10.0.0.2:7934"},
+		}, change)
+	case <-time.After(time.Second):
+		t.Fatal("peer change is not notified")
+	}
+	assert.Equal(t, []string{"10.0.0.1:7934", "10.0.0.3:7934"}, getAddresses(t, provider, "cadence-history"))
+
+	// the peers are kept when DNS fails
+	r.setError(errors.New("dns is down"))
+	timeSource.BlockUntil(1)
+	timeSource.Advance(defaultRefreshInterval)
+	timeSource.BlockUntil(1)
+	select {
+	case change := <-changes:
+		t.Fatalf("unexpected peer change %v", change)
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.Equal(t, []string{"10.0.0.1:7934", "10.0.0.3:7934"}, getAddresses(t, provider, "cadence-history"))
+	assert.Equal(t, []string{"10.0.1.1:7933"}, getAddresses(t, provider, "cadence-frontend"))
+
+	require.NoError(t, provider.SelfEvict())
+	assert.Equal(t, membership.ChangedEvent{HostsRemoved: []string{"10.0.0.1:7934"}}, <-changes)
+	assert.Equal(t, []string{"10.0.0.3:7934"}, getAddresses(t, provider, "cadence-history"))
+}
+
+func TestProviderDNSSRV(t *testing.T) {
+	r := newFakeResolver()
+	r.setHost("pod-1.cadence-matching-headless", "10.0.0.1")
+	r.setHost("pod-2.cadence-matching-headless", "10.0.0.2")
+	r.setSRV("_tchannel._tcp.cadence-matching-headless",
+		&net.SRV{Target: "pod-1.cadence-matching-headless", Port: 7935},
+		&net.SRV{Target: "pod-2.cadence-matching-headless", Port: 7935},
+	)
+	provider, err := newProvider(
+		"cadence-matching",
+		&Config{
+			BroadcastAddress: "10.0.0.1",
+			Services: map[string]ServiceConfig{
+				"cadence-matching": {
+					Mode:  ModeDNSSRV,
+					Hosts: []string{"_tchannel._tcp.cadence-matching-headless"},
+				},
+			},
+		},
+		membership.PortMap{membership.PortTchannel: 7935},
+		r,
+		clock.NewMockedTimeSource(),
+		testlogger.New(t),
+	)
+	require.NoError(t, err)
+
+	provider.Start()
+	defer provider.Stop()
+
+	members, err := provider.GetMembers("cadence-matching")
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{
+		membership.NewDetailedHostInfo("10.0.0.1:7935", "10.0.0.1:7935", membership.PortMap{membership.PortTchannel: 7935}),
+		membership.NewDetailedHostInfo("10.0.0.2:7935", "10.0.0.2:7935", membership.PortMap{membership.PortTchannel: 7935}),
+	}, members)
+}
+
+func TestNewProviderErrors(t *testing.T) {
+	_, err := newProvider("cadence-history", &Config{}, membership.PortMap{membership.PortTchannel: 7934}, newFakeResolver(), clock.NewMockedTimeSource(), testlogger.New(t))
+	assert.Error(t, err)
+
+	_, err = newProvider("cadence-history", newTestConfig(), membership.PortMap{membership.PortGRPC: 7834}, newFakeResolver(), clock.NewMockedTimeSource(), testlogger.New(t))
+	assert.Error(t, err)
+}
+
+func TestNewFromPlugin(t *testing.T) {
+	cfg, err := config.ToYamlNode(map[string]interface{}{
+		"broadcastAddress": "10.0.0.1",
+		"services": map[string]interface{}{
+			"cadence-frontend": map[string]interface{}{
+				"mode":  "static",
+				"hosts": []string{"10.0.0.1:7933"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	provider, err := peerprovider.New(
+		config.PeerProvider{ConfigKey: cfg},
+		peerprovider.Container{
+			Service: "cadence-frontend",
+			Logger:  testlogger.New(t),
+			Portmap: membership.PortMap{membership.PortTchannel: 7933},
+		},
+	).Provider()
+	require.NoError(t, err)
+
+	self, err := provider.WhoAmI()
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:7933", self.GetAddress())
+}
@@ -278,6 +278,9 @@ const (
 	DomainAuditOperationTypeDeprecate
 	DomainAuditOperationTypeDelete
 	DomainAuditOperationTypeFailover
+	// DomainAuditOperationTypeFailoverDryRun is a failover the active cluster failover controller decided in dry run
+	// mode. It is kept apart from the failovers, so that it is not listed in the failover history of the domain.
+	DomainAuditOperationTypeFailoverDryRun
 )
 
 func (d DomainAuditOperationType) String() string {
@@ -288,6 +291,8 @@ func (d DomainAuditOperationType) String() string {
 		return "Update"
 	case DomainAuditOperationTypeFailover:
 		return "Failover"
+	case DomainAuditOperationTypeFailoverDryRun:
+		return "FailoverDryRun"
 	case DomainAuditOperationTypeDeprecate:
 		return "Deprecate"
 	case DomainAuditOperationTypeDelete:
@@ -432,6 +437,18 @@ type (
 		ExpirationSeconds int32 // TODO: is this field useful?
 
 		ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+
+		// Pause state set by an operator
+		Paused          bool
+		PauseReason     string
+		PauseIdentity   string
+		PausedTimestamp time.Time
+
+		// AcceptedUpdateIDs are the workflow updates accepted by the decider and not completed yet
+		AcceptedUpdateIDs []string
+
+		// TaskPriority is the priority level of the decision tasks of the workflow, empty for the default level
+		TaskPriority string
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -559,6 +576,7 @@ type (
 		Expiry                        time.Time
 		CreatedTime                   time.Time
 		PartitionConfig               map[string]string
+		Priority                      string
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -636,6 +654,7 @@ type (
 		StartedIdentity    string
 		TaskList           string
 		TaskListKind       types.TaskListKind
+		TaskPriority       string // priority level set in the activity header, empty if the activity has the priority of the workflow
 		HasRetryPolicy     bool
 		InitialInterval    int32
 		BackoffCoefficient float64
@@ -646,6 +665,11 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		// Pause state set by an operator
+		Paused          bool
+		PauseReason     string
+		PauseIdentity   string
+		PausedTimestamp time.Time
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -333,6 +333,18 @@ type (
 
 		ActiveClusterSelectionPolicy *DataBlob
 
+		// Pause state set by an operator
+		Paused          bool
+		PauseReason     string
+		PauseIdentity   string
+		PausedTimestamp time.Time
+
+		// AcceptedUpdateIDs are the workflow updates accepted by the decider and not completed yet
+		AcceptedUpdateIDs []string
+
+		// TaskPriority is the priority level of the decision tasks of the workflow, empty for the default level
+		TaskPriority string
+
 		// attributes which are not related to mutable state at all
 		HistorySize int64
 		IsCron      bool
@@ -385,6 +397,7 @@ type (
 		StartedIdentity    string
 		TaskList           string
 		TaskListKind       types.TaskListKind
+		TaskPriority       string // priority level set in the activity header, empty if the activity has the priority of the workflow
 		HasRetryPolicy     bool
 		InitialInterval    time.Duration
 		BackoffCoefficient float64
@@ -395,6 +408,11 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		// Pause state set by an operator
+		Paused          bool
+		PauseReason     string
+		PauseIdentity   string
+		PausedTimestamp time.Time
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
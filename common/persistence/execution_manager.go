@@ -212,6 +212,12 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		Memo:                               info.Memo,
 		PartitionConfig:                    info.PartitionConfig,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		Paused:                             info.Paused,
+		PauseReason:                        info.PauseReason,
+		PauseIdentity:                      info.PauseIdentity,
+		PausedTimestamp:                    info.PausedTimestamp,
+		AcceptedUpdateIDs:                  info.AcceptedUpdateIDs,
+		TaskPriority:                       info.TaskPriority,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -321,6 +327,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			StartedIdentity:                         v.StartedIdentity,
 			TaskList:                                v.TaskList,
 			TaskListKind:                            v.TaskListKind,
+			TaskPriority:                            v.TaskPriority,
 			HasRetryPolicy:                          v.HasRetryPolicy,
 			InitialInterval:                         int32(v.InitialInterval.Seconds()),
 			BackoffCoefficient:                      v.BackoffCoefficient,
@@ -331,6 +338,10 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			Paused:                                  v.Paused,
+			PauseReason:                             v.PauseReason,
+			PauseIdentity:                           v.PauseIdentity,
+			PausedTimestamp:                         v.PausedTimestamp,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -451,6 +462,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			StartedIdentity:                         v.StartedIdentity,
 			TaskList:                                v.TaskList,
 			TaskListKind:                            v.TaskListKind,
+			TaskPriority:                            v.TaskPriority,
 			HasRetryPolicy:                          v.HasRetryPolicy,
 			InitialInterval:                         common.SecondsToDuration(int64(v.InitialInterval)),
 			BackoffCoefficient:                      v.BackoffCoefficient,
@@ -461,6 +473,10 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			Paused:                                  v.Paused,
+			PauseReason:                             v.PauseReason,
+			PauseIdentity:                           v.PauseIdentity,
+			PausedTimestamp:                         v.PausedTimestamp,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -552,6 +568,12 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		PartitionConfig:                    info.PartitionConfig,
 		CronOverlapPolicy:                  info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		Paused:                             info.Paused,
+		PauseReason:                        info.PauseReason,
+		PauseIdentity:                      info.PauseIdentity,
+		PausedTimestamp:                    info.PausedTimestamp,
+		AcceptedUpdateIDs:                  info.AcceptedUpdateIDs,
+		TaskPriority:                       info.TaskPriority,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
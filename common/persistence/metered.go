@@ -96,7 +96,7 @@ func (r *TaskInfo) ByteSize() uint64 {
 		return 0
 	}
 
-	return uint64(int(unsafe.Sizeof(*r)) + len(r.DomainID) + len(r.WorkflowID) + len(r.RunID) + estimateStringMapSize(r.PartitionConfig))
+	return uint64(int(unsafe.Sizeof(*r)) + len(r.DomainID) + len(r.WorkflowID) + len(r.RunID) + estimateStringMapSize(r.PartitionConfig) + len(r.Priority))
 }
 
 func (r *ListDomainsResponse) ByteSize() uint64 {
@@ -305,6 +305,7 @@ func (t *nosqlTaskStore) CreateTasks(
 			ScheduledID:     taskRequest.Data.ScheduleID,
 			CreatedTime:     currentTimeStamp,
 			PartitionConfig: taskRequest.Data.PartitionConfig,
+			Priority:        taskRequest.Data.Priority,
 		}
 
 		var ttl int
@@ -411,6 +412,7 @@ func toTaskInfo(t *nosqlplugin.TaskRow) *persistence.TaskInfo {
 		ScheduleID:      t.ScheduledID,
 		CreatedTime:     t.CreatedTime,
 		PartitionConfig: t.PartitionConfig,
+		Priority:        t.Priority,
 	}
 }
 
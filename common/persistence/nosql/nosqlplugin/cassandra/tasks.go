@@ -368,6 +368,7 @@ func (db *CDB) InsertTasks(
 				scheduleID,
 				task.CreatedTime,
 				task.PartitionConfig,
+				task.Priority,
 				timeStamp,
 			)
 		} else {
@@ -386,6 +387,7 @@ func (db *CDB) InsertTasks(
 				scheduleID,
 				task.CreatedTime,
 				task.PartitionConfig,
+				task.Priority,
 				timeStamp,
 				ttl)
 		}
@@ -508,6 +510,8 @@ func createTaskInfo(
 			info.CreatedTime = v.(time.Time)
 		case "partition_config":
 			info.PartitionConfig = v.(map[string]string)
+		case "priority":
+			info.Priority = v.(string)
 		}
 	}
 
@@ -38,7 +38,8 @@ const (
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
 		`created_time: ?, ` +
-		`partition_config: ? ` +
+		`partition_config: ?, ` +
+		`priority: ? ` +
 		`}`
 
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
@@ -86,7 +86,13 @@ const (
 		`memo: ?, ` +
 		`partition_config: ?, ` +
 		`active_cluster_selection_policy: ?, ` +
-		`active_cluster_selection_policy_encoding: ?` +
+		`active_cluster_selection_policy_encoding: ?, ` +
+		`paused: ?, ` +
+		`pause_reason: ?, ` +
+		`pause_identity: ?, ` +
+		`paused_time: ?, ` +
+		`accepted_update_ids: ?, ` +
+		`task_priority: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -162,6 +168,7 @@ const (
 		`attempt: ?, ` +
 		`task_list: ?, ` +
 		`task_list_kind: ?, ` +
+		`task_priority: ?, ` +
 		`started_identity: ?, ` +
 		`has_retry_policy: ?, ` +
 		`init_interval: ?, ` +
@@ -173,6 +180,10 @@ const (
 		`last_failure_reason: ?, ` +
 		`last_worker_identity: ?, ` +
 		`last_failure_details: ?, ` +
+		`paused: ?, ` +
+		`pause_reason: ?, ` +
+		`pause_identity: ?, ` +
+		`paused_time: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
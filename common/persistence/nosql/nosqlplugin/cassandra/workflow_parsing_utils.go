@@ -186,6 +186,18 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) *persistence.Inte
 			activeClusterSelectionPolicyEncoding = constants.EncodingType(v.(string))
 		case "cron_overlap_policy":
 			info.CronOverlapPolicy = types.CronOverlapPolicy(int32(v.(int)))
+		case "paused":
+			info.Paused = v.(bool)
+		case "pause_reason":
+			info.PauseReason = v.(string)
+		case "pause_identity":
+			info.PauseIdentity = v.(string)
+		case "paused_time":
+			info.PausedTimestamp = v.(time.Time)
+		case "accepted_update_ids":
+			info.AcceptedUpdateIDs = v.([]string)
+		case "task_priority":
+			info.TaskPriority = v.(string)
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
@@ -297,6 +309,8 @@ func parseActivityInfo(
 			info.TaskList = v.(string)
 		case "task_list_kind":
 			info.TaskListKind = types.TaskListKind(int32(v.(int)))
+		case "task_priority":
+			info.TaskPriority = v.(string)
 		case "started_identity":
 			info.StartedIdentity = v.(string)
 		case "has_retry_policy":
@@ -319,6 +333,14 @@ func parseActivityInfo(
 			info.LastWorkerIdentity = v.(string)
 		case "last_failure_details":
 			info.LastFailureDetails = v.([]byte)
+		case "paused":
+			info.Paused = v.(bool)
+		case "pause_reason":
+			info.PauseReason = v.(string)
+		case "pause_identity":
+			info.PauseIdentity = v.(string)
+		case "paused_time":
+			info.PausedTimestamp = v.(time.Time)
 		case "event_data_encoding":
 			sharedEncoding = constants.EncodingType(v.(string))
 		}
@@ -123,6 +123,12 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				"auto_reset_points_encoding":               "Proto3",
 				"active_cluster_selection_policy":          activeClusterSelectionPolicyData,
 				"active_cluster_selection_policy_encoding": "Proto3",
+				"paused":                                   true,
+				"pause_reason":                             "pause_reason",
+				"pause_identity":                           "pause_identity",
+				"paused_time":                              timeNow,
+				"accepted_update_ids":                      []string{"update_id"},
+				"task_priority":                            "high",
 			},
 			want: &persistence.InternalWorkflowExecutionInfo{
 				DomainID:                           "domain_id",
@@ -175,6 +181,12 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				Memo:                               memo,
 				PartitionConfig:                    partitionConfig,
 				ActiveClusterSelectionPolicy:       persistence.NewDataBlob(activeClusterSelectionPolicyData, "Proto3"),
+				Paused:                             true,
+				PauseReason:                        "pause_reason",
+				PauseIdentity:                      "pause_identity",
+				PausedTimestamp:                    timeNow,
+				AcceptedUpdateIDs:                  []string{"update_id"},
+				TaskPriority:                       "high",
 			},
 		},
 		{
@@ -243,6 +255,12 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 		assert.Equal(t, result.DecisionAttempt, tt.want.DecisionAttempt)
 		assert.Equal(t, result.ParentDomainID, tt.want.ParentDomainID)
 		assert.Equal(t, result.ActiveClusterSelectionPolicy, tt.want.ActiveClusterSelectionPolicy)
+		assert.Equal(t, result.Paused, tt.want.Paused)
+		assert.Equal(t, result.PauseReason, tt.want.PauseReason)
+		assert.Equal(t, result.PauseIdentity, tt.want.PauseIdentity)
+		assert.Equal(t, result.PausedTimestamp, tt.want.PausedTimestamp)
+		assert.Equal(t, result.AcceptedUpdateIDs, tt.want.AcceptedUpdateIDs)
+		assert.Equal(t, result.TaskPriority, tt.want.TaskPriority)
 	}
 }
 
@@ -321,6 +339,7 @@ func Test_parseActivityInfo(t *testing.T) {
 		"attempt":                   10,
 		"task_list":                 "task_list",
 		"task_list_kind":            1,
+		"task_priority":             "high",
 		"started_identity":          "started_identity",
 		"has_retry_policy":          true,
 		"init_interval":             11,
@@ -332,6 +351,10 @@ func Test_parseActivityInfo(t *testing.T) {
 		"last_failure_reason":       "last_failure_reason",
 		"last_worker_identity":      "last_worker_identity",
 		"last_failure_details":      []byte("last_failure_details"),
+		"paused":                    true,
+		"pause_reason":              "pause_reason",
+		"pause_identity":            "pause_identity",
+		"paused_time":               timeNow,
 		"event_data_encoding":       "Proto3",
 	}
 
@@ -358,6 +381,7 @@ func Test_parseActivityInfo(t *testing.T) {
 		Attempt:                  int32(10),
 		TaskList:                 "task_list",
 		TaskListKind:             types.TaskListKindSticky,
+		TaskPriority:             "high",
 		StartedIdentity:          "started_identity",
 		HasRetryPolicy:           true,
 		InitialInterval:          common.SecondsToDuration(int64(11)),
@@ -369,6 +393,10 @@ func Test_parseActivityInfo(t *testing.T) {
 		LastFailureReason:        "last_failure_reason",
 		LastWorkerIdentity:       "last_worker_identity",
 		LastFailureDetails:       []byte("last_failure_details"),
+		Paused:                   true,
+		PauseReason:              "pause_reason",
+		PauseIdentity:            "pause_identity",
+		PausedTimestamp:          timeNow,
 		DomainID:                 "domain_id",
 	}
 
@@ -1031,6 +1031,7 @@ func resetActivityInfoMap(activityInfos map[int64]*persistence.InternalActivityI
 		aInfo["timer_task_status"] = a.TimerTaskStatus
 		aInfo["attempt"] = a.Attempt
 		aInfo["task_list"] = a.TaskList
+		aInfo["task_priority"] = a.TaskPriority
 		aInfo["started_identity"] = a.StartedIdentity
 		aInfo["has_retry_policy"] = a.HasRetryPolicy
 		aInfo["init_interval"] = int32(a.InitialInterval.Seconds())
@@ -1042,6 +1043,10 @@ func resetActivityInfoMap(activityInfos map[int64]*persistence.InternalActivityI
 		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["last_worker_identity"] = a.LastWorkerIdentity
 		aInfo["last_failure_details"] = a.LastFailureDetails
+		aInfo["paused"] = a.Paused
+		aInfo["pause_reason"] = a.PauseReason
+		aInfo["pause_identity"] = a.PauseIdentity
+		aInfo["paused_time"] = a.PausedTimestamp
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -1084,6 +1089,7 @@ func updateActivityInfos(
 			a.Attempt,
 			a.TaskList,
 			int32(a.TaskListKind),
+			a.TaskPriority,
 			a.StartedIdentity,
 			a.HasRetryPolicy,
 			int32(a.InitialInterval.Seconds()),
@@ -1095,6 +1101,10 @@ func updateActivityInfos(
 			a.LastFailureReason,
 			a.LastWorkerIdentity,
 			a.LastFailureDetails,
+			a.Paused,
+			a.PauseReason,
+			a.PauseIdentity,
+			a.PausedTimestamp,
 			a.ScheduledEvent.GetEncodingString(),
 			timeStamp,
 			shardID,
@@ -1407,6 +1417,12 @@ func updateWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.Paused,
+		execution.PauseReason,
+		execution.PauseIdentity,
+		execution.PausedTimestamp,
+		execution.AcceptedUpdateIDs,
+		execution.TaskPriority,
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1509,6 +1525,12 @@ func createWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.Paused,
+		execution.PauseReason,
+		execution.PauseIdentity,
+		execution.PausedTimestamp,
+		execution.AcceptedUpdateIDs,
+		execution.TaskPriority,
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -1,5 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
-// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -21,14 +20,93 @@
 
 package dynamodb
 
-import "github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
 
 var _ nosqlplugin.AdminDB = (*ddb)(nil)
 
+const (
+	testSchemaDir = "schema/dynamodb/"
+)
+
+// schemaCommand is one entry of schema/dynamodb/cadence/schema.json
+type schemaCommand struct {
+	CreateTable      *dynamodb.CreateTableInput      `json:"CreateTable,omitempty"`
+	UpdateTimeToLive *dynamodb.UpdateTimeToLiveInput `json:"UpdateTimeToLive,omitempty"`
+}
+
 func (db *ddb) SetupTestDatabase(schemaBaseDir string, replicas int) error {
-	panic("TODO")
+	if schemaBaseDir == "" {
+		var err error
+		schemaBaseDir, err = nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	schemaFile := schemaBaseDir + "cadence/schema.json"
+	content, err := os.ReadFile(schemaFile)
+	if err != nil {
+		return err
+	}
+	var commands []schemaCommand
+	if err := json.Unmarshal(content, &commands); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	for _, cmd := range commands {
+		switch {
+		case cmd.CreateTable != nil:
+			cmd.CreateTable.TableName = aws.String(db.tableName(aws.StringValue(cmd.CreateTable.TableName)))
+			if _, err := db.client.CreateTableWithContext(ctx, cmd.CreateTable); err != nil {
+				return err
+			}
+			if err := db.client.WaitUntilTableExistsWithContext(ctx, &dynamodb.DescribeTableInput{
+				TableName: cmd.CreateTable.TableName,
+			}); err != nil {
+				return err
+			}
+		case cmd.UpdateTimeToLive != nil:
+			cmd.UpdateTimeToLive.TableName = aws.String(db.tableName(aws.StringValue(cmd.UpdateTimeToLive.TableName)))
+			if _, err := db.client.UpdateTimeToLiveWithContext(ctx, cmd.UpdateTimeToLive); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported schema command in %v", schemaFile)
+		}
+	}
+	return nil
 }
 
 func (db *ddb) TeardownTestDatabase() error {
-	panic("TODO")
+	ctx := context.Background()
+	var tables []*string
+	err := db.client.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{}, func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+		for _, table := range page.TableNames {
+			if db.tablePrefix == "" || strings.HasPrefix(aws.StringValue(table), db.tablePrefix+"_") {
+				tables = append(tables, table)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if _, err := db.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{TableName: table}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -22,15 +22,66 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// configRecord is the JSON encoded data of a cluster config item
+type configRecord struct {
+	Timestamp time.Time
+	Values    *persistence.DataBlob
+}
+
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	data, err := jsonValue(&configRecord{
+		Timestamp: row.Timestamp,
+		Values:    row.Values,
+	})
+	if err != nil {
+		return err
+	}
+	it := primaryKey(strconv.Itoa(row.RowType), sortableInt64(row.Version))
+	it[attrData] = data
+	cond := expression.AttributeNotExists(expression.Name(attrPK))
+	_, err = db.putItem(ctx, tableClusterConfig, it, &cond)
+	if err == errConditionFailed {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	query, err := db.newQuery(tableClusterConfig, keyCondition(strconv.Itoa(rowType), nil), nil)
+	if err != nil {
+		return nil, err
+	}
+	query.ScanIndexForward = aws.Bool(false)
+	items, _, err := db.queryPage(ctx, query, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errNotFound
+	}
+
+	version, err := parseSortableInt64(getString(items[0], attrSK))
+	if err != nil {
+		return nil, err
+	}
+	var record configRecord
+	if err := getJSON(items[0], attrData, &record); err != nil {
+		return nil, err
+	}
+	return &persistence.InternalConfigStoreEntry{
+		RowType:   rowType,
+		Version:   version,
+		Timestamp: record.Timestamp,
+		Values:    record.Values,
+	}, nil
 }
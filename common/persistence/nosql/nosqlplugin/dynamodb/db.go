@@ -21,8 +21,19 @@
 package dynamodb
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
@@ -32,25 +43,83 @@ import (
 const (
 	// PluginName is the name of the plugin
 	PluginName = "dynamodb"
+
+	defaultRegion = "us-east-1"
 )
 
 var (
 	errConditionFailed = errors.New("internal condition fail error")
+	// errNotFound is returned by all the select operations when the requested item doesn't exist.
+	// DynamoDB itself returns an empty result instead of an error in that case.
+	errNotFound = errors.New("dynamodb item not found")
 )
 
 // ddb represents a logical connection to DynamoDB database
 type ddb struct {
+	client dynamodbiface.DynamoDBAPI
+	// tablePrefix is prepended to every table name so that multiple clusters can share an AWS account
+	tablePrefix string
+	logger      log.Logger
 }
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
 // NewDynamoDB return a new DB
 func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
+	return newDDB(&cfg, logger)
+}
+
+func newDDB(cfg *config.NoSQL, logger log.Logger) (*ddb, error) {
+	awsConfig := aws.NewConfig().WithRegion(defaultRegion)
+	if cfg.Region != "" {
+		awsConfig = awsConfig.WithRegion(cfg.Region)
+	}
+	if endpoint := getEndpoint(cfg); endpoint != "" {
+		awsConfig = awsConfig.WithEndpoint(endpoint)
+	}
+	if cfg.User != "" {
+		// user and password are interpreted as the access key ID and the secret access key,
+		// otherwise the default credential chain(environment, shared config, instance role) is used
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(cfg.User, cfg.Password, ""))
+	}
+	if cfg.Timeout > 0 {
+		awsConfig = awsConfig.WithHTTPClient(&http.Client{Timeout: cfg.Timeout})
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create aws session: %w", err)
+	}
+	return newDDBWithClient(dynamodb.New(sess), cfg.Keyspace, logger), nil
+}
+
+func newDDBWithClient(client dynamodbiface.DynamoDBAPI, tablePrefix string, logger log.Logger) *ddb {
+	return &ddb{
+		client:      client,
+		tablePrefix: tablePrefix,
+		logger:      logger,
+	}
+}
+
+// getEndpoint builds the endpoint URL from hosts and port.
+// Hosts can either be a full URL, or a host name in which case the scheme is derived from the TLS config.
+func getEndpoint(cfg *config.NoSQL) string {
+	host := strings.TrimSpace(strings.Split(cfg.Hosts, ",")[0])
+	if host == "" || strings.Contains(host, "://") {
+		return host
+	}
+	scheme := "http"
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		scheme = "https"
+	}
+	if cfg.Port > 0 {
+		return fmt.Sprintf("%v://%v:%v", scheme, host, cfg.Port)
+	}
+	return fmt.Sprintf("%v://%v", scheme, host)
 }
 
 func (db *ddb) Close() {
-	panic("TODO")
+	// the aws client is stateless and holds no connection that needs to be released
 }
 
 func (db *ddb) PluginName() string {
@@ -58,19 +127,57 @@ func (db *ddb) PluginName() string {
 }
 
 func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
+	return errors.Is(err, errNotFound)
 }
 
 func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == request.CanceledErrorCode || awsErr.Code() == request.ErrCodeResponseTimeout
+	}
+	return false
 }
 
 func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	switch awsErr.Code() {
+	case dynamodb.ErrCodeProvisionedThroughputExceededException,
+		dynamodb.ErrCodeRequestLimitExceeded,
+		dynamodb.ErrCodeTransactionConflictException,
+		dynamodb.ErrCodeTransactionInProgressException,
+		"ThrottlingException":
+		return true
+	case dynamodb.ErrCodeTransactionCanceledException:
+		// transactions conflicting with each other or throttled are canceled with reasons other than failed conditions
+		var canceled *dynamodb.TransactionCanceledException
+		if errors.As(err, &canceled) {
+			for _, reason := range canceled.CancellationReasons {
+				switch aws.StringValue(reason.Code) {
+				case "TransactionConflict", "ThrottlingError", "ProvisionedThroughputExceeded":
+					return true
+				}
+			}
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsDBUnavailableError(err error) bool {
-	panic("TODO")
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	switch awsErr.Code() {
+	case dynamodb.ErrCodeInternalServerError, "ServiceUnavailable":
+		return true
+	}
+	return false
 }
 
 func (db *ddb) IsConditionFailedError(err error) bool {
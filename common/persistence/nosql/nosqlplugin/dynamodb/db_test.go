@@ -0,0 +1,166 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+)
+
+// fakeClient records the requests and returns the configured responses, the methods which
+// are not overridden panic
+type fakeClient struct {
+	dynamodbiface.DynamoDBAPI
+
+	items       map[string]item
+	putErr      error
+	transactErr error
+	// transactErrs are returned by the next transactions, before transactErr
+	transactErrs []error
+	transactions []*dynamodb.TransactWriteItemsInput
+	puts         []*dynamodb.PutItemInput
+	batchWrites  []*dynamodb.BatchWriteItemInput
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{items: make(map[string]item)}
+}
+
+func fakeItemKey(table string, key item) string {
+	return table + "|" + getString(key, attrPK) + "|" + getString(key, attrSK)
+}
+
+func (c *fakeClient) GetItemWithContext(ctx aws.Context, input *dynamodb.GetItemInput, opts ...request.Option) (*dynamodb.GetItemOutput, error) {
+	return &dynamodb.GetItemOutput{Item: c.items[fakeItemKey(aws.StringValue(input.TableName), input.Key)]}, nil
+}
+
+// QueryWithContext returns the items of the partition, the partition key is the first value of the key condition
+func (c *fakeClient) QueryWithContext(ctx aws.Context, input *dynamodb.QueryInput, opts ...request.Option) (*dynamodb.QueryOutput, error) {
+	pk := aws.StringValue(input.ExpressionAttributeValues[":0"].S)
+	var items []item
+	for key, it := range c.items {
+		if strings.HasPrefix(key, aws.StringValue(input.TableName)+"|"+pk+"|") {
+			items = append(items, it)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return getString(items[i], attrSK) < getString(items[j], attrSK) })
+	return &dynamodb.QueryOutput{Items: items, Count: aws.Int64(int64(len(items)))}, nil
+}
+
+func (c *fakeClient) PutItemWithContext(ctx aws.Context, input *dynamodb.PutItemInput, opts ...request.Option) (*dynamodb.PutItemOutput, error) {
+	c.puts = append(c.puts, input)
+	return &dynamodb.PutItemOutput{}, c.putErr
+}
+
+func (c *fakeClient) TransactWriteItemsWithContext(ctx aws.Context, input *dynamodb.TransactWriteItemsInput, opts ...request.Option) (*dynamodb.TransactWriteItemsOutput, error) {
+	c.transactions = append(c.transactions, input)
+	if len(c.transactErrs) > 0 {
+		err := c.transactErrs[0]
+		c.transactErrs = c.transactErrs[1:]
+		return &dynamodb.TransactWriteItemsOutput{}, err
+	}
+	return &dynamodb.TransactWriteItemsOutput{}, c.transactErr
+}
+
+func (c *fakeClient) BatchWriteItemWithContext(ctx aws.Context, input *dynamodb.BatchWriteItemInput, opts ...request.Option) (*dynamodb.BatchWriteItemOutput, error) {
+	c.batchWrites = append(c.batchWrites, input)
+	return &dynamodb.BatchWriteItemOutput{}, nil
+}
+
+func (c *fakeClient) putItem(table string, it item) {
+	c.items[fakeItemKey("test_"+table, it)] = it
+}
+
+func newTestDB(t *testing.T, client *fakeClient) *ddb {
+	return newDDBWithClient(client, "test", testlogger.New(t))
+}
+
+func TestGetEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		cfg  config.NoSQL
+		want string
+	}{
+		"no host": {
+			cfg:  config.NoSQL{},
+			want: "",
+		},
+		"full url": {
+			cfg:  config.NoSQL{Hosts: "https://dynamodb.us-west-2.amazonaws.com", Port: 8000},
+			want: "https://dynamodb.us-west-2.amazonaws.com",
+		},
+		"host and port": {
+			cfg:  config.NoSQL{Hosts: "127.0.0.1, 127.0.0.2", Port: 8000},
+			want: "http://127.0.0.1:8000",
+		},
+		"host with tls": {
+			cfg:  config.NoSQL{Hosts: "localhost", TLS: &config.TLS{Enabled: true}},
+			want: "https://localhost",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, getEndpoint(&tc.cfg))
+		})
+	}
+}
+
+func TestErrorClassifiers(t *testing.T) {
+	db := newTestDB(t, newFakeClient())
+
+	assert.True(t, db.IsNotFoundError(errNotFound))
+	assert.False(t, db.IsNotFoundError(errors.New("some error")))
+
+	assert.True(t, db.IsTimeoutError(context.DeadlineExceeded))
+	assert.True(t, db.IsTimeoutError(awserr.New(request.CanceledErrorCode, "canceled", nil)))
+	assert.False(t, db.IsTimeoutError(errors.New("some error")))
+
+	assert.True(t, db.IsThrottlingError(awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "throttled", nil)))
+	assert.True(t, db.IsThrottlingError(&dynamodb.TransactionCanceledException{
+		Message_: aws.String("canceled"),
+		CancellationReasons: []*dynamodb.CancellationReason{
+			{Code: aws.String("None")},
+			{Code: aws.String("TransactionConflict")},
+		},
+	}))
+	assert.False(t, db.IsThrottlingError(&dynamodb.TransactionCanceledException{
+		Message_:            aws.String("canceled"),
+		CancellationReasons: []*dynamodb.CancellationReason{{Code: aws.String("ConditionalCheckFailed")}},
+	}))
+
+	assert.True(t, db.IsDBUnavailableError(awserr.New(dynamodb.ErrCodeInternalServerError, "internal", nil)))
+	assert.False(t, db.IsDBUnavailableError(errors.New("some error")))
+
+	assert.True(t, db.IsConditionFailedError(errConditionFailed))
+	assert.False(t, db.IsConditionFailedError(errors.New("some error")))
+}
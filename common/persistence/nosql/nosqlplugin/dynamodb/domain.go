@@ -22,17 +22,150 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// domains are stored by name, with a separate item per ID pointing to the name
+	domainByNamePK = "domain_by_name"
+	domainByIDPK   = "domain_by_id"
+	domainMetadata = "domain_metadata"
+	attrDomainID   = "domain_id"
+	attrDomainName = "domain_name"
+	attrIsGlobal   = "is_global_domain"
+	attrNotifyVer  = "notification_version"
 )
 
+// domainRecord is the JSON encoded data of a domain item
+type domainRecord struct {
+	Info                        *persistence.DomainInfo
+	Config                      *persistence.InternalDomainConfig
+	ReplicationConfig           *persistence.InternalDomainReplicationConfig
+	ConfigVersion               int64
+	FailoverVersion             int64
+	FailoverNotificationVersion int64
+	PreviousFailoverVersion     int64
+	FailoverEndTime             *time.Time
+	NotificationVersion         int64
+	LastUpdatedTime             time.Time
+}
+
+func newDomainRecord(row *nosqlplugin.DomainRow) *domainRecord {
+	config := *row.Config
+	// retention is stored in days, the same as other databases
+	config.Retention = common.DaysToDuration(common.DurationToDays(config.Retention))
+	return &domainRecord{
+		Info:                        row.Info,
+		Config:                      &config,
+		ReplicationConfig:           row.ReplicationConfig,
+		ConfigVersion:               row.ConfigVersion,
+		FailoverVersion:             row.FailoverVersion,
+		FailoverNotificationVersion: row.FailoverNotificationVersion,
+		PreviousFailoverVersion:     row.PreviousFailoverVersion,
+		FailoverEndTime:             row.FailoverEndTime,
+		NotificationVersion:         row.NotificationVersion,
+		LastUpdatedTime:             row.LastUpdatedTime,
+	}
+}
+
+func domainByNameKey(name string) item {
+	return primaryKey(domainByNamePK, name)
+}
+
+func domainByIDKey(id string) item {
+	return primaryKey(domainByIDPK, id)
+}
+
+func domainMetadataKey() item {
+	return primaryKey(domainMetadata, domainMetadata)
+}
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	idItem := domainByIDKey(row.Info.ID)
+	idItem[attrDomainName] = stringValue(row.Info.Name)
+	notExists := expression.AttributeNotExists(expression.Name(attrPK))
+	if _, err := db.putItem(ctx, tableDomains, idItem, &notExists); err != nil {
+		if err == errConditionFailed {
+			return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+		}
+		return err
+	}
+
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	record := newDomainRecord(row)
+	record.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	record.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	record.NotificationVersion = metadataNotificationVersion
+	data, err := jsonValue(record)
+	if err != nil {
+		return err
+	}
+	nameItem := domainByNameKey(row.Info.Name)
+	nameItem[attrDomainID] = stringValue(row.Info.ID)
+	nameItem[attrIsGlobal] = &dynamodb.AttributeValue{BOOL: aws.Bool(row.IsGlobalDomain)}
+	nameItem[attrData] = data
+
+	txn := db.newTransaction()
+	if err := txn.put(tableDomains, nameItem, &notExists); err != nil {
+		return err
+	}
+	if err := db.updateMetadataInTransaction(txn, metadataNotificationVersion); err != nil {
+		return err
+	}
+	failures, err := txn.execute(ctx)
+	if err == nil {
+		return nil
+	}
+	if err != errConditionFailed {
+		return err
+	}
+
+	// Domain already exist. Delete orphan domain record before returning back to user
+	if _, errDelete := db.deleteItem(ctx, tableDomains, domainByIDKey(row.Info.ID), nil); errDelete != nil {
+		db.logger.Warn("Unable to delete orphan domain record. Error", tag.Error(errDelete))
+	}
+	for _, failure := range failures {
+		if failure.index == 0 {
+			db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+			return &types.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+			}
+		}
+	}
+	db.logger.Warn("Create domain operation failed because of condition update failure on domain metadata record")
+	return nosqlplugin.NewConditionFailure("domain")
+}
+
+// updateMetadataInTransaction bumps the notification version of the domain metadata,
+// with the condition that the current version is still notificationVersion
+func (db *ddb) updateMetadataInTransaction(txn *transaction, notificationVersion int64) error {
+	cond := expression.Name(attrNotifyVer).Equal(expression.Value(notificationVersion))
+	if notificationVersion <= 0 {
+		cond = expression.AttributeNotExists(expression.Name(attrPK)).Or(cond)
+	}
+	update := expression.Set(expression.Name(attrNotifyVer), expression.Value(notificationVersion+1))
+	return txn.update(tableDomains, domainMetadataKey(), update, &cond)
 }
 
 // Update domain
@@ -40,7 +173,28 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	data, err := jsonValue(newDomainRecord(row))
+	if err != nil {
+		return err
+	}
+	update := expression.
+		Set(expression.Name(attrData), expression.Value(data.B)).
+		Set(expression.Name(attrDomainID), expression.Value(row.Info.ID))
+
+	txn := db.newTransaction()
+	if err := txn.update(tableDomains, domainByNameKey(row.Info.Name), update, nil); err != nil {
+		return err
+	}
+	if err := db.updateMetadataInTransaction(txn, row.NotificationVersion); err != nil {
+		return err
+	}
+	if _, err := txn.execute(ctx); err != nil {
+		if err == errConditionFailed {
+			return nosqlplugin.NewConditionFailure("domain")
+		}
+		return err
+	}
+	return nil
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +203,57 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	if domainID != nil {
+		idItem, err := db.getItem(ctx, tableDomains, domainByIDKey(*domainID))
+		if err != nil {
+			return nil, err
+		}
+		domainName = common.StringPtr(getString(idItem, attrDomainName))
+	}
+
+	it, err := db.getItem(ctx, tableDomains, domainByNameKey(*domainName))
+	if err != nil {
+		return nil, err
+	}
+	return parseDomainItem(it)
+}
+
+func parseDomainItem(it item) (*nosqlplugin.DomainRow, error) {
+	var record domainRecord
+	if err := getJSON(it, attrData, &record); err != nil {
+		return nil, err
+	}
+	record.Config.BadBinaries = normalizeBlob(record.Config.BadBinaries)
+	record.Config.IsolationGroups = normalizeBlob(record.Config.IsolationGroups)
+	record.Config.AsyncWorkflowsConfig = normalizeBlob(record.Config.AsyncWorkflowsConfig)
+	record.ReplicationConfig.ActiveClustersConfig = normalizeBlob(record.ReplicationConfig.ActiveClustersConfig)
+
+	isGlobalDomain := false
+	if v, ok := it[attrIsGlobal]; ok && v != nil {
+		isGlobalDomain = aws.BoolValue(v.BOOL)
+	}
+	row := &nosqlplugin.DomainRow{
+		Info:                        record.Info,
+		Config:                      record.Config,
+		ReplicationConfig:           record.ReplicationConfig,
+		ConfigVersion:               record.ConfigVersion,
+		FailoverVersion:             record.FailoverVersion,
+		FailoverNotificationVersion: record.FailoverNotificationVersion,
+		PreviousFailoverVersion:     record.PreviousFailoverVersion,
+		NotificationVersion:         record.NotificationVersion,
+		LastUpdatedTime:             time.Unix(0, record.LastUpdatedTime.UnixNano()),
+		IsGlobalDomain:              isGlobalDomain,
+	}
+	if record.FailoverEndTime != nil && record.FailoverEndTime.UnixNano() > 0 {
+		row.FailoverEndTime = common.TimePtr(time.Unix(0, record.FailoverEndTime.UnixNano()))
+	}
+	return row, nil
 }
 
 // Get all domain data
@@ -58,7 +262,23 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	query, err := db.newQuery(tableDomains, keyCondition(domainByNamePK, nil), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, query, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.DomainRow, 0, len(items))
+	for _, it := range items {
+		row, err := parseDomainItem(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +287,46 @@ func (db *ddb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	if domainName == nil {
+		idItem, err := db.getItem(ctx, tableDomains, domainByIDKey(*domainID))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		domainName = common.StringPtr(getString(idItem, attrDomainName))
+	} else {
+		nameItem, err := db.getItem(ctx, tableDomains, domainByNameKey(*domainName))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		domainID = common.StringPtr(getString(nameItem, attrDomainID))
+	}
+
+	if _, err := db.deleteItem(ctx, tableDomains, domainByNameKey(*domainName), nil); err != nil {
+		return err
+	}
+	_, err := db.deleteItem(ctx, tableDomains, domainByIDKey(*domainID), nil)
+	return err
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableDomains, domainMetadataKey())
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return 0, nil
+		}
+		return -1, err
+	}
+	return getNumber(it, attrNotifyVer)
 }
@@ -22,16 +22,73 @@ package dynamodb
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// domainAuditLogPK partitions the audit logs by domain and operation type
+func domainAuditLogPK(domainID string, operationType int) string {
+	return joinKey(domainID, strconv.Itoa(operationType))
+}
+
+// domainAuditLogSK orders the audit logs by created time(DESC) and then event ID
+func domainAuditLogSK(createdTime time.Time, eventID string) string {
+	return joinKey(sortableInt64(^timeToUnixNano(createdTime)), eventID)
+}
+
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *ddb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	panic("TODO: InsertDomainAuditLog not implemented")
+	data, err := jsonValue(row)
+	if err != nil {
+		return err
+	}
+	it := primaryKey(domainAuditLogPK(row.DomainID, int(row.OperationType)), domainAuditLogSK(row.CreatedTime, row.EventID))
+	it[attrData] = data
+	if row.TTLSeconds > 0 {
+		it[attrTTL] = ttlValue(row.TTLSeconds)
+	}
+	_, err = db.putItem(ctx, tableDomainAuditLog, it, nil)
+	return err
 }
 
 // SelectDomainAuditLogs returns audit log entries for a domain and operation type
 func (db *ddb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	panic("TODO: SelectDomainAuditLogs not implemented")
+	start := time.Unix(0, 0)
+	end := time.Unix(0, time.Now().UnixNano())
+
+	if filter.MinCreatedTime != nil {
+		start = *filter.MinCreatedTime
+	}
+	if filter.MaxCreatedTime != nil {
+		end = *filter.MaxCreatedTime
+	}
+
+	// the created time is inverted in the sort key, so the bounds are swapped
+	inclusiveMin, inclusiveMax := timeToUnixNano(start), exclusiveMax(timeToUnixNano(end))
+	if inclusiveMin > inclusiveMax {
+		return nil, nil, nil
+	}
+	lower := sortableInt64(^inclusiveMax)
+	upper := joinKey(sortableInt64(^inclusiveMin), keyUpperBound)
+	query, err := db.newQuery(tableDomainAuditLog, keyCondition(domainAuditLogPK(filter.DomainID, int(filter.OperationType)), skBetween(lower, upper)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, query, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(items))
+	for _, it := range items {
+		var row nosqlplugin.DomainAuditLogRow
+		if err := getJSON(it, attrData, &row); err != nil {
+			return nil, nil, err
+		}
+		row.TTLSeconds = 0
+		rows = append(rows, &row)
+	}
+	return rows, nextPageToken, nil
 }
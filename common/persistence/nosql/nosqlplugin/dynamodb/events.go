@@ -22,31 +22,229 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	attrNodeData         = "node_data"
+	attrNodeDataEncoding = "node_data_encoding"
+	attrTxnID            = "txn_id"
+	attrNodeID           = "node_id"
 )
 
+// historyTreeRecord is the JSON encoded data of a history branch item
+type historyTreeRecord struct {
+	Ancestors       []*historyBranchAncestor
+	CreateTimestamp time.Time
+	Info            string
+}
+
+type historyBranchAncestor struct {
+	BranchID  string
+	EndNodeID int64
+}
+
+// historyNodeSK orders the nodes of a tree by (branch_id ASC, node_id ASC, txn_id DESC)
+func historyNodeSK(branchID string, nodeID int64, txnID int64) string {
+	return joinKey(branchID, sortableInt64(nodeID), sortableInt64(^txnID))
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *ddb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	txn := db.newTransaction()
+	if treeRow != nil {
+		record := &historyTreeRecord{
+			CreateTimestamp: treeRow.CreateTimestamp,
+			Info:            treeRow.Info,
+		}
+		for _, an := range treeRow.Ancestors {
+			record.Ancestors = append(record.Ancestors, &historyBranchAncestor{
+				BranchID:  an.BranchID,
+				EndNodeID: an.EndNodeID,
+			})
+		}
+		data, err := jsonValue(record)
+		if err != nil {
+			return err
+		}
+		it := primaryKey(treeRow.TreeID, treeRow.BranchID)
+		it[attrData] = data
+		if err := txn.put(tableHistoryTree, it, nil); err != nil {
+			return err
+		}
+	}
+	if nodeRow != nil {
+		txnID := int64(0)
+		if nodeRow.TxnID != nil {
+			txnID = *nodeRow.TxnID
+		}
+		it := primaryKey(nodeRow.TreeID, historyNodeSK(nodeRow.BranchID, nodeRow.NodeID, txnID))
+		it[attrNodeID] = numberValue(nodeRow.NodeID)
+		it[attrTxnID] = numberValue(txnID)
+		it[attrNodeData] = &dynamodb.AttributeValue{B: nodeRow.Data}
+		it[attrNodeDataEncoding] = stringValue(nodeRow.DataEncoding)
+		it[attrCreatedTime] = numberValue(timeToUnixNano(nodeRow.CreateTimestamp))
+		if err := txn.put(tableHistoryNode, it, nil); err != nil {
+			return err
+		}
+	}
+
+	if len(txn.items) == 1 {
+		// a single item doesn't need the overhead of a transaction
+		put := txn.items[0].Put
+		_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{TableName: put.TableName, Item: put.Item})
+		return err
+	}
+	_, err := txn.execute(ctx)
+	return err
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *ddb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	if filter.MinNodeID >= filter.MaxNodeID {
+		return nil, nil, nil
+	}
+	lower := joinKey(filter.BranchID, sortableInt64(filter.MinNodeID))
+	upper := joinKey(filter.BranchID, sortableInt64(filter.MaxNodeID-1), keyUpperBound)
+	query, err := db.newQuery(tableHistoryNode, keyCondition(filter.TreeID, skBetween(lower, upper)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, query, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(items))
+	for _, it := range items {
+		nodeID, err := getNumber(it, attrNodeID)
+		if err != nil {
+			return nil, nil, err
+		}
+		txnID, err := getNumber(it, attrTxnID)
+		if err != nil {
+			return nil, nil, err
+		}
+		var data []byte
+		if v, ok := it[attrNodeData]; ok && v != nil {
+			data = v.B
+		}
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			TreeID:       filter.TreeID,
+			BranchID:     filter.BranchID,
+			NodeID:       nodeID,
+			TxnID:        &txnID,
+			Data:         data,
+			DataEncoding: getString(it, attrNodeDataEncoding),
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
+// for each range, it will delete all nodes starting from MinNodeID(inclusive)
 func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// DynamoDB doesn't support range deletes, so nodes are deleted before the branch record
+	// to make sure a failed deletion can be retried from the branch record
+	for _, nodeFilter := range nodeFilters {
+		lower := joinKey(nodeFilter.BranchID, sortableInt64(nodeFilter.MinNodeID))
+		upper := joinKey(nodeFilter.BranchID, sortableInt64(math.MaxInt64), keyUpperBound)
+		query, err := db.newQuery(tableHistoryNode, keyCondition(nodeFilter.TreeID, skBetween(lower, upper)), nil)
+		if err != nil {
+			return err
+		}
+		if _, err := db.queryDelete(ctx, query, 0); err != nil {
+			return err
+		}
+	}
+	_, err := db.deleteItem(ctx, tableHistoryTree, primaryKey(treeFilter.TreeID, aws.StringValue(treeFilter.BranchID)), nil)
+	return err
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName:      aws.String(db.tableName(tableHistoryTree)),
+		ConsistentRead: aws.Bool(true),
+	}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, it := range items {
+		row, err := parseHistoryTreeItem(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *ddb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	query, err := db.newQuery(tableHistoryTree, keyCondition(filter.TreeID, nil), nil)
+	if err != nil {
+		return nil, err
+	}
+	items, _, err := db.queryPage(ctx, query, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, it := range items {
+		row, err := parseHistoryTreeItem(it)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func parseHistoryTreeItem(it item) (*nosqlplugin.HistoryTreeRow, error) {
+	var record historyTreeRecord
+	if err := getJSON(it, attrData, &record); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryTreeRow{
+		TreeID:          getString(it, attrPK),
+		BranchID:        getString(it, attrSK),
+		Ancestors:       parseBranchAncestors(record.Ancestors),
+		CreateTimestamp: record.CreateTimestamp,
+		Info:            record.Info,
+	}, nil
+}
+
+func parseBranchAncestors(ancestors []*historyBranchAncestor) []*types.HistoryBranchRange {
+	ans := make([]*types.HistoryBranchRange, 0, len(ancestors))
+	for _, e := range ancestors {
+		ans = append(ans, &types.HistoryBranchRange{
+			BranchID:  e.BranchID,
+			EndNodeID: e.EndNodeID,
+		})
+	}
+
+	if len(ans) > 0 {
+		// sort ans based onf EndNodeID so that we can set BeginNodeID
+		sort.Slice(ans, func(i, j int) bool { return ans[i].EndNodeID < ans[j].EndNodeID })
+		ans[0].BeginNodeID = int64(1)
+		for i := 1; i < len(ans); i++ {
+			ans[i].BeginNodeID = ans[i-1].EndNodeID
+		}
+	}
+	return ans
 }
@@ -0,0 +1,168 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/uuid"
+)
+
+// A workflow write which doesn't fit in a single transaction is committed in two steps. The transaction
+// with the conditions(shard range ID, next event ID, current run, ...) commits the execution item along with
+// pending items, which hold the writes of the mutable state entries and tasks that didn't fit.
+// Each pending item is then applied by a follow-up transaction, which is fenced by the shard range ID and
+// deletes the pending item, so that it is applied exactly once and only by the owner of the shard.
+//
+// When a follow-up transaction fails, the write fails with an error of unknown outcome, and the history shard
+// renews its range ID, the same as for a timeout. Renewing the range ID applies the remaining pending items of
+// the shard before any other write, see UpdateShard, so that the following reads see the whole write.
+const (
+	executionTypePending = "pending"
+
+	attrPuts    = "puts"
+	attrDeletes = "deletes"
+
+	// maxPendingItemOperations leaves room for the shard condition and the deletion of the pending item
+	// in the follow-up transaction
+	maxPendingItemOperations = maxTransactionItems - 2
+	// maxPendingItemSize keeps a pending item below the 400KB item size limit
+	maxPendingItemSize = 300 * 1024
+	// maxTransactionSize is the 4MB limit of a transaction, with some room for the request overhead
+	maxTransactionSize = 3500 * 1024
+)
+
+// operation is the unconditional write of an item which isn't checked by a transaction,
+// either a put or a delete
+type operation struct {
+	put    item
+	delete item
+}
+
+func (o operation) size() int {
+	if o.put != nil {
+		return itemSize(o.put)
+	}
+	return itemSize(o.delete)
+}
+
+func operationsSize(operations []operation) int {
+	size := 0
+	for _, o := range operations {
+		size += o.size()
+	}
+	return size
+}
+
+// newPendingItems groups the operations in pending items
+func newPendingItems(shardID int, operations []operation) []item {
+	// the sort key orders the pending items of a shard by the time they were written
+	writeID := joinKey(sortableTime(time.Now()), uuid.NewString())
+	var result []item
+	for i := 0; len(operations) > 0; i++ {
+		n, size := 0, 0
+		for n < len(operations) && n < maxPendingItemOperations {
+			size += operations[n].size()
+			if n > 0 && size > maxPendingItemSize {
+				break
+			}
+			n++
+		}
+
+		it := primaryKey(shardPK(shardID, executionTypePending), joinKey(writeID, sortableInt64(int64(i))))
+		var puts, deletes []*dynamodb.AttributeValue
+		for _, o := range operations[:n] {
+			if o.put != nil {
+				puts = append(puts, &dynamodb.AttributeValue{M: o.put})
+			} else {
+				deletes = append(deletes, &dynamodb.AttributeValue{M: o.delete})
+			}
+		}
+		if len(puts) > 0 {
+			it[attrPuts] = &dynamodb.AttributeValue{L: puts}
+		}
+		if len(deletes) > 0 {
+			it[attrDeletes] = &dynamodb.AttributeValue{L: deletes}
+		}
+		result = append(result, it)
+		operations = operations[n:]
+	}
+	return result
+}
+
+// applyPendingItem applies the operations of a pending item and deletes it, in a transaction fenced by the
+// shard range ID. The failed shard condition is returned with errConditionFailed if the shard moved.
+func (db *ddb) applyPendingItem(ctx context.Context, shardID int, rangeID int64, pending item) ([]conditionFailure, error) {
+	txn := db.newWorkflowTransaction()
+	if err := txn.assertShardRangeID(shardID, rangeID); err != nil {
+		return nil, err
+	}
+	if v, ok := pending[attrPuts]; ok {
+		for _, put := range v.L {
+			if err := txn.put(tableExecutions, put.M, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if v, ok := pending[attrDeletes]; ok {
+		for _, key := range v.L {
+			if err := txn.delete(tableExecutions, key.M, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := txn.delete(tableExecutions, primaryKey(getString(pending, attrPK), getString(pending, attrSK)), nil); err != nil {
+		return nil, err
+	}
+	return txn.transaction.execute(ctx)
+}
+
+// applyPendingItems applies the pending items left by the writes of the shard which failed after their commit.
+// It is called by the owner of the shard when it renews the range ID, before any other write of the shard.
+func (db *ddb) applyPendingItems(ctx context.Context, shardID int, rangeID int64) error {
+	query, err := db.newQuery(tableExecutions, keyCondition(shardPK(shardID, executionTypePending), nil), nil)
+	if err != nil {
+		return err
+	}
+	var pageToken []byte
+	for {
+		items, nextPageToken, err := db.queryPage(ctx, query, maxPendingItemOperations, pageToken)
+		if err != nil {
+			return err
+		}
+		for _, it := range items {
+			failures, err := db.applyPendingItem(ctx, shardID, rangeID, it)
+			if db.IsConditionFailedError(err) {
+				return convertToConflictedShardRow(failures[0].previous)
+			}
+			if err != nil {
+				return fmt.Errorf("apply pending writes of shard %v: %w", shardID, err)
+			}
+		}
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+		pageToken = nextPageToken
+	}
+}
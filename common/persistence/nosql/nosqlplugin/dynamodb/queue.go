@@ -22,18 +22,112 @@ package dynamodb
 
 import (
 	"context"
+	"math"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	queueMessagePrefix = "message" + keySeparator
+	queueMetadataSK    = "metadata"
+
+	attrMessagePayload   = "message_payload"
+	attrCreatedTime      = "created_time"
+	attrClusterAckLevels = "cluster_ack_levels"
+	attrVersion          = "version"
+)
+
+func queuePK(queueType persistence.QueueType) string {
+	return strconv.Itoa(int(queueType))
+}
+
+func queueMessageKey(queueType persistence.QueueType, messageID int64) item {
+	return primaryKey(queuePK(queueType), queueMessagePrefix+sortableInt64(messageID))
+}
+
+func parseQueueMessage(queueType persistence.QueueType, it item) (*nosqlplugin.QueueMessageRow, error) {
+	id, err := parseSortableInt64(getString(it, attrSK)[len(queueMessagePrefix):])
+	if err != nil {
+		return nil, err
+	}
+	var payload []byte
+	if v, ok := it[attrMessagePayload]; ok && v != nil {
+		payload = v.B
+	}
+	return &nosqlplugin.QueueMessageRow{
+		QueueType: queueType,
+		ID:        id,
+		Payload:   payload,
+	}, nil
+}
+
+// selectMessages reads the messages with inclusiveMin <= ID <= inclusiveMax, ordered by ID
+func (db *ddb) selectMessages(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	inclusiveMin int64,
+	inclusiveMax int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*nosqlplugin.QueueMessageRow, []byte, error) {
+	skCond, ok := skInt64Range(queueMessagePrefix, inclusiveMin, inclusiveMax)
+	if !ok {
+		return nil, nil, nil
+	}
+	query, err := db.newQuery(tableQueue, keyCondition(queuePK(queueType), skCond), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, query, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.QueueMessageRow, 0, len(items))
+	for _, it := range items {
+		row, err := parseQueueMessage(queueType, it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
+}
+
+// deleteMessages deletes the messages with inclusiveMin <= ID <= inclusiveMax
+func (db *ddb) deleteMessages(ctx context.Context, queueType persistence.QueueType, inclusiveMin int64, inclusiveMax int64) error {
+	skCond, ok := skInt64Range(queueMessagePrefix, inclusiveMin, inclusiveMax)
+	if !ok {
+		return nil
+	}
+	query, err := db.newQuery(tableQueue, keyCondition(queuePK(queueType), skCond), nil)
+	if err != nil {
+		return err
+	}
+	_, err = db.queryDelete(ctx, query, 0)
+	return err
+}
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	it := queueMessageKey(row.QueueType, row.ID)
+	it[attrMessagePayload] = &dynamodb.AttributeValue{B: row.Payload}
+	it[attrCreatedTime] = numberValue(timeToUnixNano(row.CurrentTimeStamp))
+	cond := expression.AttributeNotExists(expression.Name(attrPK))
+	_, err := db.putItem(ctx, tableQueue, it, &cond)
+	if err == errConditionFailed {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +135,23 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	query, err := db.newQuery(tableQueue, keyCondition(queuePK(queueType), skBeginsWith(queueMessagePrefix)), nil)
+	if err != nil {
+		return 0, err
+	}
+	query.ScanIndexForward = aws.Bool(false)
+	items, _, err := db.queryPage(ctx, query, 1, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, errNotFound
+	}
+	row, err := parseQueueMessage(queueType, items[0])
+	if err != nil {
+		return 0, err
+	}
+	return row.ID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +161,8 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	rows, _, err := db.selectMessages(ctx, queueType, exclusiveMin(exclusiveBeginMessageID), math.MaxInt64, maxRows, nil)
+	return rows, err
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +170,22 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	rows, nextPageToken, err := db.selectMessages(
+		ctx,
+		request.QueueType,
+		exclusiveMin(request.ExclusiveBeginMessageID),
+		request.InclusiveEndMessageID,
+		request.PageSize,
+		request.NextPageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+	response := &nosqlplugin.SelectMessagesBetweenResponse{NextPageToken: nextPageToken}
+	for _, row := range rows {
+		response.Rows = append(response.Rows, *row)
+	}
+	return response, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +194,7 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteMessages(ctx, queueType, math.MinInt64, exclusiveMax(exclusiveBeginMessageID))
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +204,7 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteMessages(ctx, queueType, exclusiveMin(exclusiveBeginMessageID), inclusiveEndMessageID)
 }
 
 // Delete one message
@@ -87,12 +213,27 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.deleteItem(ctx, tableQueue, queueMessageKey(queueType, messageID), nil)
+	return err
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *ddb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	panic("TODO")
+	ackLevels, err := jsonValue(map[string]int64{})
+	if err != nil {
+		return err
+	}
+	it := primaryKey(queuePK(row.QueueType), queueMetadataSK)
+	it[attrClusterAckLevels] = ackLevels
+	it[attrVersion] = numberValue(row.Version)
+	it[attrCreatedTime] = numberValue(timeToUnixNano(row.CurrentTimeStamp))
+	cond := expression.AttributeNotExists(expression.Name(attrPK))
+	_, err = db.putItem(ctx, tableQueue, it, &cond)
+	if err == errConditionFailed {
+		// it's ok if the item exists already
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -102,7 +243,19 @@ func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	ackLevels, err := jsonValue(row.ClusterAckLevels)
+	if err != nil {
+		return err
+	}
+	update := expression.
+		Set(expression.Name(attrClusterAckLevels), expression.Value(ackLevels.B)).
+		Set(expression.Name(attrVersion), expression.Value(row.Version))
+	cond := expression.Name(attrVersion).Equal(expression.Value(row.Version - 1))
+	_, err = db.updateItem(ctx, tableQueue, primaryKey(queuePK(row.QueueType), queueMetadataSK), update, &cond)
+	if err == errConditionFailed {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -110,12 +263,36 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableQueue, primaryKey(queuePK(queueType), queueMetadataSK))
+	if err != nil {
+		return nil, err
+	}
+	version, err := getNumber(it, attrVersion)
+	if err != nil {
+		return nil, err
+	}
+	var ackLevels map[string]int64
+	if err := getJSON(it, attrClusterAckLevels, &ackLevels); err != nil {
+		return nil, err
+	}
+	// if record exist but ackLevels is empty, we initialize the map
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          version,
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	query, err := db.newQuery(tableQueue, keyCondition(queuePK(queueType), skBeginsWith(queueMessagePrefix)), nil)
+	if err != nil {
+		return 0, err
+	}
+	return db.queryCount(ctx, query)
 }
@@ -22,29 +22,150 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	attrRangeID = "range_id"
+	shardSK     = "shard"
+)
+
+// shardRecord is the JSON encoded data of a shard item
+type shardRecord struct {
+	Info         *persistence.InternalShardInfo
+	Data         []byte
+	DataEncoding string
+}
+
+// shardKey is the key of the shard item, it lives in the executions table so that
+// workflow transactions can check the range ID
+func shardKey(shardID int) item {
+	return primaryKey(joinKey("shard", strconv.Itoa(shardID)), shardSK)
+}
+
+func newShardItem(row *nosqlplugin.ShardRow) (item, error) {
+	info := *row.InternalShardInfo
+	info.UpdatedAt = row.CurrentTimestamp
+	data, err := jsonValue(&shardRecord{
+		Info:         &info,
+		Data:         row.Data,
+		DataEncoding: row.DataEncoding,
+	})
+	if err != nil {
+		return nil, err
+	}
+	it := shardKey(row.ShardID)
+	it[attrRangeID] = numberValue(row.RangeID)
+	it[attrData] = data
+	return it, nil
+}
+
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	it, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	cond := expression.AttributeNotExists(expression.Name(attrPK))
+	previous, err := db.putItem(ctx, tableExecutions, it, &cond)
+	if err == errConditionFailed {
+		return convertToConflictedShardRow(previous)
+	}
+	return err
+}
+
+func convertToConflictedShardRow(previous item) error {
+	rangeID, err := getNumber(previous, attrRangeID)
+	if err != nil {
+		rangeID = -1
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("range_id=%v", rangeID),
+	}
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, shardKey(shardID))
+	if err != nil {
+		return 0, nil, err
+	}
+	rangeID, err := getNumber(it, attrRangeID)
+	if err != nil {
+		return 0, nil, err
+	}
+	var record shardRecord
+	if err := getJSON(it, attrData, &record); err != nil {
+		return 0, nil, err
+	}
+
+	info := record.Info
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	info.PendingFailoverMarkers = normalizeBlob(info.PendingFailoverMarkers)
+	info.TransferProcessingQueueStates = normalizeBlob(info.TransferProcessingQueueStates)
+	info.TimerProcessingQueueStates = normalizeBlob(info.TimerProcessingQueueStates)
+	return rangeID, &nosqlplugin.ShardRow{
+		InternalShardInfo: info,
+		Data:              record.Data,
+		DataEncoding:      record.DataEncoding,
+	}, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	update := expression.Set(expression.Name(attrRangeID), expression.Value(rangeID))
+	cond := expression.Name(attrRangeID).Equal(expression.Value(previousRangeID))
+	previous, err := db.updateItem(ctx, tableExecutions, shardKey(shardID), update, &cond)
+	if err == errConditionFailed {
+		return convertToConflictedShardRow(previous)
+	}
+	if err != nil {
+		return err
+	}
+	return db.applyPendingItems(ctx, shardID, rangeID)
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	it, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	cond := expression.Name(attrRangeID).Equal(expression.Value(previousRangeID))
+	previous, err := db.putItem(ctx, tableExecutions, it, &cond)
+	if err == errConditionFailed {
+		return convertToConflictedShardRow(previous)
+	}
+	if err != nil || row.RangeID == previousRangeID {
+		return err
+	}
+	// the range ID is renewed when the shard is acquired, or when the outcome of a write is unknown:
+	// the writes which failed after their commit are completed before the shard is used with the new range ID
+	return db.applyPendingItems(ctx, row.ShardID, row.RangeID)
 }
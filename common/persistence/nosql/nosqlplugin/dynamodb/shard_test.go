@@ -0,0 +1,90 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func TestInsertShard(t *testing.T) {
+	client := newFakeClient()
+	db := newTestDB(t, client)
+	row := &nosqlplugin.ShardRow{
+		InternalShardInfo: &persistence.InternalShardInfo{ShardID: 1, RangeID: 3},
+	}
+
+	require.NoError(t, db.InsertShard(context.Background(), row))
+	require.Len(t, client.puts, 1)
+	assert.Equal(t, "test_executions", aws.StringValue(client.puts[0].TableName))
+	assert.Equal(t, "3", aws.StringValue(client.puts[0].Item[attrRangeID].N))
+	assert.NotNil(t, client.puts[0].ConditionExpression)
+
+	client.putErr = &dynamodb.ConditionalCheckFailedException{
+		Message_: aws.String("conditional request failed"),
+		Item:     item{attrRangeID: numberValue(5)},
+	}
+	err := db.InsertShard(context.Background(), row)
+	var conflict *nosqlplugin.ShardOperationConditionFailure
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, int64(5), conflict.RangeID)
+}
+
+func TestConvertToConflictedShardRow(t *testing.T) {
+	err := convertToConflictedShardRow(nil)
+	var conflict *nosqlplugin.ShardOperationConditionFailure
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, int64(-1), conflict.RangeID)
+}
+
+func TestUpdateShard_AppliesPendingItems(t *testing.T) {
+	client := newFakeClient()
+	db := newTestDB(t, client)
+	taskKey := transferTaskKey(1, 5)
+	pending := newPendingItems(1, []operation{{put: taskKey}, {delete: transferTaskKey(1, 6)}})
+	require.Len(t, pending, 1)
+	client.putItem(tableExecutions, pending[0])
+	row := &nosqlplugin.ShardRow{
+		InternalShardInfo: &persistence.InternalShardInfo{ShardID: 1, RangeID: 4},
+	}
+
+	// the range ID is not renewed
+	require.NoError(t, db.UpdateShard(context.Background(), row, 4))
+	assert.Empty(t, client.transactions)
+
+	require.NoError(t, db.UpdateShard(context.Background(), row, 3))
+	require.Len(t, client.transactions, 1)
+	items := client.transactions[0].TransactItems
+	require.Len(t, items, 4)
+	assert.Equal(t, shardKey(1), items[0].ConditionCheck.Key)
+	assert.Equal(t, "4", aws.StringValue(items[0].ConditionCheck.ExpressionAttributeValues[":0"].N))
+	assert.Equal(t, taskKey, items[1].Put.Item)
+	assert.Equal(t, transferTaskKey(1, 6), items[2].Delete.Key)
+	assert.Equal(t, primaryKey(getString(pending[0], attrPK), getString(pending[0], attrSK)), items[3].Delete.Key)
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,20 +22,151 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	taskListSK     = "tasklist"
+	taskSKPrefix   = "task" + keySeparator
+	initialRangeID = 1 // Id of the first range of a new task list
+
+	attrTaskListName = "task_list_name"
+	attrTaskListType = "task_list_type"
+)
+
+// taskListRecord is the JSON encoded data of a tasklist item
+type taskListRecord struct {
+	TaskListKind            int
+	AckLevel                int64
+	LastUpdatedTime         time.Time
+	AdaptivePartitionConfig *persistence.TaskListPartitionConfig
+}
+
+// taskRecord is the JSON encoded data of a task item
+type taskRecord struct {
+	WorkflowID      string
+	RunID           string
+	ScheduledID     int64
+	CreatedTime     time.Time
+	Expiry          time.Time
+	PartitionConfig map[string]string
+	Priority        string
+}
+
+// taskListPK is the partition key of a tasklist and its tasks
+func taskListPK(domainID string, taskListName string, taskListType int) string {
+	return joinKey(domainID, escapeKey(taskListName), strconv.Itoa(taskListType))
+}
+
+func taskListKey(domainID string, taskListName string, taskListType int) item {
+	return primaryKey(taskListPK(domainID, taskListName, taskListType), taskListSK)
+}
+
+func newTaskListItem(row *nosqlplugin.TaskListRow, rangeID int64) (item, error) {
+	data, err := jsonValue(&taskListRecord{
+		TaskListKind:            row.TaskListKind,
+		AckLevel:                row.AckLevel,
+		LastUpdatedTime:         row.LastUpdatedTime,
+		AdaptivePartitionConfig: row.AdaptivePartitionConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	it := taskListKey(row.DomainID, row.TaskListName, row.TaskListType)
+	it[attrDomainID] = stringValue(row.DomainID)
+	it[attrTaskListName] = stringValue(row.TaskListName)
+	it[attrTaskListType] = numberValue(int64(row.TaskListType))
+	it[attrRangeID] = numberValue(rangeID)
+	it[attrData] = data
+	return it, nil
+}
+
+func parseTaskListItem(it item) (*nosqlplugin.TaskListRow, error) {
+	rangeID, err := getNumber(it, attrRangeID)
+	if err != nil {
+		return nil, err
+	}
+	taskListType, err := getNumber(it, attrTaskListType)
+	if err != nil {
+		return nil, err
+	}
+	var record taskListRecord
+	if err := getJSON(it, attrData, &record); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.TaskListRow{
+		DomainID:                getString(it, attrDomainID),
+		TaskListName:            getString(it, attrTaskListName),
+		TaskListType:            int(taskListType),
+		RangeID:                 rangeID,
+		TaskListKind:            record.TaskListKind,
+		AckLevel:                record.AckLevel,
+		LastUpdatedTime:         record.LastUpdatedTime,
+		AdaptivePartitionConfig: record.AdaptivePartitionConfig,
+	}, nil
+}
+
+func handleTaskListConditionFailure(previous item) error {
+	// the tasklist item doesn't exist if there is no range ID
+	rangeID, err := getNumber(previous, attrRangeID)
+	if err != nil {
+		rangeID = -1
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("range_id=%v", rangeID),
+	}
+}
+
+// tasksKeyCondition is the key condition of the tasks with exclusiveMinTaskID < taskID <= inclusiveMaxTaskID
+func tasksKeyCondition(filter *nosqlplugin.TasksFilter, inclusiveMaxTaskID int64) (expression.KeyConditionBuilder, bool) {
+	skCond, ok := skInt64Range(taskSKPrefix, exclusiveMin(filter.MinTaskID), inclusiveMaxTaskID)
+	if !ok {
+		return expression.KeyConditionBuilder{}, false
+	}
+	return keyCondition(taskListPK(filter.DomainID, filter.TaskListName, filter.TaskListType), skCond), true
+}
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *ddb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableTasks, taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType))
+	if err != nil {
+		return nil, err
+	}
+	return parseTaskListItem(it)
 }
 
 // InsertTaskList insert a single tasklist row
 // Return IsConditionFailedError if the row already exists, and also the existing row
 func (db *ddb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	it, err := newTaskListItem(&nosqlplugin.TaskListRow{
+		DomainID:        row.DomainID,
+		TaskListName:    row.TaskListName,
+		TaskListType:    row.TaskListType,
+		TaskListKind:    row.TaskListKind,
+		AckLevel:        0,
+		LastUpdatedTime: row.LastUpdatedTime,
+	}, initialRangeID)
+	if err != nil {
+		return err
+	}
+	cond := expression.AttributeNotExists(expression.Name(attrPK))
+	previous, err := db.putItem(ctx, tableTasks, it, &cond)
+	if err == errConditionFailed {
+		return handleTaskListConditionFailure(previous)
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +176,7 @@ func (db *ddb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, row, previousRangeID, 0)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +189,68 @@ func (db *ddb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, row, previousRangeID, ttlSeconds)
+}
+
+func (db *ddb) updateTaskList(
+	ctx context.Context,
+	row *nosqlplugin.TaskListRow,
+	previousRangeID int64,
+	ttlSeconds int64,
+) error {
+	it, err := newTaskListItem(row, row.RangeID)
+	if err != nil {
+		return err
+	}
+	if ttlSeconds > 0 {
+		it[attrTTL] = ttlValue(ttlSeconds)
+	}
+	cond := expression.Name(attrRangeID).Equal(expression.Value(previousRangeID))
+	previous, err := db.putItem(ctx, tableTasks, it, &cond)
+	if err == errConditionFailed {
+		return handleTaskListConditionFailure(previous)
+	}
+	return err
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *ddb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	filter := expression.Name(attrSK).Equal(expression.Value(taskListSK))
+	expr, err := expression.NewBuilder().WithFilter(filter).Build()
+	if err != nil {
+		return nil, err
+	}
+	items, nextPageToken, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName:                 aws.String(db.tableName(tableTasks)),
+		FilterExpression:          expr.Filter(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ConsistentRead:            aws.Bool(true),
+	}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	result := &nosqlplugin.ListTaskListResult{NextPageToken: nextPageToken}
+	for _, it := range items {
+		row, err := parseTaskListItem(it)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *ddb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	cond := expression.Name(attrRangeID).Equal(expression.Value(previousRangeID))
+	previous, err := db.deleteItem(ctx, tableTasks, taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType), &cond)
+	if err == errConditionFailed {
+		return handleTaskListConditionFailure(previous)
+	}
+	return err
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,23 +260,125 @@ func (db *ddb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	pk := taskListPK(tasklistCondition.DomainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType)
+	rangeCond := expression.Name(attrRangeID).Equal(expression.Value(tasklistCondition.RangeID))
+
+	// every transaction checks the range ID of the tasklist, so a batch larger than a single transaction
+	// is written in several transactions which are all fenced by the range ID
+	for len(tasksToInsert) > 0 {
+		n := len(tasksToInsert)
+		if n > maxTransactionItems-1 {
+			n = maxTransactionItems - 1
+		}
+		txn := db.newTransaction()
+		for _, task := range tasksToInsert[:n] {
+			record := &taskRecord{
+				WorkflowID:      task.WorkflowID,
+				RunID:           task.RunID,
+				ScheduledID:     task.ScheduledID,
+				CreatedTime:     task.CreatedTime,
+				PartitionConfig: task.PartitionConfig,
+				Priority:        task.Priority,
+			}
+			if task.TTLSeconds > 0 {
+				record.Expiry = tasklistCondition.CurrentTimeStamp.Add(time.Duration(task.TTLSeconds) * time.Second)
+			}
+			data, err := jsonValue(record)
+			if err != nil {
+				return err
+			}
+			it := primaryKey(pk, taskSKPrefix+sortableInt64(task.TaskID))
+			it[attrData] = data
+			if task.TTLSeconds > 0 {
+				it[attrTTL] = numberValue(record.Expiry.Unix())
+			}
+			if err := txn.put(tableTasks, it, nil); err != nil {
+				return err
+			}
+		}
+		if err := txn.conditionCheck(tableTasks, primaryKey(pk, taskListSK), rangeCond); err != nil {
+			return err
+		}
+
+		failures, err := txn.execute(ctx)
+		if err == errConditionFailed {
+			// only the condition check has a condition
+			return handleTaskListConditionFailure(failures[0].previous)
+		}
+		if err != nil {
+			return err
+		}
+		tasksToInsert = tasksToInsert[n:]
+	}
+	return nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	keyCond, ok := tasksKeyCondition(filter, filter.MaxTaskID)
+	if !ok {
+		return nil, nil
+	}
+	query, err := db.newQuery(tableTasks, keyCond, nil)
+	if err != nil {
+		return nil, err
+	}
+	items, _, err := db.queryPage(ctx, query, filter.BatchSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []*nosqlplugin.TaskRow
+	for _, it := range items {
+		taskID, err := parseSortableInt64(getString(it, attrSK)[len(taskSKPrefix):])
+		if err != nil {
+			return nil, err
+		}
+		var record taskRecord
+		if err := getJSON(it, attrData, &record); err != nil {
+			return nil, err
+		}
+		response = append(response, &nosqlplugin.TaskRow{
+			DomainID:        filter.DomainID,
+			TaskListName:    filter.TaskListName,
+			TaskListType:    filter.TaskListType,
+			TaskID:          taskID,
+			WorkflowID:      record.WorkflowID,
+			RunID:           record.RunID,
+			ScheduledID:     record.ScheduledID,
+			Expiry:          record.Expiry,
+			CreatedTime:     record.CreatedTime,
+			PartitionConfig: record.PartitionConfig,
+			Priority:        record.Priority,
+		})
+	}
+	return response, nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	keyCond, ok := tasksKeyCondition(filter, math.MaxInt64)
+	if !ok {
+		return 0, nil
+	}
+	query, err := db.newQuery(tableTasks, keyCond, nil)
+	if err != nil {
+		return 0, err
+	}
+	return db.queryCount(ctx, query)
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
 // If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
+// NOTE: DynamoDB doesn't support range deletes, so the tasks are read and deleted in batches of at most `BatchSize` tasks
 func (db *ddb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	keyCond, ok := tasksKeyCondition(filter, filter.MaxTaskID)
+	if !ok {
+		return 0, nil
+	}
+	query, err := db.newQuery(tableTasks, keyCond, nil)
+	if err != nil {
+		return 0, err
+	}
+	return db.queryDelete(ctx, query, filter.BatchSize)
 }
@@ -0,0 +1,671 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// item is a DynamoDB item, or the primary key of an item
+type item = map[string]*dynamodb.AttributeValue
+
+const (
+	tableExecutions     = "executions"
+	tableHistoryTree    = "history_tree"
+	tableHistoryNode    = "history_node"
+	tableTasks          = "tasks"
+	tableQueue          = "queue"
+	tableDomains        = "domains"
+	tableVisibility     = "visibility"
+	tableDomainAuditLog = "domain_audit_log"
+	tableClusterConfig  = "cluster_config"
+
+	// every table uses a string partition key and a string sort key
+	attrPK = "pk"
+	attrSK = "sk"
+	// attrData holds the JSON encoded value of an item, for all the columns that don't need to be queried or conditioned on
+	attrData = "data"
+	// attrTTL is the TTL attribute(epoch seconds) of the tables with TTL enabled
+	attrTTL = "ttl"
+
+	// keySeparator separates the components of a partition key or sort key
+	keySeparator = "#"
+	// keyUpperBound sorts after any character used in keys so it can be used as an inclusive upper bound of a prefix
+	keyUpperBound = "~"
+
+	// maxBatchWriteItems is the max number of items in a BatchWriteItem request
+	maxBatchWriteItems = 25
+	// maxTransactionItems is the max number of items in a TransactWriteItems request
+	maxTransactionItems = 100
+)
+
+var keyEscaper = strings.NewReplacer("%", "%25", keySeparator, "%23")
+
+func (db *ddb) tableName(name string) string {
+	if db.tablePrefix == "" {
+		return name
+	}
+	return db.tablePrefix + "_" + name
+}
+
+// joinKey builds a key from its components. Components which are free form strings(e.g. workflowID)
+// must be escaped so that the key is unambiguous.
+func joinKey(parts ...string) string {
+	return strings.Join(parts, keySeparator)
+}
+
+func escapeKey(s string) string {
+	return keyEscaper.Replace(s)
+}
+
+// sortableInt64 encodes v so that the lexicographical order of the encoded values is the numeric order
+func sortableInt64(v int64) string {
+	return fmt.Sprintf("%020d", uint64(v)^(1<<63))
+}
+
+func parseSortableInt64(s string) (int64, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(v ^ (1 << 63)), nil
+}
+
+// sortableTime encodes t so that the lexicographical order of the encoded values is the chronological order
+func sortableTime(t time.Time) string {
+	return sortableInt64(timeToUnixNano(t))
+}
+
+// timeToUnixNano is the same as UnixNano, except the times which can't be represented are clamped
+func timeToUnixNano(t time.Time) int64 {
+	if t.Before(time.Unix(0, math.MinInt64)) {
+		return math.MinInt64
+	}
+	if t.After(time.Unix(0, math.MaxInt64)) {
+		return math.MaxInt64
+	}
+	return t.UnixNano()
+}
+
+func ttlValue(ttlSeconds int64) *dynamodb.AttributeValue {
+	return numberValue(time.Now().Unix() + ttlSeconds)
+}
+
+func stringValue(v string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{S: aws.String(v)}
+}
+
+func numberValue(v int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(v, 10))}
+}
+
+func jsonValue(v interface{}) (*dynamodb.AttributeValue, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &dynamodb.AttributeValue{B: data}, nil
+}
+
+// normalizeBlob returns nil for empty blobs, the same as persistence.NewDataBlob
+func normalizeBlob(blob *persistence.DataBlob) *persistence.DataBlob {
+	if blob == nil || len(blob.Data) == 0 {
+		return nil
+	}
+	return blob
+}
+
+func getString(it item, name string) string {
+	if v, ok := it[name]; ok && v != nil {
+		return aws.StringValue(v.S)
+	}
+	return ""
+}
+
+func getNumber(it item, name string) (int64, error) {
+	v, ok := it[name]
+	if !ok || v == nil || v.N == nil {
+		return 0, fmt.Errorf("attribute %v is missing in item", name)
+	}
+	return strconv.ParseInt(aws.StringValue(v.N), 10, 64)
+}
+
+func getJSON(it item, name string, v interface{}) error {
+	attr, ok := it[name]
+	if !ok || attr == nil || attr.B == nil {
+		return fmt.Errorf("attribute %v is missing in item", name)
+	}
+	return json.Unmarshal(attr.B, v)
+}
+
+// itemSize approximates the size of an item the way DynamoDB computes it, i.e. the lengths of the attribute names and values
+func itemSize(it item) int {
+	size := 0
+	for name, v := range it {
+		size += len(name) + attributeSize(v)
+	}
+	return size
+}
+
+func attributeSize(v *dynamodb.AttributeValue) int {
+	if v == nil {
+		return 0
+	}
+	size := len(aws.StringValue(v.S)) + len(aws.StringValue(v.N)) + len(v.B) + 1
+	for _, e := range v.L {
+		size += attributeSize(e) + 1
+	}
+	size += itemSize(v.M)
+	for _, s := range v.SS {
+		size += len(aws.StringValue(s))
+	}
+	for _, n := range v.NS {
+		size += len(aws.StringValue(n))
+	}
+	for _, b := range v.BS {
+		size += len(b)
+	}
+	return size
+}
+
+func primaryKey(pk, sk string) item {
+	return item{
+		attrPK: stringValue(pk),
+		attrSK: stringValue(sk),
+	}
+}
+
+func encodePageToken(lastEvaluatedKey item) ([]byte, error) {
+	if len(lastEvaluatedKey) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(lastEvaluatedKey)
+}
+
+func decodePageToken(pageToken []byte) (item, error) {
+	if len(pageToken) == 0 {
+		return nil, nil
+	}
+	var key item
+	if err := json.Unmarshal(pageToken, &key); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	return key, nil
+}
+
+// keyCondition returns a key condition of the partition key with an optional condition on the sort key
+func keyCondition(pk string, sk *expression.KeyConditionBuilder) expression.KeyConditionBuilder {
+	cond := expression.Key(attrPK).Equal(expression.Value(pk))
+	if sk != nil {
+		cond = cond.And(*sk)
+	}
+	return cond
+}
+
+func skBetween(lower, upper string) *expression.KeyConditionBuilder {
+	cond := expression.Key(attrSK).Between(expression.Value(lower), expression.Value(upper))
+	return &cond
+}
+
+func skBeginsWith(prefix string) *expression.KeyConditionBuilder {
+	cond := expression.Key(attrSK).BeginsWith(prefix)
+	return &cond
+}
+
+// skInt64Range is a condition on the sort keys prefix+sortableInt64(v), where inclusiveMin <= v <= inclusiveMax.
+// DynamoDB rejects a BETWEEN with an upper bound smaller than the lower bound, so false is returned for an empty range.
+func skInt64Range(prefix string, inclusiveMin, inclusiveMax int64) (*expression.KeyConditionBuilder, bool) {
+	if inclusiveMin > inclusiveMax {
+		return nil, false
+	}
+	return skBetween(prefix+sortableInt64(inclusiveMin), prefix+sortableInt64(inclusiveMax)), true
+}
+
+// exclusiveMin converts an exclusive lower bound to an inclusive one
+func exclusiveMin(v int64) int64 {
+	if v == math.MaxInt64 {
+		return v
+	}
+	return v + 1
+}
+
+// exclusiveMax converts an exclusive upper bound to an inclusive one
+func exclusiveMax(v int64) int64 {
+	if v == math.MinInt64 {
+		return v
+	}
+	return v - 1
+}
+
+// newQuery builds a strongly consistent query on the table
+func (db *ddb) newQuery(table string, keyCond expression.KeyConditionBuilder, filter *expression.ConditionBuilder) (*dynamodb.QueryInput, error) {
+	builder := expression.NewBuilder().WithKeyCondition(keyCond)
+	if filter != nil {
+		builder = builder.WithFilter(*filter)
+	}
+	expr, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(table)),
+		KeyConditionExpression:    expr.KeyCondition(),
+		FilterExpression:          expr.Filter(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ConsistentRead:            aws.Bool(true),
+	}, nil
+}
+
+// queryPage reads up to pageSize items of the query, starting from pageToken.
+// The query is repeated until the page is full, so that a page is not cut short by a filter expression or the 1MB response limit.
+// pageSize <= 0 reads all the items.
+func (db *ddb) queryPage(ctx context.Context, input *dynamodb.QueryInput, pageSize int, pageToken []byte) ([]item, []byte, error) {
+	startKey, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+
+	var items []item
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		out, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, out.Items...)
+		if len(out.LastEvaluatedKey) == 0 {
+			return items, nil, nil
+		}
+		if pageSize > 0 && len(items) >= pageSize {
+			token, err := encodePageToken(out.LastEvaluatedKey)
+			return items, token, err
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
+
+// scanPage is the same as queryPage for a scan of the whole table
+func (db *ddb) scanPage(ctx context.Context, input *dynamodb.ScanInput, pageSize int, pageToken []byte) ([]item, []byte, error) {
+	startKey, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+
+	var items []item
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		out, err := db.client.ScanWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, out.Items...)
+		if len(out.LastEvaluatedKey) == 0 {
+			return items, nil, nil
+		}
+		if pageSize > 0 && len(items) >= pageSize {
+			token, err := encodePageToken(out.LastEvaluatedKey)
+			return items, token, err
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
+
+// queryCount returns the number of items matching the query
+func (db *ddb) queryCount(ctx context.Context, input *dynamodb.QueryInput) (int64, error) {
+	input.Select = aws.String(dynamodb.SelectCount)
+	var count int64
+	for {
+		out, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return 0, err
+		}
+		count += aws.Int64Value(out.Count)
+		if len(out.LastEvaluatedKey) == 0 {
+			return count, nil
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
+
+// queryDelete deletes up to limit items matching the query and returns the number of deleted items.
+// DynamoDB doesn't support range deletes so the keys are read first. limit <= 0 deletes all the matching items.
+func (db *ddb) queryDelete(ctx context.Context, input *dynamodb.QueryInput, limit int) (int, error) {
+	input.ProjectionExpression = aws.String(attrPK + ", " + attrSK)
+	deleted := 0
+	var pageToken []byte
+	for {
+		pageSize := maxBatchWriteItems * 4
+		if limit > 0 && limit-deleted < pageSize {
+			pageSize = limit - deleted
+		}
+		keys, nextPageToken, err := db.queryPage(ctx, input, pageSize, pageToken)
+		if err != nil {
+			return deleted, err
+		}
+		if err := db.batchDelete(ctx, aws.StringValue(input.TableName), keys); err != nil {
+			return deleted, err
+		}
+		deleted += len(keys)
+		if len(nextPageToken) == 0 || (limit > 0 && deleted >= limit) {
+			return deleted, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// batchDelete deletes the items of the given keys, the table name is the full name
+func (db *ddb) batchDelete(ctx context.Context, fullTableName string, keys []item) error {
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > maxBatchWriteItems {
+			n = maxBatchWriteItems
+		}
+		var requests []*dynamodb.WriteRequest
+		for _, key := range keys[:n] {
+			requests = append(requests, &dynamodb.WriteRequest{
+				DeleteRequest: &dynamodb.DeleteRequest{Key: primaryKey(getString(key, attrPK), getString(key, attrSK))},
+			})
+		}
+		if err := db.batchWrite(ctx, fullTableName, requests); err != nil {
+			return err
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
+// batchWrite executes at most maxBatchWriteItems write requests, retrying the unprocessed ones
+func (db *ddb) batchWrite(ctx context.Context, fullTableName string, requests []*dynamodb.WriteRequest) error {
+	pending := map[string][]*dynamodb.WriteRequest{fullTableName: requests}
+	for attempt := 0; len(pending) > 0; attempt++ {
+		out, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{RequestItems: pending})
+		if err != nil {
+			return err
+		}
+		pending = out.UnprocessedItems
+		if len(pending) > 0 {
+			// unprocessed items are returned when the table is throttled, back off before retrying
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(1<<uint(attempt%8)) * 10 * time.Millisecond):
+			}
+		}
+	}
+	return nil
+}
+
+// getItem reads an item with strong consistency, returns errNotFound if the item doesn't exist
+func (db *ddb) getItem(ctx context.Context, table string, key item) (item, error) {
+	out, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(db.tableName(table)),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Item) == 0 {
+		return nil, errNotFound
+	}
+	return out.Item, nil
+}
+
+// putItem writes an item, optionally with a condition.
+// When the condition fails, the existing item(nil if the item doesn't exist) is returned with errConditionFailed.
+func (db *ddb) putItem(ctx context.Context, table string, it item, cond *expression.ConditionBuilder) (item, error) {
+	input := &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(table)),
+		Item:      it,
+	}
+	if cond != nil {
+		expr, err := expression.NewBuilder().WithCondition(*cond).Build()
+		if err != nil {
+			return nil, err
+		}
+		input.ConditionExpression = expr.Condition()
+		input.ExpressionAttributeNames = expr.Names()
+		input.ExpressionAttributeValues = expr.Values()
+		input.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+	}
+	_, err := db.client.PutItemWithContext(ctx, input)
+	return conditionFailedItem(err)
+}
+
+// updateItem updates an item, optionally with a condition.
+// When the condition fails, the existing item(nil if the item doesn't exist) is returned with errConditionFailed.
+func (db *ddb) updateItem(ctx context.Context, table string, key item, update expression.UpdateBuilder, cond *expression.ConditionBuilder) (item, error) {
+	builder := expression.NewBuilder().WithUpdate(update)
+	if cond != nil {
+		builder = builder.WithCondition(*cond)
+	}
+	expr, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	input := &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(db.tableName(table)),
+		Key:                       key,
+		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+	if cond != nil {
+		input.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+	}
+	_, err = db.client.UpdateItemWithContext(ctx, input)
+	return conditionFailedItem(err)
+}
+
+// deleteItem deletes an item, optionally with a condition.
+// When the condition fails, the existing item(nil if the item doesn't exist) is returned with errConditionFailed.
+func (db *ddb) deleteItem(ctx context.Context, table string, key item, cond *expression.ConditionBuilder) (item, error) {
+	input := &dynamodb.DeleteItemInput{
+		TableName: aws.String(db.tableName(table)),
+		Key:       key,
+	}
+	if cond != nil {
+		expr, err := expression.NewBuilder().WithCondition(*cond).Build()
+		if err != nil {
+			return nil, err
+		}
+		input.ConditionExpression = expr.Condition()
+		input.ExpressionAttributeNames = expr.Names()
+		input.ExpressionAttributeValues = expr.Values()
+		input.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+	}
+	_, err := db.client.DeleteItemWithContext(ctx, input)
+	return conditionFailedItem(err)
+}
+
+func conditionFailedItem(err error) (item, error) {
+	var conditionFailed *dynamodb.ConditionalCheckFailedException
+	if errors.As(err, &conditionFailed) {
+		return conditionFailed.Item, errConditionFailed
+	}
+	return nil, err
+}
+
+// transaction accumulates the items of a TransactWriteItems request
+type transaction struct {
+	db    *ddb
+	items []*dynamodb.TransactWriteItem
+}
+
+func (db *ddb) newTransaction() *transaction {
+	return &transaction{db: db}
+}
+
+func (t *transaction) put(table string, it item, cond *expression.ConditionBuilder) error {
+	put := &dynamodb.Put{
+		TableName: aws.String(t.db.tableName(table)),
+		Item:      it,
+	}
+	if cond != nil {
+		expr, err := expression.NewBuilder().WithCondition(*cond).Build()
+		if err != nil {
+			return err
+		}
+		put.ConditionExpression = expr.Condition()
+		put.ExpressionAttributeNames = expr.Names()
+		put.ExpressionAttributeValues = expr.Values()
+		put.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+	}
+	t.items = append(t.items, &dynamodb.TransactWriteItem{Put: put})
+	return nil
+}
+
+func (t *transaction) update(table string, key item, update expression.UpdateBuilder, cond *expression.ConditionBuilder) error {
+	builder := expression.NewBuilder().WithUpdate(update)
+	if cond != nil {
+		builder = builder.WithCondition(*cond)
+	}
+	expr, err := builder.Build()
+	if err != nil {
+		return err
+	}
+	u := &dynamodb.Update{
+		TableName:                 aws.String(t.db.tableName(table)),
+		Key:                       key,
+		UpdateExpression:          expr.Update(),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+	if cond != nil {
+		u.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+	}
+	t.items = append(t.items, &dynamodb.TransactWriteItem{Update: u})
+	return nil
+}
+
+func (t *transaction) delete(table string, key item, cond *expression.ConditionBuilder) error {
+	d := &dynamodb.Delete{
+		TableName: aws.String(t.db.tableName(table)),
+		Key:       key,
+	}
+	if cond != nil {
+		expr, err := expression.NewBuilder().WithCondition(*cond).Build()
+		if err != nil {
+			return err
+		}
+		d.ConditionExpression = expr.Condition()
+		d.ExpressionAttributeNames = expr.Names()
+		d.ExpressionAttributeValues = expr.Values()
+		d.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+	}
+	t.items = append(t.items, &dynamodb.TransactWriteItem{Delete: d})
+	return nil
+}
+
+func (t *transaction) conditionCheck(table string, key item, cond expression.ConditionBuilder) error {
+	expr, err := expression.NewBuilder().WithCondition(cond).Build()
+	if err != nil {
+		return err
+	}
+	t.items = append(t.items, &dynamodb.TransactWriteItem{ConditionCheck: &dynamodb.ConditionCheck{
+		TableName:                           aws.String(t.db.tableName(table)),
+		Key:                                 key,
+		ConditionExpression:                 expr.Condition(),
+		ExpressionAttributeNames:            expr.Names(),
+		ExpressionAttributeValues:           expr.Values(),
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	}})
+	return nil
+}
+
+// size approximates the size of the transaction, which DynamoDB limits to 4MB
+func (t *transaction) size() int {
+	size := 0
+	for _, it := range t.items {
+		switch {
+		case it.Put != nil:
+			size += itemSize(it.Put.Item)
+		case it.Update != nil:
+			size += itemSize(it.Update.Key) + itemSize(it.Update.ExpressionAttributeValues)
+		case it.Delete != nil:
+			size += itemSize(it.Delete.Key)
+		case it.ConditionCheck != nil:
+			size += itemSize(it.ConditionCheck.Key)
+		}
+	}
+	return size
+}
+
+// conditionFailure is a failed condition of a transaction item
+type conditionFailure struct {
+	// index is the position of the item in the transaction
+	index int
+	// previous is the existing item, nil if the item doesn't exist
+	previous item
+}
+
+// execute commits the transaction. If the transaction is canceled because of failed conditions,
+// the failures are returned with errConditionFailed.
+func (t *transaction) execute(ctx context.Context) ([]conditionFailure, error) {
+	if len(t.items) == 0 {
+		return nil, nil
+	}
+	_, err := t.db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: t.items})
+	if err == nil {
+		return nil, nil
+	}
+	var canceled *dynamodb.TransactionCanceledException
+	if !errors.As(err, &canceled) {
+		return nil, err
+	}
+	var failures []conditionFailure
+	for i, reason := range canceled.CancellationReasons {
+		switch aws.StringValue(reason.Code) {
+		case "None", "":
+		case dynamodb.BatchStatementErrorCodeEnumConditionalCheckFailed:
+			failures = append(failures, conditionFailure{index: i, previous: reason.Item})
+		default:
+			// conflicts and throttling are returned as is so they can be retried
+			return nil, err
+		}
+	}
+	if len(failures) == 0 {
+		return nil, err
+	}
+	return failures, errConditionFailed
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortableInt64(t *testing.T) {
+	values := []int64{math.MaxInt64, 1, 0, -1, 100, math.MinInt64, -100}
+	encoded := make([]string, 0, len(values))
+	for _, v := range values {
+		s := sortableInt64(v)
+		decoded, err := parseSortableInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, v, decoded)
+		encoded = append(encoded, s)
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	sort.Strings(encoded)
+	for i, v := range values {
+		assert.Equal(t, sortableInt64(v), encoded[i])
+	}
+	assert.True(t, sortableInt64(^int64(2)) < sortableInt64(^int64(1)), "complement must reverse the order")
+}
+
+func TestEscapeKey(t *testing.T) {
+	assert.Equal(t, "a%23b%25c", escapeKey("a#b%c"))
+	assert.NotEqual(t, joinKey(escapeKey("a#b"), "c"), joinKey("a", escapeKey("b#c")))
+}
+
+func TestPageToken(t *testing.T) {
+	token, err := encodePageToken(nil)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	key := primaryKey("pk", "sk")
+	token, err = encodePageToken(key)
+	require.NoError(t, err)
+	decoded, err := decodePageToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, key, decoded)
+
+	_, err = decodePageToken([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestSKInt64Range(t *testing.T) {
+	_, ok := skInt64Range("task#", 10, 9)
+	assert.False(t, ok)
+	_, ok = skInt64Range("task#", 10, 10)
+	assert.True(t, ok)
+
+	assert.Equal(t, int64(math.MaxInt64), exclusiveMin(math.MaxInt64))
+	assert.Equal(t, int64(11), exclusiveMin(10))
+	assert.Equal(t, int64(math.MinInt64), exclusiveMax(math.MinInt64))
+	assert.Equal(t, int64(9), exclusiveMax(10))
+}
+
+func TestTransactionExecute(t *testing.T) {
+	client := newFakeClient()
+	db := newTestDB(t, client)
+
+	txn := db.newTransaction()
+	failures, err := txn.execute(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, failures)
+	assert.Empty(t, client.transactions, "empty transaction must not be sent")
+
+	require.NoError(t, txn.put(tableExecutions, primaryKey("pk", "sk"), nil))
+	require.NoError(t, txn.conditionCheck(tableExecutions, shardKey(1), expression.Name(attrRangeID).Equal(expression.Value(1))))
+	previous := item{attrRangeID: numberValue(2)}
+	client.transactErr = &dynamodb.TransactionCanceledException{
+		Message_: aws.String("canceled"),
+		CancellationReasons: []*dynamodb.CancellationReason{
+			{Code: aws.String("None")},
+			{Code: aws.String("ConditionalCheckFailed"), Item: previous},
+		},
+	}
+	failures, err = txn.execute(context.Background())
+	assert.Equal(t, errConditionFailed, err)
+	assert.Equal(t, []conditionFailure{{index: 1, previous: previous}}, failures)
+	assert.Equal(t, "test_executions", aws.StringValue(client.transactions[0].TransactItems[0].Put.TableName))
+
+	client.transactErr = &dynamodb.TransactionCanceledException{
+		Message_:            aws.String("canceled"),
+		CancellationReasons: []*dynamodb.CancellationReason{{Code: aws.String("TransactionConflict")}},
+	}
+	failures, err = txn.execute(context.Background())
+	assert.Nil(t, failures)
+	assert.True(t, db.IsThrottlingError(err))
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,16 +22,86 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// A visibility record is a single item keyed by domainID and runID.
+// Open and closed records are listed through global secondary indexes: a record is only part of the
+// open index while it has attrOpenPK, and only part of the closed indexes once it has attrClosedPK.
+const (
+	indexOpenByStartTime   = "open_by_start_time"
+	indexClosedByStartTime = "closed_by_start_time"
+	indexClosedByCloseTime = "closed_by_close_time"
+
+	attrOpenPK       = "open_pk"
+	attrClosedPK     = "closed_pk"
+	attrStartSK      = "start_sk"
+	attrCloseSK      = "close_sk"
+	attrWorkflowID   = "workflow_id"
+	attrWorkflowType = "workflow_type"
+	attrCloseStatus  = "close_status"
+)
+
+func newVisibilityItem(domainID string, row *nosqlplugin.VisibilityRow, ttlSeconds int64) (item, error) {
+	data, err := jsonValue(row)
+	if err != nil {
+		return nil, err
+	}
+	it := primaryKey(domainID, row.RunID)
+	it[attrStartSK] = stringValue(visibilityTimeSK(row.StartTime, row.RunID))
+	it[attrWorkflowID] = stringValue(row.WorkflowID)
+	it[attrWorkflowType] = stringValue(row.TypeName)
+	it[attrData] = data
+	if row.Status == nil {
+		it[attrOpenPK] = stringValue(domainID)
+	} else {
+		it[attrClosedPK] = stringValue(domainID)
+		it[attrCloseSK] = stringValue(visibilityTimeSK(row.CloseTime, row.RunID))
+		it[attrCloseStatus] = numberValue(int64(*row.Status))
+	}
+	if ttlSeconds > 0 {
+		it[attrTTL] = ttlValue(ttlSeconds)
+	}
+	return it, nil
+}
+
+func visibilityTimeSK(t time.Time, runID string) string {
+	return joinKey(sortableTime(t), runID)
+}
+
+func parseVisibilityItem(it item) (*nosqlplugin.VisibilityRow, error) {
+	var row nosqlplugin.VisibilityRow
+	if err := getJSON(it, attrData, &row); err != nil {
+		return nil, err
+	}
+	row.Memo = normalizeBlob(row.Memo)
+	return &row, nil
+}
+
+// InsertVisibility creates a new visibility record, return error is there is any.
 func (db *ddb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	it, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	// the started record can be written after the closed record, in which case it must not reopen the workflow
+	cond := expression.AttributeNotExists(expression.Name(attrClosedPK))
+	_, err = db.putItem(ctx, tableVisibility, it, &cond)
+	if err == errConditionFailed {
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) UpdateVisibility(
@@ -39,26 +109,122 @@ func (db *ddb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	if row.UpdateCloseToOpen {
+		return errors.New("not supported operation")
+	}
+	if row.Status == nil {
+		return errors.New("close status is required to update visibility")
+	}
+	// replacing the item removes it from the open index
+	it, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	_, err = db.putItem(ctx, tableVisibility, it, nil)
+	return err
 }
 
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	var index, pkName, skName string
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		index, pkName, skName = indexOpenByStartTime, attrOpenPK, attrStartSK
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		switch filter.SortType {
+		case nosqlplugin.SortByStartTime:
+			index, pkName, skName = indexClosedByStartTime, attrClosedPK, attrStartSK
+		case nosqlplugin.SortByClosedTime:
+			index, pkName, skName = indexClosedByCloseTime, attrClosedPK, attrCloseSK
+		default:
+			return nil, errors.New("not supported sorting type")
+		}
+	default:
+		return nil, errors.New("no supported filter type")
+	}
+
+	var cond *expression.ConditionBuilder
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		c := expression.Name(attrWorkflowType).Equal(expression.Value(filter.WorkflowType))
+		cond = &c
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		c := expression.Name(attrWorkflowID).Equal(expression.Value(filter.WorkflowID))
+		cond = &c
+	case nosqlplugin.ClosedByClosedStatus:
+		c := expression.Name(attrCloseStatus).Equal(expression.Value(int64(filter.CloseStatus)))
+		cond = &c
+	}
+
+	request := &filter.ListRequest
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions: make([]*nosqlplugin.VisibilityRow, 0),
+	}
+	if request.EarliestTime.After(request.LatestTime) {
+		return response, nil
+	}
+	keyCond := expression.Key(pkName).Equal(expression.Value(request.DomainUUID)).And(
+		expression.Key(skName).Between(
+			expression.Value(sortableTime(request.EarliestTime)),
+			expression.Value(joinKey(sortableTime(request.LatestTime), keyUpperBound)),
+		),
+	)
+	builder := expression.NewBuilder().WithKeyCondition(keyCond)
+	if cond != nil {
+		builder = builder.WithFilter(*cond)
+	}
+	expr, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	// global secondary indexes don't support strongly consistent reads
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableVisibility)),
+		IndexName:                 aws.String(index),
+		KeyConditionExpression:    expr.KeyCondition(),
+		FilterExpression:          expr.Filter(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ScanIndexForward:          aws.Bool(false),
+	}, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range items {
+		row, err := parseVisibilityItem(it)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	response.NextPageToken = nextPageToken
+	return response, nil
 }
 
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	_, err := db.deleteItem(ctx, tableVisibility, primaryKey(domainID, runID), nil)
+	return err
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableVisibility, primaryKey(domainID, runID))
+	if err == errNotFound {
+		// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, closed := it[attrClosedPK]; !closed || getString(it, attrWorkflowID) != workflowID {
+		return nil, nil
+	}
+	return parseVisibilityItem(it)
 }
@@ -1,5 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
-// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -23,8 +22,14 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -40,7 +45,35 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	txn := db.newWorkflowTransaction()
+	if err := insertOrUpsertWorkflowRequestRow(txn, requests); err != nil {
+		return err
+	}
+	if err := createOrUpdateCurrentWorkflow(txn, shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	if err := createWorkflowExecutionWithMergeMaps(txn, shardID, execution); err != nil {
+		return err
+	}
+	if err := insertWorkflowActiveClusterSelectionPolicyRow(txn, activeClusterSelectionPolicyRow); err != nil {
+		return err
+	}
+	if err := txn.assertShardRangeID(shardID, shardCondition.RangeID); err != nil {
+		return err
+	}
+	if err := createTasksByCategory(txn, shardID, domainID, workflowID, tasksByCategory); err != nil {
+		return err
+	}
+
+	failures, err := txn.execute(ctx)
+	if db.IsConditionFailedError(err) {
+		return db.convertCreateWorkflowConditionFailures(ctx, txn, failures, currentWorkflowRequest, execution, shardCondition)
+	}
+	return err
 }
 
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
@@ -54,105 +87,389 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var previousNextEventIDCondition int64
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		previousNextEventIDCondition = *mutatedExecution.PreviousNextEventIDCondition
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		previousNextEventIDCondition = *resetExecution.PreviousNextEventIDCondition
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	txn := db.newWorkflowTransaction()
+	if err := insertOrUpsertWorkflowRequestRow(txn, requests); err != nil {
+		return err
+	}
+	if err := createOrUpdateCurrentWorkflow(txn, shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	if mutatedExecution != nil {
+		if err := db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(ctx, txn, shardID, mutatedExecution); err != nil {
+			return err
+		}
+	}
+	if insertedExecution != nil {
+		if err := createWorkflowExecutionWithMergeMaps(txn, shardID, insertedExecution); err != nil {
+			return err
+		}
+		if err := insertWorkflowActiveClusterSelectionPolicyRow(txn, activeClusterSelectionPolicyRow); err != nil {
+			return err
+		}
+	}
+	if resetExecution != nil {
+		if err := db.resetWorkflowExecutionAndMapsAndEventBuffer(ctx, txn, shardID, resetExecution); err != nil {
+			return err
+		}
+	}
+	if err := txn.assertShardRangeID(shardID, shardCondition.RangeID); err != nil {
+		return err
+	}
+	if err := createTasksByCategory(txn, shardID, domainID, workflowID, tasksByCategory); err != nil {
+		return err
+	}
+
+	failures, err := txn.execute(ctx)
+	if db.IsConditionFailedError(err) {
+		return db.convertUpdateWorkflowConditionFailures(txn, failures, currentWorkflowRequest, previousNextEventIDCondition, shardCondition)
+	}
+	return err
 }
 
 func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, currentWorkflowKey(shardID, domainID, workflowID))
+	if err != nil {
+		return nil, err
+	}
+	return parseCurrentWorkflowItem(shardID, it)
 }
 
 func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, executionKey(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	record, err := parseExecutionItem(it)
+	if err != nil {
+		return nil, err
+	}
+	query, err := db.newQuery(tableExecutions, keyCondition(mutableStatePK(shardID, domainID, workflowID, runID), nil), nil)
+	if err != nil {
+		return nil, err
+	}
+	entries, _, err := db.queryPage(ctx, query, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       record.ExecutionInfo,
+		VersionHistories:    record.VersionHistories,
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+		SignalRequestedIDs:  make(map[string]struct{}),
+		Checksum:            record.Checksum,
+	}
+	if err := parseMutableStateEntries(state, entries); err != nil {
+		return nil, err
+	}
+	return state, nil
 }
 
 func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	cond := expression.Name(attrCurrentRunID).Equal(expression.Value(currentRunIDCondition))
+	_, err := db.deleteItem(ctx, tableExecutions, currentWorkflowKey(shardID, domainID, workflowID), &cond)
+	if db.IsConditionFailedError(err) {
+		// the current workflow has moved on to another run, the same as the IF condition of Cassandra
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	// the execution item is deleted first, so that a partially deleted execution doesn't exist anymore
+	// and the deletion of the entries can be retried
+	if _, err := db.deleteItem(ctx, tableExecutions, executionKey(shardID, domainID, workflowID, runID), nil); err != nil {
+		return err
+	}
+	query, err := db.newQuery(tableExecutions, keyCondition(mutableStatePK(shardID, domainID, workflowID, runID), nil), nil)
+	if err != nil {
+		return err
+	}
+	_, err = db.queryDelete(ctx, query, 0)
+	return err
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	query, err := db.newQuery(tableExecutions, keyCondition(shardPK(shardID, executionTypeCurrent), nil), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, query, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(items))
+	for _, it := range items {
+		row, err := parseCurrentWorkflowItem(shardID, it)
+		if err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     row.DomainID,
+			WorkflowID:   row.WorkflowID,
+			RunID:        permanentRunID,
+			State:        row.State,
+			CurrentRunID: row.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	query, err := db.newQuery(tableExecutions, keyCondition(shardPK(shardID, executionTypeExecution), nil), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, nextPageToken, err := db.queryPage(ctx, query, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(items))
+	for _, it := range items {
+		record, err := parseExecutionItem(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    record.ExecutionInfo,
+			VersionHistories: record.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	_, err := db.getItem(ctx, tableExecutions, executionKey(shardID, domainID, workflowID, runID))
+	if db.IsNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectTasksOrderByTaskID(ctx, shardPK(shardID, executionTypeTransfer), pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.deleteItem(ctx, tableExecutions, transferTaskKey(shardID, taskID), nil)
+	return err
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, shardPK(shardID, executionTypeTransfer), inclusiveBeginTaskID, exclusiveEndTaskID)
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	keyCond, ok := timerTasksKeyCondition(shardID, inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil, nil, nil
+	}
+	query, err := db.newQuery(tableExecutions, keyCond, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.queryHistoryTasks(ctx, query, pageSize, pageToken)
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	_, err := db.deleteItem(ctx, tableExecutions, timerTaskKey(shardID, visibilityTimestamp, taskID), nil)
+	return err
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	keyCond, ok := timerTasksKeyCondition(shardID, inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil
+	}
+	query, err := db.newQuery(tableExecutions, keyCond, nil)
+	if err != nil {
+		return err
+	}
+	_, err = db.queryDelete(ctx, query, 0)
+	return err
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectTasksOrderByTaskID(ctx, shardPK(shardID, executionTypeReplication), pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.deleteItem(ctx, tableExecutions, replicationTaskKey(shardID, taskID), nil)
+	return err
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, shardPK(shardID, executionTypeReplication), math.MinInt64, exclusiveEndTaskID)
 }
 
-func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, shardCondition nosqlplugin.ShardCondition) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	shardID := shardCondition.ShardID
+	txn := db.newWorkflowTransaction()
+	if err := txn.assertShardRangeID(shardID, shardCondition.RangeID); err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		it, err := newHistoryTaskItem(replicationTaskKey(shardID, task.Replication.TaskID), &historyTaskRecord{Replication: task.Replication, Task: task.Task})
+		if err != nil {
+			return err
+		}
+		if err := txn.putTask(it); err != nil {
+			return err
+		}
+	}
+
+	failures, err := txn.execute(ctx)
+	if db.IsConditionFailedError(err) {
+		// the shard condition is the only conditional item
+		rangeID := int64(-1)
+		if len(failures) > 0 {
+			rangeID = actualRangeID(failures[0].previous)
+		}
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: rangeID,
+		}
+	}
+	return err
 }
 
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	// cross cluster tasks are deprecated and never written by this plugin
+	return nil
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	it, err := newHistoryTaskItem(
+		primaryKey(replicationDLQPK(shardID, sourceCluster), sortableInt64(task.Replication.TaskID)),
+		&historyTaskRecord{Replication: task.Replication, Task: task.Task},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = db.putItem(ctx, tableExecutions, it, nil)
+	return err
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectTasksOrderByTaskID(ctx, replicationDLQPK(shardID, sourceCluster), pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	query, err := db.newQuery(tableExecutions, keyCondition(replicationDLQPK(shardID, sourceCluster), nil), nil)
+	if err != nil {
+		return 0, err
+	}
+	return db.queryCount(ctx, query)
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	_, err := db.deleteItem(ctx, tableExecutions, primaryKey(replicationDLQPK(shardID, sourceCluster), sortableInt64(taskID)), nil)
+	return err
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, replicationDLQPK(shardID, sourceCluster), inclusiveBeginTaskID, exclusiveEndTaskID)
 }
 
 func (db *ddb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, activeClusterSelectionPolicyKey(shardID, domainID, wfID, rID))
+	if db.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	if v, ok := it[attrData]; ok {
+		data = v.B
+	}
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy:     persistence.NewDataBlob(data, constants.EncodingType(getString(it, attrDataEncoding))),
+	}, nil
 }
 
 func (db *ddb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+	_, err := db.deleteItem(ctx, tableExecutions, activeClusterSelectionPolicyKey(shardID, domainID, wfID, rID), nil)
+	return err
+}
+
+func (db *ddb) selectTasksOrderByTaskID(ctx context.Context, pk string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	skCond, ok := skInt64Range("", inclusiveMinTaskID, exclusiveMax(exclusiveMaxTaskID))
+	if !ok {
+		return nil, nil, nil
+	}
+	query, err := db.newQuery(tableExecutions, keyCondition(pk, skCond), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.queryHistoryTasks(ctx, query, pageSize, pageToken)
+}
+
+func (db *ddb) rangeDeleteTasks(ctx context.Context, pk string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
+	skCond, ok := skInt64Range("", inclusiveBeginTaskID, exclusiveMax(exclusiveEndTaskID))
+	if !ok {
+		return nil
+	}
+	query, err := db.newQuery(tableExecutions, keyCondition(pk, skCond), nil)
+	if err != nil {
+		return err
+	}
+	_, err = db.queryDelete(ctx, query, 0)
+	return err
+}
+
+func (db *ddb) queryHistoryTasks(ctx context.Context, query *dynamodb.QueryInput, pageSize int, pageToken []byte) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	items, nextPageToken, err := db.queryPage(ctx, query, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(items))
+	for _, it := range items {
+		task, err := parseHistoryTaskItem(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
+}
+
+// timerTasksKeyCondition is the key condition of the timers with inclusiveMinTime <= visibility timestamp < exclusiveMaxTime
+func timerTasksKeyCondition(shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) (expression.KeyConditionBuilder, bool) {
+	minTimestamp := persistence.UnixNanoToDBTimestamp(inclusiveMinTime.UnixNano())
+	maxTimestamp := persistence.UnixNanoToDBTimestamp(exclusiveMaxTime.UnixNano())
+	if minTimestamp >= maxTimestamp {
+		return expression.KeyConditionBuilder{}, false
+	}
+	skCond := skBetween(sortableInt64(minTimestamp), joinKey(sortableInt64(maxTimestamp-1), keyUpperBound))
+	return keyCondition(shardPK(shardID, executionTypeTimer), skCond), true
 }
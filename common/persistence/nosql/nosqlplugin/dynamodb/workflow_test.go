@@ -0,0 +1,334 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	testShardID    = 1
+	testDomainID   = "domain-id"
+	testWorkflowID = "workflow#id"
+	testRunID      = "run-id"
+)
+
+func newTestExecutionRequest() *nosqlplugin.WorkflowExecutionRequest {
+	return &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:        testDomainID,
+			WorkflowID:      testWorkflowID,
+			RunID:           testRunID,
+			CreateRequestID: "request-id",
+			NextEventID:     3,
+		},
+		MapsWriteMode: nosqlplugin.WorkflowExecutionMapsWriteModeCreate,
+	}
+}
+
+func newTestCurrentWorkflowRequest(mode nosqlplugin.CurrentWorkflowWriteMode) *nosqlplugin.CurrentWorkflowWriteRequest {
+	return &nosqlplugin.CurrentWorkflowWriteRequest{
+		WriteMode: mode,
+		Row: nosqlplugin.CurrentWorkflowRow{
+			ShardID:    testShardID,
+			DomainID:   testDomainID,
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+		},
+	}
+}
+
+// cancelTransaction makes the next transaction fail the condition of the item at the index
+func cancelTransaction(client *fakeClient, size, index int, previous item) {
+	reasons := make([]*dynamodb.CancellationReason, size)
+	for i := range reasons {
+		reasons[i] = &dynamodb.CancellationReason{Code: aws.String("None")}
+	}
+	reasons[index] = &dynamodb.CancellationReason{Code: aws.String("ConditionalCheckFailed"), Item: previous}
+	client.transactErr = &dynamodb.TransactionCanceledException{
+		Message_:            aws.String("canceled"),
+		CancellationReasons: reasons,
+	}
+}
+
+func TestInsertWorkflowExecutionWithTasks(t *testing.T) {
+	tests := map[string]struct {
+		failedIndex int
+		previous    item
+		assertErr   func(t *testing.T, failure *nosqlplugin.WorkflowOperationConditionFailure)
+	}{
+		"shard range ID mismatch": {
+			failedIndex: 2,
+			previous:    item{attrRangeID: numberValue(11)},
+			assertErr: func(t *testing.T, failure *nosqlplugin.WorkflowOperationConditionFailure) {
+				assert.Equal(t, common.Int64Ptr(11), failure.ShardRangeIDNotMatch)
+			},
+		},
+		"current workflow exists": {
+			failedIndex: 0,
+			previous: item{
+				attrCurrentRunID:     stringValue("other-run-id"),
+				attrCreateRequestID:  stringValue("other-request-id"),
+				attrState:            numberValue(persistence.WorkflowStateRunning),
+				attrCloseStatus:      numberValue(persistence.WorkflowCloseStatusNone),
+				attrLastWriteVersion: numberValue(7),
+			},
+			assertErr: func(t *testing.T, failure *nosqlplugin.WorkflowOperationConditionFailure) {
+				require.NotNil(t, failure.WorkflowExecutionAlreadyExists)
+				assert.Equal(t, "other-run-id", failure.WorkflowExecutionAlreadyExists.RunID)
+				assert.Equal(t, "other-request-id", failure.WorkflowExecutionAlreadyExists.CreateRequestID)
+				assert.Equal(t, int64(7), failure.WorkflowExecutionAlreadyExists.LastWriteVersion)
+			},
+		},
+		"execution exists": {
+			failedIndex: 1,
+			previous:    item{attrLastWriteVersion: numberValue(9)},
+			assertErr: func(t *testing.T, failure *nosqlplugin.WorkflowOperationConditionFailure) {
+				require.NotNil(t, failure.WorkflowExecutionAlreadyExists)
+				assert.Equal(t, testRunID, failure.WorkflowExecutionAlreadyExists.RunID)
+				assert.Equal(t, int64(9), failure.WorkflowExecutionAlreadyExists.LastWriteVersion)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newFakeClient()
+			db := newTestDB(t, client)
+			cancelTransaction(client, 3, tc.failedIndex, tc.previous)
+
+			err := db.InsertWorkflowExecutionWithTasks(
+				context.Background(),
+				nil,
+				newTestCurrentWorkflowRequest(nosqlplugin.CurrentWorkflowWriteModeInsert),
+				newTestExecutionRequest(),
+				nil,
+				nil,
+				&nosqlplugin.ShardCondition{ShardID: testShardID, RangeID: 10},
+			)
+			var failure *nosqlplugin.WorkflowOperationConditionFailure
+			require.ErrorAs(t, err, &failure)
+			tc.assertErr(t, failure)
+		})
+	}
+}
+
+func newTestTransferTasks(n int) map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask {
+	var tasks []*nosqlplugin.HistoryMigrationTask
+	for i := int64(0); i < int64(n); i++ {
+		tasks = append(tasks, &nosqlplugin.HistoryMigrationTask{
+			Transfer: &nosqlplugin.TransferTask{TaskID: i},
+			Task:     persistence.NewDataBlob([]byte("task"), "json"),
+		})
+	}
+	return map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask{
+		persistence.HistoryTaskCategoryTransfer: tasks,
+	}
+}
+
+func TestInsertWorkflowExecutionWithTasks_PendingItems(t *testing.T) {
+	client := newFakeClient()
+	db := newTestDB(t, client)
+
+	err := db.InsertWorkflowExecutionWithTasks(
+		context.Background(),
+		nil,
+		newTestCurrentWorkflowRequest(nosqlplugin.CurrentWorkflowWriteModeInsert),
+		newTestExecutionRequest(),
+		newTestTransferTasks(150),
+		nil,
+		&nosqlplugin.ShardCondition{ShardID: testShardID, RangeID: 10},
+	)
+	require.NoError(t, err)
+	assert.Empty(t, client.batchWrites, "tasks must not be written outside of a fenced transaction")
+
+	// current workflow, execution, shard condition and the tasks in 2 pending items
+	require.Len(t, client.transactions, 3)
+	main := client.transactions[0].TransactItems
+	require.Len(t, main, 5)
+	assert.NotNil(t, main[2].ConditionCheck)
+	written := 0
+	for i, txn := range client.transactions[1:] {
+		items := txn.TransactItems
+		pendingKey := main[3+i].Put.Item
+		// fenced by the shard range ID, and deleting the pending item
+		require.NotNil(t, items[0].ConditionCheck)
+		assert.Equal(t, shardKey(testShardID), items[0].ConditionCheck.Key)
+		assert.Equal(t, primaryKey(getString(pendingKey, attrPK), getString(pendingKey, attrSK)), items[len(items)-1].Delete.Key)
+		assert.LessOrEqual(t, len(items), maxTransactionItems)
+		written += len(items) - 2
+	}
+	assert.Equal(t, 150, written)
+}
+
+func TestInsertWorkflowExecutionWithTasks_PendingItemShardMoved(t *testing.T) {
+	client := newFakeClient()
+	db := newTestDB(t, client)
+	client.transactErrs = []error{nil}
+	cancelTransaction(client, 100, 0, item{attrRangeID: numberValue(11)})
+
+	err := db.InsertWorkflowExecutionWithTasks(
+		context.Background(),
+		nil,
+		newTestCurrentWorkflowRequest(nosqlplugin.CurrentWorkflowWriteModeInsert),
+		newTestExecutionRequest(),
+		newTestTransferTasks(150),
+		nil,
+		&nosqlplugin.ShardCondition{ShardID: testShardID, RangeID: 10},
+	)
+	var failure *nosqlplugin.WorkflowOperationConditionFailure
+	require.ErrorAs(t, err, &failure)
+	assert.Equal(t, common.Int64Ptr(11), failure.ShardRangeIDNotMatch)
+	assert.Len(t, client.transactions, 2)
+}
+
+func TestUpdateWorkflowExecutionWithTasks(t *testing.T) {
+	client := newFakeClient()
+	db := newTestDB(t, client)
+
+	execution := newTestExecutionRequest()
+	execution.MapsWriteMode = nosqlplugin.WorkflowExecutionMapsWriteModeUpdate
+	execution.PreviousNextEventIDCondition = common.Int64Ptr(3)
+	current := newTestCurrentWorkflowRequest(nosqlplugin.CurrentWorkflowWriteModeUpdate)
+	current.Condition = &nosqlplugin.CurrentWorkflowWriteCondition{CurrentRunID: common.StringPtr(testRunID)}
+	shardCondition := &nosqlplugin.ShardCondition{ShardID: testShardID, RangeID: 10}
+
+	err := db.UpdateWorkflowExecutionWithTasks(context.Background(), nil, current, nil, nil, nil, nil, nil, shardCondition)
+	assert.EqualError(t, err, "at least one of mutatedExecution and resetExecution should be provided")
+
+	cancelTransaction(client, 3, 1, item{attrNextEventID: numberValue(5)})
+	err = db.UpdateWorkflowExecutionWithTasks(context.Background(), nil, current, execution, nil, nil, nil, nil, shardCondition)
+	var failure *nosqlplugin.WorkflowOperationConditionFailure
+	require.ErrorAs(t, err, &failure)
+	require.NotNil(t, failure.UnknownConditionFailureDetails)
+	assert.Contains(t, *failure.UnknownConditionFailureDetails, "actualNextEventID: 5")
+
+	cancelTransaction(client, 3, 0, item{attrCurrentRunID: stringValue("other-run-id")})
+	err = db.UpdateWorkflowExecutionWithTasks(context.Background(), nil, current, execution, nil, nil, nil, nil, shardCondition)
+	require.ErrorAs(t, err, &failure)
+	require.NotNil(t, failure.CurrentWorkflowConditionFailInfo)
+	assert.Contains(t, *failure.CurrentWorkflowConditionFailInfo, "Actual Value: other-run-id")
+}
+
+func TestMutableStateEntries(t *testing.T) {
+	client := newFakeClient()
+	db := newTestDB(t, client)
+
+	execution := newTestExecutionRequest()
+	execution.ActivityInfos = map[int64]*persistence.InternalActivityInfo{1: {ScheduleID: 1}, 2: {ScheduleID: 2}}
+	execution.TimerInfos = map[string]*persistence.TimerInfo{"timer#1": {TimerID: "timer#1"}}
+	execution.SignalRequestedIDs = []string{"a", "b"}
+	entries := newMutableStateEntries(testShardID, execution)
+	require.NoError(t, upsertMapEntries(entries, execution))
+	for _, o := range entries.list() {
+		client.putItem(tableExecutions, o.put)
+	}
+	record := newExecutionRecord(execution)
+	record.BufferedEventBatches = []int64{4}
+	it, err := newExecutionItem(testShardID, execution, record, 4)
+	require.NoError(t, err)
+	client.putItem(tableExecutions, it)
+
+	// an update which deletes an entry, upserts another one and clears the buffered events
+	update := newTestExecutionRequest()
+	update.MapsWriteMode = nosqlplugin.WorkflowExecutionMapsWriteModeUpdate
+	update.EventBufferWriteMode = nosqlplugin.EventBufferWriteModeClear
+	update.PreviousNextEventIDCondition = common.Int64Ptr(3)
+	update.ActivityInfos = map[int64]*persistence.InternalActivityInfo{3: {ScheduleID: 3, TaskPriority: "high"}}
+	update.ActivityInfoKeysToDelete = []int64{1}
+	update.SignalRequestedIDs = []string{"c"}
+	update.SignalRequestedIDsKeysToDelete = []string{"a"}
+	txn := db.newWorkflowTransaction()
+	require.NoError(t, db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(context.Background(), txn, testShardID, update))
+	require.Len(t, txn.items, 1, "the entries are added to the transaction when it is executed")
+	assert.Equal(t, "5", aws.StringValue(txn.items[0].Put.Item[attrRecordVersion].N))
+	for _, o := range txn.operations {
+		if o.put != nil {
+			client.putItem(tableExecutions, o.put)
+		} else {
+			delete(client.items, fakeItemKey("test_"+tableExecutions, o.delete))
+		}
+	}
+	assert.Len(t, txn.operations, 5)
+
+	state, err := db.SelectWorkflowExecution(context.Background(), testShardID, testDomainID, testWorkflowID, testRunID)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]*persistence.InternalActivityInfo{2: {ScheduleID: 2}, 3: {ScheduleID: 3, TaskPriority: "high"}}, state.ActivityInfos)
+	assert.Equal(t, map[string]*persistence.TimerInfo{"timer#1": {TimerID: "timer#1"}}, state.TimerInfos)
+	assert.Equal(t, map[string]struct{}{"b": {}, "c": {}}, state.SignalRequestedIDs)
+	assert.Empty(t, state.BufferedEvents)
+}
+
+func TestResetWorkflowExecution(t *testing.T) {
+	client := newFakeClient()
+	db := newTestDB(t, client)
+
+	execution := newTestExecutionRequest()
+	execution.ActivityInfos = map[int64]*persistence.InternalActivityInfo{1: {ScheduleID: 1}, 2: {ScheduleID: 2}}
+	entries := newMutableStateEntries(testShardID, execution)
+	require.NoError(t, upsertMapEntries(entries, execution))
+	for _, o := range entries.list() {
+		client.putItem(tableExecutions, o.put)
+	}
+
+	reset := newTestExecutionRequest()
+	reset.MapsWriteMode = nosqlplugin.WorkflowExecutionMapsWriteModeReset
+	reset.EventBufferWriteMode = nosqlplugin.EventBufferWriteModeClear
+	reset.PreviousNextEventIDCondition = common.Int64Ptr(3)
+	reset.ActivityInfos = map[int64]*persistence.InternalActivityInfo{2: {ScheduleID: 2, Attempt: 1}}
+	txn := db.newWorkflowTransaction()
+	require.NoError(t, db.resetWorkflowExecutionAndMapsAndEventBuffer(context.Background(), txn, testShardID, reset))
+
+	// the entry of the request is replaced and the other one is deleted, each written once
+	require.Len(t, txn.operations, 2)
+	assert.NotNil(t, txn.operations[0].put)
+	assert.Equal(t, mutableStateEntryKey(testShardID, testDomainID, testWorkflowID, testRunID, entryTypeActivity, sortableInt64(1)), txn.operations[1].delete)
+}
+
+func TestHistoryTaskItem(t *testing.T) {
+	visibilityTimestamp := time.Unix(0, 1234567891234)
+	key := timerTaskKey(testShardID, visibilityTimestamp, 5)
+	it, err := newHistoryTaskItem(key, &historyTaskRecord{
+		Timer: &nosqlplugin.TimerTask{TaskID: 5, VisibilityTimestamp: visibilityTimestamp},
+	})
+	require.NoError(t, err)
+
+	task, err := parseHistoryTaskItem(it)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), task.TaskID)
+	assert.True(t, visibilityTimestamp.Equal(task.ScheduledTime))
+	assert.Nil(t, task.Task)
+
+	_, ok := timerTasksKeyCondition(testShardID, visibilityTimestamp, visibilityTimestamp)
+	assert.False(t, ok)
+	_, ok = timerTasksKeyCondition(testShardID, visibilityTimestamp, visibilityTimestamp.Add(time.Millisecond))
+	assert.True(t, ok)
+}
@@ -0,0 +1,1047 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/expression"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// All the items of a history shard live in the executions table, partitioned by shard and item type
+// the same way as the Cassandra executions table is partitioned by shard.
+const (
+	permanentRunID = "30000000-0000-f000-f000-000000000001"
+
+	executionTypeCurrent      = "current"
+	executionTypeExecution    = "execution"
+	executionTypeMutableState = "mutable_state"
+	executionTypeRequest      = "request"
+	executionTypeACSP         = "acsp"
+	executionTypeTransfer     = "transfer"
+	executionTypeTimer        = "timer"
+	executionTypeReplication  = "replication"
+	executionTypeDLQ          = "dlq"
+
+	workflowRequestTTLInSeconds = 10800
+
+	attrCurrentRunID     = "current_run_id"
+	attrCreateRequestID  = "create_request_id"
+	attrState            = "state"
+	attrLastWriteVersion = "last_write_version"
+	attrNextEventID      = "next_event_id"
+	attrRunID            = "run_id"
+	attrDataEncoding     = "data_encoding"
+	// attrRecordVersion is incremented by every write of an execution item, so that the
+	// read-modify-write of a mutable state update can't overwrite a concurrent change
+	attrRecordVersion = "record_version"
+)
+
+// The maps of the mutable state are stored one item per entry, the same way as the map columns of the
+// Cassandra executions table, so that the size of a workflow is not bounded by the item size limit.
+// The entries of an execution live in their own partition, keyed by entry type and map key.
+const (
+	entryTypeActivity        = "activity"
+	entryTypeTimer           = "timer"
+	entryTypeChildExecution  = "child"
+	entryTypeRequestCancel   = "request_cancel"
+	entryTypeSignal          = "signal"
+	entryTypeSignalRequested = "signal_requested"
+	entryTypeBufferedEvents  = "buffered_events"
+)
+
+// executionRecord is the JSON encoded data of an execution item
+type executionRecord struct {
+	ExecutionInfo    *persistence.InternalWorkflowExecutionInfo
+	VersionHistories *persistence.DataBlob
+	Checksum         checksum.Checksum
+	// BufferedEventBatches are the record versions of the writes which appended a batch of buffered events,
+	// the batches are entries keyed by those record versions
+	BufferedEventBatches []int64
+}
+
+// historyTaskRecord is the JSON encoded data of a transfer, timer or replication task item
+type historyTaskRecord struct {
+	Transfer    *nosqlplugin.TransferTask    `json:",omitempty"`
+	Timer       *nosqlplugin.TimerTask       `json:",omitempty"`
+	Replication *nosqlplugin.ReplicationTask `json:",omitempty"`
+	Task        *persistence.DataBlob        `json:",omitempty"`
+}
+
+func shardPK(shardID int, executionType string) string {
+	return joinKey("shard", strconv.Itoa(shardID), executionType)
+}
+
+func currentWorkflowKey(shardID int, domainID, workflowID string) item {
+	return primaryKey(shardPK(shardID, executionTypeCurrent), joinKey(domainID, escapeKey(workflowID)))
+}
+
+func executionKey(shardID int, domainID, workflowID, runID string) item {
+	return primaryKey(shardPK(shardID, executionTypeExecution), joinKey(domainID, escapeKey(workflowID), runID))
+}
+
+func mutableStatePK(shardID int, domainID, workflowID, runID string) string {
+	return joinKey(shardPK(shardID, executionTypeMutableState), domainID, escapeKey(workflowID), runID)
+}
+
+func mutableStateEntryKey(shardID int, domainID, workflowID, runID, entryType, entryID string) item {
+	return primaryKey(mutableStatePK(shardID, domainID, workflowID, runID), joinKey(entryType, entryID))
+}
+
+func workflowRequestKey(row *nosqlplugin.WorkflowRequestRow) item {
+	return primaryKey(
+		shardPK(row.ShardID, executionTypeRequest),
+		joinKey(row.DomainID, escapeKey(row.WorkflowID), strconv.Itoa(int(row.RequestType)), escapeKey(row.RequestID)),
+	)
+}
+
+func activeClusterSelectionPolicyKey(shardID int, domainID, workflowID, runID string) item {
+	return primaryKey(shardPK(shardID, executionTypeACSP), joinKey(domainID, escapeKey(workflowID), runID))
+}
+
+func transferTaskKey(shardID int, taskID int64) item {
+	return primaryKey(shardPK(shardID, executionTypeTransfer), sortableInt64(taskID))
+}
+
+func replicationTaskKey(shardID int, taskID int64) item {
+	return primaryKey(shardPK(shardID, executionTypeReplication), sortableInt64(taskID))
+}
+
+func replicationDLQPK(shardID int, sourceCluster string) string {
+	return joinKey(shardPK(shardID, executionTypeDLQ), escapeKey(sourceCluster))
+}
+
+// timerTaskKey orders the timers by (visibility timestamp, task ID). The same as Cassandra,
+// the visibility timestamp is stored with millisecond precision.
+func timerTaskKey(shardID int, visibilityTimestamp time.Time, taskID int64) item {
+	return primaryKey(shardPK(shardID, executionTypeTimer), joinKey(timerSortKeyPrefix(visibilityTimestamp), sortableInt64(taskID)))
+}
+
+func timerSortKeyPrefix(visibilityTimestamp time.Time) string {
+	return sortableInt64(persistence.UnixNanoToDBTimestamp(visibilityTimestamp.UnixNano()))
+}
+
+func newHistoryTaskItem(key item, record *historyTaskRecord) (item, error) {
+	data, err := jsonValue(record)
+	if err != nil {
+		return nil, err
+	}
+	key[attrData] = data
+	return key, nil
+}
+
+func parseHistoryTaskItem(it item) (*nosqlplugin.HistoryMigrationTask, error) {
+	var record historyTaskRecord
+	if err := getJSON(it, attrData, &record); err != nil {
+		return nil, err
+	}
+	task := &nosqlplugin.HistoryMigrationTask{
+		Transfer:    record.Transfer,
+		Timer:       record.Timer,
+		Replication: record.Replication,
+		Task:        normalizeBlob(record.Task),
+	}
+	switch {
+	case record.Transfer != nil:
+		task.TaskID = record.Transfer.TaskID
+	case record.Timer != nil:
+		task.TaskID = record.Timer.TaskID
+		task.ScheduledTime = record.Timer.VisibilityTimestamp
+	case record.Replication != nil:
+		task.TaskID = record.Replication.TaskID
+	}
+	return task, nil
+}
+
+// workflowTransaction is a transaction of workflow items, which keeps track of what each item is
+// so that failed conditions can be converted to the same errors as Cassandra
+type workflowTransaction struct {
+	*transaction
+	roles []workflowItemRole
+	// operations are the unconditional writes of mutable state entries and tasks, which are added to the
+	// transaction when it is executed, or staged in pending items if they don't fit, see pending_writes.go
+	operations []operation
+	// the shard condition of the transaction, which also fences the follow-up transactions of pending items
+	fenced     bool
+	shardID    int
+	rangeID    int64
+	shardIndex int
+}
+
+type workflowItemRole int
+
+const (
+	roleOther workflowItemRole = iota
+	roleShard
+	roleCurrentWorkflow
+	roleWorkflowRequest
+	roleInsertedExecution
+	roleUpdatedExecution
+)
+
+func (db *ddb) newWorkflowTransaction() *workflowTransaction {
+	return &workflowTransaction{
+		transaction: db.newTransaction(),
+	}
+}
+
+func (t *workflowTransaction) track(role workflowItemRole) {
+	for len(t.roles) < len(t.items) {
+		t.roles = append(t.roles, role)
+	}
+}
+
+func (t *workflowTransaction) role(index int) workflowItemRole {
+	if index < len(t.roles) {
+		return t.roles[index]
+	}
+	return roleOther
+}
+
+// putTask adds an unconditional task item to the transaction
+func (t *workflowTransaction) putTask(it item) error {
+	t.operations = append(t.operations, operation{put: it})
+	return nil
+}
+
+func (t *workflowTransaction) addOperations(operations []operation) {
+	t.operations = append(t.operations, operations...)
+}
+
+func (t *workflowTransaction) assertShardRangeID(shardID int, rangeID int64) error {
+	cond := expression.Name(attrRangeID).Equal(expression.Value(rangeID))
+	t.fenced, t.shardID, t.rangeID, t.shardIndex = true, shardID, rangeID, len(t.items)
+	err := t.conditionCheck(tableExecutions, shardKey(shardID), cond)
+	t.track(roleShard)
+	return err
+}
+
+// execute commits the transaction with its operations. The operations which don't fit are committed
+// in pending items, and applied right after the commit by follow-up transactions fenced by the shard range ID.
+func (t *workflowTransaction) execute(ctx context.Context) ([]conditionFailure, error) {
+	if len(t.items)+len(t.operations) <= maxTransactionItems && t.size()+operationsSize(t.operations) <= maxTransactionSize {
+		for _, o := range t.operations {
+			if err := t.addOperation(o); err != nil {
+				return nil, err
+			}
+		}
+		return t.transaction.execute(ctx)
+	}
+
+	if !t.fenced {
+		return nil, fmt.Errorf("a transaction of %v operations must check the shard range ID", len(t.operations))
+	}
+	pending := newPendingItems(t.shardID, t.operations)
+	for _, it := range pending {
+		if err := t.put(tableExecutions, it, nil); err != nil {
+			return nil, err
+		}
+		t.track(roleOther)
+	}
+	if len(t.items) > maxTransactionItems || t.size() > maxTransactionSize {
+		return nil, fmt.Errorf("workflow write is too large for a transaction: %v items, %v bytes", len(t.items), t.size())
+	}
+
+	failures, err := t.transaction.execute(ctx)
+	if err != nil {
+		return failures, err
+	}
+	for _, it := range pending {
+		failures, err := t.db.applyPendingItem(ctx, t.shardID, t.rangeID, it)
+		if t.db.IsConditionFailedError(err) {
+			// the shard moved after the commit, the new owner applies the remaining pending items
+			return []conditionFailure{{index: t.shardIndex, previous: failures[0].previous}}, errConditionFailed
+		}
+		if err != nil {
+			// the error is not wrapped so that the write is not retried as throttled, its outcome is unknown
+			// and the shard renews its range ID, which applies the remaining pending items
+			return nil, fmt.Errorf("failed to apply pending writes of the committed transaction: %v", err)
+		}
+	}
+	return nil, nil
+}
+
+func (t *workflowTransaction) addOperation(o operation) error {
+	var err error
+	if o.put != nil {
+		err = t.put(tableExecutions, o.put, nil)
+	} else {
+		err = t.delete(tableExecutions, o.delete, nil)
+	}
+	t.track(roleOther)
+	return err
+}
+
+func insertWorkflowActiveClusterSelectionPolicyRow(
+	txn *workflowTransaction,
+	row *nosqlplugin.ActiveClusterSelectionPolicyRow,
+) error {
+	if row == nil || row.Policy == nil {
+		return nil
+	}
+	it := activeClusterSelectionPolicyKey(row.ShardID, row.DomainID, row.WorkflowID, row.RunID)
+	it[attrData] = &dynamodb.AttributeValue{B: row.Policy.Data}
+	it[attrDataEncoding] = stringValue(row.Policy.GetEncodingString())
+	cond := expression.AttributeNotExists(expression.Name(attrPK))
+	err := txn.put(tableExecutions, it, &cond)
+	txn.track(roleOther)
+	return err
+}
+
+func insertOrUpsertWorkflowRequestRow(
+	txn *workflowTransaction,
+	requests *nosqlplugin.WorkflowRequestsWriteRequest,
+) error {
+	if requests == nil {
+		return nil
+	}
+	var cond *expression.ConditionBuilder
+	switch requests.WriteMode {
+	case nosqlplugin.WorkflowRequestWriteModeInsert:
+		c := expression.AttributeNotExists(expression.Name(attrPK))
+		cond = &c
+	case nosqlplugin.WorkflowRequestWriteModeUpsert:
+	default:
+		return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+	}
+	for _, row := range requests.Rows {
+		switch row.RequestType {
+		case persistence.WorkflowRequestTypeStart, persistence.WorkflowRequestTypeSignal,
+			persistence.WorkflowRequestTypeCancel, persistence.WorkflowRequestTypeReset:
+		default:
+			return fmt.Errorf("unknown workflow request type %v", row.RequestType)
+		}
+		it := workflowRequestKey(row)
+		it[attrRunID] = stringValue(row.RunID)
+		it[attrTTL] = ttlValue(workflowRequestTTLInSeconds)
+		if err := txn.put(tableExecutions, it, cond); err != nil {
+			return err
+		}
+		txn.track(roleWorkflowRequest)
+	}
+	return nil
+}
+
+func createOrUpdateCurrentWorkflow(
+	txn *workflowTransaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	var cond expression.ConditionBuilder
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		cond = expression.AttributeNotExists(expression.Name(attrPK))
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		cond = expression.Name(attrCurrentRunID).Equal(expression.Value(*request.Condition.CurrentRunID))
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			cond = cond.And(
+				expression.Name(attrLastWriteVersion).Equal(expression.Value(*request.Condition.LastWriteVersion)),
+				expression.Name(attrState).Equal(expression.Value(int64(*request.Condition.State))),
+			)
+		}
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+
+	it := currentWorkflowKey(shardID, domainID, workflowID)
+	it[attrDomainID] = stringValue(domainID)
+	it[attrWorkflowID] = stringValue(workflowID)
+	it[attrCurrentRunID] = stringValue(request.Row.RunID)
+	it[attrCreateRequestID] = stringValue(request.Row.CreateRequestID)
+	it[attrState] = numberValue(int64(request.Row.State))
+	it[attrCloseStatus] = numberValue(int64(request.Row.CloseStatus))
+	it[attrLastWriteVersion] = numberValue(request.Row.LastWriteVersion)
+	err := txn.put(tableExecutions, it, &cond)
+	txn.track(roleCurrentWorkflow)
+	return err
+}
+
+func newExecutionRecord(execution *nosqlplugin.WorkflowExecutionRequest) *executionRecord {
+	record := &executionRecord{}
+	updateExecutionRecordInfo(record, execution)
+	return record
+}
+
+func updateExecutionRecordInfo(record *executionRecord, execution *nosqlplugin.WorkflowExecutionRequest) {
+	info := execution.InternalWorkflowExecutionInfo
+	info.LastUpdatedTimestamp = execution.CurrentTimeStamp
+	record.ExecutionInfo = &info
+	record.VersionHistories = execution.VersionHistories
+	if execution.Checksums != nil {
+		record.Checksum = *execution.Checksums
+	} else {
+		record.Checksum = checksum.Checksum{}
+	}
+}
+
+// mutableStateEntries are the writes of the entries of an execution. The writes are keyed by sort key,
+// since a transaction can't write the same item twice: the last write of an entry wins.
+type mutableStateEntries struct {
+	shardID    int
+	execution  *nosqlplugin.WorkflowExecutionRequest
+	operations map[string]operation
+	order      []string
+}
+
+func newMutableStateEntries(shardID int, execution *nosqlplugin.WorkflowExecutionRequest) *mutableStateEntries {
+	return &mutableStateEntries{
+		shardID:    shardID,
+		execution:  execution,
+		operations: make(map[string]operation),
+	}
+}
+
+func (e *mutableStateEntries) key(entryType, entryID string) item {
+	return mutableStateEntryKey(e.shardID, e.execution.DomainID, e.execution.WorkflowID, e.execution.RunID, entryType, entryID)
+}
+
+func (e *mutableStateEntries) add(key item, o operation) {
+	sk := getString(key, attrSK)
+	if _, ok := e.operations[sk]; !ok {
+		e.order = append(e.order, sk)
+	}
+	e.operations[sk] = o
+}
+
+func (e *mutableStateEntries) put(entryType, entryID string, value interface{}) error {
+	data, err := jsonValue(value)
+	if err != nil {
+		return err
+	}
+	it := e.key(entryType, entryID)
+	it[attrData] = data
+	e.add(it, operation{put: it})
+	return nil
+}
+
+func (e *mutableStateEntries) delete(entryType, entryID string) {
+	key := e.key(entryType, entryID)
+	e.add(key, operation{delete: key})
+}
+
+func (e *mutableStateEntries) has(sk string) bool {
+	_, ok := e.operations[sk]
+	return ok
+}
+
+func (e *mutableStateEntries) list() []operation {
+	result := make([]operation, 0, len(e.order))
+	for _, sk := range e.order {
+		result = append(result, e.operations[sk])
+	}
+	return result
+}
+
+// upsertMapEntries writes the map entries of the request
+func upsertMapEntries(e *mutableStateEntries, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	for k, v := range execution.ActivityInfos {
+		if err := e.put(entryTypeActivity, sortableInt64(k), v); err != nil {
+			return err
+		}
+	}
+	for k, v := range execution.TimerInfos {
+		if err := e.put(entryTypeTimer, escapeKey(k), v); err != nil {
+			return err
+		}
+	}
+	for k, v := range execution.ChildWorkflowInfos {
+		if err := e.put(entryTypeChildExecution, sortableInt64(k), v); err != nil {
+			return err
+		}
+	}
+	for k, v := range execution.RequestCancelInfos {
+		if err := e.put(entryTypeRequestCancel, sortableInt64(k), v); err != nil {
+			return err
+		}
+	}
+	for k, v := range execution.SignalInfos {
+		if err := e.put(entryTypeSignal, sortableInt64(k), v); err != nil {
+			return err
+		}
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		if err := e.put(entryTypeSignalRequested, escapeKey(id), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteMapEntries deletes the map entries of the request
+func deleteMapEntries(e *mutableStateEntries, execution *nosqlplugin.WorkflowExecutionRequest) {
+	for _, k := range execution.ActivityInfoKeysToDelete {
+		e.delete(entryTypeActivity, sortableInt64(k))
+	}
+	for _, k := range execution.TimerInfoKeysToDelete {
+		e.delete(entryTypeTimer, escapeKey(k))
+	}
+	for _, k := range execution.ChildWorkflowInfoKeysToDelete {
+		e.delete(entryTypeChildExecution, sortableInt64(k))
+	}
+	for _, k := range execution.RequestCancelInfoKeysToDelete {
+		e.delete(entryTypeRequestCancel, sortableInt64(k))
+	}
+	for _, k := range execution.SignalInfoKeysToDelete {
+		e.delete(entryTypeSignal, sortableInt64(k))
+	}
+	for _, id := range execution.SignalRequestedIDsKeysToDelete {
+		e.delete(entryTypeSignalRequested, escapeKey(id))
+	}
+}
+
+func newExecutionItem(shardID int, execution *nosqlplugin.WorkflowExecutionRequest, record *executionRecord, recordVersion int64) (item, error) {
+	data, err := jsonValue(record)
+	if err != nil {
+		return nil, err
+	}
+	it := executionKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	it[attrDomainID] = stringValue(execution.DomainID)
+	it[attrWorkflowID] = stringValue(execution.WorkflowID)
+	it[attrRunID] = stringValue(execution.RunID)
+	it[attrNextEventID] = numberValue(execution.NextEventID)
+	it[attrLastWriteVersion] = numberValue(execution.LastWriteVersion)
+	it[attrState] = numberValue(int64(execution.State))
+	it[attrRecordVersion] = numberValue(recordVersion)
+	it[attrData] = data
+	return it, nil
+}
+
+func createWorkflowExecutionWithMergeMaps(
+	txn *workflowTransaction,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+	it, err := newExecutionItem(shardID, execution, newExecutionRecord(execution), 1)
+	if err != nil {
+		return err
+	}
+	cond := expression.AttributeNotExists(expression.Name(attrPK))
+	err = txn.put(tableExecutions, it, &cond)
+	txn.track(roleInsertedExecution)
+	if err != nil {
+		return err
+	}
+
+	entries := newMutableStateEntries(shardID, execution)
+	if err := upsertMapEntries(entries, execution); err != nil {
+		return err
+	}
+	txn.addOperations(entries.list())
+	return nil
+}
+
+func (db *ddb) resetWorkflowExecutionAndMapsAndEventBuffer(
+	ctx context.Context,
+	txn *workflowTransaction,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+
+	// the maps are replaced, so the entries which are not part of the request are deleted
+	entries := newMutableStateEntries(shardID, execution)
+	if err := upsertMapEntries(entries, execution); err != nil {
+		return err
+	}
+	query, err := db.newQuery(tableExecutions, keyCondition(mutableStatePK(shardID, execution.DomainID, execution.WorkflowID, execution.RunID), nil), nil)
+	if err != nil {
+		return err
+	}
+	query.ProjectionExpression = aws.String(attrPK + ", " + attrSK)
+	existing, _, err := db.queryPage(ctx, query, 0, nil)
+	if err != nil {
+		return err
+	}
+	for _, key := range existing {
+		if sk := getString(key, attrSK); !entries.has(sk) {
+			entries.add(key, operation{delete: primaryKey(getString(key, attrPK), sk)})
+		}
+	}
+
+	// the entries are read outside of the transaction, so it is also conditioned on the record version
+	cond := expression.Name(attrNextEventID).Equal(expression.Value(*execution.PreviousNextEventIDCondition))
+	previous, err := db.getItem(ctx, tableExecutions, executionKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID))
+	switch err {
+	case nil:
+		recordVersion, err := getNumber(previous, attrRecordVersion)
+		if err != nil {
+			return err
+		}
+		cond = cond.And(expression.Name(attrRecordVersion).Equal(expression.Value(recordVersion)))
+	case errNotFound:
+		// the condition will fail in the transaction
+	default:
+		return err
+	}
+
+	it, err := newExecutionItem(shardID, execution, newExecutionRecord(execution), time.Now().UnixNano())
+	if err != nil {
+		return err
+	}
+	err = txn.put(tableExecutions, it, &cond)
+	txn.track(roleUpdatedExecution)
+	if err != nil {
+		return err
+	}
+	txn.addOperations(entries.list())
+	return nil
+}
+
+func (db *ddb) updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(
+	ctx context.Context,
+	txn *workflowTransaction,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	// the execution item is read for the record version and the keys of the buffered events, and written back
+	// conditioned on both the next event ID and the record version
+	record := newExecutionRecord(execution)
+	recordVersion := int64(0)
+	previous, err := db.getItem(ctx, tableExecutions, executionKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID))
+	switch err {
+	case nil:
+		if err := getJSON(previous, attrData, record); err != nil {
+			return err
+		}
+		if recordVersion, err = getNumber(previous, attrRecordVersion); err != nil {
+			return err
+		}
+	case errNotFound:
+		// the condition will fail in the transaction
+	default:
+		return err
+	}
+	updateExecutionRecordInfo(record, execution)
+
+	entries := newMutableStateEntries(shardID, execution)
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		for _, version := range record.BufferedEventBatches {
+			entries.delete(entryTypeBufferedEvents, sortableInt64(version))
+		}
+		record.BufferedEventBatches = nil
+	case nosqlplugin.EventBufferWriteModeAppend:
+		if execution.NewBufferedEventBatch != nil {
+			if err := entries.put(entryTypeBufferedEvents, sortableInt64(recordVersion+1), execution.NewBufferedEventBatch); err != nil {
+				return err
+			}
+			record.BufferedEventBatches = append(record.BufferedEventBatches, recordVersion+1)
+		}
+	}
+	if err := upsertMapEntries(entries, execution); err != nil {
+		return err
+	}
+	deleteMapEntries(entries, execution)
+
+	it, err := newExecutionItem(shardID, execution, record, recordVersion+1)
+	if err != nil {
+		return err
+	}
+	cond := expression.Name(attrNextEventID).Equal(expression.Value(*execution.PreviousNextEventIDCondition)).And(
+		expression.Name(attrRecordVersion).Equal(expression.Value(recordVersion)),
+	)
+	err = txn.put(tableExecutions, it, &cond)
+	txn.track(roleUpdatedExecution)
+	if err != nil {
+		return err
+	}
+	txn.addOperations(entries.list())
+	return nil
+}
+
+func parseExecutionItem(it item) (*executionRecord, error) {
+	var record executionRecord
+	if err := getJSON(it, attrData, &record); err != nil {
+		return nil, err
+	}
+	info := record.ExecutionInfo
+	if info == nil {
+		return nil, fmt.Errorf("corrupted execution item, execution info is missing")
+	}
+	info.CompletionEvent = normalizeBlob(info.CompletionEvent)
+	info.AutoResetPoints = normalizeBlob(info.AutoResetPoints)
+	info.ActiveClusterSelectionPolicy = normalizeBlob(info.ActiveClusterSelectionPolicy)
+	record.VersionHistories = normalizeBlob(record.VersionHistories)
+	return &record, nil
+}
+
+// parseMutableStateEntries adds the entries, sorted by sort key, to the maps and buffered events of the state
+func parseMutableStateEntries(state *nosqlplugin.WorkflowExecution, entries []item) error {
+	for _, it := range entries {
+		parts := strings.SplitN(getString(it, attrSK), keySeparator, 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("corrupted mutable state entry, sort key: %v", getString(it, attrSK))
+		}
+		entryType, entryID := parts[0], parts[1]
+		var err error
+		switch entryType {
+		case entryTypeActivity:
+			var v persistence.InternalActivityInfo
+			if err = getJSON(it, attrData, &v); err == nil {
+				err = putIntKeyEntry(state.ActivityInfos, entryID, &v)
+			}
+		case entryTypeTimer:
+			var v persistence.TimerInfo
+			if err = getJSON(it, attrData, &v); err == nil {
+				state.TimerInfos[v.TimerID] = &v
+			}
+		case entryTypeChildExecution:
+			var v persistence.InternalChildExecutionInfo
+			if err = getJSON(it, attrData, &v); err == nil {
+				err = putIntKeyEntry(state.ChildExecutionInfos, entryID, &v)
+			}
+		case entryTypeRequestCancel:
+			var v persistence.RequestCancelInfo
+			if err = getJSON(it, attrData, &v); err == nil {
+				err = putIntKeyEntry(state.RequestCancelInfos, entryID, &v)
+			}
+		case entryTypeSignal:
+			var v persistence.SignalInfo
+			if err = getJSON(it, attrData, &v); err == nil {
+				err = putIntKeyEntry(state.SignalInfos, entryID, &v)
+			}
+		case entryTypeSignalRequested:
+			var id string
+			if err = getJSON(it, attrData, &id); err == nil {
+				state.SignalRequestedIDs[id] = struct{}{}
+			}
+		case entryTypeBufferedEvents:
+			var blob persistence.DataBlob
+			if err = getJSON(it, attrData, &blob); err == nil {
+				state.BufferedEvents = append(state.BufferedEvents, normalizeBlob(&blob))
+			}
+		default:
+			err = fmt.Errorf("unknown mutable state entry type %v", entryType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func putIntKeyEntry[V any](m map[int64]V, entryID string, v V) error {
+	k, err := parseSortableInt64(entryID)
+	if err != nil {
+		return err
+	}
+	m[k] = v
+	return nil
+}
+
+func createTasksByCategory(
+	txn *workflowTransaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+) error {
+	for c, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			var it item
+			var err error
+			switch c.ID() {
+			case persistence.HistoryTaskCategoryIDTransfer:
+				t := *task.Transfer
+				t.DomainID, t.WorkflowID = domainID, workflowID
+				it, err = newHistoryTaskItem(transferTaskKey(shardID, t.TaskID), &historyTaskRecord{Transfer: &t, Task: task.Task})
+			case persistence.HistoryTaskCategoryIDTimer:
+				t := *task.Timer
+				t.DomainID, t.WorkflowID = domainID, workflowID
+				t.VisibilityTimestamp = time.Unix(0, persistence.DBTimestampToUnixNano(persistence.UnixNanoToDBTimestamp(t.VisibilityTimestamp.UnixNano())))
+				it, err = newHistoryTaskItem(timerTaskKey(shardID, t.VisibilityTimestamp, t.TaskID), &historyTaskRecord{Timer: &t, Task: task.Task})
+			case persistence.HistoryTaskCategoryIDReplication:
+				t := *task.Replication
+				t.DomainID, t.WorkflowID = domainID, workflowID
+				it, err = newHistoryTaskItem(replicationTaskKey(shardID, t.TaskID), &historyTaskRecord{Replication: &t, Task: task.Task})
+			default:
+				// TODO: implementing writing tasks for other categories
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := txn.putTask(it); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func newUnknownConditionFailureReason(
+	rangeID int64,
+	failures []conditionFailure,
+) *nosqlplugin.WorkflowOperationConditionFailure {
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, columns: (%v)",
+		rangeID, describeConditionFailures(failures))
+
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func describeConditionFailures(failures []conditionFailure) string {
+	var columns []string
+	for _, failure := range failures {
+		for k, v := range failure.previous {
+			if k == attrData {
+				continue
+			}
+			columns = append(columns, fmt.Sprintf("%v: %s=%v", failure.index, k, strings.TrimSpace(v.String())))
+		}
+	}
+	return strings.Join(columns, ",")
+}
+
+// convertCreateWorkflowConditionFailures converts the failed conditions of InsertWorkflowExecutionWithTasks
+// to an error, checking them with the same priority as Cassandra
+func (db *ddb) convertCreateWorkflowConditionFailures(
+	ctx context.Context,
+	txn *workflowTransaction,
+	failures []conditionFailure,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	byRole := make(map[workflowItemRole]conditionFailure)
+	for _, failure := range failures {
+		if _, ok := byRole[txn.role(failure.index)]; !ok {
+			byRole[txn.role(failure.index)] = failure
+		}
+	}
+
+	if failure, ok := byRole[roleShard]; ok {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(actualRangeID(failure.previous)),
+		}
+	}
+	if failure, ok := byRole[roleWorkflowRequest]; ok {
+		return db.convertDuplicateRequest(txn, failure)
+	}
+	if failure, ok := byRole[roleCurrentWorkflow]; ok && failure.previous != nil {
+		previous := failure.previous
+		actualLastWriteVersion, _ := getNumber(previous, attrLastWriteVersion)
+		switch currentWorkflowRequest.WriteMode {
+		case nosqlplugin.CurrentWorkflowWriteModeInsert:
+			state, _ := getNumber(previous, attrState)
+			closeStatus, _ := getNumber(previous, attrCloseStatus)
+			runID := getString(previous, attrCurrentRunID)
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", currentWorkflowRequest.Row.WorkflowID, runID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  getString(previous, attrCreateRequestID),
+					RunID:            runID,
+					State:            int(state),
+					CloseStatus:      int(closeStatus),
+					LastWriteVersion: actualLastWriteVersion,
+				},
+			}
+		case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+			condition := currentWorkflowRequest.Condition
+			if actualCurrRunID := getString(previous, attrCurrentRunID); actualCurrRunID != condition.GetCurrentRunID() {
+				msg := fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+					currentWorkflowRequest.Row.WorkflowID, condition.GetCurrentRunID(), actualCurrRunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			if condition.LastWriteVersion != nil && *condition.LastWriteVersion != actualLastWriteVersion {
+				msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.LastWriteVersion, actualLastWriteVersion)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			if actualState, _ := getNumber(previous, attrState); condition.State != nil && int64(*condition.State) != actualState {
+				msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.State, actualState)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+		}
+	}
+	if failure, ok := byRole[roleInsertedExecution]; ok && failure.previous != nil {
+		actualLastWriteVersion, _ := getNumber(failure.previous, attrLastWriteVersion)
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", execution.WorkflowID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: actualLastWriteVersion,
+			},
+		}
+	}
+
+	// At this point we only know that the write was not applied.
+	return newUnknownConditionFailureReason(shardCondition.RangeID, failures)
+}
+
+// convertUpdateWorkflowConditionFailures converts the failed conditions of UpdateWorkflowExecutionWithTasks
+// to an error, checking them with the same priority as Cassandra
+func (db *ddb) convertUpdateWorkflowConditionFailures(
+	txn *workflowTransaction,
+	failures []conditionFailure,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	previousNextEventIDCondition int64,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	byRole := make(map[workflowItemRole]conditionFailure)
+	for _, failure := range failures {
+		if _, ok := byRole[txn.role(failure.index)]; !ok {
+			byRole[txn.role(failure.index)] = failure
+		}
+	}
+
+	requestRunID := currentWorkflowRequest.Row.RunID
+	requestConditionalRunID := currentWorkflowRequest.Condition.GetCurrentRunID()
+
+	if failure, ok := byRole[roleShard]; ok {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(actualRangeID(failure.previous)),
+		}
+	}
+	if failure, ok := byRole[roleWorkflowRequest]; ok {
+		return db.convertDuplicateRequest(txn, failure)
+	}
+	if failure, ok := byRole[roleCurrentWorkflow]; ok && failure.previous != nil {
+		if actualCurrRunID := getString(failure.previous, attrCurrentRunID); requestConditionalRunID != "" && actualCurrRunID != requestConditionalRunID {
+			msg := fmt.Sprintf("Failed to update mutable state. requestConditionalRunID: %v, Actual Value: %v",
+				requestConditionalRunID, actualCurrRunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+	}
+	if failure, ok := byRole[roleUpdatedExecution]; ok && failure.previous != nil {
+		if actualNextEventID, err := getNumber(failure.previous, attrNextEventID); err == nil && actualNextEventID != previousNextEventIDCondition {
+			msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+				previousNextEventIDCondition, actualNextEventID, requestRunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				UnknownConditionFailureDetails: &msg,
+			}
+		}
+	}
+
+	// At this point we only know that the write was not applied.
+	msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RangeID: %v, previousNextEventIDCondition: %v, requestConditionalRunID: %v, columns: (%v)",
+		shardCondition.ShardID, shardCondition.RangeID, previousNextEventIDCondition, requestConditionalRunID, describeConditionFailures(failures))
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func (db *ddb) convertDuplicateRequest(txn *workflowTransaction, failure conditionFailure) error {
+	var requestType persistence.WorkflowRequestType
+	if put := txn.items[failure.index].Put; put != nil {
+		// the request type is part of the sort key: <domainID>#<workflowID>#<requestType>#<requestID>
+		parts := strings.Split(getString(put.Item, attrSK), keySeparator)
+		if len(parts) == 4 {
+			v, err := strconv.Atoi(parts[2])
+			if err != nil {
+				return err
+			}
+			requestType = persistence.WorkflowRequestType(v)
+		}
+	}
+	runID := getString(failure.previous, attrRunID)
+	if runID == "" {
+		return fmt.Errorf("corrupted data detected. RequestType: %v", requestType)
+	}
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		DuplicateRequest: &nosqlplugin.DuplicateRequest{
+			RequestType: requestType,
+			RunID:       runID,
+		},
+	}
+}
+
+// actualRangeID returns the range ID of the shard item of a failed condition, or -1 if unknown
+func actualRangeID(previous item) int64 {
+	rangeID, err := getNumber(previous, attrRangeID)
+	if err != nil {
+		return -1
+	}
+	return rangeID
+}
+
+func parseCurrentWorkflowItem(shardID int, it item) (*nosqlplugin.CurrentWorkflowRow, error) {
+	state, err := getNumber(it, attrState)
+	if err != nil {
+		return nil, err
+	}
+	closeStatus, err := getNumber(it, attrCloseStatus)
+	if err != nil {
+		return nil, err
+	}
+	lastWriteVersion, err := getNumber(it, attrLastWriteVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          shardID,
+		DomainID:         getString(it, attrDomainID),
+		WorkflowID:       getString(it, attrWorkflowID),
+		RunID:            getString(it, attrCurrentRunID),
+		State:            int(state),
+		CloseStatus:      int(closeStatus),
+		CreateRequestID:  getString(it, attrCreateRequestID),
+		LastWriteVersion: lastWriteVersion,
+	}, nil
+}
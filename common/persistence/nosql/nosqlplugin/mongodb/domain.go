@@ -22,17 +22,131 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	domainMetadataID = "domain_metadata"
 )
 
+var errDomainMetadataConditionFailed = errors.New("domain metadata notification version is not matched")
+
+// domainRecord is the JSON encoded data of a domain document
+type domainRecord struct {
+	Info                        *persistence.DomainInfo
+	Config                      *persistence.InternalDomainConfig
+	ReplicationConfig           *persistence.InternalDomainReplicationConfig
+	ConfigVersion               int64
+	FailoverVersion             int64
+	FailoverNotificationVersion int64
+	PreviousFailoverVersion     int64
+	FailoverEndTime             *time.Time
+	NotificationVersion         int64
+	LastUpdatedTime             time.Time
+}
+
+func newDomainRecord(row *nosqlplugin.DomainRow) *domainRecord {
+	config := *row.Config
+	// retention is stored in days, the same as other databases
+	config.Retention = common.DaysToDuration(common.DurationToDays(config.Retention))
+	return &domainRecord{
+		Info:                        row.Info,
+		Config:                      &config,
+		ReplicationConfig:           row.ReplicationConfig,
+		ConfigVersion:               row.ConfigVersion,
+		FailoverVersion:             row.FailoverVersion,
+		FailoverNotificationVersion: row.FailoverNotificationVersion,
+		PreviousFailoverVersion:     row.PreviousFailoverVersion,
+		FailoverEndTime:             row.FailoverEndTime,
+		NotificationVersion:         row.NotificationVersion,
+		LastUpdatedTime:             row.LastUpdatedTime,
+	}
+}
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	domains := db.dbConn.Collection(cadence.DomainCollectionName)
+	err := db.runTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		if _, err := findOne[cadence.DomainCollectionEntry](sessCtx, domains, row.Info.ID); err == nil {
+			return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+		} else if !db.IsNotFoundError(err) {
+			return err
+		}
+		if err := domains.FindOne(sessCtx, bson.M{"name": row.Info.Name}).Err(); err == nil {
+			db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+			return &types.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+			}
+		} else if !db.IsNotFoundError(err) {
+			return err
+		}
+
+		metadataNotificationVersion, err := db.SelectDomainMetadata(sessCtx)
+		if err != nil {
+			return err
+		}
+		record := newDomainRecord(row)
+		record.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+		record.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+		record.NotificationVersion = metadataNotificationVersion
+		data, err := marshalJSON(record)
+		if err != nil {
+			return err
+		}
+		if _, err := domains.InsertOne(sessCtx, &cadence.DomainCollectionEntry{
+			ID:             row.Info.ID,
+			Name:           row.Info.Name,
+			IsGlobalDomain: row.IsGlobalDomain,
+			Data:           data,
+		}); err != nil {
+			return err
+		}
+		return db.updateDomainMetadata(sessCtx, metadataNotificationVersion)
+	})
+	if errors.Is(err, errDomainMetadataConditionFailed) {
+		db.logger.Warn("Create domain operation failed because of condition update failure on domain metadata record")
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return err
+}
+
+// updateDomainMetadata bumps the notification version of the domain metadata,
+// with the condition that the current version is still notificationVersion
+func (db *mdb) updateDomainMetadata(ctx context.Context, notificationVersion int64) error {
+	// the metadata document is created by the first update, a concurrent creation fails with a duplicate key
+	result, err := db.dbConn.Collection(cadence.DomainMetadataCollectionName).UpdateOne(ctx,
+		bson.M{"_id": domainMetadataID, "notificationversion": notificationVersion},
+		bson.M{"$set": bson.M{"notificationversion": notificationVersion + 1}},
+		options.Update().SetUpsert(notificationVersion <= 0),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return errDomainMetadataConditionFailed
+	}
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 && result.UpsertedCount == 0 {
+		return errDomainMetadataConditionFailed
+	}
+	return nil
 }
 
 // Update domain
@@ -40,7 +154,23 @@ func (db *mdb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	data, err := marshalJSON(newDomainRecord(row))
+	if err != nil {
+		return err
+	}
+	err = db.runTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		if _, err := db.dbConn.Collection(cadence.DomainCollectionName).UpdateOne(sessCtx,
+			bson.M{"_id": row.Info.ID},
+			bson.M{"$set": bson.M{"name": row.Info.Name, "data": data}},
+		); err != nil {
+			return err
+		}
+		return db.updateDomainMetadata(sessCtx, row.NotificationVersion)
+	})
+	if errors.Is(err, errDomainMetadataConditionFailed) {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return err
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +179,54 @@ func (db *mdb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	domains := db.dbConn.Collection(cadence.DomainCollectionName)
+	if domainID != nil {
+		doc, err := findOne[cadence.DomainCollectionEntry](ctx, domains, *domainID)
+		if err != nil {
+			return nil, err
+		}
+		return parseDomainDocument(doc)
+	}
+
+	var doc cadence.DomainCollectionEntry
+	if err := domains.FindOne(ctx, bson.M{"name": *domainName}).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return parseDomainDocument(&doc)
+}
+
+func parseDomainDocument(doc *cadence.DomainCollectionEntry) (*nosqlplugin.DomainRow, error) {
+	var record domainRecord
+	if err := unmarshalJSON(doc.Data, &record); err != nil {
+		return nil, err
+	}
+	record.Config.BadBinaries = normalizeBlob(record.Config.BadBinaries)
+	record.Config.IsolationGroups = normalizeBlob(record.Config.IsolationGroups)
+	record.Config.AsyncWorkflowsConfig = normalizeBlob(record.Config.AsyncWorkflowsConfig)
+	record.ReplicationConfig.ActiveClustersConfig = normalizeBlob(record.ReplicationConfig.ActiveClustersConfig)
+
+	row := &nosqlplugin.DomainRow{
+		Info:                        record.Info,
+		Config:                      record.Config,
+		ReplicationConfig:           record.ReplicationConfig,
+		ConfigVersion:               record.ConfigVersion,
+		FailoverVersion:             record.FailoverVersion,
+		FailoverNotificationVersion: record.FailoverNotificationVersion,
+		PreviousFailoverVersion:     record.PreviousFailoverVersion,
+		NotificationVersion:         record.NotificationVersion,
+		LastUpdatedTime:             time.Unix(0, record.LastUpdatedTime.UnixNano()),
+		IsGlobalDomain:              doc.IsGlobalDomain,
+	}
+	if record.FailoverEndTime != nil && record.FailoverEndTime.UnixNano() > 0 {
+		row.FailoverEndTime = common.TimePtr(time.Unix(0, record.FailoverEndTime.UnixNano()))
+	}
+	return row, nil
 }
 
 // Get all domain data
@@ -58,7 +235,27 @@ func (db *mdb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.DomainCollectionName),
+		idRange{},
+		pageSize,
+		pageToken,
+		false,
+		func(doc *cadence.DomainCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.DomainRow, 0, len(docs))
+	for _, doc := range docs {
+		row, err := parseDomainDocument(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +264,27 @@ func (db *mdb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	domains := db.dbConn.Collection(cadence.DomainCollectionName)
+	if domainID != nil {
+		return deleteOne(ctx, domains, *domainID)
+	}
+	_, err := domains.DeleteOne(ctx, bson.M{"name": *domainName})
+	return err
 }
 
 func (db *mdb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	doc, err := findOne[cadence.DomainMetadataCollectionEntry](ctx, db.dbConn.Collection(cadence.DomainMetadataCollectionName), domainMetadataID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return 0, nil
+		}
+		return -1, err
+	}
+	return doc.NotificationVersion, nil
 }
@@ -22,17 +22,82 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+func domainAuditLogPrefix(domainID string, operationType int) string {
+	return joinKey(escapeKey(domainID), strconv.Itoa(operationType))
+}
+
+// domainAuditLogID orders the audit logs by created time(DESC) and then event ID
+func domainAuditLogID(row *nosqlplugin.DomainAuditLogRow) string {
+	return joinKey(
+		domainAuditLogPrefix(row.DomainID, int(row.OperationType)),
+		sortableInt64(^timeToUnixNano(row.CreatedTime)),
+		escapeKey(row.EventID),
+	)
+}
+
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *mdb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	return fmt.Errorf("InsertDomainAuditLog not implemented")
+	data, err := marshalJSON(row)
+	if err != nil {
+		return err
+	}
+	id := domainAuditLogID(row)
+	return replaceOne(ctx, db.dbConn.Collection(cadence.DomainAuditLogCollectionName), id, &cadence.DomainAuditLogCollectionEntry{
+		ID:            id,
+		DomainID:      row.DomainID,
+		OperationType: int(row.OperationType),
+		CreatedTime:   row.CreatedTime,
+		Data:          data,
+		ExpireAt:      expireAt(time.Now(), row.TTLSeconds),
+	})
 }
 
 // SelectDomainAuditLogs returns audit log entries for a domain and operation type
 func (db *mdb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	return nil, nil, fmt.Errorf("SelectDomainAuditLogs not implemented")
+	start := time.Unix(0, 0)
+	end := time.Unix(0, time.Now().UnixNano())
+
+	if filter.MinCreatedTime != nil {
+		start = *filter.MinCreatedTime
+	}
+	if filter.MaxCreatedTime != nil {
+		end = *filter.MaxCreatedTime
+	}
+
+	// the created time is inverted in the _id, so the bounds are swapped
+	inclusiveMin, inclusiveMax := timeToUnixNano(start), exclusiveMax(timeToUnixNano(end))
+	if inclusiveMin > inclusiveMax {
+		return nil, nil, nil
+	}
+	r, _ := int64Range(domainAuditLogPrefix(filter.DomainID, int(filter.OperationType)), ^inclusiveMax, ^inclusiveMin)
+	docs, nextPageToken, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.DomainAuditLogCollectionName),
+		r,
+		filter.PageSize,
+		filter.NextPageToken,
+		false,
+		func(doc *cadence.DomainAuditLogCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(docs))
+	for _, doc := range docs {
+		var row nosqlplugin.DomainAuditLogRow
+		if err := unmarshalJSON(doc.Data, &row); err != nil {
+			return nil, nil, err
+		}
+		row.TTLSeconds = 0
+		rows = append(rows, &row)
+	}
+	return rows, nextPageToken, nil
 }
@@ -22,31 +22,228 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+// historyTreeRecord is the JSON encoded data of a history branch document
+type historyTreeRecord struct {
+	Ancestors       []*historyBranchAncestor
+	CreateTimestamp time.Time
+	Info            string
+}
+
+type historyBranchAncestor struct {
+	BranchID  string
+	EndNodeID int64
+}
+
+func historyTreeID(treeID, branchID string) string {
+	return joinKey(escapeKey(treeID), escapeKey(branchID))
+}
+
+// historyNodeID orders the nodes of a branch by (node_id ASC, txn_id DESC)
+func historyNodeID(treeID, branchID string, nodeID int64, txnID int64) string {
+	return joinKey(historyTreeID(treeID, branchID), sortableInt64(nodeID), sortableInt64(^txnID))
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *mdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var treeDoc *cadence.HistoryTreeCollectionEntry
+	if treeRow != nil {
+		record := &historyTreeRecord{
+			CreateTimestamp: treeRow.CreateTimestamp,
+			Info:            treeRow.Info,
+		}
+		for _, an := range treeRow.Ancestors {
+			record.Ancestors = append(record.Ancestors, &historyBranchAncestor{
+				BranchID:  an.BranchID,
+				EndNodeID: an.EndNodeID,
+			})
+		}
+		data, err := marshalJSON(record)
+		if err != nil {
+			return err
+		}
+		treeDoc = &cadence.HistoryTreeCollectionEntry{
+			ID:       historyTreeID(treeRow.TreeID, treeRow.BranchID),
+			TreeID:   treeRow.TreeID,
+			BranchID: treeRow.BranchID,
+			Data:     data,
+		}
+	}
+	var nodeDoc *cadence.HistoryNodeCollectionEntry
+	if nodeRow != nil {
+		txnID := int64(0)
+		if nodeRow.TxnID != nil {
+			txnID = *nodeRow.TxnID
+		}
+		nodeDoc = &cadence.HistoryNodeCollectionEntry{
+			ID:           historyNodeID(nodeRow.TreeID, nodeRow.BranchID, nodeRow.NodeID, txnID),
+			TreeID:       nodeRow.TreeID,
+			BranchID:     nodeRow.BranchID,
+			NodeID:       nodeRow.NodeID,
+			TxnID:        txnID,
+			Data:         nodeRow.Data,
+			DataEncoding: nodeRow.DataEncoding,
+			CreatedTime:  nodeRow.CreateTimestamp,
+		}
+	}
+
+	insert := func(ctx context.Context) error {
+		if treeDoc != nil {
+			if err := replaceOne(ctx, db.dbConn.Collection(cadence.HistoryTreeCollectionName), treeDoc.ID, treeDoc); err != nil {
+				return err
+			}
+		}
+		if nodeDoc != nil {
+			return replaceOne(ctx, db.dbConn.Collection(cadence.HistoryNodeCollectionName), nodeDoc.ID, nodeDoc)
+		}
+		return nil
+	}
+	if treeDoc == nil || nodeDoc == nil {
+		// a single document doesn't need the overhead of a transaction
+		return insert(ctx)
+	}
+	return db.runTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		return insert(sessCtx)
+	})
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *mdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	r, ok := int64Range(historyTreeID(filter.TreeID, filter.BranchID), filter.MinNodeID, exclusiveMax(filter.MaxNodeID))
+	if !ok {
+		return nil, nil, nil
+	}
+	docs, nextPageToken, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.HistoryNodeCollectionName),
+		r,
+		filter.PageSize,
+		filter.NextPageToken,
+		false,
+		func(doc *cadence.HistoryNodeCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(docs))
+	for _, doc := range docs {
+		txnID := doc.TxnID
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			TreeID:       filter.TreeID,
+			BranchID:     filter.BranchID,
+			NodeID:       doc.NodeID,
+			TxnID:        &txnID,
+			Data:         doc.Data,
+			DataEncoding: doc.DataEncoding,
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
+// for each range, it will delete all nodes starting from MinNodeID(inclusive)
 func (db *mdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// nodes are deleted before the branch record to make sure a failed deletion can be retried from the branch record
+	for _, nodeFilter := range nodeFilters {
+		r, ok := int64Range(historyTreeID(nodeFilter.TreeID, nodeFilter.BranchID), nodeFilter.MinNodeID, math.MaxInt64)
+		if !ok {
+			continue
+		}
+		if _, err := deleteRange(ctx, db.dbConn.Collection(cadence.HistoryNodeCollectionName), r); err != nil {
+			return err
+		}
+	}
+	branchID := ""
+	if treeFilter.BranchID != nil {
+		branchID = *treeFilter.BranchID
+	}
+	return deleteOne(ctx, db.dbConn.Collection(cadence.HistoryTreeCollectionName), historyTreeID(treeFilter.TreeID, branchID))
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *mdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.HistoryTreeCollectionName),
+		idRange{},
+		pageSize,
+		nextPageToken,
+		false,
+		func(doc *cadence.HistoryTreeCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := parseHistoryTreeDocuments(docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, nextPageToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *mdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	docs, err := findAll[cadence.HistoryTreeCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.HistoryTreeCollectionName),
+		prefixRange(escapeKey(filter.TreeID)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return parseHistoryTreeDocuments(docs)
+}
+
+func parseHistoryTreeDocuments(docs []*cadence.HistoryTreeCollectionEntry) ([]*nosqlplugin.HistoryTreeRow, error) {
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(docs))
+	for _, doc := range docs {
+		var record historyTreeRecord
+		if err := unmarshalJSON(doc.Data, &record); err != nil {
+			return nil, err
+		}
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:          doc.TreeID,
+			BranchID:        doc.BranchID,
+			Ancestors:       parseBranchAncestors(record.Ancestors),
+			CreateTimestamp: record.CreateTimestamp,
+			Info:            record.Info,
+		})
+	}
+	return rows, nil
+}
+
+func parseBranchAncestors(ancestors []*historyBranchAncestor) []*types.HistoryBranchRange {
+	ans := make([]*types.HistoryBranchRange, 0, len(ancestors))
+	for _, e := range ancestors {
+		ans = append(ans, &types.HistoryBranchRange{
+			BranchID:  e.BranchID,
+			EndNodeID: e.EndNodeID,
+		})
+	}
+
+	if len(ans) > 0 {
+		// sort ans based onf EndNodeID so that we can set BeginNodeID
+		sort.Slice(ans, func(i, j int) bool { return ans[i].EndNodeID < ans[j].EndNodeID })
+		ans[0].BeginNodeID = int64(1)
+		for i := 1; i < len(ans); i++ {
+			ans[i].BeginNodeID = ans[i-1].EndNodeID
+		}
+	}
+	return ans
 }
@@ -23,6 +23,7 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -58,10 +59,17 @@ func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger, dc *persist
 }
 
 func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*mdb, error) {
-	uri := fmt.Sprintf("mongodb://%v:%v@%v:%v/", cfg.User, cfg.Password, cfg.Hosts, cfg.Port)
+	uri := &url.URL{
+		Scheme: "mongodb",
+		Host:   fmt.Sprintf("%v:%v", cfg.Hosts, cfg.Port),
+		Path:   "/",
+	}
+	if cfg.User != "" {
+		uri.User = url.UserPassword(cfg.User, cfg.Password)
+	}
 	// TODO CreateDB/CreateAdminDB don't pass in context.Context so we are using background for now
 	// It's okay because this is being called during server startup or CLI.
-	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri.String()))
 	if err != nil {
 		return nil, err
 	}
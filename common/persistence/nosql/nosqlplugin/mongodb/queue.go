@@ -22,19 +22,87 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+	"math"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+func queueKey(queueType persistence.QueueType) string {
+	return strconv.Itoa(int(queueType))
+}
+
+func queueMessageID(queueType persistence.QueueType, messageID int64) string {
+	return joinKey(queueKey(queueType), sortableInt64(messageID))
+}
+
+// selectMessages reads the messages with inclusiveMin <= ID <= inclusiveMax, ordered by ID
+func (db *mdb) selectMessages(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	inclusiveMin int64,
+	inclusiveMax int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*nosqlplugin.QueueMessageRow, []byte, error) {
+	r, ok := int64Range(queueKey(queueType), inclusiveMin, inclusiveMax)
+	if !ok {
+		return nil, nil, nil
+	}
+	docs, nextPageToken, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.QueueMessageCollectionName),
+		r,
+		pageSize,
+		pageToken,
+		false,
+		func(doc *cadence.QueueMessageCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.QueueMessageRow, 0, len(docs))
+	for _, doc := range docs {
+		rows = append(rows, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        doc.MessageID,
+			Payload:   doc.Payload,
+		})
+	}
+	return rows, nextPageToken, nil
+}
+
+// deleteMessages deletes the messages with inclusiveMin <= ID <= inclusiveMax
+func (db *mdb) deleteMessages(ctx context.Context, queueType persistence.QueueType, inclusiveMin int64, inclusiveMax int64) error {
+	r, ok := int64Range(queueKey(queueType), inclusiveMin, inclusiveMax)
+	if !ok {
+		return nil
+	}
+	_, err := deleteRange(ctx, db.dbConn.Collection(cadence.QueueMessageCollectionName), r)
+	return err
+}
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.QueueMessageCollectionName).InsertOne(ctx, &cadence.QueueMessageCollectionEntry{
+		ID:        queueMessageID(row.QueueType, row.ID),
+		QueueType: int(row.QueueType),
+		MessageID: row.ID,
+		Payload:   row.Payload,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -42,7 +110,22 @@ func (db *mdb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	docs, _, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.QueueMessageCollectionName),
+		prefixRange(queueKey(queueType)),
+		1,
+		nil,
+		true,
+		func(doc *cadence.QueueMessageCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return 0, err
+	}
+	if len(docs) == 0 {
+		return 0, mongo.ErrNoDocuments
+	}
+	return docs[0].MessageID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -52,7 +135,8 @@ func (db *mdb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	rows, _, err := db.selectMessages(ctx, queueType, exclusiveMin(exclusiveBeginMessageID), math.MaxInt64, maxRows, nil)
+	return rows, err
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -60,7 +144,22 @@ func (db *mdb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	rows, nextPageToken, err := db.selectMessages(
+		ctx,
+		request.QueueType,
+		exclusiveMin(request.ExclusiveBeginMessageID),
+		request.InclusiveEndMessageID,
+		request.PageSize,
+		request.NextPageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+	response := &nosqlplugin.SelectMessagesBetweenResponse{NextPageToken: nextPageToken}
+	for _, row := range rows {
+		response.Rows = append(response.Rows, *row)
+	}
+	return response, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -69,7 +168,7 @@ func (db *mdb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteMessages(ctx, queueType, math.MinInt64, exclusiveMax(exclusiveBeginMessageID))
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -79,7 +178,7 @@ func (db *mdb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteMessages(ctx, queueType, exclusiveMin(exclusiveBeginMessageID), inclusiveEndMessageID)
 }
 
 // Delete one message
@@ -88,13 +187,22 @@ func (db *mdb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return deleteOne(ctx, db.dbConn.Collection(cadence.QueueMessageCollectionName), queueMessageID(queueType, messageID))
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *mdb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil
+	_, err := db.dbConn.Collection(cadence.QueueMetadataCollectionName).InsertOne(ctx, &cadence.QueueMetadataCollectionEntry{
+		ID:               queueKey(row.QueueType),
+		QueueType:        int(row.QueueType),
+		Version:          row.Version,
+		ClusterAckLevels: map[string]int64{},
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// it's ok if the document exists already
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -104,7 +212,17 @@ func (db *mdb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	result, err := db.dbConn.Collection(cadence.QueueMetadataCollectionName).UpdateOne(ctx,
+		bson.M{"_id": queueKey(row.QueueType), "version": row.Version - 1},
+		bson.M{"$set": bson.M{"clusteracklevels": row.ClusterAckLevels, "version": row.Version}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return nil
 }
 
 // Read a QueueMetadata
@@ -112,13 +230,25 @@ func (db *mdb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil, nil
+	doc, err := findOne[cadence.QueueMetadataCollectionEntry](ctx, db.dbConn.Collection(cadence.QueueMetadataCollectionName), queueKey(queueType))
+	if err != nil {
+		return nil, err
+	}
+	// if record exist but ackLevels is empty, we initialize the map
+	ackLevels := doc.ClusterAckLevels
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          doc.Version,
+	}, nil
 }
 
 func (db *mdb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.dbConn.Collection(cadence.QueueMessageCollectionName).CountDocuments(ctx, prefixRange(queueKey(queueType)).filter("", false))
 }
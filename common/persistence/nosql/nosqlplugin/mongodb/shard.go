@@ -22,31 +22,147 @@ package mongodb
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"strconv"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+// shardRecord is the JSON encoded data of a shard document
+type shardRecord struct {
+	Info         *persistence.InternalShardInfo
+	Data         []byte
+	DataEncoding string
+}
+
+func shardDocumentID(shardID int) string {
+	return strconv.Itoa(shardID)
+}
+
+func newShardDocument(row *nosqlplugin.ShardRow) (*cadence.ShardCollectionEntry, error) {
+	info := *row.InternalShardInfo
+	info.UpdatedAt = row.CurrentTimestamp
+	data, err := marshalJSON(&shardRecord{
+		Info:         &info,
+		Data:         row.Data,
+		DataEncoding: row.DataEncoding,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.ShardCollectionEntry{
+		ID:      shardDocumentID(row.ShardID),
+		ShardID: row.ShardID,
+		RangeID: row.RangeID,
+		Data:    data,
+	}, nil
+}
+
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	log.Println("not implemented...ignore the error for testing...")
-	return nil
+	doc, err := newShardDocument(row)
+	if err != nil {
+		return err
+	}
+	collection := db.dbConn.Collection(cadence.ShardCollectionName)
+	_, err = collection.InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.convertToConflictedShardRow(ctx, collection, row.ShardID)
+	}
+	return err
+}
+
+// convertToConflictedShardRow reads the current range ID of a shard after a failed condition
+func (db *mdb) convertToConflictedShardRow(ctx context.Context, collection *mongo.Collection, shardID int) error {
+	rangeID := int64(-1)
+	doc, err := findOne[cadence.ShardCollectionEntry](ctx, collection, shardDocumentID(shardID))
+	if err == nil {
+		rangeID = doc.RangeID
+	} else if !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("rangeid=%v", rangeID),
+	}
 }
 
 // SelectShard gets a shard
 func (db *mdb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	doc, err := findOne[cadence.ShardCollectionEntry](ctx, db.dbConn.Collection(cadence.ShardCollectionName), shardDocumentID(shardID))
+	if err != nil {
+		return 0, nil, err
+	}
+	var record shardRecord
+	if err := unmarshalJSON(doc.Data, &record); err != nil {
+		return 0, nil, err
+	}
+
+	info := record.Info
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	info.PendingFailoverMarkers = normalizeBlob(info.PendingFailoverMarkers)
+	info.TransferProcessingQueueStates = normalizeBlob(info.TransferProcessingQueueStates)
+	info.TimerProcessingQueueStates = normalizeBlob(info.TimerProcessingQueueStates)
+	return doc.RangeID, &nosqlplugin.ShardRow{
+		InternalShardInfo: info,
+		Data:              record.Data,
+		DataEncoding:      record.DataEncoding,
+	}, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	collection := db.dbConn.Collection(cadence.ShardCollectionName)
+	result, err := collection.UpdateOne(ctx,
+		bson.M{"_id": shardDocumentID(shardID), "rangeid": previousRangeID},
+		bson.M{"$set": bson.M{"rangeid": rangeID}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.convertToConflictedShardRow(ctx, collection, shardID)
+	}
+	return nil
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	doc, err := newShardDocument(row)
+	if err != nil {
+		return err
+	}
+	collection := db.dbConn.Collection(cadence.ShardCollectionName)
+	result, err := collection.ReplaceOne(ctx, bson.M{"_id": doc.ID, "rangeid": previousRangeID}, doc)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.convertToConflictedShardRow(ctx, collection, row.ShardID)
+	}
+	return nil
 }
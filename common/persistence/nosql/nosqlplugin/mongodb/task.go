@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,20 +22,141 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
 )
 
+var errTaskListRangeIDNotMatch = errors.New("tasklist range ID is not matched")
+
+// taskListRecord is the JSON encoded data of a tasklist document
+type taskListRecord struct {
+	TaskListKind            int
+	AckLevel                int64
+	LastUpdatedTime         time.Time
+	AdaptivePartitionConfig *persistence.TaskListPartitionConfig
+}
+
+// taskRecord is the JSON encoded data of a task document
+type taskRecord struct {
+	WorkflowID      string
+	RunID           string
+	ScheduledID     int64
+	CreatedTime     time.Time
+	Expiry          time.Time
+	PartitionConfig map[string]string
+	Priority        string
+}
+
+// taskListID is the _id of a tasklist, and the prefix of the _id of its tasks
+func taskListID(domainID string, taskListName string, taskListType int) string {
+	return joinKey(escapeKey(domainID), escapeKey(taskListName), strconv.Itoa(taskListType))
+}
+
+func newTaskListDocument(row *nosqlplugin.TaskListRow, rangeID int64, ttlSeconds int64) (*cadence.TaskListCollectionEntry, error) {
+	data, err := marshalJSON(&taskListRecord{
+		TaskListKind:            row.TaskListKind,
+		AckLevel:                row.AckLevel,
+		LastUpdatedTime:         row.LastUpdatedTime,
+		AdaptivePartitionConfig: row.AdaptivePartitionConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.TaskListCollectionEntry{
+		ID:           taskListID(row.DomainID, row.TaskListName, row.TaskListType),
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+		RangeID:      rangeID,
+		Data:         data,
+		ExpireAt:     expireAt(time.Now(), ttlSeconds),
+	}, nil
+}
+
+func parseTaskListDocument(doc *cadence.TaskListCollectionEntry) (*nosqlplugin.TaskListRow, error) {
+	var record taskListRecord
+	if err := unmarshalJSON(doc.Data, &record); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.TaskListRow{
+		DomainID:                doc.DomainID,
+		TaskListName:            doc.TaskListName,
+		TaskListType:            doc.TaskListType,
+		RangeID:                 doc.RangeID,
+		TaskListKind:            record.TaskListKind,
+		AckLevel:                record.AckLevel,
+		LastUpdatedTime:         record.LastUpdatedTime,
+		AdaptivePartitionConfig: record.AdaptivePartitionConfig,
+	}, nil
+}
+
+// convertToConflictedTaskListRow reads the current range ID of a tasklist after a failed condition
+func (db *mdb) convertToConflictedTaskListRow(ctx context.Context, id string) error {
+	rangeID := int64(-1)
+	doc, err := findOne[cadence.TaskListCollectionEntry](ctx, db.dbConn.Collection(cadence.TaskListCollectionName), id)
+	if err == nil {
+		rangeID = doc.RangeID
+	} else if !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("range_id=%v", rangeID),
+	}
+}
+
+// tasksRange is the _id range of the tasks with exclusiveMinTaskID < taskID <= inclusiveMaxTaskID
+func tasksRange(filter *nosqlplugin.TasksFilter, inclusiveMaxTaskID int64) (idRange, bool) {
+	return int64Range(taskListID(filter.DomainID, filter.TaskListName, filter.TaskListType), exclusiveMin(filter.MinTaskID), inclusiveMaxTaskID)
+}
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	doc, err := findOne[cadence.TaskListCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.TaskListCollectionName),
+		taskListID(filter.DomainID, filter.TaskListName, filter.TaskListType),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return parseTaskListDocument(doc)
 }
 
 // InsertTaskList insert a single tasklist row
 // Return IsConditionFailedError if the row already exists, and also the existing row
 func (db *mdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	doc, err := newTaskListDocument(&nosqlplugin.TaskListRow{
+		DomainID:        row.DomainID,
+		TaskListName:    row.TaskListName,
+		TaskListType:    row.TaskListType,
+		TaskListKind:    row.TaskListKind,
+		AckLevel:        0,
+		LastUpdatedTime: row.LastUpdatedTime,
+	}, initialRangeID, 0)
+	if err != nil {
+		return err
+	}
+	_, err = db.dbConn.Collection(cadence.TaskListCollectionName).InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.convertToConflictedTaskListRow(ctx, doc.ID)
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +166,7 @@ func (db *mdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, row, previousRangeID, 0)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +179,67 @@ func (db *mdb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, row, previousRangeID, ttlSeconds)
+}
+
+func (db *mdb) updateTaskList(
+	ctx context.Context,
+	row *nosqlplugin.TaskListRow,
+	previousRangeID int64,
+	ttlSeconds int64,
+) error {
+	doc, err := newTaskListDocument(row, row.RangeID, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	result, err := db.dbConn.Collection(cadence.TaskListCollectionName).ReplaceOne(ctx, bson.M{"_id": doc.ID, "rangeid": previousRangeID}, doc)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.convertToConflictedTaskListRow(ctx, doc.ID)
+	}
+	return nil
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *mdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.TaskListCollectionName),
+		idRange{},
+		pageSize,
+		nextPageToken,
+		false,
+		func(doc *cadence.TaskListCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, err
+	}
+	result := &nosqlplugin.ListTaskListResult{NextPageToken: nextPageToken}
+	for _, doc := range docs {
+		row, err := parseTaskListDocument(doc)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *mdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	id := taskListID(filter.DomainID, filter.TaskListName, filter.TaskListType)
+	result, err := db.dbConn.Collection(cadence.TaskListCollectionName).DeleteOne(ctx, bson.M{"_id": id, "rangeid": previousRangeID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return db.convertToConflictedTaskListRow(ctx, id)
+	}
+	return nil
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,22 +249,111 @@ func (db *mdb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	id := taskListID(tasklistCondition.DomainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType)
+	docs := make([]*cadence.TaskCollectionEntry, 0, len(tasksToInsert))
+	for _, task := range tasksToInsert {
+		record := &taskRecord{
+			WorkflowID:      task.WorkflowID,
+			RunID:           task.RunID,
+			ScheduledID:     task.ScheduledID,
+			CreatedTime:     task.CreatedTime,
+			PartitionConfig: task.PartitionConfig,
+			Priority:        task.Priority,
+		}
+		if task.TTLSeconds > 0 {
+			record.Expiry = tasklistCondition.CurrentTimeStamp.Add(time.Duration(task.TTLSeconds) * time.Second)
+		}
+		data, err := marshalJSON(record)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, &cadence.TaskCollectionEntry{
+			ID:       joinKey(id, sortableInt64(task.TaskID)),
+			TaskID:   task.TaskID,
+			Data:     data,
+			ExpireAt: expireAt(tasklistCondition.CurrentTimeStamp, int64(task.TTLSeconds)),
+		})
+	}
+
+	err := db.runTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		matched, err := checkRangeID(sessCtx, db.dbConn.Collection(cadence.TaskListCollectionName), id, tasklistCondition.RangeID)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			return errTaskListRangeIDNotMatch
+		}
+		tasks := db.dbConn.Collection(cadence.TaskCollectionName)
+		for _, doc := range docs {
+			if err := replaceOne(sessCtx, tasks, doc.ID, doc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errTaskListRangeIDNotMatch) {
+		return db.convertToConflictedTaskListRow(ctx, id)
+	}
+	return err
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *mdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	r, ok := tasksRange(filter, filter.MaxTaskID)
+	if !ok {
+		return nil, nil
+	}
+	docs, _, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.TaskCollectionName),
+		r,
+		filter.BatchSize,
+		nil,
+		false,
+		func(doc *cadence.TaskCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []*nosqlplugin.TaskRow
+	for _, doc := range docs {
+		var record taskRecord
+		if err := unmarshalJSON(doc.Data, &record); err != nil {
+			return nil, err
+		}
+		response = append(response, &nosqlplugin.TaskRow{
+			DomainID:        filter.DomainID,
+			TaskListName:    filter.TaskListName,
+			TaskListType:    filter.TaskListType,
+			TaskID:          doc.TaskID,
+			WorkflowID:      record.WorkflowID,
+			RunID:           record.RunID,
+			ScheduledID:     record.ScheduledID,
+			Expiry:          record.Expiry,
+			CreatedTime:     record.CreatedTime,
+			PartitionConfig: record.PartitionConfig,
+			Priority:        record.Priority,
+		})
+	}
+	return response, nil
 }
 
+// SelectTasks return tasks that associated to a tasklist
 func (db *mdb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	r, ok := tasksRange(filter, math.MaxInt64)
+	if !ok {
+		return 0, nil
+	}
+	return db.dbConn.Collection(cadence.TaskCollectionName).CountDocuments(ctx, r.filter("", false))
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
 // If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
 func (db *mdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	r, ok := tasksRange(filter, filter.MaxTaskID)
+	if !ok {
+		return 0, nil
+	}
+	return deleteRange(ctx, db.dbConn.Collection(cadence.TaskCollectionName), r)
 }
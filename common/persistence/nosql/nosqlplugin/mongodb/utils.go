@@ -0,0 +1,280 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	keySeparator = "#"
+)
+
+var keyEscaper = strings.NewReplacer("%", "%25", keySeparator, "%23")
+
+// joinKey builds a document _id from its components. Components which are free form strings(e.g. workflowID)
+// must be escaped so that the key is unambiguous.
+func joinKey(parts ...string) string {
+	return strings.Join(parts, keySeparator)
+}
+
+func escapeKey(s string) string {
+	return keyEscaper.Replace(s)
+}
+
+// sortableInt64 encodes v so that the lexicographical order of the encoded values is the numeric order
+func sortableInt64(v int64) string {
+	return fmt.Sprintf("%020d", uint64(v)^(1<<63))
+}
+
+func parseSortableInt64(s string) (int64, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(v ^ (1 << 63)), nil
+}
+
+// idRange is a condition on the _id of the documents, the empty bounds are unbounded
+type idRange struct {
+	min          string
+	max          string
+	exclusiveMax bool
+}
+
+// prefixRange is the range of all the _id starting with the components of the prefix
+func prefixRange(parts ...string) idRange {
+	prefix := joinKey(parts...) + keySeparator
+	// the separator is followed by the next byte, which is the smallest key after all the keys with the prefix
+	return idRange{
+		min:          prefix,
+		max:          prefix[:len(prefix)-1] + string(keySeparator[0]+1),
+		exclusiveMax: true,
+	}
+}
+
+// int64Range is the range of the _id prefix#sortableInt64(v), where inclusiveMin <= v <= inclusiveMax.
+// false is returned for an empty range.
+func int64Range(prefix string, inclusiveMin, inclusiveMax int64) (idRange, bool) {
+	if inclusiveMin > inclusiveMax {
+		return idRange{}, false
+	}
+	return idRange{
+		min: joinKey(prefix, sortableInt64(inclusiveMin)),
+		// the suffix of the keys with the same number is included
+		max:          joinKey(prefix, sortableInt64(inclusiveMax)) + string(keySeparator[0]+1),
+		exclusiveMax: true,
+	}, true
+}
+
+func (r idRange) filter(after string, descending bool) bson.M {
+	cond := bson.M{}
+	if r.min != "" {
+		cond["$gte"] = r.min
+	}
+	if r.max != "" {
+		if r.exclusiveMax {
+			cond["$lt"] = r.max
+		} else {
+			cond["$lte"] = r.max
+		}
+	}
+	if after != "" {
+		if descending {
+			delete(cond, "$lte")
+			cond["$lt"] = after
+		} else {
+			delete(cond, "$gte")
+			cond["$gt"] = after
+		}
+	}
+	if len(cond) == 0 {
+		return bson.M{}
+	}
+	return bson.M{"_id": cond}
+}
+
+// exclusiveMin converts an exclusive lower bound to an inclusive one
+func exclusiveMin(v int64) int64 {
+	if v == math.MaxInt64 {
+		return v
+	}
+	return v + 1
+}
+
+// exclusiveMax converts an exclusive upper bound to an inclusive one
+func exclusiveMax(v int64) int64 {
+	if v == math.MinInt64 {
+		return v
+	}
+	return v - 1
+}
+
+// findPage reads a page of the documents in the _id range, ordered by _id.
+// The page token is the _id of the last document of the previous page.
+func findPage[T any](
+	ctx context.Context,
+	collection *mongo.Collection,
+	r idRange,
+	pageSize int,
+	pageToken []byte,
+	descending bool,
+	id func(*T) string,
+) ([]*T, []byte, error) {
+	order := 1
+	if descending {
+		order = -1
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: order}})
+	if pageSize > 0 {
+		opts.SetLimit(int64(pageSize))
+	}
+	cursor, err := collection.Find(ctx, r.filter(string(pageToken), descending), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	var docs []*T
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, nil, err
+	}
+	var nextPageToken []byte
+	if pageSize > 0 && len(docs) == pageSize {
+		nextPageToken = []byte(id(docs[len(docs)-1]))
+	}
+	return docs, nextPageToken, nil
+}
+
+// findAll reads all the documents in the _id range, ordered by _id
+func findAll[T any](ctx context.Context, collection *mongo.Collection, r idRange) ([]*T, error) {
+	docs, _, err := findPage(ctx, collection, r, 0, nil, false, func(*T) string { return "" })
+	return docs, err
+}
+
+// deleteRange deletes all the documents in the _id range, and returns the number of deleted documents
+func deleteRange(ctx context.Context, collection *mongo.Collection, r idRange) (int, error) {
+	result, err := collection.DeleteMany(ctx, r.filter("", false))
+	if err != nil {
+		return 0, err
+	}
+	return int(result.DeletedCount), nil
+}
+
+// findOne reads a document by _id, returns mongo.ErrNoDocuments if the document doesn't exist
+func findOne[T any](ctx context.Context, collection *mongo.Collection, id string) (*T, error) {
+	var doc T
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// replaceOne writes a document, creating it if it doesn't exist
+func replaceOne(ctx context.Context, collection *mongo.Collection, id string, doc interface{}) error {
+	_, err := collection.ReplaceOne(ctx, bson.M{"_id": id}, doc, options.Replace().SetUpsert(true))
+	return err
+}
+
+// deleteOne deletes a document by _id, it is not an error if the document doesn't exist
+func deleteOne(ctx context.Context, collection *mongo.Collection, id string) error {
+	_, err := collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+// runTransaction runs fn in a multi-document transaction. A transaction is retried by the driver
+// on transient errors, so fn must be idempotent. An error returned by fn aborts the transaction.
+// Transactions require MongoDB to run as a replica set.
+func (db *mdb) runTransaction(ctx context.Context, fn func(sessCtx mongo.SessionContext) error) error {
+	session, err := db.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	return err
+}
+
+func marshalJSON(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func unmarshalJSON(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return errors.New("document data is missing")
+	}
+	return json.Unmarshal(data, v)
+}
+
+// normalizeBlob returns nil for empty blobs, the same as persistence.NewDataBlob
+func normalizeBlob(blob *persistence.DataBlob) *persistence.DataBlob {
+	if blob == nil || len(blob.Data) == 0 {
+		return nil
+	}
+	return blob
+}
+
+// expireAt returns the expiry time of a document with the TTL, or nil if there is no TTL
+func expireAt(now time.Time, ttlSeconds int64) *time.Time {
+	if ttlSeconds <= 0 {
+		return nil
+	}
+	t := now.Add(time.Duration(ttlSeconds) * time.Second)
+	return &t
+}
+
+// timeToUnixNano is the same as UnixNano, except the times which can't be represented are clamped
+func timeToUnixNano(t time.Time) int64 {
+	if t.Before(time.Unix(0, math.MinInt64)) {
+		return math.MinInt64
+	}
+	if t.After(time.Unix(0, math.MaxInt64)) {
+		return math.MaxInt64
+	}
+	return t.UnixNano()
+}
+
+// checkRangeID verifies the range ID of a shard or tasklist document in a transaction.
+// A counter is incremented so that the transaction conflicts with any concurrent update of the range ID,
+// which could not be detected from a read in snapshot isolation.
+func checkRangeID(ctx mongo.SessionContext, collection *mongo.Collection, id string, rangeID int64) (bool, error) {
+	result, err := collection.UpdateOne(ctx,
+		bson.M{"_id": id, "rangeid": rangeID},
+		bson.M{"$inc": bson.M{"fencingcounter": 1}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
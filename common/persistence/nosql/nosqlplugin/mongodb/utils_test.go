@@ -0,0 +1,141 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mongodb
+
+import (
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestSortableInt64(t *testing.T) {
+	values := []int64{math.MaxInt64, 1, 0, -1, 100, math.MinInt64, -100}
+	encoded := make([]string, 0, len(values))
+	for _, v := range values {
+		s := sortableInt64(v)
+		decoded, err := parseSortableInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, v, decoded)
+		encoded = append(encoded, s)
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	sort.Strings(encoded)
+	for i, v := range values {
+		assert.Equal(t, sortableInt64(v), encoded[i])
+	}
+	assert.True(t, sortableInt64(^int64(2)) < sortableInt64(^int64(1)), "complement must reverse the order")
+}
+
+func TestEscapeKey(t *testing.T) {
+	assert.Equal(t, "a%23b%25c", escapeKey("a#b%c"))
+	assert.NotEqual(t, joinKey(escapeKey("a#b"), "c"), joinKey("a", escapeKey("b#c")))
+}
+
+func TestPrefixRange(t *testing.T) {
+	r := prefixRange("1", escapeKey("wf"))
+	assert.True(t, inRange(r, joinKey("1", "wf", "run")))
+	assert.True(t, inRange(r, joinKey("1", "wf", "")))
+	assert.False(t, inRange(r, joinKey("1", "wf2", "run")))
+	assert.False(t, inRange(r, joinKey("1", "wf")))
+	assert.False(t, inRange(r, joinKey("10", "wf", "run")))
+}
+
+func TestInt64Range(t *testing.T) {
+	_, ok := int64Range("task", 10, 9)
+	assert.False(t, ok)
+
+	r, ok := int64Range("task", 10, 20)
+	require.True(t, ok)
+	assert.False(t, inRange(r, joinKey("task", sortableInt64(9))))
+	assert.True(t, inRange(r, joinKey("task", sortableInt64(10))))
+	assert.True(t, inRange(r, joinKey("task", sortableInt64(20), sortableInt64(^int64(5)))))
+	assert.False(t, inRange(r, joinKey("task", sortableInt64(21))))
+	assert.False(t, inRange(r, joinKey("other", sortableInt64(15))))
+
+	assert.Equal(t, int64(math.MaxInt64), exclusiveMin(math.MaxInt64))
+	assert.Equal(t, int64(11), exclusiveMin(10))
+	assert.Equal(t, int64(math.MinInt64), exclusiveMax(math.MinInt64))
+	assert.Equal(t, int64(9), exclusiveMax(10))
+}
+
+func TestIDRangeFilter(t *testing.T) {
+	assert.Equal(t, bson.M{}, idRange{}.filter("", false))
+	assert.Equal(t, bson.M{"_id": bson.M{"$gt": "b"}}, idRange{}.filter("b", false))
+
+	r := idRange{min: "a", max: "c"}
+	assert.Equal(t, bson.M{"_id": bson.M{"$gte": "a", "$lte": "c"}}, r.filter("", false))
+	assert.Equal(t, bson.M{"_id": bson.M{"$gt": "b", "$lte": "c"}}, r.filter("b", false))
+	assert.Equal(t, bson.M{"_id": bson.M{"$gte": "a", "$lt": "b"}}, r.filter("b", true))
+
+	r.exclusiveMax = true
+	assert.Equal(t, bson.M{"_id": bson.M{"$gte": "a", "$lt": "c"}}, r.filter("", false))
+}
+
+func TestExpireAt(t *testing.T) {
+	now := time.Now()
+	assert.Nil(t, expireAt(now, 0))
+	assert.Nil(t, expireAt(now, -1))
+	expiry := expireAt(now, 10)
+	require.NotNil(t, expiry)
+	assert.Equal(t, now.Add(10*time.Second), *expiry)
+}
+
+func TestTimeToUnixNano(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, now.UnixNano(), timeToUnixNano(now))
+	assert.Equal(t, int64(math.MinInt64), timeToUnixNano(time.Time{}))
+	assert.Equal(t, int64(math.MaxInt64), timeToUnixNano(time.Unix(0, math.MaxInt64).Add(time.Hour)))
+}
+
+// inRange evaluates the filter of the range on a single _id
+func inRange(r idRange, id string) bool {
+	cond, ok := r.filter("", false)["_id"].(bson.M)
+	if !ok {
+		return true
+	}
+	for op, v := range cond {
+		bound := v.(string)
+		switch op {
+		case "$gte":
+			if id < bound {
+				return false
+			}
+		case "$gt":
+			if id <= bound {
+				return false
+			}
+		case "$lte":
+			if id > bound {
+				return false
+			}
+		case "$lt":
+			if id >= bound {
+				return false
+			}
+		}
+	}
+	return true
+}
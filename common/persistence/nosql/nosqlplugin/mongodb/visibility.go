@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,16 +22,81 @@ package mongodb
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+// visibilityPageToken is the position of the last record of a page in the (time DESC, _id DESC) order
+type visibilityPageToken struct {
+	Time int64
+	ID   string
+}
+
+func visibilityID(domainID, runID string) string {
+	return joinKey(escapeKey(domainID), escapeKey(runID))
+}
+
+func newVisibilityDocument(domainID string, row *nosqlplugin.VisibilityRow, ttlSeconds int64) (*cadence.VisibilityCollectionEntry, error) {
+	data, err := marshalJSON(row)
+	if err != nil {
+		return nil, err
+	}
+	doc := &cadence.VisibilityCollectionEntry{
+		ID:           visibilityID(domainID, row.RunID),
+		DomainID:     domainID,
+		WorkflowID:   row.WorkflowID,
+		RunID:        row.RunID,
+		WorkflowType: row.TypeName,
+		StartTime:    timeToUnixNano(row.StartTime),
+		Data:         data,
+		ExpireAt:     expireAt(time.Now(), ttlSeconds),
+	}
+	if row.Status != nil {
+		doc.Closed = true
+		doc.CloseTime = timeToUnixNano(row.CloseTime)
+		doc.CloseStatus = int(*row.Status)
+	}
+	return doc, nil
+}
+
+func parseVisibilityDocument(doc *cadence.VisibilityCollectionEntry) (*nosqlplugin.VisibilityRow, error) {
+	var row nosqlplugin.VisibilityRow
+	if err := unmarshalJSON(doc.Data, &row); err != nil {
+		return nil, err
+	}
+	row.Memo = normalizeBlob(row.Memo)
+	return &row, nil
+}
+
+// InsertVisibility creates a new visibility record, return error is there is any.
 func (db *mdb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	doc, err := newVisibilityDocument(row.DomainID, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	// the started record can be written after the closed record, in which case it must not reopen the workflow.
+	// The filter doesn't match a closed record, and the upsert fails with a duplicate key.
+	_, err = db.dbConn.Collection(cadence.VisibilityCollectionName).ReplaceOne(ctx,
+		bson.M{"_id": doc.ID, "closed": false},
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	return err
 }
 
 func (db *mdb) UpdateVisibility(
@@ -39,26 +104,125 @@ func (db *mdb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	if row.UpdateCloseToOpen {
+		return errors.New("not supported operation")
+	}
+	if row.Status == nil {
+		return errors.New("close status is required to update visibility")
+	}
+	doc, err := newVisibilityDocument(row.DomainID, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return replaceOne(ctx, db.dbConn.Collection(cadence.VisibilityCollectionName), doc.ID, doc)
 }
 
 func (db *mdb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := &filter.ListRequest
+	query := bson.M{"domainid": request.DomainUUID}
+	timeField := "starttime"
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		query["closed"] = false
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		query["closed"] = true
+		switch filter.SortType {
+		case nosqlplugin.SortByStartTime:
+		case nosqlplugin.SortByClosedTime:
+			timeField = "closetime"
+		default:
+			return nil, errors.New("not supported sorting type")
+		}
+	default:
+		return nil, errors.New("no supported filter type")
+	}
+
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		query["workflowtype"] = filter.WorkflowType
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		query["workflowid"] = filter.WorkflowID
+	case nosqlplugin.ClosedByClosedStatus:
+		query["closestatus"] = int(filter.CloseStatus)
+	}
+
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions: make([]*nosqlplugin.VisibilityRow, 0),
+	}
+	if request.EarliestTime.After(request.LatestTime) {
+		return response, nil
+	}
+	query[timeField] = bson.M{
+		"$gte": timeToUnixNano(request.EarliestTime),
+		"$lte": timeToUnixNano(request.LatestTime),
+	}
+	if len(request.NextPageToken) > 0 {
+		var token visibilityPageToken
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, err
+		}
+		query["$or"] = bson.A{
+			bson.M{timeField: bson.M{"$lt": token.Time}},
+			bson.M{timeField: token.Time, "_id": bson.M{"$lt": token.ID}},
+		}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: timeField, Value: -1}, {Key: "_id", Value: -1}})
+	if request.PageSize > 0 {
+		opts.SetLimit(int64(request.PageSize))
+	}
+	cursor, err := db.dbConn.Collection(cadence.VisibilityCollectionName).Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []*cadence.VisibilityCollectionEntry
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	for _, doc := range docs {
+		row, err := parseVisibilityDocument(doc)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	if request.PageSize > 0 && len(docs) == request.PageSize {
+		last := docs[len(docs)-1]
+		token := visibilityPageToken{Time: last.StartTime, ID: last.ID}
+		if timeField == "closetime" {
+			token.Time = last.CloseTime
+		}
+		if response.NextPageToken, err = json.Marshal(&token); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
 }
 
 func (db *mdb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	return deleteOne(ctx, db.dbConn.Collection(cadence.VisibilityCollectionName), visibilityID(domainID, runID))
 }
 
 func (db *mdb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	doc, err := findOne[cadence.VisibilityCollectionEntry](ctx, db.dbConn.Collection(cadence.VisibilityCollectionName), visibilityID(domainID, runID))
+	if db.IsNotFoundError(err) {
+		// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !doc.Closed || doc.WorkflowID != workflowID {
+		return nil, nil
+	}
+	return parseVisibilityDocument(doc)
 }
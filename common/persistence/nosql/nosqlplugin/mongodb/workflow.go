@@ -1,5 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
-// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -23,14 +22,34 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*mdb)(nil)
 
+// runWorkflowTransaction runs fn in a transaction, and returns the error of a failed condition as is
+func (db *mdb) runWorkflowTransaction(ctx context.Context, shardID int, fn func(txn *workflowTransaction) error) error {
+	err := db.runTransaction(ctx, func(sessCtx mongo.SessionContext) error {
+		return fn(&workflowTransaction{db: db, ctx: sessCtx, shardID: shardID})
+	})
+	var failure *workflowConditionFailure
+	if errors.As(err, &failure) {
+		return failure.err
+	}
+	return err
+}
+
 func (db *mdb) InsertWorkflowExecutionWithTasks(
 	ctx context.Context,
 	requests *nosqlplugin.WorkflowRequestsWriteRequest,
@@ -40,7 +59,96 @@ func (db *mdb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	// the conditions are checked with the same priority as Cassandra: shard, request, current workflow, execution
+	return db.runWorkflowTransaction(ctx, shardCondition.ShardID, func(txn *workflowTransaction) error {
+		if err := txn.assertShardRangeID(shardCondition.RangeID); err != nil {
+			return err
+		}
+		if err := txn.insertOrUpsertWorkflowRequestRow(requests); err != nil {
+			return err
+		}
+		if err := txn.createOrUpdateCurrentWorkflow(domainID, workflowID, currentWorkflowRequest, func(previous *cadence.CurrentWorkflowCollectionEntry) error {
+			return convertCreateCurrentWorkflowConditionFailure(currentWorkflowRequest, previous, shardCondition)
+		}); err != nil {
+			return err
+		}
+		if err := txn.createWorkflowExecutionWithMergeMaps(execution, func(previous *cadence.ExecutionCollectionEntry) error {
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", execution.WorkflowID, execution.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  execution.CreateRequestID,
+					RunID:            execution.RunID,
+					State:            execution.State,
+					CloseStatus:      execution.CloseStatus,
+					LastWriteVersion: previous.LastWriteVersion,
+				},
+			}
+		}); err != nil {
+			return err
+		}
+		if err := txn.insertWorkflowActiveClusterSelectionPolicyRow(activeClusterSelectionPolicyRow); err != nil {
+			return err
+		}
+		return txn.createTasksByCategory(domainID, workflowID, tasksByCategory)
+	})
+}
+
+func convertCreateCurrentWorkflowConditionFailure(
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	previous *cadence.CurrentWorkflowCollectionEntry,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	if previous == nil {
+		// At this point we only know that the write was not applied.
+		msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, current workflow doesn't exist", shardCondition.RangeID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	if currentWorkflowRequest.WriteMode == nosqlplugin.CurrentWorkflowWriteModeInsert {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", currentWorkflowRequest.Row.WorkflowID, previous.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  previous.CreateRequestID,
+				RunID:            previous.RunID,
+				State:            previous.State,
+				CloseStatus:      previous.CloseStatus,
+				LastWriteVersion: previous.LastWriteVersion,
+			},
+		}
+	}
+
+	condition := currentWorkflowRequest.Condition
+	if previous.RunID != condition.GetCurrentRunID() {
+		msg := fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+			currentWorkflowRequest.Row.WorkflowID, condition.GetCurrentRunID(), previous.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+	if condition.LastWriteVersion != nil && *condition.LastWriteVersion != previous.LastWriteVersion {
+		msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+			currentWorkflowRequest.Row.WorkflowID, *condition.LastWriteVersion, previous.LastWriteVersion)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+	if condition.State != nil && *condition.State != previous.State {
+		msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+			currentWorkflowRequest.Row.WorkflowID, *condition.State, previous.State)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, current workflow runid=%v", shardCondition.RangeID, previous.RunID)
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
 }
 
 func (db *mdb) UpdateWorkflowExecutionWithTasks(
@@ -54,105 +162,401 @@ func (db *mdb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	var domainID, workflowID string
+	var previousNextEventIDCondition int64
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		previousNextEventIDCondition = *mutatedExecution.PreviousNextEventIDCondition
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		previousNextEventIDCondition = *resetExecution.PreviousNextEventIDCondition
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	requestRunID := currentWorkflowRequest.Row.RunID
+	requestConditionalRunID := currentWorkflowRequest.Condition.GetCurrentRunID()
+	unknownConditionFailure := func(details string) error {
+		// At this point we only know that the write was not applied.
+		msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RangeID: %v, previousNextEventIDCondition: %v, requestConditionalRunID: %v, details: %v",
+			shardCondition.ShardID, shardCondition.RangeID, previousNextEventIDCondition, requestConditionalRunID, details)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+	executionConditionFailure := func(previous *cadence.ExecutionCollectionEntry) error {
+		if previous == nil {
+			return unknownConditionFailure("execution doesn't exist")
+		}
+		msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+			previousNextEventIDCondition, previous.NextEventID, requestRunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+
+	return db.runWorkflowTransaction(ctx, shardCondition.ShardID, func(txn *workflowTransaction) error {
+		if err := txn.assertShardRangeID(shardCondition.RangeID); err != nil {
+			return err
+		}
+		if err := txn.insertOrUpsertWorkflowRequestRow(requests); err != nil {
+			return err
+		}
+		if err := txn.createOrUpdateCurrentWorkflow(domainID, workflowID, currentWorkflowRequest, func(previous *cadence.CurrentWorkflowCollectionEntry) error {
+			if previous == nil {
+				return unknownConditionFailure("current workflow doesn't exist")
+			}
+			if requestConditionalRunID != "" && previous.RunID != requestConditionalRunID {
+				msg := fmt.Sprintf("Failed to update mutable state. requestConditionalRunID: %v, Actual Value: %v",
+					requestConditionalRunID, previous.RunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			return unknownConditionFailure(fmt.Sprintf("current workflow: lastwriteversion=%v, state=%v", previous.LastWriteVersion, previous.State))
+		}); err != nil {
+			return err
+		}
+		if mutatedExecution != nil {
+			if err := txn.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(mutatedExecution, executionConditionFailure); err != nil {
+				return err
+			}
+		}
+		if insertedExecution != nil {
+			if err := txn.createWorkflowExecutionWithMergeMaps(insertedExecution, func(previous *cadence.ExecutionCollectionEntry) error {
+				return unknownConditionFailure(fmt.Sprintf("inserted execution already exists, runid=%v", insertedExecution.RunID))
+			}); err != nil {
+				return err
+			}
+			if err := txn.insertWorkflowActiveClusterSelectionPolicyRow(activeClusterSelectionPolicyRow); err != nil {
+				return err
+			}
+		}
+		if resetExecution != nil {
+			if err := txn.resetWorkflowExecutionAndMapsAndEventBuffer(resetExecution, executionConditionFailure); err != nil {
+				return err
+			}
+		}
+		return txn.createTasksByCategory(domainID, workflowID, tasksByCategory)
+	})
 }
 
 func (db *mdb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	doc, err := findOne[cadence.CurrentWorkflowCollectionEntry](ctx, db.dbConn.Collection(cadence.CurrentWorkflowCollectionName), currentWorkflowID(shardID, domainID, workflowID))
+	if err != nil {
+		return nil, err
+	}
+	return parseCurrentWorkflowDocument(doc), nil
 }
 
 func (db *mdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	doc, err := findOne[cadence.ExecutionCollectionEntry](ctx, db.dbConn.Collection(cadence.ExecutionCollectionName), executionID(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	record, err := parseExecutionDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       record.ExecutionInfo,
+		VersionHistories:    record.VersionHistories,
+		ActivityInfos:       record.ActivityInfos,
+		TimerInfos:          record.TimerInfos,
+		ChildExecutionInfos: record.ChildExecutionInfos,
+		RequestCancelInfos:  record.RequestCancelInfos,
+		SignalInfos:         record.SignalInfos,
+		SignalRequestedIDs:  make(map[string]struct{}, len(record.SignalRequestedIDs)),
+		Checksum:            record.Checksum,
+	}
+	if state.ActivityInfos == nil {
+		state.ActivityInfos = make(map[int64]*persistence.InternalActivityInfo)
+	}
+	if state.TimerInfos == nil {
+		state.TimerInfos = make(map[string]*persistence.TimerInfo)
+	}
+	if state.ChildExecutionInfos == nil {
+		state.ChildExecutionInfos = make(map[int64]*persistence.InternalChildExecutionInfo)
+	}
+	if state.RequestCancelInfos == nil {
+		state.RequestCancelInfos = make(map[int64]*persistence.RequestCancelInfo)
+	}
+	if state.SignalInfos == nil {
+		state.SignalInfos = make(map[int64]*persistence.SignalInfo)
+	}
+	for _, id := range record.SignalRequestedIDs {
+		state.SignalRequestedIDs[id] = struct{}{}
+	}
+	for _, blob := range record.BufferedEvents {
+		state.BufferedEvents = append(state.BufferedEvents, normalizeBlob(blob))
+	}
+	return state, nil
 }
 
 func (db *mdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	// the current workflow may have moved on to another run, in which case nothing is deleted, the same as the IF condition of Cassandra
+	_, err := db.dbConn.Collection(cadence.CurrentWorkflowCollectionName).DeleteOne(ctx, bson.M{
+		"_id":   currentWorkflowID(shardID, domainID, workflowID),
+		"runid": currentRunIDCondition,
+	})
+	return err
 }
 
 func (db *mdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	return deleteOne(ctx, db.dbConn.Collection(cadence.ExecutionCollectionName), executionID(shardID, domainID, workflowID, runID))
 }
 
 func (db *mdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.CurrentWorkflowCollectionName),
+		prefixRange(shardKey(shardID)),
+		pageSize,
+		pageToken,
+		false,
+		func(doc *cadence.CurrentWorkflowCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(docs))
+	for _, doc := range docs {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     doc.DomainID,
+			WorkflowID:   doc.WorkflowID,
+			RunID:        permanentRunID,
+			State:        doc.State,
+			CurrentRunID: doc.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.ExecutionCollectionName),
+		prefixRange(shardKey(shardID)),
+		pageSize,
+		pageToken,
+		false,
+		func(doc *cadence.ExecutionCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(docs))
+	for _, doc := range docs {
+		record, err := parseExecutionDocument(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    record.ExecutionInfo,
+			VersionHistories: record.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	_, err := findOne[cadence.ExecutionCollectionEntry](ctx, db.dbConn.Collection(cadence.ExecutionCollectionName), executionID(shardID, domainID, workflowID, runID))
+	if db.IsNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 func (db *mdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectTasksOrderByTaskID(ctx, historyTasksPrefix(shardID, historyTaskTypeTransfer), pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *mdb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return deleteOne(ctx, db.dbConn.Collection(cadence.HistoryTaskCollectionName), transferTaskID(shardID, taskID))
 }
 
 func (db *mdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, historyTasksPrefix(shardID, historyTaskTypeTransfer), inclusiveBeginTaskID, exclusiveEndTaskID)
 }
 
 func (db *mdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	r, ok := timerTasksRange(shardID, inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil, nil, nil
+	}
+	return db.selectHistoryTasks(ctx, r, pageSize, pageToken)
 }
 
 func (db *mdb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	return deleteOne(ctx, db.dbConn.Collection(cadence.HistoryTaskCollectionName), timerTaskID(shardID, visibilityTimestamp, taskID))
 }
 
 func (db *mdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	r, ok := timerTasksRange(shardID, inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil
+	}
+	_, err := deleteRange(ctx, db.dbConn.Collection(cadence.HistoryTaskCollectionName), r)
+	return err
 }
 
 func (db *mdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectTasksOrderByTaskID(ctx, historyTasksPrefix(shardID, historyTaskTypeReplication), pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *mdb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return deleteOne(ctx, db.dbConn.Collection(cadence.HistoryTaskCollectionName), replicationTaskID(shardID, taskID))
 }
 
 func (db *mdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, historyTasksPrefix(shardID, historyTaskTypeReplication), math.MinInt64, exclusiveEndTaskID)
 }
 
-func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, shardCondition nosqlplugin.ShardCondition) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	shardID := shardCondition.ShardID
+	docs := make([]*cadence.HistoryTaskCollectionEntry, 0, len(tasks))
+	for _, task := range tasks {
+		doc, err := newHistoryTaskDocument(shardID, replicationTaskID(shardID, task.Replication.TaskID), task.Replication.TaskID,
+			&historyTaskRecord{Replication: task.Replication, Task: task.Task})
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+
+	err := db.runWorkflowTransaction(ctx, shardID, func(txn *workflowTransaction) error {
+		if err := txn.assertShardRangeID(shardCondition.RangeID); err != nil {
+			return err
+		}
+		for _, doc := range docs {
+			if err := replaceOne(txn.ctx, txn.collection(cadence.HistoryTaskCollectionName), doc.ID, doc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	var failure *nosqlplugin.WorkflowOperationConditionFailure
+	if errors.As(err, &failure) && failure.ShardRangeIDNotMatch != nil {
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: *failure.ShardRangeIDNotMatch,
+		}
+	}
+	return err
 }
 
 func (db *mdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	// cross cluster tasks are deprecated and never written by this plugin
+	return nil
 }
 
 func (db *mdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	doc, err := newHistoryTaskDocument(
+		shardID,
+		joinKey(replicationDLQPrefix(shardID, sourceCluster), sortableInt64(task.Replication.TaskID)),
+		task.Replication.TaskID,
+		&historyTaskRecord{Replication: task.Replication, Task: task.Task},
+	)
+	if err != nil {
+		return err
+	}
+	return replaceOne(ctx, db.dbConn.Collection(cadence.HistoryTaskCollectionName), doc.ID, doc)
 }
 
 func (db *mdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectTasksOrderByTaskID(ctx, replicationDLQPrefix(shardID, sourceCluster), pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *mdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	return db.dbConn.Collection(cadence.HistoryTaskCollectionName).CountDocuments(ctx, prefixRange(replicationDLQPrefix(shardID, sourceCluster)).filter("", false))
 }
 
 func (db *mdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return deleteOne(ctx, db.dbConn.Collection(cadence.HistoryTaskCollectionName), joinKey(replicationDLQPrefix(shardID, sourceCluster), sortableInt64(taskID)))
 }
 
 func (db *mdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasks(ctx, replicationDLQPrefix(shardID, sourceCluster), inclusiveBeginTaskID, exclusiveEndTaskID)
 }
 
 func (db *mdb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	doc, err := findOne[cadence.ActiveClusterSelectionPolicyCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.ActiveClusterSelectionPolicyCollectionName),
+		activeClusterSelectionPolicyID(shardID, domainID, wfID, rID),
+	)
+	if db.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy:     persistence.NewDataBlob(doc.Data, constants.EncodingType(doc.DataEncoding)),
+	}, nil
 }
 
 func (db *mdb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+	return deleteOne(ctx, db.dbConn.Collection(cadence.ActiveClusterSelectionPolicyCollectionName), activeClusterSelectionPolicyID(shardID, domainID, wfID, rID))
+}
+
+func (db *mdb) selectTasksOrderByTaskID(ctx context.Context, prefix string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	r, ok := int64Range(prefix, inclusiveMinTaskID, exclusiveMax(exclusiveMaxTaskID))
+	if !ok {
+		return nil, nil, nil
+	}
+	return db.selectHistoryTasks(ctx, r, pageSize, pageToken)
+}
+
+func (db *mdb) rangeDeleteTasks(ctx context.Context, prefix string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
+	r, ok := int64Range(prefix, inclusiveBeginTaskID, exclusiveMax(exclusiveEndTaskID))
+	if !ok {
+		return nil
+	}
+	_, err := deleteRange(ctx, db.dbConn.Collection(cadence.HistoryTaskCollectionName), r)
+	return err
+}
+
+func (db *mdb) selectHistoryTasks(ctx context.Context, r idRange, pageSize int, pageToken []byte) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	docs, nextPageToken, err := findPage(
+		ctx,
+		db.dbConn.Collection(cadence.HistoryTaskCollectionName),
+		r,
+		pageSize,
+		pageToken,
+		false,
+		func(doc *cadence.HistoryTaskCollectionEntry) string { return doc.ID },
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(docs))
+	for _, doc := range docs {
+		task, err := parseHistoryTaskDocument(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
+}
+
+// timerTasksRange is the _id range of the timers with inclusiveMinTime <= visibility timestamp < exclusiveMaxTime
+func timerTasksRange(shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) (idRange, bool) {
+	minTimestamp := persistence.UnixNanoToDBTimestamp(inclusiveMinTime.UnixNano())
+	maxTimestamp := persistence.UnixNanoToDBTimestamp(exclusiveMaxTime.UnixNano())
+	return int64Range(historyTasksPrefix(shardID, historyTaskTypeTimer), minTimestamp, exclusiveMax(maxTimestamp))
 }
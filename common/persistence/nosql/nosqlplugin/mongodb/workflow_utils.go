@@ -0,0 +1,595 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+// The documents of a history shard are prefixed by the shard ID, the same way as the
+// Cassandra executions table is partitioned by shard.
+const (
+	permanentRunID = "30000000-0000-f000-f000-000000000001"
+
+	historyTaskTypeTransfer    = "transfer"
+	historyTaskTypeTimer       = "timer"
+	historyTaskTypeReplication = "replication"
+	historyTaskTypeDLQ         = "dlq"
+
+	workflowRequestTTLInSeconds = 10800
+)
+
+// executionRecord is the JSON encoded data of an execution document
+type executionRecord struct {
+	ExecutionInfo       *persistence.InternalWorkflowExecutionInfo
+	VersionHistories    *persistence.DataBlob
+	Checksum            checksum.Checksum
+	ActivityInfos       map[int64]*persistence.InternalActivityInfo
+	TimerInfos          map[string]*persistence.TimerInfo
+	ChildExecutionInfos map[int64]*persistence.InternalChildExecutionInfo
+	RequestCancelInfos  map[int64]*persistence.RequestCancelInfo
+	SignalInfos         map[int64]*persistence.SignalInfo
+	SignalRequestedIDs  []string
+	BufferedEvents      []*persistence.DataBlob
+}
+
+// historyTaskRecord is the JSON encoded data of a transfer, timer or replication task document
+type historyTaskRecord struct {
+	Transfer    *nosqlplugin.TransferTask    `json:",omitempty"`
+	Timer       *nosqlplugin.TimerTask       `json:",omitempty"`
+	Replication *nosqlplugin.ReplicationTask `json:",omitempty"`
+	Task        *persistence.DataBlob        `json:",omitempty"`
+}
+
+// workflowConditionFailure aborts a workflow transaction with the error to return
+type workflowConditionFailure struct {
+	err error
+}
+
+func (e *workflowConditionFailure) Error() string {
+	return e.err.Error()
+}
+
+func shardKey(shardID int) string {
+	return strconv.Itoa(shardID)
+}
+
+func currentWorkflowID(shardID int, domainID, workflowID string) string {
+	return joinKey(shardKey(shardID), escapeKey(domainID), escapeKey(workflowID))
+}
+
+func executionID(shardID int, domainID, workflowID, runID string) string {
+	return joinKey(shardKey(shardID), escapeKey(domainID), escapeKey(workflowID), escapeKey(runID))
+}
+
+func workflowRequestID(row *nosqlplugin.WorkflowRequestRow) string {
+	return joinKey(
+		shardKey(row.ShardID),
+		escapeKey(row.DomainID),
+		escapeKey(row.WorkflowID),
+		strconv.Itoa(int(row.RequestType)),
+		escapeKey(row.RequestID),
+	)
+}
+
+func activeClusterSelectionPolicyID(shardID int, domainID, workflowID, runID string) string {
+	return executionID(shardID, domainID, workflowID, runID)
+}
+
+func historyTasksPrefix(shardID int, taskType string) string {
+	return joinKey(shardKey(shardID), taskType)
+}
+
+func transferTaskID(shardID int, taskID int64) string {
+	return joinKey(historyTasksPrefix(shardID, historyTaskTypeTransfer), sortableInt64(taskID))
+}
+
+func replicationTaskID(shardID int, taskID int64) string {
+	return joinKey(historyTasksPrefix(shardID, historyTaskTypeReplication), sortableInt64(taskID))
+}
+
+func replicationDLQPrefix(shardID int, sourceCluster string) string {
+	return joinKey(historyTasksPrefix(shardID, historyTaskTypeDLQ), escapeKey(sourceCluster))
+}
+
+// timerTaskID orders the timers by (visibility timestamp, task ID). The same as Cassandra,
+// the visibility timestamp is stored with millisecond precision.
+func timerTaskID(shardID int, visibilityTimestamp time.Time, taskID int64) string {
+	return joinKey(
+		historyTasksPrefix(shardID, historyTaskTypeTimer),
+		sortableInt64(persistence.UnixNanoToDBTimestamp(visibilityTimestamp.UnixNano())),
+		sortableInt64(taskID),
+	)
+}
+
+func newHistoryTaskDocument(shardID int, id string, taskID int64, record *historyTaskRecord) (*cadence.HistoryTaskCollectionEntry, error) {
+	data, err := marshalJSON(record)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.HistoryTaskCollectionEntry{
+		ID:      id,
+		ShardID: shardID,
+		TaskID:  taskID,
+		Data:    data,
+	}, nil
+}
+
+func parseHistoryTaskDocument(doc *cadence.HistoryTaskCollectionEntry) (*nosqlplugin.HistoryMigrationTask, error) {
+	var record historyTaskRecord
+	if err := unmarshalJSON(doc.Data, &record); err != nil {
+		return nil, err
+	}
+	task := &nosqlplugin.HistoryMigrationTask{
+		Transfer:    record.Transfer,
+		Timer:       record.Timer,
+		Replication: record.Replication,
+		Task:        normalizeBlob(record.Task),
+		TaskID:      doc.TaskID,
+	}
+	if record.Timer != nil {
+		task.ScheduledTime = record.Timer.VisibilityTimestamp
+	}
+	return task, nil
+}
+
+// workflowTransaction holds the documents read and written by a workflow transaction
+type workflowTransaction struct {
+	db      *mdb
+	ctx     mongo.SessionContext
+	shardID int
+}
+
+func (t *workflowTransaction) collection(name string) *mongo.Collection {
+	return t.db.dbConn.Collection(name)
+}
+
+// assertShardRangeID must be the first operation of a transaction, so that a failure of the shard condition
+// takes priority over all the others, the same as Cassandra
+func (t *workflowTransaction) assertShardRangeID(rangeID int64) error {
+	matched, err := checkRangeID(t.ctx, t.collection(cadence.ShardCollectionName), shardDocumentID(t.shardID), rangeID)
+	if err != nil || matched {
+		return err
+	}
+	actualRangeID := int64(-1)
+	doc, err := findOne[cadence.ShardCollectionEntry](t.ctx, t.collection(cadence.ShardCollectionName), shardDocumentID(t.shardID))
+	if err == nil {
+		actualRangeID = doc.RangeID
+	} else if !t.db.IsNotFoundError(err) {
+		return err
+	}
+	return &workflowConditionFailure{err: &nosqlplugin.WorkflowOperationConditionFailure{
+		ShardRangeIDNotMatch: common.Int64Ptr(actualRangeID),
+	}}
+}
+
+func (t *workflowTransaction) insertWorkflowActiveClusterSelectionPolicyRow(row *nosqlplugin.ActiveClusterSelectionPolicyRow) error {
+	if row == nil || row.Policy == nil {
+		return nil
+	}
+	id := activeClusterSelectionPolicyID(row.ShardID, row.DomainID, row.WorkflowID, row.RunID)
+	return replaceOne(t.ctx, t.collection(cadence.ActiveClusterSelectionPolicyCollectionName), id, &cadence.ActiveClusterSelectionPolicyCollectionEntry{
+		ID:           id,
+		ShardID:      row.ShardID,
+		Data:         row.Policy.Data,
+		DataEncoding: row.Policy.GetEncodingString(),
+	})
+}
+
+func (t *workflowTransaction) insertOrUpsertWorkflowRequestRow(requests *nosqlplugin.WorkflowRequestsWriteRequest) error {
+	if requests == nil {
+		return nil
+	}
+	switch requests.WriteMode {
+	case nosqlplugin.WorkflowRequestWriteModeInsert, nosqlplugin.WorkflowRequestWriteModeUpsert:
+	default:
+		return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+	}
+	collection := t.collection(cadence.WorkflowRequestCollectionName)
+	for _, row := range requests.Rows {
+		switch row.RequestType {
+		case persistence.WorkflowRequestTypeStart, persistence.WorkflowRequestTypeSignal,
+			persistence.WorkflowRequestTypeCancel, persistence.WorkflowRequestTypeReset:
+		default:
+			return fmt.Errorf("unknown workflow request type %v", row.RequestType)
+		}
+		id := workflowRequestID(row)
+		if requests.WriteMode == nosqlplugin.WorkflowRequestWriteModeInsert {
+			previous, err := findOne[cadence.WorkflowRequestCollectionEntry](t.ctx, collection, id)
+			if err == nil {
+				if previous.RunID == "" {
+					return fmt.Errorf("corrupted data detected. RequestType: %v", row.RequestType)
+				}
+				return &workflowConditionFailure{err: &nosqlplugin.WorkflowOperationConditionFailure{
+					DuplicateRequest: &nosqlplugin.DuplicateRequest{
+						RequestType: row.RequestType,
+						RunID:       previous.RunID,
+					},
+				}}
+			}
+			if !t.db.IsNotFoundError(err) {
+				return err
+			}
+		}
+		if err := replaceOne(t.ctx, collection, id, &cadence.WorkflowRequestCollectionEntry{
+			ID:       id,
+			ShardID:  row.ShardID,
+			RunID:    row.RunID,
+			ExpireAt: *expireAt(time.Now(), workflowRequestTTLInSeconds),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createOrUpdateCurrentWorkflow writes the current workflow, conditionFailure converts a failed
+// condition on the previous current workflow to the error of the operation
+func (t *workflowTransaction) createOrUpdateCurrentWorkflow(
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+	conditionFailure func(previous *cadence.CurrentWorkflowCollectionEntry) error,
+) error {
+	id := currentWorkflowID(t.shardID, domainID, workflowID)
+	collection := t.collection(cadence.CurrentWorkflowCollectionName)
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		previous, err := findOne[cadence.CurrentWorkflowCollectionEntry](t.ctx, collection, id)
+		if err == nil {
+			return &workflowConditionFailure{err: conditionFailure(previous)}
+		}
+		if !t.db.IsNotFoundError(err) {
+			return err
+		}
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		previous, err := findOne[cadence.CurrentWorkflowCollectionEntry](t.ctx, collection, id)
+		if t.db.IsNotFoundError(err) {
+			return &workflowConditionFailure{err: conditionFailure(nil)}
+		}
+		if err != nil {
+			return err
+		}
+		matched := previous.RunID == *request.Condition.CurrentRunID
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			matched = matched &&
+				previous.LastWriteVersion == *request.Condition.LastWriteVersion &&
+				previous.State == *request.Condition.State
+		}
+		if !matched {
+			return &workflowConditionFailure{err: conditionFailure(previous)}
+		}
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+
+	return replaceOne(t.ctx, collection, id, &cadence.CurrentWorkflowCollectionEntry{
+		ID:               id,
+		ShardID:          t.shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            request.Row.RunID,
+		CreateRequestID:  request.Row.CreateRequestID,
+		State:            request.Row.State,
+		CloseStatus:      request.Row.CloseStatus,
+		LastWriteVersion: request.Row.LastWriteVersion,
+	})
+}
+
+func newExecutionRecord(execution *nosqlplugin.WorkflowExecutionRequest) *executionRecord {
+	record := &executionRecord{
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+	}
+	updateExecutionRecordInfo(record, execution)
+	return record
+}
+
+func updateExecutionRecordInfo(record *executionRecord, execution *nosqlplugin.WorkflowExecutionRequest) {
+	info := execution.InternalWorkflowExecutionInfo
+	info.LastUpdatedTimestamp = execution.CurrentTimeStamp
+	record.ExecutionInfo = &info
+	record.VersionHistories = execution.VersionHistories
+	if execution.Checksums != nil {
+		record.Checksum = *execution.Checksums
+	} else {
+		record.Checksum = checksum.Checksum{}
+	}
+}
+
+// mergeExecutionMaps upserts the map entries of the request, and also deletes entries in update mode
+func mergeExecutionMaps(record *executionRecord, execution *nosqlplugin.WorkflowExecutionRequest) {
+	for k, v := range execution.ActivityInfos {
+		record.ActivityInfos[k] = v
+	}
+	for k, v := range execution.TimerInfos {
+		record.TimerInfos[k] = v
+	}
+	for k, v := range execution.ChildWorkflowInfos {
+		record.ChildExecutionInfos[k] = v
+	}
+	for k, v := range execution.RequestCancelInfos {
+		record.RequestCancelInfos[k] = v
+	}
+	for k, v := range execution.SignalInfos {
+		record.SignalInfos[k] = v
+	}
+	signalRequested := make(map[string]struct{}, len(record.SignalRequestedIDs))
+	for _, id := range record.SignalRequestedIDs {
+		signalRequested[id] = struct{}{}
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		if _, ok := signalRequested[id]; !ok {
+			signalRequested[id] = struct{}{}
+			record.SignalRequestedIDs = append(record.SignalRequestedIDs, id)
+		}
+	}
+
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return
+	}
+	for _, k := range execution.ActivityInfoKeysToDelete {
+		delete(record.ActivityInfos, k)
+	}
+	for _, k := range execution.TimerInfoKeysToDelete {
+		delete(record.TimerInfos, k)
+	}
+	for _, k := range execution.ChildWorkflowInfoKeysToDelete {
+		delete(record.ChildExecutionInfos, k)
+	}
+	for _, k := range execution.RequestCancelInfoKeysToDelete {
+		delete(record.RequestCancelInfos, k)
+	}
+	for _, k := range execution.SignalInfoKeysToDelete {
+		delete(record.SignalInfos, k)
+	}
+	if len(execution.SignalRequestedIDsKeysToDelete) > 0 {
+		toDelete := make(map[string]struct{}, len(execution.SignalRequestedIDsKeysToDelete))
+		for _, id := range execution.SignalRequestedIDsKeysToDelete {
+			toDelete[id] = struct{}{}
+		}
+		remaining := record.SignalRequestedIDs[:0]
+		for _, id := range record.SignalRequestedIDs {
+			if _, ok := toDelete[id]; !ok {
+				remaining = append(remaining, id)
+			}
+		}
+		record.SignalRequestedIDs = remaining
+	}
+}
+
+func (t *workflowTransaction) writeExecution(execution *nosqlplugin.WorkflowExecutionRequest, record *executionRecord) error {
+	data, err := marshalJSON(record)
+	if err != nil {
+		return err
+	}
+	id := executionID(t.shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	return replaceOne(t.ctx, t.collection(cadence.ExecutionCollectionName), id, &cadence.ExecutionCollectionEntry{
+		ID:               id,
+		ShardID:          t.shardID,
+		DomainID:         execution.DomainID,
+		WorkflowID:       execution.WorkflowID,
+		RunID:            execution.RunID,
+		NextEventID:      execution.NextEventID,
+		LastWriteVersion: execution.LastWriteVersion,
+		State:            execution.State,
+		Data:             data,
+	})
+}
+
+// createWorkflowExecutionWithMergeMaps writes a new execution, conditionFailure converts a conflict
+// with an existing execution to the error of the operation
+func (t *workflowTransaction) createWorkflowExecutionWithMergeMaps(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	conditionFailure func(previous *cadence.ExecutionCollectionEntry) error,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+	id := executionID(t.shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	previous, err := findOne[cadence.ExecutionCollectionEntry](t.ctx, t.collection(cadence.ExecutionCollectionName), id)
+	if err == nil {
+		return &workflowConditionFailure{err: conditionFailure(previous)}
+	}
+	if !t.db.IsNotFoundError(err) {
+		return err
+	}
+	record := newExecutionRecord(execution)
+	mergeExecutionMaps(record, execution)
+	return t.writeExecution(execution, record)
+}
+
+// readExecutionForUpdate reads an execution which is updated with the condition on its next event ID,
+// conditionFailure converts a failed condition to the error of the operation
+func (t *workflowTransaction) readExecutionForUpdate(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	conditionFailure func(previous *cadence.ExecutionCollectionEntry) error,
+) (*cadence.ExecutionCollectionEntry, error) {
+	id := executionID(t.shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	previous, err := findOne[cadence.ExecutionCollectionEntry](t.ctx, t.collection(cadence.ExecutionCollectionName), id)
+	if t.db.IsNotFoundError(err) {
+		return nil, &workflowConditionFailure{err: conditionFailure(nil)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if previous.NextEventID != *execution.PreviousNextEventIDCondition {
+		return nil, &workflowConditionFailure{err: conditionFailure(previous)}
+	}
+	return previous, nil
+}
+
+func (t *workflowTransaction) resetWorkflowExecutionAndMapsAndEventBuffer(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	conditionFailure func(previous *cadence.ExecutionCollectionEntry) error,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+	if _, err := t.readExecutionForUpdate(execution, conditionFailure); err != nil {
+		return err
+	}
+	// the whole document is replaced, so the previous maps are dropped
+	record := newExecutionRecord(execution)
+	mergeExecutionMaps(record, execution)
+	return t.writeExecution(execution, record)
+}
+
+func (t *workflowTransaction) updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	conditionFailure func(previous *cadence.ExecutionCollectionEntry) error,
+) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+	previous, err := t.readExecutionForUpdate(execution, conditionFailure)
+	if err != nil {
+		return err
+	}
+
+	// the maps are JSON encoded, so the mutable state is read, changed and written back in the transaction
+	record, err := parseExecutionDocument(previous)
+	if err != nil {
+		return err
+	}
+	if record.ActivityInfos == nil {
+		record.ActivityInfos = make(map[int64]*persistence.InternalActivityInfo)
+	}
+	if record.TimerInfos == nil {
+		record.TimerInfos = make(map[string]*persistence.TimerInfo)
+	}
+	if record.ChildExecutionInfos == nil {
+		record.ChildExecutionInfos = make(map[int64]*persistence.InternalChildExecutionInfo)
+	}
+	if record.RequestCancelInfos == nil {
+		record.RequestCancelInfos = make(map[int64]*persistence.RequestCancelInfo)
+	}
+	if record.SignalInfos == nil {
+		record.SignalInfos = make(map[int64]*persistence.SignalInfo)
+	}
+	updateExecutionRecordInfo(record, execution)
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		record.BufferedEvents = nil
+	case nosqlplugin.EventBufferWriteModeAppend:
+		if execution.NewBufferedEventBatch != nil {
+			record.BufferedEvents = append(record.BufferedEvents, execution.NewBufferedEventBatch)
+		}
+	}
+	mergeExecutionMaps(record, execution)
+	return t.writeExecution(execution, record)
+}
+
+func parseExecutionDocument(doc *cadence.ExecutionCollectionEntry) (*executionRecord, error) {
+	var record executionRecord
+	if err := unmarshalJSON(doc.Data, &record); err != nil {
+		return nil, err
+	}
+	info := record.ExecutionInfo
+	if info == nil {
+		return nil, fmt.Errorf("corrupted execution document, execution info is missing")
+	}
+	info.CompletionEvent = normalizeBlob(info.CompletionEvent)
+	info.AutoResetPoints = normalizeBlob(info.AutoResetPoints)
+	info.ActiveClusterSelectionPolicy = normalizeBlob(info.ActiveClusterSelectionPolicy)
+	record.VersionHistories = normalizeBlob(record.VersionHistories)
+	return &record, nil
+}
+
+func (t *workflowTransaction) createTasksByCategory(
+	domainID string,
+	workflowID string,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+) error {
+	collection := t.collection(cadence.HistoryTaskCollectionName)
+	for c, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			var doc *cadence.HistoryTaskCollectionEntry
+			var err error
+			switch c.ID() {
+			case persistence.HistoryTaskCategoryIDTransfer:
+				transfer := *task.Transfer
+				transfer.DomainID, transfer.WorkflowID = domainID, workflowID
+				doc, err = newHistoryTaskDocument(t.shardID, transferTaskID(t.shardID, transfer.TaskID), transfer.TaskID,
+					&historyTaskRecord{Transfer: &transfer, Task: task.Task})
+			case persistence.HistoryTaskCategoryIDTimer:
+				timer := *task.Timer
+				timer.DomainID, timer.WorkflowID = domainID, workflowID
+				timer.VisibilityTimestamp = time.Unix(0, persistence.DBTimestampToUnixNano(persistence.UnixNanoToDBTimestamp(timer.VisibilityTimestamp.UnixNano())))
+				doc, err = newHistoryTaskDocument(t.shardID, timerTaskID(t.shardID, timer.VisibilityTimestamp, timer.TaskID), timer.TaskID,
+					&historyTaskRecord{Timer: &timer, Task: task.Task})
+			case persistence.HistoryTaskCategoryIDReplication:
+				replication := *task.Replication
+				replication.DomainID, replication.WorkflowID = domainID, workflowID
+				doc, err = newHistoryTaskDocument(t.shardID, replicationTaskID(t.shardID, replication.TaskID), replication.TaskID,
+					&historyTaskRecord{Replication: &replication, Task: task.Task})
+			default:
+				// TODO: implementing writing tasks for other categories
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := replaceOne(t.ctx, collection, doc.ID, doc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func parseCurrentWorkflowDocument(doc *cadence.CurrentWorkflowCollectionEntry) *nosqlplugin.CurrentWorkflowRow {
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          doc.ShardID,
+		DomainID:         doc.DomainID,
+		WorkflowID:       doc.WorkflowID,
+		RunID:            doc.RunID,
+		State:            doc.State,
+		CloseStatus:      doc.CloseStatus,
+		CreateRequestID:  doc.CreateRequestID,
+		LastWriteVersion: doc.LastWriteVersion,
+	}
+}
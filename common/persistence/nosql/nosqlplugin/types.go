@@ -189,6 +189,7 @@ type (
 		Expiry          time.Time
 		CreatedTime     time.Time
 		PartitionConfig map[string]string
+		Priority        string
 	}
 
 	// TaskListFilter is for filtering tasklist
@@ -0,0 +1,296 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// SQLVisibilityPersistenceSuite tests the query based visibility APIs supported by the SQL plugins
+	// on top of the basic DB visibility tests
+	SQLVisibilityPersistenceSuite struct {
+		DBVisibilityPersistenceSuite
+	}
+)
+
+// TestUpsertWorkflowExecution test
+func (s *SQLVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "visibility-upsert-test",
+		RunID:      uuid.New(),
+	}
+	startTime := time.Now().Add(-time.Minute).UnixNano()
+	err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		TaskList:         "visibility-tasklist",
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`"before"`),
+		},
+	})
+	s.NoError(err)
+
+	err = s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		TaskList:         "visibility-tasklist",
+		UpdateTimestamp:  time.Now().UnixNano(),
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`"after"`),
+			"CustomIntField":     []byte(`7`),
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "CustomKeywordField = 'before'",
+	})
+	s.NoError(err)
+	s.Empty(resp.Executions)
+
+	resp, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "CustomKeywordField = 'after' and CustomIntField = 7",
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+	s.Equal(workflowExecution.RunID, resp.Executions[0].Execution.GetRunID())
+	s.Equal("visibility-tasklist", resp.Executions[0].TaskList.GetName())
+	s.Equal([]byte(`"after"`), resp.Executions[0].SearchAttributes.IndexedFields["CustomKeywordField"])
+	s.Equal([]byte(`7`), resp.Executions[0].SearchAttributes.IndexedFields["CustomIntField"])
+}
+
+// TestListWorkflowExecutionsByQuery test
+func (s *SQLVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(-time.Hour)
+	for i := 0; i < 4; i++ {
+		workflowExecution := types.WorkflowExecution{
+			WorkflowID: fmt.Sprintf("visibility-query-test-%v", i),
+			RunID:      uuid.New(),
+		}
+		searchAttributes := map[string][]byte{
+			"CustomKeywordField": []byte(fmt.Sprintf(`["keyword-%v", "shared"]`, i)),
+			"CustomIntField":     []byte(fmt.Sprint(i * 10)),
+			"CustomStringField":  []byte(fmt.Sprintf(`"some text %v"`, i)),
+			"CustomBoolField":    []byte(fmt.Sprint(i%2 == 0)),
+		}
+		workflowStartTime := startTime.Add(time.Duration(i) * time.Minute).UnixNano()
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        workflowExecution,
+			WorkflowTypeName: fmt.Sprintf("visibility-workflow-%v", i%2),
+			StartTimestamp:   workflowStartTime,
+			SearchAttributes: searchAttributes,
+		})
+		s.NoError(err)
+		if i < 2 {
+			err = s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+				DomainUUID:       testDomainUUID,
+				Execution:        workflowExecution,
+				WorkflowTypeName: fmt.Sprintf("visibility-workflow-%v", i%2),
+				StartTimestamp:   workflowStartTime,
+				CloseTimestamp:   time.Now().UnixNano(),
+				Status:           types.WorkflowExecutionCloseStatus(i),
+				HistoryLength:    int64(i + 1),
+				SearchAttributes: searchAttributes,
+			})
+			s.NoError(err)
+		}
+	}
+
+	tests := map[string]struct {
+		query       string
+		workflowIDs []string
+	}{
+		"all": {
+			query: "",
+			workflowIDs: []string{
+				"visibility-query-test-3",
+				"visibility-query-test-2",
+				"visibility-query-test-1",
+				"visibility-query-test-0",
+			},
+		},
+		"open": {
+			query:       "CloseTime = missing",
+			workflowIDs: []string{"visibility-query-test-3", "visibility-query-test-2"},
+		},
+		"close status": {
+			query:       "CloseStatus = 'FAILED'",
+			workflowIDs: []string{"visibility-query-test-1"},
+		},
+		"workflow type and history length": {
+			query:       "WorkflowType = 'visibility-workflow-0' and HistoryLength >= 1",
+			workflowIDs: []string{"visibility-query-test-0"},
+		},
+		"keyword": {
+			query:       "CustomKeywordField = 'keyword-2' or CustomKeywordField = 'keyword-3'",
+			workflowIDs: []string{"visibility-query-test-3", "visibility-query-test-2"},
+		},
+		"keyword array": {
+			query:       "CustomKeywordField in ('shared') and CustomIntField between 10 and 20",
+			workflowIDs: []string{"visibility-query-test-2", "visibility-query-test-1"},
+		},
+		"string": {
+			query:       "CustomStringField = 'text 1'",
+			workflowIDs: []string{"visibility-query-test-1"},
+		},
+		"bool": {
+			query:       "CustomBoolField = 'true' and CloseTime != missing",
+			workflowIDs: []string{"visibility-query-test-0"},
+		},
+		"order by": {
+			query: "order by CustomIntField asc",
+			workflowIDs: []string{
+				"visibility-query-test-0",
+				"visibility-query-test-1",
+				"visibility-query-test-2",
+				"visibility-query-test-3",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID: testDomainUUID,
+			PageSize:   10,
+			Query:      test.query,
+		})
+		s.NoError(err, name)
+		var workflowIDs []string
+		for _, execution := range resp.Executions {
+			workflowIDs = append(workflowIDs, execution.Execution.GetWorkflowID())
+		}
+		s.Equal(test.workflowIDs, workflowIDs, name)
+
+		count, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+			DomainUUID: testDomainUUID,
+			Query:      test.query,
+		})
+		s.NoError(err, name)
+		s.Equal(int64(len(test.workflowIDs)), count.Count, name)
+	}
+}
+
+// TestListWorkflowExecutionsByQueryPagination test
+func (s *SQLVisibilityPersistenceSuite) TestListWorkflowExecutionsByQueryPagination() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID: testDomainUUID,
+			Execution: types.WorkflowExecution{
+				WorkflowID: fmt.Sprintf("visibility-pagination-test-%v", i),
+				RunID:      uuid.New(),
+			},
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime.Add(time.Duration(i) * time.Minute).UnixNano(),
+		})
+		s.NoError(err)
+	}
+
+	scan := func(query string) []string {
+		var workflowIDs []string
+		var pageToken []byte
+		for pages := 0; pages == 0 || len(pageToken) > 0; pages++ {
+			s.Less(pages, 3)
+			resp, err := s.VisibilityMgr.ScanWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+				DomainUUID:    testDomainUUID,
+				PageSize:      2,
+				NextPageToken: pageToken,
+				Query:         query,
+			})
+			s.NoError(err)
+			for _, execution := range resp.Executions {
+				workflowIDs = append(workflowIDs, execution.Execution.GetWorkflowID())
+			}
+			pageToken = resp.NextPageToken
+		}
+		return workflowIDs
+	}
+	s.Equal([]string{
+		"visibility-pagination-test-4",
+		"visibility-pagination-test-3",
+		"visibility-pagination-test-2",
+		"visibility-pagination-test-1",
+		"visibility-pagination-test-0",
+	}, scan("WorkflowType = 'visibility-workflow'"))
+	// the close time of open workflows is NULL
+	s.Equal([]string{
+		"visibility-pagination-test-0",
+		"visibility-pagination-test-1",
+		"visibility-pagination-test-2",
+		"visibility-pagination-test-3",
+		"visibility-pagination-test-4",
+	}, scan("WorkflowType = 'visibility-workflow' order by CloseTime desc, StartTime asc"))
+}
+
+// TestListWorkflowExecutionsByInvalidQuery test
+func (s *SQLVisibilityPersistenceSuite) TestListWorkflowExecutionsByInvalidQuery() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	for _, query := range []string{
+		"UnknownField = 'value'",
+		"CustomIntField = 'not a number'",
+		"WorkflowType = 'wf' group by WorkflowID",
+		"WorkflowType =",
+	} {
+		_, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID: uuid.New(),
+			PageSize:   10,
+			Query:      query,
+		})
+		s.IsType(&types.BadRequestError{}, err, query)
+
+		_, err = s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+			DomainUUID: uuid.New(),
+			Query:      query,
+		})
+		s.IsType(&types.BadRequestError{}, err, query)
+	}
+}
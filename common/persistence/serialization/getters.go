@@ -555,6 +555,54 @@ func (w *WorkflowExecutionInfo) GetActiveClusterSelectionPolicyEncoding() (o str
 	return
 }
 
+// GetPaused internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if w != nil {
+		return w.Paused
+	}
+	return
+}
+
+// GetPauseReason internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPauseReason() (o string) {
+	if w != nil {
+		return w.PauseReason
+	}
+	return
+}
+
+// GetPauseIdentity internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPauseIdentity() (o string) {
+	if w != nil {
+		return w.PauseIdentity
+	}
+	return
+}
+
+// GetPausedTimestamp internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPausedTimestamp() time.Time {
+	if w != nil {
+		return w.PausedTimestamp
+	}
+	return time.Unix(0, 0)
+}
+
+// GetAcceptedUpdateIDs internal sql blob getter
+func (w *WorkflowExecutionInfo) GetAcceptedUpdateIDs() (o []string) {
+	if w != nil {
+		return w.AcceptedUpdateIDs
+	}
+	return
+}
+
+// GetTaskPriority internal sql blob getter
+func (w *WorkflowExecutionInfo) GetTaskPriority() (o string) {
+	if w != nil {
+		return w.TaskPriority
+	}
+	return
+}
+
 // GetInitiatedID internal sql blob getter
 func (w *WorkflowExecutionInfo) GetInitiatedID() (o int64) {
 	if w != nil {
@@ -1002,6 +1050,14 @@ func (a *ActivityInfo) GetTaskListKind() (o types.TaskListKind) {
 	return
 }
 
+// GetTaskPriority internal sql blob getter
+func (a *ActivityInfo) GetTaskPriority() (o string) {
+	if a != nil {
+		return a.TaskPriority
+	}
+	return
+}
+
 // GetStartedEventEncoding internal sql blob getter
 func (a *ActivityInfo) GetStartedEventEncoding() (o string) {
 	if a != nil {
@@ -1138,6 +1194,38 @@ func (a *ActivityInfo) GetRetryLastFailureDetails() (o []byte) {
 	return
 }
 
+// GetPaused internal sql blob getter
+func (a *ActivityInfo) GetPaused() (o bool) {
+	if a != nil {
+		return a.Paused
+	}
+	return
+}
+
+// GetPauseReason internal sql blob getter
+func (a *ActivityInfo) GetPauseReason() (o string) {
+	if a != nil {
+		return a.PauseReason
+	}
+	return
+}
+
+// GetPauseIdentity internal sql blob getter
+func (a *ActivityInfo) GetPauseIdentity() (o string) {
+	if a != nil {
+		return a.PauseIdentity
+	}
+	return
+}
+
+// GetPausedTimestamp internal sql blob getter
+func (a *ActivityInfo) GetPausedTimestamp() time.Time {
+	if a != nil {
+		return a.PausedTimestamp
+	}
+	return time.Unix(0, 0)
+}
+
 // GetCancelRequested internal sql blob getter
 func (a *ActivityInfo) GetCancelRequested() (o bool) {
 	if a != nil {
@@ -1434,6 +1522,14 @@ func (t *TaskInfo) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority internal sql blob getter
+func (t *TaskInfo) GetPriority() (o string) {
+	if t != nil {
+		return t.Priority
+	}
+	return
+}
+
 // GetKind internal sql blob getter
 func (t *TaskListInfo) GetKind() (o int16) {
 	if t != nil {
@@ -100,6 +100,12 @@ var expectedNil = map[string]map[string]any{
 		"GetWorkflowTypeName":                     "",
 		"GetChecksum":                             []uint8(nil),
 		"GetChecksumEncoding":                     "",
+		"GetPaused":                               false,
+		"GetPauseReason":                          "",
+		"GetPauseIdentity":                        "",
+		"GetPausedTimestamp":                      zeroUnix,
+		"GetAcceptedUpdateIDs":                    []string(nil),
+		"GetTaskPriority":                         "",
 	},
 	"*serialization.TransferTaskInfo": {
 		"GetDomainID":                []uint8(nil),
@@ -151,6 +157,7 @@ var expectedNil = map[string]map[string]any{
 		"GetCreatedTimestamp": zeroUnix,
 		"GetExpiryTimestamp":  zeroUnix,
 		"GetPartitionConfig":  map[string]string(nil),
+		"GetPriority":         "",
 		"GetRunID":            []uint8(nil),
 		"GetScheduleID":       int64(0),
 		"GetWorkflowID":       "",
@@ -221,8 +228,13 @@ var expectedNil = map[string]map[string]any{
 		"GetStartedTimestamp":         zeroUnix,
 		"GetTaskList":                 "",
 		"GetTaskListKind":             types.TaskListKindNormal,
+		"GetTaskPriority":             "",
 		"GetTimerTaskStatus":          int32(0),
 		"GetVersion":                  int64(0),
+		"GetPaused":                   false,
+		"GetPauseReason":              "",
+		"GetPauseIdentity":            "",
+		"GetPausedTimestamp":          zeroUnix,
 	},
 	"*serialization.HistoryTreeInfo": {
 		"GetAncestors":        []*types.HistoryBranchRange(nil),
@@ -345,6 +357,12 @@ var expectedEmpty = map[string]map[string]any{
 		"GetWorkflowTypeName":                     "",
 		"GetChecksum":                             []uint8(nil),
 		"GetChecksumEncoding":                     "",
+		"GetPaused":                               false,
+		"GetPauseReason":                          "",
+		"GetPauseIdentity":                        "",
+		"GetPausedTimestamp":                      time.Time{},
+		"GetAcceptedUpdateIDs":                    []string(nil),
+		"GetTaskPriority":                         "",
 	},
 	"*serialization.TransferTaskInfo": {
 		"GetDomainID":                []uint8(nil),
@@ -396,6 +414,7 @@ var expectedEmpty = map[string]map[string]any{
 		"GetCreatedTimestamp": time.Time{},
 		"GetExpiryTimestamp":  time.Time{},
 		"GetPartitionConfig":  map[string]string(nil),
+		"GetPriority":         "",
 		"GetRunID":            []uint8(nil),
 		"GetScheduleID":       int64(0),
 		"GetWorkflowID":       "",
@@ -466,8 +485,13 @@ var expectedEmpty = map[string]map[string]any{
 		"GetStartedTimestamp":         time.Time{},
 		"GetTaskList":                 "",
 		"GetTaskListKind":             types.TaskListKindNormal,
+		"GetTaskPriority":             "",
 		"GetTimerTaskStatus":          int32(0),
 		"GetVersion":                  int64(0),
+		"GetPaused":                   false,
+		"GetPauseReason":              "",
+		"GetPauseIdentity":            "",
+		"GetPausedTimestamp":          time.Time{},
 	},
 	"*serialization.HistoryTreeInfo": {
 		"GetAncestors":        []*types.HistoryBranchRange(nil),
@@ -592,6 +616,12 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetChecksum":                             []uint8(nil),
 		"GetChecksumEncoding":                     "",
 		"GetActiveClusterSelectionPolicyEncoding": "",
+		"GetPaused":                               false,
+		"GetPauseReason":                          "",
+		"GetPauseIdentity":                        "",
+		"GetPausedTimestamp":                      time.Time{},
+		"GetAcceptedUpdateIDs":                    []string(nil),
+		"GetTaskPriority":                         "",
 	},
 	"*serialization.TransferTaskInfo": {
 		"GetDomainID":                []uint8(taskDomainID),
@@ -643,6 +673,7 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetCreatedTimestamp": taskInfoCreateTime,
 		"GetExpiryTimestamp":  taskInfoExpiryTime,
 		"GetPartitionConfig":  map[string]string{"key": "value"},
+		"GetPriority":         "high",
 		"GetRunID":            []byte(taskInfoRunID),
 		"GetScheduleID":       int64(1),
 		"GetWorkflowID":       "workflowID",
@@ -713,9 +744,14 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetStartedTimestamp":         activeInfoStartedTime,
 		"GetTaskList":                 "taskList",
 		"GetTaskListKind":             types.TaskListKindSticky,
+		"GetTaskPriority":             "high",
 
 		"GetTimerTaskStatus": int32(5),
 		"GetVersion":         int64(1),
+		"GetPaused":          true,
+		"GetPauseReason":     "pauseReason",
+		"GetPauseIdentity":   "pauseIdentity",
+		"GetPausedTimestamp": activityInfoPausedTime,
 	},
 	"*serialization.HistoryTreeInfo": {
 		"GetAncestors": []*types.HistoryBranchRange{
@@ -119,6 +119,7 @@ var (
 	activityInfoScheduledTime     = time.Unix(70, 0)
 	activeInfoStartedTime         = time.Unix(80, 0)
 	activeInfoRetryExpirationTime = time.Unix(90, 0)
+	activityInfoPausedTime        = time.Unix(95, 0)
 
 	historyTreeEventCreatedTime = time.Unix(100, 0)
 
@@ -206,6 +207,7 @@ func TestGettersForInfos(t *testing.T) {
 			PartitionConfig: map[string]string{
 				"key": "value",
 			},
+			Priority: "high",
 		},
 		&TimerInfo{
 			Version:         1,
@@ -263,6 +265,7 @@ func TestGettersForInfos(t *testing.T) {
 			Attempt:                  6,
 			TaskList:                 "taskList",
 			TaskListKind:             types.TaskListKindSticky,
+			TaskPriority:             "high",
 			StartedIdentity:          "startedIdentity",
 			HasRetryPolicy:           true,
 			RetryInitialInterval:     time.Duration(5),
@@ -274,6 +277,10 @@ func TestGettersForInfos(t *testing.T) {
 			RetryLastWorkerIdentity:  "retryLastWorkerIdentity",
 			RetryLastFailureReason:   "retryLastFailureReason",
 			RetryLastFailureDetails:  []byte("retryLastFailureDetails"),
+			Paused:                   true,
+			PauseReason:              "pauseReason",
+			PauseIdentity:            "pauseIdentity",
+			PausedTimestamp:          activityInfoPausedTime,
 		},
 		&HistoryTreeInfo{
 			CreatedTimestamp: historyTreeEventCreatedTime,
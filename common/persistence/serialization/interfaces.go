@@ -178,6 +178,12 @@ type (
 		ChecksumEncoding                     string
 		ActiveClusterSelectionPolicy         []byte
 		ActiveClusterSelectionPolicyEncoding string
+		Paused                               bool
+		PauseReason                          string
+		PauseIdentity                        string
+		PausedTimestamp                      time.Time
+		AcceptedUpdateIDs                    []string
+		TaskPriority                         string
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
@@ -203,6 +209,7 @@ type (
 		Attempt                  int32
 		TaskList                 string
 		TaskListKind             types.TaskListKind
+		TaskPriority             string
 		StartedIdentity          string
 		HasRetryPolicy           bool
 		RetryInitialInterval     time.Duration
@@ -214,6 +221,10 @@ type (
 		RetryLastFailureReason   string
 		RetryLastWorkerIdentity  string
 		RetryLastFailureDetails  []byte
+		Paused                   bool
+		PauseReason              string
+		PauseIdentity            string
+		PausedTimestamp          time.Time
 	}
 
 	// ChildExecutionInfo blob in a serialization agnostic format
@@ -267,6 +278,7 @@ type (
 		ExpiryTimestamp  time.Time
 		CreatedTimestamp time.Time
 		PartitionConfig  map[string]string
+		Priority         string
 	}
 
 	TaskListPartition struct {
@@ -78,6 +78,12 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 		PartitionConfig:                    info.PartitionConfig,
 		IsCron:                             info.IsCron,
 		CronOverlapPolicy:                  types.CronOverlapPolicy(info.GetCronOverlapPolicy()),
+		Paused:                             info.GetPaused(),
+		PauseReason:                        info.GetPauseReason(),
+		PauseIdentity:                      info.GetPauseIdentity(),
+		PausedTimestamp:                    info.GetPausedTimestamp(),
+		AcceptedUpdateIDs:                  info.GetAcceptedUpdateIDs(),
+		TaskPriority:                       info.GetTaskPriority(),
 	}
 	if info.ParentDomainID != nil {
 		result.ParentDomainID = info.ParentDomainID.String()
@@ -171,6 +177,12 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		CronOverlapPolicy:                    executionInfo.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:         executionInfo.ActiveClusterSelectionPolicy.GetData(),
 		ActiveClusterSelectionPolicyEncoding: string(executionInfo.ActiveClusterSelectionPolicy.GetEncoding()),
+		Paused:                               executionInfo.Paused,
+		PauseReason:                          executionInfo.PauseReason,
+		PauseIdentity:                        executionInfo.PauseIdentity,
+		PausedTimestamp:                      executionInfo.PausedTimestamp,
+		AcceptedUpdateIDs:                    executionInfo.AcceptedUpdateIDs,
+		TaskPriority:                         executionInfo.TaskPriority,
 	}
 
 	if executionInfo.CompletionEvent != nil {
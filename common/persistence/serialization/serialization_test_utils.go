@@ -142,6 +142,7 @@ var activityInfoTestData = &ActivityInfo{
 	TimerTaskStatus:          5,
 	Attempt:                  6,
 	TaskList:                 "test_task_list",
+	TaskPriority:             "high",
 	StartedIdentity:          "test_identity",
 	HasRetryPolicy:           true,
 	RetryInitialInterval:     1 * time.Second,
@@ -153,6 +154,10 @@ var activityInfoTestData = &ActivityInfo{
 	RetryLastFailureReason:   "test_failure_reason",
 	RetryLastWorkerIdentity:  "test_worker_identity",
 	RetryLastFailureDetails:  []byte("test_failure_details"),
+	Paused:                   true,
+	PauseReason:              "test_pause_reason",
+	PauseIdentity:            "test_pause_identity",
+	PausedTimestamp:          time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local),
 }
 
 var childExecutionInfoTestData = &ChildExecutionInfo{
@@ -201,6 +206,7 @@ var taskInfoTestData = &TaskInfo{
 	ExpiryTimestamp:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local),
 	CreatedTimestamp: time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local),
 	PartitionConfig:  map[string]string{"test_key": "test_value"},
+	Priority:         "high",
 }
 
 var taskListInfoTestData = &TaskListInfo{
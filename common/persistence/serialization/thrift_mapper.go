@@ -288,6 +288,12 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		ChecksumEncoding:                        &info.ChecksumEncoding,
 		ActiveClusterSelectionPolicy:            info.ActiveClusterSelectionPolicy,
 		ActiveClusterSelectionPolicyEncoding:    &info.ActiveClusterSelectionPolicyEncoding,
+		Paused:                                  &info.Paused,
+		PauseReason:                             &info.PauseReason,
+		PauseIdentity:                           &info.PauseIdentity,
+		PausedTimeNanos:                         timeToUnixNanoPtr(info.PausedTimestamp),
+		AcceptedUpdateIDs:                       info.AcceptedUpdateIDs,
+		TaskPriority:                            &info.TaskPriority,
 	}
 }
 
@@ -363,6 +369,12 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		ChecksumEncoding:                     info.GetChecksumEncoding(),
 		ActiveClusterSelectionPolicy:         info.ActiveClusterSelectionPolicy,
 		ActiveClusterSelectionPolicyEncoding: info.GetActiveClusterSelectionPolicyEncoding(),
+		Paused:                               info.GetPaused(),
+		PauseReason:                          info.GetPauseReason(),
+		PauseIdentity:                        info.GetPauseIdentity(),
+		PausedTimestamp:                      timeFromUnixNano(info.GetPausedTimeNanos()),
+		AcceptedUpdateIDs:                    info.AcceptedUpdateIDs,
+		TaskPriority:                         info.GetTaskPriority(),
 	}
 }
 
@@ -392,6 +404,7 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 		Attempt:                       &info.Attempt,
 		TaskList:                      &info.TaskList,
 		TaskListKind:                  thrift.FromTaskListKind(&info.TaskListKind),
+		TaskPriority:                  &info.TaskPriority,
 		StartedIdentity:               &info.StartedIdentity,
 		HasRetryPolicy:                &info.HasRetryPolicy,
 		RetryInitialIntervalSeconds:   durationToSecondsInt32Ptr(info.RetryInitialInterval),
@@ -403,6 +416,10 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 		RetryLastFailureReason:        &info.RetryLastFailureReason,
 		RetryLastWorkerIdentity:       &info.RetryLastWorkerIdentity,
 		RetryLastFailureDetails:       info.RetryLastFailureDetails,
+		Paused:                        &info.Paused,
+		PauseReason:                   &info.PauseReason,
+		PauseIdentity:                 &info.PauseIdentity,
+		PausedTimeNanos:               timeToUnixNanoPtr(info.PausedTimestamp),
 	}
 }
 
@@ -432,6 +449,7 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		Attempt:                  info.GetAttempt(),
 		TaskList:                 info.GetTaskList(),
 		TaskListKind:             taskListKindFromThrift(info.TaskListKind),
+		TaskPriority:             info.GetTaskPriority(),
 		StartedIdentity:          info.GetStartedIdentity(),
 		HasRetryPolicy:           info.GetHasRetryPolicy(),
 		RetryInitialInterval:     common.SecondsToDuration(int64(info.GetRetryInitialIntervalSeconds())),
@@ -443,6 +461,10 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		RetryLastFailureReason:   info.GetRetryLastFailureReason(),
 		RetryLastWorkerIdentity:  info.GetRetryLastWorkerIdentity(),
 		RetryLastFailureDetails:  info.RetryLastFailureDetails,
+		Paused:                   info.GetPaused(),
+		PauseReason:              info.GetPauseReason(),
+		PauseIdentity:            info.GetPauseIdentity(),
+		PausedTimestamp:          timeFromUnixNano(info.GetPausedTimeNanos()),
 	}
 }
 
@@ -575,6 +597,7 @@ func taskInfoToThrift(info *TaskInfo) *sqlblobs.TaskInfo {
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		CreatedTimeNanos: timeToUnixNanoPtr(info.CreatedTimestamp),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         &info.Priority,
 	}
 }
 
@@ -589,6 +612,7 @@ func taskInfoFromThrift(info *sqlblobs.TaskInfo) *TaskInfo {
 		ExpiryTimestamp:  timeFromUnixNano(info.GetExpiryTimeNanos()),
 		CreatedTimestamp: timeFromUnixNano(info.GetCreatedTimeNanos()),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         info.GetPriority(),
 	}
 }
 
@@ -274,6 +274,12 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		Checksum:                           []byte("Checksum"),
 		ChecksumEncoding:                   "ChecksumEncoding",
 		IsCron:                             true,
+		Paused:                             true,
+		PauseReason:                        "PauseReason",
+		PauseIdentity:                      "PauseIdentity",
+		PausedTimestamp:                    time.UnixMilli(1752018142826),
+		AcceptedUpdateIDs:                  []string{"update-1", "update-2"},
+		TaskPriority:                       "low",
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
@@ -379,6 +385,7 @@ func TestActivityInfo(t *testing.T) {
 		Attempt:                  int32(rand.Intn(1000)),
 		TaskList:                 "TaskList",
 		TaskListKind:             types.TaskListKindEphemeral,
+		TaskPriority:             "high",
 		StartedIdentity:          "StartedIdentity",
 		HasRetryPolicy:           true,
 		RetryInitialInterval:     time.Minute * time.Duration(rand.Intn(10)),
@@ -390,6 +397,10 @@ func TestActivityInfo(t *testing.T) {
 		RetryLastFailureReason:   "RetryLastFailureReason",
 		RetryLastWorkerIdentity:  "RetryLastWorkerIdentity",
 		RetryLastFailureDetails:  []byte("RetryLastFailureDetails"),
+		Paused:                   true,
+		PauseReason:              "PauseReason",
+		PauseIdentity:            "PauseIdentity",
+		PausedTimestamp:          time.UnixMilli(1752018142827),
 	}
 	actual := activityInfoFromThrift(activityInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
@@ -471,6 +482,7 @@ func TestTaskInfo(t *testing.T) {
 		ExpiryTimestamp:  time.Now(),
 		CreatedTimestamp: time.Now(),
 		PartitionConfig:  map[string]string{"zone": "dca1"},
+		Priority:         "high",
 	}
 	actual := taskInfoFromThrift(taskInfoToThrift(expected))
 	assert.Equal(t, expected.WorkflowID, actual.WorkflowID)
@@ -479,6 +491,7 @@ func TestTaskInfo(t *testing.T) {
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 	assert.Equal(t, expected.CreatedTimestamp.Sub(actual.CreatedTimestamp), time.Duration(0))
 	assert.Equal(t, expected.PartitionConfig, actual.PartitionConfig)
+	assert.Equal(t, expected.Priority, actual.Priority)
 	assert.Nil(t, taskInfoFromThrift(nil))
 	assert.Nil(t, taskInfoToThrift(nil))
 }
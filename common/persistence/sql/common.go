@@ -128,7 +128,8 @@ func convertCommonErrors(
 		*types.DomainAlreadyExistsError,
 		*types.EntityNotExistsError,
 		*types.ServiceBusyError,
-		*types.InternalServiceError:
+		*types.InternalServiceError,
+		*types.BadRequestError:
 		return err
 	}
 	if errChecker.IsNotFoundError(err) {
@@ -0,0 +1,235 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	sqlDomainAuditStore struct {
+		sqlStore
+	}
+
+	// domainAuditLogPageToken is the last row returned in a page, the next page starts right after it
+	domainAuditLogPageToken struct {
+		CreatedTime time.Time `json:"createdTime"`
+		EventID     string    `json:"eventID"`
+	}
+)
+
+// defaultDomainAuditLogPageSize is used when the request does not set a page size
+const defaultDomainAuditLogPageSize = 1000
+
+// maxEventID sorts after any event ID, so that the first page does not return rows created at its upper bound
+var maxEventID = serialization.UUID(bytes.Repeat([]byte{0xff}, 16))
+
+// NewSQLDomainAuditStore creates a domain audit store for SQL
+func NewSQLDomainAuditStore(
+	db sqlplugin.DB,
+	logger log.Logger,
+) (persistence.DomainAuditStore, error) {
+	return &sqlDomainAuditStore{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+// CreateDomainAuditLog creates a new domain audit log entry
+func (m *sqlDomainAuditStore) CreateDomainAuditLog(
+	ctx context.Context,
+	request *persistence.InternalCreateDomainAuditLogRequest,
+) (*persistence.CreateDomainAuditLogResponse, error) {
+	domainID, err := parseUUID(request.DomainID)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("CreateDomainAuditLog failed. Invalid domain ID %q.", request.DomainID)}
+	}
+	eventID, err := parseUUID(request.EventID)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("CreateDomainAuditLog failed. Invalid event ID %q.", request.EventID)}
+	}
+
+	now := time.Now().UTC()
+	row := &sqlplugin.DomainAuditLogRow{
+		DomainID:            domainID,
+		OperationType:       int(request.OperationType),
+		CreatedTime:         request.CreatedTime.UTC(),
+		EventID:             eventID,
+		StateBefore:         getDataBlobBytes(request.StateBefore),
+		StateBeforeEncoding: getDataBlobEncoding(request.StateBefore),
+		StateAfter:          getDataBlobBytes(request.StateAfter),
+		StateAfterEncoding:  getDataBlobEncoding(request.StateAfter),
+		LastUpdatedTime:     request.LastUpdatedTime.UTC(),
+		Identity:            request.Identity,
+		IdentityType:        request.IdentityType,
+		Comment:             request.Comment,
+	}
+	if request.TTLSeconds > 0 {
+		expiresAt := now.Add(time.Duration(request.TTLSeconds) * time.Second)
+		row.ExpiresAt = &expiresAt
+	}
+
+	if _, err := m.db.InsertIntoDomainAuditLog(ctx, row); err != nil {
+		if m.db.IsDupEntryError(err) {
+			return nil, &persistence.ConditionFailedError{Msg: fmt.Sprintf("CreateDomainAuditLog failed. Event %v already exists.", request.EventID)}
+		}
+		return nil, convertCommonErrors(m.db, "CreateDomainAuditLog", "", err)
+	}
+
+	// unlike Cassandra there is no TTL in SQL, so the expired rows of the partition are deleted here.
+	// Failing to do so only delays the cleanup, as expired rows are never returned.
+	if _, err := m.db.DeleteExpiredDomainAuditLogs(ctx, domainID, row.OperationType, now); err != nil {
+		m.logger.Warn("Failed to delete expired domain audit logs",
+			tag.WorkflowDomainID(request.DomainID),
+			tag.Error(err),
+		)
+	}
+
+	return &persistence.CreateDomainAuditLogResponse{
+		EventID: request.EventID,
+	}, nil
+}
+
+// GetDomainAuditLogs retrieves domain audit logs, most recent first
+func (m *sqlDomainAuditStore) GetDomainAuditLogs(
+	ctx context.Context,
+	request *persistence.GetDomainAuditLogsRequest,
+) (*persistence.InternalGetDomainAuditLogsResponse, error) {
+	domainID, err := parseUUID(request.DomainID)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("GetDomainAuditLogs failed. Invalid domain ID %q.", request.DomainID)}
+	}
+
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultDomainAuditLogPageSize
+	}
+
+	now := time.Now().UTC()
+	filter := &sqlplugin.DomainAuditLogFilter{
+		DomainID:           domainID,
+		OperationType:      int(request.OperationType),
+		MinCreatedTime:     time.Unix(0, 0).UTC(),
+		PageMaxCreatedTime: now,
+		PageMinEventID:     maxEventID,
+		Now:                now,
+		PageSize:           pageSize,
+	}
+	if request.MinCreatedTime != nil {
+		filter.MinCreatedTime = request.MinCreatedTime.UTC()
+	}
+	if request.MaxCreatedTime != nil {
+		filter.PageMaxCreatedTime = request.MaxCreatedTime.UTC()
+	}
+	if len(request.NextPageToken) > 0 {
+		var token domainAuditLogPageToken
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("GetDomainAuditLogs failed. Invalid next page token: %v", err)}
+		}
+		eventID, err := parseUUID(token.EventID)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: "GetDomainAuditLogs failed. Invalid next page token."}
+		}
+		filter.PageMaxCreatedTime = token.CreatedTime.UTC()
+		filter.PageMinEventID = eventID
+	}
+
+	rows, err := m.db.SelectFromDomainAuditLogs(ctx, filter)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetDomainAuditLogs", "", err)
+	}
+
+	auditLogs := make([]*persistence.InternalDomainAuditLog, 0, len(rows))
+	for _, row := range rows {
+		auditLog := &persistence.InternalDomainAuditLog{
+			EventID:         row.EventID.String(),
+			DomainID:        row.DomainID.String(),
+			OperationType:   persistence.DomainAuditOperationType(row.OperationType),
+			CreatedTime:     row.CreatedTime,
+			LastUpdatedTime: row.LastUpdatedTime,
+			Identity:        row.Identity,
+			IdentityType:    row.IdentityType,
+			Comment:         row.Comment,
+		}
+		if len(row.StateBefore) > 0 {
+			auditLog.StateBefore = persistence.NewDataBlob(row.StateBefore, constants.EncodingType(row.StateBeforeEncoding))
+		}
+		if len(row.StateAfter) > 0 {
+			auditLog.StateAfter = persistence.NewDataBlob(row.StateAfter, constants.EncodingType(row.StateAfterEncoding))
+		}
+		auditLogs = append(auditLogs, auditLog)
+	}
+
+	resp := &persistence.InternalGetDomainAuditLogsResponse{
+		AuditLogs: auditLogs,
+	}
+	if len(rows) == pageSize {
+		last := rows[len(rows)-1]
+		resp.NextPageToken, err = json.Marshal(&domainAuditLogPageToken{
+			CreatedTime: last.CreatedTime,
+			EventID:     last.EventID.String(),
+		})
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: fmt.Sprintf("GetDomainAuditLogs failed. Failed to serialize next page token: %v", err)}
+		}
+	}
+	return resp, nil
+}
+
+func parseUUID(s string) (serialization.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return id[:], nil
+}
+
+func getDataBlobBytes(blob *persistence.DataBlob) []byte {
+	if blob == nil {
+		return nil
+	}
+	return blob.Data
+}
+
+func getDataBlobEncoding(blob *persistence.DataBlob) string {
+	if blob == nil {
+		return ""
+	}
+	return string(blob.Encoding)
+}
@@ -0,0 +1,358 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestCreateDomainAuditLog(t *testing.T) {
+	domainID := uuid.NewString()
+	eventID := uuid.NewString()
+	createdTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		request   *persistence.InternalCreateDomainAuditLogRequest
+		mockSetup func(*sqlplugin.MockDB)
+		wantErr   error
+	}{
+		{
+			name: "Success case with TTL",
+			request: &persistence.InternalCreateDomainAuditLogRequest{
+				DomainID:        domainID,
+				EventID:         eventID,
+				StateAfter:      persistence.NewDataBlob([]byte("after"), constants.EncodingTypeThriftRWSnappy),
+				OperationType:   persistence.DomainAuditOperationTypeFailover,
+				CreatedTime:     createdTime,
+				LastUpdatedTime: createdTime,
+				Identity:        "test-user",
+				IdentityType:    "user",
+				Comment:         "failover",
+				TTLSeconds:      3600,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().InsertIntoDomainAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, row *sqlplugin.DomainAuditLogRow) (sql.Result, error) {
+						assert.Equal(t, serialization.MustParseUUID(domainID), row.DomainID)
+						assert.Equal(t, serialization.MustParseUUID(eventID), row.EventID)
+						assert.Equal(t, int(persistence.DomainAuditOperationTypeFailover), row.OperationType)
+						assert.Equal(t, createdTime, row.CreatedTime)
+						assert.Nil(t, row.StateBefore)
+						assert.Equal(t, "", row.StateBeforeEncoding)
+						assert.Equal(t, []byte("after"), row.StateAfter)
+						assert.Equal(t, string(constants.EncodingTypeThriftRWSnappy), row.StateAfterEncoding)
+						assert.Equal(t, "failover", row.Comment)
+						require.NotNil(t, row.ExpiresAt)
+						assert.WithinDuration(t, time.Now().Add(time.Hour), *row.ExpiresAt, time.Minute)
+						return nil, nil
+					})
+				mockDB.EXPECT().DeleteExpiredDomainAuditLogs(gomock.Any(), serialization.MustParseUUID(domainID), int(persistence.DomainAuditOperationTypeFailover), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "Success case without TTL",
+			request: &persistence.InternalCreateDomainAuditLogRequest{
+				DomainID:      domainID,
+				EventID:       eventID,
+				OperationType: persistence.DomainAuditOperationTypeCreate,
+				CreatedTime:   createdTime,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().InsertIntoDomainAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, row *sqlplugin.DomainAuditLogRow) (sql.Result, error) {
+						assert.Nil(t, row.ExpiresAt)
+						return nil, nil
+					})
+				mockDB.EXPECT().DeleteExpiredDomainAuditLogs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "Failing to delete expired logs is not an error",
+			request: &persistence.InternalCreateDomainAuditLogRequest{
+				DomainID:      domainID,
+				EventID:       eventID,
+				OperationType: persistence.DomainAuditOperationTypeUpdate,
+				CreatedTime:   createdTime,
+				TTLSeconds:    60,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().InsertIntoDomainAuditLog(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockDB.EXPECT().DeleteExpiredDomainAuditLogs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+		},
+		{
+			name: "Invalid domain ID",
+			request: &persistence.InternalCreateDomainAuditLogRequest{
+				DomainID: "not-a-uuid",
+				EventID:  eventID,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {},
+			wantErr:   &types.BadRequestError{},
+		},
+		{
+			name: "Invalid event ID",
+			request: &persistence.InternalCreateDomainAuditLogRequest{
+				DomainID: domainID,
+				EventID:  "not-a-uuid",
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {},
+			wantErr:   &types.BadRequestError{},
+		},
+		{
+			name: "Duplicate event",
+			request: &persistence.InternalCreateDomainAuditLogRequest{
+				DomainID: domainID,
+				EventID:  eventID,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				err := errors.New("duplicate")
+				mockDB.EXPECT().InsertIntoDomainAuditLog(gomock.Any(), gomock.Any()).Return(nil, err)
+				mockDB.EXPECT().IsDupEntryError(err).Return(true)
+			},
+			wantErr: &persistence.ConditionFailedError{},
+		},
+		{
+			name: "Database error",
+			request: &persistence.InternalCreateDomainAuditLogRequest{
+				DomainID: domainID,
+				EventID:  eventID,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				err := errors.New("db error")
+				mockDB.EXPECT().InsertIntoDomainAuditLog(gomock.Any(), gomock.Any()).Return(nil, err)
+				mockDB.EXPECT().IsDupEntryError(err).Return(false)
+				mockDB.EXPECT().IsNotFoundError(err).Return(false)
+				mockDB.EXPECT().IsTimeoutError(err).Return(false)
+				mockDB.EXPECT().IsThrottlingError(err).Return(false)
+			},
+			wantErr: &types.InternalServiceError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockDB := sqlplugin.NewMockDB(ctrl)
+			store, err := NewSQLDomainAuditStore(mockDB, testlogger.New(t))
+			require.NoError(t, err, "Failed to create sql domain audit store")
+
+			tc.mockSetup(mockDB)
+			resp, err := store.CreateDomainAuditLog(context.Background(), tc.request)
+			if tc.wantErr != nil {
+				assert.IsType(t, tc.wantErr, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.request.EventID, resp.EventID)
+			}
+		})
+	}
+}
+
+func TestGetDomainAuditLogs(t *testing.T) {
+	domainID := uuid.NewString()
+	eventID1 := uuid.NewString()
+	eventID2 := uuid.NewString()
+	minTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxTime := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	createdTime1 := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	createdTime2 := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	rows := []sqlplugin.DomainAuditLogRow{
+		{
+			DomainID:            serialization.MustParseUUID(domainID),
+			OperationType:       int(persistence.DomainAuditOperationTypeFailover),
+			CreatedTime:         createdTime1,
+			EventID:             serialization.MustParseUUID(eventID1),
+			StateBefore:         []byte("before"),
+			StateBeforeEncoding: string(constants.EncodingTypeThriftRWSnappy),
+			StateAfter:          []byte("after"),
+			StateAfterEncoding:  string(constants.EncodingTypeThriftRWSnappy),
+			LastUpdatedTime:     createdTime1,
+			Identity:            "test-user",
+			IdentityType:        "user",
+			Comment:             "comment",
+		},
+		{
+			DomainID:        serialization.MustParseUUID(domainID),
+			OperationType:   int(persistence.DomainAuditOperationTypeFailover),
+			CreatedTime:     createdTime2,
+			EventID:         serialization.MustParseUUID(eventID2),
+			LastUpdatedTime: createdTime2,
+		},
+	}
+	pageToken, err := json.Marshal(&domainAuditLogPageToken{CreatedTime: createdTime2, EventID: eventID2})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		request   *persistence.GetDomainAuditLogsRequest
+		mockSetup func(*sqlplugin.MockDB)
+		want      *persistence.InternalGetDomainAuditLogsResponse
+		wantErr   error
+	}{
+		{
+			name: "First page",
+			request: &persistence.GetDomainAuditLogsRequest{
+				DomainID:       domainID,
+				OperationType:  persistence.DomainAuditOperationTypeFailover,
+				MinCreatedTime: &minTime,
+				MaxCreatedTime: &maxTime,
+				PageSize:       2,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().SelectFromDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, filter *sqlplugin.DomainAuditLogFilter) ([]sqlplugin.DomainAuditLogRow, error) {
+						assert.Equal(t, serialization.MustParseUUID(domainID), filter.DomainID)
+						assert.Equal(t, int(persistence.DomainAuditOperationTypeFailover), filter.OperationType)
+						assert.Equal(t, minTime, filter.MinCreatedTime)
+						assert.Equal(t, maxTime, filter.PageMaxCreatedTime)
+						assert.Equal(t, maxEventID, filter.PageMinEventID)
+						assert.Equal(t, 2, filter.PageSize)
+						return rows, nil
+					})
+			},
+			want: &persistence.InternalGetDomainAuditLogsResponse{
+				AuditLogs: []*persistence.InternalDomainAuditLog{
+					{
+						EventID:         eventID1,
+						DomainID:        domainID,
+						OperationType:   persistence.DomainAuditOperationTypeFailover,
+						CreatedTime:     createdTime1,
+						LastUpdatedTime: createdTime1,
+						Identity:        "test-user",
+						IdentityType:    "user",
+						Comment:         "comment",
+						StateBefore:     persistence.NewDataBlob([]byte("before"), constants.EncodingTypeThriftRWSnappy),
+						StateAfter:      persistence.NewDataBlob([]byte("after"), constants.EncodingTypeThriftRWSnappy),
+					},
+					{
+						EventID:         eventID2,
+						DomainID:        domainID,
+						OperationType:   persistence.DomainAuditOperationTypeFailover,
+						CreatedTime:     createdTime2,
+						LastUpdatedTime: createdTime2,
+					},
+				},
+				NextPageToken: pageToken,
+			},
+		},
+		{
+			name: "Last page",
+			request: &persistence.GetDomainAuditLogsRequest{
+				DomainID:      domainID,
+				OperationType: persistence.DomainAuditOperationTypeFailover,
+				PageSize:      2,
+				NextPageToken: pageToken,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().SelectFromDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, filter *sqlplugin.DomainAuditLogFilter) ([]sqlplugin.DomainAuditLogRow, error) {
+						assert.Equal(t, time.Unix(0, 0).UTC(), filter.MinCreatedTime)
+						assert.Equal(t, createdTime2, filter.PageMaxCreatedTime)
+						assert.Equal(t, serialization.MustParseUUID(eventID2), filter.PageMinEventID)
+						return nil, nil
+					})
+			},
+			want: &persistence.InternalGetDomainAuditLogsResponse{
+				AuditLogs: []*persistence.InternalDomainAuditLog{},
+			},
+		},
+		{
+			name: "Default page size",
+			request: &persistence.GetDomainAuditLogsRequest{
+				DomainID:      domainID,
+				OperationType: persistence.DomainAuditOperationTypeFailover,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().SelectFromDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, filter *sqlplugin.DomainAuditLogFilter) ([]sqlplugin.DomainAuditLogRow, error) {
+						assert.Equal(t, defaultDomainAuditLogPageSize, filter.PageSize)
+						return nil, nil
+					})
+			},
+			want: &persistence.InternalGetDomainAuditLogsResponse{
+				AuditLogs: []*persistence.InternalDomainAuditLog{},
+			},
+		},
+		{
+			name: "Invalid next page token",
+			request: &persistence.GetDomainAuditLogsRequest{
+				DomainID:      domainID,
+				NextPageToken: []byte("invalid"),
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {},
+			wantErr:   &types.BadRequestError{},
+		},
+		{
+			name: "Database error",
+			request: &persistence.GetDomainAuditLogsRequest{
+				DomainID: domainID,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				err := errors.New("db error")
+				mockDB.EXPECT().SelectFromDomainAuditLogs(gomock.Any(), gomock.Any()).Return(nil, err)
+				mockDB.EXPECT().IsNotFoundError(err).Return(false)
+				mockDB.EXPECT().IsTimeoutError(err).Return(true)
+			},
+			wantErr: &persistence.TimeoutError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockDB := sqlplugin.NewMockDB(ctrl)
+			store, err := NewSQLDomainAuditStore(mockDB, testlogger.New(t))
+			require.NoError(t, err, "Failed to create sql domain audit store")
+
+			tc.mockSetup(mockDB)
+			got, err := store.GetDomainAuditLogs(context.Background(), tc.request)
+			if tc.wantErr != nil {
+				assert.IsType(t, tc.wantErr, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
@@ -2073,6 +2073,7 @@ func TestGetWorkflowExecution(t *testing.T) {
 					Attempt:                  106,
 					TaskList:                 "test-task-list",
 					TaskListKind:             types.TaskListKindEphemeral,
+					TaskPriority:             "high",
 					StartedIdentity:          "test-started-identity",
 					HasRetryPolicy:           true,
 					RetryInitialInterval:     time.Duration(107),
@@ -2084,6 +2085,10 @@ func TestGetWorkflowExecution(t *testing.T) {
 					RetryLastFailureReason:   "test-retry-last-failure-reason",
 					RetryLastWorkerIdentity:  "test-retry-last-worker-identity",
 					RetryLastFailureDetails:  []byte("test-retry-last-failure-details"),
+					Paused:                   true,
+					PauseReason:              "test-pause-reason",
+					PauseIdentity:            "test-pause-identity",
+					PausedTimestamp:          time.Unix(17, 18),
 				}, nil)
 				parser.EXPECT().TimerInfoFromBlob(gomock.Any(), gomock.Any()).Return(&serialization.TimerInfo{
 					Version:         101,
@@ -2214,6 +2219,7 @@ func TestGetWorkflowExecution(t *testing.T) {
 							Attempt:                106,
 							TaskList:               "test-task-list",
 							TaskListKind:           types.TaskListKindEphemeral,
+							TaskPriority:           "high",
 							StartedIdentity:        "test-started-identity",
 							HasRetryPolicy:         true,
 							DomainID:               "ff9c8a3f-0e4f-4d3e-a4d2-6f5f8f3f7d9d",
@@ -2226,6 +2232,10 @@ func TestGetWorkflowExecution(t *testing.T) {
 							LastFailureReason:      "test-retry-last-failure-reason",
 							LastWorkerIdentity:     "test-retry-last-worker-identity",
 							LastFailureDetails:     []byte("test-retry-last-failure-details"),
+							Paused:                 true,
+							PauseReason:            "test-pause-reason",
+							PauseIdentity:          "test-pause-identity",
+							PausedTimestamp:        time.Unix(17, 18),
 						},
 					},
 					TimerInfos: map[string]*persistence.TimerInfo{
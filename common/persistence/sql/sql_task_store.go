@@ -445,6 +445,7 @@ func (m *sqlTaskStore) CreateTasks(
 			ExpiryTimestamp:  expiryTime,
 			CreatedTimestamp: time.Now(),
 			PartitionConfig:  v.Data.PartitionConfig,
+			Priority:         v.Data.Priority,
 		})
 		if err != nil {
 			return nil, err
@@ -532,6 +533,7 @@ func (m *sqlTaskStore) GetTasks(
 			Expiry:          info.GetExpiryTimestamp(),
 			CreatedTime:     info.GetCreatedTimestamp(),
 			PartitionConfig: info.GetPartitionConfig(),
+			Priority:        info.GetPriority(),
 		}
 	}
 
@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -30,7 +31,9 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
@@ -41,16 +44,23 @@ import (
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		dc *p.DynamicConfiguration
 	}
 
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
 	}
+
+	// visibilityQueryPageToken is the page token of query based list and scan, the position of the
+	// last row of the page in the order of the query
+	visibilityQueryPageToken struct {
+		After sqlplugin.VisibilityQueryCursor
+	}
 )
 
 // NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger, dc *p.DynamicConfiguration) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
@@ -60,6 +70,7 @@ func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore
 			db:     db,
 			logger: logger,
 		},
+		dc: dc,
 	}, nil
 }
 
@@ -67,7 +78,11 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionStartedRequest,
 ) error {
-	_, err := s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+	searchAttributes, err := s.serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
 		DomainID:         request.DomainUUID,
 		WorkflowID:       request.WorkflowID,
 		RunID:            request.RunID,
@@ -80,6 +95,8 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 		NumClusters:      request.NumClusters,
 		UpdateTime:       request.UpdateTimestamp,
 		ShardID:          request.ShardID,
+		TaskList:         request.TaskList,
+		SearchAttributes: searchAttributes,
 	})
 
 	if err != nil {
@@ -92,6 +109,10 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionClosedRequest,
 ) error {
+	searchAttributes, err := s.serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
 	closeTime := request.CloseTimestamp
 	result, err := s.db.ReplaceIntoVisibility(ctx, &sqlplugin.VisibilityRow{
 		DomainID:         request.DomainUUID,
@@ -109,6 +130,8 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 		NumClusters:      request.NumClusters,
 		UpdateTime:       request.UpdateTimestamp,
 		ShardID:          request.ShardID,
+		TaskList:         request.TaskList,
+		SearchAttributes: searchAttributes,
 	})
 	if err != nil {
 		return convertCommonErrors(s.db, "RecordWorkflowExecutionClosed", "", err)
@@ -136,13 +159,33 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionUninitialized(
 }
 
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(
-	_ context.Context,
+	ctx context.Context,
 	request *p.InternalUpsertWorkflowExecutionRequest,
 ) error {
-	if p.IsNopUpsertWorkflowRequest(request) {
-		return nil
+	searchAttributes, err := s.serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.UpsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+		DomainID:         request.DomainUUID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        request.StartTimestamp,
+		ExecutionTime:    request.ExecutionTimestamp,
+		WorkflowTypeName: request.WorkflowTypeName,
+		Memo:             request.Memo.GetData(),
+		Encoding:         string(request.Memo.GetEncoding()),
+		IsCron:           request.IsCron,
+		NumClusters:      request.NumClusters,
+		UpdateTime:       request.UpdateTimestamp,
+		ShardID:          int16(request.ShardID),
+		TaskList:         request.TaskList,
+		SearchAttributes: searchAttributes,
+	})
+	if err != nil {
+		return convertCommonErrors(s.db, "UpsertWorkflowExecution", "", err)
 	}
-	return p.ErrVisibilityOperationNotSupported
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
@@ -314,24 +357,32 @@ func (s *sqlVisibilityStore) DeleteUninitializedWorkflowExecution(
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	return s.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	return s.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(
-	_ context.Context,
-	_ *p.CountWorkflowExecutionsRequest,
+	ctx context.Context,
+	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	count, err := s.db.CountFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID:         request.DomainUUID,
+		Query:            request.Query,
+		SearchAttributes: s.searchAttributeTypes(),
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "CountWorkflowExecutions", "", err)
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.InternalVisibilityWorkflowExecutionInfo {
@@ -349,6 +400,17 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Internal
 		Memo:          p.NewDataBlob(row.Memo, constants.EncodingType(row.Encoding)),
 		UpdateTime:    row.UpdateTime,
 		ShardID:       row.ShardID,
+		TaskList:      row.TaskList,
+	}
+	if len(row.SearchAttributes) > 0 {
+		searchAttributes, err := deserializeSearchAttributes(row.SearchAttributes)
+		if err != nil {
+			s.logger.Error("failed to deserialize search attributes",
+				tag.WorkflowID(row.WorkflowID),
+				tag.WorkflowRunID(row.RunID),
+				tag.Error(err))
+		}
+		info.SearchAttributes = searchAttributes
 	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
@@ -400,6 +462,118 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []b
 	}, nil
 }
 
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	request *p.ListWorkflowExecutionsByQueryRequest,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	var token visibilityQueryPageToken
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid next page token: %v", err)}
+		}
+	}
+	searchAttributes := s.searchAttributeTypes()
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID:         request.DomainUUID,
+		Query:            request.Query,
+		SearchAttributes: searchAttributes,
+		PageSize:         request.PageSize,
+		After:            token.After,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, opName, "", err)
+	}
+
+	infos := make([]*p.InternalVisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		rows[i].DomainID = request.DomainUUID
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+	var nextPageToken []byte
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		after, err := sqlplugin.NewVisibilityQueryCursor(request.Query, searchAttributes, &rows[len(rows)-1])
+		if err != nil {
+			return nil, err
+		}
+		nextPageToken, err = json.Marshal(&visibilityQueryPageToken{After: after})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// searchAttributeTypes returns the types of the valid search attributes
+func (s *sqlVisibilityStore) searchAttributeTypes() map[string]types.IndexedValueType {
+	validSearchAttributes := definition.GetDefaultIndexedKeys()
+	if s.dc != nil && s.dc.ValidSearchAttributes != nil {
+		validSearchAttributes = s.dc.ValidSearchAttributes()
+	}
+	result := make(map[string]types.IndexedValueType, len(validSearchAttributes))
+	for key, valueType := range validSearchAttributes {
+		result[key] = common.ConvertIndexedValueTypeToInternalType(valueType, s.logger)
+	}
+	return result
+}
+
+// serializeSearchAttributes encodes the search attributes as a JSON object.
+// Datetime values are converted to sqlplugin.VisibilityDatetimeFormat so that they can be compared in queries.
+func (s *sqlVisibilityStore) serializeSearchAttributes(searchAttributes map[string][]byte) ([]byte, error) {
+	if len(searchAttributes) == 0 {
+		return nil, nil
+	}
+	valueTypes := s.searchAttributeTypes()
+	fields := make(map[string]json.RawMessage, len(searchAttributes))
+	for key, value := range searchAttributes {
+		if !json.Valid(value) {
+			// keep values which are not valid JSON as a JSON string
+			value, _ = json.Marshal(string(value))
+		} else if valueTypes[key] == types.IndexedValueTypeDatetime {
+			value = normalizeDatetimeSearchAttribute(value)
+		}
+		fields[key] = value
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("failed to serialize search attributes: %v", err),
+		}
+	}
+	return data, nil
+}
+
+func normalizeDatetimeSearchAttribute(value []byte) []byte {
+	var datetime interface{}
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&datetime); err != nil {
+		return value
+	}
+	normalized, err := sqlplugin.NormalizeVisibilityDatetime(fmt.Sprint(datetime))
+	if err != nil {
+		return value
+	}
+	result, err := json.Marshal(normalized)
+	if err != nil {
+		return value
+	}
+	return result
+}
+
+func deserializeSearchAttributes(data []byte) (map[string]interface{}, error) {
+	var searchAttributes map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&searchAttributes); err != nil {
+		return nil, err
+	}
+	return searchAttributes, nil
+}
+
 func (s *sqlVisibilityStore) deserializePageToken(data []byte) (*visibilityPageToken, error) {
 	var token visibilityPageToken
 	err := json.Unmarshal(data, &token)
@@ -19,6 +19,7 @@ import (
 
 	config "github.com/uber/cadence/common/config"
 	persistence "github.com/uber/cadence/common/persistence"
+	serialization "github.com/uber/cadence/common/persistence/serialization"
 )
 
 // MockPlugin is a mock of Plugin interface.
@@ -99,6 +100,36 @@ func (m *MocktableCRUD) EXPECT() *MocktableCRUDMockRecorder {
 	return m.recorder
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
+// DeleteExpiredDomainAuditLogs mocks base method.
+func (m *MocktableCRUD) DeleteExpiredDomainAuditLogs(ctx context.Context, domainID serialization.UUID, operationType int, expiredBefore time.Time) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredDomainAuditLogs", ctx, domainID, operationType, expiredBefore)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredDomainAuditLogs indicates an expected call of DeleteExpiredDomainAuditLogs.
+func (mr *MocktableCRUDMockRecorder) DeleteExpiredDomainAuditLogs(ctx, domainID, operationType, expiredBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredDomainAuditLogs", reflect.TypeOf((*MocktableCRUD)(nil).DeleteExpiredDomainAuditLogs), ctx, domainID, operationType, expiredBefore)
+}
+
 // DeleteFromActivityInfoMaps mocks base method.
 func (m *MocktableCRUD) DeleteFromActivityInfoMaps(ctx context.Context, filter *ActivityInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +145,21 @@ func (mr *MocktableCRUDMockRecorder) DeleteFromActivityInfoMaps(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromActivityInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromActivityInfoMaps), ctx, filter)
 }
 
+// DeleteFromAsyncWorkflowRequests mocks base method.
+func (m *MocktableCRUD) DeleteFromAsyncWorkflowRequests(ctx context.Context, queueName, requestID string) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromAsyncWorkflowRequests", ctx, queueName, requestID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromAsyncWorkflowRequests indicates an expected call of DeleteFromAsyncWorkflowRequests.
+func (mr *MocktableCRUDMockRecorder) DeleteFromAsyncWorkflowRequests(ctx, queueName, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromAsyncWorkflowRequests", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromAsyncWorkflowRequests), ctx, queueName, requestID)
+}
+
 // DeleteFromBufferedEvents mocks base method.
 func (m *MocktableCRUD) DeleteFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -549,6 +595,21 @@ func (mr *MocktableCRUDMockRecorder) GetTasksCount(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksCount", reflect.TypeOf((*MocktableCRUD)(nil).GetTasksCount), ctx, filter)
 }
 
+// IncrementMAPQSequence mocks base method.
+func (m *MocktableCRUD) IncrementMAPQSequence(ctx context.Context, queueID, queuePath string, count int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementMAPQSequence", ctx, queueID, queuePath, count)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementMAPQSequence indicates an expected call of IncrementMAPQSequence.
+func (mr *MocktableCRUDMockRecorder) IncrementMAPQSequence(ctx, queueID, queuePath, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMAPQSequence", reflect.TypeOf((*MocktableCRUD)(nil).IncrementMAPQSequence), ctx, queueID, queuePath, count)
+}
+
 // InsertAckLevel mocks base method.
 func (m *MocktableCRUD) InsertAckLevel(ctx context.Context, queueType persistence.QueueType, messageID int64, clusterName string) error {
 	m.ctrl.T.Helper()
@@ -577,6 +638,21 @@ func (mr *MocktableCRUDMockRecorder) InsertConfig(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertConfig", reflect.TypeOf((*MocktableCRUD)(nil).InsertConfig), ctx, row)
 }
 
+// InsertIntoAsyncWorkflowRequests mocks base method.
+func (m *MocktableCRUD) InsertIntoAsyncWorkflowRequests(ctx context.Context, row *AsyncWorkflowRequestRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncWorkflowRequests", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncWorkflowRequests indicates an expected call of InsertIntoAsyncWorkflowRequests.
+func (mr *MocktableCRUDMockRecorder) InsertIntoAsyncWorkflowRequests(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncWorkflowRequests", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoAsyncWorkflowRequests), ctx, row)
+}
+
 // InsertIntoBufferedEvents mocks base method.
 func (m *MocktableCRUD) InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -637,6 +713,21 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoDomain(ctx, rows any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoDomain", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoDomain), ctx, rows)
 }
 
+// InsertIntoDomainAuditLog mocks base method.
+func (m *MocktableCRUD) InsertIntoDomainAuditLog(ctx context.Context, row *DomainAuditLogRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoDomainAuditLog", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoDomainAuditLog indicates an expected call of InsertIntoDomainAuditLog.
+func (mr *MocktableCRUDMockRecorder) InsertIntoDomainAuditLog(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoDomainAuditLog", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoDomainAuditLog), ctx, row)
+}
+
 // InsertIntoExecutions mocks base method.
 func (m *MocktableCRUD) InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -682,6 +773,21 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoMAPQItems mocks base method.
+func (m *MocktableCRUD) InsertIntoMAPQItems(ctx context.Context, rows []MAPQItemRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoMAPQItems", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoMAPQItems indicates an expected call of InsertIntoMAPQItems.
+func (mr *MocktableCRUDMockRecorder) InsertIntoMAPQItems(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoMAPQItems", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoMAPQItems), ctx, rows)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MocktableCRUD) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -951,6 +1057,21 @@ func (mr *MocktableCRUDMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromMAPQItems mocks base method.
+func (m *MocktableCRUD) RangeDeleteFromMAPQItems(ctx context.Context, queueID, queuePath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromMAPQItems", ctx, queueID, queuePath, inclusiveMaxOffset)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromMAPQItems indicates an expected call of RangeDeleteFromMAPQItems.
+func (mr *MocktableCRUDMockRecorder) RangeDeleteFromMAPQItems(ctx, queueID, queuePath, inclusiveMaxOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromMAPQItems", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromMAPQItems), ctx, queueID, queuePath, inclusiveMaxOffset)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MocktableCRUD) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1161,6 +1282,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromActivityInfoMaps(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromActivityInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromActivityInfoMaps), ctx, filter)
 }
 
+// SelectFromAsyncWorkflowRequests mocks base method.
+func (m *MocktableCRUD) SelectFromAsyncWorkflowRequests(ctx context.Context, filter *AsyncWorkflowRequestsFilter) ([]AsyncWorkflowRequestRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncWorkflowRequests", ctx, filter)
+	ret0, _ := ret[0].([]AsyncWorkflowRequestRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncWorkflowRequests indicates an expected call of SelectFromAsyncWorkflowRequests.
+func (mr *MocktableCRUDMockRecorder) SelectFromAsyncWorkflowRequests(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncWorkflowRequests", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromAsyncWorkflowRequests), ctx, filter)
+}
+
 // SelectFromBufferedEvents mocks base method.
 func (m *MocktableCRUD) SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error) {
 	m.ctrl.T.Helper()
@@ -1236,6 +1372,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromDomain(ctx, filter any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromDomain", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromDomain), ctx, filter)
 }
 
+// SelectFromDomainAuditLogs mocks base method.
+func (m *MocktableCRUD) SelectFromDomainAuditLogs(ctx context.Context, filter *DomainAuditLogFilter) ([]DomainAuditLogRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromDomainAuditLogs", ctx, filter)
+	ret0, _ := ret[0].([]DomainAuditLogRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromDomainAuditLogs indicates an expected call of SelectFromDomainAuditLogs.
+func (mr *MocktableCRUDMockRecorder) SelectFromDomainAuditLogs(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromDomainAuditLogs", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromDomainAuditLogs), ctx, filter)
+}
+
 // SelectFromDomainMetadata mocks base method.
 func (m *MocktableCRUD) SelectFromDomainMetadata(ctx context.Context) (*DomainMetadataRow, error) {
 	m.ctrl.T.Helper()
@@ -1296,6 +1447,36 @@ func (mr *MocktableCRUDMockRecorder) SelectFromHistoryTree(ctx, filter any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromMAPQItems mocks base method.
+func (m *MocktableCRUD) SelectFromMAPQItems(ctx context.Context, filter *MAPQItemsFilter) ([]MAPQItemRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMAPQItems", ctx, filter)
+	ret0, _ := ret[0].([]MAPQItemRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMAPQItems indicates an expected call of SelectFromMAPQItems.
+func (mr *MocktableCRUDMockRecorder) SelectFromMAPQItems(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMAPQItems", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromMAPQItems), ctx, filter)
+}
+
+// SelectFromMAPQOffsets mocks base method.
+func (m *MocktableCRUD) SelectFromMAPQOffsets(ctx context.Context, queueID string) (*MAPQOffsetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMAPQOffsets", ctx, queueID)
+	ret0, _ := ret[0].(*MAPQOffsetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMAPQOffsets indicates an expected call of SelectFromMAPQOffsets.
+func (mr *MocktableCRUDMockRecorder) SelectFromMAPQOffsets(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMAPQOffsets", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromMAPQOffsets), ctx, queueID)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MocktableCRUD) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1491,6 +1672,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromVisibility(ctx, filter any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MocktableCRUD) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -1548,6 +1744,21 @@ func (mr *MocktableCRUDMockRecorder) UpdateAckLevels(ctx, queueType, clusterAckL
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevels", reflect.TypeOf((*MocktableCRUD)(nil).UpdateAckLevels), ctx, queueType, clusterAckLevels)
 }
 
+// UpdateAsyncWorkflowRequest mocks base method.
+func (m *MocktableCRUD) UpdateAsyncWorkflowRequest(ctx context.Context, row *AsyncWorkflowRequestRow, previousAttempt int) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequest", ctx, row, previousAttempt)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAsyncWorkflowRequest indicates an expected call of UpdateAsyncWorkflowRequest.
+func (mr *MocktableCRUDMockRecorder) UpdateAsyncWorkflowRequest(ctx, row, previousAttempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequest", reflect.TypeOf((*MocktableCRUD)(nil).UpdateAsyncWorkflowRequest), ctx, row, previousAttempt)
+}
+
 // UpdateCurrentExecutions mocks base method.
 func (m *MocktableCRUD) UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1653,6 +1864,36 @@ func (mr *MocktableCRUDMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MocktableCRUD)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpsertIntoMAPQOffsets mocks base method.
+func (m *MocktableCRUD) UpsertIntoMAPQOffsets(ctx context.Context, row *MAPQOffsetsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoMAPQOffsets", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoMAPQOffsets indicates an expected call of UpsertIntoMAPQOffsets.
+func (mr *MocktableCRUDMockRecorder) UpsertIntoMAPQOffsets(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoMAPQOffsets", reflect.TypeOf((*MocktableCRUD)(nil).UpsertIntoMAPQOffsets), ctx, row)
+}
+
+// UpsertIntoVisibility mocks base method.
+func (m *MocktableCRUD) UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoVisibility indicates an expected call of UpsertIntoVisibility.
+func (mr *MocktableCRUDMockRecorder) UpsertIntoVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoVisibility", reflect.TypeOf((*MocktableCRUD)(nil).UpsertIntoVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MocktableCRUD) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -1892,6 +2133,36 @@ func (mr *MockTxMockRecorder) Commit() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTx)(nil).Commit))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockTx) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
+// DeleteExpiredDomainAuditLogs mocks base method.
+func (m *MockTx) DeleteExpiredDomainAuditLogs(ctx context.Context, domainID serialization.UUID, operationType int, expiredBefore time.Time) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredDomainAuditLogs", ctx, domainID, operationType, expiredBefore)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredDomainAuditLogs indicates an expected call of DeleteExpiredDomainAuditLogs.
+func (mr *MockTxMockRecorder) DeleteExpiredDomainAuditLogs(ctx, domainID, operationType, expiredBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredDomainAuditLogs", reflect.TypeOf((*MockTx)(nil).DeleteExpiredDomainAuditLogs), ctx, domainID, operationType, expiredBefore)
+}
+
 // DeleteFromActivityInfoMaps mocks base method.
 func (m *MockTx) DeleteFromActivityInfoMaps(ctx context.Context, filter *ActivityInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1907,6 +2178,21 @@ func (mr *MockTxMockRecorder) DeleteFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromActivityInfoMaps", reflect.TypeOf((*MockTx)(nil).DeleteFromActivityInfoMaps), ctx, filter)
 }
 
+// DeleteFromAsyncWorkflowRequests mocks base method.
+func (m *MockTx) DeleteFromAsyncWorkflowRequests(ctx context.Context, queueName, requestID string) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromAsyncWorkflowRequests", ctx, queueName, requestID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromAsyncWorkflowRequests indicates an expected call of DeleteFromAsyncWorkflowRequests.
+func (mr *MockTxMockRecorder) DeleteFromAsyncWorkflowRequests(ctx, queueName, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromAsyncWorkflowRequests", reflect.TypeOf((*MockTx)(nil).DeleteFromAsyncWorkflowRequests), ctx, queueName, requestID)
+}
+
 // DeleteFromBufferedEvents mocks base method.
 func (m *MockTx) DeleteFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2342,6 +2628,21 @@ func (mr *MockTxMockRecorder) GetTasksCount(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksCount", reflect.TypeOf((*MockTx)(nil).GetTasksCount), ctx, filter)
 }
 
+// IncrementMAPQSequence mocks base method.
+func (m *MockTx) IncrementMAPQSequence(ctx context.Context, queueID, queuePath string, count int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementMAPQSequence", ctx, queueID, queuePath, count)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementMAPQSequence indicates an expected call of IncrementMAPQSequence.
+func (mr *MockTxMockRecorder) IncrementMAPQSequence(ctx, queueID, queuePath, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMAPQSequence", reflect.TypeOf((*MockTx)(nil).IncrementMAPQSequence), ctx, queueID, queuePath, count)
+}
+
 // InsertAckLevel mocks base method.
 func (m *MockTx) InsertAckLevel(ctx context.Context, queueType persistence.QueueType, messageID int64, clusterName string) error {
 	m.ctrl.T.Helper()
@@ -2370,6 +2671,21 @@ func (mr *MockTxMockRecorder) InsertConfig(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertConfig", reflect.TypeOf((*MockTx)(nil).InsertConfig), ctx, row)
 }
 
+// InsertIntoAsyncWorkflowRequests mocks base method.
+func (m *MockTx) InsertIntoAsyncWorkflowRequests(ctx context.Context, row *AsyncWorkflowRequestRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncWorkflowRequests", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncWorkflowRequests indicates an expected call of InsertIntoAsyncWorkflowRequests.
+func (mr *MockTxMockRecorder) InsertIntoAsyncWorkflowRequests(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncWorkflowRequests", reflect.TypeOf((*MockTx)(nil).InsertIntoAsyncWorkflowRequests), ctx, row)
+}
+
 // InsertIntoBufferedEvents mocks base method.
 func (m *MockTx) InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2430,6 +2746,21 @@ func (mr *MockTxMockRecorder) InsertIntoDomain(ctx, rows any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoDomain", reflect.TypeOf((*MockTx)(nil).InsertIntoDomain), ctx, rows)
 }
 
+// InsertIntoDomainAuditLog mocks base method.
+func (m *MockTx) InsertIntoDomainAuditLog(ctx context.Context, row *DomainAuditLogRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoDomainAuditLog", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoDomainAuditLog indicates an expected call of InsertIntoDomainAuditLog.
+func (mr *MockTxMockRecorder) InsertIntoDomainAuditLog(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoDomainAuditLog", reflect.TypeOf((*MockTx)(nil).InsertIntoDomainAuditLog), ctx, row)
+}
+
 // InsertIntoExecutions mocks base method.
 func (m *MockTx) InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2475,6 +2806,21 @@ func (mr *MockTxMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MockTx)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoMAPQItems mocks base method.
+func (m *MockTx) InsertIntoMAPQItems(ctx context.Context, rows []MAPQItemRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoMAPQItems", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoMAPQItems indicates an expected call of InsertIntoMAPQItems.
+func (mr *MockTxMockRecorder) InsertIntoMAPQItems(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoMAPQItems", reflect.TypeOf((*MockTx)(nil).InsertIntoMAPQItems), ctx, rows)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MockTx) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2800,6 +3146,21 @@ func (mr *MockTxMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromMAPQItems mocks base method.
+func (m *MockTx) RangeDeleteFromMAPQItems(ctx context.Context, queueID, queuePath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromMAPQItems", ctx, queueID, queuePath, inclusiveMaxOffset)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromMAPQItems indicates an expected call of RangeDeleteFromMAPQItems.
+func (mr *MockTxMockRecorder) RangeDeleteFromMAPQItems(ctx, queueID, queuePath, inclusiveMaxOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromMAPQItems", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromMAPQItems), ctx, queueID, queuePath, inclusiveMaxOffset)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockTx) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3024,6 +3385,21 @@ func (mr *MockTxMockRecorder) SelectFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromActivityInfoMaps", reflect.TypeOf((*MockTx)(nil).SelectFromActivityInfoMaps), ctx, filter)
 }
 
+// SelectFromAsyncWorkflowRequests mocks base method.
+func (m *MockTx) SelectFromAsyncWorkflowRequests(ctx context.Context, filter *AsyncWorkflowRequestsFilter) ([]AsyncWorkflowRequestRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncWorkflowRequests", ctx, filter)
+	ret0, _ := ret[0].([]AsyncWorkflowRequestRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncWorkflowRequests indicates an expected call of SelectFromAsyncWorkflowRequests.
+func (mr *MockTxMockRecorder) SelectFromAsyncWorkflowRequests(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncWorkflowRequests", reflect.TypeOf((*MockTx)(nil).SelectFromAsyncWorkflowRequests), ctx, filter)
+}
+
 // SelectFromBufferedEvents mocks base method.
 func (m *MockTx) SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error) {
 	m.ctrl.T.Helper()
@@ -3099,6 +3475,21 @@ func (mr *MockTxMockRecorder) SelectFromDomain(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromDomain", reflect.TypeOf((*MockTx)(nil).SelectFromDomain), ctx, filter)
 }
 
+// SelectFromDomainAuditLogs mocks base method.
+func (m *MockTx) SelectFromDomainAuditLogs(ctx context.Context, filter *DomainAuditLogFilter) ([]DomainAuditLogRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromDomainAuditLogs", ctx, filter)
+	ret0, _ := ret[0].([]DomainAuditLogRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromDomainAuditLogs indicates an expected call of SelectFromDomainAuditLogs.
+func (mr *MockTxMockRecorder) SelectFromDomainAuditLogs(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromDomainAuditLogs", reflect.TypeOf((*MockTx)(nil).SelectFromDomainAuditLogs), ctx, filter)
+}
+
 // SelectFromDomainMetadata mocks base method.
 func (m *MockTx) SelectFromDomainMetadata(ctx context.Context) (*DomainMetadataRow, error) {
 	m.ctrl.T.Helper()
@@ -3159,6 +3550,36 @@ func (mr *MockTxMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockTx)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromMAPQItems mocks base method.
+func (m *MockTx) SelectFromMAPQItems(ctx context.Context, filter *MAPQItemsFilter) ([]MAPQItemRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMAPQItems", ctx, filter)
+	ret0, _ := ret[0].([]MAPQItemRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMAPQItems indicates an expected call of SelectFromMAPQItems.
+func (mr *MockTxMockRecorder) SelectFromMAPQItems(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMAPQItems", reflect.TypeOf((*MockTx)(nil).SelectFromMAPQItems), ctx, filter)
+}
+
+// SelectFromMAPQOffsets mocks base method.
+func (m *MockTx) SelectFromMAPQOffsets(ctx context.Context, queueID string) (*MAPQOffsetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMAPQOffsets", ctx, queueID)
+	ret0, _ := ret[0].(*MAPQOffsetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMAPQOffsets indicates an expected call of SelectFromMAPQOffsets.
+func (mr *MockTxMockRecorder) SelectFromMAPQOffsets(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMAPQOffsets", reflect.TypeOf((*MockTx)(nil).SelectFromMAPQOffsets), ctx, queueID)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MockTx) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -3354,6 +3775,21 @@ func (mr *MockTxMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockTx)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockTx) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockTx) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -3411,6 +3847,21 @@ func (mr *MockTxMockRecorder) UpdateAckLevels(ctx, queueType, clusterAckLevels a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevels", reflect.TypeOf((*MockTx)(nil).UpdateAckLevels), ctx, queueType, clusterAckLevels)
 }
 
+// UpdateAsyncWorkflowRequest mocks base method.
+func (m *MockTx) UpdateAsyncWorkflowRequest(ctx context.Context, row *AsyncWorkflowRequestRow, previousAttempt int) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequest", ctx, row, previousAttempt)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAsyncWorkflowRequest indicates an expected call of UpdateAsyncWorkflowRequest.
+func (mr *MockTxMockRecorder) UpdateAsyncWorkflowRequest(ctx, row, previousAttempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequest", reflect.TypeOf((*MockTx)(nil).UpdateAsyncWorkflowRequest), ctx, row, previousAttempt)
+}
+
 // UpdateCurrentExecutions mocks base method.
 func (m *MockTx) UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3516,6 +3967,36 @@ func (mr *MockTxMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockTx)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpsertIntoMAPQOffsets mocks base method.
+func (m *MockTx) UpsertIntoMAPQOffsets(ctx context.Context, row *MAPQOffsetsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoMAPQOffsets", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoMAPQOffsets indicates an expected call of UpsertIntoMAPQOffsets.
+func (mr *MockTxMockRecorder) UpsertIntoMAPQOffsets(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoMAPQOffsets", reflect.TypeOf((*MockTx)(nil).UpsertIntoMAPQOffsets), ctx, row)
+}
+
+// UpsertIntoVisibility mocks base method.
+func (m *MockTx) UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoVisibility indicates an expected call of UpsertIntoVisibility.
+func (mr *MockTxMockRecorder) UpsertIntoVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoVisibility", reflect.TypeOf((*MockTx)(nil).UpsertIntoVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockTx) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -3599,6 +4080,36 @@ func (mr *MockDBMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDB)(nil).Close))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockDB) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
+// DeleteExpiredDomainAuditLogs mocks base method.
+func (m *MockDB) DeleteExpiredDomainAuditLogs(ctx context.Context, domainID serialization.UUID, operationType int, expiredBefore time.Time) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredDomainAuditLogs", ctx, domainID, operationType, expiredBefore)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredDomainAuditLogs indicates an expected call of DeleteExpiredDomainAuditLogs.
+func (mr *MockDBMockRecorder) DeleteExpiredDomainAuditLogs(ctx, domainID, operationType, expiredBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredDomainAuditLogs", reflect.TypeOf((*MockDB)(nil).DeleteExpiredDomainAuditLogs), ctx, domainID, operationType, expiredBefore)
+}
+
 // DeleteFromActivityInfoMaps mocks base method.
 func (m *MockDB) DeleteFromActivityInfoMaps(ctx context.Context, filter *ActivityInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3614,6 +4125,21 @@ func (mr *MockDBMockRecorder) DeleteFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromActivityInfoMaps", reflect.TypeOf((*MockDB)(nil).DeleteFromActivityInfoMaps), ctx, filter)
 }
 
+// DeleteFromAsyncWorkflowRequests mocks base method.
+func (m *MockDB) DeleteFromAsyncWorkflowRequests(ctx context.Context, queueName, requestID string) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromAsyncWorkflowRequests", ctx, queueName, requestID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromAsyncWorkflowRequests indicates an expected call of DeleteFromAsyncWorkflowRequests.
+func (mr *MockDBMockRecorder) DeleteFromAsyncWorkflowRequests(ctx, queueName, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromAsyncWorkflowRequests", reflect.TypeOf((*MockDB)(nil).DeleteFromAsyncWorkflowRequests), ctx, queueName, requestID)
+}
+
 // DeleteFromBufferedEvents mocks base method.
 func (m *MockDB) DeleteFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4063,6 +4589,21 @@ func (mr *MockDBMockRecorder) GetTotalNumDBShards() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalNumDBShards", reflect.TypeOf((*MockDB)(nil).GetTotalNumDBShards))
 }
 
+// IncrementMAPQSequence mocks base method.
+func (m *MockDB) IncrementMAPQSequence(ctx context.Context, queueID, queuePath string, count int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementMAPQSequence", ctx, queueID, queuePath, count)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementMAPQSequence indicates an expected call of IncrementMAPQSequence.
+func (mr *MockDBMockRecorder) IncrementMAPQSequence(ctx, queueID, queuePath, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMAPQSequence", reflect.TypeOf((*MockDB)(nil).IncrementMAPQSequence), ctx, queueID, queuePath, count)
+}
+
 // InsertAckLevel mocks base method.
 func (m *MockDB) InsertAckLevel(ctx context.Context, queueType persistence.QueueType, messageID int64, clusterName string) error {
 	m.ctrl.T.Helper()
@@ -4091,6 +4632,21 @@ func (mr *MockDBMockRecorder) InsertConfig(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertConfig", reflect.TypeOf((*MockDB)(nil).InsertConfig), ctx, row)
 }
 
+// InsertIntoAsyncWorkflowRequests mocks base method.
+func (m *MockDB) InsertIntoAsyncWorkflowRequests(ctx context.Context, row *AsyncWorkflowRequestRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncWorkflowRequests", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncWorkflowRequests indicates an expected call of InsertIntoAsyncWorkflowRequests.
+func (mr *MockDBMockRecorder) InsertIntoAsyncWorkflowRequests(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncWorkflowRequests", reflect.TypeOf((*MockDB)(nil).InsertIntoAsyncWorkflowRequests), ctx, row)
+}
+
 // InsertIntoBufferedEvents mocks base method.
 func (m *MockDB) InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4151,6 +4707,21 @@ func (mr *MockDBMockRecorder) InsertIntoDomain(ctx, rows any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoDomain", reflect.TypeOf((*MockDB)(nil).InsertIntoDomain), ctx, rows)
 }
 
+// InsertIntoDomainAuditLog mocks base method.
+func (m *MockDB) InsertIntoDomainAuditLog(ctx context.Context, row *DomainAuditLogRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoDomainAuditLog", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoDomainAuditLog indicates an expected call of InsertIntoDomainAuditLog.
+func (mr *MockDBMockRecorder) InsertIntoDomainAuditLog(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoDomainAuditLog", reflect.TypeOf((*MockDB)(nil).InsertIntoDomainAuditLog), ctx, row)
+}
+
 // InsertIntoExecutions mocks base method.
 func (m *MockDB) InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4196,6 +4767,21 @@ func (mr *MockDBMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MockDB)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoMAPQItems mocks base method.
+func (m *MockDB) InsertIntoMAPQItems(ctx context.Context, rows []MAPQItemRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoMAPQItems", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoMAPQItems indicates an expected call of InsertIntoMAPQItems.
+func (mr *MockDBMockRecorder) InsertIntoMAPQItems(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoMAPQItems", reflect.TypeOf((*MockDB)(nil).InsertIntoMAPQItems), ctx, rows)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MockDB) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4535,6 +5121,21 @@ func (mr *MockDBMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromMAPQItems mocks base method.
+func (m *MockDB) RangeDeleteFromMAPQItems(ctx context.Context, queueID, queuePath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromMAPQItems", ctx, queueID, queuePath, inclusiveMaxOffset)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromMAPQItems indicates an expected call of RangeDeleteFromMAPQItems.
+func (mr *MockDBMockRecorder) RangeDeleteFromMAPQItems(ctx, queueID, queuePath, inclusiveMaxOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromMAPQItems", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromMAPQItems), ctx, queueID, queuePath, inclusiveMaxOffset)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockDB) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4745,6 +5346,21 @@ func (mr *MockDBMockRecorder) SelectFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromActivityInfoMaps", reflect.TypeOf((*MockDB)(nil).SelectFromActivityInfoMaps), ctx, filter)
 }
 
+// SelectFromAsyncWorkflowRequests mocks base method.
+func (m *MockDB) SelectFromAsyncWorkflowRequests(ctx context.Context, filter *AsyncWorkflowRequestsFilter) ([]AsyncWorkflowRequestRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncWorkflowRequests", ctx, filter)
+	ret0, _ := ret[0].([]AsyncWorkflowRequestRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncWorkflowRequests indicates an expected call of SelectFromAsyncWorkflowRequests.
+func (mr *MockDBMockRecorder) SelectFromAsyncWorkflowRequests(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncWorkflowRequests", reflect.TypeOf((*MockDB)(nil).SelectFromAsyncWorkflowRequests), ctx, filter)
+}
+
 // SelectFromBufferedEvents mocks base method.
 func (m *MockDB) SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error) {
 	m.ctrl.T.Helper()
@@ -4820,6 +5436,21 @@ func (mr *MockDBMockRecorder) SelectFromDomain(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromDomain", reflect.TypeOf((*MockDB)(nil).SelectFromDomain), ctx, filter)
 }
 
+// SelectFromDomainAuditLogs mocks base method.
+func (m *MockDB) SelectFromDomainAuditLogs(ctx context.Context, filter *DomainAuditLogFilter) ([]DomainAuditLogRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromDomainAuditLogs", ctx, filter)
+	ret0, _ := ret[0].([]DomainAuditLogRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromDomainAuditLogs indicates an expected call of SelectFromDomainAuditLogs.
+func (mr *MockDBMockRecorder) SelectFromDomainAuditLogs(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromDomainAuditLogs", reflect.TypeOf((*MockDB)(nil).SelectFromDomainAuditLogs), ctx, filter)
+}
+
 // SelectFromDomainMetadata mocks base method.
 func (m *MockDB) SelectFromDomainMetadata(ctx context.Context) (*DomainMetadataRow, error) {
 	m.ctrl.T.Helper()
@@ -4880,6 +5511,36 @@ func (mr *MockDBMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockDB)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromMAPQItems mocks base method.
+func (m *MockDB) SelectFromMAPQItems(ctx context.Context, filter *MAPQItemsFilter) ([]MAPQItemRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMAPQItems", ctx, filter)
+	ret0, _ := ret[0].([]MAPQItemRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMAPQItems indicates an expected call of SelectFromMAPQItems.
+func (mr *MockDBMockRecorder) SelectFromMAPQItems(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMAPQItems", reflect.TypeOf((*MockDB)(nil).SelectFromMAPQItems), ctx, filter)
+}
+
+// SelectFromMAPQOffsets mocks base method.
+func (m *MockDB) SelectFromMAPQOffsets(ctx context.Context, queueID string) (*MAPQOffsetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMAPQOffsets", ctx, queueID)
+	ret0, _ := ret[0].(*MAPQOffsetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMAPQOffsets indicates an expected call of SelectFromMAPQOffsets.
+func (mr *MockDBMockRecorder) SelectFromMAPQOffsets(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMAPQOffsets", reflect.TypeOf((*MockDB)(nil).SelectFromMAPQOffsets), ctx, queueID)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MockDB) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -5075,6 +5736,21 @@ func (mr *MockDBMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockDB)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockDB) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockDB) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -5132,6 +5808,21 @@ func (mr *MockDBMockRecorder) UpdateAckLevels(ctx, queueType, clusterAckLevels a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevels", reflect.TypeOf((*MockDB)(nil).UpdateAckLevels), ctx, queueType, clusterAckLevels)
 }
 
+// UpdateAsyncWorkflowRequest mocks base method.
+func (m *MockDB) UpdateAsyncWorkflowRequest(ctx context.Context, row *AsyncWorkflowRequestRow, previousAttempt int) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequest", ctx, row, previousAttempt)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAsyncWorkflowRequest indicates an expected call of UpdateAsyncWorkflowRequest.
+func (mr *MockDBMockRecorder) UpdateAsyncWorkflowRequest(ctx, row, previousAttempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequest", reflect.TypeOf((*MockDB)(nil).UpdateAsyncWorkflowRequest), ctx, row, previousAttempt)
+}
+
 // UpdateCurrentExecutions mocks base method.
 func (m *MockDB) UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -5237,6 +5928,36 @@ func (mr *MockDBMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockDB)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpsertIntoMAPQOffsets mocks base method.
+func (m *MockDB) UpsertIntoMAPQOffsets(ctx context.Context, row *MAPQOffsetsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoMAPQOffsets", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoMAPQOffsets indicates an expected call of UpsertIntoMAPQOffsets.
+func (mr *MockDBMockRecorder) UpsertIntoMAPQOffsets(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoMAPQOffsets", reflect.TypeOf((*MockDB)(nil).UpsertIntoMAPQOffsets), ctx, row)
+}
+
+// UpsertIntoVisibility mocks base method.
+func (m *MockDB) UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoVisibility indicates an expected call of UpsertIntoVisibility.
+func (mr *MockDBMockRecorder) UpsertIntoVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoVisibility", reflect.TypeOf((*MockDB)(nil).UpsertIntoVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockDB) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/types"
 )
 
 var (
@@ -541,6 +542,9 @@ type (
 		NumClusters      int16
 		UpdateTime       time.Time
 		ShardID          int16
+		TaskList         string
+		// SearchAttributes is a JSON object of the search attributes of the workflow
+		SearchAttributes []byte
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -557,6 +561,18 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the parameters of a query based read of the executions_visibility table
+	VisibilityQueryFilter struct {
+		DomainID string
+		// Query is a where clause of the visibility query language, optionally followed by an order by clause
+		Query string
+		// SearchAttributes are the types of the valid search attributes
+		SearchAttributes map[string]types.IndexedValueType
+		PageSize         int
+		// After is the cursor of the last row of the previous page, nil for the first page
+		After VisibilityQueryCursor
+	}
+
 	// QueueRow represents a row in queue table
 	QueueRow struct {
 		QueueType      persistence.QueueType
@@ -579,6 +595,88 @@ type (
 		DataEncoding string
 	}
 
+	// AsyncWorkflowRequestRow represents a row in async_workflow_requests table
+	AsyncWorkflowRequestRow struct {
+		QueueName  string
+		RequestID  string
+		DomainName string
+		InDLQ      bool
+		Attempt    int
+		// VisibleAt is the unix nano time from which the request can be leased by a consumer
+		VisibleAt int64
+		CreatedAt int64
+		Payload   []byte
+	}
+
+	// AsyncWorkflowRequestsFilter contains the column names within async_workflow_requests table that
+	// can be used to filter results through a WHERE clause
+	AsyncWorkflowRequestsFilter struct {
+		QueueName string
+		InDLQ     bool
+		// MaxVisibleAt is the inclusive upper bound of visible_at
+		MaxVisibleAt int64
+		PageSize     int
+	}
+
+	// MAPQItemRow represents a row in mapq_items table
+	MAPQItemRow struct {
+		QueueID    string
+		QueuePath  string
+		ItemOffset int64
+		Payload    []byte
+	}
+
+	// MAPQItemsFilter contains the column names within mapq_items table that
+	// can be used to filter results through a WHERE clause
+	MAPQItemsFilter struct {
+		QueueID   string
+		QueuePath string
+		// ExclusiveMinOffset is the exclusive lower bound of item_offset
+		ExclusiveMinOffset int64
+		PageSize           int
+	}
+
+	// MAPQOffsetsRow represents a row in mapq_offsets table
+	MAPQOffsetsRow struct {
+		QueueID string
+		Offsets []byte
+	}
+
+	// DomainAuditLogRow represents a row in domain_audit_log table
+	DomainAuditLogRow struct {
+		DomainID            serialization.UUID
+		OperationType       int
+		CreatedTime         time.Time
+		EventID             serialization.UUID
+		StateBefore         []byte
+		StateBeforeEncoding string
+		StateAfter          []byte
+		StateAfterEncoding  string
+		LastUpdatedTime     time.Time
+		Identity            string
+		IdentityType        string
+		Comment             string
+		// ExpiresAt is the time after which the row is no longer returned, nil if the row never expires
+		ExpiresAt *time.Time
+	}
+
+	// DomainAuditLogFilter contains the column names within domain_audit_log table that
+	// can be used to filter results through a WHERE clause
+	DomainAuditLogFilter struct {
+		DomainID      serialization.UUID
+		OperationType int
+		// MinCreatedTime is the inclusive lower bound of created_time
+		MinCreatedTime time.Time
+		// PageMaxCreatedTime and PageMinEventID are the exclusive upper bound of the page in
+		// (created_time DESC, event_id ASC) order, i.e. rows created at PageMaxCreatedTime are
+		// only returned if their event_id is greater than PageMinEventID
+		PageMaxCreatedTime time.Time
+		PageMinEventID     serialization.UUID
+		// Now is used to skip the expired rows
+		Now      time.Time
+		PageSize int
+	}
+
 	// tableCRUD defines the API for interacting with the database tables
 	tableCRUD interface {
 		InsertIntoDomain(ctx context.Context, rows *DomainRow) (sql.Result, error)
@@ -798,6 +896,15 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+		// only its search attributes, memo and update time are updated
+		UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns a page of the rows matching the query from visibility table
+		// Required filter params - {domainID, query, searchAttributes, pageSize}
+		SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows matching the query in visibility table
+		// Required filter params - {domainID, query, searchAttributes}
+		CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
@@ -811,6 +918,43 @@ type (
 		GetAckLevels(ctx context.Context, queueType persistence.QueueType, forUpdate bool) (map[string]int64, error)
 		GetQueueSize(ctx context.Context, queueType persistence.QueueType) (int64, error)
 
+		// InsertIntoAsyncWorkflowRequests inserts a new row into async_workflow_requests table
+		InsertIntoAsyncWorkflowRequests(ctx context.Context, row *AsyncWorkflowRequestRow) (sql.Result, error)
+		// SelectFromAsyncWorkflowRequests returns the rows of a queue ordered by visible_at
+		// Required filter params - {queueName, inDLQ, maxVisibleAt, pageSize}
+		SelectFromAsyncWorkflowRequests(ctx context.Context, filter *AsyncWorkflowRequestsFilter) ([]AsyncWorkflowRequestRow, error)
+		// UpdateAsyncWorkflowRequest updates the in_dlq, attempt and visible_at columns of a row
+		// only if its attempt is still previousAttempt, so that a request is leased by one consumer at a time.
+		// Callers can check the output of Result.RowsAffected() to see if the row was updated or not
+		UpdateAsyncWorkflowRequest(ctx context.Context, row *AsyncWorkflowRequestRow, previousAttempt int) (sql.Result, error)
+		// DeleteFromAsyncWorkflowRequests deletes a single row
+		// Required params - {queueName, requestID}
+		DeleteFromAsyncWorkflowRequests(ctx context.Context, queueName string, requestID string) (sql.Result, error)
+
+		// InsertIntoMAPQItems inserts one or more rows into mapq_items table
+		InsertIntoMAPQItems(ctx context.Context, rows []MAPQItemRow) (sql.Result, error)
+		// SelectFromMAPQItems returns the rows of a leaf queue ordered by item_offset
+		// Required filter params - {queueID, queuePath, exclusiveMinOffset, pageSize}
+		SelectFromMAPQItems(ctx context.Context, filter *MAPQItemsFilter) ([]MAPQItemRow, error)
+		// RangeDeleteFromMAPQItems deletes the rows of a leaf queue whose item_offset is lower than or equal to inclusiveMaxOffset
+		RangeDeleteFromMAPQItems(ctx context.Context, queueID string, queuePath string, inclusiveMaxOffset int64) (sql.Result, error)
+		// IncrementMAPQSequence adds count to the last offset assigned to the items of a leaf queue and returns the new last offset.
+		// The row of the leaf queue is locked until the transaction ends, so it must be called in a transaction.
+		IncrementMAPQSequence(ctx context.Context, queueID string, queuePath string, count int64) (int64, error)
+		// UpsertIntoMAPQOffsets inserts a row into mapq_offsets table or replaces the offsets of the existing row
+		UpsertIntoMAPQOffsets(ctx context.Context, row *MAPQOffsetsRow) (sql.Result, error)
+		// SelectFromMAPQOffsets returns the row of a MAPQ instance from mapq_offsets table
+		SelectFromMAPQOffsets(ctx context.Context, queueID string) (*MAPQOffsetsRow, error)
+
+		// InsertIntoDomainAuditLog inserts a new row into domain_audit_log table
+		InsertIntoDomainAuditLog(ctx context.Context, row *DomainAuditLogRow) (sql.Result, error)
+		// SelectFromDomainAuditLogs returns the unexpired rows of a domain and operation type
+		// ordered by created_time DESC, event_id ASC
+		// Required filter params - {domainID, operationType, minCreatedTime, pageMaxCreatedTime, pageMinEventID, now, pageSize}
+		SelectFromDomainAuditLogs(ctx context.Context, filter *DomainAuditLogFilter) ([]DomainAuditLogRow, error)
+		// DeleteExpiredDomainAuditLogs deletes the rows of a domain and operation type which expired before the given time
+		DeleteExpiredDomainAuditLogs(ctx context.Context, domainID serialization.UUID, operationType int, expiredBefore time.Time) (sql.Result, error)
+
 		// InsertConfig insert a config entry with version. Return nosqlplugin.NewConditionFailure if the same version of the row_type is existing
 		InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error
 		// SelectLatestConfig returns the config entry of the row_type with the largest(latest) version value
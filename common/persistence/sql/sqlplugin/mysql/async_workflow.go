@@ -0,0 +1,81 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertAsyncWorkflowRequestQuery = `INSERT INTO async_workflow_requests
+(queue_name, request_id, domain_name, in_dlq, attempt, visible_at, created_at, payload)
+VALUES (:queue_name, :request_id, :domain_name, :in_dlq, :attempt, :visible_at, :created_at, :payload)`
+
+	getAsyncWorkflowRequestsQuery = `SELECT queue_name, request_id, domain_name, in_dlq, attempt, visible_at, created_at, payload
+FROM async_workflow_requests WHERE queue_name = ? AND in_dlq = ? AND visible_at <= ? ORDER BY visible_at LIMIT ?`
+
+	updateAsyncWorkflowRequestQuery = `UPDATE async_workflow_requests SET in_dlq = ?, attempt = ?, visible_at = ?
+WHERE queue_name = ? AND request_id = ? AND attempt = ?`
+
+	deleteAsyncWorkflowRequestQuery = `DELETE FROM async_workflow_requests WHERE queue_name = ? AND request_id = ?`
+)
+
+// InsertIntoAsyncWorkflowRequests inserts a new row into async_workflow_requests table
+func (mdb *DB) InsertIntoAsyncWorkflowRequests(ctx context.Context, row *sqlplugin.AsyncWorkflowRequestRow) (sql.Result, error) {
+	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, insertAsyncWorkflowRequestQuery, row)
+}
+
+// SelectFromAsyncWorkflowRequests reads one or more rows from async_workflow_requests table
+func (mdb *DB) SelectFromAsyncWorkflowRequests(ctx context.Context, filter *sqlplugin.AsyncWorkflowRequestsFilter) ([]sqlplugin.AsyncWorkflowRequestRow, error) {
+	var rows []sqlplugin.AsyncWorkflowRequestRow
+	err := mdb.driver.SelectContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		&rows,
+		getAsyncWorkflowRequestsQuery,
+		filter.QueueName,
+		filter.InDLQ,
+		filter.MaxVisibleAt,
+		filter.PageSize)
+	return rows, err
+}
+
+// UpdateAsyncWorkflowRequest updates a row in async_workflow_requests table if its attempt is previousAttempt
+func (mdb *DB) UpdateAsyncWorkflowRequest(ctx context.Context, row *sqlplugin.AsyncWorkflowRequestRow, previousAttempt int) (sql.Result, error) {
+	return mdb.driver.ExecContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		updateAsyncWorkflowRequestQuery,
+		row.InDLQ,
+		row.Attempt,
+		row.VisibleAt,
+		row.QueueName,
+		row.RequestID,
+		previousAttempt)
+}
+
+// DeleteFromAsyncWorkflowRequests deletes a row from async_workflow_requests table
+func (mdb *DB) DeleteFromAsyncWorkflowRequests(ctx context.Context, queueName string, requestID string) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, deleteAsyncWorkflowRequestQuery, queueName, requestID)
+}
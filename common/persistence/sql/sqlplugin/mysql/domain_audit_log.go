@@ -0,0 +1,104 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertDomainAuditLogQuery = `INSERT INTO domain_audit_log
+(domain_id, operation_type, created_time, event_id, state_before, state_before_encoding, state_after, state_after_encoding,
+last_updated_time, identity, identity_type, comment, expires_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	getDomainAuditLogsQuery = `SELECT domain_id, operation_type, created_time, event_id, state_before, state_before_encoding,
+state_after, state_after_encoding, last_updated_time, identity, identity_type, comment
+FROM domain_audit_log WHERE domain_id = ? AND operation_type = ? AND created_time >= ?
+AND (created_time < ? OR (created_time = ? AND event_id > ?))
+AND (expires_at IS NULL OR expires_at > ?)
+ORDER BY created_time DESC, event_id ASC LIMIT ?`
+
+	deleteExpiredDomainAuditLogsQuery = `DELETE FROM domain_audit_log WHERE domain_id = ? AND operation_type = ? AND expires_at <= ?`
+)
+
+// InsertIntoDomainAuditLog inserts a new row into domain_audit_log table
+func (mdb *DB) InsertIntoDomainAuditLog(ctx context.Context, row *sqlplugin.DomainAuditLogRow) (sql.Result, error) {
+	var expiresAt *time.Time
+	if row.ExpiresAt != nil {
+		t := mdb.converter.ToDateTime(*row.ExpiresAt)
+		expiresAt = &t
+	}
+	return mdb.driver.ExecContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		insertDomainAuditLogQuery,
+		row.DomainID,
+		row.OperationType,
+		mdb.converter.ToDateTime(row.CreatedTime),
+		row.EventID,
+		row.StateBefore,
+		row.StateBeforeEncoding,
+		row.StateAfter,
+		row.StateAfterEncoding,
+		mdb.converter.ToDateTime(row.LastUpdatedTime),
+		row.Identity,
+		row.IdentityType,
+		row.Comment,
+		expiresAt)
+}
+
+// SelectFromDomainAuditLogs reads one or more rows from domain_audit_log table
+func (mdb *DB) SelectFromDomainAuditLogs(ctx context.Context, filter *sqlplugin.DomainAuditLogFilter) ([]sqlplugin.DomainAuditLogRow, error) {
+	var rows []sqlplugin.DomainAuditLogRow
+	pageMaxCreatedTime := mdb.converter.ToDateTime(filter.PageMaxCreatedTime)
+	err := mdb.driver.SelectContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		&rows,
+		getDomainAuditLogsQuery,
+		filter.DomainID,
+		filter.OperationType,
+		mdb.converter.ToDateTime(filter.MinCreatedTime),
+		pageMaxCreatedTime,
+		pageMaxCreatedTime,
+		filter.PageMinEventID,
+		mdb.converter.ToDateTime(filter.Now),
+		filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].CreatedTime = mdb.converter.FromDateTime(rows[i].CreatedTime)
+		rows[i].LastUpdatedTime = mdb.converter.FromDateTime(rows[i].LastUpdatedTime)
+	}
+	return rows, nil
+}
+
+// DeleteExpiredDomainAuditLogs deletes the expired rows of a domain and operation type from domain_audit_log table
+func (mdb *DB) DeleteExpiredDomainAuditLogs(ctx context.Context, domainID serialization.UUID, operationType int, expiredBefore time.Time) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, deleteExpiredDomainAuditLogsQuery, domainID, operationType, mdb.converter.ToDateTime(expiredBefore))
+}
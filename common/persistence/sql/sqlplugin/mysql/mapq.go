@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertMAPQItemQuery = `INSERT INTO mapq_items (queue_id, queue_path, item_offset, payload)
+VALUES (:queue_id, :queue_path, :item_offset, :payload)`
+
+	getMAPQItemsQuery = `SELECT queue_id, queue_path, item_offset, payload FROM mapq_items
+WHERE queue_id = ? AND queue_path = ? AND item_offset > ? ORDER BY item_offset LIMIT ?`
+
+	rangeDeleteMAPQItemsQuery = `DELETE FROM mapq_items WHERE queue_id = ? AND queue_path = ? AND item_offset <= ?`
+
+	incrementMAPQSequenceQuery = `INSERT INTO mapq_sequences (queue_id, queue_path, last_offset) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE last_offset = last_offset + VALUES(last_offset)`
+
+	getMAPQSequenceQuery = `SELECT last_offset FROM mapq_sequences WHERE queue_id = ? AND queue_path = ?`
+
+	upsertMAPQOffsetsQuery = `INSERT INTO mapq_offsets (queue_id, offsets) VALUES (:queue_id, :offsets)
+ON DUPLICATE KEY UPDATE offsets = VALUES(offsets)`
+
+	getMAPQOffsetsQuery = `SELECT queue_id, offsets FROM mapq_offsets WHERE queue_id = ?`
+)
+
+// InsertIntoMAPQItems inserts one or more rows into mapq_items table
+func (mdb *DB) InsertIntoMAPQItems(ctx context.Context, rows []sqlplugin.MAPQItemRow) (sql.Result, error) {
+	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, insertMAPQItemQuery, rows)
+}
+
+// SelectFromMAPQItems reads one or more rows from mapq_items table
+func (mdb *DB) SelectFromMAPQItems(ctx context.Context, filter *sqlplugin.MAPQItemsFilter) ([]sqlplugin.MAPQItemRow, error) {
+	var rows []sqlplugin.MAPQItemRow
+	err := mdb.driver.SelectContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		&rows,
+		getMAPQItemsQuery,
+		filter.QueueID,
+		filter.QueuePath,
+		filter.ExclusiveMinOffset,
+		filter.PageSize)
+	return rows, err
+}
+
+// RangeDeleteFromMAPQItems deletes the ack'ed rows of a leaf queue from mapq_items table
+func (mdb *DB) RangeDeleteFromMAPQItems(ctx context.Context, queueID string, queuePath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, rangeDeleteMAPQItemsQuery, queueID, queuePath, inclusiveMaxOffset)
+}
+
+// IncrementMAPQSequence increments the last offset of a leaf queue in mapq_sequences table and returns it
+func (mdb *DB) IncrementMAPQSequence(ctx context.Context, queueID string, queuePath string, count int64) (int64, error) {
+	if _, err := mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, incrementMAPQSequenceQuery, queueID, queuePath, count); err != nil {
+		return 0, err
+	}
+	var lastOffset int64
+	err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &lastOffset, getMAPQSequenceQuery, queueID, queuePath)
+	return lastOffset, err
+}
+
+// UpsertIntoMAPQOffsets inserts or replaces a row in mapq_offsets table
+func (mdb *DB) UpsertIntoMAPQOffsets(ctx context.Context, row *sqlplugin.MAPQOffsetsRow) (sql.Result, error) {
+	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, upsertMAPQOffsetsQuery, row)
+}
+
+// SelectFromMAPQOffsets reads a single row from mapq_offsets table
+func (mdb *DB) SelectFromMAPQOffsets(ctx context.Context, queueID string) (*sqlplugin.MAPQOffsetsRow, error) {
+	var row sqlplugin.MAPQOffsetsRow
+	err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, getMAPQOffsetsQuery, queueID)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
@@ -25,18 +25,33 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, task_list, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, task_list, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, task_list, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE memo = VALUES(memo), encoding = VALUES(encoding), update_time = VALUES(update_time), search_attributes = VALUES(search_attributes)`
+
+	templateQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, update_time, shard_id, search_attributes`
+
+	templateGetWorkflowExecutionsByQuery = `SELECT ` + templateQueryFieldNames + ` FROM executions_visibility
+		 WHERE domain_id = ? AND (%s)
+		 ORDER BY %s
+		 LIMIT ?`
+
+	templateCountWorkflowExecutionsByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ? AND (%s)`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -95,7 +110,9 @@ func (mdb *DB) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.IsCron,
 		row.NumClusters,
 		row.UpdateTime,
-		row.ShardID)
+		row.ShardID,
+		row.TaskList,
+		searchAttributesParam(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -122,7 +139,9 @@ func (mdb *DB) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			row.IsCron,
 			row.NumClusters,
 			row.UpdateTime,
-			row.ShardID)
+			row.ShardID,
+			row.TaskList,
+			searchAttributesParam(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -134,6 +153,84 @@ func (mdb *DB) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	return mdb.driver.ExecContext(ctx, dbShardID, templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
 }
 
+// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+// only its search attributes, memo and update time are updated
+func (mdb *DB) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToDateTime(row.StartTime)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.IsCron,
+		row.NumClusters,
+		row.UpdateTime,
+		row.ShardID,
+		row.TaskList,
+		searchAttributesParam(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of the rows matching the query from visibility table
+func (mdb *DB) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	query, err := sqlplugin.ConvertVisibilityQuery(filter.Query, filter.SearchAttributes, visibilityQueryDialect{})
+	if err != nil {
+		return nil, err
+	}
+	if filter.After != nil {
+		if err := query.StartAfter(filter.After); err != nil {
+			return nil, err
+		}
+	}
+	args := append([]interface{}{filter.DomainID}, mdb.convertQueryArgs(query.Args)...)
+	args = append(args, filter.PageSize)
+
+	var rows []sqlplugin.VisibilityRow
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	err = mdb.driver.SelectContext(ctx, dbShardID, &rows, fmt.Sprintf(templateGetWorkflowExecutionsByQuery, query.Where, query.OrderBy), args...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows matching the query in visibility table
+func (mdb *DB) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	query, err := sqlplugin.ConvertVisibilityQuery(filter.Query, filter.SearchAttributes, visibilityQueryDialect{})
+	if err != nil {
+		return 0, err
+	}
+	args := append([]interface{}{filter.DomainID}, mdb.convertQueryArgs(query.Args)...)
+
+	var count int64
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	err = mdb.driver.GetContext(ctx, dbShardID, &count, fmt.Sprintf(templateCountWorkflowExecutionsByQuery, query.Where), args...)
+	return count, err
+}
+
+func (mdb *DB) convertQueryArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = mdb.converter.ToDateTime(t)
+		}
+	}
+	return args
+}
+
 // SelectFromVisibility reads one or more rows from visibility table
 func (mdb *DB) SelectFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) ([]sqlplugin.VisibilityRow, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
@@ -227,3 +324,30 @@ func (mdb *DB) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// visibilityQueryDialect reads the search attributes from the JSON column of visibility table
+type visibilityQueryDialect struct{}
+
+func (visibilityQueryDialect) SearchAttributeText(key string) string {
+	return fmt.Sprintf(`JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."%s"'))`, key)
+}
+
+func (visibilityQueryDialect) SearchAttributeNumber(key string) string {
+	return fmt.Sprintf(`CAST(JSON_EXTRACT(search_attributes, '$."%s"') AS DECIMAL(65, 10))`, key)
+}
+
+func (visibilityQueryDialect) SearchAttributeBool(key string) string {
+	return fmt.Sprintf(`JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."%s"'))`, key)
+}
+
+func (visibilityQueryDialect) SearchAttributeContains(key string) string {
+	return fmt.Sprintf(`COALESCE(JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$."%s"'), JSON_QUOTE(?)), 0) = 1`, key)
+}
+
+// searchAttributesParam binds the search attributes as text, which is required by JSON columns
+func searchAttributesParam(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertAsyncWorkflowRequestQuery = `INSERT INTO async_workflow_requests
+(queue_name, request_id, domain_name, in_dlq, attempt, visible_at, created_at, payload)
+VALUES (:queue_name, :request_id, :domain_name, :in_dlq, :attempt, :visible_at, :created_at, :payload)`
+
+	getAsyncWorkflowRequestsQuery = `SELECT queue_name, request_id, domain_name, in_dlq, attempt, visible_at, created_at, payload
+FROM async_workflow_requests WHERE queue_name = $1 AND in_dlq = $2 AND visible_at <= $3 ORDER BY visible_at LIMIT $4`
+
+	updateAsyncWorkflowRequestQuery = `UPDATE async_workflow_requests SET in_dlq = $1, attempt = $2, visible_at = $3
+WHERE queue_name = $4 AND request_id = $5 AND attempt = $6`
+
+	deleteAsyncWorkflowRequestQuery = `DELETE FROM async_workflow_requests WHERE queue_name = $1 AND request_id = $2`
+)
+
+// InsertIntoAsyncWorkflowRequests inserts a new row into async_workflow_requests table
+func (pdb *db) InsertIntoAsyncWorkflowRequests(ctx context.Context, row *sqlplugin.AsyncWorkflowRequestRow) (sql.Result, error) {
+	return pdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, insertAsyncWorkflowRequestQuery, row)
+}
+
+// SelectFromAsyncWorkflowRequests reads one or more rows from async_workflow_requests table
+func (pdb *db) SelectFromAsyncWorkflowRequests(ctx context.Context, filter *sqlplugin.AsyncWorkflowRequestsFilter) ([]sqlplugin.AsyncWorkflowRequestRow, error) {
+	var rows []sqlplugin.AsyncWorkflowRequestRow
+	err := pdb.driver.SelectContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		&rows,
+		getAsyncWorkflowRequestsQuery,
+		filter.QueueName,
+		filter.InDLQ,
+		filter.MaxVisibleAt,
+		filter.PageSize)
+	return rows, err
+}
+
+// UpdateAsyncWorkflowRequest updates a row in async_workflow_requests table if its attempt is previousAttempt
+func (pdb *db) UpdateAsyncWorkflowRequest(ctx context.Context, row *sqlplugin.AsyncWorkflowRequestRow, previousAttempt int) (sql.Result, error) {
+	return pdb.driver.ExecContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		updateAsyncWorkflowRequestQuery,
+		row.InDLQ,
+		row.Attempt,
+		row.VisibleAt,
+		row.QueueName,
+		row.RequestID,
+		previousAttempt)
+}
+
+// DeleteFromAsyncWorkflowRequests deletes a row from async_workflow_requests table
+func (pdb *db) DeleteFromAsyncWorkflowRequests(ctx context.Context, queueName string, requestID string) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, deleteAsyncWorkflowRequestQuery, queueName, requestID)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertDomainAuditLogQuery = `INSERT INTO domain_audit_log
+(domain_id, operation_type, created_time, event_id, state_before, state_before_encoding, state_after, state_after_encoding,
+last_updated_time, identity, identity_type, comment, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+	getDomainAuditLogsQuery = `SELECT domain_id, operation_type, created_time, event_id, state_before, state_before_encoding,
+state_after, state_after_encoding, last_updated_time, identity, identity_type, comment
+FROM domain_audit_log WHERE domain_id = $1 AND operation_type = $2 AND created_time >= $3
+AND (created_time < $4 OR (created_time = $4 AND event_id > $5))
+AND (expires_at IS NULL OR expires_at > $6)
+ORDER BY created_time DESC, event_id ASC LIMIT $7`
+
+	deleteExpiredDomainAuditLogsQuery = `DELETE FROM domain_audit_log WHERE domain_id = $1 AND operation_type = $2 AND expires_at <= $3`
+)
+
+// InsertIntoDomainAuditLog inserts a new row into domain_audit_log table
+func (pdb *db) InsertIntoDomainAuditLog(ctx context.Context, row *sqlplugin.DomainAuditLogRow) (sql.Result, error) {
+	var expiresAt *time.Time
+	if row.ExpiresAt != nil {
+		t := pdb.converter.ToPostgresDateTime(*row.ExpiresAt)
+		expiresAt = &t
+	}
+	return pdb.driver.ExecContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		insertDomainAuditLogQuery,
+		row.DomainID,
+		row.OperationType,
+		pdb.converter.ToPostgresDateTime(row.CreatedTime),
+		row.EventID,
+		row.StateBefore,
+		row.StateBeforeEncoding,
+		row.StateAfter,
+		row.StateAfterEncoding,
+		pdb.converter.ToPostgresDateTime(row.LastUpdatedTime),
+		row.Identity,
+		row.IdentityType,
+		row.Comment,
+		expiresAt)
+}
+
+// SelectFromDomainAuditLogs reads one or more rows from domain_audit_log table
+func (pdb *db) SelectFromDomainAuditLogs(ctx context.Context, filter *sqlplugin.DomainAuditLogFilter) ([]sqlplugin.DomainAuditLogRow, error) {
+	var rows []sqlplugin.DomainAuditLogRow
+	err := pdb.driver.SelectContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		&rows,
+		getDomainAuditLogsQuery,
+		filter.DomainID,
+		filter.OperationType,
+		pdb.converter.ToPostgresDateTime(filter.MinCreatedTime),
+		pdb.converter.ToPostgresDateTime(filter.PageMaxCreatedTime),
+		filter.PageMinEventID,
+		pdb.converter.ToPostgresDateTime(filter.Now),
+		filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].CreatedTime = pdb.converter.FromPostgresDateTime(rows[i].CreatedTime)
+		rows[i].LastUpdatedTime = pdb.converter.FromPostgresDateTime(rows[i].LastUpdatedTime)
+	}
+	return rows, nil
+}
+
+// DeleteExpiredDomainAuditLogs deletes the expired rows of a domain and operation type from domain_audit_log table
+func (pdb *db) DeleteExpiredDomainAuditLogs(ctx context.Context, domainID serialization.UUID, operationType int, expiredBefore time.Time) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, deleteExpiredDomainAuditLogsQuery, domainID, operationType, pdb.converter.ToPostgresDateTime(expiredBefore))
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertMAPQItemQuery = `INSERT INTO mapq_items (queue_id, queue_path, item_offset, payload)
+VALUES (:queue_id, :queue_path, :item_offset, :payload)`
+
+	getMAPQItemsQuery = `SELECT queue_id, queue_path, item_offset, payload FROM mapq_items
+WHERE queue_id = $1 AND queue_path = $2 AND item_offset > $3 ORDER BY item_offset LIMIT $4`
+
+	rangeDeleteMAPQItemsQuery = `DELETE FROM mapq_items WHERE queue_id = $1 AND queue_path = $2 AND item_offset <= $3`
+
+	incrementMAPQSequenceQuery = `INSERT INTO mapq_sequences (queue_id, queue_path, last_offset) VALUES ($1, $2, $3)
+ON CONFLICT (queue_id, queue_path) DO UPDATE SET last_offset = mapq_sequences.last_offset + excluded.last_offset
+RETURNING last_offset`
+
+	upsertMAPQOffsetsQuery = `INSERT INTO mapq_offsets (queue_id, offsets) VALUES (:queue_id, :offsets)
+ON CONFLICT (queue_id) DO UPDATE SET offsets = excluded.offsets`
+
+	getMAPQOffsetsQuery = `SELECT queue_id, offsets FROM mapq_offsets WHERE queue_id = $1`
+)
+
+// InsertIntoMAPQItems inserts one or more rows into mapq_items table
+func (pdb *db) InsertIntoMAPQItems(ctx context.Context, rows []sqlplugin.MAPQItemRow) (sql.Result, error) {
+	return pdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, insertMAPQItemQuery, rows)
+}
+
+// SelectFromMAPQItems reads one or more rows from mapq_items table
+func (pdb *db) SelectFromMAPQItems(ctx context.Context, filter *sqlplugin.MAPQItemsFilter) ([]sqlplugin.MAPQItemRow, error) {
+	var rows []sqlplugin.MAPQItemRow
+	err := pdb.driver.SelectContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		&rows,
+		getMAPQItemsQuery,
+		filter.QueueID,
+		filter.QueuePath,
+		filter.ExclusiveMinOffset,
+		filter.PageSize)
+	return rows, err
+}
+
+// RangeDeleteFromMAPQItems deletes the ack'ed rows of a leaf queue from mapq_items table
+func (pdb *db) RangeDeleteFromMAPQItems(ctx context.Context, queueID string, queuePath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, rangeDeleteMAPQItemsQuery, queueID, queuePath, inclusiveMaxOffset)
+}
+
+// IncrementMAPQSequence increments the last offset of a leaf queue in mapq_sequences table and returns it
+func (pdb *db) IncrementMAPQSequence(ctx context.Context, queueID string, queuePath string, count int64) (int64, error) {
+	var lastOffset int64
+	err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &lastOffset, incrementMAPQSequenceQuery, queueID, queuePath, count)
+	return lastOffset, err
+}
+
+// UpsertIntoMAPQOffsets inserts or replaces a row in mapq_offsets table
+func (pdb *db) UpsertIntoMAPQOffsets(ctx context.Context, row *sqlplugin.MAPQOffsetsRow) (sql.Result, error) {
+	return pdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, upsertMAPQOffsetsQuery, row)
+}
+
+// SelectFromMAPQOffsets reads a single row from mapq_offsets table
+func (pdb *db) SelectFromMAPQOffsets(ctx context.Context, queueID string) (*sqlplugin.MAPQOffsetsRow, error) {
+	var row sqlplugin.MAPQOffsetsRow
+	err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, getMAPQOffsetsQuery, queueID)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
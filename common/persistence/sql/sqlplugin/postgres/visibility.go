@@ -26,19 +26,31 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, task_list, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
          ON CONFLICT (domain_id, run_id) DO NOTHING`
 
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, task_list, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+         ON CONFLICT (domain_id, run_id) DO UPDATE
+		  SET memo = excluded.memo,
+		      encoding = excluded.encoding,
+		      update_time = excluded.update_time,
+		      search_attributes = excluded.search_attributes`
+
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, task_list, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		ON CONFLICT (domain_id, run_id) DO UPDATE
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -52,7 +64,9 @@ const (
 				is_cron = excluded.is_cron,
 				num_clusters = excluded.num_clusters,
 				update_time = excluded.update_time,
-				shard_id = excluded.shard_id`
+				shard_id = excluded.shard_id,
+				task_list = excluded.task_list,
+				search_attributes = excluded.search_attributes`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND domain_id = $1
@@ -95,6 +109,16 @@ const (
 		 AND run_id = $2`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=$1 AND run_id=$2"
+
+	templateQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, update_time, shard_id, search_attributes`
+
+	// the bind variables of the queries are rebound from ? as the conditions are created by sqlplugin.ConvertVisibilityQuery
+	templateGetWorkflowExecutionsByQuery = `SELECT ` + templateQueryFieldNames + ` FROM executions_visibility
+		 WHERE domain_id = ? AND (%s)
+		 ORDER BY %s
+		 LIMIT ?`
+
+	templateCountWorkflowExecutionsByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ? AND (%s)`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -116,7 +140,9 @@ func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.IsCron,
 		row.NumClusters,
 		row.UpdateTime,
-		row.ShardID)
+		row.ShardID,
+		row.TaskList,
+		searchAttributesParam(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -141,7 +167,9 @@ func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			row.IsCron,
 			row.NumClusters,
 			row.UpdateTime,
-			row.ShardID)
+			row.ShardID,
+			row.TaskList,
+			searchAttributesParam(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -153,6 +181,85 @@ func (pdb *db) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	return pdb.driver.ExecContext(ctx, dbShardID, templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
 }
 
+// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+// only its search attributes, memo and update time are updated
+func (pdb *db) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	row.StartTime = pdb.converter.ToPostgresDateTime(row.StartTime)
+	return pdb.driver.ExecContext(ctx, dbShardID, templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.IsCron,
+		row.NumClusters,
+		row.UpdateTime,
+		row.ShardID,
+		row.TaskList,
+		searchAttributesParam(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of the rows matching the query from visibility table
+func (pdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	query, err := sqlplugin.ConvertVisibilityQuery(filter.Query, filter.SearchAttributes, visibilityQueryDialect{})
+	if err != nil {
+		return nil, err
+	}
+	if filter.After != nil {
+		if err := query.StartAfter(filter.After); err != nil {
+			return nil, err
+		}
+	}
+	args := append([]interface{}{filter.DomainID}, pdb.convertQueryArgs(query.Args)...)
+	args = append(args, filter.PageSize)
+	qry := sqlx.Rebind(sqlx.DOLLAR, fmt.Sprintf(templateGetWorkflowExecutionsByQuery, query.Where, query.OrderBy))
+
+	var rows []sqlplugin.VisibilityRow
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, qry, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgresDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows matching the query in visibility table
+func (pdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	query, err := sqlplugin.ConvertVisibilityQuery(filter.Query, filter.SearchAttributes, visibilityQueryDialect{})
+	if err != nil {
+		return 0, err
+	}
+	args := append([]interface{}{filter.DomainID}, pdb.convertQueryArgs(query.Args)...)
+	qry := sqlx.Rebind(sqlx.DOLLAR, fmt.Sprintf(templateCountWorkflowExecutionsByQuery, query.Where))
+
+	var count int64
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	err = pdb.driver.GetContext(ctx, dbShardID, &count, qry, args...)
+	return count, err
+}
+
+func (pdb *db) convertQueryArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = pdb.converter.ToPostgresDateTime(t)
+		}
+	}
+	return args
+}
+
 // SelectFromVisibility reads one or more rows from visibility table
 func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) ([]sqlplugin.VisibilityRow, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
@@ -242,3 +349,30 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// visibilityQueryDialect reads the search attributes from the JSONB column of visibility table
+type visibilityQueryDialect struct{}
+
+func (visibilityQueryDialect) SearchAttributeText(key string) string {
+	return fmt.Sprintf(`(search_attributes->>'%s')`, key)
+}
+
+func (visibilityQueryDialect) SearchAttributeNumber(key string) string {
+	return fmt.Sprintf(`(search_attributes->>'%s')::numeric`, key)
+}
+
+func (visibilityQueryDialect) SearchAttributeBool(key string) string {
+	return fmt.Sprintf(`(search_attributes->>'%s')`, key)
+}
+
+func (visibilityQueryDialect) SearchAttributeContains(key string) string {
+	return fmt.Sprintf(`COALESCE((search_attributes->'%s') @> to_jsonb(?::text), FALSE)`, key)
+}
+
+// searchAttributesParam binds the search attributes as text, which is required by JSONB columns
+func searchAttributesParam(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
+}
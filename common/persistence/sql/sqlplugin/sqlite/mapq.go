@@ -0,0 +1,50 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	incrementMAPQSequenceQuery = `INSERT INTO mapq_sequences (queue_id, queue_path, last_offset) VALUES (?, ?, ?)
+ON CONFLICT (queue_id, queue_path) DO UPDATE SET last_offset = mapq_sequences.last_offset + excluded.last_offset
+RETURNING last_offset`
+
+	upsertMAPQOffsetsQuery = `INSERT INTO mapq_offsets (queue_id, offsets) VALUES (:queue_id, :offsets)
+ON CONFLICT (queue_id) DO UPDATE SET offsets = excluded.offsets`
+)
+
+// IncrementMAPQSequence increments the last offset of a leaf queue in mapq_sequences table and returns it
+func (mdb *DB) IncrementMAPQSequence(ctx context.Context, queueID string, queuePath string, count int64) (int64, error) {
+	var lastOffset int64
+	err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &lastOffset, incrementMAPQSequenceQuery, queueID, queuePath, count)
+	return lastOffset, err
+}
+
+// UpsertIntoMAPQOffsets inserts or replaces a row in mapq_offsets table
+func (mdb *DB) UpsertIntoMAPQOffsets(ctx context.Context, row *sqlplugin.MAPQOffsetsRow) (sql.Result, error) {
+	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, upsertMAPQOffsetsQuery, row)
+}
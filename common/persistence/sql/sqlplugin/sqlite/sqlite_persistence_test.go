@@ -89,7 +89,7 @@ func TestSQLiteExecutionManagerWithEventsV2(t *testing.T) {
 }
 
 func TestSQLiteVisibilityPersistenceSuite(t *testing.T) {
-	s := new(pt.DBVisibilityPersistenceSuite)
+	s := new(pt.SQLVisibilityPersistenceSuite)
 	option := GetTestClusterOption()
 	s.TestBase = pt.NewTestBaseWithSQL(t, option)
 	s.TestBase.Setup()
@@ -113,5 +113,9 @@ func TestSQLiteConfigPersistence(t *testing.T) {
 }
 
 func TestSQLiteDomainAuditPersistence(t *testing.T) {
-	t.Skip("DomainAuditPersistence is only implemented for NoSQL/Cassandra in this PR")
+	s := new(pt.DomainAuditPersistenceSuite)
+	option := GetTestClusterOption()
+	s.TestBase = pt.NewTestBaseWithSQL(t, option)
+	s.TestBase.Setup()
+	suite.Run(t, s)
 }
@@ -0,0 +1,767 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+// VisibilityDatetimeFormat is the format of the datetime search attributes stored in the visibility table.
+// The values are stored in UTC with a fixed width so that their lexical order is chronological.
+const VisibilityDatetimeFormat = "2006-01-02T15:04:05.000000000Z"
+
+const (
+	visibilityQueryMissingValue = "missing"
+	// likeEscapeChar is the escape character of the LIKE patterns created for string search attributes,
+	// it is the same on all databases unlike the backslash
+	likeEscapeChar = "!"
+)
+
+type (
+	// VisibilityQueryDialect renders the database specific parts of a visibility query.
+	// Keys are names of custom search attributes which consist of letters, digits, '_' and '-' only.
+	VisibilityQueryDialect interface {
+		// SearchAttributeText returns an expression of the text value of the search attribute
+		SearchAttributeText(key string) string
+		// SearchAttributeNumber returns an expression of the numeric value of the search attribute
+		SearchAttributeNumber(key string) string
+		// SearchAttributeBool returns an expression of the value of a bool search attribute as 'true' or 'false'
+		SearchAttributeBool(key string) string
+		// SearchAttributeContains returns a condition with a single bind variable which is true if the search attribute
+		// is equal to the bind variable or is an array containing it. The condition must not be NULL.
+		SearchAttributeContains(key string) string
+	}
+
+	// VisibilityQuery is a visibility query converted to SQL. Bind variables are "?" in Where.
+	// The rows are ordered by OrderBy, whose last key is run_id so that the order is total, and the pages
+	// are read with keyset pagination: StartAfter adds the condition of the rows following the cursor
+	// of the last row of the previous page, see NewVisibilityQueryCursor.
+	VisibilityQuery struct {
+		Where   string
+		Args    []interface{}
+		OrderBy string
+
+		orderKeys []visibilityOrderKey
+	}
+
+	// VisibilityQueryCursor is the position of a row in the order of a visibility query: the values of the
+	// order keys of the row in their text form, nil for NULL.
+	VisibilityQueryCursor []*string
+
+	// visibilityCondition is a SQL condition with its bind variables
+	visibilityCondition struct {
+		sql  string
+		args []interface{}
+	}
+
+	// visibilityOrderKey is a key of the order of a visibility query
+	visibilityOrderKey struct {
+		operand    *visibilityQueryOperand
+		expression string
+		descending bool
+	}
+
+	visibilityQueryColumn struct {
+		name      string
+		valueType types.IndexedValueType
+	}
+
+	visibilityQueryOperand struct {
+		key       string
+		column    string
+		valueType types.IndexedValueType
+		custom    bool
+	}
+
+	visibilityQueryConverter struct {
+		searchAttributes map[string]types.IndexedValueType
+		dialect          VisibilityQueryDialect
+		args             []interface{}
+	}
+)
+
+var (
+	visibilityQueryDefaultOrder = []visibilityOrderKey{
+		{operand: builtinOperand(definition.StartTime), descending: true},
+	}
+
+	// visibilityQueryNullableColumns are the columns which are NULL for open workflows
+	visibilityQueryNullableColumns = map[string]bool{
+		"close_time":     true,
+		"close_status":   true,
+		"history_length": true,
+	}
+
+	visibilityQueryColumns = map[string]visibilityQueryColumn{
+		definition.DomainID:      {name: "domain_id", valueType: types.IndexedValueTypeKeyword},
+		definition.WorkflowID:    {name: "workflow_id", valueType: types.IndexedValueTypeKeyword},
+		definition.RunID:         {name: "run_id", valueType: types.IndexedValueTypeKeyword},
+		definition.WorkflowType:  {name: "workflow_type_name", valueType: types.IndexedValueTypeKeyword},
+		definition.TaskList:      {name: "task_list", valueType: types.IndexedValueTypeKeyword},
+		definition.StartTime:     {name: "start_time", valueType: types.IndexedValueTypeDatetime},
+		definition.ExecutionTime: {name: "execution_time", valueType: types.IndexedValueTypeDatetime},
+		definition.CloseTime:     {name: "close_time", valueType: types.IndexedValueTypeDatetime},
+		definition.UpdateTime:    {name: "update_time", valueType: types.IndexedValueTypeDatetime},
+		definition.CloseStatus:   {name: "close_status", valueType: types.IndexedValueTypeInt},
+		definition.HistoryLength: {name: "history_length", valueType: types.IndexedValueTypeInt},
+		definition.NumClusters:   {name: "num_clusters", valueType: types.IndexedValueTypeInt},
+		definition.IsCron:        {name: "is_cron", valueType: types.IndexedValueTypeBool},
+	}
+
+	searchAttributeKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+// ConvertVisibilityQuery converts a query of the visibility query language to SQL.
+// Custom search attributes may be prefixed with "Attr." as done by the query validator of the frontend.
+// Errors are returned as BadRequestError.
+func ConvertVisibilityQuery(
+	query string,
+	searchAttributes map[string]types.IndexedValueType,
+	dialect VisibilityQueryDialect,
+) (*VisibilityQuery, error) {
+	sel, err := parseVisibilityQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &visibilityQueryConverter{
+		searchAttributes: searchAttributes,
+		dialect:          dialect,
+	}
+	result := &VisibilityQuery{Where: "1 = 1"}
+	if sel != nil && sel.Where != nil {
+		where, err := c.convertExpr(sel.Where.Expr)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+		result.Where = where
+		result.Args = c.args
+	}
+	result.orderKeys, err = c.orderKeys(sel)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	var orderBy []string
+	for i := range result.orderKeys {
+		key := &result.orderKeys[i]
+		key.expression = c.operandExpression(key.operand)
+		orderBy = append(orderBy, key.orderBy()...)
+	}
+	result.OrderBy = strings.Join(orderBy, ", ")
+	return result, nil
+}
+
+// NewVisibilityQueryCursor returns the cursor of the row in the order of the query, which is passed to
+// StartAfter to read the rows following it.
+func NewVisibilityQueryCursor(
+	query string,
+	searchAttributes map[string]types.IndexedValueType,
+	row *VisibilityRow,
+) (VisibilityQueryCursor, error) {
+	sel, err := parseVisibilityQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	c := &visibilityQueryConverter{searchAttributes: searchAttributes}
+	keys, err := c.orderKeys(sel)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+
+	var customValues map[string]interface{}
+	if len(row.SearchAttributes) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(row.SearchAttributes))
+		decoder.UseNumber()
+		if err := decoder.Decode(&customValues); err != nil {
+			return nil, fmt.Errorf("invalid search attributes of workflow %s: %w", row.WorkflowID, err)
+		}
+	}
+	cursor := make(VisibilityQueryCursor, 0, len(keys))
+	for _, key := range keys {
+		var value *string
+		if key.operand.custom {
+			value, err = customValueText(key.operand.key, customValues[key.operand.key])
+		} else {
+			value = columnValueText(key.operand.column, row)
+		}
+		if err != nil {
+			return nil, err
+		}
+		cursor = append(cursor, value)
+	}
+	return cursor, nil
+}
+
+// StartAfter restricts the query to the rows which follow the cursor in its order
+func (q *VisibilityQuery) StartAfter(cursor VisibilityQueryCursor) error {
+	if len(cursor) != len(q.orderKeys) {
+		return &types.BadRequestError{Message: "Invalid next page token: it does not match the order of the query."}
+	}
+
+	var conditions []string
+	var args []interface{}
+	var equal []visibilityCondition
+	for i, key := range q.orderKeys {
+		var value interface{}
+		if cursor[i] != nil {
+			var err error
+			if value, err = (&visibilityQueryConverter{}).parseValue(key.operand, *cursor[i]); err != nil {
+				return &types.BadRequestError{Message: "Invalid next page token: " + err.Error()}
+			}
+		}
+		// the rows equal to the cursor on the previous keys and after it on this key
+		if after, ok := key.after(value); ok {
+			var terms []string
+			for _, e := range equal {
+				terms = append(terms, e.sql)
+				args = append(args, e.args...)
+			}
+			conditions = append(conditions, strings.Join(append(terms, after.sql), " AND "))
+			args = append(args, after.args...)
+		}
+		equal = append(equal, key.equal(value))
+	}
+	if len(conditions) == 0 {
+		// the cursor is the last row of the order
+		conditions = append(conditions, "1 = 0")
+	}
+	q.Where = fmt.Sprintf("(%s) AND (%s)", q.Where, strings.Join(conditions, " OR "))
+	q.Args = append(q.Args, args...)
+	return nil
+}
+
+// orderBy returns the order by terms of the key. NULLs come before the other values, i.e. first in
+// ascending order and last in descending order, the same on all databases.
+func (k visibilityOrderKey) orderBy() []string {
+	direction := "ASC"
+	if k.descending {
+		direction = "DESC"
+	}
+	if !k.nullable() {
+		return []string{fmt.Sprintf("%s %s", k.expression, direction)}
+	}
+	nullsDirection := "DESC"
+	if k.descending {
+		nullsDirection = "ASC"
+	}
+	return []string{
+		fmt.Sprintf("(%s IS NULL) %s", k.expression, nullsDirection),
+		fmt.Sprintf("%s %s", k.expression, direction),
+	}
+}
+
+func (k visibilityOrderKey) nullable() bool {
+	return k.operand.custom || visibilityQueryNullableColumns[k.operand.column]
+}
+
+// after returns the condition of the values of the key which follow the value, false if there is none
+func (k visibilityOrderKey) after(value interface{}) (visibilityCondition, bool) {
+	operator := ">"
+	if k.descending {
+		operator = "<"
+	}
+	switch {
+	case value == nil && k.descending:
+		return visibilityCondition{}, false
+	case value == nil:
+		return visibilityCondition{sql: fmt.Sprintf("%s IS NOT NULL", k.expression)}, true
+	case k.descending && k.nullable():
+		return visibilityCondition{
+			sql:  fmt.Sprintf("(%s %s ? OR %s IS NULL)", k.expression, operator, k.expression),
+			args: []interface{}{value},
+		}, true
+	default:
+		return visibilityCondition{sql: fmt.Sprintf("%s %s ?", k.expression, operator), args: []interface{}{value}}, true
+	}
+}
+
+// equal returns the condition of the values of the key which are equal to the value
+func (k visibilityOrderKey) equal(value interface{}) visibilityCondition {
+	if value == nil {
+		return visibilityCondition{sql: fmt.Sprintf("%s IS NULL", k.expression)}
+	}
+	return visibilityCondition{sql: fmt.Sprintf("%s = ?", k.expression), args: []interface{}{value}}
+}
+
+// parseVisibilityQuery parses the query, it returns nil for an empty query
+func parseVisibilityQuery(query string) (*sqlparser.Select, error) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return nil, nil
+	}
+
+	// IMPORTANT: the placeholder query is never executed, it is only used to parse the query
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: "Invalid query: " + err.Error()}
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, &types.BadRequestError{Message: "Invalid select query."}
+	}
+	if sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return nil, &types.BadRequestError{Message: "Only where and order by clauses are supported in the query."}
+	}
+	return sel, nil
+}
+
+func (c *visibilityQueryConverter) convertExpr(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertBinaryExpr(expr.Left, expr.Right, "AND")
+	case *sqlparser.OrExpr:
+		return c.convertBinaryExpr(expr.Left, expr.Right, "OR")
+	case *sqlparser.NotExpr:
+		inner, err := c.convertExpr(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("NOT (%s)", inner), nil
+	case *sqlparser.ParenExpr:
+		inner, err := c.convertExpr(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s)", inner), nil
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(expr)
+	default:
+		return "", fmt.Errorf("invalid where clause: %s", sqlparser.String(expr))
+	}
+}
+
+func (c *visibilityQueryConverter) convertBinaryExpr(left, right sqlparser.Expr, operator string) (string, error) {
+	leftStr, err := c.convertExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightStr, err := c.convertExpr(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", leftStr, operator, rightStr), nil
+}
+
+func (c *visibilityQueryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (string, error) {
+	operand, err := c.resolveOperand(expr.Left)
+	if err != nil {
+		return "", err
+	}
+
+	if isMissingValue(expr.Right) {
+		expression := operand.column
+		if operand.custom {
+			expression = c.dialect.SearchAttributeText(operand.key)
+		}
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			return fmt.Sprintf("%s IS NULL", expression), nil
+		case sqlparser.NotEqualStr:
+			return fmt.Sprintf("%s IS NOT NULL", expression), nil
+		default:
+			return "", fmt.Errorf("operator %q is not supported with %s", expr.Operator, visibilityQueryMissingValue)
+		}
+	}
+
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		return c.convertInExpr(operand, expr)
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		return c.convertLikeExpr(operand, expr)
+	case sqlparser.EqualStr, sqlparser.NotEqualStr,
+		sqlparser.LessThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+	default:
+		return "", fmt.Errorf("operator %q is not supported", expr.Operator)
+	}
+
+	value, err := c.convertValue(operand, expr.Right)
+	if err != nil {
+		return "", err
+	}
+	if !operand.custom {
+		return c.bind(fmt.Sprintf("%s %s ?", operand.column, expr.Operator), value), nil
+	}
+
+	isEquality := expr.Operator == sqlparser.EqualStr || expr.Operator == sqlparser.NotEqualStr
+	switch operand.valueType {
+	case types.IndexedValueTypeKeyword:
+		if isEquality {
+			condition := c.bind(c.dialect.SearchAttributeContains(operand.key), value)
+			if expr.Operator == sqlparser.NotEqualStr {
+				return fmt.Sprintf("NOT (%s)", condition), nil
+			}
+			return condition, nil
+		}
+		return c.bind(fmt.Sprintf("%s %s ?", c.dialect.SearchAttributeText(operand.key), expr.Operator), value), nil
+	case types.IndexedValueTypeString:
+		if isEquality {
+			// string search attributes are matched partially like the full text search of Elasticsearch
+			text := c.dialect.SearchAttributeText(operand.key)
+			pattern := "%" + escapeLikePattern(value.(string)) + "%"
+			if expr.Operator == sqlparser.NotEqualStr {
+				return c.bind(fmt.Sprintf("(%s IS NULL OR %s NOT LIKE ? ESCAPE '%s')", text, text, likeEscapeChar), pattern), nil
+			}
+			return c.bind(fmt.Sprintf("%s LIKE ? ESCAPE '%s'", text, likeEscapeChar), pattern), nil
+		}
+		return c.bind(fmt.Sprintf("%s %s ?", c.dialect.SearchAttributeText(operand.key), expr.Operator), value), nil
+	case types.IndexedValueTypeBool:
+		if !isEquality {
+			return "", fmt.Errorf("operator %q is not supported for bool search attribute %s", expr.Operator, operand.key)
+		}
+		return c.bind(fmt.Sprintf("%s %s ?", c.dialect.SearchAttributeBool(operand.key), expr.Operator), value), nil
+	default:
+		return c.bind(fmt.Sprintf("%s %s ?", c.operandExpression(operand), expr.Operator), value), nil
+	}
+}
+
+func (c *visibilityQueryConverter) convertInExpr(operand *visibilityQueryOperand, expr *sqlparser.ComparisonExpr) (string, error) {
+	tuple, ok := expr.Right.(sqlparser.ValTuple)
+	if !ok || len(tuple) == 0 {
+		return "", fmt.Errorf("invalid IN expression on %s", operand.key)
+	}
+	values := make([]interface{}, len(tuple))
+	for i, val := range tuple {
+		value, err := c.convertValue(operand, val)
+		if err != nil {
+			return "", err
+		}
+		values[i] = value
+	}
+
+	var condition string
+	switch {
+	case operand.custom && operand.valueType == types.IndexedValueTypeKeyword:
+		conditions := make([]string, len(values))
+		for i, value := range values {
+			conditions[i] = c.bind(c.dialect.SearchAttributeContains(operand.key), value)
+		}
+		condition = "(" + strings.Join(conditions, " OR ") + ")"
+	case operand.custom && operand.valueType == types.IndexedValueTypeBool:
+		return "", fmt.Errorf("operator %q is not supported for bool search attribute %s", expr.Operator, operand.key)
+	default:
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+		condition = c.bind(fmt.Sprintf("%s IN (%s)", c.operandExpression(operand), placeholders), values...)
+	}
+	if expr.Operator == sqlparser.NotInStr {
+		return fmt.Sprintf("NOT %s", condition), nil
+	}
+	return condition, nil
+}
+
+func (c *visibilityQueryConverter) convertLikeExpr(operand *visibilityQueryOperand, expr *sqlparser.ComparisonExpr) (string, error) {
+	if operand.valueType != types.IndexedValueTypeKeyword && operand.valueType != types.IndexedValueTypeString {
+		return "", fmt.Errorf("operator %q is only supported for string and keyword search attributes", expr.Operator)
+	}
+	value, err := c.convertValue(operand, expr.Right)
+	if err != nil {
+		return "", err
+	}
+	return c.bind(fmt.Sprintf("%s %s ?", c.operandExpression(operand), strings.ToUpper(expr.Operator)), value), nil
+}
+
+func (c *visibilityQueryConverter) convertRangeCond(expr *sqlparser.RangeCond) (string, error) {
+	operand, err := c.resolveOperand(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	if operand.valueType == types.IndexedValueTypeBool {
+		return "", fmt.Errorf("operator %q is not supported for bool search attribute %s", expr.Operator, operand.key)
+	}
+	from, err := c.convertValue(operand, expr.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := c.convertValue(operand, expr.To)
+	if err != nil {
+		return "", err
+	}
+	return c.bind(fmt.Sprintf("%s %s ? AND ?", c.operandExpression(operand), strings.ToUpper(expr.Operator)), from, to), nil
+}
+
+// orderKeys returns the keys of the order by clause of the query, or the default order. run_id is added
+// as the last key to make the order total, which is required for pagination.
+func (c *visibilityQueryConverter) orderKeys(sel *sqlparser.Select) ([]visibilityOrderKey, error) {
+	if sel == nil || len(sel.OrderBy) == 0 {
+		return append(visibilityQueryDefaultOrder, visibilityOrderKey{operand: builtinOperand(definition.RunID)}), nil
+	}
+
+	var keys []visibilityOrderKey
+	for _, order := range sel.OrderBy {
+		operand, err := c.resolveOperand(order.Expr)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, visibilityOrderKey{
+			operand:    operand,
+			descending: order.Direction == sqlparser.DescScr,
+		})
+	}
+	return append(keys, visibilityOrderKey{operand: builtinOperand(definition.RunID)}), nil
+}
+
+func (c *visibilityQueryConverter) resolveOperand(expr sqlparser.Expr) (*visibilityQueryOperand, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid search attribute: %s", sqlparser.String(expr))
+	}
+	key := colName.Name.String()
+	prefixed := false
+	switch {
+	case !colName.Qualifier.IsEmpty():
+		if colName.Qualifier.Name.String() != definition.Attr {
+			return nil, fmt.Errorf("invalid search attribute %q", sqlparser.String(colName))
+		}
+		prefixed = true
+	case strings.HasPrefix(key, definition.Attr+"."):
+		key = strings.TrimPrefix(key, definition.Attr+".")
+		prefixed = true
+	}
+
+	if column, ok := visibilityQueryColumns[key]; ok && !prefixed {
+		return &visibilityQueryOperand{
+			key:       key,
+			column:    column.name,
+			valueType: column.valueType,
+		}, nil
+	}
+	if definition.IsSystemIndexedKey(key) {
+		return nil, fmt.Errorf("search attribute %q is not supported by SQL visibility", key)
+	}
+	valueType, ok := c.searchAttributes[key]
+	if !ok || !searchAttributeKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid search attribute %q", key)
+	}
+	return &visibilityQueryOperand{
+		key:       key,
+		valueType: valueType,
+		custom:    true,
+	}, nil
+}
+
+func builtinOperand(key string) *visibilityQueryOperand {
+	column := visibilityQueryColumns[key]
+	return &visibilityQueryOperand{
+		key:       key,
+		column:    column.name,
+		valueType: column.valueType,
+	}
+}
+
+// operandExpression returns the expression of the operand used for ordering and comparisons
+func (c *visibilityQueryConverter) operandExpression(operand *visibilityQueryOperand) string {
+	if !operand.custom {
+		return operand.column
+	}
+	switch operand.valueType {
+	case types.IndexedValueTypeInt, types.IndexedValueTypeDouble:
+		return c.dialect.SearchAttributeNumber(operand.key)
+	case types.IndexedValueTypeBool:
+		return c.dialect.SearchAttributeBool(operand.key)
+	default:
+		return c.dialect.SearchAttributeText(operand.key)
+	}
+}
+
+func (c *visibilityQueryConverter) convertValue(operand *visibilityQueryOperand, expr sqlparser.Expr) (interface{}, error) {
+	var raw string
+	switch val := expr.(type) {
+	case *sqlparser.SQLVal:
+		raw = string(val.Val)
+	case sqlparser.BoolVal:
+		raw = strconv.FormatBool(bool(val))
+	default:
+		return nil, fmt.Errorf("invalid value of search attribute %s: %s", operand.key, sqlparser.String(expr))
+	}
+	return c.parseValue(operand, raw)
+}
+
+// parseValue converts the text of a value of the operand to the type of its column or expression
+func (c *visibilityQueryConverter) parseValue(operand *visibilityQueryOperand, raw string) (interface{}, error) {
+	switch operand.valueType {
+	case types.IndexedValueTypeInt:
+		if operand.key == definition.CloseStatus {
+			return parseCloseStatus(raw)
+		}
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int value of search attribute %s: %q", operand.key, raw)
+		}
+		return value, nil
+	case types.IndexedValueTypeDouble:
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid double value of search attribute %s: %q", operand.key, raw)
+		}
+		return value, nil
+	case types.IndexedValueTypeBool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bool value of search attribute %s: %q", operand.key, raw)
+		}
+		if operand.custom {
+			return strconv.FormatBool(value), nil
+		}
+		return value, nil
+	case types.IndexedValueTypeDatetime:
+		value, err := parseDatetime(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid datetime value of search attribute %s: %q", operand.key, raw)
+		}
+		if operand.custom {
+			return value.Format(VisibilityDatetimeFormat), nil
+		}
+		return value, nil
+	default:
+		return raw, nil
+	}
+}
+
+func (c *visibilityQueryConverter) bind(condition string, args ...interface{}) string {
+	c.args = append(c.args, args...)
+	return condition
+}
+
+// NormalizeVisibilityDatetime converts a datetime search attribute value to VisibilityDatetimeFormat.
+// The value can be a RFC3339 string or the number of nanoseconds since epoch.
+func NormalizeVisibilityDatetime(value string) (string, error) {
+	t, err := parseDatetime(value)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(VisibilityDatetimeFormat), nil
+}
+
+func parseDatetime(value string) (time.Time, error) {
+	if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, nanos).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+func parseCloseStatus(value string) (int32, error) {
+	if status, err := strconv.ParseInt(value, 10, 32); err == nil {
+		return int32(status), nil
+	}
+	var status types.WorkflowExecutionCloseStatus
+	if err := status.UnmarshalText([]byte(value)); err != nil {
+		return 0, fmt.Errorf("invalid value of search attribute %s: %q", definition.CloseStatus, value)
+	}
+	return int32(status), nil
+}
+
+// columnValueText returns the text of the value of the column of the row, as parsed by parseValue
+func columnValueText(column string, row *VisibilityRow) *string {
+	var value string
+	switch column {
+	case "domain_id":
+		value = row.DomainID
+	case "workflow_id":
+		value = row.WorkflowID
+	case "run_id":
+		value = row.RunID
+	case "workflow_type_name":
+		value = row.WorkflowTypeName
+	case "task_list":
+		value = row.TaskList
+	case "start_time":
+		value = row.StartTime.UTC().Format(time.RFC3339Nano)
+	case "execution_time":
+		value = row.ExecutionTime.UTC().Format(time.RFC3339Nano)
+	case "update_time":
+		value = row.UpdateTime.UTC().Format(time.RFC3339Nano)
+	case "close_time":
+		if row.CloseTime == nil {
+			return nil
+		}
+		value = row.CloseTime.UTC().Format(time.RFC3339Nano)
+	case "close_status":
+		if row.CloseStatus == nil {
+			return nil
+		}
+		value = strconv.FormatInt(int64(*row.CloseStatus), 10)
+	case "history_length":
+		if row.HistoryLength == nil {
+			return nil
+		}
+		value = strconv.FormatInt(*row.HistoryLength, 10)
+	case "num_clusters":
+		value = strconv.FormatInt(int64(row.NumClusters), 10)
+	case "is_cron":
+		value = strconv.FormatBool(row.IsCron)
+	}
+	return &value
+}
+
+// customValueText returns the text of the value of a custom search attribute, as parsed by parseValue
+func customValueText(key string, value interface{}) (*string, error) {
+	var text string
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		text = value
+	case json.Number:
+		text = value.String()
+	case bool:
+		text = strconv.FormatBool(value)
+	default:
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Cannot paginate a query ordered by search attribute %s, which has multiple values.", key)}
+	}
+	return &text, nil
+}
+
+func isMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && strings.EqualFold(colName.Name.String(), visibilityQueryMissingValue)
+}
+
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(
+		likeEscapeChar, likeEscapeChar+likeEscapeChar,
+		"%", likeEscapeChar+"%",
+		"_", likeEscapeChar+"_",
+	).Replace(value)
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type testVisibilityQueryDialect struct{}
+
+func (testVisibilityQueryDialect) SearchAttributeText(key string) string {
+	return fmt.Sprintf("text(%s)", key)
+}
+
+func (testVisibilityQueryDialect) SearchAttributeNumber(key string) string {
+	return fmt.Sprintf("number(%s)", key)
+}
+
+func (testVisibilityQueryDialect) SearchAttributeBool(key string) string {
+	return fmt.Sprintf("bool(%s)", key)
+}
+
+func (testVisibilityQueryDialect) SearchAttributeContains(key string) string {
+	return fmt.Sprintf("contains(%s, ?)", key)
+}
+
+func TestConvertVisibilityQuery(t *testing.T) {
+	searchAttributes := map[string]types.IndexedValueType{
+		"CustomKeywordField":  types.IndexedValueTypeKeyword,
+		"CustomStringField":   types.IndexedValueTypeString,
+		"CustomIntField":      types.IndexedValueTypeInt,
+		"CustomDoubleField":   types.IndexedValueTypeDouble,
+		"CustomBoolField":     types.IndexedValueTypeBool,
+		"CustomDatetimeField": types.IndexedValueTypeDatetime,
+		"Invalid'Key":         types.IndexedValueTypeKeyword,
+	}
+	startTime := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	tests := map[string]struct {
+		query   string
+		where   string
+		args    []interface{}
+		orderBy string
+		err     string
+	}{
+		"empty query": {
+			query:   "",
+			where:   "1 = 1",
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"system keyword": {
+			query:   "WorkflowType = 'wf' and WorkflowID != 'id'",
+			where:   "(workflow_type_name = ? AND workflow_id != ?)",
+			args:    []interface{}{"wf", "id"},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"system time as nanos and RFC3339": {
+			query:   fmt.Sprintf("StartTime >= %d and CloseTime < '2024-01-02T03:04:05.000000006Z'", startTime.UnixNano()),
+			where:   "(start_time >= ? AND close_time < ?)",
+			args:    []interface{}{startTime, startTime},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"missing": {
+			query:   "CloseTime = missing or CustomKeywordField != missing",
+			where:   "(close_time IS NULL OR text(CustomKeywordField) IS NOT NULL)",
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"close status name": {
+			query:   "CloseStatus = 'TIMED_OUT' or CloseStatus = 1",
+			where:   "(close_status = ? OR close_status = ?)",
+			args:    []interface{}{int32(types.WorkflowExecutionCloseStatusTimedOut), int32(1)},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"system bool": {
+			query:   "IsCron = true",
+			where:   "is_cron = ?",
+			args:    []interface{}{true},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"keyword with prefix added by the query validator": {
+			query:   "`Attr.CustomKeywordField` = 'value' and Attr.CustomKeywordField != 'other'",
+			where:   "(contains(CustomKeywordField, ?) AND NOT (contains(CustomKeywordField, ?)))",
+			args:    []interface{}{"value", "other"},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"keyword in": {
+			query:   "CustomKeywordField in ('a', 'b')",
+			where:   "(contains(CustomKeywordField, ?) OR contains(CustomKeywordField, ?))",
+			args:    []interface{}{"a", "b"},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"system in": {
+			query:   "WorkflowType not in ('a', 'b')",
+			where:   "NOT workflow_type_name IN (?, ?)",
+			args:    []interface{}{"a", "b"},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"string is matched partially": {
+			query:   "CustomStringField = '100%_done' and CustomStringField != 'x'",
+			where:   "(text(CustomStringField) LIKE ? ESCAPE '!' AND (text(CustomStringField) IS NULL OR text(CustomStringField) NOT LIKE ? ESCAPE '!'))",
+			args:    []interface{}{"%100!%!_done%", "%x%"},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"like": {
+			query:   "WorkflowID like 'order-%'",
+			where:   "workflow_id LIKE ?",
+			args:    []interface{}{"order-%"},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"numbers and between": {
+			query:   "(CustomIntField > 10 or CustomDoubleField <= 1.5) and HistoryLength between 1 and 100",
+			where:   "(((number(CustomIntField) > ? OR number(CustomDoubleField) <= ?)) AND history_length BETWEEN ? AND ?)",
+			args:    []interface{}{int64(10), 1.5, int64(1), int64(100)},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"custom bool and datetime": {
+			query:   "CustomBoolField = 'true' and CustomDatetimeField > '2024-01-02T04:04:05.000000006+01:00'",
+			where:   "(bool(CustomBoolField) = ? AND text(CustomDatetimeField) > ?)",
+			args:    []interface{}{"true", "2024-01-02T03:04:05.000000006Z"},
+			orderBy: "start_time DESC, run_id ASC",
+		},
+		"order by": {
+			query:   "WorkflowType = 'wf' order by CloseTime desc, `Attr.CustomIntField` asc",
+			where:   "workflow_type_name = ?",
+			args:    []interface{}{"wf"},
+			orderBy: "(close_time IS NULL) ASC, close_time DESC, (number(CustomIntField) IS NULL) DESC, number(CustomIntField) ASC, run_id ASC",
+		},
+		"just order by": {
+			query:   "order by StartTime",
+			where:   "1 = 1",
+			orderBy: "start_time ASC, run_id ASC",
+		},
+		"unknown search attribute": {
+			query: "Unknown = 'a'",
+			err:   `invalid search attribute "Unknown"`,
+		},
+		"unsafe search attribute key": {
+			query: "`Invalid'Key` = 'a'",
+			err:   `invalid search attribute "Invalid'Key"`,
+		},
+		"unsupported system search attribute": {
+			query: "ClusterAttributeScope = 'a'",
+			err:   `search attribute "ClusterAttributeScope" is not supported by SQL visibility`,
+		},
+		"invalid int": {
+			query: "CustomIntField = 'abc'",
+			err:   `invalid int value of search attribute CustomIntField: "abc"`,
+		},
+		"range on bool": {
+			query: "CustomBoolField > 'true'",
+			err:   `operator ">" is not supported for bool search attribute CustomBoolField`,
+		},
+		"group by": {
+			query: "WorkflowType = 'wf' group by WorkflowID",
+			err:   "Only where and order by clauses are supported in the query.",
+		},
+		"syntax error": {
+			query: "WorkflowType = ",
+			err:   "Invalid query",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			query, err := ConvertVisibilityQuery(test.query, searchAttributes, testVisibilityQueryDialect{})
+			if test.err != "" {
+				require.Error(t, err)
+				assert.IsType(t, &types.BadRequestError{}, err)
+				assert.Contains(t, err.Error(), test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.where, query.Where)
+			assert.Equal(t, test.args, query.Args)
+			assert.Equal(t, test.orderBy, query.OrderBy)
+		})
+	}
+}
+
+func TestVisibilityQueryStartAfter(t *testing.T) {
+	searchAttributes := map[string]types.IndexedValueType{
+		"CustomIntField": types.IndexedValueTypeInt,
+	}
+	startTime := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	closeTime := startTime.Add(time.Hour)
+	closeStatus := int32(1)
+	row := &VisibilityRow{
+		RunID:            "run",
+		StartTime:        startTime,
+		SearchAttributes: []byte(`{"CustomIntField": 12}`),
+	}
+
+	tests := map[string]struct {
+		query string
+		row   *VisibilityRow
+		where string
+		args  []interface{}
+	}{
+		"default order": {
+			query: "WorkflowType = 'wf'",
+			row:   row,
+			where: "(workflow_type_name = ?) AND (start_time < ? OR start_time = ? AND run_id > ?)",
+			args:  []interface{}{"wf", startTime, startTime, "run"},
+		},
+		"ascending custom attribute": {
+			query: "order by CustomIntField",
+			row:   row,
+			where: "(1 = 1) AND (number(CustomIntField) > ? OR number(CustomIntField) = ? AND run_id > ?)",
+			args:  []interface{}{int64(12), int64(12), "run"},
+		},
+		"ascending null": {
+			query: "order by CustomIntField",
+			row:   &VisibilityRow{RunID: "run"},
+			where: "(1 = 1) AND (number(CustomIntField) IS NOT NULL OR number(CustomIntField) IS NULL AND run_id > ?)",
+			args:  []interface{}{"run"},
+		},
+		"descending nullable column": {
+			query: "order by CloseTime desc, CloseStatus",
+			row:   &VisibilityRow{RunID: "run", CloseTime: &closeTime, CloseStatus: &closeStatus},
+			where: "(1 = 1) AND ((close_time < ? OR close_time IS NULL) OR close_time = ? AND close_status > ? OR close_time = ? AND close_status = ? AND run_id > ?)",
+			args:  []interface{}{closeTime, closeTime, int32(1), closeTime, int32(1), "run"},
+		},
+		"descending null": {
+			query: "order by CloseTime desc",
+			row:   &VisibilityRow{RunID: "run"},
+			where: "(1 = 1) AND (close_time IS NULL AND run_id > ?)",
+			args:  []interface{}{"run"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cursor, err := NewVisibilityQueryCursor(test.query, searchAttributes, test.row)
+			require.NoError(t, err)
+			query, err := ConvertVisibilityQuery(test.query, searchAttributes, testVisibilityQueryDialect{})
+			require.NoError(t, err)
+			require.NoError(t, query.StartAfter(cursor))
+			assert.Equal(t, test.where, query.Where)
+			assert.Equal(t, test.args, query.Args)
+		})
+	}
+}
+
+func TestVisibilityQueryStartAfter_InvalidCursor(t *testing.T) {
+	query, err := ConvertVisibilityQuery("order by CloseTime", nil, testVisibilityQueryDialect{})
+	require.NoError(t, err)
+	value := "yesterday"
+
+	err = query.StartAfter(VisibilityQueryCursor{nil})
+	assert.IsType(t, &types.BadRequestError{}, err)
+	err = query.StartAfter(VisibilityQueryCursor{&value, &value})
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
+
+func TestNewVisibilityQueryCursor_MultipleValues(t *testing.T) {
+	searchAttributes := map[string]types.IndexedValueType{
+		"CustomKeywordField": types.IndexedValueTypeKeyword,
+	}
+	row := &VisibilityRow{
+		RunID:            "run",
+		SearchAttributes: []byte(`{"CustomKeywordField": ["a", "b"]}`),
+	}
+
+	_, err := NewVisibilityQueryCursor("order by CustomKeywordField", searchAttributes, row)
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
+
+func TestNormalizeVisibilityDatetime(t *testing.T) {
+	expected := "2024-01-02T03:04:05.000000000Z"
+
+	normalized, err := NormalizeVisibilityDatetime("2024-01-02T04:04:05+01:00")
+	require.NoError(t, err)
+	assert.Equal(t, expected, normalized)
+
+	normalized, err = NormalizeVisibilityDatetime(fmt.Sprint(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).UnixNano()))
+	require.NoError(t, err)
+	assert.Equal(t, expected, normalized)
+
+	_, err = NormalizeVisibilityDatetime("yesterday")
+	assert.Error(t, err)
+}
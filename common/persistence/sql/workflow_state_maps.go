@@ -72,6 +72,7 @@ func updateActivityInfos(
 				Attempt:                  activityInfo.Attempt,
 				TaskList:                 activityInfo.TaskList,
 				TaskListKind:             activityInfo.TaskListKind,
+				TaskPriority:             activityInfo.TaskPriority,
 				StartedIdentity:          activityInfo.StartedIdentity,
 				HasRetryPolicy:           activityInfo.HasRetryPolicy,
 				RetryInitialInterval:     activityInfo.InitialInterval,
@@ -83,6 +84,10 @@ func updateActivityInfos(
 				RetryLastFailureReason:   activityInfo.LastFailureReason,
 				RetryLastWorkerIdentity:  activityInfo.LastWorkerIdentity,
 				RetryLastFailureDetails:  activityInfo.LastFailureDetails,
+				Paused:                   activityInfo.Paused,
+				PauseReason:              activityInfo.PauseReason,
+				PauseIdentity:            activityInfo.PauseIdentity,
+				PausedTimestamp:          activityInfo.PausedTimestamp,
 			}
 			blob, err := parser.ActivityInfoToBlob(info)
 			if err != nil {
@@ -171,6 +176,7 @@ func getActivityInfoMap(
 			StartedIdentity:          decoded.GetStartedIdentity(),
 			TaskList:                 decoded.GetTaskList(),
 			TaskListKind:             decoded.GetTaskListKind(),
+			TaskPriority:             decoded.GetTaskPriority(),
 			HasRetryPolicy:           decoded.GetHasRetryPolicy(),
 			InitialInterval:          decoded.GetRetryInitialInterval(),
 			BackoffCoefficient:       decoded.GetRetryBackoffCoefficient(),
@@ -181,6 +187,10 @@ func getActivityInfoMap(
 			LastFailureReason:        decoded.GetRetryLastFailureReason(),
 			LastWorkerIdentity:       decoded.GetRetryLastWorkerIdentity(),
 			LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
+			Paused:                   decoded.GetPaused(),
+			PauseReason:              decoded.GetPauseReason(),
+			PauseIdentity:            decoded.GetPauseIdentity(),
+			PausedTimestamp:          decoded.GetPausedTimestamp(),
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, constants.EncodingType(decoded.GetStartedEventEncoding()))
@@ -27,11 +27,14 @@ import (
 	"errors"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -123,7 +126,18 @@ func (p *base) updateErrorMetric(scope metrics.ScopeIdx, err error, metricsScope
 	}
 }
 
-func (p *base) call(scope metrics.ScopeIdx, op func() error, tags ...metrics.Tag) error {
+// startSpan starts a span for the persistence call, named after the operation of its metrics scope
+func (p *base) startSpan(ctx context.Context, scope metrics.ScopeIdx) trace.Span {
+	_, span := tracing.Tracer().Start(ctx, "persistence."+metrics.ScopeDefs[metrics.Common][scope].GetOperationString(),
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	return span
+}
+
+func (p *base) call(ctx context.Context, scope metrics.ScopeIdx, op func() error, tags ...metrics.Tag) (err error) {
+	span := p.startSpan(ctx, scope)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	metricsScope := p.metricClient.Scope(scope, tags...)
 	if len(tags) > 0 {
 		metricsScope.IncCounter(metrics.PersistenceRequestsPerDomain)
@@ -131,7 +145,7 @@ func (p *base) call(scope metrics.ScopeIdx, op func() error, tags ...metrics.Tag
 		metricsScope.IncCounter(metrics.PersistenceRequests)
 	}
 	before := time.Now()
-	err := op()
+	err = op()
 	duration := time.Since(before)
 	if len(tags) > 0 {
 		metricsScope.RecordTimer(metrics.PersistenceLatencyPerDomain, duration)
@@ -154,11 +168,14 @@ func (p *base) call(scope metrics.ScopeIdx, op func() error, tags ...metrics.Tag
 	return err
 }
 
-func (p *base) callWithoutDomainTag(scope metrics.ScopeIdx, op func() error, tags ...metrics.Tag) error {
+func (p *base) callWithoutDomainTag(ctx context.Context, scope metrics.ScopeIdx, op func() error, tags ...metrics.Tag) (err error) {
+	span := p.startSpan(ctx, scope)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	metricsScope := p.metricClient.Scope(scope, tags...)
 	metricsScope.IncCounter(metrics.PersistenceRequests)
 	before := time.Now()
-	err := op()
+	err = op()
 	duration := time.Since(before)
 	metricsScope.RecordTimer(metrics.PersistenceLatency, duration)
 
@@ -171,7 +188,10 @@ func (p *base) callWithoutDomainTag(scope metrics.ScopeIdx, op func() error, tag
 	return err
 }
 
-func (p *base) callWithDomainAndShardScope(scope metrics.ScopeIdx, op func() error, domainTag metrics.Tag, shardIDTag metrics.Tag, additionalTags ...metrics.Tag) error {
+func (p *base) callWithDomainAndShardScope(ctx context.Context, scope metrics.ScopeIdx, op func() error, domainTag metrics.Tag, shardIDTag metrics.Tag, additionalTags ...metrics.Tag) (err error) {
+	span := p.startSpan(ctx, scope)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	domainMetricsScope := p.metricClient.Scope(scope, append([]metrics.Tag{domainTag}, additionalTags...)...)
 	shardOperationsMetricsScope := p.metricClient.Scope(scope, append([]metrics.Tag{shardIDTag}, additionalTags...)...)
 	shardOverallMetricsScope := p.metricClient.Scope(metrics.PersistenceShardRequestCountScope, shardIDTag)
@@ -181,7 +201,7 @@ func (p *base) callWithDomainAndShardScope(scope metrics.ScopeIdx, op func() err
 	shardOverallMetricsScope.IncCounter(metrics.PersistenceRequestsPerShard)
 
 	before := time.Now()
-	err := op()
+	err = op()
 	duration := time.Since(before)
 
 	domainMetricsScope.RecordTimer(metrics.PersistenceLatencyPerDomain, duration)
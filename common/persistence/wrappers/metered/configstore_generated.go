@@ -48,7 +48,7 @@ func (c *meteredConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfgT
 		return err
 	}
 
-	err = c.call(metrics.PersistenceFetchDynamicConfigScope, op, getCustomMetricTags(cfgType)...)
+	err = c.call(ctx, metrics.PersistenceFetchDynamicConfigScope, op, getCustomMetricTags(cfgType)...)
 	return
 }
 
@@ -59,6 +59,6 @@ func (c *meteredConfigStoreManager) UpdateDynamicConfig(ctx context.Context, req
 		return err
 	}
 
-	err = c.call(metrics.PersistenceUpdateDynamicConfigScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceUpdateDynamicConfigScope, op, getCustomMetricTags(request)...)
 	return
 }
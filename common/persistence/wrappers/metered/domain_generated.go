@@ -48,7 +48,7 @@ func (c *meteredDomainManager) CreateDomain(ctx context.Context, request *persis
 		return err
 	}
 
-	err = c.call(metrics.PersistenceCreateDomainScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceCreateDomainScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -59,7 +59,7 @@ func (c *meteredDomainManager) DeleteDomain(ctx context.Context, request *persis
 		return err
 	}
 
-	err = c.call(metrics.PersistenceDeleteDomainScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceDeleteDomainScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -70,7 +70,7 @@ func (c *meteredDomainManager) DeleteDomainByName(ctx context.Context, request *
 		return err
 	}
 
-	err = c.call(metrics.PersistenceDeleteDomainByNameScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceDeleteDomainByNameScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -81,7 +81,7 @@ func (c *meteredDomainManager) GetDomain(ctx context.Context, request *persisten
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetDomainScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceGetDomainScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -91,7 +91,7 @@ func (c *meteredDomainManager) GetMetadata(ctx context.Context) (gp1 *persistenc
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetMetadataScope, op)
+	err = c.call(ctx, metrics.PersistenceGetMetadataScope, op)
 	return
 }
 
@@ -106,7 +106,7 @@ func (c *meteredDomainManager) ListDomains(ctx context.Context, request *persist
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListDomainsScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListDomainsScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -117,6 +117,6 @@ func (c *meteredDomainManager) UpdateDomain(ctx context.Context, request *persis
 		return err
 	}
 
-	err = c.call(metrics.PersistenceUpdateDomainScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceUpdateDomainScope, op, getCustomMetricTags(request)...)
 	return
 }
@@ -43,7 +43,7 @@ func (c *meteredHistoryManager) AppendHistoryNodes(ctx context.Context, request
 		return err
 	}
 
-	err = c.call(metrics.PersistenceAppendHistoryNodesScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceAppendHistoryNodesScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -59,7 +59,7 @@ func (c *meteredHistoryManager) DeleteHistoryBranch(ctx context.Context, request
 		return err
 	}
 
-	err = c.call(metrics.PersistenceDeleteHistoryBranchScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceDeleteHistoryBranchScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -70,7 +70,7 @@ func (c *meteredHistoryManager) ForkHistoryBranch(ctx context.Context, request *
 		return err
 	}
 
-	err = c.call(metrics.PersistenceForkHistoryBranchScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceForkHistoryBranchScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -81,7 +81,7 @@ func (c *meteredHistoryManager) GetAllHistoryTreeBranches(ctx context.Context, r
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetAllHistoryTreeBranchesScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceGetAllHistoryTreeBranchesScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -92,7 +92,7 @@ func (c *meteredHistoryManager) GetHistoryTree(ctx context.Context, request *per
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetHistoryTreeScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceGetHistoryTreeScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -107,7 +107,7 @@ func (c *meteredHistoryManager) ReadHistoryBranch(ctx context.Context, request *
 		return err
 	}
 
-	err = c.call(metrics.PersistenceReadHistoryBranchScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceReadHistoryBranchScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -118,7 +118,7 @@ func (c *meteredHistoryManager) ReadHistoryBranchByBatch(ctx context.Context, re
 		return err
 	}
 
-	err = c.call(metrics.PersistenceReadHistoryBranchByBatchScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceReadHistoryBranchByBatchScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -129,6 +129,6 @@ func (c *meteredHistoryManager) ReadRawHistoryBranch(ctx context.Context, reques
 		return err
 	}
 
-	err = c.call(metrics.PersistenceReadRawHistoryBranchScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceReadRawHistoryBranchScope, op, getCustomMetricTags(request)...)
 	return
 }
@@ -48,7 +48,7 @@ func (c *meteredQueueManager) DeleteMessageFromDLQ(ctx context.Context, messageI
 		return err
 	}
 
-	err = c.call(metrics.PersistenceDeleteMessageFromDLQScope, op, getCustomMetricTags(messageID)...)
+	err = c.call(ctx, metrics.PersistenceDeleteMessageFromDLQScope, op, getCustomMetricTags(messageID)...)
 	return
 }
 
@@ -59,7 +59,7 @@ func (c *meteredQueueManager) DeleteMessagesBefore(ctx context.Context, messageI
 		return err
 	}
 
-	err = c.call(metrics.PersistenceDeleteMessagesBeforeScope, op, getCustomMetricTags(messageID)...)
+	err = c.call(ctx, metrics.PersistenceDeleteMessagesBeforeScope, op, getCustomMetricTags(messageID)...)
 	return
 }
 
@@ -70,7 +70,7 @@ func (c *meteredQueueManager) EnqueueMessage(ctx context.Context, messagePayload
 		return err
 	}
 
-	err = c.call(metrics.PersistenceEnqueueMessageScope, op, getCustomMetricTags(messagePayload)...)
+	err = c.call(ctx, metrics.PersistenceEnqueueMessageScope, op, getCustomMetricTags(messagePayload)...)
 	return
 }
 
@@ -81,7 +81,7 @@ func (c *meteredQueueManager) EnqueueMessageToDLQ(ctx context.Context, messagePa
 		return err
 	}
 
-	err = c.call(metrics.PersistenceEnqueueMessageToDLQScope, op, getCustomMetricTags(messagePayload)...)
+	err = c.call(ctx, metrics.PersistenceEnqueueMessageToDLQScope, op, getCustomMetricTags(messagePayload)...)
 	return
 }
 
@@ -91,7 +91,7 @@ func (c *meteredQueueManager) GetAckLevels(ctx context.Context) (m1 map[string]i
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetAckLevelsScope, op)
+	err = c.call(ctx, metrics.PersistenceGetAckLevelsScope, op)
 	return
 }
 
@@ -101,7 +101,7 @@ func (c *meteredQueueManager) GetDLQAckLevels(ctx context.Context) (m1 map[strin
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetDLQAckLevelsScope, op)
+	err = c.call(ctx, metrics.PersistenceGetDLQAckLevelsScope, op)
 	return
 }
 
@@ -111,7 +111,7 @@ func (c *meteredQueueManager) GetDLQSize(ctx context.Context) (i1 int64, err err
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetDLQSizeScope, op)
+	err = c.call(ctx, metrics.PersistenceGetDLQSizeScope, op)
 	return
 }
 
@@ -122,7 +122,7 @@ func (c *meteredQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, fi
 		return err
 	}
 
-	err = c.call(metrics.PersistenceRangeDeleteMessagesFromDLQScope, op, getCustomMetricTags(firstMessageID)...)
+	err = c.call(ctx, metrics.PersistenceRangeDeleteMessagesFromDLQScope, op, getCustomMetricTags(firstMessageID)...)
 	return
 }
 
@@ -133,7 +133,7 @@ func (c *meteredQueueManager) ReadMessages(ctx context.Context, lastMessageID in
 		return err
 	}
 
-	err = c.call(metrics.PersistenceReadMessagesScope, op, getCustomMetricTags(lastMessageID)...)
+	err = c.call(ctx, metrics.PersistenceReadMessagesScope, op, getCustomMetricTags(lastMessageID)...)
 	return
 }
 
@@ -144,7 +144,7 @@ func (c *meteredQueueManager) ReadMessagesFromDLQ(ctx context.Context, firstMess
 		return err
 	}
 
-	err = c.call(metrics.PersistenceReadMessagesFromDLQScope, op, getCustomMetricTags(firstMessageID)...)
+	err = c.call(ctx, metrics.PersistenceReadMessagesFromDLQScope, op, getCustomMetricTags(firstMessageID)...)
 	return
 }
 
@@ -155,7 +155,7 @@ func (c *meteredQueueManager) UpdateAckLevel(ctx context.Context, messageID int6
 		return err
 	}
 
-	err = c.call(metrics.PersistenceUpdateAckLevelScope, op, getCustomMetricTags(messageID)...)
+	err = c.call(ctx, metrics.PersistenceUpdateAckLevelScope, op, getCustomMetricTags(messageID)...)
 	return
 }
 
@@ -166,6 +166,6 @@ func (c *meteredQueueManager) UpdateDLQAckLevel(ctx context.Context, messageID i
 		return err
 	}
 
-	err = c.call(metrics.PersistenceUpdateDLQAckLevelScope, op, getCustomMetricTags(messageID)...)
+	err = c.call(ctx, metrics.PersistenceUpdateDLQAckLevelScope, op, getCustomMetricTags(messageID)...)
 	return
 }
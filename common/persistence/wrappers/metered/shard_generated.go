@@ -48,7 +48,7 @@ func (c *meteredShardManager) CreateShard(ctx context.Context, request *persiste
 		return err
 	}
 
-	err = c.call(metrics.PersistenceCreateShardScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceCreateShardScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -63,7 +63,7 @@ func (c *meteredShardManager) GetShard(ctx context.Context, request *persistence
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetShardScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceGetShardScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -74,6 +74,6 @@ func (c *meteredShardManager) UpdateShard(ctx context.Context, request *persiste
 		return err
 	}
 
-	err = c.call(metrics.PersistenceUpdateShardScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceUpdateShardScope, op, getCustomMetricTags(request)...)
 	return
 }
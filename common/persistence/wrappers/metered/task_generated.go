@@ -48,7 +48,7 @@ func (c *meteredTaskManager) CompleteTask(ctx context.Context, request *persiste
 		return err
 	}
 
-	err = c.call(metrics.PersistenceCompleteTaskScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceCompleteTaskScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -59,7 +59,7 @@ func (c *meteredTaskManager) CompleteTasksLessThan(ctx context.Context, request
 		return err
 	}
 
-	err = c.call(metrics.PersistenceCompleteTasksLessThanScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceCompleteTasksLessThanScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -70,7 +70,7 @@ func (c *meteredTaskManager) CreateTasks(ctx context.Context, request *persisten
 		return err
 	}
 
-	err = c.call(metrics.PersistenceCreateTasksScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceCreateTasksScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -81,7 +81,7 @@ func (c *meteredTaskManager) DeleteTaskList(ctx context.Context, request *persis
 		return err
 	}
 
-	err = c.call(metrics.PersistenceDeleteTaskListScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceDeleteTaskListScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -96,7 +96,7 @@ func (c *meteredTaskManager) GetOrphanTasks(ctx context.Context, request *persis
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetOrphanTasksScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceGetOrphanTasksScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -107,7 +107,7 @@ func (c *meteredTaskManager) GetTaskList(ctx context.Context, request *persisten
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetTaskListScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceGetTaskListScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -118,7 +118,7 @@ func (c *meteredTaskManager) GetTaskListSize(ctx context.Context, request *persi
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetTaskListSizeScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceGetTaskListSizeScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -129,7 +129,7 @@ func (c *meteredTaskManager) GetTasks(ctx context.Context, request *persistence.
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetTasksScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceGetTasksScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -140,7 +140,7 @@ func (c *meteredTaskManager) LeaseTaskList(ctx context.Context, request *persist
 		return err
 	}
 
-	err = c.call(metrics.PersistenceLeaseTaskListScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceLeaseTaskListScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -151,7 +151,7 @@ func (c *meteredTaskManager) ListTaskList(ctx context.Context, request *persiste
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListTaskListScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListTaskListScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -162,6 +162,6 @@ func (c *meteredTaskManager) UpdateTaskList(ctx context.Context, request *persis
 		return err
 	}
 
-	err = c.call(metrics.PersistenceUpdateTaskListScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceUpdateTaskListScope, op, getCustomMetricTags(request)...)
 	return
 }
@@ -48,7 +48,7 @@ func (c *meteredVisibilityManager) CountWorkflowExecutions(ctx context.Context,
 		return err
 	}
 
-	err = c.call(metrics.PersistenceCountWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceCountWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -59,7 +59,7 @@ func (c *meteredVisibilityManager) DeleteUninitializedWorkflowExecution(ctx cont
 		return err
 	}
 
-	err = c.call(metrics.PersistenceDeleteUninitializedWorkflowExecutionScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceDeleteUninitializedWorkflowExecutionScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -70,7 +70,7 @@ func (c *meteredVisibilityManager) DeleteWorkflowExecution(ctx context.Context,
 		return err
 	}
 
-	err = c.call(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -81,7 +81,7 @@ func (c *meteredVisibilityManager) GetClosedWorkflowExecution(ctx context.Contex
 		return err
 	}
 
-	err = c.call(metrics.PersistenceGetClosedWorkflowExecutionScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceGetClosedWorkflowExecutionScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -96,7 +96,7 @@ func (c *meteredVisibilityManager) ListClosedWorkflowExecutions(ctx context.Cont
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListClosedWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListClosedWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -107,7 +107,7 @@ func (c *meteredVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx cont
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -118,7 +118,7 @@ func (c *meteredVisibilityManager) ListClosedWorkflowExecutionsByType(ctx contex
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -129,7 +129,7 @@ func (c *meteredVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(ctx
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -140,7 +140,7 @@ func (c *meteredVisibilityManager) ListOpenWorkflowExecutions(ctx context.Contex
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListOpenWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListOpenWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -151,7 +151,7 @@ func (c *meteredVisibilityManager) ListOpenWorkflowExecutionsByType(ctx context.
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -162,7 +162,7 @@ func (c *meteredVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ctx co
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -173,7 +173,7 @@ func (c *meteredVisibilityManager) ListWorkflowExecutions(ctx context.Context, r
 		return err
 	}
 
-	err = c.call(metrics.PersistenceListWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceListWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -184,7 +184,7 @@ func (c *meteredVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Con
 		return err
 	}
 
-	err = c.call(metrics.PersistenceRecordWorkflowExecutionClosedScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceRecordWorkflowExecutionClosedScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -195,7 +195,7 @@ func (c *meteredVisibilityManager) RecordWorkflowExecutionStarted(ctx context.Co
 		return err
 	}
 
-	err = c.call(metrics.PersistenceRecordWorkflowExecutionStartedScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceRecordWorkflowExecutionStartedScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -206,7 +206,7 @@ func (c *meteredVisibilityManager) RecordWorkflowExecutionUninitialized(ctx cont
 		return err
 	}
 
-	err = c.call(metrics.PersistenceRecordWorkflowExecutionUninitializedScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceRecordWorkflowExecutionUninitializedScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -217,7 +217,7 @@ func (c *meteredVisibilityManager) ScanWorkflowExecutions(ctx context.Context, r
 		return err
 	}
 
-	err = c.call(metrics.PersistenceScanWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceScanWorkflowExecutionsScope, op, getCustomMetricTags(request)...)
 	return
 }
 
@@ -228,6 +228,6 @@ func (c *meteredVisibilityManager) UpsertWorkflowExecution(ctx context.Context,
 		return err
 	}
 
-	err = c.call(metrics.PersistenceUpsertWorkflowExecutionScope, op, getCustomMetricTags(request)...)
+	err = c.call(ctx, metrics.PersistenceUpsertWorkflowExecutionScope, op, getCustomMetricTags(request)...)
 	return
 }
@@ -24,7 +24,11 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
@@ -34,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 )
 
 type authOutboundMiddleware struct {
@@ -179,6 +184,9 @@ func (m *HeaderForwardingMiddleware) Call(ctx context.Context, request *transpor
 		names := inboundCall.HeaderNames()
 		for _, rule := range m.Rules {
 			for _, key := range names {
+				if isGRPCReservedHeader(key) {
+					continue // set by the gRPC transport itself, forwarding them would duplicate them
+				}
 				if !rule.Match.MatchString(key) {
 					continue
 				}
@@ -202,6 +210,12 @@ func (m *HeaderForwardingMiddleware) Call(ctx context.Context, request *transpor
 	return out.Call(ctx, request)
 }
 
+// isGRPCReservedHeader returns true for the headers, like grpc-accept-encoding, which gRPC exposes on inbound calls
+// but adds on its own to outbound calls
+func isGRPCReservedHeader(key string) bool {
+	return strings.HasPrefix(strings.ToLower(key), "grpc-")
+}
+
 // ForwardPartitionConfigMiddleware forwards the partition config to remote cluster
 // The middleware should always be applied after any other middleware that inject partition config into the context
 // so that it can overwrites the partition config into the context
@@ -260,3 +274,61 @@ func (m *ClientPartitionConfigMiddleware) Handle(ctx context.Context, req *trans
 	}
 	return h.Handle(ctx, req, resw)
 }
+
+// TracingMiddleware starts a server span for every inbound call and a client span for every outbound call.
+// The trace context is propagated in the request headers, so it works the same over gRPC and TChannel.
+type TracingMiddleware struct{}
+
+func (m *TracingMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, h transport.UnaryHandler) error {
+	ctx = tracing.Propagator.Extract(ctx, headersCarrier{headers: &req.Headers})
+	ctx, span := tracing.Tracer().Start(ctx, req.Procedure,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(requestAttributes(req)...),
+	)
+	err := h.Handle(ctx, req, resw)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (m *TracingMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	ctx, span := tracing.Tracer().Start(ctx, request.Procedure,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(requestAttributes(request)...),
+	)
+	tracing.Propagator.Inject(ctx, headersCarrier{headers: &request.Headers})
+	response, err := out.Call(ctx, request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func requestAttributes(req *transport.Request) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.RPCSystemKey.String("yarpc"),
+		semconv.RPCService(req.Service),
+		semconv.RPCMethod(req.Procedure),
+		attribute.String("cadence.caller", req.Caller),
+		attribute.String("cadence.transport", req.Transport),
+	}
+}
+
+// headersCarrier adapts yarpc headers to the propagation.TextMapCarrier interface
+type headersCarrier struct {
+	headers *transport.Headers
+}
+
+func (c headersCarrier) Get(key string) string {
+	value, _ := c.headers.Get(key)
+	return value
+}
+
+func (c headersCarrier) Set(key, value string) {
+	*c.headers = c.headers.With(key, value)
+}
+
+func (c headersCarrier) Keys() []string {
+	keys := make([]string, 0, c.headers.Len())
+	for key := range c.headers.Items() {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -31,6 +31,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/yarpc/api/transport"
 	"go.uber.org/yarpc/yarpctest"
 
@@ -100,6 +105,8 @@ func TestHeaderForwardingMiddleware(t *testing.T) {
 		"key-a": "inbound-value-a",
 		"key-b": "inbound-value-b",
 		"key-x": "inbound-value-x",
+		// set by gRPC on every call, so never forwarded
+		"grpc-accept-encoding": "gzip",
 	}
 	outboundHeaders := map[string]string{
 		"key-b": "outbound-value-b",
@@ -329,6 +336,42 @@ func TestClientPartitionConfigMiddleware(t *testing.T) {
 	})
 }
 
+func TestTracingMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	m := &TracingMiddleware{}
+	var forwarded transport.Headers
+	_, err := m.Call(context.Background(), &transport.Request{Service: "cadence-history", Procedure: "StartWorkflowExecution"}, &fakeOutbound{
+		verify: func(request *transport.Request) {
+			forwarded = request.Headers
+		},
+	})
+	require.NoError(t, err)
+	_, ok := forwarded.Get("traceparent")
+	require.True(t, ok, "trace context must be propagated in the request headers")
+
+	h := &fakeHandler{}
+	err = m.Handle(context.Background(), &transport.Request{Service: "cadence-history", Procedure: "StartWorkflowExecution", Headers: forwarded}, nil, h)
+	require.NoError(t, err)
+
+	_, err = m.Call(context.Background(), &transport.Request{Procedure: "AddDecisionTask"}, &fakeOutbound{err: assert.AnError})
+	assert.Equal(t, assert.AnError, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	client, server, failed := spans[0], spans[1], spans[2]
+	assert.Equal(t, trace.SpanKindClient, client.SpanKind())
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind())
+	assert.Equal(t, "StartWorkflowExecution", server.Name())
+	assert.Equal(t, client.SpanContext().TraceID(), server.SpanContext().TraceID())
+	assert.Equal(t, client.SpanContext().SpanID(), server.Parent().SpanID())
+	assert.Equal(t, server.SpanContext(), trace.SpanContextFromContext(h.ctx))
+	assert.Equal(t, codes.Error, failed.Status().Code)
+}
+
 type fakeHandler struct {
 	ctx context.Context
 }
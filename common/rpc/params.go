@@ -170,12 +170,14 @@ func NewParams(serviceName string, config *config.Config, dc *dynamicconfig.Coll
 		OutboundTLS:      outboundTLS,
 		InboundMiddleware: yarpc.InboundMiddleware{
 			// order matters: ForwardPartitionConfigMiddleware must be applied after ClientPartitionConfigMiddleware
-			Unary: yarpc.UnaryInboundMiddleware(&PinotComparatorMiddleware{}, &InboundMetricsMiddleware{}, &ClientPartitionConfigMiddleware{}, &ForwardPartitionConfigMiddleware{}),
+			// and TracingMiddleware is applied first so that the server span covers the whole call
+			Unary: yarpc.UnaryInboundMiddleware(&TracingMiddleware{}, &PinotComparatorMiddleware{}, &InboundMetricsMiddleware{}, &ClientPartitionConfigMiddleware{}, &ForwardPartitionConfigMiddleware{}),
 		},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
+			// TracingMiddleware is applied last so that its trace context overwrites any forwarded one
 			Unary: yarpc.UnaryOutboundMiddleware(&HeaderForwardingMiddleware{
 				Rules: forwardingRules,
-			}, &ForwardPartitionConfigMiddleware{}),
+			}, &ForwardPartitionConfigMiddleware{}, &TracingMiddleware{}),
 		},
 	}, nil
 }
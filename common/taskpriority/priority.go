@@ -0,0 +1,137 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package taskpriority defines the priority levels of activity and decision tasks within a task list.
+// The priority is set when an activity is scheduled or a workflow is started, persisted with the activity,
+// the workflow and the task list tasks by its level name, and dispatched with weighted fairness by matching.
+package taskpriority
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// Level is the priority level of a task, lower values are dispatched first
+type Level int
+
+const (
+	LevelHighest Level = iota
+	LevelHigh
+	LevelNormal
+	LevelLow
+	LevelLowest
+
+	// NumLevels is the number of priority levels
+	NumLevels = 5
+	// DefaultLevel is the priority level of tasks which don't specify a priority
+	DefaultLevel = LevelNormal
+
+	maxWeightRatio = 1000
+)
+
+var levelNames = [NumLevels]string{"highest", "high", "normal", "low", "lowest"}
+
+// Levels returns all the priority levels from the highest to the lowest
+func Levels() []Level {
+	levels := make([]Level, 0, NumLevels)
+	for l := LevelHighest; l <= LevelLowest; l++ {
+		levels = append(levels, l)
+	}
+	return levels
+}
+
+func (l Level) String() string {
+	if l < LevelHighest || l > LevelLowest {
+		return strconv.Itoa(int(l))
+	}
+	return levelNames[l]
+}
+
+// Parse parses a priority level from either its name or its numeric value
+func Parse(s string) (Level, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for i, name := range levelNames {
+		if s == name {
+			return Level(i), nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < int(LevelHighest) || n > int(LevelLowest) {
+		return DefaultLevel, fmt.Errorf("invalid task priority %q, valid values are %v or 0-%d", s, strings.Join(levelNames[:], ", "), NumLevels-1)
+	}
+	return Level(n), nil
+}
+
+// FromName returns the priority level with the given persisted name, or the default level
+// if the name is empty or not valid
+func FromName(name string) Level {
+	if name == "" {
+		return DefaultLevel
+	}
+	level, err := Parse(name)
+	if err != nil {
+		return DefaultLevel
+	}
+	return level
+}
+
+// FromTaskPriority returns the name under which the given priority is persisted, or an empty
+// string if the priority is not set or not valid
+func FromTaskPriority(priority *types.TaskPriority) string {
+	if priority == nil {
+		return ""
+	}
+	level := Level(*priority)
+	if level < LevelHighest || level > LevelLowest {
+		return ""
+	}
+	return level.String()
+}
+
+// ToTaskPriority returns the priority with the given persisted name, or nil if the name is empty or not valid
+func ToTaskPriority(name string) *types.TaskPriority {
+	if name == "" {
+		return nil
+	}
+	level, err := Parse(name)
+	if err != nil {
+		return nil
+	}
+	return types.TaskPriority(level).Ptr()
+}
+
+// Weights returns the dispatch weight of each priority level. The weight of a level is ratio times
+// the weight of the next lower level and the lowest level always has a weight of 1, so that every
+// level with backlog keeps getting a share of the dispatches and lower levels are never starved.
+func Weights(ratio int) [NumLevels]int {
+	ratio = min(max(ratio, 1), maxWeightRatio)
+	var weights [NumLevels]int
+	weight := 1
+	for l := LevelLowest; l >= LevelHighest; l-- {
+		weights[l] = weight
+		weight *= ratio
+	}
+	return weights
+}
@@ -0,0 +1,96 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package taskpriority
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		level   Level
+		wantErr bool
+	}{
+		"name":           {value: "high", level: LevelHigh},
+		"name any case":  {value: " Lowest ", level: LevelLowest},
+		"number":         {value: "0", level: LevelHighest},
+		"out of range":   {value: "5", level: DefaultLevel, wantErr: true},
+		"negative":       {value: "-1", level: DefaultLevel, wantErr: true},
+		"unknown name":   {value: "urgent", level: DefaultLevel, wantErr: true},
+		"empty is error": {value: "", level: DefaultLevel, wantErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			level, err := Parse(test.value)
+			assert.Equal(t, test.level, level)
+			assert.Equal(t, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestLevelString(t *testing.T) {
+	for _, level := range Levels() {
+		parsed, err := Parse(level.String())
+		assert.NoError(t, err)
+		assert.Equal(t, level, parsed)
+	}
+	assert.Equal(t, "7", Level(7).String())
+}
+
+func TestFromName(t *testing.T) {
+	assert.Equal(t, DefaultLevel, FromName(""))
+	assert.Equal(t, DefaultLevel, FromName("invalid"))
+	assert.Equal(t, LevelLow, FromName("low"))
+}
+
+func TestTaskPriority(t *testing.T) {
+	assert.Equal(t, "", FromTaskPriority(nil))
+	assert.Equal(t, "", FromTaskPriority(types.TaskPriority(7).Ptr()))
+	assert.Nil(t, ToTaskPriority(""))
+	assert.Nil(t, ToTaskPriority("invalid"))
+
+	for _, priority := range []types.TaskPriority{
+		types.TaskPriorityHighest,
+		types.TaskPriorityHigh,
+		types.TaskPriorityNormal,
+		types.TaskPriorityLow,
+		types.TaskPriorityLowest,
+	} {
+		name := FromTaskPriority(priority.Ptr())
+		assert.Equal(t, strings.ToLower(priority.String()), name)
+		assert.Equal(t, &priority, ToTaskPriority(name))
+		assert.Equal(t, Level(priority), FromName(name))
+	}
+}
+
+func TestWeights(t *testing.T) {
+	assert.Equal(t, [NumLevels]int{16, 8, 4, 2, 1}, Weights(2))
+	assert.Equal(t, [NumLevels]int{1, 1, 1, 1, 1}, Weights(0))
+	assert.Equal(t, [NumLevels]int{1000000000000, 1000000000, 1000000, 1000, 1}, Weights(1000000))
+}
@@ -0,0 +1,76 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+)
+
+func TestNewTracerProvider_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	provider, err := NewTracerProvider(&config.Tracing{
+		Exporter: config.TracingExporterFile,
+		File:     config.FileTracing{Path: path},
+	}, "cadence-frontend")
+	require.NoError(t, err)
+
+	_, span := provider.Tracer(instrumentationName).Start(context.Background(), "test-span")
+	EndSpan(span, errors.New("test-error"))
+	require.NoError(t, provider.Shutdown(context.Background()))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "test-span")
+	assert.Contains(t, string(content), "test-error")
+	assert.Contains(t, string(content), "cadence-frontend")
+}
+
+func TestNewTracerProvider_OTLP(t *testing.T) {
+	provider, err := NewTracerProvider(&config.Tracing{
+		Exporter: config.TracingExporterOTLP,
+		OTLP:     config.OTLPTracing{Endpoint: "localhost:4317", Insecure: true},
+	}, "cadence-history")
+	require.NoError(t, err)
+	// nothing was exported, so this does not need a collector
+	require.NoError(t, provider.Shutdown(context.Background()))
+}
+
+func TestNewTracerProvider_Invalid(t *testing.T) {
+	_, err := NewTracerProvider(&config.Tracing{Exporter: "jaeger"}, "cadence-matching")
+	assert.EqualError(t, err, `unknown tracing exporter "jaeger"`)
+
+	_, err = NewTracerProvider(&config.Tracing{
+		Exporter: config.TracingExporterFile,
+		File:     config.FileTracing{Path: filepath.Join(t.TempDir(), "missing", "traces.json")},
+	}, "cadence-matching")
+	assert.ErrorContains(t, err, "open tracing file")
+}
@@ -0,0 +1,67 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tracingfx
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/tracing"
+)
+
+// Module installs the global tracer provider for fx application, if tracing is enabled.
+var Module = fx.Module("tracingfx",
+	fx.Invoke(setupTracerProvider))
+
+type params struct {
+	fx.In
+
+	Config          config.Config
+	Logger          log.Logger
+	ServiceFullName string `name:"service-full-name"`
+	Lifecycle       fx.Lifecycle
+}
+
+func setupTracerProvider(p params) error {
+	if !p.Config.Tracing.Enabled() {
+		return nil
+	}
+	if tracing.Enabled() {
+		// services running in the same process share the tracer provider of the first one
+		return nil
+	}
+
+	provider, err := tracing.NewTracerProvider(&p.Config.Tracing, p.ServiceFullName)
+	if err != nil {
+		return err
+	}
+	otel.SetTracerProvider(provider)
+	p.Logger.Info("Tracing enabled", tag.Value(p.Config.Tracing.Exporter))
+
+	// flush the spans not exported yet on shutdown
+	p.Lifecycle.Append(fx.StopHook(provider.Shutdown))
+	return nil
+}
@@ -65,6 +65,14 @@ func EventTypeValues() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeWorkflowExecutionSearchAttributesUpdated,
+		EventTypeWorkflowExecutionPaused,
+		EventTypeWorkflowExecutionUnpaused,
+		EventTypeActivityTaskPaused,
+		EventTypeActivityTaskUnpaused,
+		EventTypeWorkflowExecutionUpdateAccepted,
+		EventTypeWorkflowExecutionUpdateRejected,
+		EventTypeWorkflowExecutionUpdateCompleted,
 	}
 }
 
@@ -84,5 +92,8 @@ func DecisionTypeValues() []DecisionType {
 		DecisionTypeStartChildWorkflowExecution,
 		DecisionTypeSignalExternalWorkflowExecution,
 		DecisionTypeUpsertWorkflowSearchAttributes,
+		DecisionTypeAcceptWorkflowUpdate,
+		DecisionTypeRejectWorkflowUpdate,
+		DecisionTypeCompleteWorkflowUpdate,
 	}
 }
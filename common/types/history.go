@@ -271,6 +271,28 @@ func (v *ParentExecutionInfo) GetExecution() (o *WorkflowExecution) {
 	return
 }
 
+// HistoryPauseWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryPauseWorkflowExecutionRequest struct {
+	DomainUUID   string                         `json:"domainUUID,omitempty"`
+	PauseRequest *PauseWorkflowExecutionRequest `json:"pauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryPauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetPauseRequest is an internal getter (TBD...)
+func (v *HistoryPauseWorkflowExecutionRequest) GetPauseRequest() (o *PauseWorkflowExecutionRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+	return
+}
+
 // PollMutableStateRequest is an internal type (TBD...)
 type PollMutableStateRequest struct {
 	DomainUUID          string              `json:"domainUUID,omitempty"`
@@ -684,6 +706,7 @@ type RecordDecisionTaskStartedResponse struct {
 	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
 	HistorySize               int64                     `json:"historySize,omitempty"`
+	Updates                   []*WorkflowUpdate         `json:"updates,omitempty"`
 }
 
 // GetPreviousStartedEventID is an internal getter (TBD...)
@@ -754,6 +777,7 @@ type ReplicateEventsV2Request struct {
 	VersionHistoryItems []*VersionHistoryItem `json:"versionHistoryItems,omitempty"`
 	Events              *DataBlob             `json:"events,omitempty"`
 	NewRunEvents        *DataBlob             `json:"newRunEvents,omitempty"`
+	Restored            bool                  `json:"restored,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -764,6 +788,14 @@ func (v *ReplicateEventsV2Request) GetDomainUUID() (o string) {
 	return
 }
 
+// GetRestored is an internal getter (TBD...)
+func (v *ReplicateEventsV2Request) GetRestored() (o bool) {
+	if v != nil {
+		return v.Restored
+	}
+	return
+}
+
 // HistoryRequestCancelWorkflowExecutionRequest is an internal type (TBD...)
 type HistoryRequestCancelWorkflowExecutionRequest struct {
 	DomainUUID                string                                 `json:"domainUUID,omitempty"`
@@ -805,6 +837,28 @@ func (v *HistoryRequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() (o
 	return
 }
 
+// HistoryResetActivityRequest is an internal type (TBD...)
+type HistoryResetActivityRequest struct {
+	DomainUUID   string                `json:"domainUUID,omitempty"`
+	ResetRequest *ResetActivityRequest `json:"resetRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryResetActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetResetRequest is an internal getter (TBD...)
+func (v *HistoryResetActivityRequest) GetResetRequest() (o *ResetActivityRequest) {
+	if v != nil && v.ResetRequest != nil {
+		return v.ResetRequest
+	}
+	return
+}
+
 // HistoryResetStickyTaskListRequest is an internal type (TBD...)
 type HistoryResetStickyTaskListRequest struct {
 	DomainUUID string             `json:"domainUUID,omitempty"`
@@ -1262,6 +1316,99 @@ func (v *HistoryTerminateWorkflowExecutionRequest) GetChildWorkflowOnly() (o boo
 	return
 }
 
+// HistoryUpdateWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryUpdateWorkflowExecutionRequest struct {
+	DomainUUID    string                          `json:"domainUUID,omitempty"`
+	UpdateRequest *UpdateWorkflowExecutionRequest `json:"updateRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUpdateWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUpdateRequest is an internal getter (TBD...)
+func (v *HistoryUpdateWorkflowExecutionRequest) GetUpdateRequest() (o *UpdateWorkflowExecutionRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+	return
+}
+
+// HistoryUnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryUnpauseWorkflowExecutionRequest struct {
+	DomainUUID     string                           `json:"domainUUID,omitempty"`
+	UnpauseRequest *UnpauseWorkflowExecutionRequest `json:"unpauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUnpauseRequest is an internal getter (TBD...)
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetUnpauseRequest() (o *UnpauseWorkflowExecutionRequest) {
+	if v != nil && v.UnpauseRequest != nil {
+		return v.UnpauseRequest
+	}
+	return
+}
+
+// UpdateWorkflowSearchAttributesRequest is an internal type (TBD...)
+type UpdateWorkflowSearchAttributesRequest struct {
+	DomainUUID        string             `json:"domainUUID,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	SearchAttributes  *SearchAttributes  `json:"searchAttributes,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *UpdateWorkflowSearchAttributesRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateWorkflowSearchAttributesRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *UpdateWorkflowSearchAttributesRequest) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UpdateWorkflowSearchAttributesRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateWorkflowSearchAttributesRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // GetFailoverInfoRequest is an internal type (TBD...)
 type GetFailoverInfoRequest struct {
 	DomainID string `json:"domainID,omitempty"`
@@ -878,6 +878,21 @@ func TestReplicateEventsV2Request_GetDomainUUID(t *testing.T) {
 	assert.Equal(t, "", res)
 }
 
+func TestReplicateEventsV2Request_GetRestored(t *testing.T) {
+	testStruct := ReplicateEventsV2Request{
+		Restored: true,
+	}
+
+	// Non-nil struct test
+	res := testStruct.GetRestored()
+	assert.True(t, res)
+
+	// Nil struct test
+	var nilStruct *ReplicateEventsV2Request
+	res = nilStruct.GetRestored()
+	assert.False(t, res)
+}
+
 func TestHistoryRefreshWorkflowTasksRequest_GetRequest(t *testing.T) {
 	// Define a sample RefreshWorkflowTasksRequest
 	sampleRequest := &RefreshWorkflowTasksRequest{}
@@ -148,6 +148,28 @@ func ToActivityTaskFailedEventAttributes(t *apiv1.ActivityTaskFailedEventAttribu
 	}
 }
 
+func FromActivityTaskPausedEventAttributes(t *types.ActivityTaskPausedEventAttributes) *apiv1.ActivityTaskPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskPausedEventAttributes{
+		ScheduledEventId: t.ScheduledEventID,
+		Reason:           t.Reason,
+		Identity:         t.Identity,
+	}
+}
+
+func ToActivityTaskPausedEventAttributes(t *apiv1.ActivityTaskPausedEventAttributes) *types.ActivityTaskPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskPausedEventAttributes{
+		ScheduledEventID: t.ScheduledEventId,
+		Reason:           t.Reason,
+		Identity:         t.Identity,
+	}
+}
+
 func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEventAttributes) *apiv1.ActivityTaskScheduledEventAttributes {
 	if t == nil {
 		return nil
@@ -165,6 +187,7 @@ func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEven
 		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
 		RetryPolicy:                  FromRetryPolicy(t.RetryPolicy),
 		Header:                       FromHeader(t.Header),
+		TaskPriority:                 FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -185,6 +208,7 @@ func ToActivityTaskScheduledEventAttributes(t *apiv1.ActivityTaskScheduledEventA
 		DecisionTaskCompletedEventID:  t.DecisionTaskCompletedEventId,
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
+		TaskPriority:                  ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -242,6 +266,28 @@ func ToActivityTaskTimedOutEventAttributes(t *apiv1.ActivityTaskTimedOutEventAtt
 	}
 }
 
+func FromActivityTaskUnpausedEventAttributes(t *types.ActivityTaskUnpausedEventAttributes) *apiv1.ActivityTaskUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventId: t.ScheduledEventID,
+		Reason:           t.Reason,
+		Identity:         t.Identity,
+	}
+}
+
+func ToActivityTaskUnpausedEventAttributes(t *apiv1.ActivityTaskUnpausedEventAttributes) *types.ActivityTaskUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventID: t.ScheduledEventId,
+		Reason:           t.Reason,
+		Identity:         t.Identity,
+	}
+}
+
 func FromActivityType(t *types.ActivityType) *apiv1.ActivityType {
 	if t == nil {
 		return nil
@@ -847,6 +893,12 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) apiv1.Decisio
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES
+	case types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_ACCEPT_WORKFLOW_UPDATE_ATTRIBUTES
+	case types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REJECT_WORKFLOW_UPDATE_ATTRIBUTES
+	case types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES
 	}
 	return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID
 }
@@ -901,6 +953,12 @@ func ToDecisionTaskFailedCause(t apiv1.DecisionTaskFailedCause) *types.DecisionT
 		return types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr()
 	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES:
 		return types.DecisionTaskFailedCauseBadSearchAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_ACCEPT_WORKFLOW_UPDATE_ATTRIBUTES:
+		return types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REJECT_WORKFLOW_UPDATE_ATTRIBUTES:
+		return types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES:
+		return types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes.Ptr()
 	}
 	return nil
 }
@@ -1288,6 +1346,7 @@ func FromDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecutionRes
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              FromPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -1301,6 +1360,7 @@ func ToDescribeWorkflowExecutionResponse(t *apiv1.DescribeWorkflowExecutionRespo
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              ToPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -2019,6 +2079,54 @@ func ToParentClosePolicy(t apiv1.ParentClosePolicy) *types.ParentClosePolicy {
 	return nil
 }
 
+func FromPauseInfo(t *types.PauseInfo) *apiv1.PauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseInfo{
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+		PausedTime: unixNanoToTime(t.PausedTimestamp),
+	}
+}
+
+func ToPauseInfo(t *apiv1.PauseInfo) *types.PauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseInfo{
+		Reason:          t.Reason,
+		Identity:        t.Identity,
+		PausedTimestamp: timeToUnixNano(t.PausedTime),
+	}
+}
+
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *apiv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func ToPauseWorkflowExecutionRequest(t *apiv1.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
 func FromPendingActivityInfo(t *types.PendingActivityInfo) *apiv1.PendingActivityInfo {
 	if t == nil {
 		return nil
@@ -2038,6 +2146,7 @@ func FromPendingActivityInfo(t *types.PendingActivityInfo) *apiv1.PendingActivit
 		LastWorkerIdentity:    t.LastWorkerIdentity,
 		StartedWorkerIdentity: t.StartedWorkerIdentity,
 		ScheduleId:            t.ScheduleID,
+		PauseInfo:             FromPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -2061,6 +2170,7 @@ func ToPendingActivityInfo(t *apiv1.PendingActivityInfo) *types.PendingActivityI
 		LastWorkerIdentity:     t.LastWorkerIdentity,
 		StartedWorkerIdentity:  t.StartedWorkerIdentity,
 		ScheduleID:             t.ScheduleId,
+		PauseInfo:              ToPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -2293,6 +2403,7 @@ func FromPollForDecisionTaskResponse(t *types.PollForDecisionTaskResponse) *apiv
 		NextEventId:               t.NextEventID,
 		TotalHistoryBytes:         t.TotalHistoryBytes,
 		AutoConfigHint:            FromAutoConfigHint(t.AutoConfigHint),
+		Updates:                   FromWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -2318,6 +2429,7 @@ func ToPollForDecisionTaskResponse(t *apiv1.PollForDecisionTaskResponse) *types.
 		NextEventID:               t.NextEventId,
 		TotalHistoryBytes:         t.TotalHistoryBytes,
 		AutoConfigHint:            ToAutoConfigHint(t.AutoConfigHint),
+		Updates:                   ToWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -2368,6 +2480,35 @@ func ToQueryConsistencyLevel(t apiv1.QueryConsistencyLevel) *types.QueryConsiste
 	return nil
 }
 
+func FromWorkflowUpdateStage(t *types.WorkflowUpdateStage) apiv1.WorkflowUpdateStage {
+	if t == nil {
+		return apiv1.WorkflowUpdateStage_WORKFLOW_UPDATE_STAGE_INVALID
+	}
+	switch *t {
+	case types.WorkflowUpdateStageAccepted:
+		return apiv1.WorkflowUpdateStage_WORKFLOW_UPDATE_STAGE_ACCEPTED
+	case types.WorkflowUpdateStageRejected:
+		return apiv1.WorkflowUpdateStage_WORKFLOW_UPDATE_STAGE_REJECTED
+	case types.WorkflowUpdateStageCompleted:
+		return apiv1.WorkflowUpdateStage_WORKFLOW_UPDATE_STAGE_COMPLETED
+	}
+	return apiv1.WorkflowUpdateStage_WORKFLOW_UPDATE_STAGE_INVALID
+}
+
+func ToWorkflowUpdateStage(t apiv1.WorkflowUpdateStage) *types.WorkflowUpdateStage {
+	switch t {
+	case apiv1.WorkflowUpdateStage_WORKFLOW_UPDATE_STAGE_INVALID:
+		return nil
+	case apiv1.WorkflowUpdateStage_WORKFLOW_UPDATE_STAGE_ACCEPTED:
+		return types.WorkflowUpdateStageAccepted.Ptr()
+	case apiv1.WorkflowUpdateStage_WORKFLOW_UPDATE_STAGE_REJECTED:
+		return types.WorkflowUpdateStageRejected.Ptr()
+	case apiv1.WorkflowUpdateStage_WORKFLOW_UPDATE_STAGE_COMPLETED:
+		return types.WorkflowUpdateStageCompleted.Ptr()
+	}
+	return nil
+}
+
 func FromQueryRejectCondition(t *types.QueryRejectCondition) apiv1.QueryRejectCondition {
 	if t == nil {
 		return apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_INVALID
@@ -2785,6 +2926,30 @@ func ToRequestCancelWorkflowExecutionRequest(t *apiv1.RequestCancelWorkflowExecu
 	}
 }
 
+func FromResetActivityRequest(t *types.ResetActivityRequest) *apiv1.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToResetActivityRequest(t *apiv1.ResetActivityRequest) *types.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
 func FromResetPointInfo(t *types.ResetPointInfo) *apiv1.ResetPointInfo {
 	if t == nil {
 		return nil
@@ -3308,6 +3473,7 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
 		Header:                 FromHeader(t.Header),
 		RequestLocalDispatch:   t.RequestLocalDispatch,
+		TaskPriority:           FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -3328,6 +3494,7 @@ func ToScheduleActivityTaskDecisionAttributes(t *apiv1.ScheduleActivityTaskDecis
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.RequestLocalDispatch,
+		TaskPriority:                  ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -3486,6 +3653,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 			FirstRunAt:                   unixNanoToTime(t.FirstRunAtTimestamp),
 			CronOverlapPolicy:            FromCronOverlapPolicy(t.CronOverlapPolicy),
 			ActiveClusterSelectionPolicy: FromActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+			TaskPriority:                 FromTaskPriority(t.TaskPriority),
 		},
 		SignalName:  t.SignalName,
 		SignalInput: FromPayload(t.SignalInput),
@@ -3521,6 +3689,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *apiv1.SignalWithStartWorkflowE
 		FirstRunAtTimestamp:                 timeToUnixNano(t.StartRequest.FirstRunAt),
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.StartRequest.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.StartRequest.ActiveClusterSelectionPolicy),
+		TaskPriority:                        ToTaskPriority(t.StartRequest.TaskPriority),
 	}
 }
 
@@ -3572,6 +3741,61 @@ func ToSignalWorkflowExecutionRequest(t *apiv1.SignalWorkflowExecutionRequest) *
 	}
 }
 
+func FromUpdateWorkflowExecutionRequest(t *types.UpdateWorkflowExecutionRequest) *apiv1.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		UpdateId:          t.UpdateID,
+		UpdateName:        t.UpdateName,
+		Input:             FromPayload(t.Input),
+		Identity:          t.Identity,
+		WaitForStage:      FromWorkflowUpdateStage(t.WaitForStage),
+	}
+}
+
+func ToUpdateWorkflowExecutionRequest(t *apiv1.UpdateWorkflowExecutionRequest) *types.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		UpdateID:          t.UpdateId,
+		UpdateName:        t.UpdateName,
+		Input:             ToPayload(t.Input),
+		Identity:          t.Identity,
+		WaitForStage:      ToWorkflowUpdateStage(t.WaitForStage),
+	}
+}
+
+func FromUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *apiv1.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateWorkflowExecutionResponse{
+		UpdateId: t.UpdateID,
+		Stage:    FromWorkflowUpdateStage(t.Stage),
+		Result:   FromPayload(t.Result),
+		Failure:  FromFailure(t.FailureReason, t.FailureDetails),
+	}
+}
+
+func ToUpdateWorkflowExecutionResponse(t *apiv1.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		UpdateID:       t.UpdateId,
+		Stage:          ToWorkflowUpdateStage(t.Stage),
+		Result:         ToPayload(t.Result),
+		FailureReason:  ToFailureReason(t.Failure),
+		FailureDetails: ToFailureDetails(t.Failure),
+	}
+}
+
 func FromStartChildWorkflowExecutionDecisionAttributes(t *types.StartChildWorkflowExecutionDecisionAttributes) *apiv1.StartChildWorkflowExecutionDecisionAttributes {
 	if t == nil {
 		return nil
@@ -3859,6 +4083,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		FirstRunAt:                   unixNanoToTime(t.FirstRunAtTimeStamp),
 		CronOverlapPolicy:            FromCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy: FromActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		TaskPriority:                 FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -3887,6 +4112,7 @@ func ToStartWorkflowExecutionRequest(t *apiv1.StartWorkflowExecutionRequest) *ty
 		FirstRunAtTimeStamp:                 timeToUnixNano(t.FirstRunAt),
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		TaskPriority:                        ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -4040,6 +4266,43 @@ func ToTaskListKind(t apiv1.TaskListKind) *types.TaskListKind {
 	return nil
 }
 
+func FromTaskPriority(t *types.TaskPriority) apiv1.TaskPriority {
+	if t == nil {
+		return apiv1.TaskPriority_TASK_PRIORITY_INVALID
+	}
+	switch *t {
+	case types.TaskPriorityHighest:
+		return apiv1.TaskPriority_TASK_PRIORITY_HIGHEST
+	case types.TaskPriorityHigh:
+		return apiv1.TaskPriority_TASK_PRIORITY_HIGH
+	case types.TaskPriorityNormal:
+		return apiv1.TaskPriority_TASK_PRIORITY_NORMAL
+	case types.TaskPriorityLow:
+		return apiv1.TaskPriority_TASK_PRIORITY_LOW
+	case types.TaskPriorityLowest:
+		return apiv1.TaskPriority_TASK_PRIORITY_LOWEST
+	}
+	return apiv1.TaskPriority_TASK_PRIORITY_INVALID
+}
+
+func ToTaskPriority(t apiv1.TaskPriority) *types.TaskPriority {
+	switch t {
+	case apiv1.TaskPriority_TASK_PRIORITY_INVALID:
+		return nil
+	case apiv1.TaskPriority_TASK_PRIORITY_HIGHEST:
+		return types.TaskPriorityHighest.Ptr()
+	case apiv1.TaskPriority_TASK_PRIORITY_HIGH:
+		return types.TaskPriorityHigh.Ptr()
+	case apiv1.TaskPriority_TASK_PRIORITY_NORMAL:
+		return types.TaskPriorityNormal.Ptr()
+	case apiv1.TaskPriority_TASK_PRIORITY_LOW:
+		return types.TaskPriorityLow.Ptr()
+	case apiv1.TaskPriority_TASK_PRIORITY_LOWEST:
+		return types.TaskPriorityLowest.Ptr()
+	}
+	return nil
+}
+
 func FromTaskListMetadata(t *types.TaskListMetadata) *apiv1.TaskListMetadata {
 	if t == nil {
 		return nil
@@ -4083,14 +4346,15 @@ func FromTaskListStatus(t *types.TaskListStatus) *apiv1.TaskListStatus {
 		return nil
 	}
 	return &apiv1.TaskListStatus{
-		BacklogCountHint:      t.BacklogCountHint,
-		ReadLevel:             t.ReadLevel,
-		AckLevel:              t.AckLevel,
-		RatePerSecond:         t.RatePerSecond,
-		TaskIdBlock:           FromTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
-		NewTasksPerSecond:     t.NewTasksPerSecond,
-		Empty:                 t.Empty,
+		BacklogCountHint:       t.BacklogCountHint,
+		ReadLevel:              t.ReadLevel,
+		AckLevel:               t.AckLevel,
+		RatePerSecond:          t.RatePerSecond,
+		TaskIdBlock:            FromTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:  FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
+		NewTasksPerSecond:      t.NewTasksPerSecond,
+		Empty:                  t.Empty,
+		BacklogCountByPriority: t.BacklogCountByPriority,
 	}
 }
 
@@ -4099,14 +4363,15 @@ func ToTaskListStatus(t *apiv1.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint:      t.BacklogCountHint,
-		ReadLevel:             t.ReadLevel,
-		AckLevel:              t.AckLevel,
-		RatePerSecond:         t.RatePerSecond,
-		TaskIDBlock:           ToTaskIDBlock(t.TaskIdBlock),
-		IsolationGroupMetrics: ToIsolationGroupMetricsMap(t.IsolationGroupMetrics),
-		NewTasksPerSecond:     t.NewTasksPerSecond,
-		Empty:                 t.Empty,
+		BacklogCountHint:       t.BacklogCountHint,
+		ReadLevel:              t.ReadLevel,
+		AckLevel:               t.AckLevel,
+		RatePerSecond:          t.RatePerSecond,
+		TaskIDBlock:            ToTaskIDBlock(t.TaskIdBlock),
+		IsolationGroupMetrics:  ToIsolationGroupMetricsMap(t.IsolationGroupMetrics),
+		NewTasksPerSecond:      t.NewTasksPerSecond,
+		Empty:                  t.Empty,
+		BacklogCountByPriority: t.BacklogCountByPriority,
 	}
 }
 
@@ -4324,6 +4589,32 @@ const (
 	DomainUpdateFailoverTimeoutField          = "failover_timeout"
 )
 
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *apiv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UnpauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func ToUnpauseWorkflowExecutionRequest(t *apiv1.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
 	if t == nil {
 		return nil
@@ -4531,6 +4822,7 @@ func FromFailoverDomainRequest(t *types.FailoverDomainRequest) *apiv1.FailoverDo
 		DomainName:              t.DomainName,
 		DomainActiveClusterName: t.GetDomainActiveClusterName(),
 		ActiveClusters:          FromActiveClusters(t.ActiveClusters),
+		Reason:                  t.Reason,
 	}
 }
 
@@ -4542,6 +4834,7 @@ func ToFailoverDomainRequest(t *apiv1.FailoverDomainRequest) *types.FailoverDoma
 		DomainName:              t.DomainName,
 		DomainActiveClusterName: common.StringPtr(t.DomainActiveClusterName),
 		ActiveClusters:          ToActiveClusters(t.ActiveClusters),
+		Reason:                  t.Reason,
 	}
 }
 
@@ -4860,6 +5153,74 @@ func ToUpsertWorkflowSearchAttributesDecisionAttributes(t *apiv1.UpsertWorkflowS
 	}
 }
 
+func FromAcceptWorkflowUpdateDecisionAttributes(t *types.AcceptWorkflowUpdateDecisionAttributes) *apiv1.AcceptWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.AcceptWorkflowUpdateDecisionAttributes{
+		UpdateId:   t.UpdateID,
+		UpdateName: t.UpdateName,
+		Input:      FromPayload(t.Input),
+	}
+}
+
+func ToAcceptWorkflowUpdateDecisionAttributes(t *apiv1.AcceptWorkflowUpdateDecisionAttributes) *types.AcceptWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.AcceptWorkflowUpdateDecisionAttributes{
+		UpdateID:   t.UpdateId,
+		UpdateName: t.UpdateName,
+		Input:      ToPayload(t.Input),
+	}
+}
+
+func FromRejectWorkflowUpdateDecisionAttributes(t *types.RejectWorkflowUpdateDecisionAttributes) *apiv1.RejectWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RejectWorkflowUpdateDecisionAttributes{
+		UpdateId:   t.UpdateID,
+		UpdateName: t.UpdateName,
+		Failure:    FromFailure(&t.Reason, t.Details),
+	}
+}
+
+func ToRejectWorkflowUpdateDecisionAttributes(t *apiv1.RejectWorkflowUpdateDecisionAttributes) *types.RejectWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.RejectWorkflowUpdateDecisionAttributes{
+		UpdateID:   t.UpdateId,
+		UpdateName: t.UpdateName,
+		Reason:     t.GetFailure().GetReason(),
+		Details:    ToFailureDetails(t.Failure),
+	}
+}
+
+func FromCompleteWorkflowUpdateDecisionAttributes(t *types.CompleteWorkflowUpdateDecisionAttributes) *apiv1.CompleteWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateId: t.UpdateID,
+		Result:   FromPayload(t.Result),
+		Failure:  FromFailure(t.FailureReason, t.FailureDetails),
+	}
+}
+
+func ToCompleteWorkflowUpdateDecisionAttributes(t *apiv1.CompleteWorkflowUpdateDecisionAttributes) *types.CompleteWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateID:       t.UpdateId,
+		Result:         ToPayload(t.Result),
+		FailureReason:  ToFailureReason(t.Failure),
+		FailureDetails: ToFailureDetails(t.Failure),
+	}
+}
+
 func FromUpsertWorkflowSearchAttributesEventAttributes(t *types.UpsertWorkflowSearchAttributesEventAttributes) *apiv1.UpsertWorkflowSearchAttributesEventAttributes {
 	if t == nil {
 		return nil
@@ -4900,77 +5261,191 @@ func ToWorkerVersionInfo(t *apiv1.WorkerVersionInfo) *types.WorkerVersionInfo {
 	}
 }
 
-func FromWorkflowRunPair(workflowID, runID string) *apiv1.WorkflowExecution {
-	return &apiv1.WorkflowExecution{
-		WorkflowId: workflowID,
-		RunId:      runID,
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *apiv1.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionPausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
 	}
 }
 
-func ToWorkflowID(t *apiv1.WorkflowExecution) string {
+func ToWorkflowExecutionPausedEventAttributes(t *apiv1.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
 	if t == nil {
-		return ""
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
 	}
-	return t.WorkflowId
 }
 
-func ToRunID(t *apiv1.WorkflowExecution) string {
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *apiv1.WorkflowExecutionUnpausedEventAttributes {
 	if t == nil {
-		return ""
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
 	}
-	return t.RunId
 }
 
-func FromWorkflowExecution(t *types.WorkflowExecution) *apiv1.WorkflowExecution {
+func ToWorkflowExecutionUnpausedEventAttributes(t *apiv1.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
 	if t == nil {
 		return nil
 	}
-	return &apiv1.WorkflowExecution{
-		WorkflowId: t.WorkflowID,
-		RunId:      t.RunID,
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
 	}
 }
 
-func ToWorkflowExecution(t *apiv1.WorkflowExecution) *types.WorkflowExecution {
+func FromWorkflowExecutionUpdateAcceptedEventAttributes(t *types.WorkflowExecutionUpdateAcceptedEventAttributes) *apiv1.WorkflowExecutionUpdateAcceptedEventAttributes {
 	if t == nil {
 		return nil
 	}
-	return &types.WorkflowExecution{
-		WorkflowID: t.WorkflowId,
-		RunID:      t.RunId,
+	return &apiv1.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateId:                     t.UpdateID,
+		UpdateName:                   t.UpdateName,
+		Input:                        FromPayload(t.Input),
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
 	}
 }
 
-func FromExternalExecutionInfoFields(we *types.WorkflowExecution, initiatedID *int64) *apiv1.ExternalExecutionInfo {
-	if we == nil && initiatedID == nil {
+func ToWorkflowExecutionUpdateAcceptedEventAttributes(t *apiv1.WorkflowExecutionUpdateAcceptedEventAttributes) *types.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
 		return nil
 	}
-	return &apiv1.ExternalExecutionInfo{
-		WorkflowExecution: FromWorkflowExecution(we),
-		InitiatedId:       common.Int64Default(initiatedID), // TODO: we need to figure out whetherrr this field is needed or not
+	return &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:                     t.UpdateId,
+		UpdateName:                   t.UpdateName,
+		Input:                        ToPayload(t.Input),
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
 	}
 }
 
-func ToExternalWorkflowExecution(t *apiv1.ExternalExecutionInfo) *types.WorkflowExecution {
+func FromWorkflowExecutionUpdateRejectedEventAttributes(t *types.WorkflowExecutionUpdateRejectedEventAttributes) *apiv1.WorkflowExecutionUpdateRejectedEventAttributes {
 	if t == nil {
 		return nil
 	}
-	return ToWorkflowExecution(t.WorkflowExecution)
+	return &apiv1.WorkflowExecutionUpdateRejectedEventAttributes{
+		UpdateId:                     t.UpdateID,
+		UpdateName:                   t.UpdateName,
+		Failure:                      FromFailure(&t.Reason, t.Details),
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+	}
 }
 
-func ToExternalInitiatedID(t *apiv1.ExternalExecutionInfo) *int64 {
+func ToWorkflowExecutionUpdateRejectedEventAttributes(t *apiv1.WorkflowExecutionUpdateRejectedEventAttributes) *types.WorkflowExecutionUpdateRejectedEventAttributes {
 	if t == nil {
 		return nil
 	}
-	return &t.InitiatedId
+	return &types.WorkflowExecutionUpdateRejectedEventAttributes{
+		UpdateID:                     t.UpdateId,
+		UpdateName:                   t.UpdateName,
+		Reason:                       t.GetFailure().GetReason(),
+		Details:                      ToFailureDetails(t.Failure),
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+	}
 }
 
-func FromWorkflowExecutionCancelRequestedEventAttributes(t *types.WorkflowExecutionCancelRequestedEventAttributes) *apiv1.WorkflowExecutionCancelRequestedEventAttributes {
+func FromWorkflowExecutionUpdateCompletedEventAttributes(t *types.WorkflowExecutionUpdateCompletedEventAttributes) *apiv1.WorkflowExecutionUpdateCompletedEventAttributes {
 	if t == nil {
 		return nil
 	}
-	return &apiv1.WorkflowExecutionCancelRequestedEventAttributes{
-		Cause:                 t.Cause,
+	return &apiv1.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateId:                     t.UpdateID,
+		Result:                       FromPayload(t.Result),
+		Failure:                      FromFailure(t.FailureReason, t.FailureDetails),
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+	}
+}
+
+func ToWorkflowExecutionUpdateCompletedEventAttributes(t *apiv1.WorkflowExecutionUpdateCompletedEventAttributes) *types.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     t.UpdateId,
+		Result:                       ToPayload(t.Result),
+		FailureReason:                ToFailureReason(t.Failure),
+		FailureDetails:               ToFailureDetails(t.Failure),
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+	}
+}
+
+func FromWorkflowRunPair(workflowID, runID string) *apiv1.WorkflowExecution {
+	return &apiv1.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      runID,
+	}
+}
+
+func ToWorkflowID(t *apiv1.WorkflowExecution) string {
+	if t == nil {
+		return ""
+	}
+	return t.WorkflowId
+}
+
+func ToRunID(t *apiv1.WorkflowExecution) string {
+	if t == nil {
+		return ""
+	}
+	return t.RunId
+}
+
+func FromWorkflowExecution(t *types.WorkflowExecution) *apiv1.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecution{
+		WorkflowId: t.WorkflowID,
+		RunId:      t.RunID,
+	}
+}
+
+func ToWorkflowExecution(t *apiv1.WorkflowExecution) *types.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecution{
+		WorkflowID: t.WorkflowId,
+		RunID:      t.RunId,
+	}
+}
+
+func FromExternalExecutionInfoFields(we *types.WorkflowExecution, initiatedID *int64) *apiv1.ExternalExecutionInfo {
+	if we == nil && initiatedID == nil {
+		return nil
+	}
+	return &apiv1.ExternalExecutionInfo{
+		WorkflowExecution: FromWorkflowExecution(we),
+		InitiatedId:       common.Int64Default(initiatedID), // TODO: we need to figure out whetherrr this field is needed or not
+	}
+}
+
+func ToExternalWorkflowExecution(t *apiv1.ExternalExecutionInfo) *types.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	return ToWorkflowExecution(t.WorkflowExecution)
+}
+
+func ToExternalInitiatedID(t *apiv1.ExternalExecutionInfo) *int64 {
+	if t == nil {
+		return nil
+	}
+	return &t.InitiatedId
+}
+
+func FromWorkflowExecutionCancelRequestedEventAttributes(t *types.WorkflowExecutionCancelRequestedEventAttributes) *apiv1.WorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                 t.Cause,
 		ExternalExecutionInfo: FromExternalExecutionInfoFields(t.ExternalWorkflowExecution, t.ExternalInitiatedEventID),
 		Identity:              t.Identity,
 		RequestId:             t.RequestID,
@@ -5309,6 +5784,28 @@ func ToWorkflowExecutionInfo(t *apiv1.WorkflowExecutionInfo) *types.WorkflowExec
 	}
 }
 
+func FromWorkflowExecutionSearchAttributesUpdatedEventAttributes(t *types.WorkflowExecutionSearchAttributesUpdatedEventAttributes) *apiv1.WorkflowExecutionSearchAttributesUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+		Reason:           t.Reason,
+		Identity:         t.Identity,
+	}
+}
+
+func ToWorkflowExecutionSearchAttributesUpdatedEventAttributes(t *apiv1.WorkflowExecutionSearchAttributesUpdatedEventAttributes) *types.WorkflowExecutionSearchAttributesUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+		Reason:           t.Reason,
+		Identity:         t.Identity,
+	}
+}
+
 func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSignaledEventAttributes) *apiv1.WorkflowExecutionSignaledEventAttributes {
 	if t == nil {
 		return nil
@@ -5366,6 +5863,8 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		RequestId:                    t.RequestID,
 		CronOverlapPolicy:            FromCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy: FromActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		PauseInfo:                    FromPauseInfo(t.PauseInfo),
+		TaskPriority:                 FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -5405,6 +5904,8 @@ func ToWorkflowExecutionStartedEventAttributes(t *apiv1.WorkflowExecutionStarted
 		RequestID:                           t.RequestId,
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		PauseInfo:                           ToPauseInfo(t.PauseInfo),
+		TaskPriority:                        ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -5973,6 +6474,50 @@ func ToWorkflowQueryMap(t map[string]*apiv1.WorkflowQuery) map[string]*types.Wor
 	return v
 }
 
+func FromWorkflowUpdate(t *types.WorkflowUpdate) *apiv1.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowUpdate{
+		UpdateId:   t.UpdateID,
+		UpdateName: t.UpdateName,
+		Input:      FromPayload(t.Input),
+	}
+}
+
+func ToWorkflowUpdate(t *apiv1.WorkflowUpdate) *types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUpdate{
+		UpdateID:   t.UpdateId,
+		UpdateName: t.UpdateName,
+		Input:      ToPayload(t.Input),
+	}
+}
+
+func FromWorkflowUpdateArray(t []*types.WorkflowUpdate) []*apiv1.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowUpdate, len(t))
+	for i := range t {
+		v[i] = FromWorkflowUpdate(t[i])
+	}
+	return v
+}
+
+func ToWorkflowUpdateArray(t []*apiv1.WorkflowUpdate) []*types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowUpdate, len(t))
+	for i := range t {
+		v[i] = ToWorkflowUpdate(t[i])
+	}
+	return v
+}
+
 func FromWorkflowQueryResultMap(t map[string]*types.WorkflowQueryResult) map[string]*apiv1.WorkflowQueryResult {
 	if t == nil {
 		return nil
@@ -6265,6 +6810,38 @@ func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
 			UpsertWorkflowSearchAttributesEventAttributes: FromUpsertWorkflowSearchAttributesEventAttributes(e.UpsertWorkflowSearchAttributesEventAttributes),
 		}
+	case types.EventTypeWorkflowExecutionSearchAttributesUpdated:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+			WorkflowExecutionSearchAttributesUpdatedEventAttributes: FromWorkflowExecutionSearchAttributesUpdatedEventAttributes(e.WorkflowExecutionSearchAttributesUpdatedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionPaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes{
+			WorkflowExecutionPausedEventAttributes: FromWorkflowExecutionPausedEventAttributes(e.WorkflowExecutionPausedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUnpaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes{
+			WorkflowExecutionUnpausedEventAttributes: FromWorkflowExecutionUnpausedEventAttributes(e.WorkflowExecutionUnpausedEventAttributes),
+		}
+	case types.EventTypeActivityTaskPaused:
+		event.Attributes = &apiv1.HistoryEvent_ActivityTaskPausedEventAttributes{
+			ActivityTaskPausedEventAttributes: FromActivityTaskPausedEventAttributes(e.ActivityTaskPausedEventAttributes),
+		}
+	case types.EventTypeActivityTaskUnpaused:
+		event.Attributes = &apiv1.HistoryEvent_ActivityTaskUnpausedEventAttributes{
+			ActivityTaskUnpausedEventAttributes: FromActivityTaskUnpausedEventAttributes(e.ActivityTaskUnpausedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUpdateAccepted:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes{
+			WorkflowExecutionUpdateAcceptedEventAttributes: FromWorkflowExecutionUpdateAcceptedEventAttributes(e.WorkflowExecutionUpdateAcceptedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUpdateRejected:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUpdateRejectedEventAttributes{
+			WorkflowExecutionUpdateRejectedEventAttributes: FromWorkflowExecutionUpdateRejectedEventAttributes(e.WorkflowExecutionUpdateRejectedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes{
+			WorkflowExecutionUpdateCompletedEventAttributes: FromWorkflowExecutionUpdateCompletedEventAttributes(e.WorkflowExecutionUpdateCompletedEventAttributes),
+		}
 	}
 	return &event
 }
@@ -6406,6 +6983,30 @@ func ToHistoryEvent(e *apiv1.HistoryEvent) *types.HistoryEvent {
 	case *apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes:
 		event.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		event.UpsertWorkflowSearchAttributesEventAttributes = ToUpsertWorkflowSearchAttributesEventAttributes(attr.UpsertWorkflowSearchAttributesEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionSearchAttributesUpdatedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionSearchAttributesUpdated.Ptr()
+		event.WorkflowExecutionSearchAttributesUpdatedEventAttributes = ToWorkflowExecutionSearchAttributesUpdatedEventAttributes(attr.WorkflowExecutionSearchAttributesUpdatedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		event.WorkflowExecutionPausedEventAttributes = ToWorkflowExecutionPausedEventAttributes(attr.WorkflowExecutionPausedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		event.WorkflowExecutionUnpausedEventAttributes = ToWorkflowExecutionUnpausedEventAttributes(attr.WorkflowExecutionUnpausedEventAttributes)
+	case *apiv1.HistoryEvent_ActivityTaskPausedEventAttributes:
+		event.EventType = types.EventTypeActivityTaskPaused.Ptr()
+		event.ActivityTaskPausedEventAttributes = ToActivityTaskPausedEventAttributes(attr.ActivityTaskPausedEventAttributes)
+	case *apiv1.HistoryEvent_ActivityTaskUnpausedEventAttributes:
+		event.EventType = types.EventTypeActivityTaskUnpaused.Ptr()
+		event.ActivityTaskUnpausedEventAttributes = ToActivityTaskUnpausedEventAttributes(attr.ActivityTaskUnpausedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUpdateAccepted.Ptr()
+		event.WorkflowExecutionUpdateAcceptedEventAttributes = ToWorkflowExecutionUpdateAcceptedEventAttributes(attr.WorkflowExecutionUpdateAcceptedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUpdateRejectedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUpdateRejected.Ptr()
+		event.WorkflowExecutionUpdateRejectedEventAttributes = ToWorkflowExecutionUpdateRejectedEventAttributes(attr.WorkflowExecutionUpdateRejectedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUpdateCompleted.Ptr()
+		event.WorkflowExecutionUpdateCompletedEventAttributes = ToWorkflowExecutionUpdateCompletedEventAttributes(attr.WorkflowExecutionUpdateCompletedEventAttributes)
 	}
 	return &event
 }
@@ -6468,6 +7069,18 @@ func FromDecision(d *types.Decision) *apiv1.Decision {
 		decision.Attributes = &apiv1.Decision_UpsertWorkflowSearchAttributesDecisionAttributes{
 			UpsertWorkflowSearchAttributesDecisionAttributes: FromUpsertWorkflowSearchAttributesDecisionAttributes(d.UpsertWorkflowSearchAttributesDecisionAttributes),
 		}
+	case types.DecisionTypeAcceptWorkflowUpdate:
+		decision.Attributes = &apiv1.Decision_AcceptWorkflowUpdateDecisionAttributes{
+			AcceptWorkflowUpdateDecisionAttributes: FromAcceptWorkflowUpdateDecisionAttributes(d.AcceptWorkflowUpdateDecisionAttributes),
+		}
+	case types.DecisionTypeRejectWorkflowUpdate:
+		decision.Attributes = &apiv1.Decision_RejectWorkflowUpdateDecisionAttributes{
+			RejectWorkflowUpdateDecisionAttributes: FromRejectWorkflowUpdateDecisionAttributes(d.RejectWorkflowUpdateDecisionAttributes),
+		}
+	case types.DecisionTypeCompleteWorkflowUpdate:
+		decision.Attributes = &apiv1.Decision_CompleteWorkflowUpdateDecisionAttributes{
+			CompleteWorkflowUpdateDecisionAttributes: FromCompleteWorkflowUpdateDecisionAttributes(d.CompleteWorkflowUpdateDecisionAttributes),
+		}
 	}
 	return &decision
 }
@@ -6517,6 +7130,15 @@ func ToDecision(d *apiv1.Decision) *types.Decision {
 	case *apiv1.Decision_UpsertWorkflowSearchAttributesDecisionAttributes:
 		decision.DecisionType = types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr()
 		decision.UpsertWorkflowSearchAttributesDecisionAttributes = ToUpsertWorkflowSearchAttributesDecisionAttributes(attr.UpsertWorkflowSearchAttributesDecisionAttributes)
+	case *apiv1.Decision_AcceptWorkflowUpdateDecisionAttributes:
+		decision.DecisionType = types.DecisionTypeAcceptWorkflowUpdate.Ptr()
+		decision.AcceptWorkflowUpdateDecisionAttributes = ToAcceptWorkflowUpdateDecisionAttributes(attr.AcceptWorkflowUpdateDecisionAttributes)
+	case *apiv1.Decision_RejectWorkflowUpdateDecisionAttributes:
+		decision.DecisionType = types.DecisionTypeRejectWorkflowUpdate.Ptr()
+		decision.RejectWorkflowUpdateDecisionAttributes = ToRejectWorkflowUpdateDecisionAttributes(attr.RejectWorkflowUpdateDecisionAttributes)
+	case *apiv1.Decision_CompleteWorkflowUpdateDecisionAttributes:
+		decision.DecisionType = types.DecisionTypeCompleteWorkflowUpdate.Ptr()
+		decision.CompleteWorkflowUpdateDecisionAttributes = ToCompleteWorkflowUpdateDecisionAttributes(attr.CompleteWorkflowUpdateDecisionAttributes)
 	}
 	return &decision
 }
@@ -6744,6 +7366,411 @@ func ToCronOverlapPolicy(p apiv1.CronOverlapPolicy) *types.CronOverlapPolicy {
 	return nil
 }
 
+func FromScheduleOverlapPolicy(p *types.ScheduleOverlapPolicy) apiv1.ScheduleOverlapPolicy {
+	if p == nil {
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID
+	}
+	switch *p {
+	case types.ScheduleOverlapPolicySkip:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP
+	case types.ScheduleOverlapPolicyBuffer:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER
+	case types.ScheduleOverlapPolicyCancelPrevious:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_PREVIOUS
+	case types.ScheduleOverlapPolicyAllowAll:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL
+	}
+	return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID
+}
+
+func ToScheduleOverlapPolicy(p apiv1.ScheduleOverlapPolicy) *types.ScheduleOverlapPolicy {
+	switch p {
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP:
+		return types.ScheduleOverlapPolicySkip.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER:
+		return types.ScheduleOverlapPolicyBuffer.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_PREVIOUS:
+		return types.ScheduleOverlapPolicyCancelPrevious.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL:
+		return types.ScheduleOverlapPolicyAllowAll.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID:
+		return nil
+	}
+	return nil
+}
+
+func FromScheduleSpec(t *types.ScheduleSpec) *apiv1.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleSpec{
+		CronExpression: t.CronExpression,
+		Jitter:         secondsToDuration(&t.JitterInSeconds),
+		StartTime:      unixNanoToTime(t.StartTimestamp),
+		EndTime:        unixNanoToTime(t.EndTimestamp),
+	}
+}
+
+func ToScheduleSpec(t *apiv1.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronExpression:  t.CronExpression,
+		JitterInSeconds: common.Int32Default(durationToSeconds(t.Jitter)),
+		StartTimestamp:  timeToUnixNano(t.StartTime),
+		EndTimestamp:    timeToUnixNano(t.EndTime),
+	}
+}
+
+func FromScheduleAction(t *types.ScheduleAction) *apiv1.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleAction{
+		WorkflowType:                 FromWorkflowType(t.WorkflowType),
+		TaskList:                     FromTaskList(t.TaskList),
+		Input:                        FromPayload(t.Input),
+		ExecutionStartToCloseTimeout: secondsToDuration(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeout:      secondsToDuration(t.TaskStartToCloseTimeoutSeconds),
+	}
+}
+
+func ToScheduleAction(t *apiv1.ScheduleAction) *types.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleAction{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               ToPayload(t.Input),
+		ExecutionStartToCloseTimeoutSeconds: durationToSeconds(t.ExecutionStartToCloseTimeout),
+		TaskStartToCloseTimeoutSeconds:      durationToSeconds(t.TaskStartToCloseTimeout),
+	}
+}
+
+func FromScheduleActionResult(t *types.ScheduleActionResult) *apiv1.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleActionResult{
+		NominalTime:       unixNanoToTime(t.NominalTimestamp),
+		ActualTime:        unixNanoToTime(t.ActualTimestamp),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Skipped:           t.Skipped,
+		FailureReason:     t.FailureReason,
+	}
+}
+
+func ToScheduleActionResult(t *apiv1.ScheduleActionResult) *types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleActionResult{
+		NominalTimestamp:  timeToUnixNano(t.NominalTime),
+		ActualTimestamp:   timeToUnixNano(t.ActualTime),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Skipped:           t.Skipped,
+		FailureReason:     t.FailureReason,
+	}
+}
+
+func FromScheduleActionResultArray(t []*types.ScheduleActionResult) []*apiv1.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = FromScheduleActionResult(t[i])
+	}
+	return v
+}
+
+func ToScheduleActionResultArray(t []*apiv1.ScheduleActionResult) []*types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = ToScheduleActionResult(t[i])
+	}
+	return v
+}
+
+func FromWorkflowExecutionArray(t []*types.WorkflowExecution) []*apiv1.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecution(t[i])
+	}
+	return v
+}
+
+func ToWorkflowExecutionArray(t []*apiv1.WorkflowExecution) []*types.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecution(t[i])
+	}
+	return v
+}
+
+func FromCreateScheduleRequest(t *types.CreateScheduleRequest) *apiv1.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CreateScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleId:    t.ScheduleID,
+		Spec:          FromScheduleSpec(t.Spec),
+		Action:        FromScheduleAction(t.Action),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Paused:        t.Paused,
+		Identity:      t.Identity,
+	}
+}
+
+func ToCreateScheduleRequest(t *apiv1.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleID:    t.ScheduleId,
+		Spec:          ToScheduleSpec(t.Spec),
+		Action:        ToScheduleAction(t.Action),
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Paused:        t.Paused,
+		Identity:      t.Identity,
+	}
+}
+
+func FromDescribeScheduleRequest(t *types.DescribeScheduleRequest) *apiv1.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+	}
+}
+
+func ToDescribeScheduleRequest(t *apiv1.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+	}
+}
+
+func FromDescribeScheduleResponse(t *types.DescribeScheduleResponse) *apiv1.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeScheduleResponse{
+		Spec:              FromScheduleSpec(t.Spec),
+		Action:            FromScheduleAction(t.Action),
+		OverlapPolicy:     FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Paused:            t.Paused,
+		PauseReason:       t.PauseReason,
+		RunningExecutions: FromWorkflowExecutionArray(t.RunningExecutions),
+		RecentActions:     FromScheduleActionResultArray(t.RecentActions),
+		NextRunTimes:      unixNanoArrayToTime(t.NextRunTimestamps),
+		TotalActions:      t.TotalActions,
+		SkippedActions:    t.SkippedActions,
+	}
+}
+
+func ToDescribeScheduleResponse(t *apiv1.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:              ToScheduleSpec(t.Spec),
+		Action:            ToScheduleAction(t.Action),
+		OverlapPolicy:     ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Paused:            t.Paused,
+		PauseReason:       t.PauseReason,
+		RunningExecutions: ToWorkflowExecutionArray(t.RunningExecutions),
+		RecentActions:     ToScheduleActionResultArray(t.RecentActions),
+		NextRunTimestamps: timeArrayToUnixNano(t.NextRunTimes),
+		TotalActions:      t.TotalActions,
+		SkippedActions:    t.SkippedActions,
+	}
+}
+
+func FromUpdateScheduleRequest(t *types.UpdateScheduleRequest) *apiv1.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleId:    t.ScheduleID,
+		Spec:          FromScheduleSpec(t.Spec),
+		Action:        FromScheduleAction(t.Action),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.Identity,
+	}
+}
+
+func ToUpdateScheduleRequest(t *apiv1.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleID:    t.ScheduleId,
+		Spec:          ToScheduleSpec(t.Spec),
+		Action:        ToScheduleAction(t.Action),
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.Identity,
+	}
+}
+
+func FromPauseScheduleRequest(t *types.PauseScheduleRequest) *apiv1.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+	}
+}
+
+func ToPauseScheduleRequest(t *apiv1.PauseScheduleRequest) *types.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+	}
+}
+
+func FromResumeScheduleRequest(t *types.ResumeScheduleRequest) *apiv1.ResumeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResumeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Identity:   t.Identity,
+	}
+}
+
+func ToResumeScheduleRequest(t *apiv1.ResumeScheduleRequest) *types.ResumeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Identity:   t.Identity,
+	}
+}
+
+func FromBackfillScheduleRequest(t *types.BackfillScheduleRequest) *apiv1.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BackfillScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleId:    t.ScheduleID,
+		StartTime:     unixNanoToTime(t.StartTimestamp),
+		EndTime:       unixNanoToTime(t.EndTimestamp),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.Identity,
+	}
+}
+
+func ToBackfillScheduleRequest(t *apiv1.BackfillScheduleRequest) *types.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillScheduleRequest{
+		Domain:         t.Domain,
+		ScheduleID:     t.ScheduleId,
+		StartTimestamp: timeToUnixNano(t.StartTime),
+		EndTimestamp:   timeToUnixNano(t.EndTime),
+		OverlapPolicy:  ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:       t.Identity,
+	}
+}
+
+func FromDeleteScheduleRequest(t *types.DeleteScheduleRequest) *apiv1.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DeleteScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Identity:   t.Identity,
+	}
+}
+
+func ToDeleteScheduleRequest(t *apiv1.DeleteScheduleRequest) *types.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Identity:   t.Identity,
+	}
+}
+
+func FromListSchedulesRequest(t *types.ListSchedulesRequest) *apiv1.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListSchedulesRequest(t *apiv1.ListSchedulesRequest) *types.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromListSchedulesResponse(t *types.ListSchedulesResponse) *apiv1.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListSchedulesResponse{
+		ScheduleIds:   t.ScheduleIDs,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListSchedulesResponse(t *apiv1.ListSchedulesResponse) *types.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesResponse{
+		ScheduleIDs:   t.ScheduleIds,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
 func FromClusterAttribute(c *types.ClusterAttribute) *apiv1.ClusterAttribute {
 	if c == nil {
 		return nil
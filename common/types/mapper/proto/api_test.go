@@ -921,6 +921,172 @@ func TestWorkflowExecutionInfo_MigrateTaskList(t *testing.T) {
 	}
 }
 
+func TestWorkflowExecutionSearchAttributesUpdatedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionSearchAttributesUpdatedEventAttributes{nil, {}, &testdata.WorkflowExecutionSearchAttributesUpdatedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionSearchAttributesUpdatedEventAttributes(FromWorkflowExecutionSearchAttributesUpdatedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionPausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionPausedEventAttributes{nil, {}, &testdata.WorkflowExecutionPausedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionPausedEventAttributes(FromWorkflowExecutionPausedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionUnpausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionUnpausedEventAttributes{nil, {}, &testdata.WorkflowExecutionUnpausedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionUnpausedEventAttributes(FromWorkflowExecutionUnpausedEventAttributes(item)))
+	}
+}
+func TestActivityTaskPausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.ActivityTaskPausedEventAttributes{nil, {}, &testdata.ActivityTaskPausedEventAttributes} {
+		assert.Equal(t, item, ToActivityTaskPausedEventAttributes(FromActivityTaskPausedEventAttributes(item)))
+	}
+}
+func TestActivityTaskUnpausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.ActivityTaskUnpausedEventAttributes{nil, {}, &testdata.ActivityTaskUnpausedEventAttributes} {
+		assert.Equal(t, item, ToActivityTaskUnpausedEventAttributes(FromActivityTaskUnpausedEventAttributes(item)))
+	}
+}
+func TestPauseInfo(t *testing.T) {
+	for _, item := range []*types.PauseInfo{nil, {}, &testdata.PauseInfo} {
+		assert.Equal(t, item, ToPauseInfo(FromPauseInfo(item)))
+	}
+}
+func TestScheduleSpec(t *testing.T) {
+	for _, item := range []*types.ScheduleSpec{nil, {}, &testdata.ScheduleSpec} {
+		assert.Equal(t, item, ToScheduleSpec(FromScheduleSpec(item)))
+	}
+}
+func TestScheduleAction(t *testing.T) {
+	for _, item := range []*types.ScheduleAction{nil, {}, &testdata.ScheduleAction} {
+		assert.Equal(t, item, ToScheduleAction(FromScheduleAction(item)))
+	}
+}
+func TestScheduleActionResult(t *testing.T) {
+	for _, item := range []*types.ScheduleActionResult{nil, {}, &testdata.ScheduleActionResult} {
+		assert.Equal(t, item, ToScheduleActionResult(FromScheduleActionResult(item)))
+	}
+}
+func TestCreateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.CreateScheduleRequest{nil, {}, &testdata.CreateScheduleRequest} {
+		assert.Equal(t, item, ToCreateScheduleRequest(FromCreateScheduleRequest(item)))
+	}
+}
+func TestDescribeScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleRequest{nil, {}, &testdata.DescribeScheduleRequest} {
+		assert.Equal(t, item, ToDescribeScheduleRequest(FromDescribeScheduleRequest(item)))
+	}
+}
+func TestDescribeScheduleResponse(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleResponse{nil, {}, &testdata.DescribeScheduleResponse} {
+		assert.Equal(t, item, ToDescribeScheduleResponse(FromDescribeScheduleResponse(item)))
+	}
+}
+func TestUpdateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UpdateScheduleRequest{nil, {}, &testdata.UpdateScheduleRequest} {
+		assert.Equal(t, item, ToUpdateScheduleRequest(FromUpdateScheduleRequest(item)))
+	}
+}
+func TestPauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.PauseScheduleRequest{nil, {}, &testdata.PauseScheduleRequest} {
+		assert.Equal(t, item, ToPauseScheduleRequest(FromPauseScheduleRequest(item)))
+	}
+}
+func TestResumeScheduleRequest(t *testing.T) {
+	for _, item := range []*types.ResumeScheduleRequest{nil, {}, &testdata.ResumeScheduleRequest} {
+		assert.Equal(t, item, ToResumeScheduleRequest(FromResumeScheduleRequest(item)))
+	}
+}
+func TestBackfillScheduleRequest(t *testing.T) {
+	for _, item := range []*types.BackfillScheduleRequest{nil, {}, &testdata.BackfillScheduleRequest} {
+		assert.Equal(t, item, ToBackfillScheduleRequest(FromBackfillScheduleRequest(item)))
+	}
+}
+func TestDeleteScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DeleteScheduleRequest{nil, {}, &testdata.DeleteScheduleRequest} {
+		assert.Equal(t, item, ToDeleteScheduleRequest(FromDeleteScheduleRequest(item)))
+	}
+}
+func TestListSchedulesRequest(t *testing.T) {
+	for _, item := range []*types.ListSchedulesRequest{nil, {}, &testdata.ListSchedulesRequest} {
+		assert.Equal(t, item, ToListSchedulesRequest(FromListSchedulesRequest(item)))
+	}
+}
+func TestListSchedulesResponse(t *testing.T) {
+	for _, item := range []*types.ListSchedulesResponse{nil, {}, &testdata.ListSchedulesResponse} {
+		assert.Equal(t, item, ToListSchedulesResponse(FromListSchedulesResponse(item)))
+	}
+}
+func TestScheduleOverlapPolicy(t *testing.T) {
+	for _, item := range []*types.ScheduleOverlapPolicy{
+		nil,
+		types.ScheduleOverlapPolicySkip.Ptr(),
+		types.ScheduleOverlapPolicyBuffer.Ptr(),
+		types.ScheduleOverlapPolicyCancelPrevious.Ptr(),
+		types.ScheduleOverlapPolicyAllowAll.Ptr(),
+	} {
+		assert.Equal(t, item, ToScheduleOverlapPolicy(FromScheduleOverlapPolicy(item)))
+	}
+}
+func TestPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.PauseWorkflowExecutionRequest{nil, {}, &testdata.PauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToPauseWorkflowExecutionRequest(FromPauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseWorkflowExecutionRequest{nil, {}, &testdata.UnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToUnpauseWorkflowExecutionRequest(FromUnpauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestResetActivityRequest(t *testing.T) {
+	for _, item := range []*types.ResetActivityRequest{nil, {}, &testdata.ResetActivityRequest} {
+		assert.Equal(t, item, ToResetActivityRequest(FromResetActivityRequest(item)))
+	}
+}
+func TestWorkflowExecutionUpdateAcceptedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionUpdateAcceptedEventAttributes{nil, {}, &testdata.WorkflowExecutionUpdateAcceptedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionUpdateAcceptedEventAttributes(FromWorkflowExecutionUpdateAcceptedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionUpdateRejectedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionUpdateRejectedEventAttributes{nil, {}, &testdata.WorkflowExecutionUpdateRejectedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionUpdateRejectedEventAttributes(FromWorkflowExecutionUpdateRejectedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionUpdateCompletedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionUpdateCompletedEventAttributes{nil, {}, &testdata.WorkflowExecutionUpdateCompletedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionUpdateCompletedEventAttributes(FromWorkflowExecutionUpdateCompletedEventAttributes(item)))
+	}
+}
+func TestAcceptWorkflowUpdateDecisionAttributes(t *testing.T) {
+	for _, item := range []*types.AcceptWorkflowUpdateDecisionAttributes{nil, {}, &testdata.AcceptWorkflowUpdateDecisionAttributes} {
+		assert.Equal(t, item, ToAcceptWorkflowUpdateDecisionAttributes(FromAcceptWorkflowUpdateDecisionAttributes(item)))
+	}
+}
+func TestRejectWorkflowUpdateDecisionAttributes(t *testing.T) {
+	for _, item := range []*types.RejectWorkflowUpdateDecisionAttributes{nil, {}, &testdata.RejectWorkflowUpdateDecisionAttributes} {
+		assert.Equal(t, item, ToRejectWorkflowUpdateDecisionAttributes(FromRejectWorkflowUpdateDecisionAttributes(item)))
+	}
+}
+func TestCompleteWorkflowUpdateDecisionAttributes(t *testing.T) {
+	for _, item := range []*types.CompleteWorkflowUpdateDecisionAttributes{nil, {}, &testdata.CompleteWorkflowUpdateDecisionAttributes} {
+		assert.Equal(t, item, ToCompleteWorkflowUpdateDecisionAttributes(FromCompleteWorkflowUpdateDecisionAttributes(item)))
+	}
+}
+func TestUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionRequest{nil, {}, &testdata.UpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToUpdateWorkflowExecutionRequest(FromUpdateWorkflowExecutionRequest(item)))
+	}
+}
+func TestUpdateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionResponse{nil, {}, &testdata.UpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToUpdateWorkflowExecutionResponse(FromUpdateWorkflowExecutionResponse(item)))
+	}
+}
+func TestWorkflowUpdate(t *testing.T) {
+	for _, item := range []*types.WorkflowUpdate{nil, {}, &testdata.WorkflowUpdate} {
+		assert.Equal(t, item, ToWorkflowUpdate(FromWorkflowUpdate(item)))
+	}
+}
 func TestWorkflowExecutionSignaledEventAttributes(t *testing.T) {
 	for _, item := range []*types.WorkflowExecutionSignaledEventAttributes{nil, {}, &testdata.WorkflowExecutionSignaledEventAttributes} {
 		assert.Equal(t, item, ToWorkflowExecutionSignaledEventAttributes(FromWorkflowExecutionSignaledEventAttributes(item)))
@@ -1108,6 +1274,14 @@ func TestHistoryEvent(t *testing.T) {
 		&testdata.HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&testdata.HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&testdata.HistoryEvent_UpsertWorkflowSearchAttributes,
+		&testdata.HistoryEvent_WorkflowExecutionSearchAttributesUpdated,
+		&testdata.HistoryEvent_WorkflowExecutionPaused,
+		&testdata.HistoryEvent_WorkflowExecutionUnpaused,
+		&testdata.HistoryEvent_ActivityTaskPaused,
+		&testdata.HistoryEvent_ActivityTaskUnpaused,
+		&testdata.HistoryEvent_WorkflowExecutionUpdateAccepted,
+		&testdata.HistoryEvent_WorkflowExecutionUpdateRejected,
+		&testdata.HistoryEvent_WorkflowExecutionUpdateCompleted,
 	} {
 		assert.Equal(t, item, ToHistoryEvent(FromHistoryEvent(item)))
 	}
@@ -1129,6 +1303,9 @@ func TestDecision(t *testing.T) {
 		&testdata.Decision_StartChildWorkflowExecution,
 		&testdata.Decision_StartTimer,
 		&testdata.Decision_UpsertWorkflowSearchAttributes,
+		&testdata.Decision_AcceptWorkflowUpdate,
+		&testdata.Decision_RejectWorkflowUpdate,
+		&testdata.Decision_CompleteWorkflowUpdate,
 	} {
 		assert.Equal(t, item, ToDecision(FromDecision(item)))
 	}
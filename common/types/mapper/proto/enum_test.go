@@ -675,6 +675,9 @@ func TestDecisionTaskFailedCause(t *testing.T) {
 		types.DecisionTaskFailedCauseBadBinary.Ptr(),
 		types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr(),
 		types.DecisionTaskFailedCauseBadSearchAttributes.Ptr(),
+		types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes.Ptr(),
+		types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes.Ptr(),
+		types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes.Ptr(),
 	} {
 		assert.Equal(t, item, ToDecisionTaskFailedCause(FromDecisionTaskFailedCause(item)))
 	}
@@ -1210,6 +1213,16 @@ func TestQueryConsistencyLevel(t *testing.T) {
 		assert.Equal(t, item, ToQueryConsistencyLevel(FromQueryConsistencyLevel(item)))
 	}
 }
+func TestWorkflowUpdateStage(t *testing.T) {
+	for _, item := range []*types.WorkflowUpdateStage{
+		nil,
+		types.WorkflowUpdateStageAccepted.Ptr(),
+		types.WorkflowUpdateStageRejected.Ptr(),
+		types.WorkflowUpdateStageCompleted.Ptr(),
+	} {
+		assert.Equal(t, item, ToWorkflowUpdateStage(FromWorkflowUpdateStage(item)))
+	}
+}
 
 func TestToQueryConsistencyLevel(t *testing.T) {
 	cases := []struct {
@@ -1606,6 +1619,21 @@ func TestFromTaskListKind(t *testing.T) {
 	}
 }
 
+func TestTaskPriority(t *testing.T) {
+	for _, item := range []*types.TaskPriority{
+		nil,
+		types.TaskPriorityHighest.Ptr(),
+		types.TaskPriorityHigh.Ptr(),
+		types.TaskPriorityNormal.Ptr(),
+		types.TaskPriorityLow.Ptr(),
+		types.TaskPriorityLowest.Ptr(),
+	} {
+		assert.Equal(t, item, ToTaskPriority(FromTaskPriority(item)))
+	}
+	assert.Equal(t, apiv1.TaskPriority_TASK_PRIORITY_INVALID, FromTaskPriority(types.TaskPriority(UnknownValue).Ptr()))
+	assert.Nil(t, ToTaskPriority(apiv1.TaskPriority(UnknownValue)))
+}
+
 func TestTaskListType(t *testing.T) {
 	for _, item := range []*types.TaskListType{
 		nil,
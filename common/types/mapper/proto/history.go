@@ -198,6 +198,7 @@ func FromHistoryDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecu
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              FromPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -211,6 +212,7 @@ func ToHistoryDescribeWorkflowExecutionResponse(t *historyv1.DescribeWorkflowExe
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              ToPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -509,6 +511,26 @@ func ToHistoryNotifyFailoverMarkersRequest(t *historyv1.NotifyFailoverMarkersReq
 	}
 }
 
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *historyv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseWorkflowExecutionRequest{
+		DomainId:     t.DomainUUID,
+		PauseRequest: FromPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+func ToHistoryPauseWorkflowExecutionRequest(t *historyv1.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   t.DomainId,
+		PauseRequest: ToPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
 func FromHistoryPollMutableStateRequest(t *types.PollMutableStateRequest) *historyv1.PollMutableStateRequest {
 	if t == nil {
 		return nil
@@ -901,6 +923,7 @@ func FromHistoryRecordDecisionTaskStartedResponse(t *types.RecordDecisionTaskSta
 		StartedTime:               unixNanoToTime(t.StartedTimestamp),
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		HistorySize:               t.HistorySize,
+		Updates:                   FromWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -924,6 +947,7 @@ func ToHistoryRecordDecisionTaskStartedResponse(t *historyv1.RecordDecisionTaskS
 		StartedTimestamp:          timeToUnixNano(t.StartedTime),
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		HistorySize:               t.HistorySize,
+		Updates:                   ToWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -1009,6 +1033,7 @@ func FromHistoryReplicateEventsV2Request(t *types.ReplicateEventsV2Request) *his
 		VersionHistoryItems: FromVersionHistoryItemArray(t.VersionHistoryItems),
 		Events:              FromDataBlob(t.Events),
 		NewRunEvents:        FromDataBlob(t.NewRunEvents),
+		Restored:            t.Restored,
 	}
 }
 
@@ -1022,6 +1047,7 @@ func ToHistoryReplicateEventsV2Request(t *historyv1.ReplicateEventsV2Request) *t
 		VersionHistoryItems: ToVersionHistoryItemArray(t.VersionHistoryItems),
 		Events:              ToDataBlob(t.Events),
 		NewRunEvents:        ToDataBlob(t.NewRunEvents),
+		Restored:            t.Restored,
 	}
 }
 
@@ -1050,6 +1076,26 @@ func ToHistoryRequestCancelWorkflowExecutionRequest(t *historyv1.RequestCancelWo
 	}
 }
 
+func FromHistoryResetActivityRequest(t *types.HistoryResetActivityRequest) *historyv1.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ResetActivityRequest{
+		DomainId:     t.DomainUUID,
+		ResetRequest: FromResetActivityRequest(t.ResetRequest),
+	}
+}
+
+func ToHistoryResetActivityRequest(t *historyv1.ResetActivityRequest) *types.HistoryResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityRequest{
+		DomainUUID:   t.DomainId,
+		ResetRequest: ToResetActivityRequest(t.ResetRequest),
+	}
+}
+
 func FromHistoryResetQueueRequest(t *types.ResetQueueRequest) *historyv1.ResetQueueRequest {
 	if t == nil {
 		return nil
@@ -1339,6 +1385,51 @@ func ToHistorySignalWorkflowExecutionRequest(t *historyv1.SignalWorkflowExecutio
 	}
 }
 
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *historyv1.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionRequest{
+		Request:  FromUpdateWorkflowExecutionRequest(t.UpdateRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionRequest(t *historyv1.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    t.DomainId,
+		UpdateRequest: ToUpdateWorkflowExecutionRequest(t.Request),
+	}
+}
+
+func FromHistoryUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *historyv1.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionResponse{
+		UpdateId: t.UpdateID,
+		Stage:    FromWorkflowUpdateStage(t.Stage),
+		Result:   FromPayload(t.Result),
+		Failure:  FromFailure(t.FailureReason, t.FailureDetails),
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionResponse(t *historyv1.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		UpdateID:       t.UpdateId,
+		Stage:          ToWorkflowUpdateStage(t.Stage),
+		Result:         ToPayload(t.Result),
+		FailureReason:  ToFailureReason(t.Failure),
+		FailureDetails: ToFailureDetails(t.Failure),
+	}
+}
+
 func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecutionRequest) *historyv1.StartWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -1484,6 +1575,52 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *historyv1.TerminateWorkflowEx
 	}
 }
 
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *historyv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UnpauseWorkflowExecutionRequest{
+		DomainId:       t.DomainUUID,
+		UnpauseRequest: FromUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+func ToHistoryUnpauseWorkflowExecutionRequest(t *historyv1.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     t.DomainId,
+		UnpauseRequest: ToUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+func FromHistoryUpdateWorkflowSearchAttributesRequest(t *types.UpdateWorkflowSearchAttributesRequest) *historyv1.UpdateWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowSearchAttributesRequest{
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		SearchAttributes:  FromSearchAttributes(t.SearchAttributes),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func ToHistoryUpdateWorkflowSearchAttributesRequest(t *historyv1.UpdateWorkflowSearchAttributesRequest) *types.UpdateWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowSearchAttributesRequest{
+		DomainUUID:        t.DomainId,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		SearchAttributes:  ToSearchAttributes(t.SearchAttributes),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
 // FromHistoryGetCrossClusterTasksRequest converts internal GetCrossClusterTasksRequest type to proto
 func FromHistoryGetCrossClusterTasksRequest(t *types.GetCrossClusterTasksRequest) *historyv1.GetCrossClusterTasksRequest {
 	if t == nil {
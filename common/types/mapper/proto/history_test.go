@@ -329,6 +329,36 @@ func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryTerminateWorkflowExecutionRequest(FromHistoryTerminateWorkflowExecutionRequest(item)))
 	}
 }
+func TestHistoryUpdateWorkflowSearchAttributesRequest(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowSearchAttributesRequest{nil, {}, &testdata.HistoryUpdateWorkflowSearchAttributesRequest} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowSearchAttributesRequest(FromHistoryUpdateWorkflowSearchAttributesRequest(item)))
+	}
+}
+func TestHistoryPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryPauseWorkflowExecutionRequest(FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseWorkflowExecutionRequest(FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryResetActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryResetActivityRequest{nil, {}, &testdata.HistoryResetActivityRequest} {
+		assert.Equal(t, item, ToHistoryResetActivityRequest(FromHistoryResetActivityRequest(item)))
+	}
+}
+func TestHistoryUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateWorkflowExecutionRequest{nil, {}, &testdata.HistoryUpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionRequest(FromHistoryUpdateWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryUpdateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionResponse{nil, {}, &testdata.UpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionResponse(FromHistoryUpdateWorkflowExecutionResponse(item)))
+	}
+}
 
 func TestHistoryGetCrossClusterTasksRequest(t *testing.T) {
 	for _, item := range []*types.GetCrossClusterTasksRequest{nil, {}, &testdata.HistoryGetCrossClusterTasksRequest} {
@@ -41,6 +41,7 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:            t.ForwardedFrom,
 		ActivityTaskDispatchInfo: FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:          t.PartitionConfig,
+		TaskPriority:             FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -59,6 +60,7 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		ForwardedFrom:                 t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		TaskPriority:                  ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -75,6 +77,7 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
 		PartitionConfig:        t.PartitionConfig,
+		TaskPriority:           FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -91,6 +94,7 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		TaskPriority:                  ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -540,6 +544,7 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		PartitionConfig:           FromTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:         FromLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:            FromAutoConfigHint(t.AutoConfigHint),
+		Updates:                   FromWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -569,6 +574,7 @@ func ToMatchingPollForDecisionTaskResponse(t *matchingv1.PollForDecisionTaskResp
 		PartitionConfig:           ToTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:         ToLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:            ToAutoConfigHint(t.AutoConfigHint),
+		Updates:                   ToWorkflowUpdateArray(t.Updates),
 	}
 }
 
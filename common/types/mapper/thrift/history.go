@@ -352,6 +352,28 @@ func ToParentExecutionInfo(t *history.ParentExecutionInfo) *types.ParentExecutio
 	}
 }
 
+// FromHistoryPauseWorkflowExecutionRequest converts internal HistoryPauseWorkflowExecutionRequest type to thrift
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *history.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.PauseWorkflowExecutionRequest{
+		DomainUUID:   &t.DomainUUID,
+		PauseRequest: FromPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+// ToHistoryPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToHistoryPauseWorkflowExecutionRequest(t *history.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		PauseRequest: ToPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
 // FromHistoryPollMutableStateRequest converts internal PollMutableStateRequest type to thrift
 func FromHistoryPollMutableStateRequest(t *types.PollMutableStateRequest) *history.PollMutableStateRequest {
 	if t == nil {
@@ -701,6 +723,7 @@ func FromRecordDecisionTaskStartedResponse(t *types.RecordDecisionTaskStartedRes
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		HistorySize:               &t.HistorySize,
+		Updates:                   FromWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -725,6 +748,7 @@ func ToRecordDecisionTaskStartedResponse(t *history.RecordDecisionTaskStartedRes
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		HistorySize:               t.GetHistorySize(),
+		Updates:                   ToWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -785,6 +809,7 @@ func FromHistoryReplicateEventsV2Request(t *types.ReplicateEventsV2Request) *his
 		VersionHistoryItems: FromVersionHistoryItemArray(t.VersionHistoryItems),
 		Events:              FromDataBlob(t.Events),
 		NewRunEvents:        FromDataBlob(t.NewRunEvents),
+		Restored:            &t.Restored,
 	}
 }
 
@@ -799,6 +824,7 @@ func ToHistoryReplicateEventsV2Request(t *history.ReplicateEventsV2Request) *typ
 		VersionHistoryItems: ToVersionHistoryItemArray(t.VersionHistoryItems),
 		Events:              ToDataBlob(t.Events),
 		NewRunEvents:        ToDataBlob(t.NewRunEvents),
+		Restored:            t.GetRestored(),
 	}
 }
 
@@ -830,6 +856,28 @@ func ToHistoryRequestCancelWorkflowExecutionRequest(t *history.RequestCancelWork
 	}
 }
 
+// FromHistoryResetActivityRequest converts internal HistoryResetActivityRequest type to thrift
+func FromHistoryResetActivityRequest(t *types.HistoryResetActivityRequest) *history.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ResetActivityRequest{
+		DomainUUID:   &t.DomainUUID,
+		ResetRequest: FromResetActivityRequest(t.ResetRequest),
+	}
+}
+
+// ToHistoryResetActivityRequest converts thrift ResetActivityRequest type to internal
+func ToHistoryResetActivityRequest(t *history.ResetActivityRequest) *types.HistoryResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		ResetRequest: ToResetActivityRequest(t.ResetRequest),
+	}
+}
+
 // FromHistoryResetStickyTaskListRequest converts internal ResetStickyTaskListRequest type to thrift
 func FromHistoryResetStickyTaskListRequest(t *types.HistoryResetStickyTaskListRequest) *history.ResetStickyTaskListRequest {
 	if t == nil {
@@ -1256,6 +1304,78 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *history.TerminateWorkflowExec
 	}
 }
 
+// FromHistoryUpdateWorkflowExecutionRequest converts internal HistoryUpdateWorkflowExecutionRequest type to thrift
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *history.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpdateWorkflowExecutionRequest{
+		DomainUUID:    &t.DomainUUID,
+		UpdateRequest: FromUpdateWorkflowExecutionRequest(t.UpdateRequest),
+	}
+}
+
+// ToHistoryUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToHistoryUpdateWorkflowExecutionRequest(t *history.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		UpdateRequest: ToUpdateWorkflowExecutionRequest(t.UpdateRequest),
+	}
+}
+
+// FromHistoryUnpauseWorkflowExecutionRequest converts internal HistoryUnpauseWorkflowExecutionRequest type to thrift
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *history.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UnpauseWorkflowExecutionRequest{
+		DomainUUID:     &t.DomainUUID,
+		UnpauseRequest: FromUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+// ToHistoryUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToHistoryUnpauseWorkflowExecutionRequest(t *history.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		UnpauseRequest: ToUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+// FromHistoryUpdateWorkflowSearchAttributesRequest converts internal UpdateWorkflowSearchAttributesRequest type to thrift
+func FromHistoryUpdateWorkflowSearchAttributesRequest(t *types.UpdateWorkflowSearchAttributesRequest) *history.UpdateWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpdateWorkflowSearchAttributesRequest{
+		DomainUUID:        &t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		SearchAttributes:  FromSearchAttributes(t.SearchAttributes),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToHistoryUpdateWorkflowSearchAttributesRequest converts thrift UpdateWorkflowSearchAttributesRequest type to internal
+func ToHistoryUpdateWorkflowSearchAttributesRequest(t *history.UpdateWorkflowSearchAttributesRequest) *types.UpdateWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowSearchAttributesRequest{
+		DomainUUID:        t.GetDomainUUID(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		SearchAttributes:  ToSearchAttributes(t.SearchAttributes),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
 // FromFailoverMarkerTokenArray converts internal FailoverMarkerToken type array to thrift
 func FromFailoverMarkerTokenArray(t []*types.FailoverMarkerToken) []*history.FailoverMarkerToken {
 	if t == nil {
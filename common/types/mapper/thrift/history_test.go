@@ -40,6 +40,36 @@ func TestHistoryTerminateWorkflowExecutionRequestConversion(t *testing.T) {
 	}
 }
 
+func TestHistoryUpdateWorkflowSearchAttributesRequestConversion(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowSearchAttributesRequest{nil, {}, &testdata.HistoryUpdateWorkflowSearchAttributesRequest} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowSearchAttributesRequest(FromHistoryUpdateWorkflowSearchAttributesRequest(item)))
+	}
+}
+
+func TestHistoryPauseWorkflowExecutionRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryPauseWorkflowExecutionRequest(FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryUnpauseWorkflowExecutionRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseWorkflowExecutionRequest(FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryResetActivityRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryResetActivityRequest{nil, {}, &testdata.HistoryResetActivityRequest} {
+		assert.Equal(t, item, ToHistoryResetActivityRequest(FromHistoryResetActivityRequest(item)))
+	}
+}
+
+func TestHistoryUpdateWorkflowExecutionRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateWorkflowExecutionRequest{nil, {}, &testdata.HistoryUpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionRequest(FromHistoryUpdateWorkflowExecutionRequest(item)))
+	}
+}
+
 func TestDescribeMutableStateRequestConversion(t *testing.T) {
 	testCases := []*types.DescribeMutableStateRequest{
 		nil,
@@ -54,6 +54,7 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:                 &t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		TaskPriority:                  FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -73,6 +74,7 @@ func ToMatchingAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		TaskPriority:                  ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -121,6 +123,7 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		TaskPriority:                  FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -138,6 +141,7 @@ func ToMatchingAddDecisionTaskRequest(t *matching.AddDecisionTaskRequest) *types
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		PartitionConfig:               t.PartitionConfig,
+		TaskPriority:                  ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -292,6 +296,7 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		TotalHistoryBytes:         &t.TotalHistoryBytes,
 		AutoConfigHint:            FromAutoConfigHint(t.AutoConfigHint),
+		Updates:                   FromWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -320,6 +325,7 @@ func ToMatchingPollForDecisionTaskResponse(t *matching.PollForDecisionTaskRespon
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		TotalHistoryBytes:         t.GetTotalHistoryBytes(),
 		AutoConfigHint:            ToAutoConfigHint(t.AutoConfigHint),
+		Updates:                   ToWorkflowUpdateArray(t.Updates),
 	}
 }
 
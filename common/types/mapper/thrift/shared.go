@@ -38,6 +38,30 @@ var (
 	ToSignalWithStartWorkflowExecutionResponse   = ToStartWorkflowExecutionResponse
 )
 
+// FromAcceptWorkflowUpdateDecisionAttributes converts internal AcceptWorkflowUpdateDecisionAttributes type to thrift
+func FromAcceptWorkflowUpdateDecisionAttributes(t *types.AcceptWorkflowUpdateDecisionAttributes) *shared.AcceptWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.AcceptWorkflowUpdateDecisionAttributes{
+		UpdateId:   &t.UpdateID,
+		UpdateName: &t.UpdateName,
+		Input:      t.Input,
+	}
+}
+
+// ToAcceptWorkflowUpdateDecisionAttributes converts thrift AcceptWorkflowUpdateDecisionAttributes type to internal
+func ToAcceptWorkflowUpdateDecisionAttributes(t *shared.AcceptWorkflowUpdateDecisionAttributes) *types.AcceptWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.AcceptWorkflowUpdateDecisionAttributes{
+		UpdateID:   t.GetUpdateId(),
+		UpdateName: t.GetUpdateName(),
+		Input:      t.Input,
+	}
+}
+
 // FromAccessDeniedError converts internal AccessDeniedError type to thrift
 func FromAccessDeniedError(t *types.AccessDeniedError) *shared.AccessDeniedError {
 	if t == nil {
@@ -190,6 +214,30 @@ func ToActivityTaskFailedEventAttributes(t *shared.ActivityTaskFailedEventAttrib
 	}
 }
 
+// FromActivityTaskPausedEventAttributes converts internal ActivityTaskPausedEventAttributes type to thrift
+func FromActivityTaskPausedEventAttributes(t *types.ActivityTaskPausedEventAttributes) *shared.ActivityTaskPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskPausedEventAttributes{
+		ScheduledEventId: &t.ScheduledEventID,
+		Reason:           &t.Reason,
+		Identity:         &t.Identity,
+	}
+}
+
+// ToActivityTaskPausedEventAttributes converts thrift ActivityTaskPausedEventAttributes type to internal
+func ToActivityTaskPausedEventAttributes(t *shared.ActivityTaskPausedEventAttributes) *types.ActivityTaskPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskPausedEventAttributes{
+		ScheduledEventID: t.GetScheduledEventId(),
+		Reason:           t.GetReason(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
 // FromActivityTaskScheduledEventAttributes converts internal ActivityTaskScheduledEventAttributes type to thrift
 func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEventAttributes) *shared.ActivityTaskScheduledEventAttributes {
 	if t == nil {
@@ -208,6 +256,7 @@ func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEven
 		DecisionTaskCompletedEventId:  &t.DecisionTaskCompletedEventID,
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
+		TaskPriority:                  FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -229,6 +278,7 @@ func ToActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEvent
 		DecisionTaskCompletedEventID:  t.GetDecisionTaskCompletedEventId(),
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
+		TaskPriority:                  ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -292,6 +342,30 @@ func ToActivityTaskTimedOutEventAttributes(t *shared.ActivityTaskTimedOutEventAt
 	}
 }
 
+// FromActivityTaskUnpausedEventAttributes converts internal ActivityTaskUnpausedEventAttributes type to thrift
+func FromActivityTaskUnpausedEventAttributes(t *types.ActivityTaskUnpausedEventAttributes) *shared.ActivityTaskUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventId: &t.ScheduledEventID,
+		Reason:           &t.Reason,
+		Identity:         &t.Identity,
+	}
+}
+
+// ToActivityTaskUnpausedEventAttributes converts thrift ActivityTaskUnpausedEventAttributes type to internal
+func ToActivityTaskUnpausedEventAttributes(t *shared.ActivityTaskUnpausedEventAttributes) *types.ActivityTaskUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventID: t.GetScheduledEventId(),
+		Reason:           t.GetReason(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
 // FromActivityType converts internal ActivityType type to thrift
 func FromActivityType(t *types.ActivityType) *shared.ActivityType {
 	if t == nil {
@@ -754,6 +828,32 @@ func ToClientVersionNotSupportedError(t *shared.ClientVersionNotSupportedError)
 	}
 }
 
+// FromCompleteWorkflowUpdateDecisionAttributes converts internal CompleteWorkflowUpdateDecisionAttributes type to thrift
+func FromCompleteWorkflowUpdateDecisionAttributes(t *types.CompleteWorkflowUpdateDecisionAttributes) *shared.CompleteWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateId:       &t.UpdateID,
+		Result:         t.Result,
+		FailureReason:  t.FailureReason,
+		FailureDetails: t.FailureDetails,
+	}
+}
+
+// ToCompleteWorkflowUpdateDecisionAttributes converts thrift CompleteWorkflowUpdateDecisionAttributes type to internal
+func ToCompleteWorkflowUpdateDecisionAttributes(t *shared.CompleteWorkflowUpdateDecisionAttributes) *types.CompleteWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateID:       t.GetUpdateId(),
+		Result:         t.Result,
+		FailureReason:  t.FailureReason,
+		FailureDetails: t.FailureDetails,
+	}
+}
+
 // FromFeatureNotEnabledError converts internal FeatureNotEnabledError type to thrift
 func FromFeatureNotEnabledError(t *types.FeatureNotEnabledError) *shared.FeatureNotEnabledError {
 	if t == nil {
@@ -1052,6 +1152,9 @@ func FromDecision(t *types.Decision) *shared.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            FromStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        FromSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         FromUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		AcceptWorkflowUpdateDecisionAttributes:                   FromAcceptWorkflowUpdateDecisionAttributes(t.AcceptWorkflowUpdateDecisionAttributes),
+		RejectWorkflowUpdateDecisionAttributes:                   FromRejectWorkflowUpdateDecisionAttributes(t.RejectWorkflowUpdateDecisionAttributes),
+		CompleteWorkflowUpdateDecisionAttributes:                 FromCompleteWorkflowUpdateDecisionAttributes(t.CompleteWorkflowUpdateDecisionAttributes),
 	}
 }
 
@@ -1075,6 +1178,9 @@ func ToDecision(t *shared.Decision) *types.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            ToStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        ToSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         ToUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		AcceptWorkflowUpdateDecisionAttributes:                   ToAcceptWorkflowUpdateDecisionAttributes(t.AcceptWorkflowUpdateDecisionAttributes),
+		RejectWorkflowUpdateDecisionAttributes:                   ToRejectWorkflowUpdateDecisionAttributes(t.RejectWorkflowUpdateDecisionAttributes),
+		CompleteWorkflowUpdateDecisionAttributes:                 ToCompleteWorkflowUpdateDecisionAttributes(t.CompleteWorkflowUpdateDecisionAttributes),
 	}
 }
 
@@ -1181,6 +1287,15 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) *shared.Decis
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		v := shared.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes:
+		v := shared.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes
+		return &v
+	case types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes:
+		v := shared.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes
+		return &v
+	case types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes:
+		v := shared.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1260,6 +1375,15 @@ func ToDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) *types.Decisio
 	case shared.DecisionTaskFailedCauseBadSearchAttributes:
 		v := types.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case shared.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes:
+		v := types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes
+		return &v
+	case shared.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes:
+		v := types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes
+		return &v
+	case shared.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes:
+		v := types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1465,6 +1589,15 @@ func FromDecisionType(t *types.DecisionType) *shared.DecisionType {
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := shared.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.DecisionTypeAcceptWorkflowUpdate:
+		v := shared.DecisionTypeAcceptWorkflowUpdate
+		return &v
+	case types.DecisionTypeRejectWorkflowUpdate:
+		v := shared.DecisionTypeRejectWorkflowUpdate
+		return &v
+	case types.DecisionTypeCompleteWorkflowUpdate:
+		v := shared.DecisionTypeCompleteWorkflowUpdate
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1514,6 +1647,15 @@ func ToDecisionType(t *shared.DecisionType) *types.DecisionType {
 	case shared.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := types.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.DecisionTypeAcceptWorkflowUpdate:
+		v := types.DecisionTypeAcceptWorkflowUpdate
+		return &v
+	case shared.DecisionTypeRejectWorkflowUpdate:
+		v := types.DecisionTypeRejectWorkflowUpdate
+		return &v
+	case shared.DecisionTypeCompleteWorkflowUpdate:
+		v := types.DecisionTypeCompleteWorkflowUpdate
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1623,6 +1765,7 @@ func FromFailoverDomainRequest(t *types.FailoverDomainRequest) *shared.FailoverD
 		DomainName:              &t.DomainName,
 		DomainActiveClusterName: t.DomainActiveClusterName,
 		ActiveClusters:          FromActiveClusters(t.ActiveClusters),
+		Reason:                  &t.Reason,
 	}
 }
 
@@ -1635,6 +1778,7 @@ func ToFailoverDomainRequest(t *shared.FailoverDomainRequest) *types.FailoverDom
 		DomainName:              t.GetDomainName(),
 		DomainActiveClusterName: t.DomainActiveClusterName,
 		ActiveClusters:          ToActiveClusters(t.ActiveClusters),
+		Reason:                  t.GetReason(),
 	}
 }
 
@@ -1891,6 +2035,7 @@ func FromDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecutionRes
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              FromPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -1905,6 +2050,7 @@ func ToDescribeWorkflowExecutionResponse(t *shared.DescribeWorkflowExecutionResp
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              ToPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -2324,6 +2470,198 @@ func ToEntityNotExistsError(t *shared.EntityNotExistsError) *types.EntityNotExis
 	}
 }
 
+// FromPauseInfo converts internal PauseInfo type to thrift
+func FromPauseInfo(t *types.PauseInfo) *shared.PauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseInfo{
+		Reason:          &t.Reason,
+		Identity:        &t.Identity,
+		PausedTimestamp: t.PausedTimestamp,
+	}
+}
+
+// ToPauseInfo converts thrift PauseInfo type to internal
+func ToPauseInfo(t *shared.PauseInfo) *types.PauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseInfo{
+		Reason:          t.GetReason(),
+		Identity:        t.GetIdentity(),
+		PausedTimestamp: t.PausedTimestamp,
+	}
+}
+
+// FromPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *shared.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToPauseWorkflowExecutionRequest(t *shared.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromRejectWorkflowUpdateDecisionAttributes converts internal RejectWorkflowUpdateDecisionAttributes type to thrift
+func FromRejectWorkflowUpdateDecisionAttributes(t *types.RejectWorkflowUpdateDecisionAttributes) *shared.RejectWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.RejectWorkflowUpdateDecisionAttributes{
+		UpdateId:   &t.UpdateID,
+		UpdateName: &t.UpdateName,
+		Reason:     &t.Reason,
+		Details:    t.Details,
+	}
+}
+
+// ToRejectWorkflowUpdateDecisionAttributes converts thrift RejectWorkflowUpdateDecisionAttributes type to internal
+func ToRejectWorkflowUpdateDecisionAttributes(t *shared.RejectWorkflowUpdateDecisionAttributes) *types.RejectWorkflowUpdateDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.RejectWorkflowUpdateDecisionAttributes{
+		UpdateID:   t.GetUpdateId(),
+		UpdateName: t.GetUpdateName(),
+		Reason:     t.GetReason(),
+		Details:    t.Details,
+	}
+}
+
+// FromResetActivityRequest converts internal ResetActivityRequest type to thrift
+func FromResetActivityRequest(t *types.ResetActivityRequest) *shared.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToResetActivityRequest converts thrift ResetActivityRequest type to internal
+func ToResetActivityRequest(t *shared.ResetActivityRequest) *types.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *shared.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToUnpauseWorkflowExecutionRequest(t *shared.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromUpdateWorkflowExecutionRequest converts internal UpdateWorkflowExecutionRequest type to thrift
+func FromUpdateWorkflowExecutionRequest(t *types.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		UpdateId:          &t.UpdateID,
+		UpdateName:        &t.UpdateName,
+		Input:             t.Input,
+		Identity:          &t.Identity,
+		WaitForStage:      FromWorkflowUpdateStage(t.WaitForStage),
+	}
+}
+
+// ToUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToUpdateWorkflowExecutionRequest(t *shared.UpdateWorkflowExecutionRequest) *types.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		UpdateID:          t.GetUpdateId(),
+		UpdateName:        t.GetUpdateName(),
+		Input:             t.Input,
+		Identity:          t.GetIdentity(),
+		WaitForStage:      ToWorkflowUpdateStage(t.WaitForStage),
+	}
+}
+
+// FromUpdateWorkflowExecutionResponse converts internal UpdateWorkflowExecutionResponse type to thrift
+func FromUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *shared.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionResponse{
+		UpdateId:       &t.UpdateID,
+		Stage:          FromWorkflowUpdateStage(t.Stage),
+		Result:         t.Result,
+		FailureReason:  t.FailureReason,
+		FailureDetails: t.FailureDetails,
+	}
+}
+
+// ToUpdateWorkflowExecutionResponse converts thrift UpdateWorkflowExecutionResponse type to internal
+func ToUpdateWorkflowExecutionResponse(t *shared.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		UpdateID:       t.GetUpdateId(),
+		Stage:          ToWorkflowUpdateStage(t.Stage),
+		Result:         t.Result,
+		FailureReason:  t.FailureReason,
+		FailureDetails: t.FailureDetails,
+	}
+}
+
 // FromWorkflowExecutionAlreadyCompletedError converts internal WorkflowExecutionAlreadyCompletedError type to thrift
 func FromWorkflowExecutionAlreadyCompletedError(t *types.WorkflowExecutionAlreadyCompletedError) *shared.WorkflowExecutionAlreadyCompletedError {
 	if t == nil {
@@ -2476,6 +2814,30 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeWorkflowExecutionSearchAttributesUpdated:
+		v := shared.EventTypeWorkflowExecutionSearchAttributesUpdated
+		return &v
+	case types.EventTypeWorkflowExecutionPaused:
+		v := shared.EventTypeWorkflowExecutionPaused
+		return &v
+	case types.EventTypeWorkflowExecutionUnpaused:
+		v := shared.EventTypeWorkflowExecutionUnpaused
+		return &v
+	case types.EventTypeActivityTaskPaused:
+		v := shared.EventTypeActivityTaskPaused
+		return &v
+	case types.EventTypeActivityTaskUnpaused:
+		v := shared.EventTypeActivityTaskUnpaused
+		return &v
+	case types.EventTypeWorkflowExecutionUpdateAccepted:
+		v := shared.EventTypeWorkflowExecutionUpdateAccepted
+		return &v
+	case types.EventTypeWorkflowExecutionUpdateRejected:
+		v := shared.EventTypeWorkflowExecutionUpdateRejected
+		return &v
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		v := shared.EventTypeWorkflowExecutionUpdateCompleted
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2612,6 +2974,30 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeWorkflowExecutionSearchAttributesUpdated:
+		v := types.EventTypeWorkflowExecutionSearchAttributesUpdated
+		return &v
+	case shared.EventTypeWorkflowExecutionPaused:
+		v := types.EventTypeWorkflowExecutionPaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUnpaused:
+		v := types.EventTypeWorkflowExecutionUnpaused
+		return &v
+	case shared.EventTypeActivityTaskPaused:
+		v := types.EventTypeActivityTaskPaused
+		return &v
+	case shared.EventTypeActivityTaskUnpaused:
+		v := types.EventTypeActivityTaskUnpaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUpdateAccepted:
+		v := types.EventTypeWorkflowExecutionUpdateAccepted
+		return &v
+	case shared.EventTypeWorkflowExecutionUpdateRejected:
+		v := types.EventTypeWorkflowExecutionUpdateRejected
+		return &v
+	case shared.EventTypeWorkflowExecutionUpdateCompleted:
+		v := types.EventTypeWorkflowExecutionUpdateCompleted
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2909,6 +3295,14 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionSearchAttributesUpdatedEventAttributes:        FromWorkflowExecutionSearchAttributesUpdatedEventAttributes(t.WorkflowExecutionSearchAttributesUpdatedEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         FromWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       FromWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
+		ActivityTaskPausedEventAttributes:                              FromActivityTaskPausedEventAttributes(t.ActivityTaskPausedEventAttributes),
+		ActivityTaskUnpausedEventAttributes:                            FromActivityTaskUnpausedEventAttributes(t.ActivityTaskUnpausedEventAttributes),
+		WorkflowExecutionUpdateAcceptedEventAttributes:                 FromWorkflowExecutionUpdateAcceptedEventAttributes(t.WorkflowExecutionUpdateAcceptedEventAttributes),
+		WorkflowExecutionUpdateRejectedEventAttributes:                 FromWorkflowExecutionUpdateRejectedEventAttributes(t.WorkflowExecutionUpdateRejectedEventAttributes),
+		WorkflowExecutionUpdateCompletedEventAttributes:                FromWorkflowExecutionUpdateCompletedEventAttributes(t.WorkflowExecutionUpdateCompletedEventAttributes),
 	}
 }
 
@@ -2965,6 +3359,14 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionSearchAttributesUpdatedEventAttributes:        ToWorkflowExecutionSearchAttributesUpdatedEventAttributes(t.WorkflowExecutionSearchAttributesUpdatedEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         ToWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       ToWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
+		ActivityTaskPausedEventAttributes:                              ToActivityTaskPausedEventAttributes(t.ActivityTaskPausedEventAttributes),
+		ActivityTaskUnpausedEventAttributes:                            ToActivityTaskUnpausedEventAttributes(t.ActivityTaskUnpausedEventAttributes),
+		WorkflowExecutionUpdateAcceptedEventAttributes:                 ToWorkflowExecutionUpdateAcceptedEventAttributes(t.WorkflowExecutionUpdateAcceptedEventAttributes),
+		WorkflowExecutionUpdateRejectedEventAttributes:                 ToWorkflowExecutionUpdateRejectedEventAttributes(t.WorkflowExecutionUpdateRejectedEventAttributes),
+		WorkflowExecutionUpdateCompletedEventAttributes:                ToWorkflowExecutionUpdateCompletedEventAttributes(t.WorkflowExecutionUpdateCompletedEventAttributes),
 	}
 }
 
@@ -3789,6 +4191,7 @@ func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivi
 		LastFailureDetails:     t.LastFailureDetails,
 		StartedWorkerIdentity:  &t.StartedWorkerIdentity,
 		ScheduleID:             &t.ScheduleID,
+		PauseInfo:              FromPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -3813,6 +4216,7 @@ func ToPendingActivityInfo(t *shared.PendingActivityInfo) *types.PendingActivity
 		LastFailureDetails:     t.LastFailureDetails,
 		StartedWorkerIdentity:  t.GetStartedWorkerIdentity(),
 		ScheduleID:             t.GetScheduleID(),
+		PauseInfo:              ToPauseInfo(t.PauseInfo),
 	}
 }
 
@@ -4073,6 +4477,7 @@ func FromPollForDecisionTaskResponse(t *types.PollForDecisionTaskResponse) *shar
 		NextEventId:               &t.NextEventID,
 		TotalHistoryBytes:         &t.TotalHistoryBytes,
 		AutoConfigHint:            FromAutoConfigHint(t.AutoConfigHint),
+		Updates:                   FromWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -4099,6 +4504,7 @@ func ToPollForDecisionTaskResponse(t *shared.PollForDecisionTaskResponse) *types
 		NextEventID:               t.GetNextEventId(),
 		TotalHistoryBytes:         t.GetTotalHistoryBytes(),
 		AutoConfigHint:            ToAutoConfigHint(t.AutoConfigHint),
+		Updates:                   ToWorkflowUpdateArray(t.Updates),
 	}
 }
 
@@ -5292,6 +5698,7 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
 		RequestLocalDispatch:          &t.RequestLocalDispatch,
+		TaskPriority:                  FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -5313,6 +5720,7 @@ func ToScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDeci
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.GetRequestLocalDispatch(),
+		TaskPriority:                  ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -5509,6 +5917,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 		FirstRunAtTimestamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   FromCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        FromActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		TaskPriority:                        FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -5539,6 +5948,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflow
 		FirstRunAtTimestamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		TaskPriority:                        ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -5853,6 +6263,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		FirstRunAtTimestamp:                 t.FirstRunAtTimeStamp,
 		CronOverlapPolicy:                   FromCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        thriftPolicy,
+		TaskPriority:                        FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -5882,6 +6293,7 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		FirstRunAtTimeStamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		TaskPriority:                        ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -6042,6 +6454,46 @@ func ToTaskListKind(t *shared.TaskListKind) *types.TaskListKind {
 	panic("unexpected enum value")
 }
 
+// FromTaskPriority converts internal TaskPriority type to thrift
+func FromTaskPriority(t *types.TaskPriority) *shared.TaskPriority {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.TaskPriorityHighest:
+		return shared.TaskPriorityHighest.Ptr()
+	case types.TaskPriorityHigh:
+		return shared.TaskPriorityHigh.Ptr()
+	case types.TaskPriorityNormal:
+		return shared.TaskPriorityNormal.Ptr()
+	case types.TaskPriorityLow:
+		return shared.TaskPriorityLow.Ptr()
+	case types.TaskPriorityLowest:
+		return shared.TaskPriorityLowest.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+// ToTaskPriority converts thrift TaskPriority type to internal
+func ToTaskPriority(t *shared.TaskPriority) *types.TaskPriority {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.TaskPriorityHighest:
+		return types.TaskPriorityHighest.Ptr()
+	case shared.TaskPriorityHigh:
+		return types.TaskPriorityHigh.Ptr()
+	case shared.TaskPriorityNormal:
+		return types.TaskPriorityNormal.Ptr()
+	case shared.TaskPriorityLow:
+		return types.TaskPriorityLow.Ptr()
+	case shared.TaskPriorityLowest:
+		return types.TaskPriorityLowest.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 // FromTaskListMetadata converts internal TaskListMetadata type to thrift
 func FromTaskListMetadata(t *types.TaskListMetadata) *shared.TaskListMetadata {
 	if t == nil {
@@ -6090,14 +6542,15 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint:      &t.BacklogCountHint,
-		ReadLevel:             &t.ReadLevel,
-		AckLevel:              &t.AckLevel,
-		RatePerSecond:         &t.RatePerSecond,
-		TaskIDBlock:           FromTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
-		NewTasksPerSecond:     &t.NewTasksPerSecond,
-		Empty:                 &t.Empty,
+		BacklogCountHint:       &t.BacklogCountHint,
+		ReadLevel:              &t.ReadLevel,
+		AckLevel:               &t.AckLevel,
+		RatePerSecond:          &t.RatePerSecond,
+		TaskIDBlock:            FromTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:  FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
+		NewTasksPerSecond:      &t.NewTasksPerSecond,
+		Empty:                  &t.Empty,
+		BacklogCountByPriority: t.BacklogCountByPriority,
 	}
 }
 
@@ -6107,14 +6560,15 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint:      t.GetBacklogCountHint(),
-		ReadLevel:             t.GetReadLevel(),
-		AckLevel:              t.GetAckLevel(),
-		RatePerSecond:         t.GetRatePerSecond(),
-		TaskIDBlock:           ToTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
-		NewTasksPerSecond:     t.GetNewTasksPerSecond(),
-		Empty:                 t.GetEmpty(),
+		BacklogCountHint:       t.GetBacklogCountHint(),
+		ReadLevel:              t.GetReadLevel(),
+		AckLevel:               t.GetAckLevel(),
+		RatePerSecond:          t.GetRatePerSecond(),
+		TaskIDBlock:            ToTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:  ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
+		NewTasksPerSecond:      t.GetNewTasksPerSecond(),
+		Empty:                  t.GetEmpty(),
+		BacklogCountByPriority: t.BacklogCountByPriority,
 	}
 }
 
@@ -6940,6 +7394,52 @@ func ToWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *types.WorkflowExe
 	}
 }
 
+// FromWorkflowExecutionPausedEventAttributes converts internal WorkflowExecutionPausedEventAttributes type to thrift
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *shared.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionPausedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionPausedEventAttributes converts thrift WorkflowExecutionPausedEventAttributes type to internal
+func ToWorkflowExecutionPausedEventAttributes(t *shared.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// FromWorkflowExecutionSearchAttributesUpdatedEventAttributes converts internal WorkflowExecutionSearchAttributesUpdatedEventAttributes type to thrift
+func FromWorkflowExecutionSearchAttributesUpdatedEventAttributes(t *types.WorkflowExecutionSearchAttributesUpdatedEventAttributes) *shared.WorkflowExecutionSearchAttributesUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+		Reason:           &t.Reason,
+		Identity:         &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionSearchAttributesUpdatedEventAttributes converts thrift WorkflowExecutionSearchAttributesUpdatedEventAttributes type to internal
+func ToWorkflowExecutionSearchAttributesUpdatedEventAttributes(t *shared.WorkflowExecutionSearchAttributesUpdatedEventAttributes) *types.WorkflowExecutionSearchAttributesUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+		Reason:           t.GetReason(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
 // FromWorkflowExecutionSignaledEventAttributes converts internal WorkflowExecutionSignaledEventAttributes type to thrift
 func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
 	if t == nil {
@@ -7003,6 +7503,8 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		RequestId:                           &t.RequestID,
 		ActiveClusterSelectionPolicy:        FromActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
 		CronOverlapPolicy:                   FromCronOverlapPolicy(t.CronOverlapPolicy),
+		PauseInfo:                           FromPauseInfo(t.PauseInfo),
+		TaskPriority:                        FromTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -7043,6 +7545,8 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 		RequestID:                           t.GetRequestId(),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
+		PauseInfo:                           ToPauseInfo(t.PauseInfo),
+		TaskPriority:                        ToTaskPriority(t.TaskPriority),
 	}
 }
 
@@ -7162,8 +7666,112 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimed
 	}
 }
 
-// FromWorkflowIDReusePolicy converts internal WorkflowIDReusePolicy type to thrift
-func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) *shared.WorkflowIdReusePolicy {
+// FromWorkflowExecutionUnpausedEventAttributes converts internal WorkflowExecutionUnpausedEventAttributes type to thrift
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *shared.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionUnpausedEventAttributes converts thrift WorkflowExecutionUnpausedEventAttributes type to internal
+func ToWorkflowExecutionUnpausedEventAttributes(t *shared.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// FromWorkflowExecutionUpdateAcceptedEventAttributes converts internal WorkflowExecutionUpdateAcceptedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateAcceptedEventAttributes(t *types.WorkflowExecutionUpdateAcceptedEventAttributes) *shared.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateId:                     &t.UpdateID,
+		UpdateName:                   &t.UpdateName,
+		Input:                        t.Input,
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+	}
+}
+
+// ToWorkflowExecutionUpdateAcceptedEventAttributes converts thrift WorkflowExecutionUpdateAcceptedEventAttributes type to internal
+func ToWorkflowExecutionUpdateAcceptedEventAttributes(t *shared.WorkflowExecutionUpdateAcceptedEventAttributes) *types.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:                     t.GetUpdateId(),
+		UpdateName:                   t.GetUpdateName(),
+		Input:                        t.Input,
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// FromWorkflowExecutionUpdateCompletedEventAttributes converts internal WorkflowExecutionUpdateCompletedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateCompletedEventAttributes(t *types.WorkflowExecutionUpdateCompletedEventAttributes) *shared.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateId:                     &t.UpdateID,
+		Result:                       t.Result,
+		FailureReason:                t.FailureReason,
+		FailureDetails:               t.FailureDetails,
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+	}
+}
+
+// ToWorkflowExecutionUpdateCompletedEventAttributes converts thrift WorkflowExecutionUpdateCompletedEventAttributes type to internal
+func ToWorkflowExecutionUpdateCompletedEventAttributes(t *shared.WorkflowExecutionUpdateCompletedEventAttributes) *types.WorkflowExecutionUpdateCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     t.GetUpdateId(),
+		Result:                       t.Result,
+		FailureReason:                t.FailureReason,
+		FailureDetails:               t.FailureDetails,
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// FromWorkflowExecutionUpdateRejectedEventAttributes converts internal WorkflowExecutionUpdateRejectedEventAttributes type to thrift
+func FromWorkflowExecutionUpdateRejectedEventAttributes(t *types.WorkflowExecutionUpdateRejectedEventAttributes) *shared.WorkflowExecutionUpdateRejectedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateRejectedEventAttributes{
+		UpdateId:                     &t.UpdateID,
+		UpdateName:                   &t.UpdateName,
+		Reason:                       &t.Reason,
+		Details:                      t.Details,
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+	}
+}
+
+// ToWorkflowExecutionUpdateRejectedEventAttributes converts thrift WorkflowExecutionUpdateRejectedEventAttributes type to internal
+func ToWorkflowExecutionUpdateRejectedEventAttributes(t *shared.WorkflowExecutionUpdateRejectedEventAttributes) *types.WorkflowExecutionUpdateRejectedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUpdateRejectedEventAttributes{
+		UpdateID:                     t.GetUpdateId(),
+		UpdateName:                   t.GetUpdateName(),
+		Reason:                       t.GetReason(),
+		Details:                      t.Details,
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// FromWorkflowIDReusePolicy converts internal WorkflowIDReusePolicy type to thrift
+func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) *shared.WorkflowIdReusePolicy {
 	if t == nil {
 		return nil
 	}
@@ -7676,6 +8284,30 @@ func ToWorkflowQueryMap(t map[string]*shared.WorkflowQuery) map[string]*types.Wo
 	return v
 }
 
+// FromWorkflowUpdateArray converts internal WorkflowUpdate type array to thrift
+func FromWorkflowUpdateArray(t []*types.WorkflowUpdate) []*shared.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowUpdate, len(t))
+	for i := range t {
+		v[i] = FromWorkflowUpdate(t[i])
+	}
+	return v
+}
+
+// ToWorkflowUpdateArray converts thrift WorkflowUpdate type array to internal
+func ToWorkflowUpdateArray(t []*shared.WorkflowUpdate) []*types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowUpdate, len(t))
+	for i := range t {
+		v[i] = ToWorkflowUpdate(t[i])
+	}
+	return v
+}
+
 // FromWorkflowQueryResultMap converts internal WorkflowQueryResult type map to thrift
 func FromWorkflowQueryResultMap(t map[string]*types.WorkflowQueryResult) map[string]*shared.WorkflowQueryResult {
 	if t == nil {
@@ -8916,3 +9548,503 @@ func ToCronOverlapPolicy(t *shared.CronOverlapPolicy) *types.CronOverlapPolicy {
 	}
 	panic("unexpected enum value")
 }
+
+// FromScheduleOverlapPolicy converts internal ScheduleOverlapPolicy type to thrift
+func FromScheduleOverlapPolicy(t *types.ScheduleOverlapPolicy) *shared.ScheduleOverlapPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.ScheduleOverlapPolicySkip:
+		return shared.ScheduleOverlapPolicySkip.Ptr()
+	case types.ScheduleOverlapPolicyBuffer:
+		return shared.ScheduleOverlapPolicyBuffer.Ptr()
+	case types.ScheduleOverlapPolicyCancelPrevious:
+		return shared.ScheduleOverlapPolicyCancelPrevious.Ptr()
+	case types.ScheduleOverlapPolicyAllowAll:
+		return shared.ScheduleOverlapPolicyAllowAll.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+// ToScheduleOverlapPolicy converts thrift ScheduleOverlapPolicy type to internal
+func ToScheduleOverlapPolicy(t *shared.ScheduleOverlapPolicy) *types.ScheduleOverlapPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.ScheduleOverlapPolicySkip:
+		return types.ScheduleOverlapPolicySkip.Ptr()
+	case shared.ScheduleOverlapPolicyBuffer:
+		return types.ScheduleOverlapPolicyBuffer.Ptr()
+	case shared.ScheduleOverlapPolicyCancelPrevious:
+		return types.ScheduleOverlapPolicyCancelPrevious.Ptr()
+	case shared.ScheduleOverlapPolicyAllowAll:
+		return types.ScheduleOverlapPolicyAllowAll.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+// FromScheduleSpec converts internal ScheduleSpec type to thrift
+func FromScheduleSpec(t *types.ScheduleSpec) *shared.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleSpec{
+		CronExpression:  &t.CronExpression,
+		JitterInSeconds: &t.JitterInSeconds,
+		StartTimestamp:  t.StartTimestamp,
+		EndTimestamp:    t.EndTimestamp,
+	}
+}
+
+// ToScheduleSpec converts thrift ScheduleSpec type to internal
+func ToScheduleSpec(t *shared.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronExpression:  t.GetCronExpression(),
+		JitterInSeconds: t.GetJitterInSeconds(),
+		StartTimestamp:  t.StartTimestamp,
+		EndTimestamp:    t.EndTimestamp,
+	}
+}
+
+// FromScheduleAction converts internal ScheduleAction type to thrift
+func FromScheduleAction(t *types.ScheduleAction) *shared.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleAction{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+	}
+}
+
+// ToScheduleAction converts thrift ScheduleAction type to internal
+func ToScheduleAction(t *shared.ScheduleAction) *types.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleAction{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+	}
+}
+
+// FromScheduleActionResult converts internal ScheduleActionResult type to thrift
+func FromScheduleActionResult(t *types.ScheduleActionResult) *shared.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleActionResult{
+		NominalTimestamp:  t.NominalTimestamp,
+		ActualTimestamp:   t.ActualTimestamp,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Skipped:           &t.Skipped,
+		FailureReason:     &t.FailureReason,
+	}
+}
+
+// ToScheduleActionResult converts thrift ScheduleActionResult type to internal
+func ToScheduleActionResult(t *shared.ScheduleActionResult) *types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleActionResult{
+		NominalTimestamp:  t.NominalTimestamp,
+		ActualTimestamp:   t.ActualTimestamp,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Skipped:           t.GetSkipped(),
+		FailureReason:     t.GetFailureReason(),
+	}
+}
+
+// FromScheduleActionResultArray converts internal ScheduleActionResult type array to thrift
+func FromScheduleActionResultArray(t []*types.ScheduleActionResult) []*shared.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = FromScheduleActionResult(t[i])
+	}
+	return v
+}
+
+// ToScheduleActionResultArray converts thrift ScheduleActionResult type array to internal
+func ToScheduleActionResultArray(t []*shared.ScheduleActionResult) []*types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = ToScheduleActionResult(t[i])
+	}
+	return v
+}
+
+// FromWorkflowExecutionArray converts internal WorkflowExecution type array to thrift
+func FromWorkflowExecutionArray(t []*types.WorkflowExecution) []*shared.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecution(t[i])
+	}
+	return v
+}
+
+// ToWorkflowExecutionArray converts thrift WorkflowExecution type array to internal
+func ToWorkflowExecutionArray(t []*shared.WorkflowExecution) []*types.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecution(t[i])
+	}
+	return v
+}
+
+// FromCreateScheduleRequest converts internal CreateScheduleRequest type to thrift
+func FromCreateScheduleRequest(t *types.CreateScheduleRequest) *shared.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.CreateScheduleRequest{
+		Domain:        &t.Domain,
+		ScheduleID:    &t.ScheduleID,
+		Spec:          FromScheduleSpec(t.Spec),
+		Action:        FromScheduleAction(t.Action),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Paused:        &t.Paused,
+		Identity:      &t.Identity,
+	}
+}
+
+// ToCreateScheduleRequest converts thrift CreateScheduleRequest type to internal
+func ToCreateScheduleRequest(t *shared.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:        t.GetDomain(),
+		ScheduleID:    t.GetScheduleID(),
+		Spec:          ToScheduleSpec(t.Spec),
+		Action:        ToScheduleAction(t.Action),
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Paused:        t.GetPaused(),
+		Identity:      t.GetIdentity(),
+	}
+}
+
+// FromDescribeScheduleRequest converts internal DescribeScheduleRequest type to thrift
+func FromDescribeScheduleRequest(t *types.DescribeScheduleRequest) *shared.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleID: &t.ScheduleID,
+	}
+}
+
+// ToDescribeScheduleRequest converts thrift DescribeScheduleRequest type to internal
+func ToDescribeScheduleRequest(t *shared.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleID(),
+	}
+}
+
+// FromDescribeScheduleResponse converts internal DescribeScheduleResponse type to thrift
+func FromDescribeScheduleResponse(t *types.DescribeScheduleResponse) *shared.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleResponse{
+		Spec:              FromScheduleSpec(t.Spec),
+		Action:            FromScheduleAction(t.Action),
+		OverlapPolicy:     FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Paused:            &t.Paused,
+		PauseReason:       &t.PauseReason,
+		RunningExecutions: FromWorkflowExecutionArray(t.RunningExecutions),
+		RecentActions:     FromScheduleActionResultArray(t.RecentActions),
+		NextRunTimestamps: t.NextRunTimestamps,
+		TotalActions:      &t.TotalActions,
+		SkippedActions:    &t.SkippedActions,
+	}
+}
+
+// ToDescribeScheduleResponse converts thrift DescribeScheduleResponse type to internal
+func ToDescribeScheduleResponse(t *shared.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:              ToScheduleSpec(t.Spec),
+		Action:            ToScheduleAction(t.Action),
+		OverlapPolicy:     ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Paused:            t.GetPaused(),
+		PauseReason:       t.GetPauseReason(),
+		RunningExecutions: ToWorkflowExecutionArray(t.RunningExecutions),
+		RecentActions:     ToScheduleActionResultArray(t.RecentActions),
+		NextRunTimestamps: t.NextRunTimestamps,
+		TotalActions:      t.GetTotalActions(),
+		SkippedActions:    t.GetSkippedActions(),
+	}
+}
+
+// FromUpdateScheduleRequest converts internal UpdateScheduleRequest type to thrift
+func FromUpdateScheduleRequest(t *types.UpdateScheduleRequest) *shared.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateScheduleRequest{
+		Domain:        &t.Domain,
+		ScheduleID:    &t.ScheduleID,
+		Spec:          FromScheduleSpec(t.Spec),
+		Action:        FromScheduleAction(t.Action),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      &t.Identity,
+	}
+}
+
+// ToUpdateScheduleRequest converts thrift UpdateScheduleRequest type to internal
+func ToUpdateScheduleRequest(t *shared.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:        t.GetDomain(),
+		ScheduleID:    t.GetScheduleID(),
+		Spec:          ToScheduleSpec(t.Spec),
+		Action:        ToScheduleAction(t.Action),
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.GetIdentity(),
+	}
+}
+
+// FromPauseScheduleRequest converts internal PauseScheduleRequest type to thrift
+func FromPauseScheduleRequest(t *types.PauseScheduleRequest) *shared.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleID: &t.ScheduleID,
+		Reason:     &t.Reason,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToPauseScheduleRequest converts thrift PauseScheduleRequest type to internal
+func ToPauseScheduleRequest(t *shared.PauseScheduleRequest) *types.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleID(),
+		Reason:     t.GetReason(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromResumeScheduleRequest converts internal ResumeScheduleRequest type to thrift
+func FromResumeScheduleRequest(t *types.ResumeScheduleRequest) *shared.ResumeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResumeScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleID: &t.ScheduleID,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToResumeScheduleRequest converts thrift ResumeScheduleRequest type to internal
+func ToResumeScheduleRequest(t *shared.ResumeScheduleRequest) *types.ResumeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleID(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromBackfillScheduleRequest converts internal BackfillScheduleRequest type to thrift
+func FromBackfillScheduleRequest(t *types.BackfillScheduleRequest) *shared.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.BackfillScheduleRequest{
+		Domain:         &t.Domain,
+		ScheduleID:     &t.ScheduleID,
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+		OverlapPolicy:  FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:       &t.Identity,
+	}
+}
+
+// ToBackfillScheduleRequest converts thrift BackfillScheduleRequest type to internal
+func ToBackfillScheduleRequest(t *shared.BackfillScheduleRequest) *types.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillScheduleRequest{
+		Domain:         t.GetDomain(),
+		ScheduleID:     t.GetScheduleID(),
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+		OverlapPolicy:  ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:       t.GetIdentity(),
+	}
+}
+
+// FromDeleteScheduleRequest converts internal DeleteScheduleRequest type to thrift
+func FromDeleteScheduleRequest(t *types.DeleteScheduleRequest) *shared.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleID: &t.ScheduleID,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToDeleteScheduleRequest converts thrift DeleteScheduleRequest type to internal
+func ToDeleteScheduleRequest(t *shared.DeleteScheduleRequest) *types.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleID(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromListSchedulesRequest converts internal ListSchedulesRequest type to thrift
+func FromListSchedulesRequest(t *types.ListSchedulesRequest) *shared.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesRequest{
+		Domain:        &t.Domain,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesRequest converts thrift ListSchedulesRequest type to internal
+func ToListSchedulesRequest(t *shared.ListSchedulesRequest) *types.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListSchedulesResponse converts internal ListSchedulesResponse type to thrift
+func FromListSchedulesResponse(t *types.ListSchedulesResponse) *shared.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesResponse{
+		ScheduleIDs:   t.ScheduleIDs,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesResponse converts thrift ListSchedulesResponse type to internal
+func ToListSchedulesResponse(t *shared.ListSchedulesResponse) *types.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesResponse{
+		ScheduleIDs:   t.ScheduleIDs,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromWorkflowUpdate converts internal WorkflowUpdate type to thrift
+func FromWorkflowUpdate(t *types.WorkflowUpdate) *shared.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowUpdate{
+		UpdateId:   &t.UpdateID,
+		UpdateName: &t.UpdateName,
+		Input:      t.Input,
+	}
+}
+
+// ToWorkflowUpdate converts thrift WorkflowUpdate type to internal
+func ToWorkflowUpdate(t *shared.WorkflowUpdate) *types.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUpdate{
+		UpdateID:   t.GetUpdateId(),
+		UpdateName: t.GetUpdateName(),
+		Input:      t.Input,
+	}
+}
+
+// FromWorkflowUpdateStage converts internal WorkflowUpdateStage type to thrift
+func FromWorkflowUpdateStage(t *types.WorkflowUpdateStage) *shared.WorkflowUpdateStage {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.WorkflowUpdateStageAccepted:
+		v := shared.WorkflowUpdateStageAccepted
+		return &v
+	case types.WorkflowUpdateStageRejected:
+		v := shared.WorkflowUpdateStageRejected
+		return &v
+	case types.WorkflowUpdateStageCompleted:
+		v := shared.WorkflowUpdateStageCompleted
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToWorkflowUpdateStage converts thrift WorkflowUpdateStage type to internal
+func ToWorkflowUpdateStage(t *shared.WorkflowUpdateStage) *types.WorkflowUpdateStage {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.WorkflowUpdateStageAccepted:
+		v := types.WorkflowUpdateStageAccepted
+		return &v
+	case shared.WorkflowUpdateStageRejected:
+		v := types.WorkflowUpdateStageRejected
+		return &v
+	case shared.WorkflowUpdateStageCompleted:
+		v := types.WorkflowUpdateStageCompleted
+		return &v
+	}
+	panic("unexpected enum value")
+}
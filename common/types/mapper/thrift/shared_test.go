@@ -900,6 +900,9 @@ func TestDecisionTaskFailedCauseConversion(t *testing.T) {
 		types.DecisionTaskFailedCauseBadBinary.Ptr(),
 		types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr(),
 		types.DecisionTaskFailedCauseBadSearchAttributes.Ptr(),
+		types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes.Ptr(),
+		types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes.Ptr(),
+		types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -995,6 +998,9 @@ func TestDecisionTypeConversion(t *testing.T) {
 		types.DecisionTypeStartChildWorkflowExecution.Ptr(),
 		types.DecisionTypeSignalExternalWorkflowExecution.Ptr(),
 		types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr(),
+		types.DecisionTypeAcceptWorkflowUpdate.Ptr(),
+		types.DecisionTypeRejectWorkflowUpdate.Ptr(),
+		types.DecisionTypeCompleteWorkflowUpdate.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -1432,6 +1438,14 @@ func TestEventTypeConversion(t *testing.T) {
 		types.EventTypeSignalExternalWorkflowExecutionFailed.Ptr(),
 		types.EventTypeExternalWorkflowExecutionSignaled.Ptr(),
 		types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+		types.EventTypeWorkflowExecutionSearchAttributesUpdated.Ptr(),
+		types.EventTypeWorkflowExecutionPaused.Ptr(),
+		types.EventTypeWorkflowExecutionUnpaused.Ptr(),
+		types.EventTypeActivityTaskPaused.Ptr(),
+		types.EventTypeActivityTaskUnpaused.Ptr(),
+		types.EventTypeWorkflowExecutionUpdateAccepted.Ptr(),
+		types.EventTypeWorkflowExecutionUpdateRejected.Ptr(),
+		types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -2082,6 +2096,21 @@ func TestQueryConsistencyLevelConversion(t *testing.T) {
 	}
 }
 
+func TestWorkflowUpdateStageConversion(t *testing.T) {
+	testCases := []*types.WorkflowUpdateStage{
+		nil,
+		types.WorkflowUpdateStageAccepted.Ptr(),
+		types.WorkflowUpdateStageRejected.Ptr(),
+		types.WorkflowUpdateStageCompleted.Ptr(),
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowUpdateStage(original)
+		roundTripObj := ToWorkflowUpdateStage(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestQueryFailedErrorConversion(t *testing.T) {
 	testCases := []*types.QueryFailedError{
 		nil,
@@ -3351,6 +3380,456 @@ func TestWorkflowExecutionFilterConversion(t *testing.T) {
 	}
 }
 
+func TestWorkflowExecutionSearchAttributesUpdatedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+		nil,
+		{},
+		&testdata.WorkflowExecutionSearchAttributesUpdatedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowExecutionSearchAttributesUpdatedEventAttributes(original)
+		roundTripObj := ToWorkflowExecutionSearchAttributesUpdatedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowExecutionPausedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.WorkflowExecutionPausedEventAttributes{
+		nil,
+		{},
+		&testdata.WorkflowExecutionPausedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowExecutionPausedEventAttributes(original)
+		roundTripObj := ToWorkflowExecutionPausedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowExecutionUnpausedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.WorkflowExecutionUnpausedEventAttributes{
+		nil,
+		{},
+		&testdata.WorkflowExecutionUnpausedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowExecutionUnpausedEventAttributes(original)
+		roundTripObj := ToWorkflowExecutionUnpausedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestActivityTaskPausedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.ActivityTaskPausedEventAttributes{
+		nil,
+		{},
+		&testdata.ActivityTaskPausedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityTaskPausedEventAttributes(original)
+		roundTripObj := ToActivityTaskPausedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestActivityTaskUnpausedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.ActivityTaskUnpausedEventAttributes{
+		nil,
+		{},
+		&testdata.ActivityTaskUnpausedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromActivityTaskUnpausedEventAttributes(original)
+		roundTripObj := ToActivityTaskUnpausedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowExecutionUpdateAcceptedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		nil,
+		{},
+		&testdata.WorkflowExecutionUpdateAcceptedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowExecutionUpdateAcceptedEventAttributes(original)
+		roundTripObj := ToWorkflowExecutionUpdateAcceptedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowExecutionUpdateRejectedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.WorkflowExecutionUpdateRejectedEventAttributes{
+		nil,
+		{},
+		&testdata.WorkflowExecutionUpdateRejectedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowExecutionUpdateRejectedEventAttributes(original)
+		roundTripObj := ToWorkflowExecutionUpdateRejectedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowExecutionUpdateCompletedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.WorkflowExecutionUpdateCompletedEventAttributes{
+		nil,
+		{},
+		&testdata.WorkflowExecutionUpdateCompletedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowExecutionUpdateCompletedEventAttributes(original)
+		roundTripObj := ToWorkflowExecutionUpdateCompletedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestPauseInfoConversion(t *testing.T) {
+	testCases := []*types.PauseInfo{
+		nil,
+		{},
+		&testdata.PauseInfo,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromPauseInfo(original)
+		roundTripObj := ToPauseInfo(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestScheduleSpecConversion(t *testing.T) {
+	testCases := []*types.ScheduleSpec{
+		nil,
+		{},
+		&testdata.ScheduleSpec,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromScheduleSpec(original)
+		roundTripObj := ToScheduleSpec(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestScheduleActionConversion(t *testing.T) {
+	testCases := []*types.ScheduleAction{
+		nil,
+		{},
+		&testdata.ScheduleAction,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromScheduleAction(original)
+		roundTripObj := ToScheduleAction(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestScheduleActionResultConversion(t *testing.T) {
+	testCases := []*types.ScheduleActionResult{
+		nil,
+		{},
+		&testdata.ScheduleActionResult,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromScheduleActionResult(original)
+		roundTripObj := ToScheduleActionResult(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestCreateScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.CreateScheduleRequest{
+		nil,
+		{},
+		&testdata.CreateScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromCreateScheduleRequest(original)
+		roundTripObj := ToCreateScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDescribeScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.DescribeScheduleRequest{
+		nil,
+		{},
+		&testdata.DescribeScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDescribeScheduleRequest(original)
+		roundTripObj := ToDescribeScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDescribeScheduleResponseConversion(t *testing.T) {
+	testCases := []*types.DescribeScheduleResponse{
+		nil,
+		{},
+		&testdata.DescribeScheduleResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDescribeScheduleResponse(original)
+		roundTripObj := ToDescribeScheduleResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.UpdateScheduleRequest{
+		nil,
+		{},
+		&testdata.UpdateScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateScheduleRequest(original)
+		roundTripObj := ToUpdateScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestPauseScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.PauseScheduleRequest{
+		nil,
+		{},
+		&testdata.PauseScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromPauseScheduleRequest(original)
+		roundTripObj := ToPauseScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestResumeScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.ResumeScheduleRequest{
+		nil,
+		{},
+		&testdata.ResumeScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromResumeScheduleRequest(original)
+		roundTripObj := ToResumeScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestBackfillScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.BackfillScheduleRequest{
+		nil,
+		{},
+		&testdata.BackfillScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromBackfillScheduleRequest(original)
+		roundTripObj := ToBackfillScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDeleteScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.DeleteScheduleRequest{
+		nil,
+		{},
+		&testdata.DeleteScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDeleteScheduleRequest(original)
+		roundTripObj := ToDeleteScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestListSchedulesRequestConversion(t *testing.T) {
+	testCases := []*types.ListSchedulesRequest{
+		nil,
+		{},
+		&testdata.ListSchedulesRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListSchedulesRequest(original)
+		roundTripObj := ToListSchedulesRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestListSchedulesResponseConversion(t *testing.T) {
+	testCases := []*types.ListSchedulesResponse{
+		nil,
+		{},
+		&testdata.ListSchedulesResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListSchedulesResponse(original)
+		roundTripObj := ToListSchedulesResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestScheduleOverlapPolicyConversion(t *testing.T) {
+	testCases := []*types.ScheduleOverlapPolicy{
+		nil,
+		types.ScheduleOverlapPolicySkip.Ptr(),
+		types.ScheduleOverlapPolicyBuffer.Ptr(),
+		types.ScheduleOverlapPolicyCancelPrevious.Ptr(),
+		types.ScheduleOverlapPolicyAllowAll.Ptr(),
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromScheduleOverlapPolicy(original)
+		roundTripObj := ToScheduleOverlapPolicy(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestPauseWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.PauseWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.PauseWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromPauseWorkflowExecutionRequest(original)
+		roundTripObj := ToPauseWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUnpauseWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.UnpauseWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.UnpauseWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUnpauseWorkflowExecutionRequest(original)
+		roundTripObj := ToUnpauseWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestResetActivityRequestConversion(t *testing.T) {
+	testCases := []*types.ResetActivityRequest{
+		nil,
+		{},
+		&testdata.ResetActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromResetActivityRequest(original)
+		roundTripObj := ToResetActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestAcceptWorkflowUpdateDecisionAttributesConversion(t *testing.T) {
+	testCases := []*types.AcceptWorkflowUpdateDecisionAttributes{
+		nil,
+		{},
+		&testdata.AcceptWorkflowUpdateDecisionAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromAcceptWorkflowUpdateDecisionAttributes(original)
+		roundTripObj := ToAcceptWorkflowUpdateDecisionAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestRejectWorkflowUpdateDecisionAttributesConversion(t *testing.T) {
+	testCases := []*types.RejectWorkflowUpdateDecisionAttributes{
+		nil,
+		{},
+		&testdata.RejectWorkflowUpdateDecisionAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromRejectWorkflowUpdateDecisionAttributes(original)
+		roundTripObj := ToRejectWorkflowUpdateDecisionAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestCompleteWorkflowUpdateDecisionAttributesConversion(t *testing.T) {
+	testCases := []*types.CompleteWorkflowUpdateDecisionAttributes{
+		nil,
+		{},
+		&testdata.CompleteWorkflowUpdateDecisionAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromCompleteWorkflowUpdateDecisionAttributes(original)
+		roundTripObj := ToCompleteWorkflowUpdateDecisionAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.UpdateWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.UpdateWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateWorkflowExecutionRequest(original)
+		roundTripObj := ToUpdateWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateWorkflowExecutionResponseConversion(t *testing.T) {
+	testCases := []*types.UpdateWorkflowExecutionResponse{
+		nil,
+		{},
+		&testdata.UpdateWorkflowExecutionResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateWorkflowExecutionResponse(original)
+		roundTripObj := ToUpdateWorkflowExecutionResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowUpdateConversion(t *testing.T) {
+	testCases := []*types.WorkflowUpdate{
+		nil,
+		{},
+		&testdata.WorkflowUpdate,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowUpdate(original)
+		roundTripObj := ToWorkflowUpdate(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestWorkflowIDReusePolicyConversion(t *testing.T) {
 	testCases := []*types.WorkflowIDReusePolicy{
 		nil,
@@ -38,6 +38,7 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom                 string                    `json:"forwardedFrom,omitempty"`
 	ActivityTaskDispatchInfo      *ActivityTaskDispatchInfo `json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig               map[string]string
+	TaskPriority                  *TaskPriority `json:"taskPriority,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -133,6 +134,7 @@ type AddDecisionTaskRequest struct {
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
 	PartitionConfig               map[string]string
+	TaskPriority                  *TaskPriority `json:"taskPriority,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -451,6 +453,7 @@ type MatchingPollForDecisionTaskResponse struct {
 	PartitionConfig           *TaskListPartitionConfig
 	LoadBalancerHints         *LoadBalancerHints
 	AutoConfigHint            *AutoConfigHint
+	Updates                   []*WorkflowUpdate `json:"updates,omitempty"`
 }
 
 // GetWorkflowExecution is an internal getter (TBD...)
@@ -30,6 +30,37 @@ import (
 	"unsafe"
 )
 
+// AcceptWorkflowUpdateDecisionAttributes is an internal type (TBD...)
+type AcceptWorkflowUpdateDecisionAttributes struct {
+	UpdateID   string `json:"updateId,omitempty"`
+	UpdateName string `json:"updateName,omitempty"`
+	Input      []byte `json:"-"` // Filtering PII
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *AcceptWorkflowUpdateDecisionAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetUpdateName is an internal getter (TBD...)
+func (v *AcceptWorkflowUpdateDecisionAttributes) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *AcceptWorkflowUpdateDecisionAttributes) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
 // AccessDeniedError is an internal type (TBD...)
 // TODO(c-warren): Move to common/types/errors.go
 type AccessDeniedError struct {
@@ -153,6 +184,42 @@ func (v *ActivityTaskFailedEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
+// ActivityTaskPausedEventAttributes is an internal type (TBD...)
+type ActivityTaskPausedEventAttributes struct {
+	ScheduledEventID int64  `json:"scheduledEventId,omitempty"`
+	Reason           string `json:"reason,omitempty"`
+	Identity         string `json:"identity,omitempty"`
+}
+
+// GetScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskPausedEventAttributes) GetScheduledEventID() (o int64) {
+	if v != nil {
+		return v.ScheduledEventID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *ActivityTaskPausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ActivityTaskPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *ActivityTaskPausedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // ActivityTaskScheduledEventAttributes is an internal type (TBD...)
 type ActivityTaskScheduledEventAttributes struct {
 	ActivityID                    string        `json:"activityId,omitempty"`
@@ -167,6 +234,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventID  int64         `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	TaskPriority                  *TaskPriority `json:"taskPriority,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -233,6 +301,14 @@ func (v *ActivityTaskScheduledEventAttributes) GetHeartbeatTimeoutSeconds() (o i
 	return
 }
 
+// GetHeader is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetHeader() (o *Header) {
+	if v != nil {
+		return v.Header
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *ActivityTaskScheduledEventAttributes) ByteSize() uint64 {
 	return 0
@@ -300,6 +376,42 @@ func (v *ActivityTaskTimedOutEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
+// ActivityTaskUnpausedEventAttributes is an internal type (TBD...)
+type ActivityTaskUnpausedEventAttributes struct {
+	ScheduledEventID int64  `json:"scheduledEventId,omitempty"`
+	Reason           string `json:"reason,omitempty"`
+	Identity         string `json:"identity,omitempty"`
+}
+
+// GetScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskUnpausedEventAttributes) GetScheduledEventID() (o int64) {
+	if v != nil {
+		return v.ScheduledEventID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *ActivityTaskUnpausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ActivityTaskUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *ActivityTaskUnpausedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // ActivityType is an internal type (TBD...)
 type ActivityType struct {
 	Name string `json:"name,omitempty"`
@@ -760,6 +872,46 @@ type ClientVersionNotSupportedError struct {
 	SupportedVersions string `json:"supportedVersions,required"`
 }
 
+// CompleteWorkflowUpdateDecisionAttributes is an internal type (TBD...)
+type CompleteWorkflowUpdateDecisionAttributes struct {
+	UpdateID       string  `json:"updateId,omitempty"`
+	Result         []byte  `json:"result,omitempty"`
+	FailureReason  *string `json:"failureReason,omitempty"`
+	FailureDetails []byte  `json:"failureDetails,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *CompleteWorkflowUpdateDecisionAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *CompleteWorkflowUpdateDecisionAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetFailureReason is an internal getter (TBD...)
+func (v *CompleteWorkflowUpdateDecisionAttributes) GetFailureReason() (o string) {
+	if v != nil && v.FailureReason != nil {
+		return *v.FailureReason
+	}
+	return
+}
+
+// GetFailureDetails is an internal getter (TBD...)
+func (v *CompleteWorkflowUpdateDecisionAttributes) GetFailureDetails() (o []byte) {
+	if v != nil && v.FailureDetails != nil {
+		return v.FailureDetails
+	}
+	return
+}
+
 // FeatureNotEnabledError is an internal type (TBD...)
 type FeatureNotEnabledError struct {
 	FeatureFlag string `json:"featureFlag,required"`
@@ -1055,6 +1207,9 @@ type Decision struct {
 	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
 	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesDecisionAttributes         *UpsertWorkflowSearchAttributesDecisionAttributes         `json:"upsertWorkflowSearchAttributesDecisionAttributes,omitempty"`
+	AcceptWorkflowUpdateDecisionAttributes                   *AcceptWorkflowUpdateDecisionAttributes                   `json:"acceptWorkflowUpdateDecisionAttributes,omitempty"`
+	RejectWorkflowUpdateDecisionAttributes                   *RejectWorkflowUpdateDecisionAttributes                   `json:"rejectWorkflowUpdateDecisionAttributes,omitempty"`
+	CompleteWorkflowUpdateDecisionAttributes                 *CompleteWorkflowUpdateDecisionAttributes                 `json:"completeWorkflowUpdateDecisionAttributes,omitempty"`
 }
 
 // GetDecisionType is an internal getter (TBD...)
@@ -1153,6 +1308,12 @@ func (e DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_I_D"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "BAD_ACCEPT_WORKFLOW_UPDATE_ATTRIBUTES"
+	case 24:
+		return "BAD_REJECT_WORKFLOW_UPDATE_ATTRIBUTES"
+	case 25:
+		return "BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -1229,6 +1390,15 @@ func (e *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*e = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "BAD_ACCEPT_WORKFLOW_UPDATE_ATTRIBUTES":
+		*e = DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes
+		return nil
+	case "BAD_REJECT_WORKFLOW_UPDATE_ATTRIBUTES":
+		*e = DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes
+		return nil
+	case "BAD_COMPLETE_WORKFLOW_UPDATE_ATTRIBUTES":
+		*e = DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1291,6 +1461,12 @@ const (
 	DecisionTaskFailedCauseScheduleActivityDuplicateID
 	// DecisionTaskFailedCauseBadSearchAttributes is an option for DecisionTaskFailedCause
 	DecisionTaskFailedCauseBadSearchAttributes
+	// DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes
+	// DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes
+	// DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes
 )
 
 // DecisionTaskFailedEventAttributes is an internal type (TBD...)
@@ -1588,6 +1764,12 @@ func (e DecisionType) String() string {
 		return "SignalExternalWorkflowExecution"
 	case 12:
 		return "UpsertWorkflowSearchAttributes"
+	case 13:
+		return "AcceptWorkflowUpdate"
+	case 14:
+		return "RejectWorkflowUpdate"
+	case 15:
+		return "CompleteWorkflowUpdate"
 	}
 	return fmt.Sprintf("DecisionType(%d)", w)
 }
@@ -1634,6 +1816,15 @@ func (e *DecisionType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = DecisionTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "ACCEPTWORKFLOWUPDATE":
+		*e = DecisionTypeAcceptWorkflowUpdate
+		return nil
+	case "REJECTWORKFLOWUPDATE":
+		*e = DecisionTypeRejectWorkflowUpdate
+		return nil
+	case "COMPLETEWORKFLOWUPDATE":
+		*e = DecisionTypeCompleteWorkflowUpdate
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1676,6 +1867,12 @@ const (
 	DecisionTypeSignalExternalWorkflowExecution
 	// DecisionTypeUpsertWorkflowSearchAttributes is an option for DecisionType
 	DecisionTypeUpsertWorkflowSearchAttributes
+	// DecisionTypeAcceptWorkflowUpdate is an option for DecisionType
+	DecisionTypeAcceptWorkflowUpdate
+	// DecisionTypeRejectWorkflowUpdate is an option for DecisionType
+	DecisionTypeRejectWorkflowUpdate
+	// DecisionTypeCompleteWorkflowUpdate is an option for DecisionType
+	DecisionTypeCompleteWorkflowUpdate
 )
 
 // DeleteDomainRequest is an internal type (TBD...)
@@ -1782,6 +1979,7 @@ type FailoverDomainRequest struct {
 	DomainName              string          `json:"domainName,omitempty"`
 	DomainActiveClusterName *string         `json:"domainActiveClusterName,omitempty"`
 	ActiveClusters          *ActiveClusters `json:"activeClusters,omitempty"`
+	Reason                  string          `json:"reason,omitempty"`
 }
 
 func (v *FailoverDomainRequest) ToUpdateDomainRequest() *UpdateDomainRequest {
@@ -1811,6 +2009,14 @@ func (v *FailoverDomainRequest) GetDomainActiveClusterName() (o string) {
 	return
 }
 
+// GetReason is an internal getter (TBD...)
+func (v *FailoverDomainRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
 // GetDomain is an internal getter (TBD...)
 func (v *FailoverDomainRequest) GetDomain() (o string) {
 	if v != nil {
@@ -2217,6 +2423,7 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
 	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren,omitempty"`
 	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+	PauseInfo              *PauseInfo                      `json:"pauseInfo,omitempty"`
 }
 
 // GetWorkflowExecutionInfo is an internal getter (TBD...)
@@ -2235,6 +2442,14 @@ func (v *DescribeWorkflowExecutionResponse) GetPendingActivities() (o []*Pending
 	return
 }
 
+// GetPauseInfo is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetPauseInfo() (o *PauseInfo) {
+	if v != nil && v.PauseInfo != nil {
+		return v.PauseInfo
+	}
+	return
+}
+
 // DomainAlreadyExistsError is an internal type (TBD...)
 type DomainAlreadyExistsError struct {
 	Message string `json:"message,required"`
@@ -3002,130 +3217,471 @@ type EntityNotExistsError struct {
 	ActiveClusters []string `json:"activeClusters,omitempty"`
 }
 
-// WorkflowExecutionAlreadyCompletedError is an internal type (TBD...)
-type WorkflowExecutionAlreadyCompletedError struct {
-	Message string `json:"message,required"`
+// PauseInfo is an internal type (TBD...)
+type PauseInfo struct {
+	Reason          string `json:"reason,omitempty"`
+	Identity        string `json:"identity,omitempty"`
+	PausedTimestamp *int64 `json:"pausedTimestamp,omitempty"`
 }
 
-// EventType is an internal type (TBD...)
-type EventType int32
+// GetReason is an internal getter (TBD...)
+func (v *PauseInfo) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
 
-// Ptr is a helper function for getting pointer value
-func (e EventType) Ptr() *EventType {
-	return &e
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseInfo) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
 }
 
-// String returns a readable string representation of EventType.
-func (e EventType) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "WorkflowExecutionStarted"
-	case 1:
-		return "WorkflowExecutionCompleted"
-	case 2:
-		return "WorkflowExecutionFailed"
-	case 3:
-		return "WorkflowExecutionTimedOut"
-	case 4:
-		return "DecisionTaskScheduled"
-	case 5:
-		return "DecisionTaskStarted"
-	case 6:
-		return "DecisionTaskCompleted"
-	case 7:
-		return "DecisionTaskTimedOut"
-	case 8:
-		return "DecisionTaskFailed"
-	case 9:
-		return "ActivityTaskScheduled"
-	case 10:
-		return "ActivityTaskStarted"
-	case 11:
-		return "ActivityTaskCompleted"
-	case 12:
-		return "ActivityTaskFailed"
-	case 13:
-		return "ActivityTaskTimedOut"
-	case 14:
-		return "ActivityTaskCancelRequested"
-	case 15:
-		return "RequestCancelActivityTaskFailed"
-	case 16:
-		return "ActivityTaskCanceled"
-	case 17:
-		return "TimerStarted"
-	case 18:
-		return "TimerFired"
-	case 19:
-		return "CancelTimerFailed"
-	case 20:
-		return "TimerCanceled"
-	case 21:
-		return "WorkflowExecutionCancelRequested"
-	case 22:
-		return "WorkflowExecutionCanceled"
-	case 23:
-		return "RequestCancelExternalWorkflowExecutionInitiated"
-	case 24:
-		return "RequestCancelExternalWorkflowExecutionFailed"
-	case 25:
-		return "ExternalWorkflowExecutionCancelRequested"
-	case 26:
-		return "MarkerRecorded"
-	case 27:
-		return "WorkflowExecutionSignaled"
-	case 28:
-		return "WorkflowExecutionTerminated"
-	case 29:
-		return "WorkflowExecutionContinuedAsNew"
-	case 30:
-		return "StartChildWorkflowExecutionInitiated"
-	case 31:
-		return "StartChildWorkflowExecutionFailed"
-	case 32:
-		return "ChildWorkflowExecutionStarted"
-	case 33:
-		return "ChildWorkflowExecutionCompleted"
-	case 34:
-		return "ChildWorkflowExecutionFailed"
-	case 35:
-		return "ChildWorkflowExecutionCanceled"
-	case 36:
-		return "ChildWorkflowExecutionTimedOut"
-	case 37:
-		return "ChildWorkflowExecutionTerminated"
-	case 38:
-		return "SignalExternalWorkflowExecutionInitiated"
-	case 39:
-		return "SignalExternalWorkflowExecutionFailed"
-	case 40:
-		return "ExternalWorkflowExecutionSignaled"
-	case 41:
-		return "UpsertWorkflowSearchAttributes"
+// GetPausedTimestamp is an internal getter (TBD...)
+func (v *PauseInfo) GetPausedTimestamp() (o int64) {
+	if v != nil && v.PausedTimestamp != nil {
+		return *v.PausedTimestamp
 	}
-	return fmt.Sprintf("EventType(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *EventType) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "WORKFLOWEXECUTIONSTARTED":
-		*e = EventTypeWorkflowExecutionStarted
-		return nil
-	case "WORKFLOWEXECUTIONCOMPLETED":
-		*e = EventTypeWorkflowExecutionCompleted
-		return nil
-	case "WORKFLOWEXECUTIONFAILED":
-		*e = EventTypeWorkflowExecutionFailed
-		return nil
-	case "WORKFLOWEXECUTIONTIMEDOUT":
-		*e = EventTypeWorkflowExecutionTimedOut
-		return nil
-	case "DECISIONTASKSCHEDULED":
-		*e = EventTypeDecisionTaskScheduled
-		return nil
-	case "DECISIONTASKSTARTED":
+// PauseWorkflowExecutionRequest is an internal type (TBD...)
+type PauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// RejectWorkflowUpdateDecisionAttributes is an internal type (TBD...)
+type RejectWorkflowUpdateDecisionAttributes struct {
+	UpdateID   string `json:"updateId,omitempty"`
+	UpdateName string `json:"updateName,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Details    []byte `json:"details,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *RejectWorkflowUpdateDecisionAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetUpdateName is an internal getter (TBD...)
+func (v *RejectWorkflowUpdateDecisionAttributes) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *RejectWorkflowUpdateDecisionAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetDetails is an internal getter (TBD...)
+func (v *RejectWorkflowUpdateDecisionAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+	return
+}
+
+// ResetActivityRequest is an internal type (TBD...)
+type ResetActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type UnpauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UpdateWorkflowExecutionRequest is an internal type (TBD...)
+type UpdateWorkflowExecutionRequest struct {
+	Domain            string               `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution   `json:"workflowExecution,omitempty"`
+	UpdateID          string               `json:"updateId,omitempty"`
+	UpdateName        string               `json:"updateName,omitempty"`
+	Input             []byte               `json:"-"` // Filtering PII
+	Identity          string               `json:"identity,omitempty"`
+	WaitForStage      *WorkflowUpdateStage `json:"waitForStage,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetUpdateName is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetWaitForStage is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionRequest) GetWaitForStage() (o WorkflowUpdateStage) {
+	if v != nil && v.WaitForStage != nil {
+		return *v.WaitForStage
+	}
+	return
+}
+
+// UpdateWorkflowExecutionResponse is an internal type (TBD...)
+type UpdateWorkflowExecutionResponse struct {
+	UpdateID       string               `json:"updateId,omitempty"`
+	Stage          *WorkflowUpdateStage `json:"stage,omitempty"`
+	Result         []byte               `json:"result,omitempty"`
+	FailureReason  *string              `json:"failureReason,omitempty"`
+	FailureDetails []byte               `json:"failureDetails,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+// GetStage is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetStage() (o WorkflowUpdateStage) {
+	if v != nil && v.Stage != nil {
+		return *v.Stage
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetFailureReason is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetFailureReason() (o string) {
+	if v != nil && v.FailureReason != nil {
+		return *v.FailureReason
+	}
+	return
+}
+
+// GetFailureDetails is an internal getter (TBD...)
+func (v *UpdateWorkflowExecutionResponse) GetFailureDetails() (o []byte) {
+	if v != nil && v.FailureDetails != nil {
+		return v.FailureDetails
+	}
+	return
+}
+
+// WorkflowExecutionAlreadyCompletedError is an internal type (TBD...)
+type WorkflowExecutionAlreadyCompletedError struct {
+	Message string `json:"message,required"`
+}
+
+// EventType is an internal type (TBD...)
+type EventType int32
+
+// Ptr is a helper function for getting pointer value
+func (e EventType) Ptr() *EventType {
+	return &e
+}
+
+// String returns a readable string representation of EventType.
+func (e EventType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "WorkflowExecutionStarted"
+	case 1:
+		return "WorkflowExecutionCompleted"
+	case 2:
+		return "WorkflowExecutionFailed"
+	case 3:
+		return "WorkflowExecutionTimedOut"
+	case 4:
+		return "DecisionTaskScheduled"
+	case 5:
+		return "DecisionTaskStarted"
+	case 6:
+		return "DecisionTaskCompleted"
+	case 7:
+		return "DecisionTaskTimedOut"
+	case 8:
+		return "DecisionTaskFailed"
+	case 9:
+		return "ActivityTaskScheduled"
+	case 10:
+		return "ActivityTaskStarted"
+	case 11:
+		return "ActivityTaskCompleted"
+	case 12:
+		return "ActivityTaskFailed"
+	case 13:
+		return "ActivityTaskTimedOut"
+	case 14:
+		return "ActivityTaskCancelRequested"
+	case 15:
+		return "RequestCancelActivityTaskFailed"
+	case 16:
+		return "ActivityTaskCanceled"
+	case 17:
+		return "TimerStarted"
+	case 18:
+		return "TimerFired"
+	case 19:
+		return "CancelTimerFailed"
+	case 20:
+		return "TimerCanceled"
+	case 21:
+		return "WorkflowExecutionCancelRequested"
+	case 22:
+		return "WorkflowExecutionCanceled"
+	case 23:
+		return "RequestCancelExternalWorkflowExecutionInitiated"
+	case 24:
+		return "RequestCancelExternalWorkflowExecutionFailed"
+	case 25:
+		return "ExternalWorkflowExecutionCancelRequested"
+	case 26:
+		return "MarkerRecorded"
+	case 27:
+		return "WorkflowExecutionSignaled"
+	case 28:
+		return "WorkflowExecutionTerminated"
+	case 29:
+		return "WorkflowExecutionContinuedAsNew"
+	case 30:
+		return "StartChildWorkflowExecutionInitiated"
+	case 31:
+		return "StartChildWorkflowExecutionFailed"
+	case 32:
+		return "ChildWorkflowExecutionStarted"
+	case 33:
+		return "ChildWorkflowExecutionCompleted"
+	case 34:
+		return "ChildWorkflowExecutionFailed"
+	case 35:
+		return "ChildWorkflowExecutionCanceled"
+	case 36:
+		return "ChildWorkflowExecutionTimedOut"
+	case 37:
+		return "ChildWorkflowExecutionTerminated"
+	case 38:
+		return "SignalExternalWorkflowExecutionInitiated"
+	case 39:
+		return "SignalExternalWorkflowExecutionFailed"
+	case 40:
+		return "ExternalWorkflowExecutionSignaled"
+	case 41:
+		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionSearchAttributesUpdated"
+	case 43:
+		return "WorkflowExecutionPaused"
+	case 44:
+		return "WorkflowExecutionUnpaused"
+	case 45:
+		return "ActivityTaskPaused"
+	case 46:
+		return "ActivityTaskUnpaused"
+	case 47:
+		return "WorkflowExecutionUpdateAccepted"
+	case 48:
+		return "WorkflowExecutionUpdateRejected"
+	case 49:
+		return "WorkflowExecutionUpdateCompleted"
+	}
+	return fmt.Sprintf("EventType(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *EventType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "WORKFLOWEXECUTIONSTARTED":
+		*e = EventTypeWorkflowExecutionStarted
+		return nil
+	case "WORKFLOWEXECUTIONCOMPLETED":
+		*e = EventTypeWorkflowExecutionCompleted
+		return nil
+	case "WORKFLOWEXECUTIONFAILED":
+		*e = EventTypeWorkflowExecutionFailed
+		return nil
+	case "WORKFLOWEXECUTIONTIMEDOUT":
+		*e = EventTypeWorkflowExecutionTimedOut
+		return nil
+	case "DECISIONTASKSCHEDULED":
+		*e = EventTypeDecisionTaskScheduled
+		return nil
+	case "DECISIONTASKSTARTED":
 		*e = EventTypeDecisionTaskStarted
 		return nil
 	case "DECISIONTASKCOMPLETED":
@@ -3236,6 +3792,30 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WORKFLOWEXECUTIONSEARCHATTRIBUTESUPDATED":
+		*e = EventTypeWorkflowExecutionSearchAttributesUpdated
+		return nil
+	case "WORKFLOWEXECUTIONPAUSED":
+		*e = EventTypeWorkflowExecutionPaused
+		return nil
+	case "WORKFLOWEXECUTIONUNPAUSED":
+		*e = EventTypeWorkflowExecutionUnpaused
+		return nil
+	case "ACTIVITYTASKPAUSED":
+		*e = EventTypeActivityTaskPaused
+		return nil
+	case "ACTIVITYTASKUNPAUSED":
+		*e = EventTypeActivityTaskUnpaused
+		return nil
+	case "WORKFLOWEXECUTIONUPDATEACCEPTED":
+		*e = EventTypeWorkflowExecutionUpdateAccepted
+		return nil
+	case "WORKFLOWEXECUTIONUPDATEREJECTED":
+		*e = EventTypeWorkflowExecutionUpdateRejected
+		return nil
+	case "WORKFLOWEXECUTIONUPDATECOMPLETED":
+		*e = EventTypeWorkflowExecutionUpdateCompleted
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -3246,2282 +3826,3173 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	}
 }
 
-// MarshalText encodes EventType to text.
-func (e EventType) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// MarshalText encodes EventType to text.
+func (e EventType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// EventTypeWorkflowExecutionStarted is an option for EventType
+	EventTypeWorkflowExecutionStarted EventType = iota
+	// EventTypeWorkflowExecutionCompleted is an option for EventType
+	EventTypeWorkflowExecutionCompleted
+	// EventTypeWorkflowExecutionFailed is an option for EventType
+	EventTypeWorkflowExecutionFailed
+	// EventTypeWorkflowExecutionTimedOut is an option for EventType
+	EventTypeWorkflowExecutionTimedOut
+	// EventTypeDecisionTaskScheduled is an option for EventType
+	EventTypeDecisionTaskScheduled
+	// EventTypeDecisionTaskStarted is an option for EventType
+	EventTypeDecisionTaskStarted
+	// EventTypeDecisionTaskCompleted is an option for EventType
+	EventTypeDecisionTaskCompleted
+	// EventTypeDecisionTaskTimedOut is an option for EventType
+	EventTypeDecisionTaskTimedOut
+	// EventTypeDecisionTaskFailed is an option for EventType
+	EventTypeDecisionTaskFailed
+	// EventTypeActivityTaskScheduled is an option for EventType
+	EventTypeActivityTaskScheduled
+	// EventTypeActivityTaskStarted is an option for EventType
+	EventTypeActivityTaskStarted
+	// EventTypeActivityTaskCompleted is an option for EventType
+	EventTypeActivityTaskCompleted
+	// EventTypeActivityTaskFailed is an option for EventType
+	EventTypeActivityTaskFailed
+	// EventTypeActivityTaskTimedOut is an option for EventType
+	EventTypeActivityTaskTimedOut
+	// EventTypeActivityTaskCancelRequested is an option for EventType
+	EventTypeActivityTaskCancelRequested
+	// EventTypeRequestCancelActivityTaskFailed is an option for EventType
+	EventTypeRequestCancelActivityTaskFailed
+	// EventTypeActivityTaskCanceled is an option for EventType
+	EventTypeActivityTaskCanceled
+	// EventTypeTimerStarted is an option for EventType
+	EventTypeTimerStarted
+	// EventTypeTimerFired is an option for EventType
+	EventTypeTimerFired
+	// EventTypeCancelTimerFailed is an option for EventType
+	EventTypeCancelTimerFailed
+	// EventTypeTimerCanceled is an option for EventType
+	EventTypeTimerCanceled
+	// EventTypeWorkflowExecutionCancelRequested is an option for EventType
+	EventTypeWorkflowExecutionCancelRequested
+	// EventTypeWorkflowExecutionCanceled is an option for EventType
+	EventTypeWorkflowExecutionCanceled
+	// EventTypeRequestCancelExternalWorkflowExecutionInitiated is an option for EventType
+	EventTypeRequestCancelExternalWorkflowExecutionInitiated
+	// EventTypeRequestCancelExternalWorkflowExecutionFailed is an option for EventType
+	EventTypeRequestCancelExternalWorkflowExecutionFailed
+	// EventTypeExternalWorkflowExecutionCancelRequested is an option for EventType
+	EventTypeExternalWorkflowExecutionCancelRequested
+	// EventTypeMarkerRecorded is an option for EventType
+	EventTypeMarkerRecorded
+	// EventTypeWorkflowExecutionSignaled is an option for EventType
+	EventTypeWorkflowExecutionSignaled
+	// EventTypeWorkflowExecutionTerminated is an option for EventType
+	EventTypeWorkflowExecutionTerminated
+	// EventTypeWorkflowExecutionContinuedAsNew is an option for EventType
+	EventTypeWorkflowExecutionContinuedAsNew
+	// EventTypeStartChildWorkflowExecutionInitiated is an option for EventType
+	EventTypeStartChildWorkflowExecutionInitiated
+	// EventTypeStartChildWorkflowExecutionFailed is an option for EventType
+	EventTypeStartChildWorkflowExecutionFailed
+	// EventTypeChildWorkflowExecutionStarted is an option for EventType
+	EventTypeChildWorkflowExecutionStarted
+	// EventTypeChildWorkflowExecutionCompleted is an option for EventType
+	EventTypeChildWorkflowExecutionCompleted
+	// EventTypeChildWorkflowExecutionFailed is an option for EventType
+	EventTypeChildWorkflowExecutionFailed
+	// EventTypeChildWorkflowExecutionCanceled is an option for EventType
+	EventTypeChildWorkflowExecutionCanceled
+	// EventTypeChildWorkflowExecutionTimedOut is an option for EventType
+	EventTypeChildWorkflowExecutionTimedOut
+	// EventTypeChildWorkflowExecutionTerminated is an option for EventType
+	EventTypeChildWorkflowExecutionTerminated
+	// EventTypeSignalExternalWorkflowExecutionInitiated is an option for EventType
+	EventTypeSignalExternalWorkflowExecutionInitiated
+	// EventTypeSignalExternalWorkflowExecutionFailed is an option for EventType
+	EventTypeSignalExternalWorkflowExecutionFailed
+	// EventTypeExternalWorkflowExecutionSignaled is an option for EventType
+	EventTypeExternalWorkflowExecutionSignaled
+	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
+	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeWorkflowExecutionSearchAttributesUpdated is an option for EventType
+	EventTypeWorkflowExecutionSearchAttributesUpdated
+	// EventTypeWorkflowExecutionPaused is an option for EventType
+	EventTypeWorkflowExecutionPaused
+	// EventTypeWorkflowExecutionUnpaused is an option for EventType
+	EventTypeWorkflowExecutionUnpaused
+	// EventTypeActivityTaskPaused is an option for EventType
+	EventTypeActivityTaskPaused
+	// EventTypeActivityTaskUnpaused is an option for EventType
+	EventTypeActivityTaskUnpaused
+	// EventTypeWorkflowExecutionUpdateAccepted is an option for EventType
+	EventTypeWorkflowExecutionUpdateAccepted
+	// EventTypeWorkflowExecutionUpdateRejected is an option for EventType
+	EventTypeWorkflowExecutionUpdateRejected
+	// EventTypeWorkflowExecutionUpdateCompleted is an option for EventType
+	EventTypeWorkflowExecutionUpdateCompleted
+)
+
+// ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
+type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
+	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// GetInitiatedEventID is an internal getter (TBD...)
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetInitiatedEventID() (o int64) {
+	if v != nil {
+		return v.InitiatedEventID
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// ExternalWorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
+type ExternalWorkflowExecutionSignaledEventAttributes struct {
+	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Control           []byte             `json:"control,omitempty"`
+}
+
+// GetInitiatedEventID is an internal getter (TBD...)
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetInitiatedEventID() (o int64) {
+	if v != nil {
+		return v.InitiatedEventID
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// FailWorkflowExecutionDecisionAttributes is an internal type (TBD...)
+type FailWorkflowExecutionDecisionAttributes struct {
+	Reason  *string `json:"reason,omitempty"`
+	Details []byte  `json:"details,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *FailWorkflowExecutionDecisionAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+	return
+}
+
+// GetSearchAttributesResponse is an internal type (TBD...)
+type GetSearchAttributesResponse struct {
+	Keys map[string]IndexedValueType `json:"keys,omitempty"`
+}
+
+// GetKeys is an internal getter (TBD...)
+func (v *GetSearchAttributesResponse) GetKeys() (o map[string]IndexedValueType) {
+	if v != nil && v.Keys != nil {
+		return v.Keys
+	}
+	return
+}
+
+// GetWorkflowExecutionHistoryRequest is an internal type (TBD...)
+type GetWorkflowExecutionHistoryRequest struct {
+	Domain                 string                  `json:"domain,omitempty"`
+	Execution              *WorkflowExecution      `json:"execution,omitempty"`
+	MaximumPageSize        int32                   `json:"maximumPageSize,omitempty"`
+	NextPageToken          []byte                  `json:"nextPageToken,omitempty"`
+	WaitForNewEvent        bool                    `json:"waitForNewEvent,omitempty"`
+	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
+	SkipArchival           bool                    `json:"skipArchival,omitempty"`
+	QueryConsistencyLevel  *QueryConsistencyLevel  `json:"queryConsistencyLevel,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+// GetMaximumPageSize is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetMaximumPageSize() (o int32) {
+	if v != nil {
+		return v.MaximumPageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// GetWaitForNewEvent is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetWaitForNewEvent() (o bool) {
+	if v != nil {
+		return v.WaitForNewEvent
+	}
+	return
+}
+
+// GetHistoryEventFilterType is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetHistoryEventFilterType() (o HistoryEventFilterType) {
+	if v != nil && v.HistoryEventFilterType != nil {
+		return *v.HistoryEventFilterType
+	}
+	return
+}
+
+// GetSkipArchival is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetSkipArchival() (o bool) {
+	if v != nil {
+		return v.SkipArchival
+	}
+	return
+}
+
+// GetQueryConsistencyLevel is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetQueryConsistencyLevel() (o QueryConsistencyLevel) {
+	if v != nil && v.QueryConsistencyLevel != nil {
+		return *v.QueryConsistencyLevel
+	}
+	return
+}
+
+// GetWorkflowExecutionHistoryResponse is an internal type (TBD...)
+type GetWorkflowExecutionHistoryResponse struct {
+	History       *History    `json:"history,omitempty"`
+	RawHistory    []*DataBlob `json:"rawHistory,omitempty"`
+	NextPageToken []byte      `json:"nextPageToken,omitempty"`
+	Archived      bool        `json:"archived,omitempty"`
+}
+
+// GetHistory is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryResponse) GetHistory() (o *History) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+	return
+}
+
+// GetArchived is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryResponse) GetArchived() (o bool) {
+	if v != nil {
+		return v.Archived
+	}
+	return
+}
+
+// FailoverInfo is an internal type (TBD...)
+type FailoverInfo struct {
+	FailoverVersion         int64   `json:"failoverVersion,omitempty"`
+	FailoverStartTimestamp  int64   `json:"failoverStartTimestamp,omitempty"`
+	FailoverExpireTimestamp int64   `json:"failoverExpireTimestamp,omitempty"`
+	CompletedShardCount     int32   `json:"completedShardCount,omitempty"`
+	PendingShards           []int32 `json:"pendingShards,omitempty"`
+}
+
+// GetFailoverVersion is an internal getter (TBD...)
+func (v *FailoverInfo) GetFailoverVersion() (o int64) {
+	if v != nil {
+		return v.FailoverVersion
+	}
+	return
+}
+
+// GetFailoverStartTimestamp is an internal getter (TBD...)
+func (v *FailoverInfo) GetFailoverStartTimestamp() (o int64) {
+	if v != nil {
+		return v.FailoverStartTimestamp
+	}
+	return
+}
+
+// GetFailoverExpireTimestamp is an internal getter (TBD...)
+func (v *FailoverInfo) GetFailoverExpireTimestamp() (o int64) {
+	if v != nil {
+		return v.FailoverExpireTimestamp
+	}
+	return
+}
+
+// GetCompletedShardCount is an internal getter (TBD...)
+func (v *FailoverInfo) GetCompletedShardCount() (o int32) {
+	if v != nil {
+		return v.CompletedShardCount
+	}
+	return
+}
+
+// GetPendingShards is an internal getter (TBD...)
+func (v *FailoverInfo) GetPendingShards() (o []int32) {
+	if v != nil {
+		return v.PendingShards
+	}
+	return
+}
+
+// Header is an internal type (TBD...)
+type Header struct {
+	Fields map[string][]byte `json:"fields,omitempty"`
+}
+
+// History is an internal type (TBD...)
+type History struct {
+	Events []*HistoryEvent `json:"events,omitempty"`
+}
+
+// GetEvents is an internal getter (TBD...)
+func (v *History) GetEvents() (o []*HistoryEvent) {
+	if v != nil && v.Events != nil {
+		return v.Events
+	}
+	return
+}
+
+// HistoryBranch is an internal type (TBD...)
+type HistoryBranch struct {
+	TreeID    string
+	BranchID  string
+	Ancestors []*HistoryBranchRange
+}
+
+// HistoryBranchRange is an internal type (TBD...)
+type HistoryBranchRange struct {
+	BranchID    string
+	BeginNodeID int64
+	EndNodeID   int64
+}
+
+// HistoryEvent is an internal type (TBD...)
+type HistoryEvent struct {
+	ID                                                             int64                                                           `json:"eventId,omitempty"`
+	Timestamp                                                      *int64                                                          `json:"timestamp,omitempty"`
+	EventType                                                      *EventType                                                      `json:"eventType,omitempty"`
+	Version                                                        int64                                                           `json:"version,omitempty"`
+	TaskID                                                         int64                                                           `json:"taskId,omitempty"`
+	WorkflowExecutionStartedEventAttributes                        *WorkflowExecutionStartedEventAttributes                        `json:"workflowExecutionStartedEventAttributes,omitempty"`
+	WorkflowExecutionCompletedEventAttributes                      *WorkflowExecutionCompletedEventAttributes                      `json:"workflowExecutionCompletedEventAttributes,omitempty"`
+	WorkflowExecutionFailedEventAttributes                         *WorkflowExecutionFailedEventAttributes                         `json:"workflowExecutionFailedEventAttributes,omitempty"`
+	WorkflowExecutionTimedOutEventAttributes                       *WorkflowExecutionTimedOutEventAttributes                       `json:"workflowExecutionTimedOutEventAttributes,omitempty"`
+	DecisionTaskScheduledEventAttributes                           *DecisionTaskScheduledEventAttributes                           `json:"decisionTaskScheduledEventAttributes,omitempty"`
+	DecisionTaskStartedEventAttributes                             *DecisionTaskStartedEventAttributes                             `json:"decisionTaskStartedEventAttributes,omitempty"`
+	DecisionTaskCompletedEventAttributes                           *DecisionTaskCompletedEventAttributes                           `json:"decisionTaskCompletedEventAttributes,omitempty"`
+	DecisionTaskTimedOutEventAttributes                            *DecisionTaskTimedOutEventAttributes                            `json:"decisionTaskTimedOutEventAttributes,omitempty"`
+	DecisionTaskFailedEventAttributes                              *DecisionTaskFailedEventAttributes                              `json:"decisionTaskFailedEventAttributes,omitempty"`
+	ActivityTaskScheduledEventAttributes                           *ActivityTaskScheduledEventAttributes                           `json:"activityTaskScheduledEventAttributes,omitempty"`
+	ActivityTaskStartedEventAttributes                             *ActivityTaskStartedEventAttributes                             `json:"activityTaskStartedEventAttributes,omitempty"`
+	ActivityTaskCompletedEventAttributes                           *ActivityTaskCompletedEventAttributes                           `json:"activityTaskCompletedEventAttributes,omitempty"`
+	ActivityTaskFailedEventAttributes                              *ActivityTaskFailedEventAttributes                              `json:"activityTaskFailedEventAttributes,omitempty"`
+	ActivityTaskTimedOutEventAttributes                            *ActivityTaskTimedOutEventAttributes                            `json:"activityTaskTimedOutEventAttributes,omitempty"`
+	TimerStartedEventAttributes                                    *TimerStartedEventAttributes                                    `json:"timerStartedEventAttributes,omitempty"`
+	TimerFiredEventAttributes                                      *TimerFiredEventAttributes                                      `json:"timerFiredEventAttributes,omitempty"`
+	ActivityTaskCancelRequestedEventAttributes                     *ActivityTaskCancelRequestedEventAttributes                     `json:"activityTaskCancelRequestedEventAttributes,omitempty"`
+	RequestCancelActivityTaskFailedEventAttributes                 *RequestCancelActivityTaskFailedEventAttributes                 `json:"requestCancelActivityTaskFailedEventAttributes,omitempty"`
+	ActivityTaskCanceledEventAttributes                            *ActivityTaskCanceledEventAttributes                            `json:"activityTaskCanceledEventAttributes,omitempty"`
+	TimerCanceledEventAttributes                                   *TimerCanceledEventAttributes                                   `json:"timerCanceledEventAttributes,omitempty"`
+	CancelTimerFailedEventAttributes                               *CancelTimerFailedEventAttributes                               `json:"cancelTimerFailedEventAttributes,omitempty"`
+	MarkerRecordedEventAttributes                                  *MarkerRecordedEventAttributes                                  `json:"markerRecordedEventAttributes,omitempty"`
+	WorkflowExecutionSignaledEventAttributes                       *WorkflowExecutionSignaledEventAttributes                       `json:"workflowExecutionSignaledEventAttributes,omitempty"`
+	WorkflowExecutionTerminatedEventAttributes                     *WorkflowExecutionTerminatedEventAttributes                     `json:"workflowExecutionTerminatedEventAttributes,omitempty"`
+	WorkflowExecutionCancelRequestedEventAttributes                *WorkflowExecutionCancelRequestedEventAttributes                `json:"workflowExecutionCancelRequestedEventAttributes,omitempty"`
+	WorkflowExecutionCanceledEventAttributes                       *WorkflowExecutionCanceledEventAttributes                       `json:"workflowExecutionCanceledEventAttributes,omitempty"`
+	RequestCancelExternalWorkflowExecutionInitiatedEventAttributes *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
+	RequestCancelExternalWorkflowExecutionFailedEventAttributes    *RequestCancelExternalWorkflowExecutionFailedEventAttributes    `json:"requestCancelExternalWorkflowExecutionFailedEventAttributes,omitempty"`
+	ExternalWorkflowExecutionCancelRequestedEventAttributes        *ExternalWorkflowExecutionCancelRequestedEventAttributes        `json:"externalWorkflowExecutionCancelRequestedEventAttributes,omitempty"`
+	WorkflowExecutionContinuedAsNewEventAttributes                 *WorkflowExecutionContinuedAsNewEventAttributes                 `json:"workflowExecutionContinuedAsNewEventAttributes,omitempty"`
+	StartChildWorkflowExecutionInitiatedEventAttributes            *StartChildWorkflowExecutionInitiatedEventAttributes            `json:"startChildWorkflowExecutionInitiatedEventAttributes,omitempty"`
+	StartChildWorkflowExecutionFailedEventAttributes               *StartChildWorkflowExecutionFailedEventAttributes               `json:"startChildWorkflowExecutionFailedEventAttributes,omitempty"`
+	ChildWorkflowExecutionStartedEventAttributes                   *ChildWorkflowExecutionStartedEventAttributes                   `json:"childWorkflowExecutionStartedEventAttributes,omitempty"`
+	ChildWorkflowExecutionCompletedEventAttributes                 *ChildWorkflowExecutionCompletedEventAttributes                 `json:"childWorkflowExecutionCompletedEventAttributes,omitempty"`
+	ChildWorkflowExecutionFailedEventAttributes                    *ChildWorkflowExecutionFailedEventAttributes                    `json:"childWorkflowExecutionFailedEventAttributes,omitempty"`
+	ChildWorkflowExecutionCanceledEventAttributes                  *ChildWorkflowExecutionCanceledEventAttributes                  `json:"childWorkflowExecutionCanceledEventAttributes,omitempty"`
+	ChildWorkflowExecutionTimedOutEventAttributes                  *ChildWorkflowExecutionTimedOutEventAttributes                  `json:"childWorkflowExecutionTimedOutEventAttributes,omitempty"`
+	ChildWorkflowExecutionTerminatedEventAttributes                *ChildWorkflowExecutionTerminatedEventAttributes                `json:"childWorkflowExecutionTerminatedEventAttributes,omitempty"`
+	SignalExternalWorkflowExecutionInitiatedEventAttributes        *SignalExternalWorkflowExecutionInitiatedEventAttributes        `json:"signalExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
+	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
+	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
+	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionSearchAttributesUpdatedEventAttributes        *WorkflowExecutionSearchAttributesUpdatedEventAttributes        `json:"workflowExecutionSearchAttributesUpdatedEventAttributes,omitempty"`
+	WorkflowExecutionPausedEventAttributes                         *WorkflowExecutionPausedEventAttributes                         `json:"workflowExecutionPausedEventAttributes,omitempty"`
+	WorkflowExecutionUnpausedEventAttributes                       *WorkflowExecutionUnpausedEventAttributes                       `json:"workflowExecutionUnpausedEventAttributes,omitempty"`
+	ActivityTaskPausedEventAttributes                              *ActivityTaskPausedEventAttributes                              `json:"activityTaskPausedEventAttributes,omitempty"`
+	ActivityTaskUnpausedEventAttributes                            *ActivityTaskUnpausedEventAttributes                            `json:"activityTaskUnpausedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateAcceptedEventAttributes                 *WorkflowExecutionUpdateAcceptedEventAttributes                 `json:"workflowExecutionUpdateAcceptedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateRejectedEventAttributes                 *WorkflowExecutionUpdateRejectedEventAttributes                 `json:"workflowExecutionUpdateRejectedEventAttributes,omitempty"`
+	WorkflowExecutionUpdateCompletedEventAttributes                *WorkflowExecutionUpdateCompletedEventAttributes                `json:"workflowExecutionUpdateCompletedEventAttributes,omitempty"`
+}
+
+// GetTimestamp is an internal getter (TBD...)
+func (v *HistoryEvent) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+	return
+}
+
+// GetEventType is an internal getter (TBD...)
+func (v *HistoryEvent) GetEventType() (o EventType) {
+	if v != nil && v.EventType != nil {
+		return *v.EventType
+	}
+	return
+}
+
+// GetWorkflowExecutionStartedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionStartedEventAttributes() (o *WorkflowExecutionStartedEventAttributes) {
+	if v != nil && v.WorkflowExecutionStartedEventAttributes != nil {
+		return v.WorkflowExecutionStartedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionCompletedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionCompletedEventAttributes() (o *WorkflowExecutionCompletedEventAttributes) {
+	if v != nil && v.WorkflowExecutionCompletedEventAttributes != nil {
+		return v.WorkflowExecutionCompletedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionFailedEventAttributes() (o *WorkflowExecutionFailedEventAttributes) {
+	if v != nil && v.WorkflowExecutionFailedEventAttributes != nil {
+		return v.WorkflowExecutionFailedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionTimedOutEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionTimedOutEventAttributes() (o *WorkflowExecutionTimedOutEventAttributes) {
+	if v != nil && v.WorkflowExecutionTimedOutEventAttributes != nil {
+		return v.WorkflowExecutionTimedOutEventAttributes
+	}
+	return
+}
+
+// GetDecisionTaskScheduledEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetDecisionTaskScheduledEventAttributes() (o *DecisionTaskScheduledEventAttributes) {
+	if v != nil && v.DecisionTaskScheduledEventAttributes != nil {
+		return v.DecisionTaskScheduledEventAttributes
+	}
+	return
+}
+
+// GetDecisionTaskStartedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetDecisionTaskStartedEventAttributes() (o *DecisionTaskStartedEventAttributes) {
+	if v != nil && v.DecisionTaskStartedEventAttributes != nil {
+		return v.DecisionTaskStartedEventAttributes
+	}
+	return
+}
+
+// GetDecisionTaskCompletedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetDecisionTaskCompletedEventAttributes() (o *DecisionTaskCompletedEventAttributes) {
+	if v != nil && v.DecisionTaskCompletedEventAttributes != nil {
+		return v.DecisionTaskCompletedEventAttributes
+	}
+	return
+}
+
+// GetDecisionTaskTimedOutEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetDecisionTaskTimedOutEventAttributes() (o *DecisionTaskTimedOutEventAttributes) {
+	if v != nil && v.DecisionTaskTimedOutEventAttributes != nil {
+		return v.DecisionTaskTimedOutEventAttributes
+	}
+	return
+}
+
+// GetDecisionTaskFailedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetDecisionTaskFailedEventAttributes() (o *DecisionTaskFailedEventAttributes) {
+	if v != nil && v.DecisionTaskFailedEventAttributes != nil {
+		return v.DecisionTaskFailedEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskScheduledEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskScheduledEventAttributes() (o *ActivityTaskScheduledEventAttributes) {
+	if v != nil && v.ActivityTaskScheduledEventAttributes != nil {
+		return v.ActivityTaskScheduledEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskStartedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskStartedEventAttributes() (o *ActivityTaskStartedEventAttributes) {
+	if v != nil && v.ActivityTaskStartedEventAttributes != nil {
+		return v.ActivityTaskStartedEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskCompletedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskCompletedEventAttributes() (o *ActivityTaskCompletedEventAttributes) {
+	if v != nil && v.ActivityTaskCompletedEventAttributes != nil {
+		return v.ActivityTaskCompletedEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskFailedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskFailedEventAttributes() (o *ActivityTaskFailedEventAttributes) {
+	if v != nil && v.ActivityTaskFailedEventAttributes != nil {
+		return v.ActivityTaskFailedEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskTimedOutEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskTimedOutEventAttributes() (o *ActivityTaskTimedOutEventAttributes) {
+	if v != nil && v.ActivityTaskTimedOutEventAttributes != nil {
+		return v.ActivityTaskTimedOutEventAttributes
+	}
+	return
+}
+
+// GetTimerStartedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetTimerStartedEventAttributes() (o *TimerStartedEventAttributes) {
+	if v != nil && v.TimerStartedEventAttributes != nil {
+		return v.TimerStartedEventAttributes
+	}
+	return
+}
+
+// GetTimerFiredEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetTimerFiredEventAttributes() (o *TimerFiredEventAttributes) {
+	if v != nil && v.TimerFiredEventAttributes != nil {
+		return v.TimerFiredEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskCancelRequestedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskCancelRequestedEventAttributes() (o *ActivityTaskCancelRequestedEventAttributes) {
+	if v != nil && v.ActivityTaskCancelRequestedEventAttributes != nil {
+		return v.ActivityTaskCancelRequestedEventAttributes
+	}
+	return
+}
+
+// GetRequestCancelActivityTaskFailedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetRequestCancelActivityTaskFailedEventAttributes() (o *RequestCancelActivityTaskFailedEventAttributes) {
+	if v != nil && v.RequestCancelActivityTaskFailedEventAttributes != nil {
+		return v.RequestCancelActivityTaskFailedEventAttributes
+	}
+	return
 }
 
-const (
-	// EventTypeWorkflowExecutionStarted is an option for EventType
-	EventTypeWorkflowExecutionStarted EventType = iota
-	// EventTypeWorkflowExecutionCompleted is an option for EventType
-	EventTypeWorkflowExecutionCompleted
-	// EventTypeWorkflowExecutionFailed is an option for EventType
-	EventTypeWorkflowExecutionFailed
-	// EventTypeWorkflowExecutionTimedOut is an option for EventType
-	EventTypeWorkflowExecutionTimedOut
-	// EventTypeDecisionTaskScheduled is an option for EventType
-	EventTypeDecisionTaskScheduled
-	// EventTypeDecisionTaskStarted is an option for EventType
-	EventTypeDecisionTaskStarted
-	// EventTypeDecisionTaskCompleted is an option for EventType
-	EventTypeDecisionTaskCompleted
-	// EventTypeDecisionTaskTimedOut is an option for EventType
-	EventTypeDecisionTaskTimedOut
-	// EventTypeDecisionTaskFailed is an option for EventType
-	EventTypeDecisionTaskFailed
-	// EventTypeActivityTaskScheduled is an option for EventType
-	EventTypeActivityTaskScheduled
-	// EventTypeActivityTaskStarted is an option for EventType
-	EventTypeActivityTaskStarted
-	// EventTypeActivityTaskCompleted is an option for EventType
-	EventTypeActivityTaskCompleted
-	// EventTypeActivityTaskFailed is an option for EventType
-	EventTypeActivityTaskFailed
-	// EventTypeActivityTaskTimedOut is an option for EventType
-	EventTypeActivityTaskTimedOut
-	// EventTypeActivityTaskCancelRequested is an option for EventType
-	EventTypeActivityTaskCancelRequested
-	// EventTypeRequestCancelActivityTaskFailed is an option for EventType
-	EventTypeRequestCancelActivityTaskFailed
-	// EventTypeActivityTaskCanceled is an option for EventType
-	EventTypeActivityTaskCanceled
-	// EventTypeTimerStarted is an option for EventType
-	EventTypeTimerStarted
-	// EventTypeTimerFired is an option for EventType
-	EventTypeTimerFired
-	// EventTypeCancelTimerFailed is an option for EventType
-	EventTypeCancelTimerFailed
-	// EventTypeTimerCanceled is an option for EventType
-	EventTypeTimerCanceled
-	// EventTypeWorkflowExecutionCancelRequested is an option for EventType
-	EventTypeWorkflowExecutionCancelRequested
-	// EventTypeWorkflowExecutionCanceled is an option for EventType
-	EventTypeWorkflowExecutionCanceled
-	// EventTypeRequestCancelExternalWorkflowExecutionInitiated is an option for EventType
-	EventTypeRequestCancelExternalWorkflowExecutionInitiated
-	// EventTypeRequestCancelExternalWorkflowExecutionFailed is an option for EventType
-	EventTypeRequestCancelExternalWorkflowExecutionFailed
-	// EventTypeExternalWorkflowExecutionCancelRequested is an option for EventType
-	EventTypeExternalWorkflowExecutionCancelRequested
-	// EventTypeMarkerRecorded is an option for EventType
-	EventTypeMarkerRecorded
-	// EventTypeWorkflowExecutionSignaled is an option for EventType
-	EventTypeWorkflowExecutionSignaled
-	// EventTypeWorkflowExecutionTerminated is an option for EventType
-	EventTypeWorkflowExecutionTerminated
-	// EventTypeWorkflowExecutionContinuedAsNew is an option for EventType
-	EventTypeWorkflowExecutionContinuedAsNew
-	// EventTypeStartChildWorkflowExecutionInitiated is an option for EventType
-	EventTypeStartChildWorkflowExecutionInitiated
-	// EventTypeStartChildWorkflowExecutionFailed is an option for EventType
-	EventTypeStartChildWorkflowExecutionFailed
-	// EventTypeChildWorkflowExecutionStarted is an option for EventType
-	EventTypeChildWorkflowExecutionStarted
-	// EventTypeChildWorkflowExecutionCompleted is an option for EventType
-	EventTypeChildWorkflowExecutionCompleted
-	// EventTypeChildWorkflowExecutionFailed is an option for EventType
-	EventTypeChildWorkflowExecutionFailed
-	// EventTypeChildWorkflowExecutionCanceled is an option for EventType
-	EventTypeChildWorkflowExecutionCanceled
-	// EventTypeChildWorkflowExecutionTimedOut is an option for EventType
-	EventTypeChildWorkflowExecutionTimedOut
-	// EventTypeChildWorkflowExecutionTerminated is an option for EventType
-	EventTypeChildWorkflowExecutionTerminated
-	// EventTypeSignalExternalWorkflowExecutionInitiated is an option for EventType
-	EventTypeSignalExternalWorkflowExecutionInitiated
-	// EventTypeSignalExternalWorkflowExecutionFailed is an option for EventType
-	EventTypeSignalExternalWorkflowExecutionFailed
-	// EventTypeExternalWorkflowExecutionSignaled is an option for EventType
-	EventTypeExternalWorkflowExecutionSignaled
-	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
-	EventTypeUpsertWorkflowSearchAttributes
-)
+// GetActivityTaskCanceledEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskCanceledEventAttributes() (o *ActivityTaskCanceledEventAttributes) {
+	if v != nil && v.ActivityTaskCanceledEventAttributes != nil {
+		return v.ActivityTaskCanceledEventAttributes
+	}
+	return
+}
 
-// ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
-type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
-	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
-	Domain            string             `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+// GetTimerCanceledEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetTimerCanceledEventAttributes() (o *TimerCanceledEventAttributes) {
+	if v != nil && v.TimerCanceledEventAttributes != nil {
+		return v.TimerCanceledEventAttributes
+	}
+	return
 }
 
-// GetInitiatedEventID is an internal getter (TBD...)
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetInitiatedEventID() (o int64) {
-	if v != nil {
-		return v.InitiatedEventID
+// GetCancelTimerFailedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetCancelTimerFailedEventAttributes() (o *CancelTimerFailedEventAttributes) {
+	if v != nil && v.CancelTimerFailedEventAttributes != nil {
+		return v.CancelTimerFailedEventAttributes
 	}
 	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// GetMarkerRecordedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetMarkerRecordedEventAttributes() (o *MarkerRecordedEventAttributes) {
+	if v != nil && v.MarkerRecordedEventAttributes != nil {
+		return v.MarkerRecordedEventAttributes
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) ByteSize() uint64 {
-	return 0
+// GetWorkflowExecutionSignaledEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionSignaledEventAttributes() (o *WorkflowExecutionSignaledEventAttributes) {
+	if v != nil && v.WorkflowExecutionSignaledEventAttributes != nil {
+		return v.WorkflowExecutionSignaledEventAttributes
+	}
+	return
 }
 
-// ExternalWorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
-type ExternalWorkflowExecutionSignaledEventAttributes struct {
-	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
-	Domain            string             `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Control           []byte             `json:"control,omitempty"`
+// GetWorkflowExecutionTerminatedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionTerminatedEventAttributes() (o *WorkflowExecutionTerminatedEventAttributes) {
+	if v != nil && v.WorkflowExecutionTerminatedEventAttributes != nil {
+		return v.WorkflowExecutionTerminatedEventAttributes
+	}
+	return
 }
 
-// GetInitiatedEventID is an internal getter (TBD...)
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetInitiatedEventID() (o int64) {
-	if v != nil {
-		return v.InitiatedEventID
+// GetWorkflowExecutionCancelRequestedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionCancelRequestedEventAttributes() (o *WorkflowExecutionCancelRequestedEventAttributes) {
+	if v != nil && v.WorkflowExecutionCancelRequestedEventAttributes != nil {
+		return v.WorkflowExecutionCancelRequestedEventAttributes
 	}
 	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// GetWorkflowExecutionCanceledEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionCanceledEventAttributes() (o *WorkflowExecutionCanceledEventAttributes) {
+	if v != nil && v.WorkflowExecutionCanceledEventAttributes != nil {
+		return v.WorkflowExecutionCanceledEventAttributes
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) ByteSize() uint64 {
-	return 0
+// GetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() (o *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) {
+	if v != nil && v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		return v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+	}
+	return
 }
 
-// FailWorkflowExecutionDecisionAttributes is an internal type (TBD...)
-type FailWorkflowExecutionDecisionAttributes struct {
-	Reason  *string `json:"reason,omitempty"`
-	Details []byte  `json:"details,omitempty"`
+// GetRequestCancelExternalWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetRequestCancelExternalWorkflowExecutionFailedEventAttributes() (o *RequestCancelExternalWorkflowExecutionFailedEventAttributes) {
+	if v != nil && v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
+		return v.RequestCancelExternalWorkflowExecutionFailedEventAttributes
+	}
+	return
 }
 
-// GetReason is an internal getter (TBD...)
-func (v *FailWorkflowExecutionDecisionAttributes) GetReason() (o string) {
-	if v != nil && v.Reason != nil {
-		return *v.Reason
+// GetExternalWorkflowExecutionCancelRequestedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetExternalWorkflowExecutionCancelRequestedEventAttributes() (o *ExternalWorkflowExecutionCancelRequestedEventAttributes) {
+	if v != nil && v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
+		return v.ExternalWorkflowExecutionCancelRequestedEventAttributes
 	}
 	return
 }
 
-// GetSearchAttributesResponse is an internal type (TBD...)
-type GetSearchAttributesResponse struct {
-	Keys map[string]IndexedValueType `json:"keys,omitempty"`
+// GetWorkflowExecutionContinuedAsNewEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionContinuedAsNewEventAttributes() (o *WorkflowExecutionContinuedAsNewEventAttributes) {
+	if v != nil && v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
+		return v.WorkflowExecutionContinuedAsNewEventAttributes
+	}
+	return
 }
 
-// GetKeys is an internal getter (TBD...)
-func (v *GetSearchAttributesResponse) GetKeys() (o map[string]IndexedValueType) {
-	if v != nil && v.Keys != nil {
-		return v.Keys
+// GetStartChildWorkflowExecutionInitiatedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetStartChildWorkflowExecutionInitiatedEventAttributes() (o *StartChildWorkflowExecutionInitiatedEventAttributes) {
+	if v != nil && v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
+		return v.StartChildWorkflowExecutionInitiatedEventAttributes
 	}
 	return
 }
 
-// GetWorkflowExecutionHistoryRequest is an internal type (TBD...)
-type GetWorkflowExecutionHistoryRequest struct {
-	Domain                 string                  `json:"domain,omitempty"`
-	Execution              *WorkflowExecution      `json:"execution,omitempty"`
-	MaximumPageSize        int32                   `json:"maximumPageSize,omitempty"`
-	NextPageToken          []byte                  `json:"nextPageToken,omitempty"`
-	WaitForNewEvent        bool                    `json:"waitForNewEvent,omitempty"`
-	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
-	SkipArchival           bool                    `json:"skipArchival,omitempty"`
-	QueryConsistencyLevel  *QueryConsistencyLevel  `json:"queryConsistencyLevel,omitempty"`
+// GetStartChildWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetStartChildWorkflowExecutionFailedEventAttributes() (o *StartChildWorkflowExecutionFailedEventAttributes) {
+	if v != nil && v.StartChildWorkflowExecutionFailedEventAttributes != nil {
+		return v.StartChildWorkflowExecutionFailedEventAttributes
+	}
+	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryRequest) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// GetChildWorkflowExecutionStartedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetChildWorkflowExecutionStartedEventAttributes() (o *ChildWorkflowExecutionStartedEventAttributes) {
+	if v != nil && v.ChildWorkflowExecutionStartedEventAttributes != nil {
+		return v.ChildWorkflowExecutionStartedEventAttributes
 	}
 	return
 }
 
-// GetExecution is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+// GetChildWorkflowExecutionCompletedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetChildWorkflowExecutionCompletedEventAttributes() (o *ChildWorkflowExecutionCompletedEventAttributes) {
+	if v != nil && v.ChildWorkflowExecutionCompletedEventAttributes != nil {
+		return v.ChildWorkflowExecutionCompletedEventAttributes
+	}
+	return
+}
+
+// GetChildWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetChildWorkflowExecutionFailedEventAttributes() (o *ChildWorkflowExecutionFailedEventAttributes) {
+	if v != nil && v.ChildWorkflowExecutionFailedEventAttributes != nil {
+		return v.ChildWorkflowExecutionFailedEventAttributes
 	}
 	return
 }
 
-// GetMaximumPageSize is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryRequest) GetMaximumPageSize() (o int32) {
-	if v != nil {
-		return v.MaximumPageSize
+// GetChildWorkflowExecutionCanceledEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetChildWorkflowExecutionCanceledEventAttributes() (o *ChildWorkflowExecutionCanceledEventAttributes) {
+	if v != nil && v.ChildWorkflowExecutionCanceledEventAttributes != nil {
+		return v.ChildWorkflowExecutionCanceledEventAttributes
 	}
 	return
 }
 
-// GetNextPageToken is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryRequest) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+// GetChildWorkflowExecutionTimedOutEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetChildWorkflowExecutionTimedOutEventAttributes() (o *ChildWorkflowExecutionTimedOutEventAttributes) {
+	if v != nil && v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
+		return v.ChildWorkflowExecutionTimedOutEventAttributes
 	}
 	return
 }
 
-// GetWaitForNewEvent is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryRequest) GetWaitForNewEvent() (o bool) {
-	if v != nil {
-		return v.WaitForNewEvent
+// GetChildWorkflowExecutionTerminatedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetChildWorkflowExecutionTerminatedEventAttributes() (o *ChildWorkflowExecutionTerminatedEventAttributes) {
+	if v != nil && v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
+		return v.ChildWorkflowExecutionTerminatedEventAttributes
 	}
 	return
 }
 
-// GetHistoryEventFilterType is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryRequest) GetHistoryEventFilterType() (o HistoryEventFilterType) {
-	if v != nil && v.HistoryEventFilterType != nil {
-		return *v.HistoryEventFilterType
+// GetSignalExternalWorkflowExecutionInitiatedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetSignalExternalWorkflowExecutionInitiatedEventAttributes() (o *SignalExternalWorkflowExecutionInitiatedEventAttributes) {
+	if v != nil && v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		return v.SignalExternalWorkflowExecutionInitiatedEventAttributes
 	}
 	return
 }
 
-// GetSkipArchival is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryRequest) GetSkipArchival() (o bool) {
-	if v != nil {
-		return v.SkipArchival
+// GetSignalExternalWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetSignalExternalWorkflowExecutionFailedEventAttributes() (o *SignalExternalWorkflowExecutionFailedEventAttributes) {
+	if v != nil && v.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
+		return v.SignalExternalWorkflowExecutionFailedEventAttributes
 	}
 	return
 }
 
-// GetQueryConsistencyLevel is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryRequest) GetQueryConsistencyLevel() (o QueryConsistencyLevel) {
-	if v != nil && v.QueryConsistencyLevel != nil {
-		return *v.QueryConsistencyLevel
+// GetExternalWorkflowExecutionSignaledEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetExternalWorkflowExecutionSignaledEventAttributes() (o *ExternalWorkflowExecutionSignaledEventAttributes) {
+	if v != nil && v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
+		return v.ExternalWorkflowExecutionSignaledEventAttributes
 	}
 	return
 }
 
-// GetWorkflowExecutionHistoryResponse is an internal type (TBD...)
-type GetWorkflowExecutionHistoryResponse struct {
-	History       *History    `json:"history,omitempty"`
-	RawHistory    []*DataBlob `json:"rawHistory,omitempty"`
-	NextPageToken []byte      `json:"nextPageToken,omitempty"`
-	Archived      bool        `json:"archived,omitempty"`
+// GetUpsertWorkflowSearchAttributesEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *UpsertWorkflowSearchAttributesEventAttributes) {
+	if v != nil && v.UpsertWorkflowSearchAttributesEventAttributes != nil {
+		return v.UpsertWorkflowSearchAttributesEventAttributes
+	}
+	return
 }
 
-// GetHistory is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryResponse) GetHistory() (o *History) {
-	if v != nil && v.History != nil {
-		return v.History
+// GetWorkflowExecutionSearchAttributesUpdatedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionSearchAttributesUpdatedEventAttributes() (o *WorkflowExecutionSearchAttributesUpdatedEventAttributes) {
+	if v != nil && v.WorkflowExecutionSearchAttributesUpdatedEventAttributes != nil {
+		return v.WorkflowExecutionSearchAttributesUpdatedEventAttributes
 	}
 	return
 }
 
-// GetArchived is an internal getter (TBD...)
-func (v *GetWorkflowExecutionHistoryResponse) GetArchived() (o bool) {
-	if v != nil {
-		return v.Archived
+// GetWorkflowExecutionPausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() (o *WorkflowExecutionPausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionPausedEventAttributes != nil {
+		return v.WorkflowExecutionPausedEventAttributes
 	}
 	return
 }
 
-// FailoverInfo is an internal type (TBD...)
-type FailoverInfo struct {
-	FailoverVersion         int64   `json:"failoverVersion,omitempty"`
-	FailoverStartTimestamp  int64   `json:"failoverStartTimestamp,omitempty"`
-	FailoverExpireTimestamp int64   `json:"failoverExpireTimestamp,omitempty"`
-	CompletedShardCount     int32   `json:"completedShardCount,omitempty"`
-	PendingShards           []int32 `json:"pendingShards,omitempty"`
+// GetWorkflowExecutionUnpausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() (o *WorkflowExecutionUnpausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUnpausedEventAttributes != nil {
+		return v.WorkflowExecutionUnpausedEventAttributes
+	}
+	return
 }
 
-// GetFailoverVersion is an internal getter (TBD...)
-func (v *FailoverInfo) GetFailoverVersion() (o int64) {
-	if v != nil {
-		return v.FailoverVersion
+// GetActivityTaskPausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskPausedEventAttributes() (o *ActivityTaskPausedEventAttributes) {
+	if v != nil && v.ActivityTaskPausedEventAttributes != nil {
+		return v.ActivityTaskPausedEventAttributes
 	}
 	return
 }
 
-// GetFailoverStartTimestamp is an internal getter (TBD...)
-func (v *FailoverInfo) GetFailoverStartTimestamp() (o int64) {
-	if v != nil {
-		return v.FailoverStartTimestamp
+// GetActivityTaskUnpausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskUnpausedEventAttributes() (o *ActivityTaskUnpausedEventAttributes) {
+	if v != nil && v.ActivityTaskUnpausedEventAttributes != nil {
+		return v.ActivityTaskUnpausedEventAttributes
 	}
 	return
 }
 
-// GetFailoverExpireTimestamp is an internal getter (TBD...)
-func (v *FailoverInfo) GetFailoverExpireTimestamp() (o int64) {
-	if v != nil {
-		return v.FailoverExpireTimestamp
+// GetWorkflowExecutionUpdateAcceptedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateAcceptedEventAttributes() (o *WorkflowExecutionUpdateAcceptedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateAcceptedEventAttributes
 	}
 	return
 }
 
-// GetCompletedShardCount is an internal getter (TBD...)
-func (v *FailoverInfo) GetCompletedShardCount() (o int32) {
-	if v != nil {
-		return v.CompletedShardCount
+// GetWorkflowExecutionUpdateRejectedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateRejectedEventAttributes() (o *WorkflowExecutionUpdateRejectedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateRejectedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateRejectedEventAttributes
 	}
 	return
 }
 
-// GetPendingShards is an internal getter (TBD...)
-func (v *FailoverInfo) GetPendingShards() (o []int32) {
-	if v != nil {
-		return v.PendingShards
+// GetWorkflowExecutionUpdateCompletedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUpdateCompletedEventAttributes() (o *WorkflowExecutionUpdateCompletedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateCompletedEventAttributes
 	}
 	return
 }
 
-// Header is an internal type (TBD...)
-type Header struct {
-	Fields map[string][]byte `json:"fields,omitempty"`
-}
+// Size is an internal method to get the estimated size of the event
+func (v *HistoryEvent) ByteSize() uint64 {
+	if v == nil {
+		return 0
+	}
 
-// History is an internal type (TBD...)
-type History struct {
-	Events []*HistoryEvent `json:"events,omitempty"`
-}
+	size := uint64(8 + 8 + 4 + 8 + 8) // size of ID, Timestamp, EventType, Version, TaskID
 
-// GetEvents is an internal getter (TBD...)
-func (v *History) GetEvents() (o []*HistoryEvent) {
-	if v != nil && v.Events != nil {
-		return v.Events
+	if v.WorkflowExecutionStartedEventAttributes != nil {
+		size += v.WorkflowExecutionStartedEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionCompletedEventAttributes != nil {
+		size += v.WorkflowExecutionCompletedEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionFailedEventAttributes != nil {
+		size += v.WorkflowExecutionFailedEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionTimedOutEventAttributes != nil {
+		size += v.WorkflowExecutionTimedOutEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionCancelRequestedEventAttributes != nil {
+		size += v.WorkflowExecutionCancelRequestedEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionCanceledEventAttributes != nil {
+		size += v.WorkflowExecutionCanceledEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionTerminatedEventAttributes != nil {
+		size += v.WorkflowExecutionTerminatedEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
+		size += v.WorkflowExecutionContinuedAsNewEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionSignaledEventAttributes != nil {
+		size += v.WorkflowExecutionSignaledEventAttributes.ByteSize()
+	}
+
+	if v.DecisionTaskScheduledEventAttributes != nil {
+		size += v.DecisionTaskScheduledEventAttributes.ByteSize()
+	}
+
+	if v.DecisionTaskStartedEventAttributes != nil {
+		size += v.DecisionTaskStartedEventAttributes.ByteSize()
+	}
+
+	if v.DecisionTaskCompletedEventAttributes != nil {
+		size += v.DecisionTaskCompletedEventAttributes.ByteSize()
+	}
+
+	if v.DecisionTaskTimedOutEventAttributes != nil {
+		size += v.DecisionTaskTimedOutEventAttributes.ByteSize()
+	}
+
+	if v.DecisionTaskFailedEventAttributes != nil {
+		size += v.DecisionTaskFailedEventAttributes.ByteSize()
+	}
+
+	if v.ActivityTaskScheduledEventAttributes != nil {
+		size += v.ActivityTaskScheduledEventAttributes.ByteSize()
+	}
+
+	if v.ActivityTaskStartedEventAttributes != nil {
+		size += v.ActivityTaskStartedEventAttributes.ByteSize()
+	}
+
+	if v.ActivityTaskCompletedEventAttributes != nil {
+		size += v.ActivityTaskCompletedEventAttributes.ByteSize()
+	}
+
+	if v.ActivityTaskFailedEventAttributes != nil {
+		size += v.ActivityTaskFailedEventAttributes.ByteSize()
+	}
+
+	if v.ActivityTaskTimedOutEventAttributes != nil {
+		size += v.ActivityTaskTimedOutEventAttributes.ByteSize()
+	}
+
+	if v.ActivityTaskCancelRequestedEventAttributes != nil {
+		size += v.ActivityTaskCancelRequestedEventAttributes.ByteSize()
+	}
+
+	if v.ActivityTaskCanceledEventAttributes != nil {
+		size += v.ActivityTaskCanceledEventAttributes.ByteSize()
+	}
+
+	if v.RequestCancelActivityTaskFailedEventAttributes != nil {
+		size += v.RequestCancelActivityTaskFailedEventAttributes.ByteSize()
+	}
+
+	if v.TimerStartedEventAttributes != nil {
+		size += v.TimerStartedEventAttributes.ByteSize()
+	}
+
+	if v.TimerFiredEventAttributes != nil {
+		size += v.TimerFiredEventAttributes.ByteSize()
+	}
+
+	if v.TimerCanceledEventAttributes != nil {
+		size += v.TimerCanceledEventAttributes.ByteSize()
+	}
+
+	if v.CancelTimerFailedEventAttributes != nil {
+		size += v.CancelTimerFailedEventAttributes.ByteSize()
+	}
+
+	if v.MarkerRecordedEventAttributes != nil {
+		size += v.MarkerRecordedEventAttributes.ByteSize()
+	}
+
+	if v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		size += v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.ByteSize()
+	}
+
+	if v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
+		size += v.RequestCancelExternalWorkflowExecutionFailedEventAttributes.ByteSize()
+	}
+
+	if v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
+		size += v.ExternalWorkflowExecutionCancelRequestedEventAttributes.ByteSize()
+	}
+
+	if v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		size += v.SignalExternalWorkflowExecutionInitiatedEventAttributes.ByteSize()
+	}
+
+	if v.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
+		size += v.SignalExternalWorkflowExecutionFailedEventAttributes.ByteSize()
+	}
+
+	if v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
+		size += v.ExternalWorkflowExecutionSignaledEventAttributes.ByteSize()
+	}
+
+	if v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
+		size += v.StartChildWorkflowExecutionInitiatedEventAttributes.ByteSize()
+	}
+
+	if v.StartChildWorkflowExecutionFailedEventAttributes != nil {
+		size += v.StartChildWorkflowExecutionFailedEventAttributes.ByteSize()
+	}
+
+	if v.ChildWorkflowExecutionStartedEventAttributes != nil {
+		size += v.ChildWorkflowExecutionStartedEventAttributes.ByteSize()
 	}
-	return
-}
 
-// HistoryBranch is an internal type (TBD...)
-type HistoryBranch struct {
-	TreeID    string
-	BranchID  string
-	Ancestors []*HistoryBranchRange
-}
+	if v.ChildWorkflowExecutionCompletedEventAttributes != nil {
+		size += v.ChildWorkflowExecutionCompletedEventAttributes.ByteSize()
+	}
 
-// HistoryBranchRange is an internal type (TBD...)
-type HistoryBranchRange struct {
-	BranchID    string
-	BeginNodeID int64
-	EndNodeID   int64
-}
+	if v.ChildWorkflowExecutionFailedEventAttributes != nil {
+		size += v.ChildWorkflowExecutionFailedEventAttributes.ByteSize()
+	}
 
-// HistoryEvent is an internal type (TBD...)
-type HistoryEvent struct {
-	ID                                                             int64                                                           `json:"eventId,omitempty"`
-	Timestamp                                                      *int64                                                          `json:"timestamp,omitempty"`
-	EventType                                                      *EventType                                                      `json:"eventType,omitempty"`
-	Version                                                        int64                                                           `json:"version,omitempty"`
-	TaskID                                                         int64                                                           `json:"taskId,omitempty"`
-	WorkflowExecutionStartedEventAttributes                        *WorkflowExecutionStartedEventAttributes                        `json:"workflowExecutionStartedEventAttributes,omitempty"`
-	WorkflowExecutionCompletedEventAttributes                      *WorkflowExecutionCompletedEventAttributes                      `json:"workflowExecutionCompletedEventAttributes,omitempty"`
-	WorkflowExecutionFailedEventAttributes                         *WorkflowExecutionFailedEventAttributes                         `json:"workflowExecutionFailedEventAttributes,omitempty"`
-	WorkflowExecutionTimedOutEventAttributes                       *WorkflowExecutionTimedOutEventAttributes                       `json:"workflowExecutionTimedOutEventAttributes,omitempty"`
-	DecisionTaskScheduledEventAttributes                           *DecisionTaskScheduledEventAttributes                           `json:"decisionTaskScheduledEventAttributes,omitempty"`
-	DecisionTaskStartedEventAttributes                             *DecisionTaskStartedEventAttributes                             `json:"decisionTaskStartedEventAttributes,omitempty"`
-	DecisionTaskCompletedEventAttributes                           *DecisionTaskCompletedEventAttributes                           `json:"decisionTaskCompletedEventAttributes,omitempty"`
-	DecisionTaskTimedOutEventAttributes                            *DecisionTaskTimedOutEventAttributes                            `json:"decisionTaskTimedOutEventAttributes,omitempty"`
-	DecisionTaskFailedEventAttributes                              *DecisionTaskFailedEventAttributes                              `json:"decisionTaskFailedEventAttributes,omitempty"`
-	ActivityTaskScheduledEventAttributes                           *ActivityTaskScheduledEventAttributes                           `json:"activityTaskScheduledEventAttributes,omitempty"`
-	ActivityTaskStartedEventAttributes                             *ActivityTaskStartedEventAttributes                             `json:"activityTaskStartedEventAttributes,omitempty"`
-	ActivityTaskCompletedEventAttributes                           *ActivityTaskCompletedEventAttributes                           `json:"activityTaskCompletedEventAttributes,omitempty"`
-	ActivityTaskFailedEventAttributes                              *ActivityTaskFailedEventAttributes                              `json:"activityTaskFailedEventAttributes,omitempty"`
-	ActivityTaskTimedOutEventAttributes                            *ActivityTaskTimedOutEventAttributes                            `json:"activityTaskTimedOutEventAttributes,omitempty"`
-	TimerStartedEventAttributes                                    *TimerStartedEventAttributes                                    `json:"timerStartedEventAttributes,omitempty"`
-	TimerFiredEventAttributes                                      *TimerFiredEventAttributes                                      `json:"timerFiredEventAttributes,omitempty"`
-	ActivityTaskCancelRequestedEventAttributes                     *ActivityTaskCancelRequestedEventAttributes                     `json:"activityTaskCancelRequestedEventAttributes,omitempty"`
-	RequestCancelActivityTaskFailedEventAttributes                 *RequestCancelActivityTaskFailedEventAttributes                 `json:"requestCancelActivityTaskFailedEventAttributes,omitempty"`
-	ActivityTaskCanceledEventAttributes                            *ActivityTaskCanceledEventAttributes                            `json:"activityTaskCanceledEventAttributes,omitempty"`
-	TimerCanceledEventAttributes                                   *TimerCanceledEventAttributes                                   `json:"timerCanceledEventAttributes,omitempty"`
-	CancelTimerFailedEventAttributes                               *CancelTimerFailedEventAttributes                               `json:"cancelTimerFailedEventAttributes,omitempty"`
-	MarkerRecordedEventAttributes                                  *MarkerRecordedEventAttributes                                  `json:"markerRecordedEventAttributes,omitempty"`
-	WorkflowExecutionSignaledEventAttributes                       *WorkflowExecutionSignaledEventAttributes                       `json:"workflowExecutionSignaledEventAttributes,omitempty"`
-	WorkflowExecutionTerminatedEventAttributes                     *WorkflowExecutionTerminatedEventAttributes                     `json:"workflowExecutionTerminatedEventAttributes,omitempty"`
-	WorkflowExecutionCancelRequestedEventAttributes                *WorkflowExecutionCancelRequestedEventAttributes                `json:"workflowExecutionCancelRequestedEventAttributes,omitempty"`
-	WorkflowExecutionCanceledEventAttributes                       *WorkflowExecutionCanceledEventAttributes                       `json:"workflowExecutionCanceledEventAttributes,omitempty"`
-	RequestCancelExternalWorkflowExecutionInitiatedEventAttributes *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
-	RequestCancelExternalWorkflowExecutionFailedEventAttributes    *RequestCancelExternalWorkflowExecutionFailedEventAttributes    `json:"requestCancelExternalWorkflowExecutionFailedEventAttributes,omitempty"`
-	ExternalWorkflowExecutionCancelRequestedEventAttributes        *ExternalWorkflowExecutionCancelRequestedEventAttributes        `json:"externalWorkflowExecutionCancelRequestedEventAttributes,omitempty"`
-	WorkflowExecutionContinuedAsNewEventAttributes                 *WorkflowExecutionContinuedAsNewEventAttributes                 `json:"workflowExecutionContinuedAsNewEventAttributes,omitempty"`
-	StartChildWorkflowExecutionInitiatedEventAttributes            *StartChildWorkflowExecutionInitiatedEventAttributes            `json:"startChildWorkflowExecutionInitiatedEventAttributes,omitempty"`
-	StartChildWorkflowExecutionFailedEventAttributes               *StartChildWorkflowExecutionFailedEventAttributes               `json:"startChildWorkflowExecutionFailedEventAttributes,omitempty"`
-	ChildWorkflowExecutionStartedEventAttributes                   *ChildWorkflowExecutionStartedEventAttributes                   `json:"childWorkflowExecutionStartedEventAttributes,omitempty"`
-	ChildWorkflowExecutionCompletedEventAttributes                 *ChildWorkflowExecutionCompletedEventAttributes                 `json:"childWorkflowExecutionCompletedEventAttributes,omitempty"`
-	ChildWorkflowExecutionFailedEventAttributes                    *ChildWorkflowExecutionFailedEventAttributes                    `json:"childWorkflowExecutionFailedEventAttributes,omitempty"`
-	ChildWorkflowExecutionCanceledEventAttributes                  *ChildWorkflowExecutionCanceledEventAttributes                  `json:"childWorkflowExecutionCanceledEventAttributes,omitempty"`
-	ChildWorkflowExecutionTimedOutEventAttributes                  *ChildWorkflowExecutionTimedOutEventAttributes                  `json:"childWorkflowExecutionTimedOutEventAttributes,omitempty"`
-	ChildWorkflowExecutionTerminatedEventAttributes                *ChildWorkflowExecutionTerminatedEventAttributes                `json:"childWorkflowExecutionTerminatedEventAttributes,omitempty"`
-	SignalExternalWorkflowExecutionInitiatedEventAttributes        *SignalExternalWorkflowExecutionInitiatedEventAttributes        `json:"signalExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
-	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
-	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
-	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
-}
+	if v.ChildWorkflowExecutionCanceledEventAttributes != nil {
+		size += v.ChildWorkflowExecutionCanceledEventAttributes.ByteSize()
+	}
 
-// GetTimestamp is an internal getter (TBD...)
-func (v *HistoryEvent) GetTimestamp() (o int64) {
-	if v != nil && v.Timestamp != nil {
-		return *v.Timestamp
+	if v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
+		size += v.ChildWorkflowExecutionTimedOutEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetEventType is an internal getter (TBD...)
-func (v *HistoryEvent) GetEventType() (o EventType) {
-	if v != nil && v.EventType != nil {
-		return *v.EventType
+	if v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
+		size += v.ChildWorkflowExecutionTerminatedEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetWorkflowExecutionStartedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetWorkflowExecutionStartedEventAttributes() (o *WorkflowExecutionStartedEventAttributes) {
-	if v != nil && v.WorkflowExecutionStartedEventAttributes != nil {
-		return v.WorkflowExecutionStartedEventAttributes
+	if v.UpsertWorkflowSearchAttributesEventAttributes != nil {
+		size += v.UpsertWorkflowSearchAttributesEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetWorkflowExecutionCompletedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetWorkflowExecutionCompletedEventAttributes() (o *WorkflowExecutionCompletedEventAttributes) {
-	if v != nil && v.WorkflowExecutionCompletedEventAttributes != nil {
-		return v.WorkflowExecutionCompletedEventAttributes
+	if v.WorkflowExecutionSearchAttributesUpdatedEventAttributes != nil {
+		size += v.WorkflowExecutionSearchAttributesUpdatedEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetWorkflowExecutionFailedEventAttributes() (o *WorkflowExecutionFailedEventAttributes) {
-	if v != nil && v.WorkflowExecutionFailedEventAttributes != nil {
-		return v.WorkflowExecutionFailedEventAttributes
+	if v.WorkflowExecutionPausedEventAttributes != nil {
+		size += v.WorkflowExecutionPausedEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetWorkflowExecutionTimedOutEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetWorkflowExecutionTimedOutEventAttributes() (o *WorkflowExecutionTimedOutEventAttributes) {
-	if v != nil && v.WorkflowExecutionTimedOutEventAttributes != nil {
-		return v.WorkflowExecutionTimedOutEventAttributes
+	if v.WorkflowExecutionUnpausedEventAttributes != nil {
+		size += v.WorkflowExecutionUnpausedEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetDecisionTaskScheduledEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetDecisionTaskScheduledEventAttributes() (o *DecisionTaskScheduledEventAttributes) {
-	if v != nil && v.DecisionTaskScheduledEventAttributes != nil {
-		return v.DecisionTaskScheduledEventAttributes
+	if v.ActivityTaskPausedEventAttributes != nil {
+		size += v.ActivityTaskPausedEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetDecisionTaskStartedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetDecisionTaskStartedEventAttributes() (o *DecisionTaskStartedEventAttributes) {
-	if v != nil && v.DecisionTaskStartedEventAttributes != nil {
-		return v.DecisionTaskStartedEventAttributes
+	if v.ActivityTaskUnpausedEventAttributes != nil {
+		size += v.ActivityTaskUnpausedEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetDecisionTaskCompletedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetDecisionTaskCompletedEventAttributes() (o *DecisionTaskCompletedEventAttributes) {
-	if v != nil && v.DecisionTaskCompletedEventAttributes != nil {
-		return v.DecisionTaskCompletedEventAttributes
+	if v.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		size += v.WorkflowExecutionUpdateAcceptedEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetDecisionTaskTimedOutEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetDecisionTaskTimedOutEventAttributes() (o *DecisionTaskTimedOutEventAttributes) {
-	if v != nil && v.DecisionTaskTimedOutEventAttributes != nil {
-		return v.DecisionTaskTimedOutEventAttributes
+	if v.WorkflowExecutionUpdateRejectedEventAttributes != nil {
+		size += v.WorkflowExecutionUpdateRejectedEventAttributes.ByteSize()
 	}
-	return
-}
 
-// GetDecisionTaskFailedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetDecisionTaskFailedEventAttributes() (o *DecisionTaskFailedEventAttributes) {
-	if v != nil && v.DecisionTaskFailedEventAttributes != nil {
-		return v.DecisionTaskFailedEventAttributes
+	if v.WorkflowExecutionUpdateCompletedEventAttributes != nil {
+		size += v.WorkflowExecutionUpdateCompletedEventAttributes.ByteSize()
 	}
-	return
+
+	return size
 }
 
-// GetActivityTaskScheduledEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetActivityTaskScheduledEventAttributes() (o *ActivityTaskScheduledEventAttributes) {
-	if v != nil && v.ActivityTaskScheduledEventAttributes != nil {
-		return v.ActivityTaskScheduledEventAttributes
-	}
-	return
+// HistoryEventFilterType is an internal type (TBD...)
+type HistoryEventFilterType int32
+
+// Ptr is a helper function for getting pointer value
+func (e HistoryEventFilterType) Ptr() *HistoryEventFilterType {
+	return &e
 }
 
-// GetActivityTaskStartedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetActivityTaskStartedEventAttributes() (o *ActivityTaskStartedEventAttributes) {
-	if v != nil && v.ActivityTaskStartedEventAttributes != nil {
-		return v.ActivityTaskStartedEventAttributes
+// String returns a readable string representation of HistoryEventFilterType.
+func (e HistoryEventFilterType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "ALL_EVENT"
+	case 1:
+		return "CLOSE_EVENT"
 	}
-	return
+	return fmt.Sprintf("HistoryEventFilterType(%d)", w)
 }
 
-// GetActivityTaskCompletedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetActivityTaskCompletedEventAttributes() (o *ActivityTaskCompletedEventAttributes) {
-	if v != nil && v.ActivityTaskCompletedEventAttributes != nil {
-		return v.ActivityTaskCompletedEventAttributes
+// UnmarshalText parses enum value from string representation
+func (e *HistoryEventFilterType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "ALL_EVENT":
+		*e = HistoryEventFilterTypeAllEvent
+		return nil
+	case "CLOSE_EVENT":
+		*e = HistoryEventFilterTypeCloseEvent
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "HistoryEventFilterType", err)
+		}
+		*e = HistoryEventFilterType(val)
+		return nil
 	}
-	return
 }
 
-// GetActivityTaskFailedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetActivityTaskFailedEventAttributes() (o *ActivityTaskFailedEventAttributes) {
-	if v != nil && v.ActivityTaskFailedEventAttributes != nil {
-		return v.ActivityTaskFailedEventAttributes
-	}
-	return
+// MarshalText encodes HistoryEventFilterType to text.
+func (e HistoryEventFilterType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
 }
 
-// GetActivityTaskTimedOutEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetActivityTaskTimedOutEventAttributes() (o *ActivityTaskTimedOutEventAttributes) {
-	if v != nil && v.ActivityTaskTimedOutEventAttributes != nil {
-		return v.ActivityTaskTimedOutEventAttributes
-	}
-	return
+const (
+	// HistoryEventFilterTypeAllEvent is an option for HistoryEventFilterType
+	HistoryEventFilterTypeAllEvent HistoryEventFilterType = iota
+	// HistoryEventFilterTypeCloseEvent is an option for HistoryEventFilterType
+	HistoryEventFilterTypeCloseEvent
+)
+
+// IndexedValueType is an internal type (TBD...)
+type IndexedValueType int32
+
+// Ptr is a helper function for getting pointer value
+func (e IndexedValueType) Ptr() *IndexedValueType {
+	return &e
 }
 
-// GetTimerStartedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetTimerStartedEventAttributes() (o *TimerStartedEventAttributes) {
-	if v != nil && v.TimerStartedEventAttributes != nil {
-		return v.TimerStartedEventAttributes
+// String returns a readable string representation of IndexedValueType.
+func (e IndexedValueType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "STRING"
+	case 1:
+		return "KEYWORD"
+	case 2:
+		return "INT"
+	case 3:
+		return "DOUBLE"
+	case 4:
+		return "BOOL"
+	case 5:
+		return "DATETIME"
 	}
-	return
+	return fmt.Sprintf("IndexedValueType(%d)", w)
 }
 
-// GetTimerFiredEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetTimerFiredEventAttributes() (o *TimerFiredEventAttributes) {
-	if v != nil && v.TimerFiredEventAttributes != nil {
-		return v.TimerFiredEventAttributes
+// UnmarshalText parses enum value from string representation
+func (e *IndexedValueType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "STRING":
+		*e = IndexedValueTypeString
+		return nil
+	case "KEYWORD":
+		*e = IndexedValueTypeKeyword
+		return nil
+	case "INT":
+		*e = IndexedValueTypeInt
+		return nil
+	case "DOUBLE":
+		*e = IndexedValueTypeDouble
+		return nil
+	case "BOOL":
+		*e = IndexedValueTypeBool
+		return nil
+	case "DATETIME":
+		*e = IndexedValueTypeDatetime
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "IndexedValueType", err)
+		}
+		*e = IndexedValueType(val)
+		return nil
 	}
-	return
 }
 
-// GetActivityTaskCancelRequestedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetActivityTaskCancelRequestedEventAttributes() (o *ActivityTaskCancelRequestedEventAttributes) {
-	if v != nil && v.ActivityTaskCancelRequestedEventAttributes != nil {
-		return v.ActivityTaskCancelRequestedEventAttributes
-	}
-	return
+// MarshalText encodes IndexedValueType to text.
+func (e IndexedValueType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
 }
 
-// GetRequestCancelActivityTaskFailedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetRequestCancelActivityTaskFailedEventAttributes() (o *RequestCancelActivityTaskFailedEventAttributes) {
-	if v != nil && v.RequestCancelActivityTaskFailedEventAttributes != nil {
-		return v.RequestCancelActivityTaskFailedEventAttributes
-	}
-	return
-}
+const (
+	// IndexedValueTypeString is an option for IndexedValueType
+	IndexedValueTypeString IndexedValueType = iota
+	// IndexedValueTypeKeyword is an option for IndexedValueType
+	IndexedValueTypeKeyword
+	// IndexedValueTypeInt is an option for IndexedValueType
+	IndexedValueTypeInt
+	// IndexedValueTypeDouble is an option for IndexedValueType
+	IndexedValueTypeDouble
+	// IndexedValueTypeBool is an option for IndexedValueType
+	IndexedValueTypeBool
+	// IndexedValueTypeDatetime is an option for IndexedValueType
+	IndexedValueTypeDatetime
+)
 
-// GetActivityTaskCanceledEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetActivityTaskCanceledEventAttributes() (o *ActivityTaskCanceledEventAttributes) {
-	if v != nil && v.ActivityTaskCanceledEventAttributes != nil {
-		return v.ActivityTaskCanceledEventAttributes
-	}
-	return
+// InternalDataInconsistencyError is an internal type (TBD...)
+type InternalDataInconsistencyError struct {
+	Message string `json:"message,required"`
 }
 
-// GetTimerCanceledEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetTimerCanceledEventAttributes() (o *TimerCanceledEventAttributes) {
-	if v != nil && v.TimerCanceledEventAttributes != nil {
-		return v.TimerCanceledEventAttributes
-	}
-	return
+// InternalServiceError is an internal type (TBD...)
+type InternalServiceError struct {
+	Message string `json:"message,required"`
 }
 
-// GetCancelTimerFailedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetCancelTimerFailedEventAttributes() (o *CancelTimerFailedEventAttributes) {
-	if v != nil && v.CancelTimerFailedEventAttributes != nil {
-		return v.CancelTimerFailedEventAttributes
+// GetMessage is an internal getter (TBD...)
+func (v *InternalServiceError) GetMessage() (o string) {
+	if v != nil {
+		return v.Message
 	}
 	return
 }
 
-// GetMarkerRecordedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetMarkerRecordedEventAttributes() (o *MarkerRecordedEventAttributes) {
-	if v != nil && v.MarkerRecordedEventAttributes != nil {
-		return v.MarkerRecordedEventAttributes
-	}
-	return
+// LimitExceededError is an internal type (TBD...)
+type LimitExceededError struct {
+	Message string `json:"message,required"`
 }
 
-// GetWorkflowExecutionSignaledEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetWorkflowExecutionSignaledEventAttributes() (o *WorkflowExecutionSignaledEventAttributes) {
-	if v != nil && v.WorkflowExecutionSignaledEventAttributes != nil {
-		return v.WorkflowExecutionSignaledEventAttributes
-	}
-	return
+// ListArchivedWorkflowExecutionsRequest is an internal type (TBD...)
+type ListArchivedWorkflowExecutionsRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+	Query         string `json:"query,omitempty"`
 }
 
-// GetWorkflowExecutionTerminatedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetWorkflowExecutionTerminatedEventAttributes() (o *WorkflowExecutionTerminatedEventAttributes) {
-	if v != nil && v.WorkflowExecutionTerminatedEventAttributes != nil {
-		return v.WorkflowExecutionTerminatedEventAttributes
+// GetDomain is an internal getter (TBD...)
+func (v *ListArchivedWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetWorkflowExecutionCancelRequestedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetWorkflowExecutionCancelRequestedEventAttributes() (o *WorkflowExecutionCancelRequestedEventAttributes) {
-	if v != nil && v.WorkflowExecutionCancelRequestedEventAttributes != nil {
-		return v.WorkflowExecutionCancelRequestedEventAttributes
+// GetPageSize is an internal getter (TBD...)
+func (v *ListArchivedWorkflowExecutionsRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
 	}
 	return
 }
 
-// GetWorkflowExecutionCanceledEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetWorkflowExecutionCanceledEventAttributes() (o *WorkflowExecutionCanceledEventAttributes) {
-	if v != nil && v.WorkflowExecutionCanceledEventAttributes != nil {
-		return v.WorkflowExecutionCanceledEventAttributes
+// GetQuery is an internal getter (TBD...)
+func (v *ListArchivedWorkflowExecutionsRequest) GetQuery() (o string) {
+	if v != nil {
+		return v.Query
 	}
 	return
 }
 
-// GetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() (o *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) {
-	if v != nil && v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
-		return v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
-	}
-	return
+// ListArchivedWorkflowExecutionsResponse is an internal type (TBD...)
+type ListArchivedWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
 }
 
-// GetRequestCancelExternalWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetRequestCancelExternalWorkflowExecutionFailedEventAttributes() (o *RequestCancelExternalWorkflowExecutionFailedEventAttributes) {
-	if v != nil && v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
-		return v.RequestCancelExternalWorkflowExecutionFailedEventAttributes
+// GetExecutions is an internal getter (TBD...)
+func (v *ListArchivedWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
+	if v != nil && v.Executions != nil {
+		return v.Executions
 	}
 	return
 }
 
-// GetExternalWorkflowExecutionCancelRequestedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetExternalWorkflowExecutionCancelRequestedEventAttributes() (o *ExternalWorkflowExecutionCancelRequestedEventAttributes) {
-	if v != nil && v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
-		return v.ExternalWorkflowExecutionCancelRequestedEventAttributes
-	}
-	return
+// ListClosedWorkflowExecutionsRequest is an internal type (TBD...)
+type ListClosedWorkflowExecutionsRequest struct {
+	Domain          string                        `json:"domain,omitempty"`
+	MaximumPageSize int32                         `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte                        `json:"nextPageToken,omitempty"`
+	StartTimeFilter *StartTimeFilter              `json:"StartTimeFilter,omitempty"`
+	ExecutionFilter *WorkflowExecutionFilter      `json:"executionFilter,omitempty"`
+	TypeFilter      *WorkflowTypeFilter           `json:"typeFilter,omitempty"`
+	StatusFilter    *WorkflowExecutionCloseStatus `json:"statusFilter,omitempty"`
 }
 
-// GetWorkflowExecutionContinuedAsNewEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetWorkflowExecutionContinuedAsNewEventAttributes() (o *WorkflowExecutionContinuedAsNewEventAttributes) {
-	if v != nil && v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
-		return v.WorkflowExecutionContinuedAsNewEventAttributes
+// GetDomain is an internal getter (TBD...)
+func (v *ListClosedWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetStartChildWorkflowExecutionInitiatedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetStartChildWorkflowExecutionInitiatedEventAttributes() (o *StartChildWorkflowExecutionInitiatedEventAttributes) {
-	if v != nil && v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
-		return v.StartChildWorkflowExecutionInitiatedEventAttributes
+// GetMaximumPageSize is an internal getter (TBD...)
+func (v *ListClosedWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
+	if v != nil {
+		return v.MaximumPageSize
 	}
 	return
 }
 
-// GetStartChildWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetStartChildWorkflowExecutionFailedEventAttributes() (o *StartChildWorkflowExecutionFailedEventAttributes) {
-	if v != nil && v.StartChildWorkflowExecutionFailedEventAttributes != nil {
-		return v.StartChildWorkflowExecutionFailedEventAttributes
+// GetStatusFilter is an internal getter (TBD...)
+func (v *ListClosedWorkflowExecutionsRequest) GetStatusFilter() (o WorkflowExecutionCloseStatus) {
+	if v != nil && v.StatusFilter != nil {
+		return *v.StatusFilter
 	}
 	return
 }
 
-// GetChildWorkflowExecutionStartedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetChildWorkflowExecutionStartedEventAttributes() (o *ChildWorkflowExecutionStartedEventAttributes) {
-	if v != nil && v.ChildWorkflowExecutionStartedEventAttributes != nil {
-		return v.ChildWorkflowExecutionStartedEventAttributes
-	}
-	return
+// ListClosedWorkflowExecutionsResponse is an internal type (TBD...)
+type ListClosedWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
 }
 
-// GetChildWorkflowExecutionCompletedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetChildWorkflowExecutionCompletedEventAttributes() (o *ChildWorkflowExecutionCompletedEventAttributes) {
-	if v != nil && v.ChildWorkflowExecutionCompletedEventAttributes != nil {
-		return v.ChildWorkflowExecutionCompletedEventAttributes
+// GetExecutions is an internal getter (TBD...)
+func (v *ListClosedWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
+	if v != nil && v.Executions != nil {
+		return v.Executions
 	}
 	return
 }
 
-// GetChildWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetChildWorkflowExecutionFailedEventAttributes() (o *ChildWorkflowExecutionFailedEventAttributes) {
-	if v != nil && v.ChildWorkflowExecutionFailedEventAttributes != nil {
-		return v.ChildWorkflowExecutionFailedEventAttributes
-	}
-	return
+// ListDomainsRequest is an internal type (TBD...)
+type ListDomainsRequest struct {
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
 }
 
-// GetChildWorkflowExecutionCanceledEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetChildWorkflowExecutionCanceledEventAttributes() (o *ChildWorkflowExecutionCanceledEventAttributes) {
-	if v != nil && v.ChildWorkflowExecutionCanceledEventAttributes != nil {
-		return v.ChildWorkflowExecutionCanceledEventAttributes
+// GetPageSize is an internal getter (TBD...)
+func (v *ListDomainsRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
 	}
 	return
 }
 
-// GetChildWorkflowExecutionTimedOutEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetChildWorkflowExecutionTimedOutEventAttributes() (o *ChildWorkflowExecutionTimedOutEventAttributes) {
-	if v != nil && v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
-		return v.ChildWorkflowExecutionTimedOutEventAttributes
-	}
-	return
+// ListDomainsResponse is an internal type (TBD...)
+type ListDomainsResponse struct {
+	Domains       []*DescribeDomainResponse `json:"domains,omitempty"`
+	NextPageToken []byte                    `json:"nextPageToken,omitempty"`
 }
 
-// GetChildWorkflowExecutionTerminatedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetChildWorkflowExecutionTerminatedEventAttributes() (o *ChildWorkflowExecutionTerminatedEventAttributes) {
-	if v != nil && v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
-		return v.ChildWorkflowExecutionTerminatedEventAttributes
+// GetDomains is an internal getter (TBD...)
+func (v *ListDomainsResponse) GetDomains() (o []*DescribeDomainResponse) {
+	if v != nil && v.Domains != nil {
+		return v.Domains
 	}
 	return
 }
 
-// GetSignalExternalWorkflowExecutionInitiatedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetSignalExternalWorkflowExecutionInitiatedEventAttributes() (o *SignalExternalWorkflowExecutionInitiatedEventAttributes) {
-	if v != nil && v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
-		return v.SignalExternalWorkflowExecutionInitiatedEventAttributes
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListDomainsResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
 	return
 }
 
-// GetSignalExternalWorkflowExecutionFailedEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetSignalExternalWorkflowExecutionFailedEventAttributes() (o *SignalExternalWorkflowExecutionFailedEventAttributes) {
-	if v != nil && v.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
-		return v.SignalExternalWorkflowExecutionFailedEventAttributes
-	}
-	return
+// ListOpenWorkflowExecutionsRequest is an internal type (TBD...)
+type ListOpenWorkflowExecutionsRequest struct {
+	Domain          string                   `json:"domain,omitempty"`
+	MaximumPageSize int32                    `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte                   `json:"nextPageToken,omitempty"`
+	StartTimeFilter *StartTimeFilter         `json:"StartTimeFilter,omitempty"`
+	ExecutionFilter *WorkflowExecutionFilter `json:"executionFilter,omitempty"`
+	TypeFilter      *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
 }
 
-// GetExternalWorkflowExecutionSignaledEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetExternalWorkflowExecutionSignaledEventAttributes() (o *ExternalWorkflowExecutionSignaledEventAttributes) {
-	if v != nil && v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
-		return v.ExternalWorkflowExecutionSignaledEventAttributes
+// GetDomain is an internal getter (TBD...)
+func (v *ListOpenWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetUpsertWorkflowSearchAttributesEventAttributes is an internal getter (TBD...)
-func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *UpsertWorkflowSearchAttributesEventAttributes) {
-	if v != nil && v.UpsertWorkflowSearchAttributesEventAttributes != nil {
-		return v.UpsertWorkflowSearchAttributesEventAttributes
+// GetMaximumPageSize is an internal getter (TBD...)
+func (v *ListOpenWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
+	if v != nil {
+		return v.MaximumPageSize
 	}
 	return
 }
 
-// Size is an internal method to get the estimated size of the event
-func (v *HistoryEvent) ByteSize() uint64 {
-	if v == nil {
-		return 0
-	}
-
-	size := uint64(8 + 8 + 4 + 8 + 8) // size of ID, Timestamp, EventType, Version, TaskID
-
-	if v.WorkflowExecutionStartedEventAttributes != nil {
-		size += v.WorkflowExecutionStartedEventAttributes.ByteSize()
-	}
+// ListOpenWorkflowExecutionsResponse is an internal type (TBD...)
+type ListOpenWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+}
 
-	if v.WorkflowExecutionCompletedEventAttributes != nil {
-		size += v.WorkflowExecutionCompletedEventAttributes.ByteSize()
+// GetExecutions is an internal getter (TBD...)
+func (v *ListOpenWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
+	if v != nil && v.Executions != nil {
+		return v.Executions
 	}
+	return
+}
 
-	if v.WorkflowExecutionFailedEventAttributes != nil {
-		size += v.WorkflowExecutionFailedEventAttributes.ByteSize()
-	}
+// ListTaskListPartitionsRequest is an internal type (TBD...)
+type ListTaskListPartitionsRequest struct {
+	Domain   string    `json:"domain,omitempty"`
+	TaskList *TaskList `json:"taskList,omitempty"`
+}
 
-	if v.WorkflowExecutionTimedOutEventAttributes != nil {
-		size += v.WorkflowExecutionTimedOutEventAttributes.ByteSize()
+// GetDomain is an internal getter (TBD...)
+func (v *ListTaskListPartitionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
+	return
+}
 
-	if v.WorkflowExecutionCancelRequestedEventAttributes != nil {
-		size += v.WorkflowExecutionCancelRequestedEventAttributes.ByteSize()
+// GetTaskList is an internal getter (TBD...)
+func (v *ListTaskListPartitionsRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
 	}
+	return
+}
 
-	if v.WorkflowExecutionCanceledEventAttributes != nil {
-		size += v.WorkflowExecutionCanceledEventAttributes.ByteSize()
-	}
+// ListTaskListPartitionsResponse is an internal type (TBD...)
+type ListTaskListPartitionsResponse struct {
+	ActivityTaskListPartitions []*TaskListPartitionMetadata `json:"activityTaskListPartitions,omitempty"`
+	DecisionTaskListPartitions []*TaskListPartitionMetadata `json:"decisionTaskListPartitions,omitempty"`
+}
 
-	if v.WorkflowExecutionTerminatedEventAttributes != nil {
-		size += v.WorkflowExecutionTerminatedEventAttributes.ByteSize()
-	}
+// GetTaskListsByDomainRequest is an internal type (TBD...)
+type GetTaskListsByDomainRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
 
-	if v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
-		size += v.WorkflowExecutionContinuedAsNewEventAttributes.ByteSize()
+// GetDomain is an internal getter (TBD...)
+func (v *GetTaskListsByDomainRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
+	return
+}
 
-	if v.WorkflowExecutionSignaledEventAttributes != nil {
-		size += v.WorkflowExecutionSignaledEventAttributes.ByteSize()
-	}
+// GetTaskListsByDomainResponse is an internal type (TBD...)
+type GetTaskListsByDomainResponse struct {
+	DecisionTaskListMap map[string]*DescribeTaskListResponse `json:"decisionTaskListMap,omitempty"`
+	ActivityTaskListMap map[string]*DescribeTaskListResponse `json:"activityTaskListMap,omitempty"`
+}
 
-	if v.DecisionTaskScheduledEventAttributes != nil {
-		size += v.DecisionTaskScheduledEventAttributes.ByteSize()
+// GetDecisionTaskListMap is an internal getter (TBD...)
+func (v *GetTaskListsByDomainResponse) GetDecisionTaskListMap() (o map[string]*DescribeTaskListResponse) {
+	if v != nil && v.DecisionTaskListMap != nil {
+		return v.DecisionTaskListMap
 	}
+	return
+}
 
-	if v.DecisionTaskStartedEventAttributes != nil {
-		size += v.DecisionTaskStartedEventAttributes.ByteSize()
+// GetActivityTaskListMap is an internal getter (TBD...)
+func (v *GetTaskListsByDomainResponse) GetActivityTaskListMap() (o map[string]*DescribeTaskListResponse) {
+	if v != nil && v.ActivityTaskListMap != nil {
+		return v.ActivityTaskListMap
 	}
+	return
+}
 
-	if v.DecisionTaskCompletedEventAttributes != nil {
-		size += v.DecisionTaskCompletedEventAttributes.ByteSize()
-	}
+// ListWorkflowExecutionsRequest is an internal type (TBD...)
+type ListWorkflowExecutionsRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+	Query         string `json:"query,omitempty"`
+}
 
-	if v.DecisionTaskTimedOutEventAttributes != nil {
-		size += v.DecisionTaskTimedOutEventAttributes.ByteSize()
+// GetDomain is an internal getter (TBD...)
+func (v *ListWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
+	return
+}
 
-	if v.DecisionTaskFailedEventAttributes != nil {
-		size += v.DecisionTaskFailedEventAttributes.ByteSize()
+// GetPageSize is an internal getter (TBD...)
+func (v *ListWorkflowExecutionsRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
 	}
+	return
+}
 
-	if v.ActivityTaskScheduledEventAttributes != nil {
-		size += v.ActivityTaskScheduledEventAttributes.ByteSize()
+// GetQuery is an internal getter (TBD...)
+func (v *ListWorkflowExecutionsRequest) GetQuery() (o string) {
+	if v != nil {
+		return v.Query
 	}
+	return
+}
 
-	if v.ActivityTaskStartedEventAttributes != nil {
-		size += v.ActivityTaskStartedEventAttributes.ByteSize()
-	}
+// ListWorkflowExecutionsResponse is an internal type (TBD...)
+type ListWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+}
 
-	if v.ActivityTaskCompletedEventAttributes != nil {
-		size += v.ActivityTaskCompletedEventAttributes.ByteSize()
+// GetExecutions is an internal getter (TBD...)
+func (v *ListWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
+	if v != nil && v.Executions != nil {
+		return v.Executions
 	}
+	return
+}
 
-	if v.ActivityTaskFailedEventAttributes != nil {
-		size += v.ActivityTaskFailedEventAttributes.ByteSize()
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
+	return
+}
 
-	if v.ActivityTaskTimedOutEventAttributes != nil {
-		size += v.ActivityTaskTimedOutEventAttributes.ByteSize()
-	}
+// MarkerRecordedEventAttributes is an internal type (TBD...)
+type MarkerRecordedEventAttributes struct {
+	MarkerName                   string  `json:"markerName,omitempty"`
+	Details                      []byte  `json:"details,omitempty"`
+	DecisionTaskCompletedEventID int64   `json:"decisionTaskCompletedEventId,omitempty"`
+	Header                       *Header `json:"header,omitempty"`
+}
 
-	if v.ActivityTaskCancelRequestedEventAttributes != nil {
-		size += v.ActivityTaskCancelRequestedEventAttributes.ByteSize()
+// GetMarkerName is an internal getter (TBD...)
+func (v *MarkerRecordedEventAttributes) GetMarkerName() (o string) {
+	if v != nil {
+		return v.MarkerName
 	}
+	return
+}
 
-	if v.ActivityTaskCanceledEventAttributes != nil {
-		size += v.ActivityTaskCanceledEventAttributes.ByteSize()
-	}
+// Size returns the approximate memory used in bytes
+func (v *MarkerRecordedEventAttributes) ByteSize() uint64 {
+	return 0
+}
 
-	if v.RequestCancelActivityTaskFailedEventAttributes != nil {
-		size += v.RequestCancelActivityTaskFailedEventAttributes.ByteSize()
-	}
+// Memo is an internal type (TBD...)
+type Memo struct {
+	Fields map[string][]byte `json:"fields,omitempty"`
+}
 
-	if v.TimerStartedEventAttributes != nil {
-		size += v.TimerStartedEventAttributes.ByteSize()
+// GetFields is an internal getter (TBD...)
+func (v *Memo) GetFields() (o map[string][]byte) {
+	if v != nil && v.Fields != nil {
+		return v.Fields
 	}
+	return
+}
 
-	if v.TimerFiredEventAttributes != nil {
-		size += v.TimerFiredEventAttributes.ByteSize()
-	}
+// ParentClosePolicy is an internal type (TBD...)
+type ParentClosePolicy int32
 
-	if v.TimerCanceledEventAttributes != nil {
-		size += v.TimerCanceledEventAttributes.ByteSize()
-	}
+// Ptr is a helper function for getting pointer value
+func (e ParentClosePolicy) Ptr() *ParentClosePolicy {
+	return &e
+}
 
-	if v.CancelTimerFailedEventAttributes != nil {
-		size += v.CancelTimerFailedEventAttributes.ByteSize()
+// String returns a readable string representation of ParentClosePolicy.
+func (e ParentClosePolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "ABANDON"
+	case 1:
+		return "REQUEST_CANCEL"
+	case 2:
+		return "TERMINATE"
 	}
+	return fmt.Sprintf("ParentClosePolicy(%d)", w)
+}
 
-	if v.MarkerRecordedEventAttributes != nil {
-		size += v.MarkerRecordedEventAttributes.ByteSize()
+// UnmarshalText parses enum value from string representation
+func (e *ParentClosePolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "ABANDON":
+		*e = ParentClosePolicyAbandon
+		return nil
+	case "REQUEST_CANCEL":
+		*e = ParentClosePolicyRequestCancel
+		return nil
+	case "TERMINATE":
+		*e = ParentClosePolicyTerminate
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ParentClosePolicy", err)
+		}
+		*e = ParentClosePolicy(val)
+		return nil
 	}
+}
 
-	if v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
-		size += v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.ByteSize()
-	}
+// MarshalText encodes ParentClosePolicy to text.
+func (e ParentClosePolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
 
-	if v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
-		size += v.RequestCancelExternalWorkflowExecutionFailedEventAttributes.ByteSize()
-	}
+const (
+	// ParentClosePolicyAbandon is an option for ParentClosePolicy
+	ParentClosePolicyAbandon ParentClosePolicy = iota
+	// ParentClosePolicyRequestCancel is an option for ParentClosePolicy
+	ParentClosePolicyRequestCancel
+	// ParentClosePolicyTerminate is an option for ParentClosePolicy
+	ParentClosePolicyTerminate
+)
 
-	if v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
-		size += v.ExternalWorkflowExecutionCancelRequestedEventAttributes.ByteSize()
-	}
+// PendingActivityInfo is an internal type (TBD...)
+type PendingActivityInfo struct {
+	ActivityID             string                `json:"activityID,omitempty"`
+	ActivityType           *ActivityType         `json:"activityType,omitempty"`
+	State                  *PendingActivityState `json:"state,omitempty"`
+	HeartbeatDetails       []byte                `json:"heartbeatDetails,omitempty"`
+	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
+	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
+	Attempt                int32                 `json:"attempt,omitempty"`
+	MaximumAttempts        int32                 `json:"maximumAttempts,omitempty"`
+	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
+	ExpirationTimestamp    *int64                `json:"expirationTimestamp,omitempty"`
+	LastFailureReason      *string               `json:"lastFailureReason,omitempty"`
+	StartedWorkerIdentity  string                `json:"startedWorkerIdentity,omitempty"`
+	LastWorkerIdentity     string                `json:"lastWorkerIdentity,omitempty"`
+	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
+	ScheduleID             int64                 `json:"scheduleID,omitempty"`
+	PauseInfo              *PauseInfo            `json:"pauseInfo,omitempty"`
+}
 
-	if v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
-		size += v.SignalExternalWorkflowExecutionInitiatedEventAttributes.ByteSize()
+// GetActivityID is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
 	}
+	return
+}
 
-	if v.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
-		size += v.SignalExternalWorkflowExecutionFailedEventAttributes.ByteSize()
+// GetState is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetState() (o PendingActivityState) {
+	if v != nil && v.State != nil {
+		return *v.State
 	}
+	return
+}
 
-	if v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
-		size += v.ExternalWorkflowExecutionSignaledEventAttributes.ByteSize()
+// GetHeartbeatDetails is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetHeartbeatDetails() (o []byte) {
+	if v != nil && v.HeartbeatDetails != nil {
+		return v.HeartbeatDetails
 	}
+	return
+}
 
-	if v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
-		size += v.StartChildWorkflowExecutionInitiatedEventAttributes.ByteSize()
+// GetLastHeartbeatTimestamp is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetLastHeartbeatTimestamp() (o int64) {
+	if v != nil && v.LastHeartbeatTimestamp != nil {
+		return *v.LastHeartbeatTimestamp
 	}
+	return
+}
 
-	if v.StartChildWorkflowExecutionFailedEventAttributes != nil {
-		size += v.StartChildWorkflowExecutionFailedEventAttributes.ByteSize()
+// GetAttempt is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
 	}
+	return
+}
 
-	if v.ChildWorkflowExecutionStartedEventAttributes != nil {
-		size += v.ChildWorkflowExecutionStartedEventAttributes.ByteSize()
+// GetMaximumAttempts is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetMaximumAttempts() (o int32) {
+	if v != nil {
+		return v.MaximumAttempts
 	}
+	return
+}
 
-	if v.ChildWorkflowExecutionCompletedEventAttributes != nil {
-		size += v.ChildWorkflowExecutionCompletedEventAttributes.ByteSize()
+// GetLastFailureReason is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetLastFailureReason() (o string) {
+	if v != nil && v.LastFailureReason != nil {
+		return *v.LastFailureReason
 	}
+	return
+}
 
-	if v.ChildWorkflowExecutionFailedEventAttributes != nil {
-		size += v.ChildWorkflowExecutionFailedEventAttributes.ByteSize()
+// GetStartedWorkerIdentity is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetStartedWorkerIdentity() (o string) {
+	if v != nil {
+		return v.StartedWorkerIdentity
 	}
+	return
+}
 
-	if v.ChildWorkflowExecutionCanceledEventAttributes != nil {
-		size += v.ChildWorkflowExecutionCanceledEventAttributes.ByteSize()
+// GetLastWorkerIdentity is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetLastWorkerIdentity() (o string) {
+	if v != nil {
+		return v.LastWorkerIdentity
 	}
+	return
+}
 
-	if v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
-		size += v.ChildWorkflowExecutionTimedOutEventAttributes.ByteSize()
+// GetLastFailureDetails is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
+	if v != nil && v.LastFailureDetails != nil {
+		return v.LastFailureDetails
 	}
+	return
+}
 
-	if v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
-		size += v.ChildWorkflowExecutionTerminatedEventAttributes.ByteSize()
+// GetScheduleID is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetScheduleID() (o int64) {
+	if v != nil {
+		return v.ScheduleID
 	}
+	return
+}
 
-	if v.UpsertWorkflowSearchAttributesEventAttributes != nil {
-		size += v.UpsertWorkflowSearchAttributesEventAttributes.ByteSize()
+// GetPauseInfo is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetPauseInfo() (o *PauseInfo) {
+	if v != nil && v.PauseInfo != nil {
+		return v.PauseInfo
 	}
-
-	return size
+	return
 }
 
-// HistoryEventFilterType is an internal type (TBD...)
-type HistoryEventFilterType int32
+// PendingActivityState is an internal type (TBD...)
+type PendingActivityState int32
 
 // Ptr is a helper function for getting pointer value
-func (e HistoryEventFilterType) Ptr() *HistoryEventFilterType {
+func (e PendingActivityState) Ptr() *PendingActivityState {
 	return &e
 }
 
-// String returns a readable string representation of HistoryEventFilterType.
-func (e HistoryEventFilterType) String() string {
+// String returns a readable string representation of PendingActivityState.
+func (e PendingActivityState) String() string {
 	w := int32(e)
 	switch w {
 	case 0:
-		return "ALL_EVENT"
+		return "SCHEDULED"
 	case 1:
-		return "CLOSE_EVENT"
+		return "STARTED"
+	case 2:
+		return "CANCEL_REQUESTED"
 	}
-	return fmt.Sprintf("HistoryEventFilterType(%d)", w)
+	return fmt.Sprintf("PendingActivityState(%d)", w)
 }
 
 // UnmarshalText parses enum value from string representation
-func (e *HistoryEventFilterType) UnmarshalText(value []byte) error {
+func (e *PendingActivityState) UnmarshalText(value []byte) error {
 	switch s := strings.ToUpper(string(value)); s {
-	case "ALL_EVENT":
-		*e = HistoryEventFilterTypeAllEvent
+	case "SCHEDULED":
+		*e = PendingActivityStateScheduled
 		return nil
-	case "CLOSE_EVENT":
-		*e = HistoryEventFilterTypeCloseEvent
+	case "STARTED":
+		*e = PendingActivityStateStarted
+		return nil
+	case "CANCEL_REQUESTED":
+		*e = PendingActivityStateCancelRequested
 		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "HistoryEventFilterType", err)
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingActivityState", err)
 		}
-		*e = HistoryEventFilterType(val)
+		*e = PendingActivityState(val)
 		return nil
 	}
 }
 
-// MarshalText encodes HistoryEventFilterType to text.
-func (e HistoryEventFilterType) MarshalText() ([]byte, error) {
+// MarshalText encodes PendingActivityState to text.
+func (e PendingActivityState) MarshalText() ([]byte, error) {
 	return []byte(e.String()), nil
 }
 
 const (
-	// HistoryEventFilterTypeAllEvent is an option for HistoryEventFilterType
-	HistoryEventFilterTypeAllEvent HistoryEventFilterType = iota
-	// HistoryEventFilterTypeCloseEvent is an option for HistoryEventFilterType
-	HistoryEventFilterTypeCloseEvent
+	// PendingActivityStateScheduled is an option for PendingActivityState
+	PendingActivityStateScheduled PendingActivityState = iota
+	// PendingActivityStateStarted is an option for PendingActivityState
+	PendingActivityStateStarted
+	// PendingActivityStateCancelRequested is an option for PendingActivityState
+	PendingActivityStateCancelRequested
 )
 
-// IndexedValueType is an internal type (TBD...)
-type IndexedValueType int32
+// PendingChildExecutionInfo is an internal type (TBD...)
+type PendingChildExecutionInfo struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowID        string             `json:"workflowID,omitempty"`
+	RunID             string             `json:"runID,omitempty"`
+	WorkflowTypeName  string             `json:"workflowTypeName,omitempty"`
+	InitiatedID       int64              `json:"initiatedID,omitempty"`
+	ParentClosePolicy *ParentClosePolicy `json:"parentClosePolicy,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PendingChildExecutionInfo) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *PendingChildExecutionInfo) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *PendingChildExecutionInfo) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetWorkflowTypeName is an internal getter (TBD...)
+func (v *PendingChildExecutionInfo) GetWorkflowTypeName() (o string) {
+	if v != nil {
+		return v.WorkflowTypeName
+	}
+	return
+}
+
+// PendingDecisionInfo is an internal type (TBD...)
+type PendingDecisionInfo struct {
+	State                      *PendingDecisionState `json:"state,omitempty"`
+	ScheduledTimestamp         *int64                `json:"scheduledTimestamp,omitempty"`
+	StartedTimestamp           *int64                `json:"startedTimestamp,omitempty"`
+	Attempt                    int64                 `json:"attempt,omitempty"`
+	OriginalScheduledTimestamp *int64                `json:"originalScheduledTimestamp,omitempty"`
+	ScheduleID                 int64                 `json:"scheduleID,omitempty"`
+}
+
+// PendingDecisionState is an internal type (TBD...)
+type PendingDecisionState int32
 
 // Ptr is a helper function for getting pointer value
-func (e IndexedValueType) Ptr() *IndexedValueType {
+func (e PendingDecisionState) Ptr() *PendingDecisionState {
 	return &e
 }
 
-// String returns a readable string representation of IndexedValueType.
-func (e IndexedValueType) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "STRING"
-	case 1:
-		return "KEYWORD"
-	case 2:
-		return "INT"
-	case 3:
-		return "DOUBLE"
-	case 4:
-		return "BOOL"
-	case 5:
-		return "DATETIME"
+// String returns a readable string representation of PendingDecisionState.
+func (e PendingDecisionState) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "SCHEDULED"
+	case 1:
+		return "STARTED"
 	}
-	return fmt.Sprintf("IndexedValueType(%d)", w)
+	return fmt.Sprintf("PendingDecisionState(%d)", w)
 }
 
 // UnmarshalText parses enum value from string representation
-func (e *IndexedValueType) UnmarshalText(value []byte) error {
+func (e *PendingDecisionState) UnmarshalText(value []byte) error {
 	switch s := strings.ToUpper(string(value)); s {
-	case "STRING":
-		*e = IndexedValueTypeString
-		return nil
-	case "KEYWORD":
-		*e = IndexedValueTypeKeyword
-		return nil
-	case "INT":
-		*e = IndexedValueTypeInt
-		return nil
-	case "DOUBLE":
-		*e = IndexedValueTypeDouble
-		return nil
-	case "BOOL":
-		*e = IndexedValueTypeBool
+	case "SCHEDULED":
+		*e = PendingDecisionStateScheduled
 		return nil
-	case "DATETIME":
-		*e = IndexedValueTypeDatetime
+	case "STARTED":
+		*e = PendingDecisionStateStarted
 		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "IndexedValueType", err)
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingDecisionState", err)
 		}
-		*e = IndexedValueType(val)
+		*e = PendingDecisionState(val)
 		return nil
 	}
 }
 
-// MarshalText encodes IndexedValueType to text.
-func (e IndexedValueType) MarshalText() ([]byte, error) {
+// MarshalText encodes PendingDecisionState to text.
+func (e PendingDecisionState) MarshalText() ([]byte, error) {
 	return []byte(e.String()), nil
 }
 
 const (
-	// IndexedValueTypeString is an option for IndexedValueType
-	IndexedValueTypeString IndexedValueType = iota
-	// IndexedValueTypeKeyword is an option for IndexedValueType
-	IndexedValueTypeKeyword
-	// IndexedValueTypeInt is an option for IndexedValueType
-	IndexedValueTypeInt
-	// IndexedValueTypeDouble is an option for IndexedValueType
-	IndexedValueTypeDouble
-	// IndexedValueTypeBool is an option for IndexedValueType
-	IndexedValueTypeBool
-	// IndexedValueTypeDatetime is an option for IndexedValueType
-	IndexedValueTypeDatetime
+	// PendingDecisionStateScheduled is an option for PendingDecisionState
+	PendingDecisionStateScheduled PendingDecisionState = iota
+	// PendingDecisionStateStarted is an option for PendingDecisionState
+	PendingDecisionStateStarted
 )
 
-// InternalDataInconsistencyError is an internal type (TBD...)
-type InternalDataInconsistencyError struct {
-	Message string `json:"message,required"`
+// PollForActivityTaskRequest is an internal type (TBD...)
+type PollForActivityTaskRequest struct {
+	Domain           string            `json:"domain,omitempty"`
+	TaskList         *TaskList         `json:"taskList,omitempty"`
+	Identity         string            `json:"identity,omitempty"`
+	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
 }
 
-// InternalServiceError is an internal type (TBD...)
-type InternalServiceError struct {
-	Message string `json:"message,required"`
+// GetDomain is an internal getter (TBD...)
+func (v *PollForActivityTaskRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
 }
 
-// GetMessage is an internal getter (TBD...)
-func (v *InternalServiceError) GetMessage() (o string) {
+// GetTaskList is an internal getter (TBD...)
+func (v *PollForActivityTaskRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PollForActivityTaskRequest) GetIdentity() (o string) {
 	if v != nil {
-		return v.Message
+		return v.Identity
 	}
 	return
 }
 
-// LimitExceededError is an internal type (TBD...)
-type LimitExceededError struct {
-	Message string `json:"message,required"`
+// PollForActivityTaskResponse is an internal type (TBD...)
+type PollForActivityTaskResponse struct {
+	TaskToken                       []byte             `json:"taskToken,omitempty"`
+	WorkflowExecution               *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID                      string             `json:"activityId,omitempty"`
+	ActivityType                    *ActivityType      `json:"activityType,omitempty"`
+	Input                           []byte             `json:"input,omitempty"`
+	ScheduledTimestamp              *int64             `json:"scheduledTimestamp,omitempty"`
+	ScheduleToCloseTimeoutSeconds   *int32             `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	StartedTimestamp                *int64             `json:"startedTimestamp,omitempty"`
+	StartToCloseTimeoutSeconds      *int32             `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds         *int32             `json:"heartbeatTimeoutSeconds,omitempty"`
+	Attempt                         int32              `json:"attempt,omitempty"`
+	ScheduledTimestampOfThisAttempt *int64             `json:"scheduledTimestampOfThisAttempt,omitempty"`
+	HeartbeatDetails                []byte             `json:"heartbeatDetails,omitempty"`
+	WorkflowType                    *WorkflowType      `json:"workflowType,omitempty"`
+	WorkflowDomain                  string             `json:"workflowDomain,omitempty"`
+	Header                          *Header            `json:"header,omitempty"`
+	AutoConfigHint                  *AutoConfigHint    `json:"autoConfigHint,omitempty"`
 }
 
-// ListArchivedWorkflowExecutionsRequest is an internal type (TBD...)
-type ListArchivedWorkflowExecutionsRequest struct {
-	Domain        string `json:"domain,omitempty"`
-	PageSize      int32  `json:"pageSize,omitempty"`
-	NextPageToken []byte `json:"nextPageToken,omitempty"`
-	Query         string `json:"query,omitempty"`
+// GetActivityID is an internal getter (TBD...)
+func (v *PollForActivityTaskResponse) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// PollForDecisionTaskRequest is an internal type (TBD...)
+type PollForDecisionTaskRequest struct {
+	Domain         string    `json:"domain,omitempty"`
+	TaskList       *TaskList `json:"taskList,omitempty"`
+	Identity       string    `json:"identity,omitempty"`
+	BinaryChecksum string    `json:"binaryChecksum,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *ListArchivedWorkflowExecutionsRequest) GetDomain() (o string) {
+func (v *PollForDecisionTaskRequest) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// GetPageSize is an internal getter (TBD...)
-func (v *ListArchivedWorkflowExecutionsRequest) GetPageSize() (o int32) {
+// GetTaskList is an internal getter (TBD...)
+func (v *PollForDecisionTaskRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PollForDecisionTaskRequest) GetIdentity() (o string) {
 	if v != nil {
-		return v.PageSize
+		return v.Identity
 	}
 	return
 }
 
-// GetQuery is an internal getter (TBD...)
-func (v *ListArchivedWorkflowExecutionsRequest) GetQuery() (o string) {
+// GetBinaryChecksum is an internal getter (TBD...)
+func (v *PollForDecisionTaskRequest) GetBinaryChecksum() (o string) {
 	if v != nil {
-		return v.Query
+		return v.BinaryChecksum
 	}
 	return
 }
 
-// ListArchivedWorkflowExecutionsResponse is an internal type (TBD...)
-type ListArchivedWorkflowExecutionsResponse struct {
-	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
-	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+// PollForDecisionTaskResponse is an internal type (TBD...)
+type PollForDecisionTaskResponse struct {
+	TaskToken                 []byte                    `json:"taskToken,omitempty"`
+	WorkflowExecution         *WorkflowExecution        `json:"workflowExecution,omitempty"`
+	WorkflowType              *WorkflowType             `json:"workflowType,omitempty"`
+	PreviousStartedEventID    *int64                    `json:"previousStartedEventId,omitempty"`
+	StartedEventID            int64                     `json:"startedEventId,omitempty"`
+	Attempt                   int64                     `json:"attempt,omitempty"`
+	BacklogCountHint          int64                     `json:"backlogCountHint,omitempty"`
+	History                   *History                  `json:"history,omitempty"`
+	NextPageToken             []byte                    `json:"nextPageToken,omitempty"`
+	Query                     *WorkflowQuery            `json:"query,omitempty"`
+	WorkflowExecutionTaskList *TaskList                 `json:"WorkflowExecutionTaskList,omitempty"`
+	ScheduledTimestamp        *int64                    `json:"scheduledTimestamp,omitempty"`
+	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
+	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
+	NextEventID               int64                     `json:"nextEventId,omitempty"`
+	TotalHistoryBytes         int64                     `json:"historySize,omitempty"`
+	AutoConfigHint            *AutoConfigHint           `json:"autoConfigHint,omitempty"`
+	Updates                   []*WorkflowUpdate         `json:"updates,omitempty"`
 }
 
-// GetExecutions is an internal getter (TBD...)
-func (v *ListArchivedWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
-	if v != nil && v.Executions != nil {
-		return v.Executions
+// GetTaskToken is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
 	}
 	return
 }
 
-// ListClosedWorkflowExecutionsRequest is an internal type (TBD...)
-type ListClosedWorkflowExecutionsRequest struct {
-	Domain          string                        `json:"domain,omitempty"`
-	MaximumPageSize int32                         `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte                        `json:"nextPageToken,omitempty"`
-	StartTimeFilter *StartTimeFilter              `json:"StartTimeFilter,omitempty"`
-	ExecutionFilter *WorkflowExecutionFilter      `json:"executionFilter,omitempty"`
-	TypeFilter      *WorkflowTypeFilter           `json:"typeFilter,omitempty"`
-	StatusFilter    *WorkflowExecutionCloseStatus `json:"statusFilter,omitempty"`
+// GetPreviousStartedEventID is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetPreviousStartedEventID() (o int64) {
+	if v != nil && v.PreviousStartedEventID != nil {
+		return *v.PreviousStartedEventID
+	}
+	return
+}
+
+// GetStartedEventID is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetStartedEventID() (o int64) {
+	if v != nil {
+		return v.StartedEventID
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetQueries is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetQueries() (o map[string]*WorkflowQuery) {
+	if v != nil && v.Queries != nil {
+		return v.Queries
+	}
+	return
+}
+
+// GetUpdates is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetUpdates() (o []*WorkflowUpdate) {
+	if v != nil && v.Updates != nil {
+		return v.Updates
+	}
+	return
+}
+
+// GetNextEventID is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetNextEventID() (o int64) {
+	if v != nil {
+		return v.NextEventID
+	}
+	return
+}
+
+func (v *PollForDecisionTaskResponse) GetHistorySize() (o int64) {
+	if v != nil {
+		return v.TotalHistoryBytes
+	}
+	return
+}
+
+// PollerInfo is an internal type (TBD...)
+type PollerInfo struct {
+	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
+	Identity       string  `json:"identity,omitempty"`
+	RatePerSecond  float64 `json:"ratePerSecond,omitempty"`
+}
+
+// GetLastAccessTime is an internal getter (TBD...)
+func (v *PollerInfo) GetLastAccessTime() (o int64) {
+	if v != nil && v.LastAccessTime != nil {
+		return *v.LastAccessTime
+	}
+	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ListClosedWorkflowExecutionsRequest) GetDomain() (o string) {
+// GetIdentity is an internal getter (TBD...)
+func (v *PollerInfo) GetIdentity() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.Identity
 	}
 	return
 }
 
-// GetMaximumPageSize is an internal getter (TBD...)
-func (v *ListClosedWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
+// GetRatePerSecond is an internal getter (TBD...)
+func (v *PollerInfo) GetRatePerSecond() (o float64) {
 	if v != nil {
-		return v.MaximumPageSize
+		return v.RatePerSecond
 	}
 	return
 }
 
-// GetStatusFilter is an internal getter (TBD...)
-func (v *ListClosedWorkflowExecutionsRequest) GetStatusFilter() (o WorkflowExecutionCloseStatus) {
-	if v != nil && v.StatusFilter != nil {
-		return *v.StatusFilter
-	}
-	return
+// QueryConsistencyLevel is an internal type (TBD...)
+type QueryConsistencyLevel int32
+
+// Ptr is a helper function for getting pointer value
+func (e QueryConsistencyLevel) Ptr() *QueryConsistencyLevel {
+	return &e
 }
 
-// ListClosedWorkflowExecutionsResponse is an internal type (TBD...)
-type ListClosedWorkflowExecutionsResponse struct {
-	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
-	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+// String returns a readable string representation of QueryConsistencyLevel.
+func (e QueryConsistencyLevel) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "EVENTUAL"
+	case 1:
+		return "STRONG"
+	}
+	return fmt.Sprintf("QueryConsistencyLevel(%d)", w)
 }
 
-// GetExecutions is an internal getter (TBD...)
-func (v *ListClosedWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
-	if v != nil && v.Executions != nil {
-		return v.Executions
+// UnmarshalText parses enum value from string representation
+func (e *QueryConsistencyLevel) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "EVENTUAL":
+		*e = QueryConsistencyLevelEventual
+		return nil
+	case "STRONG":
+		*e = QueryConsistencyLevelStrong
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryConsistencyLevel", err)
+		}
+		*e = QueryConsistencyLevel(val)
+		return nil
 	}
-	return
 }
 
-// ListDomainsRequest is an internal type (TBD...)
-type ListDomainsRequest struct {
-	PageSize      int32  `json:"pageSize,omitempty"`
-	NextPageToken []byte `json:"nextPageToken,omitempty"`
+// MarshalText encodes QueryConsistencyLevel to text.
+func (e QueryConsistencyLevel) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
 }
 
-// GetPageSize is an internal getter (TBD...)
-func (v *ListDomainsRequest) GetPageSize() (o int32) {
-	if v != nil {
-		return v.PageSize
-	}
-	return
+const (
+	// QueryConsistencyLevelEventual is an option for QueryConsistencyLevel
+	QueryConsistencyLevelEventual QueryConsistencyLevel = iota
+	// QueryConsistencyLevelStrong is an option for QueryConsistencyLevel
+	QueryConsistencyLevelStrong
+)
+
+// QueryFailedError is an internal type (TBD...)
+type QueryFailedError struct {
+	Message string `json:"message,required"`
 }
 
-// ListDomainsResponse is an internal type (TBD...)
-type ListDomainsResponse struct {
-	Domains       []*DescribeDomainResponse `json:"domains,omitempty"`
-	NextPageToken []byte                    `json:"nextPageToken,omitempty"`
+// QueryRejectCondition is an internal type (TBD...)
+type QueryRejectCondition int32
+
+// Ptr is a helper function for getting pointer value
+func (e QueryRejectCondition) Ptr() *QueryRejectCondition {
+	return &e
 }
 
-// GetDomains is an internal getter (TBD...)
-func (v *ListDomainsResponse) GetDomains() (o []*DescribeDomainResponse) {
-	if v != nil && v.Domains != nil {
-		return v.Domains
+// String returns a readable string representation of QueryRejectCondition.
+func (e QueryRejectCondition) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "NOT_OPEN"
+	case 1:
+		return "NOT_COMPLETED_CLEANLY"
 	}
-	return
+	return fmt.Sprintf("QueryRejectCondition(%d)", w)
 }
 
-// GetNextPageToken is an internal getter (TBD...)
-func (v *ListDomainsResponse) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+// UnmarshalText parses enum value from string representation
+func (e *QueryRejectCondition) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "NOT_OPEN":
+		*e = QueryRejectConditionNotOpen
+		return nil
+	case "NOT_COMPLETED_CLEANLY":
+		*e = QueryRejectConditionNotCompletedCleanly
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryRejectCondition", err)
+		}
+		*e = QueryRejectCondition(val)
+		return nil
 	}
-	return
 }
 
-// ListOpenWorkflowExecutionsRequest is an internal type (TBD...)
-type ListOpenWorkflowExecutionsRequest struct {
-	Domain          string                   `json:"domain,omitempty"`
-	MaximumPageSize int32                    `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte                   `json:"nextPageToken,omitempty"`
-	StartTimeFilter *StartTimeFilter         `json:"StartTimeFilter,omitempty"`
-	ExecutionFilter *WorkflowExecutionFilter `json:"executionFilter,omitempty"`
-	TypeFilter      *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
+// MarshalText encodes QueryRejectCondition to text.
+func (e QueryRejectCondition) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ListOpenWorkflowExecutionsRequest) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+const (
+	// QueryRejectConditionNotOpen is an option for QueryRejectCondition
+	QueryRejectConditionNotOpen QueryRejectCondition = iota
+	// QueryRejectConditionNotCompletedCleanly is an option for QueryRejectCondition
+	QueryRejectConditionNotCompletedCleanly
+)
+
+// QueryRejected is an internal type (TBD...)
+type QueryRejected struct {
+	CloseStatus *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+}
+
+// QueryResultType is an internal type (TBD...)
+type QueryResultType int32
+
+// Ptr is a helper function for getting pointer value
+func (e QueryResultType) Ptr() *QueryResultType {
+	return &e
+}
+
+// String returns a readable string representation of QueryResultType.
+func (e QueryResultType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "ANSWERED"
+	case 1:
+		return "FAILED"
 	}
-	return
+	return fmt.Sprintf("QueryResultType(%d)", w)
 }
 
-// GetMaximumPageSize is an internal getter (TBD...)
-func (v *ListOpenWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
-	if v != nil {
-		return v.MaximumPageSize
+// UnmarshalText parses enum value from string representation
+func (e *QueryResultType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "ANSWERED":
+		*e = QueryResultTypeAnswered
+		return nil
+	case "FAILED":
+		*e = QueryResultTypeFailed
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryResultType", err)
+		}
+		*e = QueryResultType(val)
+		return nil
 	}
-	return
 }
 
-// ListOpenWorkflowExecutionsResponse is an internal type (TBD...)
-type ListOpenWorkflowExecutionsResponse struct {
-	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
-	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+// MarshalText encodes QueryResultType to text.
+func (e QueryResultType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
 }
 
-// GetExecutions is an internal getter (TBD...)
-func (v *ListOpenWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
-	if v != nil && v.Executions != nil {
-		return v.Executions
+const (
+	// QueryResultTypeAnswered is an option for QueryResultType
+	QueryResultTypeAnswered QueryResultType = iota
+	// QueryResultTypeFailed is an option for QueryResultType
+	QueryResultTypeFailed
+)
+
+// QueryTaskCompletedType is an internal type (TBD...)
+type QueryTaskCompletedType int32
+
+// Ptr is a helper function for getting pointer value
+func (e QueryTaskCompletedType) Ptr() *QueryTaskCompletedType {
+	return &e
+}
+
+// String returns a readable string representation of QueryTaskCompletedType.
+func (e QueryTaskCompletedType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "COMPLETED"
+	case 1:
+		return "FAILED"
+	}
+	return fmt.Sprintf("QueryTaskCompletedType(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *QueryTaskCompletedType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "COMPLETED":
+		*e = QueryTaskCompletedTypeCompleted
+		return nil
+	case "FAILED":
+		*e = QueryTaskCompletedTypeFailed
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryTaskCompletedType", err)
+		}
+		*e = QueryTaskCompletedType(val)
+		return nil
 	}
-	return
 }
 
-// ListTaskListPartitionsRequest is an internal type (TBD...)
-type ListTaskListPartitionsRequest struct {
-	Domain   string    `json:"domain,omitempty"`
-	TaskList *TaskList `json:"taskList,omitempty"`
+// MarshalText encodes QueryTaskCompletedType to text.
+func (e QueryTaskCompletedType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// QueryTaskCompletedTypeCompleted is an option for QueryTaskCompletedType
+	QueryTaskCompletedTypeCompleted QueryTaskCompletedType = iota
+	// QueryTaskCompletedTypeFailed is an option for QueryTaskCompletedType
+	QueryTaskCompletedTypeFailed
+)
+
+// QueryWorkflowRequest is an internal type (TBD...)
+type QueryWorkflowRequest struct {
+	Domain                string                 `json:"domain,omitempty"`
+	Execution             *WorkflowExecution     `json:"execution,omitempty"`
+	Query                 *WorkflowQuery         `json:"query,omitempty"`
+	QueryRejectCondition  *QueryRejectCondition  `json:"queryRejectCondition,omitempty"`
+	QueryConsistencyLevel *QueryConsistencyLevel `json:"queryConsistencyLevel,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *ListTaskListPartitionsRequest) GetDomain() (o string) {
+func (v *QueryWorkflowRequest) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// GetTaskList is an internal getter (TBD...)
-func (v *ListTaskListPartitionsRequest) GetTaskList() (o *TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+// GetExecution is an internal getter (TBD...)
+func (v *QueryWorkflowRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
 	}
 	return
 }
 
-// ListTaskListPartitionsResponse is an internal type (TBD...)
-type ListTaskListPartitionsResponse struct {
-	ActivityTaskListPartitions []*TaskListPartitionMetadata `json:"activityTaskListPartitions,omitempty"`
-	DecisionTaskListPartitions []*TaskListPartitionMetadata `json:"decisionTaskListPartitions,omitempty"`
+// GetQuery is an internal getter (TBD...)
+func (v *QueryWorkflowRequest) GetQuery() (o *WorkflowQuery) {
+	if v != nil && v.Query != nil {
+		return v.Query
+	}
+	return
 }
 
-// GetTaskListsByDomainRequest is an internal type (TBD...)
-type GetTaskListsByDomainRequest struct {
-	Domain string `json:"domain,omitempty"`
+// GetQueryRejectCondition is an internal getter (TBD...)
+func (v *QueryWorkflowRequest) GetQueryRejectCondition() (o QueryRejectCondition) {
+	if v != nil && v.QueryRejectCondition != nil {
+		return *v.QueryRejectCondition
+	}
+	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *GetTaskListsByDomainRequest) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// GetQueryConsistencyLevel is an internal getter (TBD...)
+func (v *QueryWorkflowRequest) GetQueryConsistencyLevel() (o QueryConsistencyLevel) {
+	if v != nil && v.QueryConsistencyLevel != nil {
+		return *v.QueryConsistencyLevel
 	}
 	return
 }
 
-// GetTaskListsByDomainResponse is an internal type (TBD...)
-type GetTaskListsByDomainResponse struct {
-	DecisionTaskListMap map[string]*DescribeTaskListResponse `json:"decisionTaskListMap,omitempty"`
-	ActivityTaskListMap map[string]*DescribeTaskListResponse `json:"activityTaskListMap,omitempty"`
+// QueryWorkflowResponse is an internal type (TBD...)
+type QueryWorkflowResponse struct {
+	QueryResult   []byte         `json:"queryResult,omitempty"`
+	QueryRejected *QueryRejected `json:"queryRejected,omitempty"`
 }
 
-// GetDecisionTaskListMap is an internal getter (TBD...)
-func (v *GetTaskListsByDomainResponse) GetDecisionTaskListMap() (o map[string]*DescribeTaskListResponse) {
-	if v != nil && v.DecisionTaskListMap != nil {
-		return v.DecisionTaskListMap
+// GetQueryResult is an internal getter (TBD...)
+func (v *QueryWorkflowResponse) GetQueryResult() (o []byte) {
+	if v != nil && v.QueryResult != nil {
+		return v.QueryResult
 	}
 	return
 }
 
-// GetActivityTaskListMap is an internal getter (TBD...)
-func (v *GetTaskListsByDomainResponse) GetActivityTaskListMap() (o map[string]*DescribeTaskListResponse) {
-	if v != nil && v.ActivityTaskListMap != nil {
-		return v.ActivityTaskListMap
+// GetQueryRejected is an internal getter (TBD...)
+func (v *QueryWorkflowResponse) GetQueryRejected() (o *QueryRejected) {
+	if v != nil && v.QueryRejected != nil {
+		return v.QueryRejected
 	}
 	return
 }
 
-// ListWorkflowExecutionsRequest is an internal type (TBD...)
-type ListWorkflowExecutionsRequest struct {
-	Domain        string `json:"domain,omitempty"`
-	PageSize      int32  `json:"pageSize,omitempty"`
-	NextPageToken []byte `json:"nextPageToken,omitempty"`
-	Query         string `json:"query,omitempty"`
+// ReapplyEventsRequest is an internal type (TBD...)
+type ReapplyEventsRequest struct {
+	DomainName        string             `json:"domainName,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Events            *DataBlob          `json:"events,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ListWorkflowExecutionsRequest) GetDomain() (o string) {
+// GetDomainName is an internal getter (TBD...)
+func (v *ReapplyEventsRequest) GetDomainName() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.DomainName
 	}
 	return
 }
 
-// GetPageSize is an internal getter (TBD...)
-func (v *ListWorkflowExecutionsRequest) GetPageSize() (o int32) {
-	if v != nil {
-		return v.PageSize
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ReapplyEventsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// GetQuery is an internal getter (TBD...)
-func (v *ListWorkflowExecutionsRequest) GetQuery() (o string) {
-	if v != nil {
-		return v.Query
+// GetEvents is an internal getter (TBD...)
+func (v *ReapplyEventsRequest) GetEvents() (o *DataBlob) {
+	if v != nil && v.Events != nil {
+		return v.Events
 	}
 	return
 }
 
-// ListWorkflowExecutionsResponse is an internal type (TBD...)
-type ListWorkflowExecutionsResponse struct {
-	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
-	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+// RecordActivityTaskHeartbeatByIDRequest is an internal type (TBD...)
+type RecordActivityTaskHeartbeatByIDRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	WorkflowID string `json:"workflowID,omitempty"`
+	RunID      string `json:"runID,omitempty"`
+	ActivityID string `json:"activityID,omitempty"`
+	Details    []byte `json:"details,omitempty"`
+	Identity   string `json:"identity,omitempty"`
 }
 
-// GetExecutions is an internal getter (TBD...)
-func (v *ListWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
-	if v != nil && v.Executions != nil {
-		return v.Executions
+// GetDomain is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetNextPageToken is an internal getter (TBD...)
-func (v *ListWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+// GetWorkflowID is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
 	}
 	return
 }
 
-// MarkerRecordedEventAttributes is an internal type (TBD...)
-type MarkerRecordedEventAttributes struct {
-	MarkerName                   string  `json:"markerName,omitempty"`
-	Details                      []byte  `json:"details,omitempty"`
-	DecisionTaskCompletedEventID int64   `json:"decisionTaskCompletedEventId,omitempty"`
-	Header                       *Header `json:"header,omitempty"`
-}
-
-// GetMarkerName is an internal getter (TBD...)
-func (v *MarkerRecordedEventAttributes) GetMarkerName() (o string) {
+// GetRunID is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetRunID() (o string) {
 	if v != nil {
-		return v.MarkerName
+		return v.RunID
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *MarkerRecordedEventAttributes) ByteSize() uint64 {
-	return 0
-}
-
-// Memo is an internal type (TBD...)
-type Memo struct {
-	Fields map[string][]byte `json:"fields,omitempty"`
-}
-
-// GetFields is an internal getter (TBD...)
-func (v *Memo) GetFields() (o map[string][]byte) {
-	if v != nil && v.Fields != nil {
-		return v.Fields
+// GetActivityID is an internal getter (TBD...)
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
 	}
 	return
 }
 
-// ParentClosePolicy is an internal type (TBD...)
-type ParentClosePolicy int32
-
-// Ptr is a helper function for getting pointer value
-func (e ParentClosePolicy) Ptr() *ParentClosePolicy {
-	return &e
-}
-
-// String returns a readable string representation of ParentClosePolicy.
-func (e ParentClosePolicy) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "ABANDON"
-	case 1:
-		return "REQUEST_CANCEL"
-	case 2:
-		return "TERMINATE"
-	}
-	return fmt.Sprintf("ParentClosePolicy(%d)", w)
-}
-
-// UnmarshalText parses enum value from string representation
-func (e *ParentClosePolicy) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "ABANDON":
-		*e = ParentClosePolicyAbandon
-		return nil
-	case "REQUEST_CANCEL":
-		*e = ParentClosePolicyRequestCancel
-		return nil
-	case "TERMINATE":
-		*e = ParentClosePolicyTerminate
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ParentClosePolicy", err)
-		}
-		*e = ParentClosePolicy(val)
-		return nil
-	}
+// RecordActivityTaskHeartbeatRequest is an internal type (TBD...)
+type RecordActivityTaskHeartbeatRequest struct {
+	TaskToken []byte `json:"taskToken,omitempty"`
+	Details   []byte `json:"details,omitempty"`
+	Identity  string `json:"identity,omitempty"`
 }
 
-// MarshalText encodes ParentClosePolicy to text.
-func (e ParentClosePolicy) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// RecordActivityTaskHeartbeatResponse is an internal type (TBD...)
+type RecordActivityTaskHeartbeatResponse struct {
+	CancelRequested bool `json:"cancelRequested,omitempty"`
 }
 
-const (
-	// ParentClosePolicyAbandon is an option for ParentClosePolicy
-	ParentClosePolicyAbandon ParentClosePolicy = iota
-	// ParentClosePolicyRequestCancel is an option for ParentClosePolicy
-	ParentClosePolicyRequestCancel
-	// ParentClosePolicyTerminate is an option for ParentClosePolicy
-	ParentClosePolicyTerminate
-)
-
-// PendingActivityInfo is an internal type (TBD...)
-type PendingActivityInfo struct {
-	ActivityID             string                `json:"activityID,omitempty"`
-	ActivityType           *ActivityType         `json:"activityType,omitempty"`
-	State                  *PendingActivityState `json:"state,omitempty"`
-	HeartbeatDetails       []byte                `json:"heartbeatDetails,omitempty"`
-	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
-	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
-	Attempt                int32                 `json:"attempt,omitempty"`
-	MaximumAttempts        int32                 `json:"maximumAttempts,omitempty"`
-	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
-	ExpirationTimestamp    *int64                `json:"expirationTimestamp,omitempty"`
-	LastFailureReason      *string               `json:"lastFailureReason,omitempty"`
-	StartedWorkerIdentity  string                `json:"startedWorkerIdentity,omitempty"`
-	LastWorkerIdentity     string                `json:"lastWorkerIdentity,omitempty"`
-	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
-	ScheduleID             int64                 `json:"scheduleID,omitempty"`
+// RecordMarkerDecisionAttributes is an internal type (TBD...)
+type RecordMarkerDecisionAttributes struct {
+	MarkerName string  `json:"markerName,omitempty"`
+	Details    []byte  `json:"details,omitempty"`
+	Header     *Header `json:"header,omitempty"`
 }
 
-// GetActivityID is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetActivityID() (o string) {
+// GetMarkerName is an internal getter (TBD...)
+func (v *RecordMarkerDecisionAttributes) GetMarkerName() (o string) {
 	if v != nil {
-		return v.ActivityID
+		return v.MarkerName
 	}
 	return
 }
 
-// GetState is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetState() (o PendingActivityState) {
-	if v != nil && v.State != nil {
-		return *v.State
-	}
-	return
+// RefreshWorkflowTasksRequest is an internal type (TBD...)
+type RefreshWorkflowTasksRequest struct {
+	Domain    string             `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
 }
 
-// GetHeartbeatDetails is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetHeartbeatDetails() (o []byte) {
-	if v != nil && v.HeartbeatDetails != nil {
-		return v.HeartbeatDetails
+// GetDomain is an internal getter (TBD...)
+func (v *RefreshWorkflowTasksRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetLastHeartbeatTimestamp is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetLastHeartbeatTimestamp() (o int64) {
-	if v != nil && v.LastHeartbeatTimestamp != nil {
-		return *v.LastHeartbeatTimestamp
+// GetExecution is an internal getter (TBD...)
+func (v *RefreshWorkflowTasksRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
 	}
 	return
 }
 
-// GetAttempt is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetAttempt() (o int32) {
-	if v != nil {
-		return v.Attempt
-	}
-	return
+// RegisterDomainRequest is an internal type (TBD...)
+type RegisterDomainRequest struct {
+	Name                                   string                             `json:"name,omitempty"`
+	Description                            string                             `json:"description,omitempty"`
+	OwnerEmail                             string                             `json:"ownerEmail,omitempty"`
+	WorkflowExecutionRetentionPeriodInDays int32                              `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
+	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+	ActiveClusterName                      string                             `json:"activeClusterName,omitempty"`
+	ActiveClusters                         *ActiveClusters                    `json:"activeClusters,omitempty"`
+	Data                                   map[string]string                  `json:"data,omitempty"`
+	SecurityToken                          string                             `json:"securityToken,omitempty"`
+	IsGlobalDomain                         bool                               `json:"isGlobalDomain,omitempty"`
+	HistoryArchivalStatus                  *ArchivalStatus                    `json:"historyArchivalStatus,omitempty"`
+	HistoryArchivalURI                     string                             `json:"historyArchivalURI,omitempty"`
+	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
+	VisibilityArchivalURI                  string                             `json:"visibilityArchivalURI,omitempty"`
 }
 
-// GetMaximumAttempts is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetMaximumAttempts() (o int32) {
+// GetName is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetName() (o string) {
 	if v != nil {
-		return v.MaximumAttempts
+		return v.Name
 	}
 	return
 }
 
-// GetLastFailureReason is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetLastFailureReason() (o string) {
-	if v != nil && v.LastFailureReason != nil {
-		return *v.LastFailureReason
+// GetDescription is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetDescription() (o string) {
+	if v != nil {
+		return v.Description
 	}
 	return
 }
 
-// GetStartedWorkerIdentity is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetStartedWorkerIdentity() (o string) {
+// GetOwnerEmail is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetOwnerEmail() (o string) {
 	if v != nil {
-		return v.StartedWorkerIdentity
+		return v.OwnerEmail
 	}
 	return
 }
 
-// GetLastWorkerIdentity is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetLastWorkerIdentity() (o string) {
+// GetWorkflowExecutionRetentionPeriodInDays is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
 	if v != nil {
-		return v.LastWorkerIdentity
+		return v.WorkflowExecutionRetentionPeriodInDays
 	}
 	return
 }
 
-// GetLastFailureDetails is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
-	if v != nil && v.LastFailureDetails != nil {
-		return v.LastFailureDetails
+// GetEmitMetric is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetEmitMetric() (o bool) {
+	if v != nil && v.EmitMetric != nil {
+		return *v.EmitMetric
 	}
+	o = true
 	return
 }
 
-// GetScheduleID is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetScheduleID() (o int64) {
+// GetActiveClusterName is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetActiveClusterName() (o string) {
 	if v != nil {
-		return v.ScheduleID
+		return v.ActiveClusterName
 	}
 	return
 }
 
-// PendingActivityState is an internal type (TBD...)
-type PendingActivityState int32
-
-// Ptr is a helper function for getting pointer value
-func (e PendingActivityState) Ptr() *PendingActivityState {
-	return &e
-}
-
-// String returns a readable string representation of PendingActivityState.
-func (e PendingActivityState) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "SCHEDULED"
-	case 1:
-		return "STARTED"
-	case 2:
-		return "CANCEL_REQUESTED"
-	}
-	return fmt.Sprintf("PendingActivityState(%d)", w)
-}
-
-// UnmarshalText parses enum value from string representation
-func (e *PendingActivityState) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "SCHEDULED":
-		*e = PendingActivityStateScheduled
-		return nil
-	case "STARTED":
-		*e = PendingActivityStateStarted
-		return nil
-	case "CANCEL_REQUESTED":
-		*e = PendingActivityStateCancelRequested
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingActivityState", err)
-		}
-		*e = PendingActivityState(val)
-		return nil
-	}
-}
-
-// MarshalText encodes PendingActivityState to text.
-func (e PendingActivityState) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
-}
-
-const (
-	// PendingActivityStateScheduled is an option for PendingActivityState
-	PendingActivityStateScheduled PendingActivityState = iota
-	// PendingActivityStateStarted is an option for PendingActivityState
-	PendingActivityStateStarted
-	// PendingActivityStateCancelRequested is an option for PendingActivityState
-	PendingActivityStateCancelRequested
-)
-
-// PendingChildExecutionInfo is an internal type (TBD...)
-type PendingChildExecutionInfo struct {
-	Domain            string             `json:"domain,omitempty"`
-	WorkflowID        string             `json:"workflowID,omitempty"`
-	RunID             string             `json:"runID,omitempty"`
-	WorkflowTypeName  string             `json:"workflowTypeName,omitempty"`
-	InitiatedID       int64              `json:"initiatedID,omitempty"`
-	ParentClosePolicy *ParentClosePolicy `json:"parentClosePolicy,omitempty"`
-}
-
-// GetDomain is an internal getter (TBD...)
-func (v *PendingChildExecutionInfo) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// GetData is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetData() (o map[string]string) {
+	if v != nil && v.Data != nil {
+		return v.Data
 	}
 	return
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *PendingChildExecutionInfo) GetWorkflowID() (o string) {
+// GetIsGlobalDomain is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetIsGlobalDomain() (o bool) {
 	if v != nil {
-		return v.WorkflowID
+		return v.IsGlobalDomain
 	}
 	return
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *PendingChildExecutionInfo) GetRunID() (o string) {
+// GetHistoryArchivalURI is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetHistoryArchivalURI() (o string) {
 	if v != nil {
-		return v.RunID
+		return v.HistoryArchivalURI
 	}
 	return
 }
 
-// GetWorkflowTypeName is an internal getter (TBD...)
-func (v *PendingChildExecutionInfo) GetWorkflowTypeName() (o string) {
+// GetVisibilityArchivalURI is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetVisibilityArchivalURI() (o string) {
 	if v != nil {
-		return v.WorkflowTypeName
+		return v.VisibilityArchivalURI
 	}
 	return
 }
 
-// PendingDecisionInfo is an internal type (TBD...)
-type PendingDecisionInfo struct {
-	State                      *PendingDecisionState `json:"state,omitempty"`
-	ScheduledTimestamp         *int64                `json:"scheduledTimestamp,omitempty"`
-	StartedTimestamp           *int64                `json:"startedTimestamp,omitempty"`
-	Attempt                    int64                 `json:"attempt,omitempty"`
-	OriginalScheduledTimestamp *int64                `json:"originalScheduledTimestamp,omitempty"`
-	ScheduleID                 int64                 `json:"scheduleID,omitempty"`
-}
-
-// PendingDecisionState is an internal type (TBD...)
-type PendingDecisionState int32
-
-// Ptr is a helper function for getting pointer value
-func (e PendingDecisionState) Ptr() *PendingDecisionState {
-	return &e
+// Size returns the approximate memory used in bytes
+func (v *RegisterDomainRequest) ByteSize() uint64 {
+	return 0
 }
 
-// String returns a readable string representation of PendingDecisionState.
-func (e PendingDecisionState) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "SCHEDULED"
-	case 1:
-		return "STARTED"
-	}
-	return fmt.Sprintf("PendingDecisionState(%d)", w)
+// RemoteSyncMatchedError is an internal type (TBD...)
+type RemoteSyncMatchedError struct {
+	Message string `json:"message,required"`
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *PendingDecisionState) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "SCHEDULED":
-		*e = PendingDecisionStateScheduled
-		return nil
-	case "STARTED":
-		*e = PendingDecisionStateStarted
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingDecisionState", err)
-		}
-		*e = PendingDecisionState(val)
-		return nil
-	}
+// Size returns the approximate memory used in bytes
+func (v *RemoteSyncMatchedError) ByteSize() uint64 {
+	return 0
 }
 
-// MarshalText encodes PendingDecisionState to text.
-func (e PendingDecisionState) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// RemoveTaskRequest is an internal type (TBD...)
+type RemoveTaskRequest struct {
+	ShardID             int32  `json:"shardID,omitempty"`
+	Type                *int32 `json:"type,omitempty"`
+	TaskID              int64  `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64 `json:"visibilityTimestamp,omitempty"`
+	ClusterName         string `json:"clusterName,omitempty"`
 }
 
-const (
-	// PendingDecisionStateScheduled is an option for PendingDecisionState
-	PendingDecisionStateScheduled PendingDecisionState = iota
-	// PendingDecisionStateStarted is an option for PendingDecisionState
-	PendingDecisionStateStarted
-)
+// GetShardID is an internal getter (TBD...)
+func (v *RemoveTaskRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
 
-// PollForActivityTaskRequest is an internal type (TBD...)
-type PollForActivityTaskRequest struct {
-	Domain           string            `json:"domain,omitempty"`
-	TaskList         *TaskList         `json:"taskList,omitempty"`
-	Identity         string            `json:"identity,omitempty"`
-	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+// GetType is an internal getter (TBD...)
+func (v *RemoveTaskRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *PollForActivityTaskRequest) GetDomain() (o string) {
+// GetTaskID is an internal getter (TBD...)
+func (v *RemoveTaskRequest) GetTaskID() (o int64) {
 	if v != nil {
-		return v.Domain
+		return v.TaskID
 	}
 	return
 }
 
-// GetTaskList is an internal getter (TBD...)
-func (v *PollForActivityTaskRequest) GetTaskList() (o *TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+// GetVisibilityTimestamp is an internal getter (TBD...)
+func (v *RemoveTaskRequest) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
 	}
 	return
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *PollForActivityTaskRequest) GetIdentity() (o string) {
+// GetClusterName is an internal getter (TBD...)
+func (v *RemoveTaskRequest) GetClusterName() (o string) {
 	if v != nil {
-		return v.Identity
+		return v.ClusterName
 	}
 	return
 }
 
-// PollForActivityTaskResponse is an internal type (TBD...)
-type PollForActivityTaskResponse struct {
-	TaskToken                       []byte             `json:"taskToken,omitempty"`
-	WorkflowExecution               *WorkflowExecution `json:"workflowExecution,omitempty"`
-	ActivityID                      string             `json:"activityId,omitempty"`
-	ActivityType                    *ActivityType      `json:"activityType,omitempty"`
-	Input                           []byte             `json:"input,omitempty"`
-	ScheduledTimestamp              *int64             `json:"scheduledTimestamp,omitempty"`
-	ScheduleToCloseTimeoutSeconds   *int32             `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	StartedTimestamp                *int64             `json:"startedTimestamp,omitempty"`
-	StartToCloseTimeoutSeconds      *int32             `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds         *int32             `json:"heartbeatTimeoutSeconds,omitempty"`
-	Attempt                         int32              `json:"attempt,omitempty"`
-	ScheduledTimestampOfThisAttempt *int64             `json:"scheduledTimestampOfThisAttempt,omitempty"`
-	HeartbeatDetails                []byte             `json:"heartbeatDetails,omitempty"`
-	WorkflowType                    *WorkflowType      `json:"workflowType,omitempty"`
-	WorkflowDomain                  string             `json:"workflowDomain,omitempty"`
-	Header                          *Header            `json:"header,omitempty"`
-	AutoConfigHint                  *AutoConfigHint    `json:"autoConfigHint,omitempty"`
+// Size returns the approximate memory used in bytes
+func (v *RemoveTaskRequest) ByteSize() uint64 {
+	return 0
+}
+
+// RequestCancelActivityTaskDecisionAttributes is an internal type (TBD...)
+type RequestCancelActivityTaskDecisionAttributes struct {
+	ActivityID string `json:"activityId,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
-func (v *PollForActivityTaskResponse) GetActivityID() (o string) {
+func (v *RequestCancelActivityTaskDecisionAttributes) GetActivityID() (o string) {
 	if v != nil {
 		return v.ActivityID
 	}
 	return
 }
 
-// PollForDecisionTaskRequest is an internal type (TBD...)
-type PollForDecisionTaskRequest struct {
-	Domain         string    `json:"domain,omitempty"`
-	TaskList       *TaskList `json:"taskList,omitempty"`
-	Identity       string    `json:"identity,omitempty"`
-	BinaryChecksum string    `json:"binaryChecksum,omitempty"`
+// Size returns the approximate memory used in bytes
+func (v *RequestCancelActivityTaskDecisionAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// RequestCancelActivityTaskFailedEventAttributes is an internal type (TBD...)
+type RequestCancelActivityTaskFailedEventAttributes struct {
+	ActivityID                   string `json:"activityId,omitempty"`
+	Cause                        string `json:"cause,omitempty"`
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+}
+
+// Size returns the approximate memory used in bytes
+func (v *RequestCancelActivityTaskFailedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// RequestCancelExternalWorkflowExecutionDecisionAttributes is an internal type (TBD...)
+type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
+	Domain            string `json:"domain,omitempty"`
+	WorkflowID        string `json:"workflowId,omitempty"`
+	RunID             string `json:"runId,omitempty"`
+	Control           []byte `json:"control,omitempty"`
+	ChildWorkflowOnly bool   `json:"childWorkflowOnly,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *PollForDecisionTaskRequest) GetDomain() (o string) {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// GetTaskList is an internal getter (TBD...)
-func (v *PollForDecisionTaskRequest) GetTaskList() (o *TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+// GetWorkflowID is an internal getter (TBD...)
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
 	}
 	return
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *PollForDecisionTaskRequest) GetIdentity() (o string) {
+// GetRunID is an internal getter (TBD...)
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetRunID() (o string) {
 	if v != nil {
-		return v.Identity
+		return v.RunID
 	}
 	return
 }
 
-// GetBinaryChecksum is an internal getter (TBD...)
-func (v *PollForDecisionTaskRequest) GetBinaryChecksum() (o string) {
+// Size returns the approximate memory used in bytes
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// RequestCancelExternalWorkflowExecutionFailedEventAttributes is an internal type (TBD...)
+type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
+	Cause                        *CancelExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
+	DecisionTaskCompletedEventID int64                                       `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       string                                      `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
+	InitiatedEventID             int64                                       `json:"initiatedEventId,omitempty"`
+	Control                      []byte                                      `json:"control,omitempty"`
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
 	if v != nil {
-		return v.BinaryChecksum
+		return v.DecisionTaskCompletedEventID
 	}
 	return
 }
 
-// PollForDecisionTaskResponse is an internal type (TBD...)
-type PollForDecisionTaskResponse struct {
-	TaskToken                 []byte                    `json:"taskToken,omitempty"`
-	WorkflowExecution         *WorkflowExecution        `json:"workflowExecution,omitempty"`
-	WorkflowType              *WorkflowType             `json:"workflowType,omitempty"`
-	PreviousStartedEventID    *int64                    `json:"previousStartedEventId,omitempty"`
-	StartedEventID            int64                     `json:"startedEventId,omitempty"`
-	Attempt                   int64                     `json:"attempt,omitempty"`
-	BacklogCountHint          int64                     `json:"backlogCountHint,omitempty"`
-	History                   *History                  `json:"history,omitempty"`
-	NextPageToken             []byte                    `json:"nextPageToken,omitempty"`
-	Query                     *WorkflowQuery            `json:"query,omitempty"`
-	WorkflowExecutionTaskList *TaskList                 `json:"WorkflowExecutionTaskList,omitempty"`
-	ScheduledTimestamp        *int64                    `json:"scheduledTimestamp,omitempty"`
-	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
-	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
-	NextEventID               int64                     `json:"nextEventId,omitempty"`
-	TotalHistoryBytes         int64                     `json:"historySize,omitempty"`
-	AutoConfigHint            *AutoConfigHint           `json:"autoConfigHint,omitempty"`
+// GetDomain is an internal getter (TBD...)
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
 }
 
-// GetTaskToken is an internal getter (TBD...)
-func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
-	if v != nil && v.TaskToken != nil {
-		return v.TaskToken
+// GetInitiatedEventID is an internal getter (TBD...)
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventID() (o int64) {
+	if v != nil {
+		return v.InitiatedEventID
 	}
 	return
 }
 
-// GetPreviousStartedEventID is an internal getter (TBD...)
-func (v *PollForDecisionTaskResponse) GetPreviousStartedEventID() (o int64) {
-	if v != nil && v.PreviousStartedEventID != nil {
-		return *v.PreviousStartedEventID
+// Size returns the approximate memory used in bytes
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// RequestCancelExternalWorkflowExecutionInitiatedEventAttributes is an internal type (TBD...)
+type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
+	DecisionTaskCompletedEventID int64              `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       string             `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Control                      []byte             `json:"control,omitempty"`
+	ChildWorkflowOnly            bool               `json:"childWorkflowOnly,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetStartedEventID is an internal getter (TBD...)
-func (v *PollForDecisionTaskResponse) GetStartedEventID() (o int64) {
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetChildWorkflowOnly is an internal getter (TBD...)
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
 	if v != nil {
-		return v.StartedEventID
+		return v.ChildWorkflowOnly
 	}
 	return
 }
 
-// GetAttempt is an internal getter (TBD...)
-func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
+// Size returns the approximate memory used in bytes
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// RequestCancelWorkflowExecutionRequest is an internal type (TBD...)
+type RequestCancelWorkflowExecutionRequest struct {
+	Domain              string             `json:"domain,omitempty"`
+	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity            string             `json:"identity,omitempty"`
+	RequestID           string             `json:"requestId,omitempty"`
+	Cause               string             `json:"cause,omitempty"`
+	FirstExecutionRunID string             `json:"first_execution_run_id,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil {
-		return v.Attempt
+		return v.Domain
 	}
 	return
 }
 
-// GetQueries is an internal getter (TBD...)
-func (v *PollForDecisionTaskResponse) GetQueries() (o map[string]*WorkflowQuery) {
-	if v != nil && v.Queries != nil {
-		return v.Queries
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// GetNextEventID is an internal getter (TBD...)
-func (v *PollForDecisionTaskResponse) GetNextEventID() (o int64) {
+// GetRequestID is an internal getter (TBD...)
+func (v *RequestCancelWorkflowExecutionRequest) GetRequestID() (o string) {
 	if v != nil {
-		return v.NextEventID
+		return v.RequestID
 	}
 	return
 }
 
-func (v *PollForDecisionTaskResponse) GetHistorySize() (o int64) {
+// GetFirstExecutionRunID is an internal getter (TBD...)
+func (v *RequestCancelWorkflowExecutionRequest) GetFirstExecutionRunID() (o string) {
 	if v != nil {
-		return v.TotalHistoryBytes
+		return v.FirstExecutionRunID
 	}
 	return
 }
 
-// PollerInfo is an internal type (TBD...)
-type PollerInfo struct {
-	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
-	Identity       string  `json:"identity,omitempty"`
-	RatePerSecond  float64 `json:"ratePerSecond,omitempty"`
+// Size returns the approximate memory used in bytes
+func (v *RequestCancelWorkflowExecutionRequest) ByteSize() uint64 {
+	return 0
+}
+
+// ResetPointInfo is an internal type (TBD...)
+type ResetPointInfo struct {
+	BinaryChecksum           string `json:"binaryChecksum,omitempty"`
+	RunID                    string `json:"runId,omitempty"`
+	FirstDecisionCompletedID int64  `json:"firstDecisionCompletedId,omitempty"`
+	CreatedTimeNano          *int64 `json:"createdTimeNano,omitempty"`
+	ExpiringTimeNano         *int64 `json:"expiringTimeNano,omitempty"`
+	Resettable               bool   `json:"resettable,omitempty"`
 }
 
-// GetLastAccessTime is an internal getter (TBD...)
-func (v *PollerInfo) GetLastAccessTime() (o int64) {
-	if v != nil && v.LastAccessTime != nil {
-		return *v.LastAccessTime
+// GetBinaryChecksum is an internal getter (TBD...)
+func (v *ResetPointInfo) GetBinaryChecksum() (o string) {
+	if v != nil {
+		return v.BinaryChecksum
 	}
 	return
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *PollerInfo) GetIdentity() (o string) {
+// GetRunID is an internal getter (TBD...)
+func (v *ResetPointInfo) GetRunID() (o string) {
 	if v != nil {
-		return v.Identity
+		return v.RunID
 	}
 	return
 }
 
-// GetRatePerSecond is an internal getter (TBD...)
-func (v *PollerInfo) GetRatePerSecond() (o float64) {
+// GetFirstDecisionCompletedID is an internal getter (TBD...)
+func (v *ResetPointInfo) GetFirstDecisionCompletedID() (o int64) {
 	if v != nil {
-		return v.RatePerSecond
+		return v.FirstDecisionCompletedID
 	}
 	return
 }
 
-// QueryConsistencyLevel is an internal type (TBD...)
-type QueryConsistencyLevel int32
-
-// Ptr is a helper function for getting pointer value
-func (e QueryConsistencyLevel) Ptr() *QueryConsistencyLevel {
-	return &e
+// GetCreatedTimeNano is an internal getter (TBD...)
+func (v *ResetPointInfo) GetCreatedTimeNano() (o int64) {
+	if v != nil && v.CreatedTimeNano != nil {
+		return *v.CreatedTimeNano
+	}
+	return
 }
 
-// String returns a readable string representation of QueryConsistencyLevel.
-func (e QueryConsistencyLevel) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "EVENTUAL"
-	case 1:
-		return "STRONG"
+// GetExpiringTimeNano is an internal getter (TBD...)
+func (v *ResetPointInfo) GetExpiringTimeNano() (o int64) {
+	if v != nil && v.ExpiringTimeNano != nil {
+		return *v.ExpiringTimeNano
 	}
-	return fmt.Sprintf("QueryConsistencyLevel(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *QueryConsistencyLevel) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "EVENTUAL":
-		*e = QueryConsistencyLevelEventual
-		return nil
-	case "STRONG":
-		*e = QueryConsistencyLevelStrong
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryConsistencyLevel", err)
-		}
-		*e = QueryConsistencyLevel(val)
-		return nil
+// GetResettable is an internal getter (TBD...)
+func (v *ResetPointInfo) GetResettable() (o bool) {
+	if v != nil {
+		return v.Resettable
 	}
+	return
 }
 
-// MarshalText encodes QueryConsistencyLevel to text.
-func (e QueryConsistencyLevel) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// Size returns the approximate memory used in bytes
+func (v *ResetPointInfo) ByteSize() uint64 {
+	return 0
 }
 
-const (
-	// QueryConsistencyLevelEventual is an option for QueryConsistencyLevel
-	QueryConsistencyLevelEventual QueryConsistencyLevel = iota
-	// QueryConsistencyLevelStrong is an option for QueryConsistencyLevel
-	QueryConsistencyLevelStrong
-)
-
-// QueryFailedError is an internal type (TBD...)
-type QueryFailedError struct {
-	Message string `json:"message,required"`
+// ResetPoints is an internal type (TBD...)
+type ResetPoints struct {
+	Points []*ResetPointInfo `json:"points,omitempty"`
 }
 
-// QueryRejectCondition is an internal type (TBD...)
-type QueryRejectCondition int32
-
-// Ptr is a helper function for getting pointer value
-func (e QueryRejectCondition) Ptr() *QueryRejectCondition {
-	return &e
+// Size returns the approximate memory used in bytes
+func (v *ResetPoints) ByteSize() uint64 {
+	return 0
 }
 
-// String returns a readable string representation of QueryRejectCondition.
-func (e QueryRejectCondition) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "NOT_OPEN"
-	case 1:
-		return "NOT_COMPLETED_CLEANLY"
-	}
-	return fmt.Sprintf("QueryRejectCondition(%d)", w)
+// ResetQueueRequest is an internal type (TBD...)
+type ResetQueueRequest struct {
+	ShardID     int32  `json:"shardID,omitempty"`
+	ClusterName string `json:"clusterName,omitempty"`
+	Type        *int32 `json:"type,omitempty"`
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *QueryRejectCondition) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "NOT_OPEN":
-		*e = QueryRejectConditionNotOpen
-		return nil
-	case "NOT_COMPLETED_CLEANLY":
-		*e = QueryRejectConditionNotCompletedCleanly
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryRejectCondition", err)
-		}
-		*e = QueryRejectCondition(val)
-		return nil
+// GetShardID is an internal getter (TBD...)
+func (v *ResetQueueRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
 	}
+	return
 }
 
-// MarshalText encodes QueryRejectCondition to text.
-func (e QueryRejectCondition) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
-}
-
-const (
-	// QueryRejectConditionNotOpen is an option for QueryRejectCondition
-	QueryRejectConditionNotOpen QueryRejectCondition = iota
-	// QueryRejectConditionNotCompletedCleanly is an option for QueryRejectCondition
-	QueryRejectConditionNotCompletedCleanly
-)
-
-// QueryRejected is an internal type (TBD...)
-type QueryRejected struct {
-	CloseStatus *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
-}
-
-// QueryResultType is an internal type (TBD...)
-type QueryResultType int32
-
-// Ptr is a helper function for getting pointer value
-func (e QueryResultType) Ptr() *QueryResultType {
-	return &e
-}
-
-// String returns a readable string representation of QueryResultType.
-func (e QueryResultType) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "ANSWERED"
-	case 1:
-		return "FAILED"
+// GetClusterName is an internal getter (TBD...)
+func (v *ResetQueueRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
 	}
-	return fmt.Sprintf("QueryResultType(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *QueryResultType) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "ANSWERED":
-		*e = QueryResultTypeAnswered
-		return nil
-	case "FAILED":
-		*e = QueryResultTypeFailed
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryResultType", err)
-		}
-		*e = QueryResultType(val)
-		return nil
+// GetType is an internal getter (TBD...)
+func (v *ResetQueueRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
 	}
+	return
 }
 
-// MarshalText encodes QueryResultType to text.
-func (e QueryResultType) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// Size returns the approximate memory used in bytes
+func (v *ResetQueueRequest) ByteSize() uint64 {
+	return 0
 }
 
-const (
-	// QueryResultTypeAnswered is an option for QueryResultType
-	QueryResultTypeAnswered QueryResultType = iota
-	// QueryResultTypeFailed is an option for QueryResultType
-	QueryResultTypeFailed
-)
-
-// QueryTaskCompletedType is an internal type (TBD...)
-type QueryTaskCompletedType int32
-
-// Ptr is a helper function for getting pointer value
-func (e QueryTaskCompletedType) Ptr() *QueryTaskCompletedType {
-	return &e
+// ResetStickyTaskListRequest is an internal type (TBD...)
+type ResetStickyTaskListRequest struct {
+	Domain    string             `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
 }
 
-// String returns a readable string representation of QueryTaskCompletedType.
-func (e QueryTaskCompletedType) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "COMPLETED"
-	case 1:
-		return "FAILED"
+// GetDomain is an internal getter (TBD...)
+func (v *ResetStickyTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
-	return fmt.Sprintf("QueryTaskCompletedType(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *QueryTaskCompletedType) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "COMPLETED":
-		*e = QueryTaskCompletedTypeCompleted
-		return nil
-	case "FAILED":
-		*e = QueryTaskCompletedTypeFailed
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryTaskCompletedType", err)
-		}
-		*e = QueryTaskCompletedType(val)
-		return nil
+// GetExecution is an internal getter (TBD...)
+func (v *ResetStickyTaskListRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
 	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *ResetStickyTaskListRequest) ByteSize() uint64 {
+	return 0
 }
 
-// MarshalText encodes QueryTaskCompletedType to text.
-func (e QueryTaskCompletedType) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// ResetStickyTaskListResponse is an internal type (TBD...)
+type ResetStickyTaskListResponse struct {
 }
 
-const (
-	// QueryTaskCompletedTypeCompleted is an option for QueryTaskCompletedType
-	QueryTaskCompletedTypeCompleted QueryTaskCompletedType = iota
-	// QueryTaskCompletedTypeFailed is an option for QueryTaskCompletedType
-	QueryTaskCompletedTypeFailed
-)
+// Size returns the approximate memory used in bytes
+func (v *ResetStickyTaskListResponse) ByteSize() uint64 {
+	return 0
+}
 
-// QueryWorkflowRequest is an internal type (TBD...)
-type QueryWorkflowRequest struct {
-	Domain                string                 `json:"domain,omitempty"`
-	Execution             *WorkflowExecution     `json:"execution,omitempty"`
-	Query                 *WorkflowQuery         `json:"query,omitempty"`
-	QueryRejectCondition  *QueryRejectCondition  `json:"queryRejectCondition,omitempty"`
-	QueryConsistencyLevel *QueryConsistencyLevel `json:"queryConsistencyLevel,omitempty"`
+// ResetWorkflowExecutionRequest is an internal type (TBD...)
+type ResetWorkflowExecutionRequest struct {
+	Domain                string             `json:"domain,omitempty"`
+	WorkflowExecution     *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason                string             `json:"reason,omitempty"`
+	DecisionFinishEventID int64              `json:"decisionFinishEventId,omitempty"`
+	RequestID             string             `json:"requestId,omitempty"`
+	SkipSignalReapply     bool               `json:"skipSignalReapply,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *QueryWorkflowRequest) GetDomain() (o string) {
+func (v *ResetWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// GetExecution is an internal getter (TBD...)
-func (v *QueryWorkflowRequest) GetExecution() (o *WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// GetQuery is an internal getter (TBD...)
-func (v *QueryWorkflowRequest) GetQuery() (o *WorkflowQuery) {
-	if v != nil && v.Query != nil {
-		return v.Query
+// GetReason is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
 	}
 	return
 }
 
-// GetQueryRejectCondition is an internal getter (TBD...)
-func (v *QueryWorkflowRequest) GetQueryRejectCondition() (o QueryRejectCondition) {
-	if v != nil && v.QueryRejectCondition != nil {
-		return *v.QueryRejectCondition
+// GetDecisionFinishEventID is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetDecisionFinishEventID() (o int64) {
+	if v != nil {
+		return v.DecisionFinishEventID
 	}
 	return
 }
 
-// GetQueryConsistencyLevel is an internal getter (TBD...)
-func (v *QueryWorkflowRequest) GetQueryConsistencyLevel() (o QueryConsistencyLevel) {
-	if v != nil && v.QueryConsistencyLevel != nil {
-		return *v.QueryConsistencyLevel
+// GetRequestID is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
 	}
 	return
 }
 
-// QueryWorkflowResponse is an internal type (TBD...)
-type QueryWorkflowResponse struct {
-	QueryResult   []byte         `json:"queryResult,omitempty"`
-	QueryRejected *QueryRejected `json:"queryRejected,omitempty"`
+// GetSkipSignalReapply is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetSkipSignalReapply() (o bool) {
+	if v != nil {
+		return v.SkipSignalReapply
+	}
+	return
 }
 
-// GetQueryResult is an internal getter (TBD...)
-func (v *QueryWorkflowResponse) GetQueryResult() (o []byte) {
-	if v != nil && v.QueryResult != nil {
-		return v.QueryResult
+// Size returns the approximate memory used in bytes
+func (v *ResetWorkflowExecutionRequest) ByteSize() uint64 {
+	return 0
+}
+
+// ResetWorkflowExecutionResponse is an internal type (TBD...)
+type ResetWorkflowExecutionResponse struct {
+	RunID string `json:"runId,omitempty"`
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionResponse) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
 	}
 	return
 }
 
-// GetQueryRejected is an internal getter (TBD...)
-func (v *QueryWorkflowResponse) GetQueryRejected() (o *QueryRejected) {
-	if v != nil && v.QueryRejected != nil {
-		return v.QueryRejected
+// Size returns the approximate memory used in bytes
+func (v *ResetWorkflowExecutionResponse) ByteSize() uint64 {
+	return 0
+}
+
+// RespondActivityTaskCanceledByIDRequest is an internal type (TBD...)
+type RespondActivityTaskCanceledByIDRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	WorkflowID string `json:"workflowID,omitempty"`
+	RunID      string `json:"runID,omitempty"`
+	ActivityID string `json:"activityID,omitempty"`
+	Details    []byte `json:"details,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RespondActivityTaskCanceledByIDRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// ReapplyEventsRequest is an internal type (TBD...)
-type ReapplyEventsRequest struct {
-	DomainName        string             `json:"domainName,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Events            *DataBlob          `json:"events,omitempty"`
+// GetWorkflowID is an internal getter (TBD...)
+func (v *RespondActivityTaskCanceledByIDRequest) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
 }
 
-// GetDomainName is an internal getter (TBD...)
-func (v *ReapplyEventsRequest) GetDomainName() (o string) {
+// GetRunID is an internal getter (TBD...)
+func (v *RespondActivityTaskCanceledByIDRequest) GetRunID() (o string) {
 	if v != nil {
-		return v.DomainName
+		return v.RunID
 	}
 	return
 }
 
-// GetWorkflowExecution is an internal getter (TBD...)
-func (v *ReapplyEventsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+// GetActivityID is an internal getter (TBD...)
+func (v *RespondActivityTaskCanceledByIDRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
 	}
 	return
 }
 
-// GetEvents is an internal getter (TBD...)
-func (v *ReapplyEventsRequest) GetEvents() (o *DataBlob) {
-	if v != nil && v.Events != nil {
-		return v.Events
+// GetIdentity is an internal getter (TBD...)
+func (v *RespondActivityTaskCanceledByIDRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
 	return
 }
 
-// RecordActivityTaskHeartbeatByIDRequest is an internal type (TBD...)
-type RecordActivityTaskHeartbeatByIDRequest struct {
+// Size returns the approximate memory used in bytes
+func (v *RespondActivityTaskCanceledByIDRequest) ByteSize() uint64 {
+	return 0
+}
+
+// RespondActivityTaskCanceledRequest is an internal type (TBD...)
+type RespondActivityTaskCanceledRequest struct {
+	TaskToken []byte `json:"taskToken,omitempty"`
+	Details   []byte `json:"details,omitempty"`
+	Identity  string `json:"identity,omitempty"`
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *RespondActivityTaskCanceledRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *RespondActivityTaskCanceledRequest) ByteSize() uint64 {
+	return 0
+}
+
+// RespondActivityTaskCompletedByIDRequest is an internal type (TBD...)
+type RespondActivityTaskCompletedByIDRequest struct {
 	Domain     string `json:"domain,omitempty"`
 	WorkflowID string `json:"workflowID,omitempty"`
 	RunID      string `json:"runID,omitempty"`
 	ActivityID string `json:"activityID,omitempty"`
-	Details    []byte `json:"details,omitempty"`
+	Result     []byte `json:"result,omitempty"`
 	Identity   string `json:"identity,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetDomain() (o string) {
+func (v *RespondActivityTaskCompletedByIDRequest) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
@@ -5529,7 +7000,7 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) GetDomain() (o string) {
 }
 
 // GetWorkflowID is an internal getter (TBD...)
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetWorkflowID() (o string) {
+func (v *RespondActivityTaskCompletedByIDRequest) GetWorkflowID() (o string) {
 	if v != nil {
 		return v.WorkflowID
 	}
@@ -5537,7 +7008,7 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) GetWorkflowID() (o string) {
 }
 
 // GetRunID is an internal getter (TBD...)
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetRunID() (o string) {
+func (v *RespondActivityTaskCompletedByIDRequest) GetRunID() (o string) {
 	if v != nil {
 		return v.RunID
 	}
@@ -5545,280 +7016,370 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) GetRunID() (o string) {
 }
 
 // GetActivityID is an internal getter (TBD...)
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetActivityID() (o string) {
+func (v *RespondActivityTaskCompletedByIDRequest) GetActivityID() (o string) {
 	if v != nil {
 		return v.ActivityID
 	}
 	return
 }
 
-// RecordActivityTaskHeartbeatRequest is an internal type (TBD...)
-type RecordActivityTaskHeartbeatRequest struct {
+// GetIdentity is an internal getter (TBD...)
+func (v *RespondActivityTaskCompletedByIDRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *RespondActivityTaskCompletedByIDRequest) ByteSize() uint64 {
+	return 0
+}
+
+// RespondActivityTaskCompletedRequest is an internal type (TBD...)
+type RespondActivityTaskCompletedRequest struct {
 	TaskToken []byte `json:"taskToken,omitempty"`
-	Details   []byte `json:"details,omitempty"`
+	Result    []byte `json:"result,omitempty"`
 	Identity  string `json:"identity,omitempty"`
 }
 
-// RecordActivityTaskHeartbeatResponse is an internal type (TBD...)
-type RecordActivityTaskHeartbeatResponse struct {
-	CancelRequested bool `json:"cancelRequested,omitempty"`
+// GetIdentity is an internal getter (TBD...)
+func (v *RespondActivityTaskCompletedRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
 }
 
-// RecordMarkerDecisionAttributes is an internal type (TBD...)
-type RecordMarkerDecisionAttributes struct {
-	MarkerName string  `json:"markerName,omitempty"`
+// Size returns the approximate memory used in bytes
+func (v *RespondActivityTaskCompletedRequest) ByteSize() uint64 {
+	return 0
+}
+
+// RespondActivityTaskFailedByIDRequest is an internal type (TBD...)
+type RespondActivityTaskFailedByIDRequest struct {
+	Domain     string  `json:"domain,omitempty"`
+	WorkflowID string  `json:"workflowID,omitempty"`
+	RunID      string  `json:"runID,omitempty"`
+	ActivityID string  `json:"activityID,omitempty"`
+	Reason     *string `json:"reason,omitempty"`
 	Details    []byte  `json:"details,omitempty"`
-	Header     *Header `json:"header,omitempty"`
+	Identity   string  `json:"identity,omitempty"`
 }
 
-// GetMarkerName is an internal getter (TBD...)
-func (v *RecordMarkerDecisionAttributes) GetMarkerName() (o string) {
+// GetDomain is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedByIDRequest) GetDomain() (o string) {
 	if v != nil {
-		return v.MarkerName
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedByIDRequest) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedByIDRequest) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
 	}
 	return
 }
 
-// RefreshWorkflowTasksRequest is an internal type (TBD...)
-type RefreshWorkflowTasksRequest struct {
-	Domain    string             `json:"domain,omitempty"`
-	Execution *WorkflowExecution `json:"execution,omitempty"`
-}
-
-// GetDomain is an internal getter (TBD...)
-func (v *RefreshWorkflowTasksRequest) GetDomain() (o string) {
+// GetActivityID is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedByIDRequest) GetActivityID() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.ActivityID
 	}
 	return
 }
 
-// GetExecution is an internal getter (TBD...)
-func (v *RefreshWorkflowTasksRequest) GetExecution() (o *WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+// GetIdentity is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedByIDRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
 	return
 }
 
-// RegisterDomainRequest is an internal type (TBD...)
-type RegisterDomainRequest struct {
-	Name                                   string                             `json:"name,omitempty"`
-	Description                            string                             `json:"description,omitempty"`
-	OwnerEmail                             string                             `json:"ownerEmail,omitempty"`
-	WorkflowExecutionRetentionPeriodInDays int32                              `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
-	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
-	ActiveClusterName                      string                             `json:"activeClusterName,omitempty"`
-	ActiveClusters                         *ActiveClusters                    `json:"activeClusters,omitempty"`
-	Data                                   map[string]string                  `json:"data,omitempty"`
-	SecurityToken                          string                             `json:"securityToken,omitempty"`
-	IsGlobalDomain                         bool                               `json:"isGlobalDomain,omitempty"`
-	HistoryArchivalStatus                  *ArchivalStatus                    `json:"historyArchivalStatus,omitempty"`
-	HistoryArchivalURI                     string                             `json:"historyArchivalURI,omitempty"`
-	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
-	VisibilityArchivalURI                  string                             `json:"visibilityArchivalURI,omitempty"`
+// Size returns the approximate memory used in bytes
+func (v *RespondActivityTaskFailedByIDRequest) ByteSize() uint64 {
+	return 0
 }
 
-// GetName is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetName() (o string) {
-	if v != nil {
-		return v.Name
-	}
-	return
+// RespondActivityTaskFailedRequest is an internal type (TBD...)
+type RespondActivityTaskFailedRequest struct {
+	TaskToken []byte  `json:"taskToken,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+	Details   []byte  `json:"details,omitempty"`
+	Identity  string  `json:"identity,omitempty"`
 }
 
-// GetDescription is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetDescription() (o string) {
-	if v != nil {
-		return v.Description
+// GetReason is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
 	}
 	return
 }
 
-// GetOwnerEmail is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetOwnerEmail() (o string) {
-	if v != nil {
-		return v.OwnerEmail
+// GetDetails is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
 	return
 }
 
-// GetWorkflowExecutionRetentionPeriodInDays is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
+// GetIdentity is an internal getter (TBD...)
+func (v *RespondActivityTaskFailedRequest) GetIdentity() (o string) {
 	if v != nil {
-		return v.WorkflowExecutionRetentionPeriodInDays
+		return v.Identity
 	}
 	return
 }
 
-// GetEmitMetric is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetEmitMetric() (o bool) {
-	if v != nil && v.EmitMetric != nil {
-		return *v.EmitMetric
-	}
-	o = true
-	return
+// Size returns the approximate memory used in bytes
+func (v *RespondActivityTaskFailedRequest) ByteSize() uint64 {
+	return 0
 }
 
-// GetActiveClusterName is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetActiveClusterName() (o string) {
+// RespondDecisionTaskCompletedRequest is an internal type (TBD...)
+type RespondDecisionTaskCompletedRequest struct {
+	TaskToken                  []byte                          `json:"taskToken,omitempty"`
+	Decisions                  []*Decision                     `json:"decisions,omitempty"`
+	ExecutionContext           []byte                          `json:"executionContext,omitempty"`
+	Identity                   string                          `json:"identity,omitempty"`
+	StickyAttributes           *StickyExecutionAttributes      `json:"stickyAttributes,omitempty"`
+	ReturnNewDecisionTask      bool                            `json:"returnNewDecisionTask,omitempty"`
+	ForceCreateNewDecisionTask bool                            `json:"forceCreateNewDecisionTask,omitempty"`
+	BinaryChecksum             string                          `json:"binaryChecksum,omitempty"`
+	QueryResults               map[string]*WorkflowQueryResult `json:"queryResults,omitempty"`
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedRequest) GetIdentity() (o string) {
 	if v != nil {
-		return v.ActiveClusterName
+		return v.Identity
 	}
 	return
 }
 
-// GetData is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetData() (o map[string]string) {
-	if v != nil && v.Data != nil {
-		return v.Data
+// GetReturnNewDecisionTask is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedRequest) GetReturnNewDecisionTask() (o bool) {
+	if v != nil {
+		return v.ReturnNewDecisionTask
 	}
 	return
 }
 
-// GetIsGlobalDomain is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetIsGlobalDomain() (o bool) {
+// GetForceCreateNewDecisionTask is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedRequest) GetForceCreateNewDecisionTask() (o bool) {
 	if v != nil {
-		return v.IsGlobalDomain
+		return v.ForceCreateNewDecisionTask
 	}
 	return
 }
 
-// GetHistoryArchivalURI is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetHistoryArchivalURI() (o string) {
+// GetBinaryChecksum is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedRequest) GetBinaryChecksum() (o string) {
 	if v != nil {
-		return v.HistoryArchivalURI
+		return v.BinaryChecksum
 	}
 	return
 }
 
-// GetVisibilityArchivalURI is an internal getter (TBD...)
-func (v *RegisterDomainRequest) GetVisibilityArchivalURI() (o string) {
-	if v != nil {
-		return v.VisibilityArchivalURI
+// GetQueryResults is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedRequest) GetQueryResults() (o map[string]*WorkflowQueryResult) {
+	if v != nil && v.QueryResults != nil {
+		return v.QueryResults
 	}
 	return
 }
 
 // Size returns the approximate memory used in bytes
-func (v *RegisterDomainRequest) ByteSize() uint64 {
+func (v *RespondDecisionTaskCompletedRequest) ByteSize() uint64 {
 	return 0
 }
 
-// RemoteSyncMatchedError is an internal type (TBD...)
-type RemoteSyncMatchedError struct {
-	Message string `json:"message,required"`
+// RespondDecisionTaskCompletedResponse is an internal type (TBD...)
+type RespondDecisionTaskCompletedResponse struct {
+	DecisionTask                *PollForDecisionTaskResponse          `json:"decisionTask,omitempty"`
+	ActivitiesToDispatchLocally map[string]*ActivityLocalDispatchInfo `json:"activitiesToDispatchLocally,omitempty"`
+}
+
+// GetDecisionTask is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedResponse) GetDecisionTask() (o *PollForDecisionTaskResponse) {
+	if v != nil && v.DecisionTask != nil {
+		return v.DecisionTask
+	}
+	return
 }
 
 // Size returns the approximate memory used in bytes
-func (v *RemoteSyncMatchedError) ByteSize() uint64 {
+func (v *RespondDecisionTaskCompletedResponse) ByteSize() uint64 {
 	return 0
 }
 
-// RemoveTaskRequest is an internal type (TBD...)
-type RemoveTaskRequest struct {
-	ShardID             int32  `json:"shardID,omitempty"`
-	Type                *int32 `json:"type,omitempty"`
-	TaskID              int64  `json:"taskID,omitempty"`
-	VisibilityTimestamp *int64 `json:"visibilityTimestamp,omitempty"`
-	ClusterName         string `json:"clusterName,omitempty"`
+// RespondDecisionTaskFailedRequest is an internal type (TBD...)
+type RespondDecisionTaskFailedRequest struct {
+	TaskToken      []byte                   `json:"taskToken,omitempty"`
+	Cause          *DecisionTaskFailedCause `json:"cause,omitempty"`
+	Details        []byte                   `json:"details,omitempty"`
+	Identity       string                   `json:"identity,omitempty"`
+	BinaryChecksum string                   `json:"binaryChecksum,omitempty"`
 }
 
-// GetShardID is an internal getter (TBD...)
-func (v *RemoveTaskRequest) GetShardID() (o int32) {
-	if v != nil {
-		return v.ShardID
+// GetCause is an internal getter (TBD...)
+func (v *RespondDecisionTaskFailedRequest) GetCause() (o DecisionTaskFailedCause) {
+	if v != nil && v.Cause != nil {
+		return *v.Cause
 	}
 	return
 }
 
-// GetType is an internal getter (TBD...)
-func (v *RemoveTaskRequest) GetType() (o int32) {
-	if v != nil && v.Type != nil {
-		return *v.Type
+// GetIdentity is an internal getter (TBD...)
+func (v *RespondDecisionTaskFailedRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
 	return
 }
 
-// GetTaskID is an internal getter (TBD...)
-func (v *RemoveTaskRequest) GetTaskID() (o int64) {
+// GetBinaryChecksum is an internal getter (TBD...)
+func (v *RespondDecisionTaskFailedRequest) GetBinaryChecksum() (o string) {
 	if v != nil {
-		return v.TaskID
+		return v.BinaryChecksum
 	}
 	return
 }
 
-// GetVisibilityTimestamp is an internal getter (TBD...)
-func (v *RemoveTaskRequest) GetVisibilityTimestamp() (o int64) {
-	if v != nil && v.VisibilityTimestamp != nil {
-		return *v.VisibilityTimestamp
+// RespondQueryTaskCompletedRequest is an internal type (TBD...)
+type RespondQueryTaskCompletedRequest struct {
+	TaskToken         []byte                  `json:"taskToken,omitempty"`
+	CompletedType     *QueryTaskCompletedType `json:"completedType,omitempty"`
+	QueryResult       []byte                  `json:"queryResult,omitempty"`
+	ErrorMessage      string                  `json:"errorMessage,omitempty"`
+	WorkerVersionInfo *WorkerVersionInfo      `json:"workerVersionInfo,omitempty"`
+}
+
+// GetCompletedType is an internal getter (TBD...)
+func (v *RespondQueryTaskCompletedRequest) GetCompletedType() (o QueryTaskCompletedType) {
+	if v != nil && v.CompletedType != nil {
+		return *v.CompletedType
 	}
 	return
 }
 
-// GetClusterName is an internal getter (TBD...)
-func (v *RemoveTaskRequest) GetClusterName() (o string) {
+// GetQueryResult is an internal getter (TBD...)
+func (v *RespondQueryTaskCompletedRequest) GetQueryResult() (o []byte) {
+	if v != nil && v.QueryResult != nil {
+		return v.QueryResult
+	}
+	return
+}
+
+// GetErrorMessage is an internal getter (TBD...)
+func (v *RespondQueryTaskCompletedRequest) GetErrorMessage() (o string) {
 	if v != nil {
-		return v.ClusterName
+		return v.ErrorMessage
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RemoveTaskRequest) ByteSize() uint64 {
-	return 0
+// GetWorkerVersionInfo is an internal getter (TBD...)
+func (v *RespondQueryTaskCompletedRequest) GetWorkerVersionInfo() (o *WorkerVersionInfo) {
+	if v != nil && v.WorkerVersionInfo != nil {
+		return v.WorkerVersionInfo
+	}
+	return
 }
 
-// RequestCancelActivityTaskDecisionAttributes is an internal type (TBD...)
-type RequestCancelActivityTaskDecisionAttributes struct {
-	ActivityID string `json:"activityId,omitempty"`
+// RetryPolicy is an internal type (TBD...)
+type RetryPolicy struct {
+	InitialIntervalInSeconds    int32    `json:"initialIntervalInSeconds,omitempty"`
+	BackoffCoefficient          float64  `json:"backoffCoefficient,omitempty"`
+	MaximumIntervalInSeconds    int32    `json:"maximumIntervalInSeconds,omitempty"`
+	MaximumAttempts             int32    `json:"maximumAttempts,omitempty"`
+	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
+	ExpirationIntervalInSeconds int32    `json:"expirationIntervalInSeconds,omitempty"`
 }
 
-// GetActivityID is an internal getter (TBD...)
-func (v *RequestCancelActivityTaskDecisionAttributes) GetActivityID() (o string) {
+// GetInitialIntervalInSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetInitialIntervalInSeconds() (o int32) {
+	if v != nil {
+		return v.InitialIntervalInSeconds
+	}
+	return
+}
+
+// GetBackoffCoefficient is an internal getter (TBD...)
+func (v *RetryPolicy) GetBackoffCoefficient() (o float64) {
+	if v != nil {
+		return v.BackoffCoefficient
+	}
+	return
+}
+
+// GetMaximumIntervalInSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetMaximumIntervalInSeconds() (o int32) {
 	if v != nil {
-		return v.ActivityID
+		return v.MaximumIntervalInSeconds
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RequestCancelActivityTaskDecisionAttributes) ByteSize() uint64 {
-	return 0
+// GetMaximumAttempts is an internal getter (TBD...)
+func (v *RetryPolicy) GetMaximumAttempts() (o int32) {
+	if v != nil {
+		return v.MaximumAttempts
+	}
+	return
 }
 
-// RequestCancelActivityTaskFailedEventAttributes is an internal type (TBD...)
-type RequestCancelActivityTaskFailedEventAttributes struct {
-	ActivityID                   string `json:"activityId,omitempty"`
-	Cause                        string `json:"cause,omitempty"`
-	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+// GetNonRetriableErrorReasons is an internal getter (TBD...)
+func (v *RetryPolicy) GetNonRetriableErrorReasons() (o []string) {
+	if v != nil && v.NonRetriableErrorReasons != nil {
+		return v.NonRetriableErrorReasons
+	}
+	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RequestCancelActivityTaskFailedEventAttributes) ByteSize() uint64 {
-	return 0
+// GetExpirationIntervalInSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
+	if v != nil {
+		return v.ExpirationIntervalInSeconds
+	}
+	return
 }
 
-// RequestCancelExternalWorkflowExecutionDecisionAttributes is an internal type (TBD...)
-type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
-	Domain            string `json:"domain,omitempty"`
+// RetryTaskV2Error is an internal type (TBD...)
+type RetryTaskV2Error struct {
+	Message           string `json:"message,required"`
+	DomainID          string `json:"domainId,omitempty"`
 	WorkflowID        string `json:"workflowId,omitempty"`
 	RunID             string `json:"runId,omitempty"`
-	Control           []byte `json:"control,omitempty"`
-	ChildWorkflowOnly bool   `json:"childWorkflowOnly,omitempty"`
+	StartEventID      *int64 `json:"startEventId,omitempty"`
+	StartEventVersion *int64 `json:"startEventVersion,omitempty"`
+	EndEventID        *int64 `json:"endEventId,omitempty"`
+	EndEventVersion   *int64 `json:"endEventVersion,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
+// GetDomainID is an internal getter (TBD...)
+func (v *RetryTaskV2Error) GetDomainID() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.DomainID
 	}
 	return
 }
 
 // GetWorkflowID is an internal getter (TBD...)
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetWorkflowID() (o string) {
+func (v *RetryTaskV2Error) GetWorkflowID() (o string) {
 	if v != nil {
 		return v.WorkflowID
 	}
@@ -5826,455 +7387,475 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetWorkflowID
 }
 
 // GetRunID is an internal getter (TBD...)
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetRunID() (o string) {
+func (v *RetryTaskV2Error) GetRunID() (o string) {
 	if v != nil {
 		return v.RunID
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ByteSize() uint64 {
-	return 0
+// ScheduleActivityTaskDecisionAttributes is an internal type (TBD...)
+type ScheduleActivityTaskDecisionAttributes struct {
+	ActivityID                    string        `json:"activityId,omitempty"`
+	ActivityType                  *ActivityType `json:"activityType,omitempty"`
+	Domain                        string        `json:"domain,omitempty"`
+	TaskList                      *TaskList     `json:"taskList,omitempty"`
+	Input                         []byte        `json:"input,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
+	Header                        *Header       `json:"header,omitempty"`
+	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+	TaskPriority                  *TaskPriority `json:"taskPriority,omitempty"`
 }
 
-// RequestCancelExternalWorkflowExecutionFailedEventAttributes is an internal type (TBD...)
-type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
-	Cause                        *CancelExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
-	DecisionTaskCompletedEventID int64                                       `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       string                                      `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
-	InitiatedEventID             int64                                       `json:"initiatedEventId,omitempty"`
-	Control                      []byte                                      `json:"control,omitempty"`
+// GetActivityID is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
 }
 
-// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
-	if v != nil {
-		return v.DecisionTaskCompletedEventID
+// GetActivityType is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetActivityType() (o *ActivityType) {
+	if v != nil && v.ActivityType != nil {
+		return v.ActivityType
 	}
 	return
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
+func (v *ScheduleActivityTaskDecisionAttributes) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// GetInitiatedEventID is an internal getter (TBD...)
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventID() (o int64) {
-	if v != nil {
-		return v.InitiatedEventID
+// GetScheduleToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetScheduleToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
+		return *v.ScheduleToCloseTimeoutSeconds
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ByteSize() uint64 {
-	return 0
-}
-
-// RequestCancelExternalWorkflowExecutionInitiatedEventAttributes is an internal type (TBD...)
-type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
-	DecisionTaskCompletedEventID int64              `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       string             `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Control                      []byte             `json:"control,omitempty"`
-	ChildWorkflowOnly            bool               `json:"childWorkflowOnly,omitempty"`
-}
-
-// GetDomain is an internal getter (TBD...)
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// GetScheduleToStartTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
 	}
 	return
 }
 
-// GetWorkflowExecution is an internal getter (TBD...)
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+// GetStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
 	}
 	return
 }
 
-// GetChildWorkflowOnly is an internal getter (TBD...)
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
-	if v != nil {
-		return v.ChildWorkflowOnly
+// GetHeartbeatTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetHeartbeatTimeoutSeconds() (o int32) {
+	if v != nil && v.HeartbeatTimeoutSeconds != nil {
+		return *v.HeartbeatTimeoutSeconds
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ByteSize() uint64 {
-	return 0
-}
-
-// RequestCancelWorkflowExecutionRequest is an internal type (TBD...)
-type RequestCancelWorkflowExecutionRequest struct {
-	Domain              string             `json:"domain,omitempty"`
-	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Identity            string             `json:"identity,omitempty"`
-	RequestID           string             `json:"requestId,omitempty"`
-	Cause               string             `json:"cause,omitempty"`
-	FirstExecutionRunID string             `json:"first_execution_run_id,omitempty"`
+// SearchAttributes is an internal type (TBD...)
+type SearchAttributes struct {
+	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// GetIndexedFields is an internal getter (TBD...)
+func (v *SearchAttributes) GetIndexedFields() (o map[string][]byte) {
+	if v != nil && v.IndexedFields != nil {
+		return v.IndexedFields
 	}
 	return
 }
 
-// GetWorkflowExecution is an internal getter (TBD...)
-func (v *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
-	}
-	return
+// ServiceBusyError is an internal type (TBD...)
+type ServiceBusyError struct {
+	Message string `json:"message,required"`
+	Reason  string `json:"reason,omitempty"`
 }
 
-// GetRequestID is an internal getter (TBD...)
-func (v *RequestCancelWorkflowExecutionRequest) GetRequestID() (o string) {
+// SignalExternalWorkflowExecutionDecisionAttributes is an internal type (TBD...)
+type SignalExternalWorkflowExecutionDecisionAttributes struct {
+	Domain            string             `json:"domain,omitempty"`
+	Execution         *WorkflowExecution `json:"execution,omitempty"`
+	SignalName        string             `json:"signalName,omitempty"`
+	Input             []byte             `json:"input,omitempty"`
+	Control           []byte             `json:"control,omitempty"`
+	ChildWorkflowOnly bool               `json:"childWorkflowOnly,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *SignalExternalWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
 	if v != nil {
-		return v.RequestID
+		return v.Domain
 	}
 	return
 }
 
-// GetFirstExecutionRunID is an internal getter (TBD...)
-func (v *RequestCancelWorkflowExecutionRequest) GetFirstExecutionRunID() (o string) {
+// GetSignalName is an internal getter (TBD...)
+func (v *SignalExternalWorkflowExecutionDecisionAttributes) GetSignalName() (o string) {
 	if v != nil {
-		return v.FirstExecutionRunID
+		return v.SignalName
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RequestCancelWorkflowExecutionRequest) ByteSize() uint64 {
-	return 0
-}
-
-// ResetPointInfo is an internal type (TBD...)
-type ResetPointInfo struct {
-	BinaryChecksum           string `json:"binaryChecksum,omitempty"`
-	RunID                    string `json:"runId,omitempty"`
-	FirstDecisionCompletedID int64  `json:"firstDecisionCompletedId,omitempty"`
-	CreatedTimeNano          *int64 `json:"createdTimeNano,omitempty"`
-	ExpiringTimeNano         *int64 `json:"expiringTimeNano,omitempty"`
-	Resettable               bool   `json:"resettable,omitempty"`
-}
+// SignalExternalWorkflowExecutionFailedCause is an internal type (TBD...)
+type SignalExternalWorkflowExecutionFailedCause int32
 
-// GetBinaryChecksum is an internal getter (TBD...)
-func (v *ResetPointInfo) GetBinaryChecksum() (o string) {
-	if v != nil {
-		return v.BinaryChecksum
-	}
-	return
+// Ptr is a helper function for getting pointer value
+func (e SignalExternalWorkflowExecutionFailedCause) Ptr() *SignalExternalWorkflowExecutionFailedCause {
+	return &e
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *ResetPointInfo) GetRunID() (o string) {
-	if v != nil {
-		return v.RunID
+// String returns a readable string representation of SignalExternalWorkflowExecutionFailedCause.
+func (e SignalExternalWorkflowExecutionFailedCause) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+	case 1:
+		return "WORKFLOW_ALREADY_COMPLETED"
 	}
-	return
+	return fmt.Sprintf("SignalExternalWorkflowExecutionFailedCause(%d)", w)
 }
 
-// GetFirstDecisionCompletedID is an internal getter (TBD...)
-func (v *ResetPointInfo) GetFirstDecisionCompletedID() (o int64) {
-	if v != nil {
-		return v.FirstDecisionCompletedID
+// UnmarshalText parses enum value from string representation
+func (e *SignalExternalWorkflowExecutionFailedCause) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION":
+		*e = SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution
+		return nil
+	case "WORKFLOW_ALREADY_COMPLETED":
+		*e = SignalExternalWorkflowExecutionFailedCauseWorkflowAlreadyCompleted
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "SignalExternalWorkflowExecutionFailedCause", err)
+		}
+		*e = SignalExternalWorkflowExecutionFailedCause(val)
+		return nil
 	}
-	return
 }
 
-// GetCreatedTimeNano is an internal getter (TBD...)
-func (v *ResetPointInfo) GetCreatedTimeNano() (o int64) {
-	if v != nil && v.CreatedTimeNano != nil {
-		return *v.CreatedTimeNano
-	}
-	return
+// MarshalText encodes SignalExternalWorkflowExecutionFailedCause to text.
+func (e SignalExternalWorkflowExecutionFailedCause) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
 }
 
-// GetExpiringTimeNano is an internal getter (TBD...)
-func (v *ResetPointInfo) GetExpiringTimeNano() (o int64) {
-	if v != nil && v.ExpiringTimeNano != nil {
-		return *v.ExpiringTimeNano
+const (
+	// SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution is an option for SignalExternalWorkflowExecutionFailedCause
+	SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution SignalExternalWorkflowExecutionFailedCause = iota
+	SignalExternalWorkflowExecutionFailedCauseWorkflowAlreadyCompleted
+)
+
+// SignalExternalWorkflowExecutionFailedEventAttributes is an internal type (TBD...)
+type SignalExternalWorkflowExecutionFailedEventAttributes struct {
+	Cause                        *SignalExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
+	DecisionTaskCompletedEventID int64                                       `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       string                                      `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
+	InitiatedEventID             int64                                       `json:"initiatedEventId,omitempty"`
+	Control                      []byte                                      `json:"control,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *SignalExternalWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetResettable is an internal getter (TBD...)
-func (v *ResetPointInfo) GetResettable() (o bool) {
+// GetInitiatedEventID is an internal getter (TBD...)
+func (v *SignalExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventID() (o int64) {
 	if v != nil {
-		return v.Resettable
+		return v.InitiatedEventID
 	}
 	return
 }
 
 // Size returns the approximate memory used in bytes
-func (v *ResetPointInfo) ByteSize() uint64 {
+func (v *SignalExternalWorkflowExecutionFailedEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
-// ResetPoints is an internal type (TBD...)
-type ResetPoints struct {
-	Points []*ResetPointInfo `json:"points,omitempty"`
-}
-
-// Size returns the approximate memory used in bytes
-func (v *ResetPoints) ByteSize() uint64 {
-	return 0
+// SignalExternalWorkflowExecutionInitiatedEventAttributes is an internal type (TBD...)
+type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
+	DecisionTaskCompletedEventID int64              `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       string             `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
+	SignalName                   string             `json:"signalName,omitempty"`
+	Input                        []byte             `json:"input,omitempty"`
+	Control                      []byte             `json:"control,omitempty"`
+	ChildWorkflowOnly            bool               `json:"childWorkflowOnly,omitempty"`
 }
 
-// ResetQueueRequest is an internal type (TBD...)
-type ResetQueueRequest struct {
-	ShardID     int32  `json:"shardID,omitempty"`
-	ClusterName string `json:"clusterName,omitempty"`
-	Type        *int32 `json:"type,omitempty"`
+// GetDomain is an internal getter (TBD...)
+func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
 }
 
-// GetShardID is an internal getter (TBD...)
-func (v *ResetQueueRequest) GetShardID() (o int32) {
-	if v != nil {
-		return v.ShardID
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// GetClusterName is an internal getter (TBD...)
-func (v *ResetQueueRequest) GetClusterName() (o string) {
+// GetSignalName is an internal getter (TBD...)
+func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetSignalName() (o string) {
 	if v != nil {
-		return v.ClusterName
+		return v.SignalName
 	}
 	return
 }
 
-// GetType is an internal getter (TBD...)
-func (v *ResetQueueRequest) GetType() (o int32) {
-	if v != nil && v.Type != nil {
-		return *v.Type
+// GetChildWorkflowOnly is an internal getter (TBD...)
+func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
+	if v != nil {
+		return v.ChildWorkflowOnly
 	}
 	return
 }
 
 // Size returns the approximate memory used in bytes
-func (v *ResetQueueRequest) ByteSize() uint64 {
+func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
-// ResetStickyTaskListRequest is an internal type (TBD...)
-type ResetStickyTaskListRequest struct {
-	Domain    string             `json:"domain,omitempty"`
-	Execution *WorkflowExecution `json:"execution,omitempty"`
+// SignalWithStartWorkflowExecutionRequest is an internal type (TBD...)
+type SignalWithStartWorkflowExecutionRequest struct {
+	Domain                              string                        `json:"domain,omitempty"`
+	WorkflowID                          string                        `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                     `json:"taskList,omitempty"`
+	Input                               []byte                        `json:"-"` // Filtering PII
+	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                        `json:"identity,omitempty"`
+	RequestID                           string                        `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy        `json:"workflowIdReusePolicy,omitempty"`
+	SignalName                          string                        `json:"signalName,omitempty"`
+	SignalInput                         []byte                        `json:"-"` // Filtering PII
+	Control                             []byte                        `json:"control,omitempty"`
+	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                         `json:"-"` // Filtering PII
+	SearchAttributes                    *SearchAttributes             `json:"-"` // Filtering PII
+	Header                              *Header                       `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                        `json:"delayStartSeconds,omitempty"`
+	JitterStartSeconds                  *int32                        `json:"jitterStartSeconds,omitempty"`
+	FirstRunAtTimestamp                 *int64                        `json:"firstRunAtTimestamp,omitempty"`
+	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
+	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	TaskPriority                        *TaskPriority                 `json:"taskPriority,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *ResetStickyTaskListRequest) GetDomain() (o string) {
+func (v *SignalWithStartWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// GetExecution is an internal getter (TBD...)
-func (v *ResetStickyTaskListRequest) GetExecution() (o *WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+// GetWorkflowID is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *ResetStickyTaskListRequest) ByteSize() uint64 {
-	return 0
-}
-
-// ResetStickyTaskListResponse is an internal type (TBD...)
-type ResetStickyTaskListResponse struct {
-}
-
-// Size returns the approximate memory used in bytes
-func (v *ResetStickyTaskListResponse) ByteSize() uint64 {
-	return 0
-}
-
-// ResetWorkflowExecutionRequest is an internal type (TBD...)
-type ResetWorkflowExecutionRequest struct {
-	Domain                string             `json:"domain,omitempty"`
-	WorkflowExecution     *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Reason                string             `json:"reason,omitempty"`
-	DecisionFinishEventID int64              `json:"decisionFinishEventId,omitempty"`
-	RequestID             string             `json:"requestId,omitempty"`
-	SkipSignalReapply     bool               `json:"skipSignalReapply,omitempty"`
+// GetWorkflowType is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ResetWorkflowExecutionRequest) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// GetTaskList is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
 	}
 	return
 }
 
-// GetWorkflowExecution is an internal getter (TBD...)
-func (v *ResetWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
 	}
 	return
 }
 
-// GetReason is an internal getter (TBD...)
-func (v *ResetWorkflowExecutionRequest) GetReason() (o string) {
-	if v != nil {
-		return v.Reason
+// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
 	}
 	return
 }
 
-// GetDecisionFinishEventID is an internal getter (TBD...)
-func (v *ResetWorkflowExecutionRequest) GetDecisionFinishEventID() (o int64) {
+// GetIdentity is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetIdentity() (o string) {
 	if v != nil {
-		return v.DecisionFinishEventID
+		return v.Identity
 	}
 	return
 }
 
 // GetRequestID is an internal getter (TBD...)
-func (v *ResetWorkflowExecutionRequest) GetRequestID() (o string) {
+func (v *SignalWithStartWorkflowExecutionRequest) GetRequestID() (o string) {
 	if v != nil {
 		return v.RequestID
 	}
 	return
 }
 
-// GetSkipSignalReapply is an internal getter (TBD...)
-func (v *ResetWorkflowExecutionRequest) GetSkipSignalReapply() (o bool) {
-	if v != nil {
-		return v.SkipSignalReapply
+// GetWorkflowIDReusePolicy is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o WorkflowIDReusePolicy) {
+	if v != nil && v.WorkflowIDReusePolicy != nil {
+		return *v.WorkflowIDReusePolicy
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *ResetWorkflowExecutionRequest) ByteSize() uint64 {
-	return 0
+// GetSignalName is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
 }
 
-// ResetWorkflowExecutionResponse is an internal type (TBD...)
-type ResetWorkflowExecutionResponse struct {
-	RunID string `json:"runId,omitempty"`
+// GetSignalInput is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetSignalInput() (o []byte) {
+	if v != nil && v.SignalInput != nil {
+		return v.SignalInput
+	}
+	return
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *ResetWorkflowExecutionResponse) GetRunID() (o string) {
+// GetCronSchedule is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	if v != nil {
-		return v.RunID
+		return v.CronSchedule
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *ResetWorkflowExecutionResponse) ByteSize() uint64 {
-	return 0
+// SignalWithStartWorkflowExecutionAsyncRequest is an internal type (TBD...)
+type SignalWithStartWorkflowExecutionAsyncRequest struct {
+	*SignalWithStartWorkflowExecutionRequest
 }
 
-// RespondActivityTaskCanceledByIDRequest is an internal type (TBD...)
-type RespondActivityTaskCanceledByIDRequest struct {
-	Domain     string `json:"domain,omitempty"`
-	WorkflowID string `json:"workflowID,omitempty"`
-	RunID      string `json:"runID,omitempty"`
-	ActivityID string `json:"activityID,omitempty"`
-	Details    []byte `json:"details,omitempty"`
-	Identity   string `json:"identity,omitempty"`
+// SignalWithStartWorkflowExecutionAsyncResponse is an internal type (TBD...)
+type SignalWithStartWorkflowExecutionAsyncResponse struct {
+}
+
+// SignalWorkflowExecutionRequest is an internal type (TBD...)
+type SignalWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	SignalName        string             `json:"signalName,omitempty"`
+	Input             []byte             `json:"-"` // Filtering PII
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+	Control           []byte             `json:"control,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *RespondActivityTaskCanceledByIDRequest) GetDomain() (o string) {
+func (v *SignalWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *RespondActivityTaskCanceledByIDRequest) GetWorkflowID() (o string) {
-	if v != nil {
-		return v.WorkflowID
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *SignalWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *RespondActivityTaskCanceledByIDRequest) GetRunID() (o string) {
+// GetSignalName is an internal getter (TBD...)
+func (v *SignalWorkflowExecutionRequest) GetSignalName() (o string) {
 	if v != nil {
-		return v.RunID
+		return v.SignalName
 	}
 	return
 }
 
-// GetActivityID is an internal getter (TBD...)
-func (v *RespondActivityTaskCanceledByIDRequest) GetActivityID() (o string) {
-	if v != nil {
-		return v.ActivityID
+// GetInput is an internal getter (TBD...)
+func (v *SignalWorkflowExecutionRequest) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
 	}
 	return
 }
 
 // GetIdentity is an internal getter (TBD...)
-func (v *RespondActivityTaskCanceledByIDRequest) GetIdentity() (o string) {
+func (v *SignalWorkflowExecutionRequest) GetIdentity() (o string) {
 	if v != nil {
 		return v.Identity
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RespondActivityTaskCanceledByIDRequest) ByteSize() uint64 {
-	return 0
-}
-
-// RespondActivityTaskCanceledRequest is an internal type (TBD...)
-type RespondActivityTaskCanceledRequest struct {
-	TaskToken []byte `json:"taskToken,omitempty"`
-	Details   []byte `json:"details,omitempty"`
-	Identity  string `json:"identity,omitempty"`
-}
-
-// GetIdentity is an internal getter (TBD...)
-func (v *RespondActivityTaskCanceledRequest) GetIdentity() (o string) {
+// GetRequestID is an internal getter (TBD...)
+func (v *SignalWorkflowExecutionRequest) GetRequestID() (o string) {
 	if v != nil {
-		return v.Identity
+		return v.RequestID
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RespondActivityTaskCanceledRequest) ByteSize() uint64 {
-	return 0
-}
-
-// RespondActivityTaskCompletedByIDRequest is an internal type (TBD...)
-type RespondActivityTaskCompletedByIDRequest struct {
-	Domain     string `json:"domain,omitempty"`
-	WorkflowID string `json:"workflowID,omitempty"`
-	RunID      string `json:"runID,omitempty"`
-	ActivityID string `json:"activityID,omitempty"`
-	Result     []byte `json:"result,omitempty"`
-	Identity   string `json:"identity,omitempty"`
+// StartChildWorkflowExecutionDecisionAttributes is an internal type (TBD...)
+type StartChildWorkflowExecutionDecisionAttributes struct {
+	Domain                              string                        `json:"domain,omitempty"`
+	WorkflowID                          string                        `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                     `json:"taskList,omitempty"`
+	Input                               []byte                        `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	ParentClosePolicy                   *ParentClosePolicy            `json:"parentClosePolicy,omitempty"`
+	Control                             []byte                        `json:"control,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy        `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
+	Header                              *Header                       `json:"header,omitempty"`
+	Memo                                *Memo                         `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes             `json:"searchAttributes,omitempty"`
+	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
+	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *RespondActivityTaskCompletedByIDRequest) GetDomain() (o string) {
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
@@ -6282,3290 +7863,3470 @@ func (v *RespondActivityTaskCompletedByIDRequest) GetDomain() (o string) {
 }
 
 // GetWorkflowID is an internal getter (TBD...)
-func (v *RespondActivityTaskCompletedByIDRequest) GetWorkflowID() (o string) {
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetWorkflowID() (o string) {
 	if v != nil {
 		return v.WorkflowID
 	}
 	return
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *RespondActivityTaskCompletedByIDRequest) GetRunID() (o string) {
-	if v != nil {
-		return v.RunID
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
 	}
 	return
 }
 
-// GetActivityID is an internal getter (TBD...)
-func (v *RespondActivityTaskCompletedByIDRequest) GetActivityID() (o string) {
-	if v != nil {
-		return v.ActivityID
+// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
 	}
 	return
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *RespondActivityTaskCompletedByIDRequest) GetIdentity() (o string) {
-	if v != nil {
-		return v.Identity
+// GetParentClosePolicy is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetParentClosePolicy() (o ParentClosePolicy) {
+	if v != nil && v.ParentClosePolicy != nil {
+		return *v.ParentClosePolicy
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RespondActivityTaskCompletedByIDRequest) ByteSize() uint64 {
-	return 0
-}
-
-// RespondActivityTaskCompletedRequest is an internal type (TBD...)
-type RespondActivityTaskCompletedRequest struct {
-	TaskToken []byte `json:"taskToken,omitempty"`
-	Result    []byte `json:"result,omitempty"`
-	Identity  string `json:"identity,omitempty"`
-}
-
-// GetIdentity is an internal getter (TBD...)
-func (v *RespondActivityTaskCompletedRequest) GetIdentity() (o string) {
+// GetCronSchedule is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetCronSchedule() (o string) {
 	if v != nil {
-		return v.Identity
+		return v.CronSchedule
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RespondActivityTaskCompletedRequest) ByteSize() uint64 {
-	return 0
-}
-
-// RespondActivityTaskFailedByIDRequest is an internal type (TBD...)
-type RespondActivityTaskFailedByIDRequest struct {
-	Domain     string  `json:"domain,omitempty"`
-	WorkflowID string  `json:"workflowID,omitempty"`
-	RunID      string  `json:"runID,omitempty"`
-	ActivityID string  `json:"activityID,omitempty"`
-	Reason     *string `json:"reason,omitempty"`
-	Details    []byte  `json:"details,omitempty"`
-	Identity   string  `json:"identity,omitempty"`
+// StartChildWorkflowExecutionFailedEventAttributes is an internal type (TBD...)
+type StartChildWorkflowExecutionFailedEventAttributes struct {
+	Domain                       string                             `json:"domain,omitempty"`
+	WorkflowID                   string                             `json:"workflowId,omitempty"`
+	WorkflowType                 *WorkflowType                      `json:"workflowType,omitempty"`
+	Cause                        *ChildWorkflowExecutionFailedCause `json:"cause,omitempty"`
+	Control                      []byte                             `json:"control,omitempty"`
+	InitiatedEventID             int64                              `json:"initiatedEventId,omitempty"`
+	DecisionTaskCompletedEventID int64                              `json:"decisionTaskCompletedEventId,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
-func (v *RespondActivityTaskFailedByIDRequest) GetDomain() (o string) {
+func (v *StartChildWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
 	if v != nil {
 		return v.Domain
 	}
 	return
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *RespondActivityTaskFailedByIDRequest) GetWorkflowID() (o string) {
+// GetInitiatedEventID is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionFailedEventAttributes) GetInitiatedEventID() (o int64) {
 	if v != nil {
-		return v.WorkflowID
+		return v.InitiatedEventID
 	}
 	return
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *RespondActivityTaskFailedByIDRequest) GetRunID() (o string) {
-	if v != nil {
-		return v.RunID
-	}
-	return
+// Size returns the approximate memory used in bytes
+func (v *StartChildWorkflowExecutionFailedEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// GetActivityID is an internal getter (TBD...)
-func (v *RespondActivityTaskFailedByIDRequest) GetActivityID() (o string) {
-	if v != nil {
-		return v.ActivityID
-	}
-	return
+// StartChildWorkflowExecutionInitiatedEventAttributes is an internal type (TBD...)
+type StartChildWorkflowExecutionInitiatedEventAttributes struct {
+	Domain                              string                        `json:"domain,omitempty"`
+	WorkflowID                          string                        `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                     `json:"taskList,omitempty"`
+	Input                               []byte                        `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	ParentClosePolicy                   *ParentClosePolicy            `json:"parentClosePolicy,omitempty"`
+	Control                             []byte                        `json:"control,omitempty"`
+	DecisionTaskCompletedEventID        int64                         `json:"decisionTaskCompletedEventId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy        `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
+	Header                              *Header                       `json:"header,omitempty"`
+	Memo                                *Memo                         `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes             `json:"searchAttributes,omitempty"`
+	DelayStartSeconds                   *int32                        `json:"delayStartSeconds,omitempty"`
+	JitterStartSeconds                  *int32                        `json:"jitterStartSeconds,omitempty"`
+	FirstRunAtTimestamp                 *int64                        `json:"firstRunAtTimestamp,omitempty"`
+	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
+	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *RespondActivityTaskFailedByIDRequest) GetIdentity() (o string) {
+// GetDomain is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
 	if v != nil {
-		return v.Identity
+		return v.Domain
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RespondActivityTaskFailedByIDRequest) ByteSize() uint64 {
-	return 0
-}
-
-// RespondActivityTaskFailedRequest is an internal type (TBD...)
-type RespondActivityTaskFailedRequest struct {
-	TaskToken []byte  `json:"taskToken,omitempty"`
-	Reason    *string `json:"reason,omitempty"`
-	Details   []byte  `json:"details,omitempty"`
-	Identity  string  `json:"identity,omitempty"`
-}
-
-// GetReason is an internal getter (TBD...)
-func (v *RespondActivityTaskFailedRequest) GetReason() (o string) {
-	if v != nil && v.Reason != nil {
-		return *v.Reason
+// GetWorkflowID is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
 	}
 	return
 }
 
-// GetDetails is an internal getter (TBD...)
-func (v *RespondActivityTaskFailedRequest) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+// GetWorkflowType is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
 	}
 	return
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *RespondActivityTaskFailedRequest) GetIdentity() (o string) {
-	if v != nil {
-		return v.Identity
+// GetParentClosePolicy is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetParentClosePolicy() (o ParentClosePolicy) {
+	if v != nil && v.ParentClosePolicy != nil {
+		return *v.ParentClosePolicy
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RespondActivityTaskFailedRequest) ByteSize() uint64 {
-	return 0
-}
-
-// RespondDecisionTaskCompletedRequest is an internal type (TBD...)
-type RespondDecisionTaskCompletedRequest struct {
-	TaskToken                  []byte                          `json:"taskToken,omitempty"`
-	Decisions                  []*Decision                     `json:"decisions,omitempty"`
-	ExecutionContext           []byte                          `json:"executionContext,omitempty"`
-	Identity                   string                          `json:"identity,omitempty"`
-	StickyAttributes           *StickyExecutionAttributes      `json:"stickyAttributes,omitempty"`
-	ReturnNewDecisionTask      bool                            `json:"returnNewDecisionTask,omitempty"`
-	ForceCreateNewDecisionTask bool                            `json:"forceCreateNewDecisionTask,omitempty"`
-	BinaryChecksum             string                          `json:"binaryChecksum,omitempty"`
-	QueryResults               map[string]*WorkflowQueryResult `json:"queryResults,omitempty"`
-}
-
-// GetIdentity is an internal getter (TBD...)
-func (v *RespondDecisionTaskCompletedRequest) GetIdentity() (o string) {
-	if v != nil {
-		return v.Identity
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
 	}
 	return
 }
 
-// GetReturnNewDecisionTask is an internal getter (TBD...)
-func (v *RespondDecisionTaskCompletedRequest) GetReturnNewDecisionTask() (o bool) {
-	if v != nil {
-		return v.ReturnNewDecisionTask
+// Size returns the approximate memory used in bytes
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// StartTimeFilter is an internal type (TBD...)
+type StartTimeFilter struct {
+	EarliestTime *int64 `json:"earliestTime,omitempty"`
+	LatestTime   *int64 `json:"latestTime,omitempty"`
+}
+
+// GetEarliestTime is an internal getter (TBD...)
+func (v *StartTimeFilter) GetEarliestTime() (o int64) {
+	if v != nil && v.EarliestTime != nil {
+		return *v.EarliestTime
 	}
 	return
 }
 
-// GetForceCreateNewDecisionTask is an internal getter (TBD...)
-func (v *RespondDecisionTaskCompletedRequest) GetForceCreateNewDecisionTask() (o bool) {
-	if v != nil {
-		return v.ForceCreateNewDecisionTask
+// GetLatestTime is an internal getter (TBD...)
+func (v *StartTimeFilter) GetLatestTime() (o int64) {
+	if v != nil && v.LatestTime != nil {
+		return *v.LatestTime
 	}
 	return
 }
 
-// GetBinaryChecksum is an internal getter (TBD...)
-func (v *RespondDecisionTaskCompletedRequest) GetBinaryChecksum() (o string) {
+// StartTimerDecisionAttributes is an internal type (TBD...)
+type StartTimerDecisionAttributes struct {
+	TimerID                   string `json:"timerId,omitempty"`
+	StartToFireTimeoutSeconds *int64 `json:"startToFireTimeoutSeconds,omitempty"`
+}
+
+// GetTimerID is an internal getter (TBD...)
+func (v *StartTimerDecisionAttributes) GetTimerID() (o string) {
 	if v != nil {
-		return v.BinaryChecksum
+		return v.TimerID
 	}
 	return
 }
 
-// GetQueryResults is an internal getter (TBD...)
-func (v *RespondDecisionTaskCompletedRequest) GetQueryResults() (o map[string]*WorkflowQueryResult) {
-	if v != nil && v.QueryResults != nil {
-		return v.QueryResults
+// GetStartToFireTimeoutSeconds is an internal getter (TBD...)
+func (v *StartTimerDecisionAttributes) GetStartToFireTimeoutSeconds() (o int64) {
+	if v != nil && v.StartToFireTimeoutSeconds != nil {
+		return *v.StartToFireTimeoutSeconds
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RespondDecisionTaskCompletedRequest) ByteSize() uint64 {
-	return 0
+type RestartWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
 }
 
-// RespondDecisionTaskCompletedResponse is an internal type (TBD...)
-type RespondDecisionTaskCompletedResponse struct {
-	DecisionTask                *PollForDecisionTaskResponse          `json:"decisionTask,omitempty"`
-	ActivitiesToDispatchLocally map[string]*ActivityLocalDispatchInfo `json:"activitiesToDispatchLocally,omitempty"`
+// GetDomain is an internal getter (TBD...)
+func (v *RestartWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
 }
 
-// GetDecisionTask is an internal getter (TBD...)
-func (v *RespondDecisionTaskCompletedResponse) GetDecisionTask() (o *PollForDecisionTaskResponse) {
-	if v != nil && v.DecisionTask != nil {
-		return v.DecisionTask
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *RestartWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *RespondDecisionTaskCompletedResponse) ByteSize() uint64 {
-	return 0
+type DiagnoseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
 }
 
-// RespondDecisionTaskFailedRequest is an internal type (TBD...)
-type RespondDecisionTaskFailedRequest struct {
-	TaskToken      []byte                   `json:"taskToken,omitempty"`
-	Cause          *DecisionTaskFailedCause `json:"cause,omitempty"`
-	Details        []byte                   `json:"details,omitempty"`
-	Identity       string                   `json:"identity,omitempty"`
-	BinaryChecksum string                   `json:"binaryChecksum,omitempty"`
+// GetDomain returns the domain
+func (v *DiagnoseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
 }
 
-// GetCause is an internal getter (TBD...)
-func (v *RespondDecisionTaskFailedRequest) GetCause() (o DecisionTaskFailedCause) {
-	if v != nil && v.Cause != nil {
-		return *v.Cause
+// GetWorkflowExecution returns the workflow execution
+func (v *DiagnoseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *RespondDecisionTaskFailedRequest) GetIdentity() (o string) {
+// GetIdentity returns the identity
+func (v *DiagnoseWorkflowExecutionRequest) GetIdentity() (o string) {
 	if v != nil {
 		return v.Identity
 	}
 	return
 }
 
-// GetBinaryChecksum is an internal getter (TBD...)
-func (v *RespondDecisionTaskFailedRequest) GetBinaryChecksum() (o string) {
+type DiagnoseWorkflowExecutionResponse struct {
+	Domain                      string             `json:"domain,omitempty"`
+	DiagnosticWorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// GetDomain returns the fomain
+func (v *DiagnoseWorkflowExecutionResponse) GetDomain() (o string) {
 	if v != nil {
-		return v.BinaryChecksum
+		return v.Domain
 	}
 	return
 }
 
-// RespondQueryTaskCompletedRequest is an internal type (TBD...)
-type RespondQueryTaskCompletedRequest struct {
-	TaskToken         []byte                  `json:"taskToken,omitempty"`
-	CompletedType     *QueryTaskCompletedType `json:"completedType,omitempty"`
-	QueryResult       []byte                  `json:"queryResult,omitempty"`
-	ErrorMessage      string                  `json:"errorMessage,omitempty"`
-	WorkerVersionInfo *WorkerVersionInfo      `json:"workerVersionInfo,omitempty"`
-}
-
-// GetCompletedType is an internal getter (TBD...)
-func (v *RespondQueryTaskCompletedRequest) GetCompletedType() (o QueryTaskCompletedType) {
-	if v != nil && v.CompletedType != nil {
-		return *v.CompletedType
+// GetDiagnosticWorkflowExecution returns the workflow execution
+func (v *DiagnoseWorkflowExecutionResponse) GetDiagnosticWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.DiagnosticWorkflowExecution != nil {
+		return v.DiagnosticWorkflowExecution
 	}
 	return
 }
 
-// GetQueryResult is an internal getter (TBD...)
-func (v *RespondQueryTaskCompletedRequest) GetQueryResult() (o []byte) {
-	if v != nil && v.QueryResult != nil {
-		return v.QueryResult
+// StartWorkflowExecutionRequest is an internal type (TBD...)
+type StartWorkflowExecutionRequest struct {
+	Domain                              string                        `json:"domain,omitempty"`
+	WorkflowID                          string                        `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                     `json:"taskList,omitempty"`
+	Input                               []byte                        `json:"-"`
+	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                        `json:"identity,omitempty"`
+	RequestID                           string                        `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy        `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                         `json:"-"`
+	SearchAttributes                    *SearchAttributes             `json:"-"`
+	Header                              *Header                       `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                        `json:"delayStartSeconds,omitempty"`
+	JitterStartSeconds                  *int32                        `json:"jitterStartSeconds,omitempty"`
+	FirstRunAtTimeStamp                 *int64                        `json:"firstRunAtTimeStamp,omitempty"`
+	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
+	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	TaskPriority                        *TaskPriority                 `json:"taskPriority,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetErrorMessage is an internal getter (TBD...)
-func (v *RespondQueryTaskCompletedRequest) GetErrorMessage() (o string) {
+// GetWorkflowID is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetWorkflowID() (o string) {
 	if v != nil {
-		return v.ErrorMessage
+		return v.WorkflowID
 	}
 	return
 }
 
-// GetWorkerVersionInfo is an internal getter (TBD...)
-func (v *RespondQueryTaskCompletedRequest) GetWorkerVersionInfo() (o *WorkerVersionInfo) {
-	if v != nil && v.WorkerVersionInfo != nil {
-		return v.WorkerVersionInfo
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
 	}
 	return
 }
 
-// RetryPolicy is an internal type (TBD...)
-type RetryPolicy struct {
-	InitialIntervalInSeconds    int32    `json:"initialIntervalInSeconds,omitempty"`
-	BackoffCoefficient          float64  `json:"backoffCoefficient,omitempty"`
-	MaximumIntervalInSeconds    int32    `json:"maximumIntervalInSeconds,omitempty"`
-	MaximumAttempts             int32    `json:"maximumAttempts,omitempty"`
-	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
-	ExpirationIntervalInSeconds int32    `json:"expirationIntervalInSeconds,omitempty"`
+// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
+	}
+	return
 }
 
-// GetInitialIntervalInSeconds is an internal getter (TBD...)
-func (v *RetryPolicy) GetInitialIntervalInSeconds() (o int32) {
-	if v != nil {
-		return v.InitialIntervalInSeconds
+// GetDelayStartSeconds is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetDelayStartSeconds() (o int32) {
+	if v != nil && v.DelayStartSeconds != nil {
+		return *v.DelayStartSeconds
 	}
 	return
 }
 
-// GetBackoffCoefficient is an internal getter (TBD...)
-func (v *RetryPolicy) GetBackoffCoefficient() (o float64) {
-	if v != nil {
-		return v.BackoffCoefficient
+// GetJitterStartSeconds is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetJitterStartSeconds() (o int32) {
+	if v != nil && v.JitterStartSeconds != nil {
+		return *v.JitterStartSeconds
 	}
 	return
 }
 
-// GetMaximumIntervalInSeconds is an internal getter (TBD...)
-func (v *RetryPolicy) GetMaximumIntervalInSeconds() (o int32) {
-	if v != nil {
-		return v.MaximumIntervalInSeconds
+// GetFirstRunAtTimeStamp is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetFirstRunAtTimeStamp() (o int64) {
+	if v != nil && v.FirstRunAtTimeStamp != nil {
+		return *v.FirstRunAtTimeStamp
 	}
 	return
 }
 
-// GetMaximumAttempts is an internal getter (TBD...)
-func (v *RetryPolicy) GetMaximumAttempts() (o int32) {
+// GetRequestID is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetRequestID() (o string) {
 	if v != nil {
-		return v.MaximumAttempts
+		return v.RequestID
 	}
 	return
 }
 
-// GetNonRetriableErrorReasons is an internal getter (TBD...)
-func (v *RetryPolicy) GetNonRetriableErrorReasons() (o []string) {
-	if v != nil && v.NonRetriableErrorReasons != nil {
-		return v.NonRetriableErrorReasons
+// GetWorkflowIDReusePolicy is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o WorkflowIDReusePolicy) {
+	if v != nil && v.WorkflowIDReusePolicy != nil {
+		return *v.WorkflowIDReusePolicy
 	}
 	return
 }
 
-// GetExpirationIntervalInSeconds is an internal getter (TBD...)
-func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
+// GetCronSchedule is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	if v != nil {
-		return v.ExpirationIntervalInSeconds
+		return v.CronSchedule
 	}
 	return
 }
 
-// RetryTaskV2Error is an internal type (TBD...)
-type RetryTaskV2Error struct {
-	Message           string `json:"message,required"`
-	DomainID          string `json:"domainId,omitempty"`
-	WorkflowID        string `json:"workflowId,omitempty"`
-	RunID             string `json:"runId,omitempty"`
-	StartEventID      *int64 `json:"startEventId,omitempty"`
-	StartEventVersion *int64 `json:"startEventVersion,omitempty"`
-	EndEventID        *int64 `json:"endEventId,omitempty"`
-	EndEventVersion   *int64 `json:"endEventVersion,omitempty"`
-}
-
-// GetDomainID is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetDomainID() (o string) {
-	if v != nil {
-		return v.DomainID
+// GetCronOverlapPolicy is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetCronOverlapPolicy() (o CronOverlapPolicy) {
+	if v != nil && v.CronOverlapPolicy != nil {
+		return *v.CronOverlapPolicy
 	}
 	return
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetWorkflowID() (o string) {
-	if v != nil {
-		return v.WorkflowID
-	}
-	return
+// StartWorkflowExecutionResponse is an internal type (TBD...)
+type StartWorkflowExecutionResponse struct {
+	RunID string `json:"runId,omitempty"`
 }
 
 // GetRunID is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetRunID() (o string) {
+func (v *StartWorkflowExecutionResponse) GetRunID() (o string) {
 	if v != nil {
 		return v.RunID
 	}
 	return
 }
 
-// ScheduleActivityTaskDecisionAttributes is an internal type (TBD...)
-type ScheduleActivityTaskDecisionAttributes struct {
-	ActivityID                    string        `json:"activityId,omitempty"`
-	ActivityType                  *ActivityType `json:"activityType,omitempty"`
-	Domain                        string        `json:"domain,omitempty"`
-	TaskList                      *TaskList     `json:"taskList,omitempty"`
-	Input                         []byte        `json:"input,omitempty"`
-	ScheduleToCloseTimeoutSeconds *int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
-	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
-	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
-	Header                        *Header       `json:"header,omitempty"`
-	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+type StartWorkflowExecutionAsyncRequest struct {
+	*StartWorkflowExecutionRequest
 }
 
-// GetActivityID is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetActivityID() (o string) {
-	if v != nil {
-		return v.ActivityID
-	}
-	return
+type StartWorkflowExecutionAsyncResponse struct {
 }
 
-// GetActivityType is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetActivityType() (o *ActivityType) {
-	if v != nil && v.ActivityType != nil {
-		return v.ActivityType
-	}
-	return
+// RestartWorkflowExecutionResponse is an internal type (TBD...)
+type RestartWorkflowExecutionResponse struct {
+	RunID string `json:"runId,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetDomain() (o string) {
+// GetRunID is an internal getter (TBD...)
+func (v *RestartWorkflowExecutionResponse) GetRunID() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.RunID
 	}
 	return
 }
 
-// GetScheduleToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetScheduleToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
-		return *v.ScheduleToCloseTimeoutSeconds
-	}
-	return
+// StickyExecutionAttributes is an internal type (TBD...)
+type StickyExecutionAttributes struct {
+	WorkerTaskList                *TaskList `json:"workerTaskList,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32    `json:"scheduleToStartTimeoutSeconds,omitempty"`
 }
 
 // GetScheduleToStartTimeoutSeconds is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetScheduleToStartTimeoutSeconds() (o int32) {
+func (v *StickyExecutionAttributes) GetScheduleToStartTimeoutSeconds() (o int32) {
 	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
 		return *v.ScheduleToStartTimeoutSeconds
 	}
 	return
 }
 
-// GetStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.StartToCloseTimeoutSeconds != nil {
-		return *v.StartToCloseTimeoutSeconds
+// SupportedClientVersions is an internal type (TBD...)
+type SupportedClientVersions struct {
+	GoSdk   string `json:"goSdk,omitempty"`
+	JavaSdk string `json:"javaSdk,omitempty"`
+}
+
+// TaskIDBlock is an internal type (TBD...)
+type TaskIDBlock struct {
+	StartID int64 `json:"startID,omitempty"`
+	EndID   int64 `json:"endID,omitempty"`
+}
+
+// GetStartID is an internal getter (TBD...)
+func (v *TaskIDBlock) GetStartID() (o int64) {
+	if v != nil {
+		return v.StartID
 	}
 	return
 }
 
-// GetHeartbeatTimeoutSeconds is an internal getter (TBD...)
-func (v *ScheduleActivityTaskDecisionAttributes) GetHeartbeatTimeoutSeconds() (o int32) {
-	if v != nil && v.HeartbeatTimeoutSeconds != nil {
-		return *v.HeartbeatTimeoutSeconds
+// GetEndID is an internal getter (TBD...)
+func (v *TaskIDBlock) GetEndID() (o int64) {
+	if v != nil {
+		return v.EndID
 	}
 	return
 }
 
-// SearchAttributes is an internal type (TBD...)
-type SearchAttributes struct {
-	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
+// TaskList is an internal type (TBD...)
+type TaskList struct {
+	Name string        `json:"name,omitempty"`
+	Kind *TaskListKind `json:"kind,omitempty"`
 }
 
-// GetIndexedFields is an internal getter (TBD...)
-func (v *SearchAttributes) GetIndexedFields() (o map[string][]byte) {
-	if v != nil && v.IndexedFields != nil {
-		return v.IndexedFields
+// GetName is an internal getter (TBD...)
+func (v *TaskList) GetName() (o string) {
+	if v != nil {
+		return v.Name
 	}
 	return
 }
 
-// ServiceBusyError is an internal type (TBD...)
-type ServiceBusyError struct {
-	Message string `json:"message,required"`
-	Reason  string `json:"reason,omitempty"`
+// GetKind is an internal getter (TBD...)
+func (v *TaskList) GetKind() (o TaskListKind) {
+	if v != nil && v.Kind != nil {
+		return *v.Kind
+	}
+	return
 }
 
-// SignalExternalWorkflowExecutionDecisionAttributes is an internal type (TBD...)
-type SignalExternalWorkflowExecutionDecisionAttributes struct {
-	Domain            string             `json:"domain,omitempty"`
-	Execution         *WorkflowExecution `json:"execution,omitempty"`
-	SignalName        string             `json:"signalName,omitempty"`
-	Input             []byte             `json:"input,omitempty"`
-	Control           []byte             `json:"control,omitempty"`
-	ChildWorkflowOnly bool               `json:"childWorkflowOnly,omitempty"`
+// TaskListKind is an internal type (TBD...)
+type TaskListKind int32
+
+// Ptr is a helper function for getting pointer value
+func (e TaskListKind) Ptr() *TaskListKind {
+	return &e
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *SignalExternalWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// String returns a readable string representation of TaskListKind.
+func (e TaskListKind) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "NORMAL"
+	case 1:
+		return "STICKY"
+	case 2:
+		return "EPHEMERAL"
 	}
-	return
+	return fmt.Sprintf("TaskListKind(%d)", w)
 }
 
-// GetSignalName is an internal getter (TBD...)
-func (v *SignalExternalWorkflowExecutionDecisionAttributes) GetSignalName() (o string) {
-	if v != nil {
-		return v.SignalName
+// UnmarshalText parses enum value from string representation
+func (e *TaskListKind) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "NORMAL":
+		*e = TaskListKindNormal
+		return nil
+	case "STICKY":
+		*e = TaskListKindSticky
+		return nil
+	case "EPHEMERAL":
+		*e = TaskListKindEphemeral
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskListKind", err)
+		}
+		*e = TaskListKind(val)
+		return nil
 	}
-	return
 }
 
-// SignalExternalWorkflowExecutionFailedCause is an internal type (TBD...)
-type SignalExternalWorkflowExecutionFailedCause int32
+// MarshalText encodes TaskListKind to text.
+func (e TaskListKind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// TaskListKindNormal is an option for TaskListKind
+	TaskListKindNormal TaskListKind = iota
+	// TaskListKindSticky is an option for TaskListKind
+	TaskListKindSticky
+	// TaskListKindEphemeral is an option for TaskListKind
+	TaskListKindEphemeral
+)
+
+// TaskPriority is the priority of activity and decision tasks within a task list
+type TaskPriority int32
 
 // Ptr is a helper function for getting pointer value
-func (e SignalExternalWorkflowExecutionFailedCause) Ptr() *SignalExternalWorkflowExecutionFailedCause {
+func (e TaskPriority) Ptr() *TaskPriority {
 	return &e
 }
 
-// String returns a readable string representation of SignalExternalWorkflowExecutionFailedCause.
-func (e SignalExternalWorkflowExecutionFailedCause) String() string {
+// String returns a readable string representation of TaskPriority.
+func (e TaskPriority) String() string {
 	w := int32(e)
 	switch w {
 	case 0:
-		return "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+		return "HIGHEST"
 	case 1:
-		return "WORKFLOW_ALREADY_COMPLETED"
+		return "HIGH"
+	case 2:
+		return "NORMAL"
+	case 3:
+		return "LOW"
+	case 4:
+		return "LOWEST"
 	}
-	return fmt.Sprintf("SignalExternalWorkflowExecutionFailedCause(%d)", w)
+	return fmt.Sprintf("TaskPriority(%d)", w)
 }
 
 // UnmarshalText parses enum value from string representation
-func (e *SignalExternalWorkflowExecutionFailedCause) UnmarshalText(value []byte) error {
+func (e *TaskPriority) UnmarshalText(value []byte) error {
 	switch s := strings.ToUpper(string(value)); s {
-	case "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION":
-		*e = SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution
+	case "HIGHEST":
+		*e = TaskPriorityHighest
 		return nil
-	case "WORKFLOW_ALREADY_COMPLETED":
-		*e = SignalExternalWorkflowExecutionFailedCauseWorkflowAlreadyCompleted
+	case "HIGH":
+		*e = TaskPriorityHigh
+		return nil
+	case "NORMAL":
+		*e = TaskPriorityNormal
+		return nil
+	case "LOW":
+		*e = TaskPriorityLow
+		return nil
+	case "LOWEST":
+		*e = TaskPriorityLowest
 		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "SignalExternalWorkflowExecutionFailedCause", err)
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskPriority", err)
 		}
-		*e = SignalExternalWorkflowExecutionFailedCause(val)
+		*e = TaskPriority(val)
 		return nil
 	}
 }
 
-// MarshalText encodes SignalExternalWorkflowExecutionFailedCause to text.
-func (e SignalExternalWorkflowExecutionFailedCause) MarshalText() ([]byte, error) {
+// MarshalText encodes TaskPriority to text.
+func (e TaskPriority) MarshalText() ([]byte, error) {
 	return []byte(e.String()), nil
 }
 
 const (
-	// SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution is an option for SignalExternalWorkflowExecutionFailedCause
-	SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution SignalExternalWorkflowExecutionFailedCause = iota
-	SignalExternalWorkflowExecutionFailedCauseWorkflowAlreadyCompleted
+	// TaskPriorityHighest is an option for TaskPriority
+	TaskPriorityHighest TaskPriority = iota
+	// TaskPriorityHigh is an option for TaskPriority
+	TaskPriorityHigh
+	// TaskPriorityNormal is an option for TaskPriority
+	TaskPriorityNormal
+	// TaskPriorityLow is an option for TaskPriority
+	TaskPriorityLow
+	// TaskPriorityLowest is an option for TaskPriority
+	TaskPriorityLowest
 )
 
-// SignalExternalWorkflowExecutionFailedEventAttributes is an internal type (TBD...)
-type SignalExternalWorkflowExecutionFailedEventAttributes struct {
-	Cause                        *SignalExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
-	DecisionTaskCompletedEventID int64                                       `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       string                                      `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
-	InitiatedEventID             int64                                       `json:"initiatedEventId,omitempty"`
-	Control                      []byte                                      `json:"control,omitempty"`
+// TaskListMetadata is an internal type (TBD...)
+type TaskListMetadata struct {
+	MaxTasksPerSecond *float64 `json:"maxTasksPerSecond,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *SignalExternalWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
+// TaskListPartitionMetadata is an internal type (TBD...)
+type TaskListPartitionMetadata struct {
+	Key           string `json:"key,omitempty"`
+	OwnerHostName string `json:"ownerHostName,omitempty"`
+}
+
+// GetKey is an internal getter (TBD...)
+func (v *TaskListPartitionMetadata) GetKey() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.Key
 	}
 	return
 }
 
-// GetInitiatedEventID is an internal getter (TBD...)
-func (v *SignalExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventID() (o int64) {
+// GetOwnerHostName is an internal getter (TBD...)
+func (v *TaskListPartitionMetadata) GetOwnerHostName() (o string) {
 	if v != nil {
-		return v.InitiatedEventID
+		return v.OwnerHostName
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *SignalExternalWorkflowExecutionFailedEventAttributes) ByteSize() uint64 {
-	return 0
-}
-
-// SignalExternalWorkflowExecutionInitiatedEventAttributes is an internal type (TBD...)
-type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
-	DecisionTaskCompletedEventID int64              `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       string             `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
-	SignalName                   string             `json:"signalName,omitempty"`
-	Input                        []byte             `json:"input,omitempty"`
-	Control                      []byte             `json:"control,omitempty"`
-	ChildWorkflowOnly            bool               `json:"childWorkflowOnly,omitempty"`
+type IsolationGroupMetrics struct {
+	NewTasksPerSecond float64 `json:"newTasksPerSecond,omitempty"`
+	PollerCount       int64   `json:"pollerCount,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
+// TaskListStatus is an internal type (TBD...)
+type TaskListStatus struct {
+	BacklogCountHint       int64                             `json:"backlogCountHint,omitempty"`
+	ReadLevel              int64                             `json:"readLevel,omitempty"`
+	AckLevel               int64                             `json:"ackLevel,omitempty"`
+	RatePerSecond          float64                           `json:"ratePerSecond,omitempty"`
+	TaskIDBlock            *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
+	IsolationGroupMetrics  map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
+	NewTasksPerSecond      float64                           `json:"newTasksPerSecond,omitempty"`
+	Empty                  bool                              `json:"empty,omitempty"`
+	BacklogCountByPriority map[string]int64                  `json:"backlogCountByPriority,omitempty"`
+}
+
+// GetBacklogCountHint is an internal getter (TBD...)
+func (v *TaskListStatus) GetBacklogCountHint() (o int64) {
 	if v != nil {
-		return v.Domain
+		return v.BacklogCountHint
 	}
 	return
 }
 
-// GetWorkflowExecution is an internal getter (TBD...)
-func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+// GetReadLevel is an internal getter (TBD...)
+func (v *TaskListStatus) GetReadLevel() (o int64) {
+	if v != nil {
+		return v.ReadLevel
 	}
 	return
 }
 
-// GetSignalName is an internal getter (TBD...)
-func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetSignalName() (o string) {
+// GetAckLevel is an internal getter (TBD...)
+func (v *TaskListStatus) GetAckLevel() (o int64) {
 	if v != nil {
-		return v.SignalName
+		return v.AckLevel
 	}
 	return
 }
 
-// GetChildWorkflowOnly is an internal getter (TBD...)
-func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
+// GetRatePerSecond is an internal getter (TBD...)
+func (v *TaskListStatus) GetRatePerSecond() (o float64) {
 	if v != nil {
-		return v.ChildWorkflowOnly
+		return v.RatePerSecond
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) ByteSize() uint64 {
-	return 0
+// GetTaskIDBlock is an internal getter (TBD...)
+func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
+	if v != nil && v.TaskIDBlock != nil {
+		return v.TaskIDBlock
+	}
+	return
 }
 
-// SignalWithStartWorkflowExecutionRequest is an internal type (TBD...)
-type SignalWithStartWorkflowExecutionRequest struct {
-	Domain                              string                        `json:"domain,omitempty"`
-	WorkflowID                          string                        `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
-	TaskList                            *TaskList                     `json:"taskList,omitempty"`
-	Input                               []byte                        `json:"-"` // Filtering PII
-	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                        `json:"identity,omitempty"`
-	RequestID                           string                        `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy        `json:"workflowIdReusePolicy,omitempty"`
-	SignalName                          string                        `json:"signalName,omitempty"`
-	SignalInput                         []byte                        `json:"-"` // Filtering PII
-	Control                             []byte                        `json:"control,omitempty"`
-	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                         `json:"-"` // Filtering PII
-	SearchAttributes                    *SearchAttributes             `json:"-"` // Filtering PII
-	Header                              *Header                       `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                        `json:"delayStartSeconds,omitempty"`
-	JitterStartSeconds                  *int32                        `json:"jitterStartSeconds,omitempty"`
-	FirstRunAtTimestamp                 *int64                        `json:"firstRunAtTimestamp,omitempty"`
-	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
-	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+// TaskListType is an internal type (TBD...)
+type TaskListType int32
+
+// Ptr is a helper function for getting pointer value
+func (e TaskListType) Ptr() *TaskListType {
+	return &e
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// String returns a readable string representation of TaskListType.
+func (e TaskListType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "Decision"
+	case 1:
+		return "Activity"
 	}
-	return
+	return fmt.Sprintf("TaskListType(%d)", w)
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowID() (o string) {
-	if v != nil {
-		return v.WorkflowID
+// UnmarshalText parses enum value from string representation
+func (e *TaskListType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "DECISION":
+		*e = TaskListTypeDecision
+		return nil
+	case "ACTIVITY":
+		*e = TaskListTypeActivity
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskListType", err)
+		}
+		*e = TaskListType(val)
+		return nil
 	}
-	return
 }
 
-// GetWorkflowType is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowType() (o *WorkflowType) {
-	if v != nil && v.WorkflowType != nil {
-		return v.WorkflowType
+// MarshalText encodes TaskListType to text.
+func (e TaskListType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// TaskListTypeDecision is an option for TaskListType
+	TaskListTypeDecision TaskListType = iota
+	// TaskListTypeActivity is an option for TaskListType
+	TaskListTypeActivity
+)
+
+// TerminateWorkflowExecutionRequest is an internal type (TBD...)
+type TerminateWorkflowExecutionRequest struct {
+	Domain              string             `json:"domain,omitempty"`
+	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason              string             `json:"reason,omitempty"`
+	Details             []byte             `json:"details,omitempty"`
+	Identity            string             `json:"identity,omitempty"`
+	FirstExecutionRunID string             `json:"first_execution_run_id,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *TerminateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetTaskList is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetTaskList() (o *TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *TerminateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
-		return *v.ExecutionStartToCloseTimeoutSeconds
+// GetReason is an internal getter (TBD...)
+func (v *TerminateWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
 	}
 	return
 }
 
-// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
-		return *v.TaskStartToCloseTimeoutSeconds
+// GetDetails is an internal getter (TBD...)
+func (v *TerminateWorkflowExecutionRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
 	return
 }
 
 // GetIdentity is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetIdentity() (o string) {
+func (v *TerminateWorkflowExecutionRequest) GetIdentity() (o string) {
 	if v != nil {
 		return v.Identity
 	}
 	return
 }
 
-// GetRequestID is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetRequestID() (o string) {
+// GetFirstExecutionRunID is an internal getter (TBD...)
+func (v *TerminateWorkflowExecutionRequest) GetFirstExecutionRunID() (o string) {
 	if v != nil {
-		return v.RequestID
+		return v.FirstExecutionRunID
 	}
 	return
 }
 
-// GetWorkflowIDReusePolicy is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o WorkflowIDReusePolicy) {
-	if v != nil && v.WorkflowIDReusePolicy != nil {
-		return *v.WorkflowIDReusePolicy
-	}
-	return
+// TimeoutType is an internal type (TBD...)
+type TimeoutType int32
+
+// Ptr is a helper function for getting pointer value
+func (e TimeoutType) Ptr() *TimeoutType {
+	return &e
 }
 
-// GetSignalName is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetSignalName() (o string) {
-	if v != nil {
-		return v.SignalName
+// String returns a readable string representation of TimeoutType.
+func (e TimeoutType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "START_TO_CLOSE"
+	case 1:
+		return "SCHEDULE_TO_START"
+	case 2:
+		return "SCHEDULE_TO_CLOSE"
+	case 3:
+		return "HEARTBEAT"
 	}
-	return
+	return fmt.Sprintf("TimeoutType(%d)", w)
 }
 
-// GetSignalInput is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetSignalInput() (o []byte) {
-	if v != nil && v.SignalInput != nil {
-		return v.SignalInput
+// UnmarshalText parses enum value from string representation
+func (e *TimeoutType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "START_TO_CLOSE":
+		*e = TimeoutTypeStartToClose
+		return nil
+	case "SCHEDULE_TO_START":
+		*e = TimeoutTypeScheduleToStart
+		return nil
+	case "SCHEDULE_TO_CLOSE":
+		*e = TimeoutTypeScheduleToClose
+		return nil
+	case "HEARTBEAT":
+		*e = TimeoutTypeHeartbeat
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TimeoutType", err)
+		}
+		*e = TimeoutType(val)
+		return nil
 	}
-	return
 }
 
-// GetCronSchedule is an internal getter (TBD...)
-func (v *SignalWithStartWorkflowExecutionRequest) GetCronSchedule() (o string) {
+// MarshalText encodes TimeoutType to text.
+func (e TimeoutType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// TimeoutTypeStartToClose is an option for TimeoutType
+	TimeoutTypeStartToClose TimeoutType = iota
+	// TimeoutTypeScheduleToStart is an option for TimeoutType
+	TimeoutTypeScheduleToStart
+	// TimeoutTypeScheduleToClose is an option for TimeoutType
+	TimeoutTypeScheduleToClose
+	// TimeoutTypeHeartbeat is an option for TimeoutType
+	TimeoutTypeHeartbeat
+)
+
+// TimerCanceledEventAttributes is an internal type (TBD...)
+type TimerCanceledEventAttributes struct {
+	TimerID                      string `json:"timerId,omitempty"`
+	StartedEventID               int64  `json:"startedEventId,omitempty"`
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	Identity                     string `json:"identity,omitempty"`
+}
+
+// GetTimerID is an internal getter (TBD...)
+func (v *TimerCanceledEventAttributes) GetTimerID() (o string) {
 	if v != nil {
-		return v.CronSchedule
+		return v.TimerID
 	}
 	return
 }
 
-// SignalWithStartWorkflowExecutionAsyncRequest is an internal type (TBD...)
-type SignalWithStartWorkflowExecutionAsyncRequest struct {
-	*SignalWithStartWorkflowExecutionRequest
-}
-
-// SignalWithStartWorkflowExecutionAsyncResponse is an internal type (TBD...)
-type SignalWithStartWorkflowExecutionAsyncResponse struct {
+// Size returns the approximate memory used in bytes
+func (v *TimerCanceledEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// SignalWorkflowExecutionRequest is an internal type (TBD...)
-type SignalWorkflowExecutionRequest struct {
-	Domain            string             `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	SignalName        string             `json:"signalName,omitempty"`
-	Input             []byte             `json:"-"` // Filtering PII
-	Identity          string             `json:"identity,omitempty"`
-	RequestID         string             `json:"requestId,omitempty"`
-	Control           []byte             `json:"control,omitempty"`
+// TimerFiredEventAttributes is an internal type (TBD...)
+type TimerFiredEventAttributes struct {
+	TimerID        string `json:"timerId,omitempty"`
+	StartedEventID int64  `json:"startedEventId,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *SignalWorkflowExecutionRequest) GetDomain() (o string) {
+// GetTimerID is an internal getter (TBD...)
+func (v *TimerFiredEventAttributes) GetTimerID() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.TimerID
 	}
 	return
 }
 
-// GetWorkflowExecution is an internal getter (TBD...)
-func (v *SignalWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+// GetStartedEventID is an internal getter (TBD...)
+func (v *TimerFiredEventAttributes) GetStartedEventID() (o int64) {
+	if v != nil {
+		return v.StartedEventID
 	}
 	return
 }
 
-// GetSignalName is an internal getter (TBD...)
-func (v *SignalWorkflowExecutionRequest) GetSignalName() (o string) {
-	if v != nil {
-		return v.SignalName
-	}
-	return
+// Size returns the approximate memory used in bytes
+func (v *TimerFiredEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// GetInput is an internal getter (TBD...)
-func (v *SignalWorkflowExecutionRequest) GetInput() (o []byte) {
-	if v != nil && v.Input != nil {
-		return v.Input
-	}
-	return
+// TimerStartedEventAttributes is an internal type (TBD...)
+type TimerStartedEventAttributes struct {
+	TimerID                      string `json:"timerId,omitempty"`
+	StartToFireTimeoutSeconds    *int64 `json:"startToFireTimeoutSeconds,omitempty"`
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *SignalWorkflowExecutionRequest) GetIdentity() (o string) {
+// GetTimerID is an internal getter (TBD...)
+func (v *TimerStartedEventAttributes) GetTimerID() (o string) {
 	if v != nil {
-		return v.Identity
+		return v.TimerID
 	}
 	return
 }
 
-// GetRequestID is an internal getter (TBD...)
-func (v *SignalWorkflowExecutionRequest) GetRequestID() (o string) {
-	if v != nil {
-		return v.RequestID
+// GetStartToFireTimeoutSeconds is an internal getter (TBD...)
+func (v *TimerStartedEventAttributes) GetStartToFireTimeoutSeconds() (o int64) {
+	if v != nil && v.StartToFireTimeoutSeconds != nil {
+		return *v.StartToFireTimeoutSeconds
 	}
 	return
 }
 
-// StartChildWorkflowExecutionDecisionAttributes is an internal type (TBD...)
-type StartChildWorkflowExecutionDecisionAttributes struct {
-	Domain                              string                        `json:"domain,omitempty"`
-	WorkflowID                          string                        `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
-	TaskList                            *TaskList                     `json:"taskList,omitempty"`
-	Input                               []byte                        `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	ParentClosePolicy                   *ParentClosePolicy            `json:"parentClosePolicy,omitempty"`
-	Control                             []byte                        `json:"control,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy        `json:"workflowIdReusePolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
-	Header                              *Header                       `json:"header,omitempty"`
-	Memo                                *Memo                         `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes             `json:"searchAttributes,omitempty"`
-	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
-	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+// Size returns the approximate memory used in bytes
+func (v *TimerStartedEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
-	}
-	return
+// TransientDecisionInfo is an internal type (TBD...)
+type TransientDecisionInfo struct {
+	ScheduledEvent *HistoryEvent `json:"scheduledEvent,omitempty"`
+	StartedEvent   *HistoryEvent `json:"startedEvent,omitempty"`
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionDecisionAttributes) GetWorkflowID() (o string) {
+// UpdateDomainRequest is an internal type (TBD...)
+type UpdateDomainRequest struct {
+	Name                                   string                             `json:"name,omitempty"`
+	Description                            *string                            `json:"description,omitempty"`
+	OwnerEmail                             *string                            `json:"ownerEmail,omitempty"`
+	Data                                   map[string]string                  `json:"data,omitempty"`
+	WorkflowExecutionRetentionPeriodInDays *int32                             `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
+	BadBinaries                            *BadBinaries                       `json:"badBinaries,omitempty"`
+	HistoryArchivalStatus                  *ArchivalStatus                    `json:"historyArchivalStatus,omitempty"`
+	HistoryArchivalURI                     *string                            `json:"historyArchivalURI,omitempty"`
+	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
+	VisibilityArchivalURI                  *string                            `json:"visibilityArchivalURI,omitempty"`
+	ActiveClusterName                      *string                            `json:"activeClusterName,omitempty"`
+	ActiveClusters                         *ActiveClusters                    `json:"activeClusters,omitempty"`
+	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+	SecurityToken                          string                             `json:"securityToken,omitempty"`
+	DeleteBadBinary                        *string                            `json:"deleteBadBinary,omitempty"`
+	FailoverTimeoutInSeconds               *int32                             `json:"failoverTimeoutInSeconds,omitempty"`
+}
+
+// IsAFailoverRequest identifies if any part of the request is a failover request
+// and if so, will return true
+// this includes:
+//
+// - an active cluster change  (force failver)
+// - any failvoer timeout values (for graceful failover)
+// - or a change to one of the domain's cluster-attribute fields (active-active failover)
+//
+// this is not a validation function
+// and doesn't attempt to give valid or coherent combinations
+func (v *UpdateDomainRequest) IsAFailoverRequest() bool {
+	return v.ActiveClusterName != nil ||
+		(v.FailoverTimeoutInSeconds != nil && *v.FailoverTimeoutInSeconds > 0) ||
+		(v.ActiveClusters != nil && len(v.ActiveClusters.AttributeScopes) > 0)
+}
+
+// GetName is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetName() (o string) {
 	if v != nil {
-		return v.WorkflowID
+		return v.Name
 	}
 	return
 }
 
-// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionDecisionAttributes) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
-		return *v.ExecutionStartToCloseTimeoutSeconds
+// GetFailoverTimeoutInSeconds is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
+	if v != nil && v.FailoverTimeoutInSeconds != nil {
+		return *v.FailoverTimeoutInSeconds
 	}
 	return
 }
 
-// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionDecisionAttributes) GetTaskStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
-		return *v.TaskStartToCloseTimeoutSeconds
+// GetHistoryArchivalURI is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetHistoryArchivalURI() (o string) {
+	if v != nil && v.HistoryArchivalURI != nil {
+		return *v.HistoryArchivalURI
 	}
 	return
 }
 
-// GetParentClosePolicy is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionDecisionAttributes) GetParentClosePolicy() (o ParentClosePolicy) {
-	if v != nil && v.ParentClosePolicy != nil {
-		return *v.ParentClosePolicy
+// GetVisibilityArchivalURI is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetVisibilityArchivalURI() (o string) {
+	if v != nil && v.VisibilityArchivalURI != nil {
+		return *v.VisibilityArchivalURI
 	}
 	return
 }
 
-// GetCronSchedule is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionDecisionAttributes) GetCronSchedule() (o string) {
-	if v != nil {
-		return v.CronSchedule
+// UpdateDomainResponse is an internal type (TBD...)
+type UpdateDomainResponse struct {
+	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
+	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
+	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+	FailoverVersion          int64                           `json:"failoverVersion,omitempty"`
+	IsGlobalDomain           bool                            `json:"isGlobalDomain,omitempty"`
+}
+
+func (v *UpdateDomainResponse) ToFailoverDomainResponse() *FailoverDomainResponse {
+	if v == nil {
+		return nil
+	}
+	return &FailoverDomainResponse{
+		DomainInfo:               v.DomainInfo,
+		Configuration:            v.Configuration,
+		ReplicationConfiguration: v.ReplicationConfiguration,
+		FailoverVersion:          v.FailoverVersion,
+		IsGlobalDomain:           v.IsGlobalDomain,
 	}
-	return
 }
 
-// StartChildWorkflowExecutionFailedEventAttributes is an internal type (TBD...)
-type StartChildWorkflowExecutionFailedEventAttributes struct {
-	Domain                       string                             `json:"domain,omitempty"`
-	WorkflowID                   string                             `json:"workflowId,omitempty"`
-	WorkflowType                 *WorkflowType                      `json:"workflowType,omitempty"`
-	Cause                        *ChildWorkflowExecutionFailedCause `json:"cause,omitempty"`
-	Control                      []byte                             `json:"control,omitempty"`
-	InitiatedEventID             int64                              `json:"initiatedEventId,omitempty"`
-	DecisionTaskCompletedEventID int64                              `json:"decisionTaskCompletedEventId,omitempty"`
+// GetDomainInfo is an internal getter (TBD...)
+func (v *UpdateDomainResponse) GetDomainInfo() (o *DomainInfo) {
+	if v != nil && v.DomainInfo != nil {
+		return v.DomainInfo
+	}
+	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
+// GetFailoverVersion is an internal getter (TBD...)
+func (v *UpdateDomainResponse) GetFailoverVersion() (o int64) {
 	if v != nil {
-		return v.Domain
+		return v.FailoverVersion
 	}
 	return
 }
 
-// GetInitiatedEventID is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionFailedEventAttributes) GetInitiatedEventID() (o int64) {
+// GetIsGlobalDomain is an internal getter (TBD...)
+func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	if v != nil {
-		return v.InitiatedEventID
+		return v.IsGlobalDomain
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *StartChildWorkflowExecutionFailedEventAttributes) ByteSize() uint64 {
-	return 0
+// UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
+type UpsertWorkflowSearchAttributesDecisionAttributes struct {
+	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
 }
 
-// StartChildWorkflowExecutionInitiatedEventAttributes is an internal type (TBD...)
-type StartChildWorkflowExecutionInitiatedEventAttributes struct {
-	Domain                              string                        `json:"domain,omitempty"`
-	WorkflowID                          string                        `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
-	TaskList                            *TaskList                     `json:"taskList,omitempty"`
-	Input                               []byte                        `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	ParentClosePolicy                   *ParentClosePolicy            `json:"parentClosePolicy,omitempty"`
-	Control                             []byte                        `json:"control,omitempty"`
-	DecisionTaskCompletedEventID        int64                         `json:"decisionTaskCompletedEventId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy        `json:"workflowIdReusePolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
-	Header                              *Header                       `json:"header,omitempty"`
-	Memo                                *Memo                         `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes             `json:"searchAttributes,omitempty"`
-	DelayStartSeconds                   *int32                        `json:"delayStartSeconds,omitempty"`
-	JitterStartSeconds                  *int32                        `json:"jitterStartSeconds,omitempty"`
-	FirstRunAtTimestamp                 *int64                        `json:"firstRunAtTimestamp,omitempty"`
-	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
-	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
+// UpsertWorkflowSearchAttributesEventAttributes is an internal type (TBD...)
+type UpsertWorkflowSearchAttributesEventAttributes struct {
+	DecisionTaskCompletedEventID int64             `json:"decisionTaskCompletedEventId,omitempty"`
+	SearchAttributes             *SearchAttributes `json:"searchAttributes,omitempty"`
+}
+
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
 	}
 	return
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowID() (o string) {
+// Size returns the approximate memory used in bytes
+func (v *UpsertWorkflowSearchAttributesEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// VersionHistories is an internal type (TBD...)
+type VersionHistories struct {
+	CurrentVersionHistoryIndex int32             `json:"currentVersionHistoryIndex,omitempty"`
+	Histories                  []*VersionHistory `json:"histories,omitempty"`
+}
+
+// GetCurrentVersionHistoryIndex is an internal getter (TBD...)
+func (v *VersionHistories) GetCurrentVersionHistoryIndex() (o int32) {
 	if v != nil {
-		return v.WorkflowID
+		return v.CurrentVersionHistoryIndex
 	}
 	return
 }
 
-// GetWorkflowType is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowType() (o *WorkflowType) {
-	if v != nil && v.WorkflowType != nil {
-		return v.WorkflowType
-	}
-	return
+// VersionHistory is an internal type (TBD...)
+type VersionHistory struct {
+	BranchToken []byte                `json:"branchToken,omitempty"`
+	Items       []*VersionHistoryItem `json:"items,omitempty"`
 }
 
-// GetParentClosePolicy is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetParentClosePolicy() (o ParentClosePolicy) {
-	if v != nil && v.ParentClosePolicy != nil {
-		return *v.ParentClosePolicy
+// GetItems is an internal getter (TBD...)
+func (v *VersionHistory) GetItems() (o []*VersionHistoryItem) {
+	if v != nil && v.Items != nil {
+		return v.Items
 	}
 	return
 }
 
-// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
-		return *v.ExecutionStartToCloseTimeoutSeconds
+// ByteSize returns the approximate memory used in bytes
+func (v *VersionHistory) ByteSize() uint64 {
+	if v == nil {
+		return 0
 	}
-	return
-}
 
-// Size returns the approximate memory used in bytes
-func (v *StartChildWorkflowExecutionInitiatedEventAttributes) ByteSize() uint64 {
-	return 0
+	size := uint64(unsafe.Sizeof(*v))
+	size += uint64(len(v.BranchToken))
+
+	// [] *VersionHistoryItem backing array: len * pointer size
+	size += uint64(len(v.Items)) * uint64(unsafe.Sizeof((*VersionHistoryItem)(nil)))
+	for _, e := range v.Items {
+		size += e.ByteSize()
+	}
+
+	return size
 }
 
-// StartTimeFilter is an internal type (TBD...)
-type StartTimeFilter struct {
-	EarliestTime *int64 `json:"earliestTime,omitempty"`
-	LatestTime   *int64 `json:"latestTime,omitempty"`
+// VersionHistoryItem is an internal type (TBD...)
+type VersionHistoryItem struct {
+	EventID int64 `json:"eventID,omitempty"`
+	Version int64 `json:"version,omitempty"`
 }
 
-// GetEarliestTime is an internal getter (TBD...)
-func (v *StartTimeFilter) GetEarliestTime() (o int64) {
-	if v != nil && v.EarliestTime != nil {
-		return *v.EarliestTime
+// GetVersion is an internal getter (TBD...)
+func (v *VersionHistoryItem) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
 	}
 	return
 }
 
-// GetLatestTime is an internal getter (TBD...)
-func (v *StartTimeFilter) GetLatestTime() (o int64) {
-	if v != nil && v.LatestTime != nil {
-		return *v.LatestTime
+// ByteSize returns the approximate memory used in bytes
+func (v *VersionHistoryItem) ByteSize() uint64 {
+	if v == nil {
+		return 0
 	}
-	return
+
+	return uint64(unsafe.Sizeof(*v))
 }
 
-// StartTimerDecisionAttributes is an internal type (TBD...)
-type StartTimerDecisionAttributes struct {
-	TimerID                   string `json:"timerId,omitempty"`
-	StartToFireTimeoutSeconds *int64 `json:"startToFireTimeoutSeconds,omitempty"`
+// WorkerVersionInfo is an internal type (TBD...)
+type WorkerVersionInfo struct {
+	Impl           string `json:"impl,omitempty"`
+	FeatureVersion string `json:"featureVersion,omitempty"`
 }
 
-// GetTimerID is an internal getter (TBD...)
-func (v *StartTimerDecisionAttributes) GetTimerID() (o string) {
+// GetImpl is an internal getter (TBD...)
+func (v *WorkerVersionInfo) GetImpl() (o string) {
 	if v != nil {
-		return v.TimerID
+		return v.Impl
 	}
 	return
 }
 
-// GetStartToFireTimeoutSeconds is an internal getter (TBD...)
-func (v *StartTimerDecisionAttributes) GetStartToFireTimeoutSeconds() (o int64) {
-	if v != nil && v.StartToFireTimeoutSeconds != nil {
-		return *v.StartToFireTimeoutSeconds
+// GetFeatureVersion is an internal getter (TBD...)
+func (v *WorkerVersionInfo) GetFeatureVersion() (o string) {
+	if v != nil {
+		return v.FeatureVersion
 	}
 	return
 }
 
-type RestartWorkflowExecutionRequest struct {
-	Domain            string             `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Identity          string             `json:"identity,omitempty"`
+// WorkflowExecution is an internal type (TBD...)
+type WorkflowExecution struct {
+	WorkflowID string `json:"workflowId,omitempty"`
+	RunID      string `json:"runId,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *RestartWorkflowExecutionRequest) GetDomain() (o string) {
+// GetWorkflowID is an internal getter (TBD...)
+func (v *WorkflowExecution) GetWorkflowID() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.WorkflowID
 	}
 	return
 }
 
-// GetWorkflowExecution is an internal getter (TBD...)
-func (v *RestartWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+// GetRunID is an internal getter (TBD...)
+func (v *WorkflowExecution) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
 	}
 	return
 }
 
-type DiagnoseWorkflowExecutionRequest struct {
-	Domain            string             `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Identity          string             `json:"identity,omitempty"`
+// WorkflowExecutionAlreadyStartedError is an internal type (TBD...)
+type WorkflowExecutionAlreadyStartedError struct {
+	Message        string `json:"message,omitempty"`
+	StartRequestID string `json:"startRequestId,omitempty"`
+	RunID          string `json:"runId,omitempty"`
 }
 
-// GetDomain returns the domain
-func (v *DiagnoseWorkflowExecutionRequest) GetDomain() (o string) {
+// GetMessage is an internal getter (TBD...)
+func (v *WorkflowExecutionAlreadyStartedError) GetMessage() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.Message
 	}
 	return
 }
 
-// GetWorkflowExecution returns the workflow execution
-func (v *DiagnoseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+// WorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionCancelRequestedEventAttributes struct {
+	Cause                     string             `json:"cause,omitempty"`
+	ExternalInitiatedEventID  *int64             `json:"externalInitiatedEventId,omitempty"`
+	ExternalWorkflowExecution *WorkflowExecution `json:"externalWorkflowExecution,omitempty"`
+	Identity                  string             `json:"identity,omitempty"`
+	RequestID                 string             `json:"requestId,omitempty"`
+}
+
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionCancelRequestedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// WorkflowExecutionCanceledEventAttributes is an internal type (TBD...)
+type WorkflowExecutionCanceledEventAttributes struct {
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	Details                      []byte `json:"details,omitempty"`
+}
+
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionCanceledEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// WorkflowExecutionCloseStatus is an internal type (TBD...)
+type WorkflowExecutionCloseStatus int32
+
+// Ptr is a helper function for getting pointer value
+func (e WorkflowExecutionCloseStatus) Ptr() *WorkflowExecutionCloseStatus {
+	return &e
+}
+
+// String returns a readable string representation of WorkflowExecutionCloseStatus.
+func (e WorkflowExecutionCloseStatus) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "COMPLETED"
+	case 1:
+		return "FAILED"
+	case 2:
+		return "CANCELED"
+	case 3:
+		return "TERMINATED"
+	case 4:
+		return "CONTINUED_AS_NEW"
+	case 5:
+		return "TIMED_OUT"
 	}
-	return
+	return fmt.Sprintf("WorkflowExecutionCloseStatus(%d)", w)
 }
 
-// GetIdentity returns the identity
-func (v *DiagnoseWorkflowExecutionRequest) GetIdentity() (o string) {
-	if v != nil {
-		return v.Identity
+// UnmarshalText parses enum value from string representation
+func (e *WorkflowExecutionCloseStatus) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "COMPLETED":
+		*e = WorkflowExecutionCloseStatusCompleted
+		return nil
+	case "FAILED":
+		*e = WorkflowExecutionCloseStatusFailed
+		return nil
+	case "CANCELED":
+		*e = WorkflowExecutionCloseStatusCanceled
+		return nil
+	case "TERMINATED":
+		*e = WorkflowExecutionCloseStatusTerminated
+		return nil
+	case "CONTINUED_AS_NEW":
+		*e = WorkflowExecutionCloseStatusContinuedAsNew
+		return nil
+	case "TIMED_OUT":
+		*e = WorkflowExecutionCloseStatusTimedOut
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowExecutionCloseStatus", err)
+		}
+		*e = WorkflowExecutionCloseStatus(val)
+		return nil
 	}
-	return
 }
 
-type DiagnoseWorkflowExecutionResponse struct {
-	Domain                      string             `json:"domain,omitempty"`
-	DiagnosticWorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+// MarshalText encodes WorkflowExecutionCloseStatus to text.
+func (e WorkflowExecutionCloseStatus) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// WorkflowExecutionCloseStatusCompleted is an option for WorkflowExecutionCloseStatus
+	WorkflowExecutionCloseStatusCompleted WorkflowExecutionCloseStatus = iota
+	// WorkflowExecutionCloseStatusFailed is an option for WorkflowExecutionCloseStatus
+	WorkflowExecutionCloseStatusFailed
+	// WorkflowExecutionCloseStatusCanceled is an option for WorkflowExecutionCloseStatus
+	WorkflowExecutionCloseStatusCanceled
+	// WorkflowExecutionCloseStatusTerminated is an option for WorkflowExecutionCloseStatus
+	WorkflowExecutionCloseStatusTerminated
+	// WorkflowExecutionCloseStatusContinuedAsNew is an option for WorkflowExecutionCloseStatus
+	WorkflowExecutionCloseStatusContinuedAsNew
+	// WorkflowExecutionCloseStatusTimedOut is an option for WorkflowExecutionCloseStatus
+	WorkflowExecutionCloseStatusTimedOut
+)
+
+// WorkflowExecutionCompletedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionCompletedEventAttributes struct {
+	Result                       []byte `json:"result,omitempty"`
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-// GetDomain returns the fomain
-func (v *DiagnoseWorkflowExecutionResponse) GetDomain() (o string) {
-	if v != nil {
-		return v.Domain
-	}
-	return
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionCompletedEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// GetDiagnosticWorkflowExecution returns the workflow execution
-func (v *DiagnoseWorkflowExecutionResponse) GetDiagnosticWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.DiagnosticWorkflowExecution != nil {
-		return v.DiagnosticWorkflowExecution
-	}
-	return
+// WorkflowExecutionConfiguration is an internal type (TBD...)
+type WorkflowExecutionConfiguration struct {
+	TaskList                            *TaskList `json:"taskList,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
 }
 
-// StartWorkflowExecutionRequest is an internal type (TBD...)
-type StartWorkflowExecutionRequest struct {
-	Domain                              string                        `json:"domain,omitempty"`
-	WorkflowID                          string                        `json:"workflowId,omitempty"`
+// WorkflowExecutionContinuedAsNewEventAttributes is an internal type (TBD...)
+type WorkflowExecutionContinuedAsNewEventAttributes struct {
+	NewExecutionRunID                   string                        `json:"newExecutionRunId,omitempty"`
 	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
 	TaskList                            *TaskList                     `json:"taskList,omitempty"`
-	Input                               []byte                        `json:"-"`
+	Input                               []byte                        `json:"input,omitempty"`
 	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
 	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                        `json:"identity,omitempty"`
-	RequestID                           string                        `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy        `json:"workflowIdReusePolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                         `json:"-"`
-	SearchAttributes                    *SearchAttributes             `json:"-"`
+	DecisionTaskCompletedEventID        int64                         `json:"decisionTaskCompletedEventId,omitempty"`
+	BackoffStartIntervalInSeconds       *int32                        `json:"backoffStartIntervalInSeconds,omitempty"`
+	Initiator                           *ContinueAsNewInitiator       `json:"initiator,omitempty"`
+	FailureReason                       *string                       `json:"failureReason,omitempty"`
+	FailureDetails                      []byte                        `json:"failureDetails,omitempty"`
+	LastCompletionResult                []byte                        `json:"lastCompletionResult,omitempty"`
 	Header                              *Header                       `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                        `json:"delayStartSeconds,omitempty"`
+	Memo                                *Memo                         `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes             `json:"searchAttributes,omitempty"`
 	JitterStartSeconds                  *int32                        `json:"jitterStartSeconds,omitempty"`
-	FirstRunAtTimeStamp                 *int64                        `json:"firstRunAtTimeStamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetDomain() (o string) {
+// GetNewExecutionRunID is an internal getter (TBD...)
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetNewExecutionRunID() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.NewExecutionRunID
 	}
 	return
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetWorkflowID() (o string) {
-	if v != nil {
-		return v.WorkflowID
+// GetInitiator is an internal getter (TBD...)
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetInitiator() (o ContinueAsNewInitiator) {
+	if v != nil && v.Initiator != nil {
+		return *v.Initiator
 	}
 	return
 }
 
-// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
-		return *v.ExecutionStartToCloseTimeoutSeconds
+// GetFailureReason is an internal getter (TBD...)
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetFailureReason() (o string) {
+	if v != nil && v.FailureReason != nil {
+		return *v.FailureReason
 	}
 	return
 }
 
-// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
-		return *v.TaskStartToCloseTimeoutSeconds
+// GetLastCompletionResult is an internal getter (TBD...)
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetLastCompletionResult() (o []byte) {
+	if v != nil && v.LastCompletionResult != nil {
+		return v.LastCompletionResult
 	}
 	return
 }
 
-// GetDelayStartSeconds is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetDelayStartSeconds() (o int32) {
-	if v != nil && v.DelayStartSeconds != nil {
-		return *v.DelayStartSeconds
-	}
-	return
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// GetJitterStartSeconds is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetJitterStartSeconds() (o int32) {
-	if v != nil && v.JitterStartSeconds != nil {
-		return *v.JitterStartSeconds
-	}
-	return
+// WorkflowExecutionFailedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionFailedEventAttributes struct {
+	Reason                       *string `json:"reason,omitempty"`
+	Details                      []byte  `json:"details,omitempty"`
+	DecisionTaskCompletedEventID int64   `json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-// GetFirstRunAtTimeStamp is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetFirstRunAtTimeStamp() (o int64) {
-	if v != nil && v.FirstRunAtTimeStamp != nil {
-		return *v.FirstRunAtTimeStamp
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionFailedEventAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
 	}
 	return
 }
 
-// GetRequestID is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetRequestID() (o string) {
-	if v != nil {
-		return v.RequestID
-	}
-	return
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionFailedEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// GetWorkflowIDReusePolicy is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o WorkflowIDReusePolicy) {
-	if v != nil && v.WorkflowIDReusePolicy != nil {
-		return *v.WorkflowIDReusePolicy
-	}
-	return
+// WorkflowExecutionFilter is an internal type (TBD...)
+type WorkflowExecutionFilter struct {
+	WorkflowID string `json:"workflowId,omitempty"`
+	RunID      string `json:"runId,omitempty"`
 }
 
-// GetCronSchedule is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetCronSchedule() (o string) {
+// GetWorkflowID is an internal getter (TBD...)
+func (v *WorkflowExecutionFilter) GetWorkflowID() (o string) {
 	if v != nil {
-		return v.CronSchedule
-	}
-	return
-}
-
-// GetCronOverlapPolicy is an internal getter (TBD...)
-func (v *StartWorkflowExecutionRequest) GetCronOverlapPolicy() (o CronOverlapPolicy) {
-	if v != nil && v.CronOverlapPolicy != nil {
-		return *v.CronOverlapPolicy
+		return v.WorkflowID
 	}
 	return
 }
 
-// StartWorkflowExecutionResponse is an internal type (TBD...)
-type StartWorkflowExecutionResponse struct {
-	RunID string `json:"runId,omitempty"`
+// WorkflowExecutionInfo is an internal type (TBD...)
+type WorkflowExecutionInfo struct {
+	Execution                    *WorkflowExecution            `json:"execution,omitempty"`
+	Type                         *WorkflowType                 `json:"type,omitempty"`
+	StartTime                    *int64                        `json:"startTime,omitempty"`
+	CloseTime                    *int64                        `json:"closeTime,omitempty"`
+	CloseStatus                  *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	HistoryLength                int64                         `json:"historyLength,omitempty"` // should be history count
+	ParentDomainID               *string                       `json:"parentDomainId,omitempty"`
+	ParentDomain                 *string                       `json:"parentDomain,omitempty"`
+	ParentExecution              *WorkflowExecution            `json:"parentExecution,omitempty"`
+	ParentInitiatedID            *int64                        `json:"parentInitiatedId,omitempty"`
+	ExecutionTime                *int64                        `json:"executionTime,omitempty"`
+	Memo                         *Memo                         `json:"memo,omitempty"`
+	SearchAttributes             *SearchAttributes             `json:"searchAttributes,omitempty"`
+	AutoResetPoints              *ResetPoints                  `json:"autoResetPoints,omitempty"`
+	TaskList                     *TaskList                     `json:"taskList,omitempty"`
+	IsCron                       bool                          `json:"isCron,omitempty"`
+	UpdateTime                   *int64                        `json:"updateTime,omitempty"`
+	PartitionConfig              map[string]string             `json:"partitionConfig,omitempty"`
+	CronOverlapPolicy            *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
+	ActiveClusterSelectionPolicy *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *StartWorkflowExecutionResponse) GetRunID() (o string) {
-	if v != nil {
-		return v.RunID
+// GetExecution is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
 	}
 	return
 }
 
-type StartWorkflowExecutionAsyncRequest struct {
-	*StartWorkflowExecutionRequest
-}
-
-type StartWorkflowExecutionAsyncResponse struct {
-}
-
-// RestartWorkflowExecutionResponse is an internal type (TBD...)
-type RestartWorkflowExecutionResponse struct {
-	RunID string `json:"runId,omitempty"`
-}
-
-// GetRunID is an internal getter (TBD...)
-func (v *RestartWorkflowExecutionResponse) GetRunID() (o string) {
-	if v != nil {
-		return v.RunID
+// GetType is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetType() (o *WorkflowType) {
+	if v != nil && v.Type != nil {
+		return v.Type
 	}
 	return
 }
 
-// StickyExecutionAttributes is an internal type (TBD...)
-type StickyExecutionAttributes struct {
-	WorkerTaskList                *TaskList `json:"workerTaskList,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32    `json:"scheduleToStartTimeoutSeconds,omitempty"`
-}
-
-// GetScheduleToStartTimeoutSeconds is an internal getter (TBD...)
-func (v *StickyExecutionAttributes) GetScheduleToStartTimeoutSeconds() (o int32) {
-	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
-		return *v.ScheduleToStartTimeoutSeconds
+// GetStartTime is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetStartTime() (o int64) {
+	if v != nil && v.StartTime != nil {
+		return *v.StartTime
 	}
 	return
 }
 
-// SupportedClientVersions is an internal type (TBD...)
-type SupportedClientVersions struct {
-	GoSdk   string `json:"goSdk,omitempty"`
-	JavaSdk string `json:"javaSdk,omitempty"`
+// GetCloseTime is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetCloseTime() (o int64) {
+	if v != nil && v.CloseTime != nil {
+		return *v.CloseTime
+	}
+	return
 }
 
-// TaskIDBlock is an internal type (TBD...)
-type TaskIDBlock struct {
-	StartID int64 `json:"startID,omitempty"`
-	EndID   int64 `json:"endID,omitempty"`
+// GetCloseStatus is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetCloseStatus() (o WorkflowExecutionCloseStatus) {
+	if v != nil && v.CloseStatus != nil {
+		return *v.CloseStatus
+	}
+	return
 }
 
-// GetStartID is an internal getter (TBD...)
-func (v *TaskIDBlock) GetStartID() (o int64) {
-	if v != nil {
-		return v.StartID
+// GetExecutionTime is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetExecutionTime() (o int64) {
+	if v != nil && v.ExecutionTime != nil {
+		return *v.ExecutionTime
 	}
 	return
 }
 
-// GetEndID is an internal getter (TBD...)
-func (v *TaskIDBlock) GetEndID() (o int64) {
-	if v != nil {
-		return v.EndID
+// GetUpdateTime is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetUpdateTime() (o int64) {
+	if v != nil && v.UpdateTime != nil {
+		return *v.UpdateTime
 	}
 	return
 }
 
-// TaskList is an internal type (TBD...)
-type TaskList struct {
-	Name string        `json:"name,omitempty"`
-	Kind *TaskListKind `json:"kind,omitempty"`
-}
-
-// GetName is an internal getter (TBD...)
-func (v *TaskList) GetName() (o string) {
-	if v != nil {
-		return v.Name
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
 	}
 	return
 }
 
-// GetKind is an internal getter (TBD...)
-func (v *TaskList) GetKind() (o TaskListKind) {
-	if v != nil && v.Kind != nil {
-		return *v.Kind
+// GetPartitionConfig is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetPartitionConfig() (o map[string]string) {
+	if v != nil && v.PartitionConfig != nil {
+		return v.PartitionConfig
 	}
 	return
 }
 
-// TaskListKind is an internal type (TBD...)
-type TaskListKind int32
-
-// Ptr is a helper function for getting pointer value
-func (e TaskListKind) Ptr() *TaskListKind {
-	return &e
+// WorkflowExecutionPausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
 }
 
-// String returns a readable string representation of TaskListKind.
-func (e TaskListKind) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "NORMAL"
-	case 1:
-		return "STICKY"
-	case 2:
-		return "EPHEMERAL"
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
 	}
-	return fmt.Sprintf("TaskListKind(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *TaskListKind) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "NORMAL":
-		*e = TaskListKindNormal
-		return nil
-	case "STICKY":
-		*e = TaskListKindSticky
-		return nil
-	case "EPHEMERAL":
-		*e = TaskListKindEphemeral
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskListKind", err)
-		}
-		*e = TaskListKind(val)
-		return nil
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
+	return
 }
 
-// MarshalText encodes TaskListKind to text.
-func (e TaskListKind) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionPausedEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-const (
-	// TaskListKindNormal is an option for TaskListKind
-	TaskListKindNormal TaskListKind = iota
-	// TaskListKindSticky is an option for TaskListKind
-	TaskListKindSticky
-	// TaskListKindEphemeral is an option for TaskListKind
-	TaskListKindEphemeral
-)
-
-// TaskListMetadata is an internal type (TBD...)
-type TaskListMetadata struct {
-	MaxTasksPerSecond *float64 `json:"maxTasksPerSecond,omitempty"`
+// WorkflowExecutionSearchAttributesUpdatedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionSearchAttributesUpdatedEventAttributes struct {
+	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
+	Reason           string            `json:"reason,omitempty"`
+	Identity         string            `json:"identity,omitempty"`
 }
 
-// TaskListPartitionMetadata is an internal type (TBD...)
-type TaskListPartitionMetadata struct {
-	Key           string `json:"key,omitempty"`
-	OwnerHostName string `json:"ownerHostName,omitempty"`
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *WorkflowExecutionSearchAttributesUpdatedEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
 }
 
-// GetKey is an internal getter (TBD...)
-func (v *TaskListPartitionMetadata) GetKey() (o string) {
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionSearchAttributesUpdatedEventAttributes) GetReason() (o string) {
 	if v != nil {
-		return v.Key
+		return v.Reason
 	}
 	return
 }
 
-// GetOwnerHostName is an internal getter (TBD...)
-func (v *TaskListPartitionMetadata) GetOwnerHostName() (o string) {
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionSearchAttributesUpdatedEventAttributes) GetIdentity() (o string) {
 	if v != nil {
-		return v.OwnerHostName
+		return v.Identity
 	}
 	return
 }
 
-type IsolationGroupMetrics struct {
-	NewTasksPerSecond float64 `json:"newTasksPerSecond,omitempty"`
-	PollerCount       int64   `json:"pollerCount,omitempty"`
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionSearchAttributesUpdatedEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// TaskListStatus is an internal type (TBD...)
-type TaskListStatus struct {
-	BacklogCountHint      int64                             `json:"backlogCountHint,omitempty"`
-	ReadLevel             int64                             `json:"readLevel,omitempty"`
-	AckLevel              int64                             `json:"ackLevel,omitempty"`
-	RatePerSecond         float64                           `json:"ratePerSecond,omitempty"`
-	TaskIDBlock           *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
-	IsolationGroupMetrics map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
-	NewTasksPerSecond     float64                           `json:"newTasksPerSecond,omitempty"`
-	Empty                 bool                              `json:"empty,omitempty"`
+// WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
+type WorkflowExecutionSignaledEventAttributes struct {
+	SignalName string `json:"signalName,omitempty"`
+	Input      []byte `json:"input,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
 }
 
-// GetBacklogCountHint is an internal getter (TBD...)
-func (v *TaskListStatus) GetBacklogCountHint() (o int64) {
+// GetSignalName is an internal getter (TBD...)
+func (v *WorkflowExecutionSignaledEventAttributes) GetSignalName() (o string) {
 	if v != nil {
-		return v.BacklogCountHint
+		return v.SignalName
 	}
 	return
 }
 
-// GetReadLevel is an internal getter (TBD...)
-func (v *TaskListStatus) GetReadLevel() (o int64) {
-	if v != nil {
-		return v.ReadLevel
+// GetInput is an internal getter (TBD...)
+func (v *WorkflowExecutionSignaledEventAttributes) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
 	}
 	return
 }
 
-// GetAckLevel is an internal getter (TBD...)
-func (v *TaskListStatus) GetAckLevel() (o int64) {
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionSignaledEventAttributes) GetIdentity() (o string) {
 	if v != nil {
-		return v.AckLevel
+		return v.Identity
 	}
 	return
 }
 
-// GetRatePerSecond is an internal getter (TBD...)
-func (v *TaskListStatus) GetRatePerSecond() (o float64) {
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionSignaledEventAttributes) GetRequestID() (o string) {
 	if v != nil {
-		return v.RatePerSecond
+		return v.RequestID
 	}
 	return
 }
 
-// GetTaskIDBlock is an internal getter (TBD...)
-func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
-	if v != nil && v.TaskIDBlock != nil {
-		return v.TaskIDBlock
-	}
-	return
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionSignaledEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// TaskListType is an internal type (TBD...)
-type TaskListType int32
-
-// Ptr is a helper function for getting pointer value
-func (e TaskListType) Ptr() *TaskListType {
-	return &e
+// WorkflowExecutionStartedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionStartedEventAttributes struct {
+	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
+	ParentWorkflowDomainID              *string                       `json:"parentWorkflowDomainID,omitempty"`
+	ParentWorkflowDomain                *string                       `json:"parentWorkflowDomain,omitempty"`
+	ParentWorkflowExecution             *WorkflowExecution            `json:"parentWorkflowExecution,omitempty"`
+	ParentInitiatedEventID              *int64                        `json:"parentInitiatedEventId,omitempty"`
+	TaskList                            *TaskList                     `json:"taskList,omitempty"`
+	Input                               []byte                        `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	ContinuedExecutionRunID             string                        `json:"continuedExecutionRunId,omitempty"`
+	Initiator                           *ContinueAsNewInitiator       `json:"initiator,omitempty"`
+	ContinuedFailureReason              *string                       `json:"continuedFailureReason,omitempty"`
+	ContinuedFailureDetails             []byte                        `json:"continuedFailureDetails,omitempty"`
+	LastCompletionResult                []byte                        `json:"lastCompletionResult,omitempty"`
+	OriginalExecutionRunID              string                        `json:"originalExecutionRunId,omitempty"`
+	Identity                            string                        `json:"identity,omitempty"`
+	FirstExecutionRunID                 string                        `json:"firstExecutionRunId,omitempty"`
+	FirstScheduleTime                   *time.Time                    `json:"firstScheduleTimeNano,omitempty"`
+	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
+	Attempt                             int32                         `json:"attempt,omitempty"`
+	ExpirationTimestamp                 *int64                        `json:"expirationTimestamp,omitempty"`
+	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
+	FirstDecisionTaskBackoffSeconds     *int32                        `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	Memo                                *Memo                         `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes             `json:"searchAttributes,omitempty"`
+	PrevAutoResetPoints                 *ResetPoints                  `json:"prevAutoResetPoints,omitempty"`
+	Header                              *Header                       `json:"header,omitempty"`
+	JitterStartSeconds                  *int32                        `json:"jitterStartSeconds,omitempty"`
+	PartitionConfig                     map[string]string             `json:"partitionConfig,omitempty"`
+	RequestID                           string                        `json:"requestId,omitempty"`
+	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
+	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	PauseInfo                           *PauseInfo                    `json:"pauseInfo,omitempty"`
+	TaskPriority                        *TaskPriority                 `json:"taskPriority,omitempty"`
 }
 
-// String returns a readable string representation of TaskListType.
-func (e TaskListType) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "Decision"
-	case 1:
-		return "Activity"
+// GetParentWorkflowDomain is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetParentWorkflowDomain() (o string) {
+	if v != nil && v.ParentWorkflowDomain != nil {
+		return *v.ParentWorkflowDomain
 	}
-	return fmt.Sprintf("TaskListType(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *TaskListType) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "DECISION":
-		*e = TaskListTypeDecision
-		return nil
-	case "ACTIVITY":
-		*e = TaskListTypeActivity
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskListType", err)
-		}
-		*e = TaskListType(val)
-		return nil
+// GetParentInitiatedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetParentInitiatedEventID() (o int64) {
+	if v != nil && v.ParentInitiatedEventID != nil {
+		return *v.ParentInitiatedEventID
 	}
+	return
 }
 
-// MarshalText encodes TaskListType to text.
-func (e TaskListType) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
+	}
+	return
 }
 
-const (
-	// TaskListTypeDecision is an option for TaskListType
-	TaskListTypeDecision TaskListType = iota
-	// TaskListTypeActivity is an option for TaskListType
-	TaskListTypeActivity
-)
-
-// TerminateWorkflowExecutionRequest is an internal type (TBD...)
-type TerminateWorkflowExecutionRequest struct {
-	Domain              string             `json:"domain,omitempty"`
-	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Reason              string             `json:"reason,omitempty"`
-	Details             []byte             `json:"details,omitempty"`
-	Identity            string             `json:"identity,omitempty"`
-	FirstExecutionRunID string             `json:"first_execution_run_id,omitempty"`
+// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
+	}
+	return
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *TerminateWorkflowExecutionRequest) GetDomain() (o string) {
+// GetContinuedExecutionRunID is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetContinuedExecutionRunID() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.ContinuedExecutionRunID
 	}
 	return
 }
 
-// GetWorkflowExecution is an internal getter (TBD...)
-func (v *TerminateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+// GetInitiator is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetInitiator() (o ContinueAsNewInitiator) {
+	if v != nil && v.Initiator != nil {
+		return *v.Initiator
 	}
 	return
 }
 
-// GetReason is an internal getter (TBD...)
-func (v *TerminateWorkflowExecutionRequest) GetReason() (o string) {
+// GetFirstExecutionRunID is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetFirstExecutionRunID() (o string) {
 	if v != nil {
-		return v.Reason
+		return v.FirstExecutionRunID
 	}
 	return
 }
 
-// GetDetails is an internal getter (TBD...)
-func (v *TerminateWorkflowExecutionRequest) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+// GetFirstScheduledTime is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetFirstScheduledTime() (o time.Time) {
+	if v != nil && v.FirstScheduleTime != nil {
+		return *v.FirstScheduleTime
 	}
 	return
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *TerminateWorkflowExecutionRequest) GetIdentity() (o string) {
+// GetAttempt is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetAttempt() (o int32) {
 	if v != nil {
-		return v.Identity
+		return v.Attempt
 	}
 	return
 }
 
-// GetFirstExecutionRunID is an internal getter (TBD...)
-func (v *TerminateWorkflowExecutionRequest) GetFirstExecutionRunID() (o string) {
-	if v != nil {
-		return v.FirstExecutionRunID
+// GetExpirationTimestamp is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetExpirationTimestamp() (o int64) {
+	if v != nil && v.ExpirationTimestamp != nil {
+		return *v.ExpirationTimestamp
 	}
 	return
 }
 
-// TimeoutType is an internal type (TBD...)
-type TimeoutType int32
+// GetCronSchedule is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetCronSchedule() (o string) {
+	if v != nil {
+		return v.CronSchedule
+	}
+	return
+}
 
-// Ptr is a helper function for getting pointer value
-func (e TimeoutType) Ptr() *TimeoutType {
-	return &e
+// GetFirstDecisionTaskBackoffSeconds is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetFirstDecisionTaskBackoffSeconds() (o int32) {
+	if v != nil && v.FirstDecisionTaskBackoffSeconds != nil {
+		return *v.FirstDecisionTaskBackoffSeconds
+	}
+	return
 }
 
-// String returns a readable string representation of TimeoutType.
-func (e TimeoutType) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "START_TO_CLOSE"
-	case 1:
-		return "SCHEDULE_TO_START"
-	case 2:
-		return "SCHEDULE_TO_CLOSE"
-	case 3:
-		return "HEARTBEAT"
+func (v *WorkflowExecutionStartedEventAttributes) GetJitterStartSeconds() (o int32) {
+	if v != nil && v.JitterStartSeconds != nil {
+		return *v.JitterStartSeconds
 	}
-	return fmt.Sprintf("TimeoutType(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *TimeoutType) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "START_TO_CLOSE":
-		*e = TimeoutTypeStartToClose
-		return nil
-	case "SCHEDULE_TO_START":
-		*e = TimeoutTypeScheduleToStart
-		return nil
-	case "SCHEDULE_TO_CLOSE":
-		*e = TimeoutTypeScheduleToClose
-		return nil
-	case "HEARTBEAT":
-		*e = TimeoutTypeHeartbeat
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TimeoutType", err)
-		}
-		*e = TimeoutType(val)
-		return nil
+// GetMemo is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
 	}
+	return
 }
 
-// MarshalText encodes TimeoutType to text.
-func (e TimeoutType) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
 }
 
-const (
-	// TimeoutTypeStartToClose is an option for TimeoutType
-	TimeoutTypeStartToClose TimeoutType = iota
-	// TimeoutTypeScheduleToStart is an option for TimeoutType
-	TimeoutTypeScheduleToStart
-	// TimeoutTypeScheduleToClose is an option for TimeoutType
-	TimeoutTypeScheduleToClose
-	// TimeoutTypeHeartbeat is an option for TimeoutType
-	TimeoutTypeHeartbeat
-)
+// GetPrevAutoResetPoints is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetPrevAutoResetPoints() (o *ResetPoints) {
+	if v != nil && v.PrevAutoResetPoints != nil {
+		return v.PrevAutoResetPoints
+	}
+	return
+}
 
-// TimerCanceledEventAttributes is an internal type (TBD...)
-type TimerCanceledEventAttributes struct {
-	TimerID                      string `json:"timerId,omitempty"`
-	StartedEventID               int64  `json:"startedEventId,omitempty"`
-	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
-	Identity                     string `json:"identity,omitempty"`
+// GetPartitionConfig is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetPartitionConfig() (o map[string]string) {
+	if v != nil && v.PartitionConfig != nil {
+		return v.PartitionConfig
+	}
+	return
 }
 
-// GetTimerID is an internal getter (TBD...)
-func (v *TimerCanceledEventAttributes) GetTimerID() (o string) {
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetRequestID() (o string) {
 	if v != nil {
-		return v.TimerID
+		return v.RequestID
+	}
+	return
+}
+
+// GetPauseInfo is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetPauseInfo() (o *PauseInfo) {
+	if v != nil && v.PauseInfo != nil {
+		return v.PauseInfo
 	}
 	return
 }
 
 // Size returns the approximate memory used in bytes
-func (v *TimerCanceledEventAttributes) ByteSize() uint64 {
+func (v *WorkflowExecutionStartedEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
-// TimerFiredEventAttributes is an internal type (TBD...)
-type TimerFiredEventAttributes struct {
-	TimerID        string `json:"timerId,omitempty"`
-	StartedEventID int64  `json:"startedEventId,omitempty"`
+// WorkflowExecutionTerminatedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionTerminatedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Details  []byte `json:"details,omitempty"`
+	Identity string `json:"identity,omitempty"`
 }
 
-// GetTimerID is an internal getter (TBD...)
-func (v *TimerFiredEventAttributes) GetTimerID() (o string) {
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionTerminatedEventAttributes) GetReason() (o string) {
 	if v != nil {
-		return v.TimerID
+		return v.Reason
 	}
 	return
 }
 
-// GetStartedEventID is an internal getter (TBD...)
-func (v *TimerFiredEventAttributes) GetStartedEventID() (o int64) {
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionTerminatedEventAttributes) GetIdentity() (o string) {
 	if v != nil {
-		return v.StartedEventID
+		return v.Identity
 	}
 	return
 }
 
 // Size returns the approximate memory used in bytes
-func (v *TimerFiredEventAttributes) ByteSize() uint64 {
+func (v *WorkflowExecutionTerminatedEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
-// TimerStartedEventAttributes is an internal type (TBD...)
-type TimerStartedEventAttributes struct {
-	TimerID                      string `json:"timerId,omitempty"`
-	StartToFireTimeoutSeconds    *int64 `json:"startToFireTimeoutSeconds,omitempty"`
-	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
-}
-
-// GetTimerID is an internal getter (TBD...)
-func (v *TimerStartedEventAttributes) GetTimerID() (o string) {
-	if v != nil {
-		return v.TimerID
-	}
-	return
+// WorkflowExecutionTimedOutEventAttributes is an internal type (TBD...)
+type WorkflowExecutionTimedOutEventAttributes struct {
+	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
 }
 
-// GetStartToFireTimeoutSeconds is an internal getter (TBD...)
-func (v *TimerStartedEventAttributes) GetStartToFireTimeoutSeconds() (o int64) {
-	if v != nil && v.StartToFireTimeoutSeconds != nil {
-		return *v.StartToFireTimeoutSeconds
+// GetTimeoutType is an internal getter (TBD...)
+func (v *WorkflowExecutionTimedOutEventAttributes) GetTimeoutType() (o TimeoutType) {
+	if v != nil && v.TimeoutType != nil {
+		return *v.TimeoutType
 	}
 	return
 }
 
 // Size returns the approximate memory used in bytes
-func (v *TimerStartedEventAttributes) ByteSize() uint64 {
+func (v *WorkflowExecutionTimedOutEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
-// TransientDecisionInfo is an internal type (TBD...)
-type TransientDecisionInfo struct {
-	ScheduledEvent *HistoryEvent `json:"scheduledEvent,omitempty"`
-	StartedEvent   *HistoryEvent `json:"startedEvent,omitempty"`
+// WorkflowExecutionUnpausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
 }
 
-// UpdateDomainRequest is an internal type (TBD...)
-type UpdateDomainRequest struct {
-	Name                                   string                             `json:"name,omitempty"`
-	Description                            *string                            `json:"description,omitempty"`
-	OwnerEmail                             *string                            `json:"ownerEmail,omitempty"`
-	Data                                   map[string]string                  `json:"data,omitempty"`
-	WorkflowExecutionRetentionPeriodInDays *int32                             `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
-	BadBinaries                            *BadBinaries                       `json:"badBinaries,omitempty"`
-	HistoryArchivalStatus                  *ArchivalStatus                    `json:"historyArchivalStatus,omitempty"`
-	HistoryArchivalURI                     *string                            `json:"historyArchivalURI,omitempty"`
-	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
-	VisibilityArchivalURI                  *string                            `json:"visibilityArchivalURI,omitempty"`
-	ActiveClusterName                      *string                            `json:"activeClusterName,omitempty"`
-	ActiveClusters                         *ActiveClusters                    `json:"activeClusters,omitempty"`
-	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
-	SecurityToken                          string                             `json:"securityToken,omitempty"`
-	DeleteBadBinary                        *string                            `json:"deleteBadBinary,omitempty"`
-	FailoverTimeoutInSeconds               *int32                             `json:"failoverTimeoutInSeconds,omitempty"`
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
 }
 
-// IsAFailoverRequest identifies if any part of the request is a failover request
-// and if so, will return true
-// this includes:
-//
-// - an active cluster change  (force failver)
-// - any failvoer timeout values (for graceful failover)
-// - or a change to one of the domain's cluster-attribute fields (active-active failover)
-//
-// this is not a validation function
-// and doesn't attempt to give valid or coherent combinations
-func (v *UpdateDomainRequest) IsAFailoverRequest() bool {
-	return v.ActiveClusterName != nil ||
-		(v.FailoverTimeoutInSeconds != nil && *v.FailoverTimeoutInSeconds > 0) ||
-		(v.ActiveClusters != nil && len(v.ActiveClusters.AttributeScopes) > 0)
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
 }
 
-// GetName is an internal getter (TBD...)
-func (v *UpdateDomainRequest) GetName() (o string) {
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionUnpausedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
+// WorkflowExecutionUpdateAcceptedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateAcceptedEventAttributes struct {
+	UpdateID                     string `json:"updateId,omitempty"`
+	UpdateName                   string `json:"updateName,omitempty"`
+	Input                        []byte `json:"input,omitempty"`
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdateID() (o string) {
 	if v != nil {
-		return v.Name
+		return v.UpdateID
 	}
 	return
 }
 
-// GetFailoverTimeoutInSeconds is an internal getter (TBD...)
-func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
-	if v != nil && v.FailoverTimeoutInSeconds != nil {
-		return *v.FailoverTimeoutInSeconds
+// GetUpdateName is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
 	}
 	return
 }
 
-// GetHistoryArchivalURI is an internal getter (TBD...)
-func (v *UpdateDomainRequest) GetHistoryArchivalURI() (o string) {
-	if v != nil && v.HistoryArchivalURI != nil {
-		return *v.HistoryArchivalURI
+// GetInput is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
 	}
 	return
 }
 
-// GetVisibilityArchivalURI is an internal getter (TBD...)
-func (v *UpdateDomainRequest) GetVisibilityArchivalURI() (o string) {
-	if v != nil && v.VisibilityArchivalURI != nil {
-		return *v.VisibilityArchivalURI
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
 	}
 	return
 }
 
-// UpdateDomainResponse is an internal type (TBD...)
-type UpdateDomainResponse struct {
-	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
-	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
-	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
-	FailoverVersion          int64                           `json:"failoverVersion,omitempty"`
-	IsGlobalDomain           bool                            `json:"isGlobalDomain,omitempty"`
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-func (v *UpdateDomainResponse) ToFailoverDomainResponse() *FailoverDomainResponse {
-	if v == nil {
-		return nil
-	}
-	return &FailoverDomainResponse{
-		DomainInfo:               v.DomainInfo,
-		Configuration:            v.Configuration,
-		ReplicationConfiguration: v.ReplicationConfiguration,
-		FailoverVersion:          v.FailoverVersion,
-		IsGlobalDomain:           v.IsGlobalDomain,
-	}
+// WorkflowExecutionUpdateCompletedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateCompletedEventAttributes struct {
+	UpdateID                     string  `json:"updateId,omitempty"`
+	Result                       []byte  `json:"result,omitempty"`
+	FailureReason                *string `json:"failureReason,omitempty"`
+	FailureDetails               []byte  `json:"failureDetails,omitempty"`
+	DecisionTaskCompletedEventID int64   `json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-// GetDomainInfo is an internal getter (TBD...)
-func (v *UpdateDomainResponse) GetDomainInfo() (o *DomainInfo) {
-	if v != nil && v.DomainInfo != nil {
-		return v.DomainInfo
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
 	}
 	return
 }
 
-// GetFailoverVersion is an internal getter (TBD...)
-func (v *UpdateDomainResponse) GetFailoverVersion() (o int64) {
-	if v != nil {
-		return v.FailoverVersion
+// GetResult is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
 	}
 	return
 }
 
-// GetIsGlobalDomain is an internal getter (TBD...)
-func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
-	if v != nil {
-		return v.IsGlobalDomain
+// GetFailureReason is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetFailureReason() (o string) {
+	if v != nil && v.FailureReason != nil {
+		return *v.FailureReason
 	}
 	return
 }
 
-// UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
-type UpsertWorkflowSearchAttributesDecisionAttributes struct {
-	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
-}
-
-// GetSearchAttributes is an internal getter (TBD...)
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) GetSearchAttributes() (o *SearchAttributes) {
-	if v != nil && v.SearchAttributes != nil {
-		return v.SearchAttributes
+// GetFailureDetails is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetFailureDetails() (o []byte) {
+	if v != nil && v.FailureDetails != nil {
+		return v.FailureDetails
 	}
 	return
 }
 
-// UpsertWorkflowSearchAttributesEventAttributes is an internal type (TBD...)
-type UpsertWorkflowSearchAttributesEventAttributes struct {
-	DecisionTaskCompletedEventID int64             `json:"decisionTaskCompletedEventId,omitempty"`
-	SearchAttributes             *SearchAttributes `json:"searchAttributes,omitempty"`
-}
-
-// GetSearchAttributes is an internal getter (TBD...)
-func (v *UpsertWorkflowSearchAttributesEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
-	if v != nil && v.SearchAttributes != nil {
-		return v.SearchAttributes
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
 	}
 	return
 }
 
 // Size returns the approximate memory used in bytes
-func (v *UpsertWorkflowSearchAttributesEventAttributes) ByteSize() uint64 {
+func (v *WorkflowExecutionUpdateCompletedEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
-// VersionHistories is an internal type (TBD...)
-type VersionHistories struct {
-	CurrentVersionHistoryIndex int32             `json:"currentVersionHistoryIndex,omitempty"`
-	Histories                  []*VersionHistory `json:"histories,omitempty"`
+// WorkflowExecutionUpdateRejectedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUpdateRejectedEventAttributes struct {
+	UpdateID                     string `json:"updateId,omitempty"`
+	UpdateName                   string `json:"updateName,omitempty"`
+	Reason                       string `json:"reason,omitempty"`
+	Details                      []byte `json:"details,omitempty"`
+	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-// GetCurrentVersionHistoryIndex is an internal getter (TBD...)
-func (v *VersionHistories) GetCurrentVersionHistoryIndex() (o int32) {
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRejectedEventAttributes) GetUpdateID() (o string) {
 	if v != nil {
-		return v.CurrentVersionHistoryIndex
+		return v.UpdateID
 	}
 	return
 }
 
-// VersionHistory is an internal type (TBD...)
-type VersionHistory struct {
-	BranchToken []byte                `json:"branchToken,omitempty"`
-	Items       []*VersionHistoryItem `json:"items,omitempty"`
+// GetUpdateName is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRejectedEventAttributes) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
 }
 
-// GetItems is an internal getter (TBD...)
-func (v *VersionHistory) GetItems() (o []*VersionHistoryItem) {
-	if v != nil && v.Items != nil {
-		return v.Items
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRejectedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
 	}
 	return
 }
 
-// ByteSize returns the approximate memory used in bytes
-func (v *VersionHistory) ByteSize() uint64 {
-	if v == nil {
-		return 0
+// GetDetails is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRejectedEventAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
+	return
+}
 
-	size := uint64(unsafe.Sizeof(*v))
-	size += uint64(len(v.BranchToken))
-
-	// [] *VersionHistoryItem backing array: len * pointer size
-	size += uint64(len(v.Items)) * uint64(unsafe.Sizeof((*VersionHistoryItem)(nil)))
-	for _, e := range v.Items {
-		size += e.ByteSize()
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *WorkflowExecutionUpdateRejectedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
 	}
+	return
+}
 
-	return size
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionUpdateRejectedEventAttributes) ByteSize() uint64 {
+	return 0
 }
 
-// VersionHistoryItem is an internal type (TBD...)
-type VersionHistoryItem struct {
-	EventID int64 `json:"eventID,omitempty"`
-	Version int64 `json:"version,omitempty"`
+// WorkflowIDReusePolicy is an internal type (TBD...)
+type WorkflowIDReusePolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e WorkflowIDReusePolicy) Ptr() *WorkflowIDReusePolicy {
+	return &e
 }
 
-// GetVersion is an internal getter (TBD...)
-func (v *VersionHistoryItem) GetVersion() (o int64) {
-	if v != nil {
-		return v.Version
+// String returns a readable string representation of WorkflowIDReusePolicy.
+func (e WorkflowIDReusePolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "AllowDuplicateFailedOnly"
+	case 1:
+		return "AllowDuplicate"
+	case 2:
+		return "RejectDuplicate"
+	case 3:
+		return "TerminateIfRunning"
 	}
-	return
+	return fmt.Sprintf("WorkflowIDReusePolicy(%d)", w)
 }
 
-// ByteSize returns the approximate memory used in bytes
-func (v *VersionHistoryItem) ByteSize() uint64 {
-	if v == nil {
-		return 0
+// UnmarshalText parses enum value from string representation
+func (e *WorkflowIDReusePolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "ALLOWDUPLICATEFAILEDONLY":
+		*e = WorkflowIDReusePolicyAllowDuplicateFailedOnly
+		return nil
+	case "ALLOWDUPLICATE":
+		*e = WorkflowIDReusePolicyAllowDuplicate
+		return nil
+	case "REJECTDUPLICATE":
+		*e = WorkflowIDReusePolicyRejectDuplicate
+		return nil
+	case "TERMINATEIFRUNNING":
+		*e = WorkflowIDReusePolicyTerminateIfRunning
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowIDReusePolicy", err)
+		}
+		*e = WorkflowIDReusePolicy(val)
+		return nil
 	}
+}
 
-	return uint64(unsafe.Sizeof(*v))
+// MarshalText encodes WorkflowIDReusePolicy to text.
+func (e WorkflowIDReusePolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
 }
 
-// WorkerVersionInfo is an internal type (TBD...)
-type WorkerVersionInfo struct {
-	Impl           string `json:"impl,omitempty"`
-	FeatureVersion string `json:"featureVersion,omitempty"`
+const (
+	// WorkflowIDReusePolicyAllowDuplicateFailedOnly is an option for WorkflowIDReusePolicy
+	WorkflowIDReusePolicyAllowDuplicateFailedOnly WorkflowIDReusePolicy = iota
+	// WorkflowIDReusePolicyAllowDuplicate is an option for WorkflowIDReusePolicy
+	WorkflowIDReusePolicyAllowDuplicate
+	// WorkflowIDReusePolicyRejectDuplicate is an option for WorkflowIDReusePolicy
+	WorkflowIDReusePolicyRejectDuplicate
+	// WorkflowIDReusePolicyTerminateIfRunning is an option for WorkflowIDReusePolicy
+	WorkflowIDReusePolicyTerminateIfRunning
+)
+
+// WorkflowQuery is an internal type (TBD...)
+type WorkflowQuery struct {
+	QueryType string `json:"queryType,omitempty"`
+	QueryArgs []byte `json:"queryArgs,omitempty"`
 }
 
-// GetImpl is an internal getter (TBD...)
-func (v *WorkerVersionInfo) GetImpl() (o string) {
+// GetQueryType is an internal getter (TBD...)
+func (v *WorkflowQuery) GetQueryType() (o string) {
 	if v != nil {
-		return v.Impl
+		return v.QueryType
 	}
 	return
 }
 
-// GetFeatureVersion is an internal getter (TBD...)
-func (v *WorkerVersionInfo) GetFeatureVersion() (o string) {
-	if v != nil {
-		return v.FeatureVersion
+// GetQueryArgs is an internal getter (TBD...)
+func (v *WorkflowQuery) GetQueryArgs() (o []byte) {
+	if v != nil && v.QueryArgs != nil {
+		return v.QueryArgs
 	}
 	return
 }
 
-// WorkflowExecution is an internal type (TBD...)
-type WorkflowExecution struct {
-	WorkflowID string `json:"workflowId,omitempty"`
-	RunID      string `json:"runId,omitempty"`
+// WorkflowQueryResult is an internal type (TBD...)
+type WorkflowQueryResult struct {
+	ResultType   *QueryResultType `json:"resultType,omitempty"`
+	Answer       []byte           `json:"answer,omitempty"`
+	ErrorMessage string           `json:"errorMessage,omitempty"`
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *WorkflowExecution) GetWorkflowID() (o string) {
+// GetResultType is an internal getter (TBD...)
+func (v *WorkflowQueryResult) GetResultType() (o QueryResultType) {
+	if v != nil && v.ResultType != nil {
+		return *v.ResultType
+	}
+	return
+}
+
+// GetAnswer is an internal getter (TBD...)
+func (v *WorkflowQueryResult) GetAnswer() (o []byte) {
+	if v != nil && v.Answer != nil {
+		return v.Answer
+	}
+	return
+}
+
+// GetErrorMessage is an internal getter (TBD...)
+func (v *WorkflowQueryResult) GetErrorMessage() (o string) {
 	if v != nil {
-		return v.WorkflowID
+		return v.ErrorMessage
 	}
 	return
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *WorkflowExecution) GetRunID() (o string) {
+// WorkflowType is an internal type (TBD...)
+type WorkflowType struct {
+	Name string `json:"name,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *WorkflowType) GetName() (o string) {
 	if v != nil {
-		return v.RunID
+		return v.Name
 	}
 	return
 }
 
-// WorkflowExecutionAlreadyStartedError is an internal type (TBD...)
-type WorkflowExecutionAlreadyStartedError struct {
-	Message        string `json:"message,omitempty"`
-	StartRequestID string `json:"startRequestId,omitempty"`
-	RunID          string `json:"runId,omitempty"`
+// WorkflowTypeFilter is an internal type (TBD...)
+type WorkflowTypeFilter struct {
+	Name string `json:"name,omitempty"`
 }
 
-// GetMessage is an internal getter (TBD...)
-func (v *WorkflowExecutionAlreadyStartedError) GetMessage() (o string) {
+// GetName is an internal getter (TBD...)
+func (v *WorkflowTypeFilter) GetName() (o string) {
 	if v != nil {
-		return v.Message
+		return v.Name
 	}
 	return
 }
 
-// WorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
-type WorkflowExecutionCancelRequestedEventAttributes struct {
-	Cause                     string             `json:"cause,omitempty"`
-	ExternalInitiatedEventID  *int64             `json:"externalInitiatedEventId,omitempty"`
-	ExternalWorkflowExecution *WorkflowExecution `json:"externalWorkflowExecution,omitempty"`
-	Identity                  string             `json:"identity,omitempty"`
-	RequestID                 string             `json:"requestId,omitempty"`
+// CrossClusterTaskType is an internal type (TBD...)
+type CrossClusterTaskType int32
+
+// Ptr is a helper function for getting pointer value
+func (e CrossClusterTaskType) Ptr() *CrossClusterTaskType {
+	return &e
 }
 
-// Size returns the approximate memory used in bytes
-func (v *WorkflowExecutionCancelRequestedEventAttributes) ByteSize() uint64 {
-	return 0
+// String returns a readable string representation of CrossClusterTaskType.
+func (e CrossClusterTaskType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "StartChildExecution"
+	case 1:
+		return "CancelExecution"
+	case 2:
+		return "SignalExecution"
+	case 3:
+		return "RecordChildWorkflowExecutionComplete"
+	case 4:
+		return "ApplyParentClosePolicy"
+	}
+	return fmt.Sprintf("CrossClusterTaskType(%d)", w)
 }
 
-// WorkflowExecutionCanceledEventAttributes is an internal type (TBD...)
-type WorkflowExecutionCanceledEventAttributes struct {
-	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
-	Details                      []byte `json:"details,omitempty"`
+// UnmarshalText parses enum value from string representation
+func (e *CrossClusterTaskType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "STARTCHILDEXECUTION":
+		*e = CrossClusterTaskTypeStartChildExecution
+		return nil
+	case "CANCELEXECUTION":
+		*e = CrossClusterTaskTypeCancelExecution
+		return nil
+	case "SIGNALEXECUTION":
+		*e = CrossClusterTaskTypeSignalExecution
+		return nil
+	case "RECORDCHILDWORKLOWEXECUTIONCOMPLETE":
+		*e = CrossClusterTaskTypeRecordChildWorkflowExeuctionComplete
+		return nil
+	case "APPLYPARENTCLOSEPOLICY":
+		*e = CrossClusterTaskTypeApplyParentPolicy
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "CrossClusterTaskType", err)
+		}
+		*e = CrossClusterTaskType(val)
+		return nil
+	}
 }
 
-// Size returns the approximate memory used in bytes
-func (v *WorkflowExecutionCanceledEventAttributes) ByteSize() uint64 {
-	return 0
+// MarshalText encodes CrossClusterTaskType to text.
+func (e CrossClusterTaskType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
 }
 
-// WorkflowExecutionCloseStatus is an internal type (TBD...)
-type WorkflowExecutionCloseStatus int32
+const (
+	// CrossClusterTaskTypeStartChildExecution is an option for CrossClusterTaskType
+	CrossClusterTaskTypeStartChildExecution CrossClusterTaskType = iota
+	// CrossClusterTaskTypeCancelExecution is an option for CrossClusterTaskType
+	CrossClusterTaskTypeCancelExecution
+	// CrossClusterTaskTypeSignalExecution is an option for CrossClusterTaskType
+	CrossClusterTaskTypeSignalExecution
+	// CrossClusterTaskTypeRecordChildWorkflowExeuctionComplete is an option for CrossClusterTaskType
+	CrossClusterTaskTypeRecordChildWorkflowExeuctionComplete
+	// CrossClusterTaskTypeApplyParentPolicy is an option for CrossClusterTaskType
+	CrossClusterTaskTypeApplyParentPolicy
+)
+
+// CrossClusterTaskFailedCause is an internal type (TBD...)
+type CrossClusterTaskFailedCause int32
 
 // Ptr is a helper function for getting pointer value
-func (e WorkflowExecutionCloseStatus) Ptr() *WorkflowExecutionCloseStatus {
+func (e CrossClusterTaskFailedCause) Ptr() *CrossClusterTaskFailedCause {
 	return &e
 }
 
-// String returns a readable string representation of WorkflowExecutionCloseStatus.
-func (e WorkflowExecutionCloseStatus) String() string {
+// String returns a readable string representation of CrossClusterTaskFailedCause.
+func (e CrossClusterTaskFailedCause) String() string {
 	w := int32(e)
 	switch w {
 	case 0:
-		return "COMPLETED"
+		return "DOMAIN_NOT_ACTIVE"
 	case 1:
-		return "FAILED"
+		return "DOMAIN_NOT_EXISTS"
 	case 2:
-		return "CANCELED"
+		return "WORKFLOW_ALREADY_RUNNING"
 	case 3:
-		return "TERMINATED"
+		return "WORKFLOW_NOT_EXISTS"
 	case 4:
-		return "CONTINUED_AS_NEW"
+		return "WORKFLOW_ALREADY_COMPLETED"
 	case 5:
-		return "TIMED_OUT"
+		return "UNCATEGORIZED"
 	}
-	return fmt.Sprintf("WorkflowExecutionCloseStatus(%d)", w)
+	return fmt.Sprintf("CrossClusterTaskFailedCause(%d)", w)
 }
 
 // UnmarshalText parses enum value from string representation
-func (e *WorkflowExecutionCloseStatus) UnmarshalText(value []byte) error {
+func (e *CrossClusterTaskFailedCause) UnmarshalText(value []byte) error {
 	switch s := strings.ToUpper(string(value)); s {
-	case "COMPLETED":
-		*e = WorkflowExecutionCloseStatusCompleted
+	case "DOMAIN_NOT_ACTIVE":
+		*e = CrossClusterTaskFailedCauseDomainNotActive
 		return nil
-	case "FAILED":
-		*e = WorkflowExecutionCloseStatusFailed
+	case "DOMAIN_NOT_EXISTS":
+		*e = CrossClusterTaskFailedCauseDomainNotExists
 		return nil
-	case "CANCELED":
-		*e = WorkflowExecutionCloseStatusCanceled
+	case "WORKFLOW_ALREADY_RUNNING":
+		*e = CrossClusterTaskFailedCauseWorkflowAlreadyRunning
 		return nil
-	case "TERMINATED":
-		*e = WorkflowExecutionCloseStatusTerminated
+	case "WORKFLOW_NOT_EXISTS":
+		*e = CrossClusterTaskFailedCauseWorkflowNotExists
 		return nil
-	case "CONTINUED_AS_NEW":
-		*e = WorkflowExecutionCloseStatusContinuedAsNew
+	case "WORKFLOW_ALREADY_COMPLETED":
+		*e = CrossClusterTaskFailedCauseWorkflowAlreadyCompleted
 		return nil
-	case "TIMED_OUT":
-		*e = WorkflowExecutionCloseStatusTimedOut
+	case "UNCATEGORIZED":
+		*e = CrossClusterTaskFailedCauseUncategorized
 		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowExecutionCloseStatus", err)
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "CrossClusterTaskFailedCause", err)
 		}
-		*e = WorkflowExecutionCloseStatus(val)
+		*e = CrossClusterTaskFailedCause(val)
+		return nil
+	}
+}
+
+// MarshalText encodes CrossClusterTaskFailedCause to text.
+func (e CrossClusterTaskFailedCause) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// CrossClusterTaskFailedCauseDomainNotActive is an option for CrossClusterTaskFailedCause
+	CrossClusterTaskFailedCauseDomainNotActive CrossClusterTaskFailedCause = iota
+	// CrossClusterTaskFailedCauseDomainNotExists is an option for CrossClusterTaskFailedCause
+	CrossClusterTaskFailedCauseDomainNotExists
+	// CrossClusterTaskFailedCauseWorkflowAlreadyRunning is an option for CrossClusterTaskFailedCause
+	CrossClusterTaskFailedCauseWorkflowAlreadyRunning
+	// CrossClusterTaskFailedCauseWorkflowNotExists is an option for CrossClusterTaskFailedCause
+	CrossClusterTaskFailedCauseWorkflowNotExists
+	// CrossClusterTaskFailedCauseWorkflowAlreadyCompleted is an option for CrossClusterTaskFailedCause
+	CrossClusterTaskFailedCauseWorkflowAlreadyCompleted
+	// CrossClusterTaskFailedCauseUncategorized is an option for CrossClusterTaskFailedCause
+	CrossClusterTaskFailedCauseUncategorized
+)
+
+// GetTaskFailedCause is an internal type (TBD...)
+type GetTaskFailedCause int32
+
+// Ptr is a helper function for getting pointer value
+func (e GetTaskFailedCause) Ptr() *GetTaskFailedCause {
+	return &e
+}
+
+// String returns a readable string representation of GetCrossClusterTaskFailedCause.
+func (e GetTaskFailedCause) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "SERVICE_BUSY"
+	case 1:
+		return "TIMEOUT"
+	case 2:
+		return "SHARD_OWNERSHIP_LOST"
+	case 3:
+		return "UNCATEGORIZED"
+	}
+	return fmt.Sprintf("GetCrossClusterTaskFailedCause(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *GetTaskFailedCause) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "SERVICE_BUSY":
+		*e = GetTaskFailedCauseServiceBusy
+		return nil
+	case "TIMEOUT":
+		*e = GetTaskFailedCauseTimeout
+		return nil
+	case "SHARD_OWNERSHIP_LOST":
+		*e = GetTaskFailedCauseShardOwnershipLost
+		return nil
+	case "UNCATEGORIZED":
+		*e = GetTaskFailedCauseUncategorized
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "GetCrossClusterTaskFailedCause", err)
+		}
+		*e = GetTaskFailedCause(val)
 		return nil
 	}
 }
 
-// MarshalText encodes WorkflowExecutionCloseStatus to text.
-func (e WorkflowExecutionCloseStatus) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// MarshalText encodes GetCrossClusterTaskFailedCause to text.
+func (e GetTaskFailedCause) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// GetTaskFailedCauseServiceBusy is an option for GetCrossClusterTaskFailedCause
+	GetTaskFailedCauseServiceBusy GetTaskFailedCause = iota
+	// GetTaskFailedCauseTimeout is an option for GetCrossClusterTaskFailedCause
+	GetTaskFailedCauseTimeout
+	// GetTaskFailedCauseShardOwnershipLost is an option for GetCrossClusterTaskFailedCause
+	GetTaskFailedCauseShardOwnershipLost
+	// GetTaskFailedCauseUncategorized is an option for GetCrossClusterTaskFailedCause
+	GetTaskFailedCauseUncategorized
+)
+
+// CrossClusterTaskInfo is an internal type (TBD...)
+type CrossClusterTaskInfo struct {
+	DomainID            string                `json:"domainID,omitempty"`
+	WorkflowID          string                `json:"workflowID,omitempty"`
+	RunID               string                `json:"runID,omitempty"`
+	TaskType            *CrossClusterTaskType `json:"taskType,omitempty"`
+	TaskState           int16                 `json:"taskState,omitempty"`
+	TaskID              int64                 `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64                `json:"visibilityTimestamp,omitempty"`
+}
+
+// GetTaskType is an internal getter (TBD...)
+func (v *CrossClusterTaskInfo) GetTaskType() (o CrossClusterTaskType) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+	return
 }
 
-const (
-	// WorkflowExecutionCloseStatusCompleted is an option for WorkflowExecutionCloseStatus
-	WorkflowExecutionCloseStatusCompleted WorkflowExecutionCloseStatus = iota
-	// WorkflowExecutionCloseStatusFailed is an option for WorkflowExecutionCloseStatus
-	WorkflowExecutionCloseStatusFailed
-	// WorkflowExecutionCloseStatusCanceled is an option for WorkflowExecutionCloseStatus
-	WorkflowExecutionCloseStatusCanceled
-	// WorkflowExecutionCloseStatusTerminated is an option for WorkflowExecutionCloseStatus
-	WorkflowExecutionCloseStatusTerminated
-	// WorkflowExecutionCloseStatusContinuedAsNew is an option for WorkflowExecutionCloseStatus
-	WorkflowExecutionCloseStatusContinuedAsNew
-	// WorkflowExecutionCloseStatusTimedOut is an option for WorkflowExecutionCloseStatus
-	WorkflowExecutionCloseStatusTimedOut
-)
-
-// WorkflowExecutionCompletedEventAttributes is an internal type (TBD...)
-type WorkflowExecutionCompletedEventAttributes struct {
-	Result                       []byte `json:"result,omitempty"`
-	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
+// GetTaskID is an internal getter (TBD...)
+func (v *CrossClusterTaskInfo) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *WorkflowExecutionCompletedEventAttributes) ByteSize() uint64 {
-	return 0
+// GetVisibilityTimestamp is an internal getter (TBD...)
+func (v *CrossClusterTaskInfo) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+	return
 }
 
-// WorkflowExecutionConfiguration is an internal type (TBD...)
-type WorkflowExecutionConfiguration struct {
-	TaskList                            *TaskList `json:"taskList,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+// CrossClusterStartChildExecutionRequestAttributes is an internal type (TBD...)
+type CrossClusterStartChildExecutionRequestAttributes struct {
+	TargetDomainID           string                                               `json:"targetDomainID,omitempty"`
+	RequestID                string                                               `json:"requestID,omitempty"`
+	InitiatedEventID         int64                                                `json:"initiatedEventID,omitempty"`
+	InitiatedEventAttributes *StartChildWorkflowExecutionInitiatedEventAttributes `json:"initiatedEventAttributes,omitempty"`
+	TargetRunID              *string                                              `json:"targetRunID,omitempty"`
+	PartitionConfig          map[string]string
 }
 
-// WorkflowExecutionContinuedAsNewEventAttributes is an internal type (TBD...)
-type WorkflowExecutionContinuedAsNewEventAttributes struct {
-	NewExecutionRunID                   string                        `json:"newExecutionRunId,omitempty"`
-	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
-	TaskList                            *TaskList                     `json:"taskList,omitempty"`
-	Input                               []byte                        `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	DecisionTaskCompletedEventID        int64                         `json:"decisionTaskCompletedEventId,omitempty"`
-	BackoffStartIntervalInSeconds       *int32                        `json:"backoffStartIntervalInSeconds,omitempty"`
-	Initiator                           *ContinueAsNewInitiator       `json:"initiator,omitempty"`
-	FailureReason                       *string                       `json:"failureReason,omitempty"`
-	FailureDetails                      []byte                        `json:"failureDetails,omitempty"`
-	LastCompletionResult                []byte                        `json:"lastCompletionResult,omitempty"`
-	Header                              *Header                       `json:"header,omitempty"`
-	Memo                                *Memo                         `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes             `json:"searchAttributes,omitempty"`
-	JitterStartSeconds                  *int32                        `json:"jitterStartSeconds,omitempty"`
-	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
-	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+// GetRequestID is an internal getter (TBD...)
+func (v *CrossClusterStartChildExecutionRequestAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
 }
 
-// GetNewExecutionRunID is an internal getter (TBD...)
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetNewExecutionRunID() (o string) {
-	if v != nil {
-		return v.NewExecutionRunID
+// GetInitiatedEventAttributes is an internal getter (TBD...)
+func (v *CrossClusterStartChildExecutionRequestAttributes) GetInitiatedEventAttributes() (o *StartChildWorkflowExecutionInitiatedEventAttributes) {
+	if v != nil && v.InitiatedEventAttributes != nil {
+		return v.InitiatedEventAttributes
 	}
 	return
 }
 
-// GetInitiator is an internal getter (TBD...)
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetInitiator() (o ContinueAsNewInitiator) {
-	if v != nil && v.Initiator != nil {
-		return *v.Initiator
+// GetTargetRunID is an internal getter (TBD...)
+func (v *CrossClusterStartChildExecutionRequestAttributes) GetTargetRunID() (o string) {
+	if v != nil && v.TargetRunID != nil {
+		return *v.TargetRunID
 	}
 	return
 }
 
-// GetFailureReason is an internal getter (TBD...)
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetFailureReason() (o string) {
-	if v != nil && v.FailureReason != nil {
-		return *v.FailureReason
+// GetTargetRunID is an internal getter (TBD...)
+func (v *CrossClusterStartChildExecutionRequestAttributes) GetPartitionConfig() (o map[string]string) {
+	if v != nil && v.PartitionConfig != nil {
+		return v.PartitionConfig
 	}
 	return
 }
 
-// GetLastCompletionResult is an internal getter (TBD...)
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetLastCompletionResult() (o []byte) {
-	if v != nil && v.LastCompletionResult != nil {
-		return v.LastCompletionResult
+// CrossClusterStartChildExecutionResponseAttributes is an internal type (TBD...)
+type CrossClusterStartChildExecutionResponseAttributes struct {
+	RunID string `json:"runID,omitempty"`
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *CrossClusterStartChildExecutionResponseAttributes) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *WorkflowExecutionContinuedAsNewEventAttributes) ByteSize() uint64 {
-	return 0
+// CrossClusterCancelExecutionRequestAttributes is an internal type (TBD...)
+type CrossClusterCancelExecutionRequestAttributes struct {
+	TargetDomainID    string `json:"targetDomainID,omitempty"`
+	TargetWorkflowID  string `json:"targetWorkflowID,omitempty"`
+	TargetRunID       string `json:"targetRunID,omitempty"`
+	RequestID         string `json:"requestID,omitempty"`
+	InitiatedEventID  int64  `json:"initiatedEventID,omitempty"`
+	ChildWorkflowOnly bool   `json:"childWorkflowOnly,omitempty"`
 }
 
-// WorkflowExecutionFailedEventAttributes is an internal type (TBD...)
-type WorkflowExecutionFailedEventAttributes struct {
-	Reason                       *string `json:"reason,omitempty"`
-	Details                      []byte  `json:"details,omitempty"`
-	DecisionTaskCompletedEventID int64   `json:"decisionTaskCompletedEventId,omitempty"`
+// CrossClusterCancelExecutionResponseAttributes is an internal type (TBD...)
+type CrossClusterCancelExecutionResponseAttributes struct {
 }
 
-// GetReason is an internal getter (TBD...)
-func (v *WorkflowExecutionFailedEventAttributes) GetReason() (o string) {
-	if v != nil && v.Reason != nil {
-		return *v.Reason
-	}
-	return
+// CrossClusterSignalExecutionRequestAttributes is an internal type (TBD...)
+type CrossClusterSignalExecutionRequestAttributes struct {
+	TargetDomainID    string `json:"targetDomainID,omitempty"`
+	TargetWorkflowID  string `json:"targetWorkflowID,omitempty"`
+	TargetRunID       string `json:"targetRunID,omitempty"`
+	RequestID         string `json:"requestID,omitempty"`
+	InitiatedEventID  int64  `json:"initiatedEventID,omitempty"`
+	ChildWorkflowOnly bool   `json:"childWorkflowOnly,omitempty"`
+	SignalName        string `json:"signalName,omitempty"`
+	SignalInput       []byte `json:"signalInput,omitempty"`
+	Control           []byte `json:"control,omitempty"`
 }
 
-// Size returns the approximate memory used in bytes
-func (v *WorkflowExecutionFailedEventAttributes) ByteSize() uint64 {
-	return 0
+// CrossClusterSignalExecutionResponseAttributes is an internal type (TBD...)
+type CrossClusterSignalExecutionResponseAttributes struct {
 }
 
-// WorkflowExecutionFilter is an internal type (TBD...)
-type WorkflowExecutionFilter struct {
-	WorkflowID string `json:"workflowId,omitempty"`
-	RunID      string `json:"runId,omitempty"`
+type CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes struct {
+	TargetDomainID   string        `json:"targetDomainID,omitempty"`
+	TargetWorkflowID string        `json:"targetWorkflowID,omitempty"`
+	TargetRunID      string        `json:"targetRunID,omitempty"`
+	InitiatedEventID int64         `json:"initiatedEventID,omitempty"`
+	CompletionEvent  *HistoryEvent `json:"completionEvent,omitempty"`
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *WorkflowExecutionFilter) GetWorkflowID() (o string) {
+// CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes is an internal type (TBD...)
+type CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes struct {
+}
+
+type ApplyParentClosePolicyStatus struct {
+	Completed   bool                         `json:"completed,omitempty"`
+	FailedCause *CrossClusterTaskFailedCause `json:"failedCause,omitempty"`
+}
+
+type ApplyParentClosePolicyAttributes struct {
+	ChildDomainID     string             `json:"ChildDomainID,omitempty"`
+	ChildWorkflowID   string             `json:"ChildWorkflowID,omitempty"`
+	ChildRunID        string             `json:"ChildRunID,omitempty"`
+	ParentClosePolicy *ParentClosePolicy `json:"parentClosePolicy,omitempty"`
+}
+
+// GetParentClosePolicy is an internal getter (TBD...)
+func (v *ApplyParentClosePolicyAttributes) GetParentClosePolicy() (o *ParentClosePolicy) {
 	if v != nil {
-		return v.WorkflowID
+		return v.ParentClosePolicy
 	}
 	return
 }
 
-// WorkflowExecutionInfo is an internal type (TBD...)
-type WorkflowExecutionInfo struct {
-	Execution                    *WorkflowExecution            `json:"execution,omitempty"`
-	Type                         *WorkflowType                 `json:"type,omitempty"`
-	StartTime                    *int64                        `json:"startTime,omitempty"`
-	CloseTime                    *int64                        `json:"closeTime,omitempty"`
-	CloseStatus                  *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
-	HistoryLength                int64                         `json:"historyLength,omitempty"` // should be history count
-	ParentDomainID               *string                       `json:"parentDomainId,omitempty"`
-	ParentDomain                 *string                       `json:"parentDomain,omitempty"`
-	ParentExecution              *WorkflowExecution            `json:"parentExecution,omitempty"`
-	ParentInitiatedID            *int64                        `json:"parentInitiatedId,omitempty"`
-	ExecutionTime                *int64                        `json:"executionTime,omitempty"`
-	Memo                         *Memo                         `json:"memo,omitempty"`
-	SearchAttributes             *SearchAttributes             `json:"searchAttributes,omitempty"`
-	AutoResetPoints              *ResetPoints                  `json:"autoResetPoints,omitempty"`
-	TaskList                     *TaskList                     `json:"taskList,omitempty"`
-	IsCron                       bool                          `json:"isCron,omitempty"`
-	UpdateTime                   *int64                        `json:"updateTime,omitempty"`
-	PartitionConfig              map[string]string             `json:"partitionConfig,omitempty"`
-	CronOverlapPolicy            *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
-	ActiveClusterSelectionPolicy *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+type ApplyParentClosePolicyRequest struct {
+	Child  *ApplyParentClosePolicyAttributes `json:"child,omitempty"`
+	Status *ApplyParentClosePolicyStatus     `json:"status,omitempty"`
 }
 
-// GetExecution is an internal getter (TBD...)
-func (v *WorkflowExecutionInfo) GetExecution() (o *WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
-	}
-	return
+type CrossClusterApplyParentClosePolicyRequestAttributes struct {
+	Children []*ApplyParentClosePolicyRequest `json:"children,omitempty"`
 }
 
-// GetType is an internal getter (TBD...)
-func (v *WorkflowExecutionInfo) GetType() (o *WorkflowType) {
-	if v != nil && v.Type != nil {
-		return v.Type
-	}
-	return
+type ApplyParentClosePolicyResult struct {
+	Child       *ApplyParentClosePolicyAttributes `json:"child,omitempty"`
+	FailedCause *CrossClusterTaskFailedCause      `json:"failedCause,omitempty"`
 }
 
-// GetStartTime is an internal getter (TBD...)
-func (v *WorkflowExecutionInfo) GetStartTime() (o int64) {
-	if v != nil && v.StartTime != nil {
-		return *v.StartTime
-	}
-	return
+// CrossClusterApplyParentClosePolicyResponseAttributes is an internal type (TBD...)
+type CrossClusterApplyParentClosePolicyResponseAttributes struct {
+	ChildrenStatus []*ApplyParentClosePolicyResult `json:"childrenStatus,omitempty"`
+}
+
+// CrossClusterTaskRequest is an internal type (TBD...)
+type CrossClusterTaskRequest struct {
+	TaskInfo                                       *CrossClusterTaskInfo                                              `json:"taskInfo,omitempty"`
+	StartChildExecutionAttributes                  *CrossClusterStartChildExecutionRequestAttributes                  `json:"startChildExecutionAttributes,omitempty"`
+	CancelExecutionAttributes                      *CrossClusterCancelExecutionRequestAttributes                      `json:"cancelExecutionAttributes,omitempty"`
+	SignalExecutionAttributes                      *CrossClusterSignalExecutionRequestAttributes                      `json:"signalExecutionAttributes,omitempty"`
+	RecordChildWorkflowExecutionCompleteAttributes *CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes `json:"RecordChildWorkflowExecutionCompleteAttributes,omitempty"`
+	ApplyParentClosePolicyAttributes               *CrossClusterApplyParentClosePolicyRequestAttributes               `json:"ApplyParentClosePolicyAttributes,omitempty"`
+}
+
+// CrossClusterTaskResponse is an internal type (TBD...)
+type CrossClusterTaskResponse struct {
+	TaskID                                         int64                                                               `json:"taskID,omitempty"`
+	TaskType                                       *CrossClusterTaskType                                               `json:"taskType,omitempty"`
+	TaskState                                      int16                                                               `json:"taskState,omitempty"`
+	FailedCause                                    *CrossClusterTaskFailedCause                                        `json:"failedCause,omitempty"`
+	StartChildExecutionAttributes                  *CrossClusterStartChildExecutionResponseAttributes                  `json:"startChildExecutionAttributes,omitempty"`
+	CancelExecutionAttributes                      *CrossClusterCancelExecutionResponseAttributes                      `json:"cancelExecutionAttributes,omitempty"`
+	SignalExecutionAttributes                      *CrossClusterSignalExecutionResponseAttributes                      `json:"signalExecutionAttributes,omitempty"`
+	RecordChildWorkflowExecutionCompleteAttributes *CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes `json:"RecordChildWorkflowExecutionCompleteAttributes,omitempty"`
+	ApplyParentClosePolicyAttributes               *CrossClusterApplyParentClosePolicyResponseAttributes               `json:"ApplyParentClosePolicyAttributes,omitempty"`
 }
 
-// GetCloseTime is an internal getter (TBD...)
-func (v *WorkflowExecutionInfo) GetCloseTime() (o int64) {
-	if v != nil && v.CloseTime != nil {
-		return *v.CloseTime
+// GetTaskID is an internal getter (TBD...)
+func (v *CrossClusterTaskResponse) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
 	}
 	return
 }
 
-// GetCloseStatus is an internal getter (TBD...)
-func (v *WorkflowExecutionInfo) GetCloseStatus() (o WorkflowExecutionCloseStatus) {
-	if v != nil && v.CloseStatus != nil {
-		return *v.CloseStatus
+// GetTaskType is an internal getter (TBD...)
+func (v *CrossClusterTaskResponse) GetTaskType() (o CrossClusterTaskType) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
 	}
 	return
 }
 
-// GetExecutionTime is an internal getter (TBD...)
-func (v *WorkflowExecutionInfo) GetExecutionTime() (o int64) {
-	if v != nil && v.ExecutionTime != nil {
-		return *v.ExecutionTime
+// GetFailedCause is an internal getter (TBD...)
+func (v *CrossClusterTaskResponse) GetFailedCause() (o CrossClusterTaskFailedCause) {
+	if v != nil && v.FailedCause != nil {
+		return *v.FailedCause
 	}
 	return
 }
 
-// GetUpdateTime is an internal getter (TBD...)
-func (v *WorkflowExecutionInfo) GetUpdateTime() (o int64) {
-	if v != nil && v.UpdateTime != nil {
-		return *v.UpdateTime
-	}
-	return
+// GetCrossClusterTasksRequest is an internal type (TBD...)
+type GetCrossClusterTasksRequest struct {
+	ShardIDs      []int32 `json:"shardIDs,omitempty"`
+	TargetCluster string  `json:"targetCluster,omitempty"`
 }
 
-// GetSearchAttributes is an internal getter (TBD...)
-func (v *WorkflowExecutionInfo) GetSearchAttributes() (o *SearchAttributes) {
-	if v != nil && v.SearchAttributes != nil {
-		return v.SearchAttributes
+// GetShardIDs is an internal getter (TBD...)
+func (v *GetCrossClusterTasksRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
 	}
 	return
 }
 
-// GetPartitionConfig is an internal getter (TBD...)
-func (v *WorkflowExecutionInfo) GetPartitionConfig() (o map[string]string) {
-	if v != nil && v.PartitionConfig != nil {
-		return v.PartitionConfig
+// GetTargetCluster is an internal getter (TBD...)
+func (v *GetCrossClusterTasksRequest) GetTargetCluster() (o string) {
+	if v != nil {
+		return v.TargetCluster
 	}
 	return
 }
 
-// WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
-type WorkflowExecutionSignaledEventAttributes struct {
-	SignalName string `json:"signalName,omitempty"`
-	Input      []byte `json:"input,omitempty"`
-	Identity   string `json:"identity,omitempty"`
-	RequestID  string `json:"requestId,omitempty"`
+// GetCrossClusterTasksResponse is an internal type (TBD...)
+type GetCrossClusterTasksResponse struct {
+	TasksByShard       map[int32][]*CrossClusterTaskRequest `json:"tasksByShard,omitempty"`
+	FailedCauseByShard map[int32]GetTaskFailedCause         `json:"failedCauseByShard,omitempty"`
 }
 
-// GetSignalName is an internal getter (TBD...)
-func (v *WorkflowExecutionSignaledEventAttributes) GetSignalName() (o string) {
-	if v != nil {
-		return v.SignalName
+// GetTasksByShard is an internal getter (TBD...)
+func (v *GetCrossClusterTasksResponse) GetTasksByShard() (o map[int32][]*CrossClusterTaskRequest) {
+	if v != nil && v.TasksByShard != nil {
+		return v.TasksByShard
 	}
 	return
 }
 
-// GetInput is an internal getter (TBD...)
-func (v *WorkflowExecutionSignaledEventAttributes) GetInput() (o []byte) {
-	if v != nil && v.Input != nil {
-		return v.Input
-	}
-	return
+// RespondCrossClusterTasksCompletedRequest is an internal type (TBD...)
+type RespondCrossClusterTasksCompletedRequest struct {
+	ShardID       int32                       `json:"shardID,omitempty"`
+	TargetCluster string                      `json:"targetCluster,omitempty"`
+	TaskResponses []*CrossClusterTaskResponse `json:"taskResponses,omitempty"`
+	FetchNewTasks bool                        `json:"fetchNewTasks,omitempty"`
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *WorkflowExecutionSignaledEventAttributes) GetIdentity() (o string) {
+// GetShardID is an internal getter (TBD...)
+func (v *RespondCrossClusterTasksCompletedRequest) GetShardID() (o int32) {
 	if v != nil {
-		return v.Identity
+		return v.ShardID
 	}
 	return
 }
 
-// GetRequestID is an internal getter (TBD...)
-func (v *WorkflowExecutionSignaledEventAttributes) GetRequestID() (o string) {
+// GetFetchNewTasks is an internal getter (TBD...)
+func (v *RespondCrossClusterTasksCompletedRequest) GetFetchNewTasks() (o bool) {
 	if v != nil {
-		return v.RequestID
+		return v.FetchNewTasks
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *WorkflowExecutionSignaledEventAttributes) ByteSize() uint64 {
-	return 0
+// RespondCrossClusterTasksCompletedResponse is an internal type (TBD...)
+type RespondCrossClusterTasksCompletedResponse struct {
+	Tasks []*CrossClusterTaskRequest `json:"tasks,omitempty"`
 }
 
-// WorkflowExecutionStartedEventAttributes is an internal type (TBD...)
-type WorkflowExecutionStartedEventAttributes struct {
-	WorkflowType                        *WorkflowType                 `json:"workflowType,omitempty"`
-	ParentWorkflowDomainID              *string                       `json:"parentWorkflowDomainID,omitempty"`
-	ParentWorkflowDomain                *string                       `json:"parentWorkflowDomain,omitempty"`
-	ParentWorkflowExecution             *WorkflowExecution            `json:"parentWorkflowExecution,omitempty"`
-	ParentInitiatedEventID              *int64                        `json:"parentInitiatedEventId,omitempty"`
-	TaskList                            *TaskList                     `json:"taskList,omitempty"`
-	Input                               []byte                        `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	ContinuedExecutionRunID             string                        `json:"continuedExecutionRunId,omitempty"`
-	Initiator                           *ContinueAsNewInitiator       `json:"initiator,omitempty"`
-	ContinuedFailureReason              *string                       `json:"continuedFailureReason,omitempty"`
-	ContinuedFailureDetails             []byte                        `json:"continuedFailureDetails,omitempty"`
-	LastCompletionResult                []byte                        `json:"lastCompletionResult,omitempty"`
-	OriginalExecutionRunID              string                        `json:"originalExecutionRunId,omitempty"`
-	Identity                            string                        `json:"identity,omitempty"`
-	FirstExecutionRunID                 string                        `json:"firstExecutionRunId,omitempty"`
-	FirstScheduleTime                   *time.Time                    `json:"firstScheduleTimeNano,omitempty"`
-	RetryPolicy                         *RetryPolicy                  `json:"retryPolicy,omitempty"`
-	Attempt                             int32                         `json:"attempt,omitempty"`
-	ExpirationTimestamp                 *int64                        `json:"expirationTimestamp,omitempty"`
-	CronSchedule                        string                        `json:"cronSchedule,omitempty"`
-	FirstDecisionTaskBackoffSeconds     *int32                        `json:"firstDecisionTaskBackoffSeconds,omitempty"`
-	Memo                                *Memo                         `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes             `json:"searchAttributes,omitempty"`
-	PrevAutoResetPoints                 *ResetPoints                  `json:"prevAutoResetPoints,omitempty"`
-	Header                              *Header                       `json:"header,omitempty"`
-	JitterStartSeconds                  *int32                        `json:"jitterStartSeconds,omitempty"`
-	PartitionConfig                     map[string]string             `json:"partitionConfig,omitempty"`
-	RequestID                           string                        `json:"requestId,omitempty"`
-	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
-	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+// StickyWorkerUnavailableError is an internal type (TBD...)
+type StickyWorkerUnavailableError struct {
+	Message string `json:"message,required"`
 }
 
-// GetParentWorkflowDomain is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetParentWorkflowDomain() (o string) {
-	if v != nil && v.ParentWorkflowDomain != nil {
-		return *v.ParentWorkflowDomain
-	}
-	return
+// Any is an internal mirror of google.protobuf.Any, serving the same purposes, but
+// intentionally breaking direct compatibility because it may hold data that is not
+// actually protobuf encoded.
+//
+// All uses of Any must either:
+//   - check that ValueType is a recognized type, and deserialize based on its contents
+//   - or just pass it along as an opaque type for something else to use
+//
+// Contents are intentionally undefined to allow external definitions, e.g. from
+// third-party plugins that are not part of this source repository.
+type Any struct {
+	// ValueType describes the type of encoded data in Value.
+	//
+	// No structure or allowed values are defined, but you are strongly encouraged
+	// to use hard-coded strings (or unambiguous prefixes) or URLs when possible.
+	//
+	// For more concise encoding of exclusively known types, use e.g. DataBlob instead.
+	ValueType string `json:"value_type"`
+	// Value holds arbitrary bytes, and is described by ValueType.
+	//
+	// To interpret, you MUST check ValueType.
+	Value []byte `json:"value"`
 }
 
-// GetParentInitiatedEventID is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetParentInitiatedEventID() (o int64) {
-	if v != nil && v.ParentInitiatedEventID != nil {
-		return *v.ParentInitiatedEventID
-	}
-	return
+// AutoConfigHint is an internal type (TBD...)
+type AutoConfigHint struct {
+	EnableAutoConfig   bool  `json:"enableAutoConfig"`
+	PollerWaitTimeInMs int64 `json:"pollerWaitTimeInMs"`
 }
 
-// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
-		return *v.ExecutionStartToCloseTimeoutSeconds
-	}
-	return
-}
+type CronOverlapPolicy int32
 
-// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetTaskStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
-		return *v.TaskStartToCloseTimeoutSeconds
-	}
-	return
-}
+const (
+	CronOverlapPolicySkipped CronOverlapPolicy = iota
+	CronOverlapPolicyBufferOne
+)
 
-// GetContinuedExecutionRunID is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetContinuedExecutionRunID() (o string) {
-	if v != nil {
-		return v.ContinuedExecutionRunID
+func (v CronOverlapPolicy) String() string {
+	switch v {
+	case CronOverlapPolicySkipped:
+		return "SKIP"
+	case CronOverlapPolicyBufferOne:
+		return "BUFFERONE"
 	}
-	return
+	return "UNKNOWN"
 }
 
-// GetInitiator is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetInitiator() (o ContinueAsNewInitiator) {
-	if v != nil && v.Initiator != nil {
-		return *v.Initiator
-	}
-	return
+// Ptr is a helper function for getting pointer to CronOverlapPolicy
+func (v CronOverlapPolicy) Ptr() *CronOverlapPolicy {
+	return &v
 }
 
-// GetFirstExecutionRunID is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetFirstExecutionRunID() (o string) {
-	if v != nil {
-		return v.FirstExecutionRunID
-	}
-	return
-}
+// ScheduleOverlapPolicy is an internal type (TBD...)
+type ScheduleOverlapPolicy int32
 
-// GetFirstScheduledTime is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetFirstScheduledTime() (o time.Time) {
-	if v != nil && v.FirstScheduleTime != nil {
-		return *v.FirstScheduleTime
+const (
+	// ScheduleOverlapPolicySkip is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicySkip ScheduleOverlapPolicy = iota
+	// ScheduleOverlapPolicyBuffer is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyBuffer
+	// ScheduleOverlapPolicyCancelPrevious is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyCancelPrevious
+	// ScheduleOverlapPolicyAllowAll is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyAllowAll
+)
+
+func (v ScheduleOverlapPolicy) String() string {
+	switch v {
+	case ScheduleOverlapPolicySkip:
+		return "SKIP"
+	case ScheduleOverlapPolicyBuffer:
+		return "BUFFER"
+	case ScheduleOverlapPolicyCancelPrevious:
+		return "CANCEL_PREVIOUS"
+	case ScheduleOverlapPolicyAllowAll:
+		return "ALLOW_ALL"
 	}
-	return
+	return "UNKNOWN"
 }
 
-// GetAttempt is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetAttempt() (o int32) {
-	if v != nil {
-		return v.Attempt
-	}
-	return
+// Ptr is a helper function for getting pointer to ScheduleOverlapPolicy
+func (v ScheduleOverlapPolicy) Ptr() *ScheduleOverlapPolicy {
+	return &v
 }
 
-// GetExpirationTimestamp is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetExpirationTimestamp() (o int64) {
-	if v != nil && v.ExpirationTimestamp != nil {
-		return *v.ExpirationTimestamp
-	}
-	return
+// ScheduleSpec is an internal type (TBD...)
+type ScheduleSpec struct {
+	CronExpression  string `json:"cronExpression,omitempty"`
+	JitterInSeconds int32  `json:"jitterInSeconds,omitempty"`
+	StartTimestamp  *int64 `json:"startTimestamp,omitempty"`
+	EndTimestamp    *int64 `json:"endTimestamp,omitempty"`
 }
 
-// GetCronSchedule is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetCronSchedule() (o string) {
+// GetCronExpression is an internal getter (TBD...)
+func (v *ScheduleSpec) GetCronExpression() (o string) {
 	if v != nil {
-		return v.CronSchedule
+		return v.CronExpression
 	}
 	return
 }
 
-// GetFirstDecisionTaskBackoffSeconds is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetFirstDecisionTaskBackoffSeconds() (o int32) {
-	if v != nil && v.FirstDecisionTaskBackoffSeconds != nil {
-		return *v.FirstDecisionTaskBackoffSeconds
+// GetJitterInSeconds is an internal getter (TBD...)
+func (v *ScheduleSpec) GetJitterInSeconds() (o int32) {
+	if v != nil {
+		return v.JitterInSeconds
 	}
 	return
 }
 
-func (v *WorkflowExecutionStartedEventAttributes) GetJitterStartSeconds() (o int32) {
-	if v != nil && v.JitterStartSeconds != nil {
-		return *v.JitterStartSeconds
+// GetStartTimestamp is an internal getter (TBD...)
+func (v *ScheduleSpec) GetStartTimestamp() (o int64) {
+	if v != nil && v.StartTimestamp != nil {
+		return *v.StartTimestamp
 	}
 	return
 }
 
-// GetMemo is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetMemo() (o *Memo) {
-	if v != nil && v.Memo != nil {
-		return v.Memo
+// GetEndTimestamp is an internal getter (TBD...)
+func (v *ScheduleSpec) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
 	}
 	return
 }
 
-// GetSearchAttributes is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
-	if v != nil && v.SearchAttributes != nil {
-		return v.SearchAttributes
-	}
-	return
+// ScheduleAction is an internal type (TBD...)
+type ScheduleAction struct {
+	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
+	TaskList                            *TaskList     `json:"taskList,omitempty"`
+	Input                               []byte        `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
 }
 
-// GetPrevAutoResetPoints is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetPrevAutoResetPoints() (o *ResetPoints) {
-	if v != nil && v.PrevAutoResetPoints != nil {
-		return v.PrevAutoResetPoints
+// GetWorkflowType is an internal getter (TBD...)
+func (v *ScheduleAction) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
 	}
 	return
 }
 
-// GetPartitionConfig is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetPartitionConfig() (o map[string]string) {
-	if v != nil && v.PartitionConfig != nil {
-		return v.PartitionConfig
+// GetTaskList is an internal getter (TBD...)
+func (v *ScheduleAction) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
 	}
 	return
 }
 
-// GetRequestID is an internal getter (TBD...)
-func (v *WorkflowExecutionStartedEventAttributes) GetRequestID() (o string) {
-	if v != nil {
-		return v.RequestID
+// GetInput is an internal getter (TBD...)
+func (v *ScheduleAction) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *WorkflowExecutionStartedEventAttributes) ByteSize() uint64 {
-	return 0
-}
-
-// WorkflowExecutionTerminatedEventAttributes is an internal type (TBD...)
-type WorkflowExecutionTerminatedEventAttributes struct {
-	Reason   string `json:"reason,omitempty"`
-	Details  []byte `json:"details,omitempty"`
-	Identity string `json:"identity,omitempty"`
-}
-
-// GetReason is an internal getter (TBD...)
-func (v *WorkflowExecutionTerminatedEventAttributes) GetReason() (o string) {
-	if v != nil {
-		return v.Reason
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleAction) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
 	}
 	return
 }
 
-// GetIdentity is an internal getter (TBD...)
-func (v *WorkflowExecutionTerminatedEventAttributes) GetIdentity() (o string) {
-	if v != nil {
-		return v.Identity
+// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *WorkflowExecutionTerminatedEventAttributes) ByteSize() uint64 {
-	return 0
-}
-
-// WorkflowExecutionTimedOutEventAttributes is an internal type (TBD...)
-type WorkflowExecutionTimedOutEventAttributes struct {
-	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
+// ScheduleActionResult is an internal type (TBD...)
+type ScheduleActionResult struct {
+	NominalTimestamp  *int64             `json:"nominalTimestamp,omitempty"`
+	ActualTimestamp   *int64             `json:"actualTimestamp,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Skipped           bool               `json:"skipped,omitempty"`
+	FailureReason     string             `json:"failureReason,omitempty"`
 }
 
-// GetTimeoutType is an internal getter (TBD...)
-func (v *WorkflowExecutionTimedOutEventAttributes) GetTimeoutType() (o TimeoutType) {
-	if v != nil && v.TimeoutType != nil {
-		return *v.TimeoutType
+// GetNominalTimestamp is an internal getter (TBD...)
+func (v *ScheduleActionResult) GetNominalTimestamp() (o int64) {
+	if v != nil && v.NominalTimestamp != nil {
+		return *v.NominalTimestamp
 	}
 	return
 }
 
-// Size returns the approximate memory used in bytes
-func (v *WorkflowExecutionTimedOutEventAttributes) ByteSize() uint64 {
-	return 0
-}
-
-// WorkflowIDReusePolicy is an internal type (TBD...)
-type WorkflowIDReusePolicy int32
-
-// Ptr is a helper function for getting pointer value
-func (e WorkflowIDReusePolicy) Ptr() *WorkflowIDReusePolicy {
-	return &e
-}
-
-// String returns a readable string representation of WorkflowIDReusePolicy.
-func (e WorkflowIDReusePolicy) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "AllowDuplicateFailedOnly"
-	case 1:
-		return "AllowDuplicate"
-	case 2:
-		return "RejectDuplicate"
-	case 3:
-		return "TerminateIfRunning"
+// GetActualTimestamp is an internal getter (TBD...)
+func (v *ScheduleActionResult) GetActualTimestamp() (o int64) {
+	if v != nil && v.ActualTimestamp != nil {
+		return *v.ActualTimestamp
 	}
-	return fmt.Sprintf("WorkflowIDReusePolicy(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *WorkflowIDReusePolicy) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "ALLOWDUPLICATEFAILEDONLY":
-		*e = WorkflowIDReusePolicyAllowDuplicateFailedOnly
-		return nil
-	case "ALLOWDUPLICATE":
-		*e = WorkflowIDReusePolicyAllowDuplicate
-		return nil
-	case "REJECTDUPLICATE":
-		*e = WorkflowIDReusePolicyRejectDuplicate
-		return nil
-	case "TERMINATEIFRUNNING":
-		*e = WorkflowIDReusePolicyTerminateIfRunning
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowIDReusePolicy", err)
-		}
-		*e = WorkflowIDReusePolicy(val)
-		return nil
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ScheduleActionResult) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
+	return
 }
 
-// MarshalText encodes WorkflowIDReusePolicy to text.
-func (e WorkflowIDReusePolicy) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
-}
-
-const (
-	// WorkflowIDReusePolicyAllowDuplicateFailedOnly is an option for WorkflowIDReusePolicy
-	WorkflowIDReusePolicyAllowDuplicateFailedOnly WorkflowIDReusePolicy = iota
-	// WorkflowIDReusePolicyAllowDuplicate is an option for WorkflowIDReusePolicy
-	WorkflowIDReusePolicyAllowDuplicate
-	// WorkflowIDReusePolicyRejectDuplicate is an option for WorkflowIDReusePolicy
-	WorkflowIDReusePolicyRejectDuplicate
-	// WorkflowIDReusePolicyTerminateIfRunning is an option for WorkflowIDReusePolicy
-	WorkflowIDReusePolicyTerminateIfRunning
-)
-
-// WorkflowQuery is an internal type (TBD...)
-type WorkflowQuery struct {
-	QueryType string `json:"queryType,omitempty"`
-	QueryArgs []byte `json:"queryArgs,omitempty"`
-}
-
-// GetQueryType is an internal getter (TBD...)
-func (v *WorkflowQuery) GetQueryType() (o string) {
+// GetSkipped is an internal getter (TBD...)
+func (v *ScheduleActionResult) GetSkipped() (o bool) {
 	if v != nil {
-		return v.QueryType
+		return v.Skipped
 	}
 	return
 }
 
-// GetQueryArgs is an internal getter (TBD...)
-func (v *WorkflowQuery) GetQueryArgs() (o []byte) {
-	if v != nil && v.QueryArgs != nil {
-		return v.QueryArgs
+// GetFailureReason is an internal getter (TBD...)
+func (v *ScheduleActionResult) GetFailureReason() (o string) {
+	if v != nil {
+		return v.FailureReason
 	}
 	return
 }
 
-// WorkflowQueryResult is an internal type (TBD...)
-type WorkflowQueryResult struct {
-	ResultType   *QueryResultType `json:"resultType,omitempty"`
-	Answer       []byte           `json:"answer,omitempty"`
-	ErrorMessage string           `json:"errorMessage,omitempty"`
+// CreateScheduleRequest is an internal type (TBD...)
+type CreateScheduleRequest struct {
+	Domain        string                 `json:"domain,omitempty"`
+	ScheduleID    string                 `json:"scheduleID,omitempty"`
+	Spec          *ScheduleSpec          `json:"spec,omitempty"`
+	Action        *ScheduleAction        `json:"action,omitempty"`
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	Paused        bool                   `json:"paused,omitempty"`
+	Identity      string                 `json:"identity,omitempty"`
 }
 
-// GetResultType is an internal getter (TBD...)
-func (v *WorkflowQueryResult) GetResultType() (o QueryResultType) {
-	if v != nil && v.ResultType != nil {
-		return *v.ResultType
+// GetDomain is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetAnswer is an internal getter (TBD...)
-func (v *WorkflowQueryResult) GetAnswer() (o []byte) {
-	if v != nil && v.Answer != nil {
-		return v.Answer
+// GetScheduleID is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
 	}
 	return
 }
 
-// GetErrorMessage is an internal getter (TBD...)
-func (v *WorkflowQueryResult) GetErrorMessage() (o string) {
-	if v != nil {
-		return v.ErrorMessage
+// GetSpec is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
 	}
 	return
 }
 
-// WorkflowType is an internal type (TBD...)
-type WorkflowType struct {
-	Name string `json:"name,omitempty"`
-}
-
-// GetName is an internal getter (TBD...)
-func (v *WorkflowType) GetName() (o string) {
-	if v != nil {
-		return v.Name
+// GetAction is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetAction() (o *ScheduleAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
 	}
 	return
 }
 
-// WorkflowTypeFilter is an internal type (TBD...)
-type WorkflowTypeFilter struct {
-	Name string `json:"name,omitempty"`
-}
-
-// GetName is an internal getter (TBD...)
-func (v *WorkflowTypeFilter) GetName() (o string) {
-	if v != nil {
-		return v.Name
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
 	}
 	return
 }
 
-// CrossClusterTaskType is an internal type (TBD...)
-type CrossClusterTaskType int32
-
-// Ptr is a helper function for getting pointer value
-func (e CrossClusterTaskType) Ptr() *CrossClusterTaskType {
-	return &e
-}
-
-// String returns a readable string representation of CrossClusterTaskType.
-func (e CrossClusterTaskType) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "StartChildExecution"
-	case 1:
-		return "CancelExecution"
-	case 2:
-		return "SignalExecution"
-	case 3:
-		return "RecordChildWorkflowExecutionComplete"
-	case 4:
-		return "ApplyParentClosePolicy"
+// GetPaused is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
 	}
-	return fmt.Sprintf("CrossClusterTaskType(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *CrossClusterTaskType) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "STARTCHILDEXECUTION":
-		*e = CrossClusterTaskTypeStartChildExecution
-		return nil
-	case "CANCELEXECUTION":
-		*e = CrossClusterTaskTypeCancelExecution
-		return nil
-	case "SIGNALEXECUTION":
-		*e = CrossClusterTaskTypeSignalExecution
-		return nil
-	case "RECORDCHILDWORKLOWEXECUTIONCOMPLETE":
-		*e = CrossClusterTaskTypeRecordChildWorkflowExeuctionComplete
-		return nil
-	case "APPLYPARENTCLOSEPOLICY":
-		*e = CrossClusterTaskTypeApplyParentPolicy
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "CrossClusterTaskType", err)
-		}
-		*e = CrossClusterTaskType(val)
-		return nil
+// GetIdentity is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
+	return
 }
 
-// MarshalText encodes CrossClusterTaskType to text.
-func (e CrossClusterTaskType) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// DescribeScheduleRequest is an internal type (TBD...)
+type DescribeScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleID,omitempty"`
 }
 
-const (
-	// CrossClusterTaskTypeStartChildExecution is an option for CrossClusterTaskType
-	CrossClusterTaskTypeStartChildExecution CrossClusterTaskType = iota
-	// CrossClusterTaskTypeCancelExecution is an option for CrossClusterTaskType
-	CrossClusterTaskTypeCancelExecution
-	// CrossClusterTaskTypeSignalExecution is an option for CrossClusterTaskType
-	CrossClusterTaskTypeSignalExecution
-	// CrossClusterTaskTypeRecordChildWorkflowExeuctionComplete is an option for CrossClusterTaskType
-	CrossClusterTaskTypeRecordChildWorkflowExeuctionComplete
-	// CrossClusterTaskTypeApplyParentPolicy is an option for CrossClusterTaskType
-	CrossClusterTaskTypeApplyParentPolicy
-)
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
 
-// CrossClusterTaskFailedCause is an internal type (TBD...)
-type CrossClusterTaskFailedCause int32
+// GetScheduleID is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
 
-// Ptr is a helper function for getting pointer value
-func (e CrossClusterTaskFailedCause) Ptr() *CrossClusterTaskFailedCause {
-	return &e
+// DescribeScheduleResponse is an internal type (TBD...)
+type DescribeScheduleResponse struct {
+	Spec              *ScheduleSpec           `json:"spec,omitempty"`
+	Action            *ScheduleAction         `json:"action,omitempty"`
+	OverlapPolicy     *ScheduleOverlapPolicy  `json:"overlapPolicy,omitempty"`
+	Paused            bool                    `json:"paused,omitempty"`
+	PauseReason       string                  `json:"pauseReason,omitempty"`
+	RunningExecutions []*WorkflowExecution    `json:"runningExecutions,omitempty"`
+	RecentActions     []*ScheduleActionResult `json:"recentActions,omitempty"`
+	NextRunTimestamps []int64                 `json:"nextRunTimestamps,omitempty"`
+	TotalActions      int64                   `json:"totalActions,omitempty"`
+	SkippedActions    int64                   `json:"skippedActions,omitempty"`
 }
 
-// String returns a readable string representation of CrossClusterTaskFailedCause.
-func (e CrossClusterTaskFailedCause) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "DOMAIN_NOT_ACTIVE"
-	case 1:
-		return "DOMAIN_NOT_EXISTS"
-	case 2:
-		return "WORKFLOW_ALREADY_RUNNING"
-	case 3:
-		return "WORKFLOW_NOT_EXISTS"
-	case 4:
-		return "WORKFLOW_ALREADY_COMPLETED"
-	case 5:
-		return "UNCATEGORIZED"
+// GetSpec is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
 	}
-	return fmt.Sprintf("CrossClusterTaskFailedCause(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *CrossClusterTaskFailedCause) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "DOMAIN_NOT_ACTIVE":
-		*e = CrossClusterTaskFailedCauseDomainNotActive
-		return nil
-	case "DOMAIN_NOT_EXISTS":
-		*e = CrossClusterTaskFailedCauseDomainNotExists
-		return nil
-	case "WORKFLOW_ALREADY_RUNNING":
-		*e = CrossClusterTaskFailedCauseWorkflowAlreadyRunning
-		return nil
-	case "WORKFLOW_NOT_EXISTS":
-		*e = CrossClusterTaskFailedCauseWorkflowNotExists
-		return nil
-	case "WORKFLOW_ALREADY_COMPLETED":
-		*e = CrossClusterTaskFailedCauseWorkflowAlreadyCompleted
-		return nil
-	case "UNCATEGORIZED":
-		*e = CrossClusterTaskFailedCauseUncategorized
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "CrossClusterTaskFailedCause", err)
-		}
-		*e = CrossClusterTaskFailedCause(val)
-		return nil
+// GetAction is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetAction() (o *ScheduleAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
 	}
+	return
 }
 
-// MarshalText encodes CrossClusterTaskFailedCause to text.
-func (e CrossClusterTaskFailedCause) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
 }
 
-const (
-	// CrossClusterTaskFailedCauseDomainNotActive is an option for CrossClusterTaskFailedCause
-	CrossClusterTaskFailedCauseDomainNotActive CrossClusterTaskFailedCause = iota
-	// CrossClusterTaskFailedCauseDomainNotExists is an option for CrossClusterTaskFailedCause
-	CrossClusterTaskFailedCauseDomainNotExists
-	// CrossClusterTaskFailedCauseWorkflowAlreadyRunning is an option for CrossClusterTaskFailedCause
-	CrossClusterTaskFailedCauseWorkflowAlreadyRunning
-	// CrossClusterTaskFailedCauseWorkflowNotExists is an option for CrossClusterTaskFailedCause
-	CrossClusterTaskFailedCauseWorkflowNotExists
-	// CrossClusterTaskFailedCauseWorkflowAlreadyCompleted is an option for CrossClusterTaskFailedCause
-	CrossClusterTaskFailedCauseWorkflowAlreadyCompleted
-	// CrossClusterTaskFailedCauseUncategorized is an option for CrossClusterTaskFailedCause
-	CrossClusterTaskFailedCauseUncategorized
-)
-
-// GetTaskFailedCause is an internal type (TBD...)
-type GetTaskFailedCause int32
-
-// Ptr is a helper function for getting pointer value
-func (e GetTaskFailedCause) Ptr() *GetTaskFailedCause {
-	return &e
+// GetPaused is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
 }
 
-// String returns a readable string representation of GetCrossClusterTaskFailedCause.
-func (e GetTaskFailedCause) String() string {
-	w := int32(e)
-	switch w {
-	case 0:
-		return "SERVICE_BUSY"
-	case 1:
-		return "TIMEOUT"
-	case 2:
-		return "SHARD_OWNERSHIP_LOST"
-	case 3:
-		return "UNCATEGORIZED"
+// GetPauseReason is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetPauseReason() (o string) {
+	if v != nil {
+		return v.PauseReason
 	}
-	return fmt.Sprintf("GetCrossClusterTaskFailedCause(%d)", w)
+	return
 }
 
-// UnmarshalText parses enum value from string representation
-func (e *GetTaskFailedCause) UnmarshalText(value []byte) error {
-	switch s := strings.ToUpper(string(value)); s {
-	case "SERVICE_BUSY":
-		*e = GetTaskFailedCauseServiceBusy
-		return nil
-	case "TIMEOUT":
-		*e = GetTaskFailedCauseTimeout
-		return nil
-	case "SHARD_OWNERSHIP_LOST":
-		*e = GetTaskFailedCauseShardOwnershipLost
-		return nil
-	case "UNCATEGORIZED":
-		*e = GetTaskFailedCauseUncategorized
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "GetCrossClusterTaskFailedCause", err)
-		}
-		*e = GetTaskFailedCause(val)
-		return nil
+// GetRunningExecutions is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetRunningExecutions() (o []*WorkflowExecution) {
+	if v != nil && v.RunningExecutions != nil {
+		return v.RunningExecutions
 	}
+	return
 }
 
-// MarshalText encodes GetCrossClusterTaskFailedCause to text.
-func (e GetTaskFailedCause) MarshalText() ([]byte, error) {
-	return []byte(e.String()), nil
+// GetRecentActions is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetRecentActions() (o []*ScheduleActionResult) {
+	if v != nil && v.RecentActions != nil {
+		return v.RecentActions
+	}
+	return
 }
 
-const (
-	// GetTaskFailedCauseServiceBusy is an option for GetCrossClusterTaskFailedCause
-	GetTaskFailedCauseServiceBusy GetTaskFailedCause = iota
-	// GetTaskFailedCauseTimeout is an option for GetCrossClusterTaskFailedCause
-	GetTaskFailedCauseTimeout
-	// GetTaskFailedCauseShardOwnershipLost is an option for GetCrossClusterTaskFailedCause
-	GetTaskFailedCauseShardOwnershipLost
-	// GetTaskFailedCauseUncategorized is an option for GetCrossClusterTaskFailedCause
-	GetTaskFailedCauseUncategorized
-)
-
-// CrossClusterTaskInfo is an internal type (TBD...)
-type CrossClusterTaskInfo struct {
-	DomainID            string                `json:"domainID,omitempty"`
-	WorkflowID          string                `json:"workflowID,omitempty"`
-	RunID               string                `json:"runID,omitempty"`
-	TaskType            *CrossClusterTaskType `json:"taskType,omitempty"`
-	TaskState           int16                 `json:"taskState,omitempty"`
-	TaskID              int64                 `json:"taskID,omitempty"`
-	VisibilityTimestamp *int64                `json:"visibilityTimestamp,omitempty"`
+// GetNextRunTimestamps is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetNextRunTimestamps() (o []int64) {
+	if v != nil && v.NextRunTimestamps != nil {
+		return v.NextRunTimestamps
+	}
+	return
 }
 
-// GetTaskType is an internal getter (TBD...)
-func (v *CrossClusterTaskInfo) GetTaskType() (o CrossClusterTaskType) {
-	if v != nil && v.TaskType != nil {
-		return *v.TaskType
+// GetTotalActions is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetTotalActions() (o int64) {
+	if v != nil {
+		return v.TotalActions
 	}
 	return
 }
 
-// GetTaskID is an internal getter (TBD...)
-func (v *CrossClusterTaskInfo) GetTaskID() (o int64) {
+// GetSkippedActions is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetSkippedActions() (o int64) {
 	if v != nil {
-		return v.TaskID
+		return v.SkippedActions
 	}
 	return
 }
 
-// GetVisibilityTimestamp is an internal getter (TBD...)
-func (v *CrossClusterTaskInfo) GetVisibilityTimestamp() (o int64) {
-	if v != nil && v.VisibilityTimestamp != nil {
-		return *v.VisibilityTimestamp
+// UpdateScheduleRequest is an internal type (TBD...)
+type UpdateScheduleRequest struct {
+	Domain        string                 `json:"domain,omitempty"`
+	ScheduleID    string                 `json:"scheduleID,omitempty"`
+	Spec          *ScheduleSpec          `json:"spec,omitempty"`
+	Action        *ScheduleAction        `json:"action,omitempty"`
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	Identity      string                 `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// CrossClusterStartChildExecutionRequestAttributes is an internal type (TBD...)
-type CrossClusterStartChildExecutionRequestAttributes struct {
-	TargetDomainID           string                                               `json:"targetDomainID,omitempty"`
-	RequestID                string                                               `json:"requestID,omitempty"`
-	InitiatedEventID         int64                                                `json:"initiatedEventID,omitempty"`
-	InitiatedEventAttributes *StartChildWorkflowExecutionInitiatedEventAttributes `json:"initiatedEventAttributes,omitempty"`
-	TargetRunID              *string                                              `json:"targetRunID,omitempty"`
-	PartitionConfig          map[string]string
+// GetScheduleID is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
 }
 
-// GetRequestID is an internal getter (TBD...)
-func (v *CrossClusterStartChildExecutionRequestAttributes) GetRequestID() (o string) {
-	if v != nil {
-		return v.RequestID
+// GetSpec is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
 	}
 	return
 }
 
-// GetInitiatedEventAttributes is an internal getter (TBD...)
-func (v *CrossClusterStartChildExecutionRequestAttributes) GetInitiatedEventAttributes() (o *StartChildWorkflowExecutionInitiatedEventAttributes) {
-	if v != nil && v.InitiatedEventAttributes != nil {
-		return v.InitiatedEventAttributes
+// GetAction is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetAction() (o *ScheduleAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
 	}
 	return
 }
 
-// GetTargetRunID is an internal getter (TBD...)
-func (v *CrossClusterStartChildExecutionRequestAttributes) GetTargetRunID() (o string) {
-	if v != nil && v.TargetRunID != nil {
-		return *v.TargetRunID
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
 	}
 	return
 }
 
-// GetTargetRunID is an internal getter (TBD...)
-func (v *CrossClusterStartChildExecutionRequestAttributes) GetPartitionConfig() (o map[string]string) {
-	if v != nil && v.PartitionConfig != nil {
-		return v.PartitionConfig
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
 	return
 }
 
-// CrossClusterStartChildExecutionResponseAttributes is an internal type (TBD...)
-type CrossClusterStartChildExecutionResponseAttributes struct {
-	RunID string `json:"runID,omitempty"`
+// PauseScheduleRequest is an internal type (TBD...)
+type PauseScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleID,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Identity   string `json:"identity,omitempty"`
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *CrossClusterStartChildExecutionResponseAttributes) GetRunID() (o string) {
+// GetDomain is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetDomain() (o string) {
 	if v != nil {
-		return v.RunID
+		return v.Domain
 	}
 	return
 }
 
-// CrossClusterCancelExecutionRequestAttributes is an internal type (TBD...)
-type CrossClusterCancelExecutionRequestAttributes struct {
-	TargetDomainID    string `json:"targetDomainID,omitempty"`
-	TargetWorkflowID  string `json:"targetWorkflowID,omitempty"`
-	TargetRunID       string `json:"targetRunID,omitempty"`
-	RequestID         string `json:"requestID,omitempty"`
-	InitiatedEventID  int64  `json:"initiatedEventID,omitempty"`
-	ChildWorkflowOnly bool   `json:"childWorkflowOnly,omitempty"`
+// GetScheduleID is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
 }
 
-// CrossClusterCancelExecutionResponseAttributes is an internal type (TBD...)
-type CrossClusterCancelExecutionResponseAttributes struct {
+// GetReason is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
 }
 
-// CrossClusterSignalExecutionRequestAttributes is an internal type (TBD...)
-type CrossClusterSignalExecutionRequestAttributes struct {
-	TargetDomainID    string `json:"targetDomainID,omitempty"`
-	TargetWorkflowID  string `json:"targetWorkflowID,omitempty"`
-	TargetRunID       string `json:"targetRunID,omitempty"`
-	RequestID         string `json:"requestID,omitempty"`
-	InitiatedEventID  int64  `json:"initiatedEventID,omitempty"`
-	ChildWorkflowOnly bool   `json:"childWorkflowOnly,omitempty"`
-	SignalName        string `json:"signalName,omitempty"`
-	SignalInput       []byte `json:"signalInput,omitempty"`
-	Control           []byte `json:"control,omitempty"`
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
 }
 
-// CrossClusterSignalExecutionResponseAttributes is an internal type (TBD...)
-type CrossClusterSignalExecutionResponseAttributes struct {
+// ResumeScheduleRequest is an internal type (TBD...)
+type ResumeScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleID,omitempty"`
+	Identity   string `json:"identity,omitempty"`
 }
 
-type CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes struct {
-	TargetDomainID   string        `json:"targetDomainID,omitempty"`
-	TargetWorkflowID string        `json:"targetWorkflowID,omitempty"`
-	TargetRunID      string        `json:"targetRunID,omitempty"`
-	InitiatedEventID int64         `json:"initiatedEventID,omitempty"`
-	CompletionEvent  *HistoryEvent `json:"completionEvent,omitempty"`
+// GetDomain is an internal getter (TBD...)
+func (v *ResumeScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
 }
 
-// CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes is an internal type (TBD...)
-type CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes struct {
+// GetScheduleID is an internal getter (TBD...)
+func (v *ResumeScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
 }
 
-type ApplyParentClosePolicyStatus struct {
-	Completed   bool                         `json:"completed,omitempty"`
-	FailedCause *CrossClusterTaskFailedCause `json:"failedCause,omitempty"`
+// GetIdentity is an internal getter (TBD...)
+func (v *ResumeScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
 }
 
-type ApplyParentClosePolicyAttributes struct {
-	ChildDomainID     string             `json:"ChildDomainID,omitempty"`
-	ChildWorkflowID   string             `json:"ChildWorkflowID,omitempty"`
-	ChildRunID        string             `json:"ChildRunID,omitempty"`
-	ParentClosePolicy *ParentClosePolicy `json:"parentClosePolicy,omitempty"`
+// BackfillScheduleRequest is an internal type (TBD...)
+type BackfillScheduleRequest struct {
+	Domain         string                 `json:"domain,omitempty"`
+	ScheduleID     string                 `json:"scheduleID,omitempty"`
+	StartTimestamp *int64                 `json:"startTimestamp,omitempty"`
+	EndTimestamp   *int64                 `json:"endTimestamp,omitempty"`
+	OverlapPolicy  *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	Identity       string                 `json:"identity,omitempty"`
 }
 
-// GetParentClosePolicy is an internal getter (TBD...)
-func (v *ApplyParentClosePolicyAttributes) GetParentClosePolicy() (o *ParentClosePolicy) {
+// GetDomain is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetDomain() (o string) {
 	if v != nil {
-		return v.ParentClosePolicy
+		return v.Domain
 	}
 	return
 }
 
-type ApplyParentClosePolicyRequest struct {
-	Child  *ApplyParentClosePolicyAttributes `json:"child,omitempty"`
-	Status *ApplyParentClosePolicyStatus     `json:"status,omitempty"`
+// GetScheduleID is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
 }
 
-type CrossClusterApplyParentClosePolicyRequestAttributes struct {
-	Children []*ApplyParentClosePolicyRequest `json:"children,omitempty"`
+// GetStartTimestamp is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetStartTimestamp() (o int64) {
+	if v != nil && v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+	return
 }
 
-type ApplyParentClosePolicyResult struct {
-	Child       *ApplyParentClosePolicyAttributes `json:"child,omitempty"`
-	FailedCause *CrossClusterTaskFailedCause      `json:"failedCause,omitempty"`
+// GetEndTimestamp is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
+	}
+	return
 }
 
-// CrossClusterApplyParentClosePolicyResponseAttributes is an internal type (TBD...)
-type CrossClusterApplyParentClosePolicyResponseAttributes struct {
-	ChildrenStatus []*ApplyParentClosePolicyResult `json:"childrenStatus,omitempty"`
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
 }
 
-// CrossClusterTaskRequest is an internal type (TBD...)
-type CrossClusterTaskRequest struct {
-	TaskInfo                                       *CrossClusterTaskInfo                                              `json:"taskInfo,omitempty"`
-	StartChildExecutionAttributes                  *CrossClusterStartChildExecutionRequestAttributes                  `json:"startChildExecutionAttributes,omitempty"`
-	CancelExecutionAttributes                      *CrossClusterCancelExecutionRequestAttributes                      `json:"cancelExecutionAttributes,omitempty"`
-	SignalExecutionAttributes                      *CrossClusterSignalExecutionRequestAttributes                      `json:"signalExecutionAttributes,omitempty"`
-	RecordChildWorkflowExecutionCompleteAttributes *CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes `json:"RecordChildWorkflowExecutionCompleteAttributes,omitempty"`
-	ApplyParentClosePolicyAttributes               *CrossClusterApplyParentClosePolicyRequestAttributes               `json:"ApplyParentClosePolicyAttributes,omitempty"`
+// GetIdentity is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
 }
 
-// CrossClusterTaskResponse is an internal type (TBD...)
-type CrossClusterTaskResponse struct {
-	TaskID                                         int64                                                               `json:"taskID,omitempty"`
-	TaskType                                       *CrossClusterTaskType                                               `json:"taskType,omitempty"`
-	TaskState                                      int16                                                               `json:"taskState,omitempty"`
-	FailedCause                                    *CrossClusterTaskFailedCause                                        `json:"failedCause,omitempty"`
-	StartChildExecutionAttributes                  *CrossClusterStartChildExecutionResponseAttributes                  `json:"startChildExecutionAttributes,omitempty"`
-	CancelExecutionAttributes                      *CrossClusterCancelExecutionResponseAttributes                      `json:"cancelExecutionAttributes,omitempty"`
-	SignalExecutionAttributes                      *CrossClusterSignalExecutionResponseAttributes                      `json:"signalExecutionAttributes,omitempty"`
-	RecordChildWorkflowExecutionCompleteAttributes *CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes `json:"RecordChildWorkflowExecutionCompleteAttributes,omitempty"`
-	ApplyParentClosePolicyAttributes               *CrossClusterApplyParentClosePolicyResponseAttributes               `json:"ApplyParentClosePolicyAttributes,omitempty"`
+// DeleteScheduleRequest is an internal type (TBD...)
+type DeleteScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleID,omitempty"`
+	Identity   string `json:"identity,omitempty"`
 }
 
-// GetTaskID is an internal getter (TBD...)
-func (v *CrossClusterTaskResponse) GetTaskID() (o int64) {
+// GetDomain is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetDomain() (o string) {
 	if v != nil {
-		return v.TaskID
+		return v.Domain
 	}
 	return
 }
 
-// GetTaskType is an internal getter (TBD...)
-func (v *CrossClusterTaskResponse) GetTaskType() (o CrossClusterTaskType) {
-	if v != nil && v.TaskType != nil {
-		return *v.TaskType
+// GetScheduleID is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
 	}
 	return
 }
 
-// GetFailedCause is an internal getter (TBD...)
-func (v *CrossClusterTaskResponse) GetFailedCause() (o CrossClusterTaskFailedCause) {
-	if v != nil && v.FailedCause != nil {
-		return *v.FailedCause
+// GetIdentity is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
 	return
 }
 
-// GetCrossClusterTasksRequest is an internal type (TBD...)
-type GetCrossClusterTasksRequest struct {
-	ShardIDs      []int32 `json:"shardIDs,omitempty"`
-	TargetCluster string  `json:"targetCluster,omitempty"`
+// ListSchedulesRequest is an internal type (TBD...)
+type ListSchedulesRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
 }
 
-// GetShardIDs is an internal getter (TBD...)
-func (v *GetCrossClusterTasksRequest) GetShardIDs() (o []int32) {
-	if v != nil && v.ShardIDs != nil {
-		return v.ShardIDs
+// GetDomain is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetTargetCluster is an internal getter (TBD...)
-func (v *GetCrossClusterTasksRequest) GetTargetCluster() (o string) {
+// GetPageSize is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetPageSize() (o int32) {
 	if v != nil {
-		return v.TargetCluster
+		return v.PageSize
 	}
 	return
 }
 
-// GetCrossClusterTasksResponse is an internal type (TBD...)
-type GetCrossClusterTasksResponse struct {
-	TasksByShard       map[int32][]*CrossClusterTaskRequest `json:"tasksByShard,omitempty"`
-	FailedCauseByShard map[int32]GetTaskFailedCause         `json:"failedCauseByShard,omitempty"`
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
 }
 
-// GetTasksByShard is an internal getter (TBD...)
-func (v *GetCrossClusterTasksResponse) GetTasksByShard() (o map[int32][]*CrossClusterTaskRequest) {
-	if v != nil && v.TasksByShard != nil {
-		return v.TasksByShard
+// ListSchedulesResponse is an internal type (TBD...)
+type ListSchedulesResponse struct {
+	ScheduleIDs   []string `json:"scheduleIDs,omitempty"`
+	NextPageToken []byte   `json:"nextPageToken,omitempty"`
+}
+
+// GetScheduleIDs is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetScheduleIDs() (o []string) {
+	if v != nil && v.ScheduleIDs != nil {
+		return v.ScheduleIDs
 	}
 	return
 }
 
-// RespondCrossClusterTasksCompletedRequest is an internal type (TBD...)
-type RespondCrossClusterTasksCompletedRequest struct {
-	ShardID       int32                       `json:"shardID,omitempty"`
-	TargetCluster string                      `json:"targetCluster,omitempty"`
-	TaskResponses []*CrossClusterTaskResponse `json:"taskResponses,omitempty"`
-	FetchNewTasks bool                        `json:"fetchNewTasks,omitempty"`
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
 }
 
-// GetShardID is an internal getter (TBD...)
-func (v *RespondCrossClusterTasksCompletedRequest) GetShardID() (o int32) {
+// WorkflowUpdate is an internal type (TBD...)
+type WorkflowUpdate struct {
+	UpdateID   string `json:"updateId,omitempty"`
+	UpdateName string `json:"updateName,omitempty"`
+	Input      []byte `json:"-"` // Filtering PII
+}
+
+// GetUpdateID is an internal getter (TBD...)
+func (v *WorkflowUpdate) GetUpdateID() (o string) {
 	if v != nil {
-		return v.ShardID
+		return v.UpdateID
 	}
 	return
 }
 
-// GetFetchNewTasks is an internal getter (TBD...)
-func (v *RespondCrossClusterTasksCompletedRequest) GetFetchNewTasks() (o bool) {
+// GetUpdateName is an internal getter (TBD...)
+func (v *WorkflowUpdate) GetUpdateName() (o string) {
 	if v != nil {
-		return v.FetchNewTasks
+		return v.UpdateName
 	}
 	return
 }
 
-// RespondCrossClusterTasksCompletedResponse is an internal type (TBD...)
-type RespondCrossClusterTasksCompletedResponse struct {
-	Tasks []*CrossClusterTaskRequest `json:"tasks,omitempty"`
+// GetInput is an internal getter (TBD...)
+func (v *WorkflowUpdate) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
 }
 
-// StickyWorkerUnavailableError is an internal type (TBD...)
-type StickyWorkerUnavailableError struct {
-	Message string `json:"message,required"`
-}
+// WorkflowUpdateStage is an internal type (TBD...)
+type WorkflowUpdateStage int32
 
-// Any is an internal mirror of google.protobuf.Any, serving the same purposes, but
-// intentionally breaking direct compatibility because it may hold data that is not
-// actually protobuf encoded.
-//
-// All uses of Any must either:
-//   - check that ValueType is a recognized type, and deserialize based on its contents
-//   - or just pass it along as an opaque type for something else to use
-//
-// Contents are intentionally undefined to allow external definitions, e.g. from
-// third-party plugins that are not part of this source repository.
-type Any struct {
-	// ValueType describes the type of encoded data in Value.
-	//
-	// No structure or allowed values are defined, but you are strongly encouraged
-	// to use hard-coded strings (or unambiguous prefixes) or URLs when possible.
-	//
-	// For more concise encoding of exclusively known types, use e.g. DataBlob instead.
-	ValueType string `json:"value_type"`
-	// Value holds arbitrary bytes, and is described by ValueType.
-	//
-	// To interpret, you MUST check ValueType.
-	Value []byte `json:"value"`
+// Ptr is a helper function for getting pointer value
+func (e WorkflowUpdateStage) Ptr() *WorkflowUpdateStage {
+	return &e
 }
 
-// AutoConfigHint is an internal type (TBD...)
-type AutoConfigHint struct {
-	EnableAutoConfig   bool  `json:"enableAutoConfig"`
-	PollerWaitTimeInMs int64 `json:"pollerWaitTimeInMs"`
+// String returns a readable string representation of WorkflowUpdateStage.
+func (e WorkflowUpdateStage) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "ACCEPTED"
+	case 1:
+		return "REJECTED"
+	case 2:
+		return "COMPLETED"
+	}
+	return fmt.Sprintf("WorkflowUpdateStage(%d)", w)
 }
 
-type CronOverlapPolicy int32
-
-const (
-	CronOverlapPolicySkipped CronOverlapPolicy = iota
-	CronOverlapPolicyBufferOne
-)
-
-func (v CronOverlapPolicy) String() string {
-	switch v {
-	case CronOverlapPolicySkipped:
-		return "SKIP"
-	case CronOverlapPolicyBufferOne:
-		return "BUFFERONE"
+// UnmarshalText parses enum value from string representation
+func (e *WorkflowUpdateStage) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "ACCEPTED":
+		*e = WorkflowUpdateStageAccepted
+		return nil
+	case "REJECTED":
+		*e = WorkflowUpdateStageRejected
+		return nil
+	case "COMPLETED":
+		*e = WorkflowUpdateStageCompleted
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowUpdateStage", err)
+		}
+		*e = WorkflowUpdateStage(val)
+		return nil
 	}
-	return "UNKNOWN"
 }
 
-// Ptr is a helper function for getting pointer to CronOverlapPolicy
-func (v CronOverlapPolicy) Ptr() *CronOverlapPolicy {
-	return &v
+// MarshalText encodes WorkflowUpdateStage to text.
+func (e WorkflowUpdateStage) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
 }
+
+const (
+	// WorkflowUpdateStageAccepted is an option for WorkflowUpdateStage
+	WorkflowUpdateStageAccepted WorkflowUpdateStage = iota
+	// WorkflowUpdateStageRejected is an option for WorkflowUpdateStage
+	WorkflowUpdateStageRejected
+	// WorkflowUpdateStageCompleted is an option for WorkflowUpdateStage
+	WorkflowUpdateStageCompleted
+)
@@ -43,6 +43,8 @@ const (
 	TaskListName         = "TaskListName"
 	MarkerName           = "MarkerName"
 	SignalName           = "SignalName"
+	UpdateID             = "UpdateID"
+	UpdateName           = "UpdateName"
 	QueryType            = "QueryType"
 	HostName             = "HostName"
 	HostName2            = "HostName2"
@@ -60,6 +62,7 @@ const (
 	FeatureFlag          = "FeatureFlag"
 	Namespace            = "Namespace"
 	ShardKey             = "ShardKey"
+	ScheduleName         = "ScheduleName"
 
 	Attempt            = 2
 	ScheduleID         = 5
@@ -249,6 +252,10 @@ var (
 		},
 		NewTasksPerSecond: 10,
 		Empty:             true,
+		BacklogCountByPriority: map[string]int64{
+			"high":   1,
+			"normal": 2,
+		},
 	}
 	TaskIDBlock = types.TaskIDBlock{
 		StartID: 551,
@@ -425,6 +432,13 @@ var (
 	WorkflowQueryResultMap = map[string]*types.WorkflowQueryResult{
 		"WorkflowQuery1": &WorkflowQueryResult,
 	}
+	WorkflowUpdate = types.WorkflowUpdate{
+		UpdateID:   UpdateID,
+		UpdateName: UpdateName,
+		Input:      Payload1,
+	}
+	WorkflowUpdateArray = []*types.WorkflowUpdate{&WorkflowUpdate}
+
 	QueryRejected = types.QueryRejected{
 		CloseStatus: &WorkflowExecutionCloseStatus,
 	}
@@ -433,6 +447,31 @@ var (
 		ExecutionStartToCloseTimeoutSeconds: &Duration1,
 		TaskStartToCloseTimeoutSeconds:      &Duration2,
 	}
+	ScheduleSpec = types.ScheduleSpec{
+		CronExpression:  CronSchedule,
+		JitterInSeconds: Duration1,
+		StartTimestamp:  &Timestamp1,
+		EndTimestamp:    &Timestamp2,
+	}
+	ScheduleAction = types.ScheduleAction{
+		WorkflowType:                        &WorkflowType,
+		TaskList:                            &TaskList,
+		Input:                               Payload1,
+		ExecutionStartToCloseTimeoutSeconds: &Duration1,
+		TaskStartToCloseTimeoutSeconds:      &Duration2,
+	}
+	ScheduleActionResult = types.ScheduleActionResult{
+		NominalTimestamp:  &Timestamp1,
+		ActualTimestamp:   &Timestamp2,
+		WorkflowExecution: &WorkflowExecution,
+		Skipped:           true,
+		FailureReason:     Reason,
+	}
+	PauseInfo = types.PauseInfo{
+		Reason:          Reason,
+		Identity:        Identity,
+		PausedTimestamp: &Timestamp1,
+	}
 	PendingActivityInfo = types.PendingActivityInfo{
 		ActivityID:             ActivityID,
 		ActivityType:           &ActivityType,
@@ -449,6 +488,7 @@ var (
 		LastFailureDetails:     FailureDetails,
 		StartedWorkerIdentity:  Identity,
 		ScheduleID:             ScheduleID,
+		PauseInfo:              &PauseInfo,
 	}
 	PendingActivityInfoArray = []*types.PendingActivityInfo{
 		&PendingActivityInfo,
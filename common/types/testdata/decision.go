@@ -40,6 +40,9 @@ var (
 		&Decision_StartChildWorkflowExecution,
 		&Decision_StartTimer,
 		&Decision_UpsertWorkflowSearchAttributes,
+		&Decision_AcceptWorkflowUpdate,
+		&Decision_RejectWorkflowUpdate,
+		&Decision_CompleteWorkflowUpdate,
 	}
 
 	Decision_CancelTimer = types.Decision{
@@ -94,6 +97,18 @@ var (
 		DecisionType: types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr(),
 		UpsertWorkflowSearchAttributesDecisionAttributes: &UpsertWorkflowSearchAttributesDecisionAttributes,
 	}
+	Decision_AcceptWorkflowUpdate = types.Decision{
+		DecisionType:                           types.DecisionTypeAcceptWorkflowUpdate.Ptr(),
+		AcceptWorkflowUpdateDecisionAttributes: &AcceptWorkflowUpdateDecisionAttributes,
+	}
+	Decision_RejectWorkflowUpdate = types.Decision{
+		DecisionType:                           types.DecisionTypeRejectWorkflowUpdate.Ptr(),
+		RejectWorkflowUpdateDecisionAttributes: &RejectWorkflowUpdateDecisionAttributes,
+	}
+	Decision_CompleteWorkflowUpdate = types.Decision{
+		DecisionType:                             types.DecisionTypeCompleteWorkflowUpdate.Ptr(),
+		CompleteWorkflowUpdateDecisionAttributes: &CompleteWorkflowUpdateDecisionAttributes,
+	}
 
 	CancelTimerDecisionAttributes = types.CancelTimerDecisionAttributes{
 		TimerID: TimerID,
@@ -155,6 +170,7 @@ var (
 		RetryPolicy:                   &RetryPolicy,
 		Header:                        &Header,
 		RequestLocalDispatch:          true,
+		TaskPriority:                  types.TaskPriorityHigh.Ptr(),
 	}
 	SignalExternalWorkflowExecutionDecisionAttributes = types.SignalExternalWorkflowExecutionDecisionAttributes{
 		Domain:            DomainName,
@@ -189,4 +205,21 @@ var (
 	UpsertWorkflowSearchAttributesDecisionAttributes = types.UpsertWorkflowSearchAttributesDecisionAttributes{
 		SearchAttributes: &SearchAttributes,
 	}
+	AcceptWorkflowUpdateDecisionAttributes = types.AcceptWorkflowUpdateDecisionAttributes{
+		UpdateID:   UpdateID,
+		UpdateName: UpdateName,
+		Input:      Payload1,
+	}
+	RejectWorkflowUpdateDecisionAttributes = types.RejectWorkflowUpdateDecisionAttributes{
+		UpdateID:   UpdateID,
+		UpdateName: UpdateName,
+		Reason:     Reason,
+		Details:    FailureDetails,
+	}
+	CompleteWorkflowUpdateDecisionAttributes = types.CompleteWorkflowUpdateDecisionAttributes{
+		UpdateID:       UpdateID,
+		Result:         Payload1,
+		FailureReason:  &FailureReason,
+		FailureDetails: FailureDetails,
+	}
 )
@@ -76,6 +76,14 @@ var (
 		&HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&HistoryEvent_UpsertWorkflowSearchAttributes,
+		&HistoryEvent_WorkflowExecutionSearchAttributesUpdated,
+		&HistoryEvent_WorkflowExecutionPaused,
+		&HistoryEvent_WorkflowExecutionUnpaused,
+		&HistoryEvent_ActivityTaskPaused,
+		&HistoryEvent_ActivityTaskUnpaused,
+		&HistoryEvent_WorkflowExecutionUpdateAccepted,
+		&HistoryEvent_WorkflowExecutionUpdateRejected,
+		&HistoryEvent_WorkflowExecutionUpdateCompleted,
 	}
 
 	HistoryEvent_WorkflowExecutionStarted = generateEvent(func(e *types.HistoryEvent) {
@@ -246,6 +254,38 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	HistoryEvent_WorkflowExecutionSearchAttributesUpdated = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionSearchAttributesUpdated.Ptr()
+		e.WorkflowExecutionSearchAttributesUpdatedEventAttributes = &WorkflowExecutionSearchAttributesUpdatedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionPaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		e.WorkflowExecutionPausedEventAttributes = &WorkflowExecutionPausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUnpaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		e.WorkflowExecutionUnpausedEventAttributes = &WorkflowExecutionUnpausedEventAttributes
+	})
+	HistoryEvent_ActivityTaskPaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeActivityTaskPaused.Ptr()
+		e.ActivityTaskPausedEventAttributes = &ActivityTaskPausedEventAttributes
+	})
+	HistoryEvent_ActivityTaskUnpaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeActivityTaskUnpaused.Ptr()
+		e.ActivityTaskUnpausedEventAttributes = &ActivityTaskUnpausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUpdateAccepted = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateAccepted.Ptr()
+		e.WorkflowExecutionUpdateAcceptedEventAttributes = &WorkflowExecutionUpdateAcceptedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUpdateRejected = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateRejected.Ptr()
+		e.WorkflowExecutionUpdateRejectedEventAttributes = &WorkflowExecutionUpdateRejectedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUpdateCompleted = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUpdateCompleted.Ptr()
+		e.WorkflowExecutionUpdateCompletedEventAttributes = &WorkflowExecutionUpdateCompletedEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -278,6 +318,8 @@ var (
 		RequestID:                           RequestID,
 		ActiveClusterSelectionPolicy:        &ActiveClusterSelectionPolicyExternalEntity,
 		CronOverlapPolicy:                   &CronOverlapPolicy,
+		PauseInfo:                           &PauseInfo,
+		TaskPriority:                        types.TaskPriorityLow.Ptr(),
 	}
 	WorkflowExecutionCompletedEventAttributes = types.WorkflowExecutionCompletedEventAttributes{
 		Result:                       Payload1,
@@ -345,6 +387,7 @@ var (
 		DecisionTaskCompletedEventID:  EventID1,
 		RetryPolicy:                   &RetryPolicy,
 		Header:                        &Header,
+		TaskPriority:                  types.TaskPriorityHigh.Ptr(),
 	}
 	ActivityTaskStartedEventAttributes = types.ActivityTaskStartedEventAttributes{
 		ScheduledEventID:   EventID1,
@@ -584,6 +627,49 @@ var (
 		DecisionTaskCompletedEventID: EventID1,
 		SearchAttributes:             &SearchAttributes,
 	}
+	WorkflowExecutionSearchAttributesUpdatedEventAttributes = types.WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+		SearchAttributes: &SearchAttributes,
+		Reason:           Reason,
+		Identity:         Identity,
+	}
+	WorkflowExecutionPausedEventAttributes = types.WorkflowExecutionPausedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
+	WorkflowExecutionUnpausedEventAttributes = types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
+	ActivityTaskPausedEventAttributes = types.ActivityTaskPausedEventAttributes{
+		ScheduledEventID: EventID1,
+		Reason:           Reason,
+		Identity:         Identity,
+	}
+	ActivityTaskUnpausedEventAttributes = types.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventID: EventID1,
+		Reason:           Reason,
+		Identity:         Identity,
+	}
+	WorkflowExecutionUpdateAcceptedEventAttributes = types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:                     UpdateID,
+		UpdateName:                   UpdateName,
+		Input:                        Payload1,
+		DecisionTaskCompletedEventID: EventID1,
+	}
+	WorkflowExecutionUpdateRejectedEventAttributes = types.WorkflowExecutionUpdateRejectedEventAttributes{
+		UpdateID:                     UpdateID,
+		UpdateName:                   UpdateName,
+		Reason:                       Reason,
+		Details:                      FailureDetails,
+		DecisionTaskCompletedEventID: EventID1,
+	}
+	WorkflowExecutionUpdateCompletedEventAttributes = types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     UpdateID,
+		Result:                       Payload1,
+		FailureReason:                &FailureReason,
+		FailureDetails:               FailureDetails,
+		DecisionTaskCompletedEventID: EventID1,
+	}
 	GetFailoverInfoRequest = types.GetFailoverInfoRequest{
 		DomainID: uuid.NewUUID().String(),
 	}
@@ -209,6 +209,7 @@ var (
 		Queries:                   WorkflowQueryMap,
 		NextEventID:               EventID3,
 		AutoConfigHint:            &AutoConfigHint,
+		Updates:                   WorkflowUpdateArray,
 	}
 	RespondDecisionTaskCompletedRequest = types.RespondDecisionTaskCompletedRequest{
 		TaskToken:                  TaskToken,
@@ -328,6 +329,108 @@ var (
 		RequestID:           RequestID,
 		FirstExecutionRunID: RunID,
 	}
+	CreateScheduleRequest = types.CreateScheduleRequest{
+		Domain:        DomainName,
+		ScheduleID:    ScheduleName,
+		Spec:          &ScheduleSpec,
+		Action:        &ScheduleAction,
+		OverlapPolicy: types.ScheduleOverlapPolicyBuffer.Ptr(),
+		Paused:        true,
+		Identity:      Identity,
+	}
+	DescribeScheduleRequest = types.DescribeScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleName,
+	}
+	DescribeScheduleResponse = types.DescribeScheduleResponse{
+		Spec:              &ScheduleSpec,
+		Action:            &ScheduleAction,
+		OverlapPolicy:     types.ScheduleOverlapPolicyCancelPrevious.Ptr(),
+		Paused:            true,
+		PauseReason:       Reason,
+		RunningExecutions: []*types.WorkflowExecution{&WorkflowExecution},
+		RecentActions:     []*types.ScheduleActionResult{&ScheduleActionResult},
+		NextRunTimestamps: []int64{Timestamp3, Timestamp4},
+		TotalActions:      HistoryLength,
+		SkippedActions:    Attempt,
+	}
+	UpdateScheduleRequest = types.UpdateScheduleRequest{
+		Domain:        DomainName,
+		ScheduleID:    ScheduleName,
+		Spec:          &ScheduleSpec,
+		Action:        &ScheduleAction,
+		OverlapPolicy: types.ScheduleOverlapPolicyAllowAll.Ptr(),
+		Identity:      Identity,
+	}
+	PauseScheduleRequest = types.PauseScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleName,
+		Reason:     Reason,
+		Identity:   Identity,
+	}
+	ResumeScheduleRequest = types.ResumeScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleName,
+		Identity:   Identity,
+	}
+	BackfillScheduleRequest = types.BackfillScheduleRequest{
+		Domain:         DomainName,
+		ScheduleID:     ScheduleName,
+		StartTimestamp: &Timestamp1,
+		EndTimestamp:   &Timestamp2,
+		OverlapPolicy:  types.ScheduleOverlapPolicySkip.Ptr(),
+		Identity:       Identity,
+	}
+	DeleteScheduleRequest = types.DeleteScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleName,
+		Identity:   Identity,
+	}
+	ListSchedulesRequest = types.ListSchedulesRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	ListSchedulesResponse = types.ListSchedulesResponse{
+		ScheduleIDs:   []string{ScheduleName},
+		NextPageToken: NextPageToken,
+	}
+	PauseWorkflowExecutionRequest = types.PauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Reason:            Reason,
+		Identity:          Identity,
+	}
+	UnpauseWorkflowExecutionRequest = types.UnpauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Reason:            Reason,
+		Identity:          Identity,
+	}
+	ResetActivityRequest = types.ResetActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	UpdateWorkflowExecutionRequest = types.UpdateWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		UpdateID:          UpdateID,
+		UpdateName:        UpdateName,
+		Input:             Payload1,
+		Identity:          Identity,
+		WaitForStage:      types.WorkflowUpdateStageCompleted.Ptr(),
+	}
+	UpdateWorkflowExecutionResponse = types.UpdateWorkflowExecutionResponse{
+		UpdateID:       UpdateID,
+		Stage:          types.WorkflowUpdateStageCompleted.Ptr(),
+		Result:         Payload1,
+		FailureReason:  &FailureReason,
+		FailureDetails: FailureDetails,
+	}
 	StartWorkflowExecutionRequest = types.StartWorkflowExecutionRequest{
 		Domain:                              DomainName,
 		WorkflowID:                          WorkflowID,
@@ -347,6 +450,7 @@ var (
 		Header:                              &Header,
 		FirstRunAtTimeStamp:                 &Timestamp1,
 		ActiveClusterSelectionPolicy:        &ActiveClusterSelectionPolicyExternalEntity,
+		TaskPriority:                        types.TaskPriorityLow.Ptr(),
 	}
 	StartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -386,6 +490,7 @@ var (
 		Header:                              &Header,
 		FirstRunAtTimestamp:                 &Timestamp1,
 		ActiveClusterSelectionPolicy:        &ActiveClusterSelectionPolicyRegionSticky,
+		TaskPriority:                        types.TaskPriorityHighest.Ptr(),
 	}
 	SignalWithStartWorkflowExecutionAsyncRequest = types.SignalWithStartWorkflowExecutionAsyncRequest{
 		SignalWithStartWorkflowExecutionRequest: &SignalWithStartWorkflowExecutionRequest,
@@ -421,6 +526,7 @@ var (
 		PendingActivities:      PendingActivityInfoArray,
 		PendingChildren:        PendingChildExecutionInfoArray,
 		PendingDecision:        &PendingDecisionInfo,
+		PauseInfo:              &PauseInfo,
 	}
 	DiagnoseWorkflowExecutionRequest = types.DiagnoseWorkflowExecutionRequest{
 		Domain:            DomainName,
@@ -174,6 +174,7 @@ var (
 		StartedTimestamp:          &Timestamp2,
 		Queries:                   WorkflowQueryMap,
 		HistorySize:               HistorySizeInBytes,
+		Updates:                   WorkflowUpdateArray,
 	}
 	HistoryRefreshWorkflowTasksRequest = types.HistoryRefreshWorkflowTasksRequest{
 		DomainUIID: DomainID,
@@ -191,6 +192,7 @@ var (
 		VersionHistoryItems: VersionHistoryItemArray,
 		Events:              &DataBlob,
 		NewRunEvents:        &DataBlob,
+		Restored:            true,
 	}
 	HistoryRequestCancelWorkflowExecutionRequest = types.HistoryRequestCancelWorkflowExecutionRequest{
 		DomainUUID:                DomainID,
@@ -199,6 +201,22 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryPauseWorkflowExecutionRequest = types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   DomainID,
+		PauseRequest: &PauseWorkflowExecutionRequest,
+	}
+	HistoryUnpauseWorkflowExecutionRequest = types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     DomainID,
+		UnpauseRequest: &UnpauseWorkflowExecutionRequest,
+	}
+	HistoryResetActivityRequest = types.HistoryResetActivityRequest{
+		DomainUUID:   DomainID,
+		ResetRequest: &ResetActivityRequest,
+	}
+	HistoryUpdateWorkflowExecutionRequest = types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		UpdateRequest: &UpdateWorkflowExecutionRequest,
+	}
 	HistoryResetQueueRequest          = AdminResetQueueRequest
 	HistoryResetStickyTaskListRequest = types.HistoryResetStickyTaskListRequest{
 		DomainUUID: DomainID,
@@ -298,6 +316,13 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryUpdateWorkflowSearchAttributesRequest = types.UpdateWorkflowSearchAttributesRequest{
+		DomainUUID:        DomainID,
+		WorkflowExecution: &WorkflowExecution,
+		SearchAttributes:  &SearchAttributes,
+		Reason:            Reason,
+		Identity:          Identity,
+	}
 	HistoryGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	HistoryGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	HistoryRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
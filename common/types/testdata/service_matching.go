@@ -61,6 +61,7 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		TaskPriority:                  types.TaskPriorityHigh.Ptr(),
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -71,6 +72,7 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		TaskPriority:                  types.TaskPriorityHigh.Ptr(),
 	}
 	MatchingAddActivityTaskResponse = types.AddActivityTaskResponse{
 		PartitionConfig: &TaskListPartitionConfig,
@@ -155,6 +157,7 @@ var (
 		StartedTimestamp:          &Timestamp2,
 		Queries:                   WorkflowQueryMap,
 		PartitionConfig:           &TaskListPartitionConfig,
+		Updates:                   WorkflowUpdateArray,
 		LoadBalancerHints:         &LoadBalancerHints,
 		AutoConfigHint:            &AutoConfigHint,
 	}
@@ -463,6 +463,7 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *types.RecordDeci
 		ScheduledTimestamp:        historyResponse.ScheduledTimestamp,
 		StartedTimestamp:          historyResponse.StartedTimestamp,
 		Queries:                   historyResponse.Queries,
+		Updates:                   historyResponse.Updates,
 		TotalHistoryBytes:         historyResponse.HistorySize,
 	}
 	if historyResponse.GetPreviousStartedEventID() != constants.EmptyEventID {
@@ -794,6 +795,21 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		if event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes != nil {
 			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
 		}
+	case types.EventTypeWorkflowExecutionSearchAttributesUpdated:
+		if event.WorkflowExecutionSearchAttributesUpdatedEventAttributes.SearchAttributes != nil {
+			res += GetSizeOfMapStringToByteArray(event.WorkflowExecutionSearchAttributesUpdatedEventAttributes.SearchAttributes.IndexedFields)
+		}
+	case types.EventTypeWorkflowExecutionPaused:
+	case types.EventTypeWorkflowExecutionUnpaused:
+	case types.EventTypeActivityTaskPaused:
+	case types.EventTypeActivityTaskUnpaused:
+	case types.EventTypeWorkflowExecutionUpdateAccepted:
+		res += len(event.WorkflowExecutionUpdateAcceptedEventAttributes.Input)
+	case types.EventTypeWorkflowExecutionUpdateRejected:
+		res += len(event.WorkflowExecutionUpdateRejectedEventAttributes.Details)
+	case types.EventTypeWorkflowExecutionUpdateCompleted:
+		res += len(event.WorkflowExecutionUpdateCompletedEventAttributes.Result)
+		res += len(event.WorkflowExecutionUpdateCompletedEventAttributes.FailureDetails)
 	}
 	return uint64(res)
 }
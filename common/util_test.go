@@ -1468,6 +1468,45 @@ func TestGetSizeOfHistoryEvent(t *testing.T) {
 			},
 			want: someMapStringToByteArraySize,
 		},
+		types.EventTypeWorkflowExecutionSearchAttributesUpdated: {
+			event: &types.HistoryEvent{
+				WorkflowExecutionSearchAttributesUpdatedEventAttributes: &types.WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+					SearchAttributes: &types.SearchAttributes{
+						IndexedFields: someMapStringToByteArray, // 66 bytes
+					},
+				},
+			},
+			want: someMapStringToByteArraySize,
+		},
+		types.EventTypeWorkflowExecutionPaused:   {event: &types.HistoryEvent{}, want: 0},
+		types.EventTypeWorkflowExecutionUnpaused: {event: &types.HistoryEvent{}, want: 0},
+		types.EventTypeActivityTaskPaused:        {event: &types.HistoryEvent{}, want: 0},
+		types.EventTypeActivityTaskUnpaused:      {event: &types.HistoryEvent{}, want: 0},
+		types.EventTypeWorkflowExecutionUpdateAccepted: {
+			event: &types.HistoryEvent{
+				WorkflowExecutionUpdateAcceptedEventAttributes: &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+					Input: someBytesArray, // 17 bytes
+				},
+			},
+			want: someBytesArraySize,
+		},
+		types.EventTypeWorkflowExecutionUpdateRejected: {
+			event: &types.HistoryEvent{
+				WorkflowExecutionUpdateRejectedEventAttributes: &types.WorkflowExecutionUpdateRejectedEventAttributes{
+					Details: someBytesArray, // 17 bytes
+				},
+			},
+			want: someBytesArraySize,
+		},
+		types.EventTypeWorkflowExecutionUpdateCompleted: {
+			event: &types.HistoryEvent{
+				WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{
+					Result:         someBytesArray, // 17 bytes
+					FailureDetails: someBytesArray, // 17 bytes
+				},
+			},
+			want: 2 * someBytesArraySize,
+		},
 	} {
 		t.Run(eventType.String(), func(t *testing.T) {
 			if c.event != nil {
@@ -24,6 +24,7 @@ var _ = ioutil.NopCloser
 type HistoryAPIYARPCClient interface {
 	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error)
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*UpdateWorkflowExecutionResponse, error)
 	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error)
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
@@ -57,6 +58,10 @@ type HistoryAPIYARPCClient interface {
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest, ...yarpc.CallOption) (*ReapplyEventsResponse, error)
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest, ...yarpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	UpdateWorkflowSearchAttributes(context.Context, *UpdateWorkflowSearchAttributesRequest, ...yarpc.CallOption) (*UpdateWorkflowSearchAttributesResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest, ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest, ...yarpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	ResetActivity(context.Context, *ResetActivityRequest, ...yarpc.CallOption) (*ResetActivityResponse, error)
 	CountDLQMessages(context.Context, *CountDLQMessagesRequest, ...yarpc.CallOption) (*CountDLQMessagesResponse, error)
 	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest, ...yarpc.CallOption) (*ReadDLQMessagesResponse, error)
 	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest, ...yarpc.CallOption) (*PurgeDLQMessagesResponse, error)
@@ -88,6 +93,7 @@ func NewHistoryAPIYARPCClient(clientConfig transport.ClientConfig, options ...pr
 type HistoryAPIYARPCServer interface {
 	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest) (*SignalWorkflowExecutionResponse, error)
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
 	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*SignalWithStartWorkflowExecutionResponse, error)
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
@@ -121,6 +127,10 @@ type HistoryAPIYARPCServer interface {
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	UpdateWorkflowSearchAttributes(context.Context, *UpdateWorkflowSearchAttributesRequest) (*UpdateWorkflowSearchAttributesResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
 	CountDLQMessages(context.Context, *CountDLQMessagesRequest) (*CountDLQMessagesResponse, error)
 	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest) (*ReadDLQMessagesResponse, error)
 	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest) (*PurgeDLQMessagesResponse, error)
@@ -163,6 +173,16 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "UpdateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateWorkflowExecution,
+							NewRequest:  newHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "SignalWithStartWorkflowExecution",
 					Handler: protobuf.NewUnaryHandler(
@@ -493,6 +513,46 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "UpdateWorkflowSearchAttributes",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateWorkflowSearchAttributes,
+							NewRequest:  newHistoryAPIServiceUpdateWorkflowSearchAttributesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PauseWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PauseWorkflowExecution,
+							NewRequest:  newHistoryAPIServicePauseWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UnpauseWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UnpauseWorkflowExecution,
+							NewRequest:  newHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResetActivity",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResetActivity,
+							NewRequest:  newHistoryAPIServiceResetActivityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "CountDLQMessages",
 					Handler: protobuf.NewUnaryHandler(
@@ -725,6 +785,18 @@ func (c *_HistoryAPIYARPCCaller) SignalWorkflowExecution(ctx context.Context, re
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest, options ...yarpc.CallOption) (*UpdateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateWorkflowExecution", request, newHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) SignalWithStartWorkflowExecution(ctx context.Context, request *SignalWithStartWorkflowExecutionRequest, options ...yarpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "SignalWithStartWorkflowExecution", request, newHistoryAPIServiceSignalWithStartWorkflowExecutionYARPCResponse, options...)
 	if responseMessage == nil {
@@ -1121,6 +1193,54 @@ func (c *_HistoryAPIYARPCCaller) RefreshWorkflowTasks(ctx context.Context, reque
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) UpdateWorkflowSearchAttributes(ctx context.Context, request *UpdateWorkflowSearchAttributesRequest, options ...yarpc.CallOption) (*UpdateWorkflowSearchAttributesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateWorkflowSearchAttributes", request, newHistoryAPIServiceUpdateWorkflowSearchAttributesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateWorkflowSearchAttributesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowSearchAttributesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) PauseWorkflowExecution(ctx context.Context, request *PauseWorkflowExecutionRequest, options ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PauseWorkflowExecution", request, newHistoryAPIServicePauseWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServicePauseWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) UnpauseWorkflowExecution(ctx context.Context, request *UnpauseWorkflowExecutionRequest, options ...yarpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UnpauseWorkflowExecution", request, newHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UnpauseWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) ResetActivity(ctx context.Context, request *ResetActivityRequest, options ...yarpc.CallOption) (*ResetActivityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetActivity", request, newHistoryAPIServiceResetActivityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetActivityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceResetActivityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) CountDLQMessages(ctx context.Context, request *CountDLQMessagesRequest, options ...yarpc.CallOption) (*CountDLQMessagesResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "CountDLQMessages", request, newHistoryAPIServiceCountDLQMessagesYARPCResponse, options...)
 	if responseMessage == nil {
@@ -1265,6 +1385,22 @@ func (h *_HistoryAPIYARPCHandler) SignalWorkflowExecution(ctx context.Context, r
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) UpdateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) SignalWithStartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *SignalWithStartWorkflowExecutionRequest
 	var ok bool
@@ -1793,6 +1929,70 @@ func (h *_HistoryAPIYARPCHandler) RefreshWorkflowTasks(ctx context.Context, requ
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) UpdateWorkflowSearchAttributes(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateWorkflowSearchAttributesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateWorkflowSearchAttributesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowSearchAttributesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateWorkflowSearchAttributes(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) PauseWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PauseWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PauseWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServicePauseWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PauseWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) UnpauseWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UnpauseWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UnpauseWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UnpauseWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) ResetActivity(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetActivityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetActivityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceResetActivityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetActivity(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) CountDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *CountDLQMessagesRequest
 	var ok bool
@@ -1953,6 +2153,14 @@ func newHistoryAPIServiceSignalWorkflowExecutionYARPCResponse() proto.Message {
 	return &SignalWorkflowExecutionResponse{}
 }
 
+func newHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest() proto.Message {
+	return &UpdateWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse() proto.Message {
+	return &UpdateWorkflowExecutionResponse{}
+}
+
 func newHistoryAPIServiceSignalWithStartWorkflowExecutionYARPCRequest() proto.Message {
 	return &SignalWithStartWorkflowExecutionRequest{}
 }
@@ -2217,6 +2425,38 @@ func newHistoryAPIServiceRefreshWorkflowTasksYARPCResponse() proto.Message {
 	return &RefreshWorkflowTasksResponse{}
 }
 
+func newHistoryAPIServiceUpdateWorkflowSearchAttributesYARPCRequest() proto.Message {
+	return &UpdateWorkflowSearchAttributesRequest{}
+}
+
+func newHistoryAPIServiceUpdateWorkflowSearchAttributesYARPCResponse() proto.Message {
+	return &UpdateWorkflowSearchAttributesResponse{}
+}
+
+func newHistoryAPIServicePauseWorkflowExecutionYARPCRequest() proto.Message {
+	return &PauseWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServicePauseWorkflowExecutionYARPCResponse() proto.Message {
+	return &PauseWorkflowExecutionResponse{}
+}
+
+func newHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest() proto.Message {
+	return &UnpauseWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse() proto.Message {
+	return &UnpauseWorkflowExecutionResponse{}
+}
+
+func newHistoryAPIServiceResetActivityYARPCRequest() proto.Message {
+	return &ResetActivityRequest{}
+}
+
+func newHistoryAPIServiceResetActivityYARPCResponse() proto.Message {
+	return &ResetActivityResponse{}
+}
+
 func newHistoryAPIServiceCountDLQMessagesYARPCRequest() proto.Message {
 	return &CountDLQMessagesRequest{}
 }
@@ -2294,6 +2534,8 @@ var (
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCResponse            = &StartWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceSignalWorkflowExecutionYARPCRequest            = &SignalWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceSignalWorkflowExecutionYARPCResponse           = &SignalWorkflowExecutionResponse{}
+	emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCRequest            = &UpdateWorkflowExecutionRequest{}
+	emptyHistoryAPIServiceUpdateWorkflowExecutionYARPCResponse           = &UpdateWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceSignalWithStartWorkflowExecutionYARPCRequest   = &SignalWithStartWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceSignalWithStartWorkflowExecutionYARPCResponse  = &SignalWithStartWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceResetWorkflowExecutionYARPCRequest             = &ResetWorkflowExecutionRequest{}
@@ -2360,6 +2602,14 @@ var (
 	emptyHistoryAPIServiceReapplyEventsYARPCResponse                     = &ReapplyEventsResponse{}
 	emptyHistoryAPIServiceRefreshWorkflowTasksYARPCRequest               = &RefreshWorkflowTasksRequest{}
 	emptyHistoryAPIServiceRefreshWorkflowTasksYARPCResponse              = &RefreshWorkflowTasksResponse{}
+	emptyHistoryAPIServiceUpdateWorkflowSearchAttributesYARPCRequest     = &UpdateWorkflowSearchAttributesRequest{}
+	emptyHistoryAPIServiceUpdateWorkflowSearchAttributesYARPCResponse    = &UpdateWorkflowSearchAttributesResponse{}
+	emptyHistoryAPIServicePauseWorkflowExecutionYARPCRequest             = &PauseWorkflowExecutionRequest{}
+	emptyHistoryAPIServicePauseWorkflowExecutionYARPCResponse            = &PauseWorkflowExecutionResponse{}
+	emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCRequest           = &UnpauseWorkflowExecutionRequest{}
+	emptyHistoryAPIServiceUnpauseWorkflowExecutionYARPCResponse          = &UnpauseWorkflowExecutionResponse{}
+	emptyHistoryAPIServiceResetActivityYARPCRequest                      = &ResetActivityRequest{}
+	emptyHistoryAPIServiceResetActivityYARPCResponse                     = &ResetActivityResponse{}
 	emptyHistoryAPIServiceCountDLQMessagesYARPCRequest                   = &CountDLQMessagesRequest{}
 	emptyHistoryAPIServiceCountDLQMessagesYARPCResponse                  = &CountDLQMessagesResponse{}
 	emptyHistoryAPIServiceReadDLQMessagesYARPCRequest                    = &ReadDLQMessagesRequest{}
@@ -2383,317 +2633,342 @@ var (
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
-		0x76, 0xe8, 0x19, 0xf1, 0xf7, 0x48, 0x0e, 0xc9, 0x12, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0xbd, 0x96,
-		0x44, 0xcb, 0xeb, 0xa1, 0x45, 0xd9, 0xfa, 0x59, 0x5e, 0xad, 0x44, 0x4a, 0xf2, 0x38, 0xfa, 0x36,
-		0x69, 0x39, 0x5f, 0xf7, 0x36, 0xa7, 0x6b, 0xc8, 0x8e, 0x7a, 0xba, 0x47, 0xdd, 0x3d, 0xa4, 0xe8,
-		0x43, 0xe0, 0xc4, 0x41, 0x80, 0x2c, 0x82, 0xec, 0x66, 0x91, 0x04, 0x01, 0x02, 0x04, 0x08, 0x36,
-		0xc0, 0x62, 0x8d, 0xdc, 0x12, 0x20, 0x87, 0x24, 0xa7, 0x5c, 0x72, 0xcc, 0x35, 0xf7, 0xdd, 0x43,
-		0x02, 0xe4, 0xb6, 0xe7, 0x20, 0xa8, 0x4f, 0xf7, 0xf4, 0xa7, 0xba, 0x7a, 0x86, 0x0c, 0x22, 0xaf,
-		0xe3, 0xdb, 0x74, 0x55, 0xbd, 0x57, 0xaf, 0x5e, 0xbd, 0xf7, 0xfa, 0xfd, 0x7a, 0xe0, 0x7c, 0x77,
-		0x17, 0x7b, 0xeb, 0x4d, 0xc3, 0xc4, 0x4e, 0x13, 0xaf, 0xef, 0x5b, 0x7e, 0xe0, 0x7a, 0x47, 0xeb,
-		0x07, 0x97, 0xd7, 0x7d, 0xec, 0x1d, 0x58, 0x4d, 0x5c, 0xef, 0x78, 0x6e, 0xe0, 0xa2, 0x05, 0xb2,
-		0xac, 0xce, 0x97, 0xd5, 0xf9, 0xb2, 0xfa, 0xc1, 0xe5, 0xda, 0xf2, 0x9e, 0xeb, 0xee, 0xd9, 0x78,
-		0x9d, 0x2e, 0xdb, 0xed, 0xb6, 0xd6, 0xcd, 0xae, 0x67, 0x04, 0x96, 0xeb, 0x30, 0xc0, 0xda, 0xb9,
-		0xf4, 0x7c, 0x60, 0xb5, 0xb1, 0x1f, 0x18, 0xed, 0x0e, 0x5f, 0x90, 0x41, 0x70, 0xe8, 0x19, 0x9d,
-		0x0e, 0xf6, 0x7c, 0x3e, 0xbf, 0x92, 0x20, 0xd0, 0xe8, 0x58, 0x84, 0xb8, 0xa6, 0xdb, 0x6e, 0x47,
-		0x5b, 0xac, 0x8a, 0x56, 0x84, 0x24, 0x72, 0x2a, 0x44, 0x4b, 0x5e, 0x76, 0x71, 0xb4, 0x40, 0x15,
-		0x2d, 0x08, 0x0c, 0xff, 0x85, 0x6d, 0xf9, 0x81, 0x6c, 0xcd, 0xa1, 0xeb, 0xbd, 0x68, 0xd9, 0xee,
-		0x21, 0x5f, 0x73, 0x49, 0xb4, 0x86, 0xb3, 0x52, 0x4f, 0xad, 0x5d, 0x2b, 0x5a, 0x8b, 0x3d, 0xbe,
-		0xf2, 0x5b, 0xc9, 0x95, 0x66, 0xdb, 0x72, 0x28, 0x17, 0xec, 0xae, 0x1f, 0x14, 0x2d, 0x4a, 0x32,
-		0x62, 0x55, 0xbc, 0xe8, 0x65, 0x17, 0x77, 0xf9, 0x55, 0xd7, 0x2e, 0x8a, 0x97, 0x78, 0xb8, 0x63,
-		0x5b, 0xcd, 0xf8, 0xd5, 0x26, 0x6f, 0xc6, 0xdf, 0x37, 0x3c, 0x6c, 0x92, 0x95, 0x86, 0x13, 0xee,
-		0xf6, 0x46, 0xce, 0x8a, 0x24, 0x4d, 0xe7, 0x73, 0x56, 0x25, 0xd9, 0xa5, 0xfe, 0x6c, 0x18, 0xce,
-		0x6e, 0x07, 0x86, 0x17, 0x7c, 0xc2, 0xc7, 0xef, 0xbd, 0xc2, 0xcd, 0x2e, 0xa1, 0x47, 0xc3, 0x2f,
-		0xbb, 0xd8, 0x0f, 0xd0, 0x43, 0x18, 0xf1, 0xd8, 0xcf, 0xaa, 0xb2, 0xa2, 0xac, 0x8d, 0x6f, 0x6c,
-		0xd4, 0x13, 0x62, 0x6b, 0x74, 0xac, 0xfa, 0xc1, 0xe5, 0xba, 0x14, 0x89, 0x16, 0xa2, 0x40, 0x4b,
-		0x30, 0x66, 0xba, 0x6d, 0xc3, 0x72, 0x74, 0xcb, 0xac, 0x96, 0x56, 0x94, 0xb5, 0x31, 0x6d, 0x94,
-		0x0d, 0x34, 0x4c, 0xf4, 0x9b, 0x30, 0xd7, 0x31, 0x3c, 0xec, 0x04, 0x3a, 0x0e, 0x11, 0xe8, 0x96,
-		0xd3, 0x72, 0xab, 0x65, 0xba, 0xf1, 0x9a, 0x70, 0xe3, 0xa7, 0x14, 0x22, 0xda, 0xb1, 0xe1, 0xb4,
-		0x5c, 0xed, 0x74, 0x27, 0x3b, 0x88, 0xaa, 0x30, 0x62, 0x04, 0x01, 0x6e, 0x77, 0x82, 0xea, 0xa9,
-		0x15, 0x65, 0x6d, 0x48, 0x0b, 0x1f, 0xd1, 0x26, 0x4c, 0xe1, 0x57, 0x1d, 0x8b, 0xa9, 0x98, 0x4e,
-		0x74, 0xa9, 0x3a, 0x44, 0x77, 0xac, 0xd5, 0x99, 0x1e, 0xd5, 0x43, 0x3d, 0xaa, 0xef, 0x84, 0x8a,
-		0xa6, 0x55, 0x7a, 0x20, 0x64, 0x10, 0xb5, 0x60, 0xb1, 0xe9, 0x3a, 0x81, 0xe5, 0x74, 0xb1, 0x6e,
-		0xf8, 0xba, 0x83, 0x0f, 0x75, 0xcb, 0xb1, 0x02, 0xcb, 0x08, 0x5c, 0xaf, 0x3a, 0xbc, 0xa2, 0xac,
-		0x55, 0x36, 0xde, 0x12, 0x1e, 0x60, 0x93, 0x43, 0xdd, 0xf1, 0x1f, 0xe3, 0xc3, 0x46, 0x08, 0xa2,
-		0xcd, 0x37, 0x85, 0xe3, 0xa8, 0x01, 0x33, 0xe1, 0x8c, 0xa9, 0xb7, 0x0c, 0xcb, 0xee, 0x7a, 0xb8,
-		0x3a, 0x42, 0xc9, 0x3d, 0x23, 0xc4, 0x7f, 0x9f, 0xad, 0xd1, 0xa6, 0x23, 0x30, 0x3e, 0x82, 0x34,
-		0x98, 0xb7, 0x0d, 0x3f, 0xd0, 0x9b, 0x6e, 0xbb, 0x63, 0x63, 0x7a, 0x78, 0x0f, 0xfb, 0x5d, 0x3b,
-		0xa8, 0x8e, 0x4a, 0xf0, 0x3d, 0x35, 0x8e, 0x6c, 0xd7, 0x30, 0xb5, 0x59, 0x02, 0xbb, 0x19, 0x81,
-		0x6a, 0x14, 0x12, 0xfd, 0x2a, 0x2c, 0xb5, 0x2c, 0xcf, 0x0f, 0x74, 0x13, 0x37, 0x2d, 0x9f, 0xf2,
-		0xd3, 0xf0, 0x5f, 0xe8, 0xbb, 0x46, 0xf3, 0x85, 0xdb, 0x6a, 0x55, 0xc7, 0x28, 0xe2, 0xc5, 0x0c,
-		0x5f, 0xb7, 0xb8, 0x81, 0xd3, 0xaa, 0x14, 0x7a, 0x8b, 0x03, 0xef, 0x18, 0xfe, 0x8b, 0xbb, 0x0c,
-		0x14, 0x1d, 0xc0, 0x74, 0xc7, 0xf0, 0x02, 0x8b, 0xd2, 0xd9, 0x74, 0x9d, 0x96, 0xb5, 0x57, 0x85,
-		0x95, 0xf2, 0xda, 0xf8, 0xc6, 0xaf, 0xd4, 0x73, 0x0c, 0xa9, 0x5c, 0x2a, 0x89, 0xe8, 0x30, 0x74,
-		0x9b, 0x14, 0xdb, 0x3d, 0x27, 0xf0, 0x8e, 0xb4, 0xa9, 0x4e, 0x72, 0xb4, 0x76, 0x17, 0x66, 0x45,
-		0x0b, 0xd1, 0x34, 0x94, 0x5f, 0xe0, 0x23, 0xaa, 0x14, 0x63, 0x1a, 0xf9, 0x89, 0x66, 0x61, 0xe8,
-		0xc0, 0xb0, 0xbb, 0x98, 0x0b, 0x36, 0x7b, 0xb8, 0x59, 0xba, 0xae, 0xa8, 0xd7, 0x60, 0x39, 0x8f,
-		0x14, 0xbf, 0xe3, 0x3a, 0x3e, 0x46, 0x73, 0x30, 0xec, 0x75, 0xa9, 0x56, 0x30, 0x84, 0x43, 0x5e,
-		0xd7, 0x69, 0x98, 0xea, 0xdf, 0x94, 0x60, 0x79, 0xdb, 0xda, 0x73, 0x0c, 0x3b, 0x57, 0x41, 0x1f,
-		0xa5, 0x15, 0xf4, 0x8a, 0x58, 0x41, 0xa5, 0x58, 0xfa, 0xd4, 0xd0, 0x16, 0x2c, 0xe1, 0x57, 0x01,
-		0xf6, 0x1c, 0xc3, 0x8e, 0x0c, 0x6f, 0x4f, 0x59, 0xb9, 0x9e, 0x5e, 0x10, 0xee, 0x9f, 0xdd, 0x79,
-		0x31, 0x44, 0x95, 0x99, 0x42, 0x75, 0x38, 0xdd, 0xdc, 0xb7, 0x6c, 0xb3, 0xb7, 0x89, 0xeb, 0xd8,
-		0x47, 0x54, 0x6f, 0x47, 0xb5, 0x19, 0x3a, 0x15, 0x02, 0x3d, 0x71, 0xec, 0x23, 0x75, 0x15, 0xce,
-		0xe5, 0x9e, 0x8f, 0x31, 0x58, 0xfd, 0x79, 0x09, 0x2e, 0xf2, 0x35, 0x56, 0xb0, 0x2f, 0xb7, 0x79,
-		0xcf, 0xd3, 0x2c, 0xbd, 0x25, 0x63, 0x69, 0x11, 0xba, 0x3e, 0x79, 0xfb, 0xb9, 0x22, 0x10, 0xf0,
-		0x32, 0x15, 0xf0, 0x8f, 0xf3, 0x05, 0xbc, 0x3f, 0x12, 0xfe, 0x0f, 0x45, 0xfd, 0x0e, 0xac, 0x15,
-		0x13, 0x25, 0x17, 0xfa, 0xef, 0x2b, 0x70, 0x56, 0xc3, 0x3e, 0x3e, 0xf1, 0x4b, 0x49, 0x8a, 0xa4,
-		0xbf, 0x6b, 0x21, 0xaa, 0x9b, 0x87, 0x46, 0x7e, 0x8a, 0x2f, 0x4b, 0xb0, 0xba, 0x83, 0xbd, 0xb6,
-		0xe5, 0x18, 0x01, 0xce, 0x3d, 0xc9, 0xd3, 0xf4, 0x49, 0xae, 0x0a, 0x4f, 0x52, 0x88, 0xe8, 0x97,
-		0x5c, 0x81, 0xdf, 0x00, 0x55, 0x76, 0x44, 0xae, 0xc3, 0x3f, 0x54, 0x60, 0x65, 0x0b, 0xfb, 0x4d,
-		0xcf, 0xda, 0xcd, 0xe7, 0xe8, 0x93, 0x34, 0x47, 0xdf, 0x13, 0x1e, 0xa7, 0x08, 0x4f, 0x9f, 0xe2,
-		0xf1, 0xdf, 0x65, 0x58, 0x95, 0xa0, 0xe2, 0x22, 0x62, 0xc3, 0x42, 0xcf, 0xa5, 0x61, 0xaa, 0xcd,
-		0x5f, 0x78, 0x52, 0x9b, 0x9d, 0x41, 0xb8, 0x19, 0x07, 0xd5, 0xe6, 0xb1, 0x70, 0x1c, 0xed, 0xc2,
-		0x42, 0xf6, 0x6e, 0x99, 0x27, 0x55, 0xa2, 0xbb, 0x5d, 0xea, 0x6f, 0x37, 0xea, 0x4b, 0xcd, 0x1d,
-		0x8a, 0x86, 0xd1, 0x27, 0x80, 0x3a, 0xd8, 0x31, 0x2d, 0x67, 0x4f, 0x37, 0x9a, 0x81, 0x75, 0x60,
-		0x05, 0x16, 0xf6, 0xb9, 0xb9, 0xca, 0x71, 0xd4, 0xd8, 0xf2, 0x3b, 0x6c, 0xf5, 0x11, 0x45, 0x3e,
-		0xd3, 0x49, 0x0c, 0x5a, 0xd8, 0x47, 0xbf, 0x06, 0xd3, 0x21, 0x62, 0x2a, 0x26, 0x1e, 0x76, 0xaa,
-		0xa7, 0x28, 0xda, 0xba, 0x0c, 0xed, 0x26, 0x59, 0x9b, 0xa4, 0x7c, 0xaa, 0x13, 0x9b, 0xf2, 0xb0,
-		0x83, 0xb6, 0x7b, 0xa8, 0x43, 0xef, 0x84, 0x3b, 0x7a, 0x52, 0x8a, 0x43, 0x67, 0x24, 0x81, 0x34,
-		0x1c, 0x54, 0x5f, 0xc1, 0xec, 0x33, 0x12, 0xf3, 0x84, 0xdc, 0x0b, 0xc5, 0x70, 0x33, 0x2d, 0x86,
-		0x6f, 0x0a, 0xf7, 0x10, 0xc1, 0xf6, 0x29, 0x7a, 0x3f, 0x56, 0x60, 0x2e, 0x05, 0xce, 0xc5, 0xed,
-		0x36, 0x4c, 0xd0, 0x38, 0x2c, 0x74, 0xe7, 0x94, 0x3e, 0xdc, 0xb9, 0x71, 0x0a, 0xc1, 0xbd, 0xb8,
-		0x06, 0x54, 0x42, 0x04, 0xbf, 0x8d, 0x9b, 0x01, 0x36, 0xb9, 0xe0, 0xa8, 0xf9, 0x67, 0xd0, 0xf8,
-		0x4a, 0x6d, 0xf2, 0x65, 0xfc, 0x51, 0xfd, 0x7d, 0x05, 0x6a, 0xd4, 0x80, 0x6e, 0x07, 0x56, 0xf3,
-		0xc5, 0x11, 0xf1, 0xe8, 0x1e, 0x5a, 0x7e, 0x10, 0xb2, 0xa9, 0x91, 0x66, 0xd3, 0x7a, 0xbe, 0x25,
-		0x17, 0x62, 0xe8, 0x93, 0x59, 0x67, 0x61, 0x49, 0x88, 0x83, 0x5b, 0x96, 0x7f, 0x2b, 0xc1, 0xfc,
-		0x03, 0x1c, 0x3c, 0xea, 0x06, 0xc6, 0xae, 0x8d, 0xb7, 0x03, 0x23, 0xc0, 0x9a, 0x08, 0xad, 0x92,
-		0xb2, 0xa7, 0x1f, 0x03, 0x12, 0x98, 0xd1, 0xd2, 0x40, 0x66, 0x74, 0x26, 0xa3, 0x61, 0xe8, 0x0a,
-		0xcc, 0xe3, 0x57, 0x1d, 0xca, 0x40, 0xdd, 0xc1, 0xaf, 0x02, 0x1d, 0x1f, 0x90, 0xb0, 0xc8, 0x32,
-		0xa9, 0x85, 0x2e, 0x6b, 0xa7, 0xc3, 0xd9, 0xc7, 0xf8, 0x55, 0x70, 0x8f, 0xcc, 0x35, 0x4c, 0xf4,
-		0x0e, 0xcc, 0x36, 0xbb, 0x1e, 0x8d, 0x9f, 0x76, 0x3d, 0xc3, 0x69, 0xee, 0xeb, 0x81, 0xfb, 0x82,
-		0x6a, 0x8f, 0xb2, 0x36, 0xa1, 0x21, 0x3e, 0x77, 0x97, 0x4e, 0xed, 0x90, 0x19, 0xf4, 0x1b, 0x30,
-		0x7b, 0x80, 0x3d, 0xea, 0xa5, 0x73, 0x9f, 0x42, 0xb7, 0x02, 0xdc, 0xe6, 0x4a, 0x91, 0x16, 0x58,
-		0x12, 0xb4, 0x92, 0x13, 0x3c, 0x67, 0x20, 0x1f, 0x32, 0x88, 0x46, 0x80, 0xdb, 0x1a, 0x3a, 0xc8,
-		0x8c, 0xa9, 0xff, 0x30, 0x06, 0x0b, 0x19, 0x96, 0x72, 0x01, 0x15, 0xb3, 0x4d, 0x39, 0x29, 0xdb,
-		0xee, 0xc3, 0x64, 0x84, 0x36, 0x38, 0xea, 0x60, 0x7e, 0x11, 0xab, 0x52, 0x8c, 0x3b, 0x47, 0x1d,
-		0xac, 0x4d, 0x1c, 0xc6, 0x9e, 0x90, 0x0a, 0x93, 0x22, 0xae, 0x8f, 0x3b, 0x31, 0x6e, 0x3f, 0x87,
-		0xc5, 0x8e, 0x87, 0x0f, 0x2c, 0xb7, 0xeb, 0xeb, 0x3e, 0x71, 0x73, 0xb0, 0xd9, 0x5b, 0x7f, 0x8a,
-		0xee, 0xbb, 0x94, 0x09, 0x73, 0x1a, 0x4e, 0x70, 0xf5, 0xdd, 0xe7, 0xc4, 0x57, 0xd2, 0xe6, 0x43,
-		0xe8, 0x6d, 0x06, 0x1c, 0xe2, 0x7d, 0x1b, 0x4e, 0xd3, 0xa0, 0x8c, 0x45, 0x51, 0x11, 0xc6, 0x21,
-		0x4a, 0xc1, 0x34, 0x99, 0xba, 0x4f, 0x66, 0xc2, 0xe5, 0x37, 0x61, 0x8c, 0x06, 0x58, 0xb6, 0xe5,
-		0x07, 0x34, 0xcc, 0x1c, 0xdf, 0x38, 0x2b, 0xf6, 0x20, 0x42, 0x91, 0x1f, 0x0d, 0xf8, 0x2f, 0xf4,
-		0x00, 0xa6, 0x7d, 0xaa, 0x0e, 0x7a, 0x0f, 0xc5, 0x48, 0x3f, 0x28, 0x2a, 0x7e, 0x42, 0x8b, 0xd0,
-		0xbb, 0x30, 0xdf, 0xb4, 0x2d, 0x42, 0xa9, 0x6d, 0xed, 0x7a, 0x86, 0x77, 0xa4, 0x73, 0x79, 0xa0,
-		0x81, 0xe4, 0x98, 0x36, 0xcb, 0x66, 0x1f, 0xb2, 0x49, 0x2e, 0x3f, 0x31, 0xa8, 0x16, 0x36, 0x82,
-		0xae, 0x87, 0x23, 0xa8, 0xb1, 0x38, 0xd4, 0x7d, 0x36, 0x19, 0x42, 0x9d, 0x83, 0x71, 0x0e, 0x65,
-		0xb5, 0x3b, 0x76, 0x15, 0xe8, 0x52, 0x60, 0x43, 0x8d, 0x76, 0xc7, 0x46, 0x3e, 0x5c, 0x4a, 0x9f,
-		0x4a, 0xf7, 0x9b, 0xfb, 0xd8, 0xec, 0xda, 0x58, 0x0f, 0x5c, 0x76, 0x59, 0x34, 0xca, 0x77, 0xbb,
-		0x41, 0x75, 0xbc, 0x28, 0x20, 0x7d, 0x23, 0x79, 0xd6, 0x6d, 0x8e, 0x69, 0xc7, 0xa5, 0xf7, 0xb6,
-		0xc3, 0xd0, 0x10, 0x7f, 0x87, 0x5d, 0x15, 0x91, 0xff, 0xde, 0x41, 0x26, 0x68, 0xa2, 0x61, 0x86,
-		0x4e, 0x6d, 0x93, 0x99, 0xf0, 0x14, 0x79, 0xba, 0x3a, 0x99, 0xab, 0xab, 0x0f, 0xa1, 0x12, 0xc9,
-		0xb6, 0x4f, 0x94, 0xa9, 0x5a, 0xa1, 0x49, 0x85, 0xf3, 0xc9, 0xab, 0x62, 0x99, 0x9e, 0xb8, 0x7c,
-		0x33, 0xcd, 0x8b, 0x14, 0x83, 0x3e, 0xa2, 0x26, 0xcc, 0x46, 0xd8, 0x9a, 0xb6, 0xeb, 0x63, 0x8e,
-		0x73, 0x8a, 0xe2, 0xbc, 0xdc, 0xa7, 0x37, 0x42, 0x00, 0x09, 0xbe, 0xae, 0xaf, 0x45, 0xfa, 0x1c,
-		0x0d, 0x12, 0x2d, 0x9f, 0x49, 0x9a, 0x17, 0xe2, 0x22, 0x4c, 0x8b, 0x5e, 0xb8, 0x3d, 0xaa, 0x13,
-		0xc6, 0xc5, 0xc2, 0xbe, 0x36, 0x7d, 0x90, 0x1a, 0x41, 0xb7, 0x60, 0xc9, 0x22, 0x3a, 0x97, 0xba,
-		0x63, 0xec, 0x10, 0x3b, 0x63, 0x56, 0x67, 0xa8, 0x8f, 0xb9, 0x60, 0xf9, 0x49, 0x53, 0x7f, 0x8f,
-		0x4d, 0xa3, 0x55, 0x98, 0x08, 0x6d, 0x9d, 0x6f, 0x7d, 0x86, 0xab, 0x88, 0xa9, 0x36, 0x1f, 0xdb,
-		0xb6, 0x3e, 0xc3, 0xea, 0x2f, 0x14, 0x58, 0x78, 0xea, 0xda, 0xf6, 0xff, 0xaf, 0xb7, 0x81, 0xfa,
-		0x93, 0x51, 0xa8, 0x66, 0x8f, 0xfd, 0x8d, 0xc5, 0xfe, 0xc6, 0x62, 0x7f, 0x1d, 0x2d, 0x76, 0x9e,
-		0x7e, 0x4c, 0xe4, 0x5a, 0x60, 0xa1, 0x39, 0x9b, 0x3c, 0xb1, 0x39, 0xfb, 0xe5, 0x33, 0xec, 0xea,
-		0xbf, 0x94, 0x60, 0x45, 0xc3, 0x4d, 0xd7, 0x33, 0xe3, 0x89, 0x5a, 0xae, 0x16, 0xaf, 0xd3, 0x52,
-		0x9e, 0x83, 0xf1, 0x48, 0x70, 0x22, 0x23, 0x00, 0xe1, 0x50, 0xc3, 0x44, 0x0b, 0x30, 0x42, 0x65,
-		0x8c, 0x6b, 0x7c, 0x59, 0x1b, 0x26, 0x8f, 0x0d, 0x13, 0x9d, 0x05, 0xe0, 0x71, 0x44, 0xa8, 0xbb,
-		0x63, 0xda, 0x18, 0x1f, 0x69, 0x98, 0x48, 0x83, 0x89, 0x8e, 0x6b, 0xdb, 0x7a, 0x18, 0xab, 0x0c,
-		0x4b, 0x62, 0x15, 0x62, 0x43, 0xef, 0xbb, 0x5e, 0x9c, 0x35, 0x61, 0xac, 0x32, 0x4e, 0x90, 0xf0,
-		0x07, 0xf5, 0xf7, 0x46, 0x61, 0x55, 0xc2, 0x45, 0x6e, 0x78, 0x33, 0x16, 0x52, 0x39, 0x9e, 0x85,
-		0x94, 0x5a, 0xbf, 0xd2, 0xf1, 0xad, 0xdf, 0xb7, 0x01, 0x85, 0xfc, 0x35, 0xd3, 0xe6, 0x77, 0x3a,
-		0x9a, 0x09, 0x57, 0xaf, 0x11, 0x03, 0x26, 0x30, 0xbd, 0x65, 0x62, 0xa1, 0x12, 0x78, 0x33, 0x16,
-		0x7d, 0x28, 0x6b, 0xd1, 0x63, 0x25, 0x9d, 0xe1, 0x64, 0x49, 0xe7, 0x3a, 0x54, 0xb9, 0x49, 0xe9,
-		0x25, 0x40, 0x42, 0x07, 0x61, 0x84, 0x3a, 0x08, 0xf3, 0x6c, 0x3e, 0x92, 0x9d, 0xd0, 0x3f, 0xd0,
-		0x60, 0x32, 0x2a, 0x5d, 0xd0, 0x94, 0x09, 0xab, 0x85, 0xbc, 0x9d, 0xa7, 0x8d, 0x3b, 0x9e, 0xe1,
-		0xf8, 0xc4, 0x94, 0x25, 0xd2, 0x04, 0x13, 0x66, 0xec, 0x09, 0x7d, 0x0a, 0x67, 0x04, 0x09, 0x99,
-		0x9e, 0x09, 0x1f, 0xeb, 0xc7, 0x84, 0x2f, 0x66, 0xc4, 0x3d, 0xb2, 0xe6, 0x39, 0xde, 0x27, 0xe4,
-		0x79, 0x9f, 0xab, 0x30, 0x91, 0xb0, 0x79, 0xe3, 0xd4, 0xe6, 0x8d, 0xef, 0xc6, 0x8c, 0xdd, 0x1d,
-		0xa8, 0xf4, 0xae, 0x95, 0x96, 0xc4, 0x26, 0x0a, 0x4b, 0x62, 0x93, 0x11, 0x04, 0xad, 0x88, 0x7d,
-		0x00, 0x13, 0xe1, 0x5d, 0x53, 0x04, 0x93, 0x85, 0x08, 0xc6, 0xf9, 0x7a, 0x0a, 0x6e, 0xc0, 0xc8,
-		0xcb, 0x2e, 0xa6, 0x46, 0xb6, 0x42, 0xf3, 0x3f, 0x0f, 0x72, 0xb3, 0xe0, 0x85, 0x5a, 0x44, 0x53,
-		0x14, 0x16, 0xf6, 0x59, 0xde, 0x3b, 0xc4, 0x9b, 0xf1, 0x05, 0xa7, 0x32, 0xbe, 0x60, 0xed, 0x53,
-		0x98, 0x88, 0xc3, 0x0a, 0x52, 0xe1, 0xd7, 0xe3, 0xa9, 0xf0, 0xbc, 0x14, 0x49, 0xa8, 0x98, 0x2c,
-		0x55, 0x12, 0x4b, 0x97, 0xf7, 0x4c, 0x69, 0x98, 0x18, 0xfb, 0xc6, 0x94, 0x66, 0x4c, 0x69, 0x9c,
-		0x35, 0x42, 0x53, 0xfa, 0xb3, 0x72, 0x68, 0x4a, 0x85, 0x5c, 0xe4, 0xa6, 0xf4, 0x23, 0x98, 0x4a,
-		0x99, 0x2a, 0xa9, 0x31, 0xe5, 0xc9, 0x0c, 0x6a, 0x6c, 0xb4, 0x4a, 0xd2, 0x94, 0x65, 0x84, 0xbb,
-		0x34, 0x98, 0x70, 0xc7, 0x2c, 0x57, 0x39, 0x69, 0xb9, 0x3e, 0x85, 0xe5, 0xa4, 0xe2, 0xe9, 0x6e,
-		0x4b, 0x0f, 0xf6, 0x2d, 0x5f, 0x8f, 0x57, 0xaf, 0xe5, 0x5b, 0xd5, 0x12, 0x8a, 0xf8, 0xa4, 0xb5,
-		0xb3, 0x6f, 0xf9, 0x77, 0x38, 0xfe, 0x06, 0xcc, 0xec, 0x63, 0xc3, 0x0b, 0x76, 0xb1, 0x11, 0xe8,
-		0x26, 0x0e, 0x0c, 0xcb, 0xf6, 0x79, 0xc2, 0x47, 0x9e, 0x20, 0x9c, 0x8e, 0xc0, 0xb6, 0x18, 0x54,
-		0xf6, 0xd5, 0x34, 0x7c, 0xbc, 0x57, 0xd3, 0x45, 0x98, 0x8a, 0xf0, 0x30, 0xb1, 0xa6, 0x36, 0x7a,
-		0x4c, 0x8b, 0x1c, 0xa3, 0x2d, 0x3a, 0xaa, 0xfe, 0xb9, 0x02, 0xdf, 0x62, 0xb7, 0x99, 0x50, 0x76,
-		0x5e, 0x84, 0xee, 0xe9, 0x8b, 0x96, 0x4e, 0x2a, 0x5e, 0xcf, 0x4b, 0x2a, 0x16, 0xa1, 0xea, 0x33,
-		0xbb, 0xf8, 0x77, 0x65, 0x78, 0x43, 0x8e, 0x8d, 0x8b, 0x20, 0xee, 0xbd, 0xff, 0x3c, 0x3e, 0xc6,
-		0x49, 0xbc, 0x79, 0x7c, 0xeb, 0xa6, 0x4d, 0xf9, 0x29, 0x49, 0xff, 0xb1, 0x02, 0xcb, 0xbd, 0xb4,
-		0x3c, 0xf1, 0xa1, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7, 0xdd, 0xa6, 0x61, 0xdb, 0x47,
-		0xd5, 0x12, 0xb5, 0xa9, 0x9f, 0x4a, 0x76, 0x2d, 0x3e, 0x4e, 0xbd, 0x97, 0xb7, 0xdf, 0x71, 0xb7,
-		0xf8, 0x0e, 0x0f, 0xd9, 0x06, 0xcc, 0xd4, 0x2e, 0x19, 0xf9, 0x2b, 0x6a, 0xbf, 0x03, 0x2b, 0x45,
-		0x08, 0x04, 0xf6, 0x76, 0x2b, 0x69, 0x6f, 0xc5, 0x55, 0x81, 0xd0, 0x0c, 0x50, 0x5c, 0x21, 0x62,
-		0xfa, 0x66, 0x8e, 0xd9, 0xde, 0x1f, 0x2a, 0xc4, 0xf6, 0x66, 0x8e, 0x79, 0xdf, 0xb0, 0xec, 0x9e,
-		0x2c, 0xf5, 0x59, 0x4e, 0x2a, 0xc2, 0xd3, 0xa7, 0x20, 0x7d, 0x8b, 0xd8, 0xb1, 0x5c, 0x4c, 0x3c,
-		0x59, 0xfd, 0xa7, 0x0a, 0xa8, 0x59, 0x6b, 0xf7, 0x61, 0xa8, 0x9e, 0x21, 0xe5, 0xcf, 0xd2, 0x94,
-		0x5f, 0xcb, 0xa1, 0xbc, 0x08, 0x53, 0x9f, 0xb4, 0x3f, 0x25, 0xca, 0x29, 0xc1, 0xc5, 0x65, 0xf3,
-		0x4d, 0x98, 0x6e, 0x1a, 0x4e, 0x13, 0x47, 0x6f, 0x00, 0xcc, 0xde, 0x69, 0xa3, 0xda, 0x14, 0x1b,
-		0xd7, 0xc2, 0xe1, 0xb8, 0xbe, 0xc7, 0x71, 0x9e, 0x50, 0xdf, 0x65, 0xa8, 0xfa, 0x3c, 0xea, 0x85,
-		0x48, 0xdd, 0x73, 0x90, 0xc5, 0x0a, 0x96, 0x82, 0x85, 0x27, 0x91, 0xb0, 0x5c, 0x3c, 0x03, 0x4b,
-		0x98, 0x08, 0x53, 0x42, 0xc2, 0xb2, 0x07, 0xa4, 0xf7, 0xd3, 0xa3, 0xbc, 0x6f, 0x09, 0x2b, 0xc2,
-		0xd4, 0x27, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe1, 0xe2, 0xd4, 0xff, 0xbd, 0x02, 0xe7, 0x34, 0xdc,
-		0x76, 0x0f, 0x30, 0xeb, 0x44, 0xf8, 0xaa, 0xe4, 0xf1, 0x92, 0x8e, 0x51, 0x39, 0xe5, 0x18, 0xa9,
-		0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0xb1, 0x04, 0xe7, 0xf9, 0x11, 0xd8, 0xb1, 0x73,
-		0xcb, 0xe0, 0xd2, 0x03, 0x1a, 0x50, 0x49, 0xea, 0x20, 0x3f, 0xdc, 0xcd, 0x9c, 0xfb, 0xeb, 0x63,
-		0x43, 0x6d, 0x32, 0xa1, 0xbd, 0x68, 0x17, 0x16, 0xa2, 0x4e, 0x03, 0x61, 0x3b, 0x9f, 0xb8, 0x08,
-		0x7d, 0x8f, 0xc3, 0xa4, 0x8a, 0xd0, 0x58, 0x34, 0x3c, 0x70, 0x97, 0xc1, 0x1a, 0x5c, 0x28, 0x3a,
-		0x0b, 0xe7, 0xf3, 0x3f, 0x2b, 0xb0, 0x14, 0x26, 0x8e, 0x04, 0x81, 0xfc, 0x6b, 0x11, 0x9f, 0x4b,
-		0x30, 0x63, 0xf9, 0x7a, 0xb2, 0xbb, 0x8e, 0xf2, 0x72, 0x54, 0x9b, 0xb2, 0xfc, 0xfb, 0xf1, 0xbe,
-		0x39, 0x75, 0x19, 0xce, 0x88, 0xc9, 0xe7, 0xe7, 0xfb, 0x82, 0x3a, 0x2c, 0xc4, 0x58, 0x27, 0x0b,
-		0xe7, 0x19, 0xd3, 0xfa, 0x3a, 0x0e, 0xba, 0x0a, 0x13, 0xbc, 0x75, 0x12, 0x9b, 0xb1, 0x5c, 0x6e,
-		0x34, 0xd6, 0x30, 0xd1, 0x27, 0x70, 0xba, 0x19, 0x92, 0x1a, 0xdb, 0xfa, 0xd4, 0x40, 0x5b, 0xa3,
-		0x08, 0x45, 0x6f, 0xef, 0x87, 0x30, 0x1d, 0x6b, 0x87, 0x64, 0x41, 0xc2, 0x50, 0xbf, 0x41, 0xc2,
-		0x54, 0x0f, 0x94, 0x45, 0x09, 0x67, 0x01, 0x42, 0x77, 0xcf, 0x32, 0xa9, 0x7b, 0x5c, 0xd6, 0xc6,
-		0xf8, 0x48, 0xc3, 0x54, 0x2f, 0x12, 0x65, 0x96, 0x5e, 0x02, 0xbf, 0xae, 0xff, 0x28, 0x41, 0x55,
-		0xe3, 0xbd, 0xc2, 0x98, 0xa2, 0xf6, 0x9f, 0x6f, 0xbc, 0xce, 0x2b, 0xfa, 0x2d, 0x98, 0x13, 0x55,
-		0x8e, 0xc3, 0x0e, 0x90, 0x01, 0x4a, 0xc7, 0xa7, 0xb3, 0xa5, 0x63, 0x1f, 0xbd, 0x07, 0xc3, 0x94,
-		0xf5, 0x3e, 0xbf, 0x51, 0x71, 0x6a, 0x64, 0xcb, 0x08, 0x8c, 0xbb, 0xb6, 0xbb, 0xab, 0xf1, 0xc5,
-		0x68, 0x13, 0x2a, 0x0e, 0x3e, 0xd4, 0xbd, 0x2e, 0xbf, 0xb9, 0x30, 0xb0, 0x29, 0x00, 0x9f, 0x70,
-		0xf0, 0xa1, 0xd6, 0x65, 0x57, 0xe6, 0xab, 0x4b, 0xb0, 0x28, 0x60, 0x35, 0xbf, 0x88, 0xef, 0x2b,
-		0x30, 0xbf, 0x7d, 0xe4, 0x34, 0xb7, 0xf7, 0x0d, 0xcf, 0xe4, 0x19, 0x52, 0x7e, 0x0d, 0xe7, 0xa1,
-		0xe2, 0xbb, 0x5d, 0xaf, 0x89, 0x75, 0xde, 0x42, 0xce, 0xef, 0x62, 0x92, 0x8d, 0x6e, 0xb2, 0x41,
-		0xb4, 0x08, 0xa3, 0x3e, 0x01, 0x0e, 0xdf, 0x6f, 0x43, 0xda, 0x08, 0x7d, 0x6e, 0x98, 0xa8, 0x0e,
-		0xa7, 0x68, 0x2c, 0x59, 0x2e, 0x0c, 0xf0, 0xe8, 0x3a, 0x75, 0x11, 0x16, 0x32, 0xb4, 0x70, 0x3a,
-		0xff, 0x75, 0x08, 0x4e, 0x93, 0xb9, 0xf0, 0x3d, 0xf9, 0x3a, 0x65, 0xa5, 0x0a, 0x23, 0x61, 0x46,
-		0x8a, 0x69, 0x72, 0xf8, 0x48, 0x14, 0xbd, 0x17, 0xeb, 0x46, 0x79, 0x84, 0x28, 0xef, 0x40, 0x78,
-		0x92, 0xcd, 0x43, 0x0d, 0x0d, 0x9a, 0x87, 0x92, 0x2b, 0x61, 0x26, 0x92, 0x1f, 0x19, 0x2c, 0x92,
-		0xff, 0x88, 0x57, 0x7f, 0x7a, 0x41, 0x35, 0xc5, 0x32, 0x5a, 0x88, 0x65, 0x86, 0x80, 0x45, 0xee,
-		0x31, 0xc5, 0x75, 0x15, 0x46, 0xc2, 0x88, 0x7c, 0xac, 0x8f, 0x88, 0x3c, 0x5c, 0x1c, 0xcf, 0x26,
-		0x40, 0x32, 0x9b, 0x70, 0x1b, 0x26, 0x58, 0x6d, 0x8a, 0x37, 0x8a, 0x8f, 0xf7, 0xd1, 0x28, 0x3e,
-		0x4e, 0x4b, 0x56, 0xbc, 0x47, 0xfc, 0x1d, 0xa0, 0x7d, 0xde, 0xfc, 0xd3, 0x09, 0xdd, 0x32, 0xb1,
-		0x13, 0x58, 0xc1, 0x11, 0xcd, 0x06, 0x8e, 0x69, 0x88, 0xcc, 0x7d, 0x42, 0xa7, 0x1a, 0x7c, 0x06,
-		0x3d, 0x86, 0xa9, 0x94, 0x69, 0xe0, 0x99, 0xbf, 0xf3, 0x7d, 0x19, 0x05, 0xad, 0x92, 0x34, 0x08,
-		0xea, 0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x28, 0xb0, 0x14, 0x76, 0xde, 0x7d, 0x45,
-		0x3c, 0x3c, 0xf5, 0x8f, 0x15, 0x38, 0x23, 0xa6, 0x89, 0x07, 0x3f, 0x57, 0x60, 0xbe, 0xcd, 0xc6,
-		0x59, 0x5d, 0x46, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7, 0xf0, 0x74, 0x3b, 0x06, 0xd5,
-		0x70, 0x36, 0xc9, 0x14, 0xba, 0x01, 0x8b, 0x19, 0x20, 0xd3, 0x08, 0x8c, 0x5d, 0xc3, 0x0f, 0x1b,
-		0x70, 0xe7, 0x93, 0x70, 0x5b, 0x7c, 0x56, 0x3d, 0x03, 0xb5, 0x90, 0x1e, 0xce, 0xcf, 0x0f, 0xdd,
-		0xa8, 0x75, 0x4a, 0xfd, 0xdd, 0x52, 0x8f, 0x85, 0x89, 0x69, 0x4e, 0xed, 0x1a, 0x4c, 0x3b, 0xdd,
-		0xf6, 0x2e, 0xf6, 0x74, 0xb7, 0xa5, 0x53, 0x2b, 0xe5, 0x53, 0x3a, 0x87, 0xb4, 0x0a, 0x1b, 0x7f,
-		0xd2, 0xa2, 0xc6, 0xc7, 0x27, 0xcc, 0x0e, 0xad, 0x9a, 0x4f, 0x53, 0x0b, 0x43, 0xda, 0x28, 0x37,
-		0x6b, 0x3e, 0x6a, 0xc0, 0x04, 0xbf, 0x09, 0x76, 0x54, 0x71, 0x97, 0x69, 0x28, 0x0e, 0x2c, 0xd7,
-		0x43, 0x4f, 0x4e, 0x7d, 0xbf, 0x71, 0xb3, 0x37, 0x80, 0xae, 0xc2, 0x02, 0xdb, 0xa7, 0xe9, 0x3a,
-		0x81, 0xe7, 0xda, 0x36, 0xf6, 0x28, 0x4f, 0xba, 0xec, 0x4d, 0x31, 0xa6, 0xcd, 0xd1, 0xe9, 0xcd,
-		0x68, 0x96, 0xd9, 0x45, 0xaa, 0x21, 0xa6, 0xe9, 0x61, 0xdf, 0xe7, 0x09, 0xc9, 0xf0, 0x51, 0xad,
-		0xc3, 0x0c, 0xab, 0x6c, 0x11, 0xb8, 0x50, 0x76, 0xe2, 0x46, 0x5a, 0x49, 0x18, 0x69, 0x75, 0x16,
-		0x50, 0x7c, 0x3d, 0x17, 0xc6, 0xff, 0x52, 0x60, 0x86, 0x39, 0xef, 0x71, 0x2f, 0x31, 0x1f, 0x0d,
-		0xba, 0xc5, 0xab, 0xc0, 0x51, 0xd1, 0xbb, 0xb2, 0x71, 0x2e, 0x87, 0x21, 0x04, 0x23, 0xcd, 0x9a,
-		0xd1, 0x3a, 0x30, 0xcd, 0x98, 0xc5, 0x72, 0xaf, 0xe5, 0x44, 0xee, 0x75, 0x13, 0xa6, 0x0e, 0x2c,
-		0xdf, 0xda, 0xb5, 0x6c, 0x2b, 0x38, 0x62, 0x96, 0xa8, 0x38, 0x5d, 0x58, 0xe9, 0x81, 0x50, 0x33,
-		0xb4, 0x0a, 0x13, 0xfc, 0x15, 0xa6, 0x3b, 0x06, 0xb7, 0xb8, 0x63, 0xda, 0x38, 0x1f, 0x7b, 0x6c,
-		0xb4, 0x31, 0xe1, 0x42, 0xfc, 0xb8, 0x9c, 0x0b, 0x3f, 0xa0, 0x5c, 0xf0, 0x71, 0xf0, 0xac, 0x8b,
-		0xbb, 0xb8, 0x0f, 0x2e, 0xa4, 0x77, 0x2a, 0x65, 0x76, 0x4a, 0x32, 0xaa, 0x3c, 0x20, 0xa3, 0x18,
-		0x9d, 0x3d, 0x82, 0x38, 0x9d, 0x3f, 0x52, 0x60, 0x36, 0x94, 0xfb, 0xaf, 0x0c, 0xa9, 0x4f, 0x60,
-		0x2e, 0x45, 0x13, 0xd7, 0xc2, 0xab, 0xb0, 0xd0, 0xf1, 0xdc, 0x26, 0xf6, 0x7d, 0xcb, 0xd9, 0xd3,
-		0xe9, 0x57, 0x65, 0xcc, 0x0e, 0x10, 0x65, 0x2c, 0x13, 0x99, 0xef, 0x4d, 0x53, 0x48, 0x6a, 0x04,
-		0x7c, 0xf5, 0x0b, 0x05, 0xce, 0x3e, 0xc0, 0x81, 0xd6, 0xfb, 0xc6, 0xec, 0x11, 0xf6, 0x7d, 0x63,
-		0x0f, 0x47, 0x2e, 0xcb, 0x6d, 0x18, 0xa6, 0x05, 0x20, 0x86, 0x68, 0x7c, 0xe3, 0x62, 0x0e, 0xb5,
-		0x31, 0x14, 0xb4, 0x3a, 0xa4, 0x71, 0xb0, 0x3e, 0x98, 0x42, 0x6c, 0xcc, 0x72, 0x1e, 0x15, 0xfc,
-		0x80, 0x2f, 0xa1, 0xc2, 0xb8, 0xde, 0xe6, 0x33, 0x9c, 0x9c, 0x8f, 0x72, 0x93, 0x93, 0x72, 0x84,
-		0x75, 0xaa, 0x9b, 0xe1, 0x28, 0x4b, 0x44, 0x4e, 0xfa, 0xf1, 0xb1, 0x9a, 0x0d, 0x28, 0xbb, 0x28,
-		0x9e, 0x6c, 0x1c, 0x62, 0xc9, 0xc6, 0xef, 0x26, 0x93, 0x8d, 0x97, 0x8a, 0x19, 0x14, 0x11, 0x13,
-		0x4b, 0x34, 0xb6, 0x61, 0xe5, 0x01, 0x0e, 0xb6, 0x1e, 0x3e, 0x93, 0xdc, 0x45, 0x03, 0x80, 0xa9,
-		0xb4, 0xd3, 0x72, 0x43, 0x06, 0xf4, 0xb1, 0x1d, 0x11, 0x24, 0x6a, 0x26, 0xa9, 0xe8, 0x91, 0x5f,
-		0xbe, 0xfa, 0x0a, 0x56, 0x25, 0xdb, 0x71, 0xa6, 0x6f, 0xc3, 0x4c, 0xec, 0xeb, 0x43, 0x5a, 0x8c,
-		0x0c, 0xb7, 0xbd, 0xd0, 0xdf, 0xb6, 0xda, 0xb4, 0x97, 0x1c, 0xf0, 0xd5, 0x7f, 0x57, 0x60, 0x56,
-		0xc3, 0x46, 0xa7, 0x63, 0xb3, 0x88, 0x28, 0x3a, 0xdd, 0x3c, 0x0c, 0xf3, 0xcc, 0x3e, 0x7b, 0xcf,
-		0xf1, 0x27, 0xf9, 0xc7, 0x0a, 0xe2, 0x97, 0x74, 0xf9, 0xa4, 0xfe, 0xe8, 0xf1, 0x82, 0x0b, 0x75,
-		0x01, 0xe6, 0x52, 0x47, 0xe3, 0xd6, 0xe4, 0xa7, 0x0a, 0x2c, 0x69, 0xb8, 0xe5, 0x61, 0x7f, 0x3f,
-		0x2a, 0x72, 0x10, 0x6e, 0x7c, 0x05, 0xcf, 0xae, 0x2e, 0xc3, 0x19, 0x31, 0xa9, 0xfc, 0x2c, 0x37,
-		0x60, 0x61, 0xd3, 0xed, 0x3a, 0x44, 0x78, 0xd2, 0x02, 0xba, 0x0c, 0xd0, 0x72, 0xbd, 0x26, 0xbe,
-		0x8f, 0x83, 0xe6, 0x3e, 0xcf, 0xd8, 0xc6, 0x46, 0x54, 0x03, 0xaa, 0x59, 0x50, 0x2e, 0x6c, 0xf7,
-		0x60, 0x04, 0x3b, 0x01, 0xad, 0xe5, 0x32, 0x11, 0x7b, 0x2b, 0x47, 0xc4, 0xb8, 0x17, 0xb2, 0xf5,
-		0xf0, 0x19, 0xc5, 0xc5, 0xeb, 0xb5, 0x1c, 0x56, 0xfd, 0x69, 0x09, 0xe6, 0x35, 0x6c, 0x98, 0x02,
-		0xea, 0x36, 0xe0, 0x54, 0xd4, 0x1d, 0x51, 0xd9, 0x58, 0xce, 0xf3, 0x2d, 0x1e, 0x3e, 0xa3, 0x56,
-		0x97, 0xae, 0x95, 0x85, 0x62, 0xd9, 0x60, 0xae, 0x2c, 0x0a, 0xe6, 0x76, 0xa0, 0x6a, 0x39, 0x64,
-		0x85, 0x75, 0x80, 0x75, 0xec, 0x44, 0x16, 0xac, 0xcf, 0x8e, 0xb2, 0xb9, 0x08, 0xf8, 0x9e, 0x13,
-		0x9a, 0xa2, 0x86, 0x49, 0x04, 0xa3, 0x43, 0x90, 0xd0, 0x9a, 0xf4, 0x10, 0x25, 0x6c, 0x94, 0x0c,
-		0x6c, 0x5b, 0x9f, 0x61, 0x74, 0x01, 0xa6, 0x68, 0x5f, 0x04, 0x5d, 0xc1, 0xca, 0xf7, 0xc3, 0xb4,
-		0x7c, 0x4f, 0xdb, 0x25, 0x9e, 0x1a, 0x7b, 0x98, 0x75, 0xf3, 0xfd, 0x6d, 0x09, 0x16, 0x32, 0xbc,
-		0xe2, 0xd7, 0x71, 0x1c, 0x66, 0x09, 0xed, 0x45, 0xe9, 0x64, 0xf6, 0x02, 0x7d, 0x0f, 0xe6, 0x33,
-		0x48, 0xc3, 0x1c, 0xe1, 0xa0, 0x06, 0x70, 0x36, 0x8d, 0x9d, 0xa6, 0x08, 0x05, 0xec, 0x3a, 0x25,
-		0x62, 0xd7, 0xcf, 0x15, 0x58, 0x78, 0xda, 0xf5, 0xf6, 0xf0, 0xd7, 0x5b, 0xb6, 0xd4, 0x1a, 0x54,
-		0xb3, 0xc7, 0xe4, 0xca, 0xff, 0x65, 0x09, 0x16, 0x1e, 0xe1, 0xaf, 0x3d, 0x0f, 0xfe, 0x77, 0xf4,
-		0xeb, 0x2e, 0x54, 0xb3, 0xbc, 0xe2, 0xfa, 0x25, 0xc0, 0xa1, 0x88, 0x70, 0x7c, 0xae, 0xc0, 0x99,
-		0xc7, 0x6e, 0x60, 0xb5, 0x8e, 0x48, 0xb8, 0xed, 0x1e, 0x60, 0xef, 0x91, 0x41, 0x62, 0xe9, 0x88,
-		0xeb, 0xdf, 0x83, 0xf9, 0x16, 0x9f, 0xd1, 0xdb, 0x74, 0x4a, 0x4f, 0x38, 0x6c, 0x79, 0xfa, 0x91,
-		0x44, 0xc7, 0x7c, 0xb6, 0xd9, 0x56, 0x76, 0xd0, 0x57, 0xcf, 0xc1, 0xd9, 0x1c, 0x0a, 0xb8, 0x50,
-		0x18, 0xb0, 0xf4, 0x00, 0x07, 0x9b, 0x9e, 0xeb, 0xfb, 0xfc, 0x56, 0x12, 0x2f, 0xb7, 0x44, 0xe0,
-		0xa7, 0xa4, 0x02, 0xbf, 0xf3, 0x50, 0x09, 0x0c, 0x6f, 0x0f, 0x07, 0xd1, 0x2d, 0xb3, 0xd7, 0xdc,
-		0x24, 0x1b, 0xe5, 0xf8, 0xd4, 0x5f, 0x94, 0xe1, 0x8c, 0x78, 0x0f, 0xce, 0xcf, 0x36, 0xc1, 0x43,
-		0x4c, 0xc3, 0xee, 0x11, 0x0b, 0x43, 0xf9, 0xf1, 0x1f, 0xc8, 0x1c, 0xc4, 0x5c, 0x74, 0xd4, 0xf9,
-		0xf6, 0xef, 0x1e, 0x51, 0x07, 0x90, 0xbd, 0x61, 0x26, 0x82, 0xd8, 0x10, 0xfa, 0x5c, 0x81, 0xb9,
-		0x16, 0x2d, 0x88, 0xe9, 0x4d, 0xa3, 0xeb, 0xe3, 0xde, 0xb6, 0xcc, 0xde, 0x3d, 0x3a, 0xde, 0xb6,
-		0xac, 0xc6, 0xb6, 0x49, 0x30, 0x26, 0x36, 0x47, 0xad, 0xcc, 0x44, 0xad, 0x03, 0x33, 0x19, 0x2a,
-		0x05, 0xee, 0xe9, 0xbd, 0xa4, 0x7b, 0xba, 0x9e, 0x23, 0x0e, 0x69, 0x9a, 0xf8, 0xe5, 0xc5, 0x7d,
-		0xd4, 0x5a, 0x07, 0x16, 0x72, 0x08, 0x14, 0xec, 0x7b, 0x3b, 0xbe, 0x6f, 0x25, 0x37, 0xdd, 0xfb,
-		0x00, 0x07, 0xbd, 0xe2, 0x22, 0xc5, 0x1b, 0xf7, 0x8a, 0xff, 0x53, 0x81, 0x35, 0x5e, 0xce, 0xcb,
-		0x30, 0x2d, 0x53, 0x87, 0x90, 0x44, 0x66, 0xfd, 0x49, 0x19, 0x7a, 0xce, 0x84, 0x28, 0xea, 0xbb,
-		0x08, 0x73, 0xd5, 0xfd, 0x33, 0x8d, 0x77, 0x5b, 0x4c, 0x06, 0xb1, 0x27, 0x1f, 0xbd, 0x01, 0x93,
-		0x2d, 0xe2, 0x00, 0x3d, 0xc6, 0xcc, 0x97, 0xe2, 0xe5, 0xa7, 0xe4, 0xa0, 0xea, 0xc1, 0x9b, 0x7d,
-		0x9c, 0x35, 0x72, 0x97, 0x86, 0x42, 0x7f, 0xfc, 0x78, 0xd7, 0x4a, 0xa1, 0xd5, 0xf7, 0xe8, 0x37,
-		0x6d, 0xa1, 0x62, 0xd3, 0x97, 0x64, 0x1f, 0xb9, 0x31, 0x35, 0xa0, 0xdf, 0x6d, 0x25, 0xc1, 0x22,
-		0xc7, 0x61, 0xae, 0x57, 0x76, 0x09, 0x13, 0x31, 0x5d, 0xde, 0x47, 0x35, 0xa4, 0xf5, 0x6a, 0x32,
-		0xdb, 0x2c, 0x0b, 0xd3, 0x75, 0x68, 0x5e, 0x3c, 0xfc, 0xea, 0x92, 0xa7, 0x90, 0x58, 0x7e, 0x68,
-		0x92, 0x8f, 0xb2, 0x0c, 0x92, 0xda, 0x80, 0x79, 0xcd, 0x08, 0xb0, 0x6d, 0xb5, 0xad, 0xe0, 0xe3,
-		0x8e, 0x19, 0x4b, 0xe4, 0xad, 0xc3, 0x29, 0xd3, 0x08, 0x0c, 0xce, 0x8c, 0xa5, 0xbc, 0x46, 0xcc,
-		0x3b, 0xce, 0x91, 0x46, 0x17, 0xaa, 0x1f, 0xc1, 0x42, 0x06, 0x15, 0x3f, 0xc0, 0xa0, 0xb8, 0x36,
-		0xfe, 0xa9, 0x0e, 0xc0, 0x9d, 0xd2, 0x3b, 0x4f, 0x1b, 0xe8, 0x0f, 0x15, 0x98, 0x17, 0x7f, 0xd4,
-		0x8e, 0xae, 0x1e, 0xef, 0x5f, 0x28, 0x6a, 0xd7, 0x06, 0x86, 0xe3, 0x67, 0xf9, 0x23, 0x05, 0x16,
-		0x72, 0xfe, 0xf5, 0x00, 0x5d, 0x2b, 0xfa, 0xc7, 0x80, 0x3c, 0x6a, 0xae, 0x0f, 0x0e, 0xc8, 0xc9,
-		0xf9, 0x89, 0x02, 0x2b, 0x45, 0x5f, 0xfe, 0xa3, 0xef, 0x9e, 0xf4, 0x9f, 0x0c, 0x6a, 0x77, 0x4e,
-		0x80, 0x81, 0x53, 0x4a, 0x2e, 0x51, 0xfc, 0x4d, 0xbf, 0xe4, 0x12, 0xa5, 0xff, 0x25, 0x20, 0xb9,
-		0xc4, 0x82, 0x3f, 0x0f, 0xf8, 0x33, 0x05, 0x6a, 0xf9, 0x5f, 0xbe, 0xa3, 0xfc, 0xae, 0xb0, 0xc2,
-		0x7f, 0x04, 0xa8, 0xbd, 0x7f, 0x2c, 0x58, 0x4e, 0xd7, 0x8f, 0x14, 0x58, 0xcc, 0xfd, 0xae, 0x1d,
-		0xdd, 0xc8, 0x45, 0x5d, 0xf4, 0x59, 0x7d, 0xed, 0xe6, 0x71, 0x40, 0x39, 0x51, 0x0e, 0x4c, 0x26,
-		0x3e, 0x78, 0x46, 0x6f, 0xe7, 0x22, 0x13, 0x7d, 0x57, 0x5d, 0xab, 0xf7, 0xbb, 0x9c, 0xef, 0xf7,
-		0xb9, 0x02, 0xa7, 0x05, 0x5f, 0x0d, 0xa3, 0x2b, 0xf2, 0xdb, 0x16, 0x7e, 0xa7, 0x5c, 0x7b, 0x77,
-		0x30, 0x20, 0x4e, 0x42, 0x00, 0x53, 0xa9, 0x8f, 0x68, 0xd1, 0xba, 0xcc, 0xfd, 0x10, 0x54, 0x42,
-		0x6a, 0xef, 0xf4, 0x0f, 0xc0, 0x77, 0x3d, 0x84, 0xe9, 0xf4, 0x97, 0x60, 0x28, 0x1f, 0x4b, 0xce,
-		0xb7, 0x72, 0xb5, 0xcb, 0x03, 0x40, 0xc4, 0xc4, 0x2e, 0xb7, 0xdf, 0x51, 0x22, 0x76, 0x45, 0x5f,
-		0xa3, 0xd4, 0x4e, 0xd0, 0x5e, 0x89, 0xfe, 0x52, 0x81, 0x33, 0xb2, 0x76, 0x48, 0x74, 0xeb, 0x98,
-		0x5d, 0x94, 0x8c, 0xb4, 0x0f, 0x4e, 0xd4, 0x83, 0xc9, 0x59, 0x96, 0xd3, 0x33, 0x28, 0x65, 0x99,
-		0xbc, 0x63, 0x51, 0xca, 0xb2, 0x82, 0x16, 0xc5, 0xd8, 0x3d, 0x0a, 0x1a, 0xb2, 0x0b, 0xef, 0x31,
-		0xbf, 0x15, 0xbe, 0xf0, 0x1e, 0x65, 0xfd, 0xdf, 0xb1, 0x7b, 0x14, 0xb6, 0xed, 0x15, 0xdf, 0xa3,
-		0xac, 0x75, 0xb0, 0xf8, 0x1e, 0xa5, 0xbd, 0x82, 0xf1, 0x7b, 0xcc, 0x76, 0xe6, 0x15, 0xdf, 0x63,
-		0x6e, 0x5f, 0x60, 0xf1, 0x3d, 0xe6, 0x37, 0x02, 0xa2, 0xbf, 0xa0, 0xb9, 0xcd, 0xdc, 0x96, 0x3b,
-		0xf4, 0xfe, 0x40, 0x67, 0x4e, 0x36, 0xfd, 0xd5, 0x6e, 0x1d, 0x0f, 0x38, 0x41, 0x5a, 0x6e, 0xbf,
-		0xa9, 0x94, 0xb4, 0xa2, 0x8e, 0x57, 0x29, 0x69, 0xc5, 0x2d, 0xae, 0x7f, 0xad, 0xc0, 0xb2, 0xbc,
-		0xd1, 0x0c, 0x7d, 0x47, 0xb2, 0x41, 0x1f, 0xdd, 0x76, 0xb5, 0xdb, 0xc7, 0x86, 0xe7, 0x34, 0xfe,
-		0x40, 0x81, 0x6a, 0x5e, 0xbb, 0x21, 0xba, 0x2e, 0xc1, 0x2e, 0xed, 0xab, 0xac, 0xdd, 0x38, 0x06,
-		0x24, 0xa7, 0xe8, 0x0b, 0x05, 0x66, 0x45, 0x4d, 0x6b, 0x28, 0xff, 0xcd, 0x29, 0x69, 0xd1, 0xab,
-		0xbd, 0x37, 0x20, 0x14, 0xa7, 0xe2, 0xaf, 0xe8, 0x9f, 0x4f, 0x49, 0x9a, 0xb2, 0xd0, 0x07, 0x05,
-		0xb2, 0x21, 0xef, 0xa8, 0xab, 0x7d, 0xe7, 0xb8, 0xe0, 0x9c, 0xc0, 0xcf, 0x60, 0x26, 0xd3, 0x9f,
-		0x84, 0x2e, 0x4b, 0x90, 0x8a, 0xdb, 0xc6, 0x6a, 0x1b, 0x83, 0x80, 0xf4, 0xbc, 0x91, 0x54, 0xc7,
-		0x91, 0xc4, 0x1b, 0x11, 0xf7, 0x49, 0x49, 0xbc, 0x91, 0x9c, 0x66, 0x26, 0xf4, 0x02, 0x26, 0xe2,
-		0x1d, 0x20, 0xe8, 0xdb, 0x52, 0x0c, 0xa9, 0x96, 0xa7, 0xda, 0xdb, 0x7d, 0xae, 0x8e, 0x49, 0xa1,
-		0xa8, 0x85, 0x43, 0x22, 0x85, 0x92, 0x2e, 0x14, 0x89, 0x14, 0x4a, 0xfb, 0x44, 0x88, 0xe7, 0x29,
-		0xe8, 0xcc, 0x90, 0x78, 0x9e, 0xf9, 0x6d, 0x1e, 0xb5, 0x77, 0x07, 0x03, 0x8a, 0x3e, 0x55, 0x81,
-		0x5e, 0xa3, 0x03, 0xba, 0x94, 0x8b, 0x23, 0xd3, 0x3d, 0x51, 0x7b, 0xab, 0xaf, 0xb5, 0xbd, 0x6d,
-		0x7a, 0x9d, 0x04, 0x92, 0x6d, 0x32, 0xdd, 0x15, 0x92, 0x6d, 0xb2, 0xad, 0x09, 0x6c, 0x9b, 0xb0,
-		0x11, 0x40, 0xba, 0x4d, 0xaa, 0x7d, 0x41, 0xba, 0x4d, 0xba, 0xb3, 0x80, 0x44, 0x28, 0x89, 0x22,
-		0xbe, 0x24, 0x42, 0x11, 0x35, 0x20, 0x48, 0x22, 0x14, 0x71, 0x6f, 0x00, 0x09, 0x65, 0xc5, 0xc5,
-		0x70, 0x49, 0x28, 0x2b, 0x6d, 0x0a, 0x90, 0x84, 0xb2, 0x05, 0x65, 0x7c, 0xe2, 0xc0, 0xe4, 0xd6,
-		0x9d, 0x25, 0x0e, 0x4c, 0x51, 0x69, 0x5c, 0xe2, 0xc0, 0x14, 0x97, 0xb9, 0x1d, 0x98, 0x4c, 0x54,
-		0x6d, 0x25, 0x17, 0x22, 0x2a, 0x5c, 0x4b, 0x2e, 0x44, 0x58, 0x0c, 0xa6, 0xe6, 0x43, 0x54, 0x61,
-		0x45, 0xb2, 0xf0, 0x2f, 0xb7, 0x76, 0x2c, 0x31, 0x1f, 0xb2, 0x32, 0x2e, 0x89, 0xdf, 0xd2, 0xb5,
-		0x58, 0x49, 0xfc, 0x96, 0x53, 0xf1, 0x95, 0xc4, 0x6f, 0xb9, 0x85, 0xde, 0x00, 0xa6, 0x52, 0x45,
-		0x47, 0xc9, 0x0b, 0x42, 0x5c, 0xca, 0x95, 0xbc, 0x20, 0xf2, 0xea, 0x99, 0x24, 0x5c, 0x4d, 0x15,
-		0xb5, 0x64, 0xe1, 0xaa, 0xb8, 0xcc, 0x27, 0x0b, 0x57, 0x73, 0x2a, 0x66, 0x64, 0xe3, 0x74, 0x11,
-		0x48, 0xb2, 0x71, 0x4e, 0x6d, 0x4d, 0xb2, 0x71, 0x6e, 0x85, 0xe9, 0x0f, 0x14, 0x98, 0x13, 0xd6,
-		0x6d, 0x50, 0xbe, 0xc4, 0xc8, 0x2a, 0x4d, 0xb5, 0xab, 0x83, 0x82, 0xc5, 0xe4, 0x5d, 0x54, 0xf5,
-		0x90, 0xc8, 0xbb, 0xa4, 0x9c, 0x24, 0x91, 0x77, 0x69, 0x81, 0xe8, 0x4b, 0x25, 0xfa, 0xaa, 0x29,
-		0x3f, 0xbd, 0x8e, 0xee, 0x14, 0xc5, 0x1b, 0x85, 0x65, 0x88, 0xda, 0xdd, 0x93, 0xa0, 0x48, 0xa4,
-		0x74, 0xe2, 0xf9, 0x75, 0x79, 0x4a, 0x47, 0x90, 0xc0, 0x97, 0xa7, 0x74, 0x84, 0xa9, 0x7b, 0xa2,
-		0x99, 0xc9, 0xa4, 0xb8, 0x4c, 0x33, 0x85, 0x99, 0x78, 0x99, 0x66, 0x8a, 0xf3, 0xed, 0x77, 0x6f,
-		0xfc, 0xfa, 0xb5, 0x3d, 0x2b, 0xd8, 0xef, 0xee, 0xd6, 0x9b, 0x6e, 0x7b, 0x3d, 0xf1, 0x9f, 0xe4,
-		0xf5, 0x3d, 0xec, 0xb0, 0x3f, 0xa8, 0x8f, 0xfd, 0x43, 0xfe, 0xfb, 0xfc, 0xe7, 0xc1, 0xe5, 0xdd,
-		0x61, 0x3a, 0x77, 0xe5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x55, 0xea, 0x8b, 0xf1, 0x4d, 0x5f,
-		0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x4b, 0x6c, 0x1c, 0x47,
+		0x76, 0x68, 0x52, 0xfc, 0x3d, 0x92, 0x43, 0xb2, 0xc4, 0xcf, 0x68, 0x28, 0x51, 0x64, 0xdb, 0x92,
+		0x69, 0x79, 0x4d, 0x5a, 0x94, 0x2c, 0xc9, 0xff, 0x95, 0x48, 0x49, 0x1e, 0x47, 0x92, 0xa5, 0x26,
+		0x2d, 0xe7, 0xb7, 0xee, 0x6d, 0x4e, 0xd7, 0x90, 0x1d, 0xcd, 0x74, 0x8f, 0xba, 0x7b, 0x48, 0xd1,
+		0x87, 0xc0, 0x81, 0x93, 0x00, 0x59, 0x2c, 0xb2, 0x9b, 0x45, 0x36, 0x08, 0x10, 0x24, 0x40, 0xe0,
+		0x00, 0x8b, 0x35, 0x72, 0x4b, 0x80, 0x1c, 0x82, 0x3d, 0x04, 0xb9, 0xe4, 0x98, 0x6b, 0xee, 0xbb,
+		0x97, 0x20, 0xb9, 0xed, 0x35, 0x40, 0xd0, 0xf5, 0xe9, 0xe9, 0x4f, 0x55, 0x75, 0x0f, 0x19, 0x44,
+		0x5e, 0xaf, 0x6f, 0x9c, 0xaa, 0x7a, 0xaf, 0x5e, 0xbd, 0x7a, 0xef, 0xf5, 0xfb, 0x75, 0x13, 0x2e,
+		0x74, 0x77, 0xb1, 0xbf, 0xde, 0xb0, 0x6c, 0xec, 0x36, 0xf0, 0xfa, 0xbe, 0x13, 0x84, 0x9e, 0x7f,
+		0xb4, 0x7e, 0x70, 0x79, 0x3d, 0xc0, 0xfe, 0x81, 0xd3, 0xc0, 0x6b, 0x1d, 0xdf, 0x0b, 0x3d, 0xb4,
+		0x10, 0x2d, 0x5b, 0x63, 0xcb, 0xd6, 0xd8, 0xb2, 0xb5, 0x83, 0xcb, 0xb5, 0xa5, 0x3d, 0xcf, 0xdb,
+		0x6b, 0xe1, 0x75, 0xb2, 0x6c, 0xb7, 0xdb, 0x5c, 0xb7, 0xbb, 0xbe, 0x15, 0x3a, 0x9e, 0x4b, 0x01,
+		0x6b, 0xe7, 0xb3, 0xf3, 0xa1, 0xd3, 0xc6, 0x41, 0x68, 0xb5, 0x3b, 0x6c, 0x41, 0x0e, 0xc1, 0xa1,
+		0x6f, 0x75, 0x3a, 0xd8, 0x0f, 0xd8, 0xfc, 0x72, 0x8a, 0x40, 0xab, 0xe3, 0x44, 0xc4, 0x35, 0xbc,
+		0x76, 0x3b, 0xde, 0x62, 0x45, 0xb4, 0x82, 0x93, 0xc8, 0xa8, 0x10, 0x2d, 0x79, 0xda, 0xc5, 0xf1,
+		0x02, 0x5d, 0xb4, 0x20, 0xb4, 0x82, 0x27, 0x2d, 0x27, 0x08, 0x55, 0x6b, 0x0e, 0x3d, 0xff, 0x49,
+		0xb3, 0xe5, 0x1d, 0xb2, 0x35, 0x97, 0x44, 0x6b, 0x18, 0x2b, 0xcd, 0xcc, 0xda, 0xd5, 0xa2, 0xb5,
+		0xd8, 0x67, 0x2b, 0x5f, 0x48, 0xaf, 0xb4, 0xdb, 0x8e, 0x4b, 0xb8, 0xd0, 0xea, 0x06, 0x61, 0xd1,
+		0xa2, 0x34, 0x23, 0x56, 0xc4, 0x8b, 0x9e, 0x76, 0x71, 0x97, 0x5d, 0x75, 0xed, 0x25, 0xf1, 0x12,
+		0x1f, 0x77, 0x5a, 0x4e, 0x23, 0x79, 0xb5, 0xe9, 0x9b, 0x09, 0xf6, 0x2d, 0x1f, 0xdb, 0xd1, 0x4a,
+		0xcb, 0xe5, 0xbb, 0xbd, 0x28, 0x59, 0x91, 0xa6, 0xe9, 0x82, 0x64, 0x55, 0x9a, 0x5d, 0xfa, 0xcf,
+		0x87, 0xe1, 0xdc, 0x76, 0x68, 0xf9, 0xe1, 0xc7, 0x6c, 0xfc, 0xf6, 0x33, 0xdc, 0xe8, 0x46, 0xf4,
+		0x18, 0xf8, 0x69, 0x17, 0x07, 0x21, 0xba, 0x07, 0x23, 0x3e, 0xfd, 0xb3, 0xaa, 0x2d, 0x6b, 0xab,
+		0xe3, 0x1b, 0x1b, 0x6b, 0x29, 0xb1, 0xb5, 0x3a, 0xce, 0xda, 0xc1, 0xe5, 0x35, 0x25, 0x12, 0x83,
+		0xa3, 0x40, 0x8b, 0x30, 0x66, 0x7b, 0x6d, 0xcb, 0x71, 0x4d, 0xc7, 0xae, 0x0e, 0x2c, 0x6b, 0xab,
+		0x63, 0xc6, 0x28, 0x1d, 0xa8, 0xdb, 0xe8, 0x77, 0x61, 0xae, 0x63, 0xf9, 0xd8, 0x0d, 0x4d, 0xcc,
+		0x11, 0x98, 0x8e, 0xdb, 0xf4, 0xaa, 0x83, 0x64, 0xe3, 0x55, 0xe1, 0xc6, 0x0f, 0x09, 0x44, 0xbc,
+		0x63, 0xdd, 0x6d, 0x7a, 0xc6, 0xe9, 0x4e, 0x7e, 0x10, 0x55, 0x61, 0xc4, 0x0a, 0x43, 0xdc, 0xee,
+		0x84, 0xd5, 0x53, 0xcb, 0xda, 0xea, 0x90, 0xc1, 0x7f, 0xa2, 0x4d, 0x98, 0xc2, 0xcf, 0x3a, 0x0e,
+		0x55, 0x31, 0x33, 0xd2, 0xa5, 0xea, 0x10, 0xd9, 0xb1, 0xb6, 0x46, 0xf5, 0x68, 0x8d, 0xeb, 0xd1,
+		0xda, 0x0e, 0x57, 0x34, 0xa3, 0xd2, 0x03, 0x89, 0x06, 0x51, 0x13, 0xce, 0x34, 0x3c, 0x37, 0x74,
+		0xdc, 0x2e, 0x36, 0xad, 0xc0, 0x74, 0xf1, 0xa1, 0xe9, 0xb8, 0x4e, 0xe8, 0x58, 0xa1, 0xe7, 0x57,
+		0x87, 0x97, 0xb5, 0xd5, 0xca, 0xc6, 0x2b, 0xc2, 0x03, 0x6c, 0x32, 0xa8, 0x9b, 0xc1, 0x03, 0x7c,
+		0x58, 0xe7, 0x20, 0xc6, 0x7c, 0x43, 0x38, 0x8e, 0xea, 0x30, 0xc3, 0x67, 0x6c, 0xb3, 0x69, 0x39,
+		0xad, 0xae, 0x8f, 0xab, 0x23, 0x84, 0xdc, 0xb3, 0x42, 0xfc, 0x77, 0xe8, 0x1a, 0x63, 0x3a, 0x06,
+		0x63, 0x23, 0xc8, 0x80, 0xf9, 0x96, 0x15, 0x84, 0x66, 0xc3, 0x6b, 0x77, 0x5a, 0x98, 0x1c, 0xde,
+		0xc7, 0x41, 0xb7, 0x15, 0x56, 0x47, 0x15, 0xf8, 0x1e, 0x5a, 0x47, 0x2d, 0xcf, 0xb2, 0x8d, 0xd9,
+		0x08, 0x76, 0x33, 0x06, 0x35, 0x08, 0x24, 0xfa, 0x4d, 0x58, 0x6c, 0x3a, 0x7e, 0x10, 0x9a, 0x36,
+		0x6e, 0x38, 0x01, 0xe1, 0xa7, 0x15, 0x3c, 0x31, 0x77, 0xad, 0xc6, 0x13, 0xaf, 0xd9, 0xac, 0x8e,
+		0x11, 0xc4, 0x67, 0x72, 0x7c, 0xdd, 0x62, 0x06, 0xce, 0xa8, 0x12, 0xe8, 0x2d, 0x06, 0xbc, 0x63,
+		0x05, 0x4f, 0x6e, 0x51, 0x50, 0x74, 0x00, 0xd3, 0x1d, 0xcb, 0x0f, 0x1d, 0x42, 0x67, 0xc3, 0x73,
+		0x9b, 0xce, 0x5e, 0x15, 0x96, 0x07, 0x57, 0xc7, 0x37, 0x7e, 0x63, 0x4d, 0x62, 0x48, 0xd5, 0x52,
+		0x19, 0x89, 0x0e, 0x45, 0xb7, 0x49, 0xb0, 0xdd, 0x76, 0x43, 0xff, 0xc8, 0x98, 0xea, 0xa4, 0x47,
+		0x6b, 0xb7, 0x60, 0x56, 0xb4, 0x10, 0x4d, 0xc3, 0xe0, 0x13, 0x7c, 0x44, 0x94, 0x62, 0xcc, 0x88,
+		0xfe, 0x44, 0xb3, 0x30, 0x74, 0x60, 0xb5, 0xba, 0x98, 0x09, 0x36, 0xfd, 0xf1, 0xe6, 0xc0, 0x0d,
+		0x4d, 0xbf, 0x0e, 0x4b, 0x32, 0x52, 0x82, 0x8e, 0xe7, 0x06, 0x18, 0xcd, 0xc1, 0xb0, 0xdf, 0x25,
+		0x5a, 0x41, 0x11, 0x0e, 0xf9, 0x5d, 0xb7, 0x6e, 0xeb, 0x7f, 0x37, 0x00, 0x4b, 0xdb, 0xce, 0x9e,
+		0x6b, 0xb5, 0xa4, 0x0a, 0x7a, 0x3f, 0xab, 0xa0, 0x57, 0xc4, 0x0a, 0xaa, 0xc4, 0x52, 0x52, 0x43,
+		0x9b, 0xb0, 0x88, 0x9f, 0x85, 0xd8, 0x77, 0xad, 0x56, 0x6c, 0x78, 0x7b, 0xca, 0xca, 0xf4, 0xf4,
+		0xa2, 0x70, 0xff, 0xfc, 0xce, 0x67, 0x38, 0xaa, 0xdc, 0x14, 0x5a, 0x83, 0xd3, 0x8d, 0x7d, 0xa7,
+		0x65, 0xf7, 0x36, 0xf1, 0xdc, 0xd6, 0x11, 0xd1, 0xdb, 0x51, 0x63, 0x86, 0x4c, 0x71, 0xa0, 0x0f,
+		0xdd, 0xd6, 0x91, 0xbe, 0x02, 0xe7, 0xa5, 0xe7, 0xa3, 0x0c, 0xd6, 0xbf, 0xaf, 0xc1, 0xd2, 0x47,
+		0x1d, 0xdb, 0x0a, 0xf1, 0x49, 0x39, 0xa9, 0xc6, 0x52, 0x8e, 0x93, 0xfa, 0x7f, 0x69, 0x70, 0x5e,
+		0x8a, 0x88, 0xc9, 0xc4, 0x22, 0x8c, 0x75, 0xc9, 0x92, 0x9e, 0x58, 0x8c, 0xd2, 0x81, 0xba, 0x8d,
+		0xde, 0x85, 0xa1, 0x20, 0xb4, 0xf6, 0xa8, 0xb0, 0x55, 0x24, 0xc6, 0x91, 0xe3, 0xa6, 0x3b, 0x6d,
+		0x47, 0xeb, 0x0d, 0x0a, 0x86, 0xae, 0xc2, 0x30, 0x53, 0xf6, 0xc1, 0x12, 0xca, 0xce, 0xd6, 0xa2,
+		0x6b, 0x30, 0xc2, 0x6d, 0xce, 0xa9, 0x12, 0x36, 0x87, 0x2f, 0xd6, 0x7f, 0x31, 0x00, 0x2f, 0xb1,
+		0x1b, 0x72, 0xc2, 0x7d, 0xf5, 0x13, 0xe7, 0x71, 0xf6, 0x1a, 0xde, 0x56, 0x09, 0x74, 0x11, 0xba,
+		0x92, 0x92, 0xfd, 0x99, 0x26, 0x30, 0x2f, 0x83, 0xc4, 0xbc, 0x7c, 0x24, 0x37, 0x2f, 0xe5, 0x48,
+		0xf8, 0x7f, 0x34, 0x34, 0x37, 0x61, 0xb5, 0x98, 0x28, 0xb5, 0xc9, 0xf9, 0x9e, 0x06, 0xe7, 0x0c,
+		0x1c, 0xe0, 0x13, 0xbb, 0x04, 0x4a, 0x24, 0x25, 0xd5, 0xe4, 0x3a, 0x2c, 0xc9, 0xd0, 0xa8, 0x4f,
+		0xf1, 0xe5, 0x00, 0xac, 0xec, 0x60, 0xbf, 0xed, 0xb8, 0x2a, 0x8d, 0x7f, 0x98, 0x3d, 0xc9, 0x35,
+		0xe1, 0x49, 0x0a, 0x11, 0xfd, 0x8a, 0x9b, 0xcf, 0x17, 0x41, 0x57, 0x1d, 0x91, 0x59, 0xd0, 0x1f,
+		0x6a, 0xb0, 0xbc, 0x85, 0x83, 0x86, 0xef, 0xec, 0xca, 0x39, 0xfa, 0x61, 0x96, 0xa3, 0xaf, 0x0b,
+		0x8f, 0x53, 0x84, 0xa7, 0xa4, 0x78, 0xfc, 0xec, 0x14, 0xac, 0x28, 0x50, 0x31, 0x11, 0x69, 0xc1,
+		0x42, 0xcf, 0xa1, 0xa4, 0xaa, 0xcd, 0xdc, 0x0d, 0xa5, 0x9d, 0xcf, 0x21, 0xdc, 0x4c, 0x82, 0x1a,
+		0xf3, 0x58, 0x38, 0x8e, 0x76, 0x61, 0x21, 0x7f, 0xb7, 0xd4, 0x8f, 0x1d, 0x20, 0xbb, 0x5d, 0x2a,
+		0xb7, 0x1b, 0xf1, 0x64, 0xe7, 0x0e, 0x45, 0xc3, 0xe8, 0x63, 0x40, 0x1d, 0xec, 0xda, 0x8e, 0xbb,
+		0x67, 0x5a, 0x8d, 0xd0, 0x39, 0x70, 0x42, 0x07, 0x07, 0xcc, 0x5c, 0x49, 0xdc, 0x64, 0xba, 0xfc,
+		0x26, 0x5d, 0x7d, 0x44, 0x90, 0xcf, 0x74, 0x52, 0x83, 0x0e, 0x0e, 0xd0, 0x6f, 0xc1, 0x34, 0x47,
+		0x4c, 0xc4, 0xc4, 0xc7, 0x6e, 0xf5, 0x14, 0x41, 0xbb, 0xa6, 0x42, 0xbb, 0x19, 0xad, 0x4d, 0x53,
+		0x3e, 0xd5, 0x49, 0x4c, 0xf9, 0xd8, 0x45, 0xdb, 0x3d, 0xd4, 0xdc, 0x37, 0x64, 0x6e, 0xb6, 0x92,
+		0x62, 0xee, 0x0a, 0xa6, 0x90, 0xf2, 0x41, 0xf4, 0x0e, 0x40, 0xc7, 0xea, 0x06, 0x98, 0xf2, 0x77,
+		0x98, 0xa0, 0x5b, 0x92, 0x3c, 0xc9, 0xba, 0x01, 0x26, 0x48, 0xc6, 0x3a, 0xfc, 0x4f, 0xfd, 0x19,
+		0xcc, 0x3e, 0x8a, 0x02, 0x56, 0xce, 0x7c, 0x2e, 0xc5, 0x9b, 0x59, 0x29, 0x7e, 0x59, 0x88, 0x53,
+		0x04, 0x5b, 0x52, 0x72, 0xbf, 0xd0, 0x60, 0x2e, 0x03, 0xce, 0xa4, 0xf5, 0x3d, 0x98, 0x20, 0x41,
+		0x34, 0xf7, 0xc5, 0xb5, 0x12, 0x8f, 0xe7, 0x71, 0x02, 0xc1, 0x5c, 0xf0, 0x3a, 0x54, 0x38, 0x82,
+		0xdf, 0xc3, 0x8d, 0x10, 0xdb, 0x4c, 0xee, 0x74, 0xf9, 0x19, 0x0c, 0xb6, 0xd2, 0x98, 0x7c, 0x9a,
+		0xfc, 0xa9, 0xff, 0xa1, 0x06, 0x35, 0x62, 0x7f, 0xb7, 0x43, 0xa7, 0xf1, 0xe4, 0x28, 0x72, 0xc7,
+		0xef, 0x39, 0x41, 0xc8, 0xd9, 0x54, 0xcf, 0xb2, 0x69, 0x5d, 0xfe, 0x20, 0x10, 0x62, 0x28, 0xc9,
+		0xac, 0x73, 0xb0, 0x28, 0xc4, 0xc1, 0x0c, 0xd3, 0xbf, 0x0f, 0xc0, 0xfc, 0x5d, 0x1c, 0xde, 0xef,
+		0x86, 0xd6, 0x6e, 0x2b, 0xf2, 0x72, 0x42, 0x6c, 0x88, 0xd0, 0x6a, 0x19, 0x73, 0xfc, 0x11, 0x20,
+		0x81, 0x15, 0x1e, 0xe8, 0xcb, 0x0a, 0xcf, 0xe4, 0x14, 0x14, 0x5d, 0x81, 0x79, 0xfc, 0xac, 0x43,
+		0x18, 0x68, 0xba, 0xf8, 0x59, 0x68, 0xe2, 0x83, 0x28, 0xa6, 0x75, 0x6c, 0x62, 0xe0, 0x07, 0x8d,
+		0xd3, 0x7c, 0xf6, 0x01, 0x7e, 0x16, 0xde, 0x8e, 0xe6, 0xea, 0x36, 0x7a, 0x0d, 0x66, 0x1b, 0x5d,
+		0x9f, 0x04, 0xbf, 0xbb, 0xbe, 0xe5, 0x36, 0xf6, 0xcd, 0xd0, 0x7b, 0x42, 0x94, 0x4f, 0x5b, 0x9d,
+		0x30, 0x10, 0x9b, 0xbb, 0x45, 0xa6, 0x76, 0xa2, 0x19, 0xf4, 0x3b, 0x30, 0x7b, 0x80, 0x7d, 0x12,
+		0x62, 0x31, 0x97, 0xc4, 0x74, 0x42, 0xdc, 0x66, 0x3a, 0x95, 0x15, 0x58, 0xbb, 0xed, 0xb8, 0xd1,
+		0x09, 0x1e, 0x53, 0x90, 0xf7, 0x29, 0x44, 0x3d, 0xc4, 0x6d, 0x03, 0x1d, 0xe4, 0xc6, 0xf4, 0x7f,
+		0x1a, 0x83, 0x85, 0x1c, 0x4b, 0x99, 0x80, 0x8a, 0xd9, 0xa6, 0x9d, 0x94, 0x6d, 0x77, 0x60, 0x32,
+		0x46, 0x1b, 0x1e, 0x75, 0x30, 0xbb, 0x88, 0x15, 0x25, 0xc6, 0x9d, 0xa3, 0x0e, 0x36, 0x26, 0x0e,
+		0x13, 0xbf, 0x90, 0x0e, 0x93, 0x22, 0xae, 0x8f, 0xbb, 0x09, 0x6e, 0x3f, 0x86, 0x33, 0x1d, 0x1f,
+		0x1f, 0x38, 0x5e, 0x37, 0x30, 0x83, 0xc8, 0x4b, 0xc2, 0x76, 0x6f, 0x3d, 0x75, 0x6c, 0x17, 0x73,
+		0x31, 0x6a, 0xdd, 0x0d, 0xaf, 0x5d, 0x7d, 0x1c, 0xb9, 0x5a, 0xc6, 0x3c, 0x87, 0xde, 0xa6, 0xc0,
+		0x1c, 0xef, 0xab, 0x70, 0x9a, 0x44, 0xd4, 0x34, 0x04, 0x8e, 0x31, 0x0e, 0x11, 0x0a, 0xa6, 0xa3,
+		0xa9, 0x3b, 0xd1, 0x0c, 0x5f, 0xfe, 0x26, 0x8c, 0x91, 0xe8, 0xb8, 0xe5, 0x04, 0x21, 0x33, 0x5e,
+		0xe7, 0xc4, 0x0e, 0x08, 0x17, 0xf9, 0xd1, 0x90, 0xfd, 0x85, 0xee, 0xc2, 0x74, 0x40, 0xd4, 0xc1,
+		0xec, 0xa1, 0x18, 0x29, 0x83, 0xa2, 0x12, 0xa4, 0xb4, 0x08, 0x5d, 0x85, 0xf9, 0x46, 0xcb, 0x89,
+		0x28, 0x6d, 0x39, 0xbb, 0xbe, 0xe5, 0x1f, 0x99, 0x4c, 0x1e, 0x48, 0x16, 0x60, 0xcc, 0x98, 0xa5,
+		0xb3, 0xf7, 0xe8, 0x24, 0x93, 0x9f, 0x04, 0x54, 0x13, 0x5b, 0x61, 0xd7, 0xc7, 0x31, 0xd4, 0x58,
+		0x12, 0xea, 0x0e, 0x9d, 0xe4, 0x50, 0xe7, 0x61, 0x9c, 0x41, 0x39, 0xed, 0x4e, 0xab, 0x0a, 0x64,
+		0x29, 0xd0, 0xa1, 0x7a, 0xbb, 0xd3, 0x42, 0x01, 0x5c, 0xca, 0x9e, 0xca, 0x0c, 0x1a, 0xfb, 0xd8,
+		0xee, 0xb6, 0xb0, 0x19, 0x7a, 0xf4, 0xb2, 0x48, 0x8a, 0xc6, 0xeb, 0x86, 0xd5, 0xf1, 0xa2, 0x6c,
+		0xc2, 0x8b, 0xe9, 0xb3, 0x6e, 0x33, 0x4c, 0x3b, 0x1e, 0xb9, 0xb7, 0x1d, 0x8a, 0x26, 0x72, 0x97,
+		0xe8, 0x55, 0x45, 0xf2, 0xdf, 0x3b, 0xc8, 0x04, 0xc9, 0x12, 0xcd, 0x90, 0xa9, 0xed, 0x68, 0x86,
+		0x9f, 0x42, 0xa6, 0xab, 0x93, 0x52, 0x5d, 0xbd, 0x07, 0x95, 0x58, 0xb6, 0x83, 0x48, 0x99, 0xaa,
+		0x15, 0x12, 0xb5, 0x5d, 0x48, 0x5f, 0x15, 0x4d, 0xd3, 0x25, 0xe5, 0x9b, 0x6a, 0x5e, 0xac, 0x18,
+		0xe4, 0x27, 0x6a, 0xc0, 0x6c, 0x8c, 0xad, 0xd1, 0xf2, 0x02, 0xcc, 0x70, 0x4e, 0x11, 0x9c, 0x97,
+		0x4b, 0x3a, 0x33, 0x11, 0x60, 0x84, 0xaf, 0x1b, 0x18, 0xb1, 0x3e, 0xc7, 0x83, 0x91, 0x96, 0xcf,
+		0xa4, 0xcd, 0x4b, 0xe4, 0x61, 0x4c, 0x8b, 0x9e, 0xd7, 0x3d, 0xaa, 0x53, 0xc6, 0xc5, 0xc1, 0x81,
+		0x31, 0x7d, 0x90, 0x19, 0x41, 0x6f, 0xc3, 0xa2, 0x13, 0xe9, 0x5c, 0xe6, 0x8e, 0xb1, 0x1b, 0xd9,
+		0x19, 0xbb, 0x3a, 0x43, 0x5c, 0xd4, 0x05, 0x27, 0x48, 0x9b, 0xfa, 0xdb, 0x74, 0x1a, 0xad, 0xc0,
+		0x04, 0xb7, 0x75, 0x81, 0xf3, 0x29, 0xae, 0x22, 0xaa, 0xda, 0x6c, 0x6c, 0xdb, 0xf9, 0x14, 0xeb,
+		0xbf, 0xd4, 0x60, 0xe1, 0xa1, 0xd7, 0x6a, 0xfd, 0x7a, 0x3d, 0x0d, 0xf4, 0x9f, 0x8c, 0x42, 0x35,
+		0x7f, 0xec, 0x6f, 0x2c, 0xf6, 0x37, 0x16, 0xfb, 0xeb, 0x68, 0xb1, 0x65, 0xfa, 0x31, 0x21, 0xb5,
+		0xc0, 0x42, 0x73, 0x36, 0x79, 0x62, 0x73, 0xf6, 0xab, 0x67, 0xd8, 0xf5, 0x7f, 0x1d, 0x80, 0x65,
+		0x03, 0x37, 0x3c, 0xdf, 0x4e, 0x66, 0xd9, 0x99, 0x5a, 0x3c, 0x4f, 0x4b, 0x79, 0x1e, 0xc6, 0x63,
+		0xc1, 0x89, 0x8d, 0x00, 0xf0, 0xa1, 0xba, 0x8d, 0x16, 0x60, 0x84, 0xc8, 0x18, 0xd3, 0xf8, 0x41,
+		0x63, 0x38, 0xfa, 0x59, 0xb7, 0xd1, 0x39, 0x00, 0x16, 0x47, 0x70, 0xdd, 0x1d, 0x33, 0xc6, 0xd8,
+		0x48, 0xdd, 0x46, 0x06, 0x4c, 0x74, 0xbc, 0x56, 0xcb, 0xe4, 0xb1, 0xca, 0xb0, 0x22, 0x56, 0x89,
+		0x6c, 0xe8, 0x1d, 0xcf, 0x4f, 0xb2, 0x86, 0xc7, 0x2a, 0xe3, 0x11, 0x12, 0xf6, 0x43, 0xff, 0x97,
+		0x51, 0x58, 0x51, 0x70, 0x91, 0x19, 0xde, 0x9c, 0x85, 0xd4, 0x8e, 0x67, 0x21, 0x95, 0xd6, 0x6f,
+		0xe0, 0xf8, 0xd6, 0xef, 0x5b, 0x80, 0x38, 0x7f, 0xed, 0xac, 0xf9, 0x9d, 0x8e, 0x67, 0xf8, 0xea,
+		0xd5, 0xc8, 0x80, 0x09, 0x4c, 0xef, 0x60, 0x64, 0xa1, 0x52, 0x78, 0x73, 0x16, 0x7d, 0x28, 0x6f,
+		0xd1, 0x13, 0xf5, 0xb8, 0xe1, 0x74, 0x3d, 0xee, 0x06, 0x54, 0x99, 0x49, 0xe9, 0xe5, 0x4f, 0xb8,
+		0x83, 0x30, 0x42, 0x1c, 0x84, 0x79, 0x3a, 0x1f, 0xcb, 0x0e, 0xf7, 0x0f, 0x0c, 0x98, 0x8c, 0xeb,
+		0x4e, 0x24, 0x23, 0x40, 0x0b, 0x59, 0xaf, 0xca, 0xb4, 0x71, 0xc7, 0xb7, 0xdc, 0x20, 0x32, 0x65,
+		0xa9, 0x2c, 0xc3, 0x84, 0x9d, 0xf8, 0x85, 0x3e, 0x81, 0xb3, 0x82, 0x7c, 0x4e, 0xcf, 0x84, 0x8f,
+		0x95, 0x31, 0xe1, 0x67, 0x72, 0xe2, 0x1e, 0x5b, 0x73, 0x89, 0xf7, 0x09, 0x32, 0xef, 0x73, 0x05,
+		0x26, 0x52, 0x36, 0x6f, 0x9c, 0xd8, 0xbc, 0xf1, 0xdd, 0x84, 0xb1, 0xbb, 0x09, 0x95, 0xde, 0xb5,
+		0x92, 0x7a, 0xe6, 0x44, 0x61, 0x3d, 0x73, 0x32, 0x86, 0x20, 0xe5, 0xcc, 0x77, 0x60, 0x82, 0xdf,
+		0x35, 0x41, 0x30, 0x59, 0x88, 0x60, 0x9c, 0xad, 0x27, 0xe0, 0x16, 0x8c, 0x3c, 0xed, 0x62, 0x62,
+		0x64, 0x2b, 0x24, 0x7d, 0x74, 0x57, 0x9a, 0x44, 0x2f, 0xd4, 0x22, 0x92, 0xa2, 0x70, 0x70, 0x40,
+		0xd3, 0xe6, 0x1c, 0x6f, 0xce, 0x17, 0x9c, 0xca, 0xf9, 0x82, 0xe8, 0x1d, 0x18, 0xa1, 0xf5, 0x92,
+		0xc8, 0x73, 0x8d, 0xa8, 0x78, 0xa1, 0x44, 0x95, 0xc4, 0xe0, 0x30, 0xb5, 0x4f, 0x60, 0x22, 0xb9,
+		0xb5, 0x20, 0x11, 0x7f, 0x23, 0x99, 0x88, 0x97, 0x65, 0x58, 0x38, 0x7a, 0x9a, 0x69, 0x49, 0x24,
+		0xeb, 0x7b, 0x96, 0x98, 0xa7, 0xe5, 0xbe, 0xb1, 0xc4, 0x39, 0x4b, 0x9c, 0x64, 0x8d, 0xd0, 0x12,
+		0xff, 0x7c, 0x90, 0x5b, 0x62, 0x21, 0x17, 0x99, 0x25, 0xfe, 0x00, 0xa6, 0x32, 0x96, 0x4e, 0x69,
+		0x8b, 0x59, 0x2e, 0x84, 0xd8, 0x2a, 0xa3, 0x92, 0xb6, 0x84, 0x39, 0xdd, 0x18, 0xe8, 0x4f, 0x37,
+		0x12, 0x86, 0x6f, 0x30, 0x6d, 0xf8, 0x3e, 0x81, 0xa5, 0xb4, 0xde, 0x9a, 0x5e, 0xd3, 0x0c, 0xf7,
+		0x9d, 0xc0, 0x4c, 0x76, 0x2e, 0xa8, 0xb7, 0xaa, 0xa5, 0xf4, 0xf8, 0xc3, 0xe6, 0xce, 0xbe, 0x13,
+		0xdc, 0x64, 0xf8, 0xeb, 0x30, 0xb3, 0x8f, 0x2d, 0x3f, 0xdc, 0xc5, 0x56, 0x68, 0xda, 0x38, 0xb4,
+		0x9c, 0x56, 0xc0, 0xf2, 0x45, 0xea, 0xfc, 0xe2, 0x74, 0x0c, 0xb6, 0x45, 0xa1, 0xf2, 0x4f, 0xb6,
+		0xe1, 0xe3, 0x3d, 0xd9, 0x5e, 0x82, 0xa9, 0x18, 0x0f, 0x15, 0x6b, 0x62, 0xe2, 0xc7, 0x8c, 0xd8,
+		0xaf, 0xda, 0x22, 0xa3, 0xfa, 0x5f, 0x68, 0xf0, 0x02, 0xbd, 0xcd, 0x94, 0xad, 0x60, 0x0d, 0x08,
+		0x3d, 0x7d, 0x31, 0xb2, 0x39, 0xc9, 0x1b, 0xb2, 0x9c, 0x64, 0x11, 0xaa, 0x92, 0xc9, 0xc9, 0x7f,
+		0x18, 0x84, 0x17, 0xd5, 0xd8, 0x98, 0x08, 0xe2, 0xde, 0xe3, 0xd3, 0x67, 0x63, 0x8c, 0xc4, 0x37,
+		0x8f, 0x6f, 0x1c, 0x8d, 0xa9, 0x20, 0x23, 0xe9, 0x5f, 0x68, 0xb0, 0xd4, 0x2b, 0x0a, 0x44, 0x2e,
+		0xb8, 0xed, 0x04, 0x1d, 0x2b, 0x6c, 0xec, 0x9b, 0x2d, 0xaf, 0x61, 0xb5, 0x5a, 0x47, 0xd5, 0x01,
+		0x62, 0x0c, 0x3f, 0x51, 0xec, 0x5a, 0x7c, 0x9c, 0xb5, 0x5e, 0xd5, 0x60, 0xc7, 0xdb, 0x62, 0x3b,
+		0xdc, 0xa3, 0x1b, 0x50, 0x4b, 0xbd, 0x68, 0xc9, 0x57, 0xd4, 0x7e, 0x1f, 0x96, 0x8b, 0x10, 0x08,
+		0xec, 0xed, 0x56, 0xda, 0xde, 0x8a, 0x6b, 0x12, 0xdc, 0x0c, 0x10, 0x5c, 0x1c, 0x31, 0x79, 0xb0,
+		0x27, 0x6c, 0xef, 0x0f, 0xb5, 0xc8, 0xf6, 0xe6, 0x8e, 0x79, 0xc7, 0x72, 0x5a, 0x3d, 0x59, 0x2a,
+		0x59, 0xcc, 0x2a, 0xc2, 0x53, 0x52, 0x90, 0x5e, 0x88, 0xec, 0x98, 0x14, 0x13, 0xcb, 0x75, 0xff,
+		0xb9, 0x06, 0x7a, 0xde, 0xda, 0xbd, 0xcf, 0xd5, 0x93, 0x53, 0xfe, 0x28, 0x4b, 0xf9, 0x75, 0x09,
+		0xe5, 0x45, 0x98, 0x4a, 0xd2, 0xfe, 0x30, 0x52, 0x4e, 0x05, 0x2e, 0x26, 0x9b, 0x2f, 0xc3, 0x74,
+		0xc3, 0x72, 0x1b, 0x38, 0x7e, 0x02, 0x60, 0xfa, 0x4c, 0x1b, 0x35, 0xa6, 0xe8, 0xb8, 0xc1, 0x87,
+		0x93, 0xfa, 0x9e, 0xc4, 0x79, 0x42, 0x7d, 0x57, 0xa1, 0x2a, 0x79, 0xd4, 0x8b, 0xb1, 0xba, 0x4b,
+		0x90, 0x25, 0xca, 0xa5, 0x82, 0x85, 0x27, 0x91, 0x30, 0x29, 0x9e, 0xbe, 0x25, 0x4c, 0x84, 0x29,
+		0x25, 0x61, 0xf9, 0x03, 0x92, 0xfb, 0xe9, 0x51, 0x5e, 0x5a, 0xc2, 0x8a, 0x30, 0x95, 0xa4, 0xfd,
+		0x82, 0x58, 0x1c, 0x62, 0x5c, 0x8c, 0xfa, 0x7f, 0xd4, 0xe0, 0xbc, 0x81, 0xdb, 0xde, 0x01, 0xa6,
+		0x7d, 0x10, 0x5f, 0x95, 0x34, 0x60, 0xda, 0x31, 0x1a, 0xcc, 0x38, 0x46, 0xba, 0x1e, 0xc9, 0x8a,
+		0x8c, 0x6a, 0x76, 0xb4, 0x7f, 0x1e, 0x80, 0x0b, 0xec, 0x08, 0xf4, 0xd8, 0xd2, 0x22, 0xbc, 0xf2,
+		0x80, 0x16, 0x54, 0xd2, 0x3a, 0xc8, 0x0e, 0xf7, 0xa6, 0xe4, 0xfe, 0x4a, 0x6c, 0x68, 0x4c, 0xa6,
+		0xb4, 0x17, 0xed, 0xc2, 0x42, 0xdc, 0xe7, 0x20, 0x6c, 0xe5, 0x14, 0x97, 0xc0, 0x6f, 0x33, 0x98,
+		0x4c, 0x09, 0x1c, 0x8b, 0x86, 0xfb, 0xee, 0x71, 0x58, 0x85, 0x8b, 0x45, 0x67, 0x61, 0x7c, 0xfe,
+		0x99, 0x06, 0x8b, 0x3c, 0xef, 0x24, 0xc8, 0x03, 0x3c, 0x17, 0xf1, 0xb9, 0x04, 0x33, 0x4e, 0x60,
+		0xa6, 0x3b, 0x2b, 0x09, 0x2f, 0x47, 0x8d, 0x29, 0x27, 0xb8, 0x93, 0xec, 0x99, 0xd4, 0x97, 0xe0,
+		0xac, 0x98, 0x7c, 0x76, 0xbe, 0xcf, 0x89, 0xc3, 0x12, 0x19, 0xeb, 0x74, 0xd9, 0x3e, 0x67, 0x5a,
+		0x9f, 0xc7, 0x41, 0x57, 0x60, 0x82, 0xb5, 0xcd, 0x62, 0x3b, 0x91, 0x0a, 0x8e, 0xc7, 0xea, 0x36,
+		0xfa, 0x18, 0x4e, 0x37, 0x38, 0xa9, 0x89, 0xad, 0x4f, 0xf5, 0xb5, 0x35, 0x8a, 0x51, 0xf4, 0xf6,
+		0xbe, 0x07, 0xd3, 0x89, 0x56, 0x58, 0x1a, 0x24, 0x0c, 0x95, 0x0d, 0x12, 0xa6, 0x7a, 0xa0, 0x34,
+		0x4a, 0x38, 0x07, 0xc0, 0xdd, 0x3d, 0xc7, 0x26, 0xee, 0xf1, 0xa0, 0x31, 0xc6, 0x46, 0xea, 0xb6,
+		0xfe, 0x52, 0xa4, 0xcc, 0xca, 0x4b, 0x60, 0xd7, 0xf5, 0x47, 0x83, 0x50, 0x35, 0x58, 0x9f, 0x38,
+		0x26, 0xa8, 0x83, 0xc7, 0x1b, 0xcf, 0xf3, 0x8a, 0xbe, 0x03, 0x73, 0xa2, 0xc2, 0x33, 0xef, 0x3f,
+		0xe9, 0xa3, 0xf2, 0x7c, 0x3a, 0x5f, 0x79, 0x0e, 0xd0, 0xeb, 0x30, 0x4c, 0x58, 0x1f, 0xb0, 0x1b,
+		0x15, 0x67, 0x56, 0xb6, 0xac, 0xd0, 0xba, 0xd5, 0xf2, 0x76, 0x0d, 0xb6, 0x18, 0x6d, 0x42, 0xc5,
+		0xc5, 0x87, 0xa6, 0xdf, 0x65, 0x37, 0xc7, 0x03, 0x9b, 0x02, 0xf0, 0x09, 0x17, 0x1f, 0x1a, 0x5d,
+		0x7a, 0x65, 0x01, 0xaa, 0xc1, 0xa8, 0x8f, 0x49, 0x1e, 0x86, 0xde, 0xd8, 0xa8, 0x11, 0xff, 0xd6,
+		0x17, 0xe1, 0x8c, 0xe0, 0x1a, 0xd8, 0x25, 0x7d, 0x4f, 0x83, 0xf9, 0xed, 0x23, 0xb7, 0xb1, 0xbd,
+		0x6f, 0xf9, 0x36, 0x4b, 0xbe, 0xb2, 0x2b, 0xba, 0x00, 0x95, 0xc0, 0xeb, 0xfa, 0x0d, 0x6c, 0xb2,
+		0x57, 0x0b, 0xd8, 0x3d, 0x4d, 0xd2, 0xd1, 0x4d, 0x3a, 0x88, 0xce, 0xc0, 0x68, 0x10, 0x01, 0xf3,
+		0x67, 0xdf, 0x90, 0x31, 0x42, 0x7e, 0xd7, 0x6d, 0xb4, 0x06, 0xa7, 0x48, 0x9c, 0x39, 0x58, 0x18,
+		0xfc, 0x91, 0x75, 0xfa, 0x19, 0x58, 0xc8, 0xd1, 0xc2, 0xe8, 0xfc, 0xb7, 0x21, 0x38, 0x1d, 0xcd,
+		0xf1, 0x67, 0xe8, 0xf3, 0x94, 0xa3, 0x2a, 0x8c, 0xf0, 0x64, 0x17, 0xd5, 0x72, 0xfe, 0x33, 0x32,
+		0x02, 0xbd, 0x38, 0x38, 0xce, 0x31, 0xc4, 0x39, 0x89, 0x88, 0x27, 0xf9, 0x14, 0xd7, 0x50, 0xbf,
+		0x29, 0x2e, 0xb5, 0x82, 0xe6, 0xa2, 0xfc, 0x91, 0xfe, 0xa2, 0xfc, 0x0f, 0x58, 0x61, 0xa9, 0x17,
+		0x70, 0x13, 0x2c, 0xa3, 0x85, 0x58, 0x66, 0x22, 0xb0, 0xd8, 0x75, 0x26, 0xb8, 0xae, 0xc1, 0x08,
+		0x8f, 0xd6, 0xc7, 0x4a, 0x44, 0xeb, 0x7c, 0x71, 0x32, 0xd3, 0x00, 0xe9, 0x4c, 0xc3, 0x7b, 0x30,
+		0x41, 0xcb, 0x5e, 0xac, 0x99, 0x77, 0xbc, 0x44, 0x33, 0xef, 0x38, 0xa9, 0x86, 0xb1, 0x77, 0x07,
+		0x5e, 0x03, 0xd2, 0xff, 0xcf, 0x5e, 0xa9, 0x31, 0x1d, 0x1b, 0xbb, 0xa1, 0x13, 0x1e, 0x91, 0x44,
+		0xe3, 0x98, 0x81, 0xa2, 0xb9, 0x8f, 0xc9, 0x54, 0x9d, 0xcd, 0xa0, 0x07, 0x30, 0x95, 0x31, 0x1b,
+		0x2c, 0xa9, 0x78, 0xa1, 0x94, 0xc1, 0x30, 0x2a, 0x69, 0x63, 0xa1, 0xcf, 0xc3, 0x6c, 0x5a, 0x92,
+		0x99, 0x88, 0xff, 0x99, 0x06, 0x8b, 0xbc, 0x27, 0xf0, 0x2b, 0xe2, 0xfd, 0xe9, 0x7f, 0xaa, 0xc1,
+		0x59, 0x31, 0x4d, 0x2c, 0x30, 0xba, 0x02, 0xf3, 0x6d, 0x3a, 0x4e, 0x4b, 0x3e, 0xa6, 0xe3, 0x9a,
+		0x0d, 0xab, 0xb1, 0x8f, 0x19, 0x85, 0xa7, 0xdb, 0x09, 0xa8, 0xba, 0xbb, 0x19, 0x4d, 0xa1, 0x37,
+		0xe0, 0x4c, 0x0e, 0xc8, 0xb6, 0x42, 0x6b, 0xd7, 0x0a, 0x78, 0x6b, 0xf0, 0x7c, 0x1a, 0x6e, 0x8b,
+		0xcd, 0xea, 0x67, 0xa1, 0xc6, 0xe9, 0x61, 0xfc, 0x7c, 0xdf, 0x8b, 0xbb, 0xb2, 0xf4, 0x3f, 0x18,
+		0xe8, 0xb1, 0x30, 0x35, 0xcd, 0xa8, 0x5d, 0x85, 0x69, 0xb7, 0xdb, 0xde, 0xc5, 0xbe, 0xe9, 0x35,
+		0x4d, 0x62, 0xa5, 0x02, 0x42, 0xe7, 0x90, 0x51, 0xa1, 0xe3, 0x1f, 0x36, 0x89, 0xf1, 0x09, 0x22,
+		0x66, 0x73, 0xab, 0x16, 0x90, 0xb4, 0xc3, 0x90, 0x31, 0xca, 0xcc, 0x5a, 0x80, 0xea, 0x30, 0xc1,
+		0x6e, 0x82, 0x1e, 0x55, 0xdc, 0xff, 0xca, 0xc5, 0x81, 0xe6, 0x81, 0xc8, 0xc9, 0x89, 0x5f, 0x38,
+		0x6e, 0xf7, 0x06, 0xd0, 0x35, 0x58, 0xa0, 0xfb, 0x34, 0x3c, 0x37, 0xf4, 0xbd, 0x56, 0x0b, 0xfb,
+		0x84, 0x27, 0x5d, 0xfa, 0x14, 0x19, 0x33, 0xe6, 0xc8, 0xf4, 0x66, 0x3c, 0x4b, 0xed, 0x22, 0xd1,
+		0x10, 0xdb, 0xf6, 0x71, 0x10, 0xb0, 0x64, 0x25, 0xff, 0xa9, 0xaf, 0xc1, 0x0c, 0x2d, 0x9a, 0x45,
+		0x70, 0x5c, 0x76, 0x92, 0x46, 0x5a, 0x4b, 0x19, 0x69, 0x7d, 0x16, 0x50, 0x72, 0x3d, 0x13, 0xc6,
+		0xff, 0xd6, 0x60, 0x86, 0x3a, 0xf6, 0x49, 0x0f, 0x52, 0x8e, 0x06, 0xbd, 0xcd, 0x0a, 0xcc, 0x71,
+		0x3d, 0xbd, 0xb2, 0x71, 0x5e, 0xc2, 0x90, 0x08, 0x23, 0xc9, 0xa8, 0x91, 0x12, 0x33, 0xc9, 0xa6,
+		0x25, 0xf2, 0xb2, 0x83, 0xa9, 0xbc, 0xec, 0x26, 0x4c, 0x1d, 0x38, 0x81, 0xb3, 0xeb, 0xb4, 0x9c,
+		0xf0, 0x88, 0x5a, 0xa2, 0xe2, 0x54, 0x62, 0xa5, 0x07, 0x42, 0xcc, 0xd0, 0x0a, 0x4c, 0xb0, 0x47,
+		0x98, 0xe9, 0x5a, 0xcc, 0xe2, 0x8e, 0x19, 0xe3, 0x6c, 0xec, 0x81, 0xd5, 0xc6, 0x11, 0x17, 0x92,
+		0xc7, 0x65, 0x5c, 0xf8, 0x01, 0xe1, 0x42, 0x80, 0xc3, 0x47, 0x5d, 0xdc, 0xc5, 0x25, 0xb8, 0x90,
+		0xdd, 0x69, 0x20, 0xb7, 0x53, 0x9a, 0x51, 0x83, 0x7d, 0x32, 0x8a, 0xd2, 0xd9, 0x23, 0x88, 0xd1,
+		0xf9, 0x23, 0x0d, 0x66, 0xb9, 0xdc, 0x7f, 0x65, 0x48, 0xfd, 0x10, 0xe6, 0x32, 0x34, 0x31, 0x2d,
+		0xbc, 0x06, 0x0b, 0x1d, 0xdf, 0x6b, 0xe0, 0x20, 0x70, 0xdc, 0x3d, 0x93, 0xbc, 0x6d, 0x48, 0xed,
+		0x40, 0xa4, 0x8c, 0x83, 0x91, 0xcc, 0xf7, 0xa6, 0x09, 0x24, 0x31, 0x02, 0x81, 0xfe, 0xb9, 0x06,
+		0xe7, 0xee, 0xe2, 0xd0, 0xe8, 0xbd, 0x7b, 0x78, 0x1f, 0x07, 0x81, 0xb5, 0x87, 0x63, 0x97, 0xe5,
+		0x3d, 0x18, 0x26, 0xb5, 0x25, 0x8a, 0x68, 0x7c, 0xe3, 0x25, 0x09, 0xb5, 0x09, 0x14, 0xa4, 0xf0,
+		0x64, 0x30, 0xb0, 0x12, 0x4c, 0x89, 0x6c, 0xcc, 0x92, 0x8c, 0x0a, 0x76, 0xc0, 0xa7, 0x50, 0xa1,
+		0x5c, 0x6f, 0xb3, 0x19, 0x46, 0xce, 0x07, 0xd2, 0xc4, 0xa5, 0x1a, 0xe1, 0x1a, 0xd1, 0x4d, 0x3e,
+		0x4a, 0x93, 0x94, 0x93, 0x41, 0x72, 0xac, 0xd6, 0x02, 0x94, 0x5f, 0x94, 0x4c, 0x44, 0x0e, 0xd1,
+		0x44, 0xe4, 0xb7, 0xd3, 0x89, 0xc8, 0x4b, 0xc5, 0x0c, 0x8a, 0x89, 0x49, 0x24, 0x21, 0xdb, 0xb0,
+		0x7c, 0x17, 0x87, 0x5b, 0xf7, 0x1e, 0x29, 0xee, 0xa2, 0x0e, 0x40, 0x55, 0xda, 0x6d, 0x7a, 0x9c,
+		0x01, 0x25, 0xb6, 0x8b, 0x04, 0x89, 0x76, 0x3b, 0x87, 0xec, 0xaf, 0x40, 0x7f, 0x06, 0x2b, 0x8a,
+		0xed, 0x18, 0xd3, 0xb7, 0x61, 0x26, 0xf1, 0x56, 0x2a, 0xa9, 0x73, 0xf2, 0x6d, 0x2f, 0x96, 0xdb,
+		0xd6, 0x98, 0xf6, 0xd3, 0x03, 0x81, 0xfe, 0x1f, 0x1a, 0xcc, 0x1a, 0xd8, 0xea, 0x74, 0x5a, 0x34,
+		0x5a, 0x8a, 0x4f, 0x37, 0x0f, 0xc3, 0x2c, 0xeb, 0x4f, 0x9f, 0x73, 0xec, 0x97, 0xfa, 0x35, 0x0a,
+		0xf1, 0x43, 0x7a, 0xf0, 0xa4, 0xfe, 0xe8, 0xf1, 0x02, 0x0f, 0x7d, 0x01, 0xe6, 0x32, 0x47, 0x63,
+		0xd6, 0xe4, 0xa7, 0x1a, 0x2c, 0x1a, 0xb8, 0xe9, 0xe3, 0x60, 0x3f, 0x2e, 0x80, 0x44, 0xdc, 0xf8,
+		0x0a, 0x9e, 0x5d, 0x5f, 0x82, 0xb3, 0x62, 0x52, 0xd9, 0x59, 0xbe, 0x18, 0x80, 0x0b, 0xe9, 0xd7,
+		0xd5, 0xb6, 0xb1, 0xe5, 0x37, 0xf6, 0x6f, 0x86, 0xa1, 0xef, 0xec, 0x76, 0xc3, 0x9e, 0xbc, 0x3e,
+		0x8f, 0x48, 0xc2, 0x80, 0x99, 0x80, 0x90, 0x63, 0x5a, 0x31, 0x3d, 0x8c, 0x27, 0x17, 0xc4, 0xef,
+		0x8e, 0x65, 0x89, 0x9f, 0x0e, 0x32, 0x23, 0xd1, 0xed, 0xf8, 0xd8, 0x0a, 0x58, 0x62, 0x61, 0xcc,
+		0x60, 0xbf, 0xa2, 0x10, 0x31, 0x76, 0x76, 0xe9, 0x03, 0x30, 0xfe, 0xad, 0xaf, 0xc2, 0xc5, 0x22,
+		0x26, 0x31, 0x7e, 0xfe, 0x58, 0x83, 0x73, 0xe4, 0x8d, 0x84, 0xe3, 0xe5, 0xf0, 0x3e, 0x86, 0x49,
+		0xfa, 0xda, 0x43, 0x3a, 0x85, 0xb7, 0x21, 0x7f, 0xf3, 0x41, 0x9a, 0xba, 0x9b, 0x20, 0x88, 0xb8,
+		0xe7, 0xb7, 0x0c, 0x4b, 0xb2, 0xe5, 0x8c, 0xf2, 0xbf, 0xd6, 0xe0, 0xfc, 0x47, 0x6e, 0xe7, 0xf8,
+		0xb4, 0x7f, 0x07, 0xa6, 0xba, 0xae, 0x88, 0xfa, 0xab, 0xe2, 0xb7, 0x2d, 0xd5, 0x7b, 0x19, 0x15,
+		0x86, 0x8c, 0x9f, 0x40, 0x87, 0x65, 0x39, 0x08, 0xcf, 0x80, 0x11, 0x73, 0x14, 0xe0, 0xb0, 0xaf,
+		0x30, 0xf8, 0x01, 0x4c, 0xfa, 0x11, 0x50, 0x86, 0xec, 0x97, 0xe5, 0xef, 0x3c, 0x64, 0xd0, 0x1b,
+		0x13, 0x04, 0x9e, 0x53, 0x4a, 0x0c, 0x47, 0x6a, 0x15, 0x23, 0xef, 0x0d, 0x58, 0xd8, 0xf4, 0xba,
+		0x6e, 0x64, 0xa9, 0xb3, 0x4f, 0x83, 0x25, 0x80, 0xa6, 0xe7, 0x37, 0xf0, 0x1d, 0x1c, 0x36, 0xf6,
+		0x59, 0xe9, 0x24, 0x31, 0xa2, 0x5b, 0x50, 0xcd, 0x83, 0x32, 0xcb, 0x7e, 0x1b, 0x46, 0xb0, 0x1b,
+		0x92, 0x9e, 0x0c, 0x6a, 0xcf, 0x5f, 0x91, 0xd8, 0x73, 0xe6, 0xf2, 0x6f, 0xdd, 0x7b, 0x44, 0x70,
+		0xb1, 0xbe, 0x0b, 0x06, 0xab, 0xff, 0x74, 0x00, 0xe6, 0x0d, 0x6c, 0xd9, 0x02, 0xea, 0x36, 0xe0,
+		0x54, 0xdc, 0xe5, 0x54, 0xc9, 0xbd, 0x87, 0x13, 0x3b, 0xf2, 0xf7, 0x1e, 0x11, 0x17, 0x87, 0xac,
+		0x55, 0xe5, 0x3d, 0xf2, 0x99, 0x93, 0x41, 0x51, 0xe6, 0x64, 0x07, 0xaa, 0x8e, 0x1b, 0xad, 0x70,
+		0x0e, 0xb0, 0x89, 0xdd, 0xd8, 0x5d, 0x28, 0xd9, 0x19, 0x3a, 0x17, 0x03, 0xdf, 0x76, 0xf9, 0x73,
+		0xbf, 0x6e, 0x47, 0xa2, 0xd0, 0x89, 0x90, 0x90, 0xde, 0x92, 0x21, 0x42, 0xd8, 0x68, 0x34, 0x40,
+		0x1a, 0x4b, 0x2e, 0xc2, 0x14, 0xe9, 0x6f, 0x22, 0x2b, 0x68, 0x1b, 0xce, 0x30, 0x69, 0xc3, 0x21,
+		0x6d, 0x4f, 0x0f, 0xad, 0x3d, 0x4c, 0xbb, 0x72, 0xff, 0x7e, 0x00, 0x16, 0x72, 0xbc, 0x62, 0xd7,
+		0x71, 0x1c, 0x66, 0x09, 0x1f, 0xce, 0x03, 0x27, 0x7b, 0x38, 0xa3, 0xef, 0xc2, 0x7c, 0x0e, 0x29,
+		0x4f, 0xd6, 0xf7, 0xeb, 0x6d, 0xcc, 0x66, 0xb1, 0x93, 0x5c, 0xbd, 0x80, 0x5d, 0xa7, 0x44, 0xec,
+		0xfa, 0x85, 0x06, 0x0b, 0x0f, 0xbb, 0xfe, 0x1e, 0xfe, 0x7a, 0xcb, 0x96, 0x5e, 0x83, 0x6a, 0xfe,
+		0x98, 0x4c, 0xf9, 0xbf, 0x1c, 0x80, 0x85, 0xfb, 0xf8, 0x6b, 0xcf, 0x83, 0xff, 0x1b, 0xfd, 0xba,
+		0x05, 0xd5, 0x3c, 0xaf, 0x98, 0x7e, 0x09, 0x70, 0x68, 0x22, 0x1c, 0x9f, 0x69, 0x70, 0xf6, 0x81,
+		0x17, 0x3a, 0xcd, 0xa3, 0x3b, 0x96, 0xd3, 0xf2, 0x0e, 0xb0, 0x7f, 0xdf, 0xf2, 0x9f, 0x60, 0x3f,
+		0xe6, 0xfa, 0x77, 0x61, 0xbe, 0xc9, 0x66, 0xcc, 0x36, 0x99, 0x32, 0x53, 0xd1, 0x91, 0x4c, 0x3f,
+		0xd2, 0xe8, 0x68, 0x80, 0x34, 0xdb, 0xcc, 0x0f, 0x06, 0xfa, 0x79, 0x38, 0x27, 0xa1, 0x80, 0x09,
+		0x85, 0x05, 0x8b, 0x77, 0x71, 0xb8, 0xe9, 0x7b, 0x41, 0xc0, 0x6e, 0x25, 0xe5, 0x49, 0xa6, 0xb2,
+		0x2c, 0x5a, 0x26, 0xcb, 0x72, 0x01, 0x2a, 0xa1, 0xe5, 0xef, 0xe1, 0x30, 0xbe, 0x65, 0xea, 0x53,
+		0x4e, 0xd2, 0x51, 0x86, 0x4f, 0xff, 0xe5, 0x20, 0x9c, 0x15, 0xef, 0xc1, 0xf8, 0xd9, 0x8e, 0xf0,
+		0x44, 0xa6, 0x61, 0xf7, 0x88, 0xe6, 0x7c, 0xd8, 0xf1, 0xef, 0xaa, 0xa2, 0x31, 0x29, 0x3a, 0x12,
+		0xe9, 0x06, 0xb7, 0x8e, 0x48, 0xb4, 0x45, 0x9f, 0x30, 0x13, 0x61, 0x62, 0x08, 0x7d, 0xa6, 0xc1,
+		0x5c, 0x93, 0x54, 0xa6, 0xcd, 0x06, 0x71, 0x16, 0xe2, 0x6d, 0xa9, 0xbd, 0xbb, 0x7f, 0xbc, 0x6d,
+		0x69, 0xb1, 0x7b, 0x33, 0xc2, 0x98, 0xda, 0x1c, 0x35, 0x73, 0x13, 0xb5, 0x0e, 0xcc, 0xe4, 0xa8,
+		0x14, 0xc4, 0x82, 0xb7, 0xd3, 0xb1, 0xe0, 0xba, 0x44, 0x1c, 0xb2, 0x34, 0xb1, 0xcb, 0x4b, 0x06,
+		0x84, 0xb5, 0x0e, 0x2c, 0x48, 0x08, 0x14, 0xec, 0xfb, 0x5e, 0x72, 0xdf, 0x8a, 0xb4, 0xee, 0x72,
+		0x17, 0x87, 0xbd, 0x2a, 0x3f, 0xc1, 0x9b, 0x0c, 0x41, 0xff, 0x53, 0x83, 0x55, 0x56, 0x57, 0xcf,
+		0x31, 0x2d, 0x57, 0x10, 0x54, 0xa4, 0x41, 0xca, 0x49, 0x19, 0x7a, 0x4c, 0x85, 0x28, 0x6e, 0x80,
+		0xe2, 0x45, 0xa3, 0xf2, 0x4c, 0x63, 0x6d, 0x4f, 0x93, 0x61, 0xe2, 0x57, 0x80, 0x5e, 0x84, 0xc9,
+		0x66, 0xe4, 0x00, 0x3d, 0xc0, 0x34, 0x70, 0x61, 0x75, 0xe0, 0xf4, 0xa0, 0xee, 0xc3, 0xcb, 0x25,
+		0xce, 0x1a, 0xbb, 0x4b, 0x43, 0x3c, 0xf8, 0x3d, 0xde, 0xb5, 0x12, 0x68, 0xfd, 0x75, 0xf2, 0x6e,
+		0x2a, 0x57, 0x6c, 0xf2, 0x90, 0x2c, 0xe1, 0x6c, 0xea, 0x21, 0x79, 0xff, 0x32, 0x0d, 0x16, 0x3b,
+		0x0e, 0x73, 0xbd, 0xfa, 0x27, 0xcf, 0x7a, 0x76, 0x59, 0x43, 0xe3, 0x90, 0xd1, 0x2b, 0x8e, 0x6e,
+		0xd3, 0x94, 0x67, 0xd7, 0x25, 0x45, 0x28, 0xfe, 0xf2, 0x35, 0xcb, 0xd7, 0xd2, 0x64, 0xec, 0x24,
+		0x1b, 0xa5, 0xe9, 0x5a, 0xbd, 0x0e, 0xf3, 0x86, 0x15, 0xe2, 0x96, 0xd3, 0x76, 0x42, 0xd6, 0x0d,
+		0xcb, 0x88, 0x5d, 0x87, 0x53, 0xb6, 0x15, 0x5a, 0x8c, 0x19, 0x8b, 0xb2, 0x86, 0xea, 0x9b, 0xee,
+		0x91, 0x41, 0x16, 0xea, 0x1f, 0xc0, 0x42, 0x0e, 0x15, 0x3b, 0x40, 0xbf, 0xb8, 0x36, 0xfe, 0xe7,
+		0x0a, 0x00, 0x73, 0x4a, 0x6f, 0x3e, 0xac, 0xa3, 0x3f, 0xd1, 0x60, 0x5e, 0xfc, 0x6d, 0x0b, 0x74,
+		0xed, 0x78, 0x9f, 0x02, 0xaa, 0x5d, 0xef, 0x1b, 0x8e, 0x9d, 0xe5, 0xfb, 0x1a, 0x2c, 0x48, 0x3e,
+		0x3d, 0x83, 0xae, 0x17, 0x7d, 0x38, 0x44, 0x46, 0xcd, 0x8d, 0xfe, 0x01, 0x13, 0xe4, 0x48, 0x3e,
+		0x2b, 0xa3, 0x20, 0x47, 0xfd, 0x45, 0x1b, 0x05, 0x39, 0x45, 0x5f, 0xb0, 0xf9, 0x89, 0x06, 0xcb,
+		0x45, 0xdf, 0x23, 0x41, 0xdf, 0x3e, 0xe9, 0xf7, 0x55, 0x6a, 0x37, 0x4f, 0x80, 0x81, 0x51, 0x1a,
+		0xc9, 0x94, 0xf8, 0x4b, 0x23, 0x0a, 0x99, 0x52, 0x7e, 0xe1, 0x44, 0x21, 0x53, 0x05, 0x9f, 0x34,
+		0xf9, 0xb1, 0x06, 0x35, 0xf9, 0xf7, 0x38, 0x90, 0xbc, 0x5b, 0xb4, 0xf0, 0x3b, 0x25, 0xb5, 0xb7,
+		0x8e, 0x05, 0xcb, 0xe8, 0xfa, 0x91, 0x06, 0x67, 0xa4, 0x5f, 0xdb, 0x40, 0x6f, 0x48, 0x51, 0x17,
+		0x7d, 0xec, 0xa3, 0xf6, 0xe6, 0x71, 0x40, 0x19, 0x51, 0x2e, 0x4c, 0xa6, 0xbe, 0xa3, 0x80, 0x5e,
+		0x95, 0x22, 0x13, 0x7d, 0xae, 0xa1, 0xb6, 0x56, 0x76, 0x39, 0xdb, 0xef, 0x33, 0x0d, 0x4e, 0x0b,
+		0x3e, 0x46, 0x80, 0xae, 0xa8, 0x6f, 0x5b, 0xf8, 0xf9, 0x83, 0xda, 0xd5, 0xfe, 0x80, 0x18, 0x09,
+		0x21, 0x4c, 0x65, 0xde, 0xcd, 0x47, 0xeb, 0x2a, 0x6f, 0x48, 0x50, 0x05, 0xad, 0xbd, 0x56, 0x1e,
+		0x80, 0xed, 0x7a, 0x08, 0xd3, 0xd9, 0x17, 0x4c, 0x91, 0x1c, 0x8b, 0xe4, 0x15, 0xdc, 0xda, 0xe5,
+		0x3e, 0x20, 0x12, 0x62, 0x27, 0xed, 0x83, 0x56, 0x88, 0x5d, 0xd1, 0x4b, 0x6e, 0xb5, 0x13, 0xb4,
+		0x5d, 0xa3, 0xbf, 0xd2, 0xe0, 0xac, 0xaa, 0x4d, 0x1a, 0xbd, 0x7d, 0xcc, 0xee, 0x6a, 0x4a, 0xda,
+		0x3b, 0x27, 0xea, 0xcd, 0x66, 0x2c, 0x93, 0xf4, 0x12, 0x2b, 0x59, 0xa6, 0xee, 0x64, 0x56, 0xb2,
+		0xac, 0xa0, 0x75, 0x39, 0x71, 0x8f, 0x82, 0x17, 0x35, 0x0a, 0xef, 0x51, 0xfe, 0x8a, 0x4c, 0xe1,
+		0x3d, 0xaa, 0xde, 0x0b, 0x49, 0xdc, 0xa3, 0xb0, 0x9d, 0xb7, 0xf8, 0x1e, 0x55, 0x2d, 0xc5, 0xc5,
+		0xf7, 0xa8, 0xec, 0x21, 0x4e, 0xde, 0x63, 0xbe, 0x63, 0xb7, 0xf8, 0x1e, 0xa5, 0xfd, 0xc2, 0xc5,
+		0xf7, 0x28, 0x6f, 0x10, 0x46, 0x7f, 0x49, 0xea, 0x1a, 0xd2, 0x56, 0x5c, 0xf4, 0x56, 0x5f, 0x67,
+		0x4e, 0x37, 0x03, 0xd7, 0xde, 0x3e, 0x1e, 0x70, 0x8a, 0x34, 0x69, 0x1f, 0xba, 0x92, 0xb4, 0xa2,
+		0x4e, 0x78, 0x25, 0x69, 0xc5, 0xad, 0xef, 0x7f, 0xab, 0xc1, 0x92, 0xba, 0x01, 0x15, 0xbd, 0xab,
+		0xd8, 0xa0, 0x44, 0x17, 0x6e, 0xed, 0xbd, 0x63, 0xc3, 0x33, 0x1a, 0x7f, 0xa0, 0x41, 0x55, 0xd6,
+		0x86, 0x8c, 0x6e, 0x28, 0xb0, 0x2b, 0xfb, 0xad, 0x6b, 0x6f, 0x1c, 0x03, 0x92, 0x51, 0xf4, 0xb9,
+		0x06, 0xb3, 0xa2, 0x66, 0x56, 0x24, 0x7f, 0x72, 0x2a, 0x5a, 0x77, 0x6b, 0xaf, 0xf7, 0x09, 0xc5,
+		0xa8, 0xf8, 0x1b, 0xf2, 0x49, 0x3c, 0x45, 0xb3, 0x26, 0x7a, 0xa7, 0x40, 0x36, 0xd4, 0x9d, 0xb6,
+		0xb5, 0x77, 0x8f, 0x0b, 0xce, 0x08, 0xfc, 0x14, 0x66, 0x72, 0xbd, 0x89, 0xe8, 0xb2, 0x02, 0xa9,
+		0xb8, 0x9d, 0xb4, 0xb6, 0xd1, 0x0f, 0x48, 0xcf, 0x1b, 0xc9, 0x74, 0x1b, 0x2a, 0xbc, 0x11, 0x71,
+		0x8f, 0xa4, 0xc2, 0x1b, 0x91, 0x34, 0x32, 0xa2, 0x27, 0x30, 0x91, 0xec, 0xfe, 0x42, 0xdf, 0x52,
+		0x62, 0xc8, 0x14, 0x62, 0x6a, 0xaf, 0x96, 0x5c, 0x9d, 0x90, 0x42, 0x51, 0xfb, 0x96, 0x42, 0x0a,
+		0x15, 0x1d, 0x68, 0x0a, 0x29, 0x54, 0xf6, 0x88, 0x45, 0x9e, 0xa7, 0xa0, 0x2b, 0x4b, 0xe1, 0x79,
+		0xca, 0x5b, 0xbc, 0x6a, 0x57, 0xfb, 0x03, 0x8a, 0x5f, 0x61, 0x83, 0x5e, 0x93, 0x13, 0xba, 0x24,
+		0xc5, 0x91, 0xeb, 0x9c, 0xaa, 0xbd, 0x52, 0x6a, 0x6d, 0x6f, 0x9b, 0x5e, 0x17, 0x91, 0x62, 0x9b,
+		0x5c, 0x67, 0x95, 0x62, 0x9b, 0x7c, 0x5b, 0x12, 0xdd, 0x86, 0x37, 0x01, 0x29, 0xb7, 0xc9, 0xb4,
+		0x2e, 0x29, 0xb7, 0xc9, 0x76, 0x15, 0x45, 0x11, 0x4a, 0xaa, 0x81, 0x47, 0x11, 0xa1, 0x88, 0x9a,
+		0x8f, 0x14, 0x11, 0x8a, 0xb8, 0x2f, 0x28, 0x0a, 0x65, 0xc5, 0x8d, 0x30, 0x8a, 0x50, 0x56, 0xd9,
+		0x10, 0xa4, 0x08, 0x65, 0x0b, 0x5a, 0x78, 0x22, 0x07, 0x46, 0xda, 0x73, 0xa2, 0x70, 0x60, 0x8a,
+		0xda, 0x62, 0x14, 0x0e, 0x4c, 0x71, 0x8b, 0x8b, 0x0b, 0x93, 0xa9, 0x8e, 0x0d, 0xc5, 0x85, 0x88,
+		0x9a, 0x56, 0x14, 0x17, 0x22, 0x6c, 0x04, 0x41, 0xb4, 0xdc, 0x9c, 0xef, 0xae, 0x40, 0xaa, 0xf0,
+		0x4f, 0xda, 0x37, 0xa2, 0x30, 0x1f, 0xaa, 0x16, 0x0e, 0xe2, 0x80, 0xa8, 0xbb, 0x13, 0x14, 0x0e,
+		0x48, 0xa9, 0xde, 0x0f, 0x85, 0x03, 0x52, 0xae, 0x2d, 0x82, 0x88, 0xae, 0xb8, 0xff, 0x40, 0x21,
+		0xba, 0xca, 0xfe, 0x06, 0x85, 0xe8, 0xaa, 0x1b, 0x1d, 0x88, 0x33, 0x24, 0xeb, 0x24, 0x50, 0x38,
+		0x43, 0x05, 0xfd, 0x0a, 0x0a, 0x67, 0xa8, 0xa8, 0x6d, 0x81, 0xca, 0x6d, 0xa2, 0x61, 0x40, 0x29,
+		0xb7, 0xf9, 0xf6, 0x03, 0xa5, 0xdc, 0x0a, 0xfa, 0x10, 0xa2, 0x88, 0x3f, 0xdb, 0x4c, 0xa0, 0x88,
+		0xf8, 0x25, 0x2d, 0x0b, 0x8a, 0x88, 0x5f, 0xda, 0xa9, 0x10, 0xc2, 0x54, 0xa6, 0x6a, 0xae, 0x70,
+		0x29, 0xc4, 0xbd, 0x08, 0x0a, 0x97, 0x42, 0x56, 0x90, 0x3f, 0x84, 0xe9, 0x6c, 0x55, 0x56, 0x95,
+		0xe0, 0x10, 0xd7, 0xa9, 0x55, 0x09, 0x0e, 0x49, 0xc9, 0x37, 0xda, 0x38, 0x5b, 0xc5, 0x54, 0x6c,
+		0x2c, 0x29, 0x0e, 0x2b, 0x36, 0x96, 0x96, 0x48, 0xff, 0x58, 0x83, 0x39, 0x61, 0xe1, 0x11, 0xc9,
+		0x6d, 0x8c, 0xaa, 0x54, 0x5a, 0xbb, 0xd6, 0x2f, 0x58, 0xc2, 0x42, 0x8a, 0xca, 0x76, 0x0a, 0x0b,
+		0xa9, 0xa8, 0x87, 0x2a, 0x2c, 0xa4, 0xb2, 0xc2, 0xf9, 0xa5, 0x16, 0xbf, 0x1f, 0x2b, 0xaf, 0x0f,
+		0xa1, 0x9b, 0x45, 0x11, 0x6a, 0x61, 0x1d, 0xad, 0x76, 0xeb, 0x24, 0x28, 0x52, 0x49, 0xc0, 0x64,
+		0x81, 0x48, 0x9d, 0x04, 0x14, 0x54, 0xa0, 0xd4, 0x49, 0x40, 0x61, 0xed, 0x29, 0xd2, 0xcc, 0x74,
+		0x55, 0x47, 0xa5, 0x99, 0xc2, 0x52, 0x92, 0x4a, 0x33, 0xc5, 0x05, 0xa3, 0x5b, 0x6f, 0xfc, 0xf6,
+		0xf5, 0x3d, 0x27, 0xdc, 0xef, 0xee, 0xae, 0x35, 0xbc, 0xf6, 0x7a, 0xea, 0x3f, 0x9b, 0xac, 0xed,
+		0x61, 0x97, 0xfe, 0x9b, 0x9b, 0xc4, 0xff, 0xd9, 0x79, 0x8b, 0xfd, 0x79, 0x70, 0x79, 0x77, 0x98,
+		0xcc, 0x5d, 0xf9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xbf, 0x21, 0x00, 0x93, 0x67, 0x00,
+		0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -2841,247 +3116,269 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0x49,
-		0xb5, 0xde, 0x9e, 0xb1, 0xc7, 0x9e, 0x33, 0x8e, 0x3d, 0x2e, 0x3b, 0x8e, 0xff, 0x12, 0x3b, 0x93,
-		0x6c, 0xe2, 0x75, 0x9c, 0x71, 0xe2, 0x64, 0x93, 0x4d, 0xb2, 0x3f, 0xd7, 0x76, 0x6c, 0xed, 0x48,
-		0xbe, 0x49, 0x6e, 0xc7, 0xc9, 0xde, 0x7b, 0xb5, 0xd2, 0xd0, 0xee, 0x2e, 0xc7, 0x8d, 0x67, 0xba,
-		0x67, 0xbb, 0x6b, 0x3c, 0x31, 0x82, 0x27, 0x1e, 0x90, 0x10, 0x2b, 0x58, 0xad, 0x90, 0x58, 0x09,
-		0x04, 0x42, 0x02, 0xb1, 0xfc, 0x68, 0x11, 0x08, 0xf1, 0xf7, 0x02, 0x48, 0x68, 0x91, 0x40, 0x0b,
-		0x4f, 0xbc, 0xf0, 0x84, 0x84, 0x10, 0xfb, 0xc6, 0x03, 0xcb, 0x33, 0xea, 0xea, 0xea, 0x99, 0xe9,
-		0x9e, 0xaa, 0xfe, 0x19, 0x3b, 0x59, 0xd0, 0xe6, 0x6d, 0xba, 0xfb, 0x9c, 0xd3, 0x5f, 0x55, 0x9d,
-		0x73, 0xea, 0xd4, 0x39, 0xa7, 0x07, 0x4e, 0xd6, 0xb7, 0xb0, 0xb5, 0xa8, 0x2a, 0x1a, 0x36, 0x54,
-		0xbc, 0xa8, 0xd4, 0xf4, 0xc5, 0xbd, 0x8b, 0x8b, 0x3b, 0xba, 0x4d, 0x4c, 0x6b, 0xbf, 0x58, 0xb3,
-		0x4c, 0x62, 0xa2, 0x11, 0x87, 0xa4, 0xc8, 0x48, 0x8a, 0x4a, 0x4d, 0x2f, 0xee, 0x5d, 0x9c, 0x3c,
-		0xf1, 0xc0, 0x34, 0x1f, 0x54, 0xf0, 0x22, 0x25, 0xd9, 0xaa, 0x6f, 0x2f, 0x6a, 0x75, 0x4b, 0x21,
-		0xba, 0x69, 0xb8, 0x4c, 0x93, 0x33, 0xc1, 0xe7, 0x44, 0xaf, 0x62, 0x9b, 0x28, 0xd5, 0x1a, 0x23,
-		0x98, 0xe5, 0xbd, 0x58, 0x35, 0xab, 0xd5, 0xa6, 0x88, 0x02, 0x8f, 0x82, 0x28, 0xf6, 0x6e, 0x45,
-		0xb7, 0x49, 0x18, 0x4d, 0xc3, 0xb4, 0x76, 0xb7, 0x2b, 0x66, 0xc3, 0xa5, 0x29, 0xdc, 0x84, 0xbe,
-		0x97, 0xdd, 0x01, 0xa1, 0x6b, 0x90, 0xc1, 0x7b, 0xd8, 0x20, 0xf6, 0xb8, 0x34, 0x9b, 0x9e, 0xcb,
-		0x2d, 0x9d, 0x2c, 0x72, 0xc6, 0x56, 0x64, 0xd4, 0x6b, 0x0e, 0xa5, 0xcc, 0x18, 0x0a, 0xef, 0x5f,
-		0x85, 0x81, 0xf6, 0x07, 0x68, 0x02, 0xfa, 0xe9, 0xa3, 0xb2, 0xae, 0x8d, 0x4b, 0xb3, 0xd2, 0x5c,
-		0x5a, 0xee, 0xa3, 0xd7, 0x25, 0x0d, 0x5d, 0x03, 0x70, 0x1f, 0x39, 0x83, 0x1e, 0x4f, 0xcd, 0x4a,
-		0x73, 0xb9, 0xa5, 0xc9, 0xa2, 0x3b, 0x23, 0x45, 0x6f, 0x46, 0x8a, 0x9b, 0xde, 0x8c, 0xc8, 0x59,
-		0x4a, 0xed, 0x5c, 0xa3, 0x71, 0xe8, 0xdb, 0xc3, 0x96, 0xad, 0x9b, 0xc6, 0x78, 0xda, 0x15, 0xca,
-		0x2e, 0xd1, 0x31, 0xe8, 0x73, 0x06, 0xef, 0xbc, 0xae, 0x87, 0x3e, 0xc9, 0x38, 0x97, 0x25, 0x0d,
-		0x7d, 0x59, 0x82, 0x73, 0xde, 0x90, 0xcb, 0xf8, 0x21, 0x56, 0xeb, 0xce, 0x3a, 0x94, 0x6d, 0xa2,
-		0x58, 0x04, 0x6b, 0x65, 0x17, 0x89, 0x42, 0x88, 0xa5, 0x6f, 0xd5, 0x09, 0xb6, 0xc7, 0x7b, 0x29,
-		0x9e, 0xe7, 0xb9, 0x43, 0x7f, 0x85, 0xc9, 0x59, 0xf3, 0xc4, 0xdc, 0x75, 0xa5, 0xd0, 0x21, 0x2f,
-		0x37, 0x65, 0xbc, 0xfc, 0x94, 0x7c, 0xb6, 0x11, 0x8f, 0x14, 0x7d, 0x5d, 0x82, 0xf3, 0x1c, 0x78,
-		0xaa, 0x59, 0xad, 0x55, 0x30, 0x17, 0x60, 0x86, 0x02, 0x7c, 0x31, 0x1e, 0xc0, 0x55, 0x4f, 0x4e,
-		0x27, 0xc4, 0x67, 0x1a, 0x71, 0x89, 0xd1, 0x5b, 0x12, 0xcc, 0x73, 0x40, 0x6e, 0x2b, 0x7a, 0x85,
-		0x87, 0xb0, 0x8f, 0x22, 0xbc, 0x11, 0x0f, 0xe1, 0x3a, 0x15, 0xd2, 0x09, 0xef, 0x4c, 0x23, 0x16,
-		0x25, 0xfa, 0x1a, 0x7f, 0x02, 0x1d, 0xdd, 0xd2, 0xca, 0x66, 0x9d, 0x74, 0xc2, 0xeb, 0xa7, 0xf0,
-		0x5e, 0x88, 0x07, 0xcf, 0x51, 0x3b, 0xed, 0x76, 0x9d, 0x74, 0x02, 0x9c, 0x6b, 0xc4, 0xa4, 0x45,
-		0x6f, 0x4a, 0x30, 0xa7, 0x61, 0x55, 0xb7, 0x29, 0x30, 0x47, 0x4b, 0x6d, 0x75, 0x07, 0x6b, 0x75,
-		0xee, 0xe4, 0x65, 0x29, 0xba, 0x6b, 0x5c, 0x74, 0x37, 0x99, 0x90, 0x4d, 0xc5, 0xde, 0xbd, 0xeb,
-		0x89, 0xe8, 0x44, 0x76, 0x5a, 0x8b, 0x41, 0x87, 0x5e, 0x97, 0xe0, 0x4c, 0x00, 0x95, 0xc8, 0x26,
-		0x80, 0x62, 0xba, 0x1a, 0x8d, 0x49, 0x64, 0x0e, 0x05, 0x2d, 0x92, 0x8a, 0x33, 0x4b, 0x21, 0x46,
-		0x90, 0x8b, 0x39, 0x4b, 0x21, 0xfa, 0xef, 0x9b, 0x25, 0xa1, 0xea, 0xbf, 0xd1, 0x81, 0x2a, 0x44,
-		0xb3, 0x06, 0x28, 0xaa, 0xe7, 0x22, 0x51, 0x89, 0x95, 0xea, 0x94, 0x16, 0x4d, 0x86, 0x3e, 0x2b,
-		0xc1, 0xd3, 0x7e, 0x4c, 0x22, 0x4b, 0x3c, 0x42, 0x01, 0x5d, 0x89, 0x04, 0x24, 0x32, 0xc2, 0x93,
-		0x5a, 0x14, 0x11, 0x5d, 0x36, 0x45, 0x25, 0xfa, 0x9e, 0x4e, 0xf6, 0x23, 0x95, 0x7b, 0x30, 0x64,
-		0xd9, 0x96, 0x99, 0x90, 0x28, 0xe5, 0x56, 0x62, 0xd0, 0x51, 0xe5, 0x0e, 0xa0, 0x12, 0x29, 0xf7,
-		0x50, 0x88, 0x72, 0xfb, 0x30, 0x09, 0x95, 0x5b, 0x89, 0xa4, 0xe2, 0xcc, 0x52, 0x88, 0x72, 0xe7,
-		0x63, 0xce, 0x52, 0x98, 0x72, 0x2b, 0x31, 0xe8, 0xa8, 0x22, 0xf9, 0x51, 0x89, 0x14, 0x69, 0x38,
-		0x44, 0x91, 0xda, 0x21, 0x09, 0x15, 0x49, 0x89, 0x22, 0xa2, 0x96, 0xe6, 0x07, 0x13, 0x62, 0x69,
-		0x28, 0xc4, 0xd2, 0xda, 0xf1, 0x84, 0x58, 0x9a, 0x12, 0x4d, 0x86, 0x1a, 0x70, 0xc2, 0x01, 0x61,
-		0x89, 0xb5, 0x67, 0x84, 0x02, 0xb9, 0xc0, 0x05, 0xe2, 0x48, 0xb5, 0x84, 0x6a, 0x33, 0x45, 0xc4,
-		0x8f, 0xd1, 0x6b, 0x30, 0xed, 0xbe, 0x78, 0x5b, 0xb7, 0x78, 0xaf, 0x1d, 0xa5, 0xaf, 0x2d, 0x8a,
-		0x5f, 0xbb, 0xee, 0xf0, 0x75, 0xbe, 0x74, 0x82, 0x88, 0x1e, 0xa2, 0x6f, 0x4a, 0xb0, 0x18, 0x50,
-		0x51, 0xc5, 0x50, 0x71, 0xa5, 0x6c, 0xe1, 0xd7, 0xea, 0xd8, 0xe6, 0x8e, 0xfe, 0x28, 0x85, 0xf1,
-		0x52, 0xb4, 0xa6, 0x52, 0x49, 0xb2, 0x27, 0xa8, 0x13, 0xd7, 0xbc, 0x12, 0x9b, 0x1a, 0xfd, 0x50,
-		0x82, 0xcb, 0x0c, 0x93, 0x07, 0x31, 0x9e, 0x12, 0x8f, 0x51, 0xb4, 0xab, 0x5c, 0xb4, 0xec, 0x6d,
-		0xee, 0xab, 0xe3, 0x68, 0x74, 0xd1, 0x4a, 0xc4, 0x81, 0xbe, 0x20, 0xc1, 0x59, 0xde, 0xf4, 0xf2,
-		0x80, 0x1e, 0x8b, 0xa9, 0xdd, 0xab, 0x4c, 0x42, 0x84, 0x76, 0x0b, 0xc8, 0xd0, 0x27, 0x60, 0xc6,
-		0x55, 0x32, 0x31, 0x92, 0x71, 0x8a, 0xe4, 0xa2, 0x58, 0xcf, 0xc4, 0x10, 0x5c, 0x05, 0x16, 0xbd,
-		0xfb, 0x33, 0x12, 0x9c, 0x66, 0x8b, 0xc7, 0x14, 0x5d, 0xb0, 0x68, 0x13, 0x14, 0xc1, 0xb3, 0x5c,
-		0x04, 0xae, 0x70, 0x57, 0xdf, 0x05, 0xcb, 0x34, 0xab, 0x46, 0xd0, 0xa0, 0x4f, 0xc1, 0x6c, 0x55,
-		0xb1, 0x76, 0xb1, 0x55, 0xb6, 0xb0, 0x6a, 0x5a, 0x1a, 0x0f, 0xc4, 0x24, 0x05, 0xb1, 0xc4, 0x05,
-		0xf1, 0xdf, 0x94, 0x59, 0x66, 0xbc, 0x9d, 0x08, 0x8e, 0x57, 0xc3, 0x08, 0xd0, 0x57, 0x25, 0x58,
-		0xe0, 0x9d, 0x4f, 0xf4, 0x07, 0x86, 0xc2, 0x9d, 0x90, 0xa9, 0x24, 0xe1, 0xeb, 0x5d, 0x26, 0x26,
-		0x4e, 0xf8, 0x2a, 0xa0, 0x45, 0xdf, 0x90, 0xa0, 0xc8, 0x8b, 0xb0, 0xb1, 0x55, 0xd5, 0x0d, 0x85,
-		0xeb, 0x17, 0xa6, 0x43, 0xfc, 0x42, 0x67, 0x88, 0xdd, 0x14, 0xc4, 0xf1, 0x0b, 0x8d, 0xd8, 0xd4,
-		0xe8, 0x47, 0x12, 0x5c, 0xe6, 0x1d, 0xa5, 0x22, 0xbd, 0xd8, 0x71, 0x8a, 0xf6, 0x66, 0xcc, 0x13,
-		0x55, 0x94, 0x2b, 0x5b, 0x6c, 0x24, 0x63, 0x11, 0x69, 0x80, 0xd8, 0x28, 0x4f, 0x24, 0xd1, 0x00,
-		0xb1, 0x81, 0xce, 0x35, 0x62, 0xd2, 0xa2, 0xbf, 0x48, 0xb0, 0x16, 0xf0, 0xb8, 0xf8, 0x21, 0xc1,
-		0x96, 0xa1, 0x54, 0xca, 0x1c, 0xe4, 0xba, 0xa1, 0x13, 0x9d, 0xaf, 0x18, 0x33, 0x14, 0xfa, 0xdd,
-		0x68, 0x17, 0xbc, 0xc6, 0xe4, 0x77, 0x8c, 0xa7, 0xe4, 0x09, 0xef, 0x1c, 0xd0, 0x8b, 0xd6, 0x81,
-		0x24, 0xa0, 0x3f, 0x49, 0xb0, 0x92, 0x60, 0x98, 0x22, 0x8f, 0x35, 0x4b, 0xc7, 0x78, 0xe7, 0x00,
-		0x63, 0x14, 0x39, 0xb3, 0x1b, 0x56, 0xf7, 0xec, 0xe8, 0x3d, 0x09, 0x5e, 0x08, 0x1b, 0x4e, 0xb4,
-		0x9d, 0x9c, 0xa4, 0x03, 0xdb, 0xe0, 0x0e, 0x4c, 0x08, 0x26, 0xd2, 0x5e, 0xae, 0xe2, 0xee, 0x58,
-		0x69, 0x1c, 0xc0, 0x4d, 0x9d, 0x18, 0x44, 0x37, 0xea, 0x58, 0x2b, 0x2b, 0x76, 0xd9, 0xc0, 0x8d,
-		0xce, 0x71, 0x14, 0x42, 0xe2, 0x00, 0x4e, 0x06, 0x85, 0x89, 0x5b, 0xb6, 0x6f, 0xe1, 0x06, 0x27,
-		0x0e, 0x68, 0x24, 0xe2, 0x40, 0xbf, 0x92, 0xe0, 0x1a, 0x8d, 0x26, 0xcb, 0xea, 0x8e, 0x5e, 0xd1,
-		0x12, 0xda, 0xcf, 0x29, 0x0a, 0xfd, 0x65, 0x2e, 0x74, 0x1a, 0x4a, 0xae, 0x3a, 0x42, 0x93, 0x18,
-		0xcd, 0x25, 0x3b, 0x39, 0x1b, 0xfa, 0xa9, 0x04, 0x57, 0x22, 0x06, 0x21, 0xb2, 0x8e, 0xd3, 0x74,
-		0x04, 0x6b, 0x49, 0x47, 0x20, 0x32, 0x89, 0x0b, 0x76, 0x42, 0x1e, 0xf4, 0x5d, 0x09, 0x2e, 0x0a,
-		0x51, 0x0b, 0xe3, 0xfc, 0xa7, 0x29, 0xec, 0x65, 0x7e, 0x18, 0xc2, 0x7d, 0xbb, 0x30, 0xf0, 0x5f,
-		0x50, 0x13, 0xd0, 0xa3, 0x1f, 0x48, 0x70, 0x49, 0x08, 0x37, 0xe4, 0x10, 0x79, 0x26, 0x44, 0xc9,
-		0xf9, 0x80, 0x43, 0x8e, 0x93, 0x45, 0x35, 0x11, 0x07, 0x7a, 0x5b, 0x82, 0x0b, 0x89, 0x35, 0xe3,
-		0x2c, 0x45, 0xfc, 0x5f, 0x09, 0x10, 0x8b, 0x94, 0xe2, 0x9c, 0x9a, 0x40, 0x1f, 0xde, 0x91, 0x60,
-		0x49, 0x3c, 0xc1, 0xc2, 0x4d, 0x78, 0x8e, 0xa2, 0x5d, 0x49, 0x32, 0xbf, 0xc2, 0x9d, 0xf8, 0xbc,
-		0x9a, 0x84, 0x01, 0x7d, 0x3f, 0x4c, 0x25, 0x42, 0x0e, 0xcd, 0xcf, 0x24, 0x86, 0x2c, 0x3e, 0x3e,
-		0x0b, 0x20, 0x8b, 0x0e, 0xd2, 0x4e, 0x6c, 0x26, 0x86, 0x1c, 0x12, 0x49, 0xce, 0x87, 0xc4, 0x66,
-		0x02, 0xcc, 0x21, 0xe1, 0xe4, 0xa2, 0x9a, 0x8c, 0x85, 0x6e, 0x9a, 0x6e, 0x28, 0xde, 0x6d, 0xc4,
-		0x73, 0x2e, 0x64, 0xd3, 0x74, 0x23, 0xee, 0x6e, 0x42, 0x9d, 0xab, 0x76, 0x77, 0xac, 0xe8, 0xd7,
-		0x12, 0x5c, 0x8f, 0x31, 0x20, 0x91, 0x8d, 0x2e, 0xd0, 0xd1, 0x94, 0xba, 0x19, 0x8d, 0xc8, 0x58,
-		0x2f, 0xdb, 0x5d, 0xf0, 0xa1, 0x9f, 0x48, 0xf0, 0x6c, 0xd8, 0x00, 0xc4, 0xe7, 0xa7, 0xf3, 0x21,
-		0x1b, 0x90, 0x10, 0x84, 0xf8, 0x1c, 0x75, 0x01, 0x27, 0xe4, 0xa1, 0x0e, 0xa7, 0x5e, 0xb3, 0xb1,
-		0x45, 0x5a, 0xc0, 0x6d, 0xac, 0x58, 0xea, 0x4e, 0x1b, 0xcc, 0x4e, 0xdc, 0xc5, 0x10, 0xeb, 0xbd,
-		0x47, 0xc5, 0x79, 0x08, 0xee, 0x52, 0x61, 0xad, 0x37, 0x72, 0xac, 0xb7, 0x9e, 0x84, 0x61, 0x65,
-		0x00, 0xa0, 0x05, 0xa4, 0xf0, 0xe7, 0x21, 0x38, 0x1b, 0x77, 0xf7, 0x5a, 0x87, 0x23, 0xcd, 0x31,
-		0x92, 0xfd, 0x1a, 0xa6, 0xb5, 0x40, 0x51, 0x65, 0xd1, 0x13, 0xba, 0xb9, 0x5f, 0xc3, 0xf2, 0x40,
-		0xa3, 0xed, 0x0a, 0xbd, 0x0a, 0x47, 0x6b, 0x8a, 0xe5, 0xcc, 0x48, 0xbb, 0xd1, 0x6d, 0x9b, 0xac,
-		0x7c, 0x38, 0xc7, 0x95, 0x77, 0x87, 0x72, 0xb4, 0xd9, 0xc4, 0xb6, 0x29, 0x8f, 0xd4, 0x3a, 0x6f,
-		0xa2, 0xeb, 0x90, 0xa5, 0x19, 0x99, 0x8a, 0x6e, 0x13, 0x5a, 0x58, 0xcc, 0x2d, 0x1d, 0xe7, 0xa7,
-		0x3c, 0x14, 0x7b, 0x77, 0x43, 0xb7, 0x89, 0xdc, 0x4f, 0xd8, 0x2f, 0xb4, 0x04, 0xbd, 0xba, 0x51,
-		0xab, 0x13, 0x5a, 0x76, 0xcc, 0x2d, 0x4d, 0x0b, 0x90, 0xec, 0x57, 0x4c, 0x45, 0x93, 0x5d, 0x52,
-		0xa4, 0xc0, 0x6c, 0x20, 0xe4, 0x28, 0x13, 0xb3, 0xac, 0x56, 0x4c, 0x1b, 0x53, 0xff, 0x6d, 0xd6,
-		0x09, 0xab, 0x43, 0x4e, 0x74, 0xd4, 0x45, 0x6f, 0xb2, 0x4a, 0xb2, 0x3c, 0x8d, 0x7d, 0x73, 0xbf,
-		0x69, 0xae, 0x3a, 0xfc, 0x9b, 0x2e, 0x3b, 0x7a, 0x05, 0xa6, 0x5a, 0x69, 0xef, 0x4e, 0xe9, 0x99,
-		0x28, 0xe9, 0xc7, 0x88, 0x97, 0xcc, 0x0e, 0x08, 0xbe, 0x01, 0x93, 0xad, 0x08, 0xbb, 0x35, 0x0a,
-		0xab, 0x6e, 0x94, 0x75, 0x8d, 0x96, 0xfe, 0xb2, 0xf2, 0xb1, 0x26, 0x45, 0x73, 0x9e, 0xe5, 0xba,
-		0x51, 0xd2, 0x50, 0x09, 0xb2, 0xcc, 0x55, 0x9a, 0x16, 0xad, 0xc3, 0x0d, 0x2e, 0x9d, 0xe3, 0xbb,
-		0x76, 0x26, 0x80, 0x86, 0xd0, 0x25, 0x8f, 0x45, 0x6e, 0x71, 0xa3, 0x12, 0x0c, 0xb7, 0x70, 0x38,
-		0xee, 0xaa, 0x6e, 0x61, 0x56, 0x3c, 0xe3, 0xaf, 0xc1, 0xba, 0x4b, 0x23, 0xe7, 0x9b, 0x6c, 0xec,
-		0x0e, 0x92, 0x61, 0xac, 0xa2, 0x38, 0x67, 0x3e, 0x37, 0x9c, 0xa1, 0xc3, 0xc1, 0x76, 0xbd, 0x42,
-		0x58, 0xe1, 0x2b, 0x7c, 0x4d, 0x47, 0x1d, 0xde, 0xd5, 0x26, 0xab, 0x4c, 0x39, 0xd1, 0x35, 0x98,
-		0x30, 0x2d, 0xfd, 0x81, 0xee, 0x3a, 0xda, 0xc0, 0x2c, 0xe5, 0xe8, 0x2c, 0x8d, 0x79, 0x04, 0x81,
-		0x49, 0x9a, 0x84, 0x7e, 0x5d, 0xc3, 0x06, 0xd1, 0xc9, 0x3e, 0xad, 0x28, 0x65, 0xe5, 0xe6, 0x35,
-		0xba, 0x04, 0x63, 0xdb, 0xba, 0x65, 0x93, 0x4e, 0x99, 0x47, 0x28, 0xe5, 0x08, 0x7d, 0x1a, 0x10,
-		0xb8, 0x0a, 0x03, 0x16, 0x26, 0xd6, 0x7e, 0xb9, 0x66, 0x56, 0x74, 0x75, 0x9f, 0x55, 0x61, 0x66,
-		0x05, 0x07, 0x54, 0x62, 0xed, 0xdf, 0xa1, 0x74, 0x72, 0xce, 0x6a, 0x5d, 0xa0, 0x71, 0xe8, 0x53,
-		0x08, 0xc1, 0xd5, 0x1a, 0xa1, 0x15, 0x93, 0x5e, 0xd9, 0xbb, 0x44, 0xab, 0x30, 0x84, 0x1f, 0xd6,
-		0x74, 0x57, 0x71, 0xdc, 0xa2, 0x7e, 0x3e, 0xb2, 0xa8, 0x3f, 0xd8, 0x62, 0xa1, 0x95, 0xfd, 0x53,
-		0x70, 0x44, 0xb5, 0x1c, 0x6b, 0x60, 0x15, 0x1d, 0x5a, 0x71, 0xc8, 0xca, 0x03, 0xce, 0x4d, 0xaf,
-		0xca, 0x83, 0xfe, 0x17, 0xa6, 0xdc, 0xd1, 0xfb, 0xab, 0x5f, 0x5b, 0x8a, 0xba, 0x6b, 0x6e, 0x6f,
-		0xb3, 0xa2, 0x40, 0x88, 0x52, 0x8f, 0x53, 0xee, 0xf6, 0xc2, 0xd7, 0x8a, 0xcb, 0x8a, 0xce, 0x43,
-		0x4f, 0x15, 0x57, 0x4d, 0x96, 0xce, 0x9f, 0xe0, 0x27, 0xfa, 0x70, 0xd5, 0x94, 0x29, 0x19, 0x92,
-		0x61, 0xb8, 0xc3, 0x63, 0xb3, 0x9c, 0xfc, 0xd3, 0xfc, 0xbd, 0x31, 0xe0, 0x61, 0xe5, 0xbc, 0x1d,
-		0xb8, 0x83, 0xee, 0xc1, 0x58, 0xcd, 0xc2, 0x7b, 0x65, 0xa5, 0x4e, 0x4c, 0x47, 0xff, 0x30, 0x29,
-		0xd7, 0x4c, 0xdd, 0x20, 0x5e, 0x96, 0x5d, 0xb4, 0x5e, 0x36, 0x26, 0x77, 0x28, 0x9d, 0x3c, 0xe2,
-		0xf0, 0x2f, 0xd7, 0x89, 0xd9, 0x76, 0x13, 0x5d, 0x82, 0xcc, 0x0e, 0x56, 0x34, 0x6c, 0xb1, 0xf4,
-		0xf7, 0x14, 0xbf, 0xa9, 0x83, 0x92, 0xc8, 0x8c, 0x14, 0x6d, 0xc0, 0xa8, 0x3b, 0xd1, 0xad, 0x5a,
-		0x1e, 0x5d, 0xd7, 0x63, 0x91, 0xeb, 0x8a, 0x28, 0x5f, 0xb3, 0x2e, 0x47, 0xd7, 0xf6, 0x93, 0x90,
-		0xaf, 0x29, 0x16, 0xd1, 0xbd, 0xe3, 0xf9, 0xb6, 0xfe, 0x60, 0x7c, 0x9c, 0x76, 0x98, 0xfc, 0xcf,
-		0x41, 0xda, 0x2c, 0x1c, 0xff, 0xee, 0x0a, 0x5d, 0xa5, 0x32, 0xd7, 0x0c, 0x62, 0xed, 0xcb, 0x43,
-		0x35, 0xff, 0x5d, 0x74, 0x1c, 0xc0, 0x4b, 0xea, 0xe8, 0x1a, 0x4d, 0x27, 0x67, 0xe5, 0x2c, 0xbb,
-		0x53, 0xd2, 0xd0, 0x7d, 0x18, 0xa1, 0x8a, 0x67, 0xee, 0x61, 0xab, 0xa2, 0xd4, 0x3c, 0x1b, 0x99,
-		0xa4, 0xce, 0xe9, 0x0c, 0xdf, 0x39, 0x59, 0xa6, 0x71, 0xdb, 0x25, 0x67, 0x96, 0x32, 0xac, 0x06,
-		0x6f, 0xa1, 0x87, 0x30, 0x43, 0x73, 0xf0, 0xb8, 0xac, 0x56, 0xea, 0x36, 0xc1, 0x56, 0xd9, 0xc6,
-		0x15, 0xac, 0xd2, 0x39, 0x60, 0xef, 0x98, 0x0a, 0x49, 0xae, 0xd3, 0x34, 0x3f, 0x5e, 0x75, 0x59,
-		0xef, 0x7a, 0x9c, 0xec, 0x75, 0xd3, 0x4a, 0xc8, 0xd3, 0xc9, 0x15, 0x18, 0xe5, 0xcd, 0x0c, 0xca,
-		0x43, 0x7a, 0x17, 0xef, 0xd3, 0x1d, 0x38, 0x2b, 0x3b, 0x3f, 0xd1, 0x28, 0xf4, 0xee, 0x29, 0x95,
-		0xba, 0xdb, 0x84, 0x93, 0x95, 0xdd, 0x8b, 0xeb, 0xa9, 0xe7, 0xa4, 0xc2, 0xdb, 0x12, 0x3c, 0x13,
-		0xff, 0xb8, 0x77, 0x19, 0x32, 0xcc, 0x61, 0x4a, 0x31, 0x1c, 0x26, 0xa3, 0x45, 0xeb, 0x30, 0x1b,
-		0x5e, 0xef, 0xd7, 0x35, 0x0a, 0x2c, 0x2d, 0x4f, 0x8b, 0x4b, 0xf5, 0x25, 0xad, 0xf0, 0x2d, 0x09,
-		0xce, 0xc4, 0x8c, 0x1a, 0xaf, 0x40, 0x9f, 0xb7, 0x55, 0x48, 0x31, 0xb6, 0x0a, 0x8f, 0xf8, 0xd0,
-		0xa0, 0x9a, 0x30, 0x17, 0xfb, 0xc8, 0xb4, 0x0a, 0x03, 0x6c, 0xb7, 0x6e, 0x45, 0x4e, 0x83, 0x02,
-		0x2f, 0xc0, 0x36, 0x67, 0x1a, 0x38, 0xe5, 0x48, 0xeb, 0xa2, 0xf0, 0x3b, 0x09, 0x4e, 0xc7, 0xe9,
-		0x1a, 0xf1, 0x87, 0x40, 0x52, 0xb2, 0x10, 0xe8, 0x16, 0x8c, 0x09, 0xc2, 0x8c, 0x54, 0x94, 0x47,
-		0x1e, 0xb1, 0x39, 0x21, 0x46, 0xdb, 0x56, 0x93, 0xf6, 0x6d, 0x35, 0x85, 0xd7, 0x25, 0x28, 0x44,
-		0x37, 0x9c, 0xa0, 0x05, 0x40, 0xc1, 0x26, 0x84, 0x66, 0x1b, 0x5a, 0xde, 0xf6, 0x4d, 0x41, 0x60,
-		0xbf, 0x4d, 0x05, 0xf6, 0x5b, 0xbf, 0xf3, 0x48, 0x07, 0x9c, 0x47, 0xe1, 0xef, 0x81, 0xe9, 0x15,
-		0x5a, 0x48, 0x32, 0x44, 0x73, 0x90, 0xf7, 0x27, 0xa2, 0x9a, 0xea, 0x35, 0x68, 0xb7, 0x8d, 0x38,
-		0x80, 0x3d, 0x1d, 0xc0, 0x7e, 0x16, 0x86, 0xb6, 0x74, 0x43, 0xb1, 0xf6, 0xcb, 0xea, 0x0e, 0x56,
-		0x77, 0xed, 0x7a, 0x95, 0xc6, 0xa8, 0x59, 0x79, 0xd0, 0xbd, 0xbd, 0xca, 0xee, 0xa2, 0x73, 0x30,
-		0xec, 0x4f, 0x9f, 0xe2, 0x87, 0x6e, 0xfc, 0x39, 0x20, 0xe7, 0x71, 0x7b, 0x56, 0x13, 0x3f, 0x24,
-		0x85, 0xef, 0xa4, 0xe1, 0x54, 0x8c, 0x5e, 0x96, 0x47, 0x36, 0xe2, 0xa0, 0x59, 0xa4, 0xbb, 0x30,
-		0x0b, 0x74, 0x02, 0x72, 0x5b, 0x8a, 0x8d, 0xbd, 0xd8, 0xc9, 0x9d, 0x96, 0xac, 0x73, 0xcb, 0x8d,
-		0x98, 0xa6, 0x01, 0x0c, 0xdc, 0xf0, 0x1e, 0xf7, 0xba, 0x13, 0x6b, 0xe0, 0x86, 0xfb, 0x74, 0x01,
-		0xd0, 0xb6, 0x69, 0xed, 0x32, 0xa4, 0x5e, 0x43, 0x62, 0xc6, 0x1d, 0x9a, 0xf3, 0x84, 0x62, 0xbd,
-		0xcf, 0x3a, 0x13, 0xc7, 0x1c, 0xe7, 0xa8, 0xd8, 0xa6, 0xc1, 0x82, 0x63, 0x76, 0x85, 0x6e, 0x42,
-		0xaf, 0xaa, 0xd4, 0x6d, 0xcc, 0xe2, 0xe0, 0x62, 0xec, 0xae, 0xa1, 0x55, 0x87, 0x4b, 0x76, 0x99,
-		0x03, 0x0a, 0x9a, 0x0d, 0x2a, 0xe8, 0xbb, 0x69, 0x38, 0x19, 0xd9, 0xe8, 0xf3, 0xc8, 0xd6, 0x6a,
-		0xc5, 0x1b, 0xa2, 0xbb, 0x48, 0x0b, 0x31, 0xfb, 0x90, 0x7c, 0x03, 0x6c, 0x73, 0xd9, 0x3d, 0x49,
-		0x5c, 0x76, 0xbb, 0x65, 0xf4, 0x06, 0x2c, 0x23, 0xb0, 0xfc, 0x99, 0xf0, 0xe5, 0xef, 0x8b, 0xb5,
-		0xfc, 0xfd, 0x82, 0xe5, 0xe7, 0x58, 0x61, 0x96, 0x6b, 0x85, 0xfe, 0x95, 0x84, 0xe0, 0x4a, 0x7e,
-		0x25, 0x03, 0xa7, 0xe3, 0xb4, 0x48, 0xa1, 0x19, 0xc8, 0x35, 0xfb, 0x0c, 0xd8, 0x2a, 0x66, 0x65,
-		0xf0, 0x6e, 0x95, 0x34, 0xe7, 0x4c, 0xde, 0x6a, 0x44, 0x70, 0x4c, 0x28, 0x15, 0x72, 0x26, 0x6f,
-		0xbe, 0x92, 0x9e, 0xc9, 0x95, 0xb6, 0x2b, 0x47, 0xb1, 0x35, 0xb3, 0xaa, 0xe8, 0x06, 0xf3, 0x3c,
-		0xec, 0xca, 0xbf, 0x95, 0xf4, 0x74, 0x79, 0x9a, 0xce, 0xc4, 0x3f, 0x4d, 0x6f, 0xc2, 0x84, 0xa7,
-		0xa3, 0x9d, 0x3b, 0x50, 0x5f, 0xd4, 0x0e, 0x34, 0xe6, 0xf1, 0x06, 0x36, 0xa1, 0x80, 0x54, 0xb6,
-		0xc1, 0x31, 0xa9, 0xfd, 0x09, 0xa4, 0xba, 0x87, 0x68, 0x26, 0x55, 0xbc, 0x55, 0x66, 0xbb, 0xda,
-		0x2a, 0xd7, 0x61, 0x78, 0x07, 0x2b, 0x16, 0xd9, 0xc2, 0x4a, 0x0b, 0x1d, 0x44, 0x89, 0xca, 0x37,
-		0x79, 0x5a, 0x72, 0xa2, 0x03, 0x9c, 0x5c, 0x74, 0x80, 0xd3, 0x71, 0xd4, 0x1c, 0xe8, 0xe6, 0xa8,
-		0xd9, 0x3a, 0xb2, 0x1c, 0x89, 0x7d, 0x64, 0x29, 0xfc, 0x4d, 0x82, 0x42, 0x74, 0xbb, 0xde, 0x63,
-		0x0b, 0x0d, 0xda, 0x83, 0x98, 0x1e, 0xff, 0x79, 0xf9, 0x25, 0x18, 0xa0, 0xe9, 0x06, 0xcf, 0xad,
-		0xf5, 0xc6, 0x70, 0x6b, 0x39, 0x87, 0x83, 0x5d, 0x14, 0xfe, 0x20, 0xf9, 0x5d, 0xc1, 0x21, 0xc7,
-		0xe5, 0xfc, 0x29, 0x4a, 0x25, 0xd8, 0x0d, 0xd2, 0x91, 0xb1, 0x4a, 0x8f, 0x7f, 0x32, 0x0b, 0xbf,
-		0x97, 0xe0, 0x64, 0x74, 0x0f, 0x55, 0xb7, 0xe1, 0xfb, 0x87, 0x31, 0xa2, 0x9f, 0xa7, 0xe0, 0x54,
-		0x8c, 0x4e, 0x44, 0x67, 0x4c, 0x1a, 0x26, 0x8a, 0x5e, 0xb1, 0x63, 0x2d, 0x92, 0x47, 0xfc, 0xc8,
-		0xc6, 0x14, 0x8c, 0xaf, 0x7a, 0xba, 0x89, 0xaf, 0x0e, 0xac, 0xe2, 0x5f, 0x94, 0x60, 0x3e, 0x7e,
-		0x03, 0x61, 0x9c, 0x3d, 0xef, 0x70, 0x0e, 0x70, 0xef, 0x48, 0x90, 0xb0, 0x55, 0x30, 0x1a, 0xdb,
-		0xa8, 0x17, 0x25, 0xb1, 0x53, 0xb8, 0x1b, 0xf7, 0xc4, 0x41, 0x9c, 0x8e, 0x81, 0xf8, 0xad, 0x80,
-		0x1e, 0x8a, 0x8a, 0x8a, 0xdd, 0xea, 0xe1, 0x3a, 0xcc, 0x56, 0x14, 0xd2, 0xd6, 0x32, 0x13, 0x6c,
-		0x20, 0x69, 0xcd, 0xac, 0x4b, 0xc7, 0x5b, 0x4a, 0x37, 0xaa, 0xe2, 0xe8, 0x73, 0x3a, 0x81, 0x3e,
-		0xf7, 0x44, 0xda, 0x68, 0x20, 0x0e, 0x2c, 0xbc, 0x27, 0xc1, 0x54, 0x48, 0x93, 0x2e, 0x9a, 0x80,
-		0x7e, 0xb7, 0x39, 0xb1, 0xb9, 0x6e, 0x7d, 0xf4, 0xba, 0xa4, 0xa1, 0x0d, 0x38, 0xda, 0xdc, 0xc8,
-		0xb7, 0x75, 0x2b, 0xc1, 0x91, 0x17, 0xb1, 0x7d, 0x7c, 0x5d, 0xb7, 0x70, 0x92, 0xed, 0x37, 0xce,
-		0x62, 0x7f, 0x0c, 0x26, 0x84, 0xdd, 0xbf, 0x61, 0xa3, 0x89, 0x1d, 0xd2, 0x17, 0xde, 0x95, 0x60,
-		0x3a, 0xac, 0xf1, 0xf3, 0x50, 0xde, 0x72, 0x58, 0xf3, 0x11, 0xea, 0xa0, 0x7f, 0x2c, 0xc1, 0x6c,
-		0x54, 0x03, 0x69, 0xd8, 0x68, 0x1e, 0xa9, 0xd9, 0x86, 0x6f, 0x96, 0x59, 0x48, 0xd8, 0xa7, 0x84,
-		0x16, 0x61, 0x94, 0xb6, 0x42, 0x05, 0xab, 0x06, 0xee, 0x98, 0x86, 0x0d, 0xdc, 0x08, 0xd4, 0x0c,
-		0x3a, 0x0a, 0x77, 0xa9, 0xee, 0x0a, 0x77, 0x4f, 0x4a, 0x6b, 0xf1, 0x4b, 0x6b, 0x71, 0x74, 0xa7,
-		0x2f, 0x86, 0xee, 0xdc, 0x86, 0x31, 0x56, 0x12, 0x61, 0x18, 0x75, 0x83, 0x60, 0x6b, 0x4f, 0xa9,
-		0x44, 0x9f, 0x5b, 0x46, 0x19, 0x23, 0x85, 0x57, 0x62, 0x6c, 0xfe, 0xb2, 0x5d, 0xf6, 0x40, 0x65,
-		0xbb, 0xb6, 0x10, 0x0e, 0x92, 0x84, 0x70, 0xe2, 0x1a, 0x5d, 0xae, 0xeb, 0x1a, 0x5d, 0xeb, 0x9c,
-		0x31, 0x10, 0xbf, 0x34, 0xe2, 0x55, 0x8a, 0x8e, 0x1c, 0xa0, 0x52, 0x34, 0x78, 0xb0, 0x4a, 0x91,
-		0xa0, 0x64, 0x31, 0xf4, 0x18, 0x4a, 0x16, 0xf9, 0x47, 0x52, 0xb2, 0x28, 0xfc, 0x55, 0x82, 0xc5,
-		0xa4, 0xed, 0x9f, 0x4d, 0xff, 0x2b, 0xb5, 0xfb, 0xdf, 0xb0, 0x13, 0xdb, 0x16, 0x1c, 0x6b, 0xb6,
-		0x8c, 0x04, 0xda, 0x08, 0x5c, 0xcf, 0x34, 0x1f, 0xda, 0x14, 0xe2, 0x6f, 0x24, 0x38, 0x8a, 0x79,
-		0xb7, 0x03, 0xa7, 0xc2, 0x9e, 0x60, 0x16, 0xe7, 0xdb, 0x12, 0xa7, 0x02, 0x20, 0xda, 0x4a, 0xe3,
-		0xf8, 0x03, 0x29, 0x86, 0x3f, 0x68, 0x0b, 0xed, 0x52, 0x09, 0x42, 0xbb, 0xc2, 0x07, 0x12, 0x1c,
-		0x0f, 0xfd, 0xba, 0xc1, 0x89, 0x6d, 0xd9, 0xb7, 0x13, 0x86, 0x52, 0xf5, 0x56, 0x02, 0xdc, 0x5b,
-		0xb7, 0x94, 0x2a, 0xee, 0xf6, 0xd5, 0x87, 0xb6, 0x8d, 0xb6, 0x4c, 0xbc, 0x27, 0x7e, 0x2a, 0xe1,
-		0x67, 0xbc, 0x45, 0x12, 0x75, 0xf3, 0xcc, 0x40, 0x8e, 0xf5, 0x53, 0xb5, 0x4f, 0x81, 0x7b, 0x8b,
-		0x4e, 0x41, 0x73, 0x17, 0x4b, 0xc5, 0xdf, 0xc5, 0xc2, 0xd2, 0xfa, 0x11, 0x1a, 0xf6, 0x25, 0x09,
-		0xe6, 0x13, 0x34, 0xb8, 0xb5, 0xb2, 0xd3, 0x92, 0x2f, 0x3b, 0xdd, 0xed, 0xc2, 0x85, 0x20, 0x2f,
-		0xfc, 0x32, 0x05, 0x2f, 0x1e, 0xac, 0xc9, 0xff, 0xd0, 0x4c, 0xa2, 0x95, 0xbb, 0x4c, 0xf9, 0x72,
-		0x97, 0xf7, 0x00, 0x75, 0x36, 0x93, 0x31, 0xef, 0x70, 0x26, 0x5e, 0xb1, 0x5a, 0x1e, 0xee, 0xe8,
-		0x08, 0x47, 0xe3, 0xd0, 0xa7, 0x9a, 0x06, 0xb1, 0xcc, 0x0a, 0x5d, 0xb0, 0x01, 0xd9, 0xbb, 0x44,
-		0x45, 0x18, 0x09, 0xf4, 0x45, 0x9a, 0x46, 0xc5, 0x3d, 0xa9, 0xf4, 0xcb, 0xc3, 0xbe, 0x76, 0xc5,
-		0xdb, 0x46, 0x65, 0xbf, 0xf0, 0x66, 0x1a, 0x6e, 0x1c, 0xe0, 0x23, 0x02, 0x74, 0xaf, 0xdd, 0x6b,
-		0x0e, 0x0a, 0x3e, 0xd1, 0x89, 0x25, 0xd9, 0x97, 0xa5, 0x3f, 0xa4, 0xf3, 0xb5, 0x30, 0xa7, 0xcc,
-		0x5f, 0x97, 0x9e, 0x83, 0xae, 0xcb, 0x02, 0xa0, 0x60, 0xeb, 0x26, 0xab, 0xf7, 0xa4, 0xe5, 0xbc,
-		0xee, 0x53, 0x42, 0x37, 0xa5, 0xe7, 0xad, 0x62, 0xc6, 0xb7, 0x8a, 0x85, 0x3f, 0x4a, 0x70, 0xb5,
-		0xcb, 0x2f, 0x20, 0x04, 0x18, 0x24, 0x01, 0x86, 0xc7, 0xab, 0xb8, 0x85, 0xcf, 0xa7, 0xe1, 0x6a,
-		0x97, 0x5d, 0xaa, 0xff, 0xa9, 0xb6, 0x1a, 0x70, 0xe8, 0x3d, 0x62, 0x87, 0xde, 0x1b, 0xdf, 0xa1,
-		0x0b, 0x55, 0x47, 0xe4, 0x00, 0xfa, 0x44, 0x0e, 0xe0, 0x73, 0x69, 0xb8, 0xdc, 0x4d, 0xa7, 0x6d,
-		0x3c, 0xcb, 0x8f, 0x25, 0xf9, 0x89, 0xe5, 0xb7, 0x2c, 0xff, 0x7d, 0x09, 0x2e, 0x24, 0xed, 0x1a,
-		0xfe, 0xb7, 0x36, 0x79, 0xf1, 0x5e, 0x55, 0xf8, 0xad, 0x04, 0xe7, 0x13, 0x75, 0x1a, 0x1f, 0x9a,
-		0x0b, 0xe0, 0x9e, 0xa2, 0x52, 0x07, 0x3a, 0x45, 0x15, 0xbe, 0x97, 0x83, 0x4b, 0x5d, 0x7c, 0x32,
-		0xd5, 0xb6, 0x1c, 0x92, 0x6f, 0x39, 0x66, 0x20, 0xd7, 0x5c, 0x0e, 0xa6, 0xf3, 0x59, 0x19, 0xbc,
-		0x5b, 0xbc, 0x94, 0x4a, 0xfa, 0x10, 0x52, 0x2a, 0xdd, 0xd6, 0x57, 0x7b, 0x0f, 0x37, 0xa5, 0x92,
-		0x79, 0xa4, 0x29, 0x95, 0xbe, 0xae, 0x53, 0x2a, 0xf7, 0x81, 0x35, 0x7c, 0x33, 0x89, 0xec, 0x18,
-		0xdb, 0x1f, 0x72, 0x54, 0x76, 0xbb, 0xc6, 0xa9, 0x14, 0xef, 0xa8, 0x5c, 0x0b, 0xde, 0x6a, 0x37,
-		0x92, 0xac, 0xdf, 0x9f, 0xc7, 0x51, 0x79, 0x88, 0xa1, 0xf2, 0x2a, 0x8c, 0xb7, 0xa9, 0x53, 0xd9,
-		0xc2, 0xf5, 0x16, 0xfc, 0x1c, 0x85, 0x3f, 0x1f, 0xaa, 0x38, 0x25, 0x4d, 0x76, 0x58, 0xd8, 0x10,
-		0x8e, 0x36, 0x78, 0xb7, 0x3b, 0xca, 0xb5, 0x47, 0xba, 0x29, 0xd7, 0x76, 0xb4, 0xee, 0x0e, 0x72,
-		0x5a, 0x77, 0x5b, 0x07, 0xb1, 0xa1, 0xe4, 0xb9, 0x96, 0xfc, 0x01, 0x72, 0x2d, 0xc3, 0x07, 0xcb,
-		0xb5, 0x5c, 0x87, 0x9c, 0x86, 0x2b, 0xca, 0xbe, 0xab, 0x9a, 0xd1, 0x2d, 0xc6, 0x40, 0xa9, 0xa9,
-		0x2a, 0xa2, 0xe7, 0x61, 0xe0, 0xe3, 0x3a, 0x21, 0xde, 0xdf, 0x87, 0x34, 0x9b, 0x8b, 0x85, 0xcc,
-		0x39, 0x97, 0xbc, 0xc9, 0xed, 0xf6, 0xe0, 0x5a, 0x75, 0xa3, 0xac, 0x10, 0xd6, 0x5e, 0x1c, 0xd6,
-		0x7b, 0x0b, 0x94, 0x5e, 0xae, 0x1b, 0xcb, 0x44, 0x94, 0x23, 0x3a, 0xfa, 0x18, 0x72, 0x44, 0x63,
-		0x8f, 0x26, 0x47, 0xf4, 0x46, 0x1a, 0x2e, 0x24, 0xfd, 0x40, 0xf4, 0xc3, 0x77, 0xd6, 0x1b, 0x5e,
-		0xd4, 0xe5, 0xd6, 0x51, 0xaf, 0x24, 0xfe, 0xba, 0xd1, 0x17, 0x6c, 0xb5, 0xb9, 0x9d, 0x5e, 0xbf,
-		0xdb, 0xe1, 0x87, 0x14, 0x19, 0x41, 0x48, 0x71, 0x48, 0x99, 0xe6, 0xc2, 0x6f, 0x52, 0xb0, 0x90,
-		0xe4, 0xeb, 0x57, 0xe1, 0x7a, 0xf0, 0x63, 0x99, 0xd4, 0x41, 0x63, 0x99, 0xc3, 0x5a, 0x45, 0xfe,
-		0xec, 0xf6, 0x08, 0x66, 0xb7, 0xe5, 0xeb, 0x7a, 0xe3, 0x27, 0x9d, 0x3e, 0x48, 0x41, 0xc2, 0xef,
-		0x72, 0x3f, 0x1a, 0x93, 0xc9, 0x2b, 0x1a, 0xf6, 0x72, 0x8b, 0x86, 0xad, 0x6e, 0x97, 0x4c, 0xfc,
-		0x6e, 0x97, 0xc2, 0x3f, 0x52, 0x70, 0xee, 0x30, 0x3c, 0xca, 0x47, 0x74, 0xd2, 0xdb, 0xea, 0x39,
-		0x99, 0x04, 0xf5, 0x9c, 0xc2, 0x3f, 0x53, 0x70, 0x3e, 0xd1, 0x67, 0xd2, 0x4f, 0x26, 0xbe, 0x63,
-		0xe2, 0xbd, 0x04, 0x6d, 0x26, 0x49, 0x52, 0xff, 0xd3, 0x69, 0xd1, 0xc4, 0x8b, 0x3a, 0x94, 0x9e,
-		0x4c, 0x7c, 0x68, 0x83, 0x54, 0xa6, 0x9b, 0xef, 0x32, 0x7e, 0x91, 0x82, 0xc5, 0x84, 0x9f, 0xaf,
-		0x3f, 0x59, 0x07, 0xdf, 0x3a, 0xcc, 0x13, 0x18, 0xa2, 0x3f, 0xd7, 0xf5, 0x0a, 0xc1, 0x16, 0x7d,
-		0xd5, 0x71, 0x98, 0x58, 0xbb, 0xbf, 0x76, 0x6b, 0xb3, 0xbc, 0x5e, 0xda, 0xd8, 0x5c, 0x93, 0xcb,
-		0x9b, 0xff, 0x77, 0x67, 0xad, 0x5c, 0xba, 0x75, 0x7f, 0x79, 0xa3, 0x74, 0x33, 0xff, 0x14, 0x9a,
-		0x81, 0xa9, 0xce, 0xc7, 0xcb, 0x1b, 0x1b, 0x65, 0x7a, 0x37, 0x2f, 0xa1, 0x93, 0x70, 0xbc, 0x93,
-		0x60, 0x75, 0xe3, 0xf6, 0xdd, 0x35, 0x46, 0x92, 0x5a, 0x79, 0x15, 0x8e, 0xa9, 0x66, 0x95, 0x37,
-		0x07, 0x2b, 0xde, 0x1f, 0x20, 0xdf, 0x71, 0x22, 0xf3, 0x3b, 0xd2, 0xff, 0x5f, 0x7c, 0xa0, 0x93,
-		0x9d, 0xfa, 0x56, 0x51, 0x35, 0xab, 0x8b, 0xed, 0x7f, 0xc4, 0x7c, 0x5e, 0xd7, 0x2a, 0x8b, 0x0f,
-		0x4c, 0xf7, 0xcf, 0x9f, 0xd9, 0xbf, 0x32, 0xdf, 0x50, 0x6a, 0xfa, 0xde, 0xc5, 0xad, 0x0c, 0xbd,
-		0x77, 0xe9, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xc4, 0x77, 0x00, 0x78, 0x5a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5f, 0x6c, 0x1c, 0x49,
+		0x5a, 0xbf, 0x9e, 0xb1, 0xc7, 0x9e, 0x6f, 0x1c, 0x67, 0x5c, 0x49, 0x1c, 0x3b, 0xce, 0x1f, 0x67,
+		0x92, 0x4d, 0xbc, 0x89, 0x33, 0x93, 0x38, 0xd9, 0xe4, 0xb2, 0xb9, 0xdd, 0xc3, 0x76, 0x6c, 0xed,
+		0x48, 0x26, 0x31, 0x1d, 0x27, 0x07, 0x68, 0xc5, 0xd0, 0xee, 0x2e, 0xc7, 0x7d, 0x9e, 0xe9, 0x9e,
+		0xeb, 0xee, 0xf1, 0xc4, 0x08, 0x9e, 0x00, 0x9d, 0x84, 0x38, 0xc1, 0xe9, 0x84, 0xc4, 0x49, 0x20,
+		0x4e, 0x48, 0x20, 0x8e, 0x3f, 0x3a, 0x04, 0x42, 0xc0, 0xf1, 0xc2, 0x21, 0xa1, 0x43, 0x02, 0x1d,
+		0x3c, 0xf1, 0xc2, 0x2b, 0x42, 0xc0, 0x03, 0xe2, 0x81, 0xe3, 0x19, 0x75, 0x55, 0xf5, 0xcc, 0x74,
+		0x77, 0x55, 0x77, 0xf5, 0x8c, 0xbd, 0xcb, 0xe9, 0xf2, 0xe6, 0xae, 0xfe, 0xea, 0xeb, 0x5f, 0x7d,
+		0xf5, 0x55, 0xd5, 0xf7, 0xaf, 0xc6, 0x70, 0xb5, 0xb3, 0x8b, 0x9d, 0x9a, 0xae, 0x19, 0xd8, 0xd2,
+		0x71, 0x4d, 0x6b, 0x9b, 0xb5, 0xc3, 0x7b, 0xb5, 0x7d, 0xd3, 0xf5, 0x6c, 0xe7, 0xa8, 0xda, 0x76,
+		0x6c, 0xcf, 0x46, 0x67, 0x7c, 0x92, 0x2a, 0x23, 0xa9, 0x6a, 0x6d, 0xb3, 0x7a, 0x78, 0xef, 0xc2,
+		0xe5, 0xd7, 0xb6, 0xfd, 0xba, 0x89, 0x6b, 0x84, 0x64, 0xb7, 0xb3, 0x57, 0x33, 0x3a, 0x8e, 0xe6,
+		0x99, 0xb6, 0x45, 0x3b, 0x5d, 0xb8, 0x12, 0x7d, 0xef, 0x99, 0x2d, 0xec, 0x7a, 0x5a, 0xab, 0xcd,
+		0x08, 0x16, 0x79, 0x1f, 0xd6, 0xed, 0x56, 0xab, 0xc7, 0xa2, 0xc2, 0xa3, 0xf0, 0x34, 0xf7, 0xa0,
+		0x69, 0xba, 0x5e, 0x12, 0x4d, 0xd7, 0x76, 0x0e, 0xf6, 0x9a, 0x76, 0x97, 0xd2, 0x54, 0x9e, 0xc2,
+		0xc4, 0x47, 0x74, 0x40, 0xe8, 0x31, 0x14, 0xf0, 0x21, 0xb6, 0x3c, 0x77, 0x4e, 0x59, 0xcc, 0x2f,
+		0x95, 0x56, 0xae, 0x56, 0x39, 0x63, 0xab, 0x32, 0xea, 0x0d, 0x9f, 0x52, 0x65, 0x1d, 0x2a, 0xdf,
+		0x5e, 0x83, 0xa9, 0xc1, 0x17, 0x68, 0x1e, 0x26, 0xc9, 0xab, 0x86, 0x69, 0xcc, 0x29, 0x8b, 0xca,
+		0x52, 0x5e, 0x9d, 0x20, 0xcf, 0x75, 0x03, 0x3d, 0x06, 0xa0, 0xaf, 0xfc, 0x41, 0xcf, 0xe5, 0x16,
+		0x95, 0xa5, 0xd2, 0xca, 0x85, 0x2a, 0x95, 0x48, 0x35, 0x90, 0x48, 0x75, 0x27, 0x90, 0x88, 0x5a,
+		0x24, 0xd4, 0xfe, 0x33, 0x9a, 0x83, 0x89, 0x43, 0xec, 0xb8, 0xa6, 0x6d, 0xcd, 0xe5, 0x29, 0x53,
+		0xf6, 0x88, 0xce, 0xc3, 0x84, 0x3f, 0x78, 0xff, 0x73, 0x63, 0xe4, 0x4d, 0xc1, 0x7f, 0xac, 0x1b,
+		0xe8, 0x37, 0x14, 0xb8, 0x1d, 0x0c, 0xb9, 0x81, 0xdf, 0x60, 0xbd, 0xe3, 0xcf, 0x43, 0xc3, 0xf5,
+		0x34, 0xc7, 0xc3, 0x46, 0x83, 0x22, 0xd1, 0x3c, 0xcf, 0x31, 0x77, 0x3b, 0x1e, 0x76, 0xe7, 0xc6,
+		0x09, 0x9e, 0xcf, 0x71, 0x87, 0xfe, 0x05, 0xc6, 0x67, 0x23, 0x60, 0xf3, 0x82, 0x72, 0x21, 0x43,
+		0x5e, 0xed, 0xf1, 0xf8, 0xe8, 0x33, 0xea, 0xcd, 0xae, 0x1c, 0x29, 0xfa, 0x6d, 0x05, 0xee, 0x70,
+		0xe0, 0xe9, 0x76, 0xab, 0xdd, 0xc4, 0x5c, 0x80, 0x05, 0x02, 0xf0, 0x43, 0x39, 0x80, 0xeb, 0x01,
+		0x9f, 0x38, 0xc4, 0x77, 0xbb, 0xb2, 0xc4, 0xe8, 0xeb, 0x0a, 0xdc, 0xe2, 0x80, 0xdc, 0xd3, 0xcc,
+		0x26, 0x0f, 0xe1, 0x04, 0x41, 0xf8, 0x44, 0x0e, 0xe1, 0x26, 0x61, 0x12, 0x87, 0x77, 0xa3, 0x2b,
+		0x45, 0x89, 0xbe, 0xc1, 0x17, 0xa0, 0xaf, 0x5b, 0x46, 0xc3, 0xee, 0x78, 0x71, 0x78, 0x93, 0x04,
+		0xde, 0x07, 0x72, 0xf0, 0x7c, 0xb5, 0x33, 0x9e, 0x77, 0xbc, 0x38, 0xc0, 0xa5, 0xae, 0x24, 0x2d,
+		0xfa, 0x9a, 0x02, 0x4b, 0x06, 0xd6, 0x4d, 0x97, 0x00, 0xf3, 0xb5, 0xd4, 0xd5, 0xf7, 0xb1, 0xd1,
+		0xe1, 0x0a, 0xaf, 0x48, 0xd0, 0x3d, 0xe6, 0xa2, 0x7b, 0xca, 0x98, 0xec, 0x68, 0xee, 0xc1, 0x8b,
+		0x80, 0x45, 0x1c, 0xd9, 0x75, 0x43, 0x82, 0x0e, 0x7d, 0x45, 0x81, 0x1b, 0x11, 0x54, 0xa2, 0x35,
+		0x01, 0x04, 0xd3, 0xa3, 0x74, 0x4c, 0xa2, 0xe5, 0x50, 0x31, 0x52, 0xa9, 0x38, 0x52, 0x4a, 0x58,
+		0x04, 0x25, 0x49, 0x29, 0x25, 0xe8, 0x7f, 0x48, 0x4a, 0x42, 0xd5, 0xff, 0x6a, 0x0c, 0x55, 0x82,
+		0x66, 0x4d, 0x11, 0x54, 0x9f, 0x4d, 0x45, 0x25, 0x56, 0xaa, 0x6b, 0x46, 0x3a, 0x19, 0xfa, 0x25,
+		0x05, 0xde, 0x09, 0x63, 0x12, 0xad, 0xc4, 0x53, 0x04, 0xd0, 0xc3, 0x54, 0x40, 0xa2, 0x45, 0x78,
+		0xd5, 0x48, 0x23, 0x22, 0xd3, 0xa6, 0xe9, 0x9e, 0x79, 0x68, 0x7a, 0x47, 0xa9, 0xca, 0x3d, 0x9d,
+		0x30, 0x6d, 0xab, 0x8c, 0x49, 0x9a, 0x72, 0x6b, 0x12, 0x74, 0x44, 0xb9, 0x23, 0xa8, 0x44, 0xca,
+		0x7d, 0x3a, 0x41, 0xb9, 0x43, 0x98, 0x84, 0xca, 0xad, 0xa5, 0x52, 0x71, 0xa4, 0x94, 0xa0, 0xdc,
+		0x65, 0x49, 0x29, 0x25, 0x29, 0xb7, 0x26, 0x41, 0x47, 0x14, 0x29, 0x8c, 0x4a, 0xa4, 0x48, 0x33,
+		0x09, 0x8a, 0x34, 0x08, 0x49, 0xa8, 0x48, 0x5a, 0x1a, 0x11, 0x59, 0x69, 0x61, 0x30, 0x09, 0x2b,
+		0x0d, 0x25, 0xac, 0xb4, 0x41, 0x3c, 0x09, 0x2b, 0x4d, 0x4b, 0x27, 0x43, 0x5d, 0xb8, 0xec, 0x83,
+		0x70, 0xc4, 0xda, 0x73, 0x86, 0x00, 0xb9, 0xcb, 0x05, 0xe2, 0x73, 0x75, 0x84, 0x6a, 0xb3, 0xe0,
+		0x89, 0x5f, 0xa3, 0x2f, 0xc1, 0x45, 0xfa, 0xe1, 0x3d, 0xd3, 0xe1, 0x7d, 0xf6, 0x2c, 0xf9, 0x6c,
+		0x55, 0xfc, 0xd9, 0x4d, 0xbf, 0x5f, 0xfc, 0xa3, 0xf3, 0x9e, 0xe8, 0x25, 0xfa, 0x5d, 0x05, 0x6a,
+		0x11, 0x15, 0xd5, 0x2c, 0x1d, 0x37, 0x1b, 0x0e, 0xfe, 0x52, 0x07, 0xbb, 0xdc, 0xd1, 0x9f, 0x23,
+		0x30, 0x3e, 0x9f, 0xae, 0xa9, 0x84, 0x93, 0x1a, 0x30, 0x8a, 0xe3, 0xba, 0xa5, 0x49, 0x53, 0xa3,
+		0x3f, 0x55, 0xe0, 0x01, 0xc3, 0x14, 0x40, 0x94, 0x53, 0xe2, 0x59, 0x82, 0x76, 0x9d, 0x8b, 0x96,
+		0x7d, 0x8d, 0x7e, 0x5a, 0x46, 0xa3, 0xab, 0x4e, 0xa6, 0x1e, 0xe8, 0x57, 0x15, 0xb8, 0xc9, 0x13,
+		0x2f, 0x0f, 0xe8, 0x79, 0x49, 0xed, 0x5e, 0x67, 0x1c, 0x52, 0xb4, 0x5b, 0x40, 0x86, 0x7e, 0x06,
+		0xae, 0x50, 0x25, 0x13, 0x23, 0x99, 0x23, 0x48, 0xee, 0x89, 0xf5, 0x4c, 0x0c, 0x81, 0x2a, 0xb0,
+		0xe8, 0xdb, 0x5f, 0x56, 0xe0, 0x3a, 0x9b, 0x3c, 0xa6, 0xe8, 0x82, 0x49, 0x9b, 0x27, 0x08, 0xde,
+		0xe3, 0x22, 0xa0, 0xcc, 0xa9, 0xbe, 0x0b, 0xa6, 0x69, 0x51, 0x4f, 0xa1, 0x41, 0x3f, 0x07, 0x8b,
+		0x2d, 0xcd, 0x39, 0xc0, 0x4e, 0xc3, 0xc1, 0xba, 0xed, 0x18, 0x3c, 0x10, 0x17, 0x08, 0x88, 0x15,
+		0x2e, 0x88, 0x1f, 0x25, 0x9d, 0x55, 0xd6, 0x37, 0x8e, 0xe0, 0x52, 0x2b, 0x89, 0x00, 0xfd, 0x96,
+		0x02, 0xcb, 0x3c, 0xff, 0xc4, 0x7c, 0x6d, 0x69, 0x5c, 0x81, 0x2c, 0x64, 0x31, 0x5f, 0x5f, 0x30,
+		0x36, 0x32, 0xe6, 0xab, 0x80, 0x16, 0xfd, 0x8e, 0x02, 0x55, 0x9e, 0x85, 0x8d, 0x9d, 0x96, 0x69,
+		0x69, 0xdc, 0x7d, 0xe1, 0x62, 0xc2, 0xbe, 0x10, 0x37, 0xb1, 0x7b, 0x8c, 0x38, 0xfb, 0x42, 0x57,
+		0x9a, 0x1a, 0xfd, 0x99, 0x02, 0x0f, 0x78, 0xae, 0x54, 0xea, 0x2e, 0x76, 0x89, 0xa0, 0x7d, 0x2a,
+		0xe9, 0x51, 0xa5, 0x6d, 0x65, 0xb5, 0x6e, 0xb6, 0x2e, 0x22, 0x0d, 0x10, 0x2f, 0xca, 0xcb, 0x59,
+		0x34, 0x40, 0xbc, 0x40, 0x97, 0xba, 0x92, 0xb4, 0xe8, 0x5f, 0x15, 0xd8, 0x88, 0xec, 0xb8, 0xf8,
+		0x8d, 0x87, 0x1d, 0x4b, 0x6b, 0x36, 0x38, 0xc8, 0x4d, 0xcb, 0xf4, 0x4c, 0xbe, 0x62, 0x5c, 0x21,
+		0xd0, 0x5f, 0xa4, 0x6f, 0xc1, 0x1b, 0x8c, 0x7f, 0x6c, 0x3c, 0xf5, 0x80, 0x79, 0x7c, 0x40, 0x1f,
+		0x3a, 0x23, 0x71, 0x40, 0xff, 0xa2, 0xc0, 0x5a, 0x86, 0x61, 0x8a, 0x76, 0xac, 0x45, 0x32, 0xc6,
+		0xed, 0x11, 0xc6, 0x28, 0xda, 0xcc, 0x9e, 0x38, 0xc3, 0x77, 0x47, 0xdf, 0x53, 0xe0, 0x83, 0xa4,
+		0xe1, 0xa4, 0xaf, 0x93, 0xab, 0x64, 0x60, 0x5b, 0xdc, 0x81, 0x09, 0xc1, 0xa4, 0xae, 0x97, 0x47,
+		0x78, 0xb8, 0xae, 0xc4, 0x0e, 0xe0, 0x86, 0x4e, 0x2c, 0xcf, 0xb4, 0x3a, 0xd8, 0x68, 0x68, 0x6e,
+		0xc3, 0xc2, 0xdd, 0xf8, 0x38, 0x2a, 0x09, 0x76, 0x00, 0x27, 0x82, 0xc2, 0xd8, 0xad, 0xba, 0xcf,
+		0x70, 0x97, 0x63, 0x07, 0x74, 0x33, 0xf5, 0x40, 0x7f, 0xa3, 0xc0, 0x63, 0x62, 0x4d, 0x36, 0xf4,
+		0x7d, 0xb3, 0x69, 0x64, 0x5c, 0x3f, 0xd7, 0x08, 0xf4, 0x8f, 0xb8, 0xd0, 0x89, 0x29, 0xb9, 0xee,
+		0x33, 0xcd, 0xb2, 0x68, 0xee, 0xbb, 0xd9, 0xbb, 0xa1, 0xbf, 0x54, 0xe0, 0x61, 0xca, 0x20, 0x44,
+		0xab, 0xe3, 0x3a, 0x19, 0xc1, 0x46, 0xd6, 0x11, 0x88, 0x96, 0xc4, 0x5d, 0x37, 0x63, 0x1f, 0xf4,
+		0x87, 0x0a, 0xdc, 0x13, 0xa2, 0x16, 0xda, 0xf9, 0xef, 0x10, 0xd8, 0xab, 0x7c, 0x33, 0x84, 0xfb,
+		0x75, 0xa1, 0xe1, 0xbf, 0xac, 0x67, 0xa0, 0x47, 0x7f, 0xa2, 0xc0, 0x7d, 0x21, 0xdc, 0x04, 0x27,
+		0xf2, 0x46, 0x82, 0x92, 0xf3, 0x01, 0x27, 0xb8, 0x93, 0x55, 0x3d, 0x53, 0x0f, 0xf4, 0x4d, 0x05,
+		0xee, 0x66, 0xd6, 0x8c, 0x9b, 0x04, 0xf1, 0x8f, 0x64, 0x40, 0x2c, 0x52, 0x8a, 0xdb, 0x7a, 0x06,
+		0x7d, 0xf8, 0x96, 0x02, 0x2b, 0x62, 0x01, 0x0b, 0x0f, 0xe1, 0x25, 0x82, 0x76, 0x2d, 0x8b, 0x7c,
+		0x85, 0x27, 0xf1, 0x1d, 0x3d, 0x4b, 0x07, 0xf4, 0xc7, 0x49, 0x2a, 0x91, 0xe0, 0x34, 0xbf, 0x9b,
+		0x19, 0xb2, 0xd8, 0x7d, 0x16, 0x40, 0x16, 0x39, 0xd2, 0xbe, 0x6d, 0x26, 0x86, 0x9c, 0x60, 0x49,
+		0xde, 0x4a, 0xb0, 0xcd, 0x04, 0x98, 0x13, 0xcc, 0xc9, 0x9a, 0x9e, 0xad, 0x0b, 0x39, 0x34, 0xa9,
+		0x29, 0x3e, 0xac, 0xc5, 0x73, 0x3b, 0xe1, 0xd0, 0xa4, 0x16, 0xf7, 0x30, 0xa6, 0xce, 0x23, 0x77,
+		0xb8, 0xae, 0xe8, 0x6f, 0x15, 0x78, 0x5f, 0x62, 0x40, 0xa2, 0x35, 0xba, 0x4c, 0x46, 0x53, 0x1f,
+		0x66, 0x34, 0xa2, 0xc5, 0xfa, 0xc0, 0x1d, 0xa2, 0x1f, 0xfa, 0x0b, 0x05, 0xde, 0x4b, 0x1a, 0x80,
+		0xd8, 0x7f, 0xba, 0x93, 0x70, 0x00, 0x09, 0x41, 0x88, 0xfd, 0xa8, 0xbb, 0x38, 0x63, 0x1f, 0xb2,
+		0xe1, 0x74, 0xda, 0x2e, 0x76, 0xbc, 0x3e, 0x70, 0x17, 0x6b, 0x8e, 0xbe, 0x3f, 0x00, 0x33, 0x8e,
+		0xbb, 0x9a, 0xb0, 0x7a, 0x5f, 0x12, 0x76, 0x01, 0x82, 0x17, 0x84, 0x59, 0xff, 0x8b, 0x9c, 0xd5,
+		0xdb, 0xc9, 0xd2, 0x81, 0xac, 0x02, 0x9e, 0x8c, 0x63, 0xa0, 0x3b, 0x6d, 0x83, 0xbf, 0x0a, 0x6a,
+		0x09, 0xab, 0x20, 0x2e, 0xb8, 0x08, 0x9c, 0x97, 0x94, 0x2b, 0x67, 0x15, 0x74, 0x87, 0xeb, 0x2a,
+		0x4a, 0x68, 0xb5, 0xb5, 0x8e, 0xcb, 0x43, 0x7f, 0x37, 0x4b, 0x42, 0x6b, 0x9b, 0x30, 0x91, 0x49,
+		0x68, 0x71, 0x29, 0x45, 0xee, 0x60, 0xc7, 0x12, 0xa1, 0xbb, 0x97, 0xc5, 0x1d, 0x7c, 0xc9, 0xd8,
+		0xc8, 0xb8, 0x83, 0x02, 0x5a, 0x4e, 0xd8, 0x58, 0x04, 0x6d, 0x45, 0x32, 0x6c, 0x2c, 0x92, 0x59,
+		0x28, 0x6c, 0xcc, 0x17, 0x57, 0x3c, 0xae, 0x26, 0x96, 0xd4, 0x7d, 0xc9, 0xb8, 0x9a, 0x58, 0x48,
+		0xa1, 0xb8, 0x9a, 0x48, 0x3e, 0xbe, 0xc9, 0xc6, 0x9b, 0x41, 0xa2, 0x8b, 0x0d, 0x4d, 0xd7, 0x71,
+		0x9b, 0xbb, 0x48, 0x1e, 0x64, 0xf1, 0x4b, 0xa8, 0x66, 0xaf, 0x32, 0x66, 0x32, 0x7e, 0x49, 0x62,
+		0x8f, 0x14, 0xd0, 0x0e, 0xfe, 0x22, 0xd6, 0xb9, 0xa0, 0xdf, 0xcb, 0x0e, 0x5a, 0x65, 0xcc, 0xe4,
+		0x41, 0x0b, 0x7a, 0x88, 0x42, 0x3e, 0x0c, 0x74, 0x82, 0x75, 0xfc, 0x30, 0x4b, 0xc8, 0x87, 0x62,
+		0x48, 0x30, 0x8f, 0x6b, 0xdd, 0x6c, 0x5d, 0xd6, 0xa6, 0x00, 0xfa, 0x60, 0x2a, 0xdf, 0x28, 0xc3,
+		0x4d, 0x59, 0x77, 0x60, 0x13, 0x4e, 0xf5, 0x06, 0xec, 0x1d, 0xb5, 0x31, 0x29, 0xae, 0x10, 0x95,
+		0x6a, 0x04, 0x4c, 0x77, 0x8e, 0xda, 0x58, 0x9d, 0xea, 0x0e, 0x3c, 0xa1, 0x8f, 0xe1, 0x5c, 0x5b,
+		0x73, 0x7c, 0xa9, 0x0c, 0x5a, 0x31, 0x7b, 0x36, 0xab, 0xc7, 0x58, 0xe2, 0xf2, 0xdb, 0x26, 0x3d,
+		0x06, 0x8c, 0x8c, 0x3d, 0x5b, 0x3d, 0xd3, 0x8e, 0x37, 0xa2, 0xf7, 0xa1, 0x48, 0x96, 0x62, 0xd3,
+		0x74, 0x3d, 0x52, 0xa9, 0x51, 0x5a, 0xb9, 0xc4, 0x8f, 0x21, 0x6b, 0xee, 0xc1, 0x96, 0xe9, 0x7a,
+		0xea, 0xa4, 0xc7, 0xfe, 0x42, 0x2b, 0x30, 0x6e, 0x5a, 0xed, 0x8e, 0x47, 0xea, 0x38, 0x4a, 0x2b,
+		0x17, 0x05, 0x48, 0x8e, 0x9a, 0xb6, 0x66, 0xa8, 0x94, 0x14, 0x69, 0xb0, 0x18, 0xf1, 0xe1, 0x1a,
+		0x9e, 0xdd, 0xd0, 0x9b, 0xb6, 0x8b, 0x89, 0x41, 0x6c, 0x77, 0x3c, 0x56, 0xd8, 0x31, 0x1f, 0x2b,
+		0x34, 0x79, 0xca, 0x4a, 0x73, 0xd4, 0x8b, 0x38, 0x24, 0xfb, 0x1d, 0x7b, 0xdd, 0xef, 0xbf, 0x43,
+		0xbb, 0xa3, 0x2f, 0xc0, 0x42, 0x3f, 0x8f, 0x18, 0xe7, 0x5e, 0x48, 0xe3, 0x7e, 0xde, 0x0b, 0xb2,
+		0x83, 0x11, 0xc6, 0x4f, 0xe0, 0x42, 0x3f, 0x64, 0xd1, 0x1f, 0x85, 0xd3, 0xb1, 0x1a, 0xa6, 0x41,
+		0x6a, 0x29, 0x8a, 0xea, 0xf9, 0x1e, 0x45, 0x4f, 0xce, 0x6a, 0xc7, 0xaa, 0x1b, 0xa8, 0x0e, 0x45,
+		0x66, 0x7b, 0xda, 0x0e, 0x29, 0x6c, 0x98, 0x5e, 0xb9, 0xcd, 0xb7, 0x95, 0x19, 0x03, 0x12, 0x93,
+		0xa8, 0x07, 0x5d, 0xd4, 0x7e, 0x6f, 0x54, 0x87, 0x99, 0x3e, 0x0e, 0xdf, 0xfe, 0xeb, 0x38, 0x98,
+		0x55, 0x23, 0xf0, 0xe7, 0x60, 0x93, 0xd2, 0xa8, 0xe5, 0x5e, 0x37, 0xd6, 0x82, 0x54, 0x98, 0x6d,
+		0x6a, 0xae, 0x17, 0x2c, 0x40, 0x32, 0x1c, 0xec, 0x76, 0x9a, 0x1e, 0xab, 0x24, 0x48, 0x9e, 0xd3,
+		0xb3, 0x7e, 0xdf, 0xf5, 0x5e, 0x57, 0x95, 0xf4, 0x44, 0x8f, 0x61, 0xde, 0x76, 0xcc, 0xd7, 0x26,
+		0xb5, 0x5c, 0x23, 0x52, 0x2a, 0x11, 0x29, 0xcd, 0x06, 0x04, 0x11, 0x21, 0x5d, 0x80, 0x49, 0xd3,
+		0xc0, 0x96, 0x67, 0x7a, 0x47, 0x24, 0x45, 0x5f, 0x54, 0x7b, 0xcf, 0xe8, 0x3e, 0xcc, 0xee, 0x99,
+		0x8e, 0xeb, 0xc5, 0x79, 0x9e, 0x22, 0x94, 0x67, 0xc8, 0xdb, 0x08, 0xc3, 0x75, 0x98, 0x72, 0xb0,
+		0xe7, 0x1c, 0x35, 0xda, 0x76, 0xd3, 0xd4, 0x8f, 0x58, 0x5a, 0x7b, 0x51, 0x10, 0xf1, 0xf3, 0x9c,
+		0xa3, 0x6d, 0x42, 0xa7, 0x96, 0x9c, 0xfe, 0x03, 0x9a, 0x83, 0x09, 0xcd, 0xf3, 0x70, 0xab, 0xed,
+		0x91, 0x14, 0xf4, 0xb8, 0x1a, 0x3c, 0xa2, 0x75, 0x38, 0x8d, 0xdf, 0xb4, 0x4d, 0xaa, 0x38, 0xb4,
+		0x4a, 0xaa, 0x9c, 0x5a, 0x25, 0x35, 0xdd, 0xef, 0x42, 0x4a, 0xa5, 0xae, 0xc1, 0x29, 0xdd, 0xf1,
+		0x57, 0x03, 0x4b, 0x91, 0x93, 0x14, 0x6e, 0x51, 0x9d, 0xf2, 0x1b, 0x83, 0xb4, 0x39, 0xfa, 0x71,
+		0x58, 0xa0, 0xa3, 0x0f, 0x97, 0x13, 0xec, 0x6a, 0xfa, 0x81, 0xbd, 0xb7, 0xc7, 0xb2, 0xac, 0x09,
+		0x4a, 0x3d, 0x47, 0x7a, 0x0f, 0x56, 0x12, 0xac, 0xd1, 0xae, 0xe8, 0x0e, 0x8c, 0xb5, 0x70, 0xcb,
+		0x66, 0xf9, 0xd1, 0x79, 0x7e, 0xe6, 0x04, 0xb7, 0x6c, 0x95, 0x90, 0x21, 0x15, 0x66, 0x62, 0xd6,
+		0x24, 0x4b, 0x72, 0xbe, 0xc3, 0x77, 0x36, 0x22, 0x86, 0x9e, 0x5a, 0x76, 0x23, 0x2d, 0xe8, 0x25,
+		0xcc, 0xb6, 0x1d, 0x7c, 0xd8, 0xd0, 0x3a, 0x9e, 0xed, 0xeb, 0x1f, 0xf6, 0x1a, 0x6d, 0xdb, 0xb4,
+		0xbc, 0x20, 0x6d, 0x29, 0x9a, 0x2f, 0x17, 0x7b, 0xdb, 0x84, 0x4e, 0x3d, 0xe3, 0xf7, 0x5f, 0xed,
+		0x78, 0xf6, 0x40, 0x23, 0xba, 0x0f, 0x85, 0x7d, 0xac, 0x19, 0xd8, 0x61, 0xf9, 0xc4, 0x05, 0x7e,
+		0x95, 0x1c, 0x21, 0x51, 0x19, 0x29, 0xda, 0x82, 0xb3, 0x54, 0xd0, 0xfd, 0xe2, 0x08, 0x32, 0xaf,
+		0xe7, 0x53, 0xe7, 0x15, 0x91, 0x7e, 0xbd, 0x42, 0x07, 0x32, 0xb7, 0x3f, 0x0b, 0xe5, 0xb6, 0xe6,
+		0x78, 0x66, 0x10, 0xef, 0xdc, 0x33, 0x5f, 0xcf, 0xcd, 0x91, 0x92, 0xbd, 0x1f, 0x1b, 0xa5, 0x6e,
+		0xcd, 0xdf, 0xdf, 0x29, 0xd3, 0x75, 0xc2, 0x73, 0xc3, 0xf2, 0x9c, 0x23, 0xf5, 0x74, 0x3b, 0xdc,
+		0x8a, 0x2e, 0x01, 0x04, 0x51, 0x72, 0xd3, 0x20, 0xf9, 0xb9, 0xa2, 0x5a, 0x64, 0x2d, 0x75, 0x03,
+		0xbd, 0x82, 0x33, 0x44, 0xf1, 0xec, 0x43, 0xec, 0x34, 0xb5, 0x76, 0xb0, 0x46, 0x2e, 0x90, 0xcd,
+		0xe9, 0x06, 0x7f, 0x73, 0x72, 0x6c, 0xeb, 0x39, 0x25, 0x67, 0x2b, 0x65, 0x46, 0x8f, 0x36, 0xa1,
+		0x37, 0x70, 0x85, 0x18, 0x5f, 0xb8, 0xa1, 0x37, 0x3b, 0xae, 0x87, 0x9d, 0x86, 0x8b, 0x9b, 0x58,
+		0xa7, 0x86, 0x3b, 0xfd, 0xc6, 0x42, 0x42, 0xb6, 0x92, 0xd8, 0x77, 0x78, 0x9d, 0x76, 0x7d, 0x11,
+		0xf4, 0x64, 0x9f, 0xbb, 0xa8, 0x25, 0xbc, 0x45, 0x1f, 0x00, 0x10, 0x43, 0x8f, 0x1e, 0x90, 0x34,
+		0xb7, 0x75, 0x59, 0xb0, 0x85, 0x75, 0x5c, 0x4c, 0x8e, 0xc5, 0x62, 0x3b, 0xf8, 0xf3, 0xc2, 0x1a,
+		0x9c, 0xe5, 0x09, 0x16, 0x95, 0x21, 0x7f, 0x80, 0x8f, 0xc8, 0x01, 0x5e, 0x54, 0xfd, 0x3f, 0xd1,
+		0x59, 0x18, 0x3f, 0xd4, 0x9a, 0x1d, 0x5a, 0x14, 0x59, 0x54, 0xe9, 0xc3, 0xfb, 0xb9, 0xcf, 0x2a,
+		0x95, 0x6f, 0x2a, 0xf0, 0xae, 0x7c, 0xf8, 0xed, 0x01, 0x14, 0xd8, 0x7e, 0xab, 0x48, 0xec, 0xb7,
+		0x8c, 0x16, 0x6d, 0xc2, 0x62, 0x72, 0xfd, 0x95, 0x69, 0x10, 0x60, 0x79, 0xf5, 0xa2, 0xb8, 0x74,
+		0xaa, 0x6e, 0x54, 0x7e, 0x4f, 0x81, 0x1b, 0x92, 0x5e, 0xfc, 0x43, 0x98, 0x08, 0x4e, 0x1a, 0x45,
+		0xe2, 0xa4, 0x09, 0x88, 0x8f, 0x0d, 0xaa, 0x0d, 0x4b, 0xd2, 0x21, 0xac, 0x75, 0x98, 0x62, 0x87,
+		0x7d, 0xdf, 0xf0, 0x9a, 0x16, 0x6c, 0x22, 0xec, 0x6c, 0x27, 0x76, 0x57, 0xc9, 0xeb, 0x3f, 0x54,
+		0xfe, 0x41, 0x81, 0xeb, 0x32, 0x55, 0x7c, 0x61, 0x0b, 0x4a, 0xc9, 0x66, 0x41, 0x3d, 0x83, 0x59,
+		0x81, 0x95, 0x92, 0x4b, 0xdb, 0xd0, 0xcf, 0xb8, 0x1c, 0x0b, 0x65, 0xe0, 0xa4, 0xca, 0x87, 0x4e,
+		0xaa, 0xca, 0x57, 0x14, 0xa8, 0xa4, 0x17, 0x00, 0xa2, 0x65, 0x40, 0xd1, 0xa2, 0xb0, 0x5e, 0x59,
+		0x70, 0xd9, 0x0d, 0x89, 0x20, 0x72, 0x5c, 0xe7, 0x22, 0xc7, 0x75, 0x78, 0xef, 0xc9, 0x47, 0xf6,
+		0x9e, 0xca, 0x7f, 0x47, 0xc4, 0x2b, 0x5c, 0x21, 0xd9, 0x10, 0x2d, 0x41, 0x39, 0x9c, 0x18, 0xe8,
+		0xa9, 0xd7, 0xb4, 0x3b, 0x30, 0xe2, 0x08, 0xf6, 0x7c, 0x04, 0xfb, 0x4d, 0x38, 0xbd, 0x6b, 0x5a,
+		0x9a, 0x73, 0xd4, 0xd0, 0xf7, 0xb1, 0x7e, 0xe0, 0x76, 0x5a, 0xc4, 0xc4, 0x2d, 0xaa, 0xd3, 0xb4,
+		0x79, 0x9d, 0xb5, 0xa2, 0xdb, 0x30, 0x13, 0x4e, 0x67, 0xe1, 0x37, 0xd4, 0x7c, 0x9d, 0x52, 0xcb,
+		0x78, 0x30, 0xcb, 0x84, 0xdf, 0x78, 0x95, 0x3f, 0xc8, 0xc3, 0x35, 0x89, 0xda, 0xc2, 0x13, 0x1b,
+		0x71, 0x74, 0x59, 0xe4, 0x87, 0x58, 0x16, 0xe8, 0x32, 0x94, 0x76, 0x35, 0x17, 0x07, 0xa6, 0x17,
+		0x15, 0x4b, 0xd1, 0x6f, 0xa2, 0x06, 0xd7, 0x45, 0x00, 0x0b, 0x77, 0x83, 0xd7, 0xe3, 0x54, 0xb0,
+		0x16, 0xee, 0xd2, 0xb7, 0xcb, 0x80, 0xf6, 0x6c, 0xe7, 0x80, 0x21, 0x0d, 0x0a, 0xc4, 0x0b, 0x74,
+		0x68, 0xfe, 0x1b, 0x82, 0xf5, 0x15, 0xab, 0x14, 0x9f, 0xf5, 0x37, 0x47, 0xcd, 0xb5, 0x2d, 0x66,
+		0x5b, 0xb3, 0x27, 0xf4, 0x14, 0xc6, 0x75, 0x7f, 0xcf, 0x66, 0x66, 0x74, 0x55, 0xba, 0x8a, 0x73,
+		0xdd, 0xef, 0xa5, 0xd2, 0xce, 0x11, 0x05, 0x2d, 0x46, 0x15, 0xf4, 0xbb, 0x79, 0xb8, 0x9a, 0x5a,
+		0x78, 0x79, 0x62, 0x73, 0xb5, 0x16, 0x0c, 0x91, 0x4e, 0xd2, 0xb2, 0x64, 0x5d, 0x68, 0x68, 0x80,
+		0x03, 0x5b, 0xf6, 0x58, 0x96, 0x2d, 0x7b, 0x70, 0x65, 0x8c, 0x47, 0x56, 0x46, 0x64, 0xfa, 0x0b,
+		0xc9, 0xd3, 0x3f, 0x21, 0x35, 0xfd, 0x93, 0x82, 0xe9, 0xe7, 0xac, 0xc2, 0x22, 0x77, 0x15, 0x86,
+		0x67, 0x12, 0xa2, 0x33, 0xf9, 0x9b, 0x05, 0xb8, 0x2e, 0x53, 0xb2, 0x8a, 0xae, 0x40, 0xa9, 0x17,
+		0x9f, 0x62, 0xb3, 0x58, 0x54, 0x21, 0x68, 0xaa, 0x1b, 0xbe, 0x4b, 0xdf, 0x0f, 0x60, 0xf9, 0x4b,
+		0x28, 0x97, 0xe0, 0xd2, 0xf7, 0x3e, 0x49, 0x5c, 0x7a, 0x6d, 0xe0, 0xc9, 0x57, 0x6c, 0xc3, 0x6e,
+		0x69, 0xa6, 0xc5, 0x76, 0x1e, 0xf6, 0x14, 0x3e, 0x4a, 0xc6, 0x86, 0x74, 0xc6, 0x0b, 0xf2, 0xce,
+		0xf8, 0x0e, 0xcc, 0x07, 0x3a, 0x1a, 0x3f, 0x81, 0x26, 0xd2, 0x4e, 0xa0, 0xd9, 0xa0, 0x6f, 0xe4,
+		0x10, 0x8a, 0x70, 0x65, 0x07, 0x1c, 0xe3, 0x3a, 0x99, 0x81, 0x2b, 0xf5, 0xc1, 0x19, 0x57, 0xf1,
+		0x51, 0x59, 0x1c, 0xea, 0xa8, 0xdc, 0x84, 0x99, 0x7d, 0xac, 0x39, 0xde, 0x2e, 0xd6, 0xfa, 0xe8,
+		0x20, 0x8d, 0x55, 0xb9, 0xd7, 0xa7, 0xcf, 0x27, 0xdd, 0xc0, 0x29, 0xa5, 0x1b, 0x38, 0x31, 0x4f,
+		0x75, 0x6a, 0x18, 0x4f, 0xb5, 0xef, 0xf1, 0x9c, 0x92, 0xf6, 0x78, 0x2a, 0xff, 0xae, 0x40, 0x25,
+		0xbd, 0x7c, 0xfa, 0x13, 0x33, 0x0d, 0x06, 0x8d, 0x98, 0xb1, 0xb0, 0xbb, 0xfd, 0x79, 0x98, 0x22,
+		0xd1, 0x8a, 0x60, 0x5b, 0x1b, 0x97, 0xd8, 0xd6, 0x4a, 0x7e, 0x0f, 0xf6, 0x50, 0xf9, 0x27, 0x25,
+		0xbc, 0x15, 0x1c, 0xb3, 0x5d, 0xce, 0x17, 0x51, 0x2e, 0xc3, 0x69, 0x90, 0x4f, 0xb5, 0x55, 0xc6,
+		0xc2, 0xc2, 0xac, 0xfc, 0xa3, 0x02, 0x57, 0xd3, 0x6b, 0x5a, 0x87, 0x35, 0xdf, 0x3f, 0x8d, 0x11,
+		0xfd, 0x55, 0x0e, 0xae, 0x49, 0x54, 0x86, 0xfb, 0x63, 0x32, 0xb0, 0xa7, 0x99, 0x4d, 0x57, 0x6a,
+		0x92, 0x02, 0xe2, 0x13, 0x1b, 0x53, 0xd4, 0xbe, 0x1a, 0x1b, 0xc6, 0xbe, 0x1a, 0x59, 0xc5, 0x7f,
+		0x4d, 0x81, 0x5b, 0xf2, 0x05, 0xdd, 0x32, 0x67, 0xde, 0xf1, 0x38, 0x70, 0xdf, 0x52, 0x20, 0x63,
+		0xe9, 0x76, 0x3a, 0xb6, 0xb3, 0x81, 0x95, 0xc4, 0xbc, 0x70, 0x6a, 0xf7, 0xc8, 0x20, 0xce, 0x4b,
+		0x20, 0xfe, 0x7a, 0x44, 0x0f, 0x45, 0x45, 0x1e, 0xc3, 0xea, 0xe1, 0x26, 0x2c, 0x36, 0x35, 0x6f,
+		0xa0, 0x84, 0x31, 0x5a, 0xd0, 0xd7, 0x97, 0x2c, 0xa5, 0xe3, 0x4d, 0x25, 0xb5, 0xaa, 0x38, 0xfa,
+		0x9c, 0xcf, 0xa0, 0xcf, 0x63, 0xa9, 0x6b, 0x34, 0x62, 0x07, 0x56, 0xbe, 0xa7, 0xc0, 0x42, 0xc2,
+		0xa5, 0x09, 0x34, 0x0f, 0x93, 0xb4, 0x58, 0xbc, 0x37, 0x6f, 0x13, 0xe4, 0xb9, 0x6e, 0xa0, 0x2d,
+		0x38, 0xd7, 0x3b, 0xc8, 0xf7, 0x4c, 0x27, 0x83, 0xcb, 0x8b, 0xd8, 0x39, 0xbe, 0x69, 0x3a, 0x38,
+		0xcb, 0xf1, 0x2b, 0x33, 0xd9, 0x3f, 0x0d, 0xf3, 0xc2, 0xdb, 0x18, 0x49, 0xa3, 0x91, 0x36, 0xe9,
+		0x2b, 0xdf, 0x55, 0xe0, 0x62, 0x52, 0x21, 0xfe, 0xb1, 0x7c, 0xe5, 0xb8, 0xe4, 0x91, 0xb8, 0x41,
+		0xff, 0xb9, 0x02, 0x8b, 0x69, 0x05, 0xfd, 0x49, 0xa3, 0x39, 0xd1, 0x65, 0x9b, 0x7c, 0x58, 0x16,
+		0x21, 0x63, 0xdd, 0x28, 0xaa, 0xc1, 0x59, 0x52, 0x9a, 0x1a, 0x4d, 0x3a, 0xd0, 0x31, 0xcd, 0x58,
+		0xb8, 0x1b, 0x49, 0x39, 0xc4, 0xf2, 0x7e, 0xb9, 0xe1, 0xf2, 0x7e, 0x6f, 0x33, 0x73, 0xf2, 0x99,
+		0x39, 0x19, 0xdd, 0x99, 0x90, 0xd0, 0x9d, 0xe7, 0x30, 0xcb, 0x32, 0x2a, 0x0c, 0xa3, 0x69, 0x79,
+		0xd8, 0x39, 0xd4, 0x9a, 0xe9, 0x7e, 0xcb, 0x59, 0xd6, 0x91, 0xc0, 0xab, 0xb3, 0x6e, 0xe1, 0xac,
+		0x5f, 0x71, 0xa4, 0xac, 0xdf, 0x80, 0x09, 0x07, 0x59, 0x4c, 0x38, 0x71, 0x8a, 0xaf, 0x34, 0x74,
+		0x8a, 0xaf, 0xef, 0x67, 0x4c, 0xc9, 0x67, 0x56, 0x82, 0x44, 0xd3, 0xa9, 0x11, 0x12, 0x4d, 0xd3,
+		0xa3, 0x25, 0x9a, 0x04, 0x19, 0x8f, 0xd3, 0x9f, 0x40, 0xc6, 0xa3, 0x7c, 0x22, 0x19, 0x8f, 0xca,
+		0xbf, 0x29, 0x50, 0xcb, 0x5a, 0x8e, 0xdf, 0xdb, 0x7f, 0x95, 0xc1, 0xfd, 0x37, 0xc9, 0x63, 0xdb,
+		0x85, 0xf3, 0xbd, 0x12, 0xbe, 0x48, 0x15, 0x02, 0xdd, 0x99, 0x6e, 0x25, 0x16, 0xe9, 0x85, 0xeb,
+		0x10, 0xce, 0x61, 0x5e, 0x73, 0xc4, 0x2b, 0x1c, 0x8b, 0x46, 0x71, 0x7e, 0x5f, 0xe1, 0x64, 0x00,
+		0x44, 0x47, 0xa9, 0xcc, 0x7e, 0xa0, 0x48, 0xec, 0x07, 0x03, 0xa6, 0x5d, 0x2e, 0x83, 0x69, 0x57,
+		0xf9, 0xbe, 0x02, 0x97, 0x12, 0x6f, 0x9b, 0xf9, 0xb6, 0x2d, 0xbb, 0xcb, 0x66, 0x69, 0xad, 0x60,
+		0x26, 0x80, 0x36, 0x3d, 0xd3, 0x5a, 0x78, 0xd8, 0x4f, 0x1f, 0xdb, 0x31, 0xda, 0x5f, 0xe2, 0x63,
+		0xf2, 0xa1, 0x84, 0x6f, 0xf3, 0x26, 0x49, 0x54, 0x5d, 0x79, 0x05, 0x4a, 0xac, 0xbe, 0x75, 0x50,
+		0x04, 0xb4, 0x89, 0x88, 0xa0, 0x77, 0x8a, 0xe5, 0xe4, 0x4f, 0xb1, 0xa4, 0xb0, 0x7e, 0x8a, 0x86,
+		0xfd, 0xba, 0x02, 0xb7, 0x32, 0x14, 0x1c, 0xf7, 0xa3, 0xd3, 0x4a, 0x28, 0x3a, 0x3d, 0xec, 0xc4,
+		0x25, 0x20, 0xaf, 0x7c, 0x27, 0x07, 0x1f, 0x8e, 0x76, 0xe9, 0xea, 0xd8, 0x96, 0x44, 0x3f, 0x76,
+		0x99, 0x0b, 0xc5, 0x2e, 0x5f, 0x02, 0x8a, 0xd7, 0x77, 0xb1, 0xdd, 0xe1, 0x86, 0x5c, 0xae, 0x5b,
+		0x9d, 0x89, 0x55, 0x67, 0xa1, 0x39, 0x98, 0xd0, 0x6d, 0xcb, 0x73, 0xec, 0x26, 0x99, 0xb0, 0x29,
+		0x35, 0x78, 0x44, 0x55, 0x38, 0x13, 0xa9, 0x53, 0xb7, 0xad, 0x26, 0xf5, 0x54, 0x26, 0xd5, 0x99,
+		0x50, 0xf9, 0xf8, 0x73, 0xab, 0x79, 0x54, 0xf9, 0x5a, 0x1e, 0x9e, 0x8c, 0x70, 0xa9, 0x0b, 0xbd,
+		0x1c, 0xdc, 0x35, 0xa7, 0x05, 0x57, 0x26, 0xa5, 0x38, 0x87, 0xa2, 0xf4, 0xc7, 0xe4, 0x5f, 0x0b,
+		0x63, 0xca, 0xfc, 0x79, 0x19, 0x1b, 0x75, 0x5e, 0x96, 0x01, 0x45, 0x4b, 0xe9, 0x59, 0xbe, 0x27,
+		0xaf, 0x96, 0xcd, 0x90, 0x12, 0xd2, 0x90, 0x5e, 0x30, 0x8b, 0x85, 0xd0, 0x2c, 0x56, 0xfe, 0x59,
+		0x81, 0x47, 0x43, 0xde, 0x48, 0x13, 0x60, 0x50, 0x04, 0x18, 0x3e, 0x59, 0xc5, 0xad, 0xfc, 0x4a,
+		0x1e, 0x1e, 0x0d, 0x79, 0x6b, 0xe0, 0x07, 0x75, 0xad, 0x46, 0x36, 0xf4, 0x31, 0xf1, 0x86, 0x3e,
+		0x2e, 0xbf, 0xa1, 0x0b, 0x55, 0x47, 0xb4, 0x01, 0x4c, 0x88, 0x36, 0x80, 0x5f, 0xce, 0xc3, 0x83,
+		0x61, 0x6e, 0x3e, 0xc8, 0xad, 0x7c, 0x29, 0xce, 0x6f, 0x57, 0x7e, 0x7f, 0xe5, 0xff, 0x87, 0x02,
+		0x77, 0xb3, 0xde, 0xe2, 0xf8, 0x7f, 0xbd, 0xe4, 0xc5, 0x67, 0x55, 0xe5, 0xef, 0x15, 0xb8, 0x93,
+		0xe9, 0xe6, 0xc7, 0xb1, 0x6d, 0x01, 0x5c, 0x2f, 0x2a, 0x37, 0x92, 0x17, 0x55, 0xf9, 0x8e, 0x02,
+		0x8f, 0x86, 0xbc, 0x0a, 0xc2, 0xc7, 0xa3, 0x8c, 0xe6, 0xd5, 0xf5, 0x2d, 0xb1, 0x5c, 0xc8, 0x12,
+		0x4b, 0xb2, 0xa8, 0x3e, 0xe6, 0x54, 0x3e, 0xf1, 0xaf, 0x2d, 0x88, 0xec, 0xbc, 0x04, 0x7f, 0xa9,
+		0xf2, 0x53, 0x1c, 0x33, 0x58, 0x74, 0x07, 0x61, 0x18, 0xfe, 0xbf, 0x18, 0x49, 0xfa, 0xf0, 0x91,
+		0x67, 0xcb, 0xd8, 0x0d, 0x23, 0xc5, 0x2f, 0x2b, 0xe1, 0x10, 0xb9, 0x68, 0x8c, 0x27, 0x8f, 0xe4,
+		0x3f, 0x15, 0xc8, 0x78, 0xf3, 0x02, 0x2d, 0x40, 0x91, 0x5d, 0x3f, 0xe8, 0x85, 0xf3, 0x26, 0x69,
+		0x43, 0xdd, 0xf0, 0xcf, 0x32, 0xf6, 0x92, 0x9c, 0x65, 0x14, 0x08, 0xd0, 0xa6, 0xf0, 0x59, 0x96,
+		0x97, 0x3f, 0xcb, 0x64, 0x16, 0xf5, 0x98, 0x44, 0xb0, 0xfa, 0xbf, 0xc4, 0x83, 0x15, 0xdd, 0xbf,
+		0x18, 0x6d, 0xb0, 0x03, 0xb1, 0xa7, 0xfc, 0x71, 0x57, 0xff, 0xc9, 0x0c, 0xf8, 0x17, 0x72, 0x9c,
+		0x28, 0x47, 0xf2, 0xcd, 0x8d, 0xe4, 0x11, 0xf7, 0xf3, 0xbb, 0xb9, 0x0c, 0xf9, 0xdd, 0x4f, 0x5b,
+		0x0c, 0x7f, 0x54, 0x82, 0xfb, 0x43, 0xfc, 0x76, 0xc0, 0xc0, 0x39, 0xa8, 0x84, 0xce, 0xc1, 0x2b,
+		0x50, 0xea, 0x9d, 0x83, 0xcc, 0xd8, 0x28, 0xaa, 0x10, 0x34, 0xf1, 0x62, 0xd9, 0xf9, 0x63, 0x88,
+		0x65, 0x0f, 0x5b, 0xd8, 0x32, 0x7e, 0xbc, 0xb1, 0xec, 0xc2, 0x89, 0xc6, 0xb2, 0x27, 0x86, 0x8e,
+		0x65, 0xbf, 0x02, 0x76, 0x51, 0x87, 0x71, 0x64, 0xf1, 0xc3, 0xc9, 0x84, 0x18, 0x25, 0xbd, 0xed,
+		0x43, 0xb8, 0x04, 0x31, 0xca, 0x76, 0xb4, 0x69, 0xd0, 0x3a, 0x29, 0x86, 0x0d, 0x69, 0x19, 0xf5,
+		0x04, 0x09, 0x5b, 0x43, 0x87, 0xb9, 0x01, 0x75, 0x6a, 0x38, 0xb8, 0xd3, 0x87, 0x5f, 0x22, 0xf0,
+		0x6f, 0x25, 0x2a, 0x4e, 0xdd, 0x50, 0xfd, 0x2e, 0x6c, 0x08, 0xe7, 0xba, 0xbc, 0xe6, 0x58, 0x9d,
+		0xcc, 0xa9, 0x61, 0xea, 0x64, 0x62, 0x57, 0x2e, 0xa6, 0x39, 0x57, 0x2e, 0xfa, 0x11, 0xb0, 0xd3,
+		0xd9, 0x83, 0xdc, 0xe5, 0x11, 0x82, 0xdc, 0x33, 0xa3, 0x99, 0x43, 0xef, 0x43, 0xc9, 0xc0, 0x4d,
+		0xed, 0x88, 0xaa, 0x66, 0xfa, 0xd5, 0x10, 0x20, 0xd4, 0x44, 0x15, 0xd1, 0xe7, 0x60, 0xea, 0x8b,
+		0xa6, 0xe7, 0x05, 0xbf, 0xa3, 0xd7, 0xbb, 0x14, 0x22, 0xec, 0x5c, 0xa2, 0xe4, 0xbd, 0xde, 0xf4,
+		0xee, 0x84, 0xd3, 0xb1, 0x1a, 0x9a, 0xc7, 0xae, 0x85, 0x24, 0xdd, 0x99, 0x00, 0x42, 0xaf, 0x76,
+		0xac, 0x55, 0x4f, 0x14, 0x9c, 0x3f, 0xf7, 0x09, 0x04, 0xe7, 0x67, 0x4f, 0x26, 0x38, 0xff, 0xd5,
+		0x3c, 0xdc, 0xcd, 0xfa, 0x4b, 0x29, 0x9f, 0xfe, 0x66, 0xbd, 0x15, 0xb8, 0xbb, 0xb4, 0x80, 0xe5,
+		0x61, 0xe6, 0x9f, 0xf9, 0x08, 0x79, 0xb9, 0x03, 0xdb, 0xce, 0x78, 0x78, 0xdb, 0xe1, 0xfb, 0x72,
+		0x05, 0x81, 0x2f, 0x77, 0x4c, 0x29, 0xbe, 0xca, 0xdf, 0xe5, 0x60, 0x39, 0xcb, 0xcf, 0xc0, 0x08,
+		0xe7, 0x83, 0xef, 0x44, 0xe6, 0x46, 0x75, 0x22, 0x8f, 0x6b, 0x16, 0xf9, 0xd2, 0x1d, 0x13, 0x48,
+		0xb7, 0xbf, 0xd7, 0x8d, 0xcb, 0x47, 0xfb, 0xbf, 0x9f, 0x83, 0x8c, 0x3f, 0x50, 0xf3, 0xc3, 0x21,
+		0x4c, 0x5e, 0xb5, 0xc6, 0x38, 0xb7, 0x5a, 0xa3, 0x6f, 0x86, 0x16, 0xe4, 0xcd, 0xd0, 0xca, 0xff,
+		0xe4, 0xe0, 0xf6, 0x71, 0xec, 0x28, 0x3f, 0xa4, 0x42, 0x1f, 0xb0, 0xe2, 0x0b, 0x19, 0xac, 0xf8,
+		0xca, 0xff, 0xe6, 0xe0, 0x4e, 0xa6, 0xdf, 0x0b, 0x7a, 0x2b, 0xf8, 0x98, 0xe0, 0x83, 0xcc, 0x58,
+		0x21, 0x4b, 0x36, 0xf5, 0xe7, 0xf3, 0x22, 0xc1, 0x8b, 0x4a, 0x43, 0xdf, 0x0a, 0x3e, 0xb1, 0x32,
+		0xb5, 0x30, 0xcc, 0x85, 0xb8, 0xbf, 0xce, 0x41, 0x2d, 0xe3, 0xef, 0x38, 0xbd, 0x9d, 0x87, 0xd0,
+		0x3c, 0xdc, 0xf2, 0xe0, 0x34, 0xf9, 0x73, 0xd3, 0x6c, 0x7a, 0xd8, 0x21, 0x9f, 0xba, 0x04, 0xf3,
+		0x1b, 0xaf, 0x36, 0x9e, 0xed, 0x34, 0x36, 0xeb, 0x5b, 0x3b, 0x1b, 0x6a, 0x63, 0xe7, 0x27, 0xb6,
+		0x37, 0x1a, 0xf5, 0x67, 0xaf, 0x56, 0xb7, 0xea, 0x4f, 0xcb, 0x9f, 0x41, 0x57, 0x60, 0x21, 0xfe,
+		0x7a, 0x75, 0x6b, 0xab, 0x41, 0x5a, 0xcb, 0x0a, 0xba, 0x0a, 0x97, 0xe2, 0x04, 0xeb, 0x5b, 0xcf,
+		0x5f, 0x6c, 0x30, 0x92, 0xdc, 0xda, 0xc7, 0x70, 0x5e, 0xb7, 0x5b, 0x3c, 0x19, 0xac, 0x05, 0xff,
+		0x09, 0x64, 0xdb, 0xb7, 0xcc, 0xb7, 0x95, 0x9f, 0xbc, 0xf7, 0xda, 0xf4, 0xf6, 0x3b, 0xbb, 0x55,
+		0xdd, 0x6e, 0xd5, 0x06, 0xff, 0x23, 0xc9, 0x1d, 0xd3, 0x68, 0xd6, 0x5e, 0xdb, 0xf4, 0xbf, 0xa0,
+		0xb0, 0x7f, 0x4f, 0xf2, 0x44, 0x6b, 0x9b, 0x87, 0xf7, 0x76, 0x0b, 0xa4, 0xed, 0xfe, 0xff, 0x05,
+		0x00, 0x00, 0xff, 0xff, 0xa4, 0x09, 0xea, 0x42, 0x81, 0x65, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
@@ -3155,167 +3452,179 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x73, 0xdb, 0xc8,
-		0xd1, 0x7e, 0x41, 0x4a, 0xb2, 0xd5, 0xd4, 0x07, 0x34, 0xb2, 0x2c, 0xfa, 0x63, 0x6d, 0x59, 0xbb,
-		0xf6, 0xca, 0x7c, 0xd7, 0xd2, 0xca, 0xfb, 0xbd, 0xce, 0xc6, 0x81, 0x40, 0xc8, 0x86, 0x4d, 0x83,
-		0xcc, 0x00, 0xb4, 0x56, 0x5b, 0x49, 0x50, 0x10, 0x38, 0x92, 0x10, 0x93, 0x00, 0x0b, 0x18, 0xda,
-		0xd6, 0x3d, 0x55, 0x39, 0x27, 0xa7, 0x54, 0x4e, 0xf9, 0x01, 0xa9, 0x4a, 0xa5, 0x72, 0xc8, 0x29,
-		0x95, 0x4b, 0xae, 0xb9, 0xe6, 0x2f, 0xa4, 0xf2, 0x2f, 0x52, 0x33, 0x18, 0x90, 0x20, 0x09, 0x12,
-		0x74, 0x52, 0xb5, 0xb9, 0x09, 0x3d, 0xcf, 0xd3, 0xe8, 0xe9, 0xe9, 0x7e, 0x7a, 0xc0, 0x12, 0x6c,
-		0xf7, 0x4e, 0x48, 0xb8, 0xe7, 0x3a, 0x2d, 0xe2, 0xbb, 0x64, 0xcf, 0xe9, 0x7a, 0x7b, 0xaf, 0xf7,
-		0xf7, 0xde, 0x04, 0xe1, 0xab, 0xd3, 0x76, 0xf0, 0x66, 0xb7, 0x1b, 0x06, 0x34, 0x40, 0xeb, 0x0c,
-		0xb3, 0x2b, 0x30, 0xbb, 0x4e, 0xd7, 0xdb, 0x7d, 0xbd, 0x7f, 0xfd, 0xd6, 0x59, 0x10, 0x9c, 0xb5,
-		0xc9, 0x1e, 0x87, 0x9c, 0xf4, 0x4e, 0xf7, 0x5a, 0xbd, 0xd0, 0xa1, 0x5e, 0xe0, 0xc7, 0xa4, 0xeb,
-		0xb7, 0x47, 0xd7, 0xa9, 0xd7, 0x21, 0x11, 0x75, 0x3a, 0x5d, 0x01, 0xd8, 0xca, 0x7a, 0xb3, 0x1b,
-		0x74, 0x3a, 0x7d, 0x17, 0x99, 0xb1, 0x51, 0x27, 0x7a, 0xd5, 0xf6, 0x22, 0x1a, 0x63, 0xb6, 0xff,
-		0x06, 0xb0, 0x71, 0x24, 0xc2, 0xd5, 0xde, 0x12, 0xb7, 0xc7, 0x42, 0xd0, 0xfd, 0xd3, 0x00, 0x35,
-		0x01, 0x25, 0xfb, 0xb0, 0x49, 0xb2, 0x52, 0x96, 0xb6, 0xa4, 0x9d, 0xd2, 0xc3, 0x7b, 0xbb, 0x19,
-		0x5b, 0xda, 0x1d, 0xf3, 0x83, 0xd7, 0xde, 0x8c, 0x9a, 0xd0, 0x67, 0x30, 0x47, 0x2f, 0xba, 0xa4,
-		0x5c, 0xe0, 0x8e, 0xee, 0x4c, 0x75, 0x64, 0x5d, 0x74, 0x09, 0xe6, 0x70, 0xf4, 0x15, 0x40, 0x44,
-		0x9d, 0x90, 0xda, 0x2c, 0x0d, 0xe5, 0x22, 0x27, 0x5f, 0xdf, 0x8d, 0x73, 0xb4, 0x9b, 0xe4, 0x68,
-		0xd7, 0x4a, 0x72, 0x84, 0x17, 0x39, 0x9a, 0x3d, 0x33, 0xaa, 0xdb, 0x0e, 0x22, 0x12, 0x53, 0xe7,
-		0xf2, 0xa9, 0x1c, 0xcd, 0xa9, 0x16, 0x2c, 0xc5, 0xd4, 0x88, 0x3a, 0xb4, 0x17, 0x95, 0xe7, 0xb7,
-		0xa4, 0x9d, 0x95, 0x87, 0xfb, 0xb3, 0xed, 0x5e, 0x65, 0x4c, 0x93, 0x13, 0x71, 0xc9, 0x1d, 0x3c,
-		0xa0, 0xbb, 0xb0, 0x72, 0xee, 0x45, 0x34, 0x08, 0x2f, 0xec, 0x36, 0xf1, 0xcf, 0xe8, 0x79, 0x79,
-		0x61, 0x4b, 0xda, 0x29, 0xe2, 0x65, 0x61, 0xad, 0x71, 0x23, 0xfa, 0x09, 0x6c, 0x74, 0x9d, 0x90,
-		0xf8, 0x74, 0x90, 0x7e, 0xdb, 0xf3, 0x4f, 0x83, 0xf2, 0x25, 0xbe, 0x85, 0x9d, 0xcc, 0x28, 0x1a,
-		0x9c, 0x31, 0x74, 0x92, 0x78, 0xbd, 0x3b, 0x6e, 0x44, 0x0a, 0xac, 0x0c, 0xdc, 0xf2, 0xcc, 0x5c,
-		0xce, 0xcd, 0xcc, 0x72, 0x9f, 0xc1, 0xb3, 0xf3, 0x00, 0xe6, 0x3a, 0xa4, 0x13, 0x94, 0x17, 0x39,
-		0xf1, 0x5a, 0x66, 0x3c, 0x2f, 0x48, 0x27, 0xc0, 0x1c, 0x86, 0x30, 0xac, 0x45, 0xc4, 0x09, 0xdd,
-		0x73, 0xdb, 0xa1, 0x34, 0xf4, 0x4e, 0x7a, 0x94, 0x44, 0x65, 0xe0, 0xdc, 0xbb, 0x99, 0x5c, 0x93,
-		0xa3, 0x95, 0x3e, 0x18, 0xcb, 0xd1, 0x88, 0x05, 0xd5, 0x60, 0xcd, 0xe9, 0xd1, 0xc0, 0x0e, 0x49,
-		0x44, 0xa8, 0xdd, 0x0d, 0x3c, 0x9f, 0x46, 0xe5, 0x12, 0xf7, 0xb9, 0x95, 0xe9, 0x13, 0x33, 0x60,
-		0x83, 0xe3, 0xf0, 0x2a, 0xa3, 0xa6, 0x0c, 0xe8, 0x06, 0x2c, 0xb2, 0xf6, 0xb0, 0x59, 0x7f, 0x94,
-		0x97, 0xb6, 0xa4, 0x9d, 0x45, 0x7c, 0x99, 0x19, 0x6a, 0x5e, 0x44, 0x91, 0x0a, 0x2b, 0xfd, 0xc5,
-		0xf8, 0x1c, 0xd6, 0xf8, 0x7b, 0xde, 0xcb, 0x7c, 0x8f, 0x25, 0x68, 0x78, 0x29, 0x71, 0xc0, 0xb3,
-		0xbe, 0x09, 0x97, 0xbc, 0xc8, 0x76, 0xc3, 0xc0, 0x2f, 0x2f, 0x6f, 0x49, 0x3b, 0x97, 0xf1, 0x82,
-		0x17, 0xa9, 0x61, 0xe0, 0xa3, 0x47, 0x50, 0xea, 0x75, 0x5b, 0x0e, 0x15, 0x55, 0xba, 0x92, 0x7b,
-		0x16, 0x10, 0xc3, 0xf9, 0x41, 0xfc, 0x1c, 0xe4, 0xae, 0x13, 0x52, 0x8f, 0x9f, 0xa5, 0x1b, 0xf8,
-		0xa7, 0xde, 0x59, 0x79, 0x75, 0xab, 0xb8, 0x53, 0x7a, 0xf8, 0x78, 0xb6, 0x52, 0x65, 0xb1, 0xb1,
-		0xd2, 0x89, 0x5d, 0xa8, 0xdc, 0x83, 0xe6, 0xd3, 0xf0, 0x02, 0xaf, 0x76, 0x87, 0xad, 0xe8, 0x25,
-		0xac, 0xb3, 0xf0, 0xed, 0xe0, 0x35, 0x09, 0xdb, 0x4e, 0xd7, 0xee, 0x06, 0x6d, 0xcf, 0xbd, 0x28,
-		0xcb, 0xbc, 0x33, 0xb2, 0x75, 0x81, 0x6d, 0xb0, 0x1e, 0xc3, 0x1b, 0x1c, 0x8d, 0xd7, 0xdc, 0x51,
-		0x13, 0x7a, 0x0b, 0xb7, 0x1d, 0x97, 0x7a, 0xaf, 0x89, 0xed, 0xb6, 0x7b, 0x11, 0x25, 0xa1, 0x1d,
-		0x91, 0x36, 0x71, 0xf9, 0x96, 0xc4, 0x3b, 0x10, 0x4f, 0x4a, 0x76, 0xf7, 0x29, 0x9c, 0xab, 0xc6,
-		0x54, 0x33, 0x61, 0x8a, 0xd7, 0xdd, 0x74, 0xa6, 0xac, 0x5e, 0x3f, 0x80, 0x2b, 0x59, 0x5b, 0x47,
-		0x32, 0x14, 0x5f, 0x91, 0x0b, 0xae, 0x78, 0x8b, 0x98, 0xfd, 0x89, 0xae, 0xc0, 0xfc, 0x6b, 0xa7,
-		0xdd, 0x8b, 0xc5, 0x6b, 0x11, 0xc7, 0x0f, 0x5f, 0x17, 0xbe, 0x94, 0xb6, 0x7f, 0x53, 0x80, 0x5b,
-		0xe3, 0x02, 0xc0, 0x9d, 0x09, 0x59, 0x47, 0x5f, 0xa7, 0x8b, 0x4b, 0x9a, 0xa5, 0x74, 0x06, 0xb5,
-		0xe7, 0xc0, 0xd6, 0xa0, 0x59, 0x85, 0x0e, 0x06, 0xf6, 0x40, 0xd5, 0x82, 0x1e, 0x15, 0x82, 0x7a,
-		0x6d, 0xac, 0x64, 0xaa, 0x22, 0x00, 0x7c, 0xb3, 0xef, 0xc2, 0xe4, 0xda, 0x18, 0xa8, 0x89, 0xce,
-		0x05, 0x3d, 0x8a, 0x8e, 0xe0, 0x06, 0x0f, 0x6f, 0x82, 0xf7, 0x62, 0x9e, 0xf7, 0x4d, 0xc6, 0xce,
-		0x70, 0xbc, 0xfd, 0x77, 0x09, 0xd6, 0x33, 0x54, 0x89, 0x35, 0x5b, 0x2b, 0xe8, 0x38, 0x9e, 0x6f,
-		0x7b, 0x2d, 0x91, 0xe4, 0xcb, 0xb1, 0x41, 0x6f, 0xa1, 0xdb, 0x50, 0x12, 0x8b, 0xbe, 0xd3, 0x49,
-		0xf2, 0x0d, 0xb1, 0xc9, 0x70, 0x3a, 0x64, 0xc2, 0x74, 0x2a, 0xfe, 0xb7, 0xd3, 0xe9, 0x0e, 0x2c,
-		0x79, 0xbe, 0x47, 0x3d, 0x87, 0x92, 0x16, 0x8b, 0x6b, 0x8e, 0x0b, 0x73, 0xa9, 0x6f, 0xd3, 0x5b,
-		0xdb, 0xbf, 0x92, 0x60, 0x43, 0x7b, 0x4b, 0x49, 0xe8, 0x3b, 0xed, 0xef, 0x65, 0x62, 0x8e, 0xc6,
-		0x54, 0x18, 0x8f, 0xe9, 0xcf, 0x0b, 0xb0, 0xde, 0x20, 0x7e, 0xcb, 0xf3, 0xcf, 0x78, 0x23, 0x78,
-		0xf4, 0x82, 0x47, 0x74, 0x1b, 0x4a, 0x8e, 0x78, 0x1e, 0x64, 0x19, 0x12, 0x93, 0xde, 0x42, 0x87,
-		0xb0, 0xdc, 0x07, 0xe4, 0x8e, 0xe5, 0xc4, 0x35, 0x1f, 0xcb, 0x4b, 0x4e, 0xea, 0x09, 0x3d, 0x86,
-		0x79, 0x36, 0x22, 0xe3, 0xc9, 0xbc, 0xf2, 0xf0, 0x7e, 0xf6, 0x6c, 0x1a, 0x8e, 0x90, 0x4d, 0x43,
-		0x82, 0x63, 0x1e, 0xd2, 0x61, 0xed, 0x9c, 0x38, 0x21, 0x3d, 0x21, 0x0e, 0xb5, 0x5b, 0x84, 0x3a,
-		0x5e, 0x3b, 0x12, 0xb3, 0xfa, 0xe6, 0x84, 0x41, 0x77, 0xd1, 0x0e, 0x9c, 0x16, 0x96, 0xfb, 0xb4,
-		0x6a, 0xcc, 0x42, 0xcf, 0x60, 0xbd, 0xed, 0x44, 0xd4, 0x1e, 0xf8, 0xe3, 0x92, 0x3a, 0x9f, 0x2b,
-		0xa9, 0x6b, 0x8c, 0xf6, 0x34, 0x61, 0x71, 0x65, 0x3d, 0x04, 0x6e, 0x8c, 0xbb, 0x82, 0xb4, 0x62,
-		0x4f, 0x0b, 0xb9, 0x9e, 0x56, 0x19, 0xc9, 0x8c, 0x39, 0xdc, 0x4f, 0x19, 0x2e, 0x39, 0x94, 0x92,
-		0x4e, 0x97, 0xf2, 0xe9, 0x3d, 0x8f, 0x93, 0x47, 0x74, 0x1f, 0xe4, 0x8e, 0xf3, 0xd6, 0xeb, 0xf4,
-		0x3a, 0xb6, 0x30, 0x45, 0x7c, 0x12, 0xcf, 0xe3, 0x55, 0x61, 0x57, 0x84, 0x99, 0x8d, 0xec, 0xc8,
-		0x3d, 0x27, 0xad, 0x5e, 0x3b, 0x89, 0x64, 0x31, 0x7f, 0x64, 0xf7, 0x19, 0x3c, 0x0e, 0x15, 0x56,
-		0xc9, 0xdb, 0xae, 0x17, 0xf7, 0x6c, 0xec, 0x03, 0x72, 0x7d, 0xac, 0x0c, 0x28, 0xdc, 0xc9, 0x63,
-		0x58, 0xe2, 0x49, 0x39, 0x75, 0xbc, 0x76, 0x2f, 0x24, 0x62, 0xde, 0x66, 0x1f, 0xd3, 0x61, 0x8c,
-		0xc1, 0x25, 0xc6, 0x10, 0x0f, 0xe8, 0x63, 0xb8, 0xc2, 0x1d, 0xb0, 0x5a, 0x27, 0xa1, 0xed, 0xb5,
-		0x88, 0x4f, 0x3d, 0x7a, 0x21, 0x46, 0x2e, 0x62, 0x6b, 0x47, 0x7c, 0x49, 0x17, 0x2b, 0xe8, 0x73,
-		0xd8, 0x4c, 0x8e, 0x60, 0x94, 0xb4, 0xcc, 0x49, 0x1b, 0x62, 0x79, 0x84, 0x77, 0x1b, 0x4a, 0x49,
-		0x02, 0x58, 0x03, 0xac, 0xf0, 0xd6, 0x81, 0xc4, 0xa4, 0xb7, 0xb6, 0xff, 0x54, 0x80, 0x6b, 0xa2,
-		0x2e, 0xd5, 0x73, 0xaf, 0xdd, 0xfa, 0x5e, 0x3a, 0xfa, 0xa3, 0x94, 0x5b, 0xd6, 0x75, 0x69, 0x91,
-		0x93, 0xdf, 0xa4, 0x2e, 0xbf, 0x5c, 0xea, 0x46, 0xfb, 0xbf, 0x38, 0xd6, 0xff, 0x6c, 0x28, 0x8b,
-		0xab, 0x62, 0xac, 0xda, 0x62, 0x60, 0xce, 0x4d, 0x19, 0xca, 0xb1, 0x24, 0x73, 0xa5, 0x4e, 0x86,
-		0x72, 0x77, 0xd4, 0x84, 0xae, 0xc2, 0x42, 0xac, 0xb9, 0xbc, 0x7b, 0x16, 0xb1, 0x78, 0xda, 0xfe,
-		0x67, 0xa1, 0xaf, 0x37, 0x55, 0xe2, 0x7a, 0x51, 0x92, 0xaf, 0xbe, 0x0c, 0x48, 0xf9, 0x32, 0x90,
-		0x10, 0x87, 0x64, 0x60, 0xbc, 0xc4, 0x0b, 0xef, 0x5a, 0xe2, 0xdf, 0xc0, 0xd2, 0x50, 0xb7, 0xe6,
-		0x7f, 0x2b, 0x94, 0xa2, 0xec, 0x4e, 0x9d, 0x1b, 0xee, 0x54, 0x0c, 0x9b, 0x41, 0xe8, 0x9d, 0x79,
-		0xbe, 0xd3, 0xb6, 0x47, 0x82, 0xcc, 0xd7, 0x96, 0x8d, 0x84, 0x6a, 0x0e, 0x05, 0x3b, 0x52, 0x9f,
-		0x0b, 0x63, 0xf5, 0xf9, 0x97, 0x02, 0x5c, 0x4b, 0x04, 0xb3, 0x16, 0xb8, 0x4e, 0xbb, 0xea, 0x45,
-		0x5d, 0x87, 0xba, 0xe7, 0xb3, 0xe9, 0xfb, 0xff, 0x3e, 0x9f, 0x3f, 0x83, 0x5b, 0xc3, 0x11, 0xd8,
-		0xc1, 0xa9, 0x4d, 0xcf, 0xbd, 0xc8, 0x4e, 0xa7, 0x79, 0xba, 0xc3, 0xeb, 0x43, 0x11, 0xd5, 0x4f,
-		0xad, 0x73, 0x2f, 0x12, 0xaa, 0x88, 0xde, 0x03, 0xe0, 0xf7, 0x16, 0x1a, 0xbc, 0x22, 0x71, 0x99,
-		0x2e, 0x61, 0x7e, 0xd1, 0xb2, 0x98, 0x61, 0xfb, 0x19, 0x94, 0xd2, 0x37, 0xfc, 0x47, 0xb0, 0x20,
-		0x3e, 0x12, 0x24, 0x7e, 0x3f, 0x7e, 0x3f, 0xe7, 0x23, 0x81, 0x7f, 0x3f, 0x09, 0xca, 0xf6, 0x1f,
-		0x0a, 0xb0, 0x32, 0xbc, 0x84, 0x3e, 0x84, 0xd5, 0x13, 0xcf, 0x77, 0xc2, 0x0b, 0xdb, 0x3d, 0x27,
-		0xee, 0xab, 0xa8, 0xd7, 0x11, 0x87, 0xb0, 0x12, 0x9b, 0x55, 0x61, 0x45, 0x1b, 0xb0, 0x10, 0xf6,
-		0xfc, 0x64, 0x7c, 0x2f, 0xe2, 0xf9, 0xb0, 0xc7, 0xee, 0x39, 0xdf, 0xc0, 0x8d, 0x53, 0x2f, 0x8c,
-		0xd8, 0xc8, 0x8b, 0xbb, 0xc1, 0x76, 0x83, 0x4e, 0xb7, 0x4d, 0x86, 0x5a, 0xbd, 0xcc, 0x21, 0x49,
-		0xbf, 0xa8, 0x09, 0x80, 0xd3, 0x97, 0xdc, 0x90, 0x38, 0xfd, 0xb3, 0xc9, 0x4f, 0x65, 0x49, 0xe0,
-		0x85, 0x90, 0x2f, 0x73, 0x69, 0xf7, 0xfc, 0xb3, 0x59, 0xeb, 0x78, 0x29, 0x21, 0x70, 0x07, 0xb7,
-		0x00, 0xf8, 0x97, 0x17, 0x75, 0x4e, 0xda, 0xf1, 0x5c, 0xbc, 0x8c, 0x53, 0x96, 0xca, 0x1f, 0x25,
-		0xb8, 0x92, 0x35, 0xf5, 0xd1, 0x36, 0xdc, 0x6a, 0x68, 0x46, 0x55, 0x37, 0x9e, 0xd8, 0x8a, 0x6a,
-		0xe9, 0x2f, 0x75, 0xeb, 0xd8, 0x36, 0x2d, 0xc5, 0xd2, 0x6c, 0xdd, 0x78, 0xa9, 0xd4, 0xf4, 0xaa,
-		0xfc, 0x7f, 0xe8, 0x03, 0xd8, 0x9a, 0x80, 0x31, 0xd5, 0xa7, 0x5a, 0xb5, 0x59, 0xd3, 0xaa, 0xb2,
-		0x34, 0xc5, 0x93, 0x69, 0x29, 0xd8, 0xd2, 0xaa, 0x72, 0x01, 0xfd, 0x3f, 0x7c, 0x38, 0x01, 0xa3,
-		0x2a, 0x86, 0xaa, 0xd5, 0x6c, 0xac, 0xfd, 0xb8, 0xa9, 0x99, 0x0c, 0x5c, 0xac, 0xfc, 0x62, 0x10,
-		0xf3, 0x90, 0x44, 0xa5, 0xdf, 0x54, 0xd5, 0x54, 0xdd, 0xd4, 0xeb, 0xc6, 0xb4, 0x98, 0x47, 0x30,
-		0x13, 0x62, 0x1e, 0x45, 0x25, 0x31, 0x57, 0x7e, 0x59, 0x18, 0xfc, 0x30, 0xa3, 0xb7, 0x30, 0xe9,
-		0xf5, 0x45, 0xf9, 0x03, 0xd8, 0x3a, 0xaa, 0xe3, 0xe7, 0x87, 0xb5, 0xfa, 0x91, 0xad, 0x57, 0x6d,
-		0xac, 0x35, 0x4d, 0xcd, 0x6e, 0xd4, 0x6b, 0xba, 0x7a, 0x9c, 0x8a, 0xe4, 0x4b, 0xf8, 0x74, 0x22,
-		0x4a, 0xa9, 0x31, 0x6b, 0xb5, 0xd9, 0xa8, 0xe9, 0x2a, 0x7b, 0xeb, 0xa1, 0xa2, 0xd7, 0xb4, 0xaa,
-		0x5d, 0x37, 0x6a, 0xc7, 0xb2, 0x84, 0x3e, 0x82, 0x9d, 0x59, 0x99, 0x72, 0x01, 0x3d, 0x80, 0xfb,
-		0x13, 0xd1, 0x58, 0x7b, 0xa6, 0xa9, 0x56, 0x0a, 0x5e, 0x44, 0xfb, 0xf0, 0x60, 0x22, 0xdc, 0xd2,
-		0xf0, 0x0b, 0xdd, 0xe0, 0x09, 0x3d, 0xb4, 0x71, 0xd3, 0x30, 0x74, 0xe3, 0x89, 0x3c, 0x57, 0xb9,
-		0x80, 0xb5, 0xb1, 0x2f, 0x48, 0x74, 0x1b, 0x6e, 0xa8, 0xb8, 0x6e, 0xd8, 0xf5, 0x97, 0x1a, 0xae,
-		0x29, 0x8d, 0xf1, 0xfd, 0x4f, 0x00, 0x98, 0xcf, 0xf5, 0x46, 0x23, 0x39, 0x84, 0x2c, 0xc0, 0x41,
-		0xf3, 0xf0, 0x50, 0xc3, 0x76, 0xdd, 0xd0, 0xe4, 0x42, 0xe5, 0x77, 0x12, 0xac, 0x8d, 0x0d, 0x4a,
-		0xe6, 0xba, 0xa1, 0x60, 0xcd, 0xb0, 0x6c, 0xb5, 0x56, 0xcf, 0xca, 0xfd, 0x04, 0x80, 0x72, 0xa0,
-		0x18, 0xd5, 0xba, 0x21, 0x4b, 0xe8, 0x1e, 0x6c, 0x67, 0x01, 0x44, 0x19, 0x8a, 0xaa, 0x94, 0x0b,
-		0xe8, 0x0e, 0xbc, 0x97, 0x85, 0xeb, 0x27, 0x4a, 0x2e, 0x56, 0xfe, 0x55, 0x80, 0x9b, 0xd3, 0x7e,
-		0x7a, 0x62, 0xc5, 0xdf, 0xcf, 0xb8, 0xf6, 0xad, 0xa6, 0x36, 0x2d, 0x56, 0x6e, 0xb1, 0x3f, 0x56,
-		0x74, 0x4d, 0x33, 0x15, 0x79, 0xfa, 0x34, 0x27, 0x80, 0xd5, 0xfa, 0x8b, 0x46, 0x4d, 0xb3, 0x78,
-		0x0e, 0x2b, 0x70, 0x2f, 0x0f, 0x1e, 0xd7, 0x96, 0x5c, 0x18, 0x2a, 0xab, 0x49, 0xae, 0xf9, 0xbe,
-		0x59, 0x17, 0xa2, 0x5d, 0xa8, 0xe4, 0xa1, 0xfb, 0x59, 0xa8, 0xca, 0x73, 0xe8, 0x53, 0xf8, 0x38,
-		0x3f, 0x70, 0xc3, 0xd2, 0x8d, 0xa6, 0x56, 0xb5, 0x15, 0xd3, 0x36, 0xb4, 0x23, 0x79, 0x7e, 0x96,
-		0xed, 0x5a, 0xfa, 0x0b, 0xd6, 0x1a, 0x4d, 0x4b, 0x5e, 0xa8, 0xfc, 0x55, 0x82, 0xab, 0x6a, 0xe0,
-		0x53, 0xcf, 0xef, 0x11, 0x25, 0x32, 0xc8, 0x1b, 0x3d, 0xbe, 0x83, 0x05, 0x21, 0xba, 0x0b, 0x77,
-		0x12, 0xff, 0xc2, 0xbd, 0xad, 0x1b, 0xba, 0xa5, 0x2b, 0x56, 0x1d, 0xa7, 0xf2, 0x3b, 0x15, 0xc6,
-		0xb4, 0xa0, 0xaa, 0xe1, 0x38, 0xaf, 0x93, 0x61, 0x58, 0xb3, 0xf0, 0xb1, 0x28, 0x85, 0x58, 0xdc,
-		0x26, 0x63, 0x79, 0x85, 0x27, 0xd2, 0x23, 0x17, 0x2b, 0xbf, 0x97, 0xa0, 0x24, 0x3e, 0xcc, 0xf9,
-		0x77, 0x5b, 0x19, 0xae, 0xb0, 0x0d, 0xd6, 0x9b, 0x96, 0x6d, 0x1d, 0x37, 0xb4, 0xe1, 0x1a, 0x1e,
-		0x5a, 0xe1, 0xca, 0x64, 0x5b, 0xf5, 0x38, 0x3b, 0x71, 0xff, 0x0c, 0x03, 0xc4, 0x5b, 0x18, 0x86,
-		0x83, 0xe5, 0xc2, 0x54, 0x4c, 0xec, 0xa7, 0x88, 0xae, 0xc3, 0xd5, 0x21, 0xcc, 0x53, 0x4d, 0xc1,
-		0xd6, 0x81, 0xa6, 0x58, 0xf2, 0x5c, 0xe5, 0xb7, 0x12, 0x5c, 0x4b, 0x44, 0xd8, 0x62, 0x33, 0xdd,
-		0xeb, 0x90, 0x56, 0xbd, 0x47, 0x55, 0xa7, 0x17, 0x11, 0x74, 0x1f, 0xee, 0xf6, 0xe5, 0xd3, 0x52,
-		0xcc, 0xe7, 0x83, 0xb3, 0xb2, 0x55, 0x85, 0xe9, 0xca, 0x60, 0x37, 0xb9, 0x50, 0x11, 0x82, 0x2c,
-		0xa1, 0x0f, 0xe1, 0xfd, 0xe9, 0x50, 0xac, 0x99, 0x9a, 0x25, 0x17, 0x2a, 0xff, 0x28, 0xc1, 0x66,
-		0x3a, 0x38, 0xf6, 0x75, 0x43, 0x5a, 0x71, 0x68, 0xf7, 0x60, 0x7b, 0xd8, 0x89, 0x90, 0xd8, 0xd1,
-		0xb8, 0xf6, 0xe1, 0xc1, 0x14, 0x5c, 0xd3, 0x78, 0xaa, 0x18, 0x55, 0xf6, 0x9c, 0x80, 0x64, 0x09,
-		0x3d, 0x86, 0x47, 0x53, 0x28, 0x07, 0x4a, 0x75, 0x90, 0xe5, 0xfe, 0xb0, 0x53, 0x2c, 0x0b, 0xeb,
-		0x07, 0x4d, 0x4b, 0x33, 0xe5, 0x02, 0xd2, 0x40, 0xc9, 0x71, 0x30, 0xac, 0x43, 0x99, 0x6e, 0x8a,
-		0xe8, 0x2b, 0xf8, 0x2c, 0x2f, 0x8e, 0xb8, 0x64, 0xf4, 0x17, 0x1a, 0x4e, 0x53, 0xe7, 0xd0, 0xd7,
-		0xf0, 0x79, 0x0e, 0x55, 0xbc, 0x79, 0x8c, 0x3b, 0x8f, 0x1e, 0xc1, 0x17, 0xb9, 0xd1, 0xab, 0x75,
-		0x5c, 0xb5, 0x5f, 0x28, 0xf8, 0xf9, 0x30, 0x79, 0x01, 0xe9, 0xa0, 0xe5, 0xbd, 0x58, 0xa8, 0x9b,
-		0x9d, 0xa1, 0x0b, 0x29, 0x57, 0x97, 0x66, 0xc8, 0x22, 0x33, 0xe4, 0xb8, 0xb9, 0x8c, 0x9e, 0x80,
-		0x3a, 0x5b, 0x2a, 0xa6, 0x3b, 0x5a, 0x44, 0xdf, 0x82, 0xf5, 0x6e, 0xa7, 0xaa, 0x7d, 0x6b, 0x69,
-		0xd8, 0x50, 0xf2, 0x3c, 0x03, 0xfa, 0x06, 0xbe, 0xca, 0x4d, 0xda, 0xb0, 0xfe, 0xa4, 0xe8, 0x25,
-		0xf4, 0x05, 0x7c, 0x32, 0x85, 0x9e, 0xae, 0x91, 0xc1, 0x85, 0x44, 0xaf, 0xca, 0x4b, 0xe8, 0x33,
-		0xd8, 0x9f, 0x42, 0xe4, 0x5d, 0x68, 0x9b, 0x96, 0xae, 0x3e, 0x3f, 0x8e, 0x97, 0x6b, 0xba, 0x69,
-		0xc9, 0xcb, 0xe8, 0x47, 0xf0, 0x83, 0x29, 0xb4, 0xfe, 0x66, 0xd9, 0x1f, 0x1a, 0x4e, 0xb5, 0x18,
-		0x83, 0x35, 0xb1, 0x26, 0xaf, 0xcc, 0x70, 0x26, 0xa6, 0xfe, 0x24, 0x3f, 0x73, 0xab, 0x48, 0x85,
-		0xc7, 0x33, 0xb5, 0x88, 0xfa, 0x54, 0xaf, 0x55, 0xb3, 0x9d, 0xc8, 0xe8, 0x13, 0xd8, 0x9b, 0xe2,
-		0xe4, 0xb0, 0x8e, 0x55, 0x4d, 0x4c, 0xac, 0xbe, 0x48, 0xac, 0xa1, 0xcf, 0xe1, 0xe1, 0x34, 0x92,
-		0xa2, 0xd7, 0xd8, 0xb5, 0x67, 0x94, 0x87, 0xd8, 0x18, 0x9d, 0x6d, 0xeb, 0xba, 0xd1, 0x68, 0x5a,
-		0xb6, 0xa9, 0x7f, 0xa7, 0xc9, 0xeb, 0x6c, 0x8c, 0xe6, 0x9e, 0x54, 0x92, 0x2b, 0xf9, 0xca, 0xb8,
-		0x18, 0x8f, 0xbd, 0xe4, 0x40, 0x37, 0x14, 0x7c, 0x2c, 0x6f, 0xe4, 0xd4, 0xde, 0xb8, 0xd0, 0x0d,
-		0x95, 0xd0, 0xd5, 0x59, 0xb6, 0xa3, 0x29, 0x58, 0x7d, 0x9a, 0xce, 0xf8, 0x26, 0x9b, 0x3a, 0x77,
-		0xf8, 0x8f, 0x41, 0x63, 0xf7, 0xaa, 0xb4, 0xc4, 0xef, 0xc3, 0x83, 0xf8, 0xdc, 0x32, 0xaa, 0x60,
-		0x82, 0xda, 0x1f, 0xc0, 0x0f, 0x67, 0xa3, 0xf4, 0xd7, 0x95, 0x1a, 0xd6, 0x94, 0xea, 0x71, 0xff,
-		0x36, 0x2c, 0x55, 0x7e, 0x5d, 0x80, 0x8a, 0xea, 0xf8, 0x2e, 0x69, 0x27, 0x3f, 0x42, 0x4f, 0x8d,
-		0xf2, 0x11, 0x7c, 0x31, 0x43, 0xbf, 0x4f, 0x88, 0xf7, 0x08, 0xcc, 0x77, 0x25, 0x37, 0x8d, 0xe7,
-		0x46, 0xfd, 0xc8, 0x98, 0x46, 0x90, 0x25, 0x64, 0xc0, 0xb3, 0x77, 0x75, 0x3c, 0x96, 0x92, 0xc1,
-		0x3d, 0xb4, 0xc0, 0x93, 0x62, 0x7a, 0x67, 0xfc, 0x17, 0xf9, 0xd9, 0x92, 0x22, 0xca, 0xf8, 0x3f,
-		0x4b, 0xca, 0xbb, 0x92, 0x67, 0x4e, 0xca, 0xbb, 0x3a, 0x9e, 0x96, 0x94, 0x83, 0x9f, 0xc2, 0xa6,
-		0x1b, 0x74, 0xb2, 0x7e, 0xe0, 0x38, 0x58, 0x4e, 0xd2, 0xd3, 0x60, 0x5f, 0xf8, 0x0d, 0xe9, 0xbb,
-		0xfd, 0x33, 0x8f, 0x9e, 0xf7, 0x4e, 0x76, 0xdd, 0xa0, 0xb3, 0x97, 0xfe, 0xaf, 0x81, 0x07, 0x5e,
-		0xab, 0xbd, 0x77, 0x16, 0xc4, 0xff, 0x85, 0x20, 0xfe, 0x85, 0xe0, 0x91, 0xd3, 0xf5, 0x5e, 0xef,
-		0x9f, 0x2c, 0x70, 0xdb, 0x27, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xc3, 0xe9, 0xec, 0x02,
-		0x21, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x6f, 0xdb, 0xd8,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0xfa, 0x3a, 0x8e, 0x15, 0x27, 0x63, 0x3b, 0x9a,
+		0x49, 0xc6, 0xd1, 0x3b, 0xb1, 0xc7, 0x99, 0xef, 0x49, 0xa7, 0x19, 0x9a, 0xa2, 0x13, 0x26, 0x0a,
+		0xa5, 0x5e, 0x51, 0xf1, 0x78, 0xd0, 0x96, 0xa0, 0xa5, 0x6b, 0x9b, 0x8d, 0x44, 0x0a, 0x24, 0x95,
+		0xc4, 0x40, 0x57, 0x45, 0x81, 0xae, 0xdb, 0x55, 0xd1, 0x55, 0x37, 0xdd, 0x14, 0x05, 0x8a, 0xa2,
+		0xeb, 0xa2, 0x9b, 0x6e, 0xfb, 0x3b, 0x8a, 0xfe, 0x81, 0xae, 0x8b, 0xfb, 0x41, 0x89, 0x92, 0x28,
+		0x51, 0x6e, 0x81, 0xe9, 0x2e, 0x3c, 0xf7, 0x79, 0x0e, 0xcf, 0x3d, 0xf7, 0x9e, 0xe7, 0x1c, 0x2a,
+		0x86, 0x42, 0xf7, 0x94, 0xf8, 0xfb, 0x0d, 0xbb, 0x49, 0xdc, 0x06, 0xd9, 0xb7, 0x3b, 0xce, 0xfe,
+		0xeb, 0x83, 0xfd, 0x37, 0x9e, 0xff, 0xea, 0xac, 0xe5, 0xbd, 0xd9, 0xeb, 0xf8, 0x5e, 0xe8, 0xa1,
+		0x35, 0x8a, 0xd9, 0x13, 0x98, 0x3d, 0xbb, 0xe3, 0xec, 0xbd, 0x3e, 0xd8, 0xdc, 0x3a, 0xf7, 0xbc,
+		0xf3, 0x16, 0xd9, 0x67, 0x90, 0xd3, 0xee, 0xd9, 0x7e, 0xb3, 0xeb, 0xdb, 0xa1, 0xe3, 0xb9, 0x9c,
+		0xb4, 0xb9, 0x3d, 0xbc, 0x1e, 0x3a, 0x6d, 0x12, 0x84, 0x76, 0xbb, 0x23, 0x00, 0x3b, 0x49, 0x6f,
+		0x6e, 0x78, 0xed, 0x76, 0xcf, 0x45, 0x62, 0x6c, 0xa1, 0x1d, 0xbc, 0x6a, 0x39, 0x41, 0xc8, 0x31,
+		0x85, 0xbf, 0x01, 0xac, 0x1f, 0x8b, 0x70, 0xb5, 0xb7, 0xa4, 0xd1, 0xa5, 0x21, 0xe8, 0xee, 0x99,
+		0x87, 0xea, 0x80, 0xa2, 0x7d, 0x58, 0x24, 0x5a, 0xc9, 0x4b, 0x3b, 0xd2, 0x6e, 0xee, 0xe1, 0xbd,
+		0xbd, 0x84, 0x2d, 0xed, 0x8d, 0xf8, 0xc1, 0xab, 0x6f, 0x86, 0x4d, 0xe8, 0x13, 0x98, 0x09, 0x2f,
+		0x3b, 0x24, 0x9f, 0x61, 0x8e, 0xee, 0x4c, 0x74, 0x64, 0x5e, 0x76, 0x08, 0x66, 0x70, 0xf4, 0x05,
+		0x40, 0x10, 0xda, 0x7e, 0x68, 0xd1, 0x34, 0xe4, 0xb3, 0x8c, 0xbc, 0xb9, 0xc7, 0x73, 0xb4, 0x17,
+		0xe5, 0x68, 0xcf, 0x8c, 0x72, 0x84, 0x17, 0x18, 0x9a, 0x3e, 0x53, 0x6a, 0xa3, 0xe5, 0x05, 0x84,
+		0x53, 0x67, 0xd2, 0xa9, 0x0c, 0xcd, 0xa8, 0x26, 0x2c, 0x72, 0x6a, 0x10, 0xda, 0x61, 0x37, 0xc8,
+		0xcf, 0xee, 0x48, 0xbb, 0xcb, 0x0f, 0x0f, 0xa6, 0xdb, 0xbd, 0x4a, 0x99, 0x35, 0x46, 0xc4, 0xb9,
+		0x46, 0xff, 0x01, 0xdd, 0x85, 0xe5, 0x0b, 0x27, 0x08, 0x3d, 0xff, 0xd2, 0x6a, 0x11, 0xf7, 0x3c,
+		0xbc, 0xc8, 0xcf, 0xed, 0x48, 0xbb, 0x59, 0xbc, 0x24, 0xac, 0x65, 0x66, 0x44, 0x3f, 0x84, 0xf5,
+		0x8e, 0xed, 0x13, 0x37, 0xec, 0xa7, 0xdf, 0x72, 0xdc, 0x33, 0x2f, 0x7f, 0x8d, 0x6d, 0x61, 0x37,
+		0x31, 0x8a, 0x2a, 0x63, 0x0c, 0x9c, 0x24, 0x5e, 0xeb, 0x8c, 0x1a, 0x91, 0x02, 0xcb, 0x7d, 0xb7,
+		0x2c, 0x33, 0xf3, 0xa9, 0x99, 0x59, 0xea, 0x31, 0x58, 0x76, 0x1e, 0xc0, 0x4c, 0x9b, 0xb4, 0xbd,
+		0xfc, 0x02, 0x23, 0xde, 0x4c, 0x8c, 0xe7, 0x05, 0x69, 0x7b, 0x98, 0xc1, 0x10, 0x86, 0xd5, 0x80,
+		0xd8, 0x7e, 0xe3, 0xc2, 0xb2, 0xc3, 0xd0, 0x77, 0x4e, 0xbb, 0x21, 0x09, 0xf2, 0xc0, 0xb8, 0x77,
+		0x13, 0xb9, 0x35, 0x86, 0x56, 0x7a, 0x60, 0x2c, 0x07, 0x43, 0x16, 0x54, 0x86, 0x55, 0xbb, 0x1b,
+		0x7a, 0x96, 0x4f, 0x02, 0x12, 0x5a, 0x1d, 0xcf, 0x71, 0xc3, 0x20, 0x9f, 0x63, 0x3e, 0x77, 0x12,
+		0x7d, 0x62, 0x0a, 0xac, 0x32, 0x1c, 0x5e, 0xa1, 0xd4, 0x98, 0x01, 0xdd, 0x82, 0x05, 0x5a, 0x1e,
+		0x16, 0xad, 0x8f, 0xfc, 0xe2, 0x8e, 0xb4, 0xbb, 0x80, 0xe7, 0xa9, 0xa1, 0xec, 0x04, 0x21, 0x52,
+		0x61, 0xb9, 0xb7, 0xc8, 0xcf, 0x61, 0x95, 0xbd, 0xe7, 0x9d, 0xc4, 0xf7, 0x98, 0x82, 0x86, 0x17,
+		0x23, 0x07, 0x2c, 0xeb, 0x1b, 0x70, 0xcd, 0x09, 0xac, 0x86, 0xef, 0xb9, 0xf9, 0xa5, 0x1d, 0x69,
+		0x77, 0x1e, 0xcf, 0x39, 0x81, 0xea, 0x7b, 0x2e, 0x7a, 0x04, 0xb9, 0x6e, 0xa7, 0x69, 0x87, 0xe2,
+		0x96, 0x2e, 0xa7, 0x9e, 0x05, 0x70, 0x38, 0x3b, 0x88, 0x9f, 0x80, 0xdc, 0xb1, 0xfd, 0xd0, 0x61,
+		0x67, 0xd9, 0xf0, 0xdc, 0x33, 0xe7, 0x3c, 0xbf, 0xb2, 0x93, 0xdd, 0xcd, 0x3d, 0x7c, 0x3c, 0xdd,
+		0x55, 0xa5, 0xb1, 0xd1, 0xab, 0xc3, 0x5d, 0xa8, 0xcc, 0x83, 0xe6, 0x86, 0xfe, 0x25, 0x5e, 0xe9,
+		0x0c, 0x5a, 0xd1, 0x4b, 0x58, 0xa3, 0xe1, 0x5b, 0xde, 0x6b, 0xe2, 0xb7, 0xec, 0x8e, 0xd5, 0xf1,
+		0x5a, 0x4e, 0xe3, 0x32, 0x2f, 0xb3, 0xca, 0x48, 0xd6, 0x05, 0xba, 0xc1, 0x0a, 0x87, 0x57, 0x19,
+		0x1a, 0xaf, 0x36, 0x86, 0x4d, 0xe8, 0x2d, 0x6c, 0xdb, 0x8d, 0xd0, 0x79, 0x4d, 0xac, 0x46, 0xab,
+		0x1b, 0x84, 0xc4, 0xb7, 0x02, 0xd2, 0x22, 0x0d, 0xb6, 0x25, 0xf1, 0x0e, 0xc4, 0x92, 0x92, 0x5c,
+		0x7d, 0x0a, 0xe3, 0xaa, 0x9c, 0x5a, 0x8b, 0x98, 0xe2, 0x75, 0xb7, 0xed, 0x09, 0xab, 0x9b, 0x87,
+		0x70, 0x3d, 0x69, 0xeb, 0x48, 0x86, 0xec, 0x2b, 0x72, 0xc9, 0x14, 0x6f, 0x01, 0xd3, 0x7f, 0xa2,
+		0xeb, 0x30, 0xfb, 0xda, 0x6e, 0x75, 0xb9, 0x78, 0x2d, 0x60, 0xfe, 0xf0, 0x65, 0xe6, 0x73, 0xa9,
+		0xf0, 0xeb, 0x0c, 0x6c, 0x8d, 0x0a, 0x00, 0x73, 0x26, 0x64, 0x1d, 0x7d, 0x19, 0xbf, 0x5c, 0xd2,
+		0x34, 0x57, 0xa7, 0x7f, 0xf7, 0x6c, 0xd8, 0xe9, 0x17, 0xab, 0xd0, 0x41, 0xcf, 0xea, 0xab, 0x9a,
+		0xd7, 0x0d, 0x85, 0xa0, 0xde, 0x1c, 0xb9, 0x32, 0x25, 0x11, 0x00, 0xbe, 0xdd, 0x73, 0x51, 0x63,
+		0xda, 0xe8, 0xa9, 0x91, 0xce, 0x79, 0xdd, 0x10, 0x1d, 0xc3, 0x2d, 0x16, 0xde, 0x18, 0xef, 0xd9,
+		0x34, 0xef, 0x1b, 0x94, 0x9d, 0xe0, 0xb8, 0xf0, 0x77, 0x09, 0xd6, 0x12, 0x54, 0x89, 0x16, 0x5b,
+		0xd3, 0x6b, 0xdb, 0x8e, 0x6b, 0x39, 0x4d, 0x91, 0xe4, 0x79, 0x6e, 0xd0, 0x9b, 0x68, 0x1b, 0x72,
+		0x62, 0xd1, 0xb5, 0xdb, 0x51, 0xbe, 0x81, 0x9b, 0x0c, 0xbb, 0x4d, 0xc6, 0x74, 0xa7, 0xec, 0x7f,
+		0xdb, 0x9d, 0xee, 0xc0, 0xa2, 0xe3, 0x3a, 0xa1, 0x63, 0x87, 0xa4, 0x49, 0xe3, 0x9a, 0x61, 0xc2,
+		0x9c, 0xeb, 0xd9, 0xf4, 0x66, 0xe1, 0x97, 0x12, 0xac, 0x6b, 0x6f, 0x43, 0xe2, 0xbb, 0x76, 0xeb,
+		0x3b, 0xe9, 0x98, 0xc3, 0x31, 0x65, 0x46, 0x63, 0xfa, 0xd7, 0x1c, 0xac, 0x55, 0x89, 0xdb, 0x74,
+		0xdc, 0x73, 0x56, 0x08, 0x4e, 0x78, 0xc9, 0x22, 0xda, 0x86, 0x9c, 0x2d, 0x9e, 0xfb, 0x59, 0x86,
+		0xc8, 0xa4, 0x37, 0xd1, 0x11, 0x2c, 0xf5, 0x00, 0xa9, 0x6d, 0x39, 0x72, 0xcd, 0xda, 0xf2, 0xa2,
+		0x1d, 0x7b, 0x42, 0x8f, 0x61, 0x96, 0xb6, 0x48, 0xde, 0x99, 0x97, 0x1f, 0xde, 0x4f, 0xee, 0x4d,
+		0x83, 0x11, 0xd2, 0x6e, 0x48, 0x30, 0xe7, 0x21, 0x1d, 0x56, 0x2f, 0x88, 0xed, 0x87, 0xa7, 0xc4,
+		0x0e, 0xad, 0x26, 0x09, 0x6d, 0xa7, 0x15, 0x88, 0x5e, 0x7d, 0x7b, 0x4c, 0xa3, 0xbb, 0x6c, 0x79,
+		0x76, 0x13, 0xcb, 0x3d, 0x5a, 0x89, 0xb3, 0xd0, 0x33, 0x58, 0x6b, 0xd9, 0x41, 0x68, 0xf5, 0xfd,
+		0x31, 0x49, 0x9d, 0x4d, 0x95, 0xd4, 0x55, 0x4a, 0x7b, 0x1a, 0xb1, 0x98, 0xb2, 0x1e, 0x01, 0x33,
+		0xf2, 0xaa, 0x20, 0x4d, 0xee, 0x69, 0x2e, 0xd5, 0xd3, 0x0a, 0x25, 0xd5, 0x38, 0x87, 0xf9, 0xc9,
+		0xc3, 0x35, 0x3b, 0x0c, 0x49, 0xbb, 0x13, 0xb2, 0xee, 0x3d, 0x8b, 0xa3, 0x47, 0x74, 0x1f, 0xe4,
+		0xb6, 0xfd, 0xd6, 0x69, 0x77, 0xdb, 0x96, 0x30, 0x05, 0xac, 0x13, 0xcf, 0xe2, 0x15, 0x61, 0x57,
+		0x84, 0x99, 0xb6, 0xec, 0xa0, 0x71, 0x41, 0x9a, 0xdd, 0x56, 0x14, 0xc9, 0x42, 0x7a, 0xcb, 0xee,
+		0x31, 0x58, 0x1c, 0x2a, 0xac, 0x90, 0xb7, 0x1d, 0x87, 0xd7, 0x2c, 0xf7, 0x01, 0xa9, 0x3e, 0x96,
+		0xfb, 0x14, 0xe6, 0xe4, 0x31, 0x2c, 0xb2, 0xa4, 0x9c, 0xd9, 0x4e, 0xab, 0xeb, 0x13, 0xd1, 0x6f,
+		0x93, 0x8f, 0xe9, 0x88, 0x63, 0x70, 0x8e, 0x32, 0xc4, 0x03, 0xfa, 0x10, 0xae, 0x33, 0x07, 0xf4,
+		0xae, 0x13, 0xdf, 0x72, 0x9a, 0xc4, 0x0d, 0x9d, 0xf0, 0x52, 0xb4, 0x5c, 0x44, 0xd7, 0x8e, 0xd9,
+		0x92, 0x2e, 0x56, 0xd0, 0xa7, 0xb0, 0x11, 0x1d, 0xc1, 0x30, 0x69, 0x89, 0x91, 0xd6, 0xc5, 0xf2,
+		0x10, 0x6f, 0x1b, 0x72, 0x51, 0x02, 0x68, 0x01, 0x2c, 0xb3, 0xd2, 0x81, 0xc8, 0xa4, 0x37, 0xd1,
+		0x57, 0x00, 0x1d, 0xbb, 0x1b, 0x10, 0xde, 0xd1, 0x57, 0xd8, 0x4e, 0xb6, 0xc6, 0x5c, 0xb8, 0x6e,
+		0x40, 0xd8, 0x3c, 0xb5, 0xd0, 0x89, 0xfe, 0x59, 0xf8, 0x29, 0x2c, 0xf4, 0xec, 0xe8, 0x06, 0xcc,
+		0xf9, 0xc4, 0x0e, 0x44, 0xcd, 0x2f, 0x60, 0xf1, 0x84, 0x36, 0x61, 0xbe, 0x17, 0x2d, 0x57, 0xb2,
+		0xde, 0x33, 0xed, 0xfb, 0xcc, 0x5b, 0x73, 0xda, 0xc1, 0x96, 0x87, 0xcb, 0x4e, 0xb3, 0xf0, 0xe7,
+		0x0c, 0xdc, 0x14, 0x45, 0xa5, 0x5e, 0x38, 0xad, 0xe6, 0x77, 0x22, 0x47, 0x1f, 0xc4, 0xdc, 0x52,
+		0xc9, 0x88, 0x2b, 0xb4, 0xfc, 0x26, 0x36, 0xb9, 0x33, 0x9d, 0x1e, 0x16, 0xaf, 0xec, 0x88, 0x78,
+		0xd1, 0x89, 0x42, 0xcc, 0xb9, 0xbc, 0xe5, 0x88, 0x6e, 0x3f, 0x33, 0x61, 0xa2, 0xe0, 0xfd, 0x84,
+		0xb5, 0x99, 0x68, 0xa2, 0xe8, 0x0c, 0x9b, 0xe8, 0x71, 0xf0, 0x86, 0xc1, 0x4a, 0x7f, 0x01, 0x8b,
+		0xa7, 0xc2, 0x3f, 0x32, 0x3d, 0xb1, 0x2c, 0x91, 0x86, 0x13, 0x44, 0xf9, 0xea, 0x69, 0x98, 0x94,
+		0xae, 0x61, 0x11, 0x71, 0x40, 0xc3, 0x46, 0xeb, 0x33, 0x73, 0xd5, 0xfa, 0xfc, 0x0a, 0x16, 0x07,
+		0xa4, 0x26, 0xfd, 0x3e, 0xe4, 0x82, 0x64, 0x99, 0x99, 0x19, 0x94, 0x19, 0x0c, 0x1b, 0x9e, 0xef,
+		0x9c, 0x3b, 0xae, 0xdd, 0xb2, 0x86, 0x82, 0x4c, 0x17, 0xc6, 0xf5, 0x88, 0x5a, 0x1b, 0x08, 0x76,
+		0xa8, 0xb8, 0xe6, 0x86, 0x8b, 0xab, 0xf0, 0x97, 0x0c, 0xdc, 0x8c, 0xd4, 0xbe, 0xec, 0x35, 0xec,
+		0x56, 0xc9, 0x09, 0x3a, 0x76, 0xd8, 0xb8, 0x98, 0xae, 0x39, 0xfd, 0xef, 0xf3, 0xf9, 0x63, 0xd8,
+		0x1a, 0x8c, 0xc0, 0xf2, 0xce, 0xac, 0xf0, 0xc2, 0x09, 0xac, 0x78, 0x9a, 0x27, 0x3b, 0xdc, 0x1c,
+		0x88, 0xa8, 0x72, 0x66, 0x5e, 0x38, 0x81, 0x90, 0x74, 0xf4, 0x0e, 0x00, 0x1b, 0xba, 0x42, 0xef,
+		0x15, 0xe1, 0xd7, 0x74, 0x11, 0xb3, 0x29, 0xd1, 0xa4, 0x86, 0xc2, 0x33, 0xc8, 0xc5, 0x3f, 0x4f,
+		0x1e, 0xc1, 0x9c, 0xf8, 0xc2, 0x91, 0xd8, 0x70, 0xff, 0x6e, 0xca, 0x17, 0x0e, 0x13, 0x2b, 0x41,
+		0x29, 0xfc, 0x31, 0x03, 0xcb, 0x83, 0x4b, 0xe8, 0x7d, 0x58, 0x39, 0x75, 0x5c, 0xdb, 0xbf, 0xb4,
+		0x1a, 0x17, 0xa4, 0xf1, 0x2a, 0xe8, 0xb6, 0xc5, 0x21, 0x2c, 0x73, 0xb3, 0x2a, 0xac, 0x68, 0x1d,
+		0xe6, 0xfc, 0xae, 0x1b, 0xcd, 0x1e, 0x0b, 0x78, 0xd6, 0xef, 0xba, 0x4c, 0x3b, 0x6f, 0x9d, 0x39,
+		0x7e, 0x40, 0xfb, 0x35, 0xaf, 0x06, 0xab, 0xe1, 0xb5, 0x3b, 0x2d, 0x32, 0x50, 0xea, 0x79, 0x06,
+		0x89, 0xea, 0x45, 0x8d, 0x00, 0x8c, 0xbe, 0xd8, 0xf0, 0x89, 0xdd, 0x3b, 0x9b, 0xf4, 0x54, 0xe6,
+		0x04, 0x5e, 0x74, 0xa1, 0x25, 0xd6, 0x97, 0x1c, 0xf7, 0x7c, 0xda, 0x7b, 0xbc, 0x18, 0x11, 0x98,
+		0x83, 0x2d, 0x00, 0xf6, 0xd9, 0x18, 0xda, 0xa7, 0x2d, 0xde, 0xd4, 0xe7, 0x71, 0xcc, 0x52, 0xf8,
+		0x99, 0x04, 0xcb, 0x91, 0x22, 0xd6, 0xd9, 0xc7, 0x16, 0x9d, 0x59, 0xc5, 0x57, 0x5a, 0x7f, 0x66,
+		0xe5, 0x06, 0x3e, 0xb3, 0x8a, 0xc5, 0xf8, 0xcc, 0xca, 0x4d, 0x4c, 0x0b, 0x1f, 0xc2, 0xac, 0xe3,
+		0x76, 0x7a, 0xc3, 0xf4, 0xe4, 0xb9, 0x86, 0x43, 0x8b, 0x7f, 0x92, 0xe0, 0x7a, 0xd2, 0xdc, 0x84,
+		0x0a, 0xb0, 0x55, 0xd5, 0x8c, 0x92, 0x6e, 0x3c, 0xb1, 0x14, 0xd5, 0xd4, 0x5f, 0xea, 0xe6, 0x89,
+		0x55, 0x33, 0x15, 0x53, 0xb3, 0x74, 0xe3, 0xa5, 0x52, 0xd6, 0x4b, 0xf2, 0xff, 0xa1, 0xf7, 0x60,
+		0x67, 0x0c, 0xa6, 0xa6, 0x3e, 0xd5, 0x4a, 0xf5, 0xb2, 0x56, 0x92, 0xa5, 0x09, 0x9e, 0x6a, 0xa6,
+		0x82, 0x4d, 0xad, 0x24, 0x67, 0xd0, 0xff, 0xc3, 0xfb, 0x63, 0x30, 0xaa, 0x62, 0xa8, 0x5a, 0xd9,
+		0xc2, 0xda, 0x0f, 0xea, 0x5a, 0x8d, 0x82, 0xb3, 0xc5, 0x9f, 0xf7, 0x63, 0x1e, 0xd0, 0xc9, 0xf8,
+		0x9b, 0x4a, 0x9a, 0xaa, 0xd7, 0xf4, 0x8a, 0x31, 0x29, 0xe6, 0x21, 0xcc, 0x98, 0x98, 0x87, 0x51,
+		0x51, 0xcc, 0xc5, 0x5f, 0x64, 0xfa, 0x3f, 0x6d, 0xe9, 0x4d, 0x4c, 0xba, 0xbd, 0xce, 0xf0, 0x1e,
+		0xec, 0x1c, 0x57, 0xf0, 0xf3, 0xa3, 0x72, 0xe5, 0xd8, 0xd2, 0x4b, 0x16, 0xd6, 0xea, 0x35, 0xcd,
+		0xaa, 0x56, 0xca, 0xba, 0x7a, 0x12, 0x8b, 0xe4, 0x73, 0xf8, 0x78, 0x2c, 0x4a, 0x29, 0x53, 0x6b,
+		0xa9, 0x5e, 0x2d, 0xeb, 0x2a, 0x7d, 0xeb, 0x91, 0xa2, 0x97, 0xb5, 0x92, 0x55, 0x31, 0xca, 0x27,
+		0xb2, 0x84, 0x3e, 0x80, 0xdd, 0x69, 0x99, 0x72, 0x06, 0x3d, 0x80, 0xfb, 0x63, 0xd1, 0x58, 0x7b,
+		0xa6, 0xa9, 0x66, 0x0c, 0x9e, 0x45, 0x07, 0xf0, 0x60, 0x2c, 0xdc, 0xd4, 0xf0, 0x0b, 0xdd, 0x60,
+		0x09, 0x3d, 0xb2, 0x70, 0xdd, 0x30, 0x74, 0xe3, 0x89, 0x3c, 0x53, 0xbc, 0x84, 0xd5, 0x91, 0x6f,
+		0x70, 0xb4, 0x0d, 0xb7, 0x54, 0x5c, 0x31, 0xac, 0xca, 0x4b, 0x0d, 0x97, 0x95, 0xea, 0xe8, 0xfe,
+		0xc7, 0x00, 0x6a, 0xcf, 0xf5, 0x6a, 0x35, 0x3a, 0x84, 0x24, 0xc0, 0x61, 0xfd, 0xe8, 0x48, 0xc3,
+		0x56, 0xc5, 0xd0, 0xe4, 0x4c, 0xf1, 0xb7, 0x12, 0xac, 0x8e, 0x74, 0x6b, 0xea, 0xba, 0xaa, 0x60,
+		0xcd, 0x30, 0x2d, 0xb5, 0x5c, 0x49, 0xca, 0xfd, 0x18, 0x80, 0x72, 0xa8, 0x18, 0xa5, 0x8a, 0x21,
+		0x4b, 0xe8, 0x1e, 0x14, 0x92, 0x00, 0xe2, 0x1a, 0x8a, 0x5b, 0x29, 0x67, 0xd0, 0x1d, 0x78, 0x27,
+		0x09, 0xd7, 0x4b, 0x94, 0x9c, 0x2d, 0xfe, 0x33, 0x03, 0xb7, 0x27, 0xfd, 0x78, 0x47, 0x2f, 0x7f,
+		0x2f, 0xe3, 0xda, 0x37, 0x9a, 0x5a, 0x37, 0xe9, 0x75, 0xe3, 0xfe, 0xe8, 0xa5, 0xab, 0xd7, 0x62,
+		0x91, 0xc7, 0x4f, 0x73, 0x0c, 0x58, 0xad, 0xbc, 0xa8, 0x96, 0x35, 0x93, 0xe5, 0xb0, 0x08, 0xf7,
+		0xd2, 0xe0, 0xfc, 0x6e, 0xc9, 0x99, 0x81, 0x6b, 0x35, 0xce, 0x35, 0xdb, 0x37, 0xad, 0x42, 0xb4,
+		0x07, 0xc5, 0x34, 0x74, 0x2f, 0x0b, 0x25, 0x79, 0x06, 0x7d, 0x0c, 0x1f, 0xa6, 0x07, 0x6e, 0x98,
+		0xba, 0x51, 0xd7, 0x4a, 0x96, 0x52, 0xb3, 0x0c, 0xed, 0x58, 0x9e, 0x9d, 0x66, 0xbb, 0xa6, 0xfe,
+		0x82, 0x96, 0x46, 0xdd, 0x94, 0xe7, 0x8a, 0x7f, 0x95, 0xe0, 0x86, 0xea, 0xb9, 0xa1, 0xe3, 0x76,
+		0x89, 0x12, 0x18, 0xe4, 0x8d, 0xce, 0x07, 0x41, 0xcf, 0x47, 0x77, 0xe1, 0x4e, 0xe4, 0x5f, 0xb8,
+		0xb7, 0x74, 0x43, 0x37, 0x75, 0xc5, 0xac, 0xe0, 0x58, 0x7e, 0x27, 0xc2, 0xa8, 0x16, 0x94, 0x34,
+		0xcc, 0xf3, 0x3a, 0x1e, 0x86, 0x35, 0x13, 0x9f, 0x88, 0xab, 0xc0, 0xc5, 0x6d, 0x3c, 0x96, 0xdd,
+		0xf0, 0x48, 0x7a, 0xe4, 0x6c, 0xf1, 0x0f, 0x12, 0xe4, 0xc4, 0x4f, 0x1b, 0xec, 0xcb, 0x37, 0x0f,
+		0xd7, 0xe9, 0x06, 0x2b, 0x75, 0xd3, 0x32, 0x4f, 0xaa, 0xda, 0xe0, 0x1d, 0x1e, 0x58, 0x61, 0xca,
+		0x64, 0x99, 0x15, 0x9e, 0x1d, 0x5e, 0x3f, 0x83, 0x00, 0xf1, 0x16, 0x8a, 0x61, 0x60, 0x39, 0x33,
+		0x11, 0xc3, 0xfd, 0x64, 0xd1, 0x26, 0xdc, 0x18, 0xc0, 0x3c, 0xd5, 0x14, 0x6c, 0x1e, 0x6a, 0x8a,
+		0x29, 0xcf, 0x14, 0x7f, 0x23, 0xc1, 0xcd, 0x48, 0x84, 0x4d, 0x3a, 0x58, 0x38, 0x6d, 0xd2, 0xac,
+		0x74, 0x43, 0x95, 0x7e, 0x45, 0xa0, 0xfb, 0x70, 0xb7, 0x27, 0x9f, 0xa6, 0x52, 0x7b, 0xde, 0x3f,
+		0x2b, 0x4b, 0x55, 0xa8, 0xae, 0xf4, 0x77, 0x93, 0x0a, 0x15, 0x21, 0xc8, 0x12, 0x7a, 0x1f, 0xde,
+		0x9d, 0x0c, 0xc5, 0x5a, 0x4d, 0x33, 0xe5, 0x4c, 0xf1, 0xf7, 0x4b, 0xb0, 0x11, 0x0f, 0x8e, 0x7e,
+		0x1f, 0x92, 0x26, 0x0f, 0xed, 0x1e, 0x14, 0x06, 0x9d, 0x08, 0x89, 0x1d, 0x8e, 0xeb, 0x00, 0x1e,
+		0x4c, 0xc0, 0xd5, 0x8d, 0xa7, 0x8a, 0x51, 0xa2, 0xcf, 0x11, 0x48, 0x96, 0xd0, 0x63, 0x78, 0x34,
+		0x81, 0x72, 0xa8, 0x94, 0xfa, 0x59, 0xee, 0x35, 0x3b, 0xc5, 0x34, 0xb1, 0x7e, 0x58, 0x37, 0xb5,
+		0x9a, 0x9c, 0x41, 0x1a, 0x28, 0x29, 0x0e, 0x06, 0x75, 0x28, 0xd1, 0x4d, 0x16, 0x7d, 0x01, 0x9f,
+		0xa4, 0xc5, 0xc1, 0xaf, 0x8c, 0xfe, 0x42, 0xc3, 0x71, 0xea, 0x0c, 0xfa, 0x12, 0x3e, 0x4d, 0xa1,
+		0x8a, 0x37, 0x8f, 0x70, 0x67, 0xd1, 0x23, 0xf8, 0x2c, 0x35, 0x7a, 0xb5, 0x82, 0x4b, 0xd6, 0x0b,
+		0x05, 0x3f, 0x1f, 0x24, 0xcf, 0x21, 0x1d, 0xb4, 0xb4, 0x17, 0x0b, 0x75, 0xb3, 0x12, 0x74, 0x21,
+		0xe6, 0xea, 0xda, 0x14, 0x59, 0xa4, 0x86, 0x14, 0x37, 0xf3, 0xe8, 0x09, 0xa8, 0xd3, 0xa5, 0x62,
+		0xb2, 0xa3, 0x05, 0xf4, 0x0d, 0x98, 0x57, 0x3b, 0x55, 0xed, 0x1b, 0x53, 0xc3, 0x86, 0x92, 0xe6,
+		0x19, 0xd0, 0x57, 0xf0, 0x45, 0x6a, 0xd2, 0x06, 0xf5, 0x27, 0x46, 0xcf, 0xa1, 0xcf, 0xe0, 0xa3,
+		0x09, 0xf4, 0xf8, 0x1d, 0xe9, 0x0f, 0x24, 0x7a, 0x49, 0x5e, 0x44, 0x9f, 0xc0, 0xc1, 0x04, 0x22,
+		0xab, 0x42, 0xab, 0x66, 0xea, 0xea, 0xf3, 0x13, 0xbe, 0x5c, 0xd6, 0x6b, 0xa6, 0xbc, 0x84, 0xbe,
+		0x86, 0xef, 0x4d, 0xa0, 0xf5, 0x36, 0x4b, 0xff, 0xa1, 0xe1, 0x58, 0x89, 0x51, 0x58, 0x1d, 0x6b,
+		0xf2, 0xf2, 0x14, 0x67, 0x52, 0xd3, 0x9f, 0xa4, 0x67, 0x6e, 0x05, 0xa9, 0xf0, 0x78, 0xaa, 0x12,
+		0x51, 0x9f, 0xea, 0xe5, 0x52, 0xb2, 0x13, 0x19, 0x7d, 0x04, 0xfb, 0x13, 0x9c, 0x1c, 0x55, 0xb0,
+		0xaa, 0x89, 0x8e, 0xd5, 0x13, 0x89, 0x55, 0xf4, 0x29, 0x3c, 0x9c, 0x44, 0x52, 0xf4, 0x32, 0x1d,
+		0x7b, 0x86, 0x79, 0x88, 0xb6, 0xd1, 0xe9, 0xb6, 0xae, 0x1b, 0xd5, 0xba, 0x69, 0xd5, 0xf4, 0x6f,
+		0x35, 0x79, 0x8d, 0xb6, 0xd1, 0xd4, 0x93, 0x8a, 0x72, 0x25, 0x5f, 0x1f, 0x15, 0xe3, 0x91, 0x97,
+		0x1c, 0xea, 0x86, 0x82, 0x4f, 0xe4, 0xf5, 0x94, 0xbb, 0x37, 0x2a, 0x74, 0x03, 0x57, 0xe8, 0xc6,
+		0x34, 0xdb, 0xd1, 0x14, 0xac, 0x3e, 0x8d, 0x67, 0x7c, 0x03, 0x95, 0xe0, 0xeb, 0x14, 0x96, 0xa2,
+		0xaa, 0x5a, 0xb5, 0xbf, 0x27, 0xab, 0x5e, 0x2d, 0xd1, 0x77, 0xc6, 0xbc, 0xe4, 0xa7, 0xf0, 0x22,
+		0xc6, 0xe3, 0x09, 0x5e, 0x6e, 0xa2, 0x23, 0x38, 0xbc, 0xb2, 0x62, 0x8d, 0xfa, 0xd9, 0x2c, 0xfe,
+		0x4e, 0x82, 0xb5, 0xc1, 0xcf, 0xc1, 0x5a, 0x68, 0x9f, 0x13, 0x3a, 0x61, 0x0e, 0xf3, 0x6a, 0xa6,
+		0xf2, 0x24, 0xde, 0xa3, 0x0a, 0xb0, 0x95, 0x0c, 0xe1, 0x59, 0x88, 0x86, 0xe9, 0x64, 0x0c, 0xdf,
+		0x23, 0x1b, 0x00, 0xdf, 0x85, 0xed, 0x64, 0x4c, 0x7f, 0xa2, 0xcc, 0xd2, 0x8e, 0x7f, 0x87, 0xfd,
+		0x1a, 0x38, 0x32, 0xd3, 0xc6, 0xdb, 0xeb, 0x01, 0x3c, 0xe0, 0x35, 0x93, 0x50, 0x81, 0x63, 0x3a,
+		0xed, 0x21, 0x7c, 0x7f, 0x3a, 0x4a, 0x6f, 0x5d, 0x29, 0x63, 0x4d, 0x29, 0x9d, 0xf4, 0xbe, 0x44,
+		0xa4, 0xe2, 0xaf, 0x32, 0x50, 0x54, 0x6d, 0xb7, 0x41, 0x5a, 0xd1, 0x7f, 0xa1, 0x4c, 0x8c, 0xf2,
+		0x11, 0x7c, 0x36, 0x85, 0xd6, 0x8e, 0x89, 0xf7, 0x18, 0x6a, 0x57, 0x25, 0xd7, 0x8d, 0xe7, 0x46,
+		0xe5, 0xd8, 0x98, 0x44, 0x90, 0x25, 0x64, 0xc0, 0xb3, 0xab, 0x3a, 0x1e, 0x49, 0x49, 0xff, 0xc4,
+		0x32, 0x2c, 0x29, 0x35, 0xe7, 0x9c, 0xfd, 0x7f, 0xd2, 0x74, 0x49, 0x11, 0x12, 0xf2, 0x9f, 0x25,
+		0xe5, 0xaa, 0xe4, 0xa9, 0x93, 0x72, 0x55, 0xc7, 0x93, 0x92, 0x72, 0xf8, 0x23, 0xd8, 0x68, 0x78,
+		0xed, 0xa4, 0x9f, 0x48, 0x0e, 0x97, 0xa2, 0xf4, 0x54, 0x7d, 0x2f, 0xf4, 0xaa, 0xd2, 0xb7, 0x07,
+		0xe7, 0x4e, 0x78, 0xd1, 0x3d, 0xdd, 0x6b, 0x78, 0xed, 0xfd, 0xf8, 0xdf, 0xbc, 0x3c, 0x70, 0x9a,
+		0xad, 0xfd, 0x73, 0x8f, 0xff, 0x0d, 0x8d, 0xf8, 0x03, 0x98, 0x47, 0x76, 0xc7, 0x79, 0x7d, 0x70,
+		0x3a, 0xc7, 0x6c, 0x1f, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xd3, 0x79, 0x4a, 0xc0, 0x23,
+		0x00, 0x00,
 	},
 	// uber/cadence/api/v1/query.proto
 	[]byte{
@@ -3356,406 +3665,514 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	},
 	// uber/cadence/api/v1/service_workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
-		0x15, 0x07, 0x57, 0xdf, 0x6f, 0x25, 0x59, 0x1a, 0x7d, 0xad, 0x57, 0xd6, 0x17, 0x1d, 0xa7, 0xaa,
-		0x63, 0xaf, 0x2a, 0x29, 0xfe, 0x88, 0x93, 0x36, 0x90, 0xd7, 0x96, 0xa3, 0xc2, 0x4e, 0x55, 0x4a,
-		0xb5, 0xd1, 0x5e, 0x88, 0x11, 0x39, 0x5a, 0x8d, 0xc5, 0x25, 0xa9, 0xe1, 0x50, 0xf2, 0x26, 0x87,
-		0xa2, 0x45, 0x90, 0x02, 0x45, 0x5b, 0xf4, 0xd8, 0x02, 0x05, 0x7a, 0xe8, 0x21, 0xa7, 0xe6, 0xd2,
-		0x53, 0xef, 0x45, 0xff, 0x8f, 0xfe, 0x05, 0x39, 0xf5, 0x1a, 0x14, 0x9c, 0x19, 0xee, 0x97, 0x48,
-		0xee, 0xae, 0x94, 0xc0, 0x4e, 0x6f, 0x3b, 0x33, 0xef, 0xf7, 0xe6, 0xcd, 0xfb, 0x9a, 0x37, 0x6f,
-		0x09, 0x37, 0xc3, 0x03, 0xc2, 0xd6, 0x2c, 0x6c, 0x13, 0xd7, 0x22, 0x6b, 0xd8, 0xa7, 0x6b, 0xa7,
-		0xeb, 0x6b, 0x01, 0x61, 0xa7, 0xd4, 0x22, 0xe6, 0x99, 0xc7, 0x8e, 0x0f, 0x1d, 0xef, 0xac, 0xe4,
-		0x33, 0x8f, 0x7b, 0x68, 0x2a, 0xa2, 0x2d, 0x29, 0xda, 0x12, 0xf6, 0x69, 0xe9, 0x74, 0xbd, 0xb8,
-		0x58, 0xf1, 0xbc, 0x8a, 0x43, 0xd6, 0x04, 0xc9, 0x41, 0x78, 0xb8, 0x66, 0x87, 0x0c, 0x73, 0xea,
-		0xb9, 0x12, 0x54, 0x5c, 0x6a, 0x5f, 0xe7, 0xb4, 0x4a, 0x02, 0x8e, 0xab, 0xbe, 0x22, 0x58, 0x4e,
-		0x92, 0xc0, 0xf2, 0xaa, 0xd5, 0x3a, 0x8b, 0x95, 0x24, 0x8a, 0x23, 0x1a, 0x70, 0x8f, 0xd5, 0xe2,
-		0x5d, 0x92, 0x48, 0x4e, 0x42, 0x52, 0x27, 0xd0, 0x93, 0x08, 0x38, 0x0e, 0x8e, 0x1d, 0x1a, 0xf0,
-		0x2c, 0x9a, 0x56, 0x1d, 0xe8, 0xff, 0xd2, 0x60, 0xc9, 0x88, 0xe4, 0x67, 0xfc, 0x85, 0x5a, 0x79,
-		0xfc, 0x8a, 0x58, 0x61, 0x74, 0x62, 0x83, 0x9c, 0x84, 0x24, 0xe0, 0x68, 0x16, 0x06, 0x6d, 0xaf,
-		0x8a, 0xa9, 0x5b, 0xd0, 0x96, 0xb5, 0xd5, 0x11, 0x43, 0x8d, 0xd0, 0xcf, 0x00, 0xc5, 0xdc, 0x4c,
-		0x12, 0x83, 0x0a, 0xb9, 0x65, 0x6d, 0x35, 0xbf, 0xf1, 0x76, 0x29, 0x41, 0xb9, 0xa5, 0xf3, 0x5b,
-		0x4c, 0x9e, 0xb5, 0x4f, 0xa1, 0x22, 0x0c, 0x53, 0x9b, 0xb8, 0x9c, 0xf2, 0x5a, 0xa1, 0x4f, 0x6c,
-		0x58, 0x1f, 0x47, 0xa2, 0x30, 0x82, 0x03, 0xcf, 0x2d, 0xf4, 0x4b, 0x51, 0xe4, 0x48, 0xff, 0xbb,
-		0x06, 0xcb, 0x8f, 0x28, 0xae, 0xb8, 0x5e, 0x40, 0xbe, 0x03, 0xe7, 0xd0, 0xbf, 0xd0, 0x60, 0x25,
-		0x43, 0xde, 0xc0, 0xf7, 0xdc, 0x80, 0xa4, 0x0a, 0xfc, 0x12, 0x16, 0x6c, 0x09, 0xe6, 0xd4, 0x32,
-		0x2f, 0x2d, 0xfb, 0x7c, 0x83, 0xd9, 0xb9, 0x45, 0xfd, 0x4f, 0x00, 0x0b, 0x7b, 0x17, 0x72, 0x8f,
-		0x25, 0xc8, 0xd7, 0x45, 0xa3, 0xb6, 0x90, 0x69, 0xc4, 0x80, 0x78, 0x6a, 0xc7, 0x46, 0xdb, 0x30,
-		0x56, 0x27, 0xe0, 0x35, 0x9f, 0x08, 0x2d, 0xe5, 0x37, 0x56, 0x32, 0xc5, 0xde, 0xaf, 0xf9, 0xc4,
-		0x18, 0x3d, 0x6b, 0x1a, 0xa1, 0x07, 0x30, 0x12, 0x79, 0xbe, 0x19, 0xb9, 0xbe, 0xf0, 0x8b, 0xfc,
-		0xc6, 0x42, 0x22, 0x8f, 0x7d, 0x1c, 0x1c, 0x3f, 0xa5, 0x01, 0x37, 0x86, 0xb9, 0xfa, 0x85, 0x36,
-		0x60, 0x80, 0xba, 0x7e, 0xc8, 0x0b, 0x03, 0x02, 0x77, 0x2d, 0x11, 0xb7, 0x8b, 0x6b, 0x8e, 0x87,
-		0x6d, 0x43, 0x92, 0x22, 0x0c, 0xcb, 0x75, 0x55, 0x9b, 0x22, 0x74, 0x4c, 0xee, 0x99, 0x96, 0xe3,
-		0x05, 0xc4, 0x8c, 0xb2, 0x81, 0x17, 0xf2, 0xc2, 0xa0, 0x60, 0x77, 0xb5, 0x24, 0xb3, 0x45, 0x29,
-		0xce, 0x16, 0xa5, 0x47, 0x2a, 0x9b, 0x18, 0xd7, 0xea, 0x2c, 0x84, 0x76, 0xf7, 0xbd, 0x72, 0x84,
-		0xdf, 0x97, 0x70, 0xf4, 0x02, 0xe6, 0xc5, 0x91, 0x52, 0xb8, 0x0f, 0x75, 0xe2, 0x3e, 0x17, 0xa1,
-		0x93, 0x18, 0x37, 0x3b, 0xe5, 0x70, 0x5b, 0x70, 0x2d, 0x00, 0x30, 0x69, 0xd3, 0xc8, 0x5e, 0x23,
-		0x62, 0x75, 0x44, 0xcd, 0xec, 0xd8, 0xc8, 0x82, 0x42, 0x93, 0x3d, 0x4d, 0x46, 0xc2, 0x80, 0x98,
-		0xbe, 0xe7, 0x50, 0xab, 0x56, 0x80, 0x65, 0x6d, 0x75, 0x7c, 0xe3, 0x66, 0xa6, 0xe5, 0x76, 0x6c,
-		0x23, 0x82, 0xec, 0x0a, 0x84, 0x31, 0x73, 0x96, 0x34, 0x8d, 0xca, 0x30, 0xca, 0x08, 0x67, 0xb5,
-		0x98, 0x71, 0x5e, 0x9c, 0x74, 0x39, 0x91, 0xb1, 0x11, 0x11, 0x2a, 0x76, 0x79, 0xd6, 0x18, 0xa0,
-		0xeb, 0x30, 0x66, 0xb1, 0xc8, 0x36, 0xd6, 0x11, 0xb1, 0x43, 0x87, 0x14, 0x46, 0xc5, 0x59, 0x46,
-		0xa3, 0xc9, 0x3d, 0x35, 0x87, 0x6e, 0x43, 0x7f, 0x95, 0x54, 0xbd, 0xc2, 0x98, 0xd2, 0x65, 0xd2,
-		0x0e, 0xcf, 0x48, 0xd5, 0x33, 0x04, 0x19, 0x32, 0x60, 0x32, 0x20, 0x98, 0x59, 0x47, 0x26, 0xe6,
-		0x9c, 0xd1, 0x83, 0x90, 0x93, 0xa0, 0x30, 0x2e, 0xb0, 0x37, 0x12, 0xb1, 0x7b, 0x82, 0x7a, 0xab,
-		0x4e, 0x6c, 0x4c, 0x04, 0x6d, 0x33, 0x68, 0x13, 0x06, 0x8f, 0x08, 0xb6, 0x09, 0x2b, 0x5c, 0x11,
-		0x8c, 0xe6, 0x13, 0x19, 0x7d, 0x24, 0x48, 0x0c, 0x45, 0x8a, 0x1e, 0x40, 0xde, 0x26, 0x0e, 0xae,
-		0x49, 0xdf, 0x28, 0x4c, 0x74, 0x72, 0x05, 0x10, 0xd4, 0xc2, 0x17, 0xd0, 0x07, 0x30, 0xfa, 0x92,
-		0x72, 0x4e, 0x98, 0x02, 0x4f, 0x76, 0x02, 0xe7, 0x25, 0x79, 0x1d, 0x7d, 0x48, 0x59, 0xc0, 0x4d,
-		0x16, 0xba, 0x26, 0xe6, 0x05, 0x24, 0xd0, 0xc5, 0x73, 0xe8, 0xfd, 0xf8, 0x46, 0x34, 0x40, 0xd0,
-		0x1b, 0xa1, 0xbb, 0xc5, 0xd1, 0x73, 0x98, 0x12, 0x46, 0xf1, 0x4e, 0x09, 0x73, 0xb0, 0x1f, 0x1b,
-		0x78, 0x4a, 0x78, 0x4e, 0x72, 0xaa, 0x2a, 0x33, 0xcf, 0xfd, 0x89, 0x24, 0x57, 0x66, 0x9e, 0xb4,
-		0xda, 0xa7, 0xd0, 0x2b, 0x58, 0xc2, 0x16, 0xa7, 0xa7, 0xc4, 0xb4, 0x9c, 0x30, 0x10, 0x67, 0x23,
-		0x0e, 0xb1, 0x44, 0x70, 0xaa, 0x3d, 0xa6, 0x85, 0xa0, 0xeb, 0x89, 0x7b, 0x6c, 0x09, 0x6c, 0x59,
-		0x42, 0xf7, 0x62, 0xa4, 0xda, 0xee, 0x1a, 0xce, 0x58, 0xd5, 0xef, 0xc1, 0x62, 0x5a, 0x66, 0x54,
-		0x09, 0x7c, 0x06, 0x06, 0x23, 0x5d, 0x51, 0x5b, 0xa5, 0xc6, 0x01, 0x16, 0xba, 0x3b, 0xb6, 0xce,
-		0x40, 0x4f, 0x06, 0x6e, 0x05, 0x35, 0xd7, 0x8a, 0xf3, 0xea, 0x53, 0x18, 0x52, 0xc1, 0x27, 0xd0,
-		0xf9, 0x8d, 0x8d, 0x64, 0x3f, 0xcb, 0x4a, 0xce, 0x46, 0xcc, 0x42, 0xbf, 0x01, 0xd7, 0x33, 0xf7,
-		0x94, 0x12, 0xeb, 0xef, 0xc1, 0x72, 0x7a, 0x39, 0x90, 0x7d, 0xaa, 0x7f, 0xe7, 0x60, 0x71, 0x8f,
-		0x56, 0x5c, 0xec, 0x7c, 0x17, 0x2a, 0x89, 0xd6, 0x64, 0xd7, 0xdf, 0x9e, 0xec, 0x96, 0x20, 0x1f,
-		0x88, 0xb3, 0x98, 0x2e, 0xae, 0x12, 0x71, 0x3b, 0x8c, 0x18, 0x20, 0xa7, 0x3e, 0xc6, 0x55, 0x82,
-		0x3e, 0x84, 0x51, 0x45, 0x20, 0xef, 0x8f, 0xc1, 0x2e, 0xee, 0x0f, 0xc5, 0x72, 0x47, 0xdc, 0x22,
-		0x05, 0x18, 0xb2, 0x3c, 0x97, 0x33, 0xcf, 0x11, 0xe9, 0x7c, 0xd4, 0x88, 0x87, 0xfa, 0x0a, 0x2c,
-		0xa5, 0xea, 0x51, 0x99, 0xe9, 0x6b, 0x0d, 0xbe, 0xa7, 0x68, 0x28, 0x3f, 0xca, 0xbe, 0x9f, 0x5f,
-		0xc0, 0x98, 0xbc, 0x46, 0x2e, 0xef, 0x4d, 0xa3, 0x82, 0x51, 0xcc, 0xb8, 0x4d, 0x47, 0xb9, 0x8e,
-		0x3a, 0xea, 0xbb, 0x84, 0x8e, 0xfa, 0x5b, 0x75, 0xb4, 0x05, 0xab, 0x9d, 0xcf, 0x9f, 0xed, 0xaf,
-		0x9f, 0x6b, 0x70, 0xab, 0x13, 0x8f, 0x96, 0x80, 0x7c, 0xde, 0x1e, 0x90, 0x1f, 0x24, 0xab, 0xb0,
-		0x3b, 0xbb, 0x34, 0x42, 0x73, 0x0d, 0x6e, 0x77, 0x29, 0x87, 0xb2, 0xfe, 0x97, 0x39, 0x58, 0x30,
-		0x48, 0x40, 0xde, 0x98, 0x92, 0xbd, 0x51, 0x96, 0xf7, 0x35, 0x97, 0xe5, 0xe8, 0x1e, 0x14, 0x6c,
-		0x62, 0xd1, 0x20, 0xca, 0xc5, 0x87, 0xd4, 0xa5, 0xc1, 0x91, 0x49, 0x4e, 0x89, 0x5b, 0x0f, 0xb9,
-		0x3e, 0x63, 0x26, 0x5e, 0xdf, 0x16, 0xcb, 0x8f, 0xa3, 0xd5, 0x1d, 0xbb, 0x2d, 0x3a, 0x07, 0xda,
-		0xa3, 0xb3, 0x04, 0x53, 0xc1, 0x31, 0xf5, 0x4d, 0xe5, 0x5d, 0x8c, 0x60, 0xdf, 0x77, 0x6a, 0x22,
-		0x06, 0x87, 0x8d, 0xc9, 0x68, 0x49, 0x2a, 0xd4, 0x90, 0x0b, 0x51, 0xa6, 0x4e, 0xd3, 0x57, 0xb6,
-		0x8f, 0xfc, 0x25, 0x07, 0x37, 0x94, 0x4e, 0xcb, 0xd8, 0xb5, 0xc8, 0xff, 0x43, 0x6a, 0x9b, 0x86,
-		0x01, 0x0b, 0x87, 0x41, 0x9c, 0xd4, 0xe4, 0x00, 0x6d, 0xc2, 0xac, 0xbc, 0xdc, 0x1b, 0xa5, 0xad,
-		0x52, 0xc8, 0xa0, 0x20, 0x9b, 0x12, 0xab, 0x0d, 0x99, 0x84, 0x7a, 0x56, 0xe1, 0xed, 0x4e, 0xda,
-		0x51, 0x2e, 0xfb, 0x8f, 0x1c, 0xac, 0xec, 0x13, 0x56, 0xa5, 0x2e, 0xe6, 0xe4, 0x4d, 0x77, 0xdb,
-		0xbb, 0x30, 0x64, 0x13, 0x8e, 0xa9, 0x13, 0xa8, 0xe7, 0x44, 0x76, 0xca, 0x8a, 0x89, 0x5b, 0x8c,
-		0x32, 0xd0, 0x66, 0x94, 0x0b, 0xe9, 0xf7, 0x2d, 0xd0, 0xb3, 0x94, 0xa6, 0x74, 0xfb, 0xdf, 0xe8,
-		0xf1, 0x4b, 0x02, 0x8b, 0xd1, 0x83, 0x37, 0x46, 0xb5, 0x07, 0x30, 0x27, 0xda, 0x15, 0xa6, 0xe5,
-		0xb9, 0x01, 0x0d, 0x38, 0x71, 0xad, 0x9a, 0xe9, 0x90, 0x53, 0xe2, 0x08, 0x5d, 0xa7, 0xbd, 0x15,
-		0x7e, 0x1a, 0x61, 0xca, 0x0d, 0xc8, 0xd3, 0x08, 0x61, 0xcc, 0x9c, 0x24, 0x4d, 0xeb, 0x5f, 0xf7,
-		0xc1, 0x4a, 0xc6, 0xb9, 0x55, 0x64, 0x3b, 0x30, 0xd7, 0x50, 0xb9, 0xe5, 0xb9, 0x87, 0xb4, 0xa2,
-		0xaa, 0x5b, 0x95, 0xc5, 0x37, 0xbb, 0x3b, 0x65, 0xb9, 0x19, 0x6a, 0xcc, 0x92, 0xc4, 0xf9, 0xe8,
-		0xdc, 0xe7, 0xd5, 0x69, 0x52, 0xf7, 0xd0, 0x53, 0x3a, 0xbd, 0xd9, 0xdd, 0x6e, 0x3b, 0xee, 0xa1,
-		0xd7, 0x78, 0x23, 0xb5, 0x4c, 0xa3, 0x17, 0x80, 0x7c, 0xe2, 0xda, 0xd4, 0xad, 0x98, 0xa2, 0x3e,
-		0xa5, 0x9c, 0x92, 0xa0, 0xd0, 0xb7, 0xdc, 0xb7, 0x9a, 0xdf, 0x58, 0x4d, 0xf6, 0x54, 0x49, 0xbe,
-		0x25, 0xa9, 0x6b, 0x82, 0xf9, 0xa4, 0xdf, 0x32, 0x49, 0x49, 0x80, 0x7e, 0x0e, 0x13, 0x31, 0x63,
-		0xeb, 0x88, 0x3a, 0x36, 0x23, 0x6e, 0xa1, 0x5f, 0xb0, 0x2d, 0x65, 0xb1, 0x2d, 0x47, 0xb4, 0xad,
-		0x92, 0x5f, 0xf1, 0x9b, 0x96, 0x18, 0x71, 0xd1, 0x5e, 0x83, 0x75, 0x9c, 0xf1, 0xd5, 0x93, 0x3b,
-		0x53, 0xe2, 0x47, 0x8a, 0xb6, 0x85, 0x69, 0x3c, 0xa9, 0x7f, 0xd6, 0x07, 0xd3, 0xc2, 0x63, 0x62,
-		0xf5, 0xbd, 0x26, 0x67, 0xbf, 0x0f, 0x03, 0xc2, 0x43, 0x55, 0x81, 0xa3, 0x67, 0x72, 0x12, 0x02,
-		0x1b, 0x12, 0x80, 0x4c, 0x98, 0x95, 0x61, 0xc2, 0xc8, 0x4b, 0x62, 0xf1, 0xc8, 0x3f, 0x6d, 0x2a,
-		0x84, 0xea, 0x17, 0x51, 0xf2, 0xfd, 0xf4, 0x28, 0x31, 0x04, 0xa2, 0x1c, 0x03, 0x8c, 0xe9, 0x93,
-		0x84, 0xd9, 0xac, 0x38, 0x1c, 0xf8, 0xa6, 0xe2, 0xf0, 0x6f, 0x1a, 0xcc, 0xb4, 0x99, 0x41, 0xc5,
-		0xde, 0x87, 0x30, 0x1a, 0x1f, 0x2f, 0x08, 0x9d, 0xb8, 0x6c, 0xea, 0x50, 0x00, 0xaa, 0x73, 0x44,
-		0x00, 0xb4, 0x03, 0xe3, 0xcd, 0xfa, 0x21, 0xb6, 0x32, 0x96, 0xde, 0x49, 0x2f, 0xc4, 0x36, 0xc6,
-		0x4e, 0x9a, 0x87, 0xfa, 0x57, 0x1a, 0xcc, 0xc5, 0xd9, 0xa2, 0xde, 0x08, 0xea, 0xe0, 0x2f, 0x2d,
-		0x9d, 0xa5, 0x5c, 0x6f, 0x9d, 0xa5, 0x27, 0x30, 0x5e, 0xc7, 0x36, 0xda, 0x5b, 0xe3, 0x29, 0xed,
-		0xad, 0x98, 0x81, 0x6c, 0x6f, 0xf1, 0xa6, 0x51, 0x54, 0x44, 0x51, 0xd7, 0x72, 0x42, 0x9b, 0x98,
-		0x0d, 0x86, 0x01, 0xc7, 0x3c, 0x94, 0xd7, 0xd3, 0xb0, 0x31, 0xa3, 0xd6, 0x63, 0x26, 0x7b, 0x62,
-		0x51, 0xff, 0x67, 0x0e, 0x0a, 0xe7, 0x4f, 0xac, 0x4c, 0xf3, 0x1e, 0x0c, 0xf9, 0x9e, 0xe3, 0x10,
-		0x16, 0x14, 0x34, 0x11, 0xe2, 0x4b, 0xc9, 0x56, 0x11, 0x34, 0x22, 0xfc, 0x62, 0x7a, 0xf4, 0x0c,
-		0x26, 0xce, 0x09, 0x22, 0x95, 0x73, 0x3d, 0xf3, 0x6c, 0x52, 0x2c, 0x63, 0x9c, 0xb7, 0x8c, 0xd1,
-		0x0b, 0x98, 0xf0, 0x31, 0xe3, 0xb4, 0x29, 0x41, 0xab, 0x40, 0xba, 0x95, 0xc9, 0x6e, 0x37, 0x06,
-		0xc9, 0x0c, 0x6c, 0x5c, 0xf1, 0x5b, 0x27, 0x2e, 0xd3, 0x17, 0xd4, 0xef, 0xc0, 0xfc, 0x13, 0xc2,
-		0xe3, 0x85, 0xe0, 0x61, 0xed, 0x91, 0xf0, 0x88, 0x0e, 0x0e, 0xa3, 0xff, 0xa1, 0x1f, 0xae, 0x25,
-		0xe3, 0x94, 0xda, 0x7f, 0x09, 0xb3, 0xf5, 0x8a, 0xb8, 0xa1, 0xc4, 0x2a, 0xf6, 0x95, 0x15, 0x7e,
-		0x9c, 0x28, 0x60, 0x16, 0xcb, 0x52, 0x9c, 0x0e, 0x63, 0x8a, 0x67, 0xd8, 0x7f, 0xec, 0x72, 0x56,
-		0x33, 0xa6, 0xec, 0xf3, 0x2b, 0x91, 0x00, 0xea, 0xd2, 0xa8, 0xb5, 0x09, 0x90, 0xbb, 0xa8, 0x00,
-		0xf1, 0xb5, 0x72, 0x5e, 0x00, 0x7c, 0x7e, 0xa5, 0x18, 0x46, 0x4e, 0x99, 0x2c, 0x31, 0x9a, 0x80,
-		0xbe, 0x63, 0x52, 0x53, 0x3a, 0x8d, 0x7e, 0xa2, 0x32, 0x0c, 0x9c, 0x62, 0x27, 0x24, 0xca, 0xc1,
-		0x6e, 0x27, 0x4a, 0x97, 0xe6, 0xe4, 0x86, 0xc4, 0x3e, 0xc8, 0xdd, 0xd7, 0xa2, 0x6d, 0xd3, 0xe4,
-		0xfc, 0x16, 0xb7, 0xd5, 0x03, 0x58, 0x10, 0x81, 0xdc, 0xee, 0xb3, 0xc1, 0xb7, 0x98, 0x7a, 0xf4,
-		0xcf, 0x73, 0xb0, 0x98, 0xb6, 0xab, 0xf2, 0xc3, 0x13, 0x58, 0x48, 0x70, 0x83, 0x7a, 0x04, 0xc5,
-		0x49, 0xa1, 0xd4, 0x5d, 0x04, 0x3e, 0x23, 0x1c, 0xdb, 0x98, 0x63, 0xa3, 0xd8, 0x6e, 0xf1, 0xc6,
-		0xd6, 0xd1, 0x96, 0x09, 0xae, 0xdf, 0xb4, 0x65, 0xee, 0x62, 0x5b, 0xb6, 0x7b, 0x79, 0x63, 0x4b,
-		0x7d, 0x0e, 0x66, 0x9e, 0x10, 0xae, 0xda, 0x77, 0x22, 0x89, 0xa9, 0x27, 0xf7, 0xaf, 0x35, 0x98,
-		0x6d, 0x5f, 0x51, 0x9a, 0x39, 0x82, 0xab, 0x41, 0xe8, 0xfb, 0x1e, 0xe3, 0xc4, 0x36, 0x2d, 0x87,
-		0x46, 0xcf, 0xd5, 0x53, 0xc2, 0x02, 0xa5, 0x95, 0xf4, 0xbc, 0xb4, 0x17, 0xa3, 0xca, 0x02, 0xf4,
-		0x5c, 0x61, 0x8c, 0xb9, 0x20, 0x79, 0x41, 0xff, 0xaa, 0x0f, 0xf4, 0x27, 0x09, 0x8f, 0xd2, 0x8f,
-		0xe4, 0x5f, 0x81, 0xaf, 0xa9, 0x98, 0x99, 0x87, 0x11, 0x1f, 0x57, 0x88, 0x19, 0xd0, 0x4f, 0xe4,
-		0x95, 0x35, 0x60, 0x0c, 0x47, 0x13, 0x7b, 0xf4, 0x13, 0x82, 0xde, 0x86, 0x2b, 0x2e, 0x79, 0x15,
-		0x59, 0xad, 0x42, 0x4c, 0xee, 0x1d, 0x13, 0x57, 0x35, 0x66, 0xc6, 0xa2, 0xe9, 0x5d, 0x5c, 0x21,
-		0xfb, 0xd1, 0x24, 0x7a, 0x07, 0xd0, 0x19, 0xa6, 0xdc, 0x3c, 0xf4, 0x98, 0xe9, 0x92, 0x33, 0xf9,
-		0xea, 0x17, 0x15, 0xc7, 0xb0, 0x71, 0x25, 0x5a, 0xd9, 0xf6, 0xd8, 0xc7, 0xe4, 0x4c, 0x3c, 0xf7,
-		0x91, 0x09, 0x57, 0xd5, 0xbf, 0x9f, 0xaa, 0x3b, 0x70, 0x48, 0x1d, 0x4e, 0x98, 0xbc, 0x34, 0x07,
-		0xc5, 0xa5, 0xf9, 0x56, 0xe2, 0x79, 0x04, 0x7c, 0x5b, 0x10, 0x8b, 0x7b, 0x73, 0x56, 0xb1, 0x69,
-		0x9b, 0x47, 0xd7, 0x61, 0x4c, 0xb4, 0x0b, 0x30, 0xb3, 0x8e, 0xe8, 0x29, 0x96, 0x0d, 0xb7, 0x61,
-		0x63, 0x34, 0x9a, 0xdc, 0x52, 0x73, 0x59, 0x95, 0xd2, 0xf0, 0x37, 0x55, 0x29, 0xfd, 0x47, 0x83,
-		0xeb, 0x99, 0x16, 0x57, 0x3e, 0x78, 0x17, 0x86, 0xd4, 0x51, 0x32, 0x4b, 0xa6, 0x18, 0x16, 0x13,
-		0xa3, 0x1f, 0x41, 0x9e, 0xe1, 0x33, 0x33, 0xc6, 0xca, 0x80, 0x4a, 0x4e, 0x1b, 0x8f, 0x30, 0xc7,
-		0x0f, 0x1d, 0xef, 0xc0, 0x00, 0x86, 0xcf, 0x14, 0xa3, 0x24, 0xf3, 0xf6, 0x25, 0x99, 0xb7, 0x08,
-		0xc3, 0x52, 0x97, 0xc4, 0x56, 0x25, 0x48, 0x7d, 0xac, 0xd7, 0x60, 0x74, 0x9b, 0x60, 0x1e, 0x32,
-		0xb2, 0xed, 0xe0, 0x4a, 0x80, 0x28, 0x6c, 0x24, 0xbc, 0x88, 0xb0, 0xc3, 0x08, 0xb6, 0x23, 0x65,
-		0x57, 0x7d, 0x87, 0x44, 0xa1, 0x46, 0x18, 0xf3, 0x98, 0x49, 0x5c, 0x7c, 0xe0, 0x10, 0xd9, 0x85,
-		0x19, 0x36, 0x6e, 0x9f, 0x73, 0xcf, 0x2d, 0x89, 0x2b, 0xc7, 0xb0, 0xc7, 0x11, 0xea, 0xb1, 0x04,
-		0xe9, 0xbf, 0xd3, 0x60, 0xde, 0x20, 0x87, 0x8c, 0x04, 0x47, 0xf5, 0xbf, 0x0b, 0x71, 0x70, 0x1c,
-		0xbc, 0x9e, 0x48, 0xd2, 0x17, 0xe1, 0x5a, 0xb2, 0x34, 0xd2, 0xca, 0x1b, 0x5f, 0x4c, 0x41, 0x3e,
-		0x5e, 0xd9, 0xda, 0xdd, 0x41, 0xbf, 0xd1, 0xa0, 0x90, 0xd6, 0x7c, 0x47, 0xef, 0xa6, 0xfc, 0x05,
-		0x96, 0xf9, 0xd7, 0x7d, 0xf1, 0x4e, 0x8f, 0x28, 0xe5, 0x7f, 0xbf, 0xd2, 0x60, 0x36, 0xb9, 0x11,
-		0x89, 0x2e, 0xd0, 0x36, 0x2e, 0x6e, 0xf6, 0x84, 0x51, 0x32, 0xfc, 0x51, 0x83, 0xf9, 0x8c, 0x66,
-		0x28, 0xba, 0xd7, 0x03, 0xd3, 0xe6, 0x36, 0x6e, 0xf1, 0x7e, 0xef, 0x40, 0x25, 0xd2, 0x67, 0x1a,
-		0xcc, 0xa5, 0x74, 0xe6, 0xd1, 0x66, 0x56, 0x2f, 0x38, 0x4d, 0x31, 0xef, 0xf6, 0x06, 0x52, 0x62,
-		0xfc, 0x55, 0x83, 0xe5, 0x4e, 0x0d, 0x63, 0x74, 0xa9, 0xde, 0x74, 0xf1, 0x87, 0x17, 0x44, 0x2b,
-		0x09, 0xbf, 0xd4, 0xe0, 0x46, 0x57, 0x2d, 0x6d, 0xb4, 0x75, 0xa1, 0x8d, 0x5a, 0xec, 0xf9, 0xf0,
-		0x32, 0x2c, 0x9a, 0x1c, 0x3e, 0xb9, 0x43, 0x9c, 0xe2, 0xf0, 0x99, 0xed, 0xf7, 0x14, 0x87, 0xef,
-		0xd0, 0x82, 0xfe, 0xb3, 0x06, 0x8b, 0xd9, 0xdd, 0x54, 0xf4, 0x20, 0x85, 0x6f, 0x17, 0x0d, 0xea,
-		0xe2, 0xfb, 0x17, 0xc2, 0x2a, 0xd9, 0x7e, 0xaf, 0x41, 0x31, 0xbd, 0x13, 0x89, 0xee, 0x26, 0xd7,
-		0x6c, 0x9d, 0xfa, 0xbd, 0xc5, 0x7b, 0x3d, 0xe3, 0x94, 0x3c, 0xbf, 0xd5, 0xe0, 0x6a, 0x6a, 0xeb,
-		0x0f, 0xdd, 0xc9, 0x2c, 0xd7, 0x53, 0xa5, 0xb9, 0xdb, 0x2b, 0x4c, 0x09, 0x73, 0x08, 0x63, 0x2d,
-		0xed, 0x0f, 0x94, 0xd1, 0xb5, 0x69, 0xeb, 0x54, 0x15, 0x6f, 0x76, 0x43, 0xaa, 0xf6, 0xf1, 0x60,
-		0xa2, 0xfd, 0xc9, 0x81, 0x6e, 0x75, 0xf9, 0x32, 0x91, 0xbb, 0xf5, 0xf6, 0x8e, 0x41, 0x9f, 0xc2,
-		0x74, 0xd2, 0xc3, 0x0f, 0xfd, 0xa0, 0x87, 0x37, 0xa2, 0xdc, 0x78, 0xbd, 0xe7, 0x57, 0xa5, 0x08,
-		0xc9, 0xe4, 0x47, 0x4c, 0x4a, 0x48, 0x66, 0xbe, 0xb3, 0x52, 0x42, 0xb2, 0xc3, 0x2b, 0x89, 0xc2,
-		0x78, 0xeb, 0x2b, 0x01, 0xdd, 0x4c, 0x3b, 0xc8, 0xf9, 0x47, 0x46, 0xf1, 0x9d, 0xae, 0x68, 0x9b,
-		0xae, 0xbb, 0x8c, 0xd2, 0x30, 0xe5, 0xba, 0xeb, 0xfc, 0x7c, 0x48, 0xb9, 0xee, 0xba, 0xa9, 0x42,
-		0x3f, 0x85, 0xe9, 0xa4, 0xfa, 0x25, 0xc5, 0xfc, 0x19, 0x85, 0x57, 0x8a, 0xf9, 0xb3, 0x8a, 0x23,
-		0x19, 0xe1, 0x69, 0x5f, 0xc8, 0xa5, 0x45, 0x78, 0x87, 0x2f, 0x00, 0xd3, 0x22, 0xbc, 0xd3, 0x87,
-		0x78, 0x0f, 0x6d, 0x98, 0xb3, 0xbc, 0x6a, 0x12, 0xf8, 0xe1, 0x74, 0x8c, 0xda, 0x93, 0x1f, 0x99,
-		0xee, 0x32, 0x8f, 0x7b, 0xbb, 0xda, 0x2f, 0xd6, 0x2b, 0x94, 0x1f, 0x85, 0x07, 0x25, 0xcb, 0xab,
-		0xae, 0x35, 0x7f, 0x87, 0x79, 0x9b, 0xda, 0xce, 0x5a, 0xc5, 0x93, 0xdf, 0x8f, 0xaa, 0x8f, 0x32,
-		0xdf, 0xc7, 0x3e, 0x3d, 0x5d, 0x3f, 0x18, 0x14, 0x73, 0x9b, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-		0x6d, 0x4a, 0x18, 0x5e, 0xc4, 0x2a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x4b, 0x6f, 0xdc, 0xc6,
+		0x19, 0x5c, 0xbd, 0xbf, 0x5d, 0xc9, 0xf6, 0x58, 0x8f, 0xf5, 0xda, 0x7a, 0xd1, 0x71, 0xe2, 0x38,
+		0xb1, 0x54, 0xcb, 0xb1, 0x9d, 0x38, 0xaf, 0xca, 0xf2, 0x23, 0x2a, 0xe2, 0x54, 0xa1, 0x9c, 0x18,
+		0xed, 0x85, 0x18, 0x91, 0x23, 0x89, 0x11, 0x97, 0xa4, 0xc9, 0xa1, 0x14, 0x25, 0x87, 0xa2, 0x69,
+		0x90, 0x02, 0x45, 0x5b, 0xf4, 0xd8, 0x02, 0x05, 0x7a, 0xe8, 0xa1, 0xa7, 0xe6, 0x92, 0x53, 0x0f,
+		0xbd, 0x14, 0x45, 0x2f, 0x3d, 0xf7, 0x07, 0x14, 0x45, 0x6f, 0x2d, 0x72, 0xea, 0xb5, 0x28, 0xe6,
+		0xc1, 0xdd, 0x25, 0x35, 0xc3, 0x5d, 0xc9, 0x4a, 0xe2, 0x04, 0xbd, 0x2d, 0x67, 0xbe, 0xef, 0x9b,
+		0xef, 0x3d, 0x33, 0xdf, 0x7c, 0x0b, 0x97, 0xd2, 0x0d, 0x12, 0x2f, 0x3a, 0xd8, 0x25, 0x81, 0x43,
+		0x16, 0x71, 0xe4, 0x2d, 0xee, 0x5e, 0x59, 0x4c, 0x48, 0xbc, 0xeb, 0x39, 0xc4, 0xde, 0x0b, 0xe3,
+		0x9d, 0x4d, 0x3f, 0xdc, 0x5b, 0x88, 0xe2, 0x90, 0x86, 0xe8, 0x34, 0x83, 0x5d, 0x90, 0xb0, 0x0b,
+		0x38, 0xf2, 0x16, 0x76, 0xaf, 0x34, 0x66, 0xb6, 0xc2, 0x70, 0xcb, 0x27, 0x8b, 0x1c, 0x64, 0x23,
+		0xdd, 0x5c, 0x74, 0xd3, 0x18, 0x53, 0x2f, 0x0c, 0x04, 0x52, 0x63, 0xb6, 0x38, 0x4f, 0xbd, 0x26,
+		0x49, 0x28, 0x6e, 0x46, 0x12, 0x60, 0x4e, 0xc5, 0x81, 0x13, 0x36, 0x9b, 0x2d, 0x12, 0xf3, 0x2a,
+		0x88, 0x6d, 0x2f, 0xa1, 0x61, 0xbc, 0x9f, 0xad, 0xa2, 0x02, 0x79, 0x94, 0x92, 0x16, 0x80, 0xa9,
+		0x94, 0xd3, 0xd9, 0x26, 0x6e, 0xea, 0x93, 0x32, 0x18, 0x8a, 0x93, 0x1d, 0xdf, 0x4b, 0x68, 0x19,
+		0x4c, 0x5e, 0x4f, 0xe6, 0x9f, 0x0d, 0x98, 0xb5, 0x98, 0x8c, 0x31, 0x7d, 0x28, 0x67, 0xee, 0xbc,
+		0x4f, 0x9c, 0x94, 0x69, 0xc5, 0x22, 0x8f, 0x52, 0x92, 0x50, 0x34, 0x09, 0x83, 0x6e, 0xd8, 0xc4,
+		0x5e, 0x50, 0x37, 0xe6, 0x8c, 0x8b, 0x23, 0x96, 0xfc, 0x42, 0xef, 0x00, 0xca, 0xa8, 0xd9, 0x24,
+		0x43, 0xaa, 0x57, 0xe6, 0x8c, 0x8b, 0xd5, 0xa5, 0xa7, 0x17, 0x14, 0x06, 0x58, 0x38, 0xb8, 0xc4,
+		0xa9, 0xbd, 0xe2, 0x10, 0x6a, 0xc0, 0xb0, 0xe7, 0x92, 0x80, 0x7a, 0x74, 0xbf, 0xde, 0xc7, 0x17,
+		0x6c, 0x7d, 0x33, 0x56, 0x62, 0x82, 0x93, 0x30, 0xa8, 0xf7, 0x0b, 0x56, 0xc4, 0x97, 0xf9, 0x7b,
+		0x03, 0xe6, 0x6e, 0x7b, 0x78, 0x2b, 0x08, 0x13, 0xf2, 0x35, 0x90, 0xc3, 0xfc, 0x9d, 0x01, 0xf3,
+		0x25, 0xfc, 0x26, 0x51, 0x18, 0x24, 0x44, 0xcb, 0xf0, 0x7b, 0x30, 0xed, 0x0a, 0x64, 0xea, 0x39,
+		0xf6, 0x63, 0xf3, 0x7e, 0xb6, 0x4d, 0xec, 0xc0, 0xa4, 0xf9, 0x4b, 0x80, 0xe9, 0xf5, 0x23, 0xb9,
+		0xc7, 0x2c, 0x54, 0x5b, 0xac, 0x79, 0x2e, 0xe7, 0x69, 0xc4, 0x82, 0x6c, 0x68, 0xd5, 0x45, 0x77,
+		0x61, 0xb4, 0x05, 0x40, 0xf7, 0x23, 0xc2, 0xb5, 0x54, 0x5d, 0x9a, 0x2f, 0x65, 0xfb, 0xc1, 0x7e,
+		0x44, 0xac, 0xda, 0x5e, 0xc7, 0x17, 0xba, 0x09, 0x23, 0xcc, 0xf3, 0x6d, 0xe6, 0xfa, 0xdc, 0x2f,
+		0xaa, 0x4b, 0xd3, 0x4a, 0x1a, 0x0f, 0x70, 0xb2, 0xf3, 0xa6, 0x97, 0x50, 0x6b, 0x98, 0xca, 0x5f,
+		0x68, 0x09, 0x06, 0xbc, 0x20, 0x4a, 0x69, 0x7d, 0x80, 0xe3, 0x9d, 0x53, 0xe2, 0xad, 0xe1, 0x7d,
+		0x3f, 0xc4, 0xae, 0x25, 0x40, 0x11, 0x86, 0xb9, 0x96, 0xaa, 0x6d, 0x1e, 0x3a, 0x36, 0x0d, 0x6d,
+		0xc7, 0x0f, 0x13, 0x62, 0xb3, 0x8c, 0x11, 0xa6, 0xb4, 0x3e, 0xc8, 0xc9, 0x9d, 0x59, 0x10, 0x19,
+		0x65, 0x21, 0xcb, 0x28, 0x0b, 0xb7, 0x65, 0xc6, 0xb1, 0xce, 0xb5, 0x48, 0x70, 0xed, 0x3e, 0x08,
+		0x57, 0x18, 0xfe, 0x03, 0x81, 0x8e, 0x1e, 0xc2, 0x59, 0x2e, 0x92, 0x86, 0xfa, 0x50, 0x37, 0xea,
+		0x53, 0x0c, 0x5b, 0x45, 0xb8, 0xd3, 0x29, 0x87, 0x0b, 0xc1, 0x35, 0x0d, 0x10, 0x0b, 0x9b, 0x32,
+		0x7b, 0x8d, 0xf0, 0xd9, 0x11, 0x39, 0xb2, 0xea, 0x22, 0x07, 0xea, 0x1d, 0xf6, 0xb4, 0x63, 0x92,
+		0x26, 0xc4, 0x8e, 0x42, 0xdf, 0x73, 0xf6, 0xeb, 0x30, 0x67, 0x5c, 0x1c, 0x5b, 0xba, 0x54, 0x6a,
+		0xb9, 0x55, 0xd7, 0x62, 0x28, 0x6b, 0x1c, 0xc3, 0x9a, 0xd8, 0x53, 0x0d, 0xa3, 0x15, 0xa8, 0xc5,
+		0x84, 0xc6, 0xfb, 0x19, 0xe1, 0x2a, 0x97, 0x74, 0x4e, 0x49, 0xd8, 0x62, 0x80, 0x92, 0x5c, 0x35,
+		0x6e, 0x7f, 0xa0, 0xf3, 0x30, 0xea, 0xc4, 0xcc, 0x36, 0x32, 0x67, 0xd6, 0x6b, 0x5c, 0x96, 0x1a,
+		0x1b, 0x5c, 0x97, 0x63, 0xe8, 0x32, 0xf4, 0x37, 0x49, 0x33, 0xac, 0x8f, 0x4a, 0x5d, 0xaa, 0x56,
+		0xb8, 0x4f, 0x9a, 0xa1, 0xc5, 0xc1, 0x90, 0x05, 0xa7, 0x12, 0x82, 0x63, 0x67, 0xdb, 0xc6, 0x94,
+		0xc6, 0xde, 0x46, 0x4a, 0x49, 0x52, 0x1f, 0xe3, 0xb8, 0x17, 0x94, 0xb8, 0xeb, 0x1c, 0x7a, 0xb9,
+		0x05, 0x6c, 0x9d, 0x4c, 0x0a, 0x23, 0xe8, 0x2a, 0x0c, 0x6e, 0x13, 0xec, 0x92, 0xb8, 0x7e, 0x82,
+		0x13, 0x3a, 0xab, 0x24, 0xf4, 0x06, 0x07, 0xb1, 0x24, 0x28, 0xba, 0x09, 0x55, 0x97, 0xf8, 0x78,
+		0x5f, 0xf8, 0x46, 0xfd, 0x64, 0x37, 0x57, 0x00, 0x0e, 0xcd, 0x7d, 0x01, 0xbd, 0x02, 0xb5, 0xf7,
+		0x3c, 0x4a, 0x49, 0x2c, 0x91, 0x4f, 0x75, 0x43, 0xae, 0x0a, 0xf0, 0x16, 0xf6, 0xa6, 0x17, 0x27,
+		0xd4, 0x8e, 0xd3, 0xc0, 0xc6, 0xb4, 0x8e, 0x38, 0x76, 0xe3, 0x00, 0xf6, 0x83, 0x6c, 0xd7, 0xb4,
+		0x80, 0xc3, 0x5b, 0x69, 0xb0, 0x4c, 0xd1, 0xbb, 0x70, 0x9a, 0x1b, 0x25, 0xdc, 0x25, 0xb1, 0x8f,
+		0xa3, 0xcc, 0xc0, 0xa7, 0xb9, 0xe7, 0xa8, 0x53, 0xd5, 0x4a, 0x1c, 0x06, 0xdf, 0x15, 0xe0, 0xd2,
+		0xcc, 0xa7, 0x9c, 0xe2, 0x10, 0x7a, 0x1f, 0x66, 0xb1, 0x43, 0xbd, 0x5d, 0x62, 0x3b, 0x7e, 0x9a,
+		0x70, 0xd9, 0x88, 0x4f, 0x1c, 0x1e, 0x9c, 0x72, 0x8d, 0x71, 0xce, 0xe8, 0x15, 0xe5, 0x1a, 0xcb,
+		0x1c, 0x77, 0x45, 0xa0, 0xae, 0x67, 0x98, 0x72, 0xb9, 0x73, 0xb8, 0x64, 0xd6, 0xbc, 0x01, 0x33,
+		0xba, 0xcc, 0x28, 0x13, 0xf8, 0x04, 0x0c, 0x32, 0x5d, 0x79, 0xae, 0x4c, 0x8d, 0x03, 0x71, 0x1a,
+		0xac, 0xba, 0x66, 0x0c, 0xa6, 0x1a, 0x71, 0x39, 0xd9, 0x0f, 0x9c, 0x2c, 0xaf, 0xbe, 0x09, 0x43,
+		0x32, 0xf8, 0x38, 0x76, 0x75, 0x69, 0x49, 0xed, 0x67, 0x65, 0xc9, 0xd9, 0xca, 0x48, 0x98, 0x17,
+		0xe0, 0x7c, 0xe9, 0x9a, 0x82, 0x63, 0xf3, 0x25, 0x98, 0xd3, 0x1f, 0x07, 0xca, 0xa5, 0xfa, 0x4b,
+		0x05, 0x66, 0xd6, 0xbd, 0xad, 0x00, 0xfb, 0x5f, 0x87, 0x93, 0x44, 0x3e, 0xd9, 0xf5, 0x17, 0x93,
+		0xdd, 0x2c, 0x54, 0x13, 0x2e, 0x8b, 0x1d, 0xe0, 0x26, 0xe1, 0xbb, 0xc3, 0x88, 0x05, 0x62, 0xe8,
+		0x2d, 0xdc, 0x24, 0xe8, 0x75, 0xa8, 0x49, 0x00, 0xb1, 0x7f, 0x0c, 0xf6, 0xb0, 0x7f, 0x48, 0x92,
+		0xab, 0x7c, 0x17, 0xa9, 0xc3, 0x90, 0x13, 0x06, 0x34, 0x0e, 0x7d, 0x9e, 0xce, 0x6b, 0x56, 0xf6,
+		0x69, 0xce, 0xc3, 0xac, 0x56, 0x8f, 0xd2, 0x4c, 0xff, 0xae, 0xc0, 0xcc, 0x3b, 0x91, 0x8b, 0xe9,
+		0x13, 0x73, 0xda, 0x39, 0x0b, 0x23, 0x29, 0x67, 0x88, 0xa9, 0x53, 0x2a, 0x5b, 0x0c, 0x08, 0x6d,
+		0xca, 0x49, 0xae, 0x4d, 0xa1, 0x6d, 0x10, 0x43, 0x5c, 0x9b, 0x47, 0xd9, 0x86, 0x3b, 0xad, 0x3b,
+		0x58, 0xb0, 0xee, 0x5b, 0x30, 0xb6, 0x87, 0x3d, 0x6a, 0x6f, 0x86, 0x3c, 0xd5, 0x6d, 0x11, 0xae,
+		0xe3, 0xb1, 0xa5, 0x8b, 0xa5, 0x02, 0x0a, 0x8d, 0xae, 0x33, 0x78, 0xab, 0xc6, 0xf0, 0xef, 0x86,
+		0x31, 0xff, 0x32, 0xff, 0x65, 0xc0, 0xac, 0x56, 0xdf, 0x32, 0x2c, 0x72, 0x1a, 0x30, 0x0a, 0x1a,
+		0x78, 0x0d, 0x06, 0x04, 0x1f, 0x95, 0x43, 0xf2, 0x21, 0xd0, 0xd0, 0x0b, 0xec, 0xe0, 0x9b, 0xa4,
+		0x3e, 0x95, 0x87, 0xa4, 0x72, 0x0d, 0x49, 0x58, 0x74, 0x1d, 0x86, 0x36, 0xb1, 0xe7, 0xa7, 0x31,
+		0x91, 0xe7, 0x22, 0x35, 0xda, 0x5d, 0x01, 0x63, 0x65, 0xc0, 0xe6, 0x7f, 0x0d, 0x78, 0x46, 0xba,
+		0xa0, 0x47, 0xb7, 0xcb, 0x8f, 0x7f, 0x0f, 0x61, 0x54, 0x9c, 0x52, 0x1e, 0x3f, 0x59, 0xd5, 0x38,
+		0xa1, 0x8c, 0x70, 0x21, 0x04, 0x2b, 0x5d, 0x43, 0xb0, 0xef, 0x31, 0x42, 0xb0, 0x3f, 0x1f, 0x82,
+		0xcb, 0x70, 0xb1, 0xbb, 0xfc, 0xe5, 0xe9, 0xf0, 0x13, 0x03, 0x9e, 0xef, 0x46, 0x23, 0x97, 0xef,
+		0xdf, 0x2d, 0xe6, 0xfb, 0x57, 0xd4, 0x2a, 0xec, 0xcd, 0x2e, 0xed, 0xcc, 0xbf, 0x08, 0x97, 0x7b,
+		0xe4, 0x43, 0x26, 0x97, 0x4f, 0x2b, 0x30, 0x6d, 0x91, 0x84, 0x3c, 0x31, 0x37, 0xc2, 0xf6, 0xad,
+		0xaf, 0xaf, 0xf3, 0xd6, 0x87, 0x6e, 0x40, 0xdd, 0x25, 0x8e, 0x97, 0xb0, 0xad, 0x7e, 0xd3, 0x0b,
+		0xbc, 0x64, 0xdb, 0x26, 0xbb, 0x24, 0x68, 0x65, 0xf4, 0x3e, 0x6b, 0x22, 0x9b, 0xbf, 0xcb, 0xa7,
+		0xef, 0xb0, 0xd9, 0x55, 0xb7, 0x90, 0xfc, 0x07, 0x8a, 0xc9, 0x7f, 0x01, 0x4e, 0x27, 0x3b, 0x5e,
+		0x64, 0x4b, 0xef, 0x8a, 0x09, 0x8e, 0x22, 0x5f, 0x24, 0x99, 0x61, 0xeb, 0x14, 0x9b, 0x12, 0x0a,
+		0xb5, 0xc4, 0x04, 0x3b, 0x08, 0xe8, 0xf4, 0x55, 0xee, 0x23, 0xbf, 0xae, 0xc0, 0x05, 0xa9, 0xd3,
+		0x15, 0x1c, 0x38, 0xe4, 0x9b, 0xb0, 0x73, 0x8e, 0xc3, 0x80, 0x83, 0xd3, 0x24, 0xdb, 0x33, 0xc5,
+		0x07, 0xba, 0x0a, 0x93, 0xe2, 0xec, 0xd8, 0xbe, 0x39, 0x49, 0x85, 0x88, 0xd4, 0x7d, 0x9a, 0xcf,
+		0xb6, 0x79, 0xe2, 0xea, 0xb9, 0x08, 0x4f, 0x77, 0xd3, 0x8e, 0x74, 0xd9, 0xcf, 0x2a, 0x30, 0xff,
+		0x80, 0xc4, 0x4d, 0x2f, 0x78, 0x82, 0xb6, 0x44, 0x9d, 0xdb, 0x5e, 0x87, 0x21, 0x97, 0x50, 0xec,
+		0xf9, 0x49, 0x69, 0x56, 0xce, 0x52, 0x56, 0x06, 0x9c, 0x33, 0xca, 0x40, 0xc1, 0x28, 0x47, 0xd2,
+		0xef, 0x53, 0x60, 0x96, 0x29, 0x4d, 0xea, 0xf6, 0x1f, 0x06, 0x4c, 0xaf, 0x31, 0x23, 0x3e, 0x29,
+		0x7a, 0x9d, 0x85, 0x2a, 0x3f, 0x97, 0x7b, 0x74, 0xbf, 0x7d, 0xd8, 0x80, 0x6c, 0x68, 0xd5, 0xd5,
+		0x55, 0x89, 0xca, 0x14, 0x68, 0xce, 0xc1, 0x8c, 0x4e, 0x48, 0xa9, 0x87, 0x7f, 0xb2, 0x33, 0x40,
+		0x10, 0x7d, 0xe3, 0x35, 0x61, 0xc2, 0x9c, 0x5e, 0x4c, 0xa9, 0x8b, 0x3f, 0x19, 0x30, 0xce, 0x53,
+		0xde, 0xb2, 0x5c, 0xeb, 0x49, 0x55, 0x40, 0xa7, 0xa0, 0xfd, 0x05, 0x41, 0xa7, 0x60, 0xa2, 0x20,
+		0x83, 0x94, 0xee, 0x3f, 0x06, 0xcc, 0xdd, 0x26, 0x89, 0x13, 0x7b, 0x1b, 0x4f, 0x8c, 0xa9, 0x37,
+		0x60, 0x8a, 0xd7, 0x88, 0x6d, 0x27, 0x0c, 0x12, 0x2f, 0xa1, 0x24, 0x70, 0xf6, 0x6d, 0x9f, 0xec,
+		0x12, 0x9f, 0x4b, 0xad, 0x2b, 0xbe, 0xbc, 0xcd, 0x70, 0x56, 0xda, 0x28, 0x6f, 0x32, 0x0c, 0x6b,
+		0xe2, 0x91, 0x6a, 0xd8, 0xfc, 0x63, 0x3f, 0xcc, 0x97, 0xc8, 0x2d, 0xf7, 0x32, 0x1f, 0xa6, 0xda,
+		0x49, 0xc6, 0x09, 0x83, 0x4d, 0x6f, 0x4b, 0x96, 0x0b, 0xe4, 0xb9, 0xe5, 0x6a, 0x6f, 0x52, 0xae,
+		0x74, 0xa2, 0x5a, 0x93, 0x44, 0x39, 0xce, 0xe4, 0x3e, 0xa8, 0x4e, 0xdb, 0x0b, 0x36, 0x43, 0xa9,
+		0xd3, 0x4b, 0xbd, 0xad, 0xb6, 0x1a, 0x6c, 0x86, 0xed, 0xa2, 0x53, 0x6e, 0x18, 0x3d, 0x04, 0x14,
+		0x91, 0xc0, 0xf5, 0x82, 0x2d, 0x5b, 0xba, 0x8e, 0x47, 0x92, 0x7a, 0xdf, 0x5c, 0xdf, 0xc5, 0xaa,
+		0xe6, 0xa4, 0xbe, 0x26, 0xc0, 0x33, 0xcf, 0xe1, 0xc4, 0x4f, 0x45, 0xb9, 0x41, 0x8f, 0x24, 0xe8,
+		0x7b, 0x70, 0x32, 0x23, 0xec, 0x6c, 0x7b, 0xbe, 0x1b, 0x13, 0x16, 0x88, 0x8c, 0xec, 0x42, 0x19,
+		0xd9, 0x15, 0x06, 0x9b, 0xe7, 0xfc, 0x44, 0xd4, 0x31, 0x15, 0x93, 0x00, 0xad, 0xb7, 0x49, 0x67,
+		0x67, 0x1c, 0x79, 0x79, 0x2a, 0xe5, 0xf8, 0xb6, 0x84, 0xcd, 0x11, 0xcd, 0x06, 0xd1, 0xab, 0x00,
+		0x3c, 0xf0, 0x85, 0x7e, 0xc5, 0x95, 0x76, 0x46, 0xb3, 0x39, 0xa5, 0x09, 0xe1, 0x44, 0x46, 0xa2,
+		0xec, 0xa7, 0xf9, 0x71, 0x1f, 0x8c, 0x73, 0x87, 0xcb, 0xb4, 0xff, 0x15, 0xc5, 0xca, 0x8b, 0x30,
+		0xc0, 0x1d, 0x5c, 0xde, 0x08, 0xcc, 0x52, 0x4a, 0x9c, 0x61, 0x4b, 0x20, 0x20, 0x1b, 0x26, 0x45,
+		0x94, 0xc5, 0xe4, 0x3d, 0xe2, 0x50, 0xe6, 0xde, 0xae, 0xc7, 0x99, 0xea, 0xe7, 0x41, 0xf6, 0xac,
+		0x3e, 0xc8, 0x2c, 0x8e, 0xb1, 0x92, 0x21, 0x58, 0xe3, 0x8f, 0x14, 0xa3, 0x65, 0x61, 0x3c, 0x70,
+		0x5c, 0x61, 0xfc, 0x5b, 0x03, 0x26, 0x0a, 0x66, 0x90, 0xa1, 0xfb, 0x3a, 0xd4, 0x32, 0xf1, 0xf8,
+		0x5d, 0xd2, 0xe8, 0xe5, 0xc6, 0x24, 0xe5, 0xe0, 0x17, 0xca, 0x55, 0x18, 0xeb, 0xd4, 0x0f, 0x71,
+		0xa5, 0xb1, 0xcc, 0x6e, 0x7a, 0x21, 0xae, 0x35, 0xfa, 0xa8, 0xf3, 0xd3, 0xfc, 0xdc, 0x80, 0xa9,
+		0x2c, 0xd9, 0xb4, 0x0a, 0xf3, 0x5d, 0xfc, 0x25, 0x57, 0xe9, 0xaf, 0x1c, 0xae, 0xd2, 0x7f, 0x0f,
+		0xc6, 0x5a, 0xb8, 0xed, 0xe7, 0x86, 0x31, 0xcd, 0x73, 0x43, 0x46, 0x40, 0x3c, 0x37, 0xd0, 0x8e,
+		0x2f, 0x76, 0xeb, 0xf0, 0x02, 0xc7, 0x4f, 0x5d, 0x62, 0xb7, 0x09, 0x26, 0x14, 0xd3, 0x54, 0x9c,
+		0xe7, 0x86, 0xad, 0x09, 0x39, 0x9f, 0x11, 0x59, 0xe7, 0x93, 0xe6, 0x1f, 0x2a, 0x50, 0x3f, 0x28,
+		0xb1, 0x34, 0xcd, 0x4b, 0x30, 0x14, 0x85, 0xbe, 0x4f, 0xe2, 0xa4, 0x6e, 0xf0, 0x0c, 0x31, 0xab,
+		0xb6, 0x0a, 0x87, 0xe1, 0x81, 0x97, 0xc1, 0xa3, 0xfb, 0x70, 0xf2, 0x00, 0x23, 0x42, 0x39, 0xe7,
+		0x4b, 0x65, 0x13, 0x6c, 0x59, 0x63, 0x34, 0xf7, 0x8d, 0x1e, 0xc2, 0xc9, 0x08, 0xc7, 0xd4, 0xeb,
+		0xc8, 0xef, 0x32, 0x90, 0x9e, 0x2f, 0x25, 0xb7, 0x96, 0x21, 0x89, 0x04, 0x6e, 0x9d, 0x88, 0xf2,
+		0x03, 0x8f, 0xf3, 0x4e, 0x63, 0x5e, 0x83, 0xb3, 0xf7, 0x08, 0xcd, 0x26, 0x92, 0x5b, 0xfb, 0xb7,
+		0xb9, 0x47, 0x74, 0x71, 0x18, 0xf3, 0xe7, 0xfd, 0x70, 0x4e, 0x8d, 0x27, 0xd5, 0xfe, 0x03, 0x98,
+		0x6c, 0x5d, 0x21, 0xdb, 0x4a, 0x6c, 0xe2, 0x48, 0x5a, 0xe1, 0x3b, 0x4a, 0x06, 0xcb, 0x48, 0x2e,
+		0x64, 0xd9, 0x34, 0x83, 0xb8, 0x8f, 0xa3, 0x3b, 0x01, 0x8d, 0xf7, 0xad, 0xd3, 0xee, 0xc1, 0x19,
+		0xc6, 0x40, 0xeb, 0x04, 0x93, 0x67, 0xa0, 0x72, 0x54, 0x06, 0xb2, 0x5d, 0xe9, 0x20, 0x03, 0xf8,
+		0xe0, 0x4c, 0x23, 0x65, 0x4e, 0xa9, 0xe6, 0x18, 0x9d, 0x84, 0xbe, 0x1d, 0xb2, 0x2f, 0x75, 0xca,
+		0x7e, 0xa2, 0x15, 0x18, 0xd8, 0xc5, 0x7e, 0x4a, 0xa4, 0x83, 0x5d, 0x56, 0x72, 0xa7, 0x73, 0x72,
+		0x4b, 0xe0, 0xde, 0xac, 0xbc, 0x68, 0xb0, 0x65, 0x75, 0x7c, 0x7e, 0x81, 0xcb, 0x9a, 0x09, 0x4c,
+		0xf3, 0x40, 0x2e, 0xfa, 0x6c, 0xf2, 0x05, 0xa6, 0x1e, 0xf3, 0x93, 0x0a, 0xcc, 0xe8, 0x56, 0x95,
+		0x7e, 0xf8, 0x08, 0xa6, 0x15, 0x6e, 0xd0, 0x8a, 0xa0, 0x2c, 0x29, 0x2c, 0xf4, 0x16, 0x81, 0xf7,
+		0x09, 0xc5, 0x2e, 0xa6, 0xd8, 0x6a, 0x14, 0x2d, 0xde, 0x5e, 0x9a, 0x2d, 0xa9, 0x70, 0xfd, 0x8e,
+		0x25, 0x2b, 0x47, 0x5b, 0xb2, 0xe8, 0xe5, 0xed, 0x25, 0xd9, 0x89, 0xfb, 0x1e, 0xa1, 0xf2, 0x39,
+		0x85, 0x27, 0x31, 0x59, 0xa3, 0xfa, 0xc8, 0x80, 0xc9, 0xe2, 0x8c, 0xd4, 0xcc, 0x36, 0x9c, 0x49,
+		0xd2, 0x28, 0x0a, 0x63, 0x4a, 0x5c, 0xdb, 0xf1, 0x3d, 0x12, 0x50, 0x7b, 0x97, 0xc4, 0x89, 0xd4,
+		0x8a, 0x3e, 0x2f, 0xad, 0x67, 0x58, 0x2b, 0x1c, 0xe9, 0x5d, 0x89, 0x63, 0x4d, 0x25, 0xea, 0x09,
+		0xf3, 0xf3, 0x3e, 0x30, 0xef, 0x29, 0xaa, 0x38, 0x6f, 0x88, 0xf6, 0x8d, 0xaf, 0xae, 0xb0, 0x1e,
+		0xe1, 0x2d, 0x62, 0x27, 0xde, 0x07, 0x62, 0xcb, 0x1a, 0xb0, 0x86, 0xd9, 0xc0, 0xba, 0xf7, 0x01,
+		0x41, 0x4f, 0xc3, 0x89, 0x80, 0xbc, 0xcf, 0xac, 0xb6, 0x45, 0x6c, 0x1a, 0xee, 0x90, 0x40, 0x56,
+		0x32, 0x47, 0xd9, 0xf0, 0x1a, 0xde, 0x22, 0x0f, 0xd8, 0x20, 0x7a, 0x0e, 0x50, 0xab, 0x1e, 0x1e,
+		0x90, 0x3d, 0x51, 0x26, 0xe3, 0x27, 0x8e, 0x61, 0xeb, 0x84, 0xac, 0x74, 0xbf, 0x45, 0xf6, 0x78,
+		0x7d, 0x0c, 0xd9, 0x70, 0x46, 0x76, 0xac, 0xc8, 0x72, 0xda, 0xa6, 0xe7, 0x53, 0x12, 0x8b, 0x4d,
+		0x73, 0x90, 0x6f, 0x9a, 0x4f, 0x29, 0xe5, 0xe1, 0xe8, 0x77, 0x39, 0x30, 0xdf, 0x37, 0x27, 0x25,
+		0x99, 0xc2, 0x38, 0x3a, 0x0f, 0xa3, 0xbc, 0xbe, 0x86, 0x63, 0x67, 0xdb, 0xdb, 0xc5, 0xe2, 0x01,
+		0x64, 0xd8, 0xaa, 0xb1, 0xc1, 0x65, 0x39, 0x56, 0x76, 0x52, 0x1a, 0x3e, 0xae, 0x93, 0xd2, 0xdf,
+		0x0d, 0x38, 0x5f, 0x6a, 0x71, 0xe9, 0x83, 0xd7, 0x61, 0x48, 0x8a, 0x52, 0x7a, 0x64, 0xca, 0xd0,
+		0x32, 0x60, 0xf4, 0x1a, 0x54, 0x63, 0xbc, 0x67, 0x67, 0xb8, 0x22, 0xa0, 0xd4, 0x69, 0xe3, 0x36,
+		0xa6, 0xf8, 0x96, 0x1f, 0x6e, 0x58, 0x10, 0xe3, 0x3d, 0x49, 0x48, 0x65, 0xde, 0x3e, 0x95, 0x79,
+		0x1b, 0x30, 0x2c, 0x74, 0x49, 0x5c, 0x79, 0x04, 0x69, 0x7d, 0x9b, 0xfb, 0x50, 0xbb, 0x4b, 0x30,
+		0x4d, 0x63, 0x72, 0xd7, 0xc7, 0x5b, 0x09, 0xf2, 0x60, 0x49, 0x71, 0xa1, 0xc2, 0x7e, 0x4c, 0xb0,
+		0xcb, 0x94, 0xdd, 0x8c, 0x7c, 0xc2, 0x42, 0x8d, 0xc4, 0x71, 0x18, 0xdb, 0x24, 0xc0, 0x1b, 0x3e,
+		0x11, 0x65, 0xcb, 0x61, 0xeb, 0xf2, 0x01, 0xf7, 0x5c, 0x16, 0x78, 0x2b, 0x19, 0xda, 0x1d, 0x86,
+		0x75, 0x47, 0x20, 0x99, 0x3f, 0x35, 0xe0, 0xac, 0x45, 0x36, 0x63, 0x92, 0x6c, 0xb7, 0xda, 0x37,
+		0x70, 0xb2, 0x93, 0x7c, 0x35, 0x91, 0x64, 0xce, 0xc0, 0x39, 0x35, 0x37, 0xf2, 0xda, 0xff, 0xd7,
+		0x0a, 0x4c, 0xac, 0xc4, 0x04, 0x53, 0x92, 0x35, 0x09, 0xf4, 0xd0, 0xe2, 0x92, 0xf5, 0x18, 0x74,
+		0xb4, 0xb8, 0x64, 0x43, 0xab, 0x2e, 0xba, 0x06, 0xfd, 0x49, 0x44, 0x9c, 0xd2, 0xce, 0x96, 0x6c,
+		0xb1, 0xf5, 0x88, 0x38, 0x16, 0x07, 0x47, 0x2f, 0xc3, 0x20, 0x76, 0x5a, 0xd7, 0x0e, 0xdd, 0x39,
+		0x2e, 0x43, 0x5c, 0xe6, 0xa0, 0x96, 0x44, 0x41, 0x6f, 0xc3, 0x58, 0xe1, 0x8d, 0xbd, 0xec, 0x66,
+		0x91, 0x11, 0xc9, 0xbf, 0xb3, 0x8f, 0x86, 0xb9, 0x37, 0xf6, 0x49, 0x18, 0xe4, 0xb7, 0x3c, 0x57,
+		0xd6, 0xc0, 0xe5, 0x57, 0xae, 0xba, 0x32, 0x54, 0xa8, 0xae, 0xd4, 0x61, 0xb2, 0xa8, 0x4c, 0xa9,
+		0x67, 0xab, 0x7d, 0xf0, 0x3f, 0x2e, 0x45, 0x9b, 0x7f, 0xeb, 0x6f, 0x9f, 0xad, 0x8b, 0x0b, 0xb6,
+		0xac, 0x60, 0x1c, 0xd5, 0x0a, 0x95, 0xe3, 0xb0, 0x42, 0xdf, 0xf1, 0x59, 0xa1, 0x3f, 0x67, 0x85,
+		0x79, 0xa8, 0x89, 0x5b, 0xbb, 0x2c, 0xf5, 0x89, 0x82, 0x5e, 0x95, 0x8f, 0x59, 0xa2, 0xde, 0xf7,
+		0x0e, 0xa0, 0x38, 0x0d, 0x02, 0x2f, 0xd8, 0x6a, 0x07, 0x54, 0x52, 0x1f, 0xe4, 0xf9, 0xa8, 0xe7,
+		0x88, 0x92, 0x14, 0x5a, 0x23, 0x09, 0x5a, 0x83, 0xb1, 0x98, 0x38, 0x6c, 0x8b, 0x10, 0x52, 0x27,
+		0xf5, 0x21, 0x4e, 0xf2, 0xd9, 0x5e, 0x34, 0xc5, 0x6f, 0x94, 0xd6, 0xa8, 0x20, 0x20, 0xc6, 0x12,
+		0xf4, 0x6d, 0x18, 0xe3, 0x19, 0x2f, 0x4e, 0x03, 0xde, 0xed, 0x94, 0xd4, 0x87, 0x39, 0xc5, 0xb2,
+		0x2e, 0x93, 0x1a, 0xc3, 0xb0, 0xd2, 0x80, 0x8f, 0xb0, 0xcd, 0x85, 0x86, 0x14, 0xfb, 0x2d, 0x96,
+		0x46, 0xf8, 0x4b, 0x50, 0x8d, 0x0f, 0x66, 0xcb, 0x3c, 0x03, 0x27, 0xd8, 0x66, 0x13, 0x11, 0xb7,
+		0x05, 0x06, 0x1c, 0x6c, 0x4c, 0x0e, 0x4b, 0x40, 0xf3, 0xb3, 0x0a, 0x4c, 0xc8, 0xe7, 0xd8, 0xff,
+		0xe7, 0x84, 0x9c, 0x37, 0x96, 0x3c, 0xbf, 0xb3, 0xd8, 0x2f, 0x2a, 0x4d, 0xc6, 0xfe, 0x8f, 0x0c,
+		0x18, 0xe7, 0xb5, 0xa3, 0x63, 0x53, 0xa7, 0xee, 0x95, 0xa5, 0x4b, 0xe5, 0xb7, 0xc0, 0x84, 0x64,
+		0xcf, 0xe7, 0x25, 0xe1, 0xb4, 0x79, 0x7c, 0xec, 0x95, 0x75, 0x81, 0xd6, 0x61, 0xb2, 0xb8, 0x5a,
+		0xfb, 0x3d, 0x6b, 0xea, 0x16, 0x76, 0x76, 0x36, 0x3d, 0xdf, 0x3f, 0x36, 0x56, 0x5e, 0x02, 0x90,
+		0xfd, 0x84, 0x5e, 0x33, 0x6b, 0xb6, 0x2c, 0x8b, 0xab, 0x11, 0x0e, 0xcd, 0xbe, 0xd1, 0x35, 0x18,
+		0x26, 0x81, 0x2b, 0x10, 0xfb, 0xbb, 0x22, 0x0e, 0x91, 0xc0, 0xe5, 0x68, 0x5f, 0xb2, 0xdb, 0x35,
+		0xa0, 0x7e, 0x50, 0x69, 0x6d, 0xcb, 0xde, 0x26, 0xec, 0x88, 0xf2, 0x65, 0x59, 0xb6, 0xb8, 0x9a,
+		0xe4, 0x23, 0x81, 0x71, 0x5e, 0x6b, 0x91, 0xe3, 0x5d, 0xcf, 0x42, 0xb9, 0xe3, 0x7f, 0xa5, 0xfb,
+		0xf1, 0x5f, 0x75, 0x3e, 0x34, 0x37, 0x60, 0xa2, 0xb0, 0xa8, 0xdc, 0x19, 0xe7, 0xa1, 0xd6, 0x21,
+		0xa4, 0xb8, 0x65, 0x8e, 0x58, 0xd5, 0xb6, 0x94, 0x89, 0x6a, 0x8d, 0x8a, 0x62, 0x8d, 0xa5, 0x8f,
+		0x66, 0xa0, 0x9a, 0x6d, 0x1a, 0xcb, 0x6b, 0xab, 0xe8, 0xc7, 0x06, 0xd4, 0x75, 0xad, 0x64, 0xe8,
+		0x05, 0x4d, 0x43, 0x67, 0x69, 0x23, 0x7a, 0xe3, 0xda, 0x21, 0xb1, 0xa4, 0x90, 0x3f, 0x34, 0x60,
+		0x52, 0xdd, 0xf7, 0x80, 0x8e, 0xd0, 0xa5, 0xd2, 0xb8, 0x7a, 0x28, 0x1c, 0xc9, 0xc3, 0x2f, 0x0c,
+		0x38, 0x5b, 0xd2, 0x7b, 0x81, 0x6e, 0x1c, 0x82, 0x68, 0x67, 0xd7, 0x48, 0xe3, 0xc5, 0xc3, 0x23,
+		0x4a, 0x96, 0x3e, 0x36, 0x60, 0x4a, 0xd3, 0x67, 0x86, 0xae, 0x96, 0xb5, 0x9e, 0xe8, 0x14, 0xf3,
+		0xc2, 0xe1, 0x90, 0x3a, 0xd8, 0xd0, 0xb4, 0x56, 0x69, 0xd8, 0x28, 0x6f, 0x7c, 0xd3, 0xb0, 0xd1,
+		0xad, 0x7b, 0xeb, 0x37, 0x06, 0xcc, 0x75, 0x6b, 0x93, 0x41, 0x8f, 0xd5, 0x91, 0xd3, 0x78, 0xf5,
+		0x88, 0xd8, 0x92, 0xc3, 0x4f, 0x0d, 0xb8, 0xd0, 0x53, 0x23, 0x0f, 0x5a, 0x3e, 0xd2, 0x42, 0x39,
+		0xb7, 0xba, 0xf5, 0x38, 0x24, 0x3a, 0xe2, 0x4e, 0xdd, 0x17, 0xa3, 0x89, 0xbb, 0xd2, 0xa6, 0x23,
+		0x4d, 0xdc, 0x75, 0x69, 0xbc, 0xf9, 0x95, 0x01, 0x33, 0xe5, 0x3d, 0x24, 0xe8, 0xa6, 0x86, 0x6e,
+		0x0f, 0x6d, 0x39, 0x8d, 0x97, 0x8f, 0x84, 0x2b, 0x79, 0xfb, 0x99, 0x01, 0x0d, 0x7d, 0xff, 0x05,
+		0xba, 0xae, 0x2e, 0xbc, 0x75, 0xeb, 0x72, 0x69, 0xdc, 0x38, 0x34, 0x5e, 0x87, 0xbd, 0xd4, 0x3d,
+		0x10, 0x1a, 0x7b, 0x95, 0x76, 0x85, 0x68, 0xec, 0x55, 0xde, 0x64, 0xc1, 0x77, 0x0d, 0x5d, 0xf7,
+		0x81, 0x66, 0xd7, 0xe8, 0xd2, 0x93, 0xa1, 0xd9, 0x35, 0xba, 0xb5, 0x38, 0xa0, 0x4d, 0x18, 0xcd,
+		0x75, 0x07, 0xa0, 0x67, 0xf5, 0xfe, 0x57, 0xe8, 0x82, 0x68, 0x5c, 0xea, 0x05, 0x54, 0xae, 0xf3,
+		0x13, 0x03, 0xce, 0x68, 0x1f, 0xdd, 0xd1, 0xb5, 0xd2, 0x4a, 0xb7, 0x56, 0xe6, 0xeb, 0x87, 0x45,
+		0x6b, 0x0b, 0x9d, 0x7b, 0x39, 0x44, 0x25, 0x0f, 0x9e, 0x85, 0x47, 0xde, 0xc6, 0xa5, 0x5e, 0x40,
+		0xe5, 0x3a, 0x21, 0x9c, 0x2c, 0x56, 0xeb, 0xd1, 0xf3, 0x3d, 0x16, 0xf5, 0xc5, 0x6a, 0x87, 0x7b,
+		0x02, 0x40, 0x1f, 0xc2, 0xb8, 0xea, 0xcd, 0x04, 0x7d, 0xeb, 0x10, 0xcf, 0x2b, 0x62, 0xe1, 0x2b,
+		0x87, 0x7e, 0x90, 0xe1, 0x81, 0xa5, 0xae, 0xff, 0x6b, 0x02, 0xab, 0xf4, 0x89, 0x42, 0x13, 0x58,
+		0x5d, 0x1e, 0x18, 0x3c, 0x18, 0xcb, 0x17, 0xd8, 0xd1, 0x25, 0x9d, 0x20, 0x07, 0xeb, 0xf3, 0x8d,
+		0xe7, 0x7a, 0x82, 0xed, 0x38, 0xeb, 0x94, 0x54, 0x55, 0x35, 0x67, 0x9d, 0xee, 0x95, 0x77, 0xcd,
+		0x59, 0xa7, 0x97, 0x02, 0xee, 0x87, 0x30, 0xae, 0x2a, 0xfd, 0x69, 0xcc, 0x5f, 0x52, 0xb3, 0xd4,
+		0x98, 0xbf, 0xac, 0xae, 0x28, 0x22, 0x5c, 0xf7, 0x67, 0x3f, 0x5d, 0x84, 0x77, 0xf9, 0x33, 0xa3,
+		0x2e, 0xc2, 0xbb, 0xfe, 0xa7, 0xd0, 0x83, 0xb1, 0x7c, 0x59, 0x4e, 0xe3, 0x07, 0xca, 0x42, 0xa8,
+		0xc6, 0x0f, 0xd4, 0x75, 0xbe, 0xce, 0x20, 0x6f, 0x2d, 0x56, 0x1e, 0xe4, 0xc5, 0xe5, 0x2e, 0xf7,
+		0x08, 0xdd, 0x96, 0x2d, 0x5f, 0x76, 0xd0, 0xc8, 0xa6, 0x2c, 0xe8, 0x68, 0x64, 0x53, 0xd7, 0x31,
+		0x58, 0xa2, 0xcc, 0x55, 0x10, 0x34, 0x89, 0x52, 0x55, 0xea, 0xd0, 0x24, 0x4a, 0x65, 0x41, 0x82,
+		0x89, 0x94, 0x2f, 0x11, 0x20, 0xed, 0xde, 0x72, 0xb0, 0x6a, 0xa1, 0x11, 0x49, 0x5d, 0x73, 0x60,
+		0xe6, 0x2a, 0xde, 0x9e, 0x35, 0xe6, 0xd2, 0x54, 0x26, 0x34, 0xe6, 0xd2, 0x5d, 0xc9, 0x99, 0x6c,
+		0xf9, 0x4b, 0xb2, 0x46, 0x36, 0xe5, 0xbd, 0x5d, 0x23, 0x9b, 0xfa, 0xd6, 0xcd, 0xcc, 0x95, 0xbb,
+		0x00, 0x6b, 0xcc, 0xa5, 0xba, 0x99, 0x6b, 0xcc, 0xa5, 0xbc, 0x4f, 0xdf, 0x72, 0x61, 0xca, 0x09,
+		0x9b, 0x2a, 0x84, 0x5b, 0xe3, 0x59, 0x4c, 0xae, 0x8b, 0x7f, 0xac, 0xaf, 0xc5, 0x21, 0x0d, 0xd7,
+		0x8c, 0xef, 0x5f, 0xd9, 0xf2, 0xe8, 0x76, 0xba, 0xb1, 0xe0, 0x84, 0xcd, 0xc5, 0xce, 0x3f, 0x6c,
+		0x5f, 0xf6, 0x5c, 0x7f, 0x71, 0x2b, 0x14, 0x7f, 0x46, 0x97, 0xff, 0xde, 0x7e, 0x19, 0x47, 0xde,
+		0xee, 0x95, 0x8d, 0x41, 0x3e, 0x76, 0xf5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0x9d, 0x9d,
+		0x0d, 0x11, 0x3f, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/schedule.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xef, 0x6f, 0xd2, 0x40,
+		0x18, 0xc7, 0x2d, 0xfb, 0xc9, 0xc3, 0x36, 0xf1, 0xd4, 0x0c, 0xe7, 0xdc, 0x18, 0x66, 0x6e, 0xd1,
+		0xd8, 0x86, 0x19, 0x5f, 0xe8, 0xe2, 0x0b, 0xc6, 0xba, 0x48, 0x6c, 0x46, 0x73, 0xc0, 0x16, 0x4d,
+		0x4c, 0x73, 0xb4, 0x37, 0x76, 0x52, 0x7a, 0x4d, 0x7b, 0x85, 0xf1, 0xde, 0xbf, 0xc6, 0x3f, 0xc8,
+		0x57, 0xfe, 0x31, 0xa6, 0xed, 0x95, 0xcc, 0x09, 0xe3, 0x0d, 0xe1, 0x1e, 0x3e, 0xdf, 0x87, 0xe7,
+		0xf9, 0x7e, 0x2f, 0x07, 0x95, 0xa8, 0x4b, 0x03, 0xcd, 0x26, 0x0e, 0xf5, 0x6c, 0xaa, 0x11, 0x9f,
+		0x69, 0xc3, 0xaa, 0x16, 0xda, 0xd7, 0xd4, 0x89, 0x5c, 0xaa, 0xfa, 0x01, 0x17, 0x1c, 0x3d, 0x8e,
+		0x19, 0x55, 0x32, 0x2a, 0xf1, 0x99, 0x3a, 0xac, 0x6e, 0xed, 0xf4, 0x38, 0xef, 0xb9, 0x54, 0x4b,
+		0x90, 0x6e, 0x74, 0xa5, 0x39, 0x51, 0x40, 0x04, 0xe3, 0x5e, 0x2a, 0xda, 0xda, 0xbd, 0xfb, 0xbb,
+		0x60, 0x03, 0x1a, 0x0a, 0x32, 0xf0, 0x25, 0x50, 0x9e, 0xf6, 0xcf, 0x36, 0x1f, 0x0c, 0x26, 0x2d,
+		0xa6, 0xce, 0x26, 0x48, 0xd8, 0x77, 0x59, 0x28, 0x52, 0xa6, 0xf2, 0x47, 0x81, 0xb5, 0x96, 0x1c,
+		0xb7, 0xe5, 0x53, 0x1b, 0x1d, 0xc0, 0x43, 0x3b, 0xe0, 0x9e, 0x45, 0x6f, 0xfc, 0x80, 0x86, 0x21,
+		0xe3, 0x5e, 0x49, 0x29, 0x2b, 0x87, 0x79, 0xbc, 0x11, 0x97, 0xf5, 0x49, 0x15, 0x55, 0x61, 0xf9,
+		0x07, 0x13, 0x82, 0x06, 0xa5, 0x5c, 0x59, 0x39, 0x2c, 0x1c, 0x3d, 0x53, 0xd3, 0x89, 0xd5, 0x6c,
+		0x62, 0xf5, 0x54, 0x6e, 0x84, 0x25, 0x88, 0x3e, 0x00, 0x84, 0x82, 0x04, 0xc2, 0x8a, 0x77, 0x29,
+		0x2d, 0x24, 0xb2, 0xad, 0xff, 0x64, 0xed, 0x6c, 0x51, 0x9c, 0x4f, 0xe8, 0xf8, 0x8c, 0xde, 0xc3,
+		0x2a, 0xf5, 0x9c, 0x54, 0xb8, 0x38, 0x57, 0xb8, 0x42, 0x3d, 0x27, 0x3e, 0x55, 0x7e, 0x2e, 0xc0,
+		0x46, 0xb6, 0x5e, 0xcd, 0x8e, 0x87, 0x41, 0x67, 0xb0, 0x3e, 0xe2, 0x41, 0xff, 0xca, 0xe5, 0x23,
+		0x4b, 0x8c, 0x7d, 0x9a, 0xac, 0x57, 0x38, 0xda, 0x53, 0xa7, 0xa4, 0xa4, 0x5e, 0x4a, 0xb2, 0x3d,
+		0xf6, 0x29, 0x5e, 0x1b, 0xdd, 0x3a, 0xa1, 0x8f, 0x90, 0x8f, 0xbd, 0xb4, 0x62, 0x33, 0xa5, 0x05,
+		0x2f, 0xa6, 0xf6, 0x68, 0x93, 0xb0, 0x6f, 0xb0, 0x50, 0xe0, 0x55, 0x21, 0xbf, 0xa1, 0x23, 0x58,
+		0x62, 0x9e, 0x1f, 0x09, 0xe9, 0xc1, 0xf6, 0x54, 0x9d, 0x49, 0xc6, 0x2e, 0x27, 0x0e, 0x4e, 0x51,
+		0x44, 0xa0, 0x4c, 0x6f, 0xa8, 0x1d, 0xc5, 0x4b, 0x58, 0xd2, 0x46, 0x6e, 0xd9, 0x2e, 0x0f, 0x69,
+		0x62, 0x0b, 0x8f, 0x84, 0x74, 0xe6, 0x9e, 0x24, 0xb6, 0x27, 0x2d, 0x5a, 0x89, 0xb5, 0xbc, 0x1e,
+		0xeb, 0xdb, 0xa9, 0x1c, 0x5d, 0xc2, 0xf3, 0x64, 0xa5, 0x19, 0xdd, 0x97, 0xe6, 0x75, 0xdf, 0x8c,
+		0xd5, 0x53, 0x1a, 0x57, 0x7e, 0xe5, 0xe0, 0xc9, 0xbf, 0x31, 0x60, 0x1a, 0x46, 0xae, 0x40, 0x9f,
+		0x60, 0xcd, 0xe3, 0x03, 0xe6, 0x11, 0x37, 0x8d, 0x56, 0x99, 0x1b, 0x6d, 0x41, 0xf2, 0xc9, 0xad,
+		0x38, 0x86, 0x02, 0xb1, 0x45, 0x94, 0xa9, 0x73, 0x73, 0xd5, 0x90, 0xe2, 0x89, 0xb8, 0x03, 0x68,
+		0x72, 0x11, 0x26, 0xb6, 0xc8, 0x44, 0x5e, 0xdd, 0x7b, 0x1b, 0xf4, 0x8c, 0xc6, 0x8f, 0x46, 0x77,
+		0x4b, 0xa8, 0x04, 0x2b, 0x61, 0x9f, 0xf9, 0x3e, 0x75, 0x92, 0x38, 0x56, 0x71, 0x76, 0x44, 0xfb,
+		0xb0, 0x71, 0x45, 0x98, 0x1b, 0x05, 0xd4, 0x0a, 0x28, 0x09, 0xb9, 0x97, 0x38, 0x9a, 0xc7, 0xeb,
+		0xb2, 0x8a, 0x93, 0xe2, 0xeb, 0xdf, 0x0a, 0x3c, 0xcd, 0xcc, 0x6a, 0x0e, 0x69, 0xe0, 0x12, 0xdf,
+		0xe4, 0x2e, 0xb3, 0xc7, 0xe8, 0x25, 0xec, 0xb6, 0xea, 0x9f, 0xf5, 0xd3, 0x8e, 0xa1, 0x5b, 0xcd,
+		0x0b, 0x1d, 0x1b, 0x35, 0xd3, 0x32, 0x9b, 0x46, 0xa3, 0xfe, 0xd5, 0x6a, 0x9c, 0x5f, 0xd4, 0x8c,
+		0xc6, 0x69, 0xf1, 0x01, 0x2a, 0xc3, 0xf6, 0x2c, 0xa8, 0xf5, 0xa5, 0x61, 0x16, 0x15, 0x54, 0x81,
+		0x9d, 0x59, 0xc4, 0x49, 0xe7, 0xec, 0x4c, 0xc7, 0xc5, 0x1c, 0x7a, 0x03, 0x07, 0xb3, 0x98, 0x7a,
+		0xed, 0xbc, 0xae, 0x1b, 0x96, 0x89, 0xf5, 0x8b, 0x46, 0xb3, 0xd3, 0x2a, 0x2e, 0xa0, 0x7d, 0xd8,
+		0x9b, 0x05, 0xd7, 0x0c, 0xa3, 0x79, 0x19, 0x7f, 0x16, 0x17, 0x4f, 0xbe, 0xc3, 0xa6, 0xcd, 0x07,
+		0xd3, 0x9c, 0x3d, 0x59, 0xcf, 0x16, 0x36, 0xe3, 0xcc, 0x4c, 0xe5, 0x5b, 0xb5, 0xc7, 0xc4, 0x75,
+		0xd4, 0x55, 0x6d, 0x3e, 0xd0, 0x6e, 0x3f, 0x63, 0x6f, 0x99, 0xe3, 0x6a, 0x3d, 0x9e, 0x3e, 0x8b,
+		0xf2, 0x4d, 0x3b, 0x26, 0x3e, 0x1b, 0x56, 0xbb, 0xcb, 0x49, 0xed, 0xdd, 0xdf, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0x99, 0x27, 0xd0, 0x60, 0x93, 0x05, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_worker.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdb, 0xc8,
-		0xf5, 0x07, 0x7d, 0xf7, 0xf1, 0x4d, 0x1e, 0xe3, 0xef, 0xbf, 0xac, 0xdc, 0x1c, 0xa5, 0x49, 0xbc,
-		0x6d, 0x2a, 0x37, 0xde, 0x6d, 0x36, 0x9b, 0x6c, 0x8a, 0xfa, 0x12, 0x23, 0x2e, 0x36, 0x5b, 0x2f,
-		0xa3, 0x4d, 0x80, 0x2d, 0x10, 0x62, 0x44, 0x8e, 0xac, 0x81, 0x29, 0x8e, 0x42, 0x0e, 0xa5, 0xe8,
-		0xa5, 0x0f, 0x7d, 0xdc, 0xf6, 0xa1, 0x40, 0xd1, 0xed, 0x4b, 0x81, 0x3c, 0xf7, 0x03, 0xf4, 0xcb,
-		0xb4, 0x4f, 0x7d, 0xee, 0x67, 0x28, 0x50, 0x70, 0x66, 0x48, 0x51, 0xd2, 0x90, 0xba, 0xb4, 0x80,
-		0x17, 0xe8, 0x9b, 0x39, 0xfc, 0x9d, 0xc3, 0x33, 0xe7, 0x77, 0xe6, 0xcc, 0x6f, 0xc6, 0x82, 0xbd,
-		0xb0, 0x46, 0xfc, 0x7d, 0x1b, 0x3b, 0xc4, 0xb3, 0xc9, 0x3e, 0x6e, 0xd1, 0xfd, 0xf6, 0xc3, 0xfd,
-		0x80, 0xf8, 0x6d, 0x6a, 0x13, 0xab, 0xc3, 0xfc, 0x4b, 0xe2, 0x57, 0x5a, 0x3e, 0xe3, 0x0c, 0x6d,
-		0x45, 0xc8, 0x8a, 0x42, 0x56, 0x70, 0x8b, 0x56, 0xda, 0x0f, 0x4b, 0x37, 0x2f, 0x18, 0xbb, 0x70,
-		0xc9, 0xbe, 0x80, 0xd4, 0xc2, 0xfa, 0xbe, 0x13, 0xfa, 0x98, 0x53, 0xe6, 0x49, 0xa3, 0xd2, 0xad,
-		0xc1, 0xf7, 0x9c, 0x36, 0x49, 0xc0, 0x71, 0xb3, 0xa5, 0x00, 0x43, 0x0e, 0x3a, 0x3e, 0x6e, 0xb5,
-		0x88, 0x1f, 0xa8, 0xf7, 0xbb, 0xba, 0xf8, 0x6c, 0xd6, 0x6c, 0x26, 0x9f, 0x28, 0xeb, 0x10, 0x0e,
-		0xb1, 0x69, 0xd0, 0x0b, 0xe3, 0xb6, 0x0e, 0xd3, 0xa0, 0x01, 0x67, 0x7e, 0x37, 0x8e, 0x54, 0x07,
-		0x79, 0x17, 0x92, 0x04, 0xa0, 0xfd, 0x0e, 0xc7, 0xc1, 0xa5, 0x4b, 0x03, 0x9e, 0x87, 0x89, 0xb2,
-		0x58, 0x77, 0x59, 0x47, 0x62, 0xca, 0x7f, 0x35, 0xa0, 0x74, 0xce, 0x5c, 0xf7, 0x94, 0xf9, 0x27,
-		0x2a, 0xca, 0x2a, 0x0e, 0x2e, 0x4d, 0xf2, 0x2e, 0x24, 0x01, 0x47, 0xdb, 0xb0, 0xe0, 0xb0, 0x26,
-		0xa6, 0x5e, 0xd1, 0xd8, 0x35, 0xf6, 0x96, 0x4d, 0xf5, 0x84, 0x9e, 0xc0, 0x72, 0xf4, 0x31, 0x2b,
-		0xfa, 0x5a, 0x71, 0x66, 0xd7, 0xd8, 0x5b, 0x39, 0xb8, 0x51, 0xd1, 0x50, 0x52, 0x89, 0x9c, 0x7d,
-		0x41, 0x03, 0x6e, 0x2e, 0x71, 0xf5, 0x17, 0x2a, 0xc1, 0x12, 0x75, 0x88, 0xc7, 0x29, 0xef, 0x16,
-		0x67, 0x85, 0xd7, 0xe4, 0x19, 0xdd, 0x87, 0x8d, 0x1a, 0xf5, 0xb0, 0xdf, 0xb5, 0xec, 0x06, 0xb1,
-		0x2f, 0x83, 0xb0, 0x59, 0x9c, 0x13, 0x90, 0x75, 0x39, 0x7c, 0xac, 0x46, 0xcb, 0xff, 0x5a, 0x82,
-		0x6b, 0xda, 0xb8, 0x83, 0x16, 0xf3, 0x02, 0x82, 0x6e, 0x00, 0x88, 0x00, 0x39, 0xbb, 0x24, 0x32,
-		0xf8, 0x55, 0x53, 0x84, 0x5c, 0x8d, 0x06, 0xd0, 0xd7, 0x80, 0xe2, 0x44, 0x58, 0xe4, 0x3d, 0xb1,
-		0xc3, 0xa8, 0x4a, 0xd4, 0x44, 0xee, 0x69, 0x27, 0xf2, 0x46, 0xc1, 0x9f, 0xc7, 0x68, 0x73, 0xb3,
-		0x33, 0x38, 0x84, 0x4e, 0x61, 0x2d, 0x71, 0xcb, 0xbb, 0x2d, 0x22, 0xe6, 0xb7, 0x72, 0x70, 0x3b,
-		0xd7, 0x63, 0xb5, 0xdb, 0x22, 0xe6, 0x6a, 0x27, 0xf5, 0x84, 0x5e, 0xc3, 0x4e, 0xcb, 0x27, 0x6d,
-		0xca, 0xc2, 0xc0, 0x0a, 0x38, 0xf6, 0x39, 0x71, 0x2c, 0xd2, 0x26, 0x1e, 0xb7, 0xa8, 0x23, 0x12,
-		0xb2, 0x72, 0x70, 0xad, 0x22, 0x6b, 0xb5, 0x12, 0xd7, 0x6a, 0xe5, 0xcc, 0xe3, 0x8f, 0x3e, 0x79,
-		0x8d, 0xdd, 0x90, 0x98, 0xdb, 0xb1, 0xf5, 0x2b, 0x69, 0xfc, 0x3c, 0xb2, 0x3d, 0x73, 0xd0, 0x1e,
-		0x14, 0x86, 0xdc, 0xcd, 0xef, 0x1a, 0x7b, 0xb3, 0xe6, 0x7a, 0xd0, 0x8f, 0x2c, 0xc2, 0x22, 0xe6,
-		0x9c, 0x34, 0x5b, 0xbc, 0xb8, 0x20, 0x00, 0xf1, 0x23, 0x7a, 0x00, 0xa8, 0x86, 0xed, 0x4b, 0x97,
-		0x5d, 0x58, 0x36, 0x0b, 0x3d, 0x6e, 0x35, 0xa8, 0xc7, 0x8b, 0x8b, 0x02, 0x54, 0x50, 0x6f, 0x8e,
-		0xa3, 0x17, 0x2f, 0xa8, 0xc7, 0xd1, 0x23, 0x58, 0x54, 0x95, 0x5d, 0x5c, 0x12, 0x71, 0x5f, 0xd7,
-		0xe6, 0xe2, 0x85, 0xc4, 0x98, 0x31, 0x18, 0xdd, 0x83, 0x0d, 0x8f, 0xbc, 0xe7, 0x56, 0x0b, 0x5f,
-		0x10, 0x45, 0xe2, 0xb2, 0x20, 0x71, 0x2d, 0x1a, 0x3e, 0xc7, 0x17, 0x44, 0x12, 0xf9, 0x18, 0xe6,
-		0xc5, 0xb2, 0x28, 0x82, 0xf0, 0x5e, 0xce, 0xcd, 0xf4, 0x57, 0x11, 0xd2, 0x94, 0x06, 0xe8, 0x2d,
-		0x5c, 0x1f, 0x2e, 0x01, 0xab, 0x57, 0xd5, 0x2b, 0xe3, 0x54, 0xf5, 0xce, 0x50, 0x0d, 0xc4, 0xaf,
-		0xd0, 0x21, 0xac, 0x07, 0x76, 0x83, 0x38, 0xa1, 0x4b, 0x1c, 0x2b, 0x6a, 0x34, 0xc5, 0x55, 0xe1,
-		0xb1, 0x34, 0x44, 0x5c, 0x35, 0xee, 0x42, 0xe6, 0x5a, 0x62, 0x11, 0x8d, 0xa1, 0x67, 0xb0, 0x1a,
-		0xd3, 0x25, 0x1c, 0xac, 0x8d, 0x74, 0xb0, 0xa2, 0xf0, 0xc2, 0xfc, 0x0d, 0x2c, 0x46, 0x53, 0xa5,
-		0x24, 0x28, 0xae, 0xef, 0xce, 0xee, 0xad, 0x1c, 0x3c, 0xd3, 0x4e, 0x26, 0x67, 0x19, 0x55, 0xbe,
-		0x92, 0xf6, 0xcf, 0x3d, 0x1e, 0x91, 0xa3, 0xbc, 0xa1, 0x32, 0x08, 0x16, 0x7a, 0x35, 0xb4, 0x21,
-		0xd8, 0x5f, 0x89, 0x06, 0xe3, 0x02, 0xaa, 0xc0, 0x16, 0x67, 0x1c, 0xbb, 0x96, 0x62, 0xd4, 0xaa,
-		0x75, 0x39, 0x09, 0x8a, 0x05, 0x81, 0xdc, 0x14, 0xaf, 0x14, 0xe9, 0x47, 0xd1, 0x0b, 0xf4, 0x12,
-		0x0a, 0x38, 0xe4, 0xcc, 0xb2, 0x99, 0x57, 0xa7, 0x17, 0xb2, 0xa8, 0x36, 0xc5, 0x7c, 0xef, 0x68,
-		0xa3, 0x3e, 0x0c, 0x39, 0x3b, 0x16, 0xd8, 0xa8, 0xce, 0xcc, 0x75, 0xdc, 0xf7, 0x5c, 0x7a, 0x0b,
-		0xab, 0xe9, 0xd8, 0x51, 0x01, 0x66, 0x2f, 0x49, 0x57, 0x75, 0xb1, 0xe8, 0xcf, 0xa8, 0x72, 0xda,
-		0xd1, 0x62, 0x51, 0xab, 0x7e, 0xac, 0xca, 0x11, 0x06, 0x4f, 0x66, 0x1e, 0x1b, 0xe5, 0xbf, 0xcc,
-		0xc3, 0x1d, 0x99, 0x25, 0x27, 0x9d, 0xb8, 0x63, 0xd6, 0x6c, 0xb9, 0x84, 0x13, 0x27, 0x6e, 0xa0,
-		0x23, 0xfa, 0xd0, 0x53, 0x58, 0x8e, 0x37, 0x87, 0xa0, 0x38, 0x23, 0x48, 0xd2, 0x57, 0x5c, 0xfc,
-		0x11, 0xb3, 0x87, 0x47, 0x3f, 0x82, 0xcd, 0x5e, 0xe1, 0xda, 0xcc, 0xe3, 0xe4, 0x3d, 0x17, 0x1d,
-		0x67, 0xd5, 0x2c, 0x24, 0x2f, 0x8e, 0xe5, 0x78, 0x5f, 0xd7, 0x9d, 0x1b, 0xe8, 0xba, 0xbf, 0x82,
-		0xcd, 0x80, 0x53, 0xfb, 0xb2, 0x6b, 0x61, 0xce, 0x7d, 0x5a, 0x0b, 0x23, 0xa6, 0xe6, 0x45, 0x5a,
-		0x2a, 0xda, 0x68, 0x5e, 0x09, 0x74, 0x52, 0xf3, 0x87, 0x89, 0x95, 0x59, 0x90, 0x8e, 0x7a, 0x23,
-		0xe8, 0x53, 0x28, 0xfa, 0x84, 0x87, 0xbe, 0x67, 0x79, 0xa4, 0x63, 0xc5, 0xd1, 0x8b, 0x85, 0x26,
-		0x5a, 0xcb, 0x92, 0xf9, 0x7f, 0xf2, 0xfd, 0x97, 0xa4, 0x93, 0x4e, 0x25, 0x3a, 0x82, 0x9b, 0x75,
-		0xe6, 0xdb, 0xc4, 0xb2, 0x7d, 0x82, 0x39, 0xd1, 0x98, 0x2f, 0x0a, 0xf3, 0x92, 0x40, 0x1d, 0x0b,
-		0xd0, 0xa0, 0x0f, 0xcd, 0x7e, 0xb2, 0xa4, 0xdb, 0x4f, 0x10, 0x83, 0x35, 0xd1, 0x16, 0x2c, 0x9f,
-		0x04, 0xa1, 0xcb, 0x83, 0xe2, 0xb2, 0x20, 0xe3, 0x17, 0xda, 0xe9, 0x8f, 0x41, 0x7c, 0x45, 0x56,
-		0x8c, 0x74, 0x26, 0x97, 0xcf, 0xea, 0xbb, 0xd4, 0x50, 0x89, 0xc2, 0xe6, 0x10, 0x44, 0x53, 0xa5,
-		0x3f, 0xeb, 0xaf, 0xd2, 0xbd, 0x31, 0xaa, 0x54, 0x38, 0x4c, 0xd7, 0xea, 0x87, 0x59, 0xf8, 0x41,
-		0x7e, 0xc8, 0x6a, 0xd3, 0xfc, 0x1a, 0xd6, 0xfa, 0x13, 0x6c, 0x88, 0x8f, 0xfe, 0x64, 0xd2, 0xb6,
-		0x61, 0xae, 0x3a, 0x69, 0x12, 0x3e, 0x18, 0x70, 0x13, 0xdb, 0x9c, 0xb6, 0x29, 0xa7, 0x24, 0xb0,
-		0x38, 0xb3, 0x1c, 0x1a, 0xb4, 0x30, 0xb7, 0x1b, 0x96, 0xcb, 0x6c, 0xec, 0xba, 0x5d, 0x55, 0xfa,
-		0xdf, 0x4c, 0x91, 0x6d, 0xd5, 0xa8, 0x0e, 0x13, 0xff, 0x55, 0x76, 0xa2, 0xbc, 0x7f, 0x21, 0x9d,
-		0xcb, 0xec, 0x5f, 0xc3, 0xd9, 0x88, 0xd2, 0xaf, 0x61, 0x77, 0x94, 0x03, 0x0d, 0x37, 0x27, 0xfd,
-		0xdc, 0xe8, 0x97, 0x8a, 0xf2, 0xdb, 0x15, 0xbe, 0x62, 0xc7, 0x67, 0x5e, 0x9d, 0xa5, 0x19, 0xfa,
-		0xcd, 0x0c, 0xec, 0x6a, 0xa6, 0x79, 0x8a, 0xa9, 0x3b, 0x76, 0x2b, 0x39, 0x82, 0x79, 0x1b, 0x87,
-		0x81, 0x8c, 0x66, 0xfd, 0xe0, 0x41, 0x6e, 0x1b, 0xe9, 0x79, 0x3f, 0x8e, 0x6c, 0x4c, 0x69, 0x1a,
-		0xed, 0xd6, 0x0e, 0xe1, 0x98, 0xba, 0x81, 0x52, 0x2e, 0xfa, 0xdd, 0xfa, 0x1c, 0x77, 0x5d, 0x86,
-		0x1d, 0x33, 0x06, 0xe7, 0x36, 0x17, 0xcd, 0x12, 0x9c, 0xd7, 0x4a, 0xba, 0x3b, 0x70, 0x3b, 0x27,
-		0x07, 0x92, 0xe7, 0xf2, 0x3f, 0x7a, 0x7a, 0x35, 0xce, 0xec, 0x55, 0xea, 0xd5, 0x57, 0x80, 0x12,
-		0xbf, 0x56, 0x93, 0x70, 0xec, 0x60, 0x8e, 0x95, 0x42, 0xbb, 0x9b, 0xfb, 0x81, 0x97, 0x0a, 0x6c,
-		0x16, 0xf8, 0xc0, 0x48, 0xf9, 0x6f, 0x3d, 0x6d, 0xdb, 0x3f, 0xc7, 0x2b, 0xd5, 0xb6, 0xb7, 0x60,
-		0x45, 0x2d, 0xa1, 0x6e, 0xb4, 0xe5, 0xcb, 0x4c, 0x40, 0x3c, 0x74, 0xe6, 0x44, 0xe2, 0x37, 0x01,
-		0x08, 0xf1, 0x3b, 0x97, 0x23, 0x7e, 0x93, 0x89, 0x09, 0xf1, 0x8b, 0x53, 0x4f, 0xe8, 0x00, 0xe6,
-		0xa9, 0xd7, 0x0a, 0xb9, 0xda, 0x81, 0xf2, 0x4b, 0x50, 0x42, 0x35, 0x62, 0x6b, 0xe1, 0x3f, 0x15,
-		0x5b, 0x8b, 0x93, 0x89, 0xad, 0x2a, 0xec, 0xc4, 0xfe, 0xa2, 0x0e, 0x67, 0xbb, 0x2c, 0x20, 0xc2,
-		0x11, 0x0b, 0xb9, 0x92, 0xbe, 0x3b, 0x43, 0xbe, 0x4e, 0xd4, 0xf9, 0xd4, 0xdc, 0x8e, 0x6d, 0xab,
-		0xec, 0x38, 0xb2, 0xac, 0x4a, 0x43, 0xf4, 0x25, 0x6c, 0x8b, 0x8f, 0x0c, 0xbb, 0x5c, 0x1e, 0xe5,
-		0x72, 0x4b, 0x18, 0x0e, 0xf8, 0x3b, 0x85, 0xcd, 0x06, 0xc1, 0x3e, 0xaf, 0x11, 0xcc, 0x13, 0x57,
-		0x30, 0xca, 0x55, 0x21, 0xb1, 0x89, 0xfd, 0xa4, 0x8e, 0x07, 0x91, 0x4e, 0x9e, 0xef, 0x1d, 0x0f,
-		0xde, 0xc2, 0xcd, 0x7e, 0x26, 0x2c, 0x56, 0xb7, 0x78, 0x83, 0x06, 0x56, 0x6c, 0x30, 0x5a, 0x06,
-		0x97, 0xfa, 0x98, 0xf9, 0x65, 0xbd, 0xda, 0xa0, 0xc1, 0xa1, 0xf2, 0x7f, 0x96, 0x9e, 0x41, 0xdc,
-		0xac, 0xd6, 0xc6, 0xa8, 0x94, 0xde, 0x24, 0x4e, 0x54, 0xd7, 0x1a, 0x3a, 0xad, 0xad, 0x4f, 0x77,
-		0x5a, 0xbb, 0x0f, 0x1b, 0x89, 0x1f, 0xd5, 0x7d, 0x36, 0x64, 0x87, 0x8b, 0x87, 0x4f, 0x64, 0x17,
-		0xfa, 0x18, 0x16, 0x1a, 0x04, 0x3b, 0xc4, 0x17, 0x32, 0x38, 0x3a, 0xc3, 0x69, 0xcf, 0x42, 0x02,
-		0x62, 0x2a, 0xe8, 0x7f, 0x59, 0x18, 0x97, 0xbf, 0x33, 0x12, 0xe1, 0x9a, 0x6e, 0x2e, 0x93, 0x0a,
-		0xd7, 0x4f, 0x60, 0x41, 0x2a, 0x25, 0xd5, 0x58, 0xf2, 0x73, 0xaf, 0xb0, 0x79, 0xad, 0xb4, 0x7c,
-		0x2f, 0x11, 0x29, 0x19, 0x71, 0xa9, 0x1d, 0xe0, 0xb7, 0x33, 0x70, 0x3f, 0x0f, 0x78, 0xd4, 0x3d,
-		0x3b, 0x19, 0xb5, 0x1d, 0x5c, 0x55, 0x8b, 0xec, 0x65, 0x6d, 0x6e, 0xca, 0xac, 0xcd, 0x0f, 0x64,
-		0xed, 0x87, 0xb0, 0x37, 0x3a, 0x19, 0x2a, 0x73, 0x7f, 0x34, 0x12, 0x95, 0x91, 0x06, 0x4f, 0xa4,
-		0x32, 0x1e, 0xc1, 0x62, 0x1d, 0x53, 0x37, 0xf4, 0x49, 0x2e, 0xf1, 0xa7, 0x12, 0x63, 0xc6, 0xe0,
-		0x5c, 0xe6, 0x7b, 0x1b, 0xbf, 0x2e, 0x2c, 0x15, 0xfc, 0xb7, 0x33, 0xda, 0xfa, 0x90, 0xa8, 0xef,
-		0x33, 0xe7, 0xa9, 0x8c, 0xcd, 0x4d, 0x9b, 0xb1, 0x41, 0xd6, 0xef, 0xc3, 0xdd, 0x11, 0xb9, 0x50,
-		0x59, 0xfb, 0x93, 0x01, 0x65, 0x5d, 0x7d, 0x60, 0xcf, 0x26, 0x13, 0x91, 0x1e, 0x77, 0xda, 0x99,
-		0x69, 0x65, 0xe1, 0x20, 0xe9, 0x77, 0xf5, 0x6d, 0x28, 0x09, 0x4c, 0x4d, 0xe0, 0x77, 0x33, 0x70,
-		0x2f, 0x07, 0xf7, 0x3d, 0x27, 0x3e, 0xce, 0xda, 0xdc, 0xb4, 0x59, 0x1b, 0x24, 0xfe, 0x23, 0x7d,
-		0xef, 0xeb, 0xcb, 0x46, 0x1f, 0xf5, 0x36, 0xf3, 0xfb, 0xa0, 0x2f, 0xe2, 0x4d, 0xf0, 0x0a, 0xa9,
-		0x3f, 0x8f, 0xa8, 0xcf, 0x09, 0x4c, 0xc9, 0xdc, 0x8f, 0xa0, 0x60, 0x8b, 0x89, 0x59, 0xbe, 0x8c,
-		0x95, 0x38, 0x22, 0xbe, 0x25, 0x73, 0x43, 0x8e, 0x9b, 0xf1, 0xb0, 0xaa, 0x92, 0x4c, 0x97, 0xff,
-		0x6b, 0x55, 0x52, 0x8d, 0xaa, 0x64, 0x44, 0x36, 0x26, 0x4f, 0xf2, 0xdf, 0x7b, 0xdb, 0x87, 0xb8,
-		0x68, 0x98, 0x46, 0x36, 0xfc, 0x7c, 0x40, 0x36, 0x8c, 0x7f, 0x9f, 0x11, 0x6f, 0x86, 0xaf, 0x61,
-		0x4b, 0xfe, 0x23, 0xc8, 0x6a, 0x13, 0x5f, 0xdc, 0x54, 0x50, 0xaf, 0xce, 0xd4, 0x71, 0x35, 0x9b,
-		0x28, 0xe2, 0xbf, 0x96, 0x70, 0x71, 0xf4, 0xde, 0xec, 0x0c, 0x0e, 0xa5, 0x36, 0x21, 0xdd, 0xe4,
-		0x62, 0xed, 0x61, 0x40, 0xc9, 0x24, 0x01, 0xe1, 0xf2, 0x02, 0x2c, 0x39, 0x2c, 0x5e, 0x49, 0x6d,
-		0x95, 0x6f, 0xc0, 0x35, 0x6d, 0x30, 0x2a, 0x58, 0x1f, 0xd6, 0xfb, 0xb5, 0x20, 0x7a, 0x00, 0x88,
-		0x78, 0xb8, 0xe6, 0x12, 0x2b, 0xa5, 0x28, 0x15, 0xdd, 0x05, 0xf9, 0xa6, 0x67, 0x81, 0x0e, 0x60,
-		0xbb, 0xc5, 0x5c, 0x97, 0xf8, 0x56, 0x07, 0x53, 0x79, 0x5a, 0xb0, 0xa8, 0x67, 0x35, 0x65, 0x27,
-		0x98, 0x35, 0x91, 0x7c, 0xfb, 0x06, 0x53, 0x71, 0x2c, 0x38, 0xf3, 0x5e, 0x06, 0x07, 0xff, 0xdc,
-		0x80, 0x65, 0x99, 0xee, 0xc3, 0xf3, 0x33, 0xf4, 0x1e, 0xb6, 0x34, 0xb7, 0x44, 0x68, 0x7f, 0xfc,
-		0xfb, 0x24, 0x91, 0xd7, 0xd2, 0xc4, 0x17, 0x50, 0xe8, 0x0f, 0x06, 0x5c, 0xcf, 0xbb, 0x37, 0x42,
-		0x8f, 0xa7, 0xbd, 0xd8, 0x2b, 0x7d, 0x36, 0xf5, 0x25, 0x15, 0xfa, 0xd6, 0x80, 0x9d, 0xcc, 0x2b,
-		0x0e, 0xf4, 0xd3, 0x71, 0x1d, 0xf7, 0x09, 0xb6, 0xd2, 0xa3, 0x49, 0xcd, 0x54, 0x30, 0x3d, 0x72,
-		0xd2, 0x5d, 0x22, 0x9f, 0x1c, 0xcd, 0x95, 0x4b, 0x3e, 0x39, 0xda, 0xfb, 0x8b, 0x14, 0x39, 0x5a,
-		0xd1, 0x9a, 0x4f, 0x4e, 0xde, 0xa9, 0x25, 0x9f, 0x9c, 0xdc, 0x73, 0x05, 0xfa, 0xa0, 0x57, 0xc7,
-		0x7d, 0x52, 0x1a, 0x7d, 0x3e, 0xb1, 0xff, 0xd4, 0xde, 0x53, 0x7a, 0x36, 0xa5, 0xf5, 0x70, 0xf9,
-		0x0c, 0xcb, 0xbe, 0xfc, 0xf2, 0xc9, 0xd4, 0xfb, 0xf9, 0xe5, 0x93, 0xad, 0xc7, 0xd1, 0x77, 0x06,
-		0xdc, 0xc8, 0xd5, 0xa0, 0xe8, 0xb3, 0xc9, 0x3c, 0xa7, 0x13, 0xf5, 0x64, 0x1a, 0x53, 0x15, 0xd8,
-		0xef, 0x0d, 0xd1, 0x16, 0xb3, 0x34, 0x12, 0xfa, 0x74, 0x6c, 0x12, 0xfa, 0x45, 0x72, 0xe9, 0xf1,
-		0xe4, 0x86, 0x2a, 0xa4, 0x3f, 0x1b, 0x70, 0x6b, 0x84, 0x6c, 0x43, 0x4f, 0x27, 0xf5, 0x9e, 0xce,
-		0xd7, 0xe7, 0xd3, 0x19, 0xf7, 0x65, 0x2c, 0x53, 0x2f, 0x64, 0x66, 0x6c, 0x94, 0xb6, 0xcc, 0xcc,
-		0xd8, 0x68, 0xed, 0x27, 0x33, 0x96, 0x2b, 0x61, 0x32, 0x33, 0x36, 0x8e, 0x0c, 0xcc, 0xcc, 0xd8,
-		0x78, 0xaa, 0x29, 0xb5, 0x12, 0x87, 0xd5, 0x42, 0xfe, 0x4a, 0xcc, 0x94, 0x4e, 0xf9, 0x2b, 0x31,
-		0x5b, 0x94, 0x44, 0x8d, 0x5c, 0x23, 0x03, 0x32, 0x1a, 0x79, 0xb6, 0x7a, 0xc9, 0x68, 0xe4, 0x39,
-		0x0a, 0xe3, 0xa8, 0x06, 0xff, 0x6f, 0xb3, 0xa6, 0xce, 0xec, 0x08, 0x49, 0x15, 0xf0, 0x4a, 0xfe,
-		0x76, 0xe7, 0xdc, 0x67, 0x9c, 0x9d, 0x1b, 0xdf, 0x3c, 0xbc, 0xa0, 0xbc, 0x11, 0xd6, 0x2a, 0x36,
-		0x6b, 0xee, 0xa7, 0x7f, 0x9c, 0xf2, 0x63, 0xea, 0xb8, 0xfb, 0x17, 0x4c, 0xfe, 0xee, 0x46, 0xfd,
-		0x52, 0xe5, 0x29, 0x6e, 0xd1, 0xf6, 0xc3, 0xda, 0x82, 0x18, 0xfb, 0xf8, 0xdf, 0x01, 0x00, 0x00,
-		0xff, 0xff, 0x83, 0x33, 0x80, 0xed, 0x1b, 0x24, 0x00, 0x00,
+		0x15, 0x06, 0x7d, 0xf7, 0xf1, 0x4d, 0x9e, 0xa0, 0xae, 0xac, 0xdc, 0x1c, 0xa5, 0x49, 0xbc, 0x6d,
+		0x2a, 0x37, 0xde, 0x6d, 0x36, 0x9b, 0x6c, 0x8a, 0xfa, 0x12, 0x23, 0x2e, 0x36, 0x5b, 0x2f, 0xa3,
+		0x24, 0xc0, 0x16, 0x08, 0x31, 0x22, 0xc7, 0xd6, 0xc0, 0x14, 0x87, 0x21, 0x87, 0x56, 0xf4, 0xd2,
+		0x87, 0x3e, 0x6e, 0xfb, 0x50, 0xa0, 0xe8, 0xf6, 0xa5, 0x40, 0x9e, 0xfb, 0x03, 0xfa, 0xd6, 0x5f,
+		0xd2, 0x3e, 0xf5, 0xb9, 0x7f, 0xa2, 0xe0, 0xcc, 0x90, 0xa2, 0xa4, 0x21, 0x75, 0x69, 0x01, 0x2f,
+		0xb0, 0x6f, 0xe6, 0xf0, 0x3b, 0x87, 0x67, 0xbe, 0x73, 0xe6, 0xcc, 0x37, 0x63, 0xc1, 0x76, 0xd4,
+		0x20, 0xc1, 0x8e, 0x8d, 0x1d, 0xe2, 0xd9, 0x64, 0x07, 0xfb, 0x74, 0xe7, 0xe2, 0xc1, 0x4e, 0x48,
+		0x82, 0x0b, 0x6a, 0x13, 0xab, 0xcd, 0x82, 0x73, 0x12, 0xd4, 0xfc, 0x80, 0x71, 0x86, 0xae, 0xc4,
+		0xc8, 0x9a, 0x42, 0xd6, 0xb0, 0x4f, 0x6b, 0x17, 0x0f, 0x2a, 0x37, 0xce, 0x18, 0x3b, 0x73, 0xc9,
+		0x8e, 0x80, 0x34, 0xa2, 0xd3, 0x1d, 0x27, 0x0a, 0x30, 0xa7, 0xcc, 0x93, 0x46, 0x95, 0x9b, 0xfd,
+		0xef, 0x39, 0x6d, 0x91, 0x90, 0xe3, 0x96, 0xaf, 0x00, 0x03, 0x0e, 0xda, 0x01, 0xf6, 0x7d, 0x12,
+		0x84, 0xea, 0xfd, 0x96, 0x2e, 0x3e, 0x9b, 0xb5, 0x5a, 0xe9, 0x27, 0xaa, 0x3a, 0x84, 0x43, 0x6c,
+		0x1a, 0x76, 0xc3, 0xb8, 0xa5, 0xc3, 0x34, 0x69, 0xc8, 0x59, 0xd0, 0x49, 0x22, 0xd5, 0x41, 0xde,
+		0x45, 0x24, 0x05, 0x68, 0xbf, 0xc3, 0x71, 0x78, 0xee, 0xd2, 0x90, 0x17, 0x61, 0x62, 0x16, 0x4f,
+		0x5d, 0xd6, 0x96, 0x98, 0xea, 0xdf, 0x0d, 0xa8, 0x9c, 0x30, 0xd7, 0x3d, 0x62, 0xc1, 0xa1, 0x8a,
+		0xb2, 0x8e, 0xc3, 0x73, 0x93, 0xbc, 0x8b, 0x48, 0xc8, 0xd1, 0x06, 0xcc, 0x39, 0xac, 0x85, 0xa9,
+		0x57, 0x36, 0xb6, 0x8c, 0xed, 0x45, 0x53, 0x3d, 0xa1, 0xc7, 0xb0, 0x18, 0x7f, 0xcc, 0x8a, 0xbf,
+		0x56, 0x9e, 0xda, 0x32, 0xb6, 0x97, 0x76, 0xaf, 0xd7, 0x34, 0x29, 0xa9, 0xc5, 0xce, 0xbe, 0xa0,
+		0x21, 0x37, 0x17, 0xb8, 0xfa, 0x0b, 0x55, 0x60, 0x81, 0x3a, 0xc4, 0xe3, 0x94, 0x77, 0xca, 0xd3,
+		0xc2, 0x6b, 0xfa, 0x8c, 0xee, 0xc1, 0x5a, 0x83, 0x7a, 0x38, 0xe8, 0x58, 0x76, 0x93, 0xd8, 0xe7,
+		0x61, 0xd4, 0x2a, 0xcf, 0x08, 0xc8, 0xaa, 0x1c, 0x3e, 0x50, 0xa3, 0xd5, 0x7f, 0x2c, 0xc2, 0x55,
+		0x6d, 0xdc, 0xa1, 0xcf, 0xbc, 0x90, 0xa0, 0xeb, 0x00, 0x22, 0x40, 0xce, 0xce, 0x89, 0x0c, 0x7e,
+		0xd9, 0x14, 0x21, 0xd7, 0xe3, 0x01, 0xf4, 0x0a, 0x50, 0x42, 0x84, 0x45, 0xde, 0x13, 0x3b, 0x8a,
+		0xab, 0x44, 0x4d, 0xe4, 0xae, 0x76, 0x22, 0x6f, 0x14, 0xfc, 0x59, 0x82, 0x36, 0xd7, 0xdb, 0xfd,
+		0x43, 0xe8, 0x08, 0x56, 0x52, 0xb7, 0xbc, 0xe3, 0x13, 0x31, 0xbf, 0xa5, 0xdd, 0x5b, 0x85, 0x1e,
+		0xeb, 0x1d, 0x9f, 0x98, 0xcb, 0xed, 0xcc, 0x13, 0x7a, 0x0d, 0x9b, 0x7e, 0x40, 0x2e, 0x28, 0x8b,
+		0x42, 0x2b, 0xe4, 0x38, 0xe0, 0xc4, 0xb1, 0xc8, 0x05, 0xf1, 0xb8, 0x45, 0x1d, 0x41, 0xc8, 0xd2,
+		0xee, 0xd5, 0x9a, 0xac, 0xd5, 0x5a, 0x52, 0xab, 0xb5, 0x63, 0x8f, 0x3f, 0xfc, 0xe4, 0x35, 0x76,
+		0x23, 0x62, 0x6e, 0x24, 0xd6, 0x2f, 0xa5, 0xf1, 0xb3, 0xd8, 0xf6, 0xd8, 0x41, 0xdb, 0x50, 0x1a,
+		0x70, 0x37, 0xbb, 0x65, 0x6c, 0x4f, 0x9b, 0xab, 0x61, 0x2f, 0xb2, 0x0c, 0xf3, 0x98, 0x73, 0xd2,
+		0xf2, 0x79, 0x79, 0x4e, 0x00, 0x92, 0x47, 0x74, 0x1f, 0x50, 0x03, 0xdb, 0xe7, 0x2e, 0x3b, 0xb3,
+		0x6c, 0x16, 0x79, 0xdc, 0x6a, 0x52, 0x8f, 0x97, 0xe7, 0x05, 0xa8, 0xa4, 0xde, 0x1c, 0xc4, 0x2f,
+		0x9e, 0x53, 0x8f, 0xa3, 0x87, 0x30, 0xaf, 0x2a, 0xbb, 0xbc, 0x20, 0xe2, 0xbe, 0xa6, 0xe5, 0xe2,
+		0xb9, 0xc4, 0x98, 0x09, 0x18, 0xdd, 0x85, 0x35, 0x8f, 0xbc, 0xe7, 0x96, 0x8f, 0xcf, 0x88, 0x4a,
+		0xe2, 0xa2, 0x48, 0xe2, 0x4a, 0x3c, 0x7c, 0x82, 0xcf, 0x88, 0x4c, 0xe4, 0x23, 0x98, 0x15, 0xcb,
+		0xa2, 0x0c, 0xc2, 0x7b, 0xb5, 0x90, 0xe9, 0xaf, 0x62, 0xa4, 0x29, 0x0d, 0xd0, 0x5b, 0xb8, 0x36,
+		0x58, 0x02, 0x56, 0xb7, 0xaa, 0x97, 0x46, 0xa9, 0xea, 0xcd, 0x81, 0x1a, 0x48, 0x5e, 0xa1, 0x3d,
+		0x58, 0x0d, 0xed, 0x26, 0x71, 0x22, 0x97, 0x38, 0x56, 0xdc, 0x68, 0xca, 0xcb, 0xc2, 0x63, 0x65,
+		0x20, 0x71, 0xf5, 0xa4, 0x0b, 0x99, 0x2b, 0xa9, 0x45, 0x3c, 0x86, 0x9e, 0xc2, 0x72, 0x92, 0x2e,
+		0xe1, 0x60, 0x65, 0xa8, 0x83, 0x25, 0x85, 0x17, 0xe6, 0x6f, 0x60, 0x3e, 0x9e, 0x2a, 0x25, 0x61,
+		0x79, 0x75, 0x6b, 0x7a, 0x7b, 0x69, 0xf7, 0xa9, 0x76, 0x32, 0x05, 0xcb, 0xa8, 0xf6, 0x95, 0xb4,
+		0x7f, 0xe6, 0xf1, 0x38, 0x39, 0xca, 0x1b, 0xaa, 0x82, 0xc8, 0x42, 0xb7, 0x86, 0xd6, 0x44, 0xf6,
+		0x97, 0xe2, 0xc1, 0xa4, 0x80, 0x6a, 0x70, 0x85, 0x33, 0x8e, 0x5d, 0x4b, 0x65, 0xd4, 0x6a, 0x74,
+		0x38, 0x09, 0xcb, 0x25, 0x81, 0x5c, 0x17, 0xaf, 0x54, 0xd2, 0xf7, 0xe3, 0x17, 0xe8, 0x05, 0x94,
+		0x70, 0xc4, 0x99, 0x65, 0x33, 0xef, 0x94, 0x9e, 0xc9, 0xa2, 0x5a, 0x17, 0xf3, 0xbd, 0xad, 0x8d,
+		0x7a, 0x2f, 0xe2, 0xec, 0x40, 0x60, 0xe3, 0x3a, 0x33, 0x57, 0x71, 0xcf, 0x33, 0x7a, 0x0a, 0xf3,
+		0x91, 0xef, 0xe0, 0xf8, 0x93, 0x48, 0xcc, 0xfd, 0x76, 0x61, 0x65, 0xbc, 0x12, 0x58, 0x33, 0xb1,
+		0xa9, 0xbc, 0x85, 0xe5, 0xec, 0xd4, 0x51, 0x09, 0xa6, 0xcf, 0x49, 0x47, 0x35, 0xc1, 0xf8, 0xcf,
+		0xb8, 0xf0, 0x2e, 0xe2, 0xb5, 0xa6, 0x9a, 0xc6, 0x48, 0x85, 0x27, 0x0c, 0x1e, 0x4f, 0x3d, 0x32,
+		0xaa, 0x7f, 0x9b, 0x85, 0xdb, 0x92, 0x64, 0x27, 0xcb, 0xfb, 0x01, 0x6b, 0xf9, 0x2e, 0xe1, 0xc4,
+		0x49, 0xfa, 0xef, 0x90, 0x36, 0xf6, 0x04, 0x16, 0x93, 0xbd, 0x25, 0x2c, 0x4f, 0x89, 0x79, 0xea,
+		0x0b, 0x36, 0xf9, 0x88, 0xd9, 0xc5, 0xa3, 0x9f, 0xc0, 0x7a, 0xb7, 0xee, 0x6d, 0xe6, 0x71, 0xf2,
+		0x9e, 0x8b, 0x86, 0xb5, 0x6c, 0x96, 0xd2, 0x17, 0x07, 0x72, 0xbc, 0xa7, 0x69, 0xcf, 0xf4, 0x35,
+		0xed, 0xdf, 0xc0, 0x7a, 0xc8, 0xa9, 0x7d, 0xde, 0xb1, 0x30, 0xe7, 0x01, 0x6d, 0x44, 0x31, 0xeb,
+		0xb3, 0x82, 0x96, 0x9a, 0x36, 0x9a, 0x97, 0x02, 0x9d, 0x2e, 0x99, 0xbd, 0xd4, 0xca, 0x2c, 0x49,
+		0x47, 0xdd, 0x11, 0xf4, 0x29, 0x94, 0x03, 0xc2, 0xa3, 0xc0, 0xb3, 0x3c, 0xd2, 0xb6, 0x92, 0xe8,
+		0xc5, 0x3a, 0x15, 0x9d, 0x69, 0xc1, 0xfc, 0x81, 0x7c, 0xff, 0x25, 0x69, 0x67, 0xa9, 0x44, 0xfb,
+		0x70, 0xe3, 0x94, 0x05, 0x36, 0xb1, 0xec, 0x80, 0x60, 0x4e, 0x34, 0xe6, 0xf3, 0xc2, 0xbc, 0x22,
+		0x50, 0x07, 0x02, 0xd4, 0xef, 0x43, 0xb3, 0x1d, 0x2d, 0xe8, 0xb6, 0x23, 0xc4, 0x60, 0x45, 0x74,
+		0x15, 0x2b, 0x20, 0x61, 0xe4, 0xf2, 0xb0, 0xbc, 0x28, 0x92, 0xf1, 0x2b, 0xed, 0xf4, 0x47, 0x48,
+		0x7c, 0x4d, 0x56, 0x8c, 0x74, 0x26, 0x57, 0xdf, 0xf2, 0xbb, 0xcc, 0x50, 0x85, 0xc2, 0xfa, 0x00,
+		0x44, 0x53, 0xa5, 0xbf, 0xe8, 0xad, 0xd2, 0xed, 0x11, 0xaa, 0x54, 0x38, 0xcc, 0xd6, 0xea, 0x87,
+		0x69, 0xf8, 0x51, 0x71, 0xc8, 0x6a, 0xcf, 0x7d, 0x05, 0x2b, 0xbd, 0x04, 0x1b, 0xe2, 0xa3, 0x3f,
+		0x1b, 0xb7, 0xeb, 0x98, 0xcb, 0x4e, 0x36, 0x09, 0x1f, 0x0c, 0xb8, 0x81, 0x6d, 0x4e, 0x2f, 0x28,
+		0xa7, 0x24, 0xb4, 0x38, 0xb3, 0x1c, 0x1a, 0xfa, 0x98, 0xdb, 0x4d, 0xcb, 0x65, 0x36, 0x76, 0xdd,
+		0x8e, 0x2a, 0xfd, 0xaf, 0x27, 0x60, 0x5b, 0xf5, 0xb9, 0xbd, 0xd4, 0x7f, 0x9d, 0x1d, 0x2a, 0xef,
+		0x5f, 0x48, 0xe7, 0x92, 0xfd, 0xab, 0x38, 0x1f, 0x51, 0xf9, 0x2d, 0x6c, 0x0d, 0x73, 0xa0, 0xc9,
+		0xcd, 0x61, 0x6f, 0x6e, 0xf4, 0x4b, 0x45, 0xf9, 0xed, 0x08, 0x5f, 0x89, 0xe3, 0x63, 0xef, 0x94,
+		0x65, 0x33, 0xf4, 0xbb, 0x29, 0xd8, 0xd2, 0x4c, 0xf3, 0x08, 0x53, 0x77, 0xe4, 0x56, 0xb2, 0x0f,
+		0xb3, 0x36, 0x8e, 0x42, 0x19, 0xcd, 0xea, 0xee, 0xfd, 0xc2, 0x36, 0xd2, 0xf5, 0x7e, 0x10, 0xdb,
+		0x98, 0xd2, 0x34, 0xde, 0xec, 0x1d, 0xc2, 0x31, 0x75, 0x43, 0x25, 0x7c, 0xf4, 0x9b, 0xfd, 0x09,
+		0xee, 0xb8, 0x0c, 0x3b, 0x66, 0x02, 0x2e, 0x6c, 0x2e, 0x9a, 0x25, 0x38, 0xab, 0x55, 0x84, 0xb7,
+		0xe1, 0x56, 0x01, 0x07, 0x32, 0xcf, 0xd5, 0x7f, 0x77, 0xe5, 0x6e, 0xc2, 0xec, 0x65, 0xca, 0xdd,
+		0x97, 0x80, 0x52, 0xbf, 0x56, 0x8b, 0x70, 0xec, 0x60, 0x8e, 0x95, 0xc0, 0xbb, 0x53, 0xf8, 0x81,
+		0x17, 0x0a, 0x6c, 0x96, 0x78, 0xdf, 0x48, 0xf5, 0x9f, 0x0b, 0xa9, 0x34, 0xee, 0x9d, 0xe3, 0xa5,
+		0x4a, 0xe3, 0x9b, 0xb0, 0xa4, 0x96, 0x50, 0x27, 0x56, 0x0c, 0x92, 0x09, 0x48, 0x86, 0x8e, 0x9d,
+		0x58, 0x3b, 0xa7, 0x00, 0xa1, 0x9d, 0x67, 0x0a, 0xb4, 0x73, 0x3a, 0x31, 0xa1, 0x9d, 0x71, 0xe6,
+		0x09, 0xed, 0xc2, 0x2c, 0xf5, 0xfc, 0x88, 0xab, 0x1d, 0xa8, 0xb8, 0x04, 0x25, 0x54, 0xa3, 0xd5,
+		0xe6, 0xfe, 0x57, 0xad, 0x36, 0x3f, 0x9e, 0x56, 0xab, 0xc3, 0x66, 0xe2, 0x2f, 0xee, 0x70, 0xb6,
+		0xcb, 0x42, 0x22, 0x1c, 0xb1, 0x88, 0x2b, 0xe5, 0xbc, 0x39, 0xe0, 0xeb, 0x50, 0x1d, 0x6f, 0xcd,
+		0x8d, 0xc4, 0xb6, 0xce, 0x0e, 0x62, 0xcb, 0xba, 0x34, 0x44, 0x5f, 0xc2, 0x86, 0xf8, 0xc8, 0xa0,
+		0xcb, 0xc5, 0x61, 0x2e, 0xaf, 0x08, 0xc3, 0x3e, 0x7f, 0x47, 0xb0, 0xde, 0x24, 0x38, 0xe0, 0x0d,
+		0x82, 0x79, 0xea, 0x0a, 0x86, 0xb9, 0x2a, 0xa5, 0x36, 0x89, 0x9f, 0xcc, 0xe9, 0x22, 0x96, 0xd9,
+		0xb3, 0xdd, 0xd3, 0xc5, 0x5b, 0xb8, 0xd1, 0x9b, 0x09, 0x8b, 0x9d, 0x5a, 0xbc, 0x49, 0x43, 0x2b,
+		0x31, 0x18, 0xae, 0xa2, 0x2b, 0x3d, 0x99, 0xf9, 0xf5, 0x69, 0xbd, 0x49, 0xc3, 0x3d, 0xe5, 0xff,
+		0x38, 0x3b, 0x83, 0xa4, 0x59, 0xad, 0x8c, 0x50, 0x29, 0xdd, 0x49, 0x1c, 0xaa, 0xae, 0x35, 0x70,
+		0xd8, 0x5b, 0x9d, 0xec, 0xb0, 0x77, 0x0f, 0xd6, 0x52, 0x3f, 0xaa, 0xfb, 0xac, 0xc9, 0x0e, 0x97,
+		0x0c, 0x1f, 0xca, 0x2e, 0xf4, 0x31, 0xcc, 0x35, 0x09, 0x76, 0x48, 0x20, 0x54, 0x74, 0x7c, 0x04,
+		0xd4, 0x1e, 0xa5, 0x04, 0xc4, 0x54, 0xd0, 0xff, 0xb3, 0xae, 0xae, 0x7e, 0x6b, 0xa4, 0xc2, 0x35,
+		0xdb, 0x5c, 0xc6, 0x15, 0xae, 0x9f, 0xc0, 0x9c, 0x54, 0x4a, 0xaa, 0xb1, 0x14, 0x73, 0xaf, 0xb0,
+		0x45, 0xad, 0xb4, 0x7a, 0x37, 0x15, 0x29, 0x39, 0x71, 0xa9, 0x1d, 0xe0, 0xf7, 0x53, 0x70, 0xaf,
+		0x08, 0xb8, 0xdf, 0x39, 0x3e, 0x1c, 0xb6, 0x1d, 0x5c, 0x56, 0x8b, 0xec, 0xb2, 0x36, 0x33, 0x21,
+		0x6b, 0xb3, 0x7d, 0xac, 0xfd, 0x18, 0xb6, 0x87, 0x93, 0xa1, 0x98, 0xfb, 0xb3, 0x91, 0xaa, 0x8c,
+		0x2c, 0x78, 0x2c, 0x95, 0xf1, 0x10, 0xe6, 0x4f, 0x31, 0x75, 0xa3, 0x80, 0x14, 0x26, 0xfe, 0x48,
+		0x62, 0xcc, 0x04, 0x5c, 0x98, 0xf9, 0xee, 0xc6, 0xaf, 0x0b, 0x4b, 0x05, 0xff, 0xcd, 0x94, 0xb6,
+		0x3e, 0x24, 0xea, 0xbb, 0x9c, 0xf3, 0x0c, 0x63, 0x33, 0x93, 0x32, 0xd6, 0x9f, 0xf5, 0x7b, 0x70,
+		0x67, 0x08, 0x17, 0x8a, 0xb5, 0xbf, 0x18, 0x50, 0xd5, 0xd5, 0x07, 0xf6, 0x6c, 0x32, 0x56, 0xd2,
+		0x93, 0x4e, 0x3b, 0x35, 0xa9, 0x2c, 0xec, 0x4f, 0xfa, 0x1d, 0x7d, 0x1b, 0x4a, 0x03, 0x53, 0x13,
+		0xf8, 0xc3, 0x14, 0xdc, 0x2d, 0xc0, 0x7d, 0xc7, 0x13, 0x9f, 0xb0, 0x36, 0x33, 0x29, 0x6b, 0xfd,
+		0x89, 0xff, 0x48, 0xdf, 0xfb, 0x7a, 0xd8, 0xe8, 0x49, 0xbd, 0xcd, 0x82, 0x1e, 0xe8, 0xf3, 0x64,
+		0x13, 0xbc, 0xc4, 0xd4, 0x9f, 0xc4, 0xa9, 0x2f, 0x08, 0x4c, 0xc9, 0xdc, 0x8f, 0xa0, 0x64, 0x8b,
+		0x89, 0x59, 0x81, 0x8c, 0x95, 0x38, 0x22, 0xbe, 0x05, 0x73, 0x4d, 0x8e, 0x9b, 0xc9, 0xb0, 0xaa,
+		0x92, 0x5c, 0x97, 0xdf, 0xb7, 0x2a, 0xa9, 0xc7, 0x55, 0x32, 0x84, 0x8d, 0xf1, 0x49, 0xfe, 0x57,
+		0x77, 0xfb, 0x10, 0x17, 0x0d, 0x93, 0xc8, 0x86, 0x5f, 0xf6, 0xc9, 0x86, 0xd1, 0xef, 0x33, 0x92,
+		0xcd, 0xf0, 0x35, 0x5c, 0x91, 0xff, 0x47, 0xb2, 0x2e, 0x48, 0x20, 0x6e, 0x2a, 0xa8, 0x77, 0xca,
+		0xd4, 0x71, 0x35, 0x3f, 0x51, 0x24, 0x78, 0x2d, 0xe1, 0xe2, 0xe8, 0xbd, 0xde, 0xee, 0x1f, 0xca,
+		0x6c, 0x42, 0xba, 0xc9, 0x25, 0xda, 0xc3, 0x80, 0x8a, 0x49, 0x42, 0xc2, 0xe5, 0x05, 0x58, 0x7a,
+		0x58, 0xbc, 0x94, 0xda, 0xaa, 0x5e, 0x87, 0xab, 0xda, 0x60, 0x54, 0xb0, 0x01, 0xac, 0xf6, 0x6a,
+		0x41, 0x74, 0x1f, 0x10, 0xf1, 0x70, 0xc3, 0x25, 0x56, 0x46, 0x51, 0xaa, 0x74, 0x97, 0xe4, 0x9b,
+		0xae, 0x05, 0xda, 0x85, 0x0d, 0x9f, 0xb9, 0x2e, 0x09, 0xac, 0x36, 0xa6, 0xf2, 0xb4, 0x60, 0x51,
+		0xcf, 0x6a, 0xc9, 0x4e, 0x30, 0x6d, 0x22, 0xf9, 0xf6, 0x0d, 0xa6, 0xe2, 0x58, 0x70, 0xec, 0xbd,
+		0x08, 0x77, 0xff, 0xb3, 0x06, 0x8b, 0x92, 0xee, 0xbd, 0x93, 0x63, 0xf4, 0x1e, 0xae, 0x68, 0x6e,
+		0x89, 0xd0, 0xce, 0xe8, 0xf7, 0x49, 0x82, 0xd7, 0xca, 0xd8, 0x17, 0x50, 0xe8, 0x4f, 0x06, 0x5c,
+		0x2b, 0xba, 0x37, 0x42, 0x8f, 0x26, 0xbd, 0xd8, 0xab, 0x7c, 0x36, 0xf1, 0x25, 0x15, 0xfa, 0xc6,
+		0x80, 0xcd, 0xdc, 0x2b, 0x0e, 0xf4, 0xf3, 0x51, 0x1d, 0xf7, 0x08, 0xb6, 0xca, 0xc3, 0x71, 0xcd,
+		0x54, 0x30, 0xdd, 0xe4, 0x64, 0xbb, 0x44, 0x71, 0x72, 0x34, 0x57, 0x2e, 0xc5, 0xc9, 0xd1, 0xde,
+		0x5f, 0x64, 0x92, 0xa3, 0x15, 0xad, 0xc5, 0xc9, 0x29, 0x3a, 0xb5, 0x14, 0x27, 0xa7, 0xf0, 0x5c,
+		0x81, 0x3e, 0xe8, 0xd5, 0x71, 0x8f, 0x94, 0x46, 0x9f, 0x8f, 0xed, 0x3f, 0xb3, 0xf7, 0x54, 0x9e,
+		0x4e, 0x68, 0x3d, 0x58, 0x3e, 0x83, 0xb2, 0xaf, 0xb8, 0x7c, 0x72, 0xf5, 0x7e, 0x71, 0xf9, 0xe4,
+		0xeb, 0x71, 0xf4, 0xad, 0x01, 0xd7, 0x0b, 0x35, 0x28, 0xfa, 0x6c, 0x3c, 0xcf, 0x59, 0xa2, 0x1e,
+		0x4f, 0x62, 0xaa, 0x02, 0xfb, 0xa3, 0x21, 0xda, 0x62, 0x9e, 0x46, 0x42, 0x9f, 0x8e, 0x9c, 0x84,
+		0x5e, 0x91, 0x5c, 0x79, 0x34, 0xbe, 0xa1, 0x0a, 0xe9, 0xaf, 0x06, 0xdc, 0x1c, 0x22, 0xdb, 0xd0,
+		0x93, 0x71, 0xbd, 0x67, 0xf9, 0xfa, 0x7c, 0x32, 0xe3, 0x1e, 0xc6, 0x72, 0xf5, 0x42, 0x2e, 0x63,
+		0xc3, 0xb4, 0x65, 0x2e, 0x63, 0xc3, 0xb5, 0x9f, 0x64, 0xac, 0x50, 0xc2, 0xe4, 0x32, 0x36, 0x8a,
+		0x0c, 0xcc, 0x65, 0x6c, 0x34, 0xd5, 0x94, 0x59, 0x89, 0x83, 0x6a, 0xa1, 0x78, 0x25, 0xe6, 0x4a,
+		0xa7, 0xe2, 0x95, 0x98, 0x2f, 0x4a, 0xe2, 0x46, 0xae, 0x91, 0x01, 0x39, 0x8d, 0x3c, 0x5f, 0xbd,
+		0xe4, 0x34, 0xf2, 0x02, 0x85, 0xb1, 0xdf, 0x80, 0x1f, 0xda, 0xac, 0xa5, 0x33, 0xdb, 0x47, 0x52,
+		0x05, 0xbc, 0x94, 0x3f, 0xfd, 0x39, 0x09, 0x18, 0x67, 0x27, 0xc6, 0xd7, 0x0f, 0xce, 0x28, 0x6f,
+		0x46, 0x8d, 0x9a, 0xcd, 0x5a, 0x3b, 0xd9, 0xdf, 0xb6, 0xfc, 0x94, 0x3a, 0xee, 0xce, 0x19, 0x93,
+		0x3f, 0xdb, 0x51, 0x3f, 0x74, 0x79, 0x82, 0x7d, 0x7a, 0xf1, 0xa0, 0x31, 0x27, 0xc6, 0x3e, 0xfe,
+		0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xa8, 0x5a, 0x14, 0x5a, 0x24, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/decision.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdf, 0x6e, 0xd4, 0xc6,
-		0x17, 0xfe, 0x39, 0x7f, 0x36, 0xbb, 0x27, 0x1b, 0x20, 0x13, 0x08, 0x09, 0x04, 0x12, 0xf6, 0xa7,
-		0x42, 0x21, 0xca, 0x6e, 0x12, 0x28, 0x42, 0x80, 0x50, 0xc9, 0x42, 0x44, 0x24, 0x08, 0x91, 0x13,
-		0x40, 0xaa, 0x54, 0x59, 0x93, 0xf1, 0x24, 0x99, 0xc6, 0xeb, 0xd9, 0x8e, 0xc7, 0x09, 0x5b, 0xa9,
-		0x12, 0x57, 0x6d, 0x6f, 0xfa, 0x00, 0x95, 0x7a, 0xd5, 0x9b, 0xb6, 0x37, 0xed, 0x6d, 0xab, 0x5e,
-		0xf5, 0x11, 0xfa, 0x2c, 0x7d, 0x81, 0xca, 0xe3, 0xb1, 0x77, 0xb3, 0xf1, 0x7a, 0xed, 0x40, 0xb9,
-		0xe8, 0x5d, 0x3c, 0x3e, 0xe7, 0x9b, 0xcf, 0x73, 0xce, 0x7e, 0xe7, 0xb3, 0x03, 0x15, 0x7f, 0x9b,
-		0x8a, 0x1a, 0xc1, 0x36, 0x75, 0x09, 0xad, 0xe1, 0x26, 0xab, 0x1d, 0x2c, 0xd5, 0x6c, 0x4a, 0x98,
-		0xc7, 0xb8, 0x5b, 0x6d, 0x0a, 0x2e, 0x39, 0x9a, 0x08, 0x62, 0xaa, 0x3a, 0xa6, 0x8a, 0x9b, 0xac,
-		0x7a, 0xb0, 0x74, 0xe1, 0xf2, 0x2e, 0xe7, 0xbb, 0x0e, 0xad, 0xa9, 0x90, 0x6d, 0x7f, 0xa7, 0x66,
-		0xfb, 0x02, 0xcb, 0x38, 0xe9, 0xc2, 0x5c, 0x12, 0x30, 0xe1, 0x8d, 0x46, 0x1c, 0x91, 0xb8, 0xb5,
-		0xc4, 0xde, 0xbe, 0xc3, 0x3c, 0x99, 0x16, 0x73, 0xc8, 0xc5, 0xfe, 0x8e, 0xc3, 0x0f, 0xc3, 0x98,
-		0xca, 0x37, 0xe3, 0x50, 0x7c, 0xa4, 0x19, 0xa3, 0xef, 0x0c, 0xb8, 0xe1, 0x91, 0x3d, 0x6a, 0xfb,
-		0x0e, 0xb5, 0x30, 0x91, 0xec, 0x80, 0xc9, 0x96, 0x15, 0xa0, 0x5a, 0xd1, 0x53, 0x59, 0x58, 0x4a,
-		0xc1, 0xb6, 0x7d, 0x49, 0xbd, 0x29, 0x63, 0xce, 0xf8, 0x70, 0x74, 0xf9, 0x5e, 0x35, 0xe1, 0x09,
-		0xab, 0x9b, 0x1a, 0xe6, 0xa1, 0x46, 0xd9, 0xc2, 0xde, 0x7e, 0xb4, 0xcf, 0xc3, 0x18, 0xe2, 0xc9,
-		0xff, 0xcc, 0xab, 0x5e, 0xa6, 0x48, 0xf4, 0x05, 0xcc, 0x7a, 0x12, 0x0b, 0x69, 0x49, 0xd6, 0xa0,
-		0x22, 0x91, 0xcf, 0x80, 0xe2, 0xb3, 0x94, 0xcc, 0x27, 0xc8, 0xdd, 0x0a, 0x52, 0x13, 0x59, 0xcc,
-		0x78, 0x29, 0xf7, 0xd1, 0x4f, 0x06, 0x04, 0xa7, 0xdf, 0x74, 0xa8, 0xa4, 0x56, 0x74, 0x80, 0x16,
-		0x7d, 0x4d, 0x89, 0x1f, 0x14, 0x2d, 0x91, 0xcc, 0xa0, 0x22, 0xf3, 0x71, 0x22, 0x99, 0xba, 0xc6,
-		0x7a, 0xa5, 0xa1, 0x1e, 0x47, 0x48, 0x89, 0xdc, 0xe6, 0x49, 0xf6, 0x70, 0xf4, 0xbd, 0x01, 0xf3,
-		0x3b, 0x98, 0x39, 0x59, 0x69, 0x0e, 0x29, 0x9a, 0xf7, 0x13, 0x69, 0xae, 0x62, 0xe6, 0x64, 0xa3,
-		0x78, 0x6d, 0x27, 0x5b, 0x28, 0xfa, 0xd9, 0x80, 0x45, 0x41, 0x3f, 0xf7, 0xa9, 0x27, 0x2d, 0x82,
-		0x5d, 0x42, 0x9d, 0x0c, 0x7d, 0x36, 0x9c, 0x72, 0x94, 0x66, 0x08, 0x56, 0x57, 0x58, 0x7d, 0x9b,
-		0x6d, 0x5e, 0x64, 0x0f, 0x47, 0x5f, 0xc2, 0x9c, 0xa6, 0xd8, 0xbb, 0xe5, 0x0a, 0x8a, 0xda, 0x72,
-		0x72, 0x95, 0x55, 0x72, 0xef, 0x9e, 0xbb, 0x44, 0xd2, 0x02, 0xd0, 0x0f, 0x06, 0x2c, 0xe8, 0xfd,
-		0x33, 0xd6, 0x72, 0x44, 0x91, 0x79, 0x90, 0x42, 0x26, 0x5b, 0x35, 0xaf, 0x93, 0xac, 0xc1, 0xe8,
-		0x2f, 0x03, 0x1e, 0x74, 0xd5, 0x93, 0xbe, 0x96, 0x54, 0xb8, 0x38, 0x33, 0xeb, 0xa2, 0x62, 0xfd,
-		0xac, 0x7f, 0x75, 0x1f, 0x6b, 0xe0, 0x6c, 0x0f, 0x71, 0x47, 0x9c, 0x30, 0x17, 0xbd, 0x31, 0xe0,
-		0x8a, 0xa0, 0x84, 0x0b, 0xdb, 0x6a, 0x60, 0xb1, 0xdf, 0xa3, 0xf2, 0x25, 0x45, 0xfb, 0x66, 0x0f,
-		0xda, 0x41, 0xf6, 0x33, 0x95, 0x9c, 0x48, 0xee, 0xb2, 0x48, 0x8d, 0x40, 0xbf, 0x1b, 0x70, 0x9b,
-		0x70, 0x57, 0x32, 0xd7, 0xa7, 0x16, 0xf6, 0x2c, 0x97, 0x1e, 0x66, 0x3d, 0x4e, 0x50, 0xbc, 0x1e,
-		0xf7, 0xd0, 0x9d, 0x10, 0xf2, 0xa1, 0xb7, 0x4e, 0x0f, 0xb3, 0x1d, 0xe3, 0x22, 0xc9, 0x99, 0x83,
-		0x7e, 0x35, 0x60, 0x39, 0x54, 0x6a, 0xb2, 0xc7, 0x1c, 0x3b, 0x2b, 0xef, 0x51, 0xc5, 0x7b, 0xa5,
-		0xb7, 0x78, 0xd7, 0x03, 0xb4, 0x6c, 0xa4, 0x17, 0xbc, 0x3c, 0x09, 0xe8, 0x0f, 0x03, 0x6e, 0x7b,
-		0x6c, 0x37, 0xe8, 0xd9, 0xbc, 0xcd, 0x5b, 0x56, 0xac, 0x57, 0x93, 0x59, 0x2b, 0xc8, 0x7c, 0x5d,
-		0xbb, 0xe4, 0xe5, 0x4d, 0x42, 0xbf, 0x19, 0xf0, 0x91, 0xdf, 0xf4, 0xa8, 0x90, 0x6d, 0xd2, 0x1e,
-		0xc5, 0x82, 0xec, 0x75, 0x10, 0x4d, 0x24, 0x3f, 0x96, 0xd2, 0x2a, 0x2f, 0x14, 0x62, 0xb4, 0xff,
-		0xa6, 0xc2, 0x6b, 0x6f, 0x9a, 0xdc, 0x2a, 0x7e, 0xce, 0x9c, 0x95, 0x32, 0x40, 0x9b, 0x4e, 0xe5,
-		0xdb, 0x02, 0x5c, 0xcd, 0x66, 0x1b, 0xd0, 0x2c, 0x8c, 0xc6, 0x63, 0x83, 0xd9, 0xca, 0x88, 0x94,
-		0x4c, 0x88, 0x96, 0xd6, 0x6c, 0xb4, 0x0a, 0x63, 0xed, 0xb9, 0xd2, 0x6a, 0x52, 0xed, 0x0d, 0xae,
-		0x24, 0x3e, 0x6b, 0xbc, 0x59, 0xab, 0x49, 0xcd, 0x32, 0xee, 0xb8, 0x42, 0x93, 0x50, 0xb0, 0x79,
-		0x03, 0x33, 0x57, 0xcd, 0xf3, 0x92, 0xa9, 0xaf, 0xd0, 0x5d, 0x28, 0xa9, 0x71, 0x15, 0xb8, 0x2d,
-		0x3d, 0x43, 0x2f, 0x25, 0x62, 0x07, 0x0f, 0xf0, 0x94, 0x79, 0xd2, 0x2c, 0x4a, 0xfd, 0x17, 0x5a,
-		0x86, 0x61, 0xe6, 0x36, 0x7d, 0xa9, 0xe7, 0xda, 0x4c, 0x62, 0xde, 0x06, 0x6e, 0x39, 0x1c, 0xdb,
-		0x66, 0x18, 0x8a, 0xb6, 0x60, 0x3a, 0x36, 0x66, 0x92, 0x5b, 0xc4, 0xe1, 0x1e, 0x55, 0x63, 0x89,
-		0xfb, 0x52, 0x0f, 0xa1, 0xe9, 0x6a, 0x68, 0x2a, 0xab, 0x91, 0xa9, 0xac, 0x3e, 0xd2, 0xa6, 0xd2,
-		0x9c, 0x8c, 0x72, 0xb7, 0x78, 0x3d, 0xc8, 0xdc, 0x0a, 0x13, 0xbb, 0x51, 0xdb, 0xfe, 0x2a, 0x40,
-		0x1d, 0xc9, 0x81, 0x1a, 0xbb, 0xab, 0x00, 0x75, 0x1d, 0x26, 0x35, 0x52, 0x37, 0xd1, 0x62, 0x3f,
-		0xc8, 0x89, 0xd0, 0x86, 0x1d, 0x65, 0xb9, 0x0a, 0xe3, 0x7b, 0x14, 0x0b, 0xb9, 0x4d, 0x71, 0x9b,
-		0x5d, 0xa9, 0x1f, 0xd4, 0x99, 0x38, 0x27, 0xc2, 0xa9, 0x43, 0x59, 0x50, 0x29, 0x5a, 0x56, 0x93,
-		0x3b, 0x8c, 0xb4, 0xb4, 0xe2, 0xcc, 0xf5, 0x50, 0x70, 0x29, 0x5a, 0x1b, 0x2a, 0xce, 0x1c, 0x15,
-		0xed, 0x0b, 0x74, 0x13, 0x0a, 0x7b, 0x14, 0xdb, 0x54, 0xe8, 0x9f, 0xfe, 0xc5, 0xc4, 0xf4, 0x27,
-		0x2a, 0xc4, 0xd4, 0xa1, 0xe8, 0x16, 0x4c, 0x46, 0x43, 0xd2, 0xe1, 0x04, 0x3b, 0x96, 0xcd, 0xbc,
-		0x26, 0x96, 0x64, 0x4f, 0xfd, 0x04, 0x8b, 0xe6, 0x59, 0x7d, 0xf7, 0x69, 0x70, 0xf3, 0x91, 0xbe,
-		0x57, 0xf9, 0xda, 0x80, 0x99, 0x34, 0xdb, 0x8a, 0xa6, 0xa1, 0x18, 0x3a, 0x93, 0xf8, 0x27, 0x30,
-		0xa2, 0xae, 0xd7, 0x6c, 0xf4, 0x14, 0xce, 0xc5, 0x35, 0xd8, 0x61, 0xa2, 0x5d, 0x82, 0x81, 0x7e,
-		0xe7, 0x86, 0x74, 0x09, 0x56, 0x99, 0x88, 0x2a, 0x50, 0x21, 0x30, 0x9f, 0xc3, 0xb2, 0xa2, 0x5b,
-		0x50, 0x10, 0xd4, 0xf3, 0x1d, 0xa9, 0xdf, 0x10, 0xd2, 0x3b, 0x5c, 0xc7, 0x56, 0x30, 0x5c, 0xcb,
-		0x68, 0x38, 0xd1, 0x6d, 0x18, 0x09, 0x0c, 0xa7, 0x2f, 0x68, 0xea, 0x0e, 0xab, 0x61, 0x8c, 0x19,
-		0x05, 0x57, 0xd6, 0x61, 0x3e, 0x87, 0x5f, 0xec, 0xab, 0x32, 0x95, 0xbb, 0x70, 0x29, 0xd5, 0xe4,
-		0xa5, 0x54, 0xa8, 0x42, 0xe0, 0x7a, 0x66, 0x4f, 0x16, 0x3c, 0xb0, 0x4d, 0x25, 0x66, 0x8e, 0x97,
-		0xe9, 0x48, 0xa3, 0xe0, 0xca, 0xdf, 0x06, 0xdc, 0x39, 0xa9, 0x87, 0xea, 0xd0, 0x3e, 0xe3, 0x88,
-		0xf6, 0xbd, 0x00, 0x74, 0x7c, 0x3a, 0xea, 0xc6, 0xba, 0x9a, 0xc8, 0xeb, 0xd8, 0x6e, 0xe6, 0xf8,
-		0x61, 0xf7, 0x12, 0x9a, 0x82, 0x91, 0xc0, 0x6b, 0x08, 0xee, 0x28, 0xad, 0x2d, 0x9b, 0xd1, 0x25,
-		0xaa, 0xc2, 0x44, 0x97, 0x95, 0xe0, 0xae, 0xd3, 0x52, 0xb2, 0x5b, 0x34, 0xc7, 0x49, 0xe7, 0x98,
-		0x7f, 0xee, 0x3a, 0xad, 0xca, 0x2f, 0x06, 0x5c, 0x4e, 0xb7, 0x60, 0x41, 0x69, 0xb5, 0xb7, 0x73,
-		0x71, 0x83, 0x46, 0xa5, 0x0d, 0x97, 0xd6, 0x71, 0x83, 0x76, 0x9e, 0xf8, 0x40, 0x8e, 0x13, 0xef,
-		0xd0, 0x87, 0xc1, 0xcc, 0xfa, 0x50, 0x79, 0x03, 0xb0, 0x98, 0xd7, 0x9b, 0x05, 0x23, 0x2e, 0x3e,
-		0x0f, 0x35, 0xe2, 0x8c, 0x94, 0x11, 0x17, 0x01, 0x86, 0x23, 0xee, 0xb0, 0xe3, 0xea, 0xe8, 0x28,
-		0x1b, 0x38, 0xe1, 0x28, 0x1b, 0xcc, 0x3e, 0xca, 0x30, 0xcc, 0xb5, 0x3d, 0x55, 0x8f, 0x41, 0x31,
-		0xd4, 0x4f, 0xa5, 0x66, 0x62, 0x88, 0xcd, 0x84, 0x89, 0xf1, 0x0a, 0x2e, 0xaa, 0x47, 0xea, 0x81,
-		0x3e, 0xdc, 0x0f, 0xfd, 0x7c, 0x90, 0x9d, 0x04, 0xfc, 0x1c, 0x26, 0xb7, 0x31, 0xd9, 0xe7, 0x3b,
-		0x3b, 0x1a, 0x9b, 0xb9, 0x92, 0x8a, 0x03, 0xec, 0xf4, 0x9f, 0xc1, 0x67, 0x75, 0xa2, 0x82, 0x5d,
-		0xd3, 0x69, 0xc7, 0x66, 0xd2, 0xc8, 0x49, 0x66, 0xd2, 0x1a, 0x94, 0x98, 0xcb, 0x24, 0xc3, 0x92,
-		0x0b, 0x35, 0x63, 0x4f, 0x2d, 0xcf, 0xf7, 0xf7, 0xff, 0x6b, 0x51, 0x8a, 0xd9, 0xce, 0xee, 0x54,
-		0xd6, 0x52, 0x0e, 0x65, 0x45, 0x26, 0x4c, 0x3a, 0x38, 0x78, 0x07, 0x0c, 0xc7, 0x44, 0x50, 0x5a,
-		0x3d, 0x02, 0x20, 0x43, 0x67, 0x9c, 0x0d, 0x72, 0xeb, 0x71, 0xaa, 0xa9, 0x32, 0xd1, 0xff, 0x61,
-		0x8c, 0x88, 0xa0, 0x47, 0xb4, 0xcd, 0x50, 0x03, 0xbb, 0x64, 0x96, 0x83, 0xc5, 0xc8, 0x27, 0x9e,
-		0x6c, 0x1e, 0x2f, 0xc0, 0x50, 0x83, 0x36, 0xb8, 0x36, 0xc0, 0xd3, 0x89, 0x29, 0xcf, 0x68, 0x83,
-		0x9b, 0x2a, 0x0c, 0x99, 0x30, 0x7e, 0xcc, 0x50, 0x4f, 0x9d, 0x52, 0xb9, 0x1f, 0x24, 0x3b, 0xff,
-		0x2e, 0xeb, 0x6b, 0x9e, 0xf1, 0xba, 0x56, 0xd0, 0x7d, 0x28, 0x7f, 0xc6, 0xa4, 0xa4, 0x22, 0x6c,
-		0xa4, 0xa9, 0xd3, 0xfd, 0xfa, 0x67, 0x34, 0x0c, 0x57, 0xed, 0x83, 0x5e, 0xc2, 0x84, 0x3a, 0x1a,
-		0x7e, 0x40, 0x85, 0x83, 0x9b, 0x51, 0xf7, 0x9c, 0x51, 0xb5, 0x4f, 0xd6, 0xe0, 0xba, 0xe0, 0xee,
-		0xf3, 0x30, 0x5c, 0xf7, 0xd0, 0x38, 0xe9, 0x5e, 0x42, 0xaf, 0x61, 0x56, 0x8d, 0x37, 0x6a, 0x11,
-		0xc7, 0xf7, 0x14, 0x3b, 0xea, 0x50, 0xa2, 0xea, 0xa9, 0xf7, 0x18, 0x4f, 0xf9, 0xc8, 0xa6, 0xe6,
-		0x29, 0xad, 0x87, 0xa9, 0x9b, 0x51, 0xa6, 0xde, 0x6e, 0x06, 0xa7, 0xdc, 0xad, 0xfc, 0x58, 0x82,
-		0x85, 0x5c, 0xaf, 0x79, 0x3d, 0xc7, 0xd3, 0x2c, 0x8c, 0xc6, 0xba, 0xc8, 0x6c, 0xa5, 0x68, 0x25,
-		0x13, 0xa2, 0xa5, 0xf0, 0xdd, 0xe0, 0xa8, 0x70, 0x0e, 0xbe, 0x03, 0xe1, 0x7c, 0x0f, 0xef, 0x00,
-		0x59, 0x84, 0xb3, 0xf0, 0xaf, 0x0a, 0xe7, 0xc8, 0x89, 0x85, 0xf3, 0x25, 0x4c, 0x34, 0xb1, 0xa0,
-		0xae, 0xd4, 0x88, 0xba, 0x99, 0x8a, 0x29, 0x0d, 0xbb, 0xa1, 0xe2, 0x15, 0x4a, 0xd4, 0xb0, 0xcd,
-		0xee, 0xa5, 0x4e, 0xd3, 0x50, 0x3a, 0x6a, 0x1a, 0x08, 0x4c, 0x75, 0xb4, 0x81, 0x25, 0xa8, 0xdf,
-		0xde, 0x16, 0xd4, 0xb6, 0x37, 0x52, 0x0b, 0xbe, 0x66, 0x9b, 0x41, 0x8a, 0xde, 0xfa, 0xdc, 0x61,
-		0xd2, 0xf2, 0xbb, 0x79, 0xa5, 0x38, 0xa6, 0x73, 0xe5, 0x54, 0x9d, 0x1b, 0xcb, 0xaf, 0x73, 0xa7,
-		0xde, 0x42, 0xe7, 0x4e, 0xbf, 0x9d, 0xce, 0xfd, 0xf7, 0x94, 0xea, 0xcf, 0x01, 0x58, 0xca, 0xfd,
-		0x69, 0xe7, 0x7d, 0x9b, 0xe9, 0x59, 0x18, 0xd5, 0x5f, 0xb4, 0x94, 0xbf, 0x0d, 0x3f, 0x5e, 0x40,
-		0xb8, 0xa4, 0xfc, 0x6d, 0x2c, 0x40, 0x43, 0xd9, 0x05, 0xa8, 0xe3, 0xc7, 0x36, 0x9c, 0xc9, 0xa1,
-		0x17, 0x7a, 0x39, 0xf4, 0xaf, 0x0c, 0x58, 0xcc, 0xfb, 0x85, 0x29, 0xb9, 0x3d, 0x8d, 0xb7, 0x6a,
-		0xcf, 0x95, 0x4f, 0xe1, 0x3c, 0xe1, 0x8d, 0xa4, 0xec, 0x95, 0xb1, 0x88, 0xc2, 0x46, 0x20, 0x73,
-		0x1b, 0xc6, 0x27, 0x4b, 0xbb, 0x4c, 0xee, 0xf9, 0xdb, 0x55, 0xc2, 0x1b, 0xb5, 0xce, 0xff, 0xac,
-		0x2d, 0x30, 0xdb, 0xa9, 0xed, 0xf2, 0xf0, 0x9f, 0x79, 0xfa, 0xdf, 0x6c, 0xf7, 0x70, 0x93, 0x1d,
-		0x2c, 0x6d, 0x17, 0xd4, 0xda, 0xcd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x34, 0x7d, 0x59,
-		0x29, 0x1c, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdf, 0x4e, 0xdc, 0xcc,
+		0x15, 0xaf, 0xf9, 0xb3, 0xec, 0x1e, 0x96, 0x04, 0x06, 0x42, 0x42, 0x42, 0x02, 0xd9, 0xaa, 0xe4,
+		0x0f, 0x65, 0x17, 0x48, 0x1a, 0x45, 0x49, 0x1a, 0x15, 0x36, 0x41, 0x41, 0x4a, 0x08, 0x32, 0x24,
+		0x91, 0x2a, 0x55, 0xd6, 0x30, 0x1e, 0x60, 0x82, 0xd7, 0x76, 0xc7, 0x63, 0xc8, 0x56, 0xaa, 0x94,
+		0xab, 0xf6, 0xaa, 0x0f, 0xd0, 0xaa, 0x6a, 0xa5, 0xde, 0xb4, 0xbd, 0x69, 0xaf, 0x2a, 0xb5, 0xea,
+		0x55, 0x1f, 0xa1, 0xaf, 0xd0, 0x57, 0xe8, 0x0b, 0x7c, 0xf2, 0x78, 0xbc, 0xbb, 0x2c, 0x5e, 0xef,
+		0x18, 0xf2, 0xe5, 0xe2, 0xbb, 0xc3, 0xe3, 0x73, 0x7e, 0xf3, 0xf3, 0x9c, 0xc3, 0x39, 0xbf, 0x33,
+		0x0b, 0x95, 0x70, 0x8f, 0xf2, 0x1a, 0xc1, 0x36, 0x75, 0x09, 0xad, 0x61, 0x9f, 0xd5, 0x8e, 0x57,
+		0x6a, 0x36, 0x25, 0x2c, 0x60, 0x9e, 0x5b, 0xf5, 0xb9, 0x27, 0x3c, 0x34, 0x19, 0xd9, 0x54, 0x95,
+		0x4d, 0x15, 0xfb, 0xac, 0x7a, 0xbc, 0x72, 0xfd, 0xd6, 0x81, 0xe7, 0x1d, 0x38, 0xb4, 0x26, 0x4d,
+		0xf6, 0xc2, 0xfd, 0x9a, 0x1d, 0x72, 0x2c, 0x5a, 0x4e, 0xd7, 0xe7, 0xd3, 0x80, 0x89, 0xd7, 0x68,
+		0xb4, 0x2c, 0x52, 0xb7, 0x16, 0x38, 0x38, 0x72, 0x58, 0x20, 0xb2, 0x6c, 0x4e, 0x3c, 0x7e, 0xb4,
+		0xef, 0x78, 0x27, 0xb1, 0x4d, 0xe5, 0x7f, 0x53, 0x50, 0x7c, 0xa1, 0x18, 0xa3, 0xdf, 0x1a, 0x70,
+		0x3f, 0x20, 0x87, 0xd4, 0x0e, 0x1d, 0x6a, 0x61, 0x22, 0xd8, 0x31, 0x13, 0x4d, 0x2b, 0x42, 0xb5,
+		0x92, 0xaf, 0xb2, 0xb0, 0x10, 0x9c, 0xed, 0x85, 0x82, 0x06, 0xd7, 0x8c, 0x79, 0xe3, 0xee, 0xe8,
+		0xea, 0xd3, 0x6a, 0xca, 0x17, 0x56, 0x77, 0x14, 0xcc, 0x9a, 0x42, 0xd9, 0xc5, 0xc1, 0x51, 0xb2,
+		0xcf, 0x5a, 0x0b, 0xe2, 0xd5, 0xf7, 0xcc, 0x85, 0x40, 0xcb, 0x12, 0xfd, 0x02, 0xe6, 0x02, 0x81,
+		0xb9, 0xb0, 0x04, 0x6b, 0x50, 0x9e, 0xca, 0x67, 0x40, 0xf2, 0x59, 0x49, 0xe7, 0x13, 0xf9, 0xee,
+		0x46, 0xae, 0xa9, 0x2c, 0x66, 0x83, 0x8c, 0xf7, 0xe8, 0x2f, 0x06, 0x44, 0xa7, 0xef, 0x3b, 0x54,
+		0x50, 0x2b, 0x39, 0x40, 0x8b, 0x7e, 0xa2, 0x24, 0x8c, 0x82, 0x96, 0x4a, 0x66, 0x50, 0x92, 0xf9,
+		0x49, 0x2a, 0x99, 0xba, 0xc2, 0xfa, 0xa0, 0xa0, 0x5e, 0x26, 0x48, 0xa9, 0xdc, 0x16, 0x89, 0xbe,
+		0x39, 0xfa, 0xbd, 0x01, 0x8b, 0xfb, 0x98, 0x39, 0xba, 0x34, 0x87, 0x24, 0xcd, 0x67, 0xa9, 0x34,
+		0x37, 0x30, 0x73, 0xf4, 0x28, 0xde, 0xd9, 0xd7, 0x33, 0x45, 0x7f, 0x35, 0x60, 0x99, 0xd3, 0x9f,
+		0x87, 0x34, 0x10, 0x16, 0xc1, 0x2e, 0xa1, 0x8e, 0x46, 0x9e, 0x0d, 0x67, 0x1c, 0xa5, 0x19, 0x83,
+		0xd5, 0x25, 0x56, 0xdf, 0x64, 0x5b, 0xe4, 0xfa, 0xe6, 0xe8, 0x97, 0x30, 0xaf, 0x28, 0xf6, 0x4e,
+		0xb9, 0x82, 0xa4, 0xb6, 0x9a, 0x1e, 0x65, 0xe9, 0xdc, 0x3b, 0xe7, 0x6e, 0x92, 0x2c, 0x03, 0xf4,
+		0x27, 0x03, 0x96, 0xd4, 0xfe, 0x9a, 0xb1, 0x1c, 0x91, 0x64, 0x9e, 0x67, 0x90, 0xd1, 0x8b, 0xe6,
+		0x3d, 0xa2, 0x6b, 0x8c, 0xfe, 0x6b, 0xc0, 0xf3, 0xae, 0x78, 0xd2, 0x4f, 0x82, 0x72, 0x17, 0x6b,
+		0xb3, 0x2e, 0x4a, 0xd6, 0x6f, 0xfa, 0x47, 0xf7, 0xa5, 0x02, 0xd6, 0xfb, 0x88, 0xc7, 0xfc, 0x9c,
+		0xbe, 0xe8, 0xb3, 0x01, 0xb7, 0x39, 0x25, 0x1e, 0xb7, 0xad, 0x06, 0xe6, 0x47, 0x3d, 0x22, 0x5f,
+		0x92, 0xb4, 0x1f, 0xf4, 0xa0, 0x1d, 0x79, 0xbf, 0x91, 0xce, 0xa9, 0xe4, 0x6e, 0xf1, 0x4c, 0x0b,
+		0xf4, 0x2f, 0x03, 0x1e, 0x11, 0xcf, 0x15, 0xcc, 0x0d, 0xa9, 0x85, 0x03, 0xcb, 0xa5, 0x27, 0xba,
+		0xc7, 0x09, 0x92, 0xd7, 0xcb, 0x1e, 0x75, 0x27, 0x86, 0x5c, 0x0b, 0xb6, 0xe8, 0x89, 0xde, 0x31,
+		0x2e, 0x93, 0x9c, 0x3e, 0xe8, 0xef, 0x06, 0xac, 0xc6, 0x95, 0x9a, 0x1c, 0x32, 0xc7, 0xd6, 0xe5,
+		0x3d, 0x2a, 0x79, 0xaf, 0xf7, 0x2e, 0xde, 0xf5, 0x08, 0x4d, 0x8f, 0xf4, 0x52, 0x90, 0xc7, 0x01,
+		0xfd, 0xdb, 0x80, 0x47, 0x01, 0x3b, 0x88, 0x72, 0x36, 0x6f, 0xf2, 0x96, 0x25, 0xeb, 0x8d, 0x74,
+		0xd6, 0x12, 0x32, 0x5f, 0xd6, 0xae, 0x04, 0x79, 0x9d, 0xd0, 0x3f, 0x0d, 0xf8, 0x51, 0xe8, 0x07,
+		0x94, 0x8b, 0x36, 0xe9, 0x80, 0x62, 0x4e, 0x0e, 0x3b, 0x88, 0xa6, 0x92, 0x1f, 0xcb, 0x48, 0x95,
+		0x77, 0x12, 0x31, 0xd9, 0x7f, 0x47, 0xe2, 0xb5, 0x37, 0x4d, 0x4f, 0x95, 0x30, 0xa7, 0x8f, 0xd4,
+		0x1b, 0x98, 0x10, 0xea, 0x77, 0x50, 0x0f, 0x7d, 0x1b, 0x0b, 0x9a, 0xca, 0xf7, 0x52, 0x86, 0xde,
+		0x58, 0x93, 0x30, 0xc9, 0xde, 0xef, 0x24, 0x48, 0xba, 0xde, 0xc0, 0x5a, 0x96, 0x92, 0x1b, 0xa7,
+		0x1f, 0x29, 0xd1, 0xe3, 0x76, 0x39, 0x83, 0x9b, 0x29, 0x61, 0x74, 0xb8, 0x71, 0x2d, 0x4b, 0xf4,
+		0x47, 0x03, 0x7e, 0x78, 0x56, 0x8f, 0x64, 0xb0, 0x1b, 0x97, 0xec, 0x7e, 0xac, 0x25, 0x46, 0x32,
+		0xf8, 0xdd, 0x25, 0x9a, 0xb6, 0xeb, 0x65, 0x80, 0xf6, 0xf6, 0x95, 0xdf, 0x14, 0x60, 0x41, 0x4f,
+		0x10, 0xa2, 0x39, 0x18, 0x6d, 0x09, 0x02, 0x66, 0x4b, 0x89, 0x59, 0x32, 0x21, 0x59, 0xda, 0xb4,
+		0xd1, 0x06, 0x8c, 0xb5, 0x15, 0x43, 0xd3, 0xa7, 0x4a, 0xf5, 0xdd, 0xee, 0x91, 0x15, 0x6a, 0xb3,
+		0xa6, 0x4f, 0xcd, 0x32, 0xee, 0x78, 0x42, 0xd3, 0x50, 0xb0, 0xbd, 0x06, 0x66, 0xae, 0x54, 0x6a,
+		0x25, 0x53, 0x3d, 0xa1, 0x27, 0x50, 0x92, 0x42, 0x24, 0xd2, 0xd1, 0x4a, 0x1d, 0xdd, 0x4c, 0xc5,
+		0x8e, 0x3e, 0xe0, 0x35, 0x0b, 0x84, 0x59, 0x14, 0xea, 0x2f, 0xb4, 0x0a, 0xc3, 0xcc, 0xf5, 0x43,
+		0xa1, 0x14, 0xcb, 0x6c, 0xaa, 0xdf, 0x36, 0x6e, 0x3a, 0x1e, 0xb6, 0xcd, 0xd8, 0x14, 0xed, 0xc2,
+		0x4c, 0x4b, 0x72, 0x0b, 0xcf, 0x22, 0x8e, 0x17, 0x50, 0x29, 0x38, 0xbc, 0x50, 0x28, 0x79, 0x31,
+		0x53, 0x8d, 0xc7, 0x85, 0x6a, 0x32, 0x2e, 0x54, 0x5f, 0xa8, 0x71, 0xc1, 0x9c, 0x4e, 0x7c, 0x77,
+		0xbd, 0x7a, 0xe4, 0xb9, 0x1b, 0x3b, 0x76, 0xa3, 0xb6, 0x95, 0x73, 0x84, 0x3a, 0x92, 0x03, 0xb5,
+		0xa5, 0x9b, 0x23, 0xd4, 0x2d, 0x98, 0x56, 0x48, 0xdd, 0x44, 0x8b, 0xfd, 0x20, 0x27, 0x63, 0x81,
+		0x7d, 0x9a, 0xe5, 0x06, 0x4c, 0x1c, 0x52, 0xcc, 0xc5, 0x1e, 0xc5, 0x6d, 0x76, 0xa5, 0x7e, 0x50,
+		0xe3, 0x2d, 0x9f, 0x04, 0xa7, 0x0e, 0x65, 0x4e, 0x05, 0x6f, 0x5a, 0xbe, 0xe7, 0x30, 0xd2, 0x54,
+		0xbd, 0x64, 0xbe, 0xc7, 0x3f, 0xa3, 0xe0, 0xcd, 0x6d, 0x69, 0x67, 0x8e, 0xf2, 0xf6, 0x03, 0x7a,
+		0x00, 0x85, 0x43, 0x8a, 0x6d, 0xca, 0x55, 0x51, 0xbf, 0x91, 0xea, 0xfe, 0x4a, 0x9a, 0x98, 0xca,
+		0x14, 0x3d, 0x84, 0xe9, 0x44, 0xfe, 0x38, 0x1e, 0xc1, 0x8e, 0x65, 0xb3, 0xc0, 0xc7, 0x82, 0x1c,
+		0xca, 0xe2, 0x5a, 0x34, 0xa7, 0xd4, 0xdb, 0xd7, 0xd1, 0xcb, 0x17, 0xea, 0x5d, 0xe5, 0xd7, 0x06,
+		0xcc, 0x66, 0x0d, 0x24, 0x68, 0x06, 0x8a, 0xb1, 0xe6, 0x6c, 0xfd, 0x0b, 0x8c, 0xc8, 0xe7, 0x4d,
+		0x1b, 0xbd, 0x86, 0x2b, 0xad, 0x18, 0xec, 0x33, 0xde, 0x0e, 0xc1, 0x40, 0xbf, 0x73, 0x43, 0x2a,
+		0x04, 0x1b, 0x8c, 0x27, 0x11, 0xa8, 0x10, 0x58, 0xcc, 0x31, 0x8c, 0xa0, 0x87, 0x50, 0xe0, 0x34,
+		0x08, 0x1d, 0xa1, 0x66, 0xbf, 0xec, 0x0c, 0x57, 0xb6, 0x15, 0x0c, 0x77, 0x34, 0x47, 0x09, 0xf4,
+		0x08, 0x46, 0xa2, 0x51, 0x22, 0xe4, 0x34, 0x73, 0x87, 0x8d, 0xd8, 0xc6, 0x4c, 0x8c, 0x2b, 0x5b,
+		0xb0, 0x98, 0x63, 0x12, 0xe8, 0x5b, 0x65, 0x2a, 0x4f, 0xe0, 0x66, 0xa6, 0x7c, 0xcf, 0x88, 0x50,
+		0x85, 0xc0, 0x3d, 0x6d, 0xb5, 0x1d, 0x7d, 0xb0, 0x4d, 0x05, 0x66, 0x4e, 0xa0, 0x75, 0xa4, 0x89,
+		0x71, 0xe5, 0xff, 0x06, 0x3c, 0x3e, 0xaf, 0x3a, 0xee, 0xa8, 0x7d, 0xc6, 0xa9, 0xda, 0xf7, 0x0e,
+		0xd0, 0x59, 0xdd, 0xa3, 0x12, 0x6b, 0x21, 0x95, 0xd7, 0x99, 0xdd, 0xcc, 0x89, 0x93, 0xee, 0x25,
+		0x74, 0x0d, 0x46, 0x22, 0x15, 0xc9, 0x3d, 0x47, 0xd6, 0xda, 0xb2, 0x99, 0x3c, 0xa2, 0x2a, 0x4c,
+		0x76, 0x89, 0x44, 0xcf, 0x75, 0x9a, 0xb2, 0xec, 0x16, 0xcd, 0x09, 0xd2, 0x29, 0xe0, 0xde, 0xba,
+		0x4e, 0xb3, 0xf2, 0x37, 0x03, 0x6e, 0x65, 0x8b, 0xeb, 0x28, 0xb4, 0x4a, 0xb5, 0xbb, 0xb8, 0x41,
+		0x93, 0xd0, 0xc6, 0x4b, 0x5b, 0xb8, 0x41, 0x3b, 0x4f, 0x7c, 0x20, 0xc7, 0x89, 0x77, 0xd4, 0x87,
+		0x41, 0xed, 0xfa, 0x50, 0xf9, 0x0c, 0xb0, 0x9c, 0x57, 0x75, 0x47, 0x2d, 0xae, 0x75, 0x1e, 0xb2,
+		0xc5, 0x19, 0x19, 0x2d, 0x2e, 0x01, 0x8c, 0x5b, 0xdc, 0x49, 0xc7, 0xd3, 0xe9, 0x56, 0x36, 0x70,
+		0xce, 0x56, 0x36, 0xa8, 0xdf, 0xca, 0x30, 0xcc, 0xb7, 0xd5, 0x72, 0x8f, 0x46, 0x31, 0xd4, 0xaf,
+		0x4a, 0xcd, 0xb6, 0x20, 0x76, 0x52, 0x3a, 0xc6, 0x07, 0xb8, 0x21, 0x3f, 0xa9, 0x07, 0xfa, 0x70,
+		0x3f, 0xf4, 0xab, 0x91, 0x77, 0x1a, 0xf0, 0x5b, 0x98, 0xde, 0xc3, 0xe4, 0xc8, 0xdb, 0xdf, 0x57,
+		0xd8, 0xcc, 0x15, 0x94, 0x1f, 0x63, 0xa7, 0x7f, 0x0f, 0x9e, 0x52, 0x8e, 0x12, 0x76, 0x53, 0xb9,
+		0x9d, 0xe9, 0x49, 0x23, 0xe7, 0xe9, 0x49, 0x9b, 0x50, 0x62, 0x2e, 0x13, 0x0c, 0x0b, 0x8f, 0xcb,
+		0x1e, 0x7b, 0x69, 0x75, 0xb1, 0xff, 0x64, 0xb7, 0x99, 0xb8, 0x98, 0x6d, 0xef, 0xce, 0xca, 0x5a,
+		0xca, 0x51, 0x59, 0x91, 0x09, 0xd3, 0x0e, 0x8e, 0xa6, 0xfb, 0xb8, 0x4d, 0x44, 0xa1, 0x55, 0x2d,
+		0x00, 0x34, 0x32, 0x63, 0x2a, 0xf2, 0xad, 0xb7, 0x5c, 0x4d, 0xe9, 0x89, 0xbe, 0x0f, 0x63, 0x84,
+		0x47, 0x39, 0xa2, 0x64, 0x86, 0x6c, 0xd8, 0x25, 0xb3, 0x1c, 0x2d, 0x26, 0x3a, 0xf1, 0x7c, 0xfd,
+		0x78, 0x09, 0x86, 0x1a, 0xb4, 0xe1, 0xa9, 0xd1, 0x66, 0x26, 0xd5, 0xe5, 0x0d, 0x6d, 0x78, 0xa6,
+		0x34, 0x43, 0x26, 0x4c, 0x9c, 0x19, 0x95, 0xd4, 0x98, 0xf1, 0x83, 0xf4, 0x99, 0xae, 0x6b, 0xa8,
+		0x31, 0xc7, 0x83, 0xae, 0x15, 0xf4, 0x0c, 0xca, 0x1f, 0x99, 0x10, 0x94, 0xc7, 0x89, 0xa4, 0x26,
+		0x83, 0x8c, 0xfc, 0x19, 0x8d, 0xcd, 0x65, 0xfa, 0xa0, 0xf7, 0x30, 0x29, 0x8f, 0xc6, 0x3b, 0xa6,
+		0xdc, 0xc1, 0x7e, 0x92, 0x3d, 0xe3, 0x32, 0xf6, 0xe9, 0x35, 0xb8, 0xce, 0x3d, 0xf7, 0x6d, 0x6c,
+		0xae, 0x72, 0x68, 0x82, 0x74, 0x2f, 0xa1, 0x4f, 0x30, 0x27, 0xdb, 0x1b, 0xb5, 0x88, 0x13, 0x06,
+		0x92, 0x1d, 0x75, 0x28, 0x91, 0xf1, 0x54, 0x7b, 0x4c, 0x64, 0x5c, 0x9f, 0xca, 0x7e, 0x4a, 0xeb,
+		0xb1, 0xeb, 0x4e, 0xe2, 0xa9, 0xb6, 0x9b, 0xc5, 0x19, 0x6f, 0x2b, 0x7f, 0x2e, 0xc1, 0x52, 0xae,
+		0x01, 0xbe, 0x67, 0x7b, 0x9a, 0x83, 0xd1, 0x56, 0x5d, 0x64, 0xb6, 0xac, 0x68, 0x25, 0x13, 0x92,
+		0xa5, 0x78, 0x36, 0x38, 0x5d, 0x38, 0x07, 0xbf, 0x40, 0xe1, 0xfc, 0x0a, 0x33, 0x80, 0x4e, 0xe1,
+		0x2c, 0x7c, 0xab, 0x85, 0x73, 0xe4, 0xdc, 0x85, 0xf3, 0x3d, 0x4c, 0xfa, 0x98, 0x53, 0x57, 0x28,
+		0x44, 0x95, 0x4c, 0xc5, 0x8c, 0x84, 0xdd, 0x96, 0xf6, 0x12, 0x25, 0x49, 0x58, 0xbf, 0x7b, 0xa9,
+		0x53, 0x34, 0x94, 0x4e, 0x8b, 0x06, 0x02, 0xd7, 0x3a, 0xd2, 0xc0, 0xe2, 0x34, 0x6c, 0x6f, 0x0b,
+		0x72, 0xdb, 0xfb, 0x99, 0x01, 0xdf, 0xb4, 0xcd, 0xc8, 0x45, 0x6d, 0x7d, 0xe5, 0x24, 0x6d, 0xf9,
+		0xcb, 0x8c, 0x14, 0x67, 0xea, 0x5c, 0x39, 0xb3, 0xce, 0x8d, 0xe5, 0xaf, 0x73, 0x97, 0x2e, 0x50,
+		0xe7, 0x2e, 0x5f, 0xac, 0xce, 0x7d, 0xf7, 0x2a, 0xd5, 0x7f, 0x06, 0x60, 0x25, 0xf7, 0xa5, 0xdd,
+		0xd7, 0x16, 0xd3, 0x73, 0x30, 0xaa, 0xee, 0x2a, 0xa5, 0xbe, 0x8d, 0x2f, 0x2f, 0x20, 0x5e, 0x92,
+		0xfa, 0xb6, 0x55, 0x80, 0x86, 0xf4, 0x0b, 0x50, 0xc7, 0x3f, 0xdb, 0xb0, 0x96, 0x42, 0x2f, 0xf4,
+		0x52, 0xe8, 0xbf, 0x32, 0x60, 0x39, 0xef, 0xdd, 0x61, 0x7a, 0x7a, 0x1a, 0x17, 0x4a, 0xcf, 0xca,
+		0xef, 0x0c, 0x58, 0xd0, 0xbb, 0x14, 0x44, 0x37, 0xa0, 0xa4, 0xee, 0xce, 0x5a, 0xc3, 0x5c, 0x31,
+		0x5e, 0xd8, 0xb4, 0xa3, 0xf3, 0x56, 0x2f, 0xe5, 0x79, 0xab, 0xa6, 0x13, 0x2f, 0x9d, 0x3e, 0x6f,
+		0x7d, 0xa5, 0x5c, 0xf9, 0x83, 0x01, 0x0b, 0x7a, 0xb7, 0x82, 0x17, 0x24, 0xd7, 0xa1, 0xfa, 0x06,
+		0xf3, 0xcc, 0xd3, 0xff, 0x30, 0xe0, 0xae, 0xee, 0xc5, 0x60, 0x36, 0xc5, 0xf6, 0x95, 0xc1, 0x80,
+		0xfe, 0x95, 0xc1, 0x79, 0x79, 0xaf, 0xff, 0x0c, 0xae, 0x12, 0xaf, 0x91, 0x66, 0xbb, 0x3e, 0x96,
+		0x30, 0xdf, 0x8e, 0x9a, 0xdb, 0xb6, 0xf1, 0xd3, 0x95, 0x03, 0x26, 0x0e, 0xc3, 0xbd, 0x2a, 0xf1,
+		0x1a, 0xb5, 0xce, 0x5f, 0xca, 0x97, 0x98, 0xed, 0xd4, 0x0e, 0xbc, 0xf8, 0xc7, 0x79, 0xf5, 0xb3,
+		0xf9, 0x53, 0xec, 0xb3, 0xe3, 0x95, 0xbd, 0x82, 0x5c, 0x7b, 0xf0, 0x4d, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x3f, 0xc0, 0x8b, 0x9c, 0xf9, 0x1f, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/cluster.proto
 	[]byte{
@@ -312,6 +312,7 @@ type PollForDecisionTaskResponse struct {
 	PartitionConfig           *TaskListPartitionConfig     `protobuf:"bytes,19,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	LoadBalancerHints         *LoadBalancerHints           `protobuf:"bytes,20,opt,name=load_balancer_hints,json=loadBalancerHints,proto3" json:"load_balancer_hints,omitempty"`
 	AutoConfigHint            *v1.AutoConfigHint           `protobuf:"bytes,21,opt,name=auto_config_hint,json=autoConfigHint,proto3" json:"auto_config_hint,omitempty"`
+	Updates                   []*v1.WorkflowUpdate         `protobuf:"bytes,22,rep,name=updates,proto3" json:"updates,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                     `json:"-"`
 	XXX_unrecognized          []byte                       `json:"-"`
 	XXX_sizecache             int32                        `json:"-"`
@@ -497,6 +498,13 @@ func (m *PollForDecisionTaskResponse) GetAutoConfigHint() *v1.AutoConfigHint {
 	return nil
 }
 
+func (m *PollForDecisionTaskResponse) GetUpdates() []*v1.WorkflowUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
 type PollForActivityTaskRequest struct {
 	Request              *v1.PollForActivityTaskRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -776,6 +784,7 @@ type AddDecisionTaskRequest struct {
 	Source                 v11.TaskSource        `protobuf:"varint,6,opt,name=source,proto3,enum=uber.cadence.shared.v1.TaskSource" json:"source,omitempty"`
 	ForwardedFrom          string                `protobuf:"bytes,7,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	PartitionConfig        map[string]string     `protobuf:"bytes,8,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TaskPriority           v1.TaskPriority       `protobuf:"varint,9,opt,name=task_priority,json=taskPriority,proto3,enum=uber.cadence.api.v1.TaskPriority" json:"task_priority,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
 	XXX_unrecognized       []byte                `json:"-"`
 	XXX_sizecache          int32                 `json:"-"`
@@ -870,6 +879,13 @@ func (m *AddDecisionTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddDecisionTaskRequest) GetTaskPriority() v1.TaskPriority {
+	if m != nil {
+		return m.TaskPriority
+	}
+	return v1.TaskPriority_TASK_PRIORITY_INVALID
+}
+
 type AddDecisionTaskResponse struct {
 	PartitionConfig      *TaskListPartitionConfig `protobuf:"bytes,1,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
@@ -928,6 +944,7 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom            string                    `protobuf:"bytes,8,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	ActivityTaskDispatchInfo *ActivityTaskDispatchInfo `protobuf:"bytes,9,opt,name=activityTaskDispatchInfo,proto3" json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig          map[string]string         `protobuf:"bytes,10,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TaskPriority             v1.TaskPriority           `protobuf:"varint,11,opt,name=task_priority,json=taskPriority,proto3,enum=uber.cadence.api.v1.TaskPriority" json:"task_priority,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{}                  `json:"-"`
 	XXX_unrecognized         []byte                    `json:"-"`
 	XXX_sizecache            int32                     `json:"-"`
@@ -1036,6 +1053,13 @@ func (m *AddActivityTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddActivityTaskRequest) GetTaskPriority() v1.TaskPriority {
+	if m != nil {
+		return m.TaskPriority
+	}
+	return v1.TaskPriority_TASK_PRIORITY_INVALID
+}
+
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent             *v1.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
 	StartedTime                *types.Timestamp `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
@@ -2135,165 +2159,169 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0x57, 0x8f, 0x3d, 0x7e, 0x7c, 0x63, 0x8f, 0xed, 0xb2, 0xd7, 0xe9, 0x4c, 0x62, 0xc7, 0x99,
-	0x6c, 0xb2, 0x5e, 0x58, 0xc6, 0x6b, 0xef, 0x26, 0x64, 0xb3, 0x62, 0x83, 0x1f, 0x71, 0x32, 0x68,
-	0x43, 0xb2, 0x1d, 0x6f, 0x22, 0xc1, 0x2a, 0x4d, 0x79, 0xba, 0xec, 0x69, 0xdc, 0xd3, 0xdd, 0xe9,
-	0xae, 0xb6, 0x77, 0xf6, 0xc0, 0x01, 0x01, 0x42, 0xe2, 0x0a, 0x77, 0x5e, 0xff, 0x04, 0x17, 0xce,
-	0x1c, 0x39, 0x22, 0xad, 0x90, 0x20, 0x12, 0x7f, 0x00, 0x48, 0xdc, 0x38, 0xa0, 0x7a, 0xf4, 0x4c,
-	0xf7, 0x4c, 0xf5, 0x3c, 0x6c, 0x27, 0xcb, 0x81, 0x9b, 0xbb, 0xea, 0x7b, 0xd5, 0xf7, 0xfa, 0x7d,
-	0x55, 0x63, 0xb8, 0x11, 0xed, 0x93, 0x60, 0xad, 0x86, 0x2d, 0xe2, 0xd6, 0xc8, 0x5a, 0x03, 0xd3,
-	0x5a, 0xdd, 0x76, 0x0f, 0xd7, 0x8e, 0xd7, 0xd7, 0x42, 0x12, 0x1c, 0xdb, 0x35, 0x52, 0xf1, 0x03,
-	0x8f, 0x7a, 0x48, 0x67, 0x74, 0x15, 0x49, 0x57, 0x89, 0xe9, 0x2a, 0xc7, 0xeb, 0xa5, 0xe5, 0x43,
-	0xcf, 0x3b, 0x74, 0xc8, 0x1a, 0xa7, 0xdb, 0x8f, 0x0e, 0xd6, 0xac, 0x28, 0xc0, 0xd4, 0xf6, 0x5c,
-	0xc1, 0x59, 0xba, 0xd2, 0xb9, 0x4f, 0xed, 0x06, 0x09, 0x29, 0x6e, 0xf8, 0x92, 0xa0, 0x4b, 0xc0,
-	0x49, 0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x49, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0x57, 0xf3,
-	0x1a, 0x8d, 0xb6, 0x0a, 0x15, 0xc5, 0x8b, 0x88, 0x04, 0x4d, 0x49, 0x50, 0x56, 0x11, 0x50, 0x1c,
-	0x1e, 0x39, 0x76, 0x48, 0x25, 0xcd, 0xaa, 0x8a, 0x46, 0x3a, 0xc1, 0x3c, 0xf1, 0x82, 0x23, 0x12,
-	0x48, 0xca, 0xaf, 0xf5, 0xa3, 0x3c, 0x70, 0xbc, 0x13, 0x49, 0x7b, 0x55, 0x45, 0x5b, 0xb7, 0x43,
-	0xea, 0xb5, 0x8c, 0x7b, 0x33, 0x45, 0x12, 0xd6, 0x71, 0x40, 0xac, 0x6e, 0xaa, 0xeb, 0x19, 0x54,
-	0xe9, 0x53, 0x94, 0x3f, 0x82, 0xb9, 0x3d, 0x1c, 0x1e, 0x7d, 0x6c, 0x87, 0xf4, 0x31, 0x0e, 0xa8,
-	0xcd, 0x02, 0x81, 0xde, 0x86, 0x59, 0x3b, 0xf4, 0x1c, 0x1e, 0x15, 0xf3, 0x30, 0xf0, 0x22, 0x3f,
-	0xd4, 0xb5, 0x95, 0x91, 0xd5, 0x49, 0x63, 0xa6, 0xb5, 0x7e, 0x9f, 0x2f, 0x97, 0xff, 0x3e, 0x0a,
-	0x17, 0xba, 0x04, 0x6c, 0x7b, 0xee, 0x81, 0x7d, 0x88, 0x74, 0x18, 0x3f, 0x26, 0x41, 0x68, 0x7b,
-	0xae, 0xae, 0xad, 0x68, 0xab, 0x23, 0x46, 0xfc, 0x89, 0x36, 0x60, 0xde, 0x8d, 0x1a, 0x66, 0x40,
-	0xb0, 0x65, 0xfa, 0x31, 0x57, 0xa8, 0xe7, 0x56, 0xb4, 0xd5, 0xfc, 0x56, 0x4e, 0xd7, 0x8c, 0x39,
-	0x37, 0x6a, 0x18, 0x04, 0x5b, 0x2d, 0x91, 0x21, 0x7a, 0x1f, 0x16, 0x18, 0xcf, 0x49, 0x60, 0x53,
-	0x92, 0x64, 0x1a, 0x69, 0x31, 0x21, 0x37, 0x6a, 0x3c, 0x63, 0xdb, 0x09, 0x2e, 0x17, 0x66, 0x3a,
-	0xb5, 0x8c, 0xae, 0x8c, 0xac, 0x16, 0x36, 0xee, 0x55, 0xb2, 0x32, 0xb4, 0x92, 0x71, 0x9e, 0x4a,
-	0xda, 0xa0, 0x7b, 0x2e, 0x0d, 0x9a, 0x46, 0x31, 0x48, 0x5b, 0xf9, 0x02, 0x66, 0xbb, 0x2c, 0xcc,
-	0x73, 0x85, 0xbb, 0xc3, 0x2b, 0xec, 0x38, 0x8c, 0xd0, 0x38, 0x73, 0x92, 0x5e, 0x2d, 0xb9, 0x30,
-	0xaf, 0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x11, 0x69, 0x72, 0xcf, 0xe7, 0x0d, 0xf6, 0x27, 0xda, 0x84,
-	0xfc, 0x31, 0x76, 0x22, 0xc2, 0xfd, 0x5c, 0xd8, 0xf8, 0xfa, 0x10, 0x06, 0x19, 0x82, 0xf3, 0x4e,
-	0xee, 0xb6, 0x56, 0xf2, 0x60, 0x41, 0x65, 0xd8, 0x2b, 0x53, 0x58, 0xfe, 0x01, 0xcc, 0x7d, 0xec,
-	0x61, 0x6b, 0x0b, 0x3b, 0xd8, 0xad, 0x91, 0xe0, 0x81, 0xed, 0xd2, 0x10, 0x5d, 0x83, 0xe9, 0x7d,
-	0x5c, 0x3b, 0x72, 0xbc, 0x43, 0xb3, 0xe6, 0x45, 0x2e, 0x95, 0x29, 0x36, 0x25, 0x17, 0xb7, 0xd9,
-	0x1a, 0xba, 0x01, 0x33, 0x01, 0x66, 0xc1, 0x20, 0x81, 0x19, 0x92, 0x9a, 0xe7, 0x5a, 0xdc, 0x14,
-	0xcd, 0x98, 0x66, 0xcb, 0x8f, 0x49, 0xf0, 0x84, 0x2f, 0x96, 0xff, 0xa9, 0x41, 0xe9, 0xb1, 0xe7,
-	0x38, 0xbb, 0x5e, 0xb0, 0x43, 0x6a, 0x36, 0xcb, 0x51, 0x66, 0x91, 0x41, 0x5e, 0x44, 0x24, 0xa4,
-	0xa8, 0x0a, 0xe3, 0x81, 0xf8, 0x93, 0x6b, 0x29, 0x6c, 0xac, 0xa5, 0x4f, 0x82, 0x7d, 0x9b, 0x1d,
-	0x22, 0x5b, 0x82, 0x11, 0xf3, 0xa3, 0x4b, 0x30, 0x69, 0x79, 0x0d, 0x6c, 0xbb, 0xa6, 0x2d, 0x6c,
-	0x99, 0x34, 0x26, 0xc4, 0x42, 0xd5, 0x62, 0x9b, 0xbe, 0xe7, 0x38, 0x24, 0x60, 0x9b, 0x23, 0x62,
-	0x53, 0x2c, 0x54, 0x2d, 0x74, 0x1d, 0x8a, 0x07, 0x5e, 0x70, 0x82, 0x03, 0x8b, 0x58, 0xe6, 0x41,
-	0xe0, 0x35, 0xf4, 0x51, 0x4e, 0x31, 0xdd, 0x5a, 0xdd, 0x0d, 0xbc, 0x06, 0x7a, 0x0b, 0x66, 0x3a,
-	0x6a, 0x57, 0xcf, 0x73, 0xba, 0x62, 0xba, 0x74, 0xcb, 0x7f, 0x2c, 0xc0, 0x25, 0xa5, 0xc5, 0xa1,
-	0xef, 0xb9, 0x21, 0x41, 0x4b, 0x00, 0xac, 0x57, 0x98, 0xd4, 0x3b, 0x22, 0xa2, 0x80, 0xa7, 0x8c,
-	0x49, 0xb6, 0xb2, 0xc7, 0x16, 0xd0, 0xa7, 0x80, 0xe2, 0xd6, 0x65, 0x92, 0xcf, 0x49, 0x2d, 0x62,
-	0x92, 0x65, 0xa0, 0x6f, 0x28, 0xdd, 0xf3, 0x4c, 0x92, 0xdf, 0x8b, 0xa9, 0x8d, 0xb9, 0x93, 0xce,
-	0x25, 0xb4, 0x0b, 0xd3, 0x2d, 0xb1, 0xb4, 0xe9, 0x13, 0xee, 0x86, 0xc2, 0xc6, 0xd5, 0x9e, 0x12,
-	0xf7, 0x9a, 0x3e, 0x31, 0xa6, 0x4e, 0x12, 0x5f, 0xe8, 0x29, 0x5c, 0xf4, 0x03, 0x72, 0x6c, 0x7b,
-	0x51, 0x68, 0x86, 0x14, 0x07, 0x94, 0x58, 0x26, 0x39, 0x26, 0x2e, 0x65, 0xae, 0x1d, 0xe5, 0x32,
-	0x2f, 0x55, 0x04, 0x90, 0x54, 0x62, 0x20, 0xa9, 0x54, 0x5d, 0x7a, 0xeb, 0xfd, 0xa7, 0x2c, 0xef,
-	0x8c, 0xc5, 0x98, 0xfb, 0x89, 0x60, 0xbe, 0xc7, 0x78, 0xab, 0x16, 0x5a, 0x85, 0xd9, 0x2e, 0x71,
-	0x79, 0x9e, 0x79, 0xc5, 0x30, 0x4d, 0xa9, 0xc3, 0x38, 0xa6, 0x94, 0x34, 0x7c, 0xaa, 0x8f, 0xf1,
-	0x92, 0x88, 0x3f, 0x51, 0x19, 0xa6, 0x5d, 0xf2, 0x39, 0x6d, 0x0b, 0x18, 0xe7, 0x02, 0x0a, 0x6c,
-	0x31, 0xe6, 0x7e, 0x07, 0x50, 0x2a, 0xbd, 0xcd, 0xba, 0xed, 0x52, 0x7d, 0x82, 0x13, 0xce, 0x26,
-	0x73, 0x9c, 0x55, 0x03, 0xba, 0x0d, 0x7a, 0x48, 0xed, 0xda, 0x51, 0xb3, 0x1d, 0x0a, 0x93, 0xb8,
-	0x78, 0xdf, 0x21, 0x96, 0x3e, 0xb9, 0xa2, 0xad, 0x4e, 0x18, 0x8b, 0x62, 0xbf, 0xe5, 0xe8, 0x7b,
-	0x62, 0x17, 0xdd, 0x86, 0x3c, 0x07, 0x3e, 0x1d, 0xb8, 0x4f, 0xca, 0x3d, 0xfd, 0xfc, 0x09, 0xa3,
-	0x34, 0x04, 0x03, 0x32, 0x60, 0xda, 0x92, 0x79, 0x63, 0xda, 0xee, 0x81, 0xa7, 0x17, 0xb8, 0x84,
-	0x6f, 0xa4, 0x25, 0x08, 0xe0, 0xe1, 0x25, 0x1e, 0x60, 0x37, 0xb4, 0x89, 0x4b, 0xe3, 0x6c, 0xab,
-	0xba, 0x07, 0x9e, 0x31, 0x65, 0x25, 0xbe, 0xd0, 0x73, 0xb8, 0xdc, 0x9d, 0x54, 0x26, 0x4f, 0x43,
-	0x86, 0x59, 0xfa, 0x14, 0x57, 0xb1, 0xa4, 0x34, 0x32, 0x6e, 0x21, 0xc6, 0xc5, 0xae, 0xac, 0x8a,
-	0xb7, 0x50, 0x05, 0xe6, 0x85, 0xd3, 0x19, 0x52, 0x12, 0x33, 0x46, 0xa7, 0x69, 0x1e, 0x9f, 0x39,
-	0xbe, 0xf5, 0x84, 0xed, 0x3c, 0x95, 0x38, 0x75, 0x15, 0xa6, 0xf6, 0x03, 0xec, 0xd6, 0xea, 0xb2,
-	0x0a, 0x8a, 0xbc, 0x0a, 0x0a, 0x62, 0x4d, 0xd4, 0xc1, 0x26, 0x14, 0xc3, 0x5a, 0x9d, 0x58, 0x91,
-	0x43, 0x2c, 0x93, 0x8d, 0x2a, 0xfa, 0x0c, 0x37, 0xb2, 0xd4, 0x95, 0x5d, 0x7b, 0xf1, 0x1c, 0x63,
-	0x4c, 0xb7, 0x38, 0xd8, 0x1a, 0xfa, 0x16, 0x4c, 0xc5, 0x39, 0xc5, 0x05, 0xcc, 0xf6, 0x15, 0x50,
-	0x90, 0xf4, 0x9c, 0xfd, 0x33, 0x18, 0x67, 0x11, 0xb1, 0x49, 0xa8, 0xcf, 0x71, 0xa4, 0xd9, 0xca,
-	0xee, 0xb3, 0x3d, 0x0a, 0xbe, 0xf2, 0x89, 0x10, 0x22, 0x50, 0x26, 0x16, 0xc9, 0x5c, 0x46, 0x3d,
-	0x8a, 0x1d, 0x53, 0x8e, 0x17, 0xe6, 0x7e, 0x93, 0x92, 0x50, 0x47, 0x3c, 0x13, 0xe7, 0xf8, 0xd6,
-	0x03, 0xb1, 0xb3, 0xc5, 0x36, 0xd0, 0x67, 0x30, 0xdb, 0x82, 0x3e, 0xb3, 0xc6, 0x71, 0x4c, 0x9f,
-	0xe7, 0x07, 0x5a, 0x1f, 0x1a, 0x00, 0x8d, 0x19, 0xbf, 0x63, 0xa4, 0xf8, 0x3e, 0xcc, 0x3b, 0x1e,
-	0xb6, 0xcc, 0x7d, 0x89, 0x05, 0xbc, 0x2c, 0x42, 0x7d, 0xa1, 0x1f, 0xbe, 0x74, 0xe1, 0x87, 0x31,
-	0xe7, 0x74, 0x41, 0xca, 0x43, 0x98, 0xc5, 0x11, 0xf5, 0xa4, 0xd5, 0xa2, 0xe2, 0xde, 0xe0, 0x92,
-	0xaf, 0x29, 0x33, 0x6e, 0x33, 0xa2, 0x9e, 0xb0, 0x8b, 0xf1, 0x1b, 0x45, 0x9c, 0xfa, 0x2e, 0x3d,
-	0x87, 0xa9, 0xa4, 0x4b, 0x93, 0xf8, 0x38, 0x29, 0xf0, 0xf1, 0x76, 0x1a, 0x1f, 0x07, 0x2a, 0xbe,
-	0x36, 0x2c, 0x26, 0x40, 0x6b, 0xb3, 0x46, 0xed, 0x63, 0x9b, 0x36, 0x4f, 0x0f, 0x5a, 0x0a, 0x09,
-	0xff, 0x8b, 0xa0, 0xf5, 0x2b, 0x68, 0x81, 0x56, 0xda, 0xe2, 0xaf, 0x14, 0xb4, 0xae, 0x40, 0x01,
-	0x4b, 0x6b, 0xda, 0x4e, 0x80, 0x78, 0xa9, 0x6a, 0x31, 0x54, 0x6b, 0x11, 0x70, 0x54, 0x1b, 0xed,
-	0x81, 0x6a, 0xad, 0x83, 0x71, 0x54, 0xc3, 0x89, 0x2f, 0xb4, 0x01, 0x79, 0xdb, 0xf5, 0x23, 0xca,
-	0xbd, 0x53, 0xd8, 0xb8, 0xac, 0x8e, 0x28, 0x6e, 0xb2, 0xdc, 0x36, 0x04, 0xa9, 0xa2, 0x41, 0x8d,
-	0x9d, 0xb5, 0x41, 0x8d, 0x0f, 0xd7, 0xa0, 0xf6, 0xe0, 0x62, 0x2c, 0xcf, 0x64, 0xe5, 0xe5, 0x78,
-	0x21, 0xe1, 0x82, 0xbc, 0x48, 0x40, 0x5a, 0x61, 0xe3, 0x62, 0x97, 0xac, 0x1d, 0x79, 0x2b, 0x34,
-	0x16, 0x63, 0xde, 0x3d, 0x6f, 0x9b, 0x71, 0xee, 0x09, 0x46, 0xf4, 0x5d, 0x58, 0xe4, 0x4a, 0xba,
-	0x45, 0x4e, 0xf6, 0x13, 0x39, 0xcf, 0x19, 0x3b, 0xe4, 0xed, 0xc2, 0x5c, 0x9d, 0xe0, 0x80, 0xee,
-	0x13, 0x4c, 0x5b, 0xa2, 0xa0, 0x9f, 0xa8, 0xd9, 0x16, 0x4f, 0x2c, 0x27, 0x81, 0xfb, 0x85, 0x34,
-	0xee, 0x3f, 0x87, 0xe5, 0x74, 0x24, 0x4c, 0xef, 0xc0, 0xa4, 0x75, 0x3b, 0x34, 0x63, 0x86, 0xa9,
-	0xbe, 0x8e, 0x2d, 0xa5, 0x22, 0xf3, 0xe8, 0x60, 0xaf, 0x6e, 0x87, 0x9b, 0x52, 0x7e, 0x35, 0x79,
-	0x02, 0x8b, 0x50, 0x6c, 0x3b, 0x21, 0xc7, 0xb6, 0x7e, 0x99, 0xd2, 0x3e, 0xc4, 0x8e, 0xe0, 0xea,
-	0x1e, 0xc3, 0x8a, 0xa7, 0x1b, 0xc3, 0xde, 0x82, 0x99, 0x96, 0x1c, 0xd1, 0x31, 0x38, 0x3c, 0x4e,
-	0x1a, 0xc5, 0x78, 0x79, 0x87, 0xaf, 0xa2, 0xf7, 0x60, 0xac, 0x4e, 0xb0, 0x45, 0x02, 0x89, 0x7e,
-	0x97, 0x94, 0x9a, 0x1e, 0x70, 0x12, 0x43, 0x92, 0x66, 0xa1, 0xc1, 0xdc, 0xb9, 0xa0, 0xc1, 0xab,
-	0x05, 0x32, 0x15, 0xd6, 0x2c, 0x9c, 0x1a, 0x6b, 0xca, 0x7f, 0x19, 0x85, 0xc5, 0x4d, 0xcb, 0x52,
-	0x5d, 0x5e, 0x52, 0xcd, 0x5b, 0xeb, 0x68, 0xde, 0xaf, 0xa8, 0x21, 0xde, 0x81, 0xc9, 0xf6, 0xd0,
-	0x36, 0x32, 0xc8, 0xd0, 0x36, 0x41, 0xe3, 0x19, 0xed, 0x0a, 0x14, 0x5a, 0xdd, 0x42, 0xce, 0xea,
-	0x23, 0x06, 0xc4, 0x4b, 0x55, 0xab, 0xb3, 0x9d, 0xc8, 0x26, 0x20, 0x0b, 0x36, 0x3f, 0x44, 0x3b,
-	0xe1, 0xa3, 0x7d, 0x5c, 0xb6, 0x77, 0x60, 0x2c, 0xf4, 0xa2, 0xa0, 0x26, 0xda, 0x63, 0xb1, 0x13,
-	0x8c, 0x13, 0x73, 0x2c, 0x0e, 0x8f, 0x9e, 0x70, 0x4a, 0x43, 0x72, 0x28, 0x50, 0x6e, 0x5c, 0x85,
-	0x72, 0xbe, 0x22, 0xa3, 0x26, 0xfa, 0x3d, 0x46, 0xa8, 0xa3, 0x5a, 0xe9, 0x48, 0x30, 0xf9, 0x34,
-	0xd0, 0x91, 0x65, 0xa5, 0x2d, 0x58, 0x50, 0x11, 0x2a, 0x46, 0x91, 0x85, 0xe4, 0x28, 0x32, 0x99,
-	0x1c, 0x33, 0x4e, 0xe0, 0x42, 0x97, 0x0d, 0x12, 0x6d, 0x55, 0x25, 0xa2, 0x9d, 0x57, 0x89, 0x94,
-	0xff, 0x95, 0xe7, 0x39, 0xad, 0x9a, 0x6d, 0xbe, 0x8a, 0x9c, 0x66, 0x37, 0x3f, 0x1e, 0x6e, 0xb3,
-	0xad, 0x5a, 0x20, 0x7d, 0x51, 0xac, 0xef, 0xc4, 0x06, 0xa4, 0xb2, 0x7f, 0xf4, 0x4c, 0xd9, 0x9f,
-	0x1f, 0x2e, 0xfb, 0xc7, 0xce, 0x9e, 0xfd, 0xe3, 0xe7, 0x90, 0xfd, 0x13, 0xaa, 0xec, 0x77, 0x41,
-	0xc7, 0x89, 0x50, 0xee, 0xd8, 0xa1, 0xcf, 0xb2, 0x82, 0xdd, 0xfb, 0x24, 0x62, 0x6f, 0xf4, 0xa8,
-	0x82, 0x0c, 0x4e, 0x23, 0x53, 0xa6, 0xb2, 0xda, 0x60, 0x80, 0x6a, 0x53, 0xe4, 0xdb, 0x6b, 0xac,
-	0xb6, 0x2f, 0x47, 0x40, 0xcf, 0x3a, 0x2c, 0xfa, 0x0e, 0xcc, 0xb4, 0x07, 0x08, 0x7e, 0x5b, 0x95,
-	0xe5, 0xa6, 0xc6, 0x65, 0x79, 0x2f, 0xe3, 0x4f, 0x0a, 0x46, 0x7b, 0x08, 0xe4, 0xdf, 0x5d, 0x33,
-	0x5d, 0x6e, 0xb8, 0x99, 0x2e, 0x31, 0xe5, 0x8c, 0x0c, 0x3b, 0xe5, 0x8c, 0x9e, 0xff, 0x94, 0x93,
-	0x3f, 0x9f, 0x29, 0x67, 0xec, 0xdc, 0xa6, 0x9c, 0x71, 0xd5, 0x94, 0x23, 0x7b, 0xa9, 0xf2, 0xe6,
-	0xf2, 0x6a, 0x7b, 0xe9, 0x97, 0x1a, 0x2c, 0xf0, 0x0b, 0x64, 0x7c, 0x8a, 0xb8, 0x93, 0x6e, 0x77,
-	0xde, 0x12, 0xdf, 0x56, 0x1e, 0x5e, 0xc5, 0x3b, 0xe0, 0xfd, 0xf0, 0x2c, 0xb3, 0xc0, 0x60, 0xd7,
-	0xc7, 0xf2, 0x7f, 0x34, 0x78, 0xa3, 0xc3, 0x42, 0xe9, 0xd5, 0xbb, 0x30, 0xc5, 0x5f, 0xab, 0xcc,
-	0x80, 0x84, 0x91, 0x13, 0x9f, 0xb1, 0x77, 0x9e, 0x14, 0x38, 0x87, 0xc1, 0x19, 0x50, 0x15, 0x8a,
-	0xb1, 0x80, 0x1f, 0x92, 0x1a, 0x25, 0x56, 0xcf, 0xbb, 0xba, 0xb8, 0xa3, 0x4b, 0x4a, 0x63, 0xfa,
-	0x45, 0xf2, 0x13, 0x3d, 0x53, 0x44, 0x58, 0xf8, 0xe3, 0x9d, 0x9e, 0xfe, 0xe8, 0x1b, 0xdc, 0x7f,
-	0x68, 0xb0, 0x22, 0x4e, 0x6c, 0x71, 0x03, 0x18, 0xe3, 0xb6, 0xd7, 0xf0, 0x1d, 0xc2, 0xac, 0x90,
-	0x31, 0x7a, 0xd4, 0x19, 0xe8, 0x9b, 0x4a, 0xa5, 0xfd, 0xe4, 0xbc, 0x86, 0xa0, 0x5f, 0x80, 0x71,
-	0xce, 0x2b, 0x87, 0xbf, 0x49, 0x63, 0x8c, 0x7d, 0x56, 0xad, 0xf2, 0x35, 0xb8, 0xda, 0xc3, 0x3c,
-	0x11, 0xf1, 0xf2, 0x5f, 0x35, 0xb8, 0xbc, 0xcd, 0xc6, 0x78, 0xe7, 0x51, 0x44, 0x43, 0x8a, 0x5d,
-	0xcb, 0x76, 0x0f, 0x1f, 0x7b, 0x8e, 0x33, 0xd0, 0xec, 0x90, 0x7a, 0xcc, 0xc8, 0x75, 0x3c, 0x66,
-	0xdc, 0x87, 0x62, 0xeb, 0x50, 0xed, 0xc7, 0xe9, 0x62, 0x46, 0xbf, 0x88, 0x4f, 0x26, 0xfa, 0x05,
-	0x4d, 0x7c, 0x9d, 0x65, 0x40, 0x28, 0x5f, 0x81, 0xa5, 0x8c, 0xe3, 0x49, 0x07, 0xfc, 0x08, 0x2e,
-	0xec, 0x90, 0xb0, 0x16, 0xd8, 0xfb, 0xa4, 0xc5, 0x2e, 0x8f, 0xbe, 0xdb, 0x99, 0x03, 0xea, 0xc4,
-	0xcb, 0x60, 0x1f, 0x2c, 0xf4, 0xe5, 0x3f, 0xe4, 0x40, 0xef, 0x96, 0x20, 0xeb, 0xf1, 0x03, 0x18,
-	0x17, 0xee, 0x14, 0x3f, 0x28, 0x16, 0x36, 0xae, 0x64, 0x3e, 0x4a, 0x91, 0x80, 0x03, 0x7c, 0x4c,
-	0xcf, 0x6e, 0x4c, 0x6d, 0xef, 0x87, 0x14, 0xd3, 0x28, 0x94, 0xb5, 0x78, 0xad, 0xa7, 0xef, 0x9e,
-	0x70, 0x52, 0xa3, 0x48, 0x53, 0xdf, 0xaf, 0xac, 0x1a, 0xcf, 0x14, 0xdc, 0x10, 0x96, 0x78, 0x92,
-	0x74, 0xea, 0x0a, 0xe3, 0x08, 0x2e, 0xc2, 0x98, 0x04, 0x18, 0x91, 0xb9, 0xf2, 0x2b, 0xad, 0x34,
-	0x37, 0x9c, 0xd2, 0x9f, 0xe5, 0x60, 0x39, 0x4b, 0xab, 0x0c, 0xdb, 0x0b, 0x58, 0x6a, 0xbf, 0x5f,
-	0xb5, 0x82, 0x90, 0xf8, 0x89, 0x53, 0x04, 0xb3, 0x32, 0x98, 0xe7, 0x1e, 0x12, 0x8a, 0x2d, 0x4c,
-	0xb1, 0x51, 0x4a, 0x0e, 0x6f, 0x69, 0xd5, 0x4c, 0x65, 0xeb, 0xe7, 0x05, 0xa5, 0xca, 0xdc, 0xe9,
-	0x54, 0x5a, 0x89, 0x8b, 0x4c, 0x5a, 0x65, 0xf9, 0x26, 0x5c, 0xba, 0x4f, 0x5a, 0x6e, 0x08, 0xb7,
-	0x9a, 0x02, 0xb5, 0xfb, 0xf8, 0xbe, 0xfc, 0xfb, 0x51, 0xb8, 0xac, 0xe6, 0x93, 0xde, 0xfb, 0x89,
-	0x06, 0x8b, 0x8a, 0xb3, 0x34, 0xb0, 0x2f, 0xfd, 0xf6, 0x28, 0x1b, 0xe1, 0x7b, 0x09, 0xae, 0xec,
-	0x74, 0x9c, 0xe5, 0x21, 0xf6, 0xc5, 0x68, 0x3a, 0x6f, 0x75, 0xef, 0x70, 0x33, 0x14, 0x51, 0x64,
-	0x66, 0xe4, 0xce, 0x64, 0xc6, 0x66, 0x47, 0x14, 0xdb, 0x66, 0xe0, 0xee, 0x9d, 0xd2, 0x17, 0xac,
-	0x3d, 0xa8, 0xed, 0x56, 0x4c, 0xca, 0x0f, 0xd2, 0x4f, 0xe4, 0x3d, 0xae, 0x08, 0x59, 0x3d, 0x27,
-	0xf9, 0xd3, 0xf5, 0x17, 0xe9, 0xe1, 0xfa, 0x75, 0xea, 0x2e, 0xff, 0x26, 0x07, 0x6f, 0x7e, 0xea,
-	0x5b, 0x98, 0x92, 0xac, 0x56, 0x32, 0x08, 0x40, 0x9d, 0xa1, 0xd0, 0xcf, 0x0f, 0xbf, 0x54, 0xbd,
-	0x73, 0xf4, 0x3c, 0x26, 0x99, 0xb7, 0xe0, 0x7a, 0x1f, 0x17, 0x49, 0x90, 0xfb, 0x6d, 0x0e, 0xae,
-	0x1b, 0xe4, 0x20, 0x20, 0x61, 0xfd, 0xff, 0xde, 0xcc, 0xf2, 0xe6, 0x2a, 0xdc, 0xe8, 0xe7, 0x23,
-	0xe1, 0xce, 0x8d, 0x7f, 0x4f, 0x41, 0xe1, 0xa1, 0xcc, 0xe7, 0xcd, 0xc7, 0x55, 0xf4, 0x63, 0x0d,
-	0xe6, 0x15, 0x3f, 0x15, 0xa2, 0xf7, 0x87, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x74, 0xf3, 0x54, 0xbf,
-	0x47, 0x26, 0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96,
-	0x1d, 0xc3, 0x4c, 0xc7, 0xeb, 0x17, 0x7a, 0x77, 0xd8, 0xc7, 0xba, 0xd2, 0xfa, 0x10, 0x1c, 0x29,
-	0xbd, 0xa9, 0x73, 0xbf, 0x3b, 0xec, 0xb3, 0x45, 0x1f, 0xbd, 0xca, 0xf3, 0xfa, 0x30, 0x9d, 0xba,
-	0x49, 0xa1, 0x4a, 0xb6, 0x0c, 0xd5, 0xa5, 0xb0, 0xb4, 0x36, 0x30, 0xbd, 0xd4, 0xf8, 0x4b, 0x0d,
-	0x2e, 0x66, 0x8e, 0xf5, 0xe8, 0x4e, 0xb6, 0xb8, 0x7e, 0x57, 0x95, 0xd2, 0x87, 0xa7, 0xe2, 0x95,
-	0x66, 0xfd, 0x5c, 0x83, 0x37, 0x94, 0x83, 0x36, 0xba, 0x95, 0x2d, 0xb6, 0xd7, 0xc5, 0xa3, 0xf4,
-	0xcd, 0xa1, 0xf9, 0xa4, 0x29, 0x4d, 0x98, 0xed, 0x04, 0x18, 0xb4, 0x3e, 0x0c, 0x18, 0x09, 0xfd,
-	0xa7, 0xc0, 0x2f, 0xf4, 0x0b, 0x0d, 0x16, 0xd5, 0xb3, 0x21, 0xea, 0x71, 0x9c, 0x9e, 0x33, 0x6c,
-	0xe9, 0xf6, 0xf0, 0x8c, 0xd2, 0x9a, 0x9f, 0x6a, 0xb0, 0xa0, 0x9a, 0x44, 0xd0, 0xcd, 0x61, 0x27,
-	0x17, 0x61, 0xc9, 0xad, 0xd3, 0x0d, 0x3c, 0xe8, 0xd7, 0x1a, 0x2c, 0xf5, 0xc4, 0x29, 0xf4, 0x51,
-	0xb6, 0xe4, 0x41, 0x66, 0x80, 0xd2, 0xdd, 0x53, 0xf3, 0x4b, 0x13, 0x7f, 0xa7, 0xc1, 0x72, 0xef,
-	0xe6, 0x8f, 0xee, 0xf6, 0x2a, 0x8f, 0x01, 0xa0, 0xb5, 0xf4, 0xed, 0xd3, 0x0b, 0x10, 0x56, 0x6e,
-	0xdd, 0xff, 0xd3, 0xcb, 0x65, 0xed, 0xcf, 0x2f, 0x97, 0xb5, 0xbf, 0xbd, 0x5c, 0xd6, 0xbe, 0xf7,
-	0xc1, 0xa1, 0x4d, 0xeb, 0xd1, 0x7e, 0xa5, 0xe6, 0x35, 0xd6, 0x52, 0xff, 0xbd, 0x5a, 0x39, 0x24,
-	0xae, 0xf8, 0x77, 0xdf, 0xe4, 0x7f, 0x1c, 0x7f, 0x18, 0xff, 0x7d, 0xbc, 0xbe, 0x3f, 0xc6, 0x77,
-	0xdf, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x50, 0xe3, 0xd9, 0x9f, 0x2c, 0x00, 0x00,
+	// 2577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6f, 0x1b, 0xc7,
+	0xf9, 0xc7, 0x52, 0xa2, 0x28, 0x3d, 0xa4, 0x28, 0x69, 0xa4, 0xc8, 0x6b, 0xda, 0x92, 0x65, 0x3a,
+	0x76, 0x94, 0xff, 0x3f, 0xa5, 0x22, 0x25, 0x4e, 0x1d, 0x07, 0x49, 0xaa, 0x17, 0xcb, 0x66, 0x11,
+	0xd7, 0xca, 0x5a, 0xb1, 0x81, 0x36, 0xf0, 0x76, 0xc4, 0x1d, 0x89, 0x5b, 0x91, 0xbb, 0xeb, 0xdd,
+	0x59, 0x29, 0xcc, 0xa1, 0x87, 0xa2, 0x2d, 0x0a, 0xf4, 0xda, 0xde, 0xd3, 0x97, 0x2f, 0xd1, 0x7e,
+	0x82, 0x1e, 0x7b, 0x0f, 0x0a, 0xb4, 0x06, 0xfa, 0x01, 0x7a, 0xe8, 0xad, 0x05, 0x8a, 0x79, 0x59,
+	0x72, 0x97, 0x9c, 0xe5, 0x8b, 0x24, 0xc7, 0x3d, 0xf4, 0xc6, 0x99, 0x79, 0xde, 0xe6, 0x79, 0x99,
+	0xdf, 0x33, 0xb3, 0x84, 0x5b, 0xe1, 0x01, 0xf1, 0xd7, 0x6a, 0xd8, 0x22, 0x4e, 0x8d, 0xac, 0x35,
+	0x31, 0xad, 0xd5, 0x6d, 0xe7, 0x68, 0xed, 0x64, 0x7d, 0x2d, 0x20, 0xfe, 0x89, 0x5d, 0x23, 0x15,
+	0xcf, 0x77, 0xa9, 0x8b, 0x74, 0x46, 0x57, 0x91, 0x74, 0x95, 0x88, 0xae, 0x72, 0xb2, 0x5e, 0x5a,
+	0x3e, 0x72, 0xdd, 0xa3, 0x06, 0x59, 0xe3, 0x74, 0x07, 0xe1, 0xe1, 0x9a, 0x15, 0xfa, 0x98, 0xda,
+	0xae, 0x23, 0x38, 0x4b, 0xd7, 0xba, 0xd7, 0xa9, 0xdd, 0x24, 0x01, 0xc5, 0x4d, 0x4f, 0x12, 0xf4,
+	0x08, 0x38, 0xf5, 0xb1, 0xe7, 0x11, 0x3f, 0x90, 0xeb, 0x2b, 0x09, 0x13, 0xb1, 0x67, 0x33, 0xeb,
+	0x6a, 0x6e, 0xb3, 0xd9, 0x51, 0xa1, 0xa2, 0x78, 0x1e, 0x12, 0xbf, 0x25, 0x09, 0xca, 0x2a, 0x02,
+	0x8a, 0x83, 0xe3, 0x86, 0x1d, 0x50, 0x49, 0xb3, 0xaa, 0xa2, 0x91, 0x4e, 0x30, 0x4f, 0x5d, 0xff,
+	0x98, 0xf8, 0x92, 0xf2, 0xff, 0x06, 0x51, 0x1e, 0x36, 0xdc, 0x53, 0x49, 0x7b, 0x5d, 0x45, 0x5b,
+	0xb7, 0x03, 0xea, 0xb6, 0x8d, 0x7b, 0x3d, 0x41, 0x12, 0xd4, 0xb1, 0x4f, 0xac, 0x5e, 0xaa, 0x9b,
+	0x29, 0x54, 0xc9, 0x5d, 0x94, 0x3f, 0x82, 0xb9, 0x7d, 0x1c, 0x1c, 0x7f, 0x62, 0x07, 0x74, 0x0f,
+	0xfb, 0xd4, 0x66, 0x81, 0x40, 0x6f, 0xc2, 0xac, 0x1d, 0xb8, 0x0d, 0x1e, 0x15, 0xf3, 0xc8, 0x77,
+	0x43, 0x2f, 0xd0, 0xb5, 0x95, 0xb1, 0xd5, 0x29, 0x63, 0xa6, 0x3d, 0x7f, 0x9f, 0x4f, 0x97, 0xff,
+	0x36, 0x0e, 0x97, 0x7a, 0x04, 0x6c, 0xbb, 0xce, 0xa1, 0x7d, 0x84, 0x74, 0xc8, 0x9d, 0x10, 0x3f,
+	0xb0, 0x5d, 0x47, 0xd7, 0x56, 0xb4, 0xd5, 0x31, 0x23, 0x1a, 0xa2, 0x0d, 0x98, 0x77, 0xc2, 0xa6,
+	0xe9, 0x13, 0x6c, 0x99, 0x5e, 0xc4, 0x15, 0xe8, 0x99, 0x15, 0x6d, 0x35, 0xbb, 0x95, 0xd1, 0x35,
+	0x63, 0xce, 0x09, 0x9b, 0x06, 0xc1, 0x56, 0x5b, 0x64, 0x80, 0xde, 0x85, 0x05, 0xc6, 0x73, 0xea,
+	0xdb, 0x94, 0xc4, 0x99, 0xc6, 0xda, 0x4c, 0xc8, 0x09, 0x9b, 0x4f, 0xd9, 0x72, 0x8c, 0xcb, 0x81,
+	0x99, 0x6e, 0x2d, 0xe3, 0x2b, 0x63, 0xab, 0xf9, 0x8d, 0x7b, 0x95, 0xb4, 0x0c, 0xad, 0xa4, 0xec,
+	0xa7, 0x92, 0x34, 0xe8, 0x9e, 0x43, 0xfd, 0x96, 0x51, 0xf4, 0x93, 0x56, 0x3e, 0x87, 0xd9, 0x1e,
+	0x0b, 0xb3, 0x5c, 0xe1, 0xee, 0xe8, 0x0a, 0xbb, 0x36, 0x23, 0x34, 0xce, 0x9c, 0x26, 0x67, 0x4b,
+	0x0e, 0xcc, 0x2b, 0x2c, 0x43, 0xb3, 0x30, 0x76, 0x4c, 0x5a, 0xdc, 0xf3, 0x59, 0x83, 0xfd, 0x44,
+	0x9b, 0x90, 0x3d, 0xc1, 0x8d, 0x90, 0x70, 0x3f, 0xe7, 0x37, 0xfe, 0x7f, 0x04, 0x83, 0x0c, 0xc1,
+	0x79, 0x37, 0x73, 0x47, 0x2b, 0xb9, 0xb0, 0xa0, 0x32, 0xec, 0xa5, 0x29, 0x2c, 0xff, 0x10, 0xe6,
+	0x3e, 0x71, 0xb1, 0xb5, 0x85, 0x1b, 0xd8, 0xa9, 0x11, 0xff, 0x81, 0xed, 0xd0, 0x00, 0xdd, 0x80,
+	0xe9, 0x03, 0x5c, 0x3b, 0x6e, 0xb8, 0x47, 0x66, 0xcd, 0x0d, 0x1d, 0x2a, 0x53, 0xac, 0x20, 0x27,
+	0xb7, 0xd9, 0x1c, 0xba, 0x05, 0x33, 0x3e, 0x66, 0xc1, 0x20, 0xbe, 0x19, 0x90, 0x9a, 0xeb, 0x58,
+	0xdc, 0x14, 0xcd, 0x98, 0x66, 0xd3, 0x7b, 0xc4, 0x7f, 0xcc, 0x27, 0xcb, 0xff, 0xd0, 0xa0, 0xb4,
+	0xe7, 0x36, 0x1a, 0xbb, 0xae, 0xbf, 0x43, 0x6a, 0x36, 0xcb, 0x51, 0x66, 0x91, 0x41, 0x9e, 0x87,
+	0x24, 0xa0, 0xa8, 0x0a, 0x39, 0x5f, 0xfc, 0xe4, 0x5a, 0xf2, 0x1b, 0x6b, 0xc9, 0x9d, 0x60, 0xcf,
+	0x66, 0x9b, 0x48, 0x97, 0x60, 0x44, 0xfc, 0xe8, 0x0a, 0x4c, 0x59, 0x6e, 0x13, 0xdb, 0x8e, 0x69,
+	0x0b, 0x5b, 0xa6, 0x8c, 0x49, 0x31, 0x51, 0xb5, 0xd8, 0xa2, 0xe7, 0x36, 0x1a, 0xc4, 0x67, 0x8b,
+	0x63, 0x62, 0x51, 0x4c, 0x54, 0x2d, 0x74, 0x13, 0x8a, 0x87, 0xae, 0x7f, 0x8a, 0x7d, 0x8b, 0x58,
+	0xe6, 0xa1, 0xef, 0x36, 0xf5, 0x71, 0x4e, 0x31, 0xdd, 0x9e, 0xdd, 0xf5, 0xdd, 0x26, 0x7a, 0x03,
+	0x66, 0xba, 0x6a, 0x57, 0xcf, 0x72, 0xba, 0x62, 0xb2, 0x74, 0xcb, 0xff, 0xce, 0xc3, 0x15, 0xa5,
+	0xc5, 0x81, 0xe7, 0x3a, 0x01, 0x41, 0x4b, 0x00, 0xec, 0xac, 0x30, 0xa9, 0x7b, 0x4c, 0x44, 0x01,
+	0x17, 0x8c, 0x29, 0x36, 0xb3, 0xcf, 0x26, 0xd0, 0x67, 0x80, 0xa2, 0xa3, 0xcb, 0x24, 0x5f, 0x90,
+	0x5a, 0xc8, 0x24, 0xcb, 0x40, 0xdf, 0x52, 0xba, 0xe7, 0xa9, 0x24, 0xbf, 0x17, 0x51, 0x1b, 0x73,
+	0xa7, 0xdd, 0x53, 0x68, 0x17, 0xa6, 0xdb, 0x62, 0x69, 0xcb, 0x23, 0xdc, 0x0d, 0xf9, 0x8d, 0xeb,
+	0x7d, 0x25, 0xee, 0xb7, 0x3c, 0x62, 0x14, 0x4e, 0x63, 0x23, 0xf4, 0x04, 0x2e, 0x7b, 0x3e, 0x39,
+	0xb1, 0xdd, 0x30, 0x30, 0x03, 0x8a, 0x7d, 0x4a, 0x2c, 0x93, 0x9c, 0x10, 0x87, 0x32, 0xd7, 0x8e,
+	0x73, 0x99, 0x57, 0x2a, 0x02, 0x48, 0x2a, 0x11, 0x90, 0x54, 0xaa, 0x0e, 0x7d, 0xef, 0xdd, 0x27,
+	0x2c, 0xef, 0x8c, 0xc5, 0x88, 0xfb, 0xb1, 0x60, 0xbe, 0xc7, 0x78, 0xab, 0x16, 0x5a, 0x85, 0xd9,
+	0x1e, 0x71, 0x59, 0x9e, 0x79, 0xc5, 0x20, 0x49, 0xa9, 0x43, 0x0e, 0x53, 0x4a, 0x9a, 0x1e, 0xd5,
+	0x27, 0x78, 0x49, 0x44, 0x43, 0x54, 0x86, 0x69, 0x87, 0x7c, 0x41, 0x3b, 0x02, 0x72, 0x5c, 0x40,
+	0x9e, 0x4d, 0x46, 0xdc, 0x6f, 0x01, 0x4a, 0xa4, 0xb7, 0x59, 0xb7, 0x1d, 0xaa, 0x4f, 0x72, 0xc2,
+	0xd9, 0x78, 0x8e, 0xb3, 0x6a, 0x40, 0x77, 0x40, 0x0f, 0xa8, 0x5d, 0x3b, 0x6e, 0x75, 0x42, 0x61,
+	0x12, 0x07, 0x1f, 0x34, 0x88, 0xa5, 0x4f, 0xad, 0x68, 0xab, 0x93, 0xc6, 0xa2, 0x58, 0x6f, 0x3b,
+	0xfa, 0x9e, 0x58, 0x45, 0x77, 0x20, 0xcb, 0x81, 0x4f, 0x07, 0xee, 0x93, 0x72, 0x5f, 0x3f, 0x7f,
+	0xca, 0x28, 0x0d, 0xc1, 0x80, 0x0c, 0x98, 0xb6, 0x64, 0xde, 0x98, 0xb6, 0x73, 0xe8, 0xea, 0x79,
+	0x2e, 0xe1, 0x5b, 0x49, 0x09, 0x02, 0x78, 0x78, 0x89, 0xfb, 0xd8, 0x09, 0x6c, 0xe2, 0xd0, 0x28,
+	0xdb, 0xaa, 0xce, 0xa1, 0x6b, 0x14, 0xac, 0xd8, 0x08, 0x3d, 0x83, 0xab, 0xbd, 0x49, 0x65, 0xf2,
+	0x34, 0x64, 0x98, 0xa5, 0x17, 0xb8, 0x8a, 0x25, 0xa5, 0x91, 0xd1, 0x11, 0x62, 0x5c, 0xee, 0xc9,
+	0xaa, 0x68, 0x09, 0x55, 0x60, 0x5e, 0x38, 0x9d, 0x21, 0x25, 0x31, 0x23, 0x74, 0x9a, 0xe6, 0xf1,
+	0x99, 0xe3, 0x4b, 0x8f, 0xd9, 0xca, 0x13, 0x89, 0x53, 0xd7, 0xa1, 0x70, 0xe0, 0x63, 0xa7, 0x56,
+	0x97, 0x55, 0x50, 0xe4, 0x55, 0x90, 0x17, 0x73, 0xa2, 0x0e, 0x36, 0xa1, 0x18, 0xd4, 0xea, 0xc4,
+	0x0a, 0x1b, 0xc4, 0x32, 0x59, 0xab, 0xa2, 0xcf, 0x70, 0x23, 0x4b, 0x3d, 0xd9, 0xb5, 0x1f, 0xf5,
+	0x31, 0xc6, 0x74, 0x9b, 0x83, 0xcd, 0xa1, 0x0f, 0xa1, 0x10, 0xe5, 0x14, 0x17, 0x30, 0x3b, 0x50,
+	0x40, 0x5e, 0xd2, 0x73, 0xf6, 0xcf, 0x21, 0xc7, 0x22, 0x62, 0x93, 0x40, 0x9f, 0xe3, 0x48, 0xb3,
+	0x95, 0x7e, 0xce, 0xf6, 0x29, 0xf8, 0xca, 0xa7, 0x42, 0x88, 0x40, 0x99, 0x48, 0x24, 0x73, 0x19,
+	0x75, 0x29, 0x6e, 0x98, 0xb2, 0xbd, 0x30, 0x0f, 0x5a, 0x94, 0x04, 0x3a, 0xe2, 0x99, 0x38, 0xc7,
+	0x97, 0x1e, 0x88, 0x95, 0x2d, 0xb6, 0x80, 0x3e, 0x87, 0xd9, 0x36, 0xf4, 0x99, 0x35, 0x8e, 0x63,
+	0xfa, 0x3c, 0xdf, 0xd0, 0xfa, 0xc8, 0x00, 0x68, 0xcc, 0x78, 0x5d, 0x2d, 0xc5, 0x0f, 0x60, 0xbe,
+	0xe1, 0x62, 0xcb, 0x3c, 0x90, 0x58, 0xc0, 0xcb, 0x22, 0xd0, 0x17, 0x06, 0xe1, 0x4b, 0x0f, 0x7e,
+	0x18, 0x73, 0x8d, 0x1e, 0x48, 0x79, 0x08, 0xb3, 0x38, 0xa4, 0xae, 0xb4, 0x5a, 0x54, 0xdc, 0x6b,
+	0x5c, 0xf2, 0x0d, 0x65, 0xc6, 0x6d, 0x86, 0xd4, 0x15, 0x76, 0x31, 0x7e, 0xa3, 0x88, 0x13, 0x63,
+	0xf4, 0x21, 0xe4, 0x42, 0xcf, 0xc2, 0xcc, 0x5b, 0x8b, 0x3c, 0x2e, 0x37, 0xfa, 0x16, 0xd7, 0x67,
+	0x9c, 0xd6, 0x88, 0x78, 0x4a, 0xcf, 0xa0, 0x10, 0x8f, 0x48, 0x1c, 0x5e, 0xa7, 0x04, 0xbc, 0xde,
+	0x49, 0xc2, 0xeb, 0x50, 0xb5, 0xdb, 0x41, 0xd5, 0x18, 0xe6, 0x6d, 0xd6, 0xa8, 0x7d, 0x62, 0xd3,
+	0xd6, 0xd9, 0x31, 0x4f, 0x21, 0xe1, 0xbf, 0x11, 0xf3, 0x7e, 0x0d, 0x6d, 0xcc, 0x4b, 0x5a, 0xfc,
+	0x4a, 0x31, 0xef, 0x1a, 0xe4, 0xb1, 0xb4, 0xa6, 0xe3, 0x04, 0x88, 0xa6, 0xaa, 0x16, 0x03, 0xc5,
+	0x36, 0x01, 0x07, 0xc5, 0xf1, 0x3e, 0xa0, 0xd8, 0xde, 0x18, 0x07, 0x45, 0x1c, 0x1b, 0xa1, 0x0d,
+	0xc8, 0xda, 0x8e, 0x17, 0x52, 0xee, 0x9d, 0xfc, 0xc6, 0x55, 0x75, 0x44, 0x71, 0x8b, 0x95, 0x86,
+	0x21, 0x48, 0x15, 0xe7, 0xdb, 0xc4, 0x79, 0xcf, 0xb7, 0xdc, 0x68, 0xe7, 0xdb, 0x3e, 0x5c, 0x8e,
+	0xe4, 0x99, 0xac, 0x3a, 0x1b, 0x6e, 0x40, 0xb8, 0x20, 0x37, 0x14, 0x88, 0x98, 0xdf, 0xb8, 0xdc,
+	0x23, 0x6b, 0x47, 0x5e, 0x2a, 0x8d, 0xc5, 0x88, 0x77, 0xdf, 0xdd, 0x66, 0x9c, 0xfb, 0x82, 0x11,
+	0x7d, 0x0f, 0x16, 0xb9, 0x92, 0x5e, 0x91, 0x53, 0x83, 0x44, 0xce, 0x73, 0xc6, 0x2e, 0x79, 0xbb,
+	0x30, 0x57, 0x27, 0xd8, 0xa7, 0x07, 0x04, 0xd3, 0xb6, 0x28, 0x18, 0x24, 0x6a, 0xb6, 0xcd, 0x13,
+	0xc9, 0x89, 0xb5, 0x0d, 0xf9, 0x64, 0xdb, 0xf0, 0x0c, 0x96, 0x93, 0x91, 0x30, 0xdd, 0x43, 0x93,
+	0xd6, 0xed, 0xc0, 0x8c, 0x18, 0x0a, 0x03, 0x1d, 0x5b, 0x4a, 0x44, 0xe6, 0xd1, 0xe1, 0x7e, 0xdd,
+	0x0e, 0x36, 0xa5, 0xfc, 0x6a, 0x7c, 0x07, 0x16, 0xa1, 0xd8, 0x6e, 0x04, 0x1c, 0x1a, 0x07, 0x65,
+	0x4a, 0x67, 0x13, 0x3b, 0x82, 0xab, 0xb7, 0x8b, 0x2b, 0x9e, 0xad, 0x8b, 0x7b, 0x03, 0x66, 0xda,
+	0x72, 0xc4, 0x89, 0xc1, 0xd1, 0x75, 0xca, 0x28, 0x46, 0xd3, 0x3b, 0x7c, 0x16, 0xbd, 0x03, 0x13,
+	0x75, 0x82, 0x2d, 0xe2, 0x4b, 0xf0, 0xbc, 0xa2, 0xd4, 0xf4, 0x80, 0x93, 0x18, 0x92, 0x34, 0x0d,
+	0x4c, 0xe6, 0x2e, 0x04, 0x4c, 0x5e, 0x2e, 0x0e, 0xaa, 0xa0, 0x6a, 0xe1, 0xcc, 0x50, 0x55, 0xfe,
+	0x2a, 0x0b, 0x8b, 0x9b, 0x96, 0xa5, 0xba, 0xfb, 0x24, 0x0e, 0x6f, 0xad, 0xeb, 0xf0, 0x7e, 0x49,
+	0x07, 0xe2, 0x5d, 0x98, 0xea, 0xf4, 0x7c, 0x63, 0xc3, 0xf4, 0x7c, 0x93, 0x34, 0x6a, 0xf1, 0xae,
+	0x41, 0xbe, 0x7d, 0x5a, 0xc8, 0x56, 0x7f, 0xcc, 0x80, 0x68, 0xaa, 0x6a, 0x75, 0x1f, 0x27, 0xf2,
+	0x10, 0x90, 0x05, 0x9b, 0x1d, 0xe1, 0x38, 0xe1, 0x37, 0x83, 0xa8, 0x6c, 0xef, 0xc2, 0x44, 0xe0,
+	0x86, 0x7e, 0x4d, 0x1c, 0x8f, 0xc5, 0x6e, 0x30, 0x8e, 0xb5, 0xc1, 0x38, 0x38, 0x7e, 0xcc, 0x29,
+	0x0d, 0xc9, 0xa1, 0x40, 0xb9, 0x9c, 0x0a, 0xe5, 0x3c, 0x45, 0x46, 0x4d, 0x0e, 0x7a, 0xcb, 0x50,
+	0x47, 0xb5, 0xd2, 0x95, 0x60, 0xf2, 0x65, 0xa1, 0x3b, 0xcb, 0x76, 0x61, 0x9a, 0xc7, 0xc1, 0xf3,
+	0x6d, 0xd7, 0xb7, 0x69, 0x8b, 0x1f, 0x8d, 0xc5, 0x94, 0x32, 0x66, 0xe2, 0xf7, 0x24, 0xa1, 0x51,
+	0xa0, 0xb1, 0x51, 0x69, 0x0b, 0x16, 0x54, 0x0a, 0x15, 0x2d, 0xcd, 0x42, 0xbc, 0xa5, 0x99, 0x8a,
+	0xb7, 0x2b, 0xa7, 0x70, 0xa9, 0x67, 0x2f, 0x12, 0xb5, 0x55, 0xa5, 0xa6, 0x5d, 0x54, 0xa9, 0x95,
+	0xff, 0x38, 0xc1, 0x6b, 0x43, 0xd5, 0x23, 0xbd, 0x8a, 0xda, 0x60, 0x17, 0x50, 0x9e, 0x36, 0x66,
+	0x47, 0xb5, 0xe8, 0x18, 0x8a, 0x62, 0x7e, 0x27, 0x32, 0x20, 0x51, 0x45, 0xe3, 0xe7, 0xaa, 0xa2,
+	0xec, 0x68, 0x55, 0x34, 0x71, 0xfe, 0x2a, 0xca, 0x5d, 0x40, 0x15, 0x4d, 0xaa, 0xaa, 0xc8, 0x01,
+	0x1d, 0xc7, 0x42, 0xb9, 0x63, 0x07, 0x1e, 0xcb, 0x0a, 0x76, 0xfd, 0x94, 0xc8, 0xbf, 0xd1, 0xa7,
+	0x9a, 0x52, 0x38, 0x8d, 0x54, 0x99, 0xca, 0xaa, 0x85, 0x21, 0xaa, 0x56, 0x91, 0x6f, 0x67, 0xad,
+	0xda, 0xfc, 0xab, 0xab, 0xda, 0xaf, 0xc7, 0x40, 0x4f, 0x73, 0x1a, 0xfa, 0x2e, 0xcc, 0x74, 0x1a,
+	0x1a, 0x7e, 0xf9, 0x96, 0x65, 0xab, 0x36, 0x55, 0x5e, 0x33, 0xf9, 0x0b, 0x89, 0xd1, 0x69, 0x4a,
+	0xf9, 0xb8, 0xa7, 0xc7, 0xcc, 0x8c, 0xd6, 0x63, 0xc6, 0xba, 0xae, 0xb1, 0x51, 0xbb, 0xae, 0xf1,
+	0x8b, 0xef, 0xba, 0xb2, 0x17, 0xd3, 0x75, 0x4d, 0x5c, 0x58, 0xd7, 0x95, 0x53, 0x75, 0x5d, 0xf2,
+	0x4c, 0x56, 0xde, 0xa4, 0x5e, 0xee, 0x99, 0xfc, 0xb5, 0x06, 0x0b, 0xfc, 0x42, 0x1b, 0xed, 0x22,
+	0x3a, 0x91, 0xb7, 0xbb, 0x6f, 0xad, 0x6f, 0x2a, 0x37, 0xaf, 0xe2, 0x1d, 0xf2, 0xbe, 0x7a, 0x9e,
+	0xde, 0x64, 0xb8, 0xeb, 0x6c, 0xf9, 0x5f, 0x1a, 0xbc, 0xd6, 0x65, 0xa1, 0xf4, 0xea, 0xc7, 0x50,
+	0xe0, 0x8f, 0x6f, 0xa6, 0x4f, 0x82, 0xb0, 0x11, 0xed, 0xb1, 0x7f, 0x9e, 0xe4, 0x39, 0x87, 0xc1,
+	0x19, 0x50, 0x15, 0x8a, 0x91, 0x80, 0x1f, 0x91, 0x1a, 0x25, 0x56, 0xdf, 0xb7, 0x03, 0xf1, 0x66,
+	0x20, 0x29, 0x8d, 0xe9, 0xe7, 0xf1, 0x21, 0x7a, 0xaa, 0x88, 0xb0, 0xf0, 0xc7, 0x5b, 0x7d, 0xfd,
+	0x31, 0x30, 0xb8, 0x7f, 0xd7, 0x60, 0x45, 0xec, 0xd8, 0xe2, 0x06, 0x30, 0xc6, 0x6d, 0xb7, 0xe9,
+	0x35, 0x08, 0xb3, 0x42, 0xc6, 0xe8, 0x51, 0x77, 0xa0, 0x6f, 0x2b, 0x95, 0x0e, 0x92, 0xf3, 0x0d,
+	0x04, 0xfd, 0x12, 0xe4, 0x38, 0xaf, 0x6c, 0x46, 0xa7, 0x8c, 0x09, 0x36, 0xac, 0x5a, 0xe5, 0x1b,
+	0x70, 0xbd, 0x8f, 0x79, 0x22, 0xe2, 0xe5, 0xbf, 0x68, 0x70, 0x75, 0x9b, 0x5d, 0x2b, 0x1a, 0x8f,
+	0x42, 0x1a, 0x50, 0xec, 0x58, 0xb6, 0x73, 0xb4, 0xe7, 0x36, 0x1a, 0x43, 0xf5, 0x20, 0x89, 0xc7,
+	0x95, 0x4c, 0xd7, 0xe3, 0xca, 0x7d, 0x28, 0xb6, 0x37, 0xd5, 0x79, 0x6b, 0xef, 0x07, 0x14, 0x6c,
+	0x3f, 0xe2, 0xbc, 0xa0, 0xb1, 0xd1, 0x79, 0x1a, 0x8d, 0xf2, 0x35, 0x58, 0x4a, 0xd9, 0x9e, 0x74,
+	0xc0, 0x8f, 0xe1, 0xd2, 0x0e, 0x09, 0x6a, 0xbe, 0x7d, 0x40, 0xda, 0xec, 0x72, 0xeb, 0xbb, 0xdd,
+	0x39, 0xa0, 0x4e, 0xbc, 0x14, 0xf6, 0xe1, 0x42, 0x5f, 0xfe, 0x43, 0x06, 0xf4, 0x5e, 0x09, 0xb2,
+	0x1e, 0xdf, 0x87, 0x9c, 0x70, 0xa7, 0xf8, 0x3e, 0x9a, 0xdf, 0xb8, 0x96, 0xfa, 0x48, 0x46, 0x7c,
+	0xde, 0x28, 0x44, 0xf4, 0xec, 0x06, 0xd7, 0xf1, 0x7e, 0x40, 0x31, 0x0d, 0x03, 0x59, 0x8b, 0x37,
+	0xfa, 0xfa, 0xee, 0x31, 0x27, 0x35, 0x8a, 0x34, 0x31, 0x7e, 0x69, 0xd5, 0x78, 0xae, 0xe0, 0x06,
+	0xb0, 0xc4, 0x93, 0xa4, 0x5b, 0x57, 0x10, 0x45, 0x70, 0x11, 0x26, 0x24, 0xc0, 0x88, 0xcc, 0x95,
+	0xa3, 0xa4, 0xd2, 0xcc, 0x68, 0x4a, 0x7f, 0x9e, 0x81, 0xe5, 0x34, 0xad, 0x32, 0x6c, 0xcf, 0x61,
+	0xa9, 0xf3, 0x9e, 0xd6, 0x0e, 0x42, 0xec, 0x8b, 0xad, 0x08, 0x66, 0x65, 0x38, 0xcf, 0x3d, 0x24,
+	0x14, 0x5b, 0x98, 0x62, 0xa3, 0x14, 0x6f, 0x02, 0x93, 0xaa, 0x99, 0xca, 0xf6, 0xd7, 0x12, 0xa5,
+	0xca, 0xcc, 0xd9, 0x54, 0x5a, 0xb1, 0x0b, 0x51, 0x52, 0x65, 0xf9, 0x36, 0x5c, 0xb9, 0x4f, 0xda,
+	0x6e, 0x08, 0xb6, 0x5a, 0x02, 0xb5, 0x07, 0xf8, 0xbe, 0xfc, 0xfb, 0x71, 0xb8, 0xaa, 0xe6, 0x93,
+	0xde, 0xfb, 0xa9, 0x06, 0x8b, 0x8a, 0xbd, 0x34, 0xb1, 0x27, 0xfd, 0xf6, 0x28, 0x1d, 0xe1, 0xfb,
+	0x09, 0xae, 0xec, 0x74, 0xed, 0xe5, 0x21, 0xf6, 0x44, 0x8b, 0x3b, 0x6f, 0xf5, 0xae, 0x70, 0x33,
+	0x14, 0x51, 0x64, 0x66, 0x64, 0xce, 0x65, 0xc6, 0x66, 0x57, 0x14, 0x3b, 0x66, 0xe0, 0xde, 0x95,
+	0xd2, 0x97, 0xec, 0x78, 0x50, 0xdb, 0xad, 0xe8, 0x94, 0x1f, 0x24, 0x9f, 0xec, 0xfb, 0x5c, 0x35,
+	0xd2, 0xce, 0x9c, 0xf8, 0x97, 0xf8, 0x2f, 0x93, 0xcd, 0xf5, 0x37, 0xa9, 0xbb, 0xfc, 0x9b, 0x0c,
+	0xbc, 0x2e, 0x3e, 0x59, 0xa4, 0x1d, 0x25, 0xc3, 0x00, 0xd4, 0x39, 0x0a, 0xfd, 0xe2, 0xf0, 0x4b,
+	0x75, 0x76, 0x8e, 0x5f, 0x44, 0x27, 0xf3, 0x06, 0xdc, 0x1c, 0xe0, 0x22, 0x09, 0x72, 0xbf, 0xcd,
+	0xc0, 0x4d, 0x83, 0x1c, 0xfa, 0x24, 0xa8, 0xff, 0xcf, 0x9b, 0x69, 0xde, 0x5c, 0x85, 0x5b, 0x83,
+	0x7c, 0x24, 0xdc, 0xb9, 0xf1, 0xcf, 0x02, 0xe4, 0x1f, 0xca, 0x7c, 0xde, 0xdc, 0xab, 0xa2, 0x9f,
+	0x68, 0x30, 0xaf, 0xf8, 0xf2, 0x89, 0xde, 0x1d, 0xf1, 0x43, 0x29, 0x0f, 0x41, 0xe9, 0xf6, 0x99,
+	0x3e, 0xaf, 0xc6, 0x8d, 0x88, 0x17, 0xed, 0x10, 0x46, 0x28, 0x9e, 0x02, 0x86, 0x30, 0x42, 0x79,
+	0x2d, 0x3b, 0x81, 0x99, 0xae, 0x57, 0x34, 0xf4, 0xf6, 0xa8, 0x8f, 0x87, 0xa5, 0xf5, 0x11, 0x38,
+	0x12, 0x7a, 0x13, 0xfb, 0x7e, 0x7b, 0xd4, 0xe7, 0x8f, 0x01, 0x7a, 0x95, 0xfb, 0xf5, 0x60, 0x3a,
+	0x71, 0x93, 0x42, 0x95, 0x74, 0x19, 0xaa, 0x4b, 0x61, 0x69, 0x6d, 0x68, 0x7a, 0xa9, 0xf1, 0x57,
+	0x1a, 0x5c, 0x4e, 0x6d, 0xeb, 0xd1, 0xdd, 0x74, 0x71, 0x83, 0xae, 0x2a, 0xa5, 0x0f, 0xce, 0xc4,
+	0x2b, 0xcd, 0xfa, 0x85, 0x06, 0xaf, 0x29, 0x1b, 0x6d, 0xf4, 0x5e, 0xba, 0xd8, 0x7e, 0x17, 0x8f,
+	0xd2, 0xb7, 0x47, 0xe6, 0x93, 0xa6, 0xb4, 0x60, 0xb6, 0x1b, 0x60, 0xd0, 0xfa, 0x28, 0x60, 0x24,
+	0xf4, 0x9f, 0x01, 0xbf, 0xd0, 0x2f, 0x35, 0x58, 0x54, 0xf7, 0x86, 0xa8, 0xcf, 0x76, 0xfa, 0xf6,
+	0xb0, 0xa5, 0x3b, 0xa3, 0x33, 0x4a, 0x6b, 0x7e, 0xa6, 0xc1, 0x82, 0xaa, 0x13, 0x41, 0xb7, 0x47,
+	0xed, 0x5c, 0x84, 0x25, 0xef, 0x9d, 0xad, 0xe1, 0x41, 0x5f, 0x69, 0xb0, 0xd4, 0x17, 0xa7, 0xd0,
+	0x47, 0xe9, 0x92, 0x87, 0xe9, 0x01, 0x4a, 0x1f, 0x9f, 0x99, 0x5f, 0x9a, 0xf8, 0x3b, 0x0d, 0x96,
+	0xfb, 0x1f, 0xfe, 0xe8, 0xe3, 0x7e, 0xe5, 0x31, 0x04, 0xb4, 0x96, 0xbe, 0x73, 0x76, 0x01, 0xc2,
+	0xca, 0xad, 0xfb, 0x7f, 0x7a, 0xb1, 0xac, 0xfd, 0xf9, 0xc5, 0xb2, 0xf6, 0xd7, 0x17, 0xcb, 0xda,
+	0xf7, 0xdf, 0x3f, 0xb2, 0x69, 0x3d, 0x3c, 0xa8, 0xd4, 0xdc, 0xe6, 0x5a, 0xe2, 0xcf, 0xb8, 0x95,
+	0x23, 0xe2, 0x88, 0x7f, 0x2f, 0xc7, 0xff, 0x40, 0xfd, 0x41, 0xf4, 0xfb, 0x64, 0xfd, 0x60, 0x82,
+	0xaf, 0xbe, 0xf3, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x81, 0x43, 0x66, 0x15, 0x6e, 0x2d, 0x00,
+	0x00,
 }
 
 func (m *TaskListPartition) Marshal() (dAtA []byte, err error) {
@@ -2551,6 +2579,22 @@ func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if m.AutoConfigHint != nil {
 		{
 			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
@@ -3110,6 +3154,11 @@ func (m *AddDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TaskPriority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskPriority))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -3255,6 +3304,11 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TaskPriority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskPriority))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -4534,6 +4588,12 @@ func (m *PollForDecisionTaskResponse) Size() (n int) {
 		l = m.AutoConfigHint.Size()
 		n += 2 + l + sovService(uint64(l))
 	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 2 + l + sovService(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4699,6 +4759,9 @@ func (m *AddDecisionTaskRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if m.TaskPriority != 0 {
+		n += 1 + sovService(uint64(m.TaskPriority))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4769,6 +4832,9 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if m.TaskPriority != 0 {
+		n += 1 + sovService(uint64(m.TaskPriority))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6666,6 +6732,40 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, &v1.WorkflowUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -7977,6 +8077,25 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskPriority", wireType)
+			}
+			m.TaskPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskPriority |= v1.TaskPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8520,6 +8639,25 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskPriority", wireType)
+			}
+			m.TaskPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskPriority |= v1.TaskPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
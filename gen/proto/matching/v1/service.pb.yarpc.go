@@ -783,164 +783,166 @@ var (
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x49, 0x73, 0xdc, 0xc6,
-		0xf5, 0x2f, 0x0c, 0x39, 0x5c, 0xde, 0x90, 0x43, 0xb2, 0x49, 0x53, 0xd0, 0x48, 0x94, 0xa8, 0x91,
-		0x25, 0xd3, 0xff, 0xbf, 0x33, 0x34, 0x69, 0x49, 0x91, 0xa5, 0x8a, 0x15, 0x2e, 0xa2, 0x34, 0x29,
-		0x2b, 0x92, 0x21, 0x5a, 0xaa, 0x4a, 0x5c, 0x42, 0x9a, 0x83, 0x26, 0x07, 0x21, 0x06, 0x80, 0x80,
-		0x06, 0x69, 0xfa, 0x90, 0x43, 0x2a, 0x49, 0xa5, 0x2a, 0xd7, 0xe4, 0x9e, 0xed, 0x4b, 0xe4, 0x92,
-		0xcf, 0x91, 0x2a, 0x57, 0x0e, 0x39, 0xe4, 0x03, 0x24, 0x55, 0xb9, 0xe5, 0x90, 0xea, 0x05, 0x33,
-		0x00, 0xa6, 0x31, 0x0b, 0x49, 0xc9, 0x39, 0xe4, 0x46, 0x74, 0xbf, 0xad, 0xdf, 0xf6, 0x7b, 0xdd,
-		0x43, 0xb8, 0x19, 0xed, 0x91, 0x60, 0xb5, 0x81, 0x2d, 0xe2, 0x36, 0xc8, 0x6a, 0x0b, 0xd3, 0x46,
-		0xd3, 0x76, 0x0f, 0x56, 0x8f, 0xd6, 0x56, 0x43, 0x12, 0x1c, 0xd9, 0x0d, 0x52, 0xf3, 0x03, 0x8f,
-		0x7a, 0x48, 0x67, 0x74, 0x35, 0x49, 0x57, 0x8b, 0xe9, 0x6a, 0x47, 0x6b, 0x95, 0x2b, 0x07, 0x9e,
-		0x77, 0xe0, 0x90, 0x55, 0x4e, 0xb7, 0x17, 0xed, 0xaf, 0x5a, 0x51, 0x80, 0xa9, 0xed, 0xb9, 0x82,
-		0xb3, 0x72, 0x35, 0xbb, 0x4f, 0xed, 0x16, 0x09, 0x29, 0x6e, 0xf9, 0x92, 0xa0, 0x4b, 0xc0, 0x71,
-		0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x4e, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0xd7, 0xf0, 0x5a,
-		0xad, 0x8e, 0x0a, 0x15, 0xc5, 0xeb, 0x88, 0x04, 0x27, 0x92, 0xa0, 0xaa, 0x22, 0xa0, 0x38, 0x3c,
-		0x74, 0xec, 0x90, 0x4a, 0x9a, 0x15, 0x15, 0x8d, 0x74, 0x82, 0x79, 0xec, 0x05, 0x87, 0x24, 0x90,
-		0x94, 0xff, 0xd7, 0x8f, 0x72, 0xdf, 0xf1, 0x8e, 0x25, 0xed, 0x35, 0x15, 0x6d, 0xd3, 0x0e, 0xa9,
-		0xd7, 0x36, 0xee, 0xdd, 0x14, 0x49, 0xd8, 0xc4, 0x01, 0xb1, 0xba, 0xa9, 0x6e, 0xe4, 0x50, 0xa5,
-		0x4f, 0x51, 0xfd, 0x04, 0xe6, 0x76, 0x71, 0x78, 0xf8, 0xa9, 0x1d, 0xd2, 0x67, 0x38, 0xa0, 0x36,
-		0x0b, 0x04, 0x7a, 0x1f, 0x66, 0xed, 0xd0, 0x73, 0x78, 0x54, 0xcc, 0x83, 0xc0, 0x8b, 0xfc, 0x50,
-		0xd7, 0x96, 0x47, 0x56, 0x26, 0x8d, 0x99, 0xf6, 0xfa, 0x23, 0xbe, 0x5c, 0xfd, 0xdb, 0x28, 0x5c,
-		0xe8, 0x12, 0xb0, 0xe5, 0xb9, 0xfb, 0xf6, 0x01, 0xd2, 0x61, 0xfc, 0x88, 0x04, 0xa1, 0xed, 0xb9,
-		0xba, 0xb6, 0xac, 0xad, 0x8c, 0x18, 0xf1, 0x27, 0x5a, 0x87, 0x79, 0x37, 0x6a, 0x99, 0x01, 0xc1,
-		0x96, 0xe9, 0xc7, 0x5c, 0xa1, 0x5e, 0x58, 0xd6, 0x56, 0x8a, 0x9b, 0x05, 0x5d, 0x33, 0xe6, 0xdc,
-		0xa8, 0x65, 0x10, 0x6c, 0xb5, 0x45, 0x86, 0xe8, 0x16, 0x2c, 0x30, 0x9e, 0xe3, 0xc0, 0xa6, 0x24,
-		0xc9, 0x34, 0xd2, 0x66, 0x42, 0x6e, 0xd4, 0x7a, 0xc9, 0xb6, 0x13, 0x5c, 0x2e, 0xcc, 0x64, 0xb5,
-		0x8c, 0x2e, 0x8f, 0xac, 0x94, 0xd6, 0x1f, 0xd6, 0xf2, 0x32, 0xb4, 0x96, 0x73, 0x9e, 0x5a, 0xda,
-		0xa0, 0x87, 0x2e, 0x0d, 0x4e, 0x8c, 0x72, 0x90, 0xb6, 0xf2, 0x35, 0xcc, 0x76, 0x59, 0x58, 0xe4,
-		0x0a, 0x77, 0x86, 0x57, 0x98, 0x39, 0x8c, 0xd0, 0x38, 0x73, 0x9c, 0x5e, 0xad, 0xb8, 0x30, 0xaf,
-		0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x21, 0x39, 0xe1, 0x9e, 0x2f, 0x1a, 0xec, 0x4f, 0xb4, 0x01, 0xc5,
-		0x23, 0xec, 0x44, 0x84, 0xfb, 0xb9, 0xb4, 0xfe, 0xff, 0x43, 0x18, 0x64, 0x08, 0xce, 0x7b, 0x85,
-		0xbb, 0x5a, 0xc5, 0x83, 0x05, 0x95, 0x61, 0x6f, 0x4c, 0x61, 0xf5, 0x47, 0x30, 0xf7, 0xa9, 0x87,
-		0xad, 0x4d, 0xec, 0x60, 0xb7, 0x41, 0x82, 0xc7, 0xb6, 0x4b, 0x43, 0x74, 0x1d, 0xa6, 0xf7, 0x70,
-		0xe3, 0xd0, 0xf1, 0x0e, 0xcc, 0x86, 0x17, 0xb9, 0x54, 0xa6, 0xd8, 0x94, 0x5c, 0xdc, 0x62, 0x6b,
-		0xe8, 0x26, 0xcc, 0x04, 0x98, 0x05, 0x83, 0x04, 0x66, 0x48, 0x1a, 0x9e, 0x6b, 0x71, 0x53, 0x34,
-		0x63, 0x9a, 0x2d, 0x3f, 0x23, 0xc1, 0x73, 0xbe, 0x58, 0xfd, 0x87, 0x06, 0x95, 0x67, 0x9e, 0xe3,
-		0xec, 0x78, 0xc1, 0x36, 0x69, 0xd8, 0x2c, 0x47, 0x99, 0x45, 0x06, 0x79, 0x1d, 0x91, 0x90, 0xa2,
-		0x3a, 0x8c, 0x07, 0xe2, 0x4f, 0xae, 0xa5, 0xb4, 0xbe, 0x9a, 0x3e, 0x09, 0xf6, 0x6d, 0x76, 0x88,
-		0x7c, 0x09, 0x46, 0xcc, 0x8f, 0x2e, 0xc1, 0xa4, 0xe5, 0xb5, 0xb0, 0xed, 0x9a, 0xb6, 0xb0, 0x65,
-		0xd2, 0x98, 0x10, 0x0b, 0x75, 0x8b, 0x6d, 0xfa, 0x9e, 0xe3, 0x90, 0x80, 0x6d, 0x8e, 0x88, 0x4d,
-		0xb1, 0x50, 0xb7, 0xd0, 0x0d, 0x28, 0xef, 0x7b, 0xc1, 0x31, 0x0e, 0x2c, 0x62, 0x99, 0xfb, 0x81,
-		0xd7, 0xd2, 0x47, 0x39, 0xc5, 0x74, 0x7b, 0x75, 0x27, 0xf0, 0x5a, 0xe8, 0x3d, 0x98, 0xc9, 0xd4,
-		0xae, 0x5e, 0xe4, 0x74, 0xe5, 0x74, 0xe9, 0x56, 0xff, 0x5c, 0x82, 0x4b, 0x4a, 0x8b, 0x43, 0xdf,
-		0x73, 0x43, 0x82, 0x96, 0x00, 0x58, 0xaf, 0x30, 0xa9, 0x77, 0x48, 0x44, 0x01, 0x4f, 0x19, 0x93,
-		0x6c, 0x65, 0x97, 0x2d, 0xa0, 0xcf, 0x01, 0xc5, 0xad, 0xcb, 0x24, 0x5f, 0x92, 0x46, 0xc4, 0x24,
-		0xcb, 0x40, 0xdf, 0x54, 0xba, 0xe7, 0xa5, 0x24, 0x7f, 0x18, 0x53, 0x1b, 0x73, 0xc7, 0xd9, 0x25,
-		0xb4, 0x03, 0xd3, 0x6d, 0xb1, 0xf4, 0xc4, 0x27, 0xdc, 0x0d, 0xa5, 0xf5, 0x6b, 0x3d, 0x25, 0xee,
-		0x9e, 0xf8, 0xc4, 0x98, 0x3a, 0x4e, 0x7c, 0xa1, 0x17, 0x70, 0xd1, 0x0f, 0xc8, 0x91, 0xed, 0x45,
-		0xa1, 0x19, 0x52, 0x1c, 0x50, 0x62, 0x99, 0xe4, 0x88, 0xb8, 0x94, 0xb9, 0x76, 0x94, 0xcb, 0xbc,
-		0x54, 0x13, 0x40, 0x52, 0x8b, 0x81, 0xa4, 0x56, 0x77, 0xe9, 0x9d, 0x5b, 0x2f, 0x58, 0xde, 0x19,
-		0x8b, 0x31, 0xf7, 0x73, 0xc1, 0xfc, 0x90, 0xf1, 0xd6, 0x2d, 0xb4, 0x02, 0xb3, 0x5d, 0xe2, 0x8a,
-		0x3c, 0xf3, 0xca, 0x61, 0x9a, 0x52, 0x87, 0x71, 0x4c, 0x29, 0x69, 0xf9, 0x54, 0x1f, 0xe3, 0x25,
-		0x11, 0x7f, 0xa2, 0x2a, 0x4c, 0xbb, 0xe4, 0x4b, 0xda, 0x11, 0x30, 0xce, 0x05, 0x94, 0xd8, 0x62,
-		0xcc, 0xfd, 0x01, 0xa0, 0x54, 0x7a, 0x9b, 0x4d, 0xdb, 0xa5, 0xfa, 0x04, 0x27, 0x9c, 0x4d, 0xe6,
-		0x38, 0xab, 0x06, 0x74, 0x17, 0xf4, 0x90, 0xda, 0x8d, 0xc3, 0x93, 0x4e, 0x28, 0x4c, 0xe2, 0xe2,
-		0x3d, 0x87, 0x58, 0xfa, 0xe4, 0xb2, 0xb6, 0x32, 0x61, 0x2c, 0x8a, 0xfd, 0xb6, 0xa3, 0x1f, 0x8a,
-		0x5d, 0x74, 0x17, 0x8a, 0x1c, 0xf8, 0x74, 0xe0, 0x3e, 0xa9, 0xf6, 0xf4, 0xf3, 0x67, 0x8c, 0xd2,
-		0x10, 0x0c, 0xc8, 0x80, 0x69, 0x4b, 0xe6, 0x8d, 0x69, 0xbb, 0xfb, 0x9e, 0x5e, 0xe2, 0x12, 0xbe,
-		0x95, 0x96, 0x20, 0x80, 0x87, 0x97, 0x78, 0x80, 0xdd, 0xd0, 0x26, 0x2e, 0x8d, 0xb3, 0xad, 0xee,
-		0xee, 0x7b, 0xc6, 0x94, 0x95, 0xf8, 0x42, 0xaf, 0xe0, 0x72, 0x77, 0x52, 0x99, 0x3c, 0x0d, 0x19,
-		0x66, 0xe9, 0x53, 0x5c, 0xc5, 0x92, 0xd2, 0xc8, 0xb8, 0x85, 0x18, 0x17, 0xbb, 0xb2, 0x2a, 0xde,
-		0x42, 0x35, 0x98, 0x17, 0x4e, 0x67, 0x48, 0x49, 0xcc, 0x18, 0x9d, 0xa6, 0x79, 0x7c, 0xe6, 0xf8,
-		0xd6, 0x73, 0xb6, 0xf3, 0x42, 0xe2, 0xd4, 0x35, 0x98, 0xda, 0x0b, 0xb0, 0xdb, 0x68, 0xca, 0x2a,
-		0x28, 0xf3, 0x2a, 0x28, 0x89, 0x35, 0x51, 0x07, 0x1b, 0x50, 0x0e, 0x1b, 0x4d, 0x62, 0x45, 0x0e,
-		0xb1, 0x4c, 0x36, 0xaa, 0xe8, 0x33, 0xdc, 0xc8, 0x4a, 0x57, 0x76, 0xed, 0xc6, 0x73, 0x8c, 0x31,
-		0xdd, 0xe6, 0x60, 0x6b, 0xe8, 0x3b, 0x30, 0x15, 0xe7, 0x14, 0x17, 0x30, 0xdb, 0x57, 0x40, 0x49,
-		0xd2, 0x73, 0xf6, 0x2f, 0x60, 0x9c, 0x45, 0xc4, 0x26, 0xa1, 0x3e, 0xc7, 0x91, 0x66, 0x33, 0xbf,
-		0xcf, 0xf6, 0x28, 0xf8, 0xda, 0x67, 0x42, 0x88, 0x40, 0x99, 0x58, 0x24, 0x73, 0x19, 0xf5, 0x28,
-		0x76, 0x4c, 0x39, 0x5e, 0x98, 0x7b, 0x27, 0x94, 0x84, 0x3a, 0xe2, 0x99, 0x38, 0xc7, 0xb7, 0x1e,
-		0x8b, 0x9d, 0x4d, 0xb6, 0x81, 0xbe, 0x80, 0xd9, 0x36, 0xf4, 0x99, 0x0d, 0x8e, 0x63, 0xfa, 0x3c,
-		0x3f, 0xd0, 0xda, 0xd0, 0x00, 0x68, 0xcc, 0xf8, 0x99, 0x91, 0xe2, 0x87, 0x30, 0xef, 0x78, 0xd8,
-		0x32, 0xf7, 0x24, 0x16, 0xf0, 0xb2, 0x08, 0xf5, 0x85, 0x7e, 0xf8, 0xd2, 0x85, 0x1f, 0xc6, 0x9c,
-		0xd3, 0x05, 0x29, 0x4f, 0x60, 0x16, 0x47, 0xd4, 0x93, 0x56, 0x8b, 0x8a, 0x7b, 0x87, 0x4b, 0xbe,
-		0xae, 0xcc, 0xb8, 0x8d, 0x88, 0x7a, 0xc2, 0x2e, 0xc6, 0x6f, 0x94, 0x71, 0xea, 0xbb, 0xf2, 0x0a,
-		0xa6, 0x92, 0x2e, 0x4d, 0xe2, 0xe3, 0xa4, 0xc0, 0xc7, 0xbb, 0x69, 0x7c, 0x1c, 0xa8, 0xf8, 0x3a,
-		0xb0, 0x98, 0x00, 0xad, 0x8d, 0x06, 0xb5, 0x8f, 0x6c, 0x7a, 0x72, 0x7a, 0xd0, 0x52, 0x48, 0xf8,
-		0x6f, 0x04, 0xad, 0xdf, 0x40, 0x1b, 0xb4, 0xd2, 0x16, 0x7f, 0xa3, 0xa0, 0x75, 0x15, 0x4a, 0x58,
-		0x5a, 0xd3, 0x71, 0x02, 0xc4, 0x4b, 0x75, 0x8b, 0xa1, 0x5a, 0x9b, 0x80, 0xa3, 0xda, 0x68, 0x0f,
-		0x54, 0x6b, 0x1f, 0x8c, 0xa3, 0x1a, 0x4e, 0x7c, 0xa1, 0x75, 0x28, 0xda, 0xae, 0x1f, 0x51, 0xee,
-		0x9d, 0xd2, 0xfa, 0x65, 0x75, 0x44, 0xf1, 0x09, 0xcb, 0x6d, 0x43, 0x90, 0x2a, 0x1a, 0xd4, 0xd8,
-		0x59, 0x1b, 0xd4, 0xf8, 0x70, 0x0d, 0x6a, 0x17, 0x2e, 0xc6, 0xf2, 0x4c, 0x56, 0x5e, 0x8e, 0x17,
-		0x12, 0x2e, 0xc8, 0x8b, 0x04, 0xa4, 0x95, 0xd6, 0x2f, 0x76, 0xc9, 0xda, 0x96, 0xb7, 0x42, 0x63,
-		0x31, 0xe6, 0xdd, 0xf5, 0xb6, 0x18, 0xe7, 0xae, 0x60, 0x44, 0xdf, 0x87, 0x45, 0xae, 0xa4, 0x5b,
-		0xe4, 0x64, 0x3f, 0x91, 0xf3, 0x9c, 0x31, 0x23, 0x6f, 0x07, 0xe6, 0x9a, 0x04, 0x07, 0x74, 0x8f,
-		0x60, 0xda, 0x16, 0x05, 0xfd, 0x44, 0xcd, 0xb6, 0x79, 0x62, 0x39, 0x09, 0xdc, 0x2f, 0xa5, 0x71,
-		0xff, 0x15, 0x5c, 0x49, 0x47, 0xc2, 0xf4, 0xf6, 0x4d, 0xda, 0xb4, 0x43, 0x33, 0x66, 0x98, 0xea,
-		0xeb, 0xd8, 0x4a, 0x2a, 0x32, 0x4f, 0xf7, 0x77, 0x9b, 0x76, 0xb8, 0x21, 0xe5, 0xd7, 0x93, 0x27,
-		0xb0, 0x08, 0xc5, 0xb6, 0x13, 0x72, 0x6c, 0xeb, 0x97, 0x29, 0x9d, 0x43, 0x6c, 0x0b, 0xae, 0xee,
-		0x31, 0xac, 0x7c, 0xba, 0x31, 0xec, 0x3d, 0x98, 0x69, 0xcb, 0x11, 0x1d, 0x83, 0xc3, 0xe3, 0xa4,
-		0x51, 0x8e, 0x97, 0xb7, 0xf9, 0x2a, 0xfa, 0x08, 0xc6, 0x9a, 0x04, 0x5b, 0x24, 0x90, 0xe8, 0x77,
-		0x49, 0xa9, 0xe9, 0x31, 0x27, 0x31, 0x24, 0x69, 0x1e, 0x1a, 0xcc, 0x9d, 0x0b, 0x1a, 0xbc, 0x59,
-		0x20, 0x53, 0x61, 0xcd, 0xc2, 0xa9, 0xb1, 0xa6, 0xfa, 0x97, 0x51, 0x58, 0xdc, 0xb0, 0x2c, 0xd5,
-		0xe5, 0x25, 0xd5, 0xbc, 0xb5, 0x4c, 0xf3, 0x7e, 0x43, 0x0d, 0xf1, 0x1e, 0x4c, 0x76, 0x86, 0xb6,
-		0x91, 0x41, 0x86, 0xb6, 0x09, 0x1a, 0xcf, 0x68, 0x57, 0xa1, 0xd4, 0xee, 0x16, 0x72, 0x56, 0x1f,
-		0x31, 0x20, 0x5e, 0xaa, 0x5b, 0xd9, 0x76, 0x22, 0x9b, 0x80, 0x2c, 0xd8, 0xe2, 0x10, 0xed, 0x84,
-		0x8f, 0xf6, 0x71, 0xd9, 0xde, 0x83, 0xb1, 0xd0, 0x8b, 0x82, 0x86, 0x68, 0x8f, 0xe5, 0x2c, 0x18,
-		0x27, 0xe6, 0x58, 0x1c, 0x1e, 0x3e, 0xe7, 0x94, 0x86, 0xe4, 0x50, 0xa0, 0xdc, 0xb8, 0x0a, 0xe5,
-		0x7c, 0x45, 0x46, 0x4d, 0xf4, 0x7b, 0x8c, 0x50, 0x47, 0xb5, 0x96, 0x49, 0x30, 0xf9, 0x34, 0x90,
-		0xc9, 0xb2, 0xca, 0x26, 0x2c, 0xa8, 0x08, 0x15, 0xa3, 0xc8, 0x42, 0x72, 0x14, 0x99, 0x4c, 0x8e,
-		0x19, 0xc7, 0x70, 0xa1, 0xcb, 0x06, 0x89, 0xb6, 0xaa, 0x12, 0xd1, 0xce, 0xab, 0x44, 0xaa, 0xff,
-		0x2c, 0xf2, 0x9c, 0x56, 0xcd, 0x36, 0xdf, 0x44, 0x4e, 0xb3, 0x9b, 0x1f, 0x0f, 0xb7, 0xd9, 0x51,
-		0x2d, 0x90, 0xbe, 0x2c, 0xd6, 0xb7, 0x63, 0x03, 0x52, 0xd9, 0x3f, 0x7a, 0xa6, 0xec, 0x2f, 0x0e,
-		0x97, 0xfd, 0x63, 0x67, 0xcf, 0xfe, 0xf1, 0x73, 0xc8, 0xfe, 0x09, 0x55, 0xf6, 0xbb, 0xa0, 0xe3,
-		0x44, 0x28, 0xb7, 0xed, 0xd0, 0x67, 0x59, 0xc1, 0xee, 0x7d, 0x12, 0xb1, 0xd7, 0x7b, 0x54, 0x41,
-		0x0e, 0xa7, 0x91, 0x2b, 0x53, 0x59, 0x6d, 0x30, 0x40, 0xb5, 0x29, 0xf2, 0xed, 0x2d, 0x56, 0xdb,
-		0xd7, 0x23, 0xa0, 0xe7, 0x1d, 0x16, 0x7d, 0x0f, 0x66, 0x3a, 0x03, 0x04, 0xbf, 0xad, 0xca, 0x72,
-		0x53, 0xe3, 0xb2, 0xbc, 0x97, 0xf1, 0x27, 0x05, 0xa3, 0x33, 0x04, 0xf2, 0xef, 0xae, 0x99, 0xae,
-		0x30, 0xdc, 0x4c, 0x97, 0x98, 0x72, 0x46, 0x86, 0x9d, 0x72, 0x46, 0xcf, 0x7f, 0xca, 0x29, 0x9e,
-		0xcf, 0x94, 0x33, 0x76, 0x6e, 0x53, 0xce, 0xb8, 0x6a, 0xca, 0x91, 0xbd, 0x54, 0x79, 0x73, 0x79,
-		0xb3, 0xbd, 0xf4, 0x6b, 0x0d, 0x16, 0xf8, 0x05, 0x32, 0x3e, 0x45, 0xdc, 0x49, 0xb7, 0xb2, 0xb7,
-		0xc4, 0xf7, 0x95, 0x87, 0x57, 0xf1, 0x0e, 0x78, 0x3f, 0x3c, 0xcb, 0x2c, 0x30, 0xd8, 0xf5, 0xb1,
-		0xfa, 0x6f, 0x0d, 0xde, 0xc9, 0x58, 0x28, 0xbd, 0xfa, 0x00, 0xa6, 0xf8, 0x6b, 0x95, 0x19, 0x90,
-		0x30, 0x72, 0xe2, 0x33, 0xf6, 0xce, 0x93, 0x12, 0xe7, 0x30, 0x38, 0x03, 0xaa, 0x43, 0x39, 0x16,
-		0xf0, 0x63, 0xd2, 0xa0, 0xc4, 0xea, 0x79, 0x57, 0x17, 0x77, 0x74, 0x49, 0x69, 0x4c, 0xbf, 0x4e,
-		0x7e, 0xa2, 0x97, 0x8a, 0x08, 0x0b, 0x7f, 0x7c, 0xd0, 0xd3, 0x1f, 0x7d, 0x83, 0xfb, 0x77, 0x0d,
-		0x96, 0xc5, 0x89, 0x2d, 0x6e, 0x00, 0x63, 0xdc, 0xf2, 0x5a, 0xbe, 0x43, 0x98, 0x15, 0x32, 0x46,
-		0x4f, 0xb3, 0x81, 0xbe, 0xad, 0x54, 0xda, 0x4f, 0xce, 0x5b, 0x08, 0xfa, 0x05, 0x18, 0xe7, 0xbc,
-		0x72, 0xf8, 0x9b, 0x34, 0xc6, 0xd8, 0x67, 0xdd, 0xaa, 0x5e, 0x87, 0x6b, 0x3d, 0xcc, 0x13, 0x11,
-		0xaf, 0xfe, 0x55, 0x83, 0xcb, 0x5b, 0x6c, 0x8c, 0x77, 0x9e, 0x46, 0x34, 0xa4, 0xd8, 0xb5, 0x6c,
-		0xf7, 0xe0, 0x99, 0xe7, 0x38, 0x03, 0xcd, 0x0e, 0xa9, 0xc7, 0x8c, 0x42, 0xe6, 0x31, 0xe3, 0x11,
-		0x94, 0xdb, 0x87, 0xea, 0x3c, 0x4e, 0x97, 0x73, 0xfa, 0x45, 0x7c, 0x32, 0xd1, 0x2f, 0x68, 0xe2,
-		0xeb, 0x2c, 0x03, 0x42, 0xf5, 0x2a, 0x2c, 0xe5, 0x1c, 0x4f, 0x3a, 0xe0, 0x27, 0x70, 0x61, 0x9b,
-		0x84, 0x8d, 0xc0, 0xde, 0x23, 0x6d, 0x76, 0x79, 0xf4, 0x9d, 0x6c, 0x0e, 0xa8, 0x13, 0x2f, 0x87,
-		0x7d, 0xb0, 0xd0, 0x57, 0xff, 0x54, 0x00, 0xbd, 0x5b, 0x82, 0xac, 0xc7, 0x8f, 0x61, 0x5c, 0xb8,
-		0x53, 0xfc, 0xa0, 0x58, 0x5a, 0xbf, 0x9a, 0xfb, 0x28, 0x45, 0x02, 0x0e, 0xf0, 0x31, 0x3d, 0xbb,
-		0x31, 0x75, 0xbc, 0x1f, 0x52, 0x4c, 0xa3, 0x50, 0xd6, 0xe2, 0xf5, 0x9e, 0xbe, 0x7b, 0xce, 0x49,
-		0x8d, 0x32, 0x4d, 0x7d, 0xbf, 0xb1, 0x6a, 0x3c, 0x53, 0x70, 0x43, 0x58, 0xe2, 0x49, 0x92, 0xd5,
-		0x15, 0xc6, 0x11, 0x5c, 0x84, 0x31, 0x09, 0x30, 0x22, 0x73, 0xe5, 0x57, 0x5a, 0x69, 0x61, 0x38,
-		0xa5, 0xbf, 0x28, 0xc0, 0x95, 0x3c, 0xad, 0x32, 0x6c, 0xaf, 0x61, 0xa9, 0xf3, 0x7e, 0xd5, 0x0e,
-		0x42, 0xe2, 0x27, 0x4e, 0x11, 0xcc, 0xda, 0x60, 0x9e, 0x7b, 0x42, 0x28, 0xb6, 0x30, 0xc5, 0x46,
-		0x25, 0x39, 0xbc, 0xa5, 0x55, 0x33, 0x95, 0xed, 0x9f, 0x17, 0x94, 0x2a, 0x0b, 0xa7, 0x53, 0x69,
-		0x25, 0x2e, 0x32, 0x69, 0x95, 0xd5, 0xdb, 0x70, 0xe9, 0x11, 0x69, 0xbb, 0x21, 0xdc, 0x3c, 0x11,
-		0xa8, 0xdd, 0xc7, 0xf7, 0xd5, 0x3f, 0x8e, 0xc2, 0x65, 0x35, 0x9f, 0xf4, 0xde, 0xcf, 0x34, 0x58,
-		0x54, 0x9c, 0xa5, 0x85, 0x7d, 0xe9, 0xb7, 0xa7, 0xf9, 0x08, 0xdf, 0x4b, 0x70, 0x6d, 0x3b, 0x73,
-		0x96, 0x27, 0xd8, 0x17, 0xa3, 0xe9, 0xbc, 0xd5, 0xbd, 0xc3, 0xcd, 0x50, 0x44, 0x91, 0x99, 0x51,
-		0x38, 0x93, 0x19, 0x1b, 0x99, 0x28, 0x76, 0xcc, 0xc0, 0xdd, 0x3b, 0x95, 0xaf, 0x58, 0x7b, 0x50,
-		0xdb, 0xad, 0x98, 0x94, 0x1f, 0xa7, 0x9f, 0xc8, 0x7b, 0x5c, 0x11, 0xf2, 0x7a, 0x4e, 0xf2, 0xa7,
-		0xeb, 0xaf, 0xd2, 0xc3, 0xf5, 0xdb, 0xd4, 0x5d, 0xfd, 0x5d, 0x01, 0xde, 0xfd, 0xdc, 0xb7, 0x30,
-		0x25, 0x79, 0xad, 0x64, 0x10, 0x80, 0x3a, 0x43, 0xa1, 0x9f, 0x1f, 0x7e, 0xa9, 0x7a, 0xe7, 0xe8,
-		0x79, 0x4c, 0x32, 0xef, 0xc1, 0x8d, 0x3e, 0x2e, 0x92, 0x20, 0xf7, 0xfb, 0x02, 0xdc, 0x30, 0xc8,
-		0x7e, 0x40, 0xc2, 0xe6, 0xff, 0xbc, 0x99, 0xe7, 0xcd, 0x15, 0xb8, 0xd9, 0xcf, 0x47, 0xc2, 0x9d,
-		0xeb, 0xff, 0x9a, 0x82, 0xd2, 0x13, 0x99, 0xcf, 0x1b, 0xcf, 0xea, 0xe8, 0xa7, 0x1a, 0xcc, 0x2b,
-		0x7e, 0x2a, 0x44, 0xb7, 0x86, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x72, 0xfb, 0x54, 0xbf, 0x47, 0x26,
-		0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96, 0x1d, 0xc1,
-		0x4c, 0xe6, 0xf5, 0x0b, 0x7d, 0x38, 0xec, 0x63, 0x5d, 0x65, 0x6d, 0x08, 0x8e, 0x94, 0xde, 0xd4,
-		0xb9, 0x3f, 0x1c, 0xf6, 0xd9, 0xa2, 0x8f, 0x5e, 0xe5, 0x79, 0x7d, 0x98, 0x4e, 0xdd, 0xa4, 0x50,
-		0x2d, 0x5f, 0x86, 0xea, 0x52, 0x58, 0x59, 0x1d, 0x98, 0x5e, 0x6a, 0xfc, 0xb5, 0x06, 0x17, 0x73,
-		0xc7, 0x7a, 0x74, 0x2f, 0x5f, 0x5c, 0xbf, 0xab, 0x4a, 0xe5, 0xfe, 0xa9, 0x78, 0xa5, 0x59, 0xbf,
-		0xd4, 0xe0, 0x1d, 0xe5, 0xa0, 0x8d, 0xee, 0xe4, 0x8b, 0xed, 0x75, 0xf1, 0xa8, 0x7c, 0x7b, 0x68,
-		0x3e, 0x69, 0xca, 0x09, 0xcc, 0x66, 0x01, 0x06, 0xad, 0x0d, 0x03, 0x46, 0x42, 0xff, 0x29, 0xf0,
-		0x0b, 0xfd, 0x4a, 0x83, 0x45, 0xf5, 0x6c, 0x88, 0x7a, 0x1c, 0xa7, 0xe7, 0x0c, 0x5b, 0xb9, 0x3b,
-		0x3c, 0xa3, 0xb4, 0xe6, 0xe7, 0x1a, 0x2c, 0xa8, 0x26, 0x11, 0x74, 0x7b, 0xd8, 0xc9, 0x45, 0x58,
-		0x72, 0xe7, 0x74, 0x03, 0x0f, 0xfa, 0xad, 0x06, 0x4b, 0x3d, 0x71, 0x0a, 0x7d, 0x92, 0x2f, 0x79,
-		0x90, 0x19, 0xa0, 0xf2, 0xe0, 0xd4, 0xfc, 0xd2, 0xc4, 0x3f, 0x68, 0x70, 0xa5, 0x77, 0xf3, 0x47,
-		0x0f, 0x7a, 0x95, 0xc7, 0x00, 0xd0, 0x5a, 0xf9, 0xee, 0xe9, 0x05, 0x08, 0x2b, 0x37, 0xef, 0xff,
-		0xe0, 0xe3, 0x03, 0x9b, 0x36, 0xa3, 0xbd, 0x5a, 0xc3, 0x6b, 0xad, 0xa6, 0xfe, 0x63, 0xb5, 0x76,
-		0x40, 0x5c, 0xf1, 0x2f, 0xbe, 0xc9, 0xff, 0x32, 0xbe, 0x1f, 0xff, 0x7d, 0xb4, 0xb6, 0x37, 0xc6,
-		0x77, 0x3f, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xe2, 0xa5, 0xb6, 0x93, 0x2c, 0x00,
-		0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0xdb, 0xc6,
+		0x19, 0x1f, 0x52, 0xa2, 0x28, 0x7d, 0xa4, 0x28, 0x69, 0xa5, 0xc8, 0x30, 0x6d, 0xd9, 0x32, 0x1d,
+		0xdb, 0x4a, 0x9b, 0x52, 0x91, 0x62, 0xbb, 0x8e, 0x3d, 0x89, 0xab, 0x87, 0x65, 0xb3, 0x13, 0xd7,
+		0x0a, 0xac, 0xd8, 0x33, 0x6d, 0xc6, 0xe8, 0x8a, 0x58, 0x89, 0xa8, 0x40, 0x00, 0x06, 0x16, 0x52,
+		0x98, 0x43, 0x0f, 0x9d, 0xb6, 0xd3, 0x99, 0x5e, 0xdb, 0x7b, 0xfa, 0xf8, 0x27, 0xda, 0x7f, 0x25,
+		0xd3, 0x43, 0x0f, 0xfd, 0x03, 0x7a, 0xe8, 0xad, 0x9d, 0xe9, 0xec, 0x03, 0x24, 0x40, 0x2e, 0xf8,
+		0x90, 0xe4, 0xb8, 0x87, 0xde, 0xb8, 0xbb, 0xdf, 0x6b, 0xbf, 0xc7, 0xfe, 0xbe, 0x5d, 0x10, 0x6e,
+		0x86, 0xfb, 0xc4, 0x5f, 0xad, 0x63, 0x93, 0x38, 0x75, 0xb2, 0xda, 0xc4, 0xb4, 0xde, 0xb0, 0x9c,
+		0xc3, 0xd5, 0xe3, 0xb5, 0xd5, 0x80, 0xf8, 0xc7, 0x56, 0x9d, 0x54, 0x3d, 0xdf, 0xa5, 0x2e, 0xd2,
+		0x18, 0x5d, 0x55, 0xd2, 0x55, 0x23, 0xba, 0xea, 0xf1, 0x5a, 0xf9, 0xca, 0xa1, 0xeb, 0x1e, 0xda,
+		0x64, 0x95, 0xd3, 0xed, 0x87, 0x07, 0xab, 0x66, 0xe8, 0x63, 0x6a, 0xb9, 0x8e, 0xe0, 0x2c, 0x5f,
+		0xed, 0x5e, 0xa7, 0x56, 0x93, 0x04, 0x14, 0x37, 0x3d, 0x49, 0xd0, 0x23, 0xe0, 0xc4, 0xc7, 0x9e,
+		0x47, 0xfc, 0x40, 0xae, 0x2f, 0x27, 0x4c, 0xc4, 0x9e, 0xc5, 0xac, 0xab, 0xbb, 0xcd, 0x66, 0x47,
+		0x85, 0x8a, 0xe2, 0x75, 0x48, 0xfc, 0x96, 0x24, 0xa8, 0xa8, 0x08, 0x28, 0x0e, 0x8e, 0x6c, 0x2b,
+		0xa0, 0x92, 0x66, 0x45, 0x45, 0x23, 0x9d, 0x60, 0x9c, 0xb8, 0xfe, 0x11, 0xf1, 0x25, 0xe5, 0x77,
+		0x06, 0x51, 0x1e, 0xd8, 0xee, 0x89, 0xa4, 0xbd, 0xa6, 0xa2, 0x6d, 0x58, 0x01, 0x75, 0xdb, 0xc6,
+		0xbd, 0x9b, 0x20, 0x09, 0x1a, 0xd8, 0x27, 0x66, 0x2f, 0xd5, 0x8d, 0x14, 0xaa, 0xe4, 0x2e, 0x2a,
+		0x9f, 0xc0, 0xdc, 0x1e, 0x0e, 0x8e, 0x3e, 0xb5, 0x02, 0xba, 0x8b, 0x7d, 0x6a, 0xb1, 0x40, 0xa0,
+		0xf7, 0x60, 0xd6, 0x0a, 0x5c, 0x9b, 0x47, 0xc5, 0x38, 0xf4, 0xdd, 0xd0, 0x0b, 0xb4, 0xcc, 0xf2,
+		0xd8, 0xca, 0x94, 0x3e, 0xd3, 0x9e, 0x7f, 0xcc, 0xa7, 0x2b, 0x7f, 0x1f, 0x87, 0x0b, 0x3d, 0x02,
+		0xb6, 0x5c, 0xe7, 0xc0, 0x3a, 0x44, 0x1a, 0xe4, 0x8f, 0x89, 0x1f, 0x58, 0xae, 0xa3, 0x65, 0x96,
+		0x33, 0x2b, 0x63, 0x7a, 0x34, 0x44, 0xeb, 0x30, 0xef, 0x84, 0x4d, 0xc3, 0x27, 0xd8, 0x34, 0xbc,
+		0x88, 0x2b, 0xd0, 0xb2, 0xcb, 0x99, 0x95, 0xdc, 0x66, 0x56, 0xcb, 0xe8, 0x73, 0x4e, 0xd8, 0xd4,
+		0x09, 0x36, 0xdb, 0x22, 0x03, 0x74, 0x1b, 0x16, 0x18, 0xcf, 0x89, 0x6f, 0x51, 0x12, 0x67, 0x1a,
+		0x6b, 0x33, 0x21, 0x27, 0x6c, 0xbe, 0x64, 0xcb, 0x31, 0x2e, 0x07, 0x66, 0xba, 0xb5, 0x8c, 0x2f,
+		0x8f, 0xad, 0x14, 0xd6, 0x1f, 0x55, 0xd3, 0x32, 0xb4, 0x9a, 0xb2, 0x9f, 0x6a, 0xd2, 0xa0, 0x47,
+		0x0e, 0xf5, 0x5b, 0x7a, 0xc9, 0x4f, 0x5a, 0xf9, 0x1a, 0x66, 0x7b, 0x2c, 0xcc, 0x71, 0x85, 0x3b,
+		0xa3, 0x2b, 0xec, 0xda, 0x8c, 0xd0, 0x38, 0x73, 0x92, 0x9c, 0x2d, 0x3b, 0x30, 0xaf, 0xb0, 0x0c,
+		0xcd, 0xc2, 0xd8, 0x11, 0x69, 0x71, 0xcf, 0xe7, 0x74, 0xf6, 0x13, 0x6d, 0x40, 0xee, 0x18, 0xdb,
+		0x21, 0xe1, 0x7e, 0x2e, 0xac, 0x7f, 0x77, 0x04, 0x83, 0x74, 0xc1, 0x79, 0x3f, 0x7b, 0x2f, 0x53,
+		0x76, 0x61, 0x41, 0x65, 0xd8, 0x1b, 0x53, 0x58, 0xf9, 0x29, 0xcc, 0x7d, 0xea, 0x62, 0x73, 0x13,
+		0xdb, 0xd8, 0xa9, 0x13, 0xff, 0x89, 0xe5, 0xd0, 0x00, 0x5d, 0x87, 0xe9, 0x7d, 0x5c, 0x3f, 0xb2,
+		0xdd, 0x43, 0xa3, 0xee, 0x86, 0x0e, 0x95, 0x29, 0x56, 0x94, 0x93, 0x5b, 0x6c, 0x0e, 0xdd, 0x84,
+		0x19, 0x1f, 0xb3, 0x60, 0x10, 0xdf, 0x08, 0x48, 0xdd, 0x75, 0x4c, 0x6e, 0x4a, 0x46, 0x9f, 0x66,
+		0xd3, 0xbb, 0xc4, 0x7f, 0xce, 0x27, 0x2b, 0xff, 0xcc, 0x40, 0x79, 0xd7, 0xb5, 0xed, 0x1d, 0xd7,
+		0xdf, 0x26, 0x75, 0x8b, 0xe5, 0x28, 0xb3, 0x48, 0x27, 0xaf, 0x43, 0x12, 0x50, 0x54, 0x83, 0xbc,
+		0x2f, 0x7e, 0x72, 0x2d, 0x85, 0xf5, 0xd5, 0xe4, 0x4e, 0xb0, 0x67, 0xb1, 0x4d, 0xa4, 0x4b, 0xd0,
+		0x23, 0x7e, 0x74, 0x09, 0xa6, 0x4c, 0xb7, 0x89, 0x2d, 0xc7, 0xb0, 0x84, 0x2d, 0x53, 0xfa, 0xa4,
+		0x98, 0xa8, 0x99, 0x6c, 0xd1, 0x73, 0x6d, 0x9b, 0xf8, 0x6c, 0x71, 0x4c, 0x2c, 0x8a, 0x89, 0x9a,
+		0x89, 0x6e, 0x40, 0xe9, 0xc0, 0xf5, 0x4f, 0xb0, 0x6f, 0x12, 0xd3, 0x38, 0xf0, 0xdd, 0xa6, 0x36,
+		0xce, 0x29, 0xa6, 0xdb, 0xb3, 0x3b, 0xbe, 0xdb, 0x44, 0xb7, 0x60, 0xa6, 0xab, 0x76, 0xb5, 0x1c,
+		0xa7, 0x2b, 0x25, 0x4b, 0xb7, 0xf2, 0x9f, 0x02, 0x5c, 0x52, 0x5a, 0x1c, 0x78, 0xae, 0x13, 0x10,
+		0xb4, 0x04, 0xc0, 0xce, 0x0a, 0x83, 0xba, 0x47, 0x44, 0x14, 0x70, 0x51, 0x9f, 0x62, 0x33, 0x7b,
+		0x6c, 0x02, 0x7d, 0x0e, 0x28, 0x3a, 0xba, 0x0c, 0xf2, 0x25, 0xa9, 0x87, 0x4c, 0xb2, 0x0c, 0xf4,
+		0x4d, 0xa5, 0x7b, 0x5e, 0x4a, 0xf2, 0x47, 0x11, 0xb5, 0x3e, 0x77, 0xd2, 0x3d, 0x85, 0x76, 0x60,
+		0xba, 0x2d, 0x96, 0xb6, 0x3c, 0xc2, 0xdd, 0x50, 0x58, 0xbf, 0xd6, 0x57, 0xe2, 0x5e, 0xcb, 0x23,
+		0x7a, 0xf1, 0x24, 0x36, 0x42, 0x2f, 0xe0, 0xa2, 0xe7, 0x93, 0x63, 0xcb, 0x0d, 0x03, 0x23, 0xa0,
+		0xd8, 0xa7, 0xc4, 0x34, 0xc8, 0x31, 0x71, 0x28, 0x73, 0xed, 0x38, 0x97, 0x79, 0xa9, 0x2a, 0x80,
+		0xa4, 0x1a, 0x01, 0x49, 0xb5, 0xe6, 0xd0, 0xbb, 0xb7, 0x5f, 0xb0, 0xbc, 0xd3, 0x17, 0x23, 0xee,
+		0xe7, 0x82, 0xf9, 0x11, 0xe3, 0xad, 0x99, 0x68, 0x05, 0x66, 0x7b, 0xc4, 0xe5, 0x78, 0xe6, 0x95,
+		0x82, 0x24, 0xa5, 0x06, 0x79, 0x4c, 0x29, 0x69, 0x7a, 0x54, 0x9b, 0xe0, 0x25, 0x11, 0x0d, 0x51,
+		0x05, 0xa6, 0x1d, 0xf2, 0x25, 0xed, 0x08, 0xc8, 0x73, 0x01, 0x05, 0x36, 0x19, 0x71, 0xbf, 0x0f,
+		0x28, 0x91, 0xde, 0x46, 0xc3, 0x72, 0xa8, 0x36, 0xc9, 0x09, 0x67, 0xe3, 0x39, 0xce, 0xaa, 0x01,
+		0xdd, 0x03, 0x2d, 0xa0, 0x56, 0xfd, 0xa8, 0xd5, 0x09, 0x85, 0x41, 0x1c, 0xbc, 0x6f, 0x13, 0x53,
+		0x9b, 0x5a, 0xce, 0xac, 0x4c, 0xea, 0x8b, 0x62, 0xbd, 0xed, 0xe8, 0x47, 0x62, 0x15, 0xdd, 0x83,
+		0x1c, 0x07, 0x3e, 0x0d, 0xb8, 0x4f, 0x2a, 0x7d, 0xfd, 0xfc, 0x19, 0xa3, 0xd4, 0x05, 0x03, 0xd2,
+		0x61, 0xda, 0x94, 0x79, 0x63, 0x58, 0xce, 0x81, 0xab, 0x15, 0xb8, 0x84, 0xef, 0x25, 0x25, 0x08,
+		0xe0, 0xe1, 0x25, 0xee, 0x63, 0x27, 0xb0, 0x88, 0x43, 0xa3, 0x6c, 0xab, 0x39, 0x07, 0xae, 0x5e,
+		0x34, 0x63, 0x23, 0xf4, 0x0a, 0x2e, 0xf7, 0x26, 0x95, 0xc1, 0xd3, 0x90, 0x61, 0x96, 0x56, 0xe4,
+		0x2a, 0x96, 0x94, 0x46, 0x46, 0x47, 0x88, 0x7e, 0xb1, 0x27, 0xab, 0xa2, 0x25, 0x54, 0x85, 0x79,
+		0xe1, 0x74, 0x86, 0x94, 0xc4, 0x88, 0xd0, 0x69, 0x9a, 0xc7, 0x67, 0x8e, 0x2f, 0x3d, 0x67, 0x2b,
+		0x2f, 0x24, 0x4e, 0x5d, 0x83, 0xe2, 0xbe, 0x8f, 0x9d, 0x7a, 0x43, 0x56, 0x41, 0x89, 0x57, 0x41,
+		0x41, 0xcc, 0x89, 0x3a, 0xd8, 0x80, 0x52, 0x50, 0x6f, 0x10, 0x33, 0xb4, 0x89, 0x69, 0xb0, 0x56,
+		0x45, 0x9b, 0xe1, 0x46, 0x96, 0x7b, 0xb2, 0x6b, 0x2f, 0xea, 0x63, 0xf4, 0xe9, 0x36, 0x07, 0x9b,
+		0x43, 0x1f, 0x43, 0x31, 0xca, 0x29, 0x2e, 0x60, 0x76, 0xa0, 0x80, 0x82, 0xa4, 0xe7, 0xec, 0x5f,
+		0x40, 0x9e, 0x45, 0xc4, 0x22, 0x81, 0x36, 0xc7, 0x91, 0x66, 0x33, 0xfd, 0x9c, 0xed, 0x53, 0xf0,
+		0xd5, 0xcf, 0x84, 0x10, 0x81, 0x32, 0x91, 0x48, 0xe6, 0x32, 0xea, 0x52, 0x6c, 0x1b, 0xb2, 0xbd,
+		0x30, 0xf6, 0x5b, 0x94, 0x04, 0x1a, 0xe2, 0x99, 0x38, 0xc7, 0x97, 0x9e, 0x88, 0x95, 0x4d, 0xb6,
+		0x80, 0xbe, 0x80, 0xd9, 0x36, 0xf4, 0x19, 0x75, 0x8e, 0x63, 0xda, 0x3c, 0xdf, 0xd0, 0xda, 0xc8,
+		0x00, 0xa8, 0xcf, 0x78, 0x5d, 0x2d, 0xc5, 0x4f, 0x60, 0xde, 0x76, 0xb1, 0x69, 0xec, 0x4b, 0x2c,
+		0xe0, 0x65, 0x11, 0x68, 0x0b, 0x83, 0xf0, 0xa5, 0x07, 0x3f, 0xf4, 0x39, 0xbb, 0x07, 0x52, 0x9e,
+		0xc2, 0x2c, 0x0e, 0xa9, 0x2b, 0xad, 0x16, 0x15, 0xf7, 0x0e, 0x97, 0x7c, 0x5d, 0x99, 0x71, 0x1b,
+		0x21, 0x75, 0x85, 0x5d, 0x8c, 0x5f, 0x2f, 0xe1, 0xc4, 0x18, 0x7d, 0x0c, 0xf9, 0xd0, 0x33, 0x31,
+		0xf3, 0xd6, 0x22, 0x8f, 0xcb, 0xf5, 0xbe, 0xc5, 0xf5, 0x39, 0xa7, 0xd5, 0x23, 0x9e, 0xf2, 0x2b,
+		0x28, 0xc6, 0x23, 0x12, 0x87, 0xd7, 0x29, 0x01, 0xaf, 0xf7, 0x92, 0xf0, 0x3a, 0x54, 0xed, 0x76,
+		0x50, 0x35, 0x86, 0x79, 0x1b, 0x75, 0x6a, 0x1d, 0x5b, 0xb4, 0x75, 0x7a, 0xcc, 0x53, 0x48, 0xf8,
+		0x5f, 0xc4, 0xbc, 0xdf, 0x43, 0x1b, 0xf3, 0x92, 0x16, 0xbf, 0x55, 0xcc, 0xbb, 0x0a, 0x05, 0x2c,
+		0xad, 0xe9, 0x38, 0x01, 0xa2, 0xa9, 0x9a, 0xc9, 0x40, 0xb1, 0x4d, 0xc0, 0x41, 0x71, 0xbc, 0x0f,
+		0x28, 0xb6, 0x37, 0xc6, 0x41, 0x11, 0xc7, 0x46, 0x68, 0x1d, 0x72, 0x96, 0xe3, 0x85, 0x94, 0x7b,
+		0xa7, 0xb0, 0x7e, 0x59, 0x1d, 0x51, 0xdc, 0x62, 0xa5, 0xa1, 0x0b, 0x52, 0xc5, 0xf9, 0x36, 0x71,
+		0xd6, 0xf3, 0x2d, 0x3f, 0xda, 0xf9, 0xb6, 0x07, 0x17, 0x23, 0x79, 0x06, 0xab, 0x4e, 0xdb, 0x0d,
+		0x08, 0x17, 0xe4, 0x86, 0x02, 0x11, 0x0b, 0xeb, 0x17, 0x7b, 0x64, 0x6d, 0xcb, 0x4b, 0xa5, 0xbe,
+		0x18, 0xf1, 0xee, 0xb9, 0x5b, 0x8c, 0x73, 0x4f, 0x30, 0xa2, 0x1f, 0xc1, 0x22, 0x57, 0xd2, 0x2b,
+		0x72, 0x6a, 0x90, 0xc8, 0x79, 0xce, 0xd8, 0x25, 0x6f, 0x07, 0xe6, 0x1a, 0x04, 0xfb, 0x74, 0x9f,
+		0x60, 0xda, 0x16, 0x05, 0x83, 0x44, 0xcd, 0xb6, 0x79, 0x22, 0x39, 0xb1, 0xb6, 0xa1, 0x90, 0x6c,
+		0x1b, 0x5e, 0xc1, 0x95, 0x64, 0x24, 0x0c, 0xf7, 0xc0, 0xa0, 0x0d, 0x2b, 0x30, 0x22, 0x86, 0xe2,
+		0x40, 0xc7, 0x96, 0x13, 0x91, 0x79, 0x76, 0xb0, 0xd7, 0xb0, 0x82, 0x0d, 0x29, 0xbf, 0x16, 0xdf,
+		0x81, 0x49, 0x28, 0xb6, 0xec, 0x80, 0x43, 0xe3, 0xa0, 0x4c, 0xe9, 0x6c, 0x62, 0x5b, 0x70, 0xf5,
+		0x76, 0x71, 0xa5, 0xd3, 0x75, 0x71, 0xb7, 0x60, 0xa6, 0x2d, 0x47, 0x9c, 0x18, 0x1c, 0x5d, 0xa7,
+		0xf4, 0x52, 0x34, 0xbd, 0xcd, 0x67, 0xd1, 0x87, 0x30, 0xd1, 0x20, 0xd8, 0x24, 0xbe, 0x04, 0xcf,
+		0x4b, 0x4a, 0x4d, 0x4f, 0x38, 0x89, 0x2e, 0x49, 0xd3, 0xc0, 0x64, 0xee, 0x5c, 0xc0, 0xe4, 0xcd,
+		0xe2, 0xa0, 0x0a, 0xaa, 0x16, 0x4e, 0x0d, 0x55, 0x95, 0xaf, 0x73, 0xb0, 0xb8, 0x61, 0x9a, 0xaa,
+		0xbb, 0x4f, 0xe2, 0xf0, 0xce, 0x74, 0x1d, 0xde, 0x6f, 0xe8, 0x40, 0xbc, 0x0f, 0x53, 0x9d, 0x9e,
+		0x6f, 0x6c, 0x98, 0x9e, 0x6f, 0x92, 0x46, 0x2d, 0xde, 0x55, 0x28, 0xb4, 0x4f, 0x0b, 0xd9, 0xea,
+		0x8f, 0xe9, 0x10, 0x4d, 0xd5, 0xcc, 0xee, 0xe3, 0x44, 0x1e, 0x02, 0xb2, 0x60, 0x73, 0x23, 0x1c,
+		0x27, 0xfc, 0x66, 0x10, 0x95, 0xed, 0x7d, 0x98, 0x08, 0xdc, 0xd0, 0xaf, 0x8b, 0xe3, 0xb1, 0xd4,
+		0x0d, 0xc6, 0xb1, 0x36, 0x18, 0x07, 0x47, 0xcf, 0x39, 0xa5, 0x2e, 0x39, 0x14, 0x28, 0x97, 0x57,
+		0xa1, 0x9c, 0xa7, 0xc8, 0xa8, 0xc9, 0x41, 0x6f, 0x19, 0xea, 0xa8, 0x56, 0xbb, 0x12, 0x4c, 0xbe,
+		0x2c, 0x74, 0x67, 0xd9, 0x0e, 0x4c, 0xf3, 0x38, 0x78, 0xbe, 0xe5, 0xfa, 0x16, 0x6d, 0xf1, 0xa3,
+		0xb1, 0x94, 0x52, 0xc6, 0x4c, 0xfc, 0xae, 0x24, 0xd4, 0x8b, 0x34, 0x36, 0x2a, 0x6f, 0xc2, 0x82,
+		0x4a, 0xa1, 0xa2, 0xa5, 0x59, 0x88, 0xb7, 0x34, 0x53, 0xf1, 0x76, 0xe5, 0x04, 0x2e, 0xf4, 0xec,
+		0x45, 0xa2, 0xb6, 0xaa, 0xd4, 0x32, 0xe7, 0x55, 0x6a, 0x95, 0xbf, 0x4e, 0xf0, 0xda, 0x50, 0xf5,
+		0x48, 0x6f, 0xa3, 0x36, 0xd8, 0x05, 0x94, 0xa7, 0x8d, 0xd1, 0x51, 0x2d, 0x3a, 0x86, 0x92, 0x98,
+		0xdf, 0x8e, 0x0c, 0x48, 0x54, 0xd1, 0xf8, 0x99, 0xaa, 0x28, 0x37, 0x5a, 0x15, 0x4d, 0x9c, 0xbd,
+		0x8a, 0xf2, 0xe7, 0x50, 0x45, 0x93, 0xaa, 0x2a, 0x72, 0x40, 0xc3, 0xb1, 0x50, 0x6e, 0x5b, 0x81,
+		0xc7, 0xb2, 0x82, 0x5d, 0x3f, 0x25, 0xf2, 0xaf, 0xf7, 0xa9, 0xa6, 0x14, 0x4e, 0x3d, 0x55, 0xa6,
+		0xb2, 0x6a, 0x61, 0x88, 0xaa, 0x55, 0xe4, 0xdb, 0x69, 0xab, 0xb6, 0xf0, 0xf6, 0xaa, 0xf6, 0x9b,
+		0x31, 0xd0, 0xd2, 0x9c, 0x86, 0x7e, 0x08, 0x33, 0x9d, 0x86, 0x86, 0x5f, 0xbe, 0x65, 0xd9, 0xaa,
+		0x4d, 0x95, 0xd7, 0x4c, 0xfe, 0x42, 0xa2, 0x77, 0x9a, 0x52, 0x3e, 0xee, 0xe9, 0x31, 0xb3, 0xa3,
+		0xf5, 0x98, 0xb1, 0xae, 0x6b, 0x6c, 0xd4, 0xae, 0x6b, 0xfc, 0xfc, 0xbb, 0xae, 0xdc, 0xf9, 0x74,
+		0x5d, 0x13, 0xe7, 0xd6, 0x75, 0xe5, 0x55, 0x5d, 0x97, 0x3c, 0x93, 0x95, 0x37, 0xa9, 0x37, 0x7b,
+		0x26, 0x7f, 0x93, 0x81, 0x05, 0x7e, 0xa1, 0x8d, 0x76, 0x11, 0x9d, 0xc8, 0x5b, 0xdd, 0xb7, 0xd6,
+		0xf7, 0x94, 0x9b, 0x57, 0xf1, 0x0e, 0x79, 0x5f, 0x3d, 0x4b, 0x6f, 0x32, 0xdc, 0x75, 0xb6, 0xf2,
+		0xef, 0x0c, 0xbc, 0xd3, 0x65, 0xa1, 0xf4, 0xea, 0x43, 0x28, 0xf2, 0xc7, 0x37, 0xc3, 0x27, 0x41,
+		0x68, 0x47, 0x7b, 0xec, 0x9f, 0x27, 0x05, 0xce, 0xa1, 0x73, 0x06, 0x54, 0x83, 0x52, 0x24, 0xe0,
+		0x67, 0xa4, 0x4e, 0x89, 0xd9, 0xf7, 0xed, 0x40, 0xbc, 0x19, 0x48, 0x4a, 0x7d, 0xfa, 0x75, 0x7c,
+		0x88, 0x5e, 0x2a, 0x22, 0x2c, 0xfc, 0xf1, 0x7e, 0x5f, 0x7f, 0x0c, 0x0c, 0xee, 0x3f, 0x32, 0xb0,
+		0x2c, 0x76, 0x6c, 0x72, 0x03, 0x18, 0xe3, 0x96, 0xdb, 0xf4, 0x6c, 0xc2, 0xac, 0x90, 0x31, 0x7a,
+		0xd6, 0x1d, 0xe8, 0x3b, 0x4a, 0xa5, 0x83, 0xe4, 0x7c, 0x0b, 0x41, 0xbf, 0x00, 0x79, 0xce, 0x2b,
+		0x9b, 0xd1, 0x29, 0x7d, 0x82, 0x0d, 0x6b, 0x66, 0xe5, 0x3a, 0x5c, 0xeb, 0x63, 0x9e, 0x88, 0x78,
+		0xe5, 0x6f, 0x19, 0xb8, 0xbc, 0xc5, 0xae, 0x15, 0xf6, 0xb3, 0x90, 0x06, 0x14, 0x3b, 0xa6, 0xe5,
+		0x1c, 0xee, 0xba, 0xb6, 0x3d, 0x54, 0x0f, 0x92, 0x78, 0x5c, 0xc9, 0x76, 0x3d, 0xae, 0x3c, 0x86,
+		0x52, 0x7b, 0x53, 0x9d, 0xb7, 0xf6, 0x7e, 0x40, 0xc1, 0xf6, 0x23, 0xce, 0x0b, 0x1a, 0x1b, 0x9d,
+		0xa5, 0xd1, 0xa8, 0x5c, 0x85, 0xa5, 0x94, 0xed, 0x49, 0x07, 0xfc, 0x1c, 0x2e, 0x6c, 0x93, 0xa0,
+		0xee, 0x5b, 0xfb, 0xa4, 0xcd, 0x2e, 0xb7, 0xbe, 0xd3, 0x9d, 0x03, 0xea, 0xc4, 0x4b, 0x61, 0x1f,
+		0x2e, 0xf4, 0x95, 0xbf, 0x64, 0x41, 0xeb, 0x95, 0x20, 0xeb, 0xf1, 0x23, 0xc8, 0x0b, 0x77, 0x8a,
+		0xef, 0xa3, 0x85, 0xf5, 0xab, 0xa9, 0x8f, 0x64, 0xc4, 0xe7, 0x8d, 0x42, 0x44, 0xcf, 0x6e, 0x70,
+		0x1d, 0xef, 0x07, 0x14, 0xd3, 0x30, 0x90, 0xb5, 0x78, 0xbd, 0xaf, 0xef, 0x9e, 0x73, 0x52, 0xbd,
+		0x44, 0x13, 0xe3, 0x37, 0x56, 0x8d, 0x67, 0x0a, 0x6e, 0x00, 0x4b, 0x3c, 0x49, 0xba, 0x75, 0x05,
+		0x51, 0x04, 0x17, 0x61, 0x42, 0x02, 0x8c, 0xc8, 0x5c, 0x39, 0x4a, 0x2a, 0xcd, 0x8e, 0xa6, 0xf4,
+		0xd7, 0x59, 0xb8, 0x92, 0xa6, 0x55, 0x86, 0xed, 0x35, 0x2c, 0x75, 0xde, 0xd3, 0xda, 0x41, 0x88,
+		0x7d, 0xb1, 0x15, 0xc1, 0xac, 0x0e, 0xe7, 0xb9, 0xa7, 0x84, 0x62, 0x13, 0x53, 0xac, 0x97, 0xe3,
+		0x4d, 0x60, 0x52, 0x35, 0x53, 0xd9, 0xfe, 0x5a, 0xa2, 0x54, 0x99, 0x3d, 0x9d, 0x4a, 0x33, 0x76,
+		0x21, 0x4a, 0xaa, 0xac, 0xdc, 0x81, 0x4b, 0x8f, 0x49, 0xdb, 0x0d, 0xc1, 0x66, 0x4b, 0xa0, 0xf6,
+		0x00, 0xdf, 0x57, 0xfe, 0x3c, 0x0e, 0x97, 0xd5, 0x7c, 0xd2, 0x7b, 0xbf, 0xcc, 0xc0, 0xa2, 0x62,
+		0x2f, 0x4d, 0xec, 0x49, 0xbf, 0x3d, 0x4b, 0x47, 0xf8, 0x7e, 0x82, 0xab, 0xdb, 0x5d, 0x7b, 0x79,
+		0x8a, 0x3d, 0xd1, 0xe2, 0xce, 0x9b, 0xbd, 0x2b, 0xdc, 0x0c, 0x45, 0x14, 0x99, 0x19, 0xd9, 0x33,
+		0x99, 0xb1, 0xd1, 0x15, 0xc5, 0x8e, 0x19, 0xb8, 0x77, 0xa5, 0xfc, 0x15, 0x3b, 0x1e, 0xd4, 0x76,
+		0x2b, 0x3a, 0xe5, 0x27, 0xc9, 0x27, 0xfb, 0x3e, 0x57, 0x8d, 0xb4, 0x33, 0x27, 0xfe, 0x25, 0xfe,
+		0xab, 0x64, 0x73, 0xfd, 0x6d, 0xea, 0xae, 0xfc, 0x21, 0x0b, 0xef, 0x8a, 0x4f, 0x16, 0x69, 0x47,
+		0xc9, 0x30, 0x00, 0x75, 0x86, 0x42, 0x3f, 0x3f, 0xfc, 0x52, 0x9d, 0x9d, 0xe3, 0xe7, 0xd1, 0xc9,
+		0xdc, 0x82, 0x1b, 0x03, 0x5c, 0x24, 0x41, 0xee, 0x8f, 0x59, 0xb8, 0xa1, 0x93, 0x03, 0x9f, 0x04,
+		0x8d, 0xff, 0x7b, 0x33, 0xcd, 0x9b, 0x2b, 0x70, 0x73, 0x90, 0x8f, 0x84, 0x3b, 0xd7, 0xff, 0x55,
+		0x84, 0xc2, 0x53, 0x99, 0xcf, 0x1b, 0xbb, 0x35, 0xf4, 0x8b, 0x0c, 0xcc, 0x2b, 0xbe, 0x7c, 0xa2,
+		0xdb, 0x23, 0x7e, 0x28, 0xe5, 0x21, 0x28, 0xdf, 0x39, 0xd5, 0xe7, 0xd5, 0xb8, 0x11, 0xf1, 0xa2,
+		0x1d, 0xc2, 0x08, 0xc5, 0x53, 0xc0, 0x10, 0x46, 0x28, 0xaf, 0x65, 0xc7, 0x30, 0xd3, 0xf5, 0x8a,
+		0x86, 0x3e, 0x18, 0xf5, 0xf1, 0xb0, 0xbc, 0x36, 0x02, 0x47, 0x42, 0x6f, 0x62, 0xdf, 0x1f, 0x8c,
+		0xfa, 0xfc, 0x31, 0x40, 0xaf, 0x72, 0xbf, 0x1e, 0x4c, 0x27, 0x6e, 0x52, 0xa8, 0x9a, 0x2e, 0x43,
+		0x75, 0x29, 0x2c, 0xaf, 0x0e, 0x4d, 0x2f, 0x35, 0xfe, 0x2e, 0x03, 0x17, 0x53, 0xdb, 0x7a, 0x74,
+		0x3f, 0x5d, 0xdc, 0xa0, 0xab, 0x4a, 0xf9, 0xc1, 0xa9, 0x78, 0xa5, 0x59, 0xbf, 0xc9, 0xc0, 0x3b,
+		0xca, 0x46, 0x1b, 0xdd, 0x4d, 0x17, 0xdb, 0xef, 0xe2, 0x51, 0xfe, 0xfe, 0xc8, 0x7c, 0xd2, 0x94,
+		0x16, 0xcc, 0x76, 0x03, 0x0c, 0x5a, 0x1b, 0x05, 0x8c, 0x84, 0xfe, 0x53, 0xe0, 0x17, 0xfa, 0x6d,
+		0x06, 0x16, 0xd5, 0xbd, 0x21, 0xea, 0xb3, 0x9d, 0xbe, 0x3d, 0x6c, 0xf9, 0xde, 0xe8, 0x8c, 0xd2,
+		0x9a, 0x5f, 0x65, 0x60, 0x41, 0xd5, 0x89, 0xa0, 0x3b, 0xa3, 0x76, 0x2e, 0xc2, 0x92, 0xbb, 0xa7,
+		0x6b, 0x78, 0xd0, 0xd7, 0x19, 0x58, 0xea, 0x8b, 0x53, 0xe8, 0x93, 0x74, 0xc9, 0xc3, 0xf4, 0x00,
+		0xe5, 0x87, 0xa7, 0xe6, 0x97, 0x26, 0xfe, 0x29, 0x03, 0x57, 0xfa, 0x1f, 0xfe, 0xe8, 0x61, 0xbf,
+		0xf2, 0x18, 0x02, 0x5a, 0xcb, 0x3f, 0x38, 0xbd, 0x00, 0x61, 0xe5, 0xe6, 0x83, 0x1f, 0x7f, 0x74,
+		0x68, 0xd1, 0x46, 0xb8, 0x5f, 0xad, 0xbb, 0xcd, 0xd5, 0xc4, 0x1f, 0x70, 0xab, 0x87, 0xc4, 0x11,
+		0xff, 0x58, 0x8e, 0xff, 0x69, 0xfa, 0x41, 0xf4, 0xfb, 0x78, 0x6d, 0x7f, 0x82, 0xaf, 0x7e, 0xf8,
+		0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0x7f, 0x23, 0x8a, 0x62, 0x2d, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -1125,884 +1127,1038 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x73, 0xdb, 0xc8,
-		0xd1, 0x7e, 0x41, 0x4a, 0xb2, 0xd5, 0xd4, 0x07, 0x34, 0xb2, 0x2c, 0xfa, 0x63, 0x6d, 0x59, 0xbb,
-		0xf6, 0xca, 0x7c, 0xd7, 0xd2, 0xca, 0xfb, 0xbd, 0xce, 0xc6, 0x81, 0x40, 0xc8, 0x86, 0x4d, 0x83,
-		0xcc, 0x00, 0xb4, 0x56, 0x5b, 0x49, 0x50, 0x10, 0x38, 0x92, 0x10, 0x93, 0x00, 0x0b, 0x18, 0xda,
-		0xd6, 0x3d, 0x55, 0x39, 0x27, 0xa7, 0x54, 0x4e, 0xf9, 0x01, 0xa9, 0x4a, 0xa5, 0x72, 0xc8, 0x29,
-		0x95, 0x4b, 0xae, 0xb9, 0xe6, 0x2f, 0xa4, 0xf2, 0x2f, 0x52, 0x33, 0x18, 0x90, 0x20, 0x09, 0x12,
-		0x74, 0x52, 0xb5, 0xb9, 0x09, 0x3d, 0xcf, 0xd3, 0xe8, 0xe9, 0xe9, 0x7e, 0x7a, 0xc0, 0x12, 0x6c,
-		0xf7, 0x4e, 0x48, 0xb8, 0xe7, 0x3a, 0x2d, 0xe2, 0xbb, 0x64, 0xcf, 0xe9, 0x7a, 0x7b, 0xaf, 0xf7,
-		0xf7, 0xde, 0x04, 0xe1, 0xab, 0xd3, 0x76, 0xf0, 0x66, 0xb7, 0x1b, 0x06, 0x34, 0x40, 0xeb, 0x0c,
-		0xb3, 0x2b, 0x30, 0xbb, 0x4e, 0xd7, 0xdb, 0x7d, 0xbd, 0x7f, 0xfd, 0xd6, 0x59, 0x10, 0x9c, 0xb5,
-		0xc9, 0x1e, 0x87, 0x9c, 0xf4, 0x4e, 0xf7, 0x5a, 0xbd, 0xd0, 0xa1, 0x5e, 0xe0, 0xc7, 0xa4, 0xeb,
-		0xb7, 0x47, 0xd7, 0xa9, 0xd7, 0x21, 0x11, 0x75, 0x3a, 0x5d, 0x01, 0xd8, 0xca, 0x7a, 0xb3, 0x1b,
-		0x74, 0x3a, 0x7d, 0x17, 0x99, 0xb1, 0x51, 0x27, 0x7a, 0xd5, 0xf6, 0x22, 0x1a, 0x63, 0xb6, 0xff,
-		0x06, 0xb0, 0x71, 0x24, 0xc2, 0xd5, 0xde, 0x12, 0xb7, 0xc7, 0x42, 0xd0, 0xfd, 0xd3, 0x00, 0x35,
-		0x01, 0x25, 0xfb, 0xb0, 0x49, 0xb2, 0x52, 0x96, 0xb6, 0xa4, 0x9d, 0xd2, 0xc3, 0x7b, 0xbb, 0x19,
-		0x5b, 0xda, 0x1d, 0xf3, 0x83, 0xd7, 0xde, 0x8c, 0x9a, 0xd0, 0x67, 0x30, 0x47, 0x2f, 0xba, 0xa4,
-		0x5c, 0xe0, 0x8e, 0xee, 0x4c, 0x75, 0x64, 0x5d, 0x74, 0x09, 0xe6, 0x70, 0xf4, 0x15, 0x40, 0x44,
-		0x9d, 0x90, 0xda, 0x2c, 0x0d, 0xe5, 0x22, 0x27, 0x5f, 0xdf, 0x8d, 0x73, 0xb4, 0x9b, 0xe4, 0x68,
-		0xd7, 0x4a, 0x72, 0x84, 0x17, 0x39, 0x9a, 0x3d, 0x33, 0xaa, 0xdb, 0x0e, 0x22, 0x12, 0x53, 0xe7,
-		0xf2, 0xa9, 0x1c, 0xcd, 0xa9, 0x16, 0x2c, 0xc5, 0xd4, 0x88, 0x3a, 0xb4, 0x17, 0x95, 0xe7, 0xb7,
-		0xa4, 0x9d, 0x95, 0x87, 0xfb, 0xb3, 0xed, 0x5e, 0x65, 0x4c, 0x93, 0x13, 0x71, 0xc9, 0x1d, 0x3c,
-		0xa0, 0xbb, 0xb0, 0x72, 0xee, 0x45, 0x34, 0x08, 0x2f, 0xec, 0x36, 0xf1, 0xcf, 0xe8, 0x79, 0x79,
-		0x61, 0x4b, 0xda, 0x29, 0xe2, 0x65, 0x61, 0xad, 0x71, 0x23, 0xfa, 0x09, 0x6c, 0x74, 0x9d, 0x90,
-		0xf8, 0x74, 0x90, 0x7e, 0xdb, 0xf3, 0x4f, 0x83, 0xf2, 0x25, 0xbe, 0x85, 0x9d, 0xcc, 0x28, 0x1a,
-		0x9c, 0x31, 0x74, 0x92, 0x78, 0xbd, 0x3b, 0x6e, 0x44, 0x0a, 0xac, 0x0c, 0xdc, 0xf2, 0xcc, 0x5c,
-		0xce, 0xcd, 0xcc, 0x72, 0x9f, 0xc1, 0xb3, 0xf3, 0x00, 0xe6, 0x3a, 0xa4, 0x13, 0x94, 0x17, 0x39,
-		0xf1, 0x5a, 0x66, 0x3c, 0x2f, 0x48, 0x27, 0xc0, 0x1c, 0x86, 0x30, 0xac, 0x45, 0xc4, 0x09, 0xdd,
-		0x73, 0xdb, 0xa1, 0x34, 0xf4, 0x4e, 0x7a, 0x94, 0x44, 0x65, 0xe0, 0xdc, 0xbb, 0x99, 0x5c, 0x93,
-		0xa3, 0x95, 0x3e, 0x18, 0xcb, 0xd1, 0x88, 0x05, 0xd5, 0x60, 0xcd, 0xe9, 0xd1, 0xc0, 0x0e, 0x49,
-		0x44, 0xa8, 0xdd, 0x0d, 0x3c, 0x9f, 0x46, 0xe5, 0x12, 0xf7, 0xb9, 0x95, 0xe9, 0x13, 0x33, 0x60,
-		0x83, 0xe3, 0xf0, 0x2a, 0xa3, 0xa6, 0x0c, 0xe8, 0x06, 0x2c, 0xb2, 0xf6, 0xb0, 0x59, 0x7f, 0x94,
-		0x97, 0xb6, 0xa4, 0x9d, 0x45, 0x7c, 0x99, 0x19, 0x6a, 0x5e, 0x44, 0x91, 0x0a, 0x2b, 0xfd, 0xc5,
-		0xf8, 0x1c, 0xd6, 0xf8, 0x7b, 0xde, 0xcb, 0x7c, 0x8f, 0x25, 0x68, 0x78, 0x29, 0x71, 0xc0, 0xb3,
-		0xbe, 0x09, 0x97, 0xbc, 0xc8, 0x76, 0xc3, 0xc0, 0x2f, 0x2f, 0x6f, 0x49, 0x3b, 0x97, 0xf1, 0x82,
-		0x17, 0xa9, 0x61, 0xe0, 0xa3, 0x47, 0x50, 0xea, 0x75, 0x5b, 0x0e, 0x15, 0x55, 0xba, 0x92, 0x7b,
-		0x16, 0x10, 0xc3, 0xf9, 0x41, 0xfc, 0x1c, 0xe4, 0xae, 0x13, 0x52, 0x8f, 0x9f, 0xa5, 0x1b, 0xf8,
-		0xa7, 0xde, 0x59, 0x79, 0x75, 0xab, 0xb8, 0x53, 0x7a, 0xf8, 0x78, 0xb6, 0x52, 0x65, 0xb1, 0xb1,
-		0xd2, 0x89, 0x5d, 0xa8, 0xdc, 0x83, 0xe6, 0xd3, 0xf0, 0x02, 0xaf, 0x76, 0x87, 0xad, 0xe8, 0x25,
-		0xac, 0xb3, 0xf0, 0xed, 0xe0, 0x35, 0x09, 0xdb, 0x4e, 0xd7, 0xee, 0x06, 0x6d, 0xcf, 0xbd, 0x28,
-		0xcb, 0xbc, 0x33, 0xb2, 0x75, 0x81, 0x6d, 0xb0, 0x1e, 0xc3, 0x1b, 0x1c, 0x8d, 0xd7, 0xdc, 0x51,
-		0x13, 0x7a, 0x0b, 0xb7, 0x1d, 0x97, 0x7a, 0xaf, 0x89, 0xed, 0xb6, 0x7b, 0x11, 0x25, 0xa1, 0x1d,
-		0x91, 0x36, 0x71, 0xf9, 0x96, 0xc4, 0x3b, 0x10, 0x4f, 0x4a, 0x76, 0xf7, 0x29, 0x9c, 0xab, 0xc6,
-		0x54, 0x33, 0x61, 0x8a, 0xd7, 0xdd, 0x74, 0xa6, 0xac, 0x5e, 0x3f, 0x80, 0x2b, 0x59, 0x5b, 0x47,
-		0x32, 0x14, 0x5f, 0x91, 0x0b, 0xae, 0x78, 0x8b, 0x98, 0xfd, 0x89, 0xae, 0xc0, 0xfc, 0x6b, 0xa7,
-		0xdd, 0x8b, 0xc5, 0x6b, 0x11, 0xc7, 0x0f, 0x5f, 0x17, 0xbe, 0x94, 0xb6, 0x7f, 0x53, 0x80, 0x5b,
-		0xe3, 0x02, 0xc0, 0x9d, 0x09, 0x59, 0x47, 0x5f, 0xa7, 0x8b, 0x4b, 0x9a, 0xa5, 0x74, 0x06, 0xb5,
-		0xe7, 0xc0, 0xd6, 0xa0, 0x59, 0x85, 0x0e, 0x06, 0xf6, 0x40, 0xd5, 0x82, 0x1e, 0x15, 0x82, 0x7a,
-		0x6d, 0xac, 0x64, 0xaa, 0x22, 0x00, 0x7c, 0xb3, 0xef, 0xc2, 0xe4, 0xda, 0x18, 0xa8, 0x89, 0xce,
-		0x05, 0x3d, 0x8a, 0x8e, 0xe0, 0x06, 0x0f, 0x6f, 0x82, 0xf7, 0x62, 0x9e, 0xf7, 0x4d, 0xc6, 0xce,
-		0x70, 0xbc, 0xfd, 0x77, 0x09, 0xd6, 0x33, 0x54, 0x89, 0x35, 0x5b, 0x2b, 0xe8, 0x38, 0x9e, 0x6f,
-		0x7b, 0x2d, 0x91, 0xe4, 0xcb, 0xb1, 0x41, 0x6f, 0xa1, 0xdb, 0x50, 0x12, 0x8b, 0xbe, 0xd3, 0x49,
-		0xf2, 0x0d, 0xb1, 0xc9, 0x70, 0x3a, 0x64, 0xc2, 0x74, 0x2a, 0xfe, 0xb7, 0xd3, 0xe9, 0x0e, 0x2c,
-		0x79, 0xbe, 0x47, 0x3d, 0x87, 0x92, 0x16, 0x8b, 0x6b, 0x8e, 0x0b, 0x73, 0xa9, 0x6f, 0xd3, 0x5b,
-		0xdb, 0xbf, 0x92, 0x60, 0x43, 0x7b, 0x4b, 0x49, 0xe8, 0x3b, 0xed, 0xef, 0x65, 0x62, 0x8e, 0xc6,
-		0x54, 0x18, 0x8f, 0xe9, 0xcf, 0x0b, 0xb0, 0xde, 0x20, 0x7e, 0xcb, 0xf3, 0xcf, 0x78, 0x23, 0x78,
-		0xf4, 0x82, 0x47, 0x74, 0x1b, 0x4a, 0x8e, 0x78, 0x1e, 0x64, 0x19, 0x12, 0x93, 0xde, 0x42, 0x87,
-		0xb0, 0xdc, 0x07, 0xe4, 0x8e, 0xe5, 0xc4, 0x35, 0x1f, 0xcb, 0x4b, 0x4e, 0xea, 0x09, 0x3d, 0x86,
-		0x79, 0x36, 0x22, 0xe3, 0xc9, 0xbc, 0xf2, 0xf0, 0x7e, 0xf6, 0x6c, 0x1a, 0x8e, 0x90, 0x4d, 0x43,
-		0x82, 0x63, 0x1e, 0xd2, 0x61, 0xed, 0x9c, 0x38, 0x21, 0x3d, 0x21, 0x0e, 0xb5, 0x5b, 0x84, 0x3a,
-		0x5e, 0x3b, 0x12, 0xb3, 0xfa, 0xe6, 0x84, 0x41, 0x77, 0xd1, 0x0e, 0x9c, 0x16, 0x96, 0xfb, 0xb4,
-		0x6a, 0xcc, 0x42, 0xcf, 0x60, 0xbd, 0xed, 0x44, 0xd4, 0x1e, 0xf8, 0xe3, 0x92, 0x3a, 0x9f, 0x2b,
-		0xa9, 0x6b, 0x8c, 0xf6, 0x34, 0x61, 0x71, 0x65, 0x3d, 0x04, 0x6e, 0x8c, 0xbb, 0x82, 0xb4, 0x62,
-		0x4f, 0x0b, 0xb9, 0x9e, 0x56, 0x19, 0xc9, 0x8c, 0x39, 0xdc, 0x4f, 0x19, 0x2e, 0x39, 0x94, 0x92,
-		0x4e, 0x97, 0xf2, 0xe9, 0x3d, 0x8f, 0x93, 0x47, 0x74, 0x1f, 0xe4, 0x8e, 0xf3, 0xd6, 0xeb, 0xf4,
-		0x3a, 0xb6, 0x30, 0x45, 0x7c, 0x12, 0xcf, 0xe3, 0x55, 0x61, 0x57, 0x84, 0x99, 0x8d, 0xec, 0xc8,
-		0x3d, 0x27, 0xad, 0x5e, 0x3b, 0x89, 0x64, 0x31, 0x7f, 0x64, 0xf7, 0x19, 0x3c, 0x0e, 0x15, 0x56,
-		0xc9, 0xdb, 0xae, 0x17, 0xf7, 0x6c, 0xec, 0x03, 0x72, 0x7d, 0xac, 0x0c, 0x28, 0xdc, 0xc9, 0x63,
-		0x58, 0xe2, 0x49, 0x39, 0x75, 0xbc, 0x76, 0x2f, 0x24, 0x62, 0xde, 0x66, 0x1f, 0xd3, 0x61, 0x8c,
-		0xc1, 0x25, 0xc6, 0x10, 0x0f, 0xe8, 0x63, 0xb8, 0xc2, 0x1d, 0xb0, 0x5a, 0x27, 0xa1, 0xed, 0xb5,
-		0x88, 0x4f, 0x3d, 0x7a, 0x21, 0x46, 0x2e, 0x62, 0x6b, 0x47, 0x7c, 0x49, 0x17, 0x2b, 0xe8, 0x73,
-		0xd8, 0x4c, 0x8e, 0x60, 0x94, 0xb4, 0xcc, 0x49, 0x1b, 0x62, 0x79, 0x84, 0x77, 0x1b, 0x4a, 0x49,
-		0x02, 0x58, 0x03, 0xac, 0xf0, 0xd6, 0x81, 0xc4, 0xa4, 0xb7, 0xb6, 0xff, 0x54, 0x80, 0x6b, 0xa2,
-		0x2e, 0xd5, 0x73, 0xaf, 0xdd, 0xfa, 0x5e, 0x3a, 0xfa, 0xa3, 0x94, 0x5b, 0xd6, 0x75, 0x69, 0x91,
-		0x93, 0xdf, 0xa4, 0x2e, 0xbf, 0x5c, 0xea, 0x46, 0xfb, 0xbf, 0x38, 0xd6, 0xff, 0x6c, 0x28, 0x8b,
-		0xab, 0x62, 0xac, 0xda, 0x62, 0x60, 0xce, 0x4d, 0x19, 0xca, 0xb1, 0x24, 0x73, 0xa5, 0x4e, 0x86,
-		0x72, 0x77, 0xd4, 0x84, 0xae, 0xc2, 0x42, 0xac, 0xb9, 0xbc, 0x7b, 0x16, 0xb1, 0x78, 0xda, 0xfe,
-		0x67, 0xa1, 0xaf, 0x37, 0x55, 0xe2, 0x7a, 0x51, 0x92, 0xaf, 0xbe, 0x0c, 0x48, 0xf9, 0x32, 0x90,
-		0x10, 0x87, 0x64, 0x60, 0xbc, 0xc4, 0x0b, 0xef, 0x5a, 0xe2, 0xdf, 0xc0, 0xd2, 0x50, 0xb7, 0xe6,
-		0x7f, 0x2b, 0x94, 0xa2, 0xec, 0x4e, 0x9d, 0x1b, 0xee, 0x54, 0x0c, 0x9b, 0x41, 0xe8, 0x9d, 0x79,
-		0xbe, 0xd3, 0xb6, 0x47, 0x82, 0xcc, 0xd7, 0x96, 0x8d, 0x84, 0x6a, 0x0e, 0x05, 0x3b, 0x52, 0x9f,
-		0x0b, 0x63, 0xf5, 0xf9, 0x97, 0x02, 0x5c, 0x4b, 0x04, 0xb3, 0x16, 0xb8, 0x4e, 0xbb, 0xea, 0x45,
-		0x5d, 0x87, 0xba, 0xe7, 0xb3, 0xe9, 0xfb, 0xff, 0x3e, 0x9f, 0x3f, 0x83, 0x5b, 0xc3, 0x11, 0xd8,
-		0xc1, 0xa9, 0x4d, 0xcf, 0xbd, 0xc8, 0x4e, 0xa7, 0x79, 0xba, 0xc3, 0xeb, 0x43, 0x11, 0xd5, 0x4f,
-		0xad, 0x73, 0x2f, 0x12, 0xaa, 0x88, 0xde, 0x03, 0xe0, 0xf7, 0x16, 0x1a, 0xbc, 0x22, 0x71, 0x99,
-		0x2e, 0x61, 0x7e, 0xd1, 0xb2, 0x98, 0x61, 0xfb, 0x19, 0x94, 0xd2, 0x37, 0xfc, 0x47, 0xb0, 0x20,
-		0x3e, 0x12, 0x24, 0x7e, 0x3f, 0x7e, 0x3f, 0xe7, 0x23, 0x81, 0x7f, 0x3f, 0x09, 0xca, 0xf6, 0x1f,
-		0x0a, 0xb0, 0x32, 0xbc, 0x84, 0x3e, 0x84, 0xd5, 0x13, 0xcf, 0x77, 0xc2, 0x0b, 0xdb, 0x3d, 0x27,
-		0xee, 0xab, 0xa8, 0xd7, 0x11, 0x87, 0xb0, 0x12, 0x9b, 0x55, 0x61, 0x45, 0x1b, 0xb0, 0x10, 0xf6,
-		0xfc, 0x64, 0x7c, 0x2f, 0xe2, 0xf9, 0xb0, 0xc7, 0xee, 0x39, 0xdf, 0xc0, 0x8d, 0x53, 0x2f, 0x8c,
-		0xd8, 0xc8, 0x8b, 0xbb, 0xc1, 0x76, 0x83, 0x4e, 0xb7, 0x4d, 0x86, 0x5a, 0xbd, 0xcc, 0x21, 0x49,
-		0xbf, 0xa8, 0x09, 0x80, 0xd3, 0x97, 0xdc, 0x90, 0x38, 0xfd, 0xb3, 0xc9, 0x4f, 0x65, 0x49, 0xe0,
-		0x85, 0x90, 0x2f, 0x73, 0x69, 0xf7, 0xfc, 0xb3, 0x59, 0xeb, 0x78, 0x29, 0x21, 0x70, 0x07, 0xb7,
-		0x00, 0xf8, 0x97, 0x17, 0x75, 0x4e, 0xda, 0xf1, 0x5c, 0xbc, 0x8c, 0x53, 0x96, 0xca, 0x1f, 0x25,
-		0xb8, 0x92, 0x35, 0xf5, 0xd1, 0x36, 0xdc, 0x6a, 0x68, 0x46, 0x55, 0x37, 0x9e, 0xd8, 0x8a, 0x6a,
-		0xe9, 0x2f, 0x75, 0xeb, 0xd8, 0x36, 0x2d, 0xc5, 0xd2, 0x6c, 0xdd, 0x78, 0xa9, 0xd4, 0xf4, 0xaa,
-		0xfc, 0x7f, 0xe8, 0x03, 0xd8, 0x9a, 0x80, 0x31, 0xd5, 0xa7, 0x5a, 0xb5, 0x59, 0xd3, 0xaa, 0xb2,
-		0x34, 0xc5, 0x93, 0x69, 0x29, 0xd8, 0xd2, 0xaa, 0x72, 0x01, 0xfd, 0x3f, 0x7c, 0x38, 0x01, 0xa3,
-		0x2a, 0x86, 0xaa, 0xd5, 0x6c, 0xac, 0xfd, 0xb8, 0xa9, 0x99, 0x0c, 0x5c, 0xac, 0xfc, 0x62, 0x10,
-		0xf3, 0x90, 0x44, 0xa5, 0xdf, 0x54, 0xd5, 0x54, 0xdd, 0xd4, 0xeb, 0xc6, 0xb4, 0x98, 0x47, 0x30,
-		0x13, 0x62, 0x1e, 0x45, 0x25, 0x31, 0x57, 0x7e, 0x59, 0x18, 0xfc, 0x30, 0xa3, 0xb7, 0x30, 0xe9,
-		0xf5, 0x45, 0xf9, 0x03, 0xd8, 0x3a, 0xaa, 0xe3, 0xe7, 0x87, 0xb5, 0xfa, 0x91, 0xad, 0x57, 0x6d,
-		0xac, 0x35, 0x4d, 0xcd, 0x6e, 0xd4, 0x6b, 0xba, 0x7a, 0x9c, 0x8a, 0xe4, 0x4b, 0xf8, 0x74, 0x22,
-		0x4a, 0xa9, 0x31, 0x6b, 0xb5, 0xd9, 0xa8, 0xe9, 0x2a, 0x7b, 0xeb, 0xa1, 0xa2, 0xd7, 0xb4, 0xaa,
-		0x5d, 0x37, 0x6a, 0xc7, 0xb2, 0x84, 0x3e, 0x82, 0x9d, 0x59, 0x99, 0x72, 0x01, 0x3d, 0x80, 0xfb,
-		0x13, 0xd1, 0x58, 0x7b, 0xa6, 0xa9, 0x56, 0x0a, 0x5e, 0x44, 0xfb, 0xf0, 0x60, 0x22, 0xdc, 0xd2,
-		0xf0, 0x0b, 0xdd, 0xe0, 0x09, 0x3d, 0xb4, 0x71, 0xd3, 0x30, 0x74, 0xe3, 0x89, 0x3c, 0x57, 0xb9,
-		0x80, 0xb5, 0xb1, 0x2f, 0x48, 0x74, 0x1b, 0x6e, 0xa8, 0xb8, 0x6e, 0xd8, 0xf5, 0x97, 0x1a, 0xae,
-		0x29, 0x8d, 0xf1, 0xfd, 0x4f, 0x00, 0x98, 0xcf, 0xf5, 0x46, 0x23, 0x39, 0x84, 0x2c, 0xc0, 0x41,
-		0xf3, 0xf0, 0x50, 0xc3, 0x76, 0xdd, 0xd0, 0xe4, 0x42, 0xe5, 0x77, 0x12, 0xac, 0x8d, 0x0d, 0x4a,
-		0xe6, 0xba, 0xa1, 0x60, 0xcd, 0xb0, 0x6c, 0xb5, 0x56, 0xcf, 0xca, 0xfd, 0x04, 0x80, 0x72, 0xa0,
-		0x18, 0xd5, 0xba, 0x21, 0x4b, 0xe8, 0x1e, 0x6c, 0x67, 0x01, 0x44, 0x19, 0x8a, 0xaa, 0x94, 0x0b,
-		0xe8, 0x0e, 0xbc, 0x97, 0x85, 0xeb, 0x27, 0x4a, 0x2e, 0x56, 0xfe, 0x55, 0x80, 0x9b, 0xd3, 0x7e,
-		0x7a, 0x62, 0xc5, 0xdf, 0xcf, 0xb8, 0xf6, 0xad, 0xa6, 0x36, 0x2d, 0x56, 0x6e, 0xb1, 0x3f, 0x56,
-		0x74, 0x4d, 0x33, 0x15, 0x79, 0xfa, 0x34, 0x27, 0x80, 0xd5, 0xfa, 0x8b, 0x46, 0x4d, 0xb3, 0x78,
-		0x0e, 0x2b, 0x70, 0x2f, 0x0f, 0x1e, 0xd7, 0x96, 0x5c, 0x18, 0x2a, 0xab, 0x49, 0xae, 0xf9, 0xbe,
-		0x59, 0x17, 0xa2, 0x5d, 0xa8, 0xe4, 0xa1, 0xfb, 0x59, 0xa8, 0xca, 0x73, 0xe8, 0x53, 0xf8, 0x38,
-		0x3f, 0x70, 0xc3, 0xd2, 0x8d, 0xa6, 0x56, 0xb5, 0x15, 0xd3, 0x36, 0xb4, 0x23, 0x79, 0x7e, 0x96,
-		0xed, 0x5a, 0xfa, 0x0b, 0xd6, 0x1a, 0x4d, 0x4b, 0x5e, 0xa8, 0xfc, 0x55, 0x82, 0xab, 0x6a, 0xe0,
-		0x53, 0xcf, 0xef, 0x11, 0x25, 0x32, 0xc8, 0x1b, 0x3d, 0xbe, 0x83, 0x05, 0x21, 0xba, 0x0b, 0x77,
-		0x12, 0xff, 0xc2, 0xbd, 0xad, 0x1b, 0xba, 0xa5, 0x2b, 0x56, 0x1d, 0xa7, 0xf2, 0x3b, 0x15, 0xc6,
-		0xb4, 0xa0, 0xaa, 0xe1, 0x38, 0xaf, 0x93, 0x61, 0x58, 0xb3, 0xf0, 0xb1, 0x28, 0x85, 0x58, 0xdc,
-		0x26, 0x63, 0x79, 0x85, 0x27, 0xd2, 0x23, 0x17, 0x2b, 0xbf, 0x97, 0xa0, 0x24, 0x3e, 0xcc, 0xf9,
-		0x77, 0x5b, 0x19, 0xae, 0xb0, 0x0d, 0xd6, 0x9b, 0x96, 0x6d, 0x1d, 0x37, 0xb4, 0xe1, 0x1a, 0x1e,
-		0x5a, 0xe1, 0xca, 0x64, 0x5b, 0xf5, 0x38, 0x3b, 0x71, 0xff, 0x0c, 0x03, 0xc4, 0x5b, 0x18, 0x86,
-		0x83, 0xe5, 0xc2, 0x54, 0x4c, 0xec, 0xa7, 0x88, 0xae, 0xc3, 0xd5, 0x21, 0xcc, 0x53, 0x4d, 0xc1,
-		0xd6, 0x81, 0xa6, 0x58, 0xf2, 0x5c, 0xe5, 0xb7, 0x12, 0x5c, 0x4b, 0x44, 0xd8, 0x62, 0x33, 0xdd,
-		0xeb, 0x90, 0x56, 0xbd, 0x47, 0x55, 0xa7, 0x17, 0x11, 0x74, 0x1f, 0xee, 0xf6, 0xe5, 0xd3, 0x52,
-		0xcc, 0xe7, 0x83, 0xb3, 0xb2, 0x55, 0x85, 0xe9, 0xca, 0x60, 0x37, 0xb9, 0x50, 0x11, 0x82, 0x2c,
-		0xa1, 0x0f, 0xe1, 0xfd, 0xe9, 0x50, 0xac, 0x99, 0x9a, 0x25, 0x17, 0x2a, 0xff, 0x28, 0xc1, 0x66,
-		0x3a, 0x38, 0xf6, 0x75, 0x43, 0x5a, 0x71, 0x68, 0xf7, 0x60, 0x7b, 0xd8, 0x89, 0x90, 0xd8, 0xd1,
-		0xb8, 0xf6, 0xe1, 0xc1, 0x14, 0x5c, 0xd3, 0x78, 0xaa, 0x18, 0x55, 0xf6, 0x9c, 0x80, 0x64, 0x09,
-		0x3d, 0x86, 0x47, 0x53, 0x28, 0x07, 0x4a, 0x75, 0x90, 0xe5, 0xfe, 0xb0, 0x53, 0x2c, 0x0b, 0xeb,
-		0x07, 0x4d, 0x4b, 0x33, 0xe5, 0x02, 0xd2, 0x40, 0xc9, 0x71, 0x30, 0xac, 0x43, 0x99, 0x6e, 0x8a,
-		0xe8, 0x2b, 0xf8, 0x2c, 0x2f, 0x8e, 0xb8, 0x64, 0xf4, 0x17, 0x1a, 0x4e, 0x53, 0xe7, 0xd0, 0xd7,
-		0xf0, 0x79, 0x0e, 0x55, 0xbc, 0x79, 0x8c, 0x3b, 0x8f, 0x1e, 0xc1, 0x17, 0xb9, 0xd1, 0xab, 0x75,
-		0x5c, 0xb5, 0x5f, 0x28, 0xf8, 0xf9, 0x30, 0x79, 0x01, 0xe9, 0xa0, 0xe5, 0xbd, 0x58, 0xa8, 0x9b,
-		0x9d, 0xa1, 0x0b, 0x29, 0x57, 0x97, 0x66, 0xc8, 0x22, 0x33, 0xe4, 0xb8, 0xb9, 0x8c, 0x9e, 0x80,
-		0x3a, 0x5b, 0x2a, 0xa6, 0x3b, 0x5a, 0x44, 0xdf, 0x82, 0xf5, 0x6e, 0xa7, 0xaa, 0x7d, 0x6b, 0x69,
-		0xd8, 0x50, 0xf2, 0x3c, 0x03, 0xfa, 0x06, 0xbe, 0xca, 0x4d, 0xda, 0xb0, 0xfe, 0xa4, 0xe8, 0x25,
-		0xf4, 0x05, 0x7c, 0x32, 0x85, 0x9e, 0xae, 0x91, 0xc1, 0x85, 0x44, 0xaf, 0xca, 0x4b, 0xe8, 0x33,
-		0xd8, 0x9f, 0x42, 0xe4, 0x5d, 0x68, 0x9b, 0x96, 0xae, 0x3e, 0x3f, 0x8e, 0x97, 0x6b, 0xba, 0x69,
-		0xc9, 0xcb, 0xe8, 0x47, 0xf0, 0x83, 0x29, 0xb4, 0xfe, 0x66, 0xd9, 0x1f, 0x1a, 0x4e, 0xb5, 0x18,
-		0x83, 0x35, 0xb1, 0x26, 0xaf, 0xcc, 0x70, 0x26, 0xa6, 0xfe, 0x24, 0x3f, 0x73, 0xab, 0x48, 0x85,
-		0xc7, 0x33, 0xb5, 0x88, 0xfa, 0x54, 0xaf, 0x55, 0xb3, 0x9d, 0xc8, 0xe8, 0x13, 0xd8, 0x9b, 0xe2,
-		0xe4, 0xb0, 0x8e, 0x55, 0x4d, 0x4c, 0xac, 0xbe, 0x48, 0xac, 0xa1, 0xcf, 0xe1, 0xe1, 0x34, 0x92,
-		0xa2, 0xd7, 0xd8, 0xb5, 0x67, 0x94, 0x87, 0xd8, 0x18, 0x9d, 0x6d, 0xeb, 0xba, 0xd1, 0x68, 0x5a,
-		0xb6, 0xa9, 0x7f, 0xa7, 0xc9, 0xeb, 0x6c, 0x8c, 0xe6, 0x9e, 0x54, 0x92, 0x2b, 0xf9, 0xca, 0xb8,
-		0x18, 0x8f, 0xbd, 0xe4, 0x40, 0x37, 0x14, 0x7c, 0x2c, 0x6f, 0xe4, 0xd4, 0xde, 0xb8, 0xd0, 0x0d,
-		0x95, 0xd0, 0xd5, 0x59, 0xb6, 0xa3, 0x29, 0x58, 0x7d, 0x9a, 0xce, 0xf8, 0x26, 0x9b, 0x3a, 0x77,
-		0xf8, 0x8f, 0x41, 0x63, 0xf7, 0xaa, 0xb4, 0xc4, 0xef, 0xc3, 0x83, 0xf8, 0xdc, 0x32, 0xaa, 0x60,
-		0x82, 0xda, 0x1f, 0xc0, 0x0f, 0x67, 0xa3, 0xf4, 0xd7, 0x95, 0x1a, 0xd6, 0x94, 0xea, 0x71, 0xff,
-		0x36, 0x2c, 0x55, 0x7e, 0x5d, 0x80, 0x8a, 0xea, 0xf8, 0x2e, 0x69, 0x27, 0x3f, 0x42, 0x4f, 0x8d,
-		0xf2, 0x11, 0x7c, 0x31, 0x43, 0xbf, 0x4f, 0x88, 0xf7, 0x08, 0xcc, 0x77, 0x25, 0x37, 0x8d, 0xe7,
-		0x46, 0xfd, 0xc8, 0x98, 0x46, 0x90, 0x25, 0x64, 0xc0, 0xb3, 0x77, 0x75, 0x3c, 0x96, 0x92, 0xc1,
-		0x3d, 0xb4, 0xc0, 0x93, 0x62, 0x7a, 0x67, 0xfc, 0x17, 0xf9, 0xd9, 0x92, 0x22, 0xca, 0xf8, 0x3f,
-		0x4b, 0xca, 0xbb, 0x92, 0x67, 0x4e, 0xca, 0xbb, 0x3a, 0x9e, 0x96, 0x94, 0x83, 0x9f, 0xc2, 0xa6,
-		0x1b, 0x74, 0xb2, 0x7e, 0xe0, 0x38, 0x58, 0x4e, 0xd2, 0xd3, 0x60, 0x5f, 0xf8, 0x0d, 0xe9, 0xbb,
-		0xfd, 0x33, 0x8f, 0x9e, 0xf7, 0x4e, 0x76, 0xdd, 0xa0, 0xb3, 0x97, 0xfe, 0xaf, 0x81, 0x07, 0x5e,
-		0xab, 0xbd, 0x77, 0x16, 0xc4, 0xff, 0x85, 0x20, 0xfe, 0x85, 0xe0, 0x91, 0xd3, 0xf5, 0x5e, 0xef,
-		0x9f, 0x2c, 0x70, 0xdb, 0x27, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xc3, 0xe9, 0xec, 0x02,
-		0x21, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x6f, 0xdb, 0xd8,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0xfa, 0x3a, 0x8e, 0x15, 0x27, 0x63, 0x3b, 0x9a,
+		0x49, 0xc6, 0xd1, 0x3b, 0xb1, 0xc7, 0x99, 0xef, 0x49, 0xa7, 0x19, 0x9a, 0xa2, 0x13, 0x26, 0x0a,
+		0xa5, 0x5e, 0x51, 0xf1, 0x78, 0xd0, 0x96, 0xa0, 0xa5, 0x6b, 0x9b, 0x8d, 0x44, 0x0a, 0x24, 0x95,
+		0xc4, 0x40, 0x57, 0x45, 0x81, 0xae, 0xdb, 0x55, 0xd1, 0x55, 0x37, 0xdd, 0x14, 0x05, 0x8a, 0xa2,
+		0xeb, 0xa2, 0x9b, 0x6e, 0xfb, 0x3b, 0x8a, 0xfe, 0x81, 0xae, 0x8b, 0xfb, 0x41, 0x89, 0x92, 0x28,
+		0x51, 0x6e, 0x81, 0xe9, 0x2e, 0x3c, 0xf7, 0x79, 0x0e, 0xcf, 0x3d, 0xf7, 0x9e, 0xe7, 0x1c, 0x2a,
+		0x86, 0x42, 0xf7, 0x94, 0xf8, 0xfb, 0x0d, 0xbb, 0x49, 0xdc, 0x06, 0xd9, 0xb7, 0x3b, 0xce, 0xfe,
+		0xeb, 0x83, 0xfd, 0x37, 0x9e, 0xff, 0xea, 0xac, 0xe5, 0xbd, 0xd9, 0xeb, 0xf8, 0x5e, 0xe8, 0xa1,
+		0x35, 0x8a, 0xd9, 0x13, 0x98, 0x3d, 0xbb, 0xe3, 0xec, 0xbd, 0x3e, 0xd8, 0xdc, 0x3a, 0xf7, 0xbc,
+		0xf3, 0x16, 0xd9, 0x67, 0x90, 0xd3, 0xee, 0xd9, 0x7e, 0xb3, 0xeb, 0xdb, 0xa1, 0xe3, 0xb9, 0x9c,
+		0xb4, 0xb9, 0x3d, 0xbc, 0x1e, 0x3a, 0x6d, 0x12, 0x84, 0x76, 0xbb, 0x23, 0x00, 0x3b, 0x49, 0x6f,
+		0x6e, 0x78, 0xed, 0x76, 0xcf, 0x45, 0x62, 0x6c, 0xa1, 0x1d, 0xbc, 0x6a, 0x39, 0x41, 0xc8, 0x31,
+		0x85, 0xbf, 0x01, 0xac, 0x1f, 0x8b, 0x70, 0xb5, 0xb7, 0xa4, 0xd1, 0xa5, 0x21, 0xe8, 0xee, 0x99,
+		0x87, 0xea, 0x80, 0xa2, 0x7d, 0x58, 0x24, 0x5a, 0xc9, 0x4b, 0x3b, 0xd2, 0x6e, 0xee, 0xe1, 0xbd,
+		0xbd, 0x84, 0x2d, 0xed, 0x8d, 0xf8, 0xc1, 0xab, 0x6f, 0x86, 0x4d, 0xe8, 0x13, 0x98, 0x09, 0x2f,
+		0x3b, 0x24, 0x9f, 0x61, 0x8e, 0xee, 0x4c, 0x74, 0x64, 0x5e, 0x76, 0x08, 0x66, 0x70, 0xf4, 0x05,
+		0x40, 0x10, 0xda, 0x7e, 0x68, 0xd1, 0x34, 0xe4, 0xb3, 0x8c, 0xbc, 0xb9, 0xc7, 0x73, 0xb4, 0x17,
+		0xe5, 0x68, 0xcf, 0x8c, 0x72, 0x84, 0x17, 0x18, 0x9a, 0x3e, 0x53, 0x6a, 0xa3, 0xe5, 0x05, 0x84,
+		0x53, 0x67, 0xd2, 0xa9, 0x0c, 0xcd, 0xa8, 0x26, 0x2c, 0x72, 0x6a, 0x10, 0xda, 0x61, 0x37, 0xc8,
+		0xcf, 0xee, 0x48, 0xbb, 0xcb, 0x0f, 0x0f, 0xa6, 0xdb, 0xbd, 0x4a, 0x99, 0x35, 0x46, 0xc4, 0xb9,
+		0x46, 0xff, 0x01, 0xdd, 0x85, 0xe5, 0x0b, 0x27, 0x08, 0x3d, 0xff, 0xd2, 0x6a, 0x11, 0xf7, 0x3c,
+		0xbc, 0xc8, 0xcf, 0xed, 0x48, 0xbb, 0x59, 0xbc, 0x24, 0xac, 0x65, 0x66, 0x44, 0x3f, 0x84, 0xf5,
+		0x8e, 0xed, 0x13, 0x37, 0xec, 0xa7, 0xdf, 0x72, 0xdc, 0x33, 0x2f, 0x7f, 0x8d, 0x6d, 0x61, 0x37,
+		0x31, 0x8a, 0x2a, 0x63, 0x0c, 0x9c, 0x24, 0x5e, 0xeb, 0x8c, 0x1a, 0x91, 0x02, 0xcb, 0x7d, 0xb7,
+		0x2c, 0x33, 0xf3, 0xa9, 0x99, 0x59, 0xea, 0x31, 0x58, 0x76, 0x1e, 0xc0, 0x4c, 0x9b, 0xb4, 0xbd,
+		0xfc, 0x02, 0x23, 0xde, 0x4c, 0x8c, 0xe7, 0x05, 0x69, 0x7b, 0x98, 0xc1, 0x10, 0x86, 0xd5, 0x80,
+		0xd8, 0x7e, 0xe3, 0xc2, 0xb2, 0xc3, 0xd0, 0x77, 0x4e, 0xbb, 0x21, 0x09, 0xf2, 0xc0, 0xb8, 0x77,
+		0x13, 0xb9, 0x35, 0x86, 0x56, 0x7a, 0x60, 0x2c, 0x07, 0x43, 0x16, 0x54, 0x86, 0x55, 0xbb, 0x1b,
+		0x7a, 0x96, 0x4f, 0x02, 0x12, 0x5a, 0x1d, 0xcf, 0x71, 0xc3, 0x20, 0x9f, 0x63, 0x3e, 0x77, 0x12,
+		0x7d, 0x62, 0x0a, 0xac, 0x32, 0x1c, 0x5e, 0xa1, 0xd4, 0x98, 0x01, 0xdd, 0x82, 0x05, 0x5a, 0x1e,
+		0x16, 0xad, 0x8f, 0xfc, 0xe2, 0x8e, 0xb4, 0xbb, 0x80, 0xe7, 0xa9, 0xa1, 0xec, 0x04, 0x21, 0x52,
+		0x61, 0xb9, 0xb7, 0xc8, 0xcf, 0x61, 0x95, 0xbd, 0xe7, 0x9d, 0xc4, 0xf7, 0x98, 0x82, 0x86, 0x17,
+		0x23, 0x07, 0x2c, 0xeb, 0x1b, 0x70, 0xcd, 0x09, 0xac, 0x86, 0xef, 0xb9, 0xf9, 0xa5, 0x1d, 0x69,
+		0x77, 0x1e, 0xcf, 0x39, 0x81, 0xea, 0x7b, 0x2e, 0x7a, 0x04, 0xb9, 0x6e, 0xa7, 0x69, 0x87, 0xe2,
+		0x96, 0x2e, 0xa7, 0x9e, 0x05, 0x70, 0x38, 0x3b, 0x88, 0x9f, 0x80, 0xdc, 0xb1, 0xfd, 0xd0, 0x61,
+		0x67, 0xd9, 0xf0, 0xdc, 0x33, 0xe7, 0x3c, 0xbf, 0xb2, 0x93, 0xdd, 0xcd, 0x3d, 0x7c, 0x3c, 0xdd,
+		0x55, 0xa5, 0xb1, 0xd1, 0xab, 0xc3, 0x5d, 0xa8, 0xcc, 0x83, 0xe6, 0x86, 0xfe, 0x25, 0x5e, 0xe9,
+		0x0c, 0x5a, 0xd1, 0x4b, 0x58, 0xa3, 0xe1, 0x5b, 0xde, 0x6b, 0xe2, 0xb7, 0xec, 0x8e, 0xd5, 0xf1,
+		0x5a, 0x4e, 0xe3, 0x32, 0x2f, 0xb3, 0xca, 0x48, 0xd6, 0x05, 0xba, 0xc1, 0x0a, 0x87, 0x57, 0x19,
+		0x1a, 0xaf, 0x36, 0x86, 0x4d, 0xe8, 0x2d, 0x6c, 0xdb, 0x8d, 0xd0, 0x79, 0x4d, 0xac, 0x46, 0xab,
+		0x1b, 0x84, 0xc4, 0xb7, 0x02, 0xd2, 0x22, 0x0d, 0xb6, 0x25, 0xf1, 0x0e, 0xc4, 0x92, 0x92, 0x5c,
+		0x7d, 0x0a, 0xe3, 0xaa, 0x9c, 0x5a, 0x8b, 0x98, 0xe2, 0x75, 0xb7, 0xed, 0x09, 0xab, 0x9b, 0x87,
+		0x70, 0x3d, 0x69, 0xeb, 0x48, 0x86, 0xec, 0x2b, 0x72, 0xc9, 0x14, 0x6f, 0x01, 0xd3, 0x7f, 0xa2,
+		0xeb, 0x30, 0xfb, 0xda, 0x6e, 0x75, 0xb9, 0x78, 0x2d, 0x60, 0xfe, 0xf0, 0x65, 0xe6, 0x73, 0xa9,
+		0xf0, 0xeb, 0x0c, 0x6c, 0x8d, 0x0a, 0x00, 0x73, 0x26, 0x64, 0x1d, 0x7d, 0x19, 0xbf, 0x5c, 0xd2,
+		0x34, 0x57, 0xa7, 0x7f, 0xf7, 0x6c, 0xd8, 0xe9, 0x17, 0xab, 0xd0, 0x41, 0xcf, 0xea, 0xab, 0x9a,
+		0xd7, 0x0d, 0x85, 0xa0, 0xde, 0x1c, 0xb9, 0x32, 0x25, 0x11, 0x00, 0xbe, 0xdd, 0x73, 0x51, 0x63,
+		0xda, 0xe8, 0xa9, 0x91, 0xce, 0x79, 0xdd, 0x10, 0x1d, 0xc3, 0x2d, 0x16, 0xde, 0x18, 0xef, 0xd9,
+		0x34, 0xef, 0x1b, 0x94, 0x9d, 0xe0, 0xb8, 0xf0, 0x77, 0x09, 0xd6, 0x12, 0x54, 0x89, 0x16, 0x5b,
+		0xd3, 0x6b, 0xdb, 0x8e, 0x6b, 0x39, 0x4d, 0x91, 0xe4, 0x79, 0x6e, 0xd0, 0x9b, 0x68, 0x1b, 0x72,
+		0x62, 0xd1, 0xb5, 0xdb, 0x51, 0xbe, 0x81, 0x9b, 0x0c, 0xbb, 0x4d, 0xc6, 0x74, 0xa7, 0xec, 0x7f,
+		0xdb, 0x9d, 0xee, 0xc0, 0xa2, 0xe3, 0x3a, 0xa1, 0x63, 0x87, 0xa4, 0x49, 0xe3, 0x9a, 0x61, 0xc2,
+		0x9c, 0xeb, 0xd9, 0xf4, 0x66, 0xe1, 0x97, 0x12, 0xac, 0x6b, 0x6f, 0x43, 0xe2, 0xbb, 0x76, 0xeb,
+		0x3b, 0xe9, 0x98, 0xc3, 0x31, 0x65, 0x46, 0x63, 0xfa, 0xd7, 0x1c, 0xac, 0x55, 0x89, 0xdb, 0x74,
+		0xdc, 0x73, 0x56, 0x08, 0x4e, 0x78, 0xc9, 0x22, 0xda, 0x86, 0x9c, 0x2d, 0x9e, 0xfb, 0x59, 0x86,
+		0xc8, 0xa4, 0x37, 0xd1, 0x11, 0x2c, 0xf5, 0x00, 0xa9, 0x6d, 0x39, 0x72, 0xcd, 0xda, 0xf2, 0xa2,
+		0x1d, 0x7b, 0x42, 0x8f, 0x61, 0x96, 0xb6, 0x48, 0xde, 0x99, 0x97, 0x1f, 0xde, 0x4f, 0xee, 0x4d,
+		0x83, 0x11, 0xd2, 0x6e, 0x48, 0x30, 0xe7, 0x21, 0x1d, 0x56, 0x2f, 0x88, 0xed, 0x87, 0xa7, 0xc4,
+		0x0e, 0xad, 0x26, 0x09, 0x6d, 0xa7, 0x15, 0x88, 0x5e, 0x7d, 0x7b, 0x4c, 0xa3, 0xbb, 0x6c, 0x79,
+		0x76, 0x13, 0xcb, 0x3d, 0x5a, 0x89, 0xb3, 0xd0, 0x33, 0x58, 0x6b, 0xd9, 0x41, 0x68, 0xf5, 0xfd,
+		0x31, 0x49, 0x9d, 0x4d, 0x95, 0xd4, 0x55, 0x4a, 0x7b, 0x1a, 0xb1, 0x98, 0xb2, 0x1e, 0x01, 0x33,
+		0xf2, 0xaa, 0x20, 0x4d, 0xee, 0x69, 0x2e, 0xd5, 0xd3, 0x0a, 0x25, 0xd5, 0x38, 0x87, 0xf9, 0xc9,
+		0xc3, 0x35, 0x3b, 0x0c, 0x49, 0xbb, 0x13, 0xb2, 0xee, 0x3d, 0x8b, 0xa3, 0x47, 0x74, 0x1f, 0xe4,
+		0xb6, 0xfd, 0xd6, 0x69, 0x77, 0xdb, 0x96, 0x30, 0x05, 0xac, 0x13, 0xcf, 0xe2, 0x15, 0x61, 0x57,
+		0x84, 0x99, 0xb6, 0xec, 0xa0, 0x71, 0x41, 0x9a, 0xdd, 0x56, 0x14, 0xc9, 0x42, 0x7a, 0xcb, 0xee,
+		0x31, 0x58, 0x1c, 0x2a, 0xac, 0x90, 0xb7, 0x1d, 0x87, 0xd7, 0x2c, 0xf7, 0x01, 0xa9, 0x3e, 0x96,
+		0xfb, 0x14, 0xe6, 0xe4, 0x31, 0x2c, 0xb2, 0xa4, 0x9c, 0xd9, 0x4e, 0xab, 0xeb, 0x13, 0xd1, 0x6f,
+		0x93, 0x8f, 0xe9, 0x88, 0x63, 0x70, 0x8e, 0x32, 0xc4, 0x03, 0xfa, 0x10, 0xae, 0x33, 0x07, 0xf4,
+		0xae, 0x13, 0xdf, 0x72, 0x9a, 0xc4, 0x0d, 0x9d, 0xf0, 0x52, 0xb4, 0x5c, 0x44, 0xd7, 0x8e, 0xd9,
+		0x92, 0x2e, 0x56, 0xd0, 0xa7, 0xb0, 0x11, 0x1d, 0xc1, 0x30, 0x69, 0x89, 0x91, 0xd6, 0xc5, 0xf2,
+		0x10, 0x6f, 0x1b, 0x72, 0x51, 0x02, 0x68, 0x01, 0x2c, 0xb3, 0xd2, 0x81, 0xc8, 0xa4, 0x37, 0xd1,
+		0x57, 0x00, 0x1d, 0xbb, 0x1b, 0x10, 0xde, 0xd1, 0x57, 0xd8, 0x4e, 0xb6, 0xc6, 0x5c, 0xb8, 0x6e,
+		0x40, 0xd8, 0x3c, 0xb5, 0xd0, 0x89, 0xfe, 0x59, 0xf8, 0x29, 0x2c, 0xf4, 0xec, 0xe8, 0x06, 0xcc,
+		0xf9, 0xc4, 0x0e, 0x44, 0xcd, 0x2f, 0x60, 0xf1, 0x84, 0x36, 0x61, 0xbe, 0x17, 0x2d, 0x57, 0xb2,
+		0xde, 0x33, 0xed, 0xfb, 0xcc, 0x5b, 0x73, 0xda, 0xc1, 0x96, 0x87, 0xcb, 0x4e, 0xb3, 0xf0, 0xe7,
+		0x0c, 0xdc, 0x14, 0x45, 0xa5, 0x5e, 0x38, 0xad, 0xe6, 0x77, 0x22, 0x47, 0x1f, 0xc4, 0xdc, 0x52,
+		0xc9, 0x88, 0x2b, 0xb4, 0xfc, 0x26, 0x36, 0xb9, 0x33, 0x9d, 0x1e, 0x16, 0xaf, 0xec, 0x88, 0x78,
+		0xd1, 0x89, 0x42, 0xcc, 0xb9, 0xbc, 0xe5, 0x88, 0x6e, 0x3f, 0x33, 0x61, 0xa2, 0xe0, 0xfd, 0x84,
+		0xb5, 0x99, 0x68, 0xa2, 0xe8, 0x0c, 0x9b, 0xe8, 0x71, 0xf0, 0x86, 0xc1, 0x4a, 0x7f, 0x01, 0x8b,
+		0xa7, 0xc2, 0x3f, 0x32, 0x3d, 0xb1, 0x2c, 0x91, 0x86, 0x13, 0x44, 0xf9, 0xea, 0x69, 0x98, 0x94,
+		0xae, 0x61, 0x11, 0x71, 0x40, 0xc3, 0x46, 0xeb, 0x33, 0x73, 0xd5, 0xfa, 0xfc, 0x0a, 0x16, 0x07,
+		0xa4, 0x26, 0xfd, 0x3e, 0xe4, 0x82, 0x64, 0x99, 0x99, 0x19, 0x94, 0x19, 0x0c, 0x1b, 0x9e, 0xef,
+		0x9c, 0x3b, 0xae, 0xdd, 0xb2, 0x86, 0x82, 0x4c, 0x17, 0xc6, 0xf5, 0x88, 0x5a, 0x1b, 0x08, 0x76,
+		0xa8, 0xb8, 0xe6, 0x86, 0x8b, 0xab, 0xf0, 0x97, 0x0c, 0xdc, 0x8c, 0xd4, 0xbe, 0xec, 0x35, 0xec,
+		0x56, 0xc9, 0x09, 0x3a, 0x76, 0xd8, 0xb8, 0x98, 0xae, 0x39, 0xfd, 0xef, 0xf3, 0xf9, 0x63, 0xd8,
+		0x1a, 0x8c, 0xc0, 0xf2, 0xce, 0xac, 0xf0, 0xc2, 0x09, 0xac, 0x78, 0x9a, 0x27, 0x3b, 0xdc, 0x1c,
+		0x88, 0xa8, 0x72, 0x66, 0x5e, 0x38, 0x81, 0x90, 0x74, 0xf4, 0x0e, 0x00, 0x1b, 0xba, 0x42, 0xef,
+		0x15, 0xe1, 0xd7, 0x74, 0x11, 0xb3, 0x29, 0xd1, 0xa4, 0x86, 0xc2, 0x33, 0xc8, 0xc5, 0x3f, 0x4f,
+		0x1e, 0xc1, 0x9c, 0xf8, 0xc2, 0x91, 0xd8, 0x70, 0xff, 0x6e, 0xca, 0x17, 0x0e, 0x13, 0x2b, 0x41,
+		0x29, 0xfc, 0x31, 0x03, 0xcb, 0x83, 0x4b, 0xe8, 0x7d, 0x58, 0x39, 0x75, 0x5c, 0xdb, 0xbf, 0xb4,
+		0x1a, 0x17, 0xa4, 0xf1, 0x2a, 0xe8, 0xb6, 0xc5, 0x21, 0x2c, 0x73, 0xb3, 0x2a, 0xac, 0x68, 0x1d,
+		0xe6, 0xfc, 0xae, 0x1b, 0xcd, 0x1e, 0x0b, 0x78, 0xd6, 0xef, 0xba, 0x4c, 0x3b, 0x6f, 0x9d, 0x39,
+		0x7e, 0x40, 0xfb, 0x35, 0xaf, 0x06, 0xab, 0xe1, 0xb5, 0x3b, 0x2d, 0x32, 0x50, 0xea, 0x79, 0x06,
+		0x89, 0xea, 0x45, 0x8d, 0x00, 0x8c, 0xbe, 0xd8, 0xf0, 0x89, 0xdd, 0x3b, 0x9b, 0xf4, 0x54, 0xe6,
+		0x04, 0x5e, 0x74, 0xa1, 0x25, 0xd6, 0x97, 0x1c, 0xf7, 0x7c, 0xda, 0x7b, 0xbc, 0x18, 0x11, 0x98,
+		0x83, 0x2d, 0x00, 0xf6, 0xd9, 0x18, 0xda, 0xa7, 0x2d, 0xde, 0xd4, 0xe7, 0x71, 0xcc, 0x52, 0xf8,
+		0x99, 0x04, 0xcb, 0x91, 0x22, 0xd6, 0xd9, 0xc7, 0x16, 0x9d, 0x59, 0xc5, 0x57, 0x5a, 0x7f, 0x66,
+		0xe5, 0x06, 0x3e, 0xb3, 0x8a, 0xc5, 0xf8, 0xcc, 0xca, 0x4d, 0x4c, 0x0b, 0x1f, 0xc2, 0xac, 0xe3,
+		0x76, 0x7a, 0xc3, 0xf4, 0xe4, 0xb9, 0x86, 0x43, 0x8b, 0x7f, 0x92, 0xe0, 0x7a, 0xd2, 0xdc, 0x84,
+		0x0a, 0xb0, 0x55, 0xd5, 0x8c, 0x92, 0x6e, 0x3c, 0xb1, 0x14, 0xd5, 0xd4, 0x5f, 0xea, 0xe6, 0x89,
+		0x55, 0x33, 0x15, 0x53, 0xb3, 0x74, 0xe3, 0xa5, 0x52, 0xd6, 0x4b, 0xf2, 0xff, 0xa1, 0xf7, 0x60,
+		0x67, 0x0c, 0xa6, 0xa6, 0x3e, 0xd5, 0x4a, 0xf5, 0xb2, 0x56, 0x92, 0xa5, 0x09, 0x9e, 0x6a, 0xa6,
+		0x82, 0x4d, 0xad, 0x24, 0x67, 0xd0, 0xff, 0xc3, 0xfb, 0x63, 0x30, 0xaa, 0x62, 0xa8, 0x5a, 0xd9,
+		0xc2, 0xda, 0x0f, 0xea, 0x5a, 0x8d, 0x82, 0xb3, 0xc5, 0x9f, 0xf7, 0x63, 0x1e, 0xd0, 0xc9, 0xf8,
+		0x9b, 0x4a, 0x9a, 0xaa, 0xd7, 0xf4, 0x8a, 0x31, 0x29, 0xe6, 0x21, 0xcc, 0x98, 0x98, 0x87, 0x51,
+		0x51, 0xcc, 0xc5, 0x5f, 0x64, 0xfa, 0x3f, 0x6d, 0xe9, 0x4d, 0x4c, 0xba, 0xbd, 0xce, 0xf0, 0x1e,
+		0xec, 0x1c, 0x57, 0xf0, 0xf3, 0xa3, 0x72, 0xe5, 0xd8, 0xd2, 0x4b, 0x16, 0xd6, 0xea, 0x35, 0xcd,
+		0xaa, 0x56, 0xca, 0xba, 0x7a, 0x12, 0x8b, 0xe4, 0x73, 0xf8, 0x78, 0x2c, 0x4a, 0x29, 0x53, 0x6b,
+		0xa9, 0x5e, 0x2d, 0xeb, 0x2a, 0x7d, 0xeb, 0x91, 0xa2, 0x97, 0xb5, 0x92, 0x55, 0x31, 0xca, 0x27,
+		0xb2, 0x84, 0x3e, 0x80, 0xdd, 0x69, 0x99, 0x72, 0x06, 0x3d, 0x80, 0xfb, 0x63, 0xd1, 0x58, 0x7b,
+		0xa6, 0xa9, 0x66, 0x0c, 0x9e, 0x45, 0x07, 0xf0, 0x60, 0x2c, 0xdc, 0xd4, 0xf0, 0x0b, 0xdd, 0x60,
+		0x09, 0x3d, 0xb2, 0x70, 0xdd, 0x30, 0x74, 0xe3, 0x89, 0x3c, 0x53, 0xbc, 0x84, 0xd5, 0x91, 0x6f,
+		0x70, 0xb4, 0x0d, 0xb7, 0x54, 0x5c, 0x31, 0xac, 0xca, 0x4b, 0x0d, 0x97, 0x95, 0xea, 0xe8, 0xfe,
+		0xc7, 0x00, 0x6a, 0xcf, 0xf5, 0x6a, 0x35, 0x3a, 0x84, 0x24, 0xc0, 0x61, 0xfd, 0xe8, 0x48, 0xc3,
+		0x56, 0xc5, 0xd0, 0xe4, 0x4c, 0xf1, 0xb7, 0x12, 0xac, 0x8e, 0x74, 0x6b, 0xea, 0xba, 0xaa, 0x60,
+		0xcd, 0x30, 0x2d, 0xb5, 0x5c, 0x49, 0xca, 0xfd, 0x18, 0x80, 0x72, 0xa8, 0x18, 0xa5, 0x8a, 0x21,
+		0x4b, 0xe8, 0x1e, 0x14, 0x92, 0x00, 0xe2, 0x1a, 0x8a, 0x5b, 0x29, 0x67, 0xd0, 0x1d, 0x78, 0x27,
+		0x09, 0xd7, 0x4b, 0x94, 0x9c, 0x2d, 0xfe, 0x33, 0x03, 0xb7, 0x27, 0xfd, 0x78, 0x47, 0x2f, 0x7f,
+		0x2f, 0xe3, 0xda, 0x37, 0x9a, 0x5a, 0x37, 0xe9, 0x75, 0xe3, 0xfe, 0xe8, 0xa5, 0xab, 0xd7, 0x62,
+		0x91, 0xc7, 0x4f, 0x73, 0x0c, 0x58, 0xad, 0xbc, 0xa8, 0x96, 0x35, 0x93, 0xe5, 0xb0, 0x08, 0xf7,
+		0xd2, 0xe0, 0xfc, 0x6e, 0xc9, 0x99, 0x81, 0x6b, 0x35, 0xce, 0x35, 0xdb, 0x37, 0xad, 0x42, 0xb4,
+		0x07, 0xc5, 0x34, 0x74, 0x2f, 0x0b, 0x25, 0x79, 0x06, 0x7d, 0x0c, 0x1f, 0xa6, 0x07, 0x6e, 0x98,
+		0xba, 0x51, 0xd7, 0x4a, 0x96, 0x52, 0xb3, 0x0c, 0xed, 0x58, 0x9e, 0x9d, 0x66, 0xbb, 0xa6, 0xfe,
+		0x82, 0x96, 0x46, 0xdd, 0x94, 0xe7, 0x8a, 0x7f, 0x95, 0xe0, 0x86, 0xea, 0xb9, 0xa1, 0xe3, 0x76,
+		0x89, 0x12, 0x18, 0xe4, 0x8d, 0xce, 0x07, 0x41, 0xcf, 0x47, 0x77, 0xe1, 0x4e, 0xe4, 0x5f, 0xb8,
+		0xb7, 0x74, 0x43, 0x37, 0x75, 0xc5, 0xac, 0xe0, 0x58, 0x7e, 0x27, 0xc2, 0xa8, 0x16, 0x94, 0x34,
+		0xcc, 0xf3, 0x3a, 0x1e, 0x86, 0x35, 0x13, 0x9f, 0x88, 0xab, 0xc0, 0xc5, 0x6d, 0x3c, 0x96, 0xdd,
+		0xf0, 0x48, 0x7a, 0xe4, 0x6c, 0xf1, 0x0f, 0x12, 0xe4, 0xc4, 0x4f, 0x1b, 0xec, 0xcb, 0x37, 0x0f,
+		0xd7, 0xe9, 0x06, 0x2b, 0x75, 0xd3, 0x32, 0x4f, 0xaa, 0xda, 0xe0, 0x1d, 0x1e, 0x58, 0x61, 0xca,
+		0x64, 0x99, 0x15, 0x9e, 0x1d, 0x5e, 0x3f, 0x83, 0x00, 0xf1, 0x16, 0x8a, 0x61, 0x60, 0x39, 0x33,
+		0x11, 0xc3, 0xfd, 0x64, 0xd1, 0x26, 0xdc, 0x18, 0xc0, 0x3c, 0xd5, 0x14, 0x6c, 0x1e, 0x6a, 0x8a,
+		0x29, 0xcf, 0x14, 0x7f, 0x23, 0xc1, 0xcd, 0x48, 0x84, 0x4d, 0x3a, 0x58, 0x38, 0x6d, 0xd2, 0xac,
+		0x74, 0x43, 0x95, 0x7e, 0x45, 0xa0, 0xfb, 0x70, 0xb7, 0x27, 0x9f, 0xa6, 0x52, 0x7b, 0xde, 0x3f,
+		0x2b, 0x4b, 0x55, 0xa8, 0xae, 0xf4, 0x77, 0x93, 0x0a, 0x15, 0x21, 0xc8, 0x12, 0x7a, 0x1f, 0xde,
+		0x9d, 0x0c, 0xc5, 0x5a, 0x4d, 0x33, 0xe5, 0x4c, 0xf1, 0xf7, 0x4b, 0xb0, 0x11, 0x0f, 0x8e, 0x7e,
+		0x1f, 0x92, 0x26, 0x0f, 0xed, 0x1e, 0x14, 0x06, 0x9d, 0x08, 0x89, 0x1d, 0x8e, 0xeb, 0x00, 0x1e,
+		0x4c, 0xc0, 0xd5, 0x8d, 0xa7, 0x8a, 0x51, 0xa2, 0xcf, 0x11, 0x48, 0x96, 0xd0, 0x63, 0x78, 0x34,
+		0x81, 0x72, 0xa8, 0x94, 0xfa, 0x59, 0xee, 0x35, 0x3b, 0xc5, 0x34, 0xb1, 0x7e, 0x58, 0x37, 0xb5,
+		0x9a, 0x9c, 0x41, 0x1a, 0x28, 0x29, 0x0e, 0x06, 0x75, 0x28, 0xd1, 0x4d, 0x16, 0x7d, 0x01, 0x9f,
+		0xa4, 0xc5, 0xc1, 0xaf, 0x8c, 0xfe, 0x42, 0xc3, 0x71, 0xea, 0x0c, 0xfa, 0x12, 0x3e, 0x4d, 0xa1,
+		0x8a, 0x37, 0x8f, 0x70, 0x67, 0xd1, 0x23, 0xf8, 0x2c, 0x35, 0x7a, 0xb5, 0x82, 0x4b, 0xd6, 0x0b,
+		0x05, 0x3f, 0x1f, 0x24, 0xcf, 0x21, 0x1d, 0xb4, 0xb4, 0x17, 0x0b, 0x75, 0xb3, 0x12, 0x74, 0x21,
+		0xe6, 0xea, 0xda, 0x14, 0x59, 0xa4, 0x86, 0x14, 0x37, 0xf3, 0xe8, 0x09, 0xa8, 0xd3, 0xa5, 0x62,
+		0xb2, 0xa3, 0x05, 0xf4, 0x0d, 0x98, 0x57, 0x3b, 0x55, 0xed, 0x1b, 0x53, 0xc3, 0x86, 0x92, 0xe6,
+		0x19, 0xd0, 0x57, 0xf0, 0x45, 0x6a, 0xd2, 0x06, 0xf5, 0x27, 0x46, 0xcf, 0xa1, 0xcf, 0xe0, 0xa3,
+		0x09, 0xf4, 0xf8, 0x1d, 0xe9, 0x0f, 0x24, 0x7a, 0x49, 0x5e, 0x44, 0x9f, 0xc0, 0xc1, 0x04, 0x22,
+		0xab, 0x42, 0xab, 0x66, 0xea, 0xea, 0xf3, 0x13, 0xbe, 0x5c, 0xd6, 0x6b, 0xa6, 0xbc, 0x84, 0xbe,
+		0x86, 0xef, 0x4d, 0xa0, 0xf5, 0x36, 0x4b, 0xff, 0xa1, 0xe1, 0x58, 0x89, 0x51, 0x58, 0x1d, 0x6b,
+		0xf2, 0xf2, 0x14, 0x67, 0x52, 0xd3, 0x9f, 0xa4, 0x67, 0x6e, 0x05, 0xa9, 0xf0, 0x78, 0xaa, 0x12,
+		0x51, 0x9f, 0xea, 0xe5, 0x52, 0xb2, 0x13, 0x19, 0x7d, 0x04, 0xfb, 0x13, 0x9c, 0x1c, 0x55, 0xb0,
+		0xaa, 0x89, 0x8e, 0xd5, 0x13, 0x89, 0x55, 0xf4, 0x29, 0x3c, 0x9c, 0x44, 0x52, 0xf4, 0x32, 0x1d,
+		0x7b, 0x86, 0x79, 0x88, 0xb6, 0xd1, 0xe9, 0xb6, 0xae, 0x1b, 0xd5, 0xba, 0x69, 0xd5, 0xf4, 0x6f,
+		0x35, 0x79, 0x8d, 0xb6, 0xd1, 0xd4, 0x93, 0x8a, 0x72, 0x25, 0x5f, 0x1f, 0x15, 0xe3, 0x91, 0x97,
+		0x1c, 0xea, 0x86, 0x82, 0x4f, 0xe4, 0xf5, 0x94, 0xbb, 0x37, 0x2a, 0x74, 0x03, 0x57, 0xe8, 0xc6,
+		0x34, 0xdb, 0xd1, 0x14, 0xac, 0x3e, 0x8d, 0x67, 0x7c, 0x03, 0x95, 0xe0, 0xeb, 0x14, 0x96, 0xa2,
+		0xaa, 0x5a, 0xb5, 0xbf, 0x27, 0xab, 0x5e, 0x2d, 0xd1, 0x77, 0xc6, 0xbc, 0xe4, 0xa7, 0xf0, 0x22,
+		0xc6, 0xe3, 0x09, 0x5e, 0x6e, 0xa2, 0x23, 0x38, 0xbc, 0xb2, 0x62, 0x8d, 0xfa, 0xd9, 0x2c, 0xfe,
+		0x4e, 0x82, 0xb5, 0xc1, 0xcf, 0xc1, 0x5a, 0x68, 0x9f, 0x13, 0x3a, 0x61, 0x0e, 0xf3, 0x6a, 0xa6,
+		0xf2, 0x24, 0xde, 0xa3, 0x0a, 0xb0, 0x95, 0x0c, 0xe1, 0x59, 0x88, 0x86, 0xe9, 0x64, 0x0c, 0xdf,
+		0x23, 0x1b, 0x00, 0xdf, 0x85, 0xed, 0x64, 0x4c, 0x7f, 0xa2, 0xcc, 0xd2, 0x8e, 0x7f, 0x87, 0xfd,
+		0x1a, 0x38, 0x32, 0xd3, 0xc6, 0xdb, 0xeb, 0x01, 0x3c, 0xe0, 0x35, 0x93, 0x50, 0x81, 0x63, 0x3a,
+		0xed, 0x21, 0x7c, 0x7f, 0x3a, 0x4a, 0x6f, 0x5d, 0x29, 0x63, 0x4d, 0x29, 0x9d, 0xf4, 0xbe, 0x44,
+		0xa4, 0xe2, 0xaf, 0x32, 0x50, 0x54, 0x6d, 0xb7, 0x41, 0x5a, 0xd1, 0x7f, 0xa1, 0x4c, 0x8c, 0xf2,
+		0x11, 0x7c, 0x36, 0x85, 0xd6, 0x8e, 0x89, 0xf7, 0x18, 0x6a, 0x57, 0x25, 0xd7, 0x8d, 0xe7, 0x46,
+		0xe5, 0xd8, 0x98, 0x44, 0x90, 0x25, 0x64, 0xc0, 0xb3, 0xab, 0x3a, 0x1e, 0x49, 0x49, 0xff, 0xc4,
+		0x32, 0x2c, 0x29, 0x35, 0xe7, 0x9c, 0xfd, 0x7f, 0xd2, 0x74, 0x49, 0x11, 0x12, 0xf2, 0x9f, 0x25,
+		0xe5, 0xaa, 0xe4, 0xa9, 0x93, 0x72, 0x55, 0xc7, 0x93, 0x92, 0x72, 0xf8, 0x23, 0xd8, 0x68, 0x78,
+		0xed, 0xa4, 0x9f, 0x48, 0x0e, 0x97, 0xa2, 0xf4, 0x54, 0x7d, 0x2f, 0xf4, 0xaa, 0xd2, 0xb7, 0x07,
+		0xe7, 0x4e, 0x78, 0xd1, 0x3d, 0xdd, 0x6b, 0x78, 0xed, 0xfd, 0xf8, 0xdf, 0xbc, 0x3c, 0x70, 0x9a,
+		0xad, 0xfd, 0x73, 0x8f, 0xff, 0x0d, 0x8d, 0xf8, 0x03, 0x98, 0x47, 0x76, 0xc7, 0x79, 0x7d, 0x70,
+		0x3a, 0xc7, 0x6c, 0x1f, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xd3, 0x79, 0x4a, 0xc0, 0x23,
+		0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdb, 0x6e, 0xdb, 0x36,
-		0x18, 0x9e, 0x7c, 0x68, 0x9d, 0xdf, 0x4d, 0xa2, 0xb2, 0x49, 0x63, 0xbb, 0xed, 0xe6, 0xfa, 0xa2,
-		0xc8, 0x8a, 0x4d, 0x46, 0xb2, 0x0d, 0x18, 0xb6, 0xa1, 0xab, 0x13, 0x1b, 0xad, 0x10, 0x27, 0x35,
-		0x64, 0xb5, 0x43, 0x07, 0x0c, 0x02, 0x2d, 0xb1, 0x0e, 0x67, 0x49, 0x14, 0x44, 0xca, 0xae, 0x6f,
-		0xf6, 0x18, 0xbb, 0xdb, 0x8b, 0xec, 0x1d, 0xf6, 0x4e, 0x03, 0x29, 0xd9, 0xf5, 0x41, 0x0d, 0xd6,
-		0x8b, 0xdd, 0x99, 0xff, 0xc7, 0x8f, 0xdf, 0x7f, 0xb6, 0xa0, 0x95, 0x8c, 0x48, 0xdc, 0x76, 0xb1,
-		0x47, 0x42, 0x97, 0xb4, 0x71, 0x44, 0xdb, 0xd3, 0x93, 0xb6, 0xc0, 0x7c, 0xe2, 0x53, 0x2e, 0x8c,
-		0x28, 0x66, 0x82, 0xa1, 0x7b, 0xf2, 0x8e, 0x91, 0xdd, 0x31, 0x70, 0x44, 0x8d, 0xe9, 0x49, 0xe3,
-		0xf3, 0x31, 0x63, 0x63, 0x9f, 0xb4, 0xd5, 0x95, 0x51, 0xf2, 0xae, 0xed, 0x25, 0x31, 0x16, 0x94,
-		0x85, 0x29, 0xa9, 0xf1, 0xc5, 0x26, 0x2e, 0x68, 0x40, 0xb8, 0xc0, 0x41, 0x94, 0x5d, 0xd8, 0x7a,
-		0x60, 0x16, 0xe3, 0x28, 0x22, 0x31, 0x4f, 0xf1, 0xd6, 0x6b, 0xa8, 0xd8, 0x98, 0x4f, 0xfa, 0x94,
-		0x0b, 0x84, 0xa0, 0x14, 0xe2, 0x80, 0xd4, 0xb4, 0xa6, 0x76, 0xbc, 0x63, 0xa9, 0xdf, 0xe8, 0x3b,
-		0x28, 0x4d, 0x68, 0xe8, 0xd5, 0x0a, 0x4d, 0xed, 0x78, 0xef, 0xf4, 0xb1, 0x91, 0xe3, 0xa4, 0xb1,
-		0x78, 0xe0, 0x82, 0x86, 0x9e, 0xa5, 0xae, 0xb7, 0x30, 0xe8, 0x0b, 0xeb, 0x25, 0x11, 0xd8, 0xc3,
-		0x02, 0xa3, 0x4b, 0x38, 0x08, 0xf0, 0x7b, 0x47, 0x86, 0xcd, 0x9d, 0x88, 0xc4, 0x0e, 0x27, 0x2e,
-		0x0b, 0x3d, 0x25, 0x57, 0x3d, 0x7d, 0x68, 0xa4, 0x9e, 0x1a, 0x0b, 0x4f, 0x8d, 0x2e, 0x4b, 0x46,
-		0x3e, 0x79, 0x83, 0xfd, 0x84, 0x58, 0x77, 0x03, 0xfc, 0x5e, 0x3e, 0xc8, 0x07, 0x24, 0x1e, 0x2a,
-		0x5a, 0xeb, 0x35, 0xd4, 0x17, 0x12, 0x03, 0x1c, 0x0b, 0x2a, 0xb3, 0xb2, 0xd4, 0xd2, 0xa1, 0x38,
-		0x21, 0xf3, 0x2c, 0x12, 0xf9, 0x13, 0x3d, 0x81, 0x7d, 0x36, 0x0b, 0x49, 0xec, 0x5c, 0x33, 0x2e,
-		0x1c, 0x15, 0x67, 0x41, 0xa1, 0xbb, 0xca, 0xfc, 0x92, 0x71, 0x71, 0x85, 0x03, 0xd2, 0x9a, 0xc0,
-		0xa1, 0xc9, 0x99, 0xaf, 0x92, 0xfc, 0x22, 0x66, 0x49, 0x74, 0x49, 0x44, 0x4c, 0x5d, 0x8e, 0xda,
-		0x70, 0x10, 0x92, 0x59, 0xbe, 0xfb, 0x9a, 0x75, 0x37, 0x24, 0xb3, 0x75, 0x07, 0xd1, 0x63, 0xb8,
-		0x13, 0x31, 0xdf, 0x27, 0xb1, 0xe3, 0xb2, 0x24, 0x14, 0x4a, 0xae, 0x68, 0x55, 0x53, 0xdb, 0xb9,
-		0x34, 0xb5, 0xfe, 0x2a, 0xc1, 0xde, 0x22, 0x88, 0xa1, 0xc0, 0x22, 0xe1, 0xe8, 0x2b, 0x40, 0x23,
-		0xec, 0x4e, 0x7c, 0x36, 0x4e, 0x69, 0xce, 0x35, 0x0d, 0x85, 0x12, 0x29, 0x5a, 0x7a, 0x86, 0x28,
-		0xf2, 0x4b, 0x1a, 0x0a, 0xf4, 0x08, 0x20, 0x26, 0xd8, 0x73, 0x7c, 0x32, 0x25, 0x7e, 0xa6, 0xb0,
-		0x23, 0x2d, 0x7d, 0x69, 0x40, 0x0f, 0x60, 0x07, 0xbb, 0x93, 0x0c, 0x2d, 0x2a, 0xb4, 0x82, 0xdd,
-		0x49, 0x0a, 0x3e, 0x81, 0xfd, 0x18, 0x0b, 0xb2, 0x1a, 0x4b, 0x49, 0xc5, 0xb2, 0x2b, 0xcd, 0x1f,
-		0xe2, 0xe8, 0xc2, 0xae, 0x0c, 0xda, 0xa1, 0x9e, 0x33, 0xf2, 0x99, 0x3b, 0xa9, 0x95, 0x55, 0xc1,
-		0x9a, 0x1f, 0xed, 0x05, 0xb3, 0x7b, 0x26, 0xef, 0x59, 0x55, 0x49, 0x33, 0x3d, 0x75, 0x40, 0x53,
-		0x38, 0xa2, 0x8b, 0xbc, 0x3a, 0x63, 0x99, 0x58, 0x27, 0x48, 0x33, 0x5b, 0xbb, 0xd5, 0x2c, 0x1e,
-		0x57, 0x4f, 0x9f, 0xdd, 0xd8, 0x5b, 0x69, 0x76, 0x8c, 0xdc, 0xd2, 0xf4, 0x42, 0x11, 0xcf, 0xad,
-		0x43, 0xfa, 0x49, 0x65, 0xbb, 0xfd, 0xb1, 0xb2, 0x1d, 0x40, 0x99, 0x04, 0x91, 0x98, 0xd7, 0x2a,
-		0x4d, 0xed, 0xb8, 0x62, 0xa5, 0x87, 0x86, 0x80, 0xc6, 0xc7, 0xb5, 0x73, 0xda, 0xed, 0x39, 0x94,
-		0xa7, 0xb2, 0x73, 0x55, 0x4d, 0xaa, 0xa7, 0x4f, 0x73, 0x83, 0xcb, 0x7d, 0xd1, 0x4a, 0x89, 0x3f,
-		0x14, 0xbe, 0xd7, 0x5a, 0x3f, 0x43, 0x75, 0x25, 0xa1, 0xa8, 0x0e, 0x15, 0x2e, 0x70, 0x2c, 0x1c,
-		0xea, 0x65, 0x1d, 0x71, 0x5b, 0x9d, 0x4d, 0x0f, 0x1d, 0xc2, 0x2d, 0x12, 0x7a, 0x12, 0x48, 0x9b,
-		0xa0, 0x4c, 0x42, 0xcf, 0xf4, 0x5a, 0x7f, 0x6a, 0x00, 0x03, 0xd5, 0x70, 0x66, 0xf8, 0x8e, 0xa1,
-		0x2e, 0xe8, 0x3e, 0xe6, 0xc2, 0xc1, 0xae, 0x4b, 0x38, 0x77, 0xe4, 0xb2, 0xc8, 0xc6, 0xaf, 0xb1,
-		0x35, 0x7e, 0xf6, 0x62, 0x93, 0x58, 0x7b, 0x92, 0xd3, 0x51, 0x14, 0x69, 0x44, 0x0d, 0xa8, 0x50,
-		0x8f, 0x84, 0x82, 0x8a, 0x79, 0x36, 0x43, 0xcb, 0x73, 0x5e, 0x53, 0x15, 0x73, 0x9a, 0xaa, 0xf5,
-		0xb7, 0x06, 0xf5, 0xa1, 0xa0, 0xee, 0x64, 0xde, 0x7b, 0x4f, 0xdc, 0x44, 0x26, 0xa1, 0x23, 0x44,
-		0x4c, 0x47, 0x89, 0x20, 0x1c, 0xbd, 0x00, 0x7d, 0xc6, 0xe2, 0x09, 0x89, 0x55, 0xdd, 0x1c, 0xb9,
-		0x25, 0x33, 0x3f, 0x1f, 0xdd, 0xd8, 0x25, 0xd6, 0x5e, 0x4a, 0x5b, 0xae, 0x34, 0x1b, 0xea, 0xdc,
-		0xbd, 0x26, 0x5e, 0xe2, 0x13, 0x47, 0x30, 0x27, 0xcd, 0x9e, 0x0c, 0x9b, 0x25, 0x22, 0x2b, 0x4d,
-		0x7d, 0x7b, 0xf1, 0x64, 0x3b, 0xd6, 0xba, 0xbf, 0xe0, 0xda, 0x6c, 0x28, 0x99, 0x76, 0x4a, 0x6c,
-		0x3d, 0x83, 0xbb, 0x5b, 0xab, 0x07, 0x7d, 0x09, 0xfa, 0x46, 0x83, 0xf3, 0x9a, 0xd6, 0x2c, 0x1e,
-		0xef, 0x58, 0xfb, 0xeb, 0x9d, 0xc9, 0x5b, 0xff, 0x94, 0xe0, 0x68, 0xeb, 0x81, 0x73, 0x16, 0xbe,
-		0xa3, 0x63, 0x54, 0x83, 0xdb, 0x53, 0x12, 0x73, 0xca, 0xc2, 0x45, 0x89, 0xb3, 0x23, 0x3a, 0x85,
-		0x7b, 0x61, 0x12, 0x38, 0x6a, 0xde, 0xa3, 0x05, 0x8b, 0xab, 0x28, 0xca, 0x67, 0x85, 0x9a, 0x6c,
-		0xe6, 0x24, 0xb0, 0x08, 0xf6, 0x96, 0x4f, 0x72, 0xf4, 0x2d, 0x1c, 0x48, 0xce, 0x2c, 0xa6, 0xb2,
-		0x26, 0x1f, 0x48, 0xc5, 0x25, 0x09, 0x85, 0x49, 0xf0, 0x8b, 0x84, 0x57, 0x58, 0x14, 0xf6, 0x37,
-		0x55, 0x4a, 0x6a, 0x46, 0x9f, 0xdf, 0x98, 0xfd, 0x8d, 0x50, 0x8c, 0x75, 0x5f, 0xd2, 0x29, 0xdd,
-		0x8b, 0xd7, 0x1d, 0xf4, 0x41, 0xdf, 0x72, 0xae, 0xac, 0xb4, 0x3a, 0x9f, 0xa4, 0xb5, 0x11, 0x42,
-		0x2a, 0xb6, 0x3f, 0x5b, 0xb7, 0x36, 0x28, 0xdc, 0xcb, 0x71, 0x6a, 0x75, 0x7c, 0xcb, 0xe9, 0xf8,
-		0xfe, 0xb4, 0x3e, 0xbe, 0x4f, 0xfe, 0x9b, 0x2f, 0x2b, 0xa3, 0xdb, 0xf8, 0x1d, 0x0e, 0xf2, 0x7c,
-		0xfa, 0x3f, 0xb4, 0x9e, 0xfe, 0x01, 0x77, 0x56, 0xff, 0x83, 0x51, 0x03, 0xee, 0xdb, 0x9d, 0xe1,
-		0x85, 0xd3, 0x37, 0x87, 0xb6, 0x73, 0x61, 0x5e, 0x75, 0x1d, 0xf3, 0xea, 0x4d, 0xa7, 0x6f, 0x76,
-		0xf5, 0xcf, 0x50, 0x1d, 0x0e, 0x37, 0xb0, 0xab, 0x57, 0xd6, 0x65, 0xa7, 0xaf, 0x6b, 0x39, 0xd0,
-		0xd0, 0x36, 0xcf, 0x2f, 0xde, 0xea, 0x05, 0xf4, 0x10, 0x6a, 0x1b, 0x50, 0x6f, 0xf0, 0xb2, 0x77,
-		0xd9, 0xb3, 0x3a, 0x7d, 0xbd, 0xf8, 0xd4, 0xfb, 0xa0, 0x6f, 0xcf, 0x23, 0xb2, 0xae, 0x6f, 0xbf,
-		0x1d, 0xf4, 0x56, 0xf4, 0x1f, 0xc0, 0xd1, 0x06, 0xd6, 0xed, 0x9d, 0x9b, 0x43, 0xf3, 0xd5, 0x95,
-		0xae, 0xe5, 0x80, 0x9d, 0x73, 0xdb, 0x7c, 0x63, 0xda, 0x6f, 0xf5, 0xc2, 0xd9, 0x6f, 0x70, 0xe4,
-		0xb2, 0x20, 0x2f, 0x3b, 0x67, 0xbb, 0xcb, 0xf4, 0xc8, 0x19, 0x1e, 0x68, 0xbf, 0x9e, 0x8c, 0xa9,
-		0xb8, 0x4e, 0x46, 0x86, 0xcb, 0x82, 0xf6, 0xea, 0xb7, 0xd7, 0xd7, 0xd4, 0xf3, 0xdb, 0x63, 0x96,
-		0x7e, 0x0e, 0x65, 0x1f, 0x62, 0x3f, 0xe2, 0x88, 0x4e, 0x4f, 0x46, 0xb7, 0x94, 0xed, 0x9b, 0x7f,
-		0x03, 0x00, 0x00, 0xff, 0xff, 0xea, 0xcc, 0x39, 0x04, 0xac, 0x09, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5d, 0x6e, 0xdb, 0x46,
+		0x17, 0xfd, 0x28, 0x59, 0x89, 0x7d, 0x1d, 0xdb, 0xf4, 0x44, 0xb6, 0x25, 0x25, 0xf9, 0xea, 0xe8,
+		0x21, 0x70, 0x8d, 0x56, 0x82, 0xdd, 0x16, 0x28, 0xda, 0x22, 0x89, 0x64, 0x09, 0x31, 0x61, 0xd9,
+		0x16, 0x28, 0x26, 0x81, 0x0b, 0x14, 0xc4, 0x88, 0x9c, 0xc8, 0x53, 0x91, 0x1c, 0x82, 0x33, 0x94,
+		0xa2, 0x3e, 0x74, 0x19, 0xdd, 0x42, 0xf7, 0xd0, 0x3d, 0x74, 0x17, 0x5d, 0x48, 0x31, 0x43, 0x4a,
+		0xd1, 0x0f, 0x63, 0x24, 0x0f, 0x7d, 0xd3, 0xdc, 0x33, 0xe7, 0x9e, 0xfb, 0x33, 0xf7, 0x8a, 0x50,
+		0x8d, 0xfb, 0x24, 0xaa, 0x3b, 0xd8, 0x25, 0x81, 0x43, 0xea, 0x38, 0xa4, 0xf5, 0xd1, 0x49, 0x5d,
+		0x60, 0x3e, 0xf4, 0x28, 0x17, 0xb5, 0x30, 0x62, 0x82, 0xa1, 0x87, 0xf2, 0x4e, 0x2d, 0xbd, 0x53,
+		0xc3, 0x21, 0xad, 0x8d, 0x4e, 0x2a, 0xff, 0x1f, 0x30, 0x36, 0xf0, 0x48, 0x5d, 0x5d, 0xe9, 0xc7,
+		0xef, 0xea, 0x6e, 0x1c, 0x61, 0x41, 0x59, 0x90, 0x90, 0x2a, 0x5f, 0x2c, 0xe3, 0x82, 0xfa, 0x84,
+		0x0b, 0xec, 0x87, 0xe9, 0x85, 0x15, 0x07, 0xe3, 0x08, 0x87, 0x21, 0x89, 0x78, 0x82, 0x57, 0x5f,
+		0xc3, 0xba, 0x85, 0xf9, 0xb0, 0x43, 0xb9, 0x40, 0x08, 0xd6, 0x02, 0xec, 0x93, 0x92, 0x76, 0xa8,
+		0x1d, 0x6d, 0x98, 0xea, 0x37, 0xfa, 0x0e, 0xd6, 0x86, 0x34, 0x70, 0x4b, 0xb9, 0x43, 0xed, 0x68,
+		0xfb, 0xf4, 0x69, 0x2d, 0x23, 0xc8, 0xda, 0xd4, 0xc1, 0x05, 0x0d, 0x5c, 0x53, 0x5d, 0xaf, 0x62,
+		0xd0, 0xa7, 0xd6, 0x4b, 0x22, 0xb0, 0x8b, 0x05, 0x46, 0x97, 0x50, 0xf4, 0xf1, 0x7b, 0x5b, 0xa6,
+		0xcd, 0xed, 0x90, 0x44, 0x36, 0x27, 0x0e, 0x0b, 0x5c, 0x25, 0xb7, 0x79, 0xfa, 0xb8, 0x96, 0x44,
+		0x5a, 0x9b, 0x46, 0x5a, 0x6b, 0xb1, 0xb8, 0xef, 0x91, 0x37, 0xd8, 0x8b, 0x89, 0xb9, 0xeb, 0xe3,
+		0xf7, 0xd2, 0x21, 0xef, 0x92, 0xa8, 0xa7, 0x68, 0xd5, 0xd7, 0x50, 0x9e, 0x4a, 0x74, 0x71, 0x24,
+		0xa8, 0xac, 0xca, 0x4c, 0x4b, 0x87, 0xfc, 0x90, 0x4c, 0xd2, 0x4c, 0xe4, 0x4f, 0xf4, 0x0c, 0x76,
+		0xd8, 0x38, 0x20, 0x91, 0x7d, 0xcb, 0xb8, 0xb0, 0x55, 0x9e, 0x39, 0x85, 0x6e, 0x29, 0xf3, 0x39,
+		0xe3, 0xe2, 0x0a, 0xfb, 0xa4, 0x3a, 0x84, 0x3d, 0x83, 0x33, 0x4f, 0x15, 0xf9, 0x55, 0xc4, 0xe2,
+		0xf0, 0x92, 0x88, 0x88, 0x3a, 0x1c, 0xd5, 0xa1, 0x18, 0x90, 0x71, 0x76, 0xf8, 0x9a, 0xb9, 0x1b,
+		0x90, 0xf1, 0x62, 0x80, 0xe8, 0x29, 0x3c, 0x08, 0x99, 0xe7, 0x91, 0xc8, 0x76, 0x58, 0x1c, 0x08,
+		0x25, 0x97, 0x37, 0x37, 0x13, 0xdb, 0x99, 0x34, 0x55, 0xff, 0x29, 0xc0, 0xf6, 0x34, 0x89, 0x9e,
+		0xc0, 0x22, 0xe6, 0xe8, 0x2b, 0x40, 0x7d, 0xec, 0x0c, 0x3d, 0x36, 0x48, 0x68, 0xf6, 0x2d, 0x0d,
+		0x84, 0x12, 0xc9, 0x9b, 0x7a, 0x8a, 0x28, 0xf2, 0x39, 0x0d, 0x04, 0x7a, 0x02, 0x10, 0x11, 0xec,
+		0xda, 0x1e, 0x19, 0x11, 0x2f, 0x55, 0xd8, 0x90, 0x96, 0x8e, 0x34, 0xa0, 0x47, 0xb0, 0x81, 0x9d,
+		0x61, 0x8a, 0xe6, 0x15, 0xba, 0x8e, 0x9d, 0x61, 0x02, 0x3e, 0x83, 0x9d, 0x08, 0x0b, 0x32, 0x9f,
+		0xcb, 0x9a, 0xca, 0x65, 0x4b, 0x9a, 0x3f, 0xe4, 0xd1, 0x82, 0x2d, 0x99, 0xb4, 0x4d, 0x5d, 0xbb,
+		0xef, 0x31, 0x67, 0x58, 0x2a, 0xa8, 0x86, 0x1d, 0x7e, 0xf4, 0x2d, 0x18, 0xad, 0xa6, 0xbc, 0x67,
+		0x6e, 0x4a, 0x9a, 0xe1, 0xaa, 0x03, 0x1a, 0xc1, 0x01, 0x9d, 0xd6, 0xd5, 0x1e, 0xc8, 0xc2, 0xda,
+		0x7e, 0x52, 0xd9, 0xd2, 0xbd, 0xc3, 0xfc, 0xd1, 0xe6, 0xe9, 0xf3, 0x3b, 0xdf, 0x56, 0x52, 0x9d,
+		0x5a, 0x66, 0x6b, 0xda, 0x81, 0x88, 0x26, 0xe6, 0x1e, 0xfd, 0xac, 0xb6, 0xdd, 0xff, 0x58, 0xdb,
+		0x8a, 0x50, 0x20, 0x7e, 0x28, 0x26, 0xa5, 0xf5, 0x43, 0xed, 0x68, 0xdd, 0x4c, 0x0e, 0xe8, 0x37,
+		0x28, 0x2f, 0xb6, 0xa5, 0x3f, 0xb1, 0xc3, 0x88, 0xb2, 0x88, 0x8a, 0x49, 0x69, 0x43, 0x25, 0xf0,
+		0xe2, 0x53, 0x12, 0x68, 0xce, 0x75, 0xb0, 0x39, 0xe9, 0xa6, 0x1e, 0x92, 0x0c, 0xf6, 0xfb, 0x99,
+		0x60, 0x45, 0x40, 0xe5, 0xe3, 0x79, 0x67, 0x3c, 0xf5, 0x97, 0x50, 0x18, 0xc9, 0xa9, 0x51, 0xef,
+		0x61, 0xf3, 0xf4, 0x38, 0x33, 0xae, 0x4c, 0x8f, 0x66, 0x42, 0xfc, 0x21, 0xf7, 0xbd, 0x56, 0x31,
+		0xe0, 0xd1, 0x1d, 0xc1, 0x66, 0xc8, 0x16, 0xe7, 0x65, 0xf3, 0x73, 0xae, 0xaa, 0x2f, 0x60, 0x73,
+		0xee, 0x5d, 0xa0, 0x32, 0xac, 0x73, 0x81, 0x23, 0x61, 0x53, 0x37, 0x7d, 0xd8, 0xf7, 0xd5, 0xd9,
+		0x70, 0xd1, 0x1e, 0xdc, 0x23, 0x81, 0x2b, 0x81, 0xd4, 0x09, 0x09, 0x5c, 0xc3, 0xad, 0xfe, 0xa1,
+		0x01, 0x74, 0xd5, 0xdc, 0x18, 0xc1, 0x3b, 0x86, 0x5a, 0xa0, 0x7b, 0x98, 0x0b, 0x1b, 0x3b, 0x0e,
+		0xe1, 0xdc, 0x96, 0x3b, 0x2f, 0xdd, 0x22, 0x95, 0x95, 0x2d, 0x62, 0x4d, 0x17, 0xa2, 0xb9, 0x2d,
+		0x39, 0x0d, 0x45, 0x91, 0x46, 0x54, 0x81, 0x75, 0xea, 0x92, 0x40, 0xc8, 0x0e, 0x26, 0xab, 0x60,
+		0x76, 0xce, 0x9a, 0x8d, 0x7c, 0xc6, 0x6c, 0x54, 0xff, 0xd2, 0xa0, 0xdc, 0x13, 0xd4, 0x19, 0x4e,
+		0xda, 0xef, 0x89, 0x13, 0xcb, 0x7a, 0x36, 0x84, 0x88, 0x68, 0x3f, 0x16, 0x84, 0xa3, 0x57, 0xa0,
+		0x8f, 0x59, 0x34, 0x24, 0x91, 0x7a, 0x7e, 0xb6, 0x5c, 0xf6, 0x69, 0x9c, 0x4f, 0xee, 0x7c, 0x2b,
+		0xe6, 0x76, 0x42, 0x9b, 0x6d, 0x66, 0x0b, 0xca, 0xdc, 0xb9, 0x25, 0x6e, 0xec, 0x11, 0x5b, 0x30,
+		0x3b, 0xa9, 0x9e, 0x4c, 0x9b, 0xc5, 0x22, 0xed, 0x72, 0x79, 0x75, 0x7f, 0xa6, 0x7f, 0x15, 0xe6,
+		0xfe, 0x94, 0x6b, 0xb1, 0x9e, 0x64, 0x5a, 0x09, 0xb1, 0xfa, 0x1c, 0x76, 0x57, 0x36, 0x28, 0xfa,
+		0x12, 0xf4, 0xa5, 0x39, 0xe5, 0x25, 0xed, 0x30, 0x7f, 0xb4, 0x61, 0xee, 0x2c, 0x0e, 0x18, 0xaf,
+		0xfe, 0xbd, 0x06, 0x07, 0x2b, 0x0e, 0xce, 0x58, 0xf0, 0x8e, 0x0e, 0x50, 0x09, 0xee, 0x8f, 0x48,
+		0xc4, 0x29, 0x0b, 0xa6, 0x2d, 0x4e, 0x8f, 0xe8, 0x14, 0x1e, 0x06, 0xb1, 0x6f, 0xab, 0xb5, 0x15,
+		0x4e, 0x59, 0x5c, 0x65, 0x51, 0x68, 0xe6, 0x4a, 0x72, 0x26, 0x63, 0xdf, 0x24, 0xd8, 0x9d, 0xb9,
+		0xe4, 0xe8, 0x5b, 0x28, 0x4a, 0xce, 0x38, 0xa2, 0xb2, 0x27, 0x1f, 0x48, 0xf9, 0x19, 0x09, 0x05,
+		0xb1, 0xff, 0x56, 0xc2, 0x73, 0x2c, 0x0a, 0x3b, 0xcb, 0x2a, 0x6b, 0x6a, 0x52, 0x5f, 0xde, 0x59,
+		0xfd, 0xa5, 0x54, 0x6a, 0x8b, 0xb1, 0x24, 0xa3, 0xba, 0x1d, 0x2d, 0x06, 0xe8, 0x81, 0xbe, 0x12,
+		0x5c, 0x41, 0x69, 0x35, 0x3e, 0x4b, 0x6b, 0x29, 0x85, 0x44, 0x6c, 0x67, 0xbc, 0x68, 0xad, 0x50,
+		0x78, 0x98, 0x11, 0xd4, 0xfc, 0x48, 0x16, 0x92, 0x91, 0xfc, 0x69, 0x71, 0x13, 0x3c, 0xfb, 0xb4,
+		0x58, 0xe6, 0xb7, 0xc0, 0xaf, 0x50, 0xcc, 0x8a, 0xe9, 0xbf, 0xd0, 0x3a, 0xfe, 0x1d, 0x1e, 0xcc,
+		0x7f, 0x4a, 0xa0, 0x0a, 0xec, 0x5b, 0x8d, 0xde, 0x85, 0xdd, 0x31, 0x7a, 0x96, 0x7d, 0x61, 0x5c,
+		0xb5, 0x6c, 0xe3, 0xea, 0x4d, 0xa3, 0x63, 0xb4, 0xf4, 0xff, 0xa1, 0x32, 0xec, 0x2d, 0x61, 0x57,
+		0xd7, 0xe6, 0x65, 0xa3, 0xa3, 0x6b, 0x19, 0x50, 0xcf, 0x32, 0xce, 0x2e, 0x6e, 0xf4, 0x1c, 0x7a,
+		0x0c, 0xa5, 0x25, 0xa8, 0xdd, 0x3d, 0x6f, 0x5f, 0xb6, 0xcd, 0x46, 0x47, 0xcf, 0x1f, 0xbb, 0x1f,
+		0xf4, 0xad, 0x49, 0x48, 0x16, 0xf5, 0xad, 0x9b, 0x6e, 0x7b, 0x4e, 0xff, 0x11, 0x1c, 0x2c, 0x61,
+		0xad, 0xf6, 0x99, 0xd1, 0x33, 0xae, 0xaf, 0x74, 0x2d, 0x03, 0x6c, 0x9c, 0x59, 0xc6, 0x1b, 0xc3,
+		0xba, 0xd1, 0x73, 0xc7, 0x7f, 0x6a, 0x89, 0xcc, 0x74, 0x9d, 0xce, 0xe2, 0xed, 0x9a, 0xc6, 0xb5,
+		0x69, 0x58, 0x37, 0x19, 0x59, 0xce, 0xa0, 0x73, 0xe3, 0xd5, 0x79, 0xbb, 0x67, 0xe9, 0x1a, 0xda,
+		0x07, 0xb4, 0x0a, 0xe9, 0x39, 0x54, 0x82, 0xe2, 0xa2, 0x3d, 0xad, 0x4b, 0x1e, 0xed, 0xc1, 0xee,
+		0x22, 0xd2, 0xb9, 0x7e, 0xab, 0xaf, 0xad, 0x12, 0x3a, 0xd7, 0x6f, 0xa5, 0x44, 0xa1, 0xf9, 0x0b,
+		0x1c, 0x38, 0xcc, 0xcf, 0xea, 0x63, 0x73, 0x6b, 0xd6, 0x48, 0xb9, 0x6d, 0xba, 0xda, 0xcf, 0x27,
+		0x03, 0x2a, 0x6e, 0xe3, 0x7e, 0xcd, 0x61, 0x7e, 0x7d, 0xfe, 0x63, 0xf7, 0x6b, 0xea, 0x7a, 0xf5,
+		0x01, 0x4b, 0xbe, 0x3f, 0xd3, 0x2f, 0xdf, 0x1f, 0x71, 0x48, 0x47, 0x27, 0xfd, 0x7b, 0xca, 0xf6,
+		0xcd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x32, 0x27, 0x24, 0xfe, 0x1d, 0x0b, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_worker.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6f, 0xdb, 0xc8,
-		0xf5, 0x07, 0x7d, 0xf7, 0xf1, 0x4d, 0x1e, 0xe3, 0xef, 0xbf, 0xac, 0xdc, 0x1c, 0xa5, 0x49, 0xbc,
-		0x6d, 0x2a, 0x37, 0xde, 0x6d, 0x36, 0x9b, 0x6c, 0x8a, 0xfa, 0x12, 0x23, 0x2e, 0x36, 0x5b, 0x2f,
-		0xa3, 0x4d, 0x80, 0x2d, 0x10, 0x62, 0x44, 0x8e, 0xac, 0x81, 0x29, 0x8e, 0x42, 0x0e, 0xa5, 0xe8,
-		0xa5, 0x0f, 0x7d, 0xdc, 0xf6, 0xa1, 0x40, 0xd1, 0xed, 0x4b, 0x81, 0x3c, 0xf7, 0x03, 0xf4, 0xcb,
-		0xb4, 0x4f, 0x7d, 0xee, 0x67, 0x28, 0x50, 0x70, 0x66, 0x48, 0x51, 0xd2, 0x90, 0xba, 0xb4, 0x80,
-		0x17, 0xe8, 0x9b, 0x39, 0xfc, 0x9d, 0xc3, 0x33, 0xe7, 0x77, 0xe6, 0xcc, 0x6f, 0xc6, 0x82, 0xbd,
-		0xb0, 0x46, 0xfc, 0x7d, 0x1b, 0x3b, 0xc4, 0xb3, 0xc9, 0x3e, 0x6e, 0xd1, 0xfd, 0xf6, 0xc3, 0xfd,
-		0x80, 0xf8, 0x6d, 0x6a, 0x13, 0xab, 0xc3, 0xfc, 0x4b, 0xe2, 0x57, 0x5a, 0x3e, 0xe3, 0x0c, 0x6d,
-		0x45, 0xc8, 0x8a, 0x42, 0x56, 0x70, 0x8b, 0x56, 0xda, 0x0f, 0x4b, 0x37, 0x2f, 0x18, 0xbb, 0x70,
-		0xc9, 0xbe, 0x80, 0xd4, 0xc2, 0xfa, 0xbe, 0x13, 0xfa, 0x98, 0x53, 0xe6, 0x49, 0xa3, 0xd2, 0xad,
-		0xc1, 0xf7, 0x9c, 0x36, 0x49, 0xc0, 0x71, 0xb3, 0xa5, 0x00, 0x43, 0x0e, 0x3a, 0x3e, 0x6e, 0xb5,
-		0x88, 0x1f, 0xa8, 0xf7, 0xbb, 0xba, 0xf8, 0x6c, 0xd6, 0x6c, 0x26, 0x9f, 0x28, 0xeb, 0x10, 0x0e,
-		0xb1, 0x69, 0xd0, 0x0b, 0xe3, 0xb6, 0x0e, 0xd3, 0xa0, 0x01, 0x67, 0x7e, 0x37, 0x8e, 0x54, 0x07,
-		0x79, 0x17, 0x92, 0x04, 0xa0, 0xfd, 0x0e, 0xc7, 0xc1, 0xa5, 0x4b, 0x03, 0x9e, 0x87, 0x89, 0xb2,
-		0x58, 0x77, 0x59, 0x47, 0x62, 0xca, 0x7f, 0x35, 0xa0, 0x74, 0xce, 0x5c, 0xf7, 0x94, 0xf9, 0x27,
-		0x2a, 0xca, 0x2a, 0x0e, 0x2e, 0x4d, 0xf2, 0x2e, 0x24, 0x01, 0x47, 0xdb, 0xb0, 0xe0, 0xb0, 0x26,
-		0xa6, 0x5e, 0xd1, 0xd8, 0x35, 0xf6, 0x96, 0x4d, 0xf5, 0x84, 0x9e, 0xc0, 0x72, 0xf4, 0x31, 0x2b,
-		0xfa, 0x5a, 0x71, 0x66, 0xd7, 0xd8, 0x5b, 0x39, 0xb8, 0x51, 0xd1, 0x50, 0x52, 0x89, 0x9c, 0x7d,
-		0x41, 0x03, 0x6e, 0x2e, 0x71, 0xf5, 0x17, 0x2a, 0xc1, 0x12, 0x75, 0x88, 0xc7, 0x29, 0xef, 0x16,
-		0x67, 0x85, 0xd7, 0xe4, 0x19, 0xdd, 0x87, 0x8d, 0x1a, 0xf5, 0xb0, 0xdf, 0xb5, 0xec, 0x06, 0xb1,
-		0x2f, 0x83, 0xb0, 0x59, 0x9c, 0x13, 0x90, 0x75, 0x39, 0x7c, 0xac, 0x46, 0xcb, 0xff, 0x5a, 0x82,
-		0x6b, 0xda, 0xb8, 0x83, 0x16, 0xf3, 0x02, 0x82, 0x6e, 0x00, 0x88, 0x00, 0x39, 0xbb, 0x24, 0x32,
-		0xf8, 0x55, 0x53, 0x84, 0x5c, 0x8d, 0x06, 0xd0, 0xd7, 0x80, 0xe2, 0x44, 0x58, 0xe4, 0x3d, 0xb1,
-		0xc3, 0xa8, 0x4a, 0xd4, 0x44, 0xee, 0x69, 0x27, 0xf2, 0x46, 0xc1, 0x9f, 0xc7, 0x68, 0x73, 0xb3,
-		0x33, 0x38, 0x84, 0x4e, 0x61, 0x2d, 0x71, 0xcb, 0xbb, 0x2d, 0x22, 0xe6, 0xb7, 0x72, 0x70, 0x3b,
-		0xd7, 0x63, 0xb5, 0xdb, 0x22, 0xe6, 0x6a, 0x27, 0xf5, 0x84, 0x5e, 0xc3, 0x4e, 0xcb, 0x27, 0x6d,
-		0xca, 0xc2, 0xc0, 0x0a, 0x38, 0xf6, 0x39, 0x71, 0x2c, 0xd2, 0x26, 0x1e, 0xb7, 0xa8, 0x23, 0x12,
-		0xb2, 0x72, 0x70, 0xad, 0x22, 0x6b, 0xb5, 0x12, 0xd7, 0x6a, 0xe5, 0xcc, 0xe3, 0x8f, 0x3e, 0x79,
-		0x8d, 0xdd, 0x90, 0x98, 0xdb, 0xb1, 0xf5, 0x2b, 0x69, 0xfc, 0x3c, 0xb2, 0x3d, 0x73, 0xd0, 0x1e,
-		0x14, 0x86, 0xdc, 0xcd, 0xef, 0x1a, 0x7b, 0xb3, 0xe6, 0x7a, 0xd0, 0x8f, 0x2c, 0xc2, 0x22, 0xe6,
-		0x9c, 0x34, 0x5b, 0xbc, 0xb8, 0x20, 0x00, 0xf1, 0x23, 0x7a, 0x00, 0xa8, 0x86, 0xed, 0x4b, 0x97,
-		0x5d, 0x58, 0x36, 0x0b, 0x3d, 0x6e, 0x35, 0xa8, 0xc7, 0x8b, 0x8b, 0x02, 0x54, 0x50, 0x6f, 0x8e,
-		0xa3, 0x17, 0x2f, 0xa8, 0xc7, 0xd1, 0x23, 0x58, 0x54, 0x95, 0x5d, 0x5c, 0x12, 0x71, 0x5f, 0xd7,
-		0xe6, 0xe2, 0x85, 0xc4, 0x98, 0x31, 0x18, 0xdd, 0x83, 0x0d, 0x8f, 0xbc, 0xe7, 0x56, 0x0b, 0x5f,
-		0x10, 0x45, 0xe2, 0xb2, 0x20, 0x71, 0x2d, 0x1a, 0x3e, 0xc7, 0x17, 0x44, 0x12, 0xf9, 0x18, 0xe6,
-		0xc5, 0xb2, 0x28, 0x82, 0xf0, 0x5e, 0xce, 0xcd, 0xf4, 0x57, 0x11, 0xd2, 0x94, 0x06, 0xe8, 0x2d,
-		0x5c, 0x1f, 0x2e, 0x01, 0xab, 0x57, 0xd5, 0x2b, 0xe3, 0x54, 0xf5, 0xce, 0x50, 0x0d, 0xc4, 0xaf,
-		0xd0, 0x21, 0xac, 0x07, 0x76, 0x83, 0x38, 0xa1, 0x4b, 0x1c, 0x2b, 0x6a, 0x34, 0xc5, 0x55, 0xe1,
-		0xb1, 0x34, 0x44, 0x5c, 0x35, 0xee, 0x42, 0xe6, 0x5a, 0x62, 0x11, 0x8d, 0xa1, 0x67, 0xb0, 0x1a,
-		0xd3, 0x25, 0x1c, 0xac, 0x8d, 0x74, 0xb0, 0xa2, 0xf0, 0xc2, 0xfc, 0x0d, 0x2c, 0x46, 0x53, 0xa5,
-		0x24, 0x28, 0xae, 0xef, 0xce, 0xee, 0xad, 0x1c, 0x3c, 0xd3, 0x4e, 0x26, 0x67, 0x19, 0x55, 0xbe,
-		0x92, 0xf6, 0xcf, 0x3d, 0x1e, 0x91, 0xa3, 0xbc, 0xa1, 0x32, 0x08, 0x16, 0x7a, 0x35, 0xb4, 0x21,
-		0xd8, 0x5f, 0x89, 0x06, 0xe3, 0x02, 0xaa, 0xc0, 0x16, 0x67, 0x1c, 0xbb, 0x96, 0x62, 0xd4, 0xaa,
-		0x75, 0x39, 0x09, 0x8a, 0x05, 0x81, 0xdc, 0x14, 0xaf, 0x14, 0xe9, 0x47, 0xd1, 0x0b, 0xf4, 0x12,
-		0x0a, 0x38, 0xe4, 0xcc, 0xb2, 0x99, 0x57, 0xa7, 0x17, 0xb2, 0xa8, 0x36, 0xc5, 0x7c, 0xef, 0x68,
-		0xa3, 0x3e, 0x0c, 0x39, 0x3b, 0x16, 0xd8, 0xa8, 0xce, 0xcc, 0x75, 0xdc, 0xf7, 0x5c, 0x7a, 0x0b,
-		0xab, 0xe9, 0xd8, 0x51, 0x01, 0x66, 0x2f, 0x49, 0x57, 0x75, 0xb1, 0xe8, 0xcf, 0xa8, 0x72, 0xda,
-		0xd1, 0x62, 0x51, 0xab, 0x7e, 0xac, 0xca, 0x11, 0x06, 0x4f, 0x66, 0x1e, 0x1b, 0xe5, 0xbf, 0xcc,
-		0xc3, 0x1d, 0x99, 0x25, 0x27, 0x9d, 0xb8, 0x63, 0xd6, 0x6c, 0xb9, 0x84, 0x13, 0x27, 0x6e, 0xa0,
-		0x23, 0xfa, 0xd0, 0x53, 0x58, 0x8e, 0x37, 0x87, 0xa0, 0x38, 0x23, 0x48, 0xd2, 0x57, 0x5c, 0xfc,
-		0x11, 0xb3, 0x87, 0x47, 0x3f, 0x82, 0xcd, 0x5e, 0xe1, 0xda, 0xcc, 0xe3, 0xe4, 0x3d, 0x17, 0x1d,
-		0x67, 0xd5, 0x2c, 0x24, 0x2f, 0x8e, 0xe5, 0x78, 0x5f, 0xd7, 0x9d, 0x1b, 0xe8, 0xba, 0xbf, 0x82,
-		0xcd, 0x80, 0x53, 0xfb, 0xb2, 0x6b, 0x61, 0xce, 0x7d, 0x5a, 0x0b, 0x23, 0xa6, 0xe6, 0x45, 0x5a,
-		0x2a, 0xda, 0x68, 0x5e, 0x09, 0x74, 0x52, 0xf3, 0x87, 0x89, 0x95, 0x59, 0x90, 0x8e, 0x7a, 0x23,
-		0xe8, 0x53, 0x28, 0xfa, 0x84, 0x87, 0xbe, 0x67, 0x79, 0xa4, 0x63, 0xc5, 0xd1, 0x8b, 0x85, 0x26,
-		0x5a, 0xcb, 0x92, 0xf9, 0x7f, 0xf2, 0xfd, 0x97, 0xa4, 0x93, 0x4e, 0x25, 0x3a, 0x82, 0x9b, 0x75,
-		0xe6, 0xdb, 0xc4, 0xb2, 0x7d, 0x82, 0x39, 0xd1, 0x98, 0x2f, 0x0a, 0xf3, 0x92, 0x40, 0x1d, 0x0b,
-		0xd0, 0xa0, 0x0f, 0xcd, 0x7e, 0xb2, 0xa4, 0xdb, 0x4f, 0x10, 0x83, 0x35, 0xd1, 0x16, 0x2c, 0x9f,
-		0x04, 0xa1, 0xcb, 0x83, 0xe2, 0xb2, 0x20, 0xe3, 0x17, 0xda, 0xe9, 0x8f, 0x41, 0x7c, 0x45, 0x56,
-		0x8c, 0x74, 0x26, 0x97, 0xcf, 0xea, 0xbb, 0xd4, 0x50, 0x89, 0xc2, 0xe6, 0x10, 0x44, 0x53, 0xa5,
-		0x3f, 0xeb, 0xaf, 0xd2, 0xbd, 0x31, 0xaa, 0x54, 0x38, 0x4c, 0xd7, 0xea, 0x87, 0x59, 0xf8, 0x41,
-		0x7e, 0xc8, 0x6a, 0xd3, 0xfc, 0x1a, 0xd6, 0xfa, 0x13, 0x6c, 0x88, 0x8f, 0xfe, 0x64, 0xd2, 0xb6,
-		0x61, 0xae, 0x3a, 0x69, 0x12, 0x3e, 0x18, 0x70, 0x13, 0xdb, 0x9c, 0xb6, 0x29, 0xa7, 0x24, 0xb0,
-		0x38, 0xb3, 0x1c, 0x1a, 0xb4, 0x30, 0xb7, 0x1b, 0x96, 0xcb, 0x6c, 0xec, 0xba, 0x5d, 0x55, 0xfa,
-		0xdf, 0x4c, 0x91, 0x6d, 0xd5, 0xa8, 0x0e, 0x13, 0xff, 0x55, 0x76, 0xa2, 0xbc, 0x7f, 0x21, 0x9d,
-		0xcb, 0xec, 0x5f, 0xc3, 0xd9, 0x88, 0xd2, 0xaf, 0x61, 0x77, 0x94, 0x03, 0x0d, 0x37, 0x27, 0xfd,
-		0xdc, 0xe8, 0x97, 0x8a, 0xf2, 0xdb, 0x15, 0xbe, 0x62, 0xc7, 0x67, 0x5e, 0x9d, 0xa5, 0x19, 0xfa,
-		0xcd, 0x0c, 0xec, 0x6a, 0xa6, 0x79, 0x8a, 0xa9, 0x3b, 0x76, 0x2b, 0x39, 0x82, 0x79, 0x1b, 0x87,
-		0x81, 0x8c, 0x66, 0xfd, 0xe0, 0x41, 0x6e, 0x1b, 0xe9, 0x79, 0x3f, 0x8e, 0x6c, 0x4c, 0x69, 0x1a,
-		0xed, 0xd6, 0x0e, 0xe1, 0x98, 0xba, 0x81, 0x52, 0x2e, 0xfa, 0xdd, 0xfa, 0x1c, 0x77, 0x5d, 0x86,
-		0x1d, 0x33, 0x06, 0xe7, 0x36, 0x17, 0xcd, 0x12, 0x9c, 0xd7, 0x4a, 0xba, 0x3b, 0x70, 0x3b, 0x27,
-		0x07, 0x92, 0xe7, 0xf2, 0x3f, 0x7a, 0x7a, 0x35, 0xce, 0xec, 0x55, 0xea, 0xd5, 0x57, 0x80, 0x12,
-		0xbf, 0x56, 0x93, 0x70, 0xec, 0x60, 0x8e, 0x95, 0x42, 0xbb, 0x9b, 0xfb, 0x81, 0x97, 0x0a, 0x6c,
-		0x16, 0xf8, 0xc0, 0x48, 0xf9, 0x6f, 0x3d, 0x6d, 0xdb, 0x3f, 0xc7, 0x2b, 0xd5, 0xb6, 0xb7, 0x60,
-		0x45, 0x2d, 0xa1, 0x6e, 0xb4, 0xe5, 0xcb, 0x4c, 0x40, 0x3c, 0x74, 0xe6, 0x44, 0xe2, 0x37, 0x01,
-		0x08, 0xf1, 0x3b, 0x97, 0x23, 0x7e, 0x93, 0x89, 0x09, 0xf1, 0x8b, 0x53, 0x4f, 0xe8, 0x00, 0xe6,
-		0xa9, 0xd7, 0x0a, 0xb9, 0xda, 0x81, 0xf2, 0x4b, 0x50, 0x42, 0x35, 0x62, 0x6b, 0xe1, 0x3f, 0x15,
-		0x5b, 0x8b, 0x93, 0x89, 0xad, 0x2a, 0xec, 0xc4, 0xfe, 0xa2, 0x0e, 0x67, 0xbb, 0x2c, 0x20, 0xc2,
-		0x11, 0x0b, 0xb9, 0x92, 0xbe, 0x3b, 0x43, 0xbe, 0x4e, 0xd4, 0xf9, 0xd4, 0xdc, 0x8e, 0x6d, 0xab,
-		0xec, 0x38, 0xb2, 0xac, 0x4a, 0x43, 0xf4, 0x25, 0x6c, 0x8b, 0x8f, 0x0c, 0xbb, 0x5c, 0x1e, 0xe5,
-		0x72, 0x4b, 0x18, 0x0e, 0xf8, 0x3b, 0x85, 0xcd, 0x06, 0xc1, 0x3e, 0xaf, 0x11, 0xcc, 0x13, 0x57,
-		0x30, 0xca, 0x55, 0x21, 0xb1, 0x89, 0xfd, 0xa4, 0x8e, 0x07, 0x91, 0x4e, 0x9e, 0xef, 0x1d, 0x0f,
-		0xde, 0xc2, 0xcd, 0x7e, 0x26, 0x2c, 0x56, 0xb7, 0x78, 0x83, 0x06, 0x56, 0x6c, 0x30, 0x5a, 0x06,
-		0x97, 0xfa, 0x98, 0xf9, 0x65, 0xbd, 0xda, 0xa0, 0xc1, 0xa1, 0xf2, 0x7f, 0x96, 0x9e, 0x41, 0xdc,
-		0xac, 0xd6, 0xc6, 0xa8, 0x94, 0xde, 0x24, 0x4e, 0x54, 0xd7, 0x1a, 0x3a, 0xad, 0xad, 0x4f, 0x77,
-		0x5a, 0xbb, 0x0f, 0x1b, 0x89, 0x1f, 0xd5, 0x7d, 0x36, 0x64, 0x87, 0x8b, 0x87, 0x4f, 0x64, 0x17,
-		0xfa, 0x18, 0x16, 0x1a, 0x04, 0x3b, 0xc4, 0x17, 0x32, 0x38, 0x3a, 0xc3, 0x69, 0xcf, 0x42, 0x02,
-		0x62, 0x2a, 0xe8, 0x7f, 0x59, 0x18, 0x97, 0xbf, 0x33, 0x12, 0xe1, 0x9a, 0x6e, 0x2e, 0x93, 0x0a,
-		0xd7, 0x4f, 0x60, 0x41, 0x2a, 0x25, 0xd5, 0x58, 0xf2, 0x73, 0xaf, 0xb0, 0x79, 0xad, 0xb4, 0x7c,
-		0x2f, 0x11, 0x29, 0x19, 0x71, 0xa9, 0x1d, 0xe0, 0xb7, 0x33, 0x70, 0x3f, 0x0f, 0x78, 0xd4, 0x3d,
-		0x3b, 0x19, 0xb5, 0x1d, 0x5c, 0x55, 0x8b, 0xec, 0x65, 0x6d, 0x6e, 0xca, 0xac, 0xcd, 0x0f, 0x64,
-		0xed, 0x87, 0xb0, 0x37, 0x3a, 0x19, 0x2a, 0x73, 0x7f, 0x34, 0x12, 0x95, 0x91, 0x06, 0x4f, 0xa4,
-		0x32, 0x1e, 0xc1, 0x62, 0x1d, 0x53, 0x37, 0xf4, 0x49, 0x2e, 0xf1, 0xa7, 0x12, 0x63, 0xc6, 0xe0,
-		0x5c, 0xe6, 0x7b, 0x1b, 0xbf, 0x2e, 0x2c, 0x15, 0xfc, 0xb7, 0x33, 0xda, 0xfa, 0x90, 0xa8, 0xef,
-		0x33, 0xe7, 0xa9, 0x8c, 0xcd, 0x4d, 0x9b, 0xb1, 0x41, 0xd6, 0xef, 0xc3, 0xdd, 0x11, 0xb9, 0x50,
-		0x59, 0xfb, 0x93, 0x01, 0x65, 0x5d, 0x7d, 0x60, 0xcf, 0x26, 0x13, 0x91, 0x1e, 0x77, 0xda, 0x99,
-		0x69, 0x65, 0xe1, 0x20, 0xe9, 0x77, 0xf5, 0x6d, 0x28, 0x09, 0x4c, 0x4d, 0xe0, 0x77, 0x33, 0x70,
-		0x2f, 0x07, 0xf7, 0x3d, 0x27, 0x3e, 0xce, 0xda, 0xdc, 0xb4, 0x59, 0x1b, 0x24, 0xfe, 0x23, 0x7d,
-		0xef, 0xeb, 0xcb, 0x46, 0x1f, 0xf5, 0x36, 0xf3, 0xfb, 0xa0, 0x2f, 0xe2, 0x4d, 0xf0, 0x0a, 0xa9,
-		0x3f, 0x8f, 0xa8, 0xcf, 0x09, 0x4c, 0xc9, 0xdc, 0x8f, 0xa0, 0x60, 0x8b, 0x89, 0x59, 0xbe, 0x8c,
-		0x95, 0x38, 0x22, 0xbe, 0x25, 0x73, 0x43, 0x8e, 0x9b, 0xf1, 0xb0, 0xaa, 0x92, 0x4c, 0x97, 0xff,
-		0x6b, 0x55, 0x52, 0x8d, 0xaa, 0x64, 0x44, 0x36, 0x26, 0x4f, 0xf2, 0xdf, 0x7b, 0xdb, 0x87, 0xb8,
-		0x68, 0x98, 0x46, 0x36, 0xfc, 0x7c, 0x40, 0x36, 0x8c, 0x7f, 0x9f, 0x11, 0x6f, 0x86, 0xaf, 0x61,
-		0x4b, 0xfe, 0x23, 0xc8, 0x6a, 0x13, 0x5f, 0xdc, 0x54, 0x50, 0xaf, 0xce, 0xd4, 0x71, 0x35, 0x9b,
-		0x28, 0xe2, 0xbf, 0x96, 0x70, 0x71, 0xf4, 0xde, 0xec, 0x0c, 0x0e, 0xa5, 0x36, 0x21, 0xdd, 0xe4,
-		0x62, 0xed, 0x61, 0x40, 0xc9, 0x24, 0x01, 0xe1, 0xf2, 0x02, 0x2c, 0x39, 0x2c, 0x5e, 0x49, 0x6d,
-		0x95, 0x6f, 0xc0, 0x35, 0x6d, 0x30, 0x2a, 0x58, 0x1f, 0xd6, 0xfb, 0xb5, 0x20, 0x7a, 0x00, 0x88,
-		0x78, 0xb8, 0xe6, 0x12, 0x2b, 0xa5, 0x28, 0x15, 0xdd, 0x05, 0xf9, 0xa6, 0x67, 0x81, 0x0e, 0x60,
-		0xbb, 0xc5, 0x5c, 0x97, 0xf8, 0x56, 0x07, 0x53, 0x79, 0x5a, 0xb0, 0xa8, 0x67, 0x35, 0x65, 0x27,
-		0x98, 0x35, 0x91, 0x7c, 0xfb, 0x06, 0x53, 0x71, 0x2c, 0x38, 0xf3, 0x5e, 0x06, 0x07, 0xff, 0xdc,
-		0x80, 0x65, 0x99, 0xee, 0xc3, 0xf3, 0x33, 0xf4, 0x1e, 0xb6, 0x34, 0xb7, 0x44, 0x68, 0x7f, 0xfc,
-		0xfb, 0x24, 0x91, 0xd7, 0xd2, 0xc4, 0x17, 0x50, 0xe8, 0x0f, 0x06, 0x5c, 0xcf, 0xbb, 0x37, 0x42,
-		0x8f, 0xa7, 0xbd, 0xd8, 0x2b, 0x7d, 0x36, 0xf5, 0x25, 0x15, 0xfa, 0xd6, 0x80, 0x9d, 0xcc, 0x2b,
-		0x0e, 0xf4, 0xd3, 0x71, 0x1d, 0xf7, 0x09, 0xb6, 0xd2, 0xa3, 0x49, 0xcd, 0x54, 0x30, 0x3d, 0x72,
-		0xd2, 0x5d, 0x22, 0x9f, 0x1c, 0xcd, 0x95, 0x4b, 0x3e, 0x39, 0xda, 0xfb, 0x8b, 0x14, 0x39, 0x5a,
-		0xd1, 0x9a, 0x4f, 0x4e, 0xde, 0xa9, 0x25, 0x9f, 0x9c, 0xdc, 0x73, 0x05, 0xfa, 0xa0, 0x57, 0xc7,
-		0x7d, 0x52, 0x1a, 0x7d, 0x3e, 0xb1, 0xff, 0xd4, 0xde, 0x53, 0x7a, 0x36, 0xa5, 0xf5, 0x70, 0xf9,
-		0x0c, 0xcb, 0xbe, 0xfc, 0xf2, 0xc9, 0xd4, 0xfb, 0xf9, 0xe5, 0x93, 0xad, 0xc7, 0xd1, 0x77, 0x06,
-		0xdc, 0xc8, 0xd5, 0xa0, 0xe8, 0xb3, 0xc9, 0x3c, 0xa7, 0x13, 0xf5, 0x64, 0x1a, 0x53, 0x15, 0xd8,
-		0xef, 0x0d, 0xd1, 0x16, 0xb3, 0x34, 0x12, 0xfa, 0x74, 0x6c, 0x12, 0xfa, 0x45, 0x72, 0xe9, 0xf1,
-		0xe4, 0x86, 0x2a, 0xa4, 0x3f, 0x1b, 0x70, 0x6b, 0x84, 0x6c, 0x43, 0x4f, 0x27, 0xf5, 0x9e, 0xce,
-		0xd7, 0xe7, 0xd3, 0x19, 0xf7, 0x65, 0x2c, 0x53, 0x2f, 0x64, 0x66, 0x6c, 0x94, 0xb6, 0xcc, 0xcc,
-		0xd8, 0x68, 0xed, 0x27, 0x33, 0x96, 0x2b, 0x61, 0x32, 0x33, 0x36, 0x8e, 0x0c, 0xcc, 0xcc, 0xd8,
-		0x78, 0xaa, 0x29, 0xb5, 0x12, 0x87, 0xd5, 0x42, 0xfe, 0x4a, 0xcc, 0x94, 0x4e, 0xf9, 0x2b, 0x31,
-		0x5b, 0x94, 0x44, 0x8d, 0x5c, 0x23, 0x03, 0x32, 0x1a, 0x79, 0xb6, 0x7a, 0xc9, 0x68, 0xe4, 0x39,
-		0x0a, 0xe3, 0xa8, 0x06, 0xff, 0x6f, 0xb3, 0xa6, 0xce, 0xec, 0x08, 0x49, 0x15, 0xf0, 0x4a, 0xfe,
-		0x76, 0xe7, 0xdc, 0x67, 0x9c, 0x9d, 0x1b, 0xdf, 0x3c, 0xbc, 0xa0, 0xbc, 0x11, 0xd6, 0x2a, 0x36,
-		0x6b, 0xee, 0xa7, 0x7f, 0x9c, 0xf2, 0x63, 0xea, 0xb8, 0xfb, 0x17, 0x4c, 0xfe, 0xee, 0x46, 0xfd,
-		0x52, 0xe5, 0x29, 0x6e, 0xd1, 0xf6, 0xc3, 0xda, 0x82, 0x18, 0xfb, 0xf8, 0xdf, 0x01, 0x00, 0x00,
-		0xff, 0xff, 0x83, 0x33, 0x80, 0xed, 0x1b, 0x24, 0x00, 0x00,
+		0x15, 0x06, 0x7d, 0xf7, 0xf1, 0x4d, 0x9e, 0xa0, 0xae, 0xac, 0xdc, 0x1c, 0xa5, 0x49, 0xbc, 0x6d,
+		0x2a, 0x37, 0xde, 0x6d, 0x36, 0x9b, 0x6c, 0x8a, 0xfa, 0x12, 0x23, 0x2e, 0x36, 0x5b, 0x2f, 0xa3,
+		0x24, 0xc0, 0x16, 0x08, 0x31, 0x22, 0xc7, 0xd6, 0xc0, 0x14, 0x87, 0x21, 0x87, 0x56, 0xf4, 0xd2,
+		0x87, 0x3e, 0x6e, 0xfb, 0x50, 0xa0, 0xe8, 0xf6, 0xa5, 0x40, 0x9e, 0xfb, 0x03, 0xfa, 0xd6, 0x5f,
+		0xd2, 0x3e, 0xf5, 0xb9, 0x7f, 0xa2, 0xe0, 0xcc, 0x90, 0xa2, 0xa4, 0x21, 0x75, 0x69, 0x01, 0x2f,
+		0xb0, 0x6f, 0xe6, 0xf0, 0x3b, 0x87, 0x67, 0xbe, 0x73, 0xe6, 0xcc, 0x37, 0x63, 0xc1, 0x76, 0xd4,
+		0x20, 0xc1, 0x8e, 0x8d, 0x1d, 0xe2, 0xd9, 0x64, 0x07, 0xfb, 0x74, 0xe7, 0xe2, 0xc1, 0x4e, 0x48,
+		0x82, 0x0b, 0x6a, 0x13, 0xab, 0xcd, 0x82, 0x73, 0x12, 0xd4, 0xfc, 0x80, 0x71, 0x86, 0xae, 0xc4,
+		0xc8, 0x9a, 0x42, 0xd6, 0xb0, 0x4f, 0x6b, 0x17, 0x0f, 0x2a, 0x37, 0xce, 0x18, 0x3b, 0x73, 0xc9,
+		0x8e, 0x80, 0x34, 0xa2, 0xd3, 0x1d, 0x27, 0x0a, 0x30, 0xa7, 0xcc, 0x93, 0x46, 0x95, 0x9b, 0xfd,
+		0xef, 0x39, 0x6d, 0x91, 0x90, 0xe3, 0x96, 0xaf, 0x00, 0x03, 0x0e, 0xda, 0x01, 0xf6, 0x7d, 0x12,
+		0x84, 0xea, 0xfd, 0x96, 0x2e, 0x3e, 0x9b, 0xb5, 0x5a, 0xe9, 0x27, 0xaa, 0x3a, 0x84, 0x43, 0x6c,
+		0x1a, 0x76, 0xc3, 0xb8, 0xa5, 0xc3, 0x34, 0x69, 0xc8, 0x59, 0xd0, 0x49, 0x22, 0xd5, 0x41, 0xde,
+		0x45, 0x24, 0x05, 0x68, 0xbf, 0xc3, 0x71, 0x78, 0xee, 0xd2, 0x90, 0x17, 0x61, 0x62, 0x16, 0x4f,
+		0x5d, 0xd6, 0x96, 0x98, 0xea, 0xdf, 0x0d, 0xa8, 0x9c, 0x30, 0xd7, 0x3d, 0x62, 0xc1, 0xa1, 0x8a,
+		0xb2, 0x8e, 0xc3, 0x73, 0x93, 0xbc, 0x8b, 0x48, 0xc8, 0xd1, 0x06, 0xcc, 0x39, 0xac, 0x85, 0xa9,
+		0x57, 0x36, 0xb6, 0x8c, 0xed, 0x45, 0x53, 0x3d, 0xa1, 0xc7, 0xb0, 0x18, 0x7f, 0xcc, 0x8a, 0xbf,
+		0x56, 0x9e, 0xda, 0x32, 0xb6, 0x97, 0x76, 0xaf, 0xd7, 0x34, 0x29, 0xa9, 0xc5, 0xce, 0xbe, 0xa0,
+		0x21, 0x37, 0x17, 0xb8, 0xfa, 0x0b, 0x55, 0x60, 0x81, 0x3a, 0xc4, 0xe3, 0x94, 0x77, 0xca, 0xd3,
+		0xc2, 0x6b, 0xfa, 0x8c, 0xee, 0xc1, 0x5a, 0x83, 0x7a, 0x38, 0xe8, 0x58, 0x76, 0x93, 0xd8, 0xe7,
+		0x61, 0xd4, 0x2a, 0xcf, 0x08, 0xc8, 0xaa, 0x1c, 0x3e, 0x50, 0xa3, 0xd5, 0x7f, 0x2c, 0xc2, 0x55,
+		0x6d, 0xdc, 0xa1, 0xcf, 0xbc, 0x90, 0xa0, 0xeb, 0x00, 0x22, 0x40, 0xce, 0xce, 0x89, 0x0c, 0x7e,
+		0xd9, 0x14, 0x21, 0xd7, 0xe3, 0x01, 0xf4, 0x0a, 0x50, 0x42, 0x84, 0x45, 0xde, 0x13, 0x3b, 0x8a,
+		0xab, 0x44, 0x4d, 0xe4, 0xae, 0x76, 0x22, 0x6f, 0x14, 0xfc, 0x59, 0x82, 0x36, 0xd7, 0xdb, 0xfd,
+		0x43, 0xe8, 0x08, 0x56, 0x52, 0xb7, 0xbc, 0xe3, 0x13, 0x31, 0xbf, 0xa5, 0xdd, 0x5b, 0x85, 0x1e,
+		0xeb, 0x1d, 0x9f, 0x98, 0xcb, 0xed, 0xcc, 0x13, 0x7a, 0x0d, 0x9b, 0x7e, 0x40, 0x2e, 0x28, 0x8b,
+		0x42, 0x2b, 0xe4, 0x38, 0xe0, 0xc4, 0xb1, 0xc8, 0x05, 0xf1, 0xb8, 0x45, 0x1d, 0x41, 0xc8, 0xd2,
+		0xee, 0xd5, 0x9a, 0xac, 0xd5, 0x5a, 0x52, 0xab, 0xb5, 0x63, 0x8f, 0x3f, 0xfc, 0xe4, 0x35, 0x76,
+		0x23, 0x62, 0x6e, 0x24, 0xd6, 0x2f, 0xa5, 0xf1, 0xb3, 0xd8, 0xf6, 0xd8, 0x41, 0xdb, 0x50, 0x1a,
+		0x70, 0x37, 0xbb, 0x65, 0x6c, 0x4f, 0x9b, 0xab, 0x61, 0x2f, 0xb2, 0x0c, 0xf3, 0x98, 0x73, 0xd2,
+		0xf2, 0x79, 0x79, 0x4e, 0x00, 0x92, 0x47, 0x74, 0x1f, 0x50, 0x03, 0xdb, 0xe7, 0x2e, 0x3b, 0xb3,
+		0x6c, 0x16, 0x79, 0xdc, 0x6a, 0x52, 0x8f, 0x97, 0xe7, 0x05, 0xa8, 0xa4, 0xde, 0x1c, 0xc4, 0x2f,
+		0x9e, 0x53, 0x8f, 0xa3, 0x87, 0x30, 0xaf, 0x2a, 0xbb, 0xbc, 0x20, 0xe2, 0xbe, 0xa6, 0xe5, 0xe2,
+		0xb9, 0xc4, 0x98, 0x09, 0x18, 0xdd, 0x85, 0x35, 0x8f, 0xbc, 0xe7, 0x96, 0x8f, 0xcf, 0x88, 0x4a,
+		0xe2, 0xa2, 0x48, 0xe2, 0x4a, 0x3c, 0x7c, 0x82, 0xcf, 0x88, 0x4c, 0xe4, 0x23, 0x98, 0x15, 0xcb,
+		0xa2, 0x0c, 0xc2, 0x7b, 0xb5, 0x90, 0xe9, 0xaf, 0x62, 0xa4, 0x29, 0x0d, 0xd0, 0x5b, 0xb8, 0x36,
+		0x58, 0x02, 0x56, 0xb7, 0xaa, 0x97, 0x46, 0xa9, 0xea, 0xcd, 0x81, 0x1a, 0x48, 0x5e, 0xa1, 0x3d,
+		0x58, 0x0d, 0xed, 0x26, 0x71, 0x22, 0x97, 0x38, 0x56, 0xdc, 0x68, 0xca, 0xcb, 0xc2, 0x63, 0x65,
+		0x20, 0x71, 0xf5, 0xa4, 0x0b, 0x99, 0x2b, 0xa9, 0x45, 0x3c, 0x86, 0x9e, 0xc2, 0x72, 0x92, 0x2e,
+		0xe1, 0x60, 0x65, 0xa8, 0x83, 0x25, 0x85, 0x17, 0xe6, 0x6f, 0x60, 0x3e, 0x9e, 0x2a, 0x25, 0x61,
+		0x79, 0x75, 0x6b, 0x7a, 0x7b, 0x69, 0xf7, 0xa9, 0x76, 0x32, 0x05, 0xcb, 0xa8, 0xf6, 0x95, 0xb4,
+		0x7f, 0xe6, 0xf1, 0x38, 0x39, 0xca, 0x1b, 0xaa, 0x82, 0xc8, 0x42, 0xb7, 0x86, 0xd6, 0x44, 0xf6,
+		0x97, 0xe2, 0xc1, 0xa4, 0x80, 0x6a, 0x70, 0x85, 0x33, 0x8e, 0x5d, 0x4b, 0x65, 0xd4, 0x6a, 0x74,
+		0x38, 0x09, 0xcb, 0x25, 0x81, 0x5c, 0x17, 0xaf, 0x54, 0xd2, 0xf7, 0xe3, 0x17, 0xe8, 0x05, 0x94,
+		0x70, 0xc4, 0x99, 0x65, 0x33, 0xef, 0x94, 0x9e, 0xc9, 0xa2, 0x5a, 0x17, 0xf3, 0xbd, 0xad, 0x8d,
+		0x7a, 0x2f, 0xe2, 0xec, 0x40, 0x60, 0xe3, 0x3a, 0x33, 0x57, 0x71, 0xcf, 0x33, 0x7a, 0x0a, 0xf3,
+		0x91, 0xef, 0xe0, 0xf8, 0x93, 0x48, 0xcc, 0xfd, 0x76, 0x61, 0x65, 0xbc, 0x12, 0x58, 0x33, 0xb1,
+		0xa9, 0xbc, 0x85, 0xe5, 0xec, 0xd4, 0x51, 0x09, 0xa6, 0xcf, 0x49, 0x47, 0x35, 0xc1, 0xf8, 0xcf,
+		0xb8, 0xf0, 0x2e, 0xe2, 0xb5, 0xa6, 0x9a, 0xc6, 0x48, 0x85, 0x27, 0x0c, 0x1e, 0x4f, 0x3d, 0x32,
+		0xaa, 0x7f, 0x9b, 0x85, 0xdb, 0x92, 0x64, 0x27, 0xcb, 0xfb, 0x01, 0x6b, 0xf9, 0x2e, 0xe1, 0xc4,
+		0x49, 0xfa, 0xef, 0x90, 0x36, 0xf6, 0x04, 0x16, 0x93, 0xbd, 0x25, 0x2c, 0x4f, 0x89, 0x79, 0xea,
+		0x0b, 0x36, 0xf9, 0x88, 0xd9, 0xc5, 0xa3, 0x9f, 0xc0, 0x7a, 0xb7, 0xee, 0x6d, 0xe6, 0x71, 0xf2,
+		0x9e, 0x8b, 0x86, 0xb5, 0x6c, 0x96, 0xd2, 0x17, 0x07, 0x72, 0xbc, 0xa7, 0x69, 0xcf, 0xf4, 0x35,
+		0xed, 0xdf, 0xc0, 0x7a, 0xc8, 0xa9, 0x7d, 0xde, 0xb1, 0x30, 0xe7, 0x01, 0x6d, 0x44, 0x31, 0xeb,
+		0xb3, 0x82, 0x96, 0x9a, 0x36, 0x9a, 0x97, 0x02, 0x9d, 0x2e, 0x99, 0xbd, 0xd4, 0xca, 0x2c, 0x49,
+		0x47, 0xdd, 0x11, 0xf4, 0x29, 0x94, 0x03, 0xc2, 0xa3, 0xc0, 0xb3, 0x3c, 0xd2, 0xb6, 0x92, 0xe8,
+		0xc5, 0x3a, 0x15, 0x9d, 0x69, 0xc1, 0xfc, 0x81, 0x7c, 0xff, 0x25, 0x69, 0x67, 0xa9, 0x44, 0xfb,
+		0x70, 0xe3, 0x94, 0x05, 0x36, 0xb1, 0xec, 0x80, 0x60, 0x4e, 0x34, 0xe6, 0xf3, 0xc2, 0xbc, 0x22,
+		0x50, 0x07, 0x02, 0xd4, 0xef, 0x43, 0xb3, 0x1d, 0x2d, 0xe8, 0xb6, 0x23, 0xc4, 0x60, 0x45, 0x74,
+		0x15, 0x2b, 0x20, 0x61, 0xe4, 0xf2, 0xb0, 0xbc, 0x28, 0x92, 0xf1, 0x2b, 0xed, 0xf4, 0x47, 0x48,
+		0x7c, 0x4d, 0x56, 0x8c, 0x74, 0x26, 0x57, 0xdf, 0xf2, 0xbb, 0xcc, 0x50, 0x85, 0xc2, 0xfa, 0x00,
+		0x44, 0x53, 0xa5, 0xbf, 0xe8, 0xad, 0xd2, 0xed, 0x11, 0xaa, 0x54, 0x38, 0xcc, 0xd6, 0xea, 0x87,
+		0x69, 0xf8, 0x51, 0x71, 0xc8, 0x6a, 0xcf, 0x7d, 0x05, 0x2b, 0xbd, 0x04, 0x1b, 0xe2, 0xa3, 0x3f,
+		0x1b, 0xb7, 0xeb, 0x98, 0xcb, 0x4e, 0x36, 0x09, 0x1f, 0x0c, 0xb8, 0x81, 0x6d, 0x4e, 0x2f, 0x28,
+		0xa7, 0x24, 0xb4, 0x38, 0xb3, 0x1c, 0x1a, 0xfa, 0x98, 0xdb, 0x4d, 0xcb, 0x65, 0x36, 0x76, 0xdd,
+		0x8e, 0x2a, 0xfd, 0xaf, 0x27, 0x60, 0x5b, 0xf5, 0xb9, 0xbd, 0xd4, 0x7f, 0x9d, 0x1d, 0x2a, 0xef,
+		0x5f, 0x48, 0xe7, 0x92, 0xfd, 0xab, 0x38, 0x1f, 0x51, 0xf9, 0x2d, 0x6c, 0x0d, 0x73, 0xa0, 0xc9,
+		0xcd, 0x61, 0x6f, 0x6e, 0xf4, 0x4b, 0x45, 0xf9, 0xed, 0x08, 0x5f, 0x89, 0xe3, 0x63, 0xef, 0x94,
+		0x65, 0x33, 0xf4, 0xbb, 0x29, 0xd8, 0xd2, 0x4c, 0xf3, 0x08, 0x53, 0x77, 0xe4, 0x56, 0xb2, 0x0f,
+		0xb3, 0x36, 0x8e, 0x42, 0x19, 0xcd, 0xea, 0xee, 0xfd, 0xc2, 0x36, 0xd2, 0xf5, 0x7e, 0x10, 0xdb,
+		0x98, 0xd2, 0x34, 0xde, 0xec, 0x1d, 0xc2, 0x31, 0x75, 0x43, 0x25, 0x7c, 0xf4, 0x9b, 0xfd, 0x09,
+		0xee, 0xb8, 0x0c, 0x3b, 0x66, 0x02, 0x2e, 0x6c, 0x2e, 0x9a, 0x25, 0x38, 0xab, 0x55, 0x84, 0xb7,
+		0xe1, 0x56, 0x01, 0x07, 0x32, 0xcf, 0xd5, 0x7f, 0x77, 0xe5, 0x6e, 0xc2, 0xec, 0x65, 0xca, 0xdd,
+		0x97, 0x80, 0x52, 0xbf, 0x56, 0x8b, 0x70, 0xec, 0x60, 0x8e, 0x95, 0xc0, 0xbb, 0x53, 0xf8, 0x81,
+		0x17, 0x0a, 0x6c, 0x96, 0x78, 0xdf, 0x48, 0xf5, 0x9f, 0x0b, 0xa9, 0x34, 0xee, 0x9d, 0xe3, 0xa5,
+		0x4a, 0xe3, 0x9b, 0xb0, 0xa4, 0x96, 0x50, 0x27, 0x56, 0x0c, 0x92, 0x09, 0x48, 0x86, 0x8e, 0x9d,
+		0x58, 0x3b, 0xa7, 0x00, 0xa1, 0x9d, 0x67, 0x0a, 0xb4, 0x73, 0x3a, 0x31, 0xa1, 0x9d, 0x71, 0xe6,
+		0x09, 0xed, 0xc2, 0x2c, 0xf5, 0xfc, 0x88, 0xab, 0x1d, 0xa8, 0xb8, 0x04, 0x25, 0x54, 0xa3, 0xd5,
+		0xe6, 0xfe, 0x57, 0xad, 0x36, 0x3f, 0x9e, 0x56, 0xab, 0xc3, 0x66, 0xe2, 0x2f, 0xee, 0x70, 0xb6,
+		0xcb, 0x42, 0x22, 0x1c, 0xb1, 0x88, 0x2b, 0xe5, 0xbc, 0x39, 0xe0, 0xeb, 0x50, 0x1d, 0x6f, 0xcd,
+		0x8d, 0xc4, 0xb6, 0xce, 0x0e, 0x62, 0xcb, 0xba, 0x34, 0x44, 0x5f, 0xc2, 0x86, 0xf8, 0xc8, 0xa0,
+		0xcb, 0xc5, 0x61, 0x2e, 0xaf, 0x08, 0xc3, 0x3e, 0x7f, 0x47, 0xb0, 0xde, 0x24, 0x38, 0xe0, 0x0d,
+		0x82, 0x79, 0xea, 0x0a, 0x86, 0xb9, 0x2a, 0xa5, 0x36, 0x89, 0x9f, 0xcc, 0xe9, 0x22, 0x96, 0xd9,
+		0xb3, 0xdd, 0xd3, 0xc5, 0x5b, 0xb8, 0xd1, 0x9b, 0x09, 0x8b, 0x9d, 0x5a, 0xbc, 0x49, 0x43, 0x2b,
+		0x31, 0x18, 0xae, 0xa2, 0x2b, 0x3d, 0x99, 0xf9, 0xf5, 0x69, 0xbd, 0x49, 0xc3, 0x3d, 0xe5, 0xff,
+		0x38, 0x3b, 0x83, 0xa4, 0x59, 0xad, 0x8c, 0x50, 0x29, 0xdd, 0x49, 0x1c, 0xaa, 0xae, 0x35, 0x70,
+		0xd8, 0x5b, 0x9d, 0xec, 0xb0, 0x77, 0x0f, 0xd6, 0x52, 0x3f, 0xaa, 0xfb, 0xac, 0xc9, 0x0e, 0x97,
+		0x0c, 0x1f, 0xca, 0x2e, 0xf4, 0x31, 0xcc, 0x35, 0x09, 0x76, 0x48, 0x20, 0x54, 0x74, 0x7c, 0x04,
+		0xd4, 0x1e, 0xa5, 0x04, 0xc4, 0x54, 0xd0, 0xff, 0xb3, 0xae, 0xae, 0x7e, 0x6b, 0xa4, 0xc2, 0x35,
+		0xdb, 0x5c, 0xc6, 0x15, 0xae, 0x9f, 0xc0, 0x9c, 0x54, 0x4a, 0xaa, 0xb1, 0x14, 0x73, 0xaf, 0xb0,
+		0x45, 0xad, 0xb4, 0x7a, 0x37, 0x15, 0x29, 0x39, 0x71, 0xa9, 0x1d, 0xe0, 0xf7, 0x53, 0x70, 0xaf,
+		0x08, 0xb8, 0xdf, 0x39, 0x3e, 0x1c, 0xb6, 0x1d, 0x5c, 0x56, 0x8b, 0xec, 0xb2, 0x36, 0x33, 0x21,
+		0x6b, 0xb3, 0x7d, 0xac, 0xfd, 0x18, 0xb6, 0x87, 0x93, 0xa1, 0x98, 0xfb, 0xb3, 0x91, 0xaa, 0x8c,
+		0x2c, 0x78, 0x2c, 0x95, 0xf1, 0x10, 0xe6, 0x4f, 0x31, 0x75, 0xa3, 0x80, 0x14, 0x26, 0xfe, 0x48,
+		0x62, 0xcc, 0x04, 0x5c, 0x98, 0xf9, 0xee, 0xc6, 0xaf, 0x0b, 0x4b, 0x05, 0xff, 0xcd, 0x94, 0xb6,
+		0x3e, 0x24, 0xea, 0xbb, 0x9c, 0xf3, 0x0c, 0x63, 0x33, 0x93, 0x32, 0xd6, 0x9f, 0xf5, 0x7b, 0x70,
+		0x67, 0x08, 0x17, 0x8a, 0xb5, 0xbf, 0x18, 0x50, 0xd5, 0xd5, 0x07, 0xf6, 0x6c, 0x32, 0x56, 0xd2,
+		0x93, 0x4e, 0x3b, 0x35, 0xa9, 0x2c, 0xec, 0x4f, 0xfa, 0x1d, 0x7d, 0x1b, 0x4a, 0x03, 0x53, 0x13,
+		0xf8, 0xc3, 0x14, 0xdc, 0x2d, 0xc0, 0x7d, 0xc7, 0x13, 0x9f, 0xb0, 0x36, 0x33, 0x29, 0x6b, 0xfd,
+		0x89, 0xff, 0x48, 0xdf, 0xfb, 0x7a, 0xd8, 0xe8, 0x49, 0xbd, 0xcd, 0x82, 0x1e, 0xe8, 0xf3, 0x64,
+		0x13, 0xbc, 0xc4, 0xd4, 0x9f, 0xc4, 0xa9, 0x2f, 0x08, 0x4c, 0xc9, 0xdc, 0x8f, 0xa0, 0x64, 0x8b,
+		0x89, 0x59, 0x81, 0x8c, 0x95, 0x38, 0x22, 0xbe, 0x05, 0x73, 0x4d, 0x8e, 0x9b, 0xc9, 0xb0, 0xaa,
+		0x92, 0x5c, 0x97, 0xdf, 0xb7, 0x2a, 0xa9, 0xc7, 0x55, 0x32, 0x84, 0x8d, 0xf1, 0x49, 0xfe, 0x57,
+		0x77, 0xfb, 0x10, 0x17, 0x0d, 0x93, 0xc8, 0x86, 0x5f, 0xf6, 0xc9, 0x86, 0xd1, 0xef, 0x33, 0x92,
+		0xcd, 0xf0, 0x35, 0x5c, 0x91, 0xff, 0x47, 0xb2, 0x2e, 0x48, 0x20, 0x6e, 0x2a, 0xa8, 0x77, 0xca,
+		0xd4, 0x71, 0x35, 0x3f, 0x51, 0x24, 0x78, 0x2d, 0xe1, 0xe2, 0xe8, 0xbd, 0xde, 0xee, 0x1f, 0xca,
+		0x6c, 0x42, 0xba, 0xc9, 0x25, 0xda, 0xc3, 0x80, 0x8a, 0x49, 0x42, 0xc2, 0xe5, 0x05, 0x58, 0x7a,
+		0x58, 0xbc, 0x94, 0xda, 0xaa, 0x5e, 0x87, 0xab, 0xda, 0x60, 0x54, 0xb0, 0x01, 0xac, 0xf6, 0x6a,
+		0x41, 0x74, 0x1f, 0x10, 0xf1, 0x70, 0xc3, 0x25, 0x56, 0x46, 0x51, 0xaa, 0x74, 0x97, 0xe4, 0x9b,
+		0xae, 0x05, 0xda, 0x85, 0x0d, 0x9f, 0xb9, 0x2e, 0x09, 0xac, 0x36, 0xa6, 0xf2, 0xb4, 0x60, 0x51,
+		0xcf, 0x6a, 0xc9, 0x4e, 0x30, 0x6d, 0x22, 0xf9, 0xf6, 0x0d, 0xa6, 0xe2, 0x58, 0x70, 0xec, 0xbd,
+		0x08, 0x77, 0xff, 0xb3, 0x06, 0x8b, 0x92, 0xee, 0xbd, 0x93, 0x63, 0xf4, 0x1e, 0xae, 0x68, 0x6e,
+		0x89, 0xd0, 0xce, 0xe8, 0xf7, 0x49, 0x82, 0xd7, 0xca, 0xd8, 0x17, 0x50, 0xe8, 0x4f, 0x06, 0x5c,
+		0x2b, 0xba, 0x37, 0x42, 0x8f, 0x26, 0xbd, 0xd8, 0xab, 0x7c, 0x36, 0xf1, 0x25, 0x15, 0xfa, 0xc6,
+		0x80, 0xcd, 0xdc, 0x2b, 0x0e, 0xf4, 0xf3, 0x51, 0x1d, 0xf7, 0x08, 0xb6, 0xca, 0xc3, 0x71, 0xcd,
+		0x54, 0x30, 0xdd, 0xe4, 0x64, 0xbb, 0x44, 0x71, 0x72, 0x34, 0x57, 0x2e, 0xc5, 0xc9, 0xd1, 0xde,
+		0x5f, 0x64, 0x92, 0xa3, 0x15, 0xad, 0xc5, 0xc9, 0x29, 0x3a, 0xb5, 0x14, 0x27, 0xa7, 0xf0, 0x5c,
+		0x81, 0x3e, 0xe8, 0xd5, 0x71, 0x8f, 0x94, 0x46, 0x9f, 0x8f, 0xed, 0x3f, 0xb3, 0xf7, 0x54, 0x9e,
+		0x4e, 0x68, 0x3d, 0x58, 0x3e, 0x83, 0xb2, 0xaf, 0xb8, 0x7c, 0x72, 0xf5, 0x7e, 0x71, 0xf9, 0xe4,
+		0xeb, 0x71, 0xf4, 0xad, 0x01, 0xd7, 0x0b, 0x35, 0x28, 0xfa, 0x6c, 0x3c, 0xcf, 0x59, 0xa2, 0x1e,
+		0x4f, 0x62, 0xaa, 0x02, 0xfb, 0xa3, 0x21, 0xda, 0x62, 0x9e, 0x46, 0x42, 0x9f, 0x8e, 0x9c, 0x84,
+		0x5e, 0x91, 0x5c, 0x79, 0x34, 0xbe, 0xa1, 0x0a, 0xe9, 0xaf, 0x06, 0xdc, 0x1c, 0x22, 0xdb, 0xd0,
+		0x93, 0x71, 0xbd, 0x67, 0xf9, 0xfa, 0x7c, 0x32, 0xe3, 0x1e, 0xc6, 0x72, 0xf5, 0x42, 0x2e, 0x63,
+		0xc3, 0xb4, 0x65, 0x2e, 0x63, 0xc3, 0xb5, 0x9f, 0x64, 0xac, 0x50, 0xc2, 0xe4, 0x32, 0x36, 0x8a,
+		0x0c, 0xcc, 0x65, 0x6c, 0x34, 0xd5, 0x94, 0x59, 0x89, 0x83, 0x6a, 0xa1, 0x78, 0x25, 0xe6, 0x4a,
+		0xa7, 0xe2, 0x95, 0x98, 0x2f, 0x4a, 0xe2, 0x46, 0xae, 0x91, 0x01, 0x39, 0x8d, 0x3c, 0x5f, 0xbd,
+		0xe4, 0x34, 0xf2, 0x02, 0x85, 0xb1, 0xdf, 0x80, 0x1f, 0xda, 0xac, 0xa5, 0x33, 0xdb, 0x47, 0x52,
+		0x05, 0xbc, 0x94, 0x3f, 0xfd, 0x39, 0x09, 0x18, 0x67, 0x27, 0xc6, 0xd7, 0x0f, 0xce, 0x28, 0x6f,
+		0x46, 0x8d, 0x9a, 0xcd, 0x5a, 0x3b, 0xd9, 0xdf, 0xb6, 0xfc, 0x94, 0x3a, 0xee, 0xce, 0x19, 0x93,
+		0x3f, 0xdb, 0x51, 0x3f, 0x74, 0x79, 0x82, 0x7d, 0x7a, 0xf1, 0xa0, 0x31, 0x27, 0xc6, 0x3e, 0xfe,
+		0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xa8, 0x5a, 0x14, 0x5a, 0x24, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/decision.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdf, 0x6e, 0xd4, 0xc6,
-		0x17, 0xfe, 0x39, 0x7f, 0x36, 0xbb, 0x27, 0x1b, 0x20, 0x13, 0x08, 0x09, 0x04, 0x12, 0xf6, 0xa7,
-		0x42, 0x21, 0xca, 0x6e, 0x12, 0x28, 0x42, 0x80, 0x50, 0xc9, 0x42, 0x44, 0x24, 0x08, 0x91, 0x13,
-		0x40, 0xaa, 0x54, 0x59, 0x93, 0xf1, 0x24, 0x99, 0xc6, 0xeb, 0xd9, 0x8e, 0xc7, 0x09, 0x5b, 0xa9,
-		0x12, 0x57, 0x6d, 0x6f, 0xfa, 0x00, 0x95, 0x7a, 0xd5, 0x9b, 0xb6, 0x37, 0xed, 0x6d, 0xab, 0x5e,
-		0xf5, 0x11, 0xfa, 0x2c, 0x7d, 0x81, 0xca, 0xe3, 0xb1, 0x77, 0xb3, 0xf1, 0x7a, 0xed, 0x40, 0xb9,
-		0xe8, 0x5d, 0x3c, 0x3e, 0xe7, 0x9b, 0xcf, 0x73, 0xce, 0x7e, 0xe7, 0xb3, 0x03, 0x15, 0x7f, 0x9b,
-		0x8a, 0x1a, 0xc1, 0x36, 0x75, 0x09, 0xad, 0xe1, 0x26, 0xab, 0x1d, 0x2c, 0xd5, 0x6c, 0x4a, 0x98,
-		0xc7, 0xb8, 0x5b, 0x6d, 0x0a, 0x2e, 0x39, 0x9a, 0x08, 0x62, 0xaa, 0x3a, 0xa6, 0x8a, 0x9b, 0xac,
-		0x7a, 0xb0, 0x74, 0xe1, 0xf2, 0x2e, 0xe7, 0xbb, 0x0e, 0xad, 0xa9, 0x90, 0x6d, 0x7f, 0xa7, 0x66,
-		0xfb, 0x02, 0xcb, 0x38, 0xe9, 0xc2, 0x5c, 0x12, 0x30, 0xe1, 0x8d, 0x46, 0x1c, 0x91, 0xb8, 0xb5,
-		0xc4, 0xde, 0xbe, 0xc3, 0x3c, 0x99, 0x16, 0x73, 0xc8, 0xc5, 0xfe, 0x8e, 0xc3, 0x0f, 0xc3, 0x98,
-		0xca, 0x37, 0xe3, 0x50, 0x7c, 0xa4, 0x19, 0xa3, 0xef, 0x0c, 0xb8, 0xe1, 0x91, 0x3d, 0x6a, 0xfb,
-		0x0e, 0xb5, 0x30, 0x91, 0xec, 0x80, 0xc9, 0x96, 0x15, 0xa0, 0x5a, 0xd1, 0x53, 0x59, 0x58, 0x4a,
-		0xc1, 0xb6, 0x7d, 0x49, 0xbd, 0x29, 0x63, 0xce, 0xf8, 0x70, 0x74, 0xf9, 0x5e, 0x35, 0xe1, 0x09,
-		0xab, 0x9b, 0x1a, 0xe6, 0xa1, 0x46, 0xd9, 0xc2, 0xde, 0x7e, 0xb4, 0xcf, 0xc3, 0x18, 0xe2, 0xc9,
-		0xff, 0xcc, 0xab, 0x5e, 0xa6, 0x48, 0xf4, 0x05, 0xcc, 0x7a, 0x12, 0x0b, 0x69, 0x49, 0xd6, 0xa0,
-		0x22, 0x91, 0xcf, 0x80, 0xe2, 0xb3, 0x94, 0xcc, 0x27, 0xc8, 0xdd, 0x0a, 0x52, 0x13, 0x59, 0xcc,
-		0x78, 0x29, 0xf7, 0xd1, 0x4f, 0x06, 0x04, 0xa7, 0xdf, 0x74, 0xa8, 0xa4, 0x56, 0x74, 0x80, 0x16,
-		0x7d, 0x4d, 0x89, 0x1f, 0x14, 0x2d, 0x91, 0xcc, 0xa0, 0x22, 0xf3, 0x71, 0x22, 0x99, 0xba, 0xc6,
-		0x7a, 0xa5, 0xa1, 0x1e, 0x47, 0x48, 0x89, 0xdc, 0xe6, 0x49, 0xf6, 0x70, 0xf4, 0xbd, 0x01, 0xf3,
-		0x3b, 0x98, 0x39, 0x59, 0x69, 0x0e, 0x29, 0x9a, 0xf7, 0x13, 0x69, 0xae, 0x62, 0xe6, 0x64, 0xa3,
-		0x78, 0x6d, 0x27, 0x5b, 0x28, 0xfa, 0xd9, 0x80, 0x45, 0x41, 0x3f, 0xf7, 0xa9, 0x27, 0x2d, 0x82,
-		0x5d, 0x42, 0x9d, 0x0c, 0x7d, 0x36, 0x9c, 0x72, 0x94, 0x66, 0x08, 0x56, 0x57, 0x58, 0x7d, 0x9b,
-		0x6d, 0x5e, 0x64, 0x0f, 0x47, 0x5f, 0xc2, 0x9c, 0xa6, 0xd8, 0xbb, 0xe5, 0x0a, 0x8a, 0xda, 0x72,
-		0x72, 0x95, 0x55, 0x72, 0xef, 0x9e, 0xbb, 0x44, 0xd2, 0x02, 0xd0, 0x0f, 0x06, 0x2c, 0xe8, 0xfd,
-		0x33, 0xd6, 0x72, 0x44, 0x91, 0x79, 0x90, 0x42, 0x26, 0x5b, 0x35, 0xaf, 0x93, 0xac, 0xc1, 0xe8,
-		0x2f, 0x03, 0x1e, 0x74, 0xd5, 0x93, 0xbe, 0x96, 0x54, 0xb8, 0x38, 0x33, 0xeb, 0xa2, 0x62, 0xfd,
-		0xac, 0x7f, 0x75, 0x1f, 0x6b, 0xe0, 0x6c, 0x0f, 0x71, 0x47, 0x9c, 0x30, 0x17, 0xbd, 0x31, 0xe0,
-		0x8a, 0xa0, 0x84, 0x0b, 0xdb, 0x6a, 0x60, 0xb1, 0xdf, 0xa3, 0xf2, 0x25, 0x45, 0xfb, 0x66, 0x0f,
-		0xda, 0x41, 0xf6, 0x33, 0x95, 0x9c, 0x48, 0xee, 0xb2, 0x48, 0x8d, 0x40, 0xbf, 0x1b, 0x70, 0x9b,
-		0x70, 0x57, 0x32, 0xd7, 0xa7, 0x16, 0xf6, 0x2c, 0x97, 0x1e, 0x66, 0x3d, 0x4e, 0x50, 0xbc, 0x1e,
-		0xf7, 0xd0, 0x9d, 0x10, 0xf2, 0xa1, 0xb7, 0x4e, 0x0f, 0xb3, 0x1d, 0xe3, 0x22, 0xc9, 0x99, 0x83,
-		0x7e, 0x35, 0x60, 0x39, 0x54, 0x6a, 0xb2, 0xc7, 0x1c, 0x3b, 0x2b, 0xef, 0x51, 0xc5, 0x7b, 0xa5,
-		0xb7, 0x78, 0xd7, 0x03, 0xb4, 0x6c, 0xa4, 0x17, 0xbc, 0x3c, 0x09, 0xe8, 0x0f, 0x03, 0x6e, 0x7b,
-		0x6c, 0x37, 0xe8, 0xd9, 0xbc, 0xcd, 0x5b, 0x56, 0xac, 0x57, 0x93, 0x59, 0x2b, 0xc8, 0x7c, 0x5d,
-		0xbb, 0xe4, 0xe5, 0x4d, 0x42, 0xbf, 0x19, 0xf0, 0x91, 0xdf, 0xf4, 0xa8, 0x90, 0x6d, 0xd2, 0x1e,
-		0xc5, 0x82, 0xec, 0x75, 0x10, 0x4d, 0x24, 0x3f, 0x96, 0xd2, 0x2a, 0x2f, 0x14, 0x62, 0xb4, 0xff,
-		0xa6, 0xc2, 0x6b, 0x6f, 0x9a, 0xdc, 0x2a, 0x7e, 0xce, 0x9c, 0x95, 0x32, 0x40, 0x9b, 0x4e, 0xe5,
-		0xdb, 0x02, 0x5c, 0xcd, 0x66, 0x1b, 0xd0, 0x2c, 0x8c, 0xc6, 0x63, 0x83, 0xd9, 0xca, 0x88, 0x94,
-		0x4c, 0x88, 0x96, 0xd6, 0x6c, 0xb4, 0x0a, 0x63, 0xed, 0xb9, 0xd2, 0x6a, 0x52, 0xed, 0x0d, 0xae,
-		0x24, 0x3e, 0x6b, 0xbc, 0x59, 0xab, 0x49, 0xcd, 0x32, 0xee, 0xb8, 0x42, 0x93, 0x50, 0xb0, 0x79,
-		0x03, 0x33, 0x57, 0xcd, 0xf3, 0x92, 0xa9, 0xaf, 0xd0, 0x5d, 0x28, 0xa9, 0x71, 0x15, 0xb8, 0x2d,
-		0x3d, 0x43, 0x2f, 0x25, 0x62, 0x07, 0x0f, 0xf0, 0x94, 0x79, 0xd2, 0x2c, 0x4a, 0xfd, 0x17, 0x5a,
-		0x86, 0x61, 0xe6, 0x36, 0x7d, 0xa9, 0xe7, 0xda, 0x4c, 0x62, 0xde, 0x06, 0x6e, 0x39, 0x1c, 0xdb,
-		0x66, 0x18, 0x8a, 0xb6, 0x60, 0x3a, 0x36, 0x66, 0x92, 0x5b, 0xc4, 0xe1, 0x1e, 0x55, 0x63, 0x89,
-		0xfb, 0x52, 0x0f, 0xa1, 0xe9, 0x6a, 0x68, 0x2a, 0xab, 0x91, 0xa9, 0xac, 0x3e, 0xd2, 0xa6, 0xd2,
-		0x9c, 0x8c, 0x72, 0xb7, 0x78, 0x3d, 0xc8, 0xdc, 0x0a, 0x13, 0xbb, 0x51, 0xdb, 0xfe, 0x2a, 0x40,
-		0x1d, 0xc9, 0x81, 0x1a, 0xbb, 0xab, 0x00, 0x75, 0x1d, 0x26, 0x35, 0x52, 0x37, 0xd1, 0x62, 0x3f,
-		0xc8, 0x89, 0xd0, 0x86, 0x1d, 0x65, 0xb9, 0x0a, 0xe3, 0x7b, 0x14, 0x0b, 0xb9, 0x4d, 0x71, 0x9b,
-		0x5d, 0xa9, 0x1f, 0xd4, 0x99, 0x38, 0x27, 0xc2, 0xa9, 0x43, 0x59, 0x50, 0x29, 0x5a, 0x56, 0x93,
-		0x3b, 0x8c, 0xb4, 0xb4, 0xe2, 0xcc, 0xf5, 0x50, 0x70, 0x29, 0x5a, 0x1b, 0x2a, 0xce, 0x1c, 0x15,
-		0xed, 0x0b, 0x74, 0x13, 0x0a, 0x7b, 0x14, 0xdb, 0x54, 0xe8, 0x9f, 0xfe, 0xc5, 0xc4, 0xf4, 0x27,
-		0x2a, 0xc4, 0xd4, 0xa1, 0xe8, 0x16, 0x4c, 0x46, 0x43, 0xd2, 0xe1, 0x04, 0x3b, 0x96, 0xcd, 0xbc,
-		0x26, 0x96, 0x64, 0x4f, 0xfd, 0x04, 0x8b, 0xe6, 0x59, 0x7d, 0xf7, 0x69, 0x70, 0xf3, 0x91, 0xbe,
-		0x57, 0xf9, 0xda, 0x80, 0x99, 0x34, 0xdb, 0x8a, 0xa6, 0xa1, 0x18, 0x3a, 0x93, 0xf8, 0x27, 0x30,
-		0xa2, 0xae, 0xd7, 0x6c, 0xf4, 0x14, 0xce, 0xc5, 0x35, 0xd8, 0x61, 0xa2, 0x5d, 0x82, 0x81, 0x7e,
-		0xe7, 0x86, 0x74, 0x09, 0x56, 0x99, 0x88, 0x2a, 0x50, 0x21, 0x30, 0x9f, 0xc3, 0xb2, 0xa2, 0x5b,
-		0x50, 0x10, 0xd4, 0xf3, 0x1d, 0xa9, 0xdf, 0x10, 0xd2, 0x3b, 0x5c, 0xc7, 0x56, 0x30, 0x5c, 0xcb,
-		0x68, 0x38, 0xd1, 0x6d, 0x18, 0x09, 0x0c, 0xa7, 0x2f, 0x68, 0xea, 0x0e, 0xab, 0x61, 0x8c, 0x19,
-		0x05, 0x57, 0xd6, 0x61, 0x3e, 0x87, 0x5f, 0xec, 0xab, 0x32, 0x95, 0xbb, 0x70, 0x29, 0xd5, 0xe4,
-		0xa5, 0x54, 0xa8, 0x42, 0xe0, 0x7a, 0x66, 0x4f, 0x16, 0x3c, 0xb0, 0x4d, 0x25, 0x66, 0x8e, 0x97,
-		0xe9, 0x48, 0xa3, 0xe0, 0xca, 0xdf, 0x06, 0xdc, 0x39, 0xa9, 0x87, 0xea, 0xd0, 0x3e, 0xe3, 0x88,
-		0xf6, 0xbd, 0x00, 0x74, 0x7c, 0x3a, 0xea, 0xc6, 0xba, 0x9a, 0xc8, 0xeb, 0xd8, 0x6e, 0xe6, 0xf8,
-		0x61, 0xf7, 0x12, 0x9a, 0x82, 0x91, 0xc0, 0x6b, 0x08, 0xee, 0x28, 0xad, 0x2d, 0x9b, 0xd1, 0x25,
-		0xaa, 0xc2, 0x44, 0x97, 0x95, 0xe0, 0xae, 0xd3, 0x52, 0xb2, 0x5b, 0x34, 0xc7, 0x49, 0xe7, 0x98,
-		0x7f, 0xee, 0x3a, 0xad, 0xca, 0x2f, 0x06, 0x5c, 0x4e, 0xb7, 0x60, 0x41, 0x69, 0xb5, 0xb7, 0x73,
-		0x71, 0x83, 0x46, 0xa5, 0x0d, 0x97, 0xd6, 0x71, 0x83, 0x76, 0x9e, 0xf8, 0x40, 0x8e, 0x13, 0xef,
-		0xd0, 0x87, 0xc1, 0xcc, 0xfa, 0x50, 0x79, 0x03, 0xb0, 0x98, 0xd7, 0x9b, 0x05, 0x23, 0x2e, 0x3e,
-		0x0f, 0x35, 0xe2, 0x8c, 0x94, 0x11, 0x17, 0x01, 0x86, 0x23, 0xee, 0xb0, 0xe3, 0xea, 0xe8, 0x28,
-		0x1b, 0x38, 0xe1, 0x28, 0x1b, 0xcc, 0x3e, 0xca, 0x30, 0xcc, 0xb5, 0x3d, 0x55, 0x8f, 0x41, 0x31,
-		0xd4, 0x4f, 0xa5, 0x66, 0x62, 0x88, 0xcd, 0x84, 0x89, 0xf1, 0x0a, 0x2e, 0xaa, 0x47, 0xea, 0x81,
-		0x3e, 0xdc, 0x0f, 0xfd, 0x7c, 0x90, 0x9d, 0x04, 0xfc, 0x1c, 0x26, 0xb7, 0x31, 0xd9, 0xe7, 0x3b,
-		0x3b, 0x1a, 0x9b, 0xb9, 0x92, 0x8a, 0x03, 0xec, 0xf4, 0x9f, 0xc1, 0x67, 0x75, 0xa2, 0x82, 0x5d,
-		0xd3, 0x69, 0xc7, 0x66, 0xd2, 0xc8, 0x49, 0x66, 0xd2, 0x1a, 0x94, 0x98, 0xcb, 0x24, 0xc3, 0x92,
-		0x0b, 0x35, 0x63, 0x4f, 0x2d, 0xcf, 0xf7, 0xf7, 0xff, 0x6b, 0x51, 0x8a, 0xd9, 0xce, 0xee, 0x54,
-		0xd6, 0x52, 0x0e, 0x65, 0x45, 0x26, 0x4c, 0x3a, 0x38, 0x78, 0x07, 0x0c, 0xc7, 0x44, 0x50, 0x5a,
-		0x3d, 0x02, 0x20, 0x43, 0x67, 0x9c, 0x0d, 0x72, 0xeb, 0x71, 0xaa, 0xa9, 0x32, 0xd1, 0xff, 0x61,
-		0x8c, 0x88, 0xa0, 0x47, 0xb4, 0xcd, 0x50, 0x03, 0xbb, 0x64, 0x96, 0x83, 0xc5, 0xc8, 0x27, 0x9e,
-		0x6c, 0x1e, 0x2f, 0xc0, 0x50, 0x83, 0x36, 0xb8, 0x36, 0xc0, 0xd3, 0x89, 0x29, 0xcf, 0x68, 0x83,
-		0x9b, 0x2a, 0x0c, 0x99, 0x30, 0x7e, 0xcc, 0x50, 0x4f, 0x9d, 0x52, 0xb9, 0x1f, 0x24, 0x3b, 0xff,
-		0x2e, 0xeb, 0x6b, 0x9e, 0xf1, 0xba, 0x56, 0xd0, 0x7d, 0x28, 0x7f, 0xc6, 0xa4, 0xa4, 0x22, 0x6c,
-		0xa4, 0xa9, 0xd3, 0xfd, 0xfa, 0x67, 0x34, 0x0c, 0x57, 0xed, 0x83, 0x5e, 0xc2, 0x84, 0x3a, 0x1a,
-		0x7e, 0x40, 0x85, 0x83, 0x9b, 0x51, 0xf7, 0x9c, 0x51, 0xb5, 0x4f, 0xd6, 0xe0, 0xba, 0xe0, 0xee,
-		0xf3, 0x30, 0x5c, 0xf7, 0xd0, 0x38, 0xe9, 0x5e, 0x42, 0xaf, 0x61, 0x56, 0x8d, 0x37, 0x6a, 0x11,
-		0xc7, 0xf7, 0x14, 0x3b, 0xea, 0x50, 0xa2, 0xea, 0xa9, 0xf7, 0x18, 0x4f, 0xf9, 0xc8, 0xa6, 0xe6,
-		0x29, 0xad, 0x87, 0xa9, 0x9b, 0x51, 0xa6, 0xde, 0x6e, 0x06, 0xa7, 0xdc, 0xad, 0xfc, 0x58, 0x82,
-		0x85, 0x5c, 0xaf, 0x79, 0x3d, 0xc7, 0xd3, 0x2c, 0x8c, 0xc6, 0xba, 0xc8, 0x6c, 0xa5, 0x68, 0x25,
-		0x13, 0xa2, 0xa5, 0xf0, 0xdd, 0xe0, 0xa8, 0x70, 0x0e, 0xbe, 0x03, 0xe1, 0x7c, 0x0f, 0xef, 0x00,
-		0x59, 0x84, 0xb3, 0xf0, 0xaf, 0x0a, 0xe7, 0xc8, 0x89, 0x85, 0xf3, 0x25, 0x4c, 0x34, 0xb1, 0xa0,
-		0xae, 0xd4, 0x88, 0xba, 0x99, 0x8a, 0x29, 0x0d, 0xbb, 0xa1, 0xe2, 0x15, 0x4a, 0xd4, 0xb0, 0xcd,
-		0xee, 0xa5, 0x4e, 0xd3, 0x50, 0x3a, 0x6a, 0x1a, 0x08, 0x4c, 0x75, 0xb4, 0x81, 0x25, 0xa8, 0xdf,
-		0xde, 0x16, 0xd4, 0xb6, 0x37, 0x52, 0x0b, 0xbe, 0x66, 0x9b, 0x41, 0x8a, 0xde, 0xfa, 0xdc, 0x61,
-		0xd2, 0xf2, 0xbb, 0x79, 0xa5, 0x38, 0xa6, 0x73, 0xe5, 0x54, 0x9d, 0x1b, 0xcb, 0xaf, 0x73, 0xa7,
-		0xde, 0x42, 0xe7, 0x4e, 0xbf, 0x9d, 0xce, 0xfd, 0xf7, 0x94, 0xea, 0xcf, 0x01, 0x58, 0xca, 0xfd,
-		0x69, 0xe7, 0x7d, 0x9b, 0xe9, 0x59, 0x18, 0xd5, 0x5f, 0xb4, 0x94, 0xbf, 0x0d, 0x3f, 0x5e, 0x40,
-		0xb8, 0xa4, 0xfc, 0x6d, 0x2c, 0x40, 0x43, 0xd9, 0x05, 0xa8, 0xe3, 0xc7, 0x36, 0x9c, 0xc9, 0xa1,
-		0x17, 0x7a, 0x39, 0xf4, 0xaf, 0x0c, 0x58, 0xcc, 0xfb, 0x85, 0x29, 0xb9, 0x3d, 0x8d, 0xb7, 0x6a,
-		0xcf, 0x95, 0x4f, 0xe1, 0x3c, 0xe1, 0x8d, 0xa4, 0xec, 0x95, 0xb1, 0x88, 0xc2, 0x46, 0x20, 0x73,
-		0x1b, 0xc6, 0x27, 0x4b, 0xbb, 0x4c, 0xee, 0xf9, 0xdb, 0x55, 0xc2, 0x1b, 0xb5, 0xce, 0xff, 0xac,
-		0x2d, 0x30, 0xdb, 0xa9, 0xed, 0xf2, 0xf0, 0x9f, 0x79, 0xfa, 0xdf, 0x6c, 0xf7, 0x70, 0x93, 0x1d,
-		0x2c, 0x6d, 0x17, 0xd4, 0xda, 0xcd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x34, 0x7d, 0x59,
-		0x29, 0x1c, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdf, 0x4e, 0xdc, 0xce,
+		0x15, 0xae, 0xf9, 0xb3, 0xec, 0x1e, 0x16, 0x7e, 0x30, 0x10, 0x12, 0x12, 0x12, 0xc8, 0x56, 0x25,
+		0x7f, 0x28, 0xbb, 0x40, 0xd2, 0x28, 0x4a, 0xd2, 0xa8, 0xb0, 0x09, 0x0a, 0x52, 0x42, 0x90, 0x21,
+		0x89, 0x54, 0xa9, 0xb2, 0x86, 0xf1, 0x00, 0x13, 0xbc, 0xb6, 0x3b, 0x1e, 0x43, 0xb6, 0x52, 0xa5,
+		0x5c, 0xb5, 0xcf, 0xd0, 0xaa, 0x6a, 0xa5, 0xde, 0xb4, 0xbd, 0x69, 0xaf, 0x2a, 0xb5, 0xea, 0x55,
+		0x1f, 0xa1, 0x17, 0x7d, 0x81, 0xbe, 0x42, 0x5f, 0xa0, 0xf2, 0x78, 0xbc, 0xbb, 0x2c, 0x5e, 0xef,
+		0x18, 0xd2, 0x5c, 0xfc, 0xee, 0xf0, 0xf8, 0x9c, 0x6f, 0x3e, 0xcf, 0x39, 0x7b, 0xce, 0x77, 0x06,
+		0xa8, 0x84, 0xfb, 0x94, 0xd7, 0x08, 0xb6, 0xa9, 0x4b, 0x68, 0x0d, 0xfb, 0xac, 0x76, 0xb2, 0x5a,
+		0xb3, 0x29, 0x61, 0x01, 0xf3, 0xdc, 0xaa, 0xcf, 0x3d, 0xe1, 0xa1, 0xa9, 0xc8, 0xa6, 0xaa, 0x6c,
+		0xaa, 0xd8, 0x67, 0xd5, 0x93, 0xd5, 0xeb, 0xb7, 0x0e, 0x3d, 0xef, 0xd0, 0xa1, 0x35, 0x69, 0xb2,
+		0x1f, 0x1e, 0xd4, 0xec, 0x90, 0x63, 0xd1, 0x72, 0xba, 0xbe, 0x90, 0x06, 0x4c, 0xbc, 0x46, 0xa3,
+		0x65, 0x91, 0xba, 0xb5, 0xc0, 0xc1, 0xb1, 0xc3, 0x02, 0x91, 0x65, 0x73, 0xea, 0xf1, 0xe3, 0x03,
+		0xc7, 0x3b, 0x8d, 0x6d, 0x2a, 0xff, 0x99, 0x86, 0xe2, 0x0b, 0xc5, 0x18, 0xfd, 0xca, 0x80, 0xfb,
+		0x01, 0x39, 0xa2, 0x76, 0xe8, 0x50, 0x0b, 0x13, 0xc1, 0x4e, 0x98, 0x68, 0x5a, 0x11, 0xaa, 0x95,
+		0x7c, 0x95, 0x85, 0x85, 0xe0, 0x6c, 0x3f, 0x14, 0x34, 0xb8, 0x66, 0x2c, 0x18, 0x77, 0x47, 0xd7,
+		0x9e, 0x56, 0x53, 0xbe, 0xb0, 0xba, 0xab, 0x60, 0xd6, 0x15, 0xca, 0x1e, 0x0e, 0x8e, 0x93, 0x7d,
+		0xd6, 0x5b, 0x10, 0xaf, 0xbe, 0x63, 0x2e, 0x06, 0x5a, 0x96, 0xe8, 0x67, 0x30, 0x1f, 0x08, 0xcc,
+		0x85, 0x25, 0x58, 0x83, 0xf2, 0x54, 0x3e, 0x03, 0x92, 0xcf, 0x6a, 0x3a, 0x9f, 0xc8, 0x77, 0x2f,
+		0x72, 0x4d, 0x65, 0x31, 0x17, 0x64, 0xbc, 0x47, 0x7f, 0x34, 0x20, 0x3a, 0x7d, 0xdf, 0xa1, 0x82,
+		0x5a, 0xc9, 0x01, 0x5a, 0xf4, 0x13, 0x25, 0x61, 0x14, 0xb4, 0x54, 0x32, 0x83, 0x92, 0xcc, 0x8f,
+		0x52, 0xc9, 0xd4, 0x15, 0xd6, 0x07, 0x05, 0xf5, 0x32, 0x41, 0x4a, 0xe5, 0xb6, 0x44, 0xf4, 0xcd,
+		0xd1, 0x6f, 0x0c, 0x58, 0x3a, 0xc0, 0xcc, 0xd1, 0xa5, 0x39, 0x24, 0x69, 0x3e, 0x4b, 0xa5, 0xb9,
+		0x89, 0x99, 0xa3, 0x47, 0xf1, 0xce, 0x81, 0x9e, 0x29, 0xfa, 0x93, 0x01, 0x2b, 0x9c, 0xfe, 0x34,
+		0xa4, 0x81, 0xb0, 0x08, 0x76, 0x09, 0x75, 0x34, 0xf2, 0x6c, 0x38, 0xe3, 0x28, 0xcd, 0x18, 0xac,
+		0x2e, 0xb1, 0xfa, 0x26, 0xdb, 0x12, 0xd7, 0x37, 0x47, 0x3f, 0x87, 0x05, 0x45, 0xb1, 0x77, 0xca,
+		0x15, 0x24, 0xb5, 0xb5, 0xf4, 0x28, 0x4b, 0xe7, 0xde, 0x39, 0x77, 0x93, 0x64, 0x19, 0xa0, 0xdf,
+		0x1b, 0xb0, 0xac, 0xf6, 0xd7, 0x8c, 0xe5, 0x88, 0x24, 0xf3, 0x3c, 0x83, 0x8c, 0x5e, 0x34, 0xef,
+		0x11, 0x5d, 0x63, 0xf4, 0x2f, 0x03, 0x9e, 0x77, 0xc5, 0x93, 0x7e, 0x12, 0x94, 0xbb, 0x58, 0x9b,
+		0x75, 0x51, 0xb2, 0x7e, 0xd3, 0x3f, 0xba, 0x2f, 0x15, 0xb0, 0xde, 0x47, 0x3c, 0xe6, 0x17, 0xf4,
+		0x45, 0x9f, 0x0d, 0xb8, 0xcd, 0x29, 0xf1, 0xb8, 0x6d, 0x35, 0x30, 0x3f, 0xee, 0x11, 0xf9, 0x92,
+		0xa4, 0xfd, 0xa0, 0x07, 0xed, 0xc8, 0xfb, 0x8d, 0x74, 0x4e, 0x25, 0x77, 0x8b, 0x67, 0x5a, 0xa0,
+		0xbf, 0x1b, 0xf0, 0x88, 0x78, 0xae, 0x60, 0x6e, 0x48, 0x2d, 0x1c, 0x58, 0x2e, 0x3d, 0xd5, 0x3d,
+		0x4e, 0x90, 0xbc, 0x5e, 0xf6, 0xa8, 0x3b, 0x31, 0xe4, 0x7a, 0xb0, 0x4d, 0x4f, 0xf5, 0x8e, 0x71,
+		0x85, 0xe4, 0xf4, 0x41, 0x7f, 0x31, 0x60, 0x2d, 0xae, 0xd4, 0xe4, 0x88, 0x39, 0xb6, 0x2e, 0xef,
+		0x51, 0xc9, 0x7b, 0xa3, 0x77, 0xf1, 0xae, 0x47, 0x68, 0x7a, 0xa4, 0x97, 0x83, 0x3c, 0x0e, 0xe8,
+		0x1f, 0x06, 0x3c, 0x0a, 0xd8, 0x61, 0x94, 0xb3, 0x79, 0x93, 0xb7, 0x2c, 0x59, 0x6f, 0xa6, 0xb3,
+		0x96, 0x90, 0xf9, 0xb2, 0x76, 0x35, 0xc8, 0xeb, 0x84, 0xfe, 0x66, 0xc0, 0x0f, 0x42, 0x3f, 0xa0,
+		0x5c, 0xb4, 0x49, 0x07, 0x14, 0x73, 0x72, 0xd4, 0x41, 0x34, 0x95, 0xfc, 0x58, 0x46, 0xaa, 0xbc,
+		0x93, 0x88, 0xc9, 0xfe, 0xbb, 0x12, 0xaf, 0xbd, 0x69, 0x7a, 0xaa, 0x84, 0x39, 0x7d, 0xa4, 0xde,
+		0xc0, 0x84, 0x50, 0xbf, 0x83, 0x7a, 0xe8, 0xdb, 0x58, 0xd0, 0x54, 0xbe, 0xe3, 0x19, 0x7a, 0x63,
+		0x5d, 0xc2, 0x24, 0x7b, 0xbf, 0x93, 0x20, 0xe9, 0x7a, 0x03, 0x6b, 0x59, 0x4a, 0x6e, 0x9c, 0x7e,
+		0xa4, 0x44, 0x8f, 0xdb, 0x37, 0x19, 0xdc, 0x4c, 0x09, 0xa3, 0xc3, 0x8d, 0x6b, 0x59, 0xa2, 0xdf,
+		0x19, 0xf0, 0xfd, 0xf3, 0x7a, 0x24, 0x83, 0xdd, 0x84, 0x64, 0xf7, 0x43, 0x2d, 0x31, 0x92, 0xc1,
+		0xef, 0x2e, 0xd1, 0xb4, 0xdd, 0x28, 0x03, 0xb4, 0xb7, 0xaf, 0xfc, 0xbb, 0x00, 0x8b, 0x7a, 0x82,
+		0x10, 0xcd, 0xc3, 0x68, 0x4b, 0x10, 0x30, 0x5b, 0x4a, 0xcc, 0x92, 0x09, 0xc9, 0xd2, 0x96, 0x8d,
+		0x36, 0x61, 0xac, 0xad, 0x18, 0x9a, 0x3e, 0x55, 0xaa, 0xef, 0x76, 0x8f, 0xac, 0x50, 0x9b, 0x35,
+		0x7d, 0x6a, 0x96, 0x71, 0xc7, 0x13, 0x9a, 0x81, 0x82, 0xed, 0x35, 0x30, 0x73, 0xa5, 0x52, 0x2b,
+		0x99, 0xea, 0x09, 0x3d, 0x81, 0x92, 0x14, 0x22, 0x91, 0x8e, 0x56, 0xea, 0xe8, 0x66, 0x2a, 0x76,
+		0xf4, 0x01, 0xaf, 0x59, 0x20, 0xcc, 0xa2, 0x50, 0x7f, 0xa1, 0x35, 0x18, 0x66, 0xae, 0x1f, 0x0a,
+		0xa5, 0x58, 0xe6, 0x52, 0xfd, 0x76, 0x70, 0xd3, 0xf1, 0xb0, 0x6d, 0xc6, 0xa6, 0x68, 0x0f, 0x66,
+		0x5b, 0x92, 0x5b, 0x78, 0x16, 0x71, 0xbc, 0x80, 0x4a, 0xc1, 0xe1, 0x85, 0x42, 0xc9, 0x8b, 0xd9,
+		0x6a, 0x3c, 0x2e, 0x54, 0x93, 0x71, 0xa1, 0xfa, 0x42, 0x8d, 0x0b, 0xe6, 0x4c, 0xe2, 0xbb, 0xe7,
+		0xd5, 0x23, 0xcf, 0xbd, 0xd8, 0xb1, 0x1b, 0xb5, 0xad, 0x9c, 0x23, 0xd4, 0x91, 0x1c, 0xa8, 0x2d,
+		0xdd, 0x1c, 0xa1, 0x6e, 0xc3, 0x8c, 0x42, 0xea, 0x26, 0x5a, 0xec, 0x07, 0x39, 0x15, 0x0b, 0xec,
+		0xb3, 0x2c, 0x37, 0x61, 0xf2, 0x88, 0x62, 0x2e, 0xf6, 0x29, 0x6e, 0xb3, 0x2b, 0xf5, 0x83, 0x9a,
+		0x68, 0xf9, 0x24, 0x38, 0x75, 0x28, 0x73, 0x2a, 0x78, 0xd3, 0xf2, 0x3d, 0x87, 0x91, 0xa6, 0xea,
+		0x25, 0x0b, 0x3d, 0x7e, 0x8c, 0x82, 0x37, 0x77, 0xa4, 0x9d, 0x39, 0xca, 0xdb, 0x0f, 0xe8, 0x01,
+		0x14, 0x8e, 0x28, 0xb6, 0x29, 0x57, 0x45, 0xfd, 0x46, 0xaa, 0xfb, 0x2b, 0x69, 0x62, 0x2a, 0x53,
+		0xf4, 0x10, 0x66, 0x12, 0xf9, 0xe3, 0x78, 0x04, 0x3b, 0x96, 0xcd, 0x02, 0x1f, 0x0b, 0x72, 0x24,
+		0x8b, 0x6b, 0xd1, 0x9c, 0x56, 0x6f, 0x5f, 0x47, 0x2f, 0x5f, 0xa8, 0x77, 0x51, 0x0e, 0xcb, 0x1c,
+		0xf3, 0x39, 0xf3, 0x38, 0x13, 0x4d, 0x59, 0xd9, 0xc6, 0x7b, 0xe4, 0x70, 0x94, 0x67, 0x3b, 0xca,
+		0xd0, 0x2c, 0x8b, 0x8e, 0xa7, 0xca, 0x2f, 0x0d, 0x98, 0xcb, 0x1a, 0x6c, 0xd0, 0x2c, 0x14, 0x63,
+		0xed, 0xda, 0xfa, 0x29, 0x8d, 0xc8, 0xe7, 0x2d, 0x1b, 0xbd, 0x86, 0x2b, 0xad, 0x58, 0x1e, 0x30,
+		0xde, 0x0e, 0xe5, 0x40, 0xbf, 0xf3, 0x47, 0x2a, 0x94, 0x9b, 0x8c, 0x27, 0x91, 0xac, 0x10, 0x58,
+		0xca, 0x31, 0xd4, 0xa0, 0x87, 0x50, 0xe0, 0x34, 0x08, 0x1d, 0xa1, 0x66, 0xc8, 0xec, 0x5f, 0x8a,
+		0xb2, 0xad, 0x60, 0xb8, 0xa3, 0x39, 0x92, 0xa0, 0x47, 0x30, 0x12, 0x8d, 0x24, 0x21, 0xa7, 0x99,
+		0x3b, 0x6c, 0xc6, 0x36, 0x66, 0x62, 0x5c, 0xd9, 0x86, 0xa5, 0x1c, 0x13, 0x45, 0xdf, 0x6a, 0x55,
+		0x79, 0x02, 0x37, 0x33, 0xc7, 0x80, 0x8c, 0x08, 0x55, 0x08, 0xdc, 0xd3, 0x56, 0xed, 0xd1, 0x07,
+		0xdb, 0x54, 0x60, 0xe6, 0x04, 0x5a, 0x47, 0x9a, 0x18, 0x57, 0xfe, 0x6b, 0xc0, 0xe3, 0x8b, 0xaa,
+		0xec, 0x8e, 0x1a, 0x6a, 0x9c, 0xa9, 0xa1, 0xef, 0x00, 0x9d, 0xd7, 0x4f, 0x2a, 0xb1, 0x16, 0x53,
+		0x79, 0x9d, 0xdb, 0xcd, 0x9c, 0x3c, 0xed, 0x5e, 0x42, 0xd7, 0x60, 0x24, 0x52, 0xa3, 0xdc, 0x73,
+		0x64, 0xcd, 0x2e, 0x9b, 0xc9, 0x23, 0xaa, 0xc2, 0x54, 0x97, 0xd8, 0xf4, 0x5c, 0xa7, 0x29, 0xcb,
+		0x77, 0xd1, 0x9c, 0x24, 0x9d, 0x42, 0xf0, 0xad, 0xeb, 0x34, 0x2b, 0x7f, 0x36, 0xe0, 0x56, 0xb6,
+		0x48, 0x8f, 0x42, 0xab, 0xd4, 0xbf, 0x8b, 0x1b, 0x34, 0x09, 0x6d, 0xbc, 0xb4, 0x8d, 0x1b, 0xb4,
+		0xf3, 0xc4, 0x07, 0x72, 0x9c, 0x78, 0x47, 0x9d, 0x19, 0xd4, 0xae, 0x33, 0x95, 0xcf, 0x00, 0x2b,
+		0x79, 0xd5, 0x7b, 0x54, 0x66, 0x5a, 0xe7, 0x21, 0x5b, 0xa5, 0x91, 0xd1, 0x2a, 0x13, 0xc0, 0xb8,
+		0x55, 0x9e, 0x76, 0x3c, 0x9d, 0x6d, 0x89, 0x03, 0x17, 0x6c, 0x89, 0x83, 0xfa, 0x2d, 0x11, 0xc3,
+		0x42, 0x5b, 0x75, 0xf7, 0x68, 0x38, 0x43, 0xfd, 0xaa, 0xd4, 0x5c, 0x0b, 0x62, 0x37, 0xa5, 0xf3,
+		0x7c, 0x80, 0x1b, 0xf2, 0x93, 0x7a, 0xa0, 0x0f, 0xf7, 0x43, 0xbf, 0x1a, 0x79, 0xa7, 0x01, 0xbf,
+		0x85, 0x99, 0x7d, 0x4c, 0x8e, 0xbd, 0x83, 0x03, 0x85, 0xcd, 0x5c, 0x41, 0xf9, 0x09, 0x76, 0xfa,
+		0xf7, 0xf2, 0x69, 0xe5, 0x28, 0x61, 0xb7, 0x94, 0xdb, 0xb9, 0xde, 0x36, 0x72, 0x91, 0xde, 0xb6,
+		0x05, 0x25, 0xe6, 0x32, 0xc1, 0xb0, 0xf0, 0xb8, 0xec, 0xd5, 0xe3, 0x6b, 0x4b, 0xfd, 0x27, 0xc4,
+		0xad, 0xc4, 0xc5, 0x6c, 0x7b, 0x77, 0x56, 0xd6, 0x52, 0x8e, 0xca, 0x8a, 0x4c, 0x98, 0x71, 0x70,
+		0x20, 0x2c, 0x25, 0x21, 0xa3, 0xd0, 0xaa, 0x16, 0x00, 0x1a, 0x99, 0x31, 0x1d, 0xf9, 0xd6, 0x5b,
+		0xae, 0xa6, 0xf4, 0x44, 0xdf, 0x85, 0x31, 0xc2, 0xa3, 0x1c, 0x51, 0x72, 0x45, 0x36, 0xfe, 0x92,
+		0x59, 0x8e, 0x16, 0x13, 0xbd, 0x79, 0xb1, 0xbe, 0xbe, 0x0c, 0x43, 0x0d, 0xda, 0xf0, 0xd4, 0x88,
+		0x34, 0x9b, 0xea, 0xf2, 0x86, 0x36, 0x3c, 0x53, 0x9a, 0x21, 0x13, 0x26, 0xcf, 0x8d, 0x5c, 0x6a,
+		0x5c, 0xf9, 0x5e, 0xfa, 0x6c, 0xd8, 0x35, 0x1c, 0x99, 0x13, 0x41, 0xd7, 0x0a, 0x7a, 0x06, 0xe5,
+		0x8f, 0x4c, 0x08, 0xca, 0xe3, 0x44, 0x52, 0x13, 0x46, 0x46, 0xfe, 0x8c, 0xc6, 0xe6, 0x32, 0x7d,
+		0xd0, 0x7b, 0x98, 0x92, 0x47, 0xe3, 0x9d, 0x50, 0xee, 0x60, 0x3f, 0xc9, 0x9e, 0x09, 0x19, 0xfb,
+		0xf4, 0x1a, 0x5c, 0xe7, 0x9e, 0xfb, 0x36, 0x36, 0x57, 0x39, 0x34, 0x49, 0xba, 0x97, 0xd0, 0x27,
+		0x98, 0x97, 0xed, 0x8d, 0x5a, 0xc4, 0x09, 0x03, 0xc9, 0x8e, 0x3a, 0x94, 0xc8, 0x78, 0xaa, 0x3d,
+		0x26, 0x33, 0xae, 0x61, 0x65, 0x3f, 0xa5, 0xf5, 0xd8, 0x75, 0x37, 0xf1, 0x54, 0xdb, 0xcd, 0xe1,
+		0x8c, 0xb7, 0x95, 0x3f, 0x94, 0x60, 0x39, 0xd7, 0x45, 0x40, 0xcf, 0xf6, 0x34, 0x0f, 0xa3, 0xad,
+		0xba, 0xc8, 0x6c, 0x59, 0xd1, 0x4a, 0x26, 0x24, 0x4b, 0xf1, 0x8c, 0x71, 0xb6, 0x70, 0x0e, 0x7e,
+		0x81, 0xc2, 0xf9, 0x15, 0x66, 0x09, 0x9d, 0xc2, 0x59, 0xf8, 0xbf, 0x16, 0xce, 0x91, 0x0b, 0x17,
+		0xce, 0xf7, 0x30, 0xe5, 0x63, 0x4e, 0x5d, 0xa1, 0x10, 0x55, 0x32, 0x15, 0x33, 0x12, 0x76, 0x47,
+		0xda, 0x4b, 0x94, 0x24, 0x61, 0xfd, 0xee, 0xa5, 0x4e, 0xd1, 0x50, 0x3a, 0x2b, 0x1a, 0x08, 0x5c,
+		0xeb, 0x48, 0x03, 0x8b, 0xd3, 0xb0, 0xbd, 0x2d, 0xc8, 0x6d, 0xef, 0x67, 0x06, 0x7c, 0xcb, 0x36,
+		0x23, 0x17, 0xb5, 0xf5, 0x95, 0xd3, 0xb4, 0xe5, 0x2f, 0x33, 0x9a, 0x9c, 0xab, 0x73, 0xe5, 0xcc,
+		0x3a, 0x37, 0x96, 0xbf, 0xce, 0x8d, 0x5f, 0xa2, 0xce, 0x7d, 0x73, 0xb9, 0x3a, 0xf7, 0xed, 0xab,
+		0x54, 0xff, 0x1c, 0x80, 0xd5, 0xdc, 0x97, 0x7f, 0x5f, 0x5b, 0x4c, 0xcf, 0xc3, 0xa8, 0xba, 0xf3,
+		0x94, 0xfa, 0x36, 0xbe, 0x04, 0x81, 0x78, 0x49, 0xea, 0xdb, 0x56, 0x01, 0x1a, 0xd2, 0x2f, 0x40,
+		0x1d, 0x3f, 0xb6, 0x61, 0x2d, 0x85, 0x5e, 0xe8, 0xa5, 0xd0, 0x7f, 0x61, 0xc0, 0x4a, 0xde, 0x3b,
+		0xc8, 0xf4, 0xf4, 0x34, 0x2e, 0x95, 0x9e, 0x95, 0x5f, 0x1b, 0xb0, 0xa8, 0x77, 0xb9, 0x88, 0x6e,
+		0x40, 0x49, 0xdd, 0xc1, 0xb5, 0x86, 0xb9, 0x62, 0xbc, 0xb0, 0x65, 0x47, 0xe7, 0xad, 0x5e, 0xca,
+		0xf3, 0x56, 0x4d, 0x27, 0x5e, 0x3a, 0x7b, 0xde, 0xfa, 0x4a, 0xb9, 0xf2, 0x5b, 0x03, 0x16, 0xf5,
+		0x6e, 0x17, 0x2f, 0x49, 0xae, 0x43, 0xf5, 0x0d, 0xe6, 0x99, 0xa7, 0xff, 0x6a, 0xc0, 0x5d, 0xdd,
+		0x0b, 0xc6, 0x6c, 0x8a, 0xed, 0x2b, 0x83, 0x01, 0xfd, 0x2b, 0x83, 0x8b, 0xf2, 0xde, 0xf8, 0x09,
+		0x5c, 0x25, 0x5e, 0x23, 0xcd, 0x76, 0x63, 0x2c, 0x61, 0xbe, 0x13, 0x35, 0xb7, 0x1d, 0xe3, 0xc7,
+		0xab, 0x87, 0x4c, 0x1c, 0x85, 0xfb, 0x55, 0xe2, 0x35, 0x6a, 0x9d, 0xff, 0x71, 0x5f, 0x66, 0xb6,
+		0x53, 0x3b, 0xf4, 0xe2, 0x7f, 0xf2, 0xab, 0x7f, 0xbf, 0x3f, 0xc5, 0x3e, 0x3b, 0x59, 0xdd, 0x2f,
+		0xc8, 0xb5, 0x07, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe7, 0xdc, 0xfc, 0x00, 0x41, 0x20, 0x00,
+		0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0x49,
-		0xb5, 0xde, 0x9e, 0xb1, 0xc7, 0x9e, 0x33, 0x8e, 0x3d, 0x2e, 0x3b, 0x8e, 0xff, 0x12, 0x3b, 0x93,
-		0x6c, 0xe2, 0x75, 0x9c, 0x71, 0xe2, 0x64, 0x93, 0x4d, 0xb2, 0x3f, 0xd7, 0x76, 0x6c, 0xed, 0x48,
-		0xbe, 0x49, 0x6e, 0xc7, 0xc9, 0xde, 0x7b, 0xb5, 0xd2, 0xd0, 0xee, 0x2e, 0xc7, 0x8d, 0x67, 0xba,
-		0x67, 0xbb, 0x6b, 0x3c, 0x31, 0x82, 0x27, 0x1e, 0x90, 0x10, 0x2b, 0x58, 0xad, 0x90, 0x58, 0x09,
-		0x04, 0x42, 0x02, 0xb1, 0xfc, 0x68, 0x11, 0x08, 0xf1, 0xf7, 0x02, 0x48, 0x68, 0x91, 0x40, 0x0b,
-		0x4f, 0xbc, 0xf0, 0x84, 0x84, 0x10, 0xfb, 0xc6, 0x03, 0xcb, 0x33, 0xea, 0xea, 0xea, 0x99, 0xe9,
-		0x9e, 0xaa, 0xfe, 0x19, 0x3b, 0x59, 0xd0, 0xe6, 0x6d, 0xba, 0xfb, 0x9c, 0xd3, 0x5f, 0x55, 0x9d,
-		0x73, 0xea, 0xd4, 0x39, 0xa7, 0x07, 0x4e, 0xd6, 0xb7, 0xb0, 0xb5, 0xa8, 0x2a, 0x1a, 0x36, 0x54,
-		0xbc, 0xa8, 0xd4, 0xf4, 0xc5, 0xbd, 0x8b, 0x8b, 0x3b, 0xba, 0x4d, 0x4c, 0x6b, 0xbf, 0x58, 0xb3,
-		0x4c, 0x62, 0xa2, 0x11, 0x87, 0xa4, 0xc8, 0x48, 0x8a, 0x4a, 0x4d, 0x2f, 0xee, 0x5d, 0x9c, 0x3c,
-		0xf1, 0xc0, 0x34, 0x1f, 0x54, 0xf0, 0x22, 0x25, 0xd9, 0xaa, 0x6f, 0x2f, 0x6a, 0x75, 0x4b, 0x21,
-		0xba, 0x69, 0xb8, 0x4c, 0x93, 0x33, 0xc1, 0xe7, 0x44, 0xaf, 0x62, 0x9b, 0x28, 0xd5, 0x1a, 0x23,
-		0x98, 0xe5, 0xbd, 0x58, 0x35, 0xab, 0xd5, 0xa6, 0x88, 0x02, 0x8f, 0x82, 0x28, 0xf6, 0x6e, 0x45,
-		0xb7, 0x49, 0x18, 0x4d, 0xc3, 0xb4, 0x76, 0xb7, 0x2b, 0x66, 0xc3, 0xa5, 0x29, 0xdc, 0x84, 0xbe,
-		0x97, 0xdd, 0x01, 0xa1, 0x6b, 0x90, 0xc1, 0x7b, 0xd8, 0x20, 0xf6, 0xb8, 0x34, 0x9b, 0x9e, 0xcb,
-		0x2d, 0x9d, 0x2c, 0x72, 0xc6, 0x56, 0x64, 0xd4, 0x6b, 0x0e, 0xa5, 0xcc, 0x18, 0x0a, 0xef, 0x5f,
-		0x85, 0x81, 0xf6, 0x07, 0x68, 0x02, 0xfa, 0xe9, 0xa3, 0xb2, 0xae, 0x8d, 0x4b, 0xb3, 0xd2, 0x5c,
-		0x5a, 0xee, 0xa3, 0xd7, 0x25, 0x0d, 0x5d, 0x03, 0x70, 0x1f, 0x39, 0x83, 0x1e, 0x4f, 0xcd, 0x4a,
-		0x73, 0xb9, 0xa5, 0xc9, 0xa2, 0x3b, 0x23, 0x45, 0x6f, 0x46, 0x8a, 0x9b, 0xde, 0x8c, 0xc8, 0x59,
-		0x4a, 0xed, 0x5c, 0xa3, 0x71, 0xe8, 0xdb, 0xc3, 0x96, 0xad, 0x9b, 0xc6, 0x78, 0xda, 0x15, 0xca,
-		0x2e, 0xd1, 0x31, 0xe8, 0x73, 0x06, 0xef, 0xbc, 0xae, 0x87, 0x3e, 0xc9, 0x38, 0x97, 0x25, 0x0d,
-		0x7d, 0x59, 0x82, 0x73, 0xde, 0x90, 0xcb, 0xf8, 0x21, 0x56, 0xeb, 0xce, 0x3a, 0x94, 0x6d, 0xa2,
-		0x58, 0x04, 0x6b, 0x65, 0x17, 0x89, 0x42, 0x88, 0xa5, 0x6f, 0xd5, 0x09, 0xb6, 0xc7, 0x7b, 0x29,
-		0x9e, 0xe7, 0xb9, 0x43, 0x7f, 0x85, 0xc9, 0x59, 0xf3, 0xc4, 0xdc, 0x75, 0xa5, 0xd0, 0x21, 0x2f,
-		0x37, 0x65, 0xbc, 0xfc, 0x94, 0x7c, 0xb6, 0x11, 0x8f, 0x14, 0x7d, 0x5d, 0x82, 0xf3, 0x1c, 0x78,
-		0xaa, 0x59, 0xad, 0x55, 0x30, 0x17, 0x60, 0x86, 0x02, 0x7c, 0x31, 0x1e, 0xc0, 0x55, 0x4f, 0x4e,
-		0x27, 0xc4, 0x67, 0x1a, 0x71, 0x89, 0xd1, 0x5b, 0x12, 0xcc, 0x73, 0x40, 0x6e, 0x2b, 0x7a, 0x85,
-		0x87, 0xb0, 0x8f, 0x22, 0xbc, 0x11, 0x0f, 0xe1, 0x3a, 0x15, 0xd2, 0x09, 0xef, 0x4c, 0x23, 0x16,
-		0x25, 0xfa, 0x1a, 0x7f, 0x02, 0x1d, 0xdd, 0xd2, 0xca, 0x66, 0x9d, 0x74, 0xc2, 0xeb, 0xa7, 0xf0,
-		0x5e, 0x88, 0x07, 0xcf, 0x51, 0x3b, 0xed, 0x76, 0x9d, 0x74, 0x02, 0x9c, 0x6b, 0xc4, 0xa4, 0x45,
-		0x6f, 0x4a, 0x30, 0xa7, 0x61, 0x55, 0xb7, 0x29, 0x30, 0x47, 0x4b, 0x6d, 0x75, 0x07, 0x6b, 0x75,
-		0xee, 0xe4, 0x65, 0x29, 0xba, 0x6b, 0x5c, 0x74, 0x37, 0x99, 0x90, 0x4d, 0xc5, 0xde, 0xbd, 0xeb,
-		0x89, 0xe8, 0x44, 0x76, 0x5a, 0x8b, 0x41, 0x87, 0x5e, 0x97, 0xe0, 0x4c, 0x00, 0x95, 0xc8, 0x26,
-		0x80, 0x62, 0xba, 0x1a, 0x8d, 0x49, 0x64, 0x0e, 0x05, 0x2d, 0x92, 0x8a, 0x33, 0x4b, 0x21, 0x46,
-		0x90, 0x8b, 0x39, 0x4b, 0x21, 0xfa, 0xef, 0x9b, 0x25, 0xa1, 0xea, 0xbf, 0xd1, 0x81, 0x2a, 0x44,
-		0xb3, 0x06, 0x28, 0xaa, 0xe7, 0x22, 0x51, 0x89, 0x95, 0xea, 0x94, 0x16, 0x4d, 0x86, 0x3e, 0x2b,
-		0xc1, 0xd3, 0x7e, 0x4c, 0x22, 0x4b, 0x3c, 0x42, 0x01, 0x5d, 0x89, 0x04, 0x24, 0x32, 0xc2, 0x93,
-		0x5a, 0x14, 0x11, 0x5d, 0x36, 0x45, 0x25, 0xfa, 0x9e, 0x4e, 0xf6, 0x23, 0x95, 0x7b, 0x30, 0x64,
-		0xd9, 0x96, 0x99, 0x90, 0x28, 0xe5, 0x56, 0x62, 0xd0, 0x51, 0xe5, 0x0e, 0xa0, 0x12, 0x29, 0xf7,
-		0x50, 0x88, 0x72, 0xfb, 0x30, 0x09, 0x95, 0x5b, 0x89, 0xa4, 0xe2, 0xcc, 0x52, 0x88, 0x72, 0xe7,
-		0x63, 0xce, 0x52, 0x98, 0x72, 0x2b, 0x31, 0xe8, 0xa8, 0x22, 0xf9, 0x51, 0x89, 0x14, 0x69, 0x38,
-		0x44, 0x91, 0xda, 0x21, 0x09, 0x15, 0x49, 0x89, 0x22, 0xa2, 0x96, 0xe6, 0x07, 0x13, 0x62, 0x69,
-		0x28, 0xc4, 0xd2, 0xda, 0xf1, 0x84, 0x58, 0x9a, 0x12, 0x4d, 0x86, 0x1a, 0x70, 0xc2, 0x01, 0x61,
-		0x89, 0xb5, 0x67, 0x84, 0x02, 0xb9, 0xc0, 0x05, 0xe2, 0x48, 0xb5, 0x84, 0x6a, 0x33, 0x45, 0xc4,
-		0x8f, 0xd1, 0x6b, 0x30, 0xed, 0xbe, 0x78, 0x5b, 0xb7, 0x78, 0xaf, 0x1d, 0xa5, 0xaf, 0x2d, 0x8a,
-		0x5f, 0xbb, 0xee, 0xf0, 0x75, 0xbe, 0x74, 0x82, 0x88, 0x1e, 0xa2, 0x6f, 0x4a, 0xb0, 0x18, 0x50,
-		0x51, 0xc5, 0x50, 0x71, 0xa5, 0x6c, 0xe1, 0xd7, 0xea, 0xd8, 0xe6, 0x8e, 0xfe, 0x28, 0x85, 0xf1,
-		0x52, 0xb4, 0xa6, 0x52, 0x49, 0xb2, 0x27, 0xa8, 0x13, 0xd7, 0xbc, 0x12, 0x9b, 0x1a, 0xfd, 0x50,
-		0x82, 0xcb, 0x0c, 0x93, 0x07, 0x31, 0x9e, 0x12, 0x8f, 0x51, 0xb4, 0xab, 0x5c, 0xb4, 0xec, 0x6d,
-		0xee, 0xab, 0xe3, 0x68, 0x74, 0xd1, 0x4a, 0xc4, 0x81, 0xbe, 0x20, 0xc1, 0x59, 0xde, 0xf4, 0xf2,
-		0x80, 0x1e, 0x8b, 0xa9, 0xdd, 0xab, 0x4c, 0x42, 0x84, 0x76, 0x0b, 0xc8, 0xd0, 0x27, 0x60, 0xc6,
-		0x55, 0x32, 0x31, 0x92, 0x71, 0x8a, 0xe4, 0xa2, 0x58, 0xcf, 0xc4, 0x10, 0x5c, 0x05, 0x16, 0xbd,
-		0xfb, 0x33, 0x12, 0x9c, 0x66, 0x8b, 0xc7, 0x14, 0x5d, 0xb0, 0x68, 0x13, 0x14, 0xc1, 0xb3, 0x5c,
-		0x04, 0xae, 0x70, 0x57, 0xdf, 0x05, 0xcb, 0x34, 0xab, 0x46, 0xd0, 0xa0, 0x4f, 0xc1, 0x6c, 0x55,
-		0xb1, 0x76, 0xb1, 0x55, 0xb6, 0xb0, 0x6a, 0x5a, 0x1a, 0x0f, 0xc4, 0x24, 0x05, 0xb1, 0xc4, 0x05,
-		0xf1, 0xdf, 0x94, 0x59, 0x66, 0xbc, 0x9d, 0x08, 0x8e, 0x57, 0xc3, 0x08, 0xd0, 0x57, 0x25, 0x58,
-		0xe0, 0x9d, 0x4f, 0xf4, 0x07, 0x86, 0xc2, 0x9d, 0x90, 0xa9, 0x24, 0xe1, 0xeb, 0x5d, 0x26, 0x26,
-		0x4e, 0xf8, 0x2a, 0xa0, 0x45, 0xdf, 0x90, 0xa0, 0xc8, 0x8b, 0xb0, 0xb1, 0x55, 0xd5, 0x0d, 0x85,
-		0xeb, 0x17, 0xa6, 0x43, 0xfc, 0x42, 0x67, 0x88, 0xdd, 0x14, 0xc4, 0xf1, 0x0b, 0x8d, 0xd8, 0xd4,
-		0xe8, 0x47, 0x12, 0x5c, 0xe6, 0x1d, 0xa5, 0x22, 0xbd, 0xd8, 0x71, 0x8a, 0xf6, 0x66, 0xcc, 0x13,
-		0x55, 0x94, 0x2b, 0x5b, 0x6c, 0x24, 0x63, 0x11, 0x69, 0x80, 0xd8, 0x28, 0x4f, 0x24, 0xd1, 0x00,
-		0xb1, 0x81, 0xce, 0x35, 0x62, 0xd2, 0xa2, 0xbf, 0x48, 0xb0, 0x16, 0xf0, 0xb8, 0xf8, 0x21, 0xc1,
-		0x96, 0xa1, 0x54, 0xca, 0x1c, 0xe4, 0xba, 0xa1, 0x13, 0x9d, 0xaf, 0x18, 0x33, 0x14, 0xfa, 0xdd,
-		0x68, 0x17, 0xbc, 0xc6, 0xe4, 0x77, 0x8c, 0xa7, 0xe4, 0x09, 0xef, 0x1c, 0xd0, 0x8b, 0xd6, 0x81,
-		0x24, 0xa0, 0x3f, 0x49, 0xb0, 0x92, 0x60, 0x98, 0x22, 0x8f, 0x35, 0x4b, 0xc7, 0x78, 0xe7, 0x00,
-		0x63, 0x14, 0x39, 0xb3, 0x1b, 0x56, 0xf7, 0xec, 0xe8, 0x3d, 0x09, 0x5e, 0x08, 0x1b, 0x4e, 0xb4,
-		0x9d, 0x9c, 0xa4, 0x03, 0xdb, 0xe0, 0x0e, 0x4c, 0x08, 0x26, 0xd2, 0x5e, 0xae, 0xe2, 0xee, 0x58,
-		0x69, 0x1c, 0xc0, 0x4d, 0x9d, 0x18, 0x44, 0x37, 0xea, 0x58, 0x2b, 0x2b, 0x76, 0xd9, 0xc0, 0x8d,
-		0xce, 0x71, 0x14, 0x42, 0xe2, 0x00, 0x4e, 0x06, 0x85, 0x89, 0x5b, 0xb6, 0x6f, 0xe1, 0x06, 0x27,
-		0x0e, 0x68, 0x24, 0xe2, 0x40, 0xbf, 0x92, 0xe0, 0x1a, 0x8d, 0x26, 0xcb, 0xea, 0x8e, 0x5e, 0xd1,
-		0x12, 0xda, 0xcf, 0x29, 0x0a, 0xfd, 0x65, 0x2e, 0x74, 0x1a, 0x4a, 0xae, 0x3a, 0x42, 0x93, 0x18,
-		0xcd, 0x25, 0x3b, 0x39, 0x1b, 0xfa, 0xa9, 0x04, 0x57, 0x22, 0x06, 0x21, 0xb2, 0x8e, 0xd3, 0x74,
-		0x04, 0x6b, 0x49, 0x47, 0x20, 0x32, 0x89, 0x0b, 0x76, 0x42, 0x1e, 0xf4, 0x5d, 0x09, 0x2e, 0x0a,
-		0x51, 0x0b, 0xe3, 0xfc, 0xa7, 0x29, 0xec, 0x65, 0x7e, 0x18, 0xc2, 0x7d, 0xbb, 0x30, 0xf0, 0x5f,
-		0x50, 0x13, 0xd0, 0xa3, 0x1f, 0x48, 0x70, 0x49, 0x08, 0x37, 0xe4, 0x10, 0x79, 0x26, 0x44, 0xc9,
-		0xf9, 0x80, 0x43, 0x8e, 0x93, 0x45, 0x35, 0x11, 0x07, 0x7a, 0x5b, 0x82, 0x0b, 0x89, 0x35, 0xe3,
-		0x2c, 0x45, 0xfc, 0x5f, 0x09, 0x10, 0x8b, 0x94, 0xe2, 0x9c, 0x9a, 0x40, 0x1f, 0xde, 0x91, 0x60,
-		0x49, 0x3c, 0xc1, 0xc2, 0x4d, 0x78, 0x8e, 0xa2, 0x5d, 0x49, 0x32, 0xbf, 0xc2, 0x9d, 0xf8, 0xbc,
-		0x9a, 0x84, 0x01, 0x7d, 0x3f, 0x4c, 0x25, 0x42, 0x0e, 0xcd, 0xcf, 0x24, 0x86, 0x2c, 0x3e, 0x3e,
-		0x0b, 0x20, 0x8b, 0x0e, 0xd2, 0x4e, 0x6c, 0x26, 0x86, 0x1c, 0x12, 0x49, 0xce, 0x87, 0xc4, 0x66,
-		0x02, 0xcc, 0x21, 0xe1, 0xe4, 0xa2, 0x9a, 0x8c, 0x85, 0x6e, 0x9a, 0x6e, 0x28, 0xde, 0x6d, 0xc4,
-		0x73, 0x2e, 0x64, 0xd3, 0x74, 0x23, 0xee, 0x6e, 0x42, 0x9d, 0xab, 0x76, 0x77, 0xac, 0xe8, 0xd7,
-		0x12, 0x5c, 0x8f, 0x31, 0x20, 0x91, 0x8d, 0x2e, 0xd0, 0xd1, 0x94, 0xba, 0x19, 0x8d, 0xc8, 0x58,
-		0x2f, 0xdb, 0x5d, 0xf0, 0xa1, 0x9f, 0x48, 0xf0, 0x6c, 0xd8, 0x00, 0xc4, 0xe7, 0xa7, 0xf3, 0x21,
-		0x1b, 0x90, 0x10, 0x84, 0xf8, 0x1c, 0x75, 0x01, 0x27, 0xe4, 0xa1, 0x0e, 0xa7, 0x5e, 0xb3, 0xb1,
-		0x45, 0x5a, 0xc0, 0x6d, 0xac, 0x58, 0xea, 0x4e, 0x1b, 0xcc, 0x4e, 0xdc, 0xc5, 0x10, 0xeb, 0xbd,
-		0x47, 0xc5, 0x79, 0x08, 0xee, 0x52, 0x61, 0xad, 0x37, 0x72, 0xac, 0xb7, 0x9e, 0x84, 0x61, 0x65,
-		0x00, 0xa0, 0x05, 0xa4, 0xf0, 0xe7, 0x21, 0x38, 0x1b, 0x77, 0xf7, 0x5a, 0x87, 0x23, 0xcd, 0x31,
-		0x92, 0xfd, 0x1a, 0xa6, 0xb5, 0x40, 0x51, 0x65, 0xd1, 0x13, 0xba, 0xb9, 0x5f, 0xc3, 0xf2, 0x40,
-		0xa3, 0xed, 0x0a, 0xbd, 0x0a, 0x47, 0x6b, 0x8a, 0xe5, 0xcc, 0x48, 0xbb, 0xd1, 0x6d, 0x9b, 0xac,
-		0x7c, 0x38, 0xc7, 0x95, 0x77, 0x87, 0x72, 0xb4, 0xd9, 0xc4, 0xb6, 0x29, 0x8f, 0xd4, 0x3a, 0x6f,
-		0xa2, 0xeb, 0x90, 0xa5, 0x19, 0x99, 0x8a, 0x6e, 0x13, 0x5a, 0x58, 0xcc, 0x2d, 0x1d, 0xe7, 0xa7,
-		0x3c, 0x14, 0x7b, 0x77, 0x43, 0xb7, 0x89, 0xdc, 0x4f, 0xd8, 0x2f, 0xb4, 0x04, 0xbd, 0xba, 0x51,
-		0xab, 0x13, 0x5a, 0x76, 0xcc, 0x2d, 0x4d, 0x0b, 0x90, 0xec, 0x57, 0x4c, 0x45, 0x93, 0x5d, 0x52,
-		0xa4, 0xc0, 0x6c, 0x20, 0xe4, 0x28, 0x13, 0xb3, 0xac, 0x56, 0x4c, 0x1b, 0x53, 0xff, 0x6d, 0xd6,
-		0x09, 0xab, 0x43, 0x4e, 0x74, 0xd4, 0x45, 0x6f, 0xb2, 0x4a, 0xb2, 0x3c, 0x8d, 0x7d, 0x73, 0xbf,
-		0x69, 0xae, 0x3a, 0xfc, 0x9b, 0x2e, 0x3b, 0x7a, 0x05, 0xa6, 0x5a, 0x69, 0xef, 0x4e, 0xe9, 0x99,
-		0x28, 0xe9, 0xc7, 0x88, 0x97, 0xcc, 0x0e, 0x08, 0xbe, 0x01, 0x93, 0xad, 0x08, 0xbb, 0x35, 0x0a,
-		0xab, 0x6e, 0x94, 0x75, 0x8d, 0x96, 0xfe, 0xb2, 0xf2, 0xb1, 0x26, 0x45, 0x73, 0x9e, 0xe5, 0xba,
-		0x51, 0xd2, 0x50, 0x09, 0xb2, 0xcc, 0x55, 0x9a, 0x16, 0xad, 0xc3, 0x0d, 0x2e, 0x9d, 0xe3, 0xbb,
-		0x76, 0x26, 0x80, 0x86, 0xd0, 0x25, 0x8f, 0x45, 0x6e, 0x71, 0xa3, 0x12, 0x0c, 0xb7, 0x70, 0x38,
-		0xee, 0xaa, 0x6e, 0x61, 0x56, 0x3c, 0xe3, 0xaf, 0xc1, 0xba, 0x4b, 0x23, 0xe7, 0x9b, 0x6c, 0xec,
-		0x0e, 0x92, 0x61, 0xac, 0xa2, 0x38, 0x67, 0x3e, 0x37, 0x9c, 0xa1, 0xc3, 0xc1, 0x76, 0xbd, 0x42,
-		0x58, 0xe1, 0x2b, 0x7c, 0x4d, 0x47, 0x1d, 0xde, 0xd5, 0x26, 0xab, 0x4c, 0x39, 0xd1, 0x35, 0x98,
-		0x30, 0x2d, 0xfd, 0x81, 0xee, 0x3a, 0xda, 0xc0, 0x2c, 0xe5, 0xe8, 0x2c, 0x8d, 0x79, 0x04, 0x81,
-		0x49, 0x9a, 0x84, 0x7e, 0x5d, 0xc3, 0x06, 0xd1, 0xc9, 0x3e, 0xad, 0x28, 0x65, 0xe5, 0xe6, 0x35,
-		0xba, 0x04, 0x63, 0xdb, 0xba, 0x65, 0x93, 0x4e, 0x99, 0x47, 0x28, 0xe5, 0x08, 0x7d, 0x1a, 0x10,
-		0xb8, 0x0a, 0x03, 0x16, 0x26, 0xd6, 0x7e, 0xb9, 0x66, 0x56, 0x74, 0x75, 0x9f, 0x55, 0x61, 0x66,
-		0x05, 0x07, 0x54, 0x62, 0xed, 0xdf, 0xa1, 0x74, 0x72, 0xce, 0x6a, 0x5d, 0xa0, 0x71, 0xe8, 0x53,
-		0x08, 0xc1, 0xd5, 0x1a, 0xa1, 0x15, 0x93, 0x5e, 0xd9, 0xbb, 0x44, 0xab, 0x30, 0x84, 0x1f, 0xd6,
-		0x74, 0x57, 0x71, 0xdc, 0xa2, 0x7e, 0x3e, 0xb2, 0xa8, 0x3f, 0xd8, 0x62, 0xa1, 0x95, 0xfd, 0x53,
-		0x70, 0x44, 0xb5, 0x1c, 0x6b, 0x60, 0x15, 0x1d, 0x5a, 0x71, 0xc8, 0xca, 0x03, 0xce, 0x4d, 0xaf,
-		0xca, 0x83, 0xfe, 0x17, 0xa6, 0xdc, 0xd1, 0xfb, 0xab, 0x5f, 0x5b, 0x8a, 0xba, 0x6b, 0x6e, 0x6f,
-		0xb3, 0xa2, 0x40, 0x88, 0x52, 0x8f, 0x53, 0xee, 0xf6, 0xc2, 0xd7, 0x8a, 0xcb, 0x8a, 0xce, 0x43,
-		0x4f, 0x15, 0x57, 0x4d, 0x96, 0xce, 0x9f, 0xe0, 0x27, 0xfa, 0x70, 0xd5, 0x94, 0x29, 0x19, 0x92,
-		0x61, 0xb8, 0xc3, 0x63, 0xb3, 0x9c, 0xfc, 0xd3, 0xfc, 0xbd, 0x31, 0xe0, 0x61, 0xe5, 0xbc, 0x1d,
-		0xb8, 0x83, 0xee, 0xc1, 0x58, 0xcd, 0xc2, 0x7b, 0x65, 0xa5, 0x4e, 0x4c, 0x47, 0xff, 0x30, 0x29,
-		0xd7, 0x4c, 0xdd, 0x20, 0x5e, 0x96, 0x5d, 0xb4, 0x5e, 0x36, 0x26, 0x77, 0x28, 0x9d, 0x3c, 0xe2,
-		0xf0, 0x2f, 0xd7, 0x89, 0xd9, 0x76, 0x13, 0x5d, 0x82, 0xcc, 0x0e, 0x56, 0x34, 0x6c, 0xb1, 0xf4,
-		0xf7, 0x14, 0xbf, 0xa9, 0x83, 0x92, 0xc8, 0x8c, 0x14, 0x6d, 0xc0, 0xa8, 0x3b, 0xd1, 0xad, 0x5a,
-		0x1e, 0x5d, 0xd7, 0x63, 0x91, 0xeb, 0x8a, 0x28, 0x5f, 0xb3, 0x2e, 0x47, 0xd7, 0xf6, 0x93, 0x90,
-		0xaf, 0x29, 0x16, 0xd1, 0xbd, 0xe3, 0xf9, 0xb6, 0xfe, 0x60, 0x7c, 0x9c, 0x76, 0x98, 0xfc, 0xcf,
-		0x41, 0xda, 0x2c, 0x1c, 0xff, 0xee, 0x0a, 0x5d, 0xa5, 0x32, 0xd7, 0x0c, 0x62, 0xed, 0xcb, 0x43,
-		0x35, 0xff, 0x5d, 0x74, 0x1c, 0xc0, 0x4b, 0xea, 0xe8, 0x1a, 0x4d, 0x27, 0x67, 0xe5, 0x2c, 0xbb,
-		0x53, 0xd2, 0xd0, 0x7d, 0x18, 0xa1, 0x8a, 0x67, 0xee, 0x61, 0xab, 0xa2, 0xd4, 0x3c, 0x1b, 0x99,
-		0xa4, 0xce, 0xe9, 0x0c, 0xdf, 0x39, 0x59, 0xa6, 0x71, 0xdb, 0x25, 0x67, 0x96, 0x32, 0xac, 0x06,
-		0x6f, 0xa1, 0x87, 0x30, 0x43, 0x73, 0xf0, 0xb8, 0xac, 0x56, 0xea, 0x36, 0xc1, 0x56, 0xd9, 0xc6,
-		0x15, 0xac, 0xd2, 0x39, 0x60, 0xef, 0x98, 0x0a, 0x49, 0xae, 0xd3, 0x34, 0x3f, 0x5e, 0x75, 0x59,
-		0xef, 0x7a, 0x9c, 0xec, 0x75, 0xd3, 0x4a, 0xc8, 0xd3, 0xc9, 0x15, 0x18, 0xe5, 0xcd, 0x0c, 0xca,
-		0x43, 0x7a, 0x17, 0xef, 0xd3, 0x1d, 0x38, 0x2b, 0x3b, 0x3f, 0xd1, 0x28, 0xf4, 0xee, 0x29, 0x95,
-		0xba, 0xdb, 0x84, 0x93, 0x95, 0xdd, 0x8b, 0xeb, 0xa9, 0xe7, 0xa4, 0xc2, 0xdb, 0x12, 0x3c, 0x13,
-		0xff, 0xb8, 0x77, 0x19, 0x32, 0xcc, 0x61, 0x4a, 0x31, 0x1c, 0x26, 0xa3, 0x45, 0xeb, 0x30, 0x1b,
-		0x5e, 0xef, 0xd7, 0x35, 0x0a, 0x2c, 0x2d, 0x4f, 0x8b, 0x4b, 0xf5, 0x25, 0xad, 0xf0, 0x2d, 0x09,
-		0xce, 0xc4, 0x8c, 0x1a, 0xaf, 0x40, 0x9f, 0xb7, 0x55, 0x48, 0x31, 0xb6, 0x0a, 0x8f, 0xf8, 0xd0,
-		0xa0, 0x9a, 0x30, 0x17, 0xfb, 0xc8, 0xb4, 0x0a, 0x03, 0x6c, 0xb7, 0x6e, 0x45, 0x4e, 0x83, 0x02,
-		0x2f, 0xc0, 0x36, 0x67, 0x1a, 0x38, 0xe5, 0x48, 0xeb, 0xa2, 0xf0, 0x3b, 0x09, 0x4e, 0xc7, 0xe9,
-		0x1a, 0xf1, 0x87, 0x40, 0x52, 0xb2, 0x10, 0xe8, 0x16, 0x8c, 0x09, 0xc2, 0x8c, 0x54, 0x94, 0x47,
-		0x1e, 0xb1, 0x39, 0x21, 0x46, 0xdb, 0x56, 0x93, 0xf6, 0x6d, 0x35, 0x85, 0xd7, 0x25, 0x28, 0x44,
-		0x37, 0x9c, 0xa0, 0x05, 0x40, 0xc1, 0x26, 0x84, 0x66, 0x1b, 0x5a, 0xde, 0xf6, 0x4d, 0x41, 0x60,
-		0xbf, 0x4d, 0x05, 0xf6, 0x5b, 0xbf, 0xf3, 0x48, 0x07, 0x9c, 0x47, 0xe1, 0xef, 0x81, 0xe9, 0x15,
-		0x5a, 0x48, 0x32, 0x44, 0x73, 0x90, 0xf7, 0x27, 0xa2, 0x9a, 0xea, 0x35, 0x68, 0xb7, 0x8d, 0x38,
-		0x80, 0x3d, 0x1d, 0xc0, 0x7e, 0x16, 0x86, 0xb6, 0x74, 0x43, 0xb1, 0xf6, 0xcb, 0xea, 0x0e, 0x56,
-		0x77, 0xed, 0x7a, 0x95, 0xc6, 0xa8, 0x59, 0x79, 0xd0, 0xbd, 0xbd, 0xca, 0xee, 0xa2, 0x73, 0x30,
-		0xec, 0x4f, 0x9f, 0xe2, 0x87, 0x6e, 0xfc, 0x39, 0x20, 0xe7, 0x71, 0x7b, 0x56, 0x13, 0x3f, 0x24,
-		0x85, 0xef, 0xa4, 0xe1, 0x54, 0x8c, 0x5e, 0x96, 0x47, 0x36, 0xe2, 0xa0, 0x59, 0xa4, 0xbb, 0x30,
-		0x0b, 0x74, 0x02, 0x72, 0x5b, 0x8a, 0x8d, 0xbd, 0xd8, 0xc9, 0x9d, 0x96, 0xac, 0x73, 0xcb, 0x8d,
-		0x98, 0xa6, 0x01, 0x0c, 0xdc, 0xf0, 0x1e, 0xf7, 0xba, 0x13, 0x6b, 0xe0, 0x86, 0xfb, 0x74, 0x01,
-		0xd0, 0xb6, 0x69, 0xed, 0x32, 0xa4, 0x5e, 0x43, 0x62, 0xc6, 0x1d, 0x9a, 0xf3, 0x84, 0x62, 0xbd,
-		0xcf, 0x3a, 0x13, 0xc7, 0x1c, 0xe7, 0xa8, 0xd8, 0xa6, 0xc1, 0x82, 0x63, 0x76, 0x85, 0x6e, 0x42,
-		0xaf, 0xaa, 0xd4, 0x6d, 0xcc, 0xe2, 0xe0, 0x62, 0xec, 0xae, 0xa1, 0x55, 0x87, 0x4b, 0x76, 0x99,
-		0x03, 0x0a, 0x9a, 0x0d, 0x2a, 0xe8, 0xbb, 0x69, 0x38, 0x19, 0xd9, 0xe8, 0xf3, 0xc8, 0xd6, 0x6a,
-		0xc5, 0x1b, 0xa2, 0xbb, 0x48, 0x0b, 0x31, 0xfb, 0x90, 0x7c, 0x03, 0x6c, 0x73, 0xd9, 0x3d, 0x49,
-		0x5c, 0x76, 0xbb, 0x65, 0xf4, 0x06, 0x2c, 0x23, 0xb0, 0xfc, 0x99, 0xf0, 0xe5, 0xef, 0x8b, 0xb5,
-		0xfc, 0xfd, 0x82, 0xe5, 0xe7, 0x58, 0x61, 0x96, 0x6b, 0x85, 0xfe, 0x95, 0x84, 0xe0, 0x4a, 0x7e,
-		0x25, 0x03, 0xa7, 0xe3, 0xb4, 0x48, 0xa1, 0x19, 0xc8, 0x35, 0xfb, 0x0c, 0xd8, 0x2a, 0x66, 0x65,
-		0xf0, 0x6e, 0x95, 0x34, 0xe7, 0x4c, 0xde, 0x6a, 0x44, 0x70, 0x4c, 0x28, 0x15, 0x72, 0x26, 0x6f,
-		0xbe, 0x92, 0x9e, 0xc9, 0x95, 0xb6, 0x2b, 0x47, 0xb1, 0x35, 0xb3, 0xaa, 0xe8, 0x06, 0xf3, 0x3c,
-		0xec, 0xca, 0xbf, 0x95, 0xf4, 0x74, 0x79, 0x9a, 0xce, 0xc4, 0x3f, 0x4d, 0x6f, 0xc2, 0x84, 0xa7,
-		0xa3, 0x9d, 0x3b, 0x50, 0x5f, 0xd4, 0x0e, 0x34, 0xe6, 0xf1, 0x06, 0x36, 0xa1, 0x80, 0x54, 0xb6,
-		0xc1, 0x31, 0xa9, 0xfd, 0x09, 0xa4, 0xba, 0x87, 0x68, 0x26, 0x55, 0xbc, 0x55, 0x66, 0xbb, 0xda,
-		0x2a, 0xd7, 0x61, 0x78, 0x07, 0x2b, 0x16, 0xd9, 0xc2, 0x4a, 0x0b, 0x1d, 0x44, 0x89, 0xca, 0x37,
-		0x79, 0x5a, 0x72, 0xa2, 0x03, 0x9c, 0x5c, 0x74, 0x80, 0xd3, 0x71, 0xd4, 0x1c, 0xe8, 0xe6, 0xa8,
-		0xd9, 0x3a, 0xb2, 0x1c, 0x89, 0x7d, 0x64, 0x29, 0xfc, 0x4d, 0x82, 0x42, 0x74, 0xbb, 0xde, 0x63,
-		0x0b, 0x0d, 0xda, 0x83, 0x98, 0x1e, 0xff, 0x79, 0xf9, 0x25, 0x18, 0xa0, 0xe9, 0x06, 0xcf, 0xad,
-		0xf5, 0xc6, 0x70, 0x6b, 0x39, 0x87, 0x83, 0x5d, 0x14, 0xfe, 0x20, 0xf9, 0x5d, 0xc1, 0x21, 0xc7,
-		0xe5, 0xfc, 0x29, 0x4a, 0x25, 0xd8, 0x0d, 0xd2, 0x91, 0xb1, 0x4a, 0x8f, 0x7f, 0x32, 0x0b, 0xbf,
-		0x97, 0xe0, 0x64, 0x74, 0x0f, 0x55, 0xb7, 0xe1, 0xfb, 0x87, 0x31, 0xa2, 0x9f, 0xa7, 0xe0, 0x54,
-		0x8c, 0x4e, 0x44, 0x67, 0x4c, 0x1a, 0x26, 0x8a, 0x5e, 0xb1, 0x63, 0x2d, 0x92, 0x47, 0xfc, 0xc8,
-		0xc6, 0x14, 0x8c, 0xaf, 0x7a, 0xba, 0x89, 0xaf, 0x0e, 0xac, 0xe2, 0x5f, 0x94, 0x60, 0x3e, 0x7e,
-		0x03, 0x61, 0x9c, 0x3d, 0xef, 0x70, 0x0e, 0x70, 0xef, 0x48, 0x90, 0xb0, 0x55, 0x30, 0x1a, 0xdb,
-		0xa8, 0x17, 0x25, 0xb1, 0x53, 0xb8, 0x1b, 0xf7, 0xc4, 0x41, 0x9c, 0x8e, 0x81, 0xf8, 0xad, 0x80,
-		0x1e, 0x8a, 0x8a, 0x8a, 0xdd, 0xea, 0xe1, 0x3a, 0xcc, 0x56, 0x14, 0xd2, 0xd6, 0x32, 0x13, 0x6c,
-		0x20, 0x69, 0xcd, 0xac, 0x4b, 0xc7, 0x5b, 0x4a, 0x37, 0xaa, 0xe2, 0xe8, 0x73, 0x3a, 0x81, 0x3e,
-		0xf7, 0x44, 0xda, 0x68, 0x20, 0x0e, 0x2c, 0xbc, 0x27, 0xc1, 0x54, 0x48, 0x93, 0x2e, 0x9a, 0x80,
-		0x7e, 0xb7, 0x39, 0xb1, 0xb9, 0x6e, 0x7d, 0xf4, 0xba, 0xa4, 0xa1, 0x0d, 0x38, 0xda, 0xdc, 0xc8,
-		0xb7, 0x75, 0x2b, 0xc1, 0x91, 0x17, 0xb1, 0x7d, 0x7c, 0x5d, 0xb7, 0x70, 0x92, 0xed, 0x37, 0xce,
-		0x62, 0x7f, 0x0c, 0x26, 0x84, 0xdd, 0xbf, 0x61, 0xa3, 0x89, 0x1d, 0xd2, 0x17, 0xde, 0x95, 0x60,
-		0x3a, 0xac, 0xf1, 0xf3, 0x50, 0xde, 0x72, 0x58, 0xf3, 0x11, 0xea, 0xa0, 0x7f, 0x2c, 0xc1, 0x6c,
-		0x54, 0x03, 0x69, 0xd8, 0x68, 0x1e, 0xa9, 0xd9, 0x86, 0x6f, 0x96, 0x59, 0x48, 0xd8, 0xa7, 0x84,
-		0x16, 0x61, 0x94, 0xb6, 0x42, 0x05, 0xab, 0x06, 0xee, 0x98, 0x86, 0x0d, 0xdc, 0x08, 0xd4, 0x0c,
-		0x3a, 0x0a, 0x77, 0xa9, 0xee, 0x0a, 0x77, 0x4f, 0x4a, 0x6b, 0xf1, 0x4b, 0x6b, 0x71, 0x74, 0xa7,
-		0x2f, 0x86, 0xee, 0xdc, 0x86, 0x31, 0x56, 0x12, 0x61, 0x18, 0x75, 0x83, 0x60, 0x6b, 0x4f, 0xa9,
-		0x44, 0x9f, 0x5b, 0x46, 0x19, 0x23, 0x85, 0x57, 0x62, 0x6c, 0xfe, 0xb2, 0x5d, 0xf6, 0x40, 0x65,
-		0xbb, 0xb6, 0x10, 0x0e, 0x92, 0x84, 0x70, 0xe2, 0x1a, 0x5d, 0xae, 0xeb, 0x1a, 0x5d, 0xeb, 0x9c,
-		0x31, 0x10, 0xbf, 0x34, 0xe2, 0x55, 0x8a, 0x8e, 0x1c, 0xa0, 0x52, 0x34, 0x78, 0xb0, 0x4a, 0x91,
-		0xa0, 0x64, 0x31, 0xf4, 0x18, 0x4a, 0x16, 0xf9, 0x47, 0x52, 0xb2, 0x28, 0xfc, 0x55, 0x82, 0xc5,
-		0xa4, 0xed, 0x9f, 0x4d, 0xff, 0x2b, 0xb5, 0xfb, 0xdf, 0xb0, 0x13, 0xdb, 0x16, 0x1c, 0x6b, 0xb6,
-		0x8c, 0x04, 0xda, 0x08, 0x5c, 0xcf, 0x34, 0x1f, 0xda, 0x14, 0xe2, 0x6f, 0x24, 0x38, 0x8a, 0x79,
-		0xb7, 0x03, 0xa7, 0xc2, 0x9e, 0x60, 0x16, 0xe7, 0xdb, 0x12, 0xa7, 0x02, 0x20, 0xda, 0x4a, 0xe3,
-		0xf8, 0x03, 0x29, 0x86, 0x3f, 0x68, 0x0b, 0xed, 0x52, 0x09, 0x42, 0xbb, 0xc2, 0x07, 0x12, 0x1c,
-		0x0f, 0xfd, 0xba, 0xc1, 0x89, 0x6d, 0xd9, 0xb7, 0x13, 0x86, 0x52, 0xf5, 0x56, 0x02, 0xdc, 0x5b,
-		0xb7, 0x94, 0x2a, 0xee, 0xf6, 0xd5, 0x87, 0xb6, 0x8d, 0xb6, 0x4c, 0xbc, 0x27, 0x7e, 0x2a, 0xe1,
-		0x67, 0xbc, 0x45, 0x12, 0x75, 0xf3, 0xcc, 0x40, 0x8e, 0xf5, 0x53, 0xb5, 0x4f, 0x81, 0x7b, 0x8b,
-		0x4e, 0x41, 0x73, 0x17, 0x4b, 0xc5, 0xdf, 0xc5, 0xc2, 0xd2, 0xfa, 0x11, 0x1a, 0xf6, 0x25, 0x09,
-		0xe6, 0x13, 0x34, 0xb8, 0xb5, 0xb2, 0xd3, 0x92, 0x2f, 0x3b, 0xdd, 0xed, 0xc2, 0x85, 0x20, 0x2f,
-		0xfc, 0x32, 0x05, 0x2f, 0x1e, 0xac, 0xc9, 0xff, 0xd0, 0x4c, 0xa2, 0x95, 0xbb, 0x4c, 0xf9, 0x72,
-		0x97, 0xf7, 0x00, 0x75, 0x36, 0x93, 0x31, 0xef, 0x70, 0x26, 0x5e, 0xb1, 0x5a, 0x1e, 0xee, 0xe8,
-		0x08, 0x47, 0xe3, 0xd0, 0xa7, 0x9a, 0x06, 0xb1, 0xcc, 0x0a, 0x5d, 0xb0, 0x01, 0xd9, 0xbb, 0x44,
-		0x45, 0x18, 0x09, 0xf4, 0x45, 0x9a, 0x46, 0xc5, 0x3d, 0xa9, 0xf4, 0xcb, 0xc3, 0xbe, 0x76, 0xc5,
-		0xdb, 0x46, 0x65, 0xbf, 0xf0, 0x66, 0x1a, 0x6e, 0x1c, 0xe0, 0x23, 0x02, 0x74, 0xaf, 0xdd, 0x6b,
-		0x0e, 0x0a, 0x3e, 0xd1, 0x89, 0x25, 0xd9, 0x97, 0xa5, 0x3f, 0xa4, 0xf3, 0xb5, 0x30, 0xa7, 0xcc,
-		0x5f, 0x97, 0x9e, 0x83, 0xae, 0xcb, 0x02, 0xa0, 0x60, 0xeb, 0x26, 0xab, 0xf7, 0xa4, 0xe5, 0xbc,
-		0xee, 0x53, 0x42, 0x37, 0xa5, 0xe7, 0xad, 0x62, 0xc6, 0xb7, 0x8a, 0x85, 0x3f, 0x4a, 0x70, 0xb5,
-		0xcb, 0x2f, 0x20, 0x04, 0x18, 0x24, 0x01, 0x86, 0xc7, 0xab, 0xb8, 0x85, 0xcf, 0xa7, 0xe1, 0x6a,
-		0x97, 0x5d, 0xaa, 0xff, 0xa9, 0xb6, 0x1a, 0x70, 0xe8, 0x3d, 0x62, 0x87, 0xde, 0x1b, 0xdf, 0xa1,
-		0x0b, 0x55, 0x47, 0xe4, 0x00, 0xfa, 0x44, 0x0e, 0xe0, 0x73, 0x69, 0xb8, 0xdc, 0x4d, 0xa7, 0x6d,
-		0x3c, 0xcb, 0x8f, 0x25, 0xf9, 0x89, 0xe5, 0xb7, 0x2c, 0xff, 0x7d, 0x09, 0x2e, 0x24, 0xed, 0x1a,
-		0xfe, 0xb7, 0x36, 0x79, 0xf1, 0x5e, 0x55, 0xf8, 0xad, 0x04, 0xe7, 0x13, 0x75, 0x1a, 0x1f, 0x9a,
-		0x0b, 0xe0, 0x9e, 0xa2, 0x52, 0x07, 0x3a, 0x45, 0x15, 0xbe, 0x97, 0x83, 0x4b, 0x5d, 0x7c, 0x32,
-		0xd5, 0xb6, 0x1c, 0x92, 0x6f, 0x39, 0x66, 0x20, 0xd7, 0x5c, 0x0e, 0xa6, 0xf3, 0x59, 0x19, 0xbc,
-		0x5b, 0xbc, 0x94, 0x4a, 0xfa, 0x10, 0x52, 0x2a, 0xdd, 0xd6, 0x57, 0x7b, 0x0f, 0x37, 0xa5, 0x92,
-		0x79, 0xa4, 0x29, 0x95, 0xbe, 0xae, 0x53, 0x2a, 0xf7, 0x81, 0x35, 0x7c, 0x33, 0x89, 0xec, 0x18,
-		0xdb, 0x1f, 0x72, 0x54, 0x76, 0xbb, 0xc6, 0xa9, 0x14, 0xef, 0xa8, 0x5c, 0x0b, 0xde, 0x6a, 0x37,
-		0x92, 0xac, 0xdf, 0x9f, 0xc7, 0x51, 0x79, 0x88, 0xa1, 0xf2, 0x2a, 0x8c, 0xb7, 0xa9, 0x53, 0xd9,
-		0xc2, 0xf5, 0x16, 0xfc, 0x1c, 0x85, 0x3f, 0x1f, 0xaa, 0x38, 0x25, 0x4d, 0x76, 0x58, 0xd8, 0x10,
-		0x8e, 0x36, 0x78, 0xb7, 0x3b, 0xca, 0xb5, 0x47, 0xba, 0x29, 0xd7, 0x76, 0xb4, 0xee, 0x0e, 0x72,
-		0x5a, 0x77, 0x5b, 0x07, 0xb1, 0xa1, 0xe4, 0xb9, 0x96, 0xfc, 0x01, 0x72, 0x2d, 0xc3, 0x07, 0xcb,
-		0xb5, 0x5c, 0x87, 0x9c, 0x86, 0x2b, 0xca, 0xbe, 0xab, 0x9a, 0xd1, 0x2d, 0xc6, 0x40, 0xa9, 0xa9,
-		0x2a, 0xa2, 0xe7, 0x61, 0xe0, 0xe3, 0x3a, 0x21, 0xde, 0xdf, 0x87, 0x34, 0x9b, 0x8b, 0x85, 0xcc,
-		0x39, 0x97, 0xbc, 0xc9, 0xed, 0xf6, 0xe0, 0x5a, 0x75, 0xa3, 0xac, 0x10, 0xd6, 0x5e, 0x1c, 0xd6,
-		0x7b, 0x0b, 0x94, 0x5e, 0xae, 0x1b, 0xcb, 0x44, 0x94, 0x23, 0x3a, 0xfa, 0x18, 0x72, 0x44, 0x63,
-		0x8f, 0x26, 0x47, 0xf4, 0x46, 0x1a, 0x2e, 0x24, 0xfd, 0x40, 0xf4, 0xc3, 0x77, 0xd6, 0x1b, 0x5e,
-		0xd4, 0xe5, 0xd6, 0x51, 0xaf, 0x24, 0xfe, 0xba, 0xd1, 0x17, 0x6c, 0xb5, 0xb9, 0x9d, 0x5e, 0xbf,
-		0xdb, 0xe1, 0x87, 0x14, 0x19, 0x41, 0x48, 0x71, 0x48, 0x99, 0xe6, 0xc2, 0x6f, 0x52, 0xb0, 0x90,
-		0xe4, 0xeb, 0x57, 0xe1, 0x7a, 0xf0, 0x63, 0x99, 0xd4, 0x41, 0x63, 0x99, 0xc3, 0x5a, 0x45, 0xfe,
-		0xec, 0xf6, 0x08, 0x66, 0xb7, 0xe5, 0xeb, 0x7a, 0xe3, 0x27, 0x9d, 0x3e, 0x48, 0x41, 0xc2, 0xef,
-		0x72, 0x3f, 0x1a, 0x93, 0xc9, 0x2b, 0x1a, 0xf6, 0x72, 0x8b, 0x86, 0xad, 0x6e, 0x97, 0x4c, 0xfc,
-		0x6e, 0x97, 0xc2, 0x3f, 0x52, 0x70, 0xee, 0x30, 0x3c, 0xca, 0x47, 0x74, 0xd2, 0xdb, 0xea, 0x39,
-		0x99, 0x04, 0xf5, 0x9c, 0xc2, 0x3f, 0x53, 0x70, 0x3e, 0xd1, 0x67, 0xd2, 0x4f, 0x26, 0xbe, 0x63,
-		0xe2, 0xbd, 0x04, 0x6d, 0x26, 0x49, 0x52, 0xff, 0xd3, 0x69, 0xd1, 0xc4, 0x8b, 0x3a, 0x94, 0x9e,
-		0x4c, 0x7c, 0x68, 0x83, 0x54, 0xa6, 0x9b, 0xef, 0x32, 0x7e, 0x91, 0x82, 0xc5, 0x84, 0x9f, 0xaf,
-		0x3f, 0x59, 0x07, 0xdf, 0x3a, 0xcc, 0x13, 0x18, 0xa2, 0x3f, 0xd7, 0xf5, 0x0a, 0xc1, 0x16, 0x7d,
-		0xd5, 0x71, 0x98, 0x58, 0xbb, 0xbf, 0x76, 0x6b, 0xb3, 0xbc, 0x5e, 0xda, 0xd8, 0x5c, 0x93, 0xcb,
-		0x9b, 0xff, 0x77, 0x67, 0xad, 0x5c, 0xba, 0x75, 0x7f, 0x79, 0xa3, 0x74, 0x33, 0xff, 0x14, 0x9a,
-		0x81, 0xa9, 0xce, 0xc7, 0xcb, 0x1b, 0x1b, 0x65, 0x7a, 0x37, 0x2f, 0xa1, 0x93, 0x70, 0xbc, 0x93,
-		0x60, 0x75, 0xe3, 0xf6, 0xdd, 0x35, 0x46, 0x92, 0x5a, 0x79, 0x15, 0x8e, 0xa9, 0x66, 0x95, 0x37,
-		0x07, 0x2b, 0xde, 0x1f, 0x20, 0xdf, 0x71, 0x22, 0xf3, 0x3b, 0xd2, 0xff, 0x5f, 0x7c, 0xa0, 0x93,
-		0x9d, 0xfa, 0x56, 0x51, 0x35, 0xab, 0x8b, 0xed, 0x7f, 0xc4, 0x7c, 0x5e, 0xd7, 0x2a, 0x8b, 0x0f,
-		0x4c, 0xf7, 0xcf, 0x9f, 0xd9, 0xbf, 0x32, 0xdf, 0x50, 0x6a, 0xfa, 0xde, 0xc5, 0xad, 0x0c, 0xbd,
-		0x77, 0xe9, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xc4, 0x77, 0x00, 0x78, 0x5a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5d, 0x6c, 0x1c, 0x4b,
+		0x56, 0xde, 0x9e, 0xb1, 0xc7, 0x9e, 0x33, 0x8e, 0x63, 0x57, 0x12, 0xc7, 0x8e, 0xf3, 0xe3, 0x4c,
+		0x72, 0x13, 0xdf, 0xfc, 0x8c, 0x13, 0x27, 0x37, 0xd9, 0xdc, 0xec, 0xbd, 0x8b, 0xed, 0xd8, 0xba,
+		0x23, 0x99, 0xc4, 0x74, 0x9c, 0x2c, 0xa0, 0x2b, 0x86, 0x76, 0x77, 0x39, 0xe9, 0xf5, 0x4c, 0xf7,
+		0x6c, 0x77, 0x8d, 0x27, 0x46, 0xf0, 0x04, 0x68, 0x25, 0xc4, 0x0a, 0x56, 0x2b, 0x24, 0x56, 0x42,
+		0x02, 0x21, 0x81, 0x58, 0x7e, 0xb4, 0x08, 0x84, 0x80, 0xe5, 0x85, 0x45, 0x42, 0x8b, 0x04, 0x5a,
+		0x78, 0xe2, 0x85, 0x57, 0x40, 0x0b, 0x0f, 0x88, 0x07, 0x96, 0x67, 0xd4, 0x55, 0xd5, 0x33, 0xd3,
+		0xdd, 0x55, 0xdd, 0xd5, 0x63, 0xfb, 0x5e, 0x56, 0x9b, 0x37, 0x77, 0xf5, 0xa9, 0xd3, 0x5f, 0x9d,
+		0x3a, 0xa7, 0xea, 0xd4, 0x39, 0xa7, 0xc6, 0x70, 0xb9, 0xb3, 0x83, 0xbd, 0x25, 0xd3, 0xb0, 0xb0,
+		0x63, 0xe2, 0x25, 0xa3, 0x6d, 0x2f, 0xed, 0xdf, 0x5d, 0x7a, 0x6d, 0xfb, 0xc4, 0xf5, 0x0e, 0x6a,
+		0x6d, 0xcf, 0x25, 0x2e, 0x3a, 0x15, 0x90, 0xd4, 0x38, 0x49, 0xcd, 0x68, 0xdb, 0xb5, 0xfd, 0xbb,
+		0xe7, 0x2e, 0xbe, 0x72, 0xdd, 0x57, 0x4d, 0xbc, 0x44, 0x49, 0x76, 0x3a, 0xbb, 0x4b, 0x56, 0xc7,
+		0x33, 0x88, 0xed, 0x3a, 0xac, 0xd3, 0xb9, 0x4b, 0xf1, 0xf7, 0xc4, 0x6e, 0x61, 0x9f, 0x18, 0xad,
+		0x36, 0x27, 0x58, 0x10, 0x7d, 0xd8, 0x74, 0x5b, 0xad, 0x1e, 0x8b, 0xaa, 0x88, 0x82, 0x18, 0xfe,
+		0x5e, 0xd3, 0xf6, 0x49, 0x1a, 0x4d, 0xd7, 0xf5, 0xf6, 0x76, 0x9b, 0x6e, 0x97, 0xd1, 0x54, 0x9f,
+		0xc0, 0xd8, 0x47, 0x6c, 0x40, 0xe8, 0x11, 0x94, 0xf0, 0x3e, 0x76, 0x88, 0x3f, 0xab, 0x2d, 0x14,
+		0x17, 0x2b, 0xcb, 0x97, 0x6b, 0x82, 0xb1, 0xd5, 0x38, 0xf5, 0x7a, 0x40, 0xa9, 0xf3, 0x0e, 0xd5,
+		0x6f, 0xad, 0xc2, 0xc4, 0xe0, 0x0b, 0x34, 0x07, 0xe3, 0xf4, 0x55, 0xc3, 0xb6, 0x66, 0xb5, 0x05,
+		0x6d, 0xb1, 0xa8, 0x8f, 0xd1, 0xe7, 0xba, 0x85, 0x1e, 0x01, 0xb0, 0x57, 0xc1, 0xa0, 0x67, 0x0b,
+		0x0b, 0xda, 0x62, 0x65, 0xf9, 0x5c, 0x8d, 0x49, 0xa4, 0x16, 0x4a, 0xa4, 0xb6, 0x1d, 0x4a, 0x44,
+		0x2f, 0x53, 0xea, 0xe0, 0x19, 0xcd, 0xc2, 0xd8, 0x3e, 0xf6, 0x7c, 0xdb, 0x75, 0x66, 0x8b, 0x8c,
+		0x29, 0x7f, 0x44, 0x67, 0x61, 0x2c, 0x18, 0x7c, 0xf0, 0xb9, 0x11, 0xfa, 0xa6, 0x14, 0x3c, 0xd6,
+		0x2d, 0xf4, 0x1b, 0x1a, 0xdc, 0x0c, 0x87, 0xdc, 0xc0, 0x6f, 0xb0, 0xd9, 0x09, 0xe6, 0xa1, 0xe1,
+		0x13, 0xc3, 0x23, 0xd8, 0x6a, 0x30, 0x24, 0x06, 0x21, 0x9e, 0xbd, 0xd3, 0x21, 0xd8, 0x9f, 0x1d,
+		0xa5, 0x78, 0x3e, 0x27, 0x1c, 0xfa, 0x17, 0x38, 0x9f, 0xf5, 0x90, 0xcd, 0x73, 0xc6, 0x85, 0x0e,
+		0x79, 0xa5, 0xc7, 0xe3, 0xa3, 0xcf, 0xe8, 0xd7, 0xbb, 0x6a, 0xa4, 0xe8, 0xb7, 0x35, 0xb8, 0x2d,
+		0x80, 0x67, 0xba, 0xad, 0x76, 0x13, 0x0b, 0x01, 0x96, 0x28, 0xc0, 0x0f, 0xd5, 0x00, 0xae, 0x85,
+		0x7c, 0x92, 0x10, 0xdf, 0xed, 0xaa, 0x12, 0xa3, 0xaf, 0x6b, 0x70, 0x43, 0x00, 0x72, 0xd7, 0xb0,
+		0x9b, 0x22, 0x84, 0x63, 0x14, 0xe1, 0x63, 0x35, 0x84, 0x1b, 0x94, 0x49, 0x12, 0xde, 0xb5, 0xae,
+		0x12, 0x25, 0xfa, 0x2d, 0xb1, 0x00, 0x03, 0xdd, 0xb2, 0x1a, 0x6e, 0x87, 0x24, 0xe1, 0x8d, 0x53,
+		0x78, 0x1f, 0xa8, 0xc1, 0x0b, 0xd4, 0xce, 0x7a, 0xd6, 0x21, 0x49, 0x80, 0x8b, 0x5d, 0x45, 0x5a,
+		0xf4, 0x35, 0x0d, 0x16, 0x2d, 0x6c, 0xda, 0x3e, 0x05, 0x16, 0x68, 0xa9, 0x6f, 0xbe, 0xc6, 0x56,
+		0x47, 0x28, 0xbc, 0x32, 0x45, 0xf7, 0x48, 0x88, 0xee, 0x09, 0x67, 0xb2, 0x6d, 0xf8, 0x7b, 0xcf,
+		0x43, 0x16, 0x49, 0x64, 0x57, 0x2d, 0x05, 0x3a, 0xf4, 0x15, 0x0d, 0xae, 0xc5, 0x50, 0xc9, 0x6c,
+		0x02, 0x28, 0xa6, 0x87, 0xd9, 0x98, 0x64, 0xe6, 0x50, 0xb5, 0x32, 0xa9, 0x04, 0x52, 0x4a, 0x31,
+		0x82, 0x8a, 0xa2, 0x94, 0x52, 0xf4, 0x3f, 0x22, 0x25, 0xa9, 0xea, 0x7f, 0x35, 0x81, 0x2a, 0x45,
+		0xb3, 0x26, 0x28, 0xaa, 0xcf, 0x66, 0xa2, 0x92, 0x2b, 0xd5, 0x15, 0x2b, 0x9b, 0x0c, 0xfd, 0x92,
+		0x06, 0xef, 0x44, 0x31, 0xc9, 0x2c, 0xf1, 0x04, 0x05, 0xf4, 0x20, 0x13, 0x90, 0xcc, 0x08, 0x2f,
+		0x5b, 0x59, 0x44, 0x74, 0xda, 0x0c, 0x93, 0xd8, 0xfb, 0x36, 0x39, 0xc8, 0x54, 0xee, 0xc9, 0x94,
+		0x69, 0x5b, 0xe1, 0x4c, 0xb2, 0x94, 0xdb, 0x50, 0xa0, 0xa3, 0xca, 0x1d, 0x43, 0x25, 0x53, 0xee,
+		0x93, 0x29, 0xca, 0x1d, 0xc1, 0x24, 0x55, 0x6e, 0x23, 0x93, 0x4a, 0x20, 0xa5, 0x14, 0xe5, 0x9e,
+		0x52, 0x94, 0x52, 0x9a, 0x72, 0x1b, 0x0a, 0x74, 0x54, 0x91, 0xa2, 0xa8, 0x64, 0x8a, 0x34, 0x9d,
+		0xa2, 0x48, 0x83, 0x90, 0xa4, 0x8a, 0x64, 0x64, 0x11, 0x51, 0x4b, 0x8b, 0x82, 0x49, 0xb1, 0x34,
+		0x94, 0x62, 0x69, 0x83, 0x78, 0x52, 0x2c, 0xcd, 0xc8, 0x26, 0x43, 0x5d, 0xb8, 0x18, 0x80, 0xf0,
+		0xe4, 0xda, 0x73, 0x8a, 0x02, 0xb9, 0x23, 0x04, 0x12, 0x70, 0xf5, 0xa4, 0x6a, 0x33, 0x4f, 0xe4,
+		0xaf, 0xd1, 0x97, 0xe0, 0x3c, 0xfb, 0xf0, 0xae, 0xed, 0x89, 0x3e, 0x7b, 0x9a, 0x7e, 0xb6, 0x26,
+		0xff, 0xec, 0x46, 0xd0, 0x2f, 0xf9, 0xd1, 0x39, 0x22, 0x7b, 0x89, 0x7e, 0x57, 0x83, 0xa5, 0x98,
+		0x8a, 0x1a, 0x8e, 0x89, 0x9b, 0x0d, 0x0f, 0x7f, 0xa9, 0x83, 0x7d, 0xe1, 0xe8, 0xcf, 0x50, 0x18,
+		0x9f, 0xcf, 0xd6, 0x54, 0xca, 0x49, 0x0f, 0x19, 0x25, 0x71, 0xdd, 0x30, 0x94, 0xa9, 0xd1, 0x9f,
+		0x6a, 0x70, 0x9f, 0x63, 0x0a, 0x21, 0xaa, 0x29, 0xf1, 0x0c, 0x45, 0xbb, 0x26, 0x44, 0xcb, 0xbf,
+		0xc6, 0x3e, 0xad, 0xa2, 0xd1, 0x35, 0x2f, 0x57, 0x0f, 0xf4, 0xab, 0x1a, 0x5c, 0x17, 0x89, 0x57,
+		0x04, 0xf4, 0xac, 0xa2, 0x76, 0xaf, 0x71, 0x0e, 0x19, 0xda, 0x2d, 0x21, 0x43, 0x3f, 0x03, 0x97,
+		0x98, 0x92, 0xc9, 0x91, 0xcc, 0x52, 0x24, 0x77, 0xe5, 0x7a, 0x26, 0x87, 0xc0, 0x14, 0x58, 0xf6,
+		0xed, 0x2f, 0x6b, 0x70, 0x95, 0x4f, 0x1e, 0x57, 0x74, 0xc9, 0xa4, 0xcd, 0x51, 0x04, 0xef, 0x09,
+		0x11, 0x30, 0xe6, 0x4c, 0xdf, 0x25, 0xd3, 0xb4, 0x60, 0x66, 0xd0, 0xa0, 0x9f, 0x83, 0x85, 0x96,
+		0xe1, 0xed, 0x61, 0xaf, 0xe1, 0x61, 0xd3, 0xf5, 0x2c, 0x11, 0x88, 0x73, 0x14, 0xc4, 0xb2, 0x10,
+		0xc4, 0x8f, 0xd2, 0xce, 0x3a, 0xef, 0x9b, 0x44, 0x70, 0xa1, 0x95, 0x46, 0x80, 0x7e, 0x53, 0x83,
+		0x5b, 0xa2, 0xf3, 0x89, 0xfd, 0xca, 0x31, 0x84, 0x02, 0x99, 0xcf, 0xe3, 0xbe, 0x3e, 0xe7, 0x6c,
+		0x54, 0xdc, 0x57, 0x09, 0x2d, 0xfa, 0x1d, 0x0d, 0x6a, 0x22, 0x0f, 0x1b, 0x7b, 0x2d, 0xdb, 0x31,
+		0x84, 0xeb, 0xc2, 0xf9, 0x94, 0x75, 0x21, 0xe9, 0x62, 0xf7, 0x18, 0x09, 0xd6, 0x85, 0xae, 0x32,
+		0x35, 0xfa, 0x33, 0x0d, 0xee, 0x8b, 0x8e, 0x52, 0x99, 0xab, 0xd8, 0x05, 0x8a, 0xf6, 0x89, 0xe2,
+		0x89, 0x2a, 0x6b, 0x29, 0x5b, 0xea, 0xe6, 0xeb, 0x22, 0xd3, 0x00, 0xb9, 0x51, 0x5e, 0xcc, 0xa3,
+		0x01, 0x72, 0x03, 0x5d, 0xec, 0x2a, 0xd2, 0xa2, 0x7f, 0xd5, 0x60, 0x3d, 0xb6, 0xe2, 0xe2, 0x37,
+		0x04, 0x7b, 0x8e, 0xd1, 0x6c, 0x08, 0x90, 0xdb, 0x8e, 0x4d, 0x6c, 0xb1, 0x62, 0x5c, 0xa2, 0xd0,
+		0x9f, 0x67, 0x2f, 0xc1, 0xeb, 0x9c, 0x7f, 0x62, 0x3c, 0xf5, 0x90, 0x79, 0x72, 0x40, 0x1f, 0x7a,
+		0x87, 0xe2, 0x80, 0xfe, 0x45, 0x83, 0xd5, 0x1c, 0xc3, 0x94, 0xad, 0x58, 0x0b, 0x74, 0x8c, 0x5b,
+		0x87, 0x18, 0xa3, 0x6c, 0x31, 0x7b, 0xec, 0x0d, 0xdf, 0x1d, 0x7d, 0x57, 0x83, 0x0f, 0xd2, 0x86,
+		0x93, 0x6d, 0x27, 0x97, 0xe9, 0xc0, 0x36, 0x85, 0x03, 0x93, 0x82, 0xc9, 0xb4, 0x97, 0x87, 0x78,
+		0xb8, 0xae, 0xd4, 0x0f, 0x10, 0x86, 0x4e, 0x1c, 0x62, 0x3b, 0x1d, 0x6c, 0x35, 0x0c, 0xbf, 0xe1,
+		0xe0, 0x6e, 0x72, 0x1c, 0xd5, 0x14, 0x3f, 0x40, 0x10, 0x41, 0xe1, 0xec, 0x56, 0xfc, 0xa7, 0xb8,
+		0x2b, 0xf0, 0x03, 0xba, 0xb9, 0x7a, 0xa0, 0xbf, 0xd1, 0xe0, 0x11, 0xf5, 0x26, 0x1b, 0xe6, 0x6b,
+		0xbb, 0x69, 0xe5, 0xb4, 0x9f, 0x2b, 0x14, 0xfa, 0x47, 0x42, 0xe8, 0xd4, 0x95, 0x5c, 0x0b, 0x98,
+		0xe6, 0x31, 0x9a, 0x7b, 0x7e, 0xfe, 0x6e, 0xe8, 0x2f, 0x35, 0x78, 0x90, 0x31, 0x08, 0x99, 0x75,
+		0x5c, 0xa5, 0x23, 0x58, 0xcf, 0x3b, 0x02, 0x99, 0x49, 0xdc, 0xf1, 0x73, 0xf6, 0x41, 0x7f, 0xa8,
+		0xc1, 0x5d, 0x29, 0x6a, 0xa9, 0x9f, 0xff, 0x0e, 0x85, 0xbd, 0x22, 0x76, 0x43, 0x84, 0x5f, 0x97,
+		0x3a, 0xfe, 0xb7, 0xcc, 0x1c, 0xf4, 0xe8, 0x4f, 0x34, 0xb8, 0x27, 0x85, 0x9b, 0x72, 0x88, 0xbc,
+		0x96, 0xa2, 0xe4, 0x62, 0xc0, 0x29, 0xc7, 0xc9, 0x9a, 0x99, 0xab, 0x07, 0xfa, 0x86, 0x06, 0x77,
+		0x72, 0x6b, 0xc6, 0x75, 0x8a, 0xf8, 0x47, 0x72, 0x20, 0x96, 0x29, 0xc5, 0x4d, 0x33, 0x87, 0x3e,
+		0x7c, 0x53, 0x83, 0x65, 0xb9, 0x80, 0xa5, 0x9b, 0xf0, 0x22, 0x45, 0xbb, 0x9a, 0x47, 0xbe, 0xd2,
+		0x9d, 0xf8, 0xb6, 0x99, 0xa7, 0x03, 0xfa, 0xe3, 0x34, 0x95, 0x48, 0x39, 0x34, 0xbf, 0x9b, 0x1b,
+		0xb2, 0xfc, 0xf8, 0x2c, 0x81, 0x2c, 0x3b, 0x48, 0x07, 0xbe, 0x99, 0x1c, 0x72, 0x8a, 0x27, 0x79,
+		0x23, 0xc5, 0x37, 0x93, 0x60, 0x4e, 0x71, 0x27, 0x97, 0xcc, 0x7c, 0x5d, 0xe8, 0xa6, 0xc9, 0x5c,
+		0xf1, 0x61, 0x3d, 0x9e, 0x9b, 0x29, 0x9b, 0x26, 0xf3, 0xb8, 0x87, 0x71, 0x75, 0x1e, 0xfa, 0xc3,
+		0x75, 0x45, 0x7f, 0xab, 0xc1, 0xfb, 0x0a, 0x03, 0x92, 0xd9, 0xe8, 0x2d, 0x3a, 0x9a, 0xfa, 0x30,
+		0xa3, 0x91, 0x19, 0xeb, 0x7d, 0x7f, 0x88, 0x7e, 0xe8, 0x2f, 0x34, 0x78, 0x2f, 0x6d, 0x00, 0xf2,
+		0xf3, 0xd3, 0xed, 0x94, 0x0d, 0x48, 0x0a, 0x42, 0x7e, 0x8e, 0xba, 0x83, 0x73, 0xf6, 0xa1, 0x0b,
+		0x4e, 0xa7, 0xed, 0x63, 0x8f, 0xf4, 0x81, 0xfb, 0xd8, 0xf0, 0xcc, 0xd7, 0x03, 0x30, 0x93, 0xb8,
+		0x6b, 0x29, 0xd6, 0xfb, 0x82, 0xb2, 0x0b, 0x11, 0x3c, 0xa7, 0xcc, 0xfa, 0x5f, 0x14, 0x58, 0x6f,
+		0x27, 0x4f, 0x07, 0x6a, 0x05, 0x22, 0x19, 0x27, 0x40, 0x77, 0xda, 0x96, 0xd8, 0x0a, 0x96, 0x52,
+		0xac, 0x20, 0x29, 0xb8, 0x18, 0x9c, 0x17, 0x8c, 0xab, 0xc0, 0x0a, 0xba, 0xc3, 0x75, 0x95, 0x25,
+		0xb4, 0xda, 0x46, 0xc7, 0x17, 0xa1, 0xbf, 0x93, 0x27, 0xa1, 0xb5, 0x45, 0x99, 0xa8, 0x24, 0xb4,
+		0x84, 0x94, 0xb2, 0xe3, 0x60, 0xc7, 0x91, 0xa1, 0xbb, 0x9b, 0xe7, 0x38, 0xf8, 0x82, 0xb3, 0x51,
+		0x39, 0x0e, 0x4a, 0x68, 0x05, 0x61, 0x63, 0x19, 0xb4, 0x65, 0xc5, 0xb0, 0xb1, 0x4c, 0x66, 0x91,
+		0xb0, 0xb1, 0x58, 0x5c, 0xc9, 0xb8, 0x9a, 0x5c, 0x52, 0xf7, 0x14, 0xe3, 0x6a, 0x72, 0x21, 0x45,
+		0xe2, 0x6a, 0x32, 0xf9, 0x04, 0x2e, 0x9b, 0x68, 0x06, 0xa9, 0x2e, 0x36, 0x0c, 0xd3, 0xc4, 0x6d,
+		0xa1, 0x91, 0xdc, 0xcf, 0x73, 0x2e, 0x61, 0x9a, 0xbd, 0xc2, 0x99, 0xa9, 0x9c, 0x4b, 0x52, 0x7b,
+		0x64, 0x80, 0xf6, 0xf0, 0x17, 0xb1, 0x29, 0x04, 0xfd, 0x5e, 0x7e, 0xd0, 0x3a, 0x67, 0xa6, 0x0e,
+		0x5a, 0xd2, 0x43, 0x16, 0xf2, 0xe1, 0xa0, 0x53, 0xbc, 0xe3, 0x07, 0x79, 0x42, 0x3e, 0x0c, 0x43,
+		0x8a, 0x7b, 0xbc, 0xd4, 0xcd, 0xd7, 0x65, 0x75, 0x02, 0xa0, 0x0f, 0xa6, 0xfa, 0xbd, 0x29, 0xb8,
+		0xae, 0x7a, 0x1c, 0xd8, 0x80, 0x13, 0xbd, 0x01, 0x93, 0x83, 0x36, 0xa6, 0xc5, 0x15, 0xb2, 0x52,
+		0x8d, 0x90, 0xe9, 0xf6, 0x41, 0x1b, 0xeb, 0x13, 0xdd, 0x81, 0x27, 0xf4, 0x31, 0x9c, 0x69, 0x1b,
+		0x5e, 0x20, 0x95, 0x41, 0x2f, 0x66, 0xd7, 0xe5, 0xf5, 0x18, 0x8b, 0x42, 0x7e, 0x5b, 0xb4, 0xc7,
+		0x80, 0x93, 0xb1, 0xeb, 0xea, 0xa7, 0xda, 0xc9, 0x46, 0xf4, 0x3e, 0x94, 0xa9, 0x29, 0x36, 0x6d,
+		0x9f, 0xd0, 0x4a, 0x8d, 0xca, 0xf2, 0x05, 0x71, 0x0c, 0xd9, 0xf0, 0xf7, 0x36, 0x6d, 0x9f, 0xe8,
+		0xe3, 0x84, 0xff, 0x85, 0x96, 0x61, 0xd4, 0x76, 0xda, 0x1d, 0x42, 0xeb, 0x38, 0x2a, 0xcb, 0xe7,
+		0x25, 0x48, 0x0e, 0x9a, 0xae, 0x61, 0xe9, 0x8c, 0x14, 0x19, 0xb0, 0x10, 0x3b, 0xc3, 0x35, 0x88,
+		0xdb, 0x30, 0x9b, 0xae, 0x8f, 0xa9, 0x43, 0xec, 0x76, 0x08, 0x2f, 0xec, 0x98, 0x4b, 0x14, 0x9a,
+		0x3c, 0xe1, 0xa5, 0x39, 0xfa, 0x79, 0x1c, 0x91, 0xfd, 0xb6, 0xbb, 0x16, 0xf4, 0xdf, 0x66, 0xdd,
+		0xd1, 0x17, 0x60, 0xbe, 0x9f, 0x47, 0x4c, 0x72, 0x2f, 0x65, 0x71, 0x3f, 0x4b, 0xc2, 0xec, 0x60,
+		0x8c, 0xf1, 0x63, 0x38, 0xd7, 0x0f, 0x59, 0xf4, 0x47, 0xe1, 0x75, 0x9c, 0x86, 0x6d, 0xd1, 0x5a,
+		0x8a, 0xb2, 0x7e, 0xb6, 0x47, 0xd1, 0x93, 0xb3, 0xde, 0x71, 0xea, 0x16, 0xaa, 0x43, 0x99, 0xfb,
+		0x9e, 0xae, 0x47, 0x0b, 0x1b, 0x26, 0x97, 0x6f, 0x8a, 0x7d, 0x65, 0xce, 0x80, 0xc6, 0x24, 0xea,
+		0x61, 0x17, 0xbd, 0xdf, 0x1b, 0xd5, 0x61, 0xba, 0x8f, 0x23, 0xf0, 0xff, 0x3a, 0x1e, 0xe6, 0xd5,
+		0x08, 0xe2, 0x39, 0xd8, 0x60, 0x34, 0xfa, 0x54, 0xaf, 0x1b, 0x6f, 0x41, 0x3a, 0xcc, 0x34, 0x0d,
+		0x9f, 0x84, 0x06, 0x48, 0x87, 0x83, 0xfd, 0x4e, 0x93, 0xf0, 0x4a, 0x82, 0xf4, 0x39, 0x3d, 0x1d,
+		0xf4, 0x5d, 0xeb, 0x75, 0xd5, 0x69, 0x4f, 0xf4, 0x08, 0xe6, 0x5c, 0xcf, 0x7e, 0x65, 0x33, 0xcf,
+		0x35, 0x26, 0xa5, 0x0a, 0x95, 0xd2, 0x4c, 0x48, 0x10, 0x13, 0xd2, 0x39, 0x18, 0xb7, 0x2d, 0xec,
+		0x10, 0x9b, 0x1c, 0xd0, 0x14, 0x7d, 0x59, 0xef, 0x3d, 0xa3, 0x7b, 0x30, 0xb3, 0x6b, 0x7b, 0x3e,
+		0x49, 0xf2, 0x3c, 0x41, 0x29, 0x4f, 0xd1, 0xb7, 0x31, 0x86, 0x6b, 0x30, 0xe1, 0x61, 0xe2, 0x1d,
+		0x34, 0xda, 0x6e, 0xd3, 0x36, 0x0f, 0x78, 0x5a, 0x7b, 0x41, 0x12, 0xf1, 0x23, 0xde, 0xc1, 0x16,
+		0xa5, 0xd3, 0x2b, 0x5e, 0xff, 0x01, 0xcd, 0xc2, 0x98, 0x41, 0x08, 0x6e, 0xb5, 0x09, 0x4d, 0x41,
+		0x8f, 0xea, 0xe1, 0x23, 0x5a, 0x83, 0x93, 0xf8, 0x4d, 0xdb, 0x66, 0x8a, 0xc3, 0xaa, 0xa4, 0xa6,
+		0x32, 0xab, 0xa4, 0x26, 0xfb, 0x5d, 0x68, 0xa9, 0xd4, 0x15, 0x38, 0x61, 0x7a, 0x81, 0x35, 0xf0,
+		0x14, 0x39, 0x4d, 0xe1, 0x96, 0xf5, 0x89, 0xa0, 0x31, 0x4c, 0x9b, 0xa3, 0x1f, 0x87, 0x79, 0x36,
+		0xfa, 0x68, 0x39, 0xc1, 0x8e, 0x61, 0xee, 0xb9, 0xbb, 0xbb, 0x3c, 0xcb, 0x9a, 0xa2, 0xd4, 0xb3,
+		0xb4, 0xf7, 0x60, 0x25, 0xc1, 0x2a, 0xeb, 0x8a, 0x6e, 0xc3, 0x48, 0x0b, 0xb7, 0x5c, 0x9e, 0x1f,
+		0x9d, 0x13, 0x67, 0x4e, 0x70, 0xcb, 0xd5, 0x29, 0x19, 0xd2, 0x61, 0x3a, 0xe1, 0x4d, 0xf2, 0x24,
+		0xe7, 0x3b, 0xe2, 0xc3, 0x46, 0xcc, 0xd1, 0xd3, 0xa7, 0xfc, 0x58, 0x0b, 0x7a, 0x01, 0x33, 0x6d,
+		0x0f, 0xef, 0x37, 0x8c, 0x0e, 0x71, 0x03, 0xfd, 0xc3, 0xa4, 0xd1, 0x76, 0x6d, 0x87, 0x84, 0x69,
+		0x4b, 0xd9, 0x7c, 0xf9, 0x98, 0x6c, 0x51, 0x3a, 0xfd, 0x54, 0xd0, 0x7f, 0xa5, 0x43, 0xdc, 0x81,
+		0x46, 0x74, 0x0f, 0x4a, 0xaf, 0xb1, 0x61, 0x61, 0x8f, 0xe7, 0x13, 0xe7, 0xc5, 0x55, 0x72, 0x94,
+		0x44, 0xe7, 0xa4, 0x68, 0x13, 0x4e, 0x33, 0x41, 0xf7, 0x8b, 0x23, 0xe8, 0xbc, 0x9e, 0xcd, 0x9c,
+		0x57, 0x44, 0xfb, 0xf5, 0x0a, 0x1d, 0xe8, 0xdc, 0xfe, 0x2c, 0x4c, 0xb5, 0x0d, 0x8f, 0xd8, 0x61,
+		0xbc, 0x73, 0xd7, 0x7e, 0x35, 0x3b, 0x4b, 0x4b, 0xf6, 0x7e, 0xec, 0x30, 0x75, 0x6b, 0xc1, 0xfa,
+		0xce, 0x98, 0xae, 0x51, 0x9e, 0xeb, 0x0e, 0xf1, 0x0e, 0xf4, 0x93, 0xed, 0x68, 0x2b, 0xba, 0x00,
+		0x10, 0x46, 0xc9, 0x6d, 0x8b, 0xe6, 0xe7, 0xca, 0x7a, 0x99, 0xb7, 0xd4, 0x2d, 0xf4, 0x12, 0x4e,
+		0x51, 0xc5, 0x73, 0xf7, 0xb1, 0xd7, 0x34, 0xda, 0xa1, 0x8d, 0x9c, 0xa3, 0x8b, 0xd3, 0x35, 0xf1,
+		0xe2, 0xe4, 0xb9, 0xce, 0x33, 0x46, 0xce, 0x2d, 0x65, 0xda, 0x8c, 0x37, 0xa1, 0x37, 0x70, 0x89,
+		0x3a, 0x5f, 0xb8, 0x61, 0x36, 0x3b, 0x3e, 0xc1, 0x5e, 0xc3, 0xc7, 0x4d, 0x6c, 0x32, 0xc7, 0x9d,
+		0x7d, 0x63, 0x3e, 0x25, 0x5b, 0x49, 0xfd, 0x3b, 0xbc, 0xc6, 0xba, 0x3e, 0x0f, 0x7b, 0xf2, 0xcf,
+		0x9d, 0x37, 0x52, 0xde, 0xa2, 0x0f, 0x00, 0xa8, 0xa3, 0xc7, 0x36, 0x48, 0x96, 0xdb, 0xba, 0x28,
+		0x59, 0xc2, 0x3a, 0x3e, 0xa6, 0xdb, 0x62, 0xb9, 0x1d, 0xfe, 0x19, 0x6c, 0xd9, 0xcc, 0x49, 0xf6,
+		0x6c, 0xd7, 0x0b, 0xd6, 0xa0, 0x0b, 0x54, 0x14, 0x97, 0xa5, 0x1b, 0xe2, 0x16, 0x27, 0xd4, 0x27,
+		0xc8, 0xc0, 0xd3, 0xb9, 0x55, 0x38, 0x2d, 0x9a, 0x20, 0x34, 0x05, 0xc5, 0x3d, 0x7c, 0x40, 0x1d,
+		0x81, 0xb2, 0x1e, 0xfc, 0x89, 0x4e, 0xc3, 0xe8, 0xbe, 0xd1, 0xec, 0xb0, 0xe2, 0xca, 0xb2, 0xce,
+		0x1e, 0xde, 0x2f, 0x7c, 0x56, 0xab, 0x7e, 0x43, 0x83, 0x77, 0xd5, 0xc3, 0x78, 0xf7, 0xa1, 0xc4,
+		0xd7, 0x6d, 0x4d, 0x61, 0xdd, 0xe6, 0xb4, 0x68, 0x03, 0x16, 0xd2, 0xeb, 0xb8, 0x6c, 0x8b, 0x02,
+		0x2b, 0xea, 0xe7, 0xe5, 0x25, 0x58, 0x75, 0xab, 0xfa, 0x7b, 0x1a, 0x5c, 0x53, 0x8c, 0x06, 0x3c,
+		0x80, 0xb1, 0x70, 0xc7, 0xd2, 0x14, 0x76, 0xac, 0x90, 0xf8, 0xc8, 0xa0, 0xba, 0xb0, 0xa8, 0x1c,
+		0x0a, 0x5b, 0x83, 0x09, 0xee, 0x34, 0xf4, 0x1d, 0xb8, 0x49, 0xc9, 0x62, 0xc4, 0x7d, 0x04, 0xea,
+		0xbf, 0x55, 0x48, 0xff, 0xa1, 0xfa, 0x0f, 0x1a, 0x5c, 0x55, 0xa9, 0x06, 0x8c, 0x7a, 0x62, 0x5a,
+		0x3e, 0x4f, 0xec, 0x29, 0xcc, 0x48, 0xbc, 0x9d, 0x42, 0xd6, 0xc6, 0x70, 0xca, 0x17, 0x78, 0x3a,
+		0x03, 0x3b, 0x5e, 0x31, 0xb2, 0xe3, 0x55, 0xbf, 0xa2, 0x41, 0x35, 0xbb, 0x90, 0x10, 0xdd, 0x02,
+		0x14, 0x2f, 0x2e, 0xeb, 0x95, 0x17, 0x4f, 0xf9, 0x11, 0x11, 0xc4, 0xb6, 0xfd, 0x42, 0x6c, 0xdb,
+		0x8f, 0xae, 0x61, 0xc5, 0xd8, 0x1a, 0x56, 0xfd, 0xef, 0x98, 0x78, 0xa5, 0x16, 0x92, 0x0f, 0xd1,
+		0x22, 0x4c, 0x45, 0x13, 0x0c, 0x3d, 0xf5, 0x9a, 0xf4, 0x07, 0x46, 0x1c, 0xc3, 0x5e, 0x8c, 0x61,
+		0xbf, 0x0e, 0x27, 0x77, 0x6c, 0xc7, 0xf0, 0x0e, 0x1a, 0xe6, 0x6b, 0x6c, 0xee, 0xf9, 0x9d, 0x16,
+		0x75, 0x95, 0xcb, 0xfa, 0x24, 0x6b, 0x5e, 0xe3, 0xad, 0xe8, 0x26, 0x4c, 0x47, 0xd3, 0x62, 0xf8,
+		0x0d, 0x73, 0x83, 0x27, 0xf4, 0x29, 0x3c, 0x98, 0xad, 0xc2, 0x6f, 0x48, 0xf5, 0x0f, 0x8a, 0x70,
+		0x45, 0xa1, 0x46, 0xf1, 0xd8, 0x46, 0x1c, 0x37, 0x8b, 0xe2, 0x10, 0x66, 0x81, 0x2e, 0x42, 0x65,
+		0xc7, 0xf0, 0x71, 0xe8, 0xc2, 0x31, 0xb1, 0x94, 0x83, 0x26, 0xe6, 0xb8, 0x9d, 0x07, 0x70, 0x70,
+		0x37, 0x7c, 0x3d, 0xca, 0x04, 0xeb, 0xe0, 0x2e, 0x7b, 0x7b, 0x0b, 0xd0, 0xae, 0xeb, 0xed, 0x71,
+		0xa4, 0x61, 0xa1, 0x79, 0x89, 0x0d, 0x2d, 0x78, 0x43, 0xb1, 0xbe, 0xe4, 0x15, 0xe7, 0x33, 0xc1,
+		0xe2, 0x68, 0xf8, 0xae, 0xc3, 0x7d, 0x74, 0xfe, 0x84, 0x9e, 0xc0, 0xa8, 0x19, 0xac, 0xfd, 0xdc,
+		0x1d, 0xaf, 0x29, 0x57, 0x83, 0xae, 0x05, 0xbd, 0x74, 0xd6, 0x39, 0xa6, 0xa0, 0xe5, 0xb8, 0x82,
+		0x7e, 0xa7, 0x08, 0x97, 0x33, 0x0b, 0x38, 0x8f, 0x6d, 0xae, 0x56, 0xc3, 0x21, 0xb2, 0x49, 0xba,
+		0xa5, 0x58, 0x5f, 0x1a, 0x19, 0xe0, 0xc0, 0x92, 0x3d, 0x92, 0x67, 0xc9, 0x1e, 0xb4, 0x8c, 0xd1,
+		0x98, 0x65, 0xc4, 0xa6, 0xbf, 0x94, 0x3e, 0xfd, 0x63, 0x4a, 0xd3, 0x3f, 0x2e, 0x99, 0x7e, 0x81,
+		0x15, 0x96, 0x85, 0x56, 0x18, 0x9d, 0x49, 0x88, 0xcf, 0xe4, 0xbf, 0x95, 0xe0, 0xaa, 0x4a, 0xe9,
+		0x2b, 0xba, 0x04, 0x95, 0x5e, 0x9c, 0x8b, 0xcf, 0x62, 0x59, 0x87, 0xb0, 0xa9, 0x6e, 0x05, 0x7e,
+		0x46, 0x3f, 0x10, 0x16, 0x98, 0x50, 0x21, 0x25, 0x34, 0xd0, 0xfb, 0x24, 0x0d, 0x0d, 0x18, 0x03,
+		0x4f, 0x81, 0x62, 0x5b, 0x6e, 0xcb, 0xb0, 0x1d, 0xbe, 0xf2, 0xf0, 0xa7, 0xe8, 0x56, 0x32, 0x32,
+		0xe4, 0xa1, 0xbe, 0xa4, 0x7e, 0xa8, 0xdf, 0x86, 0xb9, 0x50, 0x47, 0x93, 0x3b, 0xd0, 0x58, 0xd6,
+		0x0e, 0x34, 0x13, 0xf6, 0x8d, 0x6d, 0x42, 0x31, 0xae, 0x7c, 0x83, 0xe3, 0x5c, 0xc7, 0x73, 0x70,
+		0x65, 0x67, 0x79, 0xce, 0x55, 0xbe, 0x55, 0x96, 0x87, 0xda, 0x2a, 0x37, 0x60, 0xfa, 0x35, 0x36,
+		0x3c, 0xb2, 0x83, 0x8d, 0x3e, 0x3a, 0xc8, 0x62, 0x35, 0xd5, 0xeb, 0xd3, 0xe7, 0x93, 0xed, 0xe0,
+		0x54, 0xb2, 0x1d, 0x9c, 0xc4, 0x89, 0x77, 0x62, 0x98, 0x13, 0x6f, 0xff, 0xe4, 0x74, 0x42, 0xfd,
+		0xe4, 0x94, 0xf0, 0x9e, 0x27, 0x87, 0xf2, 0x9e, 0xab, 0xdf, 0xd3, 0xa0, 0x9a, 0x5d, 0xce, 0xfd,
+		0x89, 0xb9, 0x18, 0x83, 0xce, 0xd0, 0x48, 0xf4, 0xf8, 0xff, 0x79, 0x98, 0xa0, 0xd1, 0x93, 0x70,
+		0x79, 0x1c, 0x55, 0x58, 0x1e, 0x2b, 0x41, 0x0f, 0xfe, 0x50, 0xfd, 0x27, 0x2d, 0xba, 0xa4, 0x1c,
+		0xb1, 0x7f, 0x2f, 0x16, 0x51, 0x21, 0xc7, 0xae, 0x52, 0xcc, 0xf4, 0x79, 0x46, 0xa2, 0xc2, 0xac,
+		0xfe, 0xa3, 0x06, 0x97, 0xb3, 0x6b, 0x6c, 0x87, 0x3d, 0x06, 0x7c, 0x1a, 0x23, 0xfa, 0xab, 0x02,
+		0x5c, 0x51, 0xa8, 0x54, 0x0f, 0xc6, 0x64, 0x61, 0x62, 0xd8, 0x4d, 0x5f, 0x69, 0x92, 0x42, 0xe2,
+		0x63, 0x1b, 0x53, 0xdc, 0x4f, 0x1b, 0x19, 0xc6, 0x4f, 0x3b, 0xb4, 0x8a, 0xff, 0x9a, 0x06, 0x37,
+		0xd4, 0x0b, 0xcc, 0x55, 0xf6, 0xce, 0xa3, 0x39, 0x08, 0x7e, 0x53, 0x83, 0x9c, 0xa5, 0xe4, 0xd9,
+		0xd8, 0x4e, 0x87, 0xde, 0x16, 0x3f, 0xcd, 0x33, 0xff, 0x49, 0x05, 0x71, 0x51, 0x01, 0xf1, 0xd7,
+		0x63, 0x7a, 0x28, 0x2b, 0x3a, 0x19, 0x56, 0x0f, 0x37, 0x60, 0xa1, 0x69, 0x90, 0x81, 0x92, 0xca,
+		0x78, 0x81, 0x61, 0x5f, 0xb2, 0x8c, 0x4e, 0x34, 0x95, 0xcc, 0x3b, 0x13, 0xe8, 0x73, 0x31, 0x87,
+		0x3e, 0x8f, 0x64, 0xda, 0x68, 0xcc, 0x9f, 0xac, 0x7e, 0x57, 0x83, 0xf9, 0x94, 0x4b, 0x1c, 0x68,
+		0x0e, 0xc6, 0x59, 0xf1, 0x7a, 0x6f, 0xde, 0xc6, 0xe8, 0x73, 0xdd, 0x42, 0x9b, 0x70, 0xa6, 0xe7,
+		0x10, 0xec, 0xda, 0x5e, 0x8e, 0xa3, 0x33, 0xe2, 0xfe, 0xc0, 0x86, 0xed, 0xe1, 0x3c, 0xdb, 0xb8,
+		0xca, 0x64, 0xff, 0x34, 0xcc, 0x49, 0x6f, 0x87, 0xa4, 0x8d, 0x46, 0xf9, 0x68, 0x50, 0xfd, 0x8e,
+		0x06, 0xe7, 0xd3, 0x2e, 0x06, 0x1c, 0xc9, 0x57, 0x8e, 0x4a, 0x1e, 0xa9, 0x0b, 0xf4, 0x9f, 0x6b,
+		0xb0, 0x90, 0x75, 0xc1, 0x20, 0x6d, 0x34, 0xc7, 0x6a, 0xb6, 0xe9, 0x9b, 0x65, 0x19, 0x72, 0xd6,
+		0xb1, 0xa2, 0x25, 0x38, 0x4d, 0x4b, 0x65, 0xe3, 0x49, 0x10, 0x36, 0xa6, 0x69, 0x07, 0x77, 0x63,
+		0x29, 0x90, 0x44, 0x1e, 0xb2, 0x30, 0x5c, 0x1e, 0xf2, 0x6d, 0xa6, 0x50, 0x3d, 0x53, 0xa8, 0xa2,
+		0x3b, 0x63, 0x0a, 0xba, 0xf3, 0x0c, 0x66, 0x78, 0x86, 0x87, 0x63, 0xb4, 0x1d, 0x82, 0xbd, 0x7d,
+		0xa3, 0x99, 0x7d, 0xfe, 0x39, 0xcd, 0x3b, 0x52, 0x78, 0x75, 0xde, 0x2d, 0x9a, 0x85, 0x2c, 0x1f,
+		0x2a, 0x0b, 0x39, 0xe0, 0xc2, 0x41, 0x1e, 0x17, 0x4e, 0x9e, 0x72, 0xac, 0x0c, 0x9d, 0x72, 0xec,
+		0x9f, 0x57, 0x26, 0xd4, 0xcf, 0x2b, 0x61, 0xe2, 0xeb, 0xc4, 0x21, 0x12, 0x5f, 0x93, 0x87, 0x4b,
+		0x7c, 0x49, 0x32, 0x30, 0x27, 0x3f, 0x81, 0x0c, 0xcc, 0xd4, 0xb1, 0x64, 0x60, 0xaa, 0xff, 0xae,
+		0xc1, 0x52, 0xde, 0xeb, 0x01, 0xbd, 0xf5, 0x57, 0x1b, 0x5c, 0x7f, 0xd3, 0x4e, 0x6c, 0x3b, 0x70,
+		0xb6, 0x57, 0x52, 0x18, 0xab, 0x8a, 0x60, 0x2b, 0xd3, 0x8d, 0xd4, 0xa2, 0xc1, 0x68, 0x5d, 0xc4,
+		0x19, 0x2c, 0x6a, 0x8e, 0x9d, 0x0a, 0x47, 0xe2, 0xd1, 0xa0, 0xdf, 0xd7, 0x04, 0x99, 0x04, 0xd9,
+		0x56, 0xaa, 0xb2, 0x1e, 0x68, 0x0a, 0xeb, 0xc1, 0x80, 0x6b, 0x57, 0xc8, 0xe1, 0xda, 0x55, 0xbf,
+		0xaf, 0xc1, 0x85, 0xd4, 0xdb, 0x6f, 0x81, 0x6f, 0xcb, 0xef, 0xd6, 0x39, 0x46, 0x2b, 0x9c, 0x09,
+		0x60, 0x4d, 0x4f, 0x8d, 0x16, 0x1e, 0xf6, 0xd3, 0x47, 0xb6, 0x8d, 0xf6, 0x4d, 0x7c, 0x44, 0xd9,
+		0xc4, 0xab, 0xdf, 0x12, 0x4d, 0x92, 0xac, 0xda, 0xf3, 0x12, 0x54, 0x78, 0xbd, 0xed, 0xa0, 0x08,
+		0x58, 0x13, 0x15, 0x41, 0x6f, 0x17, 0x2b, 0xa8, 0xef, 0x62, 0x69, 0xe9, 0x81, 0x0c, 0x0d, 0xfb,
+		0x75, 0x0d, 0x6e, 0xe4, 0x28, 0x80, 0xee, 0x47, 0xb9, 0xb5, 0x48, 0x94, 0x7b, 0xd8, 0x89, 0x4b,
+		0x41, 0x5e, 0xfd, 0x76, 0x01, 0x3e, 0x3c, 0xdc, 0x25, 0xb0, 0x23, 0x33, 0x89, 0x7e, 0x0c, 0xb4,
+		0x10, 0x89, 0x81, 0xbe, 0x00, 0x94, 0xac, 0x37, 0xe3, 0xab, 0xc3, 0x35, 0xb5, 0xdc, 0xbb, 0x3e,
+		0x9d, 0xa8, 0x16, 0x43, 0xb3, 0x30, 0x66, 0xba, 0x0e, 0xf1, 0xdc, 0x26, 0x9d, 0xb0, 0x09, 0x3d,
+		0x7c, 0x44, 0x35, 0x38, 0x15, 0xab, 0x9b, 0x77, 0x9d, 0x26, 0x3b, 0xa9, 0x8c, 0xeb, 0xd3, 0x91,
+		0x72, 0xf6, 0x67, 0x4e, 0xf3, 0xa0, 0xfa, 0xb5, 0x22, 0x3c, 0x3e, 0xc4, 0x25, 0x33, 0xf4, 0x62,
+		0x70, 0xd5, 0x9c, 0x94, 0x5c, 0xe1, 0x54, 0xe2, 0x1c, 0x89, 0xf6, 0x1f, 0xd1, 0xf9, 0x5a, 0x1a,
+		0x9b, 0x16, 0xcf, 0xcb, 0xc8, 0x61, 0xe7, 0xe5, 0x16, 0xa0, 0x78, 0x69, 0x3f, 0xcf, 0x1b, 0x15,
+		0xf5, 0x29, 0x3b, 0xa2, 0x84, 0x2c, 0xa4, 0x17, 0xce, 0x62, 0x29, 0x32, 0x8b, 0xd5, 0x7f, 0xd6,
+		0xe0, 0xe1, 0x90, 0x37, 0xe4, 0x24, 0x18, 0x34, 0x09, 0x86, 0x4f, 0x56, 0x71, 0xab, 0xbf, 0x52,
+		0x84, 0x87, 0x43, 0xde, 0x62, 0xf8, 0x41, 0xb5, 0xd5, 0xd8, 0x82, 0x3e, 0x22, 0x5f, 0xd0, 0x47,
+		0xd5, 0x17, 0x74, 0xa9, 0xea, 0xc8, 0x16, 0x80, 0x31, 0xd9, 0x02, 0xf0, 0xcb, 0x45, 0xb8, 0x3f,
+		0xcc, 0x4d, 0x0c, 0x35, 0xcb, 0x57, 0xe2, 0xfc, 0xd6, 0xf2, 0xfb, 0x96, 0xff, 0x1f, 0x1a, 0xdc,
+		0xc9, 0x7b, 0xab, 0xe4, 0xff, 0xb5, 0xc9, 0xcb, 0xf7, 0xaa, 0xea, 0xdf, 0x6b, 0x70, 0x3b, 0xd7,
+		0x4d, 0x94, 0x23, 0x5b, 0x02, 0x84, 0xa7, 0xa8, 0xc2, 0xa1, 0x4e, 0x51, 0xd5, 0x6f, 0x6b, 0xf0,
+		0x70, 0xc8, 0xab, 0x29, 0x62, 0x3c, 0xda, 0xe1, 0x4e, 0x75, 0x7d, 0x4f, 0xac, 0x10, 0xf1, 0xc4,
+		0xd2, 0x3c, 0xaa, 0x8f, 0x05, 0x15, 0x54, 0xe2, 0x6b, 0x14, 0x32, 0x3f, 0x2f, 0xe5, 0xbc, 0x54,
+		0xfd, 0x29, 0x81, 0x1b, 0x2c, 0xbb, 0x13, 0x31, 0x0c, 0xff, 0x5f, 0x8c, 0x25, 0x7d, 0xc4, 0xc8,
+		0xf3, 0x65, 0xec, 0x86, 0x91, 0xe2, 0x97, 0xb5, 0x68, 0x88, 0x5c, 0x36, 0xc6, 0xe3, 0x47, 0xf2,
+		0x9f, 0x1a, 0xe4, 0xbc, 0x09, 0x82, 0xe6, 0xa1, 0xcc, 0xaf, 0x43, 0xf4, 0xc2, 0x79, 0xe3, 0xac,
+		0xa1, 0x6e, 0x05, 0x7b, 0x19, 0x7f, 0x49, 0xf7, 0x32, 0x06, 0x04, 0x58, 0x53, 0x74, 0x2f, 0x2b,
+		0xaa, 0xef, 0x65, 0x2a, 0x46, 0x3d, 0xa2, 0x10, 0xac, 0xfe, 0x2f, 0xf9, 0x60, 0x65, 0xf7, 0x41,
+		0x0e, 0x37, 0xd8, 0x81, 0xd8, 0x53, 0xf1, 0xa8, 0xab, 0x08, 0x55, 0x06, 0xfc, 0x0b, 0x05, 0x41,
+		0x94, 0x23, 0xfd, 0x26, 0x49, 0xfa, 0x88, 0xfb, 0xf9, 0xdd, 0x42, 0x8e, 0xfc, 0xee, 0xa7, 0x2d,
+		0x86, 0x3f, 0xaa, 0xc0, 0xbd, 0x21, 0x7e, 0xcb, 0x60, 0x60, 0x1f, 0xd4, 0x22, 0xfb, 0xe0, 0x25,
+		0xa8, 0xf4, 0xf6, 0x41, 0xee, 0x6c, 0x94, 0x75, 0x08, 0x9b, 0x44, 0xb1, 0xec, 0xe2, 0x11, 0xc4,
+		0xb2, 0x87, 0x2d, 0x90, 0x19, 0x3d, 0xda, 0x58, 0x76, 0xe9, 0x58, 0x63, 0xd9, 0x63, 0x43, 0xc7,
+		0xb2, 0x5f, 0x02, 0xbf, 0x38, 0xc4, 0x39, 0xf2, 0xf8, 0xe1, 0x78, 0x4a, 0x8c, 0x92, 0xdd, 0x3e,
+		0xa2, 0x5c, 0xc2, 0x18, 0x65, 0x3b, 0xde, 0x34, 0xe8, 0x9d, 0x94, 0xa3, 0x8e, 0xb4, 0x8a, 0x7a,
+		0x82, 0x82, 0xaf, 0x61, 0xc2, 0xec, 0x80, 0x3a, 0x35, 0x3c, 0xdc, 0xe9, 0xc3, 0xaf, 0x50, 0xf8,
+		0x37, 0x52, 0x15, 0xa7, 0x6e, 0xe9, 0x41, 0x17, 0x3e, 0x84, 0x33, 0x5d, 0x51, 0x73, 0xa2, 0xde,
+		0xe6, 0xc4, 0x30, 0xf5, 0x36, 0x89, 0x2b, 0x20, 0x93, 0x82, 0x2b, 0x20, 0xfd, 0x08, 0xd8, 0xc9,
+		0xfc, 0x41, 0xee, 0xa9, 0x43, 0x04, 0xb9, 0xa7, 0x0f, 0xe7, 0x0e, 0xbd, 0x0f, 0x15, 0x0b, 0x37,
+		0x8d, 0x03, 0xa6, 0x9a, 0xd9, 0x57, 0x55, 0x80, 0x52, 0x53, 0x55, 0x44, 0x9f, 0x83, 0x89, 0x2f,
+		0xda, 0x84, 0x84, 0xbf, 0xeb, 0xd7, 0xbb, 0xa4, 0x22, 0xed, 0x5c, 0x61, 0xe4, 0xbd, 0xde, 0xec,
+		0x2e, 0x87, 0xd7, 0x71, 0x1a, 0x06, 0xe1, 0xd7, 0x54, 0xd2, 0xee, 0x70, 0x00, 0xa5, 0xd7, 0x3b,
+		0xce, 0x0a, 0x91, 0x05, 0xe7, 0xcf, 0x7c, 0x02, 0xc1, 0xf9, 0x99, 0xe3, 0x09, 0xce, 0x7f, 0xb5,
+		0x08, 0x77, 0xf2, 0xfe, 0x72, 0xcb, 0xa7, 0xbf, 0x58, 0x6f, 0x86, 0xc7, 0x5d, 0x56, 0xc0, 0xf2,
+		0x20, 0xf7, 0xcf, 0x8e, 0x44, 0x4e, 0xb9, 0x03, 0xcb, 0xce, 0x68, 0x74, 0xd9, 0x11, 0x9f, 0xe5,
+		0x4a, 0x92, 0xb3, 0xdc, 0x11, 0xa5, 0xf8, 0xaa, 0x7f, 0x57, 0x80, 0x5b, 0x79, 0x7e, 0x96, 0x46,
+		0x3a, 0x1f, 0xe2, 0x43, 0x64, 0xe1, 0xb0, 0x87, 0xc8, 0xa3, 0x9a, 0x45, 0xb1, 0x74, 0x47, 0x24,
+		0xd2, 0xed, 0xaf, 0x75, 0xa3, 0xea, 0xd1, 0xfe, 0xef, 0x17, 0x20, 0xe7, 0x0f, 0xe6, 0xfc, 0x70,
+		0x08, 0x53, 0x54, 0xad, 0x31, 0x2a, 0xac, 0xd6, 0xe8, 0xbb, 0xa1, 0x25, 0x75, 0x37, 0xb4, 0xfa,
+		0x3f, 0x05, 0xb8, 0x79, 0x14, 0x2b, 0xca, 0x0f, 0xa9, 0xd0, 0x07, 0xbc, 0xf8, 0x52, 0x0e, 0x2f,
+		0xbe, 0xfa, 0xbf, 0x05, 0xb8, 0x9d, 0xeb, 0xf7, 0x8b, 0xde, 0x0a, 0x3e, 0x21, 0xf8, 0x30, 0x33,
+		0x56, 0xca, 0x93, 0x4d, 0xfd, 0xf9, 0xa2, 0x4c, 0xf0, 0xb2, 0xd2, 0xd0, 0xb7, 0x82, 0x4f, 0xad,
+		0x4c, 0x2d, 0x0d, 0x73, 0xb1, 0xee, 0xaf, 0x0b, 0xb0, 0x94, 0xf3, 0x77, 0xa5, 0xde, 0xce, 0x43,
+		0x64, 0x1e, 0x6e, 0x10, 0x38, 0x49, 0xff, 0xdc, 0xb0, 0x9b, 0x04, 0x7b, 0xf4, 0x53, 0x17, 0x60,
+		0x6e, 0xfd, 0xe5, 0xfa, 0xd3, 0xed, 0xc6, 0x46, 0x7d, 0x73, 0x7b, 0x5d, 0x6f, 0x6c, 0xff, 0xc4,
+		0xd6, 0x7a, 0xa3, 0xfe, 0xf4, 0xe5, 0xca, 0x66, 0xfd, 0xc9, 0xd4, 0x67, 0xd0, 0x25, 0x98, 0x4f,
+		0xbe, 0x5e, 0xd9, 0xdc, 0x6c, 0xd0, 0xd6, 0x29, 0x0d, 0x5d, 0x86, 0x0b, 0x49, 0x82, 0xb5, 0xcd,
+		0x67, 0xcf, 0xd7, 0x39, 0x49, 0x61, 0xf5, 0x63, 0x38, 0x6b, 0xba, 0x2d, 0x91, 0x0c, 0x56, 0xc3,
+		0xff, 0x4c, 0xb2, 0x15, 0x78, 0xe6, 0x5b, 0xda, 0x4f, 0xde, 0x7d, 0x65, 0x93, 0xd7, 0x9d, 0x9d,
+		0x9a, 0xe9, 0xb6, 0x96, 0x06, 0xff, 0x43, 0xca, 0x6d, 0xdb, 0x6a, 0x2e, 0xbd, 0x72, 0xd9, 0x7f,
+		0x65, 0xe1, 0xff, 0x2e, 0xe5, 0xb1, 0xd1, 0xb6, 0xf7, 0xef, 0xee, 0x94, 0x68, 0xdb, 0xbd, 0xff,
+		0x0b, 0x00, 0x00, 0xff, 0xff, 0x83, 0xaa, 0x05, 0x73, 0x11, 0x66, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
-		0x15, 0x07, 0x57, 0xdf, 0x6f, 0x25, 0x59, 0x1a, 0x7d, 0xad, 0x57, 0xd6, 0x17, 0x1d, 0xa7, 0xaa,
-		0x63, 0xaf, 0x2a, 0x29, 0xfe, 0x88, 0x93, 0x36, 0x90, 0xd7, 0x96, 0xa3, 0xc2, 0x4e, 0x55, 0x4a,
-		0xb5, 0xd1, 0x5e, 0x88, 0x11, 0x39, 0x5a, 0x8d, 0xc5, 0x25, 0xa9, 0xe1, 0x50, 0xf2, 0x26, 0x87,
-		0xa2, 0x45, 0x90, 0x02, 0x45, 0x5b, 0xf4, 0xd8, 0x02, 0x05, 0x7a, 0xe8, 0x21, 0xa7, 0xe6, 0xd2,
-		0x53, 0xef, 0x45, 0xff, 0x8f, 0xfe, 0x05, 0x39, 0xf5, 0x1a, 0x14, 0x9c, 0x19, 0xee, 0x97, 0x48,
-		0xee, 0xae, 0x94, 0xc0, 0x4e, 0x6f, 0x3b, 0x33, 0xef, 0xf7, 0xe6, 0xcd, 0xfb, 0x9a, 0x37, 0x6f,
-		0x09, 0x37, 0xc3, 0x03, 0xc2, 0xd6, 0x2c, 0x6c, 0x13, 0xd7, 0x22, 0x6b, 0xd8, 0xa7, 0x6b, 0xa7,
-		0xeb, 0x6b, 0x01, 0x61, 0xa7, 0xd4, 0x22, 0xe6, 0x99, 0xc7, 0x8e, 0x0f, 0x1d, 0xef, 0xac, 0xe4,
-		0x33, 0x8f, 0x7b, 0x68, 0x2a, 0xa2, 0x2d, 0x29, 0xda, 0x12, 0xf6, 0x69, 0xe9, 0x74, 0xbd, 0xb8,
-		0x58, 0xf1, 0xbc, 0x8a, 0x43, 0xd6, 0x04, 0xc9, 0x41, 0x78, 0xb8, 0x66, 0x87, 0x0c, 0x73, 0xea,
-		0xb9, 0x12, 0x54, 0x5c, 0x6a, 0x5f, 0xe7, 0xb4, 0x4a, 0x02, 0x8e, 0xab, 0xbe, 0x22, 0x58, 0x4e,
-		0x92, 0xc0, 0xf2, 0xaa, 0xd5, 0x3a, 0x8b, 0x95, 0x24, 0x8a, 0x23, 0x1a, 0x70, 0x8f, 0xd5, 0xe2,
-		0x5d, 0x92, 0x48, 0x4e, 0x42, 0x52, 0x27, 0xd0, 0x93, 0x08, 0x38, 0x0e, 0x8e, 0x1d, 0x1a, 0xf0,
-		0x2c, 0x9a, 0x56, 0x1d, 0xe8, 0xff, 0xd2, 0x60, 0xc9, 0x88, 0xe4, 0x67, 0xfc, 0x85, 0x5a, 0x79,
-		0xfc, 0x8a, 0x58, 0x61, 0x74, 0x62, 0x83, 0x9c, 0x84, 0x24, 0xe0, 0x68, 0x16, 0x06, 0x6d, 0xaf,
-		0x8a, 0xa9, 0x5b, 0xd0, 0x96, 0xb5, 0xd5, 0x11, 0x43, 0x8d, 0xd0, 0xcf, 0x00, 0xc5, 0xdc, 0x4c,
-		0x12, 0x83, 0x0a, 0xb9, 0x65, 0x6d, 0x35, 0xbf, 0xf1, 0x76, 0x29, 0x41, 0xb9, 0xa5, 0xf3, 0x5b,
-		0x4c, 0x9e, 0xb5, 0x4f, 0xa1, 0x22, 0x0c, 0x53, 0x9b, 0xb8, 0x9c, 0xf2, 0x5a, 0xa1, 0x4f, 0x6c,
-		0x58, 0x1f, 0x47, 0xa2, 0x30, 0x82, 0x03, 0xcf, 0x2d, 0xf4, 0x4b, 0x51, 0xe4, 0x48, 0xff, 0xbb,
-		0x06, 0xcb, 0x8f, 0x28, 0xae, 0xb8, 0x5e, 0x40, 0xbe, 0x03, 0xe7, 0xd0, 0xbf, 0xd0, 0x60, 0x25,
-		0x43, 0xde, 0xc0, 0xf7, 0xdc, 0x80, 0xa4, 0x0a, 0xfc, 0x12, 0x16, 0x6c, 0x09, 0xe6, 0xd4, 0x32,
-		0x2f, 0x2d, 0xfb, 0x7c, 0x83, 0xd9, 0xb9, 0x45, 0xfd, 0x4f, 0x00, 0x0b, 0x7b, 0x17, 0x72, 0x8f,
-		0x25, 0xc8, 0xd7, 0x45, 0xa3, 0xb6, 0x90, 0x69, 0xc4, 0x80, 0x78, 0x6a, 0xc7, 0x46, 0xdb, 0x30,
-		0x56, 0x27, 0xe0, 0x35, 0x9f, 0x08, 0x2d, 0xe5, 0x37, 0x56, 0x32, 0xc5, 0xde, 0xaf, 0xf9, 0xc4,
-		0x18, 0x3d, 0x6b, 0x1a, 0xa1, 0x07, 0x30, 0x12, 0x79, 0xbe, 0x19, 0xb9, 0xbe, 0xf0, 0x8b, 0xfc,
-		0xc6, 0x42, 0x22, 0x8f, 0x7d, 0x1c, 0x1c, 0x3f, 0xa5, 0x01, 0x37, 0x86, 0xb9, 0xfa, 0x85, 0x36,
-		0x60, 0x80, 0xba, 0x7e, 0xc8, 0x0b, 0x03, 0x02, 0x77, 0x2d, 0x11, 0xb7, 0x8b, 0x6b, 0x8e, 0x87,
-		0x6d, 0x43, 0x92, 0x22, 0x0c, 0xcb, 0x75, 0x55, 0x9b, 0x22, 0x74, 0x4c, 0xee, 0x99, 0x96, 0xe3,
-		0x05, 0xc4, 0x8c, 0xb2, 0x81, 0x17, 0xf2, 0xc2, 0xa0, 0x60, 0x77, 0xb5, 0x24, 0xb3, 0x45, 0x29,
-		0xce, 0x16, 0xa5, 0x47, 0x2a, 0x9b, 0x18, 0xd7, 0xea, 0x2c, 0x84, 0x76, 0xf7, 0xbd, 0x72, 0x84,
-		0xdf, 0x97, 0x70, 0xf4, 0x02, 0xe6, 0xc5, 0x91, 0x52, 0xb8, 0x0f, 0x75, 0xe2, 0x3e, 0x17, 0xa1,
-		0x93, 0x18, 0x37, 0x3b, 0xe5, 0x70, 0x5b, 0x70, 0x2d, 0x00, 0x30, 0x69, 0xd3, 0xc8, 0x5e, 0x23,
-		0x62, 0x75, 0x44, 0xcd, 0xec, 0xd8, 0xc8, 0x82, 0x42, 0x93, 0x3d, 0x4d, 0x46, 0xc2, 0x80, 0x98,
-		0xbe, 0xe7, 0x50, 0xab, 0x56, 0x80, 0x65, 0x6d, 0x75, 0x7c, 0xe3, 0x66, 0xa6, 0xe5, 0x76, 0x6c,
-		0x23, 0x82, 0xec, 0x0a, 0x84, 0x31, 0x73, 0x96, 0x34, 0x8d, 0xca, 0x30, 0xca, 0x08, 0x67, 0xb5,
-		0x98, 0x71, 0x5e, 0x9c, 0x74, 0x39, 0x91, 0xb1, 0x11, 0x11, 0x2a, 0x76, 0x79, 0xd6, 0x18, 0xa0,
-		0xeb, 0x30, 0x66, 0xb1, 0xc8, 0x36, 0xd6, 0x11, 0xb1, 0x43, 0x87, 0x14, 0x46, 0xc5, 0x59, 0x46,
-		0xa3, 0xc9, 0x3d, 0x35, 0x87, 0x6e, 0x43, 0x7f, 0x95, 0x54, 0xbd, 0xc2, 0x98, 0xd2, 0x65, 0xd2,
-		0x0e, 0xcf, 0x48, 0xd5, 0x33, 0x04, 0x19, 0x32, 0x60, 0x32, 0x20, 0x98, 0x59, 0x47, 0x26, 0xe6,
-		0x9c, 0xd1, 0x83, 0x90, 0x93, 0xa0, 0x30, 0x2e, 0xb0, 0x37, 0x12, 0xb1, 0x7b, 0x82, 0x7a, 0xab,
-		0x4e, 0x6c, 0x4c, 0x04, 0x6d, 0x33, 0x68, 0x13, 0x06, 0x8f, 0x08, 0xb6, 0x09, 0x2b, 0x5c, 0x11,
-		0x8c, 0xe6, 0x13, 0x19, 0x7d, 0x24, 0x48, 0x0c, 0x45, 0x8a, 0x1e, 0x40, 0xde, 0x26, 0x0e, 0xae,
-		0x49, 0xdf, 0x28, 0x4c, 0x74, 0x72, 0x05, 0x10, 0xd4, 0xc2, 0x17, 0xd0, 0x07, 0x30, 0xfa, 0x92,
-		0x72, 0x4e, 0x98, 0x02, 0x4f, 0x76, 0x02, 0xe7, 0x25, 0x79, 0x1d, 0x7d, 0x48, 0x59, 0xc0, 0x4d,
-		0x16, 0xba, 0x26, 0xe6, 0x05, 0x24, 0xd0, 0xc5, 0x73, 0xe8, 0xfd, 0xf8, 0x46, 0x34, 0x40, 0xd0,
-		0x1b, 0xa1, 0xbb, 0xc5, 0xd1, 0x73, 0x98, 0x12, 0x46, 0xf1, 0x4e, 0x09, 0x73, 0xb0, 0x1f, 0x1b,
-		0x78, 0x4a, 0x78, 0x4e, 0x72, 0xaa, 0x2a, 0x33, 0xcf, 0xfd, 0x89, 0x24, 0x57, 0x66, 0x9e, 0xb4,
-		0xda, 0xa7, 0xd0, 0x2b, 0x58, 0xc2, 0x16, 0xa7, 0xa7, 0xc4, 0xb4, 0x9c, 0x30, 0x10, 0x67, 0x23,
-		0x0e, 0xb1, 0x44, 0x70, 0xaa, 0x3d, 0xa6, 0x85, 0xa0, 0xeb, 0x89, 0x7b, 0x6c, 0x09, 0x6c, 0x59,
-		0x42, 0xf7, 0x62, 0xa4, 0xda, 0xee, 0x1a, 0xce, 0x58, 0xd5, 0xef, 0xc1, 0x62, 0x5a, 0x66, 0x54,
-		0x09, 0x7c, 0x06, 0x06, 0x23, 0x5d, 0x51, 0x5b, 0xa5, 0xc6, 0x01, 0x16, 0xba, 0x3b, 0xb6, 0xce,
-		0x40, 0x4f, 0x06, 0x6e, 0x05, 0x35, 0xd7, 0x8a, 0xf3, 0xea, 0x53, 0x18, 0x52, 0xc1, 0x27, 0xd0,
-		0xf9, 0x8d, 0x8d, 0x64, 0x3f, 0xcb, 0x4a, 0xce, 0x46, 0xcc, 0x42, 0xbf, 0x01, 0xd7, 0x33, 0xf7,
-		0x94, 0x12, 0xeb, 0xef, 0xc1, 0x72, 0x7a, 0x39, 0x90, 0x7d, 0xaa, 0x7f, 0xe7, 0x60, 0x71, 0x8f,
-		0x56, 0x5c, 0xec, 0x7c, 0x17, 0x2a, 0x89, 0xd6, 0x64, 0xd7, 0xdf, 0x9e, 0xec, 0x96, 0x20, 0x1f,
-		0x88, 0xb3, 0x98, 0x2e, 0xae, 0x12, 0x71, 0x3b, 0x8c, 0x18, 0x20, 0xa7, 0x3e, 0xc6, 0x55, 0x82,
-		0x3e, 0x84, 0x51, 0x45, 0x20, 0xef, 0x8f, 0xc1, 0x2e, 0xee, 0x0f, 0xc5, 0x72, 0x47, 0xdc, 0x22,
-		0x05, 0x18, 0xb2, 0x3c, 0x97, 0x33, 0xcf, 0x11, 0xe9, 0x7c, 0xd4, 0x88, 0x87, 0xfa, 0x0a, 0x2c,
-		0xa5, 0xea, 0x51, 0x99, 0xe9, 0x6b, 0x0d, 0xbe, 0xa7, 0x68, 0x28, 0x3f, 0xca, 0xbe, 0x9f, 0x5f,
-		0xc0, 0x98, 0xbc, 0x46, 0x2e, 0xef, 0x4d, 0xa3, 0x82, 0x51, 0xcc, 0xb8, 0x4d, 0x47, 0xb9, 0x8e,
-		0x3a, 0xea, 0xbb, 0x84, 0x8e, 0xfa, 0x5b, 0x75, 0xb4, 0x05, 0xab, 0x9d, 0xcf, 0x9f, 0xed, 0xaf,
-		0x9f, 0x6b, 0x70, 0xab, 0x13, 0x8f, 0x96, 0x80, 0x7c, 0xde, 0x1e, 0x90, 0x1f, 0x24, 0xab, 0xb0,
-		0x3b, 0xbb, 0x34, 0x42, 0x73, 0x0d, 0x6e, 0x77, 0x29, 0x87, 0xb2, 0xfe, 0x97, 0x39, 0x58, 0x30,
-		0x48, 0x40, 0xde, 0x98, 0x92, 0xbd, 0x51, 0x96, 0xf7, 0x35, 0x97, 0xe5, 0xe8, 0x1e, 0x14, 0x6c,
-		0x62, 0xd1, 0x20, 0xca, 0xc5, 0x87, 0xd4, 0xa5, 0xc1, 0x91, 0x49, 0x4e, 0x89, 0x5b, 0x0f, 0xb9,
-		0x3e, 0x63, 0x26, 0x5e, 0xdf, 0x16, 0xcb, 0x8f, 0xa3, 0xd5, 0x1d, 0xbb, 0x2d, 0x3a, 0x07, 0xda,
-		0xa3, 0xb3, 0x04, 0x53, 0xc1, 0x31, 0xf5, 0x4d, 0xe5, 0x5d, 0x8c, 0x60, 0xdf, 0x77, 0x6a, 0x22,
-		0x06, 0x87, 0x8d, 0xc9, 0x68, 0x49, 0x2a, 0xd4, 0x90, 0x0b, 0x51, 0xa6, 0x4e, 0xd3, 0x57, 0xb6,
-		0x8f, 0xfc, 0x25, 0x07, 0x37, 0x94, 0x4e, 0xcb, 0xd8, 0xb5, 0xc8, 0xff, 0x43, 0x6a, 0x9b, 0x86,
-		0x01, 0x0b, 0x87, 0x41, 0x9c, 0xd4, 0xe4, 0x00, 0x6d, 0xc2, 0xac, 0xbc, 0xdc, 0x1b, 0xa5, 0xad,
-		0x52, 0xc8, 0xa0, 0x20, 0x9b, 0x12, 0xab, 0x0d, 0x99, 0x84, 0x7a, 0x56, 0xe1, 0xed, 0x4e, 0xda,
-		0x51, 0x2e, 0xfb, 0x8f, 0x1c, 0xac, 0xec, 0x13, 0x56, 0xa5, 0x2e, 0xe6, 0xe4, 0x4d, 0x77, 0xdb,
-		0xbb, 0x30, 0x64, 0x13, 0x8e, 0xa9, 0x13, 0xa8, 0xe7, 0x44, 0x76, 0xca, 0x8a, 0x89, 0x5b, 0x8c,
-		0x32, 0xd0, 0x66, 0x94, 0x0b, 0xe9, 0xf7, 0x2d, 0xd0, 0xb3, 0x94, 0xa6, 0x74, 0xfb, 0xdf, 0xe8,
-		0xf1, 0x4b, 0x02, 0x8b, 0xd1, 0x83, 0x37, 0x46, 0xb5, 0x07, 0x30, 0x27, 0xda, 0x15, 0xa6, 0xe5,
-		0xb9, 0x01, 0x0d, 0x38, 0x71, 0xad, 0x9a, 0xe9, 0x90, 0x53, 0xe2, 0x08, 0x5d, 0xa7, 0xbd, 0x15,
-		0x7e, 0x1a, 0x61, 0xca, 0x0d, 0xc8, 0xd3, 0x08, 0x61, 0xcc, 0x9c, 0x24, 0x4d, 0xeb, 0x5f, 0xf7,
-		0xc1, 0x4a, 0xc6, 0xb9, 0x55, 0x64, 0x3b, 0x30, 0xd7, 0x50, 0xb9, 0xe5, 0xb9, 0x87, 0xb4, 0xa2,
-		0xaa, 0x5b, 0x95, 0xc5, 0x37, 0xbb, 0x3b, 0x65, 0xb9, 0x19, 0x6a, 0xcc, 0x92, 0xc4, 0xf9, 0xe8,
-		0xdc, 0xe7, 0xd5, 0x69, 0x52, 0xf7, 0xd0, 0x53, 0x3a, 0xbd, 0xd9, 0xdd, 0x6e, 0x3b, 0xee, 0xa1,
-		0xd7, 0x78, 0x23, 0xb5, 0x4c, 0xa3, 0x17, 0x80, 0x7c, 0xe2, 0xda, 0xd4, 0xad, 0x98, 0xa2, 0x3e,
-		0xa5, 0x9c, 0x92, 0xa0, 0xd0, 0xb7, 0xdc, 0xb7, 0x9a, 0xdf, 0x58, 0x4d, 0xf6, 0x54, 0x49, 0xbe,
-		0x25, 0xa9, 0x6b, 0x82, 0xf9, 0xa4, 0xdf, 0x32, 0x49, 0x49, 0x80, 0x7e, 0x0e, 0x13, 0x31, 0x63,
-		0xeb, 0x88, 0x3a, 0x36, 0x23, 0x6e, 0xa1, 0x5f, 0xb0, 0x2d, 0x65, 0xb1, 0x2d, 0x47, 0xb4, 0xad,
-		0x92, 0x5f, 0xf1, 0x9b, 0x96, 0x18, 0x71, 0xd1, 0x5e, 0x83, 0x75, 0x9c, 0xf1, 0xd5, 0x93, 0x3b,
-		0x53, 0xe2, 0x47, 0x8a, 0xb6, 0x85, 0x69, 0x3c, 0xa9, 0x7f, 0xd6, 0x07, 0xd3, 0xc2, 0x63, 0x62,
-		0xf5, 0xbd, 0x26, 0x67, 0xbf, 0x0f, 0x03, 0xc2, 0x43, 0x55, 0x81, 0xa3, 0x67, 0x72, 0x12, 0x02,
-		0x1b, 0x12, 0x80, 0x4c, 0x98, 0x95, 0x61, 0xc2, 0xc8, 0x4b, 0x62, 0xf1, 0xc8, 0x3f, 0x6d, 0x2a,
-		0x84, 0xea, 0x17, 0x51, 0xf2, 0xfd, 0xf4, 0x28, 0x31, 0x04, 0xa2, 0x1c, 0x03, 0x8c, 0xe9, 0x93,
-		0x84, 0xd9, 0xac, 0x38, 0x1c, 0xf8, 0xa6, 0xe2, 0xf0, 0x6f, 0x1a, 0xcc, 0xb4, 0x99, 0x41, 0xc5,
-		0xde, 0x87, 0x30, 0x1a, 0x1f, 0x2f, 0x08, 0x9d, 0xb8, 0x6c, 0xea, 0x50, 0x00, 0xaa, 0x73, 0x44,
-		0x00, 0xb4, 0x03, 0xe3, 0xcd, 0xfa, 0x21, 0xb6, 0x32, 0x96, 0xde, 0x49, 0x2f, 0xc4, 0x36, 0xc6,
-		0x4e, 0x9a, 0x87, 0xfa, 0x57, 0x1a, 0xcc, 0xc5, 0xd9, 0xa2, 0xde, 0x08, 0xea, 0xe0, 0x2f, 0x2d,
-		0x9d, 0xa5, 0x5c, 0x6f, 0x9d, 0xa5, 0x27, 0x30, 0x5e, 0xc7, 0x36, 0xda, 0x5b, 0xe3, 0x29, 0xed,
-		0xad, 0x98, 0x81, 0x6c, 0x6f, 0xf1, 0xa6, 0x51, 0x54, 0x44, 0x51, 0xd7, 0x72, 0x42, 0x9b, 0x98,
-		0x0d, 0x86, 0x01, 0xc7, 0x3c, 0x94, 0xd7, 0xd3, 0xb0, 0x31, 0xa3, 0xd6, 0x63, 0x26, 0x7b, 0x62,
-		0x51, 0xff, 0x67, 0x0e, 0x0a, 0xe7, 0x4f, 0xac, 0x4c, 0xf3, 0x1e, 0x0c, 0xf9, 0x9e, 0xe3, 0x10,
-		0x16, 0x14, 0x34, 0x11, 0xe2, 0x4b, 0xc9, 0x56, 0x11, 0x34, 0x22, 0xfc, 0x62, 0x7a, 0xf4, 0x0c,
-		0x26, 0xce, 0x09, 0x22, 0x95, 0x73, 0x3d, 0xf3, 0x6c, 0x52, 0x2c, 0x63, 0x9c, 0xb7, 0x8c, 0xd1,
-		0x0b, 0x98, 0xf0, 0x31, 0xe3, 0xb4, 0x29, 0x41, 0xab, 0x40, 0xba, 0x95, 0xc9, 0x6e, 0x37, 0x06,
-		0xc9, 0x0c, 0x6c, 0x5c, 0xf1, 0x5b, 0x27, 0x2e, 0xd3, 0x17, 0xd4, 0xef, 0xc0, 0xfc, 0x13, 0xc2,
-		0xe3, 0x85, 0xe0, 0x61, 0xed, 0x91, 0xf0, 0x88, 0x0e, 0x0e, 0xa3, 0xff, 0xa1, 0x1f, 0xae, 0x25,
-		0xe3, 0x94, 0xda, 0x7f, 0x09, 0xb3, 0xf5, 0x8a, 0xb8, 0xa1, 0xc4, 0x2a, 0xf6, 0x95, 0x15, 0x7e,
-		0x9c, 0x28, 0x60, 0x16, 0xcb, 0x52, 0x9c, 0x0e, 0x63, 0x8a, 0x67, 0xd8, 0x7f, 0xec, 0x72, 0x56,
-		0x33, 0xa6, 0xec, 0xf3, 0x2b, 0x91, 0x00, 0xea, 0xd2, 0xa8, 0xb5, 0x09, 0x90, 0xbb, 0xa8, 0x00,
-		0xf1, 0xb5, 0x72, 0x5e, 0x00, 0x7c, 0x7e, 0xa5, 0x18, 0x46, 0x4e, 0x99, 0x2c, 0x31, 0x9a, 0x80,
-		0xbe, 0x63, 0x52, 0x53, 0x3a, 0x8d, 0x7e, 0xa2, 0x32, 0x0c, 0x9c, 0x62, 0x27, 0x24, 0xca, 0xc1,
-		0x6e, 0x27, 0x4a, 0x97, 0xe6, 0xe4, 0x86, 0xc4, 0x3e, 0xc8, 0xdd, 0xd7, 0xa2, 0x6d, 0xd3, 0xe4,
-		0xfc, 0x16, 0xb7, 0xd5, 0x03, 0x58, 0x10, 0x81, 0xdc, 0xee, 0xb3, 0xc1, 0xb7, 0x98, 0x7a, 0xf4,
-		0xcf, 0x73, 0xb0, 0x98, 0xb6, 0xab, 0xf2, 0xc3, 0x13, 0x58, 0x48, 0x70, 0x83, 0x7a, 0x04, 0xc5,
-		0x49, 0xa1, 0xd4, 0x5d, 0x04, 0x3e, 0x23, 0x1c, 0xdb, 0x98, 0x63, 0xa3, 0xd8, 0x6e, 0xf1, 0xc6,
-		0xd6, 0xd1, 0x96, 0x09, 0xae, 0xdf, 0xb4, 0x65, 0xee, 0x62, 0x5b, 0xb6, 0x7b, 0x79, 0x63, 0x4b,
-		0x7d, 0x0e, 0x66, 0x9e, 0x10, 0xae, 0xda, 0x77, 0x22, 0x89, 0xa9, 0x27, 0xf7, 0xaf, 0x35, 0x98,
-		0x6d, 0x5f, 0x51, 0x9a, 0x39, 0x82, 0xab, 0x41, 0xe8, 0xfb, 0x1e, 0xe3, 0xc4, 0x36, 0x2d, 0x87,
-		0x46, 0xcf, 0xd5, 0x53, 0xc2, 0x02, 0xa5, 0x95, 0xf4, 0xbc, 0xb4, 0x17, 0xa3, 0xca, 0x02, 0xf4,
-		0x5c, 0x61, 0x8c, 0xb9, 0x20, 0x79, 0x41, 0xff, 0xaa, 0x0f, 0xf4, 0x27, 0x09, 0x8f, 0xd2, 0x8f,
-		0xe4, 0x5f, 0x81, 0xaf, 0xa9, 0x98, 0x99, 0x87, 0x11, 0x1f, 0x57, 0x88, 0x19, 0xd0, 0x4f, 0xe4,
-		0x95, 0x35, 0x60, 0x0c, 0x47, 0x13, 0x7b, 0xf4, 0x13, 0x82, 0xde, 0x86, 0x2b, 0x2e, 0x79, 0x15,
-		0x59, 0xad, 0x42, 0x4c, 0xee, 0x1d, 0x13, 0x57, 0x35, 0x66, 0xc6, 0xa2, 0xe9, 0x5d, 0x5c, 0x21,
-		0xfb, 0xd1, 0x24, 0x7a, 0x07, 0xd0, 0x19, 0xa6, 0xdc, 0x3c, 0xf4, 0x98, 0xe9, 0x92, 0x33, 0xf9,
-		0xea, 0x17, 0x15, 0xc7, 0xb0, 0x71, 0x25, 0x5a, 0xd9, 0xf6, 0xd8, 0xc7, 0xe4, 0x4c, 0x3c, 0xf7,
-		0x91, 0x09, 0x57, 0xd5, 0xbf, 0x9f, 0xaa, 0x3b, 0x70, 0x48, 0x1d, 0x4e, 0x98, 0xbc, 0x34, 0x07,
-		0xc5, 0xa5, 0xf9, 0x56, 0xe2, 0x79, 0x04, 0x7c, 0x5b, 0x10, 0x8b, 0x7b, 0x73, 0x56, 0xb1, 0x69,
-		0x9b, 0x47, 0xd7, 0x61, 0x4c, 0xb4, 0x0b, 0x30, 0xb3, 0x8e, 0xe8, 0x29, 0x96, 0x0d, 0xb7, 0x61,
-		0x63, 0x34, 0x9a, 0xdc, 0x52, 0x73, 0x59, 0x95, 0xd2, 0xf0, 0x37, 0x55, 0x29, 0xfd, 0x47, 0x83,
-		0xeb, 0x99, 0x16, 0x57, 0x3e, 0x78, 0x17, 0x86, 0xd4, 0x51, 0x32, 0x4b, 0xa6, 0x18, 0x16, 0x13,
-		0xa3, 0x1f, 0x41, 0x9e, 0xe1, 0x33, 0x33, 0xc6, 0xca, 0x80, 0x4a, 0x4e, 0x1b, 0x8f, 0x30, 0xc7,
-		0x0f, 0x1d, 0xef, 0xc0, 0x00, 0x86, 0xcf, 0x14, 0xa3, 0x24, 0xf3, 0xf6, 0x25, 0x99, 0xb7, 0x08,
-		0xc3, 0x52, 0x97, 0xc4, 0x56, 0x25, 0x48, 0x7d, 0xac, 0xd7, 0x60, 0x74, 0x9b, 0x60, 0x1e, 0x32,
-		0xb2, 0xed, 0xe0, 0x4a, 0x80, 0x28, 0x6c, 0x24, 0xbc, 0x88, 0xb0, 0xc3, 0x08, 0xb6, 0x23, 0x65,
-		0x57, 0x7d, 0x87, 0x44, 0xa1, 0x46, 0x18, 0xf3, 0x98, 0x49, 0x5c, 0x7c, 0xe0, 0x10, 0xd9, 0x85,
-		0x19, 0x36, 0x6e, 0x9f, 0x73, 0xcf, 0x2d, 0x89, 0x2b, 0xc7, 0xb0, 0xc7, 0x11, 0xea, 0xb1, 0x04,
-		0xe9, 0xbf, 0xd3, 0x60, 0xde, 0x20, 0x87, 0x8c, 0x04, 0x47, 0xf5, 0xbf, 0x0b, 0x71, 0x70, 0x1c,
-		0xbc, 0x9e, 0x48, 0xd2, 0x17, 0xe1, 0x5a, 0xb2, 0x34, 0xd2, 0xca, 0x1b, 0x5f, 0x4c, 0x41, 0x3e,
-		0x5e, 0xd9, 0xda, 0xdd, 0x41, 0xbf, 0xd1, 0xa0, 0x90, 0xd6, 0x7c, 0x47, 0xef, 0xa6, 0xfc, 0x05,
-		0x96, 0xf9, 0xd7, 0x7d, 0xf1, 0x4e, 0x8f, 0x28, 0xe5, 0x7f, 0xbf, 0xd2, 0x60, 0x36, 0xb9, 0x11,
-		0x89, 0x2e, 0xd0, 0x36, 0x2e, 0x6e, 0xf6, 0x84, 0x51, 0x32, 0xfc, 0x51, 0x83, 0xf9, 0x8c, 0x66,
-		0x28, 0xba, 0xd7, 0x03, 0xd3, 0xe6, 0x36, 0x6e, 0xf1, 0x7e, 0xef, 0x40, 0x25, 0xd2, 0x67, 0x1a,
-		0xcc, 0xa5, 0x74, 0xe6, 0xd1, 0x66, 0x56, 0x2f, 0x38, 0x4d, 0x31, 0xef, 0xf6, 0x06, 0x52, 0x62,
-		0xfc, 0x55, 0x83, 0xe5, 0x4e, 0x0d, 0x63, 0x74, 0xa9, 0xde, 0x74, 0xf1, 0x87, 0x17, 0x44, 0x2b,
-		0x09, 0xbf, 0xd4, 0xe0, 0x46, 0x57, 0x2d, 0x6d, 0xb4, 0x75, 0xa1, 0x8d, 0x5a, 0xec, 0xf9, 0xf0,
-		0x32, 0x2c, 0x9a, 0x1c, 0x3e, 0xb9, 0x43, 0x9c, 0xe2, 0xf0, 0x99, 0xed, 0xf7, 0x14, 0x87, 0xef,
-		0xd0, 0x82, 0xfe, 0xb3, 0x06, 0x8b, 0xd9, 0xdd, 0x54, 0xf4, 0x20, 0x85, 0x6f, 0x17, 0x0d, 0xea,
-		0xe2, 0xfb, 0x17, 0xc2, 0x2a, 0xd9, 0x7e, 0xaf, 0x41, 0x31, 0xbd, 0x13, 0x89, 0xee, 0x26, 0xd7,
-		0x6c, 0x9d, 0xfa, 0xbd, 0xc5, 0x7b, 0x3d, 0xe3, 0x94, 0x3c, 0xbf, 0xd5, 0xe0, 0x6a, 0x6a, 0xeb,
-		0x0f, 0xdd, 0xc9, 0x2c, 0xd7, 0x53, 0xa5, 0xb9, 0xdb, 0x2b, 0x4c, 0x09, 0x73, 0x08, 0x63, 0x2d,
-		0xed, 0x0f, 0x94, 0xd1, 0xb5, 0x69, 0xeb, 0x54, 0x15, 0x6f, 0x76, 0x43, 0xaa, 0xf6, 0xf1, 0x60,
-		0xa2, 0xfd, 0xc9, 0x81, 0x6e, 0x75, 0xf9, 0x32, 0x91, 0xbb, 0xf5, 0xf6, 0x8e, 0x41, 0x9f, 0xc2,
-		0x74, 0xd2, 0xc3, 0x0f, 0xfd, 0xa0, 0x87, 0x37, 0xa2, 0xdc, 0x78, 0xbd, 0xe7, 0x57, 0xa5, 0x08,
-		0xc9, 0xe4, 0x47, 0x4c, 0x4a, 0x48, 0x66, 0xbe, 0xb3, 0x52, 0x42, 0xb2, 0xc3, 0x2b, 0x89, 0xc2,
-		0x78, 0xeb, 0x2b, 0x01, 0xdd, 0x4c, 0x3b, 0xc8, 0xf9, 0x47, 0x46, 0xf1, 0x9d, 0xae, 0x68, 0x9b,
-		0xae, 0xbb, 0x8c, 0xd2, 0x30, 0xe5, 0xba, 0xeb, 0xfc, 0x7c, 0x48, 0xb9, 0xee, 0xba, 0xa9, 0x42,
-		0x3f, 0x85, 0xe9, 0xa4, 0xfa, 0x25, 0xc5, 0xfc, 0x19, 0x85, 0x57, 0x8a, 0xf9, 0xb3, 0x8a, 0x23,
-		0x19, 0xe1, 0x69, 0x5f, 0xc8, 0xa5, 0x45, 0x78, 0x87, 0x2f, 0x00, 0xd3, 0x22, 0xbc, 0xd3, 0x87,
-		0x78, 0x0f, 0x6d, 0x98, 0xb3, 0xbc, 0x6a, 0x12, 0xf8, 0xe1, 0x74, 0x8c, 0xda, 0x93, 0x1f, 0x99,
-		0xee, 0x32, 0x8f, 0x7b, 0xbb, 0xda, 0x2f, 0xd6, 0x2b, 0x94, 0x1f, 0x85, 0x07, 0x25, 0xcb, 0xab,
-		0xae, 0x35, 0x7f, 0x87, 0x79, 0x9b, 0xda, 0xce, 0x5a, 0xc5, 0x93, 0xdf, 0x8f, 0xaa, 0x8f, 0x32,
-		0xdf, 0xc7, 0x3e, 0x3d, 0x5d, 0x3f, 0x18, 0x14, 0x73, 0x9b, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-		0x6d, 0x4a, 0x18, 0x5e, 0xc4, 0x2a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x4b, 0x6c, 0xdc, 0xc6,
+		0x15, 0x5c, 0xfd, 0xdf, 0xae, 0x64, 0x7b, 0xac, 0xcf, 0x7a, 0x6d, 0xfd, 0xe8, 0x38, 0x71, 0x9c,
+		0x58, 0xaa, 0xe5, 0xd8, 0x4e, 0x9c, 0x5f, 0x65, 0xf9, 0x13, 0x15, 0x71, 0xaa, 0x50, 0x4e, 0x8c,
+		0xf6, 0x42, 0x8c, 0xc8, 0x91, 0xc4, 0x88, 0x4b, 0xd2, 0xe4, 0x50, 0x8a, 0x92, 0x43, 0xd1, 0x34,
+		0x48, 0x81, 0xa2, 0x2d, 0x7a, 0x2d, 0x50, 0xa0, 0x87, 0x1e, 0x7a, 0x6a, 0x2e, 0x39, 0xf5, 0xd0,
+		0x4b, 0x51, 0xf4, 0xd2, 0x73, 0xef, 0x2d, 0x8a, 0xde, 0x5a, 0xe4, 0xd4, 0x6b, 0x51, 0xcc, 0x87,
+		0xbb, 0x4b, 0x6a, 0x86, 0xbb, 0x92, 0x95, 0xc4, 0x09, 0x7a, 0x5b, 0xce, 0xbc, 0xf7, 0xe6, 0xfd,
+		0x67, 0xe6, 0xcd, 0x5b, 0xb8, 0x94, 0x6e, 0x90, 0x78, 0xd1, 0xc1, 0x2e, 0x09, 0x1c, 0xb2, 0x88,
+		0x23, 0x6f, 0x71, 0xf7, 0xca, 0x62, 0x42, 0xe2, 0x5d, 0xcf, 0x21, 0xf6, 0x5e, 0x18, 0xef, 0x6c,
+		0xfa, 0xe1, 0xde, 0x42, 0x14, 0x87, 0x34, 0x44, 0xa7, 0x19, 0xec, 0x82, 0x84, 0x5d, 0xc0, 0x91,
+		0xb7, 0xb0, 0x7b, 0xa5, 0x31, 0xb3, 0x15, 0x86, 0x5b, 0x3e, 0x59, 0xe4, 0x20, 0x1b, 0xe9, 0xe6,
+		0xa2, 0x9b, 0xc6, 0x98, 0x7a, 0x61, 0x20, 0x90, 0x1a, 0xb3, 0xc5, 0x79, 0xea, 0x35, 0x49, 0x42,
+		0x71, 0x33, 0x92, 0x00, 0x73, 0x2a, 0x0e, 0x9c, 0xb0, 0xd9, 0x6c, 0x91, 0x98, 0x57, 0x41, 0x6c,
+		0x7b, 0x09, 0x0d, 0xe3, 0xfd, 0x6c, 0x15, 0x15, 0xc8, 0xa3, 0x94, 0xb4, 0x00, 0x4c, 0xa5, 0x9c,
+		0xce, 0x36, 0x71, 0x53, 0x9f, 0x94, 0xc1, 0x50, 0x9c, 0xec, 0xf8, 0x5e, 0x42, 0xcb, 0x60, 0xf2,
+		0x7a, 0x32, 0xff, 0x64, 0xc0, 0xac, 0xc5, 0x64, 0x8c, 0xe9, 0x43, 0x39, 0x73, 0xe7, 0x7d, 0xe2,
+		0xa4, 0x4c, 0x2b, 0x16, 0x79, 0x94, 0x92, 0x84, 0xa2, 0x49, 0x18, 0x74, 0xc3, 0x26, 0xf6, 0x82,
+		0xba, 0x31, 0x67, 0x5c, 0x1c, 0xb1, 0xe4, 0x17, 0x7a, 0x07, 0x50, 0x46, 0xcd, 0x26, 0x19, 0x52,
+		0xbd, 0x32, 0x67, 0x5c, 0xac, 0x2e, 0x3d, 0xbd, 0xa0, 0x30, 0xc0, 0xc2, 0xc1, 0x25, 0x4e, 0xed,
+		0x15, 0x87, 0x50, 0x03, 0x86, 0x3d, 0x97, 0x04, 0xd4, 0xa3, 0xfb, 0xf5, 0x3e, 0xbe, 0x60, 0xeb,
+		0x9b, 0xb1, 0x12, 0x13, 0x9c, 0x84, 0x41, 0xbd, 0x5f, 0xb0, 0x22, 0xbe, 0xcc, 0xdf, 0x19, 0x30,
+		0x77, 0xdb, 0xc3, 0x5b, 0x41, 0x98, 0x90, 0xaf, 0x81, 0x1c, 0xe6, 0x6f, 0x0d, 0x98, 0x2f, 0xe1,
+		0x37, 0x89, 0xc2, 0x20, 0x21, 0x5a, 0x86, 0xdf, 0x83, 0x69, 0x57, 0x20, 0x53, 0xcf, 0xb1, 0x1f,
+		0x9b, 0xf7, 0xb3, 0x6d, 0x62, 0x07, 0x26, 0xcd, 0xbf, 0x01, 0x4c, 0xaf, 0x1f, 0xc9, 0x3d, 0x66,
+		0xa1, 0xda, 0x62, 0xcd, 0x73, 0x39, 0x4f, 0x23, 0x16, 0x64, 0x43, 0xab, 0x2e, 0xba, 0x0b, 0xa3,
+		0x2d, 0x00, 0xba, 0x1f, 0x11, 0xae, 0xa5, 0xea, 0xd2, 0x7c, 0x29, 0xdb, 0x0f, 0xf6, 0x23, 0x62,
+		0xd5, 0xf6, 0x3a, 0xbe, 0xd0, 0x4d, 0x18, 0x61, 0x9e, 0x6f, 0x33, 0xd7, 0xe7, 0x7e, 0x51, 0x5d,
+		0x9a, 0x56, 0xd2, 0x78, 0x80, 0x93, 0x9d, 0x37, 0xbd, 0x84, 0x5a, 0xc3, 0x54, 0xfe, 0x42, 0x4b,
+		0x30, 0xe0, 0x05, 0x51, 0x4a, 0xeb, 0x03, 0x1c, 0xef, 0x9c, 0x12, 0x6f, 0x0d, 0xef, 0xfb, 0x21,
+		0x76, 0x2d, 0x01, 0x8a, 0x30, 0xcc, 0xb5, 0x54, 0x6d, 0xf3, 0xd0, 0xb1, 0x69, 0x68, 0x3b, 0x7e,
+		0x98, 0x10, 0x9b, 0x65, 0x8c, 0x30, 0xa5, 0xf5, 0x41, 0x4e, 0xee, 0xcc, 0x82, 0xc8, 0x28, 0x0b,
+		0x59, 0x46, 0x59, 0xb8, 0x2d, 0x33, 0x8e, 0x75, 0xae, 0x45, 0x82, 0x6b, 0xf7, 0x41, 0xb8, 0xc2,
+		0xf0, 0x1f, 0x08, 0x74, 0xf4, 0x10, 0xce, 0x72, 0x91, 0x34, 0xd4, 0x87, 0xba, 0x51, 0x9f, 0x62,
+		0xd8, 0x2a, 0xc2, 0x9d, 0x4e, 0x39, 0x5c, 0x08, 0xae, 0x69, 0x80, 0x58, 0xd8, 0x94, 0xd9, 0x6b,
+		0x84, 0xcf, 0x8e, 0xc8, 0x91, 0x55, 0x17, 0x39, 0x50, 0xef, 0xb0, 0xa7, 0x1d, 0x93, 0x34, 0x21,
+		0x76, 0x14, 0xfa, 0x9e, 0xb3, 0x5f, 0x87, 0x39, 0xe3, 0xe2, 0xd8, 0xd2, 0xa5, 0x52, 0xcb, 0xad,
+		0xba, 0x16, 0x43, 0x59, 0xe3, 0x18, 0xd6, 0xc4, 0x9e, 0x6a, 0x18, 0xad, 0x40, 0x2d, 0x26, 0x34,
+		0xde, 0xcf, 0x08, 0x57, 0xb9, 0xa4, 0x73, 0x4a, 0xc2, 0x16, 0x03, 0x94, 0xe4, 0xaa, 0x71, 0xfb,
+		0x03, 0x9d, 0x87, 0x51, 0x27, 0x66, 0xb6, 0x91, 0x39, 0xb3, 0x5e, 0xe3, 0xb2, 0xd4, 0xd8, 0xe0,
+		0xba, 0x1c, 0x43, 0x97, 0xa1, 0xbf, 0x49, 0x9a, 0x61, 0x7d, 0x54, 0xea, 0x52, 0xb5, 0xc2, 0x7d,
+		0xd2, 0x0c, 0x2d, 0x0e, 0x86, 0x2c, 0x38, 0x95, 0x10, 0x1c, 0x3b, 0xdb, 0x36, 0xa6, 0x34, 0xf6,
+		0x36, 0x52, 0x4a, 0x92, 0xfa, 0x18, 0xc7, 0xbd, 0xa0, 0xc4, 0x5d, 0xe7, 0xd0, 0xcb, 0x2d, 0x60,
+		0xeb, 0x64, 0x52, 0x18, 0x41, 0x57, 0x61, 0x70, 0x9b, 0x60, 0x97, 0xc4, 0xf5, 0x13, 0x9c, 0xd0,
+		0x59, 0x25, 0xa1, 0x37, 0x38, 0x88, 0x25, 0x41, 0xd1, 0x4d, 0xa8, 0xba, 0xc4, 0xc7, 0xfb, 0xc2,
+		0x37, 0xea, 0x27, 0xbb, 0xb9, 0x02, 0x70, 0x68, 0xee, 0x0b, 0xe8, 0x15, 0xa8, 0xbd, 0xe7, 0x51,
+		0x4a, 0x62, 0x89, 0x7c, 0xaa, 0x1b, 0x72, 0x55, 0x80, 0xb7, 0xb0, 0x37, 0xbd, 0x38, 0xa1, 0x76,
+		0x9c, 0x06, 0x36, 0xa6, 0x75, 0xc4, 0xb1, 0x1b, 0x07, 0xb0, 0x1f, 0x64, 0xbb, 0xa6, 0x05, 0x1c,
+		0xde, 0x4a, 0x83, 0x65, 0x8a, 0xde, 0x85, 0xd3, 0xdc, 0x28, 0xe1, 0x2e, 0x89, 0x7d, 0x1c, 0x65,
+		0x06, 0x3e, 0xcd, 0x3d, 0x47, 0x9d, 0xaa, 0x56, 0xe2, 0x30, 0xf8, 0xae, 0x00, 0x97, 0x66, 0x3e,
+		0xe5, 0x14, 0x87, 0xd0, 0xfb, 0x30, 0x8b, 0x1d, 0xea, 0xed, 0x12, 0xdb, 0xf1, 0xd3, 0x84, 0xcb,
+		0x46, 0x7c, 0xe2, 0xf0, 0xe0, 0x94, 0x6b, 0x8c, 0x73, 0x46, 0xaf, 0x28, 0xd7, 0x58, 0xe6, 0xb8,
+		0x2b, 0x02, 0x75, 0x3d, 0xc3, 0x94, 0xcb, 0x9d, 0xc3, 0x25, 0xb3, 0x2c, 0x7f, 0xf1, 0x20, 0x8d,
+		0x62, 0x2f, 0x8c, 0x59, 0x40, 0x4d, 0x70, 0x59, 0xe6, 0xb5, 0xb9, 0x67, 0x4d, 0x02, 0x5a, 0x35,
+		0xda, 0xf1, 0x65, 0xde, 0x80, 0x19, 0x5d, 0x86, 0x95, 0x1b, 0xc1, 0x04, 0x0c, 0x32, 0x9d, 0x7b,
+		0xae, 0x4c, 0xb1, 0x03, 0x71, 0x1a, 0xac, 0xba, 0x66, 0x0c, 0xa6, 0x1a, 0x71, 0x39, 0xd9, 0x0f,
+		0x9c, 0x2c, 0x3f, 0xbf, 0x09, 0x43, 0x32, 0x88, 0x39, 0x76, 0x75, 0x69, 0x49, 0xed, 0xaf, 0x65,
+		0x49, 0xde, 0xca, 0x48, 0x98, 0x17, 0xe0, 0x7c, 0xe9, 0x9a, 0x82, 0x63, 0xf3, 0x25, 0x98, 0xd3,
+		0x1f, 0x2b, 0xca, 0xa5, 0xfa, 0x73, 0x05, 0x66, 0xd6, 0xbd, 0xad, 0x00, 0xfb, 0x5f, 0x87, 0x13,
+		0x49, 0x3e, 0x69, 0xf6, 0x17, 0x93, 0xe6, 0x2c, 0x54, 0x13, 0x2e, 0x8b, 0x1d, 0xe0, 0x26, 0xe1,
+		0xbb, 0xcc, 0x88, 0x05, 0x62, 0xe8, 0x2d, 0xdc, 0x24, 0xe8, 0x75, 0xa8, 0x49, 0x00, 0xb1, 0x0f,
+		0x0d, 0xf6, 0xb0, 0x0f, 0x49, 0x92, 0xab, 0x7c, 0x37, 0xaa, 0xc3, 0x90, 0x13, 0x06, 0x34, 0x0e,
+		0x7d, 0xbe, 0x2d, 0xd4, 0xac, 0xec, 0xd3, 0x9c, 0x87, 0x59, 0xad, 0x1e, 0xa5, 0x99, 0xfe, 0x5d,
+		0x81, 0x99, 0x77, 0x22, 0x17, 0xd3, 0x27, 0xe6, 0xd4, 0x74, 0x16, 0x46, 0x52, 0xce, 0x10, 0x53,
+		0xa7, 0x54, 0xb6, 0x18, 0x10, 0xda, 0x94, 0x93, 0x5c, 0x9b, 0x42, 0xdb, 0x20, 0x86, 0xb8, 0x36,
+		0x8f, 0xb2, 0x9d, 0x77, 0x5a, 0x77, 0xb0, 0x60, 0xdd, 0xb7, 0x60, 0x6c, 0x0f, 0x7b, 0xd4, 0xde,
+		0x0c, 0x79, 0xca, 0xdc, 0x22, 0x5c, 0xc7, 0x63, 0x4b, 0x17, 0x4b, 0x05, 0x14, 0x1a, 0x5d, 0x67,
+		0xf0, 0x56, 0x8d, 0xe1, 0xdf, 0x0d, 0x63, 0xfe, 0x65, 0xfe, 0xcb, 0x80, 0x59, 0xad, 0xbe, 0x65,
+		0x58, 0xe4, 0x34, 0x60, 0x14, 0x34, 0xf0, 0x1a, 0x0c, 0x08, 0x3e, 0x2a, 0x87, 0xe4, 0x43, 0xa0,
+		0xa1, 0x17, 0xd8, 0x01, 0x3a, 0x49, 0x7d, 0x2a, 0x0f, 0x5b, 0xe5, 0x1a, 0x92, 0xb0, 0xe8, 0x3a,
+		0x0c, 0x6d, 0x62, 0xcf, 0x4f, 0x63, 0x22, 0xcf, 0x57, 0x6a, 0xb4, 0xbb, 0x02, 0xc6, 0xca, 0x80,
+		0xcd, 0xff, 0x1a, 0xf0, 0x8c, 0x74, 0x41, 0x8f, 0x6e, 0x97, 0x1f, 0x23, 0x1f, 0xc2, 0xa8, 0x38,
+		0xed, 0x3c, 0x7e, 0xb2, 0xaa, 0x71, 0x42, 0x19, 0xe1, 0x42, 0x08, 0x56, 0xba, 0x86, 0x60, 0xdf,
+		0x63, 0x84, 0x60, 0x7f, 0x3e, 0x04, 0x97, 0xe1, 0x62, 0x77, 0xf9, 0xcb, 0xd3, 0xe1, 0x27, 0x06,
+		0x3c, 0xdf, 0x8d, 0x46, 0x2e, 0xdf, 0xbf, 0x5b, 0xcc, 0xf7, 0xaf, 0xa8, 0x55, 0xd8, 0x9b, 0x5d,
+		0xda, 0x99, 0x7f, 0x11, 0x2e, 0xf7, 0xc8, 0x87, 0x4c, 0x2e, 0x9f, 0x56, 0x60, 0xda, 0x22, 0x09,
+		0x79, 0x62, 0x6e, 0x96, 0xed, 0xdb, 0x63, 0x5f, 0xe7, 0xed, 0x11, 0xdd, 0x80, 0xba, 0x4b, 0x1c,
+		0x2f, 0x61, 0x47, 0x86, 0x4d, 0x2f, 0xf0, 0x92, 0x6d, 0x9b, 0xec, 0x92, 0xa0, 0x95, 0xd1, 0xfb,
+		0xac, 0x89, 0x6c, 0xfe, 0x2e, 0x9f, 0xbe, 0xc3, 0x66, 0x57, 0xdd, 0x42, 0xf2, 0x1f, 0x28, 0x26,
+		0xff, 0x05, 0x38, 0x9d, 0xec, 0x78, 0x91, 0x2d, 0xbd, 0x2b, 0x26, 0x38, 0x8a, 0x7c, 0x91, 0x64,
+		0x86, 0xad, 0x53, 0x6c, 0x4a, 0x28, 0xd4, 0x12, 0x13, 0xec, 0x20, 0xa0, 0xd3, 0x57, 0xb9, 0x8f,
+		0xfc, 0xaa, 0x02, 0x17, 0xa4, 0x4e, 0x57, 0x70, 0xe0, 0x90, 0x6f, 0xc2, 0xce, 0x39, 0x0e, 0x03,
+		0x0e, 0x4e, 0x93, 0x6c, 0xcf, 0x14, 0x1f, 0xe8, 0x2a, 0x4c, 0x8a, 0x33, 0x68, 0xfb, 0x06, 0x26,
+		0x15, 0x22, 0x52, 0xf7, 0x69, 0x3e, 0xdb, 0xe6, 0x89, 0xab, 0xe7, 0x22, 0x3c, 0xdd, 0x4d, 0x3b,
+		0xd2, 0x65, 0x3f, 0xab, 0xc0, 0xfc, 0x03, 0x12, 0x37, 0xbd, 0xe0, 0x09, 0xda, 0x12, 0x75, 0x6e,
+		0x7b, 0x1d, 0x86, 0x5c, 0x42, 0xb1, 0xe7, 0x27, 0xa5, 0x59, 0x39, 0x4b, 0x59, 0x19, 0x70, 0xce,
+		0x28, 0x03, 0x05, 0xa3, 0x1c, 0x49, 0xbf, 0x4f, 0x81, 0x59, 0xa6, 0x34, 0xa9, 0xdb, 0x7f, 0x18,
+		0x30, 0xbd, 0xc6, 0x8c, 0xf8, 0xa4, 0xe8, 0x75, 0x16, 0xaa, 0xfc, 0x7c, 0xef, 0xd1, 0xfd, 0xf6,
+		0x61, 0x03, 0xb2, 0xa1, 0x55, 0x57, 0x57, 0x6d, 0x2a, 0x53, 0xa0, 0x39, 0x07, 0x33, 0x3a, 0x21,
+		0xa5, 0x1e, 0xfe, 0xc9, 0xce, 0x00, 0x41, 0xf4, 0x8d, 0xd7, 0x84, 0x09, 0x73, 0x7a, 0x31, 0xa5,
+		0x2e, 0xfe, 0x68, 0xc0, 0x38, 0x4f, 0x79, 0xcb, 0x72, 0xad, 0x27, 0x55, 0x01, 0x9d, 0x82, 0xf6,
+		0x17, 0x04, 0x9d, 0x82, 0x89, 0x82, 0x0c, 0x52, 0xba, 0xff, 0x18, 0x30, 0x77, 0x9b, 0x24, 0x4e,
+		0xec, 0x6d, 0x3c, 0x31, 0xa6, 0xde, 0x80, 0x29, 0x5e, 0x6b, 0xb6, 0x9d, 0x30, 0x48, 0xbc, 0x84,
+		0x92, 0xc0, 0xd9, 0xb7, 0x7d, 0xb2, 0x4b, 0x7c, 0x2e, 0xb5, 0xae, 0x88, 0xf3, 0x36, 0xc3, 0x59,
+		0x69, 0xa3, 0xbc, 0xc9, 0x30, 0xac, 0x89, 0x47, 0xaa, 0x61, 0xf3, 0x0f, 0xfd, 0x30, 0x5f, 0x22,
+		0xb7, 0xdc, 0xcb, 0x7c, 0x98, 0x6a, 0x27, 0x19, 0x27, 0x0c, 0x36, 0xbd, 0x2d, 0x59, 0x76, 0x90,
+		0xe7, 0x96, 0xab, 0xbd, 0x49, 0xb9, 0xd2, 0x89, 0x6a, 0x4d, 0x12, 0xe5, 0x38, 0x93, 0xfb, 0xa0,
+		0x3a, 0x6d, 0x2f, 0xd8, 0x0c, 0xa5, 0x4e, 0x2f, 0xf5, 0xb6, 0xda, 0x6a, 0xb0, 0x19, 0xb6, 0x8b,
+		0x57, 0xb9, 0x61, 0xf4, 0x10, 0x50, 0x44, 0x02, 0xd7, 0x0b, 0xb6, 0x6c, 0xe9, 0x3a, 0x1e, 0x49,
+		0xea, 0x7d, 0x73, 0x7d, 0x17, 0xab, 0x9a, 0x93, 0xfa, 0x9a, 0x00, 0xcf, 0x3c, 0x87, 0x13, 0x3f,
+		0x15, 0xe5, 0x06, 0x3d, 0x92, 0xa0, 0xef, 0xc1, 0xc9, 0x8c, 0xb0, 0xb3, 0xed, 0xf9, 0x6e, 0x4c,
+		0x58, 0x20, 0x32, 0xb2, 0x0b, 0x65, 0x64, 0x57, 0x18, 0x6c, 0x9e, 0xf3, 0x13, 0x51, 0xc7, 0x54,
+		0x4c, 0x02, 0xb4, 0xde, 0x26, 0x9d, 0x9d, 0x71, 0xe4, 0xe5, 0xa9, 0x94, 0xe3, 0xdb, 0x12, 0x36,
+		0x47, 0x34, 0x1b, 0x44, 0xaf, 0x02, 0xf0, 0xc0, 0x17, 0xfa, 0x15, 0x57, 0xda, 0x19, 0xcd, 0xe6,
+		0x94, 0x26, 0x84, 0x13, 0x19, 0x89, 0xb2, 0x9f, 0xe6, 0xc7, 0x7d, 0x30, 0xce, 0x1d, 0x2e, 0xd3,
+		0xfe, 0x57, 0x14, 0x2b, 0x2f, 0xc2, 0x00, 0x77, 0x70, 0x79, 0x23, 0x30, 0x4b, 0x29, 0x71, 0x86,
+		0x2d, 0x81, 0x80, 0x6c, 0x98, 0x14, 0x51, 0x16, 0x93, 0xf7, 0x88, 0x43, 0x99, 0x7b, 0xbb, 0x1e,
+		0x67, 0xaa, 0x9f, 0x07, 0xd9, 0xb3, 0xfa, 0x20, 0xb3, 0x38, 0xc6, 0x4a, 0x86, 0x60, 0x8d, 0x3f,
+		0x52, 0x8c, 0x96, 0x85, 0xf1, 0xc0, 0x71, 0x85, 0xf1, 0x6f, 0x0c, 0x98, 0x28, 0x98, 0x41, 0x86,
+		0xee, 0xeb, 0x50, 0xcb, 0xc4, 0xe3, 0x77, 0x49, 0xa3, 0x97, 0x1b, 0x93, 0x94, 0x83, 0x5f, 0x28,
+		0x57, 0x61, 0xac, 0x53, 0x3f, 0xc4, 0x95, 0xc6, 0x32, 0xbb, 0xe9, 0x85, 0xb8, 0xd6, 0xe8, 0xa3,
+		0xce, 0x4f, 0xf3, 0x73, 0x03, 0xa6, 0xb2, 0x64, 0xd3, 0x2a, 0xf0, 0x77, 0xf1, 0x97, 0xdc, 0x8b,
+		0x41, 0xe5, 0x70, 0x2f, 0x06, 0xf7, 0x60, 0xac, 0x85, 0xdb, 0x7e, 0xb6, 0x28, 0x2b, 0xfb, 0x31,
+		0x34, 0xf1, 0x6c, 0x41, 0x3b, 0xbe, 0xd8, 0xad, 0xc3, 0x0b, 0x1c, 0x3f, 0x75, 0x89, 0xdd, 0x26,
+		0x98, 0x50, 0x4c, 0x53, 0x71, 0x9e, 0x1b, 0xb6, 0x26, 0xe4, 0x7c, 0x46, 0x64, 0x9d, 0x4f, 0x9a,
+		0xbf, 0xaf, 0x40, 0xfd, 0xa0, 0xc4, 0xd2, 0x34, 0x2f, 0xc1, 0x50, 0x14, 0xfa, 0x3e, 0x89, 0x93,
+		0xba, 0xc1, 0x33, 0xc4, 0xac, 0xda, 0x2a, 0x1c, 0x86, 0x07, 0x5e, 0x06, 0x8f, 0xee, 0xc3, 0xc9,
+		0x03, 0x8c, 0x08, 0xe5, 0x9c, 0x2f, 0x95, 0x4d, 0xb0, 0x65, 0x8d, 0xd1, 0xdc, 0x37, 0x7a, 0x08,
+		0x27, 0x23, 0x1c, 0x53, 0xaf, 0x23, 0xbf, 0xcb, 0x40, 0x7a, 0xbe, 0x94, 0xdc, 0x5a, 0x86, 0x24,
+		0x12, 0xb8, 0x75, 0x22, 0xca, 0x0f, 0x3c, 0xce, 0x7b, 0x8f, 0x79, 0x0d, 0xce, 0xde, 0x23, 0x34,
+		0x9b, 0x48, 0x6e, 0xed, 0xdf, 0xe6, 0x1e, 0xd1, 0xc5, 0x61, 0xcc, 0x9f, 0xf7, 0xc3, 0x39, 0x35,
+		0x9e, 0x54, 0xfb, 0x0f, 0x60, 0xb2, 0x75, 0x85, 0x6c, 0x2b, 0xb1, 0x89, 0x23, 0x69, 0x85, 0xef,
+		0x28, 0x19, 0x2c, 0x23, 0xb9, 0x90, 0x65, 0xd3, 0x0c, 0xe2, 0x3e, 0x8e, 0xee, 0x04, 0x34, 0xde,
+		0xb7, 0x4e, 0xbb, 0x07, 0x67, 0x18, 0x03, 0xad, 0x13, 0x4c, 0x9e, 0x81, 0xca, 0x51, 0x19, 0xc8,
+		0x76, 0xa5, 0x83, 0x0c, 0xe0, 0x83, 0x33, 0x8d, 0x94, 0x39, 0xa5, 0x9a, 0x63, 0x74, 0x12, 0xfa,
+		0x76, 0xc8, 0xbe, 0xd4, 0x29, 0xfb, 0x89, 0x56, 0x60, 0x60, 0x17, 0xfb, 0x29, 0x91, 0x0e, 0x76,
+		0x59, 0xc9, 0x9d, 0xce, 0xc9, 0x2d, 0x81, 0x7b, 0xb3, 0xf2, 0xa2, 0xc1, 0x96, 0xd5, 0xf1, 0xf9,
+		0x05, 0x2e, 0x6b, 0x26, 0x30, 0xcd, 0x03, 0xb9, 0xe8, 0xb3, 0xc9, 0x17, 0x98, 0x7a, 0xcc, 0x4f,
+		0x2a, 0x30, 0xa3, 0x5b, 0x55, 0xfa, 0xe1, 0x23, 0x98, 0x56, 0xb8, 0x41, 0x2b, 0x82, 0xb2, 0xa4,
+		0xb0, 0xd0, 0x5b, 0x04, 0xde, 0x27, 0x14, 0xbb, 0x98, 0x62, 0xab, 0x51, 0xb4, 0x78, 0x7b, 0x69,
+		0xb6, 0xa4, 0xc2, 0xf5, 0x3b, 0x96, 0xac, 0x1c, 0x6d, 0xc9, 0xa2, 0x97, 0xb7, 0x97, 0x64, 0x27,
+		0xee, 0x7b, 0x84, 0xca, 0x67, 0x19, 0x9e, 0xc4, 0x64, 0x8d, 0xea, 0x23, 0x03, 0x26, 0x8b, 0x33,
+		0x52, 0x33, 0xdb, 0x70, 0x26, 0x49, 0xa3, 0x28, 0x8c, 0x29, 0x71, 0x6d, 0xc7, 0xf7, 0x48, 0x40,
+		0xed, 0x5d, 0x12, 0x27, 0x52, 0x2b, 0xfa, 0xbc, 0xb4, 0x9e, 0x61, 0xad, 0x70, 0xa4, 0x77, 0x25,
+		0x8e, 0x35, 0x95, 0xa8, 0x27, 0xcc, 0xcf, 0xfb, 0xc0, 0xbc, 0xa7, 0xa8, 0xe2, 0xbc, 0x21, 0xda,
+		0x40, 0xbe, 0xba, 0xc2, 0x7a, 0x84, 0xb7, 0x88, 0x9d, 0x78, 0x1f, 0x88, 0x2d, 0x6b, 0xc0, 0x1a,
+		0x66, 0x03, 0xeb, 0xde, 0x07, 0x04, 0x3d, 0x0d, 0x27, 0x02, 0xf2, 0x3e, 0xb3, 0xda, 0x16, 0xb1,
+		0x69, 0xb8, 0x43, 0x02, 0x59, 0xc9, 0x1c, 0x65, 0xc3, 0x6b, 0x78, 0x8b, 0x3c, 0x60, 0x83, 0xe8,
+		0x39, 0x40, 0xad, 0x7a, 0x78, 0x40, 0xf6, 0x44, 0x99, 0x8c, 0x9f, 0x38, 0x86, 0xad, 0x13, 0xb2,
+		0xd2, 0xfd, 0x16, 0xd9, 0xe3, 0xf5, 0x31, 0x64, 0xc3, 0x19, 0xd9, 0xf9, 0x22, 0xcb, 0x69, 0x9b,
+		0x9e, 0x4f, 0x49, 0x2c, 0x36, 0xcd, 0x41, 0xbe, 0x69, 0x3e, 0xa5, 0x94, 0x87, 0xa3, 0xdf, 0xe5,
+		0xc0, 0x7c, 0xdf, 0x9c, 0x94, 0x64, 0x0a, 0xe3, 0xe8, 0x3c, 0x8c, 0xf2, 0xfa, 0x1a, 0x8e, 0x9d,
+		0x6d, 0x6f, 0x17, 0x8b, 0x07, 0x90, 0x61, 0xab, 0xc6, 0x06, 0x97, 0xe5, 0x58, 0xd9, 0x49, 0x69,
+		0xf8, 0xb8, 0x4e, 0x4a, 0x7f, 0x37, 0xe0, 0x7c, 0xa9, 0xc5, 0xa5, 0x0f, 0x5e, 0x87, 0x21, 0x29,
+		0x4a, 0xe9, 0x91, 0x29, 0x43, 0xcb, 0x80, 0xd1, 0x6b, 0x50, 0x8d, 0xf1, 0x9e, 0x9d, 0xe1, 0x8a,
+		0x80, 0x52, 0xa7, 0x8d, 0xdb, 0x98, 0xe2, 0x5b, 0x7e, 0xb8, 0x61, 0x41, 0x8c, 0xf7, 0x24, 0x21,
+		0x95, 0x79, 0xfb, 0x54, 0xe6, 0x6d, 0xc0, 0xb0, 0xd0, 0x25, 0x71, 0xe5, 0x11, 0xa4, 0xf5, 0x6d,
+		0xee, 0x43, 0xed, 0x2e, 0xc1, 0x34, 0x8d, 0xc9, 0x5d, 0x1f, 0x6f, 0x25, 0xc8, 0x83, 0x25, 0xc5,
+		0x85, 0x0a, 0xfb, 0x31, 0xc1, 0x2e, 0x53, 0x76, 0x33, 0xf2, 0x09, 0x0b, 0x35, 0x12, 0xc7, 0x61,
+		0x6c, 0x93, 0x00, 0x6f, 0xf8, 0x44, 0x94, 0x2d, 0x87, 0xad, 0xcb, 0x07, 0xdc, 0x73, 0x59, 0xe0,
+		0xad, 0x64, 0x68, 0x77, 0x18, 0xd6, 0x1d, 0x81, 0x64, 0xfe, 0xd4, 0x80, 0xb3, 0x16, 0xd9, 0x8c,
+		0x49, 0xb2, 0xdd, 0x6a, 0x03, 0xc1, 0xc9, 0x4e, 0xf2, 0xd5, 0x44, 0x92, 0x39, 0x03, 0xe7, 0xd4,
+		0xdc, 0xc8, 0x6b, 0xff, 0x5f, 0x2a, 0x30, 0xb1, 0x12, 0x13, 0x4c, 0x49, 0xd6, 0x6c, 0xd0, 0x43,
+		0xab, 0x4c, 0xd6, 0xab, 0xd0, 0xd1, 0x2a, 0x93, 0x0d, 0xad, 0xba, 0xe8, 0x1a, 0xf4, 0x27, 0x11,
+		0x71, 0x4a, 0x3b, 0x64, 0xb2, 0xc5, 0xd6, 0x23, 0xe2, 0x58, 0x1c, 0x1c, 0xbd, 0x0c, 0x83, 0xd8,
+		0x69, 0x5d, 0x3b, 0x74, 0xe7, 0xb8, 0x0c, 0x71, 0x99, 0x83, 0x5a, 0x12, 0x05, 0xbd, 0x0d, 0x63,
+		0x85, 0xb7, 0xfa, 0xb2, 0x9b, 0x45, 0x46, 0x24, 0xff, 0x5e, 0x3f, 0x1a, 0xe6, 0xde, 0xea, 0x27,
+		0x61, 0x90, 0xdf, 0xf2, 0x5c, 0x59, 0x03, 0x97, 0x5f, 0xb9, 0xea, 0xca, 0x50, 0xa1, 0xba, 0x52,
+		0x87, 0xc9, 0xa2, 0x32, 0xa5, 0x9e, 0xad, 0xf6, 0xc1, 0xff, 0xb8, 0x14, 0x6d, 0xfe, 0xb5, 0xbf,
+		0x7d, 0xb6, 0x2e, 0x2e, 0xd8, 0xb2, 0x82, 0x71, 0x54, 0x2b, 0x54, 0x8e, 0xc3, 0x0a, 0x7d, 0xc7,
+		0x67, 0x85, 0xfe, 0x9c, 0x15, 0xe6, 0xa1, 0x26, 0x6e, 0xed, 0xb2, 0xd4, 0x27, 0x0a, 0x7a, 0x55,
+		0x3e, 0x66, 0x89, 0x7a, 0xdf, 0x3b, 0x80, 0xe2, 0x34, 0x08, 0xbc, 0x60, 0xab, 0x1d, 0x50, 0x49,
+		0x7d, 0x90, 0xe7, 0xa3, 0x9e, 0x23, 0x4a, 0x52, 0x68, 0x8d, 0x24, 0x68, 0x0d, 0xc6, 0x62, 0xe2,
+		0xb0, 0x2d, 0x42, 0x48, 0x9d, 0xd4, 0x87, 0x38, 0xc9, 0x67, 0x7b, 0xd1, 0x14, 0xbf, 0x51, 0x5a,
+		0xa3, 0x82, 0x80, 0x18, 0x4b, 0xd0, 0xb7, 0x61, 0x8c, 0x67, 0xbc, 0x38, 0x0d, 0x78, 0xd7, 0x54,
+		0x52, 0x1f, 0xe6, 0x14, 0xcb, 0xba, 0x55, 0x6a, 0x0c, 0xc3, 0x4a, 0x03, 0x3e, 0xc2, 0x36, 0x17,
+		0x1a, 0x52, 0xec, 0xb7, 0x58, 0x1a, 0xe1, 0x2f, 0x41, 0x35, 0x3e, 0x98, 0x2d, 0xf3, 0x0c, 0x9c,
+		0x60, 0x9b, 0x4d, 0x44, 0xdc, 0x16, 0x18, 0x70, 0xb0, 0x31, 0x39, 0x2c, 0x01, 0xcd, 0xcf, 0x2a,
+		0x30, 0x21, 0x9f, 0x63, 0xff, 0x9f, 0x13, 0x72, 0xde, 0x58, 0xf2, 0xfc, 0xce, 0x62, 0xbf, 0xa8,
+		0x34, 0x19, 0xfb, 0x3f, 0x32, 0x60, 0x9c, 0xd7, 0x8e, 0x8e, 0x4d, 0x9d, 0xba, 0x57, 0x96, 0x2e,
+		0x95, 0xdf, 0x02, 0x13, 0x92, 0x3d, 0x9f, 0x97, 0x84, 0xd3, 0xe6, 0xf1, 0xb1, 0x57, 0xd6, 0x4d,
+		0x5a, 0x87, 0xc9, 0xe2, 0x6a, 0xed, 0xf7, 0xac, 0xa9, 0x5b, 0xd8, 0xd9, 0xd9, 0xf4, 0x7c, 0xff,
+		0xd8, 0x58, 0x79, 0x09, 0x40, 0xf6, 0x25, 0x7a, 0xcd, 0xac, 0x69, 0xb3, 0x2c, 0xae, 0x46, 0x38,
+		0x34, 0xfb, 0x46, 0xd7, 0x60, 0x98, 0x04, 0xae, 0x40, 0xec, 0xef, 0x8a, 0x38, 0x44, 0x02, 0x97,
+		0xa3, 0x7d, 0xc9, 0x6e, 0xd7, 0x80, 0xfa, 0x41, 0xa5, 0xb5, 0x2d, 0x7b, 0x9b, 0xb0, 0x23, 0xca,
+		0x97, 0x65, 0xd9, 0xe2, 0x6a, 0x92, 0x8f, 0x04, 0xc6, 0x79, 0xad, 0x45, 0x8e, 0x77, 0x3d, 0x0b,
+		0xe5, 0x8e, 0xff, 0x95, 0xee, 0xc7, 0x7f, 0xd5, 0xf9, 0xd0, 0xdc, 0x80, 0x89, 0xc2, 0xa2, 0x72,
+		0x67, 0x9c, 0x87, 0x5a, 0x87, 0x90, 0xe2, 0x96, 0x39, 0x62, 0x55, 0xdb, 0x52, 0x26, 0xaa, 0x35,
+		0x2a, 0x8a, 0x35, 0x96, 0x3e, 0x9a, 0x81, 0x6a, 0xb6, 0x69, 0x2c, 0xaf, 0xad, 0xa2, 0x1f, 0x1b,
+		0x50, 0xd7, 0xb5, 0x92, 0xa1, 0x17, 0x34, 0x8d, 0xa1, 0xa5, 0x0d, 0xed, 0x8d, 0x6b, 0x87, 0xc4,
+		0x92, 0x42, 0xfe, 0xd0, 0x80, 0x49, 0x75, 0xdf, 0x03, 0x3a, 0x42, 0x97, 0x4a, 0xe3, 0xea, 0xa1,
+		0x70, 0x24, 0x0f, 0xbf, 0x30, 0xe0, 0x6c, 0x49, 0xef, 0x05, 0xba, 0x71, 0x08, 0xa2, 0x9d, 0x5d,
+		0x23, 0x8d, 0x17, 0x0f, 0x8f, 0x28, 0x59, 0xfa, 0xd8, 0x80, 0x29, 0x4d, 0x9f, 0x19, 0xba, 0x5a,
+		0xd6, 0x7a, 0xa2, 0x53, 0xcc, 0x0b, 0x87, 0x43, 0xea, 0x60, 0x43, 0xd3, 0x5a, 0xa5, 0x61, 0xa3,
+		0xbc, 0xf1, 0x4d, 0xc3, 0x46, 0xb7, 0xee, 0xad, 0x5f, 0x1b, 0x30, 0xd7, 0xad, 0x4d, 0x06, 0x3d,
+		0x56, 0x47, 0x4e, 0xe3, 0xd5, 0x23, 0x62, 0x4b, 0x0e, 0x3f, 0x35, 0xe0, 0x42, 0x4f, 0x8d, 0x3c,
+		0x68, 0xf9, 0x48, 0x0b, 0xe5, 0xdc, 0xea, 0xd6, 0xe3, 0x90, 0xe8, 0x88, 0x3b, 0x75, 0x5f, 0x8c,
+		0x26, 0xee, 0x4a, 0x9b, 0x8e, 0x34, 0x71, 0xd7, 0xa5, 0xf1, 0xe6, 0x97, 0x06, 0xcc, 0x94, 0xf7,
+		0x90, 0xa0, 0x9b, 0x1a, 0xba, 0x3d, 0xb4, 0xe5, 0x34, 0x5e, 0x3e, 0x12, 0xae, 0xe4, 0xed, 0x67,
+		0x06, 0x34, 0xf4, 0xfd, 0x17, 0xe8, 0xba, 0xba, 0xf0, 0xd6, 0xad, 0xcb, 0xa5, 0x71, 0xe3, 0xd0,
+		0x78, 0x1d, 0xf6, 0x52, 0xf7, 0x40, 0x68, 0xec, 0x55, 0xda, 0x15, 0xa2, 0xb1, 0x57, 0x79, 0x93,
+		0x05, 0xdf, 0x35, 0x74, 0xdd, 0x07, 0x9a, 0x5d, 0xa3, 0x4b, 0x4f, 0x86, 0x66, 0xd7, 0xe8, 0xd6,
+		0xe2, 0x80, 0x36, 0x61, 0x34, 0xd7, 0x1d, 0x80, 0x9e, 0xd5, 0xfb, 0x5f, 0xa1, 0x0b, 0xa2, 0x71,
+		0xa9, 0x17, 0x50, 0xb9, 0xce, 0x4f, 0x0c, 0x38, 0xa3, 0x7d, 0x74, 0x47, 0xd7, 0x4a, 0x2b, 0xdd,
+		0x5a, 0x99, 0xaf, 0x1f, 0x16, 0xad, 0x2d, 0x74, 0xee, 0xe5, 0x10, 0x95, 0x3c, 0x78, 0x16, 0x1e,
+		0x79, 0x1b, 0x97, 0x7a, 0x01, 0x95, 0xeb, 0x84, 0x70, 0xb2, 0x58, 0xad, 0x47, 0xcf, 0xf7, 0x58,
+		0xd4, 0x17, 0xab, 0x1d, 0xee, 0x09, 0x00, 0x7d, 0x08, 0xe3, 0xaa, 0x37, 0x13, 0xf4, 0xad, 0x43,
+		0x3c, 0xaf, 0x88, 0x85, 0xaf, 0x1c, 0xfa, 0x41, 0x86, 0x07, 0x96, 0xba, 0xfe, 0xaf, 0x09, 0xac,
+		0xd2, 0x27, 0x0a, 0x4d, 0x60, 0x75, 0x79, 0x60, 0xf0, 0x60, 0x2c, 0x5f, 0x60, 0x47, 0x97, 0x74,
+		0x82, 0x1c, 0xac, 0xcf, 0x37, 0x9e, 0xeb, 0x09, 0xb6, 0xe3, 0xac, 0x53, 0x52, 0x55, 0xd5, 0x9c,
+		0x75, 0xba, 0x57, 0xde, 0x35, 0x67, 0x9d, 0x5e, 0x0a, 0xb8, 0x1f, 0xc2, 0xb8, 0xaa, 0xf4, 0xa7,
+		0x31, 0x7f, 0x49, 0xcd, 0x52, 0x63, 0xfe, 0xb2, 0xba, 0xa2, 0x88, 0x70, 0xdd, 0x9f, 0x06, 0x75,
+		0x11, 0xde, 0xe5, 0x4f, 0x91, 0xba, 0x08, 0xef, 0xfa, 0xdf, 0x44, 0x0f, 0xc6, 0xf2, 0x65, 0x39,
+		0x8d, 0x1f, 0x28, 0x0b, 0xa1, 0x1a, 0x3f, 0x50, 0xd7, 0xf9, 0x3a, 0x83, 0xbc, 0xb5, 0x58, 0x79,
+		0x90, 0x17, 0x97, 0xbb, 0xdc, 0x23, 0x74, 0x5b, 0xb6, 0x7c, 0xd9, 0x41, 0x23, 0x9b, 0xb2, 0xa0,
+		0xa3, 0x91, 0x4d, 0x5d, 0xc7, 0x60, 0x89, 0x32, 0x57, 0x41, 0xd0, 0x24, 0x4a, 0x55, 0xa9, 0x43,
+		0x93, 0x28, 0x95, 0x05, 0x09, 0x26, 0x52, 0xbe, 0x44, 0x80, 0xb4, 0x7b, 0xcb, 0xc1, 0xaa, 0x85,
+		0x46, 0x24, 0x75, 0xcd, 0x81, 0x99, 0xab, 0x78, 0x7b, 0xd6, 0x98, 0x4b, 0x53, 0x99, 0xd0, 0x98,
+		0x4b, 0x77, 0x25, 0x67, 0xb2, 0xe5, 0x2f, 0xc9, 0x1a, 0xd9, 0x94, 0xf7, 0x76, 0x8d, 0x6c, 0xea,
+		0x5b, 0x37, 0x33, 0x57, 0xee, 0x02, 0xac, 0x31, 0x97, 0xea, 0x66, 0xae, 0x31, 0x97, 0xf2, 0x3e,
+		0x7d, 0xcb, 0x85, 0x29, 0x27, 0x6c, 0xaa, 0x10, 0x6e, 0x8d, 0x67, 0x31, 0xb9, 0x2e, 0xfe, 0xf9,
+		0xbe, 0x16, 0x87, 0x34, 0x5c, 0x33, 0xbe, 0x7f, 0x65, 0xcb, 0xa3, 0xdb, 0xe9, 0xc6, 0x82, 0x13,
+		0x36, 0x17, 0x3b, 0xff, 0xf8, 0x7d, 0xd9, 0x73, 0xfd, 0xc5, 0xad, 0x50, 0xfc, 0xa9, 0x5d, 0xfe,
+		0x0b, 0xfc, 0x65, 0x1c, 0x79, 0xbb, 0x57, 0x36, 0x06, 0xf9, 0xd8, 0xd5, 0xff, 0x05, 0x00, 0x00,
+		0xff, 0xff, 0x31, 0xc7, 0x58, 0xab, 0x59, 0x3f, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/schedule.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xef, 0x6f, 0xd2, 0x40,
+		0x18, 0xc7, 0x2d, 0xfb, 0xc9, 0xc3, 0x36, 0xf1, 0xd4, 0x0c, 0xe7, 0xdc, 0x18, 0x66, 0x6e, 0xd1,
+		0xd8, 0x86, 0x19, 0x5f, 0xe8, 0xe2, 0x0b, 0xc6, 0xba, 0x48, 0x6c, 0x46, 0x73, 0xc0, 0x16, 0x4d,
+		0x4c, 0x73, 0xb4, 0x37, 0x76, 0x52, 0x7a, 0x4d, 0x7b, 0x85, 0xf1, 0xde, 0xbf, 0xc6, 0x3f, 0xc8,
+		0x57, 0xfe, 0x31, 0xa6, 0xed, 0x95, 0xcc, 0x09, 0xe3, 0x0d, 0xe1, 0x1e, 0x3e, 0xdf, 0x87, 0xe7,
+		0xf9, 0x7e, 0x2f, 0x07, 0x95, 0xa8, 0x4b, 0x03, 0xcd, 0x26, 0x0e, 0xf5, 0x6c, 0xaa, 0x11, 0x9f,
+		0x69, 0xc3, 0xaa, 0x16, 0xda, 0xd7, 0xd4, 0x89, 0x5c, 0xaa, 0xfa, 0x01, 0x17, 0x1c, 0x3d, 0x8e,
+		0x19, 0x55, 0x32, 0x2a, 0xf1, 0x99, 0x3a, 0xac, 0x6e, 0xed, 0xf4, 0x38, 0xef, 0xb9, 0x54, 0x4b,
+		0x90, 0x6e, 0x74, 0xa5, 0x39, 0x51, 0x40, 0x04, 0xe3, 0x5e, 0x2a, 0xda, 0xda, 0xbd, 0xfb, 0xbb,
+		0x60, 0x03, 0x1a, 0x0a, 0x32, 0xf0, 0x25, 0x50, 0x9e, 0xf6, 0xcf, 0x36, 0x1f, 0x0c, 0x26, 0x2d,
+		0xa6, 0xce, 0x26, 0x48, 0xd8, 0x77, 0x59, 0x28, 0x52, 0xa6, 0xf2, 0x47, 0x81, 0xb5, 0x96, 0x1c,
+		0xb7, 0xe5, 0x53, 0x1b, 0x1d, 0xc0, 0x43, 0x3b, 0xe0, 0x9e, 0x45, 0x6f, 0xfc, 0x80, 0x86, 0x21,
+		0xe3, 0x5e, 0x49, 0x29, 0x2b, 0x87, 0x79, 0xbc, 0x11, 0x97, 0xf5, 0x49, 0x15, 0x55, 0x61, 0xf9,
+		0x07, 0x13, 0x82, 0x06, 0xa5, 0x5c, 0x59, 0x39, 0x2c, 0x1c, 0x3d, 0x53, 0xd3, 0x89, 0xd5, 0x6c,
+		0x62, 0xf5, 0x54, 0x6e, 0x84, 0x25, 0x88, 0x3e, 0x00, 0x84, 0x82, 0x04, 0xc2, 0x8a, 0x77, 0x29,
+		0x2d, 0x24, 0xb2, 0xad, 0xff, 0x64, 0xed, 0x6c, 0x51, 0x9c, 0x4f, 0xe8, 0xf8, 0x8c, 0xde, 0xc3,
+		0x2a, 0xf5, 0x9c, 0x54, 0xb8, 0x38, 0x57, 0xb8, 0x42, 0x3d, 0x27, 0x3e, 0x55, 0x7e, 0x2e, 0xc0,
+		0x46, 0xb6, 0x5e, 0xcd, 0x8e, 0x87, 0x41, 0x67, 0xb0, 0x3e, 0xe2, 0x41, 0xff, 0xca, 0xe5, 0x23,
+		0x4b, 0x8c, 0x7d, 0x9a, 0xac, 0x57, 0x38, 0xda, 0x53, 0xa7, 0xa4, 0xa4, 0x5e, 0x4a, 0xb2, 0x3d,
+		0xf6, 0x29, 0x5e, 0x1b, 0xdd, 0x3a, 0xa1, 0x8f, 0x90, 0x8f, 0xbd, 0xb4, 0x62, 0x33, 0xa5, 0x05,
+		0x2f, 0xa6, 0xf6, 0x68, 0x93, 0xb0, 0x6f, 0xb0, 0x50, 0xe0, 0x55, 0x21, 0xbf, 0xa1, 0x23, 0x58,
+		0x62, 0x9e, 0x1f, 0x09, 0xe9, 0xc1, 0xf6, 0x54, 0x9d, 0x49, 0xc6, 0x2e, 0x27, 0x0e, 0x4e, 0x51,
+		0x44, 0xa0, 0x4c, 0x6f, 0xa8, 0x1d, 0xc5, 0x4b, 0x58, 0xd2, 0x46, 0x6e, 0xd9, 0x2e, 0x0f, 0x69,
+		0x62, 0x0b, 0x8f, 0x84, 0x74, 0xe6, 0x9e, 0x24, 0xb6, 0x27, 0x2d, 0x5a, 0x89, 0xb5, 0xbc, 0x1e,
+		0xeb, 0xdb, 0xa9, 0x1c, 0x5d, 0xc2, 0xf3, 0x64, 0xa5, 0x19, 0xdd, 0x97, 0xe6, 0x75, 0xdf, 0x8c,
+		0xd5, 0x53, 0x1a, 0x57, 0x7e, 0xe5, 0xe0, 0xc9, 0xbf, 0x31, 0x60, 0x1a, 0x46, 0xae, 0x40, 0x9f,
+		0x60, 0xcd, 0xe3, 0x03, 0xe6, 0x11, 0x37, 0x8d, 0x56, 0x99, 0x1b, 0x6d, 0x41, 0xf2, 0xc9, 0xad,
+		0x38, 0x86, 0x02, 0xb1, 0x45, 0x94, 0xa9, 0x73, 0x73, 0xd5, 0x90, 0xe2, 0x89, 0xb8, 0x03, 0x68,
+		0x72, 0x11, 0x26, 0xb6, 0xc8, 0x44, 0x5e, 0xdd, 0x7b, 0x1b, 0xf4, 0x8c, 0xc6, 0x8f, 0x46, 0x77,
+		0x4b, 0xa8, 0x04, 0x2b, 0x61, 0x9f, 0xf9, 0x3e, 0x75, 0x92, 0x38, 0x56, 0x71, 0x76, 0x44, 0xfb,
+		0xb0, 0x71, 0x45, 0x98, 0x1b, 0x05, 0xd4, 0x0a, 0x28, 0x09, 0xb9, 0x97, 0x38, 0x9a, 0xc7, 0xeb,
+		0xb2, 0x8a, 0x93, 0xe2, 0xeb, 0xdf, 0x0a, 0x3c, 0xcd, 0xcc, 0x6a, 0x0e, 0x69, 0xe0, 0x12, 0xdf,
+		0xe4, 0x2e, 0xb3, 0xc7, 0xe8, 0x25, 0xec, 0xb6, 0xea, 0x9f, 0xf5, 0xd3, 0x8e, 0xa1, 0x5b, 0xcd,
+		0x0b, 0x1d, 0x1b, 0x35, 0xd3, 0x32, 0x9b, 0x46, 0xa3, 0xfe, 0xd5, 0x6a, 0x9c, 0x5f, 0xd4, 0x8c,
+		0xc6, 0x69, 0xf1, 0x01, 0x2a, 0xc3, 0xf6, 0x2c, 0xa8, 0xf5, 0xa5, 0x61, 0x16, 0x15, 0x54, 0x81,
+		0x9d, 0x59, 0xc4, 0x49, 0xe7, 0xec, 0x4c, 0xc7, 0xc5, 0x1c, 0x7a, 0x03, 0x07, 0xb3, 0x98, 0x7a,
+		0xed, 0xbc, 0xae, 0x1b, 0x96, 0x89, 0xf5, 0x8b, 0x46, 0xb3, 0xd3, 0x2a, 0x2e, 0xa0, 0x7d, 0xd8,
+		0x9b, 0x05, 0xd7, 0x0c, 0xa3, 0x79, 0x19, 0x7f, 0x16, 0x17, 0x4f, 0xbe, 0xc3, 0xa6, 0xcd, 0x07,
+		0xd3, 0x9c, 0x3d, 0x59, 0xcf, 0x16, 0x36, 0xe3, 0xcc, 0x4c, 0xe5, 0x5b, 0xb5, 0xc7, 0xc4, 0x75,
+		0xd4, 0x55, 0x6d, 0x3e, 0xd0, 0x6e, 0x3f, 0x63, 0x6f, 0x99, 0xe3, 0x6a, 0x3d, 0x9e, 0x3e, 0x8b,
+		0xf2, 0x4d, 0x3b, 0x26, 0x3e, 0x1b, 0x56, 0xbb, 0xcb, 0x49, 0xed, 0xdd, 0xdf, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0x99, 0x27, 0xd0, 0x60, 0x93, 0x05, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
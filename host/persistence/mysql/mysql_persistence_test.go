@@ -105,7 +105,7 @@ func TestMySQLExecutionManagerWithEventsV2(t *testing.T) {
 
 func TestMySQLVisibilityPersistenceSuite(t *testing.T) {
 	testflags.RequireMySQL(t)
-	s := new(pt.DBVisibilityPersistenceSuite)
+	s := new(pt.SQLVisibilityPersistenceSuite)
 	option, err := mysql.GetTestClusterOption()
 	assert.NoError(t, err)
 	s.TestBase = pt.NewTestBaseWithSQL(t, option)
@@ -20,15 +20,37 @@
 
 package cadence
 
+import "time"
+
 // below are the names of all mongoDB collections
 const (
-	ClusterConfigCollectionName = "cluster_config"
+	ClusterConfigCollectionName                = "cluster_config"
+	ShardCollectionName                        = "shards"
+	CurrentWorkflowCollectionName              = "current_workflows"
+	ExecutionCollectionName                    = "executions"
+	WorkflowRequestCollectionName              = "workflow_requests"
+	ActiveClusterSelectionPolicyCollectionName = "active_cluster_selection_policies"
+	HistoryTaskCollectionName                  = "history_tasks"
+	HistoryTreeCollectionName                  = "history_tree"
+	HistoryNodeCollectionName                  = "history_node"
+	TaskListCollectionName                     = "task_lists"
+	TaskCollectionName                         = "tasks"
+	QueueMessageCollectionName                 = "queue_messages"
+	QueueMetadataCollectionName                = "queue_metadata"
+	DomainCollectionName                       = "domains"
+	DomainMetadataCollectionName               = "domain_metadata"
+	DomainAuditLogCollectionName               = "domain_audit_log"
+	VisibilityCollectionName                   = "visibility"
 )
 
 // NOTE1: MongoDB collection is schemaless -- there is no schema file for collection. We use Go lang structs to define the collection fields.
 
 // NOTE2: MongoDB doesn't allow using camel case or underscore in the field names
 
+// NOTE3: Except for cluster_config, the _id of the documents is a composite key whose parts are joined by "#".
+// Integers in the keys are encoded so that the lexicographical order is the numeric order,
+// so that range queries and paging can be done on the _id index.
+
 // ClusterConfigCollectionEntry is the schema of configStore
 // IMPORTANT: making change to this struct is changing the MongoDB collection schema. Please make sure it's backward compatible(e.g., don't delete the field, or change the annotation value).
 type ClusterConfigCollectionEntry struct {
@@ -39,3 +61,156 @@ type ClusterConfigCollectionEntry struct {
 	DataEncoding         string `json:"dataencoding"`
 	UnixTimestampSeconds int64  `json:"unixtimestampseconds"`
 }
+
+// ShardCollectionEntry is the schema of shards, data is the JSON encoded shard info
+type ShardCollectionEntry struct {
+	ID      string `bson:"_id"`
+	ShardID int    `bson:"shardid"`
+	RangeID int64  `bson:"rangeid"`
+	Data    []byte `bson:"data"`
+}
+
+// CurrentWorkflowCollectionEntry is the schema of current_workflows
+type CurrentWorkflowCollectionEntry struct {
+	ID               string `bson:"_id"`
+	ShardID          int    `bson:"shardid"`
+	DomainID         string `bson:"domainid"`
+	WorkflowID       string `bson:"workflowid"`
+	RunID            string `bson:"runid"`
+	CreateRequestID  string `bson:"createrequestid"`
+	State            int    `bson:"state"`
+	CloseStatus      int    `bson:"closestatus"`
+	LastWriteVersion int64  `bson:"lastwriteversion"`
+}
+
+// ExecutionCollectionEntry is the schema of executions, data is the JSON encoded mutable state
+type ExecutionCollectionEntry struct {
+	ID               string `bson:"_id"`
+	ShardID          int    `bson:"shardid"`
+	DomainID         string `bson:"domainid"`
+	WorkflowID       string `bson:"workflowid"`
+	RunID            string `bson:"runid"`
+	NextEventID      int64  `bson:"nexteventid"`
+	LastWriteVersion int64  `bson:"lastwriteversion"`
+	State            int    `bson:"state"`
+	Data             []byte `bson:"data"`
+}
+
+// WorkflowRequestCollectionEntry is the schema of workflow_requests
+type WorkflowRequestCollectionEntry struct {
+	ID       string    `bson:"_id"`
+	ShardID  int       `bson:"shardid"`
+	RunID    string    `bson:"runid"`
+	ExpireAt time.Time `bson:"expireat"`
+}
+
+// ActiveClusterSelectionPolicyCollectionEntry is the schema of active_cluster_selection_policies
+type ActiveClusterSelectionPolicyCollectionEntry struct {
+	ID           string `bson:"_id"`
+	ShardID      int    `bson:"shardid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+}
+
+// HistoryTaskCollectionEntry is the schema of history_tasks, data is the JSON encoded task
+type HistoryTaskCollectionEntry struct {
+	ID      string `bson:"_id"`
+	ShardID int    `bson:"shardid"`
+	TaskID  int64  `bson:"taskid"`
+	Data    []byte `bson:"data"`
+}
+
+// HistoryTreeCollectionEntry is the schema of history_tree, data is the JSON encoded branch info
+type HistoryTreeCollectionEntry struct {
+	ID       string `bson:"_id"`
+	TreeID   string `bson:"treeid"`
+	BranchID string `bson:"branchid"`
+	Data     []byte `bson:"data"`
+}
+
+// HistoryNodeCollectionEntry is the schema of history_node
+type HistoryNodeCollectionEntry struct {
+	ID           string    `bson:"_id"`
+	TreeID       string    `bson:"treeid"`
+	BranchID     string    `bson:"branchid"`
+	NodeID       int64     `bson:"nodeid"`
+	TxnID        int64     `bson:"txnid"`
+	Data         []byte    `bson:"data"`
+	DataEncoding string    `bson:"dataencoding"`
+	CreatedTime  time.Time `bson:"createdtime"`
+}
+
+// TaskListCollectionEntry is the schema of task_lists, data is the JSON encoded task list info
+type TaskListCollectionEntry struct {
+	ID           string     `bson:"_id"`
+	DomainID     string     `bson:"domainid"`
+	TaskListName string     `bson:"tasklistname"`
+	TaskListType int        `bson:"tasklisttype"`
+	RangeID      int64      `bson:"rangeid"`
+	Data         []byte     `bson:"data"`
+	ExpireAt     *time.Time `bson:"expireat,omitempty"`
+}
+
+// TaskCollectionEntry is the schema of tasks, data is the JSON encoded task
+type TaskCollectionEntry struct {
+	ID       string     `bson:"_id"`
+	TaskID   int64      `bson:"taskid"`
+	Data     []byte     `bson:"data"`
+	ExpireAt *time.Time `bson:"expireat,omitempty"`
+}
+
+// QueueMessageCollectionEntry is the schema of queue_messages
+type QueueMessageCollectionEntry struct {
+	ID        string `bson:"_id"`
+	QueueType int    `bson:"queuetype"`
+	MessageID int64  `bson:"messageid"`
+	Payload   []byte `bson:"payload"`
+}
+
+// QueueMetadataCollectionEntry is the schema of queue_metadata
+type QueueMetadataCollectionEntry struct {
+	ID               string           `bson:"_id"`
+	QueueType        int              `bson:"queuetype"`
+	Version          int64            `bson:"version"`
+	ClusterAckLevels map[string]int64 `bson:"clusteracklevels"`
+}
+
+// DomainCollectionEntry is the schema of domains, data is the JSON encoded domain info and configs
+type DomainCollectionEntry struct {
+	ID             string `bson:"_id"`
+	Name           string `bson:"name"`
+	IsGlobalDomain bool   `bson:"isglobaldomain"`
+	Data           []byte `bson:"data"`
+}
+
+// DomainMetadataCollectionEntry is the schema of domain_metadata
+type DomainMetadataCollectionEntry struct {
+	ID                  string `bson:"_id"`
+	NotificationVersion int64  `bson:"notificationversion"`
+}
+
+// DomainAuditLogCollectionEntry is the schema of domain_audit_log, data is the JSON encoded audit log
+type DomainAuditLogCollectionEntry struct {
+	ID            string     `bson:"_id"`
+	DomainID      string     `bson:"domainid"`
+	OperationType int        `bson:"operationtype"`
+	CreatedTime   time.Time  `bson:"createdtime"`
+	Data          []byte     `bson:"data"`
+	ExpireAt      *time.Time `bson:"expireat,omitempty"`
+}
+
+// VisibilityCollectionEntry is the schema of visibility, data is the JSON encoded visibility record.
+// Start and close time are in unix nanoseconds so that they keep the full precision for sorting.
+type VisibilityCollectionEntry struct {
+	ID           string     `bson:"_id"`
+	DomainID     string     `bson:"domainid"`
+	WorkflowID   string     `bson:"workflowid"`
+	RunID        string     `bson:"runid"`
+	WorkflowType string     `bson:"workflowtype"`
+	StartTime    int64      `bson:"starttime"`
+	CloseTime    int64      `bson:"closetime"`
+	CloseStatus  int        `bson:"closestatus"`
+	Closed       bool       `bson:"closed"`
+	Data         []byte     `bson:"data"`
+	ExpireAt     *time.Time `bson:"expireat,omitempty"`
+}
@@ -15,6 +15,7 @@ CREATE TABLE executions_visibility (
   num_clusters         INT NULL,
   update_time          DATETIME(6) NULL,
   shard_id             INT NULL,
+  search_attributes    JSON NULL,
 
   PRIMARY KEY  (domain_id, run_id)
 );
@@ -0,0 +1,18 @@
+-- audit trail of domain operations, rows with an expires_at in the past are no longer returned and are deleted lazily
+CREATE TABLE domain_audit_log (
+  domain_id BYTEA NOT NULL,
+  operation_type INT NOT NULL,
+  created_time TIMESTAMP NOT NULL,
+  event_id BYTEA NOT NULL,
+  --
+  state_before BYTEA,
+  state_before_encoding VARCHAR(16) NOT NULL,
+  state_after BYTEA,
+  state_after_encoding VARCHAR(16) NOT NULL,
+  last_updated_time TIMESTAMP NOT NULL,
+  identity VARCHAR(255) NOT NULL,
+  identity_type VARCHAR(255) NOT NULL,
+  comment TEXT NOT NULL,
+  expires_at TIMESTAMP,
+  PRIMARY KEY (domain_id, operation_type, created_time, event_id)
+);
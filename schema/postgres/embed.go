@@ -22,5 +22,5 @@ package postgres
 
 import "embed"
 
-//go:embed cadence/* visibility/*
+//go:embed cadence/* visibility/* sharddistributor/* mapq/*
 var SchemaFS embed.FS
@@ -299,3 +299,40 @@ CREATE TABLE cluster_config
     data_encoding VARCHAR(16) NOT NULL,
     PRIMARY KEY (row_type, version)
 );
+
+-- requests of the async workflow queues backed by the database, visible_at is when the request can be leased next
+CREATE TABLE async_workflow_requests
+(
+    queue_name  VARCHAR(255) NOT NULL,
+    request_id  VARCHAR(64)  NOT NULL,
+    --
+    domain_name VARCHAR(255) NOT NULL,
+    in_dlq      BOOLEAN      NOT NULL,
+    attempt     INT          NOT NULL,
+    visible_at  BIGINT       NOT NULL,
+    created_at  BIGINT       NOT NULL,
+    payload     MEDIUMBLOB   NOT NULL,
+    PRIMARY KEY (queue_name, request_id)
+);
+
+CREATE INDEX async_workflow_requests_by_visible_at ON async_workflow_requests (queue_name, in_dlq, visible_at);
+
+-- audit trail of domain operations, rows with an expires_at in the past are no longer returned and are deleted lazily
+CREATE TABLE domain_audit_log
+(
+    domain_id             BINARY(16)   NOT NULL,
+    operation_type        INT          NOT NULL,
+    created_time          DATETIME(6)  NOT NULL,
+    event_id              BINARY(16)   NOT NULL,
+    --
+    state_before          MEDIUMBLOB,
+    state_before_encoding VARCHAR(16)  NOT NULL,
+    state_after           MEDIUMBLOB,
+    state_after_encoding  VARCHAR(16)  NOT NULL,
+    last_updated_time     DATETIME(6)  NOT NULL,
+    identity              VARCHAR(255) NOT NULL,
+    identity_type         VARCHAR(255) NOT NULL,
+    comment               TEXT         NOT NULL,
+    expires_at            DATETIME(6),
+    PRIMARY KEY (domain_id, operation_type, created_time, event_id)
+);
@@ -0,0 +1,16 @@
+-- requests of the async workflow queues backed by the database, visible_at is when the request can be leased next
+CREATE TABLE async_workflow_requests
+(
+    queue_name  VARCHAR(255) NOT NULL,
+    request_id  VARCHAR(64)  NOT NULL,
+    --
+    domain_name VARCHAR(255) NOT NULL,
+    in_dlq      BOOLEAN      NOT NULL,
+    attempt     INT          NOT NULL,
+    visible_at  BIGINT       NOT NULL,
+    created_at  BIGINT       NOT NULL,
+    payload     MEDIUMBLOB   NOT NULL,
+    PRIMARY KEY (queue_name, request_id)
+);
+
+CREATE INDEX async_workflow_requests_by_visible_at ON async_workflow_requests (queue_name, in_dlq, visible_at);
@@ -0,0 +1,19 @@
+-- audit trail of domain operations, rows with an expires_at in the past are no longer returned and are deleted lazily
+CREATE TABLE domain_audit_log
+(
+    domain_id             BINARY(16)   NOT NULL,
+    operation_type        INT          NOT NULL,
+    created_time          DATETIME(6)  NOT NULL,
+    event_id              BINARY(16)   NOT NULL,
+    --
+    state_before          MEDIUMBLOB,
+    state_before_encoding VARCHAR(16)  NOT NULL,
+    state_after           MEDIUMBLOB,
+    state_after_encoding  VARCHAR(16)  NOT NULL,
+    last_updated_time     DATETIME(6)  NOT NULL,
+    identity              VARCHAR(255) NOT NULL,
+    identity_type         VARCHAR(255) NOT NULL,
+    comment               TEXT         NOT NULL,
+    expires_at            DATETIME(6),
+    PRIMARY KEY (domain_id, operation_type, created_time, event_id)
+);
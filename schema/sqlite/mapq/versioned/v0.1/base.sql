@@ -0,0 +1,29 @@
+-- items of the leaf queues of a MAPQ tree, queue_path is the path of the leaf node in the tree
+CREATE TABLE mapq_items
+(
+    queue_id    VARCHAR(255) NOT NULL,
+    queue_path  VARCHAR(255) NOT NULL,
+    item_offset BIGINT       NOT NULL,
+    --
+    payload     MEDIUMBLOB   NOT NULL,
+    PRIMARY KEY (queue_id, queue_path, item_offset)
+);
+
+-- last offset assigned to the items of each leaf queue of a MAPQ tree
+CREATE TABLE mapq_sequences
+(
+    queue_id    VARCHAR(255) NOT NULL,
+    queue_path  VARCHAR(255) NOT NULL,
+    --
+    last_offset BIGINT       NOT NULL,
+    PRIMARY KEY (queue_id, queue_path)
+);
+
+-- committed offsets of all the leaf queues of a MAPQ tree
+CREATE TABLE mapq_offsets
+(
+    queue_id VARCHAR(255) NOT NULL,
+    --
+    offsets  MEDIUMBLOB   NOT NULL,
+    PRIMARY KEY (queue_id)
+);
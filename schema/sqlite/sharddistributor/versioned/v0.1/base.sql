@@ -0,0 +1,57 @@
+-- revision is bumped by every write to the namespace, executors_revision by the changes relevant for rebalancing
+-- and assignments_revision by the changes of the shard owners.
+CREATE TABLE shard_distributor_namespaces
+(
+    namespace            VARCHAR(255) NOT NULL,
+    --
+    revision             BIGINT       NOT NULL,
+    executors_revision   BIGINT       NOT NULL,
+    assignments_revision BIGINT       NOT NULL,
+    PRIMARY KEY (namespace)
+);
+
+CREATE TABLE shard_distributor_executors
+(
+    namespace               VARCHAR(255) NOT NULL,
+    executor_id             VARCHAR(255) NOT NULL,
+    --
+    last_heartbeat          BIGINT       NOT NULL,
+    status                  INT          NOT NULL,
+    reported_shards         MEDIUMBLOB,
+    metadata                MEDIUMBLOB,
+    assigned_state          MEDIUMBLOB,
+    assigned_state_revision BIGINT       NOT NULL,
+    PRIMARY KEY (namespace, executor_id)
+);
+
+CREATE TABLE shard_distributor_shards
+(
+    namespace   VARCHAR(255) NOT NULL,
+    shard_id    VARCHAR(255) NOT NULL,
+    --
+    executor_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (namespace, shard_id)
+);
+
+CREATE INDEX shard_distributor_shards_by_executor ON shard_distributor_shards (namespace, executor_id);
+
+CREATE TABLE shard_distributor_shard_stats
+(
+    namespace        VARCHAR(255) NOT NULL,
+    shard_id         VARCHAR(255) NOT NULL,
+    --
+    smoothed_load    DOUBLE       NOT NULL,
+    last_update_time BIGINT       NOT NULL,
+    last_move_time   BIGINT       NOT NULL,
+    PRIMARY KEY (namespace, shard_id)
+);
+
+CREATE TABLE shard_distributor_leaders
+(
+    namespace    VARCHAR(255) NOT NULL,
+    --
+    leader       VARCHAR(255) NOT NULL,
+    term         BIGINT       NOT NULL,
+    lease_expiry BIGINT       NOT NULL,
+    PRIMARY KEY (namespace)
+);
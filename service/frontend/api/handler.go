@@ -1794,6 +1794,9 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 		tag.IDTypeRequestID) {
 		return validate.ErrRequestIDTooLong
 	}
+	if err := validate.CheckMemo(startRequest.Memo); err != nil {
+		return err
+	}
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(startRequest.SearchAttributes, domainName); err != nil {
 		return err
 	}
@@ -2410,6 +2413,10 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 		}
 	}
 
+	if err := validate.CheckMemo(signalWithStartRequest.Memo); err != nil {
+		return err
+	}
+
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(signalWithStartRequest.SearchAttributes, domainName); err != nil {
 		return err
 	}
@@ -2497,6 +2504,198 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// UpdateWorkflowExecution sends an update to a running workflow execution and waits until the workflow has
+// accepted or rejected it, or until it has completed when WaitForStage is COMPLETED. The update outcomes are
+// recorded as history events by the decision task that handles them.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *types.UpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if updateRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := updateRequest.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(updateRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if updateRequest.GetUpdateName() == "" {
+		return nil, validate.ErrUpdateNameNotSet
+	}
+	if updateRequest.WaitForStage != nil &&
+		updateRequest.GetWaitForStage() != types.WorkflowUpdateStageAccepted &&
+		updateRequest.GetWaitForStage() != types.WorkflowUpdateStageCompleted {
+		return nil, validate.ErrInvalidUpdateWaitForStage
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, updateRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
+	if !common.IsValidIDLength(
+		updateRequest.GetUpdateName(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.SignalNameMaxLength(domainName),
+		metrics.CadenceErrSignalNameExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeSignalName) {
+		return nil, validate.ErrUpdateNameTooLong
+	}
+
+	if updateRequest.GetUpdateID() == "" {
+		updateRequest.UpdateID = uuid.New().String()
+	}
+	if !common.IsValidIDLength(
+		updateRequest.GetUpdateID(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return nil, validate.ErrUpdateIDTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.Input),
+		wh.config.BlobSizeLimitWarn(domainName),
+		wh.config.BlobSizeLimitError(domainName),
+		domainID,
+		domainName,
+		updateRequest.GetWorkflowExecution().GetWorkflowID(),
+		updateRequest.GetWorkflowExecution().GetRunID(),
+		scope,
+		wh.GetLogger(),
+		tag.BlobSizeViolationOperation("UpdateWorkflowExecution"),
+	); err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().UpdateWorkflowExecution(ctx, &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// PauseWorkflowExecution pauses a running workflow execution, or only one of its pending activities when
+// an ActivityID is set. The pause is recorded in the workflow history, so it is replicated and survives continue-as-new.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.PauseWorkflowExecutionRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if pauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := pauseRequest.GetDomain()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(pauseRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	return wh.GetHistoryClient().PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   domainID,
+		PauseRequest: pauseRequest,
+	})
+}
+
+// UnpauseWorkflowExecution lifts the pause of a workflow execution, or of one of its pending activities when
+// an ActivityID is set, and reschedules the tasks held back while it was paused.
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if unpauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := unpauseRequest.GetDomain()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(unpauseRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	return wh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
+	})
+}
+
+// ResetActivity resets the attempt count and the last failure of a pending activity. An activity waiting for its
+// next retry is rescheduled immediately.
+func (wh *WorkflowHandler) ResetActivity(
+	ctx context.Context,
+	resetRequest *types.ResetActivityRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if resetRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := resetRequest.GetDomain()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(resetRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+	if resetRequest.GetActivityID() == "" {
+		return validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	return wh.GetHistoryClient().ResetActivity(ctx, &types.HistoryResetActivityRequest{
+		DomainUUID:   domainID,
+		ResetRequest: resetRequest,
+	})
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -3026,6 +3225,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		ScheduledTimestamp:        matchingResp.ScheduledTimestamp,
 		StartedTimestamp:          matchingResp.StartedTimestamp,
 		Queries:                   matchingResp.Queries,
+		Updates:                   matchingResp.Updates,
 		NextEventID:               matchingResp.NextEventID,
 		TotalHistoryBytes:         matchingResp.TotalHistoryBytes,
 		AutoConfigHint:            matchingResp.AutoConfigHint,
@@ -3375,6 +3575,7 @@ func constructRestartWorkflowRequest(w *types.WorkflowExecutionStartedEventAttri
 	startRequest.Header = w.Header
 	startRequest.Memo = w.Memo
 	startRequest.SearchAttributes = w.SearchAttributes
+	startRequest.TaskPriority = w.TaskPriority
 
 	return startRequest
 }
@@ -513,6 +513,32 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadDelayStartSe
 	s.Equal(validate.ErrInvalidDelayStartSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_ReservedMemoKey() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		Memo: &types.Memo{Fields: map[string][]byte{
+			constants.ReservedPauseMemoKey: []byte(`{"workflow":{}}`),
+		}},
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Error(err)
+	s.Equal(validate.ErrReservedMemoKey, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
@@ -4733,6 +4759,348 @@ func (s *workflowHandlerSuite) TestTerminateWorkflowExecution() {
 	}
 }
 
+func (s *workflowHandlerSuite) TestUpdateWorkflowExecution() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+
+	validRequest := &types.UpdateWorkflowExecutionRequest{
+		Domain: s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: testWorkflowID,
+		},
+		UpdateID:   "update-id",
+		UpdateName: "update-name",
+		Input:      []byte("input"),
+		Identity:   "identity",
+	}
+	validResponse := &types.UpdateWorkflowExecutionResponse{
+		UpdateID: "update-id",
+		Stage:    types.WorkflowUpdateStageCompleted.Ptr(),
+		Result:   []byte("result"),
+	}
+
+	testInput := map[string]struct {
+		request         *types.UpdateWorkflowExecutionRequest
+		mockFn          func()
+		expectError     bool
+		expectErrorType error
+	}{
+		"shutting down": {
+			request: validRequest,
+			mockFn: func() {
+				wh.shuttingDown = int32(1)
+			},
+			expectError:     true,
+			expectErrorType: validate.ErrShuttingDown,
+		},
+		"nil request": {
+			request:         nil,
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrRequestNotSet,
+		},
+		"empty domain": {
+			request:         &types.UpdateWorkflowExecutionRequest{},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrDomainNotSet,
+		},
+		"empty workflow execution": {
+			request: &types.UpdateWorkflowExecutionRequest{
+				Domain: s.testDomain,
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrExecutionNotSet,
+		},
+		"empty update name": {
+			request: &types.UpdateWorkflowExecutionRequest{
+				Domain:            s.testDomain,
+				WorkflowExecution: validRequest.WorkflowExecution,
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrUpdateNameNotSet,
+		},
+		"wait for rejected stage": {
+			request: &types.UpdateWorkflowExecutionRequest{
+				Domain:            s.testDomain,
+				WorkflowExecution: validRequest.WorkflowExecution,
+				UpdateName:        "update-name",
+				WaitForStage:      types.WorkflowUpdateStageRejected.Ptr(),
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrInvalidUpdateWaitForStage,
+		},
+		"history client error": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+			expectError: true,
+		},
+		"success": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), &types.HistoryUpdateWorkflowExecutionRequest{
+					DomainUUID:    s.testDomainID,
+					UpdateRequest: validRequest,
+				}).Return(validResponse, nil)
+			},
+			expectError: false,
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := wh.UpdateWorkflowExecution(context.Background(), input.request)
+			if input.expectError {
+				s.Error(err)
+				if input.expectErrorType != nil {
+					s.ErrorIs(err, input.expectErrorType)
+				}
+			} else {
+				s.NoError(err)
+				s.Equal(validResponse, resp)
+			}
+			wh.shuttingDown = int32(0)
+		})
+	}
+}
+
+func (s *workflowHandlerSuite) TestPauseWorkflowExecution() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+
+	validRequest := &types.PauseWorkflowExecutionRequest{
+		Domain: s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+		},
+		ActivityID: "activity-id",
+		Reason:     "reason",
+		Identity:   "identity",
+	}
+
+	testInput := map[string]struct {
+		request         *types.PauseWorkflowExecutionRequest
+		mockFn          func()
+		expectError     bool
+		expectErrorType error
+	}{
+		"shutting down": {
+			request: validRequest,
+			mockFn: func() {
+				wh.shuttingDown = int32(1)
+			},
+			expectError:     true,
+			expectErrorType: validate.ErrShuttingDown,
+		},
+		"nil request": {
+			request:         nil,
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrRequestNotSet,
+		},
+		"empty domain": {
+			request:         &types.PauseWorkflowExecutionRequest{},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrDomainNotSet,
+		},
+		"empty workflow execution": {
+			request: &types.PauseWorkflowExecutionRequest{
+				Domain: s.testDomain,
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrExecutionNotSet,
+		},
+		"history client error": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+			},
+			expectError: true,
+		},
+		"success": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), &types.HistoryPauseWorkflowExecutionRequest{
+					DomainUUID:   s.testDomainID,
+					PauseRequest: validRequest,
+				}).Return(nil)
+			},
+			expectError: false,
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			err := wh.PauseWorkflowExecution(context.Background(), input.request)
+			if input.expectError {
+				s.Error(err)
+				if input.expectErrorType != nil {
+					s.ErrorIs(err, input.expectErrorType)
+				}
+			} else {
+				s.NoError(err)
+			}
+			wh.shuttingDown = int32(0)
+		})
+	}
+}
+
+func (s *workflowHandlerSuite) TestUnpauseWorkflowExecution() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+
+	validRequest := &types.UnpauseWorkflowExecutionRequest{
+		Domain: s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: testWorkflowID,
+		},
+		Identity: "identity",
+	}
+
+	testInput := map[string]struct {
+		request         *types.UnpauseWorkflowExecutionRequest
+		mockFn          func()
+		expectError     bool
+		expectErrorType error
+	}{
+		"nil request": {
+			request:         nil,
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrRequestNotSet,
+		},
+		"empty workflow ID": {
+			request: &types.UnpauseWorkflowExecutionRequest{
+				Domain:            s.testDomain,
+				WorkflowExecution: &types.WorkflowExecution{},
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrWorkflowIDNotSet,
+		},
+		"cannot get domain ID": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return("", errors.New("error getting domain ID"))
+			},
+			expectError: true,
+		},
+		"success": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), &types.HistoryUnpauseWorkflowExecutionRequest{
+					DomainUUID:     s.testDomainID,
+					UnpauseRequest: validRequest,
+				}).Return(nil)
+			},
+			expectError: false,
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			err := wh.UnpauseWorkflowExecution(context.Background(), input.request)
+			if input.expectError {
+				s.Error(err)
+				if input.expectErrorType != nil {
+					s.ErrorIs(err, input.expectErrorType)
+				}
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *workflowHandlerSuite) TestResetActivity() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+
+	validRequest := &types.ResetActivityRequest{
+		Domain: s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+		},
+		ActivityID: "activity-id",
+		Identity:   "identity",
+	}
+
+	testInput := map[string]struct {
+		request         *types.ResetActivityRequest
+		mockFn          func()
+		expectError     bool
+		expectErrorType error
+	}{
+		"empty domain": {
+			request:         &types.ResetActivityRequest{},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrDomainNotSet,
+		},
+		"empty activity ID": {
+			request: &types.ResetActivityRequest{
+				Domain:            s.testDomain,
+				WorkflowExecution: validRequest.WorkflowExecution,
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrActivityIDNotSet,
+		},
+		"history client error": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().ResetActivity(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
+			},
+			expectError:     true,
+			expectErrorType: &types.EntityNotExistsError{},
+		},
+		"success": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().ResetActivity(gomock.Any(), &types.HistoryResetActivityRequest{
+					DomainUUID:   s.testDomainID,
+					ResetRequest: validRequest,
+				}).Return(nil)
+			},
+			expectError: false,
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			err := wh.ResetActivity(context.Background(), input.request)
+			if input.expectError {
+				s.Error(err)
+				if input.expectErrorType != nil {
+					s.ErrorAs(err, &input.expectErrorType)
+				}
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *workflowHandlerSuite) TestNormalizeVersionedErrors() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	wh := s.getWorkflowHandler(config)
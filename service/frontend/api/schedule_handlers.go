@@ -0,0 +1,160 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/worker/scheduler"
+)
+
+// Schedules run as workflows of the system domain driven by the scheduler worker. The handlers
+// are only reached once the request is authorized against its own domain, and each schedule is
+// pinned to that domain so it can't start workflows anywhere else.
+
+// CreateSchedule creates a schedule in the domain of the request
+func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleDomain(request.GetDomain()); err != nil {
+		return err
+	}
+	return scheduler.NewClient(wh).Create(ctx, request)
+}
+
+// DescribeSchedule returns the definition and state of a schedule
+func (wh *WorkflowHandler) DescribeSchedule(ctx context.Context, request *types.DescribeScheduleRequest) (*types.DescribeScheduleResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleDomain(request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return scheduler.NewClient(wh).Describe(ctx, request)
+}
+
+// UpdateSchedule replaces the definition of a schedule
+func (wh *WorkflowHandler) UpdateSchedule(ctx context.Context, request *types.UpdateScheduleRequest) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleDomain(request.GetDomain()); err != nil {
+		return err
+	}
+	return scheduler.NewClient(wh).Update(ctx, request)
+}
+
+// PauseSchedule stops a schedule from firing until it is resumed
+func (wh *WorkflowHandler) PauseSchedule(ctx context.Context, request *types.PauseScheduleRequest) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleDomain(request.GetDomain()); err != nil {
+		return err
+	}
+	return scheduler.NewClient(wh).Pause(ctx, request)
+}
+
+// ResumeSchedule resumes a paused schedule
+func (wh *WorkflowHandler) ResumeSchedule(ctx context.Context, request *types.ResumeScheduleRequest) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleDomain(request.GetDomain()); err != nil {
+		return err
+	}
+	return scheduler.NewClient(wh).Resume(ctx, request)
+}
+
+// BackfillSchedule takes the actions of a schedule for a past time range
+func (wh *WorkflowHandler) BackfillSchedule(ctx context.Context, request *types.BackfillScheduleRequest) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleDomain(request.GetDomain()); err != nil {
+		return err
+	}
+	return scheduler.NewClient(wh).Backfill(ctx, request)
+}
+
+// DeleteSchedule deletes a schedule, workflows already started by it are left running
+func (wh *WorkflowHandler) DeleteSchedule(ctx context.Context, request *types.DeleteScheduleRequest) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleDomain(request.GetDomain()); err != nil {
+		return err
+	}
+	return scheduler.NewClient(wh).Delete(ctx, request)
+}
+
+// ListSchedules returns the IDs of the schedules of a domain
+func (wh *WorkflowHandler) ListSchedules(ctx context.Context, request *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleDomain(request.GetDomain()); err != nil {
+		return nil, err
+	}
+	if request.GetPageSize() <= 0 {
+		request.PageSize = int32(wh.config.VisibilityMaxPageSize(request.GetDomain()))
+	}
+	return scheduler.NewClient(wh).List(ctx, request)
+}
+
+func (wh *WorkflowHandler) validateScheduleDomain(domain string) error {
+	if domain == "" {
+		return validate.ErrDomainNotSet
+	}
+	// the schedule must belong to an existing domain, the system domain the schedule workflows run in
+	// is not checked against the caller
+	_, err := wh.GetDomainCache().GetDomain(domain)
+	return err
+}
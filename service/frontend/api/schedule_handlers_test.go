@@ -0,0 +1,140 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/worker/scheduler"
+)
+
+func TestDeleteSchedule(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.DeleteScheduleRequest
+		setupMocks    func(*mockDeps)
+		expectedError error
+	}{
+		{
+			name: "success",
+			req:  &types.DeleteScheduleRequest{Domain: "domain", ScheduleID: "s1", Identity: "identity"},
+			setupMocks: func(deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomain("domain").Return(testScheduleDomainEntry(), nil)
+				deps.mockDomainCache.EXPECT().GetDomainID(constants.SystemLocalDomainName).Return("system-domain-id", nil)
+				deps.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, request *types.HistorySignalWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+						assert.Equal(t, "system-domain-id", request.DomainUUID)
+						assert.Equal(t, scheduler.GetWorkflowID("domain", "s1"), request.SignalRequest.WorkflowExecution.WorkflowID)
+						assert.Equal(t, scheduler.SignalNameDelete, request.SignalRequest.SignalName)
+						assert.Equal(t, "identity", request.SignalRequest.Identity)
+						return nil
+					})
+			},
+		},
+		{
+			name:          "nil request",
+			setupMocks:    func(deps *mockDeps) {},
+			expectedError: validate.ErrRequestNotSet,
+		},
+		{
+			name:          "domain not set",
+			req:           &types.DeleteScheduleRequest{ScheduleID: "s1"},
+			setupMocks:    func(deps *mockDeps) {},
+			expectedError: validate.ErrDomainNotSet,
+		},
+		{
+			name: "domain cache error",
+			req:  &types.DeleteScheduleRequest{Domain: "domain", ScheduleID: "s1"},
+			setupMocks: func(deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomain("domain").Return(nil, errors.New("domain cache error"))
+			},
+			expectedError: errors.New("domain cache error"),
+		},
+		{
+			name: "schedule ID not set",
+			req:  &types.DeleteScheduleRequest{Domain: "domain"},
+			setupMocks: func(deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomain("domain").Return(testScheduleDomainEntry(), nil)
+			},
+			expectedError: &types.BadRequestError{Message: "ScheduleID is not set on request."},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(deps)
+
+			err := wh.DeleteSchedule(context.Background(), tc.req)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateSchedule_InvalidAction(t *testing.T) {
+	wh, deps := setupMocksForWorkflowHandler(t)
+	deps.mockDomainCache.EXPECT().GetDomain("domain").Return(testScheduleDomainEntry(), nil)
+
+	err := wh.CreateSchedule(context.Background(), &types.CreateScheduleRequest{
+		Domain:     "domain",
+		ScheduleID: "s1",
+		Spec:       &types.ScheduleSpec{CronExpression: "* * * * *"},
+	})
+	var badRequest *types.BadRequestError
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestListSchedules_Validation(t *testing.T) {
+	wh, deps := setupMocksForWorkflowHandler(t)
+	deps.mockDomainCache.EXPECT().GetDomain("domain").Return(nil, errors.New("domain cache error"))
+
+	request := &types.ListSchedulesRequest{Domain: "domain"}
+	_, err := wh.ListSchedules(context.Background(), request)
+	assert.Error(t, err)
+
+	_, err = wh.ListSchedules(context.Background(), nil)
+	assert.Equal(t, validate.ErrRequestNotSet, err)
+}
+
+func testScheduleDomainEntry() *cache.DomainCacheEntry {
+	return cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: "domain", ID: "domain-id"},
+		&persistence.DomainConfig{},
+		"",
+	)
+}
@@ -85,6 +85,7 @@ type Config struct {
 	// security protection settings
 	EnableAdminProtection         dynamicproperties.BoolPropertyFn
 	AdminOperationToken           dynamicproperties.StringPropertyFn
+	RBACPolicy                    dynamicproperties.StringPropertyFn
 	DisableListVisibilityByFilter dynamicproperties.BoolPropertyFnWithDomainFilter
 
 	// size limit system protection
@@ -164,6 +165,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		TaskListNameMaxLength:                             dc.GetIntPropertyFilteredByDomain(dynamicproperties.TaskListNameMaxLength),
 		EnableAdminProtection:                             dc.GetBoolProperty(dynamicproperties.EnableAdminProtection),
 		AdminOperationToken:                               dc.GetStringProperty(dynamicproperties.AdminOperationToken),
+		RBACPolicy:                                        dc.GetStringProperty(dynamicproperties.FrontendRBACPolicy),
 		DisableListVisibilityByFilter:                     dc.GetBoolPropertyFilteredByDomain(dynamicproperties.DisableListVisibilityByFilter),
 		BlobSizeLimitError:                                dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitError),
 		BlobSizeLimitWarn:                                 dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitWarn),
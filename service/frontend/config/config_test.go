@@ -81,6 +81,7 @@ func TestNewConfig(t *testing.T) {
 		"HistoryMgrNumConns":                                {dynamicproperties.FrontendHistoryMgrNumConns, 28},
 		"EnableAdminProtection":                             {dynamicproperties.EnableAdminProtection, true},
 		"AdminOperationToken":                               {dynamicproperties.AdminOperationToken, "token"},
+		"RBACPolicy":                                        {dynamicproperties.FrontendRBACPolicy, "rules: []"},
 		"DisableListVisibilityByFilter":                     {dynamicproperties.DisableListVisibilityByFilter, false},
 		"BlobSizeLimitError":                                {dynamicproperties.BlobSizeLimitError, 29},
 		"BlobSizeLimitWarn":                                 {dynamicproperties.BlobSizeLimitWarn, 30},
@@ -29,6 +29,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -162,7 +163,21 @@ func (s *Service) Start() {
 	if s.params.ClusterRedirectionPolicy != nil {
 		handler = clusterredirection.NewAPIHandler(handler, s, s.config, *s.params.ClusterRedirectionPolicy)
 	}
-	handler = accesscontrolled.NewAPIHandler(handler, s, s.params.Authorizer, s.params.AuthorizationConfig)
+	authorizer := s.params.Authorizer
+	if authorizer == nil && s.params.AuthorizationConfig.RBACAuthorizer.Enable {
+		// the RBAC authorizer is shared by both handlers so that it also reads its policy from dynamic config
+		authorizer, err = authorization.NewRBACAuthorizer(
+			s.params.AuthorizationConfig.RBACAuthorizer,
+			logger,
+			s.GetDomainCache(),
+			s.GetTimeSource(),
+			s.config.RBACPolicy,
+		)
+		if err != nil {
+			logger.Fatal("Error when initiating the Authorizer", tag.Error(err))
+		}
+	}
+	handler = accesscontrolled.NewAPIHandler(handler, s, authorizer, s.params.AuthorizationConfig)
 
 	// Register the latest (most decorated) handler
 	thriftHandler := thrift.NewAPIHandler(handler)
@@ -172,7 +187,7 @@ func (s *Service) Start() {
 	grpcHandler.Register(s.GetDispatcher())
 
 	s.adminHandler = admin.NewHandler(s, s.params, s.config, dh)
-	s.adminHandler = accesscontrolled.NewAdminHandler(s.adminHandler, s, s.params.Authorizer, s.params.AuthorizationConfig)
+	s.adminHandler = accesscontrolled.NewAdminHandler(s.adminHandler, s, authorizer, s.params.AuthorizationConfig)
 
 	adminThriftHandler := thrift.NewAdminHandler(s.adminHandler)
 	adminThriftHandler.Register(s.GetDispatcher())
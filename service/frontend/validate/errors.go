@@ -23,6 +23,7 @@ package validate
 import (
 	"github.com/google/uuid"
 
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/frontend/config"
 )
@@ -38,6 +39,8 @@ var (
 	ErrWorkflowIDNotSet                           = &types.BadRequestError{Message: "WorkflowId is not set on request."}
 	ErrActivityIDNotSet                           = &types.BadRequestError{Message: "ActivityID is not set on request."}
 	ErrSignalNameNotSet                           = &types.BadRequestError{Message: "SignalName is not set on request."}
+	ErrUpdateNameNotSet                           = &types.BadRequestError{Message: "UpdateName is not set on request."}
+	ErrInvalidUpdateWaitForStage                  = &types.BadRequestError{Message: "WaitForStage must be ACCEPTED or COMPLETED."}
 	ErrInvalidRunID                               = &types.BadRequestError{Message: "Invalid RunId."}
 	ErrInvalidNextPageToken                       = &types.BadRequestError{Message: "Invalid NextPageToken."}
 	ErrNextPageTokenRunIDMismatch                 = &types.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
@@ -57,6 +60,7 @@ var (
 	ErrEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
 	ErrEmptyQueueType                             = &types.BadRequestError{Message: "Queue type is not set."}
 	ErrDomainInLockdown                           = &types.BadRequestError{Message: "Domain is not accepting fail overs at this time due to lockdown."}
+	ErrReservedMemoKey                            = &types.BadRequestError{Message: "Memo uses a key reserved by the server."}
 	ErrShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
 
 	// Err for archival
@@ -67,6 +71,8 @@ var (
 	ErrWorkflowTypeTooLong = &types.BadRequestError{Message: "WorkflowType length exceeds limit."}
 	ErrWorkflowIDTooLong   = &types.BadRequestError{Message: "WorkflowID length exceeds limit."}
 	ErrSignalNameTooLong   = &types.BadRequestError{Message: "SignalName length exceeds limit."}
+	ErrUpdateNameTooLong   = &types.BadRequestError{Message: "UpdateName length exceeds limit."}
+	ErrUpdateIDTooLong     = &types.BadRequestError{Message: "UpdateID length exceeds limit."}
 	ErrTaskListTooLong     = &types.BadRequestError{Message: "TaskList length exceeds limit."}
 	ErrRequestIDTooLong    = &types.BadRequestError{Message: "RequestID length exceeds limit."}
 	ErrIdentityTooLong     = &types.BadRequestError{Message: "Identity length exceeds limit."}
@@ -102,3 +108,10 @@ func CheckExecution(w *types.WorkflowExecution) error {
 	}
 	return nil
 }
+
+func CheckMemo(memo *types.Memo) error {
+	if _, ok := memo.GetFields()[constants.ReservedPauseMemoKey]; ok {
+		return ErrReservedMemoKey
+	}
+	return nil
+}
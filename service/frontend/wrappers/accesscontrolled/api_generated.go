@@ -24,6 +24,96 @@ type apiHandler struct {
 }
 
 // NewAPIHandler creates frontend handler with authentication support
+func (a *apiHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendBackfillScheduleScope, bp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "BackfillSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(bp1),
+		DomainName:  bp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.BackfillSchedule(ctx, bp1)
+}
+
+func (a *apiHandler) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendCreateScheduleScope, cp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "CreateSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(cp1),
+		DomainName:  cp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.CreateSchedule(ctx, cp1)
+}
+
+func (a *apiHandler) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDeleteScheduleScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DeleteSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.DeleteSchedule(ctx, dp1)
+}
+
+func (a *apiHandler) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest) (dp2 *types.DescribeScheduleResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeScheduleScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DescribeSchedule",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeSchedule(ctx, dp1)
+}
+
+func (a *apiHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest) (lp2 *types.ListSchedulesResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListSchedulesScope, lp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ListSchedules",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+		DomainName:  lp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListSchedules(ctx, lp1)
+}
+
 func NewAPIHandler(handler api.Handler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) api.Handler {
 	if authorizer == nil {
 		var err error
@@ -313,6 +403,42 @@ func (a *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 	return a.handler.ListWorkflowExecutions(ctx, lp1)
 }
 
+func (a *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseScheduleScope, pp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "PauseSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(pp1),
+		DomainName:  pp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseSchedule(ctx, pp1)
+}
+
+func (a *apiHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "PauseWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(pp1),
+		DomainName:  pp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseWorkflowExecution(ctx, pp1)
+}
+
 func (a *apiHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendPollForActivityTaskScope, pp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -430,6 +556,24 @@ func (a *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return a.handler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (a *apiHandler) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetActivityScope, rp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ResetActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+		DomainName:  rp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.ResetActivity(ctx, rp1)
+}
+
 func (a *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	return a.handler.ResetStickyTaskList(ctx, rp1)
 }
@@ -506,6 +650,24 @@ func (a *apiHandler) RestartWorkflowExecution(ctx context.Context, rp1 *types.Re
 	return a.handler.RestartWorkflowExecution(ctx, rp1)
 }
 
+func (a *apiHandler) ResumeSchedule(ctx context.Context, rp1 *types.ResumeScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendResumeScheduleScope, rp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ResumeSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+		DomainName:  rp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.ResumeSchedule(ctx, rp1)
+}
+
 func (a *apiHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendScanWorkflowExecutionsScope, lp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -640,6 +802,24 @@ func (a *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	return a.handler.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (a *apiHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UnpauseWorkflowExecution(ctx, up1)
+}
+
 func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendUpdateDomainScope)
 	attr := &authorization.Attributes{
@@ -656,3 +836,37 @@ func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRe
 	}
 	return a.handler.UpdateDomain(ctx, up1)
 }
+func (a *apiHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateScheduleScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UpdateSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UpdateSchedule(ctx, up1)
+}
+func (a *apiHandler) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UpdateWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.UpdateWorkflowExecution(ctx, up1)
+}
@@ -50,6 +50,10 @@ const (
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
 	// 6. ResetWorkflow
+	// 7. PauseWorkflowExecution
+	// 8. UnpauseWorkflowExecution
+	// 9. ResetActivity
+	// 10. UpdateWorkflowExecution
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlist and DCRedirectionPolicySelectedAPIsForwardingV2
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 	// DCRedirectionPolicySelectedAPIsForwardingV2 forwards everything in DCRedirectionPolicySelectedAPIsForwarding,
@@ -59,13 +63,13 @@ const (
 	//
 	// This will likely replace DCRedirectionPolicySelectedAPIsForwarding soon.
 	//
-	// 1-6. from DCRedirectionPolicySelectedAPIsForwarding
-	// 7. RespondActivityTaskCanceled
-	// 8. RespondActivityTaskCanceledByID
-	// 9. RespondActivityTaskCompleted
-	// 10. RespondActivityTaskCompletedByID
-	// 11. RespondActivityTaskFailed
-	// 12. RespondActivityTaskFailedByID
+	// 1-10. from DCRedirectionPolicySelectedAPIsForwarding
+	// 11. RespondActivityTaskCanceled
+	// 12. RespondActivityTaskCanceledByID
+	// 13. RespondActivityTaskCompleted
+	// 14. RespondActivityTaskCompletedByID
+	// 15. RespondActivityTaskFailed
+	// 16. RespondActivityTaskFailedByID
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2
 	DCRedirectionPolicySelectedAPIsForwardingV2 = "selected-apis-forwarding-v2"
 	// DCRedirectionPolicyAllDomainAPIsForwarding means forwarding all the worker and non-worker APIs based domain,
@@ -127,6 +131,10 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"ResetActivity":                    {},
+	"UpdateWorkflowExecution":          {},
 }
 
 // selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 contains a list of non-worker APIs which can be redirected.
@@ -139,6 +147,10 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"ResetActivity":                    {},
+	"UpdateWorkflowExecution":          {},
 	// additional endpoints
 	"RespondActivityTaskCanceled":      {},
 	"RespondActivityTaskCanceledByID":  {},
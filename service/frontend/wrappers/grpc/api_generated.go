@@ -17,6 +17,31 @@ type APIHandler struct {
 	h api.Handler
 }
 
+func (g APIHandler) BackfillSchedule(ctx context.Context, request *apiv1.BackfillScheduleRequest) (*apiv1.BackfillScheduleResponse, error) {
+	err := g.h.BackfillSchedule(ctx, proto.ToBackfillScheduleRequest(request))
+	return &apiv1.BackfillScheduleResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) CreateSchedule(ctx context.Context, request *apiv1.CreateScheduleRequest) (*apiv1.CreateScheduleResponse, error) {
+	err := g.h.CreateSchedule(ctx, proto.ToCreateScheduleRequest(request))
+	return &apiv1.CreateScheduleResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) DeleteSchedule(ctx context.Context, request *apiv1.DeleteScheduleRequest) (*apiv1.DeleteScheduleResponse, error) {
+	err := g.h.DeleteSchedule(ctx, proto.ToDeleteScheduleRequest(request))
+	return &apiv1.DeleteScheduleResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) DescribeSchedule(ctx context.Context, request *apiv1.DescribeScheduleRequest) (*apiv1.DescribeScheduleResponse, error) {
+	response, err := g.h.DescribeSchedule(ctx, proto.ToDescribeScheduleRequest(request))
+	return proto.FromDescribeScheduleResponse(response), proto.FromError(err)
+}
+
+func (g APIHandler) ListSchedules(ctx context.Context, request *apiv1.ListSchedulesRequest) (*apiv1.ListSchedulesResponse, error) {
+	response, err := g.h.ListSchedules(ctx, proto.ToListSchedulesRequest(request))
+	return proto.FromListSchedulesResponse(response), proto.FromError(err)
+}
+
 func NewAPIHandler(h api.Handler) APIHandler {
 	return APIHandler{h}
 }
@@ -116,6 +141,16 @@ func (g APIHandler) ListWorkflowExecutions(ctx context.Context, request *apiv1.L
 	return proto.FromListWorkflowExecutionsResponse(response), proto.FromError(err)
 }
 
+func (g APIHandler) PauseSchedule(ctx context.Context, request *apiv1.PauseScheduleRequest) (*apiv1.PauseScheduleResponse, error) {
+	err := g.h.PauseSchedule(ctx, proto.ToPauseScheduleRequest(request))
+	return &apiv1.PauseScheduleResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) PauseWorkflowExecution(ctx context.Context, request *apiv1.PauseWorkflowExecutionRequest) (*apiv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(ctx, proto.ToPauseWorkflowExecutionRequest(request))
+	return &apiv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g APIHandler) PollForActivityTask(ctx context.Context, request *apiv1.PollForActivityTaskRequest) (*apiv1.PollForActivityTaskResponse, error) {
 	response, err := g.h.PollForActivityTask(ctx, proto.ToPollForActivityTaskRequest(request))
 	return proto.FromPollForActivityTaskResponse(response), proto.FromError(err)
@@ -156,6 +191,11 @@ func (g APIHandler) RequestCancelWorkflowExecution(ctx context.Context, request
 	return &apiv1.RequestCancelWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g APIHandler) ResetActivity(ctx context.Context, request *apiv1.ResetActivityRequest) (*apiv1.ResetActivityResponse, error) {
+	err := g.h.ResetActivity(ctx, proto.ToResetActivityRequest(request))
+	return &apiv1.ResetActivityResponse{}, proto.FromError(err)
+}
+
 func (g APIHandler) ResetStickyTaskList(ctx context.Context, request *apiv1.ResetStickyTaskListRequest) (*apiv1.ResetStickyTaskListResponse, error) {
 	response, err := g.h.ResetStickyTaskList(ctx, proto.ToResetStickyTaskListRequest(request))
 	return proto.FromResetStickyTaskListResponse(response), proto.FromError(err)
@@ -216,6 +256,11 @@ func (g APIHandler) RestartWorkflowExecution(ctx context.Context, request *apiv1
 	return proto.FromRestartWorkflowExecutionResponse(response), proto.FromError(err)
 }
 
+func (g APIHandler) ResumeSchedule(ctx context.Context, request *apiv1.ResumeScheduleRequest) (*apiv1.ResumeScheduleResponse, error) {
+	err := g.h.ResumeSchedule(ctx, proto.ToResumeScheduleRequest(request))
+	return &apiv1.ResumeScheduleResponse{}, proto.FromError(err)
+}
+
 func (g APIHandler) ScanWorkflowExecutions(ctx context.Context, request *apiv1.ScanWorkflowExecutionsRequest) (*apiv1.ScanWorkflowExecutionsResponse, error) {
 	response, err := g.h.ScanWorkflowExecutions(ctx, proto.ToScanWorkflowExecutionsRequest(request))
 	return proto.FromScanWorkflowExecutionsResponse(response), proto.FromError(err)
@@ -251,7 +296,20 @@ func (g APIHandler) TerminateWorkflowExecution(ctx context.Context, request *api
 	return &apiv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g APIHandler) UnpauseWorkflowExecution(ctx context.Context, request *apiv1.UnpauseWorkflowExecutionRequest) (*apiv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(ctx, proto.ToUnpauseWorkflowExecutionRequest(request))
+	return &apiv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g APIHandler) UpdateDomain(ctx context.Context, request *apiv1.UpdateDomainRequest) (*apiv1.UpdateDomainResponse, error) {
 	response, err := g.h.UpdateDomain(ctx, proto.ToUpdateDomainRequest(request))
 	return proto.FromUpdateDomainResponse(response), proto.FromError(err)
 }
+func (g APIHandler) UpdateSchedule(ctx context.Context, request *apiv1.UpdateScheduleRequest) (*apiv1.UpdateScheduleResponse, error) {
+	err := g.h.UpdateSchedule(ctx, proto.ToUpdateScheduleRequest(request))
+	return &apiv1.UpdateScheduleResponse{}, proto.FromError(err)
+}
+func (g APIHandler) UpdateWorkflowExecution(ctx context.Context, request *apiv1.UpdateWorkflowExecutionRequest) (*apiv1.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, proto.ToUpdateWorkflowExecutionRequest(request))
+	return proto.FromUpdateWorkflowExecutionResponse(response), proto.FromError(err)
+}
@@ -243,6 +243,87 @@ func TestToTerminateWorkflowExecutionRequestTags(t *testing.T) {
 	assert.ElementsMatch(t, expectedTags, tags)
 }
 
+func TestToScheduleRequestTags(t *testing.T) {
+	expectedTags := []tag.Tag{
+		tag.WorkflowDomainName("test-domain"),
+	}
+
+	assert.ElementsMatch(t, expectedTags, toCreateScheduleRequestTags(&types.CreateScheduleRequest{Domain: "test-domain", ScheduleID: "test-schedule"}))
+	assert.ElementsMatch(t, expectedTags, toDescribeScheduleRequestTags(&types.DescribeScheduleRequest{Domain: "test-domain", ScheduleID: "test-schedule"}))
+	assert.ElementsMatch(t, expectedTags, toUpdateScheduleRequestTags(&types.UpdateScheduleRequest{Domain: "test-domain", ScheduleID: "test-schedule"}))
+	assert.ElementsMatch(t, expectedTags, toPauseScheduleRequestTags(&types.PauseScheduleRequest{Domain: "test-domain", ScheduleID: "test-schedule"}))
+	assert.ElementsMatch(t, expectedTags, toResumeScheduleRequestTags(&types.ResumeScheduleRequest{Domain: "test-domain", ScheduleID: "test-schedule"}))
+	assert.ElementsMatch(t, expectedTags, toBackfillScheduleRequestTags(&types.BackfillScheduleRequest{Domain: "test-domain", ScheduleID: "test-schedule"}))
+	assert.ElementsMatch(t, expectedTags, toDeleteScheduleRequestTags(&types.DeleteScheduleRequest{Domain: "test-domain", ScheduleID: "test-schedule"}))
+	assert.ElementsMatch(t, expectedTags, toListSchedulesRequestTags(&types.ListSchedulesRequest{Domain: "test-domain"}))
+}
+
+func TestToPauseWorkflowExecutionRequestTags(t *testing.T) {
+	req := &types.PauseWorkflowExecutionRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "test-run-id",
+		},
+		ActivityID: "test-activity-id",
+	}
+
+	tags := toPauseWorkflowExecutionRequestTags(req)
+
+	expectedTags := []tag.Tag{
+		tag.WorkflowDomainName("test-domain"),
+		tag.WorkflowID("test-workflow-id"),
+		tag.WorkflowRunID("test-run-id"),
+		tag.WorkflowActivityID("test-activity-id"),
+	}
+
+	assert.ElementsMatch(t, expectedTags, tags)
+}
+
+func TestToUnpauseWorkflowExecutionRequestTags(t *testing.T) {
+	req := &types.UnpauseWorkflowExecutionRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "test-run-id",
+		},
+		ActivityID: "test-activity-id",
+	}
+
+	tags := toUnpauseWorkflowExecutionRequestTags(req)
+
+	expectedTags := []tag.Tag{
+		tag.WorkflowDomainName("test-domain"),
+		tag.WorkflowID("test-workflow-id"),
+		tag.WorkflowRunID("test-run-id"),
+		tag.WorkflowActivityID("test-activity-id"),
+	}
+
+	assert.ElementsMatch(t, expectedTags, tags)
+}
+
+func TestToResetActivityRequestTags(t *testing.T) {
+	req := &types.ResetActivityRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "test-run-id",
+		},
+		ActivityID: "test-activity-id",
+	}
+
+	tags := toResetActivityRequestTags(req)
+
+	expectedTags := []tag.Tag{
+		tag.WorkflowDomainName("test-domain"),
+		tag.WorkflowID("test-workflow-id"),
+		tag.WorkflowRunID("test-run-id"),
+		tag.WorkflowActivityID("test-activity-id"),
+	}
+
+	assert.ElementsMatch(t, expectedTags, tags)
+}
+
 func TestToScanWorkflowExecutionsRequestTags(t *testing.T) {
 	req := &types.ListWorkflowExecutionsRequest{
 		Domain: "test-domain",
@@ -11,16 +11,31 @@ import (
 	"github.com/uber/cadence/gen/go/shared"
 )
 
+func (g APIHandler) BackfillSchedule(ctx context.Context, BackfillRequest *shared.BackfillScheduleRequest) (err error) {
+	err = g.h.BackfillSchedule(ctx, thrift.ToBackfillScheduleRequest(BackfillRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) CountWorkflowExecutions(ctx context.Context, CountRequest *shared.CountWorkflowExecutionsRequest) (cp1 *shared.CountWorkflowExecutionsResponse, err error) {
 	response, err := g.h.CountWorkflowExecutions(ctx, thrift.ToCountWorkflowExecutionsRequest(CountRequest))
 	return thrift.FromCountWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) CreateSchedule(ctx context.Context, CreateRequest *shared.CreateScheduleRequest) (err error) {
+	err = g.h.CreateSchedule(ctx, thrift.ToCreateScheduleRequest(CreateRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) DeleteDomain(ctx context.Context, DeleteRequest *shared.DeleteDomainRequest) (err error) {
 	err = g.h.DeleteDomain(ctx, thrift.ToDeleteDomainRequest(DeleteRequest))
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) DeleteSchedule(ctx context.Context, DeleteRequest *shared.DeleteScheduleRequest) (err error) {
+	err = g.h.DeleteSchedule(ctx, thrift.ToDeleteScheduleRequest(DeleteRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) DeprecateDomain(ctx context.Context, DeprecateRequest *shared.DeprecateDomainRequest) (err error) {
 	err = g.h.DeprecateDomain(ctx, thrift.ToDeprecateDomainRequest(DeprecateRequest))
 	return thrift.FromError(err)
@@ -31,6 +46,11 @@ func (g APIHandler) DescribeDomain(ctx context.Context, DescribeRequest *shared.
 	return thrift.FromDescribeDomainResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) DescribeSchedule(ctx context.Context, DescribeRequest *shared.DescribeScheduleRequest) (dp1 *shared.DescribeScheduleResponse, err error) {
+	response, err := g.h.DescribeSchedule(ctx, thrift.ToDescribeScheduleRequest(DescribeRequest))
+	return thrift.FromDescribeScheduleResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) DescribeTaskList(ctx context.Context, Request *shared.DescribeTaskListRequest) (dp1 *shared.DescribeTaskListResponse, err error) {
 	response, err := g.h.DescribeTaskList(ctx, thrift.ToDescribeTaskListRequest(Request))
 	return thrift.FromDescribeTaskListResponse(response), thrift.FromError(err)
@@ -96,6 +116,11 @@ func (g APIHandler) ListOpenWorkflowExecutions(ctx context.Context, ListRequest
 	return thrift.FromListOpenWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) ListSchedules(ctx context.Context, ListRequest *shared.ListSchedulesRequest) (lp1 *shared.ListSchedulesResponse, err error) {
+	response, err := g.h.ListSchedules(ctx, thrift.ToListSchedulesRequest(ListRequest))
+	return thrift.FromListSchedulesResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) ListTaskListPartitions(ctx context.Context, Request *shared.ListTaskListPartitionsRequest) (lp1 *shared.ListTaskListPartitionsResponse, err error) {
 	response, err := g.h.ListTaskListPartitions(ctx, thrift.ToListTaskListPartitionsRequest(Request))
 	return thrift.FromListTaskListPartitionsResponse(response), thrift.FromError(err)
@@ -106,6 +131,16 @@ func (g APIHandler) ListWorkflowExecutions(ctx context.Context, ListRequest *sha
 	return thrift.FromListWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) PauseSchedule(ctx context.Context, PauseRequest *shared.PauseScheduleRequest) (err error) {
+	err = g.h.PauseSchedule(ctx, thrift.ToPauseScheduleRequest(PauseRequest))
+	return thrift.FromError(err)
+}
+
+func (g APIHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest) (err error) {
+	err = g.h.PauseWorkflowExecution(ctx, thrift.ToPauseWorkflowExecutionRequest(PauseRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) PollForActivityTask(ctx context.Context, PollRequest *shared.PollForActivityTaskRequest) (pp1 *shared.PollForActivityTaskResponse, err error) {
 	response, err := g.h.PollForActivityTask(ctx, thrift.ToPollForActivityTaskRequest(PollRequest))
 	return thrift.FromPollForActivityTaskResponse(response), thrift.FromError(err)
@@ -146,6 +181,11 @@ func (g APIHandler) RequestCancelWorkflowExecution(ctx context.Context, CancelRe
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) ResetActivity(ctx context.Context, ResetRequest *shared.ResetActivityRequest) (err error) {
+	err = g.h.ResetActivity(ctx, thrift.ToResetActivityRequest(ResetRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) ResetStickyTaskList(ctx context.Context, ResetRequest *shared.ResetStickyTaskListRequest) (rp1 *shared.ResetStickyTaskListResponse, err error) {
 	response, err := g.h.ResetStickyTaskList(ctx, thrift.ToResetStickyTaskListRequest(ResetRequest))
 	return thrift.FromResetStickyTaskListResponse(response), thrift.FromError(err)
@@ -206,6 +246,11 @@ func (g APIHandler) RestartWorkflowExecution(ctx context.Context, RestartRequest
 	return thrift.FromRestartWorkflowExecutionResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) ResumeSchedule(ctx context.Context, ResumeRequest *shared.ResumeScheduleRequest) (err error) {
+	err = g.h.ResumeSchedule(ctx, thrift.ToResumeScheduleRequest(ResumeRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) ScanWorkflowExecutions(ctx context.Context, ListRequest *shared.ListWorkflowExecutionsRequest) (lp1 *shared.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.h.ScanWorkflowExecutions(ctx, thrift.ToScanWorkflowExecutionsRequest(ListRequest))
 	return thrift.FromScanWorkflowExecutionsResponse(response), thrift.FromError(err)
@@ -241,7 +286,20 @@ func (g APIHandler) TerminateWorkflowExecution(ctx context.Context, TerminateReq
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) UnpauseWorkflowExecution(ctx context.Context, UnpauseRequest *shared.UnpauseWorkflowExecutionRequest) (err error) {
+	err = g.h.UnpauseWorkflowExecution(ctx, thrift.ToUnpauseWorkflowExecutionRequest(UnpauseRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest) (up1 *shared.UpdateDomainResponse, err error) {
 	response, err := g.h.UpdateDomain(ctx, thrift.ToUpdateDomainRequest(UpdateRequest))
 	return thrift.FromUpdateDomainResponse(response), thrift.FromError(err)
 }
+func (g APIHandler) UpdateSchedule(ctx context.Context, UpdateRequest *shared.UpdateScheduleRequest) (err error) {
+	err = g.h.UpdateSchedule(ctx, thrift.ToUpdateScheduleRequest(UpdateRequest))
+	return thrift.FromError(err)
+}
+func (g APIHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest) (up1 *shared.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, thrift.ToUpdateWorkflowExecutionRequest(UpdateRequest))
+	return thrift.FromUpdateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
@@ -119,6 +119,10 @@ type Config struct {
 	EnableTransferQueueV2PendingTaskCountAlert dynamicproperties.BoolPropertyFnWithShardIDFilter
 	QueueCriticalPendingTaskCount              dynamicproperties.IntPropertyFn
 	QueueMaxVirtualQueueCount                  dynamicproperties.IntPropertyFn
+	QueueCriticalStuckSliceDuration            dynamicproperties.DurationPropertyFn
+	QueueCriticalWatermarkLag                  dynamicproperties.DurationPropertyFn
+	QueueCriticalSliceCount                    dynamicproperties.IntPropertyFn
+	QueueCriticalDomainTaskRate                dynamicproperties.IntPropertyFn
 	VirtualSliceForceAppendInterval            dynamicproperties.DurationPropertyFn
 
 	// QueueProcessor settings
@@ -423,6 +427,10 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		EnableTransferQueueV2PendingTaskCountAlert: dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableTransferQueueV2PendingTaskCountAlert),
 		QueueCriticalPendingTaskCount:              dc.GetIntProperty(dynamicproperties.QueueCriticalPendingTaskCount),
 		QueueMaxVirtualQueueCount:                  dc.GetIntProperty(dynamicproperties.QueueMaxVirtualQueueCount),
+		QueueCriticalStuckSliceDuration:            dc.GetDurationProperty(dynamicproperties.QueueCriticalStuckSliceDuration),
+		QueueCriticalWatermarkLag:                  dc.GetDurationProperty(dynamicproperties.QueueCriticalWatermarkLag),
+		QueueCriticalSliceCount:                    dc.GetIntProperty(dynamicproperties.QueueCriticalSliceCount),
+		QueueCriticalDomainTaskRate:                dc.GetIntProperty(dynamicproperties.QueueCriticalDomainTaskRate),
 		VirtualSliceForceAppendInterval:            dc.GetDurationProperty(dynamicproperties.VirtualSliceForceAppendInterval),
 
 		QueueProcessorEnableSplit:                          dc.GetBoolProperty(dynamicproperties.QueueProcessorEnableSplit),
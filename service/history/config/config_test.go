@@ -279,6 +279,10 @@ func TestNewConfig(t *testing.T) {
 		"EnableTransferQueueV2PendingTaskCountAlert":           {dynamicproperties.EnableTransferQueueV2PendingTaskCountAlert, true},
 		"QueueCriticalPendingTaskCount":                        {dynamicproperties.QueueCriticalPendingTaskCount, 100},
 		"QueueMaxVirtualQueueCount":                            {dynamicproperties.QueueMaxVirtualQueueCount, 101},
+		"QueueCriticalStuckSliceDuration":                      {dynamicproperties.QueueCriticalStuckSliceDuration, time.Minute},
+		"QueueCriticalWatermarkLag":                            {dynamicproperties.QueueCriticalWatermarkLag, 2 * time.Minute},
+		"QueueCriticalSliceCount":                              {dynamicproperties.QueueCriticalSliceCount, 102},
+		"QueueCriticalDomainTaskRate":                          {dynamicproperties.QueueCriticalDomainTaskRate, 103},
 		"VirtualSliceForceAppendInterval":                      {dynamicproperties.VirtualSliceForceAppendInterval, time.Second},
 		"ReplicationTaskProcessorLatencyLogThreshold":          {dynamicproperties.ReplicationTaskProcessorLatencyLogThreshold, time.Duration(0)},
 	}
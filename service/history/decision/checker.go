@@ -480,6 +480,51 @@ func (v *attrValidator) validateRecordMarkerAttributes(
 	return nil
 }
 
+func (v *attrValidator) validateAcceptWorkflowUpdateAttributes(
+	attributes *types.AcceptWorkflowUpdateDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "AcceptWorkflowUpdateDecisionAttributes is not set on decision."}
+	}
+	if attributes.GetUpdateID() == "" {
+		return &types.BadRequestError{Message: "UpdateID is not set on decision."}
+	}
+	if attributes.GetUpdateName() == "" {
+		return &types.BadRequestError{Message: "UpdateName is not set on decision."}
+	}
+	return nil
+}
+
+func (v *attrValidator) validateRejectWorkflowUpdateAttributes(
+	attributes *types.RejectWorkflowUpdateDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "RejectWorkflowUpdateDecisionAttributes is not set on decision."}
+	}
+	if attributes.GetUpdateID() == "" {
+		return &types.BadRequestError{Message: "UpdateID is not set on decision."}
+	}
+	if attributes.GetUpdateName() == "" {
+		return &types.BadRequestError{Message: "UpdateName is not set on decision."}
+	}
+	return nil
+}
+
+func (v *attrValidator) validateCompleteWorkflowUpdateAttributes(
+	attributes *types.CompleteWorkflowUpdateDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "CompleteWorkflowUpdateDecisionAttributes is not set on decision."}
+	}
+	if attributes.GetUpdateID() == "" {
+		return &types.BadRequestError{Message: "UpdateID is not set on decision."}
+	}
+	return nil
+}
+
 func (v *attrValidator) validateCompleteWorkflowExecutionAttributes(
 	attributes *types.CompleteWorkflowExecutionDecisionAttributes,
 ) error {
@@ -210,6 +210,12 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 				return nil, &types.EntityNotExistsError{Message: "Decision task not found."}
 			}
 
+			// Decision task may already be in matching when the workflow is paused.
+			// It is dropped here and dispatched again when the workflow is unpaused.
+			if decision.StartedID == constants.EmptyEventID && execution.IsWorkflowPaused(mutableState) {
+				return nil, workflow.ErrWorkflowPaused
+			}
+
 			updateAction := &workflow.UpdateAction{}
 
 			if decision.StartedID != constants.EmptyEventID {
@@ -408,6 +414,7 @@ Update_History_Loop:
 			continueAsNewBuilder        execution.MutableState
 			hasUnhandledEvents          bool
 			decisionResults             []*decisionResult
+			workflowUpdateDecisions     []*types.Decision
 		)
 		hasUnhandledEvents = msBuilder.HasBufferedEvents()
 
@@ -480,6 +487,7 @@ Update_History_Loop:
 			// continueAsNewTimerTasks is not used by decisionTaskHandler
 			continueAsNewBuilder = decisionTaskHandler.continueAsNewBuilder
 			hasUnhandledEvents = decisionTaskHandler.hasUnhandledEventsBeforeDecisions
+			workflowUpdateDecisions = decisionTaskHandler.workflowUpdateDecisions
 		}
 
 		if failDecision {
@@ -492,11 +500,14 @@ Update_History_Loop:
 			}
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			workflowUpdateDecisions = nil
 		}
 
-		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
-		logger.Debugf("createNewDecisionTask: %v, msBuilder.IsWorkflowExecutionRunning: %v, hasUnhandledEvents: %v, request.GetForceCreateNewDecisionTask: %v, activityNotStartedCancelled: %v",
-			createNewDecisionTask, msBuilder.IsWorkflowExecutionRunning(), hasUnhandledEvents, request.GetForceCreateNewDecisionTask(), activityNotStartedCancelled)
+		// updates that arrived after this decision task was started have not been seen by the decider yet
+		hasUndispatchedUpdates := msBuilder.GetUpdateRegistry().HasUndispatchedUpdate()
+		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || hasUndispatchedUpdates || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
+		logger.Debugf("createNewDecisionTask: %v, msBuilder.IsWorkflowExecutionRunning: %v, hasUnhandledEvents: %v, hasUndispatchedUpdates: %v, request.GetForceCreateNewDecisionTask: %v, activityNotStartedCancelled: %v",
+			createNewDecisionTask, msBuilder.IsWorkflowExecutionRunning(), hasUnhandledEvents, hasUndispatchedUpdates, request.GetForceCreateNewDecisionTask(), activityNotStartedCancelled)
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
 			var newDecision *execution.DecisionInfo
@@ -601,6 +612,8 @@ Update_History_Loop:
 			return nil, updateErr
 		}
 
+		handler.handleWorkflowUpdates(msBuilder, workflowUpdateDecisions)
+
 		handler.handleBufferedQueries(
 			msBuilder,
 			clientImpl,
@@ -710,10 +723,36 @@ func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 		queries[id] = input
 	}
 	response.Queries = queries
+	response.Updates = msBuilder.GetUpdateRegistry().DispatchUpdates()
 	response.HistorySize = msBuilder.GetHistorySize()
 	return response, nil
 }
 
+// handleWorkflowUpdates notifies the callers waiting on the updates the decider has accepted, rejected
+// or completed. It must only be called once the events of these decisions are persisted.
+func (handler *handlerImpl) handleWorkflowUpdates(
+	msBuilder execution.MutableState,
+	decisions []*types.Decision,
+) {
+	updateRegistry := msBuilder.GetUpdateRegistry()
+	for _, decision := range decisions {
+		switch decision.GetDecisionType() {
+		case types.DecisionTypeAcceptWorkflowUpdate:
+			attr := decision.AcceptWorkflowUpdateDecisionAttributes
+			updateRegistry.Accept(attr.GetUpdateID())
+		case types.DecisionTypeRejectWorkflowUpdate:
+			attr := decision.RejectWorkflowUpdateDecisionAttributes
+			updateRegistry.Reject(attr.GetUpdateID(), attr.GetReason(), attr.GetDetails())
+		case types.DecisionTypeCompleteWorkflowUpdate:
+			attr := decision.CompleteWorkflowUpdateDecisionAttributes
+			updateRegistry.Complete(attr.GetUpdateID(), attr.GetResult(), attr.FailureReason, attr.GetFailureDetails())
+		}
+	}
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		updateRegistry.FailAll(workflow.ErrAlreadyCompleted)
+	}
+}
+
 func (handler *handlerImpl) handleBufferedQueries(
 	msBuilder execution.MutableState,
 	clientImpl string,
@@ -56,6 +56,7 @@ import (
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -1237,6 +1238,7 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 		expectCalls func()
 		expectedErr error
 		indexes     []string
+		updates     []*types.WorkflowUpdate
 	}{
 		{
 			name: "success",
@@ -1250,10 +1252,14 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 				registry.EXPECT().GetBufferedIDs().Return([]string{"test-id", "test-id1", "test-id2"})
 				registry.EXPECT().GetQueryInput(gomock.Any()).Return(&types.WorkflowQuery{}, nil).Times(2)
 				registry.EXPECT().GetQueryInput(gomock.Any()).Return(nil, &types.InternalServiceError{Message: "query does not exist"})
+				updateRegistry := update.NewRegistry()
+				updateRegistry.Register(&types.WorkflowUpdate{UpdateID: "test-update-id"}, false)
+				s.mockMutableState.EXPECT().GetUpdateRegistry().Return(updateRegistry)
 				s.mockMutableState.EXPECT().GetHistorySize()
 			},
 			expectedErr: nil,
 			indexes:     []string{"test-id", "test-id1"},
+			updates:     []*types.WorkflowUpdate{{UpdateID: "test-update-id"}},
 		},
 		{
 			name: "failure",
@@ -1289,6 +1295,7 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 					_, ok := resp.Queries[index]
 					s.True(ok)
 				}
+				s.Equal(test.updates, resp.Updates)
 			}
 		})
 	}
@@ -61,6 +61,9 @@ type (
 		continueAsNewBuilder              execution.MutableState
 		stopProcessing                    bool // should stop processing any more decisions
 		mutableState                      execution.MutableState
+		// workflowUpdateDecisions are the update decisions recorded in history, whose callers are
+		// notified once the decision task completion is persisted
+		workflowUpdateDecisions []*types.Decision
 
 		// validation
 		attrValidator    *attrValidator
@@ -205,6 +208,15 @@ func (handler *taskHandlerImpl) handleDecision(
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		return handler.handleDecisionUpsertWorkflowSearchAttributes(ctx, decision.UpsertWorkflowSearchAttributesDecisionAttributes)
 
+	case types.DecisionTypeAcceptWorkflowUpdate:
+		return handler.handleDecisionAcceptWorkflowUpdate(ctx, decision)
+
+	case types.DecisionTypeRejectWorkflowUpdate:
+		return handler.handleDecisionRejectWorkflowUpdate(ctx, decision)
+
+	case types.DecisionTypeCompleteWorkflowUpdate:
+		return handler.handleDecisionCompleteWorkflowUpdate(ctx, decision)
+
 	default:
 		return &types.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", decision.GetDecisionType())}
 	}
@@ -1042,6 +1054,149 @@ func (handler *taskHandlerImpl) handleDecisionUpsertWorkflowSearchAttributes(
 	return err
 }
 
+func (handler *taskHandlerImpl) handleDecisionAcceptWorkflowUpdate(
+	ctx context.Context,
+	decision *types.Decision,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeAcceptWorkflowUpdateCounter,
+	)
+
+	attr := decision.AcceptWorkflowUpdateDecisionAttributes
+	if err := handler.validateDecisionAttr(
+		func() error {
+			if err := handler.attrValidator.validateAcceptWorkflowUpdateAttributes(attr); err != nil {
+				return err
+			}
+			return handler.validateWorkflowUpdatePending(attr.GetUpdateID())
+		},
+		types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+		metrics.DecisionTypeTag(types.DecisionTypeAcceptWorkflowUpdate.String()),
+		attr.Input,
+		"AcceptWorkflowUpdateDecisionAttributes.Input exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	if _, err := handler.mutableState.AddWorkflowExecutionUpdateAcceptedEvent(handler.decisionTaskCompletedID, attr); err != nil {
+		return err
+	}
+	handler.workflowUpdateDecisions = append(handler.workflowUpdateDecisions, decision)
+	return nil
+}
+
+func (handler *taskHandlerImpl) handleDecisionRejectWorkflowUpdate(
+	ctx context.Context,
+	decision *types.Decision,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeRejectWorkflowUpdateCounter,
+	)
+
+	attr := decision.RejectWorkflowUpdateDecisionAttributes
+	if err := handler.validateDecisionAttr(
+		func() error {
+			if err := handler.attrValidator.validateRejectWorkflowUpdateAttributes(attr); err != nil {
+				return err
+			}
+			return handler.validateWorkflowUpdatePending(attr.GetUpdateID())
+		},
+		types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+		metrics.DecisionTypeTag(types.DecisionTypeRejectWorkflowUpdate.String()),
+		attr.Details,
+		"RejectWorkflowUpdateDecisionAttributes.Details exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	if _, err := handler.mutableState.AddWorkflowExecutionUpdateRejectedEvent(handler.decisionTaskCompletedID, attr); err != nil {
+		return err
+	}
+	handler.workflowUpdateDecisions = append(handler.workflowUpdateDecisions, decision)
+	return nil
+}
+
+func (handler *taskHandlerImpl) handleDecisionCompleteWorkflowUpdate(
+	ctx context.Context,
+	decision *types.Decision,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeCompleteWorkflowUpdateCounter,
+	)
+
+	attr := decision.CompleteWorkflowUpdateDecisionAttributes
+	if err := handler.validateDecisionAttr(
+		func() error {
+			if err := handler.attrValidator.validateCompleteWorkflowUpdateAttributes(attr); err != nil {
+				return err
+			}
+			if !handler.mutableState.IsWorkflowUpdateAccepted(attr.GetUpdateID()) {
+				return &types.BadRequestError{Message: fmt.Sprintf("Workflow update %v is not accepted or already completed.", attr.GetUpdateID())}
+			}
+			return nil
+		},
+		types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	for _, blob := range [][]byte{attr.Result, attr.FailureDetails} {
+		failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+			metrics.DecisionTypeTag(types.DecisionTypeCompleteWorkflowUpdate.String()),
+			blob,
+			"CompleteWorkflowUpdateDecisionAttributes exceeds size limit.",
+		)
+		if err != nil || failWorkflow {
+			handler.stopProcessing = true
+			return err
+		}
+	}
+
+	if _, err := handler.mutableState.AddWorkflowExecutionUpdateCompletedEvent(handler.decisionTaskCompletedID, attr); err != nil {
+		return err
+	}
+	handler.workflowUpdateDecisions = append(handler.workflowUpdateDecisions, decision)
+	return nil
+}
+
+// validateWorkflowUpdatePending checks that the update was requested and that no earlier decision
+// accepted or rejected it. Accepted updates are recorded in the mutable state as soon as their event is
+// added, rejected ones only leave the rejection decision of the current decision task behind.
+func (handler *taskHandlerImpl) validateWorkflowUpdatePending(updateID string) error {
+	if handler.mutableState.IsWorkflowUpdateAccepted(updateID) {
+		return &types.BadRequestError{Message: fmt.Sprintf("Workflow update %v is already accepted.", updateID)}
+	}
+	for _, decision := range handler.workflowUpdateDecisions {
+		if decision.RejectWorkflowUpdateDecisionAttributes.GetUpdateID() == updateID {
+			return &types.BadRequestError{Message: fmt.Sprintf("Workflow update %v is already rejected.", updateID)}
+		}
+	}
+	if !handler.mutableState.GetUpdateRegistry().IsPending(updateID) {
+		return &types.BadRequestError{Message: fmt.Sprintf("Workflow update %v is unknown.", updateID)}
+	}
+	return nil
+}
+
 func convertSearchAttributesToByteArray(fields map[string][]byte) []byte {
 	result := make([]byte, 0)
 
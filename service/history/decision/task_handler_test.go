@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -50,6 +51,7 @@ import (
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/update"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -1231,6 +1233,264 @@ func TestHandleDecisionRecordMarker(t *testing.T) {
 	}
 }
 
+func TestHandleDecisionWorkflowUpdate(t *testing.T) {
+	rejectDecision := &types.Decision{
+		DecisionType: common.Ptr(types.DecisionTypeRejectWorkflowUpdate),
+		RejectWorkflowUpdateDecisionAttributes: &types.RejectWorkflowUpdateDecisionAttributes{
+			UpdateID:   "update-1",
+			UpdateName: "approve",
+		},
+	}
+	tests := []struct {
+		name     string
+		decision *types.Decision
+		// pending are the updates waiting in the registry, accepted the ones accepted in the mutable state
+		pending           []string
+		accepted          []string
+		previousDecisions []*types.Decision
+		expectMockCalls   func(taskHandler *taskHandlerImpl, decision *types.Decision)
+		asserts           func(t *testing.T, taskHandler *taskHandlerImpl, err error)
+	}{
+		{
+			name: "accept attributes validation failure",
+			decision: &types.Decision{
+				DecisionType:                           common.Ptr(types.DecisionTypeAcceptWorkflowUpdate),
+				AcceptWorkflowUpdateDecisionAttributes: &types.AcceptWorkflowUpdateDecisionAttributes{UpdateID: "update-1"},
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes, *taskHandler.failDecisionCause)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Empty(t, taskHandler.workflowUpdateDecisions)
+			},
+		},
+		{
+			name: "accept success",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeAcceptWorkflowUpdate),
+				AcceptWorkflowUpdateDecisionAttributes: &types.AcceptWorkflowUpdateDecisionAttributes{
+					UpdateID:   "update-1",
+					UpdateName: "approve",
+					Input:      []byte("input"),
+				},
+			},
+			pending: []string{"update-1"},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, decision *types.Decision) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().
+					AddWorkflowExecutionUpdateAcceptedEvent(taskHandler.decisionTaskCompletedID, decision.AcceptWorkflowUpdateDecisionAttributes)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Len(t, taskHandler.workflowUpdateDecisions, 1)
+			},
+		},
+		{
+			name: "accept unknown update",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeAcceptWorkflowUpdate),
+				AcceptWorkflowUpdateDecisionAttributes: &types.AcceptWorkflowUpdateDecisionAttributes{
+					UpdateID:   "update-1",
+					UpdateName: "approve",
+				},
+			},
+			pending: []string{"update-2"},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes, *taskHandler.failDecisionCause)
+				assert.Equal(t, "Workflow update update-1 is unknown.", *taskHandler.failMessage)
+				assert.Empty(t, taskHandler.workflowUpdateDecisions)
+			},
+		},
+		{
+			name: "accept update accepted before",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeAcceptWorkflowUpdate),
+				AcceptWorkflowUpdateDecisionAttributes: &types.AcceptWorkflowUpdateDecisionAttributes{
+					UpdateID:   "update-1",
+					UpdateName: "approve",
+				},
+			},
+			accepted: []string{"update-1"},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes, *taskHandler.failDecisionCause)
+				assert.Equal(t, "Workflow update update-1 is already accepted.", *taskHandler.failMessage)
+				assert.Empty(t, taskHandler.workflowUpdateDecisions)
+			},
+		},
+		{
+			name: "accept update rejected by an earlier decision",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeAcceptWorkflowUpdate),
+				AcceptWorkflowUpdateDecisionAttributes: &types.AcceptWorkflowUpdateDecisionAttributes{
+					UpdateID:   "update-1",
+					UpdateName: "approve",
+				},
+			},
+			pending:           []string{"update-1"},
+			previousDecisions: []*types.Decision{rejectDecision},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadAcceptWorkflowUpdateAttributes, *taskHandler.failDecisionCause)
+				assert.Equal(t, "Workflow update update-1 is already rejected.", *taskHandler.failMessage)
+				assert.Len(t, taskHandler.workflowUpdateDecisions, 1)
+			},
+		},
+		{
+			name: "reject attributes validation failure",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeRejectWorkflowUpdate),
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes, *taskHandler.failDecisionCause)
+				assert.True(t, taskHandler.stopProcessing)
+			},
+		},
+		{
+			name: "reject success",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeRejectWorkflowUpdate),
+				RejectWorkflowUpdateDecisionAttributes: &types.RejectWorkflowUpdateDecisionAttributes{
+					UpdateID:   "update-1",
+					UpdateName: "approve",
+					Reason:     "not allowed",
+				},
+			},
+			pending: []string{"update-1"},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, decision *types.Decision) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().
+					AddWorkflowExecutionUpdateRejectedEvent(taskHandler.decisionTaskCompletedID, decision.RejectWorkflowUpdateDecisionAttributes)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Len(t, taskHandler.workflowUpdateDecisions, 1)
+			},
+		},
+		{
+			name:     "reject unknown update",
+			decision: rejectDecision,
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes, *taskHandler.failDecisionCause)
+				assert.Equal(t, "Workflow update update-1 is unknown.", *taskHandler.failMessage)
+				assert.Empty(t, taskHandler.workflowUpdateDecisions)
+			},
+		},
+		{
+			name:     "reject update accepted before",
+			decision: rejectDecision,
+			accepted: []string{"update-1"},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRejectWorkflowUpdateAttributes, *taskHandler.failDecisionCause)
+				assert.Equal(t, "Workflow update update-1 is already accepted.", *taskHandler.failMessage)
+				assert.Empty(t, taskHandler.workflowUpdateDecisions)
+			},
+		},
+		{
+			name: "complete update not accepted",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeCompleteWorkflowUpdate),
+				CompleteWorkflowUpdateDecisionAttributes: &types.CompleteWorkflowUpdateDecisionAttributes{
+					UpdateID: "update-1",
+					Result:   []byte("result"),
+				},
+			},
+			pending: []string{"update-1"},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes, *taskHandler.failDecisionCause)
+				assert.Equal(t, "Workflow update update-1 is not accepted or already completed.", *taskHandler.failMessage)
+				assert.Empty(t, taskHandler.workflowUpdateDecisions)
+			},
+		},
+		{
+			name: "complete update rejected by an earlier decision",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeCompleteWorkflowUpdate),
+				CompleteWorkflowUpdateDecisionAttributes: &types.CompleteWorkflowUpdateDecisionAttributes{
+					UpdateID: "update-1",
+					Result:   []byte("result"),
+				},
+			},
+			previousDecisions: []*types.Decision{rejectDecision},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadCompleteWorkflowUpdateAttributes, *taskHandler.failDecisionCause)
+				assert.Len(t, taskHandler.workflowUpdateDecisions, 1)
+			},
+		},
+		{
+			name: "complete blob size limit check failure",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeCompleteWorkflowUpdate),
+				CompleteWorkflowUpdateDecisionAttributes: &types.CompleteWorkflowUpdateDecisionAttributes{
+					UpdateID: "update-1",
+					Result:   []byte("some-result"),
+				},
+			},
+			accepted: []string{"update-1"},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, decision *types.Decision) {
+				taskHandler.sizeLimitChecker.blobSizeLimitError = 5
+				taskHandler.sizeLimitChecker.blobSizeLimitWarn = 3
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddFailWorkflowEvent(testTaskCompletedID, &types.FailWorkflowExecutionDecisionAttributes{
+					Reason:  common.StringPtr(common.FailureReasonDecisionBlobSizeExceedsLimit),
+					Details: []byte("CompleteWorkflowUpdateDecisionAttributes exceeds size limit."),
+				})
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Empty(t, taskHandler.workflowUpdateDecisions)
+			},
+		},
+		{
+			name: "complete success",
+			decision: &types.Decision{
+				DecisionType: common.Ptr(types.DecisionTypeCompleteWorkflowUpdate),
+				CompleteWorkflowUpdateDecisionAttributes: &types.CompleteWorkflowUpdateDecisionAttributes{
+					UpdateID: "update-1",
+					Result:   []byte("result"),
+				},
+			},
+			accepted: []string{"update-1"},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, decision *types.Decision) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().
+					AddWorkflowExecutionUpdateCompletedEvent(taskHandler.decisionTaskCompletedID, decision.CompleteWorkflowUpdateDecisionAttributes)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, err error) {
+				assert.Nil(t, err)
+				assert.Len(t, taskHandler.workflowUpdateDecisions, 1)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taskHandler := newTaskHandlerForTest(t)
+			registry := update.NewRegistry()
+			for _, id := range test.pending {
+				registry.Register(&types.WorkflowUpdate{UpdateID: id}, false)
+			}
+			mockMutableState := taskHandler.mutableState.(*execution.MockMutableState)
+			mockMutableState.EXPECT().GetUpdateRegistry().Return(registry).AnyTimes()
+			mockMutableState.EXPECT().IsWorkflowUpdateAccepted(gomock.Any()).DoAndReturn(func(id string) bool {
+				return slices.Contains(test.accepted, id)
+			}).AnyTimes()
+			taskHandler.workflowUpdateDecisions = test.previousDecisions
+			if test.expectMockCalls != nil {
+				test.expectMockCalls(taskHandler, test.decision)
+			}
+			err := taskHandler.handleDecision(context.Background(), test.decision)
+			test.asserts(t, taskHandler, err)
+		})
+	}
+}
+
 func TestHandleDecisionScheduleActivity(t *testing.T) {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{ID: testdata.DomainID, Name: testdata.DomainName},
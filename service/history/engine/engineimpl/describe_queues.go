@@ -58,10 +58,11 @@ func (e *historyEngineImpl) describeQueue(
 		return nil, err
 	}
 
-	serializedStates := make([]string, 0, len(resp.GetStateActionResult.States))
+	serializedStates := make([]string, 0, len(resp.GetStateActionResult.States)+len(resp.GetStateActionResult.Descriptions))
 	for _, state := range resp.GetStateActionResult.States {
 		serializedStates = append(serializedStates, e.serializeQueueState(state))
 	}
+	serializedStates = append(serializedStates, resp.GetStateActionResult.Descriptions...)
 	return &types.DescribeQueueResponse{
 		ProcessingQueueStates: serializedStates,
 	}, nil
@@ -86,6 +86,7 @@ func createDescribeWorkflowExecutionResponse(ctx context.Context, mutableState e
 
 	result := &types.DescribeWorkflowExecutionResponse{
 		ExecutionConfiguration: executionConfiguration,
+		PauseInfo:              mapPauseInfo(executionInfo.Paused, executionInfo.PauseReason, executionInfo.PauseIdentity, executionInfo.PausedTimestamp),
 	}
 
 	// TODO: we need to consider adding execution time to mutable state
@@ -198,6 +199,7 @@ func mapPendingActivityInfo(ai *persistence.ActivityInfo, activityScheduledEvent
 	p := &types.PendingActivityInfo{
 		ActivityID: ai.ActivityID,
 		ScheduleID: ai.ScheduleID,
+		PauseInfo:  mapPauseInfo(ai.Paused, ai.PauseReason, ai.PauseIdentity, ai.PausedTimestamp),
 	}
 
 	state := types.PendingActivityStateScheduled
@@ -244,6 +246,17 @@ func mapPendingActivityInfo(ai *persistence.ActivityInfo, activityScheduledEvent
 	return p
 }
 
+func mapPauseInfo(paused bool, reason, identity string, pausedTimestamp time.Time) *types.PauseInfo {
+	if !paused {
+		return nil
+	}
+	return &types.PauseInfo{
+		Reason:          reason,
+		Identity:        identity,
+		PausedTimestamp: common.Int64Ptr(pausedTimestamp.UnixNano()),
+	}
+}
+
 func mapPendingChildExecutionInfo(childExecution *persistence.ChildExecutionInfo, domainEntry *cache.DomainCacheEntry, domainCache cache.DomainCache) (*types.PendingChildExecutionInfo, error) {
 	childDomainName, err := execution.GetChildExecutionDomainName(
 		childExecution,
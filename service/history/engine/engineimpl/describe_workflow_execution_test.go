@@ -282,6 +282,52 @@ func TestCreateDescribeWorkflowExecutionResponse(t *testing.T) {
 				assert.Empty(t, result.PendingActivities)
 				assert.Empty(t, result.PendingChildren)
 				assert.Nil(t, result.PendingDecision)
+				assert.Nil(t, result.PauseInfo)
+			},
+		},
+		{
+			name: "Success - paused workflow",
+			setupMocks: func(mockMutableState *execution.MockMutableState, mockDomainCache *cache.MockDomainCache) {
+				executionInfo := &persistence.WorkflowExecutionInfo{
+					DomainID:                    "test-domain-id",
+					WorkflowID:                  "test-workflow-id",
+					RunID:                       "test-run-id",
+					TaskList:                    "test-task-list",
+					WorkflowTimeout:             1800,
+					DecisionStartToCloseTimeout: 30,
+					WorkflowTypeName:            "test-workflow-type",
+					State:                       persistence.WorkflowStateRunning,
+					Paused:                      true,
+					PauseReason:                 "investigating",
+					PauseIdentity:               "operator",
+					PausedTimestamp:             time.Unix(0, 2005),
+				}
+				startEvent := &types.HistoryEvent{
+					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+						FirstDecisionTaskBackoffSeconds: common.Int32Ptr(5),
+					},
+				}
+
+				mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo)
+				mockMutableState.EXPECT().GetStartEvent(gomock.Any()).Return(startEvent, nil)
+				mockMutableState.EXPECT().GetNextEventID().Return(int64(10))
+				mockMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{})
+				mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{})
+				mockMutableState.EXPECT().GetDomainEntry().Return(&cache.DomainCacheEntry{})
+				mockMutableState.EXPECT().GetPendingDecision().Return(nil, false)
+			},
+			expectError: false,
+			verifyResult: func(t *testing.T, result *types.DescribeWorkflowExecutionResponse) {
+				assert.NotNil(t, result.ExecutionConfiguration)
+				assert.NotNil(t, result.WorkflowExecutionInfo)
+				assert.Empty(t, result.PendingActivities)
+				assert.Empty(t, result.PendingChildren)
+				assert.Nil(t, result.PendingDecision)
+				assert.Equal(t, &types.PauseInfo{
+					Reason:          "investigating",
+					Identity:        "operator",
+					PausedTimestamp: common.Int64Ptr(2005),
+				}, result.PauseInfo)
 			},
 		},
 		{
@@ -795,6 +841,40 @@ func TestMapPendingActivityInfo(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success - paused activity",
+			activityInfo: &persistence.ActivityInfo{
+				ActivityID:      "test-activity-id-4",
+				ScheduleID:      128,
+				StartedID:       constants.EmptyEventID,
+				ScheduledTime:   time.Unix(0, 1999),
+				Paused:          true,
+				PauseReason:     "investigating",
+				PauseIdentity:   "operator",
+				PausedTimestamp: time.Unix(0, 2005),
+			},
+			activityScheduledEvent: &types.HistoryEvent{
+				ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+					ActivityType: &types.ActivityType{
+						Name: "test-activity-type-4",
+					},
+				},
+			},
+			expected: &types.PendingActivityInfo{
+				ActivityID:         "test-activity-id-4",
+				ScheduleID:         128,
+				State:              types.PendingActivityStateScheduled.Ptr(),
+				ScheduledTimestamp: common.Int64Ptr(1999),
+				ActivityType: &types.ActivityType{
+					Name: "test-activity-type-4",
+				},
+				PauseInfo: &types.PauseInfo{
+					Reason:          "investigating",
+					Identity:        "operator",
+					PausedTimestamp: common.Int64Ptr(2005),
+				},
+			},
+		},
 		{
 			name: "Success - cancel requested activity",
 			activityInfo: &persistence.ActivityInfo{
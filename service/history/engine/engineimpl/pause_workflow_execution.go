@@ -0,0 +1,216 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// PauseWorkflowExecution pauses a running workflow, or a single pending activity when the request names one.
+// The pause is recorded as a WorkflowExecutionPaused or ActivityTaskPaused event, so it is replicated like any
+// other event. The task executors drop the tasks of paused workflows and activities until they are unpaused.
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+) error {
+
+	pauseRequest := request.GetPauseRequest()
+	return e.updateRunningWorkflowExecution(
+		ctx,
+		request.GetDomainUUID(),
+		pauseRequest.GetWorkflowExecution(),
+		func(mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if pauseRequest.GetActivityID() == "" {
+				if mutableState.GetExecutionInfo().Paused {
+					return &workflow.UpdateAction{Noop: true}, nil
+				}
+				if _, err := mutableState.AddWorkflowExecutionPausedEvent(pauseRequest.GetReason(), pauseRequest.GetIdentity()); err != nil {
+					return nil, &types.InternalServiceError{Message: "Unable to pause workflow execution."}
+				}
+				return workflow.UpdateWithoutDecision, nil
+			}
+
+			ai, err := getPendingActivityByID(mutableState, pauseRequest.GetActivityID())
+			if err != nil {
+				return nil, err
+			}
+			if ai.Paused {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			if _, err := mutableState.AddActivityTaskPausedEvent(ai.ScheduleID, pauseRequest.GetReason(), pauseRequest.GetIdentity()); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to pause activity."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow, or a single paused activity when the request names one.
+// The workflow tasks are regenerated, since the task executors dropped them while the workflow was paused.
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUnpauseWorkflowExecutionRequest,
+) error {
+
+	unpauseRequest := request.GetUnpauseRequest()
+	return e.updateRunningWorkflowExecution(
+		ctx,
+		request.GetDomainUUID(),
+		unpauseRequest.GetWorkflowExecution(),
+		func(mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if unpauseRequest.GetActivityID() == "" {
+				if !mutableState.GetExecutionInfo().Paused {
+					return &workflow.UpdateAction{Noop: true}, nil
+				}
+				if _, err := mutableState.AddWorkflowExecutionUnpausedEvent(unpauseRequest.GetReason(), unpauseRequest.GetIdentity()); err != nil {
+					return nil, &types.InternalServiceError{Message: "Unable to unpause workflow execution."}
+				}
+			} else {
+				ai, err := getPendingActivityByID(mutableState, unpauseRequest.GetActivityID())
+				if err != nil {
+					return nil, err
+				}
+				if !ai.Paused {
+					return &workflow.UpdateAction{Noop: true}, nil
+				}
+				if _, err := mutableState.AddActivityTaskUnpausedEvent(ai.ScheduleID, unpauseRequest.GetReason(), unpauseRequest.GetIdentity()); err != nil {
+					return nil, &types.InternalServiceError{Message: "Unable to unpause activity."}
+				}
+			}
+
+			if err := e.refreshTasks(ctx, mutableState); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// ResetActivity clears the attempt count and the last failure of a pending activity that is not running,
+// and dispatches its pending retry right away instead of waiting for its backoff. A running activity
+// is not reset, since the task token of its worker carries the current attempt.
+// The activity info is replicated through the sync activity task, so no history event is written.
+func (e *historyEngineImpl) ResetActivity(
+	ctx context.Context,
+	request *types.HistoryResetActivityRequest,
+) error {
+
+	resetRequest := request.GetResetRequest()
+	return e.updateRunningWorkflowExecution(
+		ctx,
+		request.GetDomainUUID(),
+		resetRequest.GetWorkflowExecution(),
+		func(mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			ai, err := getPendingActivityByID(mutableState, resetRequest.GetActivityID())
+			if err != nil {
+				return nil, err
+			}
+			if ai.StartedID != constants.EmptyEventID {
+				return nil, &types.BadRequestError{
+					Message: fmt.Sprintf("Activity %v is running, it can only be reset while it waits for its next attempt.", ai.ActivityID),
+				}
+			}
+			ai.Attempt = 0
+			ai.LastFailureReason = ""
+			ai.LastFailureDetails = nil
+			ai.LastWorkerIdentity = ""
+			ai.ScheduledTime = e.timeSource.Now()
+			if err := mutableState.UpdateActivity(ai); err != nil {
+				return nil, err
+			}
+			if err := e.refreshTasks(ctx, mutableState); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) updateRunningWorkflowExecution(
+	ctx context.Context,
+	domainUUID string,
+	workflowExecution *types.WorkflowExecution,
+	action func(mutableState execution.MutableState) (*workflow.UpdateAction, error),
+) error {
+
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, domainUUID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.logger,
+		e.executionCache,
+		e.executionManager,
+		domainEntry.GetInfo().ID,
+		e.shard.GetDomainCache(),
+		types.WorkflowExecution{
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			return action(mutableState)
+		})
+}
+
+func (e *historyEngineImpl) refreshTasks(
+	ctx context.Context,
+	mutableState execution.MutableState,
+) error {
+
+	mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
+		e.shard.GetConfig(),
+		e.shard.GetClusterMetadata(),
+		e.shard.GetDomainCache(),
+		e.shard.GetEventsCache(),
+		e.shard.GetShardID(),
+		e.logger,
+	)
+	return mutableStateTaskRefresher.RefreshTasks(ctx, mutableState.GetExecutionInfo().StartTimestamp, mutableState)
+}
+
+func getPendingActivityByID(
+	mutableState execution.MutableState,
+	activityID string,
+) (*persistence.ActivityInfo, error) {
+	if activityID == "" {
+		return nil, &types.BadRequestError{Message: "ActivityID is not set on request."}
+	}
+	ai, ok := mutableState.GetActivityByActivityID(activityID)
+	if !ok {
+		return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("Pending activity %v not found.", activityID)}
+	}
+	return ai, nil
+}
@@ -0,0 +1,247 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/checksum"
+	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine"
+	"github.com/uber/cadence/service/history/engine/testdata"
+)
+
+func TestPauseWorkflowExecution(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+
+	cases := []struct {
+		name           string
+		workflowPaused bool
+		call           func(engine.Engine) error
+		expectUpdate   bool
+		validate       func(t *testing.T, mutation *persistence.WorkflowMutation)
+		expectedErrMsg string
+	}{
+		{
+			name: "pause workflow",
+			call: func(e engine.Engine) error {
+				return e.PauseWorkflowExecution(context.Background(), &types.HistoryPauseWorkflowExecutionRequest{
+					DomainUUID: constants.TestDomainID,
+					PauseRequest: &types.PauseWorkflowExecutionRequest{
+						Domain:            constants.TestDomainName,
+						WorkflowExecution: execution,
+						Reason:            "investigating",
+						Identity:          "operator",
+					},
+				})
+			},
+			expectUpdate: true,
+			validate: func(t *testing.T, mutation *persistence.WorkflowMutation) {
+				assert.True(t, mutation.ExecutionInfo.Paused)
+				assert.Equal(t, "investigating", mutation.ExecutionInfo.PauseReason)
+				assert.Equal(t, "operator", mutation.ExecutionInfo.PauseIdentity)
+			},
+		},
+		{
+			name:           "pause workflow already paused",
+			workflowPaused: true,
+			call: func(e engine.Engine) error {
+				return e.PauseWorkflowExecution(context.Background(), &types.HistoryPauseWorkflowExecutionRequest{
+					DomainUUID:   constants.TestDomainID,
+					PauseRequest: &types.PauseWorkflowExecutionRequest{WorkflowExecution: execution},
+				})
+			},
+		},
+		{
+			name: "pause activity",
+			call: func(e engine.Engine) error {
+				return e.PauseWorkflowExecution(context.Background(), &types.HistoryPauseWorkflowExecutionRequest{
+					DomainUUID: constants.TestDomainID,
+					PauseRequest: &types.PauseWorkflowExecutionRequest{
+						WorkflowExecution: execution,
+						ActivityID:        "activity-1",
+						Reason:            "investigating",
+						Identity:          "operator",
+					},
+				})
+			},
+			expectUpdate: true,
+			validate: func(t *testing.T, mutation *persistence.WorkflowMutation) {
+				assert.False(t, mutation.ExecutionInfo.Paused)
+				ai := findActivityInfo(t, mutation.UpsertActivityInfos, "activity-1")
+				assert.True(t, ai.Paused)
+				assert.Equal(t, "investigating", ai.PauseReason)
+			},
+		},
+		{
+			name: "pause unknown activity",
+			call: func(e engine.Engine) error {
+				return e.PauseWorkflowExecution(context.Background(), &types.HistoryPauseWorkflowExecutionRequest{
+					DomainUUID:   constants.TestDomainID,
+					PauseRequest: &types.PauseWorkflowExecutionRequest{WorkflowExecution: execution, ActivityID: "unknown"},
+				})
+			},
+			expectedErrMsg: "Pending activity unknown not found.",
+		},
+		{
+			name: "unpause workflow not paused",
+			call: func(e engine.Engine) error {
+				return e.UnpauseWorkflowExecution(context.Background(), &types.HistoryUnpauseWorkflowExecutionRequest{
+					DomainUUID:     constants.TestDomainID,
+					UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{WorkflowExecution: execution},
+				})
+			},
+		},
+		{
+			name: "unpause activity not paused",
+			call: func(e engine.Engine) error {
+				return e.UnpauseWorkflowExecution(context.Background(), &types.HistoryUnpauseWorkflowExecutionRequest{
+					DomainUUID:     constants.TestDomainID,
+					UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{WorkflowExecution: execution, ActivityID: "activity-1"},
+				})
+			},
+		},
+		{
+			name: "reset activity waiting for retry",
+			call: func(e engine.Engine) error {
+				return e.ResetActivity(context.Background(), &types.HistoryResetActivityRequest{
+					DomainUUID:   constants.TestDomainID,
+					ResetRequest: &types.ResetActivityRequest{WorkflowExecution: execution, ActivityID: "activity-1"},
+				})
+			},
+			expectUpdate: true,
+			validate: func(t *testing.T, mutation *persistence.WorkflowMutation) {
+				ai := findActivityInfo(t, mutation.UpsertActivityInfos, "activity-1")
+				assert.Equal(t, int32(0), ai.Attempt)
+				assert.Empty(t, ai.LastFailureReason)
+			},
+		},
+		{
+			name: "reset started activity",
+			call: func(e engine.Engine) error {
+				return e.ResetActivity(context.Background(), &types.HistoryResetActivityRequest{
+					DomainUUID:   constants.TestDomainID,
+					ResetRequest: &types.ResetActivityRequest{WorkflowExecution: execution, ActivityID: "activity-2"},
+				})
+			},
+			expectedErrMsg: "Activity activity-2 is running, it can only be reset while it waits for its next attempt.",
+		},
+		{
+			name: "reset activity without activity ID",
+			call: func(e engine.Engine) error {
+				return e.ResetActivity(context.Background(), &types.HistoryResetActivityRequest{
+					DomainUUID:   constants.TestDomainID,
+					ResetRequest: &types.ResetActivityRequest{WorkflowExecution: execution},
+				})
+			},
+			expectedErrMsg: "ActivityID is not set on request.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+			eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+				Return(&types.ActiveClusterInfo{ActiveClusterName: eft.ShardCtx.GetClusterMetadata().GetCurrentClusterName()}, nil).AnyTimes()
+			eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+				State: &persistence.WorkflowMutableState{
+					ExecutionInfo: &persistence.WorkflowExecutionInfo{
+						DomainID:   constants.TestDomainID,
+						WorkflowID: execution.WorkflowID,
+						RunID:      execution.RunID,
+						State:      persistence.WorkflowStateRunning,
+						Paused:     tc.workflowPaused,
+					},
+					ActivityInfos: map[int64]*persistence.ActivityInfo{
+						5: {ScheduleID: 5, ActivityID: "activity-1", StartedID: commonconstants.EmptyEventID, Attempt: 2, LastFailureReason: "failure"},
+						6: {ScheduleID: 6, ActivityID: "activity-2", StartedID: 7, Attempt: 3, LastFailureReason: "failure"},
+					},
+					ExecutionStats: &persistence.ExecutionStats{},
+					Checksum:       checksum.Checksum{},
+				},
+				MutableStateStats: &persistence.MutableStateStats{},
+			}, nil).Maybe()
+			eft.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).
+				Return(&persistence.AppendHistoryNodesResponse{}, nil).Maybe()
+			// the task refresher reads the workflow started and activity scheduled events
+			readHistoryResp := &persistence.ReadHistoryBranchResponse{}
+			eft.ShardCtx.Resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				eventID := args.Get(1).(*persistence.ReadHistoryBranchRequest).MaxEventID - 1
+				event := &types.HistoryEvent{
+					ID:                                   eventID,
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{},
+				}
+				if eventID == 1 {
+					event = &types.HistoryEvent{
+						ID:                                      eventID,
+						WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+					}
+				}
+				readHistoryResp.HistoryEvents = []*types.HistoryEvent{event}
+			}).Return(readHistoryResp, nil).Maybe()
+			var mutation *persistence.WorkflowMutation
+			eft.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				mutation = &args.Get(1).(*persistence.UpdateWorkflowExecutionRequest).UpdateWorkflowMutation
+			}).Return(&persistence.UpdateWorkflowExecutionResponse{
+				MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+			}, nil).Maybe()
+
+			eft.Engine.Start()
+			err := tc.call(eft.Engine)
+			eft.Engine.Stop()
+
+			if tc.expectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectUpdate, mutation != nil)
+			if tc.validate != nil && mutation != nil {
+				tc.validate(t, mutation)
+			}
+		})
+	}
+}
+
+func findActivityInfo(t *testing.T, activityInfos []*persistence.ActivityInfo, activityID string) *persistence.ActivityInfo {
+	for _, ai := range activityInfos {
+		if ai.ActivityID == activityID {
+			return ai
+		}
+	}
+	require.Failf(t, "activity info not found", "activity %v is not in %v", activityID, activityInfos)
+	return nil
+}
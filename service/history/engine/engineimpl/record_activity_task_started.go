@@ -122,6 +122,12 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return workflow.ErrActivityTaskNotFound
 			}
 
+			// Activity task may already be in matching when the activity is paused.
+			// It is dropped here and dispatched again when the activity is unpaused.
+			if ai.StartedID == constants.EmptyEventID && execution.IsActivityPaused(mutableState, ai) {
+				return workflow.ErrActivityTaskPaused
+			}
+
 			scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
 			if err != nil {
 				return err
@@ -514,6 +514,7 @@ func getStartRequest(
 		JitterStartSeconds:                  request.JitterStartSeconds,
 		FirstRunAtTimeStamp:                 request.FirstRunAtTimestamp,
 		ActiveClusterSelectionPolicy:        request.ActiveClusterSelectionPolicy,
+		TaskPriority:                        request.TaskPriority,
 	}
 
 	return common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now(), partitionConfig)
@@ -0,0 +1,83 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/update"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// UpdateWorkflowExecution hands an update to the decider of a running workflow and waits for the outcome.
+// The update is delivered on the next decision task, which is scheduled if none is pending. The decider answers
+// with AcceptWorkflowUpdate, RejectWorkflowUpdate and CompleteWorkflowUpdate decisions, which are recorded as
+// history events and replicated with the rest of the history. The call returns once the update reached the
+// requested stage, or once it was rejected.
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+
+	updateRequest := request.GetUpdateRequest()
+	waitForStage := types.WorkflowUpdateStageCompleted
+	if updateRequest.WaitForStage != nil {
+		waitForStage = updateRequest.GetWaitForStage()
+	}
+
+	var workflowUpdate update.Update
+	err := e.updateRunningWorkflowExecution(
+		ctx,
+		request.GetDomainUUID(),
+		updateRequest.GetWorkflowExecution(),
+		func(mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			accepted := mutableState.IsWorkflowUpdateAccepted(updateRequest.GetUpdateID())
+			workflowUpdate = mutableState.GetUpdateRegistry().Register(&types.WorkflowUpdate{
+				UpdateID:   updateRequest.GetUpdateID(),
+				UpdateName: updateRequest.GetUpdateName(),
+				Input:      updateRequest.Input,
+			}, accepted)
+			if accepted {
+				// the decider already accepted the update, and completes it without another decision task
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			if mutableState.HasPendingDecision() {
+				// the update goes out with the pending decision task, or with the one
+				// scheduled when the in-flight decision task completes
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			return workflow.UpdateWithNewDecision, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-workflowUpdate.StageCh(waitForStage):
+		return workflowUpdate.Outcome()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
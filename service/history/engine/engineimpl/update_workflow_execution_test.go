@@ -0,0 +1,152 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/checksum"
+	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestUpdateWorkflowExecution(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+
+	cases := []struct {
+		name            string
+		state           int
+		pendingDecision bool
+		// accepted is set for an update the decider accepted before the mutable state was reloaded
+		accepted     bool
+		waitForStage *types.WorkflowUpdateStage
+		expectUpdate bool
+		expectedResp *types.UpdateWorkflowExecutionResponse
+		expectedErr  error
+	}{
+		{
+			name:         "schedules a decision to deliver the update",
+			state:        persistence.WorkflowStateRunning,
+			expectUpdate: true,
+			expectedErr:  context.DeadlineExceeded,
+		},
+		{
+			name:            "delivers the update with the pending decision",
+			state:           persistence.WorkflowStateRunning,
+			pendingDecision: true,
+			expectedErr:     context.DeadlineExceeded,
+		},
+		{
+			name:         "waits on an update accepted before",
+			state:        persistence.WorkflowStateRunning,
+			accepted:     true,
+			waitForStage: types.WorkflowUpdateStageAccepted.Ptr(),
+			expectedResp: &types.UpdateWorkflowExecutionResponse{
+				UpdateID: "update-1",
+				Stage:    types.WorkflowUpdateStageAccepted.Ptr(),
+			},
+		},
+		{
+			name:        "workflow already completed",
+			state:       persistence.WorkflowStateCompleted,
+			expectedErr: workflow.ErrAlreadyCompleted,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+			eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+				Return(&types.ActiveClusterInfo{ActiveClusterName: eft.ShardCtx.GetClusterMetadata().GetCurrentClusterName()}, nil).AnyTimes()
+			executionInfo := &persistence.WorkflowExecutionInfo{
+				DomainID:           constants.TestDomainID,
+				WorkflowID:         execution.WorkflowID,
+				RunID:              execution.RunID,
+				State:              tc.state,
+				TaskList:           "tasklist",
+				DecisionScheduleID: commonconstants.EmptyEventID,
+				DecisionStartedID:  commonconstants.EmptyEventID,
+				NextEventID:        3,
+			}
+			if tc.pendingDecision {
+				executionInfo.DecisionScheduleID = 2
+			}
+			if tc.accepted {
+				executionInfo.AcceptedUpdateIDs = []string{"update-1"}
+			}
+			eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+				State: &persistence.WorkflowMutableState{
+					ExecutionInfo:  executionInfo,
+					ExecutionStats: &persistence.ExecutionStats{},
+					Checksum:       checksum.Checksum{},
+				},
+				MutableStateStats: &persistence.MutableStateStats{},
+			}, nil).Maybe()
+			eft.ShardCtx.Resource.HistoryMgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).
+				Return(&persistence.AppendHistoryNodesResponse{}, nil).Maybe()
+			var mutation *persistence.WorkflowMutation
+			eft.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				mutation = &args.Get(1).(*persistence.UpdateWorkflowExecutionRequest).UpdateWorkflowMutation
+			}).Return(&persistence.UpdateWorkflowExecutionResponse{
+				MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+			}, nil).Maybe()
+
+			// nothing answers the update in this test, so the call waits until the deadline
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			eft.Engine.Start()
+			resp, err := eft.Engine.UpdateWorkflowExecution(ctx, &types.HistoryUpdateWorkflowExecutionRequest{
+				DomainUUID: constants.TestDomainID,
+				UpdateRequest: &types.UpdateWorkflowExecutionRequest{
+					Domain:            constants.TestDomainName,
+					WorkflowExecution: execution,
+					UpdateID:          "update-1",
+					UpdateName:        "approve",
+					WaitForStage:      tc.waitForStage,
+				},
+			})
+			eft.Engine.Stop()
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedResp, resp)
+			require.Equal(t, tc.expectUpdate, mutation != nil)
+			if mutation != nil {
+				assert.Equal(t, int64(3), mutation.ExecutionInfo.DecisionScheduleID)
+			}
+		})
+	}
+}
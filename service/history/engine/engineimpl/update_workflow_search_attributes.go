@@ -0,0 +1,90 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2021 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/elasticsearch/validator"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// UpdateWorkflowSearchAttributes upserts the search attributes of a workflow from outside of the workflow.
+// The change is recorded as a WorkflowExecutionSearchAttributesUpdated event, so it is replicated like any
+// other event and does not interfere with the decisions of the workflow during replay.
+func (e *historyEngineImpl) UpdateWorkflowSearchAttributes(
+	ctx context.Context,
+	request *types.UpdateWorkflowSearchAttributesRequest,
+) error {
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      request.GetWorkflowExecution().GetRunID(),
+	}
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, request.GetDomainUUID(), workflowExecution.WorkflowID, workflowExecution.RunID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	if len(request.GetSearchAttributes().GetIndexedFields()) == 0 {
+		return &types.BadRequestError{Message: "SearchAttributes is not set on request."}
+	}
+	searchAttributesValidator := validator.NewSearchAttributesValidator(
+		e.logger,
+		e.config.EnableQueryAttributeValidation,
+		e.config.ValidSearchAttributes,
+		e.config.SearchAttributesNumberOfKeysLimit,
+		e.config.SearchAttributesSizeOfValueLimit,
+		e.config.SearchAttributesTotalSizeLimit,
+	)
+	if err := searchAttributesValidator.ValidateSearchAttributes(request.GetSearchAttributes(), domainEntry.GetInfo().Name); err != nil {
+		return err
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.logger,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionSearchAttributesUpdatedEvent(
+				request.GetSearchAttributes(),
+				request.GetReason(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to update workflow search attributes."}
+			}
+
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
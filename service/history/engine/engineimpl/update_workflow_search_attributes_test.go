@@ -0,0 +1,199 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+)
+
+func TestUpdateWorkflowSearchAttributes(t *testing.T) {
+	workflowExecution := &types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID}
+	searchAttributes := &types.SearchAttributes{
+		IndexedFields: map[string][]byte{
+			"CustomKeywordField": []byte(`"keyword"`),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		request    types.UpdateWorkflowSearchAttributesRequest
+		setupMocks func(*testing.T, *testdata.EngineForTest)
+		wantErr    bool
+	}{
+		{
+			name: "domain is not active",
+			request: types.UpdateWorkflowSearchAttributesRequest{
+				DomainUUID:        constants.TestDomainID,
+				WorkflowExecution: workflowExecution,
+				SearchAttributes:  searchAttributes,
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID).Return(&types.ActiveClusterInfo{ActiveClusterName: "aaa"}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "search attributes are not set",
+			request: types.UpdateWorkflowSearchAttributesRequest{
+				DomainUUID:        constants.TestDomainID,
+				WorkflowExecution: workflowExecution,
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID).Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid search attribute key",
+			request: types.UpdateWorkflowSearchAttributesRequest{
+				DomainUUID:        constants.TestDomainID,
+				WorkflowExecution: workflowExecution,
+				SearchAttributes: &types.SearchAttributes{
+					IndexedFields: map[string][]byte{
+						"UnknownField": []byte(`"keyword"`),
+					},
+				},
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID).Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "successful update of a running workflow",
+			request: types.UpdateWorkflowSearchAttributesRequest{
+				DomainUUID:        constants.TestDomainID,
+				WorkflowExecution: workflowExecution,
+				SearchAttributes:  searchAttributes,
+				Reason:            "test reason",
+				Identity:          "testRunner",
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID).Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil).AnyTimes()
+
+				getExecReq := &persistence.GetWorkflowExecutionRequest{
+					DomainID:   constants.TestDomainID,
+					Execution:  types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID},
+					DomainName: constants.TestDomainName,
+					RangeID:    1,
+				}
+				getExecResp := &persistence.GetWorkflowExecutionResponse{
+					State: &persistence.WorkflowMutableState{
+						ExecutionInfo: &persistence.WorkflowExecutionInfo{
+							DomainID:   constants.TestDomainID,
+							WorkflowID: constants.TestWorkflowID,
+							RunID:      constants.TestRunID,
+						},
+						ExecutionStats: &persistence.ExecutionStats{},
+					},
+					MutableStateStats: &persistence.MutableStateStats{},
+				}
+				eft.ShardCtx.Resource.ExecutionMgr.
+					On("GetWorkflowExecution", mock.Anything, getExecReq).
+					Return(getExecResp, nil).
+					Once()
+
+				eft.ShardCtx.Resource.ExecutionMgr.
+					On("UpdateWorkflowExecution", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						request, ok := args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+						if !ok {
+							t.Fatalf("failed to cast input to *persistence.UpdateWorkflowExecutionRequest, type is %T", args.Get(1))
+						}
+						assert.Equal(t, searchAttributes.IndexedFields, request.UpdateWorkflowMutation.ExecutionInfo.SearchAttributes)
+					}).
+					Return(&persistence.UpdateWorkflowExecutionResponse{
+						MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+					}, nil).
+					Once()
+
+				eft.ShardCtx.Resource.HistoryMgr.
+					On("AppendHistoryNodes", mock.Anything, mock.AnythingOfType("*persistence.AppendHistoryNodesRequest")).
+					Return(&persistence.AppendHistoryNodesResponse{}, nil).
+					Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "workflow already completed",
+			request: types.UpdateWorkflowSearchAttributesRequest{
+				DomainUUID:        constants.TestDomainID,
+				WorkflowExecution: workflowExecution,
+				SearchAttributes:  searchAttributes,
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID).Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil).AnyTimes()
+
+				getExecReq := &persistence.GetWorkflowExecutionRequest{
+					DomainID:   constants.TestDomainID,
+					Execution:  types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID},
+					DomainName: constants.TestDomainName,
+					RangeID:    1,
+				}
+				getExecResp := &persistence.GetWorkflowExecutionResponse{
+					State: &persistence.WorkflowMutableState{
+						ExecutionInfo: &persistence.WorkflowExecutionInfo{
+							DomainID:   constants.TestDomainID,
+							WorkflowID: constants.TestWorkflowID,
+							RunID:      constants.TestRunID,
+							State:      persistence.WorkflowStateCompleted,
+						},
+						ExecutionStats: &persistence.ExecutionStats{},
+					},
+					MutableStateStats: &persistence.MutableStateStats{},
+				}
+				eft.ShardCtx.Resource.ExecutionMgr.
+					On("GetWorkflowExecution", mock.Anything, getExecReq).
+					Return(getExecResp, nil).
+					Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+			eft.Engine.Start()
+			defer eft.Engine.Stop()
+
+			tc.setupMocks(t, eft)
+
+			err := eft.Engine.UpdateWorkflowSearchAttributes(context.Background(), &tc.request)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("UpdateWorkflowSearchAttributes() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
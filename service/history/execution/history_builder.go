@@ -67,9 +67,18 @@ func (b *HistoryBuilder) AddWorkflowExecutionStartedEvent(
 
 	var prevRunID string
 	var resetPoints *types.ResetPoints
+	var pauseInfo *types.PauseInfo
 	if previousExecution != nil {
 		prevRunID = previousExecution.RunID
 		resetPoints = previousExecution.AutoResetPoints
+		if previousExecution.Paused {
+			// an operator pause outlives continue-as-new, only an explicit unpause lifts it
+			pauseInfo = &types.PauseInfo{
+				Reason:          previousExecution.PauseReason,
+				Identity:        previousExecution.PauseIdentity,
+				PausedTimestamp: common.Int64Ptr(previousExecution.PausedTimestamp.UnixNano()),
+			}
+		}
 	}
 
 	request := startRequest.StartRequest
@@ -109,6 +118,8 @@ func (b *HistoryBuilder) AddWorkflowExecutionStartedEvent(
 		PartitionConfig:                     startRequest.PartitionConfig,
 		RequestID:                           request.RequestID,
 		ActiveClusterSelectionPolicy:        request.ActiveClusterSelectionPolicy,
+		PauseInfo:                           pauseInfo,
+		TaskPriority:                        request.TaskPriority,
 	}
 	if parentInfo := startRequest.ParentExecutionInfo; parentInfo != nil {
 		attributes.ParentWorkflowDomainID = &parentInfo.DomainUUID
@@ -234,6 +245,7 @@ func (b *HistoryBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID
 		DecisionTaskCompletedEventID:  decisionCompletedEventID,
 		RetryPolicy:                   attributes.RetryPolicy,
 		Domain:                        domain,
+		TaskPriority:                  attributes.TaskPriority,
 	}
 
 	return b.addEventToHistory(event)
@@ -613,6 +625,132 @@ func (b *HistoryBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowExecutionSearchAttributesUpdatedEvent adds WorkflowExecutionSearchAttributesUpdated event to history
+func (b *HistoryBuilder) AddWorkflowExecutionSearchAttributesUpdatedEvent(
+	searchAttributes *types.SearchAttributes,
+	reason string,
+	identity string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionSearchAttributesUpdated)
+	event.WorkflowExecutionSearchAttributesUpdatedEventAttributes = &types.WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+		SearchAttributes: searchAttributes,
+		Reason:           reason,
+		Identity:         identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionPausedEvent adds WorkflowExecutionPaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionPaused)
+	event.WorkflowExecutionPausedEventAttributes = &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   reason,
+		Identity: identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUnpausedEvent adds WorkflowExecutionUnpaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUnpaused)
+	event.WorkflowExecutionUnpausedEventAttributes = &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   reason,
+		Identity: identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddActivityTaskPausedEvent adds ActivityTaskPaused event to history
+func (b *HistoryBuilder) AddActivityTaskPausedEvent(
+	scheduleEventID int64,
+	reason string,
+	identity string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeActivityTaskPaused)
+	event.ActivityTaskPausedEventAttributes = &types.ActivityTaskPausedEventAttributes{
+		ScheduledEventID: scheduleEventID,
+		Reason:           reason,
+		Identity:         identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddActivityTaskUnpausedEvent adds ActivityTaskUnpaused event to history
+func (b *HistoryBuilder) AddActivityTaskUnpausedEvent(
+	scheduleEventID int64,
+	reason string,
+	identity string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeActivityTaskUnpaused)
+	event.ActivityTaskUnpausedEventAttributes = &types.ActivityTaskUnpausedEventAttributes{
+		ScheduledEventID: scheduleEventID,
+		Reason:           reason,
+		Identity:         identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent adds WorkflowExecutionUpdateAccepted event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	decisionCompletedEventID int64,
+	attributes *types.AcceptWorkflowUpdateDecisionAttributes,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateAccepted)
+	event.WorkflowExecutionUpdateAcceptedEventAttributes = &types.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateID:                     attributes.UpdateID,
+		UpdateName:                   attributes.UpdateName,
+		Input:                        attributes.Input,
+		DecisionTaskCompletedEventID: decisionCompletedEventID,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateRejectedEvent adds WorkflowExecutionUpdateRejected event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateRejectedEvent(
+	decisionCompletedEventID int64,
+	attributes *types.RejectWorkflowUpdateDecisionAttributes,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateRejected)
+	event.WorkflowExecutionUpdateRejectedEventAttributes = &types.WorkflowExecutionUpdateRejectedEventAttributes{
+		UpdateID:                     attributes.UpdateID,
+		UpdateName:                   attributes.UpdateName,
+		Reason:                       attributes.Reason,
+		Details:                      attributes.Details,
+		DecisionTaskCompletedEventID: decisionCompletedEventID,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent adds WorkflowExecutionUpdateCompleted event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	attributes *types.CompleteWorkflowUpdateDecisionAttributes,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUpdateCompleted)
+	event.WorkflowExecutionUpdateCompletedEventAttributes = &types.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateID:                     attributes.UpdateID,
+		Result:                       attributes.Result,
+		FailureReason:                attributes.FailureReason,
+		FailureDetails:               attributes.FailureDetails,
+		DecisionTaskCompletedEventID: decisionCompletedEventID,
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddSignalExternalWorkflowExecutionFailedEvent adds SignalExternalWorkflowExecutionFailed event to history
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause types.SignalExternalWorkflowExecutionFailedCause) *types.HistoryEvent {
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/query"
+	"github.com/uber/cadence/service/history/update"
 )
 
 type (
@@ -61,9 +62,11 @@ type (
 		AddActivityTaskCanceledEvent(int64, int64, int64, []uint8, string) (*types.HistoryEvent, error)
 		AddActivityTaskCompletedEvent(int64, int64, *types.RespondActivityTaskCompletedRequest) (*types.HistoryEvent, error)
 		AddActivityTaskFailedEvent(int64, int64, *types.RespondActivityTaskFailedRequest) (*types.HistoryEvent, error)
+		AddActivityTaskPausedEvent(int64, string, string) (*types.HistoryEvent, error)
 		AddActivityTaskScheduledEvent(context.Context, int64, *types.ScheduleActivityTaskDecisionAttributes, bool) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error)
 		AddActivityTaskStartedEvent(*persistence.ActivityInfo, int64, string, string) (*types.HistoryEvent, error)
 		AddActivityTaskTimedOutEvent(int64, int64, types.TimeoutType, []uint8) (*types.HistoryEvent, error)
+		AddActivityTaskUnpausedEvent(int64, string, string) (*types.HistoryEvent, error)
 		AddCancelTimerFailedEvent(int64, *types.CancelTimerDecisionAttributes, string) (*types.HistoryEvent, error)
 		AddChildWorkflowExecutionCanceledEvent(int64, *types.WorkflowExecution, *types.WorkflowExecutionCanceledEventAttributes) (*types.HistoryEvent, error)
 		AddChildWorkflowExecutionCompletedEvent(int64, *types.WorkflowExecution, *types.WorkflowExecutionCompletedEventAttributes) (*types.HistoryEvent, error)
@@ -101,9 +104,15 @@ type (
 		AddUpsertWorkflowSearchAttributesEvent(int64, *types.UpsertWorkflowSearchAttributesDecisionAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(string, string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionSearchAttributesUpdatedEvent(*types.SearchAttributes, string, string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string, reqeustID string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(types.WorkflowExecution, *types.HistoryStartWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUnpausedEvent(string, string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUpdateAcceptedEvent(int64, *types.AcceptWorkflowUpdateDecisionAttributes) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUpdateCompletedEvent(int64, *types.CompleteWorkflowUpdateDecisionAttributes) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUpdateRejectedEvent(int64, *types.RejectWorkflowUpdateDecisionAttributes) (*types.HistoryEvent, error)
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
@@ -154,6 +163,7 @@ type (
 		GetWorkflowStateCloseStatus() (int, int)
 		GetQueryRegistry() query.Registry
 		SetQueryRegistry(query.Registry)
+		GetUpdateRegistry() update.Registry
 		HasBufferedEvents() bool
 		HasInFlightDecision() bool
 		HasParentExecution() bool
@@ -161,9 +171,11 @@ type (
 		HasProcessedOrPendingDecision() bool
 		IsCancelRequested() (bool, string)
 		IsCurrentWorkflowGuaranteed() bool
+		IsRestored() bool
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
 		IsWorkflowExecutionRunning() bool
+		IsWorkflowUpdateAccepted(updateID string) bool
 		IsWorkflowCompleted() bool
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
@@ -173,9 +185,11 @@ type (
 		ReplicateActivityTaskCanceledEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskCompletedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskFailedEvent(*types.HistoryEvent) error
+		ReplicateActivityTaskPausedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskScheduledEvent(int64, *types.HistoryEvent, bool) (*persistence.ActivityInfo, error)
 		ReplicateActivityTaskStartedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskTimedOutEvent(*types.HistoryEvent) error
+		ReplicateActivityTaskUnpausedEvent(*types.HistoryEvent) error
 		ReplicateChildWorkflowExecutionCanceledEvent(*types.HistoryEvent) error
 		ReplicateChildWorkflowExecutionCompletedEvent(*types.HistoryEvent) error
 		ReplicateChildWorkflowExecutionFailedEvent(*types.HistoryEvent) error
@@ -205,13 +219,19 @@ type (
 		ReplicateWorkflowExecutionCompletedEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionContinuedAsNewEvent(int64, string, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionFailedEvent(int64, *types.HistoryEvent) error
+		ReplicateWorkflowExecutionPausedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionSearchAttributesUpdatedEvent(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionSignaled(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionStartedEvent(*string, types.WorkflowExecution, string, *types.HistoryEvent, bool) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *types.HistoryEvent) error
+		ReplicateWorkflowExecutionUnpausedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateAcceptedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateCompletedEvent(*types.HistoryEvent) error
 		SetCurrentBranchToken(branchToken []byte) error
 		SetHistoryBuilder(hBuilder *HistoryBuilder)
 		SetHistoryTree(treeID string) error
+		SetRestored()
 		SetVersionHistories(*persistence.VersionHistories) error
 		UpdateActivity(*persistence.ActivityInfo) error
 		UpdateActivityProgress(ai *persistence.ActivityInfo, request *types.RecordActivityTaskHeartbeatRequest)
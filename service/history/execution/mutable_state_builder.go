@@ -48,6 +48,7 @@ import (
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/update"
 )
 
 const (
@@ -137,6 +138,8 @@ type (
 		stateInDB int
 		// indicates the next event ID in DB, for conditional update
 		nextEventIDInDB int64
+		// indicates the events being applied are restored from the history archival
+		restored bool
 		// domain entry contains a snapshot of domain
 		// NOTE: do not use the failover version inside, use currentVersion above
 		domainEntry *cache.DomainCacheEntry
@@ -159,6 +162,7 @@ type (
 		taskGenerator       MutableStateTaskGenerator
 		decisionTaskManager mutableStateDecisionTaskManager
 		queryRegistry       query.Registry
+		updateRegistry      update.Registry
 
 		shard                      shard.Context
 		clusterMetadata            cluster.Metadata
@@ -225,7 +229,8 @@ func newMutableStateBuilder(
 		domainEntry:           domainEntry,
 		appliedEvents:         make(map[string]struct{}),
 
-		queryRegistry: query.NewRegistry(),
+		queryRegistry:  query.NewRegistry(),
+		updateRegistry: update.NewRegistry(),
 
 		shard:           shard,
 		clusterMetadata: shard.GetClusterMetadata(),
@@ -795,6 +800,15 @@ func (e *mutableStateBuilder) assignTaskIDToEvents() error {
 	return nil
 }
 
+// SetRestored marks the events applied to the mutable state as restored from the history archival
+func (e *mutableStateBuilder) SetRestored() {
+	e.restored = true
+}
+
+func (e *mutableStateBuilder) IsRestored() bool {
+	return e.restored
+}
+
 func (e *mutableStateBuilder) IsCurrentWorkflowGuaranteed() bool {
 	// stateInDB is used like a bloom filter:
 	//
@@ -904,7 +918,10 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		types.EventTypeMarkerRecorded,
 		types.EventTypeStartChildWorkflowExecutionInitiated,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated,
-		types.EventTypeUpsertWorkflowSearchAttributes:
+		types.EventTypeUpsertWorkflowSearchAttributes,
+		types.EventTypeWorkflowExecutionUpdateAccepted,
+		types.EventTypeWorkflowExecutionUpdateRejected,
+		types.EventTypeWorkflowExecutionUpdateCompleted:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -934,6 +951,10 @@ func (e *mutableStateBuilder) SetQueryRegistry(queryRegistry query.Registry) {
 	e.queryRegistry = queryRegistry
 }
 
+func (e *mutableStateBuilder) GetUpdateRegistry() update.Registry {
+	return e.updateRegistry
+}
+
 func (e *mutableStateBuilder) GetRetryBackoffDuration(
 	errReason string,
 ) time.Duration {
@@ -1147,6 +1168,36 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionSearchAttributesUpdatedEvent(
+	searchAttributes *types.SearchAttributes,
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowSearchAttributesUpdated
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionSearchAttributesUpdatedEvent(searchAttributes, reason, identity)
+	if err := e.ReplicateWorkflowExecutionSearchAttributesUpdatedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionSearchAttributesUpdatedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	updatedSearchAttr := event.WorkflowExecutionSearchAttributesUpdatedEventAttributes.GetSearchAttributes().GetIndexedFields()
+	currentSearchAttr := e.GetExecutionInfo().SearchAttributes
+
+	e.executionInfo.SearchAttributes = mergeMapOfByteArray(currentSearchAttr, updatedSearchAttr)
+
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+}
+
 func (e *mutableStateBuilder) AddRecordMarkerEvent(
 	decisionCompletedEventID int64,
 	attributes *types.RecordMarkerDecisionAttributes,
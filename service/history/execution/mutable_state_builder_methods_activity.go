@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -309,6 +310,7 @@ func (e *mutableStateBuilder) tryDispatchActivityTask(
 			ScheduledTimestampOfThisAttempt: common.Int64Ptr(ai.ScheduledTime.UnixNano()),
 		},
 		PartitionConfig: e.executionInfo.PartitionConfig,
+		TaskPriority:    taskpriority.ToTaskPriority(ai.TaskPriority),
 	})
 	if err == nil {
 		taggedScope.IncCounter(metrics.DecisionTypeScheduleActivityDispatchSucceedCounter)
@@ -355,6 +357,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		TimerTaskStatus:          TimerTaskStatusNone,
 		TaskList:                 attributes.TaskList.GetName(),
 		TaskListKind:             attributes.TaskList.GetKind(),
+		TaskPriority:             taskpriority.FromTaskPriority(attributes.TaskPriority),
 		HasRetryPolicy:           attributes.RetryPolicy != nil,
 	}
 
@@ -206,6 +206,64 @@ func Test__AddActivityTaskScheduledEvent(t *testing.T) {
 				NonRetriableErrors:     retryPolicy.NonRetriableErrorReasons,
 			},
 		},
+		{
+			name:             "success - activity with priority",
+			workflowTaskList: &types.TaskList{Name: "taskList", Kind: types.TaskListKindNormal.Ptr()},
+			attr: &types.ScheduleActivityTaskDecisionAttributes{
+				ActivityID:                    "activityID",
+				ActivityType:                  activityType,
+				Domain:                        constants.TestDomainName,
+				TaskList:                      &types.TaskList{Name: "taskList"},
+				Input:                         []byte("input"),
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(1),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(2),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(3),
+				HeartbeatTimeoutSeconds:       common.Int32Ptr(4),
+				RetryPolicy:                   retryPolicy,
+				Header:                        header,
+				RequestLocalDispatch:          false,
+				TaskPriority:                  types.TaskPriorityHigh.Ptr(),
+			},
+			expectedAttributes: &types.ActivityTaskScheduledEventAttributes{
+				ActivityID:                    "activityID",
+				ActivityType:                  activityType,
+				Domain:                        &constants.TestDomainName,
+				TaskList:                      &types.TaskList{Name: "taskList"},
+				Input:                         []byte("input"),
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(1),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(2),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(3),
+				HeartbeatTimeoutSeconds:       common.Int32Ptr(4),
+				DecisionTaskCompletedEventID:  0,
+				RetryPolicy:                   retryPolicy,
+				Header:                        header,
+				TaskPriority:                  types.TaskPriorityHigh.Ptr(),
+			},
+			expectedInfo: &persistence.ActivityInfo{
+				Version:                commonconstants.EmptyVersion,
+				ScheduleID:             1,
+				ScheduledEventBatchID:  0,
+				ScheduledTime:          currentTime,
+				StartedID:              commonconstants.EmptyEventID,
+				DomainID:               constants.TestDomainID,
+				ActivityID:             "activityID",
+				ScheduleToCloseTimeout: 1,
+				ScheduleToStartTimeout: 2,
+				StartToCloseTimeout:    3,
+				HeartbeatTimeout:       4,
+				CancelRequestID:        commonconstants.EmptyEventID,
+				TaskList:               "taskList",
+				TaskListKind:           types.TaskListKindNormal,
+				TaskPriority:           "high",
+				HasRetryPolicy:         true,
+				InitialInterval:        retryPolicy.InitialIntervalInSeconds,
+				BackoffCoefficient:     retryPolicy.BackoffCoefficient,
+				MaximumInterval:        retryPolicy.MaximumIntervalInSeconds,
+				ExpirationTime:         currentTime.Add(time.Duration(retryPolicy.ExpirationIntervalInSeconds) * time.Second),
+				MaximumAttempts:        retryPolicy.MaximumAttempts,
+				NonRetriableErrors:     retryPolicy.NonRetriableErrorReasons,
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,198 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if e.executionInfo.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(e.executionInfo.Paused))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.WorkflowExecutionPausedEventAttributes
+	e.executionInfo.Paused = true
+	e.executionInfo.PauseReason = attributes.GetReason()
+	e.executionInfo.PauseIdentity = attributes.GetIdentity()
+	e.executionInfo.PausedTimestamp = e.unixNanoToTime(event.GetTimestamp())
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if !e.executionInfo.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(e.executionInfo.Paused))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUnpausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUnpausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = false
+	e.executionInfo.PauseReason = ""
+	e.executionInfo.PauseIdentity = ""
+	e.executionInfo.PausedTimestamp = time.Time{}
+	return nil
+}
+
+func (e *mutableStateBuilder) AddActivityTaskPausedEvent(
+	scheduleEventID int64,
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionActivityTaskPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(ok),
+			tag.WorkflowScheduleID(scheduleEventID))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddActivityTaskPausedEvent(scheduleEventID, reason, identity)
+	if err := e.ReplicateActivityTaskPausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateActivityTaskPausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.ActivityTaskPausedEventAttributes
+	ai, ok := e.GetActivityInfo(attributes.GetScheduledEventID())
+	if !ok {
+		// the activity may have completed on the active side before this event was replicated
+		return nil
+	}
+
+	ai.Version = event.Version
+	ai.Paused = true
+	ai.PauseReason = attributes.GetReason()
+	ai.PauseIdentity = attributes.GetIdentity()
+	ai.PausedTimestamp = e.unixNanoToTime(event.GetTimestamp())
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	return nil
+}
+
+func (e *mutableStateBuilder) AddActivityTaskUnpausedEvent(
+	scheduleEventID int64,
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionActivityTaskUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || !ai.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(ok),
+			tag.WorkflowScheduleID(scheduleEventID))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddActivityTaskUnpausedEvent(scheduleEventID, reason, identity)
+	if err := e.ReplicateActivityTaskUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateActivityTaskUnpausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.ActivityTaskUnpausedEventAttributes
+	ai, ok := e.GetActivityInfo(attributes.GetScheduledEventID())
+	if !ok {
+		return nil
+	}
+
+	ai.Version = event.Version
+	ai.Paused = false
+	ai.PauseReason = ""
+	ai.PauseIdentity = ""
+	ai.PausedTimestamp = time.Time{}
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	return nil
+}
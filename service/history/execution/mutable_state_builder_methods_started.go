@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -82,6 +83,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		JitterStartSeconds:                  attributes.JitterStartSeconds,
 		CronOverlapPolicy:                   attributes.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:        attributes.ActiveClusterSelectionPolicy,
+		TaskPriority:                        taskpriority.ToTaskPriority(previousExecutionInfo.TaskPriority),
 	}
 
 	req := &types.HistoryStartWorkflowExecutionRequest{
@@ -211,6 +213,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	}
 	e.executionInfo.TaskList = event.TaskList.GetName()
 	e.executionInfo.TaskListKind = event.TaskList.GetKind()
+	e.executionInfo.TaskPriority = taskpriority.FromTaskPriority(event.TaskPriority)
 	e.executionInfo.WorkflowTypeName = event.WorkflowType.GetName()
 	e.executionInfo.WorkflowTimeout = event.GetExecutionStartToCloseTimeoutSeconds()
 	e.executionInfo.DecisionStartToCloseTimeout = event.GetTaskStartToCloseTimeoutSeconds()
@@ -282,6 +285,12 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	}
 	e.executionInfo.PartitionConfig = event.PartitionConfig
 	e.executionInfo.ActiveClusterSelectionPolicy = event.ActiveClusterSelectionPolicy
+	if pauseInfo := event.PauseInfo; pauseInfo != nil {
+		e.executionInfo.Paused = true
+		e.executionInfo.PauseReason = pauseInfo.GetReason()
+		e.executionInfo.PauseIdentity = pauseInfo.GetIdentity()
+		e.executionInfo.PausedTimestamp = e.unixNanoToTime(pauseInfo.GetPausedTimestamp())
+	}
 
 	e.writeEventToCache(startEvent)
 
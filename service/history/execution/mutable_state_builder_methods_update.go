@@ -0,0 +1,137 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+// The workflow update events record the decider's answer to an update. The mutable state keeps the
+// IDs of the accepted updates until they are completed, so a decision can only complete an update that
+// was accepted before, on any host and after a failover. Rejected and completed updates leave no state.
+
+// IsWorkflowUpdateAccepted returns true if the decider accepted the update and has not completed it yet
+func (e *mutableStateBuilder) IsWorkflowUpdateAccepted(updateID string) bool {
+	for _, id := range e.executionInfo.AcceptedUpdateIDs {
+		if id == updateID {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	decisionCompletedEventID int64,
+	attributes *types.AcceptWorkflowUpdateDecisionAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateAccepted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if e.IsWorkflowUpdateAccepted(attributes.GetUpdateID()) {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Dynamic("update-id", attributes.GetUpdateID()))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID, attributes)
+	if err := e.ReplicateWorkflowExecutionUpdateAcceptedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateAcceptedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	updateID := event.WorkflowExecutionUpdateAcceptedEventAttributes.GetUpdateID()
+	e.executionInfo.AcceptedUpdateIDs = append(e.executionInfo.AcceptedUpdateIDs, updateID)
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateRejectedEvent(
+	decisionCompletedEventID int64,
+	attributes *types.RejectWorkflowUpdateDecisionAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateRejected
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if e.IsWorkflowUpdateAccepted(attributes.GetUpdateID()) {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Dynamic("update-id", attributes.GetUpdateID()))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	return e.hBuilder.AddWorkflowExecutionUpdateRejectedEvent(decisionCompletedEventID, attributes), nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	attributes *types.CompleteWorkflowUpdateDecisionAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateCompleted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if !e.IsWorkflowUpdateAccepted(attributes.GetUpdateID()) {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Dynamic("update-id", attributes.GetUpdateID()))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, attributes)
+	if err := e.ReplicateWorkflowExecutionUpdateCompletedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateCompletedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	updateID := event.WorkflowExecutionUpdateCompletedEventAttributes.GetUpdateID()
+	var accepted []string
+	for _, id := range e.executionInfo.AcceptedUpdateIDs {
+		if id != updateID {
+			accepted = append(accepted, id)
+		}
+	}
+	e.executionInfo.AcceptedUpdateIDs = accepted
+	return nil
+}
@@ -0,0 +1,86 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func Test__WorkflowUpdateEvents(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.executionInfo.State = persistence.WorkflowStateRunning
+
+	assert.False(t, mb.IsWorkflowUpdateAccepted("update-1"))
+
+	// an update is only completed once accepted
+	_, err := mb.AddWorkflowExecutionUpdateCompletedEvent(1, &types.CompleteWorkflowUpdateDecisionAttributes{UpdateID: "update-1"})
+	assert.Error(t, err)
+
+	_, err = mb.AddWorkflowExecutionUpdateAcceptedEvent(1, &types.AcceptWorkflowUpdateDecisionAttributes{UpdateID: "update-1", UpdateName: "approve"})
+	require.NoError(t, err)
+	_, err = mb.AddWorkflowExecutionUpdateAcceptedEvent(1, &types.AcceptWorkflowUpdateDecisionAttributes{UpdateID: "update-2", UpdateName: "approve"})
+	require.NoError(t, err)
+	assert.True(t, mb.IsWorkflowUpdateAccepted("update-1"))
+	assert.Equal(t, []string{"update-1", "update-2"}, mb.executionInfo.AcceptedUpdateIDs)
+
+	// an accepted update can not be accepted or rejected again
+	_, err = mb.AddWorkflowExecutionUpdateAcceptedEvent(1, &types.AcceptWorkflowUpdateDecisionAttributes{UpdateID: "update-1", UpdateName: "approve"})
+	assert.Error(t, err)
+	_, err = mb.AddWorkflowExecutionUpdateRejectedEvent(1, &types.RejectWorkflowUpdateDecisionAttributes{UpdateID: "update-1", UpdateName: "approve"})
+	assert.Error(t, err)
+
+	_, err = mb.AddWorkflowExecutionUpdateCompletedEvent(1, &types.CompleteWorkflowUpdateDecisionAttributes{UpdateID: "update-1"})
+	require.NoError(t, err)
+	assert.False(t, mb.IsWorkflowUpdateAccepted("update-1"))
+	assert.Equal(t, []string{"update-2"}, mb.executionInfo.AcceptedUpdateIDs)
+
+	// a completed update can not be completed again
+	_, err = mb.AddWorkflowExecutionUpdateCompletedEvent(1, &types.CompleteWorkflowUpdateDecisionAttributes{UpdateID: "update-1"})
+	assert.Error(t, err)
+
+	_, err = mb.AddWorkflowExecutionUpdateRejectedEvent(1, &types.RejectWorkflowUpdateDecisionAttributes{UpdateID: "update-3", UpdateName: "approve"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"update-2"}, mb.executionInfo.AcceptedUpdateIDs)
+}
+
+func Test__ReplicateWorkflowUpdateEvents(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+
+	err := mb.ReplicateWorkflowExecutionUpdateAcceptedEvent(&types.HistoryEvent{
+		WorkflowExecutionUpdateAcceptedEventAttributes: &types.WorkflowExecutionUpdateAcceptedEventAttributes{UpdateID: "update-1"},
+	})
+	require.NoError(t, err)
+	assert.True(t, mb.IsWorkflowUpdateAccepted("update-1"))
+
+	err = mb.ReplicateWorkflowExecutionUpdateCompletedEvent(&types.HistoryEvent{
+		WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{UpdateID: "update-1"},
+	})
+	require.NoError(t, err)
+	assert.False(t, mb.IsWorkflowUpdateAccepted("update-1"))
+	assert.Nil(t, mb.executionInfo.AcceptedUpdateIDs)
+}
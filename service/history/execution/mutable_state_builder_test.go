@@ -244,6 +244,9 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		types.EventTypeStartChildWorkflowExecutionInitiated:            true,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated:        true,
 		types.EventTypeUpsertWorkflowSearchAttributes:                  true,
+		types.EventTypeWorkflowExecutionUpdateAccepted:                 true,
+		types.EventTypeWorkflowExecutionUpdateRejected:                 true,
+		types.EventTypeWorkflowExecutionUpdateCompleted:                true,
 	}
 
 	// other events will not be assign event ID immediately
@@ -3438,6 +3441,89 @@ func TestAddUpsertWorkflowSearchAttributesEvent(t *testing.T) {
 	}
 }
 
+func TestAddWorkflowExecutionSearchAttributesUpdatedEvent(t *testing.T) {
+
+	now := time.Unix(1730353941, 0)
+
+	searchAttributes := &types.SearchAttributes{
+		IndexedFields: map[string][]byte{
+			"CustomKeywordField": []byte("keyword"),
+		},
+	}
+
+	tests := map[string]struct {
+		mutableStateBuilderSetup func(m *mutableStateBuilder)
+		expectedEvent            *types.HistoryEvent
+		expectedSearchAttributes map[string][]byte
+		expectedErr              error
+	}{
+		"successful update": {
+			mutableStateBuilderSetup: func(m *mutableStateBuilder) {
+				m.executionInfo.SearchAttributes = map[string][]byte{
+					"CustomKeywordField": []byte("old"),
+					"CustomIntField":     []byte("1"),
+				}
+			},
+			expectedEvent: &types.HistoryEvent{
+				ID:        commonconstants.BufferedEventID,
+				EventType: types.EventTypeWorkflowExecutionSearchAttributesUpdated.Ptr(),
+				WorkflowExecutionSearchAttributesUpdatedEventAttributes: &types.WorkflowExecutionSearchAttributesUpdatedEventAttributes{
+					SearchAttributes: searchAttributes,
+					Reason:           "reason",
+					Identity:         "identity",
+				},
+				TaskID:    commonconstants.EmptyEventTaskID,
+				Version:   commonconstants.EmptyVersion,
+				Timestamp: common.Ptr(now.UnixNano()),
+			},
+			expectedSearchAttributes: map[string][]byte{
+				"CustomKeywordField": []byte("keyword"),
+				"CustomIntField":     []byte("1"),
+			},
+		},
+		"mutability check fails": {
+			mutableStateBuilderSetup: func(m *mutableStateBuilder) {
+				m.executionInfo.State = persistence.WorkflowStateCompleted
+			},
+			expectedEvent: nil,
+			expectedErr:   &types.InternalServiceError{Message: "invalid mutable state action: mutation after finish"},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+
+			shardContext := shard.NewMockContext(ctrl)
+			shardContext.EXPECT().GetLogger().Return(testlogger.New(t)).AnyTimes()
+			mockCache := events.NewMockCache(ctrl)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+
+			nowClock := clock.NewMockedTimeSourceAt(now)
+
+			msb := createMSBWithMocks(mockCache, shardContext, mockDomainCache, nil)
+
+			msb.hBuilder = &HistoryBuilder{
+				history:   []*types.HistoryEvent{},
+				msBuilder: msb,
+			}
+
+			td.mutableStateBuilderSetup(msb)
+
+			msb.timeSource = nowClock
+
+			event, err := msb.AddWorkflowExecutionSearchAttributesUpdatedEvent(searchAttributes, "reason", "identity")
+
+			assert.Equal(t, td.expectedEvent, event)
+			assert.Equal(t, td.expectedErr, err)
+			if td.expectedSearchAttributes != nil {
+				assert.Equal(t, td.expectedSearchAttributes, msb.executionInfo.SearchAttributes)
+			}
+		})
+	}
+}
+
 func TestCloseTransactionAsMutation(t *testing.T) {
 
 	now := time.Unix(500, 0)
@@ -21,6 +21,7 @@ import (
 	persistence "github.com/uber/cadence/common/persistence"
 	types "github.com/uber/cadence/common/types"
 	query "github.com/uber/cadence/service/history/query"
+	update "github.com/uber/cadence/service/history/update"
 )
 
 // MockMutableState is a mock of MutableState interface.
@@ -108,6 +109,21 @@ func (mr *MockMutableStateMockRecorder) AddActivityTaskFailedEvent(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskFailedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskFailedEvent), arg0, arg1, arg2)
 }
 
+// AddActivityTaskPausedEvent mocks base method.
+func (m *MockMutableState) AddActivityTaskPausedEvent(arg0 int64, arg1, arg2 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivityTaskPausedEvent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivityTaskPausedEvent indicates an expected call of AddActivityTaskPausedEvent.
+func (mr *MockMutableStateMockRecorder) AddActivityTaskPausedEvent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskPausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskPausedEvent), arg0, arg1, arg2)
+}
+
 // AddActivityTaskScheduledEvent mocks base method.
 func (m *MockMutableState) AddActivityTaskScheduledEvent(arg0 context.Context, arg1 int64, arg2 *types.ScheduleActivityTaskDecisionAttributes, arg3 bool) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, bool, bool, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +173,21 @@ func (mr *MockMutableStateMockRecorder) AddActivityTaskTimedOutEvent(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskTimedOutEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskTimedOutEvent), arg0, arg1, arg2, arg3)
 }
 
+// AddActivityTaskUnpausedEvent mocks base method.
+func (m *MockMutableState) AddActivityTaskUnpausedEvent(arg0 int64, arg1, arg2 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivityTaskUnpausedEvent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivityTaskUnpausedEvent indicates an expected call of AddActivityTaskUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) AddActivityTaskUnpausedEvent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskUnpausedEvent), arg0, arg1, arg2)
+}
+
 // AddCancelTimerFailedEvent mocks base method.
 func (m *MockMutableState) AddCancelTimerFailedEvent(arg0 int64, arg1 *types.CancelTimerDecisionAttributes, arg2 string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -746,6 +777,36 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCanceledEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionCanceledEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionCanceledEvent), arg0, arg1)
 }
 
+// AddWorkflowExecutionPausedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionPausedEvent(arg0, arg1 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionPausedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionPausedEvent indicates an expected call of AddWorkflowExecutionPausedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionPausedEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionPausedEvent), arg0, arg1)
+}
+
+// AddWorkflowExecutionSearchAttributesUpdatedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionSearchAttributesUpdatedEvent(arg0 *types.SearchAttributes, arg1, arg2 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionSearchAttributesUpdatedEvent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionSearchAttributesUpdatedEvent indicates an expected call of AddWorkflowExecutionSearchAttributesUpdatedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionSearchAttributesUpdatedEvent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionSearchAttributesUpdatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionSearchAttributesUpdatedEvent), arg0, arg1, arg2)
+}
+
 // AddWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity, reqeustID string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -791,6 +852,66 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionTerminatedEvent(firs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionTerminatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionTerminatedEvent), firstEventID, reason, details, identity)
 }
 
+// AddWorkflowExecutionUnpausedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUnpausedEvent(arg0, arg1 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUnpausedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUnpausedEvent indicates an expected call of AddWorkflowExecutionUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUnpausedEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUnpausedEvent), arg0, arg1)
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUpdateAcceptedEvent(arg0 int64, arg1 *types.AcceptWorkflowUpdateDecisionAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateAcceptedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent indicates an expected call of AddWorkflowExecutionUpdateAcceptedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUpdateAcceptedEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateAcceptedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUpdateAcceptedEvent), arg0, arg1)
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUpdateCompletedEvent(arg0 int64, arg1 *types.CompleteWorkflowUpdateDecisionAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateCompletedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent indicates an expected call of AddWorkflowExecutionUpdateCompletedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUpdateCompletedEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateCompletedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUpdateCompletedEvent), arg0, arg1)
+}
+
+// AddWorkflowExecutionUpdateRejectedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUpdateRejectedEvent(arg0 int64, arg1 *types.RejectWorkflowUpdateDecisionAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateRejectedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateRejectedEvent indicates an expected call of AddWorkflowExecutionUpdateRejectedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUpdateRejectedEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateRejectedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUpdateRejectedEvent), arg0, arg1)
+}
+
 // ByteSize mocks base method.
 func (m *MockMutableState) ByteSize() uint64 {
 	m.ctrl.T.Helper()
@@ -1528,6 +1649,20 @@ func (mr *MockMutableStateMockRecorder) GetUpdateCondition() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateCondition", reflect.TypeOf((*MockMutableState)(nil).GetUpdateCondition))
 }
 
+// GetUpdateRegistry mocks base method.
+func (m *MockMutableState) GetUpdateRegistry() update.Registry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateRegistry")
+	ret0, _ := ret[0].(update.Registry)
+	return ret0
+}
+
+// GetUpdateRegistry indicates an expected call of GetUpdateRegistry.
+func (mr *MockMutableStateMockRecorder) GetUpdateRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateRegistry", reflect.TypeOf((*MockMutableState)(nil).GetUpdateRegistry))
+}
+
 // GetUserTimerInfo mocks base method.
 func (m *MockMutableState) GetUserTimerInfo(arg0 string) (*persistence.TimerInfo, bool) {
 	m.ctrl.T.Helper()
@@ -1714,6 +1849,20 @@ func (mr *MockMutableStateMockRecorder) IsResourceDuplicated(resourceDedupKey an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsResourceDuplicated", reflect.TypeOf((*MockMutableState)(nil).IsResourceDuplicated), resourceDedupKey)
 }
 
+// IsRestored mocks base method.
+func (m *MockMutableState) IsRestored() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRestored")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsRestored indicates an expected call of IsRestored.
+func (mr *MockMutableStateMockRecorder) IsRestored() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRestored", reflect.TypeOf((*MockMutableState)(nil).IsRestored))
+}
+
 // IsSignalRequested mocks base method.
 func (m *MockMutableState) IsSignalRequested(requestID string) bool {
 	m.ctrl.T.Helper()
@@ -1770,6 +1919,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionRunning() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionRunning", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionRunning))
 }
 
+// IsWorkflowUpdateAccepted mocks base method.
+func (m *MockMutableState) IsWorkflowUpdateAccepted(updateID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowUpdateAccepted", updateID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowUpdateAccepted indicates an expected call of IsWorkflowUpdateAccepted.
+func (mr *MockMutableStateMockRecorder) IsWorkflowUpdateAccepted(updateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowUpdateAccepted", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowUpdateAccepted), updateID)
+}
+
 // Load mocks base method.
 func (m *MockMutableState) Load(arg0 context.Context, arg1 *persistence.WorkflowMutableState) error {
 	m.ctrl.T.Helper()
@@ -1854,6 +2017,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskFailedEvent(arg0 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskFailedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskFailedEvent), arg0)
 }
 
+// ReplicateActivityTaskPausedEvent mocks base method.
+func (m *MockMutableState) ReplicateActivityTaskPausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateActivityTaskPausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateActivityTaskPausedEvent indicates an expected call of ReplicateActivityTaskPausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskPausedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskPausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskPausedEvent), arg0)
+}
+
 // ReplicateActivityTaskScheduledEvent mocks base method.
 func (m *MockMutableState) ReplicateActivityTaskScheduledEvent(arg0 int64, arg1 *types.HistoryEvent, arg2 bool) (*persistence.ActivityInfo, error) {
 	m.ctrl.T.Helper()
@@ -1897,6 +2074,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskTimedOutEvent(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskTimedOutEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskTimedOutEvent), arg0)
 }
 
+// ReplicateActivityTaskUnpausedEvent mocks base method.
+func (m *MockMutableState) ReplicateActivityTaskUnpausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateActivityTaskUnpausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateActivityTaskUnpausedEvent indicates an expected call of ReplicateActivityTaskUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskUnpausedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskUnpausedEvent), arg0)
+}
+
 // ReplicateChildWorkflowExecutionCanceledEvent mocks base method.
 func (m *MockMutableState) ReplicateChildWorkflowExecutionCanceledEvent(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2309,6 +2500,34 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionFailedEvent(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionFailedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionFailedEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionPausedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionPausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionPausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionPausedEvent indicates an expected call of ReplicateWorkflowExecutionPausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionPausedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionPausedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionSearchAttributesUpdatedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionSearchAttributesUpdatedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionSearchAttributesUpdatedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionSearchAttributesUpdatedEvent indicates an expected call of ReplicateWorkflowExecutionSearchAttributesUpdatedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionSearchAttributesUpdatedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionSearchAttributesUpdatedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionSearchAttributesUpdatedEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) ReplicateWorkflowExecutionSignaled(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2365,6 +2584,48 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionTimedoutEvent(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionTimedoutEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionTimedoutEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionUnpausedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionUnpausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUnpausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUnpausedEvent indicates an expected call of ReplicateWorkflowExecutionUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUnpausedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUnpausedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionUpdateAcceptedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionUpdateAcceptedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUpdateAcceptedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUpdateAcceptedEvent indicates an expected call of ReplicateWorkflowExecutionUpdateAcceptedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUpdateAcceptedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUpdateAcceptedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUpdateAcceptedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionUpdateCompletedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUpdateCompletedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent indicates an expected call of ReplicateWorkflowExecutionUpdateCompletedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUpdateCompletedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUpdateCompletedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUpdateCompletedEvent), arg0)
+}
+
 // RetryActivity mocks base method.
 func (m *MockMutableState) RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2444,6 +2705,18 @@ func (mr *MockMutableStateMockRecorder) SetQueryRegistry(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueryRegistry", reflect.TypeOf((*MockMutableState)(nil).SetQueryRegistry), arg0)
 }
 
+// SetRestored mocks base method.
+func (m *MockMutableState) SetRestored() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRestored")
+}
+
+// SetRestored indicates an expected call of SetRestored.
+func (mr *MockMutableStateMockRecorder) SetRestored() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRestored", reflect.TypeOf((*MockMutableState)(nil).SetRestored))
+}
+
 // SetUpdateCondition mocks base method.
 func (m *MockMutableState) SetUpdateCondition(arg0 int64) {
 	m.ctrl.T.Helper()
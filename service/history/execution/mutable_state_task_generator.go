@@ -185,6 +185,14 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 		retentionDuration += time.Duration(rand.Intn(workflowDeletionTaskJitterRange*60)) * time.Second
 	}
 
+	deletionTimestamp := closeTimestamp.Add(retentionDuration)
+	if now := time.Now(); deletionTimestamp.Before(now) && r.mutableState.IsRestored() {
+		// the retention period of a workflow restored from the history archival
+		// has already passed, keep the workflow for a full retention period from
+		// now instead of deleting it right away
+		deletionTimestamp = now.Add(retentionDuration)
+	}
+
 	r.logger.Debug("GenerateWorkflowCloseTasks",
 		tag.WorkflowID(executionInfo.WorkflowID),
 		tag.WorkflowRunID(executionInfo.RunID),
@@ -199,7 +207,7 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 		},
 		TaskData: persistence.TaskData{
 			// TaskID is set by shard
-			VisibilityTimestamp: closeTimestamp.Add(retentionDuration),
+			VisibilityTimestamp: deletionTimestamp,
 			Version:             closeEvent.Version,
 		},
 	})
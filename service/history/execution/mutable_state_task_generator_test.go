@@ -189,7 +189,7 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks() {
 func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks_NotActive() {
 	closeEvent := &types.HistoryEvent{
 		Version:   constants.TestVersion,
-		Timestamp: common.Ptr(time.Unix(1719224698, 0).UnixNano()),
+		Timestamp: common.Ptr(time.Now().UnixNano()),
 	}
 
 	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
@@ -249,6 +249,47 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks_NotActiv
 	s.NoError(err)
 }
 
+func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks_RetentionPassed() {
+	closeEvent := &types.HistoryEvent{
+		EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+		Version:   constants.TestVersion,
+		Timestamp: common.Ptr(time.Unix(1719224698, 0).UnixNano()),
+	}
+	domainEntry, err := s.mockDomainCache.GetDomainByID(constants.TestDomainID)
+	s.NoError(err)
+	retention := time.Duration(domainEntry.GetRetentionDays(constants.TestWorkflowID)) * time.Hour * 24
+
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().AddTransferTasks(gomock.Any()).Times(2)
+	var timerTasks []persistence.Task
+	s.mockMutableState.EXPECT().AddTimerTasks(gomock.Any()).Do(func(tasks ...persistence.Task) {
+		timerTasks = tasks
+	}).Times(2)
+
+	// the deletion of a workflow which is not restored is not postponed
+	s.mockMutableState.EXPECT().IsRestored().Return(false).Times(1)
+	err = s.taskGenerator.GenerateWorkflowCloseTasks(closeEvent, 1)
+	s.NoError(err)
+	s.Len(timerTasks, 1)
+	s.IsType(&persistence.DeleteHistoryEventTask{}, timerTasks[0])
+	s.Equal(time.Unix(0, closeEvent.GetTimestamp()).Add(retention), timerTasks[0].GetVisibilityTimestamp())
+
+	// the history was restored after its retention period, so the deletion
+	// is scheduled a full retention period from now
+	s.mockMutableState.EXPECT().IsRestored().Return(true).Times(1)
+	before := time.Now()
+	err = s.taskGenerator.GenerateWorkflowCloseTasks(closeEvent, 1)
+	s.NoError(err)
+	s.Len(timerTasks, 1)
+	s.IsType(&persistence.DeleteHistoryEventTask{}, timerTasks[0])
+	s.False(timerTasks[0].GetVisibilityTimestamp().Before(before.Add(retention)))
+	s.False(timerTasks[0].GetVisibilityTimestamp().After(time.Now().Add(retention)))
+}
+
 func (s *mutableStateTaskGeneratorSuite) TestGenerateFromTransferTask() {
 	now := time.Now()
 	testCases := []struct {
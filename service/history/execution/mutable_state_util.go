@@ -185,6 +185,21 @@ func GetChildExecutionDomainID(
 	return parentDomainEntry.GetInfo().ID, nil
 }
 
+// IsWorkflowPaused returns true if an operator paused the workflow execution
+func IsWorkflowPaused(
+	mutableState MutableState,
+) bool {
+	return mutableState.GetExecutionInfo().Paused
+}
+
+// IsActivityPaused returns true if an operator paused the activity or its workflow execution
+func IsActivityPaused(
+	mutableState MutableState,
+	ai *persistence.ActivityInfo,
+) bool {
+	return ai.Paused || IsWorkflowPaused(mutableState)
+}
+
 func trimBinaryChecksums(recentBinaryChecksums []string, currResetPoints []*types.ResetPointInfo, maxResetPoints int) ([]string, []*types.ResetPointInfo) {
 	numResetPoints := len(currResetPoints)
 	if numResetPoints >= maxResetPoints {
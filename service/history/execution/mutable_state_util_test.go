@@ -547,3 +547,37 @@ func TestFailDecision(t *testing.T) {
 		assert.Equal(t, "some error adding failed event", err.Error())
 	})
 }
+
+func TestIsWorkflowAndActivityPaused(t *testing.T) {
+	tests := []struct {
+		name                 string
+		workflowPaused       bool
+		activityPaused       bool
+		expectWorkflowPaused bool
+		expectActivityPaused bool
+	}{
+		{
+			name: "not paused",
+		},
+		{
+			name:                 "workflow paused",
+			workflowPaused:       true,
+			expectWorkflowPaused: true,
+			expectActivityPaused: true,
+		},
+		{
+			name:                 "activity paused",
+			activityPaused:       true,
+			expectActivityPaused: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMutableState := NewMockMutableState(gomock.NewController(t))
+			mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{Paused: tc.workflowPaused}).AnyTimes()
+
+			assert.Equal(t, tc.expectWorkflowPaused, IsWorkflowPaused(mockMutableState))
+			assert.Equal(t, tc.expectActivityPaused, IsActivityPaused(mockMutableState, &persistence.ActivityInfo{Paused: tc.activityPaused}))
+		})
+	}
+}
@@ -149,6 +149,12 @@ func CopyWorkflowExecutionInfo(t *testing.T, sourceInfo *persistence.WorkflowExe
 		ExpirationSeconds:                  sourceInfo.ExpirationSeconds,
 		CronOverlapPolicy:                  sourceInfo.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:       sourceInfo.ActiveClusterSelectionPolicy,
+		Paused:                             sourceInfo.Paused,
+		PauseReason:                        sourceInfo.PauseReason,
+		PauseIdentity:                      sourceInfo.PauseIdentity,
+		PausedTimestamp:                    sourceInfo.PausedTimestamp,
+		AcceptedUpdateIDs:                  sourceInfo.AcceptedUpdateIDs,
+		TaskPriority:                       sourceInfo.TaskPriority,
 	}
 }
 
@@ -181,6 +187,7 @@ func CopyActivityInfo(t *testing.T, sourceInfo *persistence.ActivityInfo) *persi
 		StartedIdentity:          sourceInfo.StartedIdentity,
 		TaskList:                 sourceInfo.TaskList,
 		TaskListKind:             sourceInfo.TaskListKind,
+		TaskPriority:             sourceInfo.TaskPriority,
 		HasRetryPolicy:           sourceInfo.HasRetryPolicy,
 		InitialInterval:          sourceInfo.InitialInterval,
 		BackoffCoefficient:       sourceInfo.BackoffCoefficient,
@@ -191,6 +198,10 @@ func CopyActivityInfo(t *testing.T, sourceInfo *persistence.ActivityInfo) *persi
 		LastFailureReason:        sourceInfo.LastFailureReason,
 		LastWorkerIdentity:       sourceInfo.LastWorkerIdentity,
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
+		Paused:                   sourceInfo.Paused,
+		PauseReason:              sourceInfo.PauseReason,
+		PauseIdentity:            sourceInfo.PauseIdentity,
+		PausedTimestamp:          sourceInfo.PausedTimestamp,
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds: sourceInfo.LastHeartbeatTimeoutVisibilityInSeconds,
 	}
@@ -284,6 +284,20 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeActivityTaskPaused:
+			if err := b.mutableState.ReplicateActivityTaskPausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeActivityTaskUnpaused:
+			if err := b.mutableState.ReplicateActivityTaskUnpausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeRequestCancelActivityTaskFailed:
 			// No mutable state action is needed
 
@@ -424,6 +438,23 @@ func (b *stateBuilderImpl) ApplyEvents(
 		case types.EventTypeMarkerRecorded:
 			// No mutable state action is needed
 
+		case types.EventTypeWorkflowExecutionUpdateAccepted:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateAcceptedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUpdateRejected:
+			// No mutable state action is needed
+
+		case types.EventTypeWorkflowExecutionUpdateCompleted:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateCompletedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionSignaled:
 			if err := b.mutableState.ReplicateWorkflowExecutionSignaled(
 				event,
@@ -445,6 +476,27 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeWorkflowExecutionSearchAttributesUpdated:
+			if err := b.mutableState.ReplicateWorkflowExecutionSearchAttributesUpdatedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionPaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionPausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUnpaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionUnpausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCompleted:
 			if err := b.mutableState.ReplicateWorkflowExecutionCompletedEvent(
 				firstEvent.ID,
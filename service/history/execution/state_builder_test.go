@@ -563,6 +563,87 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowSearchAttribu
 	s.Nil(err)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionSearchAttributesUpdated() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionSearchAttributesUpdated
+	event := &types.HistoryEvent{
+		Version:   version,
+		ID:        130,
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &evenType,
+		WorkflowExecutionSearchAttributesUpdatedEventAttributes: &types.WorkflowExecutionSearchAttributesUpdatedEventAttributes{},
+	}
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionSearchAttributesUpdatedEvent(event).Return(nil).Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionPaused() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionPaused
+	event := &types.HistoryEvent{
+		Version:                                version,
+		ID:                                     130,
+		Timestamp:                              common.Int64Ptr(now.UnixNano()),
+		EventType:                              &evenType,
+		WorkflowExecutionPausedEventAttributes: &types.WorkflowExecutionPausedEventAttributes{},
+	}
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionPausedEvent(event).Return(nil).Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionUnpaused() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionUnpaused
+	event := &types.HistoryEvent{
+		Version:                                  version,
+		ID:                                       130,
+		Timestamp:                                common.Int64Ptr(now.UnixNano()),
+		EventType:                                &evenType,
+		WorkflowExecutionUnpausedEventAttributes: &types.WorkflowExecutionUnpausedEventAttributes{},
+	}
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionUnpausedEvent(event).Return(nil).Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -589,6 +670,48 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded() {
 	s.Nil(err)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionUpdate() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	for i, event := range []*types.HistoryEvent{
+		{
+			EventType: types.EventTypeWorkflowExecutionUpdateAccepted.Ptr(),
+			WorkflowExecutionUpdateAcceptedEventAttributes: &types.WorkflowExecutionUpdateAcceptedEventAttributes{UpdateID: "u1"},
+		},
+		{
+			EventType: types.EventTypeWorkflowExecutionUpdateRejected.Ptr(),
+			WorkflowExecutionUpdateRejectedEventAttributes: &types.WorkflowExecutionUpdateRejectedEventAttributes{UpdateID: "u2"},
+		},
+		{
+			EventType: types.EventTypeWorkflowExecutionUpdateCompleted.Ptr(),
+			WorkflowExecutionUpdateCompletedEventAttributes: &types.WorkflowExecutionUpdateCompletedEventAttributes{UpdateID: "u1"},
+		},
+	} {
+		event.Version = version
+		event.ID = int64(130 + i)
+		event.Timestamp = common.Int64Ptr(now.UnixNano())
+		s.mockUpdateVersion(event)
+		s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+		s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+		switch event.GetEventType() {
+		case types.EventTypeWorkflowExecutionUpdateAccepted:
+			s.mockMutableState.EXPECT().ReplicateWorkflowExecutionUpdateAcceptedEvent(event).Return(nil).Times(1)
+		case types.EventTypeWorkflowExecutionUpdateCompleted:
+			s.mockMutableState.EXPECT().ReplicateWorkflowExecutionUpdateCompletedEvent(event).Return(nil).Times(1)
+		}
+
+		_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+		s.Nil(err)
+	}
+}
+
 // decision operations
 
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeDecisionTaskScheduled() {
@@ -1138,6 +1261,60 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeActivityTaskCancelRequested
 	s.Nil(err)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeActivityTaskPaused() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeActivityTaskPaused
+	event := &types.HistoryEvent{
+		Version:                           version,
+		ID:                                130,
+		Timestamp:                         common.Int64Ptr(now.UnixNano()),
+		EventType:                         &evenType,
+		ActivityTaskPausedEventAttributes: &types.ActivityTaskPausedEventAttributes{},
+	}
+	s.mockMutableState.EXPECT().ReplicateActivityTaskPausedEvent(event).Return(nil).Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeActivityTaskUnpaused() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeActivityTaskUnpaused
+	event := &types.HistoryEvent{
+		Version:                             version,
+		ID:                                  130,
+		Timestamp:                           common.Int64Ptr(now.UnixNano()),
+		EventType:                           &evenType,
+		ActivityTaskUnpausedEventAttributes: &types.ActivityTaskUnpausedEventAttributes{},
+	}
+	s.mockMutableState.EXPECT().ReplicateActivityTaskUnpausedEvent(event).Return(nil).Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeRequestCancelActivityTaskFailed() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -1649,7 +1826,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeExternalWorkflowExecutionSi
 
 func (s *stateBuilderSuite) TestApplyEventsNewEventsNotHandled() {
 	eventTypes := types.EventTypeValues()
-	s.Equal(42, len(eventTypes), "If you see this error, you are adding new event type. "+
+	s.Equal(50, len(eventTypes), "If you see this error, you are adding new event type. "+
 		"Before updating the number to make this test pass, please make sure you update stateBuilderImpl.ApplyEvents method "+
 		"to handle the new decision type. Otherwise cross dc will not work on the new event.")
 }
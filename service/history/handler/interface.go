@@ -85,6 +85,11 @@ type Handler interface {
 	SyncActivity(context.Context, *types.SyncActivityRequest) error
 	SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 	TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
+	UpdateWorkflowExecution(context.Context, *types.HistoryUpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error)
+	UpdateWorkflowSearchAttributes(context.Context, *types.UpdateWorkflowSearchAttributesRequest) error
+	PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest) error
+	UnpauseWorkflowExecution(context.Context, *types.HistoryUnpauseWorkflowExecutionRequest) error
+	ResetActivity(context.Context, *types.HistoryResetActivityRequest) error
 	GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
 	RatelimitUpdate(context.Context, *types.RatelimitUpdateRequest) (*types.RatelimitUpdateResponse, error)
 }
@@ -423,6 +423,9 @@ func applyStartEvents(
 	requestID := uuid.New() // requestID used for start workflow execution request.  This is not on the history event.
 	// since it's replicated from the other cluster, we don't care the active cluster policy, because the failover version will be updated after ApplyEvents
 	mutableState := newMutableState(domainEntry, task.getLogger())
+	if task.isRestored() {
+		mutableState.SetRestored()
+	}
 	stateBuilder := newStateBuilder(mutableState, task.getLogger())
 
 	// use state builder for workflow mutable state mutation
@@ -536,6 +539,9 @@ func applyNonStartEventsToCurrentBranch(
 ) error {
 
 	requestID := uuid.New() // requestID used for start workflow execution request.  This is not on the history event.
+	if task.isRestored() {
+		mutableState.SetRestored()
+	}
 	stateBuilder := newStateBuilder(mutableState, task.getLogger())
 	newMutableState, err := stateBuilder.ApplyEvents(
 		task.getDomainID(),
@@ -1071,6 +1071,7 @@ func Test_applyStartEvents(t *testing.T) {
 				}).Times(1)
 				mockReplicationTask.EXPECT().getEvents().Return(nil).Times(1)
 				mockReplicationTask.EXPECT().getNewEvents().Return(nil).Times(1)
+				mockReplicationTask.EXPECT().isRestored().Return(false).Times(1)
 				mockReplicationTask.EXPECT().getEventTime().Return(time.Now()).Times(2)
 				mockReplicationTask.EXPECT().getSourceCluster().Return("test-source-cluster").Times(1)
 			},
@@ -1129,6 +1130,7 @@ func Test_applyStartEvents(t *testing.T) {
 				}).Times(1)
 				mockReplicationTask.EXPECT().getEvents().Return(nil).Times(1)
 				mockReplicationTask.EXPECT().getNewEvents().Return(nil).Times(1)
+				mockReplicationTask.EXPECT().isRestored().Return(false).Times(1)
 				mockReplicationTask.EXPECT().getEventTime().Return(time.Now()).Times(1)
 			},
 			mockTransactionManagerAffordance: func(mockTransactionManager *MocktransactionManager) {
@@ -1161,6 +1163,7 @@ func Test_applyStartEvents(t *testing.T) {
 				}).Times(1)
 				mockReplicationTask.EXPECT().getEvents().Return(nil).Times(1)
 				mockReplicationTask.EXPECT().getNewEvents().Return(nil).Times(1)
+				mockReplicationTask.EXPECT().isRestored().Return(false).Times(1)
 			},
 			mockTransactionManagerAffordance: func(mockTransactionManager *MocktransactionManager) {},
 			mockShardContextAffordance:       func(mockShard *shard.MockContext) {},
@@ -1438,6 +1441,7 @@ func Test_applyNonStartEventsToCurrentBranch(t *testing.T) {
 				}).Times(1)
 				mockTask.EXPECT().getEvents().Return(nil).Times(1)
 				mockTask.EXPECT().getNewEvents().Return(nil).Times(1)
+				mockTask.EXPECT().isRestored().Return(false).Times(1)
 				mockTask.EXPECT().getEventTime().Return(time.Now()).Times(2)
 				mockTask.EXPECT().getSourceCluster().Return("test-source-cluster").Times(1)
 			},
@@ -1499,6 +1503,7 @@ func Test_applyNonStartEventsToCurrentBranch(t *testing.T) {
 				}).Times(1)
 				mockTask.EXPECT().getEvents().Return(nil).Times(1)
 				mockTask.EXPECT().getNewEvents().Return(nil).Times(1)
+				mockTask.EXPECT().isRestored().Return(false).Times(1)
 			},
 			mockStateBuilderAffordance: func(mockStateBuilder *execution.MockStateBuilder, mockMutableState *execution.MockMutableState) {
 				mockStateBuilder.EXPECT().ApplyEvents(
@@ -1530,6 +1535,7 @@ func Test_applyNonStartEventsToCurrentBranch(t *testing.T) {
 				}).Times(1)
 				mockTask.EXPECT().getEvents().Return(nil).Times(1)
 				mockTask.EXPECT().getNewEvents().Return(nil).Times(1)
+				mockTask.EXPECT().isRestored().Return(false).Times(1)
 				mockTask.EXPECT().getEventTime().Return(time.Now()).Times(1)
 			},
 			mockStateBuilderAffordance: func(mockStateBuilder *execution.MockStateBuilder, mockMutableState *execution.MockMutableState) {
@@ -1568,6 +1574,69 @@ func Test_applyNonStartEventsToCurrentBranch(t *testing.T) {
 			},
 			expectError: fmt.Errorf("test update error"),
 		},
+		"Case4: success case with restored events": {
+			mockTaskAffordance: func(mockTask *MockreplicationTask) {
+				mockTask.EXPECT().getLogger().Return(log.NewNoop()).Times(1)
+				mockTask.EXPECT().getDomainID().Return("test-domain-id").Times(1)
+				mockTask.EXPECT().getExecution().Return(&types.WorkflowExecution{
+					WorkflowID: "test-workflow-id",
+					RunID:      "test-run-id",
+				}).Times(1)
+				mockTask.EXPECT().getEvents().Return(nil).Times(1)
+				mockTask.EXPECT().getNewEvents().Return(nil).Times(1)
+				mockTask.EXPECT().isRestored().Return(true).Times(1)
+				mockTask.EXPECT().getEventTime().Return(time.Now()).Times(2)
+				mockTask.EXPECT().getSourceCluster().Return("test-source-cluster").Times(1)
+			},
+			mockStateBuilderAffordance: func(mockStateBuilder *execution.MockStateBuilder, mockMutableState *execution.MockMutableState) {
+				mockMutableState.EXPECT().SetRestored().Times(1)
+				mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+					WorkflowID: "test-workflow-id",
+					RunID:      "test-run-id",
+					DomainID:   "test-domain-id",
+				}).Times(1)
+				mockStateBuilder.EXPECT().ApplyEvents(
+					"test-domain-id",
+					gomock.Any(),
+					types.WorkflowExecution{
+						WorkflowID: "test-workflow-id",
+						RunID:      "test-run-id",
+					},
+					nil,
+					nil,
+				).Return(mockMutableState, nil).Times(1)
+			},
+			mockTransactionManagerAffordance: func(mockTransactionManager *MocktransactionManager) {
+				mockTransactionManager.EXPECT().updateWorkflow(
+					gomock.Any(),
+					gomock.Any(),
+					true,
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil).Times(1)
+			},
+			mockShardAffordance: func(mockShard *shard.MockContext) {
+				mockShard.EXPECT().GetExecutionManager().Return(nil).Times(1)
+				mockShard.EXPECT().GetMetricsClient().Return(metrics.NewNoopMetricsClient()).Times(1)
+				mockShard.EXPECT().GetConfig().Return(&config.Config{
+					NumberOfShards:                       0,
+					IsAdvancedVisConfigExist:             false,
+					MaxResponseSize:                      0,
+					HistoryCacheInitialSize:              dynamicproperties.GetIntPropertyFn(10),
+					HistoryCacheMaxSize:                  dynamicproperties.GetIntPropertyFn(10),
+					HistoryCacheTTL:                      dynamicproperties.GetDurationPropertyFn(10),
+					HostName:                             "test-host",
+					StandbyClusterDelay:                  dynamicproperties.GetDurationPropertyFn(10),
+					EnableSizeBasedHistoryExecutionCache: dynamicproperties.GetBoolPropertyFn(false),
+				}).Times(1)
+				mockShard.EXPECT().SetCurrentTime(gomock.Any(), gomock.Any()).Times(1)
+			},
+			mockNewStateBuilderFn: func(mutableState execution.MutableState, logger log.Logger) execution.StateBuilder {
+				mockStateBuilder := execution.NewMockStateBuilder(gomock.NewController(t))
+				return mockStateBuilder
+			},
+			expectError: nil,
+		},
 	}
 
 	for name, test := range tests {
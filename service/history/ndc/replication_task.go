@@ -51,6 +51,7 @@ type (
 		getLogger() log.Logger
 		getVersionHistory() *persistence.VersionHistory
 		isWorkflowReset() bool
+		isRestored() bool
 		getWorkflowResetMetadata() (string, string, int64, bool)
 
 		splitTask(taskStartTime time.Time) (replicationTask, replicationTask, error)
@@ -67,6 +68,7 @@ type (
 		events         []*types.HistoryEvent
 		newEvents      []*types.HistoryEvent
 		versionHistory *persistence.VersionHistory
+		restored       bool
 
 		startTime time.Time
 		logger    log.Logger
@@ -156,6 +158,7 @@ func newReplicationTask(
 		events:         events,
 		newEvents:      newEvents,
 		versionHistory: persistence.NewVersionHistoryFromInternalType(versionHistory),
+		restored:       request.GetRestored(),
 
 		startTime: taskStartTime,
 		logger:    logger,
@@ -214,6 +217,10 @@ func (t *replicationTaskImpl) getVersionHistory() *persistence.VersionHistory {
 	return t.versionHistory
 }
 
+func (t *replicationTaskImpl) isRestored() bool {
+	return t.restored
+}
+
 func (t *replicationTaskImpl) isWorkflowReset() bool {
 
 	baseRunID, newRunID, baseEventVersion, isReset := t.getWorkflowResetMetadata()
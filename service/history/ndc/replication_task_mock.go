@@ -243,6 +243,20 @@ func (mr *MockreplicationTaskMockRecorder) getWorkflowResetMetadata() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getWorkflowResetMetadata", reflect.TypeOf((*MockreplicationTask)(nil).getWorkflowResetMetadata))
 }
 
+// isRestored mocks base method.
+func (m *MockreplicationTask) isRestored() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "isRestored")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// isRestored indicates an expected call of isRestored.
+func (mr *MockreplicationTaskMockRecorder) isRestored() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "isRestored", reflect.TypeOf((*MockreplicationTask)(nil).isRestored))
+}
+
 // isWorkflowReset mocks base method.
 func (m *MockreplicationTask) isWorkflowReset() bool {
 	m.ctrl.T.Helper()
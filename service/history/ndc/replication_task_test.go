@@ -509,6 +509,7 @@ func Test_replicationTaskImpl_Getters(t *testing.T) {
 		newEvents:      newHistoryEvents,
 		logger:         logger,
 		versionHistory: versionHistory,
+		restored:       true,
 	}
 
 	tests := map[string]struct {
@@ -555,6 +556,10 @@ func Test_replicationTaskImpl_Getters(t *testing.T) {
 			testFunc:       func() interface{} { return task.getVersionHistory() },
 			expectedResult: versionHistory,
 		},
+		"isRestored": {
+			testFunc:       func() interface{} { return task.isRestored() },
+			expectedResult: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -55,6 +55,9 @@ type (
 	// GetStateActionResult is the result for performing GetState Action
 	GetStateActionResult struct {
 		States []ProcessingQueueState
+		// Descriptions contains human readable states of queues that don't use processing queues,
+		// e.g. virtual queues, alerts and mitigations of history queue v2
+		Descriptions []string
 	}
 
 	// GetTasksAttributes contains the parameter to get tasks
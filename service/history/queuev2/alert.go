@@ -1,10 +1,21 @@
 package queuev2
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
 type (
 	// Alert is created by a Monitor when some statistics of the Queue is abnormal
 	Alert struct {
 		AlertType                            AlertType
 		AlertAttributesQueuePendingTaskCount *AlertAttributesQueuePendingTaskCount
+		AlertAttributesStuckSlice            *AlertAttributesStuckSlice
+		AlertAttributesWatermarkLag          *AlertAttributesWatermarkLag
+		AlertAttributesSliceCount            *AlertAttributesSliceCount
+		AlertAttributesDomainTaskRate        *AlertAttributesDomainTaskRate
 	}
 
 	AlertType int
@@ -13,9 +24,80 @@ type (
 		CurrentPendingTaskCount  int
 		CriticalPendingTaskCount int
 	}
+
+	// AlertAttributesStuckSlice is created when the inclusive min task key of a virtual slice with pending tasks
+	// doesn't move for longer than the critical duration
+	AlertAttributesStuckSlice struct {
+		Slice                 VirtualSlice
+		PendingTaskCount      int
+		StuckDuration         time.Duration
+		CriticalStuckDuration time.Duration
+	}
+
+	// AlertAttributesWatermarkLag is created when the ack level of the queue doesn't move for longer than the critical duration
+	AlertAttributesWatermarkLag struct {
+		Watermark           persistence.HistoryTaskKey
+		LagDuration         time.Duration
+		CriticalLagDuration time.Duration
+	}
+
+	// AlertAttributesSliceCount is created when the number of virtual slices of the queue exceeds the critical count
+	AlertAttributesSliceCount struct {
+		CurrentSliceCount  int
+		CriticalSliceCount int
+	}
+
+	// AlertAttributesDomainTaskRate is created when the rate of tasks loaded for a single domain exceeds the critical rate
+	AlertAttributesDomainTaskRate struct {
+		DomainID         string
+		TaskRate         float64
+		CriticalTaskRate float64
+	}
 )
 
 const (
 	AlertTypeUnspecified AlertType = iota
 	AlertTypeQueuePendingTaskCount
+	AlertTypeStuckSlice
+	AlertTypeWatermarkLag
+	AlertTypeSliceCount
+	AlertTypeDomainTaskRate
 )
+
+var alertTypeNames = map[AlertType]string{
+	AlertTypeUnspecified:           "unspecified",
+	AlertTypeQueuePendingTaskCount: "pending_task_count",
+	AlertTypeStuckSlice:            "stuck_slice",
+	AlertTypeWatermarkLag:          "watermark_lag",
+	AlertTypeSliceCount:            "slice_count",
+	AlertTypeDomainTaskRate:        "domain_task_rate",
+}
+
+func (t AlertType) String() string {
+	if name, ok := alertTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown_%d", int(t))
+}
+
+func (a *Alert) String() string {
+	switch a.AlertType {
+	case AlertTypeQueuePendingTaskCount:
+		attr := a.AlertAttributesQueuePendingTaskCount
+		return fmt.Sprintf("%v: pending task count %d, critical %d", a.AlertType, attr.CurrentPendingTaskCount, attr.CriticalPendingTaskCount)
+	case AlertTypeStuckSlice:
+		attr := a.AlertAttributesStuckSlice
+		return fmt.Sprintf("%v: slice %v with %d pending tasks stuck for %v, critical %v", a.AlertType, attr.Slice.GetState().Range, attr.PendingTaskCount, attr.StuckDuration, attr.CriticalStuckDuration)
+	case AlertTypeWatermarkLag:
+		attr := a.AlertAttributesWatermarkLag
+		return fmt.Sprintf("%v: watermark %v lagging for %v, critical %v", a.AlertType, attr.Watermark, attr.LagDuration, attr.CriticalLagDuration)
+	case AlertTypeSliceCount:
+		attr := a.AlertAttributesSliceCount
+		return fmt.Sprintf("%v: slice count %d, critical %d", a.AlertType, attr.CurrentSliceCount, attr.CriticalSliceCount)
+	case AlertTypeDomainTaskRate:
+		attr := a.AlertAttributesDomainTaskRate
+		return fmt.Sprintf("%v: domain %s task rate %.2f/s, critical %.2f/s", a.AlertType, attr.DomainID, attr.TaskRate, attr.CriticalTaskRate)
+	default:
+		return a.AlertType.String()
+	}
+}
@@ -4,24 +4,43 @@ package queuev2
 import (
 	"maps"
 	"slices"
+	"sync"
 	"time"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/collection"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
 const (
 	targetLoadFactor           = 0.8
 	clearSliceThrottleDuration = 10 * time.Second
+
+	mitigationActionSkip           = "skip"
+	mitigationActionSplitAndClear  = "split_and_clear"
+	mitigationActionSplitDomain    = "split_domain"
+	mitigationActionResumeQueue    = "resume_queue"
+	mitigationActionThrottleQueues = "throttle_queues"
+	mitigationActionMergeSlices    = "merge_slices"
 )
 
 type (
 	Mitigator interface {
 		Mitigate(Alert)
+		// GetLastMitigations returns the last mitigation of each alert type, ordered by alert type
+		GetLastMitigations() []Mitigation
+	}
+
+	// Mitigation records the action taken by the Mitigator for an alert
+	Mitigation struct {
+		Alert  Alert
+		Action string
+		Time   time.Time
 	}
 
 	MitigatorOptions struct {
@@ -33,9 +52,13 @@ type (
 		monitor             Monitor
 		logger              log.Logger
 		metricsScope        metrics.Scope
+		timeSource          clock.TimeSource
 		options             *MitigatorOptions
 
-		handlers map[AlertType]func(Alert)
+		handlers map[AlertType]func(Alert) string
+
+		sync.Mutex
+		lastMitigations map[AlertType]Mitigation
 	}
 
 	pendingTaskStats struct {
@@ -51,6 +74,7 @@ func NewMitigator(
 	monitor Monitor,
 	logger log.Logger,
 	metricsScope metrics.Scope,
+	timeSource clock.TimeSource,
 	options *MitigatorOptions,
 ) Mitigator {
 	m := &mitigatorImpl{
@@ -58,27 +82,56 @@ func NewMitigator(
 		monitor:             monitor,
 		logger:              logger,
 		metricsScope:        metricsScope,
+		timeSource:          timeSource,
 		options:             options,
+		lastMitigations:     make(map[AlertType]Mitigation),
 	}
-	m.handlers = map[AlertType]func(Alert){
+	m.handlers = map[AlertType]func(Alert) string{
 		AlertTypeQueuePendingTaskCount: m.handleQueuePendingTaskCount,
+		AlertTypeStuckSlice:            m.handleStuckSlice,
+		AlertTypeWatermarkLag:          m.handleWatermarkLag,
+		AlertTypeSliceCount:            m.handleSliceCount,
+		AlertTypeDomainTaskRate:        m.handleDomainTaskRate,
 	}
 	return m
 }
 
 func (m *mitigatorImpl) Mitigate(alert Alert) {
+	scope := m.metricsScope.Tagged(metrics.QueueAlertTypeTag(alert.AlertType.String()))
+	scope.IncCounter(metrics.VirtualQueueAlertCounter)
+
 	handler, ok := m.handlers[alert.AlertType]
 	if !ok {
 		m.logger.Error("unknown queue alert type", tag.AlertType(int(alert.AlertType)))
 		return
 	}
-	handler(alert)
+	action := handler(alert)
 
 	m.monitor.ResolveAlert(alert.AlertType)
-	m.logger.Info("mitigated queue alert", tag.AlertType(int(alert.AlertType)))
+	scope.Tagged(metrics.QueueMitigationActionTag(action)).IncCounter(metrics.VirtualQueueMitigationCounter)
+
+	m.Lock()
+	m.lastMitigations[alert.AlertType] = Mitigation{
+		Alert:  alert,
+		Action: action,
+		Time:   m.timeSource.Now(),
+	}
+	m.Unlock()
+	m.logger.Info("mitigated queue alert", tag.AlertType(int(alert.AlertType)), tag.Dynamic("alert", alert.String()), tag.Dynamic("action", action))
 }
 
-func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) {
+func (m *mitigatorImpl) GetLastMitigations() []Mitigation {
+	m.Lock()
+	defer m.Unlock()
+
+	mitigations := slices.Collect(maps.Values(m.lastMitigations))
+	slices.SortFunc(mitigations, func(a, b Mitigation) int {
+		return int(a.Alert.AlertType) - int(b.Alert.AlertType)
+	})
+	return mitigations
+}
+
+func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) string {
 	// First, try cleaning up tasks that has already been acknowledged to see if we can reduce the pending task count
 	virtualQueues := m.virtualQueueManager.VirtualQueues()
 	for _, virtualQueue := range virtualQueues {
@@ -86,7 +139,7 @@ func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) {
 	}
 	if m.monitor.GetTotalPendingTaskCount() <= alert.AlertAttributesQueuePendingTaskCount.CriticalPendingTaskCount {
 		m.logger.Debug("mitigating queue alert, skip mitigation because the alert is no longer valid")
-		return
+		return mitigationActionSkip
 	}
 	// Second, getting the stats of pending tasks. We need:
 	stats := m.collectPendingTaskStats()
@@ -128,6 +181,123 @@ func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) {
 		}
 		m.logger.Debug("mitigating queue alert, get queue state after mitigation", tag.Dynamic("queue-state", state))
 	}
+	return mitigationActionSplitAndClear
+}
+
+// handleStuckSlice moves the domain with the most pending tasks in the stuck slice to the next virtual queue,
+// so that the rest of the slice can make progress while the domain is throttled
+func (m *mitigatorImpl) handleStuckSlice(alert Alert) string {
+	stuckSlice := alert.AlertAttributesStuckSlice.Slice
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	stats := m.collectPendingTaskStats()
+	pendingTaskCountPerDomain, ok := stats.pendingTaskCountPerDomainPerSlice[stuckSlice]
+	if !ok {
+		m.logger.Debug("mitigating queue alert, skip mitigation because the stuck slice no longer exists")
+		return mitigationActionSkip
+	}
+
+	var domainToSplit string
+	maxPendingTaskCount := 0
+	for domainID, count := range pendingTaskCountPerDomain {
+		if count > maxPendingTaskCount {
+			domainToSplit, maxPendingTaskCount = domainID, count
+		}
+	}
+	if maxPendingTaskCount == 0 {
+		m.logger.Debug("mitigating queue alert, skip mitigation because the stuck slice has no pending task")
+		return mitigationActionSkip
+	}
+
+	m.processQueueSplitsAndClear(virtualQueues, map[VirtualSlice][]string{stuckSlice: {domainToSplit}})
+	return mitigationActionSplitDomain
+}
+
+// handleWatermarkLag resumes the virtual queue holding the queue watermark if it's paused, otherwise it throttles
+// the non-root virtual queues which have pending tasks of the same domains as the lagging virtual queue, since they
+// compete with it for the same domains. The root virtual queue and the queues of other domains are not throttled,
+// so that the domains which don't contribute to the lag are not starved.
+func (m *mitigatorImpl) handleWatermarkLag(alert Alert) string {
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	laggingQueueID := int64(-1)
+	var minTaskKey persistence.HistoryTaskKey
+	for queueID, vq := range virtualQueues {
+		states := vq.GetState()
+		if len(states) == 0 {
+			continue
+		}
+		if laggingQueueID == -1 || states[0].Range.InclusiveMinTaskKey.Compare(minTaskKey) < 0 {
+			laggingQueueID = queueID
+			minTaskKey = states[0].Range.InclusiveMinTaskKey
+		}
+	}
+	if laggingQueueID == -1 {
+		m.logger.Debug("mitigating queue alert, skip mitigation because there is no virtual slice")
+		return mitigationActionSkip
+	}
+
+	if virtualQueues[laggingQueueID].IsPaused() {
+		virtualQueues[laggingQueueID].Resume()
+		return mitigationActionResumeQueue
+	}
+
+	laggingDomains := pendingTaskDomains(virtualQueues[laggingQueueID])
+	throttled := false
+	for queueID, vq := range virtualQueues {
+		if queueID == laggingQueueID || queueID == rootQueueID {
+			continue
+		}
+		for domainID := range pendingTaskDomains(vq) {
+			if _, ok := laggingDomains[domainID]; ok {
+				vq.Pause(clearSliceThrottleDuration)
+				throttled = true
+				break
+			}
+		}
+	}
+	if !throttled {
+		m.logger.Debug("mitigating queue alert, skip mitigation because no other virtual queue competes with the lagging virtual queue", tag.Dynamic("queue-id", laggingQueueID))
+		return mitigationActionSkip
+	}
+	return mitigationActionThrottleQueues
+}
+
+// pendingTaskDomains returns the domains which have pending tasks in the virtual queue
+func pendingTaskDomains(vq VirtualQueue) map[string]struct{} {
+	domains := make(map[string]struct{})
+	vq.IterateSlices(func(slice VirtualSlice) {
+		for domainID, count := range slice.PendingTaskStats().PendingTaskCountPerDomain {
+			if count > 0 {
+				domains[domainID] = struct{}{}
+			}
+		}
+	})
+	return domains
+}
+
+// handleSliceCount merges the adjacent virtual slices in each virtual queue to reduce the number of slices
+func (m *mitigatorImpl) handleSliceCount(alert Alert) string {
+	for _, vq := range m.virtualQueueManager.VirtualQueues() {
+		vq.MergeAdjacentSlices()
+	}
+	return mitigationActionMergeSlices
+}
+
+// handleDomainTaskRate moves the domain with high task rate to its own virtual queue and throttles it
+func (m *mitigatorImpl) handleDomainTaskRate(alert Alert) string {
+	domainID := alert.AlertAttributesDomainTaskRate.DomainID
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	stats := m.collectPendingTaskStats()
+	if len(stats.slicesPerDomain[domainID]) == 0 {
+		m.logger.Debug("mitigating queue alert, skip mitigation because the domain has no pending task", tag.WorkflowDomainID(domainID))
+		return mitigationActionSkip
+	}
+
+	domainsToClear := make(map[VirtualSlice][]string)
+	for _, slice := range stats.slicesPerDomain[domainID] {
+		domainsToClear[slice] = []string{domainID}
+	}
+	m.processQueueSplitsAndClear(virtualQueues, domainsToClear)
+	return mitigationActionSplitDomain
 }
 
 // The stats of pending tasks are used to calculate the domains to clear. We need:
@@ -39,6 +39,20 @@ func (m *MockMitigator) EXPECT() *MockMitigatorMockRecorder {
 	return m.recorder
 }
 
+// GetLastMitigations mocks base method.
+func (m *MockMitigator) GetLastMitigations() []Mitigation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMitigations")
+	ret0, _ := ret[0].([]Mitigation)
+	return ret0
+}
+
+// GetLastMitigations indicates an expected call of GetLastMitigations.
+func (mr *MockMitigatorMockRecorder) GetLastMitigations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMitigations", reflect.TypeOf((*MockMitigator)(nil).GetLastMitigations))
+}
+
 // Mitigate mocks base method.
 func (m *MockMitigator) Mitigate(arg0 Alert) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
@@ -29,6 +30,7 @@ func TestNewMitigator(t *testing.T) {
 		mockMonitor,
 		logger,
 		metricsScope,
+		clock.NewMockedTimeSource(),
 		options,
 	)
 
@@ -44,9 +46,17 @@ func TestNewMitigator(t *testing.T) {
 
 	// Verify handlers are properly initialized
 	assert.NotNil(t, impl.handlers)
-	assert.Len(t, impl.handlers, 1)
-	_, exists := impl.handlers[AlertTypeQueuePendingTaskCount]
-	assert.True(t, exists)
+	assert.Len(t, impl.handlers, 5)
+	for _, alertType := range []AlertType{
+		AlertTypeQueuePendingTaskCount,
+		AlertTypeStuckSlice,
+		AlertTypeWatermarkLag,
+		AlertTypeSliceCount,
+		AlertTypeDomainTaskRate,
+	} {
+		_, exists := impl.handlers[alertType]
+		assert.True(t, exists)
+	}
 }
 
 func TestMitigator_Mitigate_KnownAlertType(t *testing.T) {
@@ -66,13 +76,15 @@ func TestMitigator_Mitigate_KnownAlertType(t *testing.T) {
 		mockMonitor,
 		logger,
 		metricsScope,
+		clock.NewMockedTimeSource(),
 		options,
 	)
 	impl, ok := mitigator.(*mitigatorImpl)
 	require.True(t, ok)
 	handlerCalled := false
-	impl.handlers[AlertTypeQueuePendingTaskCount] = func(alert Alert) {
+	impl.handlers[AlertTypeQueuePendingTaskCount] = func(alert Alert) string {
 		handlerCalled = true
+		return mitigationActionSplitAndClear
 	}
 
 	alert := Alert{
@@ -88,6 +100,11 @@ func TestMitigator_Mitigate_KnownAlertType(t *testing.T) {
 
 	mitigator.Mitigate(alert)
 	assert.True(t, handlerCalled)
+
+	mitigations := mitigator.GetLastMitigations()
+	require.Len(t, mitigations, 1)
+	assert.Equal(t, alert, mitigations[0].Alert)
+	assert.Equal(t, mitigationActionSplitAndClear, mitigations[0].Action)
 }
 
 func TestMitigator_Mitigate_UnknownAlertType(t *testing.T) {
@@ -106,6 +123,7 @@ func TestMitigator_Mitigate_UnknownAlertType(t *testing.T) {
 		mockMonitor,
 		logger,
 		metricsScope,
+		clock.NewMockedTimeSource(),
 		options,
 	)
 
@@ -371,6 +389,7 @@ func TestMitigator_collectPendingTaskStats(t *testing.T) {
 				mockMonitor,
 				logger,
 				metricsScope,
+				clock.NewMockedTimeSource(),
 				options,
 			)
 
@@ -454,6 +473,7 @@ func TestMitigator_findDomainsToClear(t *testing.T) {
 				mockMonitor,
 				logger,
 				metricsScope,
+				clock.NewMockedTimeSource(),
 				options,
 			)
 
@@ -733,3 +753,246 @@ func TestMitigator_processQueueSplitsAndClear(t *testing.T) {
 		})
 	}
 }
+
+func newTestMitigator(t *testing.T, ctrl *gomock.Controller) (*mitigatorImpl, *MockVirtualQueueManager, *MockMonitor) {
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockMonitor := NewMockMonitor(ctrl)
+	mitigator := NewMitigator(
+		mockVirtualQueueManager,
+		mockMonitor,
+		testlogger.New(t),
+		metrics.NoopScope,
+		clock.NewMockedTimeSource(),
+		&MitigatorOptions{
+			MaxVirtualQueueCount: dynamicproperties.GetIntPropertyFn(2),
+		},
+	)
+	return mitigator.(*mitigatorImpl), mockVirtualQueueManager, mockMonitor
+}
+
+// expectSplitDomain sets up the expectations of moving the given domain of the slice from the root queue to the next queue
+func expectSplitDomain(t *testing.T, ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager, rootQueue *MockVirtualQueue, slice *MockVirtualSlice, domainID string) {
+	splitSlice := NewMockVirtualSlice(ctrl)
+	remainingSlice := NewMockVirtualSlice(ctrl)
+	slice.EXPECT().TrySplitByPredicate(NewDomainIDPredicate([]string{domainID}, false)).Return(splitSlice, remainingSlice, true)
+	splitSlice.EXPECT().Clear()
+	rootQueue.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+		remaining, split := f(slice)
+		require.True(t, split)
+		require.Equal(t, []VirtualSlice{remainingSlice}, remaining)
+	})
+	nextQueue := NewMockVirtualQueue(ctrl)
+	mockVirtualQueueManager.EXPECT().GetOrCreateVirtualQueue(int64(1)).Return(nextQueue)
+	nextQueue.EXPECT().Pause(clearSliceThrottleDuration)
+	nextQueue.EXPECT().MergeSlices(splitSlice)
+}
+
+func TestMitigator_handleStuckSlice(t *testing.T) {
+	tests := map[string]struct {
+		stuckSliceExists bool
+		pendingTasks     map[string]int
+		expectedAction   string
+	}{
+		"slice no longer exists": {
+			stuckSliceExists: false,
+			expectedAction:   mitigationActionSkip,
+		},
+		"slice has no pending task": {
+			stuckSliceExists: true,
+			pendingTasks:     map[string]int{},
+			expectedAction:   mitigationActionSkip,
+		},
+		"split the domain with most pending tasks": {
+			stuckSliceExists: true,
+			pendingTasks:     map[string]int{"domain1": 1, "domain2": 5},
+			expectedAction:   mitigationActionSplitDomain,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mitigator, mockVirtualQueueManager, _ := newTestMitigator(t, ctrl)
+
+			stuckSlice := NewMockVirtualSlice(ctrl)
+			rootQueue := NewMockVirtualQueue(ctrl)
+			mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{0: rootQueue}).Times(2)
+			rootQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+				if tt.stuckSliceExists {
+					f(stuckSlice)
+				}
+			})
+			if tt.stuckSliceExists {
+				stuckSlice.EXPECT().PendingTaskStats().Return(PendingTaskStats{PendingTaskCountPerDomain: tt.pendingTasks})
+			}
+			if tt.expectedAction == mitigationActionSplitDomain {
+				expectSplitDomain(t, ctrl, mockVirtualQueueManager, rootQueue, stuckSlice, "domain2")
+			}
+
+			action := mitigator.handleStuckSlice(Alert{
+				AlertType: AlertTypeStuckSlice,
+				AlertAttributesStuckSlice: &AlertAttributesStuckSlice{
+					Slice: stuckSlice,
+				},
+			})
+			assert.Equal(t, tt.expectedAction, action)
+		})
+	}
+}
+
+func TestMitigator_handleWatermarkLag(t *testing.T) {
+	// mockQueue returns a virtual queue whose first slice starts at minTaskID and has pending tasks of the domains
+	mockQueue := func(ctrl *gomock.Controller, minTaskID int64, domains ...string) *MockVirtualQueue {
+		vq := NewMockVirtualQueue(ctrl)
+		vq.EXPECT().GetState().Return([]VirtualSliceState{{Range: Range{InclusiveMinTaskKey: persistence.NewImmediateTaskKey(minTaskID)}}}).AnyTimes()
+		slice := NewMockVirtualSlice(ctrl)
+		pendingTaskCountPerDomain := make(map[string]int)
+		for _, domain := range domains {
+			pendingTaskCountPerDomain[domain] = 10
+		}
+		slice.EXPECT().PendingTaskStats().Return(PendingTaskStats{PendingTaskCountPerDomain: pendingTaskCountPerDomain}).AnyTimes()
+		vq.EXPECT().IterateSlices(gomock.Any()).DoAndReturn(func(f func(VirtualSlice)) {
+			f(slice)
+		}).AnyTimes()
+		return vq
+	}
+
+	tests := map[string]struct {
+		setupMocks     func(*gomock.Controller) map[int64]VirtualQueue
+		expectedAction string
+	}{
+		"no virtual slice": {
+			setupMocks: func(ctrl *gomock.Controller) map[int64]VirtualQueue {
+				rootQueue := NewMockVirtualQueue(ctrl)
+				rootQueue.EXPECT().GetState().Return(nil)
+				return map[int64]VirtualQueue{0: rootQueue}
+			},
+			expectedAction: mitigationActionSkip,
+		},
+		"resume the paused queue holding the watermark": {
+			setupMocks: func(ctrl *gomock.Controller) map[int64]VirtualQueue {
+				rootQueue := mockQueue(ctrl, 10, "domain1")
+				nonRootQueue := mockQueue(ctrl, 5, "domain2")
+				nonRootQueue.EXPECT().IsPaused().Return(true)
+				nonRootQueue.EXPECT().Resume()
+				return map[int64]VirtualQueue{0: rootQueue, 1: nonRootQueue}
+			},
+			expectedAction: mitigationActionResumeQueue,
+		},
+		"throttle the queues competing for the domains of the lagging root queue": {
+			setupMocks: func(ctrl *gomock.Controller) map[int64]VirtualQueue {
+				rootQueue := mockQueue(ctrl, 5, "domain1", "domain2")
+				rootQueue.EXPECT().IsPaused().Return(false)
+				competingQueue := mockQueue(ctrl, 10, "domain2")
+				competingQueue.EXPECT().Pause(clearSliceThrottleDuration)
+				// the queue of another domain is not throttled
+				otherQueue := mockQueue(ctrl, 20, "domain3")
+				return map[int64]VirtualQueue{0: rootQueue, 1: competingQueue, 2: otherQueue}
+			},
+			expectedAction: mitigationActionThrottleQueues,
+		},
+		"the root queue is not throttled for a lagging non-root queue": {
+			setupMocks: func(ctrl *gomock.Controller) map[int64]VirtualQueue {
+				rootQueue := mockQueue(ctrl, 10, "domain1", "noisy-domain")
+				nonRootQueue := mockQueue(ctrl, 5, "noisy-domain")
+				nonRootQueue.EXPECT().IsPaused().Return(false)
+				competingQueue := mockQueue(ctrl, 20, "noisy-domain")
+				competingQueue.EXPECT().Pause(clearSliceThrottleDuration)
+				return map[int64]VirtualQueue{0: rootQueue, 1: nonRootQueue, 2: competingQueue}
+			},
+			expectedAction: mitigationActionThrottleQueues,
+		},
+		"no competing queue": {
+			setupMocks: func(ctrl *gomock.Controller) map[int64]VirtualQueue {
+				rootQueue := mockQueue(ctrl, 10, "domain1")
+				nonRootQueue := mockQueue(ctrl, 5, "noisy-domain")
+				nonRootQueue.EXPECT().IsPaused().Return(false)
+				return map[int64]VirtualQueue{0: rootQueue, 1: nonRootQueue}
+			},
+			expectedAction: mitigationActionSkip,
+		},
+		"single running queue": {
+			setupMocks: func(ctrl *gomock.Controller) map[int64]VirtualQueue {
+				rootQueue := mockQueue(ctrl, 5, "domain1")
+				rootQueue.EXPECT().IsPaused().Return(false)
+				return map[int64]VirtualQueue{0: rootQueue}
+			},
+			expectedAction: mitigationActionSkip,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mitigator, mockVirtualQueueManager, _ := newTestMitigator(t, ctrl)
+			mockVirtualQueueManager.EXPECT().VirtualQueues().Return(tt.setupMocks(ctrl))
+
+			action := mitigator.handleWatermarkLag(Alert{
+				AlertType:                   AlertTypeWatermarkLag,
+				AlertAttributesWatermarkLag: &AlertAttributesWatermarkLag{},
+			})
+			assert.Equal(t, tt.expectedAction, action)
+		})
+	}
+}
+
+func TestMitigator_handleSliceCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mitigator, mockVirtualQueueManager, _ := newTestMitigator(t, ctrl)
+
+	rootQueue := NewMockVirtualQueue(ctrl)
+	nonRootQueue := NewMockVirtualQueue(ctrl)
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{0: rootQueue, 1: nonRootQueue})
+	rootQueue.EXPECT().MergeAdjacentSlices()
+	nonRootQueue.EXPECT().MergeAdjacentSlices()
+
+	action := mitigator.handleSliceCount(Alert{
+		AlertType:                 AlertTypeSliceCount,
+		AlertAttributesSliceCount: &AlertAttributesSliceCount{CurrentSliceCount: 101, CriticalSliceCount: 100},
+	})
+	assert.Equal(t, mitigationActionMergeSlices, action)
+}
+
+func TestMitigator_handleDomainTaskRate(t *testing.T) {
+	tests := map[string]struct {
+		domainID       string
+		expectedAction string
+	}{
+		"domain has no pending task": {
+			domainID:       "domain2",
+			expectedAction: mitigationActionSkip,
+		},
+		"split the domain": {
+			domainID:       "domain1",
+			expectedAction: mitigationActionSplitDomain,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mitigator, mockVirtualQueueManager, _ := newTestMitigator(t, ctrl)
+
+			slice := NewMockVirtualSlice(ctrl)
+			rootQueue := NewMockVirtualQueue(ctrl)
+			mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{0: rootQueue}).Times(2)
+			rootQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+				f(slice)
+			})
+			slice.EXPECT().PendingTaskStats().Return(PendingTaskStats{PendingTaskCountPerDomain: map[string]int{"domain1": 10}})
+			if tt.expectedAction == mitigationActionSplitDomain {
+				expectSplitDomain(t, ctrl, mockVirtualQueueManager, rootQueue, slice, tt.domainID)
+			}
+
+			action := mitigator.handleDomainTaskRate(Alert{
+				AlertType: AlertTypeDomainTaskRate,
+				AlertAttributesDomainTaskRate: &AlertAttributesDomainTaskRate{
+					DomainID:         tt.domainID,
+					TaskRate:         200,
+					CriticalTaskRate: 100,
+				},
+			})
+			assert.Equal(t, tt.expectedAction, action)
+		})
+	}
+}
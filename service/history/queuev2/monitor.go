@@ -3,11 +3,17 @@ package queuev2
 
 import (
 	"sync"
+	"time"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 )
 
+const (
+	domainTaskRateWindow = 10 * time.Second
+)
+
 type (
 	Monitor interface {
 		Subscribe(chan<- *Alert)
@@ -16,35 +22,65 @@ type (
 		GetSlicePendingTaskCount(VirtualSlice) int
 		SetSlicePendingTaskCount(VirtualSlice, int)
 		RemoveSlice(VirtualSlice)
+		GetSliceCount() int
+		// UpdateWatermark updates the ack level of the queue, it's used to detect the lag of the queue
+		UpdateWatermark(persistence.HistoryTaskKey)
+		GetWatermarkLag() time.Duration
+		// RecordLoadedTasks records the number of tasks loaded per domain, it's used to detect the domains with high task rate
+		RecordLoadedTasks(map[string]int)
 		ResolveAlert(AlertType)
 	}
 
 	MonitorOptions struct {
 		EnablePendingTaskCountAlert func() bool
 		CriticalPendingTaskCount    dynamicproperties.IntPropertyFn
+		// The following alerts are disabled if the options are not set or the values are not positive
+		CriticalStuckSliceDuration dynamicproperties.DurationPropertyFn
+		CriticalWatermarkLag       dynamicproperties.DurationPropertyFn
+		CriticalSliceCount         dynamicproperties.IntPropertyFn
+		CriticalDomainTaskRate     dynamicproperties.IntPropertyFn
 	}
 
 	monitorImpl struct {
 		sync.Mutex
 
-		category persistence.HistoryTaskCategory
-		options  *MonitorOptions
+		category   persistence.HistoryTaskCategory
+		timeSource clock.TimeSource
+		options    *MonitorOptions
 
 		subscriber            chan<- *Alert
 		pendingAlerts         map[AlertType]struct{}
 		totalPendingTaskCount int
 		slicePendingTaskCount map[VirtualSlice]int
+		sliceProgress         map[VirtualSlice]*sliceProgress
+
+		watermark            persistence.HistoryTaskKey
+		watermarkUpdateTime  time.Time
+		domainTaskCount      map[string]int
+		domainTaskRateWindow time.Time
+	}
+
+	// sliceProgress tracks when the inclusive min task key of a virtual slice was last moved
+	sliceProgress struct {
+		inclusiveMinTaskKey persistence.HistoryTaskKey
+		lastProgressTime    time.Time
 	}
 )
 
-func NewMonitor(category persistence.HistoryTaskCategory, options *MonitorOptions) Monitor {
+func NewMonitor(category persistence.HistoryTaskCategory, timeSource clock.TimeSource, options *MonitorOptions) Monitor {
+	now := timeSource.Now()
 	return &monitorImpl{
-		category: category,
-		options:  options,
+		category:   category,
+		timeSource: timeSource,
+		options:    options,
 
 		pendingAlerts:         make(map[AlertType]struct{}),
 		totalPendingTaskCount: 0,
 		slicePendingTaskCount: make(map[VirtualSlice]int),
+		sliceProgress:         make(map[VirtualSlice]*sliceProgress),
+		watermarkUpdateTime:   now,
+		domainTaskCount:       make(map[string]int),
+		domainTaskRateWindow:  now,
 	}
 }
 
@@ -81,8 +117,8 @@ func (m *monitorImpl) SetSlicePendingTaskCount(slice VirtualSlice, count int) {
 	m.totalPendingTaskCount += count - currentSliceCount
 	m.slicePendingTaskCount[slice] = count
 
-	criticalPendingTaskCount := m.options.CriticalPendingTaskCount()
-	if m.options.EnablePendingTaskCountAlert() && criticalPendingTaskCount > 0 && m.totalPendingTaskCount > criticalPendingTaskCount {
+	criticalPendingTaskCount := getIntOption(m.options.CriticalPendingTaskCount)
+	if m.options.EnablePendingTaskCountAlert != nil && m.options.EnablePendingTaskCountAlert() && criticalPendingTaskCount > 0 && m.totalPendingTaskCount > criticalPendingTaskCount {
 		m.sendAlertLocked(&Alert{
 			AlertType: AlertTypeQueuePendingTaskCount,
 			AlertAttributesQueuePendingTaskCount: &AlertAttributesQueuePendingTaskCount{
@@ -91,6 +127,19 @@ func (m *monitorImpl) SetSlicePendingTaskCount(slice VirtualSlice, count int) {
 			},
 		})
 	}
+
+	criticalSliceCount := getIntOption(m.options.CriticalSliceCount)
+	if criticalSliceCount > 0 && len(m.slicePendingTaskCount) > criticalSliceCount {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeSliceCount,
+			AlertAttributesSliceCount: &AlertAttributesSliceCount{
+				CurrentSliceCount:  len(m.slicePendingTaskCount),
+				CriticalSliceCount: criticalSliceCount,
+			},
+		})
+	}
+
+	m.checkSliceProgressLocked(slice, count)
 }
 
 func (m *monitorImpl) RemoveSlice(slice VirtualSlice) {
@@ -101,6 +150,87 @@ func (m *monitorImpl) RemoveSlice(slice VirtualSlice) {
 		m.totalPendingTaskCount -= currentSliceCount
 		delete(m.slicePendingTaskCount, slice)
 	}
+	delete(m.sliceProgress, slice)
+}
+
+func (m *monitorImpl) GetSliceCount() int {
+	m.Lock()
+	defer m.Unlock()
+	return len(m.slicePendingTaskCount)
+}
+
+func (m *monitorImpl) UpdateWatermark(watermark persistence.HistoryTaskKey) {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.timeSource.Now()
+	if watermark.Compare(m.watermark) != 0 || m.totalPendingTaskCount == 0 {
+		m.watermark = watermark
+		m.watermarkUpdateTime = now
+		return
+	}
+
+	criticalWatermarkLag := getDurationOption(m.options.CriticalWatermarkLag)
+	lag := now.Sub(m.watermarkUpdateTime)
+	if criticalWatermarkLag > 0 && lag > criticalWatermarkLag {
+		sent := m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeWatermarkLag,
+			AlertAttributesWatermarkLag: &AlertAttributesWatermarkLag{
+				Watermark:           watermark,
+				LagDuration:         lag,
+				CriticalLagDuration: criticalWatermarkLag,
+			},
+		})
+		if sent {
+			// give the mitigation some time to take effect before alerting again
+			m.watermarkUpdateTime = now
+		}
+	}
+}
+
+func (m *monitorImpl) GetWatermarkLag() time.Duration {
+	m.Lock()
+	defer m.Unlock()
+	return m.timeSource.Now().Sub(m.watermarkUpdateTime)
+}
+
+func (m *monitorImpl) RecordLoadedTasks(taskCountPerDomain map[string]int) {
+	m.Lock()
+	defer m.Unlock()
+
+	for domainID, count := range taskCountPerDomain {
+		m.domainTaskCount[domainID] += count
+	}
+
+	now := m.timeSource.Now()
+	window := now.Sub(m.domainTaskRateWindow)
+	if window < domainTaskRateWindow {
+		return
+	}
+
+	criticalDomainTaskRate := float64(getIntOption(m.options.CriticalDomainTaskRate))
+	if criticalDomainTaskRate > 0 {
+		var maxDomainID string
+		maxTaskCount := 0
+		for domainID, count := range m.domainTaskCount {
+			if count > maxTaskCount {
+				maxDomainID, maxTaskCount = domainID, count
+			}
+		}
+		taskRate := float64(maxTaskCount) / window.Seconds()
+		if taskRate > criticalDomainTaskRate {
+			m.sendAlertLocked(&Alert{
+				AlertType: AlertTypeDomainTaskRate,
+				AlertAttributesDomainTaskRate: &AlertAttributesDomainTaskRate{
+					DomainID:         maxDomainID,
+					TaskRate:         taskRate,
+					CriticalTaskRate: criticalDomainTaskRate,
+				},
+			})
+		}
+	}
+	m.domainTaskCount = make(map[string]int)
+	m.domainTaskRateWindow = now
 }
 
 func (m *monitorImpl) ResolveAlert(alertType AlertType) {
@@ -110,16 +240,67 @@ func (m *monitorImpl) ResolveAlert(alertType AlertType) {
 	delete(m.pendingAlerts, alertType)
 }
 
-func (m *monitorImpl) sendAlertLocked(alert *Alert) {
+func (m *monitorImpl) checkSliceProgressLocked(slice VirtualSlice, count int) {
+	criticalStuckSliceDuration := getDurationOption(m.options.CriticalStuckSliceDuration)
+	if criticalStuckSliceDuration <= 0 {
+		return
+	}
+
+	now := m.timeSource.Now()
+	inclusiveMinTaskKey := slice.GetState().Range.InclusiveMinTaskKey
+	progress, ok := m.sliceProgress[slice]
+	if !ok || count == 0 || progress.inclusiveMinTaskKey.Compare(inclusiveMinTaskKey) != 0 {
+		m.sliceProgress[slice] = &sliceProgress{
+			inclusiveMinTaskKey: inclusiveMinTaskKey,
+			lastProgressTime:    now,
+		}
+		return
+	}
+
+	stuckDuration := now.Sub(progress.lastProgressTime)
+	if stuckDuration > criticalStuckSliceDuration {
+		sent := m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeStuckSlice,
+			AlertAttributesStuckSlice: &AlertAttributesStuckSlice{
+				Slice:                 slice,
+				PendingTaskCount:      count,
+				StuckDuration:         stuckDuration,
+				CriticalStuckDuration: criticalStuckSliceDuration,
+			},
+		})
+		if sent {
+			// give the mitigation some time to take effect before alerting again
+			progress.lastProgressTime = now
+		}
+	}
+}
+
+func (m *monitorImpl) sendAlertLocked(alert *Alert) bool {
 	// deduplicate alerts
 	if _, ok := m.pendingAlerts[alert.AlertType]; ok {
-		return
+		return false
 	}
 
 	select {
 	case m.subscriber <- alert:
 		m.pendingAlerts[alert.AlertType] = struct{}{}
+		return true
 	default:
 		// do not block if subscriber is not ready
+		return false
+	}
+}
+
+func getIntOption(option dynamicproperties.IntPropertyFn) int {
+	if option == nil {
+		return 0
+	}
+	return option()
+}
+
+func getDurationOption(option dynamicproperties.DurationPropertyFn) time.Duration {
+	if option == nil {
+		return 0
 	}
+	return option()
 }
@@ -11,8 +11,11 @@ package queuev2
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
+
+	persistence "github.com/uber/cadence/common/persistence"
 )
 
 // MockMonitor is a mock of Monitor interface.
@@ -39,6 +42,20 @@ func (m *MockMonitor) EXPECT() *MockMonitorMockRecorder {
 	return m.recorder
 }
 
+// GetSliceCount mocks base method.
+func (m *MockMonitor) GetSliceCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSliceCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetSliceCount indicates an expected call of GetSliceCount.
+func (mr *MockMonitorMockRecorder) GetSliceCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSliceCount", reflect.TypeOf((*MockMonitor)(nil).GetSliceCount))
+}
+
 // GetSlicePendingTaskCount mocks base method.
 func (m *MockMonitor) GetSlicePendingTaskCount(arg0 VirtualSlice) int {
 	m.ctrl.T.Helper()
@@ -67,6 +84,32 @@ func (mr *MockMonitorMockRecorder) GetTotalPendingTaskCount() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPendingTaskCount", reflect.TypeOf((*MockMonitor)(nil).GetTotalPendingTaskCount))
 }
 
+// GetWatermarkLag mocks base method.
+func (m *MockMonitor) GetWatermarkLag() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatermarkLag")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetWatermarkLag indicates an expected call of GetWatermarkLag.
+func (mr *MockMonitorMockRecorder) GetWatermarkLag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatermarkLag", reflect.TypeOf((*MockMonitor)(nil).GetWatermarkLag))
+}
+
+// RecordLoadedTasks mocks base method.
+func (m *MockMonitor) RecordLoadedTasks(arg0 map[string]int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordLoadedTasks", arg0)
+}
+
+// RecordLoadedTasks indicates an expected call of RecordLoadedTasks.
+func (mr *MockMonitorMockRecorder) RecordLoadedTasks(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoadedTasks", reflect.TypeOf((*MockMonitor)(nil).RecordLoadedTasks), arg0)
+}
+
 // RemoveSlice mocks base method.
 func (m *MockMonitor) RemoveSlice(arg0 VirtualSlice) {
 	m.ctrl.T.Helper()
@@ -126,3 +169,15 @@ func (mr *MockMonitorMockRecorder) Unsubscribe() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockMonitor)(nil).Unsubscribe))
 }
+
+// UpdateWatermark mocks base method.
+func (m *MockMonitor) UpdateWatermark(arg0 persistence.HistoryTaskKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateWatermark", arg0)
+}
+
+// UpdateWatermark indicates an expected call of UpdateWatermark.
+func (mr *MockMonitorMockRecorder) UpdateWatermark(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWatermark", reflect.TypeOf((*MockMonitor)(nil).UpdateWatermark), arg0)
+}
@@ -2,15 +2,17 @@ package queuev2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 )
 
 func TestMonitorPendingTaskCount(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{
 		CriticalPendingTaskCount:    dynamicproperties.GetIntPropertyFn(100),
 		EnablePendingTaskCountAlert: func() bool { return true },
 	})
@@ -69,7 +71,7 @@ func TestMonitorPendingTaskCount(t *testing.T) {
 }
 
 func TestMonitorSubscribeAndUnsubscribe(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{})
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{})
 
 	alertCh := make(chan *Alert, alertChSize)
 	monitor.Subscribe(alertCh)
@@ -82,7 +84,7 @@ func TestMonitorSubscribeAndUnsubscribe(t *testing.T) {
 }
 
 func TestMonitorResolveAlert(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{})
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{})
 
 	monitor.(*monitorImpl).pendingAlerts[AlertTypeQueuePendingTaskCount] = struct{}{}
 	assert.Equal(t, 1, len(monitor.(*monitorImpl).pendingAlerts))
@@ -90,3 +92,141 @@ func TestMonitorResolveAlert(t *testing.T) {
 	monitor.ResolveAlert(AlertTypeQueuePendingTaskCount)
 	assert.Equal(t, 0, len(monitor.(*monitorImpl).pendingAlerts))
 }
+
+func TestMonitorStuckSlice(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTransfer, timeSource, &MonitorOptions{
+		CriticalStuckSliceDuration: dynamicproperties.GetDurationPropertyFn(time.Minute),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	slice := &virtualSliceImpl{
+		state: VirtualSliceState{
+			Range: Range{
+				InclusiveMinTaskKey: persistence.NewImmediateTaskKey(10),
+				ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(100),
+			},
+		},
+	}
+	monitor.SetSlicePendingTaskCount(slice, 10)
+	timeSource.Advance(time.Minute)
+	monitor.SetSlicePendingTaskCount(slice, 10)
+	assert.Empty(t, alertCh)
+
+	// the slice makes progress, so the stuck duration is reset
+	slice.state.Range.InclusiveMinTaskKey = persistence.NewImmediateTaskKey(20)
+	timeSource.Advance(time.Second)
+	monitor.SetSlicePendingTaskCount(slice, 10)
+	timeSource.Advance(time.Minute)
+	monitor.SetSlicePendingTaskCount(slice, 10)
+	assert.Empty(t, alertCh)
+
+	timeSource.Advance(time.Second)
+	monitor.SetSlicePendingTaskCount(slice, 10)
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeStuckSlice, alert.AlertType)
+	assert.Equal(t, slice, alert.AlertAttributesStuckSlice.Slice)
+	assert.Equal(t, 10, alert.AlertAttributesStuckSlice.PendingTaskCount)
+	assert.Equal(t, time.Minute+time.Second, alert.AlertAttributesStuckSlice.StuckDuration)
+	assert.Equal(t, time.Minute, alert.AlertAttributesStuckSlice.CriticalStuckDuration)
+
+	monitor.RemoveSlice(slice)
+	assert.Empty(t, monitor.(*monitorImpl).sliceProgress)
+}
+
+func TestMonitorWatermarkLag(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTransfer, timeSource, &MonitorOptions{
+		CriticalWatermarkLag: dynamicproperties.GetDurationPropertyFn(time.Minute),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	// no alert is sent when there is no pending task
+	monitor.UpdateWatermark(persistence.NewImmediateTaskKey(10))
+	timeSource.Advance(2 * time.Minute)
+	monitor.UpdateWatermark(persistence.NewImmediateTaskKey(10))
+	assert.Empty(t, alertCh)
+	assert.Equal(t, time.Duration(0), monitor.GetWatermarkLag())
+
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 10)
+	timeSource.Advance(time.Minute)
+	monitor.UpdateWatermark(persistence.NewImmediateTaskKey(10))
+	assert.Empty(t, alertCh)
+	assert.Equal(t, time.Minute, monitor.GetWatermarkLag())
+
+	timeSource.Advance(time.Second)
+	monitor.UpdateWatermark(persistence.NewImmediateTaskKey(10))
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeWatermarkLag, alert.AlertType)
+	assert.Equal(t, persistence.NewImmediateTaskKey(10), alert.AlertAttributesWatermarkLag.Watermark)
+	assert.Equal(t, time.Minute+time.Second, alert.AlertAttributesWatermarkLag.LagDuration)
+	assert.Equal(t, time.Minute, alert.AlertAttributesWatermarkLag.CriticalLagDuration)
+
+	monitor.UpdateWatermark(persistence.NewImmediateTaskKey(11))
+	assert.Equal(t, time.Duration(0), monitor.GetWatermarkLag())
+}
+
+func TestMonitorSliceCount(t *testing.T) {
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTransfer, clock.NewMockedTimeSource(), &MonitorOptions{
+		CriticalSliceCount: dynamicproperties.GetIntPropertyFn(2),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 1)
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 1)
+	assert.Empty(t, alertCh)
+	assert.Equal(t, 2, monitor.GetSliceCount())
+
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 1)
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeSliceCount, alert.AlertType)
+	assert.Equal(t, 3, alert.AlertAttributesSliceCount.CurrentSliceCount)
+	assert.Equal(t, 2, alert.AlertAttributesSliceCount.CriticalSliceCount)
+}
+
+func TestMonitorDomainTaskRate(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTransfer, timeSource, &MonitorOptions{
+		CriticalDomainTaskRate: dynamicproperties.GetIntPropertyFn(10),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	// the rate is only evaluated at the end of each window
+	monitor.RecordLoadedTasks(map[string]int{"domain1": 20})
+	assert.Empty(t, alertCh)
+
+	timeSource.Advance(domainTaskRateWindow)
+	monitor.RecordLoadedTasks(map[string]int{"domain1": 50, "domain2": 30})
+	assert.Empty(t, alertCh)
+
+	timeSource.Advance(domainTaskRateWindow)
+	monitor.RecordLoadedTasks(map[string]int{"domain1": 50, "domain2": 101})
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeDomainTaskRate, alert.AlertType)
+	assert.Equal(t, "domain2", alert.AlertAttributesDomainTaskRate.DomainID)
+	assert.Equal(t, 10.1, alert.AlertAttributesDomainTaskRate.TaskRate)
+	assert.Equal(t, 10.0, alert.AlertAttributesDomainTaskRate.CriticalTaskRate)
+}
+
+func TestMonitorAlertsDisabled(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTransfer, timeSource, &MonitorOptions{
+		CriticalSliceCount:     dynamicproperties.GetIntPropertyFn(0),
+		CriticalDomainTaskRate: dynamicproperties.GetIntPropertyFn(0),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	// the slice has no state, so it would panic if the stuck slice alert was evaluated
+	var slice *virtualSliceImpl
+	monitor.SetSlicePendingTaskCount(slice, 10)
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 10)
+	timeSource.Advance(time.Hour)
+	monitor.UpdateWatermark(persistence.NewImmediateTaskKey(0))
+	monitor.RecordLoadedTasks(map[string]int{"domain1": 1000000})
+	assert.Empty(t, alertCh)
+}
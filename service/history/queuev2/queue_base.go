@@ -24,6 +24,9 @@ package queuev2
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/uber/cadence/common/backoff"
@@ -65,6 +68,10 @@ type (
 		CriticalPendingTaskCount    dynamicproperties.IntPropertyFn
 		EnablePendingTaskCountAlert func() bool
 		MaxVirtualQueueCount        dynamicproperties.IntPropertyFn
+		CriticalStuckSliceDuration  dynamicproperties.DurationPropertyFn
+		CriticalWatermarkLag        dynamicproperties.DurationPropertyFn
+		CriticalSliceCount          dynamicproperties.IntPropertyFn
+		CriticalDomainTaskRate      dynamicproperties.IntPropertyFn
 
 		EnableValidator        dynamicproperties.BoolPropertyFn
 		ValidationInterval     dynamicproperties.DurationPropertyFn
@@ -145,9 +152,14 @@ func newQueueBase(
 	)
 	monitor := NewMonitor(
 		category,
+		timeSource,
 		&MonitorOptions{
 			CriticalPendingTaskCount:    options.CriticalPendingTaskCount,
 			EnablePendingTaskCountAlert: options.EnablePendingTaskCountAlert,
+			CriticalStuckSliceDuration:  options.CriticalStuckSliceDuration,
+			CriticalWatermarkLag:        options.CriticalWatermarkLag,
+			CriticalSliceCount:          options.CriticalSliceCount,
+			CriticalDomainTaskRate:      options.CriticalDomainTaskRate,
 		},
 	)
 	virtualQueueManager := NewVirtualQueueManager(
@@ -187,6 +199,7 @@ func newQueueBase(
 		monitor,
 		logger,
 		metricsScope,
+		timeSource,
 		&MitigatorOptions{
 			MaxVirtualQueueCount: options.MaxVirtualQueueCount,
 		},
@@ -248,7 +261,39 @@ func (q *queueBase) FailoverDomain(domainIDs map[string]struct{}) {
 }
 
 func (q *queueBase) HandleAction(ctx context.Context, clusterName string, action *queue.Action) (*queue.ActionResult, error) {
-	return nil, nil
+	if action.ActionType != queue.ActionTypeGetState {
+		return nil, nil
+	}
+	return &queue.ActionResult{
+		ActionType: queue.ActionTypeGetState,
+		GetStateActionResult: &queue.GetStateActionResult{
+			Descriptions: q.describe(),
+		},
+	}, nil
+}
+
+func (q *queueBase) describe() []string {
+	descriptions := []string{
+		fmt.Sprintf("pending task count: %d, virtual slice count: %d, watermark lag: %v",
+			q.monitor.GetTotalPendingTaskCount(), q.monitor.GetSliceCount(), q.monitor.GetWatermarkLag()),
+	}
+
+	virtualQueues := q.virtualQueueManager.VirtualQueues()
+	queueIDs := slices.Sorted(maps.Keys(virtualQueues))
+	for _, queueID := range queueIDs {
+		vq := virtualQueues[queueID]
+		var ranges []string
+		for _, state := range vq.GetState() {
+			ranges = append(ranges, fmt.Sprintf("[%v, %v)", state.Range.InclusiveMinTaskKey, state.Range.ExclusiveMaxTaskKey))
+		}
+		descriptions = append(descriptions, fmt.Sprintf("virtual queue %d, paused: %v, virtual slices: %v", queueID, vq.IsPaused(), ranges))
+	}
+
+	for _, mitigation := range q.mitigator.GetLastMitigations() {
+		descriptions = append(descriptions, fmt.Sprintf("last %v alert at %v: %v, mitigation: %v",
+			mitigation.Alert.AlertType, mitigation.Time.Format(time.RFC3339), mitigation.Alert.String(), mitigation.Action))
+	}
+	return descriptions
 }
 
 func (q *queueBase) LockTaskProcessing() {}
@@ -282,6 +327,9 @@ func (q *queueBase) updateQueueState(ctx context.Context) {
 	}
 	newExclusiveAckLevel, maxQueueID := getExclusiveAckLevelAndMaxQueueIDFromQueueState(queueState)
 	q.metricsScope.UpdateGauge(metrics.VirtualQueueCountGauge, float64(maxQueueID+1))
+	q.monitor.UpdateWatermark(newExclusiveAckLevel)
+	q.metricsScope.UpdateGauge(metrics.VirtualSliceCountGauge, float64(q.monitor.GetSliceCount()))
+	q.metricsScope.RecordTimer(metrics.VirtualQueueWatermarkLagTimer, q.monitor.GetWatermarkLag())
 
 	// for backward compatibility, we record the timer metrics in shard info scope
 	pendingTaskCount := q.monitor.GetTotalPendingTaskCount()
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common/clock"
@@ -15,6 +16,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -203,6 +205,9 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 				mockMitigator := NewMockMitigator(ctrl)
 
 				mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(100).Times(1)
+				mockMonitor.EXPECT().UpdateWatermark(persistence.NewImmediateTaskKey(200))
+				mockMonitor.EXPECT().GetSliceCount().Return(1)
+				mockMonitor.EXPECT().GetWatermarkLag().Return(time.Second)
 				mockShard.EXPECT().GetExecutionManager().Return(mockExecutionManager).AnyTimes()
 				mockExecutionManager.EXPECT().RangeCompleteHistoryTask(gomock.Any(), &persistence.RangeCompleteHistoryTaskRequest{
 					TaskCategory:        persistence.HistoryTaskCategoryTransfer,
@@ -255,6 +260,9 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 				mockMitigator := NewMockMitigator(ctrl)
 
 				mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(100).Times(1)
+				mockMonitor.EXPECT().UpdateWatermark(persistence.NewImmediateTaskKey(200))
+				mockMonitor.EXPECT().GetSliceCount().Return(1)
+				mockMonitor.EXPECT().GetWatermarkLag().Return(time.Second)
 				mockShard.EXPECT().GetExecutionManager().Return(mockExecutionManager).AnyTimes()
 				mockExecutionManager.EXPECT().RangeCompleteHistoryTask(gomock.Any(), &persistence.RangeCompleteHistoryTaskRequest{
 					TaskCategory:        persistence.HistoryTaskCategoryTransfer,
@@ -300,6 +308,9 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 				mockMitigator := NewMockMitigator(ctrl)
 
 				mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(100).Times(1)
+				mockMonitor.EXPECT().UpdateWatermark(persistence.NewImmediateTaskKey(100))
+				mockMonitor.EXPECT().GetSliceCount().Return(1)
+				mockMonitor.EXPECT().GetWatermarkLag().Return(time.Second)
 				mockShard.EXPECT().UpdateQueueState(
 					persistence.HistoryTaskCategoryTransfer,
 					gomock.Any(),
@@ -342,6 +353,9 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 				mockMitigator := NewMockMitigator(ctrl)
 
 				mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(100).Times(1)
+				mockMonitor.EXPECT().UpdateWatermark(persistence.NewImmediateTaskKey(200))
+				mockMonitor.EXPECT().GetSliceCount().Return(1)
+				mockMonitor.EXPECT().GetWatermarkLag().Return(time.Second)
 				mockShard.EXPECT().UpdateQueueState(
 					persistence.HistoryTaskCategoryTransfer,
 					gomock.Any(),
@@ -545,3 +559,64 @@ func TestNewQueueBase(t *testing.T) {
 		},
 	}, states)
 }
+
+func TestQueueBase_HandleAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockMonitor := NewMockMonitor(ctrl)
+	mockMitigator := NewMockMitigator(ctrl)
+	mockRootQueue := NewMockVirtualQueue(ctrl)
+	mockNonRootQueue := NewMockVirtualQueue(ctrl)
+
+	mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(100)
+	mockMonitor.EXPECT().GetSliceCount().Return(2)
+	mockMonitor.EXPECT().GetWatermarkLag().Return(time.Minute)
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{0: mockRootQueue, 1: mockNonRootQueue})
+	mockRootQueue.EXPECT().GetState().Return([]VirtualSliceState{
+		{Range: Range{InclusiveMinTaskKey: persistence.NewImmediateTaskKey(10), ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(20)}},
+	})
+	mockRootQueue.EXPECT().IsPaused().Return(false)
+	mockNonRootQueue.EXPECT().GetState().Return([]VirtualSliceState{
+		{Range: Range{InclusiveMinTaskKey: persistence.NewImmediateTaskKey(5), ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(10)}},
+	})
+	mockNonRootQueue.EXPECT().IsPaused().Return(true)
+	mockMitigator.EXPECT().GetLastMitigations().Return([]Mitigation{
+		{
+			Alert: Alert{
+				AlertType: AlertTypeDomainTaskRate,
+				AlertAttributesDomainTaskRate: &AlertAttributesDomainTaskRate{
+					DomainID:         "domain1",
+					TaskRate:         200,
+					CriticalTaskRate: 100,
+				},
+			},
+			Action: mitigationActionSplitDomain,
+			Time:   time.Unix(0, 0).UTC(),
+		},
+	})
+
+	queueBase := &queueBase{
+		logger:              testlogger.New(t),
+		category:            persistence.HistoryTaskCategoryTransfer,
+		monitor:             mockMonitor,
+		mitigator:           mockMitigator,
+		virtualQueueManager: mockVirtualQueueManager,
+	}
+
+	result, err := queueBase.HandleAction(context.Background(), "cluster", queue.NewGetStateAction())
+	require.NoError(t, err)
+	assert.Equal(t, queue.ActionTypeGetState, result.ActionType)
+	descriptions := result.GetStateActionResult.Descriptions
+	require.Len(t, descriptions, 4)
+	assert.Equal(t, "pending task count: 100, virtual slice count: 2, watermark lag: 1m0s", descriptions[0])
+	assert.Contains(t, descriptions[1], "virtual queue 0, paused: false")
+	assert.Contains(t, descriptions[2], "virtual queue 1, paused: true")
+	assert.Contains(t, descriptions[3], "last domain_task_rate alert at 1970-01-01T00:00:00Z")
+	assert.Contains(t, descriptions[3], "domain1")
+	assert.Contains(t, descriptions[3], "mitigation: split_domain")
+
+	result, err = queueBase.HandleAction(context.Background(), "cluster", queue.NewResetAction())
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
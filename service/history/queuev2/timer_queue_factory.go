@@ -156,6 +156,10 @@ func (f *timerQueueFactory) createQueuev2(
 			CriticalPendingTaskCount:             config.QueueCriticalPendingTaskCount,
 			EnablePendingTaskCountAlert:          func() bool { return config.EnableTimerQueueV2PendingTaskCountAlert(shard.GetShardID()) },
 			MaxVirtualQueueCount:                 config.QueueMaxVirtualQueueCount,
+			CriticalStuckSliceDuration:           config.QueueCriticalStuckSliceDuration,
+			CriticalWatermarkLag:                 config.QueueCriticalWatermarkLag,
+			CriticalSliceCount:                   config.QueueCriticalSliceCount,
+			CriticalDomainTaskRate:               config.QueueCriticalDomainTaskRate,
 		},
 	)
 }
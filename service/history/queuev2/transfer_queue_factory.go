@@ -165,6 +165,10 @@ func (f *transferQueueFactory) createQueuev2(
 			CriticalPendingTaskCount:             config.QueueCriticalPendingTaskCount,
 			EnablePendingTaskCountAlert:          func() bool { return config.EnableTransferQueueV2PendingTaskCountAlert(shard.GetShardID()) },
 			MaxVirtualQueueCount:                 config.QueueMaxVirtualQueueCount,
+			CriticalStuckSliceDuration:           config.QueueCriticalStuckSliceDuration,
+			CriticalWatermarkLag:                 config.QueueCriticalWatermarkLag,
+			CriticalSliceCount:                   config.QueueCriticalSliceCount,
+			CriticalDomainTaskRate:               config.QueueCriticalDomainTaskRate,
 		},
 	)
 }
@@ -65,8 +65,14 @@ type (
 		ClearSlices(func(VirtualSlice) bool)
 		// SplitSlices applies the split function to the slices in the virtual queue and return the remaining slices that should be kept in the virtual queue and whether the split is applied
 		SplitSlices(func(VirtualSlice) (remaining []VirtualSlice, split bool))
+		// MergeAdjacentSlices tries to merge the adjacent slices in the virtual queue to reduce the number of slices
+		MergeAdjacentSlices()
 		// Pause pauses the virtual queue for a while
 		Pause(time.Duration)
+		// Resume resumes the virtual queue immediately if it's paused
+		Resume()
+		// IsPaused returns whether the virtual queue is paused
+		IsPaused() bool
 	}
 
 	VirtualQueueOptions struct {
@@ -313,10 +319,32 @@ func (q *virtualQueueImpl) SplitSlices(f func(VirtualSlice) (remaining []Virtual
 	q.resetNextReadSliceLocked()
 }
 
+func (q *virtualQueueImpl) MergeAdjacentSlices() {
+	q.Lock()
+	defer q.Unlock()
+
+	mergedSlices := list.New()
+	for e := q.virtualSlices.Front(); e != nil; e = e.Next() {
+		q.appendOrMergeSlice(mergedSlices, e.Value.(VirtualSlice))
+	}
+
+	q.virtualSlices.Init()
+	q.virtualSlices = mergedSlices
+	q.resetNextReadSliceLocked()
+}
+
 func (q *virtualQueueImpl) Pause(duration time.Duration) {
 	q.pauseController.Pause(duration)
 }
 
+func (q *virtualQueueImpl) Resume() {
+	q.pauseController.Resume()
+}
+
+func (q *virtualQueueImpl) IsPaused() bool {
+	return q.pauseController.IsPaused()
+}
+
 func (q *virtualQueueImpl) notify() {
 	select {
 	case q.notifyCh <- struct{}{}:
@@ -392,6 +420,14 @@ func (q *virtualQueueImpl) loadAndSubmitTasks() {
 
 	q.monitor.SetSlicePendingTaskCount(sliceToRead, sliceToRead.GetPendingTaskCount())
 
+	if len(tasks) > 0 {
+		loadedTaskCountPerDomain := make(map[string]int)
+		for _, task := range tasks {
+			loadedTaskCountPerDomain[task.GetDomainID()]++
+		}
+		q.monitor.RecordLoadedTasks(loadedTaskCountPerDomain)
+	}
+
 	now := q.timeSource.Now()
 	for _, task := range tasks {
 		if persistence.IsTaskCorrupted(task) {
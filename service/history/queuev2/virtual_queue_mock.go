@@ -82,6 +82,20 @@ func (mr *MockVirtualQueueMockRecorder) GetState() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockVirtualQueue)(nil).GetState))
 }
 
+// IsPaused mocks base method.
+func (m *MockVirtualQueue) IsPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPaused indicates an expected call of IsPaused.
+func (mr *MockVirtualQueueMockRecorder) IsPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPaused", reflect.TypeOf((*MockVirtualQueue)(nil).IsPaused))
+}
+
 // IterateSlices mocks base method.
 func (m *MockVirtualQueue) IterateSlices(arg0 func(VirtualSlice)) {
 	m.ctrl.T.Helper()
@@ -94,6 +108,18 @@ func (mr *MockVirtualQueueMockRecorder) IterateSlices(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateSlices", reflect.TypeOf((*MockVirtualQueue)(nil).IterateSlices), arg0)
 }
 
+// MergeAdjacentSlices mocks base method.
+func (m *MockVirtualQueue) MergeAdjacentSlices() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MergeAdjacentSlices")
+}
+
+// MergeAdjacentSlices indicates an expected call of MergeAdjacentSlices.
+func (mr *MockVirtualQueueMockRecorder) MergeAdjacentSlices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeAdjacentSlices", reflect.TypeOf((*MockVirtualQueue)(nil).MergeAdjacentSlices))
+}
+
 // MergeSlices mocks base method.
 func (m *MockVirtualQueue) MergeSlices(arg0 ...VirtualSlice) {
 	m.ctrl.T.Helper()
@@ -134,6 +160,18 @@ func (mr *MockVirtualQueueMockRecorder) Pause(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockVirtualQueue)(nil).Pause), arg0)
 }
 
+// Resume mocks base method.
+func (m *MockVirtualQueue) Resume() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Resume")
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockVirtualQueueMockRecorder) Resume() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockVirtualQueue)(nil).Resume))
+}
+
 // SplitSlices mocks base method.
 func (m *MockVirtualQueue) SplitSlices(arg0 func(VirtualSlice) ([]VirtualSlice, bool)) {
 	m.ctrl.T.Helper()
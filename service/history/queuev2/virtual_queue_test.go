@@ -635,19 +635,19 @@ func TestVirtualQueue_LoadAndSubmitTasks(t *testing.T) {
 	}
 
 	mockTask1 := task.NewMockTask(ctrl)
-	mockTask1.EXPECT().GetDomainID().Return("some random domainID")
+	mockTask1.EXPECT().GetDomainID().Return("some random domainID").Times(2)
 	mockTask1.EXPECT().GetWorkflowID().Return("some random workflowID")
 	mockTask1.EXPECT().GetRunID().Return("some random runID")
 	mockTask1.EXPECT().GetTaskKey().Return(persistence.NewHistoryTaskKey(mockTimeSource.Now().Add(time.Second*-1), 1))
 	mockTask1.EXPECT().GetVisibilityTimestamp().Return(mockTimeSource.Now().Add(time.Second * -1))
 	mockTask1.EXPECT().SetInitialSubmitTime(gomock.Any()).Times(1)
 	mockTask2 := task.NewMockTask(ctrl)
-	mockTask2.EXPECT().GetDomainID().Return("some random domainID")
+	mockTask2.EXPECT().GetDomainID().Return("some random domainID").Times(2)
 	mockTask2.EXPECT().GetWorkflowID().Return("some random workflowID")
 	mockTask2.EXPECT().GetRunID().Return("some random runID")
 	mockTask2.EXPECT().GetTaskKey().Return(persistence.NewHistoryTaskKey(mockTimeSource.Now().Add(time.Second*1), 2))
 	mockTask3 := task.NewMockTask(ctrl)
-	mockTask3.EXPECT().GetDomainID().Return("some random domainID")
+	mockTask3.EXPECT().GetDomainID().Return("some random domainID").Times(2)
 	mockTask3.EXPECT().GetWorkflowID().Return("some random workflowID")
 	mockTask3.EXPECT().GetRunID().Return("some random runID")
 	mockTask3.EXPECT().GetTaskKey().Return(persistence.NewHistoryTaskKey(mockTimeSource.Now().Add(time.Second*-1), 1))
@@ -659,6 +659,7 @@ func TestVirtualQueue_LoadAndSubmitTasks(t *testing.T) {
 	mockVirtualSlice1.EXPECT().GetTasks(gomock.Any(), 10).Return([]task.Task{mockTask1, mockTask2}, nil)
 	mockVirtualSlice1.EXPECT().GetPendingTaskCount().Return(2)
 	mockMonitor.EXPECT().SetSlicePendingTaskCount(mockVirtualSlice1, 2)
+	mockMonitor.EXPECT().RecordLoadedTasks(map[string]int{"some random domainID": 2})
 	mockVirtualSlice1.EXPECT().HasMoreTasks().Return(false)
 
 	mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(0)
@@ -667,6 +668,7 @@ func TestVirtualQueue_LoadAndSubmitTasks(t *testing.T) {
 	mockVirtualSlice2.EXPECT().HasMoreTasks().Return(false)
 	mockVirtualSlice2.EXPECT().GetPendingTaskCount().Return(1)
 	mockMonitor.EXPECT().SetSlicePendingTaskCount(mockVirtualSlice2, 1)
+	mockMonitor.EXPECT().RecordLoadedTasks(map[string]int{"some random domainID": 1})
 	mockProcessor.EXPECT().TrySubmit(mockTask3).Return(false, nil)
 
 	mockProcessor.EXPECT().TrySubmit(mockTask1).Return(true, nil)
@@ -1791,3 +1793,79 @@ func TestVirtualQueue_MergeWithLastSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestVirtualQueue_MergeAdjacentSlices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockMonitor := NewMockMonitor(ctrl)
+	mockVirtualSlice1 := NewMockVirtualSlice(ctrl)
+	mockVirtualSlice2 := NewMockVirtualSlice(ctrl)
+	mockVirtualSlice3 := NewMockVirtualSlice(ctrl)
+	mockMergedSlice := NewMockVirtualSlice(ctrl)
+
+	mockVirtualSlice1.EXPECT().GetPendingTaskCount().Return(1)
+	mockMonitor.EXPECT().SetSlicePendingTaskCount(mockVirtualSlice1, 1)
+	mockVirtualSlice1.EXPECT().TryMergeWithVirtualSlice(mockVirtualSlice2).Return([]VirtualSlice{mockMergedSlice}, true)
+	mockMonitor.EXPECT().RemoveSlice(mockVirtualSlice1)
+	mockMonitor.EXPECT().RemoveSlice(mockVirtualSlice2)
+	mockMergedSlice.EXPECT().GetPendingTaskCount().Return(3)
+	mockMonitor.EXPECT().SetSlicePendingTaskCount(mockMergedSlice, 3)
+	mockMergedSlice.EXPECT().TryMergeWithVirtualSlice(mockVirtualSlice3).Return(nil, false)
+	mockVirtualSlice3.EXPECT().GetPendingTaskCount().Return(4)
+	mockMonitor.EXPECT().SetSlicePendingTaskCount(mockVirtualSlice3, 4)
+	mockMergedSlice.EXPECT().HasMoreTasks().Return(false)
+	mockVirtualSlice3.EXPECT().HasMoreTasks().Return(true)
+
+	queue := NewVirtualQueue(
+		task.NewMockProcessor(ctrl),
+		task.NewMockRescheduler(ctrl),
+		testlogger.New(t),
+		metrics.NoopScope,
+		clock.NewMockedTimeSource(),
+		quotas.NewMockLimiter(ctrl),
+		mockMonitor,
+		[]VirtualSlice{mockVirtualSlice1, mockVirtualSlice2, mockVirtualSlice3},
+		&VirtualQueueOptions{
+			PageSize:                             dynamicproperties.GetIntPropertyFn(10),
+			MaxPendingTasksCount:                 dynamicproperties.GetIntPropertyFn(100),
+			PollBackoffInterval:                  dynamicproperties.GetDurationPropertyFn(time.Second * 10),
+			PollBackoffIntervalJitterCoefficient: dynamicproperties.GetFloatPropertyFn(0.0),
+		},
+	)
+
+	queue.MergeAdjacentSlices()
+
+	var slices []VirtualSlice
+	queue.IterateSlices(func(slice VirtualSlice) {
+		slices = append(slices, slice)
+	})
+	assert.Equal(t, []VirtualSlice{mockMergedSlice, mockVirtualSlice3}, slices)
+	assert.Equal(t, mockVirtualSlice3, queue.(*virtualQueueImpl).sliceToRead.Value.(VirtualSlice))
+}
+
+func TestVirtualQueue_PauseAndResume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queue := NewVirtualQueue(
+		task.NewMockProcessor(ctrl),
+		task.NewMockRescheduler(ctrl),
+		testlogger.New(t),
+		metrics.NoopScope,
+		clock.NewMockedTimeSource(),
+		quotas.NewMockLimiter(ctrl),
+		NewMockMonitor(ctrl),
+		nil,
+		&VirtualQueueOptions{
+			PageSize:                             dynamicproperties.GetIntPropertyFn(10),
+			MaxPendingTasksCount:                 dynamicproperties.GetIntPropertyFn(100),
+			PollBackoffInterval:                  dynamicproperties.GetDurationPropertyFn(time.Second * 10),
+			PollBackoffIntervalJitterCoefficient: dynamicproperties.GetFloatPropertyFn(0.0),
+		},
+	)
+
+	assert.False(t, queue.IsPaused())
+	queue.Pause(time.Minute)
+	assert.True(t, queue.IsPaused())
+	queue.Resume()
+	assert.False(t, queue.IsPaused())
+}
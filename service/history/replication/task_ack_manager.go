@@ -127,7 +127,8 @@ func (t *TaskAckManager) getTasks(ctx context.Context, pollingCluster string, la
 	var oldestUnprocessedTaskTimestamp int64
 	previousReadTaskID := t.ackLevels.GetQueueClusterAckLevel(persistence.HistoryTaskCategoryReplication, pollingCluster).GetTaskID()
 
-	if lastReadTaskID == constants.EmptyMessageID {
+	withoutReadLevel := lastReadTaskID == constants.EmptyMessageID
+	if withoutReadLevel {
 		lastReadTaskID = previousReadTaskID
 	}
 
@@ -198,7 +199,11 @@ func (t *TaskAckManager) getTasks(ctx context.Context, pollingCluster string, la
 	t.scope.RecordTimer(metrics.ReplicationTasksReturned, time.Duration(len(msgs.ReplicationTasks)))
 	t.scope.RecordTimer(metrics.ReplicationTasksReturnedDiff, time.Duration(len(taskInfos)-len(msgs.ReplicationTasks)))
 
-	t.ackLevel(pollingCluster, lastReadTaskID)
+	// a request without a read level does not carry the progress of the polling cluster,
+	// e.g. a probe of the replication lag, so it must not move the ack level of the cluster
+	if !withoutReadLevel {
+		t.ackLevel(pollingCluster, lastReadTaskID)
+	}
 
 	t.logger.Debug(
 		"Get replication tasks",
@@ -32,6 +32,7 @@ import (
 
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -398,10 +399,39 @@ func TestTaskAckManager_GetTasks(t *testing.T) {
 	}
 }
 
+func TestTaskAckManager_GetTasks_WithoutReadLevelDoesNotAck(t *testing.T) {
+	ackLevels := &fakeAckLevelStore{
+		readLevel: 200,
+		remote:    map[string]persistence.HistoryTaskKey{testClusterA: persistence.NewImmediateTaskKey(12)},
+	}
+	ackManager := NewTaskAckManager(
+		testShardID,
+		ackLevels,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoop(),
+		fakeTaskReader{&testTask12},
+		createTestTaskStore(t, fakeDomainCache{testDomainID: testDomain}, fakeTaskHydrator{testTask12.TaskID: testHydratedTask12}),
+		clock.NewMockedTimeSource(),
+		testConfig,
+		proto.ReplicationMessagesSize,
+		fakeDynamicTaskBatchSizer(10),
+	)
+
+	result, err := ackManager.GetTasks(context.Background(), testClusterA, constants.EmptyMessageID)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.ReplicationTask{&testHydratedTask12}, result.ReplicationTasks)
+	assert.Zero(t, ackLevels.updates)
+
+	_, err = ackManager.GetTasks(context.Background(), testClusterA, 12)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ackLevels.updates)
+}
+
 type fakeAckLevelStore struct {
 	remote    map[string]persistence.HistoryTaskKey
 	readLevel int64
 	updateErr error
+	updates   int
 }
 
 func (s *fakeAckLevelStore) UpdateIfNeededAndGetQueueMaxReadLevel(category persistence.HistoryTaskCategory, cluster string) persistence.HistoryTaskKey {
@@ -412,6 +442,7 @@ func (s *fakeAckLevelStore) GetQueueClusterAckLevel(category persistence.History
 }
 func (s *fakeAckLevelStore) UpdateQueueClusterAckLevel(category persistence.HistoryTaskCategory, cluster string, lastTaskID persistence.HistoryTaskKey) error {
 	s.remote[cluster] = lastTaskID
+	s.updates++
 	return s.updateErr
 }
 
@@ -31,6 +31,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/activecluster"
 	"github.com/uber/cadence/common/backoff"
@@ -110,6 +112,8 @@ type (
 		ReplicateFailoverMarkers(ctx context.Context, markers []*persistence.FailoverMarkerTask) error
 		AddingPendingFailoverMarker(*types.FailoverMarkerAttributes) error
 		ValidateAndUpdateFailoverMarkers() ([]*types.FailoverMarkerAttributes, error)
+
+		GetTaskSpanContext(taskID int64) trace.SpanContext
 	}
 
 	contextImpl struct {
@@ -144,6 +148,9 @@ type (
 
 		// true if previous owner was different from the acquirer's identity.
 		previousShardOwnerWasDifferent bool
+
+		// taskID -> span context of the request which created the task, best effort and in memory only
+		taskSpanContexts cache.Cache
 	}
 )
 
@@ -173,6 +180,8 @@ const (
 	historySizeLogThreshold     = 10 * 1024 * 1024
 	minContextTimeout           = 1 * time.Second
 	activeClusterLookupTimeout  = 1 * time.Second
+	taskSpanContextCacheSize    = 10000
+	taskSpanContextCacheTTL     = 10 * time.Minute
 )
 
 func (s *contextImpl) GetShardID() int {
@@ -671,6 +680,7 @@ func (s *contextImpl) CreateWorkflowExecution(
 		// Update MaxReadLevel if write to DB succeeds
 		s.updateMaxReadLevelLocked(immediateTaskMaxReadLevel)
 		s.logCreateWorkflowExecutionEvents(request)
+		s.recordTaskSpanContexts(ctx, request.NewWorkflowSnapshot.TasksByCategory)
 		return response, nil
 	case *types.WorkflowExecutionAlreadyStartedError,
 		*persistence.WorkflowExecutionAlreadyStartedError,
@@ -712,6 +722,34 @@ func (s *contextImpl) CreateWorkflowExecution(
 	}
 }
 
+// recordTaskSpanContexts remembers the span of the request which created the given tasks,
+// so that task processing on this host can link back to it
+func (s *contextImpl) recordTaskSpanContexts(
+	ctx context.Context,
+	tasksByCategory map[persistence.HistoryTaskCategory][]persistence.Task,
+) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if s.taskSpanContexts == nil || !spanContext.IsSampled() {
+		return
+	}
+	for _, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			s.taskSpanContexts.Put(task.GetTaskID(), spanContext)
+		}
+	}
+}
+
+func (s *contextImpl) GetTaskSpanContext(taskID int64) trace.SpanContext {
+	if s.taskSpanContexts == nil {
+		return trace.SpanContext{}
+	}
+	spanContext, ok := s.taskSpanContexts.Get(taskID).(trace.SpanContext)
+	if !ok {
+		return trace.SpanContext{}
+	}
+	return spanContext
+}
+
 func (s *contextImpl) getDefaultEncoding(domainName string) constants.EncodingType {
 	return constants.EncodingType(s.config.EventEncodingType(domainName))
 }
@@ -776,6 +814,10 @@ func (s *contextImpl) UpdateWorkflowExecution(
 		// Update MaxReadLevel if write to DB succeeds
 		s.updateMaxReadLevelLocked(immediateTaskMaxReadLevel)
 		s.logUpdateWorkflowExecutionEvents(request)
+		s.recordTaskSpanContexts(ctx, request.UpdateWorkflowMutation.TasksByCategory)
+		if request.NewWorkflowSnapshot != nil {
+			s.recordTaskSpanContexts(ctx, request.NewWorkflowSnapshot.TasksByCategory)
+		}
 		return resp, nil
 	case *persistence.ConditionFailedError,
 		*persistence.DuplicateRequestError,
@@ -886,6 +928,13 @@ func (s *contextImpl) ConflictResolveWorkflowExecution(
 		// Update MaxReadLevel if write to DB succeeds
 		s.updateMaxReadLevelLocked(immediateTaskMaxReadLevel)
 		s.logConflictResolveWorkflowExecutionEvents(request)
+		if request.CurrentWorkflowMutation != nil {
+			s.recordTaskSpanContexts(ctx, request.CurrentWorkflowMutation.TasksByCategory)
+		}
+		s.recordTaskSpanContexts(ctx, request.ResetWorkflowSnapshot.TasksByCategory)
+		if request.NewWorkflowSnapshot != nil {
+			s.recordTaskSpanContexts(ctx, request.NewWorkflowSnapshot.TasksByCategory)
+		}
 		return resp, nil
 	case *persistence.ConditionFailedError,
 		*types.ServiceBusyError:
@@ -1628,6 +1677,10 @@ func acquireShard(
 		throttledLogger:                shardItem.throttledLogger,
 		previousShardOwnerWasDifferent: ownershipChanged,
 		replicationBudgetManager:       shardItem.replicationBudgetManager,
+		taskSpanContexts: cache.New(&cache.Options{
+			MaxCount: taskSpanContextCacheSize,
+			TTL:      taskSpanContextCacheTTL,
+		}),
 	}
 
 	// TODO remove once migrated to global event cache
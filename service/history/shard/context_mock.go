@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	trace "go.opentelemetry.io/otel/trace"
 	gomock "go.uber.org/mock/gomock"
 
 	activecluster "github.com/uber/cadence/common/activecluster"
@@ -438,6 +439,20 @@ func (mr *MockContextMockRecorder) GetShardID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardID", reflect.TypeOf((*MockContext)(nil).GetShardID))
 }
 
+// GetTaskSpanContext mocks base method.
+func (m *MockContext) GetTaskSpanContext(taskID int64) trace.SpanContext {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskSpanContext", taskID)
+	ret0, _ := ret[0].(trace.SpanContext)
+	return ret0
+}
+
+// GetTaskSpanContext indicates an expected call of GetTaskSpanContext.
+func (mr *MockContextMockRecorder) GetTaskSpanContext(taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskSpanContext", reflect.TypeOf((*MockContext)(nil).GetTaskSpanContext), taskID)
+}
+
 // GetThrottledLogger mocks base method.
 func (m *MockContext) GetThrottledLogger() log.Logger {
 	m.ctrl.T.Helper()
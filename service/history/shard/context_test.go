@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common"
@@ -612,6 +613,48 @@ func (s *contextTestSuite) TestCreateWorkflowExecution() {
 	}
 }
 
+func (s *contextTestSuite) TestCreateWorkflowExecution_TaskSpanContext() {
+	s.context.taskSpanContexts = cache.New(&cache.Options{MaxCount: taskSpanContextCacheSize})
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	domainCacheEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID},
+		&persistence.DomainConfig{Retention: 7},
+		testCluster,
+	)
+	s.mockResource.DomainCache.EXPECT().GetDomainByID(testDomainID).Return(domainCacheEntry, nil).Times(2)
+	s.mockResource.ExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.CreateWorkflowExecutionResponse{}, nil)
+
+	newRequest := func() (*persistence.CreateWorkflowExecutionRequest, persistence.Task) {
+		task := &persistence.DecisionTask{}
+		return &persistence.CreateWorkflowExecutionRequest{
+			DomainName: testDomain,
+			NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:   testDomainID,
+					WorkflowID: testWorkflowID,
+				},
+				TasksByCategory: map[persistence.HistoryTaskCategory][]persistence.Task{
+					persistence.HistoryTaskCategoryTransfer: {task},
+				},
+			},
+		}, task
+	}
+
+	request, sampledTask := newRequest()
+	_, err := s.context.CreateWorkflowExecution(trace.ContextWithSpanContext(context.Background(), spanContext), request)
+	s.NoError(err)
+	s.Equal(spanContext, s.context.GetTaskSpanContext(sampledTask.GetTaskID()))
+
+	request, unsampledTask := newRequest()
+	_, err = s.context.CreateWorkflowExecution(trace.ContextWithSpanContext(context.Background(), spanContext.WithTraceFlags(0)), request)
+	s.NoError(err)
+	s.False(s.context.GetTaskSpanContext(unsampledTask.GetTaskID()).IsValid())
+}
+
 func (s *contextTestSuite) TestUpdateWorkflowExecution() {
 	cases := []struct {
 		name            string
@@ -21,11 +21,15 @@
 package task
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
@@ -37,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	ctask "github.com/uber/cadence/common/task"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -95,6 +100,7 @@ type (
 		rescheduler              Rescheduler
 		criticalRetryCount       dynamicproperties.IntPropertyFn
 		isPreviousExecutorActive bool
+		span                     trace.Span // span of the ongoing execution attempt, set only within Execute
 
 		// TODO: following three fields should be removed after new task lifecycle is implemented
 		taskFilter        Filter
@@ -199,6 +205,12 @@ func (t *taskImpl) Execute() error {
 		return nil
 	}
 
+	t.span = t.startSpan(scheduleLatency)
+	defer func() {
+		tracing.EndSpan(t.span, err)
+		t.span = nil
+	}()
+
 	executionStartTime := t.timeSource.Now()
 	defer func() {
 		t.scope.IncCounter(metrics.TaskRequestsPerDomain)
@@ -217,6 +229,46 @@ func (t *taskImpl) Execute() error {
 	return err
 }
 
+// startSpan starts the span of an execution attempt, linked to the span of the request
+// which created the task if it is still known to the shard
+func (t *taskImpl) startSpan(scheduleLatency time.Duration) trace.Span {
+	if !tracing.Enabled() {
+		// skip collecting the attributes, the returned span is a no-op one
+		return trace.SpanFromContext(context.Background())
+	}
+
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("cadence.domain_id", t.GetDomainID()),
+			attribute.String("cadence.workflow_id", t.GetWorkflowID()),
+			attribute.String("cadence.run_id", t.GetRunID()),
+			attribute.Int64("cadence.task_id", t.GetTaskID()),
+			attribute.Int("cadence.task_type", t.GetTaskType()),
+			attribute.Int("cadence.attempt", t.GetAttempt()),
+			attribute.Int64("cadence.schedule_latency_ms", scheduleLatency.Milliseconds()),
+		),
+	}
+	if spanContext := t.shard.GetTaskSpanContext(t.GetTaskID()); spanContext.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: spanContext}))
+	}
+	_, span := tracing.Tracer().Start(context.Background(), "history.task."+t.GetTaskCategory().Name(), opts...)
+	return span
+}
+
+func (t *taskImpl) getSpan() trace.Span {
+	return t.span
+}
+
+// contextWithTaskSpan returns a context carrying the span of the task's ongoing execution attempt,
+// so that RPC and persistence calls made by executors are recorded as its children
+func contextWithTaskSpan(ctx context.Context, task Task) context.Context {
+	if t, ok := task.(interface{ getSpan() trace.Span }); ok && t.getSpan() != nil {
+		return trace.ContextWithSpan(ctx, t.getSpan())
+	}
+	return ctx
+}
+
 func (t *taskImpl) resetAttempt() {
 	t.Lock()
 	defer t.Unlock()
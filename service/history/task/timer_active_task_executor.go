@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/gen/go/shared"
 	"github.com/uber/cadence/service/history/config"
@@ -96,33 +97,34 @@ func (t *timerActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 		Scope:        scope,
 		IsActiveTask: true,
 	}
+	taskCtx := contextWithTaskSpan(t.ctx, task)
 	switch timerTask := task.GetInfo().(type) {
 	case *persistence.UserTimerTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(taskCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeUserTimerTimeoutTask(ctx, timerTask)
 	case *persistence.ActivityTimeoutTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(taskCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeActivityTimeoutTask(ctx, timerTask)
 	case *persistence.DecisionTimeoutTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(taskCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeDecisionTimeoutTask(ctx, timerTask)
 	case *persistence.WorkflowTimeoutTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(taskCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowTimeoutTask(ctx, timerTask)
 	case *persistence.ActivityRetryTimerTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(taskCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeActivityRetryTimerTask(ctx, timerTask)
 	case *persistence.WorkflowBackoffTimerTask:
-		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		ctx, cancel := context.WithTimeout(taskCtx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowBackoffTimerTask(ctx, timerTask)
 	case *persistence.DeleteHistoryEventTask:
-		ctx, cancel := context.WithTimeout(t.ctx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
+		ctx, cancel := context.WithTimeout(taskCtx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
 		defer cancel()
 		return executeResponse, t.executeDeleteHistoryEventTask(ctx, timerTask)
 	default:
@@ -160,6 +162,10 @@ func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if execution.IsWorkflowPaused(mutableState) {
+		// user timer tasks are regenerated when the workflow is unpaused
+		return nil
+	}
 
 	timerSequence := execution.NewTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -357,6 +363,11 @@ Loop:
 			break Loop
 		}
 
+		if execution.IsActivityPaused(mutableState, activityInfo) {
+			// activity timers are regenerated when the activity or workflow is unpaused
+			continue Loop
+		}
+
 		if delay >= resurrectionCheckMinDelay || resurrectedActivity != nil {
 			if resurrectedActivity == nil {
 				// overwrite the context here as scan history may take a long time to complete
@@ -601,6 +612,11 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 		return nil
 	}
 
+	if execution.IsWorkflowPaused(mutableState) {
+		// backoff timer is regenerated when the workflow is unpaused
+		return nil
+	}
+
 	// schedule first decision task
 	return t.updateWorkflowExecution(ctx, wfContext, mutableState, true)
 }
@@ -653,6 +669,11 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		return err
 	}
 
+	if execution.IsActivityPaused(mutableState, activityInfo) {
+		// retry is dispatched again when the activity or workflow is unpaused
+		return nil
+	}
+
 	domainID := task.DomainID
 	targetDomainID := domainID
 	if activityInfo.DomainID != "" {
@@ -682,6 +703,8 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		Name: activityInfo.TaskList,
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	partitionConfig := mutableState.GetExecutionInfo().PartitionConfig
+	taskPriority := taskpriority.ToTaskPriority(activityInfo.TaskPriority)
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -700,7 +723,8 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		TaskList:                      taskList,
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
-		PartitionConfig:               mutableState.GetExecutionInfo().PartitionConfig,
+		PartitionConfig:               partitionConfig,
+		TaskPriority:                  taskPriority,
 	})
 	return err
 }
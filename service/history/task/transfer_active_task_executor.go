@@ -39,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -130,7 +131,7 @@ func (t *transferActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error)
 		Scope:        scope,
 		IsActiveTask: true,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), taskDefaultTimeout)
+	ctx, cancel := context.WithTimeout(contextWithTaskSpan(context.Background(), task), taskDefaultTimeout)
 	defer cancel()
 
 	switch transferTask := task.GetInfo().(type) {
@@ -201,6 +202,12 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 		return err
 	}
 
+	if execution.IsActivityPaused(mutableState, ai) {
+		// activity tasks are regenerated when the activity or workflow is unpaused
+		t.logger.Debug("Skip dispatching paused activity", tag.WorkflowID(task.WorkflowID), tag.WorkflowActivityID(ai.ActivityID))
+		return nil
+	}
+
 	timeout := min(ai.ScheduleToStartTimeout, constants.MaxTaskTimeout)
 
 	taskList := types.TaskList{
@@ -210,6 +217,8 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if taskList.Name == "" {
 		taskList.Name = task.TaskList
 	}
+	partitionConfig := mutableState.GetExecutionInfo().PartitionConfig
+	taskPriority := taskpriority.ToTaskPriority(ai.TaskPriority)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
@@ -222,7 +231,8 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	pushActivityInfo := &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: timeout,
 		tasklist:                       taskList,
-		partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+		partitionConfig:                partitionConfig,
+		taskPriority:                   taskPriority,
 	}
 	err = t.pushActivity(ctx, task, pushActivityInfo)
 	if err == nil {
@@ -268,6 +278,12 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		return err
 	}
 
+	if execution.IsWorkflowPaused(mutableState) {
+		// decision tasks are regenerated when the workflow is unpaused
+		t.logger.Debug("Skip dispatching decision of paused workflow", tag.WorkflowID(task.WorkflowID), tag.WorkflowScheduleID(task.ScheduleID))
+		return nil
+	}
+
 	domainName := mutableState.GetDomainEntry().GetInfo().Name
 	executionInfo := mutableState.GetExecutionInfo()
 	workflowTimeout := executionInfo.WorkflowTimeout
@@ -310,6 +326,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		decisionScheduleToStartTimeout: decisionTimeout,
 		tasklist:                       taskList,
 		partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+		taskPriority:                   taskpriority.ToTaskPriority(mutableState.GetExecutionInfo().TaskPriority),
 	})
 	if _, ok := err.(*types.StickyWorkerUnavailableError); ok {
 		// sticky worker is unavailable, switch to non-sticky task list
@@ -326,6 +343,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 			decisionScheduleToStartTimeout: decisionTimeout,
 			tasklist:                       taskList,
 			partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+			taskPriority:                   taskpriority.ToTaskPriority(mutableState.GetExecutionInfo().TaskPriority),
 		})
 	}
 	if err == nil {
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -97,7 +98,7 @@ func (t *transferStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error
 		Scope:        scope,
 		IsActiveTask: false,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), taskDefaultTimeout)
+	ctx, cancel := context.WithTimeout(contextWithTaskSpan(context.Background(), task), taskDefaultTimeout)
 	defer cancel()
 
 	switch transferTask := task.GetInfo().(type) {
@@ -169,6 +170,7 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 				activityInfo.ScheduleToStartTimeout,
 				taskList,
 				mutableState.GetExecutionInfo().PartitionConfig,
+				taskpriority.ToTaskPriority(activityInfo.TaskPriority),
 			), nil
 		}
 
@@ -226,6 +228,7 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 				decisionTimeout,
 				types.TaskList{Name: executionInfo.TaskList, Kind: executionInfo.TaskListKind.Ptr()}, // at standby, always use non-sticky tasklist
 				mutableState.GetExecutionInfo().PartitionConfig,
+				taskpriority.ToTaskPriority(executionInfo.TaskPriority),
 			), nil
 		}
 
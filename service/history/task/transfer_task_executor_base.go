@@ -133,6 +133,7 @@ func (t *transferTaskExecutorBase) pushActivity(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		TaskPriority:                  pushActivityInfo.taskPriority,
 	})
 	return err
 }
@@ -176,6 +177,7 @@ func (t *transferTaskExecutorBase) pushDecision(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		TaskPriority:                  pushDecisionInfo.taskPriority,
 	})
 	return err
 }
@@ -0,0 +1,258 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination registry_mock.go -self_package github.com/uber/cadence/service/history/update
+
+package update
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Registry tracks the updates of a workflow until the decider has accepted, rejected or completed them.
+	// It only lives in memory: an update is lost with the mutable state it was registered on, the same
+	// way a buffered consistent query is.
+	Registry interface {
+		HasUndispatchedUpdate() bool
+		DispatchUpdates() []*types.WorkflowUpdate
+		IsPending(id string) bool
+
+		Register(input *types.WorkflowUpdate, accepted bool) Update
+		Accept(id string)
+		Reject(id string, reason string, details []byte)
+		Complete(id string, result []byte, failureReason *string, failureDetails []byte)
+		FailAll(err error)
+	}
+
+	// Update is a single update registered on a workflow, which callers wait on
+	Update interface {
+		// StageCh returns a channel that is closed once the update has reached the given stage,
+		// or once it can no longer reach it
+		StageCh(types.WorkflowUpdateStage) <-chan struct{}
+		// Outcome returns the current state of the update, or the error that aborted it
+		Outcome() (*types.UpdateWorkflowExecutionResponse, error)
+	}
+
+	registryImpl struct {
+		sync.Mutex
+
+		// pending holds the updates that are not accepted or rejected yet
+		pending map[string]*updateImpl
+		// accepted holds the updates that are accepted but not completed yet
+		accepted map[string]*updateImpl
+	}
+
+	updateImpl struct {
+		sync.RWMutex
+
+		input      *types.WorkflowUpdate
+		dispatched bool
+		response   *types.UpdateWorkflowExecutionResponse
+		err        error
+
+		acceptedCh  chan struct{}
+		completedCh chan struct{}
+	}
+)
+
+// NewRegistry creates a new update registry
+func NewRegistry() Registry {
+	return &registryImpl{
+		pending:  make(map[string]*updateImpl),
+		accepted: make(map[string]*updateImpl),
+	}
+}
+
+func (r *registryImpl) HasUndispatchedUpdate() bool {
+	r.Lock()
+	defer r.Unlock()
+	for _, u := range r.pending {
+		if !u.dispatched {
+			return true
+		}
+	}
+	return false
+}
+
+// DispatchUpdates returns every update that still waits for the decider to accept or reject it.
+// Updates are sent again on every decision task until the decider handles them, since a failed or
+// timed out decision task does not record anything about them.
+func (r *registryImpl) DispatchUpdates() []*types.WorkflowUpdate {
+	r.Lock()
+	defer r.Unlock()
+	var result []*types.WorkflowUpdate
+	for _, u := range r.pending {
+		u.dispatched = true
+		result = append(result, u.input)
+	}
+	return result
+}
+
+// IsPending returns true if the update waits for the decider to accept or reject it
+func (r *registryImpl) IsPending(id string) bool {
+	r.Lock()
+	defer r.Unlock()
+	_, ok := r.pending[id]
+	return ok
+}
+
+// Register adds an update to the registry. Registering an update ID twice returns the existing update,
+// so a retried request waits on the same outcome. An update the mutable state records as accepted, e.g.
+// before the mutable state was reloaded, is added as accepted and is not sent to the decider again.
+func (r *registryImpl) Register(input *types.WorkflowUpdate, accepted bool) Update {
+	r.Lock()
+	defer r.Unlock()
+	id := input.GetUpdateID()
+	if u, ok := r.pending[id]; ok {
+		return u
+	}
+	if u, ok := r.accepted[id]; ok {
+		return u
+	}
+	u := &updateImpl{
+		input:       input,
+		acceptedCh:  make(chan struct{}),
+		completedCh: make(chan struct{}),
+	}
+	if accepted {
+		r.accepted[id] = u
+		u.accept()
+	} else {
+		r.pending[id] = u
+	}
+	return u
+}
+
+func (r *registryImpl) Accept(id string) {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.pending[id]
+	if !ok {
+		return
+	}
+	delete(r.pending, id)
+	r.accepted[id] = u
+	u.accept()
+}
+
+func (r *registryImpl) Reject(id string, reason string, details []byte) {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.pending[id]
+	if !ok {
+		return
+	}
+	delete(r.pending, id)
+	u.reject(reason, details)
+}
+
+func (r *registryImpl) Complete(id string, result []byte, failureReason *string, failureDetails []byte) {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.accepted[id]
+	if !ok {
+		return
+	}
+	delete(r.accepted, id)
+	u.complete(result, failureReason, failureDetails)
+}
+
+// FailAll aborts every update in the registry, e.g. because the workflow has closed
+func (r *registryImpl) FailAll(err error) {
+	r.Lock()
+	defer r.Unlock()
+	for id, u := range r.pending {
+		u.fail(err)
+		delete(r.pending, id)
+	}
+	for id, u := range r.accepted {
+		u.fail(err)
+		delete(r.accepted, id)
+	}
+}
+
+func (u *updateImpl) StageCh(stage types.WorkflowUpdateStage) <-chan struct{} {
+	if stage == types.WorkflowUpdateStageAccepted {
+		return u.acceptedCh
+	}
+	return u.completedCh
+}
+
+func (u *updateImpl) Outcome() (*types.UpdateWorkflowExecutionResponse, error) {
+	u.RLock()
+	defer u.RUnlock()
+	return u.response, u.err
+}
+
+func (u *updateImpl) accept() {
+	u.Lock()
+	defer u.Unlock()
+	u.response = u.newResponse(types.WorkflowUpdateStageAccepted)
+	close(u.acceptedCh)
+}
+
+func (u *updateImpl) reject(reason string, details []byte) {
+	u.Lock()
+	defer u.Unlock()
+	u.response = u.newResponse(types.WorkflowUpdateStageRejected)
+	u.response.FailureReason = &reason
+	u.response.FailureDetails = details
+	close(u.acceptedCh)
+	close(u.completedCh)
+}
+
+func (u *updateImpl) complete(result []byte, failureReason *string, failureDetails []byte) {
+	u.Lock()
+	defer u.Unlock()
+	u.response = u.newResponse(types.WorkflowUpdateStageCompleted)
+	u.response.Result = result
+	u.response.FailureReason = failureReason
+	u.response.FailureDetails = failureDetails
+	u.closeAcceptedCh()
+	close(u.completedCh)
+}
+
+func (u *updateImpl) fail(err error) {
+	u.Lock()
+	defer u.Unlock()
+	u.err = err
+	u.closeAcceptedCh()
+	close(u.completedCh)
+}
+
+// closeAcceptedCh closes the accepted channel unless the update was accepted before
+func (u *updateImpl) closeAcceptedCh() {
+	select {
+	case <-u.acceptedCh:
+	default:
+		close(u.acceptedCh)
+	}
+}
+
+func (u *updateImpl) newResponse(stage types.WorkflowUpdateStage) *types.UpdateWorkflowExecutionResponse {
+	return &types.UpdateWorkflowExecutionResponse{
+		UpdateID: u.input.GetUpdateID(),
+		Stage:    stage.Ptr(),
+	}
+}
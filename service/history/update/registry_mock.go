@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: registry.go
+//
+// Generated by this command:
+//
+//	mockgen -package update -source registry.go -destination registry_mock.go -self_package github.com/uber/cadence/service/history/update
+//
+
+// Package update is a generated GoMock package.
+package update
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	types "github.com/uber/cadence/common/types"
+)
+
+// MockRegistry is a mock of Registry interface.
+type MockRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryMockRecorder
+	isgomock struct{}
+}
+
+// MockRegistryMockRecorder is the mock recorder for MockRegistry.
+type MockRegistryMockRecorder struct {
+	mock *MockRegistry
+}
+
+// NewMockRegistry creates a new mock instance.
+func NewMockRegistry(ctrl *gomock.Controller) *MockRegistry {
+	mock := &MockRegistry{ctrl: ctrl}
+	mock.recorder = &MockRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistry) EXPECT() *MockRegistryMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockRegistry) Accept(id string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Accept", id)
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockRegistryMockRecorder) Accept(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockRegistry)(nil).Accept), id)
+}
+
+// Complete mocks base method.
+func (m *MockRegistry) Complete(id string, result []byte, failureReason *string, failureDetails []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Complete", id, result, failureReason, failureDetails)
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockRegistryMockRecorder) Complete(id, result, failureReason, failureDetails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockRegistry)(nil).Complete), id, result, failureReason, failureDetails)
+}
+
+// DispatchUpdates mocks base method.
+func (m *MockRegistry) DispatchUpdates() []*types.WorkflowUpdate {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchUpdates")
+	ret0, _ := ret[0].([]*types.WorkflowUpdate)
+	return ret0
+}
+
+// DispatchUpdates indicates an expected call of DispatchUpdates.
+func (mr *MockRegistryMockRecorder) DispatchUpdates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchUpdates", reflect.TypeOf((*MockRegistry)(nil).DispatchUpdates))
+}
+
+// FailAll mocks base method.
+func (m *MockRegistry) FailAll(err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FailAll", err)
+}
+
+// FailAll indicates an expected call of FailAll.
+func (mr *MockRegistryMockRecorder) FailAll(err any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailAll", reflect.TypeOf((*MockRegistry)(nil).FailAll), err)
+}
+
+// HasUndispatchedUpdate mocks base method.
+func (m *MockRegistry) HasUndispatchedUpdate() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasUndispatchedUpdate")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasUndispatchedUpdate indicates an expected call of HasUndispatchedUpdate.
+func (mr *MockRegistryMockRecorder) HasUndispatchedUpdate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasUndispatchedUpdate", reflect.TypeOf((*MockRegistry)(nil).HasUndispatchedUpdate))
+}
+
+// IsPending mocks base method.
+func (m *MockRegistry) IsPending(id string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPending", id)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPending indicates an expected call of IsPending.
+func (mr *MockRegistryMockRecorder) IsPending(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPending", reflect.TypeOf((*MockRegistry)(nil).IsPending), id)
+}
+
+// Register mocks base method.
+func (m *MockRegistry) Register(input *types.WorkflowUpdate, accepted bool) Update {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", input, accepted)
+	ret0, _ := ret[0].(Update)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockRegistryMockRecorder) Register(input, accepted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockRegistry)(nil).Register), input, accepted)
+}
+
+// Reject mocks base method.
+func (m *MockRegistry) Reject(id, reason string, details []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reject", id, reason, details)
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockRegistryMockRecorder) Reject(id, reason, details any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockRegistry)(nil).Reject), id, reason, details)
+}
+
+// MockUpdate is a mock of Update interface.
+type MockUpdate struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateMockRecorder is the mock recorder for MockUpdate.
+type MockUpdateMockRecorder struct {
+	mock *MockUpdate
+}
+
+// NewMockUpdate creates a new mock instance.
+func NewMockUpdate(ctrl *gomock.Controller) *MockUpdate {
+	mock := &MockUpdate{ctrl: ctrl}
+	mock.recorder = &MockUpdateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdate) EXPECT() *MockUpdateMockRecorder {
+	return m.recorder
+}
+
+// Outcome mocks base method.
+func (m *MockUpdate) Outcome() (*types.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outcome")
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outcome indicates an expected call of Outcome.
+func (mr *MockUpdateMockRecorder) Outcome() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outcome", reflect.TypeOf((*MockUpdate)(nil).Outcome))
+}
+
+// StageCh mocks base method.
+func (m *MockUpdate) StageCh(arg0 types.WorkflowUpdateStage) <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageCh", arg0)
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// StageCh indicates an expected call of StageCh.
+func (mr *MockUpdateMockRecorder) StageCh(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageCh", reflect.TypeOf((*MockUpdate)(nil).StageCh), arg0)
+}
@@ -0,0 +1,156 @@
+// The MIT License (MIT)
+
+package update
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type UpdateRegistrySuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestUpdateRegistrySuite(t *testing.T) {
+	suite.Run(t, new(UpdateRegistrySuite))
+}
+
+func (s *UpdateRegistrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UpdateRegistrySuite) TestDispatchUpdates() {
+	r := NewRegistry()
+	s.False(r.HasUndispatchedUpdate())
+	s.Empty(r.DispatchUpdates())
+
+	r.Register(&types.WorkflowUpdate{UpdateID: "u1"}, false)
+	s.True(r.IsPending("u1"))
+	s.True(r.HasUndispatchedUpdate())
+	s.Len(r.DispatchUpdates(), 1)
+	s.False(r.HasUndispatchedUpdate())
+
+	// undecided updates are dispatched again on the next decision task
+	s.Len(r.DispatchUpdates(), 1)
+
+	r.Accept("u1")
+	s.False(r.IsPending("u1"))
+	s.Empty(r.DispatchUpdates())
+}
+
+func (s *UpdateRegistrySuite) TestRegister_Idempotent() {
+	r := NewRegistry()
+	u1 := r.Register(&types.WorkflowUpdate{UpdateID: "u1"}, false)
+	s.Equal(u1, r.Register(&types.WorkflowUpdate{UpdateID: "u1"}, false))
+	r.Accept("u1")
+	s.Equal(u1, r.Register(&types.WorkflowUpdate{UpdateID: "u1"}, false))
+	s.Len(r.DispatchUpdates(), 0)
+}
+
+func (s *UpdateRegistrySuite) TestAcceptAndComplete() {
+	r := NewRegistry()
+	u := r.Register(&types.WorkflowUpdate{UpdateID: "u1"}, false)
+	s.assertChanState(false, u.StageCh(types.WorkflowUpdateStageAccepted), u.StageCh(types.WorkflowUpdateStageCompleted))
+
+	r.Accept("u1")
+	s.assertChanState(true, u.StageCh(types.WorkflowUpdateStageAccepted))
+	s.assertChanState(false, u.StageCh(types.WorkflowUpdateStageCompleted))
+	resp, err := u.Outcome()
+	s.NoError(err)
+	s.Equal(types.WorkflowUpdateStageAccepted, resp.GetStage())
+
+	r.Complete("u1", []byte("result"), nil, nil)
+	s.assertChanState(true, u.StageCh(types.WorkflowUpdateStageCompleted))
+	resp, err = u.Outcome()
+	s.NoError(err)
+	s.Equal(&types.UpdateWorkflowExecutionResponse{
+		UpdateID: "u1",
+		Stage:    types.WorkflowUpdateStageCompleted.Ptr(),
+		Result:   []byte("result"),
+	}, resp)
+
+	// unknown or already completed updates are ignored
+	r.Accept("u1")
+	r.Complete("u1", nil, common.StringPtr("reason"), nil)
+	resp, _ = u.Outcome()
+	s.Equal(types.WorkflowUpdateStageCompleted, resp.GetStage())
+}
+
+func (s *UpdateRegistrySuite) TestCompleteWithoutAccept() {
+	r := NewRegistry()
+	u := r.Register(&types.WorkflowUpdate{UpdateID: "u1"}, false)
+
+	// an update is only completed once accepted
+	r.Complete("u1", nil, common.StringPtr("reason"), []byte("details"))
+	s.assertChanState(false, u.StageCh(types.WorkflowUpdateStageAccepted), u.StageCh(types.WorkflowUpdateStageCompleted))
+	s.True(r.IsPending("u1"))
+}
+
+func (s *UpdateRegistrySuite) TestRegisterAccepted() {
+	r := NewRegistry()
+	u := r.Register(&types.WorkflowUpdate{UpdateID: "u1"}, true)
+	s.assertChanState(true, u.StageCh(types.WorkflowUpdateStageAccepted))
+	s.assertChanState(false, u.StageCh(types.WorkflowUpdateStageCompleted))
+	s.False(r.IsPending("u1"))
+	s.False(r.HasUndispatchedUpdate())
+	s.Empty(r.DispatchUpdates())
+
+	r.Complete("u1", []byte("result"), nil, nil)
+	s.assertChanState(true, u.StageCh(types.WorkflowUpdateStageCompleted))
+	resp, err := u.Outcome()
+	s.NoError(err)
+	s.Equal(types.WorkflowUpdateStageCompleted, resp.GetStage())
+	s.Equal([]byte("result"), resp.Result)
+}
+
+func (s *UpdateRegistrySuite) TestReject() {
+	r := NewRegistry()
+	u := r.Register(&types.WorkflowUpdate{UpdateID: "u1"}, false)
+	r.Reject("u1", "invalid", []byte("details"))
+	s.assertChanState(true, u.StageCh(types.WorkflowUpdateStageAccepted), u.StageCh(types.WorkflowUpdateStageCompleted))
+	resp, err := u.Outcome()
+	s.NoError(err)
+	s.Equal(types.WorkflowUpdateStageRejected, resp.GetStage())
+	s.Equal("invalid", resp.GetFailureReason())
+
+	// a rejected update can not be completed afterwards
+	r.Complete("u1", []byte("result"), nil, nil)
+	resp, _ = u.Outcome()
+	s.Equal(types.WorkflowUpdateStageRejected, resp.GetStage())
+}
+
+func (s *UpdateRegistrySuite) TestFailAll() {
+	r := NewRegistry()
+	u1 := r.Register(&types.WorkflowUpdate{UpdateID: "u1"}, false)
+	u2 := r.Register(&types.WorkflowUpdate{UpdateID: "u2"}, false)
+	r.Accept("u2")
+
+	errClosed := errors.New("workflow closed")
+	r.FailAll(errClosed)
+	for _, u := range []Update{u1, u2} {
+		s.assertChanState(true, u.StageCh(types.WorkflowUpdateStageAccepted), u.StageCh(types.WorkflowUpdateStageCompleted))
+		_, err := u.Outcome()
+		s.Equal(errClosed, err)
+	}
+	s.False(r.HasUndispatchedUpdate())
+	s.Empty(r.DispatchUpdates())
+}
+
+func (s *UpdateRegistrySuite) assertChanState(expectedClosed bool, chans ...<-chan struct{}) {
+	for _, ch := range chans {
+		closed := false
+		select {
+		case <-ch:
+			closed = true
+		default:
+		}
+		s.Equal(expectedClosed, closed)
+	}
+}
@@ -33,6 +33,10 @@ var (
 	ErrMaxAttemptsExceeded = errors.New("maximum attempts exceeded to update history")
 	// ErrActivityTaskNotFound is the error to indicate activity task could be duplicate and activity already completed
 	ErrActivityTaskNotFound = &types.EntityNotExistsError{Message: "activity task not found"}
+	// ErrActivityTaskPaused is the error to indicate activity task should not be started as the activity is paused
+	ErrActivityTaskPaused = &types.EntityNotExistsError{Message: "activity task is paused"}
+	// ErrWorkflowPaused is the error to indicate decision task should not be started as the workflow is paused
+	ErrWorkflowPaused = &types.EntityNotExistsError{Message: "workflow execution is paused"}
 	// ErrNotExists is the error to indicate workflow doesn't exist
 	ErrNotExists = &types.EntityNotExistsError{Message: "workflow execution already completed"}
 	// ErrAlreadyCompleted is the error to indicate workflow execution already completed
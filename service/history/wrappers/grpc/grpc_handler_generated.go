@@ -85,6 +85,11 @@ func (g GRPCHandler) NotifyFailoverMarkers(ctx context.Context, request *history
 	return &historyv1.NotifyFailoverMarkersResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) PauseWorkflowExecution(ctx context.Context, request *historyv1.PauseWorkflowExecutionRequest) (*historyv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(ctx, proto.ToHistoryPauseWorkflowExecutionRequest(request))
+	return &historyv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) PollMutableState(ctx context.Context, request *historyv1.PollMutableStateRequest) (*historyv1.PollMutableStateResponse, error) {
 	response, err := g.h.PollMutableState(ctx, proto.ToHistoryPollMutableStateRequest(request))
 	return proto.FromHistoryPollMutableStateResponse(response), proto.FromError(err)
@@ -160,6 +165,11 @@ func (g GRPCHandler) RequestCancelWorkflowExecution(ctx context.Context, request
 	return &historyv1.RequestCancelWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) ResetActivity(ctx context.Context, request *historyv1.ResetActivityRequest) (*historyv1.ResetActivityResponse, error) {
+	err := g.h.ResetActivity(ctx, proto.ToHistoryResetActivityRequest(request))
+	return &historyv1.ResetActivityResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) ResetQueue(ctx context.Context, request *historyv1.ResetQueueRequest) (*historyv1.ResetQueueResponse, error) {
 	err := g.h.ResetQueue(ctx, proto.ToHistoryResetQueueRequest(request))
 	return &historyv1.ResetQueueResponse{}, proto.FromError(err)
@@ -239,3 +249,18 @@ func (g GRPCHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g GRPCHandler) UnpauseWorkflowExecution(ctx context.Context, request *historyv1.UnpauseWorkflowExecutionRequest) (*historyv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(ctx, proto.ToHistoryUnpauseWorkflowExecutionRequest(request))
+	return &historyv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) UpdateWorkflowExecution(ctx context.Context, request *historyv1.UpdateWorkflowExecutionRequest) (*historyv1.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, proto.ToHistoryUpdateWorkflowExecutionRequest(request))
+	return proto.FromHistoryUpdateWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) UpdateWorkflowSearchAttributes(ctx context.Context, request *historyv1.UpdateWorkflowSearchAttributesRequest) (*historyv1.UpdateWorkflowSearchAttributesResponse, error) {
+	err := g.h.UpdateWorkflowSearchAttributes(ctx, proto.ToHistoryUpdateWorkflowSearchAttributesRequest(request))
+	return &historyv1.UpdateWorkflowSearchAttributesResponse{}, proto.FromError(err)
+}
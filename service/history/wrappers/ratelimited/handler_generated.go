@@ -121,6 +121,10 @@ func (h *historyHandler) NotifyFailoverMarkers(ctx context.Context, np1 *types.N
 	return h.wrapped.NotifyFailoverMarkers(ctx, np1)
 }
 
+func (h *historyHandler) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest) (err error) {
+	return h.wrapped.PauseWorkflowExecution(ctx, hp1)
+}
+
 func (h *historyHandler) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest) (pp2 *types.PollMutableStateResponse, err error) {
 	return h.wrapped.PollMutableState(ctx, pp1)
 }
@@ -185,6 +189,10 @@ func (h *historyHandler) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, hp1)
 }
 
+func (h *historyHandler) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest) (err error) {
+	return h.wrapped.ResetActivity(ctx, hp1)
+}
+
 func (h *historyHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest) (err error) {
 	return h.wrapped.ResetQueue(ctx, rp1)
 }
@@ -327,3 +335,15 @@ func (h *historyHandler) SyncShardStatus(ctx context.Context, sp1 *types.SyncSha
 func (h *historyHandler) TerminateWorkflowExecution(ctx context.Context, hp1 *types.HistoryTerminateWorkflowExecutionRequest) (err error) {
 	return h.wrapped.TerminateWorkflowExecution(ctx, hp1)
 }
+
+func (h *historyHandler) UnpauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryUnpauseWorkflowExecutionRequest) (err error) {
+	return h.wrapped.UnpauseWorkflowExecution(ctx, hp1)
+}
+
+func (h *historyHandler) UpdateWorkflowExecution(ctx context.Context, hp1 *types.HistoryUpdateWorkflowExecutionRequest) (up1 *types.UpdateWorkflowExecutionResponse, err error) {
+	return h.wrapped.UpdateWorkflowExecution(ctx, hp1)
+}
+
+func (h *historyHandler) UpdateWorkflowSearchAttributes(ctx context.Context, up1 *types.UpdateWorkflowSearchAttributesRequest) (err error) {
+	return h.wrapped.UpdateWorkflowSearchAttributes(ctx, up1)
+}
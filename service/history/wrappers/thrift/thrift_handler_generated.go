@@ -73,6 +73,11 @@ func (g ThriftHandler) NotifyFailoverMarkers(ctx context.Context, Request *histo
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *history.PauseWorkflowExecutionRequest) (err error) {
+	err = g.h.PauseWorkflowExecution(ctx, thrift.ToHistoryPauseWorkflowExecutionRequest(PauseRequest))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) PollMutableState(ctx context.Context, PollRequest *history.PollMutableStateRequest) (pp1 *history.PollMutableStateResponse, err error) {
 	response, err := g.h.PollMutableState(ctx, thrift.ToHistoryPollMutableStateRequest(PollRequest))
 	return thrift.FromHistoryPollMutableStateResponse(response), thrift.FromError(err)
@@ -148,6 +153,11 @@ func (g ThriftHandler) RequestCancelWorkflowExecution(ctx context.Context, Cance
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) ResetActivity(ctx context.Context, ResetRequest *history.ResetActivityRequest) (err error) {
+	err = g.h.ResetActivity(ctx, thrift.ToHistoryResetActivityRequest(ResetRequest))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) ResetQueue(ctx context.Context, Request *shared.ResetQueueRequest) (err error) {
 	err = g.h.ResetQueue(ctx, thrift.ToHistoryResetQueueRequest(Request))
 	return thrift.FromError(err)
@@ -227,3 +237,18 @@ func (g ThriftHandler) TerminateWorkflowExecution(ctx context.Context, Terminate
 	err = g.h.TerminateWorkflowExecution(ctx, thrift.ToHistoryTerminateWorkflowExecutionRequest(TerminateRequest))
 	return thrift.FromError(err)
 }
+
+func (g ThriftHandler) UnpauseWorkflowExecution(ctx context.Context, UnpauseRequest *history.UnpauseWorkflowExecutionRequest) (err error) {
+	err = g.h.UnpauseWorkflowExecution(ctx, thrift.ToHistoryUnpauseWorkflowExecutionRequest(UnpauseRequest))
+	return thrift.FromError(err)
+}
+
+func (g ThriftHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *history.UpdateWorkflowExecutionRequest) (up1 *shared.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, thrift.ToHistoryUpdateWorkflowExecutionRequest(UpdateRequest))
+	return thrift.FromUpdateWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
+func (g ThriftHandler) UpdateWorkflowSearchAttributes(ctx context.Context, UpdateRequest *history.UpdateWorkflowSearchAttributesRequest) (err error) {
+	err = g.h.UpdateWorkflowSearchAttributes(ctx, thrift.ToHistoryUpdateWorkflowSearchAttributesRequest(UpdateRequest))
+	return thrift.FromError(err)
+}
@@ -75,6 +75,8 @@ type (
 		IsolationGroupHasPollersSustainedDuration dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupNoPollersSustainedDuration  dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupsPerPartition               dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		EnableTaskPriority                        dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		TaskPriorityWeightRatio                   dynamicproperties.IntPropertyFnWithTaskListInfoFilters
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
@@ -143,6 +145,9 @@ type (
 		IsolationGroupHasPollersSustainedDuration func() time.Duration
 		IsolationGroupNoPollersSustainedDuration  func() time.Duration
 		IsolationGroupsPerPartition               func() int
+		// task priority configuration
+		EnableTaskPriority      func() bool
+		TaskPriorityWeightRatio func() int
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold      func() int
 		MaxTaskBatchSize                     func() int
@@ -222,6 +227,8 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		IsolationGroupHasPollersSustainedDuration: dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupHasPollersSustainedDuration),
 		IsolationGroupNoPollersSustainedDuration:  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupNoPollersSustainedDuration),
 		IsolationGroupsPerPartition:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupsPerPartition),
+		EnableTaskPriority:                        dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableTaskPriority),
+		TaskPriorityWeightRatio:                   dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingTaskPriorityWeightRatio),
 		TaskIsolationDuration:                     dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationDuration),
 		TaskIsolationPollerWindow:                 dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationPollerWindow),
 		HostName:                                  hostName,
@@ -101,6 +101,8 @@ func TestNewConfig(t *testing.T) {
 		"IsolationGroupHasPollersSustainedDuration": {dynamicproperties.MatchingIsolationGroupHasPollersSustainedDuration, time.Duration(39)},
 		"IsolationGroupNoPollersSustainedDuration":  {dynamicproperties.MatchingIsolationGroupNoPollersSustainedDuration, time.Duration(40)},
 		"IsolationGroupsPerPartition":               {dynamicproperties.MatchingIsolationGroupsPerPartition, 41},
+		"EnableTaskPriority":                        {dynamicproperties.MatchingEnableTaskPriority, true},
+		"TaskPriorityWeightRatio":                   {dynamicproperties.MatchingTaskPriorityWeightRatio, 42},
 		"EnableReturnAllTaskListKinds":              {dynamicproperties.MatchingEnableReturnAllTaskListKinds, true},
 	}
 	client := dynamicconfig.NewInMemoryClient()
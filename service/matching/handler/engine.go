@@ -50,6 +50,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/event"
@@ -404,6 +405,7 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleToStartTimeoutSeconds: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:                   e.timeSource.Now(),
 		PartitionConfig:               request.GetPartitionConfig(),
+		Priority:                      taskpriority.FromTaskPriority(request.TaskPriority),
 	}
 
 	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
@@ -479,6 +481,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleToStartTimeoutSeconds: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:                   e.timeSource.Now(),
 		PartitionConfig:               request.GetPartitionConfig(),
+		Priority:                      taskpriority.FromTaskPriority(request.TaskPriority),
 	}
 
 	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
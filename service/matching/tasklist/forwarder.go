@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 )
@@ -145,6 +146,7 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
 			PartitionConfig:               task.Event.PartitionConfig,
+			TaskPriority:                  taskpriority.ToTaskPriority(task.Event.Priority),
 		})
 	case persistence.TaskListTypeActivity:
 		_, err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
@@ -160,6 +162,7 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
 			PartitionConfig:               task.Event.PartitionConfig,
+			TaskPriority:                  taskpriority.ToTaskPriority(task.Event.Priority),
 		})
 	default:
 		return ErrInvalidTaskListType
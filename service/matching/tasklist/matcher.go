@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/clock"
@@ -33,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/event"
@@ -70,6 +72,10 @@ type taskMatcherImpl struct {
 	tasklistKind types.TaskListKind
 
 	numReadPartitionsFn func(*config.TaskListConfig) int
+
+	// number of backlog tasks of each priority level waiting for a poller in MustOffer,
+	// sync match of lower priority tasks yields to them when task priority is enabled
+	waitingBacklog [taskpriority.NumLevels]atomic.Int64
 }
 
 // ErrTasklistThrottled implies a tasklist was throttled
@@ -125,6 +131,9 @@ func (tm *taskMatcherImpl) DisconnectBlockedPollers() {
 // true and error message. This method should not be used for query
 // task. This method should ONLY be used for sync match.
 //
+// When task priority is enabled, the task is not matched while backlog tasks
+// of a higher priority are waiting for a poller.
+//
 // When a local poller is not available and forwarding to a parent
 // task list partition is possible, this method will attempt forwarding
 // to the parent partition.
@@ -148,6 +157,10 @@ func (tm *taskMatcherImpl) DisconnectBlockedPollers() {
 //   - context deadline is exceeded
 //   - task is matched and consumer returns error in response channel
 func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool, error) {
+	if tm.shouldYieldToBacklog(task) {
+		// the task is persisted and dispatched from the backlog after the higher priority tasks
+		return false, nil
+	}
 	startT := time.Now()
 	if !task.IsForwarded() {
 		err := tm.ratelimit(ctx)
@@ -298,6 +311,10 @@ func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) er
 		return fmt.Errorf("rate limit error dispatching: %w", err)
 	}
 
+	priority := task.Priority()
+	tm.waitingBacklog[priority].Add(1)
+	defer tm.waitingBacklog[priority].Add(-1)
+
 	startT := time.Now()
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
@@ -782,6 +799,21 @@ func (tm *taskMatcherImpl) isForwardingAllowed() bool {
 	return tm.fwdr != nil
 }
 
+// shouldYieldToBacklog returns true when task priority is enabled and backlog tasks of a higher
+// priority than the given task are waiting for a poller, so that the task doesn't take a poller
+// ahead of them with a sync match
+func (tm *taskMatcherImpl) shouldYieldToBacklog(task *InternalTask) bool {
+	if !tm.config.EnableTaskPriority() {
+		return false
+	}
+	for level := taskpriority.LevelHighest; level < task.Priority(); level++ {
+		if tm.waitingBacklog[level].Load() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (tm *taskMatcherImpl) getTaskC(task *InternalTask) chan<- *InternalTask {
 	taskC := tm.taskC
 	if isolatedTaskC, ok := tm.isolatedTaskC[task.isolationGroup]; ok && task.isolationGroup != "" {
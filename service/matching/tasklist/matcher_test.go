@@ -47,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 )
@@ -391,6 +392,38 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	t.NoError(err)
 }
 
+func (t *MatcherTestSuite) TestOfferYieldsToHigherPriorityBacklog() {
+	t.disableRemoteForwarding()
+	t.matcher.config.EnableTaskPriority = func() bool { return true }
+
+	backlogTask := newInternalTask(t.newTaskInfoWithPriority(taskpriority.LevelHigh), nil, types.TaskSourceDbBacklog, "", false, nil, "")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- t.matcher.MustOffer(ctx, backlogTask)
+	}()
+	t.Eventually(func() bool {
+		return t.matcher.waitingBacklog[taskpriority.LevelHigh].Load() == 1
+	}, time.Second, time.Millisecond)
+
+	lowTask := newInternalTask(t.newTaskInfoWithPriority(taskpriority.LevelLow), nil, types.TaskSourceHistory, "", true, nil, "")
+	t.True(t.matcher.shouldYieldToBacklog(lowTask))
+	syncMatch, err := t.matcher.Offer(ctx, lowTask)
+	t.NoError(err)
+	t.False(syncMatch)
+
+	highestTask := newInternalTask(t.newTaskInfoWithPriority(taskpriority.LevelHighest), nil, types.TaskSourceHistory, "", true, nil, "")
+	t.False(t.matcher.shouldYieldToBacklog(highestTask))
+
+	t.matcher.config.EnableTaskPriority = func() bool { return false }
+	t.False(t.matcher.shouldYieldToBacklog(lowTask))
+
+	cancel()
+	t.Error(<-done)
+	t.Equal(int64(0), t.matcher.waitingBacklog[taskpriority.LevelHigh].Load())
+}
+
 func (t *MatcherTestSuite) TestIsolationMustOfferLocalMatch() {
 	// force disable remote forwarding
 	t.disableRemoteForwarding()
@@ -1259,6 +1292,12 @@ func (t *MatcherTestSuite) newDomainCache() cache.DomainCache {
 	return dc
 }
 
+func (t *MatcherTestSuite) newTaskInfoWithPriority(priority taskpriority.Level) *persistence.TaskInfo {
+	info := t.newTaskInfo()
+	info.Priority = priority.String()
+	return info
+}
+
 func (t *MatcherTestSuite) newTaskInfo() *persistence.TaskInfo {
 	return &persistence.TaskInfo{
 		DomainID:                      uuid.New(),
@@ -23,6 +23,7 @@ package tasklist
 import (
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -136,6 +137,15 @@ func (task *InternalTask) IsForwarded() bool {
 	return task.forwardedFrom != ""
 }
 
+// Priority returns the priority level of an activity or decision task,
+// other tasks always have the default priority level
+func (task *InternalTask) Priority() taskpriority.Level {
+	if task.Event == nil || task.Event.TaskInfo == nil {
+		return taskpriority.DefaultLevel
+	}
+	return taskpriority.FromName(task.Event.Priority)
+}
+
 func (task *InternalTask) IsSyncMatch() bool {
 	return task.ResponseC != nil
 }
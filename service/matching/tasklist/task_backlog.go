@@ -0,0 +1,78 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/taskpriority"
+)
+
+// taskBacklog is a backlog of tasks persisted by the task list, with its own lease, range of task IDs,
+// ack level and garbage collection. A task list persists its tasks in a single backlog, unless task
+// priority is enabled: each priority level other than the default one then has a backlog of its own,
+// so that the tasks of a level are read regardless of how deep the backlog of the other levels is.
+type taskBacklog struct {
+	level      taskpriority.Level
+	db         *taskListDB
+	writer     *taskWriter
+	ackManager messaging.AckManager // tracks ackLevel for delivered messages
+	gc         *taskGC
+	notifyC    chan struct{} // Used as signal to notify pump of new tasks
+}
+
+func newTaskBacklog(tlMgr *taskListManagerImpl, level taskpriority.Level, db *taskListDB, ackManager messaging.AckManager) *taskBacklog {
+	return &taskBacklog{
+		level:      level,
+		db:         db,
+		writer:     newTaskWriter(tlMgr, db, ackManager),
+		ackManager: ackManager,
+		gc:         newTaskGC(db, tlMgr.config),
+		notifyC:    make(chan struct{}, 1),
+	}
+}
+
+// isEmpty returns true if all the tasks of the backlog are acked
+func (b *taskBacklog) isEmpty() bool {
+	return b.ackManager.GetAckLevel() == b.writer.GetMaxReadLevel()
+}
+
+// priorityBacklogName returns the name of the task list which persists the backlog of a priority level.
+// The reserved prefix keeps it from being used by a user task list, and as the name doesn't end with a
+// partition number, it isn't loaded by matching as a task list of its own.
+func priorityBacklogName(taskListName string, level taskpriority.Level) string {
+	return fmt.Sprintf("%v%v/priority-%v", constants.ReservedTaskListPrefix, taskListName, level)
+}
+
+// findBacklog returns the backlog of the priority level, or the first backlog,
+// which persists the default level, if the level has no backlog of its own
+func findBacklog(backlogs []*taskBacklog, level taskpriority.Level) *taskBacklog {
+	for _, b := range backlogs {
+		if b.level == level {
+			return b
+		}
+	}
+	return backlogs[0]
+}
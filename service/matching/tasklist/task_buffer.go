@@ -0,0 +1,131 @@
+package tasklist
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
+)
+
+// taskBuffer is the in-memory queue of backlog tasks of one isolation group waiting to be dispatched.
+// Tasks are kept in one queue per priority level. The dispatcher picks the level of the next task with
+// a smooth weighted round robin over the levels which have tasks, so higher priority tasks are dispatched
+// first while the lower priority levels keep getting their share of the dispatches and are never starved.
+// Each level is filled by the reader of its own backlog, which waits for space in that level only, so
+// that a large backlog of one priority level doesn't keep the tasks of the other levels from being read.
+type taskBuffer struct {
+	sync.Mutex
+	levels [taskpriority.NumLevels][]*persistence.TaskInfo
+	count  int
+	size   int
+	// notifyC is signaled whenever a task is added, so that the dispatcher waiting for tasks wakes up
+	notifyC chan struct{}
+	// spaceC of a level is signaled whenever a task of the level is removed,
+	// so that the reader waiting for space in the level wakes up
+	spaceC [taskpriority.NumLevels]chan struct{}
+	// current weight of each level in the weighted round robin
+	current [taskpriority.NumLevels]int
+}
+
+func newTaskBuffer(size int) *taskBuffer {
+	b := &taskBuffer{
+		size:    size,
+		notifyC: make(chan struct{}, 1),
+	}
+	for i := range b.spaceC {
+		b.spaceC[i] = make(chan struct{}, 1)
+	}
+	return b
+}
+
+// add adds the task to the queue of the given priority level without blocking
+func (b *taskBuffer) add(task *persistence.TaskInfo, priority taskpriority.Level) {
+	b.Lock()
+	b.levels[priority] = append(b.levels[priority], task)
+	b.count++
+	b.Unlock()
+	signal(b.notifyC)
+}
+
+// next returns the next task to dispatch and its priority level without blocking,
+// or false if the buffer is empty
+func (b *taskBuffer) next(weights [taskpriority.NumLevels]int) (*persistence.TaskInfo, taskpriority.Level, bool) {
+	b.Lock()
+	selected := -1
+	total := 0
+	for i := range b.levels {
+		if len(b.levels[i]) == 0 {
+			// an empty level doesn't accumulate weight, so it can't burst when tasks arrive later
+			b.current[i] = 0
+			continue
+		}
+		b.current[i] += weights[i]
+		total += weights[i]
+		if selected < 0 || b.current[i] > b.current[selected] {
+			selected = i
+		}
+	}
+	if selected < 0 {
+		b.Unlock()
+		return nil, taskpriority.DefaultLevel, false
+	}
+	b.current[selected] -= total
+	task := b.levels[selected][0]
+	b.levels[selected][0] = nil
+	b.levels[selected] = b.levels[selected][1:]
+	b.count--
+	b.Unlock()
+	signal(b.spaceC[selected])
+	return task, taskpriority.Level(selected), true
+}
+
+// wait blocks until a task may have been added to the buffer,
+// it returns false if the context is done before that
+func (b *taskBuffer) wait(ctx context.Context) bool {
+	select {
+	case <-b.notifyC:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// waitForSpace blocks until the queue of the priority level holds fewer than cap() tasks,
+// it returns false if the context is done before that
+func (b *taskBuffer) waitForSpace(ctx context.Context, priority taskpriority.Level) bool {
+	for b.lenOf(priority) >= b.size {
+		select {
+		case <-b.spaceC[priority]:
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// len returns the number of buffered tasks of all priority levels
+func (b *taskBuffer) len() int {
+	b.Lock()
+	defer b.Unlock()
+	return b.count
+}
+
+// lenOf returns the number of buffered tasks of the priority level
+func (b *taskBuffer) lenOf(priority taskpriority.Level) int {
+	b.Lock()
+	defer b.Unlock()
+	return len(b.levels[priority])
+}
+
+// cap returns the number of tasks the queue of each priority level holds before its reader waits
+func (b *taskBuffer) cap() int {
+	return b.size
+}
+
+func signal(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default: // already signaled
+	}
+}
@@ -0,0 +1,102 @@
+package tasklist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
+)
+
+func TestTaskBufferNextByWeight(t *testing.T) {
+	buffer := newTaskBuffer(100)
+	for i := 0; i < 20; i++ {
+		buffer.add(&persistence.TaskInfo{TaskID: int64(i)}, taskpriority.LevelHigh)
+		buffer.add(&persistence.TaskInfo{TaskID: int64(100 + i)}, taskpriority.LevelLow)
+	}
+	assert.Equal(t, 40, buffer.len())
+	assert.Equal(t, 20, buffer.lenOf(taskpriority.LevelHigh))
+	assert.Equal(t, 20, buffer.lenOf(taskpriority.LevelLow))
+
+	weights := taskpriority.Weights(2)
+	var order []int64
+	for i := 0; i < 10; i++ {
+		task, level, ok := buffer.next(weights)
+		require.True(t, ok)
+		assert.Equal(t, task.TaskID >= 100, level == taskpriority.LevelLow)
+		order = append(order, task.TaskID)
+	}
+	// high has 4 times the weight of low, so low gets one out of every 5 dispatches
+	assert.Equal(t, []int64{0, 1, 100, 2, 3, 4, 5, 101, 6, 7}, order)
+}
+
+func TestTaskBufferLowerPriorityIsNotStarved(t *testing.T) {
+	buffer := newTaskBuffer(1000)
+	for i := 0; i < 500; i++ {
+		buffer.add(&persistence.TaskInfo{}, taskpriority.LevelHighest)
+	}
+	buffer.add(&persistence.TaskInfo{TaskID: 1}, taskpriority.LevelLowest)
+
+	weights := taskpriority.Weights(2)
+	for i := 0; i < 17; i++ {
+		task, _, ok := buffer.next(weights)
+		require.True(t, ok)
+		if task.TaskID == 1 {
+			return
+		}
+	}
+	t.Fatal("lowest priority task was not dispatched within a round of the weighted round robin")
+}
+
+func TestTaskBufferEmpty(t *testing.T) {
+	buffer := newTaskBuffer(1)
+	_, _, ok := buffer.next(taskpriority.Weights(2))
+	assert.False(t, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, buffer.wait(ctx))
+
+	buffer.add(&persistence.TaskInfo{}, taskpriority.DefaultLevel)
+	assert.True(t, buffer.wait(context.Background()), "adding a task must notify the dispatcher")
+	assert.Equal(t, 1, buffer.cap())
+}
+
+func TestTaskBufferFullLevelDoesNotBlockOtherLevels(t *testing.T) {
+	buffer := newTaskBuffer(2)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 2; i++ {
+		require.True(t, buffer.waitForSpace(ctx, taskpriority.LevelLowest))
+		buffer.add(&persistence.TaskInfo{TaskID: int64(100 + i)}, taskpriority.LevelLowest)
+	}
+	assert.False(t, buffer.waitForSpace(ctx, taskpriority.LevelLowest), "the lowest level is full")
+	require.True(t, buffer.waitForSpace(ctx, taskpriority.LevelHighest), "a full level must not block the reader of another level")
+	buffer.add(&persistence.TaskInfo{TaskID: 1}, taskpriority.LevelHighest)
+	assert.Equal(t, 3, buffer.len())
+
+	task, level, ok := buffer.next(taskpriority.Weights(2))
+	require.True(t, ok)
+	assert.Equal(t, int64(1), task.TaskID, "the highest priority task read after a full level is dispatched first")
+	assert.Equal(t, taskpriority.LevelHighest, level)
+}
+
+func TestTaskBufferWaitForSpace(t *testing.T) {
+	buffer := newTaskBuffer(1)
+	ctx := context.Background()
+	assert.True(t, buffer.waitForSpace(ctx, taskpriority.DefaultLevel))
+	buffer.add(&persistence.TaskInfo{}, taskpriority.DefaultLevel)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(t, buffer.waitForSpace(cancelledCtx, taskpriority.DefaultLevel))
+
+	done := make(chan bool)
+	go func() { done <- buffer.waitForSpace(ctx, taskpriority.DefaultLevel) }()
+	_, _, ok := buffer.next(taskpriority.Weights(2))
+	require.True(t, ok)
+	assert.True(t, <-done, "dispatching a task must wake up the reader")
+}
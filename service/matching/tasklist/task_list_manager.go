@@ -50,6 +50,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/stats"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/event"
@@ -121,8 +122,8 @@ type (
 		taskWriter      *taskWriter
 		taskReader      *taskReader // reads tasks from db and async matches it with poller
 		liveness        *liveness.Liveness
-		taskGC          *taskGC
 		taskAckManager  messaging.AckManager // tracks ackLevel for delivered messages
+		backlogs        []*taskBacklog       // persist the tasks, the first one is the backlog of db and the default priority level
 		matcher         TaskMatcher          // for matching a task producer with a poller
 		limiter         *taskListLimiter
 		clusterMetadata cluster.Metadata
@@ -193,7 +194,6 @@ func NewManager(p ManagerParams) (Manager, error) {
 		logger:          p.Logger.WithTags(tag.WorkflowDomainName(domainName), tag.WorkflowTaskListName(p.TaskList.GetName()), tag.WorkflowTaskListType(p.TaskList.GetType())),
 		db:              db,
 		taskAckManager:  messaging.NewAckManager(p.Logger),
-		taskGC:          newTaskGC(db, taskListConfig),
 		config:          taskListConfig,
 		matchingClient:  p.MatchingClient,
 		domainName:      domainName,
@@ -248,7 +248,17 @@ func NewManager(p ManagerParams) (Manager, error) {
 	}
 	tlMgr.limiter = newTaskListLimiter(p.TimeSource, tlMgr.scope, taskListConfig, numReadPartitionsFn)
 	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.logger, p.TaskList, p.TaskListKind, tlMgr.limiter).(*taskMatcherImpl)
-	tlMgr.taskWriter = newTaskWriter(tlMgr)
+	tlMgr.backlogs = []*taskBacklog{newTaskBacklog(tlMgr, taskpriority.DefaultLevel, db, tlMgr.taskAckManager)}
+	if p.TaskListKind == types.TaskListKindNormal && taskListConfig.EnableTaskPriority() {
+		for _, level := range taskpriority.Levels() {
+			if level == taskpriority.DefaultLevel {
+				continue
+			}
+			levelDB := newTaskListDB(p.TaskManager, p.TaskList.GetDomainID(), domainName, priorityBacklogName(p.TaskList.GetName(), level), p.TaskList.GetType(), int(p.TaskListKind), p.Logger)
+			tlMgr.backlogs = append(tlMgr.backlogs, newTaskBacklog(tlMgr, level, levelDB, messaging.NewAckManager(p.Logger)))
+		}
+	}
+	tlMgr.taskWriter = tlMgr.backlogs[0].writer
 	tlMgr.taskReader = newTaskReader(tlMgr, isolationGroups)
 	tlMgr.taskCompleter = newTaskCompleter(tlMgr, historyServiceOperationRetryPolicy)
 	tlMgr.startWG.Add(1)
@@ -282,9 +292,11 @@ func (c *taskListManagerImpl) Start(ctx context.Context) error {
 			c.partitionConfig = info.AdaptivePartitionConfig.ToInternalType()
 		}
 	}
-	if err := c.taskWriter.Start(); err != nil {
-		c.Stop()
-		return err
+	for _, backlog := range c.backlogs {
+		if err := backlog.writer.Start(); err != nil {
+			c.Stop()
+			return err
+		}
 	}
 	if c.taskListID.IsRoot() && c.taskListKind == types.TaskListKindNormal {
 		c.partitionConfig = c.db.PartitionConfig().ToInternalType()
@@ -325,7 +337,9 @@ func (c *taskListManagerImpl) Stop() {
 	}
 	c.qpsTracker.Stop()
 	c.liveness.Stop()
-	c.taskWriter.Stop()
+	for _, backlog := range c.backlogs {
+		backlog.writer.Stop()
+	}
 	c.taskReader.Stop()
 	c.matcher.DisconnectBlockedPollers()
 	c.stopWG.Wait()
@@ -393,7 +407,7 @@ func (c *taskListManagerImpl) LoadBalancerHints() *types.LoadBalancerHints {
 		return nil
 	}
 	return &types.LoadBalancerHints{
-		BacklogCount:  c.taskAckManager.GetBacklogCount(),
+		BacklogCount:  c.getBacklogCount(),
 		RatePerSecond: c.qpsTracker.QPS(),
 	}
 }
@@ -572,7 +586,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 				return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 			}
 
-			r, err := c.taskWriter.appendTask(params.TaskInfo)
+			r, err := c.getBacklog(params.TaskInfo).writer.appendTask(params.TaskInfo)
 			return r, err
 		}
 
@@ -598,7 +612,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 
 		e.EventName = "Task Sent to Writer"
 		event.Log(e)
-		return c.taskWriter.appendTask(params.TaskInfo)
+		return c.getBacklog(params.TaskInfo).writer.appendTask(params.TaskInfo)
 	})
 
 	if err == nil && !syncMatch {
@@ -670,7 +684,7 @@ func (c *taskListManagerImpl) GetTask(
 		return nil, fmt.Errorf("couldn't get task: %w", err)
 	}
 	task.domainName = c.domainName
-	task.BacklogCountHint = c.taskAckManager.GetBacklogCount()
+	task.BacklogCountHint = c.getBacklogCount()
 	return task, nil
 }
 
@@ -758,7 +772,7 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 	response.TaskListStatus = &types.TaskListStatus{
 		ReadLevel:        c.taskAckManager.GetReadLevel(),
 		AckLevel:         c.taskAckManager.GetAckLevel(),
-		BacklogCountHint: c.taskAckManager.GetBacklogCount(),
+		BacklogCountHint: c.getBacklogCount(),
 		RatePerSecond:    float64(c.limiter.Limit()),
 		TaskIDBlock: &types.TaskIDBlock{
 			StartID: idBlock.start,
@@ -766,12 +780,48 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 		},
 		IsolationGroupMetrics: isolationGroupMetrics,
 		NewTasksPerSecond:     c.qpsTracker.QPS(),
-		Empty:                 c.taskAckManager.GetAckLevel() == c.taskWriter.GetMaxReadLevel(),
+		Empty:                 true,
+	}
+	for _, backlog := range c.backlogs {
+		response.TaskListStatus.Empty = response.TaskListStatus.Empty && backlog.isEmpty()
+	}
+	if c.config.EnableTaskPriority() {
+		response.TaskListStatus.BacklogCountByPriority = c.getBacklogCountByPriority()
 	}
 
 	return response
 }
 
+// getBacklog returns the backlog the task is persisted in
+func (c *taskListManagerImpl) getBacklog(task *persistence.TaskInfo) *taskBacklog {
+	return findBacklog(c.backlogs, taskpriority.FromName(task.Priority))
+}
+
+// getBacklogCount returns the number of tasks read from the backlogs and not completed yet
+func (c *taskListManagerImpl) getBacklogCount() int64 {
+	var count int64
+	for _, backlog := range c.backlogs {
+		count += backlog.ackManager.GetBacklogCount()
+	}
+	return count
+}
+
+// getBacklogCountByPriority returns the number of persisted tasks of each priority level which are not acked yet.
+// The default level also counts the tasks of the levels without a backlog of their own.
+func (c *taskListManagerImpl) getBacklogCountByPriority() map[string]int64 {
+	counts := make(map[string]int64, len(c.backlogs))
+	for _, backlog := range c.backlogs {
+		count, err := backlog.db.GetTaskListSize(backlog.ackManager.GetAckLevel())
+		if err != nil {
+			// fall back to the count of the last time the backlog was measured
+			c.logger.Warn("Failed to get the backlog size of a priority level", tag.Dynamic("priority", backlog.level.String()), tag.Error(err))
+			count = backlog.db.BacklogCount()
+		}
+		counts[backlog.level.String()] = count
+	}
+	return counts
+}
+
 func (c *taskListManagerImpl) ReleaseBlockedPollers() error {
 	c.stoppedLock.RLock()
 	defer c.stoppedLock.RUnlock()
@@ -1112,6 +1162,12 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		IsolationGroupsPerPartition: func() int {
 			return cfg.IsolationGroupsPerPartition(domainName, taskListName, taskType)
 		},
+		EnableTaskPriority: func() bool {
+			return cfg.EnableTaskPriority(domainName, taskListName, taskType)
+		},
+		TaskPriorityWeightRatio: func() int {
+			return cfg.TaskPriorityWeightRatio(domainName, taskListName, taskType)
+		},
 		QPSTrackerInterval: func() time.Duration {
 			return cfg.QPSTrackerInterval(domainName, taskListName, taskType)
 		},
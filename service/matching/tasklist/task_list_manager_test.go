@@ -52,6 +52,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/stats"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/matching/config"
@@ -128,7 +129,7 @@ func TestDeliverBufferTasks(t *testing.T) {
 		func(tlm *taskListManagerImpl) { tlm.taskReader.cancelFunc() },
 		func(tlm *taskListManagerImpl) {
 			tlm.limiter.ReportLimit(0.1)
-			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].add(&persistence.TaskInfo{}, taskpriority.DefaultLevel)
 			err := tlm.matcher.(*taskMatcherImpl).ratelimit(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.cancelFunc()
@@ -162,7 +163,7 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	logger := testlogger.New(t)
 
 	tlm := createTestTaskListManager(t, logger, controller)
-	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].add(&persistence.TaskInfo{}, taskpriority.DefaultLevel)
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -199,12 +200,12 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 		},
 	}
 
-	require.True(t, tlm.taskReader.addTasksToBuffer(tasks))
+	require.True(t, tlm.taskReader.addTasksToBuffer(tlm.backlogs[0], tasks))
 	require.Equal(t, int64(0), tlm.taskAckManager.GetAckLevel())
 	require.Equal(t, int64(12), tlm.taskAckManager.GetReadLevel())
 
 	// Now add a mix of valid and expired tasks
-	require.True(t, tlm.taskReader.addTasksToBuffer([]*persistence.TaskInfo{
+	require.True(t, tlm.taskReader.addTasksToBuffer(tlm.backlogs[0], []*persistence.TaskInfo{
 		{
 			TaskID:      13,
 			Expiry:      time.Now().Add(-time.Minute),
@@ -348,6 +349,31 @@ func TestDescribeTaskList(t *testing.T) {
 				Empty: false,
 			},
 		},
+		{
+			name:          "with status, priority backlog",
+			includeStatus: true,
+			allowance: func(_ *gomock.Controller, tlm *taskListManagerImpl) {
+				tlm.config.EnableTaskPriority = func() bool { return true }
+				// the task list was loaded without task priority, so all the levels are persisted in its own backlog
+				tlm.db.store.(*TestTaskManager).SetRangeID(tlm.taskListID, tlm.db.RangeID())
+				for i := int64(1); i <= 3; i++ {
+					_, err := tlm.db.CreateTasks([]*persistence.CreateTaskInfo{{Data: &persistence.TaskInfo{}, TaskID: i}})
+					require.NoError(t, err)
+				}
+			},
+			expectedStatus: &types.TaskListStatus{
+				RatePerSecond: defaultRps,
+				TaskIDBlock:   firstIDBlock,
+				IsolationGroupMetrics: map[string]*types.IsolationGroupMetrics{
+					"datacenterA": {},
+					"datacenterB": {},
+				},
+				Empty: true,
+				BacklogCountByPriority: map[string]int64{
+					"normal": 3,
+				},
+			},
+		},
 		{
 			name:          "with status, pollers and metrics",
 			includeStatus: true,
@@ -556,7 +582,7 @@ func TestRateLimitErrorsFromTasklistDispatch(t *testing.T) {
 		return "datacenterA", -1
 	}
 
-	breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(&persistence.TaskInfo{})
+	breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(tlm.backlogs[0], &persistence.TaskInfo{})
 	assert.False(t, breakDispatcher)
 	assert.False(t, breakRetryLoop)
 }
@@ -588,7 +614,7 @@ func TestMisconfiguredZoneDoesNotBlock(t *testing.T) {
 	maxBufferSize := config.GetTasksBatchSize("", "", 0) - 1
 
 	for i := 0; i < maxBufferSize; i++ {
-		breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(&persistence.TaskInfo{})
+		breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(tlm.backlogs[0], &persistence.TaskInfo{})
 		assert.False(t, breakDispatcher, "dispatch isn't shutting down")
 		assert.True(t, breakRetryLoop, "should be able to successfully dispatch all these tasks to the default isolation group")
 	}
@@ -599,7 +625,7 @@ func TestMisconfiguredZoneDoesNotBlock(t *testing.T) {
 
 	// ok, and here we try and ensure that this *does not block
 	// and instead complains and live-retries
-	breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(&persistence.TaskInfo{})
+	breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(tlm.backlogs[0], &persistence.TaskInfo{})
 	assert.False(t, breakDispatcher, "dispatch isn't shutting down")
 	assert.True(t, breakRetryLoop, "task should be dispatched to default channel")
 }
@@ -927,9 +953,9 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := min(cap(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]), taskCount)
+	expectedBufSize := min(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].cap(), taskCount)
 	assert.True(t, awaitCondition(func() bool {
-		return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) == expectedBufSize
+		return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].len() == expectedBufSize
 	}, 10*time.Second))
 
 	// stop all goroutines that read / write tasks in the background
@@ -939,14 +965,14 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 	// SetReadLevel should NEVER be called without updating ackManager.outstandingTasks
 	// This is only for unit test purpose
 	tlm.taskAckManager.SetReadLevel(tlm.taskWriter.GetMaxReadLevel())
-	tasks, readLevel, isReadBatchDone, err := tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err := tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.NoError(t, err)
 	assert.Equal(t, 0, len(tasks))
 	assert.Equal(t, readLevel, tlm.taskWriter.GetMaxReadLevel())
 	assert.True(t, isReadBatchDone)
 
 	tlm.taskAckManager.SetReadLevel(0)
-	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.NoError(t, err)
 	assert.Equal(t, rangeSize/2, len(tasks))
 	assert.Equal(t, rangeSize/2, int(readLevel))
@@ -990,7 +1016,7 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 		task.Finish(nil)
 	}
 	assert.Equal(t, taskCount-rangeSize, tm.GetTaskCount(taskListID))
-	tasks, _, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, _, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.NoError(t, err)
 	assert.True(t, 0 < len(tasks) && len(tasks) <= rangeSize)
 	assert.True(t, isReadBatchDone)
@@ -1099,7 +1125,7 @@ func TestTaskListManagerGetTaskBatch_ReadBatchDone(t *testing.T) {
 
 	tlm.taskAckManager.SetReadLevel(0)
 	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, maxReadLevel)
-	tasks, readLevel, isReadBatchDone, err := tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err := tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.Empty(t, tasks)
 	assert.Equal(t, int64(rangeSize/2*10), readLevel)
 	assert.False(t, isReadBatchDone)
@@ -1107,14 +1133,14 @@ func TestTaskListManagerGetTaskBatch_ReadBatchDone(t *testing.T) {
 
 	tlm.taskAckManager.SetReadLevel(readLevel)
 	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, maxReadLevel)
-	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.Empty(t, tasks)
 	assert.Equal(t, 2*int64(rangeSize/2*10), readLevel)
 	assert.False(t, isReadBatchDone)
 	assert.NoError(t, err)
 
 	tlm.taskAckManager.SetReadLevel(readLevel)
-	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	tasks, readLevel, isReadBatchDone, err = tlm.taskReader.getTaskBatch(tlm.backlogs[0], tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
 	assert.Empty(t, tasks)
 	assert.Equal(t, maxReadLevel, readLevel)
 	assert.True(t, isReadBatchDone)
@@ -1210,7 +1236,7 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 			// wait until all tasks are loaded by into in-memory buffers by task list manager
 			// the buffer size should be one less than expected because dispatcher will dequeue the head
 			assert.True(t, awaitCondition(func() bool {
-				return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) >= (taskCount/2 - 1)
+				return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].len() >= (taskCount/2 - 1)
 			}, time.Second))
 
 			remaining := taskCount
@@ -1232,6 +1258,61 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 	}
 }
 
+func TestPriorityBacklogs(t *testing.T) {
+	controller := gomock.NewController(t)
+	cfg := defaultTestConfig()
+	cfg.EnableTaskPriority = dynamicproperties.GetBoolPropertyFilteredByTaskListInfo(true)
+	cfg.GetTasksBatchSize = dynamicproperties.GetIntPropertyFilteredByTaskListInfo(3)
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, cfg, clock.NewRealTimeSource())
+	require.Len(t, tlm.backlogs, taskpriority.NumLevels)
+	assert.Equal(t, "tl", tlm.backlogs[0].db.taskListName)
+	assert.Equal(t, taskpriority.DefaultLevel, tlm.backlogs[0].level)
+	highest := findBacklog(tlm.backlogs, taskpriority.LevelHighest)
+	assert.Equal(t, "/__cadence_sys/tl/priority-highest", highest.db.taskListName)
+	_, err := NewIdentifier("domain", highest.db.taskListName, persistence.TaskListTypeActivity)
+	assert.Error(t, err, "the backlog of a priority level must not be loaded as a task list")
+
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+
+	// the low priority backlog is deeper than the buffer of its level
+	for i, priority := range []string{"low", "low", "low", "low", "low", "highest"} {
+		_, err := tlm.AddTask(context.Background(), AddTaskParams{
+			TaskInfo: &persistence.TaskInfo{
+				DomainID:                      "domainId",
+				RunID:                         "run1",
+				WorkflowID:                    "workflow1",
+				ScheduleID:                    int64(i),
+				ScheduleToStartTimeoutSeconds: 100,
+				Priority:                      priority,
+			},
+		})
+		require.NoError(t, err)
+	}
+	buffer := tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]
+	assert.True(t, awaitCondition(func() bool {
+		return buffer.lenOf(taskpriority.LevelLow) == buffer.cap() && highest.ackManager.GetReadLevel() == highest.writer.GetMaxReadLevel()
+	}, 10*time.Second), "a full level must not keep the backlog of another level from being read")
+	assert.True(t, awaitCondition(func() bool {
+		status := tlm.DescribeTaskList(true).TaskListStatus
+		return status.BacklogCountByPriority["low"] == 5 && status.BacklogCountByPriority["highest"] == 1
+	}, 10*time.Second), "the status reports the persisted backlog of each level")
+
+	// the dispatcher may already hold a low priority task, after which the highest priority task goes first
+	var scheduleIDs []int64
+	for i := 0; i < 2; i++ {
+		task, err := tlm.GetTask(context.Background(), nil)
+		require.NoError(t, err)
+		scheduleIDs = append(scheduleIDs, task.Event.ScheduleID)
+		task.Finish(nil)
+	}
+	assert.ElementsMatch(t, []int64{0, 5}, scheduleIDs)
+	assert.True(t, awaitCondition(func() bool {
+		counts := tlm.DescribeTaskList(true).TaskListStatus.BacklogCountByPriority
+		return counts["low"] == 4 && counts["highest"] == 0
+	}, 10*time.Second), "completed tasks are acked in the backlog of their level")
+}
+
 func TestTaskListManagerImpl_HasPollerAfter(t *testing.T) {
 	for name, tc := range map[string]struct {
 		outstandingPollers []string
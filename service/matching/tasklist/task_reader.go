@@ -39,9 +39,9 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
-	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/event"
@@ -60,15 +60,12 @@ type (
 		// that are enqueued for pollers to pickup. It's written to by
 		// - getTasksPump - the primary means of loading async matching tasks
 		// - task dispatch redirection - when a task is redirected from another isolation group
-		taskBuffers     map[string]chan *persistence.TaskInfo
-		notifyC         chan struct{} // Used as signal to notify pump of new tasks
+		// Each buffer keeps the tasks of an isolation group by priority level
+		taskBuffers     map[string]*taskBuffer
+		backlogs        []*taskBacklog // read by a pump each, which buffers their tasks with the priority level of the backlog
 		tlMgr           *taskListManagerImpl
 		taskListID      *Identifier
 		config          *config.TaskListConfig
-		db              *taskListDB
-		taskWriter      *taskWriter
-		taskGC          *taskGC
-		taskAckManager  messaging.AckManager
 		domainCache     cache.DomainCache
 		clusterMetadata cluster.Metadata
 		timeSource      clock.TimeSource
@@ -96,7 +93,7 @@ type (
 
 func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
-	taskBuffers := make(map[string]chan *persistence.TaskInfo)
+	taskBuffers := make(map[string]*taskBuffer)
 
 	// Validate batch size to prevent system failures
 	batchSize := tlMgr.config.GetTasksBatchSize()
@@ -108,21 +105,17 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 		batchSize = fallback
 	}
 
-	taskBuffers[defaultTaskBufferIsolationGroup] = make(chan *persistence.TaskInfo, batchSize-1)
+	taskBuffers[defaultTaskBufferIsolationGroup] = newTaskBuffer(batchSize - 1)
 	for _, g := range isolationGroups {
-		taskBuffers[g] = make(chan *persistence.TaskInfo, batchSize-1)
+		taskBuffers[g] = newTaskBuffer(batchSize - 1)
 	}
 	return &taskReader{
-		tlMgr:          tlMgr,
-		taskListID:     tlMgr.taskListID,
-		config:         tlMgr.config,
-		db:             tlMgr.db,
-		taskWriter:     tlMgr.taskWriter,
-		taskGC:         tlMgr.taskGC,
-		taskAckManager: tlMgr.taskAckManager,
-		cancelCtx:      ctx,
-		cancelFunc:     cancel,
-		notifyC:        make(chan struct{}, 1),
+		tlMgr:      tlMgr,
+		taskListID: tlMgr.taskListID,
+		config:     tlMgr.config,
+		backlogs:   tlMgr.backlogs,
+		cancelCtx:  ctx,
+		cancelFunc: cancel,
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffers:              taskBuffers,
@@ -153,123 +146,131 @@ func (tr *taskReader) Start() {
 			tr.dispatchBufferedTasks(g)
 		}()
 	}
-	tr.stopWg.Add(1)
-	go func() {
-		defer tr.stopWg.Done()
-		tr.getTasksPump()
-	}()
+	for _, b := range tr.backlogs {
+		b := b
+		tr.stopWg.Add(1)
+		go func() {
+			defer tr.stopWg.Done()
+			tr.getTasksPump(b)
+		}()
+	}
 }
 
 func (tr *taskReader) Stop() {
 	if atomic.CompareAndSwapInt64(&tr.stopped, 0, 1) {
 		tr.cancelFunc()
-		if err := tr.persistAckLevel(); err != nil {
-			tr.logger.Error("Persistent store operation failure",
-				tag.StoreOperationUpdateTaskList,
-				tag.Error(err))
+		for _, b := range tr.backlogs {
+			if err := tr.persistAckLevel(b); err != nil {
+				tr.logger.Error("Persistent store operation failure",
+					tag.StoreOperationUpdateTaskList,
+					tag.Error(err))
+			}
+			b.gc.RunNow(b.ackManager.GetAckLevel())
 		}
-		tr.taskGC.RunNow(tr.taskAckManager.GetAckLevel())
 		tr.stopWg.Wait()
 	}
 }
 
+// Signal notifies the pumps of all the backlogs that there may be new tasks to read
 func (tr *taskReader) Signal() {
-	var event struct{}
-	select {
-	case tr.notifyC <- event:
-	default: // channel already has an event, don't block
+	for _, b := range tr.backlogs {
+		signal(b.notifyC)
 	}
 }
 
 func (tr *taskReader) dispatchBufferedTasks(isolationGroup string) {
+	buffer := tr.taskBuffers[isolationGroup]
 dispatchLoop:
-	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffers[isolationGroup]:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
-			}
-			event.Log(event.E{
-				TaskListName: tr.taskListID.GetName(),
-				TaskListType: tr.taskListID.GetType(),
-				TaskListKind: &tr.tlMgr.taskListKind,
-				TaskInfo:     *taskInfo,
-				EventName:    "Attempting to Dispatch Buffered Task",
-			})
-			breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
-			if breakDispatchLoop {
-				// shutting down
+	for tr.cancelCtx.Err() == nil {
+		taskInfo, level, ok := buffer.next(taskpriority.Weights(tr.config.TaskPriorityWeightRatio()))
+		if !ok {
+			if !buffer.wait(tr.cancelCtx) {
 				break dispatchLoop
 			}
-		case <-tr.cancelCtx.Done():
+			continue dispatchLoop
+		}
+		event.Log(event.E{
+			TaskListName: tr.taskListID.GetName(),
+			TaskListType: tr.taskListID.GetType(),
+			TaskListKind: &tr.tlMgr.taskListKind,
+			TaskInfo:     *taskInfo,
+			EventName:    "Attempting to Dispatch Buffered Task",
+		})
+		breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(findBacklog(tr.backlogs, level), taskInfo)
+		if breakDispatchLoop {
+			// shutting down
 			break dispatchLoop
 		}
 	}
 }
 
-func (tr *taskReader) getTasksPump() {
+// getTasksPump reads the tasks of the backlog into the task buffers
+func (tr *taskReader) getTasksPump(b *taskBacklog) {
 	updateAckTimer := tr.timeSource.NewTimer(tr.config.UpdateAckInterval())
 	defer updateAckTimer.Stop()
 getTasksPumpLoop:
 	for {
-		tr.scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.taskAckManager.GetBacklogCount()))
+		tr.scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.tlMgr.getBacklogCount()))
 		select {
 		case <-tr.cancelCtx.Done():
 			break getTasksPumpLoop
-		case <-tr.notifyC:
+		case <-b.notifyC:
 			{
-				initialReadLevel := tr.taskAckManager.GetReadLevel()
-				maxReadLevel := tr.taskWriter.GetMaxReadLevel()
+				initialReadLevel := b.ackManager.GetReadLevel()
+				maxReadLevel := b.writer.GetMaxReadLevel()
 
-				tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch(initialReadLevel, maxReadLevel)
+				tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch(b, initialReadLevel, maxReadLevel)
 				if err != nil {
-					tr.Signal() // re-enqueue the event
+					signal(b.notifyC) // re-enqueue the event
 					// TODO: Should we ever stop retrying on db errors?
 					continue getTasksPumpLoop
 				}
 
 				if len(tasks) == 0 {
-					tr.taskAckManager.SetReadLevel(readLevel)
+					b.ackManager.SetReadLevel(readLevel)
 
-					if tr.taskAckManager.GetAckLevel() == initialReadLevel {
+					if b.ackManager.GetAckLevel() == initialReadLevel {
 						// Even though we didn't handle any tasks, we want to advance the ack-level
 						// in order to avoid needless querying database the next time.
 						// This is safe since we started reading exactly from the current AckLevel and read no tasks
-						tr.taskAckManager.SetAckLevel(readLevel)
+						b.ackManager.SetAckLevel(readLevel)
 					}
 
 					if !isReadBatchDone {
-						tr.Signal()
+						signal(b.notifyC)
 					}
 					continue getTasksPumpLoop
 				}
 
-				if !tr.addTasksToBuffer(tasks) {
+				if !tr.addTasksToBuffer(b, tasks) {
 					break getTasksPumpLoop
 				}
 				// There maybe more tasks. We yield now, but signal pump to check again later.
-				tr.Signal()
+				signal(b.notifyC)
 			}
 		case <-updateAckTimer.Chan():
 			{
-				ackLevel := tr.taskAckManager.GetAckLevel()
-				if size, err := tr.db.GetTaskListSize(ackLevel); err == nil {
-					tr.scope.UpdateGauge(metrics.TaskCountPerTaskListGauge, float64(size))
+				ackLevel := b.ackManager.GetAckLevel()
+				if size, err := b.db.GetTaskListSize(ackLevel); err == nil {
+					tr.scope.UpdateGauge(metrics.TaskCountPerTaskListGauge, float64(tr.getTaskCount()))
+					if tr.config.EnableTaskPriority() {
+						tr.scope.Tagged(metrics.TaskPriorityTag(b.level.String())).UpdateGauge(metrics.TaskBacklogPerPriorityPerTaskListGauge, float64(size))
+					}
 				}
-				if err := tr.handleErr(tr.persistAckLevel()); err != nil {
+				if err := tr.handleErr(tr.persistAckLevel(b)); err != nil {
 					tr.logger.Error("Persistent store operation failure",
 						tag.StoreOperationUpdateTaskList,
 						tag.Error(err))
 					// keep going as saving ack is not critical
 				}
-				tr.Signal() // periodically signal pump to check persistence for tasks
+				signal(b.notifyC) // periodically signal pump to check persistence for tasks
 				updateAckTimer.Reset(tr.config.UpdateAckInterval())
 			}
 		}
 	}
 }
 
-func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
+func (tr *taskReader) getTaskBatchWithRange(b *taskBacklog, readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
 	var response *persistence.GetTasksResponse
 
 	// Validate batch size to prevent requesting 0 tasks
@@ -283,7 +284,7 @@ func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64)
 	}
 
 	op := func(ctx context.Context) (err error) {
-		response, err = tr.db.GetTasks(readLevel, maxReadLevel, batchSize)
+		response, err = b.db.GetTasks(readLevel, maxReadLevel, batchSize)
 		return
 	}
 	err := tr.throttleRetry.Do(context.Background(), op)
@@ -301,7 +302,7 @@ func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64)
 // Returns a batch of tasks from persistence starting form current read level.
 // Also return a number that can be used to update readLevel
 // Also return a bool to indicate whether read is finished
-func (tr *taskReader) getTaskBatch(readLevel, maxReadLevel int64) ([]*persistence.TaskInfo, int64, bool, error) {
+func (tr *taskReader) getTaskBatch(b *taskBacklog, readLevel, maxReadLevel int64) ([]*persistence.TaskInfo, int64, bool, error) {
 	var tasks []*persistence.TaskInfo
 
 	// counter i is used to break and let caller check whether tasklist is still alive and need resume read.
@@ -310,7 +311,7 @@ func (tr *taskReader) getTaskBatch(readLevel, maxReadLevel int64) ([]*persistenc
 		if upper > maxReadLevel {
 			upper = maxReadLevel
 		}
-		tasks, err := tr.getTaskBatchWithRange(readLevel, upper)
+		tasks, err := tr.getTaskBatchWithRange(b, readLevel, upper)
 		if err != nil {
 			return nil, readLevel, true, err
 		}
@@ -327,24 +328,24 @@ func (tr *taskReader) isTaskExpired(t *persistence.TaskInfo) bool {
 	return !t.Expiry.IsZero() && t.Expiry.After(epochStartTime) && tr.timeSource.Now().After(t.Expiry)
 }
 
-func (tr *taskReader) addTasksToBuffer(tasks []*persistence.TaskInfo) bool {
+func (tr *taskReader) addTasksToBuffer(b *taskBacklog, tasks []*persistence.TaskInfo) bool {
 	for _, t := range tasks {
-		if !tr.addSingleTaskToBuffer(t) {
+		if !tr.addSingleTaskToBuffer(b, t) {
 			return false // we are shutting down the task list
 		}
 	}
 	return true
 }
 
-func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
+func (tr *taskReader) addSingleTaskToBuffer(b *taskBacklog, task *persistence.TaskInfo) bool {
 	if tr.isTaskExpired(task) {
 		tr.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
 		// Also increment readLevel for expired tasks otherwise it could result in
 		// looping over the same tasks if all tasks read in the batch are expired
-		tr.taskAckManager.SetReadLevel(task.TaskID)
+		b.ackManager.SetReadLevel(task.TaskID)
 		return true
 	}
-	err := tr.taskAckManager.ReadItem(task.TaskID)
+	err := b.ackManager.ReadItem(task.TaskID)
 	if err != nil {
 		tr.logger.Fatal("critical bug when adding item to ackManager", tag.Error(err))
 	}
@@ -354,32 +355,40 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 	if !ok {
 		buffer = tr.taskBuffers[defaultTaskBufferIsolationGroup]
 	}
-	select {
-	case buffer <- task:
-		return true
-	case <-tr.cancelCtx.Done():
+	if !buffer.waitForSpace(tr.cancelCtx, b.level) {
 		return false
 	}
+	buffer.add(task, b.level)
+	return true
+}
+
+// getTaskCount returns the number of persisted tasks of all the backlogs, as last counted by their pumps
+func (tr *taskReader) getTaskCount() int64 {
+	var count int64
+	for _, b := range tr.backlogs {
+		count += b.db.BacklogCount()
+	}
+	return count
 }
 
-func (tr *taskReader) persistAckLevel() error {
-	ackLevel := tr.taskAckManager.GetAckLevel()
+func (tr *taskReader) persistAckLevel(b *taskBacklog) error {
+	ackLevel := b.ackManager.GetAckLevel()
 	if ackLevel >= 0 {
-		maxReadLevel := tr.taskWriter.GetMaxReadLevel()
+		maxReadLevel := b.writer.GetMaxReadLevel()
 		// note: this metrics is only an estimation for the lag. taskID in DB may not be continuous,
 		// especially when task list ownership changes.
 		tr.scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(maxReadLevel-ackLevel))
 
-		return tr.db.UpdateState(ackLevel)
+		return b.db.UpdateState(ackLevel)
 	}
 	return nil
 }
 
-// completeTask marks a task as processed. Only tasks created by taskReader (i.e. backlog from db) reach
-// here. As part of completion:
+// completeTask marks a task of the backlog as processed. Only tasks created by taskReader (i.e. backlog from db)
+// reach here. As part of completion:
 //   - task is deleted from the database when err is nil
 //   - new task is created and current task is deleted when err is not nil
-func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
+func (tr *taskReader) completeTask(b *taskBacklog, task *persistence.TaskInfo, err error) {
 	if err != nil {
 		// failed to start the task.
 		// We cannot just remove it from persistence because then it will be lost.
@@ -389,7 +398,7 @@ func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
 		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
 		// re-written to persistence frequently.
 		op := func(ctx context.Context) error {
-			_, err := tr.taskWriter.appendTask(task)
+			_, err := b.writer.appendTask(task)
 			return err
 		}
 		err = tr.throttleRetry.Do(context.Background(), op)
@@ -401,10 +410,10 @@ func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
 			tr.onFatalErr()
 			return
 		}
-		tr.Signal()
+		signal(b.notifyC)
 	}
-	ackLevel := tr.taskAckManager.AckItem(task.TaskID)
-	tr.taskGC.Run(ackLevel)
+	ackLevel := b.ackManager.AckItem(task.TaskID)
+	b.gc.Run(ackLevel)
 }
 
 func (tr *taskReader) newDispatchContext(isolationGroup string, isolationDuration time.Duration) (context.Context, context.CancelFunc) {
@@ -439,17 +448,17 @@ func (tr *taskReader) getDispatchTimeout(rps float64, isolationDuration time.Dur
 	return timeout
 }
 
-func (tr *taskReader) dispatchSingleTaskFromBufferWithRetries(taskInfo *persistence.TaskInfo) (breakDispatchLoop bool) {
+func (tr *taskReader) dispatchSingleTaskFromBufferWithRetries(b *taskBacklog, taskInfo *persistence.TaskInfo) (breakDispatchLoop bool) {
 	// retry loop for dispatching a single task
 	for {
-		breakDispatchLoop, breakRetryLoop := tr.dispatchSingleTaskFromBuffer(taskInfo)
+		breakDispatchLoop, breakRetryLoop := tr.dispatchSingleTaskFromBuffer(b, taskInfo)
 		if breakRetryLoop {
 			return breakDispatchLoop
 		}
 	}
 }
 
-func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInfo) (breakDispatchLoop bool, breakRetries bool) {
+func (tr *taskReader) dispatchSingleTaskFromBuffer(b *taskBacklog, taskInfo *persistence.TaskInfo) (breakDispatchLoop bool, breakRetries bool) {
 	e := event.E{
 		TaskListName: tr.taskListID.GetName(),
 		TaskListType: tr.taskListID.GetType(),
@@ -460,7 +469,7 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		e.EventName = "Task Expired"
 		event.Log(e)
 		tr.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
-		tr.taskAckManager.AckItem(taskInfo.TaskID)
+		b.ackManager.AckItem(taskInfo.TaskID)
 		return false, true
 	}
 	isolationGroup, isolationDuration := tr.getIsolationGroupForTask(tr.cancelCtx, taskInfo)
@@ -469,7 +478,8 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		isolationGroup = defaultTaskBufferIsolationGroup
 		isolationDuration = noIsolationTimeout
 	}
-	task := newInternalTask(taskInfo, tr.completeTask, types.TaskSourceDbBacklog, "", false, nil, isolationGroup)
+	completeTask := func(task *persistence.TaskInfo, err error) { tr.completeTask(b, task, err) }
+	task := newInternalTask(taskInfo, completeTask, types.TaskSourceDbBacklog, "", false, nil, isolationGroup)
 	dispatchCtx, cancel := tr.newDispatchContext(isolationGroup, isolationDuration)
 	timerScope := tr.scope.StartTimer(metrics.AsyncMatchLatencyPerTaskList)
 	err := tr.dispatchTask(dispatchCtx, task)
@@ -231,7 +231,7 @@ func TestDispatchSingleTaskFromBuffer(t *testing.T) {
 			taskInfo := newTask(timeSource)
 			taskInfo.Expiry = timeSource.Now().Add(time.Duration(tc.ttl) * time.Second)
 
-			breakDispatch, breakRetries := reader.dispatchSingleTaskFromBuffer(taskInfo)
+			breakDispatch, breakRetries := reader.dispatchSingleTaskFromBuffer(tlm.backlogs[0], taskInfo)
 			assert.Equal(t, tc.breakDispatch, breakDispatch)
 			assert.Equal(t, tc.breakRetries, breakRetries)
 		})
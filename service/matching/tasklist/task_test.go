@@ -30,6 +30,7 @@ import (
 
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -37,6 +38,7 @@ func TestNewInternalTask(t *testing.T) {
 	cases := []struct {
 		name                    string
 		partitionConfig         map[string]string
+		priority                string
 		source                  types.TaskSource
 		forwardedFrom           string
 		forSyncMatch            bool
@@ -128,14 +130,32 @@ func TestNewInternalTask(t *testing.T) {
 				isolationgroup.WorkflowIDKey:    "workflowID",
 			},
 		},
+		{
+			name:     "priority",
+			source:   types.TaskSourceDbBacklog,
+			priority: "high",
+			additionalAssertions: func(t *testing.T, task *InternalTask) {
+				assert.Equal(t, taskpriority.LevelHigh, task.Priority())
+			},
+		},
+		{
+			name:   "default priority",
+			source: types.TaskSourceHistory,
+			additionalAssertions: func(t *testing.T, task *InternalTask) {
+				assert.Equal(t, taskpriority.DefaultLevel, task.Priority())
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			completionFunc := func(_ *persistence.TaskInfo, _ error) {}
 			taskInfo := defaultTaskInfo(tc.partitionConfig)
+			taskInfo.Priority = tc.priority
 			activityDispatchInfo := &types.ActivityTaskDispatchInfo{WorkflowDomain: "domain"}
 			task := newInternalTask(taskInfo, completionFunc, tc.source, tc.forwardedFrom, tc.forSyncMatch, activityDispatchInfo, tc.isolationGroup)
-			assert.Equal(t, defaultTaskInfo(tc.expectedPartitionConfig), task.Event.TaskInfo)
+			expectedTaskInfo := defaultTaskInfo(tc.expectedPartitionConfig)
+			expectedTaskInfo.Priority = tc.priority
+			assert.Equal(t, expectedTaskInfo, task.Event.TaskInfo)
 			assert.NotNil(t, task.Event.completionFunc)
 			assert.Equal(t, tc.source, task.source)
 			assert.Equal(t, tc.forwardedFrom, task.forwardedFrom)
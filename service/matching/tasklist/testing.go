@@ -43,11 +43,19 @@ type (
 	TestTaskManager struct {
 		sync.Mutex
 		t          *testing.T
-		taskLists  map[Identifier]*testTaskListManager
+		taskLists  map[testTaskListKey]*testTaskListManager
 		logger     log.Logger
 		timeSource clock.TimeSource
 	}
 
+	// testTaskListKey identifies a persisted task list, which may not be a task list partition,
+	// e.g. the backlog of a priority level
+	testTaskListKey struct {
+		domainID string
+		name     string
+		taskType int
+	}
+
 	testTaskListManager struct {
 		sync.RWMutex
 		rangeID                 int64
@@ -66,7 +74,7 @@ func newTestTaskListManager() *testTaskListManager {
 func NewTestTaskManager(t *testing.T, logger log.Logger, timeSource clock.TimeSource) *TestTaskManager {
 	return &TestTaskManager{
 		t:          t,
-		taskLists:  make(map[Identifier]*testTaskListManager),
+		taskLists:  make(map[testTaskListKey]*testTaskListManager),
 		logger:     logger,
 		timeSource: timeSource,
 	}
@@ -84,7 +92,7 @@ func (m *TestTaskManager) LeaseTaskList(
 	_ context.Context,
 	request *persistence.LeaseTaskListRequest,
 ) (*persistence.LeaseTaskListResponse, error) {
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManager(testTaskListKey{request.DomainID, request.TaskList, request.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	if request.RangeID > 0 && request.RangeID != tlm.rangeID {
@@ -114,7 +122,7 @@ func (m *TestTaskManager) GetTaskList(
 	_ context.Context,
 	request *persistence.GetTaskListRequest,
 ) (*persistence.GetTaskListResponse, error) {
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManager(testTaskListKey{request.DomainID, request.TaskList, request.TaskType})
 	tlm.RLock()
 	defer tlm.RUnlock()
 	return &persistence.GetTaskListResponse{
@@ -138,7 +146,7 @@ func (m *TestTaskManager) UpdateTaskList(
 	m.logger.Debug(fmt.Sprintf("testTaskManager.UpdateTaskList taskListInfo=%v, ackLevel=%v", request.TaskListInfo, request.TaskListInfo.AckLevel))
 
 	tli := request.TaskListInfo
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManager(testTaskListKey{tli.DomainID, tli.Name, tli.TaskType})
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -162,7 +170,7 @@ func (m *TestTaskManager) CompleteTask(
 	}
 
 	tli := request.TaskList
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManager(testTaskListKey{tli.DomainID, tli.Name, tli.TaskType})
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -177,7 +185,7 @@ func (m *TestTaskManager) CompleteTasksLessThan(
 	request *persistence.CompleteTasksLessThanRequest,
 ) (*persistence.CompleteTasksLessThanResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.CompleteTasksLessThan taskID=%v", request.TaskID))
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskListName, request.TaskType))
+	tlm := m.getTaskListManager(testTaskListKey{request.DomainID, request.TaskListName, request.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	rowsDeleted := 0
@@ -207,8 +215,7 @@ func (m *TestTaskManager) DeleteTaskList(
 ) error {
 	m.Lock()
 	defer m.Unlock()
-	key := NewTestTaskListID(m.t, request.DomainID, request.TaskListName, request.TaskListType)
-	delete(m.taskLists, *key)
+	delete(m.taskLists, testTaskListKey{request.DomainID, request.TaskListName, request.TaskListType})
 	return nil
 }
 
@@ -222,7 +229,7 @@ func (m *TestTaskManager) CreateTasks(
 	taskType := request.TaskListInfo.TaskType
 	rangeID := request.TaskListInfo.RangeID
 
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, domainID, taskList, taskType))
+	tlm := m.getTaskListManager(testTaskListKey{domainID, taskList, taskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -258,6 +265,7 @@ func (m *TestTaskManager) CreateTasks(
 			ScheduleID:      scheduleID,
 			TaskID:          task.TaskID,
 			PartitionConfig: task.Data.PartitionConfig,
+			Priority:        task.Data.Priority,
 		}
 		if task.Data.ScheduleToStartTimeoutSeconds != 0 {
 			info.Expiry = m.timeSource.Now().Add(time.Duration(task.Data.ScheduleToStartTimeoutSeconds) * time.Second)
@@ -276,7 +284,7 @@ func (m *TestTaskManager) GetTasks(
 ) (*persistence.GetTasksResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.GetTasks readLevel=%v, maxReadLevel=%v", request.ReadLevel, *request.MaxReadLevel))
 
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManager(testTaskListKey{request.DomainID, request.TaskList, request.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	var tasks []*persistence.TaskInfo
@@ -298,7 +306,7 @@ func (m *TestTaskManager) GetTasks(
 }
 
 func (m *TestTaskManager) GetTaskListSize(_ context.Context, request *persistence.GetTaskListSizeRequest) (*persistence.GetTaskListSizeResponse, error) {
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskListName, request.TaskListType))
+	tlm := m.getTaskListManager(testTaskListKey{request.DomainID, request.TaskListName, request.TaskListType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	count := int64(0)
@@ -319,7 +327,7 @@ func (m *TestTaskManager) GetOrphanTasks(_ context.Context, request *persistence
 
 // getTaskCount returns number of tasks in a task list
 func (m *TestTaskManager) GetTaskCount(taskList *Identifier) int {
-	tlm := m.getTaskListManager(taskList)
+	tlm := m.getTaskListManager(testTaskListKey{taskList.GetDomainID(), taskList.GetName(), taskList.GetType()})
 	tlm.Lock()
 	defer tlm.Unlock()
 	return tlm.tasks.Size()
@@ -327,35 +335,35 @@ func (m *TestTaskManager) GetTaskCount(taskList *Identifier) int {
 
 // getCreateTaskCount returns how many times CreateTask was called
 func (m *TestTaskManager) GetCreateTaskCount(taskList *Identifier) int {
-	tlm := m.getTaskListManager(taskList)
+	tlm := m.getTaskListManager(testTaskListKey{taskList.GetDomainID(), taskList.GetName(), taskList.GetType()})
 	tlm.Lock()
 	defer tlm.Unlock()
 	return tlm.createTaskCount
 }
 
 func (m *TestTaskManager) SetRangeID(taskList *Identifier, rangeID int64) {
-	tlm := m.getTaskListManager(taskList)
+	tlm := m.getTaskListManager(testTaskListKey{taskList.GetDomainID(), taskList.GetName(), taskList.GetType()})
 	tlm.Lock()
 	defer tlm.Unlock()
 	tlm.rangeID = rangeID
 }
 
 func (m *TestTaskManager) GetRangeID(taskList *Identifier) int64 {
-	tlm := m.getTaskListManager(taskList)
+	tlm := m.getTaskListManager(testTaskListKey{taskList.GetDomainID(), taskList.GetName(), taskList.GetType()})
 	tlm.Lock()
 	defer tlm.Unlock()
 	return tlm.rangeID
 }
 
-func (m *TestTaskManager) getTaskListManager(id *Identifier) *testTaskListManager {
+func (m *TestTaskManager) getTaskListManager(key testTaskListKey) *testTaskListManager {
 	m.Lock()
 	defer m.Unlock()
-	result, ok := m.taskLists[*id]
+	result, ok := m.taskLists[key]
 	if ok {
 		return result
 	}
 	result = newTestTaskListManager()
-	m.taskLists[*id] = result
+	m.taskLists[key] = result
 	return result
 }
 
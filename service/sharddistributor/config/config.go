@@ -70,6 +70,18 @@ type (
 		Mode string `yaml:"mode"` // TODO: this should be an ENUM with possible modes: enabled, read_only, proxy, disabled
 		// ShardNum is defined for fixed namespace.
 		ShardNum int64 `yaml:"shardNum"`
+		// AssignmentStrategy is how the leader places shards on executors. Supported values: round_robin|load_balanced.
+		// Default: round_robin
+		AssignmentStrategy string `yaml:"assignmentStrategy"`
+		// MaxMovesPerCycle caps the number of shards moved between healthy executors by a single rebalance of the load_balanced strategy.
+		// Default: 10
+		MaxMovesPerCycle int `yaml:"maxMovesPerCycle"`
+		// MoveCooldown is the minimum duration between two moves of the same shard by the load_balanced strategy.
+		// Default: 1 minute
+		MoveCooldown time.Duration `yaml:"moveCooldown"`
+		// LoadImbalanceRatio is how much the load of an executor may exceed the average load before the load_balanced strategy moves its shards.
+		// Default: 0.1
+		LoadImbalanceRatio float64 `yaml:"loadImbalanceRatio"`
 	}
 
 	Election struct {
@@ -99,6 +111,11 @@ const (
 	NamespaceTypeEphemeral = "ephemeral"
 )
 
+const (
+	AssignmentStrategyRoundRobin   = "round_robin"
+	AssignmentStrategyLoadBalanced = "load_balanced"
+)
+
 const (
 	MigrationModeINVALID                = "invalid"
 	MigrationModeLOCALPASSTHROUGH       = "local_pass"
@@ -0,0 +1,151 @@
+package process
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+// updateAssignmentsByLoad places the shards to reassign on the least loaded executors, then moves shards from the
+// most loaded executors to the least loaded ones until no executor exceeds the average load by more than LoadImbalanceRatio.
+// Shards moved within MoveCooldown are never moved again, and at most MaxMovesPerCycle shards are moved between active executors.
+func (p *namespaceProcessor) updateAssignmentsByLoad(
+	shardsToReassign []string,
+	activeExecutors []string,
+	currentAssignments map[string][]string,
+	shardStats map[string]store.ShardStatistics,
+) (distributionChanged bool) {
+	shardLoad := newShardLoadFunc(shardStats)
+
+	loads := make(map[string]float64, len(activeExecutors))
+	for _, executorID := range activeExecutors {
+		for _, shardID := range currentAssignments[executorID] {
+			loads[executorID] += shardLoad(shardID)
+		}
+	}
+
+	// Place the heaviest shards first, so that the lighter ones even out the remaining difference.
+	shards := slices.Clone(shardsToReassign)
+	slices.SortFunc(shards, func(a, b string) int {
+		if la, lb := shardLoad(a), shardLoad(b); la != lb {
+			if la > lb {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	for _, shardID := range shards {
+		executorID := leastLoadedExecutor(activeExecutors, loads, currentAssignments)
+		currentAssignments[executorID] = append(currentAssignments[executorID], shardID)
+		loads[executorID] += shardLoad(shardID)
+	}
+
+	moves := p.moveShardsByLoad(activeExecutors, currentAssignments, loads, shardLoad, shardStats)
+	if moves > 0 {
+		p.logger.Info("Moved shards to balance executor load", tag.Counter(moves))
+	}
+	return len(shards) > 0 || moves > 0
+}
+
+// moveShardsByLoad moves shards from the most loaded executor to the least loaded one, and returns the number of moved shards.
+func (p *namespaceProcessor) moveShardsByLoad(
+	activeExecutors []string,
+	currentAssignments map[string][]string,
+	loads map[string]float64,
+	shardLoad func(string) float64,
+	shardStats map[string]store.ShardStatistics,
+) int {
+	if len(activeExecutors) < 2 {
+		return 0
+	}
+
+	totalLoad := 0.0
+	for _, executorID := range activeExecutors {
+		totalLoad += loads[executorID]
+	}
+	threshold := totalLoad / float64(len(activeExecutors)) * (1 + p.namespaceCfg.LoadImbalanceRatio)
+	now := p.timeSource.Now().UTC()
+
+	moved := make(map[string]struct{})
+	moves := 0
+	for moves < p.namespaceCfg.MaxMovesPerCycle {
+		hottest, coldest := activeExecutors[0], activeExecutors[0]
+		for _, executorID := range activeExecutors[1:] {
+			if loads[executorID] > loads[hottest] {
+				hottest = executorID
+			}
+			if loads[executorID] < loads[coldest] {
+				coldest = executorID
+			}
+		}
+		if loads[hottest] <= threshold {
+			break
+		}
+
+		// Pick the shard which brings the two executors closest to each other.
+		// A shard at least as heavy as the gap would not reduce the imbalance, it would only swap the executors.
+		gap := loads[hottest] - loads[coldest]
+		best, bestDiff := -1, gap
+		for i, shardID := range currentAssignments[hottest] {
+			if _, ok := moved[shardID]; ok {
+				continue
+			}
+			if stats, ok := shardStats[shardID]; ok && !stats.LastMoveTime.IsZero() && now.Sub(stats.LastMoveTime) < p.namespaceCfg.MoveCooldown {
+				continue
+			}
+			if diff := math.Abs(gap - 2*shardLoad(shardID)); diff < bestDiff {
+				best, bestDiff = i, diff
+			}
+		}
+		if best < 0 {
+			// The most loaded executor has no shard which can be moved in this cycle.
+			break
+		}
+
+		shardID := currentAssignments[hottest][best]
+		currentAssignments[hottest] = slices.Delete(currentAssignments[hottest], best, best+1)
+		currentAssignments[coldest] = append(currentAssignments[coldest], shardID)
+		loads[hottest] -= shardLoad(shardID)
+		loads[coldest] += shardLoad(shardID)
+		moved[shardID] = struct{}{}
+		moves++
+	}
+	return moves
+}
+
+// newShardLoadFunc returns the load of a shard from its smoothed load.
+// Shards without statistics are assumed to have the average load, and every shard has the same load
+// when no load is reported at all, which degrades to balancing the number of shards.
+func newShardLoadFunc(shardStats map[string]store.ShardStatistics) func(string) float64 {
+	totalLoad := 0.0
+	for _, stats := range shardStats {
+		totalLoad += stats.SmoothedLoad
+	}
+	if totalLoad <= 0 {
+		return func(string) float64 { return 1 }
+	}
+
+	defaultLoad := totalLoad / float64(len(shardStats))
+	return func(shardID string) float64 {
+		if stats, ok := shardStats[shardID]; ok {
+			return stats.SmoothedLoad
+		}
+		return defaultLoad
+	}
+}
+
+// leastLoadedExecutor returns the executor with the lowest load, preferring the one with fewer shards on ties.
+func leastLoadedExecutor(activeExecutors []string, loads map[string]float64, currentAssignments map[string][]string) string {
+	result := activeExecutors[0]
+	for _, executorID := range activeExecutors[1:] {
+		if loads[executorID] < loads[result] ||
+			(loads[executorID] == loads[result] && len(currentAssignments[executorID]) < len(currentAssignments[result])) {
+			result = executorID
+		}
+	}
+	return result
+}
@@ -0,0 +1,259 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+func newLoadBalancedProcessor(t *testing.T, mutate func(cfg *config.Namespace)) (*namespaceProcessor, *testDependencies) {
+	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+	mocks.cfg.AssignmentStrategy = config.AssignmentStrategyLoadBalanced
+	if mutate != nil {
+		mutate(&mocks.cfg)
+	}
+	return mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor), mocks
+}
+
+func TestCreateProcessor_LoadBalancingDefaults(t *testing.T) {
+	processor, _ := newLoadBalancedProcessor(t, nil)
+	assert.Equal(t, _defaultMaxMovesPerCycle, processor.namespaceCfg.MaxMovesPerCycle)
+	assert.Equal(t, _defaultMoveCooldown, processor.namespaceCfg.MoveCooldown)
+	assert.Equal(t, _defaultLoadImbalanceRatio, processor.namespaceCfg.LoadImbalanceRatio)
+}
+
+func TestNewShardLoadFunc(t *testing.T) {
+	t.Run("no load reported", func(t *testing.T) {
+		shardLoad := newShardLoadFunc(map[string]store.ShardStatistics{"0": {}})
+		assert.Equal(t, 1.0, shardLoad("0"))
+		assert.Equal(t, 1.0, shardLoad("1"))
+	})
+	t.Run("unknown shards have the average load", func(t *testing.T) {
+		shardLoad := newShardLoadFunc(map[string]store.ShardStatistics{"0": {SmoothedLoad: 1}, "1": {SmoothedLoad: 3}})
+		assert.Equal(t, 1.0, shardLoad("0"))
+		assert.Equal(t, 3.0, shardLoad("1"))
+		assert.Equal(t, 2.0, shardLoad("2"))
+	})
+}
+
+func TestUpdateAssignmentsByLoad_PlacesShardsOnLeastLoadedExecutors(t *testing.T) {
+	processor, _ := newLoadBalancedProcessor(t, nil)
+
+	stats := map[string]store.ShardStatistics{
+		"0": {SmoothedLoad: 10},
+		"1": {SmoothedLoad: 6},
+		"2": {SmoothedLoad: 4},
+		"3": {SmoothedLoad: 1},
+	}
+	assignments := map[string][]string{"exec-1": {}, "exec-2": {}}
+
+	changed := processor.updateAssignmentsByLoad([]string{"3", "2", "1", "0"}, []string{"exec-1", "exec-2"}, assignments, stats)
+	require.True(t, changed)
+	assert.ElementsMatch(t, []string{"0", "3"}, assignments["exec-1"])
+	assert.ElementsMatch(t, []string{"1", "2"}, assignments["exec-2"])
+}
+
+func TestUpdateAssignmentsByLoad_NoChange(t *testing.T) {
+	processor, _ := newLoadBalancedProcessor(t, nil)
+
+	stats := map[string]store.ShardStatistics{"0": {SmoothedLoad: 5}, "1": {SmoothedLoad: 5}}
+	assignments := map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}}
+
+	assert.False(t, processor.updateAssignmentsByLoad(nil, []string{"exec-1", "exec-2"}, assignments, stats))
+	assert.Equal(t, map[string][]string{"exec-1": {"0"}, "exec-2": {"1"}}, assignments)
+}
+
+func TestUpdateAssignmentsByLoad_FillsEmptyExecutorWithoutLoad(t *testing.T) {
+	processor, _ := newLoadBalancedProcessor(t, nil)
+
+	assignments := map[string][]string{"exec-1": {"0", "1", "2", "3"}, "exec-2": {}}
+
+	require.True(t, processor.updateAssignmentsByLoad(nil, []string{"exec-1", "exec-2"}, assignments, nil))
+	assert.Len(t, assignments["exec-1"], 2)
+	assert.Len(t, assignments["exec-2"], 2)
+}
+
+func TestUpdateAssignmentsByLoad_RespectsMoveCooldown(t *testing.T) {
+	processor, mocks := newLoadBalancedProcessor(t, nil)
+	now := mocks.timeSource.Now()
+
+	stats := map[string]store.ShardStatistics{
+		"0": {SmoothedLoad: 5, LastMoveTime: now.Add(-time.Second)},
+		"1": {SmoothedLoad: 5, LastMoveTime: now.Add(-time.Second)},
+		"2": {SmoothedLoad: 1},
+	}
+	assignments := map[string][]string{"exec-1": {"0", "1"}, "exec-2": {"2"}}
+
+	assert.False(t, processor.updateAssignmentsByLoad(nil, []string{"exec-1", "exec-2"}, assignments, stats))
+
+	mocks.timeSource.Advance(_defaultMoveCooldown)
+	require.True(t, processor.updateAssignmentsByLoad(nil, []string{"exec-1", "exec-2"}, assignments, stats))
+	assert.Len(t, assignments["exec-1"], 1)
+	assert.Len(t, assignments["exec-2"], 2)
+}
+
+func TestUpdateAssignmentsByLoad_CapsMovesPerCycle(t *testing.T) {
+	processor, _ := newLoadBalancedProcessor(t, func(cfg *config.Namespace) {
+		cfg.MaxMovesPerCycle = 2
+	})
+
+	assignments := map[string][]string{"exec-1": makeShards(20), "exec-2": {}}
+
+	require.True(t, processor.updateAssignmentsByLoad(nil, []string{"exec-1", "exec-2"}, assignments, nil))
+	assert.Len(t, assignments["exec-1"], 18)
+	assert.Len(t, assignments["exec-2"], 2)
+}
+
+func TestUpdateAssignmentsByLoad_WithinImbalanceRatio(t *testing.T) {
+	processor, _ := newLoadBalancedProcessor(t, func(cfg *config.Namespace) {
+		cfg.LoadImbalanceRatio = 0.5
+	})
+
+	stats := map[string]store.ShardStatistics{"0": {SmoothedLoad: 6}, "1": {SmoothedLoad: 2}, "2": {SmoothedLoad: 2}}
+	assignments := map[string][]string{"exec-1": {"0"}, "exec-2": {"1", "2"}}
+
+	// the average load is 5, so 6 is within the ratio
+	assert.False(t, processor.updateAssignmentsByLoad(nil, []string{"exec-1", "exec-2"}, assignments, stats))
+}
+
+func TestRebalanceShards_LoadBalancedStrategy(t *testing.T) {
+	processor, mocks := newLoadBalancedProcessor(t, func(cfg *config.Namespace) {
+		cfg.ShardNum = 3
+	})
+	defer mocks.ctrl.Finish()
+
+	now := mocks.timeSource.Now()
+	heartbeats := map[string]store.HeartbeatState{
+		"exec-1": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+		"exec-2": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+	}
+	assignments := map[string]store.AssignedState{
+		"exec-1": {
+			AssignedShards: map[string]*types.ShardAssignment{
+				"0": {Status: types.AssignmentStatusREADY},
+				"1": {Status: types.AssignmentStatusREADY},
+				"2": {Status: types.AssignmentStatusREADY},
+			},
+		},
+	}
+	shardStats := map[string]store.ShardStatistics{
+		"0": {SmoothedLoad: 8},
+		"1": {SmoothedLoad: 1},
+		"2": {SmoothedLoad: 1},
+	}
+	mocks.store.EXPECT().GetState(gomock.Any(), mocks.cfg.Name).Return(&store.NamespaceState{
+		Executors:        heartbeats,
+		ShardAssignments: assignments,
+		ShardStats:       shardStats,
+		GlobalRevision:   1,
+	}, nil)
+	mocks.store.EXPECT().GetShardOwner(gomock.Any(), mocks.cfg.Name, gomock.Any()).Return(nil, nil).Times(3)
+	mocks.election.EXPECT().Guard().Return(store.NopGuard())
+	mocks.store.EXPECT().AssignShards(gomock.Any(), mocks.cfg.Name, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, request store.AssignShardsRequest, _ store.GuardFunc) error {
+			// moving the hot shard alone balances the load the best with a single move
+			assert.Len(t, request.NewState.ShardAssignments["exec-1"].AssignedShards, 2)
+			assert.Len(t, request.NewState.ShardAssignments["exec-2"].AssignedShards, 1)
+			assert.Contains(t, request.NewState.ShardAssignments["exec-2"].AssignedShards, "0")
+			return nil
+		},
+	)
+
+	err := processor.rebalanceShards(context.Background())
+	require.NoError(t, err)
+}
+
+// TestLoadBalancedStrategy_Simulation runs rebalancing cycles on skewed shard loads, and verifies
+// that the load variance across executors goes down, without moving shards which are cooling down.
+func TestLoadBalancedStrategy_Simulation(t *testing.T) {
+	const (
+		numShards    = 64
+		numExecutors = 4
+		maxMoves     = 3
+	)
+	processor, mocks := newLoadBalancedProcessor(t, func(cfg *config.Namespace) {
+		cfg.MaxMovesPerCycle = maxMoves
+	})
+
+	// a few hot shards and a long tail of cold ones
+	stats := make(map[string]store.ShardStatistics, numShards)
+	for i, shardID := range makeShards(numShards) {
+		stats[shardID] = store.ShardStatistics{SmoothedLoad: 100 / float64(i+1)}
+	}
+
+	// the round robin placement puts all the hot shards on the first executors
+	executors := make([]string, numExecutors)
+	assignments := make(map[string][]string, numExecutors)
+	for i := range executors {
+		executors[i] = fmt.Sprintf("exec-%d", i)
+	}
+	for i, shardID := range makeShards(numShards) {
+		executorID := executors[(i/numExecutors)%numExecutors]
+		assignments[executorID] = append(assignments[executorID], shardID)
+	}
+
+	initialVariance := loadVariance(executors, assignments, stats)
+	for cycle := 0; cycle < 20; cycle++ {
+		owners := shardOwners(assignments)
+		processor.updateAssignmentsByLoad(nil, executors, assignments, stats)
+
+		now := mocks.timeSource.Now()
+		moves := 0
+		for shardID, executorID := range shardOwners(assignments) {
+			if owners[shardID] == executorID {
+				continue
+			}
+			moves++
+			shardStats := stats[shardID]
+			require.True(t, shardStats.LastMoveTime.IsZero() || now.Sub(shardStats.LastMoveTime) >= processor.namespaceCfg.MoveCooldown,
+				"shard %v was moved again during its cooldown", shardID)
+			// the store records the move time on reassignment
+			shardStats.LastMoveTime = now
+			stats[shardID] = shardStats
+		}
+		require.LessOrEqual(t, moves, maxMoves)
+		assert.Len(t, shardOwners(assignments), numShards, "no shard may be lost or duplicated")
+
+		mocks.timeSource.Advance(processor.namespaceCfg.MoveCooldown / 4)
+	}
+
+	finalVariance := loadVariance(executors, assignments, stats)
+	t.Logf("load variance went from %.2f to %.2f", initialVariance, finalVariance)
+	assert.Less(t, finalVariance, initialVariance/10)
+}
+
+func shardOwners(assignments map[string][]string) map[string]string {
+	owners := make(map[string]string)
+	for executorID, shards := range assignments {
+		for _, shardID := range shards {
+			owners[shardID] = executorID
+		}
+	}
+	return owners
+}
+
+func loadVariance(executors []string, assignments map[string][]string, stats map[string]store.ShardStatistics) float64 {
+	loads := make([]float64, len(executors))
+	total := 0.0
+	for i, executorID := range executors {
+		for _, shardID := range assignments[executorID] {
+			loads[i] += stats[shardID].SmoothedLoad
+		}
+		total += loads[i]
+	}
+	mean := total / float64(len(executors))
+	variance := 0.0
+	for _, load := range loads {
+		variance += (load - mean) * (load - mean)
+	}
+	return variance / float64(len(executors))
+}
@@ -48,6 +48,10 @@ const (
 	_defaultPeriod       = time.Second
 	_defaultHeartbeatTTL = 10 * time.Second
 	_defaultTimeout      = 1 * time.Second
+
+	_defaultMaxMovesPerCycle   = 10
+	_defaultMoveCooldown       = time.Minute
+	_defaultLoadImbalanceRatio = 0.1
 )
 
 type processorFactory struct {
@@ -98,6 +102,16 @@ func NewProcessorFactory(
 
 // CreateProcessor creates a new processor for the given namespace
 func (f *processorFactory) CreateProcessor(cfg config.Namespace, shardStore store.Store, election store.Election) Processor {
+	if cfg.MaxMovesPerCycle == 0 {
+		cfg.MaxMovesPerCycle = _defaultMaxMovesPerCycle
+	}
+	if cfg.MoveCooldown == 0 {
+		cfg.MoveCooldown = _defaultMoveCooldown
+	}
+	if cfg.LoadImbalanceRatio == 0 {
+		cfg.LoadImbalanceRatio = _defaultLoadImbalanceRatio
+	}
+
 	return &namespaceProcessor{
 		namespaceCfg:  cfg,
 		logger:        f.logger.WithTags(tag.ComponentLeaderProcessor, tag.ShardNamespace(cfg.Name)),
@@ -380,10 +394,16 @@ func (p *namespaceProcessor) rebalanceShardsImpl(ctx context.Context, metricsLoo
 
 	metricsLoopScope.UpdateGauge(metrics.ShardDistributorAssignLoopNumRebalancedShards, float64(len(shardsToReassign)))
 
-	// If there are deleted shards or stale executors, the distribution has changed.
-	assignedToEmptyExecutors := assignShardsToEmptyExecutors(currentAssignments)
-	updatedAssignments := p.updateAssignments(shardsToReassign, activeExecutors, currentAssignments)
+	var assignedToEmptyExecutors, updatedAssignments bool
+	if p.namespaceCfg.AssignmentStrategy == config.AssignmentStrategyLoadBalanced {
+		// Empty executors have no load, so they are filled by the load balancing itself.
+		updatedAssignments = p.updateAssignmentsByLoad(shardsToReassign, activeExecutors, currentAssignments, namespaceState.ShardStats)
+	} else {
+		assignedToEmptyExecutors = assignShardsToEmptyExecutors(currentAssignments)
+		updatedAssignments = p.updateAssignments(shardsToReassign, activeExecutors, currentAssignments)
+	}
 
+	// If there are deleted shards or stale executors, the distribution has changed.
 	distributionChanged := len(deletedShards) > 0 || len(staleExecutors) > 0 || assignedToEmptyExecutors || updatedAssignments
 	if !distributionChanged {
 		p.logger.Info("No changes to distribution detected. Skipping rebalance.")
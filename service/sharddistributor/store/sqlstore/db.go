@@ -0,0 +1,248 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/uber/cadence/common/config"
+
+	// import mysql driver
+	_ "github.com/go-sql-driver/mysql"
+	// import postgres driver
+	_ "github.com/lib/pq"
+	// import sqlite driver
+	_ "github.com/ncruces/go-sqlite3/driver"
+	// import embed sqlite db
+	_ "github.com/ncruces/go-sqlite3/embed"
+)
+
+const (
+	_driverMySQL    = "mysql"
+	_driverPostgres = "postgres"
+	_driverSQLite   = "sqlite3"
+
+	_defaultPollInterval = time.Second
+	_defaultElectionTTL  = 10 * time.Second
+
+	// _maxRowsPerStatement bounds the number of rows written by a single multi-row statement.
+	_maxRowsPerStatement = 500
+)
+
+// sqlCfg is the configuration of the SQL store and of the SQL leader store, decoded from the storage params.
+type sqlCfg struct {
+	// DriverName is one of mysql, postgres or sqlite3.
+	DriverName string `yaml:"driverName"`
+	// DataSourceName is passed as is to the driver.
+	// The store and the leader store must point to the same database, since the leader guard is checked in the store transactions.
+	DataSourceName string `yaml:"dataSourceName"`
+	MaxConns       int    `yaml:"maxConns"`
+	// PollInterval is how often subscriptions check the namespace revisions. Default: 1s
+	PollInterval time.Duration `yaml:"pollInterval"`
+	// ElectionTTL is the duration of the leader lease, which is renewed every third of it. Default: 10s
+	ElectionTTL time.Duration `yaml:"electionTTL"`
+}
+
+func decodeConfig(params *config.YamlNode) (sqlCfg, error) {
+	var cfg sqlCfg
+	if err := params.Decode(&cfg); err != nil {
+		return cfg, err
+	}
+	switch cfg.DriverName {
+	case _driverMySQL, _driverPostgres, _driverSQLite:
+	default:
+		return cfg, fmt.Errorf("unsupported driver %q, supported drivers are %s, %s and %s", cfg.DriverName, _driverMySQL, _driverPostgres, _driverSQLite)
+	}
+	if cfg.DataSourceName == "" {
+		return cfg, fmt.Errorf("dataSourceName is required")
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = _defaultPollInterval
+	}
+	if cfg.ElectionTTL <= 0 {
+		cfg.ElectionTTL = _defaultElectionTTL
+	}
+	return cfg, nil
+}
+
+// dialect holds the statements which differ between the supported databases.
+type dialect struct {
+	// forUpdate locks the selected rows until the end of the transaction.
+	// SQLite locks the whole database for writing transactions, so it does not need it.
+	forUpdate string
+	// insertIgnore inserts a row unless a row with the same primary key exists.
+	insertIgnore func(table, columns, values string) string
+}
+
+func newDialect(driverName string) dialect {
+	switch driverName {
+	case _driverMySQL:
+		return dialect{
+			forUpdate: " FOR UPDATE",
+			insertIgnore: func(table, columns, values string) string {
+				return fmt.Sprintf("INSERT IGNORE INTO %s (%s) VALUES (%s)", table, columns, values)
+			},
+		}
+	case _driverPostgres:
+		return dialect{
+			forUpdate: " FOR UPDATE",
+			insertIgnore: func(table, columns, values string) string {
+				return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT DO NOTHING", table, columns, values)
+			},
+		}
+	default:
+		return dialect{
+			insertIgnore: func(table, columns, values string) string {
+				return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT DO NOTHING", table, columns, values)
+			},
+		}
+	}
+}
+
+// database wraps the connection with the dialect of the driver.
+type database struct {
+	db *sqlx.DB
+	dialect
+}
+
+func newDatabase(cfg sqlCfg) (*database, error) {
+	db, err := sqlx.Connect(cfg.DriverName, cfg.DataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", cfg.DriverName, err)
+	}
+	if cfg.MaxConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxConns)
+	}
+	return &database{db: db, dialect: newDialect(cfg.DriverName)}, nil
+}
+
+func (d *database) Close() error {
+	return d.db.Close()
+}
+
+// inTx runs fn in a transaction, which is committed if fn succeeds and rolled back otherwise.
+func (d *database) inTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := d.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			return fmt.Errorf("%w, rollback: %v", err, rollbackErr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// namespaceRevisions are the revision counters of a namespace.
+type namespaceRevisions struct {
+	Revision            int64 `db:"revision"`
+	ExecutorsRevision   int64 `db:"executors_revision"`
+	AssignmentsRevision int64 `db:"assignments_revision"`
+}
+
+// getRevisions returns the revisions of the namespace, which are all zero if nothing was written to it yet.
+func (d *database) getRevisions(ctx context.Context, namespace string) (namespaceRevisions, error) {
+	var revisions namespaceRevisions
+	err := d.db.GetContext(ctx, &revisions, d.db.Rebind(
+		`SELECT revision, executors_revision, assignments_revision FROM shard_distributor_namespaces WHERE namespace = ?`,
+	), namespace)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return revisions, fmt.Errorf("get namespace revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// bumpRevision increments the revision of the namespace and returns the new one. Since it updates the namespace row,
+// it must be the first statement of every writing transaction: the row lock serializes the writes to the namespace,
+// so the checks done later in the transaction cannot be invalidated by a concurrent write.
+func (d *database) bumpRevision(ctx context.Context, tx *sqlx.Tx, namespace string) (int64, error) {
+	bump := d.db.Rebind(`UPDATE shard_distributor_namespaces SET revision = revision + 1 WHERE namespace = ?`)
+	res, err := tx.ExecContext(ctx, bump, namespace)
+	if err != nil {
+		return 0, fmt.Errorf("bump namespace revision: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return 0, fmt.Errorf("bump namespace revision: %w", err)
+	} else if rows == 0 {
+		insert := d.db.Rebind(d.insertIgnore("shard_distributor_namespaces", "namespace, revision, executors_revision, assignments_revision", "?, 0, 0, 0"))
+		if _, err := tx.ExecContext(ctx, insert, namespace); err != nil {
+			return 0, fmt.Errorf("create namespace: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, bump, namespace); err != nil {
+			return 0, fmt.Errorf("bump namespace revision: %w", err)
+		}
+	}
+
+	var revision int64
+	if err := tx.GetContext(ctx, &revision, d.db.Rebind(`SELECT revision FROM shard_distributor_namespaces WHERE namespace = ?`), namespace); err != nil {
+		return 0, fmt.Errorf("get namespace revision: %w", err)
+	}
+	return revision, nil
+}
+
+// markChanged records that the transaction at the given revision changed the executors or the assignments of the namespace.
+func (d *database) markChanged(ctx context.Context, tx *sqlx.Tx, namespace string, revision int64, executors, assignments bool) error {
+	var columns []string
+	var args []interface{}
+	if executors {
+		columns = append(columns, "executors_revision = ?")
+		args = append(args, revision)
+	}
+	if assignments {
+		columns = append(columns, "assignments_revision = ?")
+		args = append(args, revision)
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf(`UPDATE shard_distributor_namespaces SET %s WHERE namespace = ?`, strings.Join(columns, ", "))
+	if _, err := tx.ExecContext(ctx, d.db.Rebind(query), append(args, namespace)...); err != nil {
+		return fmt.Errorf("update namespace revisions: %w", err)
+	}
+	return nil
+}
+
+// inClause returns the placeholders of an IN clause with n values.
+func inClause(n int) string {
+	return "(" + strings.TrimSuffix(strings.Repeat("?, ", n), ", ") + ")"
+}
+
+// chunks splits values into slices of at most _maxRowsPerStatement values.
+func chunks(values []string) [][]string {
+	var result [][]string
+	for len(values) > _maxRowsPerStatement {
+		result = append(result, values[:_maxRowsPerStatement])
+		values = values[_maxRowsPerStatement:]
+	}
+	if len(values) > 0 {
+		result = append(result, values)
+	}
+	return result
+}
+
+// toNanos converts a time to the unix nanoseconds stored in the database, the zero time is stored as 0.
+func toNanos(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// fromNanos converts the unix nanoseconds stored in the database back to a time.
+func fromNanos(nanos int64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos).UTC()
+}
@@ -0,0 +1,242 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+type LeaderStore struct {
+	db     *database
+	cfg    sqlCfg
+	logger log.Logger
+}
+
+// StoreParams defines the dependencies for the SQL leader store, for use with fx.
+type StoreParams struct {
+	fx.In
+
+	Cfg       config.ShardDistribution
+	Lifecycle fx.Lifecycle
+	Logger    log.Logger
+}
+
+// NewLeaderStore creates a new leaderstore backed by a SQL database.
+// The leader of a namespace holds a lease row, which it renews until it resigns or fails to renew it before it expires.
+func NewLeaderStore(p StoreParams) (store.Elector, error) {
+	cfg, err := decodeConfig(p.Cfg.LeaderStore.StorageParams)
+	if err != nil {
+		return nil, fmt.Errorf("bad config: %w", err)
+	}
+
+	db, err := newDatabase(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Lifecycle.Append(fx.StopHook(db.Close))
+
+	return &LeaderStore{
+		db:     db,
+		cfg:    cfg,
+		logger: p.Logger,
+	}, nil
+}
+
+func (ls *LeaderStore) CreateElection(ctx context.Context, namespace string) (store.Election, error) {
+	return &election{
+		db:           ls.db,
+		namespace:    namespace,
+		ttl:          ls.cfg.ElectionTTL,
+		pollInterval: ls.cfg.PollInterval,
+		logger:       ls.logger,
+		done:         make(chan struct{}),
+	}, nil
+}
+
+// election campaigns for the lease row of a namespace. Each acquisition of the lease increments its term,
+// which is what the guard compares to detect that the leadership changed.
+type election struct {
+	db           *database
+	namespace    string
+	ttl          time.Duration
+	pollInterval time.Duration
+	logger       log.Logger
+
+	mu sync.Mutex
+	// term is the term of the lease held by this election, or 0 if it is not the leader.
+	term          int64
+	stopKeepAlive chan struct{}
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (e *election) Campaign(ctx context.Context, host string) error {
+	for {
+		term, err := e.tryAcquire(ctx, host)
+		if err != nil {
+			// The drivers report their own error when the context ends during a query
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("acquire leader lease: %w", err)
+		}
+		if term > 0 {
+			e.mu.Lock()
+			e.term = term
+			e.stopKeepAlive = make(chan struct{})
+			go e.keepAlive(term, e.stopKeepAlive)
+			e.mu.Unlock()
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-e.done:
+			return fmt.Errorf("election is closed")
+		case <-time.After(e.pollInterval):
+		}
+	}
+}
+
+// tryAcquire takes the lease if it has expired, and returns the new term, or 0 if the lease is held by another host.
+func (e *election) tryAcquire(ctx context.Context, host string) (int64, error) {
+	var term int64
+	err := e.db.inTx(ctx, func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, e.db.db.Rebind(
+			e.db.insertIgnore("shard_distributor_leaders", "namespace, leader, term, lease_expiry", "?, '', 0, 0"),
+		), e.namespace); err != nil {
+			return fmt.Errorf("create lease: %w", err)
+		}
+
+		now := time.Now()
+		res, err := tx.ExecContext(ctx, e.db.db.Rebind(
+			`UPDATE shard_distributor_leaders SET leader = ?, term = term + 1, lease_expiry = ? WHERE namespace = ? AND lease_expiry <= ?`,
+		), host, now.Add(e.ttl).UnixNano(), e.namespace, now.UnixNano())
+		if err != nil {
+			return fmt.Errorf("take lease: %w", err)
+		}
+		if rows, err := res.RowsAffected(); err != nil || rows == 0 {
+			return err
+		}
+
+		return tx.GetContext(ctx, &term, e.db.db.Rebind(`SELECT term FROM shard_distributor_leaders WHERE namespace = ?`), e.namespace)
+	})
+	return term, err
+}
+
+// keepAlive renews the lease every third of its duration, and closes the election if the lease is lost.
+func (e *election) keepAlive(term int64, stop <-chan struct{}) {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+	expiry := time.Now().Add(e.ttl)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-e.done:
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		res, err := e.db.db.Exec(e.db.db.Rebind(
+			`UPDATE shard_distributor_leaders SET lease_expiry = ? WHERE namespace = ? AND term = ? AND lease_expiry > ?`,
+		), now.Add(e.ttl).UnixNano(), e.namespace, term, now.UnixNano())
+		if err != nil {
+			if time.Now().Before(expiry) {
+				e.logger.Warn("failed to renew leader lease, retrying", tag.ShardNamespace(e.namespace), tag.Error(err))
+				continue
+			}
+			e.logger.Error("failed to renew leader lease before it expired", tag.ShardNamespace(e.namespace), tag.Error(err))
+			e.close()
+			return
+		}
+		if rows, err := res.RowsAffected(); err != nil || rows == 0 {
+			e.logger.Warn("leader lease lost", tag.ShardNamespace(e.namespace))
+			e.close()
+			return
+		}
+		expiry = now.Add(e.ttl)
+	}
+}
+
+func (e *election) Resign(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.term == 0 {
+		return nil
+	}
+	close(e.stopKeepAlive)
+
+	_, err := e.db.db.ExecContext(ctx, e.db.db.Rebind(
+		`UPDATE shard_distributor_leaders SET leader = '', lease_expiry = 0 WHERE namespace = ? AND term = ?`,
+	), e.namespace, e.term)
+	e.term = 0
+	if err != nil {
+		return fmt.Errorf("release leader lease: %w", err)
+	}
+	return nil
+}
+
+func (e *election) Cleanup(ctx context.Context) error {
+	defer e.close()
+	if err := e.Resign(ctx); err != nil {
+		return fmt.Errorf("resign: %w", err)
+	}
+	return nil
+}
+
+func (e *election) Done() <-chan struct{} {
+	return e.done
+}
+
+func (e *election) Guard() store.GuardFunc {
+	e.mu.Lock()
+	term := e.term
+	e.mu.Unlock()
+
+	return func(txn store.Txn) (store.Txn, error) {
+		// The guard receives the generic Txn and asserts it to the concrete type it expects.
+		tx, ok := txn.(*sqlx.Tx)
+		if !ok {
+			return nil, fmt.Errorf("invalid transaction type for sql guard: expected *sqlx.Tx, got %T", txn)
+		}
+		if term == 0 {
+			return nil, fmt.Errorf("%w: not the leader", store.ErrVersionConflict)
+		}
+
+		// The lease row is locked until the end of the transaction, so the leadership cannot change before the commit.
+		var lease struct {
+			Term        int64 `db:"term"`
+			LeaseExpiry int64 `db:"lease_expiry"`
+		}
+		err := tx.Get(&lease, e.db.db.Rebind(
+			`SELECT term, lease_expiry FROM shard_distributor_leaders WHERE namespace = ?`+e.db.forUpdate,
+		), e.namespace)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("get leader lease: %w", err)
+		}
+		if lease.Term != term || lease.LeaseExpiry <= time.Now().UnixNano() {
+			return nil, fmt.Errorf("%w: transaction failed, leadership may have changed", store.ErrVersionConflict)
+		}
+		return tx, nil
+	}
+}
+
+func (e *election) close() {
+	e.closeOnce.Do(func() { close(e.done) })
+}
@@ -0,0 +1,12 @@
+package sqlstore
+
+import (
+	"go.uber.org/fx"
+)
+
+// Module provides the SQL store and leader store, an alternative to the etcd module for deployments which
+// already run MySQL, Postgres or SQLite. The schema is in schema/<database>/sharddistributor.
+var Module = fx.Module("sqlstore",
+	fx.Provide(NewStore),
+	fx.Provide(NewLeaderStore),
+)
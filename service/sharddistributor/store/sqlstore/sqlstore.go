@@ -0,0 +1,874 @@
+package sqlstore
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+type executorStoreImpl struct {
+	db           *database
+	logger       log.Logger
+	timeSource   clock.TimeSource
+	pollInterval time.Duration
+	stopCh       chan struct{}
+}
+
+// executorRow is a row of the shard_distributor_executors table.
+type executorRow struct {
+	ExecutorID            string `db:"executor_id"`
+	LastHeartbeat         int64  `db:"last_heartbeat"`
+	Status                int32  `db:"status"`
+	ReportedShards        []byte `db:"reported_shards"`
+	Metadata              []byte `db:"metadata"`
+	AssignedState         []byte `db:"assigned_state"`
+	AssignedStateRevision int64  `db:"assigned_state_revision"`
+}
+
+// shardStatsRow is a row of the shard_distributor_shard_stats table.
+type shardStatsRow struct {
+	ShardID        string  `db:"shard_id"`
+	SmoothedLoad   float64 `db:"smoothed_load"`
+	LastUpdateTime int64   `db:"last_update_time"`
+	LastMoveTime   int64   `db:"last_move_time"`
+}
+
+// shardRow is a row of the shard_distributor_shards table.
+type shardRow struct {
+	ShardID    string `db:"shard_id"`
+	ExecutorID string `db:"executor_id"`
+}
+
+// assignedStateRecord is the serialized form of store.AssignedState, the ModRevision is stored in its own column.
+type assignedStateRecord struct {
+	AssignedShards     map[string]*types.ShardAssignment   `json:"assigned_shards"`
+	ShardHandoverStats map[string]shardHandoverStatsRecord `json:"shard_handover_stats,omitempty"`
+	LastUpdated        int64                               `json:"last_updated"`
+}
+
+type shardHandoverStatsRecord struct {
+	PreviousExecutorLastHeartbeatTime int64              `json:"previous_executor_last_heartbeat_time"`
+	HandoverType                      types.HandoverType `json:"handover_type"`
+}
+
+const _executorColumns = `executor_id, last_heartbeat, status, reported_shards, metadata, assigned_state, assigned_state_revision`
+
+// ExecutorStoreParams defines the dependencies for the SQL store, for use with fx.
+type ExecutorStoreParams struct {
+	fx.In
+
+	Cfg        config.ShardDistribution
+	Lifecycle  fx.Lifecycle
+	Logger     log.Logger
+	TimeSource clock.TimeSource
+}
+
+// NewStore creates a new SQL-backed store and provides it to the fx application.
+func NewStore(p ExecutorStoreParams) (store.Store, error) {
+	cfg, err := decodeConfig(p.Cfg.Store.StorageParams)
+	if err != nil {
+		return nil, fmt.Errorf("bad config for sql store: %w", err)
+	}
+
+	db, err := newDatabase(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	timeSource := p.TimeSource
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
+
+	store := &executorStoreImpl{
+		db:           db,
+		logger:       p.Logger,
+		timeSource:   timeSource,
+		pollInterval: cfg.PollInterval,
+		stopCh:       make(chan struct{}),
+	}
+
+	p.Lifecycle.Append(fx.StopHook(store.Stop))
+
+	return store, nil
+}
+
+func (s *executorStoreImpl) Stop() error {
+	close(s.stopCh)
+	return s.db.Close()
+}
+
+// --- HeartbeatStore Implementation ---
+
+func (s *executorStoreImpl) RecordHeartbeat(ctx context.Context, namespace, executorID string, request store.HeartbeatState) error {
+	reportedShards, err := json.Marshal(request.ReportedShards)
+	if err != nil {
+		return fmt.Errorf("marshal reported shards: %w", err)
+	}
+
+	err = s.db.inTx(ctx, func(tx *sqlx.Tx) error {
+		revision, err := s.db.bumpRevision(ctx, tx, namespace)
+		if err != nil {
+			return err
+		}
+
+		row, found, err := s.getExecutorRow(ctx, tx, namespace, executorID)
+		if err != nil {
+			return err
+		}
+
+		// Metadata keys are only ever added or overwritten by heartbeats.
+		metadata := make(map[string]string)
+		if err := unmarshalIfPresent(row.Metadata, &metadata); err != nil {
+			return fmt.Errorf("parse metadata: %w", err)
+		}
+		for key, value := range request.Metadata {
+			metadata[key] = value
+		}
+		metadataData, err := json.Marshal(metadata)
+		if err != nil {
+			return fmt.Errorf("marshal metadata: %w", err)
+		}
+
+		metadataChanged := !bytes.Equal(metadataData, row.Metadata)
+		// Heartbeat timestamps alone are not significant for rebalancing.
+		isSignificantChange := !found ||
+			row.Status != int32(request.Status) ||
+			!bytes.Equal(reportedShards, row.ReportedShards) ||
+			metadataChanged
+
+		if found {
+			_, err = tx.ExecContext(ctx, s.db.db.Rebind(
+				`UPDATE shard_distributor_executors SET last_heartbeat = ?, status = ?, reported_shards = ?, metadata = ? WHERE namespace = ? AND executor_id = ?`,
+			), toNanos(request.LastHeartbeat), int32(request.Status), reportedShards, metadataData, namespace, executorID)
+		} else {
+			_, err = tx.ExecContext(ctx, s.db.db.Rebind(
+				`INSERT INTO shard_distributor_executors (namespace, `+_executorColumns+`) VALUES (?, ?, ?, ?, ?, ?, NULL, 0)`,
+			), namespace, executorID, toNanos(request.LastHeartbeat), int32(request.Status), reportedShards, metadataData)
+		}
+		if err != nil {
+			return fmt.Errorf("write heartbeat: %w", err)
+		}
+
+		return s.db.markChanged(ctx, tx, namespace, revision, isSignificantChange, metadataChanged)
+	})
+	if err != nil {
+		return fmt.Errorf("record heartbeat: %w", err)
+	}
+	return nil
+}
+
+// GetHeartbeat retrieves the last known heartbeat state for a single executor.
+func (s *executorStoreImpl) GetHeartbeat(ctx context.Context, namespace string, executorID string) (*store.HeartbeatState, *store.AssignedState, error) {
+	row, found, err := s.getExecutorRow(ctx, s.db.db, namespace, executorID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !found {
+		return nil, nil, store.ErrExecutorNotFound
+	}
+
+	heartbeatState, err := row.toHeartbeatState()
+	if err != nil {
+		return nil, nil, err
+	}
+	assignedState, err := row.toAssignedState()
+	if err != nil {
+		return nil, nil, err
+	}
+	return heartbeatState, assignedState, nil
+}
+
+// --- ShardStore Implementation ---
+
+func (s *executorStoreImpl) GetState(ctx context.Context, namespace string) (*store.NamespaceState, error) {
+	// The revision is read first, so that the state is at least as recent as the revision.
+	revisions, err := s.db.getRevisions(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []executorRow
+	if err := s.db.db.SelectContext(ctx, &rows, s.db.db.Rebind(
+		`SELECT `+_executorColumns+` FROM shard_distributor_executors WHERE namespace = ?`,
+	), namespace); err != nil {
+		return nil, fmt.Errorf("get executor data: %w", err)
+	}
+
+	heartbeatStates := make(map[string]store.HeartbeatState, len(rows))
+	assignedStates := make(map[string]store.AssignedState, len(rows))
+	for _, row := range rows {
+		heartbeat, err := row.toHeartbeatState()
+		if err != nil {
+			return nil, err
+		}
+		assigned, err := row.toAssignedState()
+		if err != nil {
+			return nil, err
+		}
+		heartbeatStates[row.ExecutorID] = *heartbeat
+		assignedStates[row.ExecutorID] = *assigned
+	}
+
+	var statsRows []shardStatsRow
+	if err := s.db.db.SelectContext(ctx, &statsRows, s.db.db.Rebind(
+		`SELECT shard_id, smoothed_load, last_update_time, last_move_time FROM shard_distributor_shard_stats WHERE namespace = ?`,
+	), namespace); err != nil {
+		return nil, fmt.Errorf("get shard statistics: %w", err)
+	}
+
+	shardStats := make(map[string]store.ShardStatistics, len(statsRows))
+	for _, row := range statsRows {
+		shardStats[row.ShardID] = store.ShardStatistics{
+			SmoothedLoad:   row.SmoothedLoad,
+			LastUpdateTime: fromNanos(row.LastUpdateTime),
+			LastMoveTime:   fromNanos(row.LastMoveTime),
+		}
+	}
+
+	return &store.NamespaceState{
+		Executors:        heartbeatStates,
+		ShardStats:       shardStats,
+		ShardAssignments: assignedStates,
+		GlobalRevision:   revisions.Revision,
+	}, nil
+}
+
+// SubscribeToAssignmentChanges sends the current owners of the shards, and then the new owners every time they change.
+func (s *executorStoreImpl) SubscribeToAssignmentChanges(ctx context.Context, namespace string) (<-chan map[*store.ShardOwner][]string, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	revisions, err := s.db.getRevisions(ctx, namespace)
+	if err != nil {
+		return nil, cancel, err
+	}
+	initialState, err := s.getExecutorState(ctx, namespace)
+	if err != nil {
+		return nil, cancel, err
+	}
+
+	subCh := make(chan map[*store.ShardOwner][]string)
+	go func() {
+		lastRevision := revisions.AssignmentsRevision
+		state := initialState
+		ticker := time.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+		for {
+			if state != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case <-s.stopCh:
+					return
+				case subCh <- state:
+				}
+				state = nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+			}
+
+			revisions, err := s.db.getRevisions(ctx, namespace)
+			if err != nil {
+				s.logger.Warn("failed to poll namespace revisions", tag.ShardNamespace(namespace), tag.Error(err))
+				continue
+			}
+			if revisions.AssignmentsRevision == lastRevision {
+				continue
+			}
+			if state, err = s.getExecutorState(ctx, namespace); err != nil {
+				s.logger.Warn("failed to refresh shard owners", tag.ShardNamespace(namespace), tag.Error(err))
+				continue
+			}
+			lastRevision = revisions.AssignmentsRevision
+		}
+	}()
+
+	return subCh, cancel, nil
+}
+
+// Subscribe sends the revision of the namespace every time a change significant for rebalancing is written.
+// Only the latest revision is kept if the subscriber does not keep up.
+func (s *executorStoreImpl) Subscribe(ctx context.Context, namespace string) (<-chan int64, error) {
+	revisions, err := s.db.getRevisions(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionChan := make(chan int64, 1)
+	go func() {
+		defer close(revisionChan)
+		lastRevision := revisions.ExecutorsRevision
+		ticker := time.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+			}
+
+			revisions, err := s.db.getRevisions(ctx, namespace)
+			if err != nil {
+				s.logger.Warn("failed to poll namespace revisions", tag.ShardNamespace(namespace), tag.Error(err))
+				continue
+			}
+			if revisions.ExecutorsRevision == lastRevision {
+				continue
+			}
+			lastRevision = revisions.ExecutorsRevision
+
+			select {
+			case <-revisionChan:
+			default:
+			}
+			revisionChan <- revisions.Revision
+		}
+	}()
+	return revisionChan, nil
+}
+
+func (s *executorStoreImpl) AssignShards(ctx context.Context, namespace string, request store.AssignShardsRequest, guard store.GuardFunc) error {
+	if len(request.ExecutorsToDelete) == 0 && len(request.NewState.ShardAssignments) == 0 {
+		return nil
+	}
+
+	return s.db.inTx(ctx, func(tx *sqlx.Tx) error {
+		revision, err := s.db.bumpRevision(ctx, tx, namespace)
+		if err != nil {
+			return err
+		}
+		if err := applyGuard(tx, guard); err != nil {
+			return err
+		}
+
+		// 1. Check that the assigned states have not been modified since the new state was computed.
+		var currentRevisions []struct {
+			ExecutorID            string `db:"executor_id"`
+			AssignedStateRevision int64  `db:"assigned_state_revision"`
+		}
+		if err := tx.SelectContext(ctx, &currentRevisions, s.db.db.Rebind(
+			`SELECT executor_id, assigned_state_revision FROM shard_distributor_executors WHERE namespace = ?`,
+		), namespace); err != nil {
+			return fmt.Errorf("get assigned state revisions: %w", err)
+		}
+		actualRevisions := make(map[string]int64, len(currentRevisions))
+		for _, row := range currentRevisions {
+			actualRevisions[row.ExecutorID] = row.AssignedStateRevision
+		}
+
+		failingRevisionString := ""
+		checkRevision := func(executorID string, expected int64) {
+			if actual := actualRevisions[executorID]; actual != expected {
+				failingRevisionString += fmt.Sprintf("{ executor: %s, expected:%v, actual: %v }", executorID, expected, actual)
+			}
+		}
+		for _, executorID := range sortedKeys(request.ExecutorsToDelete) {
+			checkRevision(executorID, request.ExecutorsToDelete[executorID])
+		}
+		for _, executorID := range sortedKeys(request.NewState.ShardAssignments) {
+			checkRevision(executorID, request.NewState.ShardAssignments[executorID].ModRevision)
+		}
+		if failingRevisionString != "" {
+			return fmt.Errorf("%w: transaction failed, a shard may have been concurrently assigned, %v", store.ErrVersionConflict, failingRevisionString)
+		}
+
+		currentOwners, err := s.getShardOwners(ctx, tx, namespace)
+		if err != nil {
+			return err
+		}
+
+		// 2. Delete the stale executors and their shards.
+		if err := s.deleteExecutors(ctx, tx, namespace, sortedKeys(request.ExecutorsToDelete)); err != nil {
+			return err
+		}
+
+		// 3. Write the assigned states and the shard owners.
+		var movedShards []string
+		newOwners := make(map[string]string)
+		for _, executorID := range sortedKeys(request.NewState.ShardAssignments) {
+			state := request.NewState.ShardAssignments[executorID]
+			if err := s.writeAssignedState(ctx, tx, namespace, executorID, &state, revision); err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, s.db.db.Rebind(
+				`DELETE FROM shard_distributor_shards WHERE namespace = ? AND executor_id = ?`,
+			), namespace, executorID); err != nil {
+				return fmt.Errorf("delete shards of executor %s: %w", executorID, err)
+			}
+			for shardID := range state.AssignedShards {
+				newOwners[shardID] = executorID
+				if currentOwners[shardID] != executorID {
+					movedShards = append(movedShards, shardID)
+				}
+			}
+		}
+
+		// A shard may still be owned by an executor which is neither deleted nor part of the new state.
+		var shardsOfOtherExecutors []string
+		for _, shardID := range movedShards {
+			owner, ok := currentOwners[shardID]
+			if !ok {
+				continue
+			}
+			if _, rewritten := request.NewState.ShardAssignments[owner]; rewritten {
+				continue
+			}
+			if _, deleted := request.ExecutorsToDelete[owner]; deleted {
+				continue
+			}
+			shardsOfOtherExecutors = append(shardsOfOtherExecutors, shardID)
+		}
+		if err := s.deleteShards(ctx, tx, namespace, shardsOfOtherExecutors); err != nil {
+			return err
+		}
+		if err := s.insertShards(ctx, tx, namespace, newOwners); err != nil {
+			return err
+		}
+
+		// 4. Record the move of the reassigned shards.
+		if err := s.recordShardMoves(ctx, tx, namespace, movedShards); err != nil {
+			return err
+		}
+
+		return s.db.markChanged(ctx, tx, namespace, revision, len(request.ExecutorsToDelete) > 0, true)
+	})
+}
+
+func (s *executorStoreImpl) AssignShard(ctx context.Context, namespace, shardID, executorID string) error {
+	return s.db.inTx(ctx, func(tx *sqlx.Tx) error {
+		revision, err := s.db.bumpRevision(ctx, tx, namespace)
+		if err != nil {
+			return err
+		}
+
+		// 1. Check that the executor is ACTIVE.
+		row, found, err := s.getExecutorRow(ctx, tx, namespace, executorID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return store.ErrExecutorNotFound
+		}
+		if status := types.ExecutorStatus(row.Status); status != types.ExecutorStatusACTIVE {
+			return fmt.Errorf("%w: executor status is %s", store.ErrVersionConflict, status)
+		}
+
+		// 2. Check that the shard is not assigned yet.
+		var owner string
+		err = tx.GetContext(ctx, &owner, s.db.db.Rebind(
+			`SELECT executor_id FROM shard_distributor_shards WHERE namespace = ? AND shard_id = ?`,
+		), namespace, shardID)
+		if err == nil {
+			return &store.ErrShardAlreadyAssigned{ShardID: shardID, AssignedTo: owner}
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("checking shard owner: %w", err)
+		}
+
+		// 3. Add the shard to the assigned state of the executor.
+		state, err := row.toAssignedState()
+		if err != nil {
+			return err
+		}
+		if state.AssignedShards == nil {
+			state.AssignedShards = make(map[string]*types.ShardAssignment)
+		}
+		state.AssignedShards[shardID] = &types.ShardAssignment{Status: types.AssignmentStatusREADY}
+		if err := s.writeAssignedState(ctx, tx, namespace, executorID, state, revision); err != nil {
+			return err
+		}
+		if err := s.insertShards(ctx, tx, namespace, map[string]string{shardID: executorID}); err != nil {
+			return err
+		}
+		if err := s.recordShardMoves(ctx, tx, namespace, []string{shardID}); err != nil {
+			return err
+		}
+
+		return s.db.markChanged(ctx, tx, namespace, revision, false, true)
+	})
+}
+
+// DeleteExecutors deletes the given executors from the store. It does not delete the shards owned by the executors, this
+// should be handled by the namespace processor loop as we want to reassign, not delete the shards.
+func (s *executorStoreImpl) DeleteExecutors(ctx context.Context, namespace string, executorIDs []string, guard store.GuardFunc) error {
+	if len(executorIDs) == 0 {
+		return nil
+	}
+
+	err := s.db.inTx(ctx, func(tx *sqlx.Tx) error {
+		revision, err := s.db.bumpRevision(ctx, tx, namespace)
+		if err != nil {
+			return err
+		}
+		if err := applyGuard(tx, guard); err != nil {
+			return err
+		}
+		if err := s.deleteExecutors(ctx, tx, namespace, executorIDs); err != nil {
+			return err
+		}
+		return s.db.markChanged(ctx, tx, namespace, revision, true, true)
+	})
+	if err != nil {
+		return fmt.Errorf("commit executor deletion: %w", err)
+	}
+	return nil
+}
+
+// DeleteShardStats deletes shard statistics for the given shard IDs.
+func (s *executorStoreImpl) DeleteShardStats(ctx context.Context, namespace string, shardIDs []string, guard store.GuardFunc) error {
+	if len(shardIDs) == 0 {
+		return nil
+	}
+
+	err := s.db.inTx(ctx, func(tx *sqlx.Tx) error {
+		if _, err := s.db.bumpRevision(ctx, tx, namespace); err != nil {
+			return err
+		}
+		if err := applyGuard(tx, guard); err != nil {
+			return err
+		}
+		for _, chunk := range chunks(shardIDs) {
+			if _, err := tx.ExecContext(ctx, s.db.db.Rebind(
+				`DELETE FROM shard_distributor_shard_stats WHERE namespace = ? AND shard_id IN `+inClause(len(chunk)),
+			), append([]interface{}{namespace}, toArgs(chunk)...)...); err != nil {
+				return fmt.Errorf("delete shard statistics: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("commit shard statistics deletion: %w", err)
+	}
+	return nil
+}
+
+func (s *executorStoreImpl) GetShardOwner(ctx context.Context, namespace, shardID string) (*store.ShardOwner, error) {
+	var row struct {
+		ExecutorID string `db:"executor_id"`
+		Metadata   []byte `db:"metadata"`
+	}
+	err := s.db.db.GetContext(ctx, &row, s.db.db.Rebind(
+		`SELECT s.executor_id, e.metadata FROM shard_distributor_shards s
+		LEFT JOIN shard_distributor_executors e ON e.namespace = s.namespace AND e.executor_id = s.executor_id
+		WHERE s.namespace = ? AND s.shard_id = ?`,
+	), namespace, shardID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, store.ErrShardNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get shard owner: %w", err)
+	}
+	return newShardOwner(row.ExecutorID, row.Metadata)
+}
+
+func (s *executorStoreImpl) GetExecutor(ctx context.Context, namespace string, executorID string) (*store.ShardOwner, error) {
+	row, found, err := s.getExecutorRow(ctx, s.db.db, namespace, executorID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, store.ErrExecutorNotFound
+	}
+	return newShardOwner(executorID, row.Metadata)
+}
+
+func (s *executorStoreImpl) getExecutorRow(ctx context.Context, q sqlx.QueryerContext, namespace, executorID string) (executorRow, bool, error) {
+	var row executorRow
+	err := sqlx.GetContext(ctx, q, &row, s.db.db.Rebind(
+		`SELECT `+_executorColumns+` FROM shard_distributor_executors WHERE namespace = ? AND executor_id = ?`,
+	), namespace, executorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return row, false, nil
+	}
+	if err != nil {
+		return row, false, fmt.Errorf("get executor %s: %w", executorID, err)
+	}
+	return row, true, nil
+}
+
+func (s *executorStoreImpl) getShardOwners(ctx context.Context, q sqlx.QueryerContext, namespace string) (map[string]string, error) {
+	var rows []shardRow
+	if err := sqlx.SelectContext(ctx, q, &rows, s.db.db.Rebind(
+		`SELECT shard_id, executor_id FROM shard_distributor_shards WHERE namespace = ?`,
+	), namespace); err != nil {
+		return nil, fmt.Errorf("get shard owners: %w", err)
+	}
+	owners := make(map[string]string, len(rows))
+	for _, row := range rows {
+		owners[row.ShardID] = row.ExecutorID
+	}
+	return owners, nil
+}
+
+// getExecutorState returns the shards of every executor which has an assigned state.
+func (s *executorStoreImpl) getExecutorState(ctx context.Context, namespace string) (map[*store.ShardOwner][]string, error) {
+	var executors []struct {
+		ExecutorID string `db:"executor_id"`
+		Metadata   []byte `db:"metadata"`
+	}
+	if err := s.db.db.SelectContext(ctx, &executors, s.db.db.Rebind(
+		`SELECT executor_id, metadata FROM shard_distributor_executors WHERE namespace = ? AND assigned_state IS NOT NULL`,
+	), namespace); err != nil {
+		return nil, fmt.Errorf("get executors: %w", err)
+	}
+	owners, err := s.getShardOwners(ctx, s.db.db, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	shardsByExecutor := make(map[string][]string)
+	for shardID, executorID := range owners {
+		shardsByExecutor[executorID] = append(shardsByExecutor[executorID], shardID)
+	}
+
+	executorState := make(map[*store.ShardOwner][]string, len(executors))
+	for _, executor := range executors {
+		shardOwner, err := newShardOwner(executor.ExecutorID, executor.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		shardIDs := shardsByExecutor[executor.ExecutorID]
+		sort.Strings(shardIDs)
+		executorState[shardOwner] = append([]string{}, shardIDs...)
+	}
+	return executorState, nil
+}
+
+// writeAssignedState writes the assigned state of the executor at the given revision, creating the executor if needed.
+func (s *executorStoreImpl) writeAssignedState(ctx context.Context, tx *sqlx.Tx, namespace, executorID string, state *store.AssignedState, revision int64) error {
+	value, err := json.Marshal(fromAssignedState(state))
+	if err != nil {
+		return fmt.Errorf("marshal assigned shards for executor %s: %w", executorID, err)
+	}
+
+	res, err := tx.ExecContext(ctx, s.db.db.Rebind(
+		`UPDATE shard_distributor_executors SET assigned_state = ?, assigned_state_revision = ? WHERE namespace = ? AND executor_id = ?`,
+	), value, revision, namespace, executorID)
+	if err != nil {
+		return fmt.Errorf("write assigned state for executor %s: %w", executorID, err)
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("write assigned state for executor %s: %w", executorID, err)
+	} else if rows > 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, s.db.db.Rebind(
+		`INSERT INTO shard_distributor_executors (namespace, `+_executorColumns+`) VALUES (?, ?, 0, 0, NULL, NULL, ?, ?)`,
+	), namespace, executorID, value, revision); err != nil {
+		return fmt.Errorf("write assigned state for executor %s: %w", executorID, err)
+	}
+	return nil
+}
+
+func (s *executorStoreImpl) deleteExecutors(ctx context.Context, tx *sqlx.Tx, namespace string, executorIDs []string) error {
+	for _, chunk := range chunks(executorIDs) {
+		args := append([]interface{}{namespace}, toArgs(chunk)...)
+		if _, err := tx.ExecContext(ctx, s.db.db.Rebind(
+			`DELETE FROM shard_distributor_executors WHERE namespace = ? AND executor_id IN `+inClause(len(chunk)),
+		), args...); err != nil {
+			return fmt.Errorf("delete executors: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, s.db.db.Rebind(
+			`DELETE FROM shard_distributor_shards WHERE namespace = ? AND executor_id IN `+inClause(len(chunk)),
+		), args...); err != nil {
+			return fmt.Errorf("delete shards of executors: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *executorStoreImpl) deleteShards(ctx context.Context, tx *sqlx.Tx, namespace string, shardIDs []string) error {
+	for _, chunk := range chunks(shardIDs) {
+		if _, err := tx.ExecContext(ctx, s.db.db.Rebind(
+			`DELETE FROM shard_distributor_shards WHERE namespace = ? AND shard_id IN `+inClause(len(chunk)),
+		), append([]interface{}{namespace}, toArgs(chunk)...)...); err != nil {
+			return fmt.Errorf("delete shards: %w", err)
+		}
+	}
+	return nil
+}
+
+// insertShards records the owners of the given shards, which must not have an owner.
+func (s *executorStoreImpl) insertShards(ctx context.Context, tx *sqlx.Tx, namespace string, owners map[string]string) error {
+	for _, chunk := range chunks(sortedKeys(owners)) {
+		args := make([]interface{}, 0, 3*len(chunk))
+		for _, shardID := range chunk {
+			args = append(args, namespace, shardID, owners[shardID])
+		}
+		values := strings.TrimSuffix(strings.Repeat("(?, ?, ?), ", len(chunk)), ", ")
+		if _, err := tx.ExecContext(ctx, s.db.db.Rebind(
+			`INSERT INTO shard_distributor_shards (namespace, shard_id, executor_id) VALUES `+values,
+		), args...); err != nil {
+			return fmt.Errorf("insert shards: %w", err)
+		}
+	}
+	return nil
+}
+
+// recordShardMoves sets the last move time of the given shards. Shards without statistics get new statistics with no load.
+func (s *executorStoreImpl) recordShardMoves(ctx context.Context, tx *sqlx.Tx, namespace string, shardIDs []string) error {
+	now := toNanos(s.timeSource.Now().UTC())
+	for _, chunk := range chunks(shardIDs) {
+		var existing []string
+		if err := tx.SelectContext(ctx, &existing, s.db.db.Rebind(
+			`SELECT shard_id FROM shard_distributor_shard_stats WHERE namespace = ? AND shard_id IN `+inClause(len(chunk)),
+		), append([]interface{}{namespace}, toArgs(chunk)...)...); err != nil {
+			return fmt.Errorf("get shard statistics: %w", err)
+		}
+
+		if len(existing) > 0 {
+			if _, err := tx.ExecContext(ctx, s.db.db.Rebind(
+				`UPDATE shard_distributor_shard_stats SET last_move_time = ? WHERE namespace = ? AND shard_id IN `+inClause(len(existing)),
+			), append([]interface{}{now, namespace}, toArgs(existing)...)...); err != nil {
+				return fmt.Errorf("update shard statistics: %w", err)
+			}
+		}
+
+		existingSet := make(map[string]struct{}, len(existing))
+		for _, shardID := range existing {
+			existingSet[shardID] = struct{}{}
+		}
+		var args []interface{}
+		for _, shardID := range chunk {
+			if _, ok := existingSet[shardID]; !ok {
+				args = append(args, namespace, shardID, now, now)
+			}
+		}
+		if len(args) == 0 {
+			continue
+		}
+		values := strings.TrimSuffix(strings.Repeat("(?, ?, 0, ?, ?), ", len(args)/4), ", ")
+		if _, err := tx.ExecContext(ctx, s.db.db.Rebind(
+			`INSERT INTO shard_distributor_shard_stats (namespace, shard_id, smoothed_load, last_update_time, last_move_time) VALUES `+values,
+		), args...); err != nil {
+			return fmt.Errorf("insert shard statistics: %w", err)
+		}
+	}
+	return nil
+}
+
+// applyGuard applies the leadership guard to the transaction.
+func applyGuard(tx *sqlx.Tx, guard store.GuardFunc) error {
+	guardedTxn, err := guard(tx)
+	if err != nil {
+		return fmt.Errorf("apply transaction guard: %w", err)
+	}
+	if _, ok := guardedTxn.(*sqlx.Tx); !ok {
+		return fmt.Errorf("guard function returned invalid transaction type")
+	}
+	return nil
+}
+
+func (r *executorRow) toHeartbeatState() (*store.HeartbeatState, error) {
+	state := &store.HeartbeatState{
+		LastHeartbeat: fromNanos(r.LastHeartbeat),
+		Status:        types.ExecutorStatus(r.Status),
+	}
+	if err := unmarshalIfPresent(r.ReportedShards, &state.ReportedShards); err != nil {
+		return nil, fmt.Errorf("parse reported shards: %w", err)
+	}
+	if err := unmarshalIfPresent(r.Metadata, &state.Metadata); err != nil {
+		return nil, fmt.Errorf("parse metadata: %w", err)
+	}
+	return state, nil
+}
+
+func (r *executorRow) toAssignedState() (*store.AssignedState, error) {
+	var record assignedStateRecord
+	if err := unmarshalIfPresent(r.AssignedState, &record); err != nil {
+		return nil, fmt.Errorf("parse assigned shards: %w", err)
+	}
+
+	state := &store.AssignedState{
+		AssignedShards: record.AssignedShards,
+		LastUpdated:    fromNanos(record.LastUpdated),
+		ModRevision:    r.AssignedStateRevision,
+	}
+	if len(record.ShardHandoverStats) > 0 {
+		state.ShardHandoverStats = make(map[string]store.ShardHandoverStats, len(record.ShardHandoverStats))
+		for shardID, stats := range record.ShardHandoverStats {
+			state.ShardHandoverStats[shardID] = store.ShardHandoverStats{
+				PreviousExecutorLastHeartbeatTime: fromNanos(stats.PreviousExecutorLastHeartbeatTime),
+				HandoverType:                      stats.HandoverType,
+			}
+		}
+	}
+	return state, nil
+}
+
+func fromAssignedState(state *store.AssignedState) *assignedStateRecord {
+	record := &assignedStateRecord{
+		AssignedShards: state.AssignedShards,
+		LastUpdated:    toNanos(state.LastUpdated),
+	}
+	if len(state.ShardHandoverStats) > 0 {
+		record.ShardHandoverStats = make(map[string]shardHandoverStatsRecord, len(state.ShardHandoverStats))
+		for shardID, stats := range state.ShardHandoverStats {
+			record.ShardHandoverStats[shardID] = shardHandoverStatsRecord{
+				PreviousExecutorLastHeartbeatTime: toNanos(stats.PreviousExecutorLastHeartbeatTime),
+				HandoverType:                      stats.HandoverType,
+			}
+		}
+	}
+	return record
+}
+
+func newShardOwner(executorID string, metadata []byte) (*store.ShardOwner, error) {
+	shardOwner := &store.ShardOwner{
+		ExecutorID: executorID,
+		Metadata:   make(map[string]string),
+	}
+	if err := unmarshalIfPresent(metadata, &shardOwner.Metadata); err != nil {
+		return nil, fmt.Errorf("parse metadata: %w", err)
+	}
+	return shardOwner, nil
+}
+
+func unmarshalIfPresent(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+func toArgs(values []string) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		args[i] = value
+	}
+	return args
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
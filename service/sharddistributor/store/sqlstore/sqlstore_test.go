@@ -0,0 +1,873 @@
+package sqlstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/sqlite"
+	shardDistributorCfg "github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+// TestRecordHeartbeat verifies that an executor's heartbeat is correctly stored.
+func TestRecordHeartbeat(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	executorID := "executor-TestRecordHeartbeat"
+	req := store.HeartbeatState{
+		LastHeartbeat: now,
+		Status:        types.ExecutorStatusACTIVE,
+		ReportedShards: map[string]*types.ShardStatusReport{
+			"shard-TestRecordHeartbeat": {Status: types.ShardStatusREADY},
+		},
+		Metadata: map[string]string{
+			"key-1": "value-1",
+			"key-2": "value-2",
+		},
+	}
+
+	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req)
+	require.NoError(t, err)
+
+	// Verify directly in the database
+	var row executorRow
+	require.NoError(t, tc.DB.Get(&row, `SELECT `+_executorColumns+` FROM shard_distributor_executors WHERE namespace = ? AND executor_id = ?`, tc.Namespace, executorID))
+	assert.Equal(t, now.UnixNano(), row.LastHeartbeat)
+	assert.Equal(t, int32(types.ExecutorStatusACTIVE), row.Status)
+	assert.Nil(t, row.AssignedState)
+	assert.Zero(t, row.AssignedStateRevision)
+
+	var reportedShards map[string]*types.ShardStatusReport
+	require.NoError(t, json.Unmarshal(row.ReportedShards, &reportedShards))
+	require.Len(t, reportedShards, 1)
+	assert.Equal(t, types.ShardStatusREADY, reportedShards["shard-TestRecordHeartbeat"].Status)
+
+	var metadata map[string]string
+	require.NoError(t, json.Unmarshal(row.Metadata, &metadata))
+	assert.Equal(t, req.Metadata, metadata)
+}
+
+// TestRecordHeartbeat_MergesMetadata verifies that metadata keys are only added or overwritten by heartbeats.
+func TestRecordHeartbeat_MergesMetadata(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "executor-metadata"
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+		Status:   types.ExecutorStatusACTIVE,
+		Metadata: map[string]string{"key-1": "value-1", "key-2": "value-2"},
+	}))
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+		Status:   types.ExecutorStatusACTIVE,
+		Metadata: map[string]string{"key-2": "new-value-2"},
+	}))
+
+	executor, err := executorStore.GetExecutor(ctx, tc.Namespace, executorID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key-1": "value-1", "key-2": "new-value-2"}, executor.Metadata)
+}
+
+func TestGetHeartbeat(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	executorID := "executor-get"
+	req := store.HeartbeatState{
+		Status:        types.ExecutorStatusDRAINING,
+		LastHeartbeat: now,
+	}
+
+	// 1. Record a heartbeat
+	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req)
+	require.NoError(t, err)
+
+	// Assign shards to one executor
+	assignState := map[string]store.AssignedState{
+		executorID: {
+			AssignedShards: map[string]*types.ShardAssignment{
+				"shard-1": {Status: types.AssignmentStatusREADY},
+			},
+		},
+	}
+	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: assignState,
+		},
+	}, store.NopGuard()))
+
+	// 2. Get the heartbeat back
+	hb, assignedFromDB, err := executorStore.GetHeartbeat(ctx, tc.Namespace, executorID)
+	require.NoError(t, err)
+	require.NotNil(t, hb)
+
+	// 3. Verify the state
+	assert.Equal(t, types.ExecutorStatusDRAINING, hb.Status)
+	assert.Equal(t, now, hb.LastHeartbeat)
+	require.NotNil(t, assignedFromDB.AssignedShards)
+	assert.Equal(t, assignState[executorID].AssignedShards, assignedFromDB.AssignedShards)
+	assert.Greater(t, assignedFromDB.ModRevision, int64(0))
+
+	// 4. Test getting a non-existent executor
+	_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, "executor-non-existent")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, store.ErrExecutorNotFound)
+}
+
+// TestGetState verifies that the store can accurately retrieve the state of all executors.
+func TestGetState(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID1 := "exec-TestGetState-1"
+	executorID2 := "exec-TestGetState-2"
+	shardID1 := "shard-1"
+	shardID2 := "shard-2"
+
+	// Setup: Record heartbeats and assign shards.
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID1, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID2, store.HeartbeatState{Status: types.ExecutorStatusDRAINING}))
+	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {AssignedShards: map[string]*types.ShardAssignment{shardID1: {}}},
+				executorID2: {AssignedShards: map[string]*types.ShardAssignment{shardID2: {}}},
+			},
+		},
+	}, store.NopGuard()))
+
+	// Action: Get the state.
+	namespaceState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+
+	// Verification:
+	// Check Executors
+	require.Len(t, namespaceState.Executors, 2, "Should retrieve two heartbeat states")
+	assert.Equal(t, types.ExecutorStatusACTIVE, namespaceState.Executors[executorID1].Status)
+	assert.Equal(t, types.ExecutorStatusDRAINING, namespaceState.Executors[executorID2].Status)
+
+	// Check ShardAssignments (from executor records)
+	require.Len(t, namespaceState.ShardAssignments, 2, "Should retrieve two assignment states")
+	assert.Contains(t, namespaceState.ShardAssignments[executorID1].AssignedShards, shardID1)
+	assert.Contains(t, namespaceState.ShardAssignments[executorID2].AssignedShards, shardID2)
+
+	// Every write bumps the revision of the namespace
+	assert.Equal(t, int64(3), namespaceState.GlobalRevision)
+}
+
+// TestAssignShards_WithRevisions tests the optimistic locking logic of AssignShards.
+func TestAssignShards_WithRevisions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID1 := "exec-rev-1"
+	executorID2 := "exec-rev-2"
+
+	t.Run("Success", func(t *testing.T) {
+		tc := setupStoreTestDB(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		// Define a new state: assign shard1 to exec1
+		newState := &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}}},
+			},
+		}
+
+		// Assign - should succeed
+		err := executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: newState}, store.NopGuard())
+		require.NoError(t, err)
+
+		// Verify the assignment
+		state, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		assert.Contains(t, state.ShardAssignments[executorID1].AssignedShards, "shard-1")
+	})
+
+	t.Run("ConflictOnNewShard", func(t *testing.T) {
+		tc := setupStoreTestDB(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		// Process A defines its desired state: assign shard-new to exec1
+		processAState := &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {AssignedShards: map[string]*types.ShardAssignment{"shard-new": {}}},
+				executorID2: {},
+			},
+		}
+
+		// Process B defines its desired state: assign shard-new to exec2
+		processBState := &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {},
+				executorID2: {AssignedShards: map[string]*types.ShardAssignment{"shard-new": {}}},
+			},
+		}
+
+		// Process A succeeds
+		err := executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: processAState}, store.NopGuard())
+		require.NoError(t, err)
+
+		// Process B tries to commit, but its revision check for shard-new (rev=0) will fail.
+		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: processBState}, store.NopGuard())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrVersionConflict)
+
+		// The failed transaction must not have changed the owner
+		owner, err := executorStore.GetShardOwner(ctx, tc.Namespace, "shard-new")
+		require.NoError(t, err)
+		assert.Equal(t, executorID1, owner.ExecutorID)
+	})
+
+	t.Run("ConflictOnExistingShard", func(t *testing.T) {
+		tc := setupStoreTestDB(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		shardID := "shard-to-move"
+		// 1. Setup: Assign the shard to executor1
+		setupState, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		setupState.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {AssignedShards: map[string]*types.ShardAssignment{shardID: {}}},
+		}
+		require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: setupState}, store.NopGuard()))
+
+		// 2. Process A reads the state, intending to move the shard to executor2
+		stateForProcA, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		stateForProcA.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {ModRevision: stateForProcA.ShardAssignments[executorID1].ModRevision},
+			executorID2: {AssignedShards: map[string]*types.ShardAssignment{shardID: {}}, ModRevision: 0},
+		}
+
+		// 3. In the meantime, another process makes a different change (e.g., re-assigns to same executor, which changes revision)
+		intermediateState, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		intermediateState.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {
+				AssignedShards: map[string]*types.ShardAssignment{shardID: {}},
+				ModRevision:    intermediateState.ShardAssignments[executorID1].ModRevision,
+			},
+		}
+		require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: intermediateState}, store.NopGuard()))
+
+		// 4. Process A tries to commit its change. It will fail because its stored revision for the shard is now stale.
+		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: stateForProcA}, store.NopGuard())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrVersionConflict)
+	})
+
+	t.Run("ConflictOnExecutorToDelete", func(t *testing.T) {
+		tc := setupStoreTestDB(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		// The executor receives a shard after the decision to delete it
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID2))
+
+		err := executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+			NewState:          &store.NamespaceState{ShardAssignments: map[string]store.AssignedState{}},
+			ExecutorsToDelete: map[string]int64{executorID2: 0},
+		}, store.NopGuard())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrVersionConflict)
+
+		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, executorID2)
+		assert.NoError(t, err, "Executor should not have been deleted")
+	})
+
+	t.Run("MovesShardsAndDeletesExecutors", func(t *testing.T) {
+		tc := setupStoreTestDB(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID1))
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-2", executorID2))
+
+		state, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+			NewState: &store.NamespaceState{ShardAssignments: map[string]store.AssignedState{
+				executorID1: {
+					AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}, "shard-2": {}},
+					ModRevision:    state.ShardAssignments[executorID1].ModRevision,
+				},
+			}},
+			ExecutorsToDelete: map[string]int64{executorID2: state.ShardAssignments[executorID2].ModRevision},
+		}, store.NopGuard())
+		require.NoError(t, err)
+
+		for _, shardID := range []string{"shard-1", "shard-2"} {
+			owner, err := executorStore.GetShardOwner(ctx, tc.Namespace, shardID)
+			require.NoError(t, err)
+			assert.Equal(t, executorID1, owner.ExecutorID)
+		}
+		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, executorID2)
+		assert.ErrorIs(t, err, store.ErrExecutorNotFound)
+	})
+
+	t.Run("NoChanges", func(t *testing.T) {
+		tc := setupStoreTestDB(t)
+		executorStore := createStore(t, tc)
+		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+
+		// Get the current state
+		state, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+
+		// Call AssignShards with the same assignments
+		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: state}, store.NopGuard())
+		require.NoError(t, err, "Assigning with no changes should succeed")
+	})
+}
+
+// TestGuardedOperations verifies that AssignShards and DeleteExecutors respect the leader guard.
+func TestGuardedOperations(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	namespace := "test-guarded-ns"
+	executorID := "exec-to-delete"
+
+	// 1. Create two potential leaders
+	elector := createLeaderStore(t, tc)
+	election1, err := elector.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer func() { _ = election1.Cleanup(ctx) }()
+	election2, err := elector.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer func() { _ = election2.Cleanup(ctx) }()
+
+	// 2. First node becomes leader
+	require.NoError(t, election1.Campaign(ctx, "host-1"))
+	validGuard := election1.Guard()
+
+	// 3. Use the valid guard to assign shards - should succeed
+	assignState := map[string]store.AssignedState{"exec-1": {}}
+	err = executorStore.AssignShards(ctx, namespace, store.AssignShardsRequest{NewState: &store.NamespaceState{ShardAssignments: assignState}}, validGuard)
+	require.NoError(t, err, "Assigning shards with a valid leader guard should succeed")
+
+	// 4. First node resigns, second node becomes leader
+	require.NoError(t, election1.Resign(ctx))
+	require.NoError(t, election2.Campaign(ctx, "host-2"))
+
+	// 5. Use the now-invalid guard from the first leader - should fail
+	state, err := executorStore.GetState(ctx, namespace)
+	require.NoError(t, err)
+	assignState = map[string]store.AssignedState{"exec-1": {ModRevision: state.ShardAssignments["exec-1"].ModRevision}}
+	err = executorStore.AssignShards(ctx, namespace, store.AssignShardsRequest{NewState: &store.NamespaceState{ShardAssignments: assignState}}, validGuard)
+	require.Error(t, err, "Assigning shards with a stale leader guard should fail")
+	assert.ErrorIs(t, err, store.ErrVersionConflict)
+	err = executorStore.DeleteExecutors(ctx, namespace, []string{"exec-1"}, validGuard)
+	require.Error(t, err, "Deleting executors with a stale leader guard should fail")
+	assert.ErrorIs(t, err, store.ErrVersionConflict)
+
+	// The guard of the new leader is valid
+	err = executorStore.AssignShards(ctx, namespace, store.AssignShardsRequest{NewState: &store.NamespaceState{ShardAssignments: assignState}}, election2.Guard())
+	require.NoError(t, err)
+
+	// 6. Use the NopGuard to delete an executor - should succeed
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	err = executorStore.DeleteExecutors(ctx, namespace, []string{executorID}, store.NopGuard())
+	require.NoError(t, err, "Deleting an executor without a guard should succeed")
+
+	// Verify deletion
+	newState, err := executorStore.GetState(ctx, namespace)
+	require.NoError(t, err)
+	_, ok := newState.ShardAssignments[executorID]
+	require.False(t, ok, "Executor should have been deleted")
+}
+
+// TestSubscribe verifies that the subscription channel receives notifications for significant changes.
+func TestSubscribe(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-sub"
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+	// Start subscription
+	sub, err := executorStore.Subscribe(ctx, tc.Namespace)
+	require.NoError(t, err)
+
+	// Record a heartbeat with the same state, which is an insignificant change
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+		LastHeartbeat: time.Now().UTC(),
+		Status:        types.ExecutorStatusACTIVE,
+	}))
+
+	// Assigning shards is not significant either
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID))
+
+	select {
+	case <-sub:
+		t.Fatal("Should not receive notification for a heartbeat-only update")
+	case <-time.After(10 * _testPollInterval):
+		// Expected behavior
+	}
+
+	// Now update the reported shards, which IS a significant change
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+		LastHeartbeat: time.Now().UTC(),
+		Status:        types.ExecutorStatusACTIVE,
+		ReportedShards: map[string]*types.ShardStatusReport{
+			"shard-1": {Status: types.ShardStatusREADY},
+		},
+	}))
+
+	select {
+	case rev, ok := <-sub:
+		require.True(t, ok, "Channel should be open")
+		state, err := executorStore.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		assert.Equal(t, state.GlobalRevision, rev, "Should receive the revision of the reported shards change")
+	case <-time.After(1 * time.Second):
+		t.Fatal("Should have received a notification for a reported shards change")
+	}
+
+	// The channel is closed when the context is cancelled
+	cancel()
+	select {
+	case _, ok := <-sub:
+		assert.False(t, ok, "Channel should be closed")
+	case <-time.After(1 * time.Second):
+		t.Fatal("Channel should have been closed")
+	}
+}
+
+// TestSubscribeToAssignmentChanges verifies that subscribers receive the initial owners of the shards and their changes.
+func TestSubscribeToAssignmentChanges(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-assignment-changes"
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+		Status:   types.ExecutorStatusACTIVE,
+		Metadata: map[string]string{"address": "host:1234"},
+	}))
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID))
+
+	sub, unsubscribe, err := executorStore.SubscribeToAssignmentChanges(ctx, tc.Namespace)
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	receive := func() map[string][]string {
+		t.Helper()
+		select {
+		case state := <-sub:
+			result := make(map[string][]string, len(state))
+			for owner, shardIDs := range state {
+				assert.Equal(t, "host:1234", owner.Metadata["address"])
+				result[owner.ExecutorID] = shardIDs
+			}
+			return result
+		case <-time.After(1 * time.Second):
+			t.Fatal("Should have received the shard owners")
+			return nil
+		}
+	}
+
+	assert.Equal(t, map[string][]string{executorID: {"shard-1"}}, receive())
+
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-2", executorID))
+	assert.Equal(t, map[string][]string{executorID: {"shard-1", "shard-2"}}, receive())
+}
+
+func TestDeleteExecutors_Empty(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := executorStore.DeleteExecutors(ctx, tc.Namespace, []string{}, store.NopGuard())
+	require.NoError(t, err)
+}
+
+// TestDeleteExecutors covers various scenarios for the DeleteExecutors method.
+func TestDeleteExecutors(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("SucceedsForNonExistentExecutor", func(t *testing.T) {
+		// Action: Delete a non-existent executor.
+		err := executorStore.DeleteExecutors(ctx, tc.Namespace, []string{"non-existent-executor"}, store.NopGuard())
+		// Verification: Should not return an error.
+		require.NoError(t, err)
+	})
+
+	t.Run("DeletesMultipleExecutors", func(t *testing.T) {
+		// Setup: Create and assign shards to multiple executors.
+		execToDelete1 := "multi-delete-1"
+		execToDelete2 := "multi-delete-2"
+		execToKeep := "multi-keep-1"
+		shardOfDeletedExecutor1 := "multi-shard-1"
+		shardOfDeletedExecutor2 := "multi-shard-2"
+		shardOfSurvivingExecutor := "multi-shard-keep"
+
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, execToDelete1, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, execToDelete2, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, execToKeep, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardOfDeletedExecutor1, execToDelete1))
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardOfDeletedExecutor2, execToDelete2))
+		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardOfSurvivingExecutor, execToKeep))
+
+		// Action: Delete two of the three executors in one call.
+		err := executorStore.DeleteExecutors(ctx, tc.Namespace, []string{execToDelete1, execToDelete2}, store.NopGuard())
+		require.NoError(t, err)
+
+		// Verification:
+		// 1. Check deleted executors are gone.
+		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, execToDelete1)
+		assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Executor 1 should be gone")
+
+		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, execToDelete2)
+		assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Executor 2 should be gone")
+
+		// 2. Check that the surviving executor remain.
+		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, execToKeep)
+		assert.NoError(t, err, "Surviving executor should still exist")
+
+		// 3. Check that only the shards of the surviving executor are still owned.
+		_, err = executorStore.GetShardOwner(ctx, tc.Namespace, shardOfDeletedExecutor1)
+		assert.ErrorIs(t, err, store.ErrShardNotFound)
+		owner, err := executorStore.GetShardOwner(ctx, tc.Namespace, shardOfSurvivingExecutor)
+		require.NoError(t, err)
+		assert.Equal(t, execToKeep, owner.ExecutorID)
+	})
+}
+
+// TestAssignAndGetShardOwnerRoundtrip verifies the successful assignment and retrieval of a shard owner.
+func TestAssignAndGetShardOwnerRoundtrip(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "executor-roundtrip"
+	shardID := "shard-roundtrip"
+
+	// Setup: Create an active executor.
+	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+		Status:   types.ExecutorStatusACTIVE,
+		Metadata: map[string]string{"key": "value"},
+	})
+	require.NoError(t, err)
+
+	// 1. Assign a shard to the active executor.
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID, executorID)
+	require.NoError(t, err, "Should successfully assign shard to an active executor")
+
+	// 2. Get the owner and verify it's the correct executor.
+	state, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.Contains(t, state.ShardAssignments[executorID].AssignedShards, shardID)
+
+	owner, err := executorStore.GetShardOwner(ctx, tc.Namespace, shardID)
+	require.NoError(t, err)
+	assert.Equal(t, &store.ShardOwner{ExecutorID: executorID, Metadata: map[string]string{"key": "value"}}, owner)
+
+	executor, err := executorStore.GetExecutor(ctx, tc.Namespace, executorID)
+	require.NoError(t, err)
+	assert.Equal(t, owner, executor)
+
+	// 3. Unknown shards and executors are not found.
+	_, err = executorStore.GetShardOwner(ctx, tc.Namespace, "shard-unknown")
+	assert.ErrorIs(t, err, store.ErrShardNotFound)
+	_, err = executorStore.GetExecutor(ctx, tc.Namespace, "executor-unknown")
+	assert.ErrorIs(t, err, store.ErrExecutorNotFound)
+}
+
+// TestAssignShardErrors tests the various error conditions when assigning a shard.
+func TestAssignShardErrors(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	activeExecutorID := "executor-active-errors"
+	drainingExecutorID := "executor-draining-errors"
+	shardID1 := "shard-err-1"
+	shardID2 := "shard-err-2"
+
+	// Setup: Create an active and a draining executor, and assign one shard.
+	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, activeExecutorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE})
+	require.NoError(t, err)
+	err = executorStore.RecordHeartbeat(ctx, tc.Namespace, drainingExecutorID, store.HeartbeatState{Status: types.ExecutorStatusDRAINING})
+	require.NoError(t, err)
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID1, activeExecutorID)
+	require.NoError(t, err)
+
+	// Case 1: Assigning an already-assigned shard.
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID1, activeExecutorID)
+	require.Error(t, err, "Should fail to assign an already-assigned shard")
+	assert.ErrorAs(t, err, new(*store.ErrShardAlreadyAssigned))
+
+	// Case 2: Assigning to a non-existent executor.
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID2, "non-existent-executor")
+	require.Error(t, err, "Should fail to assign to a non-existent executor")
+	assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Error should be ErrExecutorNotFound")
+
+	// Case 3: Assigning to a non-active (draining) executor.
+	err = executorStore.AssignShard(ctx, tc.Namespace, shardID2, drainingExecutorID)
+	require.Error(t, err, "Should fail to assign to a draining executor")
+	assert.ErrorIs(t, err, store.ErrVersionConflict, "Error should be ErrVersionConflict for non-active executor")
+}
+
+// TestShardStatisticsPersistence verifies that shard statistics are preserved on assignment
+// when they already exist, and that GetState exposes them.
+func TestShardStatisticsPersistence(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-stats"
+	shardID := "shard-stats"
+
+	// 1. Setup: ensure executor is ACTIVE
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+	// 2. Pre-create shard statistics as if coming from prior history
+	stats := store.ShardStatistics{SmoothedLoad: 12.5, LastUpdateTime: time.Unix(1234, 0).UTC(), LastMoveTime: time.Unix(5678, 0).UTC()}
+	insertShardStats(t, tc, map[string]store.ShardStatistics{shardID: stats})
+
+	// 3. Assign the shard via AssignShard (should not clobber existing metrics)
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardID, executorID))
+
+	// 4. Verify via GetState that metrics are preserved and exposed
+	nsState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	require.Contains(t, nsState.ShardStats, shardID)
+	updatedStats := nsState.ShardStats[shardID]
+	assert.Equal(t, stats.SmoothedLoad, updatedStats.SmoothedLoad)
+	assert.Equal(t, stats.LastUpdateTime, updatedStats.LastUpdateTime)
+	// This should be greater than the last move time
+	assert.Greater(t, updatedStats.LastMoveTime, stats.LastMoveTime)
+
+	// 5. Also ensure assignment recorded correctly
+	require.Contains(t, nsState.ShardAssignments[executorID].AssignedShards, shardID)
+}
+
+// TestShardStatisticsOnReassignment verifies that AssignShards records the move time of the reassigned shards only.
+func TestShardStatisticsOnReassignment(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	recordHeartbeats(ctx, t, executorStore, tc.Namespace, "exec-1", "exec-2")
+	stats := store.ShardStatistics{SmoothedLoad: 3, LastUpdateTime: time.Unix(1234, 0).UTC(), LastMoveTime: time.Unix(5678, 0).UTC()}
+	insertShardStats(t, tc, map[string]store.ShardStatistics{"moved": stats, "kept": stats})
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "moved", "exec-1"))
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "kept", "exec-1"))
+
+	state, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	before := state.ShardStats
+
+	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{ShardAssignments: map[string]store.AssignedState{
+			"exec-1": {AssignedShards: map[string]*types.ShardAssignment{"kept": {}}, ModRevision: state.ShardAssignments["exec-1"].ModRevision},
+			"exec-2": {AssignedShards: map[string]*types.ShardAssignment{"moved": {}, "new": {}}},
+		}},
+	}, store.NopGuard()))
+
+	state, err = executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.Equal(t, before["kept"], state.ShardStats["kept"])
+	assert.Equal(t, stats.SmoothedLoad, state.ShardStats["moved"].SmoothedLoad)
+	assert.Greater(t, state.ShardStats["moved"].LastMoveTime, before["moved"].LastMoveTime)
+	require.Contains(t, state.ShardStats, "new")
+	assert.Zero(t, state.ShardStats["new"].SmoothedLoad)
+	assert.False(t, state.ShardStats["new"].LastMoveTime.IsZero())
+}
+
+// TestGetShardStatisticsForMissingShard verifies GetState does not report statistics for unknown shards.
+func TestGetShardStatisticsForMissingShard(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// No metrics are written; GetState should not contain unknown shard
+	st, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.NotContains(t, st.ShardStats, "unknown")
+}
+
+// TestDeleteShardStatsDeletesAllStats verifies that shard statistics are correctly deleted.
+func TestDeleteShardStatsDeletesAllStats(t *testing.T) {
+	tc := setupStoreTestDB(t)
+	executorStore := createStore(t, tc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// more stats than can be deleted by a single statement
+	totalShardStats := 2*_maxRowsPerStatement + 35
+	shardIDs := make([]string, 0, totalShardStats)
+
+	// Create stale stats
+	stats := make(map[string]store.ShardStatistics)
+	for i := 0; i < totalShardStats; i++ {
+		shardID := "stale-stats-" + strconv.Itoa(i)
+		shardIDs = append(shardIDs, shardID)
+		stats[shardID] = store.ShardStatistics{
+			SmoothedLoad:   float64(i),
+			LastUpdateTime: time.Unix(int64(i), 0).UTC(),
+			LastMoveTime:   time.Unix(int64(i), 0).UTC(),
+		}
+	}
+	insertShardStats(t, tc, stats)
+
+	nsState, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	require.Len(t, nsState.ShardStats, totalShardStats)
+
+	require.NoError(t, executorStore.DeleteShardStats(ctx, tc.Namespace, shardIDs, store.NopGuard()))
+
+	nsState, err = executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	// All stats should be deleted
+	assert.Empty(t, nsState.ShardStats)
+}
+
+func TestNewStore_BadConfig(t *testing.T) {
+	for name, params := range map[string]map[string]interface{}{
+		"unsupported driver": {"driverName": "oracle", "dataSourceName": "dsn"},
+		"missing dsn":        {"driverName": _driverSQLite},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewStore(ExecutorStoreParams{
+				Cfg:       shardDistributorCfg.ShardDistribution{Store: shardDistributorCfg.Store{StorageParams: createConfig(t, params)}},
+				Lifecycle: fxtest.NewLifecycle(t),
+				Logger:    testlogger.New(t),
+			})
+			assert.ErrorContains(t, err, "bad config for sql store")
+		})
+	}
+}
+
+// --- Test Setup ---
+
+const _testPollInterval = 10 * time.Millisecond
+
+type storeTestDB struct {
+	Namespace string
+	Cfg       shardDistributorCfg.ShardDistribution
+	DB        *sqlx.DB
+}
+
+// setupStoreTestDB creates a SQLite database with the shard distributor schema.
+func setupStoreTestDB(t *testing.T) *storeTestDB {
+	t.Helper()
+
+	dataSourceName := fmt.Sprintf("file:%s?_pragma=busy_timeout(10000)&_pragma=journal_mode(wal)", filepath.Join(t.TempDir(), "sharddistributor.db"))
+	db, err := sqlx.Connect(_driverSQLite, dataSourceName)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	schema, err := sqlite.SchemaFS.ReadFile("sharddistributor/schema.sql")
+	require.NoError(t, err)
+	_, err = db.Exec(string(schema))
+	require.NoError(t, err)
+
+	storageParams := createConfig(t, map[string]interface{}{
+		"driverName":     _driverSQLite,
+		"dataSourceName": dataSourceName,
+		"pollInterval":   _testPollInterval.String(),
+		"electionTTL":    "3s",
+	})
+
+	return &storeTestDB{
+		Namespace: fmt.Sprintf("ns-%s", strings.ToLower(t.Name())),
+		Cfg: shardDistributorCfg.ShardDistribution{
+			Store:       shardDistributorCfg.Store{StorageParams: storageParams},
+			LeaderStore: shardDistributorCfg.Store{StorageParams: storageParams},
+		},
+		DB: db,
+	}
+}
+
+func createConfig(t *testing.T, params map[string]interface{}) *config.YamlNode {
+	t.Helper()
+
+	yamlCfg, err := yaml.Marshal(params)
+	require.NoError(t, err)
+
+	var res *config.YamlNode
+	require.NoError(t, yaml.Unmarshal(yamlCfg, &res))
+	return res
+}
+
+func insertShardStats(t *testing.T, tc *storeTestDB, stats map[string]store.ShardStatistics) {
+	t.Helper()
+
+	for shardID, stat := range stats {
+		_, err := tc.DB.Exec(
+			`INSERT INTO shard_distributor_shard_stats (namespace, shard_id, smoothed_load, last_update_time, last_move_time) VALUES (?, ?, ?, ?, ?)`,
+			tc.Namespace, shardID, stat.SmoothedLoad, toNanos(stat.LastUpdateTime), toNanos(stat.LastMoveTime),
+		)
+		require.NoError(t, err)
+	}
+}
+
+func recordHeartbeats(ctx context.Context, t *testing.T, executorStore store.Store, namespace string, executorIDs ...string) {
+	t.Helper()
+
+	for _, executorID := range executorIDs {
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	}
+}
+
+func createStore(t *testing.T, tc *storeTestDB) store.Store {
+	t.Helper()
+	lifecycle := fxtest.NewLifecycle(t)
+	store, err := NewStore(ExecutorStoreParams{
+		Cfg:       tc.Cfg,
+		Lifecycle: lifecycle,
+		Logger:    testlogger.New(t),
+	})
+	require.NoError(t, err)
+	t.Cleanup(lifecycle.RequireStop)
+	return store
+}
@@ -56,6 +56,7 @@ func setuptest(t *testing.T) (*Batcher, *resource.Test) {
 	sdkClient := mockResource.GetSDKClient()
 	mockClientBean.EXPECT().GetFrontendClient().Return(mockResource.FrontendClient).AnyTimes()
 	mockClientBean.EXPECT().GetRemoteAdminClient(gomock.Any()).Return(mockResource.RemoteAdminClient, nil).AnyTimes()
+	mockClientBean.EXPECT().GetHistoryClient().Return(mockResource.HistoryClient).AnyTimes()
 
 	return New(&BootstrapParams{
 		Logger:        testlogger.New(t),
@@ -56,6 +56,25 @@ type ReplicateParams struct {
 	TargetCluster string
 }
 
+// ResetParams is the parameters for resetting workflow
+type ResetParams struct {
+	// ResetType is where to reset, one of AllResetTypes
+	ResetType string
+	// DecisionOffset moves the reset point calculated by ResetType back by this number of decisions.
+	// Only zero or negative offsets are supported, and only for ResetTypeLastDecisionCompleted and ResetTypeLastDecisionScheduled
+	DecisionOffset int
+	// BadBinaryChecksum is the binary checksum to reset from, required for ResetTypeBadBinary
+	BadBinaryChecksum string
+	// SkipSignalReapply indicates whether to skip reapplying the signals received after the reset point
+	SkipSignalReapply bool
+}
+
+// UpsertSearchAttributesParams is the parameters for upserting the search attributes of workflow
+type UpsertSearchAttributesParams struct {
+	// SearchAttributes maps the search attribute keys to their JSON encoded values
+	SearchAttributes map[string][]byte
+}
+
 // BatchParams is the parameters for batch operation workflow
 type BatchParams struct {
 	// Target domain to execute batch operation
@@ -64,7 +83,7 @@ type BatchParams struct {
 	Query string
 	// Reason for the operation
 	Reason string
-	// Supporting: reset,terminate
+	// One of AllBatchTypes
 	BatchType string
 
 	// Below are all optional
@@ -76,6 +95,10 @@ type BatchParams struct {
 	SignalParams SignalParams
 	// ReplicateParams is params only for BatchTypeReplicate
 	ReplicateParams ReplicateParams
+	// ResetParams is params only for BatchTypeReset
+	ResetParams ResetParams
+	// UpsertSearchAttributesParams is params only for BatchTypeUpsertSearchAttributes
+	UpsertSearchAttributesParams UpsertSearchAttributesParams
 	// RPS of processing. Default to DefaultRPS
 	// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 	RPS int
@@ -105,6 +128,15 @@ type HeartBeatDetails struct {
 	SuccessCount int
 	// Number of workflows that give up due to errors.
 	ErrorCount int
+	// Workflows that give up due to errors, only the first MaxReportedFailures of them are kept
+	Failures []FailedWorkflow
+}
+
+// FailedWorkflow is a workflow that the batch operation failed to process
+type FailedWorkflow struct {
+	WorkflowID string
+	RunID      string
+	Error      string
 }
 
 type taskDetail struct {
@@ -113,3 +145,8 @@ type taskDetail struct {
 	// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
 	hbd HeartBeatDetails
 }
+
+type taskResponse struct {
+	execution types.WorkflowExecution
+	err       error
+}
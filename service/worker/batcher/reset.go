@@ -0,0 +1,255 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ResetTypeFirstDecisionCompleted resets to the first completed decision
+	ResetTypeFirstDecisionCompleted = "FirstDecisionCompleted"
+	// ResetTypeLastDecisionCompleted resets to the last completed decision
+	ResetTypeLastDecisionCompleted = "LastDecisionCompleted"
+	// ResetTypeLastContinuedAsNew resets to the last completed decision of the run that continued as new to the workflow
+	ResetTypeLastContinuedAsNew = "LastContinuedAsNew"
+	// ResetTypeBadBinary resets to the first completed decision of the bad binary given by ResetParams.BadBinaryChecksum
+	ResetTypeBadBinary = "BadBinary"
+	// ResetTypeFirstDecisionScheduled resets to the first scheduled decision
+	ResetTypeFirstDecisionScheduled = "FirstDecisionScheduled"
+	// ResetTypeLastDecisionScheduled resets to the last scheduled decision
+	ResetTypeLastDecisionScheduled = "LastDecisionScheduled"
+)
+
+// AllResetTypes is the reset types supported by BatchTypeReset
+var AllResetTypes = []string{
+	ResetTypeFirstDecisionCompleted,
+	ResetTypeLastDecisionCompleted,
+	ResetTypeLastContinuedAsNew,
+	ResetTypeBadBinary,
+	ResetTypeFirstDecisionScheduled,
+	ResetTypeLastDecisionScheduled,
+}
+
+// errNoResetPoint is returned when the workflow doesn't have the reset point of the reset type,
+// retrying doesn't help so the workflow is reported as failed right away
+var errNoResetPoint = errors.New("no reset point found")
+
+func validateResetParams(params ResetParams) error {
+	switch params.ResetType {
+	case ResetTypeFirstDecisionCompleted, ResetTypeLastContinuedAsNew, ResetTypeFirstDecisionScheduled:
+		if params.DecisionOffset != 0 {
+			return fmt.Errorf("decision offset is not supported for reset type %v", params.ResetType)
+		}
+	case ResetTypeBadBinary:
+		if params.BadBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum")
+		}
+		if params.DecisionOffset != 0 {
+			return fmt.Errorf("decision offset is not supported for reset type %v", params.ResetType)
+		}
+	case ResetTypeLastDecisionCompleted, ResetTypeLastDecisionScheduled:
+		if params.DecisionOffset > 0 {
+			return fmt.Errorf("only decision offset <= 0 is supported")
+		}
+	default:
+		return fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+	return nil
+}
+
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	requestID string,
+) error {
+	baseRunID, decisionFinishID, err := getResetPoint(ctx, client, batchParams.DomainName, workflowID, runID, batchParams.ResetParams)
+	if err != nil {
+		return err
+	}
+	_, err = client.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+		Domain: batchParams.DomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      baseRunID,
+		},
+		Reason:                fmt.Sprintf("%v:%v", BatchWFTypeName, batchParams.Reason),
+		DecisionFinishEventID: decisionFinishID,
+		RequestID:             requestID,
+		SkipSignalReapply:     batchParams.ResetParams.SkipSignalReapply,
+	})
+	return err
+}
+
+// getResetPoint returns the run to reset and the DecisionFinishEventID of the reset request
+func getResetPoint(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	params ResetParams,
+) (string, int64, error) {
+	switch params.ResetType {
+	case ResetTypeFirstDecisionCompleted:
+		eventIDs, err := getEventIDsByType(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskCompleted)
+		if err != nil {
+			return "", 0, err
+		}
+		return runID, eventIDs[0], nil
+	case ResetTypeLastDecisionCompleted:
+		eventIDs, err := getEventIDsByType(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskCompleted)
+		if err != nil {
+			return "", 0, err
+		}
+		eventID, err := getEventIDByOffset(eventIDs, params.DecisionOffset)
+		return runID, eventID, err
+	case ResetTypeFirstDecisionScheduled:
+		eventIDs, err := getEventIDsByType(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskScheduled)
+		if err != nil {
+			return "", 0, err
+		}
+		// DecisionFinishEventID is exclusive in reset API
+		return runID, eventIDs[0] + 1, nil
+	case ResetTypeLastDecisionScheduled:
+		eventIDs, err := getEventIDsByType(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskScheduled)
+		if err != nil {
+			return "", 0, err
+		}
+		eventID, err := getEventIDByOffset(eventIDs, params.DecisionOffset)
+		// DecisionFinishEventID is exclusive in reset API
+		return runID, eventID + 1, err
+	case ResetTypeLastContinuedAsNew:
+		resp, err := client.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+			Domain: domain,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: workflowID,
+				RunID:      runID,
+			},
+			MaximumPageSize: 1,
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		events := resp.GetHistory().GetEvents()
+		if len(events) == 0 {
+			return "", 0, fmt.Errorf("%w: workflow has no history", errNoResetPoint)
+		}
+		baseRunID := events[0].GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunID()
+		if baseRunID == "" {
+			return "", 0, fmt.Errorf("%w: workflow is not continued as new", errNoResetPoint)
+		}
+		eventIDs, err := getEventIDsByType(ctx, client, domain, workflowID, baseRunID, types.EventTypeDecisionTaskCompleted)
+		if err != nil {
+			return "", 0, err
+		}
+		return baseRunID, eventIDs[len(eventIDs)-1], nil
+	case ResetTypeBadBinary:
+		resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+			Domain: domain,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: workflowID,
+				RunID:      runID,
+			},
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		var points []*types.ResetPointInfo
+		if info := resp.GetWorkflowExecutionInfo(); info != nil && info.AutoResetPoints != nil {
+			points = info.AutoResetPoints.Points
+		}
+		nowNano := time.Now().UnixNano()
+		for _, point := range points {
+			if point.GetBinaryChecksum() != params.BadBinaryChecksum || !point.GetResettable() {
+				continue
+			}
+			if point.GetExpiringTimeNano() > 0 && nowNano > point.GetExpiringTimeNano() {
+				// reset point has expired and the history may be deleted already
+				continue
+			}
+			return runID, point.GetFirstDecisionCompletedID(), nil
+		}
+		return "", 0, fmt.Errorf("%w: no resettable point of binary checksum %v", errNoResetPoint, params.BadBinaryChecksum)
+	default:
+		return "", 0, fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+}
+
+// getEventIDsByType returns the IDs of the events of the given type in the history of the run,
+// it returns errNoResetPoint if there isn't any
+func getEventIDsByType(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	eventType types.EventType,
+) ([]int64, error) {
+	request := &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: 1000,
+	}
+	var eventIDs []int64
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range resp.GetHistory().GetEvents() {
+			if event.GetEventType() == eventType {
+				eventIDs = append(eventIDs, event.ID)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	if len(eventIDs) == 0 {
+		return nil, fmt.Errorf("%w: no %v event", errNoResetPoint, eventType)
+	}
+	return eventIDs, nil
+}
+
+// getEventIDByOffset returns the event ID which is offset decisions before the last one
+func getEventIDByOffset(eventIDs []int64, offset int) (int64, error) {
+	index := len(eventIDs) - 1 + offset
+	if index < 0 {
+		return 0, fmt.Errorf("%w: workflow has only %v decisions", errNoResetPoint, len(eventIDs))
+	}
+	return eventIDs[index], nil
+}
@@ -0,0 +1,169 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidateResetParams(t *testing.T) {
+	tests := map[string]struct {
+		params ResetParams
+		err    string
+	}{
+		"last decision completed with offset": {
+			params: ResetParams{ResetType: ResetTypeLastDecisionCompleted, DecisionOffset: -2},
+		},
+		"positive offset": {
+			params: ResetParams{ResetType: ResetTypeLastDecisionScheduled, DecisionOffset: 1},
+			err:    "only decision offset <= 0 is supported",
+		},
+		"offset of first decision": {
+			params: ResetParams{ResetType: ResetTypeFirstDecisionCompleted, DecisionOffset: -1},
+			err:    "decision offset is not supported for reset type FirstDecisionCompleted",
+		},
+		"bad binary": {
+			params: ResetParams{ResetType: ResetTypeBadBinary, BadBinaryChecksum: "checksum"},
+		},
+		"bad binary without checksum": {
+			params: ResetParams{ResetType: ResetTypeBadBinary},
+			err:    "must provide bad binary checksum",
+		},
+		"unknown reset type": {
+			params: ResetParams{ResetType: "DecisionCompletedTime"},
+			err:    "not supported reset type: DecisionCompletedTime",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateResetParams(test.params)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestGetResetPoint(t *testing.T) {
+	history := &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr(), WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+				ContinuedExecutionRunID: "previous-rid",
+			}},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+			{ID: 3, EventType: types.EventTypeDecisionTaskStarted.Ptr()},
+			{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+			{ID: 5, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+			{ID: 6, EventType: types.EventTypeDecisionTaskStarted.Ptr()},
+			{ID: 7, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+		}},
+	}
+	describe := &types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+			AutoResetPoints: &types.ResetPoints{Points: []*types.ResetPointInfo{
+				{BinaryChecksum: "expired", FirstDecisionCompletedID: 4, Resettable: true, ExpiringTimeNano: common.Int64Ptr(time.Now().Add(-time.Hour).UnixNano())},
+				{BinaryChecksum: "bad", FirstDecisionCompletedID: 7, Resettable: true},
+			}},
+		},
+	}
+
+	tests := map[string]struct {
+		params           ResetParams
+		baseRunID        string
+		decisionFinishID int64
+		noResetPoint     bool
+	}{
+		"first decision completed": {
+			params:           ResetParams{ResetType: ResetTypeFirstDecisionCompleted},
+			baseRunID:        "rid",
+			decisionFinishID: 4,
+		},
+		"last decision completed": {
+			params:           ResetParams{ResetType: ResetTypeLastDecisionCompleted},
+			baseRunID:        "rid",
+			decisionFinishID: 7,
+		},
+		"last decision completed with offset": {
+			params:           ResetParams{ResetType: ResetTypeLastDecisionCompleted, DecisionOffset: -1},
+			baseRunID:        "rid",
+			decisionFinishID: 4,
+		},
+		"last decision completed with offset out of range": {
+			params:       ResetParams{ResetType: ResetTypeLastDecisionCompleted, DecisionOffset: -2},
+			noResetPoint: true,
+		},
+		"first decision scheduled": {
+			params:           ResetParams{ResetType: ResetTypeFirstDecisionScheduled},
+			baseRunID:        "rid",
+			decisionFinishID: 3,
+		},
+		"last decision scheduled": {
+			params:           ResetParams{ResetType: ResetTypeLastDecisionScheduled},
+			baseRunID:        "rid",
+			decisionFinishID: 6,
+		},
+		"last continued as new": {
+			params:           ResetParams{ResetType: ResetTypeLastContinuedAsNew},
+			baseRunID:        "previous-rid",
+			decisionFinishID: 7,
+		},
+		"bad binary": {
+			params:           ResetParams{ResetType: ResetTypeBadBinary, BadBinaryChecksum: "bad"},
+			baseRunID:        "rid",
+			decisionFinishID: 7,
+		},
+		"expired bad binary": {
+			params:       ResetParams{ResetType: ResetTypeBadBinary, BadBinaryChecksum: "expired"},
+			noResetPoint: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := frontend.NewMockClient(ctrl)
+			client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).AnyTimes()
+			client.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(describe, nil).AnyTimes()
+
+			baseRunID, decisionFinishID, err := getResetPoint(context.Background(), client, "domain", "wid", "rid", test.params)
+			if test.noResetPoint {
+				assert.True(t, errors.Is(err, errNoResetPoint))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.baseRunID, baseRunID)
+			assert.Equal(t, test.decisionFinishID, decisionFinishID)
+		})
+	}
+}
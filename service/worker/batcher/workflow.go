@@ -22,6 +22,7 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -68,6 +70,8 @@ const (
 	DefaultActivityHeartBeatTimeout = time.Second * 10
 	// DefaultMaxActivityRetries is the default value for MaxActivityRetries
 	DefaultMaxActivityRetries = 4
+	// MaxReportedFailures is the max number of failed workflows reported in the heartbeat details
+	MaxReportedFailures = 100
 )
 
 const (
@@ -79,10 +83,16 @@ const (
 	BatchTypeSignal = "signal"
 	// BatchTypeReplicate is batch type for replicating workflows
 	BatchTypeReplicate = "replicate"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting workflows, open workflows are terminated before being deleted
+	BatchTypeDelete = "delete"
+	// BatchTypeUpsertSearchAttributes is batch type for upserting the search attributes of workflows
+	BatchTypeUpsertSearchAttributes = "upsert_search_attributes"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypeReset, BatchTypeDelete, BatchTypeUpsertSearchAttributes}
 
 var (
 	BatchActivityRetryPolicy = cadence.RetryPolicy{
@@ -141,9 +151,14 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeReset:
+		return validateResetParams(params.ResetParams)
+	case BatchTypeUpsertSearchAttributes:
+		if len(params.UpsertSearchAttributesParams.SearchAttributes) == 0 {
+			return fmt.Errorf("must provide search attributes")
+		}
+		return nil
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -186,7 +201,9 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	batcher := ctx.Value(BatcherContextKey).(*Batcher)
 	client := batcher.clientBean.GetFrontendClient()
 	var adminClient admin.Client
-	if batchParams.BatchType == BatchTypeReplicate {
+	var historyClient history.Client
+	switch batchParams.BatchType {
+	case BatchTypeReplicate:
 		currentCluster := batcher.cfg.ClusterMetadata.GetCurrentClusterName()
 		if currentCluster != batchParams.ReplicateParams.SourceCluster {
 			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, fmt.Sprintf("the activity must run in the source cluster, current cluster is %s", currentCluster))
@@ -196,6 +213,15 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		if err != nil {
 			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, err.Error())
 		}
+	case BatchTypeDelete:
+		var err error
+		adminClient, err = batcher.clientBean.GetRemoteAdminClient(batcher.cfg.ClusterMetadata.GetCurrentClusterName())
+		if err != nil {
+			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, err.Error())
+		}
+	case BatchTypeUpsertSearchAttributes:
+		// search attributes of a workflow can only be changed from outside through the history service
+		historyClient = batcher.clientBean.GetHistoryClient()
 	}
 
 	domainResp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{
@@ -219,9 +245,9 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	}
 	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
 	taskCh := make(chan taskDetail, batchParams.PageSize)
-	respCh := make(chan error, batchParams.PageSize)
+	respCh := make(chan taskResponse, batchParams.PageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client, adminClient)
+		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client, adminClient, historyClient)
 	}
 
 	for {
@@ -257,11 +283,18 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	Loop:
 		for {
 			select {
-			case err := <-respCh:
-				if err == nil {
+			case resp := <-respCh:
+				if resp.err == nil {
 					succCount++
 				} else {
 					errCount++
+					if len(hbd.Failures) < MaxReportedFailures {
+						hbd.Failures = append(hbd.Failures, FailedWorkflow{
+							WorkflowID: resp.execution.GetWorkflowID(),
+							RunID:      resp.execution.GetRunID(),
+							Error:      resp.err.Error(),
+						})
+					}
 				}
 				if succCount+errCount == batchCount {
 					break Loop
@@ -304,10 +337,11 @@ func startTaskProcessor(
 	batchParams BatchParams,
 	domainID string,
 	taskCh chan taskDetail,
-	respCh chan error,
+	respCh chan taskResponse,
 	limiter *rate.Limiter,
 	client frontend.Client,
 	adminClient admin.Client,
+	historyClient history.Client,
 ) {
 	batcher := ctx.Value(BatcherContextKey).(*Batcher)
 	for {
@@ -375,14 +409,58 @@ func startTaskProcessor(
 							RemoteCluster: batchParams.ReplicateParams.SourceCluster,
 						})
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID, requestID)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						execution := &types.WorkflowExecution{
+							WorkflowID: workflowID,
+							RunID:      runID,
+						}
+						err := client.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+							Domain:            batchParams.DomainName,
+							WorkflowExecution: execution,
+							Reason:            batchParams.Reason,
+							Identity:          BatchWFTypeName,
+						})
+						// EntityNotExistsError means wf is already closed
+						if _, ok := err.(*types.EntityNotExistsError); err != nil && !ok {
+							return err
+						}
+						_, err = adminClient.DeleteWorkflow(ctx, &types.AdminDeleteWorkflowRequest{
+							Domain:    batchParams.DomainName,
+							Execution: execution,
+						})
+						return err
+					})
+			case BatchTypeUpsertSearchAttributes:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return historyClient.UpdateWorkflowSearchAttributes(ctx, &types.UpdateWorkflowSearchAttributesRequest{
+							DomainUUID: domainID,
+							WorkflowExecution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+							SearchAttributes: &types.SearchAttributes{
+								IndexedFields: batchParams.UpsertSearchAttributesParams.SearchAttributes,
+							},
+							Reason:   batchParams.Reason,
+							Identity: BatchWFTypeName,
+						})
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
-				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
-					respCh <- err
+				if ok || errors.Is(err, errNoResetPoint) || task.attempts >= batchParams.AttemptsOnRetryableError {
+					respCh <- taskResponse{execution: task.execution, err: err}
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
@@ -390,7 +468,7 @@ func startTaskProcessor(
 				}
 			} else {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorSuccess)
-				respCh <- nil
+				respCh <- taskResponse{execution: task.execution}
 			}
 		}
 	}
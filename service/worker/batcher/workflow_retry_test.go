@@ -119,6 +119,35 @@ func (s *workflowRetrySuite) TestActivityRetries() {
 	s.ErrorContains(s.workflowEnv.GetWorkflowError(), assert.AnError.Error())
 }
 
+func (s *workflowRetrySuite) TestActivityReportsFailedWorkflows() {
+	params := createParams(BatchTypeReset)
+
+	s.metricsMock.On("IncCounter", metrics.BatcherScope, metrics.BatcherProcessorFailures).Once()
+
+	s.mockResource.FrontendClient.EXPECT().ScanWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.ListWorkflowExecutionsResponse{
+			Executions: []*types.WorkflowExecutionInfo{{Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}}},
+		}, nil).Times(1)
+	// the workflow doesn't have any completed decision to reset to, so it fails without retrying
+	s.mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(&types.GetWorkflowExecutionHistoryResponse{
+			History: &types.History{Events: []*types.HistoryEvent{
+				{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+			}},
+		}, nil).Times(1)
+
+	result, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(result.Get(&hbd))
+	s.Equal(0, hbd.SuccessCount)
+	s.Equal(1, hbd.ErrorCount)
+	s.Len(hbd.Failures, 1)
+	s.Equal("wid", hbd.Failures[0].WorkflowID)
+	s.Equal("rid", hbd.Failures[0].RunID)
+	s.Contains(hbd.Failures[0].Error, errNoResetPoint.Error())
+}
+
 func (s *workflowRetrySuite) TearDownTest() {
 	s.workflowEnv.AssertExpectations(s.T())
 }
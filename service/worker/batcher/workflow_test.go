@@ -74,8 +74,24 @@ func (s *workflowSuite) SetupTest() {
 	mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{}, nil).AnyTimes()
 	mockResource.FrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockResource.FrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+		}},
+	}, nil).AnyTimes()
+	mockResource.FrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.ResetWorkflowExecutionResponse{}, nil).AnyTimes()
 
 	mockResource.RemoteAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockResource.RemoteAdminClient.EXPECT().DeleteWorkflow(gomock.Any(), gomock.Any()).Return(&types.AdminDeleteWorkflowResponse{}, nil).AnyTimes()
+
+	mockResource.HistoryClient.EXPECT().UpdateWorkflowSearchAttributes(gomock.Any(), &types.UpdateWorkflowSearchAttributesRequest{
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)},
+		},
+		Reason:   "unit-test",
+		Identity: BatchWFTypeName,
+	}).Return(nil).AnyTimes()
 
 	ctx := context.WithValue(context.Background(), BatcherContextKey, batcher)
 	workerOpts := worker.Options{
@@ -120,6 +136,24 @@ func (s *workflowSuite) TestActivity_BatchReplicate() {
 	s.NoError(err)
 }
 
+func (s *workflowSuite) TestActivity_BatchReset() {
+	params := createParams(BatchTypeReset)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_BatchDelete() {
+	params := createParams(BatchTypeDelete)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_BatchUpsertSearchAttributes() {
+	params := createParams(BatchTypeUpsertSearchAttributes)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
 func (s *workflowSuite) TestWorkflow_BatchTypeCancelValidationError() {
 	params := createParams(BatchTypeCancel)
 	params.Query = ""
@@ -159,6 +193,22 @@ func (s *workflowSuite) TestWorkflow_BatchTypeReplicateTargetCLusterValidation()
 	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide target cluster")
 }
 
+func (s *workflowSuite) TestWorkflow_BatchTypeResetValidation() {
+	params := createParams(BatchTypeReset)
+	params.ResetParams.ResetType = ResetTypeBadBinary
+	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide bad binary checksum")
+}
+
+func (s *workflowSuite) TestWorkflow_BatchTypeUpsertSearchAttributesValidation() {
+	params := createParams(BatchTypeUpsertSearchAttributes)
+	params.UpsertSearchAttributesParams.SearchAttributes = nil
+	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide search attributes")
+}
+
 func (s *workflowSuite) TearDownTest() {
 	s.workflowEnv.AssertExpectations(s.T())
 }
@@ -182,6 +232,12 @@ func createParams(batchType string) BatchParams {
 			SourceCluster: "test-primary-cluster",
 			TargetCluster: "test-secondary-cluster",
 		},
+		ResetParams: ResetParams{
+			ResetType: ResetTypeLastDecisionCompleted,
+		},
+		UpsertSearchAttributesParams: UpsertSearchAttributesParams{
+			SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)},
+		},
 		RPS:                      5,
 		Concurrency:              5,
 		PageSize:                 10,
@@ -0,0 +1,130 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package childworkflow
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+// ChildWorkflow is an invariant that will be used to identify the child workflows which failed in the workflow execution history,
+// including the failures which were not handled by the parent workflow. Timeouts of child workflows are identified by the timeout invariant.
+type ChildWorkflow invariant.Invariant
+
+type childWorkflow struct{}
+
+func NewInvariant() ChildWorkflow {
+	return &childWorkflow{}
+}
+
+func (c *childWorkflow) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	parentCompleted := isParentCompleted(events)
+	issueID := 0
+	for _, event := range events {
+		if attr := event.GetChildWorkflowExecutionFailedEventAttributes(); attr != nil {
+			result = append(result, invariant.InvariantCheckResult{
+				IssueID:       issueID,
+				InvariantType: ChildWorkflowFailed.String(),
+				Reason:        ChildFailed.String(),
+				Metadata: invariant.MarshalData(ChildWorkflowMetadata{
+					EventID:         event.ID,
+					Domain:          attr.Domain,
+					Execution:       attr.WorkflowExecution,
+					WorkflowType:    attr.WorkflowType.GetName(),
+					FailureReason:   common.StringDefault(attr.Reason),
+					ParentCompleted: parentCompleted,
+				}),
+			})
+			issueID++
+		}
+		if attr := event.GetChildWorkflowExecutionTerminatedEventAttributes(); attr != nil {
+			result = append(result, invariant.InvariantCheckResult{
+				IssueID:       issueID,
+				InvariantType: ChildWorkflowFailed.String(),
+				Reason:        ChildTerminated.String(),
+				Metadata: invariant.MarshalData(ChildWorkflowMetadata{
+					EventID:         event.ID,
+					Domain:          attr.Domain,
+					Execution:       attr.WorkflowExecution,
+					WorkflowType:    attr.WorkflowType.GetName(),
+					ParentCompleted: parentCompleted,
+				}),
+			})
+			issueID++
+		}
+		if attr := event.GetStartChildWorkflowExecutionFailedEventAttributes(); attr != nil {
+			result = append(result, invariant.InvariantCheckResult{
+				IssueID:       issueID,
+				InvariantType: ChildWorkflowStartFailed.String(),
+				Reason:        AlreadyRunning.String(),
+				Metadata: invariant.MarshalData(ChildWorkflowMetadata{
+					EventID:         event.ID,
+					Domain:          attr.Domain,
+					Execution:       &types.WorkflowExecution{WorkflowID: attr.WorkflowID},
+					WorkflowType:    attr.WorkflowType.GetName(),
+					ParentCompleted: parentCompleted,
+				}),
+			})
+			issueID++
+		}
+	}
+	return result, nil
+}
+
+func isParentCompleted(events []*types.HistoryEvent) bool {
+	if len(events) == 0 {
+		return false
+	}
+	last := events[len(events)-1]
+	return last.GetWorkflowExecutionCompletedEventAttributes() != nil || last.GetWorkflowExecutionContinuedAsNewEventAttributes() != nil
+}
+
+func (c *childWorkflow) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		if issue.InvariantType != ChildWorkflowFailed.String() && issue.InvariantType != ChildWorkflowStartFailed.String() {
+			continue
+		}
+		var metadata ChildWorkflowMetadata
+		if err := json.Unmarshal(issue.Metadata, &metadata); err != nil {
+			return nil, err
+		}
+		rootCause := invariant.RootCauseTypeChildWorkflowFailed
+		if metadata.ParentCompleted {
+			rootCause = invariant.RootCauseTypeChildWorkflowFailureIgnored
+		} else if issue.InvariantType == ChildWorkflowStartFailed.String() {
+			rootCause = invariant.RootCauseTypeChildWorkflowAlreadyRunning
+		}
+		result = append(result, invariant.InvariantRootCauseResult{
+			IssueID:   issue.IssueID,
+			RootCause: rootCause,
+			Metadata:  issue.Metadata,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,187 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package childworkflow
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	testDomain = "test-domain"
+)
+
+var childExecution = &types.WorkflowExecution{WorkflowID: "child-wid", RunID: "child-rid"}
+
+func Test__Check(t *testing.T) {
+	testCases := []struct {
+		name       string
+		closeEvent *types.HistoryEvent
+	}{
+		{
+			name: "parent completed",
+			closeEvent: &types.HistoryEvent{
+				ID: 20,
+				WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{},
+			},
+		},
+		{
+			name: "parent failed",
+			closeEvent: &types.HistoryEvent{
+				ID: 20,
+				WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{
+					Reason: common.StringPtr("child failed"),
+				},
+			},
+		},
+		{
+			name: "parent running",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events := childWorkflowEvents()
+			if tc.closeEvent != nil {
+				events = append(events, tc.closeEvent)
+			}
+			parentCompleted := tc.closeEvent.GetWorkflowExecutionCompletedEventAttributes() != nil
+			expectedResult := []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: ChildWorkflowFailed.String(),
+					Reason:        ChildFailed.String(),
+					Metadata: mustMarshal(t, ChildWorkflowMetadata{
+						EventID:         5,
+						Domain:          testDomain,
+						Execution:       childExecution,
+						WorkflowType:    "child-workflow",
+						FailureReason:   "cadenceInternal:Generic",
+						ParentCompleted: parentCompleted,
+					}),
+				},
+				{
+					IssueID:       1,
+					InvariantType: ChildWorkflowFailed.String(),
+					Reason:        ChildTerminated.String(),
+					Metadata: mustMarshal(t, ChildWorkflowMetadata{
+						EventID:         6,
+						Domain:          testDomain,
+						Execution:       childExecution,
+						WorkflowType:    "child-workflow",
+						ParentCompleted: parentCompleted,
+					}),
+				},
+				{
+					IssueID:       2,
+					InvariantType: ChildWorkflowStartFailed.String(),
+					Reason:        AlreadyRunning.String(),
+					Metadata: mustMarshal(t, ChildWorkflowMetadata{
+						EventID:         7,
+						Domain:          testDomain,
+						Execution:       &types.WorkflowExecution{WorkflowID: "child-wid"},
+						WorkflowType:    "child-workflow",
+						ParentCompleted: parentCompleted,
+					}),
+				},
+			}
+
+			inv := NewInvariant()
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: &types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{Events: events},
+				},
+				Domain: testDomain,
+			})
+			require.NoError(t, err)
+			require.ElementsMatch(t, expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	completedMetadata := mustMarshal(t, ChildWorkflowMetadata{Execution: childExecution, ParentCompleted: true})
+	runningMetadata := mustMarshal(t, ChildWorkflowMetadata{Execution: childExecution})
+	inv := NewInvariant()
+	result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+		Domain: testDomain,
+		Issues: []invariant.InvariantCheckResult{
+			{IssueID: 0, InvariantType: ChildWorkflowFailed.String(), Reason: ChildFailed.String(), Metadata: completedMetadata},
+			{IssueID: 1, InvariantType: ChildWorkflowFailed.String(), Reason: ChildTerminated.String(), Metadata: runningMetadata},
+			{IssueID: 2, InvariantType: ChildWorkflowStartFailed.String(), Reason: AlreadyRunning.String(), Metadata: runningMetadata},
+			{IssueID: 0, InvariantType: "other invariant", Reason: "other reason"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []invariant.InvariantRootCauseResult{
+		{IssueID: 0, RootCause: invariant.RootCauseTypeChildWorkflowFailureIgnored, Metadata: completedMetadata},
+		{IssueID: 1, RootCause: invariant.RootCauseTypeChildWorkflowFailed, Metadata: runningMetadata},
+		{IssueID: 2, RootCause: invariant.RootCauseTypeChildWorkflowAlreadyRunning, Metadata: runningMetadata},
+	}, result)
+}
+
+func childWorkflowEvents() []*types.HistoryEvent {
+	return []*types.HistoryEvent{
+		{
+			ID:                                      1,
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+		},
+		{
+			ID: 5,
+			ChildWorkflowExecutionFailedEventAttributes: &types.ChildWorkflowExecutionFailedEventAttributes{
+				Reason:            common.StringPtr("cadenceInternal:Generic"),
+				Domain:            testDomain,
+				WorkflowExecution: childExecution,
+				WorkflowType:      &types.WorkflowType{Name: "child-workflow"},
+			},
+		},
+		{
+			ID: 6,
+			ChildWorkflowExecutionTerminatedEventAttributes: &types.ChildWorkflowExecutionTerminatedEventAttributes{
+				Domain:            testDomain,
+				WorkflowExecution: childExecution,
+				WorkflowType:      &types.WorkflowType{Name: "child-workflow"},
+			},
+		},
+		{
+			ID: 7,
+			StartChildWorkflowExecutionFailedEventAttributes: &types.StartChildWorkflowExecutionFailedEventAttributes{
+				Domain:       testDomain,
+				WorkflowID:   "child-wid",
+				WorkflowType: &types.WorkflowType{Name: "child-workflow"},
+				Cause:        types.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning.Ptr(),
+			},
+		},
+	}
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
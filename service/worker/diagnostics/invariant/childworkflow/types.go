@@ -0,0 +1,58 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package childworkflow
+
+import "github.com/uber/cadence/common/types"
+
+type ChildWorkflowType string
+
+const (
+	ChildWorkflowFailed      ChildWorkflowType = "Child Workflow Execution has failed"
+	ChildWorkflowStartFailed ChildWorkflowType = "Child Workflow Execution failed to start"
+)
+
+func (c ChildWorkflowType) String() string {
+	return string(c)
+}
+
+type IssueType string
+
+const (
+	ChildFailed     IssueType = "The child workflow execution failed"
+	ChildTerminated IssueType = "The child workflow execution was terminated"
+	AlreadyRunning  IssueType = "A workflow with the workflow ID of the child workflow is already running"
+)
+
+func (i IssueType) String() string {
+	return string(i)
+}
+
+type ChildWorkflowMetadata struct {
+	EventID      int64
+	Domain       string
+	Execution    *types.WorkflowExecution
+	WorkflowType string
+	// FailureReason is the reason of the failure returned by the child workflow
+	FailureReason string
+	// ParentCompleted is set if the parent workflow completed successfully after the child workflow failure
+	ParentCompleted bool
+}
@@ -0,0 +1,130 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package historysize
+
+import (
+	"context"
+
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	_eventCountThreshold               = 10 * 1024  // number of events of a run above which the history is considered too large
+	_signalCountThreshold              = 1000       // number of signals of a run above which the signals are considered unbounded
+	_continueAsNewInputSizeThreshold   = 256 * 1024 // size in bytes of the input carried over to the next run above which the growth is reported
+	_continueAsNewInputGrowthThreshold = 2          // factor by which the input of the next run must exceed the input of the current run
+)
+
+// HistorySize is an invariant that will be used to identify the workflows with a history growing without bound,
+// either in a single run or across the runs started by continue-as-new
+type HistorySize invariant.Invariant
+
+type historySize struct{}
+
+func NewInvariant() HistorySize {
+	return &historySize{}
+}
+
+func (h *historySize) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	issueID := 0
+
+	signalCount := 0
+	inputSize, continuedAsNewInputSize := 0, 0
+	for _, event := range events {
+		if event.GetWorkflowExecutionSignaledEventAttributes() != nil {
+			signalCount++
+		}
+		if attr := event.GetWorkflowExecutionStartedEventAttributes(); attr != nil {
+			inputSize = len(attr.Input)
+		}
+		if attr := event.GetWorkflowExecutionContinuedAsNewEventAttributes(); attr != nil {
+			continuedAsNewInputSize = len(attr.Input)
+		}
+	}
+
+	if len(events) > _eventCountThreshold {
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: UnboundedHistory.String(),
+			Reason:        TooManyEvents.String(),
+			Metadata: invariant.MarshalData(HistorySizeMetadata{
+				EventCount:  len(events),
+				SignalCount: signalCount,
+				Threshold:   _eventCountThreshold,
+			}),
+		})
+		issueID++
+	}
+	if signalCount > _signalCountThreshold {
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: UnboundedHistory.String(),
+			Reason:        TooManySignals.String(),
+			Metadata: invariant.MarshalData(HistorySizeMetadata{
+				EventCount:  len(events),
+				SignalCount: signalCount,
+				Threshold:   _signalCountThreshold,
+			}),
+		})
+		issueID++
+	}
+	if continuedAsNewInputSize > _continueAsNewInputSizeThreshold && continuedAsNewInputSize > inputSize*_continueAsNewInputGrowthThreshold {
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: UnboundedHistory.String(),
+			Reason:        ContinueAsNewInputGrowth.String(),
+			Metadata: invariant.MarshalData(HistorySizeMetadata{
+				EventCount:              len(events),
+				SignalCount:             signalCount,
+				Threshold:               _continueAsNewInputSizeThreshold,
+				InputSize:               inputSize,
+				ContinuedAsNewInputSize: continuedAsNewInputSize,
+			}),
+		})
+	}
+	return result, nil
+}
+
+func (h *historySize) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		var rootCause invariant.RootCause
+		switch issue.Reason {
+		case TooManyEvents.String():
+			rootCause = invariant.RootCauseTypeHistoryEventCount
+		case TooManySignals.String():
+			rootCause = invariant.RootCauseTypeUnboundedSignals
+		case ContinueAsNewInputGrowth.String():
+			rootCause = invariant.RootCauseTypeContinueAsNewStateGrowth
+		default:
+			continue
+		}
+		result = append(result, invariant.InvariantRootCauseResult{
+			IssueID:   issue.IssueID,
+			RootCause: rootCause,
+			Metadata:  issue.Metadata,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,177 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package historysize
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	testDomain = "test-domain"
+)
+
+func Test__Check(t *testing.T) {
+	testCases := []struct {
+		name           string
+		testData       *types.GetWorkflowExecutionHistoryResponse
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name:           "bounded history",
+			testData:       history(100, 10, 1024, 1024),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name:     "too many events",
+			testData: history(_eventCountThreshold, 0, 0, 0),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: UnboundedHistory.String(),
+					Reason:        TooManyEvents.String(),
+					Metadata: mustMarshal(t, HistorySizeMetadata{
+						EventCount: _eventCountThreshold + 1,
+						Threshold:  _eventCountThreshold,
+					}),
+				},
+			},
+		},
+		{
+			name:     "too many signals",
+			testData: history(0, _signalCountThreshold+1, 0, 0),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: UnboundedHistory.String(),
+					Reason:        TooManySignals.String(),
+					Metadata: mustMarshal(t, HistorySizeMetadata{
+						EventCount:  _signalCountThreshold + 2,
+						SignalCount: _signalCountThreshold + 1,
+						Threshold:   _signalCountThreshold,
+					}),
+				},
+			},
+		},
+		{
+			name:     "continue as new input growth",
+			testData: history(0, 0, 200*1024, 500*1024),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: UnboundedHistory.String(),
+					Reason:        ContinueAsNewInputGrowth.String(),
+					Metadata: mustMarshal(t, HistorySizeMetadata{
+						EventCount:              2,
+						Threshold:               _continueAsNewInputSizeThreshold,
+						InputSize:               200 * 1024,
+						ContinuedAsNewInputSize: 500 * 1024,
+					}),
+				},
+			},
+		},
+		{
+			name:           "large continue as new input without growth",
+			testData:       history(0, 0, 400*1024, 500*1024),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := NewInvariant()
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: tc.testData,
+				Domain:                   testDomain,
+			})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	metadataInBytes := mustMarshal(t, HistorySizeMetadata{EventCount: 100})
+	inv := NewInvariant()
+	result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+		Domain: testDomain,
+		Issues: []invariant.InvariantCheckResult{
+			{IssueID: 0, InvariantType: UnboundedHistory.String(), Reason: TooManyEvents.String(), Metadata: metadataInBytes},
+			{IssueID: 1, InvariantType: UnboundedHistory.String(), Reason: TooManySignals.String(), Metadata: metadataInBytes},
+			{IssueID: 2, InvariantType: UnboundedHistory.String(), Reason: ContinueAsNewInputGrowth.String(), Metadata: metadataInBytes},
+			{IssueID: 0, InvariantType: "other invariant", Reason: "other reason"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []invariant.InvariantRootCauseResult{
+		{IssueID: 0, RootCause: invariant.RootCauseTypeHistoryEventCount, Metadata: metadataInBytes},
+		{IssueID: 1, RootCause: invariant.RootCauseTypeUnboundedSignals, Metadata: metadataInBytes},
+		{IssueID: 2, RootCause: invariant.RootCauseTypeContinueAsNewStateGrowth, Metadata: metadataInBytes},
+	}, result)
+}
+
+// history returns a history with the started event, the given number of activity and signal events,
+// and a continued as new event if the continue as new input size is set
+func history(activityCount, signalCount, inputSize, continuedAsNewInputSize int) *types.GetWorkflowExecutionHistoryResponse {
+	events := []*types.HistoryEvent{
+		{
+			ID: 1,
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+				Input: make([]byte, inputSize),
+			},
+		},
+	}
+	for i := 0; i < activityCount; i++ {
+		events = append(events, &types.HistoryEvent{
+			ID:                                   int64(len(events) + 1),
+			ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{},
+		})
+	}
+	for i := 0; i < signalCount; i++ {
+		events = append(events, &types.HistoryEvent{
+			ID:                                       int64(len(events) + 1),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{},
+		})
+	}
+	if continuedAsNewInputSize > 0 {
+		events = append(events, &types.HistoryEvent{
+			ID: int64(len(events) + 1),
+			WorkflowExecutionContinuedAsNewEventAttributes: &types.WorkflowExecutionContinuedAsNewEventAttributes{
+				Input: make([]byte, continuedAsNewInputSize),
+			},
+		})
+	}
+	return &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: events},
+	}
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,54 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package historysize
+
+type HistorySizeType string
+
+const (
+	UnboundedHistory HistorySizeType = "Workflow history is growing without bound"
+)
+
+func (h HistorySizeType) String() string {
+	return string(h)
+}
+
+type IssueType string
+
+const (
+	TooManyEvents            IssueType = "Workflow history has more events than recommended for a workflow run"
+	TooManySignals           IssueType = "Workflow has received more signals than recommended for a workflow run"
+	ContinueAsNewInputGrowth IssueType = "Input carried over to the next run is large and larger than the input of the current run"
+)
+
+func (i IssueType) String() string {
+	return string(i)
+}
+
+type HistorySizeMetadata struct {
+	EventCount  int
+	SignalCount int
+	// Threshold is the recommended limit which was exceeded
+	Threshold int
+	// InputSize and ContinuedAsNewInputSize are the sizes in bytes of the input of the current run and of the next run
+	InputSize               int
+	ContinuedAsNewInputSize int
+}
@@ -66,6 +66,17 @@ const (
 	RootCauseTypeServiceSidePanic                      RootCause = "There is a panic in the activity/workflow that is causing a failure"
 	RootCauseTypeServiceSideCustomError                RootCause = "Customised error returned by the activity/workflow"
 	RootCauseTypeBlobSizeLimit                         RootCause = "Workflow has exceeded the blob size limits configured for the domain"
+	RootCauseTypeNonDeterministicWorkflowCode          RootCause = "Workflow code has changed in a way which is not compatible with the existing workflow history. Use workflow versioning for such changes"
+	RootCauseTypeDecisionTaskNonDeterminism            RootCause = "Decision task keeps failing because the workflow code is not deterministic"
+	RootCauseTypeDecisionTaskWorkflowCodeFailure       RootCause = "Decision task keeps failing because of an error or a panic in the workflow code. Check identity for worker logs"
+	RootCauseTypeBadDecisionAttributes                 RootCause = "Decision task keeps failing because the workflow code makes a decision which is rejected by the server"
+	RootCauseTypeBadBinary                             RootCause = "Decision task keeps failing because the worker binary is marked as bad for the domain"
+	RootCauseTypeHistoryEventCount                     RootCause = "Workflow history keeps growing. Use continue-as-new to keep the history of a run bounded"
+	RootCauseTypeUnboundedSignals                      RootCause = "Workflow receives signals without bound. Use continue-as-new after handling a bounded number of signals"
+	RootCauseTypeContinueAsNewStateGrowth              RootCause = "Workflow carries over a growing state to the next run when it continues as new"
+	RootCauseTypeChildWorkflowFailureIgnored           RootCause = "Child workflow failed but the parent workflow completed without handling the failure"
+	RootCauseTypeChildWorkflowFailed                   RootCause = "Child workflow failed. Diagnose the child workflow execution for its root cause"
+	RootCauseTypeChildWorkflowAlreadyRunning           RootCause = "Child workflow could not be started since a workflow with the same workflow ID is already running"
 )
 
 func (r RootCause) String() string {
@@ -0,0 +1,123 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package nondeterminism
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const _maxDetailsLength = 1024 // maximum length of the failure details kept in the metadata
+
+// NonDeterminism is an invariant that will be used to identify the failures caused by non-deterministic workflow code in the workflow execution history
+type NonDeterminism invariant.Invariant
+
+type nonDeterminism struct{}
+
+func NewInvariant() NonDeterminism {
+	return &nonDeterminism{}
+}
+
+func (n *nonDeterminism) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	issueID := 0
+	for _, event := range events {
+		if attr := event.GetDecisionTaskFailedEventAttributes(); attr != nil &&
+			attr.GetCause() == types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure &&
+			IsNonDeterministicError(common.StringDefault(attr.Reason), attr.Details) {
+			result = append(result, invariant.InvariantCheckResult{
+				IssueID:       issueID,
+				InvariantType: NonDeterministicWorkflow.String(),
+				Reason:        DecisionTaskFailedNonDeterministic.String(),
+				Metadata: invariant.MarshalData(NonDeterminismMetadata{
+					EventID:        event.ID,
+					Identity:       attr.Identity,
+					BinaryChecksum: attr.BinaryChecksum,
+					Details:        truncate(string(attr.Details)),
+				}),
+			})
+			issueID++
+		}
+		if attr := event.GetWorkflowExecutionFailedEventAttributes(); attr != nil &&
+			IsNonDeterministicError(attr.GetReason(), attr.Details) {
+			result = append(result, invariant.InvariantCheckResult{
+				IssueID:       issueID,
+				InvariantType: NonDeterministicWorkflow.String(),
+				Reason:        WorkflowFailedNonDeterministic.String(),
+				Metadata: invariant.MarshalData(NonDeterminismMetadata{
+					EventID:  event.ID,
+					Identity: fetchIdentity(attr, events),
+					Details:  truncate(string(attr.Details)),
+				}),
+			})
+			issueID++
+		}
+	}
+	return result, nil
+}
+
+// IsNonDeterministicError checks the failure reported by the client, the clients report the mismatch
+// between the workflow code and the history as a "nondeterministic workflow" or a "NonDeterministicWorkflowError"
+func IsNonDeterministicError(reason string, details []byte) bool {
+	for _, s := range []string{reason, string(details)} {
+		s = strings.ToLower(s)
+		if strings.Contains(s, "nondeterministic") || strings.Contains(s, "non-deterministic") {
+			return true
+		}
+	}
+	return false
+}
+
+func fetchIdentity(attr *types.WorkflowExecutionFailedEventAttributes, events []*types.HistoryEvent) string {
+	for _, event := range events {
+		if event.ID == attr.DecisionTaskCompletedEventID {
+			return event.GetDecisionTaskCompletedEventAttributes().Identity
+		}
+	}
+	return ""
+}
+
+func truncate(details string) string {
+	if len(details) > _maxDetailsLength {
+		return details[:_maxDetailsLength]
+	}
+	return details
+}
+
+func (n *nonDeterminism) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		if issue.InvariantType == NonDeterministicWorkflow.String() {
+			result = append(result, invariant.InvariantRootCauseResult{
+				IssueID:   issue.IssueID,
+				RootCause: invariant.RootCauseTypeNonDeterministicWorkflowCode,
+				Metadata:  issue.Metadata,
+			})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,196 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package nondeterminism
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	testDomain = "test-domain"
+)
+
+func Test__Check(t *testing.T) {
+	decisionMetadataInBytes, err := json.Marshal(NonDeterminismMetadata{
+		EventID:        4,
+		Identity:       "localhost",
+		BinaryChecksum: "checksum",
+		Details:        "nondeterministic workflow: history event is ActivityTaskScheduled, replay decision is StartTimer",
+	})
+	require.NoError(t, err)
+	wfMetadataInBytes, err := json.Marshal(NonDeterminismMetadata{
+		EventID:  12,
+		Identity: "localhost",
+		Details:  "io.temporal.worker.NonDeterministicWorkflowError: Failure handling event 5",
+	})
+	require.NoError(t, err)
+	testCases := []struct {
+		name           string
+		testData       *types.GetWorkflowExecutionHistoryResponse
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name:     "non-deterministic decision task failure and workflow failure",
+			testData: nonDeterministicWfHistory(),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: NonDeterministicWorkflow.String(),
+					Reason:        DecisionTaskFailedNonDeterministic.String(),
+					Metadata:      decisionMetadataInBytes,
+				},
+				{
+					IssueID:       1,
+					InvariantType: NonDeterministicWorkflow.String(),
+					Reason:        WorkflowFailedNonDeterministic.String(),
+					Metadata:      wfMetadataInBytes,
+				},
+			},
+		},
+		{
+			name:           "other failures",
+			testData:       otherFailuresWfHistory(),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := NewInvariant()
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: tc.testData,
+				Domain:                   testDomain,
+			})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	metadataInBytes, err := json.Marshal(NonDeterminismMetadata{EventID: 4, Identity: "localhost"})
+	require.NoError(t, err)
+	inv := NewInvariant()
+	result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+		Domain: testDomain,
+		Issues: []invariant.InvariantCheckResult{
+			{
+				IssueID:       0,
+				InvariantType: NonDeterministicWorkflow.String(),
+				Reason:        DecisionTaskFailedNonDeterministic.String(),
+				Metadata:      metadataInBytes,
+			},
+			{
+				IssueID:       0,
+				InvariantType: "other invariant",
+				Reason:        "other reason",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []invariant.InvariantRootCauseResult{
+		{
+			IssueID:   0,
+			RootCause: invariant.RootCauseTypeNonDeterministicWorkflowCode,
+			Metadata:  metadataInBytes,
+		},
+	}, result)
+}
+
+func Test__IsNonDeterministicError(t *testing.T) {
+	require.True(t, IsNonDeterministicError("", []byte("nondeterministic workflow: mismatch")))
+	require.True(t, IsNonDeterministicError("NonDeterministicWorkflowPolicyFailWorkflow", nil))
+	require.True(t, IsNonDeterministicError("", []byte("Non-Deterministic workflow detected")))
+	require.False(t, IsNonDeterministicError("cadenceInternal:Panic", []byte("index out of range")))
+}
+
+func nonDeterministicWfHistory() *types.GetWorkflowExecutionHistoryResponse {
+	return &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{
+			Events: []*types.HistoryEvent{
+				{
+					ID:                                      1,
+					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+				},
+				{
+					ID: 4,
+					DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+						Cause:          types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+						Details:        []byte("nondeterministic workflow: history event is ActivityTaskScheduled, replay decision is StartTimer"),
+						Identity:       "localhost",
+						BinaryChecksum: "checksum",
+					},
+				},
+				{
+					ID: 11,
+					DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{
+						Identity: "localhost",
+					},
+				},
+				{
+					ID: 12,
+					WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{
+						Reason:                       common.StringPtr("NonDeterministicWorkflowPolicyFailWorkflow"),
+						Details:                      []byte("io.temporal.worker.NonDeterministicWorkflowError: Failure handling event 5"),
+						DecisionTaskCompletedEventID: 11,
+					},
+				},
+			},
+		},
+	}
+}
+
+func otherFailuresWfHistory() *types.GetWorkflowExecutionHistoryResponse {
+	return &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{
+			Events: []*types.HistoryEvent{
+				{
+					ID: 4,
+					DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+						Cause:   types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+						Details: []byte("panic: index out of range"),
+					},
+				},
+				{
+					ID: 5,
+					DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+						Cause:   types.DecisionTaskFailedCauseBadScheduleActivityAttributes.Ptr(),
+						Details: []byte("nondeterministic looking details of a bad decision"),
+					},
+				},
+				{
+					ID: 12,
+					WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{
+						Reason: common.StringPtr("cadenceInternal:Generic"),
+					},
+				},
+			},
+		},
+	}
+}
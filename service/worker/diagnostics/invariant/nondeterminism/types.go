@@ -0,0 +1,50 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package nondeterminism
+
+type NonDeterminismType string
+
+const (
+	NonDeterministicWorkflow NonDeterminismType = "Non-deterministic workflow code"
+)
+
+func (n NonDeterminismType) String() string {
+	return string(n)
+}
+
+type IssueType string
+
+const (
+	DecisionTaskFailedNonDeterministic IssueType = "Decision task failed since the workflow code did not match the workflow history during replay"
+	WorkflowFailedNonDeterministic     IssueType = "Workflow failed since the workflow code did not match the workflow history during replay"
+)
+
+func (i IssueType) String() string {
+	return string(i)
+}
+
+type NonDeterminismMetadata struct {
+	EventID        int64
+	Identity       string
+	BinaryChecksum string
+	Details        string
+}
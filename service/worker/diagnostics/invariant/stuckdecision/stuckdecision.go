@@ -0,0 +1,186 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package stuckdecision
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/nondeterminism"
+)
+
+const (
+	_attemptsThreshold = 5    // number of attempts of a decision task which is considered as a failure loop
+	_maxDetailsLength  = 1024 // maximum length of the failure details kept in the metadata
+)
+
+// StuckDecision is an invariant that will be used to identify the decision tasks failing in a loop in the workflow execution history.
+// Only the first failure of a loop is recorded in the history, the following attempts are recorded with the decision task once it completes.
+type StuckDecision invariant.Invariant
+
+type stuckDecision struct{}
+
+func NewInvariant() StuckDecision {
+	return &stuckDecision{}
+}
+
+func (s *stuckDecision) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	issueID := 0
+	var lastFailure, lastDecisionEvent *types.HistoryEvent
+	for _, event := range events {
+		if !isDecisionTaskEvent(event) {
+			continue
+		}
+		lastDecisionEvent = event
+		if event.GetDecisionTaskFailedEventAttributes() != nil {
+			lastFailure = event
+		}
+		if attr := event.GetDecisionTaskScheduledEventAttributes(); attr != nil && attr.Attempt >= _attemptsThreshold && lastFailure != nil {
+			result = append(result, invariant.InvariantCheckResult{
+				IssueID:       issueID,
+				InvariantType: DecisionTaskFailureLoop.String(),
+				Reason:        DecisionTaskRetried.String(),
+				Metadata:      invariant.MarshalData(failureMetadata(lastFailure, attr.Attempt)),
+			})
+			issueID++
+		}
+	}
+
+	if lastDecisionEvent != nil && lastDecisionEvent == lastFailure && !isWorkflowClosed(events) && !isExpectedFailure(lastFailure) {
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: DecisionTaskFailureLoop.String(),
+			Reason:        DecisionTaskFailing.String(),
+			Metadata:      invariant.MarshalData(failureMetadata(lastFailure, fetchAttempt(lastFailure, events))),
+		})
+	}
+	return result, nil
+}
+
+func isDecisionTaskEvent(event *types.HistoryEvent) bool {
+	return event.GetDecisionTaskScheduledEventAttributes() != nil ||
+		event.GetDecisionTaskStartedEventAttributes() != nil ||
+		event.GetDecisionTaskCompletedEventAttributes() != nil ||
+		event.GetDecisionTaskFailedEventAttributes() != nil ||
+		event.GetDecisionTaskTimedOutEventAttributes() != nil
+}
+
+func isWorkflowClosed(events []*types.HistoryEvent) bool {
+	if len(events) == 0 {
+		return false
+	}
+	last := events[len(events)-1]
+	return last.GetWorkflowExecutionCompletedEventAttributes() != nil ||
+		last.GetWorkflowExecutionFailedEventAttributes() != nil ||
+		last.GetWorkflowExecutionTimedOutEventAttributes() != nil ||
+		last.GetWorkflowExecutionCanceledEventAttributes() != nil ||
+		last.GetWorkflowExecutionTerminatedEventAttributes() != nil ||
+		last.GetWorkflowExecutionContinuedAsNewEventAttributes() != nil
+}
+
+// isExpectedFailure checks if the decision task was failed by the server as part of the normal processing,
+// such failures are retried right away and don't indicate an issue with the workflow
+func isExpectedFailure(event *types.HistoryEvent) bool {
+	switch event.GetDecisionTaskFailedEventAttributes().GetCause() {
+	case types.DecisionTaskFailedCauseUnhandledDecision,
+		types.DecisionTaskFailedCauseResetStickyTasklist,
+		types.DecisionTaskFailedCauseForceCloseDecision,
+		types.DecisionTaskFailedCauseFailoverCloseDecision,
+		types.DecisionTaskFailedCauseResetWorkflow:
+		return true
+	}
+	return false
+}
+
+func fetchAttempt(failure *types.HistoryEvent, events []*types.HistoryEvent) int64 {
+	scheduledEventID := failure.GetDecisionTaskFailedEventAttributes().ScheduledEventID
+	for _, event := range events {
+		if event.ID == scheduledEventID {
+			return event.GetDecisionTaskScheduledEventAttributes().GetAttempt()
+		}
+	}
+	return 0
+}
+
+func failureMetadata(failure *types.HistoryEvent, attempt int64) StuckDecisionMetadata {
+	attr := failure.GetDecisionTaskFailedEventAttributes()
+	details := string(attr.Details)
+	if len(details) > _maxDetailsLength {
+		details = details[:_maxDetailsLength]
+	}
+	return StuckDecisionMetadata{
+		FailedEventID:  failure.ID,
+		Attempt:        attempt,
+		Cause:          attr.GetCause().String(),
+		Identity:       attr.Identity,
+		BinaryChecksum: attr.BinaryChecksum,
+		Details:        details,
+	}
+}
+
+func (s *stuckDecision) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		if issue.InvariantType != DecisionTaskFailureLoop.String() {
+			continue
+		}
+		var metadata StuckDecisionMetadata
+		if err := json.Unmarshal(issue.Metadata, &metadata); err != nil {
+			return nil, err
+		}
+		rootCause := rootCauseFromFailure(metadata)
+		if rootCause == "" {
+			continue
+		}
+		result = append(result, invariant.InvariantRootCauseResult{
+			IssueID:   issue.IssueID,
+			RootCause: rootCause,
+			Metadata:  issue.Metadata,
+		})
+	}
+	return result, nil
+}
+
+func rootCauseFromFailure(metadata StuckDecisionMetadata) invariant.RootCause {
+	switch metadata.Cause {
+	case types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.String():
+		if nondeterminism.IsNonDeterministicError("", []byte(metadata.Details)) {
+			return invariant.RootCauseTypeDecisionTaskNonDeterminism
+		}
+		return invariant.RootCauseTypeDecisionTaskWorkflowCodeFailure
+	case types.DecisionTaskFailedCauseBadBinary.String():
+		return invariant.RootCauseTypeBadBinary
+	case types.DecisionTaskFailedCauseUnhandledDecision.String(),
+		types.DecisionTaskFailedCauseResetStickyTasklist.String(),
+		types.DecisionTaskFailedCauseForceCloseDecision.String(),
+		types.DecisionTaskFailedCauseFailoverCloseDecision.String(),
+		types.DecisionTaskFailedCauseResetWorkflow.String():
+		return ""
+	default:
+		// the other causes are the decisions rejected by the server, e.g. the bad attributes or duplicated IDs
+		return invariant.RootCauseTypeBadDecisionAttributes
+	}
+}
@@ -0,0 +1,245 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package stuckdecision
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	testDomain = "test-domain"
+)
+
+func Test__Check(t *testing.T) {
+	retriedMetadataInBytes, err := json.Marshal(StuckDecisionMetadata{
+		FailedEventID: 4,
+		Attempt:       7,
+		Cause:         types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.String(),
+		Identity:      "localhost",
+		Details:       "panic: index out of range",
+	})
+	require.NoError(t, err)
+	failingMetadataInBytes, err := json.Marshal(StuckDecisionMetadata{
+		FailedEventID: 10,
+		Attempt:       0,
+		Cause:         types.DecisionTaskFailedCauseBadScheduleActivityAttributes.String(),
+		Identity:      "localhost",
+	})
+	require.NoError(t, err)
+	testCases := []struct {
+		name           string
+		testData       *types.GetWorkflowExecutionHistoryResponse
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name: "decision task retried and failing",
+			testData: history(
+				decisionTaskScheduled(2, 0),
+				decisionTaskFailed(4, 2, types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure, "panic: index out of range"),
+				decisionTaskScheduled(5, 7),
+				decisionTaskCompleted(7),
+				decisionTaskScheduled(8, 0),
+				decisionTaskFailed(10, 8, types.DecisionTaskFailedCauseBadScheduleActivityAttributes, ""),
+			),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: DecisionTaskFailureLoop.String(),
+					Reason:        DecisionTaskRetried.String(),
+					Metadata:      retriedMetadataInBytes,
+				},
+				{
+					IssueID:       1,
+					InvariantType: DecisionTaskFailureLoop.String(),
+					Reason:        DecisionTaskFailing.String(),
+					Metadata:      failingMetadataInBytes,
+				},
+			},
+		},
+		{
+			name: "decision task retried a few times",
+			testData: history(
+				decisionTaskScheduled(2, 0),
+				decisionTaskFailed(4, 2, types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure, ""),
+				decisionTaskScheduled(5, 2),
+				decisionTaskCompleted(7),
+			),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "decision task failed by the server",
+			testData: history(
+				decisionTaskScheduled(2, 0),
+				decisionTaskFailed(4, 2, types.DecisionTaskFailedCauseResetStickyTasklist, ""),
+			),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "workflow closed after decision task failure",
+			testData: history(
+				decisionTaskScheduled(2, 0),
+				decisionTaskFailed(4, 2, types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure, ""),
+				&types.HistoryEvent{
+					ID: 5,
+					WorkflowExecutionTerminatedEventAttributes: &types.WorkflowExecutionTerminatedEventAttributes{},
+				},
+			),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := NewInvariant()
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: tc.testData,
+				Domain:                   testDomain,
+			})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	testCases := []struct {
+		name              string
+		metadata          StuckDecisionMetadata
+		expectedRootCause invariant.RootCause
+	}{
+		{
+			name: "non-deterministic workflow code",
+			metadata: StuckDecisionMetadata{
+				Cause:   types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.String(),
+				Details: "nondeterministic workflow: history event is ActivityTaskScheduled",
+			},
+			expectedRootCause: invariant.RootCauseTypeDecisionTaskNonDeterminism,
+		},
+		{
+			name: "workflow code failure",
+			metadata: StuckDecisionMetadata{
+				Cause:   types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.String(),
+				Details: "panic: index out of range",
+			},
+			expectedRootCause: invariant.RootCauseTypeDecisionTaskWorkflowCodeFailure,
+		},
+		{
+			name: "bad binary",
+			metadata: StuckDecisionMetadata{
+				Cause: types.DecisionTaskFailedCauseBadBinary.String(),
+			},
+			expectedRootCause: invariant.RootCauseTypeBadBinary,
+		},
+		{
+			name: "bad decision",
+			metadata: StuckDecisionMetadata{
+				Cause: types.DecisionTaskFailedCauseScheduleActivityDuplicateID.String(),
+			},
+			expectedRootCause: invariant.RootCauseTypeBadDecisionAttributes,
+		},
+		{
+			name: "failed by the server",
+			metadata: StuckDecisionMetadata{
+				Cause: types.DecisionTaskFailedCauseFailoverCloseDecision.String(),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadataInBytes, err := json.Marshal(tc.metadata)
+			require.NoError(t, err)
+			inv := NewInvariant()
+			result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+				Domain: testDomain,
+				Issues: []invariant.InvariantCheckResult{
+					{
+						IssueID:       1,
+						InvariantType: DecisionTaskFailureLoop.String(),
+						Reason:        DecisionTaskFailing.String(),
+						Metadata:      metadataInBytes,
+					},
+				},
+			})
+			require.NoError(t, err)
+			if tc.expectedRootCause == "" {
+				require.Empty(t, result)
+				return
+			}
+			require.Equal(t, []invariant.InvariantRootCauseResult{
+				{
+					IssueID:   1,
+					RootCause: tc.expectedRootCause,
+					Metadata:  metadataInBytes,
+				},
+			}, result)
+		})
+	}
+}
+
+func history(events ...*types.HistoryEvent) *types.GetWorkflowExecutionHistoryResponse {
+	return &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{
+			Events: append([]*types.HistoryEvent{
+				{
+					ID:                                      1,
+					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+				},
+			}, events...),
+		},
+	}
+}
+
+func decisionTaskScheduled(id, attempt int64) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID: id,
+		DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{
+			Attempt: attempt,
+		},
+	}
+}
+
+func decisionTaskCompleted(id int64) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID: id,
+		DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{
+			Identity: "localhost",
+		},
+	}
+}
+
+func decisionTaskFailed(id, scheduledID int64, cause types.DecisionTaskFailedCause, details string) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID: id,
+		DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+			ScheduledEventID: scheduledID,
+			Cause:            cause.Ptr(),
+			Details:          []byte(details),
+			Identity:         "localhost",
+		},
+	}
+}
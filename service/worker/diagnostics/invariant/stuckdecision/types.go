@@ -0,0 +1,53 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package stuckdecision
+
+type StuckDecisionType string
+
+const (
+	DecisionTaskFailureLoop StuckDecisionType = "Decision task is failing repeatedly"
+)
+
+func (s StuckDecisionType) String() string {
+	return string(s)
+}
+
+type IssueType string
+
+const (
+	DecisionTaskFailing IssueType = "The last decision task failed and no decision task has completed since"
+	DecisionTaskRetried IssueType = "Decision task was retried many times before it completed"
+)
+
+func (i IssueType) String() string {
+	return string(i)
+}
+
+type StuckDecisionMetadata struct {
+	// FailedEventID is the ID of the first decision task failure of the loop
+	FailedEventID  int64
+	Attempt        int64
+	Cause          string
+	Identity       string
+	BinaryChecksum string
+	Details        string
+}
@@ -40,6 +40,11 @@ const (
 	issueTypeTimeouts = "Timeout"
 	issueTypeFailures = "Failure"
 	issueTypeRetry    = "Retry"
+
+	issueTypeNonDeterminism = "NonDeterminism"
+	issueTypeStuckDecisions = "StuckDecision"
+	issueTypeHistorySize    = "HistorySize"
+	issueTypeChildWorkflows = "ChildWorkflow"
 )
 
 type DiagnosticsStarterWorkflowInput struct {
@@ -126,5 +131,17 @@ func getIssueType(result DiagnosticsWorkflowResult) string {
 	if result.Retries != nil {
 		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeRetry)
 	}
+	if result.NonDeterminism != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeNonDeterminism)
+	}
+	if result.StuckDecisions != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeStuckDecisions)
+	}
+	if result.HistorySize != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeHistorySize)
+	}
+	if result.ChildWorkflows != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeChildWorkflows)
+	}
 	return issueType
 }
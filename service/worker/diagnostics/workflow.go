@@ -32,8 +32,12 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/childworkflow"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/historysize"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/nondeterminism"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/retry"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/stuckdecision"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
 
@@ -52,9 +56,13 @@ type DiagnosticsWorkflowInput struct {
 }
 
 type DiagnosticsWorkflowResult struct {
-	Timeouts *timeoutDiagnostics
-	Failures *failureDiagnostics
-	Retries  *retryDiagnostics
+	Timeouts       *timeoutDiagnostics
+	Failures       *failureDiagnostics
+	Retries        *retryDiagnostics
+	NonDeterminism *nonDeterminismDiagnostics
+	StuckDecisions *stuckDecisionDiagnostics
+	HistorySize    *historySizeDiagnostics
+	ChildWorkflows *childWorkflowDiagnostics
 }
 
 type timeoutDiagnostics struct {
@@ -107,6 +115,60 @@ type retryIssuesResult struct {
 	Metadata      retry.RetryMetadata
 }
 
+type nonDeterminismDiagnostics struct {
+	Issues    []*nonDeterminismIssuesResult
+	RootCause []*rootCauseResult
+}
+
+type nonDeterminismIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      *nondeterminism.NonDeterminismMetadata
+}
+
+type stuckDecisionDiagnostics struct {
+	Issues    []*stuckDecisionIssuesResult
+	RootCause []*rootCauseResult
+}
+
+type stuckDecisionIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      *stuckdecision.StuckDecisionMetadata
+}
+
+type historySizeDiagnostics struct {
+	Issues    []*historySizeIssuesResult
+	RootCause []*rootCauseResult
+}
+
+type historySizeIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      *historysize.HistorySizeMetadata
+}
+
+type childWorkflowDiagnostics struct {
+	Issues    []*childWorkflowIssuesResult
+	RootCause []*rootCauseResult
+}
+
+type childWorkflowIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      *childworkflow.ChildWorkflowMetadata
+}
+
+// rootCauseResult is the root cause of the invariants whose root cause metadata is the metadata of the issue
+type rootCauseResult struct {
+	IssueID       int
+	RootCauseType string
+}
+
 func (w *dw) DiagnosticsWorkflow(ctx workflow.Context, params DiagnosticsWorkflowInput) (*DiagnosticsWorkflowResult, error) {
 	scope := w.metricsClient.Scope(metrics.DiagnosticsWorkflowScope, metrics.DomainTag(params.Domain))
 	scope.IncCounter(metrics.DiagnosticsWorkflowStartedCount)
@@ -116,6 +178,10 @@ func (w *dw) DiagnosticsWorkflow(ctx workflow.Context, params DiagnosticsWorkflo
 	var timeoutsResult *timeoutDiagnostics
 	var failureResult *failureDiagnostics
 	var retryResult *retryDiagnostics
+	var nonDeterminismResult *nonDeterminismDiagnostics
+	var stuckDecisionResult *stuckDecisionDiagnostics
+	var historySizeResult *historySizeDiagnostics
+	var childWorkflowResult *childWorkflowDiagnostics
 	var checkResult []invariant.InvariantCheckResult
 	var rootCauseResult []invariant.InvariantRootCauseResult
 
@@ -191,11 +257,73 @@ func (w *dw) DiagnosticsWorkflow(ctx workflow.Context, params DiagnosticsWorkflo
 		}
 	}
 
+	nonDeterminismIssues, err := retrieveNonDeterminismIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveNonDeterminismIssues: %w", err)
+	}
+
+	if len(nonDeterminismIssues) > 0 {
+		nonDeterminismResult = &nonDeterminismDiagnostics{
+			Issues:    nonDeterminismIssues,
+			RootCause: retrieveRootCause(rootCauseResult, invariant.RootCauseTypeNonDeterministicWorkflowCode),
+		}
+	}
+
+	stuckDecisionIssues, err := retrieveStuckDecisionIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveStuckDecisionIssues: %w", err)
+	}
+
+	if len(stuckDecisionIssues) > 0 {
+		stuckDecisionResult = &stuckDecisionDiagnostics{
+			Issues: stuckDecisionIssues,
+			RootCause: retrieveRootCause(rootCauseResult,
+				invariant.RootCauseTypeDecisionTaskNonDeterminism,
+				invariant.RootCauseTypeDecisionTaskWorkflowCodeFailure,
+				invariant.RootCauseTypeBadDecisionAttributes,
+				invariant.RootCauseTypeBadBinary),
+		}
+	}
+
+	historySizeIssues, err := retrieveHistorySizeIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveHistorySizeIssues: %w", err)
+	}
+
+	if len(historySizeIssues) > 0 {
+		historySizeResult = &historySizeDiagnostics{
+			Issues: historySizeIssues,
+			RootCause: retrieveRootCause(rootCauseResult,
+				invariant.RootCauseTypeHistoryEventCount,
+				invariant.RootCauseTypeUnboundedSignals,
+				invariant.RootCauseTypeContinueAsNewStateGrowth),
+		}
+	}
+
+	childWorkflowIssues, err := retrieveChildWorkflowIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveChildWorkflowIssues: %w", err)
+	}
+
+	if len(childWorkflowIssues) > 0 {
+		childWorkflowResult = &childWorkflowDiagnostics{
+			Issues: childWorkflowIssues,
+			RootCause: retrieveRootCause(rootCauseResult,
+				invariant.RootCauseTypeChildWorkflowFailureIgnored,
+				invariant.RootCauseTypeChildWorkflowFailed,
+				invariant.RootCauseTypeChildWorkflowAlreadyRunning),
+		}
+	}
+
 	scope.IncCounter(metrics.DiagnosticsWorkflowSuccess)
 	return &DiagnosticsWorkflowResult{
-		Timeouts: timeoutsResult,
-		Failures: failureResult,
-		Retries:  retryResult,
+		Timeouts:       timeoutsResult,
+		Failures:       failureResult,
+		Retries:        retryResult,
+		NonDeterminism: nonDeterminismResult,
+		StuckDecisions: stuckDecisionResult,
+		HistorySize:    historySizeResult,
+		ChildWorkflows: childWorkflowResult,
 	}, nil
 }
 
@@ -366,6 +494,102 @@ func retrieveRetryIssues(issues []invariant.InvariantCheckResult) ([]*retryIssue
 	return result, nil
 }
 
+func retrieveNonDeterminismIssues(issues []invariant.InvariantCheckResult) ([]*nonDeterminismIssuesResult, error) {
+	result := make([]*nonDeterminismIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == nondeterminism.NonDeterministicWorkflow.String() {
+			var data nondeterminism.NonDeterminismMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &nonDeterminismIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      &data,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveStuckDecisionIssues(issues []invariant.InvariantCheckResult) ([]*stuckDecisionIssuesResult, error) {
+	result := make([]*stuckDecisionIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == stuckdecision.DecisionTaskFailureLoop.String() {
+			var data stuckdecision.StuckDecisionMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &stuckDecisionIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      &data,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveHistorySizeIssues(issues []invariant.InvariantCheckResult) ([]*historySizeIssuesResult, error) {
+	result := make([]*historySizeIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == historysize.UnboundedHistory.String() {
+			var data historysize.HistorySizeMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &historySizeIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      &data,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveChildWorkflowIssues(issues []invariant.InvariantCheckResult) ([]*childWorkflowIssuesResult, error) {
+	result := make([]*childWorkflowIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == childworkflow.ChildWorkflowFailed.String() || issue.InvariantType == childworkflow.ChildWorkflowStartFailed.String() {
+			var data childworkflow.ChildWorkflowMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &childWorkflowIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      &data,
+			})
+		}
+	}
+	return result, nil
+}
+
+// retrieveRootCause returns the root causes of the given types
+func retrieveRootCause(rootCause []invariant.InvariantRootCauseResult, rootCauseTypes ...invariant.RootCause) []*rootCauseResult {
+	result := make([]*rootCauseResult, 0)
+	for _, rc := range rootCause {
+		for _, rootCauseType := range rootCauseTypes {
+			if rc.RootCause == rootCauseType {
+				result = append(result, &rootCauseResult{
+					IssueID:       rc.IssueID,
+					RootCauseType: rc.RootCause.String(),
+				})
+			}
+		}
+	}
+	return result
+}
+
 func rootCauseHeartBeatRelated(rootCause invariant.RootCause) bool {
 	for _, rc := range []invariant.RootCause{invariant.RootCauseTypeNoHeartBeatTimeoutNoRetryPolicy,
 		invariant.RootCauseTypeHeartBeatingNotEnabledWithRetryPolicy,
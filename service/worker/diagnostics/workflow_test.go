@@ -41,8 +41,12 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/childworkflow"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/historysize"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/nondeterminism"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/retry"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/stuckdecision"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
 
@@ -482,3 +486,149 @@ func (s *diagnosticsWorkflowTestSuite) Test__retrieveRetryIssues() {
 	s.NoError(err)
 	s.ElementsMatch(retryIssues, result)
 }
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveNonDeterminismIssues() {
+	metadata := nondeterminism.NonDeterminismMetadata{
+		EventID:  5,
+		Identity: "localhost",
+		Details:  "nondeterministic workflow",
+	}
+	metadataInBytes, err := json.Marshal(metadata)
+	s.NoError(err)
+	issues := []invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: nondeterminism.NonDeterministicWorkflow.String(),
+			Reason:        nondeterminism.DecisionTaskFailedNonDeterministic.String(),
+			Metadata:      metadataInBytes,
+		},
+		{
+			IssueID:       0,
+			InvariantType: failure.WorkflowFailed.String(),
+			Reason:        failure.CustomError.String(),
+			Metadata:      []byte("{}"),
+		},
+	}
+	result, err := retrieveNonDeterminismIssues(issues)
+	s.NoError(err)
+	s.ElementsMatch([]*nonDeterminismIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: nondeterminism.NonDeterministicWorkflow.String(),
+			Reason:        nondeterminism.DecisionTaskFailedNonDeterministic.String(),
+			Metadata:      &metadata,
+		},
+	}, result)
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveStuckDecisionIssues() {
+	metadata := stuckdecision.StuckDecisionMetadata{
+		FailedEventID: 5,
+		Attempt:       10,
+		Cause:         types.DecisionTaskFailedCauseBadBinary.String(),
+	}
+	metadataInBytes, err := json.Marshal(metadata)
+	s.NoError(err)
+	result, err := retrieveStuckDecisionIssues([]invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: stuckdecision.DecisionTaskFailureLoop.String(),
+			Reason:        stuckdecision.DecisionTaskRetried.String(),
+			Metadata:      metadataInBytes,
+		},
+	})
+	s.NoError(err)
+	s.ElementsMatch([]*stuckDecisionIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: stuckdecision.DecisionTaskFailureLoop.String(),
+			Reason:        stuckdecision.DecisionTaskRetried.String(),
+			Metadata:      &metadata,
+		},
+	}, result)
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveHistorySizeIssues() {
+	metadata := historysize.HistorySizeMetadata{
+		EventCount:  20000,
+		SignalCount: 5000,
+		Threshold:   1000,
+	}
+	metadataInBytes, err := json.Marshal(metadata)
+	s.NoError(err)
+	result, err := retrieveHistorySizeIssues([]invariant.InvariantCheckResult{
+		{
+			IssueID:       1,
+			InvariantType: historysize.UnboundedHistory.String(),
+			Reason:        historysize.TooManySignals.String(),
+			Metadata:      metadataInBytes,
+		},
+	})
+	s.NoError(err)
+	s.ElementsMatch([]*historySizeIssuesResult{
+		{
+			IssueID:       1,
+			InvariantType: historysize.UnboundedHistory.String(),
+			Reason:        historysize.TooManySignals.String(),
+			Metadata:      &metadata,
+		},
+	}, result)
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveChildWorkflowIssues() {
+	metadata := childworkflow.ChildWorkflowMetadata{
+		EventID:         10,
+		Execution:       &types.WorkflowExecution{WorkflowID: "child-wid", RunID: "child-rid"},
+		WorkflowType:    "child-workflow",
+		FailureReason:   "cadenceInternal:Generic",
+		ParentCompleted: true,
+	}
+	metadataInBytes, err := json.Marshal(metadata)
+	s.NoError(err)
+	result, err := retrieveChildWorkflowIssues([]invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: childworkflow.ChildWorkflowFailed.String(),
+			Reason:        childworkflow.ChildFailed.String(),
+			Metadata:      metadataInBytes,
+		},
+	})
+	s.NoError(err)
+	s.ElementsMatch([]*childWorkflowIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: childworkflow.ChildWorkflowFailed.String(),
+			Reason:        childworkflow.ChildFailed.String(),
+			Metadata:      &metadata,
+		},
+	}, result)
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveRootCause() {
+	rootCause := []invariant.InvariantRootCauseResult{
+		{
+			IssueID:   0,
+			RootCause: invariant.RootCauseTypeServiceSideIssue,
+		},
+		{
+			IssueID:   1,
+			RootCause: invariant.RootCauseTypeUnboundedSignals,
+		},
+		{
+			IssueID:   2,
+			RootCause: invariant.RootCauseTypeHistoryEventCount,
+		},
+	}
+	result := retrieveRootCause(rootCause, invariant.RootCauseTypeHistoryEventCount, invariant.RootCauseTypeUnboundedSignals)
+	s.ElementsMatch([]*rootCauseResult{
+		{
+			IssueID:       1,
+			RootCauseType: invariant.RootCauseTypeUnboundedSignals.String(),
+		},
+		{
+			IssueID:       2,
+			RootCauseType: invariant.RootCauseTypeHistoryEventCount.String(),
+		},
+	}, result)
+	s.Empty(retrieveRootCause(rootCause, invariant.RootCauseTypeBadBinary))
+}
@@ -0,0 +1,51 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package failovercontroller
+
+import (
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+)
+
+// Config contains the configuration of the active cluster failover controller
+type Config struct {
+	// EnabledForDomain indicates if the controller can fail over the cluster attributes of a domain
+	EnabledForDomain dynamicproperties.BoolPropertyFnWithDomainFilter
+	// DryRun makes the controller only log its decisions and record them in the domain audit log
+	DryRun dynamicproperties.BoolPropertyFn
+	// Interval is the interval between two rounds
+	Interval dynamicproperties.DurationPropertyFn
+	// Cooldown is the min time between a failover of a cluster attribute, automatic or not, and its next automatic failover
+	Cooldown dynamicproperties.DurationPropertyFn
+	// MaxFailoversPerRound caps the number of cluster attributes failed over in one round
+	MaxFailoversPerRound dynamicproperties.IntPropertyFn
+	// UnhealthyThreshold is the number of consecutive failed checks after which a cluster is unhealthy
+	UnhealthyThreshold dynamicproperties.IntPropertyFn
+	// MaxReplicationLag is the replication lag to a cluster above which its health check fails
+	MaxReplicationLag dynamicproperties.DurationPropertyFn
+	// ReplicationLagShardSampleSize is the number of history shards sampled to measure the replication lag
+	ReplicationLagShardSampleSize dynamicproperties.IntPropertyFn
+	// DrainedClusters is the list of clusters drained by operators
+	DrainedClusters dynamicproperties.ListPropertyFn
+	// WitnessCluster is the cluster which breaks the tie when exactly half of the voting clusters are healthy
+	WitnessCluster dynamicproperties.StringPropertyFn
+}
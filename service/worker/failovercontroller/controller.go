@@ -0,0 +1,533 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package failovercontroller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	guuid "github.com/google/uuid"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/activecluster"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ringKey is the key used to pick the worker host running the controller of the current cluster
+	ringKey = "active-cluster-failover-controller"
+
+	// auditIdentity is the identity of the dry run decisions recorded by the controller in the domain audit log
+	auditIdentity     = "active-cluster-failover-controller"
+	auditIdentityType = "service"
+
+	failoverRequestTimeout = 10 * time.Second
+	auditLogPageSize       = 100
+)
+
+type (
+	// Params contains the dependencies of the controller
+	Params struct {
+		Config             *Config
+		ClusterMetadata    cluster.Metadata
+		ClientBean         client.Bean
+		DomainCache        cache.DomainCache
+		DomainAuditManager persistence.DomainAuditManager
+		ShardManager       persistence.ShardManager
+		ExecutionManager   activecluster.ExecutionManagerProvider
+		NumHistoryShards   int
+		MembershipResolver membership.Resolver
+		HostInfo           membership.HostInfo
+		TimeSource         clock.TimeSource
+		MetricsClient      metrics.Client
+		Logger             log.Logger
+	}
+
+	// Controller fails over the cluster attributes of active-active domains away from unhealthy clusters.
+	// Every cluster runs a controller, but only the leader makes decisions, so two clusters with a different
+	// view of the health of a third one never fail over its cluster attributes at the same time. The leader
+	// is elected by a quorum of healthy clusters: the clusters not drained by operators vote, and a controller
+	// only acts when it sees more than half of them healthy, or exactly half and the tie is broken in their
+	// favor, and its own cluster is the first healthy voting cluster by name. A cluster which is lost or
+	// partitioned away from the majority, including the primary cluster, can't act, while the majority elects
+	// a new leader on its own.
+	//
+	// A tie is broken by the witness cluster when one is configured. The witness is a cluster of the cluster
+	// group which hosts no active-active domain and doesn't vote itself: the half which sees it healthy has the
+	// quorum. Without a witness, the half with the first voting cluster by name has the quorum, so a deployment
+	// of two clusters needs a witness to fail over when the first of them is lost.
+	//
+	// The cooldown of a cluster attribute is read from the failovers recorded in the domain audit log,
+	// which is persisted and replicated to all the clusters, so it survives restarts and leader changes.
+	// In dry run mode, the decisions are recorded in the domain audit log as dry run failovers, which are
+	// not listed in the failover history of the domain, and start the cooldown of the dry runs that follow.
+	Controller struct {
+		status             int32
+		config             *Config
+		currentCluster     string
+		clusterMetadata    cluster.Metadata
+		clientBean         client.Bean
+		domainCache        cache.DomainCache
+		domainAuditManager persistence.DomainAuditManager
+		membershipResolver membership.Resolver
+		hostInfo           membership.HostInfo
+		timeSource         clock.TimeSource
+		scope              metrics.Scope
+		logger             log.Logger
+		health             *healthChecker
+
+		ctx      context.Context
+		cancelFn context.CancelFunc
+		wg       sync.WaitGroup
+	}
+
+	attributeKey struct {
+		domainID  string
+		scope     string
+		attribute string
+	}
+
+	// failover is a cluster attribute to fail over to another cluster
+	failover struct {
+		key         attributeKey
+		fromCluster string
+		toCluster   string
+		reason      string
+	}
+)
+
+// New creates a new active cluster failover controller
+func New(params *Params) *Controller {
+	logger := params.Logger.WithTags(tag.ComponentActiveClusterFailover)
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Controller{
+		status:             common.DaemonStatusInitialized,
+		config:             params.Config,
+		currentCluster:     params.ClusterMetadata.GetCurrentClusterName(),
+		clusterMetadata:    params.ClusterMetadata,
+		clientBean:         params.ClientBean,
+		domainCache:        params.DomainCache,
+		domainAuditManager: params.DomainAuditManager,
+		membershipResolver: params.MembershipResolver,
+		hostInfo:           params.HostInfo,
+		timeSource:         params.TimeSource,
+		scope:              params.MetricsClient.Scope(metrics.ActiveClusterFailoverControllerScope),
+		logger:             logger,
+		health: newHealthChecker(
+			params.ClusterMetadata,
+			params.ClientBean,
+			params.ShardManager,
+			params.ExecutionManager,
+			params.NumHistoryShards,
+			params.Config,
+			params.TimeSource,
+			logger,
+		),
+		ctx:      ctx,
+		cancelFn: cancel,
+	}
+}
+
+// Start starts the controller
+func (c *Controller) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	c.wg.Add(1)
+	go c.loop()
+	c.logger.Info("Active cluster failover controller started.")
+}
+
+// Stop stops the controller
+func (c *Controller) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	c.cancelFn()
+	c.wg.Wait()
+	c.logger.Info("Active cluster failover controller stopped.")
+}
+
+func (c *Controller) loop() {
+	defer c.wg.Done()
+	timer := c.timeSource.NewTimer(c.config.Interval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.Chan():
+			c.runRound(c.ctx)
+			timer.Reset(c.config.Interval())
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Controller) runRound(ctx context.Context) {
+	// The following is a best effort to make sure only one worker host of the cluster runs the controller.
+	// Two hosts running a round at the same time during a ring change is safe, as both would pick the
+	// same failovers and the domain handler rejects domain updates more frequent than its failover cooldown.
+	info, err := c.membershipResolver.Lookup(service.Worker, ringKey)
+	if err != nil {
+		c.logger.Warn("Failed to look up the owner of the active cluster failover controller", tag.Error(err))
+		return
+	}
+	if info.Identity() != c.hostInfo.Identity() {
+		return
+	}
+
+	health := c.health.check(ctx)
+	unhealthy := 0
+	for _, h := range health {
+		if !h.healthy {
+			unhealthy++
+		}
+	}
+	c.scope.UpdateGauge(metrics.ActiveClusterUnhealthyClusters, float64(unhealthy))
+	if unhealthy == 0 || !c.isLeader(health) {
+		return
+	}
+
+	budget := c.config.MaxFailoversPerRound()
+	dryRun := c.config.DryRun()
+	for _, entry := range c.sortedDomains() {
+		failovers := c.pickFailovers(entry, health)
+		if len(failovers) == 0 {
+			continue
+		}
+		recent, err := c.recentFailovers(ctx, entry.GetInfo().ID, dryRun)
+		if err != nil {
+			// without the failover history, the cooldown can't be enforced
+			c.logger.Warn("Failed to read the failover history of domain", tag.WorkflowDomainName(entry.GetInfo().Name), tag.Error(err))
+			c.scope.AddCounter(metrics.ActiveClusterFailoverSkippedCount, int64(len(failovers)))
+			continue
+		}
+
+		var selected []failover
+		for _, f := range failovers {
+			logger := c.logger.WithTags(
+				tag.WorkflowDomainName(entry.GetInfo().Name),
+				tag.Dynamic("cluster-attribute", f.key.scope+"/"+f.key.attribute),
+				tag.ActiveClusterName(f.fromCluster),
+				tag.ClusterName(f.toCluster),
+			)
+			if recent[f.key] {
+				logger.Info("Skipping failover of cluster attribute in cooldown")
+				c.scope.IncCounter(metrics.ActiveClusterFailoverSkippedCount)
+				continue
+			}
+			if len(selected) >= budget {
+				logger.Info("Skipping failover of cluster attribute, max failovers per round reached")
+				c.scope.IncCounter(metrics.ActiveClusterFailoverSkippedCount)
+				continue
+			}
+			selected = append(selected, f)
+		}
+		if len(selected) == 0 {
+			continue
+		}
+		budget -= len(selected)
+
+		c.failover(ctx, entry, selected, dryRun)
+	}
+}
+
+// isLeader returns whether the controller of the current cluster makes the decisions of the round,
+// given the health of the clusters as seen from the current cluster
+func (c *Controller) isLeader(health map[string]clusterHealth) bool {
+	witness := c.config.WitnessCluster()
+	witnessHealth, hasWitness := health[witness]
+	hasWitness = hasWitness && !witnessHealth.drained
+
+	var voters, healthy []string
+	for _, name := range sortedKeys(health) {
+		if health[name].drained || (hasWitness && name == witness) {
+			continue
+		}
+		voters = append(voters, name)
+		if health[name].healthy {
+			healthy = append(healthy, name)
+		}
+	}
+
+	quorum := 2*len(healthy) > len(voters)
+	if 2*len(healthy) == len(voters) && len(healthy) > 0 {
+		// on an even split, only the half which sees the witness healthy has a quorum,
+		// or the half with the first voting cluster if there is no witness
+		if hasWitness {
+			quorum = witnessHealth.healthy
+		} else {
+			quorum = healthy[0] == voters[0]
+		}
+	}
+	if !quorum {
+		c.logger.Warn("Not failing over cluster attributes, no quorum of healthy clusters",
+			tag.Dynamic("healthy-clusters", healthy),
+			tag.Dynamic("voting-clusters", voters),
+			tag.Dynamic("witness-cluster", witness),
+		)
+		return false
+	}
+	return healthy[0] == c.currentCluster
+}
+
+// recentFailovers returns the cluster attributes of the domain failed over within the cooldown,
+// as recorded in the domain audit log. In dry run mode, the cluster attributes of the dry run failovers
+// are returned too, while they don't delay a real failover once the dry run mode is turned off.
+func (c *Controller) recentFailovers(ctx context.Context, domainID string, dryRun bool) (map[attributeKey]bool, error) {
+	operationTypes := []persistence.DomainAuditOperationType{persistence.DomainAuditOperationTypeFailover}
+	if dryRun {
+		operationTypes = append(operationTypes, persistence.DomainAuditOperationTypeFailoverDryRun)
+	}
+
+	minCreatedTime := c.timeSource.Now().Add(-c.config.Cooldown())
+	result := make(map[attributeKey]bool)
+	for _, operationType := range operationTypes {
+		var pageToken []byte
+		for {
+			resp, err := c.domainAuditManager.GetDomainAuditLogs(ctx, &persistence.GetDomainAuditLogsRequest{
+				DomainID:       domainID,
+				OperationType:  operationType,
+				MinCreatedTime: &minCreatedTime,
+				PageSize:       auditLogPageSize,
+				NextPageToken:  pageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, auditLog := range resp.AuditLogs {
+				for _, f := range auditLog.ToFailoverEvents().GetClusterFailovers() {
+					if attribute := f.GetClusterAttribute(); attribute != nil {
+						result[attributeKey{domainID: domainID, scope: attribute.GetScope(), attribute: attribute.GetName()}] = true
+					}
+				}
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+	return result, nil
+}
+
+// sortedDomains returns the domains the controller can fail over, sorted by name
+// so that the max failovers per round are spent in a deterministic order
+func (c *Controller) sortedDomains() []*cache.DomainCacheEntry {
+	var domains []*cache.DomainCacheEntry
+	for _, entry := range c.domainCache.GetAllDomain() {
+		if !entry.IsGlobalDomain() || entry.IsDeprecatedOrDeleted() || !entry.GetReplicationConfig().IsActiveActive() {
+			continue
+		}
+		if !c.config.EnabledForDomain(entry.GetInfo().Name) {
+			continue
+		}
+		domains = append(domains, entry)
+	}
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i].GetInfo().Name < domains[j].GetInfo().Name
+	})
+	return domains
+}
+
+// pickFailovers returns the cluster attributes of the domain that are active in an unhealthy cluster,
+// with the cluster to fail each of them over to. The target of a cluster attribute is the first healthy
+// cluster of the domain by name.
+func (c *Controller) pickFailovers(entry *cache.DomainCacheEntry, health map[string]clusterHealth) []failover {
+	replicationConfig := entry.GetReplicationConfig()
+	clusters := make([]string, 0, len(replicationConfig.Clusters))
+	for _, cl := range replicationConfig.Clusters {
+		clusters = append(clusters, cl.ClusterName)
+	}
+	sort.Strings(clusters)
+
+	var result []failover
+	scopes := replicationConfig.ActiveClusters.GetAttributeScopes()
+	for _, scope := range sortedKeys(scopes) {
+		attributes := scopes[scope].ClusterAttributes
+		for _, attribute := range sortedKeys(attributes) {
+			activeCluster := attributes[attribute].ActiveClusterName
+			h, ok := health[activeCluster]
+			if !ok || h.healthy {
+				continue
+			}
+
+			target := pickTarget(activeCluster, clusters, health)
+			if target == "" {
+				c.logger.Warn("No healthy cluster to fail over cluster attribute to",
+					tag.WorkflowDomainName(entry.GetInfo().Name),
+					tag.Dynamic("cluster-attribute", scope+"/"+attribute),
+					tag.ActiveClusterName(activeCluster),
+				)
+				c.scope.IncCounter(metrics.ActiveClusterFailoverSkippedCount)
+				continue
+			}
+
+			result = append(result, failover{
+				key:         attributeKey{domainID: entry.GetInfo().ID, scope: scope, attribute: attribute},
+				fromCluster: activeCluster,
+				toCluster:   target,
+				reason:      h.reason,
+			})
+		}
+	}
+	return result
+}
+
+// pickTarget returns the first healthy cluster other than the source cluster, or an empty string if there is none.
+// A healthy cluster is caught up with the replication from the current cluster, see healthChecker.
+func pickTarget(source string, clusters []string, health map[string]clusterHealth) string {
+	for _, cl := range clusters {
+		if cl != source && health[cl].healthy {
+			return cl
+		}
+	}
+	return ""
+}
+
+// failover fails over the cluster attributes of the domain to their target clusters, in a single domain update.
+// The reason of the failover is passed to the domain handler, which records it in the audit entry of the update.
+func (c *Controller) failover(ctx context.Context, entry *cache.DomainCacheEntry, failovers []failover, dryRun bool) {
+	domainName := entry.GetInfo().Name
+	logger := c.logger.WithTags(tag.WorkflowDomainName(domainName), tag.Number(int64(len(failovers))))
+	comment := failoverComment(failovers, dryRun)
+
+	if dryRun {
+		logger.Info("Dry run, not failing over cluster attributes", tag.Reason(comment))
+		c.scope.AddCounter(metrics.ActiveClusterFailoverDryRunCount, int64(len(failovers)))
+		c.recordDryRun(ctx, entry, failovers, comment)
+		return
+	}
+
+	scopes := make(map[string]types.ClusterAttributeScope)
+	for _, f := range failovers {
+		scope, ok := scopes[f.key.scope]
+		if !ok {
+			scope = types.ClusterAttributeScope{ClusterAttributes: make(map[string]types.ActiveClusterInfo)}
+			scopes[f.key.scope] = scope
+		}
+		scope.ClusterAttributes[f.key.attribute] = types.ActiveClusterInfo{ActiveClusterName: f.toCluster}
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, failoverRequestTimeout)
+	defer cancel()
+	_, err := c.clientBean.GetFrontendClient().FailoverDomain(requestCtx, &types.FailoverDomainRequest{
+		DomainName:     domainName,
+		ActiveClusters: &types.ActiveClusters{AttributeScopes: scopes},
+		Reason:         comment,
+	})
+	if err != nil {
+		logger.Error("Failed to fail over cluster attributes", tag.Reason(comment), tag.Error(err))
+		c.scope.AddCounter(metrics.ActiveClusterFailoverFailedCount, int64(len(failovers)))
+		return
+	}
+
+	logger.Info("Failed over cluster attributes", tag.Reason(comment))
+	c.scope.AddCounter(metrics.ActiveClusterFailoverCount, int64(len(failovers)))
+}
+
+// recordDryRun records the failover decided in dry run mode in the domain audit log, with the domain states
+// before and after the failover as the domain handler would have recorded them, so that the cooldown applies
+// to the dry runs too
+func (c *Controller) recordDryRun(ctx context.Context, entry *cache.DomainCacheEntry, failovers []failover, comment string) {
+	stateBefore := &persistence.GetDomainResponse{
+		Info:                        entry.GetInfo(),
+		Config:                      entry.GetConfig(),
+		ReplicationConfig:           entry.GetReplicationConfig(),
+		IsGlobalDomain:              entry.IsGlobalDomain(),
+		ConfigVersion:               entry.GetConfigVersion(),
+		FailoverVersion:             entry.GetFailoverVersion(),
+		FailoverNotificationVersion: entry.GetFailoverNotificationVersion(),
+		PreviousFailoverVersion:     entry.GetPreviousFailoverVersion(),
+		FailoverEndTime:             entry.GetFailoverEndTime(),
+		NotificationVersion:         entry.GetNotificationVersion(),
+	}
+	stateAfter := stateBefore.DeepCopy()
+	for _, f := range failovers {
+		info := stateAfter.ReplicationConfig.ActiveClusters.AttributeScopes[f.key.scope].ClusterAttributes[f.key.attribute]
+		info.ActiveClusterName = f.toCluster
+		info.FailoverVersion = c.clusterMetadata.GetNextFailoverVersion(f.toCluster, info.FailoverVersion, entry.GetInfo().Name)
+		stateAfter.ReplicationConfig.ActiveClusters.AttributeScopes[f.key.scope].ClusterAttributes[f.key.attribute] = info
+	}
+
+	// Must be a UUID v7, the creation time of the entry is embedded in it
+	eventID, err := guuid.NewV7()
+	if err != nil {
+		c.logger.Error("Failed to generate domain audit log event ID", tag.Error(err))
+		return
+	}
+	_, err = c.domainAuditManager.CreateDomainAuditLog(ctx, &persistence.CreateDomainAuditLogRequest{
+		DomainID:      entry.GetInfo().ID,
+		EventID:       eventID.String(),
+		CreatedTime:   time.Unix(eventID.Time().UnixTime()),
+		StateBefore:   stateBefore,
+		StateAfter:    stateAfter,
+		OperationType: persistence.DomainAuditOperationTypeFailoverDryRun,
+		Identity:      auditIdentity,
+		IdentityType:  auditIdentityType,
+		Comment:       comment,
+	})
+	if err != nil {
+		// the same failovers are decided again in the next round
+		c.logger.Error("Failed to record dry run failover in domain audit log", tag.WorkflowDomainName(entry.GetInfo().Name), tag.Error(err))
+	}
+}
+
+func failoverComment(failovers []failover, dryRun bool) string {
+	parts := make([]string, 0, len(failovers))
+	for _, f := range failovers {
+		parts = append(parts, fmt.Sprintf("%s/%s from %s to %s (%s)", f.key.scope, f.key.attribute, f.fromCluster, f.toCluster, f.reason))
+	}
+	comment := "automatic failover: " + strings.Join(parts, ", ")
+	if dryRun {
+		comment = "dry run " + comment
+	}
+	return comment
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,566 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package failovercontroller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/activecluster"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain"
+	testRegion1    = "region1"
+	testRegion2    = "region2"
+	// testThirdClusterName is a third cluster, for the tests of the leader election
+	testThirdClusterName = "third"
+	// testNumShards is the number of history shards of the tests measuring the replication lag
+	testNumShards = 2
+)
+
+type testMocks struct {
+	clientBean         *client.MockBean
+	frontendClient     *frontend.MockClient
+	remoteClients      map[string]*frontend.MockClient
+	domainCache        *cache.MockDomainCache
+	domainAuditManager *persistence.MockDomainAuditManager
+	shardManager       *persistence.MockShardManager
+	executionProvider  *activecluster.MockExecutionManagerProvider
+	membershipResolver *membership.MockResolver
+	timeSource         clock.MockedTimeSource
+}
+
+func testConfig() *Config {
+	return &Config{
+		EnabledForDomain:              dynamicproperties.GetBoolPropertyFnFilteredByDomain(true),
+		DryRun:                        dynamicproperties.GetBoolPropertyFn(false),
+		Interval:                      dynamicproperties.GetDurationPropertyFn(time.Second),
+		Cooldown:                      dynamicproperties.GetDurationPropertyFn(time.Minute),
+		MaxFailoversPerRound:          dynamicproperties.GetIntPropertyFn(10),
+		UnhealthyThreshold:            dynamicproperties.GetIntPropertyFn(1),
+		MaxReplicationLag:             dynamicproperties.GetDurationPropertyFn(time.Minute),
+		ReplicationLagShardSampleSize: dynamicproperties.GetIntPropertyFn(2),
+		DrainedClusters:               func(...dynamicproperties.FilterOption) []interface{} { return nil },
+		WitnessCluster:                dynamicproperties.GetStringPropertyFn(""),
+	}
+}
+
+func drained(clusters ...interface{}) dynamicproperties.ListPropertyFn {
+	return func(...dynamicproperties.FilterOption) []interface{} { return clusters }
+}
+
+func setupController(t *testing.T, config *Config, numShards int) (*Controller, *testMocks) {
+	return setupControllerWithMetadata(t, config, numShards, cluster.TestActiveClusterMetadata)
+}
+
+func setupControllerWithMetadata(t *testing.T, config *Config, numShards int, clusterMetadata cluster.Metadata) (*Controller, *testMocks) {
+	ctrl := gomock.NewController(t)
+	m := &testMocks{
+		clientBean:         client.NewMockBean(ctrl),
+		frontendClient:     frontend.NewMockClient(ctrl),
+		remoteClients:      make(map[string]*frontend.MockClient),
+		domainCache:        cache.NewMockDomainCache(ctrl),
+		domainAuditManager: persistence.NewMockDomainAuditManager(ctrl),
+		shardManager:       persistence.NewMockShardManager(ctrl),
+		executionProvider:  activecluster.NewMockExecutionManagerProvider(ctrl),
+		membershipResolver: membership.NewMockResolver(ctrl),
+		timeSource:         clock.NewMockedTimeSource(),
+	}
+	m.clientBean.EXPECT().GetFrontendClient().Return(m.frontendClient).AnyTimes()
+	for name := range clusterMetadata.GetRemoteClusterInfo() {
+		m.remoteClients[name] = frontend.NewMockClient(ctrl)
+		m.clientBean.EXPECT().GetRemoteFrontendClient(name).Return(m.remoteClients[name], nil).AnyTimes()
+	}
+
+	hostInfo := membership.NewHostInfo("127.0.0.1:7939")
+	m.membershipResolver.EXPECT().Lookup(gomock.Any(), ringKey).Return(hostInfo, nil).AnyTimes()
+
+	c := New(&Params{
+		Config:             config,
+		ClusterMetadata:    clusterMetadata,
+		ClientBean:         m.clientBean,
+		DomainCache:        m.domainCache,
+		DomainAuditManager: m.domainAuditManager,
+		ShardManager:       m.shardManager,
+		ExecutionManager:   m.executionProvider,
+		NumHistoryShards:   numShards,
+		MembershipResolver: m.membershipResolver,
+		HostInfo:           hostInfo,
+		TimeSource:         m.timeSource,
+		MetricsClient:      metrics.NewNoopMetricsClient(),
+		Logger:             testlogger.New(t),
+	})
+	return c, m
+}
+
+func activeActiveDomain(activeClusters map[string]string) *cache.DomainCacheEntry {
+	attributes := make(map[string]types.ActiveClusterInfo)
+	for attribute, cl := range activeClusters {
+		attributes[attribute] = types.ActiveClusterInfo{
+			ActiveClusterName: cl,
+			FailoverVersion:   cluster.TestAllClusterInfo[cl].InitialFailoverVersion,
+		}
+	}
+	return cache.NewDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID, Name: testDomainName},
+		&persistence.DomainConfig{},
+		true,
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+			ActiveClusters: &types.ActiveClusters{
+				AttributeScopes: map[string]types.ClusterAttributeScope{
+					"region": {ClusterAttributes: attributes},
+				},
+			},
+		},
+		1,
+		nil,
+		0,
+		0,
+		0,
+	)
+}
+
+func expectFrontendsHealthy(m *testMocks) {
+	m.frontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{}, nil).AnyTimes()
+	for _, remoteClient := range m.remoteClients {
+		remoteClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{}, nil).AnyTimes()
+	}
+}
+
+func expectOutboundReplicationCaughtUp(t *testing.T, m *testMocks) {
+	executionManager := persistence.NewMockExecutionManager(gomock.NewController(t))
+	m.shardManager.EXPECT().GetShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetShardRequest) (*persistence.GetShardResponse, error) {
+			return &persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: request.ShardID}}, nil
+		}).AnyTimes()
+	m.executionProvider.EXPECT().GetExecutionManager(gomock.Any()).Return(executionManager, nil).AnyTimes()
+	executionManager.EXPECT().GetHistoryTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTasksResponse{}, nil).AnyTimes()
+}
+
+// expectFailoverHistory makes the domain audit log return the given failovers
+func expectFailoverHistory(m *testMocks, auditLogs ...*persistence.DomainAuditLog) {
+	m.domainAuditManager.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainAuditLogsResponse{
+		AuditLogs: auditLogs,
+	}, nil).AnyTimes()
+}
+
+// failoverAuditLog returns the audit log of a failover of the region attribute from one cluster to another
+func failoverAuditLog(region, from, to string, createdTime time.Time) *persistence.DomainAuditLog {
+	state := func(activeCluster string, failoverVersion int64) *persistence.GetDomainResponse {
+		return &persistence.GetDomainResponse{
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusters: &types.ActiveClusters{
+					AttributeScopes: map[string]types.ClusterAttributeScope{
+						"region": {ClusterAttributes: map[string]types.ActiveClusterInfo{
+							region: {ActiveClusterName: activeCluster, FailoverVersion: failoverVersion},
+						}},
+					},
+				},
+			},
+		}
+	}
+	return &persistence.DomainAuditLog{
+		DomainID:      testDomainID,
+		StateBefore:   state(from, 1),
+		StateAfter:    state(to, 2),
+		OperationType: persistence.DomainAuditOperationTypeFailover,
+		CreatedTime:   createdTime,
+	}
+}
+
+// threeClusterMetadata returns the metadata of a cluster group of the test clusters and a third one
+func threeClusterMetadata(currentCluster string) cluster.Metadata {
+	clusterGroup := map[string]config.ClusterInformation{
+		testThirdClusterName: {
+			Enabled:                true,
+			InitialFailoverVersion: 3,
+			RPCName:                cluster.TestAllClusterInfo[cluster.TestCurrentClusterName].RPCName,
+			RPCAddress:             "127.0.0.1:9104",
+			RPCTransport:           cluster.TestClusterXDCTransport,
+		},
+	}
+	for name, info := range cluster.TestAllClusterInfo {
+		clusterGroup[name] = info
+	}
+	return cluster.NewMetadata(
+		config.ClusterGroupMetadata{
+			FailoverVersionIncrement: cluster.TestFailoverVersionIncrement,
+			PrimaryClusterName:       cluster.TestCurrentClusterName,
+			CurrentClusterName:       currentCluster,
+			ClusterGroup:             clusterGroup,
+		},
+		func(string) bool { return false },
+		metrics.NewNoopMetricsClient(),
+		log.NewNoop(),
+	)
+}
+
+func TestRunRound_FailsOverAttributesOfDrainedCluster(t *testing.T) {
+	config := testConfig()
+	config.DrainedClusters = drained(cluster.TestAlternativeClusterName)
+	c, m := setupController(t, config, testNumShards)
+	expectFrontendsHealthy(m)
+	expectOutboundReplicationCaughtUp(t, m)
+	expectFailoverHistory(m)
+	m.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		testDomainID: activeActiveDomain(map[string]string{
+			testRegion1: cluster.TestCurrentClusterName,
+			testRegion2: cluster.TestAlternativeClusterName,
+		}),
+	})
+	m.frontendClient.EXPECT().FailoverDomain(gomock.Any(), &types.FailoverDomainRequest{
+		DomainName: testDomainName,
+		ActiveClusters: &types.ActiveClusters{
+			AttributeScopes: map[string]types.ClusterAttributeScope{
+				"region": {ClusterAttributes: map[string]types.ActiveClusterInfo{
+					testRegion2: {ActiveClusterName: cluster.TestCurrentClusterName},
+				}},
+			},
+		},
+		Reason: "automatic failover: region/region2 from standby to active (drained by operator)",
+	}).Return(&types.FailoverDomainResponse{}, nil)
+
+	c.runRound(context.Background())
+}
+
+func TestRunRound_FailsOverAttributesOfUnreachableCluster(t *testing.T) {
+	c, m := setupController(t, testConfig(), 0)
+	m.frontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{}, nil).AnyTimes()
+	m.remoteClients[cluster.TestAlternativeClusterName].EXPECT().GetClusterInfo(gomock.Any()).Return(nil, errors.New("unavailable")).AnyTimes()
+	expectFailoverHistory(m)
+	m.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		testDomainID: activeActiveDomain(map[string]string{testRegion2: cluster.TestAlternativeClusterName}),
+	})
+	m.frontendClient.EXPECT().FailoverDomain(gomock.Any(), gomock.Any()).Return(&types.FailoverDomainResponse{}, nil)
+
+	// half of the clusters is healthy, including the first one by name, which makes a quorum
+	c.runRound(context.Background())
+}
+
+func TestRunRound_DryRun(t *testing.T) {
+	config := testConfig()
+	config.DryRun = dynamicproperties.GetBoolPropertyFn(true)
+	config.DrainedClusters = drained(cluster.TestAlternativeClusterName)
+	c, m := setupController(t, config, testNumShards)
+	expectFrontendsHealthy(m)
+	expectOutboundReplicationCaughtUp(t, m)
+	m.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		testDomainID: activeActiveDomain(map[string]string{testRegion2: cluster.TestAlternativeClusterName}),
+	}).Times(2)
+	var auditLogs []*persistence.DomainAuditLog
+	m.domainAuditManager.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetDomainAuditLogsRequest) (*persistence.GetDomainAuditLogsResponse, error) {
+			resp := &persistence.GetDomainAuditLogsResponse{}
+			for _, auditLog := range auditLogs {
+				if auditLog.OperationType == request.OperationType {
+					resp.AuditLogs = append(resp.AuditLogs, auditLog)
+				}
+			}
+			return resp, nil
+		}).Times(4)
+	m.domainAuditManager.EXPECT().CreateDomainAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateDomainAuditLogRequest) (*persistence.CreateDomainAuditLogResponse, error) {
+			assert.Equal(t, persistence.DomainAuditOperationTypeFailoverDryRun, request.OperationType)
+			assert.Equal(t, "dry run automatic failover: region/region2 from standby to active (drained by operator)", request.Comment)
+			assert.Equal(t, cluster.TestAlternativeClusterName, request.StateBefore.ReplicationConfig.ActiveClusters.AttributeScopes["region"].ClusterAttributes[testRegion2].ActiveClusterName)
+			assert.Equal(t, cluster.TestCurrentClusterName, request.StateAfter.ReplicationConfig.ActiveClusters.AttributeScopes["region"].ClusterAttributes[testRegion2].ActiveClusterName)
+			auditLogs = append(auditLogs, &persistence.DomainAuditLog{
+				EventID:       request.EventID,
+				DomainID:      request.DomainID,
+				StateBefore:   request.StateBefore,
+				StateAfter:    request.StateAfter,
+				OperationType: request.OperationType,
+				CreatedTime:   request.CreatedTime,
+				Comment:       request.Comment,
+			})
+			return &persistence.CreateDomainAuditLogResponse{EventID: request.EventID}, nil
+		})
+
+	// the decision is recorded in the domain audit log, so the cluster attribute is in cooldown in the next round
+	c.runRound(context.Background())
+	m.timeSource.Advance(time.Second)
+	c.runRound(context.Background())
+}
+
+func TestRunRound_Cooldown(t *testing.T) {
+	config := testConfig()
+	config.DrainedClusters = drained(cluster.TestAlternativeClusterName)
+	c, m := setupController(t, config, testNumShards)
+	expectFrontendsHealthy(m)
+	expectOutboundReplicationCaughtUp(t, m)
+	m.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		testDomainID: activeActiveDomain(map[string]string{
+			testRegion1: cluster.TestAlternativeClusterName,
+			testRegion2: cluster.TestAlternativeClusterName,
+		}),
+	})
+	// region2 was failed back to the drained cluster 30 seconds ago, by an operator or another controller
+	minCreatedTime := m.timeSource.Now().Add(-time.Minute)
+	gomock.InOrder(
+		m.domainAuditManager.EXPECT().GetDomainAuditLogs(gomock.Any(), &persistence.GetDomainAuditLogsRequest{
+			DomainID:       testDomainID,
+			OperationType:  persistence.DomainAuditOperationTypeFailover,
+			MinCreatedTime: &minCreatedTime,
+			PageSize:       auditLogPageSize,
+		}).Return(&persistence.GetDomainAuditLogsResponse{NextPageToken: []byte("next")}, nil),
+		m.domainAuditManager.EXPECT().GetDomainAuditLogs(gomock.Any(), &persistence.GetDomainAuditLogsRequest{
+			DomainID:       testDomainID,
+			OperationType:  persistence.DomainAuditOperationTypeFailover,
+			MinCreatedTime: &minCreatedTime,
+			PageSize:       auditLogPageSize,
+			NextPageToken:  []byte("next"),
+		}).Return(&persistence.GetDomainAuditLogsResponse{
+			AuditLogs: []*persistence.DomainAuditLog{
+				failoverAuditLog(testRegion2, cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName, m.timeSource.Now().Add(-30*time.Second)),
+			},
+		}, nil),
+	)
+	m.frontendClient.EXPECT().FailoverDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.FailoverDomainRequest, _ ...interface{}) (*types.FailoverDomainResponse, error) {
+			assert.Equal(t, map[string]types.ActiveClusterInfo{
+				testRegion1: {ActiveClusterName: cluster.TestCurrentClusterName},
+			}, request.ActiveClusters.AttributeScopes["region"].ClusterAttributes)
+			return &types.FailoverDomainResponse{}, nil
+		})
+
+	c.runRound(context.Background())
+}
+
+func TestRunRound_FailoverHistoryUnavailable(t *testing.T) {
+	config := testConfig()
+	config.DrainedClusters = drained(cluster.TestAlternativeClusterName)
+	c, m := setupController(t, config, testNumShards)
+	expectFrontendsHealthy(m)
+	expectOutboundReplicationCaughtUp(t, m)
+	m.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		testDomainID: activeActiveDomain(map[string]string{testRegion2: cluster.TestAlternativeClusterName}),
+	})
+	m.domainAuditManager.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+	// no failover without the cooldown
+	c.runRound(context.Background())
+}
+
+func TestRunRound_MaxFailoversPerRound(t *testing.T) {
+	config := testConfig()
+	config.DrainedClusters = drained(cluster.TestAlternativeClusterName)
+	config.MaxFailoversPerRound = dynamicproperties.GetIntPropertyFn(1)
+	c, m := setupController(t, config, testNumShards)
+	expectFrontendsHealthy(m)
+	expectOutboundReplicationCaughtUp(t, m)
+	expectFailoverHistory(m)
+	m.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		testDomainID: activeActiveDomain(map[string]string{
+			testRegion1: cluster.TestAlternativeClusterName,
+			testRegion2: cluster.TestAlternativeClusterName,
+		}),
+	})
+	m.frontendClient.EXPECT().FailoverDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.FailoverDomainRequest, _ ...interface{}) (*types.FailoverDomainResponse, error) {
+			assert.Equal(t, map[string]types.ActiveClusterInfo{
+				testRegion1: {ActiveClusterName: cluster.TestCurrentClusterName},
+			}, request.ActiveClusters.AttributeScopes["region"].ClusterAttributes)
+			return &types.FailoverDomainResponse{}, nil
+		})
+
+	c.runRound(context.Background())
+}
+
+func TestRunRound_FailoverFailed(t *testing.T) {
+	config := testConfig()
+	config.DrainedClusters = drained(cluster.TestAlternativeClusterName)
+	c, m := setupController(t, config, testNumShards)
+	expectFrontendsHealthy(m)
+	expectOutboundReplicationCaughtUp(t, m)
+	expectFailoverHistory(m)
+	m.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		testDomainID: activeActiveDomain(map[string]string{testRegion2: cluster.TestAlternativeClusterName}),
+	})
+	m.frontendClient.EXPECT().FailoverDomain(gomock.Any(), gomock.Any()).Return(nil, errors.New("failover failed"))
+
+	c.runRound(context.Background())
+}
+
+func TestRunRound_LeaderElection(t *testing.T) {
+	tests := []struct {
+		name            string
+		clusterMetadata cluster.Metadata
+		drained         []interface{}
+		unavailable     []string
+		witness         string
+		wantFailover    bool
+	}{
+		{
+			name:            "even split without the first cluster",
+			clusterMetadata: cluster.TestPassiveClusterMetadata,
+			unavailable:     []string{cluster.TestCurrentClusterName},
+		},
+		{
+			name:            "first cluster drained",
+			clusterMetadata: cluster.TestPassiveClusterMetadata,
+			drained:         []interface{}{cluster.TestCurrentClusterName},
+			wantFailover:    true,
+		},
+		{
+			name:            "current cluster drained",
+			clusterMetadata: cluster.TestActiveClusterMetadata,
+			drained:         []interface{}{cluster.TestCurrentClusterName},
+		},
+		{
+			name:            "majority leader",
+			clusterMetadata: threeClusterMetadata(cluster.TestAlternativeClusterName),
+			unavailable:     []string{cluster.TestCurrentClusterName},
+			wantFailover:    true,
+		},
+		{
+			name:            "majority follower",
+			clusterMetadata: threeClusterMetadata(testThirdClusterName),
+			unavailable:     []string{cluster.TestCurrentClusterName},
+		},
+		{
+			name:            "minority",
+			clusterMetadata: threeClusterMetadata(cluster.TestAlternativeClusterName),
+			unavailable:     []string{cluster.TestCurrentClusterName, testThirdClusterName},
+		},
+		{
+			name:            "witness breaks the tie",
+			clusterMetadata: threeClusterMetadata(cluster.TestAlternativeClusterName),
+			unavailable:     []string{cluster.TestCurrentClusterName},
+			witness:         testThirdClusterName,
+			wantFailover:    true,
+		},
+		{
+			name:            "witness unavailable",
+			clusterMetadata: threeClusterMetadata(cluster.TestAlternativeClusterName),
+			unavailable:     []string{cluster.TestCurrentClusterName, testThirdClusterName},
+			witness:         testThirdClusterName,
+		},
+		{
+			name:            "witness does not lead",
+			clusterMetadata: threeClusterMetadata(testThirdClusterName),
+			unavailable:     []string{cluster.TestCurrentClusterName},
+			witness:         testThirdClusterName,
+		},
+		{
+			name:            "witness drained",
+			clusterMetadata: threeClusterMetadata(cluster.TestAlternativeClusterName),
+			drained:         []interface{}{testThirdClusterName},
+			unavailable:     []string{cluster.TestCurrentClusterName},
+			witness:         testThirdClusterName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testConfig()
+			config.DrainedClusters = drained(tt.drained...)
+			config.WitnessCluster = dynamicproperties.GetStringPropertyFn(tt.witness)
+			c, m := setupControllerWithMetadata(t, config, 0, tt.clusterMetadata)
+			unavailable := make(map[string]bool)
+			for _, name := range tt.unavailable {
+				unavailable[name] = true
+			}
+			m.frontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{}, nil).AnyTimes()
+			for name, remoteClient := range m.remoteClients {
+				if unavailable[name] {
+					remoteClient.EXPECT().GetClusterInfo(gomock.Any()).Return(nil, errors.New("unavailable")).AnyTimes()
+				} else {
+					remoteClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{}, nil).AnyTimes()
+				}
+			}
+			expectFailoverHistory(m)
+			m.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+				testDomainID: activeActiveDomain(map[string]string{testRegion2: cluster.TestCurrentClusterName}),
+			}).AnyTimes()
+			if tt.wantFailover {
+				m.frontendClient.EXPECT().FailoverDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.FailoverDomainRequest, _ ...interface{}) (*types.FailoverDomainResponse, error) {
+						assert.Equal(t, map[string]types.ActiveClusterInfo{
+							testRegion2: {ActiveClusterName: cluster.TestAlternativeClusterName},
+						}, request.ActiveClusters.AttributeScopes["region"].ClusterAttributes)
+						return &types.FailoverDomainResponse{}, nil
+					})
+			}
+
+			c.runRound(context.Background())
+		})
+	}
+}
+
+func TestRunRound_NotOwner(t *testing.T) {
+	c, _ := setupController(t, testConfig(), 0)
+	c.hostInfo = membership.NewHostInfo("127.0.0.2:7939")
+
+	// no health check is run
+	c.runRound(context.Background())
+}
+
+func TestRunRound_SkipsDomains(t *testing.T) {
+	config := testConfig()
+	config.DrainedClusters = drained(cluster.TestAlternativeClusterName)
+	config.EnabledForDomain = func(domain string) bool { return domain != testDomainName }
+	c, m := setupController(t, config, 0)
+	expectFrontendsHealthy(m)
+	activePassive := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "active-passive-id", Name: "active-passive"},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestAlternativeClusterName},
+		1,
+	)
+	m.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		testDomainID:        activeActiveDomain(map[string]string{testRegion2: cluster.TestAlternativeClusterName}),
+		"active-passive-id": activePassive,
+	})
+
+	c.runRound(context.Background())
+}
+
+func TestStartStop(t *testing.T) {
+	c, _ := setupController(t, testConfig(), 0)
+	c.Start()
+	c.Stop()
+}
@@ -0,0 +1,237 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package failovercontroller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/activecluster"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	frontendProbeTimeout = 5 * time.Second
+)
+
+type (
+	// clusterHealth is the health of a cluster as seen from the current cluster
+	clusterHealth struct {
+		healthy bool
+		// drained is set when operators drained the cluster, which then takes no part in the leader election
+		drained bool
+		// reason explains why the cluster is unhealthy
+		reason string
+	}
+
+	// healthChecker combines the health signals of all the enabled clusters:
+	//   - the drain flag set by operators, which makes a cluster unhealthy right away
+	//   - the availability of the cluster's frontend
+	//   - the replication lag from the current cluster to the cluster, i.e. the age of the oldest
+	//     replication task the cluster has not acked yet, which the history service also emits
+	//     as the replication latency metric
+	// A cluster becomes unhealthy once its availability or replication lag check failed
+	// UnhealthyThreshold times in a row. The replication lag is read from the replication ack levels
+	// of the shards of the current cluster, so no remote cluster is involved in measuring it.
+	healthChecker struct {
+		currentCluster   string
+		clusterMetadata  cluster.Metadata
+		clientBean       client.Bean
+		shardManager     persistence.ShardManager
+		executionManager activecluster.ExecutionManagerProvider
+		numShards        int
+		config           *Config
+		timeSource       clock.TimeSource
+		logger           log.Logger
+
+		// failures is the number of consecutive failed checks of each cluster
+		failures map[string]int
+	}
+)
+
+func newHealthChecker(
+	clusterMetadata cluster.Metadata,
+	clientBean client.Bean,
+	shardManager persistence.ShardManager,
+	executionManager activecluster.ExecutionManagerProvider,
+	numShards int,
+	config *Config,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *healthChecker {
+	return &healthChecker{
+		currentCluster:   clusterMetadata.GetCurrentClusterName(),
+		clusterMetadata:  clusterMetadata,
+		clientBean:       clientBean,
+		shardManager:     shardManager,
+		executionManager: executionManager,
+		numShards:        numShards,
+		config:           config,
+		timeSource:       timeSource,
+		logger:           logger,
+		failures:         make(map[string]int),
+	}
+}
+
+// check returns the health of all the enabled clusters
+func (h *healthChecker) check(ctx context.Context) map[string]clusterHealth {
+	drained := make(map[string]bool)
+	for _, c := range h.config.DrainedClusters() {
+		if name, ok := c.(string); ok {
+			drained[name] = true
+		}
+	}
+
+	clusters := make([]string, 0)
+	for name := range h.clusterMetadata.GetEnabledClusterInfo() {
+		clusters = append(clusters, name)
+	}
+	sort.Strings(clusters)
+
+	replicationLags, err := h.replicationLags(ctx, clusters)
+	if err != nil {
+		// the replication lag is unknown, which should not count as a failed check for all the clusters
+		h.logger.Warn("Failed to measure the replication lag of remote clusters", tag.Error(err))
+	}
+
+	result := make(map[string]clusterHealth, len(clusters))
+	for _, name := range clusters {
+		if drained[name] {
+			result[name] = clusterHealth{drained: true, reason: "drained by operator"}
+			continue
+		}
+
+		failure := h.checkFrontend(ctx, name)
+		if lag, ok := replicationLags[name]; ok && failure == "" && lag > h.config.MaxReplicationLag() {
+			failure = fmt.Sprintf("replication lag %v exceeds %v", lag, h.config.MaxReplicationLag())
+		}
+
+		if failure == "" {
+			h.failures[name] = 0
+			result[name] = clusterHealth{healthy: true}
+			continue
+		}
+
+		h.failures[name]++
+		h.logger.Info("Cluster health check failed",
+			tag.ClusterName(name),
+			tag.Counter(h.failures[name]),
+			tag.Reason(failure),
+		)
+		if h.failures[name] < h.config.UnhealthyThreshold() {
+			result[name] = clusterHealth{healthy: true}
+			continue
+		}
+		result[name] = clusterHealth{reason: failure}
+	}
+	return result
+}
+
+// checkFrontend returns why the frontend of the cluster is not available, or an empty string if it is
+func (h *healthChecker) checkFrontend(ctx context.Context, clusterName string) string {
+	frontendClient := h.clientBean.GetFrontendClient()
+	if clusterName != h.currentCluster {
+		var err error
+		frontendClient, err = h.clientBean.GetRemoteFrontendClient(clusterName)
+		if err != nil {
+			return fmt.Sprintf("frontend client not available: %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, frontendProbeTimeout)
+	defer cancel()
+	if _, err := frontendClient.GetClusterInfo(ctx); err != nil {
+		return fmt.Sprintf("frontend not available: %v", err)
+	}
+	return ""
+}
+
+// sampledShards returns the history shards sampled to measure the replication lag
+func (h *healthChecker) sampledShards() []int {
+	if h.numShards <= 0 {
+		return nil
+	}
+
+	sampleSize := h.config.ReplicationLagShardSampleSize()
+	if sampleSize <= 0 || sampleSize > h.numShards {
+		sampleSize = h.numShards
+	}
+	shardIDs := make([]int, 0, sampleSize)
+	for i := 0; i < sampleSize; i++ {
+		shardIDs = append(shardIDs, i*h.numShards/sampleSize)
+	}
+	return shardIDs
+}
+
+// replicationLags returns the max replication lag from the current cluster to each remote cluster,
+// over a sample of the history shards
+func (h *healthChecker) replicationLags(ctx context.Context, clusters []string) (map[string]time.Duration, error) {
+	lags := make(map[string]time.Duration)
+	now := h.timeSource.Now()
+	for _, shardID := range h.sampledShards() {
+		resp, err := h.shardManager.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+		if err != nil {
+			return nil, err
+		}
+		executionManager, err := h.executionManager.GetExecutionManager(shardID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range clusters {
+			if name == h.currentCluster {
+				continue
+			}
+			ackLevel, ok := resp.ShardInfo.ClusterReplicationLevel[name]
+			if !ok {
+				ackLevel = -1
+			}
+			tasks, err := executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+				TaskCategory:        persistence.HistoryTaskCategoryReplication,
+				InclusiveMinTaskKey: persistence.NewImmediateTaskKey(ackLevel + 1),
+				ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(math.MaxInt64),
+				PageSize:            1,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			var lag time.Duration
+			if len(tasks.Tasks) > 0 {
+				lag = now.Sub(tasks.Tasks[0].GetVisibilityTimestamp())
+			}
+			if maxLag, ok := lags[name]; !ok || lag > maxLag {
+				lags[name] = lag
+			}
+		}
+	}
+	return lags, nil
+}
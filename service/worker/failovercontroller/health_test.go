@@ -0,0 +1,143 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package failovercontroller
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestHealthCheck_Drained(t *testing.T) {
+	config := testConfig()
+	config.DrainedClusters = drained(cluster.TestAlternativeClusterName)
+	c, m := setupController(t, config, 0)
+	expectFrontendsHealthy(m)
+
+	health := c.health.check(context.Background())
+	assert.Equal(t, map[string]clusterHealth{
+		cluster.TestCurrentClusterName:     {healthy: true},
+		cluster.TestAlternativeClusterName: {drained: true, reason: "drained by operator"},
+	}, health)
+}
+
+func TestHealthCheck_FrontendUnavailable(t *testing.T) {
+	config := testConfig()
+	config.UnhealthyThreshold = dynamicproperties.GetIntPropertyFn(2)
+	c, m := setupController(t, config, 0)
+	m.frontendClient.EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{}, nil).AnyTimes()
+	gomock.InOrder(
+		m.remoteClients[cluster.TestAlternativeClusterName].EXPECT().GetClusterInfo(gomock.Any()).Return(nil, errors.New("unavailable")).Times(2),
+		m.remoteClients[cluster.TestAlternativeClusterName].EXPECT().GetClusterInfo(gomock.Any()).Return(&types.ClusterInfo{}, nil),
+		m.remoteClients[cluster.TestAlternativeClusterName].EXPECT().GetClusterInfo(gomock.Any()).Return(nil, errors.New("unavailable")),
+	)
+
+	// the first failure is below the threshold
+	health := c.health.check(context.Background())
+	assert.True(t, health[cluster.TestAlternativeClusterName].healthy)
+
+	health = c.health.check(context.Background())
+	assert.False(t, health[cluster.TestAlternativeClusterName].healthy)
+	assert.Contains(t, health[cluster.TestAlternativeClusterName].reason, "frontend not available")
+
+	// a successful check resets the failures
+	health = c.health.check(context.Background())
+	assert.True(t, health[cluster.TestAlternativeClusterName].healthy)
+	health = c.health.check(context.Background())
+	assert.True(t, health[cluster.TestAlternativeClusterName].healthy)
+	assert.True(t, health[cluster.TestCurrentClusterName].healthy)
+}
+
+func TestHealthCheck_ReplicationLag(t *testing.T) {
+	tests := []struct {
+		name        string
+		taskAge     time.Duration
+		wantHealthy bool
+	}{
+		{
+			name:        "lag below max",
+			taskAge:     30 * time.Second,
+			wantHealthy: true,
+		},
+		{
+			name:        "lag above max",
+			taskAge:     2 * time.Minute,
+			wantHealthy: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, m := setupController(t, testConfig(), 4)
+			expectFrontendsHealthy(m)
+			executionManager := persistence.NewMockExecutionManager(gomock.NewController(t))
+			now := m.timeSource.Now()
+
+			// shards 0 and 2 are sampled
+			for _, shardID := range []int{0, 2} {
+				m.shardManager.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: shardID}).Return(&persistence.GetShardResponse{
+					ShardInfo: &persistence.ShardInfo{
+						ShardID:                 shardID,
+						ClusterReplicationLevel: map[string]int64{cluster.TestAlternativeClusterName: 10},
+					},
+				}, nil)
+				m.executionProvider.EXPECT().GetExecutionManager(shardID).Return(executionManager, nil)
+			}
+			executionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+				TaskCategory:        persistence.HistoryTaskCategoryReplication,
+				InclusiveMinTaskKey: persistence.NewImmediateTaskKey(11),
+				ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(math.MaxInt64),
+				PageSize:            1,
+			}).Return(&persistence.GetHistoryTasksResponse{}, nil)
+			executionManager.EXPECT().GetHistoryTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTasksResponse{
+				Tasks: []persistence.Task{
+					&persistence.HistoryReplicationTask{
+						TaskData: persistence.TaskData{VisibilityTimestamp: now.Add(-tt.taskAge)},
+					},
+				},
+			}, nil)
+
+			health := c.health.check(context.Background())
+			assert.True(t, health[cluster.TestCurrentClusterName].healthy)
+			assert.Equal(t, tt.wantHealthy, health[cluster.TestAlternativeClusterName].healthy)
+		})
+	}
+}
+
+func TestHealthCheck_ReplicationLagUnknown(t *testing.T) {
+	c, m := setupController(t, testConfig(), 4)
+	expectFrontendsHealthy(m)
+	m.shardManager.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(nil, errors.New("shard not found"))
+
+	health := c.health.check(context.Background())
+	assert.True(t, health[cluster.TestAlternativeClusterName].healthy)
+}
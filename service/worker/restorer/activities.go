@@ -0,0 +1,318 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package restorer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+// RestoreActivity re-hydrates an archived workflow. The archived history is
+// validated and then applied batch by batch through ReplicateEventsV2, which
+// recreates the history branch and the closed execution record and schedules
+// the transfer tasks re-indexing visibility. Replication is idempotent, so the
+// activity can safely be retried; the number of applied batches is recorded
+// in the heartbeat details to skip them on retries.
+func (r *Restorer) RestoreActivity(ctx context.Context, params Params) (*Result, error) {
+	if err := r.checkPermission(params.SecurityToken); err != nil {
+		return nil, err
+	}
+
+	domainEntry, err := r.getDomain(params.DomainName)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	// batches applied by previous attempts
+	applied := 0
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &applied); err != nil {
+			applied = 0
+		}
+	}
+
+	batches, err := r.getArchivedHistory(ctx, domainEntry, params, applied)
+	if err != nil {
+		return nil, err
+	}
+	versionHistoryItems, err := r.validateHistory(batches)
+	if err != nil {
+		return nil, cadence.NewCustomError(ErrInvalidHistoryNonRetryable, err.Error())
+	}
+
+	historyClient := r.clientBean.GetHistoryClient()
+	for i := applied; i < len(batches); i++ {
+		blob, err := r.serializer.SerializeBatchEvents(batches[i].Events, constants.EncodingTypeThriftRW)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize history batch: %v", err)
+		}
+		err = historyClient.ReplicateEventsV2(ctx, &types.ReplicateEventsV2Request{
+			DomainUUID: domainID,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: params.WorkflowID,
+				RunID:      params.RunID,
+			},
+			VersionHistoryItems: versionHistoryItems,
+			Events:              blob.ToInternal(),
+			Restored:            true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to replicate history batch %d: %v", i, err)
+		}
+		activity.RecordHeartbeat(ctx, i+1)
+	}
+
+	lastEvents := batches[len(batches)-1].Events
+	r.logger.Info("Restored archived workflow",
+		tag.WorkflowDomainName(params.DomainName),
+		tag.WorkflowID(params.WorkflowID),
+		tag.WorkflowRunID(params.RunID),
+		tag.Counter(len(batches)),
+	)
+	return &Result{
+		DomainID:    domainID,
+		WorkflowID:  params.WorkflowID,
+		RunID:       params.RunID,
+		LastEventID: lastEvents[len(lastEvents)-1].ID,
+		Batches:     len(batches),
+	}, nil
+}
+
+// DeleteActivity deletes the archived history and visibility records of a workflow run
+// from the archives configured for its domain. Deleting from an archive is idempotent,
+// so the activity can safely be retried.
+func (r *Restorer) DeleteActivity(ctx context.Context, params Params) (*DeleteResult, error) {
+	if err := r.checkPermission(params.SecurityToken); err != nil {
+		return nil, err
+	}
+
+	domainEntry, err := r.getDomain(params.DomainName)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+	historyURI := domainEntry.GetConfig().HistoryArchivalURI
+	visibilityURI := domainEntry.GetConfig().VisibilityArchivalURI
+	if historyURI == "" && visibilityURI == "" {
+		return nil, cadence.NewCustomError(ErrDomainNotArchivedNonRetryable)
+	}
+
+	result := &DeleteResult{
+		DomainID:   domainID,
+		WorkflowID: params.WorkflowID,
+		RunID:      params.RunID,
+	}
+	if historyURI != "" {
+		URI, err := archiver.NewURI(historyURI)
+		if err != nil {
+			return nil, cadence.NewCustomError(ErrDomainNotArchivedNonRetryable, err.Error())
+		}
+		historyArchiver, err := r.archiverProvider.GetHistoryArchiver(URI.Scheme(), service.Worker)
+		if err != nil {
+			return nil, cadence.NewCustomError(ErrDomainNotArchivedNonRetryable, err.Error())
+		}
+		err = historyArchiver.Delete(ctx, URI, &archiver.DeleteHistoryRequest{
+			DomainID:   domainID,
+			WorkflowID: params.WorkflowID,
+			RunID:      params.RunID,
+		})
+		if err != nil {
+			return nil, toDeleteError("history", err)
+		}
+		result.HistoryDeleted = true
+	}
+	if visibilityURI != "" {
+		URI, err := archiver.NewURI(visibilityURI)
+		if err != nil {
+			return nil, cadence.NewCustomError(ErrDomainNotArchivedNonRetryable, err.Error())
+		}
+		visibilityArchiver, err := r.archiverProvider.GetVisibilityArchiver(URI.Scheme(), service.Worker)
+		if err != nil {
+			return nil, cadence.NewCustomError(ErrDomainNotArchivedNonRetryable, err.Error())
+		}
+		err = visibilityArchiver.Delete(ctx, URI, &archiver.DeleteVisibilityRequest{
+			DomainID:   domainID,
+			WorkflowID: params.WorkflowID,
+			RunID:      params.RunID,
+		})
+		if err != nil {
+			return nil, toDeleteError("visibility", err)
+		}
+		result.VisibilityDeleted = true
+	}
+
+	r.logger.Info("Deleted archived workflow",
+		tag.WorkflowDomainName(params.DomainName),
+		tag.WorkflowID(params.WorkflowID),
+		tag.WorkflowRunID(params.RunID),
+	)
+	return result, nil
+}
+
+func toDeleteError(archive string, err error) error {
+	var badRequestError *types.BadRequestError
+	if errors.As(err, &badRequestError) {
+		return cadence.NewCustomError(ErrInvalidDeleteRequestNonRetryable, err.Error())
+	}
+	return fmt.Errorf("failed to delete archived %s: %v", archive, err)
+}
+
+func (r *Restorer) getDomain(domainName string) (*cache.DomainCacheEntry, error) {
+	domainEntry, err := r.domainCache.GetDomain(domainName)
+	if err != nil {
+		var entityNotExistsError *types.EntityNotExistsError
+		if errors.As(err, &entityNotExistsError) {
+			return nil, cadence.NewCustomError(ErrDomainDoesNotExistNonRetryable)
+		}
+		return nil, fmt.Errorf("failed to get domain: %v", err)
+	}
+	return domainEntry, nil
+}
+
+func (r *Restorer) checkPermission(securityToken string) error {
+	if r.cfg.EnableAdminProtection != nil && r.cfg.EnableAdminProtection() {
+		if securityToken == "" || securityToken != r.cfg.AdminOperationToken() {
+			return cadence.NewCustomError(ErrAccessDeniedNonRetryable)
+		}
+	}
+	return nil
+}
+
+func (r *Restorer) getArchivedHistory(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	params Params,
+	applied int,
+) ([]*types.History, error) {
+	URIString := domainEntry.GetConfig().HistoryArchivalURI
+	if URIString == "" {
+		return nil, cadence.NewCustomError(ErrArchivalNotConfiguredNonRetryable)
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, cadence.NewCustomError(ErrArchivalNotConfiguredNonRetryable, err.Error())
+	}
+	historyArchiver, err := r.archiverProvider.GetHistoryArchiver(URI.Scheme(), service.Worker)
+	if err != nil {
+		return nil, cadence.NewCustomError(ErrArchivalNotConfiguredNonRetryable, err.Error())
+	}
+
+	var batches []*types.History
+	request := &archiver.GetHistoryRequest{
+		DomainID:   domainEntry.GetInfo().ID,
+		WorkflowID: params.WorkflowID,
+		RunID:      params.RunID,
+		PageSize:   historyPageSize,
+	}
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			var entityNotExistsError *types.EntityNotExistsError
+			if errors.As(err, &entityNotExistsError) {
+				return nil, cadence.NewCustomError(ErrHistoryNotFoundNonRetryable, err.Error())
+			}
+			return nil, fmt.Errorf("failed to read archived history: %v", err)
+		}
+		for _, batch := range resp.HistoryBatches {
+			if len(batch.GetEvents()) > 0 {
+				batches = append(batches, batch)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, applied)
+	}
+	if len(batches) == 0 {
+		return nil, cadence.NewCustomError(ErrHistoryNotFoundNonRetryable)
+	}
+	return batches, nil
+}
+
+// validateHistory checks that the archived history is a complete run of a closed
+// workflow and returns the version history items of its branch
+func (r *Restorer) validateHistory(batches []*types.History) ([]*types.VersionHistoryItem, error) {
+	var items []*types.VersionHistoryItem
+	var lastEvent *types.HistoryEvent
+	for _, batch := range batches {
+		for _, event := range batch.Events {
+			expectedID := constants.FirstEventID
+			if lastEvent != nil {
+				expectedID = lastEvent.ID + 1
+			}
+			if event.ID != expectedID {
+				return nil, fmt.Errorf("expected event ID %d but got %d", expectedID, event.ID)
+			}
+			if lastEvent == nil && event.GetEventType() != types.EventTypeWorkflowExecutionStarted {
+				return nil, fmt.Errorf("first event is %v instead of %v", event.GetEventType(), types.EventTypeWorkflowExecutionStarted)
+			}
+			if event.Version < 0 && event.Version != constants.EmptyVersion {
+				return nil, fmt.Errorf("event %d has invalid version %d", event.ID, event.Version)
+			}
+
+			if len(items) > 0 && items[len(items)-1].Version == event.Version {
+				items[len(items)-1].EventID = event.ID
+			} else {
+				if len(items) > 0 && items[len(items)-1].Version > event.Version {
+					return nil, fmt.Errorf("event %d version %d is lower than the previous version %d",
+						event.ID, event.Version, items[len(items)-1].Version)
+				}
+				if _, err := r.clusterMetadata.ClusterNameForFailoverVersion(event.Version); err != nil {
+					return nil, fmt.Errorf("event %d: %v", event.ID, err)
+				}
+				items = append(items, &types.VersionHistoryItem{EventID: event.ID, Version: event.Version})
+			}
+			lastEvent = event
+		}
+	}
+	if lastEvent == nil || !isWorkflowCloseEvent(lastEvent.GetEventType()) {
+		return nil, errors.New("archived history does not end with a workflow close event")
+	}
+	return items, nil
+}
+
+func isWorkflowCloseEvent(eventType types.EventType) bool {
+	switch eventType {
+	case types.EventTypeWorkflowExecutionCompleted,
+		types.EventTypeWorkflowExecutionFailed,
+		types.EventTypeWorkflowExecutionTimedOut,
+		types.EventTypeWorkflowExecutionCanceled,
+		types.EventTypeWorkflowExecutionTerminated,
+		types.EventTypeWorkflowExecutionContinuedAsNew:
+		return true
+	}
+	return false
+}
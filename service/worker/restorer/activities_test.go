@@ -0,0 +1,502 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package restorer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain"
+	testWorkflowID = "test-workflow-id"
+	testRunID      = "test-run-id"
+	testURI        = "file:///tmp/cadence_archival/development"
+)
+
+type restorerMocks struct {
+	domainCache        *cache.MockDomainCache
+	archiverProvider   *provider.MockArchiverProvider
+	historyArchiver    *archiver.HistoryArchiverMock
+	visibilityArchiver *archiver.VisibilityArchiverMock
+	historyClient      *history.MockClient
+}
+
+func setupRestorer(t *testing.T, protected bool) (*Restorer, *restorerMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := &restorerMocks{
+		domainCache:        cache.NewMockDomainCache(ctrl),
+		archiverProvider:   provider.NewMockArchiverProvider(ctrl),
+		historyArchiver:    &archiver.HistoryArchiverMock{},
+		visibilityArchiver: &archiver.VisibilityArchiverMock{},
+		historyClient:      history.NewMockClient(ctrl),
+	}
+	t.Cleanup(func() {
+		mocks.historyArchiver.AssertExpectations(t)
+		mocks.visibilityArchiver.AssertExpectations(t)
+	})
+	clientBean := client.NewMockBean(ctrl)
+	clientBean.EXPECT().GetHistoryClient().Return(mocks.historyClient).AnyTimes()
+
+	r := New(&BootstrapParams{
+		Config: Config{
+			EnableAdminProtection: dynamicproperties.GetBoolPropertyFn(protected),
+			AdminOperationToken:   dynamicproperties.GetStringPropertyFn("admin-token"),
+		},
+		ClientBean:       clientBean,
+		DomainCache:      mocks.domainCache,
+		ArchiverProvider: mocks.archiverProvider,
+		ClusterMetadata:  cluster.GetTestClusterMetadata(true),
+		Logger:           testlogger.New(t),
+	})
+	return r, mocks
+}
+
+func testDomainEntry(historyArchivalURI, visibilityArchivalURI string) *cache.DomainCacheEntry {
+	return cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID, Name: testDomainName},
+		&persistence.DomainConfig{
+			Retention:                1,
+			HistoryArchivalStatus:    types.ArchivalStatusEnabled,
+			HistoryArchivalURI:       historyArchivalURI,
+			VisibilityArchivalStatus: types.ArchivalStatusEnabled,
+			VisibilityArchivalURI:    visibilityArchivalURI,
+		},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		cluster.TestCurrentClusterInitialFailoverVersion,
+	)
+}
+
+func testEvent(eventID, version int64, eventType types.EventType) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID:        eventID,
+		Version:   version,
+		EventType: eventType.Ptr(),
+		Timestamp: common.Int64Ptr(eventID),
+	}
+}
+
+func testHistory() []*types.History {
+	return []*types.History{
+		{Events: []*types.HistoryEvent{
+			testEvent(1, 0, types.EventTypeWorkflowExecutionStarted),
+			testEvent(2, 0, types.EventTypeDecisionTaskScheduled),
+		}},
+		{Events: []*types.HistoryEvent{
+			testEvent(3, 0, types.EventTypeDecisionTaskStarted),
+		}},
+		{Events: []*types.HistoryEvent{
+			testEvent(4, 1, types.EventTypeDecisionTaskCompleted),
+			testEvent(5, 1, types.EventTypeWorkflowExecutionCompleted),
+		}},
+	}
+}
+
+func executeRestoreActivity(t *testing.T, r *Restorer, params Params, heartbeatDetails interface{}) (*Result, error) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(r.RestoreActivity, activity.RegisterOptions{Name: restoreActivityName})
+	if heartbeatDetails != nil {
+		env.SetHeartbeatDetails(heartbeatDetails)
+	}
+	value, err := env.ExecuteActivity(restoreActivityName, params)
+	if err != nil {
+		return nil, err
+	}
+	var result Result
+	require.NoError(t, value.Get(&result))
+	return &result, nil
+}
+
+func TestRestoreActivity(t *testing.T) {
+	params := Params{
+		DomainName:    testDomainName,
+		WorkflowID:    testWorkflowID,
+		RunID:         testRunID,
+		SecurityToken: "admin-token",
+	}
+
+	tests := map[string]struct {
+		params           Params
+		protected        bool
+		heartbeatDetails interface{}
+		setupMocks       func(m *restorerMocks)
+		expectedResult   *Result
+		expectedReason   string
+		expectedErr      bool
+	}{
+		"success": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry(testURI, ""), nil)
+				m.archiverProvider.EXPECT().GetHistoryArchiver("file", service.Worker).Return(m.historyArchiver, nil)
+				m.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(req *archiver.GetHistoryRequest) bool {
+					return req.NextPageToken == nil && req.DomainID == testDomainID && req.PageSize == historyPageSize
+				})).Return(&archiver.GetHistoryResponse{HistoryBatches: testHistory()[:2], NextPageToken: []byte("token")}, nil).Once()
+				m.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(req *archiver.GetHistoryRequest) bool {
+					return string(req.NextPageToken) == "token"
+				})).Return(&archiver.GetHistoryResponse{HistoryBatches: testHistory()[2:]}, nil).Once()
+				m.historyClient.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, req *types.ReplicateEventsV2Request, _ ...interface{}) error {
+						require.Equal(t, testDomainID, req.DomainUUID)
+						require.Equal(t, testWorkflowID, req.WorkflowExecution.WorkflowID)
+						require.Equal(t, testRunID, req.WorkflowExecution.RunID)
+						require.Equal(t, []*types.VersionHistoryItem{
+							{EventID: 3, Version: 0},
+							{EventID: 5, Version: 1},
+						}, req.VersionHistoryItems)
+						require.NotEmpty(t, req.Events.Data)
+						require.True(t, req.Restored)
+						return nil
+					}).Times(3)
+			},
+			expectedResult: &Result{
+				DomainID:    testDomainID,
+				WorkflowID:  testWorkflowID,
+				RunID:       testRunID,
+				LastEventID: 5,
+				Batches:     3,
+			},
+		},
+		"resume from heartbeat": {
+			params:           params,
+			heartbeatDetails: 2,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry(testURI, ""), nil)
+				m.archiverProvider.EXPECT().GetHistoryArchiver("file", service.Worker).Return(m.historyArchiver, nil)
+				m.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).
+					Return(&archiver.GetHistoryResponse{HistoryBatches: testHistory()}, nil).Once()
+				m.historyClient.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedResult: &Result{
+				DomainID:    testDomainID,
+				WorkflowID:  testWorkflowID,
+				RunID:       testRunID,
+				LastEventID: 5,
+				Batches:     3,
+			},
+		},
+		"access denied": {
+			params:         Params{DomainName: testDomainName, WorkflowID: testWorkflowID, RunID: testRunID, SecurityToken: "wrong"},
+			protected:      true,
+			setupMocks:     func(m *restorerMocks) {},
+			expectedReason: ErrAccessDeniedNonRetryable,
+		},
+		"domain does not exist": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(nil, &types.EntityNotExistsError{})
+			},
+			expectedReason: ErrDomainDoesNotExistNonRetryable,
+		},
+		"archival not configured": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry("", ""), nil)
+			},
+			expectedReason: ErrArchivalNotConfiguredNonRetryable,
+		},
+		"history not found": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry(testURI, ""), nil)
+				m.archiverProvider.EXPECT().GetHistoryArchiver("file", service.Worker).Return(m.historyArchiver, nil)
+				m.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, &types.EntityNotExistsError{}).Once()
+			},
+			expectedReason: ErrHistoryNotFoundNonRetryable,
+		},
+		"invalid history": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry(testURI, ""), nil)
+				m.archiverProvider.EXPECT().GetHistoryArchiver("file", service.Worker).Return(m.historyArchiver, nil)
+				m.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).
+					Return(&archiver.GetHistoryResponse{HistoryBatches: testHistory()[:2]}, nil).Once()
+			},
+			expectedReason: ErrInvalidHistoryNonRetryable,
+		},
+		"replication fails": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry(testURI, ""), nil)
+				m.archiverProvider.EXPECT().GetHistoryArchiver("file", service.Worker).Return(m.historyArchiver, nil)
+				m.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).
+					Return(&archiver.GetHistoryResponse{HistoryBatches: testHistory()}, nil).Once()
+				m.historyClient.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).Return(errors.New("replication failed"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, mocks := setupRestorer(t, tc.protected)
+			tc.setupMocks(mocks)
+
+			result, err := executeRestoreActivity(t, r, tc.params, tc.heartbeatDetails)
+			switch {
+			case tc.expectedReason != "":
+				var customErr *cadence.CustomError
+				require.ErrorAs(t, err, &customErr)
+				require.Equal(t, tc.expectedReason, customErr.Reason())
+			case tc.expectedErr:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func executeDeleteActivity(t *testing.T, r *Restorer, params Params) (*DeleteResult, error) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(r.DeleteActivity, activity.RegisterOptions{Name: deleteActivityName})
+	value, err := env.ExecuteActivity(deleteActivityName, params)
+	if err != nil {
+		return nil, err
+	}
+	var result DeleteResult
+	require.NoError(t, value.Get(&result))
+	return &result, nil
+}
+
+func TestDeleteActivity(t *testing.T) {
+	params := Params{
+		DomainName:    testDomainName,
+		WorkflowID:    testWorkflowID,
+		RunID:         testRunID,
+		SecurityToken: "admin-token",
+	}
+	expectedHistoryRequest := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	expectedVisibilityRequest := &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+
+	tests := map[string]struct {
+		params         Params
+		protected      bool
+		setupMocks     func(m *restorerMocks)
+		expectedResult *DeleteResult
+		expectedReason string
+		expectedErr    bool
+	}{
+		"success": {
+			params:    params,
+			protected: true,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry(testURI, testURI), nil)
+				m.archiverProvider.EXPECT().GetHistoryArchiver("file", service.Worker).Return(m.historyArchiver, nil)
+				m.archiverProvider.EXPECT().GetVisibilityArchiver("file", service.Worker).Return(m.visibilityArchiver, nil)
+				m.historyArchiver.On("Delete", mock.Anything, mock.Anything, expectedHistoryRequest).Return(nil).Once()
+				m.visibilityArchiver.On("Delete", mock.Anything, mock.Anything, expectedVisibilityRequest).Return(nil).Once()
+			},
+			expectedResult: &DeleteResult{
+				DomainID:          testDomainID,
+				WorkflowID:        testWorkflowID,
+				RunID:             testRunID,
+				HistoryDeleted:    true,
+				VisibilityDeleted: true,
+			},
+		},
+		"visibility archival not configured": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry(testURI, ""), nil)
+				m.archiverProvider.EXPECT().GetHistoryArchiver("file", service.Worker).Return(m.historyArchiver, nil)
+				m.historyArchiver.On("Delete", mock.Anything, mock.Anything, expectedHistoryRequest).Return(nil).Once()
+			},
+			expectedResult: &DeleteResult{
+				DomainID:       testDomainID,
+				WorkflowID:     testWorkflowID,
+				RunID:          testRunID,
+				HistoryDeleted: true,
+			},
+		},
+		"access denied": {
+			params:         Params{DomainName: testDomainName, WorkflowID: testWorkflowID, RunID: testRunID, SecurityToken: "wrong"},
+			protected:      true,
+			setupMocks:     func(m *restorerMocks) {},
+			expectedReason: ErrAccessDeniedNonRetryable,
+		},
+		"domain does not exist": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(nil, &types.EntityNotExistsError{})
+			},
+			expectedReason: ErrDomainDoesNotExistNonRetryable,
+		},
+		"archival not configured": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry("", ""), nil)
+			},
+			expectedReason: ErrDomainNotArchivedNonRetryable,
+		},
+		"invalid delete request": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry(testURI, ""), nil)
+				m.archiverProvider.EXPECT().GetHistoryArchiver("file", service.Worker).Return(m.historyArchiver, nil)
+				m.historyArchiver.On("Delete", mock.Anything, mock.Anything, mock.Anything).
+					Return(&types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}).Once()
+			},
+			expectedReason: ErrInvalidDeleteRequestNonRetryable,
+		},
+		"visibility delete fails": {
+			params: params,
+			setupMocks: func(m *restorerMocks) {
+				m.domainCache.EXPECT().GetDomain(testDomainName).Return(testDomainEntry(testURI, testURI), nil)
+				m.archiverProvider.EXPECT().GetHistoryArchiver("file", service.Worker).Return(m.historyArchiver, nil)
+				m.archiverProvider.EXPECT().GetVisibilityArchiver("file", service.Worker).Return(m.visibilityArchiver, nil)
+				m.historyArchiver.On("Delete", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				m.visibilityArchiver.On("Delete", mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("delete failed")).Once()
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, mocks := setupRestorer(t, tc.protected)
+			tc.setupMocks(mocks)
+
+			result, err := executeDeleteActivity(t, r, tc.params)
+			switch {
+			case tc.expectedReason != "":
+				var customErr *cadence.CustomError
+				require.ErrorAs(t, err, &customErr)
+				require.Equal(t, tc.expectedReason, customErr.Reason())
+			case tc.expectedErr:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestValidateHistory(t *testing.T) {
+	r, _ := setupRestorer(t, false)
+
+	tests := map[string]struct {
+		batches     []*types.History
+		expected    []*types.VersionHistoryItem
+		expectedErr bool
+	}{
+		"valid": {
+			batches: testHistory(),
+			expected: []*types.VersionHistoryItem{
+				{EventID: 3, Version: 0},
+				{EventID: 5, Version: 1},
+			},
+		},
+		"empty": {
+			expectedErr: true,
+		},
+		"missing start event": {
+			batches: []*types.History{{Events: []*types.HistoryEvent{
+				testEvent(1, 0, types.EventTypeDecisionTaskScheduled),
+				testEvent(2, 0, types.EventTypeWorkflowExecutionCompleted),
+			}}},
+			expectedErr: true,
+		},
+		"gap in event IDs": {
+			batches: []*types.History{{Events: []*types.HistoryEvent{
+				testEvent(1, 0, types.EventTypeWorkflowExecutionStarted),
+				testEvent(3, 0, types.EventTypeWorkflowExecutionCompleted),
+			}}},
+			expectedErr: true,
+		},
+		"decreasing version": {
+			batches: []*types.History{{Events: []*types.HistoryEvent{
+				testEvent(1, 11, types.EventTypeWorkflowExecutionStarted),
+				testEvent(2, 1, types.EventTypeWorkflowExecutionCompleted),
+			}}},
+			expectedErr: true,
+		},
+		"unknown cluster version": {
+			batches: []*types.History{{Events: []*types.HistoryEvent{
+				testEvent(1, 3, types.EventTypeWorkflowExecutionStarted),
+				testEvent(2, 3, types.EventTypeWorkflowExecutionCompleted),
+			}}},
+			expectedErr: true,
+		},
+		"open workflow": {
+			batches: []*types.History{{Events: []*types.HistoryEvent{
+				testEvent(1, 0, types.EventTypeWorkflowExecutionStarted),
+				testEvent(2, 0, types.EventTypeDecisionTaskScheduled),
+			}}},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			items, err := r.validateHistory(tc.batches)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, items)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package restorer
+
+import (
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// Config defines the configuration for the archive restorer
+	Config struct {
+		// EnableAdminProtection is whether restores require the admin operation token
+		EnableAdminProtection dynamicproperties.BoolPropertyFn
+		// AdminOperationToken is the security token for admin operations
+		AdminOperationToken dynamicproperties.StringPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the archive restorer
+	BootstrapParams struct {
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean       client.Bean
+		DomainCache      cache.DomainCache
+		ArchiverProvider provider.ArchiverProvider
+		ClusterMetadata  cluster.Metadata
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		Logger     log.Logger
+	}
+
+	// Restorer runs the workflows re-hydrating archived histories into live persistence
+	// and deleting archived workflows
+	Restorer struct {
+		cfg              Config
+		svcClient        workflowserviceclient.Interface
+		clientBean       client.Bean
+		domainCache      cache.DomainCache
+		archiverProvider provider.ArchiverProvider
+		clusterMetadata  cluster.Metadata
+		serializer       persistence.PayloadSerializer
+		metricsClient    metrics.Client
+		tallyScope       tally.Scope
+		logger           log.Logger
+		worker           worker.Worker
+	}
+)
+
+// New returns a new instance of Restorer
+func New(params *BootstrapParams) *Restorer {
+	return &Restorer{
+		cfg:              params.Config,
+		svcClient:        params.ServiceClient,
+		clientBean:       params.ClientBean,
+		domainCache:      params.DomainCache,
+		archiverProvider: params.ArchiverProvider,
+		clusterMetadata:  params.ClusterMetadata,
+		serializer:       persistence.NewPayloadSerializer(),
+		metricsClient:    params.MetricsClient,
+		tallyScope:       params.TallyScope,
+		logger:           params.Logger.WithTags(tag.ComponentArchiveRestorer),
+	}
+}
+
+// Start starts the worker
+func (r *Restorer) Start() error {
+	workerOpts := worker.Options{
+		MetricsScope: r.tallyScope,
+		Tracer:       opentracing.GlobalTracer(),
+	}
+	restorerWorker := worker.New(r.svcClient, constants.SystemLocalDomainName, TaskListName, workerOpts)
+	restorerWorker.RegisterWorkflowWithOptions(RestoreWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	restorerWorker.RegisterActivityWithOptions(r.RestoreActivity, activity.RegisterOptions{Name: restoreActivityName})
+	restorerWorker.RegisterWorkflowWithOptions(DeleteWorkflow, workflow.RegisterOptions{Name: DeleteWorkflowTypeName})
+	restorerWorker.RegisterActivityWithOptions(r.DeleteActivity, activity.RegisterOptions{Name: deleteActivityName})
+	r.worker = restorerWorker
+	return restorerWorker.Start()
+}
+
+// Stop stops the worker
+func (r *Restorer) Stop() {
+	r.worker.Stop()
+}
@@ -0,0 +1,108 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package restorer
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// TaskListName is the tasklist of the archive restorer worker
+	TaskListName = "cadence-sys-archive-restore-tasklist"
+	// WorkflowTypeName is the workflow type restoring an archived workflow
+	WorkflowTypeName = "cadence-sys-archive-restore-workflow"
+	// WorkflowIDPrefix is prepended to the restored execution to build the restore workflow ID
+	WorkflowIDPrefix = "cadence-sys-archive-restore-"
+
+	// DeleteWorkflowTypeName is the workflow type deleting an archived workflow
+	DeleteWorkflowTypeName = "cadence-sys-archive-delete-workflow"
+	// DeleteWorkflowIDPrefix is prepended to the deleted execution to build the delete workflow ID
+	DeleteWorkflowIDPrefix = "cadence-sys-archive-delete-"
+
+	restoreActivityName = "cadence-sys-archive-restore-activity"
+	deleteActivityName  = "cadence-sys-archive-delete-activity"
+
+	// ErrAccessDeniedNonRetryable is returned when the security token does not match the admin operation token
+	ErrAccessDeniedNonRetryable = "AccessDeniedError"
+	// ErrDomainDoesNotExistNonRetryable is returned when the domain of the workflow does not exist
+	ErrDomainDoesNotExistNonRetryable = "domain does not exist"
+	// ErrArchivalNotConfiguredNonRetryable is returned when the domain has never archived any history
+	ErrArchivalNotConfiguredNonRetryable = "history archival is not configured for domain"
+	// ErrHistoryNotFoundNonRetryable is returned when the workflow is not found in the archive
+	ErrHistoryNotFoundNonRetryable = "archived history not found"
+	// ErrInvalidHistoryNonRetryable is returned when the archived history cannot be replayed
+	ErrInvalidHistoryNonRetryable = "archived history is invalid"
+	// ErrDomainNotArchivedNonRetryable is returned when the domain has never archived any history or visibility record
+	ErrDomainNotArchivedNonRetryable = "archival is not configured for domain"
+	// ErrInvalidDeleteRequestNonRetryable is returned when the archive rejects the delete request
+	ErrInvalidDeleteRequestNonRetryable = "archive delete request is invalid"
+
+	// historyPageSize is the number of events read from the archive at a time
+	historyPageSize = 1000
+
+	// WorkflowStartToCloseTimeout is the execution timeout of the restore workflow
+	WorkflowStartToCloseTimeout = 24 * time.Hour
+	// WorkflowTaskStartToCloseTimeout is the decision task timeout of the restore workflow
+	WorkflowTaskStartToCloseTimeout = time.Minute
+)
+
+type (
+	// Params contains the parameters of the restore and delete workflows
+	Params struct {
+		DomainName    string `json:"domain_name"`
+		WorkflowID    string `json:"workflow_id"`
+		RunID         string `json:"run_id"`
+		SecurityToken string `json:"security_token"`
+	}
+
+	// Result describes the restored workflow
+	Result struct {
+		DomainID    string `json:"domain_id"`
+		WorkflowID  string `json:"workflow_id"`
+		RunID       string `json:"run_id"`
+		LastEventID int64  `json:"last_event_id"`
+		Batches     int    `json:"batches"`
+	}
+
+	// DeleteResult describes the deleted archived workflow
+	DeleteResult struct {
+		DomainID          string `json:"domain_id"`
+		WorkflowID        string `json:"workflow_id"`
+		RunID             string `json:"run_id"`
+		HistoryDeleted    bool   `json:"history_deleted"`
+		VisibilityDeleted bool   `json:"visibility_deleted"`
+	}
+)
+
+// WorkflowID returns the ID of the workflow restoring the given execution,
+// so that concurrent restores of the same execution are deduplicated
+func WorkflowID(domainName, workflowID, runID string) string {
+	return fmt.Sprintf("%s%s-%s-%s", WorkflowIDPrefix, domainName, workflowID, runID)
+}
+
+// DeleteWorkflowID returns the ID of the workflow deleting the given archived execution,
+// so that concurrent deletes of the same execution are deduplicated
+func DeleteWorkflowID(domainName, workflowID, runID string) string {
+	return fmt.Sprintf("%s%s-%s-%s", DeleteWorkflowIDPrefix, domainName, workflowID, runID)
+}
@@ -0,0 +1,119 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package restorer
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	errMsgParamsIsNil = "params is nil"
+)
+
+var (
+	retryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: WorkflowStartToCloseTimeout,
+		NonRetriableErrorReasons: []string{
+			ErrAccessDeniedNonRetryable,
+			ErrDomainDoesNotExistNonRetryable,
+			ErrArchivalNotConfiguredNonRetryable,
+			ErrHistoryNotFoundNonRetryable,
+			ErrInvalidHistoryNonRetryable,
+			ErrDomainNotArchivedNonRetryable,
+			ErrInvalidDeleteRequestNonRetryable,
+		},
+	}
+
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    time.Hour,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy:            &retryPolicy,
+	}
+)
+
+// RestoreWorkflow reads a workflow's history from the archive and replays it
+// into live persistence through the replication path
+func RestoreWorkflow(ctx workflow.Context, params *Params) (*Result, error) {
+	if params == nil {
+		return nil, errors.New(errMsgParamsIsNil)
+	}
+	logger := workflow.GetLogger(ctx).With(
+		zap.String("domain", params.DomainName),
+		zap.String("workflow-id", params.WorkflowID),
+		zap.String("run-id", params.RunID),
+	)
+	logger.Info("Starting archived workflow restore")
+
+	var result Result
+	err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		restoreActivityName,
+		*params,
+	).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to restore archived workflow", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Restored archived workflow", zap.Int64("last-event-id", result.LastEventID))
+	return &result, nil
+}
+
+// DeleteWorkflow deletes the archived history and visibility records of a workflow run
+func DeleteWorkflow(ctx workflow.Context, params *Params) (*DeleteResult, error) {
+	if params == nil {
+		return nil, errors.New(errMsgParamsIsNil)
+	}
+	logger := workflow.GetLogger(ctx).With(
+		zap.String("domain", params.DomainName),
+		zap.String("workflow-id", params.WorkflowID),
+		zap.String("run-id", params.RunID),
+	)
+	logger.Info("Starting archived workflow delete")
+
+	var result DeleteResult
+	err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		deleteActivityName,
+		*params,
+	).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to delete archived workflow", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Deleted archived workflow",
+		zap.Bool("history-deleted", result.HistoryDeleted),
+		zap.Bool("visibility-deleted", result.VisibilityDeleted),
+	)
+	return &result, nil
+}
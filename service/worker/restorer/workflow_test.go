@@ -0,0 +1,154 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package restorer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+func TestRestoreWorkflow(t *testing.T) {
+	params := &Params{
+		DomainName: testDomainName,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	result := &Result{
+		DomainID:    testDomainID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		LastEventID: 5,
+		Batches:     3,
+	}
+
+	tests := map[string]struct {
+		params         *Params
+		activityResult *Result
+		activityErr    error
+		expectedErr    bool
+	}{
+		"success": {
+			params:         params,
+			activityResult: result,
+		},
+		"activity fails": {
+			params:      params,
+			activityErr: errors.New("activity failed"),
+			expectedErr: true,
+		},
+		"nil params": {
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var s testsuite.WorkflowTestSuite
+			env := s.NewTestWorkflowEnvironment()
+			r := &Restorer{}
+			env.RegisterWorkflowWithOptions(RestoreWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+			env.RegisterActivityWithOptions(r.RestoreActivity, activity.RegisterOptions{Name: restoreActivityName})
+			if tc.params != nil {
+				env.OnActivity(restoreActivityName, mock.Anything, *tc.params).Return(tc.activityResult, tc.activityErr)
+			}
+
+			env.ExecuteWorkflow(WorkflowTypeName, tc.params)
+
+			require.True(t, env.IsWorkflowCompleted())
+			if tc.expectedErr {
+				require.Error(t, env.GetWorkflowError())
+				return
+			}
+			require.NoError(t, env.GetWorkflowError())
+			var actual Result
+			require.NoError(t, env.GetWorkflowResult(&actual))
+			require.Equal(t, *tc.activityResult, actual)
+		})
+	}
+}
+
+func TestDeleteWorkflow(t *testing.T) {
+	params := &Params{
+		DomainName: testDomainName,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	result := &DeleteResult{
+		DomainID:          testDomainID,
+		WorkflowID:        testWorkflowID,
+		RunID:             testRunID,
+		HistoryDeleted:    true,
+		VisibilityDeleted: true,
+	}
+
+	tests := map[string]struct {
+		params         *Params
+		activityResult *DeleteResult
+		activityErr    error
+		expectedErr    bool
+	}{
+		"success": {
+			params:         params,
+			activityResult: result,
+		},
+		"activity fails": {
+			params:      params,
+			activityErr: errors.New("activity failed"),
+			expectedErr: true,
+		},
+		"nil params": {
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var s testsuite.WorkflowTestSuite
+			env := s.NewTestWorkflowEnvironment()
+			r := &Restorer{}
+			env.RegisterWorkflowWithOptions(DeleteWorkflow, workflow.RegisterOptions{Name: DeleteWorkflowTypeName})
+			env.RegisterActivityWithOptions(r.DeleteActivity, activity.RegisterOptions{Name: deleteActivityName})
+			if tc.params != nil {
+				env.OnActivity(deleteActivityName, mock.Anything, *tc.params).Return(tc.activityResult, tc.activityErr)
+			}
+
+			env.ExecuteWorkflow(DeleteWorkflowTypeName, tc.params)
+
+			require.True(t, env.IsWorkflowCompleted())
+			if tc.expectedErr {
+				require.Error(t, env.GetWorkflowError())
+				return
+			}
+			require.NoError(t, env.GetWorkflowError())
+			var actual DeleteResult
+			require.NoError(t, env.GetWorkflowResult(&actual))
+			require.Equal(t, *tc.activityResult, actual)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package archival
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	// ScavengerHeartbeatDetails is the heartbeat detail for ArchivalScavengerActivity
+	ScavengerHeartbeatDetails struct {
+		// DomainID is the domain being swept, domains with a smaller ID were already swept
+		DomainID                string
+		HistoryDone             bool
+		HistoryNextPageToken    []byte
+		VisibilityNextPageToken []byte
+
+		HistoryDeletedCount    int
+		VisibilityDeletedCount int
+		SkipCount              int
+		ErrorCount             int
+	}
+
+	// Scavenger is the type that holds the state for archival scavenger daemon
+	Scavenger struct {
+		domainCache      cache.DomainCache
+		archiverProvider provider.ArchiverProvider
+		retentionInDays  dynamicproperties.IntPropertyFnWithDomainFilter
+		hbd              ScavengerHeartbeatDetails
+		timeSource       clock.TimeSource
+		metrics          metrics.Client
+		logger           log.Logger
+		isInTest         bool
+	}
+)
+
+const (
+	pageSize = 1000
+)
+
+// NewScavenger returns an instance of archival scavenger daemon
+// The Scavenger can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over all of the domains in the system. For
+// each domain with a positive archival retention, the scavenger will
+//   - delete the archived histories which were archived before the retention period
+//   - delete the archived visibility records which were archived before the retention period
+func NewScavenger(
+	domainCache cache.DomainCache,
+	archiverProvider provider.ArchiverProvider,
+	retentionInDays dynamicproperties.IntPropertyFnWithDomainFilter,
+	hbd ScavengerHeartbeatDetails,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Scavenger {
+	return &Scavenger{
+		domainCache:      domainCache,
+		archiverProvider: archiverProvider,
+		retentionInDays:  retentionInDays,
+		hbd:              hbd,
+		timeSource:       clock.NewRealTimeSource(),
+		metrics:          metricsClient,
+		logger:           logger,
+	}
+}
+
+// Run runs the scavenger
+func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error) {
+	domains := s.domainCache.GetAllDomain()
+	domainIDs := make([]string, 0, len(domains))
+	for domainID := range domains {
+		if domainID >= s.hbd.DomainID {
+			domainIDs = append(domainIDs, domainID)
+		}
+	}
+	sort.Strings(domainIDs)
+
+	for _, domainID := range domainIDs {
+		if domainID != s.hbd.DomainID {
+			s.hbd.DomainID = domainID
+			s.hbd.HistoryDone = false
+			s.hbd.HistoryNextPageToken = nil
+			s.hbd.VisibilityNextPageToken = nil
+		}
+		if err := s.sweepDomain(ctx, domains[domainID]); err != nil {
+			return s.hbd, err
+		}
+	}
+	return s.hbd, nil
+}
+
+// sweepDomain deletes the expired archived records of a domain, it only returns an error when
+// the context is done, other errors are logged and counted so that the remaining domains are still swept
+func (s *Scavenger) sweepDomain(ctx context.Context, domain *cache.DomainCacheEntry) error {
+	domainName := domain.GetInfo().Name
+	logger := s.logger.WithTags(tag.WorkflowDomainID(domain.GetInfo().ID), tag.WorkflowDomainName(domainName))
+	scope := s.metrics.Scope(metrics.ArchivalScavengerScope, metrics.DomainTag(domainName))
+
+	retentionInDays := s.retentionInDays(domainName)
+	if retentionInDays <= 0 {
+		s.hbd.SkipCount++
+		scope.IncCounter(metrics.ArchivalScavengerSkipCount)
+		return nil
+	}
+	archivedBefore := s.timeSource.Now().Add(-time.Duration(retentionInDays) * 24 * time.Hour)
+	config := domain.GetConfig()
+
+	if !s.hbd.HistoryDone {
+		if config.HistoryArchivalURI != "" {
+			err := s.sweep(ctx, config.HistoryArchivalURI, domain.GetInfo().ID, archivedBefore, &s.hbd.HistoryNextPageToken, s.deleteExpiredHistory, func(deleted int) {
+				s.hbd.HistoryDeletedCount += deleted
+				scope.AddCounter(metrics.ArchivalScavengerHistoryDeletedCount, int64(deleted))
+			})
+			if err := s.handleSweepError(ctx, scope, logger, "history", err); err != nil {
+				return err
+			}
+		}
+		s.hbd.HistoryDone = true
+	}
+
+	if config.VisibilityArchivalURI != "" {
+		err := s.sweep(ctx, config.VisibilityArchivalURI, domain.GetInfo().ID, archivedBefore, &s.hbd.VisibilityNextPageToken, s.deleteExpiredVisibility, func(deleted int) {
+			s.hbd.VisibilityDeletedCount += deleted
+			scope.AddCounter(metrics.ArchivalScavengerVisibilityDeletedCount, int64(deleted))
+		})
+		if err := s.handleSweepError(ctx, scope, logger, "visibility", err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Scavenger) sweep(
+	ctx context.Context,
+	uri string,
+	domainID string,
+	archivedBefore time.Time,
+	nextPageToken *[]byte,
+	deleteExpired func(context.Context, archiver.URI, *archiver.DeleteExpiredRequest) (*archiver.DeleteExpiredResponse, error),
+	onDeleted func(int),
+) error {
+	URI, err := archiver.NewURI(uri)
+	if err != nil {
+		return err
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		resp, err := deleteExpired(ctx, URI, &archiver.DeleteExpiredRequest{
+			DomainID:       domainID,
+			ArchivedBefore: archivedBefore,
+			PageSize:       pageSize,
+			NextPageToken:  *nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+		onDeleted(resp.DeletedCount)
+		*nextPageToken = resp.NextPageToken
+		if !s.isInTest {
+			activity.RecordHeartbeat(ctx, s.hbd)
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *Scavenger) deleteExpiredHistory(ctx context.Context, URI archiver.URI, request *archiver.DeleteExpiredRequest) (*archiver.DeleteExpiredResponse, error) {
+	historyArchiver, err := s.archiverProvider.GetHistoryArchiver(URI.Scheme(), service.Worker)
+	if err != nil {
+		return nil, err
+	}
+	return historyArchiver.DeleteExpired(ctx, URI, request)
+}
+
+func (s *Scavenger) deleteExpiredVisibility(ctx context.Context, URI archiver.URI, request *archiver.DeleteExpiredRequest) (*archiver.DeleteExpiredResponse, error) {
+	visibilityArchiver, err := s.archiverProvider.GetVisibilityArchiver(URI.Scheme(), service.Worker)
+	if err != nil {
+		return nil, err
+	}
+	return visibilityArchiver.DeleteExpired(ctx, URI, request)
+}
+
+func (s *Scavenger) handleSweepError(ctx context.Context, scope metrics.Scope, logger log.Logger, target string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	s.hbd.ErrorCount++
+	scope.IncCounter(metrics.ArchivalScavengerErrorCount)
+	logger.Error("archival scavenger failed to delete expired archived records", tag.Value(target), tag.Error(err))
+	return nil
+}
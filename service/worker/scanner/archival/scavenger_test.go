@@ -0,0 +1,236 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package archival
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testHistoryURI    = "test:///history/archival"
+	testVisibilityURI = "test:///visibility/archival"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		logger               log.Logger
+		metric               metrics.Client
+		timeSource           clock.MockedTimeSource
+		mockCache            *cache.MockDomainCache
+		mockProvider         *provider.MockArchiverProvider
+		historyArchiver      *archiver.HistoryArchiverMock
+		visibilityArchiver   *archiver.VisibilityArchiverMock
+		retentionByDomainMap map[string]int
+	}
+)
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	s.logger = testlogger.New(s.T())
+	s.metric = metrics.NewClient(tally.NoopScope, metrics.Worker, metrics.HistogramMigration{})
+	s.timeSource = clock.NewMockedTimeSource()
+	controller := gomock.NewController(s.T())
+	s.mockCache = cache.NewMockDomainCache(controller)
+	s.mockProvider = provider.NewMockArchiverProvider(controller)
+	s.historyArchiver = &archiver.HistoryArchiverMock{}
+	s.visibilityArchiver = &archiver.VisibilityArchiverMock{}
+	s.retentionByDomainMap = map[string]int{}
+
+	s.mockProvider.EXPECT().GetHistoryArchiver("test", "cadence-worker").Return(s.historyArchiver, nil).AnyTimes()
+	s.mockProvider.EXPECT().GetVisibilityArchiver("test", "cadence-worker").Return(s.visibilityArchiver, nil).AnyTimes()
+}
+
+func (s *ScavengerTestSuite) TearDownTest() {
+	s.historyArchiver.AssertExpectations(s.T())
+	s.visibilityArchiver.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) createTestScavenger(hbd ScavengerHeartbeatDetails) *Scavenger {
+	retentionInDays := func(domain string) int {
+		return s.retentionByDomainMap[domain]
+	}
+	scvgr := NewScavenger(s.mockCache, s.mockProvider, retentionInDays, hbd, s.metric, s.logger)
+	scvgr.timeSource = s.timeSource
+	scvgr.isInTest = true
+	return scvgr
+}
+
+func (s *ScavengerTestSuite) setupDomains(domains ...*cache.DomainCacheEntry) {
+	domainMap := make(map[string]*cache.DomainCacheEntry, len(domains))
+	for _, domain := range domains {
+		domainMap[domain.GetInfo().ID] = domain
+	}
+	s.mockCache.EXPECT().GetAllDomain().Return(domainMap).Times(1)
+}
+
+func (s *ScavengerTestSuite) newDomain(id string, retentionInDays int, historyURI, visibilityURI string) *cache.DomainCacheEntry {
+	name := id + "-name"
+	s.retentionByDomainMap[name] = retentionInDays
+	return cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: id, Name: name},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus:    types.ArchivalStatusEnabled,
+			HistoryArchivalURI:       historyURI,
+			VisibilityArchivalStatus: types.ArchivalStatusEnabled,
+			VisibilityArchivalURI:    visibilityURI,
+		},
+		"active",
+	)
+}
+
+func (s *ScavengerTestSuite) expectedCutoff(retentionInDays int) time.Time {
+	return s.timeSource.Now().Add(-time.Duration(retentionInDays) * 24 * time.Hour)
+}
+
+func (s *ScavengerTestSuite) TestRun_SkipDomainsWithoutRetention() {
+	s.setupDomains(
+		s.newDomain("domainID1", 0, testHistoryURI, testVisibilityURI),
+		s.newDomain("domainID2", -1, testHistoryURI, testVisibilityURI),
+	)
+
+	hbd, err := s.createTestScavenger(ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(2, hbd.SkipCount)
+	s.Equal(0, hbd.HistoryDeletedCount)
+	s.Equal(0, hbd.VisibilityDeletedCount)
+	s.Equal(0, hbd.ErrorCount)
+}
+
+func (s *ScavengerTestSuite) TestRun_DeleteExpiredMultiplePages() {
+	s.setupDomains(
+		s.newDomain("domainID1", 7, testHistoryURI, testVisibilityURI),
+		s.newDomain("domainID2", 30, testHistoryURI, ""),
+	)
+
+	s.historyArchiver.On("DeleteExpired", mock.Anything, mock.Anything, &archiver.DeleteExpiredRequest{
+		DomainID:       "domainID1",
+		ArchivedBefore: s.expectedCutoff(7),
+		PageSize:       pageSize,
+	}).Return(&archiver.DeleteExpiredResponse{DeletedCount: 3, NextPageToken: []byte("page1")}, nil).Once()
+	s.historyArchiver.On("DeleteExpired", mock.Anything, mock.Anything, &archiver.DeleteExpiredRequest{
+		DomainID:       "domainID1",
+		ArchivedBefore: s.expectedCutoff(7),
+		PageSize:       pageSize,
+		NextPageToken:  []byte("page1"),
+	}).Return(&archiver.DeleteExpiredResponse{DeletedCount: 2}, nil).Once()
+	s.visibilityArchiver.On("DeleteExpired", mock.Anything, mock.Anything, &archiver.DeleteExpiredRequest{
+		DomainID:       "domainID1",
+		ArchivedBefore: s.expectedCutoff(7),
+		PageSize:       pageSize,
+	}).Return(&archiver.DeleteExpiredResponse{DeletedCount: 4}, nil).Once()
+	s.historyArchiver.On("DeleteExpired", mock.Anything, mock.Anything, &archiver.DeleteExpiredRequest{
+		DomainID:       "domainID2",
+		ArchivedBefore: s.expectedCutoff(30),
+		PageSize:       pageSize,
+	}).Return(&archiver.DeleteExpiredResponse{DeletedCount: 1}, nil).Once()
+
+	hbd, err := s.createTestScavenger(ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(6, hbd.HistoryDeletedCount)
+	s.Equal(4, hbd.VisibilityDeletedCount)
+	s.Equal(0, hbd.SkipCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Equal("domainID2", hbd.DomainID)
+}
+
+func (s *ScavengerTestSuite) TestRun_ErrorsDoNotStopOtherDomains() {
+	s.setupDomains(
+		s.newDomain("domainID1", 7, testHistoryURI, testVisibilityURI),
+		s.newDomain("domainID2", 7, "invalid uri", testVisibilityURI),
+	)
+
+	s.historyArchiver.On("DeleteExpired", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.DeleteExpiredRequest) bool {
+		return request.DomainID == "domainID1"
+	})).Return(nil, errors.New("some random error")).Once()
+	s.visibilityArchiver.On("DeleteExpired", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.DeleteExpiredRequest) bool {
+		return request.DomainID == "domainID1"
+	})).Return(&archiver.DeleteExpiredResponse{DeletedCount: 1}, nil).Once()
+	s.visibilityArchiver.On("DeleteExpired", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.DeleteExpiredRequest) bool {
+		return request.DomainID == "domainID2"
+	})).Return(&archiver.DeleteExpiredResponse{DeletedCount: 2}, nil).Once()
+
+	hbd, err := s.createTestScavenger(ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(0, hbd.HistoryDeletedCount)
+	s.Equal(3, hbd.VisibilityDeletedCount)
+	s.Equal(2, hbd.ErrorCount)
+}
+
+func (s *ScavengerTestSuite) TestRun_ResumeFromHeartbeat() {
+	s.setupDomains(
+		s.newDomain("domainID1", 7, testHistoryURI, testVisibilityURI),
+		s.newDomain("domainID2", 7, testHistoryURI, testVisibilityURI),
+	)
+
+	s.visibilityArchiver.On("DeleteExpired", mock.Anything, mock.Anything, &archiver.DeleteExpiredRequest{
+		DomainID:       "domainID2",
+		ArchivedBefore: s.expectedCutoff(7),
+		PageSize:       pageSize,
+		NextPageToken:  []byte("page2"),
+	}).Return(&archiver.DeleteExpiredResponse{DeletedCount: 1}, nil).Once()
+
+	hbd, err := s.createTestScavenger(ScavengerHeartbeatDetails{
+		DomainID:                "domainID2",
+		HistoryDone:             true,
+		VisibilityNextPageToken: []byte("page2"),
+		HistoryDeletedCount:     5,
+	}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(5, hbd.HistoryDeletedCount)
+	s.Equal(1, hbd.VisibilityDeletedCount)
+}
+
+func (s *ScavengerTestSuite) TestRun_ContextCanceled() {
+	s.setupDomains(
+		s.newDomain("domainID1", 7, testHistoryURI, testVisibilityURI),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	hbd, err := s.createTestScavenger(ScavengerHeartbeatDetails{}).Run(ctx)
+	s.ErrorIs(err, context.Canceled)
+	s.Equal("domainID1", hbd.DomainID)
+	s.False(hbd.HistoryDone)
+}
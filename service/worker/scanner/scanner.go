@@ -70,6 +70,10 @@ type (
 		// ShardScanners is a list of shard scanner configs
 		ShardScanners              []*shardscanner.ScannerConfig
 		MaxWorkflowRetentionInDays dynamicproperties.IntPropertyFn
+		// ArchivalScannerEnabled indicates if archival scanner should be started as part of scanner
+		ArchivalScannerEnabled dynamicproperties.BoolPropertyFn
+		// ArchivalRetentionInDays is the archival retention of each domain, in days, 0 means archived records are kept forever
+		ArchivalRetentionInDays dynamicproperties.IntPropertyFnWithDomainFilter
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -155,6 +159,13 @@ func (s *Scanner) Start() error {
 			historyScannerWFTypeName)
 		workerTaskListNames = append(workerTaskListNames, historyScannerTaskListName)
 	}
+	if s.context.cfg.ArchivalScannerEnabled() {
+		ctx = s.startScanner(
+			ctx,
+			archivalScannerWFStartOptions,
+			archivalScannerWFTypeName)
+		workerTaskListNames = append(workerTaskListNames, archivalScannerTaskListName)
+	}
 
 	workerOpts := worker.Options{
 		Logger:                                 s.zapLogger,
@@ -0,0 +1,142 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	schedulerIdentity = "cadence-scheduler"
+
+	defaultTaskStartToCloseTimeoutInSeconds = 10
+)
+
+// StartWorkflowActivity starts the workflow of a single firing
+func StartWorkflowActivity(ctx context.Context, params *StartWorkflowActivityParams) (*Execution, error) {
+	if params == nil {
+		return nil, errors.New(errMsgParamsIsNil)
+	}
+	action := params.Action
+	if action.Domain != params.Domain {
+		return nil, fmt.Errorf("action domain %q doesn't match the schedule domain %q", action.Domain, params.Domain)
+	}
+	workflowID := getActionWorkflowID(params.ScheduleID, params.NominalTime)
+	taskTimeout := int32(action.TaskStartToCloseTimeout.Seconds())
+	if taskTimeout <= 0 {
+		taskTimeout = defaultTaskStartToCloseTimeoutInSeconds
+	}
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              action.Domain,
+		WorkflowID:                          workflowID,
+		WorkflowType:                        &types.WorkflowType{Name: action.WorkflowType},
+		TaskList:                            &types.TaskList{Name: action.TaskList},
+		Input:                               action.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(action.ExecutionStartToCloseTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(taskTimeout),
+		Identity:                            schedulerIdentity,
+		RequestID:                           uuid.New(),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyRejectDuplicate.Ptr(),
+	}
+	resp, err := getFrontendClient(ctx).StartWorkflowExecution(ctx, request)
+	if err != nil {
+		// the firing was already taken by a previous attempt or a backfill
+		var alreadyStarted *types.WorkflowExecutionAlreadyStartedError
+		if errors.As(err, &alreadyStarted) {
+			return &Execution{Domain: action.Domain, WorkflowID: workflowID, RunID: alreadyStarted.RunID}, nil
+		}
+		return nil, err
+	}
+	return &Execution{Domain: action.Domain, WorkflowID: workflowID, RunID: resp.GetRunID()}, nil
+}
+
+// GetRunningWorkflowsActivity returns the executions which are still open
+func GetRunningWorkflowsActivity(ctx context.Context, params *WorkflowsActivityParams) ([]Execution, error) {
+	if params == nil {
+		return nil, errors.New(errMsgParamsIsNil)
+	}
+	client := getFrontendClient(ctx)
+	var running []Execution
+	for _, execution := range params.Executions {
+		resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+			Domain: execution.Domain,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: execution.WorkflowID,
+				RunID:      execution.RunID,
+			},
+		})
+		if err != nil {
+			var notExists *types.EntityNotExistsError
+			if errors.As(err, &notExists) {
+				continue
+			}
+			return nil, err
+		}
+		if resp.GetWorkflowExecutionInfo().CloseStatus == nil {
+			running = append(running, execution)
+		}
+	}
+	return running, nil
+}
+
+// CancelWorkflowsActivity requests cancellation of the given executions
+func CancelWorkflowsActivity(ctx context.Context, params *WorkflowsActivityParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	client := getFrontendClient(ctx)
+	for _, execution := range params.Executions {
+		err := client.RequestCancelWorkflowExecution(ctx, &types.RequestCancelWorkflowExecutionRequest{
+			Domain: execution.Domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: execution.WorkflowID,
+				RunID:      execution.RunID,
+			},
+			Identity:  schedulerIdentity,
+			RequestID: uuid.New(),
+			Cause:     params.Reason,
+		})
+		if err != nil {
+			var notExists *types.EntityNotExistsError
+			var completed *types.WorkflowExecutionAlreadyCompletedError
+			if errors.As(err, &notExists) || errors.As(err, &completed) {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func getFrontendClient(ctx context.Context) frontend.Client {
+	s := ctx.Value(schedulerContextKey).(*Scheduler)
+	return s.clientBean.GetFrontendClient()
+}
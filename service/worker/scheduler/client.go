@@ -0,0 +1,244 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package scheduler
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination client_mock.go -self_package github.com/uber/cadence/service/worker/scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// workflowTimeout is the execution timeout of each run of the schedule workflow,
+	// runs continue as new long before reaching it
+	workflowTimeout = 10 * 365 * 24 * time.Hour
+
+	workflowDecisionTimeoutInSeconds = 10
+)
+
+type (
+	// WorkflowService is the part of the frontend API the schedule workflows are driven through
+	WorkflowService interface {
+		StartWorkflowExecution(context.Context, *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
+		SignalWorkflowExecution(context.Context, *types.SignalWorkflowExecutionRequest) error
+		QueryWorkflow(context.Context, *types.QueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
+		ListOpenWorkflowExecutions(context.Context, *types.ListOpenWorkflowExecutionsRequest) (*types.ListOpenWorkflowExecutionsResponse, error)
+	}
+
+	// Client serves the frontend schedule APIs with the schedule workflows of the system domain.
+	// Requests must be authorized against their domain before reaching it, schedules are
+	// pinned to that domain and only start workflows there.
+	Client struct {
+		service WorkflowService
+	}
+)
+
+// NewClient returns a schedule client
+func NewClient(service WorkflowService) *Client {
+	return &Client{
+		service: service,
+	}
+}
+
+// Create starts the schedule workflow of a new schedule
+func (c *Client) Create(ctx context.Context, request *types.CreateScheduleRequest) error {
+	if err := validateScheduleID(request.GetScheduleID()); err != nil {
+		return err
+	}
+	schedule, err := toSchedule(request.GetDomain(), request.Spec, request.Action, request.OverlapPolicy)
+	if err != nil {
+		return err
+	}
+	schedule.Paused = request.GetPaused()
+	input, err := json.Marshal(&WorkflowParams{
+		Domain:     request.GetDomain(),
+		ScheduleID: request.GetScheduleID(),
+		Schedule:   *schedule,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.service.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              constants.SystemLocalDomainName,
+		WorkflowID:                          GetWorkflowID(request.GetDomain(), request.GetScheduleID()),
+		WorkflowType:                        &types.WorkflowType{Name: WorkflowTypeName},
+		TaskList:                            &types.TaskList{Name: TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(workflowTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(workflowDecisionTimeoutInSeconds),
+		Identity:                            request.GetIdentity(),
+		RequestID:                           uuid.New(),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+	})
+	return err
+}
+
+// Describe returns the definition and state of a schedule
+func (c *Client) Describe(ctx context.Context, request *types.DescribeScheduleRequest) (*types.DescribeScheduleResponse, error) {
+	if err := validateScheduleID(request.GetScheduleID()); err != nil {
+		return nil, err
+	}
+	resp, err := c.service.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: constants.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: GetWorkflowID(request.GetDomain(), request.GetScheduleID()),
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: QueryTypeDescribe,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetQueryResult() == nil {
+		return nil, &types.InternalServiceError{Message: "schedule description has no value"}
+	}
+	var desc Description
+	if err := json.Unmarshal(resp.GetQueryResult(), &desc); err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to deserialize schedule description: %v", err)}
+	}
+	return toDescribeScheduleResponse(&desc), nil
+}
+
+// Update replaces the definition of a schedule, the pause state is kept as is
+func (c *Client) Update(ctx context.Context, request *types.UpdateScheduleRequest) error {
+	if err := validateScheduleID(request.GetScheduleID()); err != nil {
+		return err
+	}
+	schedule, err := toSchedule(request.GetDomain(), request.Spec, request.Action, request.OverlapPolicy)
+	if err != nil {
+		return err
+	}
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleID(), SignalNameUpdate, schedule, request.GetIdentity())
+}
+
+// Pause stops a schedule from firing until it is resumed
+func (c *Client) Pause(ctx context.Context, request *types.PauseScheduleRequest) error {
+	if err := validateScheduleID(request.GetScheduleID()); err != nil {
+		return err
+	}
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleID(), SignalNamePause, &PauseRequest{Reason: request.GetReason()}, request.GetIdentity())
+}
+
+// Resume resumes a paused schedule, firings missed while paused are not taken
+func (c *Client) Resume(ctx context.Context, request *types.ResumeScheduleRequest) error {
+	if err := validateScheduleID(request.GetScheduleID()); err != nil {
+		return err
+	}
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleID(), SignalNameResume, nil, request.GetIdentity())
+}
+
+// Backfill takes the actions of every firing within the given time range
+func (c *Client) Backfill(ctx context.Context, request *types.BackfillScheduleRequest) error {
+	if err := validateScheduleID(request.GetScheduleID()); err != nil {
+		return err
+	}
+	backfill, err := toBackfillRequest(request)
+	if err != nil {
+		return err
+	}
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleID(), SignalNameBackfill, backfill, request.GetIdentity())
+}
+
+// Delete stops a schedule, workflows already started by it are left running
+func (c *Client) Delete(ctx context.Context, request *types.DeleteScheduleRequest) error {
+	if err := validateScheduleID(request.GetScheduleID()); err != nil {
+		return err
+	}
+	return c.signal(ctx, request.GetDomain(), request.GetScheduleID(), SignalNameDelete, nil, request.GetIdentity())
+}
+
+// List returns the IDs of the schedules of a domain. The schedule workflows of all the domains
+// are listed together, so a page can hold less schedules than the page size.
+func (c *Client) List(ctx context.Context, request *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error) {
+	resp, err := c.service.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+		Domain:          constants.SystemLocalDomainName,
+		MaximumPageSize: request.GetPageSize(),
+		NextPageToken:   request.NextPageToken,
+		StartTimeFilter: &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+		TypeFilter: &types.WorkflowTypeFilter{Name: WorkflowTypeName},
+	})
+	if err != nil {
+		return nil, err
+	}
+	prefix := getWorkflowIDPrefix(request.GetDomain())
+	scheduleIDs := []string{}
+	for _, execution := range resp.GetExecutions() {
+		workflowID := execution.GetExecution().GetWorkflowID()
+		if !strings.HasPrefix(workflowID, prefix) {
+			continue
+		}
+		scheduleID := strings.TrimPrefix(workflowID, prefix)
+		// the schedule of another domain whose name starts with this domain and the separator
+		if strings.Contains(scheduleID, workflowIDSeparator) {
+			continue
+		}
+		scheduleIDs = append(scheduleIDs, scheduleID)
+	}
+	return &types.ListSchedulesResponse{
+		ScheduleIDs:   scheduleIDs,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (c *Client) signal(ctx context.Context, domain, scheduleID, signalName string, payload interface{}, identity string) error {
+	var input []byte
+	if payload != nil {
+		var err error
+		if input, err = json.Marshal(payload); err != nil {
+			return err
+		}
+	}
+	return c.service.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain: constants.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: GetWorkflowID(domain, scheduleID),
+		},
+		SignalName: signalName,
+		Input:      input,
+		Identity:   identity,
+		RequestID:  uuid.New(),
+	})
+}
+
+func validateScheduleID(scheduleID string) error {
+	if scheduleID == "" {
+		return &types.BadRequestError{Message: "ScheduleID is not set on request."}
+	}
+	if strings.Contains(scheduleID, workflowIDSeparator) {
+		return &types.BadRequestError{Message: fmt.Sprintf("ScheduleID must not contain %q.", workflowIDSeparator)}
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestClientCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockWorkflowService(ctrl)
+	client := NewClient(service)
+
+	service.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
+			assert.Equal(t, constants.SystemLocalDomainName, request.Domain)
+			assert.Equal(t, "cadence-sys-schedule-d/s1", request.WorkflowID)
+			assert.Equal(t, WorkflowTypeName, request.WorkflowType.Name)
+			assert.Equal(t, TaskListName, request.TaskList.Name)
+			assert.Equal(t, "test-identity", request.Identity)
+			var params WorkflowParams
+			require.NoError(t, json.Unmarshal(request.Input, &params))
+			assert.Equal(t, "d", params.Domain)
+			assert.Equal(t, "s1", params.ScheduleID)
+			want := testSchedule(OverlapPolicyBuffer)
+			want.Paused = true
+			assert.Equal(t, *want, params.Schedule)
+			assert.Nil(t, params.State)
+			return &types.StartWorkflowExecutionResponse{RunID: "r1"}, nil
+		})
+	request := &types.CreateScheduleRequest{
+		Domain:        "d",
+		ScheduleID:    "s1",
+		Spec:          testScheduleSpec(),
+		Action:        testScheduleAction(),
+		OverlapPolicy: types.ScheduleOverlapPolicyBuffer.Ptr(),
+		Paused:        true,
+		Identity:      "test-identity",
+	}
+	require.NoError(t, client.Create(context.Background(), request))
+
+	for _, invalid := range []*types.CreateScheduleRequest{
+		{Domain: "d", Spec: testScheduleSpec(), Action: testScheduleAction()},
+		{Domain: "d", ScheduleID: "a/b", Spec: testScheduleSpec(), Action: testScheduleAction()},
+		{Domain: "d", ScheduleID: "s1", Action: testScheduleAction()},
+		{Domain: "d", ScheduleID: "s1", Spec: testScheduleSpec()},
+		{Domain: "d", ScheduleID: "s1", Spec: &types.ScheduleSpec{CronExpression: "invalid"}, Action: testScheduleAction()},
+	} {
+		err := client.Create(context.Background(), invalid)
+		var badRequest *types.BadRequestError
+		assert.ErrorAs(t, err, &badRequest)
+	}
+}
+
+func TestClientDescribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockWorkflowService(ctrl)
+	client := NewClient(service)
+
+	nextRunTime := time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)
+	desc := &Description{
+		Domain:     "d",
+		ScheduleID: "s1",
+		Schedule:   *testSchedule(OverlapPolicySkip),
+		State: State{
+			PauseReason:   "maintenance",
+			Running:       []Execution{{Domain: "d", WorkflowID: "w", RunID: "r"}},
+			RecentActions: []ActionResult{{NominalTime: nextRunTime, ActualTime: nextRunTime, Error: "failed"}},
+			TotalActions:  3,
+		},
+		NextRunTimes: []time.Time{nextRunTime},
+	}
+	result, err := json.Marshal(desc)
+	require.NoError(t, err)
+	service.EXPECT().QueryWorkflow(gomock.Any(), &types.QueryWorkflowRequest{
+		Domain:    constants.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: "cadence-sys-schedule-d/s1"},
+		Query:     &types.WorkflowQuery{QueryType: QueryTypeDescribe},
+	}).Return(&types.QueryWorkflowResponse{QueryResult: result}, nil)
+	got, err := client.Describe(context.Background(), &types.DescribeScheduleRequest{Domain: "d", ScheduleID: "s1"})
+	require.NoError(t, err)
+	assert.Equal(t, &types.DescribeScheduleResponse{
+		Spec:          &types.ScheduleSpec{CronExpression: "* * * * *"},
+		Action:        testScheduleAction(),
+		OverlapPolicy: types.ScheduleOverlapPolicySkip.Ptr(),
+		PauseReason:   "maintenance",
+		RunningExecutions: []*types.WorkflowExecution{
+			{WorkflowID: "w", RunID: "r"},
+		},
+		RecentActions: []*types.ScheduleActionResult{{
+			NominalTimestamp: common.Int64Ptr(nextRunTime.UnixNano()),
+			ActualTimestamp:  common.Int64Ptr(nextRunTime.UnixNano()),
+			FailureReason:    "failed",
+		}},
+		NextRunTimestamps: []int64{nextRunTime.UnixNano()},
+		TotalActions:      3,
+	}, got)
+
+	service.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&types.QueryWorkflowResponse{}, nil)
+	_, err = client.Describe(context.Background(), &types.DescribeScheduleRequest{Domain: "d", ScheduleID: "s1"})
+	assert.Error(t, err)
+
+	service.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	_, err = client.Describe(context.Background(), &types.DescribeScheduleRequest{Domain: "d", ScheduleID: "s1"})
+	assert.Error(t, err)
+
+	_, err = client.Describe(context.Background(), &types.DescribeScheduleRequest{Domain: "d"})
+	assert.Error(t, err)
+}
+
+func TestClientSignals(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	updated := testSchedule(OverlapPolicyAllowAll)
+	tests := []struct {
+		name       string
+		call       func(*Client) error
+		signalName string
+		payload    interface{}
+		wantErr    bool
+	}{
+		{
+			name: "update",
+			call: func(c *Client) error {
+				return c.Update(context.Background(), &types.UpdateScheduleRequest{
+					Domain:        "d",
+					ScheduleID:    "s1",
+					Spec:          testScheduleSpec(),
+					Action:        testScheduleAction(),
+					OverlapPolicy: types.ScheduleOverlapPolicyAllowAll.Ptr(),
+					Identity:      "test-identity",
+				})
+			},
+			signalName: SignalNameUpdate,
+			payload:    updated,
+		},
+		{
+			name: "update invalid schedule",
+			call: func(c *Client) error {
+				return c.Update(context.Background(), &types.UpdateScheduleRequest{Domain: "d", ScheduleID: "s1", Spec: &types.ScheduleSpec{}, Action: testScheduleAction()})
+			},
+			wantErr: true,
+		},
+		{
+			name: "pause",
+			call: func(c *Client) error {
+				return c.Pause(context.Background(), &types.PauseScheduleRequest{Domain: "d", ScheduleID: "s1", Reason: "maintenance", Identity: "test-identity"})
+			},
+			signalName: SignalNamePause,
+			payload:    &PauseRequest{Reason: "maintenance"},
+		},
+		{
+			name: "resume",
+			call: func(c *Client) error {
+				return c.Resume(context.Background(), &types.ResumeScheduleRequest{Domain: "d", ScheduleID: "s1", Identity: "test-identity"})
+			},
+			signalName: SignalNameResume,
+		},
+		{
+			name: "backfill",
+			call: func(c *Client) error {
+				return c.Backfill(context.Background(), &types.BackfillScheduleRequest{
+					Domain:         "d",
+					ScheduleID:     "s1",
+					StartTimestamp: common.Int64Ptr(startTime.UnixNano()),
+					EndTimestamp:   common.Int64Ptr(endTime.UnixNano()),
+					OverlapPolicy:  types.ScheduleOverlapPolicyAllowAll.Ptr(),
+					Identity:       "test-identity",
+				})
+			},
+			signalName: SignalNameBackfill,
+			payload:    &BackfillRequest{StartTime: startTime, EndTime: endTime, OverlapPolicy: OverlapPolicyAllowAll},
+		},
+		{
+			name: "backfill invalid range",
+			call: func(c *Client) error {
+				return c.Backfill(context.Background(), &types.BackfillScheduleRequest{
+					Domain:         "d",
+					ScheduleID:     "s1",
+					StartTimestamp: common.Int64Ptr(endTime.UnixNano()),
+					EndTimestamp:   common.Int64Ptr(startTime.UnixNano()),
+				})
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete",
+			call: func(c *Client) error {
+				return c.Delete(context.Background(), &types.DeleteScheduleRequest{Domain: "d", ScheduleID: "s1", Identity: "test-identity"})
+			},
+			signalName: SignalNameDelete,
+		},
+		{
+			name: "delete without schedule ID",
+			call: func(c *Client) error {
+				return c.Delete(context.Background(), &types.DeleteScheduleRequest{Domain: "d"})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := NewMockWorkflowService(ctrl)
+			if tc.signalName != "" {
+				var wantInput []byte
+				if tc.payload != nil {
+					var err error
+					wantInput, err = json.Marshal(tc.payload)
+					require.NoError(t, err)
+				}
+				service.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, request *types.SignalWorkflowExecutionRequest) error {
+						assert.Equal(t, constants.SystemLocalDomainName, request.Domain)
+						assert.Equal(t, "cadence-sys-schedule-d/s1", request.WorkflowExecution.WorkflowID)
+						assert.Equal(t, tc.signalName, request.SignalName)
+						assert.Equal(t, wantInput, request.Input)
+						assert.Equal(t, "test-identity", request.Identity)
+						return nil
+					})
+			}
+			err := tc.call(NewClient(service))
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClientList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewMockWorkflowService(ctrl)
+	client := NewClient(service)
+
+	service.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.ListOpenWorkflowExecutionsRequest) (*types.ListOpenWorkflowExecutionsResponse, error) {
+			assert.Equal(t, constants.SystemLocalDomainName, request.Domain)
+			assert.Equal(t, WorkflowTypeName, request.TypeFilter.Name)
+			assert.Equal(t, int32(10), request.MaximumPageSize)
+			assert.Equal(t, []byte("token"), request.NextPageToken)
+			return &types.ListOpenWorkflowExecutionsResponse{
+				Executions: []*types.WorkflowExecutionInfo{
+					{Execution: &types.WorkflowExecution{WorkflowID: "cadence-sys-schedule-d/s1"}},
+					{Execution: &types.WorkflowExecution{WorkflowID: "cadence-sys-schedule-other/s2"}},
+					{Execution: &types.WorkflowExecution{WorkflowID: "cadence-sys-schedule-d/nested/s3"}},
+					{Execution: &types.WorkflowExecution{WorkflowID: "cadence-sys-schedule-d/s4"}},
+				},
+				NextPageToken: []byte("next"),
+			}, nil
+		})
+	resp, err := client.List(context.Background(), &types.ListSchedulesRequest{Domain: "d", PageSize: 10, NextPageToken: []byte("token")})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s1", "s4"}, resp.ScheduleIDs)
+	assert.Equal(t, []byte("next"), resp.NextPageToken)
+
+	service.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, errors.New("mockErr"))
+	_, err = client.List(context.Background(), &types.ListSchedulesRequest{Domain: "d", PageSize: 10})
+	assert.Error(t, err)
+}
+
+func testScheduleSpec() *types.ScheduleSpec {
+	return &types.ScheduleSpec{CronExpression: "* * * * *"}
+}
+
+func testScheduleAction() *types.ScheduleAction {
+	return &types.ScheduleAction{
+		WorkflowType:                        &types.WorkflowType{Name: "wf-type"},
+		TaskList:                            &types.TaskList{Name: "tl"},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(0),
+	}
+}
@@ -0,0 +1,162 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// toSchedule builds the schedule of the given domain from the API types and validates it
+func toSchedule(domain string, spec *types.ScheduleSpec, action *types.ScheduleAction, policy *types.ScheduleOverlapPolicy) (*Schedule, error) {
+	if spec == nil {
+		return nil, &types.BadRequestError{Message: "Spec is not set on request."}
+	}
+	if action == nil {
+		return nil, &types.BadRequestError{Message: "Action is not set on request."}
+	}
+	schedule := &Schedule{
+		Spec: Spec{
+			CronExpression: spec.GetCronExpression(),
+			Jitter:         time.Duration(spec.GetJitterInSeconds()) * time.Second,
+			StartTime:      toTime(spec.StartTimestamp),
+			EndTime:        toTime(spec.EndTimestamp),
+		},
+		Action: Action{
+			Domain:                       domain,
+			WorkflowType:                 action.GetWorkflowType().GetName(),
+			TaskList:                     action.GetTaskList().GetName(),
+			Input:                        action.Input,
+			ExecutionStartToCloseTimeout: time.Duration(action.GetExecutionStartToCloseTimeoutSeconds()) * time.Second,
+			TaskStartToCloseTimeout:      time.Duration(action.GetTaskStartToCloseTimeoutSeconds()) * time.Second,
+		},
+		OverlapPolicy: toOverlapPolicy(policy),
+	}
+	if _, err := validateSchedule(domain, schedule); err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	return schedule, nil
+}
+
+func toBackfillRequest(request *types.BackfillScheduleRequest) (*BackfillRequest, error) {
+	backfill := &BackfillRequest{
+		StartTime:     toTime(request.StartTimestamp),
+		EndTime:       toTime(request.EndTimestamp),
+		OverlapPolicy: toOverlapPolicy(request.OverlapPolicy),
+	}
+	if err := validateBackfillRequest(backfill); err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	return backfill, nil
+}
+
+func toDescribeScheduleResponse(desc *Description) *types.DescribeScheduleResponse {
+	schedule := desc.Schedule
+	resp := &types.DescribeScheduleResponse{
+		Spec: &types.ScheduleSpec{
+			CronExpression:  schedule.Spec.CronExpression,
+			JitterInSeconds: int32(schedule.Spec.Jitter / time.Second),
+			StartTimestamp:  fromTime(schedule.Spec.StartTime),
+			EndTimestamp:    fromTime(schedule.Spec.EndTime),
+		},
+		Action: &types.ScheduleAction{
+			WorkflowType:                        &types.WorkflowType{Name: schedule.Action.WorkflowType},
+			TaskList:                            &types.TaskList{Name: schedule.Action.TaskList},
+			Input:                               schedule.Action.Input,
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(schedule.Action.ExecutionStartToCloseTimeout / time.Second)),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(schedule.Action.TaskStartToCloseTimeout / time.Second)),
+		},
+		OverlapPolicy:  fromOverlapPolicy(schedule.OverlapPolicy),
+		Paused:         schedule.Paused,
+		PauseReason:    desc.State.PauseReason,
+		TotalActions:   desc.State.TotalActions,
+		SkippedActions: desc.State.SkippedActions,
+	}
+	for _, execution := range desc.State.Running {
+		resp.RunningExecutions = append(resp.RunningExecutions, &types.WorkflowExecution{
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+		})
+	}
+	for _, action := range desc.State.RecentActions {
+		result := &types.ScheduleActionResult{
+			NominalTimestamp: fromTime(action.NominalTime),
+			ActualTimestamp:  fromTime(action.ActualTime),
+			Skipped:          action.Skipped,
+			FailureReason:    action.Error,
+		}
+		if action.Execution != nil {
+			result.WorkflowExecution = &types.WorkflowExecution{
+				WorkflowID: action.Execution.WorkflowID,
+				RunID:      action.Execution.RunID,
+			}
+		}
+		resp.RecentActions = append(resp.RecentActions, result)
+	}
+	for _, t := range desc.NextRunTimes {
+		resp.NextRunTimestamps = append(resp.NextRunTimestamps, t.UnixNano())
+	}
+	return resp
+}
+
+func toOverlapPolicy(policy *types.ScheduleOverlapPolicy) OverlapPolicy {
+	if policy == nil {
+		return ""
+	}
+	switch *policy {
+	case types.ScheduleOverlapPolicyBuffer:
+		return OverlapPolicyBuffer
+	case types.ScheduleOverlapPolicyCancelPrevious:
+		return OverlapPolicyCancelPrevious
+	case types.ScheduleOverlapPolicyAllowAll:
+		return OverlapPolicyAllowAll
+	}
+	return OverlapPolicySkip
+}
+
+func fromOverlapPolicy(policy OverlapPolicy) *types.ScheduleOverlapPolicy {
+	switch policy {
+	case OverlapPolicyBuffer:
+		return types.ScheduleOverlapPolicyBuffer.Ptr()
+	case OverlapPolicyCancelPrevious:
+		return types.ScheduleOverlapPolicyCancelPrevious.Ptr()
+	case OverlapPolicyAllowAll:
+		return types.ScheduleOverlapPolicyAllowAll.Ptr()
+	}
+	return types.ScheduleOverlapPolicySkip.Ptr()
+}
+
+func toTime(unixNano *int64) time.Time {
+	if unixNano == nil {
+		return time.Time{}
+	}
+	return time.Unix(0, *unixNano).UTC()
+}
+
+func fromTime(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	return common.Int64Ptr(t.UnixNano())
+}
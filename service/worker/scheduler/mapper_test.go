@@ -0,0 +1,48 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestOverlapPolicyMapping(t *testing.T) {
+	for _, policy := range []types.ScheduleOverlapPolicy{
+		types.ScheduleOverlapPolicySkip,
+		types.ScheduleOverlapPolicyBuffer,
+		types.ScheduleOverlapPolicyCancelPrevious,
+		types.ScheduleOverlapPolicyAllowAll,
+	} {
+		assert.Equal(t, &policy, fromOverlapPolicy(toOverlapPolicy(&policy)))
+	}
+	assert.Equal(t, OverlapPolicy(""), toOverlapPolicy(nil))
+	assert.Equal(t, types.ScheduleOverlapPolicySkip.Ptr(), fromOverlapPolicy(""))
+}
+
+func TestToBackfillRequest(t *testing.T) {
+	_, err := toBackfillRequest(&types.BackfillScheduleRequest{Domain: "d", ScheduleID: "s1"})
+	assert.Error(t, err)
+}
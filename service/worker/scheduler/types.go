@@ -0,0 +1,229 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"time"
+)
+
+type (
+	contextKey string
+
+	// OverlapPolicy decides what happens when a schedule fires while a
+	// workflow started by an earlier firing is still running
+	OverlapPolicy string
+)
+
+const (
+	schedulerContextKey contextKey = "schedulerContext"
+
+	// TaskListName is the tasklist of the scheduler worker
+	TaskListName = "cadence-sys-scheduler-tasklist"
+	// WorkflowTypeName is the workflow type of a schedule
+	WorkflowTypeName = "cadence-sys-schedule-workflow"
+	// WorkflowIDPrefix is prepended to the domain and the schedule ID to build the schedule workflow ID
+	WorkflowIDPrefix = "cadence-sys-schedule-"
+	// workflowIDSeparator separates the domain from the schedule ID in the schedule workflow ID,
+	// schedule IDs can't contain it so that the domain of a schedule workflow is unambiguous
+	workflowIDSeparator = "/"
+
+	startWorkflowActivityName       = "cadence-sys-schedule-startWorkflow-activity"
+	getRunningWorkflowsActivityName = "cadence-sys-schedule-getRunningWorkflows-activity"
+	cancelWorkflowsActivityName     = "cadence-sys-schedule-cancelWorkflows-activity"
+
+	// QueryTypeDescribe is the query type returning the schedule description
+	QueryTypeDescribe = "describe"
+	// SignalNameUpdate is the signal replacing the schedule definition
+	SignalNameUpdate = "update"
+	// SignalNamePause is the signal pausing the schedule
+	SignalNamePause = "pause"
+	// SignalNameResume is the signal resuming a paused schedule
+	SignalNameResume = "resume"
+	// SignalNameBackfill is the signal taking actions for a past time range
+	SignalNameBackfill = "backfill"
+	// SignalNameDelete is the signal completing the schedule workflow
+	SignalNameDelete = "delete"
+
+	// OverlapPolicySkip drops the firing if a previous run is still open
+	OverlapPolicySkip OverlapPolicy = "skip"
+	// OverlapPolicyBuffer queues the firing until the previous runs are closed
+	OverlapPolicyBuffer OverlapPolicy = "buffer"
+	// OverlapPolicyCancelPrevious requests cancellation of the previous runs and starts a new one
+	OverlapPolicyCancelPrevious OverlapPolicy = "cancel-previous"
+	// OverlapPolicyAllowAll starts a new run regardless of the previous runs
+	OverlapPolicyAllowAll OverlapPolicy = "allow"
+
+	// maxActionsPerRun bounds the history size of a single run before continuing as new
+	maxActionsPerRun = 200
+	// maxBufferedActions bounds the number of firings kept by OverlapPolicyBuffer
+	maxBufferedActions = 100
+	// maxBackfillActions bounds the number of firings a single backfill request can take
+	maxBackfillActions = 500
+	// maxRecentActions is the number of actions reported by describe
+	maxRecentActions = 10
+	// numNextRunTimes is the number of upcoming firings reported by describe
+	numNextRunTimes = 5
+	// bufferCheckInterval is how often buffered firings check for closed runs
+	bufferCheckInterval = time.Minute
+	// catchupWindow is how late a firing can be taken, e.g. after the worker was unavailable
+	catchupWindow = 10 * time.Minute
+)
+
+type (
+	// Spec defines when a schedule fires
+	Spec struct {
+		// CronExpression is a standard cron expression
+		CronExpression string
+		// Jitter is the maximum random delay added to each firing
+		Jitter time.Duration
+		// StartTime is the optional time before which the schedule doesn't fire
+		StartTime time.Time
+		// EndTime is the optional time after which the schedule doesn't fire
+		EndTime time.Time
+	}
+
+	// Action defines the workflow started on each firing, Domain is always the domain of the schedule
+	Action struct {
+		Domain                       string
+		WorkflowType                 string
+		TaskList                     string
+		Input                        []byte
+		ExecutionStartToCloseTimeout time.Duration
+		TaskStartToCloseTimeout      time.Duration
+	}
+
+	// Schedule is the user provided definition of a schedule
+	Schedule struct {
+		Spec          Spec
+		Action        Action
+		OverlapPolicy OverlapPolicy
+		Paused        bool
+	}
+
+	// Execution identifies a workflow started by a schedule
+	Execution struct {
+		Domain     string
+		WorkflowID string
+		RunID      string
+	}
+
+	// ActionResult records the outcome of a single firing
+	ActionResult struct {
+		NominalTime time.Time
+		ActualTime  time.Time
+		Execution   *Execution
+		Skipped     bool
+		Error       string
+	}
+
+	// State is the bookkeeping carried across runs of the schedule workflow
+	State struct {
+		LastProcessedTime time.Time
+		PauseReason       string
+		Running           []Execution
+		Buffered          []time.Time
+		RecentActions     []ActionResult
+		TotalActions      int64
+		SkippedActions    int64
+	}
+
+	// WorkflowParams is the input of the schedule workflow
+	WorkflowParams struct {
+		// Domain is the domain the schedule was authorized for, its action can only start workflows there
+		Domain     string
+		ScheduleID string
+		Schedule   Schedule
+		State      *State
+	}
+
+	// Description is the result of the describe query
+	Description struct {
+		Domain       string
+		ScheduleID   string
+		Schedule     Schedule
+		State        State
+		NextRunTimes []time.Time
+	}
+
+	// PauseRequest is the payload of the pause signal
+	PauseRequest struct {
+		Reason string
+	}
+
+	// BackfillRequest is the payload of the backfill signal
+	BackfillRequest struct {
+		StartTime time.Time
+		EndTime   time.Time
+		// OverlapPolicy overrides the schedule's policy for the backfilled firings if set
+		OverlapPolicy OverlapPolicy
+	}
+
+	// StartWorkflowActivityParams is the input of the start workflow activity
+	StartWorkflowActivityParams struct {
+		Domain      string
+		ScheduleID  string
+		NominalTime time.Time
+		Action      Action
+	}
+
+	// WorkflowsActivityParams is the input of activities operating on started workflows
+	WorkflowsActivityParams struct {
+		Executions []Execution
+		Reason     string
+	}
+)
+
+// Validate checks the overlap policy is known, treating empty as OverlapPolicySkip
+func (p OverlapPolicy) Validate() error {
+	switch p {
+	case "", OverlapPolicySkip, OverlapPolicyBuffer, OverlapPolicyCancelPrevious, OverlapPolicyAllowAll:
+		return nil
+	}
+	return fmt.Errorf("unknown overlap policy %q", p)
+}
+
+func (p OverlapPolicy) orDefault(fallback OverlapPolicy) OverlapPolicy {
+	if p != "" {
+		return p
+	}
+	if fallback != "" {
+		return fallback
+	}
+	return OverlapPolicySkip
+}
+
+// GetWorkflowID returns the workflow ID of the schedule workflow
+func GetWorkflowID(domain, scheduleID string) string {
+	return getWorkflowIDPrefix(domain) + scheduleID
+}
+
+func getWorkflowIDPrefix(domain string) string {
+	return WorkflowIDPrefix + domain + workflowIDSeparator
+}
+
+// getActionWorkflowID returns the ID of the workflow started for the given firing,
+// which makes starting the same firing twice idempotent
+func getActionWorkflowID(scheduleID string, nominalTime time.Time) string {
+	return fmt.Sprintf("%s-%s", scheduleID, nominalTime.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,495 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common/backoff"
+)
+
+const (
+	errMsgParamsIsNil = "params is nil"
+)
+
+type (
+	// scheduleRunner holds the in-memory state of a single run of the schedule workflow
+	scheduleRunner struct {
+		ctx        workflow.Context
+		logger     *zap.Logger
+		domain     string
+		scheduleID string
+		schedule   Schedule
+		cron       cron.Schedule
+		state      State
+		actions    int
+		deleted    bool
+
+		// jitter of the upcoming firing, recorded once through a side effect
+		jitterTime time.Time
+		jitter     time.Duration
+	}
+
+	signalHandler struct {
+		ch     workflow.Channel
+		handle func(ch workflow.Channel, async bool) bool
+	}
+)
+
+// ScheduleWorkflow starts the schedule's action on every firing of its cron spec
+// until a delete signal is received
+func ScheduleWorkflow(ctx workflow.Context, params *WorkflowParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	sched, err := validateSchedule(params.Domain, &params.Schedule)
+	if err != nil {
+		return err
+	}
+	r := &scheduleRunner{
+		ctx:        ctx,
+		logger:     workflow.GetLogger(ctx).With(zap.String("domain", params.Domain), zap.String("schedule-id", params.ScheduleID)),
+		domain:     params.Domain,
+		scheduleID: params.ScheduleID,
+		schedule:   params.Schedule,
+		cron:       sched,
+	}
+	if params.State != nil {
+		r.state = *params.State
+	} else {
+		r.state.LastProcessedTime = workflow.Now(ctx)
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeDescribe, func() (*Description, error) {
+		return r.describe(), nil
+	}); err != nil {
+		return err
+	}
+	return r.run()
+}
+
+func (r *scheduleRunner) run() error {
+	handlers := []signalHandler{
+		{ch: workflow.GetSignalChannel(r.ctx, SignalNameUpdate), handle: r.onUpdate},
+		{ch: workflow.GetSignalChannel(r.ctx, SignalNamePause), handle: r.onPause},
+		{ch: workflow.GetSignalChannel(r.ctx, SignalNameResume), handle: r.onResume},
+		{ch: workflow.GetSignalChannel(r.ctx, SignalNameBackfill), handle: r.onBackfill},
+		{ch: workflow.GetSignalChannel(r.ctx, SignalNameDelete), handle: r.onDelete},
+	}
+
+	for r.actions < maxActionsPerRun {
+		// signals received while an action was taken are handled before the next one
+		r.drainSignals(handlers)
+		if r.deleted {
+			break
+		}
+		r.processDue()
+		r.processBuffered()
+		if r.actions >= maxActionsPerRun {
+			break
+		}
+
+		// signals are added first so they take precedence over an already fired timer
+		selector := workflow.NewSelector(r.ctx)
+		for _, h := range handlers {
+			h := h
+			selector.AddReceive(h.ch, func(ch workflow.Channel, more bool) {
+				h.handle(ch, false)
+			})
+		}
+		timerCtx, cancelTimer := workflow.WithCancel(r.ctx)
+		if d, ok := r.nextWakeup(); ok {
+			selector.AddFuture(workflow.NewTimer(timerCtx, d), func(workflow.Future) {})
+		}
+		selector.Select(r.ctx)
+		cancelTimer()
+	}
+
+	// signals which are not consumed before continuing as new are lost
+	r.drainSignals(handlers)
+	if r.deleted {
+		r.logger.Info("schedule deleted")
+		return nil
+	}
+	return workflow.NewContinueAsNewError(r.ctx, WorkflowTypeName, &WorkflowParams{
+		Domain:     r.domain,
+		ScheduleID: r.scheduleID,
+		Schedule:   r.schedule,
+		State:      &r.state,
+	})
+}
+
+func (r *scheduleRunner) drainSignals(handlers []signalHandler) {
+	for _, h := range handlers {
+		for h.handle(h.ch, true) {
+		}
+	}
+}
+
+func (r *scheduleRunner) onUpdate(ch workflow.Channel, async bool) bool {
+	var schedule Schedule
+	if !receive(r.ctx, ch, &schedule, async) {
+		return false
+	}
+	// an update can't move the schedule to another domain than the one it was authorized for
+	sched, err := validateSchedule(r.domain, &schedule)
+	if err != nil {
+		r.logger.Warn("ignoring invalid schedule update", zap.Error(err))
+		return true
+	}
+	// pausing and resuming is only done through the dedicated signals
+	schedule.Paused = r.schedule.Paused
+	r.schedule = schedule
+	r.cron = sched
+	r.jitterTime = time.Time{}
+	return true
+}
+
+func (r *scheduleRunner) onPause(ch workflow.Channel, async bool) bool {
+	var req PauseRequest
+	if !receive(r.ctx, ch, &req, async) {
+		return false
+	}
+	r.schedule.Paused = true
+	r.state.PauseReason = req.Reason
+	return true
+}
+
+func (r *scheduleRunner) onResume(ch workflow.Channel, async bool) bool {
+	if !receive(r.ctx, ch, nil, async) {
+		return false
+	}
+	if r.schedule.Paused {
+		r.schedule.Paused = false
+		r.state.PauseReason = ""
+		// firings missed while paused are not taken, use backfill for that
+		r.state.LastProcessedTime = workflow.Now(r.ctx)
+	}
+	return true
+}
+
+func (r *scheduleRunner) onBackfill(ch workflow.Channel, async bool) bool {
+	var req BackfillRequest
+	if !receive(r.ctx, ch, &req, async) {
+		return false
+	}
+	if err := validateBackfillRequest(&req); err != nil {
+		r.logger.Warn("ignoring invalid backfill request", zap.Error(err))
+		return true
+	}
+	count := 0
+	for t := r.cron.Next(req.StartTime.Add(-time.Nanosecond)); !t.IsZero() && !t.After(req.EndTime); t = r.cron.Next(t) {
+		if count >= maxBackfillActions {
+			r.logger.Warn("backfill truncated", zap.Time("last-nominal-time", t), zap.Int("max-actions", maxBackfillActions))
+			break
+		}
+		r.takeAction(t, req.OverlapPolicy)
+		count++
+	}
+	return true
+}
+
+func (r *scheduleRunner) onDelete(ch workflow.Channel, async bool) bool {
+	if !receive(r.ctx, ch, nil, async) {
+		return false
+	}
+	r.deleted = true
+	return true
+}
+
+// processDue takes the action of the oldest due firing, one firing at a time so that
+// signals are handled in between, and skips the firings overdue for longer than catchupWindow
+func (r *scheduleRunner) processDue() {
+	if r.schedule.Paused {
+		return
+	}
+	now := workflow.Now(r.ctx)
+	for {
+		next := r.nextTime(r.state.LastProcessedTime)
+		if next.IsZero() {
+			return
+		}
+		if now.Sub(next) > catchupWindow {
+			r.state.LastProcessedTime = next
+			r.state.SkippedActions++
+			continue
+		}
+		if next.Add(r.jitterFor(next)).After(now) {
+			return
+		}
+		r.state.LastProcessedTime = next
+		r.takeAction(next, r.schedule.OverlapPolicy)
+		return
+	}
+}
+
+// processBuffered starts the oldest buffered firing once the previous runs are closed
+func (r *scheduleRunner) processBuffered() {
+	if r.schedule.Paused || len(r.state.Buffered) == 0 {
+		return
+	}
+	r.refreshRunning()
+	if len(r.state.Running) > 0 {
+		return
+	}
+	nominalTime := r.state.Buffered[0]
+	r.state.Buffered = r.state.Buffered[1:]
+	r.actions++
+	r.startWorkflow(nominalTime)
+}
+
+func (r *scheduleRunner) takeAction(nominalTime time.Time, policy OverlapPolicy) {
+	r.actions++
+	policy = policy.orDefault(r.schedule.OverlapPolicy)
+	r.refreshRunning()
+	if len(r.state.Running) > 0 {
+		switch policy {
+		case OverlapPolicySkip:
+			r.recordAction(ActionResult{NominalTime: nominalTime, ActualTime: workflow.Now(r.ctx), Skipped: true})
+			return
+		case OverlapPolicyBuffer:
+			if len(r.state.Buffered) >= maxBufferedActions {
+				r.recordAction(ActionResult{NominalTime: nominalTime, ActualTime: workflow.Now(r.ctx), Skipped: true})
+				return
+			}
+			r.state.Buffered = append(r.state.Buffered, nominalTime)
+			return
+		case OverlapPolicyCancelPrevious:
+			r.cancelRunning()
+		}
+	}
+	r.startWorkflow(nominalTime)
+}
+
+func (r *scheduleRunner) startWorkflow(nominalTime time.Time) {
+	result := ActionResult{
+		NominalTime: nominalTime,
+		ActualTime:  workflow.Now(r.ctx),
+	}
+	params := &StartWorkflowActivityParams{
+		Domain:      r.domain,
+		ScheduleID:  r.scheduleID,
+		NominalTime: nominalTime,
+		Action:      r.schedule.Action,
+	}
+	var execution Execution
+	err := workflow.ExecuteActivity(workflow.WithActivityOptions(r.ctx, getActivityOptions()), StartWorkflowActivity, params).Get(r.ctx, &execution)
+	if err != nil {
+		r.logger.Warn("failed to start scheduled workflow", zap.Time("nominal-time", nominalTime), zap.Error(err))
+		result.Error = err.Error()
+	} else {
+		result.Execution = &execution
+		r.state.Running = append(r.state.Running, execution)
+	}
+	r.recordAction(result)
+}
+
+// refreshRunning drops the closed workflows from the running list, keeping
+// the list untouched if their status cannot be retrieved
+func (r *scheduleRunner) refreshRunning() {
+	if len(r.state.Running) == 0 {
+		return
+	}
+	params := &WorkflowsActivityParams{Executions: r.state.Running}
+	var running []Execution
+	err := workflow.ExecuteActivity(workflow.WithActivityOptions(r.ctx, getActivityOptions()), GetRunningWorkflowsActivity, params).Get(r.ctx, &running)
+	if err != nil {
+		r.logger.Warn("failed to get running workflows", zap.Error(err))
+		return
+	}
+	r.state.Running = running
+}
+
+func (r *scheduleRunner) cancelRunning() {
+	params := &WorkflowsActivityParams{
+		Executions: r.state.Running,
+		Reason:     fmt.Sprintf("cancelled by schedule %v overlap policy", r.scheduleID),
+	}
+	err := workflow.ExecuteActivity(workflow.WithActivityOptions(r.ctx, getActivityOptions()), CancelWorkflowsActivity, params).Get(r.ctx, nil)
+	if err != nil {
+		r.logger.Warn("failed to cancel previous workflows", zap.Error(err))
+		return
+	}
+	r.state.Running = nil
+}
+
+func (r *scheduleRunner) recordAction(result ActionResult) {
+	if result.Skipped {
+		r.state.SkippedActions++
+	} else {
+		r.state.TotalActions++
+	}
+	r.state.RecentActions = append(r.state.RecentActions, result)
+	if len(r.state.RecentActions) > maxRecentActions {
+		r.state.RecentActions = r.state.RecentActions[len(r.state.RecentActions)-maxRecentActions:]
+	}
+}
+
+// nextWakeup returns how long to sleep until the next firing or buffer check,
+// and false if the workflow should only wait for signals
+func (r *scheduleRunner) nextWakeup() (time.Duration, bool) {
+	if r.schedule.Paused {
+		return 0, false
+	}
+	var wakeup time.Duration
+	found := false
+	if next := r.nextTime(r.state.LastProcessedTime); !next.IsZero() {
+		wakeup = next.Add(r.jitterFor(next)).Sub(workflow.Now(r.ctx))
+		found = true
+	}
+	if len(r.state.Buffered) > 0 && (!found || bufferCheckInterval < wakeup) {
+		wakeup = bufferCheckInterval
+		found = true
+	}
+	if wakeup < 0 {
+		wakeup = 0
+	}
+	return wakeup, found
+}
+
+// nextTime returns the first firing after the given time, or zero if the schedule has ended
+func (r *scheduleRunner) nextTime(after time.Time) time.Time {
+	return nextScheduleTime(r.cron, r.schedule.Spec, after)
+}
+
+// jitterFor returns the random delay of the given firing
+func (r *scheduleRunner) jitterFor(nominalTime time.Time) time.Duration {
+	if r.schedule.Spec.Jitter <= 0 {
+		return 0
+	}
+	if !r.jitterTime.Equal(nominalTime) {
+		maxJitter := int64(r.schedule.Spec.Jitter)
+		var jitter time.Duration
+		if err := workflow.SideEffect(r.ctx, func(ctx workflow.Context) interface{} {
+			return time.Duration(rand.Int63n(maxJitter + 1))
+		}).Get(&jitter); err != nil {
+			jitter = 0
+		}
+		r.jitterTime = nominalTime
+		r.jitter = jitter
+	}
+	return r.jitter
+}
+
+func (r *scheduleRunner) describe() *Description {
+	desc := &Description{
+		Domain:     r.domain,
+		ScheduleID: r.scheduleID,
+		Schedule:   r.schedule,
+		State:      r.state,
+	}
+	t := r.state.LastProcessedTime
+	for i := 0; i < numNextRunTimes; i++ {
+		t = r.nextTime(t)
+		if t.IsZero() {
+			break
+		}
+		desc.NextRunTimes = append(desc.NextRunTimes, t)
+	}
+	return desc
+}
+
+func nextScheduleTime(sched cron.Schedule, spec Spec, after time.Time) time.Time {
+	if !spec.StartTime.IsZero() && after.Before(spec.StartTime) {
+		after = spec.StartTime.Add(-time.Nanosecond)
+	}
+	next := sched.Next(after)
+	if !spec.EndTime.IsZero() && next.After(spec.EndTime) {
+		return time.Time{}
+	}
+	return next
+}
+
+func receive(ctx workflow.Context, ch workflow.Channel, valuePtr interface{}, async bool) bool {
+	if async {
+		return ch.ReceiveAsync(valuePtr)
+	}
+	ch.Receive(ctx, valuePtr)
+	return true
+}
+
+func validateSchedule(domain string, schedule *Schedule) (cron.Schedule, error) {
+	if schedule == nil {
+		return nil, errors.New("schedule is nil")
+	}
+	if domain == "" {
+		return nil, errors.New("schedule domain is required")
+	}
+	sched, err := backoff.ValidateSchedule(schedule.Spec.CronExpression)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Spec.Jitter < 0 {
+		return nil, errors.New("jitter must not be negative")
+	}
+	if !schedule.Spec.StartTime.IsZero() && !schedule.Spec.EndTime.IsZero() && !schedule.Spec.EndTime.After(schedule.Spec.StartTime) {
+		return nil, errors.New("end time must be after start time")
+	}
+	if err := schedule.OverlapPolicy.Validate(); err != nil {
+		return nil, err
+	}
+	action := schedule.Action
+	if action.Domain != domain {
+		return nil, fmt.Errorf("action domain %q doesn't match the schedule domain %q", action.Domain, domain)
+	}
+	if action.WorkflowType == "" || action.TaskList == "" {
+		return nil, errors.New("action workflow type and tasklist are required")
+	}
+	if action.ExecutionStartToCloseTimeout < time.Second {
+		return nil, errors.New("action execution timeout must be at least one second")
+	}
+	if action.TaskStartToCloseTimeout < 0 {
+		return nil, errors.New("action decision timeout must not be negative")
+	}
+	return sched, nil
+}
+
+func validateBackfillRequest(req *BackfillRequest) error {
+	if req == nil {
+		return errors.New("backfill request is nil")
+	}
+	if !req.EndTime.After(req.StartTime) {
+		return errors.New("backfill end time must be after start time")
+	}
+	return req.OverlapPolicy.Validate()
+}
+
+func getActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: time.Minute,
+		},
+	}
+}
@@ -0,0 +1,510 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/encoded"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+var testStartTime = time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+
+type scheduleWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestScheduleWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(scheduleWorkflowTestSuite))
+}
+
+func (s *scheduleWorkflowTestSuite) SetupTest() {
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.SetStartTime(testStartTime)
+	s.workflowEnv.RegisterWorkflowWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(GetRunningWorkflowsActivity, activity.RegisterOptions{Name: getRunningWorkflowsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CancelWorkflowsActivity, activity.RegisterOptions{Name: cancelWorkflowsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.activityEnv.RegisterActivityWithOptions(GetRunningWorkflowsActivity, activity.RegisterOptions{Name: getRunningWorkflowsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(CancelWorkflowsActivity, activity.RegisterOptions{Name: cancelWorkflowsActivityName})
+}
+
+func (s *scheduleWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestValidateSchedule() {
+	_, err := validateSchedule("d", nil)
+	s.Error(err)
+	schedule := testSchedule(OverlapPolicySkip)
+	_, err = validateSchedule("d", schedule)
+	s.NoError(err)
+
+	schedule.Spec.CronExpression = "invalid"
+	_, err = validateSchedule("d", schedule)
+	s.Error(err)
+
+	schedule = testSchedule(OverlapPolicy("unknown"))
+	_, err = validateSchedule("d", schedule)
+	s.Error(err)
+
+	schedule = testSchedule(OverlapPolicySkip)
+	schedule.Spec.Jitter = -time.Second
+	_, err = validateSchedule("d", schedule)
+	s.Error(err)
+
+	schedule = testSchedule(OverlapPolicySkip)
+	schedule.Spec.StartTime = testStartTime
+	schedule.Spec.EndTime = testStartTime.Add(-time.Hour)
+	_, err = validateSchedule("d", schedule)
+	s.Error(err)
+
+	schedule = testSchedule(OverlapPolicySkip)
+	_, err = validateSchedule("", schedule)
+	s.Error(err)
+
+	schedule = testSchedule(OverlapPolicySkip)
+	schedule.Action.Domain = "other"
+	_, err = validateSchedule("d", schedule)
+	s.Error(err)
+
+	schedule = testSchedule(OverlapPolicySkip)
+	schedule.Action.TaskList = ""
+	_, err = validateSchedule("d", schedule)
+	s.Error(err)
+
+	schedule = testSchedule(OverlapPolicySkip)
+	schedule.Action.ExecutionStartToCloseTimeout = 0
+	_, err = validateSchedule("d", schedule)
+	s.Error(err)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1"})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_FiresOnSchedule() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params *StartWorkflowActivityParams) (*Execution, error) {
+			return &Execution{Domain: params.Action.Domain, WorkflowID: getActionWorkflowID(params.ScheduleID, params.NominalTime), RunID: "r"}, nil
+		}).Times(3)
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return(nil, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, 3*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *testSchedule(OverlapPolicySkip)})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	desc := s.describe()
+	s.Equal(int64(3), desc.State.TotalActions)
+	s.Equal(int64(0), desc.State.SkippedActions)
+	s.Len(desc.State.RecentActions, 3)
+	s.Equal(time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC), desc.State.RecentActions[0].NominalTime)
+	s.Equal("s1-2024-01-01T00:01:00Z", desc.State.RecentActions[0].Execution.WorkflowID)
+	s.Equal(time.Date(2024, 1, 1, 0, 3, 0, 0, time.UTC), desc.State.LastProcessedTime)
+	s.Len(desc.NextRunTimes, numNextRunTimes)
+	s.Equal(time.Date(2024, 1, 1, 0, 4, 0, 0, time.UTC), desc.NextRunTimes[0])
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_Jitter() {
+	schedule := testSchedule(OverlapPolicyAllowAll)
+	schedule.Spec.Jitter = 10 * time.Second
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(&Execution{RunID: "r"}, nil).Twice()
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return(nil, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, 2*time.Minute+time.Second)
+
+	var actualTimes []time.Time
+	s.workflowEnv.SetOnActivityStartedListener(func(info *activity.Info, ctx context.Context, args encoded.Values) {
+		if info.ActivityType.Name == startWorkflowActivityName {
+			actualTimes = append(actualTimes, s.workflowEnv.Now())
+		}
+	})
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *schedule})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Len(actualTimes, 2)
+	nominal := time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)
+	s.False(actualTimes[0].Before(nominal))
+	s.False(actualTimes[0].After(nominal.Add(schedule.Spec.Jitter)))
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_OverlapSkip() {
+	running := []Execution{{Domain: "d", WorkflowID: "w", RunID: "r"}}
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(&running[0], nil).Once()
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return(running, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, 3*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *testSchedule(OverlapPolicySkip)})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	desc := s.describe()
+	s.Equal(int64(1), desc.State.TotalActions)
+	s.Equal(int64(2), desc.State.SkippedActions)
+	s.True(desc.State.RecentActions[2].Skipped)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_OverlapBuffer() {
+	running := []Execution{{Domain: "d", WorkflowID: "w", RunID: "r"}}
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(&running[0], nil).Twice()
+	// the first run stays open until the buffered firing checks it after a minute
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return(running, nil).Once()
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNamePause, &PauseRequest{Reason: "stop"})
+	}, 2*time.Minute+time.Second)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, 5*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *testSchedule(OverlapPolicyBuffer)})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	desc := s.describe()
+	s.Equal(int64(2), desc.State.TotalActions)
+	s.Empty(desc.State.Buffered)
+	s.Equal(time.Date(2024, 1, 1, 0, 2, 0, 0, time.UTC), desc.State.RecentActions[1].NominalTime)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_OverlapCancelPrevious() {
+	running := []Execution{{Domain: "d", WorkflowID: "w", RunID: "r"}}
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(&running[0], nil).Twice()
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return(running, nil)
+	s.workflowEnv.OnActivity(cancelWorkflowsActivityName, mock.Anything, mock.MatchedBy(func(params *WorkflowsActivityParams) bool {
+		return len(params.Executions) == 1 && params.Executions[0] == running[0]
+	})).Return(nil).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, 2*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *testSchedule(OverlapPolicyCancelPrevious)})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Equal(int64(2), s.describe().State.TotalActions)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_StartFailure() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(nil, errors.New("mockErr"))
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *testSchedule(OverlapPolicySkip)})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	desc := s.describe()
+	s.Equal(int64(1), desc.State.TotalActions)
+	s.Nil(desc.State.RecentActions[0].Execution)
+	s.Contains(desc.State.RecentActions[0].Error, "mockErr")
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_PauseResume() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(&Execution{RunID: "r"}, nil).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNamePause, &PauseRequest{Reason: "maintenance"})
+	}, 0)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Schedule.Paused)
+		s.Equal("maintenance", desc.State.PauseReason)
+		s.Equal(int64(0), desc.State.TotalActions)
+	}, 5*time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameResume, nil)
+	}, 5*time.Minute+time.Second)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, 6*time.Minute+time.Second)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *testSchedule(OverlapPolicySkip)})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	desc := s.describe()
+	s.False(desc.Schedule.Paused)
+	s.Empty(desc.State.PauseReason)
+	s.Equal(int64(1), desc.State.TotalActions)
+	s.Equal(time.Date(2024, 1, 1, 0, 6, 0, 0, time.UTC), desc.State.RecentActions[0].NominalTime)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_Update() {
+	updated := testSchedule(OverlapPolicySkip)
+	updated.Spec.CronExpression = "0 * * * *"
+	updated.Action.WorkflowType = "updated-type"
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.MatchedBy(func(params *StartWorkflowActivityParams) bool {
+		return params.Action.WorkflowType == "updated-type"
+	})).Return(&Execution{RunID: "r"}, nil).Once()
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameUpdate, updated)
+	}, 0)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, time.Hour)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *testSchedule(OverlapPolicySkip)})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	desc := s.describe()
+	s.Equal("0 * * * *", desc.Schedule.Spec.CronExpression)
+	s.Equal(int64(1), desc.State.TotalActions)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_UpdateToOtherDomainIgnored() {
+	updated := testSchedule(OverlapPolicySkip)
+	updated.Spec.CronExpression = "0 * * * *"
+	updated.Action.Domain = "other"
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.MatchedBy(func(params *StartWorkflowActivityParams) bool {
+		return params.Action.Domain == "d"
+	})).Return(&Execution{RunID: "r"}, nil)
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return(nil, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameUpdate, updated)
+	}, 0)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, 2*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *testSchedule(OverlapPolicySkip)})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	desc := s.describe()
+	s.Equal("* * * * *", desc.Schedule.Spec.CronExpression)
+	s.Equal("d", desc.Schedule.Action.Domain)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_Backfill() {
+	schedule := testSchedule(OverlapPolicySkip)
+	schedule.Spec.CronExpression = "0 * * * *"
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(&Execution{RunID: "r"}, nil).Times(3)
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return([]Execution{{RunID: "r"}}, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameBackfill, &BackfillRequest{
+			StartTime:     testStartTime.Add(-3 * time.Hour),
+			EndTime:       testStartTime,
+			OverlapPolicy: OverlapPolicyAllowAll,
+		})
+	}, 0)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *schedule})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	desc := s.describe()
+	s.Equal(int64(3), desc.State.TotalActions)
+	s.Equal(time.Date(2023, 12, 31, 22, 0, 0, 0, time.UTC), desc.State.RecentActions[0].NominalTime)
+	s.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), desc.State.RecentActions[2].NominalTime)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_EndTime() {
+	schedule := testSchedule(OverlapPolicySkip)
+	schedule.Spec.EndTime = testStartTime.Add(2 * time.Minute)
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(&Execution{RunID: "r"}, nil).Twice()
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return(nil, nil)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(SignalNameDelete, nil)
+	}, time.Hour)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *schedule})
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Empty(s.describe().NextRunTimes)
+}
+
+func (s *scheduleWorkflowTestSuite) TestWorkflow_ContinueAsNew() {
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(&Execution{RunID: "r"}, nil).Times(maxActionsPerRun)
+	s.workflowEnv.OnActivity(getRunningWorkflowsActivityName, mock.Anything, mock.Anything).Return(nil, nil)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{Domain: "d", ScheduleID: "s1", Schedule: *testSchedule(OverlapPolicySkip)})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var continueAsNew *workflow.ContinueAsNewError
+	s.ErrorAs(s.workflowEnv.GetWorkflowError(), &continueAsNew)
+}
+
+func (s *scheduleWorkflowTestSuite) TestStartWorkflowActivity() {
+	env, mockResource := s.prepareTestActivityEnv()
+	params := &StartWorkflowActivityParams{
+		Domain:      "d",
+		ScheduleID:  "s1",
+		NominalTime: testStartTime,
+		Action:      testSchedule(OverlapPolicySkip).Action,
+	}
+	mockResource.FrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			s.Equal("d", request.Domain)
+			s.Equal("s1-2024-01-01T00:00:30Z", request.WorkflowID)
+			s.Equal("wf-type", request.WorkflowType.Name)
+			s.Equal("tl", request.TaskList.Name)
+			s.Equal(int32(3600), *request.ExecutionStartToCloseTimeoutSeconds)
+			s.Equal(int32(defaultTaskStartToCloseTimeoutInSeconds), *request.TaskStartToCloseTimeoutSeconds)
+			s.Equal(types.WorkflowIDReusePolicyRejectDuplicate, *request.WorkflowIDReusePolicy)
+			return &types.StartWorkflowExecutionResponse{RunID: "r1"}, nil
+		})
+	actResult, err := env.ExecuteActivity(startWorkflowActivityName, params)
+	s.NoError(err)
+	var result Execution
+	s.NoError(actResult.Get(&result))
+	s.Equal(Execution{Domain: "d", WorkflowID: "s1-2024-01-01T00:00:30Z", RunID: "r1"}, result)
+}
+
+func (s *scheduleWorkflowTestSuite) TestStartWorkflowActivity_AlreadyStarted() {
+	env, mockResource := s.prepareTestActivityEnv()
+	params := &StartWorkflowActivityParams{
+		Domain:      "d",
+		ScheduleID:  "s1",
+		NominalTime: testStartTime,
+		Action:      testSchedule(OverlapPolicySkip).Action,
+	}
+	mockResource.FrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, &types.WorkflowExecutionAlreadyStartedError{RunID: "r0"})
+	actResult, err := env.ExecuteActivity(startWorkflowActivityName, params)
+	s.NoError(err)
+	var result Execution
+	s.NoError(actResult.Get(&result))
+	s.Equal("r0", result.RunID)
+}
+
+func (s *scheduleWorkflowTestSuite) TestStartWorkflowActivity_DomainMismatch() {
+	env, _ := s.prepareTestActivityEnv()
+	params := &StartWorkflowActivityParams{
+		Domain:      "other",
+		ScheduleID:  "s1",
+		NominalTime: testStartTime,
+		Action:      testSchedule(OverlapPolicySkip).Action,
+	}
+	_, err := env.ExecuteActivity(startWorkflowActivityName, params)
+	s.Error(err)
+}
+
+func (s *scheduleWorkflowTestSuite) TestGetRunningWorkflowsActivity() {
+	env, mockResource := s.prepareTestActivityEnv()
+	executions := []Execution{
+		{Domain: "d", WorkflowID: "open", RunID: "r1"},
+		{Domain: "d", WorkflowID: "closed", RunID: "r2"},
+		{Domain: "d", WorkflowID: "deleted", RunID: "r3"},
+	}
+	closeStatus := types.WorkflowExecutionCloseStatusCompleted
+	mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
+			switch request.Execution.WorkflowID {
+			case "open":
+				return &types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{}}, nil
+			case "closed":
+				return &types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: &closeStatus}}, nil
+			}
+			return nil, &types.EntityNotExistsError{}
+		}).Times(3)
+	actResult, err := env.ExecuteActivity(getRunningWorkflowsActivityName, &WorkflowsActivityParams{Executions: executions})
+	s.NoError(err)
+	var result []Execution
+	s.NoError(actResult.Get(&result))
+	s.Equal(executions[:1], result)
+}
+
+func (s *scheduleWorkflowTestSuite) TestCancelWorkflowsActivity() {
+	env, mockResource := s.prepareTestActivityEnv()
+	executions := []Execution{
+		{Domain: "d", WorkflowID: "w1", RunID: "r1"},
+		{Domain: "d", WorkflowID: "w2", RunID: "r2"},
+	}
+	mockResource.FrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	mockResource.FrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.WorkflowExecutionAlreadyCompletedError{})
+	_, err := env.ExecuteActivity(cancelWorkflowsActivityName, &WorkflowsActivityParams{Executions: executions, Reason: "overlap"})
+	s.NoError(err)
+}
+
+func (s *scheduleWorkflowTestSuite) TestCancelWorkflowsActivity_Error() {
+	env, mockResource := s.prepareTestActivityEnv()
+	mockResource.FrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.InternalServiceError{Message: "mockErr"})
+	_, err := env.ExecuteActivity(cancelWorkflowsActivityName, &WorkflowsActivityParams{Executions: []Execution{{WorkflowID: "w1"}}})
+	s.Error(err)
+}
+
+func (s *scheduleWorkflowTestSuite) describe() *Description {
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryTypeDescribe)
+	s.Require().NoError(err)
+	var desc Description
+	s.Require().NoError(queryResult.Get(&desc))
+	return &desc
+}
+
+func (s *scheduleWorkflowTestSuite) prepareTestActivityEnv() (*testsuite.TestActivityEnvironment, *resource.Test) {
+	controller := gomock.NewController(s.T())
+	mockResource := resource.NewTest(s.T(), controller, metrics.Worker)
+
+	ctx := &Scheduler{
+		svcClient:  mockResource.GetSDKClient(),
+		clientBean: mockResource.ClientBean,
+	}
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), schedulerContextKey, ctx),
+	})
+
+	s.T().Cleanup(func() {
+		mockResource.Finish(s.T())
+	})
+
+	return s.activityEnv, mockResource
+}
+
+func testSchedule(policy OverlapPolicy) *Schedule {
+	return &Schedule{
+		Spec: Spec{
+			CronExpression: "* * * * *",
+		},
+		Action: Action{
+			Domain:                       "d",
+			WorkflowType:                 "wf-type",
+			TaskList:                     "tl",
+			ExecutionStartToCloseTimeout: time.Hour,
+		},
+		OverlapPolicy: policy,
+	}
+}
@@ -45,15 +45,18 @@ import (
 	"github.com/uber/cadence/service/worker/diagnostics"
 	"github.com/uber/cadence/service/worker/domaindeprecation"
 	"github.com/uber/cadence/service/worker/esanalyzer"
+	"github.com/uber/cadence/service/worker/failovercontroller"
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 	"github.com/uber/cadence/service/worker/replicator"
+	"github.com/uber/cadence/service/worker/restorer"
 	"github.com/uber/cadence/service/worker/scanner"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 type (
@@ -73,6 +76,7 @@ type (
 	// Config contains all the service config for worker
 	Config struct {
 		AdminOperationToken                 dynamicproperties.StringPropertyFn
+		EnableAdminProtection               dynamicproperties.BoolPropertyFn
 		KafkaCfg                            config.KafkaConfig
 		ArchiverConfig                      *archiver.Config
 		IndexerCfg                          *indexer.Config
@@ -80,6 +84,7 @@ type (
 		BatcherCfg                          *batcher.Config
 		ESAnalyzerCfg                       *esanalyzer.Config
 		failoverManagerCfg                  *failovermanager.Config
+		activeClusterFailoverCfg            *failovercontroller.Config
 		ThrottledLogRPS                     dynamicproperties.IntPropertyFn
 		PersistenceGlobalMaxQPS             dynamicproperties.IntPropertyFn
 		PersistenceMaxQPS                   dynamicproperties.IntPropertyFn
@@ -87,6 +92,8 @@ type (
 		EnableParentClosePolicyWorker       dynamicproperties.BoolPropertyFn
 		NumParentClosePolicySystemWorkflows dynamicproperties.IntPropertyFn
 		EnableFailoverManager               dynamicproperties.BoolPropertyFn
+		EnableActiveClusterFailover         dynamicproperties.BoolPropertyFn
+		EnableScheduler                     dynamicproperties.BoolPropertyFn
 		DomainReplicationMaxRetryDuration   dynamicproperties.DurationPropertyFn
 		EnableESAnalyzer                    dynamicproperties.BoolPropertyFn
 		EnableAsyncWorkflowConsumption      dynamicproperties.BoolPropertyFn
@@ -130,7 +137,8 @@ func NewConfig(params *resource.Params) *Config {
 		dynamicproperties.ClusterNameFilter(params.ClusterMetadata.GetCurrentClusterName()),
 	)
 	config := &Config{
-		AdminOperationToken: dc.GetStringProperty(dynamicproperties.AdminOperationToken),
+		AdminOperationToken:   dc.GetStringProperty(dynamicproperties.AdminOperationToken),
+		EnableAdminProtection: dc.GetBoolProperty(dynamicproperties.EnableAdminProtection),
 		ArchiverConfig: &archiver.Config{
 			ArchiverConcurrency:             dc.GetIntProperty(dynamicproperties.WorkerArchiverConcurrency),
 			ArchivalsPerIteration:           dc.GetIntProperty(dynamicproperties.WorkerArchivalsPerIteration),
@@ -155,12 +163,26 @@ func NewConfig(params *resource.Params) *Config {
 				timers.ScannerConfig(dc),
 			},
 			MaxWorkflowRetentionInDays: dc.GetIntProperty(dynamicproperties.MaxRetentionDays),
+			ArchivalScannerEnabled:     dc.GetBoolProperty(dynamicproperties.ArchivalScannerEnabled),
+			ArchivalRetentionInDays:    dc.GetIntPropertyFilteredByDomain(dynamicproperties.ArchivalRetentionInDays),
 		},
 		KafkaCfg: params.KafkaConfig,
 		BatcherCfg: &batcher.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicproperties.AdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
+		activeClusterFailoverCfg: &failovercontroller.Config{
+			EnabledForDomain:              dc.GetBoolPropertyFilteredByDomain(dynamicproperties.ActiveClusterFailoverEnabledForDomain),
+			DryRun:                        dc.GetBoolProperty(dynamicproperties.ActiveClusterFailoverDryRun),
+			Interval:                      dc.GetDurationProperty(dynamicproperties.ActiveClusterFailoverInterval),
+			Cooldown:                      dc.GetDurationProperty(dynamicproperties.ActiveClusterFailoverCooldown),
+			MaxFailoversPerRound:          dc.GetIntProperty(dynamicproperties.ActiveClusterFailoverMaxFailoversPerRound),
+			UnhealthyThreshold:            dc.GetIntProperty(dynamicproperties.ActiveClusterFailoverUnhealthyThreshold),
+			MaxReplicationLag:             dc.GetDurationProperty(dynamicproperties.ActiveClusterFailoverMaxReplicationLag),
+			ReplicationLagShardSampleSize: dc.GetIntProperty(dynamicproperties.ActiveClusterFailoverReplicationLagShardSampleSize),
+			DrainedClusters:               dc.GetListProperty(dynamicproperties.ActiveClusterFailoverDrainedClusters),
+			WitnessCluster:                dc.GetStringProperty(dynamicproperties.ActiveClusterFailoverWitnessCluster),
+		},
 		failoverManagerCfg: &failovermanager.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicproperties.AdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
@@ -185,6 +207,8 @@ func NewConfig(params *resource.Params) *Config {
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicproperties.NumParentClosePolicySystemWorkflows),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicproperties.EnableESAnalyzer),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicproperties.EnableFailoverManager),
+		EnableActiveClusterFailover:         dc.GetBoolProperty(dynamicproperties.EnableActiveClusterFailoverController),
+		EnableScheduler:                     dc.GetBoolProperty(dynamicproperties.EnableScheduler),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicproperties.WorkerThrottledLogRPS),
 		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicproperties.WorkerPersistenceGlobalMaxQPS),
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicproperties.WorkerPersistenceMaxQPS),
@@ -240,6 +264,7 @@ func (s *Service) Start() {
 
 	if s.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival() {
 		s.startArchiver()
+		s.startArchiveRestorer()
 	}
 	if s.config.EnableBatcher() {
 		s.ensureDomainExists(constants.BatcherLocalDomainName)
@@ -254,6 +279,12 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.config.EnableScheduler() {
+		s.startScheduler()
+	}
+	if s.config.EnableActiveClusterFailover() {
+		s.startActiveClusterFailoverController()
+	}
 
 	cm := s.startAsyncWorkflowConsumerManager()
 	defer cm.Stop()
@@ -452,6 +483,27 @@ func (s *Service) startArchiver() {
 	}
 }
 
+func (s *Service) startArchiveRestorer() {
+	params := &restorer.BootstrapParams{
+		Config: restorer.Config{
+			EnableAdminProtection: s.config.EnableAdminProtection,
+			AdminOperationToken:   s.config.AdminOperationToken,
+		},
+		ServiceClient:    s.params.PublicClient,
+		ClientBean:       s.GetClientBean(),
+		DomainCache:      s.GetDomainCache(),
+		ArchiverProvider: s.GetArchiverProvider(),
+		ClusterMetadata:  s.GetClusterMetadata(),
+		MetricsClient:    s.GetMetricsClient(),
+		TallyScope:       s.params.MetricScope,
+		Logger:           s.GetLogger(),
+	}
+	if err := restorer.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting archive restorer", tag.Error(err))
+	}
+}
+
 func (s *Service) startFailoverManager() {
 	params := &failovermanager.BootstrapParams{
 		Config:        *s.config.failoverManagerCfg,
@@ -467,6 +519,39 @@ func (s *Service) startFailoverManager() {
 	}
 }
 
+func (s *Service) startActiveClusterFailoverController() {
+	controller := failovercontroller.New(&failovercontroller.Params{
+		Config:             s.config.activeClusterFailoverCfg,
+		ClusterMetadata:    s.GetClusterMetadata(),
+		ClientBean:         s.GetClientBean(),
+		DomainCache:        s.GetDomainCache(),
+		DomainAuditManager: s.GetDomainAuditManager(),
+		ShardManager:       s.GetShardManager(),
+		ExecutionManager:   s.Resource,
+		NumHistoryShards:   s.params.PersistenceConfig.NumHistoryShards,
+		MembershipResolver: s.GetMembershipResolver(),
+		HostInfo:           s.GetHostInfo(),
+		TimeSource:         s.GetTimeSource(),
+		MetricsClient:      s.GetMetricsClient(),
+		Logger:             s.GetLogger(),
+	})
+	controller.Start()
+}
+
+func (s *Service) startScheduler() {
+	params := &scheduler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := scheduler.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
 func (s *Service) startAsyncWorkflowConsumerManager() common.Daemon {
 	cm := asyncworkflow.NewConsumerManager(
 		s.GetLogger(),
@@ -155,6 +155,50 @@ func newAdminWorkflowCommands() []*cli.Command {
 			},
 			Action: AdminMaintainCorruptWorkflow,
 		},
+		{
+			Name:  "restore",
+			Usage: "Restore an archived workflow back into live persistence",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"w", "wid"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "RunID",
+				},
+				&cli.StringFlag{
+					Name:    FlagSecurityToken,
+					Aliases: []string{"st"},
+					Usage:   "Optional token for security check",
+				},
+			},
+			Action: AdminRestoreWorkflow,
+		},
+		{
+			Name:  "delete-archived",
+			Usage: "Delete the archived history and visibility records of a workflow",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"w", "wid"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "RunID",
+				},
+				&cli.StringFlag{
+					Name:    FlagSecurityToken,
+					Aliases: []string{"st"},
+					Usage:   "Optional token for security check",
+				},
+			},
+			Action: AdminDeleteArchivedWorkflow,
+		},
 	}
 }
 
@@ -491,6 +535,15 @@ func newAdminDomainCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "resilience",
+			Aliases: []string{"rr"},
+			Usage:   "Analyse how well the domain can survive the loss of a cluster, with a score and remediation items",
+			Flags: []cli.Flag{
+				getFormatFlag(),
+			},
+			Action: AdminDomainResilience,
+		},
 	}
 }
 
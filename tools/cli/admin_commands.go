@@ -28,6 +28,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/pborman/uuid"
 	"github.com/urfave/cli/v2"
 
 	"github.com/uber/cadence/common"
@@ -37,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 	"github.com/uber/cadence/gen/go/shared"
+	"github.com/uber/cadence/service/worker/restorer"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
 
@@ -711,6 +713,80 @@ func AdminRefreshWorkflowTasks(c *cli.Context) error {
 	return nil
 }
 
+// AdminRestoreWorkflow restores an archived workflow back into live persistence
+func AdminRestoreWorkflow(c *cli.Context) error {
+	return startArchivedWorkflowOperation(c, "restore", restorer.WorkflowID, restorer.WorkflowTypeName, "Restore is in progress.")
+}
+
+// AdminDeleteArchivedWorkflow deletes the archived history and visibility records of a workflow
+func AdminDeleteArchivedWorkflow(c *cli.Context) error {
+	return startArchivedWorkflowOperation(c, "delete", restorer.DeleteWorkflowID, restorer.DeleteWorkflowTypeName, "Delete is in progress.")
+}
+
+// startArchivedWorkflowOperation starts the system workflow running an operation on an archived workflow
+func startArchivedWorkflowOperation(
+	c *cli.Context,
+	operation string,
+	workflowIDFn func(domainName, workflowID, runID string) string,
+	workflowTypeName string,
+	inProgressMessage string,
+) error {
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	rid, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+
+	input, err := json.Marshal(restorer.Params{
+		DomainName:    domain,
+		WorkflowID:    wid,
+		RunID:         rid,
+		SecurityToken: c.String(FlagSecurityToken),
+	})
+	if err != nil {
+		return commoncli.Problem(fmt.Sprintf("Failed to encode %s parameters", operation), err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	startRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     constants.SystemLocalDomainName,
+		WorkflowID: workflowIDFn(domain, wid, rid),
+		WorkflowType: &types.WorkflowType{
+			Name: workflowTypeName,
+		},
+		TaskList: &types.TaskList{
+			Name: restorer.TaskListName,
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(restorer.WorkflowStartToCloseTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(restorer.WorkflowTaskStartToCloseTimeout.Seconds())),
+		RequestID:                           uuid.New(),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicateFailedOnly.Ptr(),
+		Input:                               input,
+	}
+	resp, err := frontendClient.StartWorkflowExecution(ctx, startRequest)
+	if err != nil {
+		return commoncli.Problem(fmt.Sprintf("Failed to start %s workflow", operation), err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "%s Workflow ID: %s, Run ID: %s\n", inProgressMessage, startRequest.WorkflowID, resp.GetRunID())
+	return nil
+}
+
 // AdminResetQueue resets task processing queue states
 func AdminResetQueue(c *cli.Context) error {
 	adminClient, err := getDeps(c).ServerAdminClient(c)
@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
@@ -41,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/restorer"
 	"github.com/uber/cadence/tools/cli/clitest"
 )
 
@@ -373,6 +375,194 @@ func TestAdminRefreshWorkflowTasks(t *testing.T) {
 	}
 }
 
+func TestAdminRestoreWorkflow(t *testing.T) {
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "no domain argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app /* arguments are missing */)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "missing runID argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					/* no runID argument */
+				)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "all arguments provided",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagRunID, testRunID),
+					clitest.StringArgument(FlagSecurityToken, "token"),
+				)
+
+				td.mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, constants.SystemLocalDomainName, req.Domain)
+						assert.Equal(t, restorer.WorkflowID(testDomain, testWorkflowID, testRunID), req.WorkflowID)
+						assert.Equal(t, restorer.WorkflowTypeName, req.WorkflowType.GetName())
+						assert.Equal(t, restorer.TaskListName, req.TaskList.GetName())
+						var params restorer.Params
+						assert.NoError(t, json.Unmarshal(req.Input, &params))
+						assert.Equal(t, restorer.Params{
+							DomainName:    testDomain,
+							WorkflowID:    testWorkflowID,
+							RunID:         testRunID,
+							SecurityToken: "token",
+						}, params)
+						return &types.StartWorkflowExecutionResponse{RunID: "restore-run-id"}, nil
+					})
+
+				return cliCtx
+			},
+			errContains: "",
+			expectedOutput: fmt.Sprintf("Restore is in progress. Workflow ID: %s, Run ID: restore-run-id\n",
+				restorer.WorkflowID(testDomain, testWorkflowID, testRunID)),
+		},
+		{
+			name: "StartWorkflowExecution returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagRunID, testRunID),
+				)
+
+				td.mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("some error"))
+
+				return cliCtx
+			},
+			errContains: "Failed to start restore workflow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminRestoreWorkflow(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
+func TestAdminDeleteArchivedWorkflow(t *testing.T) {
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing workflowID argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					/* no workflowID argument */
+				)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "all arguments provided",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagRunID, testRunID),
+					clitest.StringArgument(FlagSecurityToken, "token"),
+				)
+
+				td.mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, constants.SystemLocalDomainName, req.Domain)
+						assert.Equal(t, restorer.DeleteWorkflowID(testDomain, testWorkflowID, testRunID), req.WorkflowID)
+						assert.Equal(t, restorer.DeleteWorkflowTypeName, req.WorkflowType.GetName())
+						assert.Equal(t, restorer.TaskListName, req.TaskList.GetName())
+						var params restorer.Params
+						assert.NoError(t, json.Unmarshal(req.Input, &params))
+						assert.Equal(t, restorer.Params{
+							DomainName:    testDomain,
+							WorkflowID:    testWorkflowID,
+							RunID:         testRunID,
+							SecurityToken: "token",
+						}, params)
+						return &types.StartWorkflowExecutionResponse{RunID: "delete-run-id"}, nil
+					})
+
+				return cliCtx
+			},
+			errContains: "",
+			expectedOutput: fmt.Sprintf("Delete is in progress. Workflow ID: %s, Run ID: delete-run-id\n",
+				restorer.DeleteWorkflowID(testDomain, testWorkflowID, testRunID)),
+		},
+		{
+			name: "StartWorkflowExecution returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagRunID, testRunID),
+				)
+
+				td.mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("some error"))
+
+				return cliCtx
+			},
+			errContains: "Failed to start delete workflow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminDeleteArchivedWorkflow(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
 func TestAdminDescribeHistoryHost(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,98 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/tools/common/commoncli"
+	"github.com/uber/cadence/tools/common/resilience"
+)
+
+// ResilienceCheckRow is a row of the resilience report table
+type ResilienceCheckRow struct {
+	Check   string `header:"Check"`
+	Status  string `header:"Status"`
+	Weight  int    `header:"Weight"`
+	Details string `header:"Details"`
+}
+
+// AdminDomainResilience analyses how well a domain can survive the loss of a cluster and prints the remediation items
+func AdminDomainResilience(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+
+	report, err := resilience.Analyze(ctx, frontendClient, adminClient, domain, time.Now())
+	if err != nil {
+		return commoncli.Problem("Resilience analysis failed", err)
+	}
+
+	output := getDeps(c).Output()
+	if c.String(FlagFormat) == formatJSON {
+		prettyPrintJSONObject(output, report)
+		return nil
+	}
+
+	fmt.Fprintf(output, "Domain: %s\n", report.Domain)
+	fmt.Fprintf(output, "Score: %d/100 (%s)\n\n", report.Score, report.Rating)
+
+	table := make([]ResilienceCheckRow, 0, len(report.Checks))
+	for _, check := range report.Checks {
+		table = append(table, ResilienceCheckRow{
+			Check:   check.Name,
+			Status:  string(check.Status),
+			Weight:  check.Weight,
+			Details: check.Details,
+		})
+	}
+	if err := RenderTable(output, table, RenderOptions{Color: true}); err != nil {
+		return fmt.Errorf("failed to render the resilience checks: %w", err)
+	}
+
+	if len(report.Remediation) > 0 {
+		fmt.Fprintln(output, "\nRemediation:")
+		for i, item := range report.Remediation {
+			fmt.Fprintf(output, "%d. %s\n", i+1, item)
+		}
+	}
+	return nil
+}
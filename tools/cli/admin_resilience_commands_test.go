@@ -0,0 +1,96 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/cli/clitest"
+	"github.com/uber/cadence/tools/common/resilience"
+)
+
+func TestAdminDomainResilience(t *testing.T) {
+	localDomain := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: "test-domain", UUID: "domain-id"},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "cluster-a",
+			Clusters:          []*types.ClusterReplicationConfiguration{{ClusterName: "cluster-a"}},
+		},
+	}
+	expectChecks := func(td *cliTestData) {
+		td.mockFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(localDomain, nil)
+		td.mockAdminClient.EXPECT().GetGlobalIsolationGroups(gomock.Any(), gomock.Any()).Return(&types.GetGlobalIsolationGroupsResponse{}, nil)
+		td.mockAdminClient.EXPECT().GetDomainIsolationGroups(gomock.Any(), gomock.Any()).Return(&types.GetDomainIsolationGroupsResponse{}, nil)
+	}
+
+	t.Run("table", func(t *testing.T) {
+		td := newCLITestData(t)
+		expectChecks(td)
+
+		cliCtx := clitest.NewCLIContext(t, td.app, clitest.StringArgument(FlagDomain, "test-domain"))
+		require.NoError(t, AdminDomainResilience(cliCtx))
+
+		output := td.consoleOutput()
+		assert.Contains(t, output, "Score: 39/100 (not resilient)")
+		assert.Contains(t, output, "global-domain")
+		assert.Contains(t, output, "Remediation:")
+		assert.Contains(t, output, "1. Move the workflows to a global domain")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		td := newCLITestData(t)
+		expectChecks(td)
+
+		cliCtx := clitest.NewCLIContext(t, td.app,
+			clitest.StringArgument(FlagDomain, "test-domain"),
+			clitest.StringArgument(FlagFormat, formatJSON),
+		)
+		require.NoError(t, AdminDomainResilience(cliCtx))
+
+		var report resilience.Report
+		require.NoError(t, json.Unmarshal([]byte(td.consoleOutput()), &report))
+		assert.Equal(t, "test-domain", report.Domain)
+		assert.Equal(t, resilience.RatingNotResilient, report.Rating)
+		assert.Len(t, report.Checks, 9)
+	})
+
+	t.Run("missing domain", func(t *testing.T) {
+		td := newCLITestData(t)
+		cliCtx := clitest.NewCLIContext(t, td.app)
+		assert.ErrorContains(t, AdminDomainResilience(cliCtx), "Required flag not found")
+	})
+
+	t.Run("describe domain fails", func(t *testing.T) {
+		td := newCLITestData(t)
+		td.mockFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "domain not found"})
+
+		cliCtx := clitest.NewCLIContext(t, td.app, clitest.StringArgument(FlagDomain, "test-domain"))
+		assert.ErrorContains(t, AdminDomainResilience(cliCtx), "Resilience analysis failed")
+	})
+}
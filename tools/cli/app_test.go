@@ -401,10 +401,89 @@ func (s *cliAppSuite) TestRestartWorkflow_Failed() {
 }
 
 func (s *cliAppSuite) TestDiagnoseWorkflow() {
-	resp := &types.DiagnoseWorkflowExecutionResponse{Domain: "test", DiagnosticWorkflowExecution: &types.WorkflowExecution{WorkflowID: "123", RunID: uuid.New()}}
+	resp := &types.DiagnoseWorkflowExecutionResponse{Domain: "test", DiagnosticWorkflowExecution: &types.WorkflowExecution{WorkflowID: "123", RunID: "456"}}
 	s.serverFrontendClient.EXPECT().DiagnoseWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(1)
+	gomock.InOrder(
+		s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), &types.QueryWorkflowRequest{
+			Domain:    "test",
+			Execution: &types.WorkflowExecution{WorkflowID: "123", RunID: "456"},
+			Query:     &types.WorkflowQuery{QueryType: "query-diagnostics-report"},
+		}).Return(&types.QueryWorkflowResponse{
+			QueryResult: []byte(`{"DiagnosticsResult":{},"DiagnosticsCompleted":false}`),
+		}, nil),
+		s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&types.QueryWorkflowResponse{
+			QueryResult: []byte(`{
+				"DiagnosticsResult": {
+					"Timeouts": null,
+					"Failures": {"Issues": null, "RootCause": null, "Runbook": "failures runbook"},
+					"NonDeterminism": {
+						"Issues": [{"IssueID": 0, "InvariantType": "Non deterministic workflow", "Reason": "decision task failed", "Metadata": {"DecisionTaskID": 5}}],
+						"RootCause": [{"IssueID": 0, "RootCauseType": "Workflow code changed"}]
+					}
+				},
+				"DiagnosticsCompleted": true
+			}`),
+		}, nil),
+	)
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	defer func() {
+		os.Stdout = oldStdout
+	}()
+
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "diagnose", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+
+	w.Close()
+	var stdoutBuf bytes.Buffer
+	io.Copy(&stdoutBuf, r)
+
+	s.Contains(stdoutBuf.String(), `NonDeterminism:
+  Issue 0: Non deterministic workflow, decision task failed
+    Details: {"DecisionTaskID":5}
+    Root cause: Workflow code changed
+`)
+	s.NotContains(stdoutBuf.String(), "Failures")
+	s.NotContains(stdoutBuf.String(), "Timeouts")
+}
+
+func (s *cliAppSuite) TestDiagnoseWorkflow_NoIssues() {
+	resp := &types.DiagnoseWorkflowExecutionResponse{Domain: "test", DiagnosticWorkflowExecution: &types.WorkflowExecution{WorkflowID: "123", RunID: "456"}}
+	s.serverFrontendClient.EXPECT().DiagnoseWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(1)
+	s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&types.QueryWorkflowResponse{
+		QueryResult: []byte(`{"DiagnosticsResult":{"Timeouts":{"Issues":[]}},"DiagnosticsCompleted":true}`),
+	}, nil)
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	defer func() {
+		os.Stdout = oldStdout
+	}()
+
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "diagnose", "-w", "wid", "-r", "rid"})
 	s.Nil(err)
+
+	w.Close()
+	var stdoutBuf bytes.Buffer
+	io.Copy(&stdoutBuf, r)
+
+	s.Contains(stdoutBuf.String(), "No issues found.")
+}
+
+func (s *cliAppSuite) TestDiagnoseWorkflow_InvalidReport() {
+	resp := &types.DiagnoseWorkflowExecutionResponse{Domain: "test", DiagnosticWorkflowExecution: &types.WorkflowExecution{WorkflowID: "123", RunID: "456"}}
+	s.serverFrontendClient.EXPECT().DiagnoseWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(1)
+	s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&types.QueryWorkflowResponse{
+		QueryResult: []byte(`not json`),
+	}, nil)
+
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "diagnose", "-w", "wid", "-r", "rid"})
+	s.ErrorContains(err, "cadence --domain test workflow query --workflow_id 123 --run_id 456 --query_type query-diagnostics-report")
 }
 
 func (s *cliAppSuite) TestDiagnoseWorkflow_Failed() {
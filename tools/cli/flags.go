@@ -228,6 +228,14 @@ const (
 	FlagCronOverlapPolicy              = "cron_overlap_policy"
 	FlagClusterAttributeScope          = "cluster_attribute_scope"
 	FlagClusterAttributeName           = "cluster_attribute_name"
+	FlagScheduleID                     = "schedule_id"
+	FlagOverlapPolicy                  = "overlap_policy"
+	FlagJitterSeconds                  = "jitter_seconds"
+	FlagStartTime                      = "start_time"
+	FlagEndTime                        = "end_time"
+	FlagPaused                         = "paused"
+	FlagUpdateID                       = "update_id"
+	FlagUpdateWaitForStage             = "wait_for_stage"
 
 	FlagClustersUsage = "Clusters (example: --clusters clusterA,clusterB or --cl clusterA --cl clusterB)"
 )
@@ -507,6 +515,45 @@ func getFlagsForSignal() []cli.Flag {
 	}
 }
 
+func getFlagsForUpdate() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagWorkflowID,
+			Aliases: []string{"w", "wid"},
+			Usage:   "WorkflowID",
+		},
+		&cli.StringFlag{
+			Name:    FlagRunID,
+			Aliases: []string{"r", "rid"},
+			Usage:   "RunID",
+		},
+		&cli.StringFlag{
+			Name:    FlagName,
+			Aliases: []string{"n"},
+			Usage:   "Name of the update handler",
+		},
+		&cli.StringFlag{
+			Name:    FlagInput,
+			Aliases: []string{"i"},
+			Usage:   "Input for the update, in JSON format.",
+		},
+		&cli.StringFlag{
+			Name:    FlagInputFile,
+			Aliases: []string{"if"},
+			Usage:   "Input for the update from JSON file.",
+		},
+		&cli.StringFlag{
+			Name:  FlagUpdateID,
+			Usage: "Optional ID of the update, sending an update with the same ID again doesn't apply it twice. Generated if not set.",
+		},
+		&cli.StringFlag{
+			Name:  FlagUpdateWaitForStage,
+			Value: "completed",
+			Usage: "Stage of the update to wait for, accepted (validated by the workflow) or completed (handled by the workflow)",
+		},
+	}
+}
+
 func getFlagsForSignalWithStart() []cli.Flag {
 	return append(getFlagsForStart(),
 		&cli.StringFlag{
@@ -542,6 +589,29 @@ func getFlagsForCancel() []cli.Flag {
 	})
 }
 
+func getFlagsForPause() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:    FlagReason,
+		Aliases: []string{"re"},
+		Usage:   "The reason you want to pause the workflow",
+	})
+}
+
+func getFlagsForActivityPause() []cli.Flag {
+	return append(flagsForExecution,
+		&cli.StringFlag{
+			Name:    FlagActivityID,
+			Aliases: []string{"aid"},
+			Usage:   "The activityID to operate on",
+		},
+		&cli.StringFlag{
+			Name:    FlagReason,
+			Aliases: []string{"re"},
+			Usage:   "The reason for the operation",
+		},
+	)
+}
+
 func getFormatFlag() cli.Flag {
 	return &cli.StringFlag{
 		Name:  FlagFormat,
@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli/v2"
+
+func newScheduleCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a schedule starting a workflow in the domain on every firing of a cron spec",
+			Flags:   getFlagsForScheduleDefinition(),
+			Action:  CreateSchedule,
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the definition, state and upcoming firings of a schedule",
+			Flags:   []cli.Flag{getScheduleIDFlag()},
+			Action:  DescribeSchedule,
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Replace the definition of a schedule, the pause state is kept as is",
+			Flags:   getFlagsForScheduleDefinition(),
+			Action:  UpdateSchedule,
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule, it doesn't fire until resumed",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				&cli.StringFlag{
+					Name:    FlagReason,
+					Aliases: []string{"re"},
+					Usage:   "Reason for pausing the schedule",
+				},
+			},
+			Action: PauseSchedule,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Resume a paused schedule, firings missed while paused are not taken",
+			Flags:  []cli.Flag{getScheduleIDFlag()},
+			Action: ResumeSchedule,
+		},
+		{
+			Name:  "backfill",
+			Usage: "Take the action of every firing of the schedule within a past time range",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				&cli.StringFlag{
+					Name:    FlagStartTime,
+					Aliases: []string{"st"},
+					Usage:   "Start of the time range, in UTC format '2006-01-02T15:04:05Z', raw UnixNano or time range (N<duration>)",
+				},
+				&cli.StringFlag{
+					Name:    FlagEndTime,
+					Aliases: []string{"et"},
+					Usage:   "End of the time range, in UTC format '2006-01-02T15:04:05Z', raw UnixNano or time range (N<duration>)",
+				},
+				&cli.StringFlag{
+					Name:    FlagOverlapPolicy,
+					Aliases: []string{"op"},
+					Usage:   "Optional overlap policy of the backfilled firings, defaults to the policy of the schedule. Available options: skip, buffer, cancel-previous, allow",
+				},
+			},
+			Action: BackfillSchedule,
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete a schedule, workflows already started by it are left running",
+			Flags:   []cli.Flag{getScheduleIDFlag()},
+			Action:  DeleteSchedule,
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of the domain",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:    FlagPageSize,
+					Aliases: []string{"ps"},
+					Value:   100,
+					Usage:   "Result page size",
+				},
+			},
+			Action: ListSchedules,
+		},
+	}
+}
+
+func getScheduleIDFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    FlagScheduleID,
+		Aliases: []string{"sid"},
+		Usage:   "ScheduleID",
+	}
+}
+
+func getFlagsForScheduleDefinition() []cli.Flag {
+	return []cli.Flag{
+		getScheduleIDFlag(),
+		&cli.StringFlag{
+			Name: FlagCronSchedule,
+			Usage: "Cron schedule of the firings. Cron spec is as following: \n" +
+				"\t┌───────────── minute (0 - 59) \n" +
+				"\t│ ┌───────────── hour (0 - 23) \n" +
+				"\t│ │ ┌───────────── day of the month (1 - 31) \n" +
+				"\t│ │ │ ┌───────────── month (1 - 12) \n" +
+				"\t│ │ │ │ ┌───────────── day of the week (0 - 6) (Sunday to Saturday) \n" +
+				"\t│ │ │ │ │ \n" +
+				"\t* * * * *",
+		},
+		&cli.StringFlag{
+			Name:    FlagOverlapPolicy,
+			Aliases: []string{"op"},
+			Value:   "skip",
+			Usage: "Optional policy when a firing overlaps with a workflow started by a previous firing. " +
+				"Available options: skip, buffer, cancel-previous, allow",
+		},
+		&cli.IntFlag{
+			Name:  FlagJitterSeconds,
+			Usage: "Optional maximum random delay in seconds added to each firing",
+		},
+		&cli.StringFlag{
+			Name:  FlagStartTime,
+			Usage: "Optional time before which the schedule doesn't fire, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		&cli.StringFlag{
+			Name:  FlagEndTime,
+			Usage: "Optional time after which the schedule doesn't fire, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		&cli.BoolFlag{
+			Name:  FlagPaused,
+			Usage: "Create the schedule in paused state, ignored on update",
+		},
+		&cli.StringFlag{
+			Name:    FlagTaskList,
+			Aliases: []string{"tl"},
+			Usage:   "TaskList of the started workflows",
+		},
+		&cli.StringFlag{
+			Name:    FlagWorkflowType,
+			Aliases: []string{"wt"},
+			Usage:   "WorkflowTypeName of the started workflows",
+		},
+		&cli.IntFlag{
+			Name:    FlagExecutionTimeout,
+			Aliases: []string{"et"},
+			Usage:   "Execution start to close timeout of the started workflows in seconds",
+		},
+		&cli.IntFlag{
+			Name:    FlagDecisionTimeout,
+			Aliases: []string{"dt"},
+			Value:   defaultDecisionTimeoutInSeconds,
+			Usage:   "Decision task start to close timeout of the started workflows in seconds",
+		},
+		&cli.StringFlag{
+			Name:    FlagInput,
+			Aliases: []string{"i"},
+			Usage:   "Optional input of the started workflows, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+		},
+		&cli.StringFlag{
+			Name:    FlagInputFile,
+			Aliases: []string{"if"},
+			Usage: "Optional input of the started workflows from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+				"Input from file will be overwrite by input from command line",
+		},
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) error {
+	spec, action, policy, err := constructSchedule(c)
+	if err != nil {
+		return err
+	}
+	return executeScheduleOperation(c, "create", func(ctx context.Context, client frontend.Client, domain, scheduleID string) error {
+		err := client.CreateSchedule(ctx, &types.CreateScheduleRequest{
+			Domain:        domain,
+			ScheduleID:    scheduleID,
+			Spec:          spec,
+			Action:        action,
+			OverlapPolicy: policy,
+			Paused:        c.Bool(FlagPaused),
+			Identity:      getCliIdentity(),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(getDeps(c).Output(), "Schedule %s created\n", scheduleID)
+		return nil
+	})
+}
+
+// DescribeSchedule describes a schedule
+func DescribeSchedule(c *cli.Context) error {
+	return executeScheduleOperation(c, "describe", func(ctx context.Context, client frontend.Client, domain, scheduleID string) error {
+		resp, err := client.DescribeSchedule(ctx, &types.DescribeScheduleRequest{
+			Domain:     domain,
+			ScheduleID: scheduleID,
+		})
+		if err != nil {
+			return err
+		}
+		prettyPrintJSONObject(getDeps(c).Output(), resp)
+		return nil
+	})
+}
+
+// UpdateSchedule replaces the definition of a schedule
+func UpdateSchedule(c *cli.Context) error {
+	spec, action, policy, err := constructSchedule(c)
+	if err != nil {
+		return err
+	}
+	return executeScheduleOperation(c, "update", func(ctx context.Context, client frontend.Client, domain, scheduleID string) error {
+		err := client.UpdateSchedule(ctx, &types.UpdateScheduleRequest{
+			Domain:        domain,
+			ScheduleID:    scheduleID,
+			Spec:          spec,
+			Action:        action,
+			OverlapPolicy: policy,
+			Identity:      getCliIdentity(),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(getDeps(c).Output(), "Schedule %s updated\n", scheduleID)
+		return nil
+	})
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) error {
+	return executeScheduleOperation(c, "pause", func(ctx context.Context, client frontend.Client, domain, scheduleID string) error {
+		err := client.PauseSchedule(ctx, &types.PauseScheduleRequest{
+			Domain:     domain,
+			ScheduleID: scheduleID,
+			Reason:     c.String(FlagReason),
+			Identity:   getCliIdentity(),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(getDeps(c).Output(), "Schedule %s paused\n", scheduleID)
+		return nil
+	})
+}
+
+// ResumeSchedule resumes a paused schedule
+func ResumeSchedule(c *cli.Context) error {
+	return executeScheduleOperation(c, "resume", func(ctx context.Context, client frontend.Client, domain, scheduleID string) error {
+		err := client.ResumeSchedule(ctx, &types.ResumeScheduleRequest{
+			Domain:     domain,
+			ScheduleID: scheduleID,
+			Identity:   getCliIdentity(),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(getDeps(c).Output(), "Schedule %s resumed\n", scheduleID)
+		return nil
+	})
+}
+
+// BackfillSchedule takes the actions of a schedule for a past time range
+func BackfillSchedule(c *cli.Context) error {
+	startTime, err := getRequiredOption(c, FlagStartTime)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	endTime, err := getRequiredOption(c, FlagEndTime)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	start, err := parseTime(startTime, 0)
+	if err != nil {
+		return commoncli.Problem("Failed to parse start time", err)
+	}
+	end, err := parseTime(endTime, 0)
+	if err != nil {
+		return commoncli.Problem("Failed to parse end time", err)
+	}
+	var policy *types.ScheduleOverlapPolicy
+	if c.IsSet(FlagOverlapPolicy) {
+		if policy, err = parseScheduleOverlapPolicy(c.String(FlagOverlapPolicy)); err != nil {
+			return err
+		}
+	}
+	return executeScheduleOperation(c, "backfill", func(ctx context.Context, client frontend.Client, domain, scheduleID string) error {
+		err := client.BackfillSchedule(ctx, &types.BackfillScheduleRequest{
+			Domain:         domain,
+			ScheduleID:     scheduleID,
+			StartTimestamp: common.Int64Ptr(start),
+			EndTimestamp:   common.Int64Ptr(end),
+			OverlapPolicy:  policy,
+			Identity:       getCliIdentity(),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(getDeps(c).Output(), "Schedule %s backfill requested\n", scheduleID)
+		return nil
+	})
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) error {
+	return executeScheduleOperation(c, "delete", func(ctx context.Context, client frontend.Client, domain, scheduleID string) error {
+		err := client.DeleteSchedule(ctx, &types.DeleteScheduleRequest{
+			Domain:     domain,
+			ScheduleID: scheduleID,
+			Identity:   getCliIdentity(),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(getDeps(c).Output(), "Schedule %s deleted\n", scheduleID)
+		return nil
+	})
+}
+
+// ListSchedules lists the schedules of a domain
+func ListSchedules(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	client, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	var nextPageToken []byte
+	for {
+		resp, err := client.ListSchedules(ctx, &types.ListSchedulesRequest{
+			Domain:        domain,
+			PageSize:      int32(c.Int(FlagPageSize)),
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return commoncli.Problem("Failed to list schedules", err)
+		}
+		for _, scheduleID := range resp.GetScheduleIDs() {
+			fmt.Fprintln(getDeps(c).Output(), scheduleID)
+		}
+		if len(resp.GetNextPageToken()) == 0 {
+			return nil
+		}
+		nextPageToken = resp.GetNextPageToken()
+	}
+}
+
+func executeScheduleOperation(
+	c *cli.Context,
+	operation string,
+	fn func(ctx context.Context, client frontend.Client, domain, scheduleID string) error,
+) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	client, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	if err := fn(ctx, client, domain, scheduleID); err != nil {
+		return commoncli.Problem(fmt.Sprintf("Failed to %s schedule", operation), err)
+	}
+	return nil
+}
+
+func constructSchedule(c *cli.Context) (*types.ScheduleSpec, *types.ScheduleAction, *types.ScheduleOverlapPolicy, error) {
+	cronSchedule, err := getRequiredOption(c, FlagCronSchedule)
+	if err != nil {
+		return nil, nil, nil, commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return nil, nil, nil, commoncli.Problem("Required flag not found: ", err)
+	}
+	workflowType, err := getRequiredOption(c, FlagWorkflowType)
+	if err != nil {
+		return nil, nil, nil, commoncli.Problem("Required flag not found: ", err)
+	}
+	et := c.Int(FlagExecutionTimeout)
+	if et <= 0 {
+		return nil, nil, nil, commoncli.Problem(fmt.Sprintf("Option %s format is invalid.", FlagExecutionTimeout), nil)
+	}
+	input, err := processJSONInput(c)
+	if err != nil {
+		return nil, nil, nil, commoncli.Problem("Error in processing input: ", err)
+	}
+	startTime, err := parseTime(c.String(FlagStartTime), 0)
+	if err != nil {
+		return nil, nil, nil, commoncli.Problem("Failed to parse start time", err)
+	}
+	endTime, err := parseTime(c.String(FlagEndTime), 0)
+	if err != nil {
+		return nil, nil, nil, commoncli.Problem("Failed to parse end time", err)
+	}
+	policy, err := parseScheduleOverlapPolicy(c.String(FlagOverlapPolicy))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	spec := &types.ScheduleSpec{
+		CronExpression:  cronSchedule,
+		JitterInSeconds: int32(c.Int(FlagJitterSeconds)),
+	}
+	if startTime > 0 {
+		spec.StartTimestamp = common.Int64Ptr(startTime)
+	}
+	if endTime > 0 {
+		spec.EndTimestamp = common.Int64Ptr(endTime)
+	}
+	action := &types.ScheduleAction{
+		WorkflowType:                        &types.WorkflowType{Name: workflowType},
+		TaskList:                            &types.TaskList{Name: taskList},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(c.Int(FlagDecisionTimeout))),
+	}
+	if len(input) > 0 {
+		action.Input = []byte(input)
+	}
+	return spec, action, policy, nil
+}
+
+func parseScheduleOverlapPolicy(policy string) (*types.ScheduleOverlapPolicy, error) {
+	switch policy {
+	case "", "skip":
+		return types.ScheduleOverlapPolicySkip.Ptr(), nil
+	case "buffer":
+		return types.ScheduleOverlapPolicyBuffer.Ptr(), nil
+	case "cancel-previous":
+		return types.ScheduleOverlapPolicyCancelPrevious.Ptr(), nil
+	case "allow":
+		return types.ScheduleOverlapPolicyAllowAll.Ptr(), nil
+	}
+	return nil, commoncli.Problem(fmt.Sprintf("Option %s format is invalid.", FlagOverlapPolicy), nil)
+}
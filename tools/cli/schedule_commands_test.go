@@ -0,0 +1,215 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestScheduleCommands(t *testing.T) {
+	createArgs := []string{
+		"--sid", "s1",
+		"--cron", "0 * * * *",
+		"--op", "buffer",
+		"--jitter_seconds", "30",
+		"--tl", "test-tl",
+		"--wt", "test-wf-type",
+		"--et", "3600",
+		"--i", `"input"`,
+	}
+	wantSpec := &types.ScheduleSpec{
+		CronExpression:  "0 * * * *",
+		JitterInSeconds: 30,
+	}
+	wantAction := &types.ScheduleAction{
+		WorkflowType:                        &types.WorkflowType{Name: "test-wf-type"},
+		TaskList:                            &types.TaskList{Name: "test-tl"},
+		Input:                               []byte(`"input"`),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+	}
+
+	tests := []struct {
+		desc       string
+		args       []string
+		mockFn     func(*testing.T, *frontend.MockClient)
+		wantOutput string
+		wantErr    bool
+	}{
+		{
+			desc: "create",
+			args: append([]string{"create", "--paused"}, createArgs...),
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, request *types.CreateScheduleRequest, opts ...yarpc.CallOption) error {
+						assert.Equal(t, domainName, request.Domain)
+						assert.Equal(t, "s1", request.ScheduleID)
+						assert.Equal(t, wantSpec, request.Spec)
+						assert.Equal(t, wantAction, request.Action)
+						assert.Equal(t, types.ScheduleOverlapPolicyBuffer.Ptr(), request.OverlapPolicy)
+						assert.True(t, request.Paused)
+						return nil
+					})
+			},
+			wantOutput: "Schedule s1 created\n",
+		},
+		{
+			desc:    "create without schedule id",
+			args:    append([]string{"create"}, createArgs[2:]...),
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc:    "create with invalid overlap policy",
+			args:    append([]string{"create"}, append(createArgs, "--op", "unknown")...),
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc: "create fails",
+			args: append([]string{"create"}, createArgs...),
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "invalid cron"})
+			},
+			wantErr: true,
+		},
+		{
+			desc: "describe",
+			args: []string{"describe", "--sid", "s1"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().DescribeSchedule(gomock.Any(), &types.DescribeScheduleRequest{Domain: domainName, ScheduleID: "s1"}).
+					Return(&types.DescribeScheduleResponse{PauseReason: "maintenance"}, nil)
+			},
+			wantOutput: `"pauseReason": "maintenance"`,
+		},
+		{
+			desc: "update",
+			args: append([]string{"update"}, createArgs...),
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().UpdateSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, request *types.UpdateScheduleRequest, opts ...yarpc.CallOption) error {
+						assert.Equal(t, domainName, request.Domain)
+						assert.Equal(t, "s1", request.ScheduleID)
+						assert.Equal(t, wantSpec, request.Spec)
+						assert.Equal(t, wantAction, request.Action)
+						return nil
+					})
+			},
+			wantOutput: "Schedule s1 updated\n",
+		},
+		{
+			desc: "pause",
+			args: []string{"pause", "--sid", "s1", "--reason", "maintenance"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().PauseSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, request *types.PauseScheduleRequest, opts ...yarpc.CallOption) error {
+						assert.Equal(t, domainName, request.Domain)
+						assert.Equal(t, "s1", request.ScheduleID)
+						assert.Equal(t, "maintenance", request.Reason)
+						return nil
+					})
+			},
+			wantOutput: "Schedule s1 paused\n",
+		},
+		{
+			desc: "resume",
+			args: []string{"resume", "--sid", "s1"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().ResumeSchedule(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantOutput: "Schedule s1 resumed\n",
+		},
+		{
+			desc: "backfill",
+			args: []string{"backfill", "--sid", "s1", "--st", "2024-01-01T00:00:00Z", "--et", "2024-01-02T00:00:00Z", "--op", "allow"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().BackfillSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, request *types.BackfillScheduleRequest, opts ...yarpc.CallOption) error {
+						assert.Equal(t, domainName, request.Domain)
+						assert.Equal(t, "s1", request.ScheduleID)
+						assert.Equal(t, int64(1704067200000000000), request.GetStartTimestamp())
+						assert.Equal(t, int64(1704153600000000000), request.GetEndTimestamp())
+						assert.Equal(t, types.ScheduleOverlapPolicyAllowAll.Ptr(), request.OverlapPolicy)
+						return nil
+					})
+			},
+			wantOutput: "Schedule s1 backfill requested\n",
+		},
+		{
+			desc:    "backfill without end time",
+			args:    []string{"backfill", "--sid", "s1", "--st", "2024-01-01T00:00:00Z"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc: "delete",
+			args: []string{"delete", "--sid", "s1"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().DeleteSchedule(gomock.Any(), &types.DeleteScheduleRequest{Domain: domainName, ScheduleID: "s1", Identity: getCliIdentity()}).Return(nil)
+			},
+			wantOutput: "Schedule s1 deleted\n",
+		},
+		{
+			desc: "list",
+			args: []string{"list", "--ps", "1"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().ListSchedules(gomock.Any(), &types.ListSchedulesRequest{Domain: domainName, PageSize: 1}).Return(&types.ListSchedulesResponse{
+					ScheduleIDs:   []string{"s1"},
+					NextPageToken: []byte("token"),
+				}, nil)
+				m.EXPECT().ListSchedules(gomock.Any(), &types.ListSchedulesRequest{Domain: domainName, PageSize: 1, NextPageToken: []byte("token")}).Return(&types.ListSchedulesResponse{
+					ScheduleIDs: []string{"s2"},
+				}, nil)
+			},
+			wantOutput: "s1\ns2\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			frontendCl := frontend.NewMockClient(ctrl)
+			tc.mockFn(t, frontendCl)
+			ioHandler := &testIOHandler{}
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: frontendCl,
+			}, WithIOHandler(ioHandler))
+
+			err := app.Run(append([]string{"", "--do", domainName, "schedule"}, tc.args...))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, ioHandler.outputBytes.String(), tc.wantOutput)
+		})
+	}
+}
@@ -97,6 +97,28 @@ func newWorkflowCommands() []*cli.Command {
 			Flags:   getFlagsForSignal(),
 			Action:  SignalWorkflow,
 		},
+		{
+			Name:  "update",
+			Usage: "send an update to a workflow execution and wait for it to be accepted or completed",
+			Description: "The update is handed to the workflow on its next decision task. The workflow accepts or rejects it, " +
+				"then completes it, and each outcome is recorded in the workflow history.",
+			Flags:  getFlagsForUpdate(),
+			Action: UpdateWorkflow,
+		},
+		{
+			Name:  "pause",
+			Usage: "pause a workflow execution so that it stops dispatching decision and activity tasks and firing timers",
+			Description: "Pause state is kept in the workflow mutable state and shown by describe. " +
+				"Signals are still accepted and queries are still answered while the workflow is paused.",
+			Flags:  getFlagsForPause(),
+			Action: PauseWorkflow,
+		},
+		{
+			Name:   "unpause",
+			Usage:  "unpause a paused workflow execution and dispatch its pending tasks",
+			Flags:  flagsForExecution,
+			Action: UnpauseWorkflow,
+		},
 		{
 			Name:   "signalwithstart",
 			Usage:  "signal the current open workflow if exists, or attempt to start a new run based on IDResuePolicy and signals it",
@@ -416,6 +438,24 @@ func newActivityCommands() []*cli.Command {
 			},
 			Action: FailActivity,
 		},
+		{
+			Name:   "pause",
+			Usage:  "pause a pending activity so that it is neither dispatched nor retried",
+			Flags:  getFlagsForActivityPause(),
+			Action: PauseActivity,
+		},
+		{
+			Name:   "unpause",
+			Usage:  "unpause a paused activity",
+			Flags:  getFlagsForActivityPause(),
+			Action: UnpauseActivity,
+		},
+		{
+			Name:   "reset",
+			Usage:  "reset the attempt and last failure of a pending activity that is not running, and dispatch its retry right away",
+			Flags:  getFlagsForActivityPause(),
+			Action: ResetActivity,
+		},
 	}
 }
 
@@ -505,6 +545,32 @@ func newBatchCommands() []*cli.Command {
 					Aliases: []string{"tc"},
 					Usage:   "Required for batch replicate",
 				},
+				&cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset. Where to reset, support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				&cli.IntFlag{
+					Name:  FlagDecisionOffset,
+					Usage: "Optional for batch reset. Based on the reset point calculated by resetType, this offset will move the point back by decisions. Only negative number is supported, and only works with LastDecisionCompleted and LastDecisionScheduled",
+				},
+				&cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Required for batch reset with resetType of BadBinary",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipSignalReapply,
+					Usage: "Optional for batch reset. Whether or not skipping signals reapply after the reset point",
+				},
+				&cli.StringFlag{
+					Name: FlagSearchAttributesKey,
+					Usage: "Required for batch upsert_search_attributes. Search attributes keys to upsert. If there are multiple keys, concatenate them and separate by |. " +
+						"Use 'cluster get-search-attr' cmd to list legal keys.",
+				},
+				&cli.StringFlag{
+					Name: FlagSearchAttributesVal,
+					Usage: "Required for batch upsert_search_attributes. Search attributes values to upsert. If there are multiple values, concatenate them and separate by |. " +
+						"The order must be same as " + FlagSearchAttributesKey,
+				},
 				&cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
@@ -22,15 +22,18 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/urfave/cli/v2"
 
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/types"
@@ -116,6 +119,11 @@ func DescribeBatchJob(c *cli.Context) error {
 			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetCloseStatus().String()
 		} else {
 			output["msg"] = "batch job is finished successfully"
+			hbd, err := getBatchJobResult(tcCtx, svcClient, wf.WorkflowExecutionInfo.Execution)
+			if err != nil {
+				return commoncli.Problem("Failed to get batch job result", err)
+			}
+			output["result"] = hbd
 		}
 	} else {
 		output["msg"] = "batch job is running"
@@ -133,6 +141,31 @@ func DescribeBatchJob(c *cli.Context) error {
 	return nil
 }
 
+// getBatchJobResult returns the result of a completed batch job, which includes the failed workflows
+func getBatchJobResult(ctx context.Context, svcClient frontend.Client, execution *types.WorkflowExecution) (*batcher.HeartBeatDetails, error) {
+	resp, err := svcClient.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 constants.BatcherLocalDomainName,
+		Execution:              execution,
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return nil, fmt.Errorf("batch job has no close event")
+	}
+	attributes := events[len(events)-1].GetWorkflowExecutionCompletedEventAttributes()
+	if attributes == nil {
+		return nil, fmt.Errorf("batch job is not completed")
+	}
+	hbd := &batcher.HeartBeatDetails{}
+	if err := json.Unmarshal(attributes.Result, hbd); err != nil {
+		return nil, err
+	}
+	return hbd, nil
+}
+
 // ListBatchJobs list the started batch jobs
 func ListBatchJobs(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
@@ -217,6 +250,26 @@ func StartBatchJob(c *cli.Context) error {
 			return commoncli.Problem("Required flag not found: ", err)
 		}
 	}
+	var resetType string
+	if batchType == batcher.BatchTypeReset {
+		resetType, err = getRequiredOption(c, FlagResetType)
+		if err != nil {
+			return commoncli.Problem("Required flag not found: ", err)
+		}
+		if !slices.Contains(batcher.AllResetTypes, resetType) {
+			return commoncli.Problem("resetType is not valid, supported:"+strings.Join(batcher.AllResetTypes, ","), nil)
+		}
+	}
+	var upsertSearchAttributes map[string][]byte
+	if batchType == batcher.BatchTypeUpsertSearchAttributes {
+		upsertSearchAttributes, err = processSearchAttr(c)
+		if err != nil {
+			return commoncli.Problem("Error in parsing search attributes: ", err)
+		}
+		if len(upsertSearchAttributes) == 0 {
+			return commoncli.Problem("Required flag not found: ", fmt.Errorf("option %s is required", FlagSearchAttributesKey))
+		}
+	}
 	var sourceCluster, targetCluster string
 	if batchType == batcher.BatchTypeReplicate {
 		sourceCluster, err = getRequiredOption(c, FlagSourceCluster)
@@ -292,6 +345,15 @@ func StartBatchJob(c *cli.Context) error {
 			SourceCluster: sourceCluster,
 			TargetCluster: targetCluster,
 		},
+		ResetParams: batcher.ResetParams{
+			ResetType:         resetType,
+			DecisionOffset:    c.Int(FlagDecisionOffset),
+			BadBinaryChecksum: c.String(FlagResetBadBinaryChecksum),
+			SkipSignalReapply: c.Bool(FlagSkipSignalReapply),
+		},
+		UpsertSearchAttributesParams: batcher.UpsertSearchAttributesParams{
+			SearchAttributes: upsertSearchAttributes,
+		},
 		RPS:                      rps,
 		Concurrency:              concurrency,
 		PageSize:                 pageSize,
@@ -66,6 +66,91 @@ func TestStartBatchJob(t *testing.T) {
 			expectedError:  "",
 			expectedOutput: "batch job is started",
 		},
+		{
+			name: "Valid Start Batch Reset Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
+					Count: 100,
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						var params batcher.BatchParams
+						assert.NoError(t, json.Unmarshal(request.Input, &params))
+						assert.Equal(t, batcher.ResetParams{
+							ResetType:         batcher.ResetTypeLastDecisionCompleted,
+							DecisionOffset:    -1,
+							SkipSignalReapply: true,
+						}, params.ResetParams)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id-example"}, nil
+					})
+			},
+			flags: map[string]interface{}{
+				FlagDomain:            "test-domain",
+				FlagListQuery:         "workflowType='batch'",
+				FlagReason:            "Testing batch job",
+				FlagBatchType:         batcher.BatchTypeReset,
+				FlagResetType:         batcher.ResetTypeLastDecisionCompleted,
+				FlagDecisionOffset:    -1,
+				FlagSkipSignalReapply: true,
+				FlagYes:               true,
+			},
+			expectedError:  "",
+			expectedOutput: "batch job is started",
+		},
+		{
+			name: "Valid Start Batch Upsert Search Attributes Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
+					Count: 100,
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						var params batcher.BatchParams
+						assert.NoError(t, json.Unmarshal(request.Input, &params))
+						assert.Equal(t, batcher.UpsertSearchAttributesParams{
+							SearchAttributes: map[string][]byte{
+								"CustomKeywordField": []byte(`"keyword"`),
+								"CustomIntField":     []byte(`1`),
+							},
+						}, params.UpsertSearchAttributesParams)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id-example"}, nil
+					})
+			},
+			flags: map[string]interface{}{
+				FlagDomain:              "test-domain",
+				FlagListQuery:           "workflowType='batch'",
+				FlagReason:              "Testing batch job",
+				FlagBatchType:           batcher.BatchTypeUpsertSearchAttributes,
+				FlagSearchAttributesKey: "CustomKeywordField|CustomIntField",
+				FlagSearchAttributesVal: "keyword|1",
+				FlagYes:                 true,
+			},
+			expectedError:  "",
+			expectedOutput: "batch job is started",
+		},
+		{
+			name:  "Missing Search Attributes",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch job",
+				FlagBatchType: batcher.BatchTypeUpsertSearchAttributes,
+			},
+			expectedError: "Required flag not found: : option search_attr_key is required",
+		},
+		{
+			name:  "Invalid Reset Type",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch job",
+				FlagBatchType: batcher.BatchTypeReset,
+				FlagResetType: "invalidResetType",
+			},
+			expectedError: "resetType is not valid",
+		},
 		{
 			name:  "Missing Domain",
 			setup: func(mockClient *frontend.MockClient) {},
@@ -320,6 +405,16 @@ func TestDescribeBatchJob(t *testing.T) {
 						},
 					},
 				}, nil)
+				mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{Events: []*types.HistoryEvent{
+						{
+							EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+							WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{
+								Result: []byte(`{"CurrentPage": 1, "TotalEstimate": 2, "SuccessCount": 1, "ErrorCount": 1, "Failures": [{"WorkflowID": "wid", "RunID": "rid", "Error": "reset failed"}]}`),
+							},
+						},
+					}},
+				}, nil)
 			},
 			flags: map[string]interface{}{
 				FlagJobID: "example-workflow-1",
@@ -327,7 +422,32 @@ func TestDescribeBatchJob(t *testing.T) {
 			expectedError: "",
 			expectedOutput: map[string]interface{}{
 				"msg": "batch job is finished successfully",
+				"result": batcher.HeartBeatDetails{
+					CurrentPage:   1,
+					TotalEstimate: 2,
+					SuccessCount:  1,
+					ErrorCount:    1,
+					Failures:      []batcher.FailedWorkflow{{WorkflowID: "wid", RunID: "rid", Error: "reset failed"}},
+				},
+			},
+		},
+		{
+			name: "Error when getting job result",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+						CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+						Execution: &types.WorkflowExecution{
+							WorkflowID: "example-workflow-1",
+						},
+					},
+				}, nil)
+				mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(nil, errors.New("service error"))
+			},
+			flags: map[string]interface{}{
+				FlagJobID: "example-workflow-1",
 			},
+			expectedError: "Failed to get batch job result: service error",
 		},
 		{
 			name: "Batch Job Running",
@@ -411,12 +531,14 @@ func TestDescribeBatchJob(t *testing.T) {
 				var actualOutput map[string]interface{}
 				err = json.Unmarshal(ioHandler.outputBytes.Bytes(), &actualOutput)
 				assert.NoError(t, err)
-				if progressRaw, exists := actualOutput["progress"]; exists {
-					var progress batcher.HeartBeatDetails
-					progressBytes, err := json.Marshal(progressRaw)
-					if err == nil {
-						json.Unmarshal(progressBytes, &progress)
-						actualOutput["progress"] = progress
+				for _, key := range []string{"progress", "result"} {
+					if progressRaw, exists := actualOutput[key]; exists {
+						var progress batcher.HeartBeatDetails
+						progressBytes, err := json.Marshal(progressRaw)
+						if err == nil {
+							json.Unmarshal(progressBytes, &progress)
+							actualOutput[key] = progress
+						}
 					}
 				}
 				assert.Equal(t, tt.expectedOutput, actualOutput)
@@ -23,6 +23,7 @@ package cli
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -31,6 +32,7 @@ import (
 	"math/rand"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -89,6 +91,35 @@ func RestartWorkflow(c *cli.Context) error {
 	return nil
 }
 
+const (
+	diagnosticsReportQueryType    = "query-diagnostics-report"
+	diagnosticsReportPollInterval = time.Second
+)
+
+type (
+	// diagnosticsReport is the result of the report query of the diagnostics workflow. The sections are
+	// decoded generically, so that the report of any invariant the diagnostics worker runs is printed.
+	diagnosticsReport struct {
+		DiagnosticsResult    map[string]*diagnosticsReportSection
+		DiagnosticsCompleted bool
+	}
+
+	diagnosticsReportSection struct {
+		Issues []struct {
+			IssueID       int
+			InvariantType string
+			Reason        string
+			Metadata      json.RawMessage
+		}
+		RootCause []struct {
+			IssueID       int
+			RootCauseType string
+			Metadata      json.RawMessage
+		}
+		Runbook string
+	}
+)
+
 // DiagnoseWorkflow diagnoses a workflow execution
 func DiagnoseWorkflow(c *cli.Context) error {
 	wfClient, err := getWorkflowClient(c)
@@ -128,12 +159,115 @@ func DiagnoseWorkflow(c *cli.Context) error {
 	if err != nil {
 		return commoncli.Problem("Diagnose workflow failed.", err)
 	}
-	fmt.Println("Workflow diagnosis started. Query the diagnostic workflow to get diagnostics report.")
+	fmt.Println("Workflow diagnosis started.")
 	fmt.Println("============Diagnostic Workflow details============")
 	fmt.Printf("Domain: %s, Workflow Id: %s, Run Id: %s\n", resp.GetDomain(), resp.GetDiagnosticWorkflowExecution().GetWorkflowID(), resp.GetDiagnosticWorkflowExecution().GetRunID())
+
+	waitCtx, waitCancel, err := newContextForLongPoll(c)
+	defer waitCancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	report, err := waitForDiagnosticsReport(waitCtx, wfClient, resp.GetDomain(), resp.GetDiagnosticWorkflowExecution())
+	if err != nil {
+		return commoncli.Problem(fmt.Sprintf("Failed to get the diagnostics report, query it later with: cadence --%s %s workflow query --%s %s --%s %s --%s %s",
+			FlagDomain, resp.GetDomain(),
+			FlagWorkflowID, resp.GetDiagnosticWorkflowExecution().GetWorkflowID(),
+			FlagRunID, resp.GetDiagnosticWorkflowExecution().GetRunID(),
+			FlagQueryType, diagnosticsReportQueryType), err)
+	}
+	fmt.Println("============Diagnostics report============")
+	printDiagnosticsReport(report)
 	return nil
 }
 
+// waitForDiagnosticsReport queries the diagnostics workflow until its report is completed
+func waitForDiagnosticsReport(
+	ctx context.Context,
+	wfClient frontend.Client,
+	domain string,
+	execution *types.WorkflowExecution,
+) (*diagnosticsReport, error) {
+	request := &types.QueryWorkflowRequest{
+		Domain:    domain,
+		Execution: execution,
+		Query: &types.WorkflowQuery{
+			QueryType: diagnosticsReportQueryType,
+		},
+	}
+	for {
+		var report diagnosticsReport
+		queryResp, err := wfClient.QueryWorkflow(ctx, request)
+		if err == nil {
+			if queryResp.QueryRejected != nil {
+				return nil, fmt.Errorf("diagnostics workflow is in state %v", queryResp.QueryRejected.CloseStatus)
+			}
+			if err := json.Unmarshal(queryResp.GetQueryResult(), &report); err != nil {
+				return nil, fmt.Errorf("unable to deserialize the diagnostics report: %w", err)
+			}
+			if report.DiagnosticsCompleted {
+				return &report, nil
+			}
+		}
+
+		// the query fails until the diagnostics workflow processed its first decision task
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("diagnostics not completed: %w", ctx.Err())
+		case <-time.After(diagnosticsReportPollInterval):
+		}
+	}
+}
+
+func printDiagnosticsReport(report *diagnosticsReport) {
+	sections := make([]string, 0, len(report.DiagnosticsResult))
+	for name, section := range report.DiagnosticsResult {
+		if section != nil && len(section.Issues) > 0 {
+			sections = append(sections, name)
+		}
+	}
+	if len(sections) == 0 {
+		fmt.Println("No issues found.")
+		return
+	}
+	sort.Strings(sections)
+
+	for _, name := range sections {
+		section := report.DiagnosticsResult[name]
+		fmt.Printf("%s:\n", name)
+		for _, issue := range section.Issues {
+			fmt.Printf("  Issue %d: %s, %s\n", issue.IssueID, issue.InvariantType, issue.Reason)
+			if metadata := compactJSON(issue.Metadata); metadata != "" {
+				fmt.Printf("    Details: %s\n", metadata)
+			}
+			for _, rootCause := range section.RootCause {
+				if rootCause.IssueID != issue.IssueID {
+					continue
+				}
+				fmt.Printf("    Root cause: %s\n", rootCause.RootCauseType)
+				if metadata := compactJSON(rootCause.Metadata); metadata != "" {
+					fmt.Printf("      Details: %s\n", metadata)
+				}
+			}
+		}
+		if section.Runbook != "" {
+			fmt.Printf("  Runbook: %s\n", section.Runbook)
+		}
+	}
+}
+
+// compactJSON returns the JSON value on a single line, or an empty string for null
+func compactJSON(value json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, value); err != nil || buf.String() == "null" {
+		return ""
+	}
+	return buf.String()
+}
+
 // ShowHistory shows the history of given workflow execution based on workflowID and runID.
 func ShowHistory(c *cli.Context) error {
 	wid, err := getRequiredOption(c, FlagWorkflowID)
@@ -1168,6 +1302,7 @@ type workflowExecutionInfo struct {
 	PartitionConfig              map[string]string
 	CronOverlapPolicy            *types.CronOverlapPolicy
 	ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+	Paused                       *pauseInfo `json:",omitempty"`
 }
 
 // pendingActivityInfo has same fields as types.PendingActivityInfo, but different field type for better display
@@ -1175,17 +1310,25 @@ type pendingActivityInfo struct {
 	ActivityID             string
 	ActivityType           *types.ActivityType
 	State                  *types.PendingActivityState
-	ScheduledTimestamp     *string `json:",omitempty"` // change from *int64
-	LastStartedTimestamp   *string `json:",omitempty"` // change from *int64
-	HeartbeatDetails       *string `json:",omitempty"` // change from []byte
-	LastHeartbeatTimestamp *string `json:",omitempty"` // change from *int64
-	Attempt                int32   `json:",omitempty"`
-	MaximumAttempts        int32   `json:",omitempty"`
-	ExpirationTimestamp    *string `json:",omitempty"` // change from *int64
-	LastFailureReason      *string `json:",omitempty"`
-	LastWorkerIdentity     string  `json:",omitempty"`
-	LastFailureDetails     *string `json:",omitempty"` // change from []byte
-	ScheduleID             int64   `json:",omitempty"`
+	ScheduledTimestamp     *string    `json:",omitempty"` // change from *int64
+	LastStartedTimestamp   *string    `json:",omitempty"` // change from *int64
+	HeartbeatDetails       *string    `json:",omitempty"` // change from []byte
+	LastHeartbeatTimestamp *string    `json:",omitempty"` // change from *int64
+	Attempt                int32      `json:",omitempty"`
+	MaximumAttempts        int32      `json:",omitempty"`
+	ExpirationTimestamp    *string    `json:",omitempty"` // change from *int64
+	LastFailureReason      *string    `json:",omitempty"`
+	LastWorkerIdentity     string     `json:",omitempty"`
+	LastFailureDetails     *string    `json:",omitempty"` // change from []byte
+	ScheduleID             int64      `json:",omitempty"`
+	Paused                 *pauseInfo `json:",omitempty"`
+}
+
+// pauseInfo has same fields as types.PauseInfo, but has datetime instead of raw time
+type pauseInfo struct {
+	Reason          string  `json:",omitempty"`
+	Identity        string  `json:",omitempty"`
+	PausedTimestamp *string `json:",omitempty"` // change from *int64
 }
 
 type pendingDecisionInfo struct {
@@ -1197,6 +1340,17 @@ type pendingDecisionInfo struct {
 	ScheduleID                 int64   `json:",omitempty"`
 }
 
+func convertPauseInfo(info *types.PauseInfo) *pauseInfo {
+	if info == nil {
+		return nil
+	}
+	return &pauseInfo{
+		Reason:          info.Reason,
+		Identity:        info.Identity,
+		PausedTimestamp: timestampPtrToStringPtr(info.PausedTimestamp, false),
+	}
+}
+
 func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecutionResponse,
 	wfClient frontend.Client, c *cli.Context) (*describeWorkflowExecutionResponse, error) {
 
@@ -1220,6 +1374,7 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 		PartitionConfig:              info.PartitionConfig,
 		CronOverlapPolicy:            info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy: info.ActiveClusterSelectionPolicy,
+		Paused:                       convertPauseInfo(resp.PauseInfo),
 	}
 
 	var pendingActs []*pendingActivityInfo
@@ -1238,6 +1393,7 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 			LastFailureReason:      pa.LastFailureReason,
 			LastWorkerIdentity:     pa.LastWorkerIdentity,
 			ScheduleID:             pa.ScheduleID,
+			Paused:                 convertPauseInfo(pa.PauseInfo),
 		}
 		if pa.HeartbeatDetails != nil {
 			tmpAct.HeartbeatDetails = common.StringPtr(string(pa.HeartbeatDetails))
@@ -0,0 +1,154 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// PauseWorkflow stops dispatching decision and activity tasks and firing timers of a workflow execution
+func PauseWorkflow(c *cli.Context) error {
+	return pauseOrUnpause(c, true, false)
+}
+
+// UnpauseWorkflow resumes a paused workflow execution
+func UnpauseWorkflow(c *cli.Context) error {
+	return pauseOrUnpause(c, false, false)
+}
+
+// PauseActivity stops dispatching and retrying a pending activity
+func PauseActivity(c *cli.Context) error {
+	return pauseOrUnpause(c, true, true)
+}
+
+// UnpauseActivity resumes a paused activity
+func UnpauseActivity(c *cli.Context) error {
+	return pauseOrUnpause(c, false, true)
+}
+
+// ResetActivity resets the attempt and last failure of a pending activity that is not running, and dispatches its retry right away
+func ResetActivity(c *cli.Context) error {
+	serviceClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	domain, execution, err := getPauseTarget(c)
+	if err != nil {
+		return err
+	}
+	activityID, err := getRequiredOption(c, FlagActivityID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	err = serviceClient.ResetActivity(ctx, &types.ResetActivityRequest{
+		Domain:            domain,
+		WorkflowExecution: execution,
+		ActivityID:        activityID,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Reset activity failed.", err)
+	}
+	fmt.Fprintln(getDeps(c).Output(), "Activity reset.")
+	return nil
+}
+
+func pauseOrUnpause(c *cli.Context, pause bool, requireActivityID bool) error {
+	serviceClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	domain, execution, err := getPauseTarget(c)
+	if err != nil {
+		return err
+	}
+	var activityID string
+	if requireActivityID {
+		activityID, err = getRequiredOption(c, FlagActivityID)
+		if err != nil {
+			return commoncli.Problem("Required flag not found: ", err)
+		}
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+
+	target := "Workflow"
+	if requireActivityID {
+		target = "Activity"
+	}
+	if pause {
+		err = serviceClient.PauseWorkflowExecution(ctx, &types.PauseWorkflowExecutionRequest{
+			Domain:            domain,
+			WorkflowExecution: execution,
+			ActivityID:        activityID,
+			Reason:            c.String(FlagReason),
+			Identity:          getCliIdentity(),
+		})
+		if err != nil {
+			return commoncli.Problem(fmt.Sprintf("Pause %v failed.", target), err)
+		}
+		fmt.Fprintf(getDeps(c).Output(), "%v paused.\n", target)
+		return nil
+	}
+
+	err = serviceClient.UnpauseWorkflowExecution(ctx, &types.UnpauseWorkflowExecutionRequest{
+		Domain:            domain,
+		WorkflowExecution: execution,
+		ActivityID:        activityID,
+		Reason:            c.String(FlagReason),
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem(fmt.Sprintf("Unpause %v failed.", target), err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "%v unpaused.\n", target)
+	return nil
+}
+
+func getPauseTarget(c *cli.Context) (string, *types.WorkflowExecution, error) {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return "", nil, commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return "", nil, commoncli.Problem("Required flag not found: ", err)
+	}
+	return domain, &types.WorkflowExecution{
+		WorkflowID: wid,
+		RunID:      c.String(FlagRunID),
+	}, nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestPauseCommands(t *testing.T) {
+	execution := &types.WorkflowExecution{WorkflowID: "wid"}
+	tests := []struct {
+		desc       string
+		args       []string
+		mockFn     func(*frontend.MockClient)
+		wantOutput string
+		wantErr    bool
+	}{
+		{
+			desc: "pause workflow",
+			args: []string{"workflow", "pause", "--wid", "wid", "--reason", "investigating"},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().PauseWorkflowExecution(gomock.Any(), &types.PauseWorkflowExecutionRequest{
+					Domain:            domainName,
+					WorkflowExecution: execution,
+					Reason:            "investigating",
+					Identity:          getCliIdentity(),
+				}).Return(nil)
+			},
+			wantOutput: "Workflow paused.",
+		},
+		{
+			desc: "unpause workflow",
+			args: []string{"workflow", "unpause", "--wid", "wid", "--rid", "rid"},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().UnpauseWorkflowExecution(gomock.Any(), &types.UnpauseWorkflowExecutionRequest{
+					Domain:            domainName,
+					WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+					Identity:          getCliIdentity(),
+				}).Return(nil)
+			},
+			wantOutput: "Workflow unpaused.",
+		},
+		{
+			desc: "pause activity",
+			args: []string{"workflow", "activity", "pause", "--wid", "wid", "--aid", "activity-1"},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().PauseWorkflowExecution(gomock.Any(), &types.PauseWorkflowExecutionRequest{
+					Domain:            domainName,
+					WorkflowExecution: execution,
+					ActivityID:        "activity-1",
+					Identity:          getCliIdentity(),
+				}).Return(nil)
+			},
+			wantOutput: "Activity paused.",
+		},
+		{
+			desc: "unpause activity",
+			args: []string{"workflow", "activity", "unpause", "--wid", "wid", "--aid", "activity-1"},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().UnpauseWorkflowExecution(gomock.Any(), &types.UnpauseWorkflowExecutionRequest{
+					Domain:            domainName,
+					WorkflowExecution: execution,
+					ActivityID:        "activity-1",
+					Identity:          getCliIdentity(),
+				}).Return(nil)
+			},
+			wantOutput: "Activity unpaused.",
+		},
+		{
+			desc: "reset activity",
+			args: []string{"workflow", "activity", "reset", "--wid", "wid", "--aid", "activity-1"},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().ResetActivity(gomock.Any(), &types.ResetActivityRequest{
+					Domain:            domainName,
+					WorkflowExecution: execution,
+					ActivityID:        "activity-1",
+					Identity:          getCliIdentity(),
+				}).Return(nil)
+			},
+			wantOutput: "Activity reset.",
+		},
+		{
+			desc: "pause fails",
+			args: []string{"workflow", "activity", "pause", "--wid", "wid", "--aid", "activity-1"},
+			mockFn: func(client *frontend.MockClient) {
+				client.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.EntityNotExistsError{Message: "Pending activity activity-1 not found."})
+			},
+			wantErr: true,
+		},
+		{
+			desc:    "missing activity ID",
+			args:    []string{"workflow", "activity", "pause", "--wid", "wid"},
+			wantErr: true,
+		},
+		{
+			desc:    "missing activity ID on reset",
+			args:    []string{"workflow", "activity", "reset", "--wid", "wid"},
+			wantErr: true,
+		},
+		{
+			desc:    "missing workflow ID",
+			args:    []string{"workflow", "pause"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			frontendCl := frontend.NewMockClient(ctrl)
+			if tc.mockFn != nil {
+				tc.mockFn(frontendCl)
+			}
+			ioHandler := &testIOHandler{}
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: frontendCl,
+			}, WithIOHandler(ioHandler))
+
+			err := app.Run(append([]string{"", "--do", domainName}, tc.args...))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, ioHandler.outputBytes.String(), tc.wantOutput)
+		})
+	}
+}
+
+func TestConvertPauseInfo(t *testing.T) {
+	assert.Nil(t, convertPauseInfo(nil))
+
+	pausedTimestamp := int64(1700000000000000000)
+	assert.Equal(t, &pauseInfo{
+		Reason:          "investigating",
+		Identity:        "operator",
+		PausedTimestamp: common.StringPtr(timestampToString(pausedTimestamp, false)),
+	}, convertPauseInfo(&types.PauseInfo{
+		Reason:          "investigating",
+		Identity:        "operator",
+		PausedTimestamp: common.Int64Ptr(pausedTimestamp),
+	}))
+}
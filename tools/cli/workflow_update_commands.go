@@ -0,0 +1,115 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// workflowUpdateResult is the printed outcome of an update, with the payloads shown as text
+type workflowUpdateResult struct {
+	UpdateID       string
+	Stage          string
+	Result         string `json:",omitempty"`
+	FailureReason  string `json:",omitempty"`
+	FailureDetails string `json:",omitempty"`
+}
+
+// UpdateWorkflow sends an update to a workflow execution and waits for its outcome
+func UpdateWorkflow(c *cli.Context) error {
+	serviceClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	name, err := getRequiredOption(c, FlagName)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	var waitForStage types.WorkflowUpdateStage
+	if err := waitForStage.UnmarshalText([]byte(c.String(FlagUpdateWaitForStage))); err != nil ||
+		(waitForStage != types.WorkflowUpdateStageAccepted && waitForStage != types.WorkflowUpdateStageCompleted) {
+		return commoncli.Problem(fmt.Sprintf("Option %s must be accepted or completed.", FlagUpdateWaitForStage), nil)
+	}
+	input, err := processJSONInput(c)
+	if err != nil {
+		return commoncli.Problem("Error proccessing JSON input: ", err)
+	}
+	// the update ID is set here rather than by the server, so that a retried request is not applied twice
+	updateID := c.String(FlagUpdateID)
+	if len(updateID) == 0 {
+		updateID = uuid.New()
+	}
+
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	resp, err := serviceClient.UpdateWorkflowExecution(
+		tcCtx,
+		&types.UpdateWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			UpdateID:     updateID,
+			UpdateName:   name,
+			Input:        []byte(input),
+			Identity:     getCliIdentity(),
+			WaitForStage: waitForStage.Ptr(),
+		},
+	)
+	if err != nil {
+		return commoncli.Problem("Update workflow failed.", err)
+	}
+
+	prettyPrintJSONObject(getDeps(c).Output(), &workflowUpdateResult{
+		UpdateID:       resp.GetUpdateID(),
+		Stage:          resp.GetStage().String(),
+		Result:         string(resp.GetResult()),
+		FailureReason:  resp.GetFailureReason(),
+		FailureDetails: string(resp.GetFailureDetails()),
+	})
+	switch {
+	case resp.GetStage() == types.WorkflowUpdateStageRejected:
+		return commoncli.Problem(fmt.Sprintf("Update %s was rejected: %s", updateID, resp.GetFailureReason()), nil)
+	case resp.FailureReason != nil:
+		return commoncli.Problem(fmt.Sprintf("Update %s failed: %s", updateID, resp.GetFailureReason()), nil)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestUpdateWorkflow(t *testing.T) {
+	expectUpdate := func(t *testing.T, m *frontend.MockClient, wantStage types.WorkflowUpdateStage, resp *types.UpdateWorkflowExecutionResponse, err error) {
+		m.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *types.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+				assert.Equal(t, domainName, request.Domain)
+				assert.Equal(t, "wid", request.WorkflowExecution.WorkflowID)
+				assert.Equal(t, "update-1", request.UpdateID)
+				assert.Equal(t, "approve", request.UpdateName)
+				assert.JSONEq(t, `{"approver":"a"}`, string(request.Input))
+				assert.Equal(t, wantStage, request.GetWaitForStage())
+				return resp, err
+			})
+	}
+
+	tests := []struct {
+		desc       string
+		args       []string
+		mockFn     func(*testing.T, *frontend.MockClient)
+		wantOutput string
+		wantErr    bool
+	}{
+		{
+			desc: "wait for completion",
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				expectUpdate(t, m, types.WorkflowUpdateStageCompleted, &types.UpdateWorkflowExecutionResponse{
+					UpdateID: "update-1",
+					Stage:    types.WorkflowUpdateStageCompleted.Ptr(),
+					Result:   []byte(`"approved"`),
+				}, nil)
+			},
+			wantOutput: `"Stage": "COMPLETED"`,
+		},
+		{
+			desc: "wait for acceptance",
+			args: []string{"--wait_for_stage", "accepted"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				expectUpdate(t, m, types.WorkflowUpdateStageAccepted, &types.UpdateWorkflowExecutionResponse{
+					UpdateID: "update-1",
+					Stage:    types.WorkflowUpdateStageAccepted.Ptr(),
+				}, nil)
+			},
+			wantOutput: `"Stage": "ACCEPTED"`,
+		},
+		{
+			desc: "rejected by validator",
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				expectUpdate(t, m, types.WorkflowUpdateStageCompleted, &types.UpdateWorkflowExecutionResponse{
+					UpdateID:      "update-1",
+					Stage:         types.WorkflowUpdateStageRejected.Ptr(),
+					FailureReason: common.StringPtr("not allowed"),
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "handler failed",
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				expectUpdate(t, m, types.WorkflowUpdateStageCompleted, &types.UpdateWorkflowExecutionResponse{
+					UpdateID:      "update-1",
+					Stage:         types.WorkflowUpdateStageCompleted.Ptr(),
+					FailureReason: common.StringPtr("handler panicked"),
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "update fails",
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				expectUpdate(t, m, types.WorkflowUpdateStageCompleted, nil, &types.EntityNotExistsError{})
+			},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid stage",
+			args:    []string{"--wait_for_stage", "rejected"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			frontendCl := frontend.NewMockClient(ctrl)
+			tc.mockFn(t, frontendCl)
+			ioHandler := &testIOHandler{}
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: frontendCl,
+			}, WithIOHandler(ioHandler))
+
+			args := []string{"", "--do", domainName, "workflow", "update",
+				"--wid", "wid",
+				"--name", "approve",
+				"--input", `{"approver":"a"}`,
+				"--update_id", "update-1",
+			}
+			err := app.Run(append(args, tc.args...))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, ioHandler.outputBytes.String(), tc.wantOutput)
+		})
+	}
+}
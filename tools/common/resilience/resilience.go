@@ -0,0 +1,520 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package resilience analyses how well a domain can survive the loss of a cluster or region,
+// for the admin CLI and the MCP server.
+package resilience
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+// Status is the outcome of a check
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+	// StatusUnknown means the check could not run, so it is not part of the score
+	StatusUnknown Status = "unknown"
+)
+
+// Ratings of a report, by score
+const (
+	RatingResilient    = "resilient"
+	RatingAtRisk       = "at risk"
+	RatingNotResilient = "not resilient"
+)
+
+const (
+	// FailoverDrillMaxAge is how long ago the last failover of a global domain can be before a drill is recommended
+	FailoverDrillMaxAge = 180 * 24 * time.Hour
+	// DLQFailThreshold is the number of replication DLQ messages above which the DLQ check fails instead of warning
+	DLQFailThreshold = 1000
+	// ReplicationLagWarnThreshold is the replication lag to a standby cluster above which the lag check warns
+	ReplicationLagWarnThreshold = time.Minute
+	// ReplicationLagFailThreshold is the replication lag to a standby cluster above which the lag check fails
+	ReplicationLagFailThreshold = 10 * time.Minute
+
+	failoverHistoryPageSize = 10
+	// replicationLagShardSampleSize is the number of history shards the replication lag is measured on
+	replicationLagShardSampleSize = 16
+)
+
+// Check weights, which add up to 100
+const (
+	weightGlobalDomain    = 25
+	weightClusters        = 15
+	weightActiveClusters  = 10
+	weightPendingFailover = 5
+	weightReplicationLag  = 10
+	weightReplicationDLQ  = 10
+	weightIsolationGroups = 10
+	weightArchival        = 5
+	weightFailoverDrill   = 10
+)
+
+// Check is the result of one aspect of the analysis
+type Check struct {
+	Name        string `json:"name"`
+	Status      Status `json:"status"`
+	Weight      int    `json:"weight"`
+	Details     string `json:"details"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// Report is the result of the analysis of a domain. The score is out of 100: passed checks count fully,
+// warnings count half and failed checks do not count. Checks that could not run are left out of the score.
+type Report struct {
+	Domain      string   `json:"domain"`
+	Score       int      `json:"score"`
+	Rating      string   `json:"rating"`
+	Checks      []Check  `json:"checks"`
+	Remediation []string `json:"remediation"`
+}
+
+// Analyze runs the checks for a domain. Only an error to describe the domain fails the analysis,
+// the checks which cannot get their data are reported with the unknown status.
+func Analyze(ctx context.Context, frontendClient frontend.Client, adminClient admin.Client, domain string, now time.Time) (*Report, error) {
+	desc, err := frontendClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(domain)})
+	if err != nil {
+		return nil, fmt.Errorf("describe domain %s: %w", domain, err)
+	}
+
+	replication := desc.ReplicationConfiguration
+	if replication == nil {
+		replication = &types.DomainReplicationConfiguration{}
+	}
+	clusters := make([]string, 0, len(replication.Clusters))
+	for _, cluster := range replication.Clusters {
+		clusters = append(clusters, cluster.GetClusterName())
+	}
+
+	checks := []Check{
+		checkGlobalDomain(desc),
+		checkClusters(desc.IsGlobalDomain, replication, clusters),
+		checkActiveClusters(replication, clusters),
+		checkPendingFailover(desc.FailoverInfo),
+		checkReplicationLag(ctx, adminClient, desc.IsGlobalDomain, replication.ActiveClusterName, clusters, now),
+		checkReplicationDLQ(ctx, adminClient, desc.IsGlobalDomain, clusters),
+		checkIsolationGroups(ctx, adminClient, domain),
+		checkArchival(desc.Configuration),
+		checkFailoverDrill(ctx, frontendClient, desc, now),
+	}
+	return newReport(domain, checks), nil
+}
+
+func newReport(domain string, checks []Check) *Report {
+	report := &Report{
+		Domain:      domain,
+		Checks:      checks,
+		Remediation: []string{},
+	}
+
+	total, earned := 0, 0
+	for _, check := range checks {
+		switch check.Status {
+		case StatusPass:
+			earned += 2 * check.Weight
+		case StatusWarn:
+			earned += check.Weight
+		case StatusUnknown:
+			continue
+		}
+		total += 2 * check.Weight
+		if check.Remediation != "" {
+			report.Remediation = append(report.Remediation, check.Remediation)
+		}
+	}
+	if total > 0 {
+		report.Score = earned * 100 / total
+	}
+
+	switch {
+	case report.Score >= 80:
+		report.Rating = RatingResilient
+	case report.Score >= 50:
+		report.Rating = RatingAtRisk
+	default:
+		report.Rating = RatingNotResilient
+	}
+	return report
+}
+
+func checkGlobalDomain(desc *types.DescribeDomainResponse) Check {
+	check := Check{Name: "global-domain", Weight: weightGlobalDomain}
+	if desc.IsGlobalDomain {
+		check.Status = StatusPass
+		check.Details = "the domain is global, its workflows are replicated to the other clusters"
+		return check
+	}
+	check.Status = StatusFail
+	check.Details = "the domain is local, its workflows are lost or unavailable if its cluster is"
+	check.Remediation = "Move the workflows to a global domain replicated to clusters in different regions, after checking it with cadence domain migration."
+	return check
+}
+
+func checkClusters(isGlobal bool, replication *types.DomainReplicationConfiguration, clusters []string) Check {
+	check := Check{Name: "replication-clusters", Weight: weightClusters}
+	if !isGlobal {
+		check.Status = StatusFail
+		check.Details = fmt.Sprintf("the domain is only in cluster %s", replication.ActiveClusterName)
+		check.Remediation = "Add at least one cluster in another region to the replication config of the domain."
+		return check
+	}
+	if len(clusters) < 2 {
+		check.Status = StatusFail
+		check.Details = fmt.Sprintf("the domain is replicated to %d cluster(s): %s", len(clusters), strings.Join(clusters, ", "))
+		check.Remediation = "Add at least one cluster in another region to the replication config of the domain (cadence domain update --clusters)."
+		return check
+	}
+	if replication.ActiveClusterName != "" && !contains(clusters, replication.ActiveClusterName) {
+		check.Status = StatusFail
+		check.Details = fmt.Sprintf("the active cluster %s is not one of the replication clusters %s", replication.ActiveClusterName, strings.Join(clusters, ", "))
+		check.Remediation = fmt.Sprintf("Add the active cluster %s to the replication clusters of the domain, or fail the domain over to one of them.", replication.ActiveClusterName)
+		return check
+	}
+	check.Status = StatusPass
+	check.Details = fmt.Sprintf("the domain is replicated to %d clusters: %s", len(clusters), strings.Join(clusters, ", "))
+	return check
+}
+
+func checkActiveClusters(replication *types.DomainReplicationConfiguration, clusters []string) Check {
+	check := Check{Name: "active-clusters", Weight: weightActiveClusters}
+	if replication.ActiveClusters == nil || len(replication.ActiveClusters.AttributeScopes) == 0 {
+		check.Status = StatusPass
+		check.Details = fmt.Sprintf("the domain is active-passive with active cluster %s, a regional outage requires a failover", replication.ActiveClusterName)
+		return check
+	}
+
+	activeClusters := map[string]bool{}
+	var unknown []string
+	for _, scope := range sortedKeys(replication.ActiveClusters.AttributeScopes) {
+		attributes := replication.ActiveClusters.AttributeScopes[scope].ClusterAttributes
+		for _, name := range sortedKeys(attributes) {
+			cluster := attributes[name].ActiveClusterName
+			activeClusters[cluster] = true
+			if !contains(clusters, cluster) {
+				unknown = append(unknown, fmt.Sprintf("%s/%s: %s", scope, name, cluster))
+			}
+		}
+	}
+
+	if len(unknown) > 0 {
+		check.Status = StatusFail
+		check.Details = fmt.Sprintf("active-active cluster attributes are active in clusters which do not replicate the domain: %s", strings.Join(unknown, ", "))
+		check.Remediation = "Fail the cluster attributes over to clusters in the replication config of the domain, or add their clusters to it."
+		return check
+	}
+	if len(activeClusters) < 2 {
+		check.Status = StatusWarn
+		check.Details = fmt.Sprintf("the domain is active-active, but all its cluster attributes are active in %s", strings.Join(sortedKeys(activeClusters), ", "))
+		check.Remediation = "Spread the active clusters of the cluster attributes over several regions, so an outage only affects some of the workflows."
+		return check
+	}
+	check.Status = StatusPass
+	check.Details = fmt.Sprintf("the domain is active-active in clusters %s", strings.Join(sortedKeys(activeClusters), ", "))
+	return check
+}
+
+func checkPendingFailover(info *types.FailoverInfo) Check {
+	check := Check{Name: "pending-failover", Weight: weightPendingFailover}
+	if info == nil || len(info.PendingShards) == 0 {
+		check.Status = StatusPass
+		check.Details = "no graceful failover is in progress"
+		return check
+	}
+	check.Status = StatusWarn
+	check.Details = fmt.Sprintf("a graceful failover is in progress, %d shard(s) have not completed it", len(info.PendingShards))
+	check.Remediation = "Wait for the graceful failover to complete, or check the replication of the pending shards, before a drill."
+	return check
+}
+
+// checkReplicationLag measures how far the standby clusters of the domain are behind, as the age of the oldest
+// replication task each of them has not acked yet, over a sample of the history shards. The tasks are read from
+// the cluster the tool is connected to, which should be the active cluster of the domain, without a read level,
+// which leaves the ack levels of the standby clusters untouched.
+func checkReplicationLag(ctx context.Context, adminClient admin.Client, isGlobal bool, activeCluster string, clusters []string, now time.Time) Check {
+	check := Check{Name: "replication-lag", Weight: weightReplicationLag}
+	var standbyClusters []string
+	for _, cluster := range clusters {
+		if cluster != activeCluster {
+			standbyClusters = append(standbyClusters, cluster)
+		}
+	}
+	if !isGlobal || len(standbyClusters) == 0 {
+		check.Status = StatusUnknown
+		check.Details = "the domain is not replicated"
+		return check
+	}
+
+	distribution, err := adminClient.DescribeShardDistribution(ctx, &types.DescribeShardDistributionRequest{PageSize: 1})
+	if err != nil {
+		check.Status = StatusUnknown
+		check.Details = fmt.Sprintf("failed to get the number of history shards: %v", err)
+		return check
+	}
+	numShards := int(distribution.NumberOfShards)
+	sampleSize := replicationLagShardSampleSize
+	if sampleSize > numShards {
+		sampleSize = numShards
+	}
+	tokens := make([]*types.ReplicationToken, 0, sampleSize)
+	for i := 0; i < sampleSize; i++ {
+		tokens = append(tokens, &types.ReplicationToken{
+			ShardID:                int32(i * numShards / sampleSize),
+			LastRetrievedMessageID: constants.EmptyMessageID,
+			LastProcessedMessageID: constants.EmptyMessageID,
+		})
+	}
+
+	lags := map[string]time.Duration{}
+	var errs []string
+	for _, cluster := range standbyClusters {
+		resp, err := adminClient.GetReplicationMessages(ctx, &types.GetReplicationMessagesRequest{
+			Tokens:      tokens,
+			ClusterName: cluster,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", cluster, err))
+			continue
+		}
+		if len(resp.GetMessagesByShard()) == 0 {
+			errs = append(errs, fmt.Sprintf("%s: no shard returned replication messages", cluster))
+			continue
+		}
+		var maxLag time.Duration
+		for _, messages := range resp.GetMessagesByShard() {
+			if earliest := messages.GetEarliestCreationTime(); earliest != nil {
+				if lag := now.Sub(time.Unix(0, *earliest)); lag > maxLag {
+					maxLag = lag
+				}
+			}
+		}
+		lags[cluster] = maxLag
+	}
+	if len(lags) == 0 {
+		check.Status = StatusUnknown
+		check.Details = "failed to get the replication messages: " + strings.Join(errs, ", ")
+		return check
+	}
+
+	check.Status = StatusPass
+	var details []string
+	for _, cluster := range sortedKeys(lags) {
+		lag := lags[cluster]
+		details = append(details, fmt.Sprintf("%s is %v behind", cluster, lag.Round(time.Second)))
+		switch {
+		case lag > ReplicationLagFailThreshold:
+			check.Status = StatusFail
+		case lag > ReplicationLagWarnThreshold && check.Status == StatusPass:
+			check.Status = StatusWarn
+		}
+	}
+	details = append(details, errs...)
+	if check.Status == StatusPass {
+		check.Details = "the standby clusters are caught up: " + strings.Join(details, ", ")
+		return check
+	}
+	check.Details = "the standby clusters are behind on replication, a failover would lose or delay the latest updates: " + strings.Join(details, ", ")
+	check.Remediation = "Check the replication latency and errors of the standby clusters, and do not fail over to them before they catch up."
+	return check
+}
+
+func checkReplicationDLQ(ctx context.Context, adminClient admin.Client, isGlobal bool, clusters []string) Check {
+	check := Check{Name: "replication-dlq", Weight: weightReplicationDLQ}
+	if !isGlobal {
+		check.Status = StatusUnknown
+		check.Details = "the domain is not replicated"
+		return check
+	}
+
+	resp, err := adminClient.CountDLQMessages(ctx, &types.CountDLQMessagesRequest{})
+	if err != nil {
+		check.Status = StatusUnknown
+		check.Details = fmt.Sprintf("failed to count the DLQ messages: %v", err)
+		return check
+	}
+
+	// the counts are for the whole cluster, they are only relevant for the clusters the domain replicates from
+	bySource := map[string]int64{}
+	var total int64
+	for key, count := range resp.History {
+		if count > 0 && contains(clusters, key.SourceCluster) {
+			bySource[key.SourceCluster] += count
+			total += count
+		}
+	}
+
+	if total == 0 && resp.Domain == 0 {
+		check.Status = StatusPass
+		check.Details = "the replication DLQs are empty"
+		return check
+	}
+
+	var details []string
+	for _, source := range sortedKeys(bySource) {
+		details = append(details, fmt.Sprintf("%d history replication message(s) from %s", bySource[source], source))
+	}
+	if resp.Domain > 0 {
+		details = append(details, fmt.Sprintf("%d domain replication message(s)", resp.Domain))
+	}
+	check.Status = StatusWarn
+	if total+resp.Domain > DLQFailThreshold {
+		check.Status = StatusFail
+	}
+	check.Details = "the cluster has unreplicated messages in its DLQs, the replicas may be behind: " + strings.Join(details, ", ")
+	check.Remediation = "Inspect the replication DLQ (cadence admin dlq read) and merge it (cadence admin dlq merge) so the replicas catch up."
+	return check
+}
+
+func checkIsolationGroups(ctx context.Context, adminClient admin.Client, domain string) Check {
+	check := Check{Name: "isolation-groups", Weight: weightIsolationGroups}
+
+	drained := map[string]bool{}
+	var errs []string
+	if resp, err := adminClient.GetGlobalIsolationGroups(ctx, &types.GetGlobalIsolationGroupsRequest{}); err != nil {
+		errs = append(errs, fmt.Sprintf("global isolation groups: %v", err))
+	} else {
+		collectDrained(resp.IsolationGroups, "cluster", drained)
+	}
+	if resp, err := adminClient.GetDomainIsolationGroups(ctx, &types.GetDomainIsolationGroupsRequest{Domain: domain}); err != nil {
+		errs = append(errs, fmt.Sprintf("domain isolation groups: %v", err))
+	} else {
+		collectDrained(resp.IsolationGroups, "domain", drained)
+	}
+
+	if len(errs) == 2 {
+		check.Status = StatusUnknown
+		check.Details = "failed to get the isolation groups: " + strings.Join(errs, ", ")
+		return check
+	}
+	if len(drained) > 0 {
+		check.Status = StatusWarn
+		check.Details = "isolation groups are drained, the domain already runs with reduced capacity: " + strings.Join(sortedKeys(drained), ", ")
+		check.Remediation = "Undrain the isolation groups which are healthy again (cadence admin isolation-groups update-domain/update-global) before a drill."
+		return check
+	}
+	check.Status = StatusPass
+	check.Details = "no isolation group is drained"
+	return check
+}
+
+func collectDrained(groups types.IsolationGroupConfiguration, level string, drained map[string]bool) {
+	for _, group := range groups.ToPartitionList() {
+		if group.State == types.IsolationGroupStateDrained {
+			drained[fmt.Sprintf("%s (%s)", group.Name, level)] = true
+		}
+	}
+}
+
+func checkArchival(config *types.DomainConfiguration) Check {
+	check := Check{Name: "archival", Weight: weightArchival}
+	var disabled []string
+	if config == nil || config.HistoryArchivalStatus == nil || *config.HistoryArchivalStatus != types.ArchivalStatusEnabled {
+		disabled = append(disabled, "history")
+	}
+	if config == nil || config.VisibilityArchivalStatus == nil || *config.VisibilityArchivalStatus != types.ArchivalStatusEnabled {
+		disabled = append(disabled, "visibility")
+	}
+	if len(disabled) > 0 {
+		check.Status = StatusWarn
+		check.Details = fmt.Sprintf("%s archival is disabled, closed workflows are lost after the retention period", strings.Join(disabled, " and "))
+		check.Remediation = "Enable history and visibility archival (cadence domain update --history_archival_status enabled --visibility_archival_status enabled)."
+		return check
+	}
+	check.Status = StatusPass
+	check.Details = "history and visibility archival are enabled"
+	return check
+}
+
+func checkFailoverDrill(ctx context.Context, frontendClient frontend.Client, desc *types.DescribeDomainResponse, now time.Time) Check {
+	check := Check{Name: "failover-drill", Weight: weightFailoverDrill}
+	if !desc.IsGlobalDomain {
+		check.Status = StatusUnknown
+		check.Details = "the domain is not replicated, it cannot fail over"
+		return check
+	}
+
+	pageSize := int32(failoverHistoryPageSize)
+	resp, err := frontendClient.ListFailoverHistory(ctx, &types.ListFailoverHistoryRequest{
+		Filters:    &types.ListFailoverHistoryRequestFilters{DomainID: desc.GetDomainInfo().GetUUID()},
+		Pagination: &types.PaginationOptions{PageSize: &pageSize},
+	})
+	if err != nil {
+		check.Status = StatusUnknown
+		check.Details = fmt.Sprintf("failed to list the failover history: %v", err)
+		return check
+	}
+
+	var last int64
+	for _, event := range resp.GetFailoverEvents() {
+		if created := common.Int64Default(event.CreatedTime); created > last {
+			last = created
+		}
+	}
+	if last == 0 {
+		check.Status = StatusWarn
+		check.Details = "the domain has no failover history"
+		check.Remediation = "Run a failover drill (cadence domain update --active_cluster) to check that the workers and dependencies of the domain work in the other clusters."
+		return check
+	}
+
+	lastFailover := time.Unix(0, last)
+	age := now.Sub(lastFailover)
+	if age > FailoverDrillMaxAge {
+		check.Status = StatusWarn
+		check.Details = fmt.Sprintf("the last failover was on %s, %d days ago", lastFailover.UTC().Format(time.RFC3339), int(age.Hours()/24))
+		check.Remediation = "Run a failover drill, the last one is too old to tell that the other clusters still work for the domain."
+		return check
+	}
+	check.Status = StatusPass
+	check.Details = fmt.Sprintf("the last failover was on %s", lastFailover.UTC().Format(time.RFC3339))
+	return check
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+const testDomain = "test-domain"
+
+var testNow = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func globalDomain() *types.DescribeDomainResponse {
+	enabled := types.ArchivalStatusEnabled
+	return &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: testDomain, UUID: "domain-id"},
+		Configuration: &types.DomainConfiguration{
+			HistoryArchivalStatus:    &enabled,
+			VisibilityArchivalStatus: &enabled,
+		},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "cluster-a",
+			Clusters: []*types.ClusterReplicationConfiguration{
+				{ClusterName: "cluster-a"},
+				{ClusterName: "cluster-b"},
+			},
+		},
+		IsGlobalDomain: true,
+	}
+}
+
+func setupMocks(t *testing.T, desc *types.DescribeDomainResponse) (*frontend.MockClient, *admin.MockClient) {
+	ctrl := gomock.NewController(t)
+	frontendClient := frontend.NewMockClient(ctrl)
+	adminClient := admin.NewMockClient(ctrl)
+	frontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr(testDomain)}).Return(desc, nil)
+	return frontendClient, adminClient
+}
+
+func expectHealthy(frontendClient *frontend.MockClient, adminClient *admin.MockClient) {
+	expectReplicationLag(adminClient, 2, 10*time.Second)
+	adminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).Return(&types.CountDLQMessagesResponse{}, nil).AnyTimes()
+	adminClient.EXPECT().GetGlobalIsolationGroups(gomock.Any(), gomock.Any()).Return(&types.GetGlobalIsolationGroupsResponse{}, nil).AnyTimes()
+	adminClient.EXPECT().GetDomainIsolationGroups(gomock.Any(), gomock.Any()).Return(&types.GetDomainIsolationGroupsResponse{}, nil).AnyTimes()
+	frontendClient.EXPECT().ListFailoverHistory(gomock.Any(), gomock.Any()).Return(&types.ListFailoverHistoryResponse{
+		FailoverEvents: []*types.FailoverEvent{{CreatedTime: common.Int64Ptr(testNow.Add(-30 * 24 * time.Hour).UnixNano())}},
+	}, nil).AnyTimes()
+}
+
+// expectReplicationLag makes the sampled shards return the oldest unacked replication task created lag ago
+func expectReplicationLag(adminClient *admin.MockClient, numShards int32, lag time.Duration) {
+	adminClient.EXPECT().DescribeShardDistribution(gomock.Any(), gomock.Any()).Return(&types.DescribeShardDistributionResponse{NumberOfShards: numShards}, nil).AnyTimes()
+	adminClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.GetReplicationMessagesRequest, _ ...interface{}) (*types.GetReplicationMessagesResponse, error) {
+			resp := &types.GetReplicationMessagesResponse{MessagesByShard: map[int32]*types.ReplicationMessages{}}
+			for _, token := range request.Tokens {
+				resp.MessagesByShard[token.ShardID] = &types.ReplicationMessages{
+					ReplicationTasks: []*types.ReplicationTask{{CreationTime: common.Int64Ptr(testNow.Add(-lag).UnixNano())}},
+				}
+			}
+			return resp, nil
+		}).AnyTimes()
+}
+
+func checkByName(t *testing.T, report *Report, name string) Check {
+	for _, check := range report.Checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	t.Fatalf("check %s not found", name)
+	return Check{}
+}
+
+func TestAnalyze_Resilient(t *testing.T) {
+	frontendClient, adminClient := setupMocks(t, globalDomain())
+	expectHealthy(frontendClient, adminClient)
+
+	report, err := Analyze(context.Background(), frontendClient, adminClient, testDomain, testNow)
+	require.NoError(t, err)
+
+	assert.Equal(t, testDomain, report.Domain)
+	assert.Equal(t, 100, report.Score)
+	assert.Equal(t, RatingResilient, report.Rating)
+	assert.Empty(t, report.Remediation)
+	for _, check := range report.Checks {
+		assert.Equal(t, StatusPass, check.Status, check.Name)
+	}
+}
+
+func TestAnalyze_LocalDomain(t *testing.T) {
+	desc := globalDomain()
+	desc.IsGlobalDomain = false
+	desc.Configuration = &types.DomainConfiguration{}
+	desc.ReplicationConfiguration.Clusters = desc.ReplicationConfiguration.Clusters[:1]
+	frontendClient, adminClient := setupMocks(t, desc)
+	expectHealthy(frontendClient, adminClient)
+
+	report, err := Analyze(context.Background(), frontendClient, adminClient, testDomain, testNow)
+	require.NoError(t, err)
+
+	assert.Equal(t, StatusFail, checkByName(t, report, "global-domain").Status)
+	assert.Equal(t, StatusFail, checkByName(t, report, "replication-clusters").Status)
+	assert.Equal(t, StatusWarn, checkByName(t, report, "archival").Status)
+	assert.Equal(t, StatusUnknown, checkByName(t, report, "replication-lag").Status)
+	assert.Equal(t, StatusUnknown, checkByName(t, report, "replication-dlq").Status)
+	assert.Equal(t, StatusUnknown, checkByName(t, report, "failover-drill").Status)
+	assert.Equal(t, RatingNotResilient, report.Rating)
+	assert.Len(t, report.Remediation, 3)
+}
+
+func TestAnalyze_DescribeDomainError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	frontendClient := frontend.NewMockClient(ctrl)
+	frontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "not found"})
+
+	_, err := Analyze(context.Background(), frontendClient, admin.NewMockClient(ctrl), testDomain, testNow)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestAnalyze_Warnings(t *testing.T) {
+	desc := globalDomain()
+	desc.FailoverInfo = &types.FailoverInfo{PendingShards: []int32{1, 2}}
+	frontendClient, adminClient := setupMocks(t, desc)
+
+	expectReplicationLag(adminClient, 4, 2*time.Minute)
+
+	adminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).Return(&types.CountDLQMessagesResponse{
+		History: map[types.HistoryDLQCountKey]int64{
+			{ShardID: 1, SourceCluster: "cluster-b"}:     5,
+			{ShardID: 2, SourceCluster: "cluster-other"}: 5000,
+		},
+	}, nil)
+	adminClient.EXPECT().GetGlobalIsolationGroups(gomock.Any(), gomock.Any()).Return(nil, errors.New("not configured"))
+	adminClient.EXPECT().GetDomainIsolationGroups(gomock.Any(), &types.GetDomainIsolationGroupsRequest{Domain: testDomain}).Return(&types.GetDomainIsolationGroupsResponse{
+		IsolationGroups: types.IsolationGroupConfiguration{
+			"zone-1": {Name: "zone-1", State: types.IsolationGroupStateDrained},
+			"zone-2": {Name: "zone-2", State: types.IsolationGroupStateHealthy},
+		},
+	}, nil)
+	frontendClient.EXPECT().ListFailoverHistory(gomock.Any(), gomock.Any()).Return(&types.ListFailoverHistoryResponse{
+		FailoverEvents: []*types.FailoverEvent{{CreatedTime: common.Int64Ptr(testNow.Add(-365 * 24 * time.Hour).UnixNano())}},
+	}, nil)
+
+	report, err := Analyze(context.Background(), frontendClient, adminClient, testDomain, testNow)
+	require.NoError(t, err)
+
+	pending := checkByName(t, report, "pending-failover")
+	assert.Equal(t, StatusWarn, pending.Status)
+	assert.Contains(t, pending.Details, "2 shard(s)")
+
+	lag := checkByName(t, report, "replication-lag")
+	assert.Equal(t, StatusWarn, lag.Status)
+	assert.Contains(t, lag.Details, "cluster-b is 2m0s behind")
+
+	dlq := checkByName(t, report, "replication-dlq")
+	assert.Equal(t, StatusWarn, dlq.Status)
+	assert.Contains(t, dlq.Details, "5 history replication message(s) from cluster-b")
+	assert.NotContains(t, dlq.Details, "cluster-other")
+
+	groups := checkByName(t, report, "isolation-groups")
+	assert.Equal(t, StatusWarn, groups.Status)
+	assert.Contains(t, groups.Details, "zone-1 (domain)")
+	assert.NotContains(t, groups.Details, "zone-2")
+
+	drill := checkByName(t, report, "failover-drill")
+	assert.Equal(t, StatusWarn, drill.Status)
+	assert.Contains(t, drill.Details, "365 days ago")
+
+	// 25+15+10+5/2+10/2+10/2+10/2+5+10/2 out of 100
+	assert.Equal(t, 77, report.Score)
+	assert.Equal(t, RatingAtRisk, report.Rating)
+	assert.Len(t, report.Remediation, 5)
+}
+
+func TestAnalyze_UnknownChecks(t *testing.T) {
+	frontendClient, adminClient := setupMocks(t, globalDomain())
+	adminClient.EXPECT().DescribeShardDistribution(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	adminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	adminClient.EXPECT().GetGlobalIsolationGroups(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	adminClient.EXPECT().GetDomainIsolationGroups(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	frontendClient.EXPECT().ListFailoverHistory(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+	report, err := Analyze(context.Background(), frontendClient, adminClient, testDomain, testNow)
+	require.NoError(t, err)
+
+	assert.Equal(t, StatusUnknown, checkByName(t, report, "replication-lag").Status)
+	assert.Equal(t, StatusUnknown, checkByName(t, report, "replication-dlq").Status)
+	assert.Equal(t, StatusUnknown, checkByName(t, report, "isolation-groups").Status)
+	assert.Equal(t, StatusUnknown, checkByName(t, report, "failover-drill").Status)
+	// unknown checks are left out of the score
+	assert.Equal(t, 100, report.Score)
+}
+
+func TestCheckActiveClusters(t *testing.T) {
+	clusters := []string{"cluster-a", "cluster-b"}
+	activeClusters := func(active ...string) *types.DomainReplicationConfiguration {
+		attributes := map[string]types.ActiveClusterInfo{}
+		for i, cluster := range active {
+			attributes[string(rune('a'+i))] = types.ActiveClusterInfo{ActiveClusterName: cluster}
+		}
+		return &types.DomainReplicationConfiguration{
+			ActiveClusterName: "cluster-a",
+			ActiveClusters: &types.ActiveClusters{
+				AttributeScopes: map[string]types.ClusterAttributeScope{"region": {ClusterAttributes: attributes}},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		replication *types.DomainReplicationConfiguration
+		status      Status
+	}{
+		"active-passive": {
+			replication: &types.DomainReplicationConfiguration{ActiveClusterName: "cluster-a"},
+			status:      StatusPass,
+		},
+		"active-active": {
+			replication: activeClusters("cluster-a", "cluster-b"),
+			status:      StatusPass,
+		},
+		"single active cluster": {
+			replication: activeClusters("cluster-a", "cluster-a"),
+			status:      StatusWarn,
+		},
+		"unknown cluster": {
+			replication: activeClusters("cluster-a", "cluster-c"),
+			status:      StatusFail,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.status, checkActiveClusters(test.replication, clusters).Status)
+		})
+	}
+}
+
+func TestCheckReplicationDLQ_Threshold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	adminClient := admin.NewMockClient(ctrl)
+	adminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).Return(&types.CountDLQMessagesResponse{
+		History: map[types.HistoryDLQCountKey]int64{{ShardID: 1, SourceCluster: "cluster-b"}: DLQFailThreshold},
+		Domain:  1,
+	}, nil)
+
+	check := checkReplicationDLQ(context.Background(), adminClient, true, []string{"cluster-a", "cluster-b"})
+	assert.Equal(t, StatusFail, check.Status)
+	assert.Contains(t, check.Details, "1 domain replication message(s)")
+}
+
+func TestCheckReplicationLag(t *testing.T) {
+	clusters := []string{"cluster-a", "cluster-b", "cluster-c"}
+
+	tests := map[string]struct {
+		lag     time.Duration
+		status  Status
+		details string
+	}{
+		"caught up": {
+			lag:     5 * time.Second,
+			status:  StatusPass,
+			details: "cluster-b is 5s behind, cluster-c is 5s behind",
+		},
+		"above warn threshold": {
+			lag:     ReplicationLagWarnThreshold + time.Second,
+			status:  StatusWarn,
+			details: "cluster-b is 1m1s behind",
+		},
+		"above fail threshold": {
+			lag:     ReplicationLagFailThreshold + time.Second,
+			status:  StatusFail,
+			details: "cluster-c is 10m1s behind",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			adminClient := admin.NewMockClient(ctrl)
+			expectReplicationLag(adminClient, 64, test.lag)
+
+			check := checkReplicationLag(context.Background(), adminClient, true, "cluster-a", clusters, testNow)
+			assert.Equal(t, test.status, check.Status)
+			assert.Contains(t, check.Details, test.details)
+			assert.NotContains(t, check.Details, "cluster-a")
+		})
+	}
+}
+
+func TestCheckReplicationLag_SampledShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	adminClient := admin.NewMockClient(ctrl)
+	adminClient.EXPECT().DescribeShardDistribution(gomock.Any(), gomock.Any()).Return(&types.DescribeShardDistributionResponse{NumberOfShards: 64}, nil)
+	adminClient.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.GetReplicationMessagesRequest, _ ...interface{}) (*types.GetReplicationMessagesResponse, error) {
+			assert.Equal(t, "cluster-b", request.ClusterName)
+			require.Len(t, request.Tokens, replicationLagShardSampleSize)
+			for i, token := range request.Tokens {
+				assert.Equal(t, int32(i*4), token.ShardID)
+				// without a read level, so that the ack level of the cluster is not moved
+				assert.Equal(t, int64(constants.EmptyMessageID), token.LastRetrievedMessageID)
+			}
+			// the shards which fail to read their tasks are left out
+			return &types.GetReplicationMessagesResponse{}, nil
+		})
+
+	check := checkReplicationLag(context.Background(), adminClient, true, "cluster-a", []string{"cluster-a", "cluster-b"}, testNow)
+	assert.Equal(t, StatusUnknown, check.Status)
+	assert.Contains(t, check.Details, "cluster-b: no shard returned replication messages")
+}
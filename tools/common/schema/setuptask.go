@@ -64,7 +64,7 @@ func (task *SetupTask) Run() error {
 		if err != nil {
 			return err
 		}
-		stmts, err := ParseFile(file)
+		stmts, err := parseStatements(task.db, file)
 		if err != nil {
 			return err
 		}
@@ -68,6 +68,13 @@ type (
 		// Close gracefully closes the client object
 		Close()
 	}
+	// StatementParser is optionally implemented by a SchemaClient whose schema files
+	// are not made of semicolon delimited CQL / SQL statements. The parsed statements
+	// are passed to ExecDDLQuery as is, and the client is responsible for validating them.
+	StatementParser interface {
+		// ParseStatements parses and validates the statements in a schema file
+		ParseStatements(file fs.File) ([]string, error)
+	}
 )
 
 const (
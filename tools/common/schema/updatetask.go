@@ -221,9 +221,11 @@ func (task *UpdateTask) BuildChangeSet(currVer string) ([]ChangeSet, error) {
 			return nil, e
 		}
 
-		e = validateCQLStmts(stmts)
-		if e != nil {
-			return nil, fmt.Errorf("error processing version %v:%v", vd, e.Error())
+		if _, ok := task.db.(StatementParser); !ok {
+			e = validateCQLStmts(stmts)
+			if e != nil {
+				return nil, fmt.Errorf("error processing version %v:%v", vd, e.Error())
+			}
 		}
 
 		cs := ChangeSet{}
@@ -246,7 +248,7 @@ func (task *UpdateTask) parseSQLStmts(dir string, manifest *manifest) ([]string,
 		if err != nil {
 			return nil, fmt.Errorf("error opening file %v, err=%v", path, err)
 		}
-		stmts, err := ParseFile(f)
+		stmts, err := parseStatements(task.db, f)
 		if err != nil {
 			return nil, fmt.Errorf("error parsing file %v, err=%v", path, err)
 		}
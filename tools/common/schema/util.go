@@ -29,6 +29,15 @@ import (
 
 const newLineDelim = '\n'
 
+// parseStatements parses the schema file with the parser of the client
+// if it has one, and falls back to ParseFile otherwise.
+func parseStatements(db SchemaClient, file fs.File) ([]string, error) {
+	if parser, ok := db.(StatementParser); ok {
+		return parser.ParseStatements(file)
+	}
+	return ParseFile(file)
+}
+
 // ParseFile takes a cql / sql file as input
 // and returns an array of cql / sql statements on
 // success.
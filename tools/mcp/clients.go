@@ -0,0 +1,117 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
+	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/peer"
+	"go.uber.org/yarpc/peer/hostport"
+	"go.uber.org/yarpc/transport/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
+	"github.com/uber/cadence/common"
+	cc "github.com/uber/cadence/common/client"
+)
+
+const (
+	cadenceClientName      = "cadence-mcp"
+	cadenceFrontendService = "cadence-frontend"
+)
+
+// clientConfig is how the MCP server connects to the gRPC endpoint of the frontend
+type clientConfig struct {
+	Address string
+	// Path of the CA certificate of the server, TLS is not used if empty
+	TLSCertPath string
+	// JWT sent with every request, for clusters with the OAuth authorizer
+	JWT string
+}
+
+// newClients creates the frontend and admin clients, and the dispatcher which must be stopped once they are no longer used
+func newClients(cfg clientConfig) (frontend.Client, admin.Client, *yarpc.Dispatcher, error) {
+	outbound := grpc.NewTransport().NewSingleOutbound(cfg.Address)
+	if cfg.TLSCertPath != "" {
+		caCert, err := os.ReadFile(cfg.TLSCertPath)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("read server CA certificate: %w", err)
+		}
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, nil, nil, errors.New("failed to add server CA certificate")
+		}
+		grpcTransport := grpc.NewTransport()
+		tlsCreds := credentials.NewTLS(&tls.Config{RootCAs: caCertPool})
+		tlsChooser := peer.NewSingle(hostport.Identify(cfg.Address), grpcTransport.NewDialer(grpc.DialerCredentials(tlsCreds)))
+		outbound = grpcTransport.NewOutbound(tlsChooser)
+	}
+
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name:      cadenceClientName,
+		Outbounds: yarpc.Outbounds{cadenceFrontendService: transport.Outbounds{Unary: outbound}},
+		OutboundMiddleware: yarpc.OutboundMiddleware{
+			Unary: &headersMiddleware{jwt: cfg.JWT},
+		},
+	})
+	if err := dispatcher.Start(); err != nil {
+		return nil, nil, nil, fmt.Errorf("start dispatcher: %w", err)
+	}
+
+	config := dispatcher.ClientConfig(cadenceFrontendService)
+	frontendClient := grpcClient.NewFrontendClient(
+		apiv1.NewDomainAPIYARPCClient(config),
+		apiv1.NewWorkflowAPIYARPCClient(config),
+		apiv1.NewWorkerAPIYARPCClient(config),
+		apiv1.NewVisibilityAPIYARPCClient(config),
+	)
+	adminClient := grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(config))
+	return frontendClient, adminClient, dispatcher, nil
+}
+
+// headersMiddleware sets the same client headers as the CLI, whose features the MCP server supports
+type headersMiddleware struct {
+	jwt string
+}
+
+func (m *headersMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	request.Headers = request.Headers.
+		With(common.ClientImplHeaderName, cc.CLI).
+		With(common.FeatureVersionHeaderName, cc.SupportedCLIVersion).
+		With(common.ClientFeatureFlagsHeaderName, cc.FeatureFlagsHeader(cc.DefaultCLIFeatureFlags))
+	if m.jwt != "" {
+		request.Headers = request.Headers.With(common.AuthorizationTokenHeaderName, m.jwt)
+	}
+	return out.Call(ctx, request)
+}
@@ -26,6 +26,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"flag"
 	"fmt"
 	"os"
 	"os/exec"
@@ -38,6 +39,18 @@ import (
 )
 
 func main() {
+	var cfg clientConfig
+	flag.StringVar(&cfg.Address, "address", "localhost:7833", "gRPC endpoint of the cadence frontend")
+	flag.StringVar(&cfg.TLSCertPath, "tls_cert_path", "", "Path of the CA certificate of the cadence frontend, to connect with TLS")
+	flag.StringVar(&cfg.JWT, "jwt", os.Getenv("CADENCE_CLI_JWT"), "JWT sent with every request, for clusters with authorization enabled")
+	flag.Parse()
+
+	frontendClient, adminClient, dispatcher, err := newClients(cfg)
+	if err != nil {
+		debugLog("Failed to create cadence clients: %v\n", err)
+		os.Exit(1)
+	}
+	defer dispatcher.Stop()
 
 	// Create MCP server
 	s := server.NewMCPServer(
@@ -47,17 +60,7 @@ func main() {
 	)
 
 	// Add tool handlers
-	s.AddTool(mcp.NewTool("domain_rr",
-		mcp.WithDescription("Check if a cadence domain is resilient to regional outages"),
-		mcp.WithString("domain",
-			mcp.Required(),
-			mcp.Description("Name of the cadence domain to check"),
-		),
-		mcp.WithString("grpc_endpoint",
-			mcp.DefaultString("localhost:7833"),
-			mcp.Description("gRPC endpoint of the cadence domain"),
-		),
-	), domainRRHandler)
+	newToolset(frontendClient, adminClient).register(s)
 
 	s.AddTool(mcp.NewTool("payload_decoder",
 		mcp.WithDescription("Decode a payload that is encoded by hex or base64. The payload is from Cadence database."),
@@ -93,49 +96,6 @@ func main() {
 	debugLog("Cadence MCP stopped")
 }
 
-func domainRRHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	defer func() {
-		// recover from panic
-		if r := recover(); r != nil {
-			// include the stack trace
-			debugLog("Panic: %v\n", r)
-			debugLog("Stack trace: %s\n", string(debug.Stack()))
-		}
-	}()
-
-	domain, ok := request.Params.Arguments["domain"].(string)
-	if !ok {
-		return nil, errors.New("domain must be a string")
-	}
-
-	endpoint, ok := request.Params.Arguments["grpc_endpoint"].(string)
-	if !ok {
-		endpoint = "localhost:7833"
-	}
-
-	// run cadence CLI to check if it's a global domain or not
-	cmd := exec.Command("docker", "run", "-t", "--rm", "--network", "host", "ubercadence/cli:master",
-		"--transport", "grpc",
-		"--address", endpoint,
-		"--domain", domain,
-		"domain", "describe")
-	// run the cmd and capture both stdout and stderr
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		debugLog("Error checking domain resilience: %v, %s\n", err, string(output))
-		return mcp.NewToolResultError("Error checking domain resilience: " + err.Error() + "\n" + string(output)), nil
-	}
-
-	// parse the output of the cadence CLI
-	// if it contains "IsGlobal(XDC)Domain: true" then it's a global domain
-	// otherwise it's not
-	if strings.Contains(string(output), "IsGlobal(XDC)Domain: true") {
-		return mcp.NewToolResultText("Yes, this domain is resilient to regional outages"), nil
-	}
-
-	return mcp.NewToolResultText("No, this domain is not resilient to regional outages. Consider making it a global domain."), nil
-}
-
 func payloadDecoderHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	payload, ok := request.Params.Arguments["payload"].(string)
 	if !ok {
@@ -0,0 +1,725 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/resilience"
+)
+
+const (
+	defaultListPageSize     = 20
+	defaultHistoryMaxEvents = 1000
+	historyPageSize         = 100
+	// maxHistoryFailures is how many of the last failures the history summary keeps
+	maxHistoryFailures = 5
+	mcpIdentity        = "cadence-mcp"
+
+	diagnosticsReportQueryType      = "query-diagnostics-report"
+	defaultDiagnosticsTimeoutSecond = 120
+)
+
+// toolset implements the tools which call the frontend and admin APIs of a Cadence cluster
+type toolset struct {
+	frontend frontend.Client
+	admin    admin.Client
+	// diagnosticsPollInterval is how often the report of the diagnostics workflow is queried
+	diagnosticsPollInterval time.Duration
+}
+
+func newToolset(frontendClient frontend.Client, adminClient admin.Client) *toolset {
+	return &toolset{
+		frontend:                frontendClient,
+		admin:                   adminClient,
+		diagnosticsPollInterval: time.Second,
+	}
+}
+
+func (t *toolset) register(s *server.MCPServer) {
+	s.AddTool(mcp.NewTool("domain_rr",
+		mcp.WithDescription("Check if a cadence domain is resilient to regional outages: a scored report of its replication, replication lag and DLQs, isolation group drains, archival and failover history, with remediation items"),
+		mcp.WithString("domain",
+			mcp.Required(),
+			mcp.Description("Name of the cadence domain to check"),
+		),
+	), withRecover(t.domainRR))
+
+	s.AddTool(mcp.NewTool("describe_workflow",
+		mcp.WithDescription("Describe a workflow execution: its status, pending activities, pending children and pending decision"),
+		withExecutionParams(),
+	), withRecover(t.describeWorkflow))
+
+	s.AddTool(mcp.NewTool("list_workflows",
+		mcp.WithDescription("List the workflow executions of a domain matching a visibility query, e.g. \"WorkflowType = 'MyWorkflow' AND CloseStatus = 'failed'\""),
+		mcp.WithString("domain",
+			mcp.Required(),
+			mcp.Description("Name of the cadence domain"),
+		),
+		mcp.WithString("query",
+			mcp.Description("Visibility query, all workflows if empty"),
+		),
+		mcp.WithNumber("page_size",
+			mcp.DefaultNumber(defaultListPageSize),
+			mcp.Description("Maximum number of workflows to return"),
+		),
+		mcp.WithString("next_page_token",
+			mcp.Description("Token returned by the previous call to get the next page"),
+		),
+	), withRecover(t.listWorkflows))
+
+	s.AddTool(mcp.NewTool("workflow_history_summary",
+		mcp.WithDescription("Fetch the history of a workflow execution and summarise it: event counts, close status, pending activities and the last failures"),
+		withExecutionParams(),
+		mcp.WithNumber("max_events",
+			mcp.DefaultNumber(defaultHistoryMaxEvents),
+			mcp.Description("Maximum number of events to fetch"),
+		),
+	), withRecover(t.workflowHistorySummary))
+
+	s.AddTool(mcp.NewTool("diagnose_workflow",
+		mcp.WithDescription("Run the diagnostics workflow for a workflow execution, which looks for timeouts, failures and retries, and return its report with the issues found and their root causes"),
+		withExecutionParams(),
+		mcp.WithNumber("timeout_seconds",
+			mcp.DefaultNumber(defaultDiagnosticsTimeoutSecond),
+			mcp.Description("How long to wait for the diagnostics to complete"),
+		),
+	), withRecover(t.diagnoseWorkflow))
+
+	s.AddTool(mcp.NewTool("describe_task_list",
+		mcp.WithDescription("Describe the backlog and pollers of a task list"),
+		mcp.WithString("domain",
+			mcp.Required(),
+			mcp.Description("Name of the cadence domain"),
+		),
+		mcp.WithString("task_list",
+			mcp.Required(),
+			mcp.Description("Name of the task list"),
+		),
+		mcp.WithString("task_list_type",
+			mcp.DefaultString("decision"),
+			mcp.Enum("decision", "activity"),
+			mcp.Description("Type of the task list"),
+		),
+	), withRecover(t.describeTaskList))
+
+	s.AddTool(mcp.NewTool("list_failover_history",
+		mcp.WithDescription("List the failovers of a domain, most recent first"),
+		mcp.WithString("domain",
+			mcp.Required(),
+			mcp.Description("Name of the cadence domain"),
+		),
+		mcp.WithNumber("page_size",
+			mcp.DefaultNumber(defaultListPageSize),
+			mcp.Description("Maximum number of failovers to return"),
+		),
+		mcp.WithString("next_page_token",
+			mcp.Description("Token returned by the previous call to get the next page"),
+		),
+	), withRecover(t.listFailoverHistory))
+
+	s.AddTool(mcp.NewTool("dlq_counts",
+		mcp.WithDescription("Count the messages in the history and domain replication DLQs of the cluster"),
+		mcp.WithBoolean("force_fetch",
+			mcp.DefaultBool(false),
+			mcp.Description("Read the counts from the database instead of the cached values"),
+		),
+	), withRecover(t.dlqCounts))
+}
+
+func withExecutionParams() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("domain",
+			mcp.Required(),
+			mcp.Description("Name of the cadence domain"),
+		)(tool)
+		mcp.WithString("workflow_id",
+			mcp.Required(),
+			mcp.Description("ID of the workflow"),
+		)(tool)
+		mcp.WithString("run_id",
+			mcp.Description("Run ID of the workflow, the current run if empty"),
+		)(tool)
+	}
+}
+
+func (t *toolset) domainRR(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := requiredString(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := resilience.Analyze(ctx, t.frontend, t.admin, domain, time.Now())
+	if err != nil {
+		return mcp.NewToolResultError("Error analysing domain: " + err.Error()), nil
+	}
+	return jsonResult(report)
+}
+
+func (t *toolset) describeWorkflow(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := executionArgs(request)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.frontend.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    domain,
+		Execution: execution,
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error describing workflow: " + err.Error()), nil
+	}
+	return jsonResult(resp)
+}
+
+type workflowSummary struct {
+	WorkflowID    string `json:"workflowId"`
+	RunID         string `json:"runId"`
+	WorkflowType  string `json:"workflowType,omitempty"`
+	TaskList      string `json:"taskList,omitempty"`
+	StartTime     *int64 `json:"startTime,omitempty"`
+	CloseTime     *int64 `json:"closeTime,omitempty"`
+	CloseStatus   string `json:"closeStatus,omitempty"`
+	HistoryLength int64  `json:"historyLength,omitempty"`
+}
+
+type listWorkflowsResult struct {
+	Workflows     []workflowSummary `json:"workflows"`
+	NextPageToken string            `json:"nextPageToken,omitempty"`
+}
+
+func (t *toolset) listWorkflows(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := requiredString(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+	pageToken, err := pageTokenArg(request)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.frontend.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:        domain,
+		PageSize:      int32(optionalNumber(request, "page_size", defaultListPageSize)),
+		NextPageToken: pageToken,
+		Query:         optionalString(request, "query", ""),
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error listing workflows: " + err.Error()), nil
+	}
+
+	result := listWorkflowsResult{
+		Workflows:     []workflowSummary{},
+		NextPageToken: base64.StdEncoding.EncodeToString(resp.GetNextPageToken()),
+	}
+	for _, info := range resp.GetExecutions() {
+		summary := workflowSummary{
+			WorkflowID:    info.GetExecution().GetWorkflowID(),
+			RunID:         info.GetExecution().GetRunID(),
+			WorkflowType:  info.GetType().GetName(),
+			TaskList:      info.TaskList.GetName(),
+			StartTime:     info.StartTime,
+			CloseTime:     info.CloseTime,
+			HistoryLength: info.HistoryLength,
+		}
+		if info.CloseStatus != nil {
+			summary.CloseStatus = info.CloseStatus.String()
+		}
+		result.Workflows = append(result.Workflows, summary)
+	}
+	return jsonResult(result)
+}
+
+type historyFailure struct {
+	EventID   int64  `json:"eventId"`
+	EventType string `json:"eventType"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+type historySummary struct {
+	WorkflowType       string           `json:"workflowType,omitempty"`
+	TaskList           string           `json:"taskList,omitempty"`
+	EventCount         int              `json:"eventCount"`
+	Truncated          bool             `json:"truncated,omitempty"`
+	FirstEventTime     *int64           `json:"firstEventTime,omitempty"`
+	LastEventTime      *int64           `json:"lastEventTime,omitempty"`
+	LastEventType      string           `json:"lastEventType,omitempty"`
+	Closed             bool             `json:"closed"`
+	EventTypeCounts    map[string]int   `json:"eventTypeCounts"`
+	PendingActivities  []string         `json:"pendingActivities,omitempty"`
+	LastFailures       []historyFailure `json:"lastFailures,omitempty"`
+	DecisionTaskFailed int              `json:"decisionTaskFailed,omitempty"`
+}
+
+func (t *toolset) workflowHistorySummary(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := executionArgs(request)
+	if err != nil {
+		return nil, err
+	}
+	maxEvents := optionalNumber(request, "max_events", defaultHistoryMaxEvents)
+
+	var events []*types.HistoryEvent
+	var pageToken []byte
+	truncated := false
+	for {
+		resp, err := t.frontend.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+			Domain:          domain,
+			Execution:       execution,
+			MaximumPageSize: historyPageSize,
+			NextPageToken:   pageToken,
+		})
+		if err != nil {
+			return mcp.NewToolResultError("Error fetching workflow history: " + err.Error()), nil
+		}
+		events = append(events, resp.History.GetEvents()...)
+		pageToken = resp.NextPageToken
+		if len(events) >= maxEvents {
+			truncated = len(events) > maxEvents || len(pageToken) > 0
+			events = events[:maxEvents]
+			break
+		}
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	summary := summarizeHistory(events)
+	summary.Truncated = truncated
+	return jsonResult(summary)
+}
+
+// summarizeHistory counts the events of a history, and finds its pending activities and last failures
+func summarizeHistory(events []*types.HistoryEvent) historySummary {
+	summary := historySummary{
+		EventCount:      len(events),
+		EventTypeCounts: map[string]int{},
+	}
+	// activity IDs of the scheduled activities which have not completed, keyed by the ID of their scheduled event
+	pending := map[int64]string{}
+	var failures []historyFailure
+
+	for _, event := range events {
+		eventType := event.GetEventType().String()
+		summary.EventTypeCounts[eventType]++
+
+		switch event.GetEventType() {
+		case types.EventTypeWorkflowExecutionStarted:
+			if attributes := event.WorkflowExecutionStartedEventAttributes; attributes != nil {
+				summary.WorkflowType = attributes.WorkflowType.GetName()
+				summary.TaskList = attributes.TaskList.GetName()
+			}
+		case types.EventTypeActivityTaskScheduled:
+			pending[event.ID] = event.ActivityTaskScheduledEventAttributes.GetActivityID()
+		case types.EventTypeActivityTaskCompleted:
+			delete(pending, event.ActivityTaskCompletedEventAttributes.GetScheduledEventID())
+		case types.EventTypeActivityTaskCanceled:
+			delete(pending, event.ActivityTaskCanceledEventAttributes.GetScheduledEventID())
+		case types.EventTypeActivityTaskFailed:
+			attributes := event.ActivityTaskFailedEventAttributes
+			delete(pending, attributes.GetScheduledEventID())
+			failure := historyFailure{EventID: event.ID, EventType: eventType}
+			if attributes != nil {
+				failure.Reason = common.StringDefault(attributes.Reason)
+			}
+			failures = append(failures, failure)
+		case types.EventTypeActivityTaskTimedOut:
+			attributes := event.ActivityTaskTimedOutEventAttributes
+			delete(pending, attributes.GetScheduledEventID())
+			failure := historyFailure{EventID: event.ID, EventType: eventType}
+			if attributes != nil && attributes.TimeoutType != nil {
+				failure.Reason = "timeout: " + attributes.TimeoutType.String()
+				if lastFailure := common.StringDefault(attributes.LastFailureReason); lastFailure != "" {
+					failure.Reason += ", last failure: " + lastFailure
+				}
+			}
+			failures = append(failures, failure)
+		case types.EventTypeDecisionTaskFailed:
+			summary.DecisionTaskFailed++
+			failure := historyFailure{EventID: event.ID, EventType: eventType}
+			if attributes := event.DecisionTaskFailedEventAttributes; attributes != nil && attributes.Cause != nil {
+				failure.Reason = attributes.Cause.String()
+				if reason := common.StringDefault(attributes.Reason); reason != "" {
+					failure.Reason += ": " + reason
+				}
+			}
+			failures = append(failures, failure)
+		case types.EventTypeWorkflowExecutionFailed:
+			failures = append(failures, historyFailure{EventID: event.ID, EventType: eventType, Reason: event.WorkflowExecutionFailedEventAttributes.GetReason()})
+		case types.EventTypeWorkflowExecutionTimedOut:
+			failures = append(failures, historyFailure{EventID: event.ID, EventType: eventType, Reason: "workflow timeout"})
+		}
+
+		switch event.GetEventType() {
+		case types.EventTypeWorkflowExecutionCompleted,
+			types.EventTypeWorkflowExecutionFailed,
+			types.EventTypeWorkflowExecutionTimedOut,
+			types.EventTypeWorkflowExecutionCanceled,
+			types.EventTypeWorkflowExecutionTerminated,
+			types.EventTypeWorkflowExecutionContinuedAsNew:
+			summary.Closed = true
+		}
+	}
+
+	if len(events) > 0 {
+		summary.FirstEventTime = events[0].Timestamp
+		summary.LastEventTime = events[len(events)-1].Timestamp
+		summary.LastEventType = events[len(events)-1].GetEventType().String()
+	}
+
+	scheduledIDs := make([]int64, 0, len(pending))
+	for id := range pending {
+		scheduledIDs = append(scheduledIDs, id)
+	}
+	sort.Slice(scheduledIDs, func(i, j int) bool { return scheduledIDs[i] < scheduledIDs[j] })
+	for _, id := range scheduledIDs {
+		summary.PendingActivities = append(summary.PendingActivities, pending[id])
+	}
+
+	if len(failures) > maxHistoryFailures {
+		failures = failures[len(failures)-maxHistoryFailures:]
+	}
+	summary.LastFailures = failures
+	return summary
+}
+
+// diagnosticsResult is the diagnostics workflow of a workflow execution and its report, whose sections are kept
+// as the diagnostics worker returns them
+type diagnosticsResult struct {
+	Domain              string                     `json:"domain"`
+	DiagnosticExecution *types.WorkflowExecution   `json:"diagnosticWorkflowExecution"`
+	Report              map[string]json.RawMessage `json:"report"`
+}
+
+func (t *toolset) diagnoseWorkflow(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := executionArgs(request)
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.Duration(optionalNumber(request, "timeout_seconds", defaultDiagnosticsTimeoutSecond)) * time.Second
+
+	resp, err := t.frontend.DiagnoseWorkflowExecution(ctx, &types.DiagnoseWorkflowExecutionRequest{
+		Domain:            domain,
+		WorkflowExecution: execution,
+		Identity:          mcpIdentity,
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error diagnosing workflow: " + err.Error()), nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	result, err := t.waitForDiagnosticsReport(waitCtx, resp.GetDomain(), resp.GetDiagnosticWorkflowExecution())
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Error getting the diagnostics report, query %q of workflow %s in domain %s later: %v",
+			diagnosticsReportQueryType, resp.GetDiagnosticWorkflowExecution().GetWorkflowID(), resp.GetDomain(), err)), nil
+	}
+	return jsonResult(result)
+}
+
+// waitForDiagnosticsReport queries the diagnostics workflow until its report is completed, like the CLI does
+func (t *toolset) waitForDiagnosticsReport(
+	ctx context.Context,
+	domain string,
+	execution *types.WorkflowExecution,
+) (*diagnosticsResult, error) {
+	request := &types.QueryWorkflowRequest{
+		Domain:    domain,
+		Execution: execution,
+		Query: &types.WorkflowQuery{
+			QueryType: diagnosticsReportQueryType,
+		},
+	}
+	for {
+		var report struct {
+			DiagnosticsResult    map[string]json.RawMessage
+			DiagnosticsCompleted bool
+		}
+		queryResp, err := t.frontend.QueryWorkflow(ctx, request)
+		if err == nil {
+			if queryResp.QueryRejected != nil {
+				return nil, fmt.Errorf("diagnostics workflow is in state %v", queryResp.QueryRejected.CloseStatus)
+			}
+			if err := json.Unmarshal(queryResp.GetQueryResult(), &report); err != nil {
+				return nil, fmt.Errorf("unable to deserialize the diagnostics report: %w", err)
+			}
+			if report.DiagnosticsCompleted {
+				return &diagnosticsResult{
+					Domain:              domain,
+					DiagnosticExecution: execution,
+					Report:              report.DiagnosticsResult,
+				}, nil
+			}
+		}
+
+		// the query fails until the diagnostics workflow processed its first decision task
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("diagnostics not completed: %w", ctx.Err())
+		case <-time.After(t.diagnosticsPollInterval):
+		}
+	}
+}
+
+type pollerSummary struct {
+	Identity       string  `json:"identity"`
+	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
+	RatePerSecond  float64 `json:"ratePerSecond,omitempty"`
+}
+
+type taskListSummary struct {
+	Domain       string          `json:"domain"`
+	TaskList     string          `json:"taskList"`
+	TaskListType string          `json:"taskListType"`
+	Backlog      int64           `json:"backlog"`
+	AddRate      float64         `json:"addRatePerSecond,omitempty"`
+	DispatchRate float64         `json:"dispatchRatePerSecond,omitempty"`
+	Pollers      []pollerSummary `json:"pollers"`
+	Warning      string          `json:"warning,omitempty"`
+}
+
+func (t *toolset) describeTaskList(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := requiredString(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+	taskList, err := requiredString(request, "task_list")
+	if err != nil {
+		return nil, err
+	}
+	taskListType := optionalString(request, "task_list_type", "decision")
+	var listType types.TaskListType
+	switch taskListType {
+	case "decision":
+		listType = types.TaskListTypeDecision
+	case "activity":
+		listType = types.TaskListTypeActivity
+	default:
+		return nil, fmt.Errorf("task_list_type must be decision or activity, got %q", taskListType)
+	}
+
+	resp, err := t.frontend.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
+		Domain:                domain,
+		TaskList:              &types.TaskList{Name: taskList},
+		TaskListType:          listType.Ptr(),
+		IncludeTaskListStatus: true,
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error describing task list: " + err.Error()), nil
+	}
+
+	summary := taskListSummary{
+		Domain:       domain,
+		TaskList:     taskList,
+		TaskListType: taskListType,
+		Pollers:      []pollerSummary{},
+	}
+	if status := resp.GetTaskListStatus(); status != nil {
+		summary.Backlog = status.BacklogCountHint
+		summary.AddRate = status.NewTasksPerSecond
+		summary.DispatchRate = status.RatePerSecond
+	}
+	for _, poller := range resp.GetPollers() {
+		summary.Pollers = append(summary.Pollers, pollerSummary{
+			Identity:       poller.GetIdentity(),
+			LastAccessTime: poller.LastAccessTime,
+			RatePerSecond:  poller.GetRatePerSecond(),
+		})
+	}
+	if len(summary.Pollers) == 0 {
+		summary.Warning = "no worker polled this task list recently, tasks are not being processed"
+	}
+	return jsonResult(summary)
+}
+
+type listFailoverHistoryResult struct {
+	Domain         string                 `json:"domain"`
+	FailoverEvents []*types.FailoverEvent `json:"failoverEvents"`
+	NextPageToken  string                 `json:"nextPageToken,omitempty"`
+}
+
+func (t *toolset) listFailoverHistory(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := requiredString(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+	pageToken, err := pageTokenArg(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// failover history is keyed by domain ID
+	domainResp, err := t.frontend.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: &domain})
+	if err != nil {
+		return mcp.NewToolResultError("Error describing domain: " + err.Error()), nil
+	}
+
+	pageSize := int32(optionalNumber(request, "page_size", defaultListPageSize))
+	resp, err := t.frontend.ListFailoverHistory(ctx, &types.ListFailoverHistoryRequest{
+		Filters:    &types.ListFailoverHistoryRequestFilters{DomainID: domainResp.GetDomainInfo().GetUUID()},
+		Pagination: &types.PaginationOptions{PageSize: &pageSize, NextPageToken: pageToken},
+	})
+	if err != nil {
+		return mcp.NewToolResultError("Error listing failover history: " + err.Error()), nil
+	}
+
+	result := listFailoverHistoryResult{
+		Domain:         domain,
+		FailoverEvents: resp.GetFailoverEvents(),
+		NextPageToken:  base64.StdEncoding.EncodeToString(resp.GetNextPageToken()),
+	}
+	if result.FailoverEvents == nil {
+		result.FailoverEvents = []*types.FailoverEvent{}
+	}
+	return jsonResult(result)
+}
+
+type historyDLQCount struct {
+	ShardID       int32  `json:"shardId"`
+	SourceCluster string `json:"sourceCluster"`
+	Count         int64  `json:"count"`
+}
+
+type dlqCountsResult struct {
+	DomainDLQ       int64             `json:"domainDLQ"`
+	HistoryDLQTotal int64             `json:"historyDLQTotal"`
+	HistoryDLQ      []historyDLQCount `json:"historyDLQ"`
+}
+
+func (t *toolset) dlqCounts(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	forceFetch, _ := request.Params.Arguments["force_fetch"].(bool)
+
+	resp, err := t.admin.CountDLQMessages(ctx, &types.CountDLQMessagesRequest{ForceFetch: forceFetch})
+	if err != nil {
+		return mcp.NewToolResultError("Error counting DLQ messages: " + err.Error()), nil
+	}
+
+	result := dlqCountsResult{
+		DomainDLQ:  resp.Domain,
+		HistoryDLQ: []historyDLQCount{},
+	}
+	for key, count := range resp.History {
+		result.HistoryDLQTotal += count
+		result.HistoryDLQ = append(result.HistoryDLQ, historyDLQCount{
+			ShardID:       key.ShardID,
+			SourceCluster: key.SourceCluster,
+			Count:         count,
+		})
+	}
+	sort.Slice(result.HistoryDLQ, func(i, j int) bool {
+		a, b := result.HistoryDLQ[i], result.HistoryDLQ[j]
+		if a.SourceCluster != b.SourceCluster {
+			return a.SourceCluster < b.SourceCluster
+		}
+		return a.ShardID < b.ShardID
+	})
+	return jsonResult(result)
+}
+
+// withRecover logs the panics of a tool handler, which would otherwise stop the server
+func withRecover(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (result *mcp.CallToolResult, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				debugLog("Panic in %s: %v\n", request.Params.Name, r)
+				debugLog("Stack trace: %s\n", string(debug.Stack()))
+				result, err = nil, fmt.Errorf("tool %s panicked: %v", request.Params.Name, r)
+			}
+		}()
+		return handler(ctx, request)
+	}
+}
+
+func jsonResult(v interface{}) (*mcp.CallToolResult, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal result: %w", err)
+	}
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+func requiredString(request mcp.CallToolRequest, name string) (string, error) {
+	value, ok := request.Params.Arguments[name].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("%s must be a non-empty string", name)
+	}
+	return value, nil
+}
+
+func optionalString(request mcp.CallToolRequest, name string, defaultValue string) string {
+	if value, ok := request.Params.Arguments[name].(string); ok && value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// optionalNumber returns a positive number argument, which JSON decodes as float64
+func optionalNumber(request mcp.CallToolRequest, name string, defaultValue int) int {
+	if value, ok := request.Params.Arguments[name].(float64); ok && value >= 1 {
+		return int(value)
+	}
+	return defaultValue
+}
+
+func pageTokenArg(request mcp.CallToolRequest) ([]byte, error) {
+	token := optionalString(request, "next_page_token", "")
+	if token == "" {
+		return nil, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("next_page_token must be a token returned by a previous call")
+	}
+	return decoded, nil
+}
+
+func executionArgs(request mcp.CallToolRequest) (string, *types.WorkflowExecution, error) {
+	domain, err := requiredString(request, "domain")
+	if err != nil {
+		return "", nil, err
+	}
+	workflowID, err := requiredString(request, "workflow_id")
+	if err != nil {
+		return "", nil, err
+	}
+	return domain, &types.WorkflowExecution{
+		WorkflowID: workflowID,
+		RunID:      optionalString(request, "run_id", ""),
+	}, nil
+}
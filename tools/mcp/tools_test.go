@@ -0,0 +1,453 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/resilience"
+)
+
+func newTestToolset(t *testing.T) (*toolset, *frontend.MockClient, *admin.MockClient) {
+	ctrl := gomock.NewController(t)
+	frontendClient := frontend.NewMockClient(ctrl)
+	adminClient := admin.NewMockClient(ctrl)
+	return newToolset(frontendClient, adminClient), frontendClient, adminClient
+}
+
+func callToolRequest(name string, arguments map[string]interface{}) mcp.CallToolRequest {
+	var request mcp.CallToolRequest
+	request.Params.Name = name
+	request.Params.Arguments = arguments
+	return request
+}
+
+// decodeResult unmarshals the JSON text of a successful tool result
+func decodeResult(t *testing.T, result *mcp.CallToolResult, err error, v interface{}) {
+	t.Helper()
+	require.NoError(t, err)
+	require.False(t, result.IsError, "tool returned an error: %v", result.Content)
+	require.Len(t, result.Content, 1)
+	text, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok)
+	require.NoError(t, json.Unmarshal([]byte(text.Text), v))
+}
+
+func TestDomainRR(t *testing.T) {
+	tools, frontendClient, adminClient := newTestToolset(t)
+	frontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("test-domain")}).Return(&types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: "test-domain", UUID: "domain-id"},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "cluster0",
+			Clusters:          []*types.ClusterReplicationConfiguration{{ClusterName: "cluster0"}},
+		},
+	}, nil)
+	adminClient.EXPECT().GetGlobalIsolationGroups(gomock.Any(), gomock.Any()).Return(&types.GetGlobalIsolationGroupsResponse{}, nil)
+	adminClient.EXPECT().GetDomainIsolationGroups(gomock.Any(), gomock.Any()).Return(&types.GetDomainIsolationGroupsResponse{}, nil)
+
+	var report resilience.Report
+	res, err := tools.domainRR(context.Background(), callToolRequest("domain_rr", map[string]interface{}{"domain": "test-domain"}))
+	decodeResult(t, res, err, &report)
+	assert.Equal(t, "test-domain", report.Domain)
+	assert.Equal(t, resilience.RatingNotResilient, report.Rating)
+	assert.Len(t, report.Checks, 9)
+	assert.NotEmpty(t, report.Remediation)
+}
+
+func TestDomainRR_Error(t *testing.T) {
+	tools, frontendClient, _ := newTestToolset(t)
+	frontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "domain not found"})
+
+	res, err := tools.domainRR(context.Background(), callToolRequest("domain_rr", map[string]interface{}{"domain": "test-domain"}))
+	require.NoError(t, err)
+	assert.True(t, res.IsError)
+}
+
+func TestMissingArguments(t *testing.T) {
+	tools, _, _ := newTestToolset(t)
+
+	_, err := tools.describeWorkflow(context.Background(), callToolRequest("describe_workflow", map[string]interface{}{"domain": "test-domain"}))
+	assert.EqualError(t, err, "workflow_id must be a non-empty string")
+
+	_, err = tools.listWorkflows(context.Background(), callToolRequest("list_workflows", map[string]interface{}{}))
+	assert.EqualError(t, err, "domain must be a non-empty string")
+
+	_, err = tools.listWorkflows(context.Background(), callToolRequest("list_workflows", map[string]interface{}{"domain": "test-domain", "next_page_token": "%%%"}))
+	assert.EqualError(t, err, "next_page_token must be a token returned by a previous call")
+
+	_, err = tools.describeTaskList(context.Background(), callToolRequest("describe_task_list", map[string]interface{}{"domain": "test-domain", "task_list": "tl", "task_list_type": "sticky"}))
+	assert.EqualError(t, err, `task_list_type must be decision or activity, got "sticky"`)
+}
+
+func TestAPIErrorIsToolError(t *testing.T) {
+	tools, frontendClient, _ := newTestToolset(t)
+	frontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "workflow not found"})
+
+	res, err := tools.describeWorkflow(context.Background(), callToolRequest("describe_workflow", map[string]interface{}{"domain": "test-domain", "workflow_id": "wid"}))
+	require.NoError(t, err)
+	assert.True(t, res.IsError)
+	assert.Contains(t, res.Content[0].(mcp.TextContent).Text, "workflow not found")
+}
+
+func TestDescribeWorkflow(t *testing.T) {
+	tools, frontendClient, _ := newTestToolset(t)
+	frontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
+		Domain:    "test-domain",
+		Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	}).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{HistoryLength: 12},
+		PendingActivities:     []*types.PendingActivityInfo{{ActivityID: "1"}},
+	}, nil)
+
+	var result types.DescribeWorkflowExecutionResponse
+	res, err := tools.describeWorkflow(context.Background(), callToolRequest("describe_workflow", map[string]interface{}{"domain": "test-domain", "workflow_id": "wid", "run_id": "rid"}))
+	decodeResult(t, res, err, &result)
+	assert.Equal(t, int64(12), result.WorkflowExecutionInfo.HistoryLength)
+	assert.Equal(t, "1", result.PendingActivities[0].ActivityID)
+}
+
+func TestListWorkflows(t *testing.T) {
+	tools, frontendClient, _ := newTestToolset(t)
+	frontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), &types.ListWorkflowExecutionsRequest{
+		Domain:        "test-domain",
+		PageSize:      5,
+		NextPageToken: []byte("page-1"),
+		Query:         "CloseStatus = 'failed'",
+	}).Return(&types.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{{
+			Execution:   &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+			Type:        &types.WorkflowType{Name: "PaymentWorkflow"},
+			TaskList:    &types.TaskList{Name: "payments"},
+			StartTime:   common.Int64Ptr(100),
+			CloseTime:   common.Int64Ptr(200),
+			CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr(),
+		}},
+		NextPageToken: []byte("page-2"),
+	}, nil)
+
+	var result listWorkflowsResult
+	res, err := tools.listWorkflows(context.Background(), callToolRequest("list_workflows", map[string]interface{}{
+		"domain":          "test-domain",
+		"query":           "CloseStatus = 'failed'",
+		"page_size":       float64(5),
+		"next_page_token": base64.StdEncoding.EncodeToString([]byte("page-1")),
+	}))
+	decodeResult(t, res, err, &result)
+	assert.Equal(t, listWorkflowsResult{
+		Workflows: []workflowSummary{{
+			WorkflowID:   "wid",
+			RunID:        "rid",
+			WorkflowType: "PaymentWorkflow",
+			TaskList:     "payments",
+			StartTime:    common.Int64Ptr(100),
+			CloseTime:    common.Int64Ptr(200),
+			CloseStatus:  "FAILED",
+		}},
+		NextPageToken: base64.StdEncoding.EncodeToString([]byte("page-2")),
+	}, result)
+}
+
+func TestWorkflowHistorySummary(t *testing.T) {
+	events := []*types.HistoryEvent{
+		{ID: 1, Timestamp: common.Int64Ptr(10), EventType: types.EventTypeWorkflowExecutionStarted.Ptr(), WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+			WorkflowType: &types.WorkflowType{Name: "PaymentWorkflow"},
+			TaskList:     &types.TaskList{Name: "payments"},
+		}},
+		{ID: 2, EventType: types.EventTypeDecisionTaskFailed.Ptr(), DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+			Cause:  types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+			Reason: common.StringPtr("panic"),
+		}},
+		{ID: 3, EventType: types.EventTypeActivityTaskScheduled.Ptr(), ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{ActivityID: "charge"}},
+		{ID: 4, EventType: types.EventTypeActivityTaskScheduled.Ptr(), ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{ActivityID: "notify"}},
+		{ID: 5, EventType: types.EventTypeActivityTaskFailed.Ptr(), ActivityTaskFailedEventAttributes: &types.ActivityTaskFailedEventAttributes{
+			ScheduledEventID: 3,
+			Reason:           common.StringPtr("card declined"),
+		}},
+		{ID: 6, Timestamp: common.Int64Ptr(60), EventType: types.EventTypeActivityTaskTimedOut.Ptr(), ActivityTaskTimedOutEventAttributes: &types.ActivityTaskTimedOutEventAttributes{
+			ScheduledEventID: 99,
+			TimeoutType:      types.TimeoutTypeStartToClose.Ptr(),
+		}},
+	}
+
+	tools, frontendClient, _ := newTestToolset(t)
+	gomock.InOrder(
+		frontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+			Domain:          "test-domain",
+			Execution:       &types.WorkflowExecution{WorkflowID: "wid"},
+			MaximumPageSize: historyPageSize,
+		}).Return(&types.GetWorkflowExecutionHistoryResponse{
+			History:       &types.History{Events: events[:3]},
+			NextPageToken: []byte("next"),
+		}, nil),
+		frontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+			Domain:          "test-domain",
+			Execution:       &types.WorkflowExecution{WorkflowID: "wid"},
+			MaximumPageSize: historyPageSize,
+			NextPageToken:   []byte("next"),
+		}).Return(&types.GetWorkflowExecutionHistoryResponse{
+			History: &types.History{Events: events[3:]},
+		}, nil),
+	)
+
+	var result historySummary
+	res, err := tools.workflowHistorySummary(context.Background(), callToolRequest("workflow_history_summary", map[string]interface{}{"domain": "test-domain", "workflow_id": "wid"}))
+	decodeResult(t, res, err, &result)
+	assert.Equal(t, historySummary{
+		WorkflowType:   "PaymentWorkflow",
+		TaskList:       "payments",
+		EventCount:     6,
+		FirstEventTime: common.Int64Ptr(10),
+		LastEventTime:  common.Int64Ptr(60),
+		LastEventType:  "ActivityTaskTimedOut",
+		EventTypeCounts: map[string]int{
+			"WorkflowExecutionStarted": 1,
+			"DecisionTaskFailed":       1,
+			"ActivityTaskScheduled":    2,
+			"ActivityTaskFailed":       1,
+			"ActivityTaskTimedOut":     1,
+		},
+		PendingActivities: []string{"notify"},
+		LastFailures: []historyFailure{
+			{EventID: 2, EventType: "DecisionTaskFailed", Reason: "WORKFLOW_WORKER_UNHANDLED_FAILURE: panic"},
+			{EventID: 5, EventType: "ActivityTaskFailed", Reason: "card declined"},
+			{EventID: 6, EventType: "ActivityTaskTimedOut", Reason: "timeout: START_TO_CLOSE"},
+		},
+		DecisionTaskFailed: 1,
+	}, result)
+}
+
+func TestWorkflowHistorySummary_MaxEvents(t *testing.T) {
+	tools, frontendClient, _ := newTestToolset(t)
+	frontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+		}},
+		NextPageToken: []byte("next"),
+	}, nil).Times(1)
+
+	var result historySummary
+	res, err := tools.workflowHistorySummary(context.Background(), callToolRequest("workflow_history_summary", map[string]interface{}{
+		"domain":      "test-domain",
+		"workflow_id": "wid",
+		"max_events":  float64(1),
+	}))
+	decodeResult(t, res, err, &result)
+	assert.Equal(t, 1, result.EventCount)
+	assert.True(t, result.Truncated)
+}
+
+func TestDiagnoseWorkflow(t *testing.T) {
+	diagnosticsExecution := &types.WorkflowExecution{WorkflowID: "diagnostics-wid", RunID: "diagnostics-rid"}
+	queryRequest := &types.QueryWorkflowRequest{
+		Domain:    "cadence-system",
+		Execution: diagnosticsExecution,
+		Query:     &types.WorkflowQuery{QueryType: diagnosticsReportQueryType},
+	}
+	tests := map[string]struct {
+		setupMock   func(frontendClient *frontend.MockClient)
+		expectError string
+	}{
+		"report once completed": {
+			setupMock: func(frontendClient *frontend.MockClient) {
+				gomock.InOrder(
+					frontendClient.EXPECT().QueryWorkflow(gomock.Any(), queryRequest).Return(nil, errors.New("no decision task processed yet")),
+					frontendClient.EXPECT().QueryWorkflow(gomock.Any(), queryRequest).Return(&types.QueryWorkflowResponse{
+						QueryResult: []byte(`{"DiagnosticsCompleted":false}`),
+					}, nil),
+					frontendClient.EXPECT().QueryWorkflow(gomock.Any(), queryRequest).Return(&types.QueryWorkflowResponse{
+						QueryResult: []byte(`{"DiagnosticsResult":{"Timeouts":{"Issues":[{"IssueType":"The activity timed out"}]}},"DiagnosticsCompleted":true}`),
+					}, nil),
+				)
+			},
+		},
+		"diagnostics workflow closed": {
+			setupMock: func(frontendClient *frontend.MockClient) {
+				frontendClient.EXPECT().QueryWorkflow(gomock.Any(), queryRequest).Return(&types.QueryWorkflowResponse{
+					QueryRejected: &types.QueryRejected{CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr()},
+				}, nil)
+			},
+			expectError: "diagnostics workflow is in state FAILED",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tools, frontendClient, _ := newTestToolset(t)
+			tools.diagnosticsPollInterval = 0
+			frontendClient.EXPECT().DiagnoseWorkflowExecution(gomock.Any(), &types.DiagnoseWorkflowExecutionRequest{
+				Domain:            "test-domain",
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid"},
+				Identity:          mcpIdentity,
+			}).Return(&types.DiagnoseWorkflowExecutionResponse{
+				Domain:                      "cadence-system",
+				DiagnosticWorkflowExecution: diagnosticsExecution,
+			}, nil)
+			test.setupMock(frontendClient)
+
+			res, err := tools.diagnoseWorkflow(context.Background(), callToolRequest("diagnose_workflow", map[string]interface{}{"domain": "test-domain", "workflow_id": "wid"}))
+			if test.expectError != "" {
+				require.NoError(t, err)
+				require.True(t, res.IsError)
+				assert.Contains(t, res.Content[0].(mcp.TextContent).Text, test.expectError)
+				return
+			}
+			var result diagnosticsResult
+			decodeResult(t, res, err, &result)
+			assert.Equal(t, "cadence-system", result.Domain)
+			assert.Equal(t, "diagnostics-wid", result.DiagnosticExecution.WorkflowID)
+			assert.JSONEq(t, `{"Issues":[{"IssueType":"The activity timed out"}]}`, string(result.Report["Timeouts"]))
+		})
+	}
+}
+
+func TestDescribeTaskList(t *testing.T) {
+	tests := map[string]struct {
+		resp     *types.DescribeTaskListResponse
+		expected taskListSummary
+	}{
+		"backlog and pollers": {
+			resp: &types.DescribeTaskListResponse{
+				Pollers:        []*types.PollerInfo{{Identity: "worker-1", LastAccessTime: common.Int64Ptr(100), RatePerSecond: 10}},
+				TaskListStatus: &types.TaskListStatus{BacklogCountHint: 42, RatePerSecond: 10, NewTasksPerSecond: 12},
+			},
+			expected: taskListSummary{
+				Domain:       "test-domain",
+				TaskList:     "payments",
+				TaskListType: "activity",
+				Backlog:      42,
+				AddRate:      12,
+				DispatchRate: 10,
+				Pollers:      []pollerSummary{{Identity: "worker-1", LastAccessTime: common.Int64Ptr(100), RatePerSecond: 10}},
+			},
+		},
+		"no pollers": {
+			resp: &types.DescribeTaskListResponse{},
+			expected: taskListSummary{
+				Domain:       "test-domain",
+				TaskList:     "payments",
+				TaskListType: "activity",
+				Pollers:      []pollerSummary{},
+				Warning:      "no worker polled this task list recently, tasks are not being processed",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tools, frontendClient, _ := newTestToolset(t)
+			frontendClient.EXPECT().DescribeTaskList(gomock.Any(), &types.DescribeTaskListRequest{
+				Domain:                "test-domain",
+				TaskList:              &types.TaskList{Name: "payments"},
+				TaskListType:          types.TaskListTypeActivity.Ptr(),
+				IncludeTaskListStatus: true,
+			}).Return(test.resp, nil)
+
+			var result taskListSummary
+			res, err := tools.describeTaskList(context.Background(), callToolRequest("describe_task_list", map[string]interface{}{
+				"domain":         "test-domain",
+				"task_list":      "payments",
+				"task_list_type": "activity",
+			}))
+			decodeResult(t, res, err, &result)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestListFailoverHistory(t *testing.T) {
+	tools, frontendClient, _ := newTestToolset(t)
+	frontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("test-domain")}).Return(&types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{UUID: "domain-id"},
+	}, nil)
+	pageSize := int32(defaultListPageSize)
+	frontendClient.EXPECT().ListFailoverHistory(gomock.Any(), &types.ListFailoverHistoryRequest{
+		Filters:    &types.ListFailoverHistoryRequestFilters{DomainID: "domain-id"},
+		Pagination: &types.PaginationOptions{PageSize: &pageSize},
+	}).Return(&types.ListFailoverHistoryResponse{
+		FailoverEvents: []*types.FailoverEvent{{ID: common.StringPtr("failover-1"), CreatedTime: common.Int64Ptr(100)}},
+	}, nil)
+
+	var result listFailoverHistoryResult
+	res, err := tools.listFailoverHistory(context.Background(), callToolRequest("list_failover_history", map[string]interface{}{"domain": "test-domain"}))
+	decodeResult(t, res, err, &result)
+	assert.Equal(t, "test-domain", result.Domain)
+	require.Len(t, result.FailoverEvents, 1)
+	assert.Equal(t, "failover-1", *result.FailoverEvents[0].ID)
+	assert.Empty(t, result.NextPageToken)
+}
+
+func TestListFailoverHistory_DomainError(t *testing.T) {
+	tools, frontendClient, _ := newTestToolset(t)
+	frontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+
+	res, err := tools.listFailoverHistory(context.Background(), callToolRequest("list_failover_history", map[string]interface{}{"domain": "test-domain"}))
+	require.NoError(t, err)
+	assert.True(t, res.IsError)
+}
+
+func TestDLQCounts(t *testing.T) {
+	tools, _, adminClient := newTestToolset(t)
+	adminClient.EXPECT().CountDLQMessages(gomock.Any(), &types.CountDLQMessagesRequest{ForceFetch: true}).Return(&types.CountDLQMessagesResponse{
+		History: map[types.HistoryDLQCountKey]int64{
+			{ShardID: 2, SourceCluster: "cluster1"}: 5,
+			{ShardID: 1, SourceCluster: "cluster1"}: 3,
+			{ShardID: 1, SourceCluster: "cluster0"}: 1,
+		},
+		Domain: 7,
+	}, nil)
+
+	var result dlqCountsResult
+	res, err := tools.dlqCounts(context.Background(), callToolRequest("dlq_counts", map[string]interface{}{"force_fetch": true}))
+	decodeResult(t, res, err, &result)
+	assert.Equal(t, dlqCountsResult{
+		DomainDLQ:       7,
+		HistoryDLQTotal: 9,
+		HistoryDLQ: []historyDLQCount{
+			{ShardID: 1, SourceCluster: "cluster0", Count: 1},
+			{ShardID: 1, SourceCluster: "cluster1", Count: 3},
+			{ShardID: 2, SourceCluster: "cluster1", Count: 5},
+		},
+	}, result)
+}
+
+func TestWithRecover(t *testing.T) {
+	handler := withRecover(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		panic("boom")
+	})
+
+	_, err := handler(context.Background(), callToolRequest("panicking_tool", nil))
+	assert.EqualError(t, err, "tool panicking_tool panicked: boom")
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+type (
+	// MongoClientConfig contains the configuration for the mongo schema client
+	MongoClientConfig struct {
+		Hosts    string
+		Port     int
+		User     string
+		Password string
+		Database string
+		Timeout  int
+	}
+
+	mongoClient struct {
+		client   *mongo.Client
+		db       *mongo.Database
+		database string
+		timeout  time.Duration
+	}
+
+	schemaVersionRecord struct {
+		ID                   string    `bson:"_id"`
+		CurrVersion          string    `bson:"currversion"`
+		MinCompatibleVersion string    `bson:"mincompatibleversion"`
+		CreationTime         time.Time `bson:"creationtime"`
+	}
+
+	schemaUpdateHistoryRecord struct {
+		Year        int       `bson:"year"`
+		Month       int       `bson:"month"`
+		UpdateTime  time.Time `bson:"updatetime"`
+		Description string    `bson:"description"`
+		ManifestMD5 string    `bson:"manifestmd5"`
+		NewVersion  string    `bson:"newversion"`
+		OldVersion  string    `bson:"oldversion"`
+	}
+)
+
+const (
+	// DefaultMongoPort is the default port of mongod
+	DefaultMongoPort = 27017
+	// DefaultTimeout is the default timeout in seconds of every schema operation
+	DefaultTimeout = 30
+
+	schemaVersionCollection       = "schema_version"
+	schemaUpdateHistoryCollection = "schema_update_history"
+
+	// errCodeNamespaceExists is returned by the create command when the collection already exists
+	errCodeNamespaceExists = 48
+)
+
+// allowedCommands are the only database commands a schema file is allowed to contain,
+// so that applying a schema can never drop or modify existing data
+var allowedCommands = map[string]struct{}{
+	"create":        {},
+	"createIndexes": {},
+	"insert":        {},
+}
+
+var (
+	_ schema.SchemaClient    = (*mongoClient)(nil)
+	_ schema.StatementParser = (*mongoClient)(nil)
+)
+
+// newMongoClient returns a new schema client connected to the configured database
+func newMongoClient(cfg *MongoClientConfig) (*mongoClient, error) {
+	uri := &url.URL{
+		Scheme: "mongodb",
+		Host:   fmt.Sprintf("%v:%v", cfg.Hosts, cfg.Port),
+		Path:   "/",
+	}
+	if cfg.User != "" {
+		uri.User = url.UserPassword(cfg.User, cfg.Password)
+	}
+	timeout := time.Duration(cfg.Timeout) * time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri.String()).SetConnectTimeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, fmt.Errorf("connecting to mongodb %v: %w", uri.Host, err)
+	}
+	return &mongoClient{
+		client:   client,
+		db:       client.Database(cfg.Database),
+		database: cfg.Database,
+		timeout:  timeout,
+	}, nil
+}
+
+// ParseStatements parses a schema file made of a json array of database commands,
+// and returns every command as a separate statement
+func (c *mongoClient) ParseStatements(file fs.File) ([]string, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	var commands []json.RawMessage
+	if err := json.Unmarshal(content, &commands); err != nil {
+		return nil, fmt.Errorf("schema file must be a json array of commands: %w", err)
+	}
+	stmts := make([]string, 0, len(commands))
+	for _, raw := range commands {
+		cmd, err := parseCommand(string(raw))
+		if err != nil {
+			return nil, err
+		}
+		if len(cmd) == 0 {
+			return nil, fmt.Errorf("empty command in schema file")
+		}
+		if _, ok := allowedCommands[cmd[0].Key]; !ok {
+			return nil, fmt.Errorf("command not in whitelist, command=%v", cmd[0].Key)
+		}
+		stmts = append(stmts, string(raw))
+	}
+	return stmts, nil
+}
+
+// ExecDDLQuery runs a database command parsed by ParseStatements
+func (c *mongoClient) ExecDDLQuery(stmt string, args ...interface{}) error {
+	cmd, err := parseCommand(stmt)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.newContext()
+	defer cancel()
+	return c.db.RunCommand(ctx, cmd).Err()
+}
+
+// DropAllTables drops the database together with all of its collections
+func (c *mongoClient) DropAllTables() error {
+	ctx, cancel := c.newContext()
+	defer cancel()
+	return c.db.Drop(ctx)
+}
+
+// CreateSchemaVersionTables sets up the schema version collections
+func (c *mongoClient) CreateSchemaVersionTables() error {
+	for _, name := range []string{schemaVersionCollection, schemaUpdateHistoryCollection} {
+		if err := c.createCollection(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadSchemaVersion returns the current schema version of the database
+func (c *mongoClient) ReadSchemaVersion() (string, error) {
+	ctx, cancel := c.newContext()
+	defer cancel()
+	var record schemaVersionRecord
+	err := c.db.Collection(schemaVersionCollection).FindOne(ctx, bson.M{"_id": c.database}).Decode(&record)
+	if err != nil {
+		return "", err
+	}
+	return record.CurrVersion, nil
+}
+
+// UpdateSchemaVersion updates the schema version of the database
+func (c *mongoClient) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	ctx, cancel := c.newContext()
+	defer cancel()
+	record := schemaVersionRecord{
+		ID:                   c.database,
+		CurrVersion:          newVersion,
+		MinCompatibleVersion: minCompatibleVersion,
+		CreationTime:         time.Now().UTC(),
+	}
+	_, err := c.db.Collection(schemaVersionCollection).ReplaceOne(
+		ctx, bson.M{"_id": c.database}, record, options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+// WriteSchemaUpdateLog adds an entry to the schema update history collection
+func (c *mongoClient) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	ctx, cancel := c.newContext()
+	defer cancel()
+	now := time.Now().UTC()
+	record := schemaUpdateHistoryRecord{
+		Year:        now.Year(),
+		Month:       int(now.Month()),
+		UpdateTime:  now,
+		Description: desc,
+		ManifestMD5: manifestMD5,
+		NewVersion:  newVersion,
+		OldVersion:  oldVersion,
+	}
+	_, err := c.db.Collection(schemaUpdateHistoryCollection).InsertOne(ctx, record)
+	return err
+}
+
+// Close gracefully closes the client object
+func (c *mongoClient) Close() {
+	if c.client != nil {
+		_ = c.client.Disconnect(context.Background())
+	}
+}
+
+func (c *mongoClient) createCollection(name string) error {
+	ctx, cancel := c.newContext()
+	defer cancel()
+	err := c.db.RunCommand(ctx, bson.D{{Key: "create", Value: name}}).Err()
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.Code == errCodeNamespaceExists {
+		return nil
+	}
+	return err
+}
+
+func (c *mongoClient) newContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+// parseCommand decodes a database command from extended json, keeping the order
+// of its fields because the command name must be the first one
+func parseCommand(stmt string) (bson.D, error) {
+	var cmd bson.D
+	if err := bson.UnmarshalExtJSON([]byte(stmt), false, &cmd); err != nil {
+		return nil, fmt.Errorf("error parsing command %v: %w", stmt, err)
+	}
+	return cmd, nil
+}
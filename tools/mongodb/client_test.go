@@ -0,0 +1,105 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mongodb
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+func TestValidateMongoClientConfig(t *testing.T) {
+	config := new(MongoClientConfig)
+	assert.Error(t, validateMongoClientConfig(config))
+
+	config.Hosts = "127.0.0.1"
+	assert.Error(t, validateMongoClientConfig(config))
+
+	config.Database = "cadence"
+	require.NoError(t, validateMongoClientConfig(config))
+	assert.Equal(t, DefaultMongoPort, config.Port)
+	assert.Equal(t, DefaultTimeout, config.Timeout)
+}
+
+func TestParseStatements(t *testing.T) {
+	tests := map[string]struct {
+		content   string
+		wantStmts int
+		wantErr   bool
+	}{
+		"create and index commands": {
+			content:   `[{"create": "shards"}, {"createIndexes": "shards", "indexes": [{"key": {"rangeid": 1}, "name": "rangeid"}]}]`,
+			wantStmts: 2,
+		},
+		"empty array": {
+			content:   `[]`,
+			wantStmts: 0,
+		},
+		"not an array": {
+			content: `{"create": "shards"}`,
+			wantErr: true,
+		},
+		"empty command": {
+			content: `[{}]`,
+			wantErr: true,
+		},
+		"command not in whitelist": {
+			content: `[{"drop": "shards"}]`,
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := t.TempDir() + "/schema.json"
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0644))
+			file, err := os.Open(path)
+			require.NoError(t, err)
+			defer file.Close()
+
+			stmts, err := (&mongoClient{}).ParseStatements(file)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, stmts, tc.wantStmts)
+			for _, stmt := range stmts {
+				_, err := parseCommand(stmt)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuildChangeSet(t *testing.T) {
+	task := schema.NewUpdateSchemaTask(&mongoClient{}, &schema.UpdateConfig{
+		SchemaFS: os.DirFS("../../schema/mongodb/cadence/versioned"),
+	})
+	changes, err := task.BuildChangeSet("0.0")
+	require.NoError(t, err)
+	require.NotEmpty(t, changes)
+	for _, cs := range changes {
+		assert.NotEmpty(t, cs.CqlStmts, "version %v", cs.Version)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mongodb
+
+import (
+	"log"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+// setupSchema executes the setupSchemaTask
+// using the given command line arguments
+// as input
+func setupSchema(cli *cli.Context) error {
+	config, err := newMongoClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := newMongoClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := schema.Setup(cli, client); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// updateSchema executes the updateSchemaTask
+// using the given command line args as input
+func updateSchema(cli *cli.Context) error {
+	config, err := newMongoClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := newMongoClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := schema.Update(cli, client); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+func newMongoClientConfig(cli *cli.Context) (*MongoClientConfig, error) {
+	config := &MongoClientConfig{
+		Hosts:    cli.String(schema.CLIOptEndpoint),
+		Port:     cli.Int(schema.CLIOptPort),
+		User:     cli.String(schema.CLIOptUser),
+		Password: cli.String(schema.CLIOptPassword),
+		Database: cli.String(schema.CLIOptDatabase),
+		Timeout:  cli.Int(schema.CLIOptTimeout),
+	}
+	if err := validateMongoClientConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func validateMongoClientConfig(config *MongoClientConfig) error {
+	if len(config.Hosts) == 0 {
+		return schema.NewConfigError("missing mongodb endpoint argument " + flag(schema.CLIOptEndpoint))
+	}
+	if config.Database == "" {
+		return schema.NewConfigError("missing " + flag(schema.CLIOptDatabase) + " argument ")
+	}
+	if config.Port == 0 {
+		config.Port = DefaultMongoPort
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultTimeout
+	}
+	return nil
+}
+
+func flag(opt string) string {
+	return "(-" + opt + ")"
+}
+
+func handleErr(err error) error {
+	log.Println(err)
+	return err
+}